@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package app is the all-in-one deployment of bscp for developers and evaluators, it runs config-server,
+// data-service, cache-service and feed-server in one process with sqlite, an embedded redis and the standalone
+// service discovery, so that bscp can be booted with a single command without mysql, etcd and redis.
+package app
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/alicebob/miniredis/v2"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/all-in-one/options"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// commonConfig is the configuration file shared by all the services, which overrides the same top level
+// items of the service's own configuration file.
+const commonConfig = "all_in_one.yaml"
+
+// Run start the embedded redis and all the services in current process, it blocks until receiving the exit
+// signal or any of the services fails to serve.
+func Run(opt *options.Option) error {
+	if err := os.MkdirAll(opt.DataDir, 0755); err != nil {
+		return fmt.Errorf("create data dir failed, err: %v", err)
+	}
+
+	redis := miniredis.NewMiniRedis()
+	if err := redis.StartAddr(opt.RedisAddr); err != nil {
+		return fmt.Errorf("start embedded redis on %s failed, err: %v", opt.RedisAddr, err)
+	}
+	defer redis.Close()
+	fmt.Printf("embedded redis is listening on %s\n", opt.RedisAddr)
+
+	finalizers := make([]func(), 0, len(services))
+	// stop deregisters the started services in the reverse order they are started.
+	stop := func() {
+		for i := len(finalizers) - 1; i >= 0; i-- {
+			finalizers[i]()
+		}
+	}
+	for _, one := range services {
+		sys := &cc.SysOption{
+			ConfigFiles: []string{filepath.Join(opt.EtcDir, one.config), filepath.Join(opt.EtcDir, commonConfig)},
+			BindIP:      opt.Sys.BindIP,
+			Service:     one.name,
+		}
+		finalizer, err := one.start(sys)
+		if err != nil {
+			stop()
+			return fmt.Errorf("start %s failed, err: %v", one.name, err)
+		}
+		finalizers = append(finalizers, finalizer)
+		fmt.Printf("%s is started\n", one.name)
+	}
+
+	shutdown.RegisterFirstShutdown(stop)
+
+	// SIGTERM is handled by the shutdown package, stop the services on ctrl+c too.
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, syscall.SIGINT)
+		<-sig
+		logs.Infof("received interrupt signal, stop all the services...")
+		shutdown.SignalShutdownGracefully()
+	}()
+
+	shutdown.WaitShutdown(20)
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package app
+
+import (
+	cacheapp "github.com/TencentBlueKing/bk-bscp/cmd/cache-service/app"
+	cacheopt "github.com/TencentBlueKing/bk-bscp/cmd/cache-service/options"
+	csapp "github.com/TencentBlueKing/bk-bscp/cmd/config-server/app"
+	csopt "github.com/TencentBlueKing/bk-bscp/cmd/config-server/options"
+	dsapp "github.com/TencentBlueKing/bk-bscp/cmd/data-service/app"
+	dsopt "github.com/TencentBlueKing/bk-bscp/cmd/data-service/options"
+	fsapp "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/app"
+	fsopt "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/options"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// feedServerName is the instance name of the only one feed server.
+const feedServerName = "allinone"
+
+// service is a service run by the all-in-one, the services are started in order.
+type service struct {
+	name cc.Name
+	// config is the configuration file name of the service in the etc dir.
+	config string
+	// start starts the service and returns its finalizer.
+	start func(sys *cc.SysOption) (func(), error)
+}
+
+// services data-service runs first, so that the db schema is migrated before the others start.
+var services = []service{
+	{name: cc.DataServiceName, config: "data_service.yaml", start: startDataService},
+	{name: cc.CacheServiceName, config: "cache_service.yaml", start: startCacheService},
+	{name: cc.ConfigServerName, config: "config_server.yaml", start: startConfigServer},
+	{name: cc.FeedServerName, config: "feed_server.yaml", start: startFeedServer},
+}
+
+func startDataService(sys *cc.SysOption) (func(), error) {
+	return dsapp.Start(&dsopt.Option{Sys: sys})
+}
+
+func startCacheService(sys *cc.SysOption) (func(), error) {
+	return cacheapp.Start(&cacheopt.Option{Sys: sys})
+}
+
+func startConfigServer(sys *cc.SysOption) (func(), error) {
+	return csapp.Start(&csopt.Option{Sys: sys})
+}
+
+func startFeedServer(sys *cc.SysOption) (func(), error) {
+	return fsapp.Start(&fsopt.Option{Sys: sys, Name: feedServerName})
+}
@@ -0,0 +1,192 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package dev generates the configuration files of all the services from a minimal dev.yaml, and launches
+// the whole bscp stack locally with the all-in-one and seeded demo data, it is used for development only.
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// defaultConfigFile is the default minimal input file of the dev harness.
+	defaultConfigFile = "etc/dev.yaml"
+	// defaultMasterKey is the credential master key used by the dev environment, aes requires 16/24/32 bytes.
+	defaultMasterKey = "BSCPDEVMASTERKEY"
+	// composeProject is the docker compose project name of the dev environment.
+	composeProject = "bscp-dev"
+)
+
+// Config is the minimal input of the dev harness, the other configurations are generated.
+type Config struct {
+	// WorkDir is the directory where the generated configuration, data and log files are stored.
+	WorkDir string `yaml:"workDir"`
+	// Host is the address the services listen to and dial each other.
+	Host string `yaml:"host"`
+	// PortOffset is added to all the default ports, so that multiple stacks can run on the same host,
+	// it should be a multiple of 1000 to avoid conflicting with the other stack's ports.
+	PortOffset int `yaml:"portOffset"`
+	// Compose starts the external dependencies (vault) with docker compose, otherwise vault must be configured.
+	Compose bool  `yaml:"compose"`
+	Vault   Vault `yaml:"vault"`
+	// MasterKey is the master key to encrypt the client credentials.
+	MasterKey string `yaml:"masterKey"`
+	// Repository is the repository configuration of the services as it is, a placeholder is used if not set,
+	// and file type configs can not be uploaded in that case.
+	Repository map[string]interface{} `yaml:"repository"`
+	// Esb is the esb configuration of the services as it is, a placeholder is used if not set.
+	Esb  map[string]interface{} `yaml:"esb"`
+	Demo Demo                   `yaml:"demo"`
+}
+
+// Vault is the vault used by the dev environment.
+type Vault struct {
+	Address string `yaml:"address"`
+	Token   string `yaml:"token"`
+}
+
+// Demo is the demo data seeded after the stack is started.
+type Demo struct {
+	// Disabled disables seeding the demo data.
+	Disabled bool   `yaml:"disabled"`
+	BizID    uint32 `yaml:"bizID"`
+	// App is the name of the demo kv app.
+	App string `yaml:"app"`
+	// Credential is the name of the demo client credential which can access the demo app.
+	Credential string `yaml:"credential"`
+}
+
+// LoadConfig load the dev config from the file, the defaults are used if the default file does not exist.
+func LoadConfig(file string) (*Config, error) {
+	c := &Config{Compose: true}
+
+	content, err := os.ReadFile(file)
+	switch {
+	case err == nil:
+		if err = yaml.Unmarshal(content, c); err != nil {
+			return nil, fmt.Errorf("unmarshal %s failed, err: %v", file, err)
+		}
+	case errors.Is(err, os.ErrNotExist) && file == defaultConfigFile:
+		fmt.Printf("%s does not exist, use the default dev config\n", file)
+	default:
+		return nil, fmt.Errorf("read %s failed, err: %v", file, err)
+	}
+
+	if err = c.trySetDefault(); err != nil {
+		return nil, err
+	}
+
+	return c, c.validate()
+}
+
+func (c *Config) trySetDefault() error {
+	if c.WorkDir == "" {
+		c.WorkDir = "./bscp-dev"
+	}
+	// the services run in the work dir's parent process, so use the absolute path.
+	dir, err := filepath.Abs(c.WorkDir)
+	if err != nil {
+		return fmt.Errorf("get absolute path of %s failed, err: %v", c.WorkDir, err)
+	}
+	c.WorkDir = dir
+
+	if c.Host == "" {
+		c.Host = "127.0.0.1"
+	}
+
+	if c.Compose {
+		if c.Vault.Address == "" {
+			c.Vault.Address = fmt.Sprintf("http://%s:%d", c.Host, c.port(vaultPort))
+		}
+		if c.Vault.Token == "" {
+			c.Vault.Token = "bscp-dev-root"
+		}
+	}
+
+	if c.MasterKey == "" {
+		c.MasterKey = defaultMasterKey
+	}
+
+	if c.Demo.BizID == 0 {
+		c.Demo.BizID = 2
+	}
+	if c.Demo.App == "" {
+		c.Demo.App = "demo-kv"
+	}
+	if c.Demo.Credential == "" {
+		c.Demo.Credential = "demo-client"
+	}
+
+	return nil
+}
+
+func (c *Config) validate() error {
+	if c.PortOffset < 0 {
+		return errors.New("portOffset can not be negative")
+	}
+
+	if c.Vault.Address == "" || c.Vault.Token == "" {
+		return errors.New("vault address and token must be set when compose is disabled")
+	}
+
+	switch len(c.MasterKey) {
+	case 16, 24, 32:
+	default:
+		return errors.New("masterKey should be 16, 24 or 32 bytes")
+	}
+
+	return nil
+}
+
+// the default ports of the services and the dependencies.
+const (
+	feedRPCPort    = 9510
+	feedHTTPPort   = 9610
+	dataRPCPort    = 9511
+	dataHTTPPort   = 9611
+	configRPCPort  = 9512
+	configHTTPPort = 9612
+	cacheRPCPort   = 9514
+	cacheHTTPPort  = 9614
+	redisPort      = 16379
+	vaultPort      = 8200
+)
+
+// port returns the port with the offset.
+func (c *Config) port(p int) int {
+	return p + c.PortOffset
+}
+
+// addr returns the address of the port with the offset.
+func (c *Config) addr(p int) string {
+	return fmt.Sprintf("%s:%d", c.Host, c.port(p))
+}
+
+// EtcDir returns the directory of the generated configuration files.
+func (c *Config) EtcDir() string {
+	return filepath.Join(c.WorkDir, "etc")
+}
+
+// DataDir returns the directory of the sqlite database file.
+func (c *Config) DataDir() string {
+	return filepath.Join(c.WorkDir, "data")
+}
+
+// RedisAddr returns the address of the embedded redis.
+func (c *Config) RedisAddr() string {
+	return c.addr(redisPort)
+}
@@ -0,0 +1,194 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// composeFile is the generated docker compose file name in the work dir.
+const composeFile = "docker-compose.yaml"
+
+// renderData is the data to render the templates.
+type renderData struct {
+	Host           string
+	DataRPCPort    int
+	DataHTTPPort   int
+	ConfigRPCPort  int
+	ConfigHTTPPort int
+	CacheRPCPort   int
+	CacheHTTPPort  int
+	FeedRPCPort    int
+	FeedHTTPPort   int
+	DataRPCAddr    string
+	ConfigRPCAddr  string
+	CacheRPCAddr   string
+	FeedRPCAddr    string
+	RedisAddr      string
+	VaultAddress   string
+	VaultToken     string
+	VaultPort      int
+	Database       string
+	LogDir         string
+	MasterKey      string
+	// Esb and Repository are the rendered yaml blocks.
+	Esb        string
+	Repository string
+}
+
+// Generate generates the configuration files of all the services, and the docker compose file if compose
+// is enabled, the existing files are overwritten.
+func Generate(c *Config) error {
+	data, err := c.renderData()
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(c.EtcDir(), 0755); err != nil {
+		return fmt.Errorf("create etc dir failed, err: %v", err)
+	}
+	// the log dirs are not created by the services.
+	for _, one := range []string{"data-service", "config-server", "cache-service", "feed-server"} {
+		if err = os.MkdirAll(filepath.Join(data.LogDir, one), 0755); err != nil {
+			return fmt.Errorf("create log dir failed, err: %v", err)
+		}
+	}
+
+	files := map[string]string{
+		"all_in_one.yaml":    filepath.Join(c.EtcDir(), "all_in_one.yaml"),
+		"data_service.yaml":  filepath.Join(c.EtcDir(), "data_service.yaml"),
+		"config_server.yaml": filepath.Join(c.EtcDir(), "config_server.yaml"),
+		"cache_service.yaml": filepath.Join(c.EtcDir(), "cache_service.yaml"),
+		"feed_server.yaml":   filepath.Join(c.EtcDir(), "feed_server.yaml"),
+	}
+	if c.Compose {
+		files[composeFile] = filepath.Join(c.WorkDir, composeFile)
+	}
+
+	for name, path := range files {
+		if err = render(name, path, data); err != nil {
+			return err
+		}
+		fmt.Printf("generated %s\n", path)
+	}
+
+	return nil
+}
+
+func render(name, path string, data *renderData) error {
+	tpl, err := template.ParseFS(templates, "templates/"+name+".tpl")
+	if err != nil {
+		return fmt.Errorf("parse template %s failed, err: %v", name, err)
+	}
+
+	buf := new(bytes.Buffer)
+	if err = tpl.Execute(buf, data); err != nil {
+		return fmt.Errorf("render template %s failed, err: %v", name, err)
+	}
+
+	if err = os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("write %s failed, err: %v", path, err)
+	}
+
+	return nil
+}
+
+func (c *Config) renderData() (*renderData, error) {
+	esb := c.Esb
+	if len(esb) == 0 {
+		// esb 仅用于调用蓝鲸平台的接口, 本地开发时使用占位配置
+		esb = map[string]interface{}{
+			"endpoints": []string{"http://127.0.0.1:12345"},
+			"appCode":   "bk-bscp",
+			"appSecret": "xxxx",
+			"user":      "admin",
+		}
+	}
+
+	repo := make(map[string]interface{}, len(c.Repository)+1)
+	for k, v := range c.Repository {
+		repo[k] = v
+	}
+	if len(c.Repository) == 0 {
+		// 占位配置, 键值型服务不依赖仓库存储, 文件型服务需要在 dev.yaml 中配置可用的仓库
+		repo["storageType"] = "bkRepo"
+		repo["bkRepo"] = map[string]interface{}{
+			"endpoints": []string{"http://127.0.0.1:8080"},
+			"project":   "bk_bscp",
+			"username":  "admin",
+			"password":  "xxxx",
+		}
+	}
+	if _, ok := repo["redisCluster"]; !ok {
+		repo["redisCluster"] = map[string]interface{}{
+			"endpoints": []string{c.RedisAddr()},
+			"mode":      "standalone",
+		}
+	}
+
+	esbBlock, err := yamlBlock("esb", esb)
+	if err != nil {
+		return nil, err
+	}
+	repoBlock, err := yamlBlock("repository", repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &renderData{
+		Host:           c.Host,
+		DataRPCPort:    c.port(dataRPCPort),
+		DataHTTPPort:   c.port(dataHTTPPort),
+		ConfigRPCPort:  c.port(configRPCPort),
+		ConfigHTTPPort: c.port(configHTTPPort),
+		CacheRPCPort:   c.port(cacheRPCPort),
+		CacheHTTPPort:  c.port(cacheHTTPPort),
+		FeedRPCPort:    c.port(feedRPCPort),
+		FeedHTTPPort:   c.port(feedHTTPPort),
+		DataRPCAddr:    c.addr(dataRPCPort),
+		ConfigRPCAddr:  c.addr(configRPCPort),
+		CacheRPCAddr:   c.addr(cacheRPCPort),
+		FeedRPCAddr:    c.addr(feedRPCPort),
+		RedisAddr:      c.RedisAddr(),
+		VaultAddress:   c.Vault.Address,
+		VaultToken:     c.Vault.Token,
+		VaultPort:      c.port(vaultPort),
+		Database:       filepath.Join(c.DataDir(), "bk_bscp_admin.db"),
+		LogDir:         filepath.Join(c.WorkDir, "log"),
+		MasterKey:      c.MasterKey,
+		Esb:            esbBlock,
+		Repository:     repoBlock,
+	}, nil
+}
+
+// yamlBlock marshals the value as a top level yaml item with the key.
+func yamlBlock(key string, value interface{}) (string, error) {
+	buf := new(bytes.Buffer)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(map[string]interface{}{key: value}); err != nil {
+		return "", fmt.Errorf("marshal %s failed, err: %v", key, err)
+	}
+
+	return strings.TrimRight(buf.String(), "\n") + "\n", nil
+}
@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
+	pbcredential "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/credential"
+	pbcrs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/credential-scope"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+const (
+	// seedTimeout is the max time to wait for the data service to be ready.
+	seedTimeout = 3 * time.Minute
+	// seedUser is the operator of the seeded demo data.
+	seedUser = "admin"
+)
+
+// demoKvs are the kvs of the demo app.
+var demoKvs = []*pbkv.KvSpec{
+	{Key: "log_level", KvType: string(table.KvStr), Value: "info", Memo: "demo string kv"},
+	{Key: "timeout_sec", KvType: string(table.KvNumber), Value: "30", Memo: "demo number kv"},
+	{Key: "feature", KvType: string(table.KvJson), Value: `{"enabled": true, "ratio": 0.5}`, Memo: "demo json kv"},
+}
+
+// Seed waits for the data service to be ready, then creates the demo kv app with a published release and
+// a client credential which can access it, the existing demo data is not changed.
+func Seed(c *Config) error {
+	conn, err := grpc.Dial(c.addr(dataRPCPort), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("dial data service failed, err: %v", err)
+	}
+	defer conn.Close()
+
+	s := &seeder{cfg: c, ds: pbds.NewDataClient(conn)}
+
+	appID, err := s.waitDemoApp()
+	if err != nil {
+		return err
+	}
+
+	if appID == 0 {
+		if appID, err = s.createDemoApp(); err != nil {
+			return err
+		}
+	}
+
+	token, err := s.demoCredential()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\ndemo data is ready:\n  feed server: %s\n  biz: %d\n  app: %s (id: %d)\n  token: %s\n\n",
+		c.addr(feedRPCPort), c.Demo.BizID, c.Demo.App, appID, token)
+	return nil
+}
+
+type seeder struct {
+	cfg *Config
+	ds  pbds.DataClient
+}
+
+// ctx returns the rpc context of a new kit.
+func (s *seeder) ctx() context.Context {
+	kt := kit.New()
+	kt.User = seedUser
+	kt.BizID = s.cfg.Demo.BizID
+	return kt.RpcCtx()
+}
+
+// waitDemoApp waits for the data service to be ready, and returns the demo app id, 0 means not exists.
+func (s *seeder) waitDemoApp() (uint32, error) {
+	deadline := time.Now().Add(seedTimeout)
+	for {
+		resp, err := s.ds.ListAppsRest(s.ctx(), &pbds.ListAppsRestReq{
+			BizId:  fmt.Sprintf("%d", s.cfg.Demo.BizID),
+			Search: s.cfg.Demo.App,
+			All:    true,
+		})
+		if err == nil {
+			for _, one := range resp.Details {
+				if one.Spec.Name == s.cfg.Demo.App {
+					return one.Id, nil
+				}
+			}
+			return 0, nil
+		}
+
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("data service is not ready in %s, err: %v", seedTimeout, err)
+		}
+		time.Sleep(2 * time.Second)
+	}
+}
+
+func (s *seeder) createDemoApp() (uint32, error) {
+	app, err := s.ds.CreateApp(s.ctx(), &pbds.CreateAppReq{
+		BizId: s.cfg.Demo.BizID,
+		Spec: &pbapp.AppSpec{
+			Name:       s.cfg.Demo.App,
+			ConfigType: string(table.KV),
+			DataType:   string(table.KvAny),
+			Memo:       "bscp dev demo app",
+		},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("create demo app failed, err: %v", err)
+	}
+
+	kvs := make([]*pbds.BatchUpsertKvsReq_Kv, 0, len(demoKvs))
+	for _, one := range demoKvs {
+		kvs = append(kvs, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{BizId: s.cfg.Demo.BizID, AppId: app.Id},
+			KvSpec:       one,
+		})
+	}
+
+	if _, err = s.ds.BulkRelease(s.ctx(), &pbds.BulkReleaseReq{
+		BizId:       s.cfg.Demo.BizID,
+		AppId:       app.Id,
+		Kvs:         kvs,
+		ReleaseName: "v1",
+		ReleaseMemo: "bscp dev demo release",
+		Publish:     true,
+		All:         true,
+	}); err != nil {
+		return 0, fmt.Errorf("release demo app failed, err: %v", err)
+	}
+
+	fmt.Printf("demo app %s is created and released\n", s.cfg.Demo.App)
+	return app.Id, nil
+}
+
+// demoCredential returns the token of the demo credential, it is created if not exists.
+func (s *seeder) demoCredential() (string, error) {
+	list, err := s.ds.ListCredentials(s.ctx(), &pbds.ListCredentialReq{BizId: s.cfg.Demo.BizID, All: true})
+	if err != nil {
+		return "", fmt.Errorf("list credentials failed, err: %v", err)
+	}
+
+	for _, one := range list.Details {
+		if one.Spec.Name == s.cfg.Demo.Credential {
+			return tools.DecryptCredential(one.Spec.EncCredential, s.cfg.MasterKey, one.Spec.EncAlgorithm)
+		}
+	}
+
+	enc, err := tools.CreateCredential(s.cfg.MasterKey, tools.AES)
+	if err != nil {
+		return "", err
+	}
+
+	created, err := s.ds.CreateCredential(s.ctx(), &pbds.CreateCredentialReq{
+		Attachment: &pbcredential.CredentialAttachment{BizId: s.cfg.Demo.BizID},
+		Spec: &pbcredential.CredentialSpec{
+			Name:           s.cfg.Demo.Credential,
+			Memo:           "bscp dev demo client credential",
+			CredentialType: table.BearToken.String(),
+			Enable:         true,
+			EncAlgorithm:   tools.AES,
+			EncCredential:  enc,
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("create demo credential failed, err: %v", err)
+	}
+
+	if _, err = s.ds.UpdateCredentialScopes(s.ctx(), &pbds.UpdateCredentialScopesReq{
+		BizId:        s.cfg.Demo.BizID,
+		CredentialId: created.Id,
+		Created:      []*pbcrs.CredentialScopeSpec{{App: s.cfg.Demo.App, Scope: "**"}},
+	}); err != nil {
+		return "", fmt.Errorf("update demo credential scopes failed, err: %v", err)
+	}
+
+	return tools.DecryptCredential(enc, s.cfg.MasterKey, tools.AES)
+}
@@ -0,0 +1,37 @@
+# 由 bk-bscp-allinone dev gen 根据 dev.yaml 生成, 请修改 dev.yaml 后重新生成, 不要直接修改该文件。
+# 各服务的公共配置, 各服务启动时会在自身的配置文件之后加载该文件。
+
+service:
+  standalone:
+    enabled: true
+    addresses:
+      data-service: {{ .DataRPCAddr }}
+      config-server: {{ .ConfigRPCAddr }}
+      cache-service: {{ .CacheRPCAddr }}
+      feed-server: {{ .FeedRPCAddr }}
+
+redisCluster:
+  endpoints:
+    - {{ .RedisAddr }}
+  mode: standalone
+
+sharding:
+  adminDatabase:
+    driver: sqlite
+    database: {{ .Database }}
+    maxOpenConn: 10
+    maxIdleConn: 5
+
+migration:
+  autoMigrate: true
+
+credential:
+  master_key: "{{ .MasterKey }}"
+  encryption_algorithm: "aes"
+
+vault:
+  address: {{ .VaultAddress }}
+  token: {{ .VaultToken }}
+
+{{ .Esb }}
+{{ .Repository -}}
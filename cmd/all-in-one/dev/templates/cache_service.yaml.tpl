@@ -0,0 +1,17 @@
+# 由 bk-bscp-allinone dev gen 根据 dev.yaml 生成, 请修改 dev.yaml 后重新生成, 不要直接修改该文件。
+# 公共配置见 all_in_one.yaml。
+
+network:
+  bindIP: {{ .Host }}
+  rpcPort: {{ .CacheRPCPort }}
+  httpPort: {{ .CacheHTTPPort }}
+
+log:
+  logDir: {{ .LogDir }}/cache-service
+  maxPerFileSizeMB: 1024
+  maxPerLineSizeKB: 2
+  maxFileNum: 5
+  logAppend: false
+  alsoToStdErr: false
+  verbosity: 0
+  slowRequestMS: 1000
@@ -0,0 +1,12 @@
+# 由 bk-bscp-allinone dev gen 根据 dev.yaml 生成, 包含 bscp 依赖的外部组件, 仅用于本地开发。
+services:
+  vault:
+    image: hashicorp/vault:1.15
+    command: server -dev
+    cap_add:
+      - IPC_LOCK
+    environment:
+      VAULT_DEV_ROOT_TOKEN_ID: {{ .VaultToken }}
+      VAULT_DEV_LISTEN_ADDRESS: 0.0.0.0:8200
+    ports:
+      - "{{ .VaultPort }}:8200"
@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dev
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/all-in-one/app"
+	"github.com/TencentBlueKing/bk-bscp/cmd/all-in-one/options"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// vaultReadyTimeout is the max time to wait for the vault started by docker compose to be ready.
+const vaultReadyTimeout = time.Minute
+
+const usage = `Usage: bk-bscp-allinone dev <command> [flags]
+
+Commands:
+  gen    generate the configuration files of all the services from dev.yaml
+  up     generate the configuration files, start the dependencies and all the services with demo data
+
+Flags:
+`
+
+// Main runs the dev sub command with the arguments after "dev".
+func Main(args []string) error {
+	fs := pflag.NewFlagSet("dev", pflag.ContinueOnError)
+	file := fs.StringP("config", "f", defaultConfigFile, "the minimal input file of the dev environment")
+	fs.Usage = func() {
+		fmt.Fprint(os.Stderr, usage)
+		fs.PrintDefaults()
+	}
+
+	if len(args) == 0 {
+		fs.Usage()
+		return errors.New("dev command is not specified")
+	}
+	command := args[0]
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	c, err := LoadConfig(*file)
+	if err != nil {
+		return err
+	}
+
+	switch command {
+	case "gen":
+		return Generate(c)
+	case "up":
+		return Up(c)
+	default:
+		fs.Usage()
+		return fmt.Errorf("unknown dev command %s", command)
+	}
+}
+
+// Up generates the configuration files, starts the dependencies with docker compose and all the services with
+// the all-in-one, and seeds the demo data, it blocks until the all-in-one exits.
+func Up(c *Config) error {
+	if err := Generate(c); err != nil {
+		return err
+	}
+
+	if c.Compose {
+		if err := composeUp(c); err != nil {
+			return err
+		}
+	}
+
+	if !c.Demo.Disabled {
+		go func() {
+			if err := Seed(c); err != nil {
+				fmt.Fprintf(os.Stderr, "seed demo data failed, err: %v\n", err)
+			}
+		}()
+	}
+
+	return app.Run(&options.Option{
+		Sys:       new(cc.SysOption),
+		EtcDir:    c.EtcDir(),
+		DataDir:   c.DataDir(),
+		RedisAddr: c.RedisAddr(),
+	})
+}
+
+// composeUp starts the dependencies with docker compose and waits for them to be ready.
+func composeUp(c *Config) error {
+	cmd := exec.Command("docker", "compose", "-p", composeProject, "-f", filepath.Join(c.WorkDir, composeFile),
+		"up", "-d")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("docker compose up failed, err: %v", err)
+	}
+
+	deadline := time.Now().Add(vaultReadyTimeout)
+	for {
+		resp, err := http.Get(c.Vault.Address + "/v1/sys/health")
+		if err == nil {
+			resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+			err = fmt.Errorf("status code %d", resp.StatusCode)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("vault %s is not ready in %s, err: %v", c.Vault.Address, vaultReadyTimeout, err)
+		}
+		time.Sleep(time.Second)
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package options NOTES
+package options
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/flags"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// Option defines the all-in-one's runtime flag options.
+type Option struct {
+	Sys *cc.SysOption
+	// EtcDir is the directory of the services' configuration files.
+	EtcDir string
+	// DataDir is the directory of the sqlite database file.
+	DataDir string
+	// RedisAddr is the address the embedded redis listens to, it should be the same with the redisCluster
+	// endpoint of all_in_one.yaml.
+	RedisAddr string
+}
+
+// InitOptions init all-in-one's options from command flags.
+func InitOptions() *Option {
+	fs := pflag.CommandLine
+	sysOpt := flags.SysFlags(fs)
+	opt := &Option{Sys: sysOpt}
+
+	fs.StringVar(&opt.EtcDir, "etc-dir", "./etc", "the directory of the services' configuration files")
+	fs.StringVar(&opt.DataDir, "data-dir", "./data", "the directory of the sqlite database file")
+	fs.StringVar(&opt.RedisAddr, "redis-addr", "127.0.0.1:16379", "the address the embedded redis listens to")
+
+	// parses the command-line flags from os.Args[1:]. must be called after all flags are defined
+	// and before flags are accessed by the program.
+	pflag.Parse()
+
+	// check if the command-line flag is show current version info cmd.
+	sysOpt.CheckV()
+
+	return opt
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/api-server/options"
 	"github.com/TencentBlueKing/bk-bscp/cmd/api-server/service"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bknotice"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -65,6 +66,16 @@ func (as *apiServer) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.RepoCredentialSection) {
+			repository.UpdateCredentials(cc.ApiServer().Repo)
+		}
+	})
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.ApiServer().Network.BindIP,
 		strconv.Itoa(int(cc.ApiServer().Network.HttpPort))))
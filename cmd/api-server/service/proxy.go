@@ -24,6 +24,8 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/grpcgw"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -46,6 +48,8 @@ type proxy struct {
 	configExportService *configExport
 	kvService           *kvService
 	varService          *variableService
+	usageService        *usageService
+	timelineService     *timelineService
 	mc                  *metric
 }
 
@@ -100,6 +104,8 @@ func newProxy(dis serviced.Discover) (*proxy, error) {
 
 	kv := newKvService(authorizer, cfgClient)
 	variable := newVariableService(cfgClient)
+	usage := newUsageService(cfgClient)
+	timeline := newTimelineService(cfgClient)
 
 	p := &proxy{
 		cfgSvrMux:           cfgSvrMux,
@@ -113,6 +119,8 @@ func newProxy(dis serviced.Discover) (*proxy, error) {
 		cfgClient:           cfgClient,
 		kvService:           kv,
 		varService:          variable,
+		usageService:        usage,
+		timelineService:     timeline,
 		mc:                  mc,
 	}
 
@@ -196,6 +204,9 @@ func newGrpcDialOption(dis serviced.Discover, tls cc.TLSConfig) ([]grpc.DialOpti
 
 	// add dial load balancer.
 	opts = append(opts, dis.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	opts = append(opts, grpc.WithChainUnaryInterceptor(brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor(), chaos.UnaryClientInterceptor()))
 
 	if !tls.Enable() {
 		// dial without ssl
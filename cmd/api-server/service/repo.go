@@ -24,6 +24,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
@@ -146,6 +147,12 @@ func (s *repoService) DownloadFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 同一个地址按请求头中的签名下载不同的内容, 缓存需要区分该请求头
+	w.Header().Set("Vary", constant.ContentIDHeaderKey)
+	if rest.WriteConditional(w, r, rest.ETag(sign)) {
+		return
+	}
+
 	body, contentLength, err := s.provider.Download(kt, sign)
 	if err != nil {
 		render.Render(w, r, rest.BadRequest(err))
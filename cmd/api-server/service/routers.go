@@ -14,6 +14,7 @@ package service
 
 import (
 	"net/http"
+	"regexp"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -22,8 +23,13 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/internal/rest/view"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/handler"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
 )
 
+// conditionalGetPath matches the release and config item metadata routes which support the ETag conditional
+// requests, the other routes(e.g. exports) may be large or streamed, so they are not buffered.
+var conditionalGetPath = regexp.MustCompile(`^/api/v1/config/biz/\d+/apps/\d+/(releases|config_items)(/|$)`)
+
 // routers return router config handler
 // nolint: funlen
 func (p *proxy) routers() http.Handler {
@@ -31,6 +37,7 @@ func (p *proxy) routers() http.Handler {
 	r.Use(handler.RequestID)
 	r.Use(middleware.RealIP)
 	r.Use(middleware.Logger)
+	r.Use(handler.SlowRequestLogger)
 	r.Use(middleware.Recoverer)
 	r.Use(handler.CORS)
 	r.Use(audit.Audit)
@@ -73,6 +80,8 @@ func (p *proxy) routers() http.Handler {
 		r.Use(p.authorizer.UnifiedAuthentication)
 		r.Use(p.authorizer.BizVerified)
 		r.Use(p.authorizer.AppVerified)
+		// 版本、配置项元数据的 GET 请求支持 ETag 条件请求
+		r.Use(rest.ConditionalGetOn(conditionalGetPath))
 		r.Use(view.Generic(p.authorizer))
 		r.Mount("/", p.cfgSvrMux)
 	})
@@ -213,6 +222,20 @@ func (p *proxy) routers() http.Handler {
 		r.Get("/", p.varService.ExportReleasedAppVariables)
 	})
 
+	// 导出服务的变更时间线，用于对接外部变更管理系统
+	r.Route("/api/v1/config/biz/{biz_id}/apps/{app_id}/timeline/export", func(r chi.Router) {
+		r.Use(p.authorizer.UnifiedAuthentication)
+		r.Use(p.authorizer.BizVerified)
+		r.Use(p.authorizer.AppVerified)
+		r.Get("/", p.timelineService.Export)
+	})
+
+	// 导出业务每月用量，用于成本核算
+	r.Route("/api/v1/config/usages/export", func(r chi.Router) {
+		r.Use(p.authorizer.UnifiedAuthentication)
+		r.Get("/", p.usageService.Export)
+	})
+
 	// 导出模板压缩包
 	r.Route("/api/v1/config/biz/{biz_id}/template_spaces/{template_space_id}/templates/{template_id}/export",
 		func(r chi.Router) {
@@ -0,0 +1,147 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbaudit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/audit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+)
+
+const (
+	// timelinePageSize is the page size to list the timeline events when exporting.
+	timelinePageSize = 500
+	// maxTimelineExport is the max number of timeline events can be exported at once, narrow the time range
+	// to export more.
+	maxTimelineExport = 10000
+)
+
+type timelineService struct {
+	cfgClient pbcs.ConfigClient
+}
+
+func newTimelineService(cfgClient pbcs.ConfigClient) *timelineService {
+	return &timelineService{cfgClient: cfgClient}
+}
+
+// Export exports the app's change timeline as csv or json for external change-management systems, e.g.
+// /api/v1/config/biz/2/apps/3/timeline/export?start_time=2025-01-01 00:00:00&types=publish&format=csv
+func (s *timelineService) Export(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+
+	query := r.URL.Query()
+	req := &pbcs.ListAppTimelineReq{
+		BizId:     kt.BizID,
+		AppId:     kt.AppID,
+		StartTime: query.Get("start_time"),
+		EndTime:   query.Get("end_time"),
+		Types:     query.Get("types"),
+		Limit:     timelinePageSize,
+	}
+	events := make([]*pbaudit.TimelineEvent, 0)
+	for {
+		resp, err := s.cfgClient.ListAppTimeline(kt.RpcCtx(), req)
+		if err != nil {
+			logs.Errorf("list app timeline failed, err: %v, rid: %s", err, kt.Rid)
+			_ = render.Render(w, r, rest.BadRequest(err))
+			return
+		}
+		if resp.Count > maxTimelineExport {
+			_ = render.Render(w, r, rest.BadRequest(fmt.Errorf("too many timeline events %d, max %d, "+
+				"please narrow the time range", resp.Count, maxTimelineExport)))
+			return
+		}
+		events = append(events, resp.Details...)
+		req.Start += timelinePageSize
+		if len(resp.Details) < timelinePageSize || req.Start >= resp.Count {
+			break
+		}
+	}
+
+	var content []byte
+	var err error
+	filename := fmt.Sprintf("bscp_timeline_%d_%d", kt.BizID, kt.AppID)
+	switch query.Get("format") {
+	case constant.CsvFormat, "":
+		content, err = timelineToCSV(events)
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", filename))
+	case constant.JsonFormat:
+		content, err = json.MarshalIndent(events, "", "    ")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", filename))
+	default:
+		_ = render.Render(w, r, rest.BadRequest(errors.New("invalid format")))
+		return
+	}
+	if err != nil {
+		logs.Errorf("export app timeline failed, err: %v, rid: %s", err, kt.Rid)
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	if _, err = w.Write(content); err != nil {
+		logs.Errorf("write app timeline response failed, err: %v, rid: %s", err, kt.Rid)
+	}
+}
+
+// timelineToCSV converts the timeline events to csv with header.
+func timelineToCSV(events []*pbaudit.TimelineEvent) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+	records := [][]string{{"occurred_at", "type", "res_type", "action", "res_id", "res_instance", "actor",
+		"operate_way", "status", "release_id", "rollback_from", "approvers", "approved_by", "approved_at",
+		"change_ticket_id", "memo"}}
+	for _, one := range events {
+		approval := one.Approval
+		if approval == nil {
+			approval = new(pbaudit.TimelineApproval)
+		}
+		records = append(records, []string{
+			one.OccurredAt,
+			one.Type,
+			one.ResType,
+			one.Action,
+			strconv.FormatUint(uint64(one.ResId), 10),
+			one.ResInstance,
+			one.Actor,
+			one.OperateWay,
+			one.Status,
+			strconv.FormatUint(uint64(one.ReleaseId), 10),
+			strconv.FormatUint(uint64(one.RollbackFrom), 10),
+			approval.Approvers,
+			approval.ApprovedBy,
+			approval.ApprovedAt,
+			one.ChangeTicketId,
+			one.Memo,
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
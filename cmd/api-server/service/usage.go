@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbu "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/biz-usage"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+)
+
+type usageService struct {
+	cfgClient pbcs.ConfigClient
+}
+
+func newUsageService(cfgClient pbcs.ConfigClient) *usageService {
+	return &usageService{cfgClient: cfgClient}
+}
+
+// Export exports the monthly usages of the bizs as csv or json, e.g.
+// /api/v1/config/usages/export?biz_ids=1,2&start_month=2025-01&end_month=2025-06&format=csv
+func (s *usageService) Export(w http.ResponseWriter, r *http.Request) {
+	kt := kit.MustGetKit(r.Context())
+
+	query := r.URL.Query()
+	bizIDs, err := parseBizIDs(query.Get("biz_ids"))
+	if err != nil {
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	resp, err := s.cfgClient.ListBizUsages(kt.RpcCtx(), &pbcs.ListBizUsagesReq{
+		BizIds:     bizIDs,
+		StartMonth: query.Get("start_month"),
+		EndMonth:   query.Get("end_month"),
+	})
+	if err != nil {
+		logs.Errorf("list biz usages failed, err: %v, rid: %s", err, kt.Rid)
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	var content []byte
+	filename := fmt.Sprintf("bscp_usage_%s", query.Get("start_month"))
+	switch query.Get("format") {
+	case constant.CsvFormat, "":
+		content, err = usagesToCSV(resp.Details)
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.csv", filename))
+	case constant.JsonFormat:
+		content, err = json.MarshalIndent(resp.Details, "", "    ")
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", filename))
+	default:
+		_ = render.Render(w, r, rest.BadRequest(errors.New("invalid format")))
+		return
+	}
+	if err != nil {
+		logs.Errorf("export biz usages failed, err: %v, rid: %s", err, kt.Rid)
+		_ = render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	if _, err = w.Write(content); err != nil {
+		logs.Errorf("write biz usages response failed, err: %v, rid: %s", err, kt.Rid)
+	}
+}
+
+// usagesToCSV converts the usages to csv with header.
+func usagesToCSV(usages []*pbbu.BizUsage) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+	records := [][]string{{"biz_id", "month", "storage_bytes", "egress_bytes", "api_calls", "watch_hours"}}
+	for _, one := range usages {
+		records = append(records, []string{
+			strconv.FormatUint(uint64(one.BizId), 10),
+			one.Month,
+			strconv.FormatUint(one.StorageBytes, 10),
+			strconv.FormatUint(one.EgressBytes, 10),
+			strconv.FormatUint(one.ApiCalls, 10),
+			strconv.FormatFloat(one.WatchHours, 'f', 2, 64),
+		})
+	}
+	if err := writer.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parseBizIDs parses the comma separated biz ids.
+func parseBizIDs(value string) ([]uint32, error) {
+	ids := make([]uint32, 0)
+	for _, one := range strings.Split(value, ",") {
+		one = strings.TrimSpace(one)
+		if one == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(one, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid biz id %s", one)
+		}
+		ids = append(ids, uint32(id))
+	}
+
+	return ids, nil
+}
@@ -88,6 +88,11 @@ func (as *authService) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.AuthServer().Network.BindIP,
 		strconv.Itoa(int(cc.AuthServer().Network.RpcPort))))
@@ -142,6 +147,7 @@ func (as *authService) listenAndServe() error {
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			brpc.RequestIDUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package auth
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// authorizeByAppRoles grant the app actions denied by iam with the user's app role bindings.
+func (a *Auth) authorizeByAppRoles(kt *kit.Kit, user string, decisions []*meta.Decision) error {
+	if user == "" {
+		return nil
+	}
+
+	// biz id -> app ids of the denied app actions
+	denied := make(map[uint32][]uint32)
+	for _, d := range decisions {
+		if d.Authorized || d.Resource.Basic.Type != meta.App || d.Resource.ResourceID == 0 {
+			continue
+		}
+		denied[d.Resource.BizID] = append(denied[d.Resource.BizID], d.Resource.ResourceID)
+	}
+
+	for bizID, appIDs := range denied {
+		resp, err := a.ds.ListUserAppRoles(kt.RpcCtx(), &pbds.ListUserAppRolesReq{
+			BizId:  bizID,
+			User:   user,
+			AppIds: appIDs,
+		})
+		if err != nil {
+			logs.Errorf("list user %s app roles of biz %d failed, err: %v, rid: %s", user, bizID, err, kt.Rid)
+			return err
+		}
+
+		roles := make(map[uint32]table.AppRole, len(resp.Details))
+		for _, one := range resp.Details {
+			roles[one.Attachment.AppId] = table.AppRole(one.Spec.Role)
+		}
+
+		for _, d := range decisions {
+			if d.Authorized || d.Resource.Basic.Type != meta.App || d.Resource.BizID != bizID {
+				continue
+			}
+			if role, ok := roles[d.Resource.ResourceID]; ok && role.Allowed(string(d.Resource.Basic.Action)) {
+				d.Authorized = true
+			}
+		}
+	}
+
+	return nil
+}
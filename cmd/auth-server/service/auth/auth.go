@@ -25,6 +25,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/options"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/client"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/sdk/auth"
@@ -94,6 +95,16 @@ func (a *Auth) AuthorizeBatch(ctx context.Context, req *pbas.AuthorizeBatchReq)
 		return nil, err
 	}
 
+	// service account is authorized with its own scopes
+	if bizID, name, ok := table.ParseServiceAccountUser(req.User.GetUserName()); ok {
+		decisions, err := a.authorizeServiceAccount(kt, bizID, name, pbas.ResourceAttributes(req.Resources))
+		if err != nil {
+			return nil, err
+		}
+		resp.Decisions = pbas.PbDecisions(decisions)
+		return resp, nil
+	}
+
 	// if auth is disabled, returns authorized for all request resources
 	// if a.disableAuth {
 	// 	resp.Decisions = make([]*pbas.Decision, len(req.Resources))
@@ -139,6 +150,11 @@ func (a *Auth) AuthorizeBatch(ctx context.Context, req *pbas.AuthorizeBatchReq)
 		index++
 	}
 
+	// the app actions denied by iam can be granted by the app role bindings
+	if err := a.authorizeByAppRoles(kt, req.User.GetUserName(), decisions); err != nil {
+		return nil, err
+	}
+
 	resp.Decisions = pbas.PbDecisions(decisions)
 	return resp, nil
 }
@@ -425,5 +441,10 @@ func (a *Auth) getInstIDNameMap(kt *kit.Kit, resTypeIDsMap map[client.TypeID][]s
 
 // GrantResourceCreatorAction grant resource creator action.
 func (a *Auth) GrantResourceCreatorAction(ctx context.Context, opts *client.GrantResourceCreatorActionOption) error {
+	// service account is not an iam user, which is authorized with its own scopes
+	if _, _, ok := table.ParseServiceAccountUser(opts.Creator); ok {
+		return nil
+	}
+
 	return a.auth.GrantResourceCreatorAction(ctx, opts)
 }
@@ -97,7 +97,7 @@ func genAppIAMResource(a *meta.ResourceAttribute) (*bkiam.Request, error) {
 	switch a.Basic.Action {
 	case meta.Create:
 		iamReq.Action = bkiam.NewAction(string(sys.AppCreate))
-	case meta.View:
+	case meta.View, meta.ViewSecret:
 		iamReq.Action = bkiam.NewAction(string(sys.AppView))
 	case meta.Update:
 		iamReq.Action = bkiam.NewAction(string(sys.AppEdit))
@@ -105,7 +105,7 @@ func genAppIAMResource(a *meta.ResourceAttribute) (*bkiam.Request, error) {
 		iamReq.Action = bkiam.NewAction(string(sys.ReleasePublish))
 	case meta.GenerateRelease:
 		iamReq.Action = bkiam.NewAction(string(sys.ReleaseGenerate))
-	case meta.Delete:
+	case meta.Delete, meta.ManageRole:
 		iamReq.Action = bkiam.NewAction(string(sys.AppDelete))
 	default:
 		return nil, fmt.Errorf("unsupported bscp action: %s", a.Basic.Action)
@@ -190,13 +190,13 @@ func genAppIAMApplication(a *meta.ResourceAttribute) (bkiam.ApplicationAction, e
 			},
 		}
 		return action, nil
-	case meta.View:
+	case meta.View, meta.ViewSecret:
 		action.ID = string(sys.AppView)
 		resourceNodes = append(resourceNodes, resourceNodeBiz, resourceNodeApp)
 	case meta.Update:
 		action.ID = string(sys.AppEdit)
 		resourceNodes = append(resourceNodes, resourceNodeBiz, resourceNodeApp)
-	case meta.Delete:
+	case meta.Delete, meta.ManageRole:
 		action.ID = string(sys.AppDelete)
 		resourceNodes = append(resourceNodes, resourceNodeBiz, resourceNodeApp)
 	case meta.Publish:
@@ -290,11 +290,11 @@ func genAppResource(a *meta.ResourceAttribute) (client.ActionID, []client.Resour
 	case meta.Update:
 		// update app is related to bscp application resource
 		return sys.AppEdit, []client.Resource{appRes}, nil
-	case meta.Delete:
-		// delete app is related to bscp application resource
+	case meta.Delete, meta.ManageRole:
+		// delete app and manage app roles are related to bscp application resource
 		return sys.AppDelete, []client.Resource{appRes}, nil
-	case meta.View:
-		// view app is related to bscp application resource
+	case meta.View, meta.ViewSecret:
+		// view app and its secret values are related to bscp application resource
 		return sys.AppView, []client.Resource{appRes}, nil
 	case meta.GenerateRelease:
 		// generate release is related to bscp application resource
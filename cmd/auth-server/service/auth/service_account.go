@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/sys"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
+	pbsa "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/service-account"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// VerifyServiceAccount verify the api key of the service account.
+func (a *Auth) VerifyServiceAccount(ctx context.Context, req *pbas.VerifyServiceAccountReq) (
+	*pbas.VerifyServiceAccountResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.ApiKey == "" {
+		return nil, errf.New(errf.Unauthenticated, "service account api key is empty")
+	}
+
+	sa, err := a.ds.GetServiceAccountByKey(kt.RpcCtx(), &pbds.GetServiceAccountByKeyReq{ApiKey: req.ApiKey})
+	if err != nil {
+		logs.Errorf("verify service account failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbas.VerifyServiceAccountResp{BizId: sa.Attachment.BizId, Name: sa.Spec.Name}, nil
+}
+
+// authorizeServiceAccount authorize the resources with the service account's scopes instead of iam,
+// service account can only access the resources of its own biz.
+func (a *Auth) authorizeServiceAccount(kt *kit.Kit, bizID uint32, name string,
+	resources []*meta.ResourceAttribute) ([]*meta.Decision, error) {
+
+	sa, err := a.ds.GetServiceAccountByName(kt.RpcCtx(), &pbds.GetServiceAccountByNameReq{BizId: bizID, Name: name})
+	if err != nil {
+		logs.Errorf("get service account %s of biz %d failed, err: %v, rid: %s", name, bizID, err, kt.Rid)
+		return nil, err
+	}
+
+	if !sa.Spec.Enabled {
+		return nil, errf.Errorf(errf.Unauthenticated, "service account %s is disabled", name)
+	}
+	if sa.Spec.ExpiredAt != "" {
+		expiredAt, err := time.Parse(time.RFC3339, sa.Spec.ExpiredAt)
+		if err == nil && time.Now().After(expiredAt) {
+			return nil, errf.Errorf(errf.Unauthenticated, "service account %s is expired", name)
+		}
+	}
+
+	scopes := pbsa.ServiceAccountScopes(sa.Spec.Scopes)
+	decisions := make([]*meta.Decision, len(resources))
+	for index, resource := range resources {
+		authorized, err := serviceAccountAllowed(bizID, scopes, resource)
+		if err != nil {
+			logs.Errorf("authorize service account %s failed, err: %v, rid: %s", name, err, kt.Rid)
+			return nil, err
+		}
+
+		decisions[index] = &meta.Decision{Resource: resource, Authorized: authorized}
+	}
+
+	return decisions, nil
+}
+
+// serviceAccountAllowed check whether the resource is granted to the service account.
+func serviceAccountAllowed(bizID uint32, scopes table.ServiceAccountScopes, res *meta.ResourceAttribute) (
+	bool, error) {
+	if res.Basic.Action == meta.SkipAction {
+		return true, nil
+	}
+
+	if res.BizID != bizID {
+		return false, nil
+	}
+
+	action, _, err := AdaptAuthOptions(res)
+	if err != nil {
+		return false, err
+	}
+	if action == sys.Skip {
+		return true, nil
+	}
+
+	switch res.Basic.Type {
+	case meta.Biz:
+		return res.Basic.Action == meta.FindBusinessResource, nil
+	case meta.App:
+		switch res.Basic.Action {
+		case meta.Find:
+			return true, nil
+		case meta.Create:
+			return scopes.Allowed(0, string(meta.Create)), nil
+		default:
+			return scopes.Allowed(res.ResourceID, string(res.Basic.Action)), nil
+		}
+	default:
+		// 服务账号不能管理密钥、查看审计等
+		return false, nil
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/ldap"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/oidc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
+)
+
+// Login 独立部署登入, LDAP 使用账号密码, OIDC 使用授权码, 成功后签发会话 token
+func (s *Service) Login(ctx context.Context, req *pbas.LoginReq) (*pbas.LoginResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	conf := cc.AuthServer().LoginAuth
+
+	var (
+		username string
+		err      error
+	)
+
+	switch conf.Provider {
+	case cc.LDAPLoginProvider:
+		err = ldap.Authenticate(ctx, &conf.LDAP, req.Username, req.Password)
+		if errors.Is(err, ldap.ErrInvalidCredentials) {
+			return nil, status.Error(codes.Unauthenticated, "invalid username or password")
+		}
+		username = req.Username
+	case cc.OIDCLoginProvider:
+		if req.Code == "" {
+			return nil, status.Error(codes.InvalidArgument, "oidc code is required")
+		}
+		username, err = oidc.UsernameFromCode(ctx, &conf.OIDC, req.Code)
+	default:
+		return nil, status.Errorf(codes.FailedPrecondition, "login provider %s does not support direct login",
+			conf.Provider)
+	}
+
+	if err != nil {
+		logs.Errorf("%s login failed, user: %s, err: %v, rid: %s", conf.Provider, req.Username, err, kt.Rid)
+		return nil, status.Error(codes.Unauthenticated, "login failed")
+	}
+
+	// 服务账号的用户名只能通过 API 密钥使用
+	if _, _, ok := table.ParseServiceAccountUser(username); ok {
+		return nil, status.Errorf(codes.PermissionDenied, "user %s is reserved for service account", username)
+	}
+
+	token, expiredAt, err := bkpaas.IssueSessionToken(conf.Session.Secret, username,
+		time.Duration(conf.Session.TTLHours)*time.Hour)
+	if err != nil {
+		logs.Errorf("issue session token failed, user: %s, err: %v, rid: %s", username, err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbas.LoginResp{Username: username, Token: token, ExpiredAt: expiredAt.Unix()}, nil
+}
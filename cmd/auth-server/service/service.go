@@ -38,9 +38,12 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/auth-server/service/initial"
 	confsvc "github.com/TencentBlueKing/bk-bscp/cmd/config-server/service"
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/oidc"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/apigw"
 	iamauth "github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/internal/rest/view/webannotation"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
 	esbcli "github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
@@ -164,6 +167,9 @@ func newClientSet(sd serviced.Discover, tls cc.TLSConfig, iamSettings cc.IAM, di
 
 	// add dial load balancer.
 	opts = append(opts, sd.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	opts = append(opts, grpc.WithChainUnaryInterceptor(brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor(), chaos.UnaryClientInterceptor()))
 
 	if !tls.Enable() {
 		// dial without ssl
@@ -306,6 +312,11 @@ func (s *Service) GetAuthConf(_ context.Context,
 			},
 		},
 	}
+
+	if conf := cc.AuthServer().LoginAuth; conf.Provider == cc.OIDCLoginProvider {
+		resp.LoginAuth.OidcAuthUrl = oidc.AuthCodeURL(&conf.OIDC)
+	}
+
 	return resp, nil
 }
 
@@ -330,6 +341,13 @@ func (s *Service) GrantResourceCreatorAction(ctx context.Context, req *pbas.
 
 }
 
+// VerifyServiceAccount verify the api key of the service account.
+func (s *Service) VerifyServiceAccount(ctx context.Context, req *pbas.VerifyServiceAccountReq) (
+	*pbas.VerifyServiceAccountResp, error) {
+
+	return s.auth.VerifyServiceAccount(ctx, req)
+}
+
 // CheckPermission grpc check permission
 func (s *Service) CheckPermission(ctx context.Context, req *pbas.CheckPermissionReq) (
 	*pbas.CheckPermissionResp, error) {
@@ -442,7 +460,8 @@ func (s *Service) GetUserInfo(ctx context.Context, req *pbas.UserCredentialReq)
 		err      error
 	)
 
-	if cc.AuthServer().LoginAuth.UseESB && cc.AuthServer().LoginAuth.Provider != bkpaas.BKLoginProvider {
+	// ESB 只用于蓝鲸 PaaS 登入, 独立部署的会话由本服务签发
+	if conf.UseESB && (conf.Provider == "" || conf.Provider == bkpaas.BKPaaSProvider) {
 		username, err = s.client.Esb.BKLogin().IsLogin(ctx, token)
 	} else {
 		username, err = authLoginClient.GetUserInfoByToken(ctx, host, req.GetUid(), token)
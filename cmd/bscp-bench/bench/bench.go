@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package bench simulates a large number of sidecars which perform handshake, watch, heartbeat and pull against
+// the feed server, and reports the latency percentiles of the operations for capacity planning.
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+)
+
+// progressInterval is the interval to print the progress of the load test.
+const progressInterval = 10 * time.Second
+
+// Bench is the load test of the feed server.
+type Bench struct {
+	cfg *Config
+	rec *recorder
+	pub *publisher
+
+	// started is the number of the started sidecars.
+	started atomic.Int64
+	// watching is the number of the sidecars which are watching.
+	watching atomic.Int64
+	// disconnects is the number of the broken watch streams.
+	disconnects atomic.Int64
+}
+
+// Run runs the load test, and writes the progress and report to w.
+func Run(ctx context.Context, cfg *Config, w io.Writer) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	conns := make([]*grpc.ClientConn, 0, cfg.Conns)
+	defer func() {
+		for _, conn := range conns {
+			_ = conn.Close()
+		}
+	}()
+	for i := 0; i < cfg.Conns; i++ {
+		conn, err := grpc.Dial(cfg.FeedAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("dial feed server failed, err: %v", err)
+		}
+		conns = append(conns, conn)
+	}
+
+	var dsConn *grpc.ClientConn
+	if cfg.PublishInterval > 0 {
+		var err error
+		dsConn, err = grpc.Dial(cfg.DataAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return fmt.Errorf("dial data service failed, err: %v", err)
+		}
+		defer dsConn.Close()
+	}
+
+	rec := newRecorder()
+	b := &Bench{cfg: cfg, rec: rec, pub: newPublisher(cfg, rec, dsConn)}
+	return b.run(ctx, conns, w)
+}
+
+func (b *Bench) run(ctx context.Context, conns []*grpc.ClientConn, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	fmt.Fprintf(w, "start %d sidecars in %s, then run for %s\n", b.cfg.Clients, b.cfg.RampUp, b.cfg.Duration)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		b.startSidecars(ctx, conns, &wg)
+	}()
+
+	pubErr := make(chan error, 1)
+	go func() {
+		// publish after all the sidecars are started, so that the delivery latency is not affected by ramp up.
+		if !sleep(ctx, b.cfg.RampUp) {
+			pubErr <- nil
+			return
+		}
+		pubErr <- b.pub.run(ctx)
+	}()
+
+	timer := time.NewTimer(b.cfg.RampUp + b.cfg.Duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+
+	var err error
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			break loop
+		case <-timer.C:
+			break loop
+		case err = <-pubErr:
+			if err != nil {
+				break loop
+			}
+		case <-ticker.C:
+			fmt.Fprintf(w, "[%s] started: %d, watching: %d, watch disconnects: %d\n",
+				time.Since(start).Round(time.Second), b.started.Load(), b.watching.Load(), b.disconnects.Load())
+		}
+	}
+
+	elapsed := time.Since(start)
+	cancel()
+	wg.Wait()
+
+	fmt.Fprintf(w, "\nfinished in %s, sidecars: %d, watch disconnects: %d\n\n", elapsed.Round(time.Second),
+		b.started.Load(), b.disconnects.Load())
+	b.rec.report(w, elapsed)
+
+	return err
+}
+
+// startSidecars starts the sidecars evenly in the ramp up duration.
+func (b *Bench) startSidecars(ctx context.Context, conns []*grpc.ClientConn, wg *sync.WaitGroup) {
+	interval := b.cfg.RampUp / time.Duration(b.cfg.Clients)
+	r := rand.New(rand.NewSource(time.Now().UnixNano())) //nolint:gosec
+
+	for i := 0; i < b.cfg.Clients; i++ {
+		if i > 0 && interval > 0 && !sleep(ctx, interval) {
+			return
+		}
+
+		s := newSidecar(b, pbfs.NewUpstreamClient(conns[i%len(conns)]), i, r)
+		wg.Add(1)
+		b.started.Add(1)
+		go func() {
+			defer wg.Done()
+			s.run(ctx)
+		}()
+	}
+}
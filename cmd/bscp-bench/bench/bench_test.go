@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package bench
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestParseLabelDistribution(t *testing.T) {
+	dist, err := ParseLabelDistribution("env=prod:3,test:1;region=sh")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := rand.New(rand.NewSource(1)) //nolint:gosec
+	prod := 0
+	for i := 0; i < 4000; i++ {
+		labels := dist.Sample(r)
+		if labels["region"] != "sh" {
+			t.Fatalf("unexpected labels: %v", labels)
+		}
+		if labels["env"] == "prod" {
+			prod++
+		}
+	}
+	if prod < 2800 || prod > 3200 {
+		t.Errorf("the sampled labels don't match the weights, prod: %d", prod)
+	}
+
+	for _, invalid := range []string{"env", "env=prod:x", "env=prod:0"} {
+		if _, err := ParseLabelDistribution(invalid); err == nil {
+			t.Errorf("parse %s should be failed", invalid)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	samples := make([]time.Duration, 0, 100)
+	for i := 1; i <= 100; i++ {
+		samples = append(samples, time.Duration(i)*time.Millisecond)
+	}
+
+	if p := percentile(samples, 0.5); p != 50*time.Millisecond {
+		t.Errorf("unexpected p50: %s", p)
+	}
+	if p := percentile(samples, 0.99); p != 99*time.Millisecond {
+		t.Errorf("unexpected p99: %s", p)
+	}
+	if p := percentile(nil, 0.99); p != 0 {
+		t.Errorf("unexpected p99 of empty samples: %s", p)
+	}
+}
@@ -0,0 +1,292 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package bench
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+const (
+	// clientVersion is the version reported by the simulated sidecars.
+	clientVersion = "bscp-bench"
+	// retryInterval is the interval to retry the failed handshake and watch.
+	retryInterval = 3 * time.Second
+	// rpcTimeout is the timeout of the unary rpc calls.
+	rpcTimeout = 10 * time.Second
+)
+
+// sidecarVersion is the sidecar version used in handshake.
+var sidecarVersion = &pbbase.Versioning{Major: 1, Minor: 0, Patch: 0}
+
+// sidecar simulates a sidecar which watches and pulls the kv apps.
+type sidecar struct {
+	b           *Bench
+	client      pbfs.UpstreamClient
+	uid         string
+	fingerprint string
+	labels      map[string]string
+
+	lock     sync.Mutex
+	releases map[string]uint32
+}
+
+func newSidecar(b *Bench, client pbfs.UpstreamClient, idx int, r *rand.Rand) *sidecar {
+	uid := fmt.Sprintf("bscp-bench-%d", idx)
+	return &sidecar{
+		b:           b,
+		client:      client,
+		uid:         uid,
+		fingerprint: uid,
+		labels:      b.cfg.Labels.Sample(r),
+		releases:    make(map[string]uint32),
+	}
+}
+
+// outgoing returns the context with the sidecar's metadata and credential.
+func (s *sidecar) outgoing(ctx context.Context) context.Context {
+	meta, _ := jsoni.Marshal(&sfs.SidecarMetaHeader{BizID: s.b.cfg.BizID, Fingerprint: s.fingerprint,
+		Version: sidecarVersion.Format()})
+	return metadata.AppendToOutgoingContext(ctx, constant.SideRidKey, uuid.UUID(), constant.SidecarMetaKey,
+		string(meta), "authorization", "Bearer "+s.b.cfg.Token)
+}
+
+// run runs the sidecar until the context is done.
+func (s *sidecar) run(ctx context.Context) {
+	for !s.handshake(ctx) {
+		if !sleep(ctx, retryInterval) {
+			return
+		}
+	}
+
+	for _, app := range s.b.cfg.Apps {
+		s.pull(ctx, app)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		s.loopWatch(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		s.loopHeartbeat(ctx)
+	}()
+
+	if s.b.cfg.PullInterval > 0 {
+		ticker := time.NewTicker(s.b.cfg.PullInterval)
+		defer ticker.Stop()
+		for sleepTicker(ctx, ticker) {
+			for _, app := range s.b.cfg.Apps {
+				s.pull(ctx, app)
+			}
+		}
+	}
+
+	wg.Wait()
+}
+
+func (s *sidecar) handshake(ctx context.Context) bool {
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	start := time.Now()
+	_, err := s.client.Handshake(s.outgoing(ctx), &pbfs.HandshakeMessage{
+		ApiVersion: sfs.CurrentAPIVersion,
+		Spec:       &pbfs.SidecarSpec{BizId: s.b.cfg.BizID, Version: sidecarVersion},
+	})
+	s.b.rec.observe(opHandshake, time.Since(start), ignoreCanceled(ctx, err))
+	return err == nil
+}
+
+func (s *sidecar) pull(ctx context.Context, app string) {
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	start := time.Now()
+	resp, err := s.client.PullKvMeta(s.outgoing(ctx), &pbfs.PullKvMetaReq{
+		BizId:   s.b.cfg.BizID,
+		AppMeta: &pbfs.AppMeta{App: app, Uid: s.uid, Labels: s.labels},
+	})
+	s.b.rec.observe(opPull, time.Since(start), ignoreCanceled(ctx, err))
+	if err != nil {
+		return
+	}
+
+	s.lock.Lock()
+	s.releases[app] = resp.ReleaseId
+	s.lock.Unlock()
+}
+
+// apps returns the app metas with the current release of the sidecar.
+func (s *sidecar) apps() []sfs.SideAppMeta {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	apps := make([]sfs.SideAppMeta, 0, len(s.b.cfg.Apps))
+	for _, app := range s.b.cfg.Apps {
+		apps = append(apps, sfs.SideAppMeta{
+			App:              app,
+			Uid:              s.uid,
+			Labels:           s.labels,
+			CurrentReleaseID: s.releases[app],
+		})
+	}
+	return apps
+}
+
+func (s *sidecar) loopWatch(ctx context.Context) {
+	for ctx.Err() == nil {
+		if err := s.watch(ctx); err != nil {
+			s.b.disconnects.Add(1)
+			if !sleep(ctx, retryInterval) {
+				return
+			}
+		}
+	}
+}
+
+// watch watches the release change events until the stream is broken.
+func (s *sidecar) watch(ctx context.Context) error {
+	payload, err := jsoni.Marshal(&sfs.SideWatchPayload{BizID: s.b.cfg.BizID, Applications: s.apps()})
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	stream, err := s.client.Watch(s.outgoing(ctx), &pbfs.SideWatchMeta{
+		ApiVersion: sfs.CurrentAPIVersion,
+		Payload:    payload,
+	})
+	s.b.rec.observe(opWatch, time.Since(start), ignoreCanceled(ctx, err))
+	if err != nil {
+		return err
+	}
+
+	s.b.watching.Add(1)
+	defer s.b.watching.Add(-1)
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return ignoreCanceled(ctx, err)
+		}
+
+		switch sfs.FeedMessageType(msg.Type) {
+		case sfs.Bounce:
+			// reconnect like the real sidecar.
+			return nil
+		case sfs.PublishRelease:
+			received := time.Now()
+			change := new(sfs.ReleaseChangePayload)
+			if err := jsoni.Unmarshal(msg.Payload, change); err != nil || change.ReleaseMeta == nil {
+				s.b.rec.observe(opDelivery, 0, fmt.Errorf("decode release change payload failed, err: %v", err))
+				continue
+			}
+
+			if published, ok := s.b.pub.publishedAt(change.ReleaseMeta.ReleaseID); ok {
+				s.b.rec.observe(opDelivery, received.Sub(published), nil)
+			}
+			s.pull(ctx, change.ReleaseMeta.App)
+		}
+	}
+}
+
+func (s *sidecar) loopHeartbeat(ctx context.Context) {
+	ticker := time.NewTicker(s.b.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for sleepTicker(ctx, ticker) {
+		s.heartbeat(ctx)
+	}
+}
+
+func (s *sidecar) heartbeat(ctx context.Context) {
+	hb := &sfs.HeartbeatPayload{
+		BasicData: sfs.BasicData{
+			BizID:         s.b.cfg.BizID,
+			ClientMode:    sfs.Watch,
+			HeartbeatTime: time.Now(),
+			OnlineStatus:  sfs.Online,
+			ClientVersion: clientVersion,
+		},
+		Applications: s.apps(),
+	}
+	payload, err := hb.Encode()
+	if err != nil {
+		s.b.rec.observe(opHeartbeat, 0, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+	defer cancel()
+
+	start := time.Now()
+	_, err = s.client.Messaging(s.outgoing(ctx), &pbfs.MessagingMeta{
+		ApiVersion: sfs.CurrentAPIVersion,
+		Rid:        uuid.UUID(),
+		Type:       uint32(sfs.Heartbeat),
+		Payload:    payload,
+	})
+	s.b.rec.observe(opHeartbeat, time.Since(start), ignoreCanceled(ctx, err))
+}
+
+// ignoreCanceled returns nil if the error is caused by the load test is stopped.
+func ignoreCanceled(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(ctx.Err(), context.Canceled) || status.Code(err) == codes.Canceled {
+		return nil
+	}
+	return err
+}
+
+// sleep sleeps for the duration, returns false if the context is done.
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// sleepTicker waits for the next tick, returns false if the context is done.
+func sleepTicker(ctx context.Context, ticker *time.Ticker) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-ticker.C:
+		return true
+	}
+}
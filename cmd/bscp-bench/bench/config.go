@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package bench
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config defines the options of the load test.
+type Config struct {
+	// FeedAddr is the grpc address of the feed server.
+	FeedAddr string
+	// Token is the client credential token.
+	Token string
+	// BizID is the biz of the apps.
+	BizID uint32
+	// Apps is the kv apps which the simulated sidecars watch and pull.
+	Apps []string
+	// Clients is the number of the simulated sidecars.
+	Clients int
+	// Conns is the number of the grpc connections shared by the sidecars.
+	Conns int
+	// RampUp is the duration to start all the sidecars.
+	RampUp time.Duration
+	// Duration is the duration of the load test after all the sidecars are started.
+	Duration time.Duration
+	// HeartbeatInterval is the interval of the sidecar's heartbeat.
+	HeartbeatInterval time.Duration
+	// PullInterval is the interval of the sidecar's pull, 0 means only pull after the release is changed.
+	PullInterval time.Duration
+	// Labels is the label distribution of the sidecars.
+	Labels LabelDistribution
+
+	// DataAddr is the grpc address of the data service, which is used to publish releases.
+	DataAddr string
+	// PublishInterval is the interval to publish a new release for each app, 0 means not publish.
+	PublishInterval time.Duration
+}
+
+// Validate the config.
+func (c *Config) Validate() error {
+	if c.FeedAddr == "" {
+		return errors.New("feed server address is required")
+	}
+	if c.Token == "" {
+		return errors.New("client token is required")
+	}
+	if c.BizID == 0 {
+		return errors.New("biz id is required")
+	}
+	if len(c.Apps) == 0 {
+		return errors.New("at least one app is required")
+	}
+	if c.Clients <= 0 || c.Conns <= 0 {
+		return errors.New("clients and conns should > 0")
+	}
+	if c.Duration <= 0 || c.HeartbeatInterval <= 0 {
+		return errors.New("duration and heartbeat interval should > 0")
+	}
+	if c.PublishInterval > 0 && c.DataAddr == "" {
+		return errors.New("data service address is required to publish releases")
+	}
+	return nil
+}
+
+// LabelDistribution defines the weighted values of each label key, the labels of a sidecar are sampled from it.
+type LabelDistribution map[string][]weightedValue
+
+type weightedValue struct {
+	value  string
+	weight int
+}
+
+// ParseLabelDistribution parses the label distribution from the format
+// "key1=v1:weight,v2:weight;key2=v3:weight", weight is 1 if not set, e.g: "env=prod:7,test:3;region=sh,gz".
+func ParseLabelDistribution(s string) (LabelDistribution, error) {
+	dist := make(LabelDistribution)
+	for _, one := range strings.Split(s, ";") {
+		one = strings.TrimSpace(one)
+		if one == "" {
+			continue
+		}
+
+		key, values, found := strings.Cut(one, "=")
+		if !found || key == "" || values == "" {
+			return nil, fmt.Errorf("invalid label distribution %s, should be key=value:weight,...", one)
+		}
+
+		for _, v := range strings.Split(values, ",") {
+			value, weight, hasWeight := strings.Cut(v, ":")
+			wv := weightedValue{value: value, weight: 1}
+			if hasWeight {
+				w, err := strconv.Atoi(weight)
+				if err != nil || w <= 0 {
+					return nil, fmt.Errorf("invalid label weight %s of %s", weight, key)
+				}
+				wv.weight = w
+			}
+			dist[key] = append(dist[key], wv)
+		}
+	}
+
+	return dist, nil
+}
+
+// Sample samples the labels of a sidecar.
+func (d LabelDistribution) Sample(r *rand.Rand) map[string]string {
+	labels := make(map[string]string, len(d))
+	for key, values := range d {
+		total := 0
+		for _, v := range values {
+			total += v.weight
+		}
+
+		n := r.Intn(total)
+		for _, v := range values {
+			if n < v.weight {
+				labels[key] = v.value
+				break
+			}
+			n -= v.weight
+		}
+	}
+
+	return labels
+}
@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package bench
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// benchUser is the operator of the published releases.
+	benchUser = "bscp-bench"
+	// benchKey is the kv which is changed in each published release.
+	benchKey = "bscp_bench_ts"
+)
+
+// publisher publishes the releases of the apps periodically, and records the publish time of the releases
+// to calculate the event delivery latency.
+type publisher struct {
+	cfg *Config
+	rec *recorder
+	ds  pbds.DataClient
+
+	lock sync.RWMutex
+	// published is the publish start time of the releases.
+	published map[uint32]time.Time
+	// lastStart is the start time of the latest publish, it is used when the event is received before the
+	// release id is returned.
+	lastStart time.Time
+}
+
+func newPublisher(cfg *Config, rec *recorder, conn *grpc.ClientConn) *publisher {
+	p := &publisher{cfg: cfg, rec: rec, published: make(map[uint32]time.Time)}
+	if conn != nil {
+		p.ds = pbds.NewDataClient(conn)
+	}
+	return p
+}
+
+// publishedAt returns the publish time of the release.
+func (p *publisher) publishedAt(releaseID uint32) (time.Time, bool) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if t, exists := p.published[releaseID]; exists {
+		return t, true
+	}
+	return p.lastStart, !p.lastStart.IsZero()
+}
+
+func (p *publisher) ctx(ctx context.Context) context.Context {
+	kt := kit.New()
+	kt.Ctx = ctx
+	kt.User = benchUser
+	kt.BizID = p.cfg.BizID
+	return kt.RpcCtx()
+}
+
+// run publishes the releases of all the apps every publish interval until the context is done.
+func (p *publisher) run(ctx context.Context) error {
+	if p.ds == nil || p.cfg.PublishInterval <= 0 {
+		return nil
+	}
+
+	appIDs := make(map[string]uint32, len(p.cfg.Apps))
+	for _, app := range p.cfg.Apps {
+		id, err := p.appID(ctx, app)
+		if err != nil {
+			return err
+		}
+		appIDs[app] = id
+	}
+
+	ticker := time.NewTicker(p.cfg.PublishInterval)
+	defer ticker.Stop()
+	for sleepTicker(ctx, ticker) {
+		for _, app := range p.cfg.Apps {
+			p.publish(ctx, appIDs[app])
+		}
+	}
+
+	return nil
+}
+
+func (p *publisher) appID(ctx context.Context, app string) (uint32, error) {
+	resp, err := p.ds.ListAppsRest(p.ctx(ctx), &pbds.ListAppsRestReq{
+		BizId:  strconv.Itoa(int(p.cfg.BizID)),
+		Search: app,
+		All:    true,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("list apps failed, err: %v", err)
+	}
+
+	for _, one := range resp.Details {
+		if one.Spec.Name == app {
+			if one.Spec.ConfigType != string(table.KV) {
+				return 0, fmt.Errorf("app %s is not a kv app", app)
+			}
+			return one.Id, nil
+		}
+	}
+	return 0, fmt.Errorf("app %s is not found", app)
+}
+
+// publish publishes a new release of the app which changes the bench kv, and publishes it to all the clients.
+func (p *publisher) publish(ctx context.Context, appID uint32) {
+	start := time.Now()
+	p.lock.Lock()
+	p.lastStart = start
+	p.lock.Unlock()
+
+	resp, err := p.ds.BulkRelease(p.ctx(ctx), &pbds.BulkReleaseReq{
+		BizId: p.cfg.BizID,
+		AppId: appID,
+		Kvs: []*pbds.BatchUpsertKvsReq_Kv{{
+			KvAttachment: &pbkv.KvAttachment{BizId: p.cfg.BizID, AppId: appID},
+			KvSpec: &pbkv.KvSpec{Key: benchKey, KvType: string(table.KvStr),
+				Value: strconv.FormatInt(start.UnixNano(), 10), Memo: "changed by bscp-bench"},
+		}},
+		ReleaseName: "bench-" + start.Format("20060102150405.000"),
+		ReleaseMemo: "published by bscp-bench",
+		Publish:     true,
+		All:         true,
+	})
+	p.rec.observe(opPublish, time.Since(start), ignoreCanceled(ctx, err))
+	if err != nil {
+		return
+	}
+
+	p.lock.Lock()
+	p.published[resp.ReleaseId] = start
+	p.lock.Unlock()
+}
@@ -0,0 +1,128 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package bench
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	opHandshake = "handshake"
+	opWatch     = "watch"
+	opHeartbeat = "heartbeat"
+	opPull      = "pull"
+	opDelivery  = "event_delivery"
+	opPublish   = "publish"
+
+	// maxSamples is the max latency samples of each operation, reservoir sampling is used when exceeded.
+	maxSamples = 200000
+)
+
+// ops is the report order of the operations.
+var ops = []string{opHandshake, opWatch, opHeartbeat, opPull, opPublish, opDelivery}
+
+// recorder records the latency and errors of the operations.
+type recorder struct {
+	lock  sync.Mutex
+	stats map[string]*opStats
+	rand  *rand.Rand
+}
+
+type opStats struct {
+	total     int
+	errors    int
+	samples   []time.Duration
+	lastError string
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		stats: make(map[string]*opStats),
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())), //nolint:gosec
+	}
+}
+
+// observe records an operation, the latency is not recorded if the operation is failed.
+func (r *recorder) observe(op string, latency time.Duration, err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s, exists := r.stats[op]
+	if !exists {
+		s = new(opStats)
+		r.stats[op] = s
+	}
+
+	s.total++
+	if err != nil {
+		s.errors++
+		s.lastError = err.Error()
+		return
+	}
+
+	if len(s.samples) < maxSamples {
+		s.samples = append(s.samples, latency)
+		return
+	}
+	if idx := r.rand.Intn(s.total); idx < maxSamples {
+		s.samples[idx] = latency
+	}
+}
+
+// report writes the latency percentiles of the operations.
+func (r *recorder) report(w io.Writer, elapsed time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "operation\ttotal\terrors\tqps\tp50\tp90\tp99\tmax\t")
+	for _, op := range ops {
+		s, exists := r.stats[op]
+		if !exists {
+			continue
+		}
+
+		sort.Slice(s.samples, func(i, j int) bool { return s.samples[i] < s.samples[j] })
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%.1f\t%s\t%s\t%s\t%s\t\n", op, s.total, s.errors,
+			float64(s.total)/elapsed.Seconds(), percentile(s.samples, 0.5), percentile(s.samples, 0.9),
+			percentile(s.samples, 0.99), percentile(s.samples, 1))
+	}
+	_ = tw.Flush()
+
+	for _, op := range ops {
+		if s, exists := r.stats[op]; exists && s.errors > 0 {
+			fmt.Fprintf(w, "last %s error: %s\n", op, s.lastError)
+		}
+	}
+}
+
+// percentile returns the percentile of the sorted samples.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	idx := int(float64(len(sorted))*p+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx].Round(time.Microsecond)
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// bscp-bench simulates a large number of sidecars against the feed server for capacity planning.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/bscp-bench/bench"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	cfg := new(bench.Config)
+	fs := pflag.NewFlagSet("bscp-bench", pflag.ExitOnError)
+	fs.StringVar(&cfg.FeedAddr, "feed-addr", "127.0.0.1:9510", "the grpc address of the feed server")
+	fs.StringVar(&cfg.Token, "token", "", "the client credential token which can access the apps")
+	fs.Uint32Var(&cfg.BizID, "biz", 0, "the biz id of the apps")
+	fs.StringSliceVar(&cfg.Apps, "app", nil, "the kv apps watched by each sidecar, can be repeated")
+	fs.IntVarP(&cfg.Clients, "clients", "n", 1000, "the number of the simulated sidecars")
+	fs.IntVar(&cfg.Conns, "conns", 50, "the number of the grpc connections shared by the sidecars")
+	fs.DurationVar(&cfg.RampUp, "ramp-up", time.Minute, "the duration to start all the sidecars")
+	fs.DurationVarP(&cfg.Duration, "duration", "d", 5*time.Minute, "the duration of the load test after ramp up")
+	fs.DurationVar(&cfg.HeartbeatInterval, "heartbeat-interval", 15*time.Second, "the heartbeat interval of sidecar")
+	fs.DurationVar(&cfg.PullInterval, "pull-interval", 0,
+		"the interval of sidecar's periodic pull, 0 means only pull after the release is changed")
+	labels := fs.String("labels", "",
+		"the label distribution of the sidecars, e.g: \"env=prod:7,test:3;region=sh,gz\", weight is 1 if not set")
+	fs.StringVar(&cfg.DataAddr, "data-addr", "", "the grpc address of the data service to publish releases")
+	fs.DurationVar(&cfg.PublishInterval, "publish-interval", 0,
+		"the interval to publish a new release of each app, 0 means not publish")
+	_ = fs.Parse(os.Args[1:])
+
+	var err error
+	if cfg.Labels, err = bench.ParseLabelDistribution(*labels); err != nil {
+		return err
+	}
+	for i := range cfg.Apps {
+		cfg.Apps[i] = strings.TrimSpace(cfg.Apps[i])
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	return bench.Run(ctx, cfg, os.Stdout)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/bedis"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl/cmd"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
@@ -48,26 +49,37 @@ import (
 
 // Run start the cache service
 func Run(opt *options.Option) error {
+	finalizer, err := Start(opt)
+	if err != nil {
+		return err
+	}
+
+	shutdown.RegisterFirstShutdown(finalizer)
+	shutdown.WaitShutdown(20)
+	return nil
+}
+
+// Start start the cache service without waiting for it to shut down, the returned finalizer deregisters the service,
+// it should be called first when shutting down.
+func Start(opt *options.Option) (func(), error) {
 	ds := new(cacheService)
 	if err := ds.prepare(opt); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.listenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.gwListenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.register(); err != nil {
-		return err
+		return nil, err
 	}
 
-	shutdown.RegisterFirstShutdown(ds.finalizer)
-	shutdown.WaitShutdown(20)
-	return nil
+	return ds.finalizer, nil
 }
 
 type cacheService struct {
@@ -91,15 +103,15 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.CacheService().Network.BindIP,
 		strconv.Itoa(int(cc.CacheService().Network.RpcPort))))
 
-	etcdOpt, err := cc.CacheService().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register cache service.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.CacheServiceName,
@@ -107,7 +119,7 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 		Port: cc.CacheService().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithSetting(cc.CacheService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
@@ -132,6 +144,10 @@ func (cs *cacheService) prepare(opt *options.Option) error {
 	tls := cc.CacheService().Network.TLS
 	opts := make([]grpc.DialOption, 0)
 	opts = append(opts, sd.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	opts = append(opts, grpc.WithChainUnaryInterceptor(brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor(), brpc.APIVersionUnaryClientInterceptor(),
+		chaos.UnaryClientInterceptor()))
 
 	if !tls.Enable() {
 		// dial without ssl
@@ -179,6 +195,9 @@ func (cs *cacheService) listenAndServe() error {
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(4 * 1024 * 1024),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			brpc.RequestIDUnaryServerInterceptor(),
+			brpc.APIVersionUnaryServerInterceptor(),
+			brpc.UnknownFieldsUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
@@ -211,6 +230,7 @@ func (cs *cacheService) listenAndServe() error {
 		return fmt.Errorf("initialize service failed, err: %v", err)
 	}
 	pbcs.RegisterCacheServer(serve, svc)
+	pbcs.RegisterLegacyCacheServer(serve, svc)
 
 	// initialize and register standard grpc server grpcMetrics.
 	grpcMetrics.InitializeMetrics(serve)
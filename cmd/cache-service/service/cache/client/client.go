@@ -26,6 +26,9 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
+	pbexp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/experiment"
+	pbfte "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/file-tamper-event"
+	pbher "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-exec-result"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 )
@@ -38,13 +41,19 @@ type Interface interface {
 	GetReleasedHook(kt *kit.Kit, bizID uint32, releaseID uint32) (string, error)
 	ListAppReleasedGroups(kt *kit.Kit, bizID uint32, appID uint32) (string, error)
 	GetCredential(kt *kit.Kit, bizID uint32, credential string) (string, error)
+	ListClientQuarantines(kt *kit.Kit, bizID uint32) (string, error)
+	ListAppExperiments(kt *kit.Kit, bizID uint32, appID uint32) (string, error)
 	RefreshAppCache(kt *kit.Kit, bizID uint32, appID uint32) error
 	GetReleasedKv(kt *kit.Kit, bizID uint32, releaseID uint32) (string, error)
-	GetReleasedKvValue(kt *kit.Kit, bizID, appID, releaseID uint32, key string) (string, error)
+	GetReleasedKvValue(kt *kit.Kit, bizID, appID, releaseID, groupID uint32, key string) (string, error)
 	SetClientMetric(kt *kit.Kit, bizID, appID uint32, payload []byte) error
 	BatchUpsertClientMetrics(kt *kit.Kit, clientData []*pbclient.Client, clientEventData []*pbce.ClientEvent) error
+	BatchCreateHookExecResults(kt *kit.Kit, results []*pbher.HookExecResult) error
+	BatchUpsertExperimentExposures(kt *kit.Kit, exposures []*pbexp.ExperimentExposure) error
+	BatchCreateFileTamperEvents(kt *kit.Kit, events []*pbfte.FileTamperEvent) error
 	SetAppLastConsumedTime(kt *kit.Kit, bizID uint32, appIDs []uint32) error
 	BatchUpdateLastConsumedTime(kt *kit.Kit, appIDs []uint32) error
+	ReportAppConsumption(kt *kit.Kit, req *pbds.BatchIncreaseAppConsumptionReq) error
 	GetPublishTime(kt *kit.Kit, publishTime int64) (map[uint32]PublishInfo, error)
 	SetPublishTime(kt *kit.Kit, bizID, appID, strategyID uint32, publishTime int64) (int64, error)
 }
@@ -136,6 +145,45 @@ func (c *client) BatchUpsertClientMetrics(kt *kit.Kit, clientData []*pbclient.Cl
 	return nil
 }
 
+// BatchCreateHookExecResults batch create hook exec results data
+func (c *client) BatchCreateHookExecResults(kt *kit.Kit, results []*pbher.HookExecResult) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	_, err := c.db.BatchCreateHookExecResults(kt.Ctx, &pbds.BatchCreateHookExecResultsReq{Items: results})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// BatchUpsertExperimentExposures batch upsert experiment exposures data
+func (c *client) BatchUpsertExperimentExposures(kt *kit.Kit, exposures []*pbexp.ExperimentExposure) error {
+	if len(exposures) == 0 {
+		return nil
+	}
+
+	_, err := c.db.BatchUpsertExperimentExposures(kt.Ctx, &pbds.BatchUpsertExperimentExposuresReq{Items: exposures})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// BatchCreateFileTamperEvents batch create file tamper events data
+func (c *client) BatchCreateFileTamperEvents(kt *kit.Kit, events []*pbfte.FileTamperEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	_, err := c.db.BatchCreateFileTamperEvents(kt.Ctx, &pbds.BatchCreateFileTamperEventsReq{Items: events})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 // SetAppLastConsumedTime implements Interface.
 func (c *client) SetAppLastConsumedTime(kit *kit.Kit, bizID uint32, appIDs []uint32) error {
 	value, err := json.Marshal(appIDs)
@@ -159,3 +207,12 @@ func (c *client) BatchUpdateLastConsumedTime(kit *kit.Kit, appIDs []uint32) erro
 
 	return nil
 }
+
+// ReportAppConsumption 上报服务消费统计, feed server 已按周期聚合, 直接累加到数据库
+func (c *client) ReportAppConsumption(kit *kit.Kit, req *pbds.BatchIncreaseAppConsumptionReq) error {
+	if _, err := c.db.BatchIncreaseAppConsumption(kit.Ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/cache-service/service/cache/keys"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// ListClientQuarantines list the active client quarantine specs of the biz, the result is a json array raw
+// of table.ClientQuarantineSpec.
+func (c *client) ListClientQuarantines(kt *kit.Kit, bizID uint32) (string, error) {
+	start := time.Now()
+	defer func() {
+		c.mc.refreshLagMS.With(prm.Labels{"rsc": quarantineRes, "biz": tools.Itoa(bizID)}).Observe(tools.SinceMS(start))
+	}()
+
+	val, err := c.bds.Get(kt.Ctx, keys.Key.ClientQuarantine(bizID))
+	if err != nil {
+		return "", err
+	}
+
+	if len(val) != 0 {
+		c.mc.hitCounter.With(prm.Labels{"rsc": quarantineRes, "biz": tools.Itoa(bizID)}).Inc()
+		return val, nil
+	}
+
+	cancel := kt.CtxWithTimeoutMS(200)
+	defer cancel()
+
+	list, err := c.op.ClientQuarantine().ListActive(kt, bizID)
+	if err != nil {
+		return "", err
+	}
+
+	specs := make([]*table.ClientQuarantineSpec, 0, len(list))
+	for _, one := range list {
+		specs = append(specs, one.Spec)
+	}
+
+	b, err := jsoni.Marshal(specs)
+	if err != nil {
+		return "", err
+	}
+
+	// 隔离名单的变更没有事件通知, 使用较短的过期时间刷新
+	if err := c.bds.Set(kt.Ctx, keys.Key.ClientQuarantine(bizID), string(b),
+		keys.Key.ClientQuarantineTtlSec()); err != nil {
+		return "", fmt.Errorf("set biz: %d, client quarantine cache failed, err: %v", bizID, err)
+	}
+
+	c.mc.cacheItemByteSize.With(prm.Labels{"rsc": quarantineRes, "biz": tools.Itoa(bizID)}).Observe(float64(len(b)))
+
+	return string(b), nil
+}
@@ -119,6 +119,9 @@ func (c *client) queryCredentialFromCahce(kt *kit.Kit, bizID uint32, credential
 		Enabled: cred.Spec.Enable,
 		Scope:   scope,
 	}
+	if cred.Spec.Pause.Paused() {
+		credentialCache.Pause = &cred.Spec.Pause
+	}
 	b, err := jsoni.Marshal(credentialCache)
 	if err != nil {
 		logs.Errorf("marshal credential: %d-%s,failed, err: %v", bizID, credential, err)
@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/cache-service/service/cache/keys"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// ListAppExperiments list the enabled experiments of the app, the result is a json array raw
+// of table.Experiment.
+func (c *client) ListAppExperiments(kt *kit.Kit, bizID uint32, appID uint32) (string, error) {
+	start := time.Now()
+	defer func() {
+		c.mc.refreshLagMS.With(prm.Labels{"rsc": experimentRes, "biz": tools.Itoa(bizID)}).Observe(tools.SinceMS(start))
+	}()
+
+	val, err := c.bds.Get(kt.Ctx, keys.Key.AppExperiment(bizID, appID))
+	if err != nil {
+		return "", err
+	}
+
+	if len(val) != 0 {
+		c.mc.hitCounter.With(prm.Labels{"rsc": experimentRes, "biz": tools.Itoa(bizID)}).Inc()
+		return val, nil
+	}
+
+	cancel := kt.CtxWithTimeoutMS(200)
+	defer cancel()
+
+	list, err := c.op.Experiment().ListEnabled(kt, bizID, appID)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := jsoni.Marshal(list)
+	if err != nil {
+		return "", err
+	}
+
+	// 实验的变更没有事件通知, 使用较短的过期时间刷新
+	if err := c.bds.Set(kt.Ctx, keys.Key.AppExperiment(bizID, appID), string(b),
+		keys.Key.AppExperimentTtlSec()); err != nil {
+		return "", fmt.Errorf("set biz: %d, app: %d, experiment cache failed, err: %v", bizID, appID, err)
+	}
+
+	c.mc.cacheItemByteSize.With(prm.Labels{"rsc": experimentRes, "biz": tools.Itoa(bizID)}).Observe(float64(len(b)))
+
+	return string(b), nil
+}
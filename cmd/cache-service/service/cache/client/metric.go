@@ -75,5 +75,7 @@ const (
 	releasedHookRes    = "release-hook"
 	strategyRes        = "strategy"
 	credentialRes      = "credential"
+	quarantineRes      = "client-quarantine"
+	experimentRes      = "experiment"
 	releasedGroupRes   = "released-group"
 )
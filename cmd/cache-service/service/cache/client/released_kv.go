@@ -74,8 +74,9 @@ func (c *client) GetReleasedKv(kt *kit.Kit, bizID uint32, releaseID uint32) (str
 	return kv, nil
 }
 
-// GetReleasedKvValue get rkv value from cache.
-func (c *client) GetReleasedKvValue(kt *kit.Kit, bizID, appID, releaseID uint32, key string) (string, error) {
+// GetReleasedKvValue get rkv value from cache, the override value of the group is returned if group id is set.
+func (c *client) GetReleasedKvValue(kt *kit.Kit, bizID, appID, releaseID, groupID uint32, key string) (string,
+	error) {
 
 	start := time.Now()
 	r := &pbds.GetReleasedKvReq{
@@ -83,6 +84,7 @@ func (c *client) GetReleasedKvValue(kt *kit.Kit, bizID, appID, releaseID uint32,
 		AppId:     appID,
 		ReleaseId: releaseID,
 		Key:       key,
+		GroupId:   groupID,
 	}
 	rkv, err := c.db.GetReleasedKv(kt.RpcCtx(), r)
 	if err != nil {
@@ -149,7 +151,16 @@ func (c *client) refreshReleasedKvCache(kt *kit.Kit, bizID uint32, releaseID uin
 		return "", errf.New(errf.RecordNotFound, "release not exist in db")
 	}
 
-	js, err := jsoni.Marshal(types.ReleaseKvCaches(releasedKvs))
+	overrides, err := c.op.ReleasedKvOverride().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		logs.Errorf("get biz: %d release: %d kv overrides from db failed, err: %v, rid: %s", bizID, releaseID, err,
+			kt.Rid)
+		return "", err
+	}
+
+	list := types.ReleaseKvCaches(releasedKvs)
+	types.AttachReleaseKvOverrides(list, overrides)
+	js, err := jsoni.Marshal(list)
 	if err != nil {
 		return "", err
 	}
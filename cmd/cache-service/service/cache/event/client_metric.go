@@ -28,6 +28,9 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
+	pbexp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/experiment"
+	pbfte "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/file-tamper-event"
+	pbher "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-exec-result"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
 )
@@ -156,6 +159,10 @@ func (cm *ClientMetric) handleClientMetricData(kt *kit.Kit, payload []string) er
 	vcClient := map[string]*pbclient.Client{}
 	vcClientEvent := map[string]*pbce.ClientEvent{}
 
+	hookExecResults := []*pbher.HookExecResult{}
+	exposures := []*pbexp.ExperimentExposure{}
+	tamperEvents := []*pbfte.FileTamperEvent{}
+
 	clientMetricData := sfs.ClientMetricData{}
 	for _, v := range payload {
 		err := jsoni.Unmarshal([]byte(v), &clientMetricData)
@@ -205,6 +212,44 @@ func (cm *ClientMetric) handleClientMetricData(kt *kit.Kit, payload []string) er
 				vc.Application.AppID, vc.Application.Uid)] = clientMetric
 			vcClientEvent[fmt.Sprintf("%d-%d-%s-%s-%s", vc.BasicData.BizID,
 				vc.Application.AppID, vc.Application.Uid, vc.BasicData.ClientMode, vc.Application.CursorID)] = clientEventMetric
+		case sfs.HookExecResultMessage:
+			hr := new(sfs.HookExecResultPayload)
+			if err := hr.Decode(clientMetricData.Payload); err != nil {
+				return err
+			}
+
+			results, errHr := hr.PbHookExecResults()
+			if errHr != nil {
+				// 无效的脚本执行结果只丢弃该条上报, 不影响同批次的其他数据
+				logs.Warnf("drop invalid hook exec result payload, err: %v", errHr)
+				continue
+			}
+			// 脚本执行结果不做聚合，每次执行都需要记录
+			hookExecResults = append(hookExecResults, results...)
+		case sfs.ExperimentExposureMessage:
+			ee := new(sfs.ExperimentExposurePayload)
+			if err := ee.Decode(clientMetricData.Payload); err != nil {
+				return err
+			}
+
+			items, errEe := ee.PbExperimentExposures()
+			if errEe != nil {
+				return errEe
+			}
+			// 曝光在 data service 按实验和客户端聚合
+			exposures = append(exposures, items...)
+		case sfs.FileTamperMessage:
+			ft := new(sfs.FileTamperPayload)
+			if err := ft.Decode(clientMetricData.Payload); err != nil {
+				return err
+			}
+
+			events, errFt := ft.PbFileTamperEvents()
+			if errFt != nil {
+				return errFt
+			}
+			// 篡改事件用于合规审计, 不做聚合
+			tamperEvents = append(tamperEvents, events...)
 		}
 	}
 
@@ -227,6 +272,21 @@ func (cm *ClientMetric) handleClientMetricData(kt *kit.Kit, payload []string) er
 		return err
 	}
 
+	if err := cm.op.BatchCreateHookExecResults(kt, hookExecResults); err != nil {
+		logs.Errorf("batch create hook exec results failed, rid: %s, err: %s", kt.Rid, err.Error())
+		return err
+	}
+
+	if err := cm.op.BatchUpsertExperimentExposures(kt, exposures); err != nil {
+		logs.Errorf("batch upsert experiment exposures failed, rid: %s, err: %s", kt.Rid, err.Error())
+		return err
+	}
+
+	if err := cm.op.BatchCreateFileTamperEvents(kt, tamperEvents); err != nil {
+		logs.Errorf("batch create file tamper events failed, rid: %s, err: %s", kt.Rid, err.Error())
+		return err
+	}
+
 	return nil
 }
 
@@ -355,6 +355,13 @@ func (c *consumer) cacheReleasedKv(kt *kit.Kit, releaseBizID map[uint32]uint32)
 			return nil
 		}
 
+		overrides, err := c.op.ReleasedKvOverride().ListAllByReleaseIDs(kt, releaseIDs, bizID)
+		if err != nil {
+			logs.Errorf("list released kv overrides failed, bizID: %d, releaseIDs: %v, err: %v, rid: %s", bizID,
+				releaseIDs, err, kt.Rid)
+			return err
+		}
+
 		kvList := make(map[string][]*table.ReleasedKv)
 		for _, one := range releasedKv {
 			key := keys.Key.ReleasedKv(one.Attachment.BizID, one.ReleaseID)
@@ -367,7 +374,9 @@ func (c *consumer) cacheReleasedKv(kt *kit.Kit, releaseBizID map[uint32]uint32)
 			if len(list) == 0 {
 				continue
 			}
-			js, err = json.Marshal(types.ReleaseKvCaches(list))
+			caches := types.ReleaseKvCaches(list)
+			types.AttachReleaseKvOverrides(caches, overrides)
+			js, err = json.Marshal(caches)
 			if err != nil {
 				logs.Errorf("marshal kv list failed, skip, list: %+v, err: %v, rid: %s", list, err, kt.Rid)
 				continue
@@ -508,6 +517,15 @@ func (c *consumer) refreshOneBizAppMetaCache(kt *kit.Kit, bizID uint32, appIDs [
 		}
 
 		logs.V(1).Infof("refresh app: %d app meta: %s successfully, rid: %s", appID, js, kt.Rid)
+
+		// 归档的服务不再提供配置, 释放其上线分组缓存
+		if appMeta.State == table.AppStateArchived {
+			if err = c.bds.Delete(kt.Ctx, keys.Key.ReleasedGroup(bizID, appID)); err != nil {
+				logs.Errorf("delete archived app: %d released group cache failed, err: %v, rid: %s", appID, err,
+					kt.Rid)
+				return err
+			}
+		}
 	}
 
 	return nil
@@ -543,6 +561,9 @@ func (c *consumer) refreshCredentialCache(kt *kit.Kit, events []*table.Event) er
 			Enabled: cred.Spec.Enable,
 			Scope:   scope,
 		}
+		if cred.Spec.Pause.Paused() {
+			credentialCache.Pause = &cred.Spec.Pause
+		}
 		b, err := jsoni.Marshal(credentialCache)
 		if err != nil {
 			logs.Errorf("marshal credential: %d-%s,failed, err: %v", cred.Attachment.BizID, event.Spec.ResourceUid, err)
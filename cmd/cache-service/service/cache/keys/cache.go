@@ -51,6 +51,8 @@ const (
 	clientMetric        namespace = "client-metric"
 	publish             namespace = "publish"
 	appLastConsumedTime namespace = "app-last-consumed-time"
+	clientQuarantine    namespace = "client-quarantine"
+	experiment          namespace = "experiment"
 )
 
 type keyGenerator struct {
@@ -148,6 +150,36 @@ func (k keyGenerator) CredentialTtlSec(withRange bool) int {
 	return k.credentialTTLRange[0]
 }
 
+// ClientQuarantine generate a biz's client quarantine key to save the active quarantines of the biz
+func (k keyGenerator) ClientQuarantine(bizID uint32) string {
+	return element{
+		biz: bizID,
+		ns:  clientQuarantine,
+		key: "active",
+	}.String()
+}
+
+// ClientQuarantineTtlSec generate the client quarantine's TTL seconds, the quarantines are not purged by
+// events, so the ttl is short to make the changes take effect soon.
+func (k keyGenerator) ClientQuarantineTtlSec() int {
+	return 30
+}
+
+// AppExperiment generate an app's experiment key to save the enabled experiments of the app
+func (k keyGenerator) AppExperiment(bizID, appID uint32) string {
+	return element{
+		biz: bizID,
+		ns:  experiment,
+		key: strconv.FormatUint(uint64(appID), 10),
+	}.String()
+}
+
+// AppExperimentTtlSec generate the app experiment's TTL seconds, the experiments are not purged by
+// events, so the ttl is short to make the changes take effect soon.
+func (k keyGenerator) AppExperimentTtlSec() int {
+	return 30
+}
+
 // ReleasedCI generate a release's CI cache key to save all the CIs under
 // this release
 func (k keyGenerator) ReleasedCI(bizID uint32, releaseID uint32) string {
@@ -22,6 +22,7 @@ import (
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
 	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
@@ -122,7 +123,7 @@ func (s *Service) GetReleasedKvValue(ctx context.Context, req *pbcs.GetReleasedK
 	}
 
 	kt := kit.FromGrpcContext(ctx)
-	kv, err := s.op.GetReleasedKvValue(kt, req.BizId, req.AppId, req.ReleaseId, req.Key)
+	kv, err := s.op.GetReleasedKvValue(kt, req.BizId, req.AppId, req.ReleaseId, req.GroupId, req.Key)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +186,38 @@ func (s *Service) GetCredential(ctx context.Context, req *pbcs.GetCredentialReq)
 	return &pbcs.JsonRawResp{JsonRaw: credential}, nil
 }
 
+// ListClientQuarantines list the active client quarantines of the biz.
+func (s *Service) ListClientQuarantines(ctx context.Context, req *pbcs.ListBizClientQuarantinesReq) (
+	*pbcs.JsonRawResp, error) {
+	if req.BizId <= 0 {
+		return nil, errf.New(errf.InvalidParameter, "biz id can't be empty")
+	}
+
+	kt := kit.FromGrpcContext(ctx)
+	list, err := s.op.ListClientQuarantines(kt, req.BizId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.JsonRawResp{JsonRaw: list}, nil
+}
+
+// ListAppExperiments list the enabled experiments of the app.
+func (s *Service) ListAppExperiments(ctx context.Context, req *pbcs.ListAppExperimentsReq) (
+	*pbcs.JsonRawResp, error) {
+	if req.BizId <= 0 || req.AppId <= 0 {
+		return nil, errf.New(errf.InvalidParameter, "biz id or app id can't be empty")
+	}
+
+	kt := kit.FromGrpcContext(ctx)
+	list, err := s.op.ListAppExperiments(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.JsonRawResp{JsonRaw: list}, nil
+}
+
 // GetCurrentCursorReminder get the current consumed event's id, which is the cursor reminder's resource id.
 func (s *Service) GetCurrentCursorReminder(ctx context.Context, _ *pbbase.EmptyReq) (*pbcs.CurrentCursorReminderResp,
 	error) {
@@ -263,3 +296,28 @@ func (s *Service) SetPublishTime(ctx context.Context, req *pbcs.SetPublishTimeRe
 	}
 	return &resp, nil
 }
+
+// ReportAppConsumption 上报服务消费统计
+func (s *Service) ReportAppConsumption(ctx context.Context, req *pbcs.ReportAppConsumptionReq) (
+	*pbcs.ReportAppConsumptionResp, error) {
+
+	items := make([]*pbds.BatchIncreaseAppConsumptionReq_Item, 0, len(req.Items))
+	for _, one := range req.Items {
+		items = append(items, &pbds.BatchIncreaseAppConsumptionReq_Item{
+			BizId:         one.BizId,
+			AppId:         one.AppId,
+			PullCount:     one.PullCount,
+			DownloadBytes: one.DownloadBytes,
+			WatchSeconds:  one.WatchSeconds,
+		})
+	}
+
+	kit := kit.FromGrpcContext(ctx)
+	if err := s.op.ReportAppConsumption(kit, &pbds.BatchIncreaseAppConsumptionReq{
+		StatDate: req.StatDate,
+		Items:    items,
+	}); err != nil {
+		return nil, err
+	}
+	return &pbcs.ReportAppConsumptionResp{}, nil
+}
@@ -74,7 +74,7 @@ func (g *gateway) handler() http.Handler {
 	r.Get("/-/ready", g.ReadyHandler)
 	r.Get("/healthz", g.Healthz)
 
-	r.Mount("/", handler.RegisterCommonHandler())
+	r.Mount("/", handler.RegisterCommonToolHandler())
 	return r
 }
 
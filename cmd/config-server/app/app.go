@@ -29,6 +29,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/config-server/options"
 	"github.com/TencentBlueKing/bk-bscp/cmd/config-server/service"
 	"github.com/TencentBlueKing/bk-bscp/internal/audit"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
@@ -43,26 +44,37 @@ import (
 
 // Run start the config server
 func Run(opt *options.Option) error {
+	finalizer, err := Start(opt)
+	if err != nil {
+		return err
+	}
+
+	shutdown.RegisterFirstShutdown(finalizer)
+	shutdown.WaitShutdown(20)
+	return nil
+}
+
+// Start start the config server without waiting for it to shut down, the returned finalizer deregisters the service,
+// it should be called first when shutting down.
+func Start(opt *options.Option) (func(), error) {
 	ds := new(configServer)
 	if err := ds.prepare(opt); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.listenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.gwListenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.register(); err != nil {
-		return err
+		return nil, err
 	}
 
-	shutdown.RegisterFirstShutdown(ds.finalizer)
-	shutdown.WaitShutdown(20)
-	return nil
+	return ds.finalizer, nil
 }
 
 type configServer struct {
@@ -83,16 +95,21 @@ func (ds *configServer) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.RepoCredentialSection) {
+			repository.UpdateCredentials(cc.ConfigServer().Repo)
+		}
+	})
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.ConfigServer().Network.BindIP,
 		strconv.Itoa(int(cc.ConfigServer().Network.RpcPort))))
 	metrics.Register().MustRegister(metrics.BSCPServerHandledTotal)
 
-	etcdOpt, err := cc.ConfigServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register data service.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.ConfigServerName,
@@ -100,7 +117,7 @@ func (ds *configServer) prepare(opt *options.Option) error {
 		Port: cc.ConfigServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithSetting(cc.ConfigServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery faield, err: %v", err)
 	}
@@ -121,15 +138,23 @@ func (ds *configServer) listenAndServe() error {
 	grpcMetrics := grpc_prometheus.NewServerMetrics()
 	grpcMetrics.EnableHandlingTimeHistogram(metrics.GrpcBuckets)
 
+	svc, err := service.NewService(ds.sd)
+	if err != nil {
+		return fmt.Errorf("initialize service failed, err: %v", err)
+	}
+
 	recoveryOpt := grpc_recovery.WithRecoveryHandlerContext(brpc.RecoveryHandlerFuncContext)
 
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			brpc.RequestIDUnaryServerInterceptor(),
+			brpc.LanguageUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			brpc.GrpcServerHandledTotalInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
 			audit.UnaryServerInterceptor(),
+			svc.IdempotencyUnaryServerInterceptor(),
 		),
 		grpc.ChainStreamInterceptor(
 			grpcMetrics.StreamServerInterceptor(),
@@ -152,10 +177,6 @@ func (ds *configServer) listenAndServe() error {
 	}
 
 	serve := grpc.NewServer(opts...)
-	svc, err := service.NewService(ds.sd)
-	if err != nil {
-		return fmt.Errorf("initialize service failed, err: %v", err)
-	}
 	pbcs.RegisterConfigServer(serve, svc)
 
 	// initialize and register standard grpc server grpcMetrics.
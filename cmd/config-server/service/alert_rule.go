@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbar "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/alert-rule"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateAlertRule create a alert rule
+func (s *Service) CreateAlertRule(ctx context.Context, req *pbcs.CreateAlertRuleReq) (
+	*pbcs.CreateAlertRuleResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateAlertRule(grpcKit.RpcCtx(), &pbds.CreateAlertRuleReq{
+		Attachment: &pbar.AlertRuleAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("create alert rule failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateAlertRuleResp{Id: rp.Id}, nil
+}
+
+// ListAlertRules list the alert rules of a biz
+func (s *Service) ListAlertRules(ctx context.Context, req *pbcs.ListAlertRulesReq) (
+	*pbcs.ListAlertRulesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListAlertRules(grpcKit.RpcCtx(), &pbds.ListAlertRulesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("list alert rules failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListAlertRulesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateAlertRule update a alert rule
+func (s *Service) UpdateAlertRule(ctx context.Context, req *pbcs.UpdateAlertRuleReq) (
+	*pbcs.UpdateAlertRuleResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateAlertRule(grpcKit.RpcCtx(), &pbds.UpdateAlertRuleReq{
+		Id: req.RuleId,
+		Attachment: &pbar.AlertRuleAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update alert rule failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateAlertRuleResp{}, nil
+}
+
+// DeleteAlertRule delete a alert rule
+func (s *Service) DeleteAlertRule(ctx context.Context, req *pbcs.DeleteAlertRuleReq) (
+	*pbcs.DeleteAlertRuleResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteAlertRule(grpcKit.RpcCtx(), &pbds.DeleteAlertRuleReq{
+		Id:    req.RuleId,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("delete alert rule failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteAlertRuleResp{}, nil
+}
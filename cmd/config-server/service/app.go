@@ -14,8 +14,10 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -23,6 +25,9 @@ import (
 	iamauth "github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/internal/rest/view/webannotation"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/client"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/sys"
@@ -31,6 +36,7 @@ import (
 	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
 	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 )
 
@@ -54,14 +60,16 @@ func (s *Service) CreateApp(ctx context.Context, req *pbcs.CreateAppReq) (*pbcs.
 	r := &pbds.CreateAppReq{
 		BizId: req.BizId,
 		Spec: &pbapp.AppSpec{
-			Name:        req.Name,
-			ConfigType:  req.ConfigType,
-			Memo:        req.Memo,
-			Alias:       req.Alias,
-			DataType:    req.DataType,
-			IsApprove:   req.IsApprove,
-			ApproveType: req.ApproveType,
-			Approver:    req.Approver,
+			Name:               req.Name,
+			ConfigType:         req.ConfigType,
+			Memo:               req.Memo,
+			Alias:              req.Alias,
+			DataType:           req.DataType,
+			IsApprove:          req.IsApprove,
+			ApproveType:        req.ApproveType,
+			Approver:           req.Approver,
+			ReleaseNotesPolicy: req.ReleaseNotesPolicy,
+			MinClientVersion:   req.MinClientVersion,
 		},
 	}
 	rp, err := s.client.DS.CreateApp(kt.RpcCtx(), r)
@@ -84,6 +92,204 @@ func (s *Service) CreateApp(ctx context.Context, req *pbcs.CreateAppReq) (*pbcs.
 	return resp, nil
 }
 
+// CloneApp clone a new app from the app with the selected components
+func (s *Service) CloneApp(ctx context.Context, req *pbcs.CloneAppReq) (*pbcs.CloneAppResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.Name == "" || req.Alias == "" {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "name and alias are required"))
+	}
+
+	targetBizID := req.TargetBizId
+	if targetBizID == 0 {
+		targetBizID = req.BizId
+	}
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: targetBizID},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Create}, BizID: targetBizID},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	dsReq := &pbds.CloneAppReq{
+		BizId:               req.BizId,
+		AppId:               req.AppId,
+		TargetBizId:         targetBizID,
+		Name:                req.Name,
+		Alias:               req.Alias,
+		Memo:                req.Memo,
+		WithConfigItems:     req.WithConfigItems,
+		WithKvs:             req.WithKvs,
+		WithTemplateBinding: req.WithTemplateBinding,
+		WithVariables:       req.WithVariables,
+		WithHooks:           req.WithHooks,
+		Rename:              req.Rename,
+	}
+
+	if req.Async {
+		op, err := s.client.DS.CloneAppAsync(kt.RpcCtx(), dsReq)
+		if err != nil {
+			logs.Errorf("clone app async failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		go s.grantClonedAppCreator(kt, targetBizID, op.OperationId, req.Name)
+		return &pbcs.CloneAppResp{OperationId: op.OperationId}, nil
+	}
+
+	rp, err := s.client.DS.CloneApp(kt.RpcCtx(), dsReq)
+	if err != nil {
+		logs.Errorf("clone app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	s.grantAppCreator(kt, rp.Id, req.Name)
+
+	return &pbcs.CloneAppResp{Id: rp.Id}, nil
+}
+
+// grantAppCreator grant the creator actions of the new app to the user.
+func (s *Service) grantAppCreator(kt *kit.Kit, appID uint32, name string) {
+	if err := s.authorizer.GrantResourceCreatorAction(kt, &client.GrantResourceCreatorActionOption{
+		System:  sys.SystemIDBSCP,
+		Type:    sys.Application,
+		ID:      strconv.Itoa(int(appID)),
+		Name:    name,
+		Creator: kt.User,
+	}); err != nil {
+		logs.Errorf("grant app creator action failed, err: %v, rid: %s", err, kt.Rid)
+	}
+}
+
+// grantClonedAppCreator wait for the async clone operation to finish, and grant the creator actions of the
+// cloned app to the user, the creator is not granted if the config-server restarts before the clone finished.
+func (s *Service) grantClonedAppCreator(kt *kit.Kit, bizID, opID uint32, name string) {
+	bgKit := kt.Clone()
+	ctx, cancel := context.WithTimeout(context.Background(), cloneAppOperationWaitTimeout)
+	defer cancel()
+	bgKit.Ctx = ctx
+
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			logs.Warnf("wait clone app operation %d timeout, rid: %s", opID, kt.Rid)
+			return
+		case <-ticker.C:
+		}
+
+		op, err := s.client.DS.GetOperation(bgKit.RpcCtx(), &pbds.GetOperationReq{BizId: bizID, Id: opID})
+		if err != nil {
+			logs.Errorf("get clone app operation %d failed, err: %v, rid: %s", opID, err, kt.Rid)
+			continue
+		}
+		switch table.OperationState(op.Spec.State) {
+		case table.OperationSucceeded:
+			result := struct {
+				AppID uint32 `json:"app_id"`
+			}{}
+			if err = json.Unmarshal([]byte(op.Spec.Result), &result); err != nil || result.AppID == 0 {
+				logs.Errorf("invalid clone app operation %d result: %s, rid: %s", opID, op.Spec.Result, kt.Rid)
+				return
+			}
+			s.grantAppCreator(bgKit, result.AppID, name)
+			return
+		case table.OperationFailed, table.OperationCanceled:
+			return
+		}
+	}
+}
+
+// cloneAppOperationWaitTimeout is the max time waiting for the async clone operation.
+const cloneAppOperationWaitTimeout = time.Hour
+
+// UpdateAppState transit the lifecycle state of the app, archiving or unarchiving requires the delete permission
+func (s *Service) UpdateAppState(ctx context.Context, req *pbcs.UpdateAppStateReq) (*pbcs.UpdateAppStateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.client.DS.GetAppByID(kt.RpcCtx(), &pbds.GetAppByIDReq{AppId: req.AppId})
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	action := meta.Update
+	if req.State == string(table.AppStateArchived) || app.GetSpec().GetState() == string(table.AppStateArchived) {
+		action = meta.Delete
+	}
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: action, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err = s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.client.DS.UpdateAppState(kt.RpcCtx(), &pbds.UpdateAppStateReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		State: req.State,
+	}); err != nil {
+		logs.Errorf("update app state failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateAppStateResp{}, nil
+}
+
+// PauseAppConsumption pause the clients consuming the app, it requires the publish permission of the app
+func (s *Service) PauseAppConsumption(ctx context.Context, req *pbcs.PauseAppConsumptionReq) (
+	*pbcs.PauseAppConsumptionResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.PauseConsumption(kt.RpcCtx(), &pbds.PauseConsumptionReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		Mode:    req.Mode,
+		Message: req.Message,
+	}); err != nil {
+		logs.Errorf("pause app consumption failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PauseAppConsumptionResp{}, nil
+}
+
+// ResumeAppConsumption resume the clients consuming the app, it requires the publish permission of the app
+func (s *Service) ResumeAppConsumption(ctx context.Context, req *pbcs.ResumeAppConsumptionReq) (
+	*pbcs.ResumeAppConsumptionResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.ResumeConsumption(kt.RpcCtx(), &pbds.ResumeConsumptionReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	}); err != nil {
+		logs.Errorf("resume app consumption failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ResumeAppConsumptionResp{}, nil
+}
+
 // UpdateApp update app with options
 func (s *Service) UpdateApp(ctx context.Context, req *pbcs.UpdateAppReq) (*pbapp.App, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
@@ -101,13 +307,15 @@ func (s *Service) UpdateApp(ctx context.Context, req *pbcs.UpdateAppReq) (*pbapp
 		Id:    req.Id,
 		BizId: req.BizId,
 		Spec: &pbapp.AppSpec{
-			Name:        req.Name,
-			Memo:        req.Memo,
-			Alias:       req.Alias,
-			DataType:    req.DataType,
-			IsApprove:   req.IsApprove,
-			ApproveType: req.ApproveType,
-			Approver:    req.Approver,
+			Name:               req.Name,
+			Memo:               req.Memo,
+			Alias:              req.Alias,
+			DataType:           req.DataType,
+			IsApprove:          req.IsApprove,
+			ApproveType:        req.ApproveType,
+			Approver:           req.Approver,
+			ReleaseNotesPolicy: req.ReleaseNotesPolicy,
+			MinClientVersion:   req.MinClientVersion,
 		},
 	}
 	app, err := s.client.DS.UpdateApp(grpcKit.RpcCtx(), r)
@@ -230,11 +438,12 @@ func (s *Service) ListAppsRest(ctx context.Context, req *pbcs.ListAppsRestReq) (
 	}
 
 	r := &pbds.ListAppsRestReq{
-		BizId:    strings.Join(spaceIdList, ","),
-		Start:    req.Start,
-		Limit:    req.Limit,
-		Search:   req.Search,
-		Operator: req.Operator,
+		BizId:      strings.Join(spaceIdList, ","),
+		Start:      req.Start,
+		Limit:      req.Limit,
+		Search:     req.Search,
+		Operator:   req.Operator,
+		CursorPage: req.CursorPage,
 	}
 	rp, err := s.client.DS.ListAppsRest(kt.RpcCtx(), r)
 	if err != nil {
@@ -259,9 +468,14 @@ func (s *Service) ListAppsRest(ctx context.Context, req *pbcs.ListAppsRestReq) (
 		}
 	}
 
+	for _, app := range rp.Details {
+		pbbase.PruneFields(app, req.GetCursorPage().GetFields())
+	}
+
 	resp := &pbcs.ListAppsResp{
-		Count:   rp.Count,
-		Details: rp.Details,
+		Count:      rp.Count,
+		Details:    rp.Details,
+		NextCursor: rp.NextCursor,
 	}
 	return resp, nil
 }
@@ -288,6 +502,7 @@ func (s *Service) ListAppsBySpaceRest(ctx context.Context,
 		ConfigType: req.ConfigType,
 		Search:     req.Search,
 		Operator:   req.Operator,
+		CursorPage: req.CursorPage,
 	}
 	rp, err := s.client.DS.ListAppsRest(kt.RpcCtx(), r)
 	if err != nil {
@@ -333,11 +548,16 @@ func (s *Service) ListAppsBySpaceRest(ctx context.Context,
 		}
 	}
 
+	for _, app := range rp.Details {
+		pbbase.PruneFields(app, req.GetCursorPage().GetFields())
+	}
+
 	resp := &pbcs.ListAppsResp{
 		Count:         rp.Count,
 		Details:       rp.Details,
 		KvAppsCount:   rp.KvAppsCount,
 		FileAppsCount: rp.FileAppsCount,
+		NextCursor:    rp.NextCursor,
 	}
 
 	return resp, nil
@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// GetAppConsumption get the consumption stats of an app
+func (s *Service) GetAppConsumption(ctx context.Context, req *pbcs.GetAppConsumptionReq) (
+	*pbcs.GetAppConsumptionResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetAppConsumption(grpcKit.RpcCtx(), &pbds.GetAppConsumptionReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Days:  req.Days,
+	})
+	if err != nil {
+		logs.Errorf("get app consumption failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetAppConsumptionResp{Data: rp}, nil
+}
+
+// ListTopConsumingApps list the apps with the most pull count of a biz
+func (s *Service) ListTopConsumingApps(ctx context.Context, req *pbcs.ListTopConsumingAppsReq) (
+	*pbcs.ListTopConsumingAppsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListTopConsumingApps(grpcKit.RpcCtx(), &pbds.ListTopConsumingAppsReq{
+		BizId: req.BizId,
+		Days:  req.Days,
+		Limit: req.Limit,
+	})
+	if err != nil {
+		logs.Errorf("list top consuming apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListTopConsumingAppsResp{Details: rp.Details}, nil
+}
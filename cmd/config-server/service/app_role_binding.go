@@ -0,0 +1,237 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// maxCheckPermissionApps 单次检查权限的服务数量上限
+const maxCheckPermissionApps = 100
+
+// appPermissionActions 可检查的服务操作
+var appPermissionActions = []meta.Action{meta.View, meta.ViewSecret, meta.Update, meta.GenerateRelease, meta.Publish,
+	meta.Delete, meta.ManageRole}
+
+// SetAppRoleBinding set the role of the user in the app
+func (s *Service) SetAppRoleBinding(ctx context.Context, req *pbcs.SetAppRoleBindingReq) (
+	*pbcs.SetAppRoleBindingResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.ManageRole, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.SetAppRoleBinding(grpcKit.RpcCtx(), &pbds.SetAppRoleBindingReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		User:  req.User,
+		Role:  req.Role,
+	})
+	if err != nil {
+		logs.Errorf("set app role binding failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.SetAppRoleBindingResp{Id: rp.Id}, nil
+}
+
+// GrantTemporaryAppRole grant the role of the app to the user for a period, which is revoked after expired
+func (s *Service) GrantTemporaryAppRole(ctx context.Context, req *pbcs.GrantTemporaryAppRoleReq) (
+	*pbcs.GrantTemporaryAppRoleResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.ManageRole, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if req.DurationHours == 0 || req.DurationHours > table.MaxTemporaryAppRoleHours {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "duration hours should be between 1 and %d",
+			table.MaxTemporaryAppRoleHours))
+	}
+	expiredAt := time.Now().Add(time.Duration(req.DurationHours) * time.Hour).Format(time.RFC3339)
+
+	rp, err := s.client.DS.SetAppRoleBinding(grpcKit.RpcCtx(), &pbds.SetAppRoleBindingReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		User:      req.User,
+		Role:      req.Role,
+		ExpiredAt: expiredAt,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		logs.Errorf("grant temporary app role failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GrantTemporaryAppRoleResp{Id: rp.Id, ExpiredAt: expiredAt}, nil
+}
+
+// ListAppRoleBindings list the role bindings of the app
+func (s *Service) ListAppRoleBindings(ctx context.Context, req *pbcs.ListAppRoleBindingsReq) (
+	*pbcs.ListAppRoleBindingsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListAppRoleBindings(grpcKit.RpcCtx(), &pbds.ListAppRoleBindingsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("list app role bindings failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListAppRoleBindingsResp{Details: rp.Details}, nil
+}
+
+// DeleteAppRoleBinding delete the role binding of the app
+func (s *Service) DeleteAppRoleBinding(ctx context.Context, req *pbcs.DeleteAppRoleBindingReq) (
+	*pbcs.DeleteAppRoleBindingResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.ManageRole, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteAppRoleBinding(grpcKit.RpcCtx(), &pbds.DeleteAppRoleBindingReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.BindingId,
+	})
+	if err != nil {
+		logs.Errorf("delete app role binding failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteAppRoleBindingResp{}, nil
+}
+
+// CheckAppPermissions check the current user's permissions of the app actions in batch
+func (s *Service) CheckAppPermissions(ctx context.Context, req *pbcs.CheckAppPermissionsReq) (
+	*pbcs.CheckAppPermissionsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if len(req.AppIds) == 0 || len(req.AppIds) > maxCheckPermissionApps {
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "the number of app ids should be between 1 and %d", maxCheckPermissionApps))
+	}
+
+	actions := appPermissionActions
+	if len(req.Actions) > 0 {
+		actions = make([]meta.Action, 0, len(req.Actions))
+		for _, one := range req.Actions {
+			if !isAppPermissionAction(meta.Action(one)) {
+				return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "unsupported app action: %s", one))
+			}
+			actions = append(actions, meta.Action(one))
+		}
+	}
+
+	checks := make([]*meta.ResourceAttribute, 0, len(req.AppIds)*len(actions))
+	for _, appID := range req.AppIds {
+		for _, action := range actions {
+			checks = append(checks, &meta.ResourceAttribute{
+				Basic: meta.Basic{Type: meta.App, Action: action, ResourceID: appID}, BizID: req.BizId})
+		}
+	}
+
+	decisions, _, err := s.authorizer.AuthorizeDecision(grpcKit, checks...)
+	if err != nil {
+		logs.Errorf("check app permissions failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbcs.CheckAppPermissionsResp_AppPermission, 0, len(req.AppIds))
+	index := make(map[uint32]*pbcs.CheckAppPermissionsResp_AppPermission, len(req.AppIds))
+	for _, appID := range req.AppIds {
+		if _, ok := index[appID]; ok {
+			continue
+		}
+		one := &pbcs.CheckAppPermissionsResp_AppPermission{AppId: appID, Actions: make(map[string]bool)}
+		index[appID] = one
+		details = append(details, one)
+	}
+	for _, d := range decisions {
+		index[d.Resource.ResourceID].Actions[string(d.Resource.Basic.Action)] = d.Authorized
+	}
+
+	return &pbcs.CheckAppPermissionsResp{Details: details}, nil
+}
+
+// secretVisible returns a func which checks whether the user can see the secret values of the app,
+// the authorization is done lazily and only once.
+func (s *Service) secretVisible(kt *kit.Kit, bizID, appID uint32) func() bool {
+	var checked, visible bool
+	return func() bool {
+		if checked {
+			return visible
+		}
+		checked = true
+
+		res := &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.ViewSecret, ResourceID: appID}, BizID: bizID}
+		_, authorized, err := s.authorizer.AuthorizeDecision(kt, res)
+		if err != nil {
+			logs.Errorf("authorize view secret of app %d failed, err: %v, rid: %s", appID, err, kt.Rid)
+			return false
+		}
+		visible = authorized
+		return visible
+	}
+}
+
+func isAppPermissionAction(action meta.Action) bool {
+	for _, one := range appPermissionActions {
+		if one == action {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateAppSnapshot create a snapshot of app's editing workspace
+func (s *Service) CreateAppSnapshot(ctx context.Context, req *pbcs.CreateAppSnapshotReq) (
+	*pbcs.CreateAppSnapshotResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateAppSnapshot(grpcKit.RpcCtx(), &pbds.CreateAppSnapshotReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Name:  req.Name,
+		Memo:  req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("create app snapshot failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateAppSnapshotResp{Id: rp.Id}, nil
+}
+
+// ListAppSnapshots list the snapshots of an app
+func (s *Service) ListAppSnapshots(ctx context.Context, req *pbcs.ListAppSnapshotsReq) (
+	*pbcs.ListAppSnapshotsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListAppSnapshots(grpcKit.RpcCtx(), &pbds.ListAppSnapshotsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		logs.Errorf("list app snapshots failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListAppSnapshotsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// DeleteAppSnapshot delete a snapshot of an app
+func (s *Service) DeleteAppSnapshot(ctx context.Context, req *pbcs.DeleteAppSnapshotReq) (
+	*pbcs.DeleteAppSnapshotResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteAppSnapshot(grpcKit.RpcCtx(), &pbds.DeleteAppSnapshotReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.SnapshotId,
+	}); err != nil {
+		logs.Errorf("delete app snapshot failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteAppSnapshotResp{}, nil
+}
+
+// RestoreAppSnapshot restore app's editing workspace to a snapshot
+func (s *Service) RestoreAppSnapshot(ctx context.Context, req *pbcs.RestoreAppSnapshotReq) (
+	*pbcs.RestoreAppSnapshotResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.RestoreAppSnapshot(grpcKit.RpcCtx(), &pbds.RestoreAppSnapshotReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.SnapshotId,
+	}); err != nil {
+		logs.Errorf("restore app snapshot failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RestoreAppSnapshotResp{}, nil
+}
@@ -14,7 +14,6 @@ package service
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"path"
 	"sort"
@@ -22,6 +21,8 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/natsort"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -39,7 +40,7 @@ func (s *Service) CreateAppTemplateBinding(ctx context.Context, req *pbcs.Create
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	// validate input param
-	templateSetIDs, _, err := parseBindings(req.Bindings)
+	templateSetIDs, _, err := parseBindings(grpcKit, req.Bindings)
 	if err != nil {
 		logs.Errorf("create app template binding failed, parse bindings err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
@@ -47,7 +48,8 @@ func (s *Service) CreateAppTemplateBinding(ctx context.Context, req *pbcs.Create
 	// SliceRepeatedElements get the repeated elements in a slice, and the keep the sequence of result
 	repeatedTmplSetIDs := tools.SliceRepeatedElements(templateSetIDs)
 	if len(repeatedTmplSetIDs) > 0 {
-		return nil, fmt.Errorf("repeated template set ids: %v, id must be unique", repeatedTmplSetIDs)
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "repeated template set ids: %v, id must be unique", repeatedTmplSetIDs))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -118,7 +120,7 @@ func (s *Service) UpdateAppTemplateBinding(ctx context.Context, req *pbcs.Update
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	// validate input param
-	templateSetIDs, _, err := parseBindings(req.Bindings)
+	templateSetIDs, _, err := parseBindings(grpcKit, req.Bindings)
 	if err != nil {
 		logs.Errorf("update app template binding failed, parse bindings err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
@@ -126,7 +128,8 @@ func (s *Service) UpdateAppTemplateBinding(ctx context.Context, req *pbcs.Update
 	// SliceRepeatedElements get the repeated elements in a slice, and the keep the sequence of result
 	repeatedTmplSetIDs := tools.SliceRepeatedElements(templateSetIDs)
 	if len(repeatedTmplSetIDs) > 0 {
-		return nil, fmt.Errorf("repeated template set ids: %v, id must be unique", repeatedTmplSetIDs)
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "repeated template set ids: %v, id must be unique", repeatedTmplSetIDs))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -188,22 +191,26 @@ func (s *Service) ListAppTemplateBindings(ctx context.Context, req *pbcs.ListApp
 }
 
 // parseBindings parse the bindings param
-func parseBindings(bindings []*pbatb.TemplateBinding) (templateSetIDs, templateIDs []uint32, err error) {
+func parseBindings(kt *kit.Kit, bindings []*pbatb.TemplateBinding) (templateSetIDs, templateIDs []uint32,
+	err error) {
 	if len(bindings) == 0 {
-		return nil, nil, errors.New("bindings can't be empty")
+		return nil, nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "bindings can't be empty"))
 	}
 	for _, b := range bindings {
 		if b.TemplateSetId <= 0 {
-			return nil, nil, fmt.Errorf("invalid template set id of bindings member: %d", b.TemplateSetId)
+			return nil, nil, errf.Errorf(errf.InvalidArgument,
+				i18n.T(kt, "invalid template set id of bindings member: %d", b.TemplateSetId))
 		}
 		templateSetIDs = append(templateSetIDs, b.TemplateSetId)
 		for _, r := range b.TemplateRevisions {
 			if r.TemplateId <= 0 {
-				return nil, nil, fmt.Errorf("invalid template id of bindings member: %d", r.TemplateId)
+				return nil, nil, errf.Errorf(errf.InvalidArgument,
+					i18n.T(kt, "invalid template id of bindings member: %d", r.TemplateId))
 			}
 			templateIDs = append(templateIDs, r.TemplateId)
 			if r.TemplateRevisionId <= 0 {
-				return nil, nil, fmt.Errorf("invalid template revision id of bindings member: %d", r.TemplateRevisionId)
+				return nil, nil, errf.Errorf(errf.InvalidArgument,
+					i18n.T(kt, "invalid template revision id of bindings member: %d", r.TemplateRevisionId))
 			}
 		}
 	}
@@ -397,7 +404,8 @@ func (s *Service) ListReleasedAppBoundTmplRevisions(ctx context.Context,
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	if req.ReleaseId <= 0 {
-		return nil, fmt.Errorf("invalid release id %d, it must bigger than 0", req.ReleaseId)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "invalid release id %d, it must bigger than 0",
+			req.ReleaseId))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -485,7 +493,8 @@ func (s *Service) GetReleasedAppBoundTmplRevision(ctx context.Context,
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	if req.ReleaseId <= 0 {
-		return nil, fmt.Errorf("invalid release id %d, it must bigger than 0", req.ReleaseId)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "invalid release id %d, it must bigger than 0",
+			req.ReleaseId))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -521,18 +530,20 @@ func (s *Service) UpdateAppBoundTmplRevisions(ctx context.Context, req *pbcs.Upd
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	// validate input param
-	templateSetIDs, templateIDs, err := parseBindings(req.Bindings)
+	templateSetIDs, templateIDs, err := parseBindings(grpcKit, req.Bindings)
 	if err != nil {
 		logs.Errorf("update app bound template revisions failed, parse bindings err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
 	}
 	repeatedTmplSetIDs := tools.SliceRepeatedElements(templateSetIDs)
 	if len(repeatedTmplSetIDs) > 0 {
-		return nil, fmt.Errorf("repeated template set ids: %v, id must be unique", repeatedTmplSetIDs)
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "repeated template set ids: %v, id must be unique", repeatedTmplSetIDs))
 	}
 	repeatedTmplRevisionIDs := tools.SliceRepeatedElements(templateIDs)
 	if len(repeatedTmplRevisionIDs) > 0 {
-		return nil, fmt.Errorf("repeated template ids: %v, id must be unique", repeatedTmplRevisionIDs)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "repeated template ids: %v, id must be unique",
+			repeatedTmplRevisionIDs))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -556,10 +567,11 @@ func (s *Service) UpdateAppBoundTmplRevisions(ctx context.Context, req *pbcs.Upd
 		return nil, err
 	}
 	if len(bResp.Details) == 0 {
-		return nil, fmt.Errorf("app template binding not found, biz id: %d, app id: %d", req.BizId, req.AppId)
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "app template binding not found, biz id: %d, app id: %d", req.BizId, req.AppId))
 	}
 
-	if bindings, err = getBindingsAfterUpdate(bResp.Details[0].Spec.Bindings, req.Bindings); err != nil {
+	if bindings, err = getBindingsAfterUpdate(grpcKit, bResp.Details[0].Spec.Bindings, req.Bindings); err != nil {
 		return nil, err
 	}
 
@@ -582,7 +594,8 @@ func (s *Service) UpdateAppBoundTmplRevisions(ctx context.Context, req *pbcs.Upd
 }
 
 // getBindingsAfterUpdate get the final template bindings after update
-func getBindingsAfterUpdate(origin, update []*pbatb.TemplateBinding) ([]*pbatb.TemplateBinding, error) {
+func getBindingsAfterUpdate(kt *kit.Kit, origin, update []*pbatb.TemplateBinding) ([]*pbatb.TemplateBinding,
+	error) {
 	// revisionsMap is the existent template revisions
 	// map: template set id -> (map: template id -> template revision binding)
 	revisionsMap := make(map[uint32]map[uint32]*pbatb.TemplateRevisionBinding)
@@ -598,11 +611,13 @@ func getBindingsAfterUpdate(origin, update []*pbatb.TemplateBinding) ([]*pbatb.T
 	// update existent template revisions with the new template revisions
 	for _, b := range update {
 		if _, ok := revisionsMap[b.TemplateSetId]; !ok {
-			return nil, fmt.Errorf("template set id %d is not existent for the app bound templates", b.TemplateSetId)
+			return nil, errf.Errorf(errf.InvalidArgument,
+				i18n.T(kt, "template set id %d is not existent for the app bound templates", b.TemplateSetId))
 		}
 		for _, t := range b.TemplateRevisions {
 			if _, ok := revisionsMap[b.TemplateSetId][t.TemplateId]; !ok {
-				return nil, fmt.Errorf("template id %d is not existent for the app bound templates", t.TemplateId)
+				return nil, errf.Errorf(errf.InvalidArgument,
+					i18n.T(kt, "template id %d is not existent for the app bound templates", t.TemplateId))
 			}
 			revisionsMap[b.TemplateSetId][t.TemplateId] = t
 		}
@@ -662,10 +677,11 @@ func (s *Service) DeleteAppBoundTmplSets(ctx context.Context, req *pbcs.DeleteAp
 		return nil, err
 	}
 	if len(bResp.Details) == 0 {
-		return nil, fmt.Errorf("app template binding not found, biz id: %d, app id: %d", req.BizId, req.AppId)
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(grpcKit, "app template binding not found, biz id: %d, app id: %d", req.BizId, req.AppId))
 	}
 
-	if bindings, err = getBindingsAfterDelete(bResp.Details[0].Spec.Bindings, templateSetIDs); err != nil {
+	if bindings, err = getBindingsAfterDelete(grpcKit, bResp.Details[0].Spec.Bindings, templateSetIDs); err != nil {
 		return nil, err
 	}
 
@@ -688,7 +704,7 @@ func (s *Service) DeleteAppBoundTmplSets(ctx context.Context, req *pbcs.DeleteAp
 }
 
 // getBindingsAfterDelete get the final template bindings after delete
-func getBindingsAfterDelete(origin []*pbatb.TemplateBinding, deletedTmplSetIDs []uint32) (
+func getBindingsAfterDelete(kt *kit.Kit, origin []*pbatb.TemplateBinding, deletedTmplSetIDs []uint32) (
 	[]*pbatb.TemplateBinding, error) {
 	// revisionsMap is the existent template revisions
 	// map: template set id -> (map: template id -> template revision binding)
@@ -705,7 +721,8 @@ func getBindingsAfterDelete(origin []*pbatb.TemplateBinding, deletedTmplSetIDs [
 	// delete existent template revisions with the new template revisions
 	for _, id := range deletedTmplSetIDs {
 		if _, ok := revisionsMap[id]; !ok {
-			return nil, fmt.Errorf("template set id %d is not existent for the app bound templates", id)
+			return nil, errf.Errorf(errf.InvalidArgument,
+				i18n.T(kt, "template set id %d is not existent for the app bound templates", id))
 		}
 		delete(revisionsMap, id)
 	}
@@ -732,7 +749,7 @@ func (s *Service) CheckAppTemplateBinding(ctx context.Context, req *pbcs.CheckAp
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	// validate input param
-	_, templateIDs, err := parseBindings(req.Bindings)
+	_, templateIDs, err := parseBindings(grpcKit, req.Bindings)
 	if err != nil {
 		logs.Errorf("create app template binding failed, parse bindings err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
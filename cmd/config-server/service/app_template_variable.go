@@ -14,8 +14,9 @@ package service
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -90,7 +91,8 @@ func (s *Service) GetReleasedAppTmplVariableRefs(ctx context.Context, req *pbcs.
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	if req.ReleaseId <= 0 {
-		return nil, fmt.Errorf("invalid release id %d, it must bigger than 0", req.ReleaseId)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "invalid release id %d, it must bigger than 0",
+			req.ReleaseId))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -155,7 +157,8 @@ func (s *Service) ListReleasedAppTmplVariables(ctx context.Context, req *pbcs.Li
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	if req.ReleaseId <= 0 {
-		return nil, fmt.Errorf("invalid release id %d, it must bigger than 0", req.ReleaseId)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "invalid release id %d, it must bigger than 0",
+			req.ReleaseId))
 	}
 
 	res := []*meta.ResourceAttribute{
@@ -76,3 +76,40 @@ func (s *Service) ListAudits(ctx context.Context, req *pbcs.ListAuditsReq) (
 	}
 	return resp, nil
 }
+
+// ListAppTimeline list the change timeline of the app
+func (s *Service) ListAppTimeline(ctx context.Context, req *pbcs.ListAppTimelineReq) (
+	*pbcs.ListAppTimelineResp, error) {
+
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	r := &pbds.ListAppTimelineReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+		Start:     req.Start,
+		Limit:     req.Limit,
+	}
+	if req.Types != "" {
+		r.Types = strings.Split(req.Types, ",")
+	}
+	rp, err := s.client.DS.ListAppTimeline(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("list app timeline failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListAppTimelineResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// defaultOverviewLimit is the default max number of the details of each kind in the biz overview.
+	defaultOverviewLimit = 5
+	// maxOverviewLimit is the max number of the details of each kind in the biz overview.
+	maxOverviewLimit = 20
+)
+
+// GetBizOverview get the overview of a biz in one call, the stats are queried from data-service concurrently,
+// and the app level stats only cover the apps which the user can view.
+func (s *Service) GetBizOverview(ctx context.Context, req *pbcs.GetBizOverviewReq) (*pbcs.GetBizOverviewResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultOverviewLimit
+	}
+	if limit > maxOverviewLimit {
+		limit = maxOverviewLimit
+	}
+
+	appIDs, err := s.listViewableAppIDs(grpcKit, req.BizId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pbcs.GetBizOverviewResp{AppCount: uint32(len(appIDs))}
+	if len(appIDs) == 0 {
+		return resp, nil
+	}
+
+	eg, egCtx := errgroup.WithContext(grpcKit.RpcCtx())
+
+	eg.Go(func() error {
+		rp, err := s.client.DS.ListRecentPublishes(egCtx, &pbds.ListRecentPublishesReq{
+			BizId:  req.BizId,
+			AppIds: appIDs,
+			Limit:  limit,
+		})
+		if err != nil {
+			logs.Errorf("list recent publishes failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
+		resp.RecentPublishes = rp.Details
+		return nil
+	})
+
+	eg.Go(func() error {
+		rp, err := s.client.DS.ListHookExecResults(egCtx, &pbds.ListHookExecResultsReq{
+			BizId:      req.BizId,
+			AppIds:     appIDs,
+			FailedOnly: true,
+			Limit:      limit,
+		})
+		if err != nil {
+			logs.Errorf("list failed hook exec results failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
+		resp.FailedHookCount, resp.FailedHooks = rp.Count, rp.Details
+		return nil
+	})
+
+	eg.Go(func() error {
+		rp, err := s.client.DS.ListExpiringCertificates(egCtx, &pbds.ListExpiringCertificatesReq{
+			BizId:  req.BizId,
+			AppIds: appIDs,
+			Limit:  limit,
+		})
+		if err != nil {
+			logs.Errorf("list expiring certificates failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
+		resp.ExpiringCertCount, resp.ExpiringCerts = rp.Count, rp.Details
+		return nil
+	})
+
+	eg.Go(func() error {
+		rp, err := s.client.DS.ListStaleResources(egCtx, &pbds.ListStaleResourcesReq{
+			BizId:   req.BizId,
+			ResType: string(table.StaleApp),
+			Limit:   limit,
+		})
+		if err != nil {
+			logs.Errorf("list stale apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
+		resp.StaleAppCount, resp.StaleApps = rp.Count, rp.Details
+		return nil
+	})
+
+	eg.Go(func() error {
+		rp, err := s.client.DS.ListTopConsumingApps(egCtx, &pbds.ListTopConsumingAppsReq{
+			BizId: req.BizId,
+			Days:  req.Days,
+			Limit: limit,
+		})
+		if err != nil {
+			logs.Errorf("list top consuming apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return err
+		}
+		resp.TopConsumers = rp.Details
+		return nil
+	})
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ListBizUsages list the monthly usages of the bizs, the user should have the permission of all the bizs.
+func (s *Service) ListBizUsages(ctx context.Context, req *pbcs.ListBizUsagesReq) (*pbcs.ListBizUsagesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if len(req.BizIds) == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "biz ids is required"))
+	}
+	res := make([]*meta.ResourceAttribute, 0, len(req.BizIds))
+	for _, bizID := range req.BizIds {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: bizID})
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListBizUsages(grpcKit.RpcCtx(), &pbds.ListBizUsagesReq{
+		BizIds:     req.BizIds,
+		StartMonth: req.StartMonth,
+		EndMonth:   req.EndMonth,
+	})
+	if err != nil {
+		logs.Errorf("list biz usages failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListBizUsagesResp{Details: rp.Details}, nil
+}
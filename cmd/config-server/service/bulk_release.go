@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// BulkRelease commit config items or kvs and create a release in one call, optionally publish it.
+// 用于流水线等自动化场景, 替代 上传->批量更新->生成版本->上线 的多次调用
+func (s *Service) BulkRelease(ctx context.Context, req *pbcs.BulkReleaseReq) (*pbcs.BulkReleaseResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.GenerateRelease, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if req.Publish {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if len(req.Items) > 0 && len(req.Kvs) > 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "items and kvs can not be set at the same time"))
+	}
+
+	items := make([]*pbds.BatchUpsertConfigItemsReq_ConfigItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		// 配置文件的内容需先上传
+		if _, err := s.client.provider.Metadata(grpcKit, item.Sign); err != nil {
+			logs.Errorf("validate file content %s uploaded failed, err: %v, rid: %s", item.Sign, err, grpcKit.Rid)
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit,
+				"the content of config item %s is not uploaded", item.Name))
+		}
+		items = append(items, &pbds.BatchUpsertConfigItemsReq_ConfigItem{
+			ConfigItemAttachment: &pbci.ConfigItemAttachment{
+				BizId: req.BizId,
+				AppId: req.AppId,
+			},
+			ConfigItemSpec: &pbci.ConfigItemSpec{
+				Name:     item.Name,
+				Path:     item.Path,
+				FileType: item.FileType,
+				FileMode: item.FileMode,
+				Memo:     item.Memo,
+				Permission: &pbci.FilePermission{
+					User:      item.User,
+					UserGroup: item.UserGroup,
+					Privilege: item.Privilege,
+				},
+				Charset: item.Charset,
+			},
+			ContentSpec: &pbcontent.ContentSpec{
+				Signature: item.Sign,
+				ByteSize:  item.ByteSize,
+				Md5:       item.Md5,
+			},
+		})
+	}
+
+	bindings := make([]*pbds.BatchUpsertConfigItemsReq_TemplateBinding, 0, len(req.Bindings))
+	for _, v := range req.Bindings {
+		bindings = append(bindings, &pbds.BatchUpsertConfigItemsReq_TemplateBinding{
+			TemplateSpaceId: v.TemplateSpaceId,
+			TemplateBinding: v.GetTemplateBinding(),
+		})
+	}
+
+	kvs := make([]*pbds.BatchUpsertKvsReq_Kv, 0, len(req.Kvs))
+	for _, kv := range req.Kvs {
+		expirationTime, err := verifySecretVaule(grpcKit, kv.SecretType, kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{
+				BizId: req.BizId,
+				AppId: req.AppId,
+			},
+			KvSpec: &pbkv.KvSpec{
+				Key:                       kv.Key,
+				KvType:                    kv.KvType,
+				Value:                     kv.Value,
+				Memo:                      kv.Memo,
+				SecretType:                kv.SecretType,
+				SecretHidden:              kv.SecretHidden,
+				CertificateExpirationDate: expirationTime,
+			},
+		})
+	}
+
+	rp, err := s.client.DS.BulkRelease(grpcKit.RpcCtx(), &pbds.BulkReleaseReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		Items:           items,
+		Variables:       req.Variables,
+		Bindings:        bindings,
+		Kvs:             kvs,
+		ReplaceAll:      req.ReplaceAll,
+		ReleaseName:     req.ReleaseName,
+		ReleaseMemo:     req.ReleaseMemo,
+		ReleaseNotes:    req.ReleaseNotes,
+		Publish:         req.Publish,
+		All:             req.All,
+		GrayPublishMode: req.GrayPublishMode,
+		Groups:          req.Groups,
+		Labels:          req.Labels,
+		GroupName:       req.GroupName,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
+	})
+	if err != nil {
+		logs.Errorf("bulk release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.BulkReleaseResp{
+		Ids:                        rp.Ids,
+		ReleaseId:                  rp.ReleaseId,
+		PublishedStrategyHistoryId: rp.PublishedStrategyHistoryId,
+		ComplianceWarnings:         rp.ComplianceWarnings,
+		Overlaps:                   rp.Overlaps,
+	}, nil
+}
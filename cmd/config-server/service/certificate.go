@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcert "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/certificate"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ListExpiringCertificates list the expiring certificates of the apps which the user can view
+func (s *Service) ListExpiringCertificates(ctx context.Context, req *pbcs.ListExpiringCertificatesReq) (
+	*pbcs.ListExpiringCertificatesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if req.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View,
+			ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	appIDs := []uint32{req.AppId}
+	if req.AppId == 0 {
+		var err error
+		if appIDs, err = s.listViewableAppIDs(grpcKit, req.BizId); err != nil {
+			return nil, err
+		}
+	}
+	if len(appIDs) == 0 {
+		return &pbcs.ListExpiringCertificatesResp{Details: make([]*pbcert.Certificate, 0)}, nil
+	}
+
+	rp, err := s.client.DS.ListExpiringCertificates(grpcKit.RpcCtx(), &pbds.ListExpiringCertificatesReq{
+		BizId:  req.BizId,
+		AppIds: appIDs,
+		Days:   req.Days,
+		Start:  req.Start,
+		Limit:  req.Limit,
+		All:    req.All,
+	})
+	if err != nil {
+		logs.Errorf("list expiring certificates failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListExpiringCertificatesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// RenewCertificate renew the certificate by the internal CA
+func (s *Service) RenewCertificate(ctx context.Context, req *pbcs.RenewCertificateReq) (
+	*pbcs.RenewCertificateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.RenewCertificate(grpcKit.RpcCtx(), &pbds.RenewCertificateReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.Id,
+	}); err != nil {
+		logs.Errorf("renew certificate failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RenewCertificateResp{}, nil
+}
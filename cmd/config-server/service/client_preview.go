@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-preview"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// PreviewClientRelease preview the release and the content which the client would currently receive, it's
+// used to find out why a client is still getting the old config.
+func (s *Service) PreviewClientRelease(ctx context.Context, req *pbcs.PreviewClientReleaseReq) (
+	*pbcp.ClientReleasePreview, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.PreviewClientRelease(grpcKit.RpcCtx(), &pbds.PreviewClientReleaseReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		Uid:     req.Uid,
+		Labels:  req.Labels,
+		Explain: req.Explain,
+	})
+	if err != nil {
+		logs.Errorf("preview client release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	// 敏感信息类型需要判断是否隐藏密码, 没有查看敏感信息权限时同样隐藏
+	visible := s.secretVisible(grpcKit, req.BizId, req.AppId)
+	masker, err := s.contentMasker(grpcKit, req.BizId, req.AppId, visible)
+	if err != nil {
+		return nil, err
+	}
+	for _, kv := range rp.Kvs {
+		if kv.KvType != string(table.KvSecret) {
+			// 没有查看敏感信息权限时按服务的脱敏规则处理
+			kv.Value = maskContent(masker, kv.Value)
+			continue
+		}
+		if kv.SecretHidden || !visible() {
+			kv.Value = i18n.T(grpcKit, "sensitive data is not visible, unable to view actual content")
+		}
+	}
+
+	return rp, nil
+}
@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcq "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-quarantine"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateClientQuarantine create a client quarantine
+func (s *Service) CreateClientQuarantine(ctx context.Context, req *pbcs.CreateClientQuarantineReq) (
+	*pbcs.CreateClientQuarantineResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateClientQuarantine(grpcKit.RpcCtx(), &pbds.CreateClientQuarantineReq{
+		Attachment: &pbcq.ClientQuarantineAttachment{
+			BizId: req.BizId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("create client quarantine failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateClientQuarantineResp{Id: rp.Id}, nil
+}
+
+// ListClientQuarantines list the client quarantines of a biz
+func (s *Service) ListClientQuarantines(ctx context.Context, req *pbcs.ListClientQuarantinesReq) (
+	*pbcs.ListClientQuarantinesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListClientQuarantines(grpcKit.RpcCtx(), &pbds.ListClientQuarantinesReq{
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("list client quarantines failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListClientQuarantinesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateClientQuarantine update a client quarantine
+func (s *Service) UpdateClientQuarantine(ctx context.Context, req *pbcs.UpdateClientQuarantineReq) (
+	*pbcs.UpdateClientQuarantineResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateClientQuarantine(grpcKit.RpcCtx(), &pbds.UpdateClientQuarantineReq{
+		Id: req.QuarantineId,
+		Attachment: &pbcq.ClientQuarantineAttachment{
+			BizId: req.BizId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update client quarantine failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateClientQuarantineResp{}, nil
+}
+
+// DeleteClientQuarantine delete a client quarantine, the quarantined clients are served again
+func (s *Service) DeleteClientQuarantine(ctx context.Context, req *pbcs.DeleteClientQuarantineReq) (
+	*pbcs.DeleteClientQuarantineResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteClientQuarantine(grpcKit.RpcCtx(), &pbds.DeleteClientQuarantineReq{
+		Id:    req.QuarantineId,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("delete client quarantine failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteClientQuarantineResp{}, nil
+}
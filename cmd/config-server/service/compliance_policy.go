@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/compliance-policy"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateCompliancePolicy create a compliance policy
+func (s *Service) CreateCompliancePolicy(ctx context.Context, req *pbcs.CreateCompliancePolicyReq) (
+	*pbcs.CreateCompliancePolicyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateCompliancePolicy(grpcKit.RpcCtx(), &pbds.CreateCompliancePolicyReq{
+		Attachment: &pbcp.CompliancePolicyAttachment{
+			BizId: req.BizId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("create compliance policy failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateCompliancePolicyResp{Id: rp.Id}, nil
+}
+
+// ListCompliancePolicies list the compliance policies of a biz
+func (s *Service) ListCompliancePolicies(ctx context.Context, req *pbcs.ListCompliancePoliciesReq) (
+	*pbcs.ListCompliancePoliciesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListCompliancePolicies(grpcKit.RpcCtx(), &pbds.ListCompliancePoliciesReq{
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("list compliance policies failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListCompliancePoliciesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateCompliancePolicy update a compliance policy
+func (s *Service) UpdateCompliancePolicy(ctx context.Context, req *pbcs.UpdateCompliancePolicyReq) (
+	*pbcs.UpdateCompliancePolicyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateCompliancePolicy(grpcKit.RpcCtx(), &pbds.UpdateCompliancePolicyReq{
+		Id: req.PolicyId,
+		Attachment: &pbcp.CompliancePolicyAttachment{
+			BizId: req.BizId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update compliance policy failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateCompliancePolicyResp{}, nil
+}
+
+// DeleteCompliancePolicy delete a compliance policy
+func (s *Service) DeleteCompliancePolicy(ctx context.Context, req *pbcs.DeleteCompliancePolicyReq) (
+	*pbcs.DeleteCompliancePolicyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteCompliancePolicy(grpcKit.RpcCtx(), &pbds.DeleteCompliancePolicyReq{
+		BizId: req.BizId,
+		Id:    req.PolicyId,
+	})
+	if err != nil {
+		logs.Errorf("delete compliance policy failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteCompliancePolicyResp{}, nil
+}
+
+// TestCompliancePolicy test a compliance policy with samples or the editing configs of an app
+func (s *Service) TestCompliancePolicy(ctx context.Context, req *pbcs.TestCompliancePolicyReq) (
+	*pbcs.TestCompliancePolicyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if req.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View,
+			ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	samples := make([]*pbds.TestCompliancePolicyReq_Sample, 0, len(req.Samples))
+	for _, one := range req.Samples {
+		samples = append(samples, &pbds.TestCompliancePolicyReq_Sample{
+			Target:  one.Target,
+			Name:    one.Name,
+			Content: one.Content,
+		})
+	}
+	rp, err := s.client.DS.TestCompliancePolicy(grpcKit.RpcCtx(), &pbds.TestCompliancePolicyReq{
+		BizId:   req.BizId,
+		Spec:    req.Spec,
+		Samples: samples,
+		AppId:   req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("test compliance policy failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.TestCompliancePolicyResp{Violations: rp.Violations}, nil
+}
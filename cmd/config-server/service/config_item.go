@@ -14,17 +14,20 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"strings"
 	"sync"
 
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbcommit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/commit"
 	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
 	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
@@ -195,6 +198,7 @@ func (s *Service) UpdateConfigItem(ctx context.Context, req *pbcs.UpdateConfigIt
 			},
 			Charset: req.Charset,
 		},
+		ExpectedRevision: expectedRevision(ctx, req.Revision),
 	}
 	_, err = s.client.DS.UpdateConfigItem(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -263,6 +267,22 @@ func (s *Service) UpdateConfigItem(ctx context.Context, req *pbcs.UpdateConfigIt
 	return resp, nil
 }
 
+// expectedRevision returns the expected revision of the resource to be updated for optimistic locking,
+// the revision in request takes precedence over the If-Match header.
+func expectedRevision(ctx context.Context, revision string) string {
+	if revision != "" {
+		return revision
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(constant.IfMatchKey); len(values) != 0 {
+		// If-Match 可能为带引号的 ETag 形式
+		return strings.Trim(values[0], `"`)
+	}
+
+	return ""
+}
+
 // DeleteConfigItem delete config item with option
 func (s *Service) DeleteConfigItem(ctx context.Context, req *pbcs.DeleteConfigItemReq) (
 	*pbcs.DeleteConfigItemResp, error) {
@@ -481,6 +501,7 @@ func (s *Service) ListConfigItems(ctx context.Context, req *pbcs.ListConfigItems
 		Ids:          req.Ids,
 		WithStatus:   req.WithStatus,
 		Status:       req.Status,
+		CursorPage:   req.CursorPage,
 	}
 	rp, err := s.client.DS.ListConfigItems(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -488,10 +509,15 @@ func (s *Service) ListConfigItems(ctx context.Context, req *pbcs.ListConfigItems
 		return nil, err
 	}
 
+	for _, ci := range rp.Details {
+		pbbase.PruneFields(ci, req.GetCursorPage().GetFields())
+	}
+
 	resp := &pbcs.ListConfigItemsResp{
 		Count:          rp.Count,
 		Details:        rp.Details,
 		ConflictNumber: rp.ConflictNumber,
+		NextCursor:     rp.NextCursor,
 	}
 	return resp, nil
 }
@@ -503,7 +529,8 @@ func (s *Service) ListReleasedConfigItems(ctx context.Context,
 	grpcKit := kit.FromGrpcContext(ctx)
 
 	if req.ReleaseId <= 0 {
-		return nil, fmt.Errorf("invalid release id %d, it must bigger than 0", req.ReleaseId)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "invalid release id %d, it must bigger than 0",
+			req.ReleaseId))
 	}
 
 	res := []*meta.ResourceAttribute{
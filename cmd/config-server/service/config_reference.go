@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcref "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-reference"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateConfigReference declare a reference from the config of the app to the other config
+func (s *Service) CreateConfigReference(ctx context.Context, req *pbcs.CreateConfigReferenceReq) (
+	*pbcs.CreateConfigReferenceResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateConfigReference(grpcKit.RpcCtx(), &pbds.CreateConfigReferenceReq{
+		BizId:    req.BizId,
+		SrcAppId: req.AppId,
+		DstAppId: req.DstAppId,
+		Spec: &pbcref.ConfigReferenceSpec{
+			SrcType: req.SrcType,
+			SrcId:   req.SrcId,
+			DstType: req.DstType,
+			DstId:   req.DstId,
+			RefType: req.RefType,
+			Memo:    req.Memo,
+		},
+	})
+	if err != nil {
+		logs.Errorf("create config reference failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateConfigReferenceResp{
+		Id: rp.Id,
+	}, nil
+}
+
+// DeleteConfigReference delete the config reference declared by the app
+func (s *Service) DeleteConfigReference(ctx context.Context, req *pbcs.DeleteConfigReferenceReq) (
+	*pbcs.DeleteConfigReferenceResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteConfigReference(grpcKit.RpcCtx(), &pbds.DeleteConfigReferenceReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.Id,
+	}); err != nil {
+		logs.Errorf("delete config reference failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteConfigReferenceResp{}, nil
+}
+
+// GetConfigRefGraph query the reference graph around the config
+func (s *Service) GetConfigRefGraph(ctx context.Context, req *pbcs.GetConfigRefGraphReq) (
+	*pbcs.GetConfigRefGraphResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetConfigRefGraph(grpcKit.RpcCtx(), &pbds.GetConfigRefGraphReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		NodeType:  req.NodeType,
+		NodeId:    req.NodeId,
+		Direction: req.Direction,
+		Depth:     req.Depth,
+	})
+	if err != nil {
+		logs.Errorf("get config reference graph failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetConfigRefGraphResp{
+		Nodes: rp.Nodes,
+		Edges: rp.Edges,
+	}, nil
+}
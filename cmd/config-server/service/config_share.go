@@ -0,0 +1,244 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbcshare "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-share"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateConfigShare create a config share
+func (s *Service) CreateConfigShare(ctx context.Context, req *pbcs.CreateConfigShareReq) (
+	*pbcs.CreateConfigShareResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateConfigShare(grpcKit.RpcCtx(), &pbds.CreateConfigShareReq{
+		Attachment: &pbcshare.ConfigShareAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: &pbcshare.ConfigShareSpec{
+			Name:         req.Name,
+			ConfigItemId: req.ConfigItemId,
+			Key:          req.Key,
+			Grantees:     req.Grantees,
+			Memo:         req.Memo,
+		},
+	})
+	if err != nil {
+		logs.Errorf("create config share failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateConfigShareResp{Id: rp.Id}, nil
+}
+
+// UpdateConfigShare update a config share
+func (s *Service) UpdateConfigShare(ctx context.Context, req *pbcs.UpdateConfigShareReq) (
+	*pbcs.UpdateConfigShareResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.UpdateConfigShare(grpcKit.RpcCtx(), &pbds.UpdateConfigShareReq{
+		BizId:    req.BizId,
+		Id:       req.ShareId,
+		Grantees: req.Grantees,
+		Memo:     req.Memo,
+	}); err != nil {
+		logs.Errorf("update config share failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateConfigShareResp{}, nil
+}
+
+// DeleteConfigShare delete a config share
+func (s *Service) DeleteConfigShare(ctx context.Context, req *pbcs.DeleteConfigShareReq) (
+	*pbcs.DeleteConfigShareResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteConfigShare(grpcKit.RpcCtx(), &pbds.DeleteConfigShareReq{
+		BizId: req.BizId,
+		Id:    req.ShareId,
+	}); err != nil {
+		logs.Errorf("delete config share failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteConfigShareResp{}, nil
+}
+
+// ListConfigShares list the config shares owned by the biz
+func (s *Service) ListConfigShares(ctx context.Context, req *pbcs.ListConfigSharesReq) (
+	*pbcs.ListConfigSharesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListConfigShares(grpcKit.RpcCtx(), &pbds.ListConfigSharesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListConfigSharesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// ListSharedConfigs list the config shares granted to the biz
+func (s *Service) ListSharedConfigs(ctx context.Context, req *pbcs.ListSharedConfigsReq) (
+	*pbcs.ListSharedConfigsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListConfigShares(grpcKit.RpcCtx(), &pbds.ListConfigSharesReq{
+		GranteeBizId: req.BizId,
+		Start:        req.Start,
+		Limit:        req.Limit,
+		All:          req.All,
+	})
+	if err != nil {
+		logs.Errorf("list shared configs failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListSharedConfigsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// CreateConfigShareRef create a reference to a config share for the app
+func (s *Service) CreateConfigShareRef(ctx context.Context, req *pbcs.CreateConfigShareRefReq) (
+	*pbcs.CreateConfigShareRefResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateConfigShareRef(grpcKit.RpcCtx(), &pbds.CreateConfigShareRefReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		ShareId: req.ShareId,
+	})
+	if err != nil {
+		logs.Errorf("create config share ref failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateConfigShareRefResp{Id: rp.Id}, nil
+}
+
+// DeleteConfigShareRef delete the app's reference to a config share
+func (s *Service) DeleteConfigShareRef(ctx context.Context, req *pbcs.DeleteConfigShareRefReq) (
+	*pbcs.DeleteConfigShareRefResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteConfigShareRef(grpcKit.RpcCtx(), &pbds.DeleteConfigShareRefReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.RefId,
+	}); err != nil {
+		logs.Errorf("delete config share ref failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteConfigShareRefResp{}, nil
+}
+
+// ListConfigShareRefs list the config shares referenced by the app
+func (s *Service) ListConfigShareRefs(ctx context.Context, req *pbcs.ListConfigShareRefsReq) (
+	*pbcs.ListConfigShareRefsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListConfigShareRefs(grpcKit.RpcCtx(), &pbds.ListConfigShareRefsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("list config share refs failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListConfigShareRefsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbcredential "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/credential"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
@@ -105,6 +106,7 @@ func (s *Service) ListCredentials(ctx context.Context,
 		All:           req.All,
 		EncCredential: encCredential,
 		Enable:        req.Enable,
+		CursorPage:    req.CursorPage,
 	}
 
 	rp, err := s.client.DS.ListCredentials(grpcKit.RpcCtx(), r)
@@ -121,11 +123,13 @@ func (s *Service) ListCredentials(ctx context.Context,
 			return nil, e
 		}
 		val.Spec.EncCredential = credential
+		pbbase.PruneFields(val, req.GetCursorPage().GetFields())
 	}
 
 	resp := &pbcs.ListCredentialsResp{
-		Count:   rp.Count,
-		Details: rp.Details,
+		Count:      rp.Count,
+		Details:    rp.Details,
+		NextCursor: rp.NextCursor,
 	}
 	return resp, nil
 }
@@ -196,6 +200,56 @@ func (s *Service) UpdateCredential(ctx context.Context,
 	return resp, nil
 }
 
+// PauseCredentialConsumption pause the clients using the credential consuming the configs
+func (s *Service) PauseCredentialConsumption(ctx context.Context, req *pbcs.PauseCredentialConsumptionReq) (
+	*pbcs.PauseCredentialConsumptionResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.PauseConsumption(grpcKit.RpcCtx(), &pbds.PauseConsumptionReq{
+		BizId:        req.BizId,
+		CredentialId: req.CredentialId,
+		Mode:         req.Mode,
+		Message:      req.Message,
+	}); err != nil {
+		logs.Errorf("pause credential consumption failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PauseCredentialConsumptionResp{}, nil
+}
+
+// ResumeCredentialConsumption resume the clients using the credential consuming the configs
+func (s *Service) ResumeCredentialConsumption(ctx context.Context, req *pbcs.ResumeCredentialConsumptionReq) (
+	*pbcs.ResumeCredentialConsumptionResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.ResumeConsumption(grpcKit.RpcCtx(), &pbds.ResumeConsumptionReq{
+		BizId:        req.BizId,
+		CredentialId: req.CredentialId,
+	}); err != nil {
+		logs.Errorf("resume credential consumption failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ResumeCredentialConsumptionResp{}, nil
+}
+
 // CheckCredentialName Check if the credential name exists
 func (s *Service) CheckCredentialName(ctx context.Context, req *pbcs.CheckCredentialNameReq) (
 	*pbcs.CheckCredentialNameResp, error) {
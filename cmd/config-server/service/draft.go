@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// UpsertDraftItem add an edit to the current user's draft
+func (s *Service) UpsertDraftItem(ctx context.Context, req *pbcs.UpsertDraftItemReq) (
+	*pbcs.UpsertDraftItemResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	// 配置文件的内容需先上传
+	if item := req.Item; item != nil && item.ConfigItem != nil && item.Action == string(table.DraftUpsert) {
+		if item.Content == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "config item content is required"))
+		}
+		if _, err := s.client.provider.Metadata(grpcKit, item.Content.Signature); err != nil {
+			logs.Errorf("validate file content uploaded failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
+	}
+
+	if _, err := s.client.DS.UpsertDraftItem(grpcKit.RpcCtx(), &pbds.UpsertDraftItemReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Item:  req.Item,
+	}); err != nil {
+		logs.Errorf("upsert draft item failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpsertDraftItemResp{}, nil
+}
+
+// DeleteDraftItem remove an edit from the current user's draft
+func (s *Service) DeleteDraftItem(ctx context.Context, req *pbcs.DeleteDraftItemReq) (
+	*pbcs.DeleteDraftItemResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteDraftItem(grpcKit.RpcCtx(), &pbds.DeleteDraftItemReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Key:   req.Key,
+	}); err != nil {
+		logs.Errorf("delete draft item failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteDraftItemResp{}, nil
+}
+
+// GetDraft get the current user's draft with the diff against app's shared unreleased state
+func (s *Service) GetDraft(ctx context.Context, req *pbcs.GetDraftReq) (*pbcs.GetDraftResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	draft, err := s.client.DS.GetDraft(grpcKit.RpcCtx(), &pbds.GetDraftReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("get draft failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetDraftResp{Draft: draft}, nil
+}
+
+// MergeDraft merge the current user's draft into app's shared unreleased state
+func (s *Service) MergeDraft(ctx context.Context, req *pbcs.MergeDraftReq) (*pbcs.MergeDraftResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.MergeDraft(grpcKit.RpcCtx(), &pbds.MergeDraftReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	}); err != nil {
+		logs.Errorf("merge draft failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.MergeDraftResp{}, nil
+}
+
+// DiscardDraft discard the current user's draft
+func (s *Service) DiscardDraft(ctx context.Context, req *pbcs.DiscardDraftReq) (*pbcs.DiscardDraftResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DiscardDraft(grpcKit.RpcCtx(), &pbds.DiscardDraftReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	}); err != nil {
+		logs.Errorf("discard draft failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DiscardDraftResp{}, nil
+}
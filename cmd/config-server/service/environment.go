@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbenv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/environment"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateEnvironment create an environment
+func (s *Service) CreateEnvironment(ctx context.Context, req *pbcs.CreateEnvironmentReq) (
+	*pbcs.CreateEnvironmentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateEnvironment(grpcKit.RpcCtx(), &pbds.CreateEnvironmentReq{
+		Attachment: &pbenv.EnvironmentAttachment{
+			BizId: req.BizId,
+		},
+		Spec: &pbenv.EnvironmentSpec{
+			Name:     req.Name,
+			Memo:     req.Memo,
+			Position: req.Position,
+		},
+	})
+	if err != nil {
+		logs.Errorf("create environment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateEnvironmentResp{Id: rp.Id}, nil
+}
+
+// ListEnvironments list the environments of a biz
+func (s *Service) ListEnvironments(ctx context.Context, req *pbcs.ListEnvironmentsReq) (
+	*pbcs.ListEnvironmentsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListEnvironments(grpcKit.RpcCtx(), &pbds.ListEnvironmentsReq{BizId: req.BizId})
+	if err != nil {
+		logs.Errorf("list environments failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListEnvironmentsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateEnvironment update an environment
+func (s *Service) UpdateEnvironment(ctx context.Context, req *pbcs.UpdateEnvironmentReq) (
+	*pbcs.UpdateEnvironmentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateEnvironment(grpcKit.RpcCtx(), &pbds.UpdateEnvironmentReq{
+		Id: req.EnvId,
+		Attachment: &pbenv.EnvironmentAttachment{
+			BizId: req.BizId,
+		},
+		Spec: &pbenv.EnvironmentSpec{
+			Name:     req.Name,
+			Memo:     req.Memo,
+			Position: req.Position,
+		},
+	})
+	if err != nil {
+		logs.Errorf("update environment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateEnvironmentResp{}, nil
+}
+
+// DeleteEnvironment delete an environment
+func (s *Service) DeleteEnvironment(ctx context.Context, req *pbcs.DeleteEnvironmentReq) (
+	*pbcs.DeleteEnvironmentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteEnvironment(grpcKit.RpcCtx(), &pbds.DeleteEnvironmentReq{
+		BizId: req.BizId,
+		Id:    req.EnvId,
+	})
+	if err != nil {
+		logs.Errorf("delete environment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteEnvironmentResp{}, nil
+}
+
+// BindEnvApp bind an app to an environment
+func (s *Service) BindEnvApp(ctx context.Context, req *pbcs.BindEnvAppReq) (*pbcs.BindEnvAppResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.BindEnvApp(grpcKit.RpcCtx(), &pbds.BindEnvAppReq{
+		BizId:  req.BizId,
+		EnvId:  req.EnvId,
+		AppId:  req.AppId,
+		AppKey: req.AppKey,
+	})
+	if err != nil {
+		logs.Errorf("bind env app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.BindEnvAppResp{Id: rp.Id}, nil
+}
+
+// UnbindEnvApp unbind an app from its environment
+func (s *Service) UnbindEnvApp(ctx context.Context, req *pbcs.UnbindEnvAppReq) (*pbcs.UnbindEnvAppResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UnbindEnvApp(grpcKit.RpcCtx(), &pbds.UnbindEnvAppReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("unbind env app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UnbindEnvAppResp{}, nil
+}
+
+// ListEnvApps list the env app bindings
+func (s *Service) ListEnvApps(ctx context.Context, req *pbcs.ListEnvAppsReq) (*pbcs.ListEnvAppsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListEnvApps(grpcKit.RpcCtx(), &pbds.ListEnvAppsReq{
+		BizId:  req.BizId,
+		EnvId:  req.EnvId,
+		AppKey: req.AppKey,
+		Start:  req.Start,
+		Limit:  req.Limit,
+		All:    req.All,
+	})
+	if err != nil {
+		logs.Errorf("list env apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListEnvAppsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// PromoteRelease promote a release to the app with the same app key in the target environment
+func (s *Service) PromoteRelease(ctx context.Context, req *pbcs.PromoteReleaseReq) (*pbcs.PromoteReleaseResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	// 找到目标环境中相同服务标识的服务，需要同时有源服务的查看权限和目标服务的生成版本权限
+	src, err := s.client.DS.ListEnvApps(grpcKit.RpcCtx(), &pbds.ListEnvAppsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		All:   true,
+	})
+	if err != nil {
+		logs.Errorf("list env apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	if len(src.Details) == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "app is not bound to any environment"))
+	}
+
+	dst, err := s.client.DS.ListEnvApps(grpcKit.RpcCtx(), &pbds.ListEnvAppsReq{
+		BizId:  req.BizId,
+		EnvId:  req.TargetEnvId,
+		AppKey: src.Details[0].Spec.AppKey,
+		All:    true,
+	})
+	if err != nil {
+		logs.Errorf("list env apps failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	if len(dst.Details) == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit,
+			"there is no app with app key %s in the target environment", src.Details[0].Spec.AppKey))
+	}
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.GenerateRelease,
+			ResourceID: dst.Details[0].Attachment.AppId}, BizID: req.BizId},
+	}
+	if err = s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.PromoteRelease(grpcKit.RpcCtx(), &pbds.PromoteReleaseReq{
+		BizId:       req.BizId,
+		AppId:       req.AppId,
+		ReleaseId:   req.ReleaseId,
+		TargetEnvId: req.TargetEnvId,
+		Name:        req.Name,
+		Memo:        req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("promote release failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PromoteReleaseResp{
+		AppId:     rp.AppId,
+		ReleaseId: rp.ReleaseId,
+	}, nil
+}
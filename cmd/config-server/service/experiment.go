@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbexp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/experiment"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateExperiment create a kv experiment
+func (s *Service) CreateExperiment(ctx context.Context, req *pbcs.CreateExperimentReq) (
+	*pbcs.CreateExperimentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateExperiment(grpcKit.RpcCtx(), &pbds.CreateExperimentReq{
+		Attachment: &pbexp.ExperimentAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("create experiment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateExperimentResp{Id: rp.Id}, nil
+}
+
+// ListExperiments list the kv experiments of an app
+func (s *Service) ListExperiments(ctx context.Context, req *pbcs.ListExperimentsReq) (
+	*pbcs.ListExperimentsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListExperiments(grpcKit.RpcCtx(), &pbds.ListExperimentsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("list experiments failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListExperimentsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateExperiment update a kv experiment
+func (s *Service) UpdateExperiment(ctx context.Context, req *pbcs.UpdateExperimentReq) (
+	*pbcs.UpdateExperimentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateExperiment(grpcKit.RpcCtx(), &pbds.UpdateExperimentReq{
+		Id: req.ExperimentId,
+		Attachment: &pbexp.ExperimentAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update experiment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateExperimentResp{}, nil
+}
+
+// DeleteExperiment delete a kv experiment, the clients fall back to the released value
+func (s *Service) DeleteExperiment(ctx context.Context, req *pbcs.DeleteExperimentReq) (
+	*pbcs.DeleteExperimentResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteExperiment(grpcKit.RpcCtx(), &pbds.DeleteExperimentReq{
+		Id:    req.ExperimentId,
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("delete experiment failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteExperimentResp{}, nil
+}
+
+// GetExperimentStats get the exposure statistics of each variant of a kv experiment
+func (s *Service) GetExperimentStats(ctx context.Context, req *pbcs.GetExperimentStatsReq) (
+	*pbcs.GetExperimentStatsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetExperimentStats(grpcKit.RpcCtx(), &pbds.GetExperimentStatsReq{
+		Id:    req.ExperimentId,
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("get experiment stats failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetExperimentStatsResp{Details: rp.Details}, nil
+}
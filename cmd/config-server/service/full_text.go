@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbftd "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/full-text-doc"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// SearchFullText search the config item contents and kv values of the apps which the user can view
+func (s *Service) SearchFullText(ctx context.Context, req *pbcs.SearchFullTextReq) (*pbcs.SearchFullTextResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if req.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View,
+			ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	appIDs := []uint32{req.AppId}
+	if req.AppId == 0 {
+		var err error
+		if appIDs, err = s.listViewableAppIDs(grpcKit, req.BizId); err != nil {
+			return nil, err
+		}
+	}
+	if len(appIDs) == 0 {
+		return &pbcs.SearchFullTextResp{Details: make([]*pbftd.FullTextHit, 0)}, nil
+	}
+
+	rp, err := s.client.DS.SearchFullText(grpcKit.RpcCtx(), &pbds.SearchFullTextReq{
+		BizId:   req.BizId,
+		AppIds:  appIDs,
+		Keyword: req.Keyword,
+		ResType: req.ResType,
+		Start:   req.Start,
+		Limit:   req.Limit,
+	})
+	if err != nil {
+		logs.Errorf("search full-text failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.SearchFullTextResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// listViewableAppIDs list the ids of the apps in the biz which the user has permission to view.
+func (s *Service) listViewableAppIDs(kt *kit.Kit, bizID uint32) ([]uint32, error) {
+	apps, err := s.client.DS.ListAppsRest(kt.RpcCtx(), &pbds.ListAppsRestReq{
+		BizId: strconv.FormatUint(uint64(bizID), 10),
+		All:   true,
+	})
+	if err != nil {
+		logs.Errorf("list apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	authRes := make([]*meta.ResourceAttribute, 0, len(apps.Details))
+	for _, app := range apps.Details {
+		authRes = append(authRes, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View,
+			ResourceID: app.Id}, BizID: bizID})
+	}
+	if len(authRes) == 0 {
+		return []uint32{}, nil
+	}
+
+	decisions, _, err := s.authorizer.AuthorizeDecision(kt, authRes...)
+	if err != nil {
+		return nil, err
+	}
+
+	dMap := meta.DecisionsMap(decisions)
+	appIDs := make([]uint32, 0, len(authRes))
+	for _, one := range authRes {
+		if dMap[*one] {
+			appIDs = append(appIDs, one.ResourceID)
+		}
+	}
+
+	return appIDs, nil
+}
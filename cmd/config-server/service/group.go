@@ -324,6 +324,7 @@ func (s *Service) ListAppGroups(ctx context.Context, req *pbcs.ListAppGroupsReq)
 			OldSelector: detail.OldSelector,
 			NewSelector: detail.NewSelector,
 			Edited:      detail.Edited,
+			Pinned:      detail.Pinned,
 		}
 		respData = append(respData, data)
 	}
@@ -420,3 +421,38 @@ func (s *Service) ListGroupSelector(ctx context.Context, req *pbcs.ListGroupSele
 		Values: resp.GetValues(),
 	}, nil
 }
+
+// PreviewGroupClients preview the online clients which are currently matched by a group or a selector.
+func (s *Service) PreviewGroupClients(ctx context.Context, req *pbcs.PreviewGroupClientsReq) (
+	*pbcs.PreviewGroupClientsResp, error) {
+	kit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if req.AppId > 0 {
+		res = append(res, &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.View,
+			ResourceID: req.AppId}, BizID: req.BizId})
+	}
+	if err := s.authorizer.Authorize(kit, res...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DS.PreviewGroupClients(kit.RpcCtx(), &pbds.PreviewGroupClientsReq{
+		BizId:    req.BizId,
+		AppId:    req.AppId,
+		GroupId:  req.GroupId,
+		Selector: req.Selector,
+		Start:    req.Start,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.PreviewGroupClientsResp{
+		Count:     resp.GetCount(),
+		Details:   resp.GetDetails(),
+		Truncated: resp.GetTruncated(),
+	}, nil
+}
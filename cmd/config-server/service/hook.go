@@ -14,7 +14,6 @@ package service
 
 import (
 	"context"
-	"errors"
 	"sort"
 	"sync"
 
@@ -247,7 +246,7 @@ func (s *Service) ListHooks(ctx context.Context, req *pbcs.ListHooksReq) (*pbcs.
 
 	if !req.All {
 		if req.Limit == 0 {
-			return nil, errors.New("limit has to be greater than 0")
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "limit has to be greater than 0"))
 		}
 		r.Start = req.Start
 		r.Limit = req.Limit
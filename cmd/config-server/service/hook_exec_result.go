@@ -0,0 +1,56 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ListHookExecResults list the hook exec results reported by clients
+func (s *Service) ListHookExecResults(ctx context.Context, req *pbcs.ListHookExecResultsReq) (
+	*pbcs.ListHookExecResultsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(kt, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListHookExecResults(kt.RpcCtx(), &pbds.ListHookExecResultsReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		ReleaseId:  req.ReleaseId,
+		Uid:        req.Uid,
+		HookType:   req.HookType,
+		FailedOnly: req.FailedOnly,
+		Start:      req.Start,
+		Limit:      req.Limit,
+		All:        req.All,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbcs.ListHookExecResultsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
@@ -14,8 +14,9 @@ package service
 
 import (
 	"context"
-	"errors"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -83,7 +84,7 @@ func (s *Service) ListHookRevisions(ctx context.Context, req *pbcs.ListHookRevis
 
 	if !req.All {
 		if req.Limit == 0 {
-			return nil, errors.New("limit has to be greater than 0")
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "limit has to be greater than 0"))
 		}
 		r.Start = req.Start
 		r.Limit = req.Limit
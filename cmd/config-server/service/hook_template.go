@@ -0,0 +1,256 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbhtpl "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-template"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateHookTemplate create a hook template
+func (s *Service) CreateHookTemplate(ctx context.Context, req *pbcs.CreateHookTemplateReq) (
+	*pbcs.CreateHookTemplateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateHookTemplate(grpcKit.RpcCtx(), &pbds.CreateHookTemplateReq{
+		Attachment: &pbhtpl.HookTemplateAttachment{
+			BizId: req.BizId,
+		},
+		Spec: &pbhtpl.HookTemplateSpec{
+			Name:    req.Name,
+			Type:    req.Type,
+			Content: req.Content,
+			Params:  req.Params,
+			Memo:    req.Memo,
+		},
+	})
+	if err != nil {
+		logs.Errorf("create hook template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateHookTemplateResp{Id: rp.Id}, nil
+}
+
+// ListHookTemplates list hook templates
+func (s *Service) ListHookTemplates(ctx context.Context, req *pbcs.ListHookTemplatesReq) (
+	*pbcs.ListHookTemplatesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListHookTemplates(grpcKit.RpcCtx(), &pbds.ListHookTemplatesReq{
+		BizId:       req.BizId,
+		Name:        req.Name,
+		WithBuiltin: req.WithBuiltin,
+		Start:       req.Start,
+		Limit:       req.Limit,
+		All:         req.All,
+	})
+	if err != nil {
+		logs.Errorf("list hook templates failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListHookTemplatesResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateHookTemplate update a hook template
+func (s *Service) UpdateHookTemplate(ctx context.Context, req *pbcs.UpdateHookTemplateReq) (
+	*pbcs.UpdateHookTemplateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.UpdateHookTemplate(grpcKit.RpcCtx(), &pbds.UpdateHookTemplateReq{
+		Id: req.TemplateId,
+		Attachment: &pbhtpl.HookTemplateAttachment{
+			BizId: req.BizId,
+		},
+		Spec: &pbhtpl.HookTemplateSpec{
+			Type:    req.Type,
+			Content: req.Content,
+			Params:  req.Params,
+			Memo:    req.Memo,
+		},
+	})
+	if err != nil {
+		logs.Errorf("update hook template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateHookTemplateResp{
+		Version:         rp.Version,
+		UpgradedHookIds: rp.UpgradedHookIds,
+	}, nil
+}
+
+// DeleteHookTemplate delete a hook template
+func (s *Service) DeleteHookTemplate(ctx context.Context, req *pbcs.DeleteHookTemplateReq) (
+	*pbcs.DeleteHookTemplateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.DeleteHookTemplate(grpcKit.RpcCtx(), &pbds.DeleteHookTemplateReq{
+		BizId: req.BizId,
+		Id:    req.TemplateId,
+	}); err != nil {
+		logs.Errorf("delete hook template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbcs.DeleteHookTemplateResp), nil
+}
+
+// CreateHookFromTemplate create a hook from the hook template
+func (s *Service) CreateHookFromTemplate(ctx context.Context, req *pbcs.CreateHookFromTemplateReq) (
+	*pbcs.CreateHookResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateHookFromTemplate(grpcKit.RpcCtx(), &pbds.CreateHookFromTemplateReq{
+		BizId:       req.BizId,
+		TemplateId:  req.TemplateId,
+		Name:        req.Name,
+		Tags:        req.Tags,
+		Memo:        req.Memo,
+		Args:        req.Args,
+		AutoUpgrade: req.AutoUpgrade,
+	})
+	if err != nil {
+		logs.Errorf("create hook from template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateHookResp{Id: rp.Id}, nil
+}
+
+// UpgradeHookFromTemplate upgrade the hook to the latest version of its hook template
+func (s *Service) UpgradeHookFromTemplate(ctx context.Context, req *pbcs.UpgradeHookFromTemplateReq) (
+	*pbcs.UpgradeHookFromTemplateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.UpgradeHookFromTemplate(grpcKit.RpcCtx(), &pbds.UpgradeHookFromTemplateReq{
+		BizId:       req.BizId,
+		HookId:      req.HookId,
+		Args:        req.Args,
+		AutoUpgrade: req.AutoUpgrade,
+	}); err != nil {
+		logs.Errorf("upgrade hook from template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbcs.UpgradeHookFromTemplateResp), nil
+}
+
+// UpgradeHooksFromTemplate upgrade the hooks referencing the hook template in an async operation
+func (s *Service) UpgradeHooksFromTemplate(ctx context.Context, req *pbcs.UpgradeHooksFromTemplateReq) (
+	*pbcs.UpgradeHooksFromTemplateResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	op, err := s.client.DS.UpgradeHooksFromTemplate(grpcKit.RpcCtx(), &pbds.UpgradeHooksFromTemplateReq{
+		BizId:      req.BizId,
+		TemplateId: req.TemplateId,
+		HookIds:    req.HookIds,
+	})
+	if err != nil {
+		logs.Errorf("upgrade hooks from template failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpgradeHooksFromTemplateResp{OperationId: op.OperationId}, nil
+}
+
+// GetHookTemplateRef get the hook template which the hook is created from
+func (s *Service) GetHookTemplateRef(ctx context.Context, req *pbcs.GetHookTemplateRefReq) (
+	*pbcs.GetHookTemplateRefResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetHookTemplateRef(grpcKit.RpcCtx(), &pbds.GetHookTemplateRefReq{
+		BizId:  req.BizId,
+		HookId: req.HookId,
+	})
+	if err != nil {
+		logs.Errorf("get hook template ref failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.GetHookTemplateRefResp{
+		Ref:      rp.Ref,
+		Template: rp.Template,
+	}
+	if rp.Ref != nil && rp.Template != nil {
+		resp.Outdated = rp.Ref.TemplateVersion < rp.Template.GetSpec().GetVersion()
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	idempotentProcessing = "processing"
+	idempotentCompleted  = "completed"
+	maxIdempotencyKeyLen = 128
+)
+
+// IdempotencyUnaryServerInterceptor makes the mutating apis called with the Idempotency-Key header idempotent,
+// the retries with the same key return the original response instead of creating duplicate resources.
+func (s *Service) IdempotencyUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		key := idempotencyKey(ctx)
+		if key == "" || !brpc.IsMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		// 幂等键按业务隔离, 不带业务ID的接口不做处理
+		bizReq, ok := req.(interface{ GetBizId() uint32 })
+		if !ok {
+			return handler(ctx, req)
+		}
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		grpcKit := kit.FromGrpcContext(ctx)
+		if len(key) > maxIdempotencyKeyLen {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "idempotency key should be 1 to 128 characters"))
+		}
+
+		fingerprint, err := requestFingerprint(info.FullMethod, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		begin, err := s.client.DS.BeginIdempotentRequest(grpcKit.RpcCtx(), &pbds.BeginIdempotentRequestReq{
+			BizId:       bizReq.GetBizId(),
+			Key:         key,
+			Method:      info.FullMethod,
+			Fingerprint: fingerprint,
+		})
+		if err != nil {
+			logs.Errorf("begin idempotent request failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
+
+		switch begin.State {
+		case idempotentCompleted:
+			// 回放发生在接口鉴权之前, 需重新校验用户仍有权限查看该业务及服务
+			if err = s.authorizeReplay(grpcKit, req); err != nil {
+				return nil, err
+			}
+			logs.Infof("replay idempotent request, method: %s, key: %s, rid: %s", info.FullMethod, key, grpcKit.Rid)
+			return begin.Response.UnmarshalNew()
+		case idempotentProcessing:
+			return nil, errf.Errorf(errf.Aborted, i18n.T(grpcKit,
+				"idempotency key %s is being processed, please retry later", key))
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			// 失败的请求删除幂等记录, 以便使用相同的 key 重试
+			if _, aErr := s.client.DS.AbortIdempotentRequest(grpcKit.RpcCtx(),
+				&pbds.AbortIdempotentRequestReq{Id: begin.Id}); aErr != nil {
+				logs.Errorf("abort idempotent request failed, err: %v, rid: %s", aErr, grpcKit.Rid)
+			}
+			return resp, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+		anyResp, err := anypb.New(respMsg)
+		if err != nil {
+			logs.Errorf("marshal idempotent response failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return resp, nil
+		}
+		// 请求已成功执行, 记录响应失败不影响本次返回, 记录会在处理超时后失效
+		if _, err = s.client.DS.CompleteIdempotentRequest(grpcKit.RpcCtx(), &pbds.CompleteIdempotentRequestReq{
+			Id:       begin.Id,
+			Response: anyResp,
+		}); err != nil {
+			logs.Errorf("complete idempotent request failed, err: %v, rid: %s", err, grpcKit.Rid)
+		}
+
+		return resp, nil
+	}
+}
+
+// idempotencyKey returns the idempotency key in the grpc metadata.
+func idempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(constant.IdempotencyKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
+
+// authorizeReplay re-authorizes the user before replaying the recorded response, the idempotency records
+// are scoped by the biz and the user, but the user's permissions may have been revoked since then.
+func (s *Service) authorizeReplay(kt *kit.Kit, req interface{}) error {
+	bizID := req.(interface{ GetBizId() uint32 }).GetBizId()
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: bizID},
+	}
+	if appReq, ok := req.(interface{ GetAppId() uint32 }); ok && appReq.GetAppId() != 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: appReq.GetAppId()}, BizID: bizID})
+	}
+
+	return s.authorizer.Authorize(kt, res...)
+}
+
+// requestFingerprint returns the sha256 digest of the method and the request content.
+func requestFingerprint(method string, req proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -25,6 +25,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"golang.org/x/sync/errgroup"
 	"gopkg.in/yaml.v3"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
 	pbrkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-kv"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
@@ -57,6 +59,9 @@ func (s *Service) CreateKv(ctx context.Context, req *pbcs.CreateKvReq) (*pbcs.Cr
 	if err != nil {
 		return nil, err
 	}
+	if err = verifyKvExpiredAt(grpcKit, req.ExpiredAt); err != nil {
+		return nil, err
+	}
 
 	r := &pbds.CreateKvReq{
 		Attachment: &pbkv.KvAttachment{
@@ -71,6 +76,8 @@ func (s *Service) CreateKv(ctx context.Context, req *pbcs.CreateKvReq) (*pbcs.Cr
 			SecretType:                req.SecretType,
 			SecretHidden:              req.SecretHidden,
 			CertificateExpirationDate: expirationTime,
+			ExpiredAt:                 req.ExpiredAt,
+			ExpiredFallback:           req.ExpiredFallback,
 		},
 	}
 	rp, err := s.client.DS.CreateKv(grpcKit.RpcCtx(), r)
@@ -101,6 +108,9 @@ func (s *Service) UpdateKv(ctx context.Context, req *pbcs.UpdateKvReq) (*pbcs.Up
 	if err != nil {
 		return nil, err
 	}
+	if err = verifyKvExpiredAt(grpcKit, req.ExpiredAt); err != nil {
+		return nil, err
+	}
 
 	r := &pbds.UpdateKvReq{
 		Attachment: &pbkv.KvAttachment{
@@ -113,7 +123,10 @@ func (s *Service) UpdateKv(ctx context.Context, req *pbcs.UpdateKvReq) (*pbcs.Up
 			Memo:                      req.Memo,
 			SecretHidden:              req.SecretHidden,
 			CertificateExpirationDate: expirationTime,
+			ExpiredAt:                 req.ExpiredAt,
+			ExpiredFallback:           req.ExpiredFallback,
 		},
+		ExpectedRevision: expectedRevision(ctx, req.Revision),
 	}
 	if _, err := s.client.DS.UpdateKv(grpcKit.RpcCtx(), r); err != nil {
 		logs.Errorf("update kv failed, err: %v, rid: %s", err, grpcKit.Rid)
@@ -149,10 +162,12 @@ func (s *Service) ListKvs(ctx context.Context, req *pbcs.ListKvsReq) (*pbcs.List
 		Order:      req.Order,
 		TopIds:     req.TopIds,
 		Status:     req.Status,
+		CursorPage: req.CursorPage,
 	}
-	if !req.All {
+	// 游标分页时由 cursor_page.limit 控制分页大小
+	if !req.All && req.CursorPage == nil {
 		if req.Limit == 0 {
-			return nil, errors.New("limit has to be greater than 0")
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "limit has to be greater than 0"))
 		}
 		r.Start = req.Start
 		r.Limit = req.Limit
@@ -164,21 +179,33 @@ func (s *Service) ListKvs(ctx context.Context, req *pbcs.ListKvsReq) (*pbcs.List
 		return nil, err
 	}
 
-	// 敏感信息类型需要判断是否隐藏密码
+	// 敏感信息类型需要判断是否隐藏密码, 没有查看敏感信息权限时同样隐藏
+	visible := s.secretVisible(grpcKit, req.BizId, req.AppId)
+	masker, err := s.contentMasker(grpcKit, req.BizId, req.AppId, visible)
+	if err != nil {
+		return nil, err
+	}
 	for _, v := range rp.GetDetails() {
 		if v.Spec.KvType != string(table.KvSecret) {
+			// 没有查看敏感信息权限时按服务的脱敏规则处理
+			v.Spec.Value = maskContent(masker, v.Spec.Value)
 			continue
 		}
-		if v.Spec.SecretHidden {
+		if v.Spec.SecretHidden || !visible() {
 			v.Spec.Value = i18n.T(grpcKit, "sensitive data is not visible, unable to view actual content")
 		}
 	}
 
+	for _, v := range rp.GetDetails() {
+		pbbase.PruneFields(v, req.GetCursorPage().GetFields())
+	}
+
 	resp := &pbcs.ListKvsResp{
 		Count:          rp.Count,
 		Details:        rp.Details,
 		ExclusionCount: rp.GetExclusionCount(),
 		IsCertExpired:  rp.GetIsCertExpired(),
+		NextCursor:     rp.GetNextCursor(),
 	}
 
 	return resp, nil
@@ -341,6 +368,145 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbcs.BatchUpsertKvsRe
 	return &pbcs.BatchUpsertKvsResp{Ids: data.Ids}, nil
 }
 
+// TransactKvs upsert and delete multiple kvs in one transaction with an all-or-nothing guarantee.
+func (s *Service) TransactKvs(ctx context.Context, req *pbcs.TransactKvsReq) (*pbcs.TransactKvsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if len(req.Upserts) == 0 && len(req.DeleteKeys) == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "upserts and delete_keys can not both be empty"))
+	}
+
+	upserts := make([]*pbds.BatchUpsertKvsReq_Kv, 0, len(req.Upserts))
+	for _, kv := range req.Upserts {
+		expirationTime, err := verifySecretVaule(grpcKit, kv.SecretType, kv.Key, kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		upserts = append(upserts, &pbds.BatchUpsertKvsReq_Kv{
+			KvAttachment: &pbkv.KvAttachment{
+				BizId: req.BizId,
+				AppId: req.AppId,
+			},
+			KvSpec: &pbkv.KvSpec{
+				Key:                       kv.Key,
+				KvType:                    kv.KvType,
+				Value:                     kv.Value,
+				Memo:                      kv.Memo,
+				SecretType:                kv.SecretType,
+				SecretHidden:              kv.SecretHidden,
+				CertificateExpirationDate: expirationTime,
+			},
+		})
+	}
+
+	r := &pbds.TransactKvsReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		Upserts:    upserts,
+		DeleteKeys: req.DeleteKeys,
+	}
+	data, err := s.client.DS.TransactKvs(grpcKit.RpcCtx(), r)
+	if err != nil {
+		logs.Errorf("transact kvs failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.TransactKvsResp{Ids: data.Ids, DeletedKeys: data.DeletedKeys}, nil
+}
+
+// ListKvHistory list the history timeline of a kv.
+func (s *Service) ListKvHistory(ctx context.Context, req *pbcs.ListKvHistoryReq) (*pbcs.ListKvHistoryResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListKvHistory(grpcKit.RpcCtx(), &pbds.ListKvHistoryReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Key:   req.Key,
+	})
+	if err != nil {
+		logs.Errorf("list kv history failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListKvHistoryResp{Details: rp.Details}, nil
+}
+
+// DiffKvVersions compare the values of a kv in two versions.
+func (s *Service) DiffKvVersions(ctx context.Context, req *pbcs.DiffKvVersionsReq) (*pbcs.DiffKvVersionsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.DiffKvVersions(grpcKit.RpcCtx(), &pbds.DiffKvVersionsReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		Key:             req.Key,
+		BaseReleaseId:   req.BaseReleaseId,
+		TargetReleaseId: req.TargetReleaseId,
+	})
+	if err != nil {
+		logs.Errorf("diff kv versions failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	resp := &pbcs.DiffKvVersionsResp{
+		KvType:      rp.KvType,
+		BaseValue:   rp.BaseValue,
+		TargetValue: rp.TargetValue,
+		Diff:        rp.Diff,
+	}
+	// 敏感信息不返回实际内容
+	visible := s.secretVisible(grpcKit, req.BizId, req.AppId)
+	if rp.SecretHidden || (rp.KvType == string(table.KvSecret) && !visible()) {
+		hidden := i18n.T(grpcKit, "sensitive data is not visible, unable to view actual content")
+		resp.BaseValue, resp.TargetValue, resp.Diff = hidden, hidden, ""
+		return resp, nil
+	}
+	if rp.KvType == string(table.KvSecret) {
+		return resp, nil
+	}
+
+	// 没有查看敏感信息权限时按服务的脱敏规则处理, 并使用脱敏后的内容重新计算差异
+	masker, err := s.contentMasker(grpcKit, req.BizId, req.AppId, visible)
+	if err != nil || masker == nil {
+		return resp, err
+	}
+	resp.BaseValue, resp.TargetValue = maskContent(masker, rp.BaseValue), maskContent(masker, rp.TargetValue)
+	resp.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(resp.BaseValue),
+		B:        difflib.SplitLines(resp.TargetValue),
+		FromFile: rp.BaseLabel,
+		ToFile:   rp.TargetLabel,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 // UnDeleteKv reverses the deletion of a key-value pair by reverting the current kvType and value to the previous
 // version.
 func (s *Service) UnDeleteKv(ctx context.Context, req *pbcs.UnDeleteKvReq) (*pbcs.UnDeleteKvResp, error) {
@@ -420,11 +586,11 @@ func (s *Service) CompareKvConflicts(ctx context.Context, req *pbcs.CompareKvCon
 		}
 
 		if app1.Spec.ConfigType != string(table.KV) || app2.Spec.ConfigType != string(table.KV) {
-			return nil, errors.New("not a key-value type service")
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "not a key-value type service"))
 		}
 
 		if app1.Spec.DataType != string(table.KvAny) && app1.Spec.DataType != app2.Spec.DataType {
-			return nil, errors.New("the two service types do not match")
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "the two service types do not match"))
 		}
 	}
 
@@ -689,6 +855,8 @@ func validateKvType(kvType string) error {
 	case string(table.KvYAML):
 	case string(table.KvXml):
 	case string(table.KvSecret):
+	case string(table.KvBool), string(table.KvDuration), string(table.KvBytes), string(table.KvList),
+		string(table.KvCertBundle):
 	default:
 		return errors.New("invalid data-type")
 	}
@@ -785,6 +953,19 @@ func verifySecretVaule(kit *kit.Kit, secretType, key, value string) (string, err
 	return expirationTime, nil
 }
 
+// verifyKvExpiredAt verify the expired at of the kv is in RFC3339 format, empty means never expire.
+func verifyKvExpiredAt(kit *kit.Kit, expiredAt string) error {
+	if expiredAt == "" {
+		return nil
+	}
+
+	if _, err := time.Parse(time.RFC3339, expiredAt); err != nil {
+		return errors.New(i18n.T(kit, "invalid expired at %s, should be in RFC3339 format", expiredAt))
+	}
+
+	return nil
+}
+
 // BatchUnDeleteKv 批量恢复删除的kv
 func (s *Service) BatchUnDeleteKv(ctx context.Context, req *pbcs.BatchUnDeleteKvReq) (
 	*pbcs.BatchUnDeleteKvResp, error) {
@@ -988,3 +1169,33 @@ func validatePemContent(pemStr string) (string, error) {
 
 	return "", errors.New("no valid certificate or private key was recognized")
 }
+
+// CompareKvValue 按类型语义比较kv当前值与待修改的值
+func (s *Service) CompareKvValue(ctx context.Context, req *pbcs.CompareKvValueReq) (*pbcs.CompareKvValueResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CompareKvValue(grpcKit.RpcCtx(), &pbds.CompareKvValueReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Key:   req.Key,
+		Value: req.Value,
+	})
+	if err != nil {
+		logs.Errorf("compare kv value failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CompareKvValueResp{
+		KvType:  rp.KvType,
+		Equal:   rp.Equal,
+		Changes: rp.Changes,
+	}, nil
+}
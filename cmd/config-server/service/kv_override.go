@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateKvOverride create the override value of the kv for the instances matched by the group
+func (s *Service) CreateKvOverride(ctx context.Context, req *pbcs.CreateKvOverrideReq) (
+	*pbcs.CreateKvOverrideResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateKvOverride(grpcKit.RpcCtx(), &pbds.CreateKvOverrideReq{
+		BizId:    req.BizId,
+		AppId:    req.AppId,
+		Key:      req.Key,
+		GroupId:  req.GroupId,
+		Priority: req.Priority,
+		Value:    req.Value,
+		Memo:     req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("create kv override failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateKvOverrideResp{Id: rp.Id}, nil
+}
+
+// UpdateKvOverride update the override value of the kv
+func (s *Service) UpdateKvOverride(ctx context.Context, req *pbcs.UpdateKvOverrideReq) (
+	*pbcs.UpdateKvOverrideResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateKvOverride(grpcKit.RpcCtx(), &pbds.UpdateKvOverrideReq{
+		BizId:    req.BizId,
+		AppId:    req.AppId,
+		Id:       req.Id,
+		Priority: req.Priority,
+		Value:    req.Value,
+		Memo:     req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("update kv override failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateKvOverrideResp{}, nil
+}
+
+// DeleteKvOverride delete the override value of the kv
+func (s *Service) DeleteKvOverride(ctx context.Context, req *pbcs.DeleteKvOverrideReq) (
+	*pbcs.DeleteKvOverrideResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteKvOverride(grpcKit.RpcCtx(), &pbds.DeleteKvOverrideReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.Id,
+	})
+	if err != nil {
+		logs.Errorf("delete kv override failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteKvOverrideResp{}, nil
+}
+
+// ListKvOverrides list the override values of the kv
+func (s *Service) ListKvOverrides(ctx context.Context, req *pbcs.ListKvOverridesReq) (
+	*pbcs.ListKvOverridesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListKvOverrides(grpcKit.RpcCtx(), &pbds.ListKvOverridesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Key:   req.Key,
+	})
+	if err != nil {
+		logs.Errorf("list kv overrides failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	// 敏感信息类型需要判断是否隐藏密码, 没有查看敏感信息权限时同样隐藏
+	visible := s.secretVisible(grpcKit, req.BizId, req.AppId)
+	if rp.SecretHidden || (rp.KvType == string(table.KvSecret) && !visible()) {
+		for _, v := range rp.Details {
+			v.Spec.Value = i18n.T(grpcKit, "sensitive data is not visible, unable to view actual content")
+		}
+	} else if rp.KvType != string(table.KvSecret) {
+		// 没有查看敏感信息权限时按服务的脱敏规则处理
+		masker, err := s.contentMasker(grpcKit, req.BizId, req.AppId, visible)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range rp.Details {
+			v.Spec.Value = maskContent(masker, v.Spec.Value)
+		}
+	}
+
+	return &pbcs.ListKvOverridesResp{Details: rp.Details}, nil
+}
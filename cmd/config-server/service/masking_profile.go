@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/masking"
+)
+
+// GetMaskingProfile get the masking profile of an app
+func (s *Service) GetMaskingProfile(ctx context.Context, req *pbcs.GetMaskingProfileReq) (
+	*pbcs.GetMaskingProfileResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetMaskingProfile(grpcKit.RpcCtx(), &pbds.GetMaskingProfileReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("get masking profile failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetMaskingProfileResp{Profile: rp.Profile}, nil
+}
+
+// UpdateMaskingProfile update the masking profile of an app, only the user who can view the sensitive
+// data of the app is allowed, otherwise the user can disable the masking to see the raw content.
+func (s *Service) UpdateMaskingProfile(ctx context.Context, req *pbcs.UpdateMaskingProfileReq) (
+	*pbcs.UpdateMaskingProfileResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: req.AppId}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.ViewSecret, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateMaskingProfile(grpcKit.RpcCtx(), &pbds.UpdateMaskingProfileReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update masking profile failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateMaskingProfileResp{}, nil
+}
+
+// PreviewMasking mask the sample content with the spec in request or the app's masking profile
+func (s *Service) PreviewMasking(ctx context.Context, req *pbcs.PreviewMaskingReq) (*pbcs.PreviewMaskingResp,
+	error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	spec := req.Spec
+	if spec == nil {
+		rp, err := s.client.DS.GetMaskingProfile(grpcKit.RpcCtx(), &pbds.GetMaskingProfileReq{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		})
+		if err != nil {
+			logs.Errorf("get masking profile failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
+		spec = rp.Profile.GetSpec()
+	}
+
+	ms := spec.MaskingProfileSpec()
+	if ms == nil {
+		return &pbcs.PreviewMaskingResp{Content: req.Content}, nil
+	}
+	if err := ms.Validate(); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+	// 预览时忽略启用状态, 便于启用前验证规则
+	ms.Enabled = true
+	masker, err := ms.Masker()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	content, masked := masker.Mask(req.Content)
+	return &pbcs.PreviewMaskingResp{Content: content, Masked: masked}, nil
+}
+
+// contentMasker returns the masker of the app for the user who can not view the sensitive data,
+// nil means the content is returned as it is.
+func (s *Service) contentMasker(kt *kit.Kit, bizID, appID uint32, visible func() bool) (*masking.Masker, error) {
+	if visible() {
+		return nil, nil
+	}
+
+	rp, err := s.client.DS.GetMaskingProfile(kt.RpcCtx(), &pbds.GetMaskingProfileReq{
+		BizId: bizID,
+		AppId: appID,
+	})
+	if err != nil {
+		logs.Errorf("get masking profile of app %d failed, err: %v, rid: %s", appID, err, kt.Rid)
+		return nil, err
+	}
+
+	ms := rp.Profile.GetSpec().MaskingProfileSpec()
+	if ms == nil {
+		return nil, nil
+	}
+	masker, err := ms.Masker()
+	if err != nil {
+		logs.Errorf("build masker of app %d failed, err: %v, rid: %s", appID, err, kt.Rid)
+		return nil, err
+	}
+
+	return masker, nil
+}
+
+// maskContent masks the content with the masker, nil masker returns the content as it is.
+func maskContent(masker *masking.Masker, content string) string {
+	masked, _ := masker.Mask(content)
+	return masked
+}
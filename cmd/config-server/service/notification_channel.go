@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbnc "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/notification-channel"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// bizOrAppViewRes returns the resources to authorize of the biz or app level resources, such as the
+// notification channels and alert rules, the app level one requires the view permission of the app.
+func bizOrAppViewRes(bizID, appID uint32) []*meta.ResourceAttribute {
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: bizID},
+	}
+	if appID > 0 {
+		res = append(res, &meta.ResourceAttribute{
+			Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: appID}, BizID: bizID})
+	}
+
+	return res
+}
+
+// CreateNotificationChannel create a notification channel
+func (s *Service) CreateNotificationChannel(ctx context.Context, req *pbcs.CreateNotificationChannelReq) (
+	*pbcs.CreateNotificationChannelResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateNotificationChannel(grpcKit.RpcCtx(), &pbds.CreateNotificationChannelReq{
+		Attachment: &pbnc.NotificationChannelAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("create notification channel failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateNotificationChannelResp{Id: rp.Id}, nil
+}
+
+// ListNotificationChannels list the notification channels of a biz
+func (s *Service) ListNotificationChannels(ctx context.Context, req *pbcs.ListNotificationChannelsReq) (
+	*pbcs.ListNotificationChannelsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListNotificationChannels(grpcKit.RpcCtx(), &pbds.ListNotificationChannelsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+	})
+	if err != nil {
+		logs.Errorf("list notification channels failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListNotificationChannelsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateNotificationChannel update a notification channel
+func (s *Service) UpdateNotificationChannel(ctx context.Context, req *pbcs.UpdateNotificationChannelReq) (
+	*pbcs.UpdateNotificationChannelResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, req.AppId)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateNotificationChannel(grpcKit.RpcCtx(), &pbds.UpdateNotificationChannelReq{
+		Id: req.ChannelId,
+		Attachment: &pbnc.NotificationChannelAttachment{
+			BizId: req.BizId,
+			AppId: req.AppId,
+		},
+		Spec: req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update notification channel failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateNotificationChannelResp{}, nil
+}
+
+// DeleteNotificationChannel delete a notification channel
+func (s *Service) DeleteNotificationChannel(ctx context.Context, req *pbcs.DeleteNotificationChannelReq) (
+	*pbcs.DeleteNotificationChannelResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteNotificationChannel(grpcKit.RpcCtx(), &pbds.DeleteNotificationChannelReq{
+		Id:    req.ChannelId,
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("delete notification channel failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteNotificationChannelResp{}, nil
+}
+
+// TestNotificationChannel send a test message to the notification channel
+func (s *Service) TestNotificationChannel(ctx context.Context, req *pbcs.TestNotificationChannelReq) (
+	*pbcs.TestNotificationChannelResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	if err := s.authorizer.Authorize(grpcKit, bizOrAppViewRes(req.BizId, 0)...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.TestNotificationChannel(grpcKit.RpcCtx(), &pbds.TestNotificationChannelReq{
+		Id:    req.ChannelId,
+		BizId: req.BizId,
+		Event: req.Event,
+	})
+	if err != nil {
+		logs.Errorf("test notification channel failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.TestNotificationChannelResp{}, nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// GetOperation get the progress and result of the async operation
+func (s *Service) GetOperation(ctx context.Context, req *pbcs.GetOperationReq) (*pbcs.GetOperationResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	op, err := s.client.DS.GetOperation(grpcKit.RpcCtx(), &pbds.GetOperationReq{
+		BizId: req.BizId,
+		Id:    req.OperationId,
+	})
+	if err != nil {
+		logs.Errorf("get operation failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetOperationResp{Operation: op}, nil
+}
+
+// ListOperations list the async operations of the biz
+func (s *Service) ListOperations(ctx context.Context, req *pbcs.ListOperationsReq) (*pbcs.ListOperationsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListOperations(grpcKit.RpcCtx(), &pbds.ListOperationsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		logs.Errorf("list operations failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListOperationsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// CancelOperation cancel the unfinished async operation
+func (s *Service) CancelOperation(ctx context.Context, req *pbcs.CancelOperationReq) (
+	*pbcs.CancelOperationResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.CancelOperation(grpcKit.RpcCtx(), &pbds.CancelOperationReq{
+		BizId: req.BizId,
+		Id:    req.OperationId,
+	}); err != nil {
+		logs.Errorf("cancel operation failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return new(pbcs.CancelOperationResp), nil
+}
@@ -50,6 +50,8 @@ func (s *Service) Publish(ctx context.Context, req *pbcs.PublishReq) (
 		Groups:          req.Groups,
 		Labels:          req.Labels,
 		GroupName:       req.GroupName,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
 	}
 	rp, err := s.client.DS.Publish(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -58,9 +60,11 @@ func (s *Service) Publish(ctx context.Context, req *pbcs.PublishReq) (
 	}
 
 	resp := &pbcs.PublishResp{
-		Id:              rp.PublishedStrategyHistoryId,
-		HaveCredentials: rp.HaveCredentials,
-		HavePull:        rp.HavePull,
+		Id:                 rp.PublishedStrategyHistoryId,
+		HaveCredentials:    rp.HaveCredentials,
+		HavePull:           rp.HavePull,
+		ComplianceWarnings: rp.ComplianceWarnings,
+		Overlaps:           rp.Overlaps,
 	}
 	return resp, nil
 }
@@ -94,6 +98,8 @@ func (s *Service) SubmitPublishApprove(ctx context.Context, req *pbcs.SubmitPubl
 		PublishType:     req.PublishType,
 		PublishTime:     req.PublishTime,
 		IsCompare:       req.IsCompare,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
 	}
 	rp, err := s.client.DS.SubmitPublishApprove(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -102,9 +108,11 @@ func (s *Service) SubmitPublishApprove(ctx context.Context, req *pbcs.SubmitPubl
 	}
 
 	resp := &pbcs.PublishResp{
-		Id:              rp.PublishedStrategyHistoryId,
-		HaveCredentials: rp.HaveCredentials,
-		HavePull:        rp.HavePull,
+		Id:                 rp.PublishedStrategyHistoryId,
+		HaveCredentials:    rp.HaveCredentials,
+		HavePull:           rp.HavePull,
+		ComplianceWarnings: rp.ComplianceWarnings,
+		Overlaps:           rp.Overlaps,
 	}
 	return resp, nil
 }
@@ -190,6 +198,9 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbcs.Gener
 		Groups:          req.Groups,
 		Labels:          req.Labels,
 		GroupName:       req.GroupName,
+		ReleaseNotes:    req.ReleaseNotes,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
 	}
 	rp, err := s.client.DS.GenerateReleaseAndPublish(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -198,7 +209,150 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbcs.Gener
 	}
 
 	resp := &pbcs.PublishResp{
-		Id: rp.PublishedStrategyHistoryId,
+		Id:                 rp.PublishedStrategyHistoryId,
+		ComplianceWarnings: rp.ComplianceWarnings,
+		Overlaps:           rp.Overlaps,
 	}
 	return resp, nil
 }
+
+// EmergencyPublish publish a release to an explicit list of instances temporarily.
+func (s *Service) EmergencyPublish(ctx context.Context, req *pbcs.EmergencyPublishReq) (
+	*pbcs.EmergencyPublishResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DS.EmergencyPublish(grpcKit.RpcCtx(), &pbds.EmergencyPublishReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		ReleaseId:  req.ReleaseId,
+		Uids:       req.Uids,
+		TtlSeconds: req.TtlSeconds,
+		Memo:       req.Memo,
+	})
+	if err != nil {
+		logs.Errorf("emergency publish failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.EmergencyPublishResp{
+		Id:         resp.Id,
+		GroupId:    resp.GroupId,
+		StrategyId: resp.StrategyId,
+	}, nil
+}
+
+// ListEmergencyPublishes list emergency publishes of an app.
+func (s *Service) ListEmergencyPublishes(ctx context.Context, req *pbcs.ListEmergencyPublishesReq) (
+	*pbcs.ListEmergencyPublishesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.View, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.DS.ListEmergencyPublishes(grpcKit.RpcCtx(), &pbds.ListEmergencyPublishesReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		State: req.State,
+		Start: req.Start,
+		Limit: req.Limit,
+		All:   req.All,
+	})
+	if err != nil {
+		logs.Errorf("list emergency publishes failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListEmergencyPublishesResp{
+		Count:   resp.Count,
+		Details: resp.Details,
+	}, nil
+}
+
+// RevokeEmergencyPublish revoke an active emergency publish before it's expired.
+func (s *Service) RevokeEmergencyPublish(ctx context.Context, req *pbcs.RevokeEmergencyPublishReq) (
+	*pbcs.RevokeEmergencyPublishResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.RevokeEmergencyPublish(grpcKit.RpcCtx(), &pbds.RevokeEmergencyPublishReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Id:    req.Id,
+	}); err != nil {
+		logs.Errorf("revoke emergency publish failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RevokeEmergencyPublishResp{}, nil
+}
+
+// PinReleasedGroup pin the online group to it's release, the subsequent publishes skip it until it's unpinned.
+func (s *Service) PinReleasedGroup(ctx context.Context, req *pbcs.PinReleasedGroupReq) (
+	*pbcs.PinReleasedGroupResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.PinReleasedGroup(grpcKit.RpcCtx(), &pbds.PinReleasedGroupReq{
+		BizId:     req.BizId,
+		AppId:     req.AppId,
+		GroupId:   req.GroupId,
+		ReleaseId: req.ReleaseId,
+	}); err != nil {
+		logs.Errorf("pin released group failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.PinReleasedGroupResp{}, nil
+}
+
+// UnpinReleasedGroup unpin the group, it keeps the current release until the next publish.
+func (s *Service) UnpinReleasedGroup(ctx context.Context, req *pbcs.UnpinReleasedGroupReq) (
+	*pbcs.UnpinReleasedGroupResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+		{Basic: meta.Basic{Type: meta.App, Action: meta.Publish, ResourceID: req.AppId}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.client.DS.UnpinReleasedGroup(grpcKit.RpcCtx(), &pbds.UnpinReleasedGroupReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		GroupId: req.GroupId,
+	}); err != nil {
+		logs.Errorf("unpin released group failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UnpinReleasedGroupResp{}, nil
+}
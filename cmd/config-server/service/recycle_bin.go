@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ListRecycleBin list the deleted resources in recycle bin
+func (s *Service) ListRecycleBin(ctx context.Context, req *pbcs.ListRecycleBinReq) (*pbcs.ListRecycleBinResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListRecycleBin(grpcKit.RpcCtx(), &pbds.ListRecycleBinReq{
+		BizId:   req.BizId,
+		AppId:   req.AppId,
+		ResType: req.ResType,
+		Start:   req.Start,
+		Limit:   req.Limit,
+		All:     req.All,
+	})
+	if err != nil {
+		logs.Errorf("list recycle bin failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListRecycleBinResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// RestoreRecycleBin restore a deleted resource in recycle bin
+func (s *Service) RestoreRecycleBin(ctx context.Context, req *pbcs.RestoreRecycleBinReq) (
+	*pbcs.RestoreRecycleBinResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rb, err := s.client.DS.GetRecycleBin(grpcKit.RpcCtx(), &pbds.GetRecycleBinReq{
+		BizId: req.BizId,
+		Id:    req.Id,
+	})
+	if err != nil {
+		logs.Errorf("get recycle bin failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	// restoring an app needs the permission to create app, others need the permission to update the app.
+	appRes := &meta.ResourceAttribute{
+		Basic: meta.Basic{Type: meta.App, Action: meta.Update, ResourceID: rb.Attachment.AppId}, BizID: req.BizId}
+	if rb.Spec.ResType == string(table.RecycleApp) {
+		appRes = &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.App, Action: meta.Create}, BizID: req.BizId}
+	}
+	if err = s.authorizer.Authorize(grpcKit, appRes); err != nil {
+		return nil, err
+	}
+
+	if _, err = s.client.DS.RestoreRecycleBin(grpcKit.RpcCtx(), &pbds.RestoreRecycleBinReq{
+		BizId: req.BizId,
+		Id:    req.Id,
+	}); err != nil {
+		logs.Errorf("restore recycle bin failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.RestoreRecycleBinResp{}, nil
+}
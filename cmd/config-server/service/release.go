@@ -19,6 +19,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbrelease "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 )
@@ -44,8 +45,9 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbcs.CreateReleaseReq)
 			AppId: req.AppId,
 		},
 		Spec: &pbrelease.ReleaseSpec{
-			Name: req.Name,
-			Memo: req.Memo,
+			Name:  req.Name,
+			Memo:  req.Memo,
+			Notes: req.Notes,
 		},
 		Variables: req.Variables,
 	}
@@ -81,6 +83,7 @@ func (s *Service) ListReleases(ctx context.Context, req *pbcs.ListReleasesReq) (
 		Limit:      req.Limit,
 		All:        req.All,
 		SearchKey:  req.SearchKey,
+		CursorPage: req.CursorPage,
 	}
 	rp, err := s.client.DS.ListReleases(grpcKit.RpcCtx(), r)
 	if err != nil {
@@ -88,9 +91,14 @@ func (s *Service) ListReleases(ctx context.Context, req *pbcs.ListReleasesReq) (
 		return nil, err
 	}
 
+	for _, rel := range rp.Details {
+		pbbase.PruneFields(rel, req.GetCursorPage().GetFields())
+	}
+
 	resp := &pbcs.ListReleasesResp{
-		Count:   rp.Count,
-		Details: rp.Details,
+		Count:      rp.Count,
+		Details:    rp.Details,
+		NextCursor: rp.NextCursor,
 	}
 	return resp, nil
 }
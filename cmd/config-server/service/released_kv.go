@@ -89,12 +89,19 @@ func (s *Service) ListReleasedKvs(ctx context.Context, req *pbcs.ListReleasedKvs
 		return nil, err
 	}
 
-	// 敏感信息类型需要判断是否隐藏密码
+	// 敏感信息类型需要判断是否隐藏密码, 没有查看敏感信息权限时同样隐藏
+	visible := s.secretVisible(grpcKit, req.BizId, req.AppId)
+	masker, err := s.contentMasker(grpcKit, req.BizId, req.AppId, visible)
+	if err != nil {
+		return nil, err
+	}
 	for _, v := range rkv.GetDetails() {
 		if v.Spec.KvType != string(table.KvSecret) {
+			// 没有查看敏感信息权限时按服务的脱敏规则处理
+			v.Spec.Value = maskContent(masker, v.Spec.Value)
 			continue
 		}
-		if v.Spec.SecretHidden {
+		if v.Spec.SecretHidden || !visible() {
 			v.Spec.Value = i18n.T(grpcKit, "sensitive data is not visible, unable to view actual content")
 		}
 	}
@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// GetSecretScanSetting get the secret scan setting of a biz
+func (s *Service) GetSecretScanSetting(ctx context.Context, req *pbcs.GetSecretScanSettingReq) (
+	*pbcs.GetSecretScanSettingResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.GetSecretScanSetting(grpcKit.RpcCtx(), &pbds.GetSecretScanSettingReq{
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.GetSecretScanSettingResp{
+		Setting:      rp.Setting,
+		BuiltinRules: rp.BuiltinRules,
+	}, nil
+}
+
+// UpdateSecretScanSetting update the secret scan setting of a biz
+func (s *Service) UpdateSecretScanSetting(ctx context.Context, req *pbcs.UpdateSecretScanSettingReq) (
+	*pbcs.UpdateSecretScanSettingResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateSecretScanSetting(grpcKit.RpcCtx(), &pbds.UpdateSecretScanSettingReq{
+		BizId: req.BizId,
+		Spec:  req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("update secret scan setting failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateSecretScanSettingResp{}, nil
+}
+
+// ScanSecretLeak scan the sample content for suspected credentials
+func (s *Service) ScanSecretLeak(ctx context.Context, req *pbcs.ScanSecretLeakReq) (*pbcs.ScanSecretLeakResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ScanSecretLeak(grpcKit.RpcCtx(), &pbds.ScanSecretLeakReq{
+		BizId:   req.BizId,
+		Name:    req.Name,
+		Content: req.Content,
+		Spec:    req.Spec,
+	})
+	if err != nil {
+		logs.Errorf("scan secret leak failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ScanSecretLeakResp{Findings: rp.Findings}, nil
+}
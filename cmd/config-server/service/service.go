@@ -24,6 +24,8 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	esbcli "github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -87,6 +89,9 @@ func newClientSet(sd serviced.Discover, tls cc.TLSConfig) (*ClientSet, error) {
 
 	// add dial load balancer.
 	opts = append(opts, sd.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	opts = append(opts, grpc.WithChainUnaryInterceptor(brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor(), chaos.UnaryClientInterceptor()))
 
 	if !tls.Enable() {
 		// dial without ssl
@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateServiceAccount create a service account, the api key is only returned once
+func (s *Service) CreateServiceAccount(ctx context.Context, req *pbcs.CreateServiceAccountReq) (
+	*pbcs.CreateServiceAccountResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	// 服务账号与服务密钥同属凭证, 使用密钥管理权限
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.CreateServiceAccount(grpcKit.RpcCtx(), &pbds.CreateServiceAccountReq{
+		BizId:     req.BizId,
+		Name:      req.Name,
+		Memo:      req.Memo,
+		Scopes:    req.Scopes,
+		ExpiredAt: req.ExpiredAt,
+	})
+	if err != nil {
+		logs.Errorf("create service account failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.CreateServiceAccountResp{Id: rp.Id, ApiKey: rp.ApiKey}, nil
+}
+
+// ListServiceAccounts list the service accounts of a biz
+func (s *Service) ListServiceAccounts(ctx context.Context, req *pbcs.ListServiceAccountsReq) (
+	*pbcs.ListServiceAccountsResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListServiceAccounts(grpcKit.RpcCtx(), &pbds.ListServiceAccountsReq{
+		BizId: req.BizId,
+	})
+	if err != nil {
+		logs.Errorf("list service accounts failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListServiceAccountsResp{
+		Count:   rp.Count,
+		Details: rp.Details,
+	}, nil
+}
+
+// UpdateServiceAccount update a service account
+func (s *Service) UpdateServiceAccount(ctx context.Context, req *pbcs.UpdateServiceAccountReq) (
+	*pbcs.UpdateServiceAccountResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.UpdateServiceAccount(grpcKit.RpcCtx(), &pbds.UpdateServiceAccountReq{
+		BizId:     req.BizId,
+		Id:        req.AccountId,
+		Memo:      req.Memo,
+		Scopes:    req.Scopes,
+		Enabled:   req.Enabled,
+		ExpiredAt: req.ExpiredAt,
+	})
+	if err != nil {
+		logs.Errorf("update service account failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.UpdateServiceAccountResp{}, nil
+}
+
+// DeleteServiceAccount delete a service account
+func (s *Service) DeleteServiceAccount(ctx context.Context, req *pbcs.DeleteServiceAccountReq) (
+	*pbcs.DeleteServiceAccountResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	_, err := s.client.DS.DeleteServiceAccount(grpcKit.RpcCtx(), &pbds.DeleteServiceAccountReq{
+		BizId: req.BizId,
+		Id:    req.AccountId,
+	})
+	if err != nil {
+		logs.Errorf("delete service account failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.DeleteServiceAccountResp{}, nil
+}
+
+// ResetServiceAccountKey reset the api key of a service account
+func (s *Service) ResetServiceAccountKey(ctx context.Context, req *pbcs.ResetServiceAccountKeyReq) (
+	*pbcs.ResetServiceAccountKeyResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Credential, Action: meta.Manage}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ResetServiceAccountKey(grpcKit.RpcCtx(), &pbds.ResetServiceAccountKeyReq{
+		BizId: req.BizId,
+		Id:    req.AccountId,
+	})
+	if err != nil {
+		logs.Errorf("reset service account key failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ResetServiceAccountKeyResp{ApiKey: rp.ApiKey}, nil
+}
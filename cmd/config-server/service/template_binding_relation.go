@@ -17,6 +17,8 @@ import (
 	"fmt"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -33,7 +35,8 @@ func (s *Service) ListTmplBoundCounts(ctx context.Context, req *pbcs.ListTmplBou
 	// validate input param
 	ids := tools.SliceRepeatedElements(req.TemplateIds)
 	if len(ids) > 0 {
-		return nil, fmt.Errorf("repeated template ids: %v, id must be unique", ids)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "repeated template ids: %v, id must be unique",
+			ids))
 	}
 	idsLen := len(req.TemplateIds)
 	if idsLen == 0 || idsLen > constant.ArrayInputLenLimit {
@@ -116,7 +119,8 @@ func (s *Service) ListTmplSetBoundCounts(ctx context.Context, req *pbcs.ListTmpl
 	// validate input param
 	ids := tools.SliceRepeatedElements(req.TemplateSetIds)
 	if len(ids) > 0 {
-		return nil, fmt.Errorf("repeated template set ids: %v, id must be unique", ids)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(grpcKit, "repeated template set ids: %v, id must be unique",
+			ids))
 	}
 	idsLen := len(req.TemplateSetIds)
 	if idsLen <= 0 || idsLen > constant.ArrayInputLenLimit {
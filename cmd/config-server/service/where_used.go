@@ -0,0 +1,75 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// ListTmplWhereUsed list the apps, config items and published releases which use the template
+func (s *Service) ListTmplWhereUsed(ctx context.Context, req *pbcs.ListTmplWhereUsedReq) (
+	*pbcs.ListTmplWhereUsedResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListTmplWhereUsed(grpcKit.RpcCtx(), &pbds.ListTmplWhereUsedReq{
+		BizId:           req.BizId,
+		TemplateSpaceId: req.TemplateSpaceId,
+		TemplateId:      req.TemplateId,
+	})
+	if err != nil {
+		logs.Errorf("list template where used failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListTmplWhereUsedResp{
+		Details: rp.Details,
+	}, nil
+}
+
+// ListTmplVariableWhereUsed list the apps, config items and published releases which use the template variable
+func (s *Service) ListTmplVariableWhereUsed(ctx context.Context, req *pbcs.ListTmplVariableWhereUsedReq) (
+	*pbcs.ListTmplVariableWhereUsedResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	res := []*meta.ResourceAttribute{
+		{Basic: meta.Basic{Type: meta.Biz, Action: meta.FindBusinessResource}, BizID: req.BizId},
+	}
+	if err := s.authorizer.Authorize(grpcKit, res...); err != nil {
+		return nil, err
+	}
+
+	rp, err := s.client.DS.ListTmplVariableWhereUsed(grpcKit.RpcCtx(), &pbds.ListTmplVariableWhereUsedReq{
+		BizId:              req.BizId,
+		TemplateVariableId: req.TemplateVariableId,
+	})
+	if err != nil {
+		logs.Errorf("list template variable where used failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	return &pbcs.ListTmplVariableWhereUsedResp{
+		Details: rp.Details,
+	}, nil
+}
@@ -29,6 +29,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	// run the init function to add migrations
+	_ "github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrations"
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/options"
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service/crontab"
@@ -37,7 +40,9 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl/cmd"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/scheduler"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
 	"github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
@@ -61,39 +66,51 @@ func RunServer(sysOpt *cc.SysOption) {
 
 // Run start the data service
 func Run(opt *options.Option) error {
+	finalizer, err := Start(opt)
+	if err != nil {
+		return err
+	}
+
+	shutdown.RegisterFirstShutdown(finalizer)
+	shutdown.WaitShutdown(20)
+	return nil
+}
+
+// Start start the data service without waiting for it to shut down, the returned finalizer deregisters the service,
+// it should be called first when shutting down.
+func Start(opt *options.Option) (func(), error) {
 	ds := new(dataService)
 	if err := ds.prepare(opt); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.listenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.gwListenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := ds.register(); err != nil {
-		return err
+		return nil, err
 	}
 
-	shutdown.RegisterFirstShutdown(ds.finalizer)
-	shutdown.WaitShutdown(20)
-	return nil
+	return ds.finalizer, nil
 }
 
 type dataService struct {
-	serve    *grpc.Server
-	gwServe  *http.Server
-	service  *service.Service
-	sd       serviced.Service
-	daoSet   dao.Set
-	vault    vault.Set
-	esb      client.Client
-	spaceMgr *space.Manager
-	repo     repository.Provider
-	ssd      serviced.ServiceDiscover
+	serve     *grpc.Server
+	gwServe   *http.Server
+	service   *service.Service
+	sd        serviced.Service
+	daoSet    dao.Set
+	vault     vault.Set
+	esb       client.Client
+	spaceMgr  *space.Manager
+	repo      repository.Provider
+	ssd       serviced.ServiceDiscover
+	scheduler *scheduler.Scheduler
 }
 
 // prepare do prepare jobs before run data service.
@@ -107,15 +124,20 @@ func (ds *dataService) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.RepoCredentialSection) {
+			repository.UpdateCredentials(cc.DataService().Repo)
+		}
+	})
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.DataService().Network.BindIP,
 		strconv.Itoa(int(cc.DataService().Network.RpcPort))))
 
-	etcdOpt, err := cc.DataService().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register data service.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.DataServiceName,
@@ -123,25 +145,39 @@ func (ds *dataService) prepare(opt *options.Option) error {
 		Port: cc.DataService().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewService(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithSetting(cc.DataService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service faield, err: %v", err)
 	}
 
 	ds.sd = sd
 
-	ssd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	ssd, err := serviced.NewServiceDWithSetting(cc.DataService().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service faield, err: %v", err)
 	}
 
 	ds.ssd = ssd
 
+	elec, err := sd.NewElection("scheduler")
+	if err != nil {
+		return fmt.Errorf("new scheduler election failed, err: %v", err)
+	}
+	ds.scheduler = scheduler.New(elec)
+	// 只读维护期间暂停后台任务, 避免任务写入数据库
+	ds.scheduler.PauseWhen(func() bool { return sd.Maintenance().ReadOnly().Enabled })
+
 	// init bscp control tool
-	if err = ctl.LoadCtl(ctl.WithBasics(sd)...); err != nil {
+	if err = ctl.LoadCtl(append(ctl.WithBasics(sd), cmd.WithJobs(ds.scheduler)...)...); err != nil {
 		return fmt.Errorf("load control tool failed, err: %v", err)
 	}
 
+	if cc.DataService().Migration.AutoMigrate {
+		if err = autoMigrate(); err != nil {
+			return fmt.Errorf("auto migrate db schema failed, err: %v", err)
+		}
+	}
+
 	// initial DAO set
 	set, err := dao.NewDaoSet(cc.DataService().Sharding, cc.DataService().Credential, cc.DataService().Gorm)
 	if err != nil {
@@ -187,6 +223,36 @@ func (ds *dataService) prepare(opt *options.Option) error {
 		repoSyncer.Run()
 	}
 
+	// replicate files to the per-region replicas
+	if rp, ok := ds.repo.(repository.RegionEnhancer); ok {
+		service.NewRepoReplicator(rp.ReplicaManager(), ds.sd).Run()
+	}
+
+	return nil
+}
+
+// autoMigrate run the pending up migrations, the migrator holds a db lock so that only one instance
+// runs the migrations at the same time.
+func autoMigrate() error {
+	db, err := migrator.NewDB(false)
+	if err != nil {
+		return err
+	}
+	if sqlDB, e := db.DB(); e == nil {
+		defer sqlDB.Close()
+	}
+
+	mig, err := migrator.Init(db)
+	if err != nil {
+		return err
+	}
+	mig.LockTimeoutSec = cc.DataService().Migration.LockTimeoutSec
+
+	if err = mig.Up(0); err != nil {
+		return err
+	}
+	logs.Infof("auto migrate db schema success, current version: %s", mig.CurrentVersion())
+
 	return nil
 }
 
@@ -221,9 +287,14 @@ func (ds *dataService) listenAndServe() error {
 
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.ChainUnaryInterceptor(
+			brpc.RequestIDUnaryServerInterceptor(),
+			brpc.APIVersionUnaryServerInterceptor(),
+			brpc.UnknownFieldsUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
+			service.ReadOnlyUnaryServerInterceptor(ds.sd.Maintenance()),
+			service.AppStateUnaryServerInterceptor(ds.daoSet),
 		),
 		grpc.ChainStreamInterceptor(
 			grpcMetrics.StreamServerInterceptor(),
@@ -254,6 +325,16 @@ func (ds *dataService) listenAndServe() error {
 	status := crontab.NewSyncTicketStatus(ds.daoSet, ds.sd, svc)
 	status.Run()
 
+	// 注册定时任务, 包括保留期清理、过期回收、合规扫描和报表汇总
+	if err = crontab.RegisterJobs(ds.scheduler, svc); err != nil {
+		return fmt.Errorf("register background jobs failed, err: %v", err)
+	}
+	ds.scheduler.Start()
+
+	// 同步未上线配置的全文检索索引
+	syncFullTextIndex := crontab.NewSyncFullTextIndex(ds.sd, svc)
+	syncFullTextIndex.Run()
+
 	pbds.RegisterDataServer(serve, svc)
 
 	// initialize and register standard grpc server grpcMetrics.
@@ -0,0 +1,238 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/version"
+)
+
+// contentTables 引用文件存储内容的表, 备份时记录被引用的内容清单
+var contentTables = []string{
+	string(table.ContentTable),
+	string(table.ReleasedConfigItemTable),
+	string(table.TemplateRevisionsTable),
+	"released_app_templates",
+}
+
+// Backup exports all the tables of the database into the archive written to w, the tables are read in a
+// read-only transaction so that the backup is a consistent snapshot.
+func Backup(db *gorm.DB, w io.Writer) (*Manifest, error) {
+	var opts *sql.TxOptions
+	// sqlite 不支持设置事务隔离级别, 单文件数据库的事务本身即为一致快照
+	if db.Dialector.Name() != "sqlite" {
+		opts = &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}
+	}
+	tx := db.Begin(opts)
+	if tx.Error != nil {
+		return nil, fmt.Errorf("begin snapshot transaction failed, err: %v", tx.Error)
+	}
+	defer tx.Rollback()
+
+	tables, err := tx.Migrator().GetTables()
+	if err != nil {
+		return nil, fmt.Errorf("list tables failed, err: %v", err)
+	}
+	sort.Strings(tables)
+
+	m := &Manifest{
+		FormatVersion: formatVersion,
+		BscpVersion:   version.VERSION,
+		CreatedAt:     time.Now().UTC(),
+	}
+	if m.MigrationVersion, err = migrationVersion(tx); err != nil {
+		return nil, err
+	}
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, name := range tables {
+		// sqlite 内部表(如自增序列 sqlite_sequence)由数据库自行维护, 不需要备份
+		if name == migrationTable || strings.HasPrefix(name, "sqlite_") {
+			continue
+		}
+
+		tm, err := dumpTable(tx, tw, name)
+		if err != nil {
+			return nil, err
+		}
+		m.Tables = append(m.Tables, *tm)
+	}
+
+	if m.Contents, err = listContentRefs(tx, tables); err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFile(tw, manifestFile, data); err != nil {
+		return nil, err
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// migrationVersion returns the latest applied migration's version.
+func migrationVersion(db *gorm.DB) (string, error) {
+	var versions []string
+	if err := db.Raw("SELECT version FROM " + migrationTable).Scan(&versions).Error; err != nil {
+		return "", fmt.Errorf("get migration version failed, err: %v", err)
+	}
+
+	current := ""
+	for _, v := range versions {
+		if v > current {
+			current = v
+		}
+	}
+	return current, nil
+}
+
+// dumpTable writes the table's rows as json lines into the archive, the rows are buffered in a temporary
+// file because the tar header requires the file size.
+func dumpTable(db *gorm.DB, tw *tar.Writer, name string) (*TableManifest, error) {
+	tmp, err := os.CreateTemp("", "bscp-backup-*")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	rows, err := db.Table(name).Rows()
+	if err != nil {
+		return nil, fmt.Errorf("query table %s failed, err: %v", name, err)
+	}
+	defer rows.Close()
+
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, fmt.Errorf("get table %s columns failed, err: %v", name, err)
+	}
+	tm := &TableManifest{Name: name, Columns: make([]Column, len(types))}
+	for i, ct := range types {
+		tm.Columns[i] = Column{Name: ct.Name(), Type: ct.DatabaseTypeName()}
+	}
+
+	hash := sha256.New()
+	enc := json.NewEncoder(io.MultiWriter(tmp, hash))
+	values := make([]interface{}, len(types))
+	dest := make([]interface{}, len(types))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("scan table %s failed, err: %v", name, err)
+		}
+		row := make([]interface{}, len(values))
+		for i, v := range values {
+			row[i] = encodeValue(tm.Columns[i], v)
+		}
+		if err := enc.Encode(row); err != nil {
+			return nil, err
+		}
+		tm.Rows++
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read table %s failed, err: %v", name, err)
+	}
+	tm.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	hdr := &tar.Header{Name: tm.file(), Mode: 0644, Size: size, ModTime: time.Now()}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(tw, tmp); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// listContentRefs lists the repository contents referenced by the tables.
+func listContentRefs(db *gorm.DB, tables []string) ([]ContentRef, error) {
+	exists := make(map[string]bool, len(tables))
+	for _, one := range tables {
+		exists[one] = true
+	}
+
+	uniq := make(map[ContentRef]struct{})
+	for _, name := range contentTables {
+		if !exists[name] {
+			continue
+		}
+
+		var refs []ContentRef
+		if err := db.Table(name).Distinct("biz_id", "signature").Scan(&refs).Error; err != nil {
+			return nil, fmt.Errorf("list contents of table %s failed, err: %v", name, err)
+		}
+		for _, ref := range refs {
+			uniq[ref] = struct{}{}
+		}
+	}
+
+	refs := make([]ContentRef, 0, len(uniq))
+	for ref := range uniq {
+		refs = append(refs, ref)
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].BizID != refs[j].BizID {
+			return refs[i].BizID < refs[j].BizID
+		}
+		return refs[i].Signature < refs[j].Signature
+	})
+
+	return refs, nil
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{Name: name, Mode: 0644, Size: int64(len(data)), ModTime: time.Now()}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
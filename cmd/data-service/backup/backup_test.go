@@ -0,0 +1,142 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	_ "github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrations"
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func newTestDB(t *testing.T, name string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), name)), &gorm.Config{})
+	require.NoError(t, err)
+
+	for _, ddl := range []string{
+		"CREATE TABLE schema_migrations (applied TIMESTAMP, version VARCHAR(255))",
+		"INSERT INTO schema_migrations (applied, version) VALUES (CURRENT_TIMESTAMP, '20240101000000')",
+		"CREATE TABLE contents (id INTEGER PRIMARY KEY, biz_id INTEGER, signature VARCHAR(64), " +
+			"created_at DATETIME, data BLOB, memo VARCHAR(64))",
+	} {
+		require.NoError(t, db.Exec(ddl).Error)
+	}
+	return db
+}
+
+func TestBackupRestore(t *testing.T) {
+	src := newTestDB(t, "src.db")
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, src.Exec("INSERT INTO contents VALUES (1, 2, 'sign1', ?, ?, NULL), (2, 2, 'sign1', ?, ?, 'x')",
+		now, []byte{0xff, 0x00}, now, []byte("abc")).Error)
+
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	m, err := Backup(src, f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	assert.Equal(t, "20240101000000", m.MigrationVersion)
+	assert.Len(t, m.Tables, 1)
+	assert.Equal(t, int64(2), m.Tables[0].Rows)
+	assert.Equal(t, []ContentRef{{BizID: 2, Signature: "sign1"}}, m.Contents)
+
+	_, err = Verify(path)
+	require.NoError(t, err)
+
+	dst := newTestDB(t, "dst.db")
+	_, err = Restore(dst, path, RestoreOption{})
+	require.NoError(t, err)
+
+	type row struct {
+		ID        uint32
+		Signature string
+		CreatedAt time.Time
+		Data      []byte
+		Memo      *string
+	}
+	var rows []row
+	require.NoError(t, dst.Table("contents").Order("id").Find(&rows).Error)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []byte{0xff, 0x00}, rows[0].Data)
+	assert.Nil(t, rows[0].Memo)
+	assert.True(t, now.Equal(rows[1].CreatedAt))
+	assert.Equal(t, "x", *rows[1].Memo)
+
+	// 目标库非空时需指定覆盖
+	_, err = Restore(dst, path, RestoreOption{})
+	assert.Error(t, err)
+	_, err = Restore(dst, path, RestoreOption{Overwrite: true})
+	assert.NoError(t, err)
+
+	// 迁移版本不一致时拒绝恢复
+	require.NoError(t, dst.Exec("UPDATE schema_migrations SET version = '20250101000000'").Error)
+	_, err = Restore(dst, path, RestoreOption{Overwrite: true})
+	assert.Error(t, err)
+}
+
+// newMigratedDB returns a database migrated with the real migrations, whose seeded tables are not empty.
+func newMigratedDB(t *testing.T, name string) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), name)), &gorm.Config{})
+	require.NoError(t, err)
+
+	m, err := migrator.Init(db)
+	require.NoError(t, err)
+	require.NoError(t, m.Up(0))
+	return db
+}
+
+func TestRestoreIntoMigratedDB(t *testing.T) {
+	src := newMigratedDB(t, "src.db")
+	// 模拟已有数据的部署, 资源 id 生成器已经分配过 id
+	require.NoError(t, src.Exec("UPDATE id_generators SET max_id = 100 WHERE resource = 'applications'").Error)
+
+	path := filepath.Join(t.TempDir(), "backup.tar.gz")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	_, err = Backup(src, f)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// 新部署的数据库执行迁移后即存在初始化数据, 不需要覆盖即可恢复
+	dst := newMigratedDB(t, "dst.db")
+	var seeded int64
+	require.NoError(t, dst.Table("id_generators").Count(&seeded).Error)
+	require.NotZero(t, seeded)
+
+	_, err = Restore(dst, path, RestoreOption{})
+	require.NoError(t, err)
+
+	var maxID uint32
+	require.NoError(t, dst.Table("id_generators").Select("max_id").Where("resource = ?", "applications").
+		Scan(&maxID).Error)
+	assert.Equal(t, uint32(100), maxID)
+
+	var restored int64
+	require.NoError(t, dst.Table("id_generators").Count(&restored).Error)
+	assert.Equal(t, seeded, restored)
+
+	// 其它表已有数据时仍需指定覆盖
+	require.NoError(t, dst.Exec("INSERT INTO applications (id, biz_id, name) VALUES (1, 2, 'app')").Error)
+	_, err = Restore(dst, path, RestoreOption{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,292 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package backup
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// restoreBatchSize is the rows count inserted in one batch.
+const restoreBatchSize = 500
+
+// seededTables 迁移时会初始化数据的表(资源 id 生成器, 内置的客户端查询条件和 hook 模板), 新部署的数据库中这些表
+// 非空, 恢复时总是先删除再写入备份中的数据
+var seededTables = map[string]bool{
+	string(table.IDGeneratorTable):  true,
+	string(table.HookTemplateTable): true,
+	"client_querys":                 true,
+}
+
+// RestoreOption defines the options of restoring a backup.
+type RestoreOption struct {
+	// Overwrite deletes the existing rows of the tables before restoring, otherwise the restore fails if
+	// any table is not empty, except the tables initialized by the migrations whose rows are always replaced.
+	Overwrite bool
+}
+
+// Verify verifies the archive's consistency, every table file's rows and sha256 must match the manifest.
+func Verify(path string) (*Manifest, error) {
+	var m *Manifest
+	type sum struct {
+		rows int64
+		hash string
+	}
+	sums := make(map[string]sum)
+
+	err := walkArchive(path, func(hdr *tar.Header, r io.Reader) error {
+		if hdr.Name == manifestFile {
+			m = new(Manifest)
+			if err := json.NewDecoder(r).Decode(m); err != nil {
+				return fmt.Errorf("decode manifest failed, err: %v", err)
+			}
+			return nil
+		}
+
+		hash := sha256.New()
+		rows, err := countLines(io.TeeReader(r, hash))
+		if err != nil {
+			return fmt.Errorf("read %s failed, err: %v", hdr.Name, err)
+		}
+		sums[hdr.Name] = sum{rows: rows, hash: hex.EncodeToString(hash.Sum(nil))}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if m == nil {
+		return nil, errors.New("manifest not found in the archive, the archive may be truncated")
+	}
+	if m.FormatVersion != formatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d", m.FormatVersion)
+	}
+
+	for _, t := range m.Tables {
+		s, ok := sums[t.file()]
+		if !ok {
+			return nil, fmt.Errorf("table %s not found in the archive", t.Name)
+		}
+		if s.rows != t.Rows || s.hash != t.SHA256 {
+			return nil, fmt.Errorf("table %s is inconsistent with the manifest, rows: %d/%d, sha256: %s/%s",
+				t.Name, s.rows, t.Rows, s.hash, t.SHA256)
+		}
+	}
+
+	return m, nil
+}
+
+// Restore restores the archive into the database in a transaction, the database must be migrated to the same
+// version as the backup, and the restored rows count of every table is checked before committing.
+func Restore(db *gorm.DB, path string, opt RestoreOption) (*Manifest, error) {
+	m, err := Verify(path)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := migrationVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	if current != m.MigrationVersion {
+		return nil, fmt.Errorf("the database's migration version %s mismatches the backup's %s, please migrate "+
+			"the database to the backup's version first", current, m.MigrationVersion)
+	}
+
+	tables := make(map[string]TableManifest, len(m.Tables))
+	for _, t := range m.Tables {
+		tables[t.file()] = t
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		for _, t := range m.Tables {
+			if err := prepareTable(tx, t.Name, opt.Overwrite); err != nil {
+				return err
+			}
+		}
+
+		err := walkArchive(path, func(hdr *tar.Header, r io.Reader) error {
+			t, ok := tables[hdr.Name]
+			if !ok {
+				return nil
+			}
+			return restoreTable(tx, t, r)
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, t := range m.Tables {
+			var count int64
+			if err := tx.Table(t.Name).Count(&count).Error; err != nil {
+				return fmt.Errorf("count table %s failed, err: %v", t.Name, err)
+			}
+			if count != t.Rows {
+				return fmt.Errorf("table %s restored %d rows, but the backup has %d rows", t.Name, count, t.Rows)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CheckContents checks if the referenced contents exist in the repository, returns the missing ones.
+func CheckContents(kt *kit.Kit, provider repository.Provider, refs []ContentRef) ([]ContentRef, error) {
+	missing := make([]ContentRef, 0)
+	for _, ref := range refs {
+		kt.BizID = ref.BizID
+		if _, err := provider.Metadata(kt, ref.Signature); err != nil {
+			if err != errf.ErrFileContentNotFound {
+				return nil, fmt.Errorf("get content %s metadata of biz %d failed, err: %v", ref.Signature,
+					ref.BizID, err)
+			}
+			missing = append(missing, ref)
+		}
+	}
+
+	return missing, nil
+}
+
+// prepareTable checks if the table is empty, or deletes the existing rows if overwrite is set or the table is
+// initialized by the migrations.
+func prepareTable(tx *gorm.DB, name string, overwrite bool) error {
+	if !tx.Migrator().HasTable(name) {
+		return fmt.Errorf("table %s not exists in the database", name)
+	}
+
+	if overwrite || seededTables[name] {
+		if err := tx.Exec("DELETE FROM " + tx.Statement.Quote(name)).Error; err != nil {
+			return fmt.Errorf("delete table %s rows failed, err: %v", name, err)
+		}
+		return nil
+	}
+
+	var count int64
+	if err := tx.Table(name).Count(&count).Error; err != nil {
+		return fmt.Errorf("count table %s failed, err: %v", name, err)
+	}
+	if count != 0 {
+		return fmt.Errorf("table %s has %d rows, restore into a fresh database or overwrite it", name, count)
+	}
+
+	return nil
+}
+
+// restoreTable inserts the table's rows in batches.
+func restoreTable(tx *gorm.DB, t TableManifest, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+
+	batch := make([]map[string]interface{}, 0, restoreBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := tx.Table(t.Name).Create(&batch).Error; err != nil {
+			return fmt.Errorf("insert table %s rows failed, err: %v", t.Name, err)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		var row []interface{}
+		if err := dec.Decode(&row); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("decode table %s row failed, err: %v", t.Name, err)
+		}
+		if len(row) != len(t.Columns) {
+			return fmt.Errorf("table %s row has %d columns, but the manifest has %d", t.Name, len(row),
+				len(t.Columns))
+		}
+
+		one := make(map[string]interface{}, len(row))
+		for i, c := range t.Columns {
+			v, err := decodeValue(c, row[i])
+			if err != nil {
+				return fmt.Errorf("table %s: %v", t.Name, err)
+			}
+			one[c.Name] = v
+		}
+		batch = append(batch, one)
+
+		if len(batch) >= restoreBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
+
+// walkArchive walks the files of the archive.
+func walkArchive(path string, fn func(hdr *tar.Header, r io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("open gzip archive failed, err: %v", err)
+	}
+	defer gr.Close()
+
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read archive failed, err: %v", err)
+		}
+
+		if err := fn(hdr, tr); err != nil {
+			return err
+		}
+	}
+}
+
+func countLines(r io.Reader) (int64, error) {
+	var n int64
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1<<30)
+	for sc.Scan() {
+		n++
+	}
+	return n, sc.Err()
+}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package backup provides the logical backup and restore of the data service's database, the backup archive
+// is a gzipped tar which contains every table's rows and a manifest with the consistency check information.
+package backup
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// formatVersion is the version of the backup archive format.
+	formatVersion = 1
+	// manifestFile is the manifest's file name in the archive, it's the last file of the archive.
+	manifestFile = "manifest.json"
+	// tableDir is the directory of the table files in the archive.
+	tableDir = "tables/"
+	// migrationTable records the applied migrations, it's not backed up but the current version is recorded.
+	migrationTable = "schema_migrations"
+)
+
+// Manifest is the manifest of a backup archive.
+type Manifest struct {
+	FormatVersion int `json:"format_version"`
+	// BscpVersion is the version of the bscp which creates the backup.
+	BscpVersion string `json:"bscp_version"`
+	// MigrationVersion is the latest applied migration's version of the backup database, the restore target
+	// database must be migrated to the same version.
+	MigrationVersion string          `json:"migration_version"`
+	CreatedAt        time.Time       `json:"created_at"`
+	Tables           []TableManifest `json:"tables"`
+	// Contents is the repository contents referenced by the config items and template revisions, the contents
+	// are not included in the archive, they should be restored with the repository's own backup.
+	Contents []ContentRef `json:"contents"`
+}
+
+// TableManifest is the manifest of a table in the archive.
+type TableManifest struct {
+	Name    string   `json:"name"`
+	Columns []Column `json:"columns"`
+	Rows    int64    `json:"rows"`
+	// SHA256 is the sha256 of the table file in the archive.
+	SHA256 string `json:"sha256"`
+}
+
+// Column is a column of the table.
+type Column struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// ContentRef is a repository content referenced by the database.
+type ContentRef struct {
+	BizID     uint32 `json:"biz_id"`
+	Signature string `json:"signature"`
+}
+
+// file returns the table's file name in the archive.
+func (t TableManifest) file() string {
+	return tableDir + t.Name + ".jsonl"
+}
+
+// isBinary returns if the column stores binary data which is encoded with base64 in the archive.
+func (c Column) isBinary() bool {
+	t := strings.ToUpper(c.Type)
+	return strings.Contains(t, "BLOB") || strings.Contains(t, "BINARY") || t == "BYTEA"
+}
+
+// isTime returns if the column stores time which is encoded with RFC3339 in the archive.
+func (c Column) isTime() bool {
+	t := strings.ToUpper(c.Type)
+	return strings.Contains(t, "TIME") || t == "DATE"
+}
+
+// encodeValue encodes the scanned value of the column to the json compatible value.
+func encodeValue(c Column, v interface{}) interface{} {
+	switch val := v.(type) {
+	case nil:
+		return nil
+	case []byte:
+		if c.isBinary() {
+			return base64.StdEncoding.EncodeToString(val)
+		}
+		return string(val)
+	case time.Time:
+		return val.UTC().Format(time.RFC3339Nano)
+	default:
+		return val
+	}
+}
+
+// decodeValue decodes the value in the archive to the value to be inserted into the column.
+func decodeValue(c Column, v interface{}) (interface{}, error) {
+	if n, ok := v.(json.Number); ok {
+		return n.String(), nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return v, nil
+	}
+
+	switch {
+	case c.isBinary():
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("decode binary column %s failed, err: %v", c.Name, err)
+		}
+		return b, nil
+	case c.isTime():
+		// 非 RFC3339 格式的时间(如未开启 parseTime 时读取的原始值)直接写入
+		if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return s, nil
+}
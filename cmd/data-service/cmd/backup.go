@@ -0,0 +1,164 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/backup"
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// cmd for backup the database into an archive
+var backupCmd = &cobra.Command{
+	Use:   "backup",
+	Short: "export all the tables and the referenced repository contents manifest into an archive",
+	Long: "export all the tables and the referenced repository contents manifest into an archive, the repository " +
+		"contents and the kv values stored in vault are not included, they should be backed up with their own tools",
+	Run: func(cmd *cobra.Command, args []string) {
+		output, _ := cmd.Flags().GetString("output")
+		checkRepo, _ := cmd.Flags().GetBool("check-repo")
+
+		if err := cc.LoadSettings(SysOpt); err != nil {
+			fmt.Println("load settings from config files failed, err:", err)
+			os.Exit(1)
+		}
+		logs.InitLogger(cc.DataService().Log.Logs())
+
+		db, err := migrator.NewDB(false)
+		if err != nil {
+			fmt.Println("Unable to new db, err:", err)
+			os.Exit(1)
+		}
+
+		f, err := os.Create(output)
+		if err != nil {
+			fmt.Println("create backup file failed, err:", err)
+			os.Exit(1)
+		}
+		m, err := backup.Backup(db, f)
+		if e := f.Close(); err == nil {
+			err = e
+		}
+		if err != nil {
+			_ = os.Remove(output)
+			fmt.Println("backup failed, err:", err)
+			os.Exit(1)
+		}
+
+		var rows int64
+		for _, t := range m.Tables {
+			rows += t.Rows
+		}
+		fmt.Printf("backup to %s finished, migration version: %s, tables: %d, rows: %d, contents: %d\n", output,
+			m.MigrationVersion, len(m.Tables), rows, len(m.Contents))
+
+		if checkRepo {
+			checkContents(m)
+		}
+	},
+}
+
+// cmd for restore the database from an archive
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "restore all the tables from a backup archive into a fresh deployment",
+	Long: "restore all the tables from a backup archive into a fresh deployment, the database must be migrated " +
+		"to the backup's migration version first, and the archive's consistency is verified before restoring",
+	Run: func(cmd *cobra.Command, args []string) {
+		input, _ := cmd.Flags().GetString("input")
+		overwrite, _ := cmd.Flags().GetBool("overwrite")
+		verifyOnly, _ := cmd.Flags().GetBool("verify-only")
+		checkRepo, _ := cmd.Flags().GetBool("check-repo")
+
+		if verifyOnly {
+			m, err := backup.Verify(input)
+			if err != nil {
+				fmt.Println("verify backup failed, err:", err)
+				os.Exit(1)
+			}
+			fmt.Printf("backup %s is consistent, created at: %s, migration version: %s, tables: %d\n", input,
+				m.CreatedAt, m.MigrationVersion, len(m.Tables))
+			return
+		}
+
+		if err := cc.LoadSettings(SysOpt); err != nil {
+			fmt.Println("load settings from config files failed, err:", err)
+			os.Exit(1)
+		}
+		logs.InitLogger(cc.DataService().Log.Logs())
+
+		db, err := migrator.NewDB(false)
+		if err != nil {
+			fmt.Println("Unable to new db, err:", err)
+			os.Exit(1)
+		}
+
+		m, err := backup.Restore(db, input, backup.RestoreOption{Overwrite: overwrite})
+		if err != nil {
+			fmt.Println("restore failed, err:", err)
+			os.Exit(1)
+		}
+		fmt.Printf("restore from %s finished, migration version: %s, tables: %d\n", input, m.MigrationVersion,
+			len(m.Tables))
+
+		if checkRepo {
+			checkContents(m)
+		}
+	},
+}
+
+// checkContents checks if the contents referenced by the backup exist in the repository.
+func checkContents(m *backup.Manifest) {
+	provider, err := repository.NewProvider(cc.DataService().Repo)
+	if err != nil {
+		fmt.Println("new repository provider failed, err:", err)
+		os.Exit(1)
+	}
+
+	missing, err := backup.CheckContents(kit.New(), provider, m.Contents)
+	if err != nil {
+		fmt.Println("check repository contents failed, err:", err)
+		os.Exit(1)
+	}
+	if len(missing) == 0 {
+		fmt.Printf("all the %d referenced contents exist in the repository\n", len(m.Contents))
+		return
+	}
+
+	fmt.Printf("%d of the %d referenced contents are missing in the repository:\n", len(missing), len(m.Contents))
+	for _, one := range missing {
+		fmt.Printf("  biz: %d, signature: %s\n", one.BizID, one.Signature)
+	}
+	os.Exit(1)
+}
+
+func init() {
+	backupCmd.Flags().StringP("output", "o", "bscp-backup.tar.gz", "the backup archive file path")
+	backupCmd.Flags().Bool("check-repo", false, "check if the referenced contents exist in the repository")
+
+	restoreCmd.Flags().StringP("input", "i", "", "the backup archive file path")
+	restoreCmd.Flags().Bool("overwrite", false, "delete the existing rows of the tables before restoring")
+	restoreCmd.Flags().Bool("verify-only", false, "only verify the consistency of the archive")
+	restoreCmd.Flags().Bool("check-repo", true, "check if the referenced contents exist in the repository")
+	_ = restoreCmd.MarkFlagRequired("input")
+
+	rootCmd.AddCommand(backupCmd, restoreCmd)
+}
@@ -68,11 +68,12 @@ var migrateUpCmd = &cobra.Command{
 	Short: "run up migrations",
 	Run: func(cmd *cobra.Command, args []string) {
 		var (
-			err   error
-			step  int
-			debug bool
-			db    *gorm.DB
-			mig   *migrator.Migrator
+			err    error
+			step   int
+			debug  bool
+			dryRun bool
+			db     *gorm.DB
+			mig    *migrator.Migrator
 		)
 
 		step, err = cmd.Flags().GetInt("step")
@@ -86,6 +87,12 @@ var migrateUpCmd = &cobra.Command{
 			return
 		}
 
+		dryRun, err = cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			fmt.Println("Unable to read flag `dry-run`, err:", err)
+			return
+		}
+
 		err = cc.LoadSettings(SysOpt)
 		if err != nil {
 			fmt.Println("load settings from config files failed, err:", err)
@@ -106,6 +113,8 @@ var migrateUpCmd = &cobra.Command{
 			return
 		}
 
+		mig.DryRun = dryRun
+		mig.LockTimeoutSec = cc.DataService().Migration.LockTimeoutSec
 		err = mig.Up(step)
 		if err != nil {
 			fmt.Println("Unable to run `up` migrations, err:", err)
@@ -121,11 +130,12 @@ var migrateDownCmd = &cobra.Command{
 	Short: "run down migrations",
 	Run: func(cmd *cobra.Command, args []string) {
 		var (
-			err   error
-			step  int
-			debug bool
-			db    *gorm.DB
-			mig   *migrator.Migrator
+			err    error
+			step   int
+			debug  bool
+			dryRun bool
+			db     *gorm.DB
+			mig    *migrator.Migrator
 		)
 
 		step, err = cmd.Flags().GetInt("step")
@@ -139,6 +149,12 @@ var migrateDownCmd = &cobra.Command{
 			return
 		}
 
+		dryRun, err = cmd.Flags().GetBool("dry-run")
+		if err != nil {
+			fmt.Println("Unable to read flag `dry-run`, err:", err)
+			return
+		}
+
 		if err = cc.LoadSettings(SysOpt); err != nil {
 			fmt.Println("load settings from config files failed, err:", err)
 			return
@@ -158,6 +174,8 @@ var migrateDownCmd = &cobra.Command{
 			return
 		}
 
+		mig.DryRun = dryRun
+		mig.LockTimeoutSec = cc.DataService().Migration.LockTimeoutSec
 		err = mig.Down(step)
 		if err != nil {
 			fmt.Println("Unable to run `down` migrations, err:", err)
@@ -242,6 +260,10 @@ func init() {
 	migrateUpCmd.Flags().IntP("step", "s", 0, "Number of migrations to execute")
 	migrateDownCmd.Flags().IntP("step", "s", 0, "Number of migrations to execute")
 
+	// Add "--dry-run" flag to both "up" and "down" command
+	migrateUpCmd.Flags().Bool("dry-run", false, "only print the migrations and sql to be executed")
+	migrateDownCmd.Flags().Bool("dry-run", false, "only print the migrations and sql to be executed")
+
 	// Add "create", "up" and "down" commands to the "migrate" command
 	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateCreateCmd, migrateStatusCmd)
 	migrateCmd.AddCommand(migrateInitITSMCmd)
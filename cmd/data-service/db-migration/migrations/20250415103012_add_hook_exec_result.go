@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250415103012",
+		Name:    "20250415103012_add_hook_exec_result",
+		Mode:    migrator.GormMode,
+		Up:      mig20250415103012Up,
+		Down:    mig20250415103012Down,
+	})
+}
+
+// mig20250415103012Up for up migration
+func mig20250415103012Up(tx *gorm.DB) error {
+	// HookExecResults : 脚本执行结果
+	type HookExecResults struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		BizID     uint   `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:1"`
+		AppID     uint   `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:2"`
+		ReleaseID uint   `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:3"`
+		UID       string `gorm:"column:uid;type:varchar(64);NOT NULL;index:idx_uid,priority:1"`
+
+		HookType   string    `gorm:"column:hook_type;type:varchar(20);NOT NULL"`
+		HookName   string    `gorm:"column:hook_name;type:varchar(255);default:'';NOT NULL"`
+		ExitCode   int       `gorm:"column:exit_code;type:int(11);default:0;NOT NULL"`
+		DurationMs uint      `gorm:"column:duration_ms;type:int(10) unsigned;default:0;NOT NULL"`
+		Stdout     string    `gorm:"column:stdout;type:text"`
+		Stderr     string    `gorm:"column:stderr;type:text"`
+		Ip         string    `gorm:"column:ip;type:varchar(64);default:'';NOT NULL"`
+		ClientType string    `gorm:"column:client_type;type:varchar(20);default:'';NOT NULL"`
+		ExecutedAt time.Time `gorm:"column:executed_at;type:datetime(6);NOT NULL"`
+		CreatedAt  time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&HookExecResults{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "hook_exec_results", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250415103012Down for down migration
+func mig20250415103012Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"hook_exec_results",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("hook_exec_results"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250418154633",
+		Name:    "20250418154633_add_hook_template",
+		Mode:    migrator.GormMode,
+		Up:      mig20250418154633Up,
+		Down:    mig20250418154633Down,
+	})
+}
+
+// mig20250418154633Up for up migration
+func mig20250418154633Up(tx *gorm.DB) error {
+	// HookTemplates : 脚本模板
+	type HookTemplates struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name    string `gorm:"type:varchar(255) collate utf8mb4_bin not null;uniqueIndex:idx_bizID_name,priority:2"`
+		Type    string `gorm:"type:varchar(64) not null"`
+		Content string `gorm:"type:longtext"`
+		Params  string `gorm:"type:json not null"`
+		Memo    string `gorm:"type:varchar(256) default ''"`
+		Version uint   `gorm:"type:int(10) unsigned not null;default:1"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_name,priority:1"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// HookTemplateRefs : 脚本模板引用关系
+	type HookTemplateRefs struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Args            string `gorm:"type:json not null"`
+		TemplateVersion uint   `gorm:"type:int(10) unsigned not null"`
+		AutoUpgrade     bool   `gorm:"type:tinyint(1) not null;default:0"`
+
+		// Attachment is attachment info of the resource
+		BizID      uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_hookID,priority:1"`
+		HookID     uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_hookID,priority:2"`
+		TemplateID uint `gorm:"type:bigint(1) unsigned not null;index:idx_templateID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&HookTemplates{}, &HookTemplateRefs{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	// 平台内置脚本模板，biz_id 为 0，所有业务共享
+	builtin := []HookTemplates{
+		{
+			ID:   1,
+			Name: "reload_systemd_unit",
+			Type: "shell",
+			Content: `#!/bin/bash
+set -e
+systemctl daemon-reload
+systemctl {{ .action }} {{ .unit }}
+systemctl is-active --quiet {{ .unit }}
+`,
+			Params: `[{"name":"unit","default":"","required":true,"memo":"systemd unit name"},` +
+				`{"name":"action","default":"reload-or-restart","required":false,"memo":"systemctl action"}]`,
+			Memo: "reload a systemd unit after the config is released",
+		},
+		{
+			ID:   2,
+			Name: "validate_nginx_then_reload",
+			Type: "shell",
+			Content: `#!/bin/bash
+set -e
+{{ .nginx_bin }} -t -c {{ .conf }}
+{{ .nginx_bin }} -s reload
+`,
+			Params: `[{"name":"nginx_bin","default":"nginx","required":false,"memo":"nginx binary path"},` +
+				`{"name":"conf","default":"/etc/nginx/nginx.conf","required":false,"memo":"nginx config file"}]`,
+			Memo: "validate the nginx config and then reload nginx",
+		},
+	}
+	for i := range builtin {
+		builtin[i].Version = 1
+		builtin[i].Creator = "system"
+		builtin[i].Reviser = "system"
+		builtin[i].CreatedAt = now
+		builtin[i].UpdatedAt = now
+	}
+	if result := tx.Create(builtin); result.Error != nil {
+		return result.Error
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "hook_templates", MaxID: uint(len(builtin)), UpdatedAt: now},
+		{Resource: "hook_template_refs", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250418154633Down for down migration
+func mig20250418154633Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"hook_templates",
+		"hook_template_refs",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("hook_templates", "hook_template_refs"); err != nil {
+		return err
+	}
+
+	return nil
+}
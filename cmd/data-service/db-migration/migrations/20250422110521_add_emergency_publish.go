@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250422110521",
+		Name:    "20250422110521_add_emergency_publish",
+		Mode:    migrator.GormMode,
+		Up:      mig20250422110521Up,
+		Down:    mig20250422110521Down,
+	})
+}
+
+// mig20250422110521Up for up migration
+func mig20250422110521Up(tx *gorm.DB) error {
+	// EmergencyPublishes : 紧急发布
+	type EmergencyPublishes struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		ReleaseID uint      `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL"`
+		GroupID   uint      `gorm:"column:group_id;type:bigint(1) unsigned;NOT NULL"`
+		UIDs      string    `gorm:"column:uids;type:json;NOT NULL"`
+		ExpiredAt time.Time `gorm:"column:expired_at;type:datetime(6);NOT NULL;index:idx_state_expiredAt,priority:2"`
+		State     string    `gorm:"column:state;type:varchar(20);NOT NULL;index:idx_state_expiredAt,priority:1"`
+		Memo      string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID,priority:2"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser   string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&EmergencyPublishes{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "emergency_publishes", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250422110521Down for down migration
+func mig20250422110521Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"emergency_publishes",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("emergency_publishes"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250425143052",
+		Name:    "20250425143052_add_environment",
+		Mode:    migrator.GormMode,
+		Up:      mig20250425143052Up,
+		Down:    mig20250425143052Down,
+	})
+}
+
+// mig20250425143052Up for up migration
+func mig20250425143052Up(tx *gorm.DB) error {
+	// Environments : 环境
+	type Environments struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		Name     string `gorm:"column:name;type:varchar(255);NOT NULL;uniqueIndex:idx_bizID_name,priority:2"`
+		Memo     string `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Position uint   `gorm:"column:position;type:int(10) unsigned;default:0;NOT NULL"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_name,priority:1"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser   string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// EnvAppBindings : 服务与环境的绑定关系
+	type EnvAppBindings struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		EnvID  uint   `gorm:"column:env_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_envID_appKey,priority:2"`
+		AppKey string `gorm:"column:app_key;type:varchar(255);NOT NULL;uniqueIndex:idx_bizID_envID_appKey,priority:3"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_envID_appKey,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_appID"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		Reviser   string    `gorm:"column:reviser;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+		UpdatedAt time.Time `gorm:"column:updated_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Environments{}, &EnvAppBindings{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "environments", MaxID: 0, UpdatedAt: now},
+		{Resource: "env_app_bindings", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250425143052Down for down migration
+func mig20250425143052Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"environments",
+		"env_app_bindings",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("environments"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("env_app_bindings"); err != nil {
+		return err
+	}
+
+	return nil
+}
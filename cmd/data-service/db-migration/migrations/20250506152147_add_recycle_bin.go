@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250506152147",
+		Name:    "20250506152147_add_recycle_bin",
+		Mode:    migrator.GormMode,
+		Up:      mig20250506152147Up,
+		Down:    mig20250506152147Down,
+	})
+}
+
+// mig20250506152147Up for up migration
+func mig20250506152147Up(tx *gorm.DB) error {
+	// RecycleBins : 回收站
+	type RecycleBins struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		ResType   string    `gorm:"column:res_type;type:varchar(20);NOT NULL"`
+		ResID     uint      `gorm:"column:res_id;type:bigint(1) unsigned;NOT NULL"`
+		Name      string    `gorm:"column:name;type:varchar(1024);default:'';NOT NULL"`
+		Data      string    `gorm:"column:data;type:mediumtext;NOT NULL"`
+		ExpiredAt time.Time `gorm:"column:expired_at;type:datetime(6);NOT NULL;index:idx_expiredAt"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID,priority:2"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&RecycleBins{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "recycle_bins", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250506152147Down for down migration
+func mig20250506152147Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"recycle_bins"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("recycle_bins"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250512103318",
+		Name:    "20250512103318_add_app_snapshot",
+		Mode:    migrator.GormMode,
+		Up:      mig20250512103318Up,
+		Down:    mig20250512103318Down,
+	})
+}
+
+// mig20250512103318Up for up migration
+func mig20250512103318Up(tx *gorm.DB) error {
+	// AppSnapshots : 服务编辑态快照
+	type AppSnapshots struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		Name string `gorm:"column:name;type:varchar(255);NOT NULL;uniqueIndex:idx_bizID_appID_name,priority:3"`
+		Memo string `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Data string `gorm:"column:data;type:json;NOT NULL"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_name,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_name,priority:2"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&AppSnapshots{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "app_snapshots", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250512103318Down for down migration
+func mig20250512103318Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"app_snapshots"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("app_snapshots"); err != nil {
+		return err
+	}
+
+	return nil
+}
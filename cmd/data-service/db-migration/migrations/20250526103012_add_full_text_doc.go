@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250526103012",
+		Name:    "20250526103012_add_full_text_doc",
+		Mode:    migrator.GormMode,
+		Up:      mig20250526103012Up,
+		Down:    mig20250526103012Down,
+	})
+}
+
+// mig20250526103012Up for up migration
+func mig20250526103012Up(tx *gorm.DB) error {
+	// FullTextDocs : 全文检索索引文档
+	type FullTextDocs struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		ResType   string    `gorm:"column:res_type;type:varchar(32);NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:3"`
+		ResID     uint      `gorm:"column:res_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:4"`
+		Name      string    `gorm:"column:name;type:varchar(1024);NOT NULL"`
+		Memo      string    `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+		Content   string    `gorm:"column:content;type:longtext;NOT NULL"`
+		Signature string    `gorm:"column:signature;type:varchar(64);NOT NULL"`
+		IndexedAt time.Time `gorm:"column:indexed_at;type:datetime(6);NOT NULL"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:2"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&FullTextDocs{}); err != nil {
+		return err
+	}
+
+	// ngram 分词器支持中文等无空格分隔的文本检索
+	if err := tx.Exec("ALTER TABLE full_text_docs ADD FULLTEXT INDEX idx_fulltext (name, memo, content) " +
+		"WITH PARSER ngram").Error; err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "full_text_docs", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250526103012Down for down migration
+func mig20250526103012Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"full_text_docs"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("full_text_docs"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250602143015",
+		Name:    "20250602143015_add_config_reference",
+		Mode:    migrator.GormMode,
+		Up:      mig20250602143015Up,
+		Down:    mig20250602143015Down,
+	})
+}
+
+// mig20250602143015Up for up migration
+func mig20250602143015Up(tx *gorm.DB) error {
+	// ConfigReferences : 配置引用关系
+	type ConfigReferences struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		SrcType string `gorm:"column:src_type;type:varchar(32);NOT NULL;uniqueIndex:idx_bizID_src_dst,priority:2;index:idx_bizID_src,priority:2"`
+		SrcID   uint   `gorm:"column:src_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_src_dst,priority:3;index:idx_bizID_src,priority:3"`
+		DstType string `gorm:"column:dst_type;type:varchar(32);NOT NULL;uniqueIndex:idx_bizID_src_dst,priority:4;index:idx_bizID_dst,priority:2"`
+		DstID   uint   `gorm:"column:dst_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_src_dst,priority:5;index:idx_bizID_dst,priority:3"`
+		RefType string `gorm:"column:ref_type;type:varchar(32);NOT NULL"`
+		Memo    string `gorm:"column:memo;type:varchar(256);default:'';NOT NULL"`
+
+		BizID    uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_src_dst,priority:1;index:idx_bizID_src,priority:1;index:idx_bizID_dst,priority:1"`
+		SrcAppID uint `gorm:"column:src_app_id;type:bigint(1) unsigned;NOT NULL;index:idx_srcAppID"`
+		DstAppID uint `gorm:"column:dst_app_id;type:bigint(1) unsigned;NOT NULL;index:idx_dstAppID"`
+
+		Creator   string    `gorm:"column:creator;type:varchar(64);NOT NULL"`
+		CreatedAt time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&ConfigReferences{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "config_references", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250602143015Down for down migration
+func mig20250602143015Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"config_references"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("config_references"); err != nil {
+		return err
+	}
+
+	return nil
+}
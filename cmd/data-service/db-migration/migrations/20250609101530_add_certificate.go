@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250609101530",
+		Name:    "20250609101530_add_certificate",
+		Mode:    migrator.GormMode,
+		Up:      mig20250609101530Up,
+		Down:    mig20250609101530Down,
+	})
+}
+
+// mig20250609101530Up for up migration
+func mig20250609101530Up(tx *gorm.DB) error {
+	// Certificates : 配置项和kv中的证书
+	type Certificates struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		ResType   string    `gorm:"column:res_type;type:varchar(32);NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:3"`
+		ResID     uint      `gorm:"column:res_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:4"`
+		Name      string    `gorm:"column:name;type:varchar(1024);NOT NULL"`
+		Signature string    `gorm:"column:signature;type:varchar(64);NOT NULL"`
+		Subject   string    `gorm:"column:subject;type:varchar(1024);default:'';NOT NULL"`
+		DNSNames  string    `gorm:"column:dns_names;type:text;NOT NULL"`
+		NotBefore time.Time `gorm:"column:not_before;type:datetime(6);NOT NULL"`
+		NotAfter  time.Time `gorm:"column:not_after;type:datetime(6);NOT NULL;index:idx_bizID_notAfter,priority:2"`
+
+		BizID uint `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:1;index:idx_bizID_notAfter,priority:1"`
+		AppID uint `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;uniqueIndex:idx_bizID_appID_resType_resID,priority:2"`
+
+		WarnedAt     *time.Time `gorm:"column:warned_at;type:datetime(6);default:NULL"`
+		RenewStatus  string     `gorm:"column:renew_status;type:varchar(32);default:'';NOT NULL"`
+		RenewMessage string     `gorm:"column:renew_message;type:varchar(1024);default:'';NOT NULL"`
+		ScannedAt    time.Time  `gorm:"column:scanned_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Certificates{}); err != nil {
+		return err
+	}
+
+	if result := tx.Create([]IDGenerators{
+		{Resource: "certificates", MaxID: 0, UpdatedAt: time.Now()},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250609101530Down for down migration
+func mig20250609101530Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if result := tx.Where("resource IN ?", []string{"certificates"}).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("certificates"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250616103020",
+		Name:    "20250616103020_add_kv_override",
+		Mode:    migrator.GormMode,
+		Up:      mig20250616103020Up,
+		Down:    mig20250616103020Down,
+	})
+}
+
+// mig20250616103020Up for up migration
+func mig20250616103020Up(tx *gorm.DB) error {
+	// KvOverrides : kv 按分组覆盖的值
+	type KvOverrides struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Key      string `gorm:"type:varchar(255) not null;uniqueIndex:idx_bizID_appID_key_groupID,priority:3"`
+		GroupID  uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_key_groupID,priority:4;index:idx_bizID_groupID,priority:2"` //nolint:lll
+		Priority uint   `gorm:"type:int(10) unsigned not null;default:0"`
+		Version  uint   `gorm:"type:bigint(1) unsigned not null"`
+		Memo     string `gorm:"type:varchar(256) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_key_groupID,priority:1;index:idx_bizID_groupID,priority:1"` //nolint:lll
+		AppID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_key_groupID,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+
+		// ContentSpec is specifics of the override value
+		Signature string `gorm:"type:varchar(64) not null"`
+		ByteSize  uint   `gorm:"type:bigint(1) unsigned not null"`
+		Md5       string `gorm:"type:varchar(64) not null"`
+	}
+
+	// ReleasedKvOverrides : 已生成版本的 kv 覆盖值
+	type ReleasedKvOverrides struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		ReleaseID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_relID_key_groupID,priority:1"`
+
+		// Spec is specifics of the resource defined with user
+		Key      string `gorm:"type:varchar(255) not null;uniqueIndex:idx_relID_key_groupID,priority:2"`
+		GroupID  uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_relID_key_groupID,priority:3"`
+		Priority uint   `gorm:"type:int(10) unsigned not null;default:0"`
+		Version  uint   `gorm:"type:bigint(1) unsigned not null"`
+		Memo     string `gorm:"type:varchar(256) default ''"`
+
+		// Group is the matching rule of the group when released
+		Mode     string `gorm:"type:varchar(20) not null"`
+		Selector string `gorm:"type:json default null"`
+		UID      string `gorm:"column:uid;type:varchar(64) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+
+		// ContentSpec is specifics of the override value
+		Signature string `gorm:"type:varchar(64) not null"`
+		ByteSize  uint   `gorm:"type:bigint(1) unsigned not null"`
+		Md5       string `gorm:"type:varchar(64) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&KvOverrides{}, &ReleasedKvOverrides{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "kv_overrides", MaxID: 0, UpdatedAt: now},
+		{Resource: "released_kv_overrides", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250616103020Down for down migration
+func mig20250616103020Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"kv_overrides",
+		"released_kv_overrides",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("kv_overrides"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("released_kv_overrides"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250623102040",
+		Name:    "20250623102040_add_release_notes",
+		Mode:    migrator.GormMode,
+		Up:      mig20250623102040Up,
+		Down:    mig20250623102040Down,
+	})
+}
+
+// mig20250623102040Up for up migration
+func mig20250623102040Up(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		ReleaseNotesPolicy string `gorm:"column:release_notes_policy;type:json"`
+	}
+
+	// Releases  : releases
+	type Releases struct {
+		Notes string `gorm:"column:notes;type:json"`
+	}
+
+	// Applications add new column
+	if !tx.Migrator().HasColumn(&Applications{}, "release_notes_policy") {
+		if err := tx.Migrator().AddColumn(&Applications{}, "release_notes_policy"); err != nil {
+			return err
+		}
+	}
+
+	// Releases add new column
+	if !tx.Migrator().HasColumn(&Releases{}, "notes") {
+		if err := tx.Migrator().AddColumn(&Releases{}, "notes"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250623102040Down for down migration
+func mig20250623102040Down(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		ReleaseNotesPolicy string `gorm:"column:release_notes_policy;type:json"`
+	}
+
+	// Releases  : releases
+	type Releases struct {
+		Notes string `gorm:"column:notes;type:json"`
+	}
+
+	// Applications drop column
+	if tx.Migrator().HasColumn(&Applications{}, "release_notes_policy") {
+		if err := tx.Migrator().DropColumn(&Applications{}, "release_notes_policy"); err != nil {
+			return err
+		}
+	}
+
+	// Releases drop column
+	if tx.Migrator().HasColumn(&Releases{}, "notes") {
+		if err := tx.Migrator().DropColumn(&Releases{}, "notes"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
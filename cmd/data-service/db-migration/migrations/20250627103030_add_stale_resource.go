@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250627103030",
+		Name:    "20250627103030_add_stale_resource",
+		Mode:    migrator.GormMode,
+		Up:      mig20250627103030Up,
+		Down:    mig20250627103030Down,
+	})
+}
+
+// mig20250627103030Up for up migration
+func mig20250627103030Up(tx *gorm.DB) error {
+	// StaleResources : 闲置资源
+	type StaleResources struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		ResType      string     `gorm:"type:varchar(20) not null;uniqueIndex:idx_bizID_resType_resID,priority:2"`
+		ResID        uint       `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_resType_resID,priority:3"`
+		Name         string     `gorm:"type:varchar(255) not null"`
+		Reason       string     `gorm:"type:varchar(255) default ''"`
+		LastActiveAt *time.Time `gorm:"type:datetime(6) default null"`
+		NotifiedAt   time.Time  `gorm:"type:datetime(6) not null"`
+		ArchivedAt   *time.Time `gorm:"type:datetime(6) default null"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_resType_resID,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;default:0"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&StaleResources{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "stale_resources", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250627103030Down for down migration
+func mig20250627103030Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"stale_resources",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("stale_resources"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250628101020",
+		Name:    "20250628101020_add_biz_usage",
+		Mode:    migrator.GormMode,
+		Up:      mig20250628101020Up,
+		Down:    mig20250628101020Down,
+	})
+}
+
+// mig20250628101020Up for up migration
+func mig20250628101020Up(tx *gorm.DB) error {
+	// AppConsumptionStats : 服务每日消费统计
+	type AppConsumptionStats struct {
+		WatchSeconds uint64 `gorm:"column:watch_seconds;type:bigint(1) unsigned not null;default:0"`
+	}
+
+	// BizUsages : 业务每月用量
+	type BizUsages struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Month        string    `gorm:"type:varchar(7) not null;uniqueIndex:idx_bizID_month,priority:2"`
+		StorageBytes uint64    `gorm:"type:bigint(1) unsigned not null;default:0"`
+		EgressBytes  uint64    `gorm:"type:bigint(1) unsigned not null;default:0"`
+		APICalls     uint64    `gorm:"column:api_calls;type:bigint(1) unsigned not null;default:0"`
+		WatchSeconds uint64    `gorm:"type:bigint(1) unsigned not null;default:0"`
+		UpdatedAt    time.Time `gorm:"type:datetime(6) not null"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_month,priority:1"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// AppConsumptionStats add new column
+	if !tx.Migrator().HasColumn(&AppConsumptionStats{}, "watch_seconds") {
+		if err := tx.Migrator().AddColumn(&AppConsumptionStats{}, "watch_seconds"); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&BizUsages{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "biz_usages", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250628101020Down for down migration
+func mig20250628101020Down(tx *gorm.DB) error {
+	// AppConsumptionStats : 服务每日消费统计
+	type AppConsumptionStats struct {
+		WatchSeconds uint64 `gorm:"column:watch_seconds;type:bigint(1) unsigned not null;default:0"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"biz_usages",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("biz_usages"); err != nil {
+		return err
+	}
+
+	// AppConsumptionStats drop column
+	if tx.Migrator().HasColumn(&AppConsumptionStats{}, "watch_seconds") {
+		if err := tx.Migrator().DropColumn(&AppConsumptionStats{}, "watch_seconds"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
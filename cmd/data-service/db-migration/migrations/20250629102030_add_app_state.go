@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250629102030",
+		Name:    "20250629102030_add_app_state",
+		Mode:    migrator.GormMode,
+		Up:      mig20250629102030Up,
+		Down:    mig20250629102030Down,
+	})
+}
+
+// mig20250629102030Up for up migration
+func mig20250629102030Up(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		State string `gorm:"column:state;type:varchar(20);default:'active';NOT NULL"`
+	}
+
+	// Applications add new column
+	if !tx.Migrator().HasColumn(&Applications{}, "state") {
+		if err := tx.Migrator().AddColumn(&Applications{}, "state"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250629102030Down for down migration
+func mig20250629102030Down(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		State string `gorm:"column:state;type:varchar(20);default:'active';NOT NULL"`
+	}
+
+	// Applications drop column
+	if tx.Migrator().HasColumn(&Applications{}, "state") {
+		if err := tx.Migrator().DropColumn(&Applications{}, "state"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
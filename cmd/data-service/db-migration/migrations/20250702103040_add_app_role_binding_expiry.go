@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250702103040",
+		Name:    "20250702103040_add_app_role_binding_expiry",
+		Mode:    migrator.GormMode,
+		Up:      mig20250702103040Up,
+		Down:    mig20250702103040Down,
+	})
+}
+
+// mig20250702103040Up for up migration
+func mig20250702103040Up(tx *gorm.DB) error {
+	// AppRoleBindings : 服务角色授权
+	type AppRoleBindings struct {
+		ExpiredAt *time.Time `gorm:"column:expired_at;type:datetime(6);index:idx_expiredAt"`
+		Reason    string     `gorm:"column:reason;type:varchar(256);default:''"`
+	}
+
+	// AppRoleBindings add new column
+	for _, column := range []string{"expired_at", "reason"} {
+		if !tx.Migrator().HasColumn(&AppRoleBindings{}, column) {
+			if err := tx.Migrator().AddColumn(&AppRoleBindings{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !tx.Migrator().HasIndex(&AppRoleBindings{}, "idx_expiredAt") {
+		if err := tx.Migrator().CreateIndex(&AppRoleBindings{}, "idx_expiredAt"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250702103040Down for down migration
+func mig20250702103040Down(tx *gorm.DB) error {
+	// AppRoleBindings : 服务角色授权
+	type AppRoleBindings struct {
+		ExpiredAt *time.Time `gorm:"column:expired_at;type:datetime(6);index:idx_expiredAt"`
+		Reason    string     `gorm:"column:reason;type:varchar(256);default:''"`
+	}
+
+	if tx.Migrator().HasIndex(&AppRoleBindings{}, "idx_expiredAt") {
+		if err := tx.Migrator().DropIndex(&AppRoleBindings{}, "idx_expiredAt"); err != nil {
+			return err
+		}
+	}
+
+	// AppRoleBindings drop column
+	for _, column := range []string{"expired_at", "reason"} {
+		if tx.Migrator().HasColumn(&AppRoleBindings{}, column) {
+			if err := tx.Migrator().DropColumn(&AppRoleBindings{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250703104050",
+		Name:    "20250703104050_add_idempotency_record",
+		Mode:    migrator.GormMode,
+		Up:      mig20250703104050Up,
+		Down:    mig20250703104050Down,
+	})
+}
+
+// mig20250703104050Up for up migration
+func mig20250703104050Up(tx *gorm.DB) error {
+	// IdempotencyRecords : 幂等请求记录
+	type IdempotencyRecords struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		IdemKey     string    `gorm:"type:varchar(128) not null;uniqueIndex:idx_bizID_user_key,priority:3"`
+		Method      string    `gorm:"type:varchar(255) not null"`
+		Fingerprint string    `gorm:"type:varchar(64) not null"`
+		State       string    `gorm:"type:varchar(20) not null"`
+		Response    []byte    `gorm:"type:mediumblob"`
+		ExpiredAt   time.Time `gorm:"type:datetime(6) not null;index:idx_expiredAt"`
+
+		// Attachment is attachment info of the resource
+		BizID uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_user_key,priority:1"`
+		User  string `gorm:"type:varchar(64) not null;uniqueIndex:idx_bizID_user_key,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&IdempotencyRecords{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "idempotency_records", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250703104050Down for down migration
+func mig20250703104050Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"idempotency_records",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("idempotency_records"); err != nil {
+		return err
+	}
+
+	return nil
+}
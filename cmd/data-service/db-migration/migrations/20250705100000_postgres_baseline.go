@@ -0,0 +1,1058 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250705100000",
+		Name:    "20250705100000_postgres_baseline",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250705100000Up,
+		Down:    mig20250705100000Down,
+	})
+}
+
+// baselineTables is all the tables of the postgres and sqlite baseline, tables added after the baseline
+// should be created by their own migrations for each dialect.
+var baselineTables = []string{
+	"audits", "applications", "archived_apps", "config_items", "released_config_items", "commits", "contents",
+	"resource_locks", "events", "credentials", "credential_scopes", "strategies", "groups", "released_groups",
+	"group_app_binds", "releases", "hooks", "hook_revisions", "released_hooks", "template_spaces", "templates",
+	"template_sets", "template_revisions", "template_variables", "app_template_bindings", "released_app_templates",
+	"app_template_variables", "released_app_template_variables", "kvs", "released_kvs", "clients", "client_events",
+	"client_querys", "configs", "hook_exec_results", "hook_templates", "hook_template_refs", "emergency_publishes",
+	"environments", "env_app_bindings", "config_shares", "config_share_refs", "recycle_bins", "app_snapshots",
+	"drafts", "full_text_docs", "config_references", "certificates", "kv_overrides", "released_kv_overrides",
+	"compliance_policies", "secret_scan_settings", "app_consumption_stats", "stale_resources", "biz_usages",
+	"service_accounts", "app_role_bindings", "idempotency_records", "operations",
+}
+
+// baselineUniqueIndexes is the unique indexes which the dao layer depends on, such as upsert conflict target.
+var baselineUniqueIndexes = []struct {
+	name    string
+	table   string
+	columns string
+}{
+	{"idx_bizID_name", "applications", "biz_id, name"},
+	{"idx_bizID_name", "groups", "biz_id, name"},
+	{"idx_bizID_appID_name", "releases", "biz_id, app_id, name"},
+	{"idx_bizID_appID_statDate", "app_consumption_stats", "biz_id, app_id, stat_date"},
+	{"idx_bizID_month", "biz_usages", "biz_id, month"},
+	{"idx_bizID_appID_uid", "clients", "biz_id, app_id, uid"},
+	{"idx_cursorID_bizID_appID_uid", "client_events", "cursor_id, biz_id, app_id, uid"},
+	{"idx_bizID_user_idemKey", "idempotency_records", "biz_id, \"user\", idem_key"},
+	{"idx_bizID_resType_resKey", "resource_locks", "biz_id, res_type, res_key"},
+}
+
+// mig20250705100000Up for up migration
+func mig20250705100000Up(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint `gorm:"primaryKey"`
+		Resource  string
+		MaxID     uint
+		UpdatedAt time.Time
+	}
+
+	// the table structs is the snapshot of the schema when the baseline is added, do not change them, the
+	// later schema changes should be done by new migrations.
+	type Audit struct {
+		ID           uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID        uint32    `gorm:"column:biz_id"`
+		AppID        uint32    `gorm:"column:app_id"`
+		ResourceType string    `gorm:"column:res_type"`
+		ResourceID   uint32    `gorm:"column:res_id"`
+		Action       string    `gorm:"column:action"`
+		Rid          string    `gorm:"column:rid"`
+		AppCode      string    `gorm:"column:app_code"`
+		Operator     string    `gorm:"column:operator"`
+		CreatedAt    time.Time `gorm:"column:created_at"`
+		Detail       string    `gorm:"column:detail"`
+		ResInstance  string    `gorm:"column:res_instance"`
+		OperateWay   string    `gorm:"column:operate_way"`
+		Status       string    `gorm:"column:status"`
+		StrategyId   uint32    `gorm:"column:strategy_id"`
+		IsCompare    bool      `gorm:"column:is_compare"`
+	}
+	type App struct {
+		ID                 uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID              uint32     `gorm:"column:biz_id"`
+		Name               string     `gorm:"column:name"`
+		ConfigType         string     `gorm:"column:config_type"`
+		Memo               string     `gorm:"column:memo"`
+		Alias              string     `gorm:"column:alias"`
+		DataType           string     `gorm:"column:data_type"`
+		LastConsumedTime   *time.Time `gorm:"column:last_consumed_time"`
+		ApproveType        string     `gorm:"column:approve_type"`
+		IsApprove          bool       `gorm:"column:is_approve"`
+		Approver           string     `gorm:"column:approver"`
+		ReleaseNotesPolicy []byte     `gorm:"column:release_notes_policy"`
+		State              string     `gorm:"column:state"`
+		Creator            string     `gorm:"column:creator"`
+		Reviser            string     `gorm:"column:reviser"`
+		CreatedAt          time.Time  `gorm:"column:created_at"`
+		UpdatedAt          time.Time  `gorm:"column:updated_at"`
+	}
+	type ArchivedApp struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+	type ConfigItem struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Path      string    `gorm:"column:path"`
+		FileType  string    `gorm:"column:file_type"`
+		FileMode  string    `gorm:"column:file_mode"`
+		Memo      string    `gorm:"column:memo"`
+		User      string    `gorm:"column:user"`
+		UserGroup string    `gorm:"column:user_group"`
+		Privilege string    `gorm:"column:privilege"`
+		Charset   string    `gorm:"column:charset"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type ReleasedConfigItem struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID       uint32    `gorm:"column:release_id"`
+		CommitID        uint32    `gorm:"column:commit_id"`
+		ConfigItemID    uint32    `gorm:"column:config_item_id"`
+		ContentID       uint32    `gorm:"column:content_id"`
+		Signature       string    `gorm:"column:signature"`
+		ByteSize        uint64    `gorm:"column:byte_size"`
+		OriginSignature string    `gorm:"column:origin_signature"`
+		OriginByteSize  uint64    `gorm:"column:origin_byte_size"`
+		Md5             string    `gorm:"column:md5"`
+		Memo            string    `gorm:"column:memo"`
+		Name            string    `gorm:"column:name"`
+		Path            string    `gorm:"column:path"`
+		FileType        string    `gorm:"column:file_type"`
+		FileMode        string    `gorm:"column:file_mode"`
+		User            string    `gorm:"column:user"`
+		UserGroup       string    `gorm:"column:user_group"`
+		Privilege       string    `gorm:"column:privilege"`
+		Charset         string    `gorm:"column:charset"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		AppID           uint32    `gorm:"column:app_id"`
+		Creator         string    `gorm:"column:creator"`
+		Reviser         string    `gorm:"column:reviser"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type Commit struct {
+		ID           uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ContentID    uint32    `gorm:"column:content_id"`
+		Signature    string    `gorm:"column:signature"`
+		ByteSize     uint64    `gorm:"column:byte_size"`
+		Md5          string    `gorm:"column:md5"`
+		Memo         string    `gorm:"column:memo"`
+		BizID        uint32    `gorm:"column:biz_id"`
+		AppID        uint32    `gorm:"column:app_id"`
+		ConfigItemID uint32    `gorm:"column:config_item_id"`
+		Creator      string    `gorm:"column:creator"`
+		CreatedAt    time.Time `gorm:"column:created_at"`
+	}
+	type Content struct {
+		ID           uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Signature    string    `gorm:"column:signature"`
+		ByteSize     uint64    `gorm:"column:byte_size"`
+		Md5          string    `gorm:"column:md5"`
+		BizID        uint32    `gorm:"column:biz_id"`
+		AppID        uint32    `gorm:"column:app_id"`
+		ConfigItemID uint32    `gorm:"column:config_item_id"`
+		Creator      string    `gorm:"column:creator"`
+		CreatedAt    time.Time `gorm:"column:created_at"`
+	}
+	type ResourceLock struct {
+		ID       uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID    uint32 `gorm:"column:biz_id"`
+		ResType  string `gorm:"column:res_type"`
+		ResKey   string `gorm:"column:res_key"`
+		ResCount uint32 `gorm:"column:res_count"`
+	}
+	type Event struct {
+		ID          uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Resource    string    `gorm:"column:resource"`
+		ResourceID  uint32    `gorm:"column:resource_id"`
+		ResourceUid string    `gorm:"column:resource_uid"`
+		OpType      string    `gorm:"column:op_type"`
+		BizID       uint32    `gorm:"column:biz_id"`
+		AppID       uint32    `gorm:"column:app_id"`
+		FinalStatus uint      `gorm:"column:final_status"`
+		Creator     string    `gorm:"column:creator"`
+		CreatedAt   time.Time `gorm:"column:created_at"`
+	}
+	type Credential struct {
+		ID             uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		CredentialType string    `gorm:"column:credential_type"`
+		EncCredential  string    `gorm:"column:enc_credential"`
+		EncAlgorithm   string    `gorm:"column:enc_algorithm"`
+		Name           string    `gorm:"column:name"`
+		Memo           string    `gorm:"column:memo"`
+		Enable         bool      `gorm:"column:enable"`
+		ExpiredAt      time.Time `gorm:"column:expired_at"`
+		BizID          uint32    `gorm:"column:biz_id"`
+		Creator        string    `gorm:"column:creator"`
+		Reviser        string    `gorm:"column:reviser"`
+		CreatedAt      time.Time `gorm:"column:created_at"`
+		UpdatedAt      time.Time `gorm:"column:updated_at"`
+	}
+	type CredentialScope struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		CredentialScope string    `gorm:"column:credential_scope"`
+		ExpiredAt       time.Time `gorm:"column:expired_at"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		CredentialId    uint32    `gorm:"column:credential_id"`
+		Creator         string    `gorm:"column:creator"`
+		Reviser         string    `gorm:"column:reviser"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type Strategy struct {
+		ID                uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name              string    `gorm:"column:name"`
+		ReleaseID         uint32    `gorm:"column:release_id"`
+		AsDefault         bool      `gorm:"column:as_default"`
+		Scope             string    `gorm:"column:scope;type:json"`
+		Namespace         string    `gorm:"column:namespace"`
+		Memo              string    `gorm:"column:memo"`
+		PublishType       string    `gorm:"column:publish_type"`
+		PublishTime       string    `gorm:"column:publish_time"`
+		PublishStatus     string    `gorm:"column:publish_status"`
+		RejectReason      string    `gorm:"column:reject_reason"`
+		Approver          string    `gorm:"column:approver"`
+		ApproverProgress  string    `gorm:"column:approver_progress"`
+		FinalApprovalTime time.Time `gorm:"column:final_approval_time"`
+		ApproveType       string    `gorm:"column:approve_type"`
+		ItsmTicketType    string    `gorm:"column:itsm_ticket_type"`
+		ItsmTicketUrl     string    `gorm:"column:itsm_ticket_url"`
+		ItsmTicketSn      string    `gorm:"column:itsm_ticket_sn"`
+		ItsmTicketStatus  string    `gorm:"column:itsm_ticket_status"`
+		ItsmTicketStateID int       `gorm:"column:itsm_ticket_state_id"`
+		PubState          string    `gorm:"column:pub_state"`
+		BizID             uint32    `gorm:"column:biz_id"`
+		AppID             uint32    `gorm:"column:app_id"`
+		StrategySetID     uint32    `gorm:"column:strategy_set_id"`
+		Creator           string    `gorm:"column:creator"`
+		Reviser           string    `gorm:"column:reviser"`
+		CreatedAt         time.Time `gorm:"column:created_at"`
+		UpdatedAt         time.Time `gorm:"column:updated_at"`
+	}
+	type Group struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Public    bool      `gorm:"column:public"`
+		Mode      string    `gorm:"column:mode"`
+		Selector  string    `gorm:"column:selector;type:json"`
+		UID       string    `gorm:"column:uid"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type ReleasedGroup struct {
+		ID         uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		GroupID    uint32    `gorm:"column:group_id"`
+		AppID      uint32    `gorm:"column:app_id"`
+		ReleaseID  uint32    `gorm:"column:release_id"`
+		StrategyID uint32    `gorm:"column:strategy_id"`
+		Mode       string    `gorm:"column:mode"`
+		Selector   string    `gorm:"column:selector;type:json"`
+		UID        string    `gorm:"column:uid"`
+		Edited     bool      `gorm:"column:edited"`
+		BizID      uint32    `gorm:"column:biz_id"`
+		Reviser    string    `gorm:"column:reviser"`
+		UpdatedAt  time.Time `gorm:"column:updated_at"`
+	}
+	type GroupAppBind struct {
+		ID      uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+		GroupID uint32 `gorm:"column:group_id"`
+		AppID   uint32 `gorm:"column:app_id"`
+		BizID   uint32 `gorm:"column:biz_id"`
+	}
+	type Release struct {
+		ID            uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name          string    `gorm:"column:name"`
+		Memo          string    `gorm:"column:memo"`
+		Deprecated    bool      `gorm:"column:deprecated"`
+		PublishNum    uint32    `gorm:"column:publish_num"`
+		FullyReleased bool      `gorm:"column:fully_released"`
+		Notes         []byte    `gorm:"column:notes"`
+		BizID         uint32    `gorm:"column:biz_id"`
+		AppID         uint32    `gorm:"column:app_id"`
+		Creator       string    `gorm:"column:creator"`
+		CreatedAt     time.Time `gorm:"column:created_at"`
+	}
+	type Hook struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Type      string    `gorm:"column:type"`
+		Tags      string    `gorm:"column:tags;type:json;default:'[]'"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type HookRevision struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		State     string    `gorm:"column:state"`
+		Content   string    `gorm:"column:content"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		HookID    uint32    `gorm:"column:hook_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type ReleasedHook struct {
+		ID               uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		AppID            uint32    `gorm:"column:app_id"`
+		ReleaseID        uint32    `gorm:"column:release_id"`
+		HookID           uint32    `gorm:"column:hook_id"`
+		HookRevisionID   uint32    `gorm:"column:hook_revision_id"`
+		HookName         string    `gorm:"column:hook_name"`
+		HookRevisionName string    `gorm:"column:hook_revision_name"`
+		Content          string    `gorm:"column:content"`
+		ScriptType       string    `gorm:"column:script_type"`
+		HookType         string    `gorm:"column:hook_type"`
+		BizID            uint32    `gorm:"column:biz_id"`
+		Reviser          string    `gorm:"column:reviser"`
+		UpdatedAt        time.Time `gorm:"column:updated_at"`
+	}
+	type TemplateSpace struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type Template struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name            string    `gorm:"column:name"`
+		Path            string    `gorm:"column:path"`
+		Memo            string    `gorm:"column:memo"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		TemplateSpaceID uint32    `gorm:"column:template_space_id"`
+		Creator         string    `gorm:"column:creator"`
+		Reviser         string    `gorm:"column:reviser"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type TemplateSet struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name            string    `gorm:"column:name"`
+		Memo            string    `gorm:"column:memo"`
+		TemplateIDs     string    `gorm:"column:template_ids;type:json;default:'[]'"`
+		Public          bool      `gorm:"column:public"`
+		BoundApps       string    `gorm:"column:bound_apps;type:json;default:'[]'"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		TemplateSpaceID uint32    `gorm:"column:template_space_id"`
+		Creator         string    `gorm:"column:creator"`
+		Reviser         string    `gorm:"column:reviser"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type TemplateRevision struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		RevisionName    string    `gorm:"column:revision_name"`
+		RevisionMemo    string    `gorm:"column:revision_memo"`
+		Name            string    `gorm:"column:name"`
+		Path            string    `gorm:"column:path"`
+		FileType        string    `gorm:"column:file_type"`
+		FileMode        string    `gorm:"column:file_mode"`
+		User            string    `gorm:"column:user"`
+		UserGroup       string    `gorm:"column:user_group"`
+		Privilege       string    `gorm:"column:privilege"`
+		Signature       string    `gorm:"column:signature"`
+		ByteSize        uint64    `gorm:"column:byte_size"`
+		Md5             string    `gorm:"column:md5"`
+		Charset         string    `gorm:"column:charset"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		TemplateSpaceID uint32    `gorm:"column:template_space_id"`
+		TemplateID      uint32    `gorm:"column:template_id"`
+		Creator         string    `gorm:"column:creator"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+	}
+	type TemplateVariable struct {
+		ID         uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name       string    `gorm:"column:name"`
+		Type       string    `gorm:"column:type"`
+		DefaultVal string    `gorm:"column:default_val"`
+		Memo       string    `gorm:"column:memo"`
+		BizID      uint32    `gorm:"column:biz_id"`
+		Creator    string    `gorm:"column:creator"`
+		Reviser    string    `gorm:"column:reviser"`
+		CreatedAt  time.Time `gorm:"column:created_at"`
+		UpdatedAt  time.Time `gorm:"column:updated_at"`
+	}
+	type AppTemplateBinding struct {
+		ID                  uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		TemplateSpaceIDs    string    `gorm:"column:template_space_ids;type:json;default:'[]'"`
+		TemplateSetIDs      string    `gorm:"column:template_set_ids;type:json;default:'[]'"`
+		TemplateIDs         string    `gorm:"column:template_ids;type:json;default:'[]'"`
+		TemplateRevisionIDs string    `gorm:"column:template_revision_ids;type:json;default:'[]'"`
+		LatestTemplateIDs   string    `gorm:"column:latest_template_ids;type:json;default:'[]'"`
+		Bindings            string    `gorm:"column:bindings;type:json;default:'[]'"`
+		BizID               uint32    `gorm:"column:biz_id"`
+		AppID               uint32    `gorm:"column:app_id"`
+		Creator             string    `gorm:"column:creator"`
+		Reviser             string    `gorm:"column:reviser"`
+		CreatedAt           time.Time `gorm:"column:created_at"`
+		UpdatedAt           time.Time `gorm:"column:updated_at"`
+	}
+	type ReleasedAppTemplate struct {
+		ID                   uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID            uint32    `gorm:"column:release_id"`
+		TemplateSpaceID      uint32    `gorm:"column:template_space_id"`
+		TemplateSpaceName    string    `gorm:"column:template_space_name"`
+		TemplateSetID        uint32    `gorm:"column:template_set_id"`
+		TemplateSetName      string    `gorm:"column:template_set_name"`
+		TemplateID           uint32    `gorm:"column:template_id"`
+		Name                 string    `gorm:"column:name"`
+		Path                 string    `gorm:"column:path"`
+		TemplateRevisionID   uint32    `gorm:"column:template_revision_id"`
+		IsLatest             bool      `gorm:"column:is_latest"`
+		TemplateRevisionName string    `gorm:"column:template_revision_name"`
+		TemplateRevisionMemo string    `gorm:"column:template_revision_memo"`
+		FileType             string    `gorm:"column:file_type"`
+		FileMode             string    `gorm:"column:file_mode"`
+		User                 string    `gorm:"column:user"`
+		UserGroup            string    `gorm:"column:user_group"`
+		Privilege            string    `gorm:"column:privilege"`
+		Signature            string    `gorm:"column:signature"`
+		ByteSize             uint64    `gorm:"column:byte_size"`
+		OriginSignature      string    `gorm:"column:origin_signature"`
+		OriginByteSize       uint64    `gorm:"column:origin_byte_size"`
+		Md5                  string    `gorm:"column:md5"`
+		Charset              string    `gorm:"column:charset"`
+		BizID                uint32    `gorm:"column:biz_id"`
+		AppID                uint32    `gorm:"column:app_id"`
+		Creator              string    `gorm:"column:creator"`
+		Reviser              string    `gorm:"column:reviser"`
+		CreatedAt            time.Time `gorm:"column:created_at"`
+		UpdatedAt            time.Time `gorm:"column:updated_at"`
+	}
+	type AppTemplateVariable struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Variables string    `gorm:"column:variables;type:json;default:'[]'"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type ReleasedAppTemplateVariable struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID uint32    `gorm:"column:release_id"`
+		Variables string    `gorm:"column:variables;type:json;default:'[]'"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+	type Kv struct {
+		ID                        uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		KvState                   string     `gorm:"column:kv_state"`
+		Key                       string     `gorm:"column:key"`
+		Memo                      string     `gorm:"column:memo"`
+		KvType                    string     `gorm:"column:kv_type"`
+		Version                   uint32     `gorm:"column:version"`
+		SecretType                string     `gorm:"column:secret_type"`
+		SecretHidden              bool       `gorm:"column:secret_hidden"`
+		CertificateExpirationDate *time.Time `gorm:"column:certificate_expiration_date"`
+		BizID                     uint32     `gorm:"column:biz_id"`
+		AppID                     uint32     `gorm:"column:app_id"`
+		Creator                   string     `gorm:"column:creator"`
+		Reviser                   string     `gorm:"column:reviser"`
+		CreatedAt                 time.Time  `gorm:"column:created_at"`
+		UpdatedAt                 time.Time  `gorm:"column:updated_at"`
+		Signature                 string     `gorm:"column:signature"`
+		ByteSize                  uint64     `gorm:"column:byte_size"`
+		Md5                       string     `gorm:"column:md5"`
+	}
+	type ReleasedKv struct {
+		ID                        uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID                 uint32     `gorm:"column:release_id"`
+		Key                       string     `gorm:"column:key"`
+		Memo                      string     `gorm:"column:memo"`
+		KvType                    string     `gorm:"column:kv_type"`
+		Version                   uint32     `gorm:"column:version"`
+		SecretType                string     `gorm:"column:secret_type"`
+		SecretHidden              bool       `gorm:"column:secret_hidden"`
+		CertificateExpirationDate *time.Time `gorm:"column:certificate_expiration_date"`
+		BizID                     uint32     `gorm:"column:biz_id"`
+		AppID                     uint32     `gorm:"column:app_id"`
+		Creator                   string     `gorm:"column:creator"`
+		Reviser                   string     `gorm:"column:reviser"`
+		CreatedAt                 time.Time  `gorm:"column:created_at"`
+		UpdatedAt                 time.Time  `gorm:"column:updated_at"`
+		Signature                 string     `gorm:"column:signature"`
+		ByteSize                  uint64     `gorm:"column:byte_size"`
+		Md5                       string     `gorm:"column:md5"`
+	}
+	type Client struct {
+		ID                        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		UID                       string    `gorm:"column:uid"`
+		BizID                     uint32    `gorm:"column:biz_id"`
+		AppID                     uint32    `gorm:"column:app_id"`
+		ClientVersion             string    `gorm:"column:client_version"`
+		ClientType                string    `gorm:"column:client_type"`
+		Ip                        string    `gorm:"column:ip"`
+		Labels                    string    `gorm:"column:labels"`
+		Annotations               string    `gorm:"column:annotations"`
+		FirstConnectTime          time.Time `gorm:"column:first_connect_time"`
+		LastHeartbeatTime         time.Time `gorm:"column:last_heartbeat_time"`
+		OnlineStatus              string    `gorm:"column:online_status"`
+		CpuUsage                  float64   `gorm:"column:cpu_usage"`
+		CpuMaxUsage               float64   `gorm:"column:cpu_max_usage"`
+		CpuMinUsage               float64   `gorm:"column:cpu_min_usage"`
+		CpuAvgUsage               float64   `gorm:"column:cpu_avg_usage"`
+		MemoryUsage               uint64    `gorm:"column:memory_usage"`
+		MemoryMaxUsage            uint64    `gorm:"column:memory_max_usage"`
+		MemoryMinUsage            uint64    `gorm:"column:memory_min_usage"`
+		MemoryAvgUsage            uint64    `gorm:"column:memory_avg_usage"`
+		CurrentReleaseID          uint32    `gorm:"column:current_release_id"`
+		TargetReleaseID           uint32    `gorm:"column:target_release_id"`
+		ReleaseChangeStatus       string    `gorm:"column:release_change_status"`
+		ReleaseChangeFailedReason string    `gorm:"column:release_change_failed_reason"`
+		SpecificFailedReason      string    `gorm:"column:specific_failed_reason"`
+		FailedDetailReason        string    `gorm:"column:failed_detail_reason"`
+		TotalSeconds              float64   `gorm:"column:total_seconds"`
+	}
+	type ClientEvent struct {
+		ID                        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ClientID                  uint32    `gorm:"column:client_id"`
+		CursorID                  string    `gorm:"column:cursor_id"`
+		UID                       string    `gorm:"column:uid"`
+		BizID                     uint32    `gorm:"column:biz_id"`
+		AppID                     uint32    `gorm:"column:app_id"`
+		ClientMode                string    `gorm:"column:client_mode"`
+		OriginalReleaseID         uint32    `gorm:"column:original_release_id"`
+		TargetReleaseID           uint32    `gorm:"column:target_release_id"`
+		StartTime                 time.Time `gorm:"column:start_time"`
+		EndTime                   time.Time `gorm:"column:end_time"`
+		TotalSeconds              float64   `gorm:"column:total_seconds"`
+		TotalFileSize             float64   `gorm:"column:total_file_size"`
+		DownloadFileSize          float64   `gorm:"column:download_file_size"`
+		TotalFileNum              uint32    `gorm:"column:total_file_num"`
+		DownloadFileNum           uint32    `gorm:"column:download_file_num"`
+		ReleaseChangeStatus       string    `gorm:"column:release_change_status"`
+		ReleaseChangeFailedReason string    `gorm:"column:release_change_failed_reason"`
+		SpecificFailedReason      string    `gorm:"column:specific_failed_reason"`
+		FailedDetailReason        string    `gorm:"column:failed_detail_reason"`
+	}
+	type ClientQuery struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		AppID           uint32    `gorm:"column:app_id"`
+		Creator         string    `gorm:"column:creator"`
+		SearchName      string    `gorm:"column:search_name"`
+		SearchType      string    `gorm:"column:search_type"`
+		SearchCondition string    `gorm:"column:search_condition"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type Config struct {
+		ID    uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Key   string `gorm:"column:key"`
+		Value string `gorm:"column:value"`
+	}
+	type HookExecResult struct {
+		ID         uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		BizID      uint32    `gorm:"column:biz_id"`
+		AppID      uint32    `gorm:"column:app_id"`
+		ReleaseID  uint32    `gorm:"column:release_id"`
+		UID        string    `gorm:"column:uid"`
+		HookType   string    `gorm:"column:hook_type"`
+		HookName   string    `gorm:"column:hook_name"`
+		ExitCode   int32     `gorm:"column:exit_code"`
+		DurationMs uint32    `gorm:"column:duration_ms"`
+		Stdout     string    `gorm:"column:stdout"`
+		Stderr     string    `gorm:"column:stderr"`
+		Ip         string    `gorm:"column:ip"`
+		ClientType string    `gorm:"column:client_type"`
+		ExecutedAt time.Time `gorm:"column:executed_at"`
+		CreatedAt  time.Time `gorm:"column:created_at"`
+	}
+	type HookTemplate struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Type      string    `gorm:"column:type"`
+		Content   string    `gorm:"column:content"`
+		Params    string    `gorm:"column:params;type:json;default:'[]'"`
+		Memo      string    `gorm:"column:memo"`
+		Version   uint32    `gorm:"column:version"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type HookTemplateRef struct {
+		ID              uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Args            string    `gorm:"column:args;type:json;default:'{}'"`
+		TemplateVersion uint32    `gorm:"column:template_version"`
+		AutoUpgrade     bool      `gorm:"column:auto_upgrade"`
+		BizID           uint32    `gorm:"column:biz_id"`
+		HookID          uint32    `gorm:"column:hook_id"`
+		TemplateID      uint32    `gorm:"column:template_id"`
+		Creator         string    `gorm:"column:creator"`
+		Reviser         string    `gorm:"column:reviser"`
+		CreatedAt       time.Time `gorm:"column:created_at"`
+		UpdatedAt       time.Time `gorm:"column:updated_at"`
+	}
+	type EmergencyPublish struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID uint32    `gorm:"column:release_id"`
+		GroupID   uint32    `gorm:"column:group_id"`
+		UIDs      string    `gorm:"column:uids;type:json"`
+		ExpiredAt time.Time `gorm:"column:expired_at"`
+		State     string    `gorm:"column:state"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type Environment struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Memo      string    `gorm:"column:memo"`
+		Position  uint32    `gorm:"column:position"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type EnvAppBinding struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		EnvID     uint32    `gorm:"column:env_id"`
+		AppKey    string    `gorm:"column:app_key"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type ConfigShare struct {
+		ID           uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name         string    `gorm:"column:name"`
+		ConfigType   string    `gorm:"column:config_type"`
+		ConfigItemID uint32    `gorm:"column:config_item_id"`
+		Key          string    `gorm:"column:key"`
+		Grantees     string    `gorm:"column:grantees;type:json"`
+		Memo         string    `gorm:"column:memo"`
+		BizID        uint32    `gorm:"column:biz_id"`
+		AppID        uint32    `gorm:"column:app_id"`
+		Creator      string    `gorm:"column:creator"`
+		Reviser      string    `gorm:"column:reviser"`
+		CreatedAt    time.Time `gorm:"column:created_at"`
+		UpdatedAt    time.Time `gorm:"column:updated_at"`
+	}
+	type ConfigShareRef struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ShareID   uint32    `gorm:"column:share_id"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type RecycleBin struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ResType   string    `gorm:"column:res_type"`
+		ResID     uint32    `gorm:"column:res_id"`
+		Name      string    `gorm:"column:name"`
+		Data      string    `gorm:"column:data"`
+		ExpiredAt time.Time `gorm:"column:expired_at"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+	type AppSnapshot struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Memo      string    `gorm:"column:memo"`
+		Data      string    `gorm:"column:data;type:json"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+	type Draft struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Items     string    `gorm:"column:items;type:json"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type FullTextDoc struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ResType   string    `gorm:"column:res_type"`
+		ResID     uint32    `gorm:"column:res_id"`
+		Name      string    `gorm:"column:name"`
+		Memo      string    `gorm:"column:memo"`
+		Content   string    `gorm:"column:content"`
+		Signature string    `gorm:"column:signature"`
+		IndexedAt time.Time `gorm:"column:indexed_at"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+	}
+	type ConfigReference struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		SrcType   string    `gorm:"column:src_type"`
+		SrcID     uint32    `gorm:"column:src_id"`
+		DstType   string    `gorm:"column:dst_type"`
+		DstID     uint32    `gorm:"column:dst_id"`
+		RefType   string    `gorm:"column:ref_type"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		SrcAppID  uint32    `gorm:"column:src_app_id"`
+		DstAppID  uint32    `gorm:"column:dst_app_id"`
+		Creator   string    `gorm:"column:creator"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+	}
+	type Certificate struct {
+		ID           uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ResType      string     `gorm:"column:res_type"`
+		ResID        uint32     `gorm:"column:res_id"`
+		Name         string     `gorm:"column:name"`
+		Signature    string     `gorm:"column:signature"`
+		Subject      string     `gorm:"column:subject"`
+		DNSNames     string     `gorm:"column:dns_names"`
+		NotBefore    time.Time  `gorm:"column:not_before"`
+		NotAfter     time.Time  `gorm:"column:not_after"`
+		BizID        uint32     `gorm:"column:biz_id"`
+		AppID        uint32     `gorm:"column:app_id"`
+		WarnedAt     *time.Time `gorm:"column:warned_at"`
+		RenewStatus  string     `gorm:"column:renew_status"`
+		RenewMessage string     `gorm:"column:renew_message"`
+		ScannedAt    time.Time  `gorm:"column:scanned_at"`
+	}
+	type KvOverride struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Key       string    `gorm:"column:key"`
+		GroupID   uint32    `gorm:"column:group_id"`
+		Priority  uint32    `gorm:"column:priority"`
+		Version   uint32    `gorm:"column:version"`
+		Memo      string    `gorm:"column:memo"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+		Signature string    `gorm:"column:signature"`
+		ByteSize  uint64    `gorm:"column:byte_size"`
+		Md5       string    `gorm:"column:md5"`
+	}
+	type ReleasedKvOverride struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ReleaseID uint32    `gorm:"column:release_id"`
+		Key       string    `gorm:"column:key"`
+		GroupID   uint32    `gorm:"column:group_id"`
+		Priority  uint32    `gorm:"column:priority"`
+		Version   uint32    `gorm:"column:version"`
+		Memo      string    `gorm:"column:memo"`
+		Mode      string    `gorm:"column:mode"`
+		Selector  string    `gorm:"column:selector;type:json"`
+		UID       string    `gorm:"column:uid"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		AppID     uint32    `gorm:"column:app_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+		Signature string    `gorm:"column:signature"`
+		ByteSize  uint64    `gorm:"column:byte_size"`
+		Md5       string    `gorm:"column:md5"`
+	}
+	type CompliancePolicy struct {
+		ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name      string    `gorm:"column:name"`
+		Memo      string    `gorm:"column:memo"`
+		Severity  string    `gorm:"column:severity"`
+		Enabled   bool      `gorm:"column:enabled"`
+		Target    string    `gorm:"column:target"`
+		EnvIDs    string    `gorm:"column:env_ids;type:json"`
+		Rule      string    `gorm:"column:rule;type:json"`
+		BizID     uint32    `gorm:"column:biz_id"`
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+	type SecretScanSetting struct {
+		ID            uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Mode          string    `gorm:"column:mode"`
+		DisabledRules string    `gorm:"column:disabled_rules;type:json"`
+		CustomRules   string    `gorm:"column:custom_rules;type:json"`
+		BizID         uint32    `gorm:"column:biz_id"`
+		Creator       string    `gorm:"column:creator"`
+		Reviser       string    `gorm:"column:reviser"`
+		CreatedAt     time.Time `gorm:"column:created_at"`
+		UpdatedAt     time.Time `gorm:"column:updated_at"`
+	}
+	type AppConsumptionStat struct {
+		ID            uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		StatDate      string    `gorm:"column:stat_date"`
+		PullCount     uint64    `gorm:"column:pull_count"`
+		DownloadBytes uint64    `gorm:"column:download_bytes"`
+		WatchSeconds  uint64    `gorm:"column:watch_seconds"`
+		UpdatedAt     time.Time `gorm:"column:updated_at"`
+		BizID         uint32    `gorm:"column:biz_id"`
+		AppID         uint32    `gorm:"column:app_id"`
+	}
+	type StaleResource struct {
+		ID           uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		ResType      string     `gorm:"column:res_type"`
+		ResID        uint32     `gorm:"column:res_id"`
+		Name         string     `gorm:"column:name"`
+		Reason       string     `gorm:"column:reason"`
+		LastActiveAt *time.Time `gorm:"column:last_active_at"`
+		NotifiedAt   time.Time  `gorm:"column:notified_at"`
+		ArchivedAt   *time.Time `gorm:"column:archived_at"`
+		BizID        uint32     `gorm:"column:biz_id"`
+		AppID        uint32     `gorm:"column:app_id"`
+	}
+	type BizUsage struct {
+		ID           uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Month        string    `gorm:"column:month"`
+		StorageBytes uint64    `gorm:"column:storage_bytes"`
+		EgressBytes  uint64    `gorm:"column:egress_bytes"`
+		APICalls     uint64    `gorm:"column:api_calls"`
+		WatchSeconds uint64    `gorm:"column:watch_seconds"`
+		UpdatedAt    time.Time `gorm:"column:updated_at"`
+		BizID        uint32    `gorm:"column:biz_id"`
+	}
+	type ServiceAccount struct {
+		ID         uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Name       string     `gorm:"column:name"`
+		Memo       string     `gorm:"column:memo"`
+		KeyHash    string     `gorm:"column:key_hash"`
+		KeyPrefix  string     `gorm:"column:key_prefix"`
+		Scopes     string     `gorm:"column:scopes;type:json"`
+		Enabled    bool       `gorm:"column:enabled"`
+		ExpiredAt  *time.Time `gorm:"column:expired_at"`
+		LastUsedAt *time.Time `gorm:"column:last_used_at"`
+		BizID      uint32     `gorm:"column:biz_id"`
+		Creator    string     `gorm:"column:creator"`
+		Reviser    string     `gorm:"column:reviser"`
+		CreatedAt  time.Time  `gorm:"column:created_at"`
+		UpdatedAt  time.Time  `gorm:"column:updated_at"`
+	}
+	type AppRoleBinding struct {
+		ID        uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		User      string     `gorm:"column:user"`
+		Role      string     `gorm:"column:role"`
+		ExpiredAt *time.Time `gorm:"column:expired_at"`
+		Reason    string     `gorm:"column:reason"`
+		BizID     uint32     `gorm:"column:biz_id"`
+		AppID     uint32     `gorm:"column:app_id"`
+		Creator   string     `gorm:"column:creator"`
+		Reviser   string     `gorm:"column:reviser"`
+		CreatedAt time.Time  `gorm:"column:created_at"`
+		UpdatedAt time.Time  `gorm:"column:updated_at"`
+	}
+	type IdempotencyRecord struct {
+		ID          uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Key         string    `gorm:"column:idem_key"`
+		Method      string    `gorm:"column:method"`
+		Fingerprint string    `gorm:"column:fingerprint"`
+		State       string    `gorm:"column:state"`
+		Response    []byte    `gorm:"column:response"`
+		ExpiredAt   time.Time `gorm:"column:expired_at"`
+		BizID       uint32    `gorm:"column:biz_id"`
+		User        string    `gorm:"column:user"`
+		Creator     string    `gorm:"column:creator"`
+		Reviser     string    `gorm:"column:reviser"`
+		CreatedAt   time.Time `gorm:"column:created_at"`
+		UpdatedAt   time.Time `gorm:"column:updated_at"`
+	}
+	type Operation struct {
+		ID              uint32     `gorm:"column:id;primaryKey;autoIncrement:false"`
+		Type            string     `gorm:"column:type"`
+		State           string     `gorm:"column:state"`
+		Total           uint32     `gorm:"column:total"`
+		Succeeded       uint32     `gorm:"column:succeeded"`
+		Failed          uint32     `gorm:"column:failed"`
+		Errors          string     `gorm:"column:errors;type:json"`
+		Result          string     `gorm:"column:result"`
+		CancelRequested bool       `gorm:"column:cancel_requested"`
+		FinishedAt      *time.Time `gorm:"column:finished_at"`
+		BizID           uint32     `gorm:"column:biz_id"`
+		AppID           uint32     `gorm:"column:app_id"`
+		Creator         string     `gorm:"column:creator"`
+		Reviser         string     `gorm:"column:reviser"`
+		CreatedAt       time.Time  `gorm:"column:created_at"`
+		UpdatedAt       time.Time  `gorm:"column:updated_at"`
+	}
+	models := map[string]interface{}{
+		"audits":                          &Audit{},
+		"applications":                    &App{},
+		"archived_apps":                   &ArchivedApp{},
+		"config_items":                    &ConfigItem{},
+		"released_config_items":           &ReleasedConfigItem{},
+		"commits":                         &Commit{},
+		"contents":                        &Content{},
+		"resource_locks":                  &ResourceLock{},
+		"events":                          &Event{},
+		"credentials":                     &Credential{},
+		"credential_scopes":               &CredentialScope{},
+		"strategies":                      &Strategy{},
+		"groups":                          &Group{},
+		"released_groups":                 &ReleasedGroup{},
+		"group_app_binds":                 &GroupAppBind{},
+		"releases":                        &Release{},
+		"hooks":                           &Hook{},
+		"hook_revisions":                  &HookRevision{},
+		"released_hooks":                  &ReleasedHook{},
+		"template_spaces":                 &TemplateSpace{},
+		"templates":                       &Template{},
+		"template_sets":                   &TemplateSet{},
+		"template_revisions":              &TemplateRevision{},
+		"template_variables":              &TemplateVariable{},
+		"app_template_bindings":           &AppTemplateBinding{},
+		"released_app_templates":          &ReleasedAppTemplate{},
+		"app_template_variables":          &AppTemplateVariable{},
+		"released_app_template_variables": &ReleasedAppTemplateVariable{},
+		"kvs":                             &Kv{},
+		"released_kvs":                    &ReleasedKv{},
+		"clients":                         &Client{},
+		"client_events":                   &ClientEvent{},
+		"client_querys":                   &ClientQuery{},
+		"configs":                         &Config{},
+		"hook_exec_results":               &HookExecResult{},
+		"hook_templates":                  &HookTemplate{},
+		"hook_template_refs":              &HookTemplateRef{},
+		"emergency_publishes":             &EmergencyPublish{},
+		"environments":                    &Environment{},
+		"env_app_bindings":                &EnvAppBinding{},
+		"config_shares":                   &ConfigShare{},
+		"config_share_refs":               &ConfigShareRef{},
+		"recycle_bins":                    &RecycleBin{},
+		"app_snapshots":                   &AppSnapshot{},
+		"drafts":                          &Draft{},
+		"full_text_docs":                  &FullTextDoc{},
+		"config_references":               &ConfigReference{},
+		"certificates":                    &Certificate{},
+		"kv_overrides":                    &KvOverride{},
+		"released_kv_overrides":           &ReleasedKvOverride{},
+		"compliance_policies":             &CompliancePolicy{},
+		"secret_scan_settings":            &SecretScanSetting{},
+		"app_consumption_stats":           &AppConsumptionStat{},
+		"stale_resources":                 &StaleResource{},
+		"biz_usages":                      &BizUsage{},
+		"service_accounts":                &ServiceAccount{},
+		"app_role_bindings":               &AppRoleBinding{},
+		"idempotency_records":             &IdempotencyRecord{},
+		"operations":                      &Operation{},
+	}
+
+	// id_generators is created by sql, the sqlite driver can not create the auto increment primary key from model
+	ddl := `CREATE TABLE IF NOT EXISTS id_generators (
+		id BIGSERIAL PRIMARY KEY,
+		resource VARCHAR ( 50 ) NOT NULL,
+		max_id BIGINT NOT NULL,
+		updated_at TIMESTAMP ( 6 ) NOT NULL
+	);`
+	if tx.Dialector.Name() == migrator.SQLiteDialect {
+		ddl = `CREATE TABLE IF NOT EXISTS id_generators (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		resource VARCHAR ( 50 ) NOT NULL,
+		max_id BIGINT NOT NULL,
+		updated_at DATETIME NOT NULL
+	);`
+	}
+	if err := tx.Exec(ddl).Error; err != nil {
+		return err
+	}
+	if err := tx.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_resource ON id_generators (resource)`).Error; err != nil {
+		return err
+	}
+
+	now := time.Now()
+	generators := make([]IDGenerators, 0, len(baselineTables))
+	for _, name := range baselineTables {
+		model, ok := models[name]
+		if !ok {
+			return fmt.Errorf("can not get the model of table %s", name)
+		}
+		if err := tx.Table(name).AutoMigrate(model); err != nil {
+			return fmt.Errorf("migrate table %s failed, err: %v", name, err)
+		}
+		generators = append(generators, IDGenerators{Resource: name, MaxID: 0, UpdatedAt: now})
+	}
+
+	for _, idx := range baselineUniqueIndexes {
+		// index name of postgres and sqlite is unique in schema, so prefix it with the table name
+		if err := tx.Exec(fmt.Sprintf(`CREATE UNIQUE INDEX IF NOT EXISTS "%s_%s" ON "%s" (%s)`,
+			idx.table, idx.name, idx.table, idx.columns)).Error; err != nil {
+			return err
+		}
+	}
+
+	if result := tx.Create(generators); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250705100000Down for down migration
+func mig20250705100000Down(tx *gorm.DB) error {
+	for _, name := range baselineTables {
+		if err := tx.Migrator().DropTable(name); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Migrator().DropTable("id_generators"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250711100000",
+		Name:    "20250711100000_add_alert_rule",
+		Mode:    migrator.GormMode,
+		Up:      mig20250711100000Up,
+		Down:    mig20250711100000Down,
+	})
+}
+
+// mig20250711100000Up for up migration
+func mig20250711100000Up(tx *gorm.DB) error {
+	// AlertRules : 平台指标及事件的告警规则
+	type AlertRules struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name       string  `gorm:"type:varchar(255) not null;uniqueIndex:idx_bizID_name,priority:2"`
+		Metric     string  `gorm:"type:varchar(64) not null"`
+		Threshold  float64 `gorm:"type:double not null;default:0"`
+		WindowMin  uint    `gorm:"type:int unsigned not null;default:0"`
+		GroupID    uint    `gorm:"column:group_id;type:bigint(1) unsigned not null;default:0"`
+		ChannelIDs string  `gorm:"column:channel_ids;type:json not null"`
+		SilenceMin uint    `gorm:"type:int unsigned not null;default:0"`
+		Enabled    bool    `gorm:"type:tinyint(1) not null;default:1"`
+		Memo       string  `gorm:"type:varchar(256) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_name,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;default:0"`
+
+		// State is the evaluation state of the rule
+		Firing          bool       `gorm:"type:tinyint(1) not null;default:0"`
+		LastValue       float64    `gorm:"type:double not null;default:0"`
+		LastFiredAt     *time.Time `gorm:"type:datetime(6) default null"`
+		LastEvaluatedAt *time.Time `gorm:"type:datetime(6) default null"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&AlertRules{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "alert_rules", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250711100000Down for down migration
+func mig20250711100000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"alert_rules",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("alert_rules"); err != nil {
+		return err
+	}
+
+	return nil
+}
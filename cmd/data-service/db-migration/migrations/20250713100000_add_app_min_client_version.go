@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250713100000",
+		Name:    "20250713100000_add_app_min_client_version",
+		Mode:    migrator.GormMode,
+		Up:      mig20250713100000Up,
+		Down:    mig20250713100000Down,
+	})
+}
+
+// mig20250713100000Up for up migration
+func mig20250713100000Up(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		MinClientVersion string `gorm:"column:min_client_version;type:varchar(64);default:''"`
+	}
+
+	// Applications add new column
+	if !tx.Migrator().HasColumn(&Applications{}, "min_client_version") {
+		if err := tx.Migrator().AddColumn(&Applications{}, "min_client_version"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250713100000Down for down migration
+func mig20250713100000Down(tx *gorm.DB) error {
+	// Applications  : applications
+	type Applications struct {
+		MinClientVersion string `gorm:"column:min_client_version;type:varchar(64);default:''"`
+	}
+
+	// Applications drop column
+	if tx.Migrator().HasColumn(&Applications{}, "min_client_version") {
+		if err := tx.Migrator().DropColumn(&Applications{}, "min_client_version"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250714100000",
+		Name:    "20250714100000_add_kv_expiry",
+		Mode:    migrator.GormMode,
+		Up:      mig20250714100000Up,
+		Down:    mig20250714100000Down,
+	})
+}
+
+// mig20250714100000Up for up migration
+func mig20250714100000Up(tx *gorm.DB) error {
+	// Kvs  : KV
+	type Kvs struct {
+		ExpiredAt       *time.Time `gorm:"column:expired_at;type:datetime(6);default:NULL"`
+		ExpiredFallback string     `gorm:"column:expired_fallback;type:text"`
+	}
+
+	// ReleasedKvs  : 发布的KV
+	type ReleasedKvs struct {
+		ExpiredAt       *time.Time `gorm:"column:expired_at;type:datetime(6);default:NULL"`
+		ExpiredFallback string     `gorm:"column:expired_fallback;type:text"`
+		ExpiryNotified  bool       `gorm:"column:expiry_notified;type:tinyint(1) not null;default:0"`
+	}
+
+	// Kvs add new columns
+	for _, column := range []string{"expired_at", "expired_fallback"} {
+		if !tx.Migrator().HasColumn(&Kvs{}, column) {
+			if err := tx.Migrator().AddColumn(&Kvs{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Released Kvs add new columns
+	for _, column := range []string{"expired_at", "expired_fallback", "expiry_notified"} {
+		if !tx.Migrator().HasColumn(&ReleasedKvs{}, column) {
+			if err := tx.Migrator().AddColumn(&ReleasedKvs{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mig20250714100000Down for down migration
+func mig20250714100000Down(tx *gorm.DB) error {
+	// Kvs  : KV
+	type Kvs struct{}
+
+	// ReleasedKvs  : 发布的KV
+	type ReleasedKvs struct{}
+
+	// Kvs drop columns
+	for _, column := range []string{"expired_at", "expired_fallback"} {
+		if tx.Migrator().HasColumn(&Kvs{}, column) {
+			if err := tx.Migrator().DropColumn(&Kvs{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Released Kvs drop columns
+	for _, column := range []string{"expired_at", "expired_fallback", "expiry_notified"} {
+		if tx.Migrator().HasColumn(&ReleasedKvs{}, column) {
+			if err := tx.Migrator().DropColumn(&ReleasedKvs{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250715100000",
+		Name:    "20250715100000_add_experiment",
+		Mode:    migrator.GormMode,
+		Up:      mig20250715100000Up,
+		Down:    mig20250715100000Down,
+	})
+}
+
+// mig20250715100000Up for up migration
+func mig20250715100000Up(tx *gorm.DB) error {
+	// Experiments : kv 配置实验
+	type Experiments struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name     string `gorm:"type:varchar(255) not null"`
+		Key      string `gorm:"type:varchar(255) not null;uniqueIndex:idx_bizID_appID_key,priority:3"`
+		Selector string `gorm:"type:json default null"`
+		Variants string `gorm:"type:json not null"`
+		Enabled  bool   `gorm:"type:tinyint(1) not null;default:0"`
+		Memo     string `gorm:"type:varchar(256) default ''"`
+
+		// Attachment is attachment info of the resource
+		BizID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_key,priority:1"`
+		AppID uint `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_bizID_appID_key,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"type:varchar(64) not null"`
+		Reviser   string    `gorm:"type:varchar(64) not null"`
+		CreatedAt time.Time `gorm:"type:datetime(6) not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	// ExperimentExposures : 客户端的实验曝光记录, 每个实验每个客户端一条
+	type ExperimentExposures struct {
+		ID uint `gorm:"type:bigint(1) unsigned not null;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Variant        string    `gorm:"type:varchar(255) not null"`
+		ReleaseID      uint      `gorm:"type:bigint(1) unsigned not null;default:0"`
+		Count          uint      `gorm:"type:int unsigned not null;default:0"`
+		FirstExposedAt time.Time `gorm:"type:datetime(6) not null"`
+		LastExposedAt  time.Time `gorm:"type:datetime(6) not null"`
+
+		// Attachment is attachment info of the resource
+		BizID        uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:1"`
+		AppID        uint   `gorm:"type:bigint(1) unsigned not null;index:idx_bizID_appID,priority:2"`
+		ExperimentID uint   `gorm:"type:bigint(1) unsigned not null;uniqueIndex:idx_experimentID_uid,priority:1"`
+		UID          string `gorm:"column:uid;type:varchar(64) not null;uniqueIndex:idx_experimentID_uid,priority:2"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&Experiments{}, &ExperimentExposures{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "experiments", MaxID: 0, UpdatedAt: now},
+		{Resource: "experiment_exposures", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250715100000Down for down migration
+func mig20250715100000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"experiments",
+		"experiment_exposures",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("experiments", "experiment_exposures"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250717100000",
+		Name:    "20250717100000_add_file_tamper_event",
+		Mode:    migrator.GormMode,
+		Up:      mig20250717100000Up,
+		Down:    mig20250717100000Down,
+	})
+}
+
+// mig20250717100000Up for up migration
+func mig20250717100000Up(tx *gorm.DB) error {
+	// FileTamperEvents : 客户端上报的托管文件篡改事件
+	type FileTamperEvents struct {
+		ID uint `gorm:"column:id;type:bigint(1) unsigned;primary_key"`
+
+		BizID     uint   `gorm:"column:biz_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:1"`
+		AppID     uint   `gorm:"column:app_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:2"`
+		ReleaseID uint   `gorm:"column:release_id;type:bigint(1) unsigned;NOT NULL;index:idx_bizID_appID_releaseID,priority:3"`
+		UID       string `gorm:"column:uid;type:varchar(64);NOT NULL;index:idx_uid,priority:1"`
+
+		ConfigItemID      uint      `gorm:"column:config_item_id;type:bigint(1) unsigned;default:0;NOT NULL"`
+		Path              string    `gorm:"column:path;type:varchar(255);default:'';NOT NULL"`
+		Name              string    `gorm:"column:name;type:varchar(255);NOT NULL"`
+		Kind              string    `gorm:"column:kind;type:varchar(20);NOT NULL"`
+		ExpectedSignature string    `gorm:"column:expected_signature;type:varchar(64);default:'';NOT NULL"`
+		ActualSignature   string    `gorm:"column:actual_signature;type:varchar(64);default:'';NOT NULL"`
+		Repaired          bool      `gorm:"column:repaired;type:tinyint(1);default:0;NOT NULL"`
+		RepairError       string    `gorm:"column:repair_error;type:varchar(1024);default:'';NOT NULL"`
+		Ip                string    `gorm:"column:ip;type:varchar(64);default:'';NOT NULL"`
+		ClientType        string    `gorm:"column:client_type;type:varchar(20);default:'';NOT NULL"`
+		DetectedAt        time.Time `gorm:"column:detected_at;type:datetime(6);NOT NULL"`
+		CreatedAt         time.Time `gorm:"column:created_at;type:datetime(6);NOT NULL"`
+	}
+
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	if err := tx.Set("gorm:table_options", "ENGINE=InnoDB CHARSET=utf8mb4").
+		AutoMigrate(&FileTamperEvents{}); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if result := tx.Create([]IDGenerators{
+		{Resource: "file_tamper_events", MaxID: 0, UpdatedAt: now},
+	}); result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
+// mig20250717100000Down for down migration
+func mig20250717100000Down(tx *gorm.DB) error {
+	// IDGenerators : ID生成器
+	type IDGenerators struct {
+		ID        uint      `gorm:"type:bigint(1) unsigned not null;primaryKey"`
+		Resource  string    `gorm:"type:varchar(50) not null;uniqueIndex:idx_resource"`
+		MaxID     uint      `gorm:"type:bigint(1) unsigned not null"`
+		UpdatedAt time.Time `gorm:"type:datetime(6) not null"`
+	}
+
+	var resources = []string{
+		"file_tamper_events",
+	}
+	if result := tx.Where("resource IN ?", resources).Delete(&IDGenerators{}); result.Error != nil {
+		return result.Error
+	}
+
+	if err := tx.Migrator().DropTable("file_tamper_events"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250718100000",
+		Name:    "20250718100000_complete_postgres_baseline",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250718100000Up,
+		Down:    mig20250718100000Down,
+	})
+}
+
+// completeUniqueIndexes is the unique indexes of the mysql schema which the baseline missed.
+var completeUniqueIndexes = []struct {
+	name    string
+	table   string
+	columns string
+}{
+	{"idx_bizID_appID", "archived_apps", "biz_id, app_id"},
+	{"idx_bizID_appID_name", "config_items", "biz_id, app_id, path, name"},
+	{"idx_bizID_name", "credentials", "biz_id, name"},
+	{"idx_bizID_name", "hooks", "biz_id, name"},
+	{"idx_bizID_revisionName", "hook_revisions", "hook_id, name"},
+	{"idx_appID_releaseID_hookType", "released_hooks", "app_id, release_id, hook_type"},
+	{"idx_bizID_name", "template_spaces", "biz_id, name"},
+	{"idx_bizID_tempSpaID_name_path", "templates", "biz_id, template_space_id, name, path"},
+	{"idx_bizID_tempID_revName", "template_revisions", "biz_id, template_id, revision_name"},
+	{"idx_bizID_tempSpaID_name", "template_sets", "biz_id, template_space_id, name"},
+	{"idx_bizID_appID", "app_template_bindings", "biz_id, app_id"},
+	{"idx_bizID_name", "template_variables", "biz_id, name"},
+	{"idx_bizID_appID", "app_template_variables", "biz_id, app_id"},
+	{"idx_releaseID", "released_app_template_variables", "release_id"},
+	{"idx_bizID_appID_key_kvState", "kvs", "\"key\", kv_state, biz_id, app_id"},
+	{"relID_key", "released_kvs", "\"key\", release_id"},
+	{"idx_bizID_name", "hook_templates", "biz_id, name"},
+	{"idx_bizID_hookID", "hook_template_refs", "biz_id, hook_id"},
+	{"idx_bizID_name", "environments", "biz_id, name"},
+	{"idx_bizID_envID_appKey", "env_app_bindings", "biz_id, env_id, app_key"},
+	{"idx_appID", "env_app_bindings", "app_id"},
+	{"idx_bizID_name", "config_shares", "biz_id, name"},
+	{"idx_appID_shareID", "config_share_refs", "app_id, share_id"},
+	{"idx_bizID_appID_name", "app_snapshots", "biz_id, app_id, name"},
+	{"idx_bizID_appID_creator", "drafts", "biz_id, app_id, creator"},
+	{"idx_bizID_appID_resType_resID", "full_text_docs", "biz_id, app_id, res_type, res_id"},
+	{"idx_bizID_src_dst", "config_references", "biz_id, src_type, src_id, dst_type, dst_id"},
+	{"idx_bizID_appID_resType_resID", "certificates", "biz_id, app_id, res_type, res_id"},
+	{"idx_bizID_appID_key_groupID", "kv_overrides", "biz_id, app_id, \"key\", group_id"},
+	{"idx_relID_key_groupID", "released_kv_overrides", "release_id, \"key\", group_id"},
+	{"idx_bizID_name", "compliance_policies", "biz_id, name"},
+	{"idx_bizID", "secret_scan_settings", "biz_id"},
+	{"idx_bizID_resType_resID", "stale_resources", "biz_id, res_type, res_id"},
+	{"idx_bizID_name", "service_accounts", "biz_id, name"},
+	{"idx_keyHash", "service_accounts", "key_hash"},
+	{"idx_appID_user", "app_role_bindings", "app_id, \"user\""},
+}
+
+// mig20250718100000Up for up migration
+func mig20250718100000Up(tx *gorm.DB) error {
+	for _, idx := range completeUniqueIndexes {
+		// index name of postgres and sqlite is unique in schema, so prefix it with the table name
+		if err := tx.Exec(fmt.Sprintf(`CREATE UNIQUE INDEX IF NOT EXISTS "%s_%s" ON "%s" (%s)`,
+			idx.table, idx.name, idx.table, idx.columns)).Error; err != nil {
+			return err
+		}
+	}
+
+	return addBuiltinHookTemplates(tx)
+}
+
+// mig20250718100000Down for down migration
+func mig20250718100000Down(tx *gorm.DB) error {
+	if err := tx.Table("hook_templates").Where("biz_id = ? AND name IN ?", 0, builtinHookTemplateNames()).
+		Delete(nil).Error; err != nil {
+		return err
+	}
+
+	for _, idx := range completeUniqueIndexes {
+		if err := tx.Exec(fmt.Sprintf(`DROP INDEX IF EXISTS "%s_%s"`, idx.table, idx.name)).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hookTemplateSeed : 平台内置脚本模板，与 mysql 的 20250418154633_add_hook_template 保持一致
+type hookTemplateSeed struct {
+	ID        uint32    `gorm:"column:id;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name"`
+	Type      string    `gorm:"column:type"`
+	Content   string    `gorm:"column:content"`
+	Params    string    `gorm:"column:params;type:json"`
+	Memo      string    `gorm:"column:memo"`
+	Version   uint32    `gorm:"column:version"`
+	BizID     uint32    `gorm:"column:biz_id"`
+	Creator   string    `gorm:"column:creator"`
+	Reviser   string    `gorm:"column:reviser"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+// builtinHookTemplates 平台内置脚本模板，biz_id 为 0，所有业务共享
+var builtinHookTemplates = []hookTemplateSeed{
+	{
+		Name: "reload_systemd_unit",
+		Type: "shell",
+		Content: `#!/bin/bash
+set -e
+systemctl daemon-reload
+systemctl {{ .action }} {{ .unit }}
+systemctl is-active --quiet {{ .unit }}
+`,
+		Params: `[{"name":"unit","default":"","required":true,"memo":"systemd unit name"},` +
+			`{"name":"action","default":"reload-or-restart","required":false,"memo":"systemctl action"}]`,
+		Memo: "reload a systemd unit after the config is released",
+	},
+	{
+		Name: "validate_nginx_then_reload",
+		Type: "shell",
+		Content: `#!/bin/bash
+set -e
+{{ .nginx_bin }} -t -c {{ .conf }}
+{{ .nginx_bin }} -s reload
+`,
+		Params: `[{"name":"nginx_bin","default":"nginx","required":false,"memo":"nginx binary path"},` +
+			`{"name":"conf","default":"/etc/nginx/nginx.conf","required":false,"memo":"nginx config file"}]`,
+		Memo: "validate the nginx config and then reload nginx",
+	},
+}
+
+func builtinHookTemplateNames() []string {
+	names := make([]string, 0, len(builtinHookTemplates))
+	for _, tpl := range builtinHookTemplates {
+		names = append(names, tpl.Name)
+	}
+	return names
+}
+
+// addBuiltinHookTemplates add the builtin hook templates which are not exist, the template id is allocated from
+// id_generators, because the business may have created hook templates after the baseline.
+func addBuiltinHookTemplates(tx *gorm.DB) error {
+	now := time.Now()
+	for _, tpl := range builtinHookTemplates {
+		var count int64
+		if err := tx.Table("hook_templates").Where("biz_id = ? AND name = ?", 0, tpl.Name).
+			Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+
+		if err := tx.Exec("UPDATE id_generators SET max_id = max_id + 1, updated_at = ? WHERE resource = ?",
+			now, "hook_templates").Error; err != nil {
+			return err
+		}
+		var maxID uint32
+		if err := tx.Table("id_generators").Select("max_id").Where("resource = ?", "hook_templates").
+			Scan(&maxID).Error; err != nil {
+			return err
+		}
+
+		tpl.ID = maxID
+		tpl.Version = 1
+		tpl.Creator = "system"
+		tpl.Reviser = "system"
+		tpl.CreatedAt = now
+		tpl.UpdatedAt = now
+		if err := tx.Table("hook_templates").Create(&tpl).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addIDGenerators add the id generators of the resources which are not exist, postgres or sqlite database
+// migrated by the early baseline may already have some of them.
+func addIDGenerators(tx *gorm.DB, resources ...string) error {
+	now := time.Now()
+	for _, resource := range resources {
+		var count int64
+		if err := tx.Table("id_generators").Where("resource = ?", resource).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			continue
+		}
+		if err := tx.Exec("INSERT INTO id_generators (resource, max_id, updated_at) VALUES (?, ?, ?)",
+			resource, 0, now).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteIDGenerators delete the id generators of the resources.
+func deleteIDGenerators(tx *gorm.DB, resources ...string) error {
+	return tx.Exec("DELETE FROM id_generators WHERE resource IN ?", resources).Error
+}
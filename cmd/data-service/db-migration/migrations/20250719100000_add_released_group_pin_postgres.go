@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250719100000",
+		Name:    "20250719100000_add_released_group_pin_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250719100000Up,
+		Down:    mig20250719100000Down,
+	})
+}
+
+// mig20250719100000Up for up migration
+func mig20250719100000Up(tx *gorm.DB) error {
+	// ReleasedGroups : 分组上线记录
+	type ReleasedGroups struct {
+		Pinned bool `gorm:"column:pinned;default:false;not null"`
+	}
+
+	// ReleasedGroups add new column
+	if !tx.Migrator().HasColumn(&ReleasedGroups{}, "pinned") {
+		if err := tx.Migrator().AddColumn(&ReleasedGroups{}, "pinned"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250719100000Down for down migration
+func mig20250719100000Down(tx *gorm.DB) error {
+	// ReleasedGroups : 分组上线记录
+	type ReleasedGroups struct {
+		Pinned bool `gorm:"column:pinned;default:false;not null"`
+	}
+
+	// ReleasedGroups drop column
+	if tx.Migrator().HasColumn(&ReleasedGroups{}, "pinned") {
+		if err := tx.Migrator().DropColumn(&ReleasedGroups{}, "pinned"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
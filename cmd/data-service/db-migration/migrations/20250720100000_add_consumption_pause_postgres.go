@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250720100000",
+		Name:    "20250720100000_add_consumption_pause_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250720100000Up,
+		Down:    mig20250720100000Down,
+	})
+}
+
+// mig20250720100000Up for up migration
+func mig20250720100000Up(tx *gorm.DB) error {
+	// Applications : applications
+	type Applications struct {
+		Pause string `gorm:"column:pause;type:json"`
+	}
+
+	// Credentials : credentials
+	type Credentials struct {
+		Pause string `gorm:"column:pause;type:json"`
+	}
+
+	// Applications add new column
+	if !tx.Migrator().HasColumn(&Applications{}, "pause") {
+		if err := tx.Migrator().AddColumn(&Applications{}, "pause"); err != nil {
+			return err
+		}
+	}
+
+	// Credentials add new column
+	if !tx.Migrator().HasColumn(&Credentials{}, "pause") {
+		if err := tx.Migrator().AddColumn(&Credentials{}, "pause"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250720100000Down for down migration
+func mig20250720100000Down(tx *gorm.DB) error {
+	// Applications : applications
+	type Applications struct {
+		Pause string `gorm:"column:pause;type:json"`
+	}
+
+	// Credentials : credentials
+	type Credentials struct {
+		Pause string `gorm:"column:pause;type:json"`
+	}
+
+	// Applications drop column
+	if tx.Migrator().HasColumn(&Applications{}, "pause") {
+		if err := tx.Migrator().DropColumn(&Applications{}, "pause"); err != nil {
+			return err
+		}
+	}
+
+	// Credentials drop column
+	if tx.Migrator().HasColumn(&Credentials{}, "pause") {
+		if err := tx.Migrator().DropColumn(&Credentials{}, "pause"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
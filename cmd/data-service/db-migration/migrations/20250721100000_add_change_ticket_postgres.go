@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250721100000",
+		Name:    "20250721100000_add_change_ticket_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250721100000Up,
+		Down:    mig20250721100000Down,
+	})
+}
+
+// mig20250721100000Up for up migration
+func mig20250721100000Up(tx *gorm.DB) error {
+	// Strategies : strategies
+	type Strategies struct {
+		ChangeTicketID  string `gorm:"column:change_ticket_id;type:varchar(64);default:''"`
+		ChangeTicketURL string `gorm:"column:change_ticket_url;type:varchar(255);default:''"`
+	}
+
+	// Strategies add new column
+	for _, column := range []string{"change_ticket_id", "change_ticket_url"} {
+		if !tx.Migrator().HasColumn(&Strategies{}, column) {
+			if err := tx.Migrator().AddColumn(&Strategies{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// mig20250721100000Down for down migration
+func mig20250721100000Down(tx *gorm.DB) error {
+	// Strategies : strategies
+	type Strategies struct {
+		ChangeTicketID  string `gorm:"column:change_ticket_id;type:varchar(64);default:''"`
+		ChangeTicketURL string `gorm:"column:change_ticket_url;type:varchar(255);default:''"`
+	}
+
+	// Strategies drop column
+	for _, column := range []string{"change_ticket_id", "change_ticket_url"} {
+		if tx.Migrator().HasColumn(&Strategies{}, column) {
+			if err := tx.Migrator().DropColumn(&Strategies{}, column); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
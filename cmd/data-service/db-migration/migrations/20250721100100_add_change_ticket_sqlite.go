@@ -0,0 +1,30 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	// sqlite shares the migration with postgres
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250721100100",
+		Name:    "20250721100100_add_change_ticket_sqlite",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.SQLiteDialect,
+		Up:      mig20250721100000Up,
+		Down:    mig20250721100000Down,
+	})
+}
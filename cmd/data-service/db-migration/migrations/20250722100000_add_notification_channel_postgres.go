@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250722100000",
+		Name:    "20250722100000_add_notification_channel_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250722100000Up,
+		Down:    mig20250722100000Down,
+	})
+}
+
+// mig20250722100000Up for up migration
+func mig20250722100000Up(tx *gorm.DB) error {
+	// NotificationChannels : 发布及审批事件的通知渠道
+	type NotificationChannels struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name     string `gorm:"column:name;uniqueIndex:notification_channels_idx_bizID_name,priority:2"`
+		Type     string `gorm:"column:type"`
+		Target   string `gorm:"column:target"`
+		Events   string `gorm:"column:events;type:json"`
+		Template string `gorm:"column:template"`
+		Enabled  bool   `gorm:"column:enabled"`
+		Memo     string `gorm:"column:memo"`
+
+		// Attachment is attachment info of the resource
+		BizID uint32 `gorm:"column:biz_id;uniqueIndex:notification_channels_idx_bizID_name,priority:1"`
+		AppID uint32 `gorm:"column:app_id;index:notification_channels_idx_appID"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+
+	if err := tx.AutoMigrate(&NotificationChannels{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "notification_channels")
+}
+
+// mig20250722100000Down for down migration
+func mig20250722100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "notification_channels"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("notification_channels"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250723100000",
+		Name:    "20250723100000_add_alert_rule_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250723100000Up,
+		Down:    mig20250723100000Down,
+	})
+}
+
+// mig20250723100000Up for up migration
+func mig20250723100000Up(tx *gorm.DB) error {
+	// AlertRules : 平台指标及事件的告警规则
+	type AlertRules struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name       string  `gorm:"column:name;uniqueIndex:alert_rules_idx_bizID_name,priority:2"`
+		Metric     string  `gorm:"column:metric"`
+		Threshold  float64 `gorm:"column:threshold"`
+		WindowMin  uint32  `gorm:"column:window_min"`
+		GroupID    uint32  `gorm:"column:group_id"`
+		ChannelIDs string  `gorm:"column:channel_ids;type:json"`
+		SilenceMin uint32  `gorm:"column:silence_min"`
+		Enabled    bool    `gorm:"column:enabled"`
+		Memo       string  `gorm:"column:memo"`
+
+		// Attachment is attachment info of the resource
+		BizID uint32 `gorm:"column:biz_id;uniqueIndex:alert_rules_idx_bizID_name,priority:1"`
+		AppID uint32 `gorm:"column:app_id"`
+
+		// State is the evaluation state of the rule
+		Firing          bool       `gorm:"column:firing"`
+		LastValue       float64    `gorm:"column:last_value"`
+		LastFiredAt     *time.Time `gorm:"column:last_fired_at"`
+		LastEvaluatedAt *time.Time `gorm:"column:last_evaluated_at"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+
+	if err := tx.AutoMigrate(&AlertRules{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "alert_rules")
+}
+
+// mig20250723100000Down for down migration
+func mig20250723100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "alert_rules"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("alert_rules"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250724100000",
+		Name:    "20250724100000_add_client_quarantine_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250724100000Up,
+		Down:    mig20250724100000Down,
+	})
+}
+
+// mig20250724100000Up for up migration
+func mig20250724100000Up(tx *gorm.DB) error {
+	// ClientQuarantines : feed server 拒绝服务的客户端隔离名单
+	type ClientQuarantines struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		MatchType     string     `gorm:"column:match_type;uniqueIndex:client_quarantines_idx_bizID_matchType_value,priority:2"` //nolint:lll
+		Value         string     `gorm:"column:value;uniqueIndex:client_quarantines_idx_bizID_matchType_value,priority:3"`      //nolint:lll
+		Reason        string     `gorm:"column:reason"`
+		RetryAfterSec uint32     `gorm:"column:retry_after_sec"`
+		ExpiredAt     *time.Time `gorm:"column:expired_at"`
+		Enabled       bool       `gorm:"column:enabled"`
+
+		// Attachment is attachment info of the resource
+		BizID uint32 `gorm:"column:biz_id;uniqueIndex:client_quarantines_idx_bizID_matchType_value,priority:1"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+
+	if err := tx.AutoMigrate(&ClientQuarantines{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "client_quarantines")
+}
+
+// mig20250724100000Down for down migration
+func mig20250724100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "client_quarantines"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("client_quarantines"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250727100000",
+		Name:    "20250727100000_add_experiment_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250727100000Up,
+		Down:    mig20250727100000Down,
+	})
+}
+
+// mig20250727100000Up for up migration
+func mig20250727100000Up(tx *gorm.DB) error {
+	// Experiments : kv 配置实验
+	type Experiments struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Name     string `gorm:"column:name"`
+		Key      string `gorm:"column:key;uniqueIndex:experiments_idx_bizID_appID_key,priority:3"`
+		Selector string `gorm:"column:selector;type:json"`
+		Variants string `gorm:"column:variants;type:json"`
+		Enabled  bool   `gorm:"column:enabled"`
+		Memo     string `gorm:"column:memo"`
+
+		// Attachment is attachment info of the resource
+		BizID uint32 `gorm:"column:biz_id;uniqueIndex:experiments_idx_bizID_appID_key,priority:1"`
+		AppID uint32 `gorm:"column:app_id;uniqueIndex:experiments_idx_bizID_appID_key,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+
+	// ExperimentExposures : 客户端的实验曝光记录, 每个实验每个客户端一条
+	type ExperimentExposures struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Variant        string    `gorm:"column:variant"`
+		ReleaseID      uint32    `gorm:"column:release_id"`
+		Count          uint32    `gorm:"column:count"`
+		FirstExposedAt time.Time `gorm:"column:first_exposed_at"`
+		LastExposedAt  time.Time `gorm:"column:last_exposed_at"`
+
+		// Attachment is attachment info of the resource
+		BizID        uint32 `gorm:"column:biz_id;index:experiment_exposures_idx_bizID_appID,priority:1"`
+		AppID        uint32 `gorm:"column:app_id;index:experiment_exposures_idx_bizID_appID,priority:2"`
+		ExperimentID uint32 `gorm:"column:experiment_id;uniqueIndex:experiment_exposures_idx_experimentID_uid,priority:1"` //nolint:lll
+		UID          string `gorm:"column:uid;uniqueIndex:experiment_exposures_idx_experimentID_uid,priority:2"`
+	}
+
+	if err := tx.AutoMigrate(&Experiments{}, &ExperimentExposures{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "experiments", "experiment_exposures")
+}
+
+// mig20250727100000Down for down migration
+func mig20250727100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "experiments", "experiment_exposures"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("experiments", "experiment_exposures"); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250728100000",
+		Name:    "20250728100000_add_masking_profile_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250728100000Up,
+		Down:    mig20250728100000Down,
+	})
+}
+
+// mig20250728100000Up for up migration
+func mig20250728100000Up(tx *gorm.DB) error {
+	// MaskingProfiles : 服务的配置内容脱敏规则
+	type MaskingProfiles struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		// Spec is specifics of the resource defined with user
+		Enabled bool   `gorm:"column:enabled"`
+		Rules   string `gorm:"column:rules;type:json"`
+
+		// Attachment is attachment info of the resource
+		BizID uint32 `gorm:"column:biz_id;uniqueIndex:masking_profiles_idx_bizID_appID,priority:1"`
+		AppID uint32 `gorm:"column:app_id;uniqueIndex:masking_profiles_idx_bizID_appID,priority:2"`
+
+		// Revision is revision info of the resource
+		Creator   string    `gorm:"column:creator"`
+		Reviser   string    `gorm:"column:reviser"`
+		CreatedAt time.Time `gorm:"column:created_at"`
+		UpdatedAt time.Time `gorm:"column:updated_at"`
+	}
+
+	if err := tx.AutoMigrate(&MaskingProfiles{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "masking_profiles")
+}
+
+// mig20250728100000Down for down migration
+func mig20250728100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "masking_profiles"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("masking_profiles"); err != nil {
+		return err
+	}
+
+	return nil
+}
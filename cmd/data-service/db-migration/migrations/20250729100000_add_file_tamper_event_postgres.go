@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250729100000",
+		Name:    "20250729100000_add_file_tamper_event_postgres",
+		Mode:    migrator.GormMode,
+		Dialect: migrator.PostgresDialect,
+		Up:      mig20250729100000Up,
+		Down:    mig20250729100000Down,
+	})
+}
+
+// mig20250729100000Up for up migration
+func mig20250729100000Up(tx *gorm.DB) error {
+	// FileTamperEvents : 客户端上报的托管文件篡改事件
+	type FileTamperEvents struct {
+		ID uint32 `gorm:"column:id;primaryKey;autoIncrement:false"`
+
+		BizID     uint32 `gorm:"column:biz_id;index:file_tamper_events_idx_bizID_appID_releaseID,priority:1"`
+		AppID     uint32 `gorm:"column:app_id;index:file_tamper_events_idx_bizID_appID_releaseID,priority:2"`
+		ReleaseID uint32 `gorm:"column:release_id;index:file_tamper_events_idx_bizID_appID_releaseID,priority:3"`
+		UID       string `gorm:"column:uid;index:file_tamper_events_idx_uid,priority:1"`
+
+		ConfigItemID      uint32    `gorm:"column:config_item_id"`
+		Path              string    `gorm:"column:path"`
+		Name              string    `gorm:"column:name"`
+		Kind              string    `gorm:"column:kind"`
+		ExpectedSignature string    `gorm:"column:expected_signature"`
+		ActualSignature   string    `gorm:"column:actual_signature"`
+		Repaired          bool      `gorm:"column:repaired"`
+		RepairError       string    `gorm:"column:repair_error"`
+		Ip                string    `gorm:"column:ip"`
+		ClientType        string    `gorm:"column:client_type"`
+		DetectedAt        time.Time `gorm:"column:detected_at"`
+		CreatedAt         time.Time `gorm:"column:created_at"`
+	}
+
+	if err := tx.AutoMigrate(&FileTamperEvents{}); err != nil {
+		return err
+	}
+
+	return addIDGenerators(tx, "file_tamper_events")
+}
+
+// mig20250729100000Down for down migration
+func mig20250729100000Down(tx *gorm.DB) error {
+	if err := deleteIDGenerators(tx, "file_tamper_events"); err != nil {
+		return err
+	}
+
+	if err := tx.Migrator().DropTable("file_tamper_events"); err != nil {
+		return err
+	}
+
+	return nil
+}
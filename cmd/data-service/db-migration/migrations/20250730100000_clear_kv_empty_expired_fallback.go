@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+func init() {
+	// add current migration to migrator
+	migrator.GetMigrator().AddMigration(&migrator.Migration{
+		Version: "20250730100000",
+		Name:    "20250730100000_clear_kv_empty_expired_fallback",
+		Mode:    migrator.GormMode,
+		Up:      mig20250730100000Up,
+		Down:    mig20250730100000Down,
+	})
+}
+
+// mig20250730100000Up for up migration
+// 兜底值为空字符串原表示过期后不再下发, 现由NULL表示, 空字符串是有效的兜底值
+func mig20250730100000Up(tx *gorm.DB) error {
+	for _, table := range []string{"kvs", "released_kvs"} {
+		if err := tx.Exec("UPDATE " + table + " SET expired_fallback = NULL WHERE expired_fallback = ''").
+			Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mig20250730100000Down for down migration
+// 空字符串和NULL在旧版本中都表示过期后不再下发, 无需回滚数据
+func mig20250730100000Down(tx *gorm.DB) error {
+	return nil
+}
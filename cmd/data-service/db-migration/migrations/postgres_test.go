@@ -0,0 +1,54 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package migrations
+
+import (
+	"os"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+// TestPostgresBaseline run the postgres migrations up and down, it's skipped if BSCP_TEST_POSTGRES_DSN is not set.
+func TestPostgresBaseline(t *testing.T) {
+	dsn := os.Getenv("BSCP_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("BSCP_TEST_POSTGRES_DSN is not set")
+	}
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open postgres failed, err: %v", err)
+	}
+
+	m, err := migrator.Init(db)
+	if err != nil {
+		t.Fatalf("init migrator failed, err: %v", err)
+	}
+
+	if err := m.Up(0); err != nil {
+		t.Fatalf("migrate up failed, err: %v", err)
+	}
+	if !db.Migrator().HasTable("applications") {
+		t.Fatalf("table applications is not created")
+	}
+
+	if err := m.Down(0); err != nil {
+		t.Fatalf("migrate down failed, err: %v", err)
+	}
+	if db.Migrator().HasTable("applications") {
+		t.Fatalf("table applications is not dropped")
+	}
+}
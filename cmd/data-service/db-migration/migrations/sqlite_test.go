@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration/migrator"
+)
+
+// TestSQLiteMigrations run the sqlite migrations up and down on a temporary database file.
+func TestSQLiteMigrations(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "bscp.db")), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open sqlite failed, err: %v", err)
+	}
+
+	m, err := migrator.Init(db)
+	if err != nil {
+		t.Fatalf("init migrator failed, err: %v", err)
+	}
+
+	if err := m.Up(0); err != nil {
+		t.Fatalf("migrate up failed, err: %v", err)
+	}
+	for _, name := range []string{"applications", "hook_templates", "client_quarantines"} {
+		if !db.Migrator().HasTable(name) {
+			t.Fatalf("table %s is not created", name)
+		}
+	}
+
+	var templates int64
+	if err := db.Table("hook_templates").Where("biz_id = ?", 0).Count(&templates).Error; err != nil {
+		t.Fatalf("count builtin hook templates failed, err: %v", err)
+	}
+	if templates != int64(len(builtinHookTemplates)) {
+		t.Fatalf("builtin hook templates should be %d, but got %d", len(builtinHookTemplates), templates)
+	}
+
+	if err := m.Down(0); err != nil {
+		t.Fatalf("migrate down failed, err: %v", err)
+	}
+	if db.Migrator().HasTable("applications") {
+		t.Fatalf("table applications is not dropped")
+	}
+}
@@ -15,7 +15,6 @@ package migrator
 import (
 	"fmt"
 
-	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
@@ -25,13 +24,13 @@ import (
 
 // NewDB new a gorm db instance
 func NewDB(debug bool) (*gorm.DB, error) {
-	fmt.Println("Connecting to MySQL database...")
-
 	dbConf := cc.DataService().Sharding.AdminDatabase
-	dsn := sharding.URI(dbConf)
+	fmt.Printf("Connecting to %s database...\n", dbConf.Driver)
+
+	dialector := sharding.Dialector(dbConf)
 	if debug {
 		// return the gorm db instance which prints debug log
-		return gorm.Open(mysql.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
+		return gorm.Open(dialector, &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
 	}
-	return gorm.Open(mysql.Open(dsn), &gorm.Config{})
+	return gorm.Open(dialector, &gorm.Config{})
 }
@@ -15,6 +15,7 @@ package migrator
 
 import (
 	"bytes"
+	"database/sql"
 	"errors"
 	"fmt"
 	"html/template"
@@ -27,6 +28,7 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/db-migration" //nolint:goimports
 )
@@ -36,6 +38,18 @@ const (
 	GormMode string = "gorm"
 	// SqlMode sql mode
 	SqlMode string = "sql"
+
+	// MySQLDialect is the migration for mysql, it's the default dialect.
+	MySQLDialect string = "mysql"
+	// PostgresDialect is the migration for postgres.
+	PostgresDialect string = "postgres"
+	// SQLiteDialect is the migration for sqlite.
+	SQLiteDialect string = "sqlite"
+
+	// migrationLockName is the mysql named lock, so that only one instance runs the migrations at the same time.
+	migrationLockName = "bk_bscp_schema_migration"
+	// defaultLockTimeoutSec is the default seconds to wait for the migration lock.
+	defaultLockTimeoutSec = 600
 )
 
 var allSQLFiles []string
@@ -45,6 +59,8 @@ type Migration struct {
 	Version string
 	Name    string
 	Mode    string
+	// Dialect is the database the migration works for, default is mysql.
+	Dialect string
 	Up      func(*gorm.DB) error
 	Down    func(*gorm.DB) error
 
@@ -57,6 +73,13 @@ type Migrator struct {
 	Versions      []string
 	Migrations    map[string]*Migration
 	MigrationSQLs map[string]string
+	// DryRun only prints the migrations and the sql to be executed, the db is not changed.
+	DryRun bool
+	// LockTimeoutSec is the seconds to wait for the migration lock.
+	LockTimeoutSec uint
+
+	// dialect is the dialect of the db, only the migrations of the dialect are executed.
+	dialect string
 }
 
 var migrator = &Migrator{
@@ -87,39 +110,41 @@ func (m *Migrator) AddMigration(mg *Migration) {
 // Init create the db connection and get migrator
 func Init(db *gorm.DB) (*Migrator, error) {
 	migrator.db = db
+	migrator.dialect = MySQLDialect
+	switch db.Dialector.Name() {
+	case PostgresDialect, SQLiteDialect:
+		migrator.dialect = db.Dialector.Name()
+	}
 	var err error
 
 	// Create `schema_migrations` table to remember which migrations were executed.
-	if result := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+	ddl := `CREATE TABLE IF NOT EXISTS schema_migrations (
 		id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
 		applied DATETIME ( 6 ) NOT NULL,
 		version VARCHAR ( 255 )
-	);`); result.Error != nil {
+	);`
+	switch migrator.dialect {
+	case PostgresDialect:
+		ddl = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		id SERIAL PRIMARY KEY,
+		applied TIMESTAMP ( 6 ) NOT NULL,
+		version VARCHAR ( 255 )
+	);`
+	case SQLiteDialect:
+		ddl = `CREATE TABLE IF NOT EXISTS schema_migrations (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		applied DATETIME NOT NULL,
+		version VARCHAR ( 255 )
+	);`
+	}
+	if result := db.Exec(ddl); result.Error != nil {
 		fmt.Println("Unable to create `schema_migrations` table", result.Error)
 		return migrator, result.Error
 	}
 
-	// Find out all the executed migrations
-	rows, err := db.Raw("SELECT version FROM `schema_migrations`;").Rows()
-	if err != nil {
+	if err = migrator.loadApplied(); err != nil {
 		return migrator, err
 	}
-	defer func() {
-		_ = rows.Close()
-	}()
-
-	// Mark the migrations as Done if it is already executed
-	for rows.Next() {
-		var version string
-		err = rows.Scan(&version)
-		if err != nil {
-			return migrator, err
-		}
-
-		if migrator.Migrations[version] != nil {
-			migrator.Migrations[version].done = true
-		}
-	}
 
 	migrator.MigrationSQLs, err = getMigrationSQLs()
 	if err != nil {
@@ -136,86 +161,283 @@ func GetMigrator() *Migrator {
 	return migrator
 }
 
-// Up execute forward migration
-func (m *Migrator) Up(step int) error {
-	tx := m.db.Begin()
+// loadApplied find out all the executed migrations and mark them as done
+func (m *Migrator) loadApplied() error {
+	rows, err := m.db.Raw("SELECT version FROM schema_migrations;").Rows()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
 
-	count := 0
-	for _, v := range m.Versions {
-		if step > 0 && count == step {
-			break
+	for _, mg := range m.Migrations {
+		mg.done = false
+	}
+	for rows.Next() {
+		var version string
+		if err = rows.Scan(&version); err != nil {
+			return err
 		}
 
-		mg := m.Migrations[v]
+		if m.Migrations[version] != nil {
+			m.Migrations[version].done = true
+		}
+	}
 
-		if mg.done {
-			continue
+	return rows.Err()
+}
+
+// withLock run the fn with the mysql named lock held, the applied migrations are reloaded after the lock
+// is acquired, because they may be executed by other instances when waiting for the lock.
+func (m *Migrator) withLock(fn func() error) error {
+	timeout := m.LockTimeoutSec
+	if timeout == 0 {
+		timeout = defaultLockTimeoutSec
+	}
+
+	// named lock belongs to the session, so use a dedicated connection to acquire and release it.
+	return m.db.Connection(func(conn *gorm.DB) error {
+		got, err := m.acquireLock(conn, timeout)
+		if err != nil {
+			return fmt.Errorf("acquire migration lock failed, err: %v", err)
 		}
+		if !got {
+			return fmt.Errorf("acquire migration lock timeout after %d seconds, other instance may be migrating",
+				timeout)
+		}
+		defer func() {
+			if err := m.releaseLock(conn); err != nil {
+				fmt.Println("release migration lock failed, err:", err)
+			}
+		}()
 
-		fmt.Println("Running migration", mg.Version)
-		if err := mg.Up(tx); err != nil {
-			tx.Rollback()
+		if err := m.loadApplied(); err != nil {
 			return err
 		}
+		return fn()
+	})
+}
 
-		if result := tx.Exec("INSERT INTO `schema_migrations` (applied, version) VALUES(?, ?)",
-			time.Now().UTC(), mg.Version); result.Error != nil {
-			tx.Rollback()
-			return result.Error
+// acquireLock acquire the migration lock, mysql uses the named lock and postgres uses the advisory lock.
+// sqlite is used by the single instance of the all-in-one deployment, and its write transaction is exclusive,
+// so it needs no lock.
+func (m *Migrator) acquireLock(conn *gorm.DB, timeout uint) (bool, error) {
+	if m.dialect == SQLiteDialect {
+		return true, nil
+	}
+
+	if m.dialect != PostgresDialect {
+		var got sql.NullInt64
+		if err := conn.Raw("SELECT GET_LOCK(?, ?)", migrationLockName, timeout).Scan(&got).Error; err != nil {
+			return false, err
 		}
-		fmt.Println("Finished running migration", mg.Version)
+		return got.Valid && got.Int64 == 1, nil
+	}
 
-		count++
+	// postgres advisory lock has no timeout, so try it until timeout
+	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
+	for {
+		var got bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(hashtext(?))", migrationLockName).
+			Scan(&got).Error; err != nil {
+			return false, err
+		}
+		if got {
+			return true, nil
+		}
+		if time.Now().After(deadline) {
+			return false, nil
+		}
+		time.Sleep(time.Second)
 	}
+}
 
-	if err := tx.Commit().Error; err != nil {
-		fmt.Printf("commit transaction failed, err: %s", err.Error())
+// releaseLock release the migration lock.
+func (m *Migrator) releaseLock(conn *gorm.DB) error {
+	switch m.dialect {
+	case SQLiteDialect:
+		return nil
+	case PostgresDialect:
+		return conn.Exec("SELECT pg_advisory_unlock(hashtext(?))", migrationLockName).Error
 	}
+	return conn.Exec("SELECT RELEASE_LOCK(?)", migrationLockName).Error
+}
 
-	return nil
+// Up execute forward migration
+func (m *Migrator) Up(step int) error {
+	if m.DryRun {
+		return m.dryRun(m.pending(step), true)
+	}
+
+	return m.withLock(func() error {
+		pending := m.pending(step)
+		if len(pending) == 0 {
+			fmt.Println("No pending migrations")
+			return nil
+		}
+
+		tx := m.db.Begin()
+		for _, mg := range pending {
+			fmt.Println("Running migration", mg.Version)
+			if err := mg.Up(tx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("run migration %s failed, err: %v", mg.Name, err)
+			}
+
+			if result := tx.Exec("INSERT INTO schema_migrations (applied, version) VALUES(?, ?)",
+				time.Now().UTC(), mg.Version); result.Error != nil {
+				tx.Rollback()
+				return result.Error
+			}
+			fmt.Println("Finished running migration", mg.Version)
+		}
+
+		if err := tx.Commit().Error; err != nil {
+			return fmt.Errorf("commit transaction failed, err: %v", err)
+		}
+
+		for _, mg := range pending {
+			mg.done = true
+		}
+		return nil
+	})
 }
 
 // Down execute backward migration
 func (m *Migrator) Down(step int) error {
-	tx := m.db.Begin()
+	if m.DryRun {
+		return m.dryRun(m.applied(step), false)
+	}
 
-	count := 0
-	for _, v := range reverse(m.Versions) {
-		if step > 0 && count == step {
-			break
+	return m.withLock(func() error {
+		applied := m.applied(step)
+		if len(applied) == 0 {
+			fmt.Println("No applied migrations")
+			return nil
 		}
 
-		mg := m.Migrations[v]
+		tx := m.db.Begin()
+		for _, mg := range applied {
+			fmt.Println("Reverting Migration", mg.Version)
+			if err := mg.Down(tx); err != nil {
+				tx.Rollback()
+				return fmt.Errorf("revert migration %s failed, err: %v", mg.Name, err)
+			}
 
-		if !mg.done {
-			continue
+			if result := tx.Exec("DELETE FROM schema_migrations WHERE version = ?", mg.Version); result.Error != nil {
+				tx.Rollback()
+				return result.Error
+			}
+			fmt.Println("Finished reverting migration", mg.Version)
 		}
 
-		fmt.Println("Reverting Migration", mg.Version)
-		if err := mg.Down(tx); err != nil {
-			tx.Rollback()
-			return err
+		if err := tx.Commit().Error; err != nil {
+			return fmt.Errorf("commit transaction failed, err: %v", err)
 		}
 
-		if result := tx.Exec("DELETE FROM `schema_migrations` WHERE version = ?", mg.Version); result.Error != nil {
-			tx.Rollback()
-			return result.Error
+		for _, mg := range applied {
+			mg.done = false
 		}
-		fmt.Println("Finished reverting migration", mg.Version)
+		return nil
+	})
+}
 
-		count++
+// versions returns the versions of the migrations which work for the db's dialect.
+func (m *Migrator) versions() []string {
+	dialect := m.dialect
+	if dialect == "" {
+		dialect = MySQLDialect
 	}
 
-	if err := tx.Commit().Error; err != nil {
-		fmt.Printf("commit transaction failed, err: %s", err.Error())
+	versions := make([]string, 0, len(m.Versions))
+	for _, v := range m.Versions {
+		if m.Migrations[v].dialect() == dialect {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// dialect returns the dialect of the migration.
+func (mg *Migration) dialect() string {
+	if mg.Dialect == "" {
+		return MySQLDialect
+	}
+	return mg.Dialect
+}
+
+// pending returns the migrations to run up in order, step <= 0 means all.
+func (m *Migrator) pending(step int) []*Migration {
+	list := make([]*Migration, 0)
+	for _, v := range m.versions() {
+		if step > 0 && len(list) == step {
+			break
+		}
+		if mg := m.Migrations[v]; !mg.done {
+			list = append(list, mg)
+		}
+	}
+	return list
+}
+
+// applied returns the migrations to run down in reverse order, step <= 0 means all.
+func (m *Migrator) applied(step int) []*Migration {
+	list := make([]*Migration, 0)
+	for _, v := range reverse(m.versions()) {
+		if step > 0 && len(list) == step {
+			break
+		}
+		if mg := m.Migrations[v]; mg.done {
+			list = append(list, mg)
+		}
+	}
+	return list
+}
+
+// dryRun prints the migrations and the sql they generate, the sql is not executed.
+// note that the sql is generated without checking the current schema, e.g. gorm auto migrate always
+// generates the create table statement.
+func (m *Migrator) dryRun(list []*Migration, up bool) error {
+	if len(list) == 0 {
+		fmt.Println("[dry-run] No migrations to run")
+		return nil
+	}
+
+	tx := m.db.Session(&gorm.Session{DryRun: true, Logger: logger.Default.LogMode(logger.Info)})
+	for _, mg := range list {
+		if up {
+			fmt.Printf("[dry-run] Would run migration %s (%s mode)\n", mg.Name, mg.Mode)
+			if err := mg.Up(tx); err != nil {
+				return fmt.Errorf("dry run migration %s failed, err: %v", mg.Name, err)
+			}
+			continue
+		}
+
+		fmt.Printf("[dry-run] Would revert migration %s (%s mode)\n", mg.Name, mg.Mode)
+		if err := mg.Down(tx); err != nil {
+			return fmt.Errorf("dry run migration %s failed, err: %v", mg.Name, err)
+		}
 	}
 
 	return nil
 }
 
+// CurrentVersion returns the latest applied migration's version, empty if no migration is applied.
+func (m *Migrator) CurrentVersion() string {
+	current := ""
+	for _, v := range m.versions() {
+		if m.Migrations[v].done {
+			current = v
+		}
+	}
+	return current
+}
+
 // MigrationStatus get the current migration status
 func (m *Migrator) MigrationStatus() error {
-	for _, v := range m.Versions {
+	fmt.Printf("Current version: %s\n", m.CurrentVersion())
+	for _, v := range m.versions() {
 		mg := m.Migrations[v]
 
 		if mg.done {
@@ -329,7 +551,7 @@ func genSqlMigrationFile(version, fileName string, in interface{}) error {
 func (m *Migrator) checkSQLFiles() {
 	for _, v := range m.Migrations {
 		// not check for gore mode
-		if v.Mode == GormMode {
+		if v.Mode == GormMode || v.dialect() != m.dialect {
 			continue
 		}
 		// only check 'up sql' files, 'down sql' files are optional
@@ -386,12 +608,13 @@ func getAllSQLFiles(dir string) error {
 	return nil
 }
 
+// reverse returns a reversed copy of the arr, the arr itself is not changed.
 func reverse(arr []string) []string {
-	for i := 0; i < len(arr)/2; i++ {
-		j := len(arr) - i - 1
-		arr[i], arr[j] = arr[j], arr[i]
+	reversed := make([]string, len(arr))
+	for i := range arr {
+		reversed[len(arr)-i-1] = arr[i]
 	}
-	return arr
+	return reversed
 }
 
 // GetUpSQLKey get the down sql key for MigrationSQLs
@@ -0,0 +1,53 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPendingAndApplied(t *testing.T) {
+	m := &Migrator{Versions: []string{}, Migrations: map[string]*Migration{}}
+	for _, v := range []string{"3", "1", "2", "4"} {
+		m.AddMigration(&Migration{Version: v, Name: v})
+	}
+	m.Migrations["1"].done = true
+	m.Migrations["2"].done = true
+
+	versions := func(list []*Migration) []string {
+		vs := make([]string, 0, len(list))
+		for _, mg := range list {
+			vs = append(vs, mg.Version)
+		}
+		return vs
+	}
+
+	if got := versions(m.pending(0)); !reflect.DeepEqual(got, []string{"3", "4"}) {
+		t.Errorf("unexpected pending migrations: %v", got)
+	}
+	if got := versions(m.pending(1)); !reflect.DeepEqual(got, []string{"3"}) {
+		t.Errorf("unexpected pending migrations with step: %v", got)
+	}
+	if got := versions(m.applied(0)); !reflect.DeepEqual(got, []string{"2", "1"}) {
+		t.Errorf("unexpected applied migrations: %v", got)
+	}
+
+	// 回滚计算不能改变版本顺序
+	if !reflect.DeepEqual(m.Versions, []string{"1", "2", "3", "4"}) {
+		t.Errorf("versions are changed: %v", m.Versions)
+	}
+	if got := m.CurrentVersion(); got != "2" {
+		t.Errorf("unexpected current version: %s", got)
+	}
+}
@@ -0,0 +1,401 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components/notifier"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbar "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/alert-rule"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// alertRuleBatchSize is the max count of alert rules evaluated in one batch.
+	alertRuleBatchSize = 100
+	// alertClientBatchSize is the max count of clients listed in one batch when checking group heartbeats.
+	alertClientBatchSize = 1000
+)
+
+// CreateAlertRule create alert rule.
+func (s *Service) CreateAlertRule(ctx context.Context, req *pbds.CreateAlertRuleReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rules, err := s.dao.AlertRule().List(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("list alert rules failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(rules) >= table.MaxAlertRulesPerBiz {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the number of alert rules exceeds the limit %d",
+			table.MaxAlertRulesPerBiz))
+	}
+	for _, one := range rules {
+		if one.Spec.Name == req.Spec.Name {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "alert rule name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	rule := &table.AlertRule{
+		Spec:       req.Spec.AlertRuleSpec(),
+		Attachment: req.Attachment.AlertRuleAttachment(),
+		State:      new(table.AlertRuleState),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	if err = s.validateAlertRuleRefs(kt, rule); err != nil {
+		return nil, err
+	}
+	id, err := s.dao.AlertRule().Create(kt, rule)
+	if err != nil {
+		logs.Errorf("create alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListAlertRules list the alert rules of a biz, or only the ones of the app if app id is set.
+func (s *Service) ListAlertRules(ctx context.Context, req *pbds.ListAlertRulesReq) (*pbds.ListAlertRulesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rules, err := s.dao.AlertRule().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list alert rules failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if req.AppId > 0 {
+		filtered := make([]*table.AlertRule, 0, len(rules))
+		for _, one := range rules {
+			if one.Attachment.AppID == req.AppId {
+				filtered = append(filtered, one)
+			}
+		}
+		rules = filtered
+	}
+
+	return &pbds.ListAlertRulesResp{
+		Count:   uint32(len(rules)),
+		Details: pbar.PbAlertRules(rules),
+	}, nil
+}
+
+// UpdateAlertRule update alert rule.
+func (s *Service) UpdateAlertRule(ctx context.Context, req *pbds.UpdateAlertRuleReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.AlertRule().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get alert rule (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if req.Spec.Name != old.Spec.Name {
+		if _, err = s.dao.AlertRule().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "alert rule name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	rule := &table.AlertRule{
+		ID:         old.ID,
+		Spec:       req.Spec.AlertRuleSpec(),
+		Attachment: req.Attachment.AlertRuleAttachment(),
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.validateAlertRuleRefs(kt, rule); err != nil {
+		return nil, err
+	}
+	if err = s.dao.AlertRule().Update(kt, rule); err != nil {
+		logs.Errorf("update alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteAlertRule delete alert rule.
+func (s *Service) DeleteAlertRule(ctx context.Context, req *pbds.DeleteAlertRuleReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.AlertRule().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete alert rule failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// validateAlertRuleRefs validate the notification channels and the group referenced by the rule belong to the biz.
+func (s *Service) validateAlertRuleRefs(kt *kit.Kit, rule *table.AlertRule) error {
+	if rule.Spec == nil || rule.Attachment == nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "alert rule spec is required"))
+	}
+
+	for _, id := range rule.Spec.ChannelIDs {
+		if _, err := s.dao.NotificationChannel().Get(kt, rule.Attachment.BizID, id); err != nil {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "notification channel %d is not found", id))
+		}
+	}
+
+	if rule.Spec.Metric == table.GroupNoHeartbeat && rule.Spec.GroupID > 0 {
+		if _, err := s.dao.Group().Get(kt, rule.Spec.GroupID, rule.Attachment.BizID); err != nil {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "group %d is not found", rule.Spec.GroupID))
+		}
+	}
+
+	return nil
+}
+
+// alertSample is the sampled metric value of an alert rule.
+type alertSample struct {
+	value  float64
+	detail string
+}
+
+// EvaluateAlertRules evaluate all the enabled alert rules, fire the alerts whose threshold is exceeded and
+// resolve the recovered ones, returns the count of fired alerts.
+func (s *Service) EvaluateAlertRules(kt *kit.Kit) (int, error) {
+	var lastID uint32
+	fired := 0
+	for {
+		rules, err := s.dao.AlertRule().ListEnabledByCursor(kt, lastID, alertRuleBatchSize)
+		if err != nil {
+			return fired, err
+		}
+
+		for _, rule := range rules {
+			ok, e := s.evaluateAlertRule(kt, rule)
+			if e != nil {
+				// 单个规则失败不影响其他规则的检测
+				logs.Errorf("evaluate alert rule %d failed, err: %v, rid: %s", rule.ID, e, kt.Rid)
+				continue
+			}
+			if ok {
+				fired++
+			}
+		}
+
+		if len(rules) < alertRuleBatchSize {
+			return fired, nil
+		}
+		lastID = rules[len(rules)-1].ID
+	}
+}
+
+// evaluateAlertRule evaluate one alert rule and update its state, returns whether the alert is fired.
+// the firing alert is sent again after the silence interval, and a recovery message is sent once it's resolved.
+func (s *Service) evaluateAlertRule(kt *kit.Kit, rule *table.AlertRule) (bool, error) {
+	sample, err := s.sampleAlertMetric(kt, rule)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now()
+	if rule.State == nil {
+		rule.State = new(table.AlertRuleState)
+	}
+	state := rule.State
+	state.LastEvaluatedAt = &now
+	if sample != nil {
+		state.LastValue = sample.value
+	}
+
+	// 没有数据时视为未超过阈值
+	exceeded := sample != nil && rule.Spec.Metric.Exceeded(sample.value, rule.Spec.Threshold)
+	fired := false
+	switch {
+	case exceeded && (!state.Firing || state.LastFiredAt == nil || now.Sub(*state.LastFiredAt) >= rule.Spec.Silence()):
+		s.sendAlert(kt, rule, sample, false)
+		state.Firing = true
+		state.LastFiredAt = &now
+		fired = true
+	case !exceeded && state.Firing:
+		s.sendAlert(kt, rule, sample, true)
+		state.Firing = false
+	}
+
+	return fired, s.dao.AlertRule().UpdateState(kt, rule)
+}
+
+// sampleAlertMetric sample the metric value of the alert rule, it returns nil if there is no data.
+func (s *Service) sampleAlertMetric(kt *kit.Kit, rule *table.AlertRule) (*alertSample, error) {
+	bizID, appID := rule.Attachment.BizID, rule.Attachment.AppID
+	switch rule.Spec.Metric {
+	case table.PublishFailureRate:
+		since := time.Now().Add(-time.Duration(rule.Spec.WindowMin) * time.Minute)
+		total, failed, err := s.dao.ClientEvent().CountReleaseChange(kt, bizID, appID, since)
+		if err != nil || total == 0 {
+			return nil, err
+		}
+		return &alertSample{
+			value:  float64(failed) * 100 / float64(total),
+			detail: fmt.Sprintf("最近 %d 分钟客户端 %d 次版本变更中 %d 次失败", rule.Spec.WindowMin, total, failed),
+		}, nil
+
+	case table.GroupNoHeartbeat:
+		return s.sampleGroupHeartbeat(kt, bizID, appID, rule.Spec.GroupID)
+
+	case table.CredentialExpiry:
+		accounts, err := s.dao.ServiceAccount().List(kt, bizID)
+		if err != nil {
+			return nil, err
+		}
+		var earliest *table.ServiceAccount
+		for _, one := range accounts {
+			if !one.Spec.Enabled || one.Spec.ExpiredAt == nil {
+				continue
+			}
+			if earliest == nil || one.Spec.ExpiredAt.Before(*earliest.Spec.ExpiredAt) {
+				earliest = one
+			}
+		}
+		if earliest == nil {
+			return nil, nil
+		}
+		return &alertSample{
+			value: time.Until(*earliest.Spec.ExpiredAt).Hours() / 24,
+			detail: fmt.Sprintf("服务账号 %s 的 API 密钥将于 %s 过期", earliest.Spec.Name,
+				earliest.Spec.ExpiredAt.Format(time.DateTime)),
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported alert metric: %s", rule.Spec.Metric)
+	}
+}
+
+// sampleGroupHeartbeat returns the minutes since the latest heartbeat of the app's clients in the group,
+// it returns nil if no client has ever connected in the group.
+func (s *Service) sampleGroupHeartbeat(kt *kit.Kit, bizID, appID, groupID uint32) (*alertSample, error) {
+	group, err := s.dao.Group().Get(kt, groupID, bizID)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastID uint32
+	var latest time.Time
+	matched := 0
+	for {
+		clients, err := s.dao.Client().ListByIDCursor(kt, bizID, appID, lastID, alertClientBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, one := range clients {
+			if !matchGroupClient(group, one) {
+				continue
+			}
+			matched++
+			if one.Spec.LastHeartbeatTime.After(latest) {
+				latest = one.Spec.LastHeartbeatTime
+			}
+		}
+
+		if len(clients) < alertClientBatchSize {
+			break
+		}
+		lastID = clients[len(clients)-1].ID
+	}
+
+	if matched == 0 {
+		return nil, nil
+	}
+
+	return &alertSample{
+		value: time.Since(latest).Minutes(),
+		detail: fmt.Sprintf("分组 %s 的 %d 个客户端最近一次心跳时间为 %s", group.Spec.Name, matched,
+			latest.Format(time.DateTime)),
+	}, nil
+}
+
+// matchGroupClient returns whether the client belongs to the group.
+func matchGroupClient(group *table.Group, client *table.Client) bool {
+	if group.Spec.Mode == table.GroupModeDebug {
+		return group.Spec.UID == client.Attachment.UID
+	}
+
+	if group.Spec.Selector == nil {
+		return true
+	}
+
+	labels := make(map[string]string)
+	if client.Spec.Labels != "" {
+		if err := json.Unmarshal([]byte(client.Spec.Labels), &labels); err != nil {
+			return false
+		}
+	}
+	matched, err := group.Spec.Selector.MatchInstance(client.Attachment.UID, labels)
+	return err == nil && matched
+}
+
+// sendAlert sends the firing or resolved message of the alert rule to its notification channels synchronously.
+func (s *Service) sendAlert(kt *kit.Kit, rule *table.AlertRule, sample *alertSample, resolved bool) {
+	subject := "[BSCP] 告警: " + rule.Spec.Name
+	if resolved {
+		subject = "[BSCP] 告警恢复: " + rule.Spec.Name
+	}
+
+	unit := rule.Spec.Metric.Unit()
+	lines := []string{fmt.Sprintf("业务: %d", rule.Attachment.BizID)}
+	if rule.Attachment.AppID > 0 {
+		appName := fmt.Sprintf("%d", rule.Attachment.AppID)
+		if app, err := s.dao.App().Get(kt, rule.Attachment.BizID, rule.Attachment.AppID); err == nil {
+			appName = app.Spec.Name
+		}
+		lines = append(lines, "服务: "+appName)
+	}
+	lines = append(lines, "指标: "+string(rule.Spec.Metric))
+	if sample != nil {
+		lines = append(lines, fmt.Sprintf("当前值: %.2f %s", sample.value, unit), "详情: "+sample.detail)
+	} else {
+		lines = append(lines, "当前值: 无数据")
+	}
+	lines = append(lines, fmt.Sprintf("阈值: %.2f %s", rule.Spec.Threshold, unit),
+		"时间: "+time.Now().Format(time.DateTime))
+	msg := &notifier.Message{Subject: subject, Content: strings.Join(lines, "\n")}
+
+	for _, id := range rule.Spec.ChannelIDs {
+		channel, err := s.dao.NotificationChannel().Get(kt, rule.Attachment.BizID, id)
+		if err != nil {
+			logs.Errorf("get notification channel %d of alert rule %d failed, err: %v, rid: %s", id, rule.ID, err,
+				kt.Rid)
+			continue
+		}
+		if !channel.Spec.Enabled {
+			continue
+		}
+
+		if err = s.notifier.SendMessage(kt.Ctx, channel.Spec, msg); err != nil {
+			logs.Errorf("send alert rule %d to notification channel %d failed, err: %v, rid: %s", rule.ID, id, err,
+				kt.Rid)
+		}
+	}
+}
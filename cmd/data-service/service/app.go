@@ -49,7 +49,7 @@ func (s *Service) CreateApp(ctx context.Context, req *pbds.CreateAppReq) (*pbds.
 	}
 
 	if _, err := s.dao.App().GetByName(kt, req.BizId, req.Spec.Name); err == nil {
-		return nil, errf.Errorf(errf.InvalidRequest, i18n.T(kt, "app name %s already exists", req.Spec.Name))
+		return nil, errf.ErrAppAlreadyExists.New(kt, req.Spec.Name)
 	}
 
 	if _, err := s.dao.App().GetByAlias(kt, req.BizId, req.Spec.Alias); err == nil {
@@ -170,8 +170,22 @@ func (s *Service) DeleteApp(ctx context.Context, req *pbds.DeleteAppReq) (*pbbas
 		BizID: req.BizId,
 	}
 
+	oldOne, err := s.dao.App().Get(grpcKit, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
 	tx := s.dao.GenQuery().Begin()
 
+	// the app or its configs referenced by the other apps can not be deleted.
+	if err := s.unlinkAppConfigRefsWithTx(grpcKit, tx, oldOne.BizID, oldOne.ID, oldOne.Spec.Name); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
 	// 1. delete app related resources
 	if err := s.deleteAppRelatedResources(grpcKit, req, tx); err != nil {
 		logs.Errorf("delete app related resources failed, err: %v, rid: %s", err, grpcKit.Rid)
@@ -192,6 +206,15 @@ func (s *Service) DeleteApp(ctx context.Context, req *pbds.DeleteAppReq) (*pbbas
 			i18n.T(grpcKit, "delete app failed, err: %v", err))
 	}
 
+	// 3. put the deleted app into recycle bin, so that it can be restored
+	if err := s.recycleWithTx(grpcKit, tx, table.RecycleApp, oldOne.ID, oldOne.BizID, oldOne.ID,
+		oldOne.Spec.Name, oldOne); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, errf.Errorf(errf.DBOpFailed,
@@ -244,6 +267,23 @@ func (s *Service) deleteAppRelatedResources(grpcKit *kit.Kit, req *pbds.DeleteAp
 		return err
 	}
 
+	// delete env app binding
+	if err := s.dao.EnvAppBinding().DeleteByAppIDWithTx(grpcKit, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete env app binding failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return err
+	}
+
+	// delete the app's config share refs and the config shares owned by the app
+	if err := s.deleteAppConfigShares(grpcKit, tx, req.BizId, req.Id); err != nil {
+		return err
+	}
+
+	// delete the app's kv overrides
+	if err := s.dao.KvOverride().DeleteByAppIDWithTx(grpcKit, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete kv overrides failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return err
+	}
+
 	// delete related credential scopes and update credentials
 	if err := s.updateRelatedCredentials(grpcKit, tx, req.Id, req.BizId); err != nil {
 		return err
@@ -310,7 +350,7 @@ func (s *Service) GetAppByID(ctx context.Context, req *pbds.GetAppByIDReq) (*pba
 	if err != nil {
 		logs.Errorf("get app by id failed, err: %v, rid: %s", err, grpcKit.Rid)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errf.Errorf(errf.AppNotExists, i18n.T(grpcKit, "app %d not found", req.AppId))
+			return nil, errf.ErrAppNotExists.New(grpcKit, req.AppId)
 		}
 		return nil, errors.Wrapf(err, "query app by id %d", req.GetAppId())
 	}
@@ -361,7 +401,19 @@ func (s *Service) ListAppsRest(ctx context.Context, req *pbds.ListAppsRestReq) (
 		return nil, fmt.Errorf("bizList is empty")
 	}
 
-	details, count, err := s.dao.App().List(kt, bizList, req.Search, req.ConfigType, req.Operator, opt)
+	var (
+		details    []*table.App
+		count      int64
+		nextCursor string
+	)
+	if page := req.CursorPage.CursorPage(); page != nil {
+		if err = page.Validate(types.AppCursorOption); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+		}
+		details, nextCursor, err = s.dao.App().ListWithCursor(kt, bizList, page)
+	} else {
+		details, count, err = s.dao.App().List(kt, bizList, req.Search, req.ConfigType, req.Operator, opt)
+	}
 	if err != nil {
 		logs.Errorf("list apps failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
@@ -378,6 +430,7 @@ func (s *Service) ListAppsRest(ctx context.Context, req *pbds.ListAppsRestReq) (
 		Details:       pbapp.PbApps(details),
 		KvAppsCount:   uint32(kvAppsCount),
 		FileAppsCount: uint32(fileAppsCount),
+		NextCursor:    nextCursor,
 	}
 	return resp, nil
 }
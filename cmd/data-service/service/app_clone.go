@@ -0,0 +1,304 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CloneApp clone a new app from the source app with the selected components, the new app can be in another biz.
+func (s *Service) CloneApp(ctx context.Context, req *pbds.CloneAppReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	src, app, data, err := s.prepareCloneApp(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.cloneApp(kt, req, src, app, data); err != nil {
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: app.ID}, nil
+}
+
+// CloneAppAsync clone app in an async operation, the new app id is the result of the operation.
+func (s *Service) CloneAppAsync(ctx context.Context, req *pbds.CloneAppReq) (*pbds.CreateOperationResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	// 参数校验和数据收集同步完成, 保证返回操作ID前能发现大部分错误
+	src, app, data, err := s.prepareCloneApp(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	opID, err := s.startOperation(kt, req.BizId, req.AppId, table.AppCloneOperation, 1,
+		func(r *operationRunner) (interface{}, error) {
+			if err := s.cloneApp(r.kt, req, src, app, data); err != nil {
+				r.Fail(src.Spec.Name, err)
+				return nil, nil
+			}
+			r.Succeed()
+			return map[string]uint32{"app_id": app.ID}, nil
+		})
+	if err != nil {
+		logs.Errorf("start clone app operation failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateOperationResp{OperationId: opID}, nil
+}
+
+// prepareCloneApp validate the clone request and collect the data of the source app.
+func (s *Service) prepareCloneApp(kt *kit.Kit, req *pbds.CloneAppReq) (*table.App, *table.App,
+	*table.AppSnapshotData, error) {
+	targetBizID := req.TargetBizId
+	if targetBizID == 0 {
+		targetBizID = req.BizId
+	}
+	// 模版套餐和脚本是业务级资源, 不支持跨业务复制
+	if targetBizID != req.BizId && (req.WithTemplateBinding || req.WithHooks) {
+		return nil, nil, nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(kt, "template binding and hooks can not be cloned to another biz"))
+	}
+
+	src, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, nil, err
+	}
+	// 冻结或归档的服务不允许作为复制的来源
+	if err = checkAppWritable(kt, src); err != nil {
+		return nil, nil, nil, err
+	}
+
+	if !strings.HasPrefix(kt.User, constant.BKUserForTestPrefix) {
+		if err = s.validateBizExist(kt, targetBizID); err != nil {
+			logs.Errorf("validate biz exist failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, nil, nil, err
+		}
+	}
+	if _, err = s.dao.App().GetByName(kt, targetBizID, req.Name); err == nil {
+		return nil, nil, nil, errf.ErrAppAlreadyExists.New(kt, req.Name)
+	}
+	if _, err = s.dao.App().GetByAlias(kt, targetBizID, req.Alias); err == nil {
+		return nil, nil, nil, errf.Errorf(errf.InvalidRequest, i18n.T(kt, "app alias %s already exists", req.Alias))
+	}
+
+	data, err := s.collectAppSnapshotData(kt, src)
+	if err != nil {
+		logs.Errorf("collect app %d data failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, nil, nil, err
+	}
+
+	spec := *src.Spec
+	spec.Name, spec.Alias, spec.Memo = req.Name, req.Alias, req.Memo
+	spec.LastConsumedTime = nil
+	spec.State = table.AppStateActive
+	app := &table.App{
+		BizID:    targetBizID,
+		Spec:     &spec,
+		Revision: &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+
+	return src, app, data, nil
+}
+
+// cloneApp create the new app and copy the components of the source app in a transaction.
+func (s *Service) cloneApp(kt *kit.Kit, req *pbds.CloneAppReq, src, app *table.App,
+	data *table.AppSnapshotData) error {
+	tx := s.dao.GenQuery().Begin()
+	if err := s.cloneAppWithTx(kt, tx, req, src, app, data); err != nil {
+		logs.Errorf("clone app %d failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// cloneAppWithTx create the new app and copy the selected components of the source app to it.
+func (s *Service) cloneAppWithTx(kt *kit.Kit, tx *gen.QueryTx, req *pbds.CloneAppReq, src, app *table.App,
+	data *table.AppSnapshotData) error {
+
+	if _, err := s.dao.App().CreateWithTx(kt, tx, app); err != nil {
+		return err
+	}
+	bizID, appID := app.BizID, app.ID
+
+	if req.WithConfigItems && src.Spec.ConfigType == table.File {
+		if err := s.cloneConfigItems(kt, tx, src.BizID, app, data.ConfigItems, req.Rename); err != nil {
+			return err
+		}
+	}
+
+	if req.WithKvs && src.Spec.ConfigType == table.KV {
+		if err := s.cloneKvs(kt, tx, src, app, data.Kvs, req.Rename); err != nil {
+			return err
+		}
+	}
+
+	if req.WithTemplateBinding && data.TemplateBinding != nil {
+		atb := &table.AppTemplateBinding{
+			Spec:       data.TemplateBinding,
+			Attachment: &table.AppTemplateBindingAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		}
+		if err := s.dao.AppTemplateBinding().UpsertWithTx(kt, tx, atb); err != nil {
+			return err
+		}
+	}
+
+	if req.WithVariables && len(data.Variables) != 0 {
+		if err := s.dao.AppTemplateVariable().UpsertWithTx(kt, tx, &table.AppTemplateVariable{
+			Spec:       &table.AppTemplateVariableSpec{Variables: data.Variables},
+			Attachment: &table.AppTemplateVariableAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if !req.WithHooks {
+		return nil
+	}
+	// 服务当前引用的脚本记录在 release_id 为 0 的 released hook 中
+	for _, tp := range []table.HookType{table.PreHook, table.PostHook} {
+		hook, err := s.dao.ReleasedHook().Get(kt, src.BizID, src.ID, 0, tp)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+		hook.ID = 0
+		hook.AppID = appID
+		hook.Reviser = kt.User
+		if _, err = s.dao.ReleasedHook().CreateWithTx(kt, tx, hook); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cloneConfigItems create the config items with the source contents, the contents are copied to the
+// repository of the target biz when cloning across biz.
+func (s *Service) cloneConfigItems(kt *kit.Kit, tx *gen.QueryTx, srcBizID uint32, app *table.App,
+	items []*table.AppSnapshotConfigItem, rename map[string]string) error {
+
+	dstKt := kt.Clone()
+	dstKt.BizID = app.BizID
+	for _, one := range items {
+		spec := *one.Spec
+		if to, ok := rename[path.Join(spec.Path, spec.Name)]; ok {
+			spec.Path, spec.Name = path.Dir(to), path.Base(to)
+		}
+		ci := &table.ConfigItem{
+			Spec:       &spec,
+			Attachment: &table.ConfigItemAttachment{BizID: app.BizID, AppID: app.ID},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		}
+		ciID, err := s.dao.ConfigItem().CreateWithTx(kt, tx, ci)
+		if err != nil {
+			return err
+		}
+
+		if one.Content == nil {
+			continue
+		}
+		if srcBizID != app.BizID {
+			if err = s.syncSharedContent(dstKt, srcBizID, one.Content.Signature); err != nil {
+				logs.Errorf("copy content %s to biz %d failed, err: %v, rid: %s", one.Content.Signature,
+					app.BizID, err, kt.Rid)
+				return err
+			}
+		}
+		if err = s.createCommitWithTx(kt, tx, app.BizID, app.ID, ciID, one.Content); err != nil {
+			return err
+		}
+	}
+
+	return s.dao.ConfigItem().ValidateAppCINumber(kt, tx, app.BizID, app.ID)
+}
+
+// cloneKvs copy the kv values of the source app in vault to the new app and create the kvs.
+func (s *Service) cloneKvs(kt *kit.Kit, tx *gen.QueryTx, src, app *table.App, kvs []*table.AppSnapshotKv,
+	rename map[string]string) error {
+
+	toCreate := make([]*table.Kv, 0, len(kvs))
+	for _, one := range kvs {
+		kvType, value, err := s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+			BizID:   src.BizID,
+			AppID:   src.ID,
+			Key:     one.Spec.Key,
+			Version: int(one.Spec.Version),
+		})
+		if err != nil {
+			logs.Errorf("get kv %s version %d from vault failed, err: %v, rid: %s", one.Spec.Key,
+				one.Spec.Version, err, kt.Rid)
+			return err
+		}
+
+		spec := *one.Spec
+		if to, ok := rename[spec.Key]; ok {
+			spec.Key = to
+		}
+		version, err := s.vault.UpsertKv(kt, &types.UpsertKvOption{
+			BizID:  app.BizID,
+			AppID:  app.ID,
+			Key:    spec.Key,
+			Value:  value,
+			KvType: kvType,
+		})
+		if err != nil {
+			logs.Errorf("upsert kv %s to vault failed, err: %v, rid: %s", spec.Key, err, kt.Rid)
+			return err
+		}
+		spec.Version = uint32(version)
+
+		toCreate = append(toCreate, &table.Kv{
+			KvState:     table.KvStateAdd,
+			Spec:        &spec,
+			Attachment:  &table.KvAttachment{BizID: app.BizID, AppID: app.ID},
+			Revision:    &table.Revision{Creator: kt.User, Reviser: kt.User},
+			ContentSpec: one.ContentSpec,
+		})
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	return s.dao.Kv().BatchCreateWithTx(kt, tx, toCreate)
+}
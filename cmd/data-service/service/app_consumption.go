@@ -0,0 +1,181 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	defaultConsumptionDays = 7
+	// AppConsumptionRetentionDays is the days the app consumption stats are kept.
+	AppConsumptionRetentionDays = 90
+	defaultTopConsumingApps     = 10
+	maxTopConsumingApps         = 100
+)
+
+// BatchIncreaseAppConsumption add the consumption reported by the feed servers to the daily stats.
+func (s *Service) BatchIncreaseAppConsumption(ctx context.Context, req *pbds.BatchIncreaseAppConsumptionReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	stats := make([]*table.AppConsumptionStat, 0, len(req.Items))
+	for _, one := range req.Items {
+		stats = append(stats, &table.AppConsumptionStat{
+			Spec: &table.AppConsumptionStatSpec{
+				StatDate:      req.StatDate,
+				PullCount:     one.PullCount,
+				DownloadBytes: one.DownloadBytes,
+				WatchSeconds:  one.WatchSeconds,
+			},
+			Attachment: &table.AppConsumptionStatAttachment{
+				BizID: one.BizId,
+				AppID: one.AppId,
+			},
+		})
+	}
+
+	if err := s.dao.AppConsumptionStat().BatchIncrease(kt, stats); err != nil {
+		logs.Errorf("batch increase app consumption failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetAppConsumption get the consumption stats of the app in recent days.
+func (s *Service) GetAppConsumption(ctx context.Context, req *pbds.GetAppConsumptionReq) (*pbapp.AppConsumption,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app %d failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	stats, err := s.dao.AppConsumptionStat().ListByApp(kt, req.BizId, req.AppId, consumptionSince(req.Days))
+	if err != nil {
+		logs.Errorf("list app %d consumption stats failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	online, err := s.dao.Client().CountNumberOlineClients(kt, req.BizId, req.AppId, 0,
+		&pbclient.ClientQueryCondition{})
+	if err != nil {
+		logs.Errorf("count app %d online clients failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	resp := pbAppConsumption(app)
+	resp.OnlineClients = uint32(online)
+	resp.Daily = pbapp.PbAppConsumptionDailies(stats)
+	for _, one := range stats {
+		resp.PullCount += one.Spec.PullCount
+		resp.DownloadBytes += one.Spec.DownloadBytes
+	}
+
+	return resp, nil
+}
+
+// ListTopConsumingApps list the apps with the most pull count in recent days.
+func (s *Service) ListTopConsumingApps(ctx context.Context, req *pbds.ListTopConsumingAppsReq) (
+	*pbds.ListTopConsumingAppsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultTopConsumingApps
+	}
+	if limit > maxTopConsumingApps {
+		limit = maxTopConsumingApps
+	}
+
+	sums, err := s.dao.AppConsumptionStat().ListTopApps(kt, req.BizId, consumptionSince(req.Days), limit)
+	if err != nil {
+		logs.Errorf("list top consuming apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(sums) == 0 {
+		return &pbds.ListTopConsumingAppsResp{Details: []*pbapp.AppConsumption{}}, nil
+	}
+
+	ids := make([]uint32, 0, len(sums))
+	for _, one := range sums {
+		ids = append(ids, one.AppID)
+	}
+	apps, err := s.dao.App().ListAppsByIDs(kt, ids)
+	if err != nil {
+		logs.Errorf("list apps by ids failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	appMap := make(map[uint32]*table.App, len(apps))
+	for _, one := range apps {
+		appMap[one.ID] = one
+	}
+
+	details := make([]*pbapp.AppConsumption, 0, len(sums))
+	for _, one := range sums {
+		// 已删除的服务不再展示
+		app, ok := appMap[one.AppID]
+		if !ok {
+			continue
+		}
+		item := pbAppConsumption(app)
+		item.PullCount = one.PullCount
+		item.DownloadBytes = one.DownloadBytes
+		details = append(details, item)
+	}
+
+	return &pbds.ListTopConsumingAppsResp{Details: details}, nil
+}
+
+// PurgeExpiredAppConsumption delete the app consumption stats older than the retention days.
+func (s *Service) PurgeExpiredAppConsumption(kt *kit.Kit) error {
+	date := time.Now().UTC().AddDate(0, 0, -AppConsumptionRetentionDays).Format(table.AppConsumptionStatDateLayout)
+	return s.dao.AppConsumptionStat().DeleteBefore(kt, date)
+}
+
+// consumptionSince returns the first stat date of the recent days.
+func consumptionSince(days uint32) string {
+	if days == 0 {
+		days = defaultConsumptionDays
+	}
+	if days > AppConsumptionRetentionDays {
+		days = AppConsumptionRetentionDays
+	}
+
+	return time.Now().UTC().AddDate(0, 0, 1-int(days)).Format(table.AppConsumptionStatDateLayout)
+}
+
+func pbAppConsumption(app *table.App) *pbapp.AppConsumption {
+	item := &pbapp.AppConsumption{
+		AppId:   app.ID,
+		AppName: app.Spec.Name,
+	}
+	if app.Spec.LastConsumedTime != nil {
+		item.LastConsumedTime = app.Spec.LastConsumedTime.Format(constant.TimeStdFormat)
+	}
+
+	return item
+}
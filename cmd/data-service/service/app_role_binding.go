@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbarb "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-role-binding"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// SetAppRoleBinding set the role of the user in the app, the existing role is overwritten, the role binding
+// with expired time is a temporary one which is deleted after expired.
+func (s *Service) SetAppRoleBinding(ctx context.Context, req *pbds.SetAppRoleBindingReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	expiredAt, err := parseExpiredAt(kt, req.ExpiredAt)
+	if err != nil {
+		return nil, err
+	}
+	if err = validator.ValidateMemo(kt, req.Reason, false); err != nil {
+		return nil, err
+	}
+
+	old, err := s.dao.AppRoleBinding().GetByUser(kt, req.BizId, req.AppId, req.User)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get app role binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err == nil {
+		// 临时授权不能覆盖永久授权, 否则过期后用户将失去原有权限
+		if expiredAt != nil && !old.Spec.Temporary() {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"user %s already has the permanent role %s in the app", req.User, old.Spec.Role))
+		}
+
+		old.Spec.Role = table.AppRole(req.Role)
+		old.Spec.ExpiredAt = expiredAt
+		old.Spec.Reason = req.Reason
+		old.Revision.Reviser = kt.User
+		if err = s.dao.AppRoleBinding().UpdateRole(kt, old); err != nil {
+			logs.Errorf("update app role binding failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		return &pbds.CreateResp{Id: old.ID}, nil
+	}
+
+	binding := &table.AppRoleBinding{
+		Spec: &table.AppRoleBindingSpec{
+			User:      req.User,
+			Role:      table.AppRole(req.Role),
+			ExpiredAt: expiredAt,
+			Reason:    req.Reason,
+		},
+		Attachment: &table.AppRoleBindingAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.AppRoleBinding().Create(kt, binding)
+	if err != nil {
+		logs.Errorf("create app role binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListAppRoleBindings list all the role bindings of an app.
+func (s *Service) ListAppRoleBindings(ctx context.Context, req *pbds.ListAppRoleBindingsReq) (
+	*pbds.ListAppRoleBindingsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	list, err := s.dao.AppRoleBinding().List(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("list app role bindings failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListAppRoleBindingsResp{Details: pbarb.PbAppRoleBindings(list)}, nil
+}
+
+// DeleteAppRoleBinding delete the role binding of an app.
+func (s *Service) DeleteAppRoleBinding(ctx context.Context, req *pbds.DeleteAppRoleBindingReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.AppRoleBinding().Delete(kt, req.BizId, req.AppId, req.Id); err != nil {
+		logs.Errorf("delete app role binding (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListUserAppRoles list the role bindings of the user in the apps, which is used to authorize the user.
+func (s *Service) ListUserAppRoles(ctx context.Context, req *pbds.ListUserAppRolesReq) (
+	*pbds.ListAppRoleBindingsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	list, err := s.dao.AppRoleBinding().ListByUser(kt, req.BizId, req.User, req.AppIds)
+	if err != nil {
+		logs.Errorf("list user app roles failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListAppRoleBindingsResp{Details: pbarb.PbAppRoleBindings(list)}, nil
+}
+
+// ExpireAppRoleBindings delete the expired temporary role bindings, returns the count of the expired ones.
+func (s *Service) ExpireAppRoleBindings(kt *kit.Kit, limit int) (int, error) {
+	list, err := s.dao.AppRoleBinding().ListExpired(kt, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, one := range list {
+		if err := s.dao.AppRoleBinding().DeleteExpired(kt, one); err != nil {
+			logs.Errorf("expire app role binding %d failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
@@ -0,0 +1,456 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbasnap "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app-snapshot"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateAppSnapshot take a snapshot of the app's current editing workspace.
+func (s *Service) CreateAppSnapshot(ctx context.Context, req *pbds.CreateAppSnapshotReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if _, err = s.dao.AppSnapshot().GetByName(kt, req.BizId, req.AppId, req.Name); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "app snapshot name %s already exists", req.Name))
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get app snapshot by name failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	count, err := s.dao.AppSnapshot().Count(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("count app snapshots failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if count >= table.MaxAppSnapshotsPerApp {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the number of app snapshots has reached the maximum %d, please delete the old ones first",
+			table.MaxAppSnapshotsPerApp))
+	}
+
+	data, err := s.collectAppSnapshotData(kt, app)
+	if err != nil {
+		logs.Errorf("collect app snapshot data failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	snapshot := &table.AppSnapshot{
+		Spec: &table.AppSnapshotSpec{
+			Name: req.Name,
+			Memo: req.Memo,
+			Data: *data,
+		},
+		Attachment: &table.AppSnapshotAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+	}
+	id, err := s.dao.AppSnapshot().Create(kt, snapshot)
+	if err != nil {
+		logs.Errorf("create app snapshot failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListAppSnapshots list the snapshots of an app.
+func (s *Service) ListAppSnapshots(ctx context.Context, req *pbds.ListAppSnapshotsReq) (
+	*pbds.ListAppSnapshotsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	page := &types.BasePage{
+		Start: req.Start,
+		Limit: uint(req.Limit),
+		All:   req.All,
+	}
+	details, count, err := s.dao.AppSnapshot().List(kt, req.BizId, req.AppId, page)
+	if err != nil {
+		logs.Errorf("list app snapshots failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListAppSnapshotsResp{
+		Count:   uint32(count),
+		Details: pbasnap.PbAppSnapshots(details),
+	}, nil
+}
+
+// DeleteAppSnapshot delete a snapshot of an app.
+func (s *Service) DeleteAppSnapshot(ctx context.Context, req *pbds.DeleteAppSnapshotReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.AppSnapshot().Delete(kt, req.BizId, req.AppId, req.Id); err != nil {
+		logs.Errorf("delete app snapshot (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// RestoreAppSnapshot restore the app's editing workspace to the snapshot, releases are not affected.
+func (s *Service) RestoreAppSnapshot(ctx context.Context, req *pbds.RestoreAppSnapshotReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	snapshot, err := s.dao.AppSnapshot().Get(kt, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get app snapshot (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if err = s.restoreAppSnapshotData(kt, app, &snapshot.Spec.Data); err != nil {
+		logs.Errorf("restore app snapshot %s failed, err: %v, rid: %s", snapshot.Spec.Name, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// restoreAppSnapshotData restore the editing workspace of the app to the data in one transaction.
+func (s *Service) restoreAppSnapshotData(kt *kit.Kit, app *table.App, data *table.AppSnapshotData) error {
+	var err error
+	tx := s.dao.GenQuery().Begin()
+	if app.Spec.ConfigType == table.KV {
+		err = s.restoreSnapshotKvs(kt, tx, app.BizID, app.ID, data.Kvs)
+	} else {
+		err = s.restoreSnapshotConfigItems(kt, tx, app.BizID, app.ID, data)
+	}
+	if err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// collectAppSnapshotData collect the editing workspace of the app.
+func (s *Service) collectAppSnapshotData(kt *kit.Kit, app *table.App) (*table.AppSnapshotData, error) {
+	bizID, appID := app.BizID, app.ID
+	data := &table.AppSnapshotData{
+		ConfigItems: make([]*table.AppSnapshotConfigItem, 0),
+		Kvs:         make([]*table.AppSnapshotKv, 0),
+	}
+
+	if app.Spec.ConfigType == table.KV {
+		kvs, err := s.dao.Kv().ListAllByAppID(kt, appID, bizID, []string{string(table.KvStateAdd),
+			string(table.KvStateRevise), string(table.KvStateUnchange)})
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range kvs {
+			data.Kvs = append(data.Kvs, &table.AppSnapshotKv{Spec: kv.Spec, ContentSpec: kv.ContentSpec})
+		}
+		return data, nil
+	}
+
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+	contents := make(map[uint32]*table.ContentSpec, len(commits))
+	for _, commit := range commits {
+		contents[commit.Attachment.ConfigItemID] = commit.Spec.Content
+	}
+	for _, ci := range cis {
+		data.ConfigItems = append(data.ConfigItems, &table.AppSnapshotConfigItem{
+			ID:      ci.ID,
+			Spec:    ci.Spec,
+			Content: contents[ci.ID],
+		})
+	}
+
+	atb, err := s.dao.AppTemplateBinding().GetAppTemplateBindingByAppID(kt, bizID, appID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if atb != nil {
+		data.TemplateBinding = atb.Spec
+	}
+
+	data.Variables, err = s.dao.AppTemplateVariable().ListVariables(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// restoreSnapshotConfigItems restore the config items, template binding and variables of a file app.
+func (s *Service) restoreSnapshotConfigItems(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32,
+	data *table.AppSnapshotData) error {
+
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return err
+	}
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, bizID, appID)
+	if err != nil {
+		return err
+	}
+	latest := make(map[uint32]*table.Commit, len(commits))
+	for _, commit := range commits {
+		latest[commit.Attachment.ConfigItemID] = commit
+	}
+
+	// 1. 删除快照之后新增的配置项, 避免与快照中的配置项冲突
+	wanted := make(map[uint32]bool, len(data.ConfigItems))
+	for _, one := range data.ConfigItems {
+		wanted[one.ID] = true
+	}
+	existing := make(map[uint32]*table.ConfigItem, len(cis))
+	for _, ci := range cis {
+		if wanted[ci.ID] {
+			existing[ci.ID] = ci
+			continue
+		}
+		if err = s.dao.ConfigItem().DeleteWithTx(kt, tx, ci); err != nil {
+			return err
+		}
+	}
+
+	// 2. 还原配置项元数据及内容, 内容有变化时生成新的提交
+	for _, one := range data.ConfigItems {
+		if ci, ok := existing[one.ID]; ok {
+			ci.Spec = one.Spec
+			ci.Revision.Reviser = kt.User
+			if err = s.dao.ConfigItem().UpdateWithTx(kt, tx, ci); err != nil {
+				return err
+			}
+		} else {
+			ci = &table.ConfigItem{
+				ID:         one.ID,
+				Spec:       one.Spec,
+				Attachment: &table.ConfigItemAttachment{BizID: bizID, AppID: appID},
+				Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+			}
+			if err = s.dao.ConfigItem().RecoverConfigItem(kt, tx, ci); err != nil {
+				return err
+			}
+		}
+
+		if one.Content == nil {
+			continue
+		}
+		if commit, ok := latest[one.ID]; ok && commit.Spec.Content.Signature == one.Content.Signature {
+			continue
+		}
+		if err = s.createCommitWithTx(kt, tx, bizID, appID, one.ID, one.Content); err != nil {
+			return err
+		}
+	}
+
+	if err = s.dao.ConfigItem().ValidateAppCINumber(kt, tx, bizID, appID); err != nil {
+		return err
+	}
+
+	// 3. 还原模版套餐绑定关系
+	if data.TemplateBinding == nil {
+		if err = s.dao.AppTemplateBinding().DeleteByAppIDWithTx(kt, tx, bizID, appID); err != nil {
+			return err
+		}
+	} else {
+		if err = s.dao.Validator().ValidateTmplRevisionsExist(kt, data.TemplateBinding.TemplateRevisionIDs); err != nil {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"the templates bound in snapshot have been deleted, restore failed, err: %v", err))
+		}
+		atb := &table.AppTemplateBinding{
+			Spec:       data.TemplateBinding,
+			Attachment: &table.AppTemplateBindingAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		}
+		if err = s.dao.AppTemplateBinding().UpsertWithTx(kt, tx, atb); err != nil {
+			return err
+		}
+	}
+
+	// 4. 还原服务变量
+	if len(data.Variables) == 0 {
+		return s.dao.AppTemplateVariable().DeleteWithTx(kt, tx, bizID, appID)
+	}
+	return s.dao.AppTemplateVariable().UpsertWithTx(kt, tx, &table.AppTemplateVariable{
+		Spec:       &table.AppTemplateVariableSpec{Variables: data.Variables},
+		Attachment: &table.AppTemplateVariableAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	})
+}
+
+// createCommitWithTx create a new content and commit for the config item with the uploaded content.
+func (s *Service) createCommitWithTx(kt *kit.Kit, tx *gen.QueryTx, bizID, appID, ciID uint32,
+	spec *table.ContentSpec) error {
+
+	content := &table.Content{
+		Spec: spec,
+		Attachment: &table.ContentAttachment{
+			BizID:        bizID,
+			AppID:        appID,
+			ConfigItemID: ciID,
+		},
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+	}
+	contentID, err := s.dao.Content().CreateWithTx(kt, tx, content)
+	if err != nil {
+		return err
+	}
+
+	commit := &table.Commit{
+		Spec: &table.CommitSpec{
+			ContentID: contentID,
+			Content:   content.Spec,
+		},
+		Attachment: &table.CommitAttachment{
+			BizID:        bizID,
+			AppID:        appID,
+			ConfigItemID: ciID,
+		},
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+	}
+	_, err = s.dao.Commit().CreateWithTx(kt, tx, commit)
+	return err
+}
+
+// restoreSnapshotKvs restore the kvs of a kv app, the values are read from vault with the snapshot versions.
+func (s *Service) restoreSnapshotKvs(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32,
+	kvs []*table.AppSnapshotKv) error {
+
+	current, err := s.dao.Kv().ListAllByAppIDWithTx(kt, tx, appID, bizID, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange), string(table.KvStateDelete)})
+	if err != nil {
+		return err
+	}
+
+	wanted := make(map[string]bool, len(kvs))
+	for _, one := range kvs {
+		wanted[one.Spec.Key] = true
+	}
+	existing := make(map[string]*table.Kv, len(current))
+	for _, kv := range current {
+		existing[kv.Spec.Key] = kv
+		if wanted[kv.Spec.Key] || kv.KvState == table.KvStateDelete {
+			continue
+		}
+		// 未发布过的直接删除, 已发布过的标记为删除, 下次上线时生效
+		if kv.KvState == table.KvStateAdd {
+			err = s.dao.Kv().DeleteWithTx(kt, tx, kv)
+		} else {
+			kv.KvState = table.KvStateDelete
+			kv.Revision.Reviser = kt.User
+			err = s.dao.Kv().UpdateWithTx(kt, tx, kv)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	toCreate := make([]*table.Kv, 0)
+	for _, one := range kvs {
+		exist, ok := existing[one.Spec.Key]
+		if ok && exist.KvState != table.KvStateDelete && exist.Spec.Version == one.Spec.Version {
+			continue
+		}
+
+		kvType, value, err := s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+			BizID:   bizID,
+			AppID:   appID,
+			Key:     one.Spec.Key,
+			Version: int(one.Spec.Version),
+		})
+		if err != nil {
+			logs.Errorf("get kv %s version %d from vault failed, err: %v, rid: %s", one.Spec.Key,
+				one.Spec.Version, err, kt.Rid)
+			return err
+		}
+		version, err := s.vault.UpsertKv(kt, &types.UpsertKvOption{
+			BizID:  bizID,
+			AppID:  appID,
+			Key:    one.Spec.Key,
+			Value:  value,
+			KvType: kvType,
+		})
+		if err != nil {
+			logs.Errorf("upsert kv %s to vault failed, err: %v, rid: %s", one.Spec.Key, err, kt.Rid)
+			return err
+		}
+
+		spec := *one.Spec
+		spec.Version = uint32(version)
+		if ok {
+			exist.Spec = &spec
+			exist.ContentSpec = one.ContentSpec
+			if exist.KvState != table.KvStateAdd {
+				exist.KvState = table.KvStateRevise
+			}
+			exist.Revision.Reviser = kt.User
+			if err = s.dao.Kv().UpdateWithTx(kt, tx, exist); err != nil {
+				return err
+			}
+			continue
+		}
+
+		toCreate = append(toCreate, &table.Kv{
+			KvState:     table.KvStateAdd,
+			Spec:        &spec,
+			Attachment:  &table.KvAttachment{BizID: bizID, AppID: appID},
+			Revision:    &table.Revision{Creator: kt.User, Reviser: kt.User},
+			ContentSpec: one.ContentSpec,
+		})
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	return s.dao.Kv().BatchCreateWithTx(kt, tx, toCreate)
+}
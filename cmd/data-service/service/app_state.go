@@ -0,0 +1,163 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"reflect"
+
+	"google.golang.org/grpc"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// UpdateAppState transit the lifecycle state of the app.
+func (s *Service) UpdateAppState(ctx context.Context, req *pbds.UpdateAppStateReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	to := table.AppState(req.State)
+	if err = app.Spec.State.ValidateTransition(kt, to); err != nil {
+		return nil, err
+	}
+
+	if err = s.dao.App().UpdateState(kt, req.BizId, req.AppId, to); err != nil {
+		logs.Errorf("update app %d state to %s failed, err: %v, rid: %s", req.AppId, to, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// appWriteMethods 修改服务配置或上线的接口, 服务冻结或归档后拒绝调用
+var appWriteMethods = map[string]bool{
+	pbds.Data_CreateConfigItem_FullMethodName:          true,
+	pbds.Data_BatchUpsertConfigItems_FullMethodName:    true,
+	pbds.Data_UpdateConfigItem_FullMethodName:          true,
+	pbds.Data_DeleteConfigItem_FullMethodName:          true,
+	pbds.Data_UndoConfigItem_FullMethodName:            true,
+	pbds.Data_CreateContent_FullMethodName:             true,
+	pbds.Data_CreateCommit_FullMethodName:              true,
+	pbds.Data_CreateKv_FullMethodName:                  true,
+	pbds.Data_UpdateKv_FullMethodName:                  true,
+	pbds.Data_DeleteKv_FullMethodName:                  true,
+	pbds.Data_BatchUpsertKvs_FullMethodName:            true,
+	pbds.Data_TransactKvs_FullMethodName:               true,
+	pbds.Data_UndoKv_FullMethodName:                    true,
+	pbds.Data_CreateKvOverride_FullMethodName:          true,
+	pbds.Data_UpdateKvOverride_FullMethodName:          true,
+	pbds.Data_DeleteKvOverride_FullMethodName:          true,
+	pbds.Data_UpdateConfigHook_FullMethodName:          true,
+	pbds.Data_CreateAppTemplateBinding_FullMethodName:  true,
+	pbds.Data_UpdateAppTemplateBinding_FullMethodName:  true,
+	pbds.Data_DeleteAppTemplateBinding_FullMethodName:  true,
+	pbds.Data_UpdateAppTmplVariables_FullMethodName:    true,
+	pbds.Data_RestoreAppSnapshot_FullMethodName:        true,
+	pbds.Data_MergeDraft_FullMethodName:                true,
+	pbds.Data_CreateRelease_FullMethodName:             true,
+	pbds.Data_Publish_FullMethodName:                   true,
+	pbds.Data_SubmitPublishApprove_FullMethodName:      true,
+	pbds.Data_Approve_FullMethodName:                   true,
+	pbds.Data_GenerateReleaseAndPublish_FullMethodName: true,
+	pbds.Data_EmergencyPublish_FullMethodName:          true,
+	pbds.Data_BulkRelease_FullMethodName:               true,
+	pbds.Data_CreateExperiment_FullMethodName:          true,
+	pbds.Data_UpdateExperiment_FullMethodName:          true,
+	pbds.Data_DeleteExperiment_FullMethodName:          true,
+}
+
+// appScoped is the request or its attachment which belongs to an app.
+type appScoped interface {
+	GetBizId() uint32
+	GetAppId() uint32
+}
+
+// AppStateUnaryServerInterceptor reject the writes and publishes of the frozen or archived apps.
+func AppStateUnaryServerInterceptor(daoSet dao.Set) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if !appWriteMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		bizID, appID := appOfRequest(req)
+		if bizID == 0 || appID == 0 {
+			return handler(ctx, req)
+		}
+
+		kt := kit.FromGrpcContext(ctx)
+		app, err := daoSet.App().Get(kt, bizID, appID)
+		if err != nil {
+			// 服务不存在等错误交由具体接口处理
+			return handler(ctx, req)
+		}
+
+		if err = checkAppWritable(kt, app); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// checkAppWritable returns error if the app is frozen or archived, which can not be edited and published.
+// the interceptor only covers the rpc calls, so the handlers which write an app by calling other handlers
+// directly or in background should check it by themselves.
+func checkAppWritable(kt *kit.Kit, app *table.App) error {
+	if app.Spec.State.Writable() {
+		return nil
+	}
+
+	if app.Spec.State.Normalize() == table.AppStateArchived {
+		return errf.ErrAppArchived.New(kt, app.Spec.Name)
+	}
+	return errf.ErrAppFrozen.New(kt, app.Spec.Name)
+}
+
+// ensureAppWritable get the app and check whether it can be edited and published.
+func (s *Service) ensureAppWritable(kt *kit.Kit, bizID, appID uint32) error {
+	app, err := s.dao.App().Get(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("get app %d failed, err: %v, rid: %s", appID, err, kt.Rid)
+		return err
+	}
+
+	return checkAppWritable(kt, app)
+}
+
+// appOfRequest get the biz id and app id from the request or its attachment.
+func appOfRequest(req interface{}) (uint32, uint32) {
+	if r, ok := req.(appScoped); ok {
+		return r.GetBizId(), r.GetAppId()
+	}
+
+	getter := reflect.ValueOf(req).MethodByName("GetAttachment")
+	if !getter.IsValid() || getter.Type().NumIn() != 0 || getter.Type().NumOut() != 1 {
+		return 0, 0
+	}
+	if r, ok := getter.Call(nil)[0].Interface().(appScoped); ok {
+		return r.GetBizId(), r.GetAppId()
+	}
+
+	return 0, 0
+}
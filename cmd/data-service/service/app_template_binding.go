@@ -1273,7 +1273,7 @@ func (s *Service) verifyTemplateSetBoundTemplatesNumber(kt *kit.Kit, templateSet
 	// 获取app信息
 	app, err := s.dao.App().GetByID(kt, req.AppId)
 	if err != nil {
-		return errf.Errorf(errf.AppNotExists, i18n.T(kt, "app %d not found", req.AppId))
+		return errf.ErrAppNotExists.New(kt, req.AppId)
 	}
 
 	// 只有文件类型的才能绑定套餐
@@ -15,11 +15,13 @@ package service
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbapp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
 	pbaudit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/audit"
 	pbstrategy "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/strategy"
@@ -99,6 +101,8 @@ func (s *Service) ListAudits(ctx context.Context, req *pbds.ListAuditsReq) (*pbd
 				ItsmTicketStatus:  value.Strategy.ItsmTicketStatus,
 				ItsmTicketStateId: int32(value.Strategy.ItsmTicketStateID),
 				Memo:              value.Strategy.Memo,
+				ChangeTicketId:    value.Strategy.ChangeTicketID,
+				ChangeTicketUrl:   value.Strategy.ChangeTicketURL,
 			},
 			App: &pbapp.AuditApp{
 				Name:        value.App.Name,
@@ -115,3 +119,156 @@ func (s *Service) ListAudits(ctx context.Context, req *pbds.ListAuditsReq) (*pbd
 
 	return resp, nil
 }
+
+// ListAppTimeline list the change timeline of the app.
+func (s *Service) ListAppTimeline(ctx context.Context, req *pbds.ListAppTimelineReq) (
+	*pbds.ListAppTimelineResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListAppTimelineOption{
+		BizID: req.BizId,
+		AppID: req.AppId,
+		Page:  &types.BasePage{Start: req.Start, Limit: uint(req.Limit)},
+	}
+	for _, one := range req.Types {
+		opt.Types = append(opt.Types, enumor.TimelineEventType(one))
+	}
+	if req.StartTime != "" {
+		startTime, err := time.Parse(time.DateTime, req.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		opt.StartTime = startTime
+	}
+	if req.EndTime != "" {
+		endTime, err := time.Parse(time.DateTime, req.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		// database has milliseconds left, take the upper limit
+		opt.EndTime = endTime.Add(time.Second)
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	records, count, err := s.dao.AuditDao().ListAppTimeline(grpcKit, opt)
+	if err != nil {
+		logs.Errorf("list app timeline failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	rollbackFrom, err := s.detectRollbacks(grpcKit, req.BizId, req.AppId, records)
+	if err != nil {
+		logs.Errorf("detect app timeline rollbacks failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbaudit.TimelineEvent, 0, len(records))
+	for _, one := range records {
+		details = append(details, pbTimelineEvent(one, rollbackFrom[one.StrategyID]))
+	}
+
+	return &pbds.ListAppTimelineResp{Count: uint32(count), Details: details}, nil
+}
+
+// detectRollbacks returns the publishes which publish an older release than the ones published before them,
+// the key is the strategy id and the value is the latest release published before it.
+func (s *Service) detectRollbacks(kt *kit.Kit, bizID, appID uint32, records []*types.AppTimelineRecord) (
+	map[uint32]uint32, error) {
+	var maxID uint32
+	for _, one := range records {
+		if one.Audit.Action == string(enumor.Publish) && one.StrategyID > maxID {
+			maxID = one.StrategyID
+		}
+	}
+	rollbackFrom := make(map[uint32]uint32)
+	if maxID == 0 {
+		return rollbackFrom, nil
+	}
+
+	published, err := s.dao.Strategy().ListPublishedBefore(kt, bizID, appID, maxID)
+	if err != nil {
+		return nil, err
+	}
+
+	// latest[i] is the max release id of published[0:i+1]
+	latest := make([]uint32, len(published))
+	for i, one := range published {
+		latest[i] = one.Spec.ReleaseID
+		if i > 0 && latest[i-1] > latest[i] {
+			latest[i] = latest[i-1]
+		}
+	}
+
+	for _, one := range records {
+		if one.Audit.Action != string(enumor.Publish) || one.StrategyID == 0 {
+			continue
+		}
+		idx := sort.Search(len(published), func(i int) bool { return published[i].ID >= one.StrategyID })
+		if idx > 0 && latest[idx-1] > one.Strategy.ReleaseId {
+			rollbackFrom[one.StrategyID] = latest[idx-1]
+		}
+	}
+
+	return rollbackFrom, nil
+}
+
+// pbTimelineEvent convert the timeline record to pb timeline event.
+func pbTimelineEvent(r *types.AppTimelineRecord, rollbackFrom uint32) *pbaudit.TimelineEvent {
+	event := &pbaudit.TimelineEvent{
+		AuditId:     r.Audit.ID,
+		ResType:     r.Audit.ResourceType,
+		Action:      r.Audit.Action,
+		ResId:       r.Audit.ResourceID,
+		ResInstance: r.Audit.ResInstance,
+		Actor:       r.Audit.Operator,
+		OperateWay:  r.Audit.OperateWay,
+		Status:      r.Audit.Status,
+		OccurredAt:  r.Audit.CreatedAt.Format(time.RFC3339),
+	}
+
+	switch {
+	case r.Audit.Action == string(enumor.Publish):
+		event.Type = string(enumor.PublishEvent)
+		if rollbackFrom != 0 {
+			event.Type = string(enumor.RollbackEvent)
+			event.RollbackFrom = rollbackFrom
+		}
+		event.ReleaseId = r.Strategy.ReleaseId
+		event.Memo = r.Strategy.Memo
+		event.ChangeTicketId = r.Strategy.ChangeTicketID
+		event.ChangeTicketUrl = r.Strategy.ChangeTicketURL
+		event.Approval = pbTimelineApproval(&r.Strategy)
+	case r.Audit.ResourceType == string(enumor.Release):
+		event.Type = string(enumor.ReleaseEvent)
+	case r.Audit.ResourceType == string(enumor.Group):
+		event.Type = string(enumor.StrategyEvent)
+	default:
+		event.Type = string(enumor.EditEvent)
+	}
+
+	return event
+}
+
+// pbTimelineApproval returns the approval of the publish strategy, nil if the publish needs no approval.
+func pbTimelineApproval(s *types.StrategyPart) *pbaudit.TimelineApproval {
+	if s.ApproveType == "" && s.Approver == "" {
+		return nil
+	}
+
+	approval := &pbaudit.TimelineApproval{
+		ApproveType:  s.ApproveType,
+		Approvers:    s.Approver,
+		RejectReason: s.RejectReason,
+		TicketUrl:    s.ItsmTicketUrl,
+	}
+	// 审批通过或驳回后，最后的修改人即审批人
+	switch table.PublishStatus(s.PublishStatus) {
+	case table.PendingPublish, table.AlreadyPublish, table.RejectedApproval:
+		approval.ApprovedBy = s.Reviser
+		approval.ApprovedAt = s.FinalApprovalTime.Format(time.RFC3339)
+	}
+
+	return approval
+}
@@ -0,0 +1,124 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbu "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/biz-usage"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// maxBizUsageMonths is the max months of the biz usages listed at once.
+const maxBizUsageMonths = 24
+
+// ListBizUsages list the monthly usages of the bizs.
+func (s *Service) ListBizUsages(ctx context.Context, req *pbds.ListBizUsagesReq) (*pbds.ListBizUsagesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	endMonth := req.EndMonth
+	if endMonth == "" {
+		endMonth = req.StartMonth
+	}
+	start, err := time.Parse(table.BizUsageMonthLayout, req.StartMonth)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid month %s, should be like 2006-01",
+			req.StartMonth))
+	}
+	end, err := time.Parse(table.BizUsageMonthLayout, endMonth)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid month %s, should be like 2006-01",
+			endMonth))
+	}
+	if end.Before(start) || end.After(start.AddDate(0, maxBizUsageMonths-1, 0)) {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"end month should not be before start month, and at most %d months are allowed", maxBizUsageMonths))
+	}
+
+	usages, err := s.dao.BizUsage().List(kt, req.BizIds, req.StartMonth, endMonth)
+	if err != nil {
+		logs.Errorf("list biz usages failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListBizUsagesResp{Details: pbbu.PbBizUsages(usages)}, nil
+}
+
+// AggregateBizUsage aggregate the usages of current month for all the bizs from the app consumption stats
+// and the stored contents, the previous month is aggregated again on the first day of a month to include
+// the stats of its last day, but its storage snapshot is kept.
+func (s *Service) AggregateBizUsage(kt *kit.Kit) error {
+	now := time.Now().UTC()
+	if now.Day() == 1 {
+		prev := now.AddDate(0, 0, -1).Format(table.BizUsageMonthLayout)
+		if err := s.aggregateMonthBizUsage(kt, prev, false); err != nil {
+			logs.Errorf("aggregate biz usage of month %s failed, err: %v, rid: %s", prev, err, kt.Rid)
+		}
+	}
+
+	return s.aggregateMonthBizUsage(kt, now.Format(table.BizUsageMonthLayout), true)
+}
+
+func (s *Service) aggregateMonthBizUsage(kt *kit.Kit, month string, withStorage bool) error {
+	since, until, err := table.MonthDateRange(month)
+	if err != nil {
+		return err
+	}
+
+	sums, err := s.dao.AppConsumptionStat().SumByBiz(kt, since, until)
+	if err != nil {
+		return err
+	}
+
+	usages := make(map[uint32]*table.BizUsage)
+	usageOf := func(bizID uint32) *table.BizUsage {
+		one, ok := usages[bizID]
+		if !ok {
+			one = &table.BizUsage{
+				Spec:       &table.BizUsageSpec{Month: month},
+				Attachment: &table.BizUsageAttachment{BizID: bizID},
+			}
+			usages[bizID] = one
+		}
+		return one
+	}
+	for _, one := range sums {
+		usage := usageOf(one.BizID)
+		usage.Spec.APICalls = one.PullCount
+		usage.Spec.EgressBytes = one.DownloadBytes
+		usage.Spec.WatchSeconds = one.WatchSeconds
+	}
+
+	if withStorage {
+		storages, e := s.dao.Content().SumBizStorage(kt)
+		if e != nil {
+			return e
+		}
+		for _, one := range storages {
+			usageOf(one.BizID).Spec.StorageBytes = one.StorageBytes
+		}
+	}
+
+	list := make([]*table.BizUsage, 0, len(usages))
+	for _, one := range usages {
+		list = append(list, one)
+	}
+
+	return s.dao.BizUsage().BatchUpsert(kt, list, withStorage)
+}
@@ -0,0 +1,219 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbrelease "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// BulkRelease commit the desired config items or kvs to the editing area and create a release,
+// optionally publish it. 生成版本或上线失败时, 编辑区会恢复到调用前的状态, 不会留下半完成的修改
+func (s *Service) BulkRelease(ctx context.Context, req *pbds.BulkReleaseReq) (*pbds.BulkReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 提交配置和生成版本直接调用了其他接口, 不经过拦截器, 需要先检查服务状态
+	if err = checkAppWritable(kt, app); err != nil {
+		return nil, err
+	}
+
+	if err = s.validateBulkRelease(kt, app, req); err != nil {
+		return nil, err
+	}
+
+	// 记录编辑区当前状态, 用于失败时恢复
+	backup, err := s.collectAppSnapshotData(kt, app)
+	if err != nil {
+		logs.Errorf("collect app editing data failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 1. 提交配置, 本身在一个事务中, 失败时无需恢复
+	ids, err := s.bulkUpsertDraft(ctx, kt, app, req)
+	if err != nil {
+		logs.Errorf("bulk release upsert draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 2. 生成版本, 可选直接上线
+	resp := &pbds.BulkReleaseResp{Ids: ids}
+	if err = s.bulkCreateRelease(ctx, kt, app, req, resp); err != nil {
+		logs.Errorf("bulk release create release failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := s.restoreAppSnapshotData(kt, app, backup); rErr != nil {
+			logs.Errorf("restore app %d editing area after bulk release failed, err: %v, rid: %s", app.ID, rErr,
+				kt.Rid)
+		}
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// validateBulkRelease 提前做轻量的校验, 避免提交配置后才失败
+func (s *Service) validateBulkRelease(kt *kit.Kit, app *table.App, req *pbds.BulkReleaseReq) error {
+	if req.ReleaseName == "" {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "release name is required"))
+	}
+
+	if app.Spec.ConfigType == table.KV && (len(req.Items) > 0 || len(req.Bindings) > 0 || len(req.Variables) > 0) {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv type app only supports kvs"))
+	}
+	if app.Spec.ConfigType == table.File && len(req.Kvs) > 0 {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "file type app does not support kvs"))
+	}
+
+	if _, err := s.dao.Release().GetByName(kt, req.BizId, req.AppId, req.ReleaseName); err == nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "release name %s already exists", req.ReleaseName))
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get release by name failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	if err := app.Spec.ReleaseNotesPolicy.ValidateNotes(kt, req.ReleaseNotes.ReleaseNotes()); err != nil {
+		return err
+	}
+
+	if !req.Publish {
+		return nil
+	}
+
+	strategy, err := s.dao.Strategy().GetLast(kt, req.BizId, req.AppId, 0, 0)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil && (strategy.Spec.PublishStatus == table.PendingApproval ||
+		strategy.Spec.PublishStatus == table.PendingPublish) {
+		return errors.New(i18n.T(kt, "there is a release in publishing currently"))
+	}
+
+	if _, err = s.linkChangeTicket(kt, req.BizId, req.ChangeTicketId); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bulkUpsertDraft 将配置写入编辑区, 没有配置且不替换全部时直接使用当前编辑区生成版本
+func (s *Service) bulkUpsertDraft(ctx context.Context, kt *kit.Kit, app *table.App, req *pbds.BulkReleaseReq) (
+	[]uint32, error) {
+
+	if app.Spec.ConfigType == table.KV {
+		if len(req.Kvs) == 0 && !req.ReplaceAll {
+			return []uint32{}, nil
+		}
+		return s.upsertAndDeleteKvs(kt, &pbds.BatchUpsertKvsReq{
+			BizId:      req.BizId,
+			AppId:      req.AppId,
+			Kvs:        req.Kvs,
+			ReplaceAll: req.ReplaceAll,
+		}, nil)
+	}
+
+	if len(req.Items) == 0 && len(req.Bindings) == 0 && len(req.Variables) == 0 && !req.ReplaceAll {
+		return []uint32{}, nil
+	}
+	resp, err := s.BatchUpsertConfigItems(ctx, &pbds.BatchUpsertConfigItemsReq{
+		BizId:      req.BizId,
+		AppId:      req.AppId,
+		Items:      req.Items,
+		ReplaceAll: req.ReplaceAll,
+		Variables:  req.Variables,
+		Bindings:   req.Bindings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Ids, nil
+}
+
+// bulkCreateRelease 生成版本, publish 为 true 时在同一个事务中上线
+func (s *Service) bulkCreateRelease(ctx context.Context, kt *kit.Kit, app *table.App, req *pbds.BulkReleaseReq,
+	resp *pbds.BulkReleaseResp) error {
+
+	if !req.Publish {
+		created, err := s.CreateRelease(ctx, &pbds.CreateReleaseReq{
+			Attachment: &pbrelease.ReleaseAttachment{BizId: req.BizId, AppId: req.AppId},
+			Spec: &pbrelease.ReleaseSpec{
+				Name:  req.ReleaseName,
+				Memo:  req.ReleaseMemo,
+				Notes: req.ReleaseNotes,
+			},
+			Variables: req.Variables,
+		})
+		if err != nil {
+			return err
+		}
+		resp.ReleaseId = created.Id
+		return nil
+	}
+
+	// 上线前检查非模板配置和模板配置是否存在冲突, 生成版本时 CreateRelease 已包含该检查
+	if app.Spec.ConfigType == table.File {
+		conflictNums, _, err := s.checkNonTmpAndTmpConflicts(kt, req.BizId, req.AppId, []string{})
+		if err != nil {
+			return err
+		}
+		if conflictNums > 0 {
+			return errors.New(i18n.T(kt, "create release failed there is a file conflict"))
+		}
+	}
+
+	published, err := s.GenerateReleaseAndPublish(ctx, &pbds.GenerateReleaseAndPublishReq{
+		BizId:           req.BizId,
+		AppId:           req.AppId,
+		ReleaseName:     req.ReleaseName,
+		ReleaseMemo:     req.ReleaseMemo,
+		Variables:       req.Variables,
+		All:             req.All,
+		GrayPublishMode: req.GrayPublishMode,
+		Groups:          req.Groups,
+		Labels:          req.Labels,
+		GroupName:       req.GroupName,
+		ReleaseNotes:    req.ReleaseNotes,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
+	})
+	if err != nil {
+		return err
+	}
+	resp.PublishedStrategyHistoryId = published.PublishedStrategyHistoryId
+	resp.ComplianceWarnings = published.ComplianceWarnings
+	resp.Overlaps = published.Overlaps
+
+	// 版本已经提交, 查询失败不影响结果
+	release, err := s.dao.Release().GetByName(kt, req.BizId, req.AppId, req.ReleaseName)
+	if err != nil {
+		logs.Errorf("get release %s after publish failed, err: %v, rid: %s", req.ReleaseName, err, kt.Rid)
+		return nil
+	}
+	resp.ReleaseId = release.ID
+
+	return nil
+}
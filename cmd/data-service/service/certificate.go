@@ -0,0 +1,494 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcert "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/certificate"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	certAppBatchSize = 100
+	// certWarnInterval is the interval of emitting the expiry warning event of the same certificate.
+	certWarnInterval = 24 * time.Hour
+	// maxCertContentSize is the max size of the config item content to be parsed as certificate.
+	maxCertContentSize      = 1024 * 1024
+	defaultCertExpiringDays = 30
+)
+
+// ListExpiringCertificates list the certificates which expire within the days, including the expired ones.
+func (s *Service) ListExpiringCertificates(ctx context.Context, req *pbds.ListExpiringCertificatesReq) (
+	*pbds.ListExpiringCertificatesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	days := req.Days
+	if days == 0 {
+		days = defaultCertExpiringDays
+	}
+	certs, count, err := s.dao.Certificate().ListExpiring(kt, &types.ListExpiringCertsOption{
+		BizID:  req.BizId,
+		AppIDs: req.AppIds,
+		Before: time.Now().UTC().AddDate(0, 0, int(days)),
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	})
+	if err != nil {
+		logs.Errorf("list expiring certificates failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListExpiringCertificatesResp{
+		Count:   uint32(count),
+		Details: pbcert.PbCertificates(certs),
+	}, nil
+}
+
+// RenewCertificate renew the certificate by the internal CA, the renewed one is saved as unreleased config.
+func (s *Service) RenewCertificate(ctx context.Context, req *pbds.RenewCertificateReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if !cc.DataService().Certificate.Renew.Enabled {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "certificate renew is not enabled"))
+	}
+
+	cert, err := s.dao.Certificate().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get certificate failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if cert.Attachment.AppID != req.AppId {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "certificate %d is not in app %d", req.Id, req.AppId))
+	}
+
+	renewErr := s.renewCertificate(kt, cert)
+	if err = s.dao.Certificate().UpdateState(kt, cert); err != nil {
+		logs.Errorf("update certificate state failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if renewErr != nil {
+		return nil, errf.Errorf(errf.Aborted, i18n.T(kt, "renew certificate failed, err: %v", renewErr))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ScanCertificates traverse all the apps to find the certificates in the unreleased config items and kvs,
+// emit the warning events of the expiring ones and renew them if enabled, returns the count of warnings.
+func (s *Service) ScanCertificates(kt *kit.Kit) (int, error) {
+	var lastID uint32
+	warned := 0
+	for {
+		apps, err := s.dao.App().ListByCursor(kt, lastID, certAppBatchSize)
+		if err != nil {
+			return warned, err
+		}
+
+		for _, app := range apps {
+			certs, e := s.scanAppCertificates(kt, app)
+			if e != nil {
+				// 单个服务失败不影响其他服务的扫描
+				logs.Errorf("scan app %d certificates failed, err: %v, rid: %s", app.ID, e, kt.Rid)
+				continue
+			}
+			for _, cert := range certs {
+				if s.handleExpiringCertificate(kt, cert) {
+					warned++
+				}
+			}
+		}
+
+		if len(apps) < certAppBatchSize {
+			return warned, nil
+		}
+		lastID = apps[len(apps)-1].ID
+	}
+}
+
+// scanAppCertificates sync the certificates of one app with its config items and kvs, only the changed
+// ones are re-parsed, returns all the certificates of the app.
+func (s *Service) scanAppCertificates(kt *kit.Kit, app *table.App) ([]*table.Certificate, error) {
+	bizID, appID := app.BizID, app.ID
+	existing, err := s.dao.Certificate().ListByApp(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*table.Certificate
+	switch app.Spec.ConfigType {
+	case table.File:
+		candidates, err = s.buildConfigItemCertCandidates(kt, bizID, appID)
+	case table.KV:
+		candidates, err = s.buildKvCertCandidates(kt, bizID, appID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	type certKey struct {
+		resType table.CertResType
+		resID   uint32
+	}
+	existMap := make(map[certKey]*table.Certificate, len(existing))
+	for _, one := range existing {
+		existMap[certKey{resType: one.Spec.ResType, resID: one.Spec.ResID}] = one
+	}
+
+	now := time.Now().UTC()
+	result := make([]*table.Certificate, 0, len(candidates))
+	toUpsert := make([]*table.Certificate, 0)
+	for _, cert := range candidates {
+		key := certKey{resType: cert.Spec.ResType, resID: cert.Spec.ResID}
+		exist, ok := existMap[key]
+		delete(existMap, key)
+		if ok && exist.Spec.Signature == cert.Spec.Signature && exist.Spec.Name == cert.Spec.Name {
+			result = append(result, exist)
+			continue
+		}
+
+		content, e := s.loadCertContent(kt, cert)
+		if e != nil {
+			logs.Errorf("load %s %d content for certificate failed, err: %v, rid: %s", cert.Spec.ResType,
+				cert.Spec.ResID, e, kt.Rid)
+			continue
+		}
+		info, e := table.ParsePemCertificate(content)
+		if e != nil {
+			// 不是证书，或已不再是证书
+			if ok {
+				existMap[key] = exist
+			}
+			continue
+		}
+
+		cert.Spec.Subject = info.Subject
+		cert.Spec.DNSNames = strings.Join(info.DNSNames, ",")
+		cert.Spec.NotBefore = info.NotBefore
+		cert.Spec.NotAfter = info.NotAfter
+		// 内容变更后重新告警，保留续期状态以便查看新证书是否待发布
+		cert.State = &table.CertificateState{ScannedAt: now}
+		if ok {
+			cert.ID = exist.ID
+			cert.State.RenewStatus = exist.State.RenewStatus
+			cert.State.RenewMessage = exist.State.RenewMessage
+		}
+		toUpsert = append(toUpsert, cert)
+		result = append(result, cert)
+	}
+
+	toDelete := make([]uint32, 0, len(existMap))
+	for _, one := range existMap {
+		toDelete = append(toDelete, one.ID)
+	}
+
+	if err = s.dao.Certificate().BatchUpsert(kt, toUpsert); err != nil {
+		return nil, err
+	}
+	if err = s.dao.Certificate().BatchDelete(kt, toDelete); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// buildConfigItemCertCandidates build the certificate candidates of the file app's config items
+// whose file name looks like a certificate, the content is loaded only when it's changed.
+func (s *Service) buildConfigItemCertCandidates(kt *kit.Kit, bizID, appID uint32) ([]*table.Certificate, error) {
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+	contentMap := make(map[uint32]*table.ContentSpec, len(commits))
+	for _, one := range commits {
+		contentMap[one.Attachment.ConfigItemID] = one.Spec.Content
+	}
+
+	certs := make([]*table.Certificate, 0)
+	for _, ci := range cis {
+		content, ok := contentMap[ci.ID]
+		if !ok || content == nil || content.ByteSize > maxCertContentSize || !table.IsCertFileName(ci.Spec.Name) {
+			continue
+		}
+		certs = append(certs, &table.Certificate{
+			Spec: &table.CertificateSpec{
+				ResType:   table.CertConfigItem,
+				ResID:     ci.ID,
+				Name:      path.Join(ci.Spec.Path, ci.Spec.Name),
+				Signature: content.Signature,
+			},
+			Attachment: &table.CertificateAttachment{BizID: bizID, AppID: appID},
+		})
+	}
+
+	return certs, nil
+}
+
+// buildKvCertCandidates build the certificate candidates of the kv app's certificate secrets and
+// certificate bundles, the value is loaded only when it's changed.
+func (s *Service) buildKvCertCandidates(kt *kit.Kit, bizID, appID uint32) ([]*table.Certificate, error) {
+	kvs, err := s.dao.Kv().ListAllByAppID(kt, appID, bizID, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange)})
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*table.Certificate, 0)
+	for _, kv := range kvs {
+		if kv.ContentSpec == nil ||
+			(kv.Spec.KvType != table.KvCertBundle && kv.Spec.SecretType != table.SecretTypeCertificate) {
+			continue
+		}
+		certs = append(certs, &table.Certificate{
+			Spec: &table.CertificateSpec{
+				ResType:   table.CertKv,
+				ResID:     kv.ID,
+				Name:      kv.Spec.Key,
+				Signature: kv.ContentSpec.Signature,
+			},
+			Attachment: &table.CertificateAttachment{BizID: bizID, AppID: appID},
+		})
+	}
+
+	return certs, nil
+}
+
+// loadCertContent load the current content of the config item or the value of the kv.
+func (s *Service) loadCertContent(kt *kit.Kit, cert *table.Certificate) ([]byte, error) {
+	switch cert.Spec.ResType {
+	case table.CertConfigItem:
+		repoKt := kt.GetKitForRepoCfg()
+		repoKt.BizID = cert.Attachment.BizID
+		body, _, err := s.repo.Download(repoKt, cert.Spec.Signature)
+		if err != nil {
+			return nil, err
+		}
+		defer body.Close()
+		return io.ReadAll(io.LimitReader(body, maxCertContentSize))
+	case table.CertKv:
+		kv, err := s.dao.Kv().GetByID(kt, cert.Attachment.BizID, cert.Attachment.AppID, cert.Spec.ResID)
+		if err != nil {
+			return nil, err
+		}
+		_, value, err := s.getKv(kt, cert.Attachment.BizID, cert.Attachment.AppID, kv.Spec.Version, kv.Spec.Key)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(value), nil
+	}
+
+	return nil, fmt.Errorf("unsupported certificate resource type: %s", cert.Spec.ResType)
+}
+
+// handleExpiringCertificate emit the warning event of the expiring certificate at most once a day,
+// and renew it if it's enabled and the certificate expires within the renew days, returns whether warned.
+func (s *Service) handleExpiringCertificate(kt *kit.Kit, cert *table.Certificate) bool {
+	opt := cc.DataService().Certificate
+	now := time.Now().UTC()
+	if !cert.Spec.IsExpiring(now, opt.WarnDays) {
+		return false
+	}
+	if cert.State.WarnedAt != nil && now.Sub(*cert.State.WarnedAt) < certWarnInterval {
+		return false
+	}
+
+	s.warnCertificate(kt, cert, now)
+	cert.State.WarnedAt = &now
+
+	if opt.Renew.Enabled && cert.Spec.IsExpiring(now, opt.Renew.BeforeDays) {
+		if err := s.renewCertificate(kt, cert); err != nil {
+			logs.Errorf("renew certificate %d failed, err: %v, rid: %s", cert.ID, err, kt.Rid)
+		}
+	}
+
+	if err := s.dao.Certificate().UpdateState(kt, cert); err != nil {
+		logs.Errorf("update certificate %d state failed, err: %v, rid: %s", cert.ID, err, kt.Rid)
+	}
+
+	return true
+}
+
+// warnCertificate emit the expiry warning event of the certificate, which is recorded as audit.
+func (s *Service) warnCertificate(kt *kit.Kit, cert *table.Certificate, now time.Time) {
+	daysLeft := int(cert.Spec.NotAfter.Sub(now).Hours() / 24)
+	logs.Warnf("certificate %s of biz %d app %d expires at %s, %d days left, rid: %s", cert.Spec.Name,
+		cert.Attachment.BizID, cert.Attachment.AppID, cert.Spec.NotAfter.Format(time.RFC3339), daysLeft, kt.Rid)
+
+	detail, _ := json.Marshal(map[string]interface{}{
+		"res_type":  cert.Spec.ResType,
+		"subject":   cert.Spec.Subject,
+		"not_after": cert.Spec.NotAfter.Format(time.RFC3339),
+		"days_left": daysLeft,
+	})
+	audit := &table.Audit{
+		BizID:        cert.Attachment.BizID,
+		AppID:        cert.Attachment.AppID,
+		ResourceType: enumor.Config,
+		ResourceID:   cert.Spec.ResID,
+		Action:       enumor.Warn,
+		Rid:          kt.Rid,
+		Operator:     kt.User,
+		CreatedAt:    now,
+		Detail:       string(detail),
+		ResInstance:  fmt.Sprintf("certificate: %s", cert.Spec.Name),
+		OperateWay:   string(enumor.API),
+		Status:       enumor.Success,
+	}
+	if err := s.dao.AuditDao().One(kt, audit, &dao.AuditOption{}); err != nil {
+		logs.Errorf("emit certificate %d warning event failed, err: %v, rid: %s", cert.ID, err, kt.Rid)
+	}
+}
+
+// renewCertificate renew the certificate by the internal CA and save the renewed one as unreleased config,
+// which should be released by the user. the renewal result is set to the certificate state.
+func (s *Service) renewCertificate(kt *kit.Kit, cert *table.Certificate) error {
+	err := s.doRenewCertificate(kt, cert)
+	if err != nil {
+		cert.State.RenewStatus = table.CertRenewFailed
+		cert.State.RenewMessage = err.Error()
+		return err
+	}
+
+	cert.State.RenewStatus = table.CertRenewSucceed
+	cert.State.RenewMessage = fmt.Sprintf("renewed at %s, waiting for release", time.Now().UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (s *Service) doRenewCertificate(kt *kit.Kit, cert *table.Certificate) error {
+	content, err := s.loadCertContent(kt, cert)
+	if err != nil {
+		return err
+	}
+
+	renewed, err := renewPemCertificate(kt, cc.DataService().Certificate.Renew, cert, content)
+	if err != nil {
+		return err
+	}
+
+	switch cert.Spec.ResType {
+	case table.CertConfigItem:
+		return s.saveRenewedConfigItem(kt, cert, renewed)
+	case table.CertKv:
+		return s.saveRenewedKv(kt, cert, renewed)
+	}
+
+	return fmt.Errorf("unsupported certificate resource type: %s", cert.Spec.ResType)
+}
+
+// saveRenewedConfigItem upload the renewed certificate and commit it to the config item.
+func (s *Service) saveRenewedConfigItem(kt *kit.Kit, cert *table.Certificate, content string) error {
+	bizID, appID := cert.Attachment.BizID, cert.Attachment.AppID
+	ci, err := s.dao.ConfigItem().Get(kt, cert.Spec.ResID, bizID)
+	if err != nil {
+		return err
+	}
+
+	sign := tools.SHA256(content)
+	repoKt := kt.GetKitForRepoCfg()
+	repoKt.BizID = bizID
+	if _, err = s.repo.Upload(repoKt, sign, bytes.NewReader([]byte(content))); err != nil {
+		return err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	ci.Revision.Reviser = kt.User
+	if err = s.dao.ConfigItem().UpdateWithTx(kt, tx, ci); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err = s.createCommitWithTx(kt, tx, bizID, appID, ci.ID, &table.ContentSpec{
+		Signature: sign,
+		Md5:       tools.MD5(content),
+		ByteSize:  uint64(len(content)),
+	}); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// saveRenewedKv save the renewed certificate as the new value of the kv.
+func (s *Service) saveRenewedKv(kt *kit.Kit, cert *table.Certificate, content string) error {
+	bizID, appID := cert.Attachment.BizID, cert.Attachment.AppID
+	kv, err := s.dao.Kv().GetByID(kt, bizID, appID, cert.Spec.ResID)
+	if err != nil {
+		return err
+	}
+	if kv.KvState == table.KvStateDelete {
+		return errors.New("the kv of the certificate is deleted")
+	}
+
+	version, err := s.vault.UpsertKv(kt, &types.UpsertKvOption{
+		BizID:  bizID,
+		AppID:  appID,
+		Key:    kv.Spec.Key,
+		Value:  content,
+		KvType: kv.Spec.KvType,
+	})
+	if err != nil {
+		return err
+	}
+
+	if kv.KvState == table.KvStateUnchange {
+		kv.KvState = table.KvStateRevise
+	}
+	info, err := table.ParsePemCertificate([]byte(content))
+	if err != nil {
+		return err
+	}
+	kv.Spec.Version = uint32(version)
+	kv.Spec.CertificateExpirationDate = &info.NotAfter
+	kv.ContentSpec = &table.ContentSpec{
+		Signature: tools.SHA256(content),
+		Md5:       tools.MD5(content),
+		ByteSize:  uint64(len(content)),
+	}
+	kv.Revision = &table.Revision{
+		Reviser:   kt.User,
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	return s.dao.Kv().Update(kt, kv)
+}
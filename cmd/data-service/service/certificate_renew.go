@@ -0,0 +1,147 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// certRenewReq is the request to the internal CA to sign the certificate signing request.
+type certRenewReq struct {
+	BizID uint32 `json:"biz_id"`
+	AppID uint32 `json:"app_id"`
+	Name  string `json:"name"`
+	// CSR is the PEM encoded certificate signing request, which is signed by the existing private key.
+	CSR string `json:"csr"`
+}
+
+// certRenewResp is the response of the internal CA.
+type certRenewResp struct {
+	// Certificate is the PEM encoded certificate chain, the leaf certificate first.
+	Certificate string `json:"certificate"`
+}
+
+// renewPemCertificate request the internal CA to issue a new certificate for the existing private key,
+// returns the new certificate chain with the private key. the certificate without private key can not
+// be renewed, because the new certificate would not match the key which is deployed with it.
+func renewPemCertificate(kt *kit.Kit, opt cc.CertRenew, cert *table.Certificate, content []byte) (string, error) {
+	pair, err := tls.X509KeyPair(content, content)
+	if err != nil {
+		return "", fmt.Errorf("the certificate has no matched private key, it can not be renewed, err: %v", err)
+	}
+	signer, ok := pair.PrivateKey.(crypto.Signer)
+	if !ok {
+		return "", errors.New("unsupported private key type")
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return "", err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:     leaf.Subject,
+		DNSNames:    leaf.DNSNames,
+		IPAddresses: leaf.IPAddresses,
+	}, signer)
+	if err != nil {
+		return "", fmt.Errorf("create certificate signing request failed, err: %v", err)
+	}
+
+	body, _ := json.Marshal(&certRenewReq{
+		BizID: cert.Attachment.BizID,
+		AppID: cert.Attachment.AppID,
+		Name:  cert.Spec.Name,
+		CSR:   string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	})
+	newCert, err := requestCertRenew(kt, opt, body)
+	if err != nil {
+		return "", err
+	}
+
+	// 保留原有私钥
+	keyPem := extractPemBlocks(content, func(t string) bool { return strings.HasSuffix(t, "PRIVATE KEY") })
+	if _, err = tls.X509KeyPair([]byte(newCert), keyPem); err != nil {
+		return "", fmt.Errorf("the certificate issued by CA does not match the private key, err: %v", err)
+	}
+
+	return strings.TrimSpace(newCert) + "\n" + string(keyPem), nil
+}
+
+// requestCertRenew post the renew request to the internal CA, returns the issued certificate chain.
+func requestCertRenew(kt *kit.Kit, opt cc.CertRenew, body []byte) (string, error) {
+	req, err := http.NewRequestWithContext(kt.Ctx, http.MethodPost, opt.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Request-Id", kt.Rid)
+	if opt.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+opt.Token)
+	}
+
+	client := &http.Client{Timeout: time.Duration(opt.TimeoutSec) * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("request CA failed, err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxCertContentSize))
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("request CA failed, status: %d, body: %s", resp.StatusCode, string(data))
+	}
+
+	result := new(certRenewResp)
+	if err = json.Unmarshal(data, result); err != nil {
+		return "", fmt.Errorf("decode CA response failed, err: %v", err)
+	}
+	if _, err = table.ParsePemCertificate([]byte(result.Certificate)); err != nil {
+		return "", fmt.Errorf("invalid certificate issued by CA, err: %v", err)
+	}
+
+	return result.Certificate, nil
+}
+
+// extractPemBlocks returns the PEM encoded blocks whose type matched.
+func extractPemBlocks(data []byte, match func(blockType string) bool) []byte {
+	buf := new(bytes.Buffer)
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if match(block.Type) {
+			_ = pem.Encode(buf, block)
+		}
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,42 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/internal/components/changeticket"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// linkChangeTicket validates the change ticket referenced by the publish and returns the linkage, the ticket
+// is required if the change ticket linkage is enabled, otherwise it's recorded as is if referenced.
+func (s *Service) linkChangeTicket(kt *kit.Kit, bizID uint32, ticketID string) (*changeticket.Ticket, error) {
+	if !cc.DataService().ChangeTicket.Enabled {
+		return &changeticket.Ticket{ID: ticketID}, nil
+	}
+
+	if ticketID == "" {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the publish must reference a change ticket"))
+	}
+
+	ticket, err := s.changeTicket.Validate(kt.Ctx, bizID, ticketID)
+	if err != nil {
+		logs.Errorf("validate change ticket %s failed, err: %v, rid: %s", ticketID, err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "change ticket %s is invalid, %v", ticketID, err))
+	}
+
+	return ticket, nil
+}
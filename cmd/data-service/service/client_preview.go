@@ -0,0 +1,267 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-preview"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// PreviewClientRelease preview the release and the content which the client with the uid and labels would
+// currently receive, the released groups are matched in the same way as feed server does.
+func (s *Service) PreviewClientRelease(ctx context.Context, req *pbds.PreviewClientReleaseReq) (
+	*pbcp.ClientReleasePreview, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if req.BizId == 0 || req.AppId == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "biz id and app id are required"))
+	}
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app %d failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	preview := &pbcp.ClientReleasePreview{ConfigType: string(app.Spec.ConfigType)}
+	// 归档的服务不再向客户端提供配置
+	if app.Spec.State == table.AppStateArchived {
+		preview.Reason = i18n.T(kt, "app %s is archived, clients can not pull any release", app.Spec.Name)
+		return preview, nil
+	}
+
+	groups, err := s.dao.ReleasedGroup().ListAllByAppID(kt, req.AppId, req.BizId)
+	if err != nil {
+		logs.Errorf("list app %d released groups failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	var matched *types.ReleasedGroupCache
+	groupNames := make(map[uint32]string)
+	if req.Explain {
+		var traces []*types.GroupMatchTrace
+		matched, traces, err = types.ExplainReleasedGroupMatch(types.ReleasedGroupCaches(groups), req.Uid,
+			req.Labels)
+		if err != nil && !errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
+			return nil, err
+		}
+		// 未匹配到任何分组时同样返回匹配过程
+		var e error
+		if preview.Traces, e = s.pbClientMatchTraces(kt, req.BizId, traces, groupNames); e != nil {
+			return nil, e
+		}
+	} else {
+		matched, err = types.MatchReleasedGroup(types.ReleasedGroupCaches(groups), req.Uid, req.Labels)
+	}
+	if err != nil {
+		if errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
+			preview.Reason = i18n.T(kt, "the client does not match any published group of the app")
+			return preview, nil
+		}
+		return nil, err
+	}
+
+	release, err := s.dao.Release().Get(kt, req.BizId, req.AppId, matched.ReleaseID)
+	if err != nil {
+		logs.Errorf("get release %d failed, err: %v, rid: %s", matched.ReleaseID, err, kt.Rid)
+		return nil, err
+	}
+
+	preview.Matched = true
+	preview.ReleaseId = release.ID
+	preview.ReleaseName = release.Spec.Name
+	preview.GroupId = matched.GroupID
+	preview.GroupMode = string(matched.Mode)
+	preview.StrategyId = matched.StrategyID
+	if preview.GroupName, err = s.groupName(kt, req.BizId, matched.GroupID, groupNames); err != nil {
+		return nil, err
+	}
+
+	switch app.Spec.ConfigType {
+	case table.File:
+		preview.ConfigItems, err = s.previewClientConfigItems(kt, req.BizId, release.ID)
+	case table.KV:
+		preview.Kvs, err = s.previewClientKvs(kt, req, release.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// pbClientMatchTraces convert the group match traces to pb, the group names are cached in names.
+func (s *Service) pbClientMatchTraces(kt *kit.Kit, bizID uint32, traces []*types.GroupMatchTrace,
+	names map[uint32]string) ([]*pbcp.ClientMatchTrace, error) {
+
+	result := make([]*pbcp.ClientMatchTrace, 0, len(traces))
+	for _, one := range traces {
+		name, err := s.groupName(kt, bizID, one.GroupID, names)
+		if err != nil {
+			return nil, err
+		}
+
+		st, err := one.Selector.MarshalPB()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, &pbcp.ClientMatchTrace{
+			GroupId:    one.GroupID,
+			GroupName:  name,
+			GroupMode:  string(one.Mode),
+			ReleaseId:  one.ReleaseID,
+			StrategyId: one.StrategyID,
+			UpdatedAt:  one.UpdatedAt.Format(time.RFC3339),
+			Matched:    one.Matched,
+			Selected:   one.Selected,
+			Reason:     one.Reason,
+			Selector:   st,
+		})
+	}
+
+	return result, nil
+}
+
+// groupName returns the name of the group, the default group's id is 0.
+func (s *Service) groupName(kt *kit.Kit, bizID, groupID uint32, names map[uint32]string) (string, error) {
+	if groupID == 0 {
+		return "默认分组", nil
+	}
+
+	if name, ok := names[groupID]; ok {
+		return name, nil
+	}
+
+	group, err := s.dao.Group().Get(kt, groupID, bizID)
+	if err != nil {
+		logs.Errorf("get group %d failed, err: %v, rid: %s", groupID, err, kt.Rid)
+		return "", err
+	}
+	names[groupID] = group.Spec.Name
+
+	return group.Spec.Name, nil
+}
+
+// previewClientConfigItems list the released config items of the release, the content is rendered when the
+// release is generated, so the signature is the same as the file pulled by client.
+func (s *Service) previewClientConfigItems(kt *kit.Kit, bizID, releaseID uint32) (
+	[]*pbcp.ClientPreviewConfigItem, error) {
+
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(kt, []uint32{releaseID}, bizID)
+	if err != nil {
+		logs.Errorf("list release %d config items failed, err: %v, rid: %s", releaseID, err, kt.Rid)
+		return nil, err
+	}
+
+	items := make([]*pbcp.ClientPreviewConfigItem, 0, len(rcis))
+	for _, one := range rcis {
+		item := &pbcp.ClientPreviewConfigItem{
+			ConfigItemId: one.ConfigItemID,
+			Name:         one.ConfigItemSpec.Name,
+			Path:         one.ConfigItemSpec.Path,
+			FileType:     string(one.ConfigItemSpec.FileType),
+			FileMode:     string(one.ConfigItemSpec.FileMode),
+		}
+		if p := one.ConfigItemSpec.Permission; p != nil {
+			item.User, item.UserGroup, item.Privilege = p.User, p.UserGroup, p.Privilege
+		}
+		if c := one.CommitSpec.Content; c != nil {
+			item.Signature, item.ByteSize, item.Md5 = c.Signature, c.ByteSize, c.Md5
+			item.OriginSignature = c.OriginSignature
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// previewClientKvs list the released kvs of the release, and resolve the group override value matched by the
+// client at the same time.
+func (s *Service) previewClientKvs(kt *kit.Kit, req *pbds.PreviewClientReleaseReq, releaseID uint32) (
+	[]*pbcp.ClientPreviewKv, error) {
+
+	rkvs, err := s.dao.ReleasedKv().ListAllByReleaseIDs(kt, []uint32{releaseID}, req.BizId)
+	if err != nil {
+		logs.Errorf("list release %d kvs failed, err: %v, rid: %s", releaseID, err, kt.Rid)
+		return nil, err
+	}
+
+	overrides, err := s.dao.ReleasedKvOverride().ListAllByReleaseIDs(kt, []uint32{releaseID}, req.BizId)
+	if err != nil {
+		logs.Errorf("list release %d kv overrides failed, err: %v, rid: %s", releaseID, err, kt.Rid)
+		return nil, err
+	}
+
+	// 覆盖值已按优先级排序, 每个 kv 取第一个匹配的覆盖值
+	matched := make(map[string]*table.ReleasedKvOverride)
+	for _, one := range overrides {
+		if _, ok := matched[one.Spec.Key]; ok {
+			continue
+		}
+		hit, e := one.Group.MatchInstance(req.Uid, req.Labels)
+		if e != nil {
+			return nil, e
+		}
+		if hit {
+			matched[one.Spec.Key] = one
+		}
+	}
+
+	kvs := make([]*pbcp.ClientPreviewKv, 0, len(rkvs))
+	for _, one := range rkvs {
+		kv := &pbcp.ClientPreviewKv{
+			Key:          one.Spec.Key,
+			SecretHidden: one.Spec.SecretHidden,
+		}
+
+		content := one.ContentSpec
+		var kvType table.DataType
+		if override, ok := matched[one.Spec.Key]; ok {
+			kvType, kv.Value, err = s.vault.GetKvOverride(kt, &types.GetKvOverrideOption{
+				BizID:     req.BizId,
+				AppID:     req.AppId,
+				ReleaseID: releaseID,
+				Key:       one.Spec.Key,
+				GroupID:   override.Spec.GroupID,
+				Version:   int(override.Spec.Version),
+			})
+			content = override.ContentSpec
+			kv.OverrideGroupId = override.Spec.GroupID
+		} else {
+			kvType, kv.Value, err = s.getReleasedKv(kt, req.BizId, req.AppId, one.Spec.Version, releaseID,
+				one.Spec.Key)
+		}
+		if err != nil {
+			logs.Errorf("get vault released kv %s failed, err: %v, rid: %s", one.Spec.Key, err, kt.Rid)
+			return nil, err
+		}
+
+		kv.KvType = string(kvType)
+		if content != nil {
+			kv.Signature, kv.ByteSize, kv.Md5 = content.Signature, content.ByteSize, content.Md5
+		}
+		kvs = append(kvs, kv)
+	}
+
+	return kvs, nil
+}
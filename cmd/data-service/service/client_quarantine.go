@@ -0,0 +1,142 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcq "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-quarantine"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateClientQuarantine create client quarantine.
+func (s *Service) CreateClientQuarantine(ctx context.Context, req *pbds.CreateClientQuarantineReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec, err := req.Spec.ClientQuarantineSpec()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+	if req.Attachment == nil || spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "client quarantine spec is required"))
+	}
+	bizID := req.Attachment.BizId
+
+	list, err := s.dao.ClientQuarantine().List(kt, bizID)
+	if err != nil {
+		logs.Errorf("list client quarantines failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(list) >= table.MaxClientQuarantinesPerBiz {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the number of client quarantines exceeds the limit %d", table.MaxClientQuarantinesPerBiz))
+	}
+	for _, one := range list {
+		if one.Spec.MatchType == spec.MatchType && one.Spec.Value == spec.Value {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "client %s %s is already quarantined",
+				spec.MatchType, spec.Value))
+		}
+	}
+
+	quarantine := &table.ClientQuarantine{
+		Spec:       spec,
+		Attachment: &table.ClientQuarantineAttachment{BizID: bizID},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.ClientQuarantine().Create(kt, quarantine)
+	if err != nil {
+		logs.Errorf("create client quarantine failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListClientQuarantines list the client quarantines of a biz.
+func (s *Service) ListClientQuarantines(ctx context.Context, req *pbds.ListClientQuarantinesReq) (
+	*pbds.ListClientQuarantinesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	list, err := s.dao.ClientQuarantine().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list client quarantines failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListClientQuarantinesResp{
+		Count:   uint32(len(list)),
+		Details: pbcq.PbClientQuarantines(list),
+	}, nil
+}
+
+// UpdateClientQuarantine update client quarantine, the match type and value can not be changed.
+func (s *Service) UpdateClientQuarantine(ctx context.Context, req *pbds.UpdateClientQuarantineReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec, err := req.Spec.ClientQuarantineSpec()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+	if req.Attachment == nil || spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "client quarantine spec is required"))
+	}
+
+	old, err := s.dao.ClientQuarantine().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get client quarantine (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+	spec.MatchType = old.Spec.MatchType
+	spec.Value = old.Spec.Value
+
+	quarantine := &table.ClientQuarantine{
+		ID:         old.ID,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.ClientQuarantine().Update(kt, quarantine); err != nil {
+		logs.Errorf("update client quarantine failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteClientQuarantine delete client quarantine.
+func (s *Service) DeleteClientQuarantine(ctx context.Context, req *pbds.DeleteClientQuarantineReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.ClientQuarantine().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete client quarantine failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
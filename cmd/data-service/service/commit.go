@@ -34,6 +34,21 @@ func (s *Service) CreateCommit(ctx context.Context, req *pbds.CreateCommitReq) (
 		return nil, err
 	}
 
+	ci, err := s.dao.ConfigItem().Get(grpcKit, req.Attachment.ConfigItemId, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("get config item failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	// 检测配置内容是否包含疑似凭证
+	setting, err := s.getSecretScanSetting(grpcKit, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	if err = s.checkContentSecretLeak(grpcKit, setting, ci.Spec, content.Spec.Signature); err != nil {
+		return nil, err
+	}
+
 	commit := &table.Commit{
 		Spec: &table.CommitSpec{
 			ContentID: req.ContentId,
@@ -0,0 +1,409 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/compliance-policy"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// maxComplianceContentSize is the max size of the config item content checked by the compliance policies,
+// the larger one is skipped.
+const maxComplianceContentSize = 1 << 20
+
+// complianceItem is a config item or kv checked by the compliance policies.
+type complianceItem struct {
+	target  table.ComplianceTarget
+	name    string
+	content string
+}
+
+// CreateCompliancePolicy create compliance policy.
+func (s *Service) CreateCompliancePolicy(ctx context.Context, req *pbds.CreateCompliancePolicyReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	policies, err := s.dao.CompliancePolicy().List(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("list compliance policies failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(policies) >= table.MaxCompliancePoliciesPerBiz {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the number of compliance policies exceeds the limit %d",
+			table.MaxCompliancePoliciesPerBiz))
+	}
+	for _, one := range policies {
+		if one.Spec.Name == req.Spec.Name {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "compliance policy name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	policy := &table.CompliancePolicy{
+		Spec:       req.Spec.CompliancePolicySpec(),
+		Attachment: req.Attachment.CompliancePolicyAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.CompliancePolicy().Create(kt, policy)
+	if err != nil {
+		logs.Errorf("create compliance policy failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListCompliancePolicies list all the compliance policies of a biz.
+func (s *Service) ListCompliancePolicies(ctx context.Context, req *pbds.ListCompliancePoliciesReq) (
+	*pbds.ListCompliancePoliciesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	policies, err := s.dao.CompliancePolicy().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list compliance policies failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListCompliancePoliciesResp{
+		Count:   uint32(len(policies)),
+		Details: pbcp.PbCompliancePolicies(policies),
+	}, nil
+}
+
+// UpdateCompliancePolicy update compliance policy.
+func (s *Service) UpdateCompliancePolicy(ctx context.Context, req *pbds.UpdateCompliancePolicyReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.CompliancePolicy().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get compliance policy (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if req.Spec.Name != old.Spec.Name {
+		if _, err = s.dao.CompliancePolicy().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "compliance policy name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	policy := &table.CompliancePolicy{
+		ID:         old.ID,
+		Spec:       req.Spec.CompliancePolicySpec(),
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.CompliancePolicy().Update(kt, policy); err != nil {
+		logs.Errorf("update compliance policy failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteCompliancePolicy delete compliance policy.
+func (s *Service) DeleteCompliancePolicy(ctx context.Context, req *pbds.DeleteCompliancePolicyReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.CompliancePolicy().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete compliance policy failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// TestCompliancePolicy evaluate the policy which is not saved with the samples, or with the
+// editing config items and kvs of the app if the app id is set.
+func (s *Service) TestCompliancePolicy(ctx context.Context, req *pbds.TestCompliancePolicyReq) (
+	*pbds.TestCompliancePolicyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.Spec.CompliancePolicySpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "compliance policy spec is required"))
+	}
+	if err := spec.Validate(kt); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	items := make([]*complianceItem, 0, len(req.Samples))
+	for _, one := range req.Samples {
+		items = append(items, &complianceItem{
+			target:  table.ComplianceTarget(one.Target),
+			name:    one.Name,
+			content: one.Content,
+		})
+	}
+	if req.AppId > 0 {
+		app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+		if err != nil {
+			logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		appItems, err := s.listEditingComplianceItems(kt, app)
+		if err != nil {
+			logs.Errorf("list app %d compliance items failed, err: %v, rid: %s", app.ID, err, kt.Rid)
+			return nil, err
+		}
+		items = append(items, appItems...)
+	}
+
+	policy := &table.CompliancePolicy{Spec: spec}
+	return &pbds.TestCompliancePolicyResp{
+		Violations: pbcp.PbComplianceViolations(evaluateCompliance([]*table.CompliancePolicy{policy}, items)),
+	}, nil
+}
+
+// checkCompliance evaluate the enabled compliance policies of the biz on the app's editing config items and
+// kvs if the release id is 0, otherwise on the released ones. it returns an error if any blocking policy
+// is violated, the warning violations are returned to the caller.
+func (s *Service) checkCompliance(kt *kit.Kit, app *table.App, releaseID uint32) (
+	[]*table.ComplianceViolation, error) {
+
+	policies, err := s.listAppCompliancePolicies(kt, app)
+	if err != nil {
+		logs.Errorf("list app %d compliance policies failed, err: %v, rid: %s", app.ID, err, kt.Rid)
+		return nil, err
+	}
+	// 没有生效的策略时不需要加载配置内容
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	var items []*complianceItem
+	if releaseID == 0 {
+		items, err = s.listEditingComplianceItems(kt, app)
+	} else {
+		items, err = s.listReleasedComplianceItems(kt, app, releaseID)
+	}
+	if err != nil {
+		logs.Errorf("list app %d compliance items failed, err: %v, rid: %s", app.ID, err, kt.Rid)
+		return nil, err
+	}
+
+	warnings := make([]*table.ComplianceViolation, 0)
+	blocks := make([]string, 0)
+	for _, one := range evaluateCompliance(policies, items) {
+		if one.Severity == table.ComplianceBlock {
+			blocks = append(blocks, one.PolicyName+": "+one.Name+" "+one.Message)
+			continue
+		}
+		warnings = append(warnings, one)
+	}
+	if len(blocks) > 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "blocked by compliance policies, %s",
+			strings.Join(blocks, "; ")))
+	}
+
+	return warnings, nil
+}
+
+// listAppCompliancePolicies list the enabled compliance policies which take effect in the app's environment.
+func (s *Service) listAppCompliancePolicies(kt *kit.Kit, app *table.App) ([]*table.CompliancePolicy, error) {
+	policies, err := s.dao.CompliancePolicy().ListEnabled(kt, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	var envID uint32
+	binding, err := s.dao.EnvAppBinding().GetByAppID(kt, app.BizID, app.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		envID = binding.Spec.EnvID
+	}
+
+	result := make([]*table.CompliancePolicy, 0, len(policies))
+	for _, one := range policies {
+		if one.Spec.MatchEnv(envID) {
+			result = append(result, one)
+		}
+	}
+
+	return result, nil
+}
+
+// listEditingComplianceItems list the app's editing config items or kvs with content.
+func (s *Service) listEditingComplianceItems(kt *kit.Kit, app *table.App) ([]*complianceItem, error) {
+	if app.Spec.ConfigType == table.KV {
+		kvs, err := s.dao.Kv().ListAllByAppID(kt, app.ID, app.BizID, []string{string(table.KvStateAdd),
+			string(table.KvStateRevise), string(table.KvStateUnchange)})
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*complianceItem, 0, len(kvs))
+		for _, kv := range kvs {
+			_, value, err := s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+				BizID:   app.BizID,
+				AppID:   app.ID,
+				Key:     kv.Spec.Key,
+				Version: int(kv.Spec.Version),
+			})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, &complianceItem{target: table.ComplianceTargetKv, name: kv.Spec.Key, content: value})
+		}
+		return items, nil
+	}
+
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, app.ID, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, app.BizID, app.ID)
+	if err != nil {
+		return nil, err
+	}
+	signs := make(map[uint32]string, len(commits))
+	for _, one := range commits {
+		if one.Spec.Content != nil {
+			signs[one.Attachment.ConfigItemID] = one.Spec.Content.Signature
+		}
+	}
+
+	items := make([]*complianceItem, 0, len(cis))
+	for _, ci := range cis {
+		// 二进制文件不检查
+		if ci.Spec.FileType == table.Binary || signs[ci.ID] == "" {
+			continue
+		}
+		content, ok, err := s.loadComplianceContent(kt, app.BizID, signs[ci.ID])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			items = append(items, &complianceItem{target: table.ComplianceTargetFile,
+				name: path.Join(ci.Spec.Path, ci.Spec.Name), content: content})
+		}
+	}
+
+	return items, nil
+}
+
+// listReleasedComplianceItems list the config items or kvs with content of the app's release.
+func (s *Service) listReleasedComplianceItems(kt *kit.Kit, app *table.App, releaseID uint32) (
+	[]*complianceItem, error) {
+	if app.Spec.ConfigType == table.KV {
+		kvs, err := s.dao.ReleasedKv().ListAllByReleaseIDs(kt, []uint32{releaseID}, app.BizID)
+		if err != nil {
+			return nil, err
+		}
+
+		items := make([]*complianceItem, 0, len(kvs))
+		for _, kv := range kvs {
+			_, value, err := s.vault.GetRKv(kt, &types.GetRKvOption{
+				BizID:      app.BizID,
+				AppID:      app.ID,
+				Key:        kv.Spec.Key,
+				ReleasedID: releaseID,
+				Version:    int(kv.Spec.Version),
+			})
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, &complianceItem{target: table.ComplianceTargetKv, name: kv.Spec.Key, content: value})
+		}
+		return items, nil
+	}
+
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(kt, []uint32{releaseID}, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*complianceItem, 0, len(rcis))
+	for _, rci := range rcis {
+		if rci.ConfigItemSpec.FileType == table.Binary || rci.CommitSpec.Content == nil {
+			continue
+		}
+		content, ok, err := s.loadComplianceContent(kt, app.BizID, rci.CommitSpec.Content.Signature)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			items = append(items, &complianceItem{target: table.ComplianceTargetFile,
+				name: path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name), content: content})
+		}
+	}
+
+	return items, nil
+}
+
+// loadComplianceContent download the content from repository, the content larger than the max size
+// or not a valid utf-8 text is not checked.
+func (s *Service) loadComplianceContent(kt *kit.Kit, bizID uint32, sign string) (string, bool, error) {
+	repoKt := kt.GetKitForRepoCfg()
+	repoKt.BizID = bizID
+	body, _, err := s.repo.Download(repoKt, sign)
+	if err != nil {
+		return "", false, err
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxComplianceContentSize+1))
+	if err != nil {
+		return "", false, err
+	}
+	if len(data) > maxComplianceContentSize || !utf8.Valid(data) {
+		return "", false, nil
+	}
+
+	return string(data), true, nil
+}
+
+// evaluateCompliance evaluate the items with the policies.
+func evaluateCompliance(policies []*table.CompliancePolicy, items []*complianceItem) []*table.ComplianceViolation {
+	violations := make([]*table.ComplianceViolation, 0)
+	for _, policy := range policies {
+		for _, item := range items {
+			for _, one := range policy.Spec.Evaluate(item.target, item.name, item.content) {
+				one.PolicyID = policy.ID
+				violations = append(violations, one)
+			}
+		}
+	}
+
+	return violations
+}
@@ -24,6 +24,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/internal/search"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
@@ -58,6 +59,17 @@ func (s *Service) CreateConfigItem(ctx context.Context, req *pbds.CreateConfigIt
 		return nil, err
 	}
 
+	// 检测配置内容是否包含疑似凭证
+	setting, err := s.getSecretScanSetting(grpcKit, req.ConfigItemAttachment.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	if err = s.checkContentSecretLeak(grpcKit, setting, req.ConfigItemSpec.ConfigItemSpec(),
+		req.ContentSpec.Signature); err != nil {
+		return nil, err
+	}
+
 	tx := s.dao.GenQuery().Begin()
 	// 1. create config item.
 	ci := &table.ConfigItem{
@@ -171,6 +183,19 @@ func (s *Service) BatchUpsertConfigItems(ctx context.Context, req *pbds.BatchUps
 		return nil, err
 	}
 
+	// 仅扫描新增和内容变更的配置文件
+	setting, err := s.getSecretScanSetting(grpcKit, req.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+	for _, item := range append(toCreate, toUpdateContent...) {
+		if err = s.checkContentSecretLeak(grpcKit, setting, item.ConfigItemSpec.ConfigItemSpec(),
+			item.ContentSpec.Signature); err != nil {
+			return nil, err
+		}
+	}
+
 	now := time.Now().UTC()
 	tx := s.dao.GenQuery().Begin()
 
@@ -349,7 +374,7 @@ func (s *Service) verifyAppLimitHasBeenExceeded(kit *kit.Kit, tx *gen.QueryTx, b
 
 	app, err := s.dao.App().GetByID(kit, appID)
 	if err != nil {
-		return errf.Errorf(errf.AppNotExists, i18n.T(kit, "app %d not found", appID))
+		return errf.ErrAppNotExists.New(kit, appID)
 	}
 
 	// 只有文件类型的才能绑定套餐
@@ -941,6 +966,21 @@ func (s *Service) UpdateConfigItem(ctx context.Context, req *pbds.UpdateConfigIt
 
 	grpcKit := kit.FromGrpcContext(ctx)
 
+	// 乐观锁: 编辑期间配置项已被他人修改时拒绝覆盖
+	var oldOne *table.ConfigItem
+	if req.ExpectedRevision != "" {
+		var err error
+		oldOne, err = s.dao.ConfigItem().Get(grpcKit, req.Id, req.Attachment.BizId)
+		if err != nil {
+			logs.Errorf("get config item failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
+		if err = checkExpectedRevision(grpcKit, path.Join(oldOne.Spec.Path, oldOne.Spec.Name),
+			req.ExpectedRevision, oldOne.Revision); err != nil {
+			return nil, err
+		}
+	}
+
 	ci := &table.ConfigItem{
 		ID:         req.Id,
 		Spec:       req.Spec.ConfigItemSpec(),
@@ -949,7 +989,22 @@ func (s *Service) UpdateConfigItem(ctx context.Context, req *pbds.UpdateConfigIt
 			Reviser: grpcKit.User,
 		},
 	}
-	if err := s.dao.ConfigItem().Update(grpcKit, ci); err != nil {
+
+	var err error
+	if oldOne == nil {
+		err = s.dao.ConfigItem().Update(grpcKit, ci)
+	} else {
+		// 校验后以读取到的更新时间为条件更新, 避免校验与更新之间被他人修改
+		err = s.dao.ConfigItem().UpdateWithRevision(grpcKit, ci, oldOne.Revision.UpdatedAt)
+	}
+	if errors.Is(err, dao.ErrRevisionConflict) {
+		latest, e := s.dao.ConfigItem().Get(grpcKit, req.Id, req.Attachment.BizId)
+		if e != nil {
+			return nil, e
+		}
+		return nil, revisionConflictError(grpcKit, path.Join(oldOne.Spec.Path, oldOne.Spec.Name), latest.Revision)
+	}
+	if err != nil {
 		logs.Errorf("update config item failed, err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
 	}
@@ -957,16 +1012,69 @@ func (s *Service) UpdateConfigItem(ctx context.Context, req *pbds.UpdateConfigIt
 	return new(pbbase.EmptyResp), nil
 }
 
+// checkExpectedRevision check whether the resource is modified by others after the editor got it,
+// returns the conflict with the other editor's identity and update time.
+func checkExpectedRevision(kt *kit.Kit, name, expected string, current *table.Revision) error {
+	match, err := current.MatchRevision(expected)
+	if err != nil {
+		return errf.New(errf.InvalidArgument, err.Error())
+	}
+	if match {
+		return nil
+	}
+
+	return revisionConflictError(kt, name, current)
+}
+
+// revisionConflictError returns the conflict error with the other editor's identity and update time.
+func revisionConflictError(kt *kit.Kit, name string, current *table.Revision) error {
+	return errf.Errorf(errf.Aborted, i18n.T(kt, "%s has been modified by %s at %s, please refresh and retry",
+		name, current.Reviser, current.UpdatedAt.Format(time.RFC3339)))
+}
+
 // DeleteConfigItem delete config item.
 func (s *Service) DeleteConfigItem(ctx context.Context, req *pbds.DeleteConfigItemReq) (*pbbase.EmptyResp, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
 
+	oldOne, err := s.dao.ConfigItem().Get(grpcKit, req.Id, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("get config item failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
 	ci := &table.ConfigItem{
 		ID:         req.Id,
 		Attachment: req.Attachment.ConfigItemAttachment(),
 	}
-	if err := s.dao.ConfigItem().Delete(grpcKit, ci); err != nil {
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.ConfigItem().DeleteWithTx(grpcKit, tx, ci); err != nil {
 		logs.Errorf("delete config item failed, err: %v, rid: %s", err, grpcKit.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	// the config item referenced by others can not be deleted.
+	if err = s.unlinkConfigRefsWithTx(grpcKit, tx, oldOne.Attachment.BizID, table.ConfigRefConfigItem, oldOne.ID,
+		recycleConfigItemName(oldOne)); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	// put the deleted config item into recycle bin, so that it can be restored.
+	if err = s.recycleWithTx(grpcKit, tx, table.RecycleConfigItem, oldOne.ID, oldOne.Attachment.BizID,
+		oldOne.Attachment.AppID, recycleConfigItemName(oldOne), oldOne); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
 	}
 
@@ -992,6 +1100,10 @@ func (s *Service) ListConfigItems(ctx context.Context, req *pbds.ListConfigItems
 	error) {
 	grpcKit := kit.FromGrpcContext(ctx)
 
+	if req.CursorPage != nil {
+		return s.listConfigItemsWithCursor(grpcKit, req)
+	}
+
 	// validate the page params
 	opt := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
 	if err := opt.Validate(types.DefaultPageOption); err != nil {
@@ -1112,6 +1224,33 @@ func (s *Service) ListConfigItems(ctx context.Context, req *pbds.ListConfigItems
 	return resp, nil
 }
 
+// listConfigItemsWithCursor 游标分页查询未命名版本配置项，直接在数据库中按游标分页，
+// 不计算文件删除状态和冲突状态，也不返回总数
+func (s *Service) listConfigItemsWithCursor(kt *kit.Kit, req *pbds.ListConfigItemsReq) (
+	*pbds.ListConfigItemsResp, error) {
+	page := req.CursorPage.CursorPage()
+	if err := page.Validate(types.ConfigItemCursorOption); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+	}
+
+	details, nextCursor, err := s.dao.ConfigItem().ListWithCursor(kt, req.BizId, req.AppId, page)
+	if err != nil {
+		logs.Errorf("list config items with cursor failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	configItems := make([]*pbci.ConfigItem, 0, len(details))
+	for _, ci := range details {
+		configItems = append(configItems, pbci.PbConfigItem(ci, ""))
+	}
+	if err = s.setCommitSpecForCIs(kt, configItems); err != nil {
+		logs.Errorf("set commit spec for config items failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListConfigItemsResp{Details: configItems, NextCursor: nextCursor}, nil
+}
+
 // setCommitSpecForCIs set commit spec for config items
 func (s *Service) setCommitSpecForCIs(kt *kit.Kit, cis []*pbci.ConfigItem) error {
 	ids := make([]uint32, len(cis))
@@ -0,0 +1,476 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcref "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-reference"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// maxConfigRefGraphDepth is the max depth of querying the config reference graph.
+	maxConfigRefGraphDepth = 5
+	// maxConfigRefGraphNodes is the max node count of the config reference graph, the nodes
+	// beyond it are not expanded any more.
+	maxConfigRefGraphNodes = 200
+)
+
+// config reference graph query directions.
+const (
+	configRefDownstream = "downstream"
+	configRefUpstream   = "upstream"
+	configRefBoth       = "both"
+)
+
+// configRefNode is a config in the reference graph.
+type configRefNode struct {
+	nodeType table.ConfigRefNodeType
+	bizID    uint32
+	appID    uint32
+	id       uint32
+}
+
+// configRefLink is a reference from or to a node, other is the node at the other end.
+type configRefLink struct {
+	edge  *pbcref.ConfigRefEdge
+	other configRefNode
+}
+
+// CreateConfigReference declare a reference from a config to another config in the same biz.
+func (s *Service) CreateConfigReference(ctx context.Context, req *pbds.CreateConfigReferenceReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.Spec.ConfigReferenceSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config reference spec is required"))
+	}
+	if err := spec.Validate(kt); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	src := configRefNode{nodeType: spec.SrcType, bizID: req.BizId, appID: req.SrcAppId, id: spec.SrcID}
+	dst := configRefNode{nodeType: spec.DstType, bizID: req.BizId, appID: req.DstAppId, id: spec.DstID}
+	for _, node := range []configRefNode{src, dst} {
+		if _, err := s.resolveConfigRefNode(kt, node); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "%s %d is not found in app %d",
+				node.nodeType, node.id, node.appID))
+		}
+	}
+
+	existing, err := s.dao.ConfigReference().ListBySrc(kt, req.BizId, spec.SrcType, []uint32{spec.SrcID})
+	if err != nil {
+		logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, one := range existing {
+		if one.Spec.DstType == spec.DstType && one.Spec.DstID == spec.DstID {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config reference already exists"))
+		}
+	}
+
+	// 包含关系不允许成环，否则渲染配置文件时会无限包含
+	if spec.RefType == table.ConfigRefInclude {
+		cyclic, e := s.configRefReachable(kt, dst, src, table.ConfigRefInclude)
+		if e != nil {
+			return nil, e
+		}
+		if cyclic {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config reference makes an include cycle"))
+		}
+	}
+
+	ref := &table.ConfigReference{
+		Spec: spec,
+		Attachment: &table.ConfigReferenceAttachment{
+			BizID:    req.BizId,
+			SrcAppID: req.SrcAppId,
+			DstAppID: req.DstAppId,
+		},
+		Revision: &table.CreatedRevision{Creator: kt.User},
+	}
+	id, err := s.dao.ConfigReference().Create(kt, ref)
+	if err != nil {
+		logs.Errorf("create config reference failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// DeleteConfigReference delete a reference declared by the app's config.
+func (s *Service) DeleteConfigReference(ctx context.Context, req *pbds.DeleteConfigReferenceReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	ref, err := s.dao.ConfigReference().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get config reference (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+	if ref.Attachment.SrcAppID != req.AppId {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config reference %d is not declared by app %d",
+			req.Id, req.AppId))
+	}
+
+	if err = s.dao.ConfigReference().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete config reference failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetConfigRefGraph query the reference graph around the config, both the declared references and
+// the references derived from config shares are included.
+func (s *Service) GetConfigRefGraph(ctx context.Context, req *pbds.GetConfigRefGraphReq) (
+	*pbds.GetConfigRefGraphResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	nodeType := table.ConfigRefNodeType(req.NodeType)
+	if err := nodeType.Validate(); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	direction := req.Direction
+	if direction == "" {
+		direction = configRefBoth
+	}
+	if direction != configRefDownstream && direction != configRefUpstream && direction != configRefBoth {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "unsupported direction %s", direction))
+	}
+
+	depth := int(req.Depth)
+	if depth == 0 {
+		depth = 1
+	}
+	if depth > maxConfigRefGraphDepth {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "depth should <= %d", maxConfigRefGraphDepth))
+	}
+
+	root := configRefNode{nodeType: nodeType, bizID: req.BizId, appID: req.AppId, id: req.NodeId}
+	if _, err := s.resolveConfigRefNode(kt, root); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "%s %d is not found in app %d",
+			root.nodeType, root.id, root.appID))
+	}
+
+	visited := map[configRefNode]bool{root: true}
+	nodes := []configRefNode{root}
+	edges := make([]*pbcref.ConfigRefEdge, 0)
+	edgeSeen := make(map[string]bool)
+	frontier := []configRefNode{root}
+	for level := 0; level < depth && len(frontier) > 0; level++ {
+		next := make([]configRefNode, 0)
+		for _, node := range frontier {
+			links, err := s.listConfigRefLinks(kt, node, direction)
+			if err != nil {
+				return nil, err
+			}
+			for _, link := range links {
+				key := fmt.Sprintf("%s/%d-%s-%s/%d", link.edge.SrcType, link.edge.SrcId, link.edge.RefType,
+					link.edge.DstType, link.edge.DstId)
+				if !edgeSeen[key] {
+					edgeSeen[key] = true
+					edges = append(edges, link.edge)
+				}
+				if visited[link.other] || len(nodes) >= maxConfigRefGraphNodes {
+					continue
+				}
+				visited[link.other] = true
+				nodes = append(nodes, link.other)
+				next = append(next, link.other)
+			}
+		}
+		frontier = next
+	}
+
+	pbNodes := make([]*pbcref.ConfigRefNode, 0, len(nodes))
+	for _, node := range nodes {
+		// 已删除的配置仍然展示在关系图中，名称为空
+		name, _ := s.resolveConfigRefNode(kt, node)
+		pbNodes = append(pbNodes, &pbcref.ConfigRefNode{
+			Type:  string(node.nodeType),
+			Id:    node.id,
+			BizId: node.bizID,
+			AppId: node.appID,
+			Name:  name,
+		})
+	}
+
+	return &pbds.GetConfigRefGraphResp{
+		Nodes: pbNodes,
+		Edges: edges,
+	}, nil
+}
+
+// listConfigRefLinks list the references from (downstream) or to (upstream) the node.
+func (s *Service) listConfigRefLinks(kt *kit.Kit, node configRefNode, direction string) ([]*configRefLink, error) {
+	links := make([]*configRefLink, 0)
+	if direction != configRefUpstream {
+		refs, err := s.dao.ConfigReference().ListBySrc(kt, node.bizID, node.nodeType, []uint32{node.id})
+		if err != nil {
+			logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		for _, ref := range refs {
+			links = append(links, &configRefLink{
+				edge: pbcref.PbConfigRefEdge(ref),
+				other: configRefNode{nodeType: ref.Spec.DstType, bizID: ref.Attachment.BizID,
+					appID: ref.Attachment.DstAppID, id: ref.Spec.DstID},
+			})
+		}
+
+		shared, err := s.listSharedConfigRefLinks(kt, node)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, shared...)
+	}
+
+	if direction != configRefDownstream {
+		refs, err := s.dao.ConfigReference().ListByDst(kt, node.bizID, node.nodeType, []uint32{node.id})
+		if err != nil {
+			logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		for _, ref := range refs {
+			links = append(links, &configRefLink{
+				edge: pbcref.PbConfigRefEdge(ref),
+				other: configRefNode{nodeType: ref.Spec.SrcType, bizID: ref.Attachment.BizID,
+					appID: ref.Attachment.SrcAppID, id: ref.Spec.SrcID},
+			})
+		}
+
+		consumers, err := s.listShareConsumerRefLinks(kt, node)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, consumers...)
+	}
+
+	return links, nil
+}
+
+// listSharedConfigRefLinks list the configs shared by the other bizs which are referenced by the app.
+func (s *Service) listSharedConfigRefLinks(kt *kit.Kit, node configRefNode) ([]*configRefLink, error) {
+	if node.nodeType != table.ConfigRefApp {
+		return nil, nil
+	}
+
+	refs, err := s.dao.ConfigShareRef().ListByAppID(kt, node.bizID, node.id)
+	if err != nil {
+		logs.Errorf("list config share refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	shareIDs := make([]uint32, 0, len(refs))
+	for _, ref := range refs {
+		shareIDs = append(shareIDs, ref.Spec.ShareID)
+	}
+	shares, err := s.dao.ConfigShare().ListByIDs(kt, shareIDs)
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	links := make([]*configRefLink, 0, len(shares))
+	for _, share := range shares {
+		other := configRefNode{bizID: share.Attachment.BizID, appID: share.Attachment.AppID}
+		switch share.Spec.ConfigType {
+		case table.File:
+			other.nodeType, other.id = table.ConfigRefConfigItem, share.Spec.ConfigItemID
+		case table.KV:
+			kv, e := s.dao.Kv().GetByKvState(kt, share.Attachment.BizID, share.Attachment.AppID, share.Spec.Key,
+				[]string{string(table.KvStateAdd), string(table.KvStateRevise), string(table.KvStateUnchange)})
+			if e != nil {
+				// 共享的配置项已被删除
+				continue
+			}
+			other.nodeType, other.id = table.ConfigRefKv, kv.ID
+		default:
+			continue
+		}
+		links = append(links, &configRefLink{
+			edge: &pbcref.ConfigRefEdge{SrcType: string(table.ConfigRefApp), SrcId: node.id,
+				DstType: string(other.nodeType), DstId: other.id, RefType: string(table.ConfigRefShare)},
+			other: other,
+		})
+	}
+
+	return links, nil
+}
+
+// listShareConsumerRefLinks list the apps of the other bizs which reference the config by config share.
+func (s *Service) listShareConsumerRefLinks(kt *kit.Kit, node configRefNode) ([]*configRefLink, error) {
+	if node.nodeType == table.ConfigRefApp {
+		return nil, nil
+	}
+
+	shares, _, err := s.dao.ConfigShare().List(kt, &types.ListConfigSharesOption{
+		BizID: node.bizID,
+		AppID: node.appID,
+		Page:  &types.BasePage{All: true},
+	})
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	var key string
+	if node.nodeType == table.ConfigRefKv && len(shares) > 0 {
+		if key, err = s.resolveConfigRefNode(kt, node); err != nil {
+			return nil, nil
+		}
+	}
+	shareIDs := make([]uint32, 0)
+	for _, share := range shares {
+		if (node.nodeType == table.ConfigRefConfigItem && share.Spec.ConfigItemID == node.id) ||
+			(node.nodeType == table.ConfigRefKv && share.Spec.Key == key) {
+			shareIDs = append(shareIDs, share.ID)
+		}
+	}
+	if len(shareIDs) == 0 {
+		return nil, nil
+	}
+
+	refs, err := s.dao.ConfigShareRef().ListByShareIDs(kt, shareIDs)
+	if err != nil {
+		logs.Errorf("list config share refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	links := make([]*configRefLink, 0, len(refs))
+	for _, ref := range refs {
+		links = append(links, &configRefLink{
+			edge: &pbcref.ConfigRefEdge{SrcType: string(table.ConfigRefApp), SrcId: ref.Attachment.AppID,
+				DstType: string(node.nodeType), DstId: node.id, RefType: string(table.ConfigRefShare)},
+			other: configRefNode{nodeType: table.ConfigRefApp, bizID: ref.Attachment.BizID,
+				appID: ref.Attachment.AppID, id: ref.Attachment.AppID},
+		})
+	}
+
+	return links, nil
+}
+
+// configRefReachable returns whether the target node can be reached from the node by the references of the type.
+func (s *Service) configRefReachable(kt *kit.Kit, from, target configRefNode, refType table.ConfigRefType) (
+	bool, error) {
+	visited := map[configRefNode]bool{from: true}
+	queue := []configRefNode{from}
+	for len(queue) > 0 && len(visited) <= maxConfigRefGraphNodes {
+		node := queue[0]
+		queue = queue[1:]
+		if node == target {
+			return true, nil
+		}
+
+		refs, err := s.dao.ConfigReference().ListBySrc(kt, node.bizID, node.nodeType, []uint32{node.id})
+		if err != nil {
+			logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+			return false, err
+		}
+		for _, ref := range refs {
+			if ref.Spec.RefType != refType {
+				continue
+			}
+			next := configRefNode{nodeType: ref.Spec.DstType, bizID: ref.Attachment.BizID,
+				appID: ref.Attachment.DstAppID, id: ref.Spec.DstID}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// resolveConfigRefNode check the config exists and returns its name.
+func (s *Service) resolveConfigRefNode(kt *kit.Kit, node configRefNode) (string, error) {
+	switch node.nodeType {
+	case table.ConfigRefApp:
+		if node.id != node.appID {
+			return "", errf.New(errf.InvalidArgument, "app node id should be same as its app id")
+		}
+		app, err := s.dao.App().Get(kt, node.bizID, node.id)
+		if err != nil {
+			return "", err
+		}
+		return app.Spec.Name, nil
+	case table.ConfigRefConfigItem:
+		ci, err := s.dao.ConfigItem().Get(kt, node.id, node.bizID)
+		if err != nil {
+			return "", err
+		}
+		if ci.Attachment.AppID != node.appID {
+			return "", errf.New(errf.InvalidArgument, "config item is not in the app")
+		}
+		return path.Join(ci.Spec.Path, ci.Spec.Name), nil
+	case table.ConfigRefKv:
+		kv, err := s.dao.Kv().GetByID(kt, node.bizID, node.appID, node.id)
+		if err != nil {
+			return "", err
+		}
+		if kv.KvState == table.KvStateDelete {
+			return "", errf.New(errf.InvalidArgument, "kv is deleted")
+		}
+		return kv.Spec.Key, nil
+	}
+
+	return "", errf.New(errf.InvalidArgument, "unsupported config reference node type")
+}
+
+// unlinkConfigRefsWithTx is called when the config item or kv is deleted, it rejects the deletion
+// if the config is referenced by others, otherwise removes the references declared by it.
+func (s *Service) unlinkConfigRefsWithTx(kt *kit.Kit, tx *gen.QueryTx, bizID uint32,
+	nodeType table.ConfigRefNodeType, id uint32, name string) error {
+	refs, err := s.dao.ConfigReference().ListByDstWithTx(kt, tx, bizID, nodeType, id)
+	if err != nil {
+		logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(refs) > 0 {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"%s is referenced by %d configs, please remove the references first", name, len(refs)))
+	}
+
+	return s.dao.ConfigReference().DeleteBySrcWithTx(kt, tx, bizID, nodeType, id)
+}
+
+// unlinkAppConfigRefsWithTx is called when the app is deleted, it rejects the deletion if the app or
+// its configs are referenced by the other apps, otherwise removes all the references of the app.
+func (s *Service) unlinkAppConfigRefsWithTx(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32, name string) error {
+	refs, err := s.dao.ConfigReference().ListExternalToAppWithTx(kt, tx, bizID, appID)
+	if err != nil {
+		logs.Errorf("list config references failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(refs) > 0 {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"%s is referenced by %d configs, please remove the references first", name, len(refs)))
+	}
+
+	return s.dao.ConfigReference().DeleteByAppIDWithTx(kt, tx, bizID, appID)
+}
@@ -0,0 +1,476 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbcshare "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-share"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateConfigShare share a config item or kv of an app to the other bizs.
+func (s *Service) CreateConfigShare(ctx context.Context, req *pbds.CreateConfigShareReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.Attachment.BizId, req.Attachment.AppId)
+	if err != nil {
+		logs.Errorf("get app (%d) failed, err: %v, rid: %s", req.Attachment.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	if _, err = s.dao.ConfigShare().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config share name %s already exists",
+			req.Spec.Name))
+	}
+
+	spec := req.Spec.ConfigShareSpec()
+	spec.ConfigType = app.Spec.ConfigType
+	switch spec.ConfigType {
+	case table.File:
+		ci, e := s.dao.ConfigItem().Get(kt, spec.ConfigItemID, app.BizID)
+		if e != nil || ci.Attachment.AppID != app.ID {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config item %d is not found in app %s",
+				spec.ConfigItemID, app.Spec.Name))
+		}
+	case table.KV:
+		if _, e := s.dao.Kv().GetByKvState(kt, app.BizID, app.ID, spec.Key, []string{
+			string(table.KvStateAdd), string(table.KvStateRevise), string(table.KvStateUnchange)}); e != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv %s is not found in app %s",
+				spec.Key, app.Spec.Name))
+		}
+	}
+
+	share := &table.ConfigShare{
+		Spec:       spec,
+		Attachment: req.Attachment.ConfigShareAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.ConfigShare().Create(kt, share)
+	if err != nil {
+		logs.Errorf("create config share failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateConfigShare update the config share's grantees and memo, the revoked bizs can not
+// reference it any more, but their existing releases are not affected.
+func (s *Service) UpdateConfigShare(ctx context.Context, req *pbds.UpdateConfigShareReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	share, err := s.dao.ConfigShare().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get config share (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	share.Spec.Grantees = req.Grantees
+	share.Spec.Memo = req.Memo
+	share.Revision.Reviser = kt.User
+	if err = s.dao.ConfigShare().Update(kt, share); err != nil {
+		logs.Errorf("update config share failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteConfigShare delete the config share and all the references to it.
+func (s *Service) DeleteConfigShare(ctx context.Context, req *pbds.DeleteConfigShareReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	tx := s.dao.GenQuery().Begin()
+	if err := s.dao.ConfigShareRef().DeleteByShareIDWithTx(kt, tx, req.Id); err != nil {
+		logs.Errorf("delete config share refs failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err := s.dao.ConfigShare().DeleteWithTx(kt, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete config share failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListConfigShares list the config shares owned by a biz or granted to a biz.
+func (s *Service) ListConfigShares(ctx context.Context, req *pbds.ListConfigSharesReq) (
+	*pbds.ListConfigSharesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListConfigSharesOption{
+		BizID:        req.BizId,
+		GranteeBizID: req.GranteeBizId,
+		AppID:        req.AppId,
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+	details, count, err := s.dao.ConfigShare().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListConfigSharesResp{
+		Count:   uint32(count),
+		Details: pbcshare.PbConfigShares(details),
+	}, nil
+}
+
+// CreateConfigShareRef reference a config share granted to the app's biz.
+func (s *Service) CreateConfigShareRef(ctx context.Context, req *pbds.CreateConfigShareRefReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app (%d) failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	shares, err := s.dao.ConfigShare().ListByIDs(kt, []uint32{req.ShareId})
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(shares) == 0 || !shares[0].Spec.Grantees.Has(req.BizId) {
+		return nil, errf.Errorf(errf.PermissionDenied, i18n.T(kt, "config share %d is not granted to biz %d",
+			req.ShareId, req.BizId))
+	}
+	share := shares[0]
+
+	if share.Spec.ConfigType != app.Spec.ConfigType {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the config type of config share %s is different from app %s", share.Spec.Name, app.Spec.Name))
+	}
+
+	if _, err = s.dao.ConfigShareRef().GetByShareID(kt, req.BizId, req.AppId, req.ShareId); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config share %s is already referenced",
+			share.Spec.Name))
+	}
+
+	ref := &table.ConfigShareRef{
+		Spec: &table.ConfigShareRefSpec{ShareID: share.ID},
+		Attachment: &table.ConfigShareRefAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.ConfigShareRef().Create(kt, ref, share.Spec.Name)
+	if err != nil {
+		logs.Errorf("create config share ref failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// DeleteConfigShareRef delete the app's reference to a config share.
+func (s *Service) DeleteConfigShareRef(ctx context.Context, req *pbds.DeleteConfigShareRefReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	tx := s.dao.GenQuery().Begin()
+	if err := s.dao.ConfigShareRef().DeleteWithTx(kt, tx, req.BizId, req.AppId, req.Id); err != nil {
+		logs.Errorf("delete config share ref failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListConfigShareRefs list the config shares referenced by the app.
+func (s *Service) ListConfigShareRefs(ctx context.Context, req *pbds.ListConfigShareRefsReq) (
+	*pbds.ListConfigShareRefsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	refs, shares, err := s.listAppConfigShareRefs(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*pbcshare.ConfigShareRef, 0, len(refs))
+	for _, ref := range refs {
+		details = append(details, pbcshare.PbConfigShareRef(ref, shares[ref.Spec.ShareID]))
+	}
+
+	return &pbds.ListConfigShareRefsResp{
+		Count:   uint32(len(details)),
+		Details: details,
+	}, nil
+}
+
+// listAppConfigShareRefs list the app's config share refs and the referenced config shares.
+func (s *Service) listAppConfigShareRefs(kt *kit.Kit, bizID, appID uint32) (
+	[]*table.ConfigShareRef, map[uint32]*table.ConfigShare, error) {
+
+	refs, err := s.dao.ConfigShareRef().ListByAppID(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list config share refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	ids := make([]uint32, 0, len(refs))
+	for _, ref := range refs {
+		ids = append(ids, ref.Spec.ShareID)
+	}
+	list, err := s.dao.ConfigShare().ListByIDs(kt, ids)
+	if err != nil {
+		logs.Errorf("list config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, nil, err
+	}
+
+	shares := make(map[uint32]*table.ConfigShare, len(list))
+	for _, one := range list {
+		shares[one.ID] = one
+	}
+
+	return refs, shares, nil
+}
+
+// getGrantedConfigShares get the config shares referenced by the app which are still granted to its biz.
+func (s *Service) getGrantedConfigShares(kt *kit.Kit, bizID, appID uint32) ([]*table.ConfigShare, error) {
+	refs, shares, err := s.listAppConfigShareRefs(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*table.ConfigShare, 0, len(refs))
+	for _, ref := range refs {
+		share, ok := shares[ref.Spec.ShareID]
+		if !ok || !share.Spec.Grantees.Has(bizID) {
+			return nil, errf.Errorf(errf.PermissionDenied, i18n.T(kt, "config share %d is not granted to biz %d",
+				ref.Spec.ShareID, bizID))
+		}
+		result = append(result, share)
+	}
+
+	return result, nil
+}
+
+// getSharedSourceRelease get the latest release of the config share's source app.
+func (s *Service) getSharedSourceRelease(kt *kit.Kit, share *table.ConfigShare) (*table.Release, error) {
+	release, err := s.dao.Release().GetReleaseLately(kt, share.Attachment.BizID, share.Attachment.AppID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the source app of config share %s has no release",
+				share.Spec.Name))
+		}
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// releaseSharedConfigItems add the latest released content of the app's referenced file config shares
+// to the release, exists is the path of the app's own config items which are used to check conflicts.
+func (s *Service) releaseSharedConfigItems(kt *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32,
+	exists map[string]bool) error {
+
+	shares, err := s.getGrantedConfigShares(kt, bizID, appID)
+	if err != nil {
+		return err
+	}
+
+	items := make([]*table.ReleasedConfigItem, 0, len(shares))
+	for _, share := range shares {
+		release, e := s.getSharedSourceRelease(kt, share)
+		if e != nil {
+			return e
+		}
+
+		rci, e := s.dao.ReleasedCI().Get(kt, share.Attachment.BizID, share.Attachment.AppID, release.ID,
+			share.Spec.ConfigItemID)
+		if e != nil {
+			logs.Errorf("get config share %s released config item failed, err: %v, rid: %s", share.Spec.Name, e,
+				kt.Rid)
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"the config item of config share %s is not in the latest release of the source app", share.Spec.Name))
+		}
+
+		fullPath := path.Join(rci.ConfigItemSpec.Path, rci.ConfigItemSpec.Name)
+		if exists[fullPath] {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config share %s conflicts with config item %s",
+				share.Spec.Name, fullPath))
+		}
+		exists[fullPath] = true
+
+		if e = s.syncSharedContent(kt, share.Attachment.BizID, rci.CommitSpec.Content.Signature); e != nil {
+			logs.Errorf("sync config share %s content failed, err: %v, rid: %s", share.Spec.Name, e, kt.Rid)
+			return e
+		}
+
+		rci.ID = 0
+		rci.ReleaseID = releaseID
+		rci.Attachment = &table.ConfigItemAttachment{BizID: bizID, AppID: appID}
+		rci.Revision = &table.Revision{Creator: kt.User, Reviser: kt.User}
+		items = append(items, rci)
+	}
+
+	return s.dao.ReleasedCI().BulkCreateWithTx(kt, tx, items)
+}
+
+// syncSharedContent copy the shared file content from the source biz's repository to the current biz.
+func (s *Service) syncSharedContent(kt *kit.Kit, srcBizID uint32, sign string) error {
+	dstKt := kt.GetKitForRepoCfg()
+	if _, err := s.repo.Metadata(dstKt, sign); err == nil {
+		return nil
+	} else if !errors.Is(err, errf.ErrFileContentNotFound) {
+		return err
+	}
+
+	srcKt := kt.GetKitForRepoCfg()
+	srcKt.BizID = srcBizID
+	body, _, err := s.repo.Download(srcKt, sign)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	_, err = s.repo.Upload(dstKt, sign, body)
+	return err
+}
+
+// releaseSharedKvs add the latest released value of the app's referenced kv config shares to the release.
+func (s *Service) releaseSharedKvs(kt *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error {
+	shares, err := s.getGrantedConfigShares(kt, bizID, appID)
+	if err != nil {
+		return err
+	}
+
+	rkvs := make([]*table.ReleasedKv, 0, len(shares))
+	for _, share := range shares {
+		if _, e := s.dao.Kv().GetByKvState(kt, bizID, appID, share.Spec.Key, []string{string(table.KvStateAdd),
+			string(table.KvStateRevise), string(table.KvStateUnchange)}); e == nil {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config share %s conflicts with kv %s",
+				share.Spec.Name, share.Spec.Key))
+		}
+
+		release, e := s.getSharedSourceRelease(kt, share)
+		if e != nil {
+			return e
+		}
+
+		rkv, e := s.dao.ReleasedKv().Get(kt, share.Attachment.BizID, share.Attachment.AppID, release.ID,
+			share.Spec.Key)
+		if e != nil {
+			logs.Errorf("get config share %s released kv failed, err: %v, rid: %s", share.Spec.Name, e, kt.Rid)
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"the kv of config share %s is not in the latest release of the source app", share.Spec.Name))
+		}
+
+		kvType, value, e := s.getReleasedKv(kt, share.Attachment.BizID, share.Attachment.AppID, rkv.Spec.Version,
+			release.ID, rkv.Spec.Key)
+		if e != nil {
+			logs.Errorf("get config share %s value from vault failed, err: %v, rid: %s", share.Spec.Name, e, kt.Rid)
+			return e
+		}
+
+		version, e := s.vault.CreateRKv(kt, &types.CreateReleasedKvOption{
+			BizID:     bizID,
+			AppID:     appID,
+			ReleaseID: releaseID,
+			Key:       rkv.Spec.Key,
+			Value:     value,
+			KvType:    kvType,
+		})
+		if e != nil {
+			logs.Errorf("create config share %s value in vault failed, err: %v, rid: %s", share.Spec.Name, e, kt.Rid)
+			return e
+		}
+
+		rkv.ID = 0
+		rkv.ReleaseID = releaseID
+		rkv.Attachment = &table.KvAttachment{BizID: bizID, AppID: appID}
+		rkv.Revision = &table.Revision{Creator: kt.User, Reviser: kt.User}
+		rkv.Spec.Version = uint32(version)
+		rkvs = append(rkvs, rkv)
+	}
+
+	return s.dao.ReleasedKv().BulkCreateWithTx(kt, tx, rkvs)
+}
+
+// deleteAppConfigShares delete the app's config share refs, and the config shares owned by the app with
+// all the references to them.
+func (s *Service) deleteAppConfigShares(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	if err := s.dao.ConfigShareRef().DeleteByAppIDWithTx(kt, tx, bizID, appID); err != nil {
+		logs.Errorf("delete app config share refs failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	shares, _, err := s.dao.ConfigShare().List(kt, &types.ListConfigSharesOption{
+		BizID: bizID,
+		AppID: appID,
+		Page:  &types.BasePage{All: true},
+	})
+	if err != nil {
+		logs.Errorf("list app config shares failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, share := range shares {
+		if err = s.dao.ConfigShareRef().DeleteByShareIDWithTx(kt, tx, share.ID); err != nil {
+			logs.Errorf("delete config share refs failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+		if err = s.dao.ConfigShare().DeleteWithTx(kt, tx, bizID, share.ID); err != nil {
+			logs.Errorf("delete config share failed, err: %v, rid: %s", err, kt.Rid)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// PauseConsumption pause the clients consuming the app or using the credential, feed server stops serving
+// the new releases until the consumption is resumed.
+func (s *Service) PauseConsumption(ctx context.Context, req *pbds.PauseConsumptionReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := validateConsumptionTarget(kt, req.AppId, req.CredentialId); err != nil {
+		return nil, err
+	}
+
+	pause := table.ConsumptionPause{
+		Mode:     table.PauseMode(req.Mode),
+		Message:  req.Message,
+		Operator: kt.User,
+		PausedAt: time.Now().UTC(),
+	}
+	if err := pause.Validate(kt); err != nil {
+		return nil, err
+	}
+
+	if req.AppId != 0 {
+		if err := s.dao.App().UpdatePause(kt, req.BizId, req.AppId, pause); err != nil {
+			logs.Errorf("pause app %d consumption failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+			return nil, err
+		}
+		return new(pbbase.EmptyResp), nil
+	}
+
+	if err := s.dao.Credential().UpdatePause(kt, req.BizId, req.CredentialId, pause); err != nil {
+		logs.Errorf("pause credential %d consumption failed, err: %v, rid: %s", req.CredentialId, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ResumeConsumption resume the clients consuming the app or using the credential.
+func (s *Service) ResumeConsumption(ctx context.Context, req *pbds.ResumeConsumptionReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := validateConsumptionTarget(kt, req.AppId, req.CredentialId); err != nil {
+		return nil, err
+	}
+
+	if req.CredentialId != 0 {
+		if err := s.dao.Credential().UpdatePause(kt, req.BizId, req.CredentialId,
+			table.ConsumptionPause{}); err != nil {
+			logs.Errorf("resume credential %d consumption failed, err: %v, rid: %s", req.CredentialId, err, kt.Rid)
+			return nil, err
+		}
+		return new(pbbase.EmptyResp), nil
+	}
+
+	if err := s.dao.App().UpdatePause(kt, req.BizId, req.AppId, table.ConsumptionPause{}); err != nil {
+		logs.Errorf("resume app %d consumption failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	// 暂停期间的上线未通知到客户端, 恢复后按上线事件广播一次
+	if err := s.broadcastAppReleases(kt, req.BizId, req.AppId); err != nil {
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// validateConsumptionTarget validate only one of the app and the credential is specified.
+func validateConsumptionTarget(kt *kit.Kit, appID, credentialID uint32) error {
+	if (appID == 0) == (credentialID == 0) {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "only one of app id and credential id should be set"))
+	}
+
+	return nil
+}
+
+// broadcastAppReleases fire a publish event of the app, so that feed server notifies the clients
+// watching the app with their matched releases.
+func (s *Service) broadcastAppReleases(kt *kit.Kit, bizID, appID uint32) error {
+	tx := s.dao.GenQuery().Begin()
+
+	event := types.Event{
+		Spec: &table.EventSpec{
+			Resource: table.Publish,
+			OpType:   table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.CreatedRevision{Creator: kt.User},
+	}
+	if err := s.dao.Event().Eventf(kt).FireWithTx(tx, event); err != nil {
+		logs.Errorf("fire app %d publish event failed, err: %v, rid: %s", appID, err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
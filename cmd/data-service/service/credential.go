@@ -24,6 +24,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -69,22 +70,34 @@ func (s *Service) CreateCredential(ctx context.Context, req *pbds.CreateCredenti
 func (s *Service) ListCredentials(ctx context.Context, req *pbds.ListCredentialReq) (*pbds.ListCredentialResp, error) {
 	kt := kit.FromGrpcContext(ctx)
 
-	opt := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
-	if err := opt.Validate(types.DefaultPageOption); err != nil {
-		return nil, err
-	}
-
-	// StrToUint32Slice the comma separated string goes to uint32 slice
-	topIds, _ := tools.StrToUint32Slice(req.TopIds)
+	var (
+		details    []*table.Credential
+		count      int64
+		nextCursor string
+		err        error
+	)
+	if page := req.CursorPage.CursorPage(); page != nil {
+		if err = page.Validate(types.CredentialCursorOption); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+		}
+		details, nextCursor, err = s.dao.Credential().ListWithCursor(kt, req.BizId, page)
+	} else {
+		opt := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
+		if err = opt.Validate(types.DefaultPageOption); err != nil {
+			return nil, err
+		}
 
-	details, count, err := s.dao.Credential().List(kt, req.BizId, req.SearchKey, opt, topIds,
-		req.EncCredential, req.Enable)
+		// StrToUint32Slice the comma separated string goes to uint32 slice
+		topIds, _ := tools.StrToUint32Slice(req.TopIds)
+		details, count, err = s.dao.Credential().List(kt, req.BizId, req.SearchKey, opt, topIds,
+			req.EncCredential, req.Enable)
+	}
 	if err != nil {
 		logs.Errorf("list credential failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
 	}
 	credentialScopes := map[uint32][]string{}
-	if count > 0 {
+	if len(details) > 0 {
 		credentialID := []uint32{}
 		for _, v := range details {
 			credentialID = append(credentialID, v.ID)
@@ -111,8 +124,9 @@ func (s *Service) ListCredentials(ctx context.Context, req *pbds.ListCredentialR
 	}
 
 	resp := &pbds.ListCredentialResp{
-		Count:   uint32(count),
-		Details: data,
+		Count:      uint32(count),
+		Details:    data,
+		NextCursor: nextCursor,
 	}
 	return resp, nil
 }
@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crontab
+
+import (
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/scheduler"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// expireEmergencyPublishBatchSize is the max count of emergency publishes expired in one round.
+	expireEmergencyPublishBatchSize = 100
+	// expireAppRoleBindingBatchSize is the max count of app role bindings expired in one round.
+	expireAppRoleBindingBatchSize = 100
+	// purgeRecycleBinBatchSize is the max count of recycle bin items purged in one round.
+	purgeRecycleBinBatchSize = 500
+	// cleanIdempotencyRecordBatchSize is the max count of idempotency records deleted in one round.
+	cleanIdempotencyRecordBatchSize = 1000
+	// failInterruptedOperationBatchSize is the max count of operations processed in one round.
+	failInterruptedOperationBatchSize = 100
+	// expireReleasedKvBatchSize is the max count of released kvs expired in one round.
+	expireReleasedKvBatchSize = 500
+)
+
+// RegisterJobs register the data service's background jobs to the scheduler, the jobs only run on the leader.
+func RegisterJobs(sch *scheduler.Scheduler, srv *service.Service) error {
+	jobs := []scheduler.Job{
+		// 过期的紧急发布回到主线版本
+		{
+			Name: "expire-emergency-publish",
+			Spec: "@every 30s",
+			Desc: "revert the expired emergency publishes to the mainline release",
+			Run: countJob("expired %d emergency publishes", func(kt *kit.Kit) (int, error) {
+				return srv.ExpireEmergencyPublishes(kt, expireEmergencyPublishBatchSize)
+			}),
+		},
+		// 过期的kv通知客户端重新拉取, 过期后不再下发或下发兜底值
+		{
+			Name: "expire-released-kv",
+			Spec: "@every 30s",
+			Desc: "fire the expiry events of the expired kvs to notify the instances",
+			Run: countJob("expired %d released kvs", func(kt *kit.Kit) (int, error) {
+				return srv.ExpireReleasedKvs(kt, expireReleasedKvBatchSize)
+			}),
+		},
+		// 回收过期的临时服务角色授权
+		{
+			Name: "expire-app-role-binding",
+			Spec: "@every 1m",
+			Desc: "revoke the expired temporary app role bindings",
+			Run: countJob("expired %d app role bindings", func(kt *kit.Kit) (int, error) {
+				return srv.ExpireAppRoleBindings(kt, expireAppRoleBindingBatchSize)
+			}),
+		},
+		// 清理超过保留期的幂等请求记录
+		{
+			Name: "clean-idempotency-record",
+			Spec: "*/10 * * * *",
+			Desc: "delete the idempotency records out of the retention window",
+			Run: countJob("cleaned %d expired idempotency records", func(kt *kit.Kit) (int, error) {
+				count, err := srv.CleanExpiredIdempotencyRecords(kt, cleanIdempotencyRecordBatchSize)
+				return int(count), err
+			}),
+		},
+		// 将失去心跳的异步操作标记为失败, 并清理过期的异步操作
+		{
+			Name: "fail-interrupted-operation",
+			Spec: "@every 1m",
+			Desc: "mark the async operations without heartbeat as failed and delete the expired operations",
+			Run: countJob("marked %d interrupted operations as failed", func(kt *kit.Kit) (int, error) {
+				return srv.FailInterruptedOperations(kt, failInterruptedOperationBatchSize)
+			}),
+		},
+		// 清理回收站中超过保留期的资源
+		{
+			Name: "purge-recycle-bin",
+			Spec: "0 * * * *",
+			Desc: "purge the resources out of the recycle bin retention",
+			Run: countJob("purged %d expired resources from recycle bin", func(kt *kit.Kit) (int, error) {
+				return srv.PurgeExpiredRecycleBin(kt, purgeRecycleBinBatchSize)
+			}),
+		},
+		// 清理超过保留期的服务消费统计
+		{
+			Name: "purge-app-consumption",
+			Spec: "0 */6 * * *",
+			Desc: "purge the app consumption stats out of the retention",
+			Run:  srv.PurgeExpiredAppConsumption,
+		},
+		// 汇总业务每月的用量，用于成本核算
+		{
+			Name: "aggregate-biz-usage",
+			Spec: "30 * * * *",
+			Desc: "aggregate the monthly usage of each biz for cost accounting",
+			Run:  srv.AggregateBizUsage,
+		},
+		// 检测告警规则，超过阈值时发送到通知渠道
+		{
+			Name: "evaluate-alert-rules",
+			Spec: "@every 1m",
+			Desc: "evaluate the alert rules on the platform metrics and fire to the notification channels",
+			Run:  countJob("fired %d alerts", srv.EvaluateAlertRules),
+		},
+		// 扫描证书，即将过期时告警并自动续期
+		{
+			Name: "scan-certificates",
+			Spec: fmt.Sprintf("@every %ds", cc.DataService().Certificate.ScanIntervalSec),
+			Desc: "scan the certificates in configs, warn or renew the expiring ones",
+			Run:  countJob("warned %d expiring certificates", srv.ScanCertificates),
+		},
+	}
+
+	// 检测长期未被消费的服务、配置文件和密钥，通知负责人并按需自动归档
+	if stale := cc.DataService().Stale; stale.Enabled {
+		jobs = append(jobs, scheduler.Job{
+			Name: "scan-stale-resources",
+			Spec: fmt.Sprintf("@every %ds", stale.ScanIntervalSec),
+			Desc: "detect the stale apps, config items and credentials, notify the owners and archive them",
+			Run:  countJob("detected %d stale resources", srv.ScanStaleResources),
+		})
+	}
+
+	for _, job := range jobs {
+		if err := sch.Register(job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countJob wraps the job which returns the count of the processed resources, logs the count if it is not zero.
+func countJob(format string, run func(kt *kit.Kit) (int, error)) func(kt *kit.Kit) error {
+	return func(kt *kit.Kit) error {
+		count, err := run(kt)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			logs.Infof(format+", rid: %s", count, kt.Rid)
+		}
+		return nil
+	}
+}
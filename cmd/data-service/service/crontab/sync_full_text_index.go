@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package crontab
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/data-service/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// NewSyncFullTextIndex init sync full-text index
+func NewSyncFullTextIndex(sd serviced.Service, srv *service.Service) SyncFullTextIndex {
+	return SyncFullTextIndex{
+		state: sd,
+		srv:   srv,
+	}
+}
+
+// SyncFullTextIndex syncs the full-text index with the unreleased config items and kvs periodically.
+type SyncFullTextIndex struct {
+	state serviced.Service
+	srv   *service.Service
+}
+
+// Run the sync full-text index task
+func (c *SyncFullTextIndex) Run() {
+	if !cc.DataService().FullText.Enabled {
+		logs.Infof("full-text search is disabled, skip sync full-text index task")
+		return
+	}
+
+	logs.Infof("start sync full-text index task")
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(time.Duration(cc.DataService().FullText.IndexIntervalSec) * time.Second)
+		defer ticker.Stop()
+		for {
+			kt := kit.New()
+			kt.User = constant.BKSystemUser
+			ctx, cancel := context.WithCancel(kt.Ctx)
+			kt.Ctx = ctx
+
+			select {
+			case <-notifier.Signal:
+				logs.Infof("stop sync full-text index success")
+				cancel()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				if !c.state.IsMaster() {
+					logs.V(2).Infof("current service instance is slave, skip sync full-text index")
+					cancel()
+					continue
+				}
+				count, err := c.srv.SyncFullTextIndex(kt)
+				if err != nil {
+					logs.Errorf("sync full-text index failed, err: %v, rid: %s", err, kt.Rid)
+				} else if count > 0 {
+					logs.Infof("synced %d full-text docs, rid: %s", count, kt.Rid)
+				}
+				cancel()
+			}
+		}
+	}()
+}
@@ -0,0 +1,491 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbdraft "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/draft"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// UpsertDraftItem add an edit to the current user's draft of the app.
+func (s *Service) UpsertDraftItem(ctx context.Context, req *pbds.UpsertDraftItemReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	item := req.Item.DraftItem()
+	if item == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "draft item is required"))
+	}
+	if (app.Spec.ConfigType == table.KV) != (item.Kv != nil) {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "draft item does not match the app config type %s",
+			app.Spec.ConfigType))
+	}
+
+	// 未指定基准版本时以共享编辑态的当前版本为基准
+	if item.BaseRevision == "" {
+		if item.BaseRevision, err = s.currentDraftBaseRevision(kt, req.BizId, req.AppId, item); err != nil {
+			logs.Errorf("get draft item base revision failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	draft, err := s.getOrInitDraft(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+	draft.Spec.Items = draft.Spec.Items.Upsert(item)
+	draft.Revision.Reviser = kt.User
+	if err = s.dao.Draft().Upsert(kt, draft); err != nil {
+		logs.Errorf("upsert draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "upsert draft failed, err: %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteDraftItem remove an edit from the current user's draft of the app.
+func (s *Service) DeleteDraftItem(ctx context.Context, req *pbds.DeleteDraftItemReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	draft, err := s.dao.Draft().Get(kt, req.BizId, req.AppId, kt.User)
+	if err != nil {
+		logs.Errorf("get draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	draft.Spec.Items = draft.Spec.Items.Remove(req.Key)
+	draft.Revision.Reviser = kt.User
+	if err = s.dao.Draft().Upsert(kt, draft); err != nil {
+		logs.Errorf("update draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetDraft get the current user's draft of the app, with the diff against the app's shared unreleased state.
+func (s *Service) GetDraft(ctx context.Context, req *pbds.GetDraftReq) (*pbdraft.Draft, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	draft, err := s.getOrInitDraft(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := pbdraft.PbDraft(draft)
+	for idx, item := range draft.Spec.Items {
+		diff, err := s.diffDraftItem(kt, req.BizId, req.AppId, item)
+		if err != nil {
+			logs.Errorf("diff draft item %s failed, err: %v, rid: %s", item.Key(), err, kt.Rid)
+			return nil, err
+		}
+		result.Spec.Items[idx].Status = string(diff.status)
+		result.Spec.Items[idx].BaseSignature = diff.baseSignature
+		result.Spec.Items[idx].BaseValue = diff.baseValue
+	}
+
+	return result, nil
+}
+
+// MergeDraft merge the current user's draft into the app's shared unreleased state, the draft is
+// removed after merged. it's rejected if any resource has been changed by others after it's edited in draft.
+func (s *Service) MergeDraft(ctx context.Context, req *pbds.MergeDraftReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	draft, err := s.dao.Draft().Get(kt, req.BizId, req.AppId, kt.User)
+	if err != nil {
+		logs.Errorf("get draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	diffs := make([]*draftItemDiff, 0, len(draft.Spec.Items))
+	conflicts := make([]string, 0)
+	for _, item := range draft.Spec.Items {
+		diff, err := s.diffDraftItem(kt, req.BizId, req.AppId, item)
+		if err != nil {
+			logs.Errorf("diff draft item %s failed, err: %v, rid: %s", item.Key(), err, kt.Rid)
+			return nil, err
+		}
+		if diff.status == table.DraftItemConflict {
+			conflicts = append(conflicts, item.Key())
+		}
+		diffs = append(diffs, diff)
+	}
+	if len(conflicts) != 0 {
+		return nil, errf.Errorf(errf.Aborted, i18n.T(kt,
+			"%s have been modified by others after edited in draft, please resolve the conflicts first",
+			strings.Join(conflicts, ", ")))
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	for _, diff := range diffs {
+		if diff.item.Kv != nil {
+			err = s.mergeDraftKv(kt, tx, app, diff)
+		} else {
+			err = s.mergeDraftConfigItem(kt, tx, req.BizId, req.AppId, diff)
+		}
+		if err != nil {
+			break
+		}
+	}
+	if err == nil && app.Spec.ConfigType == table.File {
+		err = s.dao.ConfigItem().ValidateAppCINumber(kt, tx, req.BizId, req.AppId)
+	}
+	if err == nil {
+		err = s.dao.Draft().DeleteWithTx(kt, tx, req.BizId, draft.ID)
+	}
+	if err != nil {
+		logs.Errorf("merge draft failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DiscardDraft discard the current user's draft of the app.
+func (s *Service) DiscardDraft(ctx context.Context, req *pbds.DiscardDraftReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	draft, err := s.dao.Draft().Get(kt, req.BizId, req.AppId, kt.User)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return new(pbbase.EmptyResp), nil
+		}
+		logs.Errorf("get draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err = s.dao.Draft().Delete(kt, req.BizId, draft.ID); err != nil {
+		logs.Errorf("delete draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// getOrInitDraft get the current user's draft of the app, init an empty one if not exists.
+func (s *Service) getOrInitDraft(kt *kit.Kit, bizID, appID uint32) (*table.Draft, error) {
+	draft, err := s.dao.Draft().Get(kt, bizID, appID, kt.User)
+	if err == nil {
+		return draft, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get draft failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &table.Draft{
+		Spec:       &table.DraftSpec{Items: make(table.DraftItems, 0)},
+		Attachment: &table.DraftAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}, nil
+}
+
+// currentDraftBaseRevision returns the update time of the shared resource, empty if it not exists.
+func (s *Service) currentDraftBaseRevision(kt *kit.Kit, bizID, appID uint32, item *table.DraftItem) (
+	string, error) {
+
+	if item.Kv != nil {
+		kv, err := s.getEditingKv(kt, bizID, appID, item.Kv.Key)
+		if err != nil || kv == nil {
+			return "", err
+		}
+		return kv.Revision.RevisionString(), nil
+	}
+
+	if item.ConfigItemID == 0 {
+		return "", nil
+	}
+	ci, err := s.dao.ConfigItem().Get(kt, item.ConfigItemID, bizID)
+	if err != nil {
+		return "", err
+	}
+	if ci.Attachment.AppID != appID {
+		return "", errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config item %d is not in app %d",
+			item.ConfigItemID, appID))
+	}
+
+	return ci.Revision.RevisionString(), nil
+}
+
+// getEditingKv get the unreleased kv which is not deleted, nil if not exists.
+func (s *Service) getEditingKv(kt *kit.Kit, bizID, appID uint32, key string) (*table.Kv, error) {
+	kv, err := s.dao.Kv().GetByKvState(kt, bizID, appID, key, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange)})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return kv, nil
+}
+
+// draftItemDiff is the diff of a draft item against the app's shared unreleased state.
+type draftItemDiff struct {
+	item          *table.DraftItem
+	status        table.DraftItemStatus
+	baseSignature string
+	baseValue     string
+	// the shared resource which is edited, nil if it not exists.
+	ci *table.ConfigItem
+	kv *table.Kv
+}
+
+// diffDraftItem compare the draft item with the app's shared unreleased state.
+func (s *Service) diffDraftItem(kt *kit.Kit, bizID, appID uint32, item *table.DraftItem) (*draftItemDiff, error) {
+	diff := &draftItemDiff{item: item}
+
+	var current *table.Revision
+	if item.Kv != nil {
+		kv, err := s.getEditingKv(kt, bizID, appID, item.Kv.Key)
+		if err != nil {
+			return nil, err
+		}
+		if kv != nil {
+			diff.kv, current = kv, kv.Revision
+		}
+		// 隐藏值的密钥不返回当前值
+		if kv != nil && !kv.Spec.SecretHidden {
+			_, diff.baseValue, err = s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+				BizID:   bizID,
+				AppID:   appID,
+				Key:     kv.Spec.Key,
+				Version: int(kv.Spec.Version),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		ci, err := s.getDraftBaseConfigItem(kt, bizID, appID, item)
+		if err != nil {
+			return nil, err
+		}
+		if ci != nil {
+			diff.ci, current = ci, ci.Revision
+			commit, err := s.dao.Commit().GetLatestCommit(kt, bizID, appID, ci.ID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			if commit != nil {
+				diff.baseSignature = commit.Spec.Content.Signature
+			}
+		}
+	}
+
+	// 共享编辑态在加入草稿后被他人新增、修改或删除时为冲突
+	switch {
+	case current == nil && item.BaseRevision == "" && item.Action == table.DraftUpsert:
+		diff.status = table.DraftItemAdd
+	case current == nil || item.BaseRevision == "":
+		diff.status = table.DraftItemConflict
+	default:
+		match, err := current.MatchRevision(item.BaseRevision)
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			diff.status = table.DraftItemConflict
+		} else if item.Action == table.DraftDelete {
+			diff.status = table.DraftItemDelete
+		} else {
+			diff.status = table.DraftItemRevise
+		}
+	}
+
+	return diff, nil
+}
+
+// getDraftBaseConfigItem get the shared config item edited by the draft item, nil if it not exists.
+func (s *Service) getDraftBaseConfigItem(kt *kit.Kit, bizID, appID uint32, item *table.DraftItem) (
+	*table.ConfigItem, error) {
+
+	var ci *table.ConfigItem
+	var err error
+	if item.ConfigItemID > 0 {
+		ci, err = s.dao.ConfigItem().Get(kt, item.ConfigItemID, bizID)
+	} else {
+		ci, err = s.dao.ConfigItem().GetByUniqueKey(kt, bizID, appID, item.ConfigItem.Name, item.ConfigItem.Path)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if ci.Attachment.AppID != appID {
+		return nil, nil
+	}
+
+	return ci, nil
+}
+
+// mergeDraftConfigItem apply the draft config item to the app's shared unreleased state.
+func (s *Service) mergeDraftConfigItem(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32,
+	diff *draftItemDiff) error {
+
+	item := diff.item
+	switch diff.status {
+	case table.DraftItemAdd:
+		id, err := s.dao.ConfigItem().CreateWithTx(kt, tx, &table.ConfigItem{
+			Spec:       item.ConfigItem,
+			Attachment: &table.ConfigItemAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+		})
+		if err != nil {
+			return err
+		}
+		return s.createCommitWithTx(kt, tx, bizID, appID, id, item.Content)
+
+	case table.DraftItemRevise:
+		ci := diff.ci
+		ci.Spec = item.ConfigItem
+		ci.Revision.Reviser = kt.User
+		if err := s.dao.ConfigItem().UpdateWithTx(kt, tx, ci); err != nil {
+			return err
+		}
+		if diff.baseSignature == item.Content.Signature {
+			return nil
+		}
+		return s.createCommitWithTx(kt, tx, bizID, appID, ci.ID, item.Content)
+
+	case table.DraftItemDelete:
+		ci := diff.ci
+		if err := s.unlinkConfigRefsWithTx(kt, tx, bizID, table.ConfigRefConfigItem, ci.ID,
+			path.Join(ci.Spec.Path, ci.Spec.Name)); err != nil {
+			return err
+		}
+		if err := s.dao.ConfigItem().DeleteWithTx(kt, tx, ci); err != nil {
+			return err
+		}
+		return s.recycleWithTx(kt, tx, table.RecycleConfigItem, ci.ID, bizID, appID,
+			path.Join(ci.Spec.Path, ci.Spec.Name), ci)
+	}
+
+	return nil
+}
+
+// mergeDraftKv apply the draft kv to the app's shared unreleased state.
+func (s *Service) mergeDraftKv(kt *kit.Kit, tx *gen.QueryTx, app *table.App, diff *draftItemDiff) error {
+	bizID, appID := app.BizID, app.ID
+	draftKv := diff.item.Kv
+
+	if diff.status == table.DraftItemDelete {
+		kv := diff.kv
+		if err := s.unlinkConfigRefsWithTx(kt, tx, bizID, table.ConfigRefKv, kv.ID, kv.Spec.Key); err != nil {
+			return err
+		}
+		if err := s.recycleWithTx(kt, tx, table.RecycleKv, kv.ID, bizID, appID, kv.Spec.Key, kv); err != nil {
+			return err
+		}
+		if kv.KvState == table.KvStateAdd {
+			return s.dao.Kv().DeleteWithTx(kt, tx, kv)
+		}
+		kv.KvState = table.KvStateDelete
+		kv.Revision.Reviser = kt.User
+		return s.dao.Kv().UpdateWithTx(kt, tx, kv)
+	}
+
+	kvType := draftKv.KvType
+	if diff.kv != nil {
+		kvType = diff.kv.Spec.KvType
+	} else {
+		if !checkKVTypeMatch(kvType, app.Spec.DataType) {
+			return errf.Errorf(errf.InvalidArgument,
+				i18n.T(kt, "kv type does not match the data type defined in the application"))
+		}
+		if err := s.checkKVConfigItemExceedsAppLimit(kt, bizID, appID, 1, 0); err != nil {
+			return err
+		}
+	}
+
+	version, err := s.vault.UpsertKv(kt, &types.UpsertKvOption{
+		BizID:  bizID,
+		AppID:  appID,
+		Key:    draftKv.Key,
+		Value:  draftKv.Value,
+		KvType: kvType,
+	})
+	if err != nil {
+		logs.Errorf("upsert kv %s to vault failed, err: %v, rid: %s", draftKv.Key, err, kt.Rid)
+		return err
+	}
+	content := &table.ContentSpec{
+		Signature: tools.SHA256(draftKv.Value),
+		Md5:       tools.MD5(draftKv.Value),
+		ByteSize:  uint64(len(draftKv.Value)),
+	}
+
+	if kv := diff.kv; kv != nil {
+		if kv.KvState == table.KvStateUnchange {
+			kv.KvState = table.KvStateRevise
+		}
+		kv.Spec.Version = uint32(version)
+		kv.Spec.Memo = draftKv.Memo
+		kv.Spec.SecretHidden = draftKv.SecretHidden
+		kv.ContentSpec = content
+		kv.Revision.Reviser = kt.User
+		return s.dao.Kv().UpdateWithTx(kt, tx, kv)
+	}
+
+	return s.dao.Kv().BatchCreateWithTx(kt, tx, []*table.Kv{{
+		KvState: table.KvStateAdd,
+		Spec: &table.KvSpec{
+			Key:          draftKv.Key,
+			Memo:         draftKv.Memo,
+			KvType:       kvType,
+			Version:      uint32(version),
+			SecretType:   draftKv.SecretType,
+			SecretHidden: draftKv.SecretHidden,
+		},
+		Attachment:  &table.KvAttachment{BizID: bizID, AppID: appID},
+		Revision:    &table.Revision{Creator: kt.User, Reviser: kt.User},
+		ContentSpec: content,
+	}})
+}
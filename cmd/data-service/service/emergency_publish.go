@@ -0,0 +1,318 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbep "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/emergency-publish"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// EmergencyPublish publish a release to an explicit list of instances temporarily, a temporary group
+// which selects these instances is created and published, it expires back to the mainline release
+// after the ttl.
+// nolint: funlen
+func (s *Service) EmergencyPublish(ctx context.Context, req *pbds.EmergencyPublishReq) (
+	*pbds.EmergencyPublishResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	uids, err := validateEmergencyPublishReq(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.dao.Release().Get(kt, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		return nil, err
+	}
+	if release.Spec.Deprecated {
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(kt, "release %s is deprecated, can not be submited", release.Spec.Name))
+	}
+
+	isRollback := true
+	tx := s.dao.GenQuery().Begin()
+	defer func() {
+		if isRollback {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+		}
+	}()
+
+	// 1. create a temporary group which selects the instances.
+	now := time.Now()
+	group := &table.Group{
+		Spec: &table.GroupSpec{
+			Name: fmt.Sprintf("emergency_%d_%s", req.AppId,
+				strings.ReplaceAll(now.Format("20060102150405.000"), ".", "")),
+			Public:   false,
+			Mode:     table.GroupModeCustom,
+			Selector: &selector.Selector{Instances: uids},
+		},
+		Attachment: &table.GroupAttachment{BizID: req.BizId},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	groupID, err := s.dao.Group().CreateWithTx(kt, tx, group)
+	if err != nil {
+		logs.Errorf("create emergency publish group failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err = s.dao.GroupAppBind().BatchCreateWithTx(kt, tx, []*table.GroupAppBind{
+		{GroupID: groupID, AppID: req.AppId, BizID: req.BizId},
+	}); err != nil {
+		logs.Errorf("bind emergency publish group to app failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 2. publish the release to the temporary group immediately, it needs no approval.
+	memo := req.Memo
+	if memo == "" {
+		memo = "emergency publish"
+	}
+	stgID, err := s.dao.Publish().SubmitWithTx(kt, tx, &types.PublishOption{
+		BizID:         req.BizId,
+		AppID:         req.AppId,
+		ReleaseID:     req.ReleaseId,
+		Memo:          memo,
+		Groups:        []uint32{groupID},
+		Revision:      &table.CreatedRevision{Creator: kt.User},
+		PublishType:   table.Immediately,
+		PublishStatus: table.AlreadyPublish,
+		PubState:      string(table.Publishing),
+		ApproveType:   string(app.Spec.ApproveType),
+	})
+	if err != nil {
+		logs.Errorf("emergency publish release failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 3. record the emergency publish, so that it can be expired later.
+	ep := &table.EmergencyPublish{
+		Spec: &table.EmergencyPublishSpec{
+			ReleaseID: req.ReleaseId,
+			GroupID:   groupID,
+			UIDs:      uids,
+			ExpiredAt: now.Add(time.Duration(req.TtlSeconds) * time.Second),
+			State:     table.EmergencyPublishActive,
+			Memo:      req.Memo,
+		},
+		Attachment: &table.EmergencyPublishAttachment{BizID: req.BizId, AppID: req.AppId},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	id, err := s.dao.EmergencyPublish().CreateWithTx(kt, tx, ep, release.Spec.Name)
+	if err != nil {
+		logs.Errorf("create emergency publish failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	isRollback = false
+
+	return &pbds.EmergencyPublishResp{Id: id, GroupId: groupID, StrategyId: stgID}, nil
+}
+
+// validateEmergencyPublishReq validate the emergency publish request, and returns the deduplicated uids.
+func validateEmergencyPublishReq(kt *kit.Kit, req *pbds.EmergencyPublishReq) ([]string, error) {
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl < table.MinEmergencyPublishTTL || ttl > table.MaxEmergencyPublishTTL {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "ttl should be in range [%d, %d] seconds",
+			int(table.MinEmergencyPublishTTL.Seconds()), int(table.MaxEmergencyPublishTTL.Seconds())))
+	}
+
+	uids := make([]string, 0, len(req.Uids))
+	exists := make(map[string]struct{}, len(req.Uids))
+	for _, uid := range req.Uids {
+		uid = strings.TrimSpace(uid)
+		if _, ok := exists[uid]; ok {
+			continue
+		}
+		if err := validator.ValidateUidLength(uid); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid uid %s, %v", uid, err))
+		}
+		exists[uid] = struct{}{}
+		uids = append(uids, uid)
+	}
+
+	if len(uids) == 0 || len(uids) > table.MaxEmergencyPublishUIDs {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "uids count should be in range [1, %d]",
+			table.MaxEmergencyPublishUIDs))
+	}
+
+	return uids, nil
+}
+
+// ListEmergencyPublishes list emergency publishes of an app.
+func (s *Service) ListEmergencyPublishes(ctx context.Context, req *pbds.ListEmergencyPublishesReq) (
+	*pbds.ListEmergencyPublishesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	state := table.EmergencyPublishState(req.State)
+	if state != "" {
+		if err := state.Validate(); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+		}
+	}
+
+	page := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
+	if err := page.Validate(types.DefaultPageOption); err != nil {
+		return nil, err
+	}
+
+	details, count, err := s.dao.EmergencyPublish().List(kt, req.BizId, req.AppId, state, page)
+	if err != nil {
+		logs.Errorf("list emergency publishes failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListEmergencyPublishesResp{
+		Count:   uint32(count),
+		Details: pbep.PbEmergencyPublishes(details),
+	}, nil
+}
+
+// RevokeEmergencyPublish revoke an active emergency publish before it's expired,
+// the instances fall back to the mainline release.
+func (s *Service) RevokeEmergencyPublish(ctx context.Context, req *pbds.RevokeEmergencyPublishReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	ep, err := s.dao.EmergencyPublish().Get(kt, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, i18n.T(kt, "emergency publish %d not found", req.Id))
+		}
+		return nil, err
+	}
+
+	if ep.Spec.State != table.EmergencyPublishActive {
+		return nil, errf.Errorf(errf.InvalidArgument,
+			i18n.T(kt, "emergency publish %d is %s, can not be revoked", req.Id, ep.Spec.State))
+	}
+
+	if err := s.finishEmergencyPublish(kt, ep, table.EmergencyPublishRevoked); err != nil {
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ExpireEmergencyPublishes expire the active emergency publishes which have reached their ttl.
+// it returns the count of expired emergency publishes.
+func (s *Service) ExpireEmergencyPublishes(kt *kit.Kit, limit int) (int, error) {
+	list, err := s.dao.EmergencyPublish().ListExpired(kt, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, ep := range list {
+		if err := s.finishEmergencyPublish(kt, ep, table.EmergencyPublishExpired); err != nil {
+			logs.Errorf("expire emergency publish %d failed, err: %v, rid: %s", ep.ID, err, kt.Rid)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// finishEmergencyPublish stop an emergency publish, the temporary group is unpublished and deleted,
+// and a publish event is fired to notify the instances to fall back to the mainline release.
+func (s *Service) finishEmergencyPublish(kt *kit.Kit, ep *table.EmergencyPublish,
+	state table.EmergencyPublishState) error {
+
+	bizID, appID, groupID := ep.Attachment.BizID, ep.Attachment.AppID, ep.Spec.GroupID
+
+	releaseName := strconv.FormatUint(uint64(ep.Spec.ReleaseID), 10)
+	if release, err := s.dao.Release().Get(kt, bizID, appID, ep.Spec.ReleaseID); err == nil {
+		releaseName = release.Spec.Name
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	rollback := func(err error) error {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err := s.dao.ReleasedGroup().DeleteByGroupIDWithTx(kt, tx, appID, groupID, bizID); err != nil {
+		logs.Errorf("delete emergency publish released group failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	err := s.dao.Group().DeleteWithTx(kt, tx, &table.Group{ID: groupID, Attachment: &table.GroupAttachment{BizID: bizID}})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("delete emergency publish group failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	if err = s.dao.GroupAppBind().BatchDeleteByGroupIDWithTx(kt, tx, groupID, bizID); err != nil {
+		logs.Errorf("delete emergency publish group app bind failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	if err = s.dao.EmergencyPublish().UpdateStateWithTx(kt, tx, ep, state, releaseName); err != nil {
+		logs.Errorf("update emergency publish state failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	// notify the instances to match release again, they fall back to the mainline release.
+	event := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Publish,
+			ResourceID: ep.Spec.ReleaseID,
+			OpType:     table.DeleteOp,
+		},
+		Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.CreatedRevision{Creator: kt.User},
+	}
+	if err = s.dao.Event().Eventf(kt).FireWithTx(tx, event); err != nil {
+		logs.Errorf("fire emergency publish finished event failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,515 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbenv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/environment"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateEnvironment create environment.
+func (s *Service) CreateEnvironment(ctx context.Context, req *pbds.CreateEnvironmentReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	envs, err := s.dao.Environment().List(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("list environments failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(envs) >= table.MaxEnvironmentsPerBiz {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the number of environments exceeds the limit %d",
+			table.MaxEnvironmentsPerBiz))
+	}
+	for _, one := range envs {
+		if one.Spec.Name == req.Spec.Name {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "environment name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	env := &table.Environment{
+		Spec:       req.Spec.EnvironmentSpec(),
+		Attachment: req.Attachment.EnvironmentAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+
+	id, err := s.dao.Environment().Create(kt, env)
+	if err != nil {
+		logs.Errorf("create environment failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListEnvironments list all the environments of a biz.
+func (s *Service) ListEnvironments(ctx context.Context, req *pbds.ListEnvironmentsReq) (
+	*pbds.ListEnvironmentsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	envs, err := s.dao.Environment().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list environments failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListEnvironmentsResp{
+		Count:   uint32(len(envs)),
+		Details: pbenv.PbEnvironments(envs),
+	}, nil
+}
+
+// UpdateEnvironment update environment.
+func (s *Service) UpdateEnvironment(ctx context.Context, req *pbds.UpdateEnvironmentReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.Environment().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get environment (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if req.Spec.Name != old.Spec.Name {
+		if _, err = s.dao.Environment().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "environment name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	env := &table.Environment{
+		ID:         old.ID,
+		Spec:       req.Spec.EnvironmentSpec(),
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.Environment().Update(kt, env); err != nil {
+		logs.Errorf("update environment failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteEnvironment delete environment, the environment which has apps bound can not be deleted.
+func (s *Service) DeleteEnvironment(ctx context.Context, req *pbds.DeleteEnvironmentReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	count, err := s.dao.EnvAppBinding().CountByEnvID(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("count env apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"there are %d apps bound to the environment, please unbind them first", count))
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.Environment().DeleteWithTx(kt, tx, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete environment failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// BindEnvApp bind an app to an environment.
+func (s *Service) BindEnvApp(ctx context.Context, req *pbds.BindEnvAppReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.Environment().Get(kt, req.BizId, req.EnvId); err != nil {
+		logs.Errorf("get environment (%d) failed, err: %v, rid: %s", req.EnvId, err, kt.Rid)
+		return nil, err
+	}
+
+	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app (%d) failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+
+	if _, err = s.dao.EnvAppBinding().GetByAppID(kt, req.BizId, req.AppId); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "app %s is already bound to an environment",
+			app.Spec.Name))
+	}
+
+	// 服务标识为空时使用服务名称
+	appKey := req.AppKey
+	if appKey == "" {
+		appKey = app.Spec.Name
+	}
+	if _, err = s.dao.EnvAppBinding().GetByAppKey(kt, req.BizId, req.EnvId, appKey); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"app key %s already exists in the environment", appKey))
+	}
+
+	// 同一服务标识下的服务类型必须一致，否则无法晋级版本
+	peers, _, err := s.dao.EnvAppBinding().List(kt, &types.ListEnvAppsOption{
+		BizID:  req.BizId,
+		AppKey: appKey,
+		Page:   &types.BasePage{All: true},
+	})
+	if err != nil {
+		logs.Errorf("list env apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(peers) > 0 {
+		peer, e := s.dao.App().Get(kt, req.BizId, peers[0].Attachment.AppID)
+		if e != nil {
+			logs.Errorf("get app (%d) failed, err: %v, rid: %s", peers[0].Attachment.AppID, e, kt.Rid)
+			return nil, e
+		}
+		if peer.Spec.ConfigType != app.Spec.ConfigType {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"the config type of app %s is different from the other apps with app key %s", app.Spec.Name, appKey))
+		}
+	}
+
+	binding := &table.EnvAppBinding{
+		Spec: &table.EnvAppBindingSpec{
+			EnvID:  req.EnvId,
+			AppKey: appKey,
+		},
+		Attachment: &table.EnvAppBindingAttachment{
+			BizID: req.BizId,
+			AppID: req.AppId,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.EnvAppBinding().Create(kt, binding)
+	if err != nil {
+		logs.Errorf("create env app binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UnbindEnvApp unbind an app from its environment.
+func (s *Service) UnbindEnvApp(ctx context.Context, req *pbds.UnbindEnvAppReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.EnvAppBinding().GetByAppID(kt, req.BizId, req.AppId); err != nil {
+		logs.Errorf("get env app binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err := s.dao.EnvAppBinding().DeleteByAppIDWithTx(kt, tx, req.BizId, req.AppId); err != nil {
+		logs.Errorf("delete env app binding failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListEnvApps list env app bindings.
+func (s *Service) ListEnvApps(ctx context.Context, req *pbds.ListEnvAppsReq) (*pbds.ListEnvAppsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListEnvAppsOption{
+		BizID:  req.BizId,
+		EnvID:  req.EnvId,
+		AppID:  req.AppId,
+		AppKey: req.AppKey,
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+
+	details, count, err := s.dao.EnvAppBinding().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list env apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListEnvAppsResp{
+		Count:   uint32(count),
+		Details: pbenv.PbEnvAppBindings(details),
+	}, nil
+}
+
+// PromoteRelease promote a release to the app with the same app key in the target environment,
+// a new release with the same content is created in the target app, which can be published
+// with the target app's own strategies.
+func (s *Service) PromoteRelease(ctx context.Context, req *pbds.PromoteReleaseReq) (*pbds.PromoteReleaseResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	src, err := s.dao.EnvAppBinding().GetByAppID(kt, req.BizId, req.AppId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "app is not bound to any environment"))
+		}
+		logs.Errorf("get env app binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if src.Spec.EnvID == req.TargetEnvId {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the target environment is the same as the source"))
+	}
+
+	if _, err = s.dao.Environment().Get(kt, req.BizId, req.TargetEnvId); err != nil {
+		logs.Errorf("get environment (%d) failed, err: %v, rid: %s", req.TargetEnvId, err, kt.Rid)
+		return nil, err
+	}
+
+	dst, err := s.dao.EnvAppBinding().GetByAppKey(kt, req.BizId, req.TargetEnvId, src.Spec.AppKey)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+				"there is no app with app key %s in the target environment", src.Spec.AppKey))
+		}
+		logs.Errorf("get env app binding failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	srcApp, err := s.dao.App().Get(kt, req.BizId, req.AppId)
+	if err != nil {
+		logs.Errorf("get app (%d) failed, err: %v, rid: %s", req.AppId, err, kt.Rid)
+		return nil, err
+	}
+	dstApp, err := s.dao.App().Get(kt, req.BizId, dst.Attachment.AppID)
+	if err != nil {
+		logs.Errorf("get app (%d) failed, err: %v, rid: %s", dst.Attachment.AppID, err, kt.Rid)
+		return nil, err
+	}
+	if srcApp.Spec.ConfigType != dstApp.Spec.ConfigType {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the config type of app %s is different from app %s", srcApp.Spec.Name, dstApp.Spec.Name))
+	}
+
+	srcRelease, err := s.dao.Release().Get(kt, req.BizId, req.AppId, req.ReleaseId)
+	if err != nil {
+		logs.Errorf("get release (%d) failed, err: %v, rid: %s", req.ReleaseId, err, kt.Rid)
+		return nil, err
+	}
+
+	// 版本名称和描述为空时沿用源版本
+	name, memo := req.Name, req.Memo
+	if name == "" {
+		name = srcRelease.Spec.Name
+	}
+	if memo == "" {
+		memo = srcRelease.Spec.Memo
+	}
+	if _, err = s.dao.Release().GetByName(kt, req.BizId, dstApp.ID, name); err == nil {
+		return nil, errf.ErrReleaseNameExists.New(kt, name)
+	}
+	// 版本说明沿用源版本, 但需满足目标服务的版本说明策略
+	if err = dstApp.Spec.ReleaseNotesPolicy.ValidateNotes(kt, srcRelease.Spec.Notes); err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	release := &table.Release{
+		Spec: &table.ReleaseSpec{
+			Name:  name,
+			Memo:  memo,
+			Notes: srcRelease.Spec.Notes,
+		},
+		Attachment: &table.ReleaseAttachment{
+			BizID: req.BizId,
+			AppID: dstApp.ID,
+		},
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+	}
+	if _, err = s.dao.Release().CreateWithTx(kt, tx, release); err != nil {
+		logs.Errorf("create release failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = s.promoteReleaseContent(kt, tx, srcRelease, release, srcApp.Spec.ConfigType); err != nil {
+		logs.Errorf("promote release content failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.PromoteReleaseResp{AppId: dstApp.ID, ReleaseId: release.ID}, nil
+}
+
+// promoteReleaseContent copy the released hooks, config items and kvs of the source release to the target
+// release, the file contents are shared in the same biz so that only the metadata needs to be copied.
+func (s *Service) promoteReleaseContent(kt *kit.Kit, tx *gen.QueryTx, src, dst *table.Release,
+	configType table.ConfigType) error {
+	bizID, srcAppID, dstAppID := src.Attachment.BizID, src.Attachment.AppID, dst.Attachment.AppID
+
+	for _, tp := range []table.HookType{table.PreHook, table.PostHook} {
+		hook, err := s.dao.ReleasedHook().Get(kt, bizID, srcAppID, src.ID, tp)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			return err
+		}
+		hook.ID = 0
+		hook.AppID = dstAppID
+		hook.ReleaseID = dst.ID
+		hook.Reviser = kt.User
+		if _, err = s.dao.ReleasedHook().CreateWithTx(kt, tx, hook); err != nil {
+			return err
+		}
+	}
+
+	switch configType {
+	case table.File:
+		return s.promoteReleasedFiles(kt, tx, bizID, srcAppID, dstAppID, src.ID, dst.ID)
+	case table.KV:
+		return s.promoteReleasedKvs(kt, tx, bizID, dstAppID, src.ID, dst.ID)
+	}
+
+	return nil
+}
+
+func (s *Service) promoteReleasedFiles(kt *kit.Kit, tx *gen.QueryTx, bizID, srcAppID, dstAppID,
+	srcReleaseID, dstReleaseID uint32) error {
+
+	cis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(kt, []uint32{srcReleaseID}, bizID)
+	if err != nil {
+		return err
+	}
+	for _, ci := range cis {
+		ci.ID = 0
+		ci.ReleaseID = dstReleaseID
+		ci.Attachment.AppID = dstAppID
+	}
+	if err = s.dao.ReleasedCI().BulkCreateWithTx(kt, tx, cis); err != nil {
+		return err
+	}
+
+	tmpls, _, err := s.dao.ReleasedAppTemplate().List(kt, bizID, srcAppID, srcReleaseID, nil,
+		&types.BasePage{All: true}, "")
+	if err != nil {
+		return err
+	}
+	for _, tmpl := range tmpls {
+		tmpl.ID = 0
+		tmpl.Spec.ReleaseID = dstReleaseID
+		tmpl.Attachment.AppID = dstAppID
+	}
+	if err = s.dao.ReleasedAppTemplate().BulkCreateWithTx(kt, tx, tmpls); err != nil {
+		return err
+	}
+
+	variables, err := s.dao.ReleasedAppTemplateVariable().ListVariables(kt, bizID, srcAppID, srcReleaseID)
+	if err != nil {
+		return err
+	}
+	if len(variables) == 0 {
+		return nil
+	}
+	_, err = s.dao.ReleasedAppTemplateVariable().CreateWithTx(kt, tx, &table.ReleasedAppTemplateVariable{
+		Spec: &table.ReleasedAppTemplateVariableSpec{
+			ReleaseID: dstReleaseID,
+			Variables: variables,
+		},
+		Attachment: &table.ReleasedAppTemplateVariableAttachment{
+			BizID: bizID,
+			AppID: dstAppID,
+		},
+		Revision: &table.CreatedRevision{
+			Creator: kt.User,
+		},
+	})
+
+	return err
+}
+
+func (s *Service) promoteReleasedKvs(kt *kit.Kit, tx *gen.QueryTx, bizID, dstAppID, srcReleaseID,
+	dstReleaseID uint32) error {
+
+	rkvs, err := s.dao.ReleasedKv().ListAllByReleaseIDs(kt, []uint32{srcReleaseID}, bizID)
+	if err != nil {
+		return err
+	}
+
+	for _, rkv := range rkvs {
+		kvType, value, e := s.getReleasedKv(kt, bizID, rkv.Attachment.AppID, rkv.Spec.Version, srcReleaseID,
+			rkv.Spec.Key)
+		if e != nil {
+			logs.Errorf("get released kv %s from vault failed, err: %v, rid: %s", rkv.Spec.Key, e, kt.Rid)
+			return e
+		}
+
+		version, e := s.vault.CreateRKv(kt, &types.CreateReleasedKvOption{
+			BizID:     bizID,
+			AppID:     dstAppID,
+			ReleaseID: dstReleaseID,
+			Key:       rkv.Spec.Key,
+			Value:     value,
+			KvType:    kvType,
+		})
+		if e != nil {
+			logs.Errorf("create released kv %s in vault failed, err: %v, rid: %s", rkv.Spec.Key, e, kt.Rid)
+			return e
+		}
+
+		rkv.ID = 0
+		rkv.ReleaseID = dstReleaseID
+		rkv.Attachment.AppID = dstAppID
+		rkv.Spec.Version = uint32(version)
+	}
+
+	return s.dao.ReleasedKv().BulkCreateWithTx(kt, tx, rkvs)
+}
@@ -0,0 +1,268 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbexp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/experiment"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateExperiment create experiment.
+func (s *Service) CreateExperiment(ctx context.Context, req *pbds.CreateExperimentReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec, err := req.Spec.ExperimentSpec()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+	if req.Attachment == nil || spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "experiment spec is required"))
+	}
+	bizID, appID := req.Attachment.BizId, req.Attachment.AppId
+
+	if err = s.ensureAppWritable(kt, bizID, appID); err != nil {
+		return nil, err
+	}
+
+	list, err := s.dao.Experiment().List(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list experiments failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(list) >= table.MaxExperimentsPerApp {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the number of experiments exceeds the limit %d", table.MaxExperimentsPerApp))
+	}
+	for _, one := range list {
+		if one.Spec.Key == spec.Key {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv %s already has an experiment %s",
+				spec.Key, one.Spec.Name))
+		}
+	}
+
+	if err = s.validateExperimentKv(kt, bizID, appID, spec); err != nil {
+		return nil, err
+	}
+
+	experiment := &table.Experiment{
+		Spec:       spec,
+		Attachment: req.Attachment.ExperimentAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.Experiment().Create(kt, experiment)
+	if err != nil {
+		logs.Errorf("create experiment failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListExperiments list the experiments of an app.
+func (s *Service) ListExperiments(ctx context.Context, req *pbds.ListExperimentsReq) (
+	*pbds.ListExperimentsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	var list []*table.Experiment
+	var err error
+	if req.EnabledOnly {
+		list, err = s.dao.Experiment().ListEnabled(kt, req.BizId, req.AppId)
+	} else {
+		list, err = s.dao.Experiment().List(kt, req.BizId, req.AppId)
+	}
+	if err != nil {
+		logs.Errorf("list experiments failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details, err := pbexp.PbExperiments(list)
+	if err != nil {
+		logs.Errorf("convert experiments failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListExperimentsResp{
+		Count:   uint32(len(details)),
+		Details: details,
+	}, nil
+}
+
+// UpdateExperiment update experiment, the kv key can not be changed.
+func (s *Service) UpdateExperiment(ctx context.Context, req *pbds.UpdateExperimentReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec, err := req.Spec.ExperimentSpec()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+	if req.Attachment == nil || spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "experiment spec is required"))
+	}
+
+	old, err := s.dao.Experiment().Get(kt, req.Attachment.BizId, req.Attachment.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get experiment (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+	spec.Key = old.Spec.Key
+
+	if err = s.ensureAppWritable(kt, old.Attachment.BizID, old.Attachment.AppID); err != nil {
+		return nil, err
+	}
+
+	if err = s.validateExperimentKv(kt, old.Attachment.BizID, old.Attachment.AppID, spec); err != nil {
+		return nil, err
+	}
+
+	experiment := &table.Experiment{
+		ID:         old.ID,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.Experiment().Update(kt, experiment); err != nil {
+		logs.Errorf("update experiment failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteExperiment delete experiment with its exposures.
+func (s *Service) DeleteExperiment(ctx context.Context, req *pbds.DeleteExperimentReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.ensureAppWritable(kt, req.BizId, req.AppId); err != nil {
+		return nil, err
+	}
+
+	if err := s.dao.Experiment().Delete(kt, req.BizId, req.AppId, req.Id); err != nil {
+		logs.Errorf("delete experiment failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// GetExperimentStats get the exposure statistics of each variant of the experiment.
+func (s *Service) GetExperimentStats(ctx context.Context, req *pbds.GetExperimentStatsReq) (
+	*pbds.GetExperimentStatsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	experiment, err := s.dao.Experiment().Get(kt, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get experiment (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	stats, err := s.dao.ExperimentExposure().ListVariantStats(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("list experiment variant stats failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 没有曝光的分桶也返回, 便于对比
+	exposed := make(map[string]struct{}, len(stats))
+	for _, one := range stats {
+		exposed[one.Variant] = struct{}{}
+	}
+	for _, v := range experiment.Spec.Variants {
+		if _, ok := exposed[v.Name]; !ok {
+			stats = append(stats, &table.ExperimentVariantStat{Variant: v.Name})
+		}
+	}
+
+	return &pbds.GetExperimentStatsResp{Details: pbexp.PbExperimentVariantStats(stats)}, nil
+}
+
+// BatchUpsertExperimentExposures batch upsert the experiment exposures reported by clients.
+func (s *Service) BatchUpsertExperimentExposures(ctx context.Context, req *pbds.BatchUpsertExperimentExposuresReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	// 同一批上报中同一客户端的曝光合并为一条, 否则 upsert 会在同一语句中冲突
+	type exposureKey struct {
+		experimentID uint32
+		uid          string
+	}
+	merged := make(map[exposureKey]*table.ExperimentExposure)
+	items := make([]*table.ExperimentExposure, 0, len(req.Items))
+	for _, v := range req.Items {
+		one, err := v.ExperimentExposure()
+		if err != nil || one == nil {
+			logs.Warnf("skip invalid experiment exposure, err: %v, rid: %s", err, kt.Rid)
+			continue
+		}
+		if err = one.Validate(); err != nil {
+			logs.Warnf("skip invalid experiment exposure, err: %v, rid: %s", err, kt.Rid)
+			continue
+		}
+
+		key := exposureKey{experimentID: one.Attachment.ExperimentID, uid: one.Attachment.UID}
+		exist, ok := merged[key]
+		if !ok {
+			merged[key] = one
+			items = append(items, one)
+			continue
+		}
+		exist.Spec.Count += one.Spec.Count
+		if one.Spec.FirstExposedAt.Before(exist.Spec.FirstExposedAt) {
+			exist.Spec.FirstExposedAt = one.Spec.FirstExposedAt
+		}
+		if one.Spec.LastExposedAt.After(exist.Spec.LastExposedAt) {
+			exist.Spec.LastExposedAt = one.Spec.LastExposedAt
+			exist.Spec.Variant = one.Spec.Variant
+			exist.Spec.ReleaseID = one.Spec.ReleaseID
+		}
+	}
+
+	if err := s.dao.ExperimentExposure().BatchUpsert(kt, items); err != nil {
+		logs.Errorf("batch upsert experiment exposures failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// validateExperimentKv validate the experiment kv exists and the variants' value match the kv type.
+func (s *Service) validateExperimentKv(kt *kit.Kit, bizID, appID uint32, spec *table.ExperimentSpec) error {
+	kv, err := s.getEditingKv(kt, bizID, appID, spec.Key)
+	if err != nil {
+		logs.Errorf("get kv %s failed, err: %v, rid: %s", spec.Key, err, kt.Rid)
+		return err
+	}
+	if kv == nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv %s not exists", spec.Key))
+	}
+
+	if err := spec.ValidateKvType(kv.Spec.KvType); err != nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid experiment, err: %v", err))
+	}
+
+	return nil
+}
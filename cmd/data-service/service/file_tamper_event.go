@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbfte "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/file-tamper-event"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// BatchCreateFileTamperEvents batch create file tamper events reported by clients.
+func (s *Service) BatchCreateFileTamperEvents(ctx context.Context, req *pbds.BatchCreateFileTamperEventsReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	now := time.Now().UTC()
+	items := make([]*table.FileTamperEvent, 0, len(req.Items))
+	for _, v := range req.Items {
+		if v == nil || v.Spec == nil || v.Attachment == nil {
+			continue
+		}
+		spec := v.Spec.FileTamperEventSpec()
+		if len(spec.RepairError) > table.FileTamperRepairErrorMaxLen {
+			spec.RepairError = spec.RepairError[:table.FileTamperRepairErrorMaxLen]
+		}
+		spec.CreatedAt = now
+		items = append(items, &table.FileTamperEvent{
+			Spec:       spec,
+			Attachment: v.Attachment.FileTamperEventAttachment(),
+		})
+	}
+
+	if err := s.dao.FileTamperEvent().BatchCreate(kt, items); err != nil {
+		logs.Errorf("batch create file tamper events failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListFileTamperEvents list file tamper events.
+func (s *Service) ListFileTamperEvents(ctx context.Context, req *pbds.ListFileTamperEventsReq) (
+	*pbds.ListFileTamperEventsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListFileTamperEventsOption{
+		BizID:          req.BizId,
+		AppID:          req.AppId,
+		ReleaseID:      req.ReleaseId,
+		UID:            req.Uid,
+		Kind:           req.Kind,
+		UnrepairedOnly: req.UnrepairedOnly,
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+
+	details, count, err := s.dao.FileTamperEvent().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list file tamper events failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListFileTamperEventsResp{
+		Count:   uint32(count),
+		Details: pbfte.PbFileTamperEvents(details),
+	}, nil
+}
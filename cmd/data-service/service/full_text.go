@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"io"
+	"path"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbftd "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/full-text-doc"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// fullTextAppBatchSize is the count of apps indexed in one batch.
+const fullTextAppBatchSize = 100
+
+// SearchFullText search the config item contents and kv values of the given apps.
+func (s *Service) SearchFullText(ctx context.Context, req *pbds.SearchFullTextReq) (*pbds.SearchFullTextResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if !cc.DataService().FullText.Enabled {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "full-text search is not enabled"))
+	}
+
+	opt := &types.FullTextSearchOption{
+		BizID:   req.BizId,
+		AppIDs:  req.AppIds,
+		Keyword: req.Keyword,
+		ResType: table.FullTextResType(req.ResType),
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+		},
+	}
+	docs, count, err := s.dao.FullTextDoc().Search(kt, opt)
+	if err != nil {
+		logs.Errorf("search full-text docs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.SearchFullTextResp{
+		Count:   uint32(count),
+		Details: pbftd.PbFullTextHits(docs, req.Keyword),
+	}, nil
+}
+
+// SyncFullTextIndex traverse all the apps and sync the full-text docs with their config items and kvs,
+// only the changed resources are re-indexed, returns the count of upserted and deleted docs.
+func (s *Service) SyncFullTextIndex(kt *kit.Kit) (int, error) {
+	var lastID uint32
+	changed := 0
+	for {
+		apps, err := s.dao.App().ListByCursor(kt, lastID, fullTextAppBatchSize)
+		if err != nil {
+			return changed, err
+		}
+
+		for _, app := range apps {
+			n, e := s.syncAppFullTextIndex(kt, app)
+			if e != nil {
+				// 单个服务失败不影响其他服务的索引
+				logs.Errorf("sync app %d full-text index failed, err: %v, rid: %s", app.ID, e, kt.Rid)
+				continue
+			}
+			changed += n
+		}
+
+		if len(apps) < fullTextAppBatchSize {
+			return changed, nil
+		}
+		lastID = apps[len(apps)-1].ID
+	}
+}
+
+// syncAppFullTextIndex sync the full-text docs of one app.
+func (s *Service) syncAppFullTextIndex(kt *kit.Kit, app *table.App) (int, error) {
+	bizID, appID := app.BizID, app.ID
+	existing, err := s.dao.FullTextDoc().ListByApp(kt, bizID, appID)
+	if err != nil {
+		return 0, err
+	}
+
+	var docs []*table.FullTextDoc
+	switch app.Spec.ConfigType {
+	case table.File:
+		docs, err = s.buildConfigItemFullTextDocs(kt, bizID, appID)
+	case table.KV:
+		docs, err = s.buildKvFullTextDocs(kt, bizID, appID)
+	default:
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	type docKey struct {
+		resType table.FullTextResType
+		resID   uint32
+	}
+	existMap := make(map[docKey]*table.FullTextDoc, len(existing))
+	for _, one := range existing {
+		existMap[docKey{resType: one.Spec.ResType, resID: one.Spec.ResID}] = one
+	}
+
+	toUpsert := make([]*table.FullTextDoc, 0)
+	for _, doc := range docs {
+		key := docKey{resType: doc.Spec.ResType, resID: doc.Spec.ResID}
+		exist, ok := existMap[key]
+		delete(existMap, key)
+		if ok && exist.Spec.Signature == doc.Spec.Signature && exist.Spec.Name == doc.Spec.Name &&
+			exist.Spec.Memo == doc.Spec.Memo {
+			continue
+		}
+
+		if ok {
+			doc.ID = exist.ID
+		}
+		if err = s.loadFullTextContent(kt, doc); err != nil {
+			logs.Errorf("load %s %d content for full-text index failed, err: %v, rid: %s", doc.Spec.ResType,
+				doc.Spec.ResID, err, kt.Rid)
+			continue
+		}
+		doc.Spec.IndexedAt = time.Now().UTC()
+		toUpsert = append(toUpsert, doc)
+	}
+
+	toDelete := make([]uint32, 0, len(existMap))
+	for _, one := range existMap {
+		toDelete = append(toDelete, one.ID)
+	}
+
+	if err = s.dao.FullTextDoc().BatchUpsert(kt, toUpsert); err != nil {
+		return 0, err
+	}
+	if err = s.dao.FullTextDoc().BatchDelete(kt, toDelete); err != nil {
+		return 0, err
+	}
+
+	return len(toUpsert) + len(toDelete), nil
+}
+
+// buildConfigItemFullTextDocs build the full-text docs of the file app's config items without content,
+// the content is loaded only when the doc need to be re-indexed.
+func (s *Service) buildConfigItemFullTextDocs(kt *kit.Kit, bizID, appID uint32) ([]*table.FullTextDoc, error) {
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := s.dao.Commit().ListAppLatestCommits(kt, bizID, appID)
+	if err != nil {
+		return nil, err
+	}
+	contentMap := make(map[uint32]*table.ContentSpec, len(commits))
+	for _, one := range commits {
+		contentMap[one.Attachment.ConfigItemID] = one.Spec.Content
+	}
+
+	docs := make([]*table.FullTextDoc, 0, len(cis))
+	for _, ci := range cis {
+		doc := &table.FullTextDoc{
+			Spec: &table.FullTextDocSpec{
+				ResType: table.FullTextConfigItem,
+				ResID:   ci.ID,
+				Name:    path.Join(ci.Spec.Path, ci.Spec.Name),
+				Memo:    ci.Spec.Memo,
+			},
+			Attachment: &table.FullTextDocAttachment{BizID: bizID, AppID: appID},
+		}
+		// 二进制文件只索引名称和描述
+		if content, ok := contentMap[ci.ID]; ok && content != nil && ci.Spec.FileType != table.Binary {
+			doc.Spec.Signature = content.Signature
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// buildKvFullTextDocs build the full-text docs of the kv app's kvs without value.
+func (s *Service) buildKvFullTextDocs(kt *kit.Kit, bizID, appID uint32) ([]*table.FullTextDoc, error) {
+	kvs, err := s.dao.Kv().ListAllByAppID(kt, appID, bizID, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange)})
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make([]*table.FullTextDoc, 0, len(kvs))
+	for _, kv := range kvs {
+		doc := &table.FullTextDoc{
+			Spec: &table.FullTextDocSpec{
+				ResType: table.FullTextKv,
+				ResID:   kv.ID,
+				Name:    kv.Spec.Key,
+				Memo:    kv.Spec.Memo,
+			},
+			Attachment: &table.FullTextDocAttachment{BizID: bizID, AppID: appID},
+		}
+		// 敏感信息类型的配置项不索引其值，避免通过检索泄露
+		if kv.Spec.KvType != table.KvSecret && kv.ContentSpec != nil {
+			doc.Spec.Signature = kv.ContentSpec.Signature
+		}
+		docs = append(docs, doc)
+	}
+
+	return docs, nil
+}
+
+// loadFullTextContent load the content of the doc whose signature is set, the content larger than
+// the max indexed size or not a valid utf-8 text is not indexed.
+func (s *Service) loadFullTextContent(kt *kit.Kit, doc *table.FullTextDoc) error {
+	doc.Spec.Content = ""
+	if doc.Spec.Signature == "" {
+		return nil
+	}
+
+	var content string
+	switch doc.Spec.ResType {
+	case table.FullTextConfigItem:
+		repoKt := kt.GetKitForRepoCfg()
+		repoKt.BizID = doc.Attachment.BizID
+		body, _, err := s.repo.Download(repoKt, doc.Spec.Signature)
+		if err != nil {
+			return err
+		}
+		defer body.Close()
+
+		maxSize := int64(cc.DataService().FullText.MaxContentKB) * 1024
+		data, err := io.ReadAll(io.LimitReader(body, maxSize+1))
+		if err != nil {
+			return err
+		}
+		if int64(len(data)) > maxSize {
+			return nil
+		}
+		content = string(data)
+	case table.FullTextKv:
+		kv, err := s.dao.Kv().GetByID(kt, doc.Attachment.BizID, doc.Attachment.AppID, doc.Spec.ResID)
+		if err != nil {
+			return err
+		}
+		_, content, err = s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+			BizID:   doc.Attachment.BizID,
+			AppID:   doc.Attachment.AppID,
+			Key:     kv.Spec.Key,
+			Version: int(kv.Spec.Version),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(content) > int(cc.DataService().FullText.MaxContentKB)*1024 || !utf8.ValidString(content) {
+		return nil
+	}
+	doc.Spec.Content = content
+
+	return nil
+}
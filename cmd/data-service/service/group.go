@@ -25,6 +25,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
@@ -207,6 +208,7 @@ func (s *Service) ListAppGroups(ctx context.Context, req *pbds.ListAppGroupsReq)
 			if group.ID == gcr.GroupID {
 				data.ReleaseId = gcr.ReleaseID
 				data.Edited = gcr.Edited
+				data.Pinned = gcr.Pinned
 				if gcr.Selector != nil {
 					oldSelector, err = gcr.Selector.MarshalPB()
 					if err != nil {
@@ -381,6 +383,21 @@ func (s *Service) DeleteGroup(ctx context.Context, req *pbds.DeleteGroupReq) (*p
 		return nil, errf.New(errf.ErrGroupAlreadyPublished,
 			fmt.Sprintf("group has already published in apps [%s]", tools.JoinUint32(publishedApps, ",")))
 	}
+
+	// 被 kv 覆盖值使用的分组不能删除，否则覆盖值无法再生成版本
+	overrides, err := s.dao.KvOverride().ListByGroupID(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if len(overrides) > 0 {
+		overrideApps := make([]uint32, len(overrides))
+		for idx, one := range overrides {
+			overrideApps[idx] = one.Attachment.AppID
+		}
+		return nil, errf.New(errf.InvalidArgument, fmt.Sprintf("group is used by the kv overrides in apps [%s]",
+			tools.JoinUint32(tools.RemoveDuplicates(overrideApps), ",")))
+	}
+
 	tx := s.dao.GenQuery().Begin()
 	if e := s.dao.Group().DeleteWithTx(kt, tx, group); e != nil {
 		logs.Errorf("delete group failed, err: %v, rid: %s", e, kt.Rid)
@@ -497,3 +514,115 @@ func (s *Service) ListGroupSelector(ctx context.Context, req *pbds.ListGroupSele
 		Values: values,
 	}, nil
 }
+
+const (
+	// previewClientsBatchSize is the batch size to scan online clients when preview group clients.
+	previewClientsBatchSize = 1000
+	// previewClientsMaxScan is the max count of online clients to scan when preview group clients.
+	previewClientsMaxScan = 100000
+)
+
+// PreviewGroupClients preview the online clients which are currently matched by a group or a selector.
+func (s *Service) PreviewGroupClients(ctx context.Context, req *pbds.PreviewGroupClientsReq) (
+	*pbds.PreviewGroupClientsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	match, err := s.groupClientMatcher(kt, req)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := make([]*table.Client, 0)
+	truncated := false
+	var afterID uint32
+	for scanned := 0; ; {
+		clients, err := s.dao.Client().ListOnlineByIDCursor(kt, req.BizId, req.AppId, afterID,
+			previewClientsBatchSize)
+		if err != nil {
+			logs.Errorf("list online clients failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+
+		for _, one := range clients {
+			labels := make(map[string]string)
+			if one.Spec.Labels != "" {
+				if err := json.Unmarshal([]byte(one.Spec.Labels), &labels); err != nil {
+					continue
+				}
+			}
+
+			ok, err := match(one.Attachment.UID, labels)
+			if err != nil {
+				return nil, errf.Errorf(errf.InvalidArgument, "match client %s failed, err: %v",
+					one.Attachment.UID, err)
+			}
+			if ok {
+				matched = append(matched, one)
+			}
+		}
+
+		scanned += len(clients)
+		if len(clients) < previewClientsBatchSize {
+			break
+		}
+
+		if scanned >= previewClientsMaxScan {
+			truncated = true
+			break
+		}
+		afterID = clients[len(clients)-1].ID
+	}
+
+	limit := req.Limit
+	if limit == 0 || limit > uint32(types.DefaultMaxPageLimit) {
+		limit = uint32(types.DefaultMaxPageLimit)
+	}
+	start := min(int(req.Start), len(matched))
+	end := min(start+int(limit), len(matched))
+
+	return &pbds.PreviewGroupClientsResp{
+		Count:     uint32(len(matched)),
+		Details:   pbclient.PbClients(matched[start:end]),
+		Truncated: truncated,
+	}, nil
+}
+
+// groupClientMatcher returns a function to test whether a client is matched by the group or the
+// selector in the request.
+func (s *Service) groupClientMatcher(kt *kit.Kit, req *pbds.PreviewGroupClientsReq) (
+	func(uid string, labels map[string]string) (bool, error), error) {
+
+	if req.GroupId == 0 {
+		if req.Selector == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, "group_id or selector should be set")
+		}
+
+		sel, err := pbgroup.UnmarshalSelector(req.Selector)
+		if err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, "invalid selector, err: %v", err)
+		}
+		return sel.MatchInstance, nil
+	}
+
+	group, err := s.dao.Group().Get(kt, req.GroupId, req.BizId)
+	if err != nil {
+		logs.Errorf("get group %d failed, err: %v, rid: %s", req.GroupId, err, kt.Rid)
+		return nil, err
+	}
+
+	switch group.Spec.Mode {
+	case table.GroupModeDebug:
+		return func(uid string, _ map[string]string) (bool, error) {
+			return uid == group.Spec.UID, nil
+		}, nil
+	case table.GroupModeDefault:
+		return func(string, map[string]string) (bool, error) {
+			return true, nil
+		}, nil
+	default:
+		if group.Spec.Selector == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, "group %d has no selector", group.ID)
+		}
+		return group.Spec.Selector.MatchInstance, nil
+	}
+}
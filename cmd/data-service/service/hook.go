@@ -211,7 +211,15 @@ func (s *Service) DeleteHook(ctx context.Context, req *pbds.DeleteHookReq) (*pbb
 		}
 		return nil, e
 	}
-	// 4. delete hook
+	// 4. delete the hook template ref if the hook is created from hook template
+	if e := s.dao.HookTemplateRef().DeleteByHookIDWithTx(kt, tx, req.BizId, req.HookId); e != nil {
+		logs.Errorf("delete hook template ref failed, err: %v, rid: %s", e, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, e
+	}
+	// 5. delete hook
 	hook := &table.Hook{
 		ID: req.HookId,
 		Attachment: &table.HookAttachment{
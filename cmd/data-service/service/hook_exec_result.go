@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbher "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-exec-result"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// BatchCreateHookExecResults batch create hook exec results reported by clients.
+func (s *Service) BatchCreateHookExecResults(ctx context.Context, req *pbds.BatchCreateHookExecResultsReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	now := time.Now().UTC()
+	items := make([]*table.HookExecResult, 0, len(req.Items))
+	for _, v := range req.Items {
+		if v == nil || v.Spec == nil || v.Attachment == nil {
+			continue
+		}
+		spec := v.Spec.HookExecResultSpec()
+		spec.Stdout = tailOutput(spec.Stdout)
+		spec.Stderr = tailOutput(spec.Stderr)
+		spec.CreatedAt = now
+		items = append(items, &table.HookExecResult{
+			Spec:       spec,
+			Attachment: v.Attachment.HookExecResultAttachment(),
+		})
+	}
+
+	if err := s.dao.HookExecResult().BatchCreate(kt, items); err != nil {
+		logs.Errorf("batch create hook exec results failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListHookExecResults list hook exec results.
+func (s *Service) ListHookExecResults(ctx context.Context, req *pbds.ListHookExecResultsReq) (
+	*pbds.ListHookExecResultsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListHookExecResultsOption{
+		BizID:      req.BizId,
+		AppID:      req.AppId,
+		ReleaseID:  req.ReleaseId,
+		UID:        req.Uid,
+		HookType:   req.HookType,
+		FailedOnly: req.FailedOnly,
+		AppIDs:     req.AppIds,
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+
+	details, count, err := s.dao.HookExecResult().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list hook exec results failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListHookExecResultsResp{
+		Count:   uint32(count),
+		Details: pbher.PbHookExecResults(details),
+	}, nil
+}
+
+// tailOutput keeps the tail of the hook output, which is the most useful part when a hook fails, the
+// tail starts at a rune boundary so that a multibyte character is not cut.
+func tailOutput(s string) string {
+	if len(s) <= table.HookExecOutputMaxLen {
+		return s
+	}
+
+	start := len(s) - table.HookExecOutputMaxLen
+	for start < len(s) && !utf8.RuneStart(s[start]) {
+		start++
+	}
+	return s[start:]
+}
@@ -0,0 +1,548 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbhtpl "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-template"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateHookTemplate create hook template.
+func (s *Service) CreateHookTemplate(ctx context.Context, req *pbds.CreateHookTemplateReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.HookTemplate().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "hook template name %s already exists",
+			req.Spec.Name))
+	}
+
+	spec := req.Spec.HookTemplateSpec()
+	spec.Version = 1
+	tpl := &table.HookTemplate{
+		Spec:       spec,
+		Attachment: req.Attachment.HookTemplateAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+
+	id, err := s.dao.HookTemplate().Create(kt, tpl)
+	if err != nil {
+		logs.Errorf("create hook template failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListHookTemplates list hook templates.
+func (s *Service) ListHookTemplates(ctx context.Context, req *pbds.ListHookTemplatesReq) (
+	*pbds.ListHookTemplatesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListHookTemplatesOption{
+		BizID:       req.BizId,
+		Name:        req.Name,
+		WithBuiltin: req.WithBuiltin,
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+
+	details, count, err := s.dao.HookTemplate().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list hook templates failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListHookTemplatesResp{
+		Count:   uint32(count),
+		Details: pbhtpl.PbHookTemplates(details),
+	}, nil
+}
+
+// UpdateHookTemplate update hook template, the hooks which opt in auto upgrade will be
+// upgraded to the new version of the template.
+func (s *Service) UpdateHookTemplate(ctx context.Context, req *pbds.UpdateHookTemplateReq) (
+	*pbds.UpdateHookTemplateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.HookTemplate().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get hook template (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+	if old.IsBuiltin() {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "built-in hook template can not be modified"))
+	}
+
+	spec := req.Spec.HookTemplateSpec()
+	spec.Name = old.Spec.Name
+	spec.Version = old.Spec.Version + 1
+	tpl := &table.HookTemplate{
+		ID:         old.ID,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+
+	refs, err := s.dao.HookTemplateRef().ListByTemplateID(kt, tpl.ID)
+	if err != nil {
+		logs.Errorf("list hook template refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.HookTemplate().UpdateWithTx(kt, tx, tpl); err != nil {
+		logs.Errorf("update hook template failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	// 开启自动升级的脚本同步上线新版本，渲染失败（例如新增了必填参数）的脚本保持原版本不变
+	upgraded := make([]uint32, 0)
+	for _, ref := range refs {
+		if !ref.Spec.AutoUpgrade {
+			continue
+		}
+
+		content, rErr := tpl.Spec.Render(ref.Spec.Args)
+		if rErr != nil {
+			logs.Warnf("skip auto upgrade hook %d, render template %d failed, err: %v, rid: %s",
+				ref.Attachment.HookID, tpl.ID, rErr, kt.Rid)
+			continue
+		}
+		if wErr := s.checkHookAppsWritable(kt, ref.Attachment.BizID, ref.Attachment.HookID); wErr != nil {
+			logs.Warnf("skip auto upgrade hook %d, err: %v, rid: %s", ref.Attachment.HookID, wErr, kt.Rid)
+			continue
+		}
+
+		if e := s.publishHookFromTemplate(kt, tx, tpl, ref, content); e != nil {
+			logs.Errorf("auto upgrade hook %d failed, err: %v, rid: %s", ref.Attachment.HookID, e, kt.Rid)
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+			}
+			return nil, e
+		}
+		upgraded = append(upgraded, ref.Attachment.HookID)
+	}
+
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+
+	return &pbds.UpdateHookTemplateResp{
+		Version:         tpl.Spec.Version,
+		UpgradedHookIds: upgraded,
+	}, nil
+}
+
+// DeleteHookTemplate delete hook template which is not referenced by any hook.
+func (s *Service) DeleteHookTemplate(ctx context.Context, req *pbds.DeleteHookTemplateReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	count, err := s.dao.HookTemplateRef().CountByTemplateID(kt, req.Id)
+	if err != nil {
+		logs.Errorf("count hook template refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if count > 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"hook template is referenced by %d hooks, can not be deleted", count))
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if e := s.dao.HookTemplate().DeleteWithTx(kt, tx, req.BizId, req.Id); e != nil {
+		logs.Errorf("delete hook template failed, err: %v, rid: %s", e, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, e
+	}
+
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// CreateHookFromTemplate create a hook which content is rendered from the hook template.
+func (s *Service) CreateHookFromTemplate(ctx context.Context, req *pbds.CreateHookFromTemplateReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.Hook().GetByName(kt, req.BizId, req.Name); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "hook name %s already exists", req.Name))
+	}
+
+	tpl, err := s.dao.HookTemplate().Get(kt, req.BizId, req.TemplateId)
+	if err != nil {
+		logs.Errorf("get hook template (%d) failed, err: %v, rid: %s", req.TemplateId, err, kt.Rid)
+		return nil, err
+	}
+
+	content, err := tpl.Spec.Render(req.Args)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "render hook template failed, err: %v", err))
+	}
+
+	res := &table.Revision{
+		Creator: kt.User,
+		Reviser: kt.User,
+	}
+
+	tx := s.dao.GenQuery().Begin()
+
+	// 1. create hook
+	hook := &table.Hook{
+		Spec: &table.HookSpec{
+			Name: req.Name,
+			Type: tpl.Spec.Type,
+			Tags: req.Tags,
+			Memo: req.Memo,
+		},
+		Attachment: &table.HookAttachment{BizID: req.BizId},
+		Revision:   res,
+	}
+	id, err := s.dao.Hook().CreateWithTx(kt, tx, hook, true)
+	if err != nil {
+		logs.Errorf("create hook failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	// 2. create the first hook revision which is deployed directly
+	revision := &table.HookRevision{
+		Spec: &table.HookRevisionSpec{
+			Name:    tools.GenerateRevisionName(),
+			Content: content,
+			Memo:    hookTemplateRevisionMemo(tpl),
+			State:   table.HookRevisionStatusDeployed,
+		},
+		Attachment: &table.HookRevisionAttachment{
+			BizID:  req.BizId,
+			HookID: id,
+		},
+		Revision: res,
+	}
+	if _, err = s.dao.HookRevision().CreateWithTx(kt, tx, revision); err != nil {
+		logs.Errorf("create hook revision failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	// 3. record the hook template ref
+	ref := &table.HookTemplateRef{
+		Spec: &table.HookTemplateRefSpec{
+			Args:            req.Args,
+			TemplateVersion: tpl.Spec.Version,
+			AutoUpgrade:     req.AutoUpgrade,
+		},
+		Attachment: &table.HookTemplateRefAttachment{
+			BizID:      req.BizId,
+			HookID:     id,
+			TemplateID: tpl.ID,
+		},
+		Revision: res,
+	}
+	if _, err = s.dao.HookTemplateRef().CreateWithTx(kt, tx, ref); err != nil {
+		logs.Errorf("create hook template ref failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpgradeHookFromTemplate upgrade the hook to the latest version of the hook template it referenced.
+func (s *Service) UpgradeHookFromTemplate(ctx context.Context, req *pbds.UpgradeHookFromTemplateReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	ref, err := s.dao.HookTemplateRef().GetByHookID(kt, req.BizId, req.HookId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "hook %d is not created from hook template",
+				req.HookId))
+		}
+		logs.Errorf("get hook template ref failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tpl, err := s.dao.HookTemplate().Get(kt, req.BizId, ref.Attachment.TemplateID)
+	if err != nil {
+		logs.Errorf("get hook template (%d) failed, err: %v, rid: %s", ref.Attachment.TemplateID, err, kt.Rid)
+		return nil, err
+	}
+
+	if len(req.Args) != 0 {
+		ref.Spec.Args = req.Args
+	}
+	ref.Spec.AutoUpgrade = req.AutoUpgrade
+
+	content, err := tpl.Spec.Render(ref.Spec.Args)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "render hook template failed, err: %v", err))
+	}
+
+	if err = s.checkHookAppsWritable(kt, req.BizId, req.HookId); err != nil {
+		return nil, err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if e := s.publishHookFromTemplate(kt, tx, tpl, ref, content); e != nil {
+		logs.Errorf("upgrade hook %d from template failed, err: %v, rid: %s", req.HookId, e, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, e
+	}
+
+	if e := tx.Commit(); e != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", e, kt.Rid)
+		return nil, e
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// UpgradeHooksFromTemplate upgrade the hooks referencing the hook template to the latest version in an async
+// operation, each hook is upgraded in its own transaction, the failed hooks keep the original version.
+func (s *Service) UpgradeHooksFromTemplate(ctx context.Context, req *pbds.UpgradeHooksFromTemplateReq) (
+	*pbds.CreateOperationResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	tpl, err := s.dao.HookTemplate().Get(kt, req.BizId, req.TemplateId)
+	if err != nil {
+		logs.Errorf("get hook template (%d) failed, err: %v, rid: %s", req.TemplateId, err, kt.Rid)
+		return nil, err
+	}
+
+	all, err := s.dao.HookTemplateRef().ListByTemplateID(kt, tpl.ID)
+	if err != nil {
+		logs.Errorf("list hook template refs failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 内置模版被多个业务引用, 只升级当前业务的脚本
+	selected := make(map[uint32]bool, len(req.HookIds))
+	for _, id := range req.HookIds {
+		selected[id] = true
+	}
+	refs := make([]*table.HookTemplateRef, 0, len(all))
+	for _, ref := range all {
+		if ref.Attachment.BizID != req.BizId {
+			continue
+		}
+		if len(selected) != 0 && !selected[ref.Attachment.HookID] {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+
+	opID, err := s.startOperation(kt, req.BizId, 0, table.HookTemplateUpgradeOperation, uint32(len(refs)),
+		func(r *operationRunner) (interface{}, error) {
+			upgraded := make([]uint32, 0, len(refs))
+			for _, ref := range refs {
+				if r.Canceled() {
+					break
+				}
+
+				item := fmt.Sprintf("hook %d", ref.Attachment.HookID)
+				if e := s.upgradeHookFromTemplate(r.kt, tpl, ref); e != nil {
+					r.Fail(item, e)
+					continue
+				}
+				r.Succeed()
+				upgraded = append(upgraded, ref.Attachment.HookID)
+			}
+			return map[string][]uint32{"upgraded_hook_ids": upgraded}, nil
+		})
+	if err != nil {
+		logs.Errorf("start upgrade hooks operation failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateOperationResp{OperationId: opID}, nil
+}
+
+// upgradeHookFromTemplate render the hook template with the args of the ref and publish it in a transaction.
+func (s *Service) upgradeHookFromTemplate(kt *kit.Kit, tpl *table.HookTemplate, ref *table.HookTemplateRef) error {
+	content, err := tpl.Spec.Render(ref.Spec.Args)
+	if err != nil {
+		return err
+	}
+
+	if err = s.checkHookAppsWritable(kt, ref.Attachment.BizID, ref.Attachment.HookID); err != nil {
+		return err
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.publishHookFromTemplate(kt, tx, tpl, ref, content); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetHookTemplateRef get the hook template which the hook is created from.
+func (s *Service) GetHookTemplateRef(ctx context.Context, req *pbds.GetHookTemplateRefReq) (
+	*pbds.GetHookTemplateRefResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	ref, err := s.dao.HookTemplateRef().GetByHookID(kt, req.BizId, req.HookId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &pbds.GetHookTemplateRefResp{}, nil
+		}
+		logs.Errorf("get hook template ref failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	tpl, err := s.dao.HookTemplate().Get(kt, req.BizId, ref.Attachment.TemplateID)
+	if err != nil {
+		logs.Errorf("get hook template (%d) failed, err: %v, rid: %s", ref.Attachment.TemplateID, err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.GetHookTemplateRefResp{
+		Ref:      pbhtpl.PbHookTemplateRef(ref),
+		Template: pbhtpl.PbHookTemplate(tpl),
+	}, nil
+}
+
+// checkHookAppsWritable returns error if any app whose editing area binds the hook is frozen or archived,
+// because publishing the hook changes the hook used by the next release of these apps.
+func (s *Service) checkHookAppsWritable(kt *kit.Kit, bizID, hookID uint32) error {
+	rhs, err := s.dao.ReleasedHook().ListAll(kt, bizID)
+	if err != nil {
+		logs.Errorf("list released hooks failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, rh := range rhs {
+		if rh.ReleaseID != 0 || rh.HookID != hookID {
+			continue
+		}
+		if err := s.ensureAppWritable(kt, bizID, rh.AppID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishHookFromTemplate create a new hook revision with the rendered content and publish it,
+// so that the unnamed release of the apps which bound the hook will use the new revision.
+func (s *Service) publishHookFromTemplate(kt *kit.Kit, tx *gen.QueryTx, tpl *table.HookTemplate,
+	ref *table.HookTemplateRef, content string) error {
+
+	bizID, hookID := ref.Attachment.BizID, ref.Attachment.HookID
+
+	// 1. 上线的版本下线
+	old, err := s.dao.HookRevision().GetByPubState(kt, &types.GetByPubStateOption{
+		BizID:  bizID,
+		HookID: hookID,
+		State:  table.HookRevisionStatusDeployed,
+	})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if err == nil {
+		old.Spec.State = table.HookRevisionStatusShutdown
+		old.Revision.Reviser = kt.User
+		if e := s.dao.HookRevision().UpdatePubStateWithTx(kt, tx, old); e != nil {
+			return e
+		}
+	}
+
+	// 2. 创建并上线新的脚本版本
+	revision := &table.HookRevision{
+		Spec: &table.HookRevisionSpec{
+			Name:    tools.GenerateRevisionName(),
+			Content: content,
+			Memo:    hookTemplateRevisionMemo(tpl),
+			State:   table.HookRevisionStatusDeployed,
+		},
+		Attachment: &table.HookRevisionAttachment{
+			BizID:  bizID,
+			HookID: hookID,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	if _, e := s.dao.HookRevision().CreateWithTx(kt, tx, revision); e != nil {
+		return e
+	}
+	// CreateWithTx encodes the content, restore it for the released hook.
+	revision.Spec.Content = content
+
+	// 3. 修改未命名版本绑定的脚本版本为上线版本
+	if e := s.dao.ReleasedHook().UpdateHookRevisionByReleaseIDWithTx(kt, tx, bizID, 0, hookID,
+		revision); e != nil {
+		return e
+	}
+
+	// 4. 记录当前使用的模板版本
+	ref.Spec.TemplateVersion = tpl.Spec.Version
+	ref.Revision.Reviser = kt.User
+	return s.dao.HookTemplateRef().UpdateWithTx(kt, tx, ref)
+}
+
+func hookTemplateRevisionMemo(tpl *table.HookTemplate) string {
+	return fmt.Sprintf("rendered from hook template %s v%d", tpl.Spec.Name, tpl.Spec.Version)
+}
@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+const (
+	// idempotentNew 首次请求, 由调用方处理
+	idempotentNew = "new"
+)
+
+// BeginIdempotentRequest record the idempotency key of the request, returns the original response if the
+// request with the same key is completed, the key is scoped by the biz and the user.
+func (s *Service) BeginIdempotentRequest(ctx context.Context, req *pbds.BeginIdempotentRequestReq) (
+	*pbds.BeginIdempotentRequestResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.IdempotencyRecord().GetByKey(kt, req.BizId, kt.User, req.Key)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get idempotency record failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err == nil {
+		// 过期或处理超时的记录视为不存在, 删除后重新记录
+		if !old.Spec.Expired(now) && !old.Abandoned(now) {
+			return idempotentReplay(kt, old, req)
+		}
+		if err = s.dao.IdempotencyRecord().Delete(kt, old.ID); err != nil {
+			logs.Errorf("delete idempotency record failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+	}
+
+	record := &table.IdempotencyRecord{
+		Spec: &table.IdempotencyRecordSpec{
+			Key:         req.Key,
+			Method:      req.Method,
+			Fingerprint: req.Fingerprint,
+			State:       table.IdempotencyProcessing,
+			ExpiredAt:   now.Add(table.IdempotencyRetention),
+		},
+		Attachment: &table.IdempotencyRecordAttachment{
+			BizID: req.BizId,
+			User:  kt.User,
+		},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.IdempotencyRecord().Create(kt, record)
+	if err != nil {
+		// 并发的相同请求已经记录了该 key
+		old, gErr := s.dao.IdempotencyRecord().GetByKey(kt, req.BizId, kt.User, req.Key)
+		if gErr == nil {
+			return idempotentReplay(kt, old, req)
+		}
+		logs.Errorf("create idempotency record failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.BeginIdempotentRequestResp{State: idempotentNew, Id: id}, nil
+}
+
+// idempotentReplay returns the state of the recorded request, the key can not be reused by different requests.
+func idempotentReplay(kt *kit.Kit, old *table.IdempotencyRecord, req *pbds.BeginIdempotentRequestReq) (
+	*pbds.BeginIdempotentRequestResp, error) {
+	if old.Spec.Method != req.Method || old.Spec.Fingerprint != req.Fingerprint {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"idempotency key %s has been used by a different request", req.Key))
+	}
+
+	resp := &pbds.BeginIdempotentRequestResp{State: string(old.Spec.State), Id: old.ID}
+	if old.Spec.State != table.IdempotencyCompleted {
+		return resp, nil
+	}
+
+	resp.Response = new(anypb.Any)
+	if err := proto.Unmarshal(old.Spec.Response, resp.Response); err != nil {
+		logs.Errorf("unmarshal idempotent response failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// CompleteIdempotentRequest save the response of the idempotent request.
+func (s *Service) CompleteIdempotentRequest(ctx context.Context, req *pbds.CompleteIdempotentRequestReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	response, err := proto.Marshal(req.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.dao.IdempotencyRecord().Complete(kt, req.Id, response); err != nil {
+		logs.Errorf("complete idempotency record failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// AbortIdempotentRequest delete the idempotency record of the failed request, so that it can be retried.
+func (s *Service) AbortIdempotentRequest(ctx context.Context, req *pbds.AbortIdempotentRequestReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.IdempotencyRecord().Delete(kt, req.Id); err != nil {
+		logs.Errorf("delete idempotency record failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// CleanExpiredIdempotencyRecords delete the idempotency records which are out of the retention window.
+func (s *Service) CleanExpiredIdempotencyRecords(kt *kit.Kit, limit int) (int64, error) {
+	return s.dao.IdempotencyRecord().DeleteExpired(kt, time.Now().UTC(), limit)
+}
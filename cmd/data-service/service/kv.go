@@ -20,10 +20,12 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -71,6 +73,19 @@ func (s *Service) CreateKv(ctx context.Context, req *pbds.CreateKvReq) (*pbds.Cr
 		return nil, errf.Errorf(errf.InvalidRequest,
 			i18n.T(kt, "kv type does not match the data type defined in the application"))
 	}
+	if err = req.Spec.KvSpec().ValidateExpiry(time.Now()); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid kv expiry, err: %v", err))
+	}
+
+	// 检测配置项的值是否包含疑似凭证
+	setting, err := s.getSecretScanSetting(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err = checkKvSecretLeak(kt, setting, req.Spec.Key, table.DataType(req.Spec.KvType), req.Spec.Value); err != nil {
+		return nil, err
+	}
 
 	opt := &types.UpsertKvOption{
 		BizID:  req.Attachment.BizId,
@@ -101,6 +116,8 @@ func (s *Service) CreateKv(ctx context.Context, req *pbds.CreateKvReq) (*pbds.Cr
 		},
 	}
 	kv.Spec.Version = uint32(version)
+	kv.Spec.CertificateExpirationDate = kvCertExpirationDate(kv.Spec.KvType, req.Spec.Value,
+		kv.Spec.CertificateExpirationDate)
 	kv.KvState = table.KvStateAdd
 	// Create one kv instance
 	id, err := s.dao.Kv().Create(kt, kv)
@@ -113,6 +130,19 @@ func (s *Service) CreateKv(ctx context.Context, req *pbds.CreateKvReq) (*pbds.Cr
 	return resp, nil
 }
 
+// kvCertExpirationDate returns the expiration date parsed from the certificate bundle kv,
+// the other kv types keep the given date.
+func kvCertExpirationDate(kvType table.DataType, value string, date *time.Time) *time.Time {
+	if kvType != table.KvCertBundle {
+		return date
+	}
+	info, err := table.ParseKvCertBundle(value)
+	if err != nil {
+		return date
+	}
+	return &info.NotAfter
+}
+
 // check KV Type Match
 func checkKVTypeMatch(kvType, appKvType table.DataType) bool {
 	if appKvType == table.KvAny {
@@ -135,6 +165,31 @@ func (s *Service) UpdateKv(ctx context.Context, req *pbds.UpdateKvReq) (*pbbase.
 			i18n.T(kt, "get kv (%d) failed, err: %v", req.Spec.Key, err))
 	}
 
+	// 乐观锁: 编辑期间配置项已被他人修改时拒绝覆盖
+	readAt := kv.Revision.UpdatedAt
+	if req.ExpectedRevision != "" {
+		if err = checkExpectedRevision(kt, kv.Spec.Key, req.ExpectedRevision, kv.Revision); err != nil {
+			return nil, err
+		}
+	}
+
+	// 检测配置项的值是否包含疑似凭证
+	setting, err := s.getSecretScanSetting(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if err = checkKvSecretLeak(kt, setting, kv.Spec.Key, kv.Spec.KvType, req.Spec.Value); err != nil {
+		return nil, err
+	}
+
+	// 过期设置整体覆盖, 为空表示取消过期
+	kv.Spec.ExpiredAt = req.Spec.KvSpec().ExpiredAt
+	kv.Spec.ExpiredFallback = req.Spec.ExpiredFallback
+	if err = kv.Spec.ValidateExpiry(time.Now()); err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid kv expiry, err: %v", err))
+	}
+
 	opt := &types.UpsertKvOption{
 		BizID:  req.Attachment.BizId,
 		AppID:  req.Attachment.AppId,
@@ -165,9 +220,23 @@ func (s *Service) UpdateKv(ctx context.Context, req *pbds.UpdateKvReq) (*pbbase.
 		ByteSize:  uint64(len(req.Spec.Value)),
 	}
 	kv.Spec.SecretHidden = req.Spec.SecretHidden
-	kv.Spec.CertificateExpirationDate = req.Spec.KvSpec().CertificateExpirationDate
-	if e := s.dao.Kv().Update(kt, kv); e != nil {
-		logs.Errorf("update kv failed, err: %v, rid: %s", e, kt.Rid)
+	kv.Spec.CertificateExpirationDate = kvCertExpirationDate(kv.Spec.KvType, req.Spec.Value,
+		req.Spec.KvSpec().CertificateExpirationDate)
+	if req.ExpectedRevision == "" {
+		err = s.dao.Kv().Update(kt, kv)
+	} else {
+		// 校验后以读取到的更新时间为条件更新, 避免校验与更新之间被他人修改
+		err = s.dao.Kv().UpdateWithRevision(kt, kv, readAt)
+	}
+	if errors.Is(err, dao.ErrRevisionConflict) {
+		latest, e := s.dao.Kv().GetByID(kt, req.Attachment.BizId, req.Attachment.AppId, kv.ID)
+		if e != nil {
+			return nil, e
+		}
+		return nil, revisionConflictError(kt, kv.Spec.Key, latest.Revision)
+	}
+	if err != nil {
+		logs.Errorf("update kv failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, errf.Errorf(errf.DBOpFailed, i18n.T(kt, "update kv failed, err: %v", err))
 	}
 
@@ -221,7 +290,19 @@ func (s *Service) ListKvs(ctx context.Context, req *pbds.ListKvsReq) (*pbds.List
 		return nil, err
 	}
 
-	details, count, err := s.dao.Kv().List(kt, opt)
+	var (
+		details    []*table.Kv
+		count      int64
+		nextCursor string
+	)
+	if cp := req.CursorPage.CursorPage(); cp != nil {
+		if err = cp.Validate(types.KvCursorOption); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+		}
+		details, nextCursor, err = s.dao.Kv().ListWithCursor(kt, req.BizId, req.AppId, cp)
+	} else {
+		details, count, err = s.dao.Kv().List(kt, opt)
+	}
 	if err != nil {
 		logs.Errorf("list kv failed, err: %v, rid: %s", err, kt.Rid)
 		return nil, err
@@ -239,6 +320,7 @@ func (s *Service) ListKvs(ctx context.Context, req *pbds.ListKvsReq) (*pbds.List
 		IsCertExpired: func() bool {
 			return expirationNumber > 0
 		}(),
+		NextCursor: nextCursor,
 	}
 
 	return resp, nil
@@ -288,18 +370,41 @@ func (s *Service) DeleteKv(ctx context.Context, req *pbds.DeleteKvReq) (*pbbase.
 		return nil, err
 	}
 
-	if kv.KvState == table.KvStateAdd {
-		if e := s.dao.Kv().Delete(kt, kv); e != nil {
-			logs.Errorf("delete kv failed, err: %v, rid: %s", e, kt.Rid)
-			return nil, e
+	// put the deleted kv into recycle bin before its state is changed, so that it can be restored.
+	tx := s.dao.GenQuery().Begin()
+	// the kv referenced by others can not be deleted.
+	if err = s.unlinkConfigRefsWithTx(kt, tx, kv.Attachment.BizID, table.ConfigRefKv, kv.ID, kv.Spec.Key); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
 		}
+		return nil, err
+	}
+	if err = s.recycleWithTx(kt, tx, table.RecycleKv, kv.ID, kv.Attachment.BizID, kv.Attachment.AppID,
+		kv.Spec.Key, kv); err != nil {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if kv.KvState == table.KvStateAdd {
+		err = s.dao.Kv().DeleteWithTx(kt, tx, kv)
 	} else {
 		kv.KvState = table.KvStateDelete
 		kv.Revision.Reviser = kt.User
-		if e := s.dao.Kv().Update(kt, kv); e != nil {
-			logs.Errorf("delete kv failed, err: %v, rid: %s", e, kt.Rid)
-			return nil, e
+		err = s.dao.Kv().UpdateWithTx(kt, tx, kv)
+	}
+	if err != nil {
+		logs.Errorf("delete kv failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
 		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
 	}
 
 	return new(pbbase.EmptyResp), nil
@@ -309,12 +414,49 @@ func (s *Service) DeleteKv(ctx context.Context, req *pbds.DeleteKvReq) (*pbbase.
 // 1.键存在则更新, 类型不一致直接提示错误
 // 2.键不存在则新增
 // replace_all为true时，清空表中的数据，但保证前面两条逻辑
-// nolint:funlen
 func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsReq) (*pbds.BatchUpsertKvsResp, error) {
 
 	// FromGrpcContext used only to obtain Kit through grpc context.
 	kt := kit.FromGrpcContext(ctx)
 
+	ids, err := s.upsertAndDeleteKvs(kt, req, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.BatchUpsertKvsResp{Ids: ids}, nil
+}
+
+// TransactKvs upsert and delete kvs in one transaction, all changes are applied or none of them.
+func (s *Service) TransactKvs(ctx context.Context, req *pbds.TransactKvsReq) (*pbds.TransactKvsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	deleteKeys := lo.Uniq(req.DeleteKeys)
+	for _, kv := range req.Upserts {
+		if lo.Contains(deleteKeys, kv.KvSpec.Key) {
+			return nil, errf.Errorf(errf.InvalidArgument,
+				i18n.T(kt, "key %s can not be upserted and deleted at the same time", kv.KvSpec.Key))
+		}
+	}
+
+	ids, err := s.upsertAndDeleteKvs(kt, &pbds.BatchUpsertKvsReq{
+		BizId: req.BizId,
+		AppId: req.AppId,
+		Kvs:   req.Upserts,
+	}, deleteKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.TransactKvsResp{Ids: ids, DeletedKeys: deleteKeys}, nil
+}
+
+// upsertAndDeleteKvs 在同一个事务中创建、更新和删除kv, 并只记录一条审计。
+// vault 中的值按版本写入, 事务失败时草稿仍指向旧版本, 因此不会出现部分生效的情况
+// nolint:funlen
+func (s *Service) upsertAndDeleteKvs(kt *kit.Kit, req *pbds.BatchUpsertKvsReq, deleteKeys []string) (
+	[]uint32, error) {
+
 	app, err := s.dao.App().Get(kt, req.BizId, req.AppId)
 	if err != nil {
 		return nil, errf.Errorf(errf.DBOpFailed, i18n.T(kt, "get app failed, err: %v", err))
@@ -322,10 +464,19 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 	if app.Spec.ConfigType != table.KV {
 		return nil, errors.New(i18n.T(kt, "not a KV type service"))
 	}
+	setting, err := s.getSecretScanSetting(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
 	for _, kv := range req.Kvs {
 		if !checkKVTypeMatch(table.DataType(kv.KvSpec.KvType), app.Spec.DataType) {
 			return nil, errors.New(i18n.T(kt, "kv type does not match the data type defined in the application"))
 		}
+		if err = checkKvSecretLeak(kt, setting, kv.KvSpec.Key, table.DataType(kv.KvSpec.KvType),
+			kv.KvSpec.Value); err != nil {
+			return nil, err
+		}
 	}
 
 	kvStateArr := []string{
@@ -390,18 +541,32 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 		}
 	}
 
+	// 6. 删除kv
+	if err = s.deleteKvsWithTx(kt, tx, req.BizId, req.AppId, kvs, deleteKeys); err != nil {
+		return nil, err
+	}
+
 	// 截取前三个对象
 	var itemNames []string
 	for i := 0; i < len(req.Kvs) && i < 3; i++ {
 		itemNames = append(itemNames, req.Kvs[i].KvSpec.Key)
 	}
+	for i := 0; i < len(deleteKeys) && len(itemNames) < 3; i++ {
+		itemNames = append(itemNames, deleteKeys[i])
+	}
 	ad := s.dao.AuditDao().Decorator(kt, req.BizId, &table.AuditField{
 		ResourceInstance: fmt.Sprintf(constant.OperateObject+constant.ResSeparator+constant.ConfigItemName,
-			len(req.Kvs), strings.Join(itemNames, constant.NameSeparator)),
+			len(req.Kvs)+len(deleteKeys), strings.Join(itemNames, constant.NameSeparator)),
 		Status: enumor.Success,
 		AppId:  req.AppId,
-	}).PrepareCreate(&table.Kv{})
-	if err := ad.Do(tx.Query); err != nil {
+	})
+	var auditDo dao.AuditDo
+	if len(deleteKeys) > 0 {
+		auditDo = ad.PrepareUpdate(&table.Kv{})
+	} else {
+		auditDo = ad.PrepareCreate(&table.Kv{})
+	}
+	if err := auditDo.Do(tx.Query); err != nil {
 		return nil, err
 	}
 
@@ -419,9 +584,44 @@ func (s *Service) BatchUpsertKvs(ctx context.Context, req *pbds.BatchUpsertKvsRe
 		updateIds = append(updateIds, item.ID)
 	}
 
-	return &pbds.BatchUpsertKvsResp{
-		Ids: tools.MergeAndDeduplicate(createIds, updateIds),
-	}, nil
+	return tools.MergeAndDeduplicate(createIds, updateIds), nil
+}
+
+// deleteKvsWithTx 删除指定的kv, 任意一个key不存在时整体失败
+func (s *Service) deleteKvsWithTx(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32, kvs []*table.Kv,
+	keys []string) error {
+
+	existing := make(map[string]*table.Kv, len(kvs))
+	for _, kv := range kvs {
+		existing[kv.Spec.Key] = kv
+	}
+
+	for _, key := range keys {
+		kv, ok := existing[key]
+		if !ok {
+			return errf.ErrKvNotExists.New(kt, key)
+		}
+		if err := s.unlinkConfigRefsWithTx(kt, tx, bizID, table.ConfigRefKv, kv.ID, kv.Spec.Key); err != nil {
+			return err
+		}
+		if err := s.recycleWithTx(kt, tx, table.RecycleKv, kv.ID, bizID, appID, kv.Spec.Key, kv); err != nil {
+			return err
+		}
+
+		var err error
+		if kv.KvState == table.KvStateAdd {
+			err = s.dao.Kv().DeleteWithTx(kt, tx, kv)
+		} else {
+			kv.KvState = table.KvStateDelete
+			kv.Revision.Reviser = kt.User
+			err = s.dao.Kv().UpdateWithTx(kt, tx, kv)
+		}
+		if err != nil {
+			return errf.Errorf(errf.DBOpFailed, i18n.T(kt, "delete kv failed, err: %v", err))
+		}
+	}
+
+	return nil
 }
 
 // 检测键值对配置项类型
@@ -558,7 +758,11 @@ func (s *Service) checkKvs(kt *kit.Kit, tx *gen.QueryTx, req *pbds.BatchUpsertKv
 			SecretType:                table.SecretType(kv.KvSpec.SecretType),
 			SecretHidden:              kv.KvSpec.SecretHidden,
 			CertificateExpirationDate: kv.GetKvSpec().KvSpec().CertificateExpirationDate,
+			ExpiredAt:                 kv.GetKvSpec().KvSpec().ExpiredAt,
+			ExpiredFallback:           kv.KvSpec.ExpiredFallback,
 		}
+		kvSpec.CertificateExpirationDate = kvCertExpirationDate(kvSpec.KvType, kv.KvSpec.Value,
+			kvSpec.CertificateExpirationDate)
 		kvAttachment := &table.KvAttachment{
 			BizID: req.BizId,
 			AppID: req.AppId,
@@ -807,3 +1011,32 @@ func (s *Service) FindNearExpiryCertKvs(ctx context.Context, req *pbds.FindNearE
 
 	return &pbds.FindNearExpiryCertKvsResp{Details: kvs, Count: count}, nil
 }
+
+// CompareKvValue compare the kv's current value with the value to be updated according to its kv type.
+func (s *Service) CompareKvValue(ctx context.Context, req *pbds.CompareKvValueReq) (*pbds.CompareKvValueResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	kv, err := s.dao.Kv().GetByKvState(kt, req.BizId, req.AppId, req.Key,
+		[]string{string(table.KvStateAdd), string(table.KvStateUnchange), string(table.KvStateRevise)})
+	if err != nil {
+		logs.Errorf("get kv (%s) failed, err: %v, rid: %s", req.Key, err, kt.Rid)
+		return nil, errf.Errorf(errf.DBOpFailed, i18n.T(kt, "get kv (%s) failed, err: %v", req.Key, err))
+	}
+
+	kvType, value, err := s.getKv(kt, req.BizId, req.AppId, kv.Spec.Version, kv.Spec.Key)
+	if err != nil {
+		logs.Errorf("get kv (%s) value failed, err: %v, rid: %s", req.Key, err, kt.Rid)
+		return nil, err
+	}
+
+	if err = kvType.ValidateValue(req.Value); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	changes := kvType.DiffValue(value, req.Value)
+	return &pbds.CompareKvValueResp{
+		KvType:  string(kvType),
+		Equal:   len(changes) == 0,
+		Changes: changes,
+	}, nil
+}
@@ -0,0 +1,133 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// ExpireReleasedKvs fire the expiry events of the released kvs which are expired, so that the feed server
+// notifies the instances to pull the kvs again, the expired kvs are not served or served with the fallback
+// value. returns the count of the expired released kvs.
+func (s *Service) ExpireReleasedKvs(kt *kit.Kit, limit int) (int, error) {
+	list, err := s.dao.ReleasedKv().ListExpiryUnnotified(kt, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// 同一服务的多个过期kv只触发一次事件
+	appKvs := make(map[[2]uint32][]*table.ReleasedKv)
+	for _, one := range list {
+		app := [2]uint32{one.Attachment.BizID, one.Attachment.AppID}
+		appKvs[app] = append(appKvs[app], one)
+	}
+
+	expired := 0
+	for app, kvs := range appKvs {
+		if err := s.fireKvExpiryEvent(kt, app[0], app[1], kvs); err != nil {
+			logs.Errorf("fire biz: %d, app: %d kv expiry event failed, err: %v, rid: %s", app[0], app[1], err,
+				kt.Rid)
+			continue
+		}
+		expired += len(kvs)
+	}
+
+	return expired, nil
+}
+
+// fireKvExpiryEvent mark the released kvs as notified and fire a publish event of the app's current published
+// release in one transaction, the event is not fired if none of the kvs is in a published release.
+func (s *Service) fireKvExpiryEvent(kt *kit.Kit, bizID, appID uint32, kvs []*table.ReleasedKv) error {
+	groups, err := s.dao.ReleasedGroup().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		return err
+	}
+
+	published := make(map[uint32]bool, len(groups))
+	for _, one := range groups {
+		published[one.ReleaseID] = true
+	}
+
+	ids := make([]uint32, 0, len(kvs))
+	keys := make([]string, 0, len(kvs))
+	notify := false
+	for _, one := range kvs {
+		ids = append(ids, one.ID)
+		keys = append(keys, fmt.Sprintf("%s(release: %d)", one.Spec.Key, one.ReleaseID))
+		if published[one.ReleaseID] {
+			notify = true
+		}
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	rollback := func(err error) error {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err := s.dao.ReleasedKv().MarkExpiryNotifiedWithTx(kt, tx, ids); err != nil {
+		return rollback(err)
+	}
+
+	// 过期的kv都不在已上线的版本中时, 没有客户端在使用, 只标记为已通知
+	if notify {
+		// notify the instances to pull the kvs again.
+		event := types.Event{
+			Spec: &table.EventSpec{
+				Resource:   table.Publish,
+				ResourceID: currentPublishedRelease(groups),
+				OpType:     table.InsertOp,
+			},
+			Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.CreatedRevision{Creator: kt.User},
+		}
+		if err := s.dao.Event().Eventf(kt).FireWithTx(tx, event); err != nil {
+			return rollback(err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	logs.Infof("biz: %d, app: %d kvs %v are expired, rid: %s", bizID, appID, keys, kt.Rid)
+	return nil
+}
+
+// currentPublishedRelease returns the app's current published release, which is the release published to the
+// default group, or the latest published release if the app is only published to some groups.
+func currentPublishedRelease(groups []*table.ReleasedGroup) uint32 {
+	var latest *table.ReleasedGroup
+	for _, one := range groups {
+		if one.GroupID == 0 {
+			return one.ReleaseID
+		}
+		if latest == nil || one.UpdatedAt.After(latest.UpdatedAt) {
+			latest = one
+		}
+	}
+
+	if latest == nil {
+		return 0
+	}
+	return latest.ReleaseID
+}
@@ -0,0 +1,352 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbkv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/kv"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// CreateKvOverride create the override value of the kv for the instances matched by the group.
+func (s *Service) CreateKvOverride(ctx context.Context, req *pbds.CreateKvOverrideReq) (*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	kv, err := s.getEditingKv(kt, req.BizId, req.AppId, req.Key)
+	if err != nil || kv == nil {
+		return nil, errf.ErrKvNotExists.New(kt, req.Key)
+	}
+
+	if err = s.checkKvOverrideGroup(kt, req.BizId, req.AppId, req.GroupId); err != nil {
+		return nil, err
+	}
+
+	overrides, err := s.dao.KvOverride().ListByKey(kt, req.BizId, req.AppId, req.Key)
+	if err != nil {
+		logs.Errorf("list kv overrides failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, one := range overrides {
+		if one.Spec.GroupID == req.GroupId {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the override of kv %s for group %d already exists",
+				req.Key, req.GroupId))
+		}
+	}
+
+	if err = kv.Spec.KvType.ValidateValue(req.Value); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	version, err := s.vault.UpsertKvOverride(kt, &types.UpsertKvOverrideOption{
+		BizID:   req.BizId,
+		AppID:   req.AppId,
+		Key:     req.Key,
+		GroupID: req.GroupId,
+		Value:   req.Value,
+		KvType:  kv.Spec.KvType,
+	})
+	if err != nil {
+		logs.Errorf("save kv override value to vault failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	override := &table.KvOverride{
+		Spec: &table.KvOverrideSpec{
+			Key:      req.Key,
+			GroupID:  req.GroupId,
+			Priority: req.Priority,
+			Version:  uint32(version),
+			Memo:     req.Memo,
+		},
+		Attachment:  &table.KvOverrideAttachment{BizID: req.BizId, AppID: req.AppId},
+		Revision:    &table.Revision{Creator: kt.User, Reviser: kt.User},
+		ContentSpec: kvOverrideContentSpec(req.Value),
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	id, err := s.dao.KvOverride().CreateWithTx(kt, tx, override)
+	if err != nil {
+		logs.Errorf("create kv override failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// UpdateKvOverride update the override value of the kv.
+func (s *Service) UpdateKvOverride(ctx context.Context, req *pbds.UpdateKvOverrideReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	override, err := s.dao.KvOverride().Get(kt, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get kv override failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv override %d is not found", req.Id))
+	}
+
+	kv, err := s.getEditingKv(kt, req.BizId, req.AppId, override.Spec.Key)
+	if err != nil || kv == nil {
+		return nil, errf.ErrKvNotExists.New(kt, override.Spec.Key)
+	}
+
+	if err = kv.Spec.KvType.ValidateValue(req.Value); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	version, err := s.vault.UpsertKvOverride(kt, &types.UpsertKvOverrideOption{
+		BizID:   req.BizId,
+		AppID:   req.AppId,
+		Key:     override.Spec.Key,
+		GroupID: override.Spec.GroupID,
+		Value:   req.Value,
+		KvType:  kv.Spec.KvType,
+	})
+	if err != nil {
+		logs.Errorf("save kv override value to vault failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	override.Spec.Priority = req.Priority
+	override.Spec.Version = uint32(version)
+	override.Spec.Memo = req.Memo
+	override.ContentSpec = kvOverrideContentSpec(req.Value)
+	override.Revision.Reviser = kt.User
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.KvOverride().UpdateWithTx(kt, tx, override); err != nil {
+		logs.Errorf("update kv override failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteKvOverride delete the override value of the kv, the released override values are not affected.
+func (s *Service) DeleteKvOverride(ctx context.Context, req *pbds.DeleteKvOverrideReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	override, err := s.dao.KvOverride().Get(kt, req.BizId, req.AppId, req.Id)
+	if err != nil {
+		logs.Errorf("get kv override failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "kv override %d is not found", req.Id))
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	if err = s.dao.KvOverride().DeleteWithTx(kt, tx, override); err != nil {
+		logs.Errorf("delete kv override failed, err: %v, rid: %s", err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	// 草稿值删除失败不影响已生成版本，仅记录日志
+	if err = s.vault.DeleteKvOverride(kt, &types.DeleteKvOverrideOption{
+		BizID:   req.BizId,
+		AppID:   req.AppId,
+		Key:     override.Spec.Key,
+		GroupID: override.Spec.GroupID,
+	}); err != nil {
+		logs.Errorf("delete kv override value from vault failed, err: %v, rid: %s", err, kt.Rid)
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ListKvOverrides list the override values of the kv, ordered by priority.
+func (s *Service) ListKvOverrides(ctx context.Context, req *pbds.ListKvOverridesReq) (*pbds.ListKvOverridesResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	kv, err := s.getEditingKv(kt, req.BizId, req.AppId, req.Key)
+	if err != nil || kv == nil {
+		return nil, errf.ErrKvNotExists.New(kt, req.Key)
+	}
+
+	overrides, err := s.dao.KvOverride().ListByKey(kt, req.BizId, req.AppId, req.Key)
+	if err != nil {
+		logs.Errorf("list kv overrides failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	details := make([]*pbkv.KvOverride, 0, len(overrides))
+	for _, one := range overrides {
+		_, value, e := s.vault.GetKvOverride(kt, &types.GetKvOverrideOption{
+			BizID:   req.BizId,
+			AppID:   req.AppId,
+			Key:     one.Spec.Key,
+			GroupID: one.Spec.GroupID,
+			Version: int(one.Spec.Version),
+		})
+		if e != nil {
+			logs.Errorf("get kv override value from vault failed, err: %v, rid: %s", e, kt.Rid)
+			return nil, e
+		}
+		details = append(details, pbkv.PbKvOverride(one, value))
+	}
+
+	return &pbds.ListKvOverridesResp{
+		Details:      details,
+		SecretHidden: kv.Spec.KvType == table.KvSecret && kv.Spec.SecretHidden,
+		KvType:       string(kv.Spec.KvType),
+	}, nil
+}
+
+// checkKvOverrideGroup check the group can be used by the kv overrides of the app, the default group
+// matches all the instances which is same as the base value, so it can not be used.
+func (s *Service) checkKvOverrideGroup(kt *kit.Kit, bizID, appID, groupID uint32) error {
+	groups, err := s.dao.Group().ListAppValidGroups(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app valid groups failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	for _, group := range groups {
+		if group.ID != groupID {
+			continue
+		}
+		if group.Spec.Mode != table.GroupModeCustom && group.Spec.Mode != table.GroupModeDebug {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "group %s can not be used to override kv values",
+				group.Spec.Name))
+		}
+		return nil
+	}
+
+	return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "group %d is not available for app %d", groupID, appID))
+}
+
+// releaseKvOverrides add the override values of the released kvs to the release, the matching rules of
+// the groups are saved with them.
+func (s *Service) releaseKvOverrides(kt *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32,
+	kvs []*pbkv.Kv) error {
+
+	overrides, err := s.dao.KvOverride().ListAllByAppID(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app kv overrides failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	released := make(map[string]bool, len(kvs))
+	for _, one := range kvs {
+		released[one.Spec.Key] = true
+	}
+
+	groups := make(map[uint32]*table.Group)
+	rkvos := make([]*table.ReleasedKvOverride, 0, len(overrides))
+	for _, one := range overrides {
+		// 基础值已删除的 kv 不再生效
+		if !released[one.Spec.Key] {
+			continue
+		}
+
+		group, ok := groups[one.Spec.GroupID]
+		if !ok {
+			group, err = s.dao.Group().Get(kt, one.Spec.GroupID, bizID)
+			if err != nil {
+				logs.Errorf("get kv override group %d failed, err: %v, rid: %s", one.Spec.GroupID, err, kt.Rid)
+				return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the group %d of kv %s override is not found",
+					one.Spec.GroupID, one.Spec.Key))
+			}
+			groups[one.Spec.GroupID] = group
+		}
+
+		kvType, value, e := s.vault.GetKvOverride(kt, &types.GetKvOverrideOption{
+			BizID:   bizID,
+			AppID:   appID,
+			Key:     one.Spec.Key,
+			GroupID: one.Spec.GroupID,
+			Version: int(one.Spec.Version),
+		})
+		if e != nil {
+			logs.Errorf("get kv override value from vault failed, err: %v, rid: %s", e, kt.Rid)
+			return e
+		}
+
+		version, e := s.vault.UpsertKvOverride(kt, &types.UpsertKvOverrideOption{
+			BizID:     bizID,
+			AppID:     appID,
+			ReleaseID: releaseID,
+			Key:       one.Spec.Key,
+			GroupID:   one.Spec.GroupID,
+			Value:     value,
+			KvType:    kvType,
+		})
+		if e != nil {
+			logs.Errorf("save released kv override value to vault failed, err: %v, rid: %s", e, kt.Rid)
+			return e
+		}
+
+		spec := *one.Spec
+		spec.Version = uint32(version)
+		rkvos = append(rkvos, &table.ReleasedKvOverride{
+			ReleaseID: releaseID,
+			Spec:      &spec,
+			Group: &table.KvOverrideGroup{
+				Mode:     group.Spec.Mode,
+				Selector: group.Spec.Selector,
+				UID:      group.Spec.UID,
+			},
+			Attachment:  one.Attachment,
+			Revision:    &table.Revision{Creator: kt.User, Reviser: kt.User},
+			ContentSpec: one.ContentSpec,
+		})
+	}
+
+	if err = s.dao.ReleasedKvOverride().BulkCreateWithTx(kt, tx, rkvos); err != nil {
+		logs.Errorf("bulk create released kv overrides failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+func kvOverrideContentSpec(value string) *table.ContentSpec {
+	return &table.ContentSpec{
+		Signature: tools.SHA256(value),
+		Md5:       tools.MD5(value),
+		ByteSize:  uint64(len(value)),
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbmp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/masking-profile"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// GetMaskingProfile get the masking profile of the app, a disabled profile is returned
+// if the app has not set it.
+func (s *Service) GetMaskingProfile(ctx context.Context, req *pbds.GetMaskingProfileReq) (
+	*pbds.GetMaskingProfileResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	profile, err := s.dao.MaskingProfile().Get(kt, req.BizId, req.AppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get masking profile failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		profile = &table.MaskingProfile{
+			Spec:       &table.MaskingProfileSpec{Enabled: false, Rules: table.MaskingRules{}},
+			Attachment: &table.MaskingProfileAttachment{BizID: req.BizId, AppID: req.AppId},
+			Revision:   &table.Revision{},
+		}
+	}
+
+	return &pbds.GetMaskingProfileResp{Profile: pbmp.PbMaskingProfile(profile)}, nil
+}
+
+// UpdateMaskingProfile create or update the masking profile of the app.
+func (s *Service) UpdateMaskingProfile(ctx context.Context, req *pbds.UpdateMaskingProfileReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.Spec.MaskingProfileSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "masking profile spec is required"))
+	}
+
+	old, err := s.dao.MaskingProfile().Get(kt, req.BizId, req.AppId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get masking profile failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		profile := &table.MaskingProfile{
+			Spec:       spec,
+			Attachment: &table.MaskingProfileAttachment{BizID: req.BizId, AppID: req.AppId},
+			Revision: &table.Revision{
+				Creator: kt.User,
+				Reviser: kt.User,
+			},
+		}
+		if _, err = s.dao.MaskingProfile().Create(kt, profile); err != nil {
+			logs.Errorf("create masking profile failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, errf.New(errf.InvalidArgument, err.Error())
+		}
+		return new(pbbase.EmptyResp), nil
+	}
+
+	profile := &table.MaskingProfile{
+		ID:         old.ID,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.MaskingProfile().Update(kt, profile); err != nil {
+		logs.Errorf("update masking profile failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
@@ -0,0 +1,260 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components/notifier"
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbnc "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/notification-channel"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// CreateNotificationChannel create notification channel.
+func (s *Service) CreateNotificationChannel(ctx context.Context, req *pbds.CreateNotificationChannelReq) (
+	*pbds.CreateResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	channels, err := s.dao.NotificationChannel().List(kt, req.Attachment.BizId)
+	if err != nil {
+		logs.Errorf("list notification channels failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(channels) >= table.MaxNotificationChannelsPerBiz {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"the number of notification channels exceeds the limit %d", table.MaxNotificationChannelsPerBiz))
+	}
+	for _, one := range channels {
+		if one.Spec.Name == req.Spec.Name {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "notification channel name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	channel := &table.NotificationChannel{
+		Spec:       req.Spec.NotificationChannelSpec(),
+		Attachment: req.Attachment.NotificationChannelAttachment(),
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.NotificationChannel().Create(kt, channel)
+	if err != nil {
+		logs.Errorf("create notification channel failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.CreateResp{Id: id}, nil
+}
+
+// ListNotificationChannels list the notification channels of a biz, or only the ones of the app if app id is set.
+func (s *Service) ListNotificationChannels(ctx context.Context, req *pbds.ListNotificationChannelsReq) (
+	*pbds.ListNotificationChannelsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	channels, err := s.dao.NotificationChannel().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list notification channels failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if req.AppId > 0 {
+		filtered := make([]*table.NotificationChannel, 0, len(channels))
+		for _, one := range channels {
+			if one.Attachment.AppID == req.AppId {
+				filtered = append(filtered, one)
+			}
+		}
+		channels = filtered
+	}
+
+	return &pbds.ListNotificationChannelsResp{
+		Count:   uint32(len(channels)),
+		Details: pbnc.PbNotificationChannels(channels),
+	}, nil
+}
+
+// UpdateNotificationChannel update notification channel.
+func (s *Service) UpdateNotificationChannel(ctx context.Context, req *pbds.UpdateNotificationChannelReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.NotificationChannel().Get(kt, req.Attachment.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get notification channel (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if req.Spec.Name != old.Spec.Name {
+		if _, err = s.dao.NotificationChannel().GetByName(kt, req.Attachment.BizId, req.Spec.Name); err == nil {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "notification channel name %s already exists",
+				req.Spec.Name))
+		}
+	}
+
+	channel := &table.NotificationChannel{
+		ID:         old.ID,
+		Spec:       req.Spec.NotificationChannelSpec(),
+		Attachment: req.Attachment.NotificationChannelAttachment(),
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.NotificationChannel().Update(kt, channel); err != nil {
+		logs.Errorf("update notification channel failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteNotificationChannel delete notification channel.
+func (s *Service) DeleteNotificationChannel(ctx context.Context, req *pbds.DeleteNotificationChannelReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.NotificationChannel().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete notification channel failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// TestNotificationChannel sends a message of the event with the sample data to the channel synchronously.
+func (s *Service) TestNotificationChannel(ctx context.Context, req *pbds.TestNotificationChannelReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	channel, err := s.dao.NotificationChannel().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get notification channel (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	event := table.NotificationEvent(req.Event)
+	if event == "" {
+		event = table.PublishSucceeded
+	}
+	if err = event.Validate(); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	payload := &notifier.Payload{
+		Event:       event,
+		BizID:       req.BizId,
+		AppID:       channel.Attachment.AppID,
+		AppName:     "demo-app",
+		ReleaseName: "v1.0.0",
+		Scope:       "ALL",
+		Operator:    kt.User,
+		Memo:        i18n.T(kt, "this is a test message of notification channel %s", channel.Spec.Name),
+	}
+	if event == table.ApprovalRequested {
+		payload.Approvers = kt.User
+	}
+	if event == table.PublishFailed {
+		payload.Reason = "rejected"
+	}
+
+	if err = s.notifier.Send(kt.Ctx, channel.Spec, payload); err != nil {
+		logs.Errorf("send test message to notification channel %d failed, err: %v, rid: %s", channel.ID, err, kt.Rid)
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "send notification failed, %v", err))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// publishEvent returns the notification event of the publish status, it's empty if the status is not notified.
+func publishEvent(status table.PublishStatus) table.NotificationEvent {
+	switch status {
+	case table.PendingApproval:
+		return table.ApprovalRequested
+	case table.PendingPublish:
+		return table.PublishStarted
+	case table.AlreadyPublish:
+		return table.PublishSucceeded
+	case table.RejectedApproval:
+		return table.PublishFailed
+	default:
+		return ""
+	}
+}
+
+// strategyScope returns the group names of the publish strategy, it's ALL if published to all the instances.
+func strategyScope(strategy *table.Strategy) string {
+	if strategy.Spec.Scope == nil || len(strategy.Spec.Scope.Groups) == 0 {
+		return "ALL"
+	}
+
+	names := make([]string, 0, len(strategy.Spec.Scope.Groups))
+	for _, group := range strategy.Spec.Scope.Groups {
+		if group.Spec != nil {
+			names = append(names, group.Spec.Name)
+		}
+	}
+
+	return strings.Join(names, constant.NameSeparator)
+}
+
+// notifyPublish sends the publish event to the enabled channels of the app which subscribe it asynchronously,
+// the notification failure does not affect the publish.
+func (s *Service) notifyPublish(kt *kit.Kit, app *table.App, p *notifier.Payload) {
+	if p.Event == "" {
+		return
+	}
+
+	channels, err := s.dao.NotificationChannel().ListEnabled(kt, app.BizID, app.ID)
+	if err != nil {
+		logs.Errorf("list app %d notification channels failed, err: %v, rid: %s", app.ID, err, kt.Rid)
+		return
+	}
+
+	p.BizID = app.BizID
+	p.AppID = app.ID
+	p.AppName = app.Spec.Name
+	if p.Operator == "" {
+		p.Operator = kt.User
+	}
+	if p.Event != table.ApprovalRequested {
+		p.Approvers = ""
+	}
+	if p.Time == "" {
+		p.Time = time.Now().Format(time.DateTime)
+	}
+
+	for _, one := range channels {
+		if !one.Spec.Subscribed(p.Event) {
+			continue
+		}
+
+		go func(channel *table.NotificationChannel) {
+			if err := s.notifier.Send(context.Background(), channel.Spec, p); err != nil {
+				logs.Errorf("send %s event of app %d to notification channel %d failed, err: %v, rid: %s",
+					p.Event, app.ID, channel.ID, err, kt.Rid)
+			}
+		}(one)
+	}
+}
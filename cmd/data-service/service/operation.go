@@ -0,0 +1,294 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbop "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/operation"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// operationHeartbeatInterval 运行中的操作定期刷新进度并检查是否被取消
+	operationHeartbeatInterval = 30 * time.Second
+	// operationFlushInterval 处理条目时刷新进度的最小间隔
+	operationFlushInterval = time.Second
+)
+
+// operationTask is the task of an async operation, it reports the progress of each item by the runner,
+// the returned result is saved as the operation result in json format, the returned error means the
+// whole operation failed.
+type operationTask func(r *operationRunner) (interface{}, error)
+
+// operationRunner runs an async operation in background, and records its progress.
+type operationRunner struct {
+	s *Service
+	// kt is the kit of the task, its context is canceled when the operation is canceled.
+	kt *kit.Kit
+	// dbKit is used to save the operation, which is not affected by the cancellation.
+	dbKit     *kit.Kit
+	cancel    context.CancelFunc
+	mu        sync.Mutex
+	op        *table.Operation
+	lastFlush time.Time
+}
+
+// startOperation create an async operation and run the task in background, returns the operation id.
+func (s *Service) startOperation(kt *kit.Kit, bizID, appID uint32, opType table.OperationType, total uint32,
+	task operationTask) (uint32, error) {
+	op := &table.Operation{
+		Spec: &table.OperationSpec{
+			Type:   opType,
+			State:  table.OperationPending,
+			Total:  total,
+			Errors: make(table.OperationErrors, 0),
+		},
+		Attachment: &table.OperationAttachment{BizID: bizID, AppID: appID},
+		Revision:   &table.Revision{Creator: kt.User, Reviser: kt.User},
+	}
+	id, err := s.dao.Operation().Create(kt, op)
+	if err != nil {
+		return 0, err
+	}
+
+	// 异步操作在请求返回后继续执行, 不能使用请求的上下文
+	ctx, cancel := context.WithCancel(context.Background())
+	taskKit := kt.Clone()
+	taskKit.Ctx = ctx
+	dbKit := kt.Clone()
+	dbKit.Ctx = context.Background()
+
+	r := &operationRunner{s: s, kt: taskKit, dbKit: dbKit, cancel: cancel, op: op}
+	go r.run(task)
+
+	return id, nil
+}
+
+// run the task, and save the final state of the operation.
+func (r *operationRunner) run(task operationTask) {
+	defer r.cancel()
+
+	r.mu.Lock()
+	r.op.Spec.State = table.OperationRunning
+	r.mu.Unlock()
+	r.flush(true)
+
+	stop := make(chan struct{})
+	go r.heartbeat(stop)
+
+	result, err := r.runTask(task)
+	close(stop)
+
+	r.mu.Lock()
+	switch {
+	case err != nil:
+		r.op.Spec.State = table.OperationFailed
+		r.addError("", err)
+	case r.kt.Ctx.Err() != nil:
+		r.op.Spec.State = table.OperationCanceled
+	case r.op.Spec.Failed > 0:
+		r.op.Spec.State = table.OperationFailed
+	default:
+		r.op.Spec.State = table.OperationSucceeded
+	}
+	if result != nil {
+		if b, mErr := json.Marshal(result); mErr == nil {
+			r.op.Spec.Result = string(b)
+		}
+	}
+	now := time.Now().UTC()
+	r.op.Spec.FinishedAt = &now
+	r.mu.Unlock()
+	r.flush(true)
+
+	logs.Infof("async operation %d finished, state: %s, rid: %s", r.op.ID, r.op.Spec.State, r.kt.Rid)
+}
+
+// runTask runs the task and converts the panic to error, so that the operation is always finished.
+func (r *operationRunner) runTask(task operationTask) (result interface{}, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logs.Errorf("async operation %d panic: %v, rid: %s", r.op.ID, p, r.kt.Rid)
+			err = fmt.Errorf("operation panic: %v", p)
+		}
+	}()
+
+	return task(r)
+}
+
+// heartbeat refresh the operation periodically and cancel the task if the cancellation is requested.
+func (r *operationRunner) heartbeat(stop <-chan struct{}) {
+	ticker := time.NewTicker(operationHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			op, err := r.s.dao.Operation().Get(r.dbKit, r.op.Attachment.BizID, r.op.ID)
+			if err != nil {
+				logs.Errorf("get async operation %d failed, err: %v, rid: %s", r.op.ID, err, r.kt.Rid)
+			} else if op.Spec.CancelRequested {
+				logs.Infof("async operation %d is canceled by %s, rid: %s", r.op.ID, op.Revision.Reviser, r.kt.Rid)
+				r.cancel()
+			}
+			r.flush(true)
+		}
+	}
+}
+
+// Canceled returns whether the operation is canceled, the task should stop processing the remaining items.
+func (r *operationRunner) Canceled() bool {
+	return r.kt.Ctx.Err() != nil
+}
+
+// Succeed records an item is processed successfully.
+func (r *operationRunner) Succeed() {
+	r.mu.Lock()
+	r.op.Spec.Succeeded++
+	r.mu.Unlock()
+	r.flush(false)
+}
+
+// Fail records an item is failed, the operation continues to process the remaining items.
+func (r *operationRunner) Fail(item string, err error) {
+	logs.Errorf("async operation %d process %s failed, err: %v, rid: %s", r.op.ID, item, err, r.kt.Rid)
+	r.mu.Lock()
+	r.op.Spec.Failed++
+	r.addError(item, err)
+	r.mu.Unlock()
+	r.flush(false)
+}
+
+// addError append the error to the operation, must be called with lock held.
+func (r *operationRunner) addError(item string, err error) {
+	if len(r.op.Spec.Errors) >= table.MaxOperationErrors {
+		return
+	}
+	r.op.Spec.Errors = append(r.op.Spec.Errors, &table.OperationError{Item: item, Message: err.Error()})
+}
+
+// flush save the progress of the operation, it is throttled unless force is true.
+func (r *operationRunner) flush(force bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if !force && now.Sub(r.lastFlush) < operationFlushInterval {
+		return
+	}
+	r.lastFlush = now
+	r.op.Revision.UpdatedAt = now.UTC()
+
+	if err := r.s.dao.Operation().UpdateProgress(r.dbKit, r.op); err != nil {
+		logs.Errorf("update async operation %d progress failed, err: %v, rid: %s", r.op.ID, err, r.kt.Rid)
+	}
+}
+
+// GetOperation get the async operation.
+func (s *Service) GetOperation(ctx context.Context, req *pbds.GetOperationReq) (*pbop.Operation, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	op, err := s.dao.Operation().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.RecordNotFound, i18n.T(kt, "operation %d is not found", req.Id))
+		}
+		logs.Errorf("get async operation failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return pbop.PbOperation(op), nil
+}
+
+// ListOperations list the async operations of the biz or the app.
+func (s *Service) ListOperations(ctx context.Context, req *pbds.ListOperationsReq) (*pbds.ListOperationsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	page := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
+	if err := page.Validate(types.DefaultPageOption); err != nil {
+		return nil, err
+	}
+
+	details, count, err := s.dao.Operation().List(kt, req.BizId, req.AppId, page)
+	if err != nil {
+		logs.Errorf("list async operations failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListOperationsResp{
+		Count:   uint32(count),
+		Details: pbop.PbOperations(details),
+	}, nil
+}
+
+// CancelOperation request to cancel the unfinished async operation, the running task stops at the next
+// heartbeat, and the processed items are not rolled back.
+func (s *Service) CancelOperation(ctx context.Context, req *pbds.CancelOperationReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	ok, err := s.dao.Operation().RequestCancel(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("cancel async operation failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if !ok {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "operation %d is not found or already finished",
+			req.Id))
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// FailInterruptedOperations mark the unfinished operations without heartbeat as failed, e.g. the data-service
+// instance running the operation is restarted, and delete the finished operations out of the retention.
+func (s *Service) FailInterruptedOperations(kt *kit.Kit, limit int) (int, error) {
+	now := time.Now().UTC()
+	ops, err := s.dao.Operation().ListInterrupted(kt, now.Add(-table.OperationHeartbeatTimeout), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, op := range ops {
+		op.Spec.State = table.OperationFailed
+		if len(op.Spec.Errors) < table.MaxOperationErrors {
+			op.Spec.Errors = append(op.Spec.Errors, &table.OperationError{Message: "operation is interrupted"})
+		}
+		op.Spec.FinishedAt = &now
+		op.Revision.UpdatedAt = now
+		if err = s.dao.Operation().UpdateProgress(kt, op); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err = s.dao.Operation().DeleteFinished(kt, now.Add(-table.OperationRetention), limit); err != nil {
+		return 0, err
+	}
+
+	return len(ops), nil
+}
@@ -24,6 +24,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components/itsm"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/notifier"
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
@@ -33,6 +34,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	pbcp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/compliance-policy"
 	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
 	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
@@ -68,6 +70,8 @@ func (s *Service) Publish(ctx context.Context, req *pbds.PublishReq) (*pbds.Publ
 		PublishType:     string(publishType),
 		PublishTime:     "",
 		IsCompare:       false,
+		ConfirmOverlap:  req.ConfirmOverlap,
+		ChangeTicketId:  req.ChangeTicketId,
 	})
 }
 
@@ -90,6 +94,36 @@ func (s *Service) SubmitPublishApprove(
 		return nil, fmt.Errorf(i18n.T(grpcKit, "release %s is deprecated, can not be submited", release.Spec.Name))
 	}
 
+	// 合规策略检查待上线版本的配置, 策略可能在版本生成后有变更
+	warnings, err := s.checkCompliance(grpcKit, app, release.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查上线分组是否固定了版本, 以及与其他版本的在线分组是否重叠
+	scope := &publishScope{
+		bizID:     req.BizId,
+		appID:     req.AppId,
+		releaseID: release.ID,
+		all:       req.All,
+		mode:      req.GrayPublishMode,
+		groupIDs:  req.Groups,
+		labels:    req.Labels,
+		groupName: req.GroupName,
+		confirmed: req.ConfirmOverlap,
+	}
+	if err = s.checkPinnedGroups(grpcKit, scope); err != nil {
+		return nil, err
+	}
+	overlaps, err := s.checkPublishOverlaps(grpcKit, scope)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := s.linkChangeTicket(grpcKit, req.BizId, req.ChangeTicketId)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取最近的上线版本
 	strategy, err := s.dao.Strategy().GetLast(grpcKit, req.BizId, req.AppId, 0, 0)
 	if err != nil {
@@ -129,6 +163,8 @@ func (s *Service) SubmitPublishApprove(
 	// parse publish option
 	opt := s.parsePublishOption(req, app)
 	opt.Groups = groupIDs
+	opt.ChangeTicketID = ticket.ID
+	opt.ChangeTicketURL = ticket.URL
 	opt.Revision = &table.CreatedRevision{
 		Creator: grpcKit.User,
 	}
@@ -206,17 +242,52 @@ func (s *Service) SubmitPublishApprove(
 	}
 	isRollback = false
 
+	s.notifyPublish(grpcKit, app, &notifier.Payload{
+		Event:          publishEvent(opt.PublishStatus),
+		ReleaseID:      release.ID,
+		ReleaseName:    release.Spec.Name,
+		Scope:          strings.Join(groupName, constant.NameSeparator),
+		Approvers:      opt.Approver,
+		Memo:           req.Memo,
+		ChangeTicketID: ticket.ID,
+	})
+
 	resp := &pbds.PublishResp{
 		PublishedStrategyHistoryId: pshID,
 		HaveCredentials:            haveCredentials,
 		HavePull:                   havePull,
+		ComplianceWarnings:         pbcp.PbComplianceViolations(warnings),
+		Overlaps:                   overlaps,
 	}
 	return resp, nil
 }
 
 // Approve publish approve.
-// nolint funlen
 func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.ApproveResp, error) {
+	resp, err := s.approve(ctx, req)
+	if err == nil || req.PublishStatus != string(table.AlreadyPublish) {
+		return resp, err
+	}
+
+	// 上线失败时通知订阅了失败事件的渠道
+	grpcKit := kit.FromGrpcContext(ctx)
+	app, aErr := s.dao.App().GetByID(grpcKit, req.AppId)
+	if aErr != nil {
+		logs.Errorf("get app failed, err: %v, rid: %s", aErr, grpcKit.Rid)
+		return resp, err
+	}
+	p := &notifier.Payload{Event: table.PublishFailed, ReleaseID: req.ReleaseId, Reason: err.Error()}
+	if release, rErr := s.dao.Release().Get(grpcKit, req.BizId, req.AppId, req.ReleaseId); rErr == nil {
+		p.ReleaseName = release.Spec.Name
+	}
+	s.notifyPublish(grpcKit, app, p)
+
+	return resp, err
+}
+
+// approve publish approve.
+// nolint funlen
+func (s *Service) approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.ApproveResp, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
 	logs.Infof("start approve operateway: %s, user: %s, req: %v", grpcKit.OperateWay, grpcKit.User, req)
 
@@ -364,6 +435,21 @@ func (s *Service) Approve(ctx context.Context, req *pbds.ApproveReq) (*pbds.Appr
 		return nil, err
 	}
 	isRollback = false
+
+	// 状态变更时通知, 会签未全部通过时仍为待审批, 不重复通知
+	if status, ok := updateContent["publish_status"].(table.PublishStatus); ok &&
+		status != strategy.Spec.PublishStatus {
+		s.notifyPublish(grpcKit, app, &notifier.Payload{
+			Event:          publishEvent(status),
+			ReleaseID:      release.ID,
+			ReleaseName:    release.Spec.Name,
+			Scope:          strategyScope(strategy),
+			Reason:         req.Reason,
+			Memo:           strategy.Spec.Memo,
+			ChangeTicketID: strategy.Spec.ChangeTicketID,
+		})
+	}
+
 	return &pbds.ApproveResp{
 		HaveCredentials: haveCredentials,
 		HavePull:        havePull,
@@ -388,6 +474,41 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, errors.New(i18n.T(grpcKit, "release name %s already exists", req.ReleaseName))
 	}
 
+	// 校验版本说明是否满足服务的版本说明策略
+	notes := req.ReleaseNotes.ReleaseNotes()
+	if err = app.Spec.ReleaseNotesPolicy.ValidateNotes(grpcKit, notes); err != nil {
+		return nil, err
+	}
+
+	// 合规策略检查未命名版本的配置
+	warnings, err := s.checkCompliance(grpcKit, app, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// 检查上线分组是否固定了版本, 以及与其他版本的在线分组是否重叠
+	scope := &publishScope{
+		bizID:     req.BizId,
+		appID:     req.AppId,
+		all:       req.All,
+		mode:      req.GrayPublishMode,
+		names:     req.Groups,
+		labels:    req.Labels,
+		groupName: req.GroupName,
+		confirmed: req.ConfirmOverlap,
+	}
+	if err = s.checkPinnedGroups(grpcKit, scope); err != nil {
+		return nil, err
+	}
+	overlaps, err := s.checkPublishOverlaps(grpcKit, scope)
+	if err != nil {
+		return nil, err
+	}
+	ticket, err := s.linkChangeTicket(grpcKit, req.BizId, req.ChangeTicketId)
+	if err != nil {
+		return nil, err
+	}
+
 	// 获取最近的上线版本
 	strategy, err := s.dao.Strategy().GetLast(grpcKit, req.BizId, req.AppId, 0, 0)
 	if err != nil {
@@ -425,8 +546,9 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 	// create release.
 	release := &table.Release{
 		Spec: &table.ReleaseSpec{
-			Name: req.ReleaseName,
-			Memo: req.ReleaseMemo,
+			Name:  req.ReleaseName,
+			Memo:  req.ReleaseMemo,
+			Notes: notes,
 		},
 		Attachment: &table.ReleaseAttachment{
 			BizID: req.BizId,
@@ -495,10 +617,12 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		Revision: &table.CreatedRevision{
 			Creator: kt.User,
 		},
-		PublishType:   table.Immediately,
-		PublishStatus: table.AlreadyPublish,
-		PubState:      string(table.Publishing),
-		ApproveType:   string(app.Spec.ApproveType),
+		PublishType:     table.Immediately,
+		PublishStatus:   table.AlreadyPublish,
+		PubState:        string(table.Publishing),
+		ApproveType:     string(app.Spec.ApproveType),
+		ChangeTicketID:  ticket.ID,
+		ChangeTicketURL: ticket.URL,
 	}
 
 	// if approval required, current approver required, pub_state unpublished
@@ -562,7 +686,22 @@ func (s *Service) GenerateReleaseAndPublish(ctx context.Context, req *pbds.Gener
 		return nil, err
 	}
 	isRollback = false
-	return &pbds.PublishResp{PublishedStrategyHistoryId: pshID}, nil
+
+	s.notifyPublish(grpcKit, app, &notifier.Payload{
+		Event:          publishEvent(opt.PublishStatus),
+		ReleaseID:      release.ID,
+		ReleaseName:    release.Spec.Name,
+		Scope:          strings.Join(groupName, constant.NameSeparator),
+		Approvers:      opt.Approver,
+		Memo:           req.ReleaseMemo,
+		ChangeTicketID: ticket.ID,
+	})
+
+	return &pbds.PublishResp{
+		PublishedStrategyHistoryId: pshID,
+		ComplianceWarnings:         pbcp.PbComplianceViolations(warnings),
+		Overlaps:                   overlaps,
+	}, nil
 }
 
 // revokeApprove revoke publish approve.
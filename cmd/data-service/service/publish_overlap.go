@@ -0,0 +1,188 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
+	pbstrategy "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/strategy"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+// publishScope is the scope of a publish, the groups are ids or names according to the publish request.
+type publishScope struct {
+	bizID     uint32
+	appID     uint32
+	releaseID uint32
+	all       bool
+	mode      string
+	groupIDs  []uint32
+	names     []string
+	labels    []*structpb.Struct
+	groupName string
+	confirmed bool
+}
+
+// checkPublishOverlaps detect the overlaps between the groups to publish and the online groups of other releases,
+// the instances in the overlapped scope match more than one release, and the latest published one takes effect.
+// the publish is refused unless the overlaps are confirmed.
+func (s *Service) checkPublishOverlaps(kt *kit.Kit, scope *publishScope) ([]*pbstrategy.PublishOverlap, error) {
+	targets, err := s.publishTargetGroups(kt, scope)
+	if err != nil || len(targets) == 0 {
+		return nil, err
+	}
+
+	released, err := s.dao.ReleasedGroup().ListAllByAppID(kt, scope.appID, scope.bizID)
+	if err != nil {
+		logs.Errorf("list app %d released groups failed, err: %v, rid: %s", scope.appID, err, kt.Rid)
+		return nil, err
+	}
+
+	publishing := make(map[uint32]bool, len(targets))
+	for _, one := range targets {
+		publishing[one.ID] = true
+	}
+
+	groupNames := make(map[uint32]string)
+	releaseNames := make(map[uint32]string)
+	overlaps := make([]*pbstrategy.PublishOverlap, 0)
+	for _, rg := range released {
+		// 同一版本的分组重叠不影响客户端匹配结果, 再次上线的分组会被替换, 默认分组仅在其他分组都不匹配时生效
+		if rg.ReleaseID == scope.releaseID || publishing[rg.GroupID] || rg.Mode == table.GroupModeDefault {
+			continue
+		}
+
+		for _, target := range targets {
+			// 按标签上线时可能复用已有的同标签分组
+			if target.ID == 0 && rg.Mode == table.GroupModeCustom && target.Spec.Selector.Equal(rg.Selector) {
+				continue
+			}
+			overlap := groupSelector(target.Spec.Mode, target.Spec.Selector, target.Spec.UID).Overlap(
+				groupSelector(rg.Mode, rg.Selector, rg.UID))
+			if overlap == nil {
+				continue
+			}
+
+			if _, exists := groupNames[rg.GroupID]; !exists {
+				groupNames[rg.GroupID] = s.overlapGroupName(kt, scope.bizID, rg.GroupID)
+			}
+			if _, exists := releaseNames[rg.ReleaseID]; !exists {
+				releaseNames[rg.ReleaseID] = s.overlapReleaseName(kt, scope.bizID, scope.appID, rg.ReleaseID)
+			}
+
+			overlaps = append(overlaps, &pbstrategy.PublishOverlap{
+				GroupId:            target.ID,
+				GroupName:          target.Spec.Name,
+				OverlapGroupId:     rg.GroupID,
+				OverlapGroupName:   groupNames[rg.GroupID],
+				OverlapReleaseId:   rg.ReleaseID,
+				OverlapReleaseName: releaseNames[rg.ReleaseID],
+				Scope:              overlap.String(),
+			})
+		}
+	}
+
+	if len(overlaps) != 0 && !scope.confirmed {
+		details := make([]string, 0, len(overlaps))
+		for _, one := range overlaps {
+			details = append(details, fmt.Sprintf("%s & %s(%s): %s", one.GroupName, one.OverlapGroupName,
+				one.OverlapReleaseName, one.Scope))
+		}
+		return nil, errors.New(i18n.T(kt, "the groups to publish overlap with the online groups of other releases, "+
+			"the instances in the overlapped scope will match the latest published release, please confirm the "+
+			"overlaps to continue: %s", strings.Join(details, "; ")))
+	}
+
+	return overlaps, nil
+}
+
+// publishTargetGroups returns the non-default groups to publish, returns nil when publish to all the instances
+// or the default group, which replaces the other groups.
+func (s *Service) publishTargetGroups(kt *kit.Kit, scope *publishScope) ([]*table.Group, error) {
+	if scope.all {
+		return nil, nil
+	}
+
+	// 按标签上线的分组可能尚未创建, 直接根据标签生成
+	if table.GrayPublishMode(scope.mode) == table.PublishByLabels {
+		sel := &selector.Selector{LabelsAnd: make([]selector.Element, 0, len(scope.labels))}
+		for _, label := range scope.labels {
+			element, err := pbgroup.UnmarshalElement(label)
+			if err != nil {
+				return nil, fmt.Errorf("unmarshal group label failed, err: %v", err)
+			}
+			sel.LabelsAnd = append(sel.LabelsAnd, *element)
+		}
+		return []*table.Group{{Spec: &table.GroupSpec{Name: scope.groupName, Mode: table.GroupModeCustom,
+			Selector: sel}}}, nil
+	}
+
+	groups := make([]*table.Group, 0, len(scope.groupIDs)+len(scope.names))
+	for _, id := range scope.groupIDs {
+		if id == 0 {
+			return nil, nil
+		}
+		group, err := s.dao.Group().Get(kt, id, scope.bizID)
+		if err != nil {
+			return nil, fmt.Errorf("group %d not exist", id)
+		}
+		groups = append(groups, group)
+	}
+	for _, name := range scope.names {
+		group, err := s.dao.Group().GetByName(kt, scope.bizID, name)
+		if err != nil {
+			return nil, fmt.Errorf("group %s not exist", name)
+		}
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// groupSelector returns the selector of the group, the debug group matches only the instance with it's uid.
+func groupSelector(mode table.GroupMode, sel *selector.Selector, uid string) *selector.Selector {
+	switch mode {
+	case table.GroupModeDebug:
+		return &selector.Selector{Instances: []string{uid}}
+	case table.GroupModeCustom:
+		return sel
+	default:
+		return nil
+	}
+}
+
+func (s *Service) overlapGroupName(kt *kit.Kit, bizID, groupID uint32) string {
+	group, err := s.dao.Group().Get(kt, groupID, bizID)
+	if err != nil {
+		logs.Errorf("get group %d failed, err: %v, rid: %s", groupID, err, kt.Rid)
+		return fmt.Sprintf("%d", groupID)
+	}
+	return group.Spec.Name
+}
+
+func (s *Service) overlapReleaseName(kt *kit.Kit, bizID, appID, releaseID uint32) string {
+	release, err := s.dao.Release().Get(kt, bizID, appID, releaseID)
+	if err != nil {
+		logs.Errorf("get release %d failed, err: %v, rid: %s", releaseID, err, kt.Rid)
+		return fmt.Sprintf("%d", releaseID)
+	}
+	return release.Spec.Name
+}
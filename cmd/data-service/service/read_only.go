@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// maintenanceAllowedMethods 只读维护期间仍允许的系统写入, 均为客户端上报的运行数据, 不影响已发布的配置,
+// 拒绝会导致 cache-service 和 feed-server 上报的数据丢失; 用户发起的写操作及幂等记录等均被拒绝
+var maintenanceAllowedMethods = map[string]bool{
+	pbds.Data_BatchUpsertClientMetrics_FullMethodName:       true,
+	pbds.Data_BatchCreateHookExecResults_FullMethodName:     true,
+	pbds.Data_BatchCreateFileTamperEvents_FullMethodName:    true,
+	pbds.Data_BatchUpsertExperimentExposures_FullMethodName: true,
+	pbds.Data_BatchIncreaseAppConsumption_FullMethodName:    true,
+	pbds.Data_BatchUpdateLastConsumedTime_FullMethodName:    true,
+}
+
+// ReadOnlyUnaryServerInterceptor rejects all the writes during the platform-level read-only maintenance.
+func ReadOnlyUnaryServerInterceptor(m serviced.Maintenance) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if !brpc.IsMutatingMethod(info.FullMethod) || maintenanceAllowedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+
+		state := m.ReadOnly()
+		if !state.Enabled {
+			return handler(ctx, req)
+		}
+
+		reason := state.Reason
+		if reason == "" {
+			reason = "since " + state.Since.Format("2006-01-02 15:04:05")
+		}
+		return nil, errf.ErrReadOnlyMaintenance.New(kit.FromGrpcContext(ctx), reason)
+	}
+}
@@ -0,0 +1,296 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"path"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbrb "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/recycle-bin"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// ListRecycleBin list the deleted resources in recycle bin.
+func (s *Service) ListRecycleBin(ctx context.Context, req *pbds.ListRecycleBinReq) (*pbds.ListRecycleBinResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.ListRecycleBinOption{
+		BizID:   req.BizId,
+		AppID:   req.AppId,
+		ResType: table.RecycleResType(req.ResType),
+		Page: &types.BasePage{
+			Start: req.Start,
+			Limit: uint(req.Limit),
+			All:   req.All,
+		},
+	}
+	details, count, err := s.dao.RecycleBin().List(kt, opt)
+	if err != nil {
+		logs.Errorf("list recycle bin failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListRecycleBinResp{
+		Count:   uint32(count),
+		Details: pbrb.PbRecycleBins(details),
+	}, nil
+}
+
+// GetRecycleBin get a deleted resource in recycle bin.
+func (s *Service) GetRecycleBin(ctx context.Context, req *pbds.GetRecycleBinReq) (*pbrb.RecycleBin, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rb, err := s.dao.RecycleBin().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get recycle bin (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return pbrb.PbRecycleBin(rb), nil
+}
+
+// RestoreRecycleBin restore a deleted resource in recycle bin.
+func (s *Service) RestoreRecycleBin(ctx context.Context, req *pbds.RestoreRecycleBinReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rb, err := s.dao.RecycleBin().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get recycle bin (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	if rb.Spec.ExpiredAt.Before(time.Now()) {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "%s has expired and can not be restored",
+			rb.Spec.Name))
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	switch rb.Spec.ResType {
+	case table.RecycleApp:
+		err = s.restoreApp(kt, tx, rb)
+	case table.RecycleConfigItem:
+		err = s.restoreConfigItem(kt, tx, rb)
+	case table.RecycleKv:
+		err = s.restoreKv(kt, tx, rb)
+	default:
+		err = rb.Spec.ResType.Validate()
+	}
+	if err == nil {
+		err = s.dao.RecycleBin().DeleteWithTx(kt, tx, rb.Attachment.BizID, rb.ID)
+	}
+	if err != nil {
+		logs.Errorf("restore %s %s failed, err: %v, rid: %s", rb.Spec.ResType, rb.Spec.Name, err, kt.Rid)
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// PurgeExpiredRecycleBin purge the expired resources in recycle bin, returns the purged count.
+func (s *Service) PurgeExpiredRecycleBin(kt *kit.Kit, limit int) (int, error) {
+	list, err := s.dao.RecycleBin().ListExpired(kt, time.Now(), limit)
+	if err != nil {
+		return 0, err
+	}
+
+	ids := make([]uint32, 0, len(list))
+	for _, one := range list {
+		ids = append(ids, one.ID)
+	}
+	if err = s.dao.RecycleBin().BatchDelete(kt, ids); err != nil {
+		return 0, err
+	}
+
+	return len(ids), nil
+}
+
+// recycleWithTx put the snapshot of a deleted resource into recycle bin with transaction.
+func (s *Service) recycleWithTx(kt *kit.Kit, tx *gen.QueryTx, resType table.RecycleResType, resID, bizID,
+	appID uint32, name string, snapshot interface{}) error {
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	retention := time.Duration(cc.DataService().RecycleBin.RetentionDays) * 24 * time.Hour
+	_, err = s.dao.RecycleBin().CreateWithTx(kt, tx, &table.RecycleBin{
+		Spec: &table.RecycleBinSpec{
+			ResType:   resType,
+			ResID:     resID,
+			Name:      name,
+			Data:      string(data),
+			ExpiredAt: time.Now().Add(retention),
+		},
+		Attachment: &table.RecycleBinAttachment{
+			BizID: bizID,
+			AppID: appID,
+		},
+		Revision: &table.CreatedRevision{Creator: kt.User},
+	})
+	if err != nil {
+		logs.Errorf("put %s %s into recycle bin failed, err: %v, rid: %s", resType, name, err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
+
+// restoreApp restore a deleted app with its original id, the app's config items, kvs and releases
+// are kept when it's deleted, so they are restored together, but the app needs to be published
+// and bound to templates again.
+func (s *Service) restoreApp(kt *kit.Kit, tx *gen.QueryTx, rb *table.RecycleBin) error {
+	app := new(table.App)
+	if err := json.Unmarshal([]byte(rb.Spec.Data), app); err != nil {
+		return err
+	}
+
+	if _, err := s.dao.App().GetByName(kt, app.BizID, app.Spec.Name); err == nil {
+		return errf.ErrAppAlreadyExists.New(kt, app.Spec.Name)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	if app.Spec.Alias != "" {
+		if _, err := s.dao.App().GetByAlias(kt, app.BizID, app.Spec.Alias); err == nil {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "app alias %s already exists", app.Spec.Alias))
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+	}
+
+	app.Revision.Reviser = kt.User
+	return s.dao.App().RecoverWithTx(kt, tx, app)
+}
+
+// restoreConfigItem restore a deleted config item with its original id, so that its commits are kept.
+func (s *Service) restoreConfigItem(kt *kit.Kit, tx *gen.QueryTx, rb *table.RecycleBin) error {
+	ci := new(table.ConfigItem)
+	if err := json.Unmarshal([]byte(rb.Spec.Data), ci); err != nil {
+		return err
+	}
+
+	if _, err := s.dao.App().Get(kt, ci.Attachment.BizID, ci.Attachment.AppID); err != nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the app of %s does not exist, restore the app first",
+			rb.Spec.Name))
+	}
+
+	if _, err := s.dao.ConfigItem().Get(kt, ci.ID, ci.Attachment.BizID); err == nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "config item %s has been restored", rb.Spec.Name))
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	newFiles := []tools.CIUniqueKey{{Name: ci.Spec.Name, Path: ci.Spec.Path}}
+	if err := s.checkRestorePrerequisites(kt, ci.Attachment.BizID, ci.Attachment.AppID, newFiles, nil); err != nil {
+		return err
+	}
+
+	ci.Revision.Reviser = kt.User
+	return s.dao.ConfigItem().RecoverConfigItem(kt, tx, ci)
+}
+
+// restoreKv restore a deleted kv with the value before it's deleted.
+func (s *Service) restoreKv(kt *kit.Kit, tx *gen.QueryTx, rb *table.RecycleBin) error {
+	kv := new(table.Kv)
+	if err := json.Unmarshal([]byte(rb.Spec.Data), kv); err != nil {
+		return err
+	}
+	bizID, appID := kv.Attachment.BizID, kv.Attachment.AppID
+
+	if _, err := s.dao.App().Get(kt, bizID, appID); err != nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the app of %s does not exist, restore the app first",
+			rb.Spec.Name))
+	}
+
+	// the kv which is deleted after released is kept with delete state until next release.
+	exist, err := s.dao.Kv().GetByKvState(kt, bizID, appID, kv.Spec.Key, []string{string(table.KvStateAdd),
+		string(table.KvStateRevise), string(table.KvStateUnchange), string(table.KvStateDelete)})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if exist != nil && exist.KvState != table.KvStateDelete {
+		return errf.ErrKvAlreadyExists.New(kt, kv.Spec.Key)
+	}
+
+	kvType, value, err := s.vault.GetKvByVersion(kt, &types.GetKvByVersion{
+		BizID:   bizID,
+		AppID:   appID,
+		Key:     kv.Spec.Key,
+		Version: int(kv.Spec.Version),
+	})
+	if err != nil {
+		logs.Errorf("get kv %s version %d from vault failed, err: %v, rid: %s", kv.Spec.Key, kv.Spec.Version,
+			err, kt.Rid)
+		return err
+	}
+
+	version, err := s.vault.UpsertKv(kt, &types.UpsertKvOption{
+		BizID:  bizID,
+		AppID:  appID,
+		Key:    kv.Spec.Key,
+		Value:  value,
+		KvType: kvType,
+	})
+	if err != nil {
+		logs.Errorf("upsert kv %s to vault failed, err: %v, rid: %s", kv.Spec.Key, err, kt.Rid)
+		return err
+	}
+
+	if exist != nil {
+		exist.Spec = kv.Spec
+		exist.Spec.Version = uint32(version)
+		exist.ContentSpec = kv.ContentSpec
+		exist.KvState = table.KvStateRevise
+		exist.Revision.Reviser = kt.User
+		return s.dao.Kv().UpdateWithTx(kt, tx, exist)
+	}
+
+	if err = s.checkKVConfigItemExceedsAppLimit(kt, bizID, appID, 1, 0); err != nil {
+		return err
+	}
+
+	kv.ID = 0
+	kv.Spec.Version = uint32(version)
+	kv.KvState = table.KvStateAdd
+	kv.Revision = &table.Revision{Creator: kt.User, Reviser: kt.User}
+	return s.dao.Kv().BatchCreateWithTx(kt, tx, []*table.Kv{kv})
+}
+
+// recycleConfigItemName the readable name of config item in recycle bin.
+func recycleConfigItemName(ci *table.ConfigItem) string {
+	return path.Join(ci.Spec.Path, ci.Spec.Name)
+}
@@ -23,6 +23,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
@@ -54,6 +55,21 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 	if _, e := s.dao.Release().GetByName(grpcKit, req.Attachment.BizId, req.Attachment.AppId, req.Spec.Name); e == nil {
 		return nil, fmt.Errorf("release name %s already exists", req.Spec.Name)
 	}
+
+	// 校验版本说明是否满足服务的版本说明策略
+	if err = app.Spec.ReleaseNotesPolicy.ValidateNotes(grpcKit, req.Spec.Notes.ReleaseNotes()); err != nil {
+		return nil, err
+	}
+
+	// 合规策略检查, 仅 warn 级别的违规不阻止生成版本
+	warnings, err := s.checkCompliance(grpcKit, app, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(warnings) > 0 {
+		logs.Warnf("app %d has %d compliance warnings when creating release, rid: %s", app.ID, len(warnings),
+			grpcKit.Rid)
+	}
 	// begin transaction to create release and released config item.
 	tx := s.dao.GenQuery().Begin()
 	// 1. create release, and create release and released config item need to begin tx.
@@ -156,6 +172,23 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 			logs.Errorf("do template action for create release failed, err: %v, rid: %s", err, grpcKit.Rid)
 			return nil, err
 		}
+
+		// 4: add the referenced config shares to the release.
+		exists := make(map[string]bool, len(cis)+len(tmplRevisions))
+		for _, ci := range cis {
+			exists[path.Join(ci.Spec.Path, ci.Spec.Name)] = true
+		}
+		for _, tr := range tmplRevisions {
+			exists[path.Join(tr.Spec.Path, tr.Spec.Name)] = true
+		}
+		if err = s.releaseSharedConfigItems(grpcKit, tx, req.Attachment.BizId, req.Attachment.AppId, release.ID,
+			exists); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+			}
+			logs.Errorf("release config shares failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
 	case table.KV:
 		expirationNumber, errC := s.checkForExpiredCertificates(grpcKit, req.Attachment.BizId, req.Attachment.AppId)
 		if errC != nil {
@@ -172,6 +205,14 @@ func (s *Service) CreateRelease(ctx context.Context, req *pbds.CreateReleaseReq)
 			logs.Errorf("do kv action for create release failed, err: %v, rid: %s", err, grpcKit.Rid)
 			return nil, err
 		}
+
+		if err = s.releaseSharedKvs(grpcKit, tx, req.Attachment.BizId, req.Attachment.AppId, release.ID); err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, grpcKit.Rid)
+			}
+			logs.Errorf("release config shares failed, err: %v, rid: %s", err, grpcKit.Rid)
+			return nil, err
+		}
 	}
 
 	// commit transaction.
@@ -657,7 +698,20 @@ func (s *Service) ListReleases(ctx context.Context, req *pbds.ListReleasesReq) (
 		query.Page.Limit = 0
 	}
 
-	details, err := s.dao.Release().List(grpcKit, query)
+	var (
+		details    *types.ListReleaseDetails
+		nextCursor string
+		err        error
+	)
+	if page := req.CursorPage.CursorPage(); page != nil {
+		if err = page.Validate(types.ReleaseCursorOption); err != nil {
+			return nil, errf.Errorf(errf.InvalidArgument, err.Error())
+		}
+		details = new(types.ListReleaseDetails)
+		details.Details, nextCursor, err = s.dao.Release().ListWithCursor(grpcKit, req.BizId, req.AppId, page)
+	} else {
+		details, err = s.dao.Release().List(grpcKit, query)
+	}
 	if err != nil {
 		logs.Errorf("list release failed, err: %v, rid: %s", err, grpcKit.Rid)
 		return nil, err
@@ -709,8 +763,9 @@ func (s *Service) ListReleases(ctx context.Context, req *pbds.ListReleasesReq) (
 	}
 
 	resp := &pbds.ListReleasesResp{
-		Count:   details.Count,
-		Details: releases,
+		Count:      details.Count,
+		Details:    releases,
+		NextCursor: nextCursor,
 	}
 	return resp, nil
 }
@@ -892,7 +947,12 @@ func (s *Service) doKvOperations(kt *kit.Kit, tx *gen.QueryTx, appID, bizID, rel
 		return err
 	}
 
-	versionMap, err := s.doBatchReleasedVault(kt, kvs, releaseID)
+	bases, err := s.releasedKvBases(kt, bizID, appID, kvs)
+	if err != nil {
+		return err
+	}
+
+	versionMap, err := s.doBatchReleasedVault(kt, kvs, releaseID, bases)
 	if err != nil {
 		return err
 	}
@@ -907,6 +967,10 @@ func (s *Service) doKvOperations(kt *kit.Kit, tx *gen.QueryTx, appID, bizID, rel
 		return err
 	}
 
+	if err = s.releaseKvOverrides(kt, tx, bizID, appID, releaseID, kvs); err != nil {
+		return err
+	}
+
 	if err = s.cleanUpKV(kt, tx, bizID, appID); err != nil {
 		logs.Errorf("clean failed, err: %v, rid: %s", err, kt.Rid)
 		return err
@@ -948,7 +1012,55 @@ func (s *Service) genCreateKv(kt *kit.Kit, bizID, appID uint32) ([]*pbkv.Kv, err
 	return kvs, nil
 }
 
-func (s *Service) doBatchReleasedVault(kt *kit.Kit, kvs []*pbkv.Kv, releaseId uint32) (map[string]int, error) {
+// releasedKvBases 获取上一个版本中同名kv的值, 作为新版本中大文本kv增量存储的基准
+func (s *Service) releasedKvBases(kt *kit.Kit, bizID, appID uint32, kvs []*pbkv.Kv) (
+	map[string]*types.ReleasedKvBase, error) {
+
+	bases := make(map[string]*types.ReleasedKvBase)
+	candidates := make(map[string]bool)
+	for _, kv := range kvs {
+		if vault.DeltaSupported(table.DataType(kv.Spec.KvType), kv.Spec.Value) {
+			candidates[kv.Spec.Key] = true
+		}
+	}
+	if len(candidates) == 0 {
+		return bases, nil
+	}
+
+	lately, err := s.dao.ReleasedKv().GetReleasedLately(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("get released kvs lately failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	for _, rkv := range lately {
+		if !candidates[rkv.Spec.Key] {
+			continue
+		}
+		_, value, err := s.vault.GetRKv(kt, &types.GetRKvOption{
+			BizID:      bizID,
+			AppID:      appID,
+			Key:        rkv.Spec.Key,
+			ReleasedID: rkv.ReleaseID,
+			Version:    int(rkv.Spec.Version),
+		})
+		if err != nil {
+			// 基准值获取失败时存储全量值
+			logs.Warnf("get base released kv %s failed, err: %v, rid: %s", rkv.Spec.Key, err, kt.Rid)
+			continue
+		}
+		bases[rkv.Spec.Key] = &types.ReleasedKvBase{
+			ReleaseID: rkv.ReleaseID,
+			Version:   int(rkv.Spec.Version),
+			Value:     value,
+		}
+	}
+
+	return bases, nil
+}
+
+func (s *Service) doBatchReleasedVault(kt *kit.Kit, kvs []*pbkv.Kv, releaseId uint32,
+	bases map[string]*types.ReleasedKvBase) (map[string]int, error) {
 
 	versionMap := make(map[string]int, len(kvs))
 	for _, rkv := range kvs {
@@ -959,6 +1071,7 @@ func (s *Service) doBatchReleasedVault(kt *kit.Kit, kvs []*pbkv.Kv, releaseId ui
 			Key:       rkv.Spec.Key,
 			Value:     rkv.Spec.Value,
 			KvType:    table.DataType(rkv.Spec.KvType),
+			Base:      bases[rkv.Spec.Key],
 		}
 		version, err := s.vault.CreateRKv(kt, opt)
 		if err != nil {
@@ -993,6 +1106,14 @@ func (s *Service) cleanUpKV(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) e
 			logs.Errorf("delete kv failed, err: %v, rid: %s", e, kt.Rid)
 			return e
 		}
+
+		// 已删除的 kv 不再保留覆盖值
+		for _, one := range result {
+			if e := s.dao.KvOverride().DeleteByKeyWithTx(kt, tx, bizID, appID, one.Spec.Key); e != nil {
+				logs.Errorf("delete kv overrides failed, err: %v, rid: %s", e, kt.Rid)
+				return e
+			}
+		}
 	}
 
 	targetKVStates := []string{
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// PinReleasedGroup pin the online group to it's release, the subsequent publishes of the app, including
+// publishing to all the instances, skip the group until it's unpinned.
+func (s *Service) PinReleasedGroup(ctx context.Context, req *pbds.PinReleasedGroupReq) (*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	// 默认分组仅在其他分组都不匹配时生效, 固定默认分组会导致全量上线无法生效
+	if req.GroupId == 0 {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "the default group can not be pinned"))
+	}
+
+	rg, err := s.getReleasedGroup(kt, req.BizId, req.AppId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ReleaseId != 0 && req.ReleaseId != rg.ReleaseID {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"group %d is online with release %d, only the current release can be pinned", req.GroupId, rg.ReleaseID))
+	}
+
+	if err = s.updateReleasedGroupPinned(kt, rg, true); err != nil {
+		return nil, err
+	}
+
+	logs.Infof("group %d of app %d is pinned to release %d by %s, rid: %s", req.GroupId, req.AppId, rg.ReleaseID,
+		kt.User, kt.Rid)
+	return new(pbbase.EmptyResp), nil
+}
+
+// UnpinReleasedGroup unpin the group, it keeps the current release until the next publish.
+func (s *Service) UnpinReleasedGroup(ctx context.Context, req *pbds.UnpinReleasedGroupReq) (*pbbase.EmptyResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	rg, err := s.getReleasedGroup(kt, req.BizId, req.AppId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.updateReleasedGroupPinned(kt, rg, false); err != nil {
+		return nil, err
+	}
+
+	logs.Infof("group %d of app %d is unpinned by %s, rid: %s", req.GroupId, req.AppId, kt.User, kt.Rid)
+	return new(pbbase.EmptyResp), nil
+}
+
+// checkPinnedGroups refuse the publish when all the groups to publish are pinned, which takes no effect.
+func (s *Service) checkPinnedGroups(kt *kit.Kit, scope *publishScope) error {
+	targets, err := s.publishTargetGroups(kt, scope)
+	if err != nil || len(targets) == 0 {
+		return err
+	}
+
+	released, err := s.dao.ReleasedGroup().ListAllByAppID(kt, scope.appID, scope.bizID)
+	if err != nil {
+		logs.Errorf("list app %d released groups failed, err: %v, rid: %s", scope.appID, err, kt.Rid)
+		return err
+	}
+
+	pinned := make(map[uint32]bool)
+	for _, one := range released {
+		if one.Pinned {
+			pinned[one.GroupID] = true
+		}
+	}
+
+	names := make([]string, 0, len(targets))
+	for _, one := range targets {
+		// 按标签上线的新分组没有ID, 不会被固定
+		if one.ID == 0 || !pinned[one.ID] {
+			return nil
+		}
+		names = append(names, one.Spec.Name)
+	}
+
+	return errf.Errorf(errf.InvalidArgument, i18n.T(kt, "groups %s are pinned, unpin them before publishing",
+		strings.Join(names, ",")))
+}
+
+func (s *Service) getReleasedGroup(kt *kit.Kit, bizID, appID, groupID uint32) (*table.ReleasedGroup, error) {
+	list, err := s.dao.ReleasedGroup().ListAllByAppID(kt, appID, bizID)
+	if err != nil {
+		logs.Errorf("list app %d released groups failed, err: %v, rid: %s", appID, err, kt.Rid)
+		return nil, err
+	}
+
+	for _, one := range list {
+		if one.GroupID == groupID {
+			return one, nil
+		}
+	}
+
+	return nil, errf.Errorf(errf.RecordNotFound, i18n.T(kt, "group %d is not online in the app", groupID))
+}
+
+// updateReleasedGroupPinned update the released group's pinned state, and notify the feed server to refresh the
+// released groups, because the pinned group takes precedence when matching release.
+func (s *Service) updateReleasedGroupPinned(kt *kit.Kit, rg *table.ReleasedGroup, pinned bool) error {
+	if rg.Pinned == pinned {
+		return nil
+	}
+
+	tx := s.dao.GenQuery().Begin()
+	rollback := func(err error) error {
+		if rErr := tx.Rollback(); rErr != nil {
+			logs.Errorf("transaction rollback failed, err: %v, rid: %s", rErr, kt.Rid)
+		}
+		return err
+	}
+
+	if err := s.dao.ReleasedGroup().UpdatePinnedWithTx(kt, tx, rg.AppID, rg.GroupID, rg.BizID, pinned); err != nil {
+		logs.Errorf("update released group %d pinned failed, err: %v, rid: %s", rg.GroupID, err, kt.Rid)
+		return rollback(err)
+	}
+
+	// 与上线事件相同, 通知刷新分组上线缓存
+	event := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Publish,
+			ResourceID: rg.ReleaseID,
+			OpType:     table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: rg.BizID, AppID: rg.AppID},
+		Revision:   &table.CreatedRevision{Creator: kt.User},
+	}
+	if err := s.dao.Event().Eventf(kt).FireWithTx(tx, event); err != nil {
+		logs.Errorf("fire released group pinned event failed, err: %v, rid: %s", err, kt.Rid)
+		return rollback(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logs.Errorf("commit transaction failed, err: %v, rid: %s", err, kt.Rid)
+		return err
+	}
+
+	return nil
+}
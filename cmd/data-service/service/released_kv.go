@@ -15,11 +15,14 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
+	"github.com/pmezard/go-difflib/difflib"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -52,6 +55,32 @@ func (s *Service) GetReleasedKv(ctx context.Context, req *pbds.GetReleasedKvReq)
 		return nil, err
 	}
 
+	// 获取客户端匹配的分组覆盖值
+	if req.GroupId > 0 {
+		rkvo, e := s.dao.ReleasedKvOverride().Get(kt, req.BizId, req.AppId, req.ReleaseId, req.GroupId, req.Key)
+		if e != nil {
+			logs.Errorf("get released kv override failed, err: %v, rid: %s", e, kt.Rid)
+			if errors.Is(e, gorm.ErrRecordNotFound) {
+				return nil, status.Errorf(codes.NotFound, e.Error())
+			}
+			return nil, e
+		}
+
+		kvType, value, err = s.vault.GetKvOverride(kt, &types.GetKvOverrideOption{
+			BizID:     req.BizId,
+			AppID:     req.AppId,
+			ReleaseID: req.ReleaseId,
+			Key:       req.Key,
+			GroupID:   req.GroupId,
+			Version:   int(rkvo.Spec.Version),
+		})
+		if err != nil {
+			logs.Errorf("get vault released kv override failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		rkv.ContentSpec = rkvo.ContentSpec
+	}
+
 	return &pbrkv.ReleasedKv{
 		Id:        rkv.ID,
 		ReleaseId: rkv.ReleaseID,
@@ -135,3 +164,152 @@ func (s *Service) getReleasedKv(kt *kit.Kit, bizID, appID, version, releasedID u
 	}
 	return s.vault.GetRKv(kt, opt)
 }
+
+// ListKvHistory list the history timeline of a kv, the unreleased change comes first if exists.
+func (s *Service) ListKvHistory(ctx context.Context, req *pbds.ListKvHistoryReq) (*pbds.ListKvHistoryResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	details := make([]*pbrkv.KvHistory, 0)
+
+	// 未命名版本中有变更时作为第一条记录
+	draft, err := s.dao.Kv().GetByKvState(kt, req.BizId, req.AppId, req.Key,
+		[]string{string(table.KvStateAdd), string(table.KvStateRevise)})
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get kv failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if draft != nil {
+		details = append(details, &pbrkv.KvHistory{
+			KvType:      string(draft.Spec.KvType),
+			ContentSpec: pbcontent.PbContentSpec(draft.ContentSpec),
+			Author:      draft.Revision.Reviser,
+			UpdatedAt:   draft.Revision.UpdatedAt.Format(time.RFC3339),
+		})
+	}
+
+	rkvs, err := s.dao.ReleasedKv().ListAllByKey(kt, req.BizId, req.AppId, req.Key)
+	if err != nil {
+		logs.Errorf("list released kvs by key failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if len(rkvs) == 0 {
+		return &pbds.ListKvHistoryResp{Details: details}, nil
+	}
+
+	releaseIDs := make([]uint32, 0, len(rkvs))
+	for _, rkv := range rkvs {
+		releaseIDs = append(releaseIDs, rkv.ReleaseID)
+	}
+	releases, err := s.dao.Release().ListAllByIDs(kt, releaseIDs, req.BizId)
+	if err != nil {
+		logs.Errorf("list releases failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	releaseMap := make(map[uint32]*table.Release, len(releases))
+	for _, r := range releases {
+		releaseMap[r.ID] = r
+	}
+
+	gcrs, err := s.dao.ReleasedGroup().ListAllByAppID(kt, req.AppId, req.BizId)
+	if err != nil {
+		logs.Errorf("list group current releases failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	for _, rkv := range rkvs {
+		history := &pbrkv.KvHistory{
+			ReleaseId:   rkv.ReleaseID,
+			KvType:      string(rkv.Spec.KvType),
+			ContentSpec: pbcontent.PbContentSpec(rkv.ContentSpec),
+			Author:      rkv.Revision.Reviser,
+			UpdatedAt:   rkv.Revision.UpdatedAt.Format(time.RFC3339),
+		}
+		history.PublishStatus, _ = s.queryPublishStatus(gcrs, rkv.ReleaseID)
+		if r, ok := releaseMap[rkv.ReleaseID]; ok {
+			history.ReleaseName = r.Spec.Name
+			history.Deprecated = r.Spec.Deprecated
+		}
+		details = append(details, history)
+	}
+
+	return &pbds.ListKvHistoryResp{Details: details}, nil
+}
+
+// DiffKvVersions compare the values of a kv in two versions, release id 0 means the unreleased kv.
+func (s *Service) DiffKvVersions(ctx context.Context, req *pbds.DiffKvVersionsReq) (*pbds.DiffKvVersionsResp,
+	error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	base, err := s.getKvOfRelease(kt, req.BizId, req.AppId, req.BaseReleaseId, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.getKvOfRelease(kt, req.BizId, req.AppId, req.TargetReleaseId, req.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(base.value),
+		B:        difflib.SplitLines(target.value),
+		FromFile: base.label,
+		ToFile:   target.label,
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.DiffKvVersionsResp{
+		KvType:       string(target.spec.KvType),
+		BaseValue:    base.value,
+		TargetValue:  target.value,
+		Diff:         diff,
+		SecretHidden: base.spec.SecretHidden || target.spec.SecretHidden,
+		BaseLabel:    base.label,
+		TargetLabel:  target.label,
+	}, nil
+}
+
+type kvOfRelease struct {
+	spec  *table.KvSpec
+	value string
+	label string
+}
+
+// getKvOfRelease get the kv value of the release, release id 0 means the unreleased kv.
+func (s *Service) getKvOfRelease(kt *kit.Kit, bizID, appID, releaseID uint32, key string) (*kvOfRelease, error) {
+	if releaseID == 0 {
+		kv, err := s.dao.Kv().GetByKvState(kt, bizID, appID, key, []string{string(table.KvStateAdd),
+			string(table.KvStateRevise), string(table.KvStateUnchange)})
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errf.ErrKvNotExists.New(kt, key)
+			}
+			return nil, err
+		}
+		_, value, err := s.getKv(kt, bizID, appID, kv.Spec.Version, key)
+		if err != nil {
+			return nil, err
+		}
+		return &kvOfRelease{spec: kv.Spec, value: value, label: key}, nil
+	}
+
+	rkv, err := s.dao.ReleasedKv().Get(kt, bizID, appID, releaseID, key)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.ErrKvNotExists.New(kt, key)
+		}
+		return nil, err
+	}
+	_, value, err := s.getReleasedKv(kt, bizID, appID, rkv.Spec.Version, releaseID, key)
+	if err != nil {
+		return nil, err
+	}
+
+	label := key
+	if release, e := s.dao.Release().Get(kt, bizID, appID, releaseID); e == nil {
+		label = key + "@" + release.Spec.Name
+	}
+	return &kvOfRelease{spec: rkv.Spec, value: value, label: label}, nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// NewRepoReplicator new repo replicator
+func NewRepoReplicator(mgr *repository.ReplicaManager, sd serviced.Service) *RepoReplicator {
+	return &RepoReplicator{
+		mgr:   mgr,
+		state: sd,
+	}
+}
+
+// RepoReplicator replicates the uploaded files from the source repo to the per-region replicas, so that the
+// clients in remote regions download from the local replica instead of crossing the WAN links.
+type RepoReplicator struct {
+	mgr   *repository.ReplicaManager
+	state serviced.Service
+}
+
+// Run runs the repo replicator
+func (r *RepoReplicator) Run() {
+	logs.Infof("begin run repo replicator, regions: %v", r.mgr.Regions())
+	kt := kit.New()
+	stop := make(chan struct{})
+
+	go func() {
+		notifier := shutdown.AddNotifier()
+		<-notifier.Signal
+		close(stop)
+		notifier.Done()
+	}()
+
+	go func() {
+		// wait for 2 minutes to ensure one of the new instance has been elected as master.
+		time.Sleep(time.Minute * 2)
+		r.recoverAck(kt)
+
+		for i := 0; i < 10; i++ {
+			go r.worker(kt, stop)
+		}
+	}()
+}
+
+// recoverAck moves the msgs left in the ack queue when the service crashed back to the replication queue.
+func (r *RepoReplicator) recoverAck(kt *kit.Kit) {
+	if !r.state.IsMaster() {
+		return
+	}
+
+	client := r.mgr.QueueClient()
+	for {
+		msg, err := client.RPopLPush(kt.Ctx, r.mgr.AckQueueName(), r.mgr.QueueName())
+		if err != nil {
+			logs.Errorf("move msg from replicate ack queue failed, err: %v, rid: %s", err, kt.Rid)
+		}
+		if msg == "" {
+			return
+		}
+	}
+}
+
+func (r *RepoReplicator) worker(kt *kit.Kit, stop <-chan struct{}) {
+	client := r.mgr.QueueClient()
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		if !r.state.IsMaster() {
+			time.Sleep(time.Second * 10)
+			continue
+		}
+
+		kt2 := kt.Clone()
+		msg, err := client.BRPopLPush(kt2.Ctx, r.mgr.QueueName(), r.mgr.AckQueueName(), 5)
+		if err != nil {
+			logs.Errorf("pop msg from replicate queue failed, err: %v, rid: %s", err, kt2.Rid)
+			time.Sleep(time.Second)
+			continue
+		}
+		if msg == "" {
+			continue
+		}
+
+		r.handle(kt2, msg)
+		if err := client.LRem(kt2.Ctx, r.mgr.AckQueueName(), 0, msg); err != nil {
+			logs.Errorf("remove msg from replicate ack queue failed, err: %v, rid: %s", err, kt2.Rid)
+		}
+	}
+}
+
+// handle replicates the file of the msg, the msg is pushed back to the queue after a delay if failed.
+func (r *RepoReplicator) handle(kt *kit.Kit, msg string) {
+	region, bizID, sign, err := r.mgr.ParseQueueMsg(msg)
+	if err != nil {
+		logs.Errorf("parse replicate queue msg failed, err: %v, rid: %s", err, kt.Rid)
+		return
+	}
+
+	kt.BizID = bizID
+	_, err = r.mgr.Replicate(kt, region, sign)
+	if err == nil || err == repository.ErrNoFileInMaster {
+		return
+	}
+
+	logs.Errorf("replicate repo file failed, msg: %s, err: %v, rid: %s", msg, err, kt.Rid)
+	// 副本存储异常时延迟后重新入队, 避免快速重试
+	go func() {
+		time.Sleep(time.Minute)
+		if e := r.mgr.QueueClient().LPush(kt.Ctx, r.mgr.QueueName(), msg); e != nil {
+			logs.Errorf("push msg back to replicate queue failed, err: %v, rid: %s", e, kt.Rid)
+		}
+	}()
+}
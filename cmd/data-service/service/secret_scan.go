@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbss "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/secret-scan"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/secretscan"
+)
+
+// GetSecretScanSetting get the secret scan setting of the biz, the default setting is returned
+// if the biz has not set it.
+func (s *Service) GetSecretScanSetting(ctx context.Context, req *pbds.GetSecretScanSettingReq) (
+	*pbds.GetSecretScanSettingResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	setting, err := s.getSecretScanSetting(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.GetSecretScanSettingResp{
+		Setting:      pbss.PbSecretScanSetting(setting),
+		BuiltinRules: secretscan.BuiltinRuleNames(),
+	}, nil
+}
+
+// UpdateSecretScanSetting create or update the secret scan setting of the biz.
+func (s *Service) UpdateSecretScanSetting(ctx context.Context, req *pbds.UpdateSecretScanSettingReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.Spec.SecretScanSettingSpec()
+	if spec == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "secret scan setting spec is required"))
+	}
+
+	old, err := s.dao.SecretScanSetting().Get(kt, req.BizId)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		setting := &table.SecretScanSetting{
+			Spec:       spec,
+			Attachment: &table.SecretScanSettingAttachment{BizID: req.BizId},
+			Revision: &table.Revision{
+				Creator: kt.User,
+				Reviser: kt.User,
+			},
+		}
+		if _, err = s.dao.SecretScanSetting().Create(kt, setting); err != nil {
+			logs.Errorf("create secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, errf.New(errf.InvalidArgument, err.Error())
+		}
+		return new(pbbase.EmptyResp), nil
+	}
+
+	setting := &table.SecretScanSetting{
+		ID:         old.ID,
+		Spec:       spec,
+		Attachment: old.Attachment,
+		Revision: &table.Revision{
+			Creator:   old.Revision.Creator,
+			CreatedAt: old.Revision.CreatedAt,
+			Reviser:   kt.User,
+		},
+	}
+	if err = s.dao.SecretScanSetting().Update(kt, setting); err != nil {
+		logs.Errorf("update secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ScanSecretLeak scan the sample content with the spec in request or the biz's setting.
+func (s *Service) ScanSecretLeak(ctx context.Context, req *pbds.ScanSecretLeakReq) (*pbds.ScanSecretLeakResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	spec := req.Spec.SecretScanSettingSpec()
+	if spec == nil {
+		setting, err := s.getSecretScanSetting(kt, req.BizId)
+		if err != nil {
+			logs.Errorf("get secret scan setting failed, err: %v, rid: %s", err, kt.Rid)
+			return nil, err
+		}
+		spec = setting.Spec
+	} else if err := spec.Validate(); err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	scanner, err := spec.Scanner()
+	if err != nil {
+		return nil, errf.New(errf.InvalidArgument, err.Error())
+	}
+
+	return &pbds.ScanSecretLeakResp{
+		Findings: pbss.PbSecretLeakFindings(req.Name, scanner.Scan(req.Content)),
+	}, nil
+}
+
+// getSecretScanSetting get the secret scan setting of the biz, returns the default one if not set.
+func (s *Service) getSecretScanSetting(kt *kit.Kit, bizID uint32) (*table.SecretScanSetting, error) {
+	setting, err := s.dao.SecretScanSetting().Get(kt, bizID)
+	if err == nil {
+		return setting, nil
+	}
+
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	return &table.SecretScanSetting{
+		Spec:       table.DefaultSecretScanSettingSpec(),
+		Attachment: &table.SecretScanSettingAttachment{BizID: bizID},
+		Revision:   &table.Revision{},
+	}, nil
+}
+
+// checkSecretLeak scan the config content or kv value with the biz's secret scan setting, returns error
+// if suspected credentials are found and the mode is block, otherwise the findings are only logged.
+func checkSecretLeak(kt *kit.Kit, setting *table.SecretScanSetting, name, content string) error {
+	scanner, err := setting.Spec.Scanner()
+	if err != nil {
+		logs.Errorf("biz %d secret scan setting is invalid, err: %v, rid: %s", setting.Attachment.BizID, err, kt.Rid)
+		return err
+	}
+
+	findings := scanner.Scan(content)
+	if len(findings) == 0 {
+		return nil
+	}
+
+	details := make([]string, 0, len(findings))
+	for _, f := range findings {
+		details = append(details, fmt.Sprintf("line %d: %s", f.Line, f.Rule))
+	}
+
+	if setting.Spec.Mode == table.SecretScanBlock {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kt,
+			"%s appears to contain credentials (%s), please use the secret kv type to store them",
+			name, strings.Join(details, ", ")))
+	}
+
+	logs.Warnf("%s of biz %d appears to contain credentials, %s, rid: %s", name, setting.Attachment.BizID,
+		strings.Join(details, ", "), kt.Rid)
+	return nil
+}
+
+// checkKvSecretLeak scan the kv value, the secret and certificate kvs are skipped since they are
+// expected to contain credentials.
+func checkKvSecretLeak(kt *kit.Kit, setting *table.SecretScanSetting, key string, kvType table.DataType,
+	value string) error {
+	if kvType == table.KvSecret || kvType == table.KvCertBundle {
+		return nil
+	}
+
+	return checkSecretLeak(kt, setting, key, value)
+}
+
+// checkContentSecretLeak download the config content and scan it, the binary file and the content
+// which is too large or not utf-8 text are skipped.
+func (s *Service) checkContentSecretLeak(kt *kit.Kit, setting *table.SecretScanSetting, ci *table.ConfigItemSpec,
+	sign string) error {
+	if setting.Spec.Mode == table.SecretScanOff || ci == nil || ci.FileType == table.Binary || sign == "" {
+		return nil
+	}
+
+	content, ok, err := s.loadComplianceContent(kt, setting.Attachment.BizID, sign)
+	if err != nil {
+		logs.Errorf("download content %s failed, err: %v, rid: %s", sign, err, kt.Rid)
+		return err
+	}
+	if !ok {
+		return nil
+	}
+
+	return checkSecretLeak(kt, setting, path.Join(ci.Path, ci.Name), content)
+}
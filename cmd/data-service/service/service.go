@@ -23,9 +23,13 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/TencentBlueKing/bk-bscp/internal/components/changeticket"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/notifier"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/vault"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/thirdparty/esb/client"
 	"github.com/TencentBlueKing/bk-bscp/internal/tmplprocess"
@@ -47,6 +51,10 @@ type Service struct {
 	esb      client.Client
 	repo     repository.Provider
 	tmplProc tmplprocess.TmplProcessor
+	// changeTicket validates the change tickets referenced by the publishes.
+	changeTicket changeticket.Validator
+	// notifier sends the publish and approval events to the notification channels.
+	notifier *notifier.Notifier
 }
 
 // NewService create a service instance.
@@ -65,6 +73,10 @@ func NewService(sd serviced.Service, ssd serviced.ServiceDiscover, daoSet dao.Se
 
 	// add dial load balancer.
 	opts = append(opts, ssd.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	opts = append(opts, grpc.WithChainUnaryInterceptor(brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor(), brpc.APIVersionUnaryClientInterceptor(),
+		chaos.UnaryClientInterceptor()))
 
 	tls := cc.DataService().Network.TLS
 
@@ -98,6 +110,9 @@ func NewService(sd serviced.Service, ssd serviced.ServiceDiscover, daoSet dao.Se
 		repo:     repo,
 		tmplProc: tmplprocess.NewTmplProcessor(),
 		cs:       pbcs.NewCacheClient(csConn),
+
+		changeTicket: changeticket.NewValidator(cc.DataService().ChangeTicket),
+		notifier:     notifier.New(cc.DataService().Notification),
 	}
 
 	return svc, nil
@@ -0,0 +1,233 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbsa "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/service-account"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// serviceAccountKeyPrefixLen 列表中展示的 api key 前缀长度
+const serviceAccountKeyPrefixLen = len(table.ServiceAccountKeyPrefix) + 6
+
+// lastUsedUpdateInterval 最近使用时间的更新间隔, 避免每次鉴权都写库
+const lastUsedUpdateInterval = time.Minute
+
+// CreateServiceAccount create service account, the api key is only returned in the response.
+func (s *Service) CreateServiceAccount(ctx context.Context, req *pbds.CreateServiceAccountReq) (
+	*pbds.CreateServiceAccountResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if _, err := s.dao.ServiceAccount().GetByName(kt, req.BizId, req.Name); err == nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "service account name %s already exists", req.Name))
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		logs.Errorf("get service account by name failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	expiredAt, err := parseExpiredAt(kt, req.ExpiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	key, hash, err := table.GenerateServiceAccountKey()
+	if err != nil {
+		logs.Errorf("generate service account key failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	sa := &table.ServiceAccount{
+		Spec: &table.ServiceAccountSpec{
+			Name:      req.Name,
+			Memo:      req.Memo,
+			KeyHash:   hash,
+			KeyPrefix: key[:serviceAccountKeyPrefixLen],
+			Scopes:    pbsa.ServiceAccountScopes(req.Scopes),
+			Enabled:   true,
+			ExpiredAt: expiredAt,
+		},
+		Attachment: &table.ServiceAccountAttachment{BizID: req.BizId},
+		Revision: &table.Revision{
+			Creator: kt.User,
+			Reviser: kt.User,
+		},
+	}
+	id, err := s.dao.ServiceAccount().Create(kt, sa)
+	if err != nil {
+		logs.Errorf("create service account failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.CreateServiceAccountResp{Id: id, ApiKey: key}, nil
+}
+
+// ListServiceAccounts list all the service accounts of a biz.
+func (s *Service) ListServiceAccounts(ctx context.Context, req *pbds.ListServiceAccountsReq) (
+	*pbds.ListServiceAccountsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	list, err := s.dao.ServiceAccount().List(kt, req.BizId)
+	if err != nil {
+		logs.Errorf("list service accounts failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListServiceAccountsResp{
+		Count:   uint32(len(list)),
+		Details: pbsa.PbServiceAccounts(list),
+	}, nil
+}
+
+// UpdateServiceAccount update service account's memo, scopes, enabled and expired time.
+func (s *Service) UpdateServiceAccount(ctx context.Context, req *pbds.UpdateServiceAccountReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	old, err := s.dao.ServiceAccount().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get service account (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	expiredAt, err := parseExpiredAt(kt, req.ExpiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	old.Spec.Memo = req.Memo
+	old.Spec.Scopes = pbsa.ServiceAccountScopes(req.Scopes)
+	old.Spec.Enabled = req.Enabled
+	old.Spec.ExpiredAt = expiredAt
+	old.Revision.Reviser = kt.User
+	if err = s.dao.ServiceAccount().Update(kt, old); err != nil {
+		logs.Errorf("update service account (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// DeleteServiceAccount delete service account.
+func (s *Service) DeleteServiceAccount(ctx context.Context, req *pbds.DeleteServiceAccountReq) (
+	*pbbase.EmptyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	if err := s.dao.ServiceAccount().Delete(kt, req.BizId, req.Id); err != nil {
+		logs.Errorf("delete service account (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return new(pbbase.EmptyResp), nil
+}
+
+// ResetServiceAccountKey reset the api key of the service account, the old one is invalid immediately.
+func (s *Service) ResetServiceAccountKey(ctx context.Context, req *pbds.ResetServiceAccountKeyReq) (
+	*pbds.ResetServiceAccountKeyResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	sa, err := s.dao.ServiceAccount().Get(kt, req.BizId, req.Id)
+	if err != nil {
+		logs.Errorf("get service account (%d) failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	key, hash, err := table.GenerateServiceAccountKey()
+	if err != nil {
+		logs.Errorf("generate service account key failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	sa.Spec.KeyHash = hash
+	sa.Spec.KeyPrefix = key[:serviceAccountKeyPrefixLen]
+	sa.Revision.Reviser = kt.User
+	if err = s.dao.ServiceAccount().UpdateKey(kt, sa); err != nil {
+		logs.Errorf("reset service account (%d) key failed, err: %v, rid: %s", req.Id, err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ResetServiceAccountKeyResp{ApiKey: key}, nil
+}
+
+// GetServiceAccountByKey get the enabled and unexpired service account by api key, which is used to
+// authenticate the requests of the service accounts.
+func (s *Service) GetServiceAccountByKey(ctx context.Context, req *pbds.GetServiceAccountByKeyReq) (
+	*pbsa.ServiceAccount, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	sa, err := s.dao.ServiceAccount().GetByKeyHash(kt, table.HashServiceAccountKey(req.ApiKey))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.Unauthenticated, i18n.T(kt, "invalid service account api key"))
+		}
+		logs.Errorf("get service account by key failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	now := time.Now()
+	if !sa.Spec.Enabled {
+		return nil, errf.Errorf(errf.Unauthenticated, i18n.T(kt, "service account %s is disabled", sa.Spec.Name))
+	}
+	if sa.Spec.Expired(now) {
+		return nil, errf.Errorf(errf.Unauthenticated, i18n.T(kt, "service account %s is expired", sa.Spec.Name))
+	}
+
+	if sa.Spec.LastUsedAt == nil || now.Sub(*sa.Spec.LastUsedAt) > lastUsedUpdateInterval {
+		if err := s.dao.ServiceAccount().UpdateLastUsed(kt, sa.Attachment.BizID, sa.ID, now); err != nil {
+			// 更新失败不影响鉴权
+			logs.Errorf("update service account (%d) last used time failed, err: %v, rid: %s", sa.ID, err, kt.Rid)
+		}
+		sa.Spec.LastUsedAt = &now
+	}
+
+	return pbsa.PbServiceAccount(sa), nil
+}
+
+// GetServiceAccountByName get service account by name.
+func (s *Service) GetServiceAccountByName(ctx context.Context, req *pbds.GetServiceAccountByNameReq) (
+	*pbsa.ServiceAccount, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	sa, err := s.dao.ServiceAccount().GetByName(kt, req.BizId, req.Name)
+	if err != nil {
+		logs.Errorf("get service account by name failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return pbsa.PbServiceAccount(sa), nil
+}
+
+// parseExpiredAt parse the RFC3339 expired time, empty means never expired.
+func parseExpiredAt(kt *kit.Kit, expiredAt string) (*time.Time, error) {
+	if expiredAt == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, expiredAt)
+	if err != nil {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "invalid expired time %s, should be RFC3339 format",
+			expiredAt))
+	}
+
+	return &t, nil
+}
@@ -0,0 +1,375 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/dao"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbsr "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/stale-resource"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// staleAppBatchSize is the batch size of listing apps when detecting the stale resources.
+const staleAppBatchSize = 500
+
+// ListStaleResources list the stale resources of a biz detected by the scheduled job.
+func (s *Service) ListStaleResources(ctx context.Context, req *pbds.ListStaleResourcesReq) (
+	*pbds.ListStaleResourcesResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	opt := &types.BasePage{Start: req.Start, Limit: uint(req.Limit), All: req.All}
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, err
+	}
+
+	details, count, err := s.dao.StaleResource().List(kt, req.BizId, req.ResType, opt)
+	if err != nil {
+		logs.Errorf("list stale resources failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	return &pbds.ListStaleResourcesResp{
+		Count:   uint32(count),
+		Details: pbsr.PbStaleResources(details),
+	}, nil
+}
+
+// ScanStaleResources detect the stale apps, config items and credentials of all the bizs, the owners of
+// the newly detected ones are notified, and the ones notified for a while are archived if auto archive
+// is enabled, returns the count of the newly detected resources.
+func (s *Service) ScanStaleResources(kt *kit.Kit) (int, error) {
+	bizApps := make(map[uint32][]*table.App)
+	var lastID uint32
+	for {
+		apps, err := s.dao.App().ListByCursor(kt, lastID, staleAppBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, app := range apps {
+			bizApps[app.BizID] = append(bizApps[app.BizID], app)
+		}
+
+		if len(apps) < staleAppBatchSize {
+			break
+		}
+		lastID = apps[len(apps)-1].ID
+	}
+
+	detected := 0
+	for bizID, apps := range bizApps {
+		count, err := s.scanBizStaleResources(kt, bizID, apps)
+		if err != nil {
+			// 单个业务失败不影响其他业务的检测
+			logs.Errorf("scan biz %d stale resources failed, err: %v, rid: %s", bizID, err, kt.Rid)
+			continue
+		}
+		detected += count
+	}
+
+	return detected, nil
+}
+
+// scanBizStaleResources sync the stale resources of one biz with the detected ones, returns the count of
+// the newly detected resources.
+func (s *Service) scanBizStaleResources(kt *kit.Kit, bizID uint32, apps []*table.App) (int, error) {
+	opt := cc.DataService().Stale
+	now := time.Now().UTC()
+
+	candidates, owners, err := s.detectBizStaleResources(kt, bizID, apps, now, opt.Days)
+	if err != nil {
+		return 0, err
+	}
+
+	existing, err := s.dao.StaleResource().ListAll(kt, bizID)
+	if err != nil {
+		return 0, err
+	}
+	existMap := make(map[string]*table.StaleResource, len(existing))
+	for _, one := range existing {
+		existMap[staleResourceKey(one.Spec.ResType, one.Spec.ResID)] = one
+	}
+
+	toCreate := make([]*table.StaleResource, 0)
+	for _, one := range candidates {
+		key := staleResourceKey(one.Spec.ResType, one.Spec.ResID)
+		if _, ok := existMap[key]; ok {
+			delete(existMap, key)
+			continue
+		}
+		one.Spec.NotifiedAt = now
+		s.notifyStaleResource(kt, one, owners[key], opt.Days)
+		toCreate = append(toCreate, one)
+	}
+	if err = s.dao.StaleResource().BatchCreate(kt, toCreate); err != nil {
+		return 0, err
+	}
+
+	// 重新被消费或已被删除的资源不再是闲置资源, 已归档的保留记录以便查询
+	toDelete := make([]uint32, 0)
+	for _, one := range existMap {
+		if one.Spec.ArchivedAt == nil {
+			toDelete = append(toDelete, one.ID)
+		}
+	}
+	if err = s.dao.StaleResource().BatchDelete(kt, bizID, toDelete); err != nil {
+		return 0, err
+	}
+
+	if !opt.AutoArchive {
+		return len(toCreate), nil
+	}
+
+	for _, one := range existing {
+		if _, gone := existMap[staleResourceKey(one.Spec.ResType, one.Spec.ResID)]; gone {
+			continue
+		}
+		if !one.Spec.ShouldArchive(now, opt.ArchiveAfterDays) {
+			continue
+		}
+		if err = s.archiveStaleResource(kt, one); err != nil {
+			logs.Errorf("archive stale %s %d failed, err: %v, rid: %s", one.Spec.ResType, one.Spec.ResID, err, kt.Rid)
+			continue
+		}
+		if err = s.dao.StaleResource().UpdateArchived(kt, bizID, one.ID, now); err != nil {
+			logs.Errorf("update stale resource %d archived failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+		}
+	}
+
+	return len(toCreate), nil
+}
+
+// detectBizStaleResources detect the stale resources of the biz, returns them with their owners:
+// 1. the app which is not consumed in the days, or never consumed and created before the days.
+// 2. the config item of the active app which is not in any published release and not updated in the days.
+// 3. the enabled credential which is not updated in the days and grants none of the active apps.
+func (s *Service) detectBizStaleResources(kt *kit.Kit, bizID uint32, apps []*table.App, now time.Time,
+	days uint) ([]*table.StaleResource, map[string]string, error) {
+	deadline := now.Add(-time.Duration(days) * 24 * time.Hour)
+	result := make([]*table.StaleResource, 0)
+	owners := make(map[string]string)
+	add := func(resType table.StaleResType, resID, appID uint32, name, reason, owner string, lastActive *time.Time) {
+		result = append(result, &table.StaleResource{
+			Spec: &table.StaleResourceSpec{
+				ResType:      resType,
+				ResID:        resID,
+				Name:         name,
+				Reason:       reason,
+				LastActiveAt: lastActive,
+			},
+			Attachment: &table.StaleResourceAttachment{BizID: bizID, AppID: appID},
+		})
+		owners[staleResourceKey(resType, resID)] = owner
+	}
+
+	activeApps := make([]*table.App, 0, len(apps))
+	for _, app := range apps {
+		last := app.Spec.LastConsumedTime
+		if (last != nil && last.Before(deadline)) || (last == nil && app.Revision.CreatedAt.Before(deadline)) {
+			add(table.StaleApp, app.ID, app.ID, app.Spec.Name, fmt.Sprintf("not consumed in %d days", days),
+				app.Revision.Creator, last)
+			continue
+		}
+		activeApps = append(activeApps, app)
+	}
+
+	for _, app := range activeApps {
+		if app.Spec.ConfigType != table.File {
+			continue
+		}
+		cis, err := s.listUnreleasedConfigItems(kt, app)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, ci := range cis {
+			if ci.Revision.UpdatedAt.After(deadline) {
+				continue
+			}
+			updatedAt := ci.Revision.UpdatedAt
+			add(table.StaleConfigItem, ci.ID, app.ID, recycleConfigItemName(ci),
+				fmt.Sprintf("not released and not updated in %d days", days), ci.Revision.Reviser, &updatedAt)
+		}
+	}
+
+	enable := true
+	credentials, _, err := s.dao.Credential().List(kt, bizID, "", &types.BasePage{All: true}, nil, "", &enable)
+	if err != nil {
+		return nil, nil, err
+	}
+	scopes, err := s.dao.CredentialScope().ListAll(kt, bizID)
+	if err != nil {
+		return nil, nil, err
+	}
+	credScopes := make(map[uint32][]*table.CredentialScope)
+	for _, one := range scopes {
+		credScopes[one.Attachment.CredentialId] = append(credScopes[one.Attachment.CredentialId], one)
+	}
+	for _, one := range credentials {
+		if one.Revision.UpdatedAt.After(deadline) || credentialGrantsAny(credScopes[one.ID], activeApps) {
+			continue
+		}
+		updatedAt := one.Revision.UpdatedAt
+		add(table.StaleCredential, one.ID, 0, one.Spec.Name, fmt.Sprintf("grants no app consumed in %d days", days),
+			one.Revision.Creator, &updatedAt)
+	}
+
+	return result, owners, nil
+}
+
+// listUnreleasedConfigItems list the config items of the app which are not in any published release.
+func (s *Service) listUnreleasedConfigItems(kt *kit.Kit, app *table.App) ([]*table.ConfigItem, error) {
+	cis, err := s.dao.ConfigItem().ListAllByAppID(kt, app.ID, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+	if len(cis) == 0 {
+		return nil, nil
+	}
+
+	groups, err := s.dao.ReleasedGroup().ListAllByAppID(kt, app.ID, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+	releaseIDs := make([]uint32, 0, len(groups))
+	for _, one := range groups {
+		releaseIDs = append(releaseIDs, one.ReleaseID)
+	}
+	// 未上线过的服务不检测配置项, 由服务本身的闲置检测覆盖
+	if len(releaseIDs) == 0 {
+		return nil, nil
+	}
+
+	rcis, err := s.dao.ReleasedCI().ListAllByReleaseIDs(kt, releaseIDs, app.BizID)
+	if err != nil {
+		return nil, err
+	}
+	released := make(map[uint32]bool, len(rcis))
+	for _, one := range rcis {
+		released[one.ConfigItemID] = true
+	}
+
+	result := make([]*table.ConfigItem, 0)
+	for _, ci := range cis {
+		if !released[ci.ID] {
+			result = append(result, ci)
+		}
+	}
+
+	return result, nil
+}
+
+// credentialGrantsAny returns whether the credential scopes grant any of the apps.
+func credentialGrantsAny(scopes []*table.CredentialScope, apps []*table.App) bool {
+	for _, scope := range scopes {
+		for _, app := range apps {
+			if ok, _ := scope.Spec.CredentialScope.MatchApp(app.Spec.Name); ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// notifyStaleResource notify the owner of the newly detected stale resource, which is recorded as audit.
+func (s *Service) notifyStaleResource(kt *kit.Kit, res *table.StaleResource, owner string, days uint) {
+	logs.Warnf("%s %s of biz %d is not consumed in %d days, owner: %s, rid: %s", res.Spec.ResType, res.Spec.Name,
+		res.Attachment.BizID, days, owner, kt.Rid)
+
+	detail, _ := json.Marshal(map[string]interface{}{
+		"res_type": res.Spec.ResType,
+		"reason":   res.Spec.Reason,
+		"owner":    owner,
+	})
+	audit := &table.Audit{
+		BizID:        res.Attachment.BizID,
+		AppID:        res.Attachment.AppID,
+		ResourceType: staleAuditResType(res.Spec.ResType),
+		ResourceID:   res.Spec.ResID,
+		Action:       enumor.Warn,
+		Rid:          kt.Rid,
+		Operator:     kt.User,
+		CreatedAt:    time.Now(),
+		Detail:       string(detail),
+		ResInstance:  fmt.Sprintf("stale %s: %s", res.Spec.ResType, res.Spec.Name),
+		OperateWay:   string(enumor.API),
+		Status:       enumor.Success,
+	}
+	if err := s.dao.AuditDao().One(kt, audit, &dao.AuditOption{}); err != nil {
+		logs.Errorf("emit stale %s %d notification failed, err: %v, rid: %s", res.Spec.ResType, res.Spec.ResID,
+			err, kt.Rid)
+	}
+}
+
+// archiveStaleResource archive the stale resource, the app and config item are moved into recycle bin
+// so that they can be restored, and the credential is disabled.
+func (s *Service) archiveStaleResource(kt *kit.Kit, res *table.StaleResource) error {
+	ctx := metadata.NewIncomingContext(kt.Ctx, kt.RPCMetaData())
+	bizID := res.Attachment.BizID
+	switch res.Spec.ResType {
+	case table.StaleApp:
+		_, err := s.DeleteApp(ctx, &pbds.DeleteAppReq{Id: res.Spec.ResID, BizId: bizID})
+		return err
+	case table.StaleConfigItem:
+		_, err := s.DeleteConfigItem(ctx, &pbds.DeleteConfigItemReq{
+			Id:         res.Spec.ResID,
+			Attachment: &pbci.ConfigItemAttachment{BizId: bizID, AppId: res.Attachment.AppID},
+		})
+		return err
+	case table.StaleCredential:
+		old, err := s.dao.Credential().Get(kt, bizID, res.Spec.ResID)
+		if err != nil {
+			return err
+		}
+		return s.dao.Credential().Update(kt, &table.Credential{
+			ID: old.ID,
+			Spec: &table.CredentialSpec{
+				Name:   old.Spec.Name,
+				Memo:   old.Spec.Memo,
+				Enable: false,
+			},
+			Attachment: old.Attachment,
+			Revision:   &table.Revision{Reviser: kt.User},
+		})
+	}
+
+	return fmt.Errorf("unsupported stale resource type: %s", res.Spec.ResType)
+}
+
+// staleAuditResType returns the audit resource type of the stale resource.
+func staleAuditResType(resType table.StaleResType) enumor.AuditResourceType {
+	switch resType {
+	case table.StaleApp:
+		return enumor.App
+	case table.StaleCredential:
+		return enumor.Credential
+	}
+
+	return enumor.Config
+}
+
+func staleResourceKey(resType table.StaleResType, resID uint32) string {
+	return fmt.Sprintf("%s-%d", resType, resID)
+}
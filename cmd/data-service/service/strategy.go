@@ -133,6 +133,39 @@ func (s *Service) GetLastPublish(ctx context.Context, req *pbds.GetLastPublishRe
 	return resp, nil
 }
 
+// ListRecentPublishes list the latest published releases of the apps in a biz
+func (s *Service) ListRecentPublishes(ctx context.Context, req *pbds.ListRecentPublishesReq) (
+	*pbds.ListRecentPublishesResp, error) {
+	grpcKit := kit.FromGrpcContext(ctx)
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = 10
+	}
+
+	records, err := s.dao.Release().ListRecentPublishes(grpcKit, req.BizId, req.AppIds, limit)
+	if err != nil {
+		logs.Errorf("list recent publishes failed, err: %v, rid: %s", err, grpcKit.Rid)
+		return nil, err
+	}
+
+	details := make([]*release.PublishRecord, 0, len(records))
+	for _, v := range records {
+		details = append(details, &release.PublishRecord{
+			PublishTime:       v.PublishTime,
+			Name:              v.Name,
+			Scope:             pbstrategy.PbScope(&v.Scope),
+			Creator:           v.Creator,
+			FullyReleased:     v.FullyReleased,
+			FinalApprovalTime: v.FinalApprovalTime.Format(time.DateTime),
+			AppId:             v.AppID,
+			ReleaseId:         v.ReleaseID,
+		})
+	}
+
+	return &pbds.ListRecentPublishesResp{Details: details}, nil
+}
+
 // GetReleasesStatus get last releases status
 func (s *Service) GetReleasesStatus(ctx context.Context, req *pbds.GetReleasesStatusReq) (*pbstrategy.Strategy, error) {
 	grpcKit := kit.FromGrpcContext(ctx)
@@ -0,0 +1,298 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbwu "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/where-used"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// publishedRelease is a release which is published to some groups of the app.
+type publishedRelease struct {
+	appID     uint32
+	releaseID uint32
+	groupIDs  []uint32
+}
+
+// ListTmplWhereUsed list the apps, config items and published releases which use the template.
+func (s *Service) ListTmplWhereUsed(ctx context.Context, req *pbds.ListTmplWhereUsedReq) (
+	*pbds.ListWhereUsedResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	tmpl, err := s.dao.Template().GetByID(kt, req.BizId, req.TemplateId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "template %d not found", req.TemplateId))
+		}
+		logs.Errorf("get template failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	if tmpl.Attachment.TemplateSpaceID != req.TemplateSpaceId {
+		return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "template %d not found", req.TemplateId))
+	}
+
+	apps := make(map[uint32]*pbwu.WhereUsedApp)
+
+	// 当前编辑态绑定了该模板的服务
+	atbs, err := s.dao.TemplateBindingRelation().ListTemplatesBoundATBs(kt, req.BizId, []uint32{req.TemplateId})
+	if err != nil {
+		logs.Errorf("list template bound app template bindings failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	bound := make(map[uint32]*table.TemplateRevisionBinding, len(atbs))
+	revisionIDs := make([]uint32, 0, len(atbs))
+	for _, atb := range atbs {
+		for _, binding := range atb.Spec.Bindings {
+			for _, r := range binding.TemplateRevisions {
+				if r.TemplateID == req.TemplateId {
+					bound[atb.Attachment.AppID] = r
+					revisionIDs = append(revisionIDs, r.TemplateRevisionID)
+				}
+			}
+		}
+	}
+	revisions, err := s.dao.TemplateRevision().ListByIDs(kt, revisionIDs)
+	if err != nil {
+		logs.Errorf("list template revisions failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	revisionMap := make(map[uint32]*table.TemplateRevision, len(revisions))
+	for _, r := range revisions {
+		revisionMap[r.ID] = r
+	}
+	for appID, r := range bound {
+		if ci := pbwu.PbWhereUsedTmplConfigItem(revisionMap[r.TemplateRevisionID], r.IsLatest); ci != nil {
+			app := whereUsedApp(apps, appID)
+			app.ConfigItems = append(app.ConfigItems, ci)
+		}
+	}
+
+	// 上线中的版本里包含该模板的服务
+	published, err := s.listPublishedReleases(kt, req.BizId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range published {
+		tmpls, _, e := s.dao.ReleasedAppTemplate().List(kt, req.BizId, p.appID, p.releaseID, nil,
+			&types.BasePage{All: true}, "")
+		if e != nil {
+			logs.Errorf("list released app templates failed, err: %v, rid: %s", e, kt.Rid)
+			return nil, e
+		}
+		for _, t := range tmpls {
+			if t.Spec.TemplateID != req.TemplateId {
+				continue
+			}
+			app := whereUsedApp(apps, p.appID)
+			app.Releases = append(app.Releases, &pbwu.WhereUsedRelease{
+				ReleaseId:            p.releaseID,
+				GroupIds:             p.groupIDs,
+				TemplateRevisionId:   t.Spec.TemplateRevisionID,
+				TemplateRevisionName: t.Spec.TemplateRevisionName,
+			})
+			break
+		}
+	}
+
+	details, err := s.fillWhereUsedNames(kt, req.BizId, apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.ListWhereUsedResp{Details: details}, nil
+}
+
+// ListTmplVariableWhereUsed list the apps, config items and published releases which use the template variable.
+func (s *Service) ListTmplVariableWhereUsed(ctx context.Context, req *pbds.ListTmplVariableWhereUsedReq) (
+	*pbds.ListWhereUsedResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+
+	variable, err := s.dao.TemplateVariable().GetByID(kt, req.BizId, req.TemplateVariableId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errf.Errorf(errf.InvalidArgument, i18n.T(kt, "template variable %d not found",
+				req.TemplateVariableId))
+		}
+		logs.Errorf("get template variable failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	name := variable.Spec.Name
+
+	apps := make(map[uint32]*pbwu.WhereUsedApp)
+
+	// 当前编辑态中引用了该变量的配置文件，需解析服务下所有配置文件的内容
+	fileApps, _, err := s.dao.App().List(kt, []uint32{req.BizId}, "", string(table.File), "",
+		&types.BasePage{All: true})
+	if err != nil {
+		logs.Errorf("list file apps failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, app := range fileApps {
+		appKt := kt.Clone()
+		appKt.BizID, appKt.AppID = req.BizId, app.ID
+		tmplRevisions, cis, e := s.getAllAppCIs(appKt)
+		if e != nil {
+			logs.Errorf("get app %d config items failed, err: %v, rid: %s", app.ID, e, kt.Rid)
+			return nil, e
+		}
+		refs, e := s.getVariableReferences(appKt, tmplRevisions, cis)
+		if e != nil {
+			logs.Errorf("get app %d variable references failed, err: %v, rid: %s", app.ID, e, kt.Rid)
+			return nil, e
+		}
+		for _, ref := range refs {
+			if ref.VariableName != name {
+				continue
+			}
+			used := whereUsedApp(apps, app.ID)
+			for _, one := range ref.References {
+				used.ConfigItems = append(used.ConfigItems, &pbwu.WhereUsedConfigItem{
+					Id:                 one.Id,
+					TemplateRevisionId: one.TemplateRevisionId,
+					Name:               one.Name,
+					Path:               one.Path,
+				})
+			}
+		}
+	}
+
+	// 上线中的版本在生成时使用了该变量的服务
+	published, err := s.listPublishedReleases(kt, req.BizId)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range published {
+		vars, e := s.dao.ReleasedAppTemplateVariable().ListVariables(kt, req.BizId, p.appID, p.releaseID)
+		if e != nil {
+			logs.Errorf("list released app template variables failed, err: %v, rid: %s", e, kt.Rid)
+			return nil, e
+		}
+		for _, v := range vars {
+			if v.Name == name {
+				app := whereUsedApp(apps, p.appID)
+				app.Releases = append(app.Releases, &pbwu.WhereUsedRelease{
+					ReleaseId: p.releaseID,
+					GroupIds:  p.groupIDs,
+				})
+				break
+			}
+		}
+	}
+
+	details, err := s.fillWhereUsedNames(kt, req.BizId, apps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbds.ListWhereUsedResp{Details: details}, nil
+}
+
+// listPublishedReleases list the releases which are published to groups currently in the biz.
+func (s *Service) listPublishedReleases(kt *kit.Kit, bizID uint32) ([]*publishedRelease, error) {
+	rgs, err := s.dao.ReleasedGroup().ListAll(kt, bizID)
+	if err != nil {
+		logs.Errorf("list released groups failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+
+	releases := make(map[uint32]*publishedRelease)
+	for _, rg := range rgs {
+		if _, ok := releases[rg.ReleaseID]; !ok {
+			releases[rg.ReleaseID] = &publishedRelease{appID: rg.AppID, releaseID: rg.ReleaseID}
+		}
+		releases[rg.ReleaseID].groupIDs = append(releases[rg.ReleaseID].groupIDs, rg.GroupID)
+	}
+
+	result := make([]*publishedRelease, 0, len(releases))
+	for _, one := range releases {
+		result = append(result, one)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].releaseID < result[j].releaseID
+	})
+
+	return result, nil
+}
+
+// fillWhereUsedNames fill the app names and release names of the where-used apps, and sort them by app id.
+func (s *Service) fillWhereUsedNames(kt *kit.Kit, bizID uint32, apps map[uint32]*pbwu.WhereUsedApp) (
+	[]*pbwu.WhereUsedApp, error) {
+
+	appIDs := make([]uint32, 0, len(apps))
+	releaseIDs := make([]uint32, 0)
+	for appID, app := range apps {
+		appIDs = append(appIDs, appID)
+		for _, r := range app.Releases {
+			releaseIDs = append(releaseIDs, r.ReleaseId)
+		}
+	}
+	if len(appIDs) == 0 {
+		return []*pbwu.WhereUsedApp{}, nil
+	}
+
+	appList, err := s.dao.App().ListAppsByIDs(kt, appIDs)
+	if err != nil {
+		logs.Errorf("list apps by ids failed, err: %v, rid: %s", err, kt.Rid)
+		return nil, err
+	}
+	for _, one := range appList {
+		apps[one.ID].AppName = one.Spec.Name
+	}
+
+	if len(releaseIDs) > 0 {
+		releases, e := s.dao.Release().ListAllByIDs(kt, releaseIDs, bizID)
+		if e != nil {
+			logs.Errorf("list releases by ids failed, err: %v, rid: %s", e, kt.Rid)
+			return nil, e
+		}
+		names := make(map[uint32]string, len(releases))
+		for _, one := range releases {
+			names[one.ID] = one.Spec.Name
+		}
+		for _, app := range apps {
+			for _, r := range app.Releases {
+				r.ReleaseName = names[r.ReleaseId]
+			}
+		}
+	}
+
+	details := make([]*pbwu.WhereUsedApp, 0, len(apps))
+	for _, app := range apps {
+		details = append(details, app)
+	}
+	sort.Slice(details, func(i, j int) bool {
+		return details[i].AppId < details[j].AppId
+	})
+
+	return details, nil
+}
+
+// whereUsedApp get the where-used app from the map, create it if not exists.
+func whereUsedApp(apps map[uint32]*pbwu.WhereUsedApp, appID uint32) *pbwu.WhereUsedApp {
+	if _, ok := apps[appID]; !ok {
+		apps[appID] = &pbwu.WhereUsedApp{AppId: appID}
+	}
+
+	return apps[appID]
+}
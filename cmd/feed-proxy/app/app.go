@@ -17,12 +17,15 @@ import (
 	"fmt"
 	"net"
 	"strconv"
+	"time"
 
 	"github.com/Tencent/bk-bcs/bcs-common/common/tcp/listener"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/realip"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-proxy/options"
@@ -73,6 +76,11 @@ func (fs *feedProxy) prepare(opt *options.Option) error {
 
 	logs.InitLogger(cc.FeedProxy().Log.Logs())
 	logs.Infof("load settings from config file success.")
+
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
 	logs.Infof("current service name: %s", opt.Name)
 
 	// init metrics
@@ -86,7 +94,8 @@ func (fs *feedProxy) prepare(opt *options.Option) error {
 	fs.upstreamDirector = upstreamDirector
 
 	// init bscp control tool
-	if err = ctl.LoadCtl(cmd.WithLog()); err != nil {
+	if err = ctl.LoadCtl(append([]cmd.Cmd{cmd.WithLog(), cmd.WithEffectiveSetting(), cmd.WithReloadSetting()},
+		ctl.WithDebugs()...)...); err != nil {
 		return fmt.Errorf("load control tool failed, err: %v", err)
 	}
 
@@ -103,17 +112,33 @@ func (fs *feedProxy) listenAndServe() error {
 
 	recoveryOpt := grpc_recovery.WithRecoveryHandlerContext(brpc.RecoveryHandlerFuncContext)
 
+	// 边缘客户端的请求按租户限流, 避免单个租户占满与 feed server 之间的复用连接
+	tenantLimiter := grpcproxy.NewTenantLimiter(cc.FeedProxy().RateLimiter)
+	downstream := cc.FeedProxy().Downstream
+
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(1 * 1024 * 1024),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			realip.UnaryServerInterceptorOpts(),
 			brpc.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
+			tenantLimiter.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
 		),
 		grpc.ChainStreamInterceptor(
+			realip.StreamServerInterceptorOpts(),
 			grpcMetrics.StreamServerInterceptor(),
+			tenantLimiter.StreamServerInterceptor(),
 			grpc_recovery.StreamServerInterceptor(recoveryOpt),
 		),
+		grpc.MaxConcurrentStreams(downstream.MaxConcurrentStreams),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             time.Duration(downstream.KeepaliveMinTimeSec) * time.Second,
+			PermitWithoutStream: true,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			MaxConnectionIdle: time.Duration(downstream.MaxConnectionIdleSec) * time.Second,
+		}),
 	}
 
 	network := cc.FeedProxy().Network
@@ -178,6 +203,25 @@ func (fs *feedProxy) listenAndServe() error {
 		}
 	}()
 
+	// 同节点的客户端可通过 unix domain socket 访问, 由 socket 文件权限控制访问
+	uds := cc.FeedProxy().UnixSocket
+	if !uds.Enabled() {
+		return nil
+	}
+
+	udsListener, err := tools.ListenUnix(uds.Path, uds.FileMode())
+	if err != nil {
+		return fmt.Errorf("listen unix socket %s failed, err: %v", uds.Path, err)
+	}
+	logs.Infof("grpc server listen unix socket: %s, mode: %s", uds.Path, uds.Mode)
+
+	go func() {
+		if err := serve.Serve(udsListener); err != nil {
+			logs.Errorf("serve grpc server on unix socket failed, err: %v", err)
+			shutdown.SignalShutdownGracefully()
+		}
+	}()
+
 	return nil
 }
 
@@ -13,17 +13,21 @@
 package grpc
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/realip"
 	"github.com/shimingyah/pool"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
 // ClientConn is a wrapper around a *grpc.ClientConn with closable
@@ -66,22 +70,37 @@ type FeedServerDirector struct {
 
 // NewFeedServerDirector returns a new FeedServerDirector
 func NewFeedServerDirector() (*FeedServerDirector, error) {
-	feedHost := cc.FeedProxy().Upstream.FeedServerHost
+	upstream := cc.FeedProxy().Upstream
+	feedHost := upstream.FeedServerHost
+
+	// 与 feed server 之间使用 mTLS 连接
+	cred := insecure.NewCredentials()
+	if upstream.TLS.Enable() {
+		tls := upstream.TLS
+		tlsC, err := tools.ClientTLSConfVerify(tls.InsecureSkipVerify, tls.CAFile, tls.CertFile, tls.KeyFile,
+			tls.Password)
+		if err != nil {
+			return nil, fmt.Errorf("init upstream tls config failed, err: %v", err)
+		}
+		cred = credentials.NewTLS(tlsC)
+	}
+
 	grpcPool, err := pool.New(feedHost, pool.Options{
 		Dial: func(address string) (*grpc.ClientConn, error) {
-			timeoutCtx, _ := context.WithTimeout(context.Background(), time.Second*5)
+			timeoutCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
 			return grpc.DialContext(timeoutCtx, feedHost,
 				grpc.WithBlock(),
-				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithTransportCredentials(cred),
 				grpc.WithKeepaliveParams(keepalive.ClientParameters{
 					Time:                30 * time.Second,
 					Timeout:             3 * time.Second,
 					PermitWithoutStream: true,
 				}))
 		},
-		MaxIdle:              8,
-		MaxActive:            64,
-		MaxConcurrentStreams: 64,
+		MaxIdle:              upstream.Pool.MaxIdle,
+		MaxActive:            upstream.Pool.MaxActive,
+		MaxConcurrentStreams: upstream.Pool.MaxConcurrentStreams,
 		Reuse:                true,
 	})
 	if err != nil {
@@ -95,8 +114,13 @@ func (d *FeedServerDirector) Director(ctx context.Context, fullMethodName string
 
 	md, _ := metadata.FromIncomingContext(ctx)
 	// Copy the bound metadata explicitly.
+	outMD := md.Copy()
+	// 多个边缘客户端复用同一条上游连接, 透传客户端真实 IP
+	if addr, ok := realip.FromContext(ctx); ok {
+		outMD.Append("x-forwarded-for", addr.String())
+	}
 	outCtx, _ := context.WithCancel(ctx)
-	outCtx = metadata.NewOutgoingContext(outCtx, md.Copy())
+	outCtx = metadata.NewOutgoingContext(outCtx, outMD)
 
 	conn, err := d.grpcPool.Get()
 	if err != nil {
@@ -78,7 +78,6 @@ func (s *handler) handler(srv interface{}, serverStream grpc.ServerStream) (err
 		}
 	}()
 
-	// TODO: Add a `forwarded` header to metadata, https://en.wikipedia.org/wiki/X-Forwarded-For.
 	clientStream, err := grpc.NewClientStream(clientCtx, clientStreamDescForProxying, backendConn.Value(), fullMethodName)
 	if err != nil {
 		return err
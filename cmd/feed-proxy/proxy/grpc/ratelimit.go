@@ -0,0 +1,177 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/realip"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+// tenantIdleTimeout the limiter of the tenant which has no request for this duration will be removed.
+const tenantIdleTimeout = 10 * time.Minute
+
+// TenantLimiter limits the request rate of each tenant, so that one tenant can not exhaust the connections
+// to the feed server which are shared by all the tenants.
+type TenantLimiter struct {
+	conf     cc.TenantLimiter
+	lock     sync.Mutex
+	limiters map[string]*tenantRL
+	limited  *prometheus.CounterVec
+}
+
+type tenantRL struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewTenantLimiter create a tenant rate limiter.
+func NewTenantLimiter(conf cc.TenantLimiter) *TenantLimiter {
+	t := &TenantLimiter{
+		conf:     conf,
+		limiters: make(map[string]*tenantRL),
+		limited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.FeedProxySubSys,
+			Name:      "rate_limited_total",
+			Help:      "the total count of the requests rejected by the tenant rate limiter",
+		}, []string{"tenant_type"}),
+	}
+	metrics.Register().MustRegister(t.limited)
+
+	if conf.Enabled {
+		go t.cleanup()
+	}
+
+	return t
+}
+
+// UnaryServerInterceptor returns the unary interceptor of the tenant rate limiter.
+func (t *TenantLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if err := t.allow(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the stream interceptor of the tenant rate limiter, only the stream
+// creation is limited.
+func (t *TenantLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := t.allow(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (t *TenantLimiter) allow(ctx context.Context, method string) error {
+	if !t.conf.Enabled {
+		return nil
+	}
+
+	tenantType, tenant, bizID := tenantOf(ctx)
+	if t.getLimiter(tenant, bizID).Allow() {
+		return nil
+	}
+
+	t.limited.WithLabelValues(tenantType).Inc()
+	logs.V(2).Infof("tenant %s request %s is rate limited", tenant, method)
+	return status.Errorf(codes.ResourceExhausted, "too many requests of tenant %s, please retry later", tenant)
+}
+
+func (t *TenantLimiter) getLimiter(tenant, bizID string) *rate.Limiter {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if rl, ok := t.limiters[tenant]; ok {
+		rl.lastSeen = time.Now()
+		return rl.limiter
+	}
+
+	conf := t.conf.Default
+	if spec, ok := t.conf.Spec[bizID]; ok && bizID != "" {
+		conf = spec
+	}
+
+	rl := &tenantRL{limiter: rate.NewLimiter(rate.Limit(conf.Limit), int(conf.Burst)), lastSeen: time.Now()}
+	t.limiters[tenant] = rl
+	return rl.limiter
+}
+
+// cleanup remove the idle tenants' limiters periodically.
+func (t *TenantLimiter) cleanup() {
+	ticker := time.NewTicker(tenantIdleTimeout)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		t.lock.Lock()
+		for tenant, rl := range t.limiters {
+			if now.Sub(rl.lastSeen) > tenantIdleTimeout {
+				delete(t.limiters, tenant)
+			}
+		}
+		t.lock.Unlock()
+	}
+}
+
+// tenantOf returns the tenant of the request, sidecar is identified by its biz, sdk is identified by its
+// credential, and the others are identified by the client ip.
+func tenantOf(ctx context.Context) (tenantType, tenant, bizID string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if sm := md.Get(constant.SidecarMetaKey); len(sm) != 0 {
+		meta := new(sfs.SidecarMetaHeader)
+		if err := jsoni.UnmarshalFromString(sm[0], meta); err == nil && meta.BizID != 0 {
+			bizID = strconv.FormatUint(uint64(meta.BizID), 10)
+			return "biz", "biz:" + bizID, bizID
+		}
+	}
+
+	if auth := md.Get("authorization"); len(auth) != 0 {
+		token := strings.TrimSpace(strings.TrimPrefix(auth[0], "Bearer"))
+		if token != "" {
+			// 不记录明文凭证
+			sum := sha256.Sum256([]byte(token))
+			return "credential", "credential:" + hex.EncodeToString(sum[:])[:16], ""
+		}
+	}
+
+	addr, ok := realip.FromContext(ctx)
+	if !ok {
+		return "ip", "ip:unknown", ""
+	}
+	return "ip", "ip:" + addr.String(), ""
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/options"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/service"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/ratelimiter"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
@@ -41,35 +42,47 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
 	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	pbfsv2 "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server/v2"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
 // Run start the feed server
 func Run(opt *options.Option) error {
+	finalizer, err := Start(opt)
+	if err != nil {
+		return err
+	}
+
+	shutdown.RegisterFirstShutdown(finalizer)
+	shutdown.WaitShutdown(20)
+	return nil
+}
+
+// Start start the feed server without waiting for it to shut down, the returned finalizer deregisters the service,
+// it should be called first when shutting down.
+func Start(opt *options.Option) (func(), error) {
 	fs := new(feedServer)
 	if err := fs.prepare(opt); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := fs.listenAndServe(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := fs.service.ListenAndServeRest(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := fs.service.ListenAndGwServerRest(); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err := fs.register(); err != nil {
-		return err
+		return nil, err
 	}
 
-	shutdown.RegisterFirstShutdown(fs.finalizer)
-	shutdown.WaitShutdown(20)
-	return nil
+	return fs.finalizer, nil
 }
 
 type feedServer struct {
@@ -87,17 +100,22 @@ func (fs *feedServer) prepare(opt *options.Option) error {
 
 	logs.InitLogger(cc.FeedServer().Log.Logs())
 	logs.Infof("load settings from config file success.")
+
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.RepoCredentialSection) {
+			repository.UpdateCredentials(cc.FeedServer().Repository)
+		}
+	})
 	logs.Infof("current service name: %s", opt.Name)
 
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.FeedServer().Network.BindIP,
 		strconv.Itoa(int(cc.FeedServer().Network.RpcPort))))
 
-	etcdOpt, err := cc.FeedServer().Service.Etcd.ToConfig()
-	if err != nil {
-		return fmt.Errorf("get etcd config failed, err: %v", err)
-	}
-
 	// register data service.
 	svcOpt := serviced.ServiceOption{
 		Name: cc.FeedServerName,
@@ -105,24 +123,26 @@ func (fs *feedServer) prepare(opt *options.Option) error {
 		Port: cc.FeedServer().Network.RpcPort,
 		Uid:  uuid.UUID(),
 	}
-	sd, err := serviced.NewServiceD(etcdOpt, svcOpt)
+	sd, err := serviced.NewServiceDWithSetting(cc.FeedServer().Service, svcOpt)
 	if err != nil {
 		return fmt.Errorf("new service discovery failed, err: %v", err)
 	}
 
 	fs.sd = sd
 
-	// init bscp control tool
-	if err = ctl.LoadCtl(ctl.WithBasics(sd)...); err != nil {
-		return fmt.Errorf("load control tool failed, err: %v", err)
-	}
-
 	svc, err := service.NewService(fs.sd, opt.Name)
 	if err != nil {
 		return fmt.Errorf("initialize service failed, err: %v", err)
 	}
 	fs.service = svc
 
+	// init bscp control tool
+	cmds := append(ctl.WithBasics(sd), service.WithWatchStreams(svc.WatchStreams())...)
+	cmds = append(cmds, service.WithMatchExplain(svc.MatchExplainer()))
+	if err = ctl.LoadCtl(cmds...); err != nil {
+		return fmt.Errorf("load control tool failed, err: %v", err)
+	}
+
 	return nil
 }
 
@@ -144,22 +164,60 @@ func (fs *feedServer) listenAndServe() error {
 		ipBurst = ratelimiter.DefaultIPLimit * 2 // 设置默认值，防止配置错误
 	}
 	ipLimiter := ratelimiter.NewGlobalRL(ipLimit, ipBurst)
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if !e.Has(cc.RateLimiterSection) {
+			return
+		}
+		opt := cc.FeedServer().RateLimiter.IP
+		if opt.Limit == 0 {
+			opt.Limit = ratelimiter.DefaultIPLimit
+		}
+		if opt.Burst == 0 {
+			opt.Burst = ratelimiter.DefaultIPLimit * 2
+		}
+		ipLimiter.Update(opt.Limit, opt.Burst)
+	})
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		realip.UnaryServerInterceptorOpts(),
+		brpc.RequestIDUnaryServerInterceptor(),
+		brpc.UnknownFieldsUnaryServerInterceptor(),
+		service.LogUnaryServerInterceptor(),
+		grpcMetrics.UnaryServerInterceptor(),
+		// 在限流之前附加协议废弃告警, 被拒绝的响应也能携带
+		service.DeprecationUnaryInterceptor(cc.FeedServer().Deprecations),
+		ratelimit.UnaryServerInterceptor(ipLimiter),
+		fs.service.PriorityLaneUnaryInterceptor(),
+	}
+	// 令牌桶限流之外, 按延迟自适应调整并发上限, 上游变慢时快速拒绝超出上限的请求
+	if conf := cc.FeedServer().ConcLimiter; conf.Enable {
+		unaryInterceptors = append(unaryInterceptors,
+			service.ConcurrencyLimitUnaryInterceptor(ratelimiter.NewAdaptiveLimiter(conf)))
+	}
+	unaryInterceptors = append(unaryInterceptors,
+		service.FeedUnaryAuthInterceptor,
+		service.FeedUnaryClientVersionInterceptor,
+		service.FeedUnaryUpdateLastConsumedTimeInterceptor,
+	)
+	// 按比例将只读请求镜像到 staging 集群并比较响应, 用生产流量验证新版本
+	if conf := cc.FeedServer().Shadowing; conf.Enable {
+		shadower, err := service.NewShadower(conf)
+		if err != nil {
+			return fmt.Errorf("initialize shadower failed, err: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, shadower.UnaryInterceptor())
+	}
+	unaryInterceptors = append(unaryInterceptors, grpc_recovery.UnaryServerInterceptor(recoveryOpt))
 
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(1 * 1024 * 1024),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
-		grpc.ChainUnaryInterceptor(
-			realip.UnaryServerInterceptorOpts(),
-			service.LogUnaryServerInterceptor(),
-			grpcMetrics.UnaryServerInterceptor(),
-			ratelimit.UnaryServerInterceptor(ipLimiter),
-			service.FeedUnaryAuthInterceptor,
-			service.FeedUnaryUpdateLastConsumedTimeInterceptor,
-			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
 		grpc.ChainStreamInterceptor(
 			realip.StreamServerInterceptorOpts(),
 			grpcMetrics.StreamServerInterceptor(),
+			service.DeprecationStreamInterceptor(cc.FeedServer().Deprecations),
 			ratelimit.StreamServerInterceptor(ipLimiter),
+			fs.service.PriorityLaneStreamInterceptor(),
 			service.FeedStreamAuthInterceptor,
 			grpc_recovery.StreamServerInterceptor(recoveryOpt),
 		),
@@ -186,6 +244,7 @@ func (fs *feedServer) listenAndServe() error {
 
 	serve := grpc.NewServer(opts...)
 	pbfs.RegisterUpstreamServer(serve, fs.service)
+	pbfsv2.RegisterUpstreamServer(serve, fs.service.V2())
 	// Register reflection service on gRPC server.
 	reflection.Register(serve)
 
@@ -196,6 +255,7 @@ func (fs *feedServer) listenAndServe() error {
 	}
 
 	fs.serve = serve
+	fs.service.EnableGrpcWeb(serve)
 
 	go func() {
 		notifier := shutdown.AddNotifier()
@@ -233,6 +293,25 @@ func (fs *feedServer) listenAndServe() error {
 		}
 	}()
 
+	// 同节点的客户端可通过 unix domain socket 访问, 由 socket 文件权限控制访问
+	uds := cc.FeedServer().UnixSocket
+	if !uds.Enabled() {
+		return nil
+	}
+
+	udsListener, err := tools.ListenUnix(uds.Path, uds.FileMode())
+	if err != nil {
+		return fmt.Errorf("listen unix socket %s failed, err: %v", uds.Path, err)
+	}
+	logs.Infof("grpc server listen unix socket: %s, mode: %s", uds.Path, uds.Mode)
+
+	go func() {
+		if err := serve.Serve(udsListener); err != nil {
+			logs.Errorf("serve grpc server on unix socket failed, err: %v", err)
+			shutdown.SignalShutdownGracefully()
+		}
+	}()
+
 	return nil
 }
 
@@ -15,6 +15,7 @@ package auth
 
 import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/lcache"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
@@ -47,3 +48,13 @@ func (as *AuthService) CanMatchCI(kt *kit.Kit, bizID uint32,
 func (as *AuthService) GetCred(kt *kit.Kit, bizID uint32, token string) (*types.CredentialCache, error) {
 	return as.cache.Credential.GetCred(kt, bizID, token)
 }
+
+// GetCredPause get the consumption pause of the credential, nil if it is not paused.
+func (as *AuthService) GetCredPause(kt *kit.Kit, bizID uint32, token string) (*table.ConsumptionPause, error) {
+	c, err := as.cache.Credential.Get(kt, bizID, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Pause, nil
+}
@@ -0,0 +1,261 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package binarypatch generates the binary patches between the consecutive releases of the large files, the
+// patches are stored in the repository, and delivered to the clients which already have the base content
+// instead of the full content.
+package binarypatch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	clientset "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/client-set"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/lock"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/binpatch"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// failedTTLSeconds 生成失败时记录的保留时间, 避免每次请求都重新生成
+const failedTTLSeconds = 10 * 60
+
+// New initialize the binary patch service instance.
+func New(cs *clientset.ClientSet, redLock *lock.RedisLock) (*Service, error) {
+	conf := cc.FeedServer().BinaryPatch
+	s := &Service{
+		conf:    conf,
+		cs:      cs,
+		redLock: redLock,
+	}
+	if !conf.Enable {
+		return s, nil
+	}
+
+	provider, err := repository.NewProvider(cc.FeedServer().Repository)
+	if err != nil {
+		return nil, fmt.Errorf("init repository provider failed, err: %v", err)
+	}
+	s.provider = provider
+	s.inflight = make(chan struct{}, conf.Concurrency)
+
+	s.counter = prm.NewCounterVec(prm.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSBinaryPatchSubSys,
+		Name:      "generate_total",
+		Help:      "the total count of the binary patch generations, grouped by the result",
+	}, []string{"result"})
+	metrics.Register().MustRegister(s.counter)
+
+	return s, nil
+}
+
+// Service defines binary patch related operations.
+type Service struct {
+	conf     cc.BinaryPatch
+	cs       *clientset.ClientSet
+	redLock  *lock.RedisLock
+	provider repository.Provider
+	inflight chan struct{}
+	counter  *prm.CounterVec
+}
+
+// Content is the content of a file.
+type Content struct {
+	Signature string
+	ByteSize  uint64
+}
+
+// Patch is the binary patch which updates the base content to the target content.
+type Patch struct {
+	Signature string `json:"sign"`
+	ByteSize  uint64 `json:"size"`
+	// Skipped means the patch is not delivered, because it's too large or failed to be generated.
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// Enabled returns whether the binary patch is enabled.
+func (s *Service) Enabled() bool {
+	return s.conf.Enable
+}
+
+// Get returns the patch which updates the base content to the target content, nil if the patch is not available.
+// the patch is generated in background when it is requested at the first time, so the clients download the full
+// content until the patch is ready.
+func (s *Service) Get(kt *kit.Kit, bizID uint32, base, target *Content) (*Patch, error) {
+	if !s.applicable(base, target) {
+		return nil, nil
+	}
+
+	key := patchKey(bizID, base.Signature, target.Signature)
+	p, err := s.getRecord(kt, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		s.generate(bizID, key, base, target)
+		return nil, nil
+	}
+
+	if p.Skipped {
+		return nil, nil
+	}
+
+	return p, nil
+}
+
+func (s *Service) applicable(base, target *Content) bool {
+	if !s.conf.Enable || base.Signature == target.Signature {
+		return false
+	}
+
+	return target.ByteSize >= s.conf.MinByteSize && target.ByteSize <= s.conf.MaxByteSize &&
+		base.ByteSize <= s.conf.MaxByteSize
+}
+
+func (s *Service) getRecord(kt *kit.Kit, key string) (*Patch, error) {
+	val, err := s.cs.Redis().Get(kt.Ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(val) == 0 {
+		return nil, nil
+	}
+
+	p := new(Patch)
+	if err := jsoni.UnmarshalFromString(val, p); err != nil {
+		return nil, fmt.Errorf("unmarshal binary patch record failed, err: %v", err)
+	}
+
+	return p, nil
+}
+
+// generate generates the patch in background, it's skipped when the generation concurrency is exhausted or
+// the patch is being generated by another feed server, and will be triggered again by the next request.
+func (s *Service) generate(bizID uint32, key string, base, target *Content) {
+	select {
+	case s.inflight <- struct{}{}:
+	default:
+		return
+	}
+
+	go func() {
+		defer func() { <-s.inflight }()
+
+		if !s.redLock.TryAcquire(key) {
+			return
+		}
+		defer s.redLock.Release(key)
+
+		kt := kit.New()
+		kt.BizID = bizID
+
+		// 获取锁后再次确认, 避免重复生成
+		if p, err := s.getRecord(kt, key); err != nil || p != nil {
+			return
+		}
+
+		start := time.Now()
+		p, err := s.build(kt, base, target)
+		ttl := int(s.conf.TTLSeconds)
+		result := "succeed"
+		switch {
+		case err != nil:
+			logs.Errorf("generate binary patch from %s to %s failed, err: %v, rid: %s", base.Signature,
+				target.Signature, err, kt.Rid)
+			p = &Patch{Skipped: true}
+			ttl = failedTTLSeconds
+			result = "failed"
+		case p.Skipped:
+			result = "skipped"
+		}
+		s.counter.With(prm.Labels{"result": result}).Inc()
+
+		js, err := jsoni.Marshal(p)
+		if err != nil {
+			logs.Errorf("marshal binary patch record failed, err: %v, rid: %s", err, kt.Rid)
+			return
+		}
+		if err := s.cs.Redis().Set(kt.Ctx, key, string(js), ttl); err != nil {
+			logs.Errorf("save binary patch record failed, err: %v, rid: %s", err, kt.Rid)
+			return
+		}
+
+		logs.Infof("generate binary patch from %s to %s %s, size: %d, cost: %s, rid: %s", base.Signature,
+			target.Signature, result, p.ByteSize, time.Since(start), kt.Rid)
+	}()
+}
+
+// build generates the patch and uploads it to the repository.
+func (s *Service) build(kt *kit.Kit, base, target *Content) (*Patch, error) {
+	old, err := s.download(kt, base)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := s.download(kt, target)
+	if err != nil {
+		return nil, err
+	}
+
+	patch, err := binpatch.Diff(old, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	size := uint64(len(patch))
+	if size*100 > target.ByteSize*uint64(s.conf.MaxRatio) {
+		return &Patch{Skipped: true, ByteSize: size}, nil
+	}
+
+	// 补丁以其自身的 sha256 作为签名存储在仓库中
+	sign := tools.ByteSHA256(patch)
+	if _, err := s.provider.Upload(kt, sign, bytes.NewReader(patch)); err != nil {
+		return nil, fmt.Errorf("upload patch failed, err: %v", err)
+	}
+
+	return &Patch{Signature: sign, ByteSize: size}, nil
+}
+
+func (s *Service) download(kt *kit.Kit, c *Content) ([]byte, error) {
+	body, _, err := s.provider.Download(kt, c.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("download content %s failed, err: %v", c.Signature, err)
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, int64(s.conf.MaxByteSize)+1))
+	if err != nil {
+		return nil, fmt.Errorf("read content %s failed, err: %v", c.Signature, err)
+	}
+
+	if tools.ByteSHA256(data) != c.Signature {
+		return nil, fmt.Errorf("content %s checksum mismatch", c.Signature)
+	}
+
+	return data, nil
+}
+
+func patchKey(bizID uint32, base, target string) string {
+	return fmt.Sprintf("BinaryPatch:%d:%s:%s", bizID, base, target)
+}
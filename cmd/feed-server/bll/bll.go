@@ -19,6 +19,7 @@ import (
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/asyncdownload"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/auth"
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/binarypatch"
 	clientset "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/client-set"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/lcache"
@@ -64,8 +65,10 @@ func New(sd serviced.Discover, authorizer iamauth.Authorizer, name string) (*BLL
 		return nil, fmt.Errorf("new release service failed, err: %v", err)
 	}
 
+	authService := auth.New(localCache)
 	handler := &eventc.Handler{
-		GetMatchedRelease: rs.GetMatchedRelease,
+		GetMatchedRelease:  rs.GetMatchedRelease,
+		GetCredentialPause: authService.GetCredPause,
 	}
 	if e := sch.Run(handler); e != nil {
 		return nil, fmt.Errorf("run scheduler faield, err: %v", e)
@@ -74,7 +77,7 @@ func New(sd serviced.Discover, authorizer iamauth.Authorizer, name string) (*BLL
 	bll := &BLL{
 		client:  client,
 		release: rs,
-		auth:    auth.New(localCache),
+		auth:    authService,
 		cache:   localCache,
 		ob:      ob,
 		sch:     sch,
@@ -90,6 +93,12 @@ func New(sd serviced.Discover, authorizer iamauth.Authorizer, name string) (*BLL
 
 	bll.adService = adService
 
+	bpService, err := binarypatch.New(client, redLock)
+	if err != nil {
+		return nil, err
+	}
+	bll.bpService = bpService
+
 	gseConf := cc.FeedServer().GSE
 	if gseConf.Enabled {
 		scheduler, err := asyncdownload.NewScheduler(mc, redLock)
@@ -118,6 +127,7 @@ type BLL struct {
 	adService   *asyncdownload.Service
 	adScheduler *asyncdownload.Scheduler
 	adCleaner   *asyncdownload.CacheCleaner
+	bpService   *binarypatch.Service
 }
 
 // Release return the release service instance.
@@ -140,12 +150,32 @@ func (b *BLL) RKvCache() *lcache.ReleasedKv {
 	return b.cache.ReleasedKv
 }
 
+// RCICache return the ReleasedCI cache instance.
+func (b *BLL) RCICache() *lcache.ReleasedCI {
+	return b.cache.ReleasedCI
+}
+
 // ClientMetric return the client metric instance.
 func (b *BLL) ClientMetric() *lcache.ClientMetric {
 	return b.cache.ClientMetric
 }
 
+// Quarantine return the client quarantine cache instance.
+func (b *BLL) Quarantine() *lcache.ClientQuarantine {
+	return b.cache.Quarantine
+}
+
+// Experiment return the kv experiment cache instance.
+func (b *BLL) Experiment() *lcache.Experiment {
+	return b.cache.Experiment
+}
+
 // AsyncDownload return the async download instance.
 func (b *BLL) AsyncDownload() *asyncdownload.Service {
 	return b.adService
 }
+
+// BinaryPatch return the binary patch instance.
+func (b *BLL) BinaryPatch() *binarypatch.Service {
+	return b.bpService
+}
@@ -14,7 +14,9 @@
 package clientset
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -40,6 +42,8 @@ func New(sd serviced.Discover, authorizer iamauth.Authorizer) (*ClientSet, error
 func newClientSet(sd serviced.Discover, tls cc.TLSConfig, authorizer iamauth.Authorizer) (*ClientSet, error) {
 	logs.Infof("start initialize the client set.")
 
+	registerCompatShims()
+
 	opt := brpc.PoolOption{
 		PoolSize:          10,
 		ReadBufferSizeMB:  16,
@@ -50,6 +54,7 @@ func newClientSet(sd serviced.Discover, tls cc.TLSConfig, authorizer iamauth.Aut
 		NewClient: func(conn *grpc.ClientConn) interface{} {
 			return pbcs.NewCacheClient(conn)
 		},
+		Interceptors: []grpc.UnaryClientInterceptor{ResilienceInterceptor(cc.FeedServer().Upstream)},
 	}
 
 	cachePool, err := brpc.NewClientPool(opt)
@@ -71,6 +76,25 @@ func newClientSet(sd serviced.Discover, tls cc.TLSConfig, authorizer iamauth.Aut
 
 }
 
+// registerCompatShims 滚动升级期间 cache service 可能仍为旧版本, 旧版本不支持的方法按以下方式兼容
+func registerCompatShims() {
+	// 消费统计为旁路数据, 旧版本 cache service 不支持时直接忽略
+	brpc.RegisterCompatShim(pbcs.Cache_ReportAppConsumption_FullMethodName, 2,
+		func(ctx context.Context, req, reply interface{}) error { return nil })
+}
+
+// ResilienceInterceptor returns the interceptor of the calls to the upstream services, so that a slow
+// upstream fails fast rather than piling up the goroutines of the feed server.
+func ResilienceInterceptor(conf cc.UpstreamResilience) grpc.UnaryClientInterceptor {
+	return brpc.ResilienceUnaryClientInterceptor(brpc.ResilienceOption{
+		Timeout:          time.Duration(conf.TimeoutMS) * time.Millisecond,
+		FailureThreshold: int(conf.FailureThreshold),
+		OpenDuration:     time.Duration(conf.OpenSeconds) * time.Second,
+		HedgeDelay:       time.Duration(conf.HedgeDelayMS) * time.Millisecond,
+		RetryBudgetRatio: float64(conf.RetryBudgetPercent) / 100,
+	})
+}
+
 // ClientSet defines configure server's all the depends api client.
 type ClientSet struct {
 	cachePool  brpc.PoolInterface
@@ -96,13 +96,7 @@ func (ae *appEvent) doFirstMatch(kt *kit.Kit, subSpec *SubscribeSpec) (uint32, u
 
 	cursor := ae.cursor.ID()
 
-	meta := &btyp.AppInstanceMeta{
-		BizID:  subSpec.InstSpec.BizID,
-		AppID:  subSpec.InstSpec.AppID,
-		App:    subSpec.InstSpec.App,
-		Uid:    subSpec.InstSpec.Uid,
-		Labels: subSpec.InstSpec.Labels,
-	}
+	meta := ae.sch.instanceMeta(kt, subSpec.InstSpec)
 
 	matchedRelease, err := ae.sch.handler.GetMatchedRelease(kt, meta)
 	if err != nil {
@@ -114,6 +108,10 @@ func (ae *appEvent) doFirstMatch(kt *kit.Kit, subSpec *SubscribeSpec) (uint32, u
 		if errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
 			return 0, 0, nil
 		}
+		// 暂停拉取配置时由 notifyOne 跳过通知, 客户端保持已生效的版本
+		if btyp.IsConsumptionPaused(err) {
+			return 0, 0, nil
+		}
 	}
 
 	return matchedRelease, cursor, nil
@@ -28,6 +28,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/observer"
 	btyp "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
@@ -54,6 +55,8 @@ type Handler struct {
 	// GetMatchedRelease get the specified app instance's current release id.
 	// Note: this function's match pipeline should not use cache data,
 	GetMatchedRelease func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, error)
+	// GetCredentialPause get the consumption pause of the credential, nil if it is not paused.
+	GetCredentialPause func(kt *kit.Kit, bizID uint32, credential string) (*table.ConsumptionPause, error)
 }
 
 // NewScheduler create a new scheduler instance.
@@ -213,6 +216,12 @@ func (sch *Scheduler) handleOneBatch(events []*types.EventMeta) {
 
 	arrangedApps := make(map[uint32][]*types.EventMeta)
 	for _, one := range events {
+		// 故障注入: 模拟从 cache service 监听到的事件丢失
+		if chaos.Drop(chaos.FeedEventPoint) {
+			logs.Warnf("chaos drop the watched event %d of app %d", one.ID, one.Attachment.AppID)
+			continue
+		}
+
 		_, exist := arrangedApps[one.Attachment.AppID]
 		if !exist {
 			arrangedApps[one.Attachment.AppID] = make([]*types.EventMeta, 0)
@@ -259,14 +268,13 @@ func (sch *Scheduler) notifyEvent(kt *kit.Kit, cursorID uint32, members []*membe
 func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 	// Note: optimize this when a mount of instances have the same labels with same release id.
 	inst := one.InstSpec
-	meta := &btyp.AppInstanceMeta{
-		BizID:  inst.BizID,
-		AppID:  inst.AppID,
-		App:    inst.App,
-		Uid:    inst.Uid,
-		Labels: inst.Labels,
-	}
+	meta := sch.instanceMeta(kt, inst)
 	releaseID, e := sch.handler.GetMatchedRelease(kt, meta)
+	if btyp.IsConsumptionPaused(e) {
+		// 暂停期间不通知新版本, 恢复时会重新广播
+		logs.V(1).Infof("%s [sn: %d] consumption is paused, skip notify, rid: %s", inst.Format(), one.sn, kt.Rid)
+		return
+	}
 	if e != nil {
 		sch.retry.Add(cursorID, one)
 		logs.Errorf("get %s [sn: %d] matched strategy failed, err: %v, rid: %s", inst.Format(), one.sn, e, kt.Rid)
@@ -287,7 +295,7 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 		if len(kvList) == 0 {
 			return
 		}
-		event = sch.buildEventForRkv(inst, kvList, releaseID, cursorID)
+		event = sch.buildEventForRkv(kt, inst, kvList, releaseID, cursorID)
 
 	case table.File:
 		ciList, err := sch.lc.ReleasedCI.Get(kt, inst.BizID, releaseID)
@@ -314,6 +322,12 @@ func (sch *Scheduler) notifyOne(kt *kit.Kit, cursorID uint32, one *member) {
 		return
 	}
 
+	// 故障注入: 模拟推送给客户端的事件丢失
+	if chaos.Drop(chaos.FeedNotifyPoint) {
+		logs.Warnf("chaos drop the event notified to %s [sn: %d], rid: %s", inst.Format(), one.sn, kt.Rid)
+		return
+	}
+
 	if one.Receiver.Notify(event, inst.Uid, one.sn) {
 		logs.Warnf("notify app instance event failed, need retry, biz: %d, app: %d, uid: %s, sn: %d, rid: %s",
 			inst.BizID, inst.AppID, inst.Uid, one.sn, kt.Rid)
@@ -338,6 +352,13 @@ func (sch *Scheduler) buildEvent(inst *sfs.InstanceSpec, ciList []*types.Release
 				continue
 			}
 		}
+		// 过滤掉凭证无权限的配置项
+		if len(inst.Scopes) > 0 && !lo.SomeBy(inst.Scopes, func(scope string) bool {
+			ok, _ := tools.MatchConfigItem(scope, cis.Path, cis.Name)
+			return ok
+		}) {
+			continue
+		}
 		m := &sfs.ConfigItemMetaV1{
 			ID:       one.ID,
 			CommitID: one.CommitID,
@@ -441,15 +462,29 @@ func (sch *Scheduler) watchRetry() {
 
 }
 
-func (sch *Scheduler) buildEventForRkv(inst *sfs.InstanceSpec, kvList []*types.ReleaseKvCache, releaseID uint32,
-	cursorID uint32) *Event {
+func (sch *Scheduler) buildEventForRkv(kt *kit.Kit, inst *sfs.InstanceSpec, kvList []*types.ReleaseKvCache,
+	releaseID uint32, cursorID uint32) *Event {
 
+	now := time.Now()
+	experiments := sch.lc.Experiment.MatchInstance(kt, inst.BizID, inst.AppID, inst.Uid, inst.Labels)
 	kvMeta := make([]*sfs.KvMetaV1, 0)
 	for _, one := range kvList {
 		// filter out mismatched config items
 		if !tools.MatchPattern(one.Key, inst.Match) {
 			continue
 		}
+		// 按实例标签匹配分组覆盖值, 过期的kv不再下发或下发兜底值
+		contentSpec := one.ContentSpec
+		if one.Expired(now) {
+			if one.ExpiredFallback == nil {
+				continue
+			}
+			contentSpec = one.FallbackContentSpec()
+		} else if override, err := one.MatchOverride(inst.Uid, inst.Labels); err != nil {
+			logs.Errorf("match %s kv %s override failed, err: %v", inst.Format(), one.Key, err)
+		} else if override != nil {
+			contentSpec = override.ContentSpec
+		}
 		m := &sfs.KvMetaV1{
 			ID:     one.ID,
 			Key:    one.Key,
@@ -464,7 +499,8 @@ func (sch *Scheduler) buildEventForRkv(inst *sfs.InstanceSpec, kvList []*types.R
 				BizId: one.Attachment.BizID,
 				AppId: one.Attachment.AppID,
 			},
-			ContentSpec: pbct.PbContentSpec(one.ContentSpec),
+			ContentSpec: pbct.PbContentSpec(contentSpec),
+			Experiment:  experiments[one.Key],
 		}
 		kvMeta = append(kvMeta, m)
 	}
@@ -480,3 +516,31 @@ func (sch *Scheduler) buildEventForRkv(inst *sfs.InstanceSpec, kvList []*types.R
 		CursorID: cursorID,
 	}
 }
+
+// instanceMeta build the release match meta of the subscribed instance, the credential's consumption pause is
+// got again, because it may be changed after the instance subscribed, and the one at subscribing is used if
+// the credential can not be got.
+func (sch *Scheduler) instanceMeta(kt *kit.Kit, inst *sfs.InstanceSpec) *btyp.AppInstanceMeta {
+	meta := &btyp.AppInstanceMeta{
+		BizID:           inst.BizID,
+		AppID:           inst.AppID,
+		App:             inst.App,
+		Uid:             inst.Uid,
+		Labels:          inst.Labels,
+		CredentialPause: inst.CredentialPause,
+	}
+
+	if inst.Credential == "" || sch.handler.GetCredentialPause == nil {
+		return meta
+	}
+
+	pause, err := sch.handler.GetCredentialPause(kt, inst.BizID, inst.Credential)
+	if err != nil {
+		logs.Warnf("get %s credential pause failed, use the one at subscribing, err: %v, rid: %s", inst.Format(),
+			err, kt.Rid)
+		return meta
+	}
+	meta.CredentialPause = pause
+
+	return meta
+}
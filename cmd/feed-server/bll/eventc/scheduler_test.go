@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package eventc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	btyp "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+func TestNotifyPausedCredential(t *testing.T) {
+	paused := &table.ConsumptionPause{Mode: table.PauseModeLastApplied}
+
+	cases := []struct {
+		name string
+		// pause is the credential's pause when the instance subscribes
+		pause *table.ConsumptionPause
+		// current is the credential's current pause, nil error means the credential can be got
+		current    *table.ConsumptionPause
+		currentErr error
+	}{
+		{name: "paused at subscribing", pause: paused, current: paused},
+		{name: "paused after subscribing", pause: nil, current: paused},
+		{name: "credential not available", pause: paused, currentErr: errors.New("not found")},
+	}
+
+	for _, c := range cases {
+		sch := &Scheduler{
+			retry: newRetryList(nil),
+			handler: &Handler{
+				GetMatchedRelease: func(kt *kit.Kit, meta *btyp.AppInstanceMeta) (uint32, error) {
+					if meta.CredentialPause.Paused() {
+						return 0, errf.ErrCredentialConsumptionPaused.New(kt, meta.CredentialPause.Reason())
+					}
+					return 1, nil
+				},
+				GetCredentialPause: func(kt *kit.Kit, bizID uint32, credential string) (*table.ConsumptionPause,
+					error) {
+					return c.current, c.currentErr
+				},
+			},
+		}
+
+		notified := false
+		_, cancel := context.WithCancel(context.Background())
+		one := &member{
+			SubscribeSpec: &SubscribeSpec{
+				InstSpec: &sfs.InstanceSpec{BizID: 2, AppID: 1, App: "app", Uid: "uid", ConfigType: table.File,
+					Credential: "token", CredentialPause: c.pause},
+				Receiver: InitReceiver(func(event *Event, sn uint64) bool {
+					notified = true
+					return true
+				}, cancel),
+			},
+			sn: 1,
+		}
+
+		sch.notifyOne(kit.New(), 10, one)
+		if notified {
+			t.Errorf("%s: release should not be pushed to the paused credential", c.name)
+		}
+		if len(sch.retry.list) != 0 {
+			t.Errorf("%s: paused instance should not be retried", c.name)
+		}
+		cancel()
+	}
+}
@@ -36,6 +36,7 @@ import (
 // newApp create an app meta's cache instance.
 func newApp(mc *metric, cs *clientset.ClientSet) *App {
 	app := new(App)
+	app.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.AppCacheTTLSec })
 	app.mc = mc
 	opt := cc.FeedServer().FSLocalCache
 	metaClient := gcache.New(int(opt.AppCacheSize)).
@@ -64,6 +65,7 @@ type App struct {
 	metaClient gcache.Cache
 	idClient   gcache.Cache
 	cs         *clientset.ClientSet
+	ttl        *cacheTTL
 }
 
 // IsAppExist validate app if exist.
@@ -141,7 +143,7 @@ func (ap *App) GetAppID(kt *kit.Kit, bizID uint32, appName string) (uint32, erro
 		return 0, err
 	}
 
-	err = ap.idClient.Set(key, resp.AppId)
+	err = ap.idClient.SetWithExpire(key, resp.AppId, ap.ttl.Duration())
 	if err != nil {
 		logs.Errorf("update biz: %d, appName: %s app id cache failed, err: %v, rid: %s", bizID, appName, err, kt.Rid)
 		// do not return, ignore the error directly.
@@ -193,7 +195,7 @@ func (ap *App) GetMeta(kt *kit.Kit, bizID uint32, appID uint32) (*types.AppCache
 		return nil, err
 	}
 
-	err = ap.metaClient.Set(appID, meta)
+	err = ap.metaClient.SetWithExpire(appID, meta, ap.ttl.Duration())
 	if err != nil {
 		logs.Errorf("update biz: %d, app: %d cache failed, err: %v, rid: %s", bizID, appID, err, kt.Rid)
 		// do not return, ignore the error directly.
@@ -240,3 +242,11 @@ func (ap *App) SetAppLastConsumedTime(kt *kit.Kit, bizID uint32, appIDs []uint32
 	}
 	return nil
 }
+
+// ReportAppConsumption 上报服务消费统计
+func (ap *App) ReportAppConsumption(kt *kit.Kit, req *pbcs.ReportAppConsumptionReq) error {
+	if _, err := ap.cs.CS().ReportAppConsumption(kt.Ctx, req); err != nil {
+		return err
+	}
+	return nil
+}
@@ -31,6 +31,7 @@ import (
 // newAuth create an auth cache instance.
 func newAuth(mc *metric, authorizer auth.Authorizer) *Auth {
 	auth := new(Auth)
+	auth.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.AuthCacheTTLSec })
 	auth.mc = mc
 	opt := cc.FeedServer().FSLocalCache
 	client := gcache.New(int(opt.AuthCacheSize)).
@@ -51,6 +52,7 @@ type Auth struct {
 	mc         *metric
 	client     gcache.Cache
 	authorizer auth.Authorizer
+	ttl        *cacheTTL
 }
 
 // Authorize if user has permission to the bscp resource.
@@ -85,7 +87,7 @@ func (au *Auth) Authorize(kt *kit.Kit, res *meta.ResourceAttribute) (bool, error
 	}
 
 	if authorized {
-		err = au.client.Set(key, authorized)
+		err = au.client.SetWithExpire(key, authorized, au.ttl.Duration())
 		if err != nil {
 			logs.Errorf("update biz: %d, auth key: %s cache failed, err: %v, rid: %s", res.BizID, key, err, kt.Rid)
 			// do not return, ignore the error directly.
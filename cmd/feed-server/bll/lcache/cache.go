@@ -36,6 +36,8 @@ func NewLocalCache(cs *clientset.ClientSet) (*Cache, error) {
 		Credential:    newCredential(mc, cs),
 		Auth:          newAuth(mc, cs.Authorizer()),
 		ClientMetric:  newClientMetric(mc, cs),
+		Quarantine:    newClientQuarantine(mc, cs),
+		Experiment:    newExperiment(mc, cs),
 	}, nil
 }
 
@@ -49,6 +51,8 @@ type Cache struct {
 	ReleasedHook  *ReleasedHook
 	Auth          *Auth
 	ClientMetric  *ClientMetric
+	Quarantine    *ClientQuarantine
+	Experiment    *Experiment
 }
 
 // Purge is used to clean the resource's cache with events.
@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcache
+
+import (
+	"time"
+
+	"github.com/bluele/gcache"
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	clientset "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/client-set"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+const (
+	// quarantineCacheSize is how many biz's client quarantines can be cached.
+	quarantineCacheSize = 1000
+	// quarantineCacheTTL is how long the client quarantines of a biz are cached.
+	quarantineCacheTTL = 10 * time.Second
+)
+
+// newClientQuarantine client quarantine's local cache instance.
+func newClientQuarantine(mc *metric, cs *clientset.ClientSet) *ClientQuarantine {
+	q := new(ClientQuarantine)
+	q.cs = cs
+	q.client = gcache.New(quarantineCacheSize).
+		LRU().
+		Expiration(quarantineCacheTTL).
+		Build()
+	q.mc = mc
+
+	return q
+}
+
+// ClientQuarantine is the instance of the client quarantine local cache.
+type ClientQuarantine struct {
+	mc     *metric
+	client gcache.Cache
+	cs     *clientset.ClientSet
+}
+
+// Match returns the active quarantine which matches the client with the fingerprint or ip, nil means the
+// client is not quarantined.
+// 获取隔离名单失败时不拒绝客户端, 避免缓存服务异常时影响所有客户端
+func (q *ClientQuarantine) Match(kt *kit.Kit, bizID uint32, fingerprint, ip string) *table.ClientQuarantineSpec {
+	if bizID == 0 || (fingerprint == "" && ip == "") {
+		return nil
+	}
+
+	specs, err := q.list(kt, bizID)
+	if err != nil {
+		logs.Errorf("list biz %d client quarantines failed, err: %v, rid: %s", bizID, err, kt.Rid)
+		return nil
+	}
+
+	now := time.Now()
+	for _, one := range specs {
+		if one.Active(now) && one.Match(fingerprint, ip) {
+			return one
+		}
+	}
+
+	return nil
+}
+
+func (q *ClientQuarantine) list(kt *kit.Kit, bizID uint32) ([]*table.ClientQuarantineSpec, error) {
+	val, err := q.client.GetIFPresent(bizID)
+	if err == nil {
+		q.mc.hitCounter.With(prm.Labels{"resource": "client_quarantine", "biz": tools.Itoa(bizID)}).Inc()
+		// 缓存的值由 list 写入, 类型一定匹配
+		return val.([]*table.ClientQuarantineSpec), nil
+	}
+
+	if err != gcache.KeyNotFoundError {
+		return nil, err
+	}
+
+	resp, err := q.cs.CS().ListClientQuarantines(kt.RpcCtx(), &pbcs.ListBizClientQuarantinesReq{BizId: bizID})
+	if err != nil {
+		q.mc.errCounter.With(prm.Labels{"resource": "client_quarantine", "biz": tools.Itoa(bizID)}).Inc()
+		return nil, err
+	}
+
+	specs := make([]*table.ClientQuarantineSpec, 0)
+	if err := jsoni.UnmarshalFromString(resp.JsonRaw, &specs); err != nil {
+		return nil, err
+	}
+
+	if err := q.client.Set(bizID, specs); err != nil {
+		logs.Errorf("refresh biz %d client quarantine cache failed, %s", bizID, err.Error())
+	}
+
+	return specs, nil
+}
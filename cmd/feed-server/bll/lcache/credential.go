@@ -121,6 +121,21 @@ func (s *Credential) CanMatchCI(kt *kit.Kit, bizID uint32, app string, credentia
 	return false, nil
 }
 
+// Get the credential, the local cache is used first.
+func (s *Credential) Get(kt *kit.Kit, bizID uint32, credential string) (*types.CredentialCache, error) {
+	c, hit, err := s.getCredentialFromCache(kt, bizID, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if hit {
+		s.mc.hitCounter.With(prm.Labels{"resource": "credential", "biz": tools.Itoa(bizID)}).Inc()
+		return &c, nil
+	}
+
+	return s.GetCred(kt, bizID, credential)
+}
+
 // GetCred 获取凭证, 并缓存
 func (s *Credential) GetCred(kt *kit.Kit, bizID uint32, credential string) (*types.CredentialCache, error) {
 	c, hit, err := s.getCredentialFromCache(kt, bizID, credential)
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package lcache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bluele/gcache"
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	clientset "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/client-set"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+const (
+	// experimentCacheSize is how many app's experiments can be cached.
+	experimentCacheSize = 2000
+	// experimentCacheTTL is how long the experiments of an app are cached.
+	experimentCacheTTL = 10 * time.Second
+)
+
+// newExperiment experiment's local cache instance.
+func newExperiment(mc *metric, cs *clientset.ClientSet) *Experiment {
+	e := new(Experiment)
+	e.cs = cs
+	e.client = gcache.New(experimentCacheSize).
+		LRU().
+		Expiration(experimentCacheTTL).
+		Build()
+	e.mc = mc
+
+	return e
+}
+
+// Experiment is the instance of the kv experiment local cache.
+type Experiment struct {
+	mc     *metric
+	client gcache.Cache
+	cs     *clientset.ClientSet
+}
+
+// MatchInstance returns the enabled experiments which the instance is eligible for, keyed by the kv key.
+// 获取实验失败时不返回实验, 客户端使用发布的值
+func (e *Experiment) MatchInstance(kt *kit.Kit, bizID, appID uint32, uid string,
+	labels map[string]string) map[string]*pbfs.KvExperiment {
+	if uid == "" {
+		return nil
+	}
+
+	list, err := e.list(kt, bizID, appID)
+	if err != nil {
+		logs.Errorf("list app %d experiments failed, err: %v, rid: %s", appID, err, kt.Rid)
+		return nil
+	}
+
+	matched := make(map[string]*pbfs.KvExperiment)
+	for _, one := range list {
+		ok, err := one.Spec.MatchInstance(uid, labels)
+		if err != nil {
+			logs.Errorf("match experiment %d failed, err: %v, rid: %s", one.ID, err, kt.Rid)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		variants := make([]*pbfs.KvExperimentVariant, 0, len(one.Spec.Variants))
+		for _, v := range one.Spec.Variants {
+			variants = append(variants, &pbfs.KvExperimentVariant{Name: v.Name, Weight: v.Weight, Value: v.Value})
+		}
+		matched[one.Spec.Key] = &pbfs.KvExperiment{Id: one.ID, Name: one.Spec.Name, Variants: variants}
+	}
+
+	return matched
+}
+
+func (e *Experiment) list(kt *kit.Kit, bizID, appID uint32) ([]*table.Experiment, error) {
+	key := fmt.Sprintf("%d-%d", bizID, appID)
+	val, err := e.client.GetIFPresent(key)
+	if err == nil {
+		e.mc.hitCounter.With(prm.Labels{"resource": "experiment", "biz": tools.Itoa(bizID)}).Inc()
+		// 缓存的值由 list 写入, 类型一定匹配
+		return val.([]*table.Experiment), nil
+	}
+
+	if err != gcache.KeyNotFoundError {
+		return nil, err
+	}
+
+	resp, err := e.cs.CS().ListAppExperiments(kt.RpcCtx(), &pbcs.ListAppExperimentsReq{BizId: bizID, AppId: appID})
+	if err != nil {
+		e.mc.errCounter.With(prm.Labels{"resource": "experiment", "biz": tools.Itoa(bizID)}).Inc()
+		return nil, err
+	}
+
+	list := make([]*table.Experiment, 0)
+	if err := jsoni.UnmarshalFromString(resp.JsonRaw, &list); err != nil {
+		return nil, err
+	}
+
+	if err := e.client.Set(key, list); err != nil {
+		logs.Errorf("refresh app %d experiment cache failed, %s", appID, err.Error())
+	}
+
+	return list, nil
+}
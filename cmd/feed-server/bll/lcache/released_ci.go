@@ -33,6 +33,7 @@ import (
 // newReleasedCI create released config item's cache instance.
 func newReleasedCI(mc *metric, cs *clientset.ClientSet) *ReleasedCI {
 	ci := new(ReleasedCI)
+	ci.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.ReleasedCICacheTTLSec })
 	ci.mc = mc
 	opt := cc.FeedServer().FSLocalCache
 	client := gcache.New(int(opt.ReleasedCICacheSize)).
@@ -62,6 +63,7 @@ type ReleasedCI struct {
 	mc     *metric
 	client gcache.Cache
 	cs     *clientset.ClientSet
+	ttl    *cacheTTL
 }
 
 // Get the released config item's cache.
@@ -107,7 +109,7 @@ func (ci *ReleasedCI) Get(kt *kit.Kit, bizID uint32, releaseID uint32) ([]*types
 
 	if len(resp.JsonRaw) <= maxRCISizeKB {
 		// only cache the released ci which is less than maxRCISizeKB
-		err = ci.client.Set(releaseID, rci)
+		err = ci.client.SetWithExpire(releaseID, rci, ci.ttl.Duration())
 		if err != nil {
 			logs.Errorf("refresh biz: %d, release: %d CI cache failed, err: %v, rid: %s", bizID, releaseID, err, kt.Rid)
 			// do not return, ignore the error directly.
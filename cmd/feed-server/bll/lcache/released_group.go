@@ -34,6 +34,7 @@ import (
 // newReleasedGroup create released group's local cache instance.
 func newReleasedGroup(mc *metric, cs *clientset.ClientSet) *ReleasedGroup {
 	stg := new(ReleasedGroup)
+	stg.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.ReleasedGroupCacheTTLSec })
 	stg.cs = cs
 	opt := cc.FeedServer().FSLocalCache
 
@@ -53,6 +54,7 @@ type ReleasedGroup struct {
 	mc     *metric
 	client gcache.Cache
 	cs     *clientset.ClientSet
+	ttl    *cacheTTL
 }
 
 // Get the released group's local cache.
@@ -93,7 +95,7 @@ func (s *ReleasedGroup) Get(kt *kit.Kit, bizID uint32, appID uint32) (
 		return groupList[i].StrategyID > groupList[j].StrategyID
 	})
 
-	if e := s.client.Set(appID, groupList); e != nil {
+	if e := s.client.SetWithExpire(appID, groupList, s.ttl.Duration()); e != nil {
 		logs.Errorf("refresh biz: %d, app: %d, client released group cache failed, err: %v",
 			bizID, appID, e)
 	}
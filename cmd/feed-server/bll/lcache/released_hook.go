@@ -33,6 +33,7 @@ import (
 // newReleasedHook create released hook's cache instance.
 func newReleasedHook(mc *metric, cs *clientset.ClientSet) *ReleasedHook {
 	rh := new(ReleasedHook)
+	rh.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.ReleasedHookCacheTTLSec })
 	rh.mc = mc
 	opt := cc.FeedServer().FSLocalCache
 	client := gcache.New(int(opt.ReleasedHookCacheSize)).
@@ -59,6 +60,7 @@ type ReleasedHook struct {
 	mc     *metric
 	client gcache.Cache
 	cs     *clientset.ClientSet
+	ttl    *cacheTTL
 }
 
 // Get the released hook's cache.
@@ -105,7 +107,7 @@ func (r *ReleasedHook) Get(kt *kit.Kit, bizID uint32, releaseID uint32) (
 
 	if len(resp.JsonRaw) <= maxRCISizeKB {
 		// only cache the released hook which is less than maxRCISizeKB
-		err = r.client.Set(releaseID, hooks)
+		err = r.client.SetWithExpire(releaseID, hooks, r.ttl.Duration())
 		if err != nil {
 			logs.Errorf("refresh biz: %d, release: %d hook cache failed, err: %v, rid: %s", bizID, releaseID, err, kt.Rid)
 			// do not return, ignore the error directly.
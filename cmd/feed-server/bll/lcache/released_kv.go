@@ -33,6 +33,7 @@ import (
 // newReleasedKv create released kv cache instance.
 func newReleasedKv(mc *metric, cs *clientset.ClientSet) *ReleasedKv {
 	kv := new(ReleasedKv)
+	kv.ttl = newCacheTTL(func(opt cc.FSLocalCache) uint { return opt.ReleasedKvCacheTTLSec })
 	kv.mc = mc
 	opt := cc.FeedServer().FSLocalCache
 	client := gcache.New(int(opt.ReleasedKvCacheSize)).
@@ -53,12 +54,13 @@ type ReleasedKv struct {
 	mc     *metric
 	client gcache.Cache
 	cs     *clientset.ClientSet
+	ttl    *cacheTTL
 }
 
-// GetKvValue Get the rkv value cache.
-func (kv *ReleasedKv) GetKvValue(kt *kit.Kit, bizID, appID, releaseID uint32, key string) (*types.ReleaseKvValueCache,
-	error) {
-	cacheKey := fmt.Sprintf("%d-%d-%d-%s", bizID, appID, releaseID, key)
+// GetKvValue Get the rkv value cache, the override value of the group is returned if group id is set.
+func (kv *ReleasedKv) GetKvValue(kt *kit.Kit, bizID, appID, releaseID, groupID uint32, key string) (
+	*types.ReleaseKvValueCache, error) {
+	cacheKey := fmt.Sprintf("%d-%d-%d-%d-%s", bizID, appID, releaseID, groupID, key)
 	val, err := kv.client.GetIFPresent(cacheKey)
 	if err == nil {
 		kv.mc.hitCounter.With(prm.Labels{"resource": "released_kv_value", "biz": tools.Itoa(bizID)}).Inc()
@@ -86,6 +88,7 @@ func (kv *ReleasedKv) GetKvValue(kt *kit.Kit, bizID, appID, releaseID uint32, ke
 		ReleaseId: releaseID,
 		AppId:     appID,
 		Key:       key,
+		GroupId:   groupID,
 	}
 
 	resp, err := kv.cs.CS().GetReleasedKvValue(kt.RpcCtx(), opt)
@@ -100,7 +103,7 @@ func (kv *ReleasedKv) GetKvValue(kt *kit.Kit, bizID, appID, releaseID uint32, ke
 		return nil, err
 	}
 
-	if err = kv.client.Set(cacheKey, rkv); err != nil {
+	if err = kv.client.SetWithExpire(cacheKey, rkv, kv.ttl.Duration()); err != nil {
 		logs.Errorf("refresh biz: %d, release: %d KV cache failed, err: %v, rid: %s", bizID, releaseID, err, kt.Rid)
 		// do not return, ignore the error directly.
 	}
@@ -155,7 +158,7 @@ func (kv *ReleasedKv) Get(kt *kit.Kit, bizID uint32, releaseID uint32) ([]*types
 	}
 
 	if len(resp.JsonRaw) <= maxRCISizeKB {
-		err = kv.client.Set(cacheKey, rkv)
+		err = kv.client.SetWithExpire(cacheKey, rkv, kv.ttl.Duration())
 		if err != nil {
 			logs.Errorf("refresh biz: %d, release: %d KV cache failed, err: %v, rid: %s", bizID, releaseID, err, kt.Rid)
 			// do not return, ignore the error directly.
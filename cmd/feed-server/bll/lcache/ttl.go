@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package lcache
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// cacheTTL is the ttl of a local cache, it is updated when the fsLocalCache setting is reloaded,
+// and the new ttl takes effect on the cache entries set after that.
+type cacheTTL struct {
+	ttl atomic.Int64
+	get func(opt cc.FSLocalCache) uint
+}
+
+// newCacheTTL create a cache ttl with the function to get the ttl seconds from the setting.
+func newCacheTTL(get func(opt cc.FSLocalCache) uint) *cacheTTL {
+	t := &cacheTTL{get: get}
+	t.reset()
+
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.FSLocalCacheSection) {
+			t.reset()
+		}
+	})
+
+	return t
+}
+
+func (t *cacheTTL) reset() {
+	t.ttl.Store(int64(time.Duration(t.get(cc.FeedServer().FSLocalCache)) * time.Second))
+}
+
+// Duration returns the current ttl.
+func (t *cacheTTL) Duration() time.Duration {
+	return time.Duration(t.ttl.Load())
+}
@@ -14,8 +14,9 @@ package release
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"sort"
+	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -27,7 +28,7 @@ import (
 // GetMatchedRelease get the app instance's matched release id.
 func (rs *ReleasedService) GetMatchedRelease(kt *kit.Kit, meta *types.AppInstanceMeta) (uint32, error) {
 
-	ctx, cancel := context.WithTimeout(context.TODO(), rs.matchReleaseWaitTime)
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Duration(rs.matchReleaseWaitTime.Load()))
 	defer cancel()
 
 	if err := rs.limiter.Wait(ctx); err != nil {
@@ -39,6 +40,19 @@ func (rs *ReleasedService) GetMatchedRelease(kt *kit.Kit, meta *types.AppInstanc
 		return 0, err
 	}
 
+	// 归档的服务不再向客户端提供配置
+	if am.State == table.AppStateArchived {
+		return 0, errf.ErrAppArchived.New(kt, am.Name)
+	}
+
+	// 暂停拉取配置后不再向客户端提供版本, 客户端保持已生效的版本
+	if am.Pause.Paused() {
+		return 0, errf.ErrAppConsumptionPaused.New(kt, am.Name, am.Pause.Reason())
+	}
+	if meta.CredentialPause.Paused() {
+		return 0, errf.ErrCredentialConsumptionPaused.New(kt, meta.CredentialPause.Reason())
+	}
+
 	switch am.ConfigType {
 	case table.File:
 	case table.KV:
@@ -81,55 +95,60 @@ func (rs *ReleasedService) matchReleasedGroupWithLabels(
 	_ *kit.Kit,
 	groups []*ptypes.ReleasedGroupCache,
 	meta *types.AppInstanceMeta) (*matchedMeta, error) {
-	// 1. sort released groups by update time
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].UpdatedAt.After(groups[j].UpdatedAt)
-	})
-	// 2. match groups with labels
-	matchedList := []*matchedMeta{}
-	var def *matchedMeta
-	for _, group := range groups {
-		switch group.Mode {
-		case table.GroupModeDebug:
-			if group.UID == meta.Uid {
-				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:  group.ReleaseID,
-					GroupID:    group.GroupID,
-					StrategyID: group.StrategyID,
-				})
-			}
-		case table.GroupModeCustom:
-			if group.Selector == nil {
-				return nil, errf.New(errf.InvalidParameter, "custom group must have selector")
-			}
-			matched, err := group.Selector.MatchLabels(meta.Labels)
-			if err != nil {
-				return nil, err
-			}
-			if matched {
-				matchedList = append(matchedList, &matchedMeta{
-					ReleaseID:  group.ReleaseID,
-					GroupID:    group.GroupID,
-					StrategyID: group.StrategyID,
-				})
-			}
-		case table.GroupModeDefault:
-			def = &matchedMeta{
-				ReleaseID:  group.ReleaseID,
-				GroupID:    group.GroupID,
-				StrategyID: group.StrategyID,
-			}
-		}
+
+	group, err := ptypes.MatchReleasedGroup(groups, meta.Uid, meta.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	return &matchedMeta{
+		ReleaseID:  group.ReleaseID,
+		GroupID:    group.GroupID,
+		StrategyID: group.StrategyID,
+	}, nil
+}
+
+// MatchExplanation is the explanation of how the app instance matches its release on current feed server.
+type MatchExplanation struct {
+	BizID      uint32                    `json:"biz_id"`
+	AppID      uint32                    `json:"app_id"`
+	Uid        string                    `json:"uid"`
+	Labels     map[string]string         `json:"labels"`
+	Matched    bool                      `json:"matched"`
+	ReleaseID  uint32                    `json:"release_id"`
+	GroupID    uint32                    `json:"group_id"`
+	StrategyID uint32                    `json:"strategy_id"`
+	Traces     []*ptypes.GroupMatchTrace `json:"traces"`
+}
+
+// ExplainMatchedRelease explain how the app instance matches its release with the released groups in local cache,
+// which is the same as GetMatchedRelease, but returns the evaluation trace of every released group.
+func (rs *ReleasedService) ExplainMatchedRelease(kt *kit.Kit, bizID uint32, app string, uid string,
+	labels map[string]string) (*MatchExplanation, error) {
+
+	appID, err := rs.cache.App.GetAppID(kt, bizID, app)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(matchedList) == 0 {
-		if def == nil {
-			return nil, errf.ErrAppInstanceNotMatchedRelease
-		}
-		return def, nil
+	meta := &types.AppInstanceMeta{BizID: bizID, AppID: appID, Uid: uid, Labels: labels}
+	groups, err := rs.listReleasedGroups(kt, meta)
+	if err != nil {
+		return nil, err
 	}
 
-	// released groups were sorted by strategy id, so the first one is the latest one.
+	matched, traces, err := ptypes.ExplainReleasedGroupMatch(groups, uid, labels)
+	if err != nil && !errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
+		return nil, err
+	}
+
+	exp := &MatchExplanation{BizID: bizID, AppID: appID, Uid: uid, Labels: labels, Traces: traces}
+	if matched != nil {
+		exp.Matched = true
+		exp.ReleaseID = matched.ReleaseID
+		exp.GroupID = matched.GroupID
+		exp.StrategyID = matched.StrategyID
+	}
 
-	return matchedList[0], nil
+	return exp, nil
 }
@@ -15,6 +15,7 @@ package release
 
 import (
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -42,15 +43,32 @@ func New(cs *clientset.ClientSet, cache *lcache.Cache, w eventc.Watcher) (*Relea
 	}
 
 	limiter := cc.FeedServer().MRLimiter
-	return &ReleasedService{
-		cs:                   cs,
-		cache:                cache,
-		provider:             provider,
-		watcher:              w,
-		wait:                 initWait(),
-		limiter:              rate.NewLimiter(rate.Limit(limiter.QPS), int(limiter.Burst)),
-		matchReleaseWaitTime: time.Duration(limiter.WaitTimeMil) * time.Millisecond,
-	}, nil
+	rs := &ReleasedService{
+		cs:       cs,
+		cache:    cache,
+		provider: provider,
+		watcher:  w,
+		wait:     initWait(),
+		streams:  newWatchStreams(),
+		limiter:  rate.NewLimiter(rate.Limit(limiter.QPS), int(limiter.Burst)),
+	}
+	rs.matchReleaseWaitTime.Store(int64(time.Duration(limiter.WaitTimeMil) * time.Millisecond))
+
+	// 定期清理长时间没有心跳的僵尸连接
+	go rs.streams.runSweeper()
+
+	// 配置热加载时同步更新匹配版本的限流器
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if !e.Has(cc.MatchReleaseLimiterSection) {
+			return
+		}
+		opt := cc.FeedServer().MRLimiter
+		rs.limiter.SetLimit(rate.Limit(opt.QPS))
+		rs.limiter.SetBurst(int(opt.Burst))
+		rs.matchReleaseWaitTime.Store(int64(time.Duration(opt.WaitTimeMil) * time.Millisecond))
+	})
+
+	return rs, nil
 }
 
 // ReleasedService defines release related operations.
@@ -60,8 +78,9 @@ type ReleasedService struct {
 	provider             repository.Provider
 	watcher              eventc.Watcher
 	wait                 *waitShutdown
+	streams              *watchStreams
 	limiter              *rate.Limiter
-	matchReleaseWaitTime time.Duration
+	matchReleaseWaitTime atomic.Int64
 }
 
 // ListAppLatestReleaseMeta list a app's latest release metadata
@@ -165,10 +184,33 @@ func (rs *ReleasedService) ListAppLatestReleaseKvMeta(kt *kit.Kit, opts *types.A
 		ReleaseId: releaseID,
 	}
 
-	kvList := make([]*types.ReleasedKvMeta, len(rkv))
-	for idx, one := range rkv {
+	now := time.Now()
+	kvList := make([]*types.ReleasedKvMeta, 0, len(rkv))
+	for _, one := range rkv {
+		// 按客户端标签匹配分组覆盖值，未匹配时使用基础值
+		contentSpec := one.ContentSpec
+		var groupID uint32
+		var fallback *string
+		if one.Expired(now) {
+			// 过期后不再下发, 配置了兜底值时下发兜底值
+			if one.ExpiredFallback == nil {
+				meta.ExpiredKeys = append(meta.ExpiredKeys, one.Key)
+				continue
+			}
+			contentSpec = one.FallbackContentSpec()
+			fallback = one.ExpiredFallback
+		} else {
+			override, e := one.MatchOverride(opts.Uid, opts.Labels)
+			if e != nil {
+				return nil, e
+			}
+			if override != nil {
+				contentSpec = override.ContentSpec
+				groupID = override.GroupID
+			}
+		}
 
-		kvList[idx] = &types.ReleasedKvMeta{
+		kvList = append(kvList, &types.ReleasedKvMeta{
 			Key:    one.Key,
 			KvType: one.KvType,
 			Revision: &pbbase.Revision{
@@ -181,8 +223,10 @@ func (rs *ReleasedService) ListAppLatestReleaseKvMeta(kt *kit.Kit, opts *types.A
 				BizId: one.Attachment.BizID,
 				AppId: one.Attachment.AppID,
 			},
-			ContentSpec: pbcontent.PbContentSpec(one.ContentSpec),
-		}
+			ContentSpec:     pbcontent.PbContentSpec(contentSpec),
+			OverrideGroupID: groupID,
+			ExpiredFallback: fallback,
+		})
 	}
 	meta.Kvs = kvList
 
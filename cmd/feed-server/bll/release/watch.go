@@ -15,11 +15,14 @@ package release
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"go.uber.org/atomic"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/lcache"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
 	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
@@ -42,12 +45,21 @@ func (rs *ReleasedService) Watch(im *sfs.IncomingMeta, payload *sfs.SideWatchPay
 		wait:        rs.wait,
 		ctx:         ctx,
 		cancelCtx:   cancel,
+		ip:          brpc.MustGetRealIP(fws.Context()),
+		startTime:   time.Now(),
 	}
 
 	if err := wh.subscribe(); err != nil {
+		// 取消已经订阅的服务, 避免订阅泄露
+		wh.unsubscribe()
+		wh.cancelCtx()
 		return err
 	}
 
+	// 记录活跃的 watch 连接, 便于通过控制工具查询和强制断开
+	id := rs.streams.add(wh)
+	defer rs.streams.remove(id)
+
 	wh.waitForFinalize()
 	return nil
 }
@@ -72,39 +84,66 @@ type watchHandler struct {
 	wait        *waitShutdown
 	ctx         context.Context
 	cancelCtx   context.CancelFunc
+	// ip is the real ip of the sidecar.
+	ip        string
+	startTime time.Time
+	// lastHeartbeat is the unix time of the sidecar's last message received by current feed server, 0 means
+	// never received.
+	lastHeartbeat atomic.Int64
+	clientKey     string
+	sessionKeys   []string
 }
 
 func (wh *watchHandler) subscribe() error {
 
-	for _, one := range wh.sidePayload.Applications {
-
-		meta, err := wh.cache.App.GetMeta(wh.im.Kit, wh.sidePayload.BizID, one.AppID)
-		if err != nil {
-			return fmt.Errorf("get app(%d) meta failed, err: %v", one.AppID, err)
-		}
-		spec := &eventc.SubscribeSpec{
-			InstSpec: &sfs.InstanceSpec{
-				BizID:      wh.sidePayload.BizID,
-				App:        one.App,
-				AppID:      one.AppID,
-				Uid:        one.Uid,
-				Labels:     one.Labels,
-				Match:      one.Match,
-				ConfigType: meta.ConfigType,
-			},
-			Receiver: eventc.InitReceiver(wh.eventReceiver, wh.cancelCtx),
+	for _, sub := range wh.sidePayload.AllSubscriptions() {
+		for _, one := range sub.Applications {
+			if err := wh.subscribeApp(sub.BizID, one); err != nil {
+				return err
+			}
 		}
+	}
 
-		sn, err := wh.watcher.Subscribe(one.CurrentReleaseID, one.CurrentCursorID, spec)
-		if err != nil {
-			return fmt.Errorf("subscribe app: %d event failed, err: %v", one.AppID, err)
-		}
+	return nil
+}
 
-		wh.snList[sn] = &appReminder{
-			appID:    one.AppID,
-			uid:      one.Uid,
-			receiver: spec.Receiver,
-		}
+func (wh *watchHandler) subscribeApp(bizID uint32, one sfs.SideAppMeta) error {
+	meta, err := wh.cache.App.GetMeta(wh.im.Kit, bizID, one.AppID)
+	if err != nil {
+		return fmt.Errorf("get app(%d) meta failed, err: %v", one.AppID, err)
+	}
+	// 归档的服务不再向客户端提供配置, 跳过该服务, 不影响同一连接上的其他服务
+	if meta.State == table.AppStateArchived {
+		logs.Warnf("app %s is archived, skip subscribing it, biz: %d, fingerprint: %s, rid: %s", meta.Name, bizID,
+			wh.im.Meta.Fingerprint, wh.im.Kit.Rid)
+		return nil
+	}
+	// 暂停拉取配置的服务仍然订阅, 由 scheduler 跳过新版本的通知, 恢复时会重新广播
+	spec := &eventc.SubscribeSpec{
+		InstSpec: &sfs.InstanceSpec{
+			BizID:           bizID,
+			App:             one.App,
+			AppID:           one.AppID,
+			Uid:             one.Uid,
+			Labels:          one.Labels,
+			Match:           one.Match,
+			ConfigType:      meta.ConfigType,
+			Scopes:          one.Scopes,
+			Credential:      one.Credential,
+			CredentialPause: one.CredentialPause,
+		},
+		Receiver: eventc.InitReceiver(wh.eventReceiver, wh.cancelCtx),
+	}
+
+	sn, err := wh.watcher.Subscribe(one.CurrentReleaseID, one.CurrentCursorID, spec)
+	if err != nil {
+		return fmt.Errorf("subscribe app: %d event failed, err: %v", one.AppID, err)
+	}
+
+	wh.snList[sn] = &appReminder{
+		appID:    one.AppID,
+		uid:      one.Uid,
+		receiver: spec.Receiver,
 	}
 
 	return nil
@@ -169,14 +208,7 @@ func (wh *watchHandler) waitForFinalize() {
 		bounce = true
 	}
 
-	for sn, reminder := range wh.snList {
-		// set the receiver's state to not working status to stop receive the
-		// watched events at first, so that the events can not send to here again.
-		reminder.receiver.SetState(false)
-
-		// unsubscribe the registration
-		wh.watcher.Unsubscribe(reminder.appID, sn, reminder.uid)
-	}
+	wh.unsubscribe()
 
 	if !bounce {
 		logs.Infof("finish deregister sidecar's watch job because of %s, fingerprint: %s, rid: %s", reason,
@@ -204,6 +236,18 @@ func (wh *watchHandler) waitForFinalize() {
 		wh.im.Meta.Fingerprint, wh.im.Kit.Rid)
 }
 
+// unsubscribe unsubscribe all the registered apps of the sidecar.
+func (wh *watchHandler) unsubscribe() {
+	for sn, reminder := range wh.snList {
+		// set the receiver's state to not working status to stop receive the
+		// watched events at first, so that the events can not send to here again.
+		reminder.receiver.SetState(false)
+
+		// unsubscribe the registration
+		wh.watcher.Unsubscribe(reminder.appID, sn, reminder.uid)
+	}
+}
+
 // nextRid generate the next rid based on the incoming rid with rules.
 func (wh *watchHandler) nextRid() string {
 	wh.counter.Inc()
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+// eventBufferSize 单个事件订阅连接缓存的事件数, 缓冲满时事件进入重试队列
+const eventBufferSize = 16
+
+// ErrServerShuttingDown the feed server is shutting down, the consumer should reconnect to another one.
+var ErrServerShuttingDown = errors.New("feed server is shutting down")
+
+// EventSender sends the release change events to the consumers which can not hold a grpc watch stream,
+// such as the server-sent events consumers.
+type EventSender interface {
+	// Send the release change event to the consumer.
+	Send(event *eventc.Event) error
+	// Heartbeat keep the connection with the consumer alive when there is no events.
+	Heartbeat() error
+}
+
+// EventSubscription defines an app instance's subscription of the release change events.
+type EventSubscription struct {
+	Instance *sfs.InstanceSpec
+	// CurrentReleaseID is the release id the instance is using, if the instance's matched release
+	// is not this one, the matched release is sent at once. 0 means the instance has no release yet.
+	CurrentReleaseID uint32
+	CurrentCursorID  uint32
+}
+
+// WatchEvents subscribe the release change events of the app instances and send them with the sender,
+// it blocks until the ctx is done, the sender failed or the feed server is shutting down.
+// heartbeat <= 0 means no heartbeat is needed.
+func (rs *ReleasedService) WatchEvents(ctx context.Context, subs []*EventSubscription, heartbeat time.Duration,
+	sender EventSender) error {
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan *eventc.Event, eventBufferSize)
+	notify := func(event *eventc.Event, _ uint64) bool {
+		select {
+		case events <- event:
+			return false
+		case <-ctx.Done():
+			return false
+		default:
+			// 缓冲已满, 交由调度器稍后重试
+			return true
+		}
+	}
+
+	reminders := make(map[uint64]*appReminder, len(subs))
+	defer func() {
+		for sn, reminder := range reminders {
+			reminder.receiver.SetState(false)
+			rs.watcher.Unsubscribe(reminder.appID, sn, reminder.uid)
+		}
+	}()
+
+	for _, sub := range subs {
+		spec := &eventc.SubscribeSpec{InstSpec: sub.Instance, Receiver: eventc.InitReceiver(notify, cancel)}
+		sn, err := rs.watcher.Subscribe(sub.CurrentReleaseID, sub.CurrentCursorID, spec)
+		if err != nil {
+			return fmt.Errorf("subscribe app: %d event failed, err: %v", sub.Instance.AppID, err)
+		}
+
+		reminders[sn] = &appReminder{appID: sub.Instance.AppID, uid: sub.Instance.Uid, receiver: spec.Receiver}
+	}
+
+	signal := rs.wait.signal()
+	defer rs.wait.done()
+
+	var tick <-chan time.Time
+	if heartbeat > 0 {
+		ticker := time.NewTicker(heartbeat)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-signal:
+			return ErrServerShuttingDown
+
+		case event := <-events:
+			if err := sender.Send(event); err != nil {
+				return err
+			}
+
+		case <-tick:
+			if err := sender.Heartbeat(); err != nil {
+				return err
+			}
+		}
+	}
+}
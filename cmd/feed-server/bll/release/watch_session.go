@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package release
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+)
+
+const (
+	// dedupFlapWindow 同一指纹在该时间内再次出现重复连接时不再踢掉旧连接, 避免两个都存活的客户端
+	// (如指纹冲突) 相互踢下线导致反复重连
+	dedupFlapWindow = time.Minute
+	// minSweepInterval is the minimum interval to sweep the zombie watch sessions.
+	minSweepInterval = 10 * time.Second
+)
+
+// WatchSessionStats is the statistics of the sidecar watch sessions on current feed server.
+type WatchSessionStats struct {
+	// Total is the number of the active watch sessions.
+	Total int `json:"total"`
+	// UniqueClients is the number of the distinct biz and fingerprint of the active watch sessions.
+	UniqueClients int `json:"unique_clients"`
+	// Duplicates is the number of the active watch sessions which duplicate with another one.
+	Duplicates int `json:"duplicates"`
+	// EvictedDuplicates is the total number of the older duplicate sessions which are closed.
+	EvictedDuplicates uint64 `json:"evicted_duplicates"`
+	// CleanedZombies is the total number of the zombie sessions which are closed.
+	CleanedZombies uint64 `json:"cleaned_zombies"`
+}
+
+type sessionMetric struct {
+	// duplicateCounter record the duplicate watch sessions, action is evicted or kept.
+	duplicateCounter *prm.CounterVec
+	// zombieCounter record the zombie watch sessions which are closed.
+	zombieCounter *prm.CounterVec
+	// uniqueClients record the distinct clients with active watch sessions.
+	uniqueClients prm.Gauge
+}
+
+var (
+	sessionMetricOnce sync.Once
+	sessMetric        *sessionMetric
+)
+
+// getSessionMetric returns the watch session metrics, which is initialized only once.
+func getSessionMetric() *sessionMetric {
+	sessionMetricOnce.Do(func() {
+		sessMetric = initSessionMetric()
+	})
+	return sessMetric
+}
+
+func initSessionMetric() *sessionMetric {
+	m := new(sessionMetric)
+	m.duplicateCounter = prm.NewCounterVec(prm.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSWatchSessionSubSys,
+		Name:      "duplicate_total",
+		Help:      "the total count of the watch sessions with the same fingerprint, app and uid",
+	}, []string{"biz", "action"})
+	metrics.Register().MustRegister(m.duplicateCounter)
+
+	m.zombieCounter = prm.NewCounterVec(prm.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSWatchSessionSubSys,
+		Name:      "zombie_total",
+		Help:      "the total count of the zombie watch sessions closed for missing heartbeats",
+	}, []string{"biz"})
+	metrics.Register().MustRegister(m.zombieCounter)
+
+	m.uniqueClients = prm.NewGauge(prm.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSWatchSessionSubSys,
+		Name:      "unique_clients",
+		Help:      "the number of the distinct clients(biz and fingerprint) with active watch sessions",
+	})
+	metrics.Register().MustRegister(m.uniqueClients)
+
+	return m
+}
+
+// clientKey 标识一个客户端实例
+func clientKey(bizID uint32, fingerprint string) string {
+	return fmt.Sprintf("%d/%s", bizID, fingerprint)
+}
+
+// sessionKeys 返回 watch 连接订阅的各个服务的标识, 同一客户端实例以相同的 uid 订阅同一服务的连接视为重复连接
+func sessionKeys(wh *watchHandler) []string {
+	keys := make([]string, 0, len(wh.sidePayload.Applications))
+	for _, sub := range wh.sidePayload.AllSubscriptions() {
+		for _, one := range sub.Applications {
+			keys = append(keys, fmt.Sprintf("%d/%s/%s/%s", sub.BizID, wh.sideMeta.Fingerprint, one.App, one.Uid))
+		}
+	}
+	return keys
+}
+
+// dedup closes the older sessions which duplicate with the new added one, caller should hold the lock.
+func (ws *watchStreams) dedup(id uint64, wh *watchHandler) {
+	older := make(map[uint64]*watchHandler)
+	for _, key := range wh.sessionKeys {
+		for one := range ws.sessions[key] {
+			if one != id {
+				older[one] = ws.handlers[one]
+			}
+		}
+	}
+	if len(older) == 0 {
+		return
+	}
+
+	biz := strconv.FormatUint(uint64(wh.sidePayload.BizID), 10)
+	ck := clientKey(wh.sidePayload.BizID, wh.sideMeta.Fingerprint)
+	now := time.Now()
+	if last, ok := ws.evictedAt[ck]; ok && now.Sub(last) < dedupFlapWindow {
+		// 短时间内反复出现重复连接, 说明多个连接都是存活的, 仅记录不再断开
+		ws.metric.duplicateCounter.WithLabelValues(biz, "kept").Add(float64(len(older)))
+		logs.Warnf("sidecar has %d live duplicate watch sessions, fingerprint: %s, ip: %s, maybe the fingerprint "+
+			"is conflicted, rid: %s", len(older)+1, wh.sideMeta.Fingerprint, wh.ip, wh.im.Kit.Rid)
+		return
+	}
+
+	// 新连接建立后旧连接不会再收到客户端的消息, 如重启的 pod、NAT 复用等, 主动断开旧连接
+	ws.evictedAt[ck] = now
+	for one, old := range older {
+		old.cancelCtx()
+		ws.evicted.Inc()
+		logs.Infof("close duplicate watch session %d, fingerprint: %s, old ip: %s, new ip: %s, rid: %s", one,
+			wh.sideMeta.Fingerprint, old.ip, wh.ip, wh.im.Kit.Rid)
+	}
+	ws.metric.duplicateCounter.WithLabelValues(biz, "evicted").Add(float64(len(older)))
+}
+
+// isDuplicate returns whether there are other live sessions with the same session key, caller should hold the lock.
+func (ws *watchStreams) isDuplicate(wh *watchHandler) bool {
+	for _, key := range wh.sessionKeys {
+		if len(ws.sessions[key]) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// touch refresh the last heartbeat time of the client's watch sessions.
+func (ws *watchStreams) touch(bizID uint32, fingerprint string) {
+	now := time.Now().Unix()
+
+	ws.lock.RLock()
+	defer ws.lock.RUnlock()
+
+	for id := range ws.clients[clientKey(bizID, fingerprint)] {
+		ws.handlers[id].lastHeartbeat.Store(now)
+	}
+}
+
+// sweepZombies closes the watch sessions which have not reported heartbeat within the timeout, the sessions
+// which never report heartbeat to current feed server are skipped, because the sidecar's messages may be sent
+// to another feed server instance.
+func (ws *watchStreams) sweepZombies(timeout time.Duration) []uint64 {
+	now := time.Now()
+	deadline := now.Add(-timeout).Unix()
+
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	for ck, at := range ws.evictedAt {
+		if now.Sub(at) >= dedupFlapWindow {
+			delete(ws.evictedAt, ck)
+		}
+	}
+
+	ids := make([]uint64, 0)
+	for id, wh := range ws.handlers {
+		last := wh.lastHeartbeat.Load()
+		if last == 0 || last > deadline {
+			continue
+		}
+
+		// 避免重复断开正在关闭中的连接
+		if wh.ctx.Err() != nil {
+			continue
+		}
+
+		wh.cancelCtx()
+		ids = append(ids, id)
+		ws.zombies.Inc()
+		ws.metric.zombieCounter.WithLabelValues(strconv.FormatUint(uint64(wh.sidePayload.BizID), 10)).Inc()
+		logs.Infof("close zombie watch session %d, no heartbeat since %s, fingerprint: %s, ip: %s, rid: %s", id,
+			time.Unix(last, 0).Format(time.RFC3339), wh.sideMeta.Fingerprint, wh.ip, wh.im.Kit.Rid)
+	}
+
+	ws.metric.uniqueClients.Set(float64(len(ws.clients)))
+	return ids
+}
+
+// runSweeper sweep the zombie watch sessions periodically.
+func (ws *watchStreams) runSweeper() {
+	for {
+		timeout := time.Duration(cc.FeedServer().Downstream.ZombieTimeoutSec) * time.Second
+		interval := timeout / 3
+		if interval < minSweepInterval {
+			interval = minSweepInterval
+		}
+		time.Sleep(interval)
+
+		if ids := ws.sweepZombies(timeout); len(ids) > 0 {
+			logs.Infof("swept %d zombie watch sessions, ids: %v", len(ids), ids)
+		}
+	}
+}
+
+// TouchWatchStreams refresh the heartbeat of the client's watch sessions when receive the client's messages.
+func (rs *ReleasedService) TouchWatchStreams(bizID uint32, fingerprint string) {
+	rs.streams.touch(bizID, fingerprint)
+}
+
+// WatchSessionStats returns the statistics of the active watch sessions.
+func (rs *ReleasedService) WatchSessionStats() *WatchSessionStats {
+	ws := rs.streams
+	ws.lock.RLock()
+	defer ws.lock.RUnlock()
+
+	stats := &WatchSessionStats{
+		Total:             len(ws.handlers),
+		UniqueClients:     len(ws.clients),
+		EvictedDuplicates: ws.evicted.Load(),
+		CleanedZombies:    ws.zombies.Load(),
+	}
+	for _, wh := range ws.handlers {
+		if ws.isDuplicate(wh) {
+			stats.Duplicates++
+		}
+	}
+
+	return stats
+}
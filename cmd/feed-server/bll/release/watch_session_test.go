@@ -0,0 +1,106 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package release
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+func newTestHandler(fingerprint, ip string, apps ...string) *watchHandler {
+	ctx, cancel := context.WithCancel(context.Background())
+	payload := &sfs.SideWatchPayload{BizID: 2}
+	for _, app := range apps {
+		payload.Applications = append(payload.Applications, sfs.SideAppMeta{App: app, Uid: "uid"})
+	}
+	meta := &sfs.SidecarMetaHeader{BizID: 2, Fingerprint: fingerprint}
+	return &watchHandler{
+		im:          &sfs.IncomingMeta{Kit: kit.New(), Meta: meta},
+		sidePayload: payload,
+		sideMeta:    meta,
+		ctx:         ctx,
+		cancelCtx:   cancel,
+		ip:          ip,
+		startTime:   time.Now(),
+	}
+}
+
+func TestWatchStreamsDedup(t *testing.T) {
+	ws := newWatchStreams()
+
+	old := newTestHandler("fp", "1.1.1.1", "app1", "app2")
+	other := newTestHandler("fp", "1.1.1.1", "app3")
+	oldID := ws.add(old)
+	ws.add(other)
+
+	// 重启后的 pod 以相同指纹重新订阅, 旧连接被断开
+	restarted := newTestHandler("fp", "1.1.1.2", "app2")
+	ws.add(restarted)
+	if old.ctx.Err() == nil {
+		t.Errorf("the older duplicate session should be closed")
+	}
+	if other.ctx.Err() != nil || restarted.ctx.Err() != nil {
+		t.Errorf("the sessions without duplicate should not be closed")
+	}
+	if ws.evicted.Load() != 1 {
+		t.Errorf("expect 1 evicted duplicate, got %d", ws.evicted.Load())
+	}
+	// 旧连接的 watch 结束后移除
+	ws.remove(oldID)
+
+	// 短时间内再次出现重复连接, 仅记录不断开
+	again := newTestHandler("fp", "1.1.1.3", "app3")
+	ws.add(again)
+	if other.ctx.Err() != nil || again.ctx.Err() != nil {
+		t.Errorf("the live duplicate sessions in flap window should not be closed")
+	}
+	if !ws.isDuplicate(again) || ws.isDuplicate(restarted) {
+		t.Errorf("unexpected duplicate state")
+	}
+}
+
+func TestWatchStreamsSweepZombies(t *testing.T) {
+	ws := newWatchStreams()
+
+	neverTouched := newTestHandler("fp1", "1.1.1.1", "app")
+	alive := newTestHandler("fp2", "1.1.1.2", "app")
+	zombie := newTestHandler("fp3", "1.1.1.3", "app")
+	ws.add(neverTouched)
+	ws.add(alive)
+	zombieID := ws.add(zombie)
+
+	ws.touch(2, "fp2")
+	zombie.lastHeartbeat.Store(time.Now().Add(-10 * time.Minute).Unix())
+
+	ids := ws.sweepZombies(2 * time.Minute)
+	if len(ids) != 1 || ids[0] != zombieID {
+		t.Fatalf("expect zombie session %d to be swept, got %v", zombieID, ids)
+	}
+	if zombie.ctx.Err() == nil || alive.ctx.Err() != nil || neverTouched.ctx.Err() != nil {
+		t.Errorf("unexpected sessions closed by the sweeper")
+	}
+
+	// 已关闭中的连接不会被重复清理
+	if ids := ws.sweepZombies(2 * time.Minute); len(ids) != 0 {
+		t.Errorf("expect no session to be swept again, got %v", ids)
+	}
+
+	ws.remove(zombieID)
+	if len(ws.clients) != 2 || len(ws.sessions) != 2 {
+		t.Errorf("the index should be cleaned after remove, clients: %d, sessions: %d", len(ws.clients),
+			len(ws.sessions))
+	}
+}
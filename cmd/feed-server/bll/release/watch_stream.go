@@ -0,0 +1,249 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package release
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// WatchStream is the active sidecar watch stream connected to current feed server.
+type WatchStream struct {
+	ID          uint64            `json:"id"`
+	BizID       uint32            `json:"biz_id"`
+	Fingerprint string            `json:"fingerprint"`
+	IP          string            `json:"ip"`
+	Apps        []*WatchStreamApp `json:"apps"`
+	StartTime   time.Time         `json:"start_time"`
+	Duration    string            `json:"duration"`
+	// LastHeartbeat is the last time the sidecar reported heartbeat to current feed server.
+	LastHeartbeat *time.Time `json:"last_heartbeat,omitempty"`
+	// Duplicate defines whether there are other live sessions with the same fingerprint, app and uid.
+	Duplicate bool `json:"duplicate"`
+}
+
+// WatchStreamApp is the app watched by the stream.
+type WatchStreamApp struct {
+	// BizID is the biz of the app, it differs from the stream's biz when the app is subscribed with
+	// another credential.
+	BizID  uint32            `json:"biz_id"`
+	App    string            `json:"app"`
+	AppID  uint32            `json:"app_id"`
+	Uid    string            `json:"uid"`
+	Labels map[string]string `json:"labels"`
+}
+
+// WatchStreamFilter defines the filter to select the watch streams, empty field matches all.
+type WatchStreamFilter struct {
+	IDs         []uint64
+	BizID       uint32
+	App         string
+	Fingerprint string
+	IP          string
+}
+
+// IsEmpty returns whether the filter selects all the streams.
+func (f *WatchStreamFilter) IsEmpty() bool {
+	return f == nil || (len(f.IDs) == 0 && f.BizID == 0 && f.App == "" && f.Fingerprint == "" && f.IP == "")
+}
+
+func (f *WatchStreamFilter) match(id uint64, wh *watchHandler) bool {
+	if f == nil {
+		return true
+	}
+
+	if len(f.IDs) > 0 {
+		hit := false
+		for _, one := range f.IDs {
+			if one == id {
+				hit = true
+				break
+			}
+		}
+		if !hit {
+			return false
+		}
+	}
+
+	if f.BizID != 0 && f.BizID != wh.sidePayload.BizID {
+		return false
+	}
+
+	if f.Fingerprint != "" && f.Fingerprint != wh.sideMeta.Fingerprint {
+		return false
+	}
+
+	if f.IP != "" && f.IP != wh.ip {
+		return false
+	}
+
+	if f.App != "" {
+		for _, sub := range wh.sidePayload.AllSubscriptions() {
+			for _, one := range sub.Applications {
+				if one.App == f.App {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// watchStreams records the active watch handlers so that they can be listed and closed by the control tool.
+type watchStreams struct {
+	lock     sync.RWMutex
+	seq      *atomic.Uint64
+	handlers map[uint64]*watchHandler
+	// clients is the index of client key(biz and fingerprint) to the stream ids.
+	clients map[string]map[uint64]struct{}
+	// sessions is the index of session key(biz, fingerprint, app and uid) to the stream ids.
+	sessions map[string]map[uint64]struct{}
+	// evictedAt records the last time the client's duplicate sessions are evicted.
+	evictedAt map[string]time.Time
+	evicted   *atomic.Uint64
+	zombies   *atomic.Uint64
+	metric    *sessionMetric
+}
+
+func newWatchStreams() *watchStreams {
+	return &watchStreams{
+		seq:       atomic.NewUint64(0),
+		handlers:  make(map[uint64]*watchHandler),
+		clients:   make(map[string]map[uint64]struct{}),
+		sessions:  make(map[string]map[uint64]struct{}),
+		evictedAt: make(map[string]time.Time),
+		evicted:   atomic.NewUint64(0),
+		zombies:   atomic.NewUint64(0),
+		metric:    getSessionMetric(),
+	}
+}
+
+func (ws *watchStreams) add(wh *watchHandler) uint64 {
+	id := ws.seq.Inc()
+	wh.clientKey = clientKey(wh.sidePayload.BizID, wh.sideMeta.Fingerprint)
+	wh.sessionKeys = sessionKeys(wh)
+
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	ws.handlers[id] = wh
+	addIndex(ws.clients, wh.clientKey, id)
+	for _, key := range wh.sessionKeys {
+		addIndex(ws.sessions, key, id)
+	}
+	ws.dedup(id, wh)
+
+	return id
+}
+
+func (ws *watchStreams) remove(id uint64) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	wh, ok := ws.handlers[id]
+	if !ok {
+		return
+	}
+
+	delete(ws.handlers, id)
+	removeIndex(ws.clients, wh.clientKey, id)
+	for _, key := range wh.sessionKeys {
+		removeIndex(ws.sessions, key, id)
+	}
+}
+
+func addIndex(index map[string]map[uint64]struct{}, key string, id uint64) {
+	if _, ok := index[key]; !ok {
+		index[key] = make(map[uint64]struct{})
+	}
+	index[key][id] = struct{}{}
+}
+
+func removeIndex(index map[string]map[uint64]struct{}, key string, id uint64) {
+	delete(index[key], id)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+// ListWatchStreams list the active watch streams on current feed server which match the filter.
+func (rs *ReleasedService) ListWatchStreams(filter *WatchStreamFilter) []*WatchStream {
+	rs.streams.lock.RLock()
+	defer rs.streams.lock.RUnlock()
+
+	now := time.Now()
+	list := make([]*WatchStream, 0)
+	for id, wh := range rs.streams.handlers {
+		if !filter.match(id, wh) {
+			continue
+		}
+
+		apps := make([]*WatchStreamApp, 0, len(wh.sidePayload.Applications))
+		for _, sub := range wh.sidePayload.AllSubscriptions() {
+			for _, one := range sub.Applications {
+				apps = append(apps, &WatchStreamApp{BizID: sub.BizID, App: one.App, AppID: one.AppID, Uid: one.Uid,
+					Labels: one.Labels})
+			}
+		}
+
+		stream := &WatchStream{
+			ID:          id,
+			BizID:       wh.sidePayload.BizID,
+			Fingerprint: wh.sideMeta.Fingerprint,
+			IP:          wh.ip,
+			Apps:        apps,
+			StartTime:   wh.startTime,
+			Duration:    now.Sub(wh.startTime).Round(time.Second).String(),
+			Duplicate:   rs.streams.isDuplicate(wh),
+		}
+		if last := wh.lastHeartbeat.Load(); last != 0 {
+			t := time.Unix(last, 0)
+			stream.LastHeartbeat = &t
+		}
+		list = append(list, stream)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].ID < list[j].ID
+	})
+
+	return list
+}
+
+// KillWatchStreams force close the active watch streams which match the filter, the sidecar will receive
+// a bounce message and reconnect. returns the closed stream ids.
+func (rs *ReleasedService) KillWatchStreams(filter *WatchStreamFilter) []uint64 {
+	rs.streams.lock.RLock()
+	defer rs.streams.lock.RUnlock()
+
+	ids := make([]uint64, 0)
+	for id, wh := range rs.streams.handlers {
+		if !filter.match(id, wh) {
+			continue
+		}
+
+		wh.cancelCtx()
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
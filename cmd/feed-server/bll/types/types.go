@@ -15,11 +15,13 @@ package types
 
 import (
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components/gse"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	pbcommit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/commit"
 	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
@@ -49,6 +51,19 @@ type AppInstanceMeta struct {
 	Namespace string            `json:"namespace"`
 	Uid       string            `json:"uid"`
 	Labels    map[string]string `json:"labels"`
+	// CredentialPause is the consumption pause of the credential the instance uses, nil if it is not paused
+	// or the credential is unknown.
+	CredentialPause *table.ConsumptionPause `json:"-"`
+}
+
+// IsConsumptionPaused returns whether the error means the consumption of the app or the credential is paused.
+func IsConsumptionPaused(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	code := errf.Error(err).Code
+	return code == errf.ErrAppConsumptionPaused.Code || code == errf.ErrCredentialConsumptionPaused.Code
 }
 
 // ListFileAppLatestReleaseMetaReq defines options to list a file type app's latest release metadata.
@@ -133,6 +148,31 @@ type AppLatestReleaseKvMeta struct {
 	Kvs       []*ReleasedKvMeta `json:"kvs,omitempty"`
 	PreHook   *pbhook.HookSpec  `json:"pre_hook,omitempty"`
 	PostHook  *pbhook.HookSpec  `json:"post_hook,omitempty"`
+	// ExpiredKeys is the keys of the released kvs which are expired and have no fallback value.
+	ExpiredKeys []string `json:"-"`
+}
+
+// Expired returns whether the kv of the key is released but expired without fallback value.
+func (m *AppLatestReleaseKvMeta) Expired(key string) bool {
+	return slices.Contains(m.ExpiredKeys, key)
+}
+
+// OverrideGroupID returns the group id of the override value of the kv matched by the instance.
+func (m *AppLatestReleaseKvMeta) OverrideGroupID(key string) uint32 {
+	if kv := m.Kv(key); kv != nil {
+		return kv.OverrideGroupID
+	}
+	return 0
+}
+
+// Kv returns the released kv meta of the key, nil if the kv is not released or is expired.
+func (m *AppLatestReleaseKvMeta) Kv(key string) *ReleasedKvMeta {
+	for _, one := range m.Kvs {
+		if one.Key == key {
+			return one
+		}
+	}
+	return nil
 }
 
 // ReleasedKvMeta defines a release's released kv metadata
@@ -142,6 +182,10 @@ type ReleasedKvMeta struct {
 	Revision     *pbbase.Revision       `json:"revision,omitempty"`
 	KvAttachment *pbkv.KvAttachment     `json:"kv_attachment,omitempty"`
 	ContentSpec  *pbcontent.ContentSpec `json:"content_spec,omitempty"`
+	// OverrideGroupID is the group id of the override value matched by the instance, 0 means the base value.
+	OverrideGroupID uint32 `json:"override_group_id,omitempty"`
+	// ExpiredFallback is the fallback value served instead of the expired kv, nil means the kv is not expired.
+	ExpiredFallback *string `json:"-"`
 }
 
 // AsyncDownloadJob defines async download job.
@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/binarypatch"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+)
+
+// getFilePatch returns the binary patch from the file of the client's base release to the requested file, nil if
+// the patch is not available, then the client downloads the full content.
+// the base content is resolved from the base release of the same app, rather than being specified by the client,
+// so the patch only reveals the content which the client is permitted to download.
+func (s *Service) getFilePatch(kt *kit.Kit, req *pbfs.GetDownloadURLReq) *pbfs.FilePatch {
+	if req.BaseReleaseId == 0 || !s.bll.BinaryPatch().Enabled() {
+		return nil
+	}
+
+	rci, err := s.bll.RCICache().Get(kt, req.BizId, req.BaseReleaseId)
+	if err != nil {
+		logs.Errorf("get base release %d config items failed, err: %v, rid: %s", req.BaseReleaseId, err, kt.Rid)
+		return nil
+	}
+
+	spec := req.FileMeta.ConfigItemSpec
+	var base *binarypatch.Content
+	for _, ci := range rci {
+		if ci.Attachment.AppID != req.FileMeta.ConfigItemAttachment.AppId ||
+			ci.ConfigItemSpec.Path != spec.Path || ci.ConfigItemSpec.Name != spec.Name {
+			continue
+		}
+		base = &binarypatch.Content{Signature: ci.CommitSpec.Signature, ByteSize: ci.CommitSpec.ByteSize}
+		break
+	}
+	if base == nil {
+		return nil
+	}
+
+	target := &binarypatch.Content{
+		Signature: req.FileMeta.CommitSpec.Content.Signature,
+		ByteSize:  req.FileMeta.CommitSpec.Content.ByteSize,
+	}
+	patch, err := s.bll.BinaryPatch().Get(kt, req.BizId, base, target)
+	if err != nil {
+		logs.Errorf("get binary patch failed, err: %v, rid: %s", err, kt.Rid)
+		return nil
+	}
+	if patch == nil {
+		return nil
+	}
+
+	links, err := s.provider.DownloadLink(kt, patch.Signature, uint32(patch.ByteSize/1024)+1)
+	if err != nil {
+		logs.Errorf("generate patch %s download url failed, err: %v, rid: %s", patch.Signature, err, kt.Rid)
+		return nil
+	}
+
+	return &pbfs.FilePatch{
+		Urls:          links,
+		Signature:     patch.Signature,
+		ByteSize:      patch.ByteSize,
+		BaseSignature: base.Signature,
+	}
+}
@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// incomingClientVersion returns the client version in the sidecar meta of the request, it's empty if the
+// client does not send it.
+func incomingClientVersion(ctx context.Context) string {
+	im, err := sfs.ParseFeedIncomingContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return im.Meta.Version
+}
+
+// checkClientVersion rejects the request whose client version is lower than the min client version of the
+// app, the request without client version is rejected too if the app has a min client version.
+func (s *Service) checkClientVersion(kt *kit.Kit, ver string, bizID, appID uint32) error {
+	meta, err := s.bll.AppCache().GetMeta(kt, bizID, appID)
+	if err != nil {
+		return status.Errorf(codes.Aborted, "get app %d meta failed, %s", appID, err.Error())
+	}
+	return checkAppClientVersion(kt, meta, ver)
+}
+
+// checkAppClientVersion checks the client version against the min client version of the app meta.
+func checkAppClientVersion(kt *kit.Kit, meta *types.AppCacheMeta, ver string) error {
+	if sfs.IsClientVersionAllowed(meta.MinVersion, ver) {
+		return nil
+	}
+
+	if ver == "" {
+		ver = "unknown"
+	}
+	return errf.ErrClientVersionTooLow.New(kt, meta.Name, meta.MinVersion, ver)
+}
+
+// FeedUnaryClientVersionInterceptor 检查每个拉取请求携带的客户端版本是否满足服务要求的最低版本
+func FeedUnaryClientVersionInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+	svc, ok := info.Server.(*Service)
+	// 跳过非业务 Service，如 GRPC Reflection
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	// 上报类消息不检查客户端版本, 低版本客户端仍可上报状态
+	if info.FullMethod == pbfs.Upstream_Messaging_FullMethodName {
+		return handler(ctx, req)
+	}
+
+	bizID, appNames, appIDs := extractConsumedApps(req, info.FullMethod)
+	if bizID == 0 {
+		return handler(ctx, req)
+	}
+
+	kt := kit.FromGrpcContext(ctx)
+	for _, appName := range appNames {
+		appID, err := svc.bll.AppCache().GetAppID(kt, bizID, appName)
+		if err != nil {
+			// 服务不存在等错误由 handler 处理
+			return handler(ctx, req)
+		}
+		appIDs = append(appIDs, appID)
+	}
+
+	ver := incomingClientVersion(ctx)
+	for _, appID := range appIDs {
+		if err := svc.checkClientVersion(kt, ver, bizID, appID); err != nil {
+			return nil, err
+		}
+	}
+
+	return handler(ctx, req)
+}
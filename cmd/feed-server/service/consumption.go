@@ -0,0 +1,205 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"sync"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// consumptionReportInterval is the interval to report the aggregated app consumption.
+const consumptionReportInterval = 30 * time.Second
+
+type consumptionKey struct {
+	bizID uint32
+	appID uint32
+}
+
+type consumptionDelta struct {
+	pullCount     uint64
+	downloadBytes uint64
+	watchSeconds  uint64
+}
+
+// watchState 服务当前的监听连接数, since 为上次累计监听时长的时间
+type watchState struct {
+	active int
+	since  time.Time
+}
+
+// consumptionRecorder 按服务聚合拉取次数、下发字节数和监听时长, 定期上报到 cache service 后持久化,
+// 用于服务的消费统计查询和业务的用量核算
+type consumptionRecorder struct {
+	lock    sync.Mutex
+	stats   map[consumptionKey]*consumptionDelta
+	watches map[consumptionKey]*watchState
+}
+
+func newConsumptionRecorder() *consumptionRecorder {
+	return &consumptionRecorder{
+		stats:   make(map[consumptionKey]*consumptionDelta),
+		watches: make(map[consumptionKey]*watchState),
+	}
+}
+
+// deltaOf returns the delta of the key, the lock should be held by the caller.
+func (r *consumptionRecorder) deltaOf(key consumptionKey) *consumptionDelta {
+	delta, ok := r.stats[key]
+	if !ok {
+		delta = new(consumptionDelta)
+		r.stats[key] = delta
+	}
+	return delta
+}
+
+func (r *consumptionRecorder) add(bizID, appID uint32, pullCount, downloadBytes uint64) {
+	if bizID == 0 || appID == 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delta := r.deltaOf(consumptionKey{bizID: bizID, appID: appID})
+	delta.pullCount += pullCount
+	delta.downloadBytes += downloadBytes
+}
+
+// watch changes the active watch count of the apps by the diff, the watch duration is accrued before that.
+func (r *consumptionRecorder) watch(bizID uint32, appIDs []uint32, diff int) {
+	now := time.Now()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, appID := range appIDs {
+		if bizID == 0 || appID == 0 {
+			continue
+		}
+		key := consumptionKey{bizID: bizID, appID: appID}
+		state, ok := r.watches[key]
+		if !ok {
+			state = &watchState{since: now}
+			r.watches[key] = state
+		}
+		r.accrueWatch(key, state, now)
+		state.active += diff
+		if state.active <= 0 {
+			delete(r.watches, key)
+		}
+	}
+}
+
+// accrueWatch add the whole seconds watched since last accrual to the stats, the remaining part less than
+// one second is accrued next time, the lock should be held by the caller.
+func (r *consumptionRecorder) accrueWatch(key consumptionKey, state *watchState, now time.Time) {
+	elapsed := now.Sub(state.since).Truncate(time.Second)
+	if elapsed <= 0 {
+		return
+	}
+	if state.active > 0 {
+		r.deltaOf(key).watchSeconds += uint64(state.active) * uint64(elapsed/time.Second)
+	}
+	state.since = state.since.Add(elapsed)
+}
+
+// take returns the aggregated stats and resets the recorder.
+func (r *consumptionRecorder) take() map[consumptionKey]*consumptionDelta {
+	now := time.Now()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// 长连接的监听时长按上报周期累计, 避免跨天或跨月的监听只在断开时统计
+	for key, state := range r.watches {
+		r.accrueWatch(key, state, now)
+	}
+
+	stats := r.stats
+	r.stats = make(map[consumptionKey]*consumptionDelta)
+	return stats
+}
+
+// recordPull records the pull requests of the apps.
+func (s *Service) recordPull(bizID uint32, appIDs []uint32, method string) {
+	for _, appID := range appIDs {
+		s.consumption.add(bizID, appID, 1, 0)
+		if s.mc.shouldReport(bizID) {
+			s.mc.appPullCounter.With(prm.Labels{"bizID": tools.Itoa(bizID), "appID": tools.Itoa(appID),
+				"method": method}).Inc()
+		}
+	}
+}
+
+// recordDownload records the bytes of the config files downloaded by the app.
+func (s *Service) recordDownload(bizID, appID uint32, bytes uint64) {
+	s.consumption.add(bizID, appID, 0, bytes)
+	if s.mc.shouldReport(bizID) {
+		s.mc.appDownloadBytes.With(prm.Labels{"bizID": tools.Itoa(bizID), "appID": tools.Itoa(appID)}).
+			Add(float64(bytes))
+	}
+}
+
+// runConsumptionReporter reports the aggregated app consumption periodically.
+func (s *Service) runConsumptionReporter() {
+	notifier := shutdown.AddNotifier()
+	go func() {
+		ticker := time.NewTicker(consumptionReportInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-notifier.Signal:
+				// 退出前上报剩余的统计
+				s.reportConsumption()
+				notifier.Done()
+				return
+			case <-ticker.C:
+				s.reportConsumption()
+			}
+		}
+	}()
+}
+
+func (s *Service) reportConsumption() {
+	stats := s.consumption.take()
+	if len(stats) == 0 {
+		return
+	}
+
+	req := &pbcs.ReportAppConsumptionReq{
+		StatDate: time.Now().UTC().Format(table.AppConsumptionStatDateLayout),
+		Items:    make([]*pbcs.ReportAppConsumptionReq_Item, 0, len(stats)),
+	}
+	for key, delta := range stats {
+		req.Items = append(req.Items, &pbcs.ReportAppConsumptionReq_Item{
+			BizId:         key.bizID,
+			AppId:         key.appID,
+			PullCount:     delta.pullCount,
+			DownloadBytes: delta.downloadBytes,
+			WatchSeconds:  delta.watchSeconds,
+		})
+	}
+
+	kt := kit.New()
+	if err := s.bll.AppCache().ReportAppConsumption(kt, req); err != nil {
+		// 上报失败时丢弃本周期的统计, 避免积压
+		logs.Errorf("report app consumption failed, count: %d, err: %v, rid: %s", len(req.Items), err, kt.Rid)
+	}
+}
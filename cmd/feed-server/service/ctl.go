@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/release"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl/cmd"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// WatchStreamOperator defines the operations of the feed server's active watch streams.
+type WatchStreamOperator interface {
+	ListWatchStreams(filter *release.WatchStreamFilter) []*release.WatchStream
+	KillWatchStreams(filter *release.WatchStreamFilter) []uint64
+	WatchSessionStats() *release.WatchSessionStats
+}
+
+// WithWatchStreams init and returns the watch stream list, kill and stats commands.
+func WithWatchStreams(op WatchStreamOperator) []cmd.Cmd {
+	return []cmd.Cmd{withListWatchStreams(op), withKillWatchStreams(op), withWatchStats(op)}
+}
+
+func withWatchStats(op WatchStreamOperator) cmd.Cmd {
+	return cmd.NewCmd(&cmd.Command{
+		Name: "watch-stats",
+		Usage: "show the statistics of the sidecar watch sessions on current feed server, including the " +
+			"duplicate sessions with the same fingerprint and the cleaned zombie sessions",
+		Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+			return op.WatchSessionStats(), nil
+		},
+	})
+}
+
+func withListWatchStreams(op WatchStreamOperator) cmd.Cmd {
+	return cmd.NewCmd(&cmd.Command{
+		Name:  "list-watch",
+		Usage: "list the sidecar watch streams connected to current feed server",
+		Parameters: []cmd.Parameter{{
+			Name:  "biz_id",
+			Usage: "defines the biz id of the watch streams to list",
+			Value: new(uint32),
+		}, {
+			Name:  "app",
+			Usage: "defines the app name of the watch streams to list",
+			Value: new(string),
+		}, {
+			Name:  "fingerprint",
+			Usage: "defines the sidecar fingerprint of the watch streams to list",
+			Value: new(string),
+		}, {
+			Name:  "ip",
+			Usage: "defines the sidecar ip of the watch streams to list",
+			Value: new(string),
+		}},
+		FromURL: true,
+		Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+			streams := op.ListWatchStreams(watchStreamFilter(params))
+			return map[string]interface{}{"count": len(streams), "details": streams}, nil
+		},
+	})
+}
+
+func withKillWatchStreams(op WatchStreamOperator) cmd.Cmd {
+	return cmd.NewCmd(&cmd.Command{
+		Name: "kill-watch",
+		Usage: "force disconnect the selected sidecar watch streams, the sidecar will receive a bounce message " +
+			"and reconnect, at least one of the parameters is required",
+		Parameters: []cmd.Parameter{{
+			Name:  "ids",
+			Usage: "defines the ids of the watch streams to disconnect, which are returned by list-watch",
+			Value: new([]uint64),
+		}, {
+			Name:  "biz_id",
+			Usage: "defines the biz id of the watch streams to disconnect",
+			Value: new(uint32),
+		}, {
+			Name:  "app",
+			Usage: "defines the app name of the watch streams to disconnect",
+			Value: new(string),
+		}, {
+			Name:  "fingerprint",
+			Usage: "defines the sidecar fingerprint of the watch streams to disconnect",
+			Value: new(string),
+		}, {
+			Name:  "ip",
+			Usage: "defines the sidecar ip of the watch streams to disconnect",
+			Value: new(string),
+		}},
+		Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+			filter := watchStreamFilter(params)
+			// 避免误操作断开全部连接
+			if filter.IsEmpty() {
+				return nil, errf.New(errf.InvalidParameter, "at least one of ids, biz_id, app, fingerprint, ip "+
+					"is required")
+			}
+
+			ids := op.KillWatchStreams(filter)
+			logs.Infof("force disconnected %d watch streams, ids: %v, filter: %+v, rid: %s", len(ids), ids,
+				filter, kt.Rid)
+			return map[string]interface{}{"count": len(ids), "ids": ids}, nil
+		},
+	})
+}
+
+// ReleaseMatchExplainer explains how the app instance matches its release on feed server.
+type ReleaseMatchExplainer interface {
+	ExplainMatchedRelease(kt *kit.Kit, bizID uint32, app string, uid string, labels map[string]string) (
+		*release.MatchExplanation, error)
+}
+
+// WithMatchExplain init and returns the release match explain command.
+func WithMatchExplain(op ReleaseMatchExplainer) cmd.Cmd {
+	return cmd.NewCmd(&cmd.Command{
+		Name: "match-explain",
+		Usage: "explain how the app instance with the uid and labels matches its release on current feed server, " +
+			"returns which released groups are evaluated and which selector clauses are matched or failed",
+		Parameters: []cmd.Parameter{{
+			Name:  "biz_id",
+			Usage: "defines the biz id of the app instance",
+			Value: new(uint32),
+		}, {
+			Name:  "app",
+			Usage: "defines the app name of the app instance",
+			Value: new(string),
+		}, {
+			Name:  "uid",
+			Usage: "defines the uid of the app instance",
+			Value: new(string),
+		}, {
+			Name:  "labels",
+			Usage: "defines the labels of the app instance, such as {\"os\":\"linux\"}",
+			Value: new(map[string]string),
+		}},
+		Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+			bizID := cmd.ParamValue[uint32](params, "biz_id")
+			app := cmd.ParamValue[string](params, "app")
+			if bizID == 0 || app == "" {
+				return nil, errf.New(errf.InvalidParameter, "biz_id and app are required")
+			}
+
+			return op.ExplainMatchedRelease(kt, bizID, app, cmd.ParamValue[string](params, "uid"),
+				cmd.ParamValue[map[string]string](params, "labels"))
+		},
+	})
+}
+
+func watchStreamFilter(params map[string]interface{}) *release.WatchStreamFilter {
+	return &release.WatchStreamFilter{
+		IDs:         cmd.ParamValue[[]uint64](params, "ids"),
+		BizID:       cmd.ParamValue[uint32](params, "biz_id"),
+		App:         cmd.ParamValue[string](params, "app"),
+		Fingerprint: cmd.ParamValue[string](params, "fingerprint"),
+		IP:          cmd.ParamValue[string](params, "ip"),
+	}
+}
@@ -0,0 +1,75 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+// deprecationHeaders build the response header of the deprecated methods, key is the full method name.
+func deprecationHeaders(deps []cc.Deprecation) map[string]metadata.MD {
+	headers := make(map[string]metadata.MD)
+	for _, one := range deps {
+		d := &sfs.Deprecation{Method: one.Method, Field: one.Field, Sunset: one.Sunset, Message: one.Message}
+		value, err := d.Encode()
+		if err != nil {
+			logs.Errorf("encode deprecation of method %s failed, err: %v", one.Method, err)
+			continue
+		}
+
+		if headers[one.Method] == nil {
+			headers[one.Method] = metadata.MD{}
+		}
+		headers[one.Method].Append(sfs.DeprecationHeaderKey, value)
+	}
+	return headers
+}
+
+// DeprecationUnaryInterceptor attaches the configured deprecation warnings to the response header of
+// the deprecated unary methods, the sdk logs them and exposes them with a callback.
+func DeprecationUnaryInterceptor(deps []cc.Deprecation) grpc.UnaryServerInterceptor {
+	headers := deprecationHeaders(deps)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if md, ok := headers[info.FullMethod]; ok {
+			if err := grpc.SetHeader(ctx, md); err != nil {
+				logs.Warnf("set deprecation header of method %s failed, err: %v", info.FullMethod, err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// DeprecationStreamInterceptor attaches the configured deprecation warnings to the response header of
+// the deprecated stream methods, the header is sent with the first message of the stream.
+func DeprecationStreamInterceptor(deps []cc.Deprecation) grpc.StreamServerInterceptor {
+	headers := deprecationHeaders(deps)
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if md, ok := headers[info.FullMethod]; ok {
+			if err := ss.SetHeader(md); err != nil {
+				logs.Warnf("set deprecation header of method %s failed, err: %v", info.FullMethod, err)
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
@@ -84,6 +85,10 @@ func newGrpcMux() *runtime.ServeMux {
 		if key == "Authorization" {
 			return key, true
 		}
+		// 透传 request id, 与 grpc 调用链关联
+		if key == constant.RidKey || key == constant.RidKeyGeneric {
+			return key, true
+		}
 		return runtime.DefaultHeaderMatcher(key)
 	}), errorHandler)
 }
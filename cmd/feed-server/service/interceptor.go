@@ -44,6 +44,8 @@ var (
 		"/pbfs.Upstream/PullAppFileMeta":      {},
 		"/pbfs.Upstream/GetDownloadURL":       {},
 		"/pbfs.Upstream/GetSingleFileContent": {},
+		// v2 watch 的鉴权依赖请求中的 biz, 在 handler 中处理
+		"/pbfs.v2.Upstream/Watch": {},
 	}
 )
 
@@ -134,6 +136,10 @@ func FeedUnaryAuthInterceptor(
 		return nil, err
 	}
 
+	if err := svc.checkQuarantine(ctx, kit.FromGrpcContext(ctx), bizID, incomingFingerprint(ctx)); err != nil {
+		return nil, err
+	}
+
 	return handler(ctx, req)
 }
 
@@ -148,16 +154,20 @@ func LogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		realIP := brpc.MustGetRealIP(ctx)
 
 		biz, app := extractBizIDAndApp(req, info.FullMethod)
+		ctx, breakdown := logs.WithBreakdown(ctx)
 
 		defer func() {
+			cost := time.Since(st)
+			brpc.LogSlowRequest(kt, realIP, info.FullMethod, biz, app, cost, breakdown)
+
 			if err != nil {
 				klog.InfoS("grpc", "rid", kt.Rid, "ip", realIP, "biz", biz, "app", app,
-					"service", service, "method", method, "grpc.duration", time.Since(st), "err", err)
+					"service", service, "method", method, "grpc.duration", cost, "err", err)
 				return
 			}
 
 			klog.InfoS("grpc", "rid", kt.Rid, "ip", realIP, "biz", biz, "app", app,
-				"service", service, "method", method, "grpc.duration", time.Since(st))
+				"service", service, "method", method, "grpc.duration", cost)
 		}()
 
 		resp, err = handler(ctx, req)
@@ -182,77 +192,89 @@ func FeedUnaryUpdateLastConsumedTimeInterceptor(ctx context.Context, req interfa
 	}
 
 	param := lastConsumedTime{}
+	param.BizID, param.AppNames, param.AppIDs = extractConsumedApps(req, info.FullMethod)
+
+	if param.BizID != 0 {
+		ctx = context.WithValue(ctx, constant.BizIDKey, param.BizID) //nolint:staticcheck
+
+		if len(param.AppIDs) == 0 {
+			for _, appName := range param.AppNames {
+				appID, err := svc.bll.AppCache().GetAppID(kit.FromGrpcContext(ctx), param.BizID, appName)
+				if err != nil {
+					logs.Errorf("get app id failed, err: %v", err)
+					return handler(ctx, req)
+				}
+				param.AppIDs = append(param.AppIDs, appID)
+			}
+		}
 
-	switch info.FullMethod {
+		svc.recordPull(param.BizID, param.AppIDs, path.Base(info.FullMethod))
+
+		if err := svc.bll.AppCache().SetAppLastConsumedTime(kit.FromGrpcContext(ctx),
+			param.BizID, param.AppIDs); err != nil {
+			logs.Errorf("set app last consumed time failed, err: %v", err)
+			return handler(ctx, req)
+		}
+		logs.Infof("set app last consumed time success")
+	}
+
+	return handler(ctx, req)
+}
+
+// extractConsumedApps 解析拉取类请求的业务和服务, 服务以名称或 ID 给出, 非拉取类请求返回的业务 ID 为 0
+func extractConsumedApps(req interface{}, fullMethod string) (bizID uint32, appNames []string, appIDs []uint32) {
+	switch fullMethod {
 	case pbfs.Upstream_GetKvValue_FullMethodName:
 		request := req.(*pbfs.GetKvValueReq)
-		param.BizID = request.BizId
-		param.AppNames = append(param.AppNames, request.GetAppMeta().GetApp())
+		bizID = request.BizId
+		appNames = append(appNames, request.GetAppMeta().GetApp())
+	case pbfs.Upstream_GetKvs_FullMethodName:
+		request := req.(*pbfs.GetKvsReq)
+		bizID = request.BizId
+		appNames = append(appNames, request.GetAppMeta().GetApp())
 	case pbfs.Upstream_PullKvMeta_FullMethodName:
 		request := req.(*pbfs.PullKvMetaReq)
-		param.BizID = request.BizId
-		param.AppNames = append(param.AppNames, request.GetAppMeta().GetApp())
+		bizID = request.BizId
+		appNames = append(appNames, request.GetAppMeta().GetApp())
 	case pbfs.Upstream_Messaging_FullMethodName:
 		request := req.(*pbfs.MessagingMeta)
 		if sfs.MessagingType(request.Type) == sfs.VersionChangeMessage {
 			vc := new(sfs.VersionChangePayload)
 			if err := vc.Decode(request.Payload); err != nil {
 				logs.Errorf("version change message decoding failed, %s", err.Error())
-				return handler(ctx, req)
+				return 0, nil, nil
 			}
-			param.BizID = vc.BasicData.BizID
-			param.AppNames = append(param.AppNames, vc.Application.App)
+			bizID = vc.BasicData.BizID
+			appNames = append(appNames, vc.Application.App)
 		}
 	case pbfs.Upstream_Watch_FullMethodName:
 		request := req.(*pbfs.SideWatchMeta)
 		payload := new(sfs.SideWatchPayload)
 		if err := jsoni.Unmarshal(request.Payload, payload); err != nil {
 			logs.Errorf("parse request payload failed, %s", err.Error())
-			return handler(ctx, req)
+			return 0, nil, nil
 		}
-		param.BizID = payload.BizID
+		bizID = payload.BizID
 		for _, v := range payload.Applications {
-			param.AppNames = append(param.AppNames, v.App)
+			appNames = append(appNames, v.App)
 		}
 	case pbfs.Upstream_PullAppFileMeta_FullMethodName:
 		request := req.(*pbfs.PullAppFileMetaReq)
-		param.BizID = request.BizId
-		param.AppNames = append(param.AppNames, request.GetAppMeta().GetApp())
+		bizID = request.BizId
+		appNames = append(appNames, request.GetAppMeta().GetApp())
 	case pbfs.Upstream_GetDownloadURL_FullMethodName:
 		request := req.(*pbfs.GetDownloadURLReq)
-		param.BizID = request.BizId
-		param.AppIDs = append(param.AppIDs, request.GetFileMeta().GetConfigItemAttachment().GetAppId())
+		bizID = request.BizId
+		appIDs = append(appIDs, request.GetFileMeta().GetConfigItemAttachment().GetAppId())
 	case pbfs.Upstream_GetSingleKvValue_FullMethodName, pbfs.Upstream_GetSingleKvMeta_FullMethodName:
 		request := req.(*pbfs.GetSingleKvValueReq)
-		param.BizID = request.BizId
-		param.AppNames = append(param.AppNames, request.GetAppMeta().GetApp())
+		bizID = request.BizId
+		appNames = append(appNames, request.GetAppMeta().GetApp())
 	default:
-		return handler(ctx, req)
+		return 0, nil, nil
 	}
 
-	if param.BizID != 0 {
-		ctx = context.WithValue(ctx, constant.BizIDKey, param.BizID) //nolint:staticcheck
-
-		if len(param.AppIDs) == 0 {
-			for _, appName := range param.AppNames {
-				appID, err := svc.bll.AppCache().GetAppID(kit.FromGrpcContext(ctx), param.BizID, appName)
-				if err != nil {
-					logs.Errorf("get app id failed, err: %v", err)
-					return handler(ctx, req)
-				}
-				param.AppIDs = append(param.AppIDs, appID)
-			}
-		}
-
-		if err := svc.bll.AppCache().SetAppLastConsumedTime(kit.FromGrpcContext(ctx),
-			param.BizID, param.AppIDs); err != nil {
-			logs.Errorf("set app last consumed time failed, err: %v", err)
-			return handler(ctx, req)
-		}
-		logs.Infof("set app last consumed time success")
-	}
-
-	return handler(ctx, req)
+	return bizID, appNames, appIDs
 }
 
 // wrappedStream stream 封装, 可自定义 context 传值
@@ -329,6 +351,48 @@ func extractBizAndApp(req any, fullMethod string) *bizAppParam {
 				return &bizAppParam{
 					BizID: hb.BasicData.BizID,
 				}
+
+			case sfs.HookExecResultMessage:
+				hr := new(sfs.HookExecResultPayload)
+				if err := hr.Decode(m.Payload); err != nil {
+					logs.Errorf("hook exec result payload decoding failed: %v", err)
+					break
+				}
+				if hr.BasicData == nil || hr.Application == nil {
+					break
+				}
+				return &bizAppParam{
+					BizID: hr.BasicData.BizID,
+					App:   hr.Application.App,
+				}
+
+			case sfs.ExperimentExposureMessage:
+				ee := new(sfs.ExperimentExposurePayload)
+				if err := ee.Decode(m.Payload); err != nil {
+					logs.Errorf("experiment exposure payload decoding failed: %v", err)
+					break
+				}
+				if ee.BasicData == nil || ee.Application == nil {
+					break
+				}
+				return &bizAppParam{
+					BizID: ee.BasicData.BizID,
+					App:   ee.Application.App,
+				}
+
+			case sfs.FileTamperMessage:
+				ft := new(sfs.FileTamperPayload)
+				if err := ft.Decode(m.Payload); err != nil {
+					logs.Errorf("file tamper payload decoding failed: %v", err)
+					break
+				}
+				if ft.BasicData == nil || ft.Application == nil {
+					break
+				}
+				return &bizAppParam{
+					BizID: ft.BasicData.BizID,
+					App:   ft.Application.App,
+				}
 			}
 
 		}
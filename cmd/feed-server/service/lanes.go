@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/ratelimiter"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	pbfsv2 "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server/v2"
+)
+
+// methodLanes 请求所属的优先级通道, 未列出的方法均属于拉取通道
+var methodLanes = map[string]ratelimiter.Lane{
+	pbfs.Upstream_Messaging_FullMethodName: ratelimiter.LaneControl,
+	pbfs.Upstream_Handshake_FullMethodName: ratelimiter.LaneWatch,
+	pbfs.Upstream_Watch_FullMethodName:     ratelimiter.LaneWatch,
+	pbfsv2.Upstream_Watch_FullMethodName:   ratelimiter.LaneWatch,
+}
+
+func laneOf(fullMethod string) ratelimiter.Lane {
+	if l, ok := methodLanes[fullMethod]; ok {
+		return l
+	}
+	return ratelimiter.LanePull
+}
+
+// laneLimiter limits the requests by the priority lanes.
+type laneLimiter struct {
+	lanes      *ratelimiter.PriorityLanes
+	retryAfter time.Duration
+	shed       *prm.CounterVec
+}
+
+// newLaneLimiter create the lane limiter, returns nil if the priority lanes are not enabled.
+func newLaneLimiter(conf cc.PriorityLanes) *laneLimiter {
+	if !conf.Enable {
+		return nil
+	}
+
+	ll := &laneLimiter{
+		lanes:      ratelimiter.NewPriorityLanes(conf),
+		retryAfter: time.Duration(conf.RetryAfterMS) * time.Millisecond,
+		shed: prm.NewCounterVec(prm.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.FSOverloadSubSys,
+			Name:      "lane_shed_total",
+			Help:      "the total count of the requests shed by the priority lanes",
+		}, []string{"lane", "reason"}),
+	}
+	metrics.Register().MustRegister(ll.shed)
+
+	for _, one := range ll.lanes.Lanes() {
+		l := one
+		metrics.Register().MustRegister(
+			prm.NewGaugeFunc(prm.GaugeOpts{
+				Namespace:   metrics.Namespace,
+				Subsystem:   metrics.FSOverloadSubSys,
+				Name:        "lane_inflight",
+				Help:        "the number of the requests in processing of the priority lane",
+				ConstLabels: prm.Labels{"lane": string(l)},
+			}, func() float64 { return float64(ll.lanes.Inflight(l)) }),
+			prm.NewGaugeFunc(prm.GaugeOpts{
+				Namespace:   metrics.Namespace,
+				Subsystem:   metrics.FSOverloadSubSys,
+				Name:        "lane_queued",
+				Help:        "the number of the requests waiting in the queue of the priority lane",
+				ConstLabels: prm.Labels{"lane": string(l)},
+			}, func() float64 { return float64(ll.lanes.Queued(l)) }),
+		)
+	}
+
+	return ll
+}
+
+// acquire acquires a slot of the lane, the release function is a no-op if the lane limiter is not enabled.
+func (ll *laneLimiter) acquire(ctx context.Context, lane ratelimiter.Lane) (func(), error) {
+	if ll == nil {
+		return func() {}, nil
+	}
+
+	release, err := ll.lanes.Acquire(ctx, lane)
+	if err == nil {
+		return release, nil
+	}
+
+	reason := "timeout"
+	switch {
+	case errors.Is(err, ratelimiter.ErrLaneQueueFull):
+		reason = "queue_full"
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return nil, status.FromContextError(err).Err()
+	}
+	ll.shed.WithLabelValues(string(lane), reason).Inc()
+
+	return nil, errf.ErrServerOverloaded.New(kit.FromGrpcContext(ctx), ll.retryAfter).WithRetryAfter(ll.retryAfter)
+}
+
+// PriorityLaneUnaryInterceptor limits the unary requests by the priority lanes.
+func (s *Service) PriorityLaneUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		release, err := s.lanes.acquire(ctx, laneOf(info.FullMethod))
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// PriorityLaneStreamInterceptor limits the stream requests by the priority lanes, the watch stream is long-lived,
+// so only its setup is limited by the watch lane in the Watch handler.
+func (s *Service) PriorityLaneStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		if info.FullMethod == pbfs.Upstream_Watch_FullMethodName ||
+			info.FullMethod == pbfsv2.Upstream_Watch_FullMethodName {
+			return handler(srv, ss)
+		}
+
+		release, err := s.lanes.acquire(ss.Context(), laneOf(info.FullMethod))
+		if err != nil {
+			return err
+		}
+		defer release()
+
+		return handler(srv, ss)
+	}
+}
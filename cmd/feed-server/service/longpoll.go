@@ -0,0 +1,182 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/release"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+)
+
+// errLongPollChanged 收到版本变更事件, 结束本次长轮询
+var errLongPollChanged = errors.New("release changed")
+
+// longPollSender collects the release change events of a long poll watch request.
+type longPollSender struct {
+	changes []*sfs.ReleaseChangeNotice
+}
+
+// Send record the release change event and finish the long poll.
+func (ls *longPollSender) Send(event *eventc.Event) error {
+	if event.Change == nil || event.Instance == nil {
+		return nil
+	}
+
+	ls.changes = append(ls.changes, &sfs.ReleaseChangeNotice{
+		BizID:       event.Instance.BizID,
+		AppID:       event.Change.AppID,
+		App:         event.Change.App,
+		ReleaseID:   event.Change.ReleaseID,
+		ReleaseName: event.Change.ReleaseName,
+		CursorID:    event.CursorID,
+	})
+
+	return errLongPollChanged
+}
+
+// Heartbeat is not needed for long poll.
+func (ls *longPollSender) Heartbeat() error {
+	return nil
+}
+
+// LongPollWatch is the long poll fallback of the watch for the environments where neither grpc nor
+// persistent connections survive the proxies. It returns immediately when a new release exists for
+// any of the apps, otherwise it returns after the timeout, the consumer should carry the returned
+// cursor in the next request.
+// nolint:funlen
+func (s *Service) LongPollWatch(w http.ResponseWriter, r *http.Request) {
+	kt := kit.FromGrpcContext(r.Context())
+
+	req := new(sfs.LongPollWatchReq)
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		render.Render(w, r, rest.BadRequest(fmt.Errorf("invalid request body, err: %v", err)))
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+	kt.BizID = req.BizID
+
+	cursor, err := sfs.DecodeLongPollCursor(req.Cursor)
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	cred, renderer := s.getRestCredential(kt, r)
+	if renderer != nil {
+		render.Render(w, r, renderer)
+		return
+	}
+
+	resp, err := s.bll.AppCache().ListApps(kt, &pbcs.ListAppsReq{BizId: kt.BizID})
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(fmt.Errorf("list apps failed, err: %v", err)))
+		return
+	}
+
+	apps := make(map[string]*sfs.InstanceSpec, len(resp.Details))
+	for _, d := range resp.Details {
+		apps[d.Spec.Name] = &sfs.InstanceSpec{BizID: kt.BizID, AppID: d.Id, App: d.Spec.Name,
+			ConfigType: table.ConfigType(d.Spec.ConfigType)}
+	}
+
+	// 新的游标只记录本次请求的 app
+	next := &sfs.LongPollCursor{Releases: make(map[uint32]uint32), CursorID: cursor.CursorID}
+	subs := make([]*release.EventSubscription, 0, len(req.Apps))
+	appIDs := make([]uint32, 0, len(req.Apps))
+	for _, one := range req.Apps {
+		app, exist := apps[one.App]
+		if !exist {
+			render.Render(w, r, rest.NotFound(fmt.Errorf("app %s not found", one.App)))
+			return
+		}
+
+		if !cred.MatchApp(one.App) {
+			render.Render(w, r, rest.PermissionDenied(fmt.Errorf("not have app %s permission", one.App), nil))
+			return
+		}
+
+		inst := *app
+		inst.Uid = one.Uid
+		inst.Labels = one.Labels
+
+		// 携带游标时以游标记录的版本为准
+		current := one.CurrentReleaseID
+		if req.Cursor != "" {
+			current = cursor.Releases[inst.AppID]
+		}
+		next.Releases[inst.AppID] = current
+
+		subs = append(subs, &release.EventSubscription{
+			Instance:         &inst,
+			CurrentReleaseID: current,
+			CurrentCursorID:  cursor.CursorID,
+		})
+		appIDs = append(appIDs, inst.AppID)
+	}
+
+	timeout := req.TimeoutSec
+	if timeout == 0 {
+		timeout = sfs.DefaultLongPollTimeoutSec
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	s.consumption.watch(kt.BizID, appIDs, 1)
+	defer s.consumption.watch(kt.BizID, appIDs, -1)
+
+	sender := new(longPollSender)
+	err = s.bll.Release().WatchEvents(ctx, subs, 0, sender)
+	if err != nil && !errors.Is(err, errLongPollChanged) && !errors.Is(err, release.ErrServerShuttingDown) {
+		logs.Errorf("long poll watch failed, biz: %d, apps: %v, err: %v, rid: %s", kt.BizID, appIDs, err, kt.Rid)
+		render.Render(w, r, rest.BadRequest(err))
+		return
+	}
+
+	for _, change := range sender.changes {
+		next.Releases[change.AppID] = change.ReleaseID
+		if change.CursorID > next.CursorID {
+			next.CursorID = change.CursorID
+		}
+	}
+
+	token, err := next.Encode()
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(fmt.Errorf("encode cursor failed, err: %v", err)))
+		return
+	}
+
+	render.Render(w, r, rest.OKRender(&sfs.LongPollWatchResp{
+		Changed: len(sender.changes) > 0,
+		Changes: sender.changes,
+		Cursor:  token,
+	}))
+}
@@ -141,6 +141,43 @@ func initMetric(name string, blacklistBizIds []uint32) *metric {
 		}, versionChange)
 	metrics.Register().MustRegister(m.changeTotalSeconds)
 
+	// 服务维度的消费统计
+	m.appPullCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   metrics.Namespace,
+			Subsystem:   metrics.FSConfigConsume,
+			Name:        "app_pull_total",
+			Help:        "record the total pull requests of the app",
+			ConstLabels: labels,
+		}, []string{"bizID", "appID", "method"})
+	metrics.Register().MustRegister(m.appPullCounter)
+	m.appDownloadBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   metrics.Namespace,
+			Subsystem:   metrics.FSConfigConsume,
+			Name:        "app_download_bytes_total",
+			Help:        "record the total bytes of config files downloaded by the app",
+			ConstLabels: labels,
+		}, []string{"bizID", "appID"})
+	metrics.Register().MustRegister(m.appDownloadBytes)
+	m.appWatchCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace:   metrics.Namespace,
+		Subsystem:   metrics.FSConfigConsume,
+		Name:        "app_current_watch_count",
+		Help:        "record the current watch connection count of the app",
+		ConstLabels: labels,
+	}, []string{"bizID", "appID"})
+	metrics.Register().MustRegister(m.appWatchCount)
+	m.quarantineRejected = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace:   metrics.Namespace,
+			Subsystem:   metrics.FSConfigConsume,
+			Name:        "quarantine_rejected_total",
+			Help:        "record the total requests rejected because the client is quarantined",
+			ConstLabels: labels,
+		}, []string{"bizID", "matchType"})
+	metrics.Register().MustRegister(m.quarantineRejected)
+
 	black := make(map[uint32]struct{}, len(blacklistBizIds))
 	for _, id := range blacklistBizIds {
 		black[id] = struct{}{}
@@ -176,6 +213,14 @@ type metric struct {
 	changeTotalFileSize *prometheus.HistogramVec
 	// 变更总耗时
 	changeTotalSeconds *prometheus.HistogramVec
+	// 服务拉取请求数
+	appPullCounter *prometheus.CounterVec
+	// 服务下发的配置文件字节数
+	appDownloadBytes *prometheus.CounterVec
+	// 服务当前的 watch 连接数
+	appWatchCount *prometheus.GaugeVec
+	// 因客户端被隔离而拒绝的请求数
+	quarantineRejected *prometheus.CounterVec
 	blacklist          map[uint32]struct{}
 }
 
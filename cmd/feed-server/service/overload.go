@@ -0,0 +1,75 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"path"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/ratelimiter"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+)
+
+// concurrencyExemptMethods 不参与并发限制的方法, 心跳等上报消息被丢弃会导致连接被视为僵尸连接
+var concurrencyExemptMethods = map[string]struct{}{
+	pbfs.Upstream_Messaging_FullMethodName: {},
+}
+
+// ConcurrencyLimitUnaryInterceptor sheds the requests exceeding the adaptive concurrency limit with a
+// retry after hint, so that the latency is bounded when the upstream services slow down.
+func ConcurrencyLimitUnaryInterceptor(limiter *ratelimiter.AdaptiveLimiter) grpc.UnaryServerInterceptor {
+	shedCounter := prm.NewCounterVec(prm.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSOverloadSubSys,
+		Name:      "shed_total",
+		Help:      "the total count of the requests shed by the adaptive concurrency limiter",
+	}, []string{"method"})
+	limitGauge := prm.NewGaugeFunc(prm.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSOverloadSubSys,
+		Name:      "concurrency_limit",
+		Help:      "the current concurrency limit of the adaptive concurrency limiter",
+	}, func() float64 { return float64(limiter.Limit()) })
+	inflightGauge := prm.NewGaugeFunc(prm.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSOverloadSubSys,
+		Name:      "inflight",
+		Help:      "the number of the requests in processing which are limited by the concurrency limiter",
+	}, func() float64 { return float64(limiter.Inflight()) })
+	metrics.Register().MustRegister(shedCounter, limitGauge, inflightGauge)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if _, ok := concurrencyExemptMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		release, ok := limiter.Acquire()
+		if !ok {
+			shedCounter.WithLabelValues(path.Base(info.FullMethod)).Inc()
+			after := limiter.RetryAfter()
+			return nil, errf.ErrServerOverloaded.New(kit.FromGrpcContext(ctx), after.Round(time.Millisecond)).
+				WithRetryAfter(after)
+		}
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
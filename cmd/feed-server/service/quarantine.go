@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// checkQuarantine rejects the client which is quarantined by fingerprint or ip, the error carries the
+// retry after hint so that the client backs off instead of retrying in a loop.
+func (s *Service) checkQuarantine(ctx context.Context, kt *kit.Kit, bizID uint32, fingerprint string) error {
+	ip := brpc.MustGetRealIP(ctx)
+	q := s.bll.Quarantine().Match(kt, bizID, fingerprint, ip)
+	if q == nil {
+		return nil
+	}
+
+	s.mc.quarantineRejected.With(prm.Labels{"bizID": tools.Itoa(bizID), "matchType": string(q.MatchType)}).Inc()
+	logs.Warnf("reject quarantined client, biz: %d, fingerprint: %s, ip: %s, quarantine: %s/%s, rid: %s",
+		bizID, fingerprint, ip, q.MatchType, q.Value, kt.Rid)
+
+	reason := q.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("matched by %s", q.MatchType)
+	}
+	return errf.ErrClientQuarantined.New(kt, reason, q.RetryAfter()).WithRetryAfter(q.RetryAfter())
+}
+
+// incomingFingerprint returns the fingerprint in the sidecar meta of the request, the clients which do not
+// send the sidecar meta can only be quarantined by ip.
+func incomingFingerprint(ctx context.Context) string {
+	im, err := sfs.ParseFeedIncomingContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return im.Meta.Fingerprint
+}
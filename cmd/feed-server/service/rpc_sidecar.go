@@ -22,7 +22,9 @@ import (
 	"github.com/dustin/go-humanize"
 	prm "github.com/prometheus/client_golang/prometheus"
 	"github.com/samber/lo"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
@@ -30,6 +32,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/ratelimiter"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/iam/meta"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
@@ -61,6 +64,11 @@ func (s *Service) Handshake(ctx context.Context, hm *pbfs.HandshakeMessage) (*pb
 			"biz id %d does not exist", hm.Spec.BizId))
 	}
 
+	// 被隔离的客户端在握手阶段拒绝, 不影响使用同一密钥的其他客户端
+	if err = s.checkQuarantine(ctx, im.Kit, hm.Spec.BizId, im.Meta.Fingerprint); err != nil {
+		return nil, err
+	}
+
 	// check if the sidecar's version can be accepted.
 	if !sfs.IsAPIVersionMatch(hm.ApiVersion) {
 		return nil, status.Error(codes.InvalidArgument, "sdk's api version is too low, should be upgraded")
@@ -88,7 +96,8 @@ func (s *Service) Handshake(ctx context.Context, hm *pbfs.HandshakeMessage) (*pb
 	decorator := s.provider.URIDecorator(hm.Spec.BizId)
 	payload := &sfs.SidecarHandshakePayload{
 		ServiceInfo: &sfs.ServiceInfo{
-			Name: s.name,
+			Name:          s.name,
+			WatchVersions: sfs.SupportedWatchVersions,
 		},
 		RuntimeOption: &sfs.SidecarRuntimeOption{
 			BounceIntervalHour: cc.FeedServer().Downstream.BounceIntervalHour,
@@ -121,6 +130,17 @@ func (s *Service) Watch(swm *pbfs.SideWatchMeta, fws pbfs.Upstream_WatchServer)
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if err = s.checkQuarantine(fws.Context(), im.Kit, im.Meta.BizID, im.Meta.Fingerprint); err != nil {
+		return err
+	}
+
+	// watch 建立阶段的鉴权、查询占用 watch 通道的并发, 建立后的长连接不再占用
+	releaseLane, err := s.lanes.acquire(fws.Context(), ratelimiter.LaneWatch)
+	if err != nil {
+		return err
+	}
+	defer releaseLane()
+
 	ra := &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.Sidecar, Action: meta.Access}, BizID: im.Meta.BizID}
 	authorized, err := s.bll.Auth().Authorize(im.Kit, ra)
 	if err != nil {
@@ -137,24 +157,52 @@ func (s *Service) Watch(swm *pbfs.SideWatchMeta, fws pbfs.Upstream_WatchServer)
 		return status.Errorf(codes.Aborted, "parse request payload failed, %s", err.Error())
 	}
 
-	for i := range payload.Applications {
-		appID, err := s.bll.AppCache().GetAppID(im.Kit, payload.BizID, payload.Applications[i].App)
-		if err != nil {
-			if isNotFoundErr(err) {
-				return status.Error(codes.NotFound, fmt.Sprintf("get app id failed, %s", err.Error()))
+	for _, sub := range payload.AllSubscriptions() {
+		if sub == nil {
+			return status.Error(codes.InvalidArgument, "invalid payload, subscription is nil")
+		}
+		for i := range sub.Applications {
+			appID, err := s.bll.AppCache().GetAppID(im.Kit, sub.BizID, sub.Applications[i].App)
+			if err != nil {
+				if isNotFoundErr(err) {
+					return status.Error(codes.NotFound, fmt.Sprintf("get app id failed, %s", err.Error()))
+				}
+				return status.Error(codes.Aborted, fmt.Sprintf("get app id failed, %s", err.Error()))
+			}
+			sub.Applications[i].AppID = appID
+
+			if err := s.checkClientVersion(im.Kit, im.Meta.Version, sub.BizID, appID); err != nil {
+				return err
 			}
-			return status.Error(codes.Aborted, fmt.Sprintf("get app id failed, %s", err.Error()))
 		}
-		payload.Applications[i].AppID = appID
 	}
 
 	if err := payload.Validate(); err != nil {
 		return status.Errorf(codes.Aborted, "invalid payload, err: %s", err.Error())
 	}
 
+	// 凭证可限定服务内的路径范围, 推送的版本变更事件中只包含有权限的配置项, 获取不到凭证时拒绝订阅,
+	// 避免推送全部配置项
+	cred, token, err := s.streamCredential(fws.Context(), im.Kit, payload.BizID)
+	if err != nil {
+		return credentialError(err, fmt.Sprintf("biz %d", payload.BizID))
+	}
+	if !cred.Enabled {
+		return status.Errorf(codes.PermissionDenied, "credential of biz %d is disabled", payload.BizID)
+	}
+	setAppCredential(cred, token, payload.Applications)
+
+	for _, sub := range payload.Subscriptions {
+		if err := s.authorizeSubscription(fws.Context(), im.Kit, sub); err != nil {
+			return err
+		}
+	}
+
 	var msg string
-	for _, one := range payload.Applications {
-		msg += fmt.Sprintf("biz: %d, app: %s, uid: %s, labels: %s, ", payload.BizID, one.App, one.Uid, one.Labels)
+	for _, sub := range payload.AllSubscriptions() {
+		for _, one := range sub.Applications {
+			msg += fmt.Sprintf("biz: %d, app: %s, uid: %s, labels: %s, ", sub.BizID, one.App, one.Uid, one.Labels)
+		}
 	}
 
 	logs.Infof("received sidecar watch request, biz: %d, %s fingerprint: %s, rid: %s.", im.Meta.BizID, msg,
@@ -163,7 +211,33 @@ func (s *Service) Watch(swm *pbfs.SideWatchMeta, fws pbfs.Upstream_WatchServer)
 	s.mc.watchTotal.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Inc()
 	defer s.mc.watchTotal.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Dec()
 	s.mc.watchCounter.With(prm.Labels{"biz": tools.Itoa(im.Meta.BizID)}).Inc()
+	for _, sub := range payload.AllSubscriptions() {
+		watchAppIDs := make([]uint32, 0, len(sub.Applications))
+		for _, one := range sub.Applications {
+			watchAppIDs = append(watchAppIDs, one.AppID)
+		}
+		s.consumption.watch(sub.BizID, watchAppIDs, 1)
+		defer s.consumption.watch(sub.BizID, watchAppIDs, -1)
 
+		if !s.mc.shouldReport(sub.BizID) {
+			continue
+		}
+		watchLabels := make([]prm.Labels, 0, len(sub.Applications))
+		for _, one := range sub.Applications {
+			watchLabels = append(watchLabels, prm.Labels{"bizID": tools.Itoa(sub.BizID),
+				"appID": tools.Itoa(one.AppID)})
+		}
+		for _, l := range watchLabels {
+			s.mc.appWatchCount.With(l).Inc()
+		}
+		defer func() {
+			for _, l := range watchLabels {
+				s.mc.appWatchCount.With(l).Dec()
+			}
+		}()
+	}
+
+	releaseLane()
 	if err := s.bll.Release().Watch(im, payload, fws); err != nil {
 		logs.Errorf("sidecar watch failed, err: %v, rid: %s.", err, im.Kit.Rid)
 		return status.Errorf(codes.Aborted, "do watch job failed, err: %v", err)
@@ -174,6 +248,88 @@ func (s *Service) Watch(swm *pbfs.SideWatchMeta, fws pbfs.Upstream_WatchServer)
 	return nil
 }
 
+// authorizeSubscription checks the credential of the apps subscribed over the watch stream besides the
+// stream's own apps, the watch stream's credential is used if the subscription's token is empty.
+func (s *Service) authorizeSubscription(ctx context.Context, kt *kit.Kit, sub *sfs.SideSubscription) error {
+	ra := &meta.ResourceAttribute{Basic: meta.Basic{Type: meta.Sidecar, Action: meta.Access}, BizID: sub.BizID}
+	authorized, err := s.bll.Auth().Authorize(kt, ra)
+	if err != nil {
+		return status.Errorf(codes.Aborted, "do authorization failed, %s", err.Error())
+	}
+	if !authorized {
+		return status.Errorf(codes.PermissionDenied, "no permission to access biz %d", sub.BizID)
+	}
+
+	var cred *pkgtypes.CredentialCache
+	token := sub.Token
+	if token == "" {
+		cred, token, err = s.streamCredential(ctx, kt, sub.BizID)
+	} else {
+		cred, err = s.bll.Auth().GetCred(kt, sub.BizID, sub.Token)
+	}
+	if err != nil {
+		return credentialError(err, fmt.Sprintf("biz %d subscription", sub.BizID))
+	}
+
+	if !cred.Enabled {
+		return status.Errorf(codes.PermissionDenied, "credential of biz %d subscription is disabled", sub.BizID)
+	}
+
+	for _, one := range sub.Applications {
+		if !cred.MatchApp(one.App) {
+			return status.Errorf(codes.PermissionDenied, "no permission to access app %s of biz %d", one.App,
+				sub.BizID)
+		}
+	}
+	setAppCredential(cred, token, sub.Applications)
+
+	return nil
+}
+
+// streamCredential returns the credential and its token of the watch stream in the biz.
+func (s *Service) streamCredential(ctx context.Context, kt *kit.Kit, bizID uint32) (*pkgtypes.CredentialCache,
+	string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, "", status.Error(codes.Unauthenticated, "missing grpc metadata")
+	}
+
+	token, err := getBearerToken(md)
+	if err != nil {
+		return nil, "", status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	cred, err := s.bll.Auth().GetCred(kt, bizID, token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return cred, token, nil
+}
+
+// credentialError converts the error of getting the credential of the watch stream to grpc status error, a
+// missing or not found credential is unauthenticated, while the other errors(e.g. cache or rpc failures) are
+// temporary, so the sidecar can retry the watch.
+func credentialError(err error, target string) error {
+	if isNotFoundErr(err) {
+		return status.Errorf(codes.Unauthenticated, "credential of %s not found", target)
+	}
+	if status.Code(err) == codes.Unauthenticated {
+		return err
+	}
+
+	return status.Errorf(codes.Unavailable, "get credential of %s failed, %s", target, err.Error())
+}
+
+// setAppCredential set the credential, its consumption pause and the path scopes granted to it of the apps.
+func setAppCredential(cred *pkgtypes.CredentialCache, token string, apps []sfs.SideAppMeta) {
+	for i := range apps {
+		apps[i].Scopes = cred.AppScopes(apps[i].App)
+		apps[i].Credential = token
+		apps[i].CredentialPause = cred.Pause
+	}
+}
+
 // Messaging received messages delivered from sidecar.
 // nolint:funlen
 func (s *Service) Messaging(ctx context.Context, msg *pbfs.MessagingMeta) (*pbfs.MessagingResp, error) {
@@ -192,9 +348,12 @@ func (s *Service) Messaging(ctx context.Context, msg *pbfs.MessagingMeta) (*pbfs
 		return nil, status.Errorf(codes.PermissionDenied, "no permission to access bscp server")
 	}
 
+	// 客户端的任意上报消息都说明其仍然存活, 刷新其 watch 连接的心跳时间
+	s.bll.Release().TouchWatchStreams(im.Meta.BizID, im.Meta.Fingerprint)
+
 	clientMetricData := make(map[uint32]*sfs.ClientMetricData)
 	// 按照服务级别上报数据
-	// 上报的事件分两种 心跳事件、变更事件
+	// 上报的事件分三种 心跳事件、变更事件、脚本执行结果事件
 	switch sfs.MessagingType(msg.Type) {
 	case sfs.VersionChangeMessage:
 		vc := new(sfs.VersionChangePayload)
@@ -282,6 +441,85 @@ func (s *Service) Messaging(ctx context.Context, msg *pbfs.MessagingMeta) (*pbfs
 				}
 			}
 		}
+	case sfs.HookExecResultMessage:
+		hr := new(sfs.HookExecResultPayload)
+		if err = hr.Decode(msg.Payload); err != nil {
+			logs.Errorf("hook exec result message decoding failed, %s", err.Error())
+			return nil, err
+		}
+		if err = hr.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		appID, errApp := s.bll.AppCache().GetAppID(im.Kit, im.Meta.BizID, hr.Application.App)
+		if errApp != nil {
+			logs.Errorf("get app id failed, %s", errApp.Error())
+			return nil, errApp
+		}
+		hr.Application.AppID = appID
+		payload, errE := hr.Encode()
+		if errE != nil {
+			logs.Errorf("hook exec result message encoding failed, %s", errE.Error())
+			return nil, errE
+		}
+		clientMetricData[appID] = &sfs.ClientMetricData{
+			MessagingType: msg.Type,
+			Payload:       payload,
+		}
+	case sfs.ExperimentExposureMessage:
+		ee := new(sfs.ExperimentExposurePayload)
+		if err = ee.Decode(msg.Payload); err != nil {
+			logs.Errorf("experiment exposure message decoding failed, %s", err.Error())
+			return nil, err
+		}
+		if err = ee.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		appID, errApp := s.bll.AppCache().GetAppID(im.Kit, im.Meta.BizID, ee.Application.App)
+		if errApp != nil {
+			logs.Errorf("get app id failed, %s", errApp.Error())
+			return nil, errApp
+		}
+		// 以 feed server 鉴权后的业务为准, 避免客户端上报到其他业务
+		ee.BasicData.BizID = im.Meta.BizID
+		ee.Application.AppID = appID
+		payload, errE := ee.Encode()
+		if errE != nil {
+			logs.Errorf("experiment exposure message encoding failed, %s", errE.Error())
+			return nil, errE
+		}
+		clientMetricData[appID] = &sfs.ClientMetricData{
+			MessagingType: msg.Type,
+			Payload:       payload,
+		}
+	case sfs.FileTamperMessage:
+		ft := new(sfs.FileTamperPayload)
+		if err = ft.Decode(msg.Payload); err != nil {
+			logs.Errorf("file tamper message decoding failed, %s", err.Error())
+			return nil, err
+		}
+		if err = ft.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		appID, errApp := s.bll.AppCache().GetAppID(im.Kit, im.Meta.BizID, ft.Application.App)
+		if errApp != nil {
+			logs.Errorf("get app id failed, %s", errApp.Error())
+			return nil, errApp
+		}
+		// 以 feed server 鉴权后的业务为准, 避免客户端上报到其他业务
+		ft.BasicData.BizID = im.Meta.BizID
+		ft.Application.AppID = appID
+		payload, errE := ft.Encode()
+		if errE != nil {
+			logs.Errorf("file tamper message encoding failed, %s", errE.Error())
+			return nil, errE
+		}
+		clientMetricData[appID] = &sfs.ClientMetricData{
+			MessagingType: msg.Type,
+			Payload:       payload,
+		}
 	}
 	for appID, v := range clientMetricData {
 		payload, err := jsoni.Marshal(v)
@@ -354,6 +592,10 @@ func (s *Service) PullAppFileMeta(ctx context.Context, req *pbfs.PullAppFileMeta
 		Uid:    req.AppMeta.Uid,
 		Labels: req.AppMeta.Labels,
 	}
+	// 客户端密钥暂停拉取配置时不再提供版本, 无效的密钥交由后续鉴权处理
+	if pause, e := s.bll.Auth().GetCredPause(im.Kit, req.BizId, req.Token); e == nil {
+		meta.CredentialPause = pause
+	}
 
 	cancel := im.Kit.CtxWithTimeoutMS(1500)
 	defer cancel()
@@ -368,6 +610,7 @@ func (s *Service) PullAppFileMeta(ctx context.Context, req *pbfs.PullAppFileMeta
 	}
 
 	fileMetas := make([]*pbfs.FileMeta, 0, len(metas.ConfigItems))
+	denied := 0
 	// 支持req.Match多个匹配，且兼容原有req.Key单个匹配，当req.Match和req.Key都未设置时则获取全部配置项
 	match := req.Match
 	if req.Key != "" {
@@ -397,8 +640,10 @@ func (s *Service) PullAppFileMeta(ctx context.Context, req *pbfs.PullAppFileMeta
 			return nil, status.Errorf(codes.InvalidArgument, "do authorization failed, %s", err.Error())
 		}
 
+		// 凭证可限定服务内的路径范围, 只返回有权限的配置文件
 		if !match {
-			return nil, status.Error(codes.PermissionDenied, "no permission to download file")
+			denied++
+			continue
 		}
 
 		fileMetas = append(fileMetas, &pbfs.FileMeta{
@@ -413,6 +658,10 @@ func (s *Service) PullAppFileMeta(ctx context.Context, req *pbfs.PullAppFileMeta
 			},
 		})
 	}
+	if len(fileMetas) == 0 && denied > 0 {
+		return nil, status.Error(codes.PermissionDenied, "no permission to download file")
+	}
+
 	resp := &pbfs.PullAppFileMetaResp{
 		ReleaseId:   metas.ReleaseId,
 		ReleaseName: metas.ReleaseName,
@@ -526,7 +775,15 @@ func (s *Service) GetDownloadURL(ctx context.Context, req *pbfs.GetDownloadURLRe
 		Url:         downloadLink[0], // 保留Url兼容老版客户端，DownloadLink方法返回无错误则downloadLink长度必大于0，无需判断
 		Urls:        downloadLink,
 		WaitTimeMil: waitTimeMil,
+		Patch:       s.getFilePatch(im.Kit, req),
+	}
+
+	// 客户端通过下载链接直接从仓库下载, 以文件大小作为下发的字节数, 下发补丁时以补丁大小计算
+	downloadBytes := req.FileMeta.CommitSpec.Content.ByteSize
+	if resp.Patch != nil {
+		downloadBytes = resp.Patch.ByteSize
 	}
+	s.recordDownload(req.BizId, req.FileMeta.ConfigItemAttachment.AppId, downloadBytes)
 
 	return resp, nil
 }
@@ -565,11 +822,12 @@ func (s *Service) PullKvMeta(ctx context.Context, req *pbfs.PullKvMetaReq) (*pbf
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.AppMeta.App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:           req.BizId,
+		App:             req.AppMeta.App,
+		AppID:           appID,
+		Uid:             req.AppMeta.Uid,
+		Labels:          req.AppMeta.Labels,
+		CredentialPause: credential.Pause,
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -581,6 +839,7 @@ func (s *Service) PullKvMeta(ctx context.Context, req *pbfs.PullKvMetaReq) (*pbf
 		return nil, err
 	}
 
+	experiments := s.bll.Experiment().MatchInstance(kt, req.BizId, appID, req.AppMeta.Uid, req.AppMeta.Labels)
 	kvMetas := make([]*pbfs.KvMeta, 0, len(metas.Kvs))
 	for _, kv := range metas.Kvs {
 		// 只返回有权限的kv
@@ -602,6 +861,7 @@ func (s *Service) PullKvMeta(ctx context.Context, req *pbfs.PullKvMetaReq) (*pbf
 				AppId: kv.KvAttachment.AppId,
 			},
 			ContentSpec: kv.ContentSpec,
+			Experiment:  experiments[kv.Key],
 		})
 	}
 
@@ -650,11 +910,12 @@ func (s *Service) GetKvValue(ctx context.Context, req *pbfs.GetKvValueReq) (*pbf
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:           req.BizId,
+		App:             req.GetAppMeta().App,
+		AppID:           appID,
+		Uid:             req.AppMeta.Uid,
+		Labels:          req.AppMeta.Labels,
+		CredentialPause: credential.Pause,
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -662,8 +923,11 @@ func (s *Service) GetKvValue(ctx context.Context, req *pbfs.GetKvValueReq) (*pbf
 		return nil, err
 	}
 
-	rkv, err := s.bll.RKvCache().GetKvValue(kt, req.BizId, appID, metas.ReleaseId, req.Key)
+	rkv, err := s.getReleasedKvValue(kt, req.BizId, appID, metas, req.Key)
 	if err != nil {
+		if isKvExpiredErr(err) {
+			return nil, err
+		}
 		// appid等未找到, 刷新缓存, 客户端重试请求
 		if isNotFoundErr(err) {
 			s.bll.AppCache().RemoveCache(kt, req.BizId, req.GetAppMeta().App)
@@ -681,10 +945,154 @@ func (s *Service) GetKvValue(ctx context.Context, req *pbfs.GetKvValueReq) (*pbf
 	return kv, nil
 }
 
+// maxBatchKvs 批量获取kv时单次最多指定的key数量
+const maxBatchKvs = 500
+
+// GetKvs 批量获取kv, 所有kv的值均取自同一个版本, 避免在发布过程中拿到不同版本的值
+func (s *Service) GetKvs(ctx context.Context, req *pbfs.GetKvsReq) (*pbfs.GetKvsResp, error) {
+	kt := kit.FromGrpcContext(ctx)
+	if req.GetAppMeta() == nil || req.GetAppMeta().App == "" {
+		return nil, status.Error(codes.InvalidArgument, "app_meta is required")
+	}
+
+	if len(req.Keys) > maxBatchKvs {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d keys are allowed", maxBatchKvs)
+	}
+
+	credential := getCredential(ctx)
+	if !credential.MatchApp(req.AppMeta.App) {
+		return nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", req.AppMeta.App)
+	}
+
+	for _, key := range req.Keys {
+		if !credential.MatchKv(req.AppMeta.App, key) {
+			return nil, status.Errorf(codes.PermissionDenied, "no permission get value of key %s", key)
+		}
+	}
+
+	appID, err := s.bll.AppCache().GetAppID(kt, req.BizId, req.GetAppMeta().App)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("get app id failed, %s", err.Error()))
+		}
+		return nil, status.Error(codes.Aborted, fmt.Sprintf("get app id failed, %s", err.Error()))
+	}
+
+	app, err := s.bll.AppCache().GetMeta(kt, req.BizId, appID)
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("get app failed, %s", err.Error()))
+		}
+		return nil, status.Error(codes.Aborted, fmt.Sprintf("get app failed, %s", err.Error()))
+	}
+
+	if app.ConfigType != table.KV {
+		return nil, status.Errorf(codes.Aborted, "app not %s type", table.KV)
+	}
+
+	meta := &types.AppInstanceMeta{
+		BizID:           req.BizId,
+		App:             req.GetAppMeta().App,
+		AppID:           appID,
+		Uid:             req.AppMeta.Uid,
+		Labels:          req.AppMeta.Labels,
+		CredentialPause: credential.Pause,
+	}
+
+	// 只解析一次最新版本, 后续所有kv都从该版本中获取
+	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
+	if err != nil {
+		if isNotFoundErr(err) {
+			s.bll.AppCache().RemoveCache(kt, req.BizId, req.AppMeta.App)
+		}
+		return nil, err
+	}
+
+	released := make(map[string]*types.ReleasedKvMeta, len(metas.Kvs))
+	for _, kv := range metas.Kvs {
+		released[kv.Key] = kv
+	}
+
+	selected := make([]*types.ReleasedKvMeta, 0)
+	notFound := make([]string, 0)
+	if len(req.Keys) > 0 {
+		for _, key := range lo.Uniq(req.Keys) {
+			kv, ok := released[key]
+			if !ok {
+				notFound = append(notFound, key)
+				continue
+			}
+			selected = append(selected, kv)
+		}
+	} else {
+		for _, kv := range metas.Kvs {
+			if credential.MatchKv(req.AppMeta.App, kv.Key) && tools.MatchPattern(kv.Key, req.Match) {
+				selected = append(selected, kv)
+			}
+		}
+	}
+
+	kvs := make([]*pbfs.KvValue, len(selected))
+	eg, _ := errgroup.WithContext(kt.RpcCtx())
+	eg.SetLimit(10)
+	for i, one := range selected {
+		i, one := i, one
+		eg.Go(func() error {
+			rkv, e := s.getReleasedKvValue(kt, req.BizId, appID, metas, one.Key)
+			if e != nil {
+				return e
+			}
+			kvs[i] = &pbfs.KvValue{Key: one.Key, KvType: rkv.KvType, Value: rkv.Value}
+			return nil
+		})
+	}
+	if err = eg.Wait(); err != nil {
+		if isKvExpiredErr(err) {
+			return nil, err
+		}
+		if isNotFoundErr(err) {
+			s.bll.AppCache().RemoveCache(kt, req.BizId, req.GetAppMeta().App)
+			return nil, status.Errorf(codes.NotFound, "get rkv failed, %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Aborted, "get rkv failed, %s", err.Error())
+	}
+
+	return &pbfs.GetKvsResp{ReleaseId: metas.ReleaseId, Kvs: kvs, NotFoundKeys: notFound}, nil
+}
+
+// getReleasedKvValue get the value of the released kv, the fallback value is returned if the kv is expired.
+func (s *Service) getReleasedKvValue(kt *kit.Kit, bizID, appID uint32, metas *types.AppLatestReleaseKvMeta,
+	key string) (*pkgtypes.ReleaseKvValueCache, error) {
+	// 过期且没有兜底值的kv返回独立的错误码, 不能按未找到处理而清理服务缓存
+	if metas.Expired(key) {
+		return nil, errf.ErrKvExpired.New(kt, key)
+	}
+
+	kv := metas.Kv(key)
+	if kv == nil {
+		return nil, status.Errorf(codes.NotFound, "kv %s is not released", key)
+	}
+
+	if kv.ExpiredFallback != nil {
+		return &pkgtypes.ReleaseKvValueCache{
+			ReleaseID: metas.ReleaseId,
+			Key:       key,
+			KvType:    kv.KvType,
+			Value:     *kv.ExpiredFallback,
+		}, nil
+	}
+
+	return s.bll.RKvCache().GetKvValue(kt, bizID, appID, metas.ReleaseId, kv.OverrideGroupID, key)
+}
+
 func isNotFoundErr(err error) bool {
 	return status.Code(err) == codes.NotFound
 }
 
+func isKvExpiredErr(err error) bool {
+	return errf.Error(err).Code == errf.ErrKvExpired.Code
+}
+
 // ListApps 获取服务列表
 func (s *Service) ListApps(ctx context.Context, req *pbfs.ListAppsReq) (*pbfs.ListAppsResp, error) {
 	kt := kit.FromGrpcContext(ctx)
@@ -892,11 +1300,12 @@ func (s *Service) GetSingleKvMeta(ctx context.Context, req *pbfs.GetSingleKvValu
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.AppMeta.App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:           req.BizId,
+		App:             req.AppMeta.App,
+		AppID:           appID,
+		Uid:             req.AppMeta.Uid,
+		Labels:          req.AppMeta.Labels,
+		CredentialPause: credential.Pause,
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -971,11 +1380,12 @@ func (s *Service) GetSingleKvValue(ctx context.Context, req *pbfs.GetSingleKvVal
 	}
 
 	meta := &types.AppInstanceMeta{
-		BizID:  req.BizId,
-		App:    req.GetAppMeta().App,
-		AppID:  appID,
-		Uid:    req.AppMeta.Uid,
-		Labels: req.AppMeta.Labels,
+		BizID:           req.BizId,
+		App:             req.GetAppMeta().App,
+		AppID:           appID,
+		Uid:             req.AppMeta.Uid,
+		Labels:          req.AppMeta.Labels,
+		CredentialPause: credential.Pause,
 	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseKvMeta(kt, meta)
@@ -983,8 +1393,11 @@ func (s *Service) GetSingleKvValue(ctx context.Context, req *pbfs.GetSingleKvVal
 		return nil, err
 	}
 
-	rkv, err := s.bll.RKvCache().GetKvValue(kt, req.BizId, appID, metas.ReleaseId, req.Key)
+	rkv, err := s.getReleasedKvValue(kt, req.BizId, appID, metas, req.Key)
 	if err != nil {
+		if isKvExpiredErr(err) {
+			return nil, err
+		}
 		// appid等未找到, 刷新缓存, 客户端重试请求
 		if isNotFoundErr(err) {
 			s.bll.AppCache().RemoveCache(kt, req.BizId, req.GetAppMeta().App)
@@ -1068,6 +1481,10 @@ func (s *Service) GetSingleFileContent(req *pbfs.GetSingleFileContentReq,
 		Uid:    req.AppMeta.Uid,
 		Labels: req.AppMeta.Labels,
 	}
+	// 客户端密钥暂停拉取配置时不再提供版本, 无效的密钥交由后续鉴权处理
+	if pause, e := s.bll.Auth().GetCredPause(im.Kit, req.BizId, req.Token); e == nil {
+		meta.CredentialPause = pause
+	}
 
 	metas, err := s.bll.Release().ListAppLatestReleaseMeta(im.Kit, meta)
 	if err != nil {
@@ -31,13 +31,16 @@ import (
 	"github.com/go-chi/httprate"
 	"github.com/go-chi/render"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
 	"k8s.io/klog/v2"
 
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll"
+	clientset "github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/client-set"
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/internal/iam/auth"
 	"github.com/TencentBlueKing/bk-bscp/internal/ratelimiter"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/grpcweb"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/handler"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
@@ -63,6 +66,12 @@ type Service struct {
 	mc    *metric
 	gwMux *runtime.ServeMux
 	rl    *ratelimiter.RL
+	// lanes 按请求类型隔离并发的优先级通道, 未启用时为 nil
+	lanes *laneLimiter
+	// consumption 服务消费统计
+	consumption *consumptionRecorder
+	// grpcWeb 浏览器端通过 grpc-web 访问 grpc 服务，未启用时为 nil
+	grpcWeb *grpcweb.Handler
 }
 
 // NewService create a service instance.
@@ -78,7 +87,8 @@ func NewService(sd serviced.Discover, name string) (*Service, error) {
 		return nil, fmt.Errorf("new gateway failed, err: %v", err)
 	}
 
-	authorizer, err := auth.NewAuthorizer(sd, cc.FeedServer().Network.TLS)
+	authorizer, err := auth.NewAuthorizer(sd, cc.FeedServer().Network.TLS,
+		clientset.ResilienceInterceptor(cc.FeedServer().Upstream))
 	if err != nil {
 		return nil, fmt.Errorf("new authorizer failed, err: %v", err)
 	}
@@ -95,17 +105,28 @@ func NewService(sd serviced.Discover, name string) (*Service, error) {
 
 	rl := ratelimiter.New(cc.FeedServer().RateLimiter)
 	logs.Infof("init rate limiter, conf: %+v", cc.FeedServer().RateLimiter)
+	cc.OnReload(func(e cc.ReloadEvent) {
+		if e.Has(cc.RateLimiterSection) {
+			rl.Update(cc.FeedServer().RateLimiter)
+			logs.Infof("reload rate limiter, conf: %+v", cc.FeedServer().RateLimiter)
+		}
+	})
 
-	return &Service{
-		bll:        bl,
-		authorizer: authorizer,
-		state:      state,
-		name:       name,
-		provider:   provider,
-		mc:         initMetric(name, cc.FeedServer().Metric.BlacklistBizIDs),
-		gwMux:      gwMux,
-		rl:         rl,
-	}, nil
+	s := &Service{
+		bll:         bl,
+		authorizer:  authorizer,
+		state:       state,
+		name:        name,
+		provider:    provider,
+		mc:          initMetric(name, cc.FeedServer().Metric.BlacklistBizIDs),
+		gwMux:       gwMux,
+		rl:          rl,
+		lanes:       newLaneLimiter(cc.FeedServer().Lanes),
+		consumption: newConsumptionRecorder(),
+	}
+	s.runConsumptionReporter()
+
+	return s, nil
 }
 
 // ListenAndServeRest listen and serve the restful server
@@ -257,6 +278,31 @@ func (s *Service) handler() http.Handler {
 	return r
 }
 
+// EnableGrpcWeb enable the grpc-web protocol on the gateway server if it is configured.
+func (s *Service) EnableGrpcWeb(serve *grpc.Server) {
+	opt := cc.FeedServer().GrpcWeb
+	if !opt.Enabled {
+		return
+	}
+
+	s.grpcWeb = grpcweb.Wrap(serve, grpcweb.Options{
+		AllowedOrigins: opt.AllowedOrigins,
+		AllowedMethods: opt.AllowedMethods,
+	})
+	logs.Infof("grpc-web is enabled on feed server gateway, allowed origins: %v", opt.AllowedOrigins)
+}
+
+// serveGrpcWeb 将 grpc-web 请求转给 grpc server 处理，其余请求继续走网关
+func (s *Service) serveGrpcWeb(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.grpcWeb != nil && grpcweb.IsGrpcWebRequest(r) {
+			s.grpcWeb.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 func (s *Service) handlerGw() http.Handler {
 	r := chi.NewRouter()
 	ipLimit := cc.FeedServer().RateLimiter.IP.Limit
@@ -266,11 +312,15 @@ func (s *Service) handlerGw() http.Handler {
 
 	r.Use(handler.RequestID)
 	r.Use(middleware.RealIP)
+	r.Use(s.serveGrpcWeb)
 	r.Use(middleware.Logger)
+	r.Use(handler.SlowRequestLogger)
 	r.Use(httprate.LimitByRealIP(int(ipLimit), time.Second))
 	r.Use(middleware.Recoverer)
 	r.Route("/api/v1/feed", func(r chi.Router) {
 		r.With(s.UpdateLastConsumedTime).Get("/biz/{biz_id}/app/{app}/files/*", s.DownloadFile)
+		r.Get("/sse/watch", s.WatchSSE)
+		r.Post("/longpoll/watch", s.LongPollWatch)
 		r.Mount("/", s.gwMux)
 	})
 	return r
@@ -374,6 +424,11 @@ func (s *Service) DownloadFile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 内容按 sha256 寻址, 签名即为内容的 ETag, 客户端缓存的内容未变化时不再下载
+	if rest.WriteConditional(w, r, rest.ETag(data.CommitSpec.GetContent().GetSignature())) {
+		return
+	}
+
 	body, contentLength, err := s.provider.Download(kt, data.CommitSpec.GetContent().GetSignature())
 	if err != nil {
 		render.Render(w, r, rest.BadRequest(err))
@@ -383,7 +438,8 @@ func (s *Service) DownloadFile(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Content-Length", strconv.FormatInt(contentLength, 10))
 	w.Header().Set("Content-Type", "application/octet-stream")
-	_, err = io.Copy(w, body)
+	n, err := io.Copy(w, body)
+	s.recordDownload(kt.BizID, appID, uint64(n))
 	if err != nil {
 		klog.ErrorS(err, "download file", "sign", data.CommitSpec.GetContent().GetSignature())
 		render.Render(w, r, rest.BadRequest(fmt.Errorf("download file failed, err: %v", err)))
@@ -404,6 +460,16 @@ func findMatchingConfigItem(filePath, fileName string,
 	return nil
 }
 
+// WatchStreams returns the operator of the active sidecar watch streams, used by control tool.
+func (s *Service) WatchStreams() WatchStreamOperator {
+	return s.bll.Release()
+}
+
+// MatchExplainer returns the release match explainer of feed server, used by control tool.
+func (s *Service) MatchExplainer() ReleaseMatchExplainer {
+	return s.bll.Release()
+}
+
 // HealthyHandler livenessProbe 健康检查
 func (s *Service) HealthyHandler(w http.ResponseWriter, r *http.Request) {
 	w.Write([]byte("OK"))
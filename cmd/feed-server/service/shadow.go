@@ -0,0 +1,257 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+
+	prm "github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// shadowHeader marks the request is a shadow request, the staging cluster does not mirror it again.
+const shadowHeader = "x-bkbscp-shadow"
+
+// shadowMethods 镜像到 staging 集群的只读方法, 值为创建响应消息的函数
+var shadowMethods = map[string]func() proto.Message{
+	pbfs.Upstream_Handshake_FullMethodName:       func() proto.Message { return new(pbfs.HandshakeResp) },
+	pbfs.Upstream_PullAppFileMeta_FullMethodName: func() proto.Message { return new(pbfs.PullAppFileMetaResp) },
+	pbfs.Upstream_PullKvMeta_FullMethodName:      func() proto.Message { return new(pbfs.PullKvMetaResp) },
+}
+
+// shadow results.
+const (
+	shadowMatch   = "match"
+	shadowDiverge = "diverge"
+	shadowError   = "error"
+	shadowDrop    = "drop"
+)
+
+// Shadower mirrors a percentage of the read only requests to the staging feed-server cluster and compares
+// the responses with the production ones.
+type Shadower struct {
+	conn    *grpc.ClientConn
+	percent int
+	timeout time.Duration
+	// token is the credential token of the staging cluster, which replaces the client's credential.
+	token string
+	// inflight limits the number of the in flight shadow requests.
+	inflight chan struct{}
+	ignore   map[protoreflect.Name]struct{}
+	counter  *prm.CounterVec
+}
+
+// NewShadower create a shadower with the shadowing options.
+func NewShadower(conf cc.Shadowing) (*Shadower, error) {
+	// 镜像请求携带 staging 集群的凭证, 必须通过 tls 发送
+	tls := conf.TLS
+	tlsC, err := tools.ClientTLSConfVerify(tls.InsecureSkipVerify, tls.CAFile, tls.CertFile, tls.KeyFile,
+		tls.Password)
+	if err != nil {
+		return nil, fmt.Errorf("init shadowing tls config failed, err: %v", err)
+	}
+
+	conn, err := grpc.Dial(conf.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsC)))
+	if err != nil {
+		return nil, fmt.Errorf("dial staging feed server %s failed, err: %v", conf.Address, err)
+	}
+
+	ignore := make(map[protoreflect.Name]struct{}, len(conf.IgnoreFields))
+	for _, one := range conf.IgnoreFields {
+		ignore[protoreflect.Name(one)] = struct{}{}
+	}
+
+	counter := prm.NewCounterVec(prm.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.FSShadowSubSys,
+		Name:      "requests_total",
+		Help:      "the total count of the shadow requests mirrored to the staging cluster, grouped by the result",
+	}, []string{"method", "result"})
+	metrics.Register().MustRegister(counter)
+
+	return &Shadower{
+		conn:     conn,
+		percent:  int(conf.Percent),
+		timeout:  time.Duration(conf.TimeoutMS) * time.Millisecond,
+		token:    conf.Token,
+		inflight: make(chan struct{}, conf.MaxInflight),
+		ignore:   ignore,
+		counter:  counter,
+	}, nil
+}
+
+// UnaryInterceptor mirrors the sampled read only requests after they are handled, the shadow request is sent
+// asynchronously, so the response to the client is never delayed or changed.
+func (s *Shadower) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		newResp, ok := shadowMethods[info.FullMethod]
+		if !ok || rand.Intn(100) >= s.percent {
+			return resp, err
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get(shadowHeader)) != 0 {
+			return resp, err
+		}
+
+		select {
+		case s.inflight <- struct{}{}:
+		default:
+			s.counter.WithLabelValues(path.Base(info.FullMethod), shadowDrop).Inc()
+			return resp, err
+		}
+
+		// 请求和响应在返回后可能被复用, 复制一份用于镜像和比较
+		shadowReq := proto.Clone(req.(proto.Message))
+		var prodResp proto.Message
+		if err == nil {
+			prodResp = proto.Clone(resp.(proto.Message))
+		}
+		rid := kit.FromGrpcContext(ctx).Rid
+
+		go func() {
+			defer func() { <-s.inflight }()
+			s.mirror(info.FullMethod, rid, shadowMetadata(md, s.token), shadowReq, newResp(), prodResp, err)
+		}()
+
+		return resp, err
+	}
+}
+
+// mirror sends the request to the staging cluster and compares its response with the production one.
+// prodResp is nil if the production request is failed, then only the error codes are compared.
+func (s *Shadower) mirror(method, rid string, md metadata.MD, req, shadowResp, prodResp proto.Message,
+	prodErr error) {
+	ctx, cancel := context.WithTimeout(metadata.NewOutgoingContext(context.Background(), md), s.timeout)
+	defer cancel()
+
+	shadowErr := s.conn.Invoke(ctx, method, req, shadowResp)
+
+	name := path.Base(method)
+	prodCode, shadowCode := status.Code(prodErr), status.Code(shadowErr)
+	if prodCode != shadowCode {
+		s.counter.WithLabelValues(name, shadowError).Inc()
+		logs.Warnf("shadow request %s diverges, production code: %s, staging code: %s, staging err: %v, rid: %s",
+			name, prodCode, shadowCode, shadowErr, rid)
+		return
+	}
+
+	if prodResp == nil {
+		s.counter.WithLabelValues(name, shadowMatch).Inc()
+		return
+	}
+
+	if fields := s.diffFields(prodResp, shadowResp); len(fields) != 0 {
+		s.counter.WithLabelValues(name, shadowDiverge).Inc()
+		logs.Warnf("shadow request %s diverges, different fields: %s, rid: %s", name,
+			strings.Join(fields, ", "), rid)
+		return
+	}
+
+	s.counter.WithLabelValues(name, shadowMatch).Inc()
+}
+
+// diffFields returns the top level fields which are different between the two responses, the ignored fields
+// are not compared at any level.
+func (s *Shadower) diffFields(prod, shadow proto.Message) []string {
+	prod, shadow = proto.Clone(prod), proto.Clone(shadow)
+	s.clearIgnored(prod.ProtoReflect())
+	s.clearIgnored(shadow.ProtoReflect())
+
+	pm, sm := prod.ProtoReflect(), shadow.ProtoReflect()
+	fields := make([]string, 0)
+	fds := pm.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if pm.Has(fd) != sm.Has(fd) || !pm.Get(fd).Equal(sm.Get(fd)) {
+			fields = append(fields, string(fd.Name()))
+		}
+	}
+
+	return fields
+}
+
+// clearIgnored clears the ignored fields of the message recursively.
+func (s *Shadower) clearIgnored(m protoreflect.Message) {
+	if len(s.ignore) == 0 {
+		return
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if _, ok := s.ignore[fd.Name()]; ok {
+			m.Clear(fd)
+			return true
+		}
+
+		switch {
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				s.clearIgnored(list.Get(i).Message())
+			}
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				s.clearIgnored(mv.Message())
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			s.clearIgnored(v.Message())
+		}
+		return true
+	})
+}
+
+// shadowMetadata returns the metadata of the shadow request, which keeps the client's metadata such as the
+// sidecar meta, replaces the client's credential with the staging token and marks the request as a shadow request.
+func shadowMetadata(md metadata.MD, token string) metadata.MD {
+	out := metadata.MD{}
+	for k, v := range md {
+		// 伪头部和 grpc 保留头部由 grpc 自行设置
+		if strings.HasPrefix(k, ":") || strings.HasPrefix(k, "grpc-") || k == "content-type" || k == "user-agent" {
+			continue
+		}
+		// 客户端的凭证不发送到 staging 集群
+		if k == "authorization" {
+			continue
+		}
+		out[k] = v
+	}
+	out.Set("authorization", "Bearer "+token)
+	out.Set(shadowHeader, "true")
+	return out
+}
+
+// Close closes the connection to the staging cluster.
+func (s *Shadower) Close() error {
+	return s.conn.Close()
+}
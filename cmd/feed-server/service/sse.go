@@ -0,0 +1,236 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/google/uuid"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/release"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	pkgtypes "github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// sseHeartbeatInterval 无事件时发送心跳的间隔, 避免连接被中间代理断开
+	sseHeartbeatInterval = 15 * time.Second
+	// sseRetryMil 客户端断开后重连的等待时间
+	sseRetryMil = 5000
+)
+
+// sseSender sends the events with the server-sent events protocol.
+type sseSender struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// Send the release change event.
+func (ss *sseSender) Send(event *eventc.Event) error {
+	if event.Change == nil || event.Instance == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(&sfs.ReleaseChangeNotice{
+		BizID:       event.Instance.BizID,
+		AppID:       event.Change.AppID,
+		App:         event.Change.App,
+		ReleaseID:   event.Change.ReleaseID,
+		ReleaseName: event.Change.ReleaseName,
+		CursorID:    event.CursorID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return ss.write(fmt.Sprintf("id: %d\nevent: release\ndata: %s\n\n", event.CursorID, data))
+}
+
+// Heartbeat send a comment line to keep the connection alive.
+func (ss *sseSender) Heartbeat() error {
+	return ss.write(": heartbeat\n\n")
+}
+
+func (ss *sseSender) write(msg string) error {
+	if _, err := ss.w.Write([]byte(msg)); err != nil {
+		return err
+	}
+	ss.flusher.Flush()
+	return nil
+}
+
+// getRestCredential 获取并校验 rest 请求携带的客户端凭证, 校验失败时返回对应的错误响应
+func (s *Service) getRestCredential(kt *kit.Kit, r *http.Request) (*pkgtypes.CredentialCache, render.Renderer) {
+	authHeaderParts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(authHeaderParts) != 2 || strings.ToLower(authHeaderParts[0]) != "bearer" {
+		return nil, rest.Unauthorized(errors.New("invalid authorization header format"))
+	}
+
+	cred, err := s.bll.Auth().GetCred(kt, kt.BizID, authHeaderParts[1])
+	if err != nil {
+		return nil, rest.Unauthorized(fmt.Errorf("do authorization failed, err: %v", err))
+	}
+
+	if !cred.Enabled {
+		return nil, rest.PermissionDenied(errors.New("credential is disabled"), nil)
+	}
+
+	return cred, nil
+}
+
+// WatchSSE streams the release change events of the credential's matched apps with server-sent events,
+// it is used by the scripts and simple consumers which can not hold a grpc watch stream.
+// query parameters:
+// 1. biz_id: required, the business id;
+// 2. apps: optional, the app names separated by comma, default is all the apps matched by the credential;
+// 3. labels: optional, the instance labels in json format;
+// 4. uid: optional, the instance uid, a random one is used if not set.
+// nolint:funlen
+func (s *Service) WatchSSE(w http.ResponseWriter, r *http.Request) {
+	kt := kit.FromGrpcContext(r.Context())
+
+	query := r.URL.Query()
+	bizID, _ := strconv.Atoi(query.Get("biz_id"))
+	if bizID <= 0 {
+		render.Render(w, r, rest.BadRequest(errors.New("biz id is required")))
+		return
+	}
+	kt.BizID = uint32(bizID)
+
+	labels := make(map[string]string)
+	if l := query.Get("labels"); l != "" {
+		if err := json.Unmarshal([]byte(l), &labels); err != nil {
+			render.Render(w, r, rest.BadRequest(errors.New("invalid labels format, not in the correct json format")))
+			return
+		}
+	}
+
+	uid := query.Get("uid")
+	if uid == "" {
+		uid = "sse-" + uuid.New().String()
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		render.Render(w, r, rest.BadRequest(errors.New("streaming is not supported")))
+		return
+	}
+
+	cred, renderer := s.getRestCredential(kt, r)
+	if renderer != nil {
+		render.Render(w, r, renderer)
+		return
+	}
+
+	resp, err := s.bll.AppCache().ListApps(kt, &pbcs.ListAppsReq{BizId: kt.BizID})
+	if err != nil {
+		render.Render(w, r, rest.BadRequest(fmt.Errorf("list apps failed, err: %v", err)))
+		return
+	}
+
+	wanted := make(map[string]bool)
+	for _, name := range strings.Split(query.Get("apps"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			wanted[name] = true
+		}
+	}
+	explicit := len(wanted) > 0
+
+	subs := make([]*release.EventSubscription, 0)
+	appIDs := make([]uint32, 0)
+	for _, d := range resp.Details {
+		if explicit && !wanted[d.Spec.Name] {
+			continue
+		}
+		delete(wanted, d.Spec.Name)
+
+		// 过滤无权限的 app, 显式指定的 app 无权限时直接拒绝
+		if !cred.MatchApp(d.Spec.Name) {
+			if explicit {
+				render.Render(w, r, rest.PermissionDenied(fmt.Errorf("not have app %s permission", d.Spec.Name), nil))
+				return
+			}
+			continue
+		}
+
+		inst := &sfs.InstanceSpec{
+			BizID:      kt.BizID,
+			AppID:      d.Id,
+			App:        d.Spec.Name,
+			Uid:        uid,
+			Labels:     labels,
+			ConfigType: table.ConfigType(d.Spec.ConfigType),
+		}
+		if err := inst.Validate(); err != nil {
+			render.Render(w, r, rest.BadRequest(err))
+			return
+		}
+		// 当前版本为 0, 订阅后会先推送一次当前匹配的版本
+		subs = append(subs, &release.EventSubscription{Instance: inst})
+		appIDs = append(appIDs, d.Id)
+	}
+
+	for name := range wanted {
+		render.Render(w, r, rest.NotFound(fmt.Errorf("app %s not found", name)))
+		return
+	}
+
+	if len(subs) == 0 {
+		render.Render(w, r, rest.NotFound(errors.New("no app matched by the credential")))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// 禁用 nginx 等代理的响应缓冲
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	sender := &sseSender{w: w, flusher: flusher}
+	if err := sender.write(fmt.Sprintf("retry: %d\n\n", sseRetryMil)); err != nil {
+		return
+	}
+
+	logs.Infof("received sse watch request, biz: %d, apps: %v, uid: %s, ip: %s, rid: %s", kt.BizID, appIDs, uid,
+		r.RemoteAddr, kt.Rid)
+
+	s.consumption.watch(kt.BizID, appIDs, 1)
+	defer s.consumption.watch(kt.BizID, appIDs, -1)
+
+	err = s.bll.Release().WatchEvents(r.Context(), subs, sseHeartbeatInterval, sender)
+	if errors.Is(err, release.ErrServerShuttingDown) {
+		// 通知客户端重连到其他 feed server
+		_ = sender.write("event: bounce\ndata: {}\n\n")
+		return
+	}
+	if err != nil {
+		logs.Errorf("sse watch failed, biz: %d, uid: %s, err: %v, rid: %s", kt.BizID, uid, err, kt.Rid)
+		return
+	}
+
+	logs.Infof("finished sse watch, biz: %d, uid: %s, rid: %s", kt.BizID, uid, kt.Rid)
+}
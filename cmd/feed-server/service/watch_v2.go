@@ -0,0 +1,344 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/eventc"
+	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/release"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbfsv2 "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server/v2"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	defaultWatchBatchSize = 100
+	maxWatchBatchSize     = 1000
+	defaultBatchWindow    = 200 * time.Millisecond
+	maxBatchWindow        = 5 * time.Second
+	defaultV2Heartbeat    = 30 * time.Second
+	minV2Heartbeat        = 5 * time.Second
+)
+
+// UpstreamV2 is the v2 feed Upstream service, it is served alongside v1 on the same port.
+type UpstreamV2 struct {
+	s *Service
+}
+
+// V2 returns the v2 feed Upstream service.
+func (s *Service) V2() *UpstreamV2 {
+	return &UpstreamV2{s: s}
+}
+
+// Watch subscribes the release change events of the apps and delivers them in batches.
+// nolint:funlen
+func (u *UpstreamV2) Watch(req *pbfsv2.WatchReq, stream pbfsv2.Upstream_WatchServer) error {
+	s := u.s
+	if !sfs.IsAPIVersionMatch(req.ApiVersion) {
+		return status.Error(codes.InvalidArgument, "client's api version is too low, should be upgraded")
+	}
+	if req.BizId == 0 || len(req.Apps) == 0 {
+		return status.Error(codes.InvalidArgument, "biz id and apps are required")
+	}
+
+	kt := kit.FromGrpcContext(stream.Context())
+	kt.BizID = req.BizId
+
+	if err := s.checkQuarantine(stream.Context(), kt, req.BizId, incomingFingerprint(stream.Context())); err != nil {
+		return err
+	}
+
+	releaseLane, err := s.lanes.acquire(stream.Context(), laneOf(pbfsv2.Upstream_Watch_FullMethodName))
+	if err != nil {
+		return err
+	}
+	defer releaseLane()
+
+	cred, token, err := s.streamCredential(stream.Context(), kt, req.BizId)
+	if err != nil {
+		return credentialError(err, fmt.Sprintf("biz %d", req.BizId))
+	}
+	if !cred.Enabled {
+		return status.Error(codes.PermissionDenied, "credential is disabled")
+	}
+
+	lookup := func(app string) (uint32, *types.AppCacheMeta, error) {
+		appID, err := s.bll.AppCache().GetAppID(kt, req.BizId, app)
+		if err != nil {
+			if isNotFoundErr(err) {
+				return 0, nil, status.Errorf(codes.NotFound, "get app id failed, %s", err.Error())
+			}
+			return 0, nil, status.Errorf(codes.Aborted, "get app id failed, %s", err.Error())
+		}
+
+		meta, err := s.bll.AppCache().GetMeta(kt, req.BizId, appID)
+		if err != nil {
+			return 0, nil, status.Errorf(codes.Aborted, "get app %s meta failed, %s", app, err.Error())
+		}
+		return appID, meta, nil
+	}
+	subs, appIDs, err := watchSubscriptions(kt, req, cred, token, incomingClientVersion(stream.Context()), lookup)
+	if err != nil {
+		return err
+	}
+
+	sender := newBatchSender(stream, kt.Rid, req.Option)
+	defer sender.close()
+
+	logs.Infof("received v2 watch request, biz: %d, apps: %v, ip: %s, rid: %s", req.BizId, appIDs,
+		brpc.MustGetRealIP(stream.Context()), kt.Rid)
+
+	s.consumption.watch(req.BizId, appIDs, 1)
+	defer s.consumption.watch(req.BizId, appIDs, -1)
+
+	releaseLane()
+	err = s.bll.Release().WatchEvents(stream.Context(), subs, sender.heartbeat, sender)
+	if errors.Is(err, release.ErrServerShuttingDown) {
+		// 通知客户端重连到其他 feed server
+		return sender.bounce()
+	}
+	if err != nil {
+		logs.Errorf("v2 watch failed, biz: %d, apps: %v, err: %v, rid: %s", req.BizId, appIDs, err, kt.Rid)
+		return status.Errorf(codes.Aborted, "do watch job failed, err: %v", err)
+	}
+
+	logs.Infof("finished v2 watch, biz: %d, apps: %v, rid: %s", req.BizId, appIDs, kt.Rid)
+	return nil
+}
+
+// watchSubscriptions builds the event subscriptions of the watched apps, lookup returns the app's id and meta.
+// an archived app is skipped with a warning and does not affect the other apps of the stream, a consumption
+// paused app is still subscribed, the scheduler holds its release changes and re-broadcasts them when resumed.
+func watchSubscriptions(kt *kit.Kit, req *pbfsv2.WatchReq, cred *types.CredentialCache, token, clientVer string,
+	lookup func(app string) (uint32, *types.AppCacheMeta, error)) ([]*release.EventSubscription, []uint32, error) {
+
+	subs := make([]*release.EventSubscription, 0, len(req.Apps))
+	appIDs := make([]uint32, 0, len(req.Apps))
+	for _, one := range req.Apps {
+		if !cred.MatchApp(one.App) {
+			return nil, nil, status.Errorf(codes.PermissionDenied, "not have app %s permission", one.App)
+		}
+
+		appID, meta, err := lookup(one.App)
+		if err != nil {
+			return nil, nil, err
+		}
+		if meta.State == table.AppStateArchived {
+			logs.Warnf("app %s is archived, skip watching it, biz: %d, rid: %s", meta.Name, req.BizId, kt.Rid)
+			continue
+		}
+		if err := checkAppClientVersion(kt, meta, clientVer); err != nil {
+			return nil, nil, err
+		}
+
+		inst := &sfs.InstanceSpec{
+			BizID:           req.BizId,
+			AppID:           appID,
+			App:             one.App,
+			Uid:             one.Uid,
+			Labels:          one.Labels,
+			Match:           one.Match,
+			ConfigType:      meta.ConfigType,
+			Scopes:          cred.AppScopes(one.App),
+			Credential:      token,
+			CredentialPause: cred.Pause,
+		}
+		if err := inst.Validate(); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		subs = append(subs, &release.EventSubscription{
+			Instance:         inst,
+			CurrentReleaseID: one.CurrentReleaseId,
+			CurrentCursorID:  one.Cursor,
+		})
+		appIDs = append(appIDs, appID)
+	}
+
+	return subs, appIDs, nil
+}
+
+// batchSender batches the release change events, a batch is sent when it is full or its first event
+// waits for the batch window.
+type batchSender struct {
+	stream    pbfsv2.Upstream_WatchServer
+	rid       string
+	size      int
+	window    time.Duration
+	heartbeat time.Duration
+	metaOnly  bool
+
+	lock    sync.Mutex
+	counter int
+	pending *pbfsv2.WatchBatch
+	timer   *time.Timer
+	// lastSent 最近一次发送的时间, 期间有事件发送时跳过心跳
+	lastSent time.Time
+	// err 异步发送失败的错误, 在下次发送时返回以结束 watch
+	err error
+}
+
+func newBatchSender(stream pbfsv2.Upstream_WatchServer, rid string, opt *pbfsv2.WatchOption) *batchSender {
+	bs := &batchSender{
+		stream:    stream,
+		rid:       rid,
+		size:      defaultWatchBatchSize,
+		window:    defaultBatchWindow,
+		heartbeat: defaultV2Heartbeat,
+		lastSent:  time.Now(),
+	}
+	if opt == nil {
+		return bs
+	}
+
+	if opt.MaxBatchSize > 0 {
+		bs.size = min(int(opt.MaxBatchSize), maxWatchBatchSize)
+	}
+	if opt.BatchWindowMs > 0 {
+		bs.window = min(time.Duration(opt.BatchWindowMs)*time.Millisecond, maxBatchWindow)
+	}
+	if opt.HeartbeatSeconds > 0 {
+		bs.heartbeat = max(time.Duration(opt.HeartbeatSeconds)*time.Second, minV2Heartbeat)
+	}
+	bs.metaOnly = opt.MetaOnly
+	return bs
+}
+
+// Send adds the event to the pending batch.
+func (bs *batchSender) Send(event *eventc.Event) error {
+	if event.Change == nil || event.Instance == nil {
+		return nil
+	}
+
+	one := &pbfsv2.ReleaseEvent{
+		BizId:       event.Instance.BizID,
+		AppId:       event.Change.AppID,
+		App:         event.Change.App,
+		Uid:         event.Instance.Uid,
+		ReleaseId:   event.Change.ReleaseID,
+		ReleaseName: event.Change.ReleaseName,
+		Cursor:      event.CursorID,
+	}
+	if !bs.metaOnly {
+		payload, err := (&sfs.ReleaseChangePayload{ReleaseMeta: event.Change, Instance: event.Instance,
+			CursorID: event.CursorID}).Encode()
+		if err != nil {
+			return fmt.Errorf("encode release change payload failed, err: %v", err)
+		}
+		one.Payload = payload
+	}
+
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+
+	if bs.err != nil {
+		return bs.err
+	}
+
+	if bs.pending == nil {
+		bs.pending = &pbfsv2.WatchBatch{Type: pbfsv2.BatchType_EVENTS, Cursors: make(map[uint32]uint32)}
+		bs.timer = time.AfterFunc(bs.window, bs.flushPending)
+	}
+	bs.pending.Events = append(bs.pending.Events, one)
+	if one.Cursor > bs.pending.Cursors[one.AppId] {
+		bs.pending.Cursors[one.AppId] = one.Cursor
+	}
+
+	if len(bs.pending.Events) >= bs.size {
+		bs.timer.Stop()
+		return bs.flushLocked()
+	}
+	return nil
+}
+
+// Heartbeat sends a heartbeat batch if there is no batch sent recently.
+func (bs *batchSender) Heartbeat() error {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+
+	if bs.err != nil {
+		return bs.err
+	}
+	if time.Since(bs.lastSent) < bs.heartbeat/2 {
+		return nil
+	}
+	return bs.sendLocked(&pbfsv2.WatchBatch{Type: pbfsv2.BatchType_HEARTBEAT})
+}
+
+// bounce sends the pending events and then the bounce batch.
+func (bs *batchSender) bounce() error {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+
+	if bs.pending != nil {
+		bs.timer.Stop()
+		if err := bs.flushLocked(); err != nil {
+			return err
+		}
+	}
+	return bs.sendLocked(&pbfsv2.WatchBatch{Type: pbfsv2.BatchType_BOUNCE})
+}
+
+// close stops the pending batch's timer, the stream is not usable after the handler returns.
+func (bs *batchSender) close() {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+
+	if bs.timer != nil {
+		bs.timer.Stop()
+	}
+	bs.pending = nil
+	if bs.err == nil {
+		bs.err = errors.New("watch stream is closed")
+	}
+}
+
+func (bs *batchSender) flushPending() {
+	bs.lock.Lock()
+	defer bs.lock.Unlock()
+
+	if bs.pending == nil || bs.err != nil {
+		return
+	}
+	if err := bs.flushLocked(); err != nil {
+		logs.Errorf("send v2 watch batch failed, err: %v, rid: %s", err, bs.rid)
+	}
+}
+
+func (bs *batchSender) flushLocked() error {
+	batch := bs.pending
+	bs.pending = nil
+	return bs.sendLocked(batch)
+}
+
+func (bs *batchSender) sendLocked(batch *pbfsv2.WatchBatch) error {
+	bs.counter++
+	batch.Rid = fmt.Sprintf("%s-fd-%d", bs.rid, bs.counter)
+	if err := bs.stream.Send(batch); err != nil {
+		bs.err = err
+		return err
+	}
+	bs.lastSent = time.Now()
+	return nil
+}
@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	pbfsv2 "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server/v2"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+func TestWatchSubscriptionsMixedApps(t *testing.T) {
+	metas := map[string]*types.AppCacheMeta{
+		"normal":   {Name: "normal", ConfigType: table.File, State: table.AppStateActive},
+		"archived": {Name: "archived", ConfigType: table.File, State: table.AppStateArchived},
+		"paused": {Name: "paused", ConfigType: table.KV, State: table.AppStateActive,
+			Pause: &table.ConsumptionPause{Mode: table.PauseModeHoldResponse}},
+	}
+	ids := map[string]uint32{"normal": 1, "archived": 2, "paused": 3}
+	lookup := func(app string) (uint32, *types.AppCacheMeta, error) {
+		return ids[app], metas[app], nil
+	}
+
+	req := &pbfsv2.WatchReq{BizId: 2, Apps: []*pbfsv2.WatchApp{
+		{App: "normal", Uid: "uid"}, {App: "archived", Uid: "uid"}, {App: "paused", Uid: "uid"},
+	}}
+	cred := &types.CredentialCache{Enabled: true, Scope: []string{"normal/**", "archived/**", "paused/**"}}
+
+	subs, appIDs, err := watchSubscriptions(kit.New(), req, cred, "token", "", lookup)
+	if err != nil {
+		t.Fatalf("an archived or paused app should not fail the whole stream, err: %v", err)
+	}
+
+	// 归档的服务被跳过, 暂停的服务仍然订阅, 由 scheduler 暂停推送
+	if len(subs) != 2 || len(appIDs) != 2 || appIDs[0] != 1 || appIDs[1] != 3 {
+		t.Fatalf("only the normal and paused apps should be subscribed, got apps: %v", appIDs)
+	}
+	if subs[1].Instance.App != "paused" || subs[1].Instance.ConfigType != table.KV ||
+		subs[1].Instance.Credential != "token" {
+		t.Errorf("unexpected subscription of the paused app: %+v", subs[1].Instance)
+	}
+
+	// 请求无权限的服务时仍然拒绝整个订阅
+	cred = &types.CredentialCache{Enabled: true, Scope: []string{"normal/**"}}
+	if _, _, err := watchSubscriptions(kit.New(), req, cred, "token", "", lookup); err == nil {
+		t.Errorf("app without permission should be rejected")
+	}
+}
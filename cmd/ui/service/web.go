@@ -198,6 +198,9 @@ func (s *WebServer) subRouter() http.Handler {
 	r.With(metrics.RequestCollect("no_permission")).Get("/403.html",
 		s.embedWebServer.Render403Handler(conf).ServeHTTP)
 
+	// 独立部署(OIDC/LDAP)登入页面, 蓝鲸统一登入不会注册路由
+	r.Mount("/login", s.authorizer.LoginHandler())
+
 	// vue 模版渲染
 	r.With(metrics.RequestCollect("index"),
 		s.webAuthentication).Get("/", s.embedWebServer.RenderIndexHandler(conf).ServeHTTP)
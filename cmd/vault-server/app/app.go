@@ -81,6 +81,11 @@ func (as *vaultService) prepare(opt *options.Option) error {
 
 	logs.Infof("load settings from config file success.")
 
+	// 监听配置文件变更, 热加载可重载的配置项
+	if err := cc.WatchSettings(); err != nil {
+		logs.Errorf("watch settings failed, config hot reload is disabled, err: %v", err)
+	}
+
 	// init metrics
 	metrics.InitMetrics(net.JoinHostPort(cc.VaultServer().Network.BindIP,
 		strconv.Itoa(int(cc.VaultServer().Network.RpcPort))))
@@ -119,6 +124,7 @@ func (as *vaultService) listenAndServe() error {
 	opts := []grpc.ServerOption{grpc.MaxRecvMsgSize(math.MaxInt32),
 		// add bscp unary interceptor and standard grpc server metrics interceptor.
 		grpc.ChainUnaryInterceptor(
+			brpc.RequestIDUnaryServerInterceptor(),
 			brpc.LogUnaryServerInterceptor(),
 			grpcMetrics.UnaryServerInterceptor(),
 			grpc_recovery.UnaryServerInterceptor(recoveryOpt),
@@ -19,6 +19,8 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/handler"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -50,6 +52,8 @@ func (g *gateway) handler() http.Handler {
 	r.Get("/-/healthy", g.HealthyHandler)
 	r.Get("/-/ready", g.ReadyHandler)
 	r.Get("/healthz", g.Healthz)
+	r.Get("/-/buildinfo", handler.BuildInfoHandler)
+	r.Get("/-/config", ctl.Auth(http.HandlerFunc(handler.ConfigHandler)).ServeHTTP)
 
 	return r
 }
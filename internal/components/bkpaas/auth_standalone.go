@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bkpaas
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+const (
+	// StandaloneTokenCookie OIDC/LDAP 登入后的会话 cookie
+	StandaloneTokenCookie = "bscp_token"
+	sessionIssuer         = "bscp"
+)
+
+// standaloneAuthClient 独立部署登入(OIDC/LDAP), 会话由 auth-server 签发
+type standaloneAuthClient struct {
+	conf *cc.LoginAuthSettings
+}
+
+// GetLoginCredentialFromCookies 从 cookie 获取 LoginCredential
+func (s *standaloneAuthClient) GetLoginCredentialFromCookies(r *http.Request) (*LoginCredential, error) {
+	token, err := r.Cookie(StandaloneTokenCookie)
+	if err != nil {
+		if errors.Is(err, http.ErrNoCookie) {
+			return nil, fmt.Errorf("%s cookie not present", StandaloneTokenCookie)
+		}
+		return nil, err
+	}
+
+	return &LoginCredential{UID: "", Token: token.Value}, nil
+}
+
+// GetUserInfoByToken 校验会话签名, 只在 auth-server 中调用
+func (s *standaloneAuthClient) GetUserInfoByToken(_ context.Context, _, _, token string) (string, error) {
+	return ParseSessionToken(s.conf.Session.Secret, token)
+}
+
+// BuildLoginRedirectURL 登入跳转URL
+func (s *standaloneAuthClient) BuildLoginRedirectURL(r *http.Request, webHost string) string {
+	return fmt.Sprintf("%s/login?c_url=%s", s.conf.Host, url.QueryEscape(buildAbsoluteUri(webHost, r)))
+}
+
+// BuildLoginURL API未登入访问URL
+func (s *standaloneAuthClient) BuildLoginURL(r *http.Request) (string, string) {
+	loginURL := fmt.Sprintf("%s/login?c_url=", s.conf.Host)
+	return loginURL, loginURL
+}
+
+// IssueSessionToken 签发会话 token, 返回 token 及过期时间
+func IssueSessionToken(secret, username string, ttl time.Duration) (string, time.Time, error) {
+	if secret == "" {
+		return "", time.Time{}, errors.New("session secret is not set")
+	}
+
+	now := time.Now()
+	expiredAt := now.Add(ttl)
+	claims := jwt.RegisteredClaims{
+		Issuer:    sessionIssuer,
+		Subject:   username,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiredAt),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiredAt, nil
+}
+
+// ParseSessionToken 校验会话 token, 返回用户名
+func ParseSessionToken(secret, token string) (string, error) {
+	if secret == "" {
+		return "", errors.New("session secret is not set")
+	}
+
+	claims := new(jwt.RegisteredClaims)
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "parse session token")
+	}
+
+	if claims.Issuer != sessionIssuer || claims.Subject == "" {
+		return "", errors.New("invalid session token")
+	}
+
+	return claims.Subject, nil
+}
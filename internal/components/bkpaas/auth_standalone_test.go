@@ -0,0 +1,41 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bkpaas
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionToken(t *testing.T) {
+	secret := "0123456789abcdef0123456789abcdef"
+	token, expiredAt, err := IssueSessionToken(secret, "alice", time.Hour)
+	assert.NoError(t, err)
+	assert.True(t, expiredAt.After(time.Now()))
+
+	username, err := ParseSessionToken(secret, token)
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", username)
+
+	// 密钥不一致
+	_, err = ParseSessionToken("another-secret-another-secret-xx", token)
+	assert.Error(t, err)
+
+	// 已过期
+	token, _, err = IssueSessionToken(secret, "alice", -time.Minute)
+	assert.NoError(t, err)
+	_, err = ParseSessionToken(secret, token)
+	assert.Error(t, err)
+}
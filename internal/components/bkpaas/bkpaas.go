@@ -44,10 +44,14 @@ type AuthLoginClient interface {
 
 // NewAuthLoginClient init client
 func NewAuthLoginClient(conf *cc.LoginAuthSettings) AuthLoginClient {
-	if conf.Provider == BKLoginProvider {
+	switch conf.Provider {
+	case BKLoginProvider:
 		return &bkLoginAuthClient{conf: conf}
+	case cc.OIDCLoginProvider, cc.LDAPLoginProvider:
+		return &standaloneAuthClient{conf: conf}
+	default:
+		return &bkPaaSAuthClient{conf: conf}
 	}
-	return &bkPaaSAuthClient{conf: conf}
 }
 
 // BuildAbsoluteUri
@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package changeticket validates the change tickets referenced by the publishes.
+package changeticket
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components/itsm"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// Ticket is the validated change ticket.
+type Ticket struct {
+	ID     string
+	Title  string
+	Status string
+	URL    string
+}
+
+// Validator validates the change ticket referenced by a publish of the biz.
+type Validator interface {
+	Validate(ctx context.Context, bizID uint32, ticketID string) (*Ticket, error)
+}
+
+// NewValidator create the change ticket validator.
+func NewValidator(conf cc.ChangeTicket) Validator {
+	switch conf.Validator {
+	case cc.ITSMChangeTicketValidator:
+		return &itsmValidator{allowed: conf.AllowedStatus, list: itsm.ListTickets}
+	default:
+		return new(noneValidator)
+	}
+}
+
+// noneValidator only records the change ticket without validation.
+type noneValidator struct{}
+
+// Validate returns the ticket as is.
+func (v *noneValidator) Validate(_ context.Context, _ uint32, ticketID string) (*Ticket, error) {
+	return &Ticket{ID: ticketID}, nil
+}
+
+// itsmValidator validates the change ticket in BlueKing ITSM, the ticket id is the ticket's sn.
+type itsmValidator struct {
+	allowed []string
+	list    func(ctx context.Context, snList []string) ([]itsm.TicketsItem, error)
+}
+
+// Validate the ticket exists, belongs to the biz and its status is allowed to publish.
+func (v *itsmValidator) Validate(ctx context.Context, bizID uint32, ticketID string) (*Ticket, error) {
+	items, err := v.list(ctx, []string{ticketID})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, one := range items {
+		if one.SN != ticketID {
+			continue
+		}
+		// 部分单据不关联业务，只校验关联了业务的单据
+		if one.BkBizID != 0 && uint32(one.BkBizID) != bizID {
+			return nil, fmt.Errorf("ticket %s belongs to biz %d", ticketID, one.BkBizID)
+		}
+		if !slices.Contains(v.allowed, one.CurrentStatus) {
+			return nil, fmt.Errorf("ticket %s status %s is not allowed to publish", ticketID, one.CurrentStatus)
+		}
+		return &Ticket{ID: one.SN, Title: one.Title, Status: one.CurrentStatus, URL: one.TicketURL}, nil
+	}
+
+	return nil, fmt.Errorf("ticket %s not found", ticketID)
+}
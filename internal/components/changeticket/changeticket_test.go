@@ -0,0 +1,51 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package changeticket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components/itsm"
+)
+
+func TestITSMValidator(t *testing.T) {
+	v := &itsmValidator{
+		allowed: []string{"RUNNING", "FINISHED"},
+		list: func(_ context.Context, snList []string) ([]itsm.TicketsItem, error) {
+			return []itsm.TicketsItem{
+				{SN: "NO1", BkBizID: 2, CurrentStatus: "RUNNING", TicketURL: "http://itsm/NO1"},
+				{SN: "NO2", BkBizID: 0, CurrentStatus: "FINISHED"},
+				{SN: "NO3", BkBizID: 2, CurrentStatus: "TERMINATED"},
+			}, nil
+		},
+	}
+
+	ticket, err := v.Validate(context.Background(), 2, "NO1")
+	assert.NoError(t, err)
+	assert.Equal(t, "http://itsm/NO1", ticket.URL)
+
+	_, err = v.Validate(context.Background(), 3, "NO1")
+	assert.ErrorContains(t, err, "belongs to biz")
+
+	_, err = v.Validate(context.Background(), 3, "NO2")
+	assert.NoError(t, err)
+
+	_, err = v.Validate(context.Background(), 2, "NO3")
+	assert.ErrorContains(t, err, "not allowed")
+
+	_, err = v.Validate(context.Background(), 2, "NO4")
+	assert.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package ldap authenticates the login users by the ldap simple bind.
+package ldap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	goldap "github.com/go-ldap/ldap/v3"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
+)
+
+// ErrInvalidCredentials 用户名或密码错误
+var ErrInvalidCredentials = errors.New("ldap invalid credentials")
+
+// defaultTimeout 未配置时的连接及读写超时
+const defaultTimeout = 10 * time.Second
+
+// Authenticate 使用用户名密码做 simple bind 校验
+func Authenticate(ctx context.Context, conf *cc.LDAPAuth, username, password string) error {
+	// 空密码在 ldap 中是匿名绑定, 会直接成功, 必须拒绝
+	if username == "" || password == "" {
+		return ErrInvalidCredentials
+	}
+
+	timeout := time.Duration(conf.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if d, ok := ctx.Deadline(); ok && time.Until(d) < timeout {
+		timeout = time.Until(d)
+	}
+
+	conn, err := dial(conf, timeout)
+	if err != nil {
+		return fmt.Errorf("dial ldap %s failed, err: %v", conf.Addr, err)
+	}
+	defer conn.Close()
+	conn.SetTimeout(timeout)
+
+	dn := fmt.Sprintf(conf.UserDN, goldap.EscapeDN(username))
+	if err := conn.Bind(dn, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return ErrInvalidCredentials
+		}
+		return fmt.Errorf("ldap bind failed, err: %v", err)
+	}
+
+	return nil
+}
+
+func dial(conf *cc.LDAPAuth, timeout time.Duration) (*goldap.Conn, error) {
+	opts := []goldap.DialOpt{goldap.DialWithDialer(&net.Dialer{Timeout: timeout})}
+	if !conf.UseTLS {
+		return goldap.DialURL("ldap://"+conf.Addr, opts...)
+	}
+
+	tlsConf, err := tools.ClientTLSConfVerify(conf.TLS.InsecureSkipVerify, conf.TLS.CAFile, conf.TLS.CertFile,
+		conf.TLS.KeyFile, conf.TLS.Password)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConf.ServerName == "" {
+		tlsConf.ServerName, _, _ = net.SplitHostPort(conf.Addr)
+	}
+
+	return goldap.DialURL("ldaps://"+conf.Addr, append(opts, goldap.DialWithTLSConfig(tlsConf))...)
+}
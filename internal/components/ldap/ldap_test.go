@@ -0,0 +1,80 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ldap
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// fakeServer 校验 bind 请求并返回对应的结果码
+func fakeServer(t *testing.T, ln net.Listener, wantDN, wantPwd string) {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+			req, err := ber.ReadPacket(conn)
+			if !assert.NoError(t, err) || !assert.Len(t, req.Children, 2) {
+				return
+			}
+			bind := req.Children[1]
+			dn, _ := bind.Children[1].Value.(string)
+			pwd := string(bind.Children[2].Data.Bytes())
+
+			code := int64(goldap.LDAPResultInvalidCredentials)
+			if dn == wantDN && pwd == wantPwd {
+				code = goldap.LDAPResultSuccess
+			}
+
+			resp := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+			resp.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger,
+				req.Children[0].Value, "MessageID"))
+			op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, goldap.ApplicationBindResponse, nil,
+				"Bind Response")
+			op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code,
+				"resultCode"))
+			op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+			op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "",
+				"diagnosticMessage"))
+			resp.AppendChild(op)
+			_, _ = conn.Write(resp.Bytes())
+			// 等待客户端关闭连接
+			_, _ = ber.ReadPacket(conn)
+		}()
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer ln.Close()
+	go fakeServer(t, ln, `uid=a\,b,ou=people,dc=example,dc=com`, "secret")
+
+	conf := &cc.LDAPAuth{Addr: ln.Addr().String(), UserDN: "uid=%s,ou=people,dc=example,dc=com", TimeoutSeconds: 3}
+	assert.NoError(t, Authenticate(context.Background(), conf, "a,b", "secret"))
+	assert.ErrorIs(t, Authenticate(context.Background(), conf, "a,b", "wrong"), ErrInvalidCredentials)
+	assert.ErrorIs(t, Authenticate(context.Background(), conf, "a,b", ""), ErrInvalidCredentials)
+}
@@ -0,0 +1,146 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package notifier sends the publish and approval event messages to the chatops notification channels,
+// such as wecom group bot, slack incoming webhook and email.
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// Payload is the data of the event message, which can be referenced in the custom template, e.g. {{.AppName}}.
+type Payload struct {
+	Event       table.NotificationEvent
+	BizID       uint32
+	AppID       uint32
+	AppName     string
+	ReleaseID   uint32
+	ReleaseName string
+	// Scope 上线范围, 全量上线时为 "全部实例", 否则为分组名称
+	Scope    string
+	Operator string
+	// Approvers 待审批的审批人, 仅审批事件有值
+	Approvers string
+	// Reason 驳回或失败原因, 仅失败事件有值
+	Reason         string
+	Memo           string
+	ChangeTicketID string
+	Time           string
+}
+
+// Message is the rendered event message.
+type Message struct {
+	Subject string
+	Content string
+}
+
+var subjects = map[table.NotificationEvent]string{
+	table.PublishStarted:    "[BSCP] 服务 %s 开始上线版本 %s",
+	table.PublishSucceeded:  "[BSCP] 服务 %s 版本 %s 上线成功",
+	table.PublishFailed:     "[BSCP] 服务 %s 版本 %s 上线失败",
+	table.ApprovalRequested: "[BSCP] 服务 %s 版本 %s 上线待审批",
+}
+
+// defaultTemplate is the message content template used when the channel does not set its own.
+const defaultTemplate = `业务: {{.BizID}}
+服务: {{.AppName}}
+版本: {{.ReleaseName}}
+上线范围: {{.Scope}}
+操作人: {{.Operator}}
+{{- if .Approvers}}
+审批人: {{.Approvers}}
+{{- end}}
+{{- if .Reason}}
+原因: {{.Reason}}
+{{- end}}
+{{- if .ChangeTicketID}}
+变更单: {{.ChangeTicketID}}
+{{- end}}
+{{- if .Memo}}
+版本描述: {{.Memo}}
+{{- end}}
+时间: {{.Time}}`
+
+// Render the event message with the channel's template, the default template is used if tmpl is empty.
+func Render(tmpl string, p *Payload) (*Message, error) {
+	subject, ok := subjects[p.Event]
+	if !ok {
+		return nil, fmt.Errorf("unsupported notification event: %s", p.Event)
+	}
+
+	if tmpl == "" {
+		tmpl = defaultTemplate
+	}
+
+	t, err := template.New(string(p.Event)).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("parse notification template failed, err: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, p); err != nil {
+		return nil, fmt.Errorf("render notification template failed, err: %v", err)
+	}
+
+	return &Message{Subject: fmt.Sprintf(subject, p.AppName, p.ReleaseName), Content: buf.String()}, nil
+}
+
+// Notifier sends the event messages to the notification channels.
+type Notifier struct {
+	conf cc.Notification
+}
+
+// New create a notifier.
+func New(conf cc.Notification) *Notifier {
+	return &Notifier{conf: conf}
+}
+
+// Send renders the event message with the channel's template and sends it to the channel.
+func (n *Notifier) Send(ctx context.Context, channel *table.NotificationChannelSpec, p *Payload) error {
+	if p.Time == "" {
+		cp := *p
+		cp.Time = time.Now().Format(time.DateTime)
+		p = &cp
+	}
+
+	msg, err := Render(channel.Template, p)
+	if err != nil {
+		return err
+	}
+
+	return n.SendMessage(ctx, channel, msg)
+}
+
+// SendMessage sends the rendered message to the channel, the channel's template is not used.
+func (n *Notifier) SendMessage(ctx context.Context, channel *table.NotificationChannelSpec, msg *Message) error {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(n.conf.TimeoutSec)*time.Second)
+	defer cancel()
+
+	switch channel.Type {
+	case table.NotificationWeCom:
+		return sendWeCom(ctx, channel.Target, msg)
+	case table.NotificationSlack:
+		return sendSlack(ctx, channel.Target, msg)
+	case table.NotificationEmail:
+		return sendEmail(ctx, n.conf.SMTP, channel.Recipients(), msg)
+	default:
+		return fmt.Errorf("unsupported notification channel type: %s", channel.Type)
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestRender(t *testing.T) {
+	p := &Payload{Event: table.PublishFailed, BizID: 2, AppName: "demo", ReleaseName: "v1", Scope: "全部实例",
+		Operator: "admin", Reason: "rejected", Time: "2025-07-10 10:00:00"}
+	msg, err := Render("", p)
+	if err != nil {
+		t.Fatalf("render default template failed, err: %v", err)
+	}
+	if msg.Subject != "[BSCP] 服务 demo 版本 v1 上线失败" {
+		t.Errorf("unexpected subject: %s", msg.Subject)
+	}
+	if !strings.Contains(msg.Content, "原因: rejected") || strings.Contains(msg.Content, "审批人") {
+		t.Errorf("unexpected content: %s", msg.Content)
+	}
+
+	msg, err = Render("{{.AppName}} {{.Event}} by {{.Operator}}", p)
+	if err != nil {
+		t.Fatalf("render custom template failed, err: %v", err)
+	}
+	if msg.Content != "demo publish_failed by admin" {
+		t.Errorf("unexpected content: %s", msg.Content)
+	}
+}
+
+func TestSendWeCom(t *testing.T) {
+	var content string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := struct {
+			Markdown struct {
+				Content string `json:"content"`
+			} `json:"markdown"`
+		}{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		content = body.Markdown.Content
+		if strings.HasSuffix(r.URL.RawQuery, "invalid") {
+			_, _ = w.Write([]byte(`{"errcode":93000,"errmsg":"invalid webhook url"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer srv.Close()
+
+	n := New(cc.Notification{TimeoutSec: 3})
+	channel := &table.NotificationChannelSpec{Type: table.NotificationWeCom, Target: srv.URL + "?key=valid"}
+	p := &Payload{Event: table.ApprovalRequested, AppName: "demo", ReleaseName: "v1", Approvers: "alice"}
+	if err := n.Send(context.Background(), channel, p); err != nil {
+		t.Fatalf("send wecom message failed, err: %v", err)
+	}
+	if !strings.HasPrefix(content, "**[BSCP] 服务 demo 版本 v1 上线待审批**") {
+		t.Errorf("unexpected wecom content: %s", content)
+	}
+
+	channel.Target = srv.URL + "?key=invalid"
+	if err := n.Send(context.Background(), channel, p); err == nil {
+		t.Errorf("wecom error code should be returned as error")
+	}
+}
@@ -0,0 +1,188 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// webhookClient do not use the components client, the webhook url contains the secret key of the bot,
+// which should not be printed in the request logs.
+var webhookClient = &http.Client{}
+
+// sendWeCom sends markdown message by wecom group bot webhook.
+func sendWeCom(ctx context.Context, webhook string, msg *Message) error {
+	body := map[string]interface{}{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"content": fmt.Sprintf("**%s**\n%s", msg.Subject, msg.Content),
+		},
+	}
+
+	resp := struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}{}
+	raw, err := postJSON(ctx, webhook, body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(raw, &resp); err != nil {
+		return fmt.Errorf("unmarshal wecom response failed, err: %v", err)
+	}
+
+	if resp.ErrCode != 0 {
+		return fmt.Errorf("send wecom message failed, code: %d, msg: %s", resp.ErrCode, resp.ErrMsg)
+	}
+
+	return nil
+}
+
+// sendSlack sends message by slack incoming webhook.
+func sendSlack(ctx context.Context, webhook string, msg *Message) error {
+	body := map[string]string{
+		"text": fmt.Sprintf("*%s*\n%s", msg.Subject, msg.Content),
+	}
+
+	_, err := postJSON(ctx, webhook, body)
+	return err
+}
+
+func postJSON(ctx context.Context, webhook string, body interface{}) ([]byte, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook, bytes.NewReader(payload))
+	if err != nil {
+		return nil, errors.New("invalid webhook url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		// 错误信息中包含 webhook 地址, 不能直接返回
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, fmt.Errorf("request webhook failed, err: %v", err)
+	}
+	defer resp.Body.Close()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook response status %d, body: %s", resp.StatusCode, raw)
+	}
+
+	return raw, nil
+}
+
+// sendEmail sends the message to the recipients by the smtp server.
+func sendEmail(ctx context.Context, conf cc.SMTP, recipients []string, msg *Message) error {
+	if !conf.Enabled() {
+		return errors.New("smtp server is not configured")
+	}
+
+	if len(recipients) == 0 {
+		return errors.New("email recipients are not set")
+	}
+
+	addr := net.JoinHostPort(conf.Host, strconv.Itoa(int(conf.Port)))
+	tlsConf := &tls.Config{ServerName: conf.Host, MinVersion: tls.VersionTLS12}
+	var conn net.Conn
+	var err error
+	if conf.TLS {
+		conn, err = (&tls.Dialer{Config: tlsConf}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = new(net.Dialer).DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return fmt.Errorf("connect smtp server failed, err: %v", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	c, err := smtp.NewClient(conn, conf.Host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if !conf.TLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(tlsConf); err != nil {
+				return err
+			}
+		}
+	}
+
+	if conf.Username != "" {
+		if ok, _ := c.Extension("AUTH"); ok {
+			if err := c.Auth(smtp.PlainAuth("", conf.Username, conf.Password, conf.Host)); err != nil {
+				return fmt.Errorf("smtp auth failed, err: %v", err)
+			}
+		}
+	}
+
+	if err := c.Mail(conf.From); err != nil {
+		return err
+	}
+	for _, rcpt := range recipients {
+		if err := c.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+
+	header := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=UTF-8\r\nContent-Transfer-Encoding: 8bit\r\n\r\n",
+		conf.From, strings.Join(recipients, ", "), mime.BEncoding.Encode("UTF-8", msg.Subject))
+	content := strings.ReplaceAll(msg.Content, "\n", "\r\n")
+	if _, err := io.WriteString(w, header+content+"\r\n"); err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
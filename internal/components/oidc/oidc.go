@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package oidc provides the oidc authorization code flow client for standalone login.
+package oidc
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+const (
+	timeout = 10 * time.Second
+	// 响应体上限
+	maxBodyLen = 1 << 20
+)
+
+// client 不复用 components 的全局 client, 避免请求/响应中的密钥和 token 被打印到日志
+var client = &http.Client{
+	Timeout:   timeout,
+	Transport: &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12}},
+}
+
+type tokenResult struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	Error       string `json:"error"`
+	ErrorDesc   string `json:"error_description"`
+}
+
+// AuthCodeURL 授权地址, 不包含 state, 由调用方追加
+func AuthCodeURL(conf *cc.OIDCAuth) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", conf.ClientID)
+	v.Set("redirect_uri", conf.RedirectURL)
+	v.Set("scope", strings.Join(conf.Scopes, " "))
+
+	sep := "?"
+	if strings.Contains(conf.AuthURL, "?") {
+		sep = "&"
+	}
+	return conf.AuthURL + sep + v.Encode()
+}
+
+// UsernameFromCode 使用授权码换取 access token, 再从用户信息中获取用户名
+func UsernameFromCode(ctx context.Context, conf *cc.OIDCAuth, code string) (string, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", conf.RedirectURL)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, conf.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	// client_secret_basic
+	req.SetBasicAuth(url.QueryEscape(conf.ClientID), url.QueryEscape(conf.ClientSecret))
+
+	token := new(tokenResult)
+	status, err := doJSON(req, token)
+	if err != nil {
+		return "", errors.Wrap(err, "exchange oidc token")
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return "", errors.Errorf("exchange oidc token failed, http code %d, error: %s %s", status,
+			token.Error, token.ErrorDesc)
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, conf.UserInfoURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token.AccessToken)
+
+	claims := make(map[string]interface{})
+	status, err = doJSON(req, &claims)
+	if err != nil {
+		return "", errors.Wrap(err, "get oidc userinfo")
+	}
+	if status != http.StatusOK {
+		return "", errors.Errorf("get oidc userinfo failed, http code %d", status)
+	}
+
+	return usernameFromClaims(claims, conf.UsernameClaim)
+}
+
+// usernameFromClaims 只使用配置的字段作为用户名, 不回退到 sub, 避免 sub 与其他用户的用户名冲突
+func usernameFromClaims(claims map[string]interface{}, claim string) (string, error) {
+	if v, ok := claims[claim].(string); ok && v != "" {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("oidc userinfo has no %s claim", claim)
+}
+
+// doJSON 发送请求并解析 json 响应, 返回 http 状态码
+func doJSON(req *http.Request, v interface{}) (int, error) {
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyLen))
+	if err != nil {
+		return resp.StatusCode, err
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return resp.StatusCode, fmt.Errorf("unmarshal response failed, http code %d, err: %v", resp.StatusCode, err)
+	}
+
+	return resp.StatusCode, nil
+}
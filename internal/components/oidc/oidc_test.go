@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+func TestUsernameFromCode(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if id != "bscp" || secret != "s3cret" || r.PostFormValue("code") != "c1" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"at","token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer at" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"sub":"u-1","preferred_username":"alice"}`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	conf := &cc.OIDCAuth{
+		AuthURL:       srv.URL + "/authorize",
+		TokenURL:      srv.URL + "/token",
+		UserInfoURL:   srv.URL + "/userinfo",
+		ClientID:      "bscp",
+		ClientSecret:  "s3cret",
+		RedirectURL:   "http://bscp.example.com/login/callback",
+		Scopes:        []string{"openid", "profile"},
+		UsernameClaim: "preferred_username",
+	}
+
+	username, err := UsernameFromCode(context.Background(), conf, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, "alice", username)
+
+	_, err = UsernameFromCode(context.Background(), conf, "bad")
+	assert.Error(t, err)
+
+	// 缺少配置的字段时不回退到 sub
+	conf.UsernameClaim = "email"
+	_, err = UsernameFromCode(context.Background(), conf, "c1")
+	assert.Error(t, err)
+
+	assert.Contains(t, AuthCodeURL(conf), "response_type=code")
+}
@@ -18,4 +18,6 @@ const (
 	YamlFormat = "yaml"
 	// JsonFormat Define a json format
 	JsonFormat = "json"
+	// CsvFormat Define a csv format
+	CsvFormat = "csv"
 )
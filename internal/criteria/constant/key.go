@@ -36,6 +36,9 @@ const (
 	// OperateWayKey is approve operate way header key.
 	OperateWayKey = "X-Bscp-Operate-Way"
 
+	// IfMatchKey is the expected revision header key of the resource to be updated, used for optimistic locking.
+	IfMatchKey = "If-Match"
+
 	// Space
 	SpaceIDKey     = "X-Bkapi-Space-Id"
 	SpaceTypeIDKey = "X-Bkapi-Space-Type-Id"
@@ -234,6 +237,40 @@ const (
 	GroupName = "group_name: %s"
 	// HookRevisionName 脚本版本名称
 	HookRevisionName = "hook_revision_name: %s"
+	// HookTemplateName 脚本模板名称
+	HookTemplateName = "hook_template_name: %s"
+	// EmergencyPublishName 紧急发布的版本名称
+	EmergencyPublishName = "emergency_publish_release_name: %s"
+	// EnvironmentName 环境名称
+	EnvironmentName = "environment_name: %s"
+	// EnvAppBindingName 环境绑定的服务标识
+	EnvAppBindingName = "env_app_key: %s"
+	// ConfigShareName 跨业务共享配置名称
+	ConfigShareName = "config_share_name: %s"
+	// AppSnapshotName 服务快照名称
+	AppSnapshotName = "app_snapshot_name: %s"
+	// ConfigReferenceName 配置引用关系，格式为 源类型/源ID -> 目标类型/目标ID
+	ConfigReferenceName = "config_reference: %s/%d -> %s/%d"
+	// KvOverrideName kv 覆盖值，格式为 key/分组ID
+	KvOverrideName = "kv_override: %s/%d"
+	// CompliancePolicyName 合规策略名称
+	CompliancePolicyName = "compliance_policy_name: %s"
+	// ServiceAccountName 服务账号名称
+	ServiceAccountName = "service_account_name: %s"
+	// AppRoleBindingName 服务角色授权，格式为 用户/角色
+	AppRoleBindingName = "app_role_binding: %s/%s"
+	// NotificationChannelName 通知渠道名称
+	NotificationChannelName = "notification_channel_name: %s"
+	// AlertRuleName 告警规则名称
+	AlertRuleName = "alert_rule_name: %s"
+	// ClientQuarantineName 客户端隔离名单
+	ClientQuarantineName = "client_quarantine: %s/%s"
+	// ExperimentName 配置实验
+	ExperimentName = "experiment_name: %s"
+	// MaskingProfileAppID 脱敏规则所属服务
+	MaskingProfileAppID = "masking_profile_app_id: %d"
+	// SecretScanSettingBizID 凭证泄露扫描设置所属业务
+	SecretScanSettingBizID = "secret_scan_setting_biz_id: %d"
 	// TemplateSpaceName 模版空间名称
 	TemplateSpaceName = "template_space_name: %s"
 	// TemplateSetName 模版套餐名称
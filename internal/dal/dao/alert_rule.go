@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// AlertRule supplies all the alert rule related operations.
+type AlertRule interface {
+	// Create one alert rule instance.
+	Create(kit *kit.Kit, r *table.AlertRule) (uint32, error)
+	// Update one alert rule instance.
+	Update(kit *kit.Kit, r *table.AlertRule) error
+	// Delete one alert rule instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get alert rule by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.AlertRule, error)
+	// GetByName get alert rule by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.AlertRule, error)
+	// List all the alert rules of a biz.
+	List(kit *kit.Kit, bizID uint32) ([]*table.AlertRule, error)
+	// ListEnabledByCursor list the enabled alert rules of all the bizs whose id is greater than afterID.
+	ListEnabledByCursor(kit *kit.Kit, afterID uint32, limit int) ([]*table.AlertRule, error)
+	// UpdateState update the evaluation state of the alert rule.
+	UpdateState(kit *kit.Kit, rule *table.AlertRule) error
+}
+
+var _ AlertRule = new(alertRuleDao)
+
+type alertRuleDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one alert rule instance.
+func (dao *alertRuleDao) Create(kit *kit.Kit, r *table.AlertRule) (uint32, error) {
+	if r == nil {
+		return 0, errors.New("alert rule is nil")
+	}
+
+	if err := r.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.AlertRuleTable)
+	if err != nil {
+		return 0, err
+	}
+	r.ID = id
+
+	ad := dao.auditDao.Decorator(kit, r.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AlertRuleName, r.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           r.Spec.Memo,
+	}).PrepareCreate(r)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.AlertRule.WithContext(kit.Ctx).Create(r); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return r.ID, nil
+}
+
+// Update one alert rule instance.
+func (dao *alertRuleDao) Update(kit *kit.Kit, r *table.AlertRule) error {
+	if r == nil {
+		return errors.New("alert rule is nil")
+	}
+
+	if err := r.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, r.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AlertRuleName, r.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           r.Spec.Memo,
+	}).PrepareUpdate(r)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.AlertRule
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(r.Attachment.BizID), m.ID.Eq(r.ID)).
+			Select(m.Name, m.Metric, m.Threshold, m.WindowMin, m.GroupID, m.ChannelIDs, m.SilenceMin, m.Enabled, m.Memo,
+				m.AppID, m.Reviser, m.UpdatedAt).
+			Updates(r)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one alert rule instance.
+func (dao *alertRuleDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and alert rule id should be set")
+	}
+
+	oldOne, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AlertRuleName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.AlertRule
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get alert rule by id.
+func (dao *alertRuleDao) Get(kit *kit.Kit, bizID, id uint32) (*table.AlertRule, error) {
+	m := dao.genQ.AlertRule
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get alert rule by name.
+func (dao *alertRuleDao) GetByName(kit *kit.Kit, bizID uint32, name string) (
+	*table.AlertRule, error) {
+	m := dao.genQ.AlertRule
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List all the alert rules of a biz.
+func (dao *alertRuleDao) List(kit *kit.Kit, bizID uint32) ([]*table.AlertRule, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.AlertRule
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID).Find()
+}
+
+// ListEnabledByCursor list the enabled alert rules of all the bizs whose id is greater than afterID.
+func (dao *alertRuleDao) ListEnabledByCursor(kit *kit.Kit, afterID uint32, limit int) ([]*table.AlertRule, error) {
+	m := dao.genQ.AlertRule
+	return m.WithContext(kit.Ctx).Where(m.Enabled.Is(true), m.ID.Gt(afterID)).Order(m.ID).Limit(limit).Find()
+}
+
+// UpdateState update the evaluation state of the alert rule, it's maintained by the evaluator and
+// not audited.
+func (dao *alertRuleDao) UpdateState(kit *kit.Kit, rule *table.AlertRule) error {
+	if rule == nil || rule.State == nil {
+		return errors.New("alert rule state is nil")
+	}
+
+	m := dao.genQ.AlertRule
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(rule.Attachment.BizID), m.ID.Eq(rule.ID)).
+		Select(m.Firing, m.LastValue, m.LastFiredAt, m.LastEvaluatedAt).Updates(rule)
+	return err
+}
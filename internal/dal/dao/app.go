@@ -35,6 +35,12 @@ import (
 type App interface {
 	// Create one app instance
 	Create(kit *kit.Kit, app *table.App) (uint32, error)
+	// CreateWithTx create one app instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, app *table.App) (uint32, error)
+	// UpdateState update the lifecycle state of the app.
+	UpdateState(kit *kit.Kit, bizID, appID uint32, state table.AppState) error
+	// UpdatePause update the consumption pause of the app, the zero pause resumes the consumption.
+	UpdatePause(kit *kit.Kit, bizID, appID uint32, pause table.ConsumptionPause) error
 	// Update one app's info
 	Update(kit *kit.Kit, app *table.App) error
 	// get app with id.
@@ -46,12 +52,19 @@ type App interface {
 	// List apps with options.
 	List(kit *kit.Kit, bizList []uint32, search, configType, operator string, opt *types.BasePage) (
 		[]*table.App, int64, error)
+	// ListWithCursor list apps of the bizs with cursor page.
+	ListWithCursor(kit *kit.Kit, bizList []uint32, opt *types.CursorPage) ([]*table.App, string, error)
 	// ListAppsByGroupID list apps by group id.
 	ListAppsByGroupID(kit *kit.Kit, groupID, bizID uint32) ([]*table.App, error)
 	// ListAppsByIDs list apps by app ids.
 	ListAppsByIDs(kit *kit.Kit, ids []uint32) ([]*table.App, error)
+	// ListByCursor list apps whose id is greater than the last id in ascending order of id,
+	// used to traverse all the apps in batches.
+	ListByCursor(kit *kit.Kit, lastID uint32, limit int) ([]*table.App, error)
 	// DeleteWithTx delete one app instance with transaction.
 	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, app *table.App) error
+	// RecoverWithTx recover a deleted app with its original id with transaction.
+	RecoverWithTx(kit *kit.Kit, tx *gen.QueryTx, app *table.App) error
 	// ListAppMetaForCache list app's basic meta info.
 	ListAppMetaForCache(kt *kit.Kit, bizID uint32, appID []uint32) (map[ /*appID*/ uint32]*types.AppCacheMeta, error)
 	// GetByAlias 通过Alisa 查询
@@ -213,6 +226,12 @@ func (dao *appDao) ListAppsByIDs(kit *kit.Kit, ids []uint32) ([]*table.App, erro
 	return result, nil
 }
 
+// ListByCursor list apps whose id is greater than the last id in ascending order of id.
+func (dao *appDao) ListByCursor(kit *kit.Kit, lastID uint32, limit int) ([]*table.App, error) {
+	m := dao.genQ.App
+	return m.WithContext(kit.Ctx).Where(m.ID.Gt(lastID)).Order(m.ID).Limit(limit).Find()
+}
+
 // Create one app instance
 func (dao *appDao) Create(kit *kit.Kit, g *table.App) (uint32, error) {
 	if g == nil {
@@ -223,6 +242,10 @@ func (dao *appDao) Create(kit *kit.Kit, g *table.App) (uint32, error) {
 		return 0, err
 	}
 
+	if g.Spec.State == "" {
+		g.Spec.State = table.AppStateActive
+	}
+
 	// generate an app id and update to g.
 	id, err := dao.idGen.One(kit, table.Name(g.TableName()))
 	if err != nil {
@@ -279,6 +302,177 @@ func (dao *appDao) Create(kit *kit.Kit, g *table.App) (uint32, error) {
 	return id, nil
 }
 
+// CreateWithTx create one app instance with transaction.
+func (dao *appDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.App) (uint32, error) {
+	if g == nil {
+		return 0, errf.Errorf(errf.InvalidArgument, i18n.T(kit, "app is nil"))
+	}
+
+	if err := g.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	if g.Spec.State == "" {
+		g.Spec.State = table.AppStateActive
+	}
+
+	id, err := dao.idGen.One(kit, table.Name(g.TableName()))
+	if err != nil {
+		return 0, err
+	}
+	g.ID = id
+
+	ad := dao.auditDao.Decorator(kit, g.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppName, g.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           g.Spec.Memo,
+		AppId:            g.ID,
+	}).PrepareCreate(g)
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, err
+	}
+
+	if err = tx.App.WithContext(kit.Ctx).Create(g); err != nil {
+		return 0, errf.Errorf(errf.DBOpFailed, i18n.T(kit, "create data failed, err: %v", err))
+	}
+
+	one := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Application,
+			ResourceID: g.ID,
+			OpType:     table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: g.BizID, AppID: g.ID},
+		Revision:   &table.CreatedRevision{Creator: kit.User},
+	}
+	if err = dao.event.Eventf(kit).FireWithTx(tx, one); err != nil {
+		logs.Errorf("fire create app: %d event failed, err: %v, rid: %s", g.ID, err, kit.Rid)
+		return 0, errors.New("fire event failed, " + err.Error())
+	}
+
+	return id, nil
+}
+
+// UpdateState update the lifecycle state of the app, and fire an update event to refresh the app meta cache.
+func (dao *appDao) UpdateState(kit *kit.Kit, bizID, appID uint32, state table.AppState) error {
+	if bizID == 0 || appID == 0 {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "invalid biz id or app id"))
+	}
+
+	old, err := dao.Get(kit, bizID, appID)
+	if err != nil {
+		return err
+	}
+	g := &table.App{
+		ID:       appID,
+		BizID:    bizID,
+		Spec:     &table.AppSpec{Name: old.Spec.Name, State: state},
+		Revision: &table.Revision{Reviser: kit.User},
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppName, old.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           string(state),
+		AppId:            appID,
+	}).PrepareUpdate(g)
+	eDecorator := dao.event.Eventf(kit)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.App
+		if _, e := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(appID)).
+			Select(m.State, m.Reviser, m.UpdatedAt).Updates(g); e != nil {
+			return e
+		}
+
+		if e := ad.Do(tx); e != nil {
+			return e
+		}
+
+		one := types.Event{
+			Spec: &table.EventSpec{
+				Resource:   table.Application,
+				ResourceID: appID,
+				OpType:     table.UpdateOp,
+			},
+			Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.CreatedRevision{Creator: kit.User},
+		}
+		if e := eDecorator.Fire(one); e != nil {
+			logs.Errorf("fire update app: %d state event failed, err: %v, rid: %s", appID, e, kit.Rid)
+			return errf.Errorf(errf.DBOpFailed, i18n.T(kit, "update app failed, err: %s", e))
+		}
+		return nil
+	}
+	err = dao.genQ.Transaction(updateTx)
+
+	eDecorator.Finalizer(err)
+
+	return err
+}
+
+// UpdatePause update the consumption pause of the app, and fire an update event to refresh the app meta cache.
+func (dao *appDao) UpdatePause(kit *kit.Kit, bizID, appID uint32, pause table.ConsumptionPause) error {
+	if bizID == 0 || appID == 0 {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "invalid biz id or app id"))
+	}
+
+	old, err := dao.Get(kit, bizID, appID)
+	if err != nil {
+		return err
+	}
+	g := &table.App{
+		ID:       appID,
+		BizID:    bizID,
+		Spec:     &table.AppSpec{Name: old.Spec.Name, Pause: pause},
+		Revision: &table.Revision{Reviser: kit.User},
+	}
+
+	detail := "resume"
+	if pause.Paused() {
+		detail = "pause: " + string(pause.Mode)
+	}
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppName, old.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           detail,
+		AppId:            appID,
+	}).PrepareUpdate(g)
+	eDecorator := dao.event.Eventf(kit)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.App
+		if _, e := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(appID)).
+			Select(m.Pause, m.Reviser, m.UpdatedAt).Updates(g); e != nil {
+			return e
+		}
+
+		if e := ad.Do(tx); e != nil {
+			return e
+		}
+
+		one := types.Event{
+			Spec: &table.EventSpec{
+				Resource:   table.Application,
+				ResourceID: appID,
+				OpType:     table.UpdateOp,
+			},
+			Attachment: &table.EventAttachment{BizID: bizID, AppID: appID},
+			Revision:   &table.CreatedRevision{Creator: kit.User},
+		}
+		if e := eDecorator.Fire(one); e != nil {
+			logs.Errorf("fire update app: %d pause event failed, err: %v, rid: %s", appID, e, kit.Rid)
+			return errf.Errorf(errf.DBOpFailed, i18n.T(kit, "update app failed, err: %s", e))
+		}
+		return nil
+	}
+	err = dao.genQ.Transaction(updateTx)
+
+	eDecorator.Finalizer(err)
+
+	return err
+}
+
 // Update an app instance.
 func (dao *appDao) Update(kit *kit.Kit, g *table.App) error {
 	if g == nil {
@@ -311,7 +505,7 @@ func (dao *appDao) Update(kit *kit.Kit, g *table.App) error {
 		q = tx.App.WithContext(kit.Ctx)
 		if _, err = q.Where(m.BizID.Eq(g.BizID), m.ID.Eq(g.ID)).
 			Select(m.Memo, m.Alias_, m.DataType, m.Reviser, m.UpdatedAt, m.IsApprove, m.ApproveType,
-				m.Approver).Updates(g); err != nil {
+				m.Approver, m.ReleaseNotesPolicy, m.MinClientVersion).Updates(g); err != nil {
 			return err
 		}
 
@@ -400,6 +594,58 @@ func (dao *appDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.App) err
 	return nil
 }
 
+// RecoverWithTx recover a deleted app with its original id with transaction.
+func (dao *appDao) RecoverWithTx(kit *kit.Kit, tx *gen.QueryTx, g *table.App) error {
+	if g == nil || g.ID <= 0 || g.BizID <= 0 {
+		return errors.New("invalid app to recover")
+	}
+
+	if g.Spec == nil {
+		return errors.New("invalid spec, is nil")
+	}
+
+	if err := g.Spec.ValidateCreate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, g.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppName, g.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           g.Spec.Memo,
+		AppId:            g.ID,
+	}).PrepareCreate(g)
+	if err := ad.Do(tx.Query); err != nil {
+		return err
+	}
+
+	if err := tx.App.WithContext(kit.Ctx).Create(g); err != nil {
+		return err
+	}
+
+	// the app is not need to be purged any more.
+	m := tx.ArchivedApp
+	if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(g.BizID), m.AppID.Eq(g.ID)).Delete(); err != nil {
+		return err
+	}
+
+	one := types.Event{
+		Spec: &table.EventSpec{
+			Resource:   table.Application,
+			ResourceID: g.ID,
+			OpType:     table.InsertOp,
+		},
+		Attachment: &table.EventAttachment{BizID: g.BizID, AppID: g.ID},
+		Revision:   &table.CreatedRevision{Creator: kit.User},
+	}
+	eDecorator := dao.event.Eventf(kit)
+	if err := eDecorator.FireWithTx(tx, one); err != nil {
+		logs.Errorf("fire recover app: %d event failed, err: %v, rid: %s", g.ID, err, kit.Rid)
+		return errors.New("fire event failed, " + err.Error()) // nolint: goconst
+	}
+
+	return nil
+}
+
 // Get 获取单个app详情
 func (dao *appDao) Get(kit *kit.Kit, bizID uint32, appID uint32) (*table.App, error) {
 	m := dao.genQ.App
@@ -480,7 +726,7 @@ func (dao *appDao) ListAppMetaForCache(kit *kit.Kit, bizID uint32, appIDs []uint
 	m := dao.genQ.App
 	q := dao.genQ.App.WithContext(kit.Ctx)
 
-	result, err := q.Select(m.ID, m.Name, m.ConfigType).
+	result, err := q.Select(m.ID, m.Name, m.ConfigType, m.State, m.Pause, m.MinClientVersion).
 		Where(m.BizID.Eq(bizID), m.ID.In(appIDs...)).Find()
 	if err != nil {
 		return nil, err
@@ -491,8 +737,32 @@ func (dao *appDao) ListAppMetaForCache(kit *kit.Kit, bizID uint32, appIDs []uint
 		meta[one.ID] = &types.AppCacheMeta{
 			Name:       one.Spec.Name,
 			ConfigType: one.Spec.ConfigType,
+			State:      one.Spec.State.Normalize(),
+			MinVersion: one.Spec.MinClientVersion,
+		}
+		if one.Spec.Pause.Paused() {
+			meta[one.ID].Pause = &one.Spec.Pause
 		}
 	}
 
 	return meta, nil
 }
+
+// ListWithCursor list apps of the bizs with cursor page.
+func (dao *appDao) ListWithCursor(kit *kit.Kit, bizList []uint32, opt *types.CursorPage) (
+	[]*table.App, string, error) {
+	m := dao.genQ.App
+	conds, orders, err := cursorQuery(&m, opt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 多查询一条用于判断是否存在下一页
+	result, err := dao.genQ.App.WithContext(kit.Ctx).Where(m.BizID.In(bizList...)).Where(conds...).
+		Order(orders...).Limit(int(opt.Limit) + 1).Find()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return nextCursorPage(result, opt)
+}
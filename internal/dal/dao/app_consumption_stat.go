@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// AppConsumptionStat supplies all the app consumption stat related operations.
+type AppConsumptionStat interface {
+	// BatchIncrease add the pull count and download bytes to the stats of the same app and date.
+	BatchIncrease(kit *kit.Kit, stats []*table.AppConsumptionStat) error
+	// ListByApp list the daily stats of an app since the date.
+	ListByApp(kit *kit.Kit, bizID, appID uint32, since string) ([]*table.AppConsumptionStat, error)
+	// ListTopApps list the apps with the most pull count since the date.
+	ListTopApps(kit *kit.Kit, bizID uint32, since string, limit int) ([]*types.AppConsumptionSum, error)
+	// SumByBiz sum the stats of each biz between the dates, both inclusive.
+	SumByBiz(kit *kit.Kit, since, until string) ([]*types.BizConsumptionSum, error)
+	// DeleteBefore delete the stats before the date.
+	DeleteBefore(kit *kit.Kit, date string) error
+}
+
+var _ AppConsumptionStat = new(appConsumptionStatDao)
+
+type appConsumptionStatDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchIncrease add the pull count and download bytes to the stats of the same app and date.
+func (dao *appConsumptionStatDao) BatchIncrease(kit *kit.Kit, stats []*table.AppConsumptionStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	for _, one := range stats {
+		if err := one.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	// 已存在的记录不会使用新生成的 id
+	ids, err := dao.idGen.Batch(kit, table.AppConsumptionStatTable, len(stats))
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for i, one := range stats {
+		one.ID = ids[i]
+		one.Spec.UpdatedAt = now
+	}
+
+	// 冲突时累加, mysql 使用 VALUES() 引用新值, postgres 使用 EXCLUDED
+	increase := func(col string) clause.Expression {
+		return utils.NewDialectExpr(fmt.Sprintf("%s + VALUES(%s)", col, col),
+			fmt.Sprintf("%s.%s + EXCLUDED.%s", table.AppConsumptionStatTable, col, col))
+	}
+
+	return dao.genQ.AppConsumptionStat.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "biz_id"}, {Name: "app_id"}, {Name: "stat_date"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"pull_count":     increase("pull_count"),
+			"download_bytes": increase("download_bytes"),
+			"watch_seconds":  increase("watch_seconds"),
+			"updated_at":     now,
+		}),
+	}).CreateInBatches(stats, 500)
+}
+
+// ListByApp list the daily stats of an app since the date.
+func (dao *appConsumptionStatDao) ListByApp(kit *kit.Kit, bizID, appID uint32, since string) (
+	[]*table.AppConsumptionStat, error) {
+	m := dao.genQ.AppConsumptionStat
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.StatDate.Gte(since)).
+		Order(m.StatDate).
+		Find()
+}
+
+// ListTopApps list the apps with the most pull count since the date.
+func (dao *appConsumptionStatDao) ListTopApps(kit *kit.Kit, bizID uint32, since string, limit int) (
+	[]*types.AppConsumptionSum, error) {
+	m := dao.genQ.AppConsumptionStat
+	var items []*types.AppConsumptionSum
+	err := m.WithContext(kit.Ctx).
+		Select(m.AppID, m.PullCount.Sum().As("pull_count"), m.DownloadBytes.Sum().As("download_bytes")).
+		Where(m.BizID.Eq(bizID), m.StatDate.Gte(since)).
+		Group(m.AppID).
+		Order(field.NewUint64("", "pull_count").Desc()).
+		Limit(limit).
+		Scan(&items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// SumByBiz sum the stats of each biz between the dates, both inclusive.
+func (dao *appConsumptionStatDao) SumByBiz(kit *kit.Kit, since, until string) ([]*types.BizConsumptionSum, error) {
+	m := dao.genQ.AppConsumptionStat
+	var items []*types.BizConsumptionSum
+	err := m.WithContext(kit.Ctx).
+		Select(m.BizID, m.PullCount.Sum().As("pull_count"), m.DownloadBytes.Sum().As("download_bytes"),
+			m.WatchSeconds.Sum().As("watch_seconds")).
+		Where(m.StatDate.Gte(since), m.StatDate.Lte(until)).
+		Group(m.BizID).
+		Scan(&items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// DeleteBefore delete the stats before the date.
+func (dao *appConsumptionStatDao) DeleteBefore(kit *kit.Kit, date string) error {
+	m := dao.genQ.AppConsumptionStat
+	_, err := m.WithContext(kit.Ctx).Where(m.StatDate.Lt(date)).Delete()
+	return err
+}
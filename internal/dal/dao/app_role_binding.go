@@ -0,0 +1,228 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// AppRoleBinding supplies all the app role binding related operations.
+type AppRoleBinding interface {
+	// Create one app role binding instance.
+	Create(kit *kit.Kit, b *table.AppRoleBinding) (uint32, error)
+	// UpdateRole update the role and expired time of the app role binding.
+	UpdateRole(kit *kit.Kit, b *table.AppRoleBinding) error
+	// Delete one app role binding instance.
+	Delete(kit *kit.Kit, bizID, appID, id uint32) error
+	// GetByUser get the app role binding of the user.
+	GetByUser(kit *kit.Kit, bizID, appID uint32, user string) (*table.AppRoleBinding, error)
+	// List all the role bindings of an app.
+	List(kit *kit.Kit, bizID, appID uint32) ([]*table.AppRoleBinding, error)
+	// ListByUser list the unexpired role bindings of the user in the apps.
+	ListByUser(kit *kit.Kit, bizID uint32, user string, appIDs []uint32) ([]*table.AppRoleBinding, error)
+	// ListExpired list the temporary role bindings which are expired before the given time.
+	ListExpired(kit *kit.Kit, before time.Time, limit int) ([]*table.AppRoleBinding, error)
+	// DeleteExpired delete the expired temporary role binding, which is audited as expired.
+	DeleteExpired(kit *kit.Kit, b *table.AppRoleBinding) error
+}
+
+var _ AppRoleBinding = new(appRoleBindingDao)
+
+type appRoleBindingDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one app role binding instance.
+func (dao *appRoleBindingDao) Create(kit *kit.Kit, b *table.AppRoleBinding) (uint32, error) {
+	if b == nil {
+		return 0, errors.New("app role binding is nil")
+	}
+
+	if err := b.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.AppRoleBindingTable)
+	if err != nil {
+		return 0, err
+	}
+	b.ID = id
+
+	ad := dao.auditDao.Decorator(kit, b.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppRoleBindingName, b.Spec.User, b.Spec.Role),
+		Status:           enumor.Success,
+		Detail:           b.Spec.Reason,
+		AppId:            b.Attachment.AppID,
+	}).PrepareCreate(b)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.AppRoleBinding.WithContext(kit.Ctx).Create(b); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return b.ID, nil
+}
+
+// UpdateRole update the role and expired time of the app role binding.
+func (dao *appRoleBindingDao) UpdateRole(kit *kit.Kit, b *table.AppRoleBinding) error {
+	if b == nil {
+		return errors.New("app role binding is nil")
+	}
+
+	if err := b.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, b.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppRoleBindingName, b.Spec.User, b.Spec.Role),
+		Status:           enumor.Success,
+		Detail:           b.Spec.Reason,
+		AppId:            b.Attachment.AppID,
+	}).PrepareUpdate(b)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.AppRoleBinding
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(b.Attachment.BizID), m.ID.Eq(b.ID)).
+			Select(m.Role, m.ExpiredAt, m.Reason, m.Reviser, m.UpdatedAt).Updates(b)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one app role binding instance.
+func (dao *appRoleBindingDao) Delete(kit *kit.Kit, bizID, appID, id uint32) error {
+	if bizID <= 0 || appID <= 0 || id <= 0 {
+		return errors.New("biz id, app id and app role binding id should be set")
+	}
+
+	m := dao.genQ.AppRoleBinding
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppRoleBindingName, oldOne.Spec.User, oldOne.Spec.Role),
+		Status:           enumor.Success,
+		AppId:            appID,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		q := tx.AppRoleBinding
+		if _, err := q.WithContext(kit.Ctx).Where(q.BizID.Eq(bizID), q.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// GetByUser get the app role binding of the user.
+func (dao *appRoleBindingDao) GetByUser(kit *kit.Kit, bizID, appID uint32, user string) (
+	*table.AppRoleBinding, error) {
+	m := dao.genQ.AppRoleBinding
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.User.Eq(user)).Take()
+}
+
+// List all the role bindings of an app.
+func (dao *appRoleBindingDao) List(kit *kit.Kit, bizID, appID uint32) ([]*table.AppRoleBinding, error) {
+	if bizID <= 0 || appID <= 0 {
+		return nil, errors.New("biz id and app id should be set")
+	}
+
+	m := dao.genQ.AppRoleBinding
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Order(m.ID).Find()
+}
+
+// ListByUser list the role bindings of the user in the apps.
+func (dao *appRoleBindingDao) ListByUser(kit *kit.Kit, bizID uint32, user string, appIDs []uint32) (
+	[]*table.AppRoleBinding, error) {
+	if bizID <= 0 || user == "" {
+		return nil, errors.New("biz id and user should be set")
+	}
+
+	m := dao.genQ.AppRoleBinding
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.User.Eq(user),
+		field.Or(m.ExpiredAt.IsNull(), m.ExpiredAt.Gt(time.Now())))
+	if len(appIDs) > 0 {
+		q = q.Where(m.AppID.In(appIDs...))
+	}
+
+	return q.Find()
+}
+
+// ListExpired list the temporary role bindings which are expired before the given time.
+func (dao *appRoleBindingDao) ListExpired(kit *kit.Kit, before time.Time, limit int) (
+	[]*table.AppRoleBinding, error) {
+	m := dao.genQ.AppRoleBinding
+	return m.WithContext(kit.Ctx).
+		Where(m.ExpiredAt.IsNotNull(), m.ExpiredAt.Lte(before)).
+		Order(m.ExpiredAt).
+		Limit(limit).
+		Find()
+}
+
+// DeleteExpired delete the expired temporary role binding, which is audited as expired.
+func (dao *appRoleBindingDao) DeleteExpired(kit *kit.Kit, b *table.AppRoleBinding) error {
+	if b == nil || b.Attachment == nil || !b.Spec.Temporary() {
+		return errors.New("app role binding is nil")
+	}
+
+	ad := dao.auditDao.Decorator(kit, b.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppRoleBindingName, b.Spec.User, b.Spec.Role),
+		Status:           enumor.Success,
+		Detail:           "expired",
+		AppId:            b.Attachment.AppID,
+	}).PrepareDelete(b)
+
+	deleteTx := func(tx *gen.Query) error {
+		q := tx.AppRoleBinding
+		// 只删除仍然过期的授权, 避免删除期间被续期的授权
+		result, err := q.WithContext(kit.Ctx).Where(q.BizID.Eq(b.Attachment.BizID), q.ID.Eq(b.ID),
+			q.ExpiredAt.Lte(*b.Spec.ExpiredAt)).Delete()
+		if err != nil {
+			return err
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
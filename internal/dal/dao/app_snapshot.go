@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// AppSnapshot supplies all the app snapshot related operations.
+type AppSnapshot interface {
+	// Create one app snapshot instance.
+	Create(kit *kit.Kit, snapshot *table.AppSnapshot) (uint32, error)
+	// Delete one app snapshot instance.
+	Delete(kit *kit.Kit, bizID, appID, id uint32) error
+	// Get app snapshot by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.AppSnapshot, error)
+	// GetByName get app snapshot by name.
+	GetByName(kit *kit.Kit, bizID, appID uint32, name string) (*table.AppSnapshot, error)
+	// List the snapshots of an app without the snapshot data.
+	List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.AppSnapshot, int64, error)
+	// Count the snapshots of an app.
+	Count(kit *kit.Kit, bizID, appID uint32) (int64, error)
+}
+
+var _ AppSnapshot = new(appSnapshotDao)
+
+type appSnapshotDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one app snapshot instance.
+func (dao *appSnapshotDao) Create(kit *kit.Kit, snapshot *table.AppSnapshot) (uint32, error) {
+	if snapshot == nil {
+		return 0, errors.New("app snapshot is nil")
+	}
+
+	if err := snapshot.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.AppSnapshotTable)
+	if err != nil {
+		return 0, err
+	}
+	snapshot.ID = id
+
+	ad := dao.auditDao.Decorator(kit, snapshot.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppSnapshotName, snapshot.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            snapshot.Attachment.AppID,
+		Detail:           snapshot.Spec.Memo,
+	}).PrepareCreate(snapshot)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.AppSnapshot.WithContext(kit.Ctx).Create(snapshot); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return snapshot.ID, nil
+}
+
+// Delete one app snapshot instance.
+func (dao *appSnapshotDao) Delete(kit *kit.Kit, bizID, appID, id uint32) error {
+	oldOne, err := dao.Get(kit, bizID, appID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.AppSnapshotName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            appID,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.AppSnapshot
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).
+			Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get app snapshot by id.
+func (dao *appSnapshotDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.AppSnapshot, error) {
+	m := dao.genQ.AppSnapshot
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get app snapshot by name.
+func (dao *appSnapshotDao) GetByName(kit *kit.Kit, bizID, appID uint32, name string) (*table.AppSnapshot, error) {
+	m := dao.genQ.AppSnapshot
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Name.Eq(name)).Take()
+}
+
+// List the snapshots of an app without the snapshot data.
+func (dao *appSnapshotDao) List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) (
+	[]*table.AppSnapshot, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("page is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.AppSnapshot
+	q := m.WithContext(kit.Ctx).
+		Select(m.ID, m.Name, m.Memo, m.BizID, m.AppID, m.Creator, m.CreatedAt).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).
+		Order(m.ID.Desc())
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// Count the snapshots of an app.
+func (dao *appSnapshotDao) Count(kit *kit.Kit, bizID, appID uint32) (int64, error) {
+	m := dao.genQ.AppSnapshot
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Count()
+}
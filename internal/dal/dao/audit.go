@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
@@ -40,6 +41,8 @@ type AuditDao interface {
 	// ListAuditsAppStrategy List audit apo strategy.
 	ListAuditsAppStrategy(
 		kit *kit.Kit, req *pbds.ListAuditsReq) ([]*types.ListAuditsAppStrategy, int64, error)
+	// ListAppTimeline list the audits of the app's change timeline with their publish strategies.
+	ListAppTimeline(kit *kit.Kit, opt *types.ListAppTimelineOption) ([]*types.AppTimelineRecord, int64, error)
 	// UpdateByStrategyID update audit kv by strategyID.
 	UpdateByStrategyID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error
 	// UpdateByStrategyIDs update audit kv by strategyIDs.
@@ -176,7 +179,8 @@ func (au *audit) createQuery(kit *kit.Kit, req *pbds.ListAuditsReq) (gen.IAuditD
 		strategy.PublishStatus, strategy.RejectReason, strategy.Approver, strategy.ApproverProgress,
 		strategy.UpdatedAt, strategy.Reviser, strategy.Creator, strategy.ReleaseID, strategy.Scope,
 		strategy.ItsmTicketSn, strategy.ItsmTicketUrl, strategy.ItsmTicketStateID, strategy.ItsmTicketStatus,
-		strategy.ItsmTicketType, strategy.ApproveType, strategy.Memo).
+		strategy.ItsmTicketType, strategy.ApproveType, strategy.Memo, strategy.ChangeTicketID,
+		strategy.ChangeTicketURL).
 		LeftJoin(app, app.ID.EqCol(audit.AppID)).
 		LeftJoin(strategy, strategy.ID.EqCol(audit.StrategyId)).
 		LeftJoin(client, audit.ResourceID.EqCol(client.ID), audit.ResourceType.Eq(string(enumor.Instance))).
@@ -251,6 +255,61 @@ func (au *audit) createQuery(kit *kit.Kit, req *pbds.ListAuditsReq) (gen.IAuditD
 	return result, nil
 }
 
+// ListAppTimeline list the audits of the app's change timeline with their publish strategies,
+// the latest comes first.
+func (au *audit) ListAppTimeline(kit *kit.Kit, opt *types.ListAppTimelineOption) (
+	[]*types.AppTimelineRecord, int64, error) {
+	audit := au.genQ.Audit
+	strategy := au.genQ.Strategy
+	bind := au.genQ.GroupAppBind
+
+	// 分组的审计不带服务ID，按服务绑定的分组查询，公开分组对所有服务可见，不计入单个服务的时间线
+	groupCond := field.And(audit.ResourceType.Eq(string(enumor.Group)),
+		audit.WithContext(kit.Ctx).Columns(audit.ResourceID).In(
+			bind.WithContext(kit.Ctx).Select(bind.GroupID).Where(bind.BizID.Eq(opt.BizID), bind.AppID.Eq(opt.AppID))))
+	conds := map[enumor.TimelineEventType]field.Expr{
+		enumor.EditEvent: field.And(audit.AppID.Eq(opt.AppID), audit.ResourceType.In(string(enumor.App),
+			string(enumor.Config), string(enumor.Hook), string(enumor.Variable), string(enumor.Template))),
+		enumor.ReleaseEvent: field.And(audit.AppID.Eq(opt.AppID), audit.ResourceType.Eq(string(enumor.Release)),
+			audit.Action.Neq(string(enumor.Publish))),
+		enumor.PublishEvent:  field.And(audit.AppID.Eq(opt.AppID), audit.Action.Eq(string(enumor.Publish))),
+		enumor.StrategyEvent: groupCond,
+	}
+	eventTypes := opt.Types
+	if len(eventTypes) == 0 {
+		eventTypes = []enumor.TimelineEventType{enumor.EditEvent, enumor.ReleaseEvent, enumor.PublishEvent,
+			enumor.StrategyEvent}
+	}
+	typeConds := make([]field.Expr, 0, len(eventTypes))
+	for _, one := range eventTypes {
+		typeConds = append(typeConds, conds[one])
+	}
+
+	q := audit.WithContext(kit.Ctx).Select(audit.ID, audit.ResourceType, audit.ResourceID, audit.Action,
+		audit.BizID, audit.AppID, audit.Operator, audit.CreatedAt, audit.ResInstance, audit.OperateWay, audit.Status,
+		audit.Detail, audit.StrategyId, strategy.ReleaseID, strategy.PublishStatus, strategy.RejectReason,
+		strategy.Approver, strategy.FinalApprovalTime, strategy.Reviser, strategy.ItsmTicketUrl,
+		strategy.ApproveType, strategy.Memo, strategy.ChangeTicketID, strategy.ChangeTicketURL).
+		LeftJoin(strategy, strategy.ID.EqCol(audit.StrategyId)).
+		Where(audit.BizID.Eq(opt.BizID), field.Or(typeConds...))
+
+	if !opt.StartTime.IsZero() {
+		q = q.Where(audit.CreatedAt.Gte(opt.StartTime))
+	}
+	if !opt.EndTime.IsZero() {
+		q = q.Where(audit.CreatedAt.Lt(opt.EndTime))
+	}
+
+	var records []*types.AppTimelineRecord
+	count, err := q.Order(audit.CreatedAt.Desc(), audit.ID.Desc()).
+		ScanByPage(&records, int(opt.Page.Start), int(opt.Page.Limit))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, count, nil
+}
+
 // UpdateByStrategyID update audit kv by strategyID.
 func (au *audit) UpdateByStrategyID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error {
 	s := tx.Audit
@@ -0,0 +1,85 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// BizUsage supplies all the biz usage related operations.
+type BizUsage interface {
+	// BatchUpsert create or overwrite the usages of the same biz and month, the storage bytes is kept
+	// if updateStorage is false.
+	BatchUpsert(kit *kit.Kit, usages []*table.BizUsage, updateStorage bool) error
+	// List the usages between the months(both inclusive) of the bizs, all bizs are listed if biz ids is empty.
+	List(kit *kit.Kit, bizIDs []uint32, startMonth, endMonth string) ([]*table.BizUsage, error)
+}
+
+var _ BizUsage = new(bizUsageDao)
+
+type bizUsageDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchUpsert create or overwrite the usages of the same biz and month.
+func (dao *bizUsageDao) BatchUpsert(kit *kit.Kit, usages []*table.BizUsage, updateStorage bool) error {
+	if len(usages) == 0 {
+		return nil
+	}
+
+	for _, one := range usages {
+		if err := one.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	// 已存在的记录不会使用新生成的 id
+	ids, err := dao.idGen.Batch(kit, table.BizUsageTable, len(usages))
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for i, one := range usages {
+		one.ID = ids[i]
+		one.Spec.UpdatedAt = now
+	}
+
+	columns := []string{"egress_bytes", "api_calls", "watch_seconds", "updated_at"}
+	if updateStorage {
+		columns = append(columns, "storage_bytes")
+	}
+
+	return dao.genQ.BizUsage.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "month"}},
+		DoUpdates: clause.AssignmentColumns(columns),
+	}).CreateInBatches(usages, 500)
+}
+
+// List the usages between the months(both inclusive) of the bizs.
+func (dao *bizUsageDao) List(kit *kit.Kit, bizIDs []uint32, startMonth, endMonth string) (
+	[]*table.BizUsage, error) {
+	m := dao.genQ.BizUsage
+	q := m.WithContext(kit.Ctx).Where(m.Month.Gte(startMonth), m.Month.Lte(endMonth))
+	if len(bizIDs) > 0 {
+		q = q.Where(m.BizID.In(bizIDs...))
+	}
+
+	return q.Order(m.BizID, m.Month).Find()
+}
@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// Certificate supplies all the certificate related operations, the certificates are derived from
+// config items and kvs by the scanner, so it's not audited.
+type Certificate interface {
+	// BatchUpsert create the certificates without id, and update the certificates with id.
+	BatchUpsert(kit *kit.Kit, certs []*table.Certificate) error
+	// Get certificate by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.Certificate, error)
+	// ListByApp list all the certificates of the app.
+	ListByApp(kit *kit.Kit, bizID, appID uint32) ([]*table.Certificate, error)
+	// BatchDelete delete certificates by ids.
+	BatchDelete(kit *kit.Kit, ids []uint32) error
+	// UpdateState update the warning and renewal state of the certificate.
+	UpdateState(kit *kit.Kit, cert *table.Certificate) error
+	// ListExpiring list the certificates which expire before the time, ordered by expiry time.
+	ListExpiring(kit *kit.Kit, opt *types.ListExpiringCertsOption) ([]*table.Certificate, int64, error)
+}
+
+var _ Certificate = new(certificateDao)
+
+type certificateDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchUpsert create the certificates without id, and update the certificates with id.
+func (dao *certificateDao) BatchUpsert(kit *kit.Kit, certs []*table.Certificate) error {
+	if len(certs) == 0 {
+		return nil
+	}
+
+	toCreate := make([]*table.Certificate, 0)
+	toUpdate := make([]*table.Certificate, 0)
+	for _, cert := range certs {
+		if err := cert.ValidateUpsert(); err != nil {
+			return err
+		}
+		if cert.ID == 0 {
+			toCreate = append(toCreate, cert)
+		} else {
+			toUpdate = append(toUpdate, cert)
+		}
+	}
+
+	m := dao.genQ.Certificate
+	for _, cert := range toUpdate {
+		if _, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(cert.ID), m.BizID.Eq(cert.Attachment.BizID)).
+			Select(m.Name, m.Signature, m.Subject, m.DNSNames, m.NotBefore, m.NotAfter, m.WarnedAt,
+				m.RenewStatus, m.RenewMessage, m.ScannedAt).Updates(cert); err != nil {
+			return err
+		}
+	}
+
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.CertificateTable, len(toCreate))
+	if err != nil {
+		return err
+	}
+	for i, cert := range toCreate {
+		cert.ID = ids[i]
+	}
+
+	return m.WithContext(kit.Ctx).CreateInBatches(toCreate, 100)
+}
+
+// Get certificate by id.
+func (dao *certificateDao) Get(kit *kit.Kit, bizID, id uint32) (*table.Certificate, error) {
+	m := dao.genQ.Certificate
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// ListByApp list all the certificates of the app.
+func (dao *certificateDao) ListByApp(kit *kit.Kit, bizID, appID uint32) ([]*table.Certificate, error) {
+	m := dao.genQ.Certificate
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Find()
+}
+
+// BatchDelete delete certificates by ids.
+func (dao *certificateDao) BatchDelete(kit *kit.Kit, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m := dao.genQ.Certificate
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Delete()
+	return err
+}
+
+// UpdateState update the warning and renewal state of the certificate.
+func (dao *certificateDao) UpdateState(kit *kit.Kit, cert *table.Certificate) error {
+	if cert == nil || cert.ID == 0 || cert.State == nil {
+		return errors.New("certificate id or state not set")
+	}
+
+	cert.State.ScannedAt = time.Now().UTC()
+	m := dao.genQ.Certificate
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(cert.ID)).
+		Select(m.WarnedAt, m.RenewStatus, m.RenewMessage, m.ScannedAt).Updates(cert)
+	return err
+}
+
+// ListExpiring list the certificates which expire before the time, ordered by expiry time.
+func (dao *certificateDao) ListExpiring(kit *kit.Kit, opt *types.ListExpiringCertsOption) (
+	[]*table.Certificate, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list expiring certificates option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.Certificate
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.In(opt.AppIDs...), m.NotAfter.Lt(opt.Before)).
+		Order(m.NotAfter, m.ID)
+
+	if opt.Page.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
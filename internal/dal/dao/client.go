@@ -85,6 +85,10 @@ type Client interface {
 		search *pbclient.ClientQueryCondition) (int64, error)
 	// GetClientsField 获取客户端某个字段
 	GetClientsLables(kit *kit.Kit, bizID uint32, lableName string) ([]*table.Client, error)
+	// ListOnlineByIDCursor 按 ID 游标列出业务下的在线客户端，appID 为 0 时不区分服务
+	ListOnlineByIDCursor(kit *kit.Kit, bizID, appID, afterID uint32, limit int) ([]*table.Client, error)
+	// ListByIDCursor 按 ID 游标列出服务下的全部客户端，包括已离线的客户端
+	ListByIDCursor(kit *kit.Kit, bizID, appID, afterID uint32, limit int) ([]*table.Client, error)
 }
 
 var _ Client = new(clientDao)
@@ -105,6 +109,28 @@ func (dao *clientDao) GetClientsLables(kit *kit.Kit, bizID uint32, lableName str
 		Find()
 }
 
+// ListOnlineByIDCursor 按 ID 游标列出业务下的在线客户端，appID 为 0 时不区分服务
+func (dao *clientDao) ListOnlineByIDCursor(kit *kit.Kit, bizID, appID, afterID uint32, limit int) (
+	[]*table.Client, error) {
+	m := dao.genQ.Client
+	q := dao.genQ.Client.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.OnlineStatus.Eq(sfs.Online.String()), m.ID.Gt(afterID))
+	if appID > 0 {
+		q = q.Where(m.AppID.Eq(appID))
+	}
+
+	return q.Order(m.ID).Limit(limit).Find()
+}
+
+// ListByIDCursor 按 ID 游标列出服务下的全部客户端，包括已离线的客户端
+func (dao *clientDao) ListByIDCursor(kit *kit.Kit, bizID, appID, afterID uint32, limit int) (
+	[]*table.Client, error) {
+	m := dao.genQ.Client
+	return dao.genQ.Client.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Gt(afterID)).
+		Order(m.ID).Limit(limit).Find()
+}
+
 // CountNumberOlineClients 统计客户端在线数量
 func (dao *clientDao) CountNumberOlineClients(kit *kit.Kit, bizID uint32, appID uint32, heartbeatTime int64,
 	search *pbclient.ClientQueryCondition) (int64, error) {
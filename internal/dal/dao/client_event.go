@@ -46,6 +46,8 @@ type ClientEvent interface {
 	UpsertHeartbeat(kit *kit.Kit, tx *gen.QueryTx, data []*table.ClientEvent) error
 	// UpsertVersionChange 更新插入版本更改
 	UpsertVersionChange(kit *kit.Kit, tx *gen.QueryTx, data []*table.ClientEvent) error
+	// CountReleaseChange 统计服务自 since 以来客户端上报的版本变更次数及其中失败的次数
+	CountReleaseChange(kit *kit.Kit, bizID, appID uint32, since time.Time) (total int64, failed int64, err error)
 }
 
 var _ ClientEvent = new(clientEventDao)
@@ -112,6 +114,28 @@ func (dao *clientEventDao) GetMinMaxAvgTime(kit *kit.Kit, bizID uint32, appID ui
 	return items, err
 }
 
+// CountReleaseChange 统计服务自 since 以来客户端上报的版本变更次数及其中失败的次数
+func (dao *clientEventDao) CountReleaseChange(kit *kit.Kit, bizID, appID uint32, since time.Time) (
+	int64, int64, error) {
+	m := dao.genQ.ClientEvent
+	count := func(status ...string) (int64, error) {
+		return dao.genQ.ClientEvent.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID),
+			m.StartTime.Gte(since), m.ReleaseChangeStatus.In(status...)).
+			Where(m.OriginalReleaseID.NeqCol(m.TargetReleaseID)).Count()
+	}
+
+	total, err := count(string(table.Success), string(table.Failed))
+	if err != nil {
+		return 0, 0, err
+	}
+	failed, err := count(string(table.Failed))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return total, failed, nil
+}
+
 // List list client event details
 func (dao *clientEventDao) List(kit *kit.Kit, bizID, appID, clientID uint32, startTime, endTime time.Time,
 	searchValue string, order *pbds.ListClientEventsReq_Order, opt *types.BasePage) (
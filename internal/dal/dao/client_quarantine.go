@@ -0,0 +1,186 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gen/field"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ClientQuarantine supplies all the client quarantine related operations.
+type ClientQuarantine interface {
+	// Create one client quarantine instance.
+	Create(kit *kit.Kit, c *table.ClientQuarantine) (uint32, error)
+	// Update one client quarantine instance.
+	Update(kit *kit.Kit, c *table.ClientQuarantine) error
+	// Delete one client quarantine instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get client quarantine by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.ClientQuarantine, error)
+	// GetByValue get client quarantine by match type and value.
+	GetByValue(kit *kit.Kit, bizID uint32, matchType table.QuarantineMatchType, value string) (
+		*table.ClientQuarantine, error)
+	// List all the client quarantines of a biz.
+	List(kit *kit.Kit, bizID uint32) ([]*table.ClientQuarantine, error)
+	// ListActive list the enabled and not expired client quarantines of a biz.
+	ListActive(kit *kit.Kit, bizID uint32) ([]*table.ClientQuarantine, error)
+}
+
+var _ ClientQuarantine = new(clientQuarantineDao)
+
+type clientQuarantineDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one client quarantine instance.
+func (dao *clientQuarantineDao) Create(kit *kit.Kit, c *table.ClientQuarantine) (uint32, error) {
+	if c == nil {
+		return 0, errors.New("client quarantine is nil")
+	}
+
+	if err := c.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ClientQuarantineTable)
+	if err != nil {
+		return 0, err
+	}
+	c.ID = id
+
+	ad := dao.auditDao.Decorator(kit, c.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ClientQuarantineName, c.Spec.MatchType, c.Spec.Value),
+		Status:           enumor.Success,
+		Detail:           c.Spec.Reason,
+	}).PrepareCreate(c)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ClientQuarantine.WithContext(kit.Ctx).Create(c); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return c.ID, nil
+}
+
+// Update one client quarantine instance.
+func (dao *clientQuarantineDao) Update(kit *kit.Kit, c *table.ClientQuarantine) error {
+	if c == nil {
+		return errors.New("client quarantine is nil")
+	}
+
+	if err := c.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, c.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ClientQuarantineName, c.Spec.MatchType, c.Spec.Value),
+		Status:           enumor.Success,
+		Detail:           c.Spec.Reason,
+	}).PrepareUpdate(c)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ClientQuarantine
+		// 匹配方式和值不允许修改, 修改需删除后重新创建
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(c.Attachment.BizID), m.ID.Eq(c.ID)).
+			Select(m.Reason, m.RetryAfterSec, m.ExpiredAt, m.Enabled, m.Reviser, m.UpdatedAt).
+			Updates(c)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one client quarantine instance.
+func (dao *clientQuarantineDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and client quarantine id should be set")
+	}
+
+	oldOne, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ClientQuarantineName, oldOne.Spec.MatchType, oldOne.Spec.Value),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Reason,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.ClientQuarantine
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get client quarantine by id.
+func (dao *clientQuarantineDao) Get(kit *kit.Kit, bizID, id uint32) (*table.ClientQuarantine, error) {
+	m := dao.genQ.ClientQuarantine
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByValue get client quarantine by match type and value.
+func (dao *clientQuarantineDao) GetByValue(kit *kit.Kit, bizID uint32, matchType table.QuarantineMatchType,
+	value string) (*table.ClientQuarantine, error) {
+	m := dao.genQ.ClientQuarantine
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.MatchType.Eq(string(matchType)), m.Value.Eq(value)).
+		Take()
+}
+
+// List all the client quarantines of a biz.
+func (dao *clientQuarantineDao) List(kit *kit.Kit, bizID uint32) ([]*table.ClientQuarantine, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.ClientQuarantine
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID).Find()
+}
+
+// ListActive list the enabled and not expired client quarantines of a biz.
+func (dao *clientQuarantineDao) ListActive(kit *kit.Kit, bizID uint32) ([]*table.ClientQuarantine, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.ClientQuarantine
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Enabled.Is(true),
+		field.Or(m.ExpiredAt.IsNull(), m.ExpiredAt.Gt(time.Now()))).Order(m.ID).Find()
+}
@@ -67,7 +67,7 @@ func (dao *clientQueryDao) ListBySearchCondition(kit *kit.Kit, bizID, appID uint
 
 	return dao.genQ.ClientQuery.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).
 		Where(m.Creator.Eq(creator), m.SearchType.Eq(searchType)).
-		Where(utils.RawCond("JSON_CONTAINS(?,?)", utils.Field{
+		Where(utils.JSONContains(utils.Field{
 			Field: m.SearchCondition,
 		}, searchCondition)).
 		Find()
@@ -0,0 +1,178 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// CompliancePolicy supplies all the compliance policy related operations.
+type CompliancePolicy interface {
+	// Create one compliance policy instance.
+	Create(kit *kit.Kit, p *table.CompliancePolicy) (uint32, error)
+	// Update one compliance policy instance.
+	Update(kit *kit.Kit, p *table.CompliancePolicy) error
+	// Delete one compliance policy instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get compliance policy by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.CompliancePolicy, error)
+	// GetByName get compliance policy by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.CompliancePolicy, error)
+	// List all the compliance policies of a biz.
+	List(kit *kit.Kit, bizID uint32) ([]*table.CompliancePolicy, error)
+	// ListEnabled list the enabled compliance policies of a biz.
+	ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.CompliancePolicy, error)
+}
+
+var _ CompliancePolicy = new(compliancePolicyDao)
+
+type compliancePolicyDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one compliance policy instance.
+func (dao *compliancePolicyDao) Create(kit *kit.Kit, p *table.CompliancePolicy) (uint32, error) {
+	if p == nil {
+		return 0, errors.New("compliance policy is nil")
+	}
+
+	if err := p.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.CompliancePolicyTable)
+	if err != nil {
+		return 0, err
+	}
+	p.ID = id
+
+	ad := dao.auditDao.Decorator(kit, p.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.CompliancePolicyName, p.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           p.Spec.Memo,
+	}).PrepareCreate(p)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.CompliancePolicy.WithContext(kit.Ctx).Create(p); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return p.ID, nil
+}
+
+// Update one compliance policy instance.
+func (dao *compliancePolicyDao) Update(kit *kit.Kit, p *table.CompliancePolicy) error {
+	if p == nil {
+		return errors.New("compliance policy is nil")
+	}
+
+	if err := p.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, p.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.CompliancePolicyName, p.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           p.Spec.Memo,
+	}).PrepareUpdate(p)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.CompliancePolicy
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(p.Attachment.BizID), m.ID.Eq(p.ID)).
+			Select(m.Name, m.Memo, m.Severity, m.Enabled, m.Target, m.EnvIDs, m.Rule, m.Reviser, m.UpdatedAt).
+			Updates(p)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one compliance policy instance.
+func (dao *compliancePolicyDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and compliance policy id should be set")
+	}
+
+	oldOne, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.CompliancePolicyName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.CompliancePolicy
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get compliance policy by id.
+func (dao *compliancePolicyDao) Get(kit *kit.Kit, bizID, id uint32) (*table.CompliancePolicy, error) {
+	m := dao.genQ.CompliancePolicy
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get compliance policy by name.
+func (dao *compliancePolicyDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.CompliancePolicy, error) {
+	m := dao.genQ.CompliancePolicy
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List all the compliance policies of a biz.
+func (dao *compliancePolicyDao) List(kit *kit.Kit, bizID uint32) ([]*table.CompliancePolicy, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.CompliancePolicy
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID).Find()
+}
+
+// ListEnabled list the enabled compliance policies of a biz.
+func (dao *compliancePolicyDao) ListEnabled(kit *kit.Kit, bizID uint32) ([]*table.CompliancePolicy, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.CompliancePolicy
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Enabled.Is(true)).Order(m.ID).Find()
+}
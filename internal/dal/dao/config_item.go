@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/samber/lo"
 	"gorm.io/gen/field"
@@ -44,6 +45,9 @@ type ConfigItem interface {
 	ValidateAppCINumber(kt *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
 	// Update one configItem instance.
 	Update(kit *kit.Kit, configItem *table.ConfigItem) error
+	// UpdateWithRevision update one configItem instance only if it's not modified since the given update
+	// time, returns ErrRevisionConflict otherwise.
+	UpdateWithRevision(kit *kit.Kit, configItem *table.ConfigItem, updatedAt time.Time) error
 	// BatchUpdateWithTx batch update configItem instances with transaction.
 	BatchUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, configItems []*table.ConfigItem) error
 	// Get configItem by id
@@ -52,6 +56,9 @@ type ConfigItem interface {
 	GetByUniqueKey(kit *kit.Kit, bizID, appID uint32, name, path string) (*table.ConfigItem, error)
 	// GetUniqueKeys get unique keys of all config items in one app
 	GetUniqueKeys(kit *kit.Kit, bizID, appID uint32) ([]types.CIUniqueKey, error)
+	// ListWithCursor list editing config items of the app with cursor page.
+	ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) ([]*table.ConfigItem, string,
+		error)
 	// ListAllByAppID list all configItem by appID
 	ListAllByAppID(kit *kit.Kit, appID uint32, bizID uint32) ([]*table.ConfigItem, error)
 	// Delete one configItem instance.
@@ -290,6 +297,15 @@ func (dao *configItemDao) BatchCreateWithTx(kit *kit.Kit, tx *gen.QueryTx,
 
 // Update one configItem instance.
 func (dao *configItemDao) Update(kit *kit.Kit, ci *table.ConfigItem) error {
+	return dao.update(kit, ci, nil)
+}
+
+// UpdateWithRevision update one configItem instance only if it's not modified since the given update time.
+func (dao *configItemDao) UpdateWithRevision(kit *kit.Kit, ci *table.ConfigItem, updatedAt time.Time) error {
+	return dao.update(kit, ci, &updatedAt)
+}
+
+func (dao *configItemDao) update(kit *kit.Kit, ci *table.ConfigItem, updatedAt *time.Time) error {
 
 	if ci == nil {
 		return errf.New(errf.InvalidParameter, "config item is nil")
@@ -323,11 +339,18 @@ func (dao *configItemDao) Update(kit *kit.Kit, ci *table.ConfigItem) error {
 	}).PrepareUpdate(ci)
 
 	updateTx := func(tx *gen.Query) error {
-		q = tx.ConfigItem.WithContext(kit.Ctx)
-		if _, err := q.Omit(m.ID, m.BizID, m.AppID).
-			Where(m.ID.Eq(ci.ID), m.BizID.Eq(ci.Attachment.BizID)).Updates(ci); err != nil {
+		q = tx.ConfigItem.WithContext(kit.Ctx).Where(m.ID.Eq(ci.ID), m.BizID.Eq(ci.Attachment.BizID))
+		// 乐观锁: 更新时间未变化时才更新, 读取与更新之间被他人修改时不影响任何行
+		if updatedAt != nil {
+			q = q.Where(m.UpdatedAt.Eq(*updatedAt))
+		}
+		result, err := q.Omit(m.ID, m.BizID, m.AppID).Updates(ci)
+		if err != nil {
 			return err
 		}
+		if updatedAt != nil && result.RowsAffected == 0 {
+			return ErrRevisionConflict
+		}
 
 		if err := ad.Do(tx); err != nil {
 			return fmt.Errorf("audit update config item failed, err: %v", err)
@@ -336,6 +359,9 @@ func (dao *configItemDao) Update(kit *kit.Kit, ci *table.ConfigItem) error {
 	}
 
 	if err := dao.genQ.Transaction(updateTx); err != nil {
+		if errors.Is(err, ErrRevisionConflict) {
+			return err
+		}
 		logs.Errorf("update config item: %d failed, err: %v, rid: %v", ci.ID, err, kit.Rid)
 		return err
 	}
@@ -642,3 +668,22 @@ func (dao *configItemDao) GetCount(kit *kit.Kit, bizID uint32, appId []uint32) (
 	v := lo.Values(configItemMap)
 	return v, nil
 }
+
+// ListWithCursor list editing config items of the app with cursor page.
+func (dao *configItemDao) ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) (
+	[]*table.ConfigItem, string, error) {
+	m := dao.genQ.ConfigItem
+	conds, orders, err := cursorQuery(&m, opt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 多查询一条用于判断是否存在下一页
+	result, err := dao.genQ.ConfigItem.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Where(conds...).
+		Order(orders...).Limit(int(opt.Limit) + 1).Find()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return nextCursorPage(result, opt)
+}
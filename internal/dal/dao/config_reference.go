@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ConfigReference supplies all the config reference related operations.
+type ConfigReference interface {
+	// Create one config reference instance.
+	Create(kit *kit.Kit, ref *table.ConfigReference) (uint32, error)
+	// Delete one config reference instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get config reference by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.ConfigReference, error)
+	// ListBySrc list the references whose source is one of the given configs.
+	ListBySrc(kit *kit.Kit, bizID uint32, srcType table.ConfigRefNodeType, srcIDs []uint32) (
+		[]*table.ConfigReference, error)
+	// ListByDst list the references whose destination is one of the given configs.
+	ListByDst(kit *kit.Kit, bizID uint32, dstType table.ConfigRefNodeType, dstIDs []uint32) (
+		[]*table.ConfigReference, error)
+	// ListByDstWithTx list the references to the config with transaction.
+	ListByDstWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32, dstType table.ConfigRefNodeType, dstID uint32) (
+		[]*table.ConfigReference, error)
+	// ListExternalToAppWithTx list the references from the other apps to the app or its configs with transaction.
+	ListExternalToAppWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) ([]*table.ConfigReference, error)
+	// DeleteBySrcWithTx delete all the references from the config with transaction.
+	DeleteBySrcWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32, srcType table.ConfigRefNodeType,
+		srcID uint32) error
+	// DeleteByAppIDWithTx delete all the references from or to the app and its configs with transaction.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+}
+
+var _ ConfigReference = new(configReferenceDao)
+
+type configReferenceDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one config reference instance.
+func (dao *configReferenceDao) Create(kit *kit.Kit, ref *table.ConfigReference) (uint32, error) {
+	if ref == nil {
+		return 0, errors.New("config reference is nil")
+	}
+
+	if err := ref.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ConfigReferenceTable)
+	if err != nil {
+		return 0, err
+	}
+	ref.ID = id
+
+	ad := dao.auditDao.Decorator(kit, ref.Attachment.BizID, &table.AuditField{
+		ResourceInstance: configReferenceInstance(ref),
+		Status:           enumor.Success,
+		AppId:            ref.Attachment.SrcAppID,
+	}).PrepareCreate(ref)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ConfigReference.WithContext(kit.Ctx).Create(ref); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return ref.ID, nil
+}
+
+// Delete one config reference instance.
+func (dao *configReferenceDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and config reference id should be set")
+	}
+
+	m := dao.genQ.ConfigReference
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: configReferenceInstance(oldOne),
+		Status:           enumor.Success,
+		AppId:            oldOne.Attachment.SrcAppID,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		q := tx.ConfigReference.WithContext(kit.Ctx)
+		if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get config reference by id.
+func (dao *configReferenceDao) Get(kit *kit.Kit, bizID, id uint32) (*table.ConfigReference, error) {
+	m := dao.genQ.ConfigReference
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// ListBySrc list the references whose source is one of the given configs.
+func (dao *configReferenceDao) ListBySrc(kit *kit.Kit, bizID uint32, srcType table.ConfigRefNodeType,
+	srcIDs []uint32) ([]*table.ConfigReference, error) {
+	m := dao.genQ.ConfigReference
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.SrcType.Eq(string(srcType)), m.SrcID.In(srcIDs...)).
+		Order(m.ID).Find()
+}
+
+// ListByDst list the references whose destination is one of the given configs.
+func (dao *configReferenceDao) ListByDst(kit *kit.Kit, bizID uint32, dstType table.ConfigRefNodeType,
+	dstIDs []uint32) ([]*table.ConfigReference, error) {
+	m := dao.genQ.ConfigReference
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.DstType.Eq(string(dstType)), m.DstID.In(dstIDs...)).
+		Order(m.ID).Find()
+}
+
+// ListByDstWithTx list the references to the config with transaction.
+func (dao *configReferenceDao) ListByDstWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32,
+	dstType table.ConfigRefNodeType, dstID uint32) ([]*table.ConfigReference, error) {
+	m := tx.ConfigReference
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.DstType.Eq(string(dstType)), m.DstID.Eq(dstID)).
+		Order(m.ID).Find()
+}
+
+// ListExternalToAppWithTx list the references from the other apps to the app or its configs with transaction.
+func (dao *configReferenceDao) ListExternalToAppWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) (
+	[]*table.ConfigReference, error) {
+	m := tx.ConfigReference
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.DstAppID.Eq(appID), m.SrcAppID.Neq(appID)).
+		Order(m.ID).Find()
+}
+
+// DeleteBySrcWithTx delete all the references from the config with transaction.
+func (dao *configReferenceDao) DeleteBySrcWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32,
+	srcType table.ConfigRefNodeType, srcID uint32) error {
+	m := tx.ConfigReference
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.SrcType.Eq(string(srcType)), m.SrcID.Eq(srcID)).
+		Delete()
+	return err
+}
+
+// DeleteByAppIDWithTx delete all the references from or to the app and its configs with transaction.
+func (dao *configReferenceDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	m := tx.ConfigReference
+	q := tx.ConfigReference.WithContext(kit.Ctx)
+	_, err := q.Where(m.BizID.Eq(bizID)).Where(q.Where(m.SrcAppID.Eq(appID)).Or(m.DstAppID.Eq(appID))).Delete()
+	return err
+}
+
+// configReferenceInstance returns the audit resource instance of the config reference.
+func configReferenceInstance(ref *table.ConfigReference) string {
+	return fmt.Sprintf(constant.ConfigReferenceName, ref.Spec.SrcType, ref.Spec.SrcID, ref.Spec.DstType,
+		ref.Spec.DstID)
+}
@@ -0,0 +1,207 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// ConfigShare supplies all the config share related operations.
+type ConfigShare interface {
+	// Create one config share instance.
+	Create(kit *kit.Kit, share *table.ConfigShare) (uint32, error)
+	// Update the config share's memo and grantees.
+	Update(kit *kit.Kit, share *table.ConfigShare) error
+	// DeleteWithTx delete one config share instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
+	// Get config share by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.ConfigShare, error)
+	// GetByName get config share by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.ConfigShare, error)
+	// ListByIDs list config shares by ids, which may belong to different bizs.
+	ListByIDs(kit *kit.Kit, ids []uint32) ([]*table.ConfigShare, error)
+	// List config shares with options.
+	List(kit *kit.Kit, opt *types.ListConfigSharesOption) ([]*table.ConfigShare, int64, error)
+}
+
+var _ ConfigShare = new(configShareDao)
+
+type configShareDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one config share instance.
+func (dao *configShareDao) Create(kit *kit.Kit, share *table.ConfigShare) (uint32, error) {
+	if share == nil {
+		return 0, errors.New("config share is nil")
+	}
+
+	if err := share.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ConfigShareTable)
+	if err != nil {
+		return 0, err
+	}
+	share.ID = id
+
+	ad := dao.auditDao.Decorator(kit, share.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ConfigShareName, share.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            share.Attachment.AppID,
+		Detail:           share.Spec.Memo,
+	}).PrepareCreate(share)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ConfigShare.WithContext(kit.Ctx).Create(share); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return share.ID, nil
+}
+
+// Update the config share's memo and grantees.
+func (dao *configShareDao) Update(kit *kit.Kit, share *table.ConfigShare) error {
+	if share == nil {
+		return errors.New("config share is nil")
+	}
+
+	if err := share.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, share.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ConfigShareName, share.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            share.Attachment.AppID,
+		Detail:           share.Spec.Memo,
+	}).PrepareUpdate(share)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ConfigShare
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(share.Attachment.BizID), m.ID.Eq(share.ID)).
+			Select(m.Grantees, m.Memo, m.Reviser, m.UpdatedAt).Updates(share)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// DeleteWithTx delete one config share instance with transaction.
+func (dao *configShareDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and config share id should be set")
+	}
+
+	m := tx.ConfigShare
+	q := tx.ConfigShare.WithContext(kit.Ctx)
+
+	oldOne, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ConfigShareName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		AppId:            oldOne.Attachment.AppID,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// Get config share by id.
+func (dao *configShareDao) Get(kit *kit.Kit, bizID, id uint32) (*table.ConfigShare, error) {
+	m := dao.genQ.ConfigShare
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get config share by name.
+func (dao *configShareDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.ConfigShare, error) {
+	m := dao.genQ.ConfigShare
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// ListByIDs list config shares by ids, which may belong to different bizs.
+func (dao *configShareDao) ListByIDs(kit *kit.Kit, ids []uint32) ([]*table.ConfigShare, error) {
+	if len(ids) == 0 {
+		return []*table.ConfigShare{}, nil
+	}
+
+	m := dao.genQ.ConfigShare
+	return m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Find()
+}
+
+// List config shares with options.
+func (dao *configShareDao) List(kit *kit.Kit, opt *types.ListConfigSharesOption) (
+	[]*table.ConfigShare, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list config shares option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.ConfigShare
+	q := m.WithContext(kit.Ctx)
+	if opt.BizID > 0 {
+		q = q.Where(m.BizID.Eq(opt.BizID))
+	} else {
+		q = q.Where(utils.JSONContains(utils.Field{
+			Field: m.Grantees,
+		}, fmt.Sprintf("%d", opt.GranteeBizID)))
+	}
+	if opt.AppID > 0 {
+		q = q.Where(m.AppID.Eq(opt.AppID))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.Page.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
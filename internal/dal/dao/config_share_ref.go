@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ConfigShareRef supplies all the config share ref related operations.
+type ConfigShareRef interface {
+	// Create one config share ref instance.
+	Create(kit *kit.Kit, ref *table.ConfigShareRef, shareName string) (uint32, error)
+	// DeleteWithTx delete one config share ref instance of the app with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, id uint32) error
+	// DeleteByShareIDWithTx delete all the refs of a config share with transaction.
+	DeleteByShareIDWithTx(kit *kit.Kit, tx *gen.QueryTx, shareID uint32) error
+	// DeleteByAppIDWithTx delete all the config share refs of an app with transaction.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+	// GetByShareID get the app's ref of the config share.
+	GetByShareID(kit *kit.Kit, bizID, appID, shareID uint32) (*table.ConfigShareRef, error)
+	// ListByAppID list all the config share refs of an app.
+	ListByAppID(kit *kit.Kit, bizID, appID uint32) ([]*table.ConfigShareRef, error)
+	// ListByShareIDs list all the refs of the config shares.
+	ListByShareIDs(kit *kit.Kit, shareIDs []uint32) ([]*table.ConfigShareRef, error)
+	// CountByShareID count the refs of a config share.
+	CountByShareID(kit *kit.Kit, shareID uint32) (int64, error)
+}
+
+var _ ConfigShareRef = new(configShareRefDao)
+
+type configShareRefDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one config share ref instance.
+func (dao *configShareRefDao) Create(kit *kit.Kit, ref *table.ConfigShareRef, shareName string) (uint32, error) {
+	if ref == nil {
+		return 0, errors.New("config share ref is nil")
+	}
+
+	if err := ref.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ConfigShareRefTable)
+	if err != nil {
+		return 0, err
+	}
+	ref.ID = id
+
+	ad := dao.auditDao.Decorator(kit, ref.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ConfigShareName, shareName),
+		Status:           enumor.Success,
+		AppId:            ref.Attachment.AppID,
+	}).PrepareCreate(ref)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ConfigShareRef.WithContext(kit.Ctx).Create(ref); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return ref.ID, nil
+}
+
+// DeleteWithTx delete one config share ref instance of the app with transaction.
+func (dao *configShareRefDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, id uint32) error {
+	if bizID <= 0 || appID <= 0 || id <= 0 {
+		return errors.New("biz id, app id and config share ref id should be set")
+	}
+
+	m := tx.ConfigShareRef
+	q := tx.ConfigShareRef.WithContext(kit.Ctx)
+
+	oldOne, err := q.Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf("config_share_id: %d", oldOne.Spec.ShareID),
+		Status:           enumor.Success,
+		AppId:            appID,
+	}).PrepareDelete(oldOne)
+
+	if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// DeleteByShareIDWithTx delete all the refs of a config share with transaction.
+func (dao *configShareRefDao) DeleteByShareIDWithTx(kit *kit.Kit, tx *gen.QueryTx, shareID uint32) error {
+	m := tx.ConfigShareRef
+	_, err := m.WithContext(kit.Ctx).Where(m.ShareID.Eq(shareID)).Delete()
+	return err
+}
+
+// DeleteByAppIDWithTx delete all the config share refs of an app with transaction.
+func (dao *configShareRefDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	m := tx.ConfigShareRef
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Delete()
+	return err
+}
+
+// GetByShareID get the app's ref of the config share.
+func (dao *configShareRefDao) GetByShareID(kit *kit.Kit, bizID, appID, shareID uint32) (
+	*table.ConfigShareRef, error) {
+	m := dao.genQ.ConfigShareRef
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ShareID.Eq(shareID)).Take()
+}
+
+// ListByAppID list all the config share refs of an app.
+func (dao *configShareRefDao) ListByAppID(kit *kit.Kit, bizID, appID uint32) ([]*table.ConfigShareRef, error) {
+	m := dao.genQ.ConfigShareRef
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Order(m.ID).Find()
+}
+
+// ListByShareIDs list all the refs of the config shares.
+func (dao *configShareRefDao) ListByShareIDs(kit *kit.Kit, shareIDs []uint32) ([]*table.ConfigShareRef, error) {
+	m := dao.genQ.ConfigShareRef
+	return m.WithContext(kit.Ctx).Where(m.ShareID.In(shareIDs...)).Order(m.ID).Find()
+}
+
+// CountByShareID count the refs of a config share.
+func (dao *configShareRefDao) CountByShareID(kit *kit.Kit, shareID uint32) (int64, error) {
+	m := dao.genQ.ConfigShareRef
+	return m.WithContext(kit.Ctx).Where(m.ShareID.Eq(shareID)).Count()
+}
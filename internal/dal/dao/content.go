@@ -22,6 +22,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
 // Content supplies all the content related operations.
@@ -38,6 +39,8 @@ type Content interface {
 	BatchDeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, contentIDs []uint32) error
 	// ListAllCISigns lists all non-template ci signatures of one biz, and only belongs to existing apps
 	ListAllCISigns(kit *kit.Kit, bizID uint32) ([]string, error)
+	// SumBizStorage sum the size of the contents stored by each biz, the same content is counted once.
+	SumBizStorage(kit *kit.Kit) ([]*types.BizStorageSum, error)
 }
 
 var _ Content = new(contentDao)
@@ -207,3 +210,19 @@ func (dao *contentDao) ListAllCISigns(kit *kit.Kit, bizID uint32) ([]string, err
 
 	return signs, nil
 }
+
+// SumBizStorage sum the size of the contents stored by each biz, the same content is counted once,
+// including the contents of the config items and the templates.
+func (dao *contentDao) SumBizStorage(kit *kit.Kit) ([]*types.BizStorageSum, error) {
+	var items []*types.BizStorageSum
+	err := dao.genQ.Content.WithContext(kit.Ctx).UnderlyingDB().Raw(
+		"SELECT biz_id, SUM(byte_size) AS storage_bytes FROM (" +
+			"SELECT biz_id, signature, byte_size FROM contents UNION " +
+			"SELECT biz_id, signature, byte_size FROM template_revisions) t GROUP BY biz_id").
+		Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -46,10 +46,14 @@ type Credential interface {
 	// List get credentials
 	List(kit *kit.Kit, bizID uint32, searchKey string, opt *types.BasePage,
 		topIds []uint32, encCredential string, enable *bool) ([]*table.Credential, int64, error)
+	// ListWithCursor list credentials of the biz with cursor page.
+	ListWithCursor(kit *kit.Kit, bizID uint32, opt *types.CursorPage) ([]*table.Credential, string, error)
 	// DeleteWithTx delete credential with transaction
 	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
 	// Update update credential
 	Update(kit *kit.Kit, credential *table.Credential) error
+	// UpdatePause update the consumption pause of the credential, the zero pause resumes the consumption.
+	UpdatePause(kit *kit.Kit, bizID, id uint32, pause table.ConsumptionPause) error
 	// UpdateRevisionWithTx update credential revision with transaction
 	UpdateRevisionWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
 	// GetByName get Credential by name.
@@ -375,6 +379,77 @@ func (dao *credentialDao) Update(kit *kit.Kit, g *table.Credential) error {
 	return err
 }
 
+// UpdatePause update the consumption pause of the credential
+// !Note: update credential should emit a update event.
+func (dao *credentialDao) UpdatePause(kit *kit.Kit, bizID, id uint32, pause table.ConsumptionPause) error {
+	if bizID == 0 || id == 0 {
+		return errors.New("credential bizID or id is zero")
+	}
+
+	m := dao.genQ.Credential
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID)).Take()
+	if err != nil {
+		return err
+	}
+
+	// decode credential string
+	masterKey := dao.credentialSetting.MasterKey
+	encrypted, err := tools.DecryptCredential(oldOne.Spec.EncCredential, masterKey, oldOne.Spec.EncAlgorithm)
+	if err != nil {
+		return err
+	}
+
+	g := &table.Credential{
+		ID:         id,
+		Spec:       &table.CredentialSpec{Name: oldOne.Spec.Name, Pause: pause},
+		Attachment: &table.CredentialAttachment{BizID: bizID},
+		Revision:   &table.Revision{Reviser: kit.User},
+	}
+	one := types.Event{
+		Spec: &table.EventSpec{
+			Resource:    table.CredentialEvent,
+			ResourceID:  id,
+			ResourceUid: encrypted,
+			OpType:      table.UpdateOp,
+		},
+		Attachment: &table.EventAttachment{BizID: bizID},
+		Revision:   &table.CreatedRevision{Creator: kit.User},
+	}
+	eDecorator := dao.event.Eventf(kit)
+	detail := "resume"
+	if pause.Paused() {
+		detail = "pause: " + string(pause.Mode)
+	}
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.CredentialName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           detail,
+	}).PrepareUpdate(g)
+
+	updateTx := func(tx *gen.Query) error {
+		q := tx.Credential.WithContext(kit.Ctx)
+		if _, e := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Select(m.Pause, m.Reviser).Updates(g); e != nil {
+			return e
+		}
+
+		if e := ad.Do(tx); e != nil {
+			return e
+		}
+
+		if e := eDecorator.Fire(one); e != nil {
+			logs.Errorf("fire update credential: %d pause event failed, err: %v, rid: %s", id, e, kit.Rid)
+			return errors.New("fire event failed, " + e.Error())
+		}
+
+		return nil
+	}
+	err = dao.genQ.Transaction(updateTx)
+
+	eDecorator.Finalizer(err)
+
+	return err
+}
+
 // UpdateRevisionWithTx update credential revision with transaction
 // !Note: update credential should emit a update event.
 func (dao *credentialDao) UpdateRevisionWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID uint32, id uint32) error {
@@ -434,3 +509,22 @@ func (dao *credentialDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*t
 
 	return credential, nil
 }
+
+// ListWithCursor list credentials of the biz with cursor page.
+func (dao *credentialDao) ListWithCursor(kit *kit.Kit, bizID uint32, opt *types.CursorPage) (
+	[]*table.Credential, string, error) {
+	m := dao.genQ.Credential
+	conds, orders, err := cursorQuery(&m, opt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 多查询一条用于判断是否存在下一页
+	result, err := dao.genQ.Credential.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Where(conds...).
+		Order(orders...).Limit(int(opt.Limit) + 1).Find()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return nextCursorPage(result, opt)
+}
@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	rawgen "gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm/schema"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/filter"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// cursorModel is the gen model which can be queried with cursor page.
+type cursorModel interface {
+	GetFieldByName(fieldName string) (field.OrderExpr, bool)
+}
+
+// cursorQuery returns the query conditions and orders of the cursor page, the page should be validated.
+// the keyset condition of sort fields (a asc, b desc, id asc) is:
+// (a > ?) OR (a = ? AND b < ?) OR (a = ? AND b = ? AND id > ?)
+func cursorQuery(m cursorModel, opt *types.CursorPage) ([]rawgen.Condition, []field.Expr, error) {
+	sorts := opt.SortFields()
+	if len(sorts) == 0 {
+		return nil, nil, errors.New("cursor page is not validated")
+	}
+
+	cols := make([]field.OrderExpr, len(sorts))
+	orders := make([]field.Expr, len(sorts))
+	for i, s := range sorts {
+		col, ok := m.GetFieldByName(s.Field)
+		if !ok {
+			return nil, nil, fmt.Errorf("sort field %s is not exist", s.Field)
+		}
+		cols[i] = col
+		orders[i] = col
+		if s.Order == types.Descending {
+			orders[i] = col.Desc()
+		}
+	}
+
+	conds := make([]rawgen.Condition, 0)
+	if expr := opt.FilterExpr(); expr != nil {
+		cond, err := filterCond(m, expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		if cond != nil {
+			conds = append(conds, cond)
+		}
+	}
+
+	cursor := opt.DecodedCursor()
+	if cursor == nil {
+		return conds, orders, nil
+	}
+
+	values := append(append([]interface{}{}, cursor.Values...), cursor.ID)
+	ors := make([]string, 0, len(sorts))
+	args := make([]interface{}, 0)
+	for i := range sorts {
+		ands := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			ands = append(ands, "? = ?")
+			args = append(args, cols[j], values[j])
+		}
+		op := ">"
+		if sorts[i].Order == types.Descending {
+			op = "<"
+		}
+		ands = append(ands, "? "+op+" ?")
+		args = append(args, cols[i], values[i])
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+	conds = append(conds, utils.RawCond("("+strings.Join(ors, " OR ")+")", args...))
+
+	return conds, orders, nil
+}
+
+// filterCond converts the filter expression to the query condition.
+func filterCond(m cursorModel, expr *filter.Expression) (rawgen.Condition, error) {
+	if len(expr.Rules) == 0 {
+		return nil, nil
+	}
+
+	subs := make([]string, 0, len(expr.Rules))
+	args := make([]interface{}, 0)
+	for _, one := range expr.Rules {
+		rule, ok := one.(*filter.AtomRule)
+		if !ok {
+			return nil, errors.New("only atom rule is supported in filter")
+		}
+		col, ok := m.GetFieldByName(rule.Field)
+		if !ok {
+			return nil, fmt.Errorf("filter field %s is not exist", rule.Field)
+		}
+
+		// 字段名使用占位符, 由 gorm 进行转义
+		sql, vars, err := rule.Op.Operator().SQLExpr("?", rule.Value)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, "("+sql+")")
+		args = append(append(args, col), vars...)
+	}
+
+	logic := " AND "
+	if expr.Op == filter.Or {
+		logic = " OR "
+	}
+	return utils.RawCond("("+strings.Join(subs, logic)+")", args...), nil
+}
+
+var cursorSchemas = new(sync.Map)
+
+// nextCursorPage trims the items queried with limit+1 to the page and returns the cursor of the next page,
+// empty cursor means there is no more page.
+func nextCursorPage[T any](items []*T, opt *types.CursorPage) ([]*T, string, error) {
+	if len(items) <= int(opt.Limit) {
+		return items, "", nil
+	}
+
+	items = items[:opt.Limit]
+	last := items[len(items)-1]
+	s, err := schema.Parse(last, cursorSchemas, schema.NamingStrategy{})
+	if err != nil {
+		return nil, "", err
+	}
+
+	rv := reflect.ValueOf(last)
+	cursor := new(types.Cursor)
+	sorts := opt.SortFields()
+	for i, sf := range sorts {
+		f := s.LookUpField(sf.Field)
+		if f == nil {
+			return nil, "", fmt.Errorf("sort field %s is not exist", sf.Field)
+		}
+		v, _ := f.ValueOf(context.Background(), rv)
+		if i == len(sorts)-1 {
+			id, ok := v.(uint32)
+			if !ok {
+				return nil, "", errors.New("the last sort field should be the uint32 id")
+			}
+			cursor.ID = id
+			break
+		}
+		cursor.Values = append(cursor.Values, v)
+	}
+
+	next, err := cursor.Encode()
+	if err != nil {
+		return nil, "", err
+	}
+	return items, next, nil
+}
@@ -14,11 +14,12 @@
 package dao
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/changsongl/gorm-plugin/query"
-	"gorm.io/driver/mysql"
+	"github.com/prometheus/client_golang/prometheus/collectors"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 	"gorm.io/plugin/opentelemetry/tracing"
@@ -35,6 +36,9 @@ import (
 var (
 	// ErrRecordNotFound alias gorm ErrRecordNotFound.
 	ErrRecordNotFound = gorm.ErrRecordNotFound
+	// ErrRevisionConflict means the resource has been modified by others since it's read, used for
+	// optimistic locking.
+	ErrRevisionConflict = errors.New("resource has been modified by others")
 )
 
 // Set defines all the DAO to be operated.
@@ -79,6 +83,38 @@ type Set interface {
 	ClientEvent() ClientEvent
 	ClientQuery() ClientQuery
 	Config() Config
+	HookExecResult() HookExecResult
+	HookTemplate() HookTemplate
+	HookTemplateRef() HookTemplateRef
+	EmergencyPublish() EmergencyPublish
+	Environment() Environment
+	EnvAppBinding() EnvAppBinding
+	ConfigShare() ConfigShare
+	ConfigShareRef() ConfigShareRef
+	RecycleBin() RecycleBin
+	AppSnapshot() AppSnapshot
+	Draft() Draft
+	FullTextDoc() FullTextDoc
+	ConfigReference() ConfigReference
+	Certificate() Certificate
+	KvOverride() KvOverride
+	ReleasedKvOverride() ReleasedKvOverride
+	CompliancePolicy() CompliancePolicy
+	SecretScanSetting() SecretScanSetting
+	AppConsumptionStat() AppConsumptionStat
+	StaleResource() StaleResource
+	BizUsage() BizUsage
+	ServiceAccount() ServiceAccount
+	AppRoleBinding() AppRoleBinding
+	IdempotencyRecord() IdempotencyRecord
+	Operation() Operation
+	NotificationChannel() NotificationChannel
+	AlertRule() AlertRule
+	ClientQuarantine() ClientQuarantine
+	Experiment() Experiment
+	ExperimentExposure() ExperimentExposure
+	MaskingProfile() MaskingProfile
+	FileTamperEvent() FileTamperEvent
 }
 
 // NewDaoSet create the DAO set instance.
@@ -90,7 +126,7 @@ func NewDaoSet(opt cc.Sharding, credentialSetting cc.Credential, gormSetting cc.
 		return nil, fmt.Errorf("init sharding failed, err: %v", err)
 	}
 
-	adminDB, err := gorm.Open(mysql.Open(sharding.URI(opt.AdminDatabase)),
+	adminDB, err := gorm.Open(sharding.Dialector(opt.AdminDatabase),
 		&gorm.Config{Logger: logger.Default.LogMode(gormSetting.GetLogLevel())})
 	if err != nil {
 		return nil, err
@@ -113,7 +149,7 @@ func NewDaoSet(opt cc.Sharding, credentialSetting cc.Credential, gormSetting cc.
 			DBName:        opt.AdminDatabase.Database,
 			NamePrefix:    "db",
 			Namespace:     metrics.Namespace,
-			SlowThreshold: 500 * time.Millisecond, // 500ms
+			SlowThreshold: gormSetting.SlowThreshold(),
 		}),
 		query.ErrorQueryCallback(query.Config{
 			DBName:     opt.AdminDatabase.Database,
@@ -133,6 +169,14 @@ func NewDaoSet(opt cc.Sharding, credentialSetting cc.Credential, gormSetting cc.
 
 	metrics.Register().MustRegister(plugin.MetricsCollectors()...)
 
+	// 按表和操作统计耗时, 并上报连接池状态
+	daoMetric := newDaoMetricPlugin(gormSetting.SlowThreshold())
+	if e := adminDB.Use(daoMetric); e != nil {
+		return nil, e
+	}
+	metrics.Register().MustRegister(daoMetric.MetricsCollectors()...)
+	metrics.Register().MustRegister(collectors.NewDBStatsCollector(db, opt.AdminDatabase.Database))
+
 	// auditor 分库, 注意需要在分表前面
 	// auditorDB := sharding.MustShardingAuditor(adminDB)
 
@@ -537,3 +581,279 @@ func (s *set) Config() Config {
 		genQ:     s.genQ,
 	}
 }
+
+// HookExecResult returns the HookExecResult scope's DAO
+func (s *set) HookExecResult() HookExecResult {
+	return &hookExecResultDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// HookTemplate returns the HookTemplate scope's DAO
+func (s *set) HookTemplate() HookTemplate {
+	return &hookTemplateDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// HookTemplateRef returns the HookTemplateRef scope's DAO
+func (s *set) HookTemplateRef() HookTemplateRef {
+	return &hookTemplateRefDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// EmergencyPublish returns the EmergencyPublish scope's DAO
+func (s *set) EmergencyPublish() EmergencyPublish {
+	return &emergencyPublishDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Environment returns the Environment scope's DAO
+func (s *set) Environment() Environment {
+	return &environmentDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// EnvAppBinding returns the EnvAppBinding scope's DAO
+func (s *set) EnvAppBinding() EnvAppBinding {
+	return &envAppBindingDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ConfigShare returns the ConfigShare scope's DAO
+func (s *set) ConfigShare() ConfigShare {
+	return &configShareDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ConfigShareRef returns the ConfigShareRef scope's DAO
+func (s *set) ConfigShareRef() ConfigShareRef {
+	return &configShareRefDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// RecycleBin returns the RecycleBin scope's DAO
+func (s *set) RecycleBin() RecycleBin {
+	return &recycleBinDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// AppSnapshot returns the AppSnapshot scope's DAO
+func (s *set) AppSnapshot() AppSnapshot {
+	return &appSnapshotDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Draft returns the Draft scope's DAO
+func (s *set) Draft() Draft {
+	return &draftDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// FullTextDoc returns the FullTextDoc scope's DAO
+func (s *set) FullTextDoc() FullTextDoc {
+	return &fullTextDocDao{
+		genQ:  s.genQ,
+		idGen: s.idGen,
+	}
+}
+
+// ConfigReference returns the ConfigReference scope's DAO
+func (s *set) ConfigReference() ConfigReference {
+	return &configReferenceDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Certificate returns the Certificate scope's DAO
+func (s *set) Certificate() Certificate {
+	return &certificateDao{
+		genQ:  s.genQ,
+		idGen: s.idGen,
+	}
+}
+
+// KvOverride returns the KvOverride scope's DAO
+func (s *set) KvOverride() KvOverride {
+	return &kvOverrideDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ReleasedKvOverride returns the ReleasedKvOverride scope's DAO
+func (s *set) ReleasedKvOverride() ReleasedKvOverride {
+	return &releasedKvOverrideDao{
+		genQ:  s.genQ,
+		idGen: s.idGen,
+	}
+}
+
+// CompliancePolicy returns the CompliancePolicy scope's DAO
+func (s *set) CompliancePolicy() CompliancePolicy {
+	return &compliancePolicyDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// SecretScanSetting returns the SecretScanSetting scope's DAO
+func (s *set) SecretScanSetting() SecretScanSetting {
+	return &secretScanSettingDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// AppConsumptionStat returns the AppConsumptionStat scope's DAO
+func (s *set) AppConsumptionStat() AppConsumptionStat {
+	return &appConsumptionStatDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// StaleResource returns the StaleResource scope's DAO
+func (s *set) StaleResource() StaleResource {
+	return &staleResourceDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// BizUsage returns the BizUsage scope's DAO
+func (s *set) BizUsage() BizUsage {
+	return &bizUsageDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// ServiceAccount returns the ServiceAccount scope's DAO
+func (s *set) ServiceAccount() ServiceAccount {
+	return &serviceAccountDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// AppRoleBinding returns the AppRoleBinding scope's DAO
+func (s *set) AppRoleBinding() AppRoleBinding {
+	return &appRoleBindingDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// IdempotencyRecord returns the IdempotencyRecord scope's DAO
+func (s *set) IdempotencyRecord() IdempotencyRecord {
+	return &idempotencyRecordDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// Operation returns the Operation scope's DAO
+func (s *set) Operation() Operation {
+	return &operationDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// NotificationChannel returns the NotificationChannel scope's DAO
+func (s *set) NotificationChannel() NotificationChannel {
+	return &notificationChannelDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// AlertRule returns the AlertRule scope's DAO
+func (s *set) AlertRule() AlertRule {
+	return &alertRuleDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ClientQuarantine returns the ClientQuarantine scope's DAO
+func (s *set) ClientQuarantine() ClientQuarantine {
+	return &clientQuarantineDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// Experiment returns the Experiment scope's DAO
+func (s *set) Experiment() Experiment {
+	return &experimentDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// ExperimentExposure returns the ExperimentExposure scope's DAO
+func (s *set) ExperimentExposure() ExperimentExposure {
+	return &experimentExposureDao{
+		idGen: s.idGen,
+		genQ:  s.genQ,
+	}
+}
+
+// MaskingProfile returns the MaskingProfile scope's DAO
+func (s *set) MaskingProfile() MaskingProfile {
+	return &maskingProfileDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
+
+// FileTamperEvent returns the FileTamperEvent scope's DAO
+func (s *set) FileTamperEvent() FileTamperEvent {
+	return &fileTamperEventDao{
+		idGen:    s.idGen,
+		auditDao: s.auditDao,
+		genQ:     s.genQ,
+	}
+}
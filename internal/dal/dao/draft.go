@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// Draft supplies all the user draft related operations, the draft is personal and invisible to
+// others, so it's not audited, the changes are audited when it's merged into the app.
+type Draft interface {
+	// Get the draft of the user in the app.
+	Get(kit *kit.Kit, bizID, appID uint32, owner string) (*table.Draft, error)
+	// Upsert create the draft if not exists, otherwise update its items.
+	Upsert(kit *kit.Kit, draft *table.Draft) error
+	// Delete one draft instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// DeleteWithTx delete one draft instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
+}
+
+var _ Draft = new(draftDao)
+
+type draftDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// Get the draft of the user in the app.
+func (dao *draftDao) Get(kit *kit.Kit, bizID, appID uint32, owner string) (*table.Draft, error) {
+	m := dao.genQ.Draft
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Creator.Eq(owner)).Take()
+}
+
+// Upsert create the draft if not exists, otherwise update its items.
+func (dao *draftDao) Upsert(kit *kit.Kit, draft *table.Draft) error {
+	if draft == nil {
+		return errors.New("draft is nil")
+	}
+
+	if err := draft.ValidateUpsert(kit); err != nil {
+		return err
+	}
+
+	m := dao.genQ.Draft
+	if draft.ID > 0 {
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(draft.Attachment.BizID), m.ID.Eq(draft.ID)).
+			Select(m.Items, m.Reviser, m.UpdatedAt).Updates(draft)
+		return err
+	}
+
+	id, err := dao.idGen.One(kit, table.DraftTable)
+	if err != nil {
+		return err
+	}
+	draft.ID = id
+
+	return m.WithContext(kit.Ctx).Create(draft)
+}
+
+// Delete one draft instance.
+func (dao *draftDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	m := dao.genQ.Draft
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete()
+	return err
+}
+
+// DeleteWithTx delete one draft instance with transaction.
+func (dao *draftDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error {
+	m := tx.Draft
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete()
+	return err
+}
@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// EmergencyPublish supplies all the emergency publish related operations.
+type EmergencyPublish interface {
+	// CreateWithTx create one emergency publish instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ep *table.EmergencyPublish, releaseName string) (uint32, error)
+	// Get emergency publish by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.EmergencyPublish, error)
+	// List emergency publishes of an app, state is optional.
+	List(kit *kit.Kit, bizID, appID uint32, state table.EmergencyPublishState, opt *types.BasePage) (
+		[]*table.EmergencyPublish, int64, error)
+	// ListExpired list the active emergency publishes which are expired before the given time.
+	ListExpired(kit *kit.Kit, before time.Time, limit int) ([]*table.EmergencyPublish, error)
+	// UpdateStateWithTx update an active emergency publish's state with transaction.
+	UpdateStateWithTx(kit *kit.Kit, tx *gen.QueryTx, ep *table.EmergencyPublish,
+		state table.EmergencyPublishState, releaseName string) error
+}
+
+var _ EmergencyPublish = new(emergencyPublishDao)
+
+type emergencyPublishDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// CreateWithTx create one emergency publish instance with transaction.
+func (dao *emergencyPublishDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ep *table.EmergencyPublish,
+	releaseName string) (uint32, error) {
+
+	if ep == nil {
+		return 0, errf.New(errf.InvalidParameter, "emergency publish is nil")
+	}
+
+	if err := ep.ValidateCreate(); err != nil {
+		return 0, errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	id, err := dao.idGen.One(kit, table.EmergencyPublishTable)
+	if err != nil {
+		return 0, err
+	}
+	ep.ID = id
+
+	if err = tx.EmergencyPublish.WithContext(kit.Ctx).Create(ep); err != nil {
+		return 0, err
+	}
+
+	ad := dao.auditDao.Decorator(kit, ep.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EmergencyPublishName, releaseName),
+		Status:           enumor.Success,
+		AppId:            ep.Attachment.AppID,
+		Detail:           ep.Spec.Memo,
+	}).PrepareCreate(ep)
+	if err = ad.Do(tx.Query); err != nil {
+		return 0, fmt.Errorf("audit create emergency publish failed, err: %v", err)
+	}
+
+	return id, nil
+}
+
+// Get emergency publish by id.
+func (dao *emergencyPublishDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.EmergencyPublish, error) {
+	m := dao.genQ.EmergencyPublish
+	return m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+}
+
+// List emergency publishes of an app, state is optional.
+func (dao *emergencyPublishDao) List(kit *kit.Kit, bizID, appID uint32, state table.EmergencyPublishState,
+	opt *types.BasePage) ([]*table.EmergencyPublish, int64, error) {
+
+	if bizID == 0 || appID == 0 {
+		return nil, 0, errf.New(errf.InvalidParameter, "bizID or appID is 0")
+	}
+
+	m := dao.genQ.EmergencyPublish
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID))
+	if state != "" {
+		q = q.Where(m.State.Eq(string(state)))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// ListExpired list the active emergency publishes which are expired before the given time.
+func (dao *emergencyPublishDao) ListExpired(kit *kit.Kit, before time.Time, limit int) (
+	[]*table.EmergencyPublish, error) {
+	m := dao.genQ.EmergencyPublish
+	return m.WithContext(kit.Ctx).
+		Where(m.State.Eq(string(table.EmergencyPublishActive)), m.ExpiredAt.Lte(before)).
+		Order(m.ExpiredAt).
+		Limit(limit).
+		Find()
+}
+
+// UpdateStateWithTx update an active emergency publish's state with transaction.
+func (dao *emergencyPublishDao) UpdateStateWithTx(kit *kit.Kit, tx *gen.QueryTx, ep *table.EmergencyPublish,
+	state table.EmergencyPublishState, releaseName string) error {
+
+	if err := state.Validate(); err != nil {
+		return errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	m := tx.EmergencyPublish
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.ID.Eq(ep.ID), m.BizID.Eq(ep.Attachment.BizID), m.State.Eq(string(table.EmergencyPublishActive))).
+		UpdateSimple(m.State.Value(string(state)), m.Reviser.Value(kit.User))
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return errf.New(errf.InvalidParameter, fmt.Sprintf("emergency publish %d is not active", ep.ID))
+	}
+
+	ad := dao.auditDao.Decorator(kit, ep.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EmergencyPublishName, releaseName),
+		Status:           enumor.Success,
+		AppId:            ep.Attachment.AppID,
+		Detail:           fmt.Sprintf("state: %s", state),
+	}).PrepareUpdate(ep)
+	return ad.Do(tx.Query)
+}
@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// EnvAppBinding supplies all the env app binding related operations.
+type EnvAppBinding interface {
+	// Create one env app binding instance.
+	Create(kit *kit.Kit, b *table.EnvAppBinding) (uint32, error)
+	// DeleteByAppIDWithTx delete the app's env binding with transaction, it's a no-op if the app is not bound.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+	// GetByAppID get the env binding of an app.
+	GetByAppID(kit *kit.Kit, bizID, appID uint32) (*table.EnvAppBinding, error)
+	// GetByAppKey get the env binding of the logical app in an environment.
+	GetByAppKey(kit *kit.Kit, bizID, envID uint32, appKey string) (*table.EnvAppBinding, error)
+	// List env app bindings with options.
+	List(kit *kit.Kit, opt *types.ListEnvAppsOption) ([]*table.EnvAppBinding, int64, error)
+	// CountByEnvID count the apps bound to the environment.
+	CountByEnvID(kit *kit.Kit, bizID, envID uint32) (int64, error)
+}
+
+var _ EnvAppBinding = new(envAppBindingDao)
+
+type envAppBindingDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one env app binding instance.
+func (dao *envAppBindingDao) Create(kit *kit.Kit, b *table.EnvAppBinding) (uint32, error) {
+	if b == nil {
+		return 0, errors.New("env app binding is nil")
+	}
+
+	if err := b.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.EnvAppBindingTable)
+	if err != nil {
+		return 0, err
+	}
+	b.ID = id
+
+	ad := dao.auditDao.Decorator(kit, b.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EnvAppBindingName, b.Spec.AppKey),
+		Status:           enumor.Success,
+		AppId:            b.Attachment.AppID,
+	}).PrepareCreate(b)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.EnvAppBinding.WithContext(kit.Ctx).Create(b); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return b.ID, nil
+}
+
+// DeleteByAppIDWithTx delete the app's env binding with transaction.
+func (dao *envAppBindingDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	if bizID <= 0 || appID <= 0 {
+		return errors.New("biz id and app id should be set")
+	}
+
+	m := tx.EnvAppBinding
+	q := tx.EnvAppBinding.WithContext(kit.Ctx)
+
+	// 服务未绑定环境时直接返回
+	oldOne, err := q.Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EnvAppBindingName, oldOne.Spec.AppKey),
+		Status:           enumor.Success,
+		AppId:            appID,
+	}).PrepareDelete(oldOne)
+
+	if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(oldOne.ID)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// GetByAppID get the env binding of an app.
+func (dao *envAppBindingDao) GetByAppID(kit *kit.Kit, bizID, appID uint32) (*table.EnvAppBinding, error) {
+	m := dao.genQ.EnvAppBinding
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+}
+
+// GetByAppKey get the env binding of the logical app in an environment.
+func (dao *envAppBindingDao) GetByAppKey(kit *kit.Kit, bizID, envID uint32, appKey string) (
+	*table.EnvAppBinding, error) {
+	m := dao.genQ.EnvAppBinding
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.EnvID.Eq(envID), m.AppKey.Eq(appKey)).Take()
+}
+
+// List env app bindings with options.
+func (dao *envAppBindingDao) List(kit *kit.Kit, opt *types.ListEnvAppsOption) (
+	[]*table.EnvAppBinding, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list env apps option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.EnvAppBinding
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID))
+	if opt.EnvID > 0 {
+		q = q.Where(m.EnvID.Eq(opt.EnvID))
+	}
+	if opt.AppID > 0 {
+		q = q.Where(m.AppID.Eq(opt.AppID))
+	}
+	if opt.AppKey != "" {
+		q = q.Where(m.AppKey.Eq(opt.AppKey))
+	}
+
+	q = q.Order(m.AppKey, m.EnvID)
+	if opt.Page.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
+
+// CountByEnvID count the apps bound to the environment.
+func (dao *envAppBindingDao) CountByEnvID(kit *kit.Kit, bizID, envID uint32) (int64, error) {
+	m := dao.genQ.EnvAppBinding
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.EnvID.Eq(envID)).Count()
+}
@@ -0,0 +1,163 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// Environment supplies all the environment related operations.
+type Environment interface {
+	// Create one environment instance.
+	Create(kit *kit.Kit, env *table.Environment) (uint32, error)
+	// Update one environment instance.
+	Update(kit *kit.Kit, env *table.Environment) error
+	// DeleteWithTx delete one environment instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
+	// Get environment by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.Environment, error)
+	// GetByName get environment by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Environment, error)
+	// List all the environments of a biz, which are ordered by position.
+	List(kit *kit.Kit, bizID uint32) ([]*table.Environment, error)
+}
+
+var _ Environment = new(environmentDao)
+
+type environmentDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one environment instance.
+func (dao *environmentDao) Create(kit *kit.Kit, env *table.Environment) (uint32, error) {
+	if env == nil {
+		return 0, errors.New("environment is nil")
+	}
+
+	if err := env.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.EnvironmentTable)
+	if err != nil {
+		return 0, err
+	}
+	env.ID = id
+
+	ad := dao.auditDao.Decorator(kit, env.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EnvironmentName, env.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           env.Spec.Memo,
+	}).PrepareCreate(env)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.Environment.WithContext(kit.Ctx).Create(env); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return env.ID, nil
+}
+
+// Update one environment instance.
+func (dao *environmentDao) Update(kit *kit.Kit, env *table.Environment) error {
+	if env == nil {
+		return errors.New("environment is nil")
+	}
+
+	if err := env.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, env.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EnvironmentName, env.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           env.Spec.Memo,
+	}).PrepareUpdate(env)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.Environment
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(env.Attachment.BizID), m.ID.Eq(env.ID)).
+			Select(m.Name, m.Memo, m.Position, m.Reviser, m.UpdatedAt).Updates(env)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// DeleteWithTx delete one environment instance with transaction.
+func (dao *environmentDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and environment id should be set")
+	}
+
+	m := tx.Environment
+	q := tx.Environment.WithContext(kit.Ctx)
+
+	oldOne, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.EnvironmentName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// Get environment by id.
+func (dao *environmentDao) Get(kit *kit.Kit, bizID, id uint32) (*table.Environment, error) {
+	m := dao.genQ.Environment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get environment by name.
+func (dao *environmentDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.Environment, error) {
+	m := dao.genQ.Environment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List all the environments of a biz, which are ordered by position.
+func (dao *environmentDao) List(kit *kit.Kit, bizID uint32) ([]*table.Environment, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.Environment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.Position, m.ID).Find()
+}
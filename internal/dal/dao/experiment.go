@@ -0,0 +1,188 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// Experiment supplies all the experiment related operations.
+type Experiment interface {
+	// Create one experiment instance.
+	Create(kit *kit.Kit, e *table.Experiment) (uint32, error)
+	// Update one experiment instance.
+	Update(kit *kit.Kit, e *table.Experiment) error
+	// Delete one experiment instance with its exposures.
+	Delete(kit *kit.Kit, bizID, appID, id uint32) error
+	// Get experiment by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.Experiment, error)
+	// GetByKey get experiment by the kv key.
+	GetByKey(kit *kit.Kit, bizID, appID uint32, key string) (*table.Experiment, error)
+	// List all the experiments of an app.
+	List(kit *kit.Kit, bizID, appID uint32) ([]*table.Experiment, error)
+	// ListEnabled list the enabled experiments of an app.
+	ListEnabled(kit *kit.Kit, bizID, appID uint32) ([]*table.Experiment, error)
+}
+
+var _ Experiment = new(experimentDao)
+
+type experimentDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one experiment instance.
+func (dao *experimentDao) Create(kit *kit.Kit, e *table.Experiment) (uint32, error) {
+	if e == nil {
+		return 0, errors.New("experiment is nil")
+	}
+
+	if err := e.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ExperimentTable)
+	if err != nil {
+		return 0, err
+	}
+	e.ID = id
+
+	ad := dao.auditDao.Decorator(kit, e.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ExperimentName, e.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           e.Spec.Memo,
+	}).PrepareCreate(e)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.Experiment.WithContext(kit.Ctx).Create(e); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return e.ID, nil
+}
+
+// Update one experiment instance.
+func (dao *experimentDao) Update(kit *kit.Kit, e *table.Experiment) error {
+	if e == nil {
+		return errors.New("experiment is nil")
+	}
+
+	if err := e.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, e.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ExperimentName, e.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           e.Spec.Memo,
+	}).PrepareUpdate(e)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.Experiment
+		// 实验的 kv 不允许修改, 修改需删除后重新创建
+		_, err := m.WithContext(kit.Ctx).
+			Where(m.BizID.Eq(e.Attachment.BizID), m.AppID.Eq(e.Attachment.AppID), m.ID.Eq(e.ID)).
+			Select(m.Name, m.Selector, m.Variants, m.Enabled, m.Memo, m.Reviser, m.UpdatedAt).
+			Updates(e)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one experiment instance with its exposures.
+func (dao *experimentDao) Delete(kit *kit.Kit, bizID, appID, id uint32) error {
+	if bizID <= 0 || appID <= 0 || id <= 0 {
+		return errors.New("biz id, app id and experiment id should be set")
+	}
+
+	oldOne, err := dao.Get(kit, bizID, appID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ExperimentName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.Experiment
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).
+			Delete(); err != nil {
+			return err
+		}
+
+		ee := tx.ExperimentExposure
+		if _, err := ee.WithContext(kit.Ctx).Where(ee.BizID.Eq(bizID), ee.ExperimentID.Eq(id)).
+			Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get experiment by id.
+func (dao *experimentDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.Experiment, error) {
+	m := dao.genQ.Experiment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// GetByKey get experiment by the kv key.
+func (dao *experimentDao) GetByKey(kit *kit.Kit, bizID, appID uint32, key string) (*table.Experiment, error) {
+	m := dao.genQ.Experiment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Key.Eq(key)).Take()
+}
+
+// List all the experiments of an app.
+func (dao *experimentDao) List(kit *kit.Kit, bizID, appID uint32) ([]*table.Experiment, error) {
+	if bizID <= 0 || appID <= 0 {
+		return nil, errors.New("biz id and app id should be set")
+	}
+
+	m := dao.genQ.Experiment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Order(m.ID).Find()
+}
+
+// ListEnabled list the enabled experiments of an app.
+func (dao *experimentDao) ListEnabled(kit *kit.Kit, bizID, appID uint32) ([]*table.Experiment, error) {
+	if bizID <= 0 || appID <= 0 {
+		return nil, errors.New("biz id and app id should be set")
+	}
+
+	m := dao.genQ.Experiment
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Enabled.Is(true)).
+		Order(m.ID).Find()
+}
@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ExperimentExposure supplies all the experiment exposure related operations.
+type ExperimentExposure interface {
+	// BatchUpsert merge the exposures into the records of the same experiment and client.
+	BatchUpsert(kit *kit.Kit, exposures []*table.ExperimentExposure) error
+	// ListVariantStats list the exposure statistics of each variant of the experiment.
+	ListVariantStats(kit *kit.Kit, bizID, experimentID uint32) ([]*table.ExperimentVariantStat, error)
+}
+
+var _ ExperimentExposure = new(experimentExposureDao)
+
+type experimentExposureDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchUpsert merge the exposures into the records of the same experiment and client.
+// exposures are reported by clients, so no audit is recorded.
+func (dao *experimentExposureDao) BatchUpsert(kit *kit.Kit, exposures []*table.ExperimentExposure) error {
+	if len(exposures) == 0 {
+		return nil
+	}
+
+	for _, one := range exposures {
+		if err := one.Validate(); err != nil {
+			return err
+		}
+	}
+
+	// 已存在的记录不会使用新生成的 id
+	ids, err := dao.idGen.Batch(kit, table.ExperimentExposureTable, len(exposures))
+	if err != nil {
+		return err
+	}
+	for i, one := range exposures {
+		one.ID = ids[i]
+	}
+
+	// 冲突时累加曝光次数, 保留首次曝光时间, 分桶以最后一次上报为准
+	increase := func(col string) clause.Expression {
+		return utils.NewDialectExpr(fmt.Sprintf("%s + VALUES(%s)", col, col),
+			fmt.Sprintf("%s.%s + EXCLUDED.%s", table.ExperimentExposureTable, col, col))
+	}
+	latest := func(col string) clause.Expression {
+		return utils.NewDialectExpr(fmt.Sprintf("VALUES(%s)", col), fmt.Sprintf("EXCLUDED.%s", col))
+	}
+
+	return dao.genQ.ExperimentExposure.WithContext(kit.Ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "experiment_id"}, {Name: "uid"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"count":           increase("count"),
+			"variant":         latest("variant"),
+			"release_id":      latest("release_id"),
+			"last_exposed_at": latest("last_exposed_at"),
+		}),
+	}).CreateInBatches(exposures, 500)
+}
+
+// ListVariantStats list the exposure statistics of each variant of the experiment.
+func (dao *experimentExposureDao) ListVariantStats(kit *kit.Kit, bizID, experimentID uint32) (
+	[]*table.ExperimentVariantStat, error) {
+	if bizID <= 0 || experimentID <= 0 {
+		return nil, errors.New("biz id and experiment id should be set")
+	}
+
+	m := dao.genQ.ExperimentExposure
+	var items []*table.ExperimentVariantStat
+	err := m.WithContext(kit.Ctx).
+		Select(m.Variant, m.ID.Count().As("clients"), m.Count.Sum().As("exposures")).
+		Where(m.BizID.Eq(bizID), m.ExperimentID.Eq(experimentID)).
+		Group(m.Variant).
+		Order(m.Variant).
+		Scan(&items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// FileTamperEvent supplies all the file tamper event related operations.
+type FileTamperEvent interface {
+	// BatchCreate batch create file tamper event instances.
+	BatchCreate(kit *kit.Kit, data []*table.FileTamperEvent) error
+	// List file tamper events with options.
+	List(kit *kit.Kit, opt *types.ListFileTamperEventsOption) ([]*table.FileTamperEvent, int64, error)
+}
+
+var _ FileTamperEvent = new(fileTamperEventDao)
+
+type fileTamperEventDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// BatchCreate batch create file tamper event instances.
+// file tamper events are reported by clients, so no audit is recorded.
+func (dao *fileTamperEventDao) BatchCreate(kit *kit.Kit, data []*table.FileTamperEvent) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	for _, item := range data {
+		if err := item.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.FileTamperEventTable, len(data))
+	if err != nil {
+		return err
+	}
+	for i, item := range data {
+		item.ID = ids[i]
+	}
+
+	return dao.genQ.FileTamperEvent.WithContext(kit.Ctx).CreateInBatches(data, 500)
+}
+
+// List file tamper events with options.
+func (dao *fileTamperEventDao) List(kit *kit.Kit, opt *types.ListFileTamperEventsOption) (
+	[]*table.FileTamperEvent, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list file tamper events option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.FileTamperEvent
+	q := dao.genQ.FileTamperEvent.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID))
+	if opt.ReleaseID != 0 {
+		q = q.Where(m.ReleaseID.Eq(opt.ReleaseID))
+	}
+	if opt.UID != "" {
+		q = q.Where(m.UID.Eq(opt.UID))
+	}
+	if opt.Kind != "" {
+		q = q.Where(m.Kind.Eq(opt.Kind))
+	}
+	if opt.UnrepairedOnly {
+		q = q.Where(m.Repaired.Is(false))
+	}
+
+	d := q.Order(m.DetectedAt.Desc(), m.ID.Desc())
+	if opt.Page.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return d.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
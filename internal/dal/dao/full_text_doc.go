@@ -0,0 +1,172 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// FullTextDoc supplies all the full-text doc related operations, the docs are derived from
+// config items and kvs by the indexer, so it's not audited.
+type FullTextDoc interface {
+	// BatchUpsert create the docs without id, and update the docs with id.
+	BatchUpsert(kit *kit.Kit, docs []*table.FullTextDoc) error
+	// ListByApp list all the docs of the app.
+	ListByApp(kit *kit.Kit, bizID, appID uint32) ([]*table.FullTextDoc, error)
+	// BatchDelete delete docs by ids.
+	BatchDelete(kit *kit.Kit, ids []uint32) error
+	// Search the docs matched the keyword, ordered by relevance.
+	Search(kit *kit.Kit, opt *types.FullTextSearchOption) ([]*table.FullTextDoc, int64, error)
+}
+
+var _ FullTextDoc = new(fullTextDocDao)
+
+type fullTextDocDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchUpsert create the docs without id, and update the docs with id.
+func (dao *fullTextDocDao) BatchUpsert(kit *kit.Kit, docs []*table.FullTextDoc) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	toCreate := make([]*table.FullTextDoc, 0)
+	toUpdate := make([]*table.FullTextDoc, 0)
+	for _, doc := range docs {
+		if err := doc.ValidateUpsert(); err != nil {
+			return err
+		}
+		if doc.ID == 0 {
+			toCreate = append(toCreate, doc)
+		} else {
+			toUpdate = append(toUpdate, doc)
+		}
+	}
+
+	m := dao.genQ.FullTextDoc
+	for _, doc := range toUpdate {
+		if _, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(doc.ID), m.BizID.Eq(doc.Attachment.BizID)).
+			Select(m.Name, m.Memo, m.Content, m.Signature, m.IndexedAt).Updates(doc); err != nil {
+			return err
+		}
+	}
+
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.FullTextDocTable, len(toCreate))
+	if err != nil {
+		return err
+	}
+	for i, doc := range toCreate {
+		doc.ID = ids[i]
+	}
+
+	// 文档内容可能较大，分批写入避免单条 SQL 过大
+	return m.WithContext(kit.Ctx).CreateInBatches(toCreate, 50)
+}
+
+// ListByApp list all the docs of the app.
+func (dao *fullTextDocDao) ListByApp(kit *kit.Kit, bizID, appID uint32) ([]*table.FullTextDoc, error) {
+	m := dao.genQ.FullTextDoc
+	// 仅比对签名，无需查询内容
+	return m.WithContext(kit.Ctx).Select(m.ID, m.ResType, m.ResID, m.Name, m.Memo, m.Signature, m.BizID, m.AppID).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Find()
+}
+
+// BatchDelete delete docs by ids.
+func (dao *fullTextDocDao) BatchDelete(kit *kit.Kit, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m := dao.genQ.FullTextDoc
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Delete()
+	return err
+}
+
+// likeEscaper escapes the wildcards of the like pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// Search the docs matched the keyword, ordered by relevance.
+func (dao *fullTextDocDao) Search(kit *kit.Kit, opt *types.FullTextSearchOption) (
+	[]*table.FullTextDoc, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("full-text search option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.FullTextDoc
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.In(opt.AppIDs...))
+	if opt.ResType != "" {
+		q = q.Where(m.ResType.Eq(string(opt.ResType)))
+	}
+
+	db := q.UnderlyingDB()
+	var order clause.Expression
+	if utils.IsPostgres(db) || utils.IsSQLite(db) {
+		// postgres 的内置分词不支持中文, sqlite 没有全文索引, 使用模糊匹配, 名称命中的排在前面
+		// sqlite 的 LIKE 本身不区分大小写, 但没有默认的转义符
+		like := "ILIKE ?"
+		if utils.IsSQLite(db) {
+			like = `LIKE ? ESCAPE '\'`
+		}
+		pattern := "%" + likeEscaper.Replace(opt.Keyword) + "%"
+		db = db.Where(fmt.Sprintf("(name %s OR memo %s OR content %s)", like, like, like), pattern, pattern, pattern)
+		order = gorm.Expr(fmt.Sprintf("CASE WHEN name %s THEN 0 WHEN memo %s THEN 1 ELSE 2 END", like, like),
+			pattern, pattern)
+	} else {
+		// 关键字按短语检索，避免用户输入被当作 boolean mode 的操作符
+		phrase := `"` + strings.ReplaceAll(opt.Keyword, `"`, " ") + `"`
+		db = db.Where("MATCH(name, memo, content) AGAINST (? IN BOOLEAN MODE)", phrase)
+		order = gorm.Expr("MATCH(name, memo, content) AGAINST (? IN BOOLEAN MODE) DESC", phrase)
+	}
+
+	var count int64
+	if err := db.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return make([]*table.FullTextDoc, 0), 0, nil
+	}
+
+	db = db.Order(order).Order("id")
+	if !opt.Page.All {
+		db = db.Offset(opt.Page.Offset()).Limit(opt.Page.LimitInt())
+	}
+
+	docs := make([]*table.FullTextDoc, 0)
+	if err := db.Find(&docs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return docs, count, nil
+}
@@ -16,7 +16,6 @@ import (
 	"errors"
 	"fmt"
 
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
@@ -126,7 +125,7 @@ func (dao *hookDao) CountNumberUnReferences(kit *kit.Kit, bizID uint32,
 		q = q.Where(h.Name.Like("%" + opt.Name + "%"))
 	}
 	if opt.Tag != "" {
-		q = q.Where(rawgen.Cond(datatypes.JSONArrayQuery("tags").Contains(opt.Tag))...)
+		q = q.Where(rawgen.Cond(utils.JSONArrayContains("tags", opt.Tag))...)
 	} else if opt.NotTag {
 		// when the length of tags is 2, it must be '[]'
 		// It could also be null
@@ -210,7 +209,7 @@ func (dao *hookDao) ListWithRefer(kit *kit.Kit, opt *types.ListHooksWithReferOpt
 		q = q.Where(h.Name.Like("%" + opt.Name + "%"))
 	}
 	if opt.Tag != "" {
-		q = q.Where(rawgen.Cond(datatypes.JSONArrayQuery("tags").Contains(opt.Tag))...)
+		q = q.Where(rawgen.Cond(utils.JSONArrayContains("tags", opt.Tag))...)
 	} else if opt.NotTag {
 		// when the length of tags is 2, it must be '[]'
 		// It could also be null
@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// HookExecResult supplies all the hook exec result related operations.
+type HookExecResult interface {
+	// BatchCreate batch create hook exec result instances.
+	BatchCreate(kit *kit.Kit, data []*table.HookExecResult) error
+	// List hook exec results with options.
+	List(kit *kit.Kit, opt *types.ListHookExecResultsOption) ([]*table.HookExecResult, int64, error)
+}
+
+var _ HookExecResult = new(hookExecResultDao)
+
+type hookExecResultDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// BatchCreate batch create hook exec result instances.
+// hook exec results are reported by clients, so no audit is recorded.
+func (dao *hookExecResultDao) BatchCreate(kit *kit.Kit, data []*table.HookExecResult) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	// 客户端上报的结果中个别无效时只丢弃该条, 不影响同批次的其他结果
+	valid := make([]*table.HookExecResult, 0, len(data))
+	for _, item := range data {
+		if err := item.ValidateCreate(); err != nil {
+			logs.Warnf("drop invalid hook exec result, err: %v, rid: %s", err, kit.Rid)
+			continue
+		}
+		valid = append(valid, item)
+	}
+	if len(valid) == 0 {
+		return nil
+	}
+	data = valid
+
+	ids, err := dao.idGen.Batch(kit, table.HookExecResultTable, len(data))
+	if err != nil {
+		return err
+	}
+	for i, item := range data {
+		item.ID = ids[i]
+	}
+
+	return dao.genQ.HookExecResult.WithContext(kit.Ctx).CreateInBatches(data, 500)
+}
+
+// List hook exec results with options.
+func (dao *hookExecResultDao) List(kit *kit.Kit, opt *types.ListHookExecResultsOption) (
+	[]*table.HookExecResult, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list hook exec results option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.HookExecResult
+	q := dao.genQ.HookExecResult.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID))
+	if opt.AppID != 0 {
+		q = q.Where(m.AppID.Eq(opt.AppID))
+	} else {
+		q = q.Where(m.AppID.In(opt.AppIDs...))
+	}
+
+	if opt.ReleaseID != 0 {
+		q = q.Where(m.ReleaseID.Eq(opt.ReleaseID))
+	}
+	if opt.UID != "" {
+		q = q.Where(m.UID.Eq(opt.UID))
+	}
+	if opt.HookType != "" {
+		q = q.Where(m.HookType.Eq(opt.HookType))
+	}
+	if opt.FailedOnly {
+		q = q.Where(m.ExitCode.Neq(0))
+	}
+
+	d := q.Order(m.ExecutedAt.Desc(), m.ID.Desc())
+	if opt.Page.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return d.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// HookTemplate supplies all the hook template related operations.
+type HookTemplate interface {
+	// Create one hook template instance.
+	Create(kit *kit.Kit, tpl *table.HookTemplate) (uint32, error)
+	// UpdateWithTx update one hook template instance with transaction.
+	UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, tpl *table.HookTemplate) error
+	// DeleteWithTx delete one hook template instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
+	// Get hook template by id, the built-in hook templates can be got by any biz.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.HookTemplate, error)
+	// GetByName get the biz's hook template by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.HookTemplate, error)
+	// List hook templates with options.
+	List(kit *kit.Kit, opt *types.ListHookTemplatesOption) ([]*table.HookTemplate, int64, error)
+}
+
+var _ HookTemplate = new(hookTemplateDao)
+
+type hookTemplateDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one hook template instance.
+func (dao *hookTemplateDao) Create(kit *kit.Kit, tpl *table.HookTemplate) (uint32, error) {
+	if tpl == nil {
+		return 0, errors.New("hook template is nil")
+	}
+
+	if err := tpl.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.HookTemplateTable)
+	if err != nil {
+		return 0, err
+	}
+	tpl.ID = id
+
+	ad := dao.auditDao.Decorator(kit, tpl.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.HookTemplateName, tpl.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           tpl.Spec.Memo,
+	}).PrepareCreate(tpl)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.HookTemplate.WithContext(kit.Ctx).Create(tpl); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return tpl.ID, nil
+}
+
+// UpdateWithTx update one hook template instance with transaction.
+func (dao *hookTemplateDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, tpl *table.HookTemplate) error {
+	if tpl == nil {
+		return errors.New("hook template is nil")
+	}
+
+	if err := tpl.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	m := tx.HookTemplate
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(tpl.Attachment.BizID), m.ID.Eq(tpl.ID)).
+		Select(m.Type, m.Content, m.Params, m.Memo, m.Version, m.Reviser, m.UpdatedAt).Updates(tpl)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, tpl.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.HookTemplateName, tpl.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           tpl.Spec.Memo,
+	}).PrepareUpdate(tpl)
+
+	return ad.Do(tx.Query)
+}
+
+// DeleteWithTx delete one hook template instance with transaction.
+func (dao *hookTemplateDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and hook template id should be set")
+	}
+
+	m := tx.HookTemplate
+	q := tx.HookTemplate.WithContext(kit.Ctx)
+
+	oldOne, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.HookTemplateName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	if _, err := q.Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// Get hook template by id, the built-in hook templates can be got by any biz.
+func (dao *hookTemplateDao) Get(kit *kit.Kit, bizID, id uint32) (*table.HookTemplate, error) {
+	m := dao.genQ.HookTemplate
+
+	return m.WithContext(kit.Ctx).
+		Where(m.BizID.In(bizID, table.BuiltinHookTemplateBizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get the biz's hook template by name.
+func (dao *hookTemplateDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.HookTemplate, error) {
+	m := dao.genQ.HookTemplate
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List hook templates with options.
+func (dao *hookTemplateDao) List(kit *kit.Kit, opt *types.ListHookTemplatesOption) (
+	[]*table.HookTemplate, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list hook templates option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.HookTemplate
+	q := dao.genQ.HookTemplate.WithContext(kit.Ctx)
+
+	if opt.WithBuiltin {
+		q = q.Where(m.BizID.In(opt.BizID, table.BuiltinHookTemplateBizID))
+	} else {
+		q = q.Where(m.BizID.Eq(opt.BizID))
+	}
+
+	if opt.Name != "" {
+		q = q.Where(m.Name.Like("%" + opt.Name + "%"))
+	}
+
+	// 内置模板排在前面
+	d := q.Order(m.BizID, m.ID.Desc())
+	if opt.Page.All {
+		result, err := d.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return d.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// HookTemplateRef supplies all the hook template ref related operations.
+type HookTemplateRef interface {
+	// CreateWithTx create one hook template ref instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ref *table.HookTemplateRef) (uint32, error)
+	// UpdateWithTx update one hook template ref instance with transaction.
+	UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, ref *table.HookTemplateRef) error
+	// GetByHookID get the hook template ref of a hook.
+	GetByHookID(kit *kit.Kit, bizID, hookID uint32) (*table.HookTemplateRef, error)
+	// ListByTemplateID list all the refs of a hook template.
+	ListByTemplateID(kit *kit.Kit, templateID uint32) ([]*table.HookTemplateRef, error)
+	// CountByTemplateID count the refs of a hook template.
+	CountByTemplateID(kit *kit.Kit, templateID uint32) (int64, error)
+	// DeleteByHookIDWithTx delete the hook template ref of a hook with transaction.
+	DeleteByHookIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, hookID uint32) error
+}
+
+var _ HookTemplateRef = new(hookTemplateRefDao)
+
+type hookTemplateRefDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// CreateWithTx create one hook template ref instance with transaction.
+func (dao *hookTemplateRefDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, ref *table.HookTemplateRef) (
+	uint32, error) {
+
+	if ref == nil {
+		return 0, errors.New("hook template ref is nil")
+	}
+
+	if err := ref.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.HookTemplateRefTable)
+	if err != nil {
+		return 0, err
+	}
+	ref.ID = id
+
+	if err := tx.HookTemplateRef.WithContext(kit.Ctx).Create(ref); err != nil {
+		return 0, err
+	}
+
+	return ref.ID, nil
+}
+
+// UpdateWithTx update one hook template ref instance with transaction.
+func (dao *hookTemplateRefDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, ref *table.HookTemplateRef) error {
+	if ref == nil || ref.ID <= 0 {
+		return errors.New("hook template ref id should be set")
+	}
+
+	m := tx.HookTemplateRef
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(ref.ID)).
+		Select(m.Args, m.TemplateVersion, m.AutoUpgrade, m.Reviser, m.UpdatedAt).Updates(ref)
+	return err
+}
+
+// GetByHookID get the hook template ref of a hook.
+func (dao *hookTemplateRefDao) GetByHookID(kit *kit.Kit, bizID, hookID uint32) (*table.HookTemplateRef, error) {
+	m := dao.genQ.HookTemplateRef
+
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.HookID.Eq(hookID)).Take()
+}
+
+// ListByTemplateID list all the refs of a hook template.
+func (dao *hookTemplateRefDao) ListByTemplateID(kit *kit.Kit, templateID uint32) (
+	[]*table.HookTemplateRef, error) {
+	m := dao.genQ.HookTemplateRef
+
+	return m.WithContext(kit.Ctx).Where(m.TemplateID.Eq(templateID)).Find()
+}
+
+// CountByTemplateID count the refs of a hook template.
+func (dao *hookTemplateRefDao) CountByTemplateID(kit *kit.Kit, templateID uint32) (int64, error) {
+	m := dao.genQ.HookTemplateRef
+
+	return m.WithContext(kit.Ctx).Where(m.TemplateID.Eq(templateID)).Count()
+}
+
+// DeleteByHookIDWithTx delete the hook template ref of a hook with transaction.
+func (dao *hookTemplateRefDao) DeleteByHookIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, hookID uint32) error {
+	m := tx.HookTemplateRef
+
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.HookID.Eq(hookID)).Delete()
+	return err
+}
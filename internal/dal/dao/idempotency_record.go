@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// IdempotencyRecord supplies all the idempotency record related operations.
+type IdempotencyRecord interface {
+	// Create one idempotency record, returns error if the key is already recorded.
+	Create(kit *kit.Kit, r *table.IdempotencyRecord) (uint32, error)
+	// GetByKey get the idempotency record by the key of the user.
+	GetByKey(kit *kit.Kit, bizID uint32, user, key string) (*table.IdempotencyRecord, error)
+	// Complete mark the idempotency record as completed and save the response.
+	Complete(kit *kit.Kit, id uint32, response []byte) error
+	// Delete one idempotency record.
+	Delete(kit *kit.Kit, id uint32) error
+	// DeleteExpired delete the idempotency records which are expired before the given time.
+	DeleteExpired(kit *kit.Kit, before time.Time, limit int) (int64, error)
+}
+
+var _ IdempotencyRecord = new(idempotencyRecordDao)
+
+type idempotencyRecordDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// Create one idempotency record, returns error if the key is already recorded.
+func (dao *idempotencyRecordDao) Create(kit *kit.Kit, r *table.IdempotencyRecord) (uint32, error) {
+	if r == nil {
+		return 0, errors.New("idempotency record is nil")
+	}
+
+	if err := r.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.IdempotencyRecordTable)
+	if err != nil {
+		return 0, err
+	}
+	r.ID = id
+
+	// 幂等记录不记录审计, 依赖 biz_id/user/key 的唯一索引保证并发请求只有一个能创建成功
+	if err := dao.genQ.IdempotencyRecord.WithContext(kit.Ctx).Create(r); err != nil {
+		return 0, err
+	}
+
+	return r.ID, nil
+}
+
+// GetByKey get the idempotency record by the key of the user.
+func (dao *idempotencyRecordDao) GetByKey(kit *kit.Kit, bizID uint32, user, key string) (
+	*table.IdempotencyRecord, error) {
+	m := dao.genQ.IdempotencyRecord
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.User.Eq(user), m.Key.Eq(key)).Take()
+}
+
+// Complete mark the idempotency record as completed and save the response.
+func (dao *idempotencyRecordDao) Complete(kit *kit.Kit, id uint32, response []byte) error {
+	if id <= 0 {
+		return errors.New("idempotency record id should be set")
+	}
+
+	m := dao.genQ.IdempotencyRecord
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(id)).UpdateSimple(
+		m.State.Value(string(table.IdempotencyCompleted)),
+		m.Response.Value(response),
+		m.Reviser.Value(kit.User),
+		m.UpdatedAt.Value(time.Now().UTC()),
+	)
+	return err
+}
+
+// Delete one idempotency record.
+func (dao *idempotencyRecordDao) Delete(kit *kit.Kit, id uint32) error {
+	if id <= 0 {
+		return errors.New("idempotency record id should be set")
+	}
+
+	m := dao.genQ.IdempotencyRecord
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(id)).Delete()
+	return err
+}
+
+// DeleteExpired delete the idempotency records which are expired before the given time.
+func (dao *idempotencyRecordDao) DeleteExpired(kit *kit.Kit, before time.Time, limit int) (int64, error) {
+	m := dao.genQ.IdempotencyRecord
+	result, err := m.WithContext(kit.Ctx).Where(m.ExpiredAt.Lte(before)).Limit(limit).Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
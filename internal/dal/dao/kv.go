@@ -34,8 +34,13 @@ type Kv interface {
 	Create(kit *kit.Kit, kv *table.Kv) (uint32, error)
 	// Update one kv's info
 	Update(kit *kit.Kit, kv *table.Kv) error
+	// UpdateWithRevision update one kv's info only if it's not modified since the given update time,
+	// returns ErrRevisionConflict otherwise.
+	UpdateWithRevision(kit *kit.Kit, kv *table.Kv, updatedAt time.Time) error
 	// List kv with options.
 	List(kit *kit.Kit, opt *types.ListKvOption) ([]*table.Kv, int64, error)
+	// ListWithCursor list editing kvs of the app with cursor page.
+	ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) ([]*table.Kv, string, error)
 	// ListAllKvByKey lists all key-value pairs based on keys
 	ListAllKvByKey(kit *kit.Kit, appID uint32, bizID uint32, keys []string, kvState []string) ([]*table.Kv, error)
 	// Delete ..
@@ -96,9 +101,10 @@ func (dao *kvDao) FindNearExpiryCertKvs(kit *kit.Kit, bizID uint32, appID uint32
 		string(table.KvStateRevise),
 	}
 
-	q := dao.genQ.Kv.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID),
-		m.SecretType.Eq(string(table.SecretTypeCertificate)),
-		m.KvState.In(kvStateArr...))
+	// 证书类型的密钥和证书包类型的kv
+	q := dao.genQ.Kv.WithContext(kit.Ctx)
+	q = q.Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.KvState.In(kvStateArr...),
+		q.Where(m.SecretType.Eq(string(table.SecretTypeCertificate))).Or(m.KvType.Eq(string(table.KvCertBundle))))
 
 	// 已过期（小于等于当前时间）
 	if days == 0 {
@@ -215,7 +221,8 @@ func (dao *kvDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.Kv) erro
 	}).PrepareUpdate(kv)
 
 	_, err := q.Where(m.BizID.Eq(kv.Attachment.BizID), m.ID.Eq(kv.ID)).Select(m.Version, m.UpdatedAt,
-		m.Reviser, m.KvState, m.Signature, m.Md5, m.ByteSize, m.CertificateExpirationDate).Updates(kv)
+		m.Reviser, m.KvState, m.Signature, m.Md5, m.ByteSize, m.Memo, m.SecretHidden, m.CertificateExpirationDate).
+		Updates(kv)
 	if err != nil {
 		return err
 	}
@@ -266,6 +273,15 @@ func (dao *kvDao) Create(kit *kit.Kit, kv *table.Kv) (uint32, error) {
 
 // Update one kv's info
 func (dao *kvDao) Update(kit *kit.Kit, kv *table.Kv) error {
+	return dao.update(kit, kv, nil)
+}
+
+// UpdateWithRevision update one kv's info only if it's not modified since the given update time.
+func (dao *kvDao) UpdateWithRevision(kit *kit.Kit, kv *table.Kv, updatedAt time.Time) error {
+	return dao.update(kit, kv, &updatedAt)
+}
+
+func (dao *kvDao) update(kit *kit.Kit, kv *table.Kv, updatedAt *time.Time) error {
 
 	if err := kv.ValidateUpdate(); err != nil {
 		return err
@@ -284,13 +300,20 @@ func (dao *kvDao) Update(kit *kit.Kit, kv *table.Kv) error {
 
 	// 多个使用事务处理
 	updateTx := func(tx *gen.Query) error {
-		q = tx.Kv.WithContext(kit.Ctx)
-		if _, e := q.Where(m.BizID.Eq(kv.Attachment.BizID), m.ID.Eq(kv.ID)).
-			Select(m.Version, m.UpdatedAt, m.Reviser, m.KvState, m.Signature, m.Md5, m.ByteSize,
-				m.Memo, m.SecretHidden, m.CertificateExpirationDate).
-			Updates(kv); e != nil {
+		q = tx.Kv.WithContext(kit.Ctx).Where(m.BizID.Eq(kv.Attachment.BizID), m.ID.Eq(kv.ID))
+		// 乐观锁: 更新时间未变化时才更新, 读取与更新之间被他人修改时不影响任何行
+		if updatedAt != nil {
+			q = q.Where(m.UpdatedAt.Eq(*updatedAt))
+		}
+		result, e := q.Select(m.Version, m.UpdatedAt, m.Reviser, m.KvState, m.Signature, m.Md5, m.ByteSize,
+			m.Memo, m.SecretHidden, m.CertificateExpirationDate, m.ExpiredAt, m.ExpiredFallback).
+			Updates(kv)
+		if e != nil {
 			return e
 		}
+		if updatedAt != nil && result.RowsAffected == 0 {
+			return ErrRevisionConflict
+		}
 
 		var prepare AuditDo
 		if kv.KvState == table.KvStateDelete {
@@ -641,3 +664,22 @@ func (dao *kvDao) GetCount(kit *kit.Kit, bizID uint32, appId []uint32) ([]*table
 
 	return configItem, nil
 }
+
+// ListWithCursor list editing kvs of the app with cursor page.
+func (dao *kvDao) ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) (
+	[]*table.Kv, string, error) {
+	m := dao.genQ.Kv
+	conds, orders, err := cursorQuery(&m, opt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 多查询一条用于判断是否存在下一页
+	result, err := dao.genQ.Kv.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Where(conds...).
+		Order(orders...).Limit(int(opt.Limit) + 1).Find()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return nextCursorPage(result, opt)
+}
@@ -0,0 +1,249 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// KvOverride supplies all the kv override related operations.
+type KvOverride interface {
+	// CreateWithTx create one kv override instance with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) (uint32, error)
+	// UpdateWithTx update one kv override instance with transaction.
+	UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) error
+	// DeleteWithTx delete one kv override instance with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) error
+	// Get kv override by id.
+	Get(kit *kit.Kit, bizID, appID, id uint32) (*table.KvOverride, error)
+	// ListByKey list the overrides of the kv, ordered by priority.
+	ListByKey(kit *kit.Kit, bizID, appID uint32, key string) ([]*table.KvOverride, error)
+	// ListAllByAppID list all the kv overrides of the app.
+	ListAllByAppID(kit *kit.Kit, bizID, appID uint32) ([]*table.KvOverride, error)
+	// ListByGroupID list the kv overrides which use the group.
+	ListByGroupID(kit *kit.Kit, bizID, groupID uint32) ([]*table.KvOverride, error)
+	// DeleteByKeyWithTx delete all the overrides of the kv with transaction.
+	DeleteByKeyWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32, key string) error
+	// DeleteByAppIDWithTx delete all the kv overrides of the app with transaction.
+	DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error
+}
+
+var _ KvOverride = new(kvOverrideDao)
+
+type kvOverrideDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// CreateWithTx create one kv override instance with transaction.
+func (dao *kvOverrideDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) (uint32, error) {
+	if kv == nil {
+		return 0, errors.New("kv override is nil")
+	}
+
+	if err := kv.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.KvOverrideTable)
+	if err != nil {
+		return 0, err
+	}
+	kv.ID = id
+
+	ad := dao.auditDao.Decorator(kit, kv.Attachment.BizID, &table.AuditField{
+		ResourceInstance: kvOverrideInstance(kv),
+		Status:           enumor.Success,
+		AppId:            kv.Attachment.AppID,
+	}).PrepareCreate(kv)
+
+	if err := tx.KvOverride.WithContext(kit.Ctx).Create(kv); err != nil {
+		return 0, err
+	}
+
+	if err := ad.Do(tx.Query); err != nil {
+		return 0, err
+	}
+
+	return kv.ID, nil
+}
+
+// UpdateWithTx update one kv override instance with transaction.
+func (dao *kvOverrideDao) UpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) error {
+	if kv == nil {
+		return errors.New("kv override is nil")
+	}
+
+	if err := kv.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	m := tx.KvOverride
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(kv.Attachment.BizID), m.AppID.Eq(kv.Attachment.AppID),
+		m.ID.Eq(kv.ID)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, kv.Attachment.BizID, &table.AuditField{
+		ResourceInstance: kvOverrideInstance(oldOne),
+		Status:           enumor.Success,
+		AppId:            kv.Attachment.AppID,
+	}).PrepareUpdate(kv)
+
+	if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(kv.Attachment.BizID), m.ID.Eq(kv.ID)).
+		Select(m.Priority, m.Version, m.Memo, m.Signature, m.ByteSize, m.Md5, m.Reviser).Updates(kv); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// DeleteWithTx delete one kv override instance with transaction.
+func (dao *kvOverrideDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, kv *table.KvOverride) error {
+	if kv == nil || kv.ID <= 0 || kv.Attachment == nil {
+		return errors.New("kv override id and attachment should be set")
+	}
+
+	ad := dao.auditDao.Decorator(kit, kv.Attachment.BizID, &table.AuditField{
+		ResourceInstance: kvOverrideInstance(kv),
+		Status:           enumor.Success,
+		AppId:            kv.Attachment.AppID,
+	}).PrepareDelete(kv)
+
+	m := tx.KvOverride
+	if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(kv.Attachment.BizID), m.ID.Eq(kv.ID)).Delete(); err != nil {
+		return err
+	}
+
+	return ad.Do(tx.Query)
+}
+
+// Get kv override by id.
+func (dao *kvOverrideDao) Get(kit *kit.Kit, bizID, appID, id uint32) (*table.KvOverride, error) {
+	m := dao.genQ.KvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Eq(id)).Take()
+}
+
+// ListByKey list the overrides of the kv, ordered by priority.
+func (dao *kvOverrideDao) ListByKey(kit *kit.Kit, bizID, appID uint32, key string) ([]*table.KvOverride, error) {
+	m := dao.genQ.KvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Key.Eq(key)).
+		Order(m.Priority, m.ID).Find()
+}
+
+// ListAllByAppID list all the kv overrides of the app.
+func (dao *kvOverrideDao) ListAllByAppID(kit *kit.Kit, bizID, appID uint32) ([]*table.KvOverride, error) {
+	m := dao.genQ.KvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).
+		Order(m.Key, m.Priority, m.ID).Find()
+}
+
+// ListByGroupID list the kv overrides which use the group.
+func (dao *kvOverrideDao) ListByGroupID(kit *kit.Kit, bizID, groupID uint32) ([]*table.KvOverride, error) {
+	m := dao.genQ.KvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.GroupID.Eq(groupID)).Find()
+}
+
+// DeleteByKeyWithTx delete all the overrides of the kv with transaction.
+func (dao *kvOverrideDao) DeleteByKeyWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32, key string) error {
+	m := tx.KvOverride
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Key.Eq(key)).Delete()
+	return err
+}
+
+// DeleteByAppIDWithTx delete all the kv overrides of the app with transaction.
+func (dao *kvOverrideDao) DeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID uint32) error {
+	m := tx.KvOverride
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Delete()
+	return err
+}
+
+// kvOverrideInstance returns the audit resource instance of the kv override.
+func kvOverrideInstance(kv *table.KvOverride) string {
+	return fmt.Sprintf(constant.KvOverrideName, kv.Spec.Key, kv.Spec.GroupID)
+}
+
+// ReleasedKvOverride supplies all the released kv override related operations.
+type ReleasedKvOverride interface {
+	// BulkCreateWithTx bulk create released kv overrides with transaction.
+	BulkCreateWithTx(kit *kit.Kit, tx *gen.QueryTx, kvs []*table.ReleasedKvOverride) error
+	// Get released kv override of the group.
+	Get(kit *kit.Kit, bizID, appID, releaseID, groupID uint32, key string) (*table.ReleasedKvOverride, error)
+	// ListAllByReleaseIDs batch list released kv overrides by release ids.
+	ListAllByReleaseIDs(kit *kit.Kit, releaseIDs []uint32, bizID uint32) ([]*table.ReleasedKvOverride, error)
+}
+
+var _ ReleasedKvOverride = new(releasedKvOverrideDao)
+
+type releasedKvOverrideDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BulkCreateWithTx bulk create released kv overrides with transaction.
+func (dao *releasedKvOverrideDao) BulkCreateWithTx(kit *kit.Kit, tx *gen.QueryTx,
+	kvs []*table.ReleasedKvOverride) error {
+
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	for _, kv := range kvs {
+		if err := kv.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.ReleasedKvOverrideTable, len(kvs))
+	if err != nil {
+		return err
+	}
+
+	for idx, kv := range kvs {
+		kv.ID = ids[idx]
+	}
+
+	if err := tx.ReleasedKvOverride.WithContext(kit.Ctx).CreateInBatches(kvs, 100); err != nil {
+		return fmt.Errorf("create released kv overrides in batch failed, err: %v", err)
+	}
+
+	return nil
+}
+
+// Get released kv override of the group.
+func (dao *releasedKvOverrideDao) Get(kit *kit.Kit, bizID, appID, releaseID, groupID uint32, key string) (
+	*table.ReleasedKvOverride, error) {
+	m := dao.genQ.ReleasedKvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID),
+		m.GroupID.Eq(groupID), m.Key.Eq(key)).Take()
+}
+
+// ListAllByReleaseIDs batch list released kv overrides by release ids.
+func (dao *releasedKvOverrideDao) ListAllByReleaseIDs(kit *kit.Kit, releaseIDs []uint32, bizID uint32) (
+	[]*table.ReleasedKvOverride, error) {
+	if bizID == 0 {
+		return nil, errors.New("biz id can not be 0")
+	}
+
+	m := dao.genQ.ReleasedKvOverride
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ReleaseID.In(releaseIDs...)).
+		Order(m.Priority, m.ID).Find()
+}
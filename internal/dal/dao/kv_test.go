@@ -0,0 +1,117 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// nopAudit does not record the audits.
+type nopAudit struct {
+	AuditDao
+}
+
+func (nopAudit) Decorator(_ *kit.Kit, _ uint32, _ *table.AuditField) AuditPrepare {
+	return nopAuditDo{}
+}
+
+type nopAuditDo struct{}
+
+func (nopAuditDo) PrepareCreate(AuditRes) AuditDo  { return nopAuditDo{} }
+func (nopAuditDo) PrepareUpdate(AuditRes) AuditDo  { return nopAuditDo{} }
+func (nopAuditDo) PrepareDelete(AuditRes) AuditDo  { return nopAuditDo{} }
+func (nopAuditDo) PreparePublish(AuditRes) AuditDo { return nopAuditDo{} }
+func (nopAuditDo) Do(*gen.Query) error             { return nil }
+func (nopAuditDo) GetAuditID() uint32              { return 0 }
+
+func TestKvUpdateWithRevision(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "bscp.db")), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// sqlite 同时只允许一个写入
+	sqlDB.SetMaxOpenConns(1)
+	require.NoError(t, db.Exec("CREATE TABLE kvs (id INTEGER PRIMARY KEY, kv_state VARCHAR(20), key VARCHAR(255), "+
+		"memo VARCHAR(255), kv_type VARCHAR(20), version INTEGER, secret_type VARCHAR(20), secret_hidden BOOLEAN, "+
+		"certificate_expiration_date DATETIME, expired_at DATETIME, expired_fallback TEXT, biz_id INTEGER, "+
+		"app_id INTEGER, creator VARCHAR(64), reviser VARCHAR(64), created_at DATETIME, updated_at DATETIME, "+
+		"signature VARCHAR(64), byte_size INTEGER, md5 VARCHAR(64))").Error)
+
+	require.NoError(t, db.Create(&table.Kv{
+		ID:          1,
+		KvState:     table.KvStateAdd,
+		Spec:        &table.KvSpec{Key: "key", KvType: table.KvStr},
+		Attachment:  &table.KvAttachment{BizID: 2, AppID: 3},
+		Revision:    &table.Revision{Creator: "admin", Reviser: "admin"},
+		ContentSpec: &table.ContentSpec{},
+	}).Error)
+
+	d := &kvDao{genQ: gen.Use(db), auditDao: nopAudit{}}
+	kt := kit.New()
+	read, err := d.GetByID(kt, 2, 3, 1)
+	require.NoError(t, err)
+
+	// 多个编辑者基于同一次读取并发更新, 只有一个能成功
+	editors := []string{"alice", "bob", "carol", "dave", "eve"}
+	errs := make([]error, len(editors))
+	var wg sync.WaitGroup
+	for i, editor := range editors {
+		wg.Add(1)
+		go func(i int, editor string) {
+			defer wg.Done()
+			kv := &table.Kv{
+				ID:          read.ID,
+				KvState:     table.KvStateRevise,
+				Spec:        &table.KvSpec{Key: read.Spec.Key, KvType: read.Spec.KvType, Version: uint32(i + 1)},
+				Attachment:  read.Attachment,
+				Revision:    &table.Revision{Reviser: editor, UpdatedAt: time.Now().UTC()},
+				ContentSpec: &table.ContentSpec{},
+			}
+			errs[i] = d.UpdateWithRevision(kt, kv, read.Revision.UpdatedAt)
+		}(i, editor)
+	}
+	wg.Wait()
+
+	var succeeded string
+	for i, err := range errs {
+		if err == nil {
+			assert.Empty(t, succeeded, "only one editor should succeed")
+			succeeded = editors[i]
+			continue
+		}
+		assert.True(t, errors.Is(err, ErrRevisionConflict), "unexpected error: %v", err)
+	}
+	require.NotEmpty(t, succeeded)
+
+	latest, err := d.GetByID(kt, 2, 3, 1)
+	require.NoError(t, err)
+	assert.Equal(t, succeeded, latest.Revision.Reviser)
+
+	// 基于最新的读取可以继续更新
+	readAt := latest.Revision.UpdatedAt
+	latest.Revision = &table.Revision{Reviser: "admin", UpdatedAt: time.Now().UTC()}
+	assert.NoError(t, d.UpdateWithRevision(kt, latest, readAt))
+}
@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaskingProfile supplies all the masking profile related operations.
+type MaskingProfile interface {
+	// Create one masking profile instance.
+	Create(kit *kit.Kit, m *table.MaskingProfile) (uint32, error)
+	// Update one masking profile instance.
+	Update(kit *kit.Kit, m *table.MaskingProfile) error
+	// Get masking profile by app id.
+	Get(kit *kit.Kit, bizID, appID uint32) (*table.MaskingProfile, error)
+}
+
+var _ MaskingProfile = new(maskingProfileDao)
+
+type maskingProfileDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one masking profile instance.
+func (dao *maskingProfileDao) Create(kit *kit.Kit, m *table.MaskingProfile) (uint32, error) {
+	if m == nil {
+		return 0, errors.New("masking profile is nil")
+	}
+
+	if err := m.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.MaskingProfileTable)
+	if err != nil {
+		return 0, err
+	}
+	m.ID = id
+
+	ad := dao.auditDao.Decorator(kit, m.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.MaskingProfileAppID, m.Attachment.AppID),
+		Status:           enumor.Success,
+	}).PrepareCreate(m)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.MaskingProfile.WithContext(kit.Ctx).Create(m); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return m.ID, nil
+}
+
+// Update one masking profile instance.
+func (dao *maskingProfileDao) Update(kit *kit.Kit, m *table.MaskingProfile) error {
+	if m == nil {
+		return errors.New("masking profile is nil")
+	}
+
+	if err := m.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, m.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.MaskingProfileAppID, m.Attachment.AppID),
+		Status:           enumor.Success,
+	}).PrepareUpdate(m)
+
+	updateTx := func(tx *gen.Query) error {
+		q := tx.MaskingProfile
+		_, err := q.WithContext(kit.Ctx).Where(q.BizID.Eq(m.Attachment.BizID), q.ID.Eq(m.ID)).
+			Select(q.Enabled, q.Rules, q.Reviser, q.UpdatedAt).
+			Updates(m)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Get masking profile by app id.
+func (dao *maskingProfileDao) Get(kit *kit.Kit, bizID, appID uint32) (*table.MaskingProfile, error) {
+	m := dao.genQ.MaskingProfile
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Take()
+}
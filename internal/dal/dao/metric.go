@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+)
+
+// daoStartTimeKey is the key to save the start time of the statement in gorm instance.
+const daoStartTimeKey = "bscp:dao_start_time"
+
+// daoMetricPlugin is a gorm plugin which records the latency of each table and operation, and counts
+// the slow queries, so that the pressure on the database can be observed.
+type daoMetricPlugin struct {
+	slowThreshold time.Duration
+	latency       *prometheus.HistogramVec
+	slowCounter   *prometheus.CounterVec
+}
+
+func newDaoMetricPlugin(slowThreshold time.Duration) *daoMetricPlugin {
+	p := &daoMetricPlugin{slowThreshold: slowThreshold}
+
+	p.latency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.DaoSubSys,
+		Name:      "query_duration_seconds",
+		Help:      "the latency(seconds) of the dao operation on each table",
+		Buckets:   []float64{0.001, 0.003, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5, 1, 2, 5, 10},
+	}, []string{"table", "operation"})
+
+	p.slowCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: metrics.DaoSubSys,
+		Name:      "slow_query_total",
+		Help:      "the total count of the dao operation which exceeds the slow threshold on each table",
+	}, []string{"table", "operation"})
+
+	return p
+}
+
+// Name of the plugin.
+func (p *daoMetricPlugin) Name() string {
+	return "bscp:dao_metric"
+}
+
+// Initialize register the callbacks of all the operations.
+func (p *daoMetricPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		op     string
+		before func(name string, fn func(*gorm.DB)) error
+		after  func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	for _, h := range hooks {
+		if err := h.before("bscp:dao_metric_before_"+h.op, p.before); err != nil {
+			return err
+		}
+		if err := h.after("bscp:dao_metric_after_"+h.op, p.after(h.op)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MetricsCollectors returns the collectors to be registered.
+func (p *daoMetricPlugin) MetricsCollectors() []prometheus.Collector {
+	return []prometheus.Collector{p.latency, p.slowCounter}
+}
+
+func (p *daoMetricPlugin) before(db *gorm.DB) {
+	db.InstanceSet(daoStartTimeKey, time.Now())
+}
+
+func (p *daoMetricPlugin) after(op string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(daoStartTimeKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		table := db.Statement.Table
+		if table == "" {
+			// 原生 sql 等无法解析出表名
+			table = "unknown"
+		}
+		cost := time.Since(start)
+		p.latency.WithLabelValues(table, op).Observe(cost.Seconds())
+		logs.RecordDownstream(db.Statement.Context, "db:"+table, cost)
+		if p.slowThreshold > 0 && cost >= p.slowThreshold {
+			p.slowCounter.WithLabelValues(table, op).Inc()
+		}
+	}
+}
@@ -0,0 +1,183 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// NotificationChannel supplies all the notification channel related operations.
+type NotificationChannel interface {
+	// Create one notification channel instance.
+	Create(kit *kit.Kit, c *table.NotificationChannel) (uint32, error)
+	// Update one notification channel instance.
+	Update(kit *kit.Kit, c *table.NotificationChannel) error
+	// Delete one notification channel instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get notification channel by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.NotificationChannel, error)
+	// GetByName get notification channel by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.NotificationChannel, error)
+	// List all the notification channels of a biz.
+	List(kit *kit.Kit, bizID uint32) ([]*table.NotificationChannel, error)
+	// ListEnabled list the enabled notification channels which take effect on the app, including
+	// the biz level channels.
+	ListEnabled(kit *kit.Kit, bizID, appID uint32) ([]*table.NotificationChannel, error)
+}
+
+var _ NotificationChannel = new(notificationChannelDao)
+
+type notificationChannelDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one notification channel instance.
+func (dao *notificationChannelDao) Create(kit *kit.Kit, c *table.NotificationChannel) (uint32, error) {
+	if c == nil {
+		return 0, errors.New("notification channel is nil")
+	}
+
+	if err := c.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.NotificationChannelTable)
+	if err != nil {
+		return 0, err
+	}
+	c.ID = id
+
+	ad := dao.auditDao.Decorator(kit, c.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.NotificationChannelName, c.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           c.Spec.Memo,
+	}).PrepareCreate(c)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.NotificationChannel.WithContext(kit.Ctx).Create(c); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return c.ID, nil
+}
+
+// Update one notification channel instance.
+func (dao *notificationChannelDao) Update(kit *kit.Kit, c *table.NotificationChannel) error {
+	if c == nil {
+		return errors.New("notification channel is nil")
+	}
+
+	if err := c.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, c.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.NotificationChannelName, c.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           c.Spec.Memo,
+	}).PrepareUpdate(c)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.NotificationChannel
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(c.Attachment.BizID), m.ID.Eq(c.ID)).
+			Select(m.Name, m.Type, m.Target, m.Events, m.Template, m.Enabled, m.Memo, m.AppID, m.Reviser, m.UpdatedAt).
+			Updates(c)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Delete one notification channel instance.
+func (dao *notificationChannelDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and notification channel id should be set")
+	}
+
+	oldOne, err := dao.Get(kit, bizID, id)
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.NotificationChannelName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		m := tx.NotificationChannel
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get notification channel by id.
+func (dao *notificationChannelDao) Get(kit *kit.Kit, bizID, id uint32) (*table.NotificationChannel, error) {
+	m := dao.genQ.NotificationChannel
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get notification channel by name.
+func (dao *notificationChannelDao) GetByName(kit *kit.Kit, bizID uint32, name string) (
+	*table.NotificationChannel, error) {
+	m := dao.genQ.NotificationChannel
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// List all the notification channels of a biz.
+func (dao *notificationChannelDao) List(kit *kit.Kit, bizID uint32) ([]*table.NotificationChannel, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.NotificationChannel
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID).Find()
+}
+
+// ListEnabled list the enabled notification channels which take effect on the app, including
+// the biz level channels.
+func (dao *notificationChannelDao) ListEnabled(kit *kit.Kit, bizID, appID uint32) (
+	[]*table.NotificationChannel, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.NotificationChannel
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.In(0, appID), m.Enabled.Is(true)).
+		Order(m.ID).Find()
+}
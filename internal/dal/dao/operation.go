@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// Operation supplies all the async operation related operations.
+type Operation interface {
+	// Create one async operation.
+	Create(kit *kit.Kit, op *table.Operation) (uint32, error)
+	// Get async operation by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.Operation, error)
+	// List async operations of the biz, list the operations of the app if app id is set.
+	List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) ([]*table.Operation, int64, error)
+	// UpdateProgress update the state, progress and result of the operation, which also acts as the heartbeat.
+	UpdateProgress(kit *kit.Kit, op *table.Operation) error
+	// RequestCancel mark the unfinished operation to be canceled, returns false if the operation is finished.
+	RequestCancel(kit *kit.Kit, bizID, id uint32) (bool, error)
+	// ListInterrupted list the unfinished operations whose heartbeat is earlier than the given time.
+	ListInterrupted(kit *kit.Kit, before time.Time, limit int) ([]*table.Operation, error)
+	// DeleteFinished delete the finished operations which are finished before the given time.
+	DeleteFinished(kit *kit.Kit, before time.Time, limit int) (int64, error)
+}
+
+var _ Operation = new(operationDao)
+
+type operationDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// Create one async operation.
+func (dao *operationDao) Create(kit *kit.Kit, op *table.Operation) (uint32, error) {
+	if op == nil {
+		return 0, errors.New("operation is nil")
+	}
+
+	if err := op.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.OperationTable)
+	if err != nil {
+		return 0, err
+	}
+	op.ID = id
+
+	if err := dao.genQ.Operation.WithContext(kit.Ctx).Create(op); err != nil {
+		return 0, err
+	}
+
+	return op.ID, nil
+}
+
+// Get async operation by id.
+func (dao *operationDao) Get(kit *kit.Kit, bizID, id uint32) (*table.Operation, error) {
+	m := dao.genQ.Operation
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// List async operations of the biz, list the operations of the app if app id is set.
+func (dao *operationDao) List(kit *kit.Kit, bizID, appID uint32, opt *types.BasePage) (
+	[]*table.Operation, int64, error) {
+	if bizID == 0 {
+		return nil, 0, errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+
+	m := dao.genQ.Operation
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID))
+	if appID != 0 {
+		q = q.Where(m.AppID.Eq(appID))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// UpdateProgress update the state, progress and result of the operation, which also acts as the heartbeat.
+func (dao *operationDao) UpdateProgress(kit *kit.Kit, op *table.Operation) error {
+	if op == nil || op.ID <= 0 {
+		return errors.New("operation id should be set")
+	}
+
+	m := dao.genQ.Operation
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(op.ID)).
+		Select(m.State, m.Total, m.Succeeded, m.Failed, m.Errors, m.Result, m.FinishedAt, m.UpdatedAt).
+		Updates(op)
+	return err
+}
+
+// RequestCancel mark the unfinished operation to be canceled, returns false if the operation is finished.
+func (dao *operationDao) RequestCancel(kit *kit.Kit, bizID, id uint32) (bool, error) {
+	m := dao.genQ.Operation
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.BizID.Eq(bizID), m.ID.Eq(id),
+			m.State.In(string(table.OperationPending), string(table.OperationRunning))).
+		UpdateSimple(m.CancelRequested.Value(true), m.Reviser.Value(kit.User))
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListInterrupted list the unfinished operations whose heartbeat is earlier than the given time.
+func (dao *operationDao) ListInterrupted(kit *kit.Kit, before time.Time, limit int) ([]*table.Operation, error) {
+	m := dao.genQ.Operation
+	return m.WithContext(kit.Ctx).
+		Where(m.State.In(string(table.OperationPending), string(table.OperationRunning)), m.UpdatedAt.Lte(before)).
+		Order(m.UpdatedAt).
+		Limit(limit).
+		Find()
+}
+
+// DeleteFinished delete the finished operations which are finished before the given time.
+func (dao *operationDao) DeleteFinished(kit *kit.Kit, before time.Time, limit int) (int64, error) {
+	m := dao.genQ.Operation
+	result, err := m.WithContext(kit.Ctx).
+		Where(m.FinishedAt.IsNotNull(), m.FinishedAt.Lte(before)).
+		Limit(limit).
+		Delete()
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected, nil
+}
@@ -118,6 +118,8 @@ func genStrategy(kit *kit.Kit, opt *types.PublishOption, stgID uint32, groups []
 			ApproverProgress:  opt.ApproverProgress,
 			FinalApprovalTime: time.Now().UTC(),
 			ApproveType:       opt.ApproveType,
+			ChangeTicketID:    opt.ChangeTicketID,
+			ChangeTicketURL:   opt.ChangeTicketURL,
 		},
 		State: &table.StrategyState{
 			PubState: state,
@@ -166,10 +168,17 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 			UID:      "",
 		},
 	}
+	// the pinned groups keep their releases, skip them.
+	pinned, err := dao.listPinnedGroups(kit, tx, opt.BizID, opt.AppID)
+	if err != nil {
+		return err
+	}
+
 	if opt.All {
-		// 1. delete all released groups
+		// 1. delete all released groups except the pinned ones
 		m := tx.ReleasedGroup
-		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID)).Delete(); err != nil {
+		if _, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.Pinned.Is(false)).
+			Delete(); err != nil {
 			logs.Errorf("delete all released groups failed, err: %v, rid: %s", err, kit.Rid)
 			return err
 		}
@@ -204,7 +213,7 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 		// 1. delete other released groups in this release.
 		m := tx.ReleasedGroup
 		if _, err := m.WithContext(kit.Ctx).
-			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.ReleaseID.Eq(opt.ReleaseID)).
+			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.ReleaseID.Eq(opt.ReleaseID), m.Pinned.Is(false)).
 			Delete(); err != nil {
 			logs.Errorf("delete other released groups in release failed, err: %v, rid: %s", err, kit.Rid)
 			return err
@@ -215,7 +224,7 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 			groupIDs = append(groupIDs, group.ID)
 		}
 		if _, err := m.WithContext(kit.Ctx).
-			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.GroupID.In(groupIDs...)).
+			Where(m.BizID.Eq(opt.BizID), m.AppID.Eq(opt.AppID), m.GroupID.In(groupIDs...), m.Pinned.Is(false)).
 			Delete(); err != nil {
 			logs.Errorf("delete other released groups in other releases failed, err: %v, rid: %s", err, kit.Rid)
 			return err
@@ -224,6 +233,12 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 		groups = []*table.Group{defaultGroup}
 	}
 	for _, group := range groups {
+		if pinned[group.ID] {
+			logs.Infof("group %d of app %d is pinned, skip publishing release %d to it, rid: %s", group.ID,
+				opt.AppID, opt.ReleaseID, kit.Rid)
+			continue
+		}
+
 		rg := &table.ReleasedGroup{
 			GroupID:    group.ID,
 			AppID:      opt.AppID,
@@ -262,6 +277,23 @@ func (dao *pubDao) upsertReleasedGroups(kit *kit.Kit, tx *gen.Query, opt *types.
 	return nil
 }
 
+// listPinnedGroups returns the pinned group ids of the app.
+func (dao *pubDao) listPinnedGroups(kit *kit.Kit, tx *gen.Query, bizID, appID uint32) (map[uint32]bool, error) {
+	m := tx.ReleasedGroup
+	var groupIDs []uint32
+	if err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Pinned.Is(true)).
+		Pluck(m.GroupID, &groupIDs); err != nil {
+		logs.Errorf("list app %d pinned groups failed, err: %v, rid: %s", appID, err, kit.Rid)
+		return nil, err
+	}
+
+	pinned := make(map[uint32]bool, len(groupIDs))
+	for _, id := range groupIDs {
+		pinned[id] = true
+	}
+	return pinned, nil
+}
+
 // SubmitWithTx submit with transaction
 func (dao *pubDao) SubmitWithTx(kit *kit.Kit, tx *gen.QueryTx, opt *types.PublishOption) (uint32, error) {
 	if opt == nil {
@@ -0,0 +1,133 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// RecycleBin supplies all the recycle bin related operations.
+type RecycleBin interface {
+	// CreateWithTx put a deleted resource into recycle bin with transaction.
+	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, rb *table.RecycleBin) (uint32, error)
+	// Get the resource in recycle bin by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.RecycleBin, error)
+	// List the resources in recycle bin with options.
+	List(kit *kit.Kit, opt *types.ListRecycleBinOption) ([]*table.RecycleBin, int64, error)
+	// ListExpired list the resources in recycle bin which are expired before the given time.
+	ListExpired(kit *kit.Kit, before time.Time, limit int) ([]*table.RecycleBin, error)
+	// DeleteWithTx remove a resource from recycle bin with transaction.
+	DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error
+	// BatchDelete remove resources from recycle bin.
+	BatchDelete(kit *kit.Kit, ids []uint32) error
+}
+
+var _ RecycleBin = new(recycleBinDao)
+
+type recycleBinDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// CreateWithTx put a deleted resource into recycle bin with transaction.
+func (dao *recycleBinDao) CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, rb *table.RecycleBin) (uint32, error) {
+	if rb == nil {
+		return 0, errf.New(errf.InvalidParameter, "recycle bin is nil")
+	}
+
+	if err := rb.ValidateCreate(); err != nil {
+		return 0, errf.New(errf.InvalidParameter, err.Error())
+	}
+
+	id, err := dao.idGen.One(kit, table.RecycleBinTable)
+	if err != nil {
+		return 0, err
+	}
+	rb.ID = id
+
+	if err = tx.RecycleBin.WithContext(kit.Ctx).Create(rb); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// Get the resource in recycle bin by id.
+func (dao *recycleBinDao) Get(kit *kit.Kit, bizID, id uint32) (*table.RecycleBin, error) {
+	m := dao.genQ.RecycleBin
+	return m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID)).Take()
+}
+
+// List the resources in recycle bin with options.
+func (dao *recycleBinDao) List(kit *kit.Kit, opt *types.ListRecycleBinOption) (
+	[]*table.RecycleBin, int64, error) {
+
+	if opt == nil {
+		return nil, 0, errors.New("list recycle bin option is nil")
+	}
+
+	if err := opt.Validate(types.DefaultPageOption); err != nil {
+		return nil, 0, err
+	}
+
+	m := dao.genQ.RecycleBin
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(opt.BizID))
+	if opt.AppID > 0 {
+		q = q.Where(m.AppID.Eq(opt.AppID))
+	}
+	if opt.ResType != "" {
+		q = q.Where(m.ResType.Eq(string(opt.ResType)))
+	}
+
+	q = q.Order(m.ID.Desc())
+	if opt.Page.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Page.Offset(), opt.Page.LimitInt())
+}
+
+// ListExpired list the resources in recycle bin which are expired before the given time.
+func (dao *recycleBinDao) ListExpired(kit *kit.Kit, before time.Time, limit int) ([]*table.RecycleBin, error) {
+	m := dao.genQ.RecycleBin
+	return m.WithContext(kit.Ctx).Where(m.ExpiredAt.Lte(before)).Order(m.ExpiredAt).Limit(limit).Find()
+}
+
+// DeleteWithTx remove a resource from recycle bin with transaction.
+func (dao *recycleBinDao) DeleteWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, id uint32) error {
+	m := tx.RecycleBin
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.Eq(id), m.BizID.Eq(bizID)).Delete()
+	return err
+}
+
+// BatchDelete remove resources from recycle bin.
+func (dao *recycleBinDao) BatchDelete(kit *kit.Kit, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m := dao.genQ.RecycleBin
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Delete()
+	return err
+}
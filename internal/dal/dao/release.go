@@ -34,6 +34,8 @@ type Release interface {
 	CreateWithTx(kit *kit.Kit, tx *gen.QueryTx, release *table.Release) (uint32, error)
 	// List releases with options.
 	List(kit *kit.Kit, opts *types.ListReleasesOption) (*types.ListReleaseDetails, error)
+	// ListWithCursor list releases of the app with cursor page.
+	ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) ([]*table.Release, string, error)
 	// ListAllByIDs list all releases by releaseIDs.
 	ListAllByIDs(kit *kit.Kit, ids []uint32, bizID uint32) ([]*table.Release, error)
 	// GetByName get release by name
@@ -48,6 +50,9 @@ type Release interface {
 	GetReleaseLately(kit *kit.Kit, bizID uint32, appID uint32) (*table.Release, error)
 	// ListReleaseStrategies list release strategie the latest three pieces of data published
 	ListReleaseStrategies(kit *kit.Kit, bizID uint32, appID uint32) ([]*types.ListReleasesStrategies, error)
+	// ListRecentPublishes list the latest published releases of the apps in a biz
+	ListRecentPublishes(kit *kit.Kit, bizID uint32, appIDs []uint32, limit int) (
+		[]*types.ListReleasesStrategies, error)
 }
 
 var _ Release = new(releaseDao)
@@ -261,3 +266,46 @@ func (dao *releaseDao) ListReleaseStrategies(
 	}
 	return publishRecords, nil
 }
+
+// ListRecentPublishes list the latest published releases of the apps in a biz
+func (dao *releaseDao) ListRecentPublishes(kit *kit.Kit, bizID uint32, appIDs []uint32, limit int) (
+	[]*types.ListReleasesStrategies, error) {
+	var publishRecords []*types.ListReleasesStrategies
+	if len(appIDs) == 0 {
+		return publishRecords, nil
+	}
+
+	r := dao.genQ.Release
+	s := dao.genQ.Strategy
+	err := r.WithContext(kit.Ctx).
+		Select(s.PublishTime, r.Name, s.Scope, r.Creator, r.FullyReleased, s.FinalApprovalTime, s.AppID,
+			s.ReleaseID).
+		Join(s, s.ReleaseID.EqCol(r.ID), s.AppID.EqCol(r.AppID), s.BizID.EqCol(r.BizID)).
+		Where(s.PublishStatus.Eq(string(table.AlreadyPublish)), s.AppID.In(appIDs...), s.BizID.Eq(bizID)).
+		Order(s.FinalApprovalTime.Desc()).Limit(limit).
+		Scan(&publishRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	return publishRecords, nil
+}
+
+// ListWithCursor list releases of the app with cursor page.
+func (dao *releaseDao) ListWithCursor(kit *kit.Kit, bizID, appID uint32, opt *types.CursorPage) (
+	[]*table.Release, string, error) {
+	m := dao.genQ.Release
+	conds, orders, err := cursorQuery(&m, opt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 多查询一条用于判断是否存在下一页
+	result, err := dao.genQ.Release.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID)).Where(conds...).
+		Order(orders...).Limit(int(opt.Limit) + 1).Find()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return nextCursorPage(result, opt)
+}
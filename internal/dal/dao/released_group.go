@@ -37,6 +37,10 @@ type ReleasedGroup interface {
 	UpdateEditedStatusWithTx(kit *kit.Kit, tx *gen.QueryTx, edited bool, groupID, bizID uint32) error
 	// BatchDeleteByAppIDWithTx batch delete by app id with transaction.
 	BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, bizID uint32) error
+	// DeleteByGroupIDWithTx delete the app's released group by group id with transaction.
+	DeleteByGroupIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, groupID, bizID uint32) error
+	// UpdatePinnedWithTx pin or unpin the app's released group with transaction.
+	UpdatePinnedWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, groupID, bizID uint32, pinned bool) error
 }
 
 var _ ReleasedGroup = new(releasedGroupDao)
@@ -155,3 +159,34 @@ func (dao *releasedGroupDao) BatchDeleteByAppIDWithTx(kit *kit.Kit, tx *gen.Quer
 	_, err := m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.BizID.Eq(bizID)).Delete()
 	return err
 }
+
+// DeleteByGroupIDWithTx delete the app's released group by group id with transaction.
+func (dao *releasedGroupDao) DeleteByGroupIDWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, groupID,
+	bizID uint32) error {
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if appID == 0 {
+		return errf.New(errf.InvalidParameter, "appID is 0")
+	}
+
+	m := tx.ReleasedGroup
+	_, err := m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.GroupID.Eq(groupID), m.BizID.Eq(bizID)).Delete()
+	return err
+}
+
+// UpdatePinnedWithTx pin or unpin the app's released group with transaction.
+func (dao *releasedGroupDao) UpdatePinnedWithTx(kit *kit.Kit, tx *gen.QueryTx, appID, groupID, bizID uint32,
+	pinned bool) error {
+	if bizID == 0 {
+		return errf.New(errf.InvalidParameter, "bizID is 0")
+	}
+	if appID == 0 {
+		return errf.New(errf.InvalidParameter, "appID is 0")
+	}
+
+	m := tx.ReleasedGroup
+	_, err := m.WithContext(kit.Ctx).Where(m.AppID.Eq(appID), m.GroupID.Eq(groupID), m.BizID.Eq(bizID)).
+		Update(m.Pinned, pinned)
+	return err
+}
@@ -15,6 +15,7 @@ package dao
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
@@ -37,8 +38,15 @@ type ReleasedKv interface {
 	GetReleasedLately(kit *kit.Kit, bizID, appID uint32) ([]*table.ReleasedKv, error)
 	// GetReleasedLatelyByKey get released kv lately by key
 	GetReleasedLatelyByKey(kit *kit.Kit, bizID, appID uint32, key string) (*table.ReleasedKv, error)
+	// ListAllByKey list the released kvs of the key in all releases, the latest release first.
+	ListAllByKey(kit *kit.Kit, bizID, appID uint32, key string) ([]*table.ReleasedKv, error)
 	// BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
 	BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, appID, releaseID uint32) error
+	// ListExpiryUnnotified list the released kvs which are expired before the given time, and the expiry
+	// events of them have not been fired.
+	ListExpiryUnnotified(kit *kit.Kit, before time.Time, limit int) ([]*table.ReleasedKv, error)
+	// MarkExpiryNotifiedWithTx mark the expiry events of the released kvs have been fired with transaction.
+	MarkExpiryNotifiedWithTx(kit *kit.Kit, tx *gen.QueryTx, ids []uint32) error
 }
 
 var _ ReleasedKv = new(releasedKvDao)
@@ -170,6 +178,13 @@ func (dao *releasedKvDao) GetReleasedLatelyByKey(kit *kit.Kit, bizID, appID uint
 	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Key.Eq(key)).Take()
 }
 
+// ListAllByKey list the released kvs of the key in all releases, the latest release first.
+func (dao *releasedKvDao) ListAllByKey(kit *kit.Kit, bizID, appID uint32, key string) ([]*table.ReleasedKv, error) {
+	m := dao.genQ.ReleasedKv
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.Key.Eq(key)).
+		Order(m.ReleaseID.Desc()).Find()
+}
+
 // BatchDeleteByReleaseIDWithTx batch delete by release id with transaction.
 func (dao *releasedKvDao) BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.QueryTx,
 	bizID, appID, releaseID uint32) error {
@@ -189,3 +204,26 @@ func (dao *releasedKvDao) BatchDeleteByReleaseIDWithTx(kit *kit.Kit, tx *gen.Que
 	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ReleaseID.Eq(releaseID)).Delete()
 	return err
 }
+
+// ListExpiryUnnotified list the released kvs which are expired before the given time, and the expiry
+// events of them have not been fired.
+func (dao *releasedKvDao) ListExpiryUnnotified(kit *kit.Kit, before time.Time, limit int) (
+	[]*table.ReleasedKv, error) {
+	m := dao.genQ.ReleasedKv
+	return m.WithContext(kit.Ctx).
+		Where(m.ExpiredAt.IsNotNull(), m.ExpiredAt.Lte(before), m.ExpiryNotified.Is(false)).
+		Order(m.ExpiredAt).
+		Limit(limit).
+		Find()
+}
+
+// MarkExpiryNotifiedWithTx mark the expiry events of the released kvs have been fired with transaction.
+func (dao *releasedKvDao) MarkExpiryNotifiedWithTx(kit *kit.Kit, tx *gen.QueryTx, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m := tx.ReleasedKv
+	_, err := m.WithContext(kit.Ctx).Where(m.ID.In(ids...)).Update(m.ExpiryNotified, true)
+	return err
+}
@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// SecretScanSetting supplies all the secret scan setting related operations.
+type SecretScanSetting interface {
+	// Create one secret scan setting instance.
+	Create(kit *kit.Kit, s *table.SecretScanSetting) (uint32, error)
+	// Update one secret scan setting instance.
+	Update(kit *kit.Kit, s *table.SecretScanSetting) error
+	// Get secret scan setting by biz id.
+	Get(kit *kit.Kit, bizID uint32) (*table.SecretScanSetting, error)
+}
+
+var _ SecretScanSetting = new(secretScanSettingDao)
+
+type secretScanSettingDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one secret scan setting instance.
+func (dao *secretScanSettingDao) Create(kit *kit.Kit, s *table.SecretScanSetting) (uint32, error) {
+	if s == nil {
+		return 0, errors.New("secret scan setting is nil")
+	}
+
+	if err := s.ValidateCreate(); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.SecretScanSettingTable)
+	if err != nil {
+		return 0, err
+	}
+	s.ID = id
+
+	ad := dao.auditDao.Decorator(kit, s.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.SecretScanSettingBizID, s.Attachment.BizID),
+		Status:           enumor.Success,
+	}).PrepareCreate(s)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.SecretScanSetting.WithContext(kit.Ctx).Create(s); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return s.ID, nil
+}
+
+// Update one secret scan setting instance.
+func (dao *secretScanSettingDao) Update(kit *kit.Kit, s *table.SecretScanSetting) error {
+	if s == nil {
+		return errors.New("secret scan setting is nil")
+	}
+
+	if err := s.ValidateUpdate(); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, s.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.SecretScanSettingBizID, s.Attachment.BizID),
+		Status:           enumor.Success,
+	}).PrepareUpdate(s)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.SecretScanSetting
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(s.Attachment.BizID), m.ID.Eq(s.ID)).
+			Select(m.Mode, m.DisabledRules, m.CustomRules, m.Reviser, m.UpdatedAt).
+			Updates(s)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// Get secret scan setting by biz id.
+func (dao *secretScanSettingDao) Get(kit *kit.Kit, bizID uint32) (*table.SecretScanSetting, error) {
+	m := dao.genQ.SecretScanSetting
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Take()
+}
@@ -0,0 +1,223 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ServiceAccount supplies all the service account related operations.
+type ServiceAccount interface {
+	// Create one service account instance.
+	Create(kit *kit.Kit, sa *table.ServiceAccount) (uint32, error)
+	// Update one service account's memo, scopes, enabled and expired time.
+	Update(kit *kit.Kit, sa *table.ServiceAccount) error
+	// UpdateKey reset the api key of the service account.
+	UpdateKey(kit *kit.Kit, sa *table.ServiceAccount) error
+	// UpdateLastUsed update the last used time of the service account.
+	UpdateLastUsed(kit *kit.Kit, bizID, id uint32, usedAt time.Time) error
+	// Delete one service account instance.
+	Delete(kit *kit.Kit, bizID, id uint32) error
+	// Get service account by id.
+	Get(kit *kit.Kit, bizID, id uint32) (*table.ServiceAccount, error)
+	// GetByName get service account by name.
+	GetByName(kit *kit.Kit, bizID uint32, name string) (*table.ServiceAccount, error)
+	// GetByKeyHash get service account by the hash of its api key.
+	GetByKeyHash(kit *kit.Kit, keyHash string) (*table.ServiceAccount, error)
+	// List all the service accounts of a biz.
+	List(kit *kit.Kit, bizID uint32) ([]*table.ServiceAccount, error)
+}
+
+var _ ServiceAccount = new(serviceAccountDao)
+
+type serviceAccountDao struct {
+	genQ     *gen.Query
+	idGen    IDGenInterface
+	auditDao AuditDao
+}
+
+// Create one service account instance.
+func (dao *serviceAccountDao) Create(kit *kit.Kit, sa *table.ServiceAccount) (uint32, error) {
+	if sa == nil {
+		return 0, errors.New("service account is nil")
+	}
+
+	if err := sa.ValidateCreate(kit); err != nil {
+		return 0, err
+	}
+
+	id, err := dao.idGen.One(kit, table.ServiceAccountTable)
+	if err != nil {
+		return 0, err
+	}
+	sa.ID = id
+
+	ad := dao.auditDao.Decorator(kit, sa.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ServiceAccountName, sa.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           sa.Spec.Memo,
+	}).PrepareCreate(sa)
+
+	createTx := func(tx *gen.Query) error {
+		if err := tx.ServiceAccount.WithContext(kit.Ctx).Create(sa); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+	if err := dao.genQ.Transaction(createTx); err != nil {
+		return 0, err
+	}
+
+	return sa.ID, nil
+}
+
+// Update one service account's memo, scopes, enabled and expired time.
+func (dao *serviceAccountDao) Update(kit *kit.Kit, sa *table.ServiceAccount) error {
+	if sa == nil {
+		return errors.New("service account is nil")
+	}
+
+	if err := sa.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, sa.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ServiceAccountName, sa.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           sa.Spec.Memo,
+	}).PrepareUpdate(sa)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ServiceAccount
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(sa.Attachment.BizID), m.ID.Eq(sa.ID)).
+			Select(m.Memo, m.Scopes, m.Enabled, m.ExpiredAt, m.Reviser, m.UpdatedAt).Updates(sa)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// UpdateKey reset the api key of the service account.
+func (dao *serviceAccountDao) UpdateKey(kit *kit.Kit, sa *table.ServiceAccount) error {
+	if sa == nil {
+		return errors.New("service account is nil")
+	}
+
+	if err := sa.ValidateUpdate(kit); err != nil {
+		return err
+	}
+
+	if sa.Spec.KeyHash == "" {
+		return errors.New("service account key hash is empty")
+	}
+
+	ad := dao.auditDao.Decorator(kit, sa.Attachment.BizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ServiceAccountName, sa.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           "reset api key",
+	}).PrepareUpdate(sa)
+
+	updateTx := func(tx *gen.Query) error {
+		m := tx.ServiceAccount
+		_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(sa.Attachment.BizID), m.ID.Eq(sa.ID)).
+			Select(m.KeyHash, m.KeyPrefix, m.Reviser, m.UpdatedAt).Updates(sa)
+		if err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(updateTx)
+}
+
+// UpdateLastUsed update the last used time of the service account, which is not audited.
+func (dao *serviceAccountDao) UpdateLastUsed(kit *kit.Kit, bizID, id uint32, usedAt time.Time) error {
+	m := dao.genQ.ServiceAccount
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).UpdateSimple(m.LastUsedAt.Value(usedAt))
+	return err
+}
+
+// Delete one service account instance.
+func (dao *serviceAccountDao) Delete(kit *kit.Kit, bizID, id uint32) error {
+	if bizID <= 0 || id <= 0 {
+		return errors.New("biz id and service account id should be set")
+	}
+
+	m := dao.genQ.ServiceAccount
+	oldOne, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+	if err != nil {
+		return err
+	}
+
+	ad := dao.auditDao.Decorator(kit, bizID, &table.AuditField{
+		ResourceInstance: fmt.Sprintf(constant.ServiceAccountName, oldOne.Spec.Name),
+		Status:           enumor.Success,
+		Detail:           oldOne.Spec.Memo,
+	}).PrepareDelete(oldOne)
+
+	deleteTx := func(tx *gen.Query) error {
+		q := tx.ServiceAccount
+		if _, err := q.WithContext(kit.Ctx).Where(q.BizID.Eq(bizID), q.ID.Eq(id)).Delete(); err != nil {
+			return err
+		}
+
+		return ad.Do(tx)
+	}
+
+	return dao.genQ.Transaction(deleteTx)
+}
+
+// Get service account by id.
+func (dao *serviceAccountDao) Get(kit *kit.Kit, bizID, id uint32) (*table.ServiceAccount, error) {
+	m := dao.genQ.ServiceAccount
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
+// GetByName get service account by name.
+func (dao *serviceAccountDao) GetByName(kit *kit.Kit, bizID uint32, name string) (*table.ServiceAccount, error) {
+	m := dao.genQ.ServiceAccount
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.Name.Eq(name)).Take()
+}
+
+// GetByKeyHash get service account by the hash of its api key.
+func (dao *serviceAccountDao) GetByKeyHash(kit *kit.Kit, keyHash string) (*table.ServiceAccount, error) {
+	if keyHash == "" {
+		return nil, errors.New("key hash is empty")
+	}
+
+	m := dao.genQ.ServiceAccount
+	return m.WithContext(kit.Ctx).Where(m.KeyHash.Eq(keyHash)).Take()
+}
+
+// List all the service accounts of a biz.
+func (dao *serviceAccountDao) List(kit *kit.Kit, bizID uint32) ([]*table.ServiceAccount, error) {
+	if bizID <= 0 {
+		return nil, errors.New("biz id should be set")
+	}
+
+	m := dao.genQ.ServiceAccount
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Order(m.ID).Find()
+}
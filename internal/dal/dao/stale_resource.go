@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dao
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+// StaleResource supplies all the stale resource related operations.
+type StaleResource interface {
+	// BatchCreate create the newly detected stale resources.
+	BatchCreate(kit *kit.Kit, items []*table.StaleResource) error
+	// ListAll list all the stale resources of the biz.
+	ListAll(kit *kit.Kit, bizID uint32) ([]*table.StaleResource, error)
+	// List the stale resources of the biz with options, all types are listed if the res type is empty.
+	List(kit *kit.Kit, bizID uint32, resType string, opt *types.BasePage) ([]*table.StaleResource, int64, error)
+	// BatchDelete delete the stale resources which are consumed again or removed.
+	BatchDelete(kit *kit.Kit, bizID uint32, ids []uint32) error
+	// UpdateArchived set the archived time of the stale resource.
+	UpdateArchived(kit *kit.Kit, bizID, id uint32, archivedAt time.Time) error
+}
+
+var _ StaleResource = new(staleResourceDao)
+
+type staleResourceDao struct {
+	genQ  *gen.Query
+	idGen IDGenInterface
+}
+
+// BatchCreate create the newly detected stale resources.
+func (dao *staleResourceDao) BatchCreate(kit *kit.Kit, items []*table.StaleResource) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	for _, one := range items {
+		if err := one.ValidateCreate(); err != nil {
+			return err
+		}
+	}
+
+	ids, err := dao.idGen.Batch(kit, table.StaleResourceTable, len(items))
+	if err != nil {
+		return err
+	}
+	for i, one := range items {
+		one.ID = ids[i]
+	}
+
+	return dao.genQ.StaleResource.WithContext(kit.Ctx).CreateInBatches(items, 500)
+}
+
+// ListAll list all the stale resources of the biz.
+func (dao *staleResourceDao) ListAll(kit *kit.Kit, bizID uint32) ([]*table.StaleResource, error) {
+	m := dao.genQ.StaleResource
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID)).Find()
+}
+
+// List the stale resources of the biz with options, all types are listed if the res type is empty.
+func (dao *staleResourceDao) List(kit *kit.Kit, bizID uint32, resType string, opt *types.BasePage) (
+	[]*table.StaleResource, int64, error) {
+	m := dao.genQ.StaleResource
+	q := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID))
+	if resType != "" {
+		q = q.Where(m.ResType.Eq(resType))
+	}
+	q = q.Order(m.NotifiedAt, m.ID)
+
+	if opt.All {
+		result, err := q.Find()
+		if err != nil {
+			return nil, 0, err
+		}
+		return result, int64(len(result)), nil
+	}
+
+	return q.FindByPage(opt.Offset(), opt.LimitInt())
+}
+
+// BatchDelete delete the stale resources which are consumed again or removed.
+func (dao *staleResourceDao) BatchDelete(kit *kit.Kit, bizID uint32, ids []uint32) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	m := dao.genQ.StaleResource
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.In(ids...)).Delete()
+	return err
+}
+
+// UpdateArchived set the archived time of the stale resource.
+func (dao *staleResourceDao) UpdateArchived(kit *kit.Kit, bizID, id uint32, archivedAt time.Time) error {
+	m := dao.genQ.StaleResource
+	_, err := m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Update(m.ArchivedAt, archivedAt)
+	return err
+}
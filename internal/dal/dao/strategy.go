@@ -29,6 +29,8 @@ type Strategy interface {
 	ListStrategyByItsm(kit *kit.Kit) ([]*table.Strategy, error)
 	// ListStrategyByReleasesIDs list strategy by ReleasesID.
 	ListStrategyByReleasesIDs(kit *kit.Kit, releasesIDs []uint32) ([]*table.Strategy, error)
+	// ListPublishedBefore list the already published strategies of the app whose id is less than the given id.
+	ListPublishedBefore(kit *kit.Kit, bizID, appID, strategyID uint32) ([]*table.Strategy, error)
 	// UpdateByID update strategy kv by id.
 	UpdateByID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error
 	// UpdateByIDs update strategy kv by ids
@@ -79,6 +81,16 @@ func (dao *strategyDao) ListStrategyByReleasesIDs(kit *kit.Kit, releasesIDs []ui
 	return m.WithContext(kit.Ctx).Where(m.WithContext(kit.Ctx).Columns(m.ID).In(subQuery)).Find()
 }
 
+// ListPublishedBefore list the already published strategies of the app whose id is less than the given id,
+// only id and release id are returned, ordered by id.
+func (dao *strategyDao) ListPublishedBefore(kit *kit.Kit, bizID, appID, strategyID uint32) ([]*table.Strategy, error) {
+	m := dao.genQ.Strategy
+	return m.WithContext(kit.Ctx).Select(m.ID, m.ReleaseID).
+		Where(m.BizID.Eq(bizID), m.AppID.Eq(appID), m.ID.Lt(strategyID),
+			m.PublishStatus.Eq(string(table.AlreadyPublish))).
+		Order(m.ID).Find()
+}
+
 // UpdateByID update strategy kv by id
 func (dao *strategyDao) UpdateByID(kit *kit.Kit, tx *gen.QueryTx, strategyID uint32, m map[string]interface{}) error {
 	s := tx.Strategy
@@ -13,10 +13,10 @@
 package dao
 
 import (
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
@@ -84,7 +84,7 @@ func (dao *templateBindingRelationDao) GetTemplateBoundUnnamedAppCount(kit *kit.
 	var rs countResult
 	if err := q.Select(m.AppID.Distinct().Count().As("counts")).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(templateID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_ids", templateID))...).
 		Scan(&rs); err != nil {
 		return 0, err
 	}
@@ -115,7 +115,7 @@ func (dao *templateBindingRelationDao) GetTemplateBoundTemplateSetCount(kit *kit
 	var rs countResult
 	if err := q.Select(m.ID.Distinct().Count().As("counts")).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(templateID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_ids", templateID))...).
 		Scan(&rs); err != nil {
 		return 0, err
 	}
@@ -132,7 +132,7 @@ func (dao *templateBindingRelationDao) GetTemplateRevisionBoundUnnamedAppCount(k
 	var rs countResult
 	if err := q.Select(m.AppID.Distinct().Count().As("counts")).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_revision_ids").Contains(templateRevisionID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_revision_ids", templateRevisionID))...).
 		Scan(&rs); err != nil {
 		return 0, err
 	}
@@ -164,7 +164,7 @@ func (dao *templateBindingRelationDao) GetTemplateSetBoundUnnamedAppCount(kit *k
 	var rs countResult
 	if err := q.Select(m.AppID.Distinct().Count().As("counts")).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_set_ids").Contains(templateSetID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_set_ids", templateSetID))...).
 		Scan(&rs); err != nil {
 		return 0, err
 	}
@@ -195,7 +195,7 @@ func (dao *templateBindingRelationDao) ListTmplBoundUnnamedApps(kit *kit.Kit, bi
 	var rs []*types.TmplBoundUnnamedAppDetail
 	if err := q.Select(m.AppID, m.TemplateRevisionIDs).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(templateID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_ids", templateID))...).
 		Scan(&rs); err != nil {
 		return nil, err
 	}
@@ -226,7 +226,7 @@ func (dao *templateBindingRelationDao) ListTmplBoundTmplSets(kit *kit.Kit, bizID
 	var templateSetIDs []uint32
 	if err := q.Select(m.ID).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(templateID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_ids", templateID))...).
 		Pluck(m.ID, &templateSetIDs); err != nil {
 		return nil, err
 	}
@@ -242,7 +242,7 @@ func (dao *templateBindingRelationDao) ListTmplRevisionBoundUnnamedApps(kit *kit
 	var appIDs []uint32
 	if err := q.Select(m.AppID.Distinct()).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_revision_ids").Contains(templateRevisionID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_revision_ids", templateRevisionID))...).
 		Pluck(m.AppID, &appIDs); err != nil {
 		return nil, err
 	}
@@ -273,7 +273,7 @@ func (dao *templateBindingRelationDao) ListTmplSetBoundUnnamedApps(kit *kit.Kit,
 	var appIDs []uint32
 	if err := q.Select(m.AppID.Distinct()).
 		Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_set_ids").Contains(templateSetID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_set_ids", templateSetID))...).
 		Pluck(m.AppID, &appIDs); err != nil {
 		return nil, err
 	}
@@ -302,7 +302,7 @@ func (dao *templateBindingRelationDao) ListLatestTmplBoundUnnamedApps(kit *kit.K
 	m := dao.genQ.AppTemplateBinding
 	q := dao.genQ.AppTemplateBinding.WithContext(kit.Ctx)
 	return q.Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("latest_template_ids").Contains(templateID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("latest_template_ids", templateID))...).
 		Find()
 }
 
@@ -314,7 +314,7 @@ func (dao *templateBindingRelationDao) ListTemplateSetsBoundATBs(kit *kit.Kit, b
 	details := make([]*table.AppTemplateBinding, 0)
 	for _, id := range templateSetIDs {
 		atbs, err := q.Where(m.BizID.Eq(bizID)).
-			Where(rawgen.Cond(datatypes.JSONArrayQuery("template_set_ids").Contains(id))...).
+			Where(rawgen.Cond(utils.JSONArrayContains("template_set_ids", id))...).
 			Find()
 		if err != nil {
 			return nil, err
@@ -347,7 +347,7 @@ func (dao *templateBindingRelationDao) ListTemplatesBoundATBs(kit *kit.Kit, bizI
 	details := make([]*table.AppTemplateBinding, 0)
 	for _, id := range templateIDs {
 		atbs, err := q.Where(m.BizID.Eq(bizID)).
-			Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(id))...).
+			Where(rawgen.Cond(utils.JSONArrayContains("template_ids", id))...).
 			Find()
 		if err != nil {
 			return nil, err
@@ -378,7 +378,7 @@ func (dao *templateBindingRelationDao) ListTemplateSetInvisibleATBs(
 	m := dao.genQ.AppTemplateBinding
 	q := dao.genQ.AppTemplateBinding.WithContext(kit.Ctx)
 	return q.Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_set_ids").Contains(templateSetID))...).
+		Where(rawgen.Cond(utils.JSONArrayContains("template_set_ids", templateSetID))...).
 		Where(m.AppID.NotIn(boundApps...)).
 		Find()
 }
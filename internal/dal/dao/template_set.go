@@ -18,12 +18,12 @@ import (
 	"path"
 	"strings"
 
-	"gorm.io/datatypes"
 	rawgen "gorm.io/gen"
 	"gorm.io/gorm"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/gen"
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/utils"
 	"github.com/TencentBlueKing/bk-bscp/internal/search"
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
@@ -526,8 +526,8 @@ func (dao *templateSetDao) AddTmplsToTmplSetsWithTx(kit *kit.Kit, tx *gen.QueryT
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	for _, tmplID := range tmplIDs {
 		if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-			Not(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-			Update(m.TemplateIDs, gorm.Expr("JSON_ARRAY_APPEND(template_ids, '$', ?)", tmplID)); err != nil {
+			Not(rawgen.Cond(utils.JSONArrayContains("template_ids", tmplID))...).
+			Update(m.TemplateIDs, utils.JSONArrayAppend("template_ids", tmplID)); err != nil {
 			return err
 		}
 	}
@@ -540,12 +540,9 @@ func (dao *templateSetDao) DeleteTmplsFromTmplSetsWithTx(kit *kit.Kit, tx *gen.Q
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	for _, tmplID := range tmplIDs {
-		// subQuery get the array of template ids after delete the target template id, set it to '[]' if no records found
-		subQuery := "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
-			"JSON_TABLE (template_ids, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')"
 		if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-			Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-			Update(m.TemplateIDs, gorm.Expr(subQuery, tmplID)); err != nil {
+			Where(rawgen.Cond(utils.JSONArrayContains("template_ids", tmplID))...).
+			Update(m.TemplateIDs, utils.JSONArrayRemoveUint("template_ids", tmplID)); err != nil {
 			return err
 		}
 	}
@@ -558,8 +555,8 @@ func (dao *templateSetDao) AddTmplToTmplSetsWithTx(
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
 	if _, err := q.Where(m.ID.In(tmplSetIDs...)).
-		Not(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-		Update(m.TemplateIDs, gorm.Expr("JSON_ARRAY_APPEND(template_ids, '$', ?)", tmplID)); err != nil {
+		Not(rawgen.Cond(utils.JSONArrayContains("template_ids", tmplID))...).
+		Update(m.TemplateIDs, utils.JSONArrayAppend("template_ids", tmplID)); err != nil {
 		return err
 	}
 	return nil
@@ -569,12 +566,9 @@ func (dao *templateSetDao) AddTmplToTmplSetsWithTx(
 func (dao *templateSetDao) DeleteTmplFromAllTmplSetsWithTx(kit *kit.Kit, tx *gen.QueryTx, bizID, tmplID uint32) error {
 	m := tx.TemplateSet
 	q := tx.TemplateSet.WithContext(kit.Ctx)
-	// subQuery get the array of template ids after delete the target template id, set it to '[]' if no records found
-	subQuery := "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
-		"JSON_TABLE (template_ids, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')"
 	if _, err := q.Where(m.BizID.Eq(bizID)).
-		Where(rawgen.Cond(datatypes.JSONArrayQuery("template_ids").Contains(tmplID))...).
-		Update(m.TemplateIDs, gorm.Expr(subQuery, tmplID)); err != nil {
+		Where(rawgen.Cond(utils.JSONArrayContains("template_ids", tmplID))...).
+		Update(m.TemplateIDs, utils.JSONArrayRemoveUint("template_ids", tmplID)); err != nil {
 		return err
 	}
 	return nil
@@ -587,7 +581,7 @@ func (dao *templateSetDao) ListAppTmplSets(kit *kit.Kit, bizID, appID uint32) ([
 
 	return q.Where(m.BizID.Eq(bizID)).
 		Where(m.Public.Is(true)).
-		Or(rawgen.Cond(datatypes.JSONArrayQuery("bound_apps").Contains(appID))...).
+		Or(rawgen.Cond(utils.JSONArrayContains("bound_apps", appID))...).
 		Order(m.Name).
 		Find()
 }
@@ -626,7 +620,7 @@ func (dao *templateSetDao) ListAllTmplSetsOfBiz(kit *kit.Kit, bizID, appID uint3
 	// if appID > 0 , return the app's all visible template sets
 	if appID > 0 {
 		q = q.Where(m.Public.Is(true)).
-			Or(rawgen.Cond(datatypes.JSONArrayQuery("bound_apps").Contains(appID))...)
+			Or(rawgen.Cond(utils.JSONArrayContains("bound_apps", appID))...)
 		return q.Where(m.BizID.Eq(bizID)).Find()
 	}
 	return q.Find()
@@ -42,6 +42,8 @@ type TemplateVariable interface {
 	BatchCreateWithTx(kit *kit.Kit, tx *gen.QueryTx, tmplVars []*table.TemplateVariable) error
 	// BatchUpdateWithTx batch update variable instances with transaction.
 	BatchUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx, tmplVars []*table.TemplateVariable) error
+	// GetByID get template variable by id.
+	GetByID(kit *kit.Kit, bizID, id uint32) (*table.TemplateVariable, error)
 	// GetByUniqueKey get template variable by unique key.
 	GetByUniqueKey(kit *kit.Kit, bizID uint32, name string) (*table.TemplateVariable, error)
 	// FetchIDsExcluding 获取指定ID后排除的ID
@@ -266,6 +268,12 @@ func (dao *templateVariableDao) BatchUpdateWithTx(kit *kit.Kit, tx *gen.QueryTx,
 	return tx.TemplateVariable.WithContext(kit.Ctx).Save(tmplVars...)
 }
 
+// GetByID get template variable by id
+func (dao *templateVariableDao) GetByID(kit *kit.Kit, bizID, id uint32) (*table.TemplateVariable, error) {
+	m := dao.genQ.TemplateVariable
+	return m.WithContext(kit.Ctx).Where(m.BizID.Eq(bizID), m.ID.Eq(id)).Take()
+}
+
 // GetByUniqueKey get template variable by unique key
 func (dao *templateVariableDao) GetByUniqueKey(kit *kit.Kit, bizID uint32, name string) (*table.TemplateVariable,
 	error) {
@@ -0,0 +1,466 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newAlertRule(db *gorm.DB, opts ...gen.DOOption) alertRule {
+	_alertRule := alertRule{}
+
+	_alertRule.alertRuleDo.UseDB(db, opts...)
+	_alertRule.alertRuleDo.UseModel(&table.AlertRule{})
+
+	tableName := _alertRule.alertRuleDo.TableName()
+	_alertRule.ALL = field.NewAsterisk(tableName)
+	_alertRule.ID = field.NewUint32(tableName, "id")
+	_alertRule.Name = field.NewString(tableName, "name")
+	_alertRule.Metric = field.NewString(tableName, "metric")
+	_alertRule.Threshold = field.NewFloat64(tableName, "threshold")
+	_alertRule.WindowMin = field.NewUint32(tableName, "window_min")
+	_alertRule.GroupID = field.NewUint32(tableName, "group_id")
+	_alertRule.ChannelIDs = field.NewField(tableName, "channel_ids")
+	_alertRule.SilenceMin = field.NewUint32(tableName, "silence_min")
+	_alertRule.Enabled = field.NewBool(tableName, "enabled")
+	_alertRule.Memo = field.NewString(tableName, "memo")
+	_alertRule.BizID = field.NewUint32(tableName, "biz_id")
+	_alertRule.AppID = field.NewUint32(tableName, "app_id")
+	_alertRule.Firing = field.NewBool(tableName, "firing")
+	_alertRule.LastValue = field.NewFloat64(tableName, "last_value")
+	_alertRule.LastFiredAt = field.NewTime(tableName, "last_fired_at")
+	_alertRule.LastEvaluatedAt = field.NewTime(tableName, "last_evaluated_at")
+	_alertRule.Creator = field.NewString(tableName, "creator")
+	_alertRule.Reviser = field.NewString(tableName, "reviser")
+	_alertRule.CreatedAt = field.NewTime(tableName, "created_at")
+	_alertRule.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_alertRule.fillFieldMap()
+
+	return _alertRule
+}
+
+type alertRule struct {
+	alertRuleDo alertRuleDo
+
+	ALL             field.Asterisk
+	ID              field.Uint32
+	Name            field.String
+	Metric          field.String
+	Threshold       field.Float64
+	WindowMin       field.Uint32
+	GroupID         field.Uint32
+	ChannelIDs      field.Field
+	SilenceMin      field.Uint32
+	Enabled         field.Bool
+	Memo            field.String
+	BizID           field.Uint32
+	AppID           field.Uint32
+	Firing          field.Bool
+	LastValue       field.Float64
+	LastFiredAt     field.Time
+	LastEvaluatedAt field.Time
+	Creator         field.String
+	Reviser         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a alertRule) Table(newTableName string) *alertRule {
+	a.alertRuleDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a alertRule) As(alias string) *alertRule {
+	a.alertRuleDo.DO = *(a.alertRuleDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *alertRule) updateTableName(table string) *alertRule {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.Name = field.NewString(table, "name")
+	a.Metric = field.NewString(table, "metric")
+	a.Threshold = field.NewFloat64(table, "threshold")
+	a.WindowMin = field.NewUint32(table, "window_min")
+	a.GroupID = field.NewUint32(table, "group_id")
+	a.ChannelIDs = field.NewField(table, "channel_ids")
+	a.SilenceMin = field.NewUint32(table, "silence_min")
+	a.Enabled = field.NewBool(table, "enabled")
+	a.Memo = field.NewString(table, "memo")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.Firing = field.NewBool(table, "firing")
+	a.LastValue = field.NewFloat64(table, "last_value")
+	a.LastFiredAt = field.NewTime(table, "last_fired_at")
+	a.LastEvaluatedAt = field.NewTime(table, "last_evaluated_at")
+	a.Creator = field.NewString(table, "creator")
+	a.Reviser = field.NewString(table, "reviser")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.UpdatedAt = field.NewTime(table, "updated_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *alertRule) WithContext(ctx context.Context) IAlertRuleDo {
+	return a.alertRuleDo.WithContext(ctx)
+}
+
+func (a alertRule) TableName() string { return a.alertRuleDo.TableName() }
+
+func (a alertRule) Alias() string { return a.alertRuleDo.Alias() }
+
+func (a alertRule) Columns(cols ...field.Expr) gen.Columns { return a.alertRuleDo.Columns(cols...) }
+
+func (a *alertRule) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *alertRule) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 20)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["name"] = a.Name
+	a.fieldMap["metric"] = a.Metric
+	a.fieldMap["threshold"] = a.Threshold
+	a.fieldMap["window_min"] = a.WindowMin
+	a.fieldMap["group_id"] = a.GroupID
+	a.fieldMap["channel_ids"] = a.ChannelIDs
+	a.fieldMap["silence_min"] = a.SilenceMin
+	a.fieldMap["enabled"] = a.Enabled
+	a.fieldMap["memo"] = a.Memo
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["firing"] = a.Firing
+	a.fieldMap["last_value"] = a.LastValue
+	a.fieldMap["last_fired_at"] = a.LastFiredAt
+	a.fieldMap["last_evaluated_at"] = a.LastEvaluatedAt
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["reviser"] = a.Reviser
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+}
+
+func (a alertRule) clone(db *gorm.DB) alertRule {
+	a.alertRuleDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a alertRule) replaceDB(db *gorm.DB) alertRule {
+	a.alertRuleDo.ReplaceDB(db)
+	return a
+}
+
+type alertRuleDo struct{ gen.DO }
+
+type IAlertRuleDo interface {
+	gen.SubQuery
+	Debug() IAlertRuleDo
+	WithContext(ctx context.Context) IAlertRuleDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IAlertRuleDo
+	WriteDB() IAlertRuleDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IAlertRuleDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IAlertRuleDo
+	Not(conds ...gen.Condition) IAlertRuleDo
+	Or(conds ...gen.Condition) IAlertRuleDo
+	Select(conds ...field.Expr) IAlertRuleDo
+	Where(conds ...gen.Condition) IAlertRuleDo
+	Order(conds ...field.Expr) IAlertRuleDo
+	Distinct(cols ...field.Expr) IAlertRuleDo
+	Omit(cols ...field.Expr) IAlertRuleDo
+	Join(table schema.Tabler, on ...field.Expr) IAlertRuleDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IAlertRuleDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IAlertRuleDo
+	Group(cols ...field.Expr) IAlertRuleDo
+	Having(conds ...gen.Condition) IAlertRuleDo
+	Limit(limit int) IAlertRuleDo
+	Offset(offset int) IAlertRuleDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IAlertRuleDo
+	Unscoped() IAlertRuleDo
+	Create(values ...*table.AlertRule) error
+	CreateInBatches(values []*table.AlertRule, batchSize int) error
+	Save(values ...*table.AlertRule) error
+	First() (*table.AlertRule, error)
+	Take() (*table.AlertRule, error)
+	Last() (*table.AlertRule, error)
+	Find() ([]*table.AlertRule, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AlertRule, err error)
+	FindInBatches(result *[]*table.AlertRule, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.AlertRule) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IAlertRuleDo
+	Assign(attrs ...field.AssignExpr) IAlertRuleDo
+	Joins(fields ...field.RelationField) IAlertRuleDo
+	Preload(fields ...field.RelationField) IAlertRuleDo
+	FirstOrInit() (*table.AlertRule, error)
+	FirstOrCreate() (*table.AlertRule, error)
+	FindByPage(offset int, limit int) (result []*table.AlertRule, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IAlertRuleDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a alertRuleDo) Debug() IAlertRuleDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a alertRuleDo) WithContext(ctx context.Context) IAlertRuleDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a alertRuleDo) ReadDB() IAlertRuleDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a alertRuleDo) WriteDB() IAlertRuleDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a alertRuleDo) Session(config *gorm.Session) IAlertRuleDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a alertRuleDo) Clauses(conds ...clause.Expression) IAlertRuleDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a alertRuleDo) Returning(value interface{}, columns ...string) IAlertRuleDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a alertRuleDo) Not(conds ...gen.Condition) IAlertRuleDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a alertRuleDo) Or(conds ...gen.Condition) IAlertRuleDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a alertRuleDo) Select(conds ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a alertRuleDo) Where(conds ...gen.Condition) IAlertRuleDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a alertRuleDo) Order(conds ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a alertRuleDo) Distinct(cols ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a alertRuleDo) Omit(cols ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a alertRuleDo) Join(table schema.Tabler, on ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a alertRuleDo) LeftJoin(table schema.Tabler, on ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a alertRuleDo) RightJoin(table schema.Tabler, on ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a alertRuleDo) Group(cols ...field.Expr) IAlertRuleDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a alertRuleDo) Having(conds ...gen.Condition) IAlertRuleDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a alertRuleDo) Limit(limit int) IAlertRuleDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a alertRuleDo) Offset(offset int) IAlertRuleDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a alertRuleDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IAlertRuleDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a alertRuleDo) Unscoped() IAlertRuleDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a alertRuleDo) Create(values ...*table.AlertRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a alertRuleDo) CreateInBatches(values []*table.AlertRule, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a alertRuleDo) Save(values ...*table.AlertRule) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a alertRuleDo) First() (*table.AlertRule, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AlertRule), nil
+	}
+}
+
+func (a alertRuleDo) Take() (*table.AlertRule, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AlertRule), nil
+	}
+}
+
+func (a alertRuleDo) Last() (*table.AlertRule, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AlertRule), nil
+	}
+}
+
+func (a alertRuleDo) Find() ([]*table.AlertRule, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.AlertRule), err
+}
+
+func (a alertRuleDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AlertRule, err error) {
+	buf := make([]*table.AlertRule, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a alertRuleDo) FindInBatches(result *[]*table.AlertRule, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a alertRuleDo) Attrs(attrs ...field.AssignExpr) IAlertRuleDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a alertRuleDo) Assign(attrs ...field.AssignExpr) IAlertRuleDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a alertRuleDo) Joins(fields ...field.RelationField) IAlertRuleDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a alertRuleDo) Preload(fields ...field.RelationField) IAlertRuleDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a alertRuleDo) FirstOrInit() (*table.AlertRule, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AlertRule), nil
+	}
+}
+
+func (a alertRuleDo) FirstOrCreate() (*table.AlertRule, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AlertRule), nil
+	}
+}
+
+func (a alertRuleDo) FindByPage(offset int, limit int) (result []*table.AlertRule, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a alertRuleDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a alertRuleDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a alertRuleDo) Delete(models ...*table.AlertRule) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *alertRuleDo) withDO(do gen.Dao) *alertRuleDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
@@ -0,0 +1,420 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newAppConsumptionStat(db *gorm.DB, opts ...gen.DOOption) appConsumptionStat {
+	_appConsumptionStat := appConsumptionStat{}
+
+	_appConsumptionStat.appConsumptionStatDo.UseDB(db, opts...)
+	_appConsumptionStat.appConsumptionStatDo.UseModel(&table.AppConsumptionStat{})
+
+	tableName := _appConsumptionStat.appConsumptionStatDo.TableName()
+	_appConsumptionStat.ALL = field.NewAsterisk(tableName)
+	_appConsumptionStat.ID = field.NewUint32(tableName, "id")
+	_appConsumptionStat.StatDate = field.NewString(tableName, "stat_date")
+	_appConsumptionStat.PullCount = field.NewUint64(tableName, "pull_count")
+	_appConsumptionStat.DownloadBytes = field.NewUint64(tableName, "download_bytes")
+	_appConsumptionStat.WatchSeconds = field.NewUint64(tableName, "watch_seconds")
+	_appConsumptionStat.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_appConsumptionStat.BizID = field.NewUint32(tableName, "biz_id")
+	_appConsumptionStat.AppID = field.NewUint32(tableName, "app_id")
+
+	_appConsumptionStat.fillFieldMap()
+
+	return _appConsumptionStat
+}
+
+type appConsumptionStat struct {
+	appConsumptionStatDo appConsumptionStatDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	StatDate      field.String
+	PullCount     field.Uint64
+	DownloadBytes field.Uint64
+	WatchSeconds  field.Uint64
+	UpdatedAt     field.Time
+	BizID         field.Uint32
+	AppID         field.Uint32
+
+	fieldMap map[string]field.Expr
+}
+
+func (a appConsumptionStat) Table(newTableName string) *appConsumptionStat {
+	a.appConsumptionStatDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a appConsumptionStat) As(alias string) *appConsumptionStat {
+	a.appConsumptionStatDo.DO = *(a.appConsumptionStatDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *appConsumptionStat) updateTableName(table string) *appConsumptionStat {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.StatDate = field.NewString(table, "stat_date")
+	a.PullCount = field.NewUint64(table, "pull_count")
+	a.DownloadBytes = field.NewUint64(table, "download_bytes")
+	a.WatchSeconds = field.NewUint64(table, "watch_seconds")
+	a.UpdatedAt = field.NewTime(table, "updated_at")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *appConsumptionStat) WithContext(ctx context.Context) IAppConsumptionStatDo {
+	return a.appConsumptionStatDo.WithContext(ctx)
+}
+
+func (a appConsumptionStat) TableName() string { return a.appConsumptionStatDo.TableName() }
+
+func (a appConsumptionStat) Alias() string { return a.appConsumptionStatDo.Alias() }
+
+func (a appConsumptionStat) Columns(cols ...field.Expr) gen.Columns {
+	return a.appConsumptionStatDo.Columns(cols...)
+}
+
+func (a *appConsumptionStat) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *appConsumptionStat) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 8)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["stat_date"] = a.StatDate
+	a.fieldMap["pull_count"] = a.PullCount
+	a.fieldMap["download_bytes"] = a.DownloadBytes
+	a.fieldMap["watch_seconds"] = a.WatchSeconds
+	a.fieldMap["updated_at"] = a.UpdatedAt
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+}
+
+func (a appConsumptionStat) clone(db *gorm.DB) appConsumptionStat {
+	a.appConsumptionStatDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a appConsumptionStat) replaceDB(db *gorm.DB) appConsumptionStat {
+	a.appConsumptionStatDo.ReplaceDB(db)
+	return a
+}
+
+type appConsumptionStatDo struct{ gen.DO }
+
+type IAppConsumptionStatDo interface {
+	gen.SubQuery
+	Debug() IAppConsumptionStatDo
+	WithContext(ctx context.Context) IAppConsumptionStatDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IAppConsumptionStatDo
+	WriteDB() IAppConsumptionStatDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IAppConsumptionStatDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IAppConsumptionStatDo
+	Not(conds ...gen.Condition) IAppConsumptionStatDo
+	Or(conds ...gen.Condition) IAppConsumptionStatDo
+	Select(conds ...field.Expr) IAppConsumptionStatDo
+	Where(conds ...gen.Condition) IAppConsumptionStatDo
+	Order(conds ...field.Expr) IAppConsumptionStatDo
+	Distinct(cols ...field.Expr) IAppConsumptionStatDo
+	Omit(cols ...field.Expr) IAppConsumptionStatDo
+	Join(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo
+	Group(cols ...field.Expr) IAppConsumptionStatDo
+	Having(conds ...gen.Condition) IAppConsumptionStatDo
+	Limit(limit int) IAppConsumptionStatDo
+	Offset(offset int) IAppConsumptionStatDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IAppConsumptionStatDo
+	Unscoped() IAppConsumptionStatDo
+	Create(values ...*table.AppConsumptionStat) error
+	CreateInBatches(values []*table.AppConsumptionStat, batchSize int) error
+	Save(values ...*table.AppConsumptionStat) error
+	First() (*table.AppConsumptionStat, error)
+	Take() (*table.AppConsumptionStat, error)
+	Last() (*table.AppConsumptionStat, error)
+	Find() ([]*table.AppConsumptionStat, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppConsumptionStat, err error)
+	FindInBatches(result *[]*table.AppConsumptionStat, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.AppConsumptionStat) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IAppConsumptionStatDo
+	Assign(attrs ...field.AssignExpr) IAppConsumptionStatDo
+	Joins(fields ...field.RelationField) IAppConsumptionStatDo
+	Preload(fields ...field.RelationField) IAppConsumptionStatDo
+	FirstOrInit() (*table.AppConsumptionStat, error)
+	FirstOrCreate() (*table.AppConsumptionStat, error)
+	FindByPage(offset int, limit int) (result []*table.AppConsumptionStat, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IAppConsumptionStatDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a appConsumptionStatDo) Debug() IAppConsumptionStatDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a appConsumptionStatDo) WithContext(ctx context.Context) IAppConsumptionStatDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a appConsumptionStatDo) ReadDB() IAppConsumptionStatDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a appConsumptionStatDo) WriteDB() IAppConsumptionStatDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a appConsumptionStatDo) Session(config *gorm.Session) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a appConsumptionStatDo) Clauses(conds ...clause.Expression) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a appConsumptionStatDo) Returning(value interface{}, columns ...string) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a appConsumptionStatDo) Not(conds ...gen.Condition) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a appConsumptionStatDo) Or(conds ...gen.Condition) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a appConsumptionStatDo) Select(conds ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a appConsumptionStatDo) Where(conds ...gen.Condition) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a appConsumptionStatDo) Order(conds ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a appConsumptionStatDo) Distinct(cols ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a appConsumptionStatDo) Omit(cols ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a appConsumptionStatDo) Join(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a appConsumptionStatDo) LeftJoin(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a appConsumptionStatDo) RightJoin(table schema.Tabler, on ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a appConsumptionStatDo) Group(cols ...field.Expr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a appConsumptionStatDo) Having(conds ...gen.Condition) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a appConsumptionStatDo) Limit(limit int) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a appConsumptionStatDo) Offset(offset int) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a appConsumptionStatDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a appConsumptionStatDo) Unscoped() IAppConsumptionStatDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a appConsumptionStatDo) Create(values ...*table.AppConsumptionStat) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a appConsumptionStatDo) CreateInBatches(values []*table.AppConsumptionStat, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a appConsumptionStatDo) Save(values ...*table.AppConsumptionStat) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a appConsumptionStatDo) First() (*table.AppConsumptionStat, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppConsumptionStat), nil
+	}
+}
+
+func (a appConsumptionStatDo) Take() (*table.AppConsumptionStat, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppConsumptionStat), nil
+	}
+}
+
+func (a appConsumptionStatDo) Last() (*table.AppConsumptionStat, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppConsumptionStat), nil
+	}
+}
+
+func (a appConsumptionStatDo) Find() ([]*table.AppConsumptionStat, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.AppConsumptionStat), err
+}
+
+func (a appConsumptionStatDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppConsumptionStat, err error) {
+	buf := make([]*table.AppConsumptionStat, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a appConsumptionStatDo) FindInBatches(result *[]*table.AppConsumptionStat, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a appConsumptionStatDo) Attrs(attrs ...field.AssignExpr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a appConsumptionStatDo) Assign(attrs ...field.AssignExpr) IAppConsumptionStatDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a appConsumptionStatDo) Joins(fields ...field.RelationField) IAppConsumptionStatDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a appConsumptionStatDo) Preload(fields ...field.RelationField) IAppConsumptionStatDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a appConsumptionStatDo) FirstOrInit() (*table.AppConsumptionStat, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppConsumptionStat), nil
+	}
+}
+
+func (a appConsumptionStatDo) FirstOrCreate() (*table.AppConsumptionStat, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppConsumptionStat), nil
+	}
+}
+
+func (a appConsumptionStatDo) FindByPage(offset int, limit int) (result []*table.AppConsumptionStat, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a appConsumptionStatDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a appConsumptionStatDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a appConsumptionStatDo) Delete(models ...*table.AppConsumptionStat) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *appConsumptionStatDo) withDO(do gen.Dao) *appConsumptionStatDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
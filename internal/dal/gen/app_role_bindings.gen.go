@@ -0,0 +1,432 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newAppRoleBinding(db *gorm.DB, opts ...gen.DOOption) appRoleBinding {
+	_appRoleBinding := appRoleBinding{}
+
+	_appRoleBinding.appRoleBindingDo.UseDB(db, opts...)
+	_appRoleBinding.appRoleBindingDo.UseModel(&table.AppRoleBinding{})
+
+	tableName := _appRoleBinding.appRoleBindingDo.TableName()
+	_appRoleBinding.ALL = field.NewAsterisk(tableName)
+	_appRoleBinding.ID = field.NewUint32(tableName, "id")
+	_appRoleBinding.User = field.NewString(tableName, "user")
+	_appRoleBinding.Role = field.NewString(tableName, "role")
+	_appRoleBinding.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_appRoleBinding.Reason = field.NewString(tableName, "reason")
+	_appRoleBinding.BizID = field.NewUint32(tableName, "biz_id")
+	_appRoleBinding.AppID = field.NewUint32(tableName, "app_id")
+	_appRoleBinding.Creator = field.NewString(tableName, "creator")
+	_appRoleBinding.Reviser = field.NewString(tableName, "reviser")
+	_appRoleBinding.CreatedAt = field.NewTime(tableName, "created_at")
+	_appRoleBinding.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_appRoleBinding.fillFieldMap()
+
+	return _appRoleBinding
+}
+
+type appRoleBinding struct {
+	appRoleBindingDo appRoleBindingDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	User      field.String
+	Role      field.String
+	ExpiredAt field.Time
+	Reason    field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a appRoleBinding) Table(newTableName string) *appRoleBinding {
+	a.appRoleBindingDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a appRoleBinding) As(alias string) *appRoleBinding {
+	a.appRoleBindingDo.DO = *(a.appRoleBindingDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *appRoleBinding) updateTableName(table string) *appRoleBinding {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.User = field.NewString(table, "user")
+	a.Role = field.NewString(table, "role")
+	a.ExpiredAt = field.NewTime(table, "expired_at")
+	a.Reason = field.NewString(table, "reason")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.Creator = field.NewString(table, "creator")
+	a.Reviser = field.NewString(table, "reviser")
+	a.CreatedAt = field.NewTime(table, "created_at")
+	a.UpdatedAt = field.NewTime(table, "updated_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *appRoleBinding) WithContext(ctx context.Context) IAppRoleBindingDo {
+	return a.appRoleBindingDo.WithContext(ctx)
+}
+
+func (a appRoleBinding) TableName() string { return a.appRoleBindingDo.TableName() }
+
+func (a appRoleBinding) Alias() string { return a.appRoleBindingDo.Alias() }
+
+func (a appRoleBinding) Columns(cols ...field.Expr) gen.Columns {
+	return a.appRoleBindingDo.Columns(cols...)
+}
+
+func (a *appRoleBinding) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *appRoleBinding) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 11)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["user"] = a.User
+	a.fieldMap["role"] = a.Role
+	a.fieldMap["expired_at"] = a.ExpiredAt
+	a.fieldMap["reason"] = a.Reason
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["reviser"] = a.Reviser
+	a.fieldMap["created_at"] = a.CreatedAt
+	a.fieldMap["updated_at"] = a.UpdatedAt
+}
+
+func (a appRoleBinding) clone(db *gorm.DB) appRoleBinding {
+	a.appRoleBindingDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a appRoleBinding) replaceDB(db *gorm.DB) appRoleBinding {
+	a.appRoleBindingDo.ReplaceDB(db)
+	return a
+}
+
+type appRoleBindingDo struct{ gen.DO }
+
+type IAppRoleBindingDo interface {
+	gen.SubQuery
+	Debug() IAppRoleBindingDo
+	WithContext(ctx context.Context) IAppRoleBindingDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IAppRoleBindingDo
+	WriteDB() IAppRoleBindingDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IAppRoleBindingDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IAppRoleBindingDo
+	Not(conds ...gen.Condition) IAppRoleBindingDo
+	Or(conds ...gen.Condition) IAppRoleBindingDo
+	Select(conds ...field.Expr) IAppRoleBindingDo
+	Where(conds ...gen.Condition) IAppRoleBindingDo
+	Order(conds ...field.Expr) IAppRoleBindingDo
+	Distinct(cols ...field.Expr) IAppRoleBindingDo
+	Omit(cols ...field.Expr) IAppRoleBindingDo
+	Join(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo
+	Group(cols ...field.Expr) IAppRoleBindingDo
+	Having(conds ...gen.Condition) IAppRoleBindingDo
+	Limit(limit int) IAppRoleBindingDo
+	Offset(offset int) IAppRoleBindingDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IAppRoleBindingDo
+	Unscoped() IAppRoleBindingDo
+	Create(values ...*table.AppRoleBinding) error
+	CreateInBatches(values []*table.AppRoleBinding, batchSize int) error
+	Save(values ...*table.AppRoleBinding) error
+	First() (*table.AppRoleBinding, error)
+	Take() (*table.AppRoleBinding, error)
+	Last() (*table.AppRoleBinding, error)
+	Find() ([]*table.AppRoleBinding, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppRoleBinding, err error)
+	FindInBatches(result *[]*table.AppRoleBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.AppRoleBinding) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IAppRoleBindingDo
+	Assign(attrs ...field.AssignExpr) IAppRoleBindingDo
+	Joins(fields ...field.RelationField) IAppRoleBindingDo
+	Preload(fields ...field.RelationField) IAppRoleBindingDo
+	FirstOrInit() (*table.AppRoleBinding, error)
+	FirstOrCreate() (*table.AppRoleBinding, error)
+	FindByPage(offset int, limit int) (result []*table.AppRoleBinding, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IAppRoleBindingDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a appRoleBindingDo) Debug() IAppRoleBindingDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a appRoleBindingDo) WithContext(ctx context.Context) IAppRoleBindingDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a appRoleBindingDo) ReadDB() IAppRoleBindingDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a appRoleBindingDo) WriteDB() IAppRoleBindingDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a appRoleBindingDo) Session(config *gorm.Session) IAppRoleBindingDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a appRoleBindingDo) Clauses(conds ...clause.Expression) IAppRoleBindingDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a appRoleBindingDo) Returning(value interface{}, columns ...string) IAppRoleBindingDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a appRoleBindingDo) Not(conds ...gen.Condition) IAppRoleBindingDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a appRoleBindingDo) Or(conds ...gen.Condition) IAppRoleBindingDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a appRoleBindingDo) Select(conds ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a appRoleBindingDo) Where(conds ...gen.Condition) IAppRoleBindingDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a appRoleBindingDo) Order(conds ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a appRoleBindingDo) Distinct(cols ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a appRoleBindingDo) Omit(cols ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a appRoleBindingDo) Join(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a appRoleBindingDo) LeftJoin(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a appRoleBindingDo) RightJoin(table schema.Tabler, on ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a appRoleBindingDo) Group(cols ...field.Expr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a appRoleBindingDo) Having(conds ...gen.Condition) IAppRoleBindingDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a appRoleBindingDo) Limit(limit int) IAppRoleBindingDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a appRoleBindingDo) Offset(offset int) IAppRoleBindingDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a appRoleBindingDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IAppRoleBindingDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a appRoleBindingDo) Unscoped() IAppRoleBindingDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a appRoleBindingDo) Create(values ...*table.AppRoleBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a appRoleBindingDo) CreateInBatches(values []*table.AppRoleBinding, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a appRoleBindingDo) Save(values ...*table.AppRoleBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a appRoleBindingDo) First() (*table.AppRoleBinding, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppRoleBinding), nil
+	}
+}
+
+func (a appRoleBindingDo) Take() (*table.AppRoleBinding, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppRoleBinding), nil
+	}
+}
+
+func (a appRoleBindingDo) Last() (*table.AppRoleBinding, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppRoleBinding), nil
+	}
+}
+
+func (a appRoleBindingDo) Find() ([]*table.AppRoleBinding, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.AppRoleBinding), err
+}
+
+func (a appRoleBindingDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppRoleBinding, err error) {
+	buf := make([]*table.AppRoleBinding, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a appRoleBindingDo) FindInBatches(result *[]*table.AppRoleBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a appRoleBindingDo) Attrs(attrs ...field.AssignExpr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a appRoleBindingDo) Assign(attrs ...field.AssignExpr) IAppRoleBindingDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a appRoleBindingDo) Joins(fields ...field.RelationField) IAppRoleBindingDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a appRoleBindingDo) Preload(fields ...field.RelationField) IAppRoleBindingDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a appRoleBindingDo) FirstOrInit() (*table.AppRoleBinding, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppRoleBinding), nil
+	}
+}
+
+func (a appRoleBindingDo) FirstOrCreate() (*table.AppRoleBinding, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppRoleBinding), nil
+	}
+}
+
+func (a appRoleBindingDo) FindByPage(offset int, limit int) (result []*table.AppRoleBinding, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a appRoleBindingDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a appRoleBindingDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a appRoleBindingDo) Delete(models ...*table.AppRoleBinding) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *appRoleBindingDo) withDO(do gen.Dao) *appRoleBindingDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
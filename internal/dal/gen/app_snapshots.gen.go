@@ -0,0 +1,418 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newAppSnapshot(db *gorm.DB, opts ...gen.DOOption) appSnapshot {
+	_appSnapshot := appSnapshot{}
+
+	_appSnapshot.appSnapshotDo.UseDB(db, opts...)
+	_appSnapshot.appSnapshotDo.UseModel(&table.AppSnapshot{})
+
+	tableName := _appSnapshot.appSnapshotDo.TableName()
+	_appSnapshot.ALL = field.NewAsterisk(tableName)
+	_appSnapshot.ID = field.NewUint32(tableName, "id")
+	_appSnapshot.Name = field.NewString(tableName, "name")
+	_appSnapshot.Memo = field.NewString(tableName, "memo")
+	_appSnapshot.Data = field.NewField(tableName, "data")
+	_appSnapshot.BizID = field.NewUint32(tableName, "biz_id")
+	_appSnapshot.AppID = field.NewUint32(tableName, "app_id")
+	_appSnapshot.Creator = field.NewString(tableName, "creator")
+	_appSnapshot.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_appSnapshot.fillFieldMap()
+
+	return _appSnapshot
+}
+
+type appSnapshot struct {
+	appSnapshotDo appSnapshotDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Name      field.String
+	Memo      field.String
+	Data      field.Field
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	CreatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (a appSnapshot) Table(newTableName string) *appSnapshot {
+	a.appSnapshotDo.UseTable(newTableName)
+	return a.updateTableName(newTableName)
+}
+
+func (a appSnapshot) As(alias string) *appSnapshot {
+	a.appSnapshotDo.DO = *(a.appSnapshotDo.As(alias).(*gen.DO))
+	return a.updateTableName(alias)
+}
+
+func (a *appSnapshot) updateTableName(table string) *appSnapshot {
+	a.ALL = field.NewAsterisk(table)
+	a.ID = field.NewUint32(table, "id")
+	a.Name = field.NewString(table, "name")
+	a.Memo = field.NewString(table, "memo")
+	a.Data = field.NewField(table, "data")
+	a.BizID = field.NewUint32(table, "biz_id")
+	a.AppID = field.NewUint32(table, "app_id")
+	a.Creator = field.NewString(table, "creator")
+	a.CreatedAt = field.NewTime(table, "created_at")
+
+	a.fillFieldMap()
+
+	return a
+}
+
+func (a *appSnapshot) WithContext(ctx context.Context) IAppSnapshotDo {
+	return a.appSnapshotDo.WithContext(ctx)
+}
+
+func (a appSnapshot) TableName() string { return a.appSnapshotDo.TableName() }
+
+func (a appSnapshot) Alias() string { return a.appSnapshotDo.Alias() }
+
+func (a appSnapshot) Columns(cols ...field.Expr) gen.Columns { return a.appSnapshotDo.Columns(cols...) }
+
+func (a *appSnapshot) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := a.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (a *appSnapshot) fillFieldMap() {
+	a.fieldMap = make(map[string]field.Expr, 8)
+	a.fieldMap["id"] = a.ID
+	a.fieldMap["name"] = a.Name
+	a.fieldMap["memo"] = a.Memo
+	a.fieldMap["data"] = a.Data
+	a.fieldMap["biz_id"] = a.BizID
+	a.fieldMap["app_id"] = a.AppID
+	a.fieldMap["creator"] = a.Creator
+	a.fieldMap["created_at"] = a.CreatedAt
+}
+
+func (a appSnapshot) clone(db *gorm.DB) appSnapshot {
+	a.appSnapshotDo.ReplaceConnPool(db.Statement.ConnPool)
+	return a
+}
+
+func (a appSnapshot) replaceDB(db *gorm.DB) appSnapshot {
+	a.appSnapshotDo.ReplaceDB(db)
+	return a
+}
+
+type appSnapshotDo struct{ gen.DO }
+
+type IAppSnapshotDo interface {
+	gen.SubQuery
+	Debug() IAppSnapshotDo
+	WithContext(ctx context.Context) IAppSnapshotDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IAppSnapshotDo
+	WriteDB() IAppSnapshotDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IAppSnapshotDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IAppSnapshotDo
+	Not(conds ...gen.Condition) IAppSnapshotDo
+	Or(conds ...gen.Condition) IAppSnapshotDo
+	Select(conds ...field.Expr) IAppSnapshotDo
+	Where(conds ...gen.Condition) IAppSnapshotDo
+	Order(conds ...field.Expr) IAppSnapshotDo
+	Distinct(cols ...field.Expr) IAppSnapshotDo
+	Omit(cols ...field.Expr) IAppSnapshotDo
+	Join(table schema.Tabler, on ...field.Expr) IAppSnapshotDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IAppSnapshotDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IAppSnapshotDo
+	Group(cols ...field.Expr) IAppSnapshotDo
+	Having(conds ...gen.Condition) IAppSnapshotDo
+	Limit(limit int) IAppSnapshotDo
+	Offset(offset int) IAppSnapshotDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IAppSnapshotDo
+	Unscoped() IAppSnapshotDo
+	Create(values ...*table.AppSnapshot) error
+	CreateInBatches(values []*table.AppSnapshot, batchSize int) error
+	Save(values ...*table.AppSnapshot) error
+	First() (*table.AppSnapshot, error)
+	Take() (*table.AppSnapshot, error)
+	Last() (*table.AppSnapshot, error)
+	Find() ([]*table.AppSnapshot, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppSnapshot, err error)
+	FindInBatches(result *[]*table.AppSnapshot, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.AppSnapshot) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IAppSnapshotDo
+	Assign(attrs ...field.AssignExpr) IAppSnapshotDo
+	Joins(fields ...field.RelationField) IAppSnapshotDo
+	Preload(fields ...field.RelationField) IAppSnapshotDo
+	FirstOrInit() (*table.AppSnapshot, error)
+	FirstOrCreate() (*table.AppSnapshot, error)
+	FindByPage(offset int, limit int) (result []*table.AppSnapshot, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IAppSnapshotDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (a appSnapshotDo) Debug() IAppSnapshotDo {
+	return a.withDO(a.DO.Debug())
+}
+
+func (a appSnapshotDo) WithContext(ctx context.Context) IAppSnapshotDo {
+	return a.withDO(a.DO.WithContext(ctx))
+}
+
+func (a appSnapshotDo) ReadDB() IAppSnapshotDo {
+	return a.Clauses(dbresolver.Read)
+}
+
+func (a appSnapshotDo) WriteDB() IAppSnapshotDo {
+	return a.Clauses(dbresolver.Write)
+}
+
+func (a appSnapshotDo) Session(config *gorm.Session) IAppSnapshotDo {
+	return a.withDO(a.DO.Session(config))
+}
+
+func (a appSnapshotDo) Clauses(conds ...clause.Expression) IAppSnapshotDo {
+	return a.withDO(a.DO.Clauses(conds...))
+}
+
+func (a appSnapshotDo) Returning(value interface{}, columns ...string) IAppSnapshotDo {
+	return a.withDO(a.DO.Returning(value, columns...))
+}
+
+func (a appSnapshotDo) Not(conds ...gen.Condition) IAppSnapshotDo {
+	return a.withDO(a.DO.Not(conds...))
+}
+
+func (a appSnapshotDo) Or(conds ...gen.Condition) IAppSnapshotDo {
+	return a.withDO(a.DO.Or(conds...))
+}
+
+func (a appSnapshotDo) Select(conds ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Select(conds...))
+}
+
+func (a appSnapshotDo) Where(conds ...gen.Condition) IAppSnapshotDo {
+	return a.withDO(a.DO.Where(conds...))
+}
+
+func (a appSnapshotDo) Order(conds ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Order(conds...))
+}
+
+func (a appSnapshotDo) Distinct(cols ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Distinct(cols...))
+}
+
+func (a appSnapshotDo) Omit(cols ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Omit(cols...))
+}
+
+func (a appSnapshotDo) Join(table schema.Tabler, on ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Join(table, on...))
+}
+
+func (a appSnapshotDo) LeftJoin(table schema.Tabler, on ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.LeftJoin(table, on...))
+}
+
+func (a appSnapshotDo) RightJoin(table schema.Tabler, on ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.RightJoin(table, on...))
+}
+
+func (a appSnapshotDo) Group(cols ...field.Expr) IAppSnapshotDo {
+	return a.withDO(a.DO.Group(cols...))
+}
+
+func (a appSnapshotDo) Having(conds ...gen.Condition) IAppSnapshotDo {
+	return a.withDO(a.DO.Having(conds...))
+}
+
+func (a appSnapshotDo) Limit(limit int) IAppSnapshotDo {
+	return a.withDO(a.DO.Limit(limit))
+}
+
+func (a appSnapshotDo) Offset(offset int) IAppSnapshotDo {
+	return a.withDO(a.DO.Offset(offset))
+}
+
+func (a appSnapshotDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IAppSnapshotDo {
+	return a.withDO(a.DO.Scopes(funcs...))
+}
+
+func (a appSnapshotDo) Unscoped() IAppSnapshotDo {
+	return a.withDO(a.DO.Unscoped())
+}
+
+func (a appSnapshotDo) Create(values ...*table.AppSnapshot) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Create(values)
+}
+
+func (a appSnapshotDo) CreateInBatches(values []*table.AppSnapshot, batchSize int) error {
+	return a.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (a appSnapshotDo) Save(values ...*table.AppSnapshot) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return a.DO.Save(values)
+}
+
+func (a appSnapshotDo) First() (*table.AppSnapshot, error) {
+	if result, err := a.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppSnapshot), nil
+	}
+}
+
+func (a appSnapshotDo) Take() (*table.AppSnapshot, error) {
+	if result, err := a.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppSnapshot), nil
+	}
+}
+
+func (a appSnapshotDo) Last() (*table.AppSnapshot, error) {
+	if result, err := a.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppSnapshot), nil
+	}
+}
+
+func (a appSnapshotDo) Find() ([]*table.AppSnapshot, error) {
+	result, err := a.DO.Find()
+	return result.([]*table.AppSnapshot), err
+}
+
+func (a appSnapshotDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.AppSnapshot, err error) {
+	buf := make([]*table.AppSnapshot, 0, batchSize)
+	err = a.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (a appSnapshotDo) FindInBatches(result *[]*table.AppSnapshot, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return a.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (a appSnapshotDo) Attrs(attrs ...field.AssignExpr) IAppSnapshotDo {
+	return a.withDO(a.DO.Attrs(attrs...))
+}
+
+func (a appSnapshotDo) Assign(attrs ...field.AssignExpr) IAppSnapshotDo {
+	return a.withDO(a.DO.Assign(attrs...))
+}
+
+func (a appSnapshotDo) Joins(fields ...field.RelationField) IAppSnapshotDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Joins(_f))
+	}
+	return &a
+}
+
+func (a appSnapshotDo) Preload(fields ...field.RelationField) IAppSnapshotDo {
+	for _, _f := range fields {
+		a = *a.withDO(a.DO.Preload(_f))
+	}
+	return &a
+}
+
+func (a appSnapshotDo) FirstOrInit() (*table.AppSnapshot, error) {
+	if result, err := a.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppSnapshot), nil
+	}
+}
+
+func (a appSnapshotDo) FirstOrCreate() (*table.AppSnapshot, error) {
+	if result, err := a.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.AppSnapshot), nil
+	}
+}
+
+func (a appSnapshotDo) FindByPage(offset int, limit int) (result []*table.AppSnapshot, count int64, err error) {
+	result, err = a.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = a.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (a appSnapshotDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = a.Count()
+	if err != nil {
+		return
+	}
+
+	err = a.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (a appSnapshotDo) Scan(result interface{}) (err error) {
+	return a.DO.Scan(result)
+}
+
+func (a appSnapshotDo) Delete(models ...*table.AppSnapshot) (result gen.ResultInfo, err error) {
+	return a.DO.Delete(models)
+}
+
+func (a *appSnapshotDo) withDO(do gen.Dao) *appSnapshotDo {
+	a.DO = *do.(*gen.DO)
+	return a
+}
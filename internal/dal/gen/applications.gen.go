@@ -38,6 +38,10 @@ func newApp(db *gorm.DB, opts ...gen.DOOption) app {
 	_app.ApproveType = field.NewString(tableName, "approve_type")
 	_app.IsApprove = field.NewBool(tableName, "is_approve")
 	_app.Approver = field.NewString(tableName, "approver")
+	_app.ReleaseNotesPolicy = field.NewField(tableName, "release_notes_policy")
+	_app.State = field.NewString(tableName, "state")
+	_app.Pause = field.NewField(tableName, "pause")
+	_app.MinClientVersion = field.NewString(tableName, "min_client_version")
 	_app.Creator = field.NewString(tableName, "creator")
 	_app.Reviser = field.NewString(tableName, "reviser")
 	_app.CreatedAt = field.NewTime(tableName, "created_at")
@@ -51,22 +55,26 @@ func newApp(db *gorm.DB, opts ...gen.DOOption) app {
 type app struct {
 	appDo appDo
 
-	ALL              field.Asterisk
-	ID               field.Uint32
-	BizID            field.Uint32
-	Name             field.String
-	ConfigType       field.String
-	Memo             field.String
-	Alias_           field.String
-	DataType         field.String
-	LastConsumedTime field.Time
-	ApproveType      field.String
-	IsApprove        field.Bool
-	Approver         field.String
-	Creator          field.String
-	Reviser          field.String
-	CreatedAt        field.Time
-	UpdatedAt        field.Time
+	ALL                field.Asterisk
+	ID                 field.Uint32
+	BizID              field.Uint32
+	Name               field.String
+	ConfigType         field.String
+	Memo               field.String
+	Alias_             field.String
+	DataType           field.String
+	LastConsumedTime   field.Time
+	ApproveType        field.String
+	IsApprove          field.Bool
+	Approver           field.String
+	ReleaseNotesPolicy field.Field
+	State              field.String
+	Pause              field.Field
+	MinClientVersion   field.String
+	Creator            field.String
+	Reviser            field.String
+	CreatedAt          field.Time
+	UpdatedAt          field.Time
 
 	fieldMap map[string]field.Expr
 }
@@ -94,6 +102,10 @@ func (a *app) updateTableName(table string) *app {
 	a.ApproveType = field.NewString(table, "approve_type")
 	a.IsApprove = field.NewBool(table, "is_approve")
 	a.Approver = field.NewString(table, "approver")
+	a.ReleaseNotesPolicy = field.NewField(table, "release_notes_policy")
+	a.State = field.NewString(table, "state")
+	a.Pause = field.NewField(table, "pause")
+	a.MinClientVersion = field.NewString(table, "min_client_version")
 	a.Creator = field.NewString(table, "creator")
 	a.Reviser = field.NewString(table, "reviser")
 	a.CreatedAt = field.NewTime(table, "created_at")
@@ -122,7 +134,7 @@ func (a *app) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (a *app) fillFieldMap() {
-	a.fieldMap = make(map[string]field.Expr, 15)
+	a.fieldMap = make(map[string]field.Expr, 19)
 	a.fieldMap["id"] = a.ID
 	a.fieldMap["biz_id"] = a.BizID
 	a.fieldMap["name"] = a.Name
@@ -134,6 +146,10 @@ func (a *app) fillFieldMap() {
 	a.fieldMap["approve_type"] = a.ApproveType
 	a.fieldMap["is_approve"] = a.IsApprove
 	a.fieldMap["approver"] = a.Approver
+	a.fieldMap["release_notes_policy"] = a.ReleaseNotesPolicy
+	a.fieldMap["state"] = a.State
+	a.fieldMap["pause"] = a.Pause
+	a.fieldMap["min_client_version"] = a.MinClientVersion
 	a.fieldMap["creator"] = a.Creator
 	a.fieldMap["reviser"] = a.Reviser
 	a.fieldMap["created_at"] = a.CreatedAt
@@ -0,0 +1,416 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newBizUsage(db *gorm.DB, opts ...gen.DOOption) bizUsage {
+	_bizUsage := bizUsage{}
+
+	_bizUsage.bizUsageDo.UseDB(db, opts...)
+	_bizUsage.bizUsageDo.UseModel(&table.BizUsage{})
+
+	tableName := _bizUsage.bizUsageDo.TableName()
+	_bizUsage.ALL = field.NewAsterisk(tableName)
+	_bizUsage.ID = field.NewUint32(tableName, "id")
+	_bizUsage.Month = field.NewString(tableName, "month")
+	_bizUsage.StorageBytes = field.NewUint64(tableName, "storage_bytes")
+	_bizUsage.EgressBytes = field.NewUint64(tableName, "egress_bytes")
+	_bizUsage.APICalls = field.NewUint64(tableName, "api_calls")
+	_bizUsage.WatchSeconds = field.NewUint64(tableName, "watch_seconds")
+	_bizUsage.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_bizUsage.BizID = field.NewUint32(tableName, "biz_id")
+
+	_bizUsage.fillFieldMap()
+
+	return _bizUsage
+}
+
+type bizUsage struct {
+	bizUsageDo bizUsageDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	Month        field.String
+	StorageBytes field.Uint64
+	EgressBytes  field.Uint64
+	APICalls     field.Uint64
+	WatchSeconds field.Uint64
+	UpdatedAt    field.Time
+	BizID        field.Uint32
+
+	fieldMap map[string]field.Expr
+}
+
+func (b bizUsage) Table(newTableName string) *bizUsage {
+	b.bizUsageDo.UseTable(newTableName)
+	return b.updateTableName(newTableName)
+}
+
+func (b bizUsage) As(alias string) *bizUsage {
+	b.bizUsageDo.DO = *(b.bizUsageDo.As(alias).(*gen.DO))
+	return b.updateTableName(alias)
+}
+
+func (b *bizUsage) updateTableName(table string) *bizUsage {
+	b.ALL = field.NewAsterisk(table)
+	b.ID = field.NewUint32(table, "id")
+	b.Month = field.NewString(table, "month")
+	b.StorageBytes = field.NewUint64(table, "storage_bytes")
+	b.EgressBytes = field.NewUint64(table, "egress_bytes")
+	b.APICalls = field.NewUint64(table, "api_calls")
+	b.WatchSeconds = field.NewUint64(table, "watch_seconds")
+	b.UpdatedAt = field.NewTime(table, "updated_at")
+	b.BizID = field.NewUint32(table, "biz_id")
+
+	b.fillFieldMap()
+
+	return b
+}
+
+func (b *bizUsage) WithContext(ctx context.Context) IBizUsageDo { return b.bizUsageDo.WithContext(ctx) }
+
+func (b bizUsage) TableName() string { return b.bizUsageDo.TableName() }
+
+func (b bizUsage) Alias() string { return b.bizUsageDo.Alias() }
+
+func (b bizUsage) Columns(cols ...field.Expr) gen.Columns { return b.bizUsageDo.Columns(cols...) }
+
+func (b *bizUsage) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := b.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (b *bizUsage) fillFieldMap() {
+	b.fieldMap = make(map[string]field.Expr, 8)
+	b.fieldMap["id"] = b.ID
+	b.fieldMap["month"] = b.Month
+	b.fieldMap["storage_bytes"] = b.StorageBytes
+	b.fieldMap["egress_bytes"] = b.EgressBytes
+	b.fieldMap["api_calls"] = b.APICalls
+	b.fieldMap["watch_seconds"] = b.WatchSeconds
+	b.fieldMap["updated_at"] = b.UpdatedAt
+	b.fieldMap["biz_id"] = b.BizID
+}
+
+func (b bizUsage) clone(db *gorm.DB) bizUsage {
+	b.bizUsageDo.ReplaceConnPool(db.Statement.ConnPool)
+	return b
+}
+
+func (b bizUsage) replaceDB(db *gorm.DB) bizUsage {
+	b.bizUsageDo.ReplaceDB(db)
+	return b
+}
+
+type bizUsageDo struct{ gen.DO }
+
+type IBizUsageDo interface {
+	gen.SubQuery
+	Debug() IBizUsageDo
+	WithContext(ctx context.Context) IBizUsageDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IBizUsageDo
+	WriteDB() IBizUsageDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IBizUsageDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IBizUsageDo
+	Not(conds ...gen.Condition) IBizUsageDo
+	Or(conds ...gen.Condition) IBizUsageDo
+	Select(conds ...field.Expr) IBizUsageDo
+	Where(conds ...gen.Condition) IBizUsageDo
+	Order(conds ...field.Expr) IBizUsageDo
+	Distinct(cols ...field.Expr) IBizUsageDo
+	Omit(cols ...field.Expr) IBizUsageDo
+	Join(table schema.Tabler, on ...field.Expr) IBizUsageDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IBizUsageDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IBizUsageDo
+	Group(cols ...field.Expr) IBizUsageDo
+	Having(conds ...gen.Condition) IBizUsageDo
+	Limit(limit int) IBizUsageDo
+	Offset(offset int) IBizUsageDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IBizUsageDo
+	Unscoped() IBizUsageDo
+	Create(values ...*table.BizUsage) error
+	CreateInBatches(values []*table.BizUsage, batchSize int) error
+	Save(values ...*table.BizUsage) error
+	First() (*table.BizUsage, error)
+	Take() (*table.BizUsage, error)
+	Last() (*table.BizUsage, error)
+	Find() ([]*table.BizUsage, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.BizUsage, err error)
+	FindInBatches(result *[]*table.BizUsage, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.BizUsage) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IBizUsageDo
+	Assign(attrs ...field.AssignExpr) IBizUsageDo
+	Joins(fields ...field.RelationField) IBizUsageDo
+	Preload(fields ...field.RelationField) IBizUsageDo
+	FirstOrInit() (*table.BizUsage, error)
+	FirstOrCreate() (*table.BizUsage, error)
+	FindByPage(offset int, limit int) (result []*table.BizUsage, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IBizUsageDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (b bizUsageDo) Debug() IBizUsageDo {
+	return b.withDO(b.DO.Debug())
+}
+
+func (b bizUsageDo) WithContext(ctx context.Context) IBizUsageDo {
+	return b.withDO(b.DO.WithContext(ctx))
+}
+
+func (b bizUsageDo) ReadDB() IBizUsageDo {
+	return b.Clauses(dbresolver.Read)
+}
+
+func (b bizUsageDo) WriteDB() IBizUsageDo {
+	return b.Clauses(dbresolver.Write)
+}
+
+func (b bizUsageDo) Session(config *gorm.Session) IBizUsageDo {
+	return b.withDO(b.DO.Session(config))
+}
+
+func (b bizUsageDo) Clauses(conds ...clause.Expression) IBizUsageDo {
+	return b.withDO(b.DO.Clauses(conds...))
+}
+
+func (b bizUsageDo) Returning(value interface{}, columns ...string) IBizUsageDo {
+	return b.withDO(b.DO.Returning(value, columns...))
+}
+
+func (b bizUsageDo) Not(conds ...gen.Condition) IBizUsageDo {
+	return b.withDO(b.DO.Not(conds...))
+}
+
+func (b bizUsageDo) Or(conds ...gen.Condition) IBizUsageDo {
+	return b.withDO(b.DO.Or(conds...))
+}
+
+func (b bizUsageDo) Select(conds ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Select(conds...))
+}
+
+func (b bizUsageDo) Where(conds ...gen.Condition) IBizUsageDo {
+	return b.withDO(b.DO.Where(conds...))
+}
+
+func (b bizUsageDo) Order(conds ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Order(conds...))
+}
+
+func (b bizUsageDo) Distinct(cols ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Distinct(cols...))
+}
+
+func (b bizUsageDo) Omit(cols ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Omit(cols...))
+}
+
+func (b bizUsageDo) Join(table schema.Tabler, on ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Join(table, on...))
+}
+
+func (b bizUsageDo) LeftJoin(table schema.Tabler, on ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.LeftJoin(table, on...))
+}
+
+func (b bizUsageDo) RightJoin(table schema.Tabler, on ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.RightJoin(table, on...))
+}
+
+func (b bizUsageDo) Group(cols ...field.Expr) IBizUsageDo {
+	return b.withDO(b.DO.Group(cols...))
+}
+
+func (b bizUsageDo) Having(conds ...gen.Condition) IBizUsageDo {
+	return b.withDO(b.DO.Having(conds...))
+}
+
+func (b bizUsageDo) Limit(limit int) IBizUsageDo {
+	return b.withDO(b.DO.Limit(limit))
+}
+
+func (b bizUsageDo) Offset(offset int) IBizUsageDo {
+	return b.withDO(b.DO.Offset(offset))
+}
+
+func (b bizUsageDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IBizUsageDo {
+	return b.withDO(b.DO.Scopes(funcs...))
+}
+
+func (b bizUsageDo) Unscoped() IBizUsageDo {
+	return b.withDO(b.DO.Unscoped())
+}
+
+func (b bizUsageDo) Create(values ...*table.BizUsage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return b.DO.Create(values)
+}
+
+func (b bizUsageDo) CreateInBatches(values []*table.BizUsage, batchSize int) error {
+	return b.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (b bizUsageDo) Save(values ...*table.BizUsage) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return b.DO.Save(values)
+}
+
+func (b bizUsageDo) First() (*table.BizUsage, error) {
+	if result, err := b.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.BizUsage), nil
+	}
+}
+
+func (b bizUsageDo) Take() (*table.BizUsage, error) {
+	if result, err := b.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.BizUsage), nil
+	}
+}
+
+func (b bizUsageDo) Last() (*table.BizUsage, error) {
+	if result, err := b.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.BizUsage), nil
+	}
+}
+
+func (b bizUsageDo) Find() ([]*table.BizUsage, error) {
+	result, err := b.DO.Find()
+	return result.([]*table.BizUsage), err
+}
+
+func (b bizUsageDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.BizUsage, err error) {
+	buf := make([]*table.BizUsage, 0, batchSize)
+	err = b.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (b bizUsageDo) FindInBatches(result *[]*table.BizUsage, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return b.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (b bizUsageDo) Attrs(attrs ...field.AssignExpr) IBizUsageDo {
+	return b.withDO(b.DO.Attrs(attrs...))
+}
+
+func (b bizUsageDo) Assign(attrs ...field.AssignExpr) IBizUsageDo {
+	return b.withDO(b.DO.Assign(attrs...))
+}
+
+func (b bizUsageDo) Joins(fields ...field.RelationField) IBizUsageDo {
+	for _, _f := range fields {
+		b = *b.withDO(b.DO.Joins(_f))
+	}
+	return &b
+}
+
+func (b bizUsageDo) Preload(fields ...field.RelationField) IBizUsageDo {
+	for _, _f := range fields {
+		b = *b.withDO(b.DO.Preload(_f))
+	}
+	return &b
+}
+
+func (b bizUsageDo) FirstOrInit() (*table.BizUsage, error) {
+	if result, err := b.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.BizUsage), nil
+	}
+}
+
+func (b bizUsageDo) FirstOrCreate() (*table.BizUsage, error) {
+	if result, err := b.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.BizUsage), nil
+	}
+}
+
+func (b bizUsageDo) FindByPage(offset int, limit int) (result []*table.BizUsage, count int64, err error) {
+	result, err = b.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = b.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (b bizUsageDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = b.Count()
+	if err != nil {
+		return
+	}
+
+	err = b.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (b bizUsageDo) Scan(result interface{}) (err error) {
+	return b.DO.Scan(result)
+}
+
+func (b bizUsageDo) Delete(models ...*table.BizUsage) (result gen.ResultInfo, err error) {
+	return b.DO.Delete(models)
+}
+
+func (b *bizUsageDo) withDO(do gen.Dao) *bizUsageDo {
+	b.DO = *do.(*gen.DO)
+	return b
+}
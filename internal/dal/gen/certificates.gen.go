@@ -0,0 +1,446 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newCertificate(db *gorm.DB, opts ...gen.DOOption) certificate {
+	_certificate := certificate{}
+
+	_certificate.certificateDo.UseDB(db, opts...)
+	_certificate.certificateDo.UseModel(&table.Certificate{})
+
+	tableName := _certificate.certificateDo.TableName()
+	_certificate.ALL = field.NewAsterisk(tableName)
+	_certificate.ID = field.NewUint32(tableName, "id")
+	_certificate.ResType = field.NewString(tableName, "res_type")
+	_certificate.ResID = field.NewUint32(tableName, "res_id")
+	_certificate.Name = field.NewString(tableName, "name")
+	_certificate.Signature = field.NewString(tableName, "signature")
+	_certificate.Subject = field.NewString(tableName, "subject")
+	_certificate.DNSNames = field.NewString(tableName, "dns_names")
+	_certificate.NotBefore = field.NewTime(tableName, "not_before")
+	_certificate.NotAfter = field.NewTime(tableName, "not_after")
+	_certificate.BizID = field.NewUint32(tableName, "biz_id")
+	_certificate.AppID = field.NewUint32(tableName, "app_id")
+	_certificate.WarnedAt = field.NewTime(tableName, "warned_at")
+	_certificate.RenewStatus = field.NewString(tableName, "renew_status")
+	_certificate.RenewMessage = field.NewString(tableName, "renew_message")
+	_certificate.ScannedAt = field.NewTime(tableName, "scanned_at")
+
+	_certificate.fillFieldMap()
+
+	return _certificate
+}
+
+type certificate struct {
+	certificateDo certificateDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	ResType      field.String
+	ResID        field.Uint32
+	Name         field.String
+	Signature    field.String
+	Subject      field.String
+	DNSNames     field.String
+	NotBefore    field.Time
+	NotAfter     field.Time
+	BizID        field.Uint32
+	AppID        field.Uint32
+	WarnedAt     field.Time
+	RenewStatus  field.String
+	RenewMessage field.String
+	ScannedAt    field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c certificate) Table(newTableName string) *certificate {
+	c.certificateDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c certificate) As(alias string) *certificate {
+	c.certificateDo.DO = *(c.certificateDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *certificate) updateTableName(table string) *certificate {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.ResType = field.NewString(table, "res_type")
+	c.ResID = field.NewUint32(table, "res_id")
+	c.Name = field.NewString(table, "name")
+	c.Signature = field.NewString(table, "signature")
+	c.Subject = field.NewString(table, "subject")
+	c.DNSNames = field.NewString(table, "dns_names")
+	c.NotBefore = field.NewTime(table, "not_before")
+	c.NotAfter = field.NewTime(table, "not_after")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.WarnedAt = field.NewTime(table, "warned_at")
+	c.RenewStatus = field.NewString(table, "renew_status")
+	c.RenewMessage = field.NewString(table, "renew_message")
+	c.ScannedAt = field.NewTime(table, "scanned_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *certificate) WithContext(ctx context.Context) ICertificateDo {
+	return c.certificateDo.WithContext(ctx)
+}
+
+func (c certificate) TableName() string { return c.certificateDo.TableName() }
+
+func (c certificate) Alias() string { return c.certificateDo.Alias() }
+
+func (c certificate) Columns(cols ...field.Expr) gen.Columns { return c.certificateDo.Columns(cols...) }
+
+func (c *certificate) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *certificate) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 15)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["res_type"] = c.ResType
+	c.fieldMap["res_id"] = c.ResID
+	c.fieldMap["name"] = c.Name
+	c.fieldMap["signature"] = c.Signature
+	c.fieldMap["subject"] = c.Subject
+	c.fieldMap["dns_names"] = c.DNSNames
+	c.fieldMap["not_before"] = c.NotBefore
+	c.fieldMap["not_after"] = c.NotAfter
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["warned_at"] = c.WarnedAt
+	c.fieldMap["renew_status"] = c.RenewStatus
+	c.fieldMap["renew_message"] = c.RenewMessage
+	c.fieldMap["scanned_at"] = c.ScannedAt
+}
+
+func (c certificate) clone(db *gorm.DB) certificate {
+	c.certificateDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c certificate) replaceDB(db *gorm.DB) certificate {
+	c.certificateDo.ReplaceDB(db)
+	return c
+}
+
+type certificateDo struct{ gen.DO }
+
+type ICertificateDo interface {
+	gen.SubQuery
+	Debug() ICertificateDo
+	WithContext(ctx context.Context) ICertificateDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICertificateDo
+	WriteDB() ICertificateDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICertificateDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICertificateDo
+	Not(conds ...gen.Condition) ICertificateDo
+	Or(conds ...gen.Condition) ICertificateDo
+	Select(conds ...field.Expr) ICertificateDo
+	Where(conds ...gen.Condition) ICertificateDo
+	Order(conds ...field.Expr) ICertificateDo
+	Distinct(cols ...field.Expr) ICertificateDo
+	Omit(cols ...field.Expr) ICertificateDo
+	Join(table schema.Tabler, on ...field.Expr) ICertificateDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICertificateDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICertificateDo
+	Group(cols ...field.Expr) ICertificateDo
+	Having(conds ...gen.Condition) ICertificateDo
+	Limit(limit int) ICertificateDo
+	Offset(offset int) ICertificateDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICertificateDo
+	Unscoped() ICertificateDo
+	Create(values ...*table.Certificate) error
+	CreateInBatches(values []*table.Certificate, batchSize int) error
+	Save(values ...*table.Certificate) error
+	First() (*table.Certificate, error)
+	Take() (*table.Certificate, error)
+	Last() (*table.Certificate, error)
+	Find() ([]*table.Certificate, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Certificate, err error)
+	FindInBatches(result *[]*table.Certificate, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.Certificate) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICertificateDo
+	Assign(attrs ...field.AssignExpr) ICertificateDo
+	Joins(fields ...field.RelationField) ICertificateDo
+	Preload(fields ...field.RelationField) ICertificateDo
+	FirstOrInit() (*table.Certificate, error)
+	FirstOrCreate() (*table.Certificate, error)
+	FindByPage(offset int, limit int) (result []*table.Certificate, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICertificateDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c certificateDo) Debug() ICertificateDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c certificateDo) WithContext(ctx context.Context) ICertificateDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c certificateDo) ReadDB() ICertificateDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c certificateDo) WriteDB() ICertificateDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c certificateDo) Session(config *gorm.Session) ICertificateDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c certificateDo) Clauses(conds ...clause.Expression) ICertificateDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c certificateDo) Returning(value interface{}, columns ...string) ICertificateDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c certificateDo) Not(conds ...gen.Condition) ICertificateDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c certificateDo) Or(conds ...gen.Condition) ICertificateDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c certificateDo) Select(conds ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c certificateDo) Where(conds ...gen.Condition) ICertificateDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c certificateDo) Order(conds ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c certificateDo) Distinct(cols ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c certificateDo) Omit(cols ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c certificateDo) Join(table schema.Tabler, on ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c certificateDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c certificateDo) RightJoin(table schema.Tabler, on ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c certificateDo) Group(cols ...field.Expr) ICertificateDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c certificateDo) Having(conds ...gen.Condition) ICertificateDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c certificateDo) Limit(limit int) ICertificateDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c certificateDo) Offset(offset int) ICertificateDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c certificateDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICertificateDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c certificateDo) Unscoped() ICertificateDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c certificateDo) Create(values ...*table.Certificate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c certificateDo) CreateInBatches(values []*table.Certificate, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c certificateDo) Save(values ...*table.Certificate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c certificateDo) First() (*table.Certificate, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Certificate), nil
+	}
+}
+
+func (c certificateDo) Take() (*table.Certificate, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Certificate), nil
+	}
+}
+
+func (c certificateDo) Last() (*table.Certificate, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Certificate), nil
+	}
+}
+
+func (c certificateDo) Find() ([]*table.Certificate, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.Certificate), err
+}
+
+func (c certificateDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Certificate, err error) {
+	buf := make([]*table.Certificate, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c certificateDo) FindInBatches(result *[]*table.Certificate, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c certificateDo) Attrs(attrs ...field.AssignExpr) ICertificateDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c certificateDo) Assign(attrs ...field.AssignExpr) ICertificateDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c certificateDo) Joins(fields ...field.RelationField) ICertificateDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c certificateDo) Preload(fields ...field.RelationField) ICertificateDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c certificateDo) FirstOrInit() (*table.Certificate, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Certificate), nil
+	}
+}
+
+func (c certificateDo) FirstOrCreate() (*table.Certificate, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Certificate), nil
+	}
+}
+
+func (c certificateDo) FindByPage(offset int, limit int) (result []*table.Certificate, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c certificateDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c certificateDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c certificateDo) Delete(models ...*table.Certificate) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *certificateDo) withDO(do gen.Dao) *certificateDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newClientQuarantine(db *gorm.DB, opts ...gen.DOOption) clientQuarantine {
+	_clientQuarantine := clientQuarantine{}
+
+	_clientQuarantine.clientQuarantineDo.UseDB(db, opts...)
+	_clientQuarantine.clientQuarantineDo.UseModel(&table.ClientQuarantine{})
+
+	tableName := _clientQuarantine.clientQuarantineDo.TableName()
+	_clientQuarantine.ALL = field.NewAsterisk(tableName)
+	_clientQuarantine.ID = field.NewUint32(tableName, "id")
+	_clientQuarantine.MatchType = field.NewString(tableName, "match_type")
+	_clientQuarantine.Value = field.NewString(tableName, "value")
+	_clientQuarantine.Reason = field.NewString(tableName, "reason")
+	_clientQuarantine.RetryAfterSec = field.NewUint32(tableName, "retry_after_sec")
+	_clientQuarantine.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_clientQuarantine.Enabled = field.NewBool(tableName, "enabled")
+	_clientQuarantine.BizID = field.NewUint32(tableName, "biz_id")
+	_clientQuarantine.Creator = field.NewString(tableName, "creator")
+	_clientQuarantine.Reviser = field.NewString(tableName, "reviser")
+	_clientQuarantine.CreatedAt = field.NewTime(tableName, "created_at")
+	_clientQuarantine.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_clientQuarantine.fillFieldMap()
+
+	return _clientQuarantine
+}
+
+type clientQuarantine struct {
+	clientQuarantineDo clientQuarantineDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	MatchType     field.String
+	Value         field.String
+	Reason        field.String
+	RetryAfterSec field.Uint32
+	ExpiredAt     field.Time
+	Enabled       field.Bool
+	BizID         field.Uint32
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c clientQuarantine) Table(newTableName string) *clientQuarantine {
+	c.clientQuarantineDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c clientQuarantine) As(alias string) *clientQuarantine {
+	c.clientQuarantineDo.DO = *(c.clientQuarantineDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *clientQuarantine) updateTableName(table string) *clientQuarantine {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.MatchType = field.NewString(table, "match_type")
+	c.Value = field.NewString(table, "value")
+	c.Reason = field.NewString(table, "reason")
+	c.RetryAfterSec = field.NewUint32(table, "retry_after_sec")
+	c.ExpiredAt = field.NewTime(table, "expired_at")
+	c.Enabled = field.NewBool(table, "enabled")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *clientQuarantine) WithContext(ctx context.Context) IClientQuarantineDo {
+	return c.clientQuarantineDo.WithContext(ctx)
+}
+
+func (c clientQuarantine) TableName() string { return c.clientQuarantineDo.TableName() }
+
+func (c clientQuarantine) Alias() string { return c.clientQuarantineDo.Alias() }
+
+func (c clientQuarantine) Columns(cols ...field.Expr) gen.Columns {
+	return c.clientQuarantineDo.Columns(cols...)
+}
+
+func (c *clientQuarantine) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *clientQuarantine) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 12)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["match_type"] = c.MatchType
+	c.fieldMap["value"] = c.Value
+	c.fieldMap["reason"] = c.Reason
+	c.fieldMap["retry_after_sec"] = c.RetryAfterSec
+	c.fieldMap["expired_at"] = c.ExpiredAt
+	c.fieldMap["enabled"] = c.Enabled
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c clientQuarantine) clone(db *gorm.DB) clientQuarantine {
+	c.clientQuarantineDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c clientQuarantine) replaceDB(db *gorm.DB) clientQuarantine {
+	c.clientQuarantineDo.ReplaceDB(db)
+	return c
+}
+
+type clientQuarantineDo struct{ gen.DO }
+
+type IClientQuarantineDo interface {
+	gen.SubQuery
+	Debug() IClientQuarantineDo
+	WithContext(ctx context.Context) IClientQuarantineDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IClientQuarantineDo
+	WriteDB() IClientQuarantineDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IClientQuarantineDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IClientQuarantineDo
+	Not(conds ...gen.Condition) IClientQuarantineDo
+	Or(conds ...gen.Condition) IClientQuarantineDo
+	Select(conds ...field.Expr) IClientQuarantineDo
+	Where(conds ...gen.Condition) IClientQuarantineDo
+	Order(conds ...field.Expr) IClientQuarantineDo
+	Distinct(cols ...field.Expr) IClientQuarantineDo
+	Omit(cols ...field.Expr) IClientQuarantineDo
+	Join(table schema.Tabler, on ...field.Expr) IClientQuarantineDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IClientQuarantineDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IClientQuarantineDo
+	Group(cols ...field.Expr) IClientQuarantineDo
+	Having(conds ...gen.Condition) IClientQuarantineDo
+	Limit(limit int) IClientQuarantineDo
+	Offset(offset int) IClientQuarantineDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IClientQuarantineDo
+	Unscoped() IClientQuarantineDo
+	Create(values ...*table.ClientQuarantine) error
+	CreateInBatches(values []*table.ClientQuarantine, batchSize int) error
+	Save(values ...*table.ClientQuarantine) error
+	First() (*table.ClientQuarantine, error)
+	Take() (*table.ClientQuarantine, error)
+	Last() (*table.ClientQuarantine, error)
+	Find() ([]*table.ClientQuarantine, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientQuarantine, err error)
+	FindInBatches(result *[]*table.ClientQuarantine, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ClientQuarantine) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IClientQuarantineDo
+	Assign(attrs ...field.AssignExpr) IClientQuarantineDo
+	Joins(fields ...field.RelationField) IClientQuarantineDo
+	Preload(fields ...field.RelationField) IClientQuarantineDo
+	FirstOrInit() (*table.ClientQuarantine, error)
+	FirstOrCreate() (*table.ClientQuarantine, error)
+	FindByPage(offset int, limit int) (result []*table.ClientQuarantine, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IClientQuarantineDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c clientQuarantineDo) Debug() IClientQuarantineDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c clientQuarantineDo) WithContext(ctx context.Context) IClientQuarantineDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c clientQuarantineDo) ReadDB() IClientQuarantineDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c clientQuarantineDo) WriteDB() IClientQuarantineDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c clientQuarantineDo) Session(config *gorm.Session) IClientQuarantineDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c clientQuarantineDo) Clauses(conds ...clause.Expression) IClientQuarantineDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c clientQuarantineDo) Returning(value interface{}, columns ...string) IClientQuarantineDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c clientQuarantineDo) Not(conds ...gen.Condition) IClientQuarantineDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c clientQuarantineDo) Or(conds ...gen.Condition) IClientQuarantineDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c clientQuarantineDo) Select(conds ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c clientQuarantineDo) Where(conds ...gen.Condition) IClientQuarantineDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c clientQuarantineDo) Order(conds ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c clientQuarantineDo) Distinct(cols ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c clientQuarantineDo) Omit(cols ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c clientQuarantineDo) Join(table schema.Tabler, on ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c clientQuarantineDo) LeftJoin(table schema.Tabler, on ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c clientQuarantineDo) RightJoin(table schema.Tabler, on ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c clientQuarantineDo) Group(cols ...field.Expr) IClientQuarantineDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c clientQuarantineDo) Having(conds ...gen.Condition) IClientQuarantineDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c clientQuarantineDo) Limit(limit int) IClientQuarantineDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c clientQuarantineDo) Offset(offset int) IClientQuarantineDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c clientQuarantineDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IClientQuarantineDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c clientQuarantineDo) Unscoped() IClientQuarantineDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c clientQuarantineDo) Create(values ...*table.ClientQuarantine) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c clientQuarantineDo) CreateInBatches(values []*table.ClientQuarantine, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c clientQuarantineDo) Save(values ...*table.ClientQuarantine) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c clientQuarantineDo) First() (*table.ClientQuarantine, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientQuarantine), nil
+	}
+}
+
+func (c clientQuarantineDo) Take() (*table.ClientQuarantine, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientQuarantine), nil
+	}
+}
+
+func (c clientQuarantineDo) Last() (*table.ClientQuarantine, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientQuarantine), nil
+	}
+}
+
+func (c clientQuarantineDo) Find() ([]*table.ClientQuarantine, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ClientQuarantine), err
+}
+
+func (c clientQuarantineDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ClientQuarantine, err error) {
+	buf := make([]*table.ClientQuarantine, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c clientQuarantineDo) FindInBatches(result *[]*table.ClientQuarantine, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c clientQuarantineDo) Attrs(attrs ...field.AssignExpr) IClientQuarantineDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c clientQuarantineDo) Assign(attrs ...field.AssignExpr) IClientQuarantineDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c clientQuarantineDo) Joins(fields ...field.RelationField) IClientQuarantineDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c clientQuarantineDo) Preload(fields ...field.RelationField) IClientQuarantineDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c clientQuarantineDo) FirstOrInit() (*table.ClientQuarantine, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientQuarantine), nil
+	}
+}
+
+func (c clientQuarantineDo) FirstOrCreate() (*table.ClientQuarantine, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ClientQuarantine), nil
+	}
+}
+
+func (c clientQuarantineDo) FindByPage(offset int, limit int) (result []*table.ClientQuarantine, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c clientQuarantineDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c clientQuarantineDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c clientQuarantineDo) Delete(models ...*table.ClientQuarantine) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *clientQuarantineDo) withDO(do gen.Dao) *clientQuarantineDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -0,0 +1,440 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newCompliancePolicy(db *gorm.DB, opts ...gen.DOOption) compliancePolicy {
+	_compliancePolicy := compliancePolicy{}
+
+	_compliancePolicy.compliancePolicyDo.UseDB(db, opts...)
+	_compliancePolicy.compliancePolicyDo.UseModel(&table.CompliancePolicy{})
+
+	tableName := _compliancePolicy.compliancePolicyDo.TableName()
+	_compliancePolicy.ALL = field.NewAsterisk(tableName)
+	_compliancePolicy.ID = field.NewUint32(tableName, "id")
+	_compliancePolicy.Name = field.NewString(tableName, "name")
+	_compliancePolicy.Memo = field.NewString(tableName, "memo")
+	_compliancePolicy.Severity = field.NewString(tableName, "severity")
+	_compliancePolicy.Enabled = field.NewBool(tableName, "enabled")
+	_compliancePolicy.Target = field.NewString(tableName, "target")
+	_compliancePolicy.EnvIDs = field.NewField(tableName, "env_ids")
+	_compliancePolicy.Rule = field.NewField(tableName, "rule")
+	_compliancePolicy.BizID = field.NewUint32(tableName, "biz_id")
+	_compliancePolicy.Creator = field.NewString(tableName, "creator")
+	_compliancePolicy.Reviser = field.NewString(tableName, "reviser")
+	_compliancePolicy.CreatedAt = field.NewTime(tableName, "created_at")
+	_compliancePolicy.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_compliancePolicy.fillFieldMap()
+
+	return _compliancePolicy
+}
+
+type compliancePolicy struct {
+	compliancePolicyDo compliancePolicyDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Name      field.String
+	Memo      field.String
+	Severity  field.String
+	Enabled   field.Bool
+	Target    field.String
+	EnvIDs    field.Field
+	Rule      field.Field
+	BizID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c compliancePolicy) Table(newTableName string) *compliancePolicy {
+	c.compliancePolicyDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c compliancePolicy) As(alias string) *compliancePolicy {
+	c.compliancePolicyDo.DO = *(c.compliancePolicyDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *compliancePolicy) updateTableName(table string) *compliancePolicy {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.Name = field.NewString(table, "name")
+	c.Memo = field.NewString(table, "memo")
+	c.Severity = field.NewString(table, "severity")
+	c.Enabled = field.NewBool(table, "enabled")
+	c.Target = field.NewString(table, "target")
+	c.EnvIDs = field.NewField(table, "env_ids")
+	c.Rule = field.NewField(table, "rule")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *compliancePolicy) WithContext(ctx context.Context) ICompliancePolicyDo {
+	return c.compliancePolicyDo.WithContext(ctx)
+}
+
+func (c compliancePolicy) TableName() string { return c.compliancePolicyDo.TableName() }
+
+func (c compliancePolicy) Alias() string { return c.compliancePolicyDo.Alias() }
+
+func (c compliancePolicy) Columns(cols ...field.Expr) gen.Columns {
+	return c.compliancePolicyDo.Columns(cols...)
+}
+
+func (c *compliancePolicy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *compliancePolicy) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 13)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["name"] = c.Name
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["severity"] = c.Severity
+	c.fieldMap["enabled"] = c.Enabled
+	c.fieldMap["target"] = c.Target
+	c.fieldMap["env_ids"] = c.EnvIDs
+	c.fieldMap["rule"] = c.Rule
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c compliancePolicy) clone(db *gorm.DB) compliancePolicy {
+	c.compliancePolicyDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c compliancePolicy) replaceDB(db *gorm.DB) compliancePolicy {
+	c.compliancePolicyDo.ReplaceDB(db)
+	return c
+}
+
+type compliancePolicyDo struct{ gen.DO }
+
+type ICompliancePolicyDo interface {
+	gen.SubQuery
+	Debug() ICompliancePolicyDo
+	WithContext(ctx context.Context) ICompliancePolicyDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ICompliancePolicyDo
+	WriteDB() ICompliancePolicyDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ICompliancePolicyDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ICompliancePolicyDo
+	Not(conds ...gen.Condition) ICompliancePolicyDo
+	Or(conds ...gen.Condition) ICompliancePolicyDo
+	Select(conds ...field.Expr) ICompliancePolicyDo
+	Where(conds ...gen.Condition) ICompliancePolicyDo
+	Order(conds ...field.Expr) ICompliancePolicyDo
+	Distinct(cols ...field.Expr) ICompliancePolicyDo
+	Omit(cols ...field.Expr) ICompliancePolicyDo
+	Join(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo
+	Group(cols ...field.Expr) ICompliancePolicyDo
+	Having(conds ...gen.Condition) ICompliancePolicyDo
+	Limit(limit int) ICompliancePolicyDo
+	Offset(offset int) ICompliancePolicyDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ICompliancePolicyDo
+	Unscoped() ICompliancePolicyDo
+	Create(values ...*table.CompliancePolicy) error
+	CreateInBatches(values []*table.CompliancePolicy, batchSize int) error
+	Save(values ...*table.CompliancePolicy) error
+	First() (*table.CompliancePolicy, error)
+	Take() (*table.CompliancePolicy, error)
+	Last() (*table.CompliancePolicy, error)
+	Find() ([]*table.CompliancePolicy, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.CompliancePolicy, err error)
+	FindInBatches(result *[]*table.CompliancePolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.CompliancePolicy) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ICompliancePolicyDo
+	Assign(attrs ...field.AssignExpr) ICompliancePolicyDo
+	Joins(fields ...field.RelationField) ICompliancePolicyDo
+	Preload(fields ...field.RelationField) ICompliancePolicyDo
+	FirstOrInit() (*table.CompliancePolicy, error)
+	FirstOrCreate() (*table.CompliancePolicy, error)
+	FindByPage(offset int, limit int) (result []*table.CompliancePolicy, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ICompliancePolicyDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c compliancePolicyDo) Debug() ICompliancePolicyDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c compliancePolicyDo) WithContext(ctx context.Context) ICompliancePolicyDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c compliancePolicyDo) ReadDB() ICompliancePolicyDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c compliancePolicyDo) WriteDB() ICompliancePolicyDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c compliancePolicyDo) Session(config *gorm.Session) ICompliancePolicyDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c compliancePolicyDo) Clauses(conds ...clause.Expression) ICompliancePolicyDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c compliancePolicyDo) Returning(value interface{}, columns ...string) ICompliancePolicyDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c compliancePolicyDo) Not(conds ...gen.Condition) ICompliancePolicyDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c compliancePolicyDo) Or(conds ...gen.Condition) ICompliancePolicyDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c compliancePolicyDo) Select(conds ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c compliancePolicyDo) Where(conds ...gen.Condition) ICompliancePolicyDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c compliancePolicyDo) Order(conds ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c compliancePolicyDo) Distinct(cols ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c compliancePolicyDo) Omit(cols ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c compliancePolicyDo) Join(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c compliancePolicyDo) LeftJoin(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c compliancePolicyDo) RightJoin(table schema.Tabler, on ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c compliancePolicyDo) Group(cols ...field.Expr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c compliancePolicyDo) Having(conds ...gen.Condition) ICompliancePolicyDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c compliancePolicyDo) Limit(limit int) ICompliancePolicyDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c compliancePolicyDo) Offset(offset int) ICompliancePolicyDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c compliancePolicyDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ICompliancePolicyDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c compliancePolicyDo) Unscoped() ICompliancePolicyDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c compliancePolicyDo) Create(values ...*table.CompliancePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c compliancePolicyDo) CreateInBatches(values []*table.CompliancePolicy, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c compliancePolicyDo) Save(values ...*table.CompliancePolicy) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c compliancePolicyDo) First() (*table.CompliancePolicy, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CompliancePolicy), nil
+	}
+}
+
+func (c compliancePolicyDo) Take() (*table.CompliancePolicy, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CompliancePolicy), nil
+	}
+}
+
+func (c compliancePolicyDo) Last() (*table.CompliancePolicy, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CompliancePolicy), nil
+	}
+}
+
+func (c compliancePolicyDo) Find() ([]*table.CompliancePolicy, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.CompliancePolicy), err
+}
+
+func (c compliancePolicyDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.CompliancePolicy, err error) {
+	buf := make([]*table.CompliancePolicy, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c compliancePolicyDo) FindInBatches(result *[]*table.CompliancePolicy, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c compliancePolicyDo) Attrs(attrs ...field.AssignExpr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c compliancePolicyDo) Assign(attrs ...field.AssignExpr) ICompliancePolicyDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c compliancePolicyDo) Joins(fields ...field.RelationField) ICompliancePolicyDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c compliancePolicyDo) Preload(fields ...field.RelationField) ICompliancePolicyDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c compliancePolicyDo) FirstOrInit() (*table.CompliancePolicy, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CompliancePolicy), nil
+	}
+}
+
+func (c compliancePolicyDo) FirstOrCreate() (*table.CompliancePolicy, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.CompliancePolicy), nil
+	}
+}
+
+func (c compliancePolicyDo) FindByPage(offset int, limit int) (result []*table.CompliancePolicy, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c compliancePolicyDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c compliancePolicyDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c compliancePolicyDo) Delete(models ...*table.CompliancePolicy) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *compliancePolicyDo) withDO(do gen.Dao) *compliancePolicyDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
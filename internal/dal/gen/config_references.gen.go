@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newConfigReference(db *gorm.DB, opts ...gen.DOOption) configReference {
+	_configReference := configReference{}
+
+	_configReference.configReferenceDo.UseDB(db, opts...)
+	_configReference.configReferenceDo.UseModel(&table.ConfigReference{})
+
+	tableName := _configReference.configReferenceDo.TableName()
+	_configReference.ALL = field.NewAsterisk(tableName)
+	_configReference.ID = field.NewUint32(tableName, "id")
+	_configReference.SrcType = field.NewString(tableName, "src_type")
+	_configReference.SrcID = field.NewUint32(tableName, "src_id")
+	_configReference.DstType = field.NewString(tableName, "dst_type")
+	_configReference.DstID = field.NewUint32(tableName, "dst_id")
+	_configReference.RefType = field.NewString(tableName, "ref_type")
+	_configReference.Memo = field.NewString(tableName, "memo")
+	_configReference.BizID = field.NewUint32(tableName, "biz_id")
+	_configReference.SrcAppID = field.NewUint32(tableName, "src_app_id")
+	_configReference.DstAppID = field.NewUint32(tableName, "dst_app_id")
+	_configReference.Creator = field.NewString(tableName, "creator")
+	_configReference.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_configReference.fillFieldMap()
+
+	return _configReference
+}
+
+type configReference struct {
+	configReferenceDo configReferenceDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	SrcType   field.String
+	SrcID     field.Uint32
+	DstType   field.String
+	DstID     field.Uint32
+	RefType   field.String
+	Memo      field.String
+	BizID     field.Uint32
+	SrcAppID  field.Uint32
+	DstAppID  field.Uint32
+	Creator   field.String
+	CreatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c configReference) Table(newTableName string) *configReference {
+	c.configReferenceDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c configReference) As(alias string) *configReference {
+	c.configReferenceDo.DO = *(c.configReferenceDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *configReference) updateTableName(table string) *configReference {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.SrcType = field.NewString(table, "src_type")
+	c.SrcID = field.NewUint32(table, "src_id")
+	c.DstType = field.NewString(table, "dst_type")
+	c.DstID = field.NewUint32(table, "dst_id")
+	c.RefType = field.NewString(table, "ref_type")
+	c.Memo = field.NewString(table, "memo")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.SrcAppID = field.NewUint32(table, "src_app_id")
+	c.DstAppID = field.NewUint32(table, "dst_app_id")
+	c.Creator = field.NewString(table, "creator")
+	c.CreatedAt = field.NewTime(table, "created_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *configReference) WithContext(ctx context.Context) IConfigReferenceDo {
+	return c.configReferenceDo.WithContext(ctx)
+}
+
+func (c configReference) TableName() string { return c.configReferenceDo.TableName() }
+
+func (c configReference) Alias() string { return c.configReferenceDo.Alias() }
+
+func (c configReference) Columns(cols ...field.Expr) gen.Columns {
+	return c.configReferenceDo.Columns(cols...)
+}
+
+func (c *configReference) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *configReference) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 12)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["src_type"] = c.SrcType
+	c.fieldMap["src_id"] = c.SrcID
+	c.fieldMap["dst_type"] = c.DstType
+	c.fieldMap["dst_id"] = c.DstID
+	c.fieldMap["ref_type"] = c.RefType
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["src_app_id"] = c.SrcAppID
+	c.fieldMap["dst_app_id"] = c.DstAppID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["created_at"] = c.CreatedAt
+}
+
+func (c configReference) clone(db *gorm.DB) configReference {
+	c.configReferenceDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c configReference) replaceDB(db *gorm.DB) configReference {
+	c.configReferenceDo.ReplaceDB(db)
+	return c
+}
+
+type configReferenceDo struct{ gen.DO }
+
+type IConfigReferenceDo interface {
+	gen.SubQuery
+	Debug() IConfigReferenceDo
+	WithContext(ctx context.Context) IConfigReferenceDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IConfigReferenceDo
+	WriteDB() IConfigReferenceDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IConfigReferenceDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IConfigReferenceDo
+	Not(conds ...gen.Condition) IConfigReferenceDo
+	Or(conds ...gen.Condition) IConfigReferenceDo
+	Select(conds ...field.Expr) IConfigReferenceDo
+	Where(conds ...gen.Condition) IConfigReferenceDo
+	Order(conds ...field.Expr) IConfigReferenceDo
+	Distinct(cols ...field.Expr) IConfigReferenceDo
+	Omit(cols ...field.Expr) IConfigReferenceDo
+	Join(table schema.Tabler, on ...field.Expr) IConfigReferenceDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IConfigReferenceDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IConfigReferenceDo
+	Group(cols ...field.Expr) IConfigReferenceDo
+	Having(conds ...gen.Condition) IConfigReferenceDo
+	Limit(limit int) IConfigReferenceDo
+	Offset(offset int) IConfigReferenceDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigReferenceDo
+	Unscoped() IConfigReferenceDo
+	Create(values ...*table.ConfigReference) error
+	CreateInBatches(values []*table.ConfigReference, batchSize int) error
+	Save(values ...*table.ConfigReference) error
+	First() (*table.ConfigReference, error)
+	Take() (*table.ConfigReference, error)
+	Last() (*table.ConfigReference, error)
+	Find() ([]*table.ConfigReference, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigReference, err error)
+	FindInBatches(result *[]*table.ConfigReference, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ConfigReference) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IConfigReferenceDo
+	Assign(attrs ...field.AssignExpr) IConfigReferenceDo
+	Joins(fields ...field.RelationField) IConfigReferenceDo
+	Preload(fields ...field.RelationField) IConfigReferenceDo
+	FirstOrInit() (*table.ConfigReference, error)
+	FirstOrCreate() (*table.ConfigReference, error)
+	FindByPage(offset int, limit int) (result []*table.ConfigReference, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IConfigReferenceDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c configReferenceDo) Debug() IConfigReferenceDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c configReferenceDo) WithContext(ctx context.Context) IConfigReferenceDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c configReferenceDo) ReadDB() IConfigReferenceDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c configReferenceDo) WriteDB() IConfigReferenceDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c configReferenceDo) Session(config *gorm.Session) IConfigReferenceDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c configReferenceDo) Clauses(conds ...clause.Expression) IConfigReferenceDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c configReferenceDo) Returning(value interface{}, columns ...string) IConfigReferenceDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c configReferenceDo) Not(conds ...gen.Condition) IConfigReferenceDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c configReferenceDo) Or(conds ...gen.Condition) IConfigReferenceDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c configReferenceDo) Select(conds ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c configReferenceDo) Where(conds ...gen.Condition) IConfigReferenceDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c configReferenceDo) Order(conds ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c configReferenceDo) Distinct(cols ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c configReferenceDo) Omit(cols ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c configReferenceDo) Join(table schema.Tabler, on ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c configReferenceDo) LeftJoin(table schema.Tabler, on ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c configReferenceDo) RightJoin(table schema.Tabler, on ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c configReferenceDo) Group(cols ...field.Expr) IConfigReferenceDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c configReferenceDo) Having(conds ...gen.Condition) IConfigReferenceDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c configReferenceDo) Limit(limit int) IConfigReferenceDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c configReferenceDo) Offset(offset int) IConfigReferenceDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c configReferenceDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigReferenceDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c configReferenceDo) Unscoped() IConfigReferenceDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c configReferenceDo) Create(values ...*table.ConfigReference) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c configReferenceDo) CreateInBatches(values []*table.ConfigReference, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c configReferenceDo) Save(values ...*table.ConfigReference) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c configReferenceDo) First() (*table.ConfigReference, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigReference), nil
+	}
+}
+
+func (c configReferenceDo) Take() (*table.ConfigReference, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigReference), nil
+	}
+}
+
+func (c configReferenceDo) Last() (*table.ConfigReference, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigReference), nil
+	}
+}
+
+func (c configReferenceDo) Find() ([]*table.ConfigReference, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ConfigReference), err
+}
+
+func (c configReferenceDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigReference, err error) {
+	buf := make([]*table.ConfigReference, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c configReferenceDo) FindInBatches(result *[]*table.ConfigReference, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c configReferenceDo) Attrs(attrs ...field.AssignExpr) IConfigReferenceDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c configReferenceDo) Assign(attrs ...field.AssignExpr) IConfigReferenceDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c configReferenceDo) Joins(fields ...field.RelationField) IConfigReferenceDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c configReferenceDo) Preload(fields ...field.RelationField) IConfigReferenceDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c configReferenceDo) FirstOrInit() (*table.ConfigReference, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigReference), nil
+	}
+}
+
+func (c configReferenceDo) FirstOrCreate() (*table.ConfigReference, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigReference), nil
+	}
+}
+
+func (c configReferenceDo) FindByPage(offset int, limit int) (result []*table.ConfigReference, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c configReferenceDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c configReferenceDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c configReferenceDo) Delete(models ...*table.ConfigReference) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *configReferenceDo) withDO(do gen.Dao) *configReferenceDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
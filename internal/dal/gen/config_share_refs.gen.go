@@ -0,0 +1,420 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newConfigShareRef(db *gorm.DB, opts ...gen.DOOption) configShareRef {
+	_configShareRef := configShareRef{}
+
+	_configShareRef.configShareRefDo.UseDB(db, opts...)
+	_configShareRef.configShareRefDo.UseModel(&table.ConfigShareRef{})
+
+	tableName := _configShareRef.configShareRefDo.TableName()
+	_configShareRef.ALL = field.NewAsterisk(tableName)
+	_configShareRef.ID = field.NewUint32(tableName, "id")
+	_configShareRef.ShareID = field.NewUint32(tableName, "share_id")
+	_configShareRef.BizID = field.NewUint32(tableName, "biz_id")
+	_configShareRef.AppID = field.NewUint32(tableName, "app_id")
+	_configShareRef.Creator = field.NewString(tableName, "creator")
+	_configShareRef.Reviser = field.NewString(tableName, "reviser")
+	_configShareRef.CreatedAt = field.NewTime(tableName, "created_at")
+	_configShareRef.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_configShareRef.fillFieldMap()
+
+	return _configShareRef
+}
+
+type configShareRef struct {
+	configShareRefDo configShareRefDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	ShareID   field.Uint32
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c configShareRef) Table(newTableName string) *configShareRef {
+	c.configShareRefDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c configShareRef) As(alias string) *configShareRef {
+	c.configShareRefDo.DO = *(c.configShareRefDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *configShareRef) updateTableName(table string) *configShareRef {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.ShareID = field.NewUint32(table, "share_id")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *configShareRef) WithContext(ctx context.Context) IConfigShareRefDo {
+	return c.configShareRefDo.WithContext(ctx)
+}
+
+func (c configShareRef) TableName() string { return c.configShareRefDo.TableName() }
+
+func (c configShareRef) Alias() string { return c.configShareRefDo.Alias() }
+
+func (c configShareRef) Columns(cols ...field.Expr) gen.Columns {
+	return c.configShareRefDo.Columns(cols...)
+}
+
+func (c *configShareRef) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *configShareRef) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 8)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["share_id"] = c.ShareID
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c configShareRef) clone(db *gorm.DB) configShareRef {
+	c.configShareRefDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c configShareRef) replaceDB(db *gorm.DB) configShareRef {
+	c.configShareRefDo.ReplaceDB(db)
+	return c
+}
+
+type configShareRefDo struct{ gen.DO }
+
+type IConfigShareRefDo interface {
+	gen.SubQuery
+	Debug() IConfigShareRefDo
+	WithContext(ctx context.Context) IConfigShareRefDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IConfigShareRefDo
+	WriteDB() IConfigShareRefDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IConfigShareRefDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IConfigShareRefDo
+	Not(conds ...gen.Condition) IConfigShareRefDo
+	Or(conds ...gen.Condition) IConfigShareRefDo
+	Select(conds ...field.Expr) IConfigShareRefDo
+	Where(conds ...gen.Condition) IConfigShareRefDo
+	Order(conds ...field.Expr) IConfigShareRefDo
+	Distinct(cols ...field.Expr) IConfigShareRefDo
+	Omit(cols ...field.Expr) IConfigShareRefDo
+	Join(table schema.Tabler, on ...field.Expr) IConfigShareRefDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IConfigShareRefDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IConfigShareRefDo
+	Group(cols ...field.Expr) IConfigShareRefDo
+	Having(conds ...gen.Condition) IConfigShareRefDo
+	Limit(limit int) IConfigShareRefDo
+	Offset(offset int) IConfigShareRefDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigShareRefDo
+	Unscoped() IConfigShareRefDo
+	Create(values ...*table.ConfigShareRef) error
+	CreateInBatches(values []*table.ConfigShareRef, batchSize int) error
+	Save(values ...*table.ConfigShareRef) error
+	First() (*table.ConfigShareRef, error)
+	Take() (*table.ConfigShareRef, error)
+	Last() (*table.ConfigShareRef, error)
+	Find() ([]*table.ConfigShareRef, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigShareRef, err error)
+	FindInBatches(result *[]*table.ConfigShareRef, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ConfigShareRef) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IConfigShareRefDo
+	Assign(attrs ...field.AssignExpr) IConfigShareRefDo
+	Joins(fields ...field.RelationField) IConfigShareRefDo
+	Preload(fields ...field.RelationField) IConfigShareRefDo
+	FirstOrInit() (*table.ConfigShareRef, error)
+	FirstOrCreate() (*table.ConfigShareRef, error)
+	FindByPage(offset int, limit int) (result []*table.ConfigShareRef, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IConfigShareRefDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c configShareRefDo) Debug() IConfigShareRefDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c configShareRefDo) WithContext(ctx context.Context) IConfigShareRefDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c configShareRefDo) ReadDB() IConfigShareRefDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c configShareRefDo) WriteDB() IConfigShareRefDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c configShareRefDo) Session(config *gorm.Session) IConfigShareRefDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c configShareRefDo) Clauses(conds ...clause.Expression) IConfigShareRefDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c configShareRefDo) Returning(value interface{}, columns ...string) IConfigShareRefDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c configShareRefDo) Not(conds ...gen.Condition) IConfigShareRefDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c configShareRefDo) Or(conds ...gen.Condition) IConfigShareRefDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c configShareRefDo) Select(conds ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c configShareRefDo) Where(conds ...gen.Condition) IConfigShareRefDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c configShareRefDo) Order(conds ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c configShareRefDo) Distinct(cols ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c configShareRefDo) Omit(cols ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c configShareRefDo) Join(table schema.Tabler, on ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c configShareRefDo) LeftJoin(table schema.Tabler, on ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c configShareRefDo) RightJoin(table schema.Tabler, on ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c configShareRefDo) Group(cols ...field.Expr) IConfigShareRefDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c configShareRefDo) Having(conds ...gen.Condition) IConfigShareRefDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c configShareRefDo) Limit(limit int) IConfigShareRefDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c configShareRefDo) Offset(offset int) IConfigShareRefDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c configShareRefDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigShareRefDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c configShareRefDo) Unscoped() IConfigShareRefDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c configShareRefDo) Create(values ...*table.ConfigShareRef) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c configShareRefDo) CreateInBatches(values []*table.ConfigShareRef, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c configShareRefDo) Save(values ...*table.ConfigShareRef) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c configShareRefDo) First() (*table.ConfigShareRef, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShareRef), nil
+	}
+}
+
+func (c configShareRefDo) Take() (*table.ConfigShareRef, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShareRef), nil
+	}
+}
+
+func (c configShareRefDo) Last() (*table.ConfigShareRef, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShareRef), nil
+	}
+}
+
+func (c configShareRefDo) Find() ([]*table.ConfigShareRef, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ConfigShareRef), err
+}
+
+func (c configShareRefDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigShareRef, err error) {
+	buf := make([]*table.ConfigShareRef, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c configShareRefDo) FindInBatches(result *[]*table.ConfigShareRef, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c configShareRefDo) Attrs(attrs ...field.AssignExpr) IConfigShareRefDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c configShareRefDo) Assign(attrs ...field.AssignExpr) IConfigShareRefDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c configShareRefDo) Joins(fields ...field.RelationField) IConfigShareRefDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c configShareRefDo) Preload(fields ...field.RelationField) IConfigShareRefDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c configShareRefDo) FirstOrInit() (*table.ConfigShareRef, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShareRef), nil
+	}
+}
+
+func (c configShareRefDo) FirstOrCreate() (*table.ConfigShareRef, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShareRef), nil
+	}
+}
+
+func (c configShareRefDo) FindByPage(offset int, limit int) (result []*table.ConfigShareRef, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c configShareRefDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c configShareRefDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c configShareRefDo) Delete(models ...*table.ConfigShareRef) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *configShareRefDo) withDO(do gen.Dao) *configShareRefDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -0,0 +1,438 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newConfigShare(db *gorm.DB, opts ...gen.DOOption) configShare {
+	_configShare := configShare{}
+
+	_configShare.configShareDo.UseDB(db, opts...)
+	_configShare.configShareDo.UseModel(&table.ConfigShare{})
+
+	tableName := _configShare.configShareDo.TableName()
+	_configShare.ALL = field.NewAsterisk(tableName)
+	_configShare.ID = field.NewUint32(tableName, "id")
+	_configShare.Name = field.NewString(tableName, "name")
+	_configShare.ConfigType = field.NewString(tableName, "config_type")
+	_configShare.ConfigItemID = field.NewUint32(tableName, "config_item_id")
+	_configShare.Key = field.NewString(tableName, "key")
+	_configShare.Grantees = field.NewField(tableName, "grantees")
+	_configShare.Memo = field.NewString(tableName, "memo")
+	_configShare.BizID = field.NewUint32(tableName, "biz_id")
+	_configShare.AppID = field.NewUint32(tableName, "app_id")
+	_configShare.Creator = field.NewString(tableName, "creator")
+	_configShare.Reviser = field.NewString(tableName, "reviser")
+	_configShare.CreatedAt = field.NewTime(tableName, "created_at")
+	_configShare.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_configShare.fillFieldMap()
+
+	return _configShare
+}
+
+type configShare struct {
+	configShareDo configShareDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	Name         field.String
+	ConfigType   field.String
+	ConfigItemID field.Uint32
+	Key          field.String
+	Grantees     field.Field
+	Memo         field.String
+	BizID        field.Uint32
+	AppID        field.Uint32
+	Creator      field.String
+	Reviser      field.String
+	CreatedAt    field.Time
+	UpdatedAt    field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (c configShare) Table(newTableName string) *configShare {
+	c.configShareDo.UseTable(newTableName)
+	return c.updateTableName(newTableName)
+}
+
+func (c configShare) As(alias string) *configShare {
+	c.configShareDo.DO = *(c.configShareDo.As(alias).(*gen.DO))
+	return c.updateTableName(alias)
+}
+
+func (c *configShare) updateTableName(table string) *configShare {
+	c.ALL = field.NewAsterisk(table)
+	c.ID = field.NewUint32(table, "id")
+	c.Name = field.NewString(table, "name")
+	c.ConfigType = field.NewString(table, "config_type")
+	c.ConfigItemID = field.NewUint32(table, "config_item_id")
+	c.Key = field.NewString(table, "key")
+	c.Grantees = field.NewField(table, "grantees")
+	c.Memo = field.NewString(table, "memo")
+	c.BizID = field.NewUint32(table, "biz_id")
+	c.AppID = field.NewUint32(table, "app_id")
+	c.Creator = field.NewString(table, "creator")
+	c.Reviser = field.NewString(table, "reviser")
+	c.CreatedAt = field.NewTime(table, "created_at")
+	c.UpdatedAt = field.NewTime(table, "updated_at")
+
+	c.fillFieldMap()
+
+	return c
+}
+
+func (c *configShare) WithContext(ctx context.Context) IConfigShareDo {
+	return c.configShareDo.WithContext(ctx)
+}
+
+func (c configShare) TableName() string { return c.configShareDo.TableName() }
+
+func (c configShare) Alias() string { return c.configShareDo.Alias() }
+
+func (c configShare) Columns(cols ...field.Expr) gen.Columns { return c.configShareDo.Columns(cols...) }
+
+func (c *configShare) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := c.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (c *configShare) fillFieldMap() {
+	c.fieldMap = make(map[string]field.Expr, 13)
+	c.fieldMap["id"] = c.ID
+	c.fieldMap["name"] = c.Name
+	c.fieldMap["config_type"] = c.ConfigType
+	c.fieldMap["config_item_id"] = c.ConfigItemID
+	c.fieldMap["key"] = c.Key
+	c.fieldMap["grantees"] = c.Grantees
+	c.fieldMap["memo"] = c.Memo
+	c.fieldMap["biz_id"] = c.BizID
+	c.fieldMap["app_id"] = c.AppID
+	c.fieldMap["creator"] = c.Creator
+	c.fieldMap["reviser"] = c.Reviser
+	c.fieldMap["created_at"] = c.CreatedAt
+	c.fieldMap["updated_at"] = c.UpdatedAt
+}
+
+func (c configShare) clone(db *gorm.DB) configShare {
+	c.configShareDo.ReplaceConnPool(db.Statement.ConnPool)
+	return c
+}
+
+func (c configShare) replaceDB(db *gorm.DB) configShare {
+	c.configShareDo.ReplaceDB(db)
+	return c
+}
+
+type configShareDo struct{ gen.DO }
+
+type IConfigShareDo interface {
+	gen.SubQuery
+	Debug() IConfigShareDo
+	WithContext(ctx context.Context) IConfigShareDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IConfigShareDo
+	WriteDB() IConfigShareDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IConfigShareDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IConfigShareDo
+	Not(conds ...gen.Condition) IConfigShareDo
+	Or(conds ...gen.Condition) IConfigShareDo
+	Select(conds ...field.Expr) IConfigShareDo
+	Where(conds ...gen.Condition) IConfigShareDo
+	Order(conds ...field.Expr) IConfigShareDo
+	Distinct(cols ...field.Expr) IConfigShareDo
+	Omit(cols ...field.Expr) IConfigShareDo
+	Join(table schema.Tabler, on ...field.Expr) IConfigShareDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IConfigShareDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IConfigShareDo
+	Group(cols ...field.Expr) IConfigShareDo
+	Having(conds ...gen.Condition) IConfigShareDo
+	Limit(limit int) IConfigShareDo
+	Offset(offset int) IConfigShareDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigShareDo
+	Unscoped() IConfigShareDo
+	Create(values ...*table.ConfigShare) error
+	CreateInBatches(values []*table.ConfigShare, batchSize int) error
+	Save(values ...*table.ConfigShare) error
+	First() (*table.ConfigShare, error)
+	Take() (*table.ConfigShare, error)
+	Last() (*table.ConfigShare, error)
+	Find() ([]*table.ConfigShare, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigShare, err error)
+	FindInBatches(result *[]*table.ConfigShare, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ConfigShare) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IConfigShareDo
+	Assign(attrs ...field.AssignExpr) IConfigShareDo
+	Joins(fields ...field.RelationField) IConfigShareDo
+	Preload(fields ...field.RelationField) IConfigShareDo
+	FirstOrInit() (*table.ConfigShare, error)
+	FirstOrCreate() (*table.ConfigShare, error)
+	FindByPage(offset int, limit int) (result []*table.ConfigShare, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IConfigShareDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (c configShareDo) Debug() IConfigShareDo {
+	return c.withDO(c.DO.Debug())
+}
+
+func (c configShareDo) WithContext(ctx context.Context) IConfigShareDo {
+	return c.withDO(c.DO.WithContext(ctx))
+}
+
+func (c configShareDo) ReadDB() IConfigShareDo {
+	return c.Clauses(dbresolver.Read)
+}
+
+func (c configShareDo) WriteDB() IConfigShareDo {
+	return c.Clauses(dbresolver.Write)
+}
+
+func (c configShareDo) Session(config *gorm.Session) IConfigShareDo {
+	return c.withDO(c.DO.Session(config))
+}
+
+func (c configShareDo) Clauses(conds ...clause.Expression) IConfigShareDo {
+	return c.withDO(c.DO.Clauses(conds...))
+}
+
+func (c configShareDo) Returning(value interface{}, columns ...string) IConfigShareDo {
+	return c.withDO(c.DO.Returning(value, columns...))
+}
+
+func (c configShareDo) Not(conds ...gen.Condition) IConfigShareDo {
+	return c.withDO(c.DO.Not(conds...))
+}
+
+func (c configShareDo) Or(conds ...gen.Condition) IConfigShareDo {
+	return c.withDO(c.DO.Or(conds...))
+}
+
+func (c configShareDo) Select(conds ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Select(conds...))
+}
+
+func (c configShareDo) Where(conds ...gen.Condition) IConfigShareDo {
+	return c.withDO(c.DO.Where(conds...))
+}
+
+func (c configShareDo) Order(conds ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Order(conds...))
+}
+
+func (c configShareDo) Distinct(cols ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Distinct(cols...))
+}
+
+func (c configShareDo) Omit(cols ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Omit(cols...))
+}
+
+func (c configShareDo) Join(table schema.Tabler, on ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Join(table, on...))
+}
+
+func (c configShareDo) LeftJoin(table schema.Tabler, on ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.LeftJoin(table, on...))
+}
+
+func (c configShareDo) RightJoin(table schema.Tabler, on ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.RightJoin(table, on...))
+}
+
+func (c configShareDo) Group(cols ...field.Expr) IConfigShareDo {
+	return c.withDO(c.DO.Group(cols...))
+}
+
+func (c configShareDo) Having(conds ...gen.Condition) IConfigShareDo {
+	return c.withDO(c.DO.Having(conds...))
+}
+
+func (c configShareDo) Limit(limit int) IConfigShareDo {
+	return c.withDO(c.DO.Limit(limit))
+}
+
+func (c configShareDo) Offset(offset int) IConfigShareDo {
+	return c.withDO(c.DO.Offset(offset))
+}
+
+func (c configShareDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IConfigShareDo {
+	return c.withDO(c.DO.Scopes(funcs...))
+}
+
+func (c configShareDo) Unscoped() IConfigShareDo {
+	return c.withDO(c.DO.Unscoped())
+}
+
+func (c configShareDo) Create(values ...*table.ConfigShare) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Create(values)
+}
+
+func (c configShareDo) CreateInBatches(values []*table.ConfigShare, batchSize int) error {
+	return c.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (c configShareDo) Save(values ...*table.ConfigShare) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return c.DO.Save(values)
+}
+
+func (c configShareDo) First() (*table.ConfigShare, error) {
+	if result, err := c.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShare), nil
+	}
+}
+
+func (c configShareDo) Take() (*table.ConfigShare, error) {
+	if result, err := c.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShare), nil
+	}
+}
+
+func (c configShareDo) Last() (*table.ConfigShare, error) {
+	if result, err := c.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShare), nil
+	}
+}
+
+func (c configShareDo) Find() ([]*table.ConfigShare, error) {
+	result, err := c.DO.Find()
+	return result.([]*table.ConfigShare), err
+}
+
+func (c configShareDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ConfigShare, err error) {
+	buf := make([]*table.ConfigShare, 0, batchSize)
+	err = c.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (c configShareDo) FindInBatches(result *[]*table.ConfigShare, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return c.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (c configShareDo) Attrs(attrs ...field.AssignExpr) IConfigShareDo {
+	return c.withDO(c.DO.Attrs(attrs...))
+}
+
+func (c configShareDo) Assign(attrs ...field.AssignExpr) IConfigShareDo {
+	return c.withDO(c.DO.Assign(attrs...))
+}
+
+func (c configShareDo) Joins(fields ...field.RelationField) IConfigShareDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Joins(_f))
+	}
+	return &c
+}
+
+func (c configShareDo) Preload(fields ...field.RelationField) IConfigShareDo {
+	for _, _f := range fields {
+		c = *c.withDO(c.DO.Preload(_f))
+	}
+	return &c
+}
+
+func (c configShareDo) FirstOrInit() (*table.ConfigShare, error) {
+	if result, err := c.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShare), nil
+	}
+}
+
+func (c configShareDo) FirstOrCreate() (*table.ConfigShare, error) {
+	if result, err := c.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ConfigShare), nil
+	}
+}
+
+func (c configShareDo) FindByPage(offset int, limit int) (result []*table.ConfigShare, count int64, err error) {
+	result, err = c.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = c.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (c configShareDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = c.Count()
+	if err != nil {
+		return
+	}
+
+	err = c.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (c configShareDo) Scan(result interface{}) (err error) {
+	return c.DO.Scan(result)
+}
+
+func (c configShareDo) Delete(models ...*table.ConfigShare) (result gen.ResultInfo, err error) {
+	return c.DO.Delete(models)
+}
+
+func (c *configShareDo) withDO(do gen.Dao) *configShareDo {
+	c.DO = *do.(*gen.DO)
+	return c
+}
@@ -35,6 +35,7 @@ func newCredential(db *gorm.DB, opts ...gen.DOOption) credential {
 	_credential.Memo = field.NewString(tableName, "memo")
 	_credential.Enable = field.NewBool(tableName, "enable")
 	_credential.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_credential.Pause = field.NewField(tableName, "pause")
 	_credential.BizID = field.NewUint32(tableName, "biz_id")
 	_credential.Creator = field.NewString(tableName, "creator")
 	_credential.Reviser = field.NewString(tableName, "reviser")
@@ -58,6 +59,7 @@ type credential struct {
 	Memo           field.String
 	Enable         field.Bool
 	ExpiredAt      field.Time
+	Pause          field.Field
 	BizID          field.Uint32
 	Creator        field.String
 	Reviser        field.String
@@ -87,6 +89,7 @@ func (c *credential) updateTableName(table string) *credential {
 	c.Memo = field.NewString(table, "memo")
 	c.Enable = field.NewBool(table, "enable")
 	c.ExpiredAt = field.NewTime(table, "expired_at")
+	c.Pause = field.NewField(table, "pause")
 	c.BizID = field.NewUint32(table, "biz_id")
 	c.Creator = field.NewString(table, "creator")
 	c.Reviser = field.NewString(table, "reviser")
@@ -118,7 +121,7 @@ func (c *credential) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (c *credential) fillFieldMap() {
-	c.fieldMap = make(map[string]field.Expr, 13)
+	c.fieldMap = make(map[string]field.Expr, 14)
 	c.fieldMap["id"] = c.ID
 	c.fieldMap["credential_type"] = c.CredentialType
 	c.fieldMap["enc_credential"] = c.EncCredential
@@ -127,6 +130,7 @@ func (c *credential) fillFieldMap() {
 	c.fieldMap["memo"] = c.Memo
 	c.fieldMap["enable"] = c.Enable
 	c.fieldMap["expired_at"] = c.ExpiredAt
+	c.fieldMap["pause"] = c.Pause
 	c.fieldMap["biz_id"] = c.BizID
 	c.fieldMap["creator"] = c.Creator
 	c.fieldMap["reviser"] = c.Reviser
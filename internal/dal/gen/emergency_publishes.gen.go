@@ -0,0 +1,440 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newEmergencyPublish(db *gorm.DB, opts ...gen.DOOption) emergencyPublish {
+	_emergencyPublish := emergencyPublish{}
+
+	_emergencyPublish.emergencyPublishDo.UseDB(db, opts...)
+	_emergencyPublish.emergencyPublishDo.UseModel(&table.EmergencyPublish{})
+
+	tableName := _emergencyPublish.emergencyPublishDo.TableName()
+	_emergencyPublish.ALL = field.NewAsterisk(tableName)
+	_emergencyPublish.ID = field.NewUint32(tableName, "id")
+	_emergencyPublish.ReleaseID = field.NewUint32(tableName, "release_id")
+	_emergencyPublish.GroupID = field.NewUint32(tableName, "group_id")
+	_emergencyPublish.UIDs = field.NewField(tableName, "uids")
+	_emergencyPublish.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_emergencyPublish.State = field.NewString(tableName, "state")
+	_emergencyPublish.Memo = field.NewString(tableName, "memo")
+	_emergencyPublish.BizID = field.NewUint32(tableName, "biz_id")
+	_emergencyPublish.AppID = field.NewUint32(tableName, "app_id")
+	_emergencyPublish.Creator = field.NewString(tableName, "creator")
+	_emergencyPublish.Reviser = field.NewString(tableName, "reviser")
+	_emergencyPublish.CreatedAt = field.NewTime(tableName, "created_at")
+	_emergencyPublish.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_emergencyPublish.fillFieldMap()
+
+	return _emergencyPublish
+}
+
+type emergencyPublish struct {
+	emergencyPublishDo emergencyPublishDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	ReleaseID field.Uint32
+	GroupID   field.Uint32
+	UIDs      field.Field
+	ExpiredAt field.Time
+	State     field.String
+	Memo      field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (e emergencyPublish) Table(newTableName string) *emergencyPublish {
+	e.emergencyPublishDo.UseTable(newTableName)
+	return e.updateTableName(newTableName)
+}
+
+func (e emergencyPublish) As(alias string) *emergencyPublish {
+	e.emergencyPublishDo.DO = *(e.emergencyPublishDo.As(alias).(*gen.DO))
+	return e.updateTableName(alias)
+}
+
+func (e *emergencyPublish) updateTableName(table string) *emergencyPublish {
+	e.ALL = field.NewAsterisk(table)
+	e.ID = field.NewUint32(table, "id")
+	e.ReleaseID = field.NewUint32(table, "release_id")
+	e.GroupID = field.NewUint32(table, "group_id")
+	e.UIDs = field.NewField(table, "uids")
+	e.ExpiredAt = field.NewTime(table, "expired_at")
+	e.State = field.NewString(table, "state")
+	e.Memo = field.NewString(table, "memo")
+	e.BizID = field.NewUint32(table, "biz_id")
+	e.AppID = field.NewUint32(table, "app_id")
+	e.Creator = field.NewString(table, "creator")
+	e.Reviser = field.NewString(table, "reviser")
+	e.CreatedAt = field.NewTime(table, "created_at")
+	e.UpdatedAt = field.NewTime(table, "updated_at")
+
+	e.fillFieldMap()
+
+	return e
+}
+
+func (e *emergencyPublish) WithContext(ctx context.Context) IEmergencyPublishDo {
+	return e.emergencyPublishDo.WithContext(ctx)
+}
+
+func (e emergencyPublish) TableName() string { return e.emergencyPublishDo.TableName() }
+
+func (e emergencyPublish) Alias() string { return e.emergencyPublishDo.Alias() }
+
+func (e emergencyPublish) Columns(cols ...field.Expr) gen.Columns {
+	return e.emergencyPublishDo.Columns(cols...)
+}
+
+func (e *emergencyPublish) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := e.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (e *emergencyPublish) fillFieldMap() {
+	e.fieldMap = make(map[string]field.Expr, 13)
+	e.fieldMap["id"] = e.ID
+	e.fieldMap["release_id"] = e.ReleaseID
+	e.fieldMap["group_id"] = e.GroupID
+	e.fieldMap["uids"] = e.UIDs
+	e.fieldMap["expired_at"] = e.ExpiredAt
+	e.fieldMap["state"] = e.State
+	e.fieldMap["memo"] = e.Memo
+	e.fieldMap["biz_id"] = e.BizID
+	e.fieldMap["app_id"] = e.AppID
+	e.fieldMap["creator"] = e.Creator
+	e.fieldMap["reviser"] = e.Reviser
+	e.fieldMap["created_at"] = e.CreatedAt
+	e.fieldMap["updated_at"] = e.UpdatedAt
+}
+
+func (e emergencyPublish) clone(db *gorm.DB) emergencyPublish {
+	e.emergencyPublishDo.ReplaceConnPool(db.Statement.ConnPool)
+	return e
+}
+
+func (e emergencyPublish) replaceDB(db *gorm.DB) emergencyPublish {
+	e.emergencyPublishDo.ReplaceDB(db)
+	return e
+}
+
+type emergencyPublishDo struct{ gen.DO }
+
+type IEmergencyPublishDo interface {
+	gen.SubQuery
+	Debug() IEmergencyPublishDo
+	WithContext(ctx context.Context) IEmergencyPublishDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IEmergencyPublishDo
+	WriteDB() IEmergencyPublishDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IEmergencyPublishDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IEmergencyPublishDo
+	Not(conds ...gen.Condition) IEmergencyPublishDo
+	Or(conds ...gen.Condition) IEmergencyPublishDo
+	Select(conds ...field.Expr) IEmergencyPublishDo
+	Where(conds ...gen.Condition) IEmergencyPublishDo
+	Order(conds ...field.Expr) IEmergencyPublishDo
+	Distinct(cols ...field.Expr) IEmergencyPublishDo
+	Omit(cols ...field.Expr) IEmergencyPublishDo
+	Join(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo
+	Group(cols ...field.Expr) IEmergencyPublishDo
+	Having(conds ...gen.Condition) IEmergencyPublishDo
+	Limit(limit int) IEmergencyPublishDo
+	Offset(offset int) IEmergencyPublishDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IEmergencyPublishDo
+	Unscoped() IEmergencyPublishDo
+	Create(values ...*table.EmergencyPublish) error
+	CreateInBatches(values []*table.EmergencyPublish, batchSize int) error
+	Save(values ...*table.EmergencyPublish) error
+	First() (*table.EmergencyPublish, error)
+	Take() (*table.EmergencyPublish, error)
+	Last() (*table.EmergencyPublish, error)
+	Find() ([]*table.EmergencyPublish, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.EmergencyPublish, err error)
+	FindInBatches(result *[]*table.EmergencyPublish, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.EmergencyPublish) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IEmergencyPublishDo
+	Assign(attrs ...field.AssignExpr) IEmergencyPublishDo
+	Joins(fields ...field.RelationField) IEmergencyPublishDo
+	Preload(fields ...field.RelationField) IEmergencyPublishDo
+	FirstOrInit() (*table.EmergencyPublish, error)
+	FirstOrCreate() (*table.EmergencyPublish, error)
+	FindByPage(offset int, limit int) (result []*table.EmergencyPublish, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IEmergencyPublishDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (e emergencyPublishDo) Debug() IEmergencyPublishDo {
+	return e.withDO(e.DO.Debug())
+}
+
+func (e emergencyPublishDo) WithContext(ctx context.Context) IEmergencyPublishDo {
+	return e.withDO(e.DO.WithContext(ctx))
+}
+
+func (e emergencyPublishDo) ReadDB() IEmergencyPublishDo {
+	return e.Clauses(dbresolver.Read)
+}
+
+func (e emergencyPublishDo) WriteDB() IEmergencyPublishDo {
+	return e.Clauses(dbresolver.Write)
+}
+
+func (e emergencyPublishDo) Session(config *gorm.Session) IEmergencyPublishDo {
+	return e.withDO(e.DO.Session(config))
+}
+
+func (e emergencyPublishDo) Clauses(conds ...clause.Expression) IEmergencyPublishDo {
+	return e.withDO(e.DO.Clauses(conds...))
+}
+
+func (e emergencyPublishDo) Returning(value interface{}, columns ...string) IEmergencyPublishDo {
+	return e.withDO(e.DO.Returning(value, columns...))
+}
+
+func (e emergencyPublishDo) Not(conds ...gen.Condition) IEmergencyPublishDo {
+	return e.withDO(e.DO.Not(conds...))
+}
+
+func (e emergencyPublishDo) Or(conds ...gen.Condition) IEmergencyPublishDo {
+	return e.withDO(e.DO.Or(conds...))
+}
+
+func (e emergencyPublishDo) Select(conds ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Select(conds...))
+}
+
+func (e emergencyPublishDo) Where(conds ...gen.Condition) IEmergencyPublishDo {
+	return e.withDO(e.DO.Where(conds...))
+}
+
+func (e emergencyPublishDo) Order(conds ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Order(conds...))
+}
+
+func (e emergencyPublishDo) Distinct(cols ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Distinct(cols...))
+}
+
+func (e emergencyPublishDo) Omit(cols ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Omit(cols...))
+}
+
+func (e emergencyPublishDo) Join(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Join(table, on...))
+}
+
+func (e emergencyPublishDo) LeftJoin(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.LeftJoin(table, on...))
+}
+
+func (e emergencyPublishDo) RightJoin(table schema.Tabler, on ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.RightJoin(table, on...))
+}
+
+func (e emergencyPublishDo) Group(cols ...field.Expr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Group(cols...))
+}
+
+func (e emergencyPublishDo) Having(conds ...gen.Condition) IEmergencyPublishDo {
+	return e.withDO(e.DO.Having(conds...))
+}
+
+func (e emergencyPublishDo) Limit(limit int) IEmergencyPublishDo {
+	return e.withDO(e.DO.Limit(limit))
+}
+
+func (e emergencyPublishDo) Offset(offset int) IEmergencyPublishDo {
+	return e.withDO(e.DO.Offset(offset))
+}
+
+func (e emergencyPublishDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IEmergencyPublishDo {
+	return e.withDO(e.DO.Scopes(funcs...))
+}
+
+func (e emergencyPublishDo) Unscoped() IEmergencyPublishDo {
+	return e.withDO(e.DO.Unscoped())
+}
+
+func (e emergencyPublishDo) Create(values ...*table.EmergencyPublish) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Create(values)
+}
+
+func (e emergencyPublishDo) CreateInBatches(values []*table.EmergencyPublish, batchSize int) error {
+	return e.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (e emergencyPublishDo) Save(values ...*table.EmergencyPublish) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Save(values)
+}
+
+func (e emergencyPublishDo) First() (*table.EmergencyPublish, error) {
+	if result, err := e.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EmergencyPublish), nil
+	}
+}
+
+func (e emergencyPublishDo) Take() (*table.EmergencyPublish, error) {
+	if result, err := e.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EmergencyPublish), nil
+	}
+}
+
+func (e emergencyPublishDo) Last() (*table.EmergencyPublish, error) {
+	if result, err := e.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EmergencyPublish), nil
+	}
+}
+
+func (e emergencyPublishDo) Find() ([]*table.EmergencyPublish, error) {
+	result, err := e.DO.Find()
+	return result.([]*table.EmergencyPublish), err
+}
+
+func (e emergencyPublishDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.EmergencyPublish, err error) {
+	buf := make([]*table.EmergencyPublish, 0, batchSize)
+	err = e.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (e emergencyPublishDo) FindInBatches(result *[]*table.EmergencyPublish, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return e.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (e emergencyPublishDo) Attrs(attrs ...field.AssignExpr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Attrs(attrs...))
+}
+
+func (e emergencyPublishDo) Assign(attrs ...field.AssignExpr) IEmergencyPublishDo {
+	return e.withDO(e.DO.Assign(attrs...))
+}
+
+func (e emergencyPublishDo) Joins(fields ...field.RelationField) IEmergencyPublishDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Joins(_f))
+	}
+	return &e
+}
+
+func (e emergencyPublishDo) Preload(fields ...field.RelationField) IEmergencyPublishDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Preload(_f))
+	}
+	return &e
+}
+
+func (e emergencyPublishDo) FirstOrInit() (*table.EmergencyPublish, error) {
+	if result, err := e.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EmergencyPublish), nil
+	}
+}
+
+func (e emergencyPublishDo) FirstOrCreate() (*table.EmergencyPublish, error) {
+	if result, err := e.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EmergencyPublish), nil
+	}
+}
+
+func (e emergencyPublishDo) FindByPage(offset int, limit int) (result []*table.EmergencyPublish, count int64, err error) {
+	result, err = e.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = e.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (e emergencyPublishDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = e.Count()
+	if err != nil {
+		return
+	}
+
+	err = e.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (e emergencyPublishDo) Scan(result interface{}) (err error) {
+	return e.DO.Scan(result)
+}
+
+func (e emergencyPublishDo) Delete(models ...*table.EmergencyPublish) (result gen.ResultInfo, err error) {
+	return e.DO.Delete(models)
+}
+
+func (e *emergencyPublishDo) withDO(do gen.Dao) *emergencyPublishDo {
+	e.DO = *do.(*gen.DO)
+	return e
+}
@@ -0,0 +1,424 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newEnvAppBinding(db *gorm.DB, opts ...gen.DOOption) envAppBinding {
+	_envAppBinding := envAppBinding{}
+
+	_envAppBinding.envAppBindingDo.UseDB(db, opts...)
+	_envAppBinding.envAppBindingDo.UseModel(&table.EnvAppBinding{})
+
+	tableName := _envAppBinding.envAppBindingDo.TableName()
+	_envAppBinding.ALL = field.NewAsterisk(tableName)
+	_envAppBinding.ID = field.NewUint32(tableName, "id")
+	_envAppBinding.EnvID = field.NewUint32(tableName, "env_id")
+	_envAppBinding.AppKey = field.NewString(tableName, "app_key")
+	_envAppBinding.BizID = field.NewUint32(tableName, "biz_id")
+	_envAppBinding.AppID = field.NewUint32(tableName, "app_id")
+	_envAppBinding.Creator = field.NewString(tableName, "creator")
+	_envAppBinding.Reviser = field.NewString(tableName, "reviser")
+	_envAppBinding.CreatedAt = field.NewTime(tableName, "created_at")
+	_envAppBinding.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_envAppBinding.fillFieldMap()
+
+	return _envAppBinding
+}
+
+type envAppBinding struct {
+	envAppBindingDo envAppBindingDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	EnvID     field.Uint32
+	AppKey    field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (e envAppBinding) Table(newTableName string) *envAppBinding {
+	e.envAppBindingDo.UseTable(newTableName)
+	return e.updateTableName(newTableName)
+}
+
+func (e envAppBinding) As(alias string) *envAppBinding {
+	e.envAppBindingDo.DO = *(e.envAppBindingDo.As(alias).(*gen.DO))
+	return e.updateTableName(alias)
+}
+
+func (e *envAppBinding) updateTableName(table string) *envAppBinding {
+	e.ALL = field.NewAsterisk(table)
+	e.ID = field.NewUint32(table, "id")
+	e.EnvID = field.NewUint32(table, "env_id")
+	e.AppKey = field.NewString(table, "app_key")
+	e.BizID = field.NewUint32(table, "biz_id")
+	e.AppID = field.NewUint32(table, "app_id")
+	e.Creator = field.NewString(table, "creator")
+	e.Reviser = field.NewString(table, "reviser")
+	e.CreatedAt = field.NewTime(table, "created_at")
+	e.UpdatedAt = field.NewTime(table, "updated_at")
+
+	e.fillFieldMap()
+
+	return e
+}
+
+func (e *envAppBinding) WithContext(ctx context.Context) IEnvAppBindingDo {
+	return e.envAppBindingDo.WithContext(ctx)
+}
+
+func (e envAppBinding) TableName() string { return e.envAppBindingDo.TableName() }
+
+func (e envAppBinding) Alias() string { return e.envAppBindingDo.Alias() }
+
+func (e envAppBinding) Columns(cols ...field.Expr) gen.Columns {
+	return e.envAppBindingDo.Columns(cols...)
+}
+
+func (e *envAppBinding) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := e.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (e *envAppBinding) fillFieldMap() {
+	e.fieldMap = make(map[string]field.Expr, 9)
+	e.fieldMap["id"] = e.ID
+	e.fieldMap["env_id"] = e.EnvID
+	e.fieldMap["app_key"] = e.AppKey
+	e.fieldMap["biz_id"] = e.BizID
+	e.fieldMap["app_id"] = e.AppID
+	e.fieldMap["creator"] = e.Creator
+	e.fieldMap["reviser"] = e.Reviser
+	e.fieldMap["created_at"] = e.CreatedAt
+	e.fieldMap["updated_at"] = e.UpdatedAt
+}
+
+func (e envAppBinding) clone(db *gorm.DB) envAppBinding {
+	e.envAppBindingDo.ReplaceConnPool(db.Statement.ConnPool)
+	return e
+}
+
+func (e envAppBinding) replaceDB(db *gorm.DB) envAppBinding {
+	e.envAppBindingDo.ReplaceDB(db)
+	return e
+}
+
+type envAppBindingDo struct{ gen.DO }
+
+type IEnvAppBindingDo interface {
+	gen.SubQuery
+	Debug() IEnvAppBindingDo
+	WithContext(ctx context.Context) IEnvAppBindingDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IEnvAppBindingDo
+	WriteDB() IEnvAppBindingDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IEnvAppBindingDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IEnvAppBindingDo
+	Not(conds ...gen.Condition) IEnvAppBindingDo
+	Or(conds ...gen.Condition) IEnvAppBindingDo
+	Select(conds ...field.Expr) IEnvAppBindingDo
+	Where(conds ...gen.Condition) IEnvAppBindingDo
+	Order(conds ...field.Expr) IEnvAppBindingDo
+	Distinct(cols ...field.Expr) IEnvAppBindingDo
+	Omit(cols ...field.Expr) IEnvAppBindingDo
+	Join(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo
+	Group(cols ...field.Expr) IEnvAppBindingDo
+	Having(conds ...gen.Condition) IEnvAppBindingDo
+	Limit(limit int) IEnvAppBindingDo
+	Offset(offset int) IEnvAppBindingDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IEnvAppBindingDo
+	Unscoped() IEnvAppBindingDo
+	Create(values ...*table.EnvAppBinding) error
+	CreateInBatches(values []*table.EnvAppBinding, batchSize int) error
+	Save(values ...*table.EnvAppBinding) error
+	First() (*table.EnvAppBinding, error)
+	Take() (*table.EnvAppBinding, error)
+	Last() (*table.EnvAppBinding, error)
+	Find() ([]*table.EnvAppBinding, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.EnvAppBinding, err error)
+	FindInBatches(result *[]*table.EnvAppBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.EnvAppBinding) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IEnvAppBindingDo
+	Assign(attrs ...field.AssignExpr) IEnvAppBindingDo
+	Joins(fields ...field.RelationField) IEnvAppBindingDo
+	Preload(fields ...field.RelationField) IEnvAppBindingDo
+	FirstOrInit() (*table.EnvAppBinding, error)
+	FirstOrCreate() (*table.EnvAppBinding, error)
+	FindByPage(offset int, limit int) (result []*table.EnvAppBinding, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IEnvAppBindingDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (e envAppBindingDo) Debug() IEnvAppBindingDo {
+	return e.withDO(e.DO.Debug())
+}
+
+func (e envAppBindingDo) WithContext(ctx context.Context) IEnvAppBindingDo {
+	return e.withDO(e.DO.WithContext(ctx))
+}
+
+func (e envAppBindingDo) ReadDB() IEnvAppBindingDo {
+	return e.Clauses(dbresolver.Read)
+}
+
+func (e envAppBindingDo) WriteDB() IEnvAppBindingDo {
+	return e.Clauses(dbresolver.Write)
+}
+
+func (e envAppBindingDo) Session(config *gorm.Session) IEnvAppBindingDo {
+	return e.withDO(e.DO.Session(config))
+}
+
+func (e envAppBindingDo) Clauses(conds ...clause.Expression) IEnvAppBindingDo {
+	return e.withDO(e.DO.Clauses(conds...))
+}
+
+func (e envAppBindingDo) Returning(value interface{}, columns ...string) IEnvAppBindingDo {
+	return e.withDO(e.DO.Returning(value, columns...))
+}
+
+func (e envAppBindingDo) Not(conds ...gen.Condition) IEnvAppBindingDo {
+	return e.withDO(e.DO.Not(conds...))
+}
+
+func (e envAppBindingDo) Or(conds ...gen.Condition) IEnvAppBindingDo {
+	return e.withDO(e.DO.Or(conds...))
+}
+
+func (e envAppBindingDo) Select(conds ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Select(conds...))
+}
+
+func (e envAppBindingDo) Where(conds ...gen.Condition) IEnvAppBindingDo {
+	return e.withDO(e.DO.Where(conds...))
+}
+
+func (e envAppBindingDo) Order(conds ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Order(conds...))
+}
+
+func (e envAppBindingDo) Distinct(cols ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Distinct(cols...))
+}
+
+func (e envAppBindingDo) Omit(cols ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Omit(cols...))
+}
+
+func (e envAppBindingDo) Join(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Join(table, on...))
+}
+
+func (e envAppBindingDo) LeftJoin(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.LeftJoin(table, on...))
+}
+
+func (e envAppBindingDo) RightJoin(table schema.Tabler, on ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.RightJoin(table, on...))
+}
+
+func (e envAppBindingDo) Group(cols ...field.Expr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Group(cols...))
+}
+
+func (e envAppBindingDo) Having(conds ...gen.Condition) IEnvAppBindingDo {
+	return e.withDO(e.DO.Having(conds...))
+}
+
+func (e envAppBindingDo) Limit(limit int) IEnvAppBindingDo {
+	return e.withDO(e.DO.Limit(limit))
+}
+
+func (e envAppBindingDo) Offset(offset int) IEnvAppBindingDo {
+	return e.withDO(e.DO.Offset(offset))
+}
+
+func (e envAppBindingDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IEnvAppBindingDo {
+	return e.withDO(e.DO.Scopes(funcs...))
+}
+
+func (e envAppBindingDo) Unscoped() IEnvAppBindingDo {
+	return e.withDO(e.DO.Unscoped())
+}
+
+func (e envAppBindingDo) Create(values ...*table.EnvAppBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Create(values)
+}
+
+func (e envAppBindingDo) CreateInBatches(values []*table.EnvAppBinding, batchSize int) error {
+	return e.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (e envAppBindingDo) Save(values ...*table.EnvAppBinding) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Save(values)
+}
+
+func (e envAppBindingDo) First() (*table.EnvAppBinding, error) {
+	if result, err := e.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EnvAppBinding), nil
+	}
+}
+
+func (e envAppBindingDo) Take() (*table.EnvAppBinding, error) {
+	if result, err := e.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EnvAppBinding), nil
+	}
+}
+
+func (e envAppBindingDo) Last() (*table.EnvAppBinding, error) {
+	if result, err := e.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EnvAppBinding), nil
+	}
+}
+
+func (e envAppBindingDo) Find() ([]*table.EnvAppBinding, error) {
+	result, err := e.DO.Find()
+	return result.([]*table.EnvAppBinding), err
+}
+
+func (e envAppBindingDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.EnvAppBinding, err error) {
+	buf := make([]*table.EnvAppBinding, 0, batchSize)
+	err = e.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (e envAppBindingDo) FindInBatches(result *[]*table.EnvAppBinding, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return e.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (e envAppBindingDo) Attrs(attrs ...field.AssignExpr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Attrs(attrs...))
+}
+
+func (e envAppBindingDo) Assign(attrs ...field.AssignExpr) IEnvAppBindingDo {
+	return e.withDO(e.DO.Assign(attrs...))
+}
+
+func (e envAppBindingDo) Joins(fields ...field.RelationField) IEnvAppBindingDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Joins(_f))
+	}
+	return &e
+}
+
+func (e envAppBindingDo) Preload(fields ...field.RelationField) IEnvAppBindingDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Preload(_f))
+	}
+	return &e
+}
+
+func (e envAppBindingDo) FirstOrInit() (*table.EnvAppBinding, error) {
+	if result, err := e.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EnvAppBinding), nil
+	}
+}
+
+func (e envAppBindingDo) FirstOrCreate() (*table.EnvAppBinding, error) {
+	if result, err := e.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.EnvAppBinding), nil
+	}
+}
+
+func (e envAppBindingDo) FindByPage(offset int, limit int) (result []*table.EnvAppBinding, count int64, err error) {
+	result, err = e.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = e.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (e envAppBindingDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = e.Count()
+	if err != nil {
+		return
+	}
+
+	err = e.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (e envAppBindingDo) Scan(result interface{}) (err error) {
+	return e.DO.Scan(result)
+}
+
+func (e envAppBindingDo) Delete(models ...*table.EnvAppBinding) (result gen.ResultInfo, err error) {
+	return e.DO.Delete(models)
+}
+
+func (e *envAppBindingDo) withDO(do gen.Dao) *envAppBindingDo {
+	e.DO = *do.(*gen.DO)
+	return e
+}
@@ -0,0 +1,428 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newExperimentExposure(db *gorm.DB, opts ...gen.DOOption) experimentExposure {
+	_experimentExposure := experimentExposure{}
+
+	_experimentExposure.experimentExposureDo.UseDB(db, opts...)
+	_experimentExposure.experimentExposureDo.UseModel(&table.ExperimentExposure{})
+
+	tableName := _experimentExposure.experimentExposureDo.TableName()
+	_experimentExposure.ALL = field.NewAsterisk(tableName)
+	_experimentExposure.ID = field.NewUint32(tableName, "id")
+	_experimentExposure.Variant = field.NewString(tableName, "variant")
+	_experimentExposure.ReleaseID = field.NewUint32(tableName, "release_id")
+	_experimentExposure.Count = field.NewUint32(tableName, "count")
+	_experimentExposure.FirstExposedAt = field.NewTime(tableName, "first_exposed_at")
+	_experimentExposure.LastExposedAt = field.NewTime(tableName, "last_exposed_at")
+	_experimentExposure.BizID = field.NewUint32(tableName, "biz_id")
+	_experimentExposure.AppID = field.NewUint32(tableName, "app_id")
+	_experimentExposure.ExperimentID = field.NewUint32(tableName, "experiment_id")
+	_experimentExposure.UID = field.NewString(tableName, "uid")
+
+	_experimentExposure.fillFieldMap()
+
+	return _experimentExposure
+}
+
+type experimentExposure struct {
+	experimentExposureDo experimentExposureDo
+
+	ALL            field.Asterisk
+	ID             field.Uint32
+	Variant        field.String
+	ReleaseID      field.Uint32
+	Count          field.Uint32
+	FirstExposedAt field.Time
+	LastExposedAt  field.Time
+	BizID          field.Uint32
+	AppID          field.Uint32
+	ExperimentID   field.Uint32
+	UID            field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (e experimentExposure) Table(newTableName string) *experimentExposure {
+	e.experimentExposureDo.UseTable(newTableName)
+	return e.updateTableName(newTableName)
+}
+
+func (e experimentExposure) As(alias string) *experimentExposure {
+	e.experimentExposureDo.DO = *(e.experimentExposureDo.As(alias).(*gen.DO))
+	return e.updateTableName(alias)
+}
+
+func (e *experimentExposure) updateTableName(table string) *experimentExposure {
+	e.ALL = field.NewAsterisk(table)
+	e.ID = field.NewUint32(table, "id")
+	e.Variant = field.NewString(table, "variant")
+	e.ReleaseID = field.NewUint32(table, "release_id")
+	e.Count = field.NewUint32(table, "count")
+	e.FirstExposedAt = field.NewTime(table, "first_exposed_at")
+	e.LastExposedAt = field.NewTime(table, "last_exposed_at")
+	e.BizID = field.NewUint32(table, "biz_id")
+	e.AppID = field.NewUint32(table, "app_id")
+	e.ExperimentID = field.NewUint32(table, "experiment_id")
+	e.UID = field.NewString(table, "uid")
+
+	e.fillFieldMap()
+
+	return e
+}
+
+func (e *experimentExposure) WithContext(ctx context.Context) IExperimentExposureDo {
+	return e.experimentExposureDo.WithContext(ctx)
+}
+
+func (e experimentExposure) TableName() string { return e.experimentExposureDo.TableName() }
+
+func (e experimentExposure) Alias() string { return e.experimentExposureDo.Alias() }
+
+func (e experimentExposure) Columns(cols ...field.Expr) gen.Columns {
+	return e.experimentExposureDo.Columns(cols...)
+}
+
+func (e *experimentExposure) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := e.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (e *experimentExposure) fillFieldMap() {
+	e.fieldMap = make(map[string]field.Expr, 10)
+	e.fieldMap["id"] = e.ID
+	e.fieldMap["variant"] = e.Variant
+	e.fieldMap["release_id"] = e.ReleaseID
+	e.fieldMap["count"] = e.Count
+	e.fieldMap["first_exposed_at"] = e.FirstExposedAt
+	e.fieldMap["last_exposed_at"] = e.LastExposedAt
+	e.fieldMap["biz_id"] = e.BizID
+	e.fieldMap["app_id"] = e.AppID
+	e.fieldMap["experiment_id"] = e.ExperimentID
+	e.fieldMap["uid"] = e.UID
+}
+
+func (e experimentExposure) clone(db *gorm.DB) experimentExposure {
+	e.experimentExposureDo.ReplaceConnPool(db.Statement.ConnPool)
+	return e
+}
+
+func (e experimentExposure) replaceDB(db *gorm.DB) experimentExposure {
+	e.experimentExposureDo.ReplaceDB(db)
+	return e
+}
+
+type experimentExposureDo struct{ gen.DO }
+
+type IExperimentExposureDo interface {
+	gen.SubQuery
+	Debug() IExperimentExposureDo
+	WithContext(ctx context.Context) IExperimentExposureDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IExperimentExposureDo
+	WriteDB() IExperimentExposureDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IExperimentExposureDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IExperimentExposureDo
+	Not(conds ...gen.Condition) IExperimentExposureDo
+	Or(conds ...gen.Condition) IExperimentExposureDo
+	Select(conds ...field.Expr) IExperimentExposureDo
+	Where(conds ...gen.Condition) IExperimentExposureDo
+	Order(conds ...field.Expr) IExperimentExposureDo
+	Distinct(cols ...field.Expr) IExperimentExposureDo
+	Omit(cols ...field.Expr) IExperimentExposureDo
+	Join(table schema.Tabler, on ...field.Expr) IExperimentExposureDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IExperimentExposureDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IExperimentExposureDo
+	Group(cols ...field.Expr) IExperimentExposureDo
+	Having(conds ...gen.Condition) IExperimentExposureDo
+	Limit(limit int) IExperimentExposureDo
+	Offset(offset int) IExperimentExposureDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IExperimentExposureDo
+	Unscoped() IExperimentExposureDo
+	Create(values ...*table.ExperimentExposure) error
+	CreateInBatches(values []*table.ExperimentExposure, batchSize int) error
+	Save(values ...*table.ExperimentExposure) error
+	First() (*table.ExperimentExposure, error)
+	Take() (*table.ExperimentExposure, error)
+	Last() (*table.ExperimentExposure, error)
+	Find() ([]*table.ExperimentExposure, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ExperimentExposure, err error)
+	FindInBatches(result *[]*table.ExperimentExposure, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ExperimentExposure) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IExperimentExposureDo
+	Assign(attrs ...field.AssignExpr) IExperimentExposureDo
+	Joins(fields ...field.RelationField) IExperimentExposureDo
+	Preload(fields ...field.RelationField) IExperimentExposureDo
+	FirstOrInit() (*table.ExperimentExposure, error)
+	FirstOrCreate() (*table.ExperimentExposure, error)
+	FindByPage(offset int, limit int) (result []*table.ExperimentExposure, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IExperimentExposureDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (e experimentExposureDo) Debug() IExperimentExposureDo {
+	return e.withDO(e.DO.Debug())
+}
+
+func (e experimentExposureDo) WithContext(ctx context.Context) IExperimentExposureDo {
+	return e.withDO(e.DO.WithContext(ctx))
+}
+
+func (e experimentExposureDo) ReadDB() IExperimentExposureDo {
+	return e.Clauses(dbresolver.Read)
+}
+
+func (e experimentExposureDo) WriteDB() IExperimentExposureDo {
+	return e.Clauses(dbresolver.Write)
+}
+
+func (e experimentExposureDo) Session(config *gorm.Session) IExperimentExposureDo {
+	return e.withDO(e.DO.Session(config))
+}
+
+func (e experimentExposureDo) Clauses(conds ...clause.Expression) IExperimentExposureDo {
+	return e.withDO(e.DO.Clauses(conds...))
+}
+
+func (e experimentExposureDo) Returning(value interface{}, columns ...string) IExperimentExposureDo {
+	return e.withDO(e.DO.Returning(value, columns...))
+}
+
+func (e experimentExposureDo) Not(conds ...gen.Condition) IExperimentExposureDo {
+	return e.withDO(e.DO.Not(conds...))
+}
+
+func (e experimentExposureDo) Or(conds ...gen.Condition) IExperimentExposureDo {
+	return e.withDO(e.DO.Or(conds...))
+}
+
+func (e experimentExposureDo) Select(conds ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Select(conds...))
+}
+
+func (e experimentExposureDo) Where(conds ...gen.Condition) IExperimentExposureDo {
+	return e.withDO(e.DO.Where(conds...))
+}
+
+func (e experimentExposureDo) Order(conds ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Order(conds...))
+}
+
+func (e experimentExposureDo) Distinct(cols ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Distinct(cols...))
+}
+
+func (e experimentExposureDo) Omit(cols ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Omit(cols...))
+}
+
+func (e experimentExposureDo) Join(table schema.Tabler, on ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Join(table, on...))
+}
+
+func (e experimentExposureDo) LeftJoin(table schema.Tabler, on ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.LeftJoin(table, on...))
+}
+
+func (e experimentExposureDo) RightJoin(table schema.Tabler, on ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.RightJoin(table, on...))
+}
+
+func (e experimentExposureDo) Group(cols ...field.Expr) IExperimentExposureDo {
+	return e.withDO(e.DO.Group(cols...))
+}
+
+func (e experimentExposureDo) Having(conds ...gen.Condition) IExperimentExposureDo {
+	return e.withDO(e.DO.Having(conds...))
+}
+
+func (e experimentExposureDo) Limit(limit int) IExperimentExposureDo {
+	return e.withDO(e.DO.Limit(limit))
+}
+
+func (e experimentExposureDo) Offset(offset int) IExperimentExposureDo {
+	return e.withDO(e.DO.Offset(offset))
+}
+
+func (e experimentExposureDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IExperimentExposureDo {
+	return e.withDO(e.DO.Scopes(funcs...))
+}
+
+func (e experimentExposureDo) Unscoped() IExperimentExposureDo {
+	return e.withDO(e.DO.Unscoped())
+}
+
+func (e experimentExposureDo) Create(values ...*table.ExperimentExposure) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Create(values)
+}
+
+func (e experimentExposureDo) CreateInBatches(values []*table.ExperimentExposure, batchSize int) error {
+	return e.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (e experimentExposureDo) Save(values ...*table.ExperimentExposure) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Save(values)
+}
+
+func (e experimentExposureDo) First() (*table.ExperimentExposure, error) {
+	if result, err := e.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ExperimentExposure), nil
+	}
+}
+
+func (e experimentExposureDo) Take() (*table.ExperimentExposure, error) {
+	if result, err := e.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ExperimentExposure), nil
+	}
+}
+
+func (e experimentExposureDo) Last() (*table.ExperimentExposure, error) {
+	if result, err := e.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ExperimentExposure), nil
+	}
+}
+
+func (e experimentExposureDo) Find() ([]*table.ExperimentExposure, error) {
+	result, err := e.DO.Find()
+	return result.([]*table.ExperimentExposure), err
+}
+
+func (e experimentExposureDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ExperimentExposure, err error) {
+	buf := make([]*table.ExperimentExposure, 0, batchSize)
+	err = e.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (e experimentExposureDo) FindInBatches(result *[]*table.ExperimentExposure, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return e.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (e experimentExposureDo) Attrs(attrs ...field.AssignExpr) IExperimentExposureDo {
+	return e.withDO(e.DO.Attrs(attrs...))
+}
+
+func (e experimentExposureDo) Assign(attrs ...field.AssignExpr) IExperimentExposureDo {
+	return e.withDO(e.DO.Assign(attrs...))
+}
+
+func (e experimentExposureDo) Joins(fields ...field.RelationField) IExperimentExposureDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Joins(_f))
+	}
+	return &e
+}
+
+func (e experimentExposureDo) Preload(fields ...field.RelationField) IExperimentExposureDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Preload(_f))
+	}
+	return &e
+}
+
+func (e experimentExposureDo) FirstOrInit() (*table.ExperimentExposure, error) {
+	if result, err := e.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ExperimentExposure), nil
+	}
+}
+
+func (e experimentExposureDo) FirstOrCreate() (*table.ExperimentExposure, error) {
+	if result, err := e.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ExperimentExposure), nil
+	}
+}
+
+func (e experimentExposureDo) FindByPage(offset int, limit int) (result []*table.ExperimentExposure, count int64, err error) {
+	result, err = e.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = e.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (e experimentExposureDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = e.Count()
+	if err != nil {
+		return
+	}
+
+	err = e.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (e experimentExposureDo) Scan(result interface{}) (err error) {
+	return e.DO.Scan(result)
+}
+
+func (e experimentExposureDo) Delete(models ...*table.ExperimentExposure) (result gen.ResultInfo, err error) {
+	return e.DO.Delete(models)
+}
+
+func (e *experimentExposureDo) withDO(do gen.Dao) *experimentExposureDo {
+	e.DO = *do.(*gen.DO)
+	return e
+}
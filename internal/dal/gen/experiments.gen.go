@@ -0,0 +1,438 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newExperiment(db *gorm.DB, opts ...gen.DOOption) experiment {
+	_experiment := experiment{}
+
+	_experiment.experimentDo.UseDB(db, opts...)
+	_experiment.experimentDo.UseModel(&table.Experiment{})
+
+	tableName := _experiment.experimentDo.TableName()
+	_experiment.ALL = field.NewAsterisk(tableName)
+	_experiment.ID = field.NewUint32(tableName, "id")
+	_experiment.Name = field.NewString(tableName, "name")
+	_experiment.Key = field.NewString(tableName, "key")
+	_experiment.Selector = field.NewField(tableName, "selector")
+	_experiment.Variants = field.NewField(tableName, "variants")
+	_experiment.Enabled = field.NewBool(tableName, "enabled")
+	_experiment.Memo = field.NewString(tableName, "memo")
+	_experiment.BizID = field.NewUint32(tableName, "biz_id")
+	_experiment.AppID = field.NewUint32(tableName, "app_id")
+	_experiment.Creator = field.NewString(tableName, "creator")
+	_experiment.Reviser = field.NewString(tableName, "reviser")
+	_experiment.CreatedAt = field.NewTime(tableName, "created_at")
+	_experiment.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_experiment.fillFieldMap()
+
+	return _experiment
+}
+
+type experiment struct {
+	experimentDo experimentDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Name      field.String
+	Key       field.String
+	Selector  field.Field
+	Variants  field.Field
+	Enabled   field.Bool
+	Memo      field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (e experiment) Table(newTableName string) *experiment {
+	e.experimentDo.UseTable(newTableName)
+	return e.updateTableName(newTableName)
+}
+
+func (e experiment) As(alias string) *experiment {
+	e.experimentDo.DO = *(e.experimentDo.As(alias).(*gen.DO))
+	return e.updateTableName(alias)
+}
+
+func (e *experiment) updateTableName(table string) *experiment {
+	e.ALL = field.NewAsterisk(table)
+	e.ID = field.NewUint32(table, "id")
+	e.Name = field.NewString(table, "name")
+	e.Key = field.NewString(table, "key")
+	e.Selector = field.NewField(table, "selector")
+	e.Variants = field.NewField(table, "variants")
+	e.Enabled = field.NewBool(table, "enabled")
+	e.Memo = field.NewString(table, "memo")
+	e.BizID = field.NewUint32(table, "biz_id")
+	e.AppID = field.NewUint32(table, "app_id")
+	e.Creator = field.NewString(table, "creator")
+	e.Reviser = field.NewString(table, "reviser")
+	e.CreatedAt = field.NewTime(table, "created_at")
+	e.UpdatedAt = field.NewTime(table, "updated_at")
+
+	e.fillFieldMap()
+
+	return e
+}
+
+func (e *experiment) WithContext(ctx context.Context) IExperimentDo {
+	return e.experimentDo.WithContext(ctx)
+}
+
+func (e experiment) TableName() string { return e.experimentDo.TableName() }
+
+func (e experiment) Alias() string { return e.experimentDo.Alias() }
+
+func (e experiment) Columns(cols ...field.Expr) gen.Columns { return e.experimentDo.Columns(cols...) }
+
+func (e *experiment) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := e.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (e *experiment) fillFieldMap() {
+	e.fieldMap = make(map[string]field.Expr, 13)
+	e.fieldMap["id"] = e.ID
+	e.fieldMap["name"] = e.Name
+	e.fieldMap["key"] = e.Key
+	e.fieldMap["selector"] = e.Selector
+	e.fieldMap["variants"] = e.Variants
+	e.fieldMap["enabled"] = e.Enabled
+	e.fieldMap["memo"] = e.Memo
+	e.fieldMap["biz_id"] = e.BizID
+	e.fieldMap["app_id"] = e.AppID
+	e.fieldMap["creator"] = e.Creator
+	e.fieldMap["reviser"] = e.Reviser
+	e.fieldMap["created_at"] = e.CreatedAt
+	e.fieldMap["updated_at"] = e.UpdatedAt
+}
+
+func (e experiment) clone(db *gorm.DB) experiment {
+	e.experimentDo.ReplaceConnPool(db.Statement.ConnPool)
+	return e
+}
+
+func (e experiment) replaceDB(db *gorm.DB) experiment {
+	e.experimentDo.ReplaceDB(db)
+	return e
+}
+
+type experimentDo struct{ gen.DO }
+
+type IExperimentDo interface {
+	gen.SubQuery
+	Debug() IExperimentDo
+	WithContext(ctx context.Context) IExperimentDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IExperimentDo
+	WriteDB() IExperimentDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IExperimentDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IExperimentDo
+	Not(conds ...gen.Condition) IExperimentDo
+	Or(conds ...gen.Condition) IExperimentDo
+	Select(conds ...field.Expr) IExperimentDo
+	Where(conds ...gen.Condition) IExperimentDo
+	Order(conds ...field.Expr) IExperimentDo
+	Distinct(cols ...field.Expr) IExperimentDo
+	Omit(cols ...field.Expr) IExperimentDo
+	Join(table schema.Tabler, on ...field.Expr) IExperimentDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IExperimentDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IExperimentDo
+	Group(cols ...field.Expr) IExperimentDo
+	Having(conds ...gen.Condition) IExperimentDo
+	Limit(limit int) IExperimentDo
+	Offset(offset int) IExperimentDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IExperimentDo
+	Unscoped() IExperimentDo
+	Create(values ...*table.Experiment) error
+	CreateInBatches(values []*table.Experiment, batchSize int) error
+	Save(values ...*table.Experiment) error
+	First() (*table.Experiment, error)
+	Take() (*table.Experiment, error)
+	Last() (*table.Experiment, error)
+	Find() ([]*table.Experiment, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Experiment, err error)
+	FindInBatches(result *[]*table.Experiment, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.Experiment) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IExperimentDo
+	Assign(attrs ...field.AssignExpr) IExperimentDo
+	Joins(fields ...field.RelationField) IExperimentDo
+	Preload(fields ...field.RelationField) IExperimentDo
+	FirstOrInit() (*table.Experiment, error)
+	FirstOrCreate() (*table.Experiment, error)
+	FindByPage(offset int, limit int) (result []*table.Experiment, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IExperimentDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (e experimentDo) Debug() IExperimentDo {
+	return e.withDO(e.DO.Debug())
+}
+
+func (e experimentDo) WithContext(ctx context.Context) IExperimentDo {
+	return e.withDO(e.DO.WithContext(ctx))
+}
+
+func (e experimentDo) ReadDB() IExperimentDo {
+	return e.Clauses(dbresolver.Read)
+}
+
+func (e experimentDo) WriteDB() IExperimentDo {
+	return e.Clauses(dbresolver.Write)
+}
+
+func (e experimentDo) Session(config *gorm.Session) IExperimentDo {
+	return e.withDO(e.DO.Session(config))
+}
+
+func (e experimentDo) Clauses(conds ...clause.Expression) IExperimentDo {
+	return e.withDO(e.DO.Clauses(conds...))
+}
+
+func (e experimentDo) Returning(value interface{}, columns ...string) IExperimentDo {
+	return e.withDO(e.DO.Returning(value, columns...))
+}
+
+func (e experimentDo) Not(conds ...gen.Condition) IExperimentDo {
+	return e.withDO(e.DO.Not(conds...))
+}
+
+func (e experimentDo) Or(conds ...gen.Condition) IExperimentDo {
+	return e.withDO(e.DO.Or(conds...))
+}
+
+func (e experimentDo) Select(conds ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Select(conds...))
+}
+
+func (e experimentDo) Where(conds ...gen.Condition) IExperimentDo {
+	return e.withDO(e.DO.Where(conds...))
+}
+
+func (e experimentDo) Order(conds ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Order(conds...))
+}
+
+func (e experimentDo) Distinct(cols ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Distinct(cols...))
+}
+
+func (e experimentDo) Omit(cols ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Omit(cols...))
+}
+
+func (e experimentDo) Join(table schema.Tabler, on ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Join(table, on...))
+}
+
+func (e experimentDo) LeftJoin(table schema.Tabler, on ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.LeftJoin(table, on...))
+}
+
+func (e experimentDo) RightJoin(table schema.Tabler, on ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.RightJoin(table, on...))
+}
+
+func (e experimentDo) Group(cols ...field.Expr) IExperimentDo {
+	return e.withDO(e.DO.Group(cols...))
+}
+
+func (e experimentDo) Having(conds ...gen.Condition) IExperimentDo {
+	return e.withDO(e.DO.Having(conds...))
+}
+
+func (e experimentDo) Limit(limit int) IExperimentDo {
+	return e.withDO(e.DO.Limit(limit))
+}
+
+func (e experimentDo) Offset(offset int) IExperimentDo {
+	return e.withDO(e.DO.Offset(offset))
+}
+
+func (e experimentDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IExperimentDo {
+	return e.withDO(e.DO.Scopes(funcs...))
+}
+
+func (e experimentDo) Unscoped() IExperimentDo {
+	return e.withDO(e.DO.Unscoped())
+}
+
+func (e experimentDo) Create(values ...*table.Experiment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Create(values)
+}
+
+func (e experimentDo) CreateInBatches(values []*table.Experiment, batchSize int) error {
+	return e.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (e experimentDo) Save(values ...*table.Experiment) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return e.DO.Save(values)
+}
+
+func (e experimentDo) First() (*table.Experiment, error) {
+	if result, err := e.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Experiment), nil
+	}
+}
+
+func (e experimentDo) Take() (*table.Experiment, error) {
+	if result, err := e.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Experiment), nil
+	}
+}
+
+func (e experimentDo) Last() (*table.Experiment, error) {
+	if result, err := e.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Experiment), nil
+	}
+}
+
+func (e experimentDo) Find() ([]*table.Experiment, error) {
+	result, err := e.DO.Find()
+	return result.([]*table.Experiment), err
+}
+
+func (e experimentDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.Experiment, err error) {
+	buf := make([]*table.Experiment, 0, batchSize)
+	err = e.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (e experimentDo) FindInBatches(result *[]*table.Experiment, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return e.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (e experimentDo) Attrs(attrs ...field.AssignExpr) IExperimentDo {
+	return e.withDO(e.DO.Attrs(attrs...))
+}
+
+func (e experimentDo) Assign(attrs ...field.AssignExpr) IExperimentDo {
+	return e.withDO(e.DO.Assign(attrs...))
+}
+
+func (e experimentDo) Joins(fields ...field.RelationField) IExperimentDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Joins(_f))
+	}
+	return &e
+}
+
+func (e experimentDo) Preload(fields ...field.RelationField) IExperimentDo {
+	for _, _f := range fields {
+		e = *e.withDO(e.DO.Preload(_f))
+	}
+	return &e
+}
+
+func (e experimentDo) FirstOrInit() (*table.Experiment, error) {
+	if result, err := e.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Experiment), nil
+	}
+}
+
+func (e experimentDo) FirstOrCreate() (*table.Experiment, error) {
+	if result, err := e.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.Experiment), nil
+	}
+}
+
+func (e experimentDo) FindByPage(offset int, limit int) (result []*table.Experiment, count int64, err error) {
+	result, err = e.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = e.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (e experimentDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = e.Count()
+	if err != nil {
+		return
+	}
+
+	err = e.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (e experimentDo) Scan(result interface{}) (err error) {
+	return e.DO.Scan(result)
+}
+
+func (e experimentDo) Delete(models ...*table.Experiment) (result gen.ResultInfo, err error) {
+	return e.DO.Delete(models)
+}
+
+func (e *experimentDo) withDO(do gen.Dao) *experimentDo {
+	e.DO = *do.(*gen.DO)
+	return e
+}
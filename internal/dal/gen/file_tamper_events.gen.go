@@ -0,0 +1,456 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newFileTamperEvent(db *gorm.DB, opts ...gen.DOOption) fileTamperEvent {
+	_fileTamperEvent := fileTamperEvent{}
+
+	_fileTamperEvent.fileTamperEventDo.UseDB(db, opts...)
+	_fileTamperEvent.fileTamperEventDo.UseModel(&table.FileTamperEvent{})
+
+	tableName := _fileTamperEvent.fileTamperEventDo.TableName()
+	_fileTamperEvent.ALL = field.NewAsterisk(tableName)
+	_fileTamperEvent.ID = field.NewUint32(tableName, "id")
+	_fileTamperEvent.BizID = field.NewUint32(tableName, "biz_id")
+	_fileTamperEvent.AppID = field.NewUint32(tableName, "app_id")
+	_fileTamperEvent.ReleaseID = field.NewUint32(tableName, "release_id")
+	_fileTamperEvent.UID = field.NewString(tableName, "uid")
+	_fileTamperEvent.ConfigItemID = field.NewUint32(tableName, "config_item_id")
+	_fileTamperEvent.Path = field.NewString(tableName, "path")
+	_fileTamperEvent.Name = field.NewString(tableName, "name")
+	_fileTamperEvent.Kind = field.NewString(tableName, "kind")
+	_fileTamperEvent.ExpectedSignature = field.NewString(tableName, "expected_signature")
+	_fileTamperEvent.ActualSignature = field.NewString(tableName, "actual_signature")
+	_fileTamperEvent.Repaired = field.NewBool(tableName, "repaired")
+	_fileTamperEvent.RepairError = field.NewString(tableName, "repair_error")
+	_fileTamperEvent.Ip = field.NewString(tableName, "ip")
+	_fileTamperEvent.ClientType = field.NewString(tableName, "client_type")
+	_fileTamperEvent.DetectedAt = field.NewTime(tableName, "detected_at")
+	_fileTamperEvent.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_fileTamperEvent.fillFieldMap()
+
+	return _fileTamperEvent
+}
+
+type fileTamperEvent struct {
+	fileTamperEventDo fileTamperEventDo
+
+	ALL               field.Asterisk
+	ID                field.Uint32
+	BizID             field.Uint32
+	AppID             field.Uint32
+	ReleaseID         field.Uint32
+	UID               field.String
+	ConfigItemID      field.Uint32
+	Path              field.String
+	Name              field.String
+	Kind              field.String
+	ExpectedSignature field.String
+	ActualSignature   field.String
+	Repaired          field.Bool
+	RepairError       field.String
+	Ip                field.String
+	ClientType        field.String
+	DetectedAt        field.Time
+	CreatedAt         field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (f fileTamperEvent) Table(newTableName string) *fileTamperEvent {
+	f.fileTamperEventDo.UseTable(newTableName)
+	return f.updateTableName(newTableName)
+}
+
+func (f fileTamperEvent) As(alias string) *fileTamperEvent {
+	f.fileTamperEventDo.DO = *(f.fileTamperEventDo.As(alias).(*gen.DO))
+	return f.updateTableName(alias)
+}
+
+func (f *fileTamperEvent) updateTableName(table string) *fileTamperEvent {
+	f.ALL = field.NewAsterisk(table)
+	f.ID = field.NewUint32(table, "id")
+	f.BizID = field.NewUint32(table, "biz_id")
+	f.AppID = field.NewUint32(table, "app_id")
+	f.ReleaseID = field.NewUint32(table, "release_id")
+	f.UID = field.NewString(table, "uid")
+	f.ConfigItemID = field.NewUint32(table, "config_item_id")
+	f.Path = field.NewString(table, "path")
+	f.Name = field.NewString(table, "name")
+	f.Kind = field.NewString(table, "kind")
+	f.ExpectedSignature = field.NewString(table, "expected_signature")
+	f.ActualSignature = field.NewString(table, "actual_signature")
+	f.Repaired = field.NewBool(table, "repaired")
+	f.RepairError = field.NewString(table, "repair_error")
+	f.Ip = field.NewString(table, "ip")
+	f.ClientType = field.NewString(table, "client_type")
+	f.DetectedAt = field.NewTime(table, "detected_at")
+	f.CreatedAt = field.NewTime(table, "created_at")
+
+	f.fillFieldMap()
+
+	return f
+}
+
+func (f *fileTamperEvent) WithContext(ctx context.Context) IFileTamperEventDo {
+	return f.fileTamperEventDo.WithContext(ctx)
+}
+
+func (f fileTamperEvent) TableName() string { return f.fileTamperEventDo.TableName() }
+
+func (f fileTamperEvent) Alias() string { return f.fileTamperEventDo.Alias() }
+
+func (f fileTamperEvent) Columns(cols ...field.Expr) gen.Columns {
+	return f.fileTamperEventDo.Columns(cols...)
+}
+
+func (f *fileTamperEvent) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := f.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (f *fileTamperEvent) fillFieldMap() {
+	f.fieldMap = make(map[string]field.Expr, 17)
+	f.fieldMap["id"] = f.ID
+	f.fieldMap["biz_id"] = f.BizID
+	f.fieldMap["app_id"] = f.AppID
+	f.fieldMap["release_id"] = f.ReleaseID
+	f.fieldMap["uid"] = f.UID
+	f.fieldMap["config_item_id"] = f.ConfigItemID
+	f.fieldMap["path"] = f.Path
+	f.fieldMap["name"] = f.Name
+	f.fieldMap["kind"] = f.Kind
+	f.fieldMap["expected_signature"] = f.ExpectedSignature
+	f.fieldMap["actual_signature"] = f.ActualSignature
+	f.fieldMap["repaired"] = f.Repaired
+	f.fieldMap["repair_error"] = f.RepairError
+	f.fieldMap["ip"] = f.Ip
+	f.fieldMap["client_type"] = f.ClientType
+	f.fieldMap["detected_at"] = f.DetectedAt
+	f.fieldMap["created_at"] = f.CreatedAt
+}
+
+func (f fileTamperEvent) clone(db *gorm.DB) fileTamperEvent {
+	f.fileTamperEventDo.ReplaceConnPool(db.Statement.ConnPool)
+	return f
+}
+
+func (f fileTamperEvent) replaceDB(db *gorm.DB) fileTamperEvent {
+	f.fileTamperEventDo.ReplaceDB(db)
+	return f
+}
+
+type fileTamperEventDo struct{ gen.DO }
+
+type IFileTamperEventDo interface {
+	gen.SubQuery
+	Debug() IFileTamperEventDo
+	WithContext(ctx context.Context) IFileTamperEventDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IFileTamperEventDo
+	WriteDB() IFileTamperEventDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IFileTamperEventDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IFileTamperEventDo
+	Not(conds ...gen.Condition) IFileTamperEventDo
+	Or(conds ...gen.Condition) IFileTamperEventDo
+	Select(conds ...field.Expr) IFileTamperEventDo
+	Where(conds ...gen.Condition) IFileTamperEventDo
+	Order(conds ...field.Expr) IFileTamperEventDo
+	Distinct(cols ...field.Expr) IFileTamperEventDo
+	Omit(cols ...field.Expr) IFileTamperEventDo
+	Join(table schema.Tabler, on ...field.Expr) IFileTamperEventDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IFileTamperEventDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IFileTamperEventDo
+	Group(cols ...field.Expr) IFileTamperEventDo
+	Having(conds ...gen.Condition) IFileTamperEventDo
+	Limit(limit int) IFileTamperEventDo
+	Offset(offset int) IFileTamperEventDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IFileTamperEventDo
+	Unscoped() IFileTamperEventDo
+	Create(values ...*table.FileTamperEvent) error
+	CreateInBatches(values []*table.FileTamperEvent, batchSize int) error
+	Save(values ...*table.FileTamperEvent) error
+	First() (*table.FileTamperEvent, error)
+	Take() (*table.FileTamperEvent, error)
+	Last() (*table.FileTamperEvent, error)
+	Find() ([]*table.FileTamperEvent, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.FileTamperEvent, err error)
+	FindInBatches(result *[]*table.FileTamperEvent, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.FileTamperEvent) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IFileTamperEventDo
+	Assign(attrs ...field.AssignExpr) IFileTamperEventDo
+	Joins(fields ...field.RelationField) IFileTamperEventDo
+	Preload(fields ...field.RelationField) IFileTamperEventDo
+	FirstOrInit() (*table.FileTamperEvent, error)
+	FirstOrCreate() (*table.FileTamperEvent, error)
+	FindByPage(offset int, limit int) (result []*table.FileTamperEvent, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IFileTamperEventDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (f fileTamperEventDo) Debug() IFileTamperEventDo {
+	return f.withDO(f.DO.Debug())
+}
+
+func (f fileTamperEventDo) WithContext(ctx context.Context) IFileTamperEventDo {
+	return f.withDO(f.DO.WithContext(ctx))
+}
+
+func (f fileTamperEventDo) ReadDB() IFileTamperEventDo {
+	return f.Clauses(dbresolver.Read)
+}
+
+func (f fileTamperEventDo) WriteDB() IFileTamperEventDo {
+	return f.Clauses(dbresolver.Write)
+}
+
+func (f fileTamperEventDo) Session(config *gorm.Session) IFileTamperEventDo {
+	return f.withDO(f.DO.Session(config))
+}
+
+func (f fileTamperEventDo) Clauses(conds ...clause.Expression) IFileTamperEventDo {
+	return f.withDO(f.DO.Clauses(conds...))
+}
+
+func (f fileTamperEventDo) Returning(value interface{}, columns ...string) IFileTamperEventDo {
+	return f.withDO(f.DO.Returning(value, columns...))
+}
+
+func (f fileTamperEventDo) Not(conds ...gen.Condition) IFileTamperEventDo {
+	return f.withDO(f.DO.Not(conds...))
+}
+
+func (f fileTamperEventDo) Or(conds ...gen.Condition) IFileTamperEventDo {
+	return f.withDO(f.DO.Or(conds...))
+}
+
+func (f fileTamperEventDo) Select(conds ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Select(conds...))
+}
+
+func (f fileTamperEventDo) Where(conds ...gen.Condition) IFileTamperEventDo {
+	return f.withDO(f.DO.Where(conds...))
+}
+
+func (f fileTamperEventDo) Order(conds ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Order(conds...))
+}
+
+func (f fileTamperEventDo) Distinct(cols ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Distinct(cols...))
+}
+
+func (f fileTamperEventDo) Omit(cols ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Omit(cols...))
+}
+
+func (f fileTamperEventDo) Join(table schema.Tabler, on ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Join(table, on...))
+}
+
+func (f fileTamperEventDo) LeftJoin(table schema.Tabler, on ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.LeftJoin(table, on...))
+}
+
+func (f fileTamperEventDo) RightJoin(table schema.Tabler, on ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.RightJoin(table, on...))
+}
+
+func (f fileTamperEventDo) Group(cols ...field.Expr) IFileTamperEventDo {
+	return f.withDO(f.DO.Group(cols...))
+}
+
+func (f fileTamperEventDo) Having(conds ...gen.Condition) IFileTamperEventDo {
+	return f.withDO(f.DO.Having(conds...))
+}
+
+func (f fileTamperEventDo) Limit(limit int) IFileTamperEventDo {
+	return f.withDO(f.DO.Limit(limit))
+}
+
+func (f fileTamperEventDo) Offset(offset int) IFileTamperEventDo {
+	return f.withDO(f.DO.Offset(offset))
+}
+
+func (f fileTamperEventDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IFileTamperEventDo {
+	return f.withDO(f.DO.Scopes(funcs...))
+}
+
+func (f fileTamperEventDo) Unscoped() IFileTamperEventDo {
+	return f.withDO(f.DO.Unscoped())
+}
+
+func (f fileTamperEventDo) Create(values ...*table.FileTamperEvent) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return f.DO.Create(values)
+}
+
+func (f fileTamperEventDo) CreateInBatches(values []*table.FileTamperEvent, batchSize int) error {
+	return f.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (f fileTamperEventDo) Save(values ...*table.FileTamperEvent) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return f.DO.Save(values)
+}
+
+func (f fileTamperEventDo) First() (*table.FileTamperEvent, error) {
+	if result, err := f.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FileTamperEvent), nil
+	}
+}
+
+func (f fileTamperEventDo) Take() (*table.FileTamperEvent, error) {
+	if result, err := f.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FileTamperEvent), nil
+	}
+}
+
+func (f fileTamperEventDo) Last() (*table.FileTamperEvent, error) {
+	if result, err := f.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FileTamperEvent), nil
+	}
+}
+
+func (f fileTamperEventDo) Find() ([]*table.FileTamperEvent, error) {
+	result, err := f.DO.Find()
+	return result.([]*table.FileTamperEvent), err
+}
+
+func (f fileTamperEventDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.FileTamperEvent, err error) {
+	buf := make([]*table.FileTamperEvent, 0, batchSize)
+	err = f.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (f fileTamperEventDo) FindInBatches(result *[]*table.FileTamperEvent, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return f.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (f fileTamperEventDo) Attrs(attrs ...field.AssignExpr) IFileTamperEventDo {
+	return f.withDO(f.DO.Attrs(attrs...))
+}
+
+func (f fileTamperEventDo) Assign(attrs ...field.AssignExpr) IFileTamperEventDo {
+	return f.withDO(f.DO.Assign(attrs...))
+}
+
+func (f fileTamperEventDo) Joins(fields ...field.RelationField) IFileTamperEventDo {
+	for _, _f := range fields {
+		f = *f.withDO(f.DO.Joins(_f))
+	}
+	return &f
+}
+
+func (f fileTamperEventDo) Preload(fields ...field.RelationField) IFileTamperEventDo {
+	for _, _f := range fields {
+		f = *f.withDO(f.DO.Preload(_f))
+	}
+	return &f
+}
+
+func (f fileTamperEventDo) FirstOrInit() (*table.FileTamperEvent, error) {
+	if result, err := f.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FileTamperEvent), nil
+	}
+}
+
+func (f fileTamperEventDo) FirstOrCreate() (*table.FileTamperEvent, error) {
+	if result, err := f.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FileTamperEvent), nil
+	}
+}
+
+func (f fileTamperEventDo) FindByPage(offset int, limit int) (result []*table.FileTamperEvent, count int64, err error) {
+	result, err = f.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = f.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (f fileTamperEventDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = f.Count()
+	if err != nil {
+		return
+	}
+
+	err = f.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (f fileTamperEventDo) Scan(result interface{}) (err error) {
+	return f.DO.Scan(result)
+}
+
+func (f fileTamperEventDo) Delete(models ...*table.FileTamperEvent) (result gen.ResultInfo, err error) {
+	return f.DO.Delete(models)
+}
+
+func (f *fileTamperEventDo) withDO(do gen.Dao) *fileTamperEventDo {
+	f.DO = *do.(*gen.DO)
+	return f
+}
@@ -0,0 +1,426 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newFullTextDoc(db *gorm.DB, opts ...gen.DOOption) fullTextDoc {
+	_fullTextDoc := fullTextDoc{}
+
+	_fullTextDoc.fullTextDocDo.UseDB(db, opts...)
+	_fullTextDoc.fullTextDocDo.UseModel(&table.FullTextDoc{})
+
+	tableName := _fullTextDoc.fullTextDocDo.TableName()
+	_fullTextDoc.ALL = field.NewAsterisk(tableName)
+	_fullTextDoc.ID = field.NewUint32(tableName, "id")
+	_fullTextDoc.ResType = field.NewString(tableName, "res_type")
+	_fullTextDoc.ResID = field.NewUint32(tableName, "res_id")
+	_fullTextDoc.Name = field.NewString(tableName, "name")
+	_fullTextDoc.Memo = field.NewString(tableName, "memo")
+	_fullTextDoc.Content = field.NewString(tableName, "content")
+	_fullTextDoc.Signature = field.NewString(tableName, "signature")
+	_fullTextDoc.IndexedAt = field.NewTime(tableName, "indexed_at")
+	_fullTextDoc.BizID = field.NewUint32(tableName, "biz_id")
+	_fullTextDoc.AppID = field.NewUint32(tableName, "app_id")
+
+	_fullTextDoc.fillFieldMap()
+
+	return _fullTextDoc
+}
+
+type fullTextDoc struct {
+	fullTextDocDo fullTextDocDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	ResType   field.String
+	ResID     field.Uint32
+	Name      field.String
+	Memo      field.String
+	Content   field.String
+	Signature field.String
+	IndexedAt field.Time
+	BizID     field.Uint32
+	AppID     field.Uint32
+
+	fieldMap map[string]field.Expr
+}
+
+func (f fullTextDoc) Table(newTableName string) *fullTextDoc {
+	f.fullTextDocDo.UseTable(newTableName)
+	return f.updateTableName(newTableName)
+}
+
+func (f fullTextDoc) As(alias string) *fullTextDoc {
+	f.fullTextDocDo.DO = *(f.fullTextDocDo.As(alias).(*gen.DO))
+	return f.updateTableName(alias)
+}
+
+func (f *fullTextDoc) updateTableName(table string) *fullTextDoc {
+	f.ALL = field.NewAsterisk(table)
+	f.ID = field.NewUint32(table, "id")
+	f.ResType = field.NewString(table, "res_type")
+	f.ResID = field.NewUint32(table, "res_id")
+	f.Name = field.NewString(table, "name")
+	f.Memo = field.NewString(table, "memo")
+	f.Content = field.NewString(table, "content")
+	f.Signature = field.NewString(table, "signature")
+	f.IndexedAt = field.NewTime(table, "indexed_at")
+	f.BizID = field.NewUint32(table, "biz_id")
+	f.AppID = field.NewUint32(table, "app_id")
+
+	f.fillFieldMap()
+
+	return f
+}
+
+func (f *fullTextDoc) WithContext(ctx context.Context) IFullTextDocDo {
+	return f.fullTextDocDo.WithContext(ctx)
+}
+
+func (f fullTextDoc) TableName() string { return f.fullTextDocDo.TableName() }
+
+func (f fullTextDoc) Alias() string { return f.fullTextDocDo.Alias() }
+
+func (f fullTextDoc) Columns(cols ...field.Expr) gen.Columns { return f.fullTextDocDo.Columns(cols...) }
+
+func (f *fullTextDoc) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := f.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (f *fullTextDoc) fillFieldMap() {
+	f.fieldMap = make(map[string]field.Expr, 10)
+	f.fieldMap["id"] = f.ID
+	f.fieldMap["res_type"] = f.ResType
+	f.fieldMap["res_id"] = f.ResID
+	f.fieldMap["name"] = f.Name
+	f.fieldMap["memo"] = f.Memo
+	f.fieldMap["content"] = f.Content
+	f.fieldMap["signature"] = f.Signature
+	f.fieldMap["indexed_at"] = f.IndexedAt
+	f.fieldMap["biz_id"] = f.BizID
+	f.fieldMap["app_id"] = f.AppID
+}
+
+func (f fullTextDoc) clone(db *gorm.DB) fullTextDoc {
+	f.fullTextDocDo.ReplaceConnPool(db.Statement.ConnPool)
+	return f
+}
+
+func (f fullTextDoc) replaceDB(db *gorm.DB) fullTextDoc {
+	f.fullTextDocDo.ReplaceDB(db)
+	return f
+}
+
+type fullTextDocDo struct{ gen.DO }
+
+type IFullTextDocDo interface {
+	gen.SubQuery
+	Debug() IFullTextDocDo
+	WithContext(ctx context.Context) IFullTextDocDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IFullTextDocDo
+	WriteDB() IFullTextDocDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IFullTextDocDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IFullTextDocDo
+	Not(conds ...gen.Condition) IFullTextDocDo
+	Or(conds ...gen.Condition) IFullTextDocDo
+	Select(conds ...field.Expr) IFullTextDocDo
+	Where(conds ...gen.Condition) IFullTextDocDo
+	Order(conds ...field.Expr) IFullTextDocDo
+	Distinct(cols ...field.Expr) IFullTextDocDo
+	Omit(cols ...field.Expr) IFullTextDocDo
+	Join(table schema.Tabler, on ...field.Expr) IFullTextDocDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IFullTextDocDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IFullTextDocDo
+	Group(cols ...field.Expr) IFullTextDocDo
+	Having(conds ...gen.Condition) IFullTextDocDo
+	Limit(limit int) IFullTextDocDo
+	Offset(offset int) IFullTextDocDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IFullTextDocDo
+	Unscoped() IFullTextDocDo
+	Create(values ...*table.FullTextDoc) error
+	CreateInBatches(values []*table.FullTextDoc, batchSize int) error
+	Save(values ...*table.FullTextDoc) error
+	First() (*table.FullTextDoc, error)
+	Take() (*table.FullTextDoc, error)
+	Last() (*table.FullTextDoc, error)
+	Find() ([]*table.FullTextDoc, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.FullTextDoc, err error)
+	FindInBatches(result *[]*table.FullTextDoc, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.FullTextDoc) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IFullTextDocDo
+	Assign(attrs ...field.AssignExpr) IFullTextDocDo
+	Joins(fields ...field.RelationField) IFullTextDocDo
+	Preload(fields ...field.RelationField) IFullTextDocDo
+	FirstOrInit() (*table.FullTextDoc, error)
+	FirstOrCreate() (*table.FullTextDoc, error)
+	FindByPage(offset int, limit int) (result []*table.FullTextDoc, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IFullTextDocDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (f fullTextDocDo) Debug() IFullTextDocDo {
+	return f.withDO(f.DO.Debug())
+}
+
+func (f fullTextDocDo) WithContext(ctx context.Context) IFullTextDocDo {
+	return f.withDO(f.DO.WithContext(ctx))
+}
+
+func (f fullTextDocDo) ReadDB() IFullTextDocDo {
+	return f.Clauses(dbresolver.Read)
+}
+
+func (f fullTextDocDo) WriteDB() IFullTextDocDo {
+	return f.Clauses(dbresolver.Write)
+}
+
+func (f fullTextDocDo) Session(config *gorm.Session) IFullTextDocDo {
+	return f.withDO(f.DO.Session(config))
+}
+
+func (f fullTextDocDo) Clauses(conds ...clause.Expression) IFullTextDocDo {
+	return f.withDO(f.DO.Clauses(conds...))
+}
+
+func (f fullTextDocDo) Returning(value interface{}, columns ...string) IFullTextDocDo {
+	return f.withDO(f.DO.Returning(value, columns...))
+}
+
+func (f fullTextDocDo) Not(conds ...gen.Condition) IFullTextDocDo {
+	return f.withDO(f.DO.Not(conds...))
+}
+
+func (f fullTextDocDo) Or(conds ...gen.Condition) IFullTextDocDo {
+	return f.withDO(f.DO.Or(conds...))
+}
+
+func (f fullTextDocDo) Select(conds ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Select(conds...))
+}
+
+func (f fullTextDocDo) Where(conds ...gen.Condition) IFullTextDocDo {
+	return f.withDO(f.DO.Where(conds...))
+}
+
+func (f fullTextDocDo) Order(conds ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Order(conds...))
+}
+
+func (f fullTextDocDo) Distinct(cols ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Distinct(cols...))
+}
+
+func (f fullTextDocDo) Omit(cols ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Omit(cols...))
+}
+
+func (f fullTextDocDo) Join(table schema.Tabler, on ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Join(table, on...))
+}
+
+func (f fullTextDocDo) LeftJoin(table schema.Tabler, on ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.LeftJoin(table, on...))
+}
+
+func (f fullTextDocDo) RightJoin(table schema.Tabler, on ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.RightJoin(table, on...))
+}
+
+func (f fullTextDocDo) Group(cols ...field.Expr) IFullTextDocDo {
+	return f.withDO(f.DO.Group(cols...))
+}
+
+func (f fullTextDocDo) Having(conds ...gen.Condition) IFullTextDocDo {
+	return f.withDO(f.DO.Having(conds...))
+}
+
+func (f fullTextDocDo) Limit(limit int) IFullTextDocDo {
+	return f.withDO(f.DO.Limit(limit))
+}
+
+func (f fullTextDocDo) Offset(offset int) IFullTextDocDo {
+	return f.withDO(f.DO.Offset(offset))
+}
+
+func (f fullTextDocDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IFullTextDocDo {
+	return f.withDO(f.DO.Scopes(funcs...))
+}
+
+func (f fullTextDocDo) Unscoped() IFullTextDocDo {
+	return f.withDO(f.DO.Unscoped())
+}
+
+func (f fullTextDocDo) Create(values ...*table.FullTextDoc) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return f.DO.Create(values)
+}
+
+func (f fullTextDocDo) CreateInBatches(values []*table.FullTextDoc, batchSize int) error {
+	return f.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (f fullTextDocDo) Save(values ...*table.FullTextDoc) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return f.DO.Save(values)
+}
+
+func (f fullTextDocDo) First() (*table.FullTextDoc, error) {
+	if result, err := f.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FullTextDoc), nil
+	}
+}
+
+func (f fullTextDocDo) Take() (*table.FullTextDoc, error) {
+	if result, err := f.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FullTextDoc), nil
+	}
+}
+
+func (f fullTextDocDo) Last() (*table.FullTextDoc, error) {
+	if result, err := f.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FullTextDoc), nil
+	}
+}
+
+func (f fullTextDocDo) Find() ([]*table.FullTextDoc, error) {
+	result, err := f.DO.Find()
+	return result.([]*table.FullTextDoc), err
+}
+
+func (f fullTextDocDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.FullTextDoc, err error) {
+	buf := make([]*table.FullTextDoc, 0, batchSize)
+	err = f.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (f fullTextDocDo) FindInBatches(result *[]*table.FullTextDoc, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return f.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (f fullTextDocDo) Attrs(attrs ...field.AssignExpr) IFullTextDocDo {
+	return f.withDO(f.DO.Attrs(attrs...))
+}
+
+func (f fullTextDocDo) Assign(attrs ...field.AssignExpr) IFullTextDocDo {
+	return f.withDO(f.DO.Assign(attrs...))
+}
+
+func (f fullTextDocDo) Joins(fields ...field.RelationField) IFullTextDocDo {
+	for _, _f := range fields {
+		f = *f.withDO(f.DO.Joins(_f))
+	}
+	return &f
+}
+
+func (f fullTextDocDo) Preload(fields ...field.RelationField) IFullTextDocDo {
+	for _, _f := range fields {
+		f = *f.withDO(f.DO.Preload(_f))
+	}
+	return &f
+}
+
+func (f fullTextDocDo) FirstOrInit() (*table.FullTextDoc, error) {
+	if result, err := f.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FullTextDoc), nil
+	}
+}
+
+func (f fullTextDocDo) FirstOrCreate() (*table.FullTextDoc, error) {
+	if result, err := f.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.FullTextDoc), nil
+	}
+}
+
+func (f fullTextDocDo) FindByPage(offset int, limit int) (result []*table.FullTextDoc, count int64, err error) {
+	result, err = f.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = f.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (f fullTextDocDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = f.Count()
+	if err != nil {
+		return
+	}
+
+	err = f.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (f fullTextDocDo) Scan(result interface{}) (err error) {
+	return f.DO.Scan(result)
+}
+
+func (f fullTextDocDo) Delete(models ...*table.FullTextDoc) (result gen.ResultInfo, err error) {
+	return f.DO.Delete(models)
+}
+
+func (f *fullTextDocDo) withDO(do gen.Dao) *fullTextDocDo {
+	f.DO = *do.(*gen.DO)
+	return f
+}
@@ -17,27 +17,55 @@ import (
 
 var (
 	Q                           = new(Query)
+	AlertRule                   *alertRule
 	App                         *app
+	AppConsumptionStat          *appConsumptionStat
+	AppRoleBinding              *appRoleBinding
+	AppSnapshot                 *appSnapshot
 	AppTemplateBinding          *appTemplateBinding
 	AppTemplateVariable         *appTemplateVariable
 	ArchivedApp                 *archivedApp
 	Audit                       *audit
+	BizUsage                    *bizUsage
+	Certificate                 *certificate
 	Client                      *client
 	ClientEvent                 *clientEvent
+	ClientQuarantine            *clientQuarantine
 	ClientQuery                 *clientQuery
 	Commit                      *commit
+	CompliancePolicy            *compliancePolicy
 	Config                      *config
 	ConfigItem                  *configItem
+	ConfigReference             *configReference
+	ConfigShare                 *configShare
+	ConfigShareRef              *configShareRef
 	Content                     *content
 	Credential                  *credential
 	CredentialScope             *credentialScope
+	Draft                       *draft
+	EmergencyPublish            *emergencyPublish
+	EnvAppBinding               *envAppBinding
+	Environment                 *environment
 	Event                       *event
+	Experiment                  *experiment
+	ExperimentExposure          *experimentExposure
+	FileTamperEvent             *fileTamperEvent
+	FullTextDoc                 *fullTextDoc
 	Group                       *group
 	GroupAppBind                *groupAppBind
 	Hook                        *hook
+	HookExecResult              *hookExecResult
 	HookRevision                *hookRevision
+	HookTemplate                *hookTemplate
+	HookTemplateRef             *hookTemplateRef
 	IDGenerator                 *iDGenerator
+	IdempotencyRecord           *idempotencyRecord
 	Kv                          *kv
+	KvOverride                  *kvOverride
+	MaskingProfile              *maskingProfile
+	NotificationChannel         *notificationChannel
+	Operation                   *operation
+	RecycleBin                  *recycleBin
 	Release                     *release
 	ReleasedAppTemplate         *releasedAppTemplate
 	ReleasedAppTemplateVariable *releasedAppTemplateVariable
@@ -45,7 +73,11 @@ var (
 	ReleasedGroup               *releasedGroup
 	ReleasedHook                *releasedHook
 	ReleasedKv                  *releasedKv
+	ReleasedKvOverride          *releasedKvOverride
 	ResourceLock                *resourceLock
+	SecretScanSetting           *secretScanSetting
+	ServiceAccount              *serviceAccount
+	StaleResource               *staleResource
 	Strategy                    *strategy
 	Template                    *template
 	TemplateRevision            *templateRevision
@@ -56,27 +88,55 @@ var (
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	AlertRule = &Q.AlertRule
 	App = &Q.App
+	AppConsumptionStat = &Q.AppConsumptionStat
+	AppRoleBinding = &Q.AppRoleBinding
+	AppSnapshot = &Q.AppSnapshot
 	AppTemplateBinding = &Q.AppTemplateBinding
 	AppTemplateVariable = &Q.AppTemplateVariable
 	ArchivedApp = &Q.ArchivedApp
 	Audit = &Q.Audit
+	BizUsage = &Q.BizUsage
+	Certificate = &Q.Certificate
 	Client = &Q.Client
 	ClientEvent = &Q.ClientEvent
+	ClientQuarantine = &Q.ClientQuarantine
 	ClientQuery = &Q.ClientQuery
 	Commit = &Q.Commit
+	CompliancePolicy = &Q.CompliancePolicy
 	Config = &Q.Config
 	ConfigItem = &Q.ConfigItem
+	ConfigReference = &Q.ConfigReference
+	ConfigShare = &Q.ConfigShare
+	ConfigShareRef = &Q.ConfigShareRef
 	Content = &Q.Content
 	Credential = &Q.Credential
 	CredentialScope = &Q.CredentialScope
+	Draft = &Q.Draft
+	EmergencyPublish = &Q.EmergencyPublish
+	EnvAppBinding = &Q.EnvAppBinding
+	Environment = &Q.Environment
 	Event = &Q.Event
+	Experiment = &Q.Experiment
+	ExperimentExposure = &Q.ExperimentExposure
+	FileTamperEvent = &Q.FileTamperEvent
+	FullTextDoc = &Q.FullTextDoc
 	Group = &Q.Group
 	GroupAppBind = &Q.GroupAppBind
 	Hook = &Q.Hook
+	HookExecResult = &Q.HookExecResult
 	HookRevision = &Q.HookRevision
+	HookTemplate = &Q.HookTemplate
+	HookTemplateRef = &Q.HookTemplateRef
 	IDGenerator = &Q.IDGenerator
+	IdempotencyRecord = &Q.IdempotencyRecord
 	Kv = &Q.Kv
+	KvOverride = &Q.KvOverride
+	MaskingProfile = &Q.MaskingProfile
+	NotificationChannel = &Q.NotificationChannel
+	Operation = &Q.Operation
+	RecycleBin = &Q.RecycleBin
 	Release = &Q.Release
 	ReleasedAppTemplate = &Q.ReleasedAppTemplate
 	ReleasedAppTemplateVariable = &Q.ReleasedAppTemplateVariable
@@ -84,7 +144,11 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	ReleasedGroup = &Q.ReleasedGroup
 	ReleasedHook = &Q.ReleasedHook
 	ReleasedKv = &Q.ReleasedKv
+	ReleasedKvOverride = &Q.ReleasedKvOverride
 	ResourceLock = &Q.ResourceLock
+	SecretScanSetting = &Q.SecretScanSetting
+	ServiceAccount = &Q.ServiceAccount
+	StaleResource = &Q.StaleResource
 	Strategy = &Q.Strategy
 	Template = &Q.Template
 	TemplateRevision = &Q.TemplateRevision
@@ -96,27 +160,55 @@ func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
 		db:                          db,
+		AlertRule:                   newAlertRule(db, opts...),
 		App:                         newApp(db, opts...),
+		AppConsumptionStat:          newAppConsumptionStat(db, opts...),
+		AppRoleBinding:              newAppRoleBinding(db, opts...),
+		AppSnapshot:                 newAppSnapshot(db, opts...),
 		AppTemplateBinding:          newAppTemplateBinding(db, opts...),
 		AppTemplateVariable:         newAppTemplateVariable(db, opts...),
 		ArchivedApp:                 newArchivedApp(db, opts...),
 		Audit:                       newAudit(db, opts...),
+		BizUsage:                    newBizUsage(db, opts...),
+		Certificate:                 newCertificate(db, opts...),
 		Client:                      newClient(db, opts...),
 		ClientEvent:                 newClientEvent(db, opts...),
+		ClientQuarantine:            newClientQuarantine(db, opts...),
 		ClientQuery:                 newClientQuery(db, opts...),
 		Commit:                      newCommit(db, opts...),
+		CompliancePolicy:            newCompliancePolicy(db, opts...),
 		Config:                      newConfig(db, opts...),
 		ConfigItem:                  newConfigItem(db, opts...),
+		ConfigReference:             newConfigReference(db, opts...),
+		ConfigShare:                 newConfigShare(db, opts...),
+		ConfigShareRef:              newConfigShareRef(db, opts...),
 		Content:                     newContent(db, opts...),
 		Credential:                  newCredential(db, opts...),
 		CredentialScope:             newCredentialScope(db, opts...),
+		Draft:                       newDraft(db, opts...),
+		EmergencyPublish:            newEmergencyPublish(db, opts...),
+		EnvAppBinding:               newEnvAppBinding(db, opts...),
+		Environment:                 newEnvironment(db, opts...),
 		Event:                       newEvent(db, opts...),
+		Experiment:                  newExperiment(db, opts...),
+		ExperimentExposure:          newExperimentExposure(db, opts...),
+		FileTamperEvent:             newFileTamperEvent(db, opts...),
+		FullTextDoc:                 newFullTextDoc(db, opts...),
 		Group:                       newGroup(db, opts...),
 		GroupAppBind:                newGroupAppBind(db, opts...),
 		Hook:                        newHook(db, opts...),
+		HookExecResult:              newHookExecResult(db, opts...),
 		HookRevision:                newHookRevision(db, opts...),
+		HookTemplate:                newHookTemplate(db, opts...),
+		HookTemplateRef:             newHookTemplateRef(db, opts...),
 		IDGenerator:                 newIDGenerator(db, opts...),
+		IdempotencyRecord:           newIdempotencyRecord(db, opts...),
 		Kv:                          newKv(db, opts...),
+		KvOverride:                  newKvOverride(db, opts...),
+		MaskingProfile:              newMaskingProfile(db, opts...),
+		NotificationChannel:         newNotificationChannel(db, opts...),
+		Operation:                   newOperation(db, opts...),
+		RecycleBin:                  newRecycleBin(db, opts...),
 		Release:                     newRelease(db, opts...),
 		ReleasedAppTemplate:         newReleasedAppTemplate(db, opts...),
 		ReleasedAppTemplateVariable: newReleasedAppTemplateVariable(db, opts...),
@@ -124,7 +216,11 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 		ReleasedGroup:               newReleasedGroup(db, opts...),
 		ReleasedHook:                newReleasedHook(db, opts...),
 		ReleasedKv:                  newReleasedKv(db, opts...),
+		ReleasedKvOverride:          newReleasedKvOverride(db, opts...),
 		ResourceLock:                newResourceLock(db, opts...),
+		SecretScanSetting:           newSecretScanSetting(db, opts...),
+		ServiceAccount:              newServiceAccount(db, opts...),
+		StaleResource:               newStaleResource(db, opts...),
 		Strategy:                    newStrategy(db, opts...),
 		Template:                    newTemplate(db, opts...),
 		TemplateRevision:            newTemplateRevision(db, opts...),
@@ -137,27 +233,55 @@ func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 type Query struct {
 	db *gorm.DB
 
+	AlertRule                   alertRule
 	App                         app
+	AppConsumptionStat          appConsumptionStat
+	AppRoleBinding              appRoleBinding
+	AppSnapshot                 appSnapshot
 	AppTemplateBinding          appTemplateBinding
 	AppTemplateVariable         appTemplateVariable
 	ArchivedApp                 archivedApp
 	Audit                       audit
+	BizUsage                    bizUsage
+	Certificate                 certificate
 	Client                      client
 	ClientEvent                 clientEvent
+	ClientQuarantine            clientQuarantine
 	ClientQuery                 clientQuery
 	Commit                      commit
+	CompliancePolicy            compliancePolicy
 	Config                      config
 	ConfigItem                  configItem
+	ConfigReference             configReference
+	ConfigShare                 configShare
+	ConfigShareRef              configShareRef
 	Content                     content
 	Credential                  credential
 	CredentialScope             credentialScope
+	Draft                       draft
+	EmergencyPublish            emergencyPublish
+	EnvAppBinding               envAppBinding
+	Environment                 environment
 	Event                       event
+	Experiment                  experiment
+	ExperimentExposure          experimentExposure
+	FileTamperEvent             fileTamperEvent
+	FullTextDoc                 fullTextDoc
 	Group                       group
 	GroupAppBind                groupAppBind
 	Hook                        hook
+	HookExecResult              hookExecResult
 	HookRevision                hookRevision
+	HookTemplate                hookTemplate
+	HookTemplateRef             hookTemplateRef
 	IDGenerator                 iDGenerator
+	IdempotencyRecord           idempotencyRecord
 	Kv                          kv
+	KvOverride                  kvOverride
+	MaskingProfile              maskingProfile
+	NotificationChannel         notificationChannel
+	Operation                   operation
+	RecycleBin                  recycleBin
 	Release                     release
 	ReleasedAppTemplate         releasedAppTemplate
 	ReleasedAppTemplateVariable releasedAppTemplateVariable
@@ -165,7 +289,11 @@ type Query struct {
 	ReleasedGroup               releasedGroup
 	ReleasedHook                releasedHook
 	ReleasedKv                  releasedKv
+	ReleasedKvOverride          releasedKvOverride
 	ResourceLock                resourceLock
+	SecretScanSetting           secretScanSetting
+	ServiceAccount              serviceAccount
+	StaleResource               staleResource
 	Strategy                    strategy
 	Template                    template
 	TemplateRevision            templateRevision
@@ -179,27 +307,55 @@ func (q *Query) Available() bool { return q.db != nil }
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
 		db:                          db,
+		AlertRule:                   q.AlertRule.clone(db),
 		App:                         q.App.clone(db),
+		AppConsumptionStat:          q.AppConsumptionStat.clone(db),
+		AppRoleBinding:              q.AppRoleBinding.clone(db),
+		AppSnapshot:                 q.AppSnapshot.clone(db),
 		AppTemplateBinding:          q.AppTemplateBinding.clone(db),
 		AppTemplateVariable:         q.AppTemplateVariable.clone(db),
 		ArchivedApp:                 q.ArchivedApp.clone(db),
 		Audit:                       q.Audit.clone(db),
+		BizUsage:                    q.BizUsage.clone(db),
+		Certificate:                 q.Certificate.clone(db),
 		Client:                      q.Client.clone(db),
 		ClientEvent:                 q.ClientEvent.clone(db),
+		ClientQuarantine:            q.ClientQuarantine.clone(db),
 		ClientQuery:                 q.ClientQuery.clone(db),
 		Commit:                      q.Commit.clone(db),
+		CompliancePolicy:            q.CompliancePolicy.clone(db),
 		Config:                      q.Config.clone(db),
 		ConfigItem:                  q.ConfigItem.clone(db),
+		ConfigReference:             q.ConfigReference.clone(db),
+		ConfigShare:                 q.ConfigShare.clone(db),
+		ConfigShareRef:              q.ConfigShareRef.clone(db),
 		Content:                     q.Content.clone(db),
 		Credential:                  q.Credential.clone(db),
 		CredentialScope:             q.CredentialScope.clone(db),
+		Draft:                       q.Draft.clone(db),
+		EmergencyPublish:            q.EmergencyPublish.clone(db),
+		EnvAppBinding:               q.EnvAppBinding.clone(db),
+		Environment:                 q.Environment.clone(db),
 		Event:                       q.Event.clone(db),
+		Experiment:                  q.Experiment.clone(db),
+		ExperimentExposure:          q.ExperimentExposure.clone(db),
+		FileTamperEvent:             q.FileTamperEvent.clone(db),
+		FullTextDoc:                 q.FullTextDoc.clone(db),
 		Group:                       q.Group.clone(db),
 		GroupAppBind:                q.GroupAppBind.clone(db),
 		Hook:                        q.Hook.clone(db),
+		HookExecResult:              q.HookExecResult.clone(db),
 		HookRevision:                q.HookRevision.clone(db),
+		HookTemplate:                q.HookTemplate.clone(db),
+		HookTemplateRef:             q.HookTemplateRef.clone(db),
 		IDGenerator:                 q.IDGenerator.clone(db),
+		IdempotencyRecord:           q.IdempotencyRecord.clone(db),
 		Kv:                          q.Kv.clone(db),
+		KvOverride:                  q.KvOverride.clone(db),
+		MaskingProfile:              q.MaskingProfile.clone(db),
+		NotificationChannel:         q.NotificationChannel.clone(db),
+		Operation:                   q.Operation.clone(db),
+		RecycleBin:                  q.RecycleBin.clone(db),
 		Release:                     q.Release.clone(db),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.clone(db),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.clone(db),
@@ -207,7 +363,11 @@ func (q *Query) clone(db *gorm.DB) *Query {
 		ReleasedGroup:               q.ReleasedGroup.clone(db),
 		ReleasedHook:                q.ReleasedHook.clone(db),
 		ReleasedKv:                  q.ReleasedKv.clone(db),
+		ReleasedKvOverride:          q.ReleasedKvOverride.clone(db),
 		ResourceLock:                q.ResourceLock.clone(db),
+		SecretScanSetting:           q.SecretScanSetting.clone(db),
+		ServiceAccount:              q.ServiceAccount.clone(db),
+		StaleResource:               q.StaleResource.clone(db),
 		Strategy:                    q.Strategy.clone(db),
 		Template:                    q.Template.clone(db),
 		TemplateRevision:            q.TemplateRevision.clone(db),
@@ -228,27 +388,55 @@ func (q *Query) WriteDB() *Query {
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
 		db:                          db,
+		AlertRule:                   q.AlertRule.replaceDB(db),
 		App:                         q.App.replaceDB(db),
+		AppConsumptionStat:          q.AppConsumptionStat.replaceDB(db),
+		AppRoleBinding:              q.AppRoleBinding.replaceDB(db),
+		AppSnapshot:                 q.AppSnapshot.replaceDB(db),
 		AppTemplateBinding:          q.AppTemplateBinding.replaceDB(db),
 		AppTemplateVariable:         q.AppTemplateVariable.replaceDB(db),
 		ArchivedApp:                 q.ArchivedApp.replaceDB(db),
 		Audit:                       q.Audit.replaceDB(db),
+		BizUsage:                    q.BizUsage.replaceDB(db),
+		Certificate:                 q.Certificate.replaceDB(db),
 		Client:                      q.Client.replaceDB(db),
 		ClientEvent:                 q.ClientEvent.replaceDB(db),
+		ClientQuarantine:            q.ClientQuarantine.replaceDB(db),
 		ClientQuery:                 q.ClientQuery.replaceDB(db),
 		Commit:                      q.Commit.replaceDB(db),
+		CompliancePolicy:            q.CompliancePolicy.replaceDB(db),
 		Config:                      q.Config.replaceDB(db),
 		ConfigItem:                  q.ConfigItem.replaceDB(db),
+		ConfigReference:             q.ConfigReference.replaceDB(db),
+		ConfigShare:                 q.ConfigShare.replaceDB(db),
+		ConfigShareRef:              q.ConfigShareRef.replaceDB(db),
 		Content:                     q.Content.replaceDB(db),
 		Credential:                  q.Credential.replaceDB(db),
 		CredentialScope:             q.CredentialScope.replaceDB(db),
+		Draft:                       q.Draft.replaceDB(db),
+		EmergencyPublish:            q.EmergencyPublish.replaceDB(db),
+		EnvAppBinding:               q.EnvAppBinding.replaceDB(db),
+		Environment:                 q.Environment.replaceDB(db),
 		Event:                       q.Event.replaceDB(db),
+		Experiment:                  q.Experiment.replaceDB(db),
+		ExperimentExposure:          q.ExperimentExposure.replaceDB(db),
+		FileTamperEvent:             q.FileTamperEvent.replaceDB(db),
+		FullTextDoc:                 q.FullTextDoc.replaceDB(db),
 		Group:                       q.Group.replaceDB(db),
 		GroupAppBind:                q.GroupAppBind.replaceDB(db),
 		Hook:                        q.Hook.replaceDB(db),
+		HookExecResult:              q.HookExecResult.replaceDB(db),
 		HookRevision:                q.HookRevision.replaceDB(db),
+		HookTemplate:                q.HookTemplate.replaceDB(db),
+		HookTemplateRef:             q.HookTemplateRef.replaceDB(db),
 		IDGenerator:                 q.IDGenerator.replaceDB(db),
+		IdempotencyRecord:           q.IdempotencyRecord.replaceDB(db),
 		Kv:                          q.Kv.replaceDB(db),
+		KvOverride:                  q.KvOverride.replaceDB(db),
+		MaskingProfile:              q.MaskingProfile.replaceDB(db),
+		NotificationChannel:         q.NotificationChannel.replaceDB(db),
+		Operation:                   q.Operation.replaceDB(db),
+		RecycleBin:                  q.RecycleBin.replaceDB(db),
 		Release:                     q.Release.replaceDB(db),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.replaceDB(db),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.replaceDB(db),
@@ -256,7 +444,11 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 		ReleasedGroup:               q.ReleasedGroup.replaceDB(db),
 		ReleasedHook:                q.ReleasedHook.replaceDB(db),
 		ReleasedKv:                  q.ReleasedKv.replaceDB(db),
+		ReleasedKvOverride:          q.ReleasedKvOverride.replaceDB(db),
 		ResourceLock:                q.ResourceLock.replaceDB(db),
+		SecretScanSetting:           q.SecretScanSetting.replaceDB(db),
+		ServiceAccount:              q.ServiceAccount.replaceDB(db),
+		StaleResource:               q.StaleResource.replaceDB(db),
 		Strategy:                    q.Strategy.replaceDB(db),
 		Template:                    q.Template.replaceDB(db),
 		TemplateRevision:            q.TemplateRevision.replaceDB(db),
@@ -267,27 +459,55 @@ func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 }
 
 type queryCtx struct {
+	AlertRule                   IAlertRuleDo
 	App                         IAppDo
+	AppConsumptionStat          IAppConsumptionStatDo
+	AppRoleBinding              IAppRoleBindingDo
+	AppSnapshot                 IAppSnapshotDo
 	AppTemplateBinding          IAppTemplateBindingDo
 	AppTemplateVariable         IAppTemplateVariableDo
 	ArchivedApp                 IArchivedAppDo
 	Audit                       IAuditDo
+	BizUsage                    IBizUsageDo
+	Certificate                 ICertificateDo
 	Client                      IClientDo
 	ClientEvent                 IClientEventDo
+	ClientQuarantine            IClientQuarantineDo
 	ClientQuery                 IClientQueryDo
 	Commit                      ICommitDo
+	CompliancePolicy            ICompliancePolicyDo
 	Config                      IConfigDo
 	ConfigItem                  IConfigItemDo
+	ConfigReference             IConfigReferenceDo
+	ConfigShare                 IConfigShareDo
+	ConfigShareRef              IConfigShareRefDo
 	Content                     IContentDo
 	Credential                  ICredentialDo
 	CredentialScope             ICredentialScopeDo
+	Draft                       IDraftDo
+	EmergencyPublish            IEmergencyPublishDo
+	EnvAppBinding               IEnvAppBindingDo
+	Environment                 IEnvironmentDo
 	Event                       IEventDo
+	Experiment                  IExperimentDo
+	ExperimentExposure          IExperimentExposureDo
+	FileTamperEvent             IFileTamperEventDo
+	FullTextDoc                 IFullTextDocDo
 	Group                       IGroupDo
 	GroupAppBind                IGroupAppBindDo
 	Hook                        IHookDo
+	HookExecResult              IHookExecResultDo
 	HookRevision                IHookRevisionDo
+	HookTemplate                IHookTemplateDo
+	HookTemplateRef             IHookTemplateRefDo
 	IDGenerator                 IIDGeneratorDo
+	IdempotencyRecord           IIdempotencyRecordDo
 	Kv                          IKvDo
+	KvOverride                  IKvOverrideDo
+	MaskingProfile              IMaskingProfileDo
+	NotificationChannel         INotificationChannelDo
+	Operation                   IOperationDo
+	RecycleBin                  IRecycleBinDo
 	Release                     IReleaseDo
 	ReleasedAppTemplate         IReleasedAppTemplateDo
 	ReleasedAppTemplateVariable IReleasedAppTemplateVariableDo
@@ -295,7 +515,11 @@ type queryCtx struct {
 	ReleasedGroup               IReleasedGroupDo
 	ReleasedHook                IReleasedHookDo
 	ReleasedKv                  IReleasedKvDo
+	ReleasedKvOverride          IReleasedKvOverrideDo
 	ResourceLock                IResourceLockDo
+	SecretScanSetting           ISecretScanSettingDo
+	ServiceAccount              IServiceAccountDo
+	StaleResource               IStaleResourceDo
 	Strategy                    IStrategyDo
 	Template                    ITemplateDo
 	TemplateRevision            ITemplateRevisionDo
@@ -306,27 +530,55 @@ type queryCtx struct {
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
+		AlertRule:                   q.AlertRule.WithContext(ctx),
 		App:                         q.App.WithContext(ctx),
+		AppConsumptionStat:          q.AppConsumptionStat.WithContext(ctx),
+		AppRoleBinding:              q.AppRoleBinding.WithContext(ctx),
+		AppSnapshot:                 q.AppSnapshot.WithContext(ctx),
 		AppTemplateBinding:          q.AppTemplateBinding.WithContext(ctx),
 		AppTemplateVariable:         q.AppTemplateVariable.WithContext(ctx),
 		ArchivedApp:                 q.ArchivedApp.WithContext(ctx),
 		Audit:                       q.Audit.WithContext(ctx),
+		BizUsage:                    q.BizUsage.WithContext(ctx),
+		Certificate:                 q.Certificate.WithContext(ctx),
 		Client:                      q.Client.WithContext(ctx),
 		ClientEvent:                 q.ClientEvent.WithContext(ctx),
+		ClientQuarantine:            q.ClientQuarantine.WithContext(ctx),
 		ClientQuery:                 q.ClientQuery.WithContext(ctx),
 		Commit:                      q.Commit.WithContext(ctx),
+		CompliancePolicy:            q.CompliancePolicy.WithContext(ctx),
 		Config:                      q.Config.WithContext(ctx),
 		ConfigItem:                  q.ConfigItem.WithContext(ctx),
+		ConfigReference:             q.ConfigReference.WithContext(ctx),
+		ConfigShare:                 q.ConfigShare.WithContext(ctx),
+		ConfigShareRef:              q.ConfigShareRef.WithContext(ctx),
 		Content:                     q.Content.WithContext(ctx),
 		Credential:                  q.Credential.WithContext(ctx),
 		CredentialScope:             q.CredentialScope.WithContext(ctx),
+		Draft:                       q.Draft.WithContext(ctx),
+		EmergencyPublish:            q.EmergencyPublish.WithContext(ctx),
+		EnvAppBinding:               q.EnvAppBinding.WithContext(ctx),
+		Environment:                 q.Environment.WithContext(ctx),
 		Event:                       q.Event.WithContext(ctx),
+		Experiment:                  q.Experiment.WithContext(ctx),
+		ExperimentExposure:          q.ExperimentExposure.WithContext(ctx),
+		FileTamperEvent:             q.FileTamperEvent.WithContext(ctx),
+		FullTextDoc:                 q.FullTextDoc.WithContext(ctx),
 		Group:                       q.Group.WithContext(ctx),
 		GroupAppBind:                q.GroupAppBind.WithContext(ctx),
 		Hook:                        q.Hook.WithContext(ctx),
+		HookExecResult:              q.HookExecResult.WithContext(ctx),
 		HookRevision:                q.HookRevision.WithContext(ctx),
+		HookTemplate:                q.HookTemplate.WithContext(ctx),
+		HookTemplateRef:             q.HookTemplateRef.WithContext(ctx),
 		IDGenerator:                 q.IDGenerator.WithContext(ctx),
+		IdempotencyRecord:           q.IdempotencyRecord.WithContext(ctx),
 		Kv:                          q.Kv.WithContext(ctx),
+		KvOverride:                  q.KvOverride.WithContext(ctx),
+		MaskingProfile:              q.MaskingProfile.WithContext(ctx),
+		NotificationChannel:         q.NotificationChannel.WithContext(ctx),
+		Operation:                   q.Operation.WithContext(ctx),
+		RecycleBin:                  q.RecycleBin.WithContext(ctx),
 		Release:                     q.Release.WithContext(ctx),
 		ReleasedAppTemplate:         q.ReleasedAppTemplate.WithContext(ctx),
 		ReleasedAppTemplateVariable: q.ReleasedAppTemplateVariable.WithContext(ctx),
@@ -334,7 +586,11 @@ func (q *Query) WithContext(ctx context.Context) *queryCtx {
 		ReleasedGroup:               q.ReleasedGroup.WithContext(ctx),
 		ReleasedHook:                q.ReleasedHook.WithContext(ctx),
 		ReleasedKv:                  q.ReleasedKv.WithContext(ctx),
+		ReleasedKvOverride:          q.ReleasedKvOverride.WithContext(ctx),
 		ResourceLock:                q.ResourceLock.WithContext(ctx),
+		SecretScanSetting:           q.SecretScanSetting.WithContext(ctx),
+		ServiceAccount:              q.ServiceAccount.WithContext(ctx),
+		StaleResource:               q.StaleResource.WithContext(ctx),
 		Strategy:                    q.Strategy.WithContext(ctx),
 		Template:                    q.Template.WithContext(ctx),
 		TemplateRevision:            q.TemplateRevision.WithContext(ctx),
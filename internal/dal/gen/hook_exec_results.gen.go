@@ -0,0 +1,448 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newHookExecResult(db *gorm.DB, opts ...gen.DOOption) hookExecResult {
+	_hookExecResult := hookExecResult{}
+
+	_hookExecResult.hookExecResultDo.UseDB(db, opts...)
+	_hookExecResult.hookExecResultDo.UseModel(&table.HookExecResult{})
+
+	tableName := _hookExecResult.hookExecResultDo.TableName()
+	_hookExecResult.ALL = field.NewAsterisk(tableName)
+	_hookExecResult.ID = field.NewUint32(tableName, "id")
+	_hookExecResult.BizID = field.NewUint32(tableName, "biz_id")
+	_hookExecResult.AppID = field.NewUint32(tableName, "app_id")
+	_hookExecResult.ReleaseID = field.NewUint32(tableName, "release_id")
+	_hookExecResult.UID = field.NewString(tableName, "uid")
+	_hookExecResult.HookType = field.NewString(tableName, "hook_type")
+	_hookExecResult.HookName = field.NewString(tableName, "hook_name")
+	_hookExecResult.ExitCode = field.NewInt32(tableName, "exit_code")
+	_hookExecResult.DurationMs = field.NewUint32(tableName, "duration_ms")
+	_hookExecResult.Stdout = field.NewString(tableName, "stdout")
+	_hookExecResult.Stderr = field.NewString(tableName, "stderr")
+	_hookExecResult.Ip = field.NewString(tableName, "ip")
+	_hookExecResult.ClientType = field.NewString(tableName, "client_type")
+	_hookExecResult.ExecutedAt = field.NewTime(tableName, "executed_at")
+	_hookExecResult.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_hookExecResult.fillFieldMap()
+
+	return _hookExecResult
+}
+
+type hookExecResult struct {
+	hookExecResultDo hookExecResultDo
+
+	ALL        field.Asterisk
+	ID         field.Uint32
+	BizID      field.Uint32
+	AppID      field.Uint32
+	ReleaseID  field.Uint32
+	UID        field.String
+	HookType   field.String
+	HookName   field.String
+	ExitCode   field.Int32
+	DurationMs field.Uint32
+	Stdout     field.String
+	Stderr     field.String
+	Ip         field.String
+	ClientType field.String
+	ExecutedAt field.Time
+	CreatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (h hookExecResult) Table(newTableName string) *hookExecResult {
+	h.hookExecResultDo.UseTable(newTableName)
+	return h.updateTableName(newTableName)
+}
+
+func (h hookExecResult) As(alias string) *hookExecResult {
+	h.hookExecResultDo.DO = *(h.hookExecResultDo.As(alias).(*gen.DO))
+	return h.updateTableName(alias)
+}
+
+func (h *hookExecResult) updateTableName(table string) *hookExecResult {
+	h.ALL = field.NewAsterisk(table)
+	h.ID = field.NewUint32(table, "id")
+	h.BizID = field.NewUint32(table, "biz_id")
+	h.AppID = field.NewUint32(table, "app_id")
+	h.ReleaseID = field.NewUint32(table, "release_id")
+	h.UID = field.NewString(table, "uid")
+	h.HookType = field.NewString(table, "hook_type")
+	h.HookName = field.NewString(table, "hook_name")
+	h.ExitCode = field.NewInt32(table, "exit_code")
+	h.DurationMs = field.NewUint32(table, "duration_ms")
+	h.Stdout = field.NewString(table, "stdout")
+	h.Stderr = field.NewString(table, "stderr")
+	h.Ip = field.NewString(table, "ip")
+	h.ClientType = field.NewString(table, "client_type")
+	h.ExecutedAt = field.NewTime(table, "executed_at")
+	h.CreatedAt = field.NewTime(table, "created_at")
+
+	h.fillFieldMap()
+
+	return h
+}
+
+func (h *hookExecResult) WithContext(ctx context.Context) IHookExecResultDo {
+	return h.hookExecResultDo.WithContext(ctx)
+}
+
+func (h hookExecResult) TableName() string { return h.hookExecResultDo.TableName() }
+
+func (h hookExecResult) Alias() string { return h.hookExecResultDo.Alias() }
+
+func (h hookExecResult) Columns(cols ...field.Expr) gen.Columns {
+	return h.hookExecResultDo.Columns(cols...)
+}
+
+func (h *hookExecResult) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := h.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (h *hookExecResult) fillFieldMap() {
+	h.fieldMap = make(map[string]field.Expr, 15)
+	h.fieldMap["id"] = h.ID
+	h.fieldMap["biz_id"] = h.BizID
+	h.fieldMap["app_id"] = h.AppID
+	h.fieldMap["release_id"] = h.ReleaseID
+	h.fieldMap["uid"] = h.UID
+	h.fieldMap["hook_type"] = h.HookType
+	h.fieldMap["hook_name"] = h.HookName
+	h.fieldMap["exit_code"] = h.ExitCode
+	h.fieldMap["duration_ms"] = h.DurationMs
+	h.fieldMap["stdout"] = h.Stdout
+	h.fieldMap["stderr"] = h.Stderr
+	h.fieldMap["ip"] = h.Ip
+	h.fieldMap["client_type"] = h.ClientType
+	h.fieldMap["executed_at"] = h.ExecutedAt
+	h.fieldMap["created_at"] = h.CreatedAt
+}
+
+func (h hookExecResult) clone(db *gorm.DB) hookExecResult {
+	h.hookExecResultDo.ReplaceConnPool(db.Statement.ConnPool)
+	return h
+}
+
+func (h hookExecResult) replaceDB(db *gorm.DB) hookExecResult {
+	h.hookExecResultDo.ReplaceDB(db)
+	return h
+}
+
+type hookExecResultDo struct{ gen.DO }
+
+type IHookExecResultDo interface {
+	gen.SubQuery
+	Debug() IHookExecResultDo
+	WithContext(ctx context.Context) IHookExecResultDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IHookExecResultDo
+	WriteDB() IHookExecResultDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IHookExecResultDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IHookExecResultDo
+	Not(conds ...gen.Condition) IHookExecResultDo
+	Or(conds ...gen.Condition) IHookExecResultDo
+	Select(conds ...field.Expr) IHookExecResultDo
+	Where(conds ...gen.Condition) IHookExecResultDo
+	Order(conds ...field.Expr) IHookExecResultDo
+	Distinct(cols ...field.Expr) IHookExecResultDo
+	Omit(cols ...field.Expr) IHookExecResultDo
+	Join(table schema.Tabler, on ...field.Expr) IHookExecResultDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IHookExecResultDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IHookExecResultDo
+	Group(cols ...field.Expr) IHookExecResultDo
+	Having(conds ...gen.Condition) IHookExecResultDo
+	Limit(limit int) IHookExecResultDo
+	Offset(offset int) IHookExecResultDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IHookExecResultDo
+	Unscoped() IHookExecResultDo
+	Create(values ...*table.HookExecResult) error
+	CreateInBatches(values []*table.HookExecResult, batchSize int) error
+	Save(values ...*table.HookExecResult) error
+	First() (*table.HookExecResult, error)
+	Take() (*table.HookExecResult, error)
+	Last() (*table.HookExecResult, error)
+	Find() ([]*table.HookExecResult, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookExecResult, err error)
+	FindInBatches(result *[]*table.HookExecResult, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.HookExecResult) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IHookExecResultDo
+	Assign(attrs ...field.AssignExpr) IHookExecResultDo
+	Joins(fields ...field.RelationField) IHookExecResultDo
+	Preload(fields ...field.RelationField) IHookExecResultDo
+	FirstOrInit() (*table.HookExecResult, error)
+	FirstOrCreate() (*table.HookExecResult, error)
+	FindByPage(offset int, limit int) (result []*table.HookExecResult, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IHookExecResultDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (h hookExecResultDo) Debug() IHookExecResultDo {
+	return h.withDO(h.DO.Debug())
+}
+
+func (h hookExecResultDo) WithContext(ctx context.Context) IHookExecResultDo {
+	return h.withDO(h.DO.WithContext(ctx))
+}
+
+func (h hookExecResultDo) ReadDB() IHookExecResultDo {
+	return h.Clauses(dbresolver.Read)
+}
+
+func (h hookExecResultDo) WriteDB() IHookExecResultDo {
+	return h.Clauses(dbresolver.Write)
+}
+
+func (h hookExecResultDo) Session(config *gorm.Session) IHookExecResultDo {
+	return h.withDO(h.DO.Session(config))
+}
+
+func (h hookExecResultDo) Clauses(conds ...clause.Expression) IHookExecResultDo {
+	return h.withDO(h.DO.Clauses(conds...))
+}
+
+func (h hookExecResultDo) Returning(value interface{}, columns ...string) IHookExecResultDo {
+	return h.withDO(h.DO.Returning(value, columns...))
+}
+
+func (h hookExecResultDo) Not(conds ...gen.Condition) IHookExecResultDo {
+	return h.withDO(h.DO.Not(conds...))
+}
+
+func (h hookExecResultDo) Or(conds ...gen.Condition) IHookExecResultDo {
+	return h.withDO(h.DO.Or(conds...))
+}
+
+func (h hookExecResultDo) Select(conds ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Select(conds...))
+}
+
+func (h hookExecResultDo) Where(conds ...gen.Condition) IHookExecResultDo {
+	return h.withDO(h.DO.Where(conds...))
+}
+
+func (h hookExecResultDo) Order(conds ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Order(conds...))
+}
+
+func (h hookExecResultDo) Distinct(cols ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Distinct(cols...))
+}
+
+func (h hookExecResultDo) Omit(cols ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Omit(cols...))
+}
+
+func (h hookExecResultDo) Join(table schema.Tabler, on ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Join(table, on...))
+}
+
+func (h hookExecResultDo) LeftJoin(table schema.Tabler, on ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.LeftJoin(table, on...))
+}
+
+func (h hookExecResultDo) RightJoin(table schema.Tabler, on ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.RightJoin(table, on...))
+}
+
+func (h hookExecResultDo) Group(cols ...field.Expr) IHookExecResultDo {
+	return h.withDO(h.DO.Group(cols...))
+}
+
+func (h hookExecResultDo) Having(conds ...gen.Condition) IHookExecResultDo {
+	return h.withDO(h.DO.Having(conds...))
+}
+
+func (h hookExecResultDo) Limit(limit int) IHookExecResultDo {
+	return h.withDO(h.DO.Limit(limit))
+}
+
+func (h hookExecResultDo) Offset(offset int) IHookExecResultDo {
+	return h.withDO(h.DO.Offset(offset))
+}
+
+func (h hookExecResultDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IHookExecResultDo {
+	return h.withDO(h.DO.Scopes(funcs...))
+}
+
+func (h hookExecResultDo) Unscoped() IHookExecResultDo {
+	return h.withDO(h.DO.Unscoped())
+}
+
+func (h hookExecResultDo) Create(values ...*table.HookExecResult) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Create(values)
+}
+
+func (h hookExecResultDo) CreateInBatches(values []*table.HookExecResult, batchSize int) error {
+	return h.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (h hookExecResultDo) Save(values ...*table.HookExecResult) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Save(values)
+}
+
+func (h hookExecResultDo) First() (*table.HookExecResult, error) {
+	if result, err := h.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookExecResult), nil
+	}
+}
+
+func (h hookExecResultDo) Take() (*table.HookExecResult, error) {
+	if result, err := h.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookExecResult), nil
+	}
+}
+
+func (h hookExecResultDo) Last() (*table.HookExecResult, error) {
+	if result, err := h.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookExecResult), nil
+	}
+}
+
+func (h hookExecResultDo) Find() ([]*table.HookExecResult, error) {
+	result, err := h.DO.Find()
+	return result.([]*table.HookExecResult), err
+}
+
+func (h hookExecResultDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookExecResult, err error) {
+	buf := make([]*table.HookExecResult, 0, batchSize)
+	err = h.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (h hookExecResultDo) FindInBatches(result *[]*table.HookExecResult, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return h.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (h hookExecResultDo) Attrs(attrs ...field.AssignExpr) IHookExecResultDo {
+	return h.withDO(h.DO.Attrs(attrs...))
+}
+
+func (h hookExecResultDo) Assign(attrs ...field.AssignExpr) IHookExecResultDo {
+	return h.withDO(h.DO.Assign(attrs...))
+}
+
+func (h hookExecResultDo) Joins(fields ...field.RelationField) IHookExecResultDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Joins(_f))
+	}
+	return &h
+}
+
+func (h hookExecResultDo) Preload(fields ...field.RelationField) IHookExecResultDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Preload(_f))
+	}
+	return &h
+}
+
+func (h hookExecResultDo) FirstOrInit() (*table.HookExecResult, error) {
+	if result, err := h.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookExecResult), nil
+	}
+}
+
+func (h hookExecResultDo) FirstOrCreate() (*table.HookExecResult, error) {
+	if result, err := h.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookExecResult), nil
+	}
+}
+
+func (h hookExecResultDo) FindByPage(offset int, limit int) (result []*table.HookExecResult, count int64, err error) {
+	result, err = h.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = h.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (h hookExecResultDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = h.Count()
+	if err != nil {
+		return
+	}
+
+	err = h.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (h hookExecResultDo) Scan(result interface{}) (err error) {
+	return h.DO.Scan(result)
+}
+
+func (h hookExecResultDo) Delete(models ...*table.HookExecResult) (result gen.ResultInfo, err error) {
+	return h.DO.Delete(models)
+}
+
+func (h *hookExecResultDo) withDO(do gen.Dao) *hookExecResultDo {
+	h.DO = *do.(*gen.DO)
+	return h
+}
@@ -0,0 +1,432 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newHookTemplateRef(db *gorm.DB, opts ...gen.DOOption) hookTemplateRef {
+	_hookTemplateRef := hookTemplateRef{}
+
+	_hookTemplateRef.hookTemplateRefDo.UseDB(db, opts...)
+	_hookTemplateRef.hookTemplateRefDo.UseModel(&table.HookTemplateRef{})
+
+	tableName := _hookTemplateRef.hookTemplateRefDo.TableName()
+	_hookTemplateRef.ALL = field.NewAsterisk(tableName)
+	_hookTemplateRef.ID = field.NewUint32(tableName, "id")
+	_hookTemplateRef.Args = field.NewField(tableName, "args")
+	_hookTemplateRef.TemplateVersion = field.NewUint32(tableName, "template_version")
+	_hookTemplateRef.AutoUpgrade = field.NewBool(tableName, "auto_upgrade")
+	_hookTemplateRef.BizID = field.NewUint32(tableName, "biz_id")
+	_hookTemplateRef.HookID = field.NewUint32(tableName, "hook_id")
+	_hookTemplateRef.TemplateID = field.NewUint32(tableName, "template_id")
+	_hookTemplateRef.Creator = field.NewString(tableName, "creator")
+	_hookTemplateRef.Reviser = field.NewString(tableName, "reviser")
+	_hookTemplateRef.CreatedAt = field.NewTime(tableName, "created_at")
+	_hookTemplateRef.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_hookTemplateRef.fillFieldMap()
+
+	return _hookTemplateRef
+}
+
+type hookTemplateRef struct {
+	hookTemplateRefDo hookTemplateRefDo
+
+	ALL             field.Asterisk
+	ID              field.Uint32
+	Args            field.Field
+	TemplateVersion field.Uint32
+	AutoUpgrade     field.Bool
+	BizID           field.Uint32
+	HookID          field.Uint32
+	TemplateID      field.Uint32
+	Creator         field.String
+	Reviser         field.String
+	CreatedAt       field.Time
+	UpdatedAt       field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (h hookTemplateRef) Table(newTableName string) *hookTemplateRef {
+	h.hookTemplateRefDo.UseTable(newTableName)
+	return h.updateTableName(newTableName)
+}
+
+func (h hookTemplateRef) As(alias string) *hookTemplateRef {
+	h.hookTemplateRefDo.DO = *(h.hookTemplateRefDo.As(alias).(*gen.DO))
+	return h.updateTableName(alias)
+}
+
+func (h *hookTemplateRef) updateTableName(table string) *hookTemplateRef {
+	h.ALL = field.NewAsterisk(table)
+	h.ID = field.NewUint32(table, "id")
+	h.Args = field.NewField(table, "args")
+	h.TemplateVersion = field.NewUint32(table, "template_version")
+	h.AutoUpgrade = field.NewBool(table, "auto_upgrade")
+	h.BizID = field.NewUint32(table, "biz_id")
+	h.HookID = field.NewUint32(table, "hook_id")
+	h.TemplateID = field.NewUint32(table, "template_id")
+	h.Creator = field.NewString(table, "creator")
+	h.Reviser = field.NewString(table, "reviser")
+	h.CreatedAt = field.NewTime(table, "created_at")
+	h.UpdatedAt = field.NewTime(table, "updated_at")
+
+	h.fillFieldMap()
+
+	return h
+}
+
+func (h *hookTemplateRef) WithContext(ctx context.Context) IHookTemplateRefDo {
+	return h.hookTemplateRefDo.WithContext(ctx)
+}
+
+func (h hookTemplateRef) TableName() string { return h.hookTemplateRefDo.TableName() }
+
+func (h hookTemplateRef) Alias() string { return h.hookTemplateRefDo.Alias() }
+
+func (h hookTemplateRef) Columns(cols ...field.Expr) gen.Columns {
+	return h.hookTemplateRefDo.Columns(cols...)
+}
+
+func (h *hookTemplateRef) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := h.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (h *hookTemplateRef) fillFieldMap() {
+	h.fieldMap = make(map[string]field.Expr, 11)
+	h.fieldMap["id"] = h.ID
+	h.fieldMap["args"] = h.Args
+	h.fieldMap["template_version"] = h.TemplateVersion
+	h.fieldMap["auto_upgrade"] = h.AutoUpgrade
+	h.fieldMap["biz_id"] = h.BizID
+	h.fieldMap["hook_id"] = h.HookID
+	h.fieldMap["template_id"] = h.TemplateID
+	h.fieldMap["creator"] = h.Creator
+	h.fieldMap["reviser"] = h.Reviser
+	h.fieldMap["created_at"] = h.CreatedAt
+	h.fieldMap["updated_at"] = h.UpdatedAt
+}
+
+func (h hookTemplateRef) clone(db *gorm.DB) hookTemplateRef {
+	h.hookTemplateRefDo.ReplaceConnPool(db.Statement.ConnPool)
+	return h
+}
+
+func (h hookTemplateRef) replaceDB(db *gorm.DB) hookTemplateRef {
+	h.hookTemplateRefDo.ReplaceDB(db)
+	return h
+}
+
+type hookTemplateRefDo struct{ gen.DO }
+
+type IHookTemplateRefDo interface {
+	gen.SubQuery
+	Debug() IHookTemplateRefDo
+	WithContext(ctx context.Context) IHookTemplateRefDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IHookTemplateRefDo
+	WriteDB() IHookTemplateRefDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IHookTemplateRefDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IHookTemplateRefDo
+	Not(conds ...gen.Condition) IHookTemplateRefDo
+	Or(conds ...gen.Condition) IHookTemplateRefDo
+	Select(conds ...field.Expr) IHookTemplateRefDo
+	Where(conds ...gen.Condition) IHookTemplateRefDo
+	Order(conds ...field.Expr) IHookTemplateRefDo
+	Distinct(cols ...field.Expr) IHookTemplateRefDo
+	Omit(cols ...field.Expr) IHookTemplateRefDo
+	Join(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo
+	Group(cols ...field.Expr) IHookTemplateRefDo
+	Having(conds ...gen.Condition) IHookTemplateRefDo
+	Limit(limit int) IHookTemplateRefDo
+	Offset(offset int) IHookTemplateRefDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IHookTemplateRefDo
+	Unscoped() IHookTemplateRefDo
+	Create(values ...*table.HookTemplateRef) error
+	CreateInBatches(values []*table.HookTemplateRef, batchSize int) error
+	Save(values ...*table.HookTemplateRef) error
+	First() (*table.HookTemplateRef, error)
+	Take() (*table.HookTemplateRef, error)
+	Last() (*table.HookTemplateRef, error)
+	Find() ([]*table.HookTemplateRef, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookTemplateRef, err error)
+	FindInBatches(result *[]*table.HookTemplateRef, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.HookTemplateRef) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IHookTemplateRefDo
+	Assign(attrs ...field.AssignExpr) IHookTemplateRefDo
+	Joins(fields ...field.RelationField) IHookTemplateRefDo
+	Preload(fields ...field.RelationField) IHookTemplateRefDo
+	FirstOrInit() (*table.HookTemplateRef, error)
+	FirstOrCreate() (*table.HookTemplateRef, error)
+	FindByPage(offset int, limit int) (result []*table.HookTemplateRef, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IHookTemplateRefDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (h hookTemplateRefDo) Debug() IHookTemplateRefDo {
+	return h.withDO(h.DO.Debug())
+}
+
+func (h hookTemplateRefDo) WithContext(ctx context.Context) IHookTemplateRefDo {
+	return h.withDO(h.DO.WithContext(ctx))
+}
+
+func (h hookTemplateRefDo) ReadDB() IHookTemplateRefDo {
+	return h.Clauses(dbresolver.Read)
+}
+
+func (h hookTemplateRefDo) WriteDB() IHookTemplateRefDo {
+	return h.Clauses(dbresolver.Write)
+}
+
+func (h hookTemplateRefDo) Session(config *gorm.Session) IHookTemplateRefDo {
+	return h.withDO(h.DO.Session(config))
+}
+
+func (h hookTemplateRefDo) Clauses(conds ...clause.Expression) IHookTemplateRefDo {
+	return h.withDO(h.DO.Clauses(conds...))
+}
+
+func (h hookTemplateRefDo) Returning(value interface{}, columns ...string) IHookTemplateRefDo {
+	return h.withDO(h.DO.Returning(value, columns...))
+}
+
+func (h hookTemplateRefDo) Not(conds ...gen.Condition) IHookTemplateRefDo {
+	return h.withDO(h.DO.Not(conds...))
+}
+
+func (h hookTemplateRefDo) Or(conds ...gen.Condition) IHookTemplateRefDo {
+	return h.withDO(h.DO.Or(conds...))
+}
+
+func (h hookTemplateRefDo) Select(conds ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Select(conds...))
+}
+
+func (h hookTemplateRefDo) Where(conds ...gen.Condition) IHookTemplateRefDo {
+	return h.withDO(h.DO.Where(conds...))
+}
+
+func (h hookTemplateRefDo) Order(conds ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Order(conds...))
+}
+
+func (h hookTemplateRefDo) Distinct(cols ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Distinct(cols...))
+}
+
+func (h hookTemplateRefDo) Omit(cols ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Omit(cols...))
+}
+
+func (h hookTemplateRefDo) Join(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Join(table, on...))
+}
+
+func (h hookTemplateRefDo) LeftJoin(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.LeftJoin(table, on...))
+}
+
+func (h hookTemplateRefDo) RightJoin(table schema.Tabler, on ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.RightJoin(table, on...))
+}
+
+func (h hookTemplateRefDo) Group(cols ...field.Expr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Group(cols...))
+}
+
+func (h hookTemplateRefDo) Having(conds ...gen.Condition) IHookTemplateRefDo {
+	return h.withDO(h.DO.Having(conds...))
+}
+
+func (h hookTemplateRefDo) Limit(limit int) IHookTemplateRefDo {
+	return h.withDO(h.DO.Limit(limit))
+}
+
+func (h hookTemplateRefDo) Offset(offset int) IHookTemplateRefDo {
+	return h.withDO(h.DO.Offset(offset))
+}
+
+func (h hookTemplateRefDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IHookTemplateRefDo {
+	return h.withDO(h.DO.Scopes(funcs...))
+}
+
+func (h hookTemplateRefDo) Unscoped() IHookTemplateRefDo {
+	return h.withDO(h.DO.Unscoped())
+}
+
+func (h hookTemplateRefDo) Create(values ...*table.HookTemplateRef) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Create(values)
+}
+
+func (h hookTemplateRefDo) CreateInBatches(values []*table.HookTemplateRef, batchSize int) error {
+	return h.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (h hookTemplateRefDo) Save(values ...*table.HookTemplateRef) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Save(values)
+}
+
+func (h hookTemplateRefDo) First() (*table.HookTemplateRef, error) {
+	if result, err := h.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplateRef), nil
+	}
+}
+
+func (h hookTemplateRefDo) Take() (*table.HookTemplateRef, error) {
+	if result, err := h.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplateRef), nil
+	}
+}
+
+func (h hookTemplateRefDo) Last() (*table.HookTemplateRef, error) {
+	if result, err := h.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplateRef), nil
+	}
+}
+
+func (h hookTemplateRefDo) Find() ([]*table.HookTemplateRef, error) {
+	result, err := h.DO.Find()
+	return result.([]*table.HookTemplateRef), err
+}
+
+func (h hookTemplateRefDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookTemplateRef, err error) {
+	buf := make([]*table.HookTemplateRef, 0, batchSize)
+	err = h.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (h hookTemplateRefDo) FindInBatches(result *[]*table.HookTemplateRef, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return h.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (h hookTemplateRefDo) Attrs(attrs ...field.AssignExpr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Attrs(attrs...))
+}
+
+func (h hookTemplateRefDo) Assign(attrs ...field.AssignExpr) IHookTemplateRefDo {
+	return h.withDO(h.DO.Assign(attrs...))
+}
+
+func (h hookTemplateRefDo) Joins(fields ...field.RelationField) IHookTemplateRefDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Joins(_f))
+	}
+	return &h
+}
+
+func (h hookTemplateRefDo) Preload(fields ...field.RelationField) IHookTemplateRefDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Preload(_f))
+	}
+	return &h
+}
+
+func (h hookTemplateRefDo) FirstOrInit() (*table.HookTemplateRef, error) {
+	if result, err := h.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplateRef), nil
+	}
+}
+
+func (h hookTemplateRefDo) FirstOrCreate() (*table.HookTemplateRef, error) {
+	if result, err := h.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplateRef), nil
+	}
+}
+
+func (h hookTemplateRefDo) FindByPage(offset int, limit int) (result []*table.HookTemplateRef, count int64, err error) {
+	result, err = h.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = h.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (h hookTemplateRefDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = h.Count()
+	if err != nil {
+		return
+	}
+
+	err = h.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (h hookTemplateRefDo) Scan(result interface{}) (err error) {
+	return h.DO.Scan(result)
+}
+
+func (h hookTemplateRefDo) Delete(models ...*table.HookTemplateRef) (result gen.ResultInfo, err error) {
+	return h.DO.Delete(models)
+}
+
+func (h *hookTemplateRefDo) withDO(do gen.Dao) *hookTemplateRefDo {
+	h.DO = *do.(*gen.DO)
+	return h
+}
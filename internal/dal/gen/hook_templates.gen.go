@@ -0,0 +1,436 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newHookTemplate(db *gorm.DB, opts ...gen.DOOption) hookTemplate {
+	_hookTemplate := hookTemplate{}
+
+	_hookTemplate.hookTemplateDo.UseDB(db, opts...)
+	_hookTemplate.hookTemplateDo.UseModel(&table.HookTemplate{})
+
+	tableName := _hookTemplate.hookTemplateDo.TableName()
+	_hookTemplate.ALL = field.NewAsterisk(tableName)
+	_hookTemplate.ID = field.NewUint32(tableName, "id")
+	_hookTemplate.Name = field.NewString(tableName, "name")
+	_hookTemplate.Type = field.NewString(tableName, "type")
+	_hookTemplate.Content = field.NewString(tableName, "content")
+	_hookTemplate.Params = field.NewField(tableName, "params")
+	_hookTemplate.Memo = field.NewString(tableName, "memo")
+	_hookTemplate.Version = field.NewUint32(tableName, "version")
+	_hookTemplate.BizID = field.NewUint32(tableName, "biz_id")
+	_hookTemplate.Creator = field.NewString(tableName, "creator")
+	_hookTemplate.Reviser = field.NewString(tableName, "reviser")
+	_hookTemplate.CreatedAt = field.NewTime(tableName, "created_at")
+	_hookTemplate.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_hookTemplate.fillFieldMap()
+
+	return _hookTemplate
+}
+
+type hookTemplate struct {
+	hookTemplateDo hookTemplateDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Name      field.String
+	Type      field.String
+	Content   field.String
+	Params    field.Field
+	Memo      field.String
+	Version   field.Uint32
+	BizID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (h hookTemplate) Table(newTableName string) *hookTemplate {
+	h.hookTemplateDo.UseTable(newTableName)
+	return h.updateTableName(newTableName)
+}
+
+func (h hookTemplate) As(alias string) *hookTemplate {
+	h.hookTemplateDo.DO = *(h.hookTemplateDo.As(alias).(*gen.DO))
+	return h.updateTableName(alias)
+}
+
+func (h *hookTemplate) updateTableName(table string) *hookTemplate {
+	h.ALL = field.NewAsterisk(table)
+	h.ID = field.NewUint32(table, "id")
+	h.Name = field.NewString(table, "name")
+	h.Type = field.NewString(table, "type")
+	h.Content = field.NewString(table, "content")
+	h.Params = field.NewField(table, "params")
+	h.Memo = field.NewString(table, "memo")
+	h.Version = field.NewUint32(table, "version")
+	h.BizID = field.NewUint32(table, "biz_id")
+	h.Creator = field.NewString(table, "creator")
+	h.Reviser = field.NewString(table, "reviser")
+	h.CreatedAt = field.NewTime(table, "created_at")
+	h.UpdatedAt = field.NewTime(table, "updated_at")
+
+	h.fillFieldMap()
+
+	return h
+}
+
+func (h *hookTemplate) WithContext(ctx context.Context) IHookTemplateDo {
+	return h.hookTemplateDo.WithContext(ctx)
+}
+
+func (h hookTemplate) TableName() string { return h.hookTemplateDo.TableName() }
+
+func (h hookTemplate) Alias() string { return h.hookTemplateDo.Alias() }
+
+func (h hookTemplate) Columns(cols ...field.Expr) gen.Columns {
+	return h.hookTemplateDo.Columns(cols...)
+}
+
+func (h *hookTemplate) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := h.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (h *hookTemplate) fillFieldMap() {
+	h.fieldMap = make(map[string]field.Expr, 12)
+	h.fieldMap["id"] = h.ID
+	h.fieldMap["name"] = h.Name
+	h.fieldMap["type"] = h.Type
+	h.fieldMap["content"] = h.Content
+	h.fieldMap["params"] = h.Params
+	h.fieldMap["memo"] = h.Memo
+	h.fieldMap["version"] = h.Version
+	h.fieldMap["biz_id"] = h.BizID
+	h.fieldMap["creator"] = h.Creator
+	h.fieldMap["reviser"] = h.Reviser
+	h.fieldMap["created_at"] = h.CreatedAt
+	h.fieldMap["updated_at"] = h.UpdatedAt
+}
+
+func (h hookTemplate) clone(db *gorm.DB) hookTemplate {
+	h.hookTemplateDo.ReplaceConnPool(db.Statement.ConnPool)
+	return h
+}
+
+func (h hookTemplate) replaceDB(db *gorm.DB) hookTemplate {
+	h.hookTemplateDo.ReplaceDB(db)
+	return h
+}
+
+type hookTemplateDo struct{ gen.DO }
+
+type IHookTemplateDo interface {
+	gen.SubQuery
+	Debug() IHookTemplateDo
+	WithContext(ctx context.Context) IHookTemplateDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IHookTemplateDo
+	WriteDB() IHookTemplateDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IHookTemplateDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IHookTemplateDo
+	Not(conds ...gen.Condition) IHookTemplateDo
+	Or(conds ...gen.Condition) IHookTemplateDo
+	Select(conds ...field.Expr) IHookTemplateDo
+	Where(conds ...gen.Condition) IHookTemplateDo
+	Order(conds ...field.Expr) IHookTemplateDo
+	Distinct(cols ...field.Expr) IHookTemplateDo
+	Omit(cols ...field.Expr) IHookTemplateDo
+	Join(table schema.Tabler, on ...field.Expr) IHookTemplateDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IHookTemplateDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IHookTemplateDo
+	Group(cols ...field.Expr) IHookTemplateDo
+	Having(conds ...gen.Condition) IHookTemplateDo
+	Limit(limit int) IHookTemplateDo
+	Offset(offset int) IHookTemplateDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IHookTemplateDo
+	Unscoped() IHookTemplateDo
+	Create(values ...*table.HookTemplate) error
+	CreateInBatches(values []*table.HookTemplate, batchSize int) error
+	Save(values ...*table.HookTemplate) error
+	First() (*table.HookTemplate, error)
+	Take() (*table.HookTemplate, error)
+	Last() (*table.HookTemplate, error)
+	Find() ([]*table.HookTemplate, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookTemplate, err error)
+	FindInBatches(result *[]*table.HookTemplate, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.HookTemplate) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IHookTemplateDo
+	Assign(attrs ...field.AssignExpr) IHookTemplateDo
+	Joins(fields ...field.RelationField) IHookTemplateDo
+	Preload(fields ...field.RelationField) IHookTemplateDo
+	FirstOrInit() (*table.HookTemplate, error)
+	FirstOrCreate() (*table.HookTemplate, error)
+	FindByPage(offset int, limit int) (result []*table.HookTemplate, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IHookTemplateDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (h hookTemplateDo) Debug() IHookTemplateDo {
+	return h.withDO(h.DO.Debug())
+}
+
+func (h hookTemplateDo) WithContext(ctx context.Context) IHookTemplateDo {
+	return h.withDO(h.DO.WithContext(ctx))
+}
+
+func (h hookTemplateDo) ReadDB() IHookTemplateDo {
+	return h.Clauses(dbresolver.Read)
+}
+
+func (h hookTemplateDo) WriteDB() IHookTemplateDo {
+	return h.Clauses(dbresolver.Write)
+}
+
+func (h hookTemplateDo) Session(config *gorm.Session) IHookTemplateDo {
+	return h.withDO(h.DO.Session(config))
+}
+
+func (h hookTemplateDo) Clauses(conds ...clause.Expression) IHookTemplateDo {
+	return h.withDO(h.DO.Clauses(conds...))
+}
+
+func (h hookTemplateDo) Returning(value interface{}, columns ...string) IHookTemplateDo {
+	return h.withDO(h.DO.Returning(value, columns...))
+}
+
+func (h hookTemplateDo) Not(conds ...gen.Condition) IHookTemplateDo {
+	return h.withDO(h.DO.Not(conds...))
+}
+
+func (h hookTemplateDo) Or(conds ...gen.Condition) IHookTemplateDo {
+	return h.withDO(h.DO.Or(conds...))
+}
+
+func (h hookTemplateDo) Select(conds ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Select(conds...))
+}
+
+func (h hookTemplateDo) Where(conds ...gen.Condition) IHookTemplateDo {
+	return h.withDO(h.DO.Where(conds...))
+}
+
+func (h hookTemplateDo) Order(conds ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Order(conds...))
+}
+
+func (h hookTemplateDo) Distinct(cols ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Distinct(cols...))
+}
+
+func (h hookTemplateDo) Omit(cols ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Omit(cols...))
+}
+
+func (h hookTemplateDo) Join(table schema.Tabler, on ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Join(table, on...))
+}
+
+func (h hookTemplateDo) LeftJoin(table schema.Tabler, on ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.LeftJoin(table, on...))
+}
+
+func (h hookTemplateDo) RightJoin(table schema.Tabler, on ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.RightJoin(table, on...))
+}
+
+func (h hookTemplateDo) Group(cols ...field.Expr) IHookTemplateDo {
+	return h.withDO(h.DO.Group(cols...))
+}
+
+func (h hookTemplateDo) Having(conds ...gen.Condition) IHookTemplateDo {
+	return h.withDO(h.DO.Having(conds...))
+}
+
+func (h hookTemplateDo) Limit(limit int) IHookTemplateDo {
+	return h.withDO(h.DO.Limit(limit))
+}
+
+func (h hookTemplateDo) Offset(offset int) IHookTemplateDo {
+	return h.withDO(h.DO.Offset(offset))
+}
+
+func (h hookTemplateDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IHookTemplateDo {
+	return h.withDO(h.DO.Scopes(funcs...))
+}
+
+func (h hookTemplateDo) Unscoped() IHookTemplateDo {
+	return h.withDO(h.DO.Unscoped())
+}
+
+func (h hookTemplateDo) Create(values ...*table.HookTemplate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Create(values)
+}
+
+func (h hookTemplateDo) CreateInBatches(values []*table.HookTemplate, batchSize int) error {
+	return h.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (h hookTemplateDo) Save(values ...*table.HookTemplate) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return h.DO.Save(values)
+}
+
+func (h hookTemplateDo) First() (*table.HookTemplate, error) {
+	if result, err := h.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplate), nil
+	}
+}
+
+func (h hookTemplateDo) Take() (*table.HookTemplate, error) {
+	if result, err := h.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplate), nil
+	}
+}
+
+func (h hookTemplateDo) Last() (*table.HookTemplate, error) {
+	if result, err := h.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplate), nil
+	}
+}
+
+func (h hookTemplateDo) Find() ([]*table.HookTemplate, error) {
+	result, err := h.DO.Find()
+	return result.([]*table.HookTemplate), err
+}
+
+func (h hookTemplateDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.HookTemplate, err error) {
+	buf := make([]*table.HookTemplate, 0, batchSize)
+	err = h.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (h hookTemplateDo) FindInBatches(result *[]*table.HookTemplate, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return h.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (h hookTemplateDo) Attrs(attrs ...field.AssignExpr) IHookTemplateDo {
+	return h.withDO(h.DO.Attrs(attrs...))
+}
+
+func (h hookTemplateDo) Assign(attrs ...field.AssignExpr) IHookTemplateDo {
+	return h.withDO(h.DO.Assign(attrs...))
+}
+
+func (h hookTemplateDo) Joins(fields ...field.RelationField) IHookTemplateDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Joins(_f))
+	}
+	return &h
+}
+
+func (h hookTemplateDo) Preload(fields ...field.RelationField) IHookTemplateDo {
+	for _, _f := range fields {
+		h = *h.withDO(h.DO.Preload(_f))
+	}
+	return &h
+}
+
+func (h hookTemplateDo) FirstOrInit() (*table.HookTemplate, error) {
+	if result, err := h.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplate), nil
+	}
+}
+
+func (h hookTemplateDo) FirstOrCreate() (*table.HookTemplate, error) {
+	if result, err := h.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.HookTemplate), nil
+	}
+}
+
+func (h hookTemplateDo) FindByPage(offset int, limit int) (result []*table.HookTemplate, count int64, err error) {
+	result, err = h.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = h.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (h hookTemplateDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = h.Count()
+	if err != nil {
+		return
+	}
+
+	err = h.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (h hookTemplateDo) Scan(result interface{}) (err error) {
+	return h.DO.Scan(result)
+}
+
+func (h hookTemplateDo) Delete(models ...*table.HookTemplate) (result gen.ResultInfo, err error) {
+	return h.DO.Delete(models)
+}
+
+func (h *hookTemplateDo) withDO(do gen.Dao) *hookTemplateDo {
+	h.DO = *do.(*gen.DO)
+	return h
+}
@@ -0,0 +1,446 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newKvOverride(db *gorm.DB, opts ...gen.DOOption) kvOverride {
+	_kvOverride := kvOverride{}
+
+	_kvOverride.kvOverrideDo.UseDB(db, opts...)
+	_kvOverride.kvOverrideDo.UseModel(&table.KvOverride{})
+
+	tableName := _kvOverride.kvOverrideDo.TableName()
+	_kvOverride.ALL = field.NewAsterisk(tableName)
+	_kvOverride.ID = field.NewUint32(tableName, "id")
+	_kvOverride.Key = field.NewString(tableName, "key")
+	_kvOverride.GroupID = field.NewUint32(tableName, "group_id")
+	_kvOverride.Priority = field.NewUint32(tableName, "priority")
+	_kvOverride.Version = field.NewUint32(tableName, "version")
+	_kvOverride.Memo = field.NewString(tableName, "memo")
+	_kvOverride.BizID = field.NewUint32(tableName, "biz_id")
+	_kvOverride.AppID = field.NewUint32(tableName, "app_id")
+	_kvOverride.Creator = field.NewString(tableName, "creator")
+	_kvOverride.Reviser = field.NewString(tableName, "reviser")
+	_kvOverride.CreatedAt = field.NewTime(tableName, "created_at")
+	_kvOverride.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_kvOverride.Signature = field.NewString(tableName, "signature")
+	_kvOverride.ByteSize = field.NewUint64(tableName, "byte_size")
+	_kvOverride.Md5 = field.NewString(tableName, "md5")
+
+	_kvOverride.fillFieldMap()
+
+	return _kvOverride
+}
+
+type kvOverride struct {
+	kvOverrideDo kvOverrideDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Key       field.String
+	GroupID   field.Uint32
+	Priority  field.Uint32
+	Version   field.Uint32
+	Memo      field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	Signature field.String
+	ByteSize  field.Uint64
+	Md5       field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (k kvOverride) Table(newTableName string) *kvOverride {
+	k.kvOverrideDo.UseTable(newTableName)
+	return k.updateTableName(newTableName)
+}
+
+func (k kvOverride) As(alias string) *kvOverride {
+	k.kvOverrideDo.DO = *(k.kvOverrideDo.As(alias).(*gen.DO))
+	return k.updateTableName(alias)
+}
+
+func (k *kvOverride) updateTableName(table string) *kvOverride {
+	k.ALL = field.NewAsterisk(table)
+	k.ID = field.NewUint32(table, "id")
+	k.Key = field.NewString(table, "key")
+	k.GroupID = field.NewUint32(table, "group_id")
+	k.Priority = field.NewUint32(table, "priority")
+	k.Version = field.NewUint32(table, "version")
+	k.Memo = field.NewString(table, "memo")
+	k.BizID = field.NewUint32(table, "biz_id")
+	k.AppID = field.NewUint32(table, "app_id")
+	k.Creator = field.NewString(table, "creator")
+	k.Reviser = field.NewString(table, "reviser")
+	k.CreatedAt = field.NewTime(table, "created_at")
+	k.UpdatedAt = field.NewTime(table, "updated_at")
+	k.Signature = field.NewString(table, "signature")
+	k.ByteSize = field.NewUint64(table, "byte_size")
+	k.Md5 = field.NewString(table, "md5")
+
+	k.fillFieldMap()
+
+	return k
+}
+
+func (k *kvOverride) WithContext(ctx context.Context) IKvOverrideDo {
+	return k.kvOverrideDo.WithContext(ctx)
+}
+
+func (k kvOverride) TableName() string { return k.kvOverrideDo.TableName() }
+
+func (k kvOverride) Alias() string { return k.kvOverrideDo.Alias() }
+
+func (k kvOverride) Columns(cols ...field.Expr) gen.Columns { return k.kvOverrideDo.Columns(cols...) }
+
+func (k *kvOverride) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := k.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (k *kvOverride) fillFieldMap() {
+	k.fieldMap = make(map[string]field.Expr, 15)
+	k.fieldMap["id"] = k.ID
+	k.fieldMap["key"] = k.Key
+	k.fieldMap["group_id"] = k.GroupID
+	k.fieldMap["priority"] = k.Priority
+	k.fieldMap["version"] = k.Version
+	k.fieldMap["memo"] = k.Memo
+	k.fieldMap["biz_id"] = k.BizID
+	k.fieldMap["app_id"] = k.AppID
+	k.fieldMap["creator"] = k.Creator
+	k.fieldMap["reviser"] = k.Reviser
+	k.fieldMap["created_at"] = k.CreatedAt
+	k.fieldMap["updated_at"] = k.UpdatedAt
+	k.fieldMap["signature"] = k.Signature
+	k.fieldMap["byte_size"] = k.ByteSize
+	k.fieldMap["md5"] = k.Md5
+}
+
+func (k kvOverride) clone(db *gorm.DB) kvOverride {
+	k.kvOverrideDo.ReplaceConnPool(db.Statement.ConnPool)
+	return k
+}
+
+func (k kvOverride) replaceDB(db *gorm.DB) kvOverride {
+	k.kvOverrideDo.ReplaceDB(db)
+	return k
+}
+
+type kvOverrideDo struct{ gen.DO }
+
+type IKvOverrideDo interface {
+	gen.SubQuery
+	Debug() IKvOverrideDo
+	WithContext(ctx context.Context) IKvOverrideDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IKvOverrideDo
+	WriteDB() IKvOverrideDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IKvOverrideDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IKvOverrideDo
+	Not(conds ...gen.Condition) IKvOverrideDo
+	Or(conds ...gen.Condition) IKvOverrideDo
+	Select(conds ...field.Expr) IKvOverrideDo
+	Where(conds ...gen.Condition) IKvOverrideDo
+	Order(conds ...field.Expr) IKvOverrideDo
+	Distinct(cols ...field.Expr) IKvOverrideDo
+	Omit(cols ...field.Expr) IKvOverrideDo
+	Join(table schema.Tabler, on ...field.Expr) IKvOverrideDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IKvOverrideDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IKvOverrideDo
+	Group(cols ...field.Expr) IKvOverrideDo
+	Having(conds ...gen.Condition) IKvOverrideDo
+	Limit(limit int) IKvOverrideDo
+	Offset(offset int) IKvOverrideDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IKvOverrideDo
+	Unscoped() IKvOverrideDo
+	Create(values ...*table.KvOverride) error
+	CreateInBatches(values []*table.KvOverride, batchSize int) error
+	Save(values ...*table.KvOverride) error
+	First() (*table.KvOverride, error)
+	Take() (*table.KvOverride, error)
+	Last() (*table.KvOverride, error)
+	Find() ([]*table.KvOverride, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.KvOverride, err error)
+	FindInBatches(result *[]*table.KvOverride, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.KvOverride) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IKvOverrideDo
+	Assign(attrs ...field.AssignExpr) IKvOverrideDo
+	Joins(fields ...field.RelationField) IKvOverrideDo
+	Preload(fields ...field.RelationField) IKvOverrideDo
+	FirstOrInit() (*table.KvOverride, error)
+	FirstOrCreate() (*table.KvOverride, error)
+	FindByPage(offset int, limit int) (result []*table.KvOverride, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IKvOverrideDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (k kvOverrideDo) Debug() IKvOverrideDo {
+	return k.withDO(k.DO.Debug())
+}
+
+func (k kvOverrideDo) WithContext(ctx context.Context) IKvOverrideDo {
+	return k.withDO(k.DO.WithContext(ctx))
+}
+
+func (k kvOverrideDo) ReadDB() IKvOverrideDo {
+	return k.Clauses(dbresolver.Read)
+}
+
+func (k kvOverrideDo) WriteDB() IKvOverrideDo {
+	return k.Clauses(dbresolver.Write)
+}
+
+func (k kvOverrideDo) Session(config *gorm.Session) IKvOverrideDo {
+	return k.withDO(k.DO.Session(config))
+}
+
+func (k kvOverrideDo) Clauses(conds ...clause.Expression) IKvOverrideDo {
+	return k.withDO(k.DO.Clauses(conds...))
+}
+
+func (k kvOverrideDo) Returning(value interface{}, columns ...string) IKvOverrideDo {
+	return k.withDO(k.DO.Returning(value, columns...))
+}
+
+func (k kvOverrideDo) Not(conds ...gen.Condition) IKvOverrideDo {
+	return k.withDO(k.DO.Not(conds...))
+}
+
+func (k kvOverrideDo) Or(conds ...gen.Condition) IKvOverrideDo {
+	return k.withDO(k.DO.Or(conds...))
+}
+
+func (k kvOverrideDo) Select(conds ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Select(conds...))
+}
+
+func (k kvOverrideDo) Where(conds ...gen.Condition) IKvOverrideDo {
+	return k.withDO(k.DO.Where(conds...))
+}
+
+func (k kvOverrideDo) Order(conds ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Order(conds...))
+}
+
+func (k kvOverrideDo) Distinct(cols ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Distinct(cols...))
+}
+
+func (k kvOverrideDo) Omit(cols ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Omit(cols...))
+}
+
+func (k kvOverrideDo) Join(table schema.Tabler, on ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Join(table, on...))
+}
+
+func (k kvOverrideDo) LeftJoin(table schema.Tabler, on ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.LeftJoin(table, on...))
+}
+
+func (k kvOverrideDo) RightJoin(table schema.Tabler, on ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.RightJoin(table, on...))
+}
+
+func (k kvOverrideDo) Group(cols ...field.Expr) IKvOverrideDo {
+	return k.withDO(k.DO.Group(cols...))
+}
+
+func (k kvOverrideDo) Having(conds ...gen.Condition) IKvOverrideDo {
+	return k.withDO(k.DO.Having(conds...))
+}
+
+func (k kvOverrideDo) Limit(limit int) IKvOverrideDo {
+	return k.withDO(k.DO.Limit(limit))
+}
+
+func (k kvOverrideDo) Offset(offset int) IKvOverrideDo {
+	return k.withDO(k.DO.Offset(offset))
+}
+
+func (k kvOverrideDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IKvOverrideDo {
+	return k.withDO(k.DO.Scopes(funcs...))
+}
+
+func (k kvOverrideDo) Unscoped() IKvOverrideDo {
+	return k.withDO(k.DO.Unscoped())
+}
+
+func (k kvOverrideDo) Create(values ...*table.KvOverride) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return k.DO.Create(values)
+}
+
+func (k kvOverrideDo) CreateInBatches(values []*table.KvOverride, batchSize int) error {
+	return k.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (k kvOverrideDo) Save(values ...*table.KvOverride) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return k.DO.Save(values)
+}
+
+func (k kvOverrideDo) First() (*table.KvOverride, error) {
+	if result, err := k.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.KvOverride), nil
+	}
+}
+
+func (k kvOverrideDo) Take() (*table.KvOverride, error) {
+	if result, err := k.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.KvOverride), nil
+	}
+}
+
+func (k kvOverrideDo) Last() (*table.KvOverride, error) {
+	if result, err := k.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.KvOverride), nil
+	}
+}
+
+func (k kvOverrideDo) Find() ([]*table.KvOverride, error) {
+	result, err := k.DO.Find()
+	return result.([]*table.KvOverride), err
+}
+
+func (k kvOverrideDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.KvOverride, err error) {
+	buf := make([]*table.KvOverride, 0, batchSize)
+	err = k.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (k kvOverrideDo) FindInBatches(result *[]*table.KvOverride, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return k.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (k kvOverrideDo) Attrs(attrs ...field.AssignExpr) IKvOverrideDo {
+	return k.withDO(k.DO.Attrs(attrs...))
+}
+
+func (k kvOverrideDo) Assign(attrs ...field.AssignExpr) IKvOverrideDo {
+	return k.withDO(k.DO.Assign(attrs...))
+}
+
+func (k kvOverrideDo) Joins(fields ...field.RelationField) IKvOverrideDo {
+	for _, _f := range fields {
+		k = *k.withDO(k.DO.Joins(_f))
+	}
+	return &k
+}
+
+func (k kvOverrideDo) Preload(fields ...field.RelationField) IKvOverrideDo {
+	for _, _f := range fields {
+		k = *k.withDO(k.DO.Preload(_f))
+	}
+	return &k
+}
+
+func (k kvOverrideDo) FirstOrInit() (*table.KvOverride, error) {
+	if result, err := k.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.KvOverride), nil
+	}
+}
+
+func (k kvOverrideDo) FirstOrCreate() (*table.KvOverride, error) {
+	if result, err := k.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.KvOverride), nil
+	}
+}
+
+func (k kvOverrideDo) FindByPage(offset int, limit int) (result []*table.KvOverride, count int64, err error) {
+	result, err = k.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = k.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (k kvOverrideDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = k.Count()
+	if err != nil {
+		return
+	}
+
+	err = k.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (k kvOverrideDo) Scan(result interface{}) (err error) {
+	return k.DO.Scan(result)
+}
+
+func (k kvOverrideDo) Delete(models ...*table.KvOverride) (result gen.ResultInfo, err error) {
+	return k.DO.Delete(models)
+}
+
+func (k *kvOverrideDo) withDO(do gen.Dao) *kvOverrideDo {
+	k.DO = *do.(*gen.DO)
+	return k
+}
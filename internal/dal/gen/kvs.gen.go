@@ -36,6 +36,8 @@ func newKv(db *gorm.DB, opts ...gen.DOOption) kv {
 	_kv.SecretType = field.NewString(tableName, "secret_type")
 	_kv.SecretHidden = field.NewBool(tableName, "secret_hidden")
 	_kv.CertificateExpirationDate = field.NewTime(tableName, "certificate_expiration_date")
+	_kv.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_kv.ExpiredFallback = field.NewString(tableName, "expired_fallback")
 	_kv.BizID = field.NewUint32(tableName, "biz_id")
 	_kv.AppID = field.NewUint32(tableName, "app_id")
 	_kv.Creator = field.NewString(tableName, "creator")
@@ -64,6 +66,8 @@ type kv struct {
 	SecretType                field.String
 	SecretHidden              field.Bool
 	CertificateExpirationDate field.Time
+	ExpiredAt                 field.Time
+	ExpiredFallback           field.String
 	BizID                     field.Uint32
 	AppID                     field.Uint32
 	Creator                   field.String
@@ -98,6 +102,8 @@ func (k *kv) updateTableName(table string) *kv {
 	k.SecretType = field.NewString(table, "secret_type")
 	k.SecretHidden = field.NewBool(table, "secret_hidden")
 	k.CertificateExpirationDate = field.NewTime(table, "certificate_expiration_date")
+	k.ExpiredAt = field.NewTime(table, "expired_at")
+	k.ExpiredFallback = field.NewString(table, "expired_fallback")
 	k.BizID = field.NewUint32(table, "biz_id")
 	k.AppID = field.NewUint32(table, "app_id")
 	k.Creator = field.NewString(table, "creator")
@@ -131,7 +137,7 @@ func (k *kv) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (k *kv) fillFieldMap() {
-	k.fieldMap = make(map[string]field.Expr, 18)
+	k.fieldMap = make(map[string]field.Expr, 20)
 	k.fieldMap["id"] = k.ID
 	k.fieldMap["kv_state"] = k.KvState
 	k.fieldMap["key"] = k.Key
@@ -141,6 +147,8 @@ func (k *kv) fillFieldMap() {
 	k.fieldMap["secret_type"] = k.SecretType
 	k.fieldMap["secret_hidden"] = k.SecretHidden
 	k.fieldMap["certificate_expiration_date"] = k.CertificateExpirationDate
+	k.fieldMap["expired_at"] = k.ExpiredAt
+	k.fieldMap["expired_fallback"] = k.ExpiredFallback
 	k.fieldMap["biz_id"] = k.BizID
 	k.fieldMap["app_id"] = k.AppID
 	k.fieldMap["creator"] = k.Creator
@@ -0,0 +1,424 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newMaskingProfile(db *gorm.DB, opts ...gen.DOOption) maskingProfile {
+	_maskingProfile := maskingProfile{}
+
+	_maskingProfile.maskingProfileDo.UseDB(db, opts...)
+	_maskingProfile.maskingProfileDo.UseModel(&table.MaskingProfile{})
+
+	tableName := _maskingProfile.maskingProfileDo.TableName()
+	_maskingProfile.ALL = field.NewAsterisk(tableName)
+	_maskingProfile.ID = field.NewUint32(tableName, "id")
+	_maskingProfile.Enabled = field.NewBool(tableName, "enabled")
+	_maskingProfile.Rules = field.NewField(tableName, "rules")
+	_maskingProfile.BizID = field.NewUint32(tableName, "biz_id")
+	_maskingProfile.AppID = field.NewUint32(tableName, "app_id")
+	_maskingProfile.Creator = field.NewString(tableName, "creator")
+	_maskingProfile.Reviser = field.NewString(tableName, "reviser")
+	_maskingProfile.CreatedAt = field.NewTime(tableName, "created_at")
+	_maskingProfile.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_maskingProfile.fillFieldMap()
+
+	return _maskingProfile
+}
+
+type maskingProfile struct {
+	maskingProfileDo maskingProfileDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Enabled   field.Bool
+	Rules     field.Field
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (m maskingProfile) Table(newTableName string) *maskingProfile {
+	m.maskingProfileDo.UseTable(newTableName)
+	return m.updateTableName(newTableName)
+}
+
+func (m maskingProfile) As(alias string) *maskingProfile {
+	m.maskingProfileDo.DO = *(m.maskingProfileDo.As(alias).(*gen.DO))
+	return m.updateTableName(alias)
+}
+
+func (m *maskingProfile) updateTableName(table string) *maskingProfile {
+	m.ALL = field.NewAsterisk(table)
+	m.ID = field.NewUint32(table, "id")
+	m.Enabled = field.NewBool(table, "enabled")
+	m.Rules = field.NewField(table, "rules")
+	m.BizID = field.NewUint32(table, "biz_id")
+	m.AppID = field.NewUint32(table, "app_id")
+	m.Creator = field.NewString(table, "creator")
+	m.Reviser = field.NewString(table, "reviser")
+	m.CreatedAt = field.NewTime(table, "created_at")
+	m.UpdatedAt = field.NewTime(table, "updated_at")
+
+	m.fillFieldMap()
+
+	return m
+}
+
+func (m *maskingProfile) WithContext(ctx context.Context) IMaskingProfileDo {
+	return m.maskingProfileDo.WithContext(ctx)
+}
+
+func (m maskingProfile) TableName() string { return m.maskingProfileDo.TableName() }
+
+func (m maskingProfile) Alias() string { return m.maskingProfileDo.Alias() }
+
+func (m maskingProfile) Columns(cols ...field.Expr) gen.Columns {
+	return m.maskingProfileDo.Columns(cols...)
+}
+
+func (m *maskingProfile) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := m.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (m *maskingProfile) fillFieldMap() {
+	m.fieldMap = make(map[string]field.Expr, 9)
+	m.fieldMap["id"] = m.ID
+	m.fieldMap["enabled"] = m.Enabled
+	m.fieldMap["rules"] = m.Rules
+	m.fieldMap["biz_id"] = m.BizID
+	m.fieldMap["app_id"] = m.AppID
+	m.fieldMap["creator"] = m.Creator
+	m.fieldMap["reviser"] = m.Reviser
+	m.fieldMap["created_at"] = m.CreatedAt
+	m.fieldMap["updated_at"] = m.UpdatedAt
+}
+
+func (m maskingProfile) clone(db *gorm.DB) maskingProfile {
+	m.maskingProfileDo.ReplaceConnPool(db.Statement.ConnPool)
+	return m
+}
+
+func (m maskingProfile) replaceDB(db *gorm.DB) maskingProfile {
+	m.maskingProfileDo.ReplaceDB(db)
+	return m
+}
+
+type maskingProfileDo struct{ gen.DO }
+
+type IMaskingProfileDo interface {
+	gen.SubQuery
+	Debug() IMaskingProfileDo
+	WithContext(ctx context.Context) IMaskingProfileDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IMaskingProfileDo
+	WriteDB() IMaskingProfileDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IMaskingProfileDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IMaskingProfileDo
+	Not(conds ...gen.Condition) IMaskingProfileDo
+	Or(conds ...gen.Condition) IMaskingProfileDo
+	Select(conds ...field.Expr) IMaskingProfileDo
+	Where(conds ...gen.Condition) IMaskingProfileDo
+	Order(conds ...field.Expr) IMaskingProfileDo
+	Distinct(cols ...field.Expr) IMaskingProfileDo
+	Omit(cols ...field.Expr) IMaskingProfileDo
+	Join(table schema.Tabler, on ...field.Expr) IMaskingProfileDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IMaskingProfileDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IMaskingProfileDo
+	Group(cols ...field.Expr) IMaskingProfileDo
+	Having(conds ...gen.Condition) IMaskingProfileDo
+	Limit(limit int) IMaskingProfileDo
+	Offset(offset int) IMaskingProfileDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IMaskingProfileDo
+	Unscoped() IMaskingProfileDo
+	Create(values ...*table.MaskingProfile) error
+	CreateInBatches(values []*table.MaskingProfile, batchSize int) error
+	Save(values ...*table.MaskingProfile) error
+	First() (*table.MaskingProfile, error)
+	Take() (*table.MaskingProfile, error)
+	Last() (*table.MaskingProfile, error)
+	Find() ([]*table.MaskingProfile, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.MaskingProfile, err error)
+	FindInBatches(result *[]*table.MaskingProfile, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.MaskingProfile) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IMaskingProfileDo
+	Assign(attrs ...field.AssignExpr) IMaskingProfileDo
+	Joins(fields ...field.RelationField) IMaskingProfileDo
+	Preload(fields ...field.RelationField) IMaskingProfileDo
+	FirstOrInit() (*table.MaskingProfile, error)
+	FirstOrCreate() (*table.MaskingProfile, error)
+	FindByPage(offset int, limit int) (result []*table.MaskingProfile, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IMaskingProfileDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (m maskingProfileDo) Debug() IMaskingProfileDo {
+	return m.withDO(m.DO.Debug())
+}
+
+func (m maskingProfileDo) WithContext(ctx context.Context) IMaskingProfileDo {
+	return m.withDO(m.DO.WithContext(ctx))
+}
+
+func (m maskingProfileDo) ReadDB() IMaskingProfileDo {
+	return m.Clauses(dbresolver.Read)
+}
+
+func (m maskingProfileDo) WriteDB() IMaskingProfileDo {
+	return m.Clauses(dbresolver.Write)
+}
+
+func (m maskingProfileDo) Session(config *gorm.Session) IMaskingProfileDo {
+	return m.withDO(m.DO.Session(config))
+}
+
+func (m maskingProfileDo) Clauses(conds ...clause.Expression) IMaskingProfileDo {
+	return m.withDO(m.DO.Clauses(conds...))
+}
+
+func (m maskingProfileDo) Returning(value interface{}, columns ...string) IMaskingProfileDo {
+	return m.withDO(m.DO.Returning(value, columns...))
+}
+
+func (m maskingProfileDo) Not(conds ...gen.Condition) IMaskingProfileDo {
+	return m.withDO(m.DO.Not(conds...))
+}
+
+func (m maskingProfileDo) Or(conds ...gen.Condition) IMaskingProfileDo {
+	return m.withDO(m.DO.Or(conds...))
+}
+
+func (m maskingProfileDo) Select(conds ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Select(conds...))
+}
+
+func (m maskingProfileDo) Where(conds ...gen.Condition) IMaskingProfileDo {
+	return m.withDO(m.DO.Where(conds...))
+}
+
+func (m maskingProfileDo) Order(conds ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Order(conds...))
+}
+
+func (m maskingProfileDo) Distinct(cols ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Distinct(cols...))
+}
+
+func (m maskingProfileDo) Omit(cols ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Omit(cols...))
+}
+
+func (m maskingProfileDo) Join(table schema.Tabler, on ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Join(table, on...))
+}
+
+func (m maskingProfileDo) LeftJoin(table schema.Tabler, on ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.LeftJoin(table, on...))
+}
+
+func (m maskingProfileDo) RightJoin(table schema.Tabler, on ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.RightJoin(table, on...))
+}
+
+func (m maskingProfileDo) Group(cols ...field.Expr) IMaskingProfileDo {
+	return m.withDO(m.DO.Group(cols...))
+}
+
+func (m maskingProfileDo) Having(conds ...gen.Condition) IMaskingProfileDo {
+	return m.withDO(m.DO.Having(conds...))
+}
+
+func (m maskingProfileDo) Limit(limit int) IMaskingProfileDo {
+	return m.withDO(m.DO.Limit(limit))
+}
+
+func (m maskingProfileDo) Offset(offset int) IMaskingProfileDo {
+	return m.withDO(m.DO.Offset(offset))
+}
+
+func (m maskingProfileDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IMaskingProfileDo {
+	return m.withDO(m.DO.Scopes(funcs...))
+}
+
+func (m maskingProfileDo) Unscoped() IMaskingProfileDo {
+	return m.withDO(m.DO.Unscoped())
+}
+
+func (m maskingProfileDo) Create(values ...*table.MaskingProfile) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Create(values)
+}
+
+func (m maskingProfileDo) CreateInBatches(values []*table.MaskingProfile, batchSize int) error {
+	return m.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (m maskingProfileDo) Save(values ...*table.MaskingProfile) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return m.DO.Save(values)
+}
+
+func (m maskingProfileDo) First() (*table.MaskingProfile, error) {
+	if result, err := m.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.MaskingProfile), nil
+	}
+}
+
+func (m maskingProfileDo) Take() (*table.MaskingProfile, error) {
+	if result, err := m.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.MaskingProfile), nil
+	}
+}
+
+func (m maskingProfileDo) Last() (*table.MaskingProfile, error) {
+	if result, err := m.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.MaskingProfile), nil
+	}
+}
+
+func (m maskingProfileDo) Find() ([]*table.MaskingProfile, error) {
+	result, err := m.DO.Find()
+	return result.([]*table.MaskingProfile), err
+}
+
+func (m maskingProfileDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.MaskingProfile, err error) {
+	buf := make([]*table.MaskingProfile, 0, batchSize)
+	err = m.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (m maskingProfileDo) FindInBatches(result *[]*table.MaskingProfile, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return m.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (m maskingProfileDo) Attrs(attrs ...field.AssignExpr) IMaskingProfileDo {
+	return m.withDO(m.DO.Attrs(attrs...))
+}
+
+func (m maskingProfileDo) Assign(attrs ...field.AssignExpr) IMaskingProfileDo {
+	return m.withDO(m.DO.Assign(attrs...))
+}
+
+func (m maskingProfileDo) Joins(fields ...field.RelationField) IMaskingProfileDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Joins(_f))
+	}
+	return &m
+}
+
+func (m maskingProfileDo) Preload(fields ...field.RelationField) IMaskingProfileDo {
+	for _, _f := range fields {
+		m = *m.withDO(m.DO.Preload(_f))
+	}
+	return &m
+}
+
+func (m maskingProfileDo) FirstOrInit() (*table.MaskingProfile, error) {
+	if result, err := m.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.MaskingProfile), nil
+	}
+}
+
+func (m maskingProfileDo) FirstOrCreate() (*table.MaskingProfile, error) {
+	if result, err := m.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.MaskingProfile), nil
+	}
+}
+
+func (m maskingProfileDo) FindByPage(offset int, limit int) (result []*table.MaskingProfile, count int64, err error) {
+	result, err = m.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = m.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (m maskingProfileDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = m.Count()
+	if err != nil {
+		return
+	}
+
+	err = m.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (m maskingProfileDo) Scan(result interface{}) (err error) {
+	return m.DO.Scan(result)
+}
+
+func (m maskingProfileDo) Delete(models ...*table.MaskingProfile) (result gen.ResultInfo, err error) {
+	return m.DO.Delete(models)
+}
+
+func (m *maskingProfileDo) withDO(do gen.Dao) *maskingProfileDo {
+	m.DO = *do.(*gen.DO)
+	return m
+}
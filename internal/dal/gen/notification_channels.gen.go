@@ -0,0 +1,444 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newNotificationChannel(db *gorm.DB, opts ...gen.DOOption) notificationChannel {
+	_notificationChannel := notificationChannel{}
+
+	_notificationChannel.notificationChannelDo.UseDB(db, opts...)
+	_notificationChannel.notificationChannelDo.UseModel(&table.NotificationChannel{})
+
+	tableName := _notificationChannel.notificationChannelDo.TableName()
+	_notificationChannel.ALL = field.NewAsterisk(tableName)
+	_notificationChannel.ID = field.NewUint32(tableName, "id")
+	_notificationChannel.Name = field.NewString(tableName, "name")
+	_notificationChannel.Type = field.NewString(tableName, "type")
+	_notificationChannel.Target = field.NewString(tableName, "target")
+	_notificationChannel.Events = field.NewField(tableName, "events")
+	_notificationChannel.Template = field.NewString(tableName, "template")
+	_notificationChannel.Enabled = field.NewBool(tableName, "enabled")
+	_notificationChannel.Memo = field.NewString(tableName, "memo")
+	_notificationChannel.BizID = field.NewUint32(tableName, "biz_id")
+	_notificationChannel.AppID = field.NewUint32(tableName, "app_id")
+	_notificationChannel.Creator = field.NewString(tableName, "creator")
+	_notificationChannel.Reviser = field.NewString(tableName, "reviser")
+	_notificationChannel.CreatedAt = field.NewTime(tableName, "created_at")
+	_notificationChannel.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_notificationChannel.fillFieldMap()
+
+	return _notificationChannel
+}
+
+type notificationChannel struct {
+	notificationChannelDo notificationChannelDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	Name      field.String
+	Type      field.String
+	Target    field.String
+	Events    field.Field
+	Template  field.String
+	Enabled   field.Bool
+	Memo      field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (n notificationChannel) Table(newTableName string) *notificationChannel {
+	n.notificationChannelDo.UseTable(newTableName)
+	return n.updateTableName(newTableName)
+}
+
+func (n notificationChannel) As(alias string) *notificationChannel {
+	n.notificationChannelDo.DO = *(n.notificationChannelDo.As(alias).(*gen.DO))
+	return n.updateTableName(alias)
+}
+
+func (n *notificationChannel) updateTableName(table string) *notificationChannel {
+	n.ALL = field.NewAsterisk(table)
+	n.ID = field.NewUint32(table, "id")
+	n.Name = field.NewString(table, "name")
+	n.Type = field.NewString(table, "type")
+	n.Target = field.NewString(table, "target")
+	n.Events = field.NewField(table, "events")
+	n.Template = field.NewString(table, "template")
+	n.Enabled = field.NewBool(table, "enabled")
+	n.Memo = field.NewString(table, "memo")
+	n.BizID = field.NewUint32(table, "biz_id")
+	n.AppID = field.NewUint32(table, "app_id")
+	n.Creator = field.NewString(table, "creator")
+	n.Reviser = field.NewString(table, "reviser")
+	n.CreatedAt = field.NewTime(table, "created_at")
+	n.UpdatedAt = field.NewTime(table, "updated_at")
+
+	n.fillFieldMap()
+
+	return n
+}
+
+func (n *notificationChannel) WithContext(ctx context.Context) INotificationChannelDo {
+	return n.notificationChannelDo.WithContext(ctx)
+}
+
+func (n notificationChannel) TableName() string { return n.notificationChannelDo.TableName() }
+
+func (n notificationChannel) Alias() string { return n.notificationChannelDo.Alias() }
+
+func (n notificationChannel) Columns(cols ...field.Expr) gen.Columns {
+	return n.notificationChannelDo.Columns(cols...)
+}
+
+func (n *notificationChannel) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := n.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (n *notificationChannel) fillFieldMap() {
+	n.fieldMap = make(map[string]field.Expr, 14)
+	n.fieldMap["id"] = n.ID
+	n.fieldMap["name"] = n.Name
+	n.fieldMap["type"] = n.Type
+	n.fieldMap["target"] = n.Target
+	n.fieldMap["events"] = n.Events
+	n.fieldMap["template"] = n.Template
+	n.fieldMap["enabled"] = n.Enabled
+	n.fieldMap["memo"] = n.Memo
+	n.fieldMap["biz_id"] = n.BizID
+	n.fieldMap["app_id"] = n.AppID
+	n.fieldMap["creator"] = n.Creator
+	n.fieldMap["reviser"] = n.Reviser
+	n.fieldMap["created_at"] = n.CreatedAt
+	n.fieldMap["updated_at"] = n.UpdatedAt
+}
+
+func (n notificationChannel) clone(db *gorm.DB) notificationChannel {
+	n.notificationChannelDo.ReplaceConnPool(db.Statement.ConnPool)
+	return n
+}
+
+func (n notificationChannel) replaceDB(db *gorm.DB) notificationChannel {
+	n.notificationChannelDo.ReplaceDB(db)
+	return n
+}
+
+type notificationChannelDo struct{ gen.DO }
+
+type INotificationChannelDo interface {
+	gen.SubQuery
+	Debug() INotificationChannelDo
+	WithContext(ctx context.Context) INotificationChannelDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() INotificationChannelDo
+	WriteDB() INotificationChannelDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) INotificationChannelDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) INotificationChannelDo
+	Not(conds ...gen.Condition) INotificationChannelDo
+	Or(conds ...gen.Condition) INotificationChannelDo
+	Select(conds ...field.Expr) INotificationChannelDo
+	Where(conds ...gen.Condition) INotificationChannelDo
+	Order(conds ...field.Expr) INotificationChannelDo
+	Distinct(cols ...field.Expr) INotificationChannelDo
+	Omit(cols ...field.Expr) INotificationChannelDo
+	Join(table schema.Tabler, on ...field.Expr) INotificationChannelDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) INotificationChannelDo
+	RightJoin(table schema.Tabler, on ...field.Expr) INotificationChannelDo
+	Group(cols ...field.Expr) INotificationChannelDo
+	Having(conds ...gen.Condition) INotificationChannelDo
+	Limit(limit int) INotificationChannelDo
+	Offset(offset int) INotificationChannelDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) INotificationChannelDo
+	Unscoped() INotificationChannelDo
+	Create(values ...*table.NotificationChannel) error
+	CreateInBatches(values []*table.NotificationChannel, batchSize int) error
+	Save(values ...*table.NotificationChannel) error
+	First() (*table.NotificationChannel, error)
+	Take() (*table.NotificationChannel, error)
+	Last() (*table.NotificationChannel, error)
+	Find() ([]*table.NotificationChannel, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.NotificationChannel, err error)
+	FindInBatches(result *[]*table.NotificationChannel, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.NotificationChannel) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) INotificationChannelDo
+	Assign(attrs ...field.AssignExpr) INotificationChannelDo
+	Joins(fields ...field.RelationField) INotificationChannelDo
+	Preload(fields ...field.RelationField) INotificationChannelDo
+	FirstOrInit() (*table.NotificationChannel, error)
+	FirstOrCreate() (*table.NotificationChannel, error)
+	FindByPage(offset int, limit int) (result []*table.NotificationChannel, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) INotificationChannelDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (n notificationChannelDo) Debug() INotificationChannelDo {
+	return n.withDO(n.DO.Debug())
+}
+
+func (n notificationChannelDo) WithContext(ctx context.Context) INotificationChannelDo {
+	return n.withDO(n.DO.WithContext(ctx))
+}
+
+func (n notificationChannelDo) ReadDB() INotificationChannelDo {
+	return n.Clauses(dbresolver.Read)
+}
+
+func (n notificationChannelDo) WriteDB() INotificationChannelDo {
+	return n.Clauses(dbresolver.Write)
+}
+
+func (n notificationChannelDo) Session(config *gorm.Session) INotificationChannelDo {
+	return n.withDO(n.DO.Session(config))
+}
+
+func (n notificationChannelDo) Clauses(conds ...clause.Expression) INotificationChannelDo {
+	return n.withDO(n.DO.Clauses(conds...))
+}
+
+func (n notificationChannelDo) Returning(value interface{}, columns ...string) INotificationChannelDo {
+	return n.withDO(n.DO.Returning(value, columns...))
+}
+
+func (n notificationChannelDo) Not(conds ...gen.Condition) INotificationChannelDo {
+	return n.withDO(n.DO.Not(conds...))
+}
+
+func (n notificationChannelDo) Or(conds ...gen.Condition) INotificationChannelDo {
+	return n.withDO(n.DO.Or(conds...))
+}
+
+func (n notificationChannelDo) Select(conds ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Select(conds...))
+}
+
+func (n notificationChannelDo) Where(conds ...gen.Condition) INotificationChannelDo {
+	return n.withDO(n.DO.Where(conds...))
+}
+
+func (n notificationChannelDo) Order(conds ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Order(conds...))
+}
+
+func (n notificationChannelDo) Distinct(cols ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Distinct(cols...))
+}
+
+func (n notificationChannelDo) Omit(cols ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Omit(cols...))
+}
+
+func (n notificationChannelDo) Join(table schema.Tabler, on ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Join(table, on...))
+}
+
+func (n notificationChannelDo) LeftJoin(table schema.Tabler, on ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.LeftJoin(table, on...))
+}
+
+func (n notificationChannelDo) RightJoin(table schema.Tabler, on ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.RightJoin(table, on...))
+}
+
+func (n notificationChannelDo) Group(cols ...field.Expr) INotificationChannelDo {
+	return n.withDO(n.DO.Group(cols...))
+}
+
+func (n notificationChannelDo) Having(conds ...gen.Condition) INotificationChannelDo {
+	return n.withDO(n.DO.Having(conds...))
+}
+
+func (n notificationChannelDo) Limit(limit int) INotificationChannelDo {
+	return n.withDO(n.DO.Limit(limit))
+}
+
+func (n notificationChannelDo) Offset(offset int) INotificationChannelDo {
+	return n.withDO(n.DO.Offset(offset))
+}
+
+func (n notificationChannelDo) Scopes(funcs ...func(gen.Dao) gen.Dao) INotificationChannelDo {
+	return n.withDO(n.DO.Scopes(funcs...))
+}
+
+func (n notificationChannelDo) Unscoped() INotificationChannelDo {
+	return n.withDO(n.DO.Unscoped())
+}
+
+func (n notificationChannelDo) Create(values ...*table.NotificationChannel) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return n.DO.Create(values)
+}
+
+func (n notificationChannelDo) CreateInBatches(values []*table.NotificationChannel, batchSize int) error {
+	return n.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (n notificationChannelDo) Save(values ...*table.NotificationChannel) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return n.DO.Save(values)
+}
+
+func (n notificationChannelDo) First() (*table.NotificationChannel, error) {
+	if result, err := n.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.NotificationChannel), nil
+	}
+}
+
+func (n notificationChannelDo) Take() (*table.NotificationChannel, error) {
+	if result, err := n.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.NotificationChannel), nil
+	}
+}
+
+func (n notificationChannelDo) Last() (*table.NotificationChannel, error) {
+	if result, err := n.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.NotificationChannel), nil
+	}
+}
+
+func (n notificationChannelDo) Find() ([]*table.NotificationChannel, error) {
+	result, err := n.DO.Find()
+	return result.([]*table.NotificationChannel), err
+}
+
+func (n notificationChannelDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.NotificationChannel, err error) {
+	buf := make([]*table.NotificationChannel, 0, batchSize)
+	err = n.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (n notificationChannelDo) FindInBatches(result *[]*table.NotificationChannel, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return n.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (n notificationChannelDo) Attrs(attrs ...field.AssignExpr) INotificationChannelDo {
+	return n.withDO(n.DO.Attrs(attrs...))
+}
+
+func (n notificationChannelDo) Assign(attrs ...field.AssignExpr) INotificationChannelDo {
+	return n.withDO(n.DO.Assign(attrs...))
+}
+
+func (n notificationChannelDo) Joins(fields ...field.RelationField) INotificationChannelDo {
+	for _, _f := range fields {
+		n = *n.withDO(n.DO.Joins(_f))
+	}
+	return &n
+}
+
+func (n notificationChannelDo) Preload(fields ...field.RelationField) INotificationChannelDo {
+	for _, _f := range fields {
+		n = *n.withDO(n.DO.Preload(_f))
+	}
+	return &n
+}
+
+func (n notificationChannelDo) FirstOrInit() (*table.NotificationChannel, error) {
+	if result, err := n.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.NotificationChannel), nil
+	}
+}
+
+func (n notificationChannelDo) FirstOrCreate() (*table.NotificationChannel, error) {
+	if result, err := n.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.NotificationChannel), nil
+	}
+}
+
+func (n notificationChannelDo) FindByPage(offset int, limit int) (result []*table.NotificationChannel, count int64, err error) {
+	result, err = n.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = n.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (n notificationChannelDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = n.Count()
+	if err != nil {
+		return
+	}
+
+	err = n.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (n notificationChannelDo) Scan(result interface{}) (err error) {
+	return n.DO.Scan(result)
+}
+
+func (n notificationChannelDo) Delete(models ...*table.NotificationChannel) (result gen.ResultInfo, err error) {
+	return n.DO.Delete(models)
+}
+
+func (n *notificationChannelDo) withDO(do gen.Dao) *notificationChannelDo {
+	n.DO = *do.(*gen.DO)
+	return n
+}
@@ -0,0 +1,426 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newRecycleBin(db *gorm.DB, opts ...gen.DOOption) recycleBin {
+	_recycleBin := recycleBin{}
+
+	_recycleBin.recycleBinDo.UseDB(db, opts...)
+	_recycleBin.recycleBinDo.UseModel(&table.RecycleBin{})
+
+	tableName := _recycleBin.recycleBinDo.TableName()
+	_recycleBin.ALL = field.NewAsterisk(tableName)
+	_recycleBin.ID = field.NewUint32(tableName, "id")
+	_recycleBin.ResType = field.NewString(tableName, "res_type")
+	_recycleBin.ResID = field.NewUint32(tableName, "res_id")
+	_recycleBin.Name = field.NewString(tableName, "name")
+	_recycleBin.Data = field.NewString(tableName, "data")
+	_recycleBin.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_recycleBin.BizID = field.NewUint32(tableName, "biz_id")
+	_recycleBin.AppID = field.NewUint32(tableName, "app_id")
+	_recycleBin.Creator = field.NewString(tableName, "creator")
+	_recycleBin.CreatedAt = field.NewTime(tableName, "created_at")
+
+	_recycleBin.fillFieldMap()
+
+	return _recycleBin
+}
+
+type recycleBin struct {
+	recycleBinDo recycleBinDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	ResType   field.String
+	ResID     field.Uint32
+	Name      field.String
+	Data      field.String
+	ExpiredAt field.Time
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	CreatedAt field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (r recycleBin) Table(newTableName string) *recycleBin {
+	r.recycleBinDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r recycleBin) As(alias string) *recycleBin {
+	r.recycleBinDo.DO = *(r.recycleBinDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *recycleBin) updateTableName(table string) *recycleBin {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.ResType = field.NewString(table, "res_type")
+	r.ResID = field.NewUint32(table, "res_id")
+	r.Name = field.NewString(table, "name")
+	r.Data = field.NewString(table, "data")
+	r.ExpiredAt = field.NewTime(table, "expired_at")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.AppID = field.NewUint32(table, "app_id")
+	r.Creator = field.NewString(table, "creator")
+	r.CreatedAt = field.NewTime(table, "created_at")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *recycleBin) WithContext(ctx context.Context) IRecycleBinDo {
+	return r.recycleBinDo.WithContext(ctx)
+}
+
+func (r recycleBin) TableName() string { return r.recycleBinDo.TableName() }
+
+func (r recycleBin) Alias() string { return r.recycleBinDo.Alias() }
+
+func (r recycleBin) Columns(cols ...field.Expr) gen.Columns { return r.recycleBinDo.Columns(cols...) }
+
+func (r *recycleBin) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *recycleBin) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 10)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["res_type"] = r.ResType
+	r.fieldMap["res_id"] = r.ResID
+	r.fieldMap["name"] = r.Name
+	r.fieldMap["data"] = r.Data
+	r.fieldMap["expired_at"] = r.ExpiredAt
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["app_id"] = r.AppID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["created_at"] = r.CreatedAt
+}
+
+func (r recycleBin) clone(db *gorm.DB) recycleBin {
+	r.recycleBinDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r recycleBin) replaceDB(db *gorm.DB) recycleBin {
+	r.recycleBinDo.ReplaceDB(db)
+	return r
+}
+
+type recycleBinDo struct{ gen.DO }
+
+type IRecycleBinDo interface {
+	gen.SubQuery
+	Debug() IRecycleBinDo
+	WithContext(ctx context.Context) IRecycleBinDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IRecycleBinDo
+	WriteDB() IRecycleBinDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IRecycleBinDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IRecycleBinDo
+	Not(conds ...gen.Condition) IRecycleBinDo
+	Or(conds ...gen.Condition) IRecycleBinDo
+	Select(conds ...field.Expr) IRecycleBinDo
+	Where(conds ...gen.Condition) IRecycleBinDo
+	Order(conds ...field.Expr) IRecycleBinDo
+	Distinct(cols ...field.Expr) IRecycleBinDo
+	Omit(cols ...field.Expr) IRecycleBinDo
+	Join(table schema.Tabler, on ...field.Expr) IRecycleBinDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IRecycleBinDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IRecycleBinDo
+	Group(cols ...field.Expr) IRecycleBinDo
+	Having(conds ...gen.Condition) IRecycleBinDo
+	Limit(limit int) IRecycleBinDo
+	Offset(offset int) IRecycleBinDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IRecycleBinDo
+	Unscoped() IRecycleBinDo
+	Create(values ...*table.RecycleBin) error
+	CreateInBatches(values []*table.RecycleBin, batchSize int) error
+	Save(values ...*table.RecycleBin) error
+	First() (*table.RecycleBin, error)
+	Take() (*table.RecycleBin, error)
+	Last() (*table.RecycleBin, error)
+	Find() ([]*table.RecycleBin, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RecycleBin, err error)
+	FindInBatches(result *[]*table.RecycleBin, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.RecycleBin) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IRecycleBinDo
+	Assign(attrs ...field.AssignExpr) IRecycleBinDo
+	Joins(fields ...field.RelationField) IRecycleBinDo
+	Preload(fields ...field.RelationField) IRecycleBinDo
+	FirstOrInit() (*table.RecycleBin, error)
+	FirstOrCreate() (*table.RecycleBin, error)
+	FindByPage(offset int, limit int) (result []*table.RecycleBin, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IRecycleBinDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r recycleBinDo) Debug() IRecycleBinDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r recycleBinDo) WithContext(ctx context.Context) IRecycleBinDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r recycleBinDo) ReadDB() IRecycleBinDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r recycleBinDo) WriteDB() IRecycleBinDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r recycleBinDo) Session(config *gorm.Session) IRecycleBinDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r recycleBinDo) Clauses(conds ...clause.Expression) IRecycleBinDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r recycleBinDo) Returning(value interface{}, columns ...string) IRecycleBinDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r recycleBinDo) Not(conds ...gen.Condition) IRecycleBinDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r recycleBinDo) Or(conds ...gen.Condition) IRecycleBinDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r recycleBinDo) Select(conds ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r recycleBinDo) Where(conds ...gen.Condition) IRecycleBinDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r recycleBinDo) Order(conds ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r recycleBinDo) Distinct(cols ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r recycleBinDo) Omit(cols ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r recycleBinDo) Join(table schema.Tabler, on ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r recycleBinDo) LeftJoin(table schema.Tabler, on ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r recycleBinDo) RightJoin(table schema.Tabler, on ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r recycleBinDo) Group(cols ...field.Expr) IRecycleBinDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r recycleBinDo) Having(conds ...gen.Condition) IRecycleBinDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r recycleBinDo) Limit(limit int) IRecycleBinDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r recycleBinDo) Offset(offset int) IRecycleBinDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r recycleBinDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IRecycleBinDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r recycleBinDo) Unscoped() IRecycleBinDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r recycleBinDo) Create(values ...*table.RecycleBin) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r recycleBinDo) CreateInBatches(values []*table.RecycleBin, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r recycleBinDo) Save(values ...*table.RecycleBin) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r recycleBinDo) First() (*table.RecycleBin, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RecycleBin), nil
+	}
+}
+
+func (r recycleBinDo) Take() (*table.RecycleBin, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RecycleBin), nil
+	}
+}
+
+func (r recycleBinDo) Last() (*table.RecycleBin, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RecycleBin), nil
+	}
+}
+
+func (r recycleBinDo) Find() ([]*table.RecycleBin, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.RecycleBin), err
+}
+
+func (r recycleBinDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.RecycleBin, err error) {
+	buf := make([]*table.RecycleBin, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r recycleBinDo) FindInBatches(result *[]*table.RecycleBin, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r recycleBinDo) Attrs(attrs ...field.AssignExpr) IRecycleBinDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r recycleBinDo) Assign(attrs ...field.AssignExpr) IRecycleBinDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r recycleBinDo) Joins(fields ...field.RelationField) IRecycleBinDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r recycleBinDo) Preload(fields ...field.RelationField) IRecycleBinDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r recycleBinDo) FirstOrInit() (*table.RecycleBin, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RecycleBin), nil
+	}
+}
+
+func (r recycleBinDo) FirstOrCreate() (*table.RecycleBin, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.RecycleBin), nil
+	}
+}
+
+func (r recycleBinDo) FindByPage(offset int, limit int) (result []*table.RecycleBin, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r recycleBinDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r recycleBinDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r recycleBinDo) Delete(models ...*table.RecycleBin) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *recycleBinDo) withDO(do gen.Dao) *recycleBinDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
@@ -36,6 +36,7 @@ func newReleasedGroup(db *gorm.DB, opts ...gen.DOOption) releasedGroup {
 	_releasedGroup.Selector = field.NewField(tableName, "selector")
 	_releasedGroup.UID = field.NewString(tableName, "uid")
 	_releasedGroup.Edited = field.NewBool(tableName, "edited")
+	_releasedGroup.Pinned = field.NewBool(tableName, "pinned")
 	_releasedGroup.BizID = field.NewUint32(tableName, "biz_id")
 	_releasedGroup.Reviser = field.NewString(tableName, "reviser")
 	_releasedGroup.UpdatedAt = field.NewTime(tableName, "updated_at")
@@ -58,6 +59,7 @@ type releasedGroup struct {
 	Selector   field.Field
 	UID        field.String
 	Edited     field.Bool
+	Pinned     field.Bool
 	BizID      field.Uint32
 	Reviser    field.String
 	UpdatedAt  field.Time
@@ -86,6 +88,7 @@ func (r *releasedGroup) updateTableName(table string) *releasedGroup {
 	r.Selector = field.NewField(table, "selector")
 	r.UID = field.NewString(table, "uid")
 	r.Edited = field.NewBool(table, "edited")
+	r.Pinned = field.NewBool(table, "pinned")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.Reviser = field.NewString(table, "reviser")
 	r.UpdatedAt = field.NewTime(table, "updated_at")
@@ -117,7 +120,7 @@ func (r *releasedGroup) GetFieldByName(fieldName string) (field.OrderExpr, bool)
 }
 
 func (r *releasedGroup) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 12)
+	r.fieldMap = make(map[string]field.Expr, 13)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["group_id"] = r.GroupID
 	r.fieldMap["app_id"] = r.AppID
@@ -127,6 +130,7 @@ func (r *releasedGroup) fillFieldMap() {
 	r.fieldMap["selector"] = r.Selector
 	r.fieldMap["uid"] = r.UID
 	r.fieldMap["edited"] = r.Edited
+	r.fieldMap["pinned"] = r.Pinned
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["reviser"] = r.Reviser
 	r.fieldMap["updated_at"] = r.UpdatedAt
@@ -0,0 +1,464 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newReleasedKvOverride(db *gorm.DB, opts ...gen.DOOption) releasedKvOverride {
+	_releasedKvOverride := releasedKvOverride{}
+
+	_releasedKvOverride.releasedKvOverrideDo.UseDB(db, opts...)
+	_releasedKvOverride.releasedKvOverrideDo.UseModel(&table.ReleasedKvOverride{})
+
+	tableName := _releasedKvOverride.releasedKvOverrideDo.TableName()
+	_releasedKvOverride.ALL = field.NewAsterisk(tableName)
+	_releasedKvOverride.ID = field.NewUint32(tableName, "id")
+	_releasedKvOverride.ReleaseID = field.NewUint32(tableName, "release_id")
+	_releasedKvOverride.Key = field.NewString(tableName, "key")
+	_releasedKvOverride.GroupID = field.NewUint32(tableName, "group_id")
+	_releasedKvOverride.Priority = field.NewUint32(tableName, "priority")
+	_releasedKvOverride.Version = field.NewUint32(tableName, "version")
+	_releasedKvOverride.Memo = field.NewString(tableName, "memo")
+	_releasedKvOverride.Mode = field.NewString(tableName, "mode")
+	_releasedKvOverride.Selector = field.NewField(tableName, "selector")
+	_releasedKvOverride.UID = field.NewString(tableName, "uid")
+	_releasedKvOverride.BizID = field.NewUint32(tableName, "biz_id")
+	_releasedKvOverride.AppID = field.NewUint32(tableName, "app_id")
+	_releasedKvOverride.Creator = field.NewString(tableName, "creator")
+	_releasedKvOverride.Reviser = field.NewString(tableName, "reviser")
+	_releasedKvOverride.CreatedAt = field.NewTime(tableName, "created_at")
+	_releasedKvOverride.UpdatedAt = field.NewTime(tableName, "updated_at")
+	_releasedKvOverride.Signature = field.NewString(tableName, "signature")
+	_releasedKvOverride.ByteSize = field.NewUint64(tableName, "byte_size")
+	_releasedKvOverride.Md5 = field.NewString(tableName, "md5")
+
+	_releasedKvOverride.fillFieldMap()
+
+	return _releasedKvOverride
+}
+
+type releasedKvOverride struct {
+	releasedKvOverrideDo releasedKvOverrideDo
+
+	ALL       field.Asterisk
+	ID        field.Uint32
+	ReleaseID field.Uint32
+	Key       field.String
+	GroupID   field.Uint32
+	Priority  field.Uint32
+	Version   field.Uint32
+	Memo      field.String
+	Mode      field.String
+	Selector  field.Field
+	UID       field.String
+	BizID     field.Uint32
+	AppID     field.Uint32
+	Creator   field.String
+	Reviser   field.String
+	CreatedAt field.Time
+	UpdatedAt field.Time
+	Signature field.String
+	ByteSize  field.Uint64
+	Md5       field.String
+
+	fieldMap map[string]field.Expr
+}
+
+func (r releasedKvOverride) Table(newTableName string) *releasedKvOverride {
+	r.releasedKvOverrideDo.UseTable(newTableName)
+	return r.updateTableName(newTableName)
+}
+
+func (r releasedKvOverride) As(alias string) *releasedKvOverride {
+	r.releasedKvOverrideDo.DO = *(r.releasedKvOverrideDo.As(alias).(*gen.DO))
+	return r.updateTableName(alias)
+}
+
+func (r *releasedKvOverride) updateTableName(table string) *releasedKvOverride {
+	r.ALL = field.NewAsterisk(table)
+	r.ID = field.NewUint32(table, "id")
+	r.ReleaseID = field.NewUint32(table, "release_id")
+	r.Key = field.NewString(table, "key")
+	r.GroupID = field.NewUint32(table, "group_id")
+	r.Priority = field.NewUint32(table, "priority")
+	r.Version = field.NewUint32(table, "version")
+	r.Memo = field.NewString(table, "memo")
+	r.Mode = field.NewString(table, "mode")
+	r.Selector = field.NewField(table, "selector")
+	r.UID = field.NewString(table, "uid")
+	r.BizID = field.NewUint32(table, "biz_id")
+	r.AppID = field.NewUint32(table, "app_id")
+	r.Creator = field.NewString(table, "creator")
+	r.Reviser = field.NewString(table, "reviser")
+	r.CreatedAt = field.NewTime(table, "created_at")
+	r.UpdatedAt = field.NewTime(table, "updated_at")
+	r.Signature = field.NewString(table, "signature")
+	r.ByteSize = field.NewUint64(table, "byte_size")
+	r.Md5 = field.NewString(table, "md5")
+
+	r.fillFieldMap()
+
+	return r
+}
+
+func (r *releasedKvOverride) WithContext(ctx context.Context) IReleasedKvOverrideDo {
+	return r.releasedKvOverrideDo.WithContext(ctx)
+}
+
+func (r releasedKvOverride) TableName() string { return r.releasedKvOverrideDo.TableName() }
+
+func (r releasedKvOverride) Alias() string { return r.releasedKvOverrideDo.Alias() }
+
+func (r releasedKvOverride) Columns(cols ...field.Expr) gen.Columns {
+	return r.releasedKvOverrideDo.Columns(cols...)
+}
+
+func (r *releasedKvOverride) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := r.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (r *releasedKvOverride) fillFieldMap() {
+	r.fieldMap = make(map[string]field.Expr, 19)
+	r.fieldMap["id"] = r.ID
+	r.fieldMap["release_id"] = r.ReleaseID
+	r.fieldMap["key"] = r.Key
+	r.fieldMap["group_id"] = r.GroupID
+	r.fieldMap["priority"] = r.Priority
+	r.fieldMap["version"] = r.Version
+	r.fieldMap["memo"] = r.Memo
+	r.fieldMap["mode"] = r.Mode
+	r.fieldMap["selector"] = r.Selector
+	r.fieldMap["uid"] = r.UID
+	r.fieldMap["biz_id"] = r.BizID
+	r.fieldMap["app_id"] = r.AppID
+	r.fieldMap["creator"] = r.Creator
+	r.fieldMap["reviser"] = r.Reviser
+	r.fieldMap["created_at"] = r.CreatedAt
+	r.fieldMap["updated_at"] = r.UpdatedAt
+	r.fieldMap["signature"] = r.Signature
+	r.fieldMap["byte_size"] = r.ByteSize
+	r.fieldMap["md5"] = r.Md5
+}
+
+func (r releasedKvOverride) clone(db *gorm.DB) releasedKvOverride {
+	r.releasedKvOverrideDo.ReplaceConnPool(db.Statement.ConnPool)
+	return r
+}
+
+func (r releasedKvOverride) replaceDB(db *gorm.DB) releasedKvOverride {
+	r.releasedKvOverrideDo.ReplaceDB(db)
+	return r
+}
+
+type releasedKvOverrideDo struct{ gen.DO }
+
+type IReleasedKvOverrideDo interface {
+	gen.SubQuery
+	Debug() IReleasedKvOverrideDo
+	WithContext(ctx context.Context) IReleasedKvOverrideDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IReleasedKvOverrideDo
+	WriteDB() IReleasedKvOverrideDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IReleasedKvOverrideDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IReleasedKvOverrideDo
+	Not(conds ...gen.Condition) IReleasedKvOverrideDo
+	Or(conds ...gen.Condition) IReleasedKvOverrideDo
+	Select(conds ...field.Expr) IReleasedKvOverrideDo
+	Where(conds ...gen.Condition) IReleasedKvOverrideDo
+	Order(conds ...field.Expr) IReleasedKvOverrideDo
+	Distinct(cols ...field.Expr) IReleasedKvOverrideDo
+	Omit(cols ...field.Expr) IReleasedKvOverrideDo
+	Join(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo
+	Group(cols ...field.Expr) IReleasedKvOverrideDo
+	Having(conds ...gen.Condition) IReleasedKvOverrideDo
+	Limit(limit int) IReleasedKvOverrideDo
+	Offset(offset int) IReleasedKvOverrideDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IReleasedKvOverrideDo
+	Unscoped() IReleasedKvOverrideDo
+	Create(values ...*table.ReleasedKvOverride) error
+	CreateInBatches(values []*table.ReleasedKvOverride, batchSize int) error
+	Save(values ...*table.ReleasedKvOverride) error
+	First() (*table.ReleasedKvOverride, error)
+	Take() (*table.ReleasedKvOverride, error)
+	Last() (*table.ReleasedKvOverride, error)
+	Find() ([]*table.ReleasedKvOverride, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleasedKvOverride, err error)
+	FindInBatches(result *[]*table.ReleasedKvOverride, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ReleasedKvOverride) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IReleasedKvOverrideDo
+	Assign(attrs ...field.AssignExpr) IReleasedKvOverrideDo
+	Joins(fields ...field.RelationField) IReleasedKvOverrideDo
+	Preload(fields ...field.RelationField) IReleasedKvOverrideDo
+	FirstOrInit() (*table.ReleasedKvOverride, error)
+	FirstOrCreate() (*table.ReleasedKvOverride, error)
+	FindByPage(offset int, limit int) (result []*table.ReleasedKvOverride, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IReleasedKvOverrideDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (r releasedKvOverrideDo) Debug() IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Debug())
+}
+
+func (r releasedKvOverrideDo) WithContext(ctx context.Context) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.WithContext(ctx))
+}
+
+func (r releasedKvOverrideDo) ReadDB() IReleasedKvOverrideDo {
+	return r.Clauses(dbresolver.Read)
+}
+
+func (r releasedKvOverrideDo) WriteDB() IReleasedKvOverrideDo {
+	return r.Clauses(dbresolver.Write)
+}
+
+func (r releasedKvOverrideDo) Session(config *gorm.Session) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Session(config))
+}
+
+func (r releasedKvOverrideDo) Clauses(conds ...clause.Expression) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Clauses(conds...))
+}
+
+func (r releasedKvOverrideDo) Returning(value interface{}, columns ...string) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Returning(value, columns...))
+}
+
+func (r releasedKvOverrideDo) Not(conds ...gen.Condition) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Not(conds...))
+}
+
+func (r releasedKvOverrideDo) Or(conds ...gen.Condition) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Or(conds...))
+}
+
+func (r releasedKvOverrideDo) Select(conds ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Select(conds...))
+}
+
+func (r releasedKvOverrideDo) Where(conds ...gen.Condition) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Where(conds...))
+}
+
+func (r releasedKvOverrideDo) Order(conds ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Order(conds...))
+}
+
+func (r releasedKvOverrideDo) Distinct(cols ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Distinct(cols...))
+}
+
+func (r releasedKvOverrideDo) Omit(cols ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Omit(cols...))
+}
+
+func (r releasedKvOverrideDo) Join(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Join(table, on...))
+}
+
+func (r releasedKvOverrideDo) LeftJoin(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.LeftJoin(table, on...))
+}
+
+func (r releasedKvOverrideDo) RightJoin(table schema.Tabler, on ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.RightJoin(table, on...))
+}
+
+func (r releasedKvOverrideDo) Group(cols ...field.Expr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Group(cols...))
+}
+
+func (r releasedKvOverrideDo) Having(conds ...gen.Condition) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Having(conds...))
+}
+
+func (r releasedKvOverrideDo) Limit(limit int) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Limit(limit))
+}
+
+func (r releasedKvOverrideDo) Offset(offset int) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Offset(offset))
+}
+
+func (r releasedKvOverrideDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Scopes(funcs...))
+}
+
+func (r releasedKvOverrideDo) Unscoped() IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Unscoped())
+}
+
+func (r releasedKvOverrideDo) Create(values ...*table.ReleasedKvOverride) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Create(values)
+}
+
+func (r releasedKvOverrideDo) CreateInBatches(values []*table.ReleasedKvOverride, batchSize int) error {
+	return r.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (r releasedKvOverrideDo) Save(values ...*table.ReleasedKvOverride) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return r.DO.Save(values)
+}
+
+func (r releasedKvOverrideDo) First() (*table.ReleasedKvOverride, error) {
+	if result, err := r.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedKvOverride), nil
+	}
+}
+
+func (r releasedKvOverrideDo) Take() (*table.ReleasedKvOverride, error) {
+	if result, err := r.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedKvOverride), nil
+	}
+}
+
+func (r releasedKvOverrideDo) Last() (*table.ReleasedKvOverride, error) {
+	if result, err := r.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedKvOverride), nil
+	}
+}
+
+func (r releasedKvOverrideDo) Find() ([]*table.ReleasedKvOverride, error) {
+	result, err := r.DO.Find()
+	return result.([]*table.ReleasedKvOverride), err
+}
+
+func (r releasedKvOverrideDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ReleasedKvOverride, err error) {
+	buf := make([]*table.ReleasedKvOverride, 0, batchSize)
+	err = r.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (r releasedKvOverrideDo) FindInBatches(result *[]*table.ReleasedKvOverride, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return r.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (r releasedKvOverrideDo) Attrs(attrs ...field.AssignExpr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Attrs(attrs...))
+}
+
+func (r releasedKvOverrideDo) Assign(attrs ...field.AssignExpr) IReleasedKvOverrideDo {
+	return r.withDO(r.DO.Assign(attrs...))
+}
+
+func (r releasedKvOverrideDo) Joins(fields ...field.RelationField) IReleasedKvOverrideDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Joins(_f))
+	}
+	return &r
+}
+
+func (r releasedKvOverrideDo) Preload(fields ...field.RelationField) IReleasedKvOverrideDo {
+	for _, _f := range fields {
+		r = *r.withDO(r.DO.Preload(_f))
+	}
+	return &r
+}
+
+func (r releasedKvOverrideDo) FirstOrInit() (*table.ReleasedKvOverride, error) {
+	if result, err := r.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedKvOverride), nil
+	}
+}
+
+func (r releasedKvOverrideDo) FirstOrCreate() (*table.ReleasedKvOverride, error) {
+	if result, err := r.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ReleasedKvOverride), nil
+	}
+}
+
+func (r releasedKvOverrideDo) FindByPage(offset int, limit int) (result []*table.ReleasedKvOverride, count int64, err error) {
+	result, err = r.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = r.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (r releasedKvOverrideDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = r.Count()
+	if err != nil {
+		return
+	}
+
+	err = r.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (r releasedKvOverrideDo) Scan(result interface{}) (err error) {
+	return r.DO.Scan(result)
+}
+
+func (r releasedKvOverrideDo) Delete(models ...*table.ReleasedKvOverride) (result gen.ResultInfo, err error) {
+	return r.DO.Delete(models)
+}
+
+func (r *releasedKvOverrideDo) withDO(do gen.Dao) *releasedKvOverrideDo {
+	r.DO = *do.(*gen.DO)
+	return r
+}
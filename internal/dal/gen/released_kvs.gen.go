@@ -36,6 +36,8 @@ func newReleasedKv(db *gorm.DB, opts ...gen.DOOption) releasedKv {
 	_releasedKv.SecretType = field.NewString(tableName, "secret_type")
 	_releasedKv.SecretHidden = field.NewBool(tableName, "secret_hidden")
 	_releasedKv.CertificateExpirationDate = field.NewTime(tableName, "certificate_expiration_date")
+	_releasedKv.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_releasedKv.ExpiredFallback = field.NewString(tableName, "expired_fallback")
 	_releasedKv.BizID = field.NewUint32(tableName, "biz_id")
 	_releasedKv.AppID = field.NewUint32(tableName, "app_id")
 	_releasedKv.Creator = field.NewString(tableName, "creator")
@@ -45,6 +47,7 @@ func newReleasedKv(db *gorm.DB, opts ...gen.DOOption) releasedKv {
 	_releasedKv.Signature = field.NewString(tableName, "signature")
 	_releasedKv.ByteSize = field.NewUint64(tableName, "byte_size")
 	_releasedKv.Md5 = field.NewString(tableName, "md5")
+	_releasedKv.ExpiryNotified = field.NewBool(tableName, "expiry_notified")
 
 	_releasedKv.fillFieldMap()
 
@@ -64,6 +67,8 @@ type releasedKv struct {
 	SecretType                field.String
 	SecretHidden              field.Bool
 	CertificateExpirationDate field.Time
+	ExpiredAt                 field.Time
+	ExpiredFallback           field.String
 	BizID                     field.Uint32
 	AppID                     field.Uint32
 	Creator                   field.String
@@ -73,6 +78,7 @@ type releasedKv struct {
 	Signature                 field.String
 	ByteSize                  field.Uint64
 	Md5                       field.String
+	ExpiryNotified            field.Bool
 
 	fieldMap map[string]field.Expr
 }
@@ -98,6 +104,8 @@ func (r *releasedKv) updateTableName(table string) *releasedKv {
 	r.SecretType = field.NewString(table, "secret_type")
 	r.SecretHidden = field.NewBool(table, "secret_hidden")
 	r.CertificateExpirationDate = field.NewTime(table, "certificate_expiration_date")
+	r.ExpiredAt = field.NewTime(table, "expired_at")
+	r.ExpiredFallback = field.NewString(table, "expired_fallback")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.AppID = field.NewUint32(table, "app_id")
 	r.Creator = field.NewString(table, "creator")
@@ -107,6 +115,7 @@ func (r *releasedKv) updateTableName(table string) *releasedKv {
 	r.Signature = field.NewString(table, "signature")
 	r.ByteSize = field.NewUint64(table, "byte_size")
 	r.Md5 = field.NewString(table, "md5")
+	r.ExpiryNotified = field.NewBool(table, "expiry_notified")
 
 	r.fillFieldMap()
 
@@ -133,7 +142,7 @@ func (r *releasedKv) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *releasedKv) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 18)
+	r.fieldMap = make(map[string]field.Expr, 21)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["release_id"] = r.ReleaseID
 	r.fieldMap["key"] = r.Key
@@ -143,6 +152,8 @@ func (r *releasedKv) fillFieldMap() {
 	r.fieldMap["secret_type"] = r.SecretType
 	r.fieldMap["secret_hidden"] = r.SecretHidden
 	r.fieldMap["certificate_expiration_date"] = r.CertificateExpirationDate
+	r.fieldMap["expired_at"] = r.ExpiredAt
+	r.fieldMap["expired_fallback"] = r.ExpiredFallback
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["app_id"] = r.AppID
 	r.fieldMap["creator"] = r.Creator
@@ -152,6 +163,7 @@ func (r *releasedKv) fillFieldMap() {
 	r.fieldMap["signature"] = r.Signature
 	r.fieldMap["byte_size"] = r.ByteSize
 	r.fieldMap["md5"] = r.Md5
+	r.fieldMap["expiry_notified"] = r.ExpiryNotified
 }
 
 func (r releasedKv) clone(db *gorm.DB) releasedKv {
@@ -33,6 +33,7 @@ func newRelease(db *gorm.DB, opts ...gen.DOOption) release {
 	_release.Deprecated = field.NewBool(tableName, "deprecated")
 	_release.PublishNum = field.NewUint32(tableName, "publish_num")
 	_release.FullyReleased = field.NewBool(tableName, "fully_released")
+	_release.Notes = field.NewField(tableName, "notes")
 	_release.BizID = field.NewUint32(tableName, "biz_id")
 	_release.AppID = field.NewUint32(tableName, "app_id")
 	_release.Creator = field.NewString(tableName, "creator")
@@ -53,6 +54,7 @@ type release struct {
 	Deprecated    field.Bool
 	PublishNum    field.Uint32
 	FullyReleased field.Bool
+	Notes         field.Field
 	BizID         field.Uint32
 	AppID         field.Uint32
 	Creator       field.String
@@ -79,6 +81,7 @@ func (r *release) updateTableName(table string) *release {
 	r.Deprecated = field.NewBool(table, "deprecated")
 	r.PublishNum = field.NewUint32(table, "publish_num")
 	r.FullyReleased = field.NewBool(table, "fully_released")
+	r.Notes = field.NewField(table, "notes")
 	r.BizID = field.NewUint32(table, "biz_id")
 	r.AppID = field.NewUint32(table, "app_id")
 	r.Creator = field.NewString(table, "creator")
@@ -107,13 +110,14 @@ func (r *release) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (r *release) fillFieldMap() {
-	r.fieldMap = make(map[string]field.Expr, 10)
+	r.fieldMap = make(map[string]field.Expr, 11)
 	r.fieldMap["id"] = r.ID
 	r.fieldMap["name"] = r.Name
 	r.fieldMap["memo"] = r.Memo
 	r.fieldMap["deprecated"] = r.Deprecated
 	r.fieldMap["publish_num"] = r.PublishNum
 	r.fieldMap["fully_released"] = r.FullyReleased
+	r.fieldMap["notes"] = r.Notes
 	r.fieldMap["biz_id"] = r.BizID
 	r.fieldMap["app_id"] = r.AppID
 	r.fieldMap["creator"] = r.Creator
@@ -0,0 +1,424 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newSecretScanSetting(db *gorm.DB, opts ...gen.DOOption) secretScanSetting {
+	_secretScanSetting := secretScanSetting{}
+
+	_secretScanSetting.secretScanSettingDo.UseDB(db, opts...)
+	_secretScanSetting.secretScanSettingDo.UseModel(&table.SecretScanSetting{})
+
+	tableName := _secretScanSetting.secretScanSettingDo.TableName()
+	_secretScanSetting.ALL = field.NewAsterisk(tableName)
+	_secretScanSetting.ID = field.NewUint32(tableName, "id")
+	_secretScanSetting.Mode = field.NewString(tableName, "mode")
+	_secretScanSetting.DisabledRules = field.NewField(tableName, "disabled_rules")
+	_secretScanSetting.CustomRules = field.NewField(tableName, "custom_rules")
+	_secretScanSetting.BizID = field.NewUint32(tableName, "biz_id")
+	_secretScanSetting.Creator = field.NewString(tableName, "creator")
+	_secretScanSetting.Reviser = field.NewString(tableName, "reviser")
+	_secretScanSetting.CreatedAt = field.NewTime(tableName, "created_at")
+	_secretScanSetting.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_secretScanSetting.fillFieldMap()
+
+	return _secretScanSetting
+}
+
+type secretScanSetting struct {
+	secretScanSettingDo secretScanSettingDo
+
+	ALL           field.Asterisk
+	ID            field.Uint32
+	Mode          field.String
+	DisabledRules field.Field
+	CustomRules   field.Field
+	BizID         field.Uint32
+	Creator       field.String
+	Reviser       field.String
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s secretScanSetting) Table(newTableName string) *secretScanSetting {
+	s.secretScanSettingDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s secretScanSetting) As(alias string) *secretScanSetting {
+	s.secretScanSettingDo.DO = *(s.secretScanSettingDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *secretScanSetting) updateTableName(table string) *secretScanSetting {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewUint32(table, "id")
+	s.Mode = field.NewString(table, "mode")
+	s.DisabledRules = field.NewField(table, "disabled_rules")
+	s.CustomRules = field.NewField(table, "custom_rules")
+	s.BizID = field.NewUint32(table, "biz_id")
+	s.Creator = field.NewString(table, "creator")
+	s.Reviser = field.NewString(table, "reviser")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *secretScanSetting) WithContext(ctx context.Context) ISecretScanSettingDo {
+	return s.secretScanSettingDo.WithContext(ctx)
+}
+
+func (s secretScanSetting) TableName() string { return s.secretScanSettingDo.TableName() }
+
+func (s secretScanSetting) Alias() string { return s.secretScanSettingDo.Alias() }
+
+func (s secretScanSetting) Columns(cols ...field.Expr) gen.Columns {
+	return s.secretScanSettingDo.Columns(cols...)
+}
+
+func (s *secretScanSetting) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *secretScanSetting) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 9)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["mode"] = s.Mode
+	s.fieldMap["disabled_rules"] = s.DisabledRules
+	s.fieldMap["custom_rules"] = s.CustomRules
+	s.fieldMap["biz_id"] = s.BizID
+	s.fieldMap["creator"] = s.Creator
+	s.fieldMap["reviser"] = s.Reviser
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+}
+
+func (s secretScanSetting) clone(db *gorm.DB) secretScanSetting {
+	s.secretScanSettingDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s secretScanSetting) replaceDB(db *gorm.DB) secretScanSetting {
+	s.secretScanSettingDo.ReplaceDB(db)
+	return s
+}
+
+type secretScanSettingDo struct{ gen.DO }
+
+type ISecretScanSettingDo interface {
+	gen.SubQuery
+	Debug() ISecretScanSettingDo
+	WithContext(ctx context.Context) ISecretScanSettingDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() ISecretScanSettingDo
+	WriteDB() ISecretScanSettingDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) ISecretScanSettingDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) ISecretScanSettingDo
+	Not(conds ...gen.Condition) ISecretScanSettingDo
+	Or(conds ...gen.Condition) ISecretScanSettingDo
+	Select(conds ...field.Expr) ISecretScanSettingDo
+	Where(conds ...gen.Condition) ISecretScanSettingDo
+	Order(conds ...field.Expr) ISecretScanSettingDo
+	Distinct(cols ...field.Expr) ISecretScanSettingDo
+	Omit(cols ...field.Expr) ISecretScanSettingDo
+	Join(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo
+	RightJoin(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo
+	Group(cols ...field.Expr) ISecretScanSettingDo
+	Having(conds ...gen.Condition) ISecretScanSettingDo
+	Limit(limit int) ISecretScanSettingDo
+	Offset(offset int) ISecretScanSettingDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) ISecretScanSettingDo
+	Unscoped() ISecretScanSettingDo
+	Create(values ...*table.SecretScanSetting) error
+	CreateInBatches(values []*table.SecretScanSetting, batchSize int) error
+	Save(values ...*table.SecretScanSetting) error
+	First() (*table.SecretScanSetting, error)
+	Take() (*table.SecretScanSetting, error)
+	Last() (*table.SecretScanSetting, error)
+	Find() ([]*table.SecretScanSetting, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.SecretScanSetting, err error)
+	FindInBatches(result *[]*table.SecretScanSetting, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.SecretScanSetting) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) ISecretScanSettingDo
+	Assign(attrs ...field.AssignExpr) ISecretScanSettingDo
+	Joins(fields ...field.RelationField) ISecretScanSettingDo
+	Preload(fields ...field.RelationField) ISecretScanSettingDo
+	FirstOrInit() (*table.SecretScanSetting, error)
+	FirstOrCreate() (*table.SecretScanSetting, error)
+	FindByPage(offset int, limit int) (result []*table.SecretScanSetting, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) ISecretScanSettingDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s secretScanSettingDo) Debug() ISecretScanSettingDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s secretScanSettingDo) WithContext(ctx context.Context) ISecretScanSettingDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s secretScanSettingDo) ReadDB() ISecretScanSettingDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s secretScanSettingDo) WriteDB() ISecretScanSettingDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s secretScanSettingDo) Session(config *gorm.Session) ISecretScanSettingDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s secretScanSettingDo) Clauses(conds ...clause.Expression) ISecretScanSettingDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s secretScanSettingDo) Returning(value interface{}, columns ...string) ISecretScanSettingDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s secretScanSettingDo) Not(conds ...gen.Condition) ISecretScanSettingDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s secretScanSettingDo) Or(conds ...gen.Condition) ISecretScanSettingDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s secretScanSettingDo) Select(conds ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s secretScanSettingDo) Where(conds ...gen.Condition) ISecretScanSettingDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s secretScanSettingDo) Order(conds ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s secretScanSettingDo) Distinct(cols ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s secretScanSettingDo) Omit(cols ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s secretScanSettingDo) Join(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s secretScanSettingDo) LeftJoin(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s secretScanSettingDo) RightJoin(table schema.Tabler, on ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s secretScanSettingDo) Group(cols ...field.Expr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s secretScanSettingDo) Having(conds ...gen.Condition) ISecretScanSettingDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s secretScanSettingDo) Limit(limit int) ISecretScanSettingDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s secretScanSettingDo) Offset(offset int) ISecretScanSettingDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s secretScanSettingDo) Scopes(funcs ...func(gen.Dao) gen.Dao) ISecretScanSettingDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s secretScanSettingDo) Unscoped() ISecretScanSettingDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s secretScanSettingDo) Create(values ...*table.SecretScanSetting) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s secretScanSettingDo) CreateInBatches(values []*table.SecretScanSetting, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s secretScanSettingDo) Save(values ...*table.SecretScanSetting) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s secretScanSettingDo) First() (*table.SecretScanSetting, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.SecretScanSetting), nil
+	}
+}
+
+func (s secretScanSettingDo) Take() (*table.SecretScanSetting, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.SecretScanSetting), nil
+	}
+}
+
+func (s secretScanSettingDo) Last() (*table.SecretScanSetting, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.SecretScanSetting), nil
+	}
+}
+
+func (s secretScanSettingDo) Find() ([]*table.SecretScanSetting, error) {
+	result, err := s.DO.Find()
+	return result.([]*table.SecretScanSetting), err
+}
+
+func (s secretScanSettingDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.SecretScanSetting, err error) {
+	buf := make([]*table.SecretScanSetting, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s secretScanSettingDo) FindInBatches(result *[]*table.SecretScanSetting, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s secretScanSettingDo) Attrs(attrs ...field.AssignExpr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s secretScanSettingDo) Assign(attrs ...field.AssignExpr) ISecretScanSettingDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s secretScanSettingDo) Joins(fields ...field.RelationField) ISecretScanSettingDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s secretScanSettingDo) Preload(fields ...field.RelationField) ISecretScanSettingDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s secretScanSettingDo) FirstOrInit() (*table.SecretScanSetting, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.SecretScanSetting), nil
+	}
+}
+
+func (s secretScanSettingDo) FirstOrCreate() (*table.SecretScanSetting, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.SecretScanSetting), nil
+	}
+}
+
+func (s secretScanSettingDo) FindByPage(offset int, limit int) (result []*table.SecretScanSetting, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s secretScanSettingDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s secretScanSettingDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s secretScanSettingDo) Delete(models ...*table.SecretScanSetting) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *secretScanSettingDo) withDO(do gen.Dao) *secretScanSettingDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -0,0 +1,444 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newServiceAccount(db *gorm.DB, opts ...gen.DOOption) serviceAccount {
+	_serviceAccount := serviceAccount{}
+
+	_serviceAccount.serviceAccountDo.UseDB(db, opts...)
+	_serviceAccount.serviceAccountDo.UseModel(&table.ServiceAccount{})
+
+	tableName := _serviceAccount.serviceAccountDo.TableName()
+	_serviceAccount.ALL = field.NewAsterisk(tableName)
+	_serviceAccount.ID = field.NewUint32(tableName, "id")
+	_serviceAccount.Name = field.NewString(tableName, "name")
+	_serviceAccount.Memo = field.NewString(tableName, "memo")
+	_serviceAccount.KeyHash = field.NewString(tableName, "key_hash")
+	_serviceAccount.KeyPrefix = field.NewString(tableName, "key_prefix")
+	_serviceAccount.Scopes = field.NewField(tableName, "scopes")
+	_serviceAccount.Enabled = field.NewBool(tableName, "enabled")
+	_serviceAccount.ExpiredAt = field.NewTime(tableName, "expired_at")
+	_serviceAccount.LastUsedAt = field.NewTime(tableName, "last_used_at")
+	_serviceAccount.BizID = field.NewUint32(tableName, "biz_id")
+	_serviceAccount.Creator = field.NewString(tableName, "creator")
+	_serviceAccount.Reviser = field.NewString(tableName, "reviser")
+	_serviceAccount.CreatedAt = field.NewTime(tableName, "created_at")
+	_serviceAccount.UpdatedAt = field.NewTime(tableName, "updated_at")
+
+	_serviceAccount.fillFieldMap()
+
+	return _serviceAccount
+}
+
+type serviceAccount struct {
+	serviceAccountDo serviceAccountDo
+
+	ALL        field.Asterisk
+	ID         field.Uint32
+	Name       field.String
+	Memo       field.String
+	KeyHash    field.String
+	KeyPrefix  field.String
+	Scopes     field.Field
+	Enabled    field.Bool
+	ExpiredAt  field.Time
+	LastUsedAt field.Time
+	BizID      field.Uint32
+	Creator    field.String
+	Reviser    field.String
+	CreatedAt  field.Time
+	UpdatedAt  field.Time
+
+	fieldMap map[string]field.Expr
+}
+
+func (s serviceAccount) Table(newTableName string) *serviceAccount {
+	s.serviceAccountDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s serviceAccount) As(alias string) *serviceAccount {
+	s.serviceAccountDo.DO = *(s.serviceAccountDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *serviceAccount) updateTableName(table string) *serviceAccount {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewUint32(table, "id")
+	s.Name = field.NewString(table, "name")
+	s.Memo = field.NewString(table, "memo")
+	s.KeyHash = field.NewString(table, "key_hash")
+	s.KeyPrefix = field.NewString(table, "key_prefix")
+	s.Scopes = field.NewField(table, "scopes")
+	s.Enabled = field.NewBool(table, "enabled")
+	s.ExpiredAt = field.NewTime(table, "expired_at")
+	s.LastUsedAt = field.NewTime(table, "last_used_at")
+	s.BizID = field.NewUint32(table, "biz_id")
+	s.Creator = field.NewString(table, "creator")
+	s.Reviser = field.NewString(table, "reviser")
+	s.CreatedAt = field.NewTime(table, "created_at")
+	s.UpdatedAt = field.NewTime(table, "updated_at")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *serviceAccount) WithContext(ctx context.Context) IServiceAccountDo {
+	return s.serviceAccountDo.WithContext(ctx)
+}
+
+func (s serviceAccount) TableName() string { return s.serviceAccountDo.TableName() }
+
+func (s serviceAccount) Alias() string { return s.serviceAccountDo.Alias() }
+
+func (s serviceAccount) Columns(cols ...field.Expr) gen.Columns {
+	return s.serviceAccountDo.Columns(cols...)
+}
+
+func (s *serviceAccount) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *serviceAccount) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 14)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["name"] = s.Name
+	s.fieldMap["memo"] = s.Memo
+	s.fieldMap["key_hash"] = s.KeyHash
+	s.fieldMap["key_prefix"] = s.KeyPrefix
+	s.fieldMap["scopes"] = s.Scopes
+	s.fieldMap["enabled"] = s.Enabled
+	s.fieldMap["expired_at"] = s.ExpiredAt
+	s.fieldMap["last_used_at"] = s.LastUsedAt
+	s.fieldMap["biz_id"] = s.BizID
+	s.fieldMap["creator"] = s.Creator
+	s.fieldMap["reviser"] = s.Reviser
+	s.fieldMap["created_at"] = s.CreatedAt
+	s.fieldMap["updated_at"] = s.UpdatedAt
+}
+
+func (s serviceAccount) clone(db *gorm.DB) serviceAccount {
+	s.serviceAccountDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s serviceAccount) replaceDB(db *gorm.DB) serviceAccount {
+	s.serviceAccountDo.ReplaceDB(db)
+	return s
+}
+
+type serviceAccountDo struct{ gen.DO }
+
+type IServiceAccountDo interface {
+	gen.SubQuery
+	Debug() IServiceAccountDo
+	WithContext(ctx context.Context) IServiceAccountDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IServiceAccountDo
+	WriteDB() IServiceAccountDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IServiceAccountDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IServiceAccountDo
+	Not(conds ...gen.Condition) IServiceAccountDo
+	Or(conds ...gen.Condition) IServiceAccountDo
+	Select(conds ...field.Expr) IServiceAccountDo
+	Where(conds ...gen.Condition) IServiceAccountDo
+	Order(conds ...field.Expr) IServiceAccountDo
+	Distinct(cols ...field.Expr) IServiceAccountDo
+	Omit(cols ...field.Expr) IServiceAccountDo
+	Join(table schema.Tabler, on ...field.Expr) IServiceAccountDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IServiceAccountDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IServiceAccountDo
+	Group(cols ...field.Expr) IServiceAccountDo
+	Having(conds ...gen.Condition) IServiceAccountDo
+	Limit(limit int) IServiceAccountDo
+	Offset(offset int) IServiceAccountDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IServiceAccountDo
+	Unscoped() IServiceAccountDo
+	Create(values ...*table.ServiceAccount) error
+	CreateInBatches(values []*table.ServiceAccount, batchSize int) error
+	Save(values ...*table.ServiceAccount) error
+	First() (*table.ServiceAccount, error)
+	Take() (*table.ServiceAccount, error)
+	Last() (*table.ServiceAccount, error)
+	Find() ([]*table.ServiceAccount, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ServiceAccount, err error)
+	FindInBatches(result *[]*table.ServiceAccount, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.ServiceAccount) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IServiceAccountDo
+	Assign(attrs ...field.AssignExpr) IServiceAccountDo
+	Joins(fields ...field.RelationField) IServiceAccountDo
+	Preload(fields ...field.RelationField) IServiceAccountDo
+	FirstOrInit() (*table.ServiceAccount, error)
+	FirstOrCreate() (*table.ServiceAccount, error)
+	FindByPage(offset int, limit int) (result []*table.ServiceAccount, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IServiceAccountDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s serviceAccountDo) Debug() IServiceAccountDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s serviceAccountDo) WithContext(ctx context.Context) IServiceAccountDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s serviceAccountDo) ReadDB() IServiceAccountDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s serviceAccountDo) WriteDB() IServiceAccountDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s serviceAccountDo) Session(config *gorm.Session) IServiceAccountDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s serviceAccountDo) Clauses(conds ...clause.Expression) IServiceAccountDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s serviceAccountDo) Returning(value interface{}, columns ...string) IServiceAccountDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s serviceAccountDo) Not(conds ...gen.Condition) IServiceAccountDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s serviceAccountDo) Or(conds ...gen.Condition) IServiceAccountDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s serviceAccountDo) Select(conds ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s serviceAccountDo) Where(conds ...gen.Condition) IServiceAccountDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s serviceAccountDo) Order(conds ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s serviceAccountDo) Distinct(cols ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s serviceAccountDo) Omit(cols ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s serviceAccountDo) Join(table schema.Tabler, on ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s serviceAccountDo) LeftJoin(table schema.Tabler, on ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s serviceAccountDo) RightJoin(table schema.Tabler, on ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s serviceAccountDo) Group(cols ...field.Expr) IServiceAccountDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s serviceAccountDo) Having(conds ...gen.Condition) IServiceAccountDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s serviceAccountDo) Limit(limit int) IServiceAccountDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s serviceAccountDo) Offset(offset int) IServiceAccountDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s serviceAccountDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IServiceAccountDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s serviceAccountDo) Unscoped() IServiceAccountDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s serviceAccountDo) Create(values ...*table.ServiceAccount) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s serviceAccountDo) CreateInBatches(values []*table.ServiceAccount, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s serviceAccountDo) Save(values ...*table.ServiceAccount) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s serviceAccountDo) First() (*table.ServiceAccount, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ServiceAccount), nil
+	}
+}
+
+func (s serviceAccountDo) Take() (*table.ServiceAccount, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ServiceAccount), nil
+	}
+}
+
+func (s serviceAccountDo) Last() (*table.ServiceAccount, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ServiceAccount), nil
+	}
+}
+
+func (s serviceAccountDo) Find() ([]*table.ServiceAccount, error) {
+	result, err := s.DO.Find()
+	return result.([]*table.ServiceAccount), err
+}
+
+func (s serviceAccountDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.ServiceAccount, err error) {
+	buf := make([]*table.ServiceAccount, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s serviceAccountDo) FindInBatches(result *[]*table.ServiceAccount, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s serviceAccountDo) Attrs(attrs ...field.AssignExpr) IServiceAccountDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s serviceAccountDo) Assign(attrs ...field.AssignExpr) IServiceAccountDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s serviceAccountDo) Joins(fields ...field.RelationField) IServiceAccountDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s serviceAccountDo) Preload(fields ...field.RelationField) IServiceAccountDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s serviceAccountDo) FirstOrInit() (*table.ServiceAccount, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ServiceAccount), nil
+	}
+}
+
+func (s serviceAccountDo) FirstOrCreate() (*table.ServiceAccount, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.ServiceAccount), nil
+	}
+}
+
+func (s serviceAccountDo) FindByPage(offset int, limit int) (result []*table.ServiceAccount, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s serviceAccountDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s serviceAccountDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s serviceAccountDo) Delete(models ...*table.ServiceAccount) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *serviceAccountDo) withDO(do gen.Dao) *serviceAccountDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
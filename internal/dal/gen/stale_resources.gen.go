@@ -0,0 +1,428 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func newStaleResource(db *gorm.DB, opts ...gen.DOOption) staleResource {
+	_staleResource := staleResource{}
+
+	_staleResource.staleResourceDo.UseDB(db, opts...)
+	_staleResource.staleResourceDo.UseModel(&table.StaleResource{})
+
+	tableName := _staleResource.staleResourceDo.TableName()
+	_staleResource.ALL = field.NewAsterisk(tableName)
+	_staleResource.ID = field.NewUint32(tableName, "id")
+	_staleResource.ResType = field.NewString(tableName, "res_type")
+	_staleResource.ResID = field.NewUint32(tableName, "res_id")
+	_staleResource.Name = field.NewString(tableName, "name")
+	_staleResource.Reason = field.NewString(tableName, "reason")
+	_staleResource.LastActiveAt = field.NewTime(tableName, "last_active_at")
+	_staleResource.NotifiedAt = field.NewTime(tableName, "notified_at")
+	_staleResource.ArchivedAt = field.NewTime(tableName, "archived_at")
+	_staleResource.BizID = field.NewUint32(tableName, "biz_id")
+	_staleResource.AppID = field.NewUint32(tableName, "app_id")
+
+	_staleResource.fillFieldMap()
+
+	return _staleResource
+}
+
+type staleResource struct {
+	staleResourceDo staleResourceDo
+
+	ALL          field.Asterisk
+	ID           field.Uint32
+	ResType      field.String
+	ResID        field.Uint32
+	Name         field.String
+	Reason       field.String
+	LastActiveAt field.Time
+	NotifiedAt   field.Time
+	ArchivedAt   field.Time
+	BizID        field.Uint32
+	AppID        field.Uint32
+
+	fieldMap map[string]field.Expr
+}
+
+func (s staleResource) Table(newTableName string) *staleResource {
+	s.staleResourceDo.UseTable(newTableName)
+	return s.updateTableName(newTableName)
+}
+
+func (s staleResource) As(alias string) *staleResource {
+	s.staleResourceDo.DO = *(s.staleResourceDo.As(alias).(*gen.DO))
+	return s.updateTableName(alias)
+}
+
+func (s *staleResource) updateTableName(table string) *staleResource {
+	s.ALL = field.NewAsterisk(table)
+	s.ID = field.NewUint32(table, "id")
+	s.ResType = field.NewString(table, "res_type")
+	s.ResID = field.NewUint32(table, "res_id")
+	s.Name = field.NewString(table, "name")
+	s.Reason = field.NewString(table, "reason")
+	s.LastActiveAt = field.NewTime(table, "last_active_at")
+	s.NotifiedAt = field.NewTime(table, "notified_at")
+	s.ArchivedAt = field.NewTime(table, "archived_at")
+	s.BizID = field.NewUint32(table, "biz_id")
+	s.AppID = field.NewUint32(table, "app_id")
+
+	s.fillFieldMap()
+
+	return s
+}
+
+func (s *staleResource) WithContext(ctx context.Context) IStaleResourceDo {
+	return s.staleResourceDo.WithContext(ctx)
+}
+
+func (s staleResource) TableName() string { return s.staleResourceDo.TableName() }
+
+func (s staleResource) Alias() string { return s.staleResourceDo.Alias() }
+
+func (s staleResource) Columns(cols ...field.Expr) gen.Columns {
+	return s.staleResourceDo.Columns(cols...)
+}
+
+func (s *staleResource) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
+	_f, ok := s.fieldMap[fieldName]
+	if !ok || _f == nil {
+		return nil, false
+	}
+	_oe, ok := _f.(field.OrderExpr)
+	return _oe, ok
+}
+
+func (s *staleResource) fillFieldMap() {
+	s.fieldMap = make(map[string]field.Expr, 10)
+	s.fieldMap["id"] = s.ID
+	s.fieldMap["res_type"] = s.ResType
+	s.fieldMap["res_id"] = s.ResID
+	s.fieldMap["name"] = s.Name
+	s.fieldMap["reason"] = s.Reason
+	s.fieldMap["last_active_at"] = s.LastActiveAt
+	s.fieldMap["notified_at"] = s.NotifiedAt
+	s.fieldMap["archived_at"] = s.ArchivedAt
+	s.fieldMap["biz_id"] = s.BizID
+	s.fieldMap["app_id"] = s.AppID
+}
+
+func (s staleResource) clone(db *gorm.DB) staleResource {
+	s.staleResourceDo.ReplaceConnPool(db.Statement.ConnPool)
+	return s
+}
+
+func (s staleResource) replaceDB(db *gorm.DB) staleResource {
+	s.staleResourceDo.ReplaceDB(db)
+	return s
+}
+
+type staleResourceDo struct{ gen.DO }
+
+type IStaleResourceDo interface {
+	gen.SubQuery
+	Debug() IStaleResourceDo
+	WithContext(ctx context.Context) IStaleResourceDo
+	WithResult(fc func(tx gen.Dao)) gen.ResultInfo
+	ReplaceDB(db *gorm.DB)
+	ReadDB() IStaleResourceDo
+	WriteDB() IStaleResourceDo
+	As(alias string) gen.Dao
+	Session(config *gorm.Session) IStaleResourceDo
+	Columns(cols ...field.Expr) gen.Columns
+	Clauses(conds ...clause.Expression) IStaleResourceDo
+	Not(conds ...gen.Condition) IStaleResourceDo
+	Or(conds ...gen.Condition) IStaleResourceDo
+	Select(conds ...field.Expr) IStaleResourceDo
+	Where(conds ...gen.Condition) IStaleResourceDo
+	Order(conds ...field.Expr) IStaleResourceDo
+	Distinct(cols ...field.Expr) IStaleResourceDo
+	Omit(cols ...field.Expr) IStaleResourceDo
+	Join(table schema.Tabler, on ...field.Expr) IStaleResourceDo
+	LeftJoin(table schema.Tabler, on ...field.Expr) IStaleResourceDo
+	RightJoin(table schema.Tabler, on ...field.Expr) IStaleResourceDo
+	Group(cols ...field.Expr) IStaleResourceDo
+	Having(conds ...gen.Condition) IStaleResourceDo
+	Limit(limit int) IStaleResourceDo
+	Offset(offset int) IStaleResourceDo
+	Count() (count int64, err error)
+	Scopes(funcs ...func(gen.Dao) gen.Dao) IStaleResourceDo
+	Unscoped() IStaleResourceDo
+	Create(values ...*table.StaleResource) error
+	CreateInBatches(values []*table.StaleResource, batchSize int) error
+	Save(values ...*table.StaleResource) error
+	First() (*table.StaleResource, error)
+	Take() (*table.StaleResource, error)
+	Last() (*table.StaleResource, error)
+	Find() ([]*table.StaleResource, error)
+	FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.StaleResource, err error)
+	FindInBatches(result *[]*table.StaleResource, batchSize int, fc func(tx gen.Dao, batch int) error) error
+	Pluck(column field.Expr, dest interface{}) error
+	Delete(...*table.StaleResource) (info gen.ResultInfo, err error)
+	Update(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	Updates(value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumn(column field.Expr, value interface{}) (info gen.ResultInfo, err error)
+	UpdateColumnSimple(columns ...field.AssignExpr) (info gen.ResultInfo, err error)
+	UpdateColumns(value interface{}) (info gen.ResultInfo, err error)
+	UpdateFrom(q gen.SubQuery) gen.Dao
+	Attrs(attrs ...field.AssignExpr) IStaleResourceDo
+	Assign(attrs ...field.AssignExpr) IStaleResourceDo
+	Joins(fields ...field.RelationField) IStaleResourceDo
+	Preload(fields ...field.RelationField) IStaleResourceDo
+	FirstOrInit() (*table.StaleResource, error)
+	FirstOrCreate() (*table.StaleResource, error)
+	FindByPage(offset int, limit int) (result []*table.StaleResource, count int64, err error)
+	ScanByPage(result interface{}, offset int, limit int) (count int64, err error)
+	Scan(result interface{}) (err error)
+	Returning(value interface{}, columns ...string) IStaleResourceDo
+	UnderlyingDB() *gorm.DB
+	schema.Tabler
+}
+
+func (s staleResourceDo) Debug() IStaleResourceDo {
+	return s.withDO(s.DO.Debug())
+}
+
+func (s staleResourceDo) WithContext(ctx context.Context) IStaleResourceDo {
+	return s.withDO(s.DO.WithContext(ctx))
+}
+
+func (s staleResourceDo) ReadDB() IStaleResourceDo {
+	return s.Clauses(dbresolver.Read)
+}
+
+func (s staleResourceDo) WriteDB() IStaleResourceDo {
+	return s.Clauses(dbresolver.Write)
+}
+
+func (s staleResourceDo) Session(config *gorm.Session) IStaleResourceDo {
+	return s.withDO(s.DO.Session(config))
+}
+
+func (s staleResourceDo) Clauses(conds ...clause.Expression) IStaleResourceDo {
+	return s.withDO(s.DO.Clauses(conds...))
+}
+
+func (s staleResourceDo) Returning(value interface{}, columns ...string) IStaleResourceDo {
+	return s.withDO(s.DO.Returning(value, columns...))
+}
+
+func (s staleResourceDo) Not(conds ...gen.Condition) IStaleResourceDo {
+	return s.withDO(s.DO.Not(conds...))
+}
+
+func (s staleResourceDo) Or(conds ...gen.Condition) IStaleResourceDo {
+	return s.withDO(s.DO.Or(conds...))
+}
+
+func (s staleResourceDo) Select(conds ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Select(conds...))
+}
+
+func (s staleResourceDo) Where(conds ...gen.Condition) IStaleResourceDo {
+	return s.withDO(s.DO.Where(conds...))
+}
+
+func (s staleResourceDo) Order(conds ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Order(conds...))
+}
+
+func (s staleResourceDo) Distinct(cols ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Distinct(cols...))
+}
+
+func (s staleResourceDo) Omit(cols ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Omit(cols...))
+}
+
+func (s staleResourceDo) Join(table schema.Tabler, on ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Join(table, on...))
+}
+
+func (s staleResourceDo) LeftJoin(table schema.Tabler, on ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.LeftJoin(table, on...))
+}
+
+func (s staleResourceDo) RightJoin(table schema.Tabler, on ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.RightJoin(table, on...))
+}
+
+func (s staleResourceDo) Group(cols ...field.Expr) IStaleResourceDo {
+	return s.withDO(s.DO.Group(cols...))
+}
+
+func (s staleResourceDo) Having(conds ...gen.Condition) IStaleResourceDo {
+	return s.withDO(s.DO.Having(conds...))
+}
+
+func (s staleResourceDo) Limit(limit int) IStaleResourceDo {
+	return s.withDO(s.DO.Limit(limit))
+}
+
+func (s staleResourceDo) Offset(offset int) IStaleResourceDo {
+	return s.withDO(s.DO.Offset(offset))
+}
+
+func (s staleResourceDo) Scopes(funcs ...func(gen.Dao) gen.Dao) IStaleResourceDo {
+	return s.withDO(s.DO.Scopes(funcs...))
+}
+
+func (s staleResourceDo) Unscoped() IStaleResourceDo {
+	return s.withDO(s.DO.Unscoped())
+}
+
+func (s staleResourceDo) Create(values ...*table.StaleResource) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Create(values)
+}
+
+func (s staleResourceDo) CreateInBatches(values []*table.StaleResource, batchSize int) error {
+	return s.DO.CreateInBatches(values, batchSize)
+}
+
+// Save : !!! underlying implementation is different with GORM
+// The method is equivalent to executing the statement: db.Clauses(clause.OnConflict{UpdateAll: true}).Create(values)
+func (s staleResourceDo) Save(values ...*table.StaleResource) error {
+	if len(values) == 0 {
+		return nil
+	}
+	return s.DO.Save(values)
+}
+
+func (s staleResourceDo) First() (*table.StaleResource, error) {
+	if result, err := s.DO.First(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.StaleResource), nil
+	}
+}
+
+func (s staleResourceDo) Take() (*table.StaleResource, error) {
+	if result, err := s.DO.Take(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.StaleResource), nil
+	}
+}
+
+func (s staleResourceDo) Last() (*table.StaleResource, error) {
+	if result, err := s.DO.Last(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.StaleResource), nil
+	}
+}
+
+func (s staleResourceDo) Find() ([]*table.StaleResource, error) {
+	result, err := s.DO.Find()
+	return result.([]*table.StaleResource), err
+}
+
+func (s staleResourceDo) FindInBatch(batchSize int, fc func(tx gen.Dao, batch int) error) (results []*table.StaleResource, err error) {
+	buf := make([]*table.StaleResource, 0, batchSize)
+	err = s.DO.FindInBatches(&buf, batchSize, func(tx gen.Dao, batch int) error {
+		defer func() { results = append(results, buf...) }()
+		return fc(tx, batch)
+	})
+	return results, err
+}
+
+func (s staleResourceDo) FindInBatches(result *[]*table.StaleResource, batchSize int, fc func(tx gen.Dao, batch int) error) error {
+	return s.DO.FindInBatches(result, batchSize, fc)
+}
+
+func (s staleResourceDo) Attrs(attrs ...field.AssignExpr) IStaleResourceDo {
+	return s.withDO(s.DO.Attrs(attrs...))
+}
+
+func (s staleResourceDo) Assign(attrs ...field.AssignExpr) IStaleResourceDo {
+	return s.withDO(s.DO.Assign(attrs...))
+}
+
+func (s staleResourceDo) Joins(fields ...field.RelationField) IStaleResourceDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Joins(_f))
+	}
+	return &s
+}
+
+func (s staleResourceDo) Preload(fields ...field.RelationField) IStaleResourceDo {
+	for _, _f := range fields {
+		s = *s.withDO(s.DO.Preload(_f))
+	}
+	return &s
+}
+
+func (s staleResourceDo) FirstOrInit() (*table.StaleResource, error) {
+	if result, err := s.DO.FirstOrInit(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.StaleResource), nil
+	}
+}
+
+func (s staleResourceDo) FirstOrCreate() (*table.StaleResource, error) {
+	if result, err := s.DO.FirstOrCreate(); err != nil {
+		return nil, err
+	} else {
+		return result.(*table.StaleResource), nil
+	}
+}
+
+func (s staleResourceDo) FindByPage(offset int, limit int) (result []*table.StaleResource, count int64, err error) {
+	result, err = s.Offset(offset).Limit(limit).Find()
+	if err != nil {
+		return
+	}
+
+	if size := len(result); 0 < limit && 0 < size && size < limit {
+		count = int64(size + offset)
+		return
+	}
+
+	count, err = s.Offset(-1).Limit(-1).Count()
+	return
+}
+
+func (s staleResourceDo) ScanByPage(result interface{}, offset int, limit int) (count int64, err error) {
+	count, err = s.Count()
+	if err != nil {
+		return
+	}
+
+	err = s.Offset(offset).Limit(limit).Scan(result)
+	return
+}
+
+func (s staleResourceDo) Scan(result interface{}) (err error) {
+	return s.DO.Scan(result)
+}
+
+func (s staleResourceDo) Delete(models ...*table.StaleResource) (result gen.ResultInfo, err error) {
+	return s.DO.Delete(models)
+}
+
+func (s *staleResourceDo) withDO(do gen.Dao) *staleResourceDo {
+	s.DO = *do.(*gen.DO)
+	return s
+}
@@ -47,6 +47,8 @@ func newStrategy(db *gorm.DB, opts ...gen.DOOption) strategy {
 	_strategy.ItsmTicketSn = field.NewString(tableName, "itsm_ticket_sn")
 	_strategy.ItsmTicketStatus = field.NewString(tableName, "itsm_ticket_status")
 	_strategy.ItsmTicketStateID = field.NewInt(tableName, "itsm_ticket_state_id")
+	_strategy.ChangeTicketID = field.NewString(tableName, "change_ticket_id")
+	_strategy.ChangeTicketURL = field.NewString(tableName, "change_ticket_url")
 	_strategy.PubState = field.NewString(tableName, "pub_state")
 	_strategy.BizID = field.NewUint32(tableName, "biz_id")
 	_strategy.AppID = field.NewUint32(tableName, "app_id")
@@ -85,6 +87,8 @@ type strategy struct {
 	ItsmTicketSn      field.String
 	ItsmTicketStatus  field.String
 	ItsmTicketStateID field.Int
+	ChangeTicketID    field.String
+	ChangeTicketURL   field.String
 	PubState          field.String
 	BizID             field.Uint32
 	AppID             field.Uint32
@@ -129,6 +133,8 @@ func (s *strategy) updateTableName(table string) *strategy {
 	s.ItsmTicketSn = field.NewString(table, "itsm_ticket_sn")
 	s.ItsmTicketStatus = field.NewString(table, "itsm_ticket_status")
 	s.ItsmTicketStateID = field.NewInt(table, "itsm_ticket_state_id")
+	s.ChangeTicketID = field.NewString(table, "change_ticket_id")
+	s.ChangeTicketURL = field.NewString(table, "change_ticket_url")
 	s.PubState = field.NewString(table, "pub_state")
 	s.BizID = field.NewUint32(table, "biz_id")
 	s.AppID = field.NewUint32(table, "app_id")
@@ -161,7 +167,7 @@ func (s *strategy) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *strategy) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 28)
+	s.fieldMap = make(map[string]field.Expr, 30)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["name"] = s.Name
 	s.fieldMap["release_id"] = s.ReleaseID
@@ -182,6 +188,8 @@ func (s *strategy) fillFieldMap() {
 	s.fieldMap["itsm_ticket_sn"] = s.ItsmTicketSn
 	s.fieldMap["itsm_ticket_status"] = s.ItsmTicketStatus
 	s.fieldMap["itsm_ticket_state_id"] = s.ItsmTicketStateID
+	s.fieldMap["change_ticket_id"] = s.ChangeTicketID
+	s.fieldMap["change_ticket_url"] = s.ChangeTicketURL
 	s.fieldMap["pub_state"] = s.PubState
 	s.fieldMap["biz_id"] = s.BizID
 	s.fieldMap["app_id"] = s.AppID
@@ -41,15 +41,26 @@ type bkrepoAuthTransport struct {
 	Username  string
 	Password  string
 	Transport http.RoundTripper
+	lock      sync.RWMutex
 }
 
 // RoundTrip Transport
 func (t *bkrepoAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lock.RLock()
 	req.SetBasicAuth(t.Username, t.Password)
+	t.lock.RUnlock()
 	resp, err := t.transport().RoundTrip(req)
 	return resp, err
 }
 
+// SetCredential 更新 basic auth 凭证
+func (t *bkrepoAuthTransport) SetCredential(username, password string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.Username = username
+	t.Password = password
+}
+
 func (t *bkrepoAuthTransport) transport() http.RoundTripper {
 	if t.Transport != nil {
 		return t.Transport
@@ -64,6 +75,7 @@ type bkrepoClient struct {
 	client      *http.Client
 	cli         *repo.Client
 	repoCreated *RepoCreated
+	auth        *bkrepoAuthTransport
 }
 
 // RepoCreated is the created repo data with lock to keep its concurrent security
@@ -439,17 +451,30 @@ func newBKRepoClient(settings cc.BaseRepo) (BaseProvider, error) {
 		},
 	}
 
+	rt, err := repoTransport(settings.Proxy)
+	if err != nil {
+		return nil, err
+	}
+
 	transport := &bkrepoAuthTransport{
 		Username:  settings.BkRepo.Username,
 		Password:  settings.BkRepo.Password,
-		Transport: tools.NewCurlLogTransport(defaultTransport),
+		Transport: tools.NewCurlLogTransport(rt),
 	}
 
+	p.auth = transport
 	p.client = &http.Client{Transport: transport}
+	registerCredentialUpdater(bkrepoCredentialKey(settings.BkRepo), p)
 
 	return p, nil
 }
 
+// updateCredential implements credentialUpdater interface
+func (c *bkrepoClient) updateCredential(conf cc.BaseRepo) {
+	c.auth.SetCredential(conf.BkRepo.Username, conf.BkRepo.Password)
+	c.cli.SetBasicAuth(conf.BkRepo.Username, conf.BkRepo.Password)
+}
+
 // newBKRepoProvider new bkrepo provider
 func newBKRepoProvider(repo cc.BaseRepo, redis cc.RedisCluster) (Provider, error) {
 	p, err := newBKRepoClient(repo)
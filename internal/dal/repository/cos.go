@@ -39,6 +39,7 @@ const (
 // cosClient tencentcloud cos client struct
 type cosClient struct {
 	conf        *cc.S3Storage
+	auth        *cos.AuthorizationTransport
 	host        string
 	client      *http.Client
 	innerClient *cos.Client
@@ -188,8 +189,8 @@ func (c *cosClient) DownloadLink(kt *kit.Kit, sign string, fetchLimit uint32) ([
 
 	// cos sdk 已经包含根目录, 需要去重
 	node = strings.TrimLeft(node, "/")
-	u, err := c.innerClient.Object.GetPresignedURL(kt.Ctx, http.MethodGet, node, c.conf.AccessKeyID,
-		c.conf.SecretAccessKey, time.Hour, opt)
+	secretID, secretKey, _ := c.auth.GetCredential()
+	u, err := c.innerClient.Object.GetPresignedURL(kt.Ctx, http.MethodGet, node, secretID, secretKey, time.Hour, opt)
 	if err != nil {
 		return nil, err
 	}
@@ -208,14 +209,19 @@ func (c *cosClient) AsyncDownloadStatus(kt *kit.Kit, sign string, taskID string)
 }
 
 // newCosClient new cos client
-func newCosClient(conf cc.S3Storage) (BaseProvider, error) {
+func newCosClient(conf cc.S3Storage, proxy cc.RepoProxy) (BaseProvider, error) {
 	host := fmt.Sprintf("%s://%s.%s", cosSchema, conf.BucketName, conf.Endpoint)
 
+	rt, err := repoTransport(proxy)
+	if err != nil {
+		return nil, err
+	}
+
 	// cos 鉴权签名
 	transport := &cos.AuthorizationTransport{
 		SecretID:  conf.AccessKeyID,
 		SecretKey: conf.SecretAccessKey,
-		Transport: tools.NewCurlLogTransport(defaultTransport),
+		Transport: tools.NewCurlLogTransport(rt),
 	}
 
 	u, err := url.Parse(host)
@@ -226,16 +232,23 @@ func newCosClient(conf cc.S3Storage) (BaseProvider, error) {
 	p := &cosClient{
 		host:        host,
 		conf:        &conf,
+		auth:        transport,
 		client:      &http.Client{Transport: transport},
 		innerClient: cos.NewClient(&cos.BaseURL{BucketURL: u}, nil),
 	}
+	registerCredentialUpdater(s3CredentialKey(conf), p)
 
 	return p, nil
 }
 
+// updateCredential implements credentialUpdater interface
+func (c *cosClient) updateCredential(conf cc.BaseRepo) {
+	c.auth.SetCredential(conf.S3.AccessKeyID, conf.S3.SecretAccessKey, "")
+}
+
 // newCosProvider new cos provider
 func newCosProvider(repo cc.BaseRepo, redis cc.RedisCluster) (Provider, error) {
-	p, err := newCosClient(repo.S3)
+	p, err := newCosClient(repo.S3, repo.Proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package repository
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// credentialUpdater 支持凭证热更新的存储客户端
+type credentialUpdater interface {
+	updateCredential(conf cc.BaseRepo)
+}
+
+// credentialUpdaters 按存储标识(endpoint+项目/桶)注册的客户端, 同一进程内可能创建多个 provider
+var credentialUpdaters = struct {
+	sync.Mutex
+	clients map[string][]credentialUpdater
+}{clients: make(map[string][]credentialUpdater)}
+
+func registerCredentialUpdater(key string, u credentialUpdater) {
+	credentialUpdaters.Lock()
+	defer credentialUpdaters.Unlock()
+	credentialUpdaters.clients[key] = append(credentialUpdaters.clients[key], u)
+}
+
+func bkrepoCredentialKey(conf cc.BkRepoStorage) string {
+	return "bkrepo/" + strings.Join(conf.Endpoints, ",") + "/" + conf.Project
+}
+
+func s3CredentialKey(conf cc.S3Storage) string {
+	return "s3/" + conf.Endpoint + "/" + conf.BucketName
+}
+
+// UpdateCredentials 配置热加载时更新已创建的存储客户端凭证, 存储地址变更需要重启才能生效
+func UpdateCredentials(conf cc.Repository) {
+	updateCredentials(conf.BaseRepo)
+	if conf.EnableHA {
+		updateCredentials(conf.Slave)
+	}
+	for _, one := range conf.Replicas {
+		updateCredentials(one.BaseRepo)
+	}
+}
+
+func updateCredentials(conf cc.BaseRepo) {
+	var key string
+	switch strings.ToUpper(string(conf.StorageType)) {
+	case string(cc.BkRepo):
+		key = bkrepoCredentialKey(conf.BkRepo)
+	case string(cc.S3):
+		key = s3CredentialKey(conf.S3)
+	default:
+		return
+	}
+
+	credentialUpdaters.Lock()
+	clients := credentialUpdaters.clients[key]
+	credentialUpdaters.Unlock()
+
+	for _, c := range clients {
+		c.updateCredential(conf)
+	}
+	logs.Infof("repository %s credential updated, clients: %d", key, len(clients))
+}
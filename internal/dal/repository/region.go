@@ -0,0 +1,235 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/dal/bedis"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// RegionEnhancer is the enhancer of the repository which has per-region replicas.
+type RegionEnhancer interface {
+	ReplicaManager() *ReplicaManager
+}
+
+// regionProvider writes to the source repo, and replicates the uploaded files to the per-region replicas
+// asynchronously, the files are downloaded from the replica of the current region first.
+type regionProvider struct {
+	Provider
+	local      Provider
+	replicaMgr *ReplicaManager
+}
+
+// ReplicaManager implements RegionEnhancer interface
+func (p *regionProvider) ReplicaManager() *ReplicaManager {
+	return p.replicaMgr
+}
+
+// Upload uploads file to the source repo, and push it to the replication queue
+func (p *regionProvider) Upload(kt *kit.Kit, sign string, body io.Reader) (*ObjectMetadata, error) {
+	md, err := p.Provider.Upload(kt, sign, body)
+	if err != nil {
+		return nil, err
+	}
+	p.replicaMgr.PushToQueue(kt, sign)
+	return md, nil
+}
+
+// CompleteMultipartUpload complete multipart upload of the source repo, and push it to the replication queue
+func (p *regionProvider) CompleteMultipartUpload(kt *kit.Kit, sign string, uploadID string) (*ObjectMetadata, error) {
+	md, err := p.Provider.CompleteMultipartUpload(kt, sign, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	p.replicaMgr.PushToQueue(kt, sign)
+	return md, nil
+}
+
+// Download downloads file from the replica of the current region first, the source repo is the fallback
+// when the file is not replicated yet.
+func (p *regionProvider) Download(kt *kit.Kit, sign string) (io.ReadCloser, int64, error) {
+	if p.local != nil {
+		body, size, err := p.local.Download(kt, sign)
+		if err == nil {
+			return body, size, nil
+		}
+		logs.V(2).Infof("download %s from region replica failed, fallback to source, err: %v, rid: %s", sign,
+			err, kt.Rid)
+	}
+
+	return p.Provider.Download(kt, sign)
+}
+
+// DownloadLink returns the download links of the replica of the current region first, the links of the source
+// repo are appended as the fallback.
+func (p *regionProvider) DownloadLink(kt *kit.Kit, sign string, fetchLimit uint32) ([]string, error) {
+	if p.local == nil {
+		return p.Provider.DownloadLink(kt, sign, fetchLimit)
+	}
+
+	var urls []string
+	// 文件尚未同步到本地域副本时只返回源存储的下载地址
+	if _, err := p.local.Metadata(kt, sign); err == nil {
+		if localUrls, err := p.local.DownloadLink(kt, sign, fetchLimit); err == nil {
+			urls = append(urls, localUrls...)
+		}
+	}
+
+	sourceUrls, err := p.Provider.DownloadLink(kt, sign, fetchLimit)
+	if err != nil {
+		if len(urls) != 0 {
+			return urls, nil
+		}
+		return nil, err
+	}
+
+	return append(urls, sourceUrls...), nil
+}
+
+// newRegionProvider wraps the provider with the per-region replicas.
+func newRegionProvider(settings cc.Repository, source Provider) (Provider, error) {
+	replicas := make(map[string]Provider, len(settings.Replicas))
+	for _, one := range settings.Replicas {
+		p, err := newReplicaProvider(one.BaseRepo, settings.RedisCluster)
+		if err != nil {
+			return nil, fmt.Errorf("new region %s replica provider failed, err: %v", one.Region, err)
+		}
+		replicas[one.Region] = p
+	}
+
+	client, err := bedis.NewRedisCache(settings.RedisCluster)
+	if err != nil {
+		return nil, fmt.Errorf("new redis cluster failed, err: %v", err)
+	}
+
+	return &regionProvider{
+		Provider: source,
+		local:    replicas[settings.Region],
+		replicaMgr: &ReplicaManager{
+			client:   client,
+			source:   source,
+			replicas: replicas,
+		},
+	}, nil
+}
+
+// newReplicaProvider new region replica provider
+func newReplicaProvider(repo cc.BaseRepo, redis cc.RedisCluster) (Provider, error) {
+	switch strings.ToUpper(string(repo.StorageType)) {
+	case string(cc.S3):
+		return newCosProvider(repo, redis)
+	case string(cc.BkRepo):
+		return newBKRepoProvider(repo, redis)
+	}
+	return nil, fmt.Errorf("unsupported storage type: %s", repo.StorageType)
+}
+
+// ReplicaManager replicates the files from the source repo to the per-region replicas.
+type ReplicaManager struct {
+	client   bedis.Client
+	source   Provider
+	replicas map[string]Provider
+}
+
+// Regions returns the regions of the replicas.
+func (m *ReplicaManager) Regions() []string {
+	regions := make([]string, 0, len(m.replicas))
+	for region := range m.replicas {
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// QueueClient returns client for replication queue
+func (m *ReplicaManager) QueueClient() bedis.Client {
+	return m.client
+}
+
+// QueueName returns name of replication queue
+func (m *ReplicaManager) QueueName() string {
+	return "{replicate_repo}replicate_queue"
+}
+
+// AckQueueName returns name of the ack queue of the replication queue
+func (m *ReplicaManager) AckQueueName() string {
+	return "{replicate_repo}ack_queue"
+}
+
+// QueueMsg returns msg of replicating a file to a region
+func (m *ReplicaManager) QueueMsg(region string, bizID uint32, sign string) string {
+	return fmt.Sprintf("%s|%d|%s", region, bizID, sign)
+}
+
+// ParseQueueMsg parses msg of replication queue
+func (m *ReplicaManager) ParseQueueMsg(msg string) (string, uint32, string, error) {
+	elements := strings.Split(msg, "|")
+	if len(elements) != 3 {
+		return "", 0, "", fmt.Errorf("parse queue msg into three elements by '|' failed, msg: %s", msg)
+	}
+
+	bizID, err := strconv.ParseUint(elements[1], 10, 32)
+	if err != nil {
+		return "", 0, "", fmt.Errorf("parse queue msg's bizID failed, msg: %s, bizID: %s", msg, elements[1])
+	}
+	return elements[0], uint32(bizID), elements[2], nil
+}
+
+// PushToQueue pushes the file to the replication queue of every region
+func (m *ReplicaManager) PushToQueue(kt *kit.Kit, sign string) {
+	for region := range m.replicas {
+		if err := m.client.LPush(kt.Ctx, m.QueueName(), m.QueueMsg(region, kt.BizID, sign)); err != nil {
+			logs.Errorf("push file %s to region %s replication queue failed, err: %v, rid: %s", sign, region,
+				err, kt.Rid)
+		}
+	}
+}
+
+// Replicate replicates file from the source repo to the region's replica
+func (m *ReplicaManager) Replicate(kt *kit.Kit, region, sign string) (skip bool, err error) {
+	replica, ok := m.replicas[region]
+	if !ok {
+		return true, nil
+	}
+
+	_, err = replica.Metadata(kt, sign)
+	// the file already exists in the replica, return directly
+	if err == nil {
+		return true, nil
+	}
+	if err != errf.ErrFileContentNotFound {
+		return false, fmt.Errorf("replicate file to region %s failed, replica metadata err: %v", region, err)
+	}
+
+	reader, _, err := m.source.Download(kt, sign)
+	if err != nil {
+		if err == errf.ErrFileContentNotFound {
+			return false, ErrNoFileInMaster
+		}
+		return false, fmt.Errorf("replicate file to region %s failed, source download err: %v", region, err)
+	}
+	defer reader.Close()
+
+	if _, err = replica.Upload(kt, sign, reader); err != nil {
+		return false, fmt.Errorf("replicate file to region %s failed, replica upload err: %v", region, err)
+	}
+
+	return false, nil
+}
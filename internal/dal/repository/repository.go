@@ -27,6 +27,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
 const (
@@ -54,6 +55,20 @@ var (
 	errNotImplemented = errors.New("notImplemented")
 )
 
+// repoTransport returns the transport to access the repo, the proxy and custom CA are set if configured.
+func repoTransport(conf cc.RepoProxy) (http.RoundTripper, error) {
+	if !conf.ProxyConfig().Enable() {
+		return defaultTransport, nil
+	}
+
+	transport, err := tools.NewProxyTransport(defaultTransport.(*http.Transport), conf.ProxyConfig())
+	if err != nil {
+		return nil, errors.Wrap(err, "init repo proxy transport failed")
+	}
+
+	return transport, nil
+}
+
 // MetadataResponse 文件元数据响应
 type MetadataResponse struct {
 	Exists   bool            `json:"exists"`
@@ -219,9 +234,16 @@ type repoProvider struct {
 
 // NewProvider init provider factory by storage type
 func NewProvider(conf cc.Repository) (Provider, error) {
+	var p Provider
+	var err error
 	if conf.EnableHA {
-		return newHAProvider(conf)
+		p, err = newHAProvider(conf)
+	} else {
+		p, err = newMasterProvider(conf)
+	}
+	if err != nil || len(conf.Replicas) == 0 {
+		return p, err
 	}
 
-	return newMasterProvider(conf)
+	return newRegionProvider(conf, p)
 }
@@ -89,10 +89,10 @@ func (s *Sharding) Event() *One {
 	return s.one
 }
 
-// Healthz check mysql healthz.
+// Healthz check database healthz.
 func (s *Sharding) Healthz() error {
 	if err := s.one.db.Ping(); err != nil {
-		return errors.New("mysql ping failed, err: " + err.Error())
+		return errors.New("database ping failed, err: " + err.Error())
 	}
 
 	return nil
@@ -135,8 +135,12 @@ func MustShardingAuditor(adminDB *gorm.DB) *gorm.DB {
 
 // InitAuditorSharding 审计表分库
 func InitAuditorSharding(adminDB *gorm.DB) (*gorm.DB, error) {
-	// 初始化配置
-	conf := adminDB.Dialector.(*mysql.Dialector).Config.DSNConfig.Clone()
+	// 初始化配置, 审计分库只支持 mysql
+	dialector, ok := adminDB.Dialector.(*mysql.Dialector)
+	if !ok {
+		return nil, errors.New("auditor sharding only supports mysql")
+	}
+	conf := dialector.Config.DSNConfig.Clone()
 	conf.DBName = "bk_bscp_auditor"
 
 	auditorDB, err := gorm.Open(mysql.Open(conf.FormatDSN()), &gorm.Config{Logger: logger.Default.LogMode(logger.Info)})
@@ -46,6 +46,12 @@ func (expr CustomExpr) Build(builder clause.Builder) {
 		idx              int
 	)
 
+	// the sql may quote the identifiers with backquote, replace it with double quote for postgres.
+	quote := byte('`')
+	if builderDialect(builder) == postgresDialect {
+		quote = '"'
+	}
+
 	for _, v := range []byte(expr.SQL) {
 		if v == '?' && len(expr.Vars) > idx {
 			if afterParenthesis || expr.WithoutParentheses {
@@ -79,6 +85,9 @@ func (expr CustomExpr) Build(builder clause.Builder) {
 			} else {
 				afterParenthesis = false
 			}
+			if v == '`' {
+				v = quote
+			}
 			_ = builder.WriteByte(v)
 		}
 	}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package utils
+
+import (
+	"encoding/json"
+
+	"gorm.io/gen"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// postgresDialect is the gorm dialector name of postgres.
+	postgresDialect = "postgres"
+	// sqliteDialect is the gorm dialector name of sqlite.
+	sqliteDialect = "sqlite"
+)
+
+// dialectOf returns the gorm dialector name of the db.
+func dialectOf(db *gorm.DB) string {
+	if db == nil || db.Dialector == nil {
+		return ""
+	}
+
+	return db.Dialector.Name()
+}
+
+// IsPostgres test if the db's dialect is postgres.
+func IsPostgres(db *gorm.DB) bool {
+	return dialectOf(db) == postgresDialect
+}
+
+// IsSQLite test if the db's dialect is sqlite.
+func IsSQLite(db *gorm.DB) bool {
+	return dialectOf(db) == sqliteDialect
+}
+
+// builderDialect returns the dialect of the db which the statement is built for.
+func builderDialect(builder clause.Builder) string {
+	stmt, ok := builder.(*gorm.Statement)
+	if !ok {
+		return ""
+	}
+
+	return dialectOf(stmt.DB)
+}
+
+// DialectExpr is an expression whose sql depends on the database dialect, the sql is chosen when the
+// statement is built, so the dao can be shared by mysql, postgres and sqlite.
+type DialectExpr struct {
+	MySQL    string
+	Postgres string
+	// SQLite is the sql of sqlite, Postgres is used if it's empty, because the most of the syntax which
+	// differs from mysql, such as EXCLUDED of upsert, is the same with postgres.
+	SQLite string
+	Vars   []interface{}
+	// PostgresVars is the vars of the postgres sql, Vars is used if it's nil.
+	PostgresVars []interface{}
+	// SQLiteVars is the vars of the sqlite sql, Vars is used if it's nil.
+	SQLiteVars []interface{}
+}
+
+// NewDialectExpr create a dialect expression, the vars are shared by both the sql.
+func NewDialectExpr(mysql, postgres string, vars ...interface{}) DialectExpr {
+	return DialectExpr{MySQL: mysql, Postgres: postgres, Vars: vars}
+}
+
+// Build build the expression with the sql of the statement's dialect.
+func (e DialectExpr) Build(builder clause.Builder) {
+	sql, vars := e.MySQL, e.Vars
+	switch builderDialect(builder) {
+	case postgresDialect:
+		sql = e.Postgres
+		if e.PostgresVars != nil {
+			vars = e.PostgresVars
+		}
+	case sqliteDialect:
+		sql = e.Postgres
+		if e.PostgresVars != nil {
+			vars = e.PostgresVars
+		}
+		if e.SQLite != "" {
+			sql, vars = e.SQLite, e.Vars
+			if e.SQLiteVars != nil {
+				vars = e.SQLiteVars
+			}
+		}
+	}
+
+	clause.Expr{SQL: sql, Vars: vars}.Build(builder)
+}
+
+// JSONContains returns the condition that the json column contains the value, the value is a json
+// string, e.g: "1" or `{"k":"v"}`.
+// mysql: JSON_CONTAINS(column, value)
+// postgres: column::jsonb @> value::jsonb
+// sqlite: the column equals to the value or the column's array elements contain the value
+func JSONContains(column Field, value string) gen.Condition {
+	return &rawCond{
+		sql:   "JSON_CONTAINS(?,?)",
+		pgSQL: "CAST(? AS jsonb) @> CAST(? AS jsonb)",
+		sqliteSQL: "EXISTS (SELECT 1 FROM (SELECT ? AS doc, ? AS val) AS p WHERE json(p.doc) = json(p.val) " +
+			"OR EXISTS (SELECT 1 FROM json_each(p.doc) WHERE json_each.value = json_extract(p.val, '$')))",
+		args: []interface{}{column, value},
+	}
+}
+
+// postgres can not infer the type of the parameters in jsonb functions, so the values are marshaled
+// to json in advance.
+func jsonString(v interface{}) string {
+	js, _ := json.Marshal(v)
+	return string(js)
+}
+
+// JSONArrayContains returns the expression that the json array column contains the value, it's used
+// instead of datatypes.JSONArrayQuery which only supports mysql.
+func JSONArrayContains(column string, value interface{}) clause.Expression {
+	col := clause.Column{Name: column}
+	return DialectExpr{
+		MySQL:        "JSON_CONTAINS(?, JSON_ARRAY(?))",
+		Postgres:     "CAST(? AS jsonb) @> CAST(? AS jsonb)",
+		SQLite:       "EXISTS (SELECT 1 FROM json_each(?) WHERE json_each.value = ?)",
+		Vars:         []interface{}{col, value},
+		PostgresVars: []interface{}{col, jsonString([]interface{}{value})},
+	}
+}
+
+// JSONArrayAppend returns the expression that appends the value to the json array column.
+func JSONArrayAppend(column string, value interface{}) clause.Expression {
+	col := clause.Column{Name: column}
+	return DialectExpr{
+		MySQL:        "JSON_ARRAY_APPEND(?, '$', ?)",
+		Postgres:     "CAST(? AS jsonb) || CAST(? AS jsonb)",
+		SQLite:       "json_insert(?, '$[#]', ?)",
+		Vars:         []interface{}{col, value},
+		PostgresVars: []interface{}{col, jsonString([]interface{}{value})},
+	}
+}
+
+// JSONArrayRemoveUint returns the expression that removes the unsigned integer value from the json array
+// column, the result is '[]' if no element is left.
+func JSONArrayRemoveUint(column string, value uint32) clause.Expression {
+	col := clause.Column{Name: column}
+	return DialectExpr{
+		MySQL: "COALESCE ((SELECT JSON_ARRAYAGG(oid) new_oids FROM " +
+			"JSON_TABLE (?, '$[*]' COLUMNS (oid BIGINT (1) UNSIGNED PATH '$')) AS t1 WHERE oid<> ?), '[]')",
+		Postgres: "COALESCE ((SELECT jsonb_agg(elem) FROM jsonb_array_elements(CAST(? AS jsonb)) AS t1(elem) " +
+			"WHERE elem <> CAST(? AS jsonb)), '[]'::jsonb)",
+		SQLite: "COALESCE ((SELECT json_group_array(json_each.value) FROM json_each(?) " +
+			"WHERE json_each.value <> ?), '[]')",
+		Vars:         []interface{}{col, value},
+		PostgresVars: []interface{}{col, jsonString(value)},
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package utils
+
+import (
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type dialectTestTable struct {
+	ID          uint32
+	TemplateIDs string
+}
+
+func dryRunSQL(t *testing.T, dialector gorm.Dialector, expr clause.Expression) string {
+	db, err := gorm.Open(dialector, &gorm.Config{DryRun: true, DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("open db failed, err: %v", err)
+	}
+	stmt := db.Model(&dialectTestTable{}).Where(expr).Find(&[]dialectTestTable{}).Statement
+	return stmt.SQL.String()
+}
+
+func TestJSONArrayContains(t *testing.T) {
+	expr := JSONArrayContains("template_ids", uint32(1))
+
+	mysqlSQL := dryRunSQL(t, mysql.New(mysql.Config{SkipInitializeWithVersion: true}), expr)
+	want := "SELECT * FROM `dialect_test_tables` WHERE JSON_CONTAINS(`template_ids`, JSON_ARRAY(?))"
+	if mysqlSQL != want {
+		t.Errorf("mysql sql want: %s, got: %s", want, mysqlSQL)
+	}
+
+	pgSQL := dryRunSQL(t, postgres.New(postgres.Config{DSN: "host=localhost"}), expr)
+	want = `SELECT * FROM "dialect_test_tables" WHERE CAST("template_ids" AS jsonb) @> CAST($1 AS jsonb)`
+	if pgSQL != want {
+		t.Errorf("postgres sql want: %s, got: %s", want, pgSQL)
+	}
+}
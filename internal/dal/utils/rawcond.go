@@ -31,8 +31,12 @@ type Field struct {
 }
 type rawCond struct {
 	field.Field
-	sql  string
-	args []interface{}
+	sql string
+	// pgSQL is the sql used for postgres, sql is used if it's empty.
+	pgSQL string
+	// sqliteSQL is the sql used for sqlite, pgSQL is used if it's empty.
+	sqliteSQL string
+	args      []interface{}
 }
 
 // BeCond xxx
@@ -64,6 +68,10 @@ func (m rawCond) BeCond() interface{} {
 		}
 	}
 
+	if m.pgSQL != "" {
+		return DialectExpr{MySQL: m.sql, Postgres: m.pgSQL, SQLite: m.sqliteSQL, Vars: args}
+	}
+
 	expr := clause.NamedExpr{SQL: m.sql}
 	expr.Vars = append(expr.Vars, args...)
 	return expr
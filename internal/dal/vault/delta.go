@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+const (
+	// deltaMinSize 值的大小超过该阈值时才尝试增量存储
+	deltaMinSize = 4 * 1024
+	// maxDeltaDepth 增量链的最大长度, 超过后存储全量值, 避免读取时回溯过多版本
+	maxDeltaDepth = 5
+)
+
+// deltaOp 增量操作, Op 为 c 时复制基准值的 [Start, End) 行, 为 i 时插入 Text
+type deltaOp struct {
+	Op    string `json:"o"`
+	Start int    `json:"s,omitempty"`
+	End   int    `json:"e,omitempty"`
+	Text  string `json:"t,omitempty"`
+}
+
+// DeltaSupported 只有文本类的大值才做增量存储
+func DeltaSupported(kvType table.DataType, value string) bool {
+	if len(value) < deltaMinSize {
+		return false
+	}
+	switch kvType {
+	case table.KvJson, table.KvYAML, table.KvText, table.KvXml:
+		return true
+	}
+	return false
+}
+
+// makeDelta 计算 target 相对于 base 的按行增量, 增量不足以节省一半空间时返回 false
+func makeDelta(base, target string) (string, bool) {
+	a, b := strings.SplitAfter(base, "\n"), strings.SplitAfter(target, "\n")
+	ops := make([]deltaOp, 0)
+	for _, c := range difflib.NewMatcher(a, b).GetOpCodes() {
+		switch c.Tag {
+		case 'e':
+			ops = append(ops, deltaOp{Op: "c", Start: c.I1, End: c.I2})
+		case 'r', 'i':
+			ops = append(ops, deltaOp{Op: "i", Text: strings.Join(b[c.J1:c.J2], "")})
+		}
+	}
+
+	delta, err := json.Marshal(ops)
+	if err != nil || len(delta) > len(target)/2 {
+		return "", false
+	}
+	return string(delta), true
+}
+
+// applyDelta 基于 base 还原增量存储的值
+func applyDelta(base, delta string) (string, error) {
+	ops := make([]deltaOp, 0)
+	if err := json.Unmarshal([]byte(delta), &ops); err != nil {
+		return "", fmt.Errorf("unmarshal kv delta failed, err: %v", err)
+	}
+
+	lines := strings.SplitAfter(base, "\n")
+	var sb strings.Builder
+	for _, op := range ops {
+		switch op.Op {
+		case "c":
+			if op.Start < 0 || op.End > len(lines) || op.Start > op.End {
+				return "", fmt.Errorf("kv delta range [%d, %d) out of base lines %d", op.Start, op.End, len(lines))
+			}
+			sb.WriteString(strings.Join(lines[op.Start:op.End], ""))
+		case "i":
+			sb.WriteString(op.Text)
+		default:
+			return "", fmt.Errorf("unknown kv delta op %s", op.Op)
+		}
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+func TestDelta(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; i < 500; i++ {
+		sb.WriteString(fmt.Sprintf("key_%d: value_%d\n", i, i))
+	}
+	base := sb.String()
+	target := strings.Replace(base, "key_10: value_10\n", "key_10: changed\nkey_new: added\n", 1)
+	target = strings.Replace(target, "key_20: value_20\n", "", 1) + "tail without newline"
+
+	if !DeltaSupported(table.KvYAML, target) {
+		t.Fatal("large yaml value should support delta")
+	}
+	if DeltaSupported(table.KvNumber, target) || DeltaSupported(table.KvYAML, "a: b") {
+		t.Error("number or small value should not support delta")
+	}
+
+	delta, ok := makeDelta(base, target)
+	if !ok {
+		t.Fatal("delta should be smaller than the target value")
+	}
+	got, err := applyDelta(base, delta)
+	if err != nil {
+		t.Fatalf("apply delta failed, err: %v", err)
+	}
+	if got != target {
+		t.Error("the value restored from delta is not the same as the target")
+	}
+
+	// 完全不同的值不做增量存储
+	if _, ok = makeDelta(base, strings.ToUpper(base)); ok {
+		t.Error("delta of totally different values should not be used")
+	}
+
+	if _, err = applyDelta("a\n", `[{"o":"c","s":0,"e":3}]`); err == nil {
+		t.Error("out of range delta should be invalid")
+	}
+}
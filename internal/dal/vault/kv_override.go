@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package vault
+
+import (
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/types"
+)
+
+const (
+	// kvOverridePath kv override path
+	kvOverridePath = "biz/%d/apps/%d/kv_overrides/%d/%s"
+	// releasedKvOverridePath released kv override path
+	releasedKvOverridePath = "biz/%d/apps/%d/releases/%d/kv_overrides/%d/%s"
+)
+
+func kvOverrideSecretPath(bizID, appID, releaseID, groupID uint32, key string) string {
+	if releaseID > 0 {
+		return fmt.Sprintf(releasedKvOverridePath, bizID, appID, releaseID, groupID, key)
+	}
+	return fmt.Sprintf(kvOverridePath, bizID, appID, groupID, key)
+}
+
+// UpsertKvOverride 创建｜更新 kv 覆盖值
+func (s *set) UpsertKvOverride(kit *kit.Kit, opt *types.UpsertKvOverrideOption) (int, error) {
+	if err := opt.Validate(); err != nil {
+		return 0, err
+	}
+
+	data := map[string]interface{}{
+		"kv_type": opt.KvType,
+		"value":   opt.Value,
+	}
+	secret, err := s.cli.KVv2(MountPath).Put(kit.Ctx,
+		kvOverrideSecretPath(opt.BizID, opt.AppID, opt.ReleaseID, opt.GroupID, opt.Key), data)
+	if err != nil {
+		return 0, err
+	}
+
+	return secret.VersionMetadata.Version, nil
+}
+
+// GetKvOverride 根据版本获取 kv 覆盖值
+func (s *set) GetKvOverride(kit *kit.Kit, opt *types.GetKvOverrideOption) (kvType table.DataType, value string,
+	err error) {
+
+	if err = opt.Validate(); err != nil {
+		return
+	}
+
+	kv, err := s.cli.KVv2(MountPath).GetVersion(kit.Ctx,
+		kvOverrideSecretPath(opt.BizID, opt.AppID, opt.ReleaseID, opt.GroupID, opt.Key), opt.Version)
+	if err != nil {
+		return
+	}
+
+	kvTypeStr, ok := kv.Data["kv_type"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("failed to get 'kv_type' as a string from kv override data")
+	}
+
+	value, ok = kv.Data["value"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("kv override value type assertion failed")
+	}
+
+	return table.DataType(kvTypeStr), value, nil
+}
+
+// DeleteKvOverride 删除 kv 覆盖值
+func (s *set) DeleteKvOverride(kit *kit.Kit, opt *types.DeleteKvOverrideOption) error {
+	if err := opt.Validate(); err != nil {
+		return err
+	}
+
+	return s.cli.KVv2(MountPath).DeleteMetadata(kit.Ctx,
+		kvOverrideSecretPath(opt.BizID, opt.AppID, 0, opt.GroupID, opt.Key))
+}
@@ -13,10 +13,13 @@
 package vault
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	"github.com/TencentBlueKing/bk-bscp/pkg/types"
 )
 
@@ -30,6 +33,9 @@ func (s *set) CreateRKv(kit *kit.Kit, opt *types.CreateReleasedKvOption) (int, e
 		"kv_type": opt.KvType,
 		"value":   opt.Value,
 	}
+	if delta := s.tryDelta(kit, opt); delta != nil {
+		data = delta
+	}
 	version, err := s.cli.KVv2(MountPath).Put(kit.Ctx,
 		fmt.Sprintf(releasedKvPath, opt.BizID, opt.AppID, opt.ReleaseID, opt.Key), data)
 	if err != nil {
@@ -52,6 +58,10 @@ func (s *set) GetRKv(kit *kit.Kit, opt *types.GetRKvOption) (kvType table.DataTy
 		return
 	}
 
+	if delta, ok := kv.Data["delta"].(string); ok {
+		return s.getDeltaRKv(kit, opt, kv.Data, delta)
+	}
+
 	kvTypeStr, ok := kv.Data["kv_type"].(string)
 	if !ok {
 		return "", "", fmt.Errorf("failed to get 'kv_type' as a string from kv.Data,"+
@@ -66,3 +76,97 @@ func (s *set) GetRKv(kit *kit.Kit, opt *types.GetRKvOption) (kvType table.DataTy
 
 	return kvType, value, nil
 }
+
+// tryDelta 尝试以相对于上一个版本的增量存储已生成版本的kv, 不满足条件时返回 nil 存储全量值
+func (s *set) tryDelta(kit *kit.Kit, opt *types.CreateReleasedKvOption) map[string]interface{} {
+	if opt.Base == nil || opt.Base.ReleaseID == 0 || !DeltaSupported(opt.KvType, opt.Value) {
+		return nil
+	}
+
+	base, err := s.cli.KVv2(MountPath).GetVersion(kit.Ctx, fmt.Sprintf(releasedKvPath, opt.BizID, opt.AppID,
+		opt.Base.ReleaseID, opt.Key), opt.Base.Version)
+	if err != nil {
+		logs.Warnf("get base released kv %s failed, store the full value, err: %v, rid: %s", opt.Key, err, kit.Rid)
+		return nil
+	}
+	depth := deltaDepth(base.Data)
+	if depth >= maxDeltaDepth {
+		return nil
+	}
+
+	delta, ok := makeDelta(opt.Base.Value, opt.Value)
+	if !ok {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"kv_type":         opt.KvType,
+		"delta":           delta,
+		"base_release_id": opt.Base.ReleaseID,
+		"base_version":    opt.Base.Version,
+		"delta_depth":     depth + 1,
+	}
+}
+
+// getDeltaRKv 读取基准版本的值并还原增量存储的kv
+func (s *set) getDeltaRKv(kit *kit.Kit, opt *types.GetRKvOption, data map[string]interface{}, delta string) (
+	table.DataType, string, error) {
+
+	kvTypeStr, ok := data["kv_type"].(string)
+	if !ok {
+		return "", "", errors.New("failed to get 'kv_type' as a string from delta kv data")
+	}
+
+	baseReleaseID, err := jsonNumber(data["base_release_id"])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid base_release_id of delta kv, err: %v", err)
+	}
+	baseVersion, err := jsonNumber(data["base_version"])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid base_version of delta kv, err: %v", err)
+	}
+
+	_, base, err := s.GetRKv(kit, &types.GetRKvOption{
+		BizID:      opt.BizID,
+		AppID:      opt.AppID,
+		Key:        opt.Key,
+		ReleasedID: uint32(baseReleaseID),
+		Version:    int(baseVersion),
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("get base released kv failed, err: %v", err)
+	}
+
+	value, err := applyDelta(base, delta)
+	if err != nil {
+		return "", "", err
+	}
+
+	return table.DataType(kvTypeStr), value, nil
+}
+
+// deltaDepth 返回已存储kv在增量链中的深度, 全量存储时为 0
+func deltaDepth(data map[string]interface{}) int {
+	if _, ok := data["delta"]; !ok {
+		return 0
+	}
+	depth, err := jsonNumber(data["delta_depth"])
+	if err != nil {
+		// 无法确认深度时按最大深度处理, 使下一个版本存储全量值
+		return maxDeltaDepth
+	}
+	return int(depth)
+}
+
+// jsonNumber vault 返回的数字类型为 json.Number
+func jsonNumber(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case int:
+		return int64(n), nil
+	}
+	return 0, fmt.Errorf("unsupported number type %T", v)
+}
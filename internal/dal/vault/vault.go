@@ -40,6 +40,12 @@ type Set interface {
 	CreateRKv(kit *kit.Kit, opt *types.CreateReleasedKvOption) (int, error)
 	// GetRKv get released kv
 	GetRKv(kit *kit.Kit, opt *types.GetRKvOption) (kvType table.DataType, value string, err error)
+	// UpsertKvOverride 创建｜更新 kv 覆盖值，设置了版本 ID 时保存为已生成版本的覆盖值
+	UpsertKvOverride(kit *kit.Kit, opt *types.UpsertKvOverrideOption) (int, error)
+	// GetKvOverride 根据版本获取 kv 覆盖值
+	GetKvOverride(kit *kit.Kit, opt *types.GetKvOverrideOption) (kvType table.DataType, value string, err error)
+	// DeleteKvOverride 删除 kv 覆盖值
+	DeleteKvOverride(kit *kit.Kit, opt *types.DeleteKvOverrideOption) error
 }
 
 type set struct {
@@ -39,39 +39,50 @@ func init() {
 }
 
 var messageKeyToIndex = map[string]int{
-	"%s and %s path file conflict":                                                        237,
-	"%s sub path is system reserved path, do not allow to use":                            194,
+	"%s and %s path file conflict":                                                        233,
+	"%s sub path is system reserved path, do not allow to use":                            190,
 	"%s type not supported":                                                               27,
-	"Unnamed Version":                                                                     150,
+	"Unnamed Version":                                                                     147,
+	"alert rule name %s already exists":                                                   298,
+	"alert rule spec is required":                                                         299,
 	"app %d not found":                                                                    54,
+	"app %s is archived, clients can not pull any release":                                281,
 	"app %s is not file type":                                                             69,
 	"app alias %s already exists":                                                         49,
-	"app is nil":                                                                          142,
+	"app is already in state %s":                                                          253,
+	"app is nil":                                                                          139,
 	"app name %s already exists":                                                          48,
 	"app related biz %d is not exist":                                                     56,
-	"app spec is nil":                                                                     214,
-	"app's type can not be updated":                                                       216,
-	"appID can not be 0":                                                                  151,
-	"approval has been revoked, invalid tikcet status: %s":                                122,
-	"attachment not set":                                                                  182,
-	"authorize failed":                                                                    175,
-	"batch add templates to template sets failed, err: %s":                                125,
+	"app spec is nil":                                                                     210,
+	"app state can not be changed from %s to %s":                                          254,
+	"app template binding not found, biz id: %d, app id: %d":                              242,
+	"app's type can not be updated":                                                       212,
+	"appID can not be 0":                                                                  148,
+	"approval has been revoked, invalid tikcet status: %s":                                121,
+	"attachment not set":                                                                  178,
+	"authorize failed":                                                                    171,
+	"batch add templates to template sets failed, err: %s":                                124,
 	"batch create contents failed, err: %s":                                               72,
 	"batch delete config items failed":                                                    18,
 	"batch delete failed":                                                                 22,
 	"batch delete groups failed":                                                          20,
-	"batch import of KV config failed, err: %v":                                           99,
+	"batch import of KV config failed, err: %v":                                           98,
 	"batch recovery config items failed":                                                  19,
 	"batch recovery kv failed":                                                            40,
-	"batch update app template binding's failed, err: %s":                                 124,
-	"batch update app template binding's failed, err: %v":                                 130,
-	"batch upsert vault failed, err: %v":                                                  102,
-	"biz list is empty":                                                                   118,
-	"bizID can not be 0":                                                                  152,
+	"batch update app template binding's failed, err: %s":                                 123,
+	"batch update app template binding's failed, err: %v":                                 314,
+	"batch upsert vault failed, err: %v":                                                  101,
+	"bindings can't be empty":                                                             238,
+	"biz id and app id are required":                                                      280,
+	"biz list is empty":                                                                   117,
+	"bizID can not be 0":                                                                  149,
 	"business query failed, err: %v":                                                      55,
-	"clearing draft area failed, err: %v":                                                 101,
+	"change ticket %s is invalid, %v":                                                     292,
+	"clearing draft area failed, err: %v":                                                 100,
+	"client %s %s is already quarantined":                                                 304,
 	"client ids is empty":                                                                 17,
-	"commit spec's content is empty":                                                      223,
+	"client quarantine spec is required":                                                  302,
+	"commit spec's content is empty":                                                      219,
 	"config item %s format error":                                                         30,
 	"config item %s json format error":                                                    31,
 	"config item %s kv type error":                                                        28,
@@ -79,207 +90,276 @@ var messageKeyToIndex = map[string]int{
 	"config item %s secret type error, err: %v":                                           35,
 	"config item %s value error":                                                          36,
 	"config item %s yaml format error":                                                    32,
-	"content id can not set":                                                              229,
-	"content signature should be lowercase":                                               231,
-	"count app %d's config items failed, err: %v":                                         146,
-	"count the number of app configs failed, err: %s":                                     136,
-	"count the number of kV files that have not been deleted failed, err: %v":             105,
+	"content id can not set":                                                              225,
+	"content signature should be lowercase":                                               227,
+	"count app %d's config items failed, err: %v":                                         143,
+	"count the number of app configs failed, err: %s":                                     133,
+	"count the number of kV files that have not been deleted failed, err: %v":             104,
 	"count the number of service configurations failed, err: %s":                          68,
-	"create app failed, err: %v":                                                          144,
-	"create data failed, err: %v":                                                         143,
+	"create app failed, err: %v":                                                          141,
+	"create data failed, err: %v":                                                         140,
 	"create directory failed, err: %v":                                                    3,
-	"create kv failed, err: %v":                                                           94,
+	"create kv failed, err: %v":                                                           93,
 	"create release failed there is a certificate expiration exists":                      42,
 	"create release failed there is a file conflict":                                      41,
 	"create temporary directory failed, err: %v":                                          4,
-	"db operation failed":                                                                 178,
+	"db operation failed":                                                                 174,
 	"decompress file failed, exceeding the maximum file limit threshold of %d":            13,
 	"decompress the file. The size of file %s exceeds the maximum limit of %s":            5,
 	"decompression failed, err: %v":                                                       6,
-	"default_val %s is not a number type":                                                 236,
+	"default_val %s is not a number type":                                                 232,
 	"delete app failed, err: %v":                                                          53,
 	"delete app related resources failed, err: %v":                                        52,
+	"delete kv failed, err: %v":                                                           248,
 	"delete one app template binding instance by app id failed, err: %s":                  71,
 	"delete one app template variable failed, err: %s":                                    73,
 	"delete template from template sets failed":                                           43,
-	"delete template from template sets failed, err: %v":                                  128,
+	"delete template from template sets failed, err: %v":                                  127,
+	"duration hours should be between 1 and %d":                                           269,
+	"experiment spec is required":                                                         308,
+	"file type app does not support kvs":                                                  272,
 	"format error, please check the key: %s":                                              29,
-	"get 'kv_type' as a string \n\t\tfrom kv.Data failed, err: %v":                        173,
-	"get a list of expired certificates failed, err: %v":                                  96,
-	"get app %d's template binding failed, err: %v":                                       147,
-	"get app fail, key: %s, err: %v":                                                      92,
+	"get 'kv_type' as a string \n\t\tfrom kv.Data failed, err: %v":                        169,
+	"get a list of expired certificates failed, err: %v":                                  95,
+	"get app %d's template binding failed, err: %v":                                       144,
+	"get app fail, key: %s, err: %v":                                                      91,
 	"get app failed, err: %v":                                                             50,
-	"get app template bindings by template set ids, err: %s":                              123,
-	"get app template bindings by template set ids, err: %v":                              129,
+	"get app template bindings by template set ids, err: %s":                              122,
+	"get app template bindings by template set ids, err: %v":                              315,
 	"get config item failed, err: %v":                                                     74,
-	"get excluded hook failed, err: %s":                                                   88,
-	"get excluded kv failed, err: %s":                                                     104,
-	"get kv (%d) failed, err: %v":                                                         90,
-	"get permission to apply failed, err: %v":                                             176,
+	"get excluded hook failed, err: %s":                                                   87,
+	"get excluded kv failed, err: %s":                                                     103,
+	"get kv (%d) failed, err: %v":                                                         89,
+	"get permission to apply failed, err: %v":                                             172,
 	"get records greater than the latest released version failed, err: %v":                80,
 	"get reference template set under this app failed, err: %s":                           84,
 	"get template binding relationships through business and service IDs failed, err: %s": 83,
-	"get template count failed, err: %v":                                                  169,
-	"get template failed, err: %v":                                                        162,
-	"get template release failed, err: %v":                                                164,
-	"get template set count failed, err: %v":                                              167,
-	"get template set data failed, err: %s":                                               126,
-	"get template set failed, err: %s":                                                    133,
-	"get template set failed, err: %v":                                                    166,
+	"get template count failed, err: %v":                                                  165,
+	"get template failed, err: %v":                                                        159,
+	"get template release failed, err: %v":                                                161,
+	"get template set count failed, err: %v":                                              163,
+	"get template set data failed, err: %s":                                               125,
+	"get template set failed, err: %s":                                                    130,
+	"get template set failed, err: %v":                                                    316,
 	"get the current number of service config items failed, err: %v":                      12,
 	"get the latest released version failed, err: %v":                                     76,
 	"get the published config failed, err: %v":                                            77,
-	"grpc status with details failed, err: %v":                                            177,
-	"hook is nil":                      149,
-	"hook name %s already exists":      87,
-	"id can not be set":                211,
-	"id is required":                   0,
-	"id should not be set":             180,
-	"invalid app id":                   234,
-	"invalid argument":                 179,
-	"invalid biz id":                   212,
-	"invalid commit spec's content id": 222,
-	"invalid config item id":           235,
-	"invalid content signature, should be config's sha256 value": 230,
-	"invalid data-type":                       220,
-	"invalid file name":                       1,
-	"invalid memo, length should <= 200":      196,
-	"invalid name %s, name cannot all be '.'": 205,
-	"invalid name %s, there can't be any /":   206,
-	"invalid name, length should <= 128":      199,
-	"invalid name, length should <= 64":       204,
-	"invalid name, length should >= 1":        198,
-	"invalid name, length should >= 9 and must start with prefix bk_bscp_ (ignore case)":                                                                      201,
-	"invalid name: %s, only allows to include Chinese, English,numbers, underscore (_),hyphen (-), and must start and end with Chinese, English, or a number": 203,
-	"invalid name: %s, only allows to include english、numbers、underscore (_), and must start with prefix bk_bscp_ (ignore case)":                              202,
-	"invalid name: %s, only allows to include english、numbers、underscore (_)、hyphen (-), and must start and end with an english、numbers":                      200,
-	"invalid namespace, length should <= 128":                                               208,
-	"invalid namespace, length should >= 1":                                                 207,
-	"invalid origin content signature, should be config's sha256 value":                     232,
-	"invalid path %s, length should <= 1024":                                                185,
-	"invalid path %s, length should >= 1":                                                   184,
-	"invalid path %s, the path must start with '/'":                                         186,
-	"invalid path %s, the pathcannot contain consecutive '/'":                               187,
-	"invalid path, length should <= 256":                                                    189,
-	"invalid path, length should >= 1":                                                      188,
-	"invalid path,path does not conform to the win file path format specification":          190,
-	"invalid publish_status: %s":                                                            110,
-	"invalid reload file path, should <= 128":                                               192,
-	"invalid spec, is nil":                                                                  213,
-	"invalid username, length should <= 32":                                                 210,
-	"invalid username, length should >= 1":                                                  209,
+	"group %d is not found":                                                               301,
+	"group %d is not online in the app":                                                   286,
+	"group %d is online with release %d, only the current release can be pinned":          285,
+	"groups %s are pinned, unpin them before publishing":                                  287,
+	"grpc status with details failed, err: %v":                                            173,
+	"hook is nil":                 146,
+	"hook name %s already exists": 86,
+	"id can not be set":           207,
+	"id is required":              0,
+	"id should not be set":        176,
+	"idempotency key %s has been used by a different request":   275,
+	"idempotency key %s is being processed, please retry later": 276,
+	"idempotency key should be 1 to 128 characters":             277,
+	"invalid app id":                   230,
+	"invalid argument":                 175,
+	"invalid biz id":                   208,
+	"invalid biz id or app id":         255,
+	"invalid commit spec's content id": 218,
+	"invalid config item id":           231,
+	"invalid content signature, should be config's sha256 value": 226,
+	"invalid data-type":                                                                  216,
+	"invalid experiment, err: %v":                                                        312,
+	"invalid expired at %s, should be in RFC3339 format":                                 306,
+	"invalid expired time %s, should be RFC3339 format":                                  263,
+	"invalid file name":                                                                  1,
+	"invalid kv expiry, err: %v":                                                         307,
+	"invalid memo, length should <= 200":                                                 192,
+	"invalid min client version %s, should be like v1.2.0":                               305,
+	"invalid name %s, name cannot all be '.'":                                            201,
+	"invalid name %s, there can't be any /":                                              202,
+	"invalid name, length should <= %d":                                                  256,
+	"invalid name, length should <= 128":                                                 195,
+	"invalid name, length should <= 64":                                                  200,
+	"invalid name, length should >= 1":                                                   194,
+	"invalid name, length should >= 9 and must start with prefix bk_bscp_ (ignore case)": 197,
+	"invalid name: %s, only allows to include Chinese, English,numbers, underscore (_),hyphen (-), and must start and end with Chinese, English, or a number": 199,
+	"invalid name: %s, only allows to include english、numbers、underscore (_), and must start with prefix bk_bscp_ (ignore case)":                              198,
+	"invalid name: %s, only allows to include english、numbers、underscore (_)、hyphen (-), and must start and end with an english、numbers":                      196,
+	"invalid namespace, length should <= 128":                                               204,
+	"invalid namespace, length should >= 1":                                                 203,
+	"invalid origin content signature, should be config's sha256 value":                     228,
+	"invalid path %s, length should <= 1024":                                                181,
+	"invalid path %s, length should >= 1":                                                   180,
+	"invalid path %s, the path must start with '/'":                                         182,
+	"invalid path %s, the pathcannot contain consecutive '/'":                               183,
+	"invalid path, length should <= 256":                                                    185,
+	"invalid path, length should >= 1":                                                      184,
+	"invalid path,path does not conform to the win file path format specification":          186,
+	"invalid publish_status: %s":                                                            109,
+	"invalid release id %d, it must bigger than 0":                                          234,
+	"invalid reload file path, should <= 128":                                               188,
+	"invalid service account api key":                                                       260,
+	"invalid spec, is nil":                                                                  209,
+	"invalid template id of bindings member: %d":                                            240,
+	"invalid template revision id of bindings member: %d":                                   241,
+	"invalid template set id of bindings member: %d":                                        239,
+	"invalid username, length should <= 32":                                                 206,
+	"invalid username, length should >= 1":                                                  205,
+	"items and kvs can not be set at the same time":                                         273,
 	"json format error, err: %v":                                                            25,
+	"key %s can not be upserted and deleted at the same time":                               247,
 	"keys is required":                                                                      39,
-	"kv type does not match the data type defined in the application":                       93,
-	"list app template bindings by app ids failed, err: %s":                                 132,
-	"list apps by app ids failed, err: %s":                                                  135,
+	"kv %s already has an experiment %s":                                                    310,
+	"kv %s not exists":                                                                      311,
+	"kv type app only supports kvs":                                                         271,
+	"kv type does not match the data type defined in the application":                       92,
+	"limit has to be greater than 0":                                                        235,
+	"list app template bindings by app ids failed, err: %s":                                 129,
+	"list apps by app ids failed, err: %s":                                                  132,
 	"list config item failed, err: %v":                                                      11,
-	"list kv failed, err: %v":                                                               98,
+	"list kv failed, err: %v":                                                               97,
 	"list template config failed, err: %v":                                                  8,
 	"list template revisions failed, err: %v":                                               65,
-	"list template sets by template set ids failed, err: %s":                                85,
+	"list template sets by template set ids failed, err: %s":                                317,
 	"list template sets by template set ids failed, err: %v":                                58,
 	"list template spaces failed, err: %v":                                                  60,
-	"list templates by tuple failed, err: %v":                                               131,
-	"list templates data failed, err: %s":                                                   138,
+	"list templates by tuple failed, err: %v":                                               128,
+	"list templates data failed, err: %s":                                                   135,
 	"list templates failed, err: %v":                                                        15,
 	"list templates of template set failed, err: %v":                                        63,
-	"list templates revisions data failed, err: %s":                                         140,
-	"memo is required, can not be empty":                                                    195,
-	"no permission to approve":                                                              115,
-	"not a KV type service":                                                                 97,
+	"list templates revisions data failed, err: %s":                                         137,
+	"masking profile spec is required":                                                      313,
+	"memo is required, can not be empty":                                                    191,
+	"name and alias are required":                                                           251,
+	"no permission to approve":                                                              114,
+	"not a KV type service":                                                                 96,
+	"not a key-value type service":                                                          245,
 	"not legal JSON data":                                                                   24,
-	"not support table config type for now":                                                 217,
+	"not support table config type for now":                                                 213,
+	"notification channel %d is not found":                                                  300,
+	"notification channel name %s already exists":                                           294,
 	"obtain the number of configuration items":                                              82,
-	"origin content signature should be lowercase":                                          233,
-	"pass not allowed, current publish status is: %s":                                       116,
+	"only one of app id and credential id should be set":                                    290,
+	"operation %d is not found":                                                             278,
+	"operation %d is not found or already finished":                                         279,
+	"origin content signature should be lowercase":                                          229,
+	"pass not allowed, current publish status is: %s":                                       115,
+	"pause message length should not exceed %d":                                             289,
 	"please fill in the value of configuration item %s first":                               37,
-	"publish not allowed, current publish status is: %s":                                    117,
+	"publish not allowed, current publish status is: %s":                                    116,
 	"read file failed, err: %v":                                                             2,
-	"reason can not empty":                                                                  114,
+	"reason can not empty":                                                                  113,
 	"recover config item failed, err: %v":                                                   79,
 	"recovery failed. A file with the same path exists and is not in a new state":           78,
-	"rejected not allowed, current publish status is: %s":                                   113,
-	"release %s is deprecated, can not be revoke":                                           109,
-	"release %s is deprecated, can not be submited":                                         107,
-	"release name %s already exists":                                                        111,
-	"reload file path is not the absolute path":                                             193,
-	"reload file path is required":                                                          191,
-	"remove the template set bound to the app failed, err: %s":                              86,
-	"resource name '%s' is prefixed with '%s' is reserved name, which is not allows to use": 197,
-	"retrieve the referenced script failed, err: %s":                                        89,
-	"revision not set":                                                                      183,
-	"revoked not allowed, current publish status is: %s":                                    112,
-	"same template variable name %s already exists":                                         141,
-	"save kv failed":                                                                        103,
+	"rejected not allowed, current publish status is: %s":                                   112,
+	"release %s is deprecated, can not be revoke":                                           108,
+	"release %s is deprecated, can not be submited":                                         106,
+	"release name %s already exists":                                                        110,
+	"release name is required":                                                              270,
+	"reload file path is not the absolute path":                                             189,
+	"reload file path is required":                                                          187,
+	"remove the template set bound to the app failed, err: %s":                              85,
+	"repeated template ids: %v, id must be unique":                                          237,
+	"repeated template set ids: %v, id must be unique":                                      236,
+	"resource name '%s' is prefixed with '%s' is reserved name, which is not allows to use": 193,
+	"retrieve the referenced script failed, err: %s":                                        88,
+	"revision not set":                                                                      179,
+	"revoked not allowed, current publish status is: %s":                                    111,
+	"same template variable name %s already exists":                                         138,
+	"save kv failed":                                                                        102,
+	"send notification failed, %v":                                                          296,
 	"sensitive data is not visible, unable to view actual content":                          23,
 	"sensitive information cannot be exported":                                              16,
-	"spec not set":             181,
-	"spec should be set":       221,
-	"template %d is not exist": 161,
-	"template data is empty":   139,
-	"template id in %v is not belong to template set id %d": 172,
-	"template id in %v is not exist":                        156,
-	"template release %d is not exist":                      163,
-	"template revision id in %v is not exist":               158,
-	"template set %d is not exist":                          165,
-	"template set %s not found":                             62,
-	"template set data is empty":                            137,
-	"template set id in %v is not exist":                    160,
-	"template space %s not found":                           61,
-	"template space id in %v is not exist":                  155,
-	"template variable %s type error":                       46,
-	"template variable name must start with %s":             44,
+	"service account %s is disabled":                                                        261,
+	"service account %s is expired":                                                         262,
+	"service account name %s already exists":                                                259,
+	"service account scope actions can not be empty":                                        257,
+	"spec not set":             177,
+	"spec should be set":       217,
+	"template %d is not exist": 158,
+	"template binding and hooks can not be cloned to another biz": 250,
+	"template data is empty": 136,
+	"template id %d is not existent for the app bound templates":     244,
+	"template id in %v is not belong to template set id %d":          168,
+	"template id in %v is not exist":                                 153,
+	"template release %d is not exist":                               160,
+	"template revision id in %v is not exist":                        155,
+	"template set %d is not exist":                                   162,
+	"template set %s not found":                                      62,
+	"template set data is empty":                                     134,
+	"template set id %d is not existent for the app bound templates": 243,
+	"template set id in %v is not exist":                             157,
+	"template space %s not found":                                    61,
+	"template space id in %v is not exist":                           152,
+	"template variable %s type error":                                46,
+	"template variable name must start with %s":                      44,
 	"template version %s in template file %s\n\t\t\t\thas been removed. Please import the set again": 66,
 	"the certificate format is incorrect, only X.509 format is supported":                            38,
+	"the client does not match any published group of the app":                                       282,
 	"the config file %s already exists in this space and cannot be created again":                    57,
-	"the config item %s under this service already exists and cannot be created again":               91,
-	"the data has not been deleted":                                                                             75,
+	"the config item %s under this service already exists and cannot be created again":               90,
+	"the content of config item %s is not uploaded":                                                  274,
+	"the data has not been deleted":                                                                  75,
+	"the default group can not be pinned":                                                            284,
+	"the groups to publish overlap with the online groups of other releases, the instances in the overlapped scope will match the latest published release, please confirm the overlaps to continue: %s": 283,
 	"the key type for config item %s cannot be empty":                                                           34,
 	"the length of hook ids is %d, it must be within the range of [1,%d]":                                       21,
 	"the length of template variable ids is %d, it must be within the range of [1,%d]":                          45,
+	"the number of alert rules exceeds the limit %d":                                                            297,
+	"the number of app ids should be between 1 and %d":                                                          266,
+	"the number of client quarantines exceeds the limit %d":                                                     303,
+	"the number of experiments exceeds the limit %d":                                                            309,
+	"the number of notification channels exceeds the limit %d":                                                  293,
+	"the publish must reference a change ticket":                                                                291,
 	"the specified type does not match the actual configuration":                                                51,
 	"the template file %s in the template set\n\t\t\t\t%s has been removed. Please import the set again":        64,
-	"the total number of app %d's config items(including template and non-template)exceeded the limit %d":       148,
+	"the total number of app %d's config items(including template and non-template)exceeded the limit %d":       145,
 	"the total number of app %s config items(including template and non-template)exceeded the limit %d":         70,
-	"the total number of config items exceeded the limit %d":                                                    106,
+	"the total number of config items exceeded the limit %d":                                                    105,
 	"the total number of config items(including template and non-template)\n\t\t\texceeded the limit %d":        81,
-	"the total number of template set %d's templates exceeded the limit %d":                                     153,
-	"the total number of template set %s templates exceeded the limit %d":                                       134,
-	"the type of config item %s is incorrect":                                                                   100,
+	"the total number of template set %d's templates exceeded the limit %d":                                     150,
+	"the total number of template set %s templates exceeded the limit %d":                                       131,
+	"the two service types do not match":                                                                        246,
+	"the type of config item %s is incorrect":                                                                   99,
 	"the version number %s in the template file %s is not the\n\t\tlatest version. Please import the set again": 67,
-	"there are template sets under the template space, need to delete them first":                               168,
-	"there are templates under the template space, need to delete them first":                                   170,
-	"there is a release in publishing currently":                                                                108,
-	"there is no template file under this template set":                                                         127,
-	"this ticket has been approved, no further processing is required":                                          119,
-	"this ticket has been finished, no further processing is required":                                          121,
-	"this ticket has been revoked, no further processing is required":                                           120,
-	"unknown config type: %s":                                          215,
-	"unsupported app reload type: %s":                                  219,
-	"unsupported config type: %s":                                      218,
-	"unsupported file charset: %s":                                     228,
-	"unsupported file format: %s":                                      226,
-	"unsupported file mode: %s":                                        227,
-	"unsupported variable type: %s":                                    47,
-	"update app failed, err: %s":                                       145,
-	"update app template binding failed, err: %v":                      59,
-	"update kv failed, err: %v":                                        95,
-	"upload completed":                                                 9,
-	"upload completed, %d failed":                                      10,
-	"upload failed, please make sure the file size does not exceed %s": 14,
-	"upload file failed, err: %v":                                      7,
-	"validate template releases exist failed, err: %v":                 157,
-	"validate template sets exist failed, err: %v":                     159,
-	"validate templates exist failed, err: %v":                         154,
-	"validate templates in a template set failed, err: %v":             171,
-	"value type assertion failed, err: %v":                             174,
-	"verify Unix file paths failed, path: %s, err: %v":                 225,
-	"verify Windows file paths failed, path: %s, err: %v":              224,
-	"yaml format error, err: %v":                                       26,
+	"there are template sets under the template space, need to delete them first":                               164,
+	"there are templates under the template space, need to delete them first":                                   166,
+	"there is a release in publishing currently":                                                                107,
+	"there is no template file under this template set":                                                         126,
+	"this is a test message of notification channel %s":                                                         295,
+	"this ticket has been approved, no further processing is required":                                          118,
+	"this ticket has been finished, no further processing is required":                                          120,
+	"this ticket has been revoked, no further processing is required":                                           119,
+	"unknown config type: %s":                                                                                   211,
+	"unsupported app action: %s":                                                                                267,
+	"unsupported app reload type: %s":                                                                           215,
+	"unsupported app role: %s":                                                                                  264,
+	"unsupported app state: %s":                                                                                 252,
+	"unsupported config type: %s":                                                                               214,
+	"unsupported file charset: %s":                                                                              224,
+	"unsupported file format: %s":                                                                               222,
+	"unsupported file mode: %s":                                                                                 223,
+	"unsupported pause mode: %s":                                                                                288,
+	"unsupported service account action: %s":                                                                    258,
+	"unsupported variable type: %s":                                                                             47,
+	"update app failed, err: %s":                                                                                142,
+	"update app template binding failed, err: %v":                                                               59,
+	"update kv failed, err: %v":                                                                                 94,
+	"upload completed":                                                                                          9,
+	"upload completed, %d failed":                                                                               10,
+	"upload failed, please make sure the file size does not exceed %s":                                          14,
+	"upload file failed, err: %v":                                                                               7,
+	"upserts and delete_keys can not both be empty":                                                             249,
+	"user %s already has the permanent role %s in the app":                                                      268,
+	"user is required": 265,
+	"validate template releases exist failed, err: %v":     154,
+	"validate template sets exist failed, err: %v":         156,
+	"validate templates exist failed, err: %v":             151,
+	"validate templates in a template set failed, err: %v": 167,
+	"value type assertion failed, err: %v":                 170,
+	"verify Unix file paths failed, path: %s, err: %v":     221,
+	"verify Windows file paths failed, path: %s, err: %v":  220,
+	"yaml format error, err: %v":                           26,
 }
 
-var enIndex = []uint32{ // 239 elements
+var enIndex = []uint32{ // 319 elements
 	// Entry 0 - 1F
 	0x00000000, 0x0000000f, 0x00000021, 0x0000003e,
 	0x00000062, 0x00000090, 0x000000df, 0x00000100,
@@ -304,53 +384,75 @@ var enIndex = []uint32{ // 239 elements
 	0x00000c7b, 0x00000ca4, 0x00000cd8, 0x00000cfb,
 	0x00000d19, 0x00000d4c, 0x00000d78, 0x00000dc4,
 	0x00000deb, 0x00000e33, 0x00000e95, 0x00000ebe,
-	0x00000f15, 0x00000f52, 0x00000f8c, 0x00000fc8,
-	0x00000fe7, 0x0000100c, 0x0000103e, 0x00001060,
-	0x000010b4, 0x000010d9, 0x00001119, 0x00001136,
+	0x00000f15, 0x00000f52, 0x00000f8e, 0x00000fad,
+	0x00000fd2, 0x00001004, 0x00001026, 0x0000107a,
+	0x0000109f, 0x000010df, 0x000010fc, 0x00001119,
 	// Entry 60 - 7F
-	0x00001153, 0x00001189, 0x0000119f, 0x000011ba,
-	0x000011e7, 0x00001212, 0x00001239, 0x0000125f,
-	0x0000126e, 0x00001291, 0x000012dc, 0x00001316,
-	0x00001347, 0x00001372, 0x000013a1, 0x000013bf,
-	0x000013e1, 0x00001417, 0x0000144e, 0x00001463,
-	0x0000147c, 0x000014af, 0x000014e5, 0x000014f7,
-	0x00001538, 0x00001578, 0x000015b9, 0x000015f1,
-	0x0000162b, 0x00001662, 0x0000169a, 0x000016c3,
+	0x0000114f, 0x00001165, 0x00001180, 0x000011ad,
+	0x000011d8, 0x000011ff, 0x00001225, 0x00001234,
+	0x00001257, 0x000012a2, 0x000012dc, 0x0000130d,
+	0x00001338, 0x00001367, 0x00001385, 0x000013a7,
+	0x000013dd, 0x00001414, 0x00001429, 0x00001442,
+	0x00001475, 0x000014ab, 0x000014bd, 0x000014fe,
+	0x0000153e, 0x0000157f, 0x000015b7, 0x000015f1,
+	0x00001628, 0x00001660, 0x00001689, 0x000016bb,
 	// Entry 80 - 9F
-	0x000016f5, 0x0000172b, 0x00001765, 0x0000179c,
-	0x000017c7, 0x00001800, 0x00001824, 0x0000186e,
-	0x00001896, 0x000018c9, 0x000018e4, 0x0000190b,
-	0x00001922, 0x00001953, 0x00001984, 0x0000198f,
-	0x000019ae, 0x000019cc, 0x000019ea, 0x00001a1c,
-	0x00001a50, 0x00001aba, 0x00001ac6, 0x00001ad6,
-	0x00001ae9, 0x00001afc, 0x00001b48, 0x00001b74,
-	0x00001b9c, 0x00001bbe, 0x00001bf2, 0x00001c1d,
+	0x000016f1, 0x0000171c, 0x00001755, 0x00001779,
+	0x000017c3, 0x000017eb, 0x0000181e, 0x00001839,
+	0x00001860, 0x00001877, 0x000018a8, 0x000018d9,
+	0x000018e4, 0x00001903, 0x00001921, 0x0000193f,
+	0x00001971, 0x000019a5, 0x00001a0f, 0x00001a1b,
+	0x00001a2b, 0x00001a3e, 0x00001a51, 0x00001a9d,
+	0x00001ac9, 0x00001af1, 0x00001b13, 0x00001b47,
+	0x00001b72, 0x00001ba2, 0x00001bc8, 0x00001be4,
 	// Entry A0 - BF
-	0x00001c4d, 0x00001c73, 0x00001c8f, 0x00001caf,
-	0x00001cd3, 0x00001cfb, 0x00001d1b, 0x00001d3f,
-	0x00001d69, 0x00001db5, 0x00001ddb, 0x00001e23,
-	0x00001e5b, 0x00001e97, 0x00001ed4, 0x00001efc,
-	0x00001f0d, 0x00001f38, 0x00001f64, 0x00001f78,
-	0x00001f89, 0x00001f9e, 0x00001fab, 0x00001fbe,
-	0x00001fcf, 0x00001ff6, 0x00002020, 0x00002051,
-	0x0000208c, 0x000020ad, 0x000020d0, 0x0000211d,
+	0x00001c04, 0x00001c28, 0x00001c50, 0x00001c70,
+	0x00001c9a, 0x00001ce6, 0x00001d0c, 0x00001d54,
+	0x00001d8c, 0x00001dc8, 0x00001e05, 0x00001e2d,
+	0x00001e3e, 0x00001e69, 0x00001e95, 0x00001ea9,
+	0x00001eba, 0x00001ecf, 0x00001edc, 0x00001eef,
+	0x00001f00, 0x00001f27, 0x00001f51, 0x00001f82,
+	0x00001fbd, 0x00001fde, 0x00002001, 0x0000204e,
+	0x0000206b, 0x00002093, 0x000020bd, 0x000020f9,
 	// Entry C0 - DF
-	0x0000213a, 0x00002162, 0x0000218c, 0x000021c8,
-	0x000021eb, 0x0000220e, 0x0000226a, 0x0000228b,
-	0x000022ae, 0x0000233c, 0x0000238f, 0x00002411,
-	0x000024ac, 0x000024ce, 0x000024f9, 0x00002522,
-	0x00002548, 0x00002570, 0x00002595, 0x000025bb,
-	0x000025cd, 0x000025dc, 0x000025f1, 0x00002601,
-	0x0000261c, 0x0000263a, 0x00002660, 0x0000267f,
-	0x000026a2, 0x000026b4, 0x000026c7, 0x000026e8,
+	0x0000211c, 0x0000213f, 0x0000219b, 0x000021bc,
+	0x000021df, 0x0000226d, 0x000022c0, 0x00002342,
+	0x000023dd, 0x000023ff, 0x0000242a, 0x00002453,
+	0x00002479, 0x000024a1, 0x000024c6, 0x000024ec,
+	0x000024fe, 0x0000250d, 0x00002522, 0x00002532,
+	0x0000254d, 0x0000256b, 0x00002591, 0x000025b0,
+	0x000025d3, 0x000025e5, 0x000025f8, 0x00002619,
+	0x00002638, 0x00002672, 0x000026a9, 0x000026c8,
 	// Entry E0 - FF
-	0x00002707, 0x00002741, 0x00002778, 0x00002797,
-	0x000027b4, 0x000027d4, 0x000027eb, 0x00002826,
-	0x0000284c, 0x0000288e, 0x000028bb, 0x000028ca,
-	0x000028e1, 0x00002908, 0x0000292b,
-} // Size: 980 bytes
+	0x000026e5, 0x00002705, 0x0000271c, 0x00002757,
+	0x0000277d, 0x000027bf, 0x000027ec, 0x000027fb,
+	0x00002812, 0x00002839, 0x0000285c, 0x0000288c,
+	0x000028ab, 0x000028df, 0x0000290f, 0x00002927,
+	0x00002959, 0x00002987, 0x000029be, 0x000029fb,
+	0x00002a3d, 0x00002a7b, 0x00002a98, 0x00002abb,
+	0x00002af6, 0x00002b13, 0x00002b41, 0x00002b7d,
+	0x00002b99, 0x00002bb6, 0x00002bd4, 0x00002c05,
+	// Entry 100 - 11F
+	0x00002c1e, 0x00002c43, 0x00002c72, 0x00002c9c,
+	0x00002cc6, 0x00002ce6, 0x00002d08, 0x00002d29,
+	0x00002d5e, 0x00002d7a, 0x00002d8b, 0x00002dbf,
+	0x00002ddd, 0x00002e18, 0x00002e45, 0x00002e5e,
+	0x00002e7c, 0x00002e9f, 0x00002ecd, 0x00002efe,
+	0x00002f39, 0x00002f76, 0x00002fa4, 0x00002fc1,
+	0x00002ff2, 0x00003011, 0x00003049, 0x00003082,
+	0x00003148, 0x0000316c, 0x000031bd, 0x000031e2,
+	// Entry 120 - 13F
+	0x00003218, 0x00003236, 0x00003263, 0x00003296,
+	0x000032c1, 0x000032e7, 0x00003323, 0x00003352,
+	0x00003387, 0x000033a7, 0x000033d9, 0x000033fe,
+	0x0000341a, 0x00003442, 0x0000345b, 0x0000347e,
+	0x000034b7, 0x000034e1, 0x00003519, 0x0000354f,
+	0x0000356d, 0x00003589, 0x000035bb, 0x000035e4,
+	0x000035f8, 0x00003617, 0x00003638, 0x0000366f,
+	0x000036a9, 0x000036cd, 0x00003707,
+} // Size: 1300 bytes
 
-const enData string = "" + // Size: 10539 bytes
+const enData string = "" + // Size: 14087 bytes
 	"\x02id is required\x02invalid file name\x02read file failed, err: %[1]v" +
 	"\x02create directory failed, err: %[1]v\x02create temporary directory fa" +
 	"iled, err: %[1]v\x02decompress the file. The size of file %[1]s exceeds " +
@@ -409,64 +511,61 @@ const enData string = "" + // Size: 10539 bytes
 	"(including template and non-template)\x0a\x09\x09\x09exceeded the limit " +
 	"%[1]d\x02obtain the number of configuration items\x02get template bindin" +
 	"g relationships through business and service IDs failed, err: %[1]s\x02g" +
-	"et reference template set under this app failed, err: %[1]s\x02list temp" +
-	"late sets by template set ids failed, err: %[1]s\x02remove the template " +
-	"set bound to the app failed, err: %[1]s\x02hook name %[1]s already exist" +
-	"s\x02get excluded hook failed, err: %[1]s\x02retrieve the referenced scr" +
-	"ipt failed, err: %[1]s\x02get kv (%[1]d) failed, err: %[2]v\x02the confi" +
-	"g item %[1]s under this service already exists and cannot be created aga" +
-	"in\x02get app fail, key: %[1]s, err: %[2]v\x02kv type does not match the" +
-	" data type defined in the application\x02create kv failed, err: %[1]v" +
-	"\x02update kv failed, err: %[1]v\x02get a list of expired certificates f" +
-	"ailed, err: %[1]v\x02not a KV type service\x02list kv failed, err: %[1]v" +
-	"\x02batch import of KV config failed, err: %[1]v\x02the type of config i" +
-	"tem %[1]s is incorrect\x02clearing draft area failed, err: %[1]v\x02batc" +
-	"h upsert vault failed, err: %[1]v\x02save kv failed\x02get excluded kv f" +
-	"ailed, err: %[1]s\x02count the number of kV files that have not been del" +
-	"eted failed, err: %[1]v\x02the total number of config items exceeded the" +
-	" limit %[1]d\x02release %[1]s is deprecated, can not be submited\x02ther" +
-	"e is a release in publishing currently\x02release %[1]s is deprecated, c" +
-	"an not be revoke\x02invalid publish_status: %[1]s\x02release name %[1]s " +
-	"already exists\x02revoked not allowed, current publish status is: %[1]s" +
-	"\x02rejected not allowed, current publish status is: %[1]s\x02reason can" +
-	" not empty\x02no permission to approve\x02pass not allowed, current publ" +
-	"ish status is: %[1]s\x02publish not allowed, current publish status is: " +
-	"%[1]s\x02biz list is empty\x02this ticket has been approved, no further " +
-	"processing is required\x02this ticket has been revoked, no further proce" +
-	"ssing is required\x02this ticket has been finished, no further processin" +
-	"g is required\x02approval has been revoked, invalid tikcet status: %[1]s" +
-	"\x02get app template bindings by template set ids, err: %[1]s\x02batch u" +
-	"pdate app template binding's failed, err: %[1]s\x02batch add templates t" +
-	"o template sets failed, err: %[1]s\x02get template set data failed, err:" +
-	" %[1]s\x02there is no template file under this template set\x02delete te" +
-	"mplate from template sets failed, err: %[1]v\x02get app template binding" +
-	"s by template set ids, err: %[1]v\x02batch update app template binding's" +
-	" failed, err: %[1]v\x02list templates by tuple failed, err: %[1]v\x02lis" +
-	"t app template bindings by app ids failed, err: %[1]s\x02get template se" +
-	"t failed, err: %[1]s\x02the total number of template set %[1]s templates" +
-	" exceeded the limit %[2]d\x02list apps by app ids failed, err: %[1]s\x02" +
-	"count the number of app configs failed, err: %[1]s\x02template set data " +
-	"is empty\x02list templates data failed, err: %[1]s\x02template data is e" +
-	"mpty\x02list templates revisions data failed, err: %[1]s\x02same templat" +
-	"e variable name %[1]s already exists\x02app is nil\x02create data failed" +
-	", err: %[1]v\x02create app failed, err: %[1]v\x02update app failed, err:" +
-	" %[1]s\x02count app %[1]d's config items failed, err: %[2]v\x02get app %" +
-	"[1]d's template binding failed, err: %[2]v\x02the total number of app %[" +
-	"1]d's config items(including template and non-template)exceeded the limi" +
-	"t %[2]d\x02hook is nil\x02Unnamed Version\x02appID can not be 0\x02bizID" +
-	" can not be 0\x02the total number of template set %[1]d's templates exce" +
-	"eded the limit %[2]d\x02validate templates exist failed, err: %[1]v\x02t" +
-	"emplate space id in %[1]v is not exist\x02template id in %[1]v is not ex" +
-	"ist\x02validate template releases exist failed, err: %[1]v\x02template r" +
-	"evision id in %[1]v is not exist\x02validate template sets exist failed," +
-	" err: %[1]v\x02template set id in %[1]v is not exist\x02template %[1]d i" +
-	"s not exist\x02get template failed, err: %[1]v\x02template release %[1]d" +
-	" is not exist\x02get template release failed, err: %[1]v\x02template set" +
-	" %[1]d is not exist\x02get template set failed, err: %[1]v\x02get templa" +
-	"te set count failed, err: %[1]v\x02there are template sets under the tem" +
-	"plate space, need to delete them first\x02get template count failed, err" +
-	": %[1]v\x02there are templates under the template space, need to delete " +
-	"them first\x02validate templates in a template set failed, err: %[1]v" +
+	"et reference template set under this app failed, err: %[1]s\x02remove th" +
+	"e template set bound to the app failed, err: %[1]s\x02hook name %[1]s al" +
+	"ready exists\x02get excluded hook failed, err: %[1]s\x02retrieve the ref" +
+	"erenced script failed, err: %[1]s\x02get kv (%[1]d) failed, err: %[2]v" +
+	"\x02the config item %[1]s under this service already exists and cannot b" +
+	"e created again\x02get app fail, key: %[1]s, err: %[2]v\x02kv type does " +
+	"not match the data type defined in the application\x02create kv failed, " +
+	"err: %[1]v\x02update kv failed, err: %[1]v\x02get a list of expired cert" +
+	"ificates failed, err: %[1]v\x02not a KV type service\x02list kv failed, " +
+	"err: %[1]v\x02batch import of KV config failed, err: %[1]v\x02the type o" +
+	"f config item %[1]s is incorrect\x02clearing draft area failed, err: %[1" +
+	"]v\x02batch upsert vault failed, err: %[1]v\x02save kv failed\x02get exc" +
+	"luded kv failed, err: %[1]s\x02count the number of kV files that have no" +
+	"t been deleted failed, err: %[1]v\x02the total number of config items ex" +
+	"ceeded the limit %[1]d\x02release %[1]s is deprecated, can not be submit" +
+	"ed\x02there is a release in publishing currently\x02release %[1]s is dep" +
+	"recated, can not be revoke\x02invalid publish_status: %[1]s\x02release n" +
+	"ame %[1]s already exists\x02revoked not allowed, current publish status " +
+	"is: %[1]s\x02rejected not allowed, current publish status is: %[1]s\x02r" +
+	"eason can not empty\x02no permission to approve\x02pass not allowed, cur" +
+	"rent publish status is: %[1]s\x02publish not allowed, current publish st" +
+	"atus is: %[1]s\x02biz list is empty\x02this ticket has been approved, no" +
+	" further processing is required\x02this ticket has been revoked, no furt" +
+	"her processing is required\x02this ticket has been finished, no further " +
+	"processing is required\x02approval has been revoked, invalid tikcet stat" +
+	"us: %[1]s\x02get app template bindings by template set ids, err: %[1]s" +
+	"\x02batch update app template binding's failed, err: %[1]s\x02batch add " +
+	"templates to template sets failed, err: %[1]s\x02get template set data f" +
+	"ailed, err: %[1]s\x02there is no template file under this template set" +
+	"\x02delete template from template sets failed, err: %[1]v\x02list templa" +
+	"tes by tuple failed, err: %[1]v\x02list app template bindings by app ids" +
+	" failed, err: %[1]s\x02get template set failed, err: %[1]s\x02the total " +
+	"number of template set %[1]s templates exceeded the limit %[2]d\x02list " +
+	"apps by app ids failed, err: %[1]s\x02count the number of app configs fa" +
+	"iled, err: %[1]s\x02template set data is empty\x02list templates data fa" +
+	"iled, err: %[1]s\x02template data is empty\x02list templates revisions d" +
+	"ata failed, err: %[1]s\x02same template variable name %[1]s already exis" +
+	"ts\x02app is nil\x02create data failed, err: %[1]v\x02create app failed," +
+	" err: %[1]v\x02update app failed, err: %[1]s\x02count app %[1]d's config" +
+	" items failed, err: %[2]v\x02get app %[1]d's template binding failed, er" +
+	"r: %[2]v\x02the total number of app %[1]d's config items(including templ" +
+	"ate and non-template)exceeded the limit %[2]d\x02hook is nil\x02Unnamed " +
+	"Version\x02appID can not be 0\x02bizID can not be 0\x02the total number " +
+	"of template set %[1]d's templates exceeded the limit %[2]d\x02validate t" +
+	"emplates exist failed, err: %[1]v\x02template space id in %[1]v is not e" +
+	"xist\x02template id in %[1]v is not exist\x02validate template releases " +
+	"exist failed, err: %[1]v\x02template revision id in %[1]v is not exist" +
+	"\x02validate template sets exist failed, err: %[1]v\x02template set id i" +
+	"n %[1]v is not exist\x02template %[1]d is not exist\x02get template fail" +
+	"ed, err: %[1]v\x02template release %[1]d is not exist\x02get template re" +
+	"lease failed, err: %[1]v\x02template set %[1]d is not exist\x02get templ" +
+	"ate set count failed, err: %[1]v\x02there are template sets under the te" +
+	"mplate space, need to delete them first\x02get template count failed, er" +
+	"r: %[1]v\x02there are templates under the template space, need to delete" +
+	" them first\x02validate templates in a template set failed, err: %[1]v" +
 	"\x02template id in %[1]v is not belong to template set id %[2]d\x02get '" +
 	"kv_type' as a string \x0a\x09\x09from kv.Data failed, err: %[1]v\x02valu" +
 	"e type assertion failed, err: %[1]v\x02authorize failed\x02get permissio" +
@@ -508,9 +607,64 @@ const enData string = "" + // Size: 10539 bytes
 	"d origin content signature, should be config's sha256 value\x02origin co" +
 	"ntent signature should be lowercase\x02invalid app id\x02invalid config " +
 	"item id\x02default_val %[1]s is not a number type\x02%[1]s and %[2]s pat" +
-	"h file conflict"
+	"h file conflict\x02invalid release id %[1]d, it must bigger than 0\x02li" +
+	"mit has to be greater than 0\x02repeated template set ids: %[1]v, id mus" +
+	"t be unique\x02repeated template ids: %[1]v, id must be unique\x02bindin" +
+	"gs can't be empty\x02invalid template set id of bindings member: %[1]d" +
+	"\x02invalid template id of bindings member: %[1]d\x02invalid template re" +
+	"vision id of bindings member: %[1]d\x02app template binding not found, b" +
+	"iz id: %[1]d, app id: %[2]d\x02template set id %[1]d is not existent for" +
+	" the app bound templates\x02template id %[1]d is not existent for the ap" +
+	"p bound templates\x02not a key-value type service\x02the two service typ" +
+	"es do not match\x02key %[1]s can not be upserted and deleted at the same" +
+	" time\x02delete kv failed, err: %[1]v\x02upserts and delete_keys can not" +
+	" both be empty\x02template binding and hooks can not be cloned to anothe" +
+	"r biz\x02name and alias are required\x02unsupported app state: %[1]s\x02" +
+	"app is already in state %[1]s\x02app state can not be changed from %[1]s" +
+	" to %[2]s\x02invalid biz id or app id\x02invalid name, length should <= " +
+	"%[1]d\x02service account scope actions can not be empty\x02unsupported s" +
+	"ervice account action: %[1]s\x02service account name %[1]s already exist" +
+	"s\x02invalid service account api key\x02service account %[1]s is disable" +
+	"d\x02service account %[1]s is expired\x02invalid expired time %[1]s, sho" +
+	"uld be RFC3339 format\x02unsupported app role: %[1]s\x02user is required" +
+	"\x02the number of app ids should be between 1 and %[1]d\x02unsupported a" +
+	"pp action: %[1]s\x02user %[1]s already has the permanent role %[2]s in t" +
+	"he app\x02duration hours should be between 1 and %[1]d\x02release name i" +
+	"s required\x02kv type app only supports kvs\x02file type app does not su" +
+	"pport kvs\x02items and kvs can not be set at the same time\x02the conten" +
+	"t of config item %[1]s is not uploaded\x02idempotency key %[1]s has been" +
+	" used by a different request\x02idempotency key %[1]s is being processed" +
+	", please retry later\x02idempotency key should be 1 to 128 characters" +
+	"\x02operation %[1]d is not found\x02operation %[1]d is not found or alre" +
+	"ady finished\x02biz id and app id are required\x02app %[1]s is archived," +
+	" clients can not pull any release\x02the client does not match any publi" +
+	"shed group of the app\x02the groups to publish overlap with the online g" +
+	"roups of other releases, the instances in the overlapped scope will matc" +
+	"h the latest published release, please confirm the overlaps to continue:" +
+	" %[1]s\x02the default group can not be pinned\x02group %[1]d is online w" +
+	"ith release %[2]d, only the current release can be pinned\x02group %[1]d" +
+	" is not online in the app\x02groups %[1]s are pinned, unpin them before " +
+	"publishing\x02unsupported pause mode: %[1]s\x02pause message length shou" +
+	"ld not exceed %[1]d\x02only one of app id and credential id should be se" +
+	"t\x02the publish must reference a change ticket\x02change ticket %[1]s i" +
+	"s invalid, %[2]v\x02the number of notification channels exceeds the limi" +
+	"t %[1]d\x02notification channel name %[1]s already exists\x02this is a t" +
+	"est message of notification channel %[1]s\x02send notification failed, %" +
+	"[1]v\x02the number of alert rules exceeds the limit %[1]d\x02alert rule " +
+	"name %[1]s already exists\x02alert rule spec is required\x02notification" +
+	" channel %[1]d is not found\x02group %[1]d is not found\x02client quaran" +
+	"tine spec is required\x02the number of client quarantines exceeds the li" +
+	"mit %[1]d\x02client %[1]s %[2]s is already quarantined\x02invalid min cl" +
+	"ient version %[1]s, should be like v1.2.0\x02invalid expired at %[1]s, s" +
+	"hould be in RFC3339 format\x02invalid kv expiry, err: %[1]v\x02experimen" +
+	"t spec is required\x02the number of experiments exceeds the limit %[1]d" +
+	"\x02kv %[1]s already has an experiment %[2]s\x02kv %[1]s not exists\x02i" +
+	"nvalid experiment, err: %[1]v\x02masking profile spec is required\x02bat" +
+	"ch update app template binding's failed, err: %[1]v\x02get app template " +
+	"bindings by template set ids, err: %[1]v\x02get template set failed, err" +
+	": %[1]v\x02list template sets by template set ids failed, err: %[1]s"
 
-var zhIndex = []uint32{ // 239 elements
+var zhIndex = []uint32{ // 319 elements
 	// Entry 0 - 1F
 	0x00000000, 0x0000000f, 0x00000022, 0x00000041,
 	0x00000060, 0x00000085, 0x000000d1, 0x000000ea,
@@ -535,53 +689,75 @@ var zhIndex = []uint32{ // 239 elements
 	0x00000add, 0x00000b02, 0x00000b27, 0x00000b49,
 	0x00000b62, 0x00000b8d, 0x00000bb8, 0x00000c04,
 	0x00000c26, 0x00000c60, 0x00000c9f, 0x00000cb5,
-	0x00000cf7, 0x00000d2e, 0x00000d64, 0x00000d83,
-	0x00000da0, 0x00000dcb, 0x00000df3, 0x00000e18,
-	0x00000e53, 0x00000e7e, 0x00000eb8, 0x00000ed3,
+	0x00000cf7, 0x00000d2e, 0x00000d4d, 0x00000d6a,
+	0x00000d95, 0x00000dbd, 0x00000de2, 0x00000e1d,
+	0x00000e48, 0x00000e82, 0x00000e9d, 0x00000eb8,
 	// Entry 60 - 7F
-	0x00000eee, 0x00000f1e, 0x00000f36, 0x00000f57,
-	0x00000f7e, 0x00000fa1, 0x00000fc3, 0x00000fec,
-	0x00000ffb, 0x00001022, 0x0000105a, 0x0000107c,
-	0x000010a0, 0x000010b9, 0x000010dd, 0x000010f8,
-	0x00001113, 0x00001143, 0x00001170, 0x00001183,
-	0x00001193, 0x000011c0, 0x000011f0, 0x00001203,
-	0x00001234, 0x0000125f, 0x0000128a, 0x000012bc,
-	0x000012f5, 0x00001326, 0x00001354, 0x0000137c,
+	0x00000ee8, 0x00000f00, 0x00000f21, 0x00000f48,
+	0x00000f6b, 0x00000f8d, 0x00000fb6, 0x00000fc5,
+	0x00000fec, 0x00001024, 0x00001046, 0x0000106a,
+	0x00001083, 0x000010a7, 0x000010c2, 0x000010dd,
+	0x0000110d, 0x0000113a, 0x0000114d, 0x0000115d,
+	0x0000118a, 0x000011ba, 0x000011cd, 0x000011fe,
+	0x00001229, 0x00001254, 0x00001286, 0x000012bf,
+	0x000012f0, 0x0000131e, 0x00001346, 0x00001368,
 	// Entry 80 - 9F
-	0x0000139e, 0x000013cf, 0x00001408, 0x00001439,
-	0x00001458, 0x0000148e, 0x000014b0, 0x000014f7,
-	0x00001521, 0x0000154c, 0x00001565, 0x0000158a,
-	0x0000159d, 0x000015c8, 0x000015f2, 0x000015ff,
-	0x00001618, 0x00001637, 0x00001656, 0x00001688,
-	0x000016be, 0x0000170e, 0x0000171e, 0x0000172e,
-	0x0000173e, 0x0000174e, 0x00001797, 0x000017c2,
-	0x000017e8, 0x00001808, 0x00001839, 0x0000185f,
+	0x00001399, 0x000013b8, 0x000013ee, 0x00001410,
+	0x00001457, 0x00001481, 0x000014ac, 0x000014c5,
+	0x000014ea, 0x000014fd, 0x00001528, 0x00001552,
+	0x0000155f, 0x00001578, 0x00001597, 0x000015b6,
+	0x000015e8, 0x0000161e, 0x0000166e, 0x0000167e,
+	0x0000168e, 0x0000169e, 0x000016ae, 0x000016f7,
+	0x00001722, 0x00001748, 0x00001768, 0x00001799,
+	0x000017bf, 0x000017f0, 0x00001816, 0x0000182d,
 	// Entry A0 - BF
-	0x00001890, 0x000018b6, 0x000018cd, 0x000018ec,
-	0x00001909, 0x0000192e, 0x0000194b, 0x0000196d,
-	0x00001998, 0x000019c8, 0x000019ed, 0x00001a17,
-	0x00001a48, 0x00001a7d, 0x00001abe, 0x00001ae0,
-	0x00001aed, 0x00001b0c, 0x00001b36, 0x00001b45,
-	0x00001b52, 0x00001b67, 0x00001b7b, 0x00001b95,
-	0x00001bad, 0x00001bd5, 0x00001c00, 0x00001c33,
-	0x00001c6c, 0x00001c8c, 0x00001cae, 0x00001ce7,
+	0x0000184c, 0x00001869, 0x0000188e, 0x000018ab,
+	0x000018d6, 0x00001906, 0x0000192b, 0x00001955,
+	0x00001986, 0x000019bb, 0x000019fc, 0x00001a1e,
+	0x00001a2b, 0x00001a4a, 0x00001a74, 0x00001a83,
+	0x00001a90, 0x00001aa5, 0x00001ab9, 0x00001ad3,
+	0x00001aeb, 0x00001b13, 0x00001b3e, 0x00001b71,
+	0x00001baa, 0x00001bca, 0x00001bec, 0x00001c25,
+	0x00001c44, 0x00001c72, 0x00001c9d, 0x00001cd3,
 	// Entry C0 - DF
-	0x00001d06, 0x00001d34, 0x00001d5f, 0x00001d95,
-	0x00001db6, 0x00001dd8, 0x00001e29, 0x00001e49,
-	0x00001e69, 0x00001ef0, 0x00001f43, 0x00001fbc,
-	0x00002055, 0x00002076, 0x000020a8, 0x000020ca,
-	0x000020f0, 0x00002118, 0x0000213b, 0x0000215f,
-	0x0000216f, 0x00002184, 0x00002191, 0x000021a4,
-	0x000021c2, 0x000021db, 0x000021f7, 0x00002218,
-	0x00002239, 0x00002252, 0x0000225f, 0x00002272,
+	0x00001cf4, 0x00001d16, 0x00001d67, 0x00001d87,
+	0x00001da7, 0x00001e2e, 0x00001e81, 0x00001efa,
+	0x00001f93, 0x00001fb4, 0x00001fe6, 0x00002008,
+	0x0000202e, 0x00002056, 0x00002079, 0x0000209d,
+	0x000020ad, 0x000020c2, 0x000020cf, 0x000020e2,
+	0x00002100, 0x00002119, 0x00002135, 0x00002156,
+	0x00002177, 0x00002190, 0x0000219d, 0x000021b0,
+	0x000021bd, 0x000021f8, 0x00002230, 0x00002251,
 	// Entry E0 - FF
-	0x0000227f, 0x000022ba, 0x000022f2, 0x00002313,
-	0x00002334, 0x00002334, 0x00002341, 0x00002370,
-	0x00002386, 0x000023be, 0x000023da, 0x000023ec,
-	0x00002401, 0x00002426, 0x00002449,
-} // Size: 980 bytes
+	0x00002272, 0x00002272, 0x0000227f, 0x000022ae,
+	0x000022c4, 0x000022fc, 0x00002318, 0x0000232a,
+	0x0000233f, 0x00002364, 0x00002387, 0x000023ae,
+	0x000023c2, 0x000023ee, 0x00002414, 0x0000242d,
+	0x0000245b, 0x00002483, 0x000024b1, 0x000024f5,
+	0x0000251e, 0x00002541, 0x00002557, 0x0000257c,
+	0x000025a8, 0x000025ca, 0x000025f5, 0x00002629,
+	0x00002645, 0x00002665, 0x00002682, 0x000026ae,
+	// Entry 100 - 11F
+	0x000026cb, 0x000026ee, 0x00002716, 0x0000273c,
+	0x0000275f, 0x00002780, 0x0000279d, 0x000027ba,
+	0x000027ee, 0x0000280e, 0x00002821, 0x00002845,
+	0x00002865, 0x0000289a, 0x000028c2, 0x000028db,
+	0x00002903, 0x0000292b, 0x0000294c, 0x00002972,
+	0x0000299b, 0x000029dc, 0x00002a06, 0x00002a23,
+	0x00002a4c, 0x00002a6c, 0x00002aa7, 0x00002adb,
+	0x00002b7a, 0x00002b99, 0x00002be8, 0x00002c0b,
+	// Entry 120 - 13F
+	0x00002c45, 0x00002c65, 0x00002c90, 0x00002cc5,
+	0x00002ce1, 0x00002cff, 0x00002d24, 0x00002d47,
+	0x00002d70, 0x00002d8a, 0x00002daf, 0x00002dd2,
+	0x00002df1, 0x00002e0e, 0x00002e25, 0x00002e47,
+	0x00002e6f, 0x00002e92, 0x00002ecb, 0x00002efd,
+	0x00002f2d, 0x00002f46, 0x00002f65, 0x00002f8b,
+	0x00002fa5, 0x00002fc4, 0x00002fdd, 0x0000300e,
+	0x00003047, 0x00003069, 0x0000309f,
+} // Size: 1300 bytes
 
-const zhData string = "" + // Size: 9289 bytes
+const zhData string = "" + // Size: 12447 bytes
 	"\x02id不能为空\x02无效的文件名\x02读取文件失败, err: %[1]v\x02创建目录失败, err: %[1]v\x02创建临时" +
 	"目录失败, err: %[1]v\x02解压文件失败, 文件 %[1]s 的大小超过了最大限制阈值 %[2]s\x02解压失败, err: " +
 	"%[1]v\x02上传文件失败, err: %[1]v\x02获取模板配置失败, err: %[1]v\x02上传完成\x02上传完成, %[1" +
@@ -608,54 +784,78 @@ const zhData string = "" + // Size: 9289 bytes
 	"]s\x02获取配置项失败, err: %[1]v\x02配置项没有被删除\x02获取最新发布版本失败, err: %[1]v\x02获取已发布" +
 	"的配置失败, err: %[1]v\x02恢复失败。具有相同路径的文件已存在，但未处于新状态\x02恢复配置项失败, err: %[1]v" +
 	"\x02获取大于最新发布版本的记录失败, err: %[1]v\x02配置项总数（包括模板和非模板）超出限制%[1]d\x02获取配置项数量" +
-	"\x02通过业务和服务ID获取模板绑定关系失败, err: %[1]s\x02获取该服务下的引用模板集失败, err: %[1]s\x02按模板" +
-	"套餐ID列出模板套餐失败, err: %[1]s\x02移除套餐失败, err: %[1]s\x02脚本名称 %[1]s 已存在\x02获取" +
-	"排除后的脚本失败, err: %[1]s\x02检索引用的脚本失败, err: %[1]s\x02获取 kv (%[1]d) 失败, err" +
-	": %[2]v\x02该服务下的配置项%[1]s已存在, 无法重复创建\x02获取服务失败, key: %[1]s, err: %[2]v" +
-	"\x02kv 类型与服务类型中定义的数据类型不匹配\x02创建kv失败, err: %[1]v\x02更新kv失败, err: %[1]v" +
-	"\x02获取过期证书列表失败，错误：%[1]v\x02不是kv类型的服务\x02获取kv列表失败, err: %[1]v\x02批量导入KV配置" +
-	"失败, err: %[1]v\x02配置项 %[1]s 的类型不正确\x02清除草稿区失败, err: %[1]v\x02批量更新vault" +
-	"失败，错误：%[1]v\x02kv保持失败\x02获取排除后的kv失败, err: %[1]s\x02统计未删除的kV文件数量失败，错误：%" +
-	"[1]v\x02配置项总数超出限制 %[1]d\x02版本%[1]s已弃用，无法提交\x02当前有版本在上线\x02版本%[1]s已弃用，无法撤" +
-	"消\x02上线状态无效：%[1]s\x02版本名称%[1]s已存在\x02不允许撤销，当前上线状态为：%[1]s\x02无法拒绝，当前上线状" +
-	"态为：%[1]s\x02原因不能为空\x02无权限审批\x02无法通过，当前上线状态为：%[1]s\x02不允许上线，当前上线状态为：%[1" +
-	"]s\x02业务列表为空\x02此单据已审批完成，无需进一步处理\x02此单据已撤销，无需进一步处理\x02此单据已结束，无需进一步处理\x02" +
-	"此单据已撤销，无效的单据状态: %[1]s\x02按模板集ID获取应用程序模板绑定, err: %[1]s\x02批量更新应用模板绑定失败," +
-	" err: %[1]s\x02模板集批量添加模板失败, err: %[1]s\x02获取模板集数据失败, err: %[1]s\x02此模板集下" +
-	"没有模板文件\x02从模板套餐中删除模板失败, err: %[1]v\x02按模板集ID获取应用程序模板绑定, err: %[1]v\x02" +
-	"批量更新应用模板绑定失败, err: %[1]v\x02列出模板失败, err: %[1]v\x02按服务ID列出应用模板绑定失败, err" +
-	": %[1]s\x02获取模板集失败, err: %[1]s\x02模板套餐 %[1]s 超过单套餐最大配置文件数量限制 %[2]d\x02按服" +
-	"务ID列出服务失败, err: %[1]s\x02统计服务配置数量失败, err: %[1]s\x02模板套餐数据为空\x02列出模板数据失" +
-	"败, err: %[1]s\x02模板数据为空\x02列出模板版本数据失败, err: %[1]s\x02同名的模版变量名称%[1]s已存在" +
-	"\x02服务为空\x02创建失败, err: %[1]v\x02创建服务失败, err: %[1]v\x02更新服务失败, err: %[1]s" +
-	"\x02统计服务 %[1]d 的配置项失败, err: %[2]v\x02获取服务 %[1]d 的模板绑定失败,  err: %[2]v\x02" +
-	"服务 %[1]d 的配置项总数（包括模板和非模板）超出限制 %[2]d\x02脚本不存在\x02未命名版本\x02appID不能为0\x02" +
-	"bizID不能为0\x02模板套餐 %[1]d's 超过单套餐最大配置文件数量限制 %[2]d\x02验证模板是否存在失败, err: %[1]" +
-	"v\x02%[1]v 中的模板空间 ID 不存在\x02%[1]v 中的模板 ID 不存在\x02验证模板版本是否存在失败, err: %[1]" +
-	"v\x02%[1]v 中的模板版本 ID 不存在\x02验证模板套餐是否存在失败, err: %[1]v\x02%[1]v 中的模板套餐 ID " +
-	"不存在\x02模板 %[1]d 不存在\x02获取模板失败, err: %[1]v\x02模板版本 %[1]d 不存在\x02获取模板版本失" +
-	"败, err: %[1]v\x02模板套餐 %[1]d 不存在\x02获取模板集失败, err: %[1]v\x02统计模板套餐数量失败, " +
-	"err: %[1]v\x02模板空间下有模板套餐, 需要先删除\x02获取模板数量失败, err: %[1]v\x02模板空间下有模板, 需要先" +
-	"删除\x02验证模板套餐中的模板失败, err: %[1]v\x02%[1]v 中的模板 ID 不属于模板套餐 ID %[2]d\x02从 " +
-	"kv.Data 获取“kv_type”作为字符串失败, err: %[1]v\x02值类型断言失败, err: %[1]v\x02授权失败" +
-	"\x02获取权限失败, err: %[1]v\x02grpc 状态详细信息失败, err: %[1]v\x02db操作失败\x02无效参数" +
-	"\x02id不应该被设置\x02spec没有被设置\x02attachment没有被设置\x02revision没有被设置\x02路径 %[1]" +
-	"s 无效，长度应为 >= 1\x02路径 %[1]s 无效，长度应为 <= 1024\x02路径 %[1]s 无效，路径必须以“/”开头\x02" +
-	"路径 %[1]s 无效，路径不能包含连续的“/”\x02路径无效, 长度应为 >= 1\x02路径无效, 长度应为 <= 256\x02路径" +
-	"无效, 路径不符合win文件路径格式规范\x02需要重新加载文件路径\x02重新加载文件路径无效, 应该 <= 128\x02重新加载文件路" +
-	"径不是绝对路径\x02%[1]s 子路径为系统保留路径, 不允许使用\x02描述为必填项, 不能为空\x02描述无效, 长度应为 <= 20" +
-	"0\x02资源名称“%[1]s”以“%[2]s”为前缀, 是保留名称, 不允许使用\x02名称无效, 长度应为 >= 1\x02无效名称, 长度" +
-	"应该<=128\x02无效名称：%[1]s, 只允许包含英文、数字、下划线（_）、连字符（-）, 且必须以英文、数字开头和结尾\x02无效名" +
-	"称, 长度应该>=9且必须以bk_bscp_前缀开头（忽略大小写）\x02无效名称：%[1]s, 只允许英文、数字、下划线（_）, 且必须以" +
-	"bk_bscp_前缀开头（忽略大小写）\x02无效名称：%[1]s, 只允许包含中文、英文、数字、下划线（_）、连字符（-）, 且必须以中文、英" +
-	"文或数字开头和结尾\x02名称无效, 长度应为 <= 64\x02名称 %[1]s 无效, 名称不能全部为“.”\x02名称 %[1]s 无" +
-	"效，不能有 /\x02命名空间无效, 长度应为 >= 1\x02命名空间无效, 长度应为 <= 128\x02用户名无效, 长度应为 >= " +
-	"1\x02用户名无效, 长度应为 <= 32\x02ID 不能为空\x02无法验证业务ID\x02参数为空\x02服务参数为空\x02未知的配置" +
-	"类型：%[1]s\x02服务类型不能编辑\x02暂不支持表配置类型\x02不支持的配置类型：%[1]s\x02不支持的服务类型：%[1]s" +
-	"\x02无法验证数据类型\x02参数为空\x02无法验证参数\x02参数为空\x02验证 Windows 文件路径失败, path: %[1]s" +
-	", err: %[2]v\x02验证 Unix 文件路径失败, path: %[1]s, err: %[2]v\x02不支持的文件格式：%[1]" +
-	"s\x02不支持的文件模式：%[1]s\x02参数为空\x02内容签名无效, 应为配置的 sha256 值\x02内容签名应小写\x02无效的原" +
-	"始内容签名, 应为配置的 sha256 值\x02原始内容签名应小写\x02无效的服务ID\x02无效的配置项ID\x02default_v" +
-	"al %[1]s 不是数字类型\x02%[1]s 与 %[2]s 路径文件冲突"
+	"\x02通过业务和服务ID获取模板绑定关系失败, err: %[1]s\x02获取该服务下的引用模板集失败, err: %[1]s\x02移除套" +
+	"餐失败, err: %[1]s\x02脚本名称 %[1]s 已存在\x02获取排除后的脚本失败, err: %[1]s\x02检索引用的脚本" +
+	"失败, err: %[1]s\x02获取 kv (%[1]d) 失败, err: %[2]v\x02该服务下的配置项%[1]s已存在, 无法" +
+	"重复创建\x02获取服务失败, key: %[1]s, err: %[2]v\x02kv 类型与服务类型中定义的数据类型不匹配\x02创建k" +
+	"v失败, err: %[1]v\x02更新kv失败, err: %[1]v\x02获取过期证书列表失败，错误：%[1]v\x02不是kv类型的服" +
+	"务\x02获取kv列表失败, err: %[1]v\x02批量导入KV配置失败, err: %[1]v\x02配置项 %[1]s 的类型不正" +
+	"确\x02清除草稿区失败, err: %[1]v\x02批量更新vault失败，错误：%[1]v\x02kv保持失败\x02获取排除后的kv" +
+	"失败, err: %[1]s\x02统计未删除的kV文件数量失败，错误：%[1]v\x02配置项总数超出限制 %[1]d\x02版本%[1]" +
+	"s已弃用，无法提交\x02当前有版本在上线\x02版本%[1]s已弃用，无法撤消\x02上线状态无效：%[1]s\x02版本名称%[1]s已存在" +
+	"\x02不允许撤销，当前上线状态为：%[1]s\x02无法拒绝，当前上线状态为：%[1]s\x02原因不能为空\x02无权限审批\x02无法通过" +
+	"，当前上线状态为：%[1]s\x02不允许上线，当前上线状态为：%[1]s\x02业务列表为空\x02此单据已审批完成，无需进一步处理" +
+	"\x02此单据已撤销，无需进一步处理\x02此单据已结束，无需进一步处理\x02此单据已撤销，无效的单据状态: %[1]s\x02按模板集ID获" +
+	"取应用程序模板绑定, err: %[1]s\x02批量更新应用模板绑定失败, err: %[1]s\x02模板集批量添加模板失败, err:" +
+	" %[1]s\x02获取模板集数据失败, err: %[1]s\x02此模板集下没有模板文件\x02从模板套餐中删除模板失败, err: %[1" +
+	"]v\x02列出模板失败, err: %[1]v\x02按服务ID列出应用模板绑定失败, err: %[1]s\x02获取模板集失败, err:" +
+	" %[1]s\x02模板套餐 %[1]s 超过单套餐最大配置文件数量限制 %[2]d\x02按服务ID列出服务失败, err: %[1]s" +
+	"\x02统计服务配置数量失败, err: %[1]s\x02模板套餐数据为空\x02列出模板数据失败, err: %[1]s\x02模板数据为空" +
+	"\x02列出模板版本数据失败, err: %[1]s\x02同名的模版变量名称%[1]s已存在\x02服务为空\x02创建失败, err: %[" +
+	"1]v\x02创建服务失败, err: %[1]v\x02更新服务失败, err: %[1]s\x02统计服务 %[1]d 的配置项失败, er" +
+	"r: %[2]v\x02获取服务 %[1]d 的模板绑定失败,  err: %[2]v\x02服务 %[1]d 的配置项总数（包括模板和非模板）" +
+	"超出限制 %[2]d\x02脚本不存在\x02未命名版本\x02appID不能为0\x02bizID不能为0\x02模板套餐 %[1]d's" +
+	" 超过单套餐最大配置文件数量限制 %[2]d\x02验证模板是否存在失败, err: %[1]v\x02%[1]v 中的模板空间 ID 不存在" +
+	"\x02%[1]v 中的模板 ID 不存在\x02验证模板版本是否存在失败, err: %[1]v\x02%[1]v 中的模板版本 ID 不存在" +
+	"\x02验证模板套餐是否存在失败, err: %[1]v\x02%[1]v 中的模板套餐 ID 不存在\x02模板 %[1]d 不存在\x02获" +
+	"取模板失败, err: %[1]v\x02模板版本 %[1]d 不存在\x02获取模板版本失败, err: %[1]v\x02模板套餐 %[" +
+	"1]d 不存在\x02统计模板套餐数量失败, err: %[1]v\x02模板空间下有模板套餐, 需要先删除\x02获取模板数量失败, err:" +
+	" %[1]v\x02模板空间下有模板, 需要先删除\x02验证模板套餐中的模板失败, err: %[1]v\x02%[1]v 中的模板 ID 不" +
+	"属于模板套餐 ID %[2]d\x02从 kv.Data 获取“kv_type”作为字符串失败, err: %[1]v\x02值类型断言失败" +
+	", err: %[1]v\x02授权失败\x02获取权限失败, err: %[1]v\x02grpc 状态详细信息失败, err: %[1]v" +
+	"\x02db操作失败\x02无效参数\x02id不应该被设置\x02spec没有被设置\x02attachment没有被设置\x02revisi" +
+	"on没有被设置\x02路径 %[1]s 无效，长度应为 >= 1\x02路径 %[1]s 无效，长度应为 <= 1024\x02路径 %[1]s" +
+	" 无效，路径必须以“/”开头\x02路径 %[1]s 无效，路径不能包含连续的“/”\x02路径无效, 长度应为 >= 1\x02路径无效, 长" +
+	"度应为 <= 256\x02路径无效, 路径不符合win文件路径格式规范\x02需要重新加载文件路径\x02重新加载文件路径无效, 应该 <" +
+	"= 128\x02重新加载文件路径不是绝对路径\x02%[1]s 子路径为系统保留路径, 不允许使用\x02描述为必填项, 不能为空\x02描述" +
+	"无效, 长度应为 <= 200\x02资源名称“%[1]s”以“%[2]s”为前缀, 是保留名称, 不允许使用\x02名称无效, 长度应为 " +
+	">= 1\x02无效名称, 长度应该<=128\x02无效名称：%[1]s, 只允许包含英文、数字、下划线（_）、连字符（-）, 且必须以英文、" +
+	"数字开头和结尾\x02无效名称, 长度应该>=9且必须以bk_bscp_前缀开头（忽略大小写）\x02无效名称：%[1]s, 只允许英文、数" +
+	"字、下划线（_）, 且必须以bk_bscp_前缀开头（忽略大小写）\x02无效名称：%[1]s, 只允许包含中文、英文、数字、下划线（_）、" +
+	"连字符（-）, 且必须以中文、英文或数字开头和结尾\x02名称无效, 长度应为 <= 64\x02名称 %[1]s 无效, 名称不能全部为“" +
+	".”\x02名称 %[1]s 无效，不能有 /\x02命名空间无效, 长度应为 >= 1\x02命名空间无效, 长度应为 <= 128\x02用" +
+	"户名无效, 长度应为 >= 1\x02用户名无效, 长度应为 <= 32\x02ID 不能为空\x02无法验证业务ID\x02参数为空" +
+	"\x02服务参数为空\x02未知的配置类型：%[1]s\x02服务类型不能编辑\x02暂不支持表配置类型\x02不支持的配置类型：%[1]s" +
+	"\x02不支持的服务类型：%[1]s\x02无法验证数据类型\x02参数为空\x02无法验证参数\x02参数为空\x02验证 Windows 文" +
+	"件路径失败, path: %[1]s, err: %[2]v\x02验证 Unix 文件路径失败, path: %[1]s, err: %[" +
+	"2]v\x02不支持的文件格式：%[1]s\x02不支持的文件模式：%[1]s\x02参数为空\x02内容签名无效, 应为配置的 sha256 " +
+	"值\x02内容签名应小写\x02无效的原始内容签名, 应为配置的 sha256 值\x02原始内容签名应小写\x02无效的服务ID\x02无" +
+	"效的配置项ID\x02default_val %[1]s 不是数字类型\x02%[1]s 与 %[2]s 路径文件冲突\x02无效的版本ID" +
+	" %[1]d, 必须大于0\x02limit 必须大于0\x02模板套餐ID重复: %[1]v, ID必须唯一\x02模板ID重复: %[1]v" +
+	", ID必须唯一\x02绑定关系不能为空\x02绑定关系中的模板套餐ID无效: %[1]d\x02绑定关系中的模板ID无效: %[1]d\x02" +
+	"绑定关系中的模板版本ID无效: %[1]d\x02服务模板绑定关系不存在, 业务ID: %[1]d, 服务ID: %[2]d\x02模板套餐" +
+	" %[1]d 未绑定到该服务\x02模板 %[1]d 未绑定到该服务\x02不是键值型服务\x02两个服务的数据类型不一致\x02配置项 %[1" +
+	"]s 不能同时更新和删除\x02删除配置项失败, err: %[1]v\x02upserts 和 delete_keys 不能同时为空\x02模" +
+	"版套餐绑定和脚本不支持跨业务克隆\x02名称和别名不能为空\x02不支持的服务状态: %[1]s\x02服务已处于 %[1]s 状态\x02" +
+	"服务状态不能从 %[1]s 变更为 %[2]s\x02无效的业务ID或服务ID\x02无效名称，长度应该<=%[1]d\x02服务账号授权的" +
+	"操作不能为空\x02服务账号不支持的操作: %[1]s\x02服务账号名称 %[1]s 已存在\x02无效的服务账号 API 密钥\x02服" +
+	"务账号 %[1]s 已禁用\x02服务账号 %[1]s 已过期\x02无效的过期时间 %[1]s，应为 RFC3339 格式\x02不支持的" +
+	"服务角色: %[1]s\x02用户不能为空\x02服务ID数量应在1到%[1]d之间\x02不支持的服务操作: %[1]s\x02用户 %[" +
+	"1]s 在该服务下已有永久角色 %[2]s\x02授权时长应在1到%[1]d小时之间\x02版本名称不能为空\x02键值型服务只支持键值配置项" +
+	"\x02文件型服务不支持键值配置项\x02items 和 kvs 不能同时设置\x02配置文件 %[1]s 的内容未上传\x02幂等键 %[1]" +
+	"s 已被其他请求使用\x02幂等键 %[1]s 对应的请求正在处理中，请稍后重试\x02幂等键长度应为 1 到 128 个字符\x02异步操作 " +
+	"%[1]d 不存在\x02异步操作 %[1]d 不存在或已结束\x02业务ID和服务ID不能为空\x02服务 %[1]s 已归档，客户端无法拉取" +
+	"任何版本\x02客户端未匹配到服务任何已上线的分组\x02上线分组与其他版本的在线分组存在重叠, 重叠范围内的客户端将匹配最新上线的版本, " +
+	"请确认重叠范围后继续上线: %[1]s\x02默认分组不能固定版本\x02分组 %[1]d 当前上线的版本为 %[2]d, 只能固定当前上线" +
+	"的版本\x02分组 %[1]d 未在该服务上线\x02分组 %[1]s 已固定版本, 请先取消固定再上线\x02不支持的暂停方式: %[1]" +
+	"s\x02暂停提示信息长度不能超过 %[1]d\x02服务ID和客户端密钥ID只能设置其中一个\x02上线必须关联变更单\x02变更单 %[1]" +
+	"s 无效, %[2]v\x02通知渠道数量超过上限 %[1]d\x02通知渠道名称 %[1]s 已存在\x02这是通知渠道 %[1]s 的测试消" +
+	"息\x02发送通知失败, %[1]v\x02告警规则数量超过上限 %[1]d\x02告警规则名称 %[1]s 已存在\x02告警规则配置不能" +
+	"为空\x02通知渠道 %[1]d 不存在\x02分组 %[1]d 不存在\x02客户端隔离配置不能为空\x02客户端隔离数量超过上限 %[1" +
+	"]d\x02客户端 %[1]s %[2]s 已被隔离\x02客户端最低版本 %[1]s 无效，格式应如 v1.2.0\x02无效的过期时间 %[" +
+	"1]s，应为RFC3339格式\x02无效的配置项过期设置，错误：%[1]v\x02实验配置不能为空\x02实验数量超过上限 %[1]d\x02" +
+	"配置项 %[1]s 已存在实验 %[2]s\x02配置项 %[1]s 不存在\x02实验配置无效, err: %[1]v\x02脱敏规则不能" +
+	"为空\x02批量更新应用模板绑定失败, err: %[1]v\x02按模板集ID获取应用程序模板绑定, err: %[1]v\x02获取模板" +
+	"集失败, err: %[1]v\x02按模板套餐ID列出模板套餐失败, err: %[1]s"
 
-	// Total table size 21788 bytes (21KiB); checksum: 9EA8092
+	// Total table size 29134 bytes (28KiB); checksum: 6708BA18
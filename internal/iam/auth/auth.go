@@ -28,6 +28,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/brpc"
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/gwparser"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/internal/space"
@@ -68,14 +70,21 @@ type Authorizer interface {
 	LogOut(r *http.Request) *rest.UnauthorizedData
 	// HasBiz 业务是否存在
 	HasBiz(bizID uint32) bool
+	// LoginHandler 独立部署(OIDC/LDAP)的登入页面及回调, 其他 provider 返回 404
+	LoginHandler() http.Handler
 }
 
 // NewAuthorizer create an authorizer for iam authorize related operation.
-func NewAuthorizer(sd serviced.Discover, tls cc.TLSConfig) (Authorizer, error) {
+func NewAuthorizer(sd serviced.Discover, tls cc.TLSConfig, extra ...grpc.UnaryClientInterceptor) (Authorizer, error) {
 	opts := make([]grpc.DialOption, 0)
 
 	// add dial load balancer.
 	opts = append(opts, sd.LBRoundRobin())
+	// record downstream rpc cost for the slow request log.
+	interceptors := append([]grpc.UnaryClientInterceptor{brpc.RequestIDUnaryClientInterceptor(),
+		brpc.DownstreamUnaryClientInterceptor()}, extra...)
+	interceptors = append(interceptors, chaos.UnaryClientInterceptor())
+	opts = append(opts, grpc.WithChainUnaryInterceptor(interceptors...))
 
 	if !tls.Enable() {
 		// dial without ssl
@@ -141,6 +150,8 @@ func NewAuthorizer(sd serviced.Discover, tls cc.TLSConfig) (Authorizer, error) {
 		authLoginClient: authLoginClient,
 		gwParser:        nil,
 		spaceMgr:        spaceMgr,
+		loginProvider:   resp.LoginAuth.Provider,
+		oidcAuthURL:     resp.LoginAuth.OidcAuthUrl,
 	}
 
 	// 如果有公钥，初始化配置
@@ -163,6 +174,9 @@ type authorizer struct {
 	authLoginClient bkpaas.AuthLoginClient
 	gwParser        gwparser.Parser
 	spaceMgr        *space.Manager
+	// loginProvider, oidcAuthURL 独立部署登入使用
+	loginProvider string
+	oidcAuthURL   string
 }
 
 // AuthorizeDecision if user has permission to the resources, returns auth status per resource and for all.
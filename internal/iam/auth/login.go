@@ -0,0 +1,210 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/components"
+	"github.com/TencentBlueKing/bk-bscp/internal/components/bkpaas"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
+)
+
+const (
+	// oidcStateCookie 保存 state 及登入后的跳转地址, 防止 CSRF
+	oidcStateCookie = "bscp_login_state"
+	oidcStateTTL    = 10 * time.Minute
+)
+
+var ldapLoginTmpl = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>BSCP Login</title></head>
+<body style="font-family: sans-serif; display: flex; justify-content: center; margin-top: 120px;">
+<form method="post" style="width: 320px;">
+  <h2>BSCP</h2>
+  {{if .Error}}<p style="color: #ea3636;">{{.Error}}</p>{{end}}
+  <input type="hidden" name="c_url" value="{{.CURL}}">
+  <p><input name="username" placeholder="Username" value="{{.Username}}" style="width: 100%;" required></p>
+  <p><input name="password" type="password" placeholder="Password" style="width: 100%;" required></p>
+  <p><button type="submit" style="width: 100%;">Login</button></p>
+</form>
+</body>
+</html>`))
+
+type ldapLoginPage struct {
+	CURL     string
+	Username string
+	Error    string
+}
+
+// LoginHandler 独立部署(OIDC/LDAP)的登入页面及回调, 需要挂载到 /login
+func (a authorizer) LoginHandler() http.Handler {
+	r := chi.NewRouter()
+
+	switch a.loginProvider {
+	case cc.OIDCLoginProvider:
+		r.Get("/", a.oidcLogin)
+		r.Get("/callback", a.oidcCallback)
+	case cc.LDAPLoginProvider:
+		r.Get("/", a.ldapLoginPage)
+		r.Post("/", a.ldapLogin)
+	}
+
+	return r
+}
+
+// oidcLogin 跳转到 IdP 授权页面
+func (a authorizer) oidcLogin(w http.ResponseWriter, r *http.Request) {
+	// 重新登入时清理旧会话, 同时作为登出使用
+	clearCookie(w, r, bkpaas.StandaloneTokenCookie)
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	state := hex.EncodeToString(b)
+	cURL := base64.RawURLEncoding.EncodeToString([]byte(r.URL.Query().Get("c_url")))
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    state + "." + cURL,
+		Path:     "/",
+		MaxAge:   int(oidcStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   isHTTPS(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	http.Redirect(w, r, a.oidcAuthURL+"&state="+url.QueryEscape(state), http.StatusFound)
+}
+
+// oidcCallback IdP 回调, 校验 state 后使用授权码登入
+func (a authorizer) oidcCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		http.Error(w, "oidc login failed: "+e+" "+query.Get("error_description"), http.StatusUnauthorized)
+		return
+	}
+
+	c, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		http.Error(w, "oidc login state not found, please login again", http.StatusBadRequest)
+		return
+	}
+	clearCookie(w, r, oidcStateCookie)
+
+	state, encodedURL, _ := strings.Cut(c.Value, ".")
+	if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(query.Get("state"))) != 1 {
+		http.Error(w, "oidc login state mismatch, please login again", http.StatusBadRequest)
+		return
+	}
+	cURL, _ := base64.RawURLEncoding.DecodeString(encodedURL)
+
+	kt := &kit.Kit{Ctx: r.Context(), Rid: components.RequestIDValue(r.Context())}
+	resp, err := a.authClient.Login(kt.RpcCtx(), &pbas.LoginReq{Code: query.Get("code")})
+	if err != nil {
+		logs.Errorf("oidc login failed, err: %v, rid: %s", err, kt.Rid)
+		http.Error(w, status.Convert(err).Message(), http.StatusUnauthorized)
+		return
+	}
+
+	setSessionCookie(w, r, resp)
+	http.Redirect(w, r, safeRedirectURL(r, string(cURL)), http.StatusFound)
+}
+
+// ldapLoginPage 账号密码登入页面
+func (a authorizer) ldapLoginPage(w http.ResponseWriter, r *http.Request) {
+	clearCookie(w, r, bkpaas.StandaloneTokenCookie)
+	renderLDAPLogin(w, http.StatusOK, &ldapLoginPage{CURL: r.URL.Query().Get("c_url")})
+}
+
+// ldapLogin 账号密码登入
+func (a authorizer) ldapLogin(w http.ResponseWriter, r *http.Request) {
+	page := &ldapLoginPage{CURL: r.PostFormValue("c_url"), Username: r.PostFormValue("username")}
+
+	kt := &kit.Kit{Ctx: r.Context(), Rid: components.RequestIDValue(r.Context())}
+	resp, err := a.authClient.Login(kt.RpcCtx(), &pbas.LoginReq{
+		Username: page.Username,
+		Password: r.PostFormValue("password"),
+	})
+	if err != nil {
+		page.Error = status.Convert(err).Message()
+		renderLDAPLogin(w, http.StatusUnauthorized, page)
+		return
+	}
+
+	setSessionCookie(w, r, resp)
+	http.Redirect(w, r, safeRedirectURL(r, page.CURL), http.StatusFound)
+}
+
+func renderLDAPLogin(w http.ResponseWriter, code int, page *ldapLoginPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(code)
+	if err := ldapLoginTmpl.Execute(w, page); err != nil {
+		logs.Errorf("render ldap login page failed, err: %v", err)
+	}
+}
+
+func setSessionCookie(w http.ResponseWriter, r *http.Request, resp *pbas.LoginResp) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     bkpaas.StandaloneTokenCookie,
+		Value:    resp.Token,
+		Path:     "/",
+		Expires:  time.Unix(resp.ExpiredAt, 0),
+		HttpOnly: true,
+		Secure:   isHTTPS(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func clearCookie(w http.ResponseWriter, r *http.Request, name string) {
+	http.SetCookie(w, &http.Cookie{Name: name, Path: "/", MaxAge: -1, HttpOnly: true, Secure: isHTTPS(r)})
+}
+
+func isHTTPS(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// safeRedirectURL 只允许跳转到当前站点, 防止开放重定向
+func safeRedirectURL(r *http.Request, raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || raw == "" {
+		return "/"
+	}
+
+	if u.Host == "" && u.Scheme == "" && strings.HasPrefix(u.Path, "/") && !strings.HasPrefix(raw, "//") &&
+		!strings.HasPrefix(raw, "/\\") {
+		return raw
+	}
+
+	if (u.Scheme == "http" || u.Scheme == "https") && u.Host == r.Host {
+		return raw
+	}
+
+	return "/"
+}
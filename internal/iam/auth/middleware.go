@@ -33,6 +33,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/internal/dal/repository"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	pbas "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
@@ -95,6 +96,26 @@ func (a authorizer) initKitWithCookie(r *http.Request, k *kit.Kit, multiErr *mul
 	return true
 }
 
+// initKitWithServiceAccount 服务账号 API 密钥鉴权, 用于流水线等自动化场景
+func (a authorizer) initKitWithServiceAccount(r *http.Request, k *kit.Kit, multiErr *multierror.Error) bool {
+	apiKey := r.Header.Get(constant.ServiceAccountKeyHeader)
+	if apiKey == "" {
+		return false
+	}
+	// 密钥不再透传给后端服务
+	r.Header.Del(constant.ServiceAccountKeyHeader)
+
+	resp, err := a.authClient.VerifyServiceAccount(k.RpcCtx(), &pbas.VerifyServiceAccountReq{ApiKey: apiKey})
+	if err != nil {
+		s := status.Convert(err)
+		multiErr.Errors = append(multiErr.Errors, errors.Wrap(errors.New(s.Message()), "auth with service account"))
+		return false
+	}
+
+	k.User = table.ServiceAccountUser(resp.BizId, resp.Name)
+	return true
+}
+
 // initKitWithDevEnv Dev环境, 可以设置环境变量鉴权
 func (a authorizer) initKitWithDevEnv(_ *http.Request, k *kit.Kit, _ *multierror.Error) bool {
 	user := os.Getenv("BK_USER_FOR_TEST")
@@ -120,8 +141,9 @@ func (a authorizer) UnifiedAuthentication(next http.Handler) http.Handler {
 		multiErr := &multierror.Error{}
 
 		switch {
-		case a.initKitWithBKJWT(r, k, multiErr):
-		case a.initKitWithCookie(r, k, multiErr):
+		case a.initKitWithServiceAccount(r, k, multiErr):
+		case a.initKitWithBKJWT(r, k, multiErr) && !isServiceAccountUser(k.User, multiErr):
+		case a.initKitWithCookie(r, k, multiErr) && !isServiceAccountUser(k.User, multiErr):
 		case a.initKitWithDevEnv(r, k, multiErr):
 		default:
 			// API类返回规范的JSON错误信息
@@ -140,6 +162,16 @@ func (a authorizer) UnifiedAuthentication(next http.Handler) http.Handler {
 	return http.HandlerFunc(fn)
 }
 
+// isServiceAccountUser 服务账号只能通过 API 密钥认证, 不允许通过请求头等方式冒用
+func isServiceAccountUser(user string, multiErr *multierror.Error) bool {
+	if _, _, ok := table.ParseServiceAccountUser(user); ok {
+		multiErr.Errors = append(multiErr.Errors, fmt.Errorf("user %s is reserved for service account", user))
+		return true
+	}
+
+	return false
+}
+
 // WebAuthentication HTTP 前端鉴权, 异常跳转302到登入页面
 func (a authorizer) WebAuthentication(webHost string) func(http.Handler) http.Handler {
 	ignoreExtMap := map[string]struct{}{
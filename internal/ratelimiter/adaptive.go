@@ -0,0 +1,151 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimiter
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+const (
+	// adaptiveSmoothing 每个采样窗口内新旧并发上限的平滑系数
+	adaptiveSmoothing = 0.2
+	// adaptiveLongWindow 长期 RTT 滑动平均的窗口数, 长期 RTT 作为无排队时的基准延迟
+	adaptiveLongWindow = 600
+	// adaptiveSampleWindow 采样窗口, 每个窗口内的请求延迟取平均后调整一次并发上限
+	adaptiveSampleWindow = 100 * time.Millisecond
+)
+
+// AdaptiveLimiter is a gradient based adaptive concurrency limiter, which is similar to the gradient2 limiter
+// of Netflix concurrency-limits. It compares the recent latency with the long term latency, the limit is
+// decreased when the latency rises because of queuing, and is increased slowly when the latency is steady,
+// so the requests exceeding the limit are shed rather than queued to keep the latency bounded.
+type AdaptiveLimiter struct {
+	lock     sync.Mutex
+	conf     cc.ConcurrencyLimiter
+	limit    float64
+	inflight int
+	// longRTT is the exponential moving average of the latency, unit is second.
+	longRTT float64
+	// window samples.
+	windowStart time.Time
+	windowSum   float64
+	windowCount int
+	windowMax   int
+	now         func() time.Time
+}
+
+// NewAdaptiveLimiter create an adaptive concurrency limiter.
+func NewAdaptiveLimiter(conf cc.ConcurrencyLimiter) *AdaptiveLimiter {
+	return &AdaptiveLimiter{
+		conf:  conf,
+		limit: float64(conf.InitialLimit),
+		now:   time.Now,
+	}
+}
+
+// Acquire try to acquire a slot for the request, ok is false if the concurrency limit is reached and the request
+// should be shed. the release function must be called when the request is done if the slot is acquired.
+func (l *AdaptiveLimiter) Acquire() (release func(), ok bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if l.inflight >= int(l.limit) {
+		return nil, false
+	}
+
+	l.inflight++
+	start := l.now()
+	return func() { l.release(start) }, true
+}
+
+// Limit returns the current concurrency limit.
+func (l *AdaptiveLimiter) Limit() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return int(l.limit)
+}
+
+// Inflight returns the number of the requests in processing.
+func (l *AdaptiveLimiter) Inflight() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	return l.inflight
+}
+
+// RetryAfter returns the hint of how long the shed request should wait before retry, it is jittered so that
+// the shed clients do not retry at the same time.
+func (l *AdaptiveLimiter) RetryAfter() time.Duration {
+	base := time.Duration(l.conf.RetryAfterMS) * time.Millisecond
+	return base + time.Duration(rand.Int63n(int64(base)+1)) //nolint:gosec
+}
+
+func (l *AdaptiveLimiter) release(start time.Time) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	if l.inflight > l.windowMax {
+		l.windowMax = l.inflight
+	}
+	l.inflight--
+
+	if l.windowStart.IsZero() {
+		l.windowStart = now
+	}
+	l.windowSum += now.Sub(start).Seconds()
+	l.windowCount++
+
+	if now.Sub(l.windowStart) < adaptiveSampleWindow {
+		return
+	}
+
+	l.update(l.windowSum/float64(l.windowCount), l.windowMax)
+	l.windowStart, l.windowSum, l.windowCount, l.windowMax = now, 0, 0, 0
+}
+
+// update the limit with the average latency of a sample window, caller should hold the lock.
+func (l *AdaptiveLimiter) update(shortRTT float64, maxInflight int) {
+	if shortRTT <= 0 {
+		return
+	}
+
+	if l.longRTT == 0 {
+		l.longRTT = shortRTT
+	} else {
+		l.longRTT += (shortRTT - l.longRTT) / adaptiveLongWindow
+	}
+
+	// 延迟长期偏高时基准延迟逐渐回落, 避免基准延迟被拉高后无法恢复
+	if l.longRTT/shortRTT > 2 {
+		l.longRTT *= 0.95
+	}
+
+	// 并发远未达到上限时说明流量不足, 延迟不反映容量, 不扩大上限
+	if float64(maxInflight) < l.limit/2 {
+		return
+	}
+
+	tolerance := float64(l.conf.TolerancePercent) / 100
+	gradient := math.Max(0.5, math.Min(1, tolerance*l.longRTT/shortRTT))
+	newLimit := l.limit*gradient + math.Sqrt(l.limit)
+	newLimit = l.limit*(1-adaptiveSmoothing) + newLimit*adaptiveSmoothing
+
+	l.limit = math.Max(float64(l.conf.MinLimit), math.Min(float64(l.conf.MaxLimit), newLimit))
+}
@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// runWindows 以固定并发及延迟运行若干个采样窗口
+func runWindows(l *AdaptiveLimiter, clock *time.Time, concurrency int, rtt time.Duration, windows int) {
+	for w := 0; w < windows; w++ {
+		releases := make([]func(), 0, concurrency)
+		for i := 0; i < concurrency; i++ {
+			if release, ok := l.Acquire(); ok {
+				releases = append(releases, release)
+			}
+		}
+		*clock = clock.Add(rtt)
+		for _, release := range releases {
+			release()
+		}
+		*clock = clock.Add(adaptiveSampleWindow)
+	}
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	conf := cc.ConcurrencyLimiter{Enable: true, InitialLimit: 100, MinLimit: 10, MaxLimit: 1000,
+		TolerancePercent: 150, RetryAfterMS: 1000}
+	l := NewAdaptiveLimiter(conf)
+	clock := time.Now()
+	l.now = func() time.Time { return clock }
+
+	// 延迟平稳时并发上限逐步扩大
+	runWindows(l, &clock, 100, 10*time.Millisecond, 20)
+	grown := l.Limit()
+	assert.Greater(t, grown, 100)
+
+	// 延迟升高说明上游出现排队, 并发上限收缩
+	runWindows(l, &clock, grown, 100*time.Millisecond, 20)
+	assert.Less(t, l.Limit(), grown)
+	assert.GreaterOrEqual(t, l.Limit(), int(conf.MinLimit))
+
+	// 超出并发上限的请求被拒绝
+	releases := make([]func(), 0)
+	for {
+		release, ok := l.Acquire()
+		if !ok {
+			break
+		}
+		releases = append(releases, release)
+	}
+	assert.Equal(t, l.Limit(), len(releases))
+	assert.Equal(t, l.Limit(), l.Inflight())
+
+	after := l.RetryAfter()
+	assert.GreaterOrEqual(t, after, time.Second)
+	assert.LessOrEqual(t, after, 2*time.Second)
+}
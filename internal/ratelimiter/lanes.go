@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimiter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+// Lane is the priority lane of a class of requests.
+type Lane string
+
+const (
+	// LaneControl 心跳、上报等控制面消息, 被饿死会导致客户端被误判为离线
+	LaneControl Lane = "control"
+	// LaneWatch watch 连接的建立
+	LaneWatch Lane = "watch"
+	// LanePull 配置元数据、内容及下载地址的拉取
+	LanePull Lane = "pull"
+)
+
+var (
+	// ErrLaneQueueFull the lane's concurrency limit is reached and its wait queue is full.
+	ErrLaneQueueFull = errors.New("priority lane queue is full")
+	// ErrLaneQueueTimeout the request waits in the lane's queue exceeds the queue timeout.
+	ErrLaneQueueTimeout = errors.New("priority lane queue wait timeout")
+)
+
+// PriorityLanes separates the requests into lanes, each lane has an independent concurrency limit and
+// a bounded wait queue, so that the burst of a lane, such as a pull storm, can not starve the others.
+type PriorityLanes struct {
+	lanes map[Lane]*lane
+}
+
+// NewPriorityLanes create the priority lanes.
+func NewPriorityLanes(conf cc.PriorityLanes) *PriorityLanes {
+	return &PriorityLanes{
+		lanes: map[Lane]*lane{
+			LaneControl: newLane(conf.Control),
+			LaneWatch:   newLane(conf.Watch),
+			LanePull:    newLane(conf.Pull),
+		},
+	}
+}
+
+// Acquire acquires a slot of the lane, the request waits in the lane's queue until a slot is released if the
+// concurrency limit is reached, and fails if the queue is full or the wait exceeds the queue timeout.
+// the release function must be called when the request is done if the slot is acquired.
+func (p *PriorityLanes) Acquire(ctx context.Context, name Lane) (release func(), err error) {
+	l, ok := p.lanes[name]
+	if !ok {
+		return func() {}, nil
+	}
+
+	return l.acquire(ctx)
+}
+
+// Inflight returns the number of the requests in processing of the lane.
+func (p *PriorityLanes) Inflight(name Lane) int {
+	l, ok := p.lanes[name]
+	if !ok {
+		return 0
+	}
+	return len(l.slots)
+}
+
+// Queued returns the number of the requests waiting in the lane's queue.
+func (p *PriorityLanes) Queued(name Lane) int {
+	l, ok := p.lanes[name]
+	if !ok {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return l.queued
+}
+
+// Lanes returns all the lanes.
+func (p *PriorityLanes) Lanes() []Lane {
+	return []Lane{LaneControl, LaneWatch, LanePull}
+}
+
+type lane struct {
+	slots    chan struct{}
+	maxQueue int
+	timeout  time.Duration
+
+	lock   sync.Mutex
+	queued int
+}
+
+func newLane(conf cc.LaneLimit) *lane {
+	return &lane{
+		slots:    make(chan struct{}, conf.MaxConcurrency),
+		maxQueue: int(conf.MaxQueue),
+		timeout:  time.Duration(conf.QueueTimeoutMS) * time.Millisecond,
+	}
+}
+
+func (l *lane) acquire(ctx context.Context) (func(), error) {
+	// 有空闲并发时直接获取, 不进入等待队列
+	select {
+	case l.slots <- struct{}{}:
+		return l.releaseFunc(), nil
+	default:
+	}
+
+	l.lock.Lock()
+	if l.queued >= l.maxQueue {
+		l.lock.Unlock()
+		return nil, ErrLaneQueueFull
+	}
+	l.queued++
+	l.lock.Unlock()
+
+	defer func() {
+		l.lock.Lock()
+		l.queued--
+		l.lock.Unlock()
+	}()
+
+	timer := time.NewTimer(l.timeout)
+	defer timer.Stop()
+
+	select {
+	case l.slots <- struct{}{}:
+		return l.releaseFunc(), nil
+	case <-timer.C:
+		return nil, ErrLaneQueueTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// releaseFunc returns the function to release the acquired slot, it is safe to be called more than once.
+func (l *lane) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() { <-l.slots })
+	}
+}
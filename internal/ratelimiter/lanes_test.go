@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ratelimiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+func TestPriorityLanes(t *testing.T) {
+	lanes := NewPriorityLanes(cc.PriorityLanes{
+		Enable:  true,
+		Control: cc.LaneLimit{MaxConcurrency: 1, MaxQueue: 1, QueueTimeoutMS: 1000},
+		Watch:   cc.LaneLimit{MaxConcurrency: 1, MaxQueue: 1, QueueTimeoutMS: 1000},
+		Pull:    cc.LaneLimit{MaxConcurrency: 2, MaxQueue: 1, QueueTimeoutMS: 200},
+	})
+	ctx := context.Background()
+
+	// 拉取通道占满后排队超时, 排队数超出上限时直接拒绝
+	r1, err := lanes.Acquire(ctx, LanePull)
+	assert.NoError(t, err)
+	r2, err := lanes.Acquire(ctx, LanePull)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, lanes.Inflight(LanePull))
+
+	done := make(chan error)
+	go func() {
+		_, e := lanes.Acquire(ctx, LanePull)
+		done <- e
+	}()
+	assert.Eventually(t, func() bool { return lanes.Queued(LanePull) == 1 }, time.Second, time.Millisecond)
+	_, err = lanes.Acquire(ctx, LanePull)
+	assert.ErrorIs(t, err, ErrLaneQueueFull)
+	assert.ErrorIs(t, <-done, ErrLaneQueueTimeout)
+
+	// 拉取通道占满不影响控制面通道
+	rc, err := lanes.Acquire(ctx, LaneControl)
+	assert.NoError(t, err)
+	rc()
+
+	// 排队中的请求在并发释放后获得执行
+	go func() {
+		release, e := lanes.Acquire(ctx, LanePull)
+		if e == nil {
+			release()
+		}
+		done <- e
+	}()
+	assert.Eventually(t, func() bool { return lanes.Queued(LanePull) == 1 }, time.Second, time.Millisecond)
+	r1()
+	r1()
+	assert.NoError(t, <-done)
+	r2()
+	assert.Equal(t, 0, lanes.Inflight(LanePull))
+}
@@ -56,14 +56,30 @@ func New(config cc.RateLimiter) *RL {
 
 // Enable returns enable of rate limiter
 func (r *RL) Enable() bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	return r.enable
 }
 
 // ClientBandwidth returns client bandwidth of rate limiter
 func (r *RL) ClientBandwidth() uint {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
 	return r.clientBw
 }
 
+// Update updates the rate limiters with the new config, it is used when the config is reloaded,
+// the limiters which are in use are updated in place.
+func (r *RL) Update(config cc.RateLimiter) {
+	r.lock.Lock()
+	r.enable = config.Enable
+	r.clientBw = config.ClientBandwidth
+	r.lock.Unlock()
+
+	r.globalRL.update(config.Global.Limit, config.Global.Burst)
+	r.bizRLs.update(config.Biz)
+}
+
 // Global is global rate limiter
 func (r *RL) Global() RateLimiter {
 	return r.globalRL
@@ -76,6 +92,7 @@ func (r *RL) UseBiz(bizID uint) RateLimiter {
 
 // RL is rate limiter for unified use
 type RL struct {
+	lock     sync.RWMutex
 	enable   bool
 	clientBw uint
 	globalRL *globalRL
@@ -94,6 +111,11 @@ func NewGlobalRL(limit, burst uint) *globalRL {
 	}
 }
 
+// Update updates the limit and burst of the global rate limiter.
+func (g *globalRL) Update(limit, burst uint) {
+	g.update(limit, burst)
+}
+
 // bizRLs is rate limiter for biz dimension
 type bizRLs struct {
 	mutex       sync.Mutex
@@ -127,6 +149,27 @@ func (b *bizRLs) getLimiter(bizID uint) *baseRL {
 	return defaultLimiter
 }
 
+// update updates the biz rate limiters, the biz which is not in spec uses the default config.
+func (b *bizRLs) update(conf cc.BizRLs) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.defaultConf = conf.Default
+	for biz, limiter := range b.bizLimiters {
+		rl, exists := conf.Spec[biz]
+		if !exists {
+			rl = conf.Default
+		}
+		limiter.update(rl.Limit, rl.Burst)
+	}
+
+	for biz, rl := range conf.Spec {
+		if _, exists := b.bizLimiters[biz]; !exists {
+			b.bizLimiters[biz] = newBaseRL(rl.Limit, rl.Burst)
+		}
+	}
+}
+
 // baseRL is base rate limiter
 type baseRL struct {
 	conf              *cc.BasicRL
@@ -165,6 +208,21 @@ func newBaseRL(limit, burst uint) *baseRL {
 	}
 }
 
+// update updates the limit and burst of the rate limiter and its dynamic limiters.
+func (r *baseRL) update(limit, burst uint) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.conf.Limit = limit
+	r.conf.Burst = burst
+	r.limiter.SetLimit(rate.Limit(int(limit) * MB))
+	r.limiter.SetBurst(int(burst) * MB)
+	for _, limiter := range r.dynamicLimiter {
+		limiter.SetLimit(rate.Limit(limit))
+		limiter.SetBurst(int(burst))
+	}
+}
+
 // WaitTimeMil returns the wait time(milliseconds) according to the rate limiter
 func (r *baseRL) WaitTimeMil(size int) int64 {
 	atomic.AddInt64(&r.totalByteSize, int64(size))
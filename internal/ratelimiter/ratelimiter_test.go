@@ -147,3 +147,24 @@ func testStats(t *testing.T, rl RateLimiter) {
 	assert.True(t, stats.DelayMilliseconds <= 2000)
 	t.Logf("stats.DelayMilliseconds: %d", stats.DelayMilliseconds)
 }
+
+func TestUpdate(t *testing.T) {
+	r := New(config)
+	biz1, biz3 := r.UseBiz(1), r.UseBiz(3)
+
+	config2 := config
+	config2.Enable = false
+	config2.Global = cc.BasicRL{Limit: 20, Burst: 30}
+	config2.Biz = cc.BizRLs{
+		Default: cc.BasicRL{Limit: 8, Burst: 8},
+		Spec:    map[string]cc.BasicRL{"2": {Limit: 15, Burst: 15}},
+	}
+	r.Update(config2)
+
+	assert.False(t, r.Enable())
+	assert.Equal(t, 30*MB, r.globalRL.limiter.Burst())
+	// biz 1 is removed from spec, so it falls back to the default config
+	assert.Equal(t, 8*MB, biz1.(*baseRL).limiter.Burst())
+	assert.Equal(t, 8*MB, biz3.(*baseRL).limiter.Burst())
+	assert.Equal(t, 15*MB, r.UseBiz(2).(*baseRL).limiter.Burst())
+}
@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// APIVersion is the version of the inter-service api, it must be increased when a service starts
+	// calling a new method or depending on a new field of another service, so that the compatibility
+	// shims can be applied during the mixed-version rolling upgrade.
+	APIVersion uint32 = 2
+	// MinCompatibleAPIVersion is the lowest api version of the caller which the current version still serves.
+	MinCompatibleAPIVersion uint32 = 1
+
+	// legacyAPIVersion 未携带 api 版本的服务, 即引入版本协商之前的版本
+	legacyAPIVersion uint32 = 1
+
+	apiVersionKey = "x-bkbscp-api-version"
+)
+
+// CompatShim handles the request locally when the upstream service is older than the method required,
+// e.g. returns an empty reply for a report method which the old upstream does not support.
+type CompatShim func(ctx context.Context, req, reply interface{}) error
+
+type compatMethod struct {
+	since uint32
+	shim  CompatShim
+}
+
+var (
+	compatLock    sync.RWMutex
+	compatMethods = make(map[string]compatMethod)
+)
+
+// RegisterCompatShim registers the api version since which the method is supported by the upstream service,
+// and the shim used when the upstream is older than that. nil shim means the request fails with
+// FailedPrecondition instead of the confusing unknown method error.
+func RegisterCompatShim(method string, since uint32, shim CompatShim) {
+	compatLock.Lock()
+	defer compatLock.Unlock()
+	compatMethods[method] = compatMethod{since: since, shim: shim}
+}
+
+func getCompatMethod(method string) (compatMethod, bool) {
+	compatLock.RLock()
+	defer compatLock.RUnlock()
+	m, ok := compatMethods[method]
+	return m, ok
+}
+
+// CallerAPIVersion returns the api version of the caller service, the caller which does not carry
+// the version is regarded as a legacy one, so that the handler can fill the fields it does not send.
+func CallerAPIVersion(ctx context.Context) uint32 {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return parseAPIVersion(md)
+}
+
+func parseAPIVersion(md metadata.MD) uint32 {
+	v := md.Get(apiVersionKey)
+	if len(v) == 0 {
+		return legacyAPIVersion
+	}
+
+	ver, err := strconv.ParseUint(v[0], 10, 32)
+	if err != nil || ver == 0 {
+		return legacyAPIVersion
+	}
+	return uint32(ver)
+}
+
+// APIVersionUnaryServerInterceptor returns the api version of the service in the response header, and
+// rejects the caller whose api version is no longer compatible.
+func APIVersionUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		_ = grpc.SetHeader(ctx, metadata.Pairs(apiVersionKey, strconv.FormatUint(uint64(APIVersion), 10)))
+
+		if ver := CallerAPIVersion(ctx); ver < MinCompatibleAPIVersion {
+			return nil, status.Errorf(codes.FailedPrecondition, "caller api version %d is lower than the min "+
+				"compatible version %d, please upgrade the caller service", ver, MinCompatibleAPIVersion)
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// APIVersionUnaryClientInterceptor carries the api version of the service to the upstream, and applies the
+// registered compatibility shim when the upstream is too old to support the method. As the instances of
+// the upstream are upgraded one by one, the version is negotiated per request rather than per connection.
+func APIVersionUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, apiVersionKey, strconv.FormatUint(uint64(APIVersion), 10))
+
+		header := metadata.MD{}
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		if err == nil || status.Code(err) != codes.Unimplemented {
+			return err
+		}
+
+		cm, ok := getCompatMethod(method)
+		upstream := parseAPIVersion(header)
+		if !ok || upstream >= cm.since {
+			return err
+		}
+
+		if cm.shim == nil {
+			return status.Errorf(codes.FailedPrecondition, "%s requires upstream api version %d, but got %d, "+
+				"the upstream service may be upgrading", method, cm.since, upstream)
+		}
+
+		logs.V(2).Infof("upstream api version %d is lower than %d, use compatibility shim for %s",
+			upstream, cm.since, method)
+		return cm.shim(ctx, req, reply)
+	}
+}
@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAPIVersionCompatShim(t *testing.T) {
+	const method = "/pbcache.Cache/TestNewMethod"
+	shimmed := false
+	RegisterCompatShim(method, APIVersion, func(ctx context.Context, req, reply interface{}) error {
+		shimmed = true
+		return nil
+	})
+
+	// upstream 返回的 api 版本, 为空时模拟未携带版本的旧版本服务
+	upstream := ""
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if len(md.Get(apiVersionKey)) == 0 {
+			t.Errorf("api version is not carried to upstream")
+		}
+		for _, opt := range opts {
+			if h, ok := opt.(grpc.HeaderCallOption); ok && upstream != "" {
+				*h.HeaderAddr = metadata.Pairs(apiVersionKey, upstream)
+			}
+		}
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+
+	interceptor := APIVersionUnaryClientInterceptor()
+	if err := interceptor(context.Background(), method, nil, nil, nil, invoker); err != nil || !shimmed {
+		t.Fatalf("legacy upstream should be handled by the shim, err: %v", err)
+	}
+
+	shimmed = false
+	upstream = "2"
+	err := interceptor(context.Background(), method, nil, nil, nil, invoker)
+	if status.Code(err) != codes.Unimplemented || shimmed {
+		t.Fatalf("upstream supports the method version, the error should be returned, err: %v", err)
+	}
+
+	err = interceptor(context.Background(), "/pbcache.Cache/Unregistered", nil, nil, nil, invoker)
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("unregistered method should not be shimmed, err: %v", err)
+	}
+}
+
+func TestCallerAPIVersion(t *testing.T) {
+	if v := CallerAPIVersion(context.Background()); v != legacyAPIVersion {
+		t.Errorf("caller without version should be legacy, but got %d", v)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiVersionKey, "3"))
+	if v := CallerAPIVersion(ctx); v != 3 {
+		t.Errorf("caller version should be 3, but got %d", v)
+	}
+}
@@ -51,16 +51,20 @@ func LogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		service := path.Dir(info.FullMethod)[1:]
 		method := path.Base(info.FullMethod)
 		realIP := MustGetRealIP(ctx)
+		ctx, breakdown := logs.WithBreakdown(ctx)
 
 		defer func() {
+			cost := time.Since(st)
+			LogSlowRequest(kt, realIP, info.FullMethod, kt.BizID, strconv.Itoa(int(kt.AppID)), cost, breakdown)
+
 			if err != nil {
 				klog.InfoS("grpc", "rid", kt.Rid, "ip", realIP,
-					"service", service, "method", method, "grpc.duration", time.Since(st), "err", err)
+					"service", service, "method", method, "grpc.duration", cost, "err", err)
 				return
 			}
 
 			klog.InfoS("grpc", "rid", kt.Rid, "ip", realIP,
-				"service", service, "method", method, "grpc.duration", time.Since(st))
+				"service", service, "method", method, "grpc.duration", cost)
 		}()
 
 		resp, err = handler(ctx, req)
@@ -68,6 +72,28 @@ func LogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// LogSlowRequest 请求耗时超过慢请求阈值时, 输出方法、业务/服务、调用方、耗时及下游耗时分布
+func LogSlowRequest(kt *kit.Kit, ip, method string, bizID uint32, app string, cost time.Duration,
+	breakdown *logs.Breakdown) {
+	if !logs.IsSlowRequest(cost) {
+		return
+	}
+
+	logs.Warnf("slow request, method: %s, biz: %d, app: %s, user: %s, app_code: %s, ip: %s, cost: %s, "+
+		"downstream: [%s], rid: %s", method, bizID, app, kt.User, kt.AppCode, ip, cost, breakdown.String(), kt.Rid)
+}
+
+// DownstreamUnaryClientInterceptor 记录下游 rpc 调用耗时, 用于慢请求日志的耗时分布
+func DownstreamUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		st := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		logs.RecordDownstream(ctx, "rpc:"+path.Base(method), time.Since(st))
+		return err
+	}
+}
+
 // GrpcServerHandledTotalInterceptor count grpc operands
 func GrpcServerHandledTotalInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
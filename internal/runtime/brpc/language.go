@@ -0,0 +1,87 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n/localizer"
+)
+
+var (
+	lowLangKey           = strings.ToLower(constant.LangKey)
+	lowAcceptLanguageKey = strings.ToLower(constant.AcceptLanguageKey)
+	// grpc-gateway 透传的 http 头部带有 grpcgateway- 前缀
+	gwCookieKey         = "grpcgateway-cookie"
+	gwAcceptLanguageKey = "grpcgateway-" + lowAcceptLanguageKey
+)
+
+// LanguageUnaryServerInterceptor 协商请求语言并写回请求元数据, 使 kit.Lang 在直接调用 grpc 接口及经过网关的
+// 请求中保持一致, 优先级: blueking_language cookie > X-Bkapi-Language > Accept-Language > 默认语言
+func LanguageUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		return handler(withIncomingLang(ctx), req)
+	}
+}
+
+// withIncomingLang 返回带有协商后语言的请求上下文
+func withIncomingLang(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+
+	lang := localizer.Negotiate(langCookie(md), firstMD(md, lowLangKey),
+		firstMD(md, lowAcceptLanguageKey, gwAcceptLanguageKey))
+	if lang == "" {
+		lang = constant.DefaultLanguage
+	}
+
+	md = md.Copy()
+	md.Set(lowLangKey, lang)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// langCookie 获取元数据中 cookie 的蓝鲸语言设置
+func langCookie(md metadata.MD) string {
+	cookies := append(md.Get("cookie"), md.Get(gwCookieKey)...)
+	if len(cookies) == 0 {
+		return ""
+	}
+
+	header := http.Header{}
+	for _, c := range cookies {
+		header.Add("Cookie", c)
+	}
+	if c, err := (&http.Request{Header: header}).Cookie(constant.LangCookieKey); err == nil {
+		return c.Value
+	}
+	return ""
+}
+
+// firstMD 返回第一个非空的元数据值
+func firstMD(md metadata.MD, keys ...string) string {
+	for _, key := range keys {
+		if v := md.Get(key); len(v) != 0 && v[0] != "" {
+			return v[0]
+		}
+	}
+	return ""
+}
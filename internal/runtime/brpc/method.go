@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+// mutatingMethods 显式登记的写接口, 不按方法名猜测. config-server 的写接口支持 Idempotency-Key 幂等,
+// data-service 的写接口在只读维护期间被拒绝. 新增接口时需在此登记, 单测会检查每个接口都已分类.
+var mutatingMethods = methodSet(map[string][]string{
+	"/pbcs.Config/": {
+		"CreateApp", "UpdateApp", "UpdateAppState", "PauseAppConsumption", "ResumeAppConsumption", "DeleteApp",
+		"CreateConfigItem", "BatchUpsertConfigItems", "UpdateConfigItem", "DeleteConfigItem",
+		"BatchDeleteConfigItems", "UnDeleteConfigItem", "BatchUnDeleteConfigItem", "UndoConfigItem",
+		"UpdateConfigHook", "CreateRelease", "DeprecateRelease", "UnDeprecateRelease", "DeleteRelease",
+		"CreateHook", "DeleteHook", "BatchDeleteHook", "UpdateHook", "CreateHookRevision", "DeleteHookRevision",
+		"PublishHookRevision", "UpdateHookRevision", "CreateHookTemplate", "UpdateHookTemplate",
+		"DeleteHookTemplate", "CreateHookFromTemplate", "UpgradeHookFromTemplate", "UpgradeHooksFromTemplate",
+		"CreateEnvironment", "UpdateEnvironment", "DeleteEnvironment", "BindEnvApp", "UnbindEnvApp",
+		"PromoteRelease", "CreateConfigShare", "UpdateConfigShare", "DeleteConfigShare", "CreateConfigShareRef",
+		"DeleteConfigShareRef", "RestoreRecycleBin", "CreateAppSnapshot", "DeleteAppSnapshot",
+		"RestoreAppSnapshot", "CloneApp", "UpsertDraftItem", "DeleteDraftItem", "MergeDraft", "DiscardDraft",
+		"CreateConfigReference", "DeleteConfigReference", "RenewCertificate", "CreateKvOverride",
+		"UpdateKvOverride", "DeleteKvOverride", "CreateCompliancePolicy", "UpdateCompliancePolicy",
+		"DeleteCompliancePolicy", "CreateNotificationChannel", "UpdateNotificationChannel",
+		"DeleteNotificationChannel", "CreateAlertRule", "UpdateAlertRule", "DeleteAlertRule",
+		"CreateClientQuarantine", "UpdateClientQuarantine", "DeleteClientQuarantine", "CreateExperiment",
+		"UpdateExperiment", "DeleteExperiment", "CreateServiceAccount", "UpdateServiceAccount",
+		"DeleteServiceAccount", "ResetServiceAccountKey", "SetAppRoleBinding", "GrantTemporaryAppRole",
+		"DeleteAppRoleBinding", "UpdateMaskingProfile", "UpdateSecretScanSetting", "CreateTemplateSpace",
+		"DeleteTemplateSpace", "UpdateTemplateSpace", "CreateDefaultTmplSpace", "CreateTemplate", "DeleteTemplate",
+		"BatchDeleteTemplate", "UpdateTemplate", "BatchUpsertTemplates", "BatchUpdateTemplatePermissions",
+		"AddTmplsToTmplSets", "DeleteTmplsFromTmplSets", "CreateTemplateRevision", "UpdateTemplateRevision",
+		"CreateTemplateSet", "DeleteTemplateSet", "UpdateTemplateSet", "CreateAppTemplateBinding",
+		"DeleteAppTemplateBinding", "UpdateAppTemplateBinding", "UpdateAppBoundTmplRevisions",
+		"DeleteAppBoundTmplSets", "RemoveAppBoundTmplSet", "ImportFromTemplateSetToApp", "CreateTemplateVariable",
+		"DeleteTemplateVariable", "BatchDeleteTemplateVariable", "UpdateTemplateVariable",
+		"ImportTemplateVariables", "ImportOtherFormatTemplateVariables", "UpdateAppTmplVariables", "CreateGroup",
+		"DeleteGroup", "BatchDeleteGroups", "UpdateGroup", "Publish", "GenerateReleaseAndPublish", "BulkRelease",
+		"CancelOperation", "SubmitPublishApprove", "Approve", "EmergencyPublish", "RevokeEmergencyPublish",
+		"PinReleasedGroup", "UnpinReleasedGroup", "CreateCredentials", "DeleteCredential", "UpdateCredential",
+		"UpdateCredentialScope", "PauseCredentialConsumption", "ResumeCredentialConsumption", "CreateKv",
+		"UpdateKv", "DeleteKv", "BatchDeleteKv", "BatchUpsertKvs", "TransactKvs", "UnDeleteKv", "BatchUnDeleteKv",
+		"UndoKv", "ImportKvs", "RetryClients", "CreateClientQuery", "UpdateClientQuery", "DeleteClientQuery",
+	},
+	"/pbds.Data/": {
+		"CreateApp", "UpdateAppState", "PauseConsumption", "ResumeConsumption", "UpdateApp", "DeleteApp",
+		"CreateConfigItem", "BatchUpsertConfigItems", "UpdateConfigItem", "DeleteConfigItem", "UnDeleteConfigItem",
+		"UndoConfigItem", "UpdateConfigHook", "CreateContent", "CreateCommit", "CreateRelease", "DeprecateRelease",
+		"UnDeprecateRelease", "DeleteRelease", "CreateHook", "DeleteHook", "UpdateHook", "CreateHookRevision",
+		"DeleteHookRevision", "PublishHookRevision", "UpdateHookRevision", "BatchCreateHookExecResults",
+		"BatchCreateFileTamperEvents", "CreateHookTemplate", "UpdateHookTemplate", "DeleteHookTemplate",
+		"CreateHookFromTemplate", "UpgradeHookFromTemplate", "UpgradeHooksFromTemplate", "CreateEnvironment",
+		"UpdateEnvironment", "DeleteEnvironment", "BindEnvApp", "UnbindEnvApp", "PromoteRelease",
+		"CreateConfigShare", "UpdateConfigShare", "DeleteConfigShare", "CreateConfigShareRef",
+		"DeleteConfigShareRef", "RestoreRecycleBin", "CreateAppSnapshot", "DeleteAppSnapshot",
+		"RestoreAppSnapshot", "CloneApp", "CloneAppAsync", "UpsertDraftItem", "DeleteDraftItem", "MergeDraft",
+		"DiscardDraft", "CreateConfigReference", "DeleteConfigReference", "RenewCertificate", "CreateKvOverride",
+		"UpdateKvOverride", "DeleteKvOverride", "CreateCompliancePolicy", "UpdateCompliancePolicy",
+		"DeleteCompliancePolicy", "CreateNotificationChannel", "UpdateNotificationChannel",
+		"DeleteNotificationChannel", "CreateAlertRule", "UpdateAlertRule", "DeleteAlertRule",
+		"CreateClientQuarantine", "UpdateClientQuarantine", "DeleteClientQuarantine", "CreateExperiment",
+		"UpdateExperiment", "DeleteExperiment", "BatchUpsertExperimentExposures", "UpdateMaskingProfile",
+		"UpdateSecretScanSetting", "BatchIncreaseAppConsumption", "CreateServiceAccount", "UpdateServiceAccount",
+		"DeleteServiceAccount", "ResetServiceAccountKey", "SetAppRoleBinding", "DeleteAppRoleBinding",
+		"CreateTemplateSpace", "UpdateTemplateSpace", "DeleteTemplateSpace", "CreateDefaultTmplSpace",
+		"CreateTemplate", "UpdateTemplate", "DeleteTemplate", "BatchDeleteTemplate", "AddTmplsToTmplSets",
+		"DeleteTmplsFromTmplSets", "BatchUpsertTemplates", "BatchUpdateTemplatePermissions",
+		"CreateTemplateRevision", "UpdateTemplateRevision", "DeleteTemplateRevision", "CreateTemplateSet",
+		"UpdateTemplateSet", "DeleteTemplateSet", "CreateAppTemplateBinding", "UpdateAppTemplateBinding",
+		"DeleteAppTemplateBinding", "ImportFromTemplateSetToApp", "UpdateAppTmplVariables",
+		"RemoveAppBoundTmplSet", "CreateTemplateVariable", "UpdateTemplateVariable", "DeleteTemplateVariable",
+		"ImportTemplateVariables", "CreateGroup", "UpdateGroup", "DeleteGroup", "Publish", "SubmitPublishApprove",
+		"Approve", "EmergencyPublish", "RevokeEmergencyPublish", "PinReleasedGroup", "UnpinReleasedGroup",
+		"GenerateReleaseAndPublish", "BulkRelease", "BeginIdempotentRequest", "CompleteIdempotentRequest",
+		"AbortIdempotentRequest", "CancelOperation", "CreateCredential", "DeleteCredential", "UpdateCredential",
+		"UpdateCredentialScopes", "CreateKv", "UpdateKv", "DeleteKv", "BatchUpsertKvs", "TransactKvs",
+		"UnDeleteKv", "UndoKv", "RetryClients", "CreateClientQuery", "UpdateClientQuery", "DeleteClientQuery",
+		"BatchUpsertClientMetrics", "BatchUpdateLastConsumedTime",
+	},
+})
+
+// IsMutatingMethod returns whether the grpc method writes data, e.g. /pbcs.Config/CreateApp.
+func IsMutatingMethod(fullMethod string) bool {
+	return mutatingMethods[fullMethod]
+}
+
+func methodSet(services map[string][]string) map[string]bool {
+	set := make(map[string]bool)
+	for prefix, methods := range services {
+		for _, method := range methods {
+			set[prefix+method] = true
+		}
+	}
+	return set
+}
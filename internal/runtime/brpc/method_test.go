@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc"
+
+	pbcs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbds "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+)
+
+// readMethods 只读接口, 与 mutatingMethods 一起覆盖 config-server 和 data-service 的所有接口
+var readMethods = methodSet(map[string][]string{
+	"/pbcs.Config/": {
+		"GetApp", "GetAppByName", "ListAppsRest", "ListAppsBySpaceRest", "GetConfigItem", "GetReleasedConfigItem",
+		"ListConfigItems", "ListReleasedConfigItems", "ListConfigItemCount", "ListConfigItemByTuple",
+		"GetReleasedKv", "ListReleasedKvs", "ListAllReleasedConfigItems", "ListReleases", "GetReleaseByName",
+		"GetRelease", "CheckReleaseName", "ListHooks", "ListHookTags", "GetHook", "ListHookRevisions",
+		"GetHookRevision", "ListHookReferences", "ListHookRevisionReferences", "GetReleaseHook",
+		"ListHookExecResults", "ListFileTamperEvents", "ListHookTemplates", "GetHookTemplateRef",
+		"ListEnvironments", "ListEnvApps", "ListConfigShares", "ListSharedConfigs", "ListConfigShareRefs",
+		"ListRecycleBin", "ListAppSnapshots", "GetDraft", "SearchFullText", "ListTmplWhereUsed",
+		"ListTmplVariableWhereUsed", "GetConfigRefGraph", "ListExpiringCertificates", "ListKvOverrides",
+		"ListCompliancePolicies", "TestCompliancePolicy", "ListNotificationChannels", "TestNotificationChannel",
+		"ListAlertRules", "ListClientQuarantines", "ListExperiments", "GetExperimentStats", "ListServiceAccounts",
+		"ListAppRoleBindings", "CheckAppPermissions", "GetMaskingProfile", "PreviewMasking",
+		"GetSecretScanSetting", "ScanSecretLeak", "GetAppConsumption", "ListTopConsumingApps",
+		"ListStaleResources", "ListBizUsages", "GetBizOverview", "ListTemplateSpaces", "GetAllBizsOfTmplSpaces",
+		"ListTmplSpacesByIDs", "ListTemplates", "ListTemplatesByIDs", "ListTemplatesNotBound",
+		"ListTemplateByTuple", "ListTmplsOfTmplSet", "ListTemplateSetsAndRevisions", "ListTemplateRevisions",
+		"GetTemplateRevision", "ListTemplateRevisionsByIDs", "ListTmplRevisionNamesByTmplIDs", "ListTemplateSets",
+		"GetLatestTemplateVersionsInSpace", "ListAppTemplateSets", "ListTemplateSetsByIDs", "ListTmplSetsOfBiz",
+		"ListAppTemplateBindings", "ListAppBoundTmplRevisions", "ListReleasedAppBoundTmplRevisions",
+		"GetReleasedAppBoundTmplRevision", "CheckAppTemplateBinding", "ListTmplBoundCounts",
+		"ListTmplRevisionBoundCounts", "ListTmplSetBoundCounts", "ListTmplBoundUnnamedApps",
+		"ListTmplBoundNamedApps", "ListTmplBoundTmplSets", "ListMultiTmplBoundTmplSets",
+		"ListTmplRevisionBoundUnnamedApps", "ListTmplRevisionBoundNamedApps", "ListTmplSetBoundUnnamedApps",
+		"ListMultiTmplSetBoundUnnamedApps", "CheckTemplateSetReferencesApps", "ListTmplSetBoundNamedApps",
+		"ListLatestTmplBoundUnnamedApps", "ListTemplateVariables", "ExtractAppTmplVariables",
+		"GetAppTmplVariableRefs", "GetReleasedAppTmplVariableRefs", "ListAppTmplVariables",
+		"ListReleasedAppTmplVariables", "ListAllGroups", "ListAppGroups", "ListGroupReleasedApps",
+		"GetGroupByName", "ListGroupSelector", "PreviewGroupClients", "PreviewClientRelease", "GetOperation",
+		"ListOperations", "ListEmergencyPublishes", "GetLastSelect", "GetLastPublish", "GetReleasesStatus",
+		"ListAudits", "ListAppTimeline", "ListCredentials", "CheckCredentialName", "ListCredentialScopes",
+		"CredentialScopePreview", "ListKvs", "ListKvHistory", "DiffKvVersions", "FindNearExpiryCertKvs",
+		"CompareKvValue", "ListClients", "ListClientEvents", "ListClientQuerys", "CheckClientQueryName",
+		"ClientConfigVersionStatistics", "ClientPullTrendStatistics", "ClientPullStatistics",
+		"ClientLabelStatistics", "ClientAnnotationStatistics", "ClientVersionStatistics",
+		"ListClientLabelAndAnnotation", "ClientSpecificFailedReason", "CompareConfigItemConflicts",
+		"CompareKvConflicts", "GetTemplateAndNonTemplateCICount",
+	},
+	"/pbds.Data/": {
+		"GetApp", "GetAppByID", "GetAppByName", "ListAppsRest", "ListAppsByIDs", "GetConfigItem",
+		"ListConfigItems", "ListReleasedConfigItems", "ListConfigItemCount", "ListConfigItemByTuple", "GetContent",
+		"GetLatestCommit", "ListReleases", "GetReleaseByName", "GetRelease", "CheckReleaseName",
+		"GetReleasedConfigItem", "GetReleasedKv", "ListReleasedKvs", "ListAllReleasedConfigItems", "ListHooks",
+		"ListHookTags", "ListHookReferences", "GetHook", "HookFetchIDsExcluding", "GetHookReferencedIDs",
+		"ListHookRevisions", "GetHookRevisionByID", "GetHookRevisionByPubState", "ListHookRevisionReferences",
+		"GetReleaseHook", "ListHookExecResults", "ListFileTamperEvents", "ListHookTemplates", "GetHookTemplateRef",
+		"ListEnvironments", "ListEnvApps", "ListConfigShares", "ListConfigShareRefs", "ListRecycleBin",
+		"GetRecycleBin", "ListAppSnapshots", "GetDraft", "SearchFullText", "ListTmplWhereUsed",
+		"ListTmplVariableWhereUsed", "GetConfigRefGraph", "ListExpiringCertificates", "ListKvOverrides",
+		"ListCompliancePolicies", "TestCompliancePolicy", "ListNotificationChannels", "TestNotificationChannel",
+		"ListAlertRules", "ListClientQuarantines", "ListExperiments", "GetExperimentStats", "GetMaskingProfile",
+		"GetSecretScanSetting", "ScanSecretLeak", "GetAppConsumption", "ListTopConsumingApps",
+		"ListStaleResources", "ListBizUsages", "ListServiceAccounts", "GetServiceAccountByKey",
+		"GetServiceAccountByName", "ListAppRoleBindings", "ListUserAppRoles", "ListTemplateSpaces",
+		"GetAllBizsOfTmplSpaces", "ListTmplSpacesByIDs", "ListTemplates", "ListTemplatesByIDs",
+		"ListTemplatesNotBound", "ListTmplsOfTmplSet", "ListTemplateSetsAndRevisions", "ListTemplateByTuple",
+		"ListTemplateRevisions", "GetTemplateRevision", "ListTemplateRevisionsByIDs",
+		"ListTmplRevisionNamesByTmplIDs", "ListTemplateSets", "ListAppTemplateSets", "ListTemplateSetsByIDs",
+		"ListTemplateSetBriefInfoByIDs", "ListTmplSetsOfBiz", "GetLatestTemplateVersionsInSpace",
+		"ListAppTemplateBindings", "ListAppBoundTmplRevisions", "ListReleasedAppBoundTmplRevisions",
+		"GetReleasedAppBoundTmplRevision", "CheckAppTemplateBinding", "ExtractAppTmplVariables",
+		"GetAppTmplVariableRefs", "GetReleasedAppTmplVariableRefs", "ListAppTmplVariables",
+		"ListReleasedAppTmplVariables", "ListTmplBoundCounts", "ListTmplRevisionBoundCounts",
+		"ListTmplSetBoundCounts", "ListTmplBoundUnnamedApps", "ListTmplBoundNamedApps", "ListTmplBoundTmplSets",
+		"ListMultiTmplBoundTmplSets", "ListTmplRevisionBoundUnnamedApps", "ListTmplRevisionBoundNamedApps",
+		"ListTmplSetBoundUnnamedApps", "ListMultiTmplSetBoundUnnamedApps", "ListTmplSetBoundNamedApps",
+		"ListLatestTmplBoundUnnamedApps", "CheckTemplateSetReferencesApps", "ListTemplateVariables",
+		"TemplateVariableFetchIDsExcluding", "ListAllGroups", "ListAppGroups", "GetGroupByName",
+		"ListGroupSelector", "PreviewGroupClients", "PreviewClientRelease", "CountGroupsReleasedApps",
+		"ListGroupReleasedApps", "GetLastSelect", "GetLastPublish", "ListRecentPublishes", "GetReleasesStatus",
+		"ListEmergencyPublishes", "GetOperation", "ListOperations", "ListAudits", "ListAppTimeline",
+		"ListCredentials", "CheckCredentialName", "ListCredentialScopes", "CredentialScopePreview", "ListKvs",
+		"ListKvHistory", "DiffKvVersions", "KvFetchIDsExcluding", "KvFetchKeysExcluding", "FindNearExpiryCertKvs",
+		"CompareKvValue", "ListClients", "ListClientEvents", "ListClientQuerys", "CheckClientQueryName",
+		"ClientConfigVersionStatistics", "ClientPullTrendStatistics", "ClientPullStatistics",
+		"ClientLabelStatistics", "ClientAnnotationStatistics", "ClientVersionStatistics",
+		"ListClientLabelAndAnnotation", "ClientSpecificFailedReason", "ListInstances", "FetchInstanceInfo", "Ping",
+		"CompareConfigItemConflicts", "GetTemplateAndNonTemplateCICount",
+	},
+})
+
+func TestMethodsClassified(t *testing.T) {
+	all := make(map[string]bool)
+	for _, desc := range []grpc.ServiceDesc{pbcs.Config_ServiceDesc, pbds.Data_ServiceDesc} {
+		for _, one := range desc.Methods {
+			method := "/" + desc.ServiceName + "/" + one.MethodName
+			all[method] = true
+			if IsMutatingMethod(method) == readMethods[method] {
+				t.Errorf("method %s should be classified as either mutating or read", method)
+			}
+		}
+	}
+
+	for method := range mutatingMethods {
+		if !all[method] {
+			t.Errorf("mutating method %s is not found", method)
+		}
+	}
+	for method := range readMethods {
+		if !all[method] {
+			t.Errorf("read method %s is not found", method)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
 	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
@@ -87,11 +88,18 @@ func newOneClient(opt PoolOption) (interface{}, error) {
 		PermitWithoutStream: true,
 	}
 
+	// 故障注入放在最内层, 以便演练时触发熔断和重试
+	interceptors := append([]grpc.UnaryClientInterceptor{RequestIDUnaryClientInterceptor(),
+		DownstreamUnaryClientInterceptor()}, opt.Interceptors...)
+	interceptors = append(interceptors, APIVersionUnaryClientInterceptor(), chaos.UnaryClientInterceptor())
+
 	opts := make([]grpc.DialOption, 0)
 	opts = append(opts, opt.SvrDiscover.LBRoundRobin(),
 		grpc.WithWriteBufferSize(opt.WriteBufferSizeMB*1024*1024),
 		grpc.WithReadBufferSize(opt.ReadBufferSizeMB*1024*1024),
-		grpc.WithKeepaliveParams(kpOpt))
+		grpc.WithKeepaliveParams(kpOpt),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+		grpc.WithChainStreamInterceptor(chaos.StreamClientInterceptor()))
 
 	tls := opt.TLS
 
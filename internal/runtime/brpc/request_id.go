@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+var (
+	lowRidKey        = strings.ToLower(constant.RidKey)
+	lowRidKeyGeneric = strings.ToLower(constant.RidKeyGeneric)
+)
+
+// RequestIDUnaryServerInterceptor 确保请求携带 request id, 未携带时生成并写回请求元数据, 使后续处理
+// 获取到同一个 request id, 同时通过响应头返回给调用方, 便于用户反馈问题时提供
+func RequestIDUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		ctx, rid := withIncomingRid(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(lowRidKeyGeneric, rid))
+		return handler(ctx, req)
+	}
+}
+
+// withIncomingRid 返回带有 request id 的请求上下文
+func withIncomingRid(ctx context.Context) (context.Context, string) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.MD{}
+	}
+
+	if rid := md.Get(lowRidKey); len(rid) != 0 && rid[0] != "" {
+		return ctx, rid[0]
+	}
+
+	// 由 kit 统一处理通用的 X-Request-Id 及 request id 的生成
+	rid := kit.FromGrpcContext(ctx).Rid
+	md = md.Copy()
+	md.Set(lowRidKey, rid)
+	return metadata.NewIncomingContext(ctx, md), rid
+}
+
+// RequestIDUnaryClientInterceptor 将当前上下文中的 request id 透传给下游服务, 未显式设置元数据的调用
+// (如直接使用 kit.Ctx 发起的调用) 也能保持同一个 request id
+func RequestIDUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(lowRidKey)) != 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if rid := ridFromContext(ctx); rid != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, lowRidKey, rid)
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// ridFromContext 从上下文中获取 request id, 依次为 kit 写入的值、上游请求的元数据
+func ridFromContext(ctx context.Context) string {
+	if rid, ok := ctx.Value(constant.RidKey).(string); ok && rid != "" {
+		return rid
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{lowRidKey, lowRidKeyGeneric} {
+		if rid := md.Get(key); len(rid) != 0 && rid[0] != "" {
+			return rid[0]
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,292 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// maxRetryTokens 重试预算可累积的最大令牌数, 允许短时间内的少量突发重试
+const maxRetryTokens = 10
+
+// ResilienceOption defines the deadline, circuit breaker and retry budget options of the grpc client,
+// so that a slow or broken upstream service fails fast rather than piling up the caller's goroutines.
+type ResilienceOption struct {
+	// Timeout is the default timeout of the requests which have no deadline, 0 means no default timeout.
+	Timeout time.Duration
+	// FailureThreshold is the consecutive failures of a method to open its circuit breaker, 0 means no
+	// circuit breaker.
+	FailureThreshold int
+	// OpenDuration is how long the circuit breaker keeps open before a probe request is allowed.
+	OpenDuration time.Duration
+	// HedgeDelay is the delay to send a hedged request when an idempotent request is not responded,
+	// 0 means no hedged request, the idempotent request is only retried once when the upstream is unavailable.
+	HedgeDelay time.Duration
+	// RetryBudgetRatio is the max ratio of the retried and hedged requests to the requests.
+	RetryBudgetRatio float64
+	// Idempotent returns whether the method can be retried and hedged, nil means IsReadMethod.
+	Idempotent func(method string) bool
+}
+
+// IsReadMethod returns whether the grpc method is a read method according to its name.
+func IsReadMethod(method string) bool {
+	name := path.Base(method)
+	for _, prefix := range []string{"Get", "List", "Query", "Check", "Authorize"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResilienceUnaryClientInterceptor sets the default deadline, breaks the circuit of the failing methods and
+// retries or hedges the idempotent requests within the retry budget.
+func ResilienceUnaryClientInterceptor(opt ResilienceOption) grpc.UnaryClientInterceptor {
+	if opt.Idempotent == nil {
+		opt.Idempotent = IsReadMethod
+	}
+	r := &resilience{opt: opt, budget: &retryBudget{ratio: opt.RetryBudgetRatio}}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+		br := r.breaker(method)
+		if !br.allow(time.Now()) {
+			return status.Errorf(codes.Unavailable, "circuit breaker of %s is open", method)
+		}
+
+		// 请求未设置超时时间时使用默认超时, 已有的超时时间会随请求传递给上游
+		if _, ok := ctx.Deadline(); !ok && r.opt.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, r.opt.Timeout)
+			defer cancel()
+		}
+
+		r.budget.deposit()
+		var err error
+		if r.opt.Idempotent(method) {
+			err = r.invokeIdempotent(ctx, method, req, reply, cc, invoker, opts...)
+		} else {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		if br.done(!isUpstreamFailure(err), time.Now()) {
+			logs.Warnf("circuit breaker of %s is opened for %s, err: %v", method, r.opt.OpenDuration, err)
+		}
+		return err
+	}
+}
+
+type resilience struct {
+	opt      ResilienceOption
+	breakers sync.Map
+	budget   *retryBudget
+}
+
+func (r *resilience) breaker(method string) *breaker {
+	if r.opt.FailureThreshold <= 0 {
+		return nil
+	}
+
+	if br, ok := r.breakers.Load(method); ok {
+		return br.(*breaker)
+	}
+
+	br, _ := r.breakers.LoadOrStore(method, &breaker{threshold: r.opt.FailureThreshold,
+		openDuration: r.opt.OpenDuration})
+	return br.(*breaker)
+}
+
+// invokeIdempotent sends a hedged request if the request is not responded after the hedge delay, or
+// retries once if the upstream is unavailable, the first succeed response is used.
+func (r *resilience) invokeIdempotent(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+
+	msg, ok := reply.(proto.Message)
+	if !ok || r.opt.HedgeDelay <= 0 {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if isRetryable(err) && ctx.Err() == nil && r.budget.withdraw() {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+
+	type result struct {
+		reply proto.Message
+		err   error
+	}
+
+	hctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan result, 2)
+	call := func() {
+		one := msg.ProtoReflect().New().Interface()
+		err := invoker(hctx, method, req, one, cc, opts...)
+		results <- result{reply: one, err: err}
+	}
+
+	inflight, hedged := 1, false
+	hedge := func() bool {
+		if hedged || !r.budget.withdraw() {
+			return false
+		}
+		hedged = true
+		inflight++
+		go call()
+		return true
+	}
+
+	go call()
+	timer := time.NewTimer(r.opt.HedgeDelay)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			hedge()
+
+		case res := <-results:
+			inflight--
+			if res.err == nil {
+				proto.Reset(msg)
+				proto.Merge(msg, res.reply)
+				return nil
+			}
+
+			if inflight > 0 {
+				continue
+			}
+
+			if isRetryable(res.err) && ctx.Err() == nil && hedge() {
+				continue
+			}
+			return res.err
+		}
+	}
+}
+
+// isRetryable returns whether the request can be retried on another upstream instance.
+func isRetryable(err error) bool {
+	return status.Code(err) == codes.Unavailable
+}
+
+// isUpstreamFailure returns whether the error is caused by the upstream's unavailability, the business
+// errors do not count as failures of the circuit breaker.
+func isUpstreamFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// breaker is a consecutive failures based circuit breaker, it allows one probe request after it keeps
+// open for the open duration, and closes when the probe request succeed.
+type breaker struct {
+	lock         sync.Mutex
+	threshold    int
+	openDuration time.Duration
+	failures     int
+	openUntil    time.Time
+	probing      bool
+}
+
+// allow returns whether the request is allowed, nil breaker allows all the requests.
+func (b *breaker) allow(now time.Time) bool {
+	if b == nil {
+		return true
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.failures < b.threshold {
+		return true
+	}
+
+	if now.Before(b.openUntil) || b.probing {
+		return false
+	}
+
+	b.probing = true
+	return true
+}
+
+// done records the request's result, returns true if the breaker is opened by this request.
+func (b *breaker) done(success bool, now time.Time) bool {
+	if b == nil {
+		return false
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.probing = false
+	if success {
+		b.failures = 0
+		return false
+	}
+
+	b.failures++
+	if b.failures < b.threshold {
+		return false
+	}
+
+	opened := !now.Before(b.openUntil)
+	b.openUntil = now.Add(b.openDuration)
+	return opened
+}
+
+// retryBudget limits the retried requests to a ratio of the requests, so that the retries do not
+// amplify the load of an overloaded upstream.
+type retryBudget struct {
+	lock   sync.Mutex
+	ratio  float64
+	tokens float64
+}
+
+func (b *retryBudget) deposit() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.tokens += b.ratio
+	if b.tokens > maxRetryTokens {
+		b.tokens = maxRetryTokens
+	}
+}
+
+func (b *retryBudget) withdraw() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
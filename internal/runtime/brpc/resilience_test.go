@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestResilienceCircuitBreaker(t *testing.T) {
+	interceptor := ResilienceUnaryClientInterceptor(ResilienceOption{
+		FailureThreshold: 2,
+		OpenDuration:     50 * time.Millisecond,
+	})
+
+	var calls atomic.Int32
+	failing := true
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		calls.Add(1)
+		if failing {
+			return status.Error(codes.Unavailable, "upstream is down")
+		}
+		return nil
+	}
+
+	const method = "/pbcache.Cache/SetPublishTime"
+	for i := 0; i < 3; i++ {
+		_ = interceptor(context.Background(), method, nil, nil, nil, invoker)
+	}
+	if calls.Load() != 2 {
+		t.Fatalf("the breaker should be opened after 2 failures, but upstream is called %d times", calls.Load())
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	failing = false
+	if err := interceptor(context.Background(), method, nil, nil, nil, invoker); err != nil {
+		t.Fatalf("the probe call should be allowed, err: %v", err)
+	}
+	if err := interceptor(context.Background(), method, nil, nil, nil, invoker); err != nil {
+		t.Fatalf("the breaker should be closed after the probe call succeed, err: %v", err)
+	}
+}
+
+func TestResilienceHedge(t *testing.T) {
+	interceptor := ResilienceUnaryClientInterceptor(ResilienceOption{
+		HedgeDelay:       10 * time.Millisecond,
+		RetryBudgetRatio: 1,
+	})
+
+	var calls atomic.Int32
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		// 首个请求阻塞直到被取消, 对冲请求立即返回
+		if calls.Add(1) == 1 {
+			<-ctx.Done()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		reply.(*wrapperspb.StringValue).Value = "hedged"
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	reply := new(wrapperspb.StringValue)
+	if err := interceptor(ctx, "/pbcache.Cache/GetAppMeta", nil, reply, nil, invoker); err != nil {
+		t.Fatalf("hedged call failed, err: %v", err)
+	}
+	if reply.Value != "hedged" {
+		t.Errorf("the reply of the hedged call should be used, got %q", reply.Value)
+	}
+}
@@ -30,6 +30,8 @@ type PoolOption struct {
 	SvrDiscover       serviced.Discover
 	TLS               cc.TLSConfig
 	NewClient         func(conn *grpc.ClientConn) interface{}
+	// Interceptors are the extra unary interceptors of the clients, such as the resilience interceptor.
+	Interceptors []grpc.UnaryClientInterceptor
 }
 
 // Validate the pool option is validate or not.
@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package brpc
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/protocol/compat"
+)
+
+// loggedUnknownFields 已记录过的未知字段, 同一方法的同一组未知字段只记录一次, 避免刷屏
+var loggedUnknownFields sync.Map
+
+// UnknownFieldsUnaryServerInterceptor logs the unknown fields of the requests, which means the caller is
+// newer than the current service and the fields are dropped, so that the protocol incompatibility during
+// the rolling upgrade can be found.
+func UnknownFieldsUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (
+		interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			logUnknownFields(ctx, info.FullMethod, msg)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func logUnknownFields(ctx context.Context, method string, msg proto.Message) {
+	fields := compat.UnknownFields(msg)
+	if len(fields) == 0 {
+		return
+	}
+
+	key := method + "|" + strings.Join(fields, ",")
+	if _, loaded := loggedUnknownFields.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+
+	kt := kit.FromGrpcContext(ctx)
+	logs.Warnf("request of %s has unknown fields %v, caller api version: %d, the caller may be newer than "+
+		"current service, rid: %s", method, fields, CallerAPIVersion(ctx), kt.Rid)
+}
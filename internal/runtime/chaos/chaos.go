@@ -0,0 +1,236 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package chaos provides the fault injection hooks for resilience testing, the faults are injected with the ctl
+// commands at runtime and expire automatically, nothing is injected if no fault is set.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+const (
+	// RPCPointPrefix is the prefix of the rpc injection point, the point is matched with the rpc full method by
+	// prefix, e.g: "rpc:/pbcache.Cache/" matches all the rpc calls to cache service.
+	RPCPointPrefix = "rpc:"
+	// FeedEventPoint is the injection point of the release events which feed server watched from cache service.
+	FeedEventPoint = "feed:event"
+	// FeedNotifyPoint is the injection point of the events which feed server notifies to the watching clients.
+	FeedNotifyPoint = "feed:notify"
+
+	// DefaultTTL is the default ttl of the fault, to avoid the fault is forgotten to be cleared.
+	DefaultTTL = 5 * time.Minute
+	// maxTTL is the max ttl of the fault.
+	maxTTL = 24 * time.Hour
+)
+
+// Fault defines the fault injected at the injection point.
+type Fault struct {
+	// Point is the injection point.
+	Point string `json:"point"`
+	// Latency is the latency added before the operation.
+	Latency time.Duration `json:"latency"`
+	// ErrorRate is the probability [0, 1] to fail the operation.
+	ErrorRate float64 `json:"error_rate"`
+	// DropRate is the probability [0, 1] to drop the event.
+	DropRate float64 `json:"drop_rate"`
+	// ExpireAt is the time when the fault is expired.
+	ExpireAt time.Time `json:"expire_at"`
+}
+
+// Validate the fault.
+func (f Fault) Validate() error {
+	if f.Point == "" {
+		return errors.New("fault point is required")
+	}
+
+	if f.Point != FeedEventPoint && f.Point != FeedNotifyPoint && !strings.HasPrefix(f.Point, RPCPointPrefix) {
+		return fmt.Errorf("unsupported fault point %s, should be %s, %s or with prefix %s", f.Point, FeedEventPoint,
+			FeedNotifyPoint, RPCPointPrefix)
+	}
+
+	if f.Latency < 0 {
+		return errors.New("fault latency should >= 0")
+	}
+
+	if f.ErrorRate < 0 || f.ErrorRate > 1 || f.DropRate < 0 || f.DropRate > 1 {
+		return errors.New("fault error rate and drop rate should be in [0, 1]")
+	}
+
+	if f.Latency == 0 && f.ErrorRate == 0 && f.DropRate == 0 {
+		return errors.New("at least one of latency, error rate and drop rate should be set")
+	}
+
+	return nil
+}
+
+// faults is the injected faults, active is used to skip the lookup when no fault is injected.
+var faults = struct {
+	sync.RWMutex
+	points map[string]Fault
+	active atomic.Bool
+}{points: make(map[string]Fault)}
+
+// Inject injects the fault, the fault with the same point is replaced.
+func Inject(f Fault, ttl time.Duration) (Fault, error) {
+	if err := f.Validate(); err != nil {
+		return Fault{}, err
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if ttl > maxTTL {
+		return Fault{}, fmt.Errorf("fault ttl should <= %s", maxTTL)
+	}
+	f.ExpireAt = time.Now().Add(ttl)
+
+	faults.Lock()
+	defer faults.Unlock()
+	faults.points[f.Point] = f
+	faults.active.Store(true)
+
+	logs.Warnf("chaos fault injected, fault: %+v", f)
+	return f, nil
+}
+
+// Clear clears the fault of the point, all the faults are cleared if point is empty.
+func Clear(point string) {
+	faults.Lock()
+	defer faults.Unlock()
+
+	if point == "" {
+		faults.points = make(map[string]Fault)
+	} else {
+		delete(faults.points, point)
+	}
+	faults.active.Store(len(faults.points) > 0)
+
+	logs.Warnf("chaos fault cleared, point: %s", point)
+}
+
+// List returns the faults which are not expired.
+func List() []Fault {
+	now := time.Now()
+
+	faults.RLock()
+	defer faults.RUnlock()
+
+	list := make([]Fault, 0, len(faults.points))
+	for _, f := range faults.points {
+		if f.ExpireAt.After(now) {
+			list = append(list, f)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Point < list[j].Point })
+
+	return list
+}
+
+// match returns the fault which matches the point, rpc points are matched by prefix.
+func match(point string) (Fault, bool) {
+	if !faults.active.Load() {
+		return Fault{}, false
+	}
+
+	now := time.Now()
+	faults.RLock()
+	defer faults.RUnlock()
+
+	if f, exists := faults.points[point]; exists && f.ExpireAt.After(now) {
+		return f, true
+	}
+
+	if !strings.HasPrefix(point, RPCPointPrefix) {
+		return Fault{}, false
+	}
+
+	// use the longest matched rpc point.
+	var hit Fault
+	for p, f := range faults.points {
+		if strings.HasPrefix(point, p) && len(p) > len(hit.Point) && f.ExpireAt.After(now) {
+			hit = f
+		}
+	}
+
+	return hit, hit.Point != ""
+}
+
+// Delay adds the latency and returns error by the fault of the point.
+func Delay(ctx context.Context, point string) error {
+	f, hit := match(point)
+	if !hit {
+		return nil
+	}
+
+	if f.Latency > 0 {
+		timer := time.NewTimer(f.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	if f.ErrorRate > 0 && rand.Float64() < f.ErrorRate { //nolint:gosec
+		return status.Errorf(codes.Unavailable, "chaos fault injected at %s", point)
+	}
+
+	return nil
+}
+
+// Drop returns true if the event at the point should be dropped by the fault.
+func Drop(point string) bool {
+	f, hit := match(point)
+	if !hit || f.DropRate == 0 {
+		return false
+	}
+
+	return rand.Float64() < f.DropRate //nolint:gosec
+}
+
+// UnaryClientInterceptor injects the rpc faults for the unary rpc calls.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := Delay(ctx, RPCPointPrefix+method); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor injects the rpc faults when the stream is created.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if err := Delay(ctx, RPCPointPrefix+method); err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestInject(t *testing.T) {
+	defer Clear("")
+
+	if _, err := Inject(Fault{Point: "unknown", DropRate: 1}, 0); err == nil {
+		t.Errorf("inject unsupported point should be failed")
+	}
+
+	if Drop(FeedEventPoint) {
+		t.Errorf("event should not be dropped without fault")
+	}
+
+	if _, err := Inject(Fault{Point: FeedEventPoint, DropRate: 1}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if !Drop(FeedEventPoint) || Drop(FeedNotifyPoint) {
+		t.Errorf("only the event of injected point should be dropped")
+	}
+
+	if _, err := Inject(Fault{Point: "rpc:/pbcache.Cache/", ErrorRate: 1}, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := Delay(context.Background(), "rpc:/pbcache.Cache/GetAppID"); err == nil {
+		t.Errorf("rpc call matched by prefix should be failed")
+	}
+	if err := Delay(context.Background(), "rpc:/pbds.Data/GetAppByID"); err != nil {
+		t.Errorf("rpc call not matched should not be failed, err: %v", err)
+	}
+
+	if len(List()) != 2 {
+		t.Errorf("unexpected faults: %+v", List())
+	}
+
+	Clear(FeedEventPoint)
+	if Drop(FeedEventPoint) {
+		t.Errorf("event should not be dropped after fault cleared")
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ctl
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
+)
+
+// AuthTokenHeader is the header to pass the token of control and debug endpoints.
+const AuthTokenHeader = "X-Bscp-Ctl-Token"
+
+// DebugEnabled returns whether the debug(pprof) endpoints and commands are enabled, they expose the runtime
+// details of the process, so they are only enabled when ctlToken is configured.
+func DebugEnabled() bool {
+	return cc.CtlToken() != ""
+}
+
+// Auth 控制及调试(pprof)接口鉴权, 未配置 ctlToken 时不鉴权, 此时调试接口不会被挂载
+func Auth(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		token := cc.CtlToken()
+		if token == "" {
+			next.ServeHTTP(w, req)
+			return
+		}
+
+		got := req.Header.Get(AuthTokenHeader)
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			logs.Warnf("access control endpoint %s with invalid token, remote addr: %s", req.URL.Path,
+				req.RemoteAddr)
+			w.WriteHeader(http.StatusUnauthorized)
+			rest.WriteResp(w, rest.NewBaseResp(errf.PermissionDenied, "invalid control token"))
+			return
+		}
+
+		next.ServeHTTP(w, req)
+	})
+}
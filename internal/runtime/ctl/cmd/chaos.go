@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cmd
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/chaos"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// WithChaos init and returns the fault injection commands, which are used for resilience testing.
+func WithChaos() []Cmd {
+	return []Cmd{withChaosInject(), withChaosClear(), withChaosList()}
+}
+
+func withChaosInject() Cmd {
+	return &defaultCmd{
+		cmd: &Command{
+			Name: "chaos-inject",
+			Usage: "inject fault for resilience testing, supported points: " + chaos.FeedEventPoint + ", " +
+				chaos.FeedNotifyPoint + " and rpc points with prefix " + chaos.RPCPointPrefix +
+				", e.g: rpc:/pbcache.Cache/. ONLY use it in testing environment",
+			Parameters: []Parameter{{
+				Name:  "point",
+				Usage: "defines the injection point",
+				Value: new(string),
+			}, {
+				Name:    "latency_ms",
+				Usage:   "defines the latency in milliseconds added to the rpc calls",
+				Value:   new(uint32),
+				Default: new(uint32),
+			}, {
+				Name:    "error_rate",
+				Usage:   "defines the probability [0, 1] to fail the rpc calls",
+				Value:   new(float64),
+				Default: new(float64),
+			}, {
+				Name:    "drop_rate",
+				Usage:   "defines the probability [0, 1] to drop the events",
+				Value:   new(float64),
+				Default: new(float64),
+			}, {
+				Name:    "ttl_sec",
+				Usage:   "defines the seconds after which the fault is expired, default is 300",
+				Value:   new(uint32),
+				Default: new(uint32),
+			}},
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				point, exists := params["point"]
+				if !exists {
+					return nil, errf.New(errf.InvalidParameter, "point is not set")
+				}
+
+				fault := chaos.Fault{
+					Point:     *point.(*string),
+					Latency:   time.Duration(*params["latency_ms"].(*uint32)) * time.Millisecond,
+					ErrorRate: *params["error_rate"].(*float64),
+					DropRate:  *params["drop_rate"].(*float64),
+				}
+				ttl := time.Duration(*params["ttl_sec"].(*uint32)) * time.Second
+
+				injected, err := chaos.Inject(fault, ttl)
+				if err != nil {
+					return nil, errf.New(errf.InvalidParameter, err.Error())
+				}
+
+				logs.Warnf("successfully injected chaos fault %s, rid: %s", injected.Point, kt.Rid)
+				return injected, nil
+			},
+		},
+	}
+}
+
+func withChaosClear() Cmd {
+	return &defaultCmd{
+		cmd: &Command{
+			Name:  "chaos-clear",
+			Usage: "clear the injected fault, all the faults are cleared if point is not set",
+			Parameters: []Parameter{{
+				Name:    "point",
+				Usage:   "defines the injection point to clear",
+				Value:   new(string),
+				Default: new(string),
+			}},
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				chaos.Clear(*params["point"].(*string))
+				return nil, nil
+			},
+		},
+	}
+}
+
+func withChaosList() Cmd {
+	return &defaultCmd{
+		cmd: &Command{
+			Name:  "chaos-list",
+			Usage: "list the injected faults which are not expired",
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				return chaos.List(), nil
+			},
+		},
+	}
+}
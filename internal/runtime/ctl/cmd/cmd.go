@@ -113,6 +113,11 @@ type Cmd interface {
 	Validate() error
 }
 
+// NewCmd returns a default Cmd with only one Command, which is used by the services to define their own commands.
+func NewCmd(c *Command) Cmd {
+	return &defaultCmd{cmd: c}
+}
+
 // defaultCmd default cmd implement with only one Command and its validation.
 type defaultCmd struct {
 	cmd *Command
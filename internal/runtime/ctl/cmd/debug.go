@@ -0,0 +1,145 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"runtime/metrics"
+	"runtime/pprof"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// WithHeapProfile init and returns the heap profile capture command.
+func WithHeapProfile() Cmd {
+	cmd := &defaultCmd{
+		cmd: &Command{
+			Name: "heap-profile",
+			Usage: "capture a heap profile to a file in the ctl profile directory on the host, analyze it with " +
+				"'go tool pprof'",
+			Parameters: []Parameter{{
+				Name:    "gc",
+				Usage:   "defines whether to run a garbage collection before capture to get up-to-date statistics",
+				Default: true,
+				Value:   new(bool),
+			}},
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				if ParamValue[bool](params, "gc") {
+					runtime.GC()
+				}
+
+				name := fmt.Sprintf("bscp-%s-heap-%d-%s.pprof", cc.ServiceName(), os.Getpid(),
+					time.Now().Format("20060102150405"))
+				file := filepath.Join(cc.CtlProfileDir(), name)
+				f, err := os.Create(file)
+				if err != nil {
+					return nil, errf.New(errf.Aborted, fmt.Sprintf("create heap profile file failed, err: %v", err))
+				}
+				defer f.Close()
+
+				if err := pprof.WriteHeapProfile(f); err != nil {
+					return nil, errf.New(errf.Aborted, fmt.Sprintf("write heap profile failed, err: %v", err))
+				}
+
+				logs.Infof("captured heap profile to %s, rid: %s", file, kt.Rid)
+				return map[string]string{"file": file}, nil
+			},
+		},
+	}
+
+	return cmd
+}
+
+// GCStats is the garbage collection and memory statistics of the process.
+type GCStats struct {
+	NumGoroutine  int       `json:"num_goroutine"`
+	NumGC         int64     `json:"num_gc"`
+	LastGC        time.Time `json:"last_gc"`
+	PauseTotal    string    `json:"pause_total"`
+	RecentPauses  []string  `json:"recent_pauses"`
+	HeapAlloc     uint64    `json:"heap_alloc"`
+	HeapInuse     uint64    `json:"heap_inuse"`
+	HeapObjects   uint64    `json:"heap_objects"`
+	Sys           uint64    `json:"sys"`
+	NextGC        uint64    `json:"next_gc"`
+	GCCPUFraction float64   `json:"gc_cpu_fraction"`
+	GCPercent     int       `json:"gc_percent"`
+	MemoryLimit   int64     `json:"memory_limit"`
+}
+
+// WithGCStats init and returns the gc stats command.
+func WithGCStats() Cmd {
+	cmd := &defaultCmd{
+		cmd: &Command{
+			Name:    "gc-stats",
+			Usage:   "get the garbage collection, memory and goroutine statistics",
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				gc := new(debug.GCStats)
+				debug.ReadGCStats(gc)
+				ms := new(runtime.MemStats)
+				runtime.ReadMemStats(ms)
+
+				// 通过 runtime/metrics 读取, 避免调用 SetGCPercent 修改运行中进程的 gc 设置
+				gcPercent := []metrics.Sample{{Name: "/gc/gogc:percent"}}
+				metrics.Read(gcPercent)
+
+				stats := &GCStats{
+					NumGoroutine:  runtime.NumGoroutine(),
+					NumGC:         gc.NumGC,
+					LastGC:        gc.LastGC,
+					PauseTotal:    gc.PauseTotal.String(),
+					HeapAlloc:     ms.HeapAlloc,
+					HeapInuse:     ms.HeapInuse,
+					HeapObjects:   ms.HeapObjects,
+					Sys:           ms.Sys,
+					NextGC:        ms.NextGC,
+					GCCPUFraction: ms.GCCPUFraction,
+					GCPercent:     int(gcPercent[0].Value.Uint64()),
+					MemoryLimit:   debug.SetMemoryLimit(-1),
+				}
+
+				// 最近 10 次 gc 停顿时间
+				for i := 0; i < len(gc.Pause) && i < 10; i++ {
+					stats.RecentPauses = append(stats.RecentPauses, gc.Pause[i].String())
+				}
+
+				return stats, nil
+			},
+		},
+	}
+
+	return cmd
+}
+
+// ParamValue returns the value of the parameter which is decoded into a pointer or set with the default value.
+func ParamValue[T any](params map[string]interface{}, name string) T {
+	var zero T
+	switch v := params[name].(type) {
+	case *T:
+		return *v
+	case T:
+		return v
+	default:
+		return zero
+	}
+}
@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// WithEnableReadOnly init and returns the enabling platform-level read-only maintenance mode command, the mode is
+// shared by all the services, the data service rejects all the writes except the clients' reports while the feed
+// server keeps serving.
+func WithEnableReadOnly(sd serviced.Service) Cmd {
+	cmd := &servicedCmd{
+		cmd: &Command{
+			Name:  "enable-read-only",
+			Usage: "enable the platform-level read-only mode, the writes except client reports are rejected",
+			Parameters: []Parameter{{
+				Name:  "reason",
+				Usage: "defines the reason of the maintenance which is returned to the rejected writes",
+				Value: new(string),
+			}},
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				state := serviced.ReadOnlyState{Enabled: true, Operator: kt.User, Since: time.Now()}
+				if reason, ok := params["reason"]; ok {
+					state.Reason = strings.TrimSpace(*reason.(*string))
+				}
+
+				if err := sd.Maintenance().SetReadOnly(kt.Ctx, state); err != nil {
+					logs.Errorf("enable read-only maintenance mode failed, err: %v, rid: %s", err, kt.Rid)
+					return nil, err
+				}
+
+				logs.Infof("successfully enabled read-only maintenance mode, state: %+v, rid: %s", state, kt.Rid)
+				return nil, nil
+			},
+		},
+		sd: sd,
+	}
+
+	return cmd
+}
+
+// WithDisableReadOnly init and returns the disabling platform-level read-only maintenance mode command.
+func WithDisableReadOnly(sd serviced.Service) Cmd {
+	cmd := &servicedCmd{
+		cmd: &Command{
+			Name:    "disable-read-only",
+			Usage:   "disable the platform-level read-only maintenance mode",
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				if err := sd.Maintenance().SetReadOnly(kt.Ctx, serviced.ReadOnlyState{}); err != nil {
+					logs.Errorf("disable read-only maintenance mode failed, err: %v, rid: %s", err, kt.Rid)
+					return nil, err
+				}
+
+				logs.Infof("successfully disabled read-only maintenance mode, rid: %s", kt.Rid)
+				return nil, nil
+			},
+		},
+		sd: sd,
+	}
+
+	return cmd
+}
+
+// WithGetReadOnly init and returns the getting platform-level read-only maintenance state command.
+func WithGetReadOnly(sd serviced.Service) Cmd {
+	cmd := &servicedCmd{
+		cmd: &Command{
+			Name:    "get-read-only",
+			Usage:   "get the platform-level read-only maintenance state",
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				return sd.Maintenance().ReadOnly(), nil
+			},
+		},
+		sd: sd,
+	}
+
+	return cmd
+}
+
+// WithReadOnly init and returns the read-only maintenance mode commands.
+func WithReadOnly(sd serviced.Service) []Cmd {
+	return []Cmd{WithEnableReadOnly(sd), WithDisableReadOnly(sd), WithGetReadOnly(sd)}
+}
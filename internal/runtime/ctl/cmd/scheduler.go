@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/scheduler"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// WithJobs init and returns the job scheduler related commands.
+func WithJobs(sch *scheduler.Scheduler) []Cmd {
+	list := &jobCmd{
+		sch: sch,
+		cmd: &Command{
+			Name:    "list-jobs",
+			Usage:   "list the background jobs with their schedule and last run status",
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				return sch.List(), nil
+			},
+		},
+	}
+
+	trigger := &jobCmd{
+		sch: sch,
+		cmd: &Command{
+			Name:  "trigger-job",
+			Usage: "run the background job immediately on the leader instance",
+			Parameters: []Parameter{{
+				Name:  "name",
+				Usage: "defines the name of the job to trigger",
+				Value: new(string),
+			}},
+			FromURL: true,
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				nameVal, exists := params["name"]
+				if !exists {
+					return nil, errf.New(errf.InvalidParameter, "name is not set")
+				}
+				name, ok := nameVal.(*string)
+				if !ok || *name == "" {
+					return nil, errf.New(errf.InvalidParameter, "name is not string")
+				}
+
+				if err := sch.Trigger(*name); err != nil {
+					return nil, errf.New(errf.InvalidParameter, err.Error())
+				}
+
+				logs.Infof("triggered job %s, rid: %s", *name, kt.Rid)
+				return nil, nil
+			},
+		},
+	}
+
+	return []Cmd{list, trigger}
+}
+
+// jobCmd job scheduler related Cmd.
+type jobCmd struct {
+	cmd *Command
+	sch *scheduler.Scheduler
+}
+
+// GetCommand get job scheduler Command.
+func (c *jobCmd) GetCommand() *Command {
+	return c.cmd
+}
+
+// Validate job scheduler related Command.
+func (c *jobCmd) Validate() error {
+	if c.sch == nil {
+		return errors.New("job scheduler is not set")
+	}
+
+	return c.cmd.Validate()
+}
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
@@ -51,6 +52,48 @@ func WithQueryRuntimeSetting(settings interface{}) Cmd {
 	return cmd
 }
 
+// WithEffectiveSetting init and returns the command to show the effective setting, sensitive fields are masked.
+func WithEffectiveSetting() Cmd {
+	cmd := &defaultCmd{
+		cmd: &Command{
+			Name:  "effective-setting",
+			Usage: "show the effective setting of this service, the sensitive fields are masked",
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				setting, err := cc.EffectiveSetting()
+				if err != nil {
+					return nil, errf.New(errf.Aborted, fmt.Sprintf("get effective setting failed, %v", err))
+				}
+
+				return setting, nil
+			},
+		},
+	}
+
+	return cmd
+}
+
+// WithReloadSetting init and returns the command to reload setting from the config files immediately.
+func WithReloadSetting() Cmd {
+	cmd := &defaultCmd{
+		cmd: &Command{
+			Name:  "reload-setting",
+			Usage: "reload the reload-safe setting sections from the config files immediately",
+			Run: func(kt *kit.Kit, params map[string]interface{}) (interface{}, error) {
+				event, err := cc.Reload()
+				if err != nil {
+					return nil, errf.New(errf.Aborted, fmt.Sprintf("reload setting failed, %v", err))
+				}
+
+				logs.Infof("successfully reload setting, changed: %v, restart required: %v, rid: %s", event.Changed,
+					event.RestartRequired, kt.Rid)
+				return event, nil
+			},
+		},
+	}
+
+	return cmd
+}
+
 // settingCmd setting related Cmd.
 type settingCmd struct {
 	cmd      *Command
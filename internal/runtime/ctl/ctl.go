@@ -70,10 +70,34 @@ func LoadCtl(commands ...cmd.Cmd) error {
 	return nil
 }
 
-// WithBasics init and returns the basic commands(register & deregister & log) that all servers needed.
+// WithBasics init and returns the basic commands(register & deregister & log & debug & chaos) that all servers
+// needed.
 func WithBasics(sd serviced.Service) []cmd.Cmd {
-	return []cmd.Cmd{cmd.WithLog(), cmd.WithRegister(sd), cmd.WithDeregister(sd), cmd.WithEnableMasterSlave(sd),
-		cmd.WithDisableMasterSlave(sd)}
+	cmds := []cmd.Cmd{cmd.WithLog(), cmd.WithRegister(sd), cmd.WithDeregister(sd), cmd.WithEnableMasterSlave(sd),
+		cmd.WithDisableMasterSlave(sd), cmd.WithEffectiveSetting(), cmd.WithReloadSetting()}
+	cmds = append(cmds, cmd.WithReadOnly(sd)...)
+	cmds = append(cmds, WithDebugs()...)
+	return append(cmds, WithChaos()...)
+}
+
+// WithDebugs init and returns the runtime debug commands(heap profile & gc stats), no commands are returned
+// if the ctl token is not configured.
+func WithDebugs() []cmd.Cmd {
+	if !DebugEnabled() {
+		return nil
+	}
+
+	return []cmd.Cmd{cmd.WithHeapProfile(), cmd.WithGCStats()}
+}
+
+// WithChaos init and returns the fault injection commands, they can delay or drop the rpc calls and events of
+// the server, so no commands are returned if the ctl token is not configured.
+func WithChaos() []cmd.Cmd {
+	if !DebugEnabled() {
+		return nil
+	}
+
+	return cmd.WithChaos()
 }
 
 func (b *Ctl) httpHandler(w http.ResponseWriter, req *http.Request) {
@@ -24,6 +24,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/rest/view"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
 )
@@ -61,7 +62,16 @@ func (j *bkJSONResponse) Marshal(v interface{}) ([]byte, error) {
 // kitMetadataHandler convert http header to grpc metadata
 func kitMetadataHandler(ctx context.Context, r *http.Request) metadata.MD {
 	kt := kit.MustGetKit(ctx)
-	return kt.RPCMetaData()
+	md := kt.RPCMetaData()
+	// 乐观锁的期望修订版本
+	if ifMatch := r.Header.Get(constant.IfMatchKey); ifMatch != "" {
+		md.Set(constant.IfMatchKey, ifMatch)
+	}
+	// 变更请求的幂等键
+	if key := r.Header.Get(constant.IdempotencyKey); key != "" {
+		md.Set(constant.IdempotencyKey, key)
+	}
+	return md
 }
 
 // bscpResponse 可动态处理 webannotation
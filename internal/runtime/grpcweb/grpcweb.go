@@ -0,0 +1,298 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package grpcweb translates the grpc-web requests from browsers into grpc requests served by the grpc server,
+// see https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-WEB.md.
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	contentTypeGrpcWeb     = "application/grpc-web"
+	contentTypeGrpcWebText = "application/grpc-web-text"
+
+	// trailerFrameFlag is the flag of the frame which carries the trailers.
+	trailerFrameFlag byte = 0x80
+)
+
+var (
+	// allowHeaders is the request headers allowed for the grpc-web requests.
+	allowHeaders = []string{"Authorization", "Content-Type", "X-Grpc-Web", "X-User-Agent", "Grpc-Timeout",
+		"Sidecar-Meta", "Side-Rid"}
+	// exposeHeaders is the response headers exposed to the browser.
+	exposeHeaders = []string{"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin"}
+)
+
+// Options is the options of the grpc-web handler.
+type Options struct {
+	// AllowedOrigins is the origins allowed to access, "*" means all.
+	AllowedOrigins []string
+	// AllowedMethods is the grpc full method names allowed to call, empty means all.
+	AllowedMethods []string
+}
+
+// Handler serves the grpc-web requests with the grpc server.
+type Handler struct {
+	server  *grpc.Server
+	origins map[string]struct{}
+	methods map[string]struct{}
+}
+
+// Wrap returns a grpc-web handler of the grpc server.
+func Wrap(server *grpc.Server, opt Options) *Handler {
+	h := &Handler{
+		server:  server,
+		origins: make(map[string]struct{}),
+		methods: make(map[string]struct{}),
+	}
+
+	for _, o := range opt.AllowedOrigins {
+		h.origins[o] = struct{}{}
+	}
+	for _, m := range opt.AllowedMethods {
+		h.methods[m] = struct{}{}
+	}
+
+	return h
+}
+
+// IsGrpcWebRequest returns whether the request is a grpc-web request or its cors preflight request.
+func IsGrpcWebRequest(r *http.Request) bool {
+	if r.Method == http.MethodPost {
+		return strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeGrpcWeb)
+	}
+
+	if r.Method == http.MethodOptions {
+		for _, h := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+			if strings.EqualFold(strings.TrimSpace(h), "x-grpc-web") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ServeHTTP serve the grpc-web request.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	origin := r.Header.Get("Origin")
+	if origin != "" {
+		if !h.isOriginAllowed(origin) {
+			http.Error(w, "origin is not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+		w.Header().Add("Vary", "Origin")
+	}
+
+	// cors 预检请求
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", strings.Join(allowHeaders, ", "))
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Access-Control-Expose-Headers", strings.Join(exposeHeaders, ", "))
+
+	text := strings.HasPrefix(r.Header.Get("Content-Type"), contentTypeGrpcWebText)
+	resp := newResponse(w, text)
+
+	if !h.isMethodAllowed(r.URL.Path) {
+		resp.writeError(codes.PermissionDenied, "method "+r.URL.Path+" is not allowed with grpc-web")
+		return
+	}
+
+	h.server.ServeHTTP(resp, toGrpcRequest(r, text))
+	resp.finish()
+}
+
+func (h *Handler) isOriginAllowed(origin string) bool {
+	if _, ok := h.origins["*"]; ok {
+		return true
+	}
+	_, ok := h.origins[origin]
+	return ok
+}
+
+func (h *Handler) isMethodAllowed(method string) bool {
+	if len(h.methods) == 0 {
+		return true
+	}
+	_, ok := h.methods[method]
+	return ok
+}
+
+// toGrpcRequest rewrite the grpc-web request to a grpc request which can be served by grpc server.
+func toGrpcRequest(r *http.Request, text bool) *http.Request {
+	req := r.Clone(r.Context())
+	req.ProtoMajor = 2
+	req.ProtoMinor = 0
+	req.Proto = "HTTP/2.0"
+
+	subtype := strings.TrimPrefix(strings.TrimPrefix(r.Header.Get("Content-Type"), contentTypeGrpcWebText),
+		contentTypeGrpcWeb)
+	req.Header.Set("Content-Type", "application/grpc"+subtype)
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+
+	if text {
+		req.Body = io.NopCloser(base64.NewDecoder(base64.StdEncoding, r.Body))
+	}
+
+	return req
+}
+
+// response translates the grpc response to grpc-web response, the trailers are sent as the last frame
+// of the body.
+type response struct {
+	w           http.ResponseWriter
+	header      http.Header
+	text        bool
+	wroteHeader bool
+	// trailers is the trailer names declared by the grpc server.
+	trailers []string
+	encoder  io.WriteCloser
+}
+
+func newResponse(w http.ResponseWriter, text bool) *response {
+	return &response{w: w, header: make(http.Header), text: text}
+}
+
+// Header returns the response header.
+func (r *response) Header() http.Header {
+	return r.header
+}
+
+// WriteHeader write the headers except the trailers.
+func (r *response) WriteHeader(code int) {
+	if r.wroteHeader {
+		return
+	}
+	r.wroteHeader = true
+
+	for _, v := range r.header.Values("Trailer") {
+		for _, name := range strings.Split(v, ",") {
+			r.trailers = append(r.trailers, http.CanonicalHeaderKey(strings.TrimSpace(name)))
+		}
+	}
+
+	dst := r.w.Header()
+	for k, vv := range r.header {
+		if k == "Trailer" || strings.HasPrefix(k, http2.TrailerPrefix) {
+			continue
+		}
+		dst[k] = vv
+	}
+
+	contentType := r.header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "application/grpc") {
+		prefix := contentTypeGrpcWeb
+		if r.text {
+			prefix = contentTypeGrpcWebText
+		}
+		dst.Set("Content-Type", prefix+strings.TrimPrefix(contentType, "application/grpc"))
+	}
+
+	r.w.WriteHeader(code)
+}
+
+// Write the response body.
+func (r *response) Write(b []byte) (int, error) {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	if !r.text {
+		return r.w.Write(b)
+	}
+
+	if r.encoder == nil {
+		r.encoder = base64.NewEncoder(base64.StdEncoding, r.w)
+	}
+	return r.encoder.Write(b)
+}
+
+// Flush the response body, the text body is encoded as padded base64 chunks.
+func (r *response) Flush() {
+	if !r.wroteHeader {
+		r.WriteHeader(http.StatusOK)
+	}
+
+	if r.encoder != nil {
+		_ = r.encoder.Close()
+		r.encoder = nil
+	}
+
+	if f, ok := r.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// finish send the trailers as the last frame.
+func (r *response) finish() {
+	trailers := make(http.Header)
+	for _, name := range r.trailers {
+		if vv := r.header.Values(name); len(vv) > 0 {
+			trailers[name] = vv
+		}
+	}
+	for k, vv := range r.header {
+		if strings.HasPrefix(k, http2.TrailerPrefix) {
+			trailers[strings.TrimPrefix(k, http2.TrailerPrefix)] = vv
+		}
+	}
+
+	// 非 grpc 响应(如请求格式错误)不写 trailer
+	if len(trailers) == 0 {
+		return
+	}
+
+	_, _ = r.Write(trailerFrame(trailers))
+	r.Flush()
+}
+
+// writeError write a trailers-only response with the status.
+func (r *response) writeError(code codes.Code, msg string) {
+	r.header.Set("Content-Type", "application/grpc+proto")
+	r.header.Set("Grpc-Status", strconv.Itoa(int(code)))
+	r.header.Set("Grpc-Message", msg)
+	r.WriteHeader(http.StatusOK)
+}
+
+// trailerFrame encode the trailers as a grpc-web trailer frame.
+func trailerFrame(trailers http.Header) []byte {
+	payload := new(bytes.Buffer)
+	for k, vv := range trailers {
+		for _, v := range vv {
+			payload.WriteString(strings.ToLower(k) + ": " + v + "\r\n")
+		}
+	}
+
+	frame := make([]byte, 5, 5+payload.Len())
+	frame[0] = trailerFrameFlag
+	binary.BigEndian.PutUint32(frame[1:5], uint32(payload.Len()))
+	return append(frame, payload.Bytes()...)
+}
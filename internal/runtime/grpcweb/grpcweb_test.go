@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package grpcweb
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestHandler(methods ...string) *Handler {
+	s := grpc.NewServer()
+	healthpb.RegisterHealthServer(s, health.NewServer())
+	return Wrap(s, Options{AllowedOrigins: []string{"https://bscp.example.com"}, AllowedMethods: methods})
+}
+
+func dataFrame(t *testing.T, msg proto.Message) []byte {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame := make([]byte, 5, 5+len(b))
+	binary.BigEndian.PutUint32(frame[1:5], uint32(len(b)))
+	return append(frame, b...)
+}
+
+// readFrames parse the grpc-web response body into data frames and trailers.
+func readFrames(t *testing.T, body []byte) (data [][]byte, trailer string) {
+	for len(body) >= 5 {
+		size := binary.BigEndian.Uint32(body[1:5])
+		if len(body) < 5+int(size) {
+			t.Fatalf("invalid frame size %d", size)
+		}
+		if body[0]&trailerFrameFlag != 0 {
+			trailer += string(body[5 : 5+size])
+		} else {
+			data = append(data, body[5:5+size])
+		}
+		body = body[5+size:]
+	}
+	return data, trailer
+}
+
+func TestServeGrpcWeb(t *testing.T) {
+	h := newTestHandler()
+
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check",
+		bytes.NewReader(dataFrame(t, &healthpb.HealthCheckRequest{})))
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	req.Header.Set("Origin", "https://bscp.example.com")
+	if !IsGrpcWebRequest(req) {
+		t.Fatal("should be a grpc-web request")
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/grpc-web+proto" {
+		t.Errorf("content type should be application/grpc-web+proto, but got %s", ct)
+	}
+	if rec.Header().Get("Trailer") != "" {
+		t.Errorf("trailer header should not be sent")
+	}
+
+	data, trailer := readFrames(t, rec.Body.Bytes())
+	if len(data) != 1 {
+		t.Fatalf("should receive 1 message, but got %d", len(data))
+	}
+	resp := new(healthpb.HealthCheckResponse)
+	if err := proto.Unmarshal(data[0], resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("health status should be serving, but got %s", resp.Status)
+	}
+	if !strings.Contains(trailer, "grpc-status: 0\r\n") {
+		t.Errorf("trailer should contain ok status, but got %q", trailer)
+	}
+}
+
+func TestServeGrpcWebText(t *testing.T) {
+	h := newTestHandler()
+
+	body := base64.StdEncoding.EncodeToString(dataFrame(t, &healthpb.HealthCheckRequest{Service: "not-exist"}))
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/grpc-web-text")
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	// 每次 flush 都是一段独立补齐的 base64, 按 4 字节分组解码
+	text := rec.Body.String()
+	if len(text)%4 != 0 {
+		t.Fatalf("invalid base64 body %q", text)
+	}
+	decoded := make([]byte, 0)
+	for i := 0; i < len(text); i += 4 {
+		b, err := base64.StdEncoding.DecodeString(text[i : i+4])
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded = append(decoded, b...)
+	}
+
+	_, trailer := readFrames(t, decoded)
+	// codes.NotFound
+	if !strings.Contains(trailer, "grpc-status: 5\r\n") {
+		t.Errorf("trailer should contain not found status, but got %q", trailer)
+	}
+}
+
+func TestGrpcWebRestricted(t *testing.T) {
+	h := newTestHandler("/grpc.health.v1.Health/Watch")
+
+	req := httptest.NewRequest(http.MethodPost, "/grpc.health.v1.Health/Check",
+		bytes.NewReader(dataFrame(t, &healthpb.HealthCheckRequest{})))
+	req.Header.Set("Content-Type", "application/grpc-web+proto")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	// codes.PermissionDenied
+	if rec.Header().Get("Grpc-Status") != "7" {
+		t.Errorf("method not allowed should be denied, but got status %s", rec.Header().Get("Grpc-Status"))
+	}
+
+	req = httptest.NewRequest(http.MethodOptions, "/grpc.health.v1.Health/Watch", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web")
+	if !IsGrpcWebRequest(req) {
+		t.Fatal("should be a grpc-web preflight request")
+	}
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("origin not allowed should be forbidden, but got %d", rec.Code)
+	}
+}
@@ -23,14 +23,17 @@ import (
 	_ "net/http/pprof" //nolint
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/go-chi/render"
-	"github.com/google/uuid"
 	"k8s.io/klog/v2"
 
 	"github.com/TencentBlueKing/bk-bscp/internal/components"
 	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/uuid"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
 )
@@ -51,10 +54,13 @@ func HTTPMiddleware(root http.Handler, handlers ...Handler) http.Handler {
 	mux.HandleFunc("/metrics", metrics.Handler().ServeHTTP)
 
 	// add pprof handler
-	mux.HandleFunc("/debug/", http.DefaultServeMux.ServeHTTP)
+	registerDebugHandler(mux)
 
 	// add tools handler
-	mux.HandleFunc("/ctl", ctl.Handler().ServeHTTP)
+	mux.Handle("/ctl", ctl.Auth(ctl.Handler()))
+
+	// add config and build info handler
+	registerInfoHandler(mux)
 
 	for _, handler := range handlers {
 		mux.HandleFunc(handler.Pattern, handler.Handler.ServeHTTP)
@@ -71,7 +77,10 @@ func RegisterCommonHandler() http.Handler {
 	mux.HandleFunc("/metrics", metrics.Handler().ServeHTTP)
 
 	// add pprof handler
-	mux.HandleFunc("/debug/", http.DefaultServeMux.ServeHTTP)
+	registerDebugHandler(mux)
+
+	// add config and build info handler
+	registerInfoHandler(mux)
 
 	return mux
 }
@@ -84,14 +93,26 @@ func RegisterCommonToolHandler() http.Handler {
 	mux.HandleFunc("/metrics", metrics.Handler().ServeHTTP)
 
 	// add pprof handler
-	mux.HandleFunc("/debug/", http.DefaultServeMux.ServeHTTP)
+	registerDebugHandler(mux)
 
 	// add tools handler
-	mux.HandleFunc("/ctl", ctl.Handler().ServeHTTP)
+	mux.Handle("/ctl", ctl.Auth(ctl.Handler()))
+
+	// add config and build info handler
+	registerInfoHandler(mux)
 
 	return mux
 }
 
+// registerDebugHandler add the pprof handler, which is only mounted when the ctl token is configured.
+func registerDebugHandler(mux *http.ServeMux) {
+	if !ctl.DebugEnabled() {
+		return
+	}
+
+	mux.Handle("/debug/", ctl.Auth(http.DefaultServeMux))
+}
+
 // ReverseProxyHandler 代理请求
 func ReverseProxyHandler(name, prefix, remoteURL string) http.Handler {
 	remote, err := url.Parse(remoteURL)
@@ -161,16 +182,19 @@ func CORS(next http.Handler) http.Handler {
 
 // RequestIdGenerator request_id
 func RequestIdGenerator() string {
-	uid := uuid.New().String()
-	requestId := strings.ReplaceAll(uid, "-", "")
-	return requestId
+	return strings.ReplaceAll(uuid.UUID(), "-", "")
 }
 
-// RequestID middleware
+// RequestID middleware, 复用调用方(如 APIGW)传入的 request id, 未传入时生成, 并透传给下游服务及在响应头中返回
 func RequestID(next http.Handler) http.Handler {
 	fn := func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
-		requestID := r.Header.Get(components.RequestIDHeaderKey)
+		var requestID string
+		for _, key := range constant.RidKeys {
+			if requestID = r.Header.Get(key); requestID != "" {
+				break
+			}
+		}
 		if requestID == "" {
 			requestID = RequestIdGenerator()
 		}
@@ -178,6 +202,8 @@ func RequestID(next http.Handler) http.Handler {
 		ctx = components.WithRequestIDValue(ctx, requestID)
 		ctx = context.WithValue(ctx, middleware.RequestIDKey, requestID)
 
+		// 代理到 grpc-gateway 时作为 grpc 元数据透传, 保证整个调用链使用同一个 request id
+		r.Header.Set(constant.RidKey, requestID)
 		w.Header().Set(components.RequestIDHeaderKey, requestID)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	}
@@ -223,3 +249,54 @@ func RequestBodyLogger(ignorePattern ...string) func(http.Handler) http.Handler
 		return http.HandlerFunc(fn)
 	}
 }
+
+// SlowRequestLogger 慢请求日志中间件, 请求耗时超过阈值时输出方法、业务/服务、调用方、耗时及下游耗时分布
+func SlowRequestLogger(next http.Handler) http.Handler {
+	fn := func(w http.ResponseWriter, r *http.Request) {
+		// 事件流、长轮询等请求的耗时不代表处理性能, 不做慢请求统计
+		if r.Header.Get("Accept") == "text/event-stream" || strings.HasSuffix(r.URL.Path, "/sse/watch") ||
+			strings.HasSuffix(r.URL.Path, "/longpoll/watch") {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		st := time.Now()
+		ctx, breakdown := logs.WithBreakdown(r.Context())
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+		defer func() {
+			cost := time.Since(st)
+			if !logs.IsSlowRequest(cost) {
+				return
+			}
+
+			bizID, appID := extractBizAndApp(r.URL.Path)
+			// 鉴权中间件会将调用方信息写入请求头
+			logs.Warnf("slow request, method: %s %s, status: %d, biz: %s, app: %s, user: %s, app_code: %s, "+
+				"ip: %s, cost: %s, downstream: [%s], rid: %s", r.Method, r.URL.Path, ww.Status(), bizID, appID,
+				r.Header.Get(constant.UserKey), r.Header.Get(constant.AppCodeKey), r.RemoteAddr, cost,
+				breakdown.String(), components.RequestIDValue(ctx))
+		}()
+
+		next.ServeHTTP(ww, r.WithContext(ctx))
+	}
+	return http.HandlerFunc(fn)
+}
+
+// extractBizAndApp 从请求路径中提取业务和服务ID
+func extractBizAndApp(path string) (bizID, appID string) {
+	parts := strings.Split(path, "/")
+	for idx, v := range parts {
+		if idx+1 >= len(parts) {
+			break
+		}
+		if bizID == "" && (v == "biz" || v == "biz_id") {
+			bizID = parts[idx+1]
+		}
+		if appID == "" && (v == "app" || v == "apps" || v == "app_id") {
+			appID = parts[idx+1]
+		}
+	}
+
+	return bizID, appID
+}
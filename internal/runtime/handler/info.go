@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/render"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/ctl"
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/version"
+)
+
+var startTime = time.Now()
+
+// BuildInfo is the build and process information of the service.
+type BuildInfo struct {
+	Service string `json:"service"`
+	*version.SysVersion
+	StartTime string `json:"start_time"`
+}
+
+// BuildInfoHandler returns the build info of the service, such as version, git commit and build time.
+func BuildInfoHandler(w http.ResponseWriter, r *http.Request) {
+	render.JSON(w, r, &BuildInfo{
+		Service:    string(cc.ServiceName()),
+		SysVersion: version.Version(),
+		StartTime:  startTime.Format(time.RFC3339),
+	})
+}
+
+// ConfigHandler returns the effective runtime configuration of the service, which is merged from the config files
+// and applied with the default values, the sensitive fields are redacted.
+func ConfigHandler(w http.ResponseWriter, r *http.Request) {
+	setting, err := cc.EffectiveSetting()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	render.JSON(w, r, setting)
+}
+
+// registerInfoHandler 注册配置和构建信息查询接口, 配置信息需要 ctl token 鉴权
+func registerInfoHandler(mux *http.ServeMux) {
+	mux.HandleFunc("/-/buildinfo", BuildInfoHandler)
+	mux.Handle("/-/config", ctl.Auth(http.HandlerFunc(ConfigHandler)))
+}
@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/metrics"
+)
+
+var (
+	once      sync.Once
+	jobMetric *metric
+)
+
+// initMetric init the job scheduler related prometheus metrics, the metrics are shared by all the schedulers.
+func initMetric() *metric {
+	once.Do(func() {
+		m := new(metric)
+		m.runCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.SchedulerSubSys,
+			Name:      "job_run_count",
+			Help:      "the count of the job runs, status is succeeded, failed or skipped",
+		}, []string{"job", "status"})
+		metrics.Register().MustRegister(m.runCounter)
+
+		m.durationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.SchedulerSubSys,
+			Name:      "job_duration_seconds",
+			Help:      "the duration(seconds) of the job runs",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800, 3600},
+		}, []string{"job"})
+		metrics.Register().MustRegister(m.durationSeconds)
+
+		m.lastSuccessTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: metrics.SchedulerSubSys,
+			Name:      "job_last_success_timestamp_seconds",
+			Help:      "the unix timestamp of the last successful run of the job",
+		}, []string{"job"})
+		metrics.Register().MustRegister(m.lastSuccessTimestamp)
+
+		jobMetric = m
+	})
+
+	return jobMetric
+}
+
+type metric struct {
+	// runCounter records the count of the job runs.
+	runCounter *prometheus.CounterVec
+	// durationSeconds records the duration of the job runs.
+	durationSeconds *prometheus.HistogramVec
+	// lastSuccessTimestamp records the last successful run time, used to alert the job which is not running.
+	lastSuccessTimestamp *prometheus.GaugeVec
+}
@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package scheduler is the background job scheduler with cron semantics, the jobs only run on the leader
+// instance elected by the etcd leader election, so that each job runs once in the cluster.
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/runtime/shutdown"
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// Job is a background job scheduled with cron semantics.
+type Job struct {
+	// Name is the unique name of the job.
+	Name string
+	// Spec is the standard cron expression, e.g. "0 */6 * * *", or the descriptors like "@hourly", "@every 30s".
+	Spec string
+	// Desc describes what the job does.
+	Desc string
+	// Run the job, the context of the kit is canceled when the server is shutting down.
+	Run func(kt *kit.Kit) error
+}
+
+// JobStatus is the running status of a job.
+type JobStatus struct {
+	Name      string     `json:"name"`
+	Spec      string     `json:"spec"`
+	Desc      string     `json:"desc"`
+	Running   bool       `json:"running"`
+	NextRunAt *time.Time `json:"next_run_at"`
+	LastRunAt *time.Time `json:"last_run_at"`
+	// LastCost is the cost of the last run, e.g. 1.5s.
+	LastCost  string `json:"last_cost"`
+	LastError string `json:"last_error"`
+	RunCount  uint64 `json:"run_count"`
+	FailCount uint64 `json:"fail_count"`
+}
+
+// ErrNotLeader is returned when triggering a job on the instance which is not the leader.
+var ErrNotLeader = errors.New("current service instance is not the leader, jobs only run on the leader")
+
+// Scheduler schedules the registered jobs.
+type Scheduler struct {
+	leader serviced.Election
+	cron   *cron.Cron
+	parser cron.Parser
+	ctx    context.Context
+	cancel context.CancelFunc
+	metric *metric
+
+	mu   sync.Mutex
+	jobs map[string]*entry
+	// paused returns whether the jobs should be skipped, e.g. during the read-only maintenance.
+	paused func() bool
+}
+
+type entry struct {
+	job     Job
+	id      cron.EntryID
+	running bool
+	status  JobStatus
+}
+
+// New create a scheduler, the jobs only run when this instance is elected as the leader.
+func New(leader serviced.Election) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		leader: leader,
+		cron:   cron.New(),
+		parser: cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor),
+		ctx:    ctx,
+		cancel: cancel,
+		metric: initMetric(),
+		jobs:   make(map[string]*entry),
+	}
+}
+
+// PauseWhen skips the scheduled runs of all the jobs when the paused func returns true.
+func (s *Scheduler) PauseWhen(paused func() bool) {
+	s.paused = paused
+}
+
+// Register a job, it should be called before Start.
+func (s *Scheduler) Register(job Job) error {
+	if job.Name == "" || job.Run == nil {
+		return errors.New("job name and run func are required")
+	}
+
+	schedule, err := s.parser.Parse(job.Spec)
+	if err != nil {
+		return fmt.Errorf("invalid spec %q of job %s, err: %v", job.Spec, job.Name, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.jobs[job.Name]; exists {
+		return fmt.Errorf("job %s is already registered", job.Name)
+	}
+
+	e := &entry{job: job, status: JobStatus{Name: job.Name, Spec: job.Spec, Desc: job.Desc}}
+	e.id = s.cron.Schedule(schedule, cron.FuncJob(func() {
+		if !s.leader.IsLeader() {
+			logs.V(2).Infof("current service instance is not the leader, skip job %s", job.Name)
+			return
+		}
+		s.run(e)
+	}))
+	s.jobs[job.Name] = e
+
+	return nil
+}
+
+// Start the scheduler, it is stopped when the server is shutting down.
+func (s *Scheduler) Start() {
+	logs.Infof("start job scheduler with %d jobs", len(s.jobs))
+	s.cron.Start()
+
+	// 持有领导权直到退出, 退出时主动放弃以便其他实例尽快接管
+	go serviced.RunAsLeader(s.ctx, s.leader, func(ctx context.Context) {
+		logs.Infof("job scheduler is elected as the leader")
+		<-ctx.Done()
+		logs.Infof("job scheduler is no longer the leader")
+	})
+
+	notifier := shutdown.AddNotifier()
+	go func() {
+		<-notifier.Signal
+		s.cancel()
+		// 等待运行中的任务退出
+		<-s.cron.Stop().Done()
+		logs.Infof("stop job scheduler success")
+		notifier.Done()
+	}()
+}
+
+// List the status of all the jobs.
+func (s *Scheduler) List() []*JobStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]*JobStatus, 0, len(s.jobs))
+	for _, e := range s.jobs {
+		status := e.status
+		status.Running = e.running
+		if next := s.cron.Entry(e.id).Next; !next.IsZero() {
+			status.NextRunAt = &next
+		}
+		list = append(list, &status)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+
+	return list
+}
+
+// Trigger run the job immediately in background, the job only runs on the leader instance.
+func (s *Scheduler) Trigger(name string) error {
+	s.mu.Lock()
+	e, exists := s.jobs[name]
+	s.mu.Unlock()
+	if !exists {
+		return fmt.Errorf("job %s is not found", name)
+	}
+
+	if !s.leader.IsLeader() {
+		return ErrNotLeader
+	}
+
+	go s.run(e)
+	return nil
+}
+
+// run the job, the job is skipped if the previous run is not finished.
+func (s *Scheduler) run(e *entry) {
+	if s.paused != nil && s.paused() {
+		logs.Infof("jobs are paused, skip the run of job %s", e.job.Name)
+		s.metric.runCounter.WithLabelValues(e.job.Name, "paused").Inc()
+		return
+	}
+
+	s.mu.Lock()
+	if e.running {
+		s.mu.Unlock()
+		logs.Warnf("job %s is still running, skip this run", e.job.Name)
+		s.metric.runCounter.WithLabelValues(e.job.Name, "skipped").Inc()
+		return
+	}
+	e.running = true
+	s.mu.Unlock()
+
+	kt := kit.New()
+	kt.User = constant.BKSystemUser
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	kt.Ctx = ctx
+
+	start := time.Now()
+	err := s.safeRun(kt, e.job)
+	cost := time.Since(start)
+
+	s.mu.Lock()
+	e.running = false
+	e.status.LastRunAt = &start
+	e.status.LastCost = cost.String()
+	e.status.RunCount++
+	e.status.LastError = ""
+	if err != nil {
+		e.status.FailCount++
+		e.status.LastError = err.Error()
+	}
+	s.mu.Unlock()
+
+	s.metric.durationSeconds.WithLabelValues(e.job.Name).Observe(cost.Seconds())
+	if err != nil {
+		logs.Errorf("run job %s failed, cost: %s, err: %v, rid: %s", e.job.Name, cost, err, kt.Rid)
+		s.metric.runCounter.WithLabelValues(e.job.Name, "failed").Inc()
+		return
+	}
+	s.metric.runCounter.WithLabelValues(e.job.Name, "succeeded").Inc()
+	s.metric.lastSuccessTimestamp.WithLabelValues(e.job.Name).Set(float64(time.Now().Unix()))
+}
+
+// safeRun runs the job and converts the panic to error.
+func (s *Scheduler) safeRun(kt *kit.Kit, job Job) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("job panic: %v", p)
+		}
+	}()
+
+	return job.Run(kt)
+}
@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/internal/serviced"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+type fakeElection struct {
+	serviced.Election
+	leader bool
+}
+
+func (f *fakeElection) IsLeader() bool { return f.leader }
+
+func TestSchedulerRegister(t *testing.T) {
+	s := New(&fakeElection{leader: true})
+	noop := func(kt *kit.Kit) error { return nil }
+
+	if err := s.Register(Job{Name: "a", Spec: "*/5 * * * *", Run: noop}); err != nil {
+		t.Fatalf("register job failed, err: %v", err)
+	}
+	if err := s.Register(Job{Name: "b", Spec: "@every 30s", Run: noop}); err != nil {
+		t.Fatalf("register job with descriptor failed, err: %v", err)
+	}
+	if err := s.Register(Job{Name: "a", Spec: "@hourly", Run: noop}); err == nil {
+		t.Errorf("register duplicated job should fail")
+	}
+	if err := s.Register(Job{Name: "c", Spec: "* * *", Run: noop}); err == nil {
+		t.Errorf("register job with invalid spec should fail")
+	}
+
+	list := s.List()
+	if len(list) != 2 || list[0].Name != "a" || list[1].Name != "b" {
+		t.Errorf("unexpected jobs: %+v", list)
+	}
+}
+
+func TestSchedulerTrigger(t *testing.T) {
+	elec := &fakeElection{}
+	s := New(elec)
+	done := make(chan struct{}, 1)
+	err := s.Register(Job{Name: "fail", Spec: "@daily", Run: func(kt *kit.Kit) error {
+		done <- struct{}{}
+		return errors.New("boom")
+	}})
+	if err != nil {
+		t.Fatalf("register job failed, err: %v", err)
+	}
+
+	if err = s.Trigger("fail"); !errors.Is(err, ErrNotLeader) {
+		t.Errorf("trigger job on non-leader should fail, got: %v", err)
+	}
+	if err = s.Trigger("unknown"); err == nil {
+		t.Errorf("trigger unknown job should fail")
+	}
+
+	elec.leader = true
+	if err = s.Trigger("fail"); err != nil {
+		t.Fatalf("trigger job failed, err: %v", err)
+	}
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("job is not triggered")
+	}
+
+	// 等待运行结果写入状态
+	for i := 0; i < 100; i++ {
+		status := s.List()[0]
+		if status.RunCount == 1 {
+			if status.FailCount != 1 || status.LastError != "boom" || status.Running {
+				t.Errorf("unexpected job status: %+v", status)
+			}
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("job status is not updated")
+}
+
+func TestSchedulerPauseWhen(t *testing.T) {
+	s := New(&fakeElection{leader: true})
+	paused := true
+	s.PauseWhen(func() bool { return paused })
+
+	runs := 0
+	if err := s.Register(Job{Name: "a", Spec: "@daily", Run: func(kt *kit.Kit) error {
+		runs++
+		return nil
+	}}); err != nil {
+		t.Fatalf("register job failed, err: %v", err)
+	}
+
+	s.run(s.jobs["a"])
+	if runs != 0 || s.List()[0].RunCount != 0 {
+		t.Errorf("paused job should not run")
+	}
+
+	paused = false
+	s.run(s.jobs["a"])
+	if runs != 1 || s.List()[0].RunCount != 1 {
+		t.Errorf("job should run after resumed, runs: %d", runs)
+	}
+}
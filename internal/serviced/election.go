@@ -0,0 +1,319 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	etcd3 "go.etcd.io/etcd/client/v3"
+	"go.etcd.io/etcd/client/v3/concurrency"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// Election defines a leader election related operations. different components (e.g. scheduler, gc)
+// can campaign with their own election name by sharing the same etcd client, instead of handling
+// etcd lock by themselves.
+type Election interface {
+	// Campaign blocks until this instance is elected as leader, or the ctx is done.
+	Campaign(ctx context.Context) error
+	// Resign gives up the leadership, so that other instances can be elected.
+	Resign(ctx context.Context) error
+	// IsLeader test if this instance is the leader or not.
+	IsLeader() bool
+	// Leader returns the current leader's value, returns empty if there is no leader.
+	Leader(ctx context.Context) (string, error)
+	// Observe returns a channel that receives the leader's value when the leadership changes,
+	// the channel is closed when the ctx is done.
+	Observe(ctx context.Context) <-chan string
+	// Done returns a channel that is closed when the leadership is lost.
+	Done() <-chan struct{}
+	// Close resigns the leadership and releases the election's session.
+	Close() error
+}
+
+// NewElection create a leader election with the name.
+func (s *serviced) NewElection(name string) (Election, error) {
+	if len(name) == 0 {
+		return nil, errors.New("election name is empty")
+	}
+
+	return &election{
+		cli:    s.cli,
+		prefix: ElectionName(s.svcOpt.Name, name),
+		value:  s.svcOpt.Uid,
+	}, nil
+}
+
+// closedCh is used as the done channel when this instance is not the leader.
+var closedCh = func() chan struct{} {
+	ch := make(chan struct{})
+	close(ch)
+	return ch
+}()
+
+type election struct {
+	cli    *etcd3.Client
+	prefix string
+	value  string
+
+	lock     sync.Mutex
+	session  *concurrency.Session
+	election *concurrency.Election
+	// lost is closed when the leadership is lost, it's nil if this instance is not the leader.
+	lost chan struct{}
+}
+
+// Campaign blocks until this instance is elected as leader, or the ctx is done.
+func (e *election) Campaign(ctx context.Context) error {
+	e.lock.Lock()
+	if e.lost != nil {
+		e.lock.Unlock()
+		return nil
+	}
+	elec, sess, err := e.prepare()
+	e.lock.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := elec.Campaign(ctx, e.value); err != nil {
+		return fmt.Errorf("campaign election %s failed, err: %v", e.prefix, err)
+	}
+
+	e.lock.Lock()
+	lost := make(chan struct{})
+	e.lost = lost
+	e.lock.Unlock()
+	logs.Infof("elected as the leader of election %s, value: %s", e.prefix, e.value)
+
+	// if the session expired, e.g. etcd is unreachable for a long time, the leadership is lost.
+	go func() {
+		select {
+		case <-sess.Done():
+			logs.Warnf("session of election %s is expired, leadership is lost", e.prefix)
+		case <-lost:
+		}
+		e.lose(lost)
+	}()
+
+	return nil
+}
+
+// Resign gives up the leadership, so that other instances can be elected.
+func (e *election) Resign(ctx context.Context) error {
+	e.lock.Lock()
+	lost, elec := e.lost, e.election
+	e.lock.Unlock()
+	if lost == nil {
+		return nil
+	}
+
+	e.lose(lost)
+	if err := elec.Resign(ctx); err != nil {
+		return fmt.Errorf("resign election %s failed, err: %v", e.prefix, err)
+	}
+
+	logs.Infof("resigned the leader of election %s, value: %s", e.prefix, e.value)
+	return nil
+}
+
+// IsLeader test if this instance is the leader or not.
+func (e *election) IsLeader() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.lost != nil
+}
+
+// Leader returns the current leader's value, returns empty if there is no leader.
+func (e *election) Leader(ctx context.Context) (string, error) {
+	e.lock.Lock()
+	elec, _, err := e.prepare()
+	e.lock.Unlock()
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := elec.Leader(ctx)
+	if err != nil {
+		if errors.Is(err, concurrency.ErrElectionNoLeader) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get leader of election %s failed, err: %v", e.prefix, err)
+	}
+
+	return string(resp.Kvs[0].Value), nil
+}
+
+// Observe returns a channel that receives the leader's value when the leadership changes,
+// the channel is closed when the ctx is done.
+func (e *election) Observe(ctx context.Context) <-chan string {
+	ch := make(chan string)
+
+	go func() {
+		defer close(ch)
+		for {
+			e.lock.Lock()
+			elec, sess, err := e.prepare()
+			e.lock.Unlock()
+			if err != nil {
+				logs.Errorf("observe election %s failed, err: %v", e.prefix, err)
+				if !sleepCtx(ctx, defaultErrSleepTime) {
+					return
+				}
+				continue
+			}
+
+			for resp := range elec.Observe(ctx) {
+				select {
+				case ch <- string(resp.Kvs[0].Value):
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			// the observe channel is closed because of the ctx is done or the session is expired,
+			// if it's the latter, observe again with a new session.
+			select {
+			case <-ctx.Done():
+				return
+			case <-sess.Done():
+			default:
+				if !sleepCtx(ctx, defaultErrSleepTime) {
+					return
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// Done returns a channel that is closed when the leadership is lost.
+func (e *election) Done() <-chan struct{} {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.lost == nil {
+		return closedCh
+	}
+	return e.lost
+}
+
+// Close resigns the leadership and releases the election's session.
+func (e *election) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := e.Resign(ctx); err != nil {
+		logs.Errorf("close election %s, but resign failed, err: %v", e.prefix, err)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.session == nil {
+		return nil
+	}
+	err := e.session.Close()
+	e.session, e.election = nil, nil
+	return err
+}
+
+// lose marks the leadership is lost, it's safe to be called multiple times.
+func (e *election) lose(lost chan struct{}) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.lost != lost {
+		return
+	}
+	close(lost)
+	e.lost = nil
+}
+
+// prepare returns the election and its session, if the session is not created or expired, a new one
+// is created. the caller should hold the lock.
+func (e *election) prepare() (*concurrency.Election, *concurrency.Session, error) {
+	if e.session != nil {
+		select {
+		case <-e.session.Done():
+		default:
+			return e.election, e.session, nil
+		}
+	}
+
+	sess, err := concurrency.NewSession(e.cli, concurrency.WithTTL(defaultElectionSessionTTL))
+	if err != nil {
+		return nil, nil, fmt.Errorf("create session of election %s failed, err: %v", e.prefix, err)
+	}
+	e.session = sess
+	e.election = concurrency.NewElection(sess, e.prefix)
+
+	return e.election, e.session, nil
+}
+
+// RunAsLeader keeps campaigning with the election and runs fn once elected, the ctx passed to fn is
+// canceled when the leadership is lost. after fn returns, the leadership is resigned and it campaigns
+// again, until the ctx is done.
+func RunAsLeader(ctx context.Context, e Election, fn func(ctx context.Context)) {
+	for {
+		if err := e.Campaign(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logs.Errorf("campaign leader failed, err: %v", err)
+			if !sleepCtx(ctx, defaultErrSleepTime) {
+				return
+			}
+			continue
+		}
+
+		leaderCtx, cancel := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-e.Done():
+			case <-leaderCtx.Done():
+			}
+			cancel()
+		}()
+
+		fn(leaderCtx)
+		cancel()
+
+		resignCtx, resignCancel := context.WithTimeout(context.Background(), 5*time.Second)
+		if err := e.Resign(resignCtx); err != nil {
+			logs.Errorf("resign leader failed, err: %v", err)
+		}
+		resignCancel()
+
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// sleepCtx sleeps for the duration, returns false if the ctx is done before that.
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
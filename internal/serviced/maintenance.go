@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	etcd3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// readOnlyKey is the key of the platform-level read-only maintenance state in etcd, it's shared by all services.
+const readOnlyKey = "/bk-bscp/maintenance/read-only"
+
+// ReadOnlyState is the platform-level read-only maintenance state, the writes are rejected during the maintenance
+// while the released configs are still served.
+type ReadOnlyState struct {
+	Enabled  bool      `json:"enabled"`
+	Reason   string    `json:"reason,omitempty"`
+	Operator string    `json:"operator,omitempty"`
+	Since    time.Time `json:"since,omitempty"`
+}
+
+// Maintenance defines the platform-level maintenance mode related operations.
+type Maintenance interface {
+	// ReadOnly returns the current read-only maintenance state.
+	ReadOnly() ReadOnlyState
+	// SetReadOnly enables or disables the read-only maintenance mode of all the services.
+	SetReadOnly(ctx context.Context, state ReadOnlyState) error
+}
+
+// Maintenance returns the platform-level maintenance mode, the state is watched from etcd once it's used.
+func (s *serviced) Maintenance() Maintenance {
+	s.maintenanceOnce.Do(func() {
+		m := &etcdMaintenance{cli: s.cli}
+		m.watch(s.ctx)
+		s.maintenance = m
+	})
+	return s.maintenance
+}
+
+type etcdMaintenance struct {
+	cli   *etcd3.Client
+	lock  sync.RWMutex
+	state ReadOnlyState
+}
+
+// ReadOnly returns the current read-only maintenance state.
+func (m *etcdMaintenance) ReadOnly() ReadOnlyState {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.state
+}
+
+// SetReadOnly enables or disables the read-only maintenance mode, the state is removed from etcd when disabled.
+func (m *etcdMaintenance) SetReadOnly(ctx context.Context, state ReadOnlyState) error {
+	if !state.Enabled {
+		if _, err := m.cli.Delete(ctx, readOnlyKey); err != nil {
+			return fmt.Errorf("delete read-only state failed, err: %v", err)
+		}
+		m.update(state)
+		return nil
+	}
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if _, err := m.cli.Put(ctx, readOnlyKey, string(value)); err != nil {
+		return fmt.Errorf("put read-only state failed, err: %v", err)
+	}
+	m.update(state)
+	return nil
+}
+
+func (m *etcdMaintenance) update(state ReadOnlyState) {
+	m.lock.Lock()
+	changed := m.state.Enabled != state.Enabled
+	m.state = state
+	m.lock.Unlock()
+
+	if changed {
+		logs.Infof("platform read-only maintenance state changed, state: %+v", state)
+	}
+}
+
+func (m *etcdMaintenance) parse(value []byte) ReadOnlyState {
+	state := ReadOnlyState{}
+	if len(value) == 0 {
+		return state
+	}
+	if err := json.Unmarshal(value, &state); err != nil {
+		// 无法解析时按只读处理, 避免维护期间误写入
+		logs.Errorf("unmarshal read-only state failed, value: %s, err: %v", value, err)
+		return ReadOnlyState{Enabled: true, Reason: "invalid maintenance state"}
+	}
+	return state
+}
+
+// watch loads the state and keeps watching its changes.
+func (m *etcdMaintenance) watch(ctx context.Context) {
+	resp, err := m.cli.Get(ctx, readOnlyKey)
+	if err != nil {
+		logs.Errorf("get read-only state failed, err: %v", err)
+	} else if len(resp.Kvs) != 0 {
+		m.update(m.parse(resp.Kvs[0].Value))
+	}
+
+	go func() {
+		for {
+			for response := range m.cli.Watch(ctx, readOnlyKey) {
+				for _, event := range response.Events {
+					if event.Type == etcd3.EventTypeDelete {
+						m.update(ReadOnlyState{})
+						continue
+					}
+					m.update(m.parse(event.Kv.Value))
+				}
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+			// watch 通道因 etcd 异常关闭时重新建立
+			time.Sleep(defaultErrSleepTime)
+		}
+	}()
+}
+
+// localMaintenance is the maintenance mode of the standalone service, the state is only kept in the process.
+type localMaintenance struct {
+	lock  sync.RWMutex
+	state ReadOnlyState
+}
+
+// ReadOnly returns the current read-only maintenance state.
+func (m *localMaintenance) ReadOnly() ReadOnlyState {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+	return m.state
+}
+
+// SetReadOnly enables or disables the read-only maintenance mode of the current process.
+func (m *localMaintenance) SetReadOnly(_ context.Context, state ReadOnlyState) error {
+	m.lock.Lock()
+	m.state = state
+	m.lock.Unlock()
+	logs.Infof("read-only maintenance state changed, state: %+v", state)
+	return nil
+}
@@ -21,17 +21,21 @@ import (
 	etcd3 "go.etcd.io/etcd/client/v3"
 	"google.golang.org/grpc/resolver"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
 
 // etcdBuilder creates a resolver that will be used to watch name resolution updates.
 type etcdBuilder struct {
 	cli *etcd3.Client
+	// locality is the locality of the current instance, the upstream instances in the same zone or region
+	// are preferred.
+	locality cc.Locality
 }
 
 // newEtcdBuilder new etcdBuilder.
-func newEtcdBuilder(cli *etcd3.Client) *etcdBuilder {
-	return &etcdBuilder{cli: cli}
+func newEtcdBuilder(cli *etcd3.Client, locality cc.Locality) *etcdBuilder {
+	return &etcdBuilder{cli: cli, locality: locality}
 }
 
 // Build creates and starts a etcd resolver that watches the name resolution of the target.
@@ -40,11 +44,12 @@ func (b *etcdBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts
 
 	ctx, cancel := context.WithCancel(context.Background())
 	r := &etcdResolver{
-		cli:    b.cli,
-		cc:     cc,
-		target: target.Endpoint(),
-		ctx:    ctx,
-		cancel: cancel,
+		cli:      b.cli,
+		cc:       cc,
+		target:   target.Endpoint(),
+		locality: b.locality,
+		ctx:      ctx,
+		cancel:   cancel,
 	}
 
 	go r.watcher()
@@ -63,7 +68,8 @@ type etcdResolver struct {
 	cli       *etcd3.Client
 	cc        resolver.ClientConn
 	target    string
-	addresses map[string]resolver.Address
+	addresses map[string]instance
+	locality  cc.Locality
 	ctx       context.Context
 	cancel    context.CancelFunc
 }
@@ -88,7 +94,7 @@ func (r *etcdResolver) watcher() {
 	}
 	logs.V(3).Infof("watcher etcd resolver get target: %s, kvs: %#v", r.target, resp.Kvs)
 
-	r.addresses = make(map[string]resolver.Address)
+	r.addresses = make(map[string]instance)
 	if err == nil {
 		for _, kv := range resp.Kvs {
 			r.setAddress(string(kv.Key), string(kv.Value))
@@ -132,7 +138,7 @@ func (r *etcdResolver) setAddress(key, address string) {
 	r.Lock()
 	defer r.Unlock()
 
-	addr := resolver.Address{}
+	addr := instance{}
 	if err := json.Unmarshal([]byte(address), &addr); err != nil {
 		logs.Errorf("etcd resolver unmarshal addr failed, addr: %s, err: %v", address, err)
 		return
@@ -150,13 +156,50 @@ func (r *etcdResolver) delAddress(key string) {
 	logs.V(3).Infof("del address key:%s, addresses:%#v", key, r.addresses)
 }
 
-// getAddresses get grpc addresses.
+// getAddresses get grpc addresses, only the instances in the same zone, or else in the same region are returned
+// if there are any, so that the requests are not routed across regions.
 func (r *etcdResolver) getAddresses() []resolver.Address {
-	addresses := make([]resolver.Address, 0, len(r.addresses))
+	r.RLock()
+	defer r.RUnlock()
 
-	for _, address := range r.addresses {
-		addresses = append(addresses, address)
+	all := make([]instance, 0, len(r.addresses))
+	for _, one := range r.addresses {
+		all = append(all, one)
+	}
+
+	preferred := preferLocal(all, r.locality)
+	addresses := make([]resolver.Address, 0, len(preferred))
+	for _, one := range preferred {
+		addresses = append(addresses, resolver.Address{Addr: one.Addr, ServerName: one.ServerName})
 	}
 
 	return addresses
 }
+
+// preferLocal returns the instances in the same zone, or else the instances in the same region, or else all the
+// instances if there is no instance in the region.
+func preferLocal(all []instance, local cc.Locality) []instance {
+	if len(local.Region) == 0 {
+		return all
+	}
+
+	var sameRegion, sameZone []instance
+	for _, one := range all {
+		if one.Region != local.Region {
+			continue
+		}
+		sameRegion = append(sameRegion, one)
+		if len(local.Zone) != 0 && one.Zone == local.Zone {
+			sameZone = append(sameZone, one)
+		}
+	}
+
+	switch {
+	case len(sameZone) != 0:
+		return sameZone
+	case len(sameRegion) != 0:
+		return sameRegion
+	default:
+		return all
+	}
+}
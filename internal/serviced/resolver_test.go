@@ -0,0 +1,45 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package serviced
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+)
+
+func TestPreferLocal(t *testing.T) {
+	all := []instance{
+		{Addr: "a", Region: "gz", Zone: "gz-1"},
+		{Addr: "b", Region: "gz", Zone: "gz-2"},
+		{Addr: "c", Region: "sh", Zone: "sh-1"},
+		// 旧版本注册的实例没有地域信息
+		{Addr: "d"},
+	}
+
+	addrs := func(ins []instance) []string {
+		res := make([]string, 0, len(ins))
+		for _, one := range ins {
+			res = append(res, one.Addr)
+		}
+		return res
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, addrs(preferLocal(all, cc.Locality{})))
+	assert.Equal(t, []string{"a"}, addrs(preferLocal(all, cc.Locality{Region: "gz", Zone: "gz-1"})))
+	assert.Equal(t, []string{"a", "b"}, addrs(preferLocal(all, cc.Locality{Region: "gz", Zone: "gz-3"})))
+	assert.Equal(t, []string{"c"}, addrs(preferLocal(all, cc.Locality{Region: "sh"})))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, addrs(preferLocal(all, cc.Locality{Region: "bj"})))
+}
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/grpc/balancer/roundrobin"
 	"google.golang.org/grpc/resolver"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
 
@@ -53,6 +54,11 @@ type Service interface {
 	Register() error
 	// Deregister the service
 	Deregister() error
+	// NewElection create a leader election with the name, instances of the same service campaign
+	// with the same name will elect only one leader.
+	NewElection(name string) (Election, error)
+	// Maintenance returns the platform-level maintenance mode shared by all the services.
+	Maintenance() Maintenance
 }
 
 // Discover defines service discovery related operations.
@@ -116,7 +122,7 @@ func NewServiceD(cfg etcd3.Config, opt ServiceOption) (ServiceDiscover, error) {
 		httpClient: httpClient,
 	}
 
-	resolver.Register(newEtcdBuilder(cli))
+	resolver.Register(newEtcdBuilder(cli, opt.Locality))
 	// keep synchronizing current node's master state.
 	s.syncMasterState()
 	return s, nil
@@ -130,7 +136,7 @@ func NewDiscovery(cfg etcd3.Config) (Discover, error) {
 	}
 
 	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: cfg.TLS}}
-	resolver.Register(newEtcdBuilder(cli))
+	resolver.Register(newEtcdBuilder(cli, cc.Locality{}))
 	return &serviced{
 		cli:        cli,
 		cfg:        cfg,
@@ -164,6 +170,9 @@ type serviced struct {
 	ctx        context.Context
 	cancel     context.CancelFunc
 	httpClient *http.Client
+
+	maintenanceOnce sync.Once
+	maintenance     Maintenance
 }
 
 // LBRoundRobin returns a load balance based on all the
@@ -180,9 +189,11 @@ func (s *serviced) Register() error {
 
 	// get service key and value.
 	key := key(ServiceDiscoveryName(s.svcOpt.Name), s.svcOpt.Uid)
-	addr := resolver.Address{
+	addr := instance{
 		Addr:       net.JoinHostPort(s.svcOpt.IP, strconv.Itoa(int(s.svcOpt.Port))),
 		ServerName: string(s.svcOpt.Name),
+		Region:     s.svcOpt.Locality.Region,
+		Zone:       s.svcOpt.Locality.Zone,
 	}
 	bytes, err := json.Marshal(addr)
 	if err != nil {
@@ -0,0 +1,237 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package serviced
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/resolver"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/cc"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// NewServiceDWithSetting create a service and discovery instance with the service setting, the standalone
+// service discovery is used if it's enabled, otherwise etcd is used.
+func NewServiceDWithSetting(setting cc.Service, opt ServiceOption) (ServiceDiscover, error) {
+	if len(opt.Locality.Region) == 0 {
+		opt.Locality = setting.Locality
+	}
+
+	if setting.Standalone.Enabled {
+		return NewStandalone(setting.Standalone, opt)
+	}
+
+	etcdOpt, err := setting.Etcd.ToConfig()
+	if err != nil {
+		return nil, fmt.Errorf("get etcd config failed, err: %v", err)
+	}
+
+	return NewServiceD(etcdOpt, opt)
+}
+
+// NewStandalone create a standalone service and discovery instance, which finds the services with the
+// static addresses instead of etcd. it's used by the all-in-one deployment, which runs only one instance
+// of each service, so the instance is always the master and the leader.
+func NewStandalone(setting cc.Standalone, opt ServiceOption) (ServiceDiscover, error) {
+	if err := opt.Validate(); err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]string, len(setting.Addresses))
+	for name, addr := range setting.Addresses {
+		addresses[ServiceDiscoveryName(name)] = addr
+	}
+	resolver.Register(&staticBuilder{addresses: addresses})
+
+	return &standalone{svcOpt: opt, maintenance: new(localMaintenance)}, nil
+}
+
+type standalone struct {
+	svcOpt      ServiceOption
+	maintenance *localMaintenance
+
+	lock sync.RWMutex
+	// disableMasterSlaveFlag defines if the service instance's master-slave check is disabled and treated as slave.
+	disableMasterSlaveFlag bool
+}
+
+// LBRoundRobin returns a load balance based on all the service's instance.
+func (s *standalone) LBRoundRobin() grpc.DialOption {
+	return grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"LoadBalancingPolicy": "%s"}`, roundrobin.Name))
+}
+
+// Maintenance returns the maintenance mode, the state is only kept in the process in standalone mode.
+func (s *standalone) Maintenance() Maintenance {
+	return s.maintenance
+}
+
+// Register the service, the address of standalone service is static, so it does nothing.
+func (s *standalone) Register() error {
+	return nil
+}
+
+// Deregister the service.
+func (s *standalone) Deregister() error {
+	return nil
+}
+
+// IsMaster standalone service is always the master unless master-slave is disabled.
+func (s *standalone) IsMaster() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return !s.disableMasterSlaveFlag
+}
+
+// DisableMasterSlave disable/enable this service instance's master-slave check.
+func (s *standalone) DisableMasterSlave(disable bool) {
+	s.lock.Lock()
+	s.disableMasterSlaveFlag = disable
+	s.lock.Unlock()
+
+	logs.Infof("master-slave disabled status: %v", disable)
+}
+
+// Healthz standalone service depends on no etcd, so it's always healthy.
+func (s *standalone) Healthz() error {
+	return nil
+}
+
+// NewElection create a leader election which is always won by this instance.
+func (s *standalone) NewElection(name string) (Election, error) {
+	if len(name) == 0 {
+		return nil, errors.New("election name is empty")
+	}
+
+	return &standaloneElection{value: s.svcOpt.Uid}, nil
+}
+
+// standaloneElection is the election of the only one instance.
+type standaloneElection struct {
+	value string
+
+	lock sync.Mutex
+	// lost is closed when the leadership is resigned, it's nil if this instance is not the leader.
+	lost chan struct{}
+}
+
+// Campaign becomes the leader immediately.
+func (e *standaloneElection) Campaign(ctx context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.lost == nil {
+		e.lost = make(chan struct{})
+	}
+	return ctx.Err()
+}
+
+// Resign gives up the leadership.
+func (e *standaloneElection) Resign(_ context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.lost != nil {
+		close(e.lost)
+		e.lost = nil
+	}
+	return nil
+}
+
+// IsLeader test if this instance is the leader or not.
+func (e *standaloneElection) IsLeader() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.lost != nil
+}
+
+// Leader returns this instance's value if it's the leader.
+func (e *standaloneElection) Leader(_ context.Context) (string, error) {
+	if !e.IsLeader() {
+		return "", nil
+	}
+	return e.value, nil
+}
+
+// Observe returns a channel that receives this instance's value once, because the leader never changes.
+func (e *standaloneElection) Observe(ctx context.Context) <-chan string {
+	ch := make(chan string, 1)
+	ch <- e.value
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch
+}
+
+// Done returns a channel that is closed when the leadership is resigned.
+func (e *standaloneElection) Done() <-chan struct{} {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.lost == nil {
+		return closedCh
+	}
+	return e.lost
+}
+
+// Close resigns the leadership.
+func (e *standaloneElection) Close() error {
+	return e.Resign(context.Background())
+}
+
+// staticBuilder creates the resolver which resolves the target with the static addresses, it uses
+// the same scheme with etcd, so that the grpc dial target of the services is not changed.
+type staticBuilder struct {
+	addresses map[string]string
+}
+
+// Build creates a static resolver and updates the target's address at once.
+func (b *staticBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (
+	resolver.Resolver, error) {
+
+	// the target endpoint is the service's discovery name, e.g: /bk-bscp/services/data-service
+	addr, ok := b.addresses[target.Endpoint()]
+	if !ok {
+		// 未配置的服务(如未部署的 auth-server)不阻塞拨号, 调用时会返回不可用
+		logs.Warnf("standalone service %s address is not configured", target.Endpoint())
+		return staticResolver{}, nil
+	}
+
+	state := resolver.State{Addresses: []resolver.Address{{Addr: addr}}}
+	if err := cc.UpdateState(state); err != nil {
+		logs.Errorf("client conn update state failed, addr: %s, err: %v", addr, err)
+	}
+
+	return staticResolver{}, nil
+}
+
+// Scheme return grpc scheme.
+func (b *staticBuilder) Scheme() string {
+	return "etcd"
+}
+
+// staticResolver never changes the address.
+type staticResolver struct{}
+
+// ResolveNow does nothing, because the address is static.
+func (staticResolver) ResolveNow(resolver.ResolveNowOptions) {}
+
+// Close closes the resolver.
+func (staticResolver) Close() {}
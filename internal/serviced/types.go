@@ -29,6 +29,9 @@ const (
 	defaultGrantLeaseTTL = 10
 	// defaultErrSleepTime is exec failed need to wait time.
 	defaultErrSleepTime = time.Second
+	// defaultElectionSessionTTL is the election session's lease ttl, if the leader does not keep alive
+	// in this time, the leadership will be lost.
+	defaultElectionSessionTTL = 10
 )
 
 // ServiceOption defines a service related options.
@@ -38,6 +41,8 @@ type ServiceOption struct {
 	Port uint
 	// Uid is a service's unique identity.
 	Uid string
+	// Locality is the region and zone of the service instance.
+	Locality cc.Locality
 }
 
 // Validate the service option
@@ -61,6 +66,15 @@ func (so ServiceOption) Validate() error {
 	return nil
 }
 
+// instance is the registered value of a service instance in etcd, it's compatible with the json of
+// resolver.Address which is registered by the old versions.
+type instance struct {
+	Addr       string
+	ServerName string
+	Region     string `json:",omitempty"`
+	Zone       string `json:",omitempty"`
+}
+
 // DiscoveryOption defines all the service discovery
 // related options.
 type DiscoveryOption struct {
@@ -77,6 +91,12 @@ func ServiceDiscoveryName(serviceName cc.Name) string {
 	return fmt.Sprintf("/bk-bscp/services/%s", serviceName)
 }
 
+// ElectionName return the leader election's key prefix in etcd.
+// e.g: /bk-bscp/elections/data-service/scheduler
+func ElectionName(serviceName cc.Name, name string) string {
+	return fmt.Sprintf("/bk-bscp/elections/%s/%s", serviceName, name)
+}
+
 // key return service's register key in etcd.
 // e.g: /bk-bscp/services/data-service/0fa709f2-8e35-11ec-83f6-acde48001122
 func key(path, uid string) string {
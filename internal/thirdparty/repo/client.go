@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"sync"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -35,6 +36,26 @@ type Client struct {
 	client rest.ClientInterface
 	// http header info
 	basicHeader http.Header
+	// headerLock 保护 basicHeader, 凭证热加载时会替换
+	headerLock sync.RWMutex
+}
+
+// SetBasicAuth 更新 bkrepo basic auth 凭证, 用于配置热加载
+func (c *Client) SetBasicAuth(username, password string) {
+	authStr := base64.RawStdEncoding.EncodeToString([]byte(username + ":" + password))
+
+	c.headerLock.Lock()
+	defer c.headerLock.Unlock()
+
+	header := c.basicHeader.Clone()
+	header.Set("Authorization", fmt.Sprintf("Basic %s", authStr))
+	c.basicHeader = header
+}
+
+func (c *Client) header() http.Header {
+	c.headerLock.RLock()
+	defer c.headerLock.RUnlock()
+	return c.basicHeader
 }
 
 // NewClient new repo client.
@@ -51,6 +72,14 @@ func NewClient(repoSetting cc.BaseRepo, reg prometheus.Registerer) (*Client, err
 		return nil, err
 	}
 
+	if proxy := repoSetting.Proxy.ProxyConfig(); proxy.Enable() {
+		transport, err := tools.NewProxyTransport(cli.Transport.(*http.Transport), proxy)
+		if err != nil {
+			return nil, fmt.Errorf("init repo proxy transport failed, err: %v", err)
+		}
+		cli.Transport = transport
+	}
+
 	c := &client.Capability{
 		Client: cli,
 		Discover: &repoDiscovery{
@@ -85,7 +114,7 @@ func (c *Client) IsProjectExist(ctx context.Context) error {
 	resp := c.client.Get().
 		WithContext(ctx).
 		SubResourcef("/repository/api/project/exist/%s", c.config.BkRepo.Project).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return resp.Err
@@ -114,7 +143,7 @@ func (c *Client) CreateRepo(ctx context.Context, req *CreateRepoReq) error {
 	resp := c.client.Post().
 		WithContext(ctx).
 		SubResourcef("/repository/api/repo/create").
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Body(req).
 		Do()
 	if resp.Err != nil {
@@ -152,7 +181,7 @@ func (c *Client) DeleteRepo(ctx context.Context, bizID uint32, forced bool) erro
 		WithContext(ctx).
 		SubResourcef("/repository/api/repo/delete/%s/%s", c.config.BkRepo.Project, repoName).
 		WithParam("forced", strconv.FormatBool(forced)).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return resp.Err
@@ -181,7 +210,7 @@ func (c *Client) IsNodeExist(ctx context.Context, nodePath string) (bool, error)
 	resp := c.client.Head().
 		WithContext(ctx).
 		SubResourcef(nodePath).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return false, resp.Err
@@ -203,7 +232,7 @@ func (c *Client) DeleteNode(ctx context.Context, nodePath string) error {
 	resp := c.client.Delete().
 		WithContext(ctx).
 		SubResourcef(nodePath).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return resp.Err
@@ -248,7 +277,7 @@ func (c *Client) QueryMetadata(ctx context.Context, opt *NodeOption) (map[string
 	resp := c.client.Get().
 		WithContext(ctx).
 		SubResourcef("/repository/api/metadata/%s/%s%s", opt.Project, repoName, fullPath).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return nil, resp.Err
@@ -275,7 +304,7 @@ func (c *Client) FileMetadataHead(ctx context.Context, nodePath string) (*FileMe
 	resp := c.client.Head().
 		WithContext(ctx).
 		SubResourcef(nodePath).
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Do()
 	if resp.Err != nil {
 		return nil, resp.Err
@@ -299,7 +328,7 @@ func (c *Client) GenerateTempDownloadURL(ctx context.Context, req *GenerateTempD
 	resp := c.client.Post().
 		WithContext(ctx).
 		SubResourcef("/generic/temporary/url/create").
-		WithHeaders(c.basicHeader).
+		WithHeaders(c.header()).
 		Body(req).
 		Do()
 	if resp.Err != nil {
@@ -14,28 +14,77 @@
 package cc
 
 import (
+	"os"
 	"sync"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
 
-var runtimeOnce sync.Once
-
 // rt is the runtime Setting which is loaded from
 // config file.
 // It can be called only after LoadSettings is executed successfully.
 var rt *runtime
 
-func initRuntime(s Setting) {
-	runtimeOnce.Do(func() {
-		rt = &runtime{
-			settings: s,
-		}
-	})
+// runtimes are the runtime Settings of the services run in current process, keyed by the service name.
+// there is more than one service only in the all-in-one deployment, and rt is the first loaded one.
+var runtimes = struct {
+	lock sync.Mutex
+	all  map[Name]*runtime
+	// order is the services in the order they are loaded.
+	order []Name
+}{all: make(map[Name]*runtime)}
+
+func initRuntime(name Name, sys *SysOption, s Setting) {
+	runtimes.lock.Lock()
+	defer runtimes.lock.Unlock()
+
+	if _, exists := runtimes.all[name]; exists {
+		return
+	}
+
+	r := &runtime{
+		name:     name,
+		sys:      sys,
+		settings: s,
+	}
+	runtimes.all[name] = r
+	runtimes.order = append(runtimes.order, name)
+	if rt == nil {
+		rt = r
+	}
+}
+
+// runtimeOf returns the runtime Setting of the service, the current service's is returned if the service
+// is not run in current process.
+func runtimeOf(name Name) *runtime {
+	runtimes.lock.Lock()
+	defer runtimes.lock.Unlock()
+
+	if r, exists := runtimes.all[name]; exists {
+		return r
+	}
+
+	return rt
+}
+
+// loadedRuntimes returns the runtime Settings of all the services run in current process in the loaded order.
+func loadedRuntimes() []*runtime {
+	runtimes.lock.Lock()
+	defer runtimes.lock.Unlock()
+
+	list := make([]*runtime, 0, len(runtimes.order))
+	for _, name := range runtimes.order {
+		list = append(list, runtimes.all[name])
+	}
+
+	return list
 }
 
 type runtime struct {
-	lock     sync.Mutex
+	lock sync.Mutex
+	name Name
+	// sys is the system option which the settings are loaded with.
+	sys      *SysOption
 	settings Setting
 }
 
@@ -56,15 +105,16 @@ func (r *runtime) Ready() bool {
 
 // ApiServer return api server Setting.
 func ApiServer() ApiServerSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(APIServerName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty api server setting")
 		return ApiServerSetting{}
 	}
 
-	s, ok := rt.settings.(*ApiServerSetting)
+	s, ok := r.settings.(*ApiServerSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get api server setting", ServiceName())
 		return ApiServerSetting{}
@@ -75,15 +125,16 @@ func ApiServer() ApiServerSetting {
 
 // AuthServer return auth server Setting.
 func AuthServer() AuthServerSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(AuthServerName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty auth server setting")
 		return AuthServerSetting{}
 	}
 
-	s, ok := rt.settings.(*AuthServerSetting)
+	s, ok := r.settings.(*AuthServerSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get auth server setting", ServiceName())
 		return AuthServerSetting{}
@@ -94,15 +145,16 @@ func AuthServer() AuthServerSetting {
 
 // ConfigServer return config server Setting.
 func ConfigServer() ConfigServerSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(ConfigServerName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty config server setting")
 		return ConfigServerSetting{}
 	}
 
-	s, ok := rt.settings.(*ConfigServerSetting)
+	s, ok := r.settings.(*ConfigServerSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get config server setting", ServiceName())
 		return ConfigServerSetting{}
@@ -113,15 +165,16 @@ func ConfigServer() ConfigServerSetting {
 
 // FeedServer return feed server Setting.
 func FeedServer() FeedServerSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(FeedServerName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty feed server setting")
 		return FeedServerSetting{}
 	}
 
-	s, ok := rt.settings.(*FeedServerSetting)
+	s, ok := r.settings.(*FeedServerSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get feed server setting", ServiceName())
 		return FeedServerSetting{}
@@ -132,15 +185,16 @@ func FeedServer() FeedServerSetting {
 
 // FeedProxy return feed proxy Setting.
 func FeedProxy() FeedProxySetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(FeedProxyName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty feed proxy setting")
 		return FeedProxySetting{}
 	}
 
-	s, ok := rt.settings.(*FeedProxySetting)
+	s, ok := r.settings.(*FeedProxySetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get feed proxy setting", ServiceName())
 		return FeedProxySetting{}
@@ -151,15 +205,16 @@ func FeedProxy() FeedProxySetting {
 
 // CacheService return cache service Setting.
 func CacheService() CacheServiceSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(CacheServiceName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty cache service setting")
 		return CacheServiceSetting{}
 	}
 
-	s, ok := rt.settings.(*CacheServiceSetting)
+	s, ok := r.settings.(*CacheServiceSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get cache service setting", ServiceName())
 		return CacheServiceSetting{}
@@ -170,15 +225,16 @@ func CacheService() CacheServiceSetting {
 
 // DataService return data service Setting.
 func DataService() DataServiceSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(DataServiceName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty data service setting")
 		return DataServiceSetting{}
 	}
 
-	s, ok := rt.settings.(*DataServiceSetting)
+	s, ok := r.settings.(*DataServiceSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get data service setting", ServiceName())
 		return DataServiceSetting{}
@@ -189,15 +245,16 @@ func DataService() DataServiceSetting {
 
 // VaultServer return vault service Setting.
 func VaultServer() VaultServerSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(VaultServerName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty data service setting")
 		return VaultServerSetting{}
 	}
 
-	s, ok := rt.settings.(*VaultServerSetting)
+	s, ok := r.settings.(*VaultServerSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get vault server setting", ServiceName())
 		return VaultServerSetting{}
@@ -208,15 +265,16 @@ func VaultServer() VaultServerSetting {
 
 // VaultSidecar return vault sidecar service Setting.
 func VaultSidecar() VaultSidecarSetting {
-	rt.lock.Lock()
-	defer rt.lock.Unlock()
+	r := runtimeOf(VaultSidecarName)
+	r.lock.Lock()
+	defer r.lock.Unlock()
 
-	if !rt.Ready() {
+	if !r.Ready() {
 		logs.ErrorDepthf(1, "runtime not ready, return empty data service setting")
 		return VaultSidecarSetting{}
 	}
 
-	s, ok := rt.settings.(*VaultSidecarSetting)
+	s, ok := r.settings.(*VaultSidecarSetting)
 	if !ok {
 		logs.ErrorDepthf(1, "current %s service can not get vault sidecar setting", ServiceName())
 		return VaultSidecarSetting{}
@@ -224,3 +282,55 @@ func VaultSidecar() VaultSidecarSetting {
 
 	return *s
 }
+
+// CtlToken return the token of the control and debug endpoints of current service.
+func CtlToken() string {
+	return ctlNetwork().CtlToken
+}
+
+// CtlProfileDir return the directory to save the profiles captured by the debug commands of current service.
+func CtlProfileDir() string {
+	if dir := ctlNetwork().CtlProfileDir; dir != "" {
+		return dir
+	}
+
+	return os.TempDir()
+}
+
+// ctlNetwork return the network setting of current service, which defines its control and debug options.
+// the first loaded service's is used if more than one service run in current process.
+func ctlNetwork() Network {
+	if rt == nil {
+		return Network{}
+	}
+
+	rt.lock.Lock()
+	defer rt.lock.Unlock()
+
+	if !rt.Ready() {
+		return Network{}
+	}
+
+	switch s := rt.settings.(type) {
+	case *ApiServerSetting:
+		return s.Network
+	case *AuthServerSetting:
+		return s.Network
+	case *ConfigServerSetting:
+		return s.Network
+	case *FeedServerSetting:
+		return s.Network
+	case *FeedProxySetting:
+		return s.Network
+	case *CacheServiceSetting:
+		return s.Network
+	case *DataServiceSetting:
+		return s.Network
+	case *VaultServerSetting:
+		return s.Network
+	case *VaultSidecarSetting:
+		return s.Network
+	default:
+		return Network{}
+	}
+}
@@ -29,42 +29,56 @@ func LoadSettings(sys *SysOption) error {
 		return errors.New("service's configuration file path is not configured")
 	}
 
-	if err := initGlobalConf(sys.ConfigFiles); err != nil {
+	if err := initGlobalConf(sys.service(), sys.ConfigFiles); err != nil {
 		return err
 	}
 
-	conf, err := mergeConfigFile(sys.ConfigFiles)
+	s, err := parseSettings(sys)
 	if err != nil {
 		return err
 	}
+
+	initRuntime(sys.service(), sys, s)
+
+	return nil
+}
+
+// parseSettings parse and validate the service's setting from the configuration files.
+func parseSettings(sys *SysOption) (Setting, error) {
+	conf, err := mergeConfigFile(sys.service(), sys.ConfigFiles)
+	if err != nil {
+		return nil, err
+	}
+	// 解析配置中 ${env:VAR} 和 ${vault:path#key} 形式的密钥引用
+	if conf, err = resolveSecrets(conf); err != nil {
+		return nil, err
+	}
 	// configure file is configured, then load configuration from file.
-	s, err := loadFromFile(conf)
+	s, err := loadFromFile(sys.service(), conf)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = s.trySetFlagBindIP(sys.BindIP); err != nil {
-		return err
+		return nil, err
 	}
 
 	if err = s.trySetFlagPort(sys.Port, sys.GRPCPort); err != nil {
-		return err
+		return nil, err
 	}
 
 	// set the default value if user not configured.
 	s.trySetDefault()
 
-	if err := s.Validate(); err != nil {
-		return err
+	if err := validateSetting(s); err != nil {
+		return nil, err
 	}
 
-	initRuntime(s)
-
-	return nil
+	return s, nil
 }
 
 // mergeConfigFile 合并多个配置文件
-func mergeConfigFile(filenames []string) ([]byte, error) {
+func mergeConfigFile(name Name, filenames []string) ([]byte, error) {
 	masterConf := map[string]interface{}{}
 
 	for _, filename := range filenames {
@@ -78,6 +92,11 @@ func mergeConfigFile(filenames []string) ([]byte, error) {
 			return nil, fmt.Errorf("unmarshal Setting yaml from file: %s failed, err: %v", filename, err)
 		}
 
+		// 单一配置文件时, 选出当前服务的配置段
+		if tmpConf, err = selectServiceSection(name, tmpConf); err != nil {
+			return nil, fmt.Errorf("load Setting from file: %s failed, err: %v", filename, err)
+		}
+
 		// 后面的配置文件按Key直接覆盖前面的配置
 		for k, v := range tmpConf {
 			masterConf[k] = v
@@ -89,13 +108,13 @@ func mergeConfigFile(filenames []string) ([]byte, error) {
 }
 
 // loadFromFile load service's configuration from local config file.
-func loadFromFile(conf []byte) (Setting, error) {
+func loadFromFile(name Name, conf []byte) (Setting, error) {
 	if len(conf) == 0 {
 		return nil, errors.New("file name is not set")
 	}
 
 	var s Setting
-	switch ServiceName() {
+	switch name {
 	case APIServerName:
 		s = new(ApiServerSetting)
 	case AuthServerName:
@@ -115,18 +134,19 @@ func loadFromFile(conf []byte) (Setting, error) {
 	case VaultSidecarName:
 		s = new(VaultSidecarSetting)
 	default:
-		return nil, fmt.Errorf("unknown %s service name", ServiceName())
+		return nil, fmt.Errorf("unknown %s service name", name)
 	}
 
 	if err := yaml.Unmarshal(conf, s); err != nil {
-		return nil, fmt.Errorf("unmarshal Setting yaml from conf:\n%s failed, err: %v", conf, err)
+		// the conf may contain the resolved secrets, so it is not printed.
+		return nil, fmt.Errorf("unmarshal Setting yaml failed, err: %v", err)
 	}
 
 	return s, nil
 }
 
 // 初始化全局配置
-func initGlobalConf(filenames []string) error {
+func initGlobalConf(name Name, filenames []string) error {
 	v := viper.New()
 	for _, f := range filenames {
 		fmt.Println("read config from: ", f)
@@ -137,5 +157,90 @@ func initGlobalConf(filenames []string) error {
 		}
 	}
 
+	// 全局配置同样按服务选出配置段
+	if section, ok := v.Get(servicesKey + "." + string(name)).(map[string]interface{}); ok {
+		if err := v.MergeConfigMap(section); err != nil {
+			return fmt.Errorf("merge %s section failed, err: %v", name, err)
+		}
+	}
+
+	// 全局配置同样支持密钥引用
+	r := newSecretResolver()
+	for _, key := range v.AllKeys() {
+		value, ok := v.Get(key).(string)
+		if !ok || !secretRefRegexp.MatchString(value) {
+			continue
+		}
+
+		resolved, err := r.resolve(value)
+		if err != nil {
+			return fmt.Errorf("resolve config %s failed, err: %v", key, err)
+		}
+		v.Set(key, resolved)
+	}
+
 	return config.G.ReadFromViper(v)
 }
+
+// servicesKey is the top level key of the per-service sections in the single configuration file.
+const servicesKey = "services"
+
+// selectServiceSection 支持所有服务共用一个配置文件, 顶层配置项(etcd, redis, mysql, tls 等)为各服务公共配置,
+// services 下按服务名划分各服务的专属配置, 当前服务的配置段会逐层覆盖到公共配置上, 其它服务的配置段被忽略.
+// 没有 services 配置项时, 配置原样返回, 兼容每个服务一个配置文件的方式.
+func selectServiceSection(name Name, conf map[string]interface{}) (map[string]interface{}, error) {
+	raw, exists := conf[servicesKey]
+	if !exists {
+		return conf, nil
+	}
+
+	sections, ok := raw.(map[string]interface{})
+	if !ok && raw != nil {
+		return nil, fmt.Errorf("%s should be a map of service name to its section", servicesKey)
+	}
+
+	for one := range sections {
+		if !knownServiceNames[Name(one)] {
+			return nil, fmt.Errorf("unknown service section %s.%s", servicesKey, one)
+		}
+	}
+
+	merged := make(map[string]interface{}, len(conf))
+	for k, v := range conf {
+		if k != servicesKey {
+			merged[k] = v
+		}
+	}
+
+	section := sections[string(name)]
+	if section == nil {
+		return merged, nil
+	}
+
+	sectionMap, ok := section.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s.%s should be a map", servicesKey, name)
+	}
+
+	return deepMerge(merged, sectionMap), nil
+}
+
+// deepMerge 将 src 逐层合并到 dst 上, 同名的 map 递归合并, 其它类型(包括列表)由 src 直接覆盖.
+func deepMerge(dst, src map[string]interface{}) map[string]interface{} {
+	for k, sv := range src {
+		srcMap, srcIsMap := sv.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			// 复制一份, 避免修改公共配置
+			cp := make(map[string]interface{}, len(dstMap))
+			for dk, dv := range dstMap {
+				cp[dk] = dv
+			}
+			dst[k] = deepMerge(cp, srcMap)
+			continue
+		}
+		dst[k] = sv
+	}
+
+	return dst
+}
@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSelectServiceSection(t *testing.T) {
+	conf := `
+network:
+  bindIP: 127.0.0.1
+  rpcPort: 9000
+  tls:
+    insecureSkipVerify: true
+log:
+  logDir: ./log
+services:
+  data-service:
+    network:
+      rpcPort: 9511
+  cache-service:
+    network:
+      rpcPort: 9514
+`
+	file := filepath.Join(t.TempDir(), "bk_bscp.yaml")
+	if err := os.WriteFile(file, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	merged, err := mergeConfigFile(DataServiceName, []string{file})
+	if err != nil {
+		t.Fatalf("merge config file failed, err: %v", err)
+	}
+	s, err := loadFromFile(DataServiceName, merged)
+	if err != nil {
+		t.Fatalf("load setting failed, err: %v", err)
+	}
+
+	ds := s.(*DataServiceSetting)
+	if ds.Network.RpcPort != 9511 {
+		t.Errorf("rpcPort should be overridden by the service section, got %d", ds.Network.RpcPort)
+	}
+	if ds.Network.BindIP != "127.0.0.1" || !ds.Network.TLS.InsecureSkipVerify || ds.Log.LogDir != "./log" {
+		t.Errorf("common blocks should be kept, got %+v", ds)
+	}
+
+	_, err = selectServiceSection(DataServiceName, map[string]interface{}{
+		servicesKey: map[string]interface{}{"data-servcie": map[string]interface{}{}},
+	})
+	if err == nil {
+		t.Errorf("unknown service section should be rejected")
+	}
+}
+
+func TestLoadServicesInOneProcess(t *testing.T) {
+	defer func(r *runtime, all map[Name]*runtime, order []Name) {
+		rt, runtimes.all, runtimes.order = r, all, order
+	}(rt, runtimes.all, runtimes.order)
+	rt, runtimes.all, runtimes.order = nil, make(map[Name]*runtime), nil
+
+	conf := `
+network:
+  bindIP: 127.0.0.1
+service:
+  standalone:
+    enabled: true
+sharding:
+  adminDatabase:
+    driver: sqlite
+    database: ./bk_bscp_admin.db
+services:
+  vault-server:
+    network:
+      rpcPort: 9516
+  vault-sidecar:
+    network:
+      rpcPort: 9517
+`
+	file := filepath.Join(t.TempDir(), "bk_bscp.yaml")
+	if err := os.WriteFile(file, []byte(conf), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []Name{VaultServerName, VaultSidecarName} {
+		if err := LoadSettings(&SysOption{ConfigFiles: []string{file}, Service: name}); err != nil {
+			t.Fatalf("load %s settings failed, err: %v", name, err)
+		}
+	}
+
+	if port := VaultServer().Network.RpcPort; port != 9516 {
+		t.Errorf("vault server rpcPort should be 9516, got %d", port)
+	}
+	if port := VaultSidecar().Network.RpcPort; port != 9517 {
+		t.Errorf("vault sidecar rpcPort should be 9517, got %d", port)
+	}
+
+	setting, err := EffectiveSetting()
+	if err != nil {
+		t.Fatalf("get effective setting failed, err: %v", err)
+	}
+	if _, ok := setting[string(VaultServerName)]; !ok {
+		t.Errorf("settings should be keyed by the service name, setting: %v", setting)
+	}
+
+	if _, err = Reload(); err != nil {
+		t.Errorf("reload the settings of all the services failed, err: %v", err)
+	}
+}
@@ -0,0 +1,411 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cc
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/config"
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
+)
+
+// the reload-safe sections of the settings, they are applied to the runtime setting when the
+// configuration files are reloaded, the other sections take effect after the service restarts.
+const (
+	// LogVerbositySection is the log.verbosity section.
+	LogVerbositySection = "log.verbosity"
+	// RateLimiterSection is the feed server's rateLimiter section.
+	RateLimiterSection = "rateLimiter"
+	// MatchReleaseLimiterSection is the feed server's matchReleaseLimiter section.
+	MatchReleaseLimiterSection = "matchReleaseLimiter"
+	// FSLocalCacheSection is the feed server's fsLocalCache section, only the ttl of the caches can be reloaded.
+	FSLocalCacheSection = "fsLocalCache"
+	// RepoCredentialSection is the credential(username, password, accessKeyID, secretAccessKey) of the
+	// repository and its slave.
+	RepoCredentialSection = "repository.credential"
+)
+
+// reloadDebounce is the time to wait for the configuration files to be written completely.
+const reloadDebounce = time.Second
+
+var (
+	// reloadLock makes the reloads run in sequence.
+	reloadLock sync.Mutex
+	hooks      = struct {
+		lock  sync.Mutex
+		hooks []ReloadHook
+	}{}
+)
+
+// ReloadEvent is the result of reloading the configuration files.
+type ReloadEvent struct {
+	// Changed is the changed reload-safe sections which are applied to the runtime setting.
+	Changed []string `json:"changed"`
+	// RestartRequired means the other sections are changed too, and they take effect after restart.
+	RestartRequired bool `json:"restart_required"`
+}
+
+// Has test if the section is changed.
+func (e ReloadEvent) Has(section string) bool {
+	for _, one := range e.Changed {
+		if one == section {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReloadHook is called after the changed reload-safe sections are applied to the runtime setting,
+// so that the services can apply the changes live, the new setting can be got with the service's
+// setting function, e.g. cc.FeedServer().
+type ReloadHook func(e ReloadEvent)
+
+// OnReload registers the hook which is called after the reload-safe sections are changed.
+func OnReload(hook ReloadHook) {
+	hooks.lock.Lock()
+	defer hooks.lock.Unlock()
+
+	hooks.hooks = append(hooks.hooks, hook)
+}
+
+// Reload reloads the configuration files, applies the changed reload-safe sections to the runtime setting
+// and calls the reload hooks. the runtime setting is not changed if the configuration files are invalid.
+// the settings of all the services run in current process are reloaded together.
+func Reload() (ReloadEvent, error) {
+	reloadLock.Lock()
+	defer reloadLock.Unlock()
+
+	loaded := loadedRuntimes()
+	if len(loaded) == 0 {
+		return ReloadEvent{}, errors.New("settings are not loaded")
+	}
+
+	latest := make([]Setting, len(loaded))
+	for idx, r := range loaded {
+		s, err := parseSettings(r.sys)
+		if err != nil {
+			return ReloadEvent{}, fmt.Errorf("reload %s settings failed, err: %v", r.name, err)
+		}
+		latest[idx] = s
+	}
+
+	event := ReloadEvent{Changed: make([]string, 0)}
+	for idx, r := range loaded {
+		one := r.apply(latest[idx])
+		for _, section := range one.Changed {
+			if !event.Has(section) {
+				event.Changed = append(event.Changed, section)
+			}
+		}
+		event.RestartRequired = event.RestartRequired || one.RestartRequired
+
+		if one.Has(LogVerbositySection) {
+			logs.SetV(int32(logVerbosity(latest[idx])))
+		}
+	}
+
+	if len(event.Changed) == 0 {
+		return event, nil
+	}
+
+	hooks.lock.Lock()
+	list := append([]ReloadHook(nil), hooks.hooks...)
+	hooks.lock.Unlock()
+
+	for _, hook := range list {
+		hook(event)
+	}
+
+	return event, nil
+}
+
+// apply applies the changed reload-safe sections of the new setting to the runtime setting.
+func (r *runtime) apply(s Setting) ReloadEvent {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	event := ReloadEvent{Changed: make([]string, 0)}
+	current, latest := reloadableSections(r.settings), reloadableSections(s)
+	for idx := range current {
+		changed := false
+		for i, field := range current[idx].fields {
+			dst, src := reflect.ValueOf(field).Elem(), reflect.ValueOf(latest[idx].fields[i]).Elem()
+			if !reflect.DeepEqual(dst.Interface(), src.Interface()) {
+				dst.Set(src)
+				changed = true
+			}
+		}
+
+		if changed {
+			event.Changed = append(event.Changed, current[idx].name)
+		}
+	}
+
+	// the reload-safe sections are the same now, so any other difference requires restart.
+	event.RestartRequired = !reflect.DeepEqual(r.settings, s)
+
+	return event
+}
+
+// section is a reload-safe section of the setting, fields are the pointers of the section's fields.
+type section struct {
+	name   string
+	fields []interface{}
+}
+
+// reloadableSections returns the reload-safe sections of the setting.
+func reloadableSections(s Setting) []section {
+	switch v := s.(type) {
+	case *ApiServerSetting:
+		return []section{logSection(&v.Log), repoCredentialSection(&v.Repo)}
+	case *AuthServerSetting:
+		return []section{logSection(&v.Log)}
+	case *ConfigServerSetting:
+		return []section{logSection(&v.Log), repoCredentialSection(&v.Repo)}
+	case *DataServiceSetting:
+		return []section{logSection(&v.Log), repoCredentialSection(&v.Repo)}
+	case *CacheServiceSetting:
+		return []section{logSection(&v.Log)}
+	case *FeedServerSetting:
+		return []section{
+			logSection(&v.Log),
+			{name: RateLimiterSection, fields: []interface{}{&v.RateLimiter}},
+			{name: MatchReleaseLimiterSection, fields: []interface{}{&v.MRLimiter}},
+			{name: FSLocalCacheSection, fields: []interface{}{&v.FSLocalCache.AppCacheTTLSec,
+				&v.FSLocalCache.ReleasedCICacheTTLSec, &v.FSLocalCache.ReleasedKvCacheTTLSec,
+				&v.FSLocalCache.ReleasedGroupCacheTTLSec, &v.FSLocalCache.ReleasedHookCacheTTLSec,
+				&v.FSLocalCache.AuthCacheTTLSec}},
+			repoCredentialSection(&v.Repository),
+		}
+	case *FeedProxySetting:
+		return []section{logSection(&v.Log)}
+	case *VaultServerSetting:
+		return []section{logSection(&v.Log)}
+	case *VaultSidecarSetting:
+		return []section{logSection(&v.Log)}
+	default:
+		return nil
+	}
+}
+
+func logSection(l *LogOption) section {
+	return section{name: LogVerbositySection, fields: []interface{}{&l.Verbosity}}
+}
+
+func repoCredentialSection(r *Repository) section {
+	return section{name: RepoCredentialSection, fields: []interface{}{
+		&r.BkRepo.Username, &r.BkRepo.Password, &r.S3.AccessKeyID, &r.S3.SecretAccessKey,
+		&r.Slave.BkRepo.Username, &r.Slave.BkRepo.Password, &r.Slave.S3.AccessKeyID, &r.Slave.S3.SecretAccessKey,
+	}}
+}
+
+// logVerbosity returns the log verbosity of the setting.
+func logVerbosity(s Setting) uint {
+	for _, one := range reloadableSections(s) {
+		if one.name == LogVerbositySection {
+			return *one.fields[0].(*uint)
+		}
+	}
+
+	return 0
+}
+
+// settingsWatcher watches the configuration files of the loaded settings.
+var settingsWatcher = struct {
+	lock    sync.Mutex
+	watcher *fsnotify.Watcher
+	// files are the absolute paths of the watched configuration files.
+	files map[string]bool
+}{files: make(map[string]bool)}
+
+// WatchSettings watches the configuration files, and reloads them when they are changed. the directories
+// of the files are watched, so that the files replaced by rename(e.g. the k8s configmap) can be detected.
+// it can be called again after more services' settings are loaded, only the new files are watched then.
+func WatchSettings() error {
+	list := loadedRuntimes()
+	if len(list) == 0 {
+		return errors.New("settings are not loaded")
+	}
+
+	settingsWatcher.lock.Lock()
+	defer settingsWatcher.lock.Unlock()
+
+	if settingsWatcher.watcher == nil {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return fmt.Errorf("new config file watcher failed, err: %v", err)
+		}
+		settingsWatcher.watcher = watcher
+
+		go watchLoop(watcher)
+	}
+
+	for _, r := range list {
+		for _, one := range r.sys.ConfigFiles {
+			path, err := filepath.Abs(one)
+			if err != nil {
+				return err
+			}
+			path = filepath.Clean(path)
+			if settingsWatcher.files[path] {
+				continue
+			}
+
+			if err = settingsWatcher.watcher.Add(filepath.Dir(path)); err != nil {
+				return fmt.Errorf("watch config file %s failed, err: %v", path, err)
+			}
+			settingsWatcher.files[path] = true
+		}
+	}
+
+	return nil
+}
+
+// isWatchedFile test if the file is a watched configuration file.
+func isWatchedFile(name string) bool {
+	settingsWatcher.lock.Lock()
+	defer settingsWatcher.lock.Unlock()
+
+	return settingsWatcher.files[filepath.Clean(name)]
+}
+
+func watchLoop(watcher *fsnotify.Watcher) {
+	var timer <-chan time.Time
+	for {
+		select {
+		case e, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+
+			// k8s configmap updates the files by replacing the ..data symlink.
+			if !isWatchedFile(e.Name) && !strings.HasSuffix(e.Name, "..data") {
+				continue
+			}
+			if e.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) == 0 {
+				continue
+			}
+			timer = time.After(reloadDebounce)
+
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			logs.Errorf("watch config files failed, err: %v", err)
+
+		case <-timer:
+			timer = nil
+			event, err := Reload()
+			if err != nil {
+				logs.Errorf("config files are changed, but %v", err)
+				continue
+			}
+			logs.Infof("config files are reloaded, changed: %v, restart required: %v", event.Changed,
+				event.RestartRequired)
+		}
+	}
+}
+
+// sensitiveKeys are the keys whose values are masked in the effective setting.
+var sensitiveKeys = []string{"password", "token", "secret", "master_key", "masterkey"}
+
+// EffectiveSetting returns the current effective setting of the service as a yaml compatible map,
+// the sensitive values are masked. the static global configuration is returned if the runtime setting
+// is not loaded, e.g. in tools and tests.
+func EffectiveSetting() (map[string]interface{}, error) {
+	out, err := runtimeSettingYaml()
+	if err != nil {
+		return nil, fmt.Errorf("marshal setting failed, err: %v", err)
+	}
+
+	m := make(map[string]interface{})
+	if err = yaml.Unmarshal(out, &m); err != nil {
+		return nil, fmt.Errorf("unmarshal setting failed, err: %v", err)
+	}
+	maskSensitive(m)
+
+	return m, nil
+}
+
+// runtimeSettingYaml marshal the runtime setting, or the static global configuration if it is not loaded.
+// the settings are keyed by the service name if more than one service run in current process.
+func runtimeSettingYaml() ([]byte, error) {
+	list := loadedRuntimes()
+	switch len(list) {
+	case 0:
+		return yaml.Marshal(config.G)
+	case 1:
+		return list[0].marshal()
+	}
+
+	all := make(map[Name]interface{}, len(list))
+	for _, r := range list {
+		out, err := r.marshal()
+		if err != nil {
+			return nil, err
+		}
+
+		var one interface{}
+		if err = yaml.Unmarshal(out, &one); err != nil {
+			return nil, err
+		}
+		all[r.name] = one
+	}
+
+	return yaml.Marshal(all)
+}
+
+// marshal the runtime setting.
+func (r *runtime) marshal() ([]byte, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return yaml.Marshal(r.settings)
+}
+
+func maskSensitive(v interface{}) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, one := range val {
+			if s, ok := one.(string); ok && s != "" && isSensitiveKey(k) {
+				val[k] = "******"
+				continue
+			}
+			maskSensitive(one)
+		}
+	case []interface{}:
+		for _, one := range val {
+			maskSensitive(one)
+		}
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, one := range sensitiveKeys {
+		if strings.Contains(key, one) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cc
+
+import (
+	"testing"
+)
+
+func TestRuntimeApply(t *testing.T) {
+	current := &FeedServerSetting{}
+	current.Log.Verbosity = 1
+	current.FSLocalCache.AppCacheTTLSec = 60
+	r := &runtime{settings: current}
+
+	latest := &FeedServerSetting{}
+	latest.Log.Verbosity = 3
+	latest.FSLocalCache.AppCacheTTLSec = 120
+	event := r.apply(latest)
+	if !event.Has(LogVerbositySection) || !event.Has(FSLocalCacheSection) || event.Has(RateLimiterSection) {
+		t.Errorf("unexpected changed sections: %v", event.Changed)
+	}
+	if event.RestartRequired {
+		t.Errorf("only reload-safe sections are changed, should not require restart")
+	}
+	if current.Log.Verbosity != 3 || current.FSLocalCache.AppCacheTTLSec != 120 {
+		t.Errorf("reload-safe sections are not applied, setting: %+v", current)
+	}
+
+	latest = &FeedServerSetting{}
+	latest.Log.Verbosity = 3
+	latest.FSLocalCache.AppCacheTTLSec = 120
+	latest.Network.BindIP = "127.0.0.1"
+	event = r.apply(latest)
+	if len(event.Changed) != 0 || !event.RestartRequired {
+		t.Errorf("unexpected reload event: %+v", event)
+	}
+	if current.Network.BindIP != "" {
+		t.Errorf("restart required section should not be applied")
+	}
+}
+
+func TestEffectiveSettingWithoutRuntime(t *testing.T) {
+	if rt != nil {
+		t.Skip("runtime setting is loaded")
+	}
+
+	setting, err := EffectiveSetting()
+	if err != nil {
+		t.Fatalf("get effective setting without runtime failed, err: %v", err)
+	}
+	if _, ok := setting["base_conf"]; !ok {
+		t.Errorf("static global configuration should be returned, setting: %v", setting)
+	}
+}
@@ -0,0 +1,226 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cc
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// secretRefRegexp matches the secret reference in the config value, supported references:
+// ${env:VAR} resolves the value from the environment variable VAR.
+// ${vault:path#key} resolves the value from the key of the vault kv secret path,
+// e.g: ${vault:secret/data/bscp#password}.
+// the vault address and token are read from the environment variables VAULT_ADDR and VAULT_TOKEN,
+// and VAULT_CACERT can be used to specify the ca file when vault is served with tls.
+var secretRefRegexp = regexp.MustCompile(`\$\{(env|vault):([^}]+)}`)
+
+const vaultRequestTimeout = 10 * time.Second
+
+// resolveSecrets resolves the secret references in the yaml configuration.
+func resolveSecrets(conf []byte) ([]byte, error) {
+	if !secretRefRegexp.Match(conf) {
+		return conf, nil
+	}
+
+	node := new(yaml.Node)
+	if err := yaml.Unmarshal(conf, node); err != nil {
+		return nil, fmt.Errorf("unmarshal setting yaml failed, err: %v", err)
+	}
+
+	r := newSecretResolver()
+	if err := r.resolveNode(node); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(node)
+}
+
+// secretResolver resolves the secret references, the vault secrets are cached during one resolution.
+type secretResolver struct {
+	vault map[string]map[string]interface{}
+}
+
+func newSecretResolver() *secretResolver {
+	return &secretResolver{vault: make(map[string]map[string]interface{})}
+}
+
+func (r *secretResolver) resolveNode(node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		for _, one := range node.Content {
+			if err := r.resolveNode(one); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !secretRefRegexp.MatchString(node.Value) {
+		return nil
+	}
+
+	value, err := r.resolve(node.Value)
+	if err != nil {
+		return fmt.Errorf("resolve config value at line %d failed, err: %v", node.Line, err)
+	}
+
+	// the whole value is a reference, so resolve the tag again to support non-string values such as port.
+	whole := secretRefRegexp.FindString(node.Value) == node.Value
+	if whole && node.Style == 0 {
+		node.Tag = ""
+	} else {
+		node.Tag = "!!str"
+	}
+	node.Value = value
+
+	return nil
+}
+
+// resolve replaces all the secret references in the value.
+func (r *secretResolver) resolve(value string) (string, error) {
+	var err error
+	resolved := secretRefRegexp.ReplaceAllStringFunc(value, func(ref string) string {
+		if err != nil {
+			return ref
+		}
+
+		match := secretRefRegexp.FindStringSubmatch(ref)
+		var v string
+		switch match[1] {
+		case "env":
+			v, err = resolveEnv(match[2])
+		case "vault":
+			v, err = r.resolveVault(match[2])
+		}
+		return v
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return resolved, nil
+}
+
+func resolveEnv(name string) (string, error) {
+	v, exists := os.LookupEnv(name)
+	if !exists {
+		return "", fmt.Errorf("environment variable %s is not set", name)
+	}
+	return v, nil
+}
+
+// resolveVault resolves the reference with path#key format from vault kv secret engine.
+func (r *secretResolver) resolveVault(ref string) (string, error) {
+	path, key, found := strings.Cut(ref, "#")
+	if !found || path == "" || key == "" {
+		return "", fmt.Errorf("invalid vault reference %s, should be path#key", ref)
+	}
+
+	data, exists := r.vault[path]
+	if !exists {
+		var err error
+		if data, err = readVaultSecret(path); err != nil {
+			return "", err
+		}
+		r.vault[path] = data
+	}
+
+	v, exists := data[key]
+	if !exists {
+		return "", fmt.Errorf("key %s is not found in vault secret %s", key, path)
+	}
+	if s, ok := v.(string); ok {
+		return s, nil
+	}
+	return fmt.Sprint(v), nil
+}
+
+// readVaultSecret reads the secret data from vault, both kv v1 and v2 engine are supported.
+func readVaultSecret(path string) (map[string]interface{}, error) {
+	addr, token := os.Getenv("VAULT_ADDR"), os.Getenv("VAULT_TOKEN")
+	if addr == "" || token == "" {
+		return nil, errors.New("VAULT_ADDR and VAULT_TOKEN environment variables are required to resolve vault secret")
+	}
+
+	client, err := vaultHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(addr, "/")+"/v1/"+strings.TrimPrefix(path, "/"), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("read vault secret %s failed, err: %v", path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read vault secret %s failed, err: %v", path, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("read vault secret %s failed, status: %d, body: %s", path, resp.StatusCode, body)
+	}
+
+	secret := new(struct {
+		Data map[string]interface{} `json:"data"`
+	})
+	if err := json.Unmarshal(body, secret); err != nil {
+		return nil, fmt.Errorf("unmarshal vault secret %s failed, err: %v", path, err)
+	}
+
+	// kv v2 engine wraps the secret data with metadata.
+	if inner, ok := secret.Data["data"].(map[string]interface{}); ok {
+		if _, hasMeta := secret.Data["metadata"]; hasMeta {
+			return inner, nil
+		}
+	}
+
+	return secret.Data, nil
+}
+
+func vaultHTTPClient() (*http.Client, error) {
+	caFile := os.Getenv("VAULT_CACERT")
+	if caFile == "" {
+		return &http.Client{Timeout: vaultRequestTimeout}, nil
+	}
+
+	ca, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("read vault ca file %s failed, err: %v", caFile, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("invalid vault ca file %s", caFile)
+	}
+
+	return &http.Client{
+		Timeout:   vaultRequestTimeout,
+		Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}},
+	}, nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package cc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestResolveSecrets(t *testing.T) {
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/bscp" || r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"data":{"password":"0123"},"metadata":{"version":1}}}`))
+	}))
+	defer vault.Close()
+
+	t.Setenv("VAULT_ADDR", vault.URL)
+	t.Setenv("VAULT_TOKEN", "root")
+	t.Setenv("BSCP_DB_PORT", "3306")
+	t.Setenv("BSCP_DB_HOST", "127.0.0.1")
+
+	conf := []byte("database:\n  port: ${env:BSCP_DB_PORT}\n  endpoint: ${env:BSCP_DB_HOST}:${env:BSCP_DB_PORT}\n" +
+		"  password: ${vault:secret/data/bscp#password}\n")
+	resolved, err := resolveSecrets(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := new(struct {
+		Database struct {
+			Port     uint   `yaml:"port"`
+			Endpoint string `yaml:"endpoint"`
+			Password string `yaml:"password"`
+		} `yaml:"database"`
+	})
+	if err := yaml.Unmarshal(resolved, s); err != nil {
+		t.Fatal(err)
+	}
+	if s.Database.Port != 3306 || s.Database.Endpoint != "127.0.0.1:3306" || s.Database.Password != "0123" {
+		t.Errorf("unexpected resolved setting: %+v", s.Database)
+	}
+
+	if _, err := resolveSecrets([]byte("password: ${env:BSCP_NOT_EXIST}")); err == nil {
+		t.Errorf("resolve not exist env should be failed")
+	}
+}
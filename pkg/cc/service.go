@@ -14,6 +14,7 @@ package cc
 
 import (
 	"errors"
+	"fmt"
 	"net"
 	"sync"
 )
@@ -61,8 +62,23 @@ const (
 	VaultSidecarName Name = "vault-sidecar"
 	// UIName is the ui service name
 	UIName Name = "ui"
+	// AllInOneName is the name of the all-in-one deployment, which runs more than one service in the process.
+	AllInOneName Name = "all-in-one"
 )
 
+// knownServiceNames is the services which can have a section in the single configuration file.
+var knownServiceNames = map[Name]bool{
+	APIServerName:    true,
+	DataServiceName:  true,
+	CacheServiceName: true,
+	ConfigServerName: true,
+	FeedServerName:   true,
+	FeedProxyName:    true,
+	AuthServerName:   true,
+	VaultServerName:  true,
+	VaultSidecarName: true,
+}
+
 // Setting defines all service Setting interface.
 type Setting interface {
 	trySetFlagBindIP(ip net.IP) error
@@ -141,6 +157,12 @@ type LoginAuthSettings struct {
 	InnerHost string `yaml:"innerHost"`
 	Provider  string `yaml:"provider"`
 	UseESB    bool   `yaml:"useEsb"`
+	// OIDC provider 为 OIDC 时的登入配置
+	OIDC OIDCAuth `yaml:"oidc"`
+	// LDAP provider 为 LDAP 时的登入配置
+	LDAP LDAPAuth `yaml:"ldap"`
+	// Session OIDC/LDAP 登入后签发的会话配置
+	Session LoginSession `yaml:"session"`
 }
 
 // ApiGateway gateway conf
@@ -167,6 +189,7 @@ func (s *AuthServerSetting) trySetDefault() {
 	s.Network.trySetDefault()
 	s.Service.trySetDefault()
 	s.Log.trySetDefault()
+	s.LoginAuth.trySetDefault()
 }
 
 // Validate AuthServerSetting option.
@@ -180,6 +203,10 @@ func (s AuthServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.LoginAuth.validate(); err != nil {
+		return err
+	}
+
 	if err := s.IAM.validate(); err != nil {
 		return err
 	}
@@ -306,14 +333,21 @@ type DataServiceSetting struct {
 	Service Service   `yaml:"service"`
 	Log     LogOption `yaml:"log"`
 
-	Credential   Credential   `yaml:"credential"`
-	Sharding     Sharding     `yaml:"sharding"`
-	Esb          Esb          `yaml:"esb"`
-	Repo         Repository   `yaml:"repository"`
-	Vault        Vault        `yaml:"vault"`
-	FeatureFlags FeatureFlags `yaml:"featureFlags"`
-	Gorm         Gorm         `yaml:"gorm"`
-	ITSM         ITSMConfig   `yaml:"itsm"`
+	Credential   Credential     `yaml:"credential"`
+	Sharding     Sharding       `yaml:"sharding"`
+	Esb          Esb            `yaml:"esb"`
+	Repo         Repository     `yaml:"repository"`
+	Vault        Vault          `yaml:"vault"`
+	FeatureFlags FeatureFlags   `yaml:"featureFlags"`
+	Gorm         Gorm           `yaml:"gorm"`
+	ITSM         ITSMConfig     `yaml:"itsm"`
+	ChangeTicket ChangeTicket   `yaml:"changeTicket"`
+	Notification Notification   `yaml:"notification"`
+	RecycleBin   RecycleBin     `yaml:"recycleBin"`
+	FullText     FullText       `yaml:"fullText"`
+	Certificate  Certificate    `yaml:"certificate"`
+	Stale        StaleDetection `yaml:"staleDetection"`
+	Migration    Migration      `yaml:"migration"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -336,6 +370,13 @@ func (s *DataServiceSetting) trySetDefault() {
 	s.Vault.getConfigFromEnv()
 	s.FeatureFlags.trySetDefault()
 	s.Gorm.trySetDefault()
+	s.ChangeTicket.trySetDefault()
+	s.Notification.trySetDefault()
+	s.RecycleBin.trySetDefault()
+	s.FullText.trySetDefault()
+	s.Certificate.trySetDefault()
+	s.Stale.trySetDefault()
+	s.Migration.trySetDefault()
 }
 
 // Validate DataServiceSetting option.
@@ -373,6 +414,30 @@ func (s DataServiceSetting) Validate() error {
 		return err
 	}
 
+	if err := s.ChangeTicket.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Notification.validate(); err != nil {
+		return err
+	}
+
+	if err := s.RecycleBin.validate(); err != nil {
+		return err
+	}
+
+	if err := s.FullText.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Certificate.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Stale.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -389,9 +454,17 @@ type FeedServerSetting struct {
 	RedisCluster RedisCluster        `yaml:"redisCluster"`
 	FSLocalCache FSLocalCache        `yaml:"fsLocalCache"`
 	Downstream   Downstream          `yaml:"downstream"`
+	Upstream     UpstreamResilience  `yaml:"upstream"`
 	MRLimiter    MatchReleaseLimiter `yaml:"matchReleaseLimiter"`
 	RateLimiter  RateLimiter         `yaml:"rateLimiter"`
+	ConcLimiter  ConcurrencyLimiter  `yaml:"concurrencyLimiter"`
+	Lanes        PriorityLanes       `yaml:"priorityLanes"`
+	Shadowing    Shadowing           `yaml:"shadowing"`
+	BinaryPatch  BinaryPatch         `yaml:"binaryPatch"`
 	Metric       Metric              `yaml:"metrics"`
+	GrpcWeb      GrpcWeb             `yaml:"grpcWeb"`
+	UnixSocket   UnixSocket          `yaml:"unixSocket"`
+	Deprecations []Deprecation       `yaml:"deprecations"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -411,11 +484,20 @@ func (s *FeedServerSetting) trySetDefault() {
 	s.Log.trySetDefault()
 	s.FSLocalCache.trySetDefault()
 	s.Downstream.trySetDefault()
+	s.Upstream.trySetDefault()
+	// 优先从所在地域的存储副本下载
+	s.Repository.Region = s.Service.Locality.Region
 	s.GSE.getFromEnv()
 	s.GSE.trySetDefault()
 	s.RedisCluster.trySetDefault()
 	s.MRLimiter.trySetDefault()
 	s.RateLimiter.trySetDefault()
+	s.ConcLimiter.trySetDefault()
+	s.Lanes.trySetDefault()
+	s.Shadowing.trySetDefault()
+	s.BinaryPatch.trySetDefault()
+	s.GrpcWeb.trySetDefault()
+	s.UnixSocket.trySetDefault()
 }
 
 // Validate FeedServerSetting option.
@@ -441,6 +523,10 @@ func (s FeedServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.Upstream.validate(); err != nil {
+		return err
+	}
+
 	if err := s.MRLimiter.validate(); err != nil {
 		return err
 	}
@@ -449,6 +535,22 @@ func (s FeedServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.ConcLimiter.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Lanes.validate(); err != nil {
+		return err
+	}
+
+	if err := s.Shadowing.validate(); err != nil {
+		return err
+	}
+
+	if err := s.BinaryPatch.validate(); err != nil {
+		return err
+	}
+
 	if err := s.Esb.validate(); err != nil {
 		return err
 	}
@@ -461,6 +563,20 @@ func (s FeedServerSetting) Validate() error {
 		return err
 	}
 
+	if err := s.GrpcWeb.validate(); err != nil {
+		return err
+	}
+
+	if err := s.UnixSocket.validate(); err != nil {
+		return err
+	}
+
+	for _, one := range s.Deprecations {
+		if err := one.validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -469,7 +585,10 @@ type FeedProxySetting struct {
 	Network Network   `yaml:"network"`
 	Log     LogOption `yaml:"log"`
 
-	Upstream Upstream `yaml:"upstream"`
+	Upstream    Upstream        `yaml:"upstream"`
+	RateLimiter TenantLimiter   `yaml:"rateLimiter"`
+	Downstream  ProxyDownstream `yaml:"downstream"`
+	UnixSocket  UnixSocket      `yaml:"unixSocket"`
 }
 
 // trySetFlagBindIP try set flag bind ip.
@@ -487,6 +606,9 @@ func (s *FeedProxySetting) trySetDefault() {
 	s.Network.trySetDefault()
 	s.Log.trySetDefault()
 	s.Upstream.trySetDefault()
+	s.RateLimiter.trySetDefault()
+	s.Downstream.trySetDefault()
+	s.UnixSocket.trySetDefault()
 }
 
 // Validate FeedProxySetting option.
@@ -500,6 +622,14 @@ func (s FeedProxySetting) Validate() error {
 		return err
 	}
 
+	if err := s.RateLimiter.validate(); err != nil {
+		return err
+	}
+
+	if err := s.UnixSocket.validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -509,12 +639,16 @@ type Upstream struct {
 	BkRepoHost     string      `yaml:"bkRepoHost"`
 	CosHost        string      `yaml:"cosHost"`
 	StorageType    StorageMode `yaml:"storageType"`
+	// TLS is the mTLS options to connect to the feed server.
+	TLS  TLSConfig    `yaml:"tls"`
+	Pool UpstreamPool `yaml:"pool"`
 }
 
 func (u *Upstream) trySetDefault() {
 	if u.StorageType == "" {
 		u.StorageType = BkRepo
 	}
+	u.Pool.trySetDefault()
 }
 
 func (u *Upstream) validate() error {
@@ -533,7 +667,12 @@ func (u *Upstream) validate() error {
 	default:
 		return errors.New("invalid storageType")
 	}
-	return nil
+
+	if err := u.TLS.validate(); err != nil {
+		return fmt.Errorf("upstream tls, %v", err)
+	}
+
+	return u.Pool.validate()
 }
 
 // VaultServerSetting defines cache service used setting options.
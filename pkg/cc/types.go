@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -143,6 +144,17 @@ func (f *FeatureFlags) trySetDefault() {
 // Service defines Setting related runtime.
 type Service struct {
 	Etcd Etcd `yaml:"etcd"`
+	// Standalone is the service discovery without etcd, it's used by the all-in-one deployment.
+	Standalone Standalone `yaml:"standalone"`
+	// Locality is the region and zone of the service instance.
+	Locality Locality `yaml:"locality"`
+}
+
+// Locality is the region and zone of a service instance, the instances in the same zone or region are
+// preferred when the upstream services are discovered.
+type Locality struct {
+	Region string `yaml:"region"`
+	Zone   string `yaml:"zone" validate:"with=Region"`
 }
 
 // trySetDefault set the Setting default value if user not configured.
@@ -152,6 +164,10 @@ func (s *Service) trySetDefault() {
 
 // validate Setting related runtime.
 func (s Service) validate() error {
+	if s.Standalone.Enabled {
+		return s.Standalone.validate()
+	}
+
 	if err := s.Etcd.validate(); err != nil {
 		return err
 	}
@@ -159,6 +175,25 @@ func (s Service) validate() error {
 	return nil
 }
 
+// Standalone defines the standalone service discovery, all the services run on the same host and are found
+// with the static addresses, the instance is always the master and the leader.
+type Standalone struct {
+	Enabled bool `yaml:"enabled"`
+	// Addresses is the grpc address of the services, key is the service name, e.g. data-service: 127.0.0.1:9510
+	Addresses map[Name]string `yaml:"addresses"`
+}
+
+// validate standalone runtime.
+func (sa Standalone) validate() error {
+	for name, addr := range sa.Addresses {
+		if err := tools.ValidateEndpoint(addr); err != nil {
+			return fmt.Errorf("standalone service %s address, %v", name, err)
+		}
+	}
+
+	return nil
+}
+
 // Etcd defines etcd related runtime
 type Etcd struct {
 	// Endpoints is a list of URLs.
@@ -176,7 +211,7 @@ type Etcd struct {
 // trySetDefault set the etcd default value if user not configured.
 func (es *Etcd) trySetDefault() {
 	if len(es.Endpoints) == 0 {
-		es.Endpoints = []string{"127.0.0.1:2379"}
+		es.Endpoints = []string{net.JoinHostPort(tools.LoopbackIP(), "2379")}
 	}
 
 	if es.DialTimeoutMS == 0 {
@@ -223,6 +258,12 @@ func (es Etcd) validate() error {
 		return errors.New("etcd endpoints is not set")
 	}
 
+	for _, one := range es.Endpoints {
+		if err := tools.ValidateURLEndpoint(one); err != nil {
+			return fmt.Errorf("etcd endpoints, %v", err)
+		}
+	}
+
 	if err := es.TLS.validate(); err != nil {
 		return fmt.Errorf("etcd tls, %v", err)
 	}
@@ -238,12 +279,12 @@ type RedisCluster struct {
 	Username string `yaml:"username"`
 	// Password is a password for authentication.
 	Password       string `yaml:"password"`
-	DialTimeoutMS  uint   `yaml:"dialTimeoutMS"`
-	ReadTimeoutMS  uint   `yaml:"readTimeoutMS"`
-	WriteTimeoutMS uint   `yaml:"writeTimeoutMS"`
+	DialTimeoutMS  uint   `yaml:"dialTimeoutMS" validate:"min=50,max=1000"`
+	ReadTimeoutMS  uint   `yaml:"readTimeoutMS" validate:"min=10,max=500"`
+	WriteTimeoutMS uint   `yaml:"writeTimeoutMS" validate:"min=10,max=500"`
 	MinIdleConn    uint   `yaml:"minIdleConn"`
-	DB             int    `yaml:"db"`
-	Mode           string `yaml:"mode"` // 支持集群或者单例模式 可选项 standalone,cluster
+	DB             int    `yaml:"db" validate:"min=0"`
+	Mode           string `yaml:"mode" validate:"oneof=standalone cluster"` // 支持集群或者单例模式 可选项 standalone,cluster
 	// PoolSize defines the connection pool size for
 	// each node of the redis cluster.
 	PoolSize uint      `yaml:"poolSize"`
@@ -257,7 +298,7 @@ type RedisCluster struct {
 // trySetDefault set the redis's default value if user not configured.
 func (rs *RedisCluster) trySetDefault() {
 	if len(rs.Endpoints) == 0 {
-		rs.Endpoints = []string{"127.0.0.1:6379"}
+		rs.Endpoints = []string{net.JoinHostPort(tools.LoopbackIP(), "6379")}
 	}
 
 	if rs.DialTimeoutMS == 0 {
@@ -292,16 +333,10 @@ func (rs RedisCluster) validate() error {
 		return errors.New("redis endpoints is not set")
 	}
 
-	if (rs.DialTimeoutMS > 0 && rs.DialTimeoutMS < 50) || rs.DialTimeoutMS > 1000 {
-		return errors.New("invalid redis dialTimeoutMS, should be in [50:1000]ms")
-	}
-
-	if (rs.ReadTimeoutMS > 0 && rs.ReadTimeoutMS < 10) || rs.ReadTimeoutMS > 500 {
-		return errors.New("invalid redis readTimeoutMS, should be in [10:500]ms")
-	}
-
-	if (rs.WriteTimeoutMS > 0 && rs.WriteTimeoutMS < 10) || rs.WriteTimeoutMS > 500 {
-		return errors.New("invalid redis writeTimeoutMS, should be in [10:500]ms")
+	for _, one := range rs.Endpoints {
+		if err := tools.ValidateEndpoint(one); err != nil {
+			return fmt.Errorf("redis endpoints, %v", err)
+		}
 	}
 
 	if err := rs.TLS.validate(); err != nil {
@@ -342,6 +377,126 @@ func (s IAM) validate() error {
 	return nil
 }
 
+const (
+	// OIDCLoginProvider 独立部署, 对接 OIDC 身份提供方登入
+	OIDCLoginProvider = "OIDC"
+	// LDAPLoginProvider 独立部署, 对接 LDAP 账号登入
+	LDAPLoginProvider = "LDAP"
+)
+
+// OIDCAuth defines the oidc authorization code flow settings.
+type OIDCAuth struct {
+	// AuthURL 授权地址, 如 https://idp.example.com/oauth2/authorize
+	AuthURL string `yaml:"authUrl"`
+	// TokenURL 换取 access token 的地址
+	TokenURL string `yaml:"tokenUrl"`
+	// UserInfoURL 获取用户信息的地址
+	UserInfoURL  string `yaml:"userInfoUrl"`
+	ClientID     string `yaml:"clientId"`
+	ClientSecret string `yaml:"clientSecret"`
+	// RedirectURL 回调地址, 需要指向 bscp ui 的 /login/callback
+	RedirectURL string   `yaml:"redirectUrl"`
+	Scopes      []string `yaml:"scopes"`
+	// UsernameClaim 用户信息中作为用户名的字段, 默认 preferred_username
+	UsernameClaim string `yaml:"usernameClaim"`
+}
+
+// validate oidc settings.
+func (o OIDCAuth) validate() error {
+	if o.AuthURL == "" || o.TokenURL == "" || o.UserInfoURL == "" {
+		return errors.New("oidc authUrl, tokenUrl and userInfoUrl are required")
+	}
+
+	if o.ClientID == "" || o.ClientSecret == "" {
+		return errors.New("oidc clientId and clientSecret are required")
+	}
+
+	if o.RedirectURL == "" {
+		return errors.New("oidc redirectUrl is required")
+	}
+
+	return nil
+}
+
+// LDAPAuth defines the ldap simple bind settings.
+type LDAPAuth struct {
+	// Addr ldap 服务地址, host:port
+	Addr string `yaml:"addr"`
+	// UseTLS 是否使用 ldaps
+	UseTLS bool      `yaml:"useTLS"`
+	TLS    TLSConfig `yaml:"tls"`
+	// UserDN 用户 DN 模版, 使用 %s 替换用户名, 如 uid=%s,ou=people,dc=example,dc=com
+	UserDN string `yaml:"userDN"`
+	// TimeoutSeconds 连接及读写超时, 默认 10s
+	TimeoutSeconds uint `yaml:"timeoutSeconds"`
+}
+
+// validate ldap settings.
+func (l LDAPAuth) validate() error {
+	if l.Addr == "" {
+		return errors.New("ldap addr is required")
+	}
+
+	if strings.Count(l.UserDN, "%s") != 1 {
+		return errors.New("ldap userDN should contain exactly one %s placeholder")
+	}
+
+	if err := l.TLS.validate(); err != nil {
+		return fmt.Errorf("ldap tls, %v", err)
+	}
+
+	return nil
+}
+
+// LoginSession defines the session issued after oidc/ldap login.
+type LoginSession struct {
+	// Secret 会话签名密钥, 至少 32 个字符
+	Secret string `yaml:"secret"`
+	// TTLHours 会话有效期, 默认 24 小时
+	TTLHours uint `yaml:"ttlHours"`
+}
+
+// trySetDefault set the login auth default value if user not configured.
+func (l *LoginAuthSettings) trySetDefault() {
+	if l.Session.TTLHours == 0 {
+		l.Session.TTLHours = 24
+	}
+
+	if l.OIDC.UsernameClaim == "" {
+		l.OIDC.UsernameClaim = "preferred_username"
+	}
+
+	if len(l.OIDC.Scopes) == 0 {
+		l.OIDC.Scopes = []string{"openid", "profile"}
+	}
+
+	if l.LDAP.TimeoutSeconds == 0 {
+		l.LDAP.TimeoutSeconds = 10
+	}
+}
+
+// validate login auth, only the standalone providers need extra settings.
+func (l LoginAuthSettings) validate() error {
+	switch l.Provider {
+	case OIDCLoginProvider:
+		if err := l.OIDC.validate(); err != nil {
+			return err
+		}
+	case LDAPLoginProvider:
+		if err := l.LDAP.validate(); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	if len(l.Session.Secret) < 32 {
+		return errors.New("login session secret should be at least 32 characters")
+	}
+
+	return nil
+}
+
 // StorageMode :
 type StorageMode string
 
@@ -359,6 +514,17 @@ type Repository struct {
 	EnableHA          bool         `yaml:"enableHA"`
 	SyncPeriodSeconds uint         `yaml:"syncPeriodSeconds"`
 	Slave             BaseRepo     `yaml:"slave"`
+	// Replicas is the per-region replicas of the repository, the uploaded files are replicated to them
+	// asynchronously, and the clients in the region download from the region's replica.
+	Replicas []RegionReplica `yaml:"replicas"`
+	// Region is the region of the current instance which is taken from the service locality.
+	Region string `yaml:"-"`
+}
+
+// RegionReplica is the repository replica of a region.
+type RegionReplica struct {
+	Region   string `yaml:"region"`
+	BaseRepo `yaml:",inline"`
 }
 
 // BaseRepo 文件存储的基础部分
@@ -366,6 +532,29 @@ type BaseRepo struct {
 	StorageType StorageMode   `yaml:"storageType"`
 	S3          S3Storage     `yaml:"s3"`
 	BkRepo      BkRepoStorage `yaml:"bkRepo"`
+	Proxy       RepoProxy     `yaml:"proxy"`
+}
+
+// RepoProxy 访问文件存储的出口代理及自定义 CA 证书
+type RepoProxy struct {
+	// URL is the http(s) proxy url, empty means the proxy is taken from the environment variables.
+	URL string `yaml:"url"`
+	// NoProxy is the comma separated hosts, domains and CIDRs which are accessed without the proxy.
+	NoProxy string `yaml:"noProxy"`
+	// CAFile is the PEM encoded CA bundle trusted besides the system root CAs.
+	CAFile string `yaml:"caFile" validate:"exclusive=InsecureSkipVerify"`
+	// InsecureSkipVerify skip verifying the repo's certificate, for testing only.
+	InsecureSkipVerify bool `yaml:"insecureSkipVerify"`
+}
+
+// ProxyConfig returns the proxy config of the repo's http transport.
+func (p RepoProxy) ProxyConfig() tools.ProxyConfig {
+	return tools.ProxyConfig{
+		ProxyURL:           p.URL,
+		NoProxy:            p.NoProxy,
+		CAFile:             p.CAFile,
+		InsecureSkipVerify: p.InsecureSkipVerify,
+	}
 }
 
 // BkRepoStorage BKRepo 存储类型
@@ -429,6 +618,11 @@ func (s *Repository) trySetDefault() {
 	if s.SyncPeriodSeconds == 0 || s.SyncPeriodSeconds < minSyncPeriodSeconds {
 		s.SyncPeriodSeconds = defaultSyncPeriodSeconds
 	}
+	for i := range s.Replicas {
+		if len(s.Replicas[i].StorageType) == 0 {
+			s.Replicas[i].StorageType = s.StorageType
+		}
+	}
 }
 
 // validate repo runtime.
@@ -447,6 +641,21 @@ func (s Repository) validate() error {
 		}
 	}
 
+	regions := make(map[string]struct{}, len(s.Replicas))
+	for _, one := range s.Replicas {
+		if len(one.Region) == 0 || strings.Contains(one.Region, "|") {
+			return fmt.Errorf("repository replica region %q is invalid", one.Region)
+		}
+		if _, ok := regions[one.Region]; ok {
+			return fmt.Errorf("repository replica region %s is duplicated", one.Region)
+		}
+		regions[one.Region] = struct{}{}
+
+		if err := one.BaseRepo.validate(); err != nil {
+			return fmt.Errorf("repository replica %s config err: %v", one.Region, err)
+		}
+	}
+
 	return nil
 }
 
@@ -473,6 +682,12 @@ func (b BaseRepo) validate() error {
 			return errors.New("bk_repo endpoints is not set")
 		}
 
+		for _, one := range b.BkRepo.Endpoints {
+			if err := tools.ValidateURLEndpoint(one); err != nil {
+				return fmt.Errorf("bk_repo endpoints, %v", err)
+			}
+		}
+
 		if len(b.BkRepo.Username) == 0 {
 			return errors.New("repo basic auth username is not set")
 		}
@@ -492,6 +707,10 @@ func (b BaseRepo) validate() error {
 		return fmt.Errorf("unsupported storage type: %s", string(b.StorageType))
 	}
 
+	if err := b.Proxy.ProxyConfig().Validate(); err != nil {
+		return fmt.Errorf("repo proxy, %v", err)
+	}
+
 	return nil
 }
 
@@ -586,6 +805,9 @@ type FeatureFlagOption struct {
 
 // Database defines database related runtime.
 type Database struct {
+	// Driver is the database type, mysql, postgres or sqlite, default is mysql.
+	// for sqlite, Database is the path of the database file and Endpoints is not used.
+	Driver string `yaml:"driver"`
 	// Endpoints is a seed list of host:port addresses of database nodes.
 	Endpoints []string `yaml:"endpoints"`
 	Database  string   `yaml:"database"`
@@ -595,19 +817,46 @@ type Database struct {
 	// response from the db server
 	// all the timeout default value reference:
 	// https://dev.mysql.com/doc/refman/8.0/en/server-system-variables.html
-	DialTimeoutSec    uint      `yaml:"dialTimeoutSec"`
-	ReadTimeoutSec    uint      `yaml:"readTimeoutSec"`
-	WriteTimeoutSec   uint      `yaml:"writeTimeoutSec"`
+	DialTimeoutSec    uint      `yaml:"dialTimeoutSec" validate:"min=1,max=60"`
+	ReadTimeoutSec    uint      `yaml:"readTimeoutSec" validate:"min=1,max=60"`
+	WriteTimeoutSec   uint      `yaml:"writeTimeoutSec" validate:"min=1,max=30"`
 	MaxIdleTimeoutMin uint      `yaml:"maxIdleTimeoutMin"`
 	MaxOpenConn       uint      `yaml:"maxOpenConn"`
 	MaxIdleConn       uint      `yaml:"maxIdleConn"`
 	TLS               TLSConfig `yaml:"tls"`
 }
 
+const (
+	// MySQLDriver is the mysql database driver.
+	MySQLDriver = "mysql"
+	// PostgresDriver is the postgres database driver.
+	PostgresDriver = "postgres"
+	// SQLiteDriver is the sqlite database driver, it's used by the all-in-one deployment.
+	SQLiteDriver = "sqlite"
+)
+
+// IsPostgres test if the database is postgres.
+func (ds Database) IsPostgres() bool {
+	return ds.Driver == PostgresDriver
+}
+
+// IsSQLite test if the database is sqlite.
+func (ds Database) IsSQLite() bool {
+	return ds.Driver == SQLiteDriver
+}
+
 // trySetDefault set the database's default value if user not configured.
 func (ds *Database) trySetDefault() {
-	if len(ds.Endpoints) == 0 {
-		ds.Endpoints = []string{"127.0.0.1:3306"}
+	if ds.Driver == "" {
+		ds.Driver = MySQLDriver
+	}
+
+	if len(ds.Endpoints) == 0 && ds.Driver != SQLiteDriver {
+		port := "3306"
+		if ds.Driver == PostgresDriver {
+			port = "5432"
+		}
+		ds.Endpoints = []string{net.JoinHostPort(tools.LoopbackIP(), port)}
 	}
 
 	if ds.DialTimeoutSec == 0 {
@@ -637,24 +886,34 @@ func (ds *Database) trySetDefault() {
 
 // validate database runtime.
 func (ds Database) validate() error {
-	if len(ds.Endpoints) == 0 {
-		return errors.New("database endpoints is not set")
+	switch ds.Driver {
+	case MySQLDriver, PostgresDriver:
+	case SQLiteDriver:
+		if len(ds.Database) == 0 {
+			return errors.New("sqlite database file is not set")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported database driver %s, should be %s, %s or %s", ds.Driver, MySQLDriver,
+			PostgresDriver, SQLiteDriver)
 	}
 
-	if len(ds.Database) == 0 {
-		return errors.New("database is not set")
+	if ds.Driver == PostgresDriver && len(ds.Endpoints) > 1 {
+		return errors.New("postgres database only supports one endpoint")
 	}
 
-	if (ds.DialTimeoutSec > 0 && ds.DialTimeoutSec < 1) || ds.DialTimeoutSec > 60 {
-		return errors.New("invalid database dialTimeoutMS, should be in [1:60]s")
+	if len(ds.Endpoints) == 0 {
+		return errors.New("database endpoints is not set")
 	}
 
-	if (ds.ReadTimeoutSec > 0 && ds.ReadTimeoutSec < 1) || ds.ReadTimeoutSec > 60 {
-		return errors.New("invalid database readTimeoutMS, should be in [1:60]s")
+	for _, one := range ds.Endpoints {
+		if err := tools.ValidateEndpoint(one); err != nil {
+			return fmt.Errorf("database endpoints, %v", err)
+		}
 	}
 
-	if (ds.WriteTimeoutSec > 0 && ds.WriteTimeoutSec < 1) || ds.WriteTimeoutSec > 30 {
-		return errors.New("invalid database writeTimeoutMS, should be in [1:30]s")
+	if len(ds.Database) == 0 {
+		return errors.New("database is not set")
 	}
 
 	if err := ds.TLS.validate(); err != nil {
@@ -678,6 +937,9 @@ type LogOption struct {
 	// at the same time.
 	AlsoToStdErr bool `yaml:"alsoToStdErr"`
 	Verbosity    uint `yaml:"verbosity"`
+	// SlowRequestMS request cost more than it is logged as a slow request with the downstream cost
+	// breakdown, default 1000ms, -1 disables the slow request log.
+	SlowRequestMS int `yaml:"slowRequestMS"`
 }
 
 // trySetDefault set the log's default value if user not configured.
@@ -698,6 +960,9 @@ func (log *LogOption) trySetDefault() {
 		log.MaxFileNum = 5
 	}
 
+	if log.SlowRequestMS == 0 {
+		log.SlowRequestMS = 1000
+	}
 }
 
 // Logs convert it to logs.LogConfig.
@@ -713,6 +978,12 @@ func (log LogOption) Logs() logs.LogConfig {
 		Verbosity:          log.Verbosity,
 	}
 
+	if log.SlowRequestMS < 0 {
+		l.SlowRequestThreshold = -1
+	} else {
+		l.SlowRequestThreshold = time.Duration(log.SlowRequestMS) * time.Millisecond
+	}
+
 	return l
 }
 
@@ -721,16 +992,22 @@ type Network struct {
 	// BindIP is the advertised IP address for service discovery.
 	// if in ipv4 single stack mode, the BindIP would be ipv4 address.
 	// if in ipv6 single stack mode, the BindIP would be ipv6 address.
-	BindIP string `yaml:"bindIP"`
+	BindIP string `yaml:"bindIP" validate:"required,ip"`
 	// BindIPs is the advertised IP address for service expose.
-	BindIPs []string `yaml:"bindIPs"`
+	BindIPs []string `yaml:"bindIPs" validate:"ip"`
 	// RpcPort is port where server listen to rpc port.
-	RpcPort uint `yaml:"rpcPort"`
+	RpcPort uint `yaml:"rpcPort" validate:"port"`
 	// HttpPort is port where server listen to http port.
-	HttpPort uint `yaml:"httpPort"`
+	HttpPort uint `yaml:"httpPort" validate:"port"`
 	// GwHttpPort  is port where server listen to grpc-gateway http port.
-	GwHttpPort uint      `yaml:"gwHttpPort"`
+	GwHttpPort uint      `yaml:"gwHttpPort" validate:"port"`
 	TLS        TLSConfig `yaml:"tls"`
+	// CtlToken is the token to access the control and debug endpoints, the control endpoint is not authenticated
+	// and the debug endpoints and commands are disabled if not set.
+	CtlToken string `yaml:"ctlToken"`
+	// CtlProfileDir is the directory to save the profiles captured by the debug commands, system temp directory
+	// is used if not set.
+	CtlProfileDir string `yaml:"ctlProfileDir"`
 }
 
 // trySetFlagBindIP try set flag bind ip, bindIP only can set by one of the flag or configuration file.
@@ -766,7 +1043,7 @@ func (n *Network) trySetDefault() {
 		if ip != "" {
 			n.BindIP = ip
 		} else {
-			n.BindIP = "127.0.0.1"
+			n.BindIP = tools.LoopbackIP()
 		}
 	}
 	if len(n.BindIPs) == 0 {
@@ -783,21 +1060,6 @@ func (n *Network) trySetDefault() {
 
 // validate network options
 func (n Network) validate() error {
-
-	if len(n.BindIP) == 0 {
-		return errors.New("network bindIP is not set")
-	}
-
-	if net.ParseIP(n.BindIP) == nil {
-		return fmt.Errorf("invalid network bindIP: %s", n.BindIP)
-	}
-
-	for _, ip := range n.BindIPs {
-		if net.ParseIP(ip) == nil {
-			return fmt.Errorf("invalid network bindIPs: %s", ip)
-		}
-	}
-
 	if err := n.TLS.validate(); err != nil {
 		return fmt.Errorf("network tls, %v", err)
 	}
@@ -811,11 +1073,11 @@ type TLSConfig struct {
 	// For testing only.
 	InsecureSkipVerify bool `yaml:"insecureSkipVerify"`
 	// Server requires TLS client certificate authentication
-	CertFile string `yaml:"certFile"`
+	CertFile string `yaml:"certFile" validate:"with=KeyFile CAFile"`
 	// Server requires TLS client certificate authentication
-	KeyFile string `yaml:"keyFile"`
+	KeyFile string `yaml:"keyFile" validate:"with=CertFile CAFile"`
 	// Trusted root certificates for server
-	CAFile string `yaml:"caFile"`
+	CAFile string `yaml:"caFile" validate:"with=CertFile KeyFile"`
 	// the password to decrypt the certificate
 	Password string `yaml:"password"`
 }
@@ -862,6 +1124,18 @@ type SysOption struct {
 	GRPCPort int
 	// Versioned Setting if show current version info.
 	Versioned bool
+	// Service is the service whose settings are loaded, it's the current service if not set. it's set by the
+	// all-in-one deployment, which runs more than one service in the process.
+	Service Name
+}
+
+// service returns the service whose settings are loaded with the option.
+func (s SysOption) service() Name {
+	if len(s.Service) != 0 {
+		return s.Service
+	}
+
+	return ServiceName()
 }
 
 // CheckV check if show current version info.
@@ -1075,6 +1349,9 @@ type Downstream struct {
 	// sidecars, which are connnected to one feed server, when new app releases are published. The larger of it,
 	// the more CPU and Mem will be costed.the minimum notifyMaxLimit is 10, the default notifyMaxLimit is 50.
 	NotifyMaxLimit uint `yaml:"notifyMaxLimit"`
+	// ZombieTimeoutSec 客户端在该时间内没有上报任何心跳时, 其 watch 连接会被视为僵尸连接并主动断开, 单位为秒,
+	// 最小值为 30, 默认为 120. 仅对在当前 feed server 上报过心跳的连接生效.
+	ZombieTimeoutSec uint `yaml:"zombieTimeoutSec"`
 }
 
 // validate if the feed server's release service runtime is valid or not.
@@ -1091,6 +1368,10 @@ func (f Downstream) validate() error {
 		return errors.New("invalid downstream.notifyMaxLimit value, should >= 10")
 	}
 
+	if f.ZombieTimeoutSec < 30 && f.ZombieTimeoutSec != 0 {
+		return errors.New("invalid downstream.zombieTimeoutSec value, should >= 30")
+	}
+
 	return nil
 }
 
@@ -1103,6 +1384,58 @@ func (f *Downstream) trySetDefault() {
 	if f.NotifyMaxLimit == 0 {
 		f.NotifyMaxLimit = 50
 	}
+
+	if f.ZombieTimeoutSec == 0 {
+		f.ZombieTimeoutSec = 120
+	}
+}
+
+// UpstreamResilience defines the feed server's deadline, circuit breaker and retry budget of the calls to the
+// upstream services, such as cache service and auth server.
+type UpstreamResilience struct {
+	// TimeoutMS is the default timeout of the calls which have no deadline, unit is millisecond, default is 5000.
+	TimeoutMS uint `yaml:"timeoutMS"`
+	// FailureThreshold is the consecutive failures of a method to open its circuit breaker, default is 20.
+	FailureThreshold uint `yaml:"failureThreshold"`
+	// OpenSeconds is how long the circuit breaker keeps open before a probe call is allowed, default is 5.
+	OpenSeconds uint `yaml:"openSeconds"`
+	// HedgeDelayMS is the delay to send a hedged call when an idempotent read call is not responded, unit
+	// is millisecond, 0 means no hedged call, the read call is only retried when the upstream is unavailable.
+	HedgeDelayMS uint `yaml:"hedgeDelayMS"`
+	// RetryBudgetPercent is the max percent of the retried and hedged calls to the calls, default is 10.
+	RetryBudgetPercent uint `yaml:"retryBudgetPercent"`
+}
+
+// validate if the upstream resilience options are valid or not.
+func (u UpstreamResilience) validate() error {
+	if u.RetryBudgetPercent > 100 {
+		return errors.New("invalid upstream.retryBudgetPercent value, should <= 100")
+	}
+
+	if u.HedgeDelayMS != 0 && u.HedgeDelayMS >= u.TimeoutMS {
+		return errors.New("invalid upstream.hedgeDelayMS value, should < upstream.timeoutMS")
+	}
+
+	return nil
+}
+
+// trySetDefault try set the upstream resilience default options if it's not set by user.
+func (u *UpstreamResilience) trySetDefault() {
+	if u.TimeoutMS == 0 {
+		u.TimeoutMS = 5000
+	}
+
+	if u.FailureThreshold == 0 {
+		u.FailureThreshold = 20
+	}
+
+	if u.OpenSeconds == 0 {
+		u.OpenSeconds = 5
+	}
+
+	if u.RetryBudgetPercent == 0 {
+		u.RetryBudgetPercent = 10
+	}
 }
 
 // MatchReleaseLimiter defines the request limit options for match release.
@@ -1157,6 +1490,265 @@ type RateLimiter struct {
 	IP              BasicRL `yaml:"ip"`
 }
 
+// ConcurrencyLimiter defines the adaptive concurrency limiter of the feed server, it sheds the requests
+// exceeding the concurrency limit which is adjusted by the latency, in addition to the rate limiter.
+type ConcurrencyLimiter struct {
+	Enable bool `yaml:"enabled"`
+	// InitialLimit is the initial concurrency limit, default is 200.
+	InitialLimit uint `yaml:"initialLimit"`
+	// MinLimit and MaxLimit bound the concurrency limit, default is 20 and 2000.
+	MinLimit uint `yaml:"minLimit"`
+	MaxLimit uint `yaml:"maxLimit"`
+	// TolerancePercent is the tolerated percent of the recent latency to the long term latency before the
+	// limit is decreased, default is 150.
+	TolerancePercent uint `yaml:"tolerancePercent"`
+	// RetryAfterMS is the base retry after hint of the shed requests, a jitter up to it is added, default is 1000.
+	RetryAfterMS uint `yaml:"retryAfterMS"`
+}
+
+// trySetDefault try set the concurrency limiter's default options if it's not set by user.
+func (c *ConcurrencyLimiter) trySetDefault() {
+	if c.InitialLimit == 0 {
+		c.InitialLimit = 200
+	}
+
+	if c.MinLimit == 0 {
+		c.MinLimit = 20
+	}
+
+	if c.MaxLimit == 0 {
+		c.MaxLimit = 2000
+	}
+
+	if c.TolerancePercent == 0 {
+		c.TolerancePercent = 150
+	}
+
+	if c.RetryAfterMS == 0 {
+		c.RetryAfterMS = 1000
+	}
+}
+
+// validate if the concurrency limiter options are valid or not.
+func (c ConcurrencyLimiter) validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if c.MinLimit > c.MaxLimit {
+		return errors.New("invalid concurrencyLimiter.minLimit value, should <= maxLimit")
+	}
+
+	if c.InitialLimit < c.MinLimit || c.InitialLimit > c.MaxLimit {
+		return errors.New("invalid concurrencyLimiter.initialLimit value, should be in [minLimit, maxLimit]")
+	}
+
+	if c.TolerancePercent < 100 {
+		return errors.New("invalid concurrencyLimiter.tolerancePercent value, should >= 100")
+	}
+
+	return nil
+}
+
+// Shadowing defines the options to mirror a percentage of the read only feed traffic (handshake, pull meta) to
+// a staging feed-server cluster and compare the responses, so a new version can be validated with production
+// traffic. the shadow requests are sent asynchronously and never affect the response to the clients.
+type Shadowing struct {
+	Enable bool `yaml:"enabled"`
+	// Address is the grpc address of the staging feed-server cluster, e.g. a load balancer or dns name.
+	Address string `yaml:"address"`
+	// Percent is the percentage of the read only requests to be mirrored, in [1, 100].
+	Percent uint `yaml:"percent" validate:"max=100"`
+	// TimeoutMS is the timeout of a shadow request, default is 3000.
+	TimeoutMS uint `yaml:"timeoutMS"`
+	// MaxInflight is the max number of the in flight shadow requests, requests beyond it are not mirrored,
+	// default is 100.
+	MaxInflight uint `yaml:"maxInflight"`
+	// IgnoreFields is the names of the response fields ignored when comparing, such as the ones contain
+	// timestamps or signed urls.
+	IgnoreFields []string `yaml:"ignoreFields"`
+	// Token is the credential token of the staging cluster, it replaces the client's credential in the shadow
+	// requests, so the production credentials are never sent to the staging cluster.
+	Token string `yaml:"token"`
+	// TLS is required, because the shadow requests carry the token and the clients' metadata.
+	TLS TLSConfig `yaml:"tls"`
+}
+
+// trySetDefault try set the shadowing's default options if it's not set by user.
+func (s *Shadowing) trySetDefault() {
+	if s.TimeoutMS == 0 {
+		s.TimeoutMS = 3000
+	}
+
+	if s.MaxInflight == 0 {
+		s.MaxInflight = 100
+	}
+}
+
+// validate if the shadowing options are valid or not.
+func (s Shadowing) validate() error {
+	if !s.Enable {
+		return nil
+	}
+
+	if len(s.Address) == 0 {
+		return errors.New("shadowing.address is not set")
+	}
+
+	if s.Percent == 0 {
+		return errors.New("invalid shadowing.percent value, should be in [1, 100]")
+	}
+
+	if len(s.Token) == 0 {
+		return errors.New("shadowing.token is not set")
+	}
+
+	if !s.TLS.Enable() {
+		return errors.New("shadowing.tls is not set, the shadow requests should be sent with tls")
+	}
+
+	if err := s.TLS.validate(); err != nil {
+		return fmt.Errorf("shadowing.tls, %v", err)
+	}
+
+	return nil
+}
+
+// BinaryPatch defines the options to deliver the binary patch between the consecutive releases of a large file,
+// the clients which already have the file of the base release download the patch instead of the full content.
+// the patches are generated lazily in background when they are requested at the first time.
+type BinaryPatch struct {
+	Enable bool `yaml:"enabled"`
+	// MinByteSize is the min byte size of the file which uses the binary patch, default is 1MB.
+	MinByteSize uint64 `yaml:"minByteSize"`
+	// MaxByteSize is the max byte size of the base and target file which uses the binary patch, the memory used
+	// to generate a patch is about 10 times of the file size, default is 64MB.
+	MaxByteSize uint64 `yaml:"maxByteSize"`
+	// MaxRatio is the max percentage of the patch size to the target file size, the patch beyond it is dropped
+	// and the clients download the full content, default is 50.
+	MaxRatio uint `yaml:"maxRatio" validate:"max=100"`
+	// Concurrency is the max number of the patches generated at the same time, default is 1.
+	Concurrency uint `yaml:"concurrency"`
+	// TTLSeconds is the retention time of the generated patch record, default is 7 days.
+	TTLSeconds uint `yaml:"ttlSeconds"`
+}
+
+// trySetDefault try set the binary patch's default options if it's not set by user.
+func (b *BinaryPatch) trySetDefault() {
+	if b.MinByteSize == 0 {
+		b.MinByteSize = 1 << 20
+	}
+
+	if b.MaxByteSize == 0 {
+		b.MaxByteSize = 64 << 20
+	}
+
+	if b.MaxRatio == 0 {
+		b.MaxRatio = 50
+	}
+
+	if b.Concurrency == 0 {
+		b.Concurrency = 1
+	}
+
+	if b.TTLSeconds == 0 {
+		b.TTLSeconds = 7 * 24 * 3600
+	}
+}
+
+// validate if the binary patch options are valid or not.
+func (b BinaryPatch) validate() error {
+	if !b.Enable {
+		return nil
+	}
+
+	if b.MinByteSize > b.MaxByteSize {
+		return errors.New("invalid binaryPatch.minByteSize value, should <= binaryPatch.maxByteSize")
+	}
+
+	if b.MaxRatio > 100 {
+		return errors.New("invalid binaryPatch.maxRatio value, should be in [1, 100]")
+	}
+
+	return nil
+}
+
+// PriorityLanes defines the priority lanes of the feed server, the requests are separated into the control
+// (heartbeat, messaging), watch (watch setup) and pull lanes, each lane has an independent concurrency limit,
+// so that a pull storm can not starve the heartbeats and cause the clients to be regarded as offline.
+type PriorityLanes struct {
+	Enable  bool      `yaml:"enabled"`
+	Control LaneLimit `yaml:"control"`
+	Watch   LaneLimit `yaml:"watch"`
+	Pull    LaneLimit `yaml:"pull"`
+	// RetryAfterMS is the retry after hint of the shed requests, default is 1000.
+	RetryAfterMS uint `yaml:"retryAfterMS"`
+}
+
+// LaneLimit defines the limit of a priority lane.
+type LaneLimit struct {
+	// MaxConcurrency is the max number of the requests processed concurrently in the lane.
+	MaxConcurrency uint `yaml:"maxConcurrency"`
+	// MaxQueue is the max number of the requests waiting for the concurrency in the lane.
+	MaxQueue uint `yaml:"maxQueue"`
+	// QueueTimeoutMS is the max time of a request waits in the queue, unit is millisecond.
+	QueueTimeoutMS uint `yaml:"queueTimeoutMS"`
+}
+
+// trySetDefault try set the priority lanes' default options if it's not set by user.
+func (p *PriorityLanes) trySetDefault() {
+	p.Control.trySetDefault(1000, 2000, 2000)
+	p.Watch.trySetDefault(200, 1000, 1000)
+	p.Pull.trySetDefault(500, 1000, 500)
+
+	if p.RetryAfterMS == 0 {
+		p.RetryAfterMS = 1000
+	}
+}
+
+// validate if the priority lanes options are valid or not.
+func (p PriorityLanes) validate() error {
+	if !p.Enable {
+		return nil
+	}
+
+	if err := p.Control.validate("control"); err != nil {
+		return err
+	}
+
+	if err := p.Watch.validate("watch"); err != nil {
+		return err
+	}
+
+	return p.Pull.validate("pull")
+}
+
+func (l *LaneLimit) trySetDefault(concurrency, queue, timeoutMS uint) {
+	if l.MaxConcurrency == 0 {
+		l.MaxConcurrency = concurrency
+	}
+
+	if l.MaxQueue == 0 {
+		l.MaxQueue = queue
+	}
+
+	if l.QueueTimeoutMS == 0 {
+		l.QueueTimeoutMS = timeoutMS
+	}
+}
+
+func (l LaneLimit) validate(name string) error {
+	if l.MaxConcurrency > 100000 {
+		return fmt.Errorf("invalid priorityLanes.%s.maxConcurrency value, should <= 100000", name)
+	}
+
+	if l.QueueTimeoutMS > 60000 {
+		return fmt.Errorf("invalid priorityLanes.%s.queueTimeoutMS value, should <= 60000", name)
+	}
+
+	return nil
+}
+
 // metrics 上报时过滤的业务名单
 type Metric struct {
 	BlacklistBizIDs []uint32 `yaml:"blacklistBizIds"`
@@ -1409,6 +2001,8 @@ func (g GSE) validate() error {
 // Gorm defines the grom related settings.
 type Gorm struct {
 	LogLevel GormLogLevel `yaml:"logLevel"`
+	// SlowThresholdMS is the threshold milliseconds of the slow query, default is 500.
+	SlowThresholdMS uint `yaml:"slowThresholdMS"`
 }
 
 // GormLogLevel is gorm log level type
@@ -1459,6 +2053,225 @@ func (g *Gorm) trySetDefault() {
 	if g.LogLevel == "" {
 		g.LogLevel = GormLogInfo
 	}
+
+	if g.SlowThresholdMS == 0 {
+		g.SlowThresholdMS = 500
+	}
+}
+
+// SlowThreshold returns the threshold of the slow query.
+func (g Gorm) SlowThreshold() time.Duration {
+	return time.Duration(g.SlowThresholdMS) * time.Millisecond
+}
+
+// DefaultRecycleBinRetentionDays is the default retention days of the deleted resources in recycle bin.
+const DefaultRecycleBinRetentionDays = 30
+
+// RecycleBin defines the recycle bin related settings.
+type RecycleBin struct {
+	// RetentionDays is how many days the deleted apps, config items and kvs can be restored,
+	// they are purged from recycle bin after that.
+	RetentionDays uint `yaml:"retentionDays"`
+}
+
+// validate if the recycle bin is valid or not.
+func (r RecycleBin) validate() error {
+	if r.RetentionDays > 365 {
+		return fmt.Errorf("recycle bin retention days %d should <= 365", r.RetentionDays)
+	}
+	return nil
+}
+
+// trySetDefault try set the default value of recycle bin
+func (r *RecycleBin) trySetDefault() {
+	if r.RetentionDays == 0 {
+		r.RetentionDays = DefaultRecycleBinRetentionDays
+	}
+}
+
+// FullTextEngine is the engine of full-text search.
+type FullTextEngine string
+
+const (
+	// MySQLFullText use the mysql fulltext index with ngram parser.
+	MySQLFullText FullTextEngine = "mysql"
+)
+
+// FullText defines the full-text search of config contents and kv values.
+type FullText struct {
+	// Enabled is whether to index the contents and kv values for full-text search.
+	Enabled bool `yaml:"enabled"`
+	// Engine is the search engine, only mysql is supported now.
+	Engine FullTextEngine `yaml:"engine"`
+	// IndexIntervalSec is the interval seconds of syncing the index with the unreleased configs.
+	IndexIntervalSec uint `yaml:"indexIntervalSec"`
+	// MaxContentKB is the max size of file content to be indexed, the larger ones only have name and memo indexed.
+	MaxContentKB uint `yaml:"maxContentKB"`
+}
+
+// validate if the full-text search is valid or not.
+func (f FullText) validate() error {
+	if !f.Enabled {
+		return nil
+	}
+
+	if f.Engine != MySQLFullText {
+		return fmt.Errorf("unsupported full-text search engine: %s", f.Engine)
+	}
+
+	if f.IndexIntervalSec < 60 {
+		return fmt.Errorf("full-text index interval seconds %d should >= 60", f.IndexIntervalSec)
+	}
+
+	if f.MaxContentKB > 10*1024 {
+		return fmt.Errorf("full-text max content size %dKB should <= 10240KB", f.MaxContentKB)
+	}
+
+	return nil
+}
+
+// trySetDefault try set the default value of full-text search
+func (f *FullText) trySetDefault() {
+	if f.Engine == "" {
+		f.Engine = MySQLFullText
+	}
+
+	if f.IndexIntervalSec == 0 {
+		f.IndexIntervalSec = 300
+	}
+
+	if f.MaxContentKB == 0 {
+		f.MaxContentKB = 1024
+	}
+}
+
+// Certificate defines the lifecycle management of the certificate config items and kvs.
+type Certificate struct {
+	// ScanIntervalSec is the interval seconds of scanning the unreleased configs for certificates.
+	ScanIntervalSec uint `yaml:"scanIntervalSec"`
+	// WarnDays is how many days before expiry the warning event is emitted.
+	WarnDays uint `yaml:"warnDays"`
+	// Renew defines the auto-renewal of the certificates by the internal CA.
+	Renew CertRenew `yaml:"renew"`
+}
+
+// CertRenew defines the auto-renewal of the certificates.
+type CertRenew struct {
+	// Enabled is whether to renew the certificates automatically.
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is the url of the internal CA (or ACME proxy) to issue the new certificate.
+	Endpoint string `yaml:"endpoint"`
+	// Token is the bearer token to request the CA.
+	Token string `yaml:"token"`
+	// BeforeDays is how many days before expiry the certificate is renewed.
+	BeforeDays uint `yaml:"beforeDays"`
+	// TimeoutSec is the timeout seconds of the request to the CA.
+	TimeoutSec uint `yaml:"timeoutSec"`
+}
+
+// validate if the certificate lifecycle is valid or not.
+func (c Certificate) validate() error {
+	if c.ScanIntervalSec < 60 {
+		return fmt.Errorf("certificate scan interval seconds %d should >= 60", c.ScanIntervalSec)
+	}
+
+	if !c.Renew.Enabled {
+		return nil
+	}
+
+	if len(c.Renew.Endpoint) == 0 {
+		return errors.New("certificate renew endpoint is not set")
+	}
+
+	if c.Renew.BeforeDays > c.WarnDays {
+		return fmt.Errorf("certificate renew before days %d should <= warn days %d", c.Renew.BeforeDays,
+			c.WarnDays)
+	}
+
+	return nil
+}
+
+// trySetDefault try set the default value of certificate lifecycle
+func (c *Certificate) trySetDefault() {
+	if c.ScanIntervalSec == 0 {
+		c.ScanIntervalSec = 3600
+	}
+
+	if c.WarnDays == 0 {
+		c.WarnDays = 30
+	}
+
+	if c.Renew.BeforeDays == 0 {
+		c.Renew.BeforeDays = 15
+	}
+
+	if c.Renew.TimeoutSec == 0 {
+		c.Renew.TimeoutSec = 30
+	}
+}
+
+// StaleDetection defines the detection of the apps, config items and credentials which are not
+// consumed for a long time.
+type StaleDetection struct {
+	// Enabled is whether to detect the stale resources periodically.
+	Enabled bool `yaml:"enabled"`
+	// ScanIntervalSec is the interval seconds of the detection.
+	ScanIntervalSec uint `yaml:"scanIntervalSec"`
+	// Days is how many days without consumption the resource is regarded as stale.
+	Days uint `yaml:"days"`
+	// AutoArchive is whether to archive the stale resources automatically after the owners are notified,
+	// the apps and config items are moved into recycle bin and the credentials are disabled.
+	AutoArchive bool `yaml:"autoArchive"`
+	// ArchiveAfterDays is how many days after the notification the stale resource is archived.
+	ArchiveAfterDays uint `yaml:"archiveAfterDays"`
+}
+
+// validate if the stale detection is valid or not.
+func (s StaleDetection) validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.ScanIntervalSec < 60 {
+		return fmt.Errorf("stale detection scan interval seconds %d should >= 60", s.ScanIntervalSec)
+	}
+
+	if s.Days < 7 {
+		return fmt.Errorf("stale detection days %d should >= 7", s.Days)
+	}
+
+	return nil
+}
+
+// trySetDefault try set the default value of stale detection
+func (s *StaleDetection) trySetDefault() {
+	if s.ScanIntervalSec == 0 {
+		s.ScanIntervalSec = 86400
+	}
+
+	if s.Days == 0 {
+		s.Days = 90
+	}
+
+	if s.ArchiveAfterDays == 0 {
+		s.ArchiveAfterDays = 14
+	}
+}
+
+// Migration defines the db schema migration related settings.
+type Migration struct {
+	// AutoMigrate is whether to run the pending up migrations when data service starts.
+	AutoMigrate bool `yaml:"autoMigrate"`
+	// LockTimeoutSec is the seconds to wait for the migration lock, so that only one instance
+	// runs the migrations at the same time.
+	LockTimeoutSec uint `yaml:"lockTimeoutSec"`
+}
+
+// trySetDefault try set the default value of migration
+func (m *Migration) trySetDefault() {
+	if m.LockTimeoutSec == 0 {
+		m.LockTimeoutSec = 600
+	}
 }
 
 // ITSMConfig itsm操作需要的配置
@@ -1469,3 +2282,308 @@ type ITSMConfig struct {
 	BscpGateway string `yaml:"bscpGateway" usage:"bscpGateway for itsm"`
 	BscpPageUrl string `yaml:"bscpPageUrl" usage:"bscpPageUrl for itsm"`
 }
+
+const (
+	// ITSMChangeTicketValidator validates the change ticket in BlueKing ITSM.
+	ITSMChangeTicketValidator = "itsm"
+	// NoneChangeTicketValidator only records the change ticket without validation.
+	NoneChangeTicketValidator = "none"
+)
+
+// ChangeTicket defines the change ticket linkage of the publishes, a publish must reference a change ticket
+// if it's enabled, which is used to satisfy the change-management compliance requirements.
+type ChangeTicket struct {
+	Enabled bool `yaml:"enabled"`
+	// Validator is the validator of the change ticket, itsm or none.
+	Validator string `yaml:"validator"`
+	// AllowedStatus is the ticket statuses which are allowed to publish.
+	AllowedStatus []string `yaml:"allowedStatus"`
+}
+
+// trySetDefault try set the default value of change ticket
+func (c *ChangeTicket) trySetDefault() {
+	if c.Validator == "" {
+		c.Validator = ITSMChangeTicketValidator
+	}
+
+	if len(c.AllowedStatus) == 0 {
+		c.AllowedStatus = []string{"RUNNING", "FINISHED"}
+	}
+}
+
+// validate change ticket options
+func (c ChangeTicket) validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	switch c.Validator {
+	case ITSMChangeTicketValidator, NoneChangeTicketValidator:
+	default:
+		return fmt.Errorf("unsupported change ticket validator: %s", c.Validator)
+	}
+
+	return nil
+}
+
+// Notification defines the notification channels of the publish and approval events.
+type Notification struct {
+	// TimeoutSec is the timeout seconds of sending one notification.
+	TimeoutSec uint `yaml:"timeoutSec"`
+	// SMTP is the smtp server which sends the email notifications.
+	SMTP SMTP `yaml:"smtp"`
+}
+
+// trySetDefault try set the default value of notification
+func (n *Notification) trySetDefault() {
+	if n.TimeoutSec == 0 {
+		n.TimeoutSec = 10
+	}
+
+	if n.SMTP.Port == 0 {
+		n.SMTP.Port = 25
+		if n.SMTP.TLS {
+			n.SMTP.Port = 465
+		}
+	}
+
+	if n.SMTP.From == "" {
+		n.SMTP.From = n.SMTP.Username
+	}
+}
+
+// validate notification options
+func (n Notification) validate() error {
+	if n.SMTP.Host != "" && n.SMTP.From == "" {
+		return errors.New("notification smtp from or username should be set")
+	}
+
+	return nil
+}
+
+// SMTP defines the smtp server options.
+type SMTP struct {
+	Host     string `yaml:"host"`
+	Port     uint   `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	From     string `yaml:"from"`
+	// TLS whether to connect the smtp server with implicit tls.
+	TLS bool `yaml:"tls"`
+}
+
+// Enabled returns whether the smtp server is configured.
+func (s SMTP) Enabled() bool {
+	return s.Host != ""
+}
+
+// UpstreamPool defines the feed proxy's grpc connection pool to the feed server, the edge client streams are
+// multiplexed over the connections in the pool.
+type UpstreamPool struct {
+	// MaxIdle is the max idle connections kept in the pool.
+	MaxIdle int `yaml:"maxIdle"`
+	// MaxActive is the max connections of the pool.
+	MaxActive int `yaml:"maxActive"`
+	// MaxConcurrentStreams is the max streams multiplexed on one connection.
+	MaxConcurrentStreams int `yaml:"maxConcurrentStreams"`
+}
+
+func (p *UpstreamPool) trySetDefault() {
+	if p.MaxIdle == 0 {
+		p.MaxIdle = 8
+	}
+
+	if p.MaxActive == 0 {
+		p.MaxActive = 64
+	}
+
+	if p.MaxConcurrentStreams == 0 {
+		p.MaxConcurrentStreams = 64
+	}
+}
+
+func (p UpstreamPool) validate() error {
+	if p.MaxIdle < 0 || p.MaxActive <= 0 || p.MaxConcurrentStreams <= 0 {
+		return errors.New("invalid upstream pool, maxIdle, maxActive and maxConcurrentStreams should > 0")
+	}
+
+	if p.MaxIdle > p.MaxActive {
+		return fmt.Errorf("invalid upstream pool, maxIdle %d should <= maxActive %d", p.MaxIdle, p.MaxActive)
+	}
+
+	return nil
+}
+
+// TenantLimiter defines the request rate limiter for each tenant, the tenant is the biz of the sidecar,
+// or the credential of the sdk, or the client ip if neither is provided.
+type TenantLimiter struct {
+	Enabled bool `yaml:"enabled"`
+	// Default is the default qps and burst for each tenant.
+	Default BasicRL `yaml:"default"`
+	// Spec is the qps and burst for the specified biz, biz id as the key.
+	Spec map[string]BasicRL `yaml:"spec"`
+}
+
+func (t *TenantLimiter) trySetDefault() {
+	if t.Default.Limit == 0 {
+		t.Default.Limit = 100
+	}
+
+	if t.Default.Burst == 0 {
+		t.Default.Burst = 2 * t.Default.Limit
+	}
+}
+
+func (t TenantLimiter) validate() error {
+	if t.Default.Burst < t.Default.Limit {
+		return fmt.Errorf("invalid rateLimiter.default.burst value %d, should >= rateLimiter.default.limit value %d",
+			t.Default.Burst, t.Default.Limit)
+	}
+
+	for bizID, l := range t.Spec {
+		if l.Burst < l.Limit {
+			return fmt.Errorf("invalid rateLimiter.spec.%s.burst value %d, should >= rateLimiter.spec.%s.limit "+
+				"value %d", bizID, l.Burst, bizID, l.Limit)
+		}
+	}
+
+	return nil
+}
+
+// ProxyDownstream defines the feed proxy's options for the edge client connections.
+type ProxyDownstream struct {
+	// MaxConcurrentStreams is the max streams of one client connection.
+	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams"`
+	// KeepaliveMinTimeSec is the min keepalive ping interval permitted for the clients, the connection which
+	// pings more frequently will be closed.
+	KeepaliveMinTimeSec uint `yaml:"keepaliveMinTimeSec"`
+	// MaxConnectionIdleSec closes the client connection which is idle for this duration.
+	MaxConnectionIdleSec uint `yaml:"maxConnectionIdleSec"`
+}
+
+func (d *ProxyDownstream) trySetDefault() {
+	if d.MaxConcurrentStreams == 0 {
+		d.MaxConcurrentStreams = 100
+	}
+
+	if d.KeepaliveMinTimeSec == 0 {
+		d.KeepaliveMinTimeSec = 10
+	}
+
+	if d.MaxConnectionIdleSec == 0 {
+		d.MaxConnectionIdleSec = 600
+	}
+}
+
+// defaultGrpcWebMethods is the read only kv methods allowed to call with grpc-web by default.
+var defaultGrpcWebMethods = []string{
+	"/pbfs.Upstream/Watch",
+	"/pbfs.Upstream/ListApps",
+	"/pbfs.Upstream/PullKvMeta",
+	"/pbfs.Upstream/GetKvValue",
+	"/pbfs.Upstream/GetKvs",
+	"/pbfs.Upstream/GetSingleKvValue",
+	"/pbfs.Upstream/GetSingleKvMeta",
+}
+
+// GrpcWeb defines the grpc-web options of the feed server's gateway, so that the web applications can
+// subscribe the kv changes from the browser directly.
+type GrpcWeb struct {
+	Enabled bool `yaml:"enabled"`
+	// AllowedOrigins is the origins of the web applications allowed to access, "*" means all.
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+	// AllowedMethods is the grpc full method names allowed to call, default is the read only kv methods.
+	AllowedMethods []string `yaml:"allowedMethods"`
+}
+
+func (g *GrpcWeb) trySetDefault() {
+	if len(g.AllowedMethods) == 0 {
+		g.AllowedMethods = defaultGrpcWebMethods
+	}
+}
+
+func (g GrpcWeb) validate() error {
+	if g.Enabled && len(g.AllowedOrigins) == 0 {
+		return errors.New("grpcWeb.allowedOrigins is required when grpc-web is enabled")
+	}
+
+	return nil
+}
+
+// defaultUnixSocketMode 默认只允许属主和属组访问
+const defaultUnixSocketMode = "0660"
+
+// UnixSocket defines the unix domain socket listener of the grpc server, so that the co-located
+// consumers can access it with the socket file's permission based access control.
+type UnixSocket struct {
+	// Path is the socket file path, empty means not listen on unix domain socket.
+	Path string `yaml:"path"`
+	// Mode is the socket file's permission in octal, default is 0660.
+	Mode string `yaml:"mode"`
+}
+
+// Enabled returns whether to listen on the unix domain socket.
+func (u UnixSocket) Enabled() bool {
+	return u.Path != ""
+}
+
+// FileMode returns the socket file's permission.
+func (u UnixSocket) FileMode() os.FileMode {
+	mode, _ := strconv.ParseUint(u.Mode, 8, 32)
+	return os.FileMode(mode)
+}
+
+func (u *UnixSocket) trySetDefault() {
+	if u.Enabled() && u.Mode == "" {
+		u.Mode = defaultUnixSocketMode
+	}
+}
+
+func (u UnixSocket) validate() error {
+	if !u.Enabled() {
+		return nil
+	}
+
+	if !filepath.IsAbs(u.Path) {
+		return fmt.Errorf("unixSocket.path %s should be an absolute path", u.Path)
+	}
+
+	if mode, err := strconv.ParseUint(u.Mode, 8, 32); err != nil || mode > 0777 {
+		return fmt.Errorf("invalid unixSocket.mode %s, should be octal permission like 0660", u.Mode)
+	}
+
+	return nil
+}
+
+// Deprecation defines a deprecation warning of the feed protocol, feed server attaches it to the responses of
+// the method, and the sdk logs it and exposes it with a callback, so that the fleets get advance notice before
+// the breaking change.
+type Deprecation struct {
+	// Method is the full grpc method name, such as /pbfs.Upstream/Watch.
+	Method string `yaml:"method"`
+	// Field is the request or response field to be removed, empty means the whole method is deprecated.
+	Field string `yaml:"field"`
+	// Sunset is the date the method or the field is removed, in 2006-01-02 format.
+	Sunset string `yaml:"sunset"`
+	// Message is the notice to the users, such as the replacement.
+	Message string `yaml:"message"`
+}
+
+func (d Deprecation) validate() error {
+	if !strings.HasPrefix(d.Method, "/") || strings.Count(d.Method, "/") != 2 {
+		return fmt.Errorf("invalid deprecations.method %s, should be like /pbfs.Upstream/Watch", d.Method)
+	}
+
+	if d.Sunset != "" {
+		if _, err := time.Parse("2006-01-02", d.Sunset); err != nil {
+			return fmt.Errorf("invalid deprecations.sunset %s of method %s, should be like 2006-01-02", d.Sunset,
+				d.Method)
+		}
+	}
+
+	if d.Message == "" {
+		return fmt.Errorf("deprecations.message of method %s is not set", d.Method)
+	}
+
+	return nil
+}
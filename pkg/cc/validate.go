@@ -0,0 +1,308 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cc
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// validateTag is the struct tag of the field validation rules, the rules are separated by comma and the
+// arguments of a rule are separated by space, e.g. `validate:"required,oneof=standalone cluster"`.
+// supported rules:
+// 1. required: the field must be set;
+// 2. ip: the string or each string of the slice must be an ip address;
+// 3. port: the number must be a port in [1, 65535];
+// 4. min=N, max=N: the range of the number, or the length of the string, slice and map;
+// 5. oneof=A B: the string must be one of the values;
+// 6. with=F1 F2: the sibling fields F1 and F2 are required when the field is set;
+// 7. exclusive=F1 F2: the field can not be set together with the sibling fields F1 and F2.
+// the empty field is only checked by the required rule, and the sections which are not configured at all
+// are skipped, whether they are needed is decided by the setting's Validate.
+const validateTag = "validate"
+
+// FieldError is the validation error of a configuration field.
+type FieldError struct {
+	// Path is the yaml path of the field, e.g. network.tls.caFile, empty means the error is not
+	// about a single field.
+	Path    string
+	Message string
+}
+
+// Error returns the field error message.
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return e.Path + ": " + e.Message
+}
+
+// ValidationErrors is all the validation errors of the configuration.
+type ValidationErrors []*FieldError
+
+// Error returns all the errors, one error per line.
+func (ve ValidationErrors) Error() string {
+	if len(ve) == 1 {
+		return "invalid configuration, " + ve[0].Error()
+	}
+
+	lines := make([]string, 0, len(ve)+1)
+	lines = append(lines, fmt.Sprintf("invalid configuration, %d errors:", len(ve)))
+	for _, e := range ve {
+		lines = append(lines, "  "+e.Error())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateSetting validates the setting with the field rules at first, so that all the field errors are
+// reported at once, and then with the setting's Validate for the rules across the sections.
+func validateSetting(s Setting) error {
+	if errs := validateFields(s); len(errs) != 0 {
+		return errs
+	}
+
+	if err := s.Validate(); err != nil {
+		return ValidationErrors{{Message: err.Error()}}
+	}
+
+	return nil
+}
+
+// validateFields validates the fields of the struct with their validate tags.
+func validateFields(v interface{}) ValidationErrors {
+	fv := &fieldValidator{reported: make(map[string]bool)}
+	fv.walk(reflect.ValueOf(v), "")
+	return fv.errs
+}
+
+type fieldValidator struct {
+	errs ValidationErrors
+	// reported 每个字段只报告第一个错误, 避免多个关联规则重复报告同一个字段
+	reported map[string]bool
+}
+
+func (fv *fieldValidator) report(path, format string, args ...interface{}) {
+	if fv.reported[path] {
+		return
+	}
+	fv.reported[path] = true
+	fv.errs = append(fv.errs, &FieldError{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func (fv *fieldValidator) walk(v reflect.Value, path string) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			name, inline := yamlName(field)
+			if name == "-" {
+				continue
+			}
+
+			fieldPath := joinPath(path, name)
+			if inline {
+				fieldPath = path
+			}
+			value := v.Field(i)
+			if rules := field.Tag.Get(validateTag); rules != "" {
+				fv.check(v, value, fieldPath, path, rules)
+			}
+
+			if value.IsZero() {
+				continue
+			}
+			fv.walk(value, fieldPath)
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			fv.walk(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, k := range keys {
+			fv.walk(v.MapIndex(k), fmt.Sprintf("%s[%v]", path, k))
+		}
+	}
+}
+
+// check validates the field with the rules, parent is the struct of the field.
+// nolint:funlen
+func (fv *fieldValidator) check(parent, value reflect.Value, path, parentPath, rules string) {
+	for _, rule := range strings.Split(rules, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch name {
+		case "required":
+			if value.IsZero() {
+				fv.report(path, "is required")
+				return
+			}
+			continue
+		case "with":
+			if value.IsZero() {
+				continue
+			}
+			for _, sibling := range strings.Fields(arg) {
+				sv, sp := siblingField(parent, parentPath, sibling)
+				if sv.IsValid() && sv.IsZero() {
+					fv.report(sp, "is required when %s is set", path)
+				}
+			}
+			continue
+		case "exclusive":
+			if value.IsZero() {
+				continue
+			}
+			for _, sibling := range strings.Fields(arg) {
+				sv, sp := siblingField(parent, parentPath, sibling)
+				if sv.IsValid() && !sv.IsZero() {
+					fv.report(path, "can not be set together with %s", sp)
+				}
+			}
+			continue
+		}
+
+		// 未设置的字段只校验 required, 其默认值由 trySetDefault 设置
+		if value.IsZero() {
+			return
+		}
+
+		switch name {
+		case "ip":
+			ips := []string{}
+			if value.Kind() == reflect.String {
+				ips = append(ips, value.String())
+			} else if value.Kind() == reflect.Slice {
+				for i := 0; i < value.Len(); i++ {
+					ips = append(ips, value.Index(i).String())
+				}
+			}
+			for _, ip := range ips {
+				if net.ParseIP(ip) == nil {
+					fv.report(path, "%q is not a valid ip address", ip)
+				}
+			}
+
+		case "port":
+			if n, ok := numberOf(value); !ok || n < 1 || n > 65535 {
+				fv.report(path, "should be a port in [1, 65535], got %v", value.Interface())
+			}
+
+		case "min", "max":
+			limit, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				fv.report(path, "invalid validate rule %s", rule)
+				continue
+			}
+			n, ok := numberOf(value)
+			desc := "value"
+			if !ok {
+				n, desc = float64(lengthOf(value)), "length"
+			}
+			if name == "min" && n < limit {
+				fv.report(path, "%s should be >= %s, got %v", desc, arg, n)
+			}
+			if name == "max" && n > limit {
+				fv.report(path, "%s should be <= %s, got %v", desc, arg, n)
+			}
+
+		case "oneof":
+			options := strings.Fields(arg)
+			hit := false
+			for _, one := range options {
+				if value.String() == one {
+					hit = true
+					break
+				}
+			}
+			if !hit {
+				fv.report(path, "should be one of [%s], got %q", strings.Join(options, ", "), value.String())
+			}
+
+		default:
+			fv.report(path, "unknown validate rule %s", rule)
+		}
+	}
+}
+
+// siblingField returns the field of the struct by its go field name and the yaml path of the field.
+func siblingField(parent reflect.Value, parentPath, name string) (reflect.Value, string) {
+	field, ok := parent.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, joinPath(parentPath, name)
+	}
+
+	yn, _ := yamlName(field)
+	return parent.FieldByIndex(field.Index), joinPath(parentPath, yn)
+}
+
+// yamlName returns the yaml key of the field and whether the field is inlined.
+func yamlName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("yaml")
+	name, opts, _ := strings.Cut(tag, ",")
+	if strings.Contains(opts, "inline") {
+		return name, true
+	}
+	if name == "" {
+		// 与 yaml 解析保持一致, 未设置 tag 时使用小写的字段名
+		return strings.ToLower(field.Name), false
+	}
+	return name, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func numberOf(v reflect.Value) (float64, bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	default:
+		return 0, false
+	}
+}
+
+func lengthOf(v reflect.Value) int {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return v.Len()
+	default:
+		return 0
+	}
+}
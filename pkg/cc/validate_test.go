@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cc
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateFields(t *testing.T) {
+	s := &FeedServerSetting{
+		Network: Network{
+			BindIP:   "127.0.0.1",
+			BindIPs:  []string{"::1", "not-an-ip"},
+			HttpPort: 70000,
+			TLS:      TLSConfig{CertFile: "cert.pem"},
+		},
+		Service:      Service{Locality: Locality{Zone: "zone-a"}},
+		RedisCluster: RedisCluster{DialTimeoutMS: 2000, ReadTimeoutMS: 100, Mode: "sentinel"},
+	}
+
+	got := make([]string, 0)
+	for _, e := range validateFields(s) {
+		got = append(got, e.Error())
+	}
+
+	expected := []string{
+		`network.bindIPs: "not-an-ip" is not a valid ip address`,
+		"network.httpPort: should be a port in [1, 65535], got 70000",
+		"network.tls.keyFile: is required when network.tls.certFile is set",
+		"network.tls.caFile: is required when network.tls.certFile is set",
+		"service.locality.region: is required when service.locality.zone is set",
+		"redisCluster.dialTimeoutMS: value should be <= 1000, got 2000",
+		`redisCluster.mode: should be one of [standalone, cluster], got "sentinel"`,
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("unexpected errors:\n%v", ValidationErrors(validateFields(s)))
+	}
+}
+
+func TestValidateFieldsDefault(t *testing.T) {
+	settings := []Setting{new(ApiServerSetting), new(AuthServerSetting), new(CacheServiceSetting),
+		new(ConfigServerSetting), new(DataServiceSetting), new(FeedServerSetting), new(FeedProxySetting),
+		new(VaultServerSetting), new(VaultSidecarSetting)}
+
+	for _, s := range settings {
+		if err := s.trySetFlagBindIP([]byte{127, 0, 0, 1}); err != nil {
+			t.Fatalf("set bind ip failed, err: %v", err)
+		}
+		s.trySetDefault()
+
+		// 默认配置不应违反任何字段规则, 同时用于检查规则的拼写
+		if errs := validateFields(s); len(errs) != 0 {
+			t.Errorf("%T default setting is invalid, %v", s, errs)
+		}
+	}
+}
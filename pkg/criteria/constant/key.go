@@ -26,6 +26,10 @@ const (
 
 	// LangKey is language key
 	LangKey = "X-Bkapi-Language"
+	// LangCookieKey is the blueking language cookie name
+	LangCookieKey = "blueking_language"
+	// AcceptLanguageKey is the standard http language header key
+	AcceptLanguageKey = "Accept-Language"
 
 	// UserKey is operator name header key.
 	UserKey = "X-Bkapi-User-Name"
@@ -36,6 +40,19 @@ const (
 	// OperateWayKey is approve operate way header key.
 	OperateWayKey = "X-Bscp-Operate-Way"
 
+	// ServiceAccountKeyHeader is the api key header of the service account.
+	ServiceAccountKeyHeader = "X-Bscp-Api-Key"
+
+	// IfMatchKey is the expected revision header key of the resource to be updated, used for optimistic locking.
+	IfMatchKey = "If-Match"
+	// IfNoneMatchKey is the header key of the client cached entity tags, used for conditional get.
+	IfNoneMatchKey = "If-None-Match"
+	// ETagKey is the header key of the response entity tag.
+	ETagKey = "ETag"
+	// IdempotencyKey is the header key of the idempotency key, the retries of a mutating request with the same
+	// idempotency key return the original result instead of being executed again.
+	IdempotencyKey = "Idempotency-Key"
+
 	// Space
 	SpaceIDKey     = "X-Bkapi-Space-Id"
 	SpaceTypeIDKey = "X-Bkapi-Space-Type-Id"
@@ -91,6 +108,8 @@ const (
 
 	// DefaultLanguage is default language
 	DefaultLanguage = "zh-cn"
+	// EnglishLanguage is english language
+	EnglishLanguage = "en"
 )
 
 // Note:
@@ -13,6 +13,8 @@
 // Package enumor is enum of the audit
 package enumor
 
+import "fmt"
+
 /*
 	audit.go store audit related enum values.
 */
@@ -53,6 +55,8 @@ const (
 	Delete AuditAction = "delete"
 	// Publish 发布
 	Publish AuditAction = "publish"
+	// Warn 告警，由系统产生，如证书即将过期
+	Warn AuditAction = "warn"
 )
 
 // AuditStatus audit status.
@@ -84,3 +88,31 @@ const (
 	// API audit operate way
 	API AuditOperateWay = "API"
 )
+
+// TimelineEventType is the event type of the app's change timeline.
+type TimelineEventType string
+
+const (
+	// EditEvent 服务、配置文件、脚本、变量和模板的编辑
+	EditEvent TimelineEventType = "edit"
+	// ReleaseEvent 生成或修改版本
+	ReleaseEvent TimelineEventType = "release"
+	// PublishEvent 上线版本，包含审批过程
+	PublishEvent TimelineEventType = "publish"
+	// RollbackEvent 上线了比已上线版本更早的版本
+	RollbackEvent TimelineEventType = "rollback"
+	// StrategyEvent 服务关联的分组(灰度策略)的变更
+	StrategyEvent TimelineEventType = "strategy"
+)
+
+// Validate the timeline event type is valid or not, rollback can not be used to filter
+// because it is detected after the publish events are listed.
+func (t TimelineEventType) Validate() error {
+	switch t {
+	case EditEvent, ReleaseEvent, PublishEvent, StrategyEvent:
+	default:
+		return fmt.Errorf("unsupported timeline event type: %s", t)
+	}
+
+	return nil
+}
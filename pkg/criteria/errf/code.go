@@ -110,7 +110,7 @@ const (
 	NotImplemented int32 = 10014
 )
 
-// bscp专用错误码，细粒度具体场景的错误码
+// bscp专用错误码，细粒度具体场景的错误码, 详细定义见 reason.go 中的错误码注册表
 const (
 	// AppNotExists means the app is not exist.
 	AppNotExists int32 = 11000
@@ -155,8 +155,7 @@ var (
 		Unknown:            "UNKNOWN",
 		NotImplemented:     "NOT_IMPLEMENTED",
 
-		// bscp专用错误码
-		AppNotExists: "APP_NOT_EXISTS",
+		// bscp专用错误码在 reason.go 中注册
 	}
 
 	// BscpStatusMap bscp错误码->状态映射
@@ -197,7 +196,6 @@ var (
 		Unknown:            http.StatusInternalServerError,
 		NotImplemented:     http.StatusNotImplemented,
 
-		// bscp专用错误码
-		AppNotExists: http.StatusNotFound,
+		// bscp专用错误码在 reason.go 中注册
 	}
 )
@@ -16,10 +16,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
@@ -30,6 +34,9 @@ type ErrorF struct {
 	Code int32 `json:"code"`
 	// Message is error detail
 	Message string `json:"message"`
+	// RetryAfter is the hint of how long the client should wait before retry, it is carried in the grpc
+	// status details.
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Errorf 返回自定义封装的bscp错误，包括错误码、错误信息
@@ -70,9 +77,32 @@ func (e *ErrorF) WithCause(cause error) *ErrorF {
 	return e
 }
 
+// WithRetryAfter set the retry after hint of the error.
+func (e *ErrorF) WithRetryAfter(d time.Duration) *ErrorF {
+	e.RetryAfter = d
+	return e
+}
+
 // GRPCStatus implements interface{ GRPCStatus() *Status } , so that it can be recognized by grpc
 func (e *ErrorF) GRPCStatus() *status.Status {
-	return status.New(codes.Code(e.Code), e.Message)
+	st := status.New(codes.Code(e.Code), e.Message)
+
+	details := make([]protoadapt.MessageV1, 0, 2)
+	// 注册的错误码附带错误详情, 便于客户端根据错误码及是否可重试进行处理
+	if r, ok := LookupReason(e.Code); ok {
+		details = append(details, r.errorInfo())
+	}
+	if e.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(e.RetryAfter)})
+	}
+	if len(details) == 0 {
+		return st
+	}
+
+	if detail, err := st.WithDetails(details...); err == nil {
+		return detail
+	}
+	return st
 }
 
 // Format the ErrorF error to a string format.
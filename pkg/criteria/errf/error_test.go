@@ -15,6 +15,8 @@ package errf
 import (
 	"encoding/json"
 	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 )
 
 func TestWrap(t *testing.T) {
@@ -39,3 +41,27 @@ func TestWrap(t *testing.T) {
 		return
 	}
 }
+
+func TestReason(t *testing.T) {
+	ef := ErrAppNotExists.New(&kit.Kit{Lang: "zh-CN"}, 1)
+	if ef.Code != AppNotExists || ef.Message != "服务 1 不存在" {
+		t.Errorf("unexpected localized error: %s", ef.Error())
+		return
+	}
+
+	if ef = ErrAppNotExists.New(&kit.Kit{Lang: "en"}, 1); ef.Message != "app 1 not found" {
+		t.Errorf("unexpected localized error: %s", ef.Error())
+		return
+	}
+
+	st := ErrPublishConflict.New(nil, 1).GRPCStatus()
+	if len(st.Details()) != 1 {
+		t.Errorf("registered error should carry the error info detail")
+		return
+	}
+
+	if !IsRetryable(ErrPublishConflict.Code) || IsRetryable(AppNotExists) {
+		t.Errorf("unexpected retryable flag")
+		return
+	}
+}
@@ -0,0 +1,217 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package errf
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n/localizer"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ErrorDomain is the domain of the error info in the grpc status details.
+const ErrorDomain = "bscp"
+
+// Reason is a registered typed error, it is identified by the code, and returned with the domain,
+// name and retryable flag in the error details, so that the sdk and ui can branch on the code
+// instead of parsing the message.
+type Reason struct {
+	// Code is the bscp专用错误码, unique in the registry.
+	Code int32 `json:"code"`
+	// Domain is the business domain of the error, such as app, release, kv.
+	Domain string `json:"domain"`
+	// Name is the code string of the error, such as APP_NOT_EXISTS.
+	Name string `json:"name"`
+	// Retryable means the request can be retried later and may succeed.
+	Retryable  bool `json:"retryable"`
+	HTTPStatus int  `json:"http_status"`
+	// MessageEn and MessageZh are the format of the English and Chinese messages.
+	MessageEn string `json:"message_en"`
+	MessageZh string `json:"message_zh"`
+}
+
+// New returns an error of the reason with the message localized by the kit's language.
+func (r *Reason) New(kt *kit.Kit, args ...interface{}) *ErrorF {
+	format := r.MessageEn
+	if kt != nil && localizer.IsChinese(kt.Lang) {
+		format = r.MessageZh
+	}
+
+	return &ErrorF{Code: r.Code, Message: fmt.Sprintf(format, args...)}
+}
+
+// errorInfo returns the error info carried in the grpc status details.
+func (r *Reason) errorInfo() *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: r.Name,
+		Domain: ErrorDomain + "." + r.Domain,
+		Metadata: map[string]string{
+			"code":      strconv.Itoa(int(r.Code)),
+			"retryable": strconv.FormatBool(r.Retryable),
+		},
+	}
+}
+
+// reasons is the registry of all the typed errors.
+var reasons = make(map[int32]*Reason)
+
+// register a typed error, the code and the name should be unique.
+func register(r *Reason) *Reason {
+	if _, exists := reasons[r.Code]; exists {
+		panic(fmt.Sprintf("error code %d is registered repeatedly", r.Code))
+	}
+
+	reasons[r.Code] = r
+	BscpCodeMap[r.Code] = r.Name
+	BscpStatusMap[r.Code] = r.HTTPStatus
+	return r
+}
+
+// LookupReason returns the registered typed error of the code.
+func LookupReason(code int32) (*Reason, bool) {
+	r, ok := reasons[code]
+	return r, ok
+}
+
+// Reasons returns all the registered typed errors ordered by code.
+func Reasons() []*Reason {
+	list := make([]*Reason, 0, len(reasons))
+	for _, r := range reasons {
+		list = append(list, r)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Code < list[j].Code })
+	return list
+}
+
+// retryableCodes 通用错误码中可以重试的错误
+var retryableCodes = map[int32]bool{
+	TooManyRequest:  true,
+	UnHealth:        true,
+	RatelimitExceed: true,
+	14:              true, // grpc Unavailable
+	4:               true, // grpc DeadlineExceeded
+}
+
+// IsRetryable returns whether the request failed with the code can be retried.
+func IsRetryable(code int32) bool {
+	if r, ok := reasons[code]; ok {
+		return r.Retryable
+	}
+	return retryableCodes[code]
+}
+
+// bscp专用错误码注册表, 按业务领域划分号段:
+// 110xx app, 111xx release, 112xx kv, 113xx config item, 114xx group, 115xx credential,
+// 116xx publish, 117xx client, 119xx storage and dependent services.
+var (
+	// ErrAppNotExists means the app is not exist.
+	ErrAppNotExists = register(&Reason{Code: AppNotExists, Domain: "app", Name: "APP_NOT_EXISTS",
+		HTTPStatus: http.StatusNotFound, MessageEn: "app %v not found", MessageZh: "服务 %v 不存在"})
+	// ErrAppAlreadyExists means the app name is already used in the biz.
+	ErrAppAlreadyExists = register(&Reason{Code: 11001, Domain: "app", Name: "APP_ALREADY_EXISTS",
+		HTTPStatus: http.StatusConflict, MessageEn: "app %v already exists", MessageZh: "服务 %v 已存在"})
+	// ErrAppFrozen means the app is frozen, the writes and publishes are rejected.
+	ErrAppFrozen = register(&Reason{Code: 11002, Domain: "app", Name: "APP_FROZEN",
+		HTTPStatus: http.StatusForbidden, MessageEn: "app %v is frozen, modification and publishing are not allowed",
+		MessageZh: "服务 %v 已冻结，不允许修改和上线"})
+	// ErrAppArchived means the app is archived, it is not writable and not served to the clients any more.
+	ErrAppArchived = register(&Reason{Code: 11003, Domain: "app", Name: "APP_ARCHIVED",
+		HTTPStatus: http.StatusForbidden, MessageEn: "app %v is archived", MessageZh: "服务 %v 已归档"})
+	// ErrAppConsumptionPaused means the consumption of the app is paused, the new releases are not served.
+	ErrAppConsumptionPaused = register(&Reason{Code: 11004, Domain: "app", Name: "APP_CONSUMPTION_PAUSED",
+		Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "consumption of app %v is paused, %v", MessageZh: "服务 %v 已暂停拉取配置，%v"})
+
+	// ErrReleaseNotExists means the release is not exist.
+	ErrReleaseNotExists = register(&Reason{Code: 11100, Domain: "release", Name: "RELEASE_NOT_EXISTS",
+		HTTPStatus: http.StatusNotFound, MessageEn: "release %v not found", MessageZh: "版本 %v 不存在"})
+	// ErrReleaseNameExists means the release name is already used in the app.
+	ErrReleaseNameExists = register(&Reason{Code: 11101, Domain: "release", Name: "RELEASE_NAME_EXISTS",
+		HTTPStatus: http.StatusConflict, MessageEn: "release name %v already exists",
+		MessageZh: "版本名称 %v 已存在"})
+	// ErrReleaseDeprecated means the release is deprecated and can not be published.
+	ErrReleaseDeprecated = register(&Reason{Code: 11102, Domain: "release", Name: "RELEASE_DEPRECATED",
+		HTTPStatus: http.StatusBadRequest, MessageEn: "release %v is deprecated",
+		MessageZh: "版本 %v 已废弃"})
+
+	// ErrKvNotExists means the kv is not exist.
+	ErrKvNotExists = register(&Reason{Code: 11200, Domain: "kv", Name: "KV_NOT_EXISTS",
+		HTTPStatus: http.StatusNotFound, MessageEn: "kv %v not found", MessageZh: "配置项 %v 不存在"})
+	// ErrKvAlreadyExists means the kv key is already used in the app.
+	ErrKvAlreadyExists = register(&Reason{Code: 11201, Domain: "kv", Name: "KV_ALREADY_EXISTS",
+		HTTPStatus: http.StatusConflict, MessageEn: "kv %v already exists", MessageZh: "配置项 %v 已存在"})
+	// ErrKvExpired means the released kv is expired and has no fallback value.
+	ErrKvExpired = register(&Reason{Code: 11202, Domain: "kv", Name: "KV_EXPIRED",
+		HTTPStatus: http.StatusGone, MessageEn: "kv %v is expired", MessageZh: "配置项 %v 已过期"})
+
+	// ErrConfigItemNotExists means the config item is not exist.
+	ErrConfigItemNotExists = register(&Reason{Code: 11300, Domain: "config_item", Name: "CONFIG_ITEM_NOT_EXISTS",
+		HTTPStatus: http.StatusNotFound, MessageEn: "config item %v not found", MessageZh: "配置文件 %v 不存在"})
+
+	// ErrGroupNotExists means the group is not exist.
+	ErrGroupNotExists = register(&Reason{Code: 11400, Domain: "group", Name: "GROUP_NOT_EXISTS",
+		HTTPStatus: http.StatusNotFound, MessageEn: "group %v not found", MessageZh: "分组 %v 不存在"})
+
+	// ErrCredentialInvalidReason means the credential is not exist or mismatched.
+	ErrCredentialInvalidReason = register(&Reason{Code: 11500, Domain: "credential", Name: "CREDENTIAL_INVALID",
+		HTTPStatus: http.StatusUnauthorized, MessageEn: "invalid credential", MessageZh: "无效的客户端密钥"})
+	// ErrCredentialDisabled means the credential is disabled.
+	ErrCredentialDisabled = register(&Reason{Code: 11501, Domain: "credential", Name: "CREDENTIAL_DISABLED",
+		HTTPStatus: http.StatusForbidden, MessageEn: "credential is disabled", MessageZh: "客户端密钥已禁用"})
+	// ErrCredentialConsumptionPaused means the consumption of the clients using the credential is paused.
+	ErrCredentialConsumptionPaused = register(&Reason{Code: 11502, Domain: "credential",
+		Name: "CREDENTIAL_CONSUMPTION_PAUSED", Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "consumption of the credential is paused, %v", MessageZh: "客户端密钥已暂停拉取配置，%v"})
+
+	// ErrPublishConflict means another publish of the app is in progress.
+	ErrPublishConflict = register(&Reason{Code: 11600, Domain: "publish", Name: "PUBLISH_CONFLICT",
+		Retryable: true, HTTPStatus: http.StatusConflict,
+		MessageEn: "another publish of app %v is in progress, please retry later",
+		MessageZh: "服务 %v 正在上线其他版本，请稍后重试"})
+	// ErrPublishPendingApproval means the publish is waiting for approval.
+	ErrPublishPendingApproval = register(&Reason{Code: 11601, Domain: "publish", Name: "PUBLISH_PENDING_APPROVAL",
+		HTTPStatus: http.StatusConflict, MessageEn: "release %v is pending approval",
+		MessageZh: "版本 %v 正在等待审批"})
+
+	// ErrClientQuarantined means the client is quarantined by fingerprint or ip, it should back off before retrying.
+	ErrClientQuarantined = register(&Reason{Code: 11700, Domain: "client", Name: "CLIENT_QUARANTINED",
+		Retryable: true, HTTPStatus: http.StatusForbidden,
+		MessageEn: "client is quarantined, %v, please retry after %v", MessageZh: "客户端已被隔离，%v，请在 %v 后重试"})
+	// ErrClientVersionTooLow means the client's version is lower than the min client version required by the app.
+	ErrClientVersionTooLow = register(&Reason{Code: 11701, Domain: "client", Name: "CLIENT_VERSION_TOO_LOW",
+		HTTPStatus: http.StatusUpgradeRequired, MessageZh: "服务 %v 要求客户端版本不低于 %v，当前客户端版本为 %v，请升级客户端",
+		MessageEn: "app %v requires client version >= %v, but the client version is %v, please upgrade the client"})
+
+	// ErrStorageUnavailable means the repository storage is unavailable.
+	ErrStorageUnavailable = register(&Reason{Code: 11900, Domain: "storage", Name: "STORAGE_UNAVAILABLE",
+		Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "storage is unavailable, please retry later", MessageZh: "存储服务暂不可用，请稍后重试"})
+	// ErrDBUnavailable means the db operation failed because of the db is unavailable.
+	ErrDBUnavailable = register(&Reason{Code: 11901, Domain: "storage", Name: "DB_UNAVAILABLE",
+		Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "database is unavailable, please retry later", MessageZh: "数据库暂不可用，请稍后重试"})
+	// ErrServerOverloaded means the request is shed because the server is overloaded.
+	ErrServerOverloaded = register(&Reason{Code: 11902, Domain: "server", Name: "SERVER_OVERLOADED",
+		Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "server is overloaded, please retry after %v", MessageZh: "服务端负载过高，请在 %v 后重试"})
+	// ErrReadOnlyMaintenance means the write is rejected because the platform is in read-only maintenance.
+	ErrReadOnlyMaintenance = register(&Reason{Code: 11903, Domain: "server", Name: "READ_ONLY_MAINTENANCE",
+		Retryable: true, HTTPStatus: http.StatusServiceUnavailable,
+		MessageEn: "the platform is in read-only maintenance(%v), writes are rejected, please retry later",
+		MessageZh: "平台正在进行只读维护（%v），暂不支持写操作，请稍后重试"})
+)
@@ -0,0 +1,283 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+const (
+	// MaxAlertRulesPerBiz is the max alert rule count of one biz.
+	MaxAlertRulesPerBiz = 100
+	// maxAlertRuleChannels is the max notification channels which one alert rule fires to.
+	maxAlertRuleChannels = 10
+	// defaultAlertSilenceMin is the default minutes before a firing alert is sent again.
+	defaultAlertSilenceMin = 60
+)
+
+// AlertRule is a threshold rule on the platform metrics and events, it's evaluated periodically by the
+// leader data service and fires to the notification channels when the threshold is exceeded.
+type AlertRule struct {
+	ID         uint32               `json:"id" gorm:"primaryKey"`
+	Spec       *AlertRuleSpec       `json:"spec" gorm:"embedded"`
+	Attachment *AlertRuleAttachment `json:"attachment" gorm:"embedded"`
+	State      *AlertRuleState      `json:"state" gorm:"embedded"`
+	Revision   *Revision            `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the alert rule's database table name.
+func (a *AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// AppID AuditRes interface
+func (a *AlertRule) AppID() uint32 {
+	if a.Attachment == nil {
+		return 0
+	}
+	return a.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (a *AlertRule) ResID() uint32 {
+	return a.ID
+}
+
+// ResType AuditRes interface
+func (a *AlertRule) ResType() string {
+	return "alert_rule"
+}
+
+// ValidateCreate validate alert rule is valid or not when create it.
+func (a *AlertRule) ValidateCreate(kit *kit.Kit) error {
+	if a.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return a.validate(kit)
+}
+
+// ValidateUpdate validate alert rule is valid or not when update it.
+func (a *AlertRule) ValidateUpdate(kit *kit.Kit) error {
+	if a.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return a.validate(kit)
+}
+
+func (a *AlertRule) validate(kit *kit.Kit) error {
+	if a.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if a.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := a.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if err := a.Spec.Validate(kit, a.Attachment.AppID); err != nil {
+		return err
+	}
+
+	if a.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// AlertRuleSpec defines all the specifics for alert rule set by user.
+type AlertRuleSpec struct {
+	Name   string      `json:"name" gorm:"column:name"`
+	Metric AlertMetric `json:"metric" gorm:"column:metric"`
+	// Threshold 告警阈值, 单位由指标决定: 失败率为百分比, 心跳为分钟, 过期为天
+	Threshold float64 `json:"threshold" gorm:"column:threshold"`
+	// WindowMin 统计窗口(分钟), 仅失败率指标使用
+	WindowMin uint32 `json:"window_min" gorm:"column:window_min"`
+	// GroupID 检测心跳的分组, 仅心跳指标使用
+	GroupID    uint32          `json:"group_id" gorm:"column:group_id"`
+	ChannelIDs AlertChannelIDs `json:"channel_ids" gorm:"column:channel_ids;type:json"`
+	// SilenceMin 告警持续时重复通知的间隔(分钟), 为 0 时为 60
+	SilenceMin uint32 `json:"silence_min" gorm:"column:silence_min"`
+	Enabled    bool   `json:"enabled" gorm:"column:enabled"`
+	Memo       string `json:"memo" gorm:"column:memo"`
+}
+
+// Validate alert rule spec, appID is the app which the rule belongs to, 0 means a biz level rule.
+func (a *AlertRuleSpec) Validate(kit *kit.Kit, appID uint32) error {
+	if err := validator.ValidateName(kit, a.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, a.Memo, false); err != nil {
+		return err
+	}
+
+	if err := a.Metric.Validate(); err != nil {
+		return err
+	}
+
+	if a.Threshold <= 0 {
+		return errors.New("alert threshold should be greater than 0")
+	}
+
+	switch a.Metric {
+	case PublishFailureRate:
+		if appID == 0 {
+			return fmt.Errorf("app is required by metric %s", a.Metric)
+		}
+		if a.Threshold > 100 {
+			return errors.New("publish failure rate threshold should be a percentage no greater than 100")
+		}
+		if a.WindowMin == 0 {
+			return errors.New("window minutes is required by metric publish_failure_rate")
+		}
+	case GroupNoHeartbeat:
+		if appID == 0 || a.GroupID == 0 {
+			return fmt.Errorf("app and group are required by metric %s", a.Metric)
+		}
+	}
+
+	if len(a.ChannelIDs) == 0 {
+		return errors.New("at least one notification channel should be set")
+	}
+
+	if len(a.ChannelIDs) > maxAlertRuleChannels {
+		return fmt.Errorf("notification channels should be no more than %d", maxAlertRuleChannels)
+	}
+
+	return nil
+}
+
+// Silence returns the interval before a firing alert is sent again.
+func (a *AlertRuleSpec) Silence() time.Duration {
+	if a.SilenceMin == 0 {
+		return defaultAlertSilenceMin * time.Minute
+	}
+	return time.Duration(a.SilenceMin) * time.Minute
+}
+
+// AlertMetric is the platform metric which the alert rule is evaluated on.
+type AlertMetric string
+
+const (
+	// PublishFailureRate the percentage of the failed release changes reported by the clients of the app
+	// in the window, e.g. publish failure rate > 5%.
+	PublishFailureRate AlertMetric = "publish_failure_rate"
+	// GroupNoHeartbeat the minutes since the latest heartbeat of the clients in the group,
+	// e.g. no heartbeat from group X for 10m.
+	GroupNoHeartbeat AlertMetric = "group_no_heartbeat"
+	// CredentialExpiry the days until the earliest expiring api key of the service accounts in the biz,
+	// e.g. credential expires in 7 days.
+	CredentialExpiry AlertMetric = "credential_expiry"
+)
+
+// Validate alert metric.
+func (m AlertMetric) Validate() error {
+	switch m {
+	case PublishFailureRate, GroupNoHeartbeat, CredentialExpiry:
+	default:
+		return fmt.Errorf("unsupported alert metric: %s", m)
+	}
+
+	return nil
+}
+
+// Unit returns the unit of the metric value.
+func (m AlertMetric) Unit() string {
+	switch m {
+	case PublishFailureRate:
+		return "%"
+	case GroupNoHeartbeat:
+		return "min"
+	case CredentialExpiry:
+		return "day"
+	default:
+		return ""
+	}
+}
+
+// Exceeded returns whether the metric value exceeds the threshold, the credential expiry exceeds the
+// threshold when the remaining days is no more than it.
+func (m AlertMetric) Exceeded(value, threshold float64) bool {
+	switch m {
+	case PublishFailureRate:
+		return value > threshold
+	case GroupNoHeartbeat:
+		return value >= threshold
+	case CredentialExpiry:
+		return value <= threshold
+	default:
+		return false
+	}
+}
+
+// AlertChannelIDs is the notification channel ids which the alert rule fires to.
+type AlertChannelIDs []uint32
+
+// Value implements the driver.Valuer interface.
+func (c AlertChannelIDs) Value() (driver.Value, error) {
+	if c == nil {
+		return "[]", nil
+	}
+	return json.Marshal(c)
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *AlertChannelIDs) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, c)
+	case string:
+		return json.Unmarshal([]byte(v), c)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported alert channel ids raw type: %T", v)
+	}
+}
+
+// AlertRuleAttachment defines the alert rule attachments.
+type AlertRuleAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// AppID 为 0 时为业务级的规则
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether alert rule attachment is valid or not.
+func (a *AlertRuleAttachment) Validate() error {
+	if a.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	return nil
+}
+
+// AlertRuleState is the evaluation state of the alert rule, which is maintained by the evaluator.
+type AlertRuleState struct {
+	Firing          bool       `json:"firing" gorm:"column:firing"`
+	LastValue       float64    `json:"last_value" gorm:"column:last_value"`
+	LastFiredAt     *time.Time `json:"last_fired_at" gorm:"column:last_fired_at"`
+	LastEvaluatedAt *time.Time `json:"last_evaluated_at" gorm:"column:last_evaluated_at"`
+}
@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestAlertRuleSpecValidate(t *testing.T) {
+	spec := &AlertRuleSpec{Name: "failure-rate", Metric: PublishFailureRate, Threshold: 5, WindowMin: 30,
+		ChannelIDs: AlertChannelIDs{1}}
+	if err := spec.Validate(nil, 0); err == nil {
+		t.Errorf("publish failure rate rule should be bound to an app")
+	}
+	if err := spec.Validate(nil, 2); err != nil {
+		t.Errorf("validate publish failure rate rule failed, err: %v", err)
+	}
+
+	spec.Metric, spec.GroupID = GroupNoHeartbeat, 0
+	if err := spec.Validate(nil, 2); err == nil {
+		t.Errorf("group no heartbeat rule should set the group")
+	}
+
+	spec.Metric, spec.ChannelIDs = CredentialExpiry, nil
+	if err := spec.Validate(nil, 0); err == nil {
+		t.Errorf("rule without notification channel should be rejected")
+	}
+}
+
+func TestAlertMetricExceeded(t *testing.T) {
+	cases := []struct {
+		metric    AlertMetric
+		value     float64
+		threshold float64
+		exceeded  bool
+	}{
+		{PublishFailureRate, 5, 5, false},
+		{PublishFailureRate, 5.1, 5, true},
+		{GroupNoHeartbeat, 10, 10, true},
+		{GroupNoHeartbeat, 9.9, 10, false},
+		{CredentialExpiry, 7, 7, true},
+		{CredentialExpiry, 8, 7, false},
+		{CredentialExpiry, -1, 7, true},
+	}
+	for _, c := range cases {
+		if got := c.metric.Exceeded(c.value, c.threshold); got != c.exceeded {
+			t.Errorf("%s %v with threshold %v, expect exceeded %v, got %v", c.metric, c.value, c.threshold,
+				c.exceeded, got)
+		}
+	}
+}
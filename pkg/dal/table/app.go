@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"time"
 
+	semver "github.com/hashicorp/go-version"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
@@ -142,6 +144,14 @@ type AppSpec struct {
 	ApproveType      ApproveType `json:"approve_type" gorm:"approve_type"`
 	IsApprove        bool        `json:"is_approve" gorm:"is_approve"`
 	Approver         string      `json:"approver" gorm:"approver"`
+	// ReleaseNotesPolicy 生成版本时对版本说明的要求
+	ReleaseNotesPolicy ReleaseNotesPolicy `json:"release_notes_policy" gorm:"column:release_notes_policy"`
+	// State 服务生命周期状态, 只能通过状态变更接口修改
+	State AppState `json:"state" gorm:"column:state"`
+	// Pause 暂停客户端拉取配置的开关, 只能通过暂停接口修改
+	Pause ConsumptionPause `json:"pause" gorm:"column:pause"`
+	// MinClientVersion 客户端(SDK/Agent)最低版本, 低于该版本的客户端 watch 服务时被拒绝, 为空时不限制
+	MinClientVersion string `json:"min_client_version" gorm:"column:min_client_version"`
 }
 
 // ValidateCreate validate spec when created.
@@ -170,6 +180,14 @@ func (as *AppSpec) ValidateCreate(kit *kit.Kit) error {
 		return err
 	}
 
+	if err := as.ReleaseNotesPolicy.Validate(kit); err != nil {
+		return err
+	}
+
+	if err := validateMinClientVersion(kit, as.MinClientVersion); err != nil {
+		return err
+	}
+
 	if as.IsApprove && (as.ApproveType == "" || as.Approver == "") {
 		return errors.New("approve_type or approver cannot be empty")
 	}
@@ -206,6 +224,14 @@ func (as *AppSpec) ValidateUpdate(kit *kit.Kit, configType ConfigType) error {
 		return err
 	}
 
+	if err := as.ReleaseNotesPolicy.Validate(kit); err != nil {
+		return err
+	}
+
+	if err := validateMinClientVersion(kit, as.MinClientVersion); err != nil {
+		return err
+	}
+
 	switch configType {
 	case File:
 	case KV:
@@ -220,6 +246,74 @@ func (as *AppSpec) ValidateUpdate(kit *kit.Kit, configType ConfigType) error {
 	return nil
 }
 
+// validateMinClientVersion validate the min client version is a semantic version, such as v1.2.0.
+func validateMinClientVersion(kit *kit.Kit, ver string) error {
+	if ver == "" {
+		return nil
+	}
+
+	if _, err := semver.NewVersion(ver); err != nil {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "invalid min client version %s, should be like v1.2.0",
+			ver))
+	}
+
+	return nil
+}
+
+const (
+	// AppStateActive the app can be edited, published and consumed.
+	AppStateActive AppState = "active"
+	// AppStateFrozen the app rejects writes and publishes, but still serves the existing releases.
+	AppStateFrozen AppState = "frozen"
+	// AppStateArchived the app stops serving the clients, and its caches are freed.
+	AppStateArchived AppState = "archived"
+)
+
+// AppState is the lifecycle state of the app: active <-> frozen <-> archived.
+type AppState string
+
+// Validate the app state.
+func (s AppState) Validate(kit *kit.Kit) error {
+	switch s {
+	case AppStateActive, AppStateFrozen, AppStateArchived:
+	default:
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "unsupported app state: %s", s))
+	}
+
+	return nil
+}
+
+// Normalize returns active for the app created before the state is introduced.
+func (s AppState) Normalize() AppState {
+	if s == "" {
+		return AppStateActive
+	}
+	return s
+}
+
+// ValidateTransition validate the app can be transited from the state to the target state,
+// an archived app must be unfrozen through the frozen state.
+func (s AppState) ValidateTransition(kit *kit.Kit, to AppState) error {
+	if err := to.Validate(kit); err != nil {
+		return err
+	}
+
+	from := s.Normalize()
+	switch {
+	case from == to:
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "app is already in state %s", to))
+	case from == AppStateActive && to == AppStateArchived, from == AppStateArchived && to == AppStateActive:
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "app state can not be changed from %s to %s", from, to))
+	}
+
+	return nil
+}
+
+// Writable returns whether the app's configs can be edited and published.
+func (s AppState) Writable() bool {
+	return s.Normalize() == AppStateActive
+}
+
 const (
 	// KV is kv configuration type
 	KV ConfigType = "kv"
@@ -302,6 +396,16 @@ const (
 	KvXml DataType = "xml"
 	// KvSecret is the type for secret kv
 	KvSecret DataType = "secret"
+	// KvBool is the type for bool kv
+	KvBool DataType = "bool"
+	// KvDuration is the type for duration kv, e.g. 30s, 1h30m
+	KvDuration DataType = "duration"
+	// KvBytes is the type for bytes-size kv, e.g. 512Mi, 1GB
+	KvBytes DataType = "bytes"
+	// KvList is the type for list kv, which is a json array of scalars
+	KvList DataType = "list"
+	// KvCertBundle is the type for certificate bundle kv, which contains the certificate chain and its private key
+	KvCertBundle DataType = "cert_bundle"
 )
 
 // ValidateApp the kvType and value match
@@ -315,6 +419,7 @@ func (k DataType) ValidateApp(kit *kit.Kit) error {
 	case KvYAML:
 	case KvXml:
 	case KvSecret:
+	case KvBool, KvDuration, KvBytes, KvList, KvCertBundle:
 	default:
 		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "invalid data-type"))
 	}
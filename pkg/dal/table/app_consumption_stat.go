@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"time"
+)
+
+// AppConsumptionStatDateLayout is the layout of the stat date.
+const AppConsumptionStatDateLayout = "2006-01-02"
+
+// AppConsumptionStat is the daily consumption statistics of an app reported by the feed servers,
+// which is used to find out whether the app is still consumed and the top-talker apps.
+type AppConsumptionStat struct {
+	ID         uint32                        `json:"id" gorm:"primaryKey"`
+	Spec       *AppConsumptionStatSpec       `json:"spec" gorm:"embedded"`
+	Attachment *AppConsumptionStatAttachment `json:"attachment" gorm:"embedded"`
+}
+
+// TableName is the app consumption stat's database table name.
+func (a *AppConsumptionStat) TableName() string {
+	return "app_consumption_stats"
+}
+
+// ValidateCreate validate app consumption stat is valid or not when create it.
+func (a *AppConsumptionStat) ValidateCreate() error {
+	if a.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if a.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if _, err := time.Parse(AppConsumptionStatDateLayout, a.Spec.StatDate); err != nil {
+		return errors.New("invalid stat date, should be like 2006-01-02")
+	}
+
+	if a.Attachment == nil || a.Attachment.BizID <= 0 || a.Attachment.AppID <= 0 {
+		return errors.New("biz id and app id should be set")
+	}
+
+	return nil
+}
+
+// AppConsumptionStatSpec defines the consumption statistics of one day.
+type AppConsumptionStatSpec struct {
+	// StatDate 统计日期, 格式为 2006-01-02
+	StatDate string `json:"stat_date" gorm:"column:stat_date"`
+	// PullCount 拉取配置的请求数
+	PullCount uint64 `json:"pull_count" gorm:"column:pull_count"`
+	// DownloadBytes 下发的配置文件大小
+	DownloadBytes uint64 `json:"download_bytes" gorm:"column:download_bytes"`
+	// WatchSeconds 客户端监听配置变更的累计时长
+	WatchSeconds uint64    `json:"watch_seconds" gorm:"column:watch_seconds"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// AppConsumptionStatAttachment defines the app consumption stat attachments.
+type AppConsumptionStatAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
@@ -0,0 +1,176 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"errors"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// AppRole is the role of the user in an app.
+type AppRole string
+
+const (
+	// AppRoleViewer can view the app, but can not see the secret values.
+	AppRoleViewer AppRole = "viewer"
+	// AppRoleEditor can edit the configs and generate releases, but can not publish.
+	AppRoleEditor AppRole = "editor"
+	// AppRolePublisher can publish the releases besides the editor's actions.
+	AppRolePublisher AppRole = "publisher"
+	// AppRoleAdmin can delete the app and manage the role bindings besides the publisher's actions.
+	AppRoleAdmin AppRole = "admin"
+)
+
+// appRoleActions 各角色允许的操作, 同 iam 操作
+var appRoleActions = map[AppRole][]string{
+	AppRoleViewer:    {"view"},
+	AppRoleEditor:    {"view", "view_secret", "update", "generate_release"},
+	AppRolePublisher: {"view", "view_secret", "update", "generate_release", "publish"},
+	AppRoleAdmin:     {"view", "view_secret", "update", "generate_release", "publish", "delete", "manage_role"},
+}
+
+// Validate app role.
+func (r AppRole) Validate(kit *kit.Kit) error {
+	if _, ok := appRoleActions[r]; !ok {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "unsupported app role: %s", r))
+	}
+
+	return nil
+}
+
+// Actions returns the actions granted to the role.
+func (r AppRole) Actions() []string {
+	return appRoleActions[r]
+}
+
+// Allowed returns whether the action is granted to the role.
+func (r AppRole) Allowed(action string) bool {
+	for _, one := range appRoleActions[r] {
+		if one == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AppRoleBinding binds a role of the app to a user, which grants the role's actions to the user
+// besides the iam permissions.
+type AppRoleBinding struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *AppRoleBindingSpec       `json:"spec" gorm:"embedded"`
+	Attachment *AppRoleBindingAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                 `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the app role binding's database table name.
+func (b *AppRoleBinding) TableName() string {
+	return "app_role_bindings"
+}
+
+// AppID AuditRes interface
+func (b *AppRoleBinding) AppID() uint32 {
+	return b.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (b *AppRoleBinding) ResID() uint32 {
+	return b.ID
+}
+
+// ResType AuditRes interface
+func (b *AppRoleBinding) ResType() string {
+	return "app_role_binding"
+}
+
+// ValidateCreate validate app role binding is valid or not when create it.
+func (b *AppRoleBinding) ValidateCreate(kit *kit.Kit) error {
+	if b.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if err := b.validate(kit); err != nil {
+		return err
+	}
+
+	return b.Revision.ValidateCreate()
+}
+
+// ValidateUpdate validate app role binding is valid or not when update it.
+func (b *AppRoleBinding) ValidateUpdate(kit *kit.Kit) error {
+	if b.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if err := b.validate(kit); err != nil {
+		return err
+	}
+
+	return b.Revision.ValidateUpdate()
+}
+
+func (b *AppRoleBinding) validate(kit *kit.Kit) error {
+	if b.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if b.Spec.User == "" {
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "user is required"))
+	}
+
+	if err := b.Spec.Role.Validate(kit); err != nil {
+		return err
+	}
+
+	if b.Attachment == nil || b.Attachment.BizID <= 0 || b.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	if b.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// MaxTemporaryAppRoleHours 临时授权的最长有效期
+const MaxTemporaryAppRoleHours = 30 * 24
+
+// AppRoleBindingSpec defines all the specifics for app role binding set by user.
+type AppRoleBindingSpec struct {
+	User string  `json:"user" gorm:"column:user"`
+	Role AppRole `json:"role" gorm:"column:role"`
+	// ExpiredAt 临时授权的过期时间, 为空表示永久授权, 过期后自动删除
+	ExpiredAt *time.Time `json:"expired_at" gorm:"column:expired_at"`
+	// Reason 授权原因, 如发布窗口期临时授予发布权限
+	Reason string `json:"reason" gorm:"column:reason"`
+}
+
+// Temporary returns whether the role binding is a temporary one.
+func (s *AppRoleBindingSpec) Temporary() bool {
+	return s.ExpiredAt != nil && !s.ExpiredAt.IsZero()
+}
+
+// Expired returns whether the temporary role binding is expired.
+func (s *AppRoleBindingSpec) Expired(now time.Time) bool {
+	return s.Temporary() && !now.Before(*s.ExpiredAt)
+}
+
+// AppRoleBindingAttachment defines the app role binding attachments.
+type AppRoleBindingAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestAppRoleAllowed(t *testing.T) {
+	cases := []struct {
+		role    AppRole
+		action  string
+		allowed bool
+	}{
+		{role: AppRoleViewer, action: "view", allowed: true},
+		{role: AppRoleViewer, action: "view_secret", allowed: false},
+		{role: AppRoleEditor, action: "update", allowed: true},
+		{role: AppRoleEditor, action: "publish", allowed: false},
+		{role: AppRolePublisher, action: "publish", allowed: true},
+		{role: AppRolePublisher, action: "delete", allowed: false},
+		{role: AppRoleAdmin, action: "manage_role", allowed: true},
+		{role: "owner", action: "view", allowed: false},
+	}
+
+	for _, c := range cases {
+		if got := c.role.Allowed(c.action); got != c.allowed {
+			t.Errorf("role %s action %s, expect %v, got %v", c.role, c.action, c.allowed, got)
+		}
+	}
+
+	if err := AppRole("owner").Validate(kit.New()); err == nil {
+		t.Errorf("role owner should be invalid")
+	}
+}
+
+func TestAppRoleBindingExpired(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Hour)
+
+	permanent := &AppRoleBindingSpec{User: "alice", Role: AppRoleEditor}
+	if permanent.Temporary() || permanent.Expired(now) {
+		t.Errorf("permanent role binding should not be temporary or expired")
+	}
+
+	if !(&AppRoleBindingSpec{ExpiredAt: &past}).Expired(now) {
+		t.Errorf("role binding expired at %v should be expired", past)
+	}
+	if (&AppRoleBindingSpec{ExpiredAt: &future}).Expired(now) {
+		t.Errorf("role binding expired at %v should not be expired", future)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaxAppSnapshotsPerApp is the max snapshot count of an app.
+const MaxAppSnapshotsPerApp = 20
+
+// AppSnapshot is a point-in-time snapshot of an app's unreleased editing workspace,
+// which is independent of releases and can be restored to the workspace.
+type AppSnapshot struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *AppSnapshotSpec       `json:"spec" gorm:"embedded"`
+	Attachment *AppSnapshotAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision       `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the app snapshot's database table name.
+func (a *AppSnapshot) TableName() string {
+	return "app_snapshots"
+}
+
+// AppID AuditRes interface
+func (a *AppSnapshot) AppID() uint32 {
+	return a.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (a *AppSnapshot) ResID() uint32 {
+	return a.ID
+}
+
+// ResType AuditRes interface
+func (a *AppSnapshot) ResType() string {
+	return "app_snapshot"
+}
+
+// ValidateCreate validate app snapshot is valid or not when create it.
+func (a *AppSnapshot) ValidateCreate(kit *kit.Kit) error {
+	if a.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if a.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := validator.ValidateName(kit, a.Spec.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, a.Spec.Memo, false); err != nil {
+		return err
+	}
+
+	if a.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := a.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if a.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return a.Revision.Validate()
+}
+
+// AppSnapshotSpec defines all the specifics for app snapshot set by user.
+type AppSnapshotSpec struct {
+	Name string          `json:"name" gorm:"column:name"`
+	Memo string          `json:"memo" gorm:"column:memo"`
+	Data AppSnapshotData `json:"data" gorm:"column:data;type:json"`
+}
+
+// AppSnapshotData is the editing workspace of an app when the snapshot is taken.
+type AppSnapshotData struct {
+	ConfigItems []*AppSnapshotConfigItem `json:"config_items"`
+	Kvs         []*AppSnapshotKv         `json:"kvs"`
+	// TemplateBinding is nil if the app is not bound to any template.
+	TemplateBinding *AppTemplateBindingSpec `json:"template_binding"`
+	Variables       AppVariables            `json:"variables"`
+}
+
+// AppSnapshotConfigItem is a config item with its latest committed content in snapshot.
+type AppSnapshotConfigItem struct {
+	ID      uint32          `json:"id"`
+	Spec    *ConfigItemSpec `json:"spec"`
+	Content *ContentSpec    `json:"content"`
+}
+
+// AppSnapshotKv is a kv in snapshot, the value is kept in vault with the version.
+type AppSnapshotKv struct {
+	Spec        *KvSpec      `json:"spec"`
+	ContentSpec *ContentSpec `json:"content_spec"`
+}
+
+// Value implements the driver.Valuer interface.
+func (d AppSnapshotData) Value() (driver.Value, error) {
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *AppSnapshotData) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, d)
+	case string:
+		return json.Unmarshal([]byte(v), d)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported app snapshot data raw type: %T", v)
+	}
+}
+
+// AppSnapshotAttachment defines the app snapshot attachments.
+type AppSnapshotAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether app snapshot attachment is valid or not.
+func (a *AppSnapshotAttachment) Validate() error {
+	if a.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if a.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
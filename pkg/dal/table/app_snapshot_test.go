@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestAppSnapshotValidateCreate(t *testing.T) {
+	snapshot := &AppSnapshot{
+		Spec:       &AppSnapshotSpec{Name: "before_upgrade", Memo: "snapshot before upgrade"},
+		Attachment: &AppSnapshotAttachment{BizID: 1, AppID: 2},
+		Revision:   &CreatedRevision{Creator: "admin"},
+	}
+	if err := snapshot.ValidateCreate(kit.New()); err != nil {
+		t.Errorf("validate app snapshot failed, err: %v", err)
+		return
+	}
+
+	snapshot.Spec.Name = ""
+	if err := snapshot.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("app snapshot without name should be invalid")
+		return
+	}
+
+	snapshot.Spec.Name = "before_upgrade"
+	snapshot.Attachment.AppID = 0
+	if err := snapshot.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("app snapshot without app id should be invalid")
+		return
+	}
+}
+
+func TestAppSnapshotDataValueScan(t *testing.T) {
+	data := AppSnapshotData{
+		ConfigItems: []*AppSnapshotConfigItem{{
+			ID:      3,
+			Spec:    &ConfigItemSpec{Name: "server.yaml", Path: "/etc"},
+			Content: &ContentSpec{Signature: "abc", ByteSize: 10},
+		}},
+		Variables: AppVariables{{Name: "bk_bscp_port", DefaultVal: "8080"}},
+	}
+	raw, err := data.Value()
+	if err != nil {
+		t.Errorf("marshal app snapshot data failed, err: %v", err)
+		return
+	}
+
+	got := new(AppSnapshotData)
+	if err = got.Scan(raw); err != nil {
+		t.Errorf("scan app snapshot data failed, err: %v", err)
+		return
+	}
+	if len(got.ConfigItems) != 1 || got.ConfigItems[0].Content.Signature != "abc" ||
+		got.ConfigItems[0].Spec.Name != "server.yaml" {
+		t.Errorf("unexpected config items in app snapshot data: %+v", got.ConfigItems)
+		return
+	}
+	if got.TemplateBinding != nil || len(got.Variables) != 1 {
+		t.Errorf("unexpected template binding or variables in app snapshot data")
+		return
+	}
+}
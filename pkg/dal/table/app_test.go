@@ -14,8 +14,43 @@ package table
 
 import (
 	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
 )
 
 func TestValidateApp(t *testing.T) {
 
 }
+
+func TestAppStateTransition(t *testing.T) {
+	kt := kit.New()
+
+	cases := []struct {
+		from  AppState
+		to    AppState
+		valid bool
+	}{
+		{from: "", to: AppStateFrozen, valid: true},
+		{from: AppStateActive, to: AppStateFrozen, valid: true},
+		{from: AppStateFrozen, to: AppStateArchived, valid: true},
+		{from: AppStateArchived, to: AppStateFrozen, valid: true},
+		{from: AppStateFrozen, to: AppStateActive, valid: true},
+		{from: "", to: AppStateActive, valid: false},
+		{from: AppStateActive, to: AppStateArchived, valid: false},
+		{from: AppStateArchived, to: AppStateActive, valid: false},
+		{from: AppStateActive, to: "deleted", valid: false},
+	}
+	for idx, c := range cases {
+		err := c.from.ValidateTransition(kt, c.to)
+		if c.valid && err != nil {
+			t.Errorf("case %d should be valid, err: %v", idx, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("case %d should be invalid", idx)
+		}
+	}
+
+	if !AppState("").Writable() || AppStateFrozen.Writable() || AppStateArchived.Writable() {
+		t.Errorf("only active app should be writable")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"time"
+)
+
+// BizUsageMonthLayout is the layout of the usage month.
+const BizUsageMonthLayout = "2006-01"
+
+// BizUsage is the monthly usage of a biz aggregated from the app consumption stats and the stored
+// contents, which is exported for chargeback.
+type BizUsage struct {
+	ID         uint32              `json:"id" gorm:"primaryKey"`
+	Spec       *BizUsageSpec       `json:"spec" gorm:"embedded"`
+	Attachment *BizUsageAttachment `json:"attachment" gorm:"embedded"`
+}
+
+// TableName is the biz usage's database table name.
+func (b *BizUsage) TableName() string {
+	return "biz_usages"
+}
+
+// ValidateCreate validate biz usage is valid or not when create it.
+func (b *BizUsage) ValidateCreate() error {
+	if b.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if b.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if _, err := time.Parse(BizUsageMonthLayout, b.Spec.Month); err != nil {
+		return errors.New("invalid month, should be like 2006-01")
+	}
+
+	if b.Attachment == nil || b.Attachment.BizID <= 0 {
+		return errors.New("biz id should be set")
+	}
+
+	return nil
+}
+
+// BizUsageSpec defines the usage of one month.
+type BizUsageSpec struct {
+	// Month 统计月份, 格式为 2006-01
+	Month string `json:"month" gorm:"column:month"`
+	// StorageBytes 存储的配置内容大小, 相同内容只计算一次, 为月内最后一次统计的快照
+	StorageBytes uint64 `json:"storage_bytes" gorm:"column:storage_bytes"`
+	// EgressBytes 下发的配置文件大小
+	EgressBytes uint64 `json:"egress_bytes" gorm:"column:egress_bytes"`
+	// APICalls 客户端拉取配置的请求数
+	APICalls uint64 `json:"api_calls" gorm:"column:api_calls"`
+	// WatchSeconds 客户端监听配置变更的累计时长
+	WatchSeconds uint64    `json:"watch_seconds" gorm:"column:watch_seconds"`
+	UpdatedAt    time.Time `json:"updated_at" gorm:"column:updated_at"`
+}
+
+// WatchHours returns the watch duration in hours.
+func (b *BizUsageSpec) WatchHours() float64 {
+	return float64(b.WatchSeconds) / 3600
+}
+
+// BizUsageAttachment defines the biz usage attachments.
+type BizUsageAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
+
+// MonthDateRange returns the first and last stat date of the month, e.g. 2025-06 returns
+// 2025-06-01 and 2025-06-30.
+func MonthDateRange(month string) (string, string, error) {
+	start, err := time.Parse(BizUsageMonthLayout, month)
+	if err != nil {
+		return "", "", errors.New("invalid month, should be like 2006-01")
+	}
+
+	end := start.AddDate(0, 1, -1)
+	return start.Format(AppConsumptionStatDateLayout), end.Format(AppConsumptionStatDateLayout), nil
+}
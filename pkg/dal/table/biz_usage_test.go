@@ -0,0 +1,32 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestMonthDateRange(t *testing.T) {
+	start, end, err := MonthDateRange("2024-02")
+	if err != nil {
+		t.Errorf("get month date range failed, err: %v", err)
+		return
+	}
+	if start != "2024-02-01" || end != "2024-02-29" {
+		t.Errorf("month date range of 2024-02 should be 2024-02-01 ~ 2024-02-29, but got %s ~ %s", start, end)
+		return
+	}
+
+	if _, _, err = MonthDateRange("2024-2-1"); err == nil {
+		t.Errorf("month 2024-2-1 should be invalid")
+		return
+	}
+}
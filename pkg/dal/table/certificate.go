@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+// Certificate is the certificate found in the unreleased config items and kvs, it's used to
+// warn before the certificate expires and renew it automatically.
+type Certificate struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *CertificateSpec       `json:"spec" gorm:"embedded"`
+	Attachment *CertificateAttachment `json:"attachment" gorm:"embedded"`
+	State      *CertificateState      `json:"state" gorm:"embedded"`
+}
+
+// TableName is the certificate's database table name.
+func (c *Certificate) TableName() string {
+	return "certificates"
+}
+
+// ValidateUpsert validate certificate is valid or not when create or update it.
+func (c *Certificate) ValidateUpsert() error {
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.ResType.Validate(); err != nil {
+		return err
+	}
+
+	if c.Spec.ResID == 0 {
+		return errors.New("resource id not set")
+	}
+
+	if c.Spec.NotAfter.IsZero() {
+		return errors.New("certificate not after not set")
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if c.Attachment.BizID == 0 || c.Attachment.AppID == 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	if c.State == nil {
+		return errors.New("state not set")
+	}
+
+	return nil
+}
+
+// CertResType is the resource type which the certificate is found in.
+type CertResType string
+
+const (
+	// CertConfigItem is the config item of file app.
+	CertConfigItem CertResType = "config_item"
+	// CertKv is the kv of kv app.
+	CertKv CertResType = "kv"
+)
+
+// Validate the certificate resource type is valid or not.
+func (t CertResType) Validate() error {
+	switch t {
+	case CertConfigItem, CertKv:
+	default:
+		return fmt.Errorf("unsupported certificate resource type: %s", t)
+	}
+
+	return nil
+}
+
+// CertificateSpec defines all the specifics for certificate.
+type CertificateSpec struct {
+	ResType CertResType `json:"res_type" gorm:"column:res_type"`
+	ResID   uint32      `json:"res_id" gorm:"column:res_id"`
+	// Name is the absolute path of config item or the key of kv.
+	Name string `json:"name" gorm:"column:name"`
+	// Signature is the sha256 of the content, used to detect whether the certificate should be re-parsed.
+	Signature string    `json:"signature" gorm:"column:signature"`
+	Subject   string    `json:"subject" gorm:"column:subject"`
+	DNSNames  string    `json:"dns_names" gorm:"column:dns_names"`
+	NotBefore time.Time `json:"not_before" gorm:"column:not_before"`
+	NotAfter  time.Time `json:"not_after" gorm:"column:not_after"`
+}
+
+// CertificateAttachment defines the certificate attachments.
+type CertificateAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// CertRenewStatus is the auto-renewal status of the certificate.
+type CertRenewStatus string
+
+const (
+	// CertRenewNone 未续期
+	CertRenewNone CertRenewStatus = ""
+	// CertRenewSucceed 已续期，新证书保存为未上线配置，等待发布
+	CertRenewSucceed CertRenewStatus = "succeed"
+	// CertRenewFailed 续期失败
+	CertRenewFailed CertRenewStatus = "failed"
+)
+
+// CertificateState defines the warning and renewal state of the certificate.
+type CertificateState struct {
+	// WarnedAt is the last time the expiry warning event is emitted.
+	WarnedAt     *time.Time      `json:"warned_at" gorm:"column:warned_at"`
+	RenewStatus  CertRenewStatus `json:"renew_status" gorm:"column:renew_status"`
+	RenewMessage string          `json:"renew_message" gorm:"column:renew_message"`
+	ScannedAt    time.Time       `json:"scanned_at" gorm:"column:scanned_at"`
+}
+
+// IsExpiring returns whether the certificate expires within the days.
+func (s *CertificateSpec) IsExpiring(now time.Time, days uint) bool {
+	return s.NotAfter.Before(now.AddDate(0, 0, int(days)))
+}
+
+// CertInfo is the parsed leaf certificate info.
+type CertInfo struct {
+	Subject   string    `json:"subject"`
+	DNSNames  []string  `json:"dns_names"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+}
+
+func newCertInfo(leaf *x509.Certificate) *CertInfo {
+	return &CertInfo{
+		Subject:   leaf.Subject.String(),
+		DNSNames:  leaf.DNSNames,
+		NotBefore: leaf.NotBefore.UTC(),
+		NotAfter:  leaf.NotAfter.UTC(),
+	}
+}
+
+// ParsePemCertificate parse the first certificate of the PEM encoded content, which is the leaf certificate.
+func ParsePemCertificate(data []byte) (*CertInfo, error) {
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		leaf, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate, err: %v", err)
+		}
+		return newCertInfo(leaf), nil
+	}
+
+	return nil, errors.New("no certificate found")
+}
+
+// certFileExts is the file extensions of the config items which may be a certificate.
+var certFileExts = map[string]bool{".pem": true, ".crt": true, ".cer": true, ".cert": true}
+
+// IsCertFileName returns whether the config item may be a certificate by its file name.
+func IsCertFileName(name string) bool {
+	return certFileExts[strings.ToLower(path.Ext(name))]
+}
@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsCertFileName(t *testing.T) {
+	cases := map[string]bool{
+		"server.pem":  true,
+		"server.CRT":  true,
+		"ca.cer":      true,
+		"tls.cert":    true,
+		"server.key":  false,
+		"config.yaml": false,
+	}
+	for name, expect := range cases {
+		if IsCertFileName(name) != expect {
+			t.Errorf("file %s is cert should be %v", name, expect)
+		}
+	}
+}
+
+func TestCertificateSpecIsExpiring(t *testing.T) {
+	now := time.Now()
+	spec := &CertificateSpec{NotAfter: now.AddDate(0, 0, 10)}
+	if !spec.IsExpiring(now, 30) {
+		t.Errorf("certificate expires in 10 days should be expiring within 30 days")
+	}
+	if spec.IsExpiring(now, 5) {
+		t.Errorf("certificate expires in 10 days should not be expiring within 5 days")
+	}
+
+	if _, err := ParsePemCertificate([]byte("invalid")); err == nil {
+		t.Errorf("parse invalid certificate should be failed")
+	}
+}
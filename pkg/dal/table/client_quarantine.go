@@ -0,0 +1,208 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+const (
+	// MaxClientQuarantinesPerBiz is the max client quarantine count of one biz.
+	MaxClientQuarantinesPerBiz = 200
+	// DefaultQuarantineRetryAfterSec is the default backoff hint answered to the quarantined clients.
+	DefaultQuarantineRetryAfterSec = 300
+	// MaxQuarantineRetryAfterSec is the max backoff hint answered to the quarantined clients.
+	MaxQuarantineRetryAfterSec = 24 * 3600
+)
+
+// ClientQuarantine is an exclusion of the clients matched by fingerprint or ip, feed server rejects
+// the handshakes and watches of them without revoking the credential shared with the healthy clients,
+// e.g. a looping buggy agent build.
+type ClientQuarantine struct {
+	ID         uint32                      `json:"id" gorm:"primaryKey"`
+	Spec       *ClientQuarantineSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ClientQuarantineAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                   `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the client quarantine's database table name.
+func (c *ClientQuarantine) TableName() string {
+	return "client_quarantines"
+}
+
+// AppID AuditRes interface
+func (c *ClientQuarantine) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (c *ClientQuarantine) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ClientQuarantine) ResType() string {
+	return "client_quarantine"
+}
+
+// ValidateCreate validate client quarantine is valid or not when create it.
+func (c *ClientQuarantine) ValidateCreate(kit *kit.Kit) error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return c.validate(kit)
+}
+
+// ValidateUpdate validate client quarantine is valid or not when update it.
+func (c *ClientQuarantine) ValidateUpdate(kit *kit.Kit) error {
+	if c.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return c.validate(kit)
+}
+
+func (c *ClientQuarantine) validate(kit *kit.Kit) error {
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil || c.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// QuarantineMatchType is how the quarantine matches the clients.
+type QuarantineMatchType string
+
+const (
+	// QuarantineByFingerprint matches the client by the fingerprint reported in the sidecar meta.
+	QuarantineByFingerprint QuarantineMatchType = "fingerprint"
+	// QuarantineByIP matches the client by the real ip, the value can be an ip or a cidr.
+	QuarantineByIP QuarantineMatchType = "ip"
+)
+
+// Validate quarantine match type.
+func (t QuarantineMatchType) Validate() error {
+	switch t {
+	case QuarantineByFingerprint, QuarantineByIP:
+	default:
+		return fmt.Errorf("unsupported quarantine match type: %s", t)
+	}
+
+	return nil
+}
+
+// ClientQuarantineSpec defines all the specifics for client quarantine set by user.
+type ClientQuarantineSpec struct {
+	MatchType QuarantineMatchType `json:"match_type" gorm:"column:match_type"`
+	// Value 客户端指纹, 或者 IP/CIDR
+	Value string `json:"value" gorm:"column:value"`
+	// Reason 返回给客户端的隔离原因
+	Reason string `json:"reason" gorm:"column:reason"`
+	// RetryAfterSec 返回给客户端的退避时间
+	RetryAfterSec uint32 `json:"retry_after_sec" gorm:"column:retry_after_sec"`
+	// ExpiredAt 隔离的过期时间, 为空时永久生效
+	ExpiredAt *time.Time `json:"expired_at" gorm:"column:expired_at"`
+	Enabled   bool       `json:"enabled" gorm:"column:enabled"`
+}
+
+// Validate client quarantine spec.
+func (c *ClientQuarantineSpec) Validate(kit *kit.Kit) error {
+	if err := c.MatchType.Validate(); err != nil {
+		return err
+	}
+
+	c.Value = strings.TrimSpace(c.Value)
+	if c.Value == "" {
+		return errors.New("quarantine value is required")
+	}
+
+	if c.MatchType == QuarantineByIP && net.ParseIP(c.Value) == nil {
+		if _, _, err := net.ParseCIDR(c.Value); err != nil {
+			return fmt.Errorf("invalid quarantine ip or cidr %s", c.Value)
+		}
+	}
+
+	if err := validator.ValidateMemo(kit, c.Reason, false); err != nil {
+		return err
+	}
+
+	if c.RetryAfterSec == 0 {
+		c.RetryAfterSec = DefaultQuarantineRetryAfterSec
+	}
+	if c.RetryAfterSec > MaxQuarantineRetryAfterSec {
+		return fmt.Errorf("retry after should not exceed %d seconds", MaxQuarantineRetryAfterSec)
+	}
+
+	return nil
+}
+
+// Active returns whether the quarantine is in effect at the time.
+func (c *ClientQuarantineSpec) Active(now time.Time) bool {
+	return c.Enabled && (c.ExpiredAt == nil || c.ExpiredAt.After(now))
+}
+
+// Match returns whether the client with the fingerprint and ip is quarantined.
+func (c *ClientQuarantineSpec) Match(fingerprint, ip string) bool {
+	switch c.MatchType {
+	case QuarantineByFingerprint:
+		return fingerprint != "" && fingerprint == c.Value
+	case QuarantineByIP:
+		if ip == "" {
+			return false
+		}
+		if !strings.Contains(c.Value, "/") {
+			return ip == c.Value
+		}
+		_, ipNet, err := net.ParseCIDR(c.Value)
+		if err != nil {
+			return false
+		}
+		parsed := net.ParseIP(ip)
+		return parsed != nil && ipNet.Contains(parsed)
+	default:
+		return false
+	}
+}
+
+// RetryAfter returns the backoff hint answered to the quarantined clients.
+func (c *ClientQuarantineSpec) RetryAfter() time.Duration {
+	if c.RetryAfterSec == 0 {
+		return DefaultQuarantineRetryAfterSec * time.Second
+	}
+	return time.Duration(c.RetryAfterSec) * time.Second
+}
+
+// ClientQuarantineAttachment defines the client quarantine attachments.
+type ClientQuarantineAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
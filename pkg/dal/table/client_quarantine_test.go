@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClientQuarantineSpecMatch(t *testing.T) {
+	spec := &ClientQuarantineSpec{MatchType: QuarantineByFingerprint, Value: "fp-1", Enabled: true}
+	if !spec.Match("fp-1", "10.0.0.1") || spec.Match("fp-2", "10.0.0.1") {
+		t.Errorf("fingerprint quarantine should only match the fingerprint")
+	}
+
+	spec = &ClientQuarantineSpec{MatchType: QuarantineByIP, Value: "10.0.0.0/24", Enabled: true}
+	if !spec.Match("", "10.0.0.8") || spec.Match("", "10.0.1.8") || spec.Match("", "") {
+		t.Errorf("cidr quarantine should only match the ips in the cidr")
+	}
+	spec.Value = "10.0.0.8"
+	if !spec.Match("fp-1", "10.0.0.8") || spec.Match("fp-1", "10.0.0.9") {
+		t.Errorf("ip quarantine should only match the ip")
+	}
+
+	now := time.Now()
+	past := now.Add(-time.Minute)
+	spec.ExpiredAt = &past
+	if spec.Active(now) {
+		t.Errorf("expired quarantine should not be active")
+	}
+	spec.ExpiredAt = nil
+	spec.Enabled = false
+	if spec.Active(now) {
+		t.Errorf("disabled quarantine should not be active")
+	}
+	if spec.RetryAfter() != DefaultQuarantineRetryAfterSec*time.Second {
+		t.Errorf("unexpected default retry after: %s", spec.RetryAfter())
+	}
+}
@@ -0,0 +1,467 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+const (
+	// MaxCompliancePoliciesPerBiz is the max compliance policy count of one biz.
+	MaxCompliancePoliciesPerBiz = 100
+	// maxComplianceViolationsPerItem is the max violations reported for one config item or kv by a policy.
+	maxComplianceViolationsPerItem = 10
+)
+
+// CompliancePolicy is a rule written by the biz admins which is evaluated on the config items and kvs
+// when a release is created or published, the violations block the release or only warn the user
+// according to the policy's severity.
+type CompliancePolicy struct {
+	ID         uint32                      `json:"id" gorm:"primaryKey"`
+	Spec       *CompliancePolicySpec       `json:"spec" gorm:"embedded"`
+	Attachment *CompliancePolicyAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                   `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the compliance policy's database table name.
+func (c *CompliancePolicy) TableName() string {
+	return "compliance_policies"
+}
+
+// AppID AuditRes interface
+func (c *CompliancePolicy) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (c *CompliancePolicy) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *CompliancePolicy) ResType() string {
+	return "compliance_policy"
+}
+
+// ValidateCreate validate compliance policy is valid or not when create it.
+func (c *CompliancePolicy) ValidateCreate(kit *kit.Kit) error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate compliance policy is valid or not when update it.
+func (c *CompliancePolicy) ValidateUpdate(kit *kit.Kit) error {
+	if c.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// CompliancePolicySpec defines all the specifics for compliance policy set by user.
+type CompliancePolicySpec struct {
+	Name     string             `json:"name" gorm:"column:name"`
+	Memo     string             `json:"memo" gorm:"column:memo"`
+	Severity ComplianceSeverity `json:"severity" gorm:"column:severity"`
+	Enabled  bool               `json:"enabled" gorm:"column:enabled"`
+	// Target 规则作用的配置类型, 为空时同时作用于配置文件和配置项
+	Target ComplianceTarget `json:"target" gorm:"column:target"`
+	// EnvIDs 规则生效的环境, 为空时对业务下所有服务生效
+	EnvIDs ComplianceEnvIDs `json:"env_ids" gorm:"column:env_ids;type:json"`
+	Rule   ComplianceRule   `json:"rule" gorm:"column:rule;type:json"`
+}
+
+// Validate compliance policy spec.
+func (c *CompliancePolicySpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, c.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, c.Memo, false); err != nil {
+		return err
+	}
+
+	if err := c.Severity.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.Target.Validate(); err != nil {
+		return err
+	}
+
+	return c.Rule.Validate()
+}
+
+// MatchEnv returns whether the policy takes effect in the environment, envID is 0 if the app
+// is not bound to any environment.
+func (c *CompliancePolicySpec) MatchEnv(envID uint32) bool {
+	if len(c.EnvIDs) == 0 {
+		return true
+	}
+
+	for _, id := range c.EnvIDs {
+		if id == envID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Evaluate the config item or kv with the policy, name is the absolute path of the config item
+// or the key of the kv.
+func (c *CompliancePolicySpec) Evaluate(target ComplianceTarget, name, content string) []*ComplianceViolation {
+	if c.Target != "" && c.Target != target {
+		return nil
+	}
+
+	if !c.Rule.MatchName(name) {
+		return nil
+	}
+
+	messages := c.Rule.Evaluate(content)
+	violations := make([]*ComplianceViolation, 0, len(messages))
+	for _, msg := range messages {
+		violations = append(violations, &ComplianceViolation{
+			PolicyName: c.Name,
+			Severity:   c.Severity,
+			Target:     target,
+			Name:       name,
+			Message:    msg,
+		})
+	}
+
+	return violations
+}
+
+const (
+	// ComplianceBlock means the release can not be created or published when the policy is violated.
+	ComplianceBlock ComplianceSeverity = "block"
+	// ComplianceWarn means the violations are only returned to the user as warnings.
+	ComplianceWarn ComplianceSeverity = "warn"
+)
+
+// ComplianceSeverity is the severity of a compliance policy.
+type ComplianceSeverity string
+
+// Validate the compliance severity.
+func (s ComplianceSeverity) Validate() error {
+	switch s {
+	case ComplianceBlock, ComplianceWarn:
+	default:
+		return fmt.Errorf("unsupported compliance severity: %s", s)
+	}
+
+	return nil
+}
+
+const (
+	// ComplianceTargetFile means the policy is evaluated on the config items of file apps.
+	ComplianceTargetFile ComplianceTarget = "file"
+	// ComplianceTargetKv means the policy is evaluated on the kvs of kv apps.
+	ComplianceTargetKv ComplianceTarget = "kv"
+)
+
+// ComplianceTarget is the config type which the compliance policy is evaluated on.
+type ComplianceTarget string
+
+// Validate the compliance target.
+func (t ComplianceTarget) Validate() error {
+	switch t {
+	case "", ComplianceTargetFile, ComplianceTargetKv:
+	default:
+		return fmt.Errorf("unsupported compliance target: %s", t)
+	}
+
+	return nil
+}
+
+const (
+	// ComplianceRuleDeny violated when the content has lines matching the pattern, e.g. plaintext password.
+	ComplianceRuleDeny ComplianceRuleType = "deny"
+	// ComplianceRuleRequire violated when the content has no line matching the pattern.
+	ComplianceRuleRequire ComplianceRuleType = "require"
+	// ComplianceRuleCompare violated when the value does not satisfy the comparison, the value is the
+	// first capture group of the pattern if the pattern is set, otherwise the whole content.
+	ComplianceRuleCompare ComplianceRuleType = "compare"
+)
+
+// ComplianceRuleType is the type of compliance rule.
+type ComplianceRuleType string
+
+// ComplianceRule is the rule of a compliance policy.
+type ComplianceRule struct {
+	Type ComplianceRuleType `json:"type"`
+	// NamePattern 限定规则检查的配置文件绝对路径或配置项 key, 支持通配符, 为空时检查全部
+	NamePattern string `json:"name_pattern"`
+	// Pattern 正则表达式, compare 类型时取第一个捕获组作为比较的值
+	Pattern string `json:"pattern"`
+	// Op 比较操作符: eq, ne, gt, ge, lt, le, 仅 compare 类型有效
+	Op string `json:"op"`
+	// Expected 比较的目标值, 仅 compare 类型有效
+	Expected string `json:"expected"`
+}
+
+// Validate the compliance rule.
+func (r ComplianceRule) Validate() error {
+	if r.NamePattern != "" {
+		if _, err := filepath.Match(r.NamePattern, ""); err != nil {
+			return fmt.Errorf("invalid name pattern %s, err: %v", r.NamePattern, err)
+		}
+	}
+
+	var re *regexp.Regexp
+	if r.Pattern != "" {
+		var err error
+		if re, err = regexp.Compile(r.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern %s, err: %v", r.Pattern, err)
+		}
+	}
+
+	switch r.Type {
+	case ComplianceRuleDeny, ComplianceRuleRequire:
+		if re == nil {
+			return fmt.Errorf("pattern is required by %s rule", r.Type)
+		}
+	case ComplianceRuleCompare:
+		if re != nil && re.NumSubexp() < 1 {
+			return errors.New("pattern of compare rule should have a capture group")
+		}
+		switch r.Op {
+		case "eq", "ne":
+		case "gt", "ge", "lt", "le":
+			if _, err := strconv.ParseFloat(r.Expected, 64); err != nil {
+				return fmt.Errorf("value %s should be a number for op %s", r.Expected, r.Op)
+			}
+		default:
+			return fmt.Errorf("unsupported compare op: %s", r.Op)
+		}
+	default:
+		return fmt.Errorf("unsupported compliance rule type: %s", r.Type)
+	}
+
+	return nil
+}
+
+// MatchName returns whether the config item or kv should be checked by the rule.
+func (r ComplianceRule) MatchName(name string) bool {
+	if r.NamePattern == "" {
+		return true
+	}
+
+	matched, err := filepath.Match(r.NamePattern, name)
+	return err == nil && matched
+}
+
+// Evaluate the content with the rule, returns the violation messages. the matched content is not
+// returned in the messages, because it may be a secret.
+func (r ComplianceRule) Evaluate(content string) []string {
+	var re *regexp.Regexp
+	if r.Pattern != "" {
+		var err error
+		if re, err = regexp.Compile(r.Pattern); err != nil {
+			return []string{fmt.Sprintf("invalid pattern %s", r.Pattern)}
+		}
+	}
+
+	switch r.Type {
+	case ComplianceRuleDeny:
+		messages := make([]string, 0)
+		for idx, line := range strings.Split(content, "\n") {
+			if !re.MatchString(line) {
+				continue
+			}
+			messages = append(messages, fmt.Sprintf("line %d matches the denied pattern", idx+1))
+			if len(messages) >= maxComplianceViolationsPerItem {
+				break
+			}
+		}
+		return messages
+	case ComplianceRuleRequire:
+		if re.MatchString(content) {
+			return nil
+		}
+		return []string{"no content matches the required pattern"}
+	case ComplianceRuleCompare:
+		values := []string{strings.TrimSpace(content)}
+		if re != nil {
+			values = values[:0]
+			for _, m := range re.FindAllStringSubmatch(content, maxComplianceViolationsPerItem) {
+				values = append(values, strings.TrimSpace(m[1]))
+			}
+		}
+
+		messages := make([]string, 0)
+		for _, v := range values {
+			if !r.compare(v) {
+				messages = append(messages, fmt.Sprintf("value %s does not satisfy %s %s", v, r.Op, r.Expected))
+			}
+		}
+		return messages
+	}
+
+	return nil
+}
+
+// compare the value with the rule's target value.
+func (r ComplianceRule) compare(value string) bool {
+	switch r.Op {
+	case "eq":
+		return value == r.Expected
+	case "ne":
+		return value != r.Expected
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	target, err := strconv.ParseFloat(r.Expected, 64)
+	if err != nil {
+		return false
+	}
+
+	switch r.Op {
+	case "gt":
+		return v > target
+	case "ge":
+		return v >= target
+	case "lt":
+		return v < target
+	case "le":
+		return v <= target
+	}
+
+	return false
+}
+
+// Value implements the driver.Valuer interface.
+func (r ComplianceRule) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *ComplianceRule) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compliance rule raw type: %T", v)
+	}
+}
+
+// ComplianceEnvIDs is the environment ids which the compliance policy takes effect in.
+type ComplianceEnvIDs []uint32
+
+// Value implements the driver.Valuer interface.
+func (e ComplianceEnvIDs) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	return json.Marshal(e)
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *ComplianceEnvIDs) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported compliance env ids raw type: %T", v)
+	}
+}
+
+// CompliancePolicyAttachment defines the compliance policy attachments.
+type CompliancePolicyAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
+
+// Validate whether compliance policy attachment is valid or not.
+func (c *CompliancePolicyAttachment) Validate() error {
+	if c.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	return nil
+}
+
+// ComplianceViolation is a violation of a compliance policy found in a config item or kv.
+type ComplianceViolation struct {
+	PolicyID   uint32             `json:"policy_id"`
+	PolicyName string             `json:"policy_name"`
+	Severity   ComplianceSeverity `json:"severity"`
+	Target     ComplianceTarget   `json:"target"`
+	Name       string             `json:"name"`
+	Message    string             `json:"message"`
+}
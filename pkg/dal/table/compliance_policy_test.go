@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestComplianceRuleEvaluate(t *testing.T) {
+	deny := ComplianceRule{Type: ComplianceRuleDeny, Pattern: `(?i)password\s*=\s*\S+`}
+	if err := deny.Validate(); err != nil {
+		t.Errorf("validate deny rule failed, err: %v", err)
+		return
+	}
+	if msgs := deny.Evaluate("user=admin\npassword=123456\n"); len(msgs) != 1 {
+		t.Errorf("plaintext password should be denied, got %v", msgs)
+	}
+
+	port := ComplianceRule{Type: ComplianceRuleCompare, NamePattern: "/etc/*.conf", Pattern: `listen\s+(\d+)`,
+		Op: "gt", Expected: "1024"}
+	if err := port.Validate(); err != nil {
+		t.Errorf("validate compare rule failed, err: %v", err)
+		return
+	}
+	if !port.MatchName("/etc/nginx.conf") || port.MatchName("/data/nginx.conf") {
+		t.Errorf("name pattern is not matched as expected")
+	}
+	if msgs := port.Evaluate("listen 80;\nlisten 8080;"); len(msgs) != 1 {
+		t.Errorf("listen port 80 should violate the rule, got %v", msgs)
+	}
+
+	replicas := ComplianceRule{Type: ComplianceRuleCompare, Op: "le", Expected: "10"}
+	if msgs := replicas.Evaluate("12"); len(msgs) != 1 {
+		t.Errorf("replicas 12 should violate the rule, got %v", msgs)
+	}
+	if msgs := replicas.Evaluate(" 3 "); len(msgs) != 0 {
+		t.Errorf("replicas 3 should satisfy the rule, got %v", msgs)
+	}
+
+	invalid := ComplianceRule{Type: ComplianceRuleCompare, Pattern: `listen \d+`, Op: "gt", Expected: "1"}
+	if err := invalid.Validate(); err == nil {
+		t.Errorf("compare rule pattern without capture group should be rejected")
+	}
+}
+
+func TestCompliancePolicySpecScope(t *testing.T) {
+	spec := &CompliancePolicySpec{
+		Name:     "replicas",
+		Severity: ComplianceBlock,
+		Target:   ComplianceTargetKv,
+		EnvIDs:   ComplianceEnvIDs{2},
+		Rule:     ComplianceRule{Type: ComplianceRuleCompare, NamePattern: "replicas", Op: "le", Expected: "10"},
+	}
+	if !spec.MatchEnv(2) || spec.MatchEnv(0) {
+		t.Errorf("policy should only take effect in environment 2")
+	}
+	if v := spec.Evaluate(ComplianceTargetFile, "replicas", "12"); len(v) != 0 {
+		t.Errorf("kv policy should not be evaluated on file")
+	}
+	if v := spec.Evaluate(ComplianceTargetKv, "replicas", "12"); len(v) != 1 || v[0].Severity != ComplianceBlock {
+		t.Errorf("replicas 12 should be blocked, got %v", v)
+	}
+}
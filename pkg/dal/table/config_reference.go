@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// ConfigReference is a reference declared by user between two configs in a biz, e.g. a config file
+// includes another one, or an app consumes a kv of another app. the referenced config can not be
+// deleted until all the references to it are removed.
+type ConfigReference struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *ConfigReferenceSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ConfigReferenceAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision           `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the config reference's database table name.
+func (c *ConfigReference) TableName() string {
+	return "config_references"
+}
+
+// AppID AuditRes interface
+func (c *ConfigReference) AppID() uint32 {
+	return c.Attachment.SrcAppID
+}
+
+// ResID AuditRes interface
+func (c *ConfigReference) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ConfigReference) ResType() string {
+	return "config_reference"
+}
+
+// ValidateCreate validate config reference is valid or not when create it.
+func (c *ConfigReference) ValidateCreate(kit *kit.Kit) error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if c.Attachment.BizID <= 0 || c.Attachment.SrcAppID <= 0 || c.Attachment.DstAppID <= 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return c.Revision.Validate()
+}
+
+// ConfigRefNodeType is the type of the config in reference graph.
+type ConfigRefNodeType string
+
+const (
+	// ConfigRefApp is the app node.
+	ConfigRefApp ConfigRefNodeType = "app"
+	// ConfigRefConfigItem is the config item node of file app.
+	ConfigRefConfigItem ConfigRefNodeType = "config_item"
+	// ConfigRefKv is the kv node of kv app.
+	ConfigRefKv ConfigRefNodeType = "kv"
+)
+
+// Validate the config reference node type is valid or not.
+func (t ConfigRefNodeType) Validate() error {
+	switch t {
+	case ConfigRefApp, ConfigRefConfigItem, ConfigRefKv:
+	default:
+		return fmt.Errorf("unsupported config reference node type: %s", t)
+	}
+
+	return nil
+}
+
+// ConfigRefType is the type of the reference.
+type ConfigRefType string
+
+const (
+	// ConfigRefInclude means the source config file includes the destination config file.
+	ConfigRefInclude ConfigRefType = "include"
+	// ConfigRefConsume means the source config consumes the destination config.
+	ConfigRefConsume ConfigRefType = "consume"
+	// ConfigRefShare means the source app consumes the destination config by config share,
+	// it's derived from the config share refs and can not be declared by user.
+	ConfigRefShare ConfigRefType = "share"
+)
+
+// Validate the config reference type is valid or not when declared by user.
+func (t ConfigRefType) Validate() error {
+	switch t {
+	case ConfigRefInclude, ConfigRefConsume:
+	default:
+		return fmt.Errorf("unsupported config reference type: %s", t)
+	}
+
+	return nil
+}
+
+// ConfigReferenceSpec defines all the specifics for config reference set by user.
+type ConfigReferenceSpec struct {
+	SrcType ConfigRefNodeType `json:"src_type" gorm:"column:src_type"`
+	SrcID   uint32            `json:"src_id" gorm:"column:src_id"`
+	DstType ConfigRefNodeType `json:"dst_type" gorm:"column:dst_type"`
+	DstID   uint32            `json:"dst_id" gorm:"column:dst_id"`
+	RefType ConfigRefType     `json:"ref_type" gorm:"column:ref_type"`
+	Memo    string            `json:"memo" gorm:"column:memo"`
+}
+
+// Validate config reference spec.
+func (c *ConfigReferenceSpec) Validate(kit *kit.Kit) error {
+	if err := c.SrcType.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.DstType.Validate(); err != nil {
+		return err
+	}
+
+	if c.SrcID <= 0 || c.DstID <= 0 {
+		return errors.New("source id and destination id should be set")
+	}
+
+	if c.SrcType == c.DstType && c.SrcID == c.DstID {
+		return errors.New("config can not reference itself")
+	}
+
+	if err := c.RefType.Validate(); err != nil {
+		return err
+	}
+
+	// 只有配置文件之间才存在包含关系
+	if c.RefType == ConfigRefInclude && (c.SrcType != ConfigRefConfigItem || c.DstType != ConfigRefConfigItem) {
+		return errors.New("include reference is only supported between config items")
+	}
+
+	return validator.ValidateMemo(kit, c.Memo, false)
+}
+
+// ConfigReferenceAttachment defines the config reference attachments.
+type ConfigReferenceAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// SrcAppID and DstAppID is the app which the source and destination config belongs to.
+	SrcAppID uint32 `json:"src_app_id" gorm:"column:src_app_id"`
+	DstAppID uint32 `json:"dst_app_id" gorm:"column:dst_app_id"`
+}
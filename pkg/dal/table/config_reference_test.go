@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestConfigReferenceSpecValidate(t *testing.T) {
+	spec := &ConfigReferenceSpec{
+		SrcType: ConfigRefConfigItem,
+		SrcID:   1,
+		DstType: ConfigRefConfigItem,
+		DstID:   2,
+		RefType: ConfigRefInclude,
+	}
+	if err := spec.Validate(kit.New()); err != nil {
+		t.Errorf("validate config reference spec failed, err: %v", err)
+		return
+	}
+
+	spec.DstID = 1
+	if err := spec.Validate(kit.New()); err == nil {
+		t.Errorf("config reference to itself should be invalid")
+		return
+	}
+
+	spec.DstType, spec.DstID = ConfigRefKv, 1
+	if err := spec.Validate(kit.New()); err == nil {
+		t.Errorf("include reference to kv should be invalid")
+		return
+	}
+
+	spec.RefType = ConfigRefConsume
+	if err := spec.Validate(kit.New()); err != nil {
+		t.Errorf("validate config reference spec failed, err: %v", err)
+		return
+	}
+
+	spec.RefType = ConfigRefShare
+	if err := spec.Validate(kit.New()); err == nil {
+		t.Errorf("share reference is derived from config share, it should not be declared")
+		return
+	}
+
+	spec.RefType, spec.SrcType = ConfigRefConsume, "release"
+	if err := spec.Validate(kit.New()); err == nil {
+		t.Errorf("config reference with unsupported node type should be invalid")
+		return
+	}
+}
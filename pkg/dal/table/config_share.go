@@ -0,0 +1,315 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaxConfigShareGrantees is the max grantee biz count of a config share.
+const MaxConfigShareGrantees = 100
+
+// ConfigShare exposes a config item or kv of an app to the other bizs as a read-only
+// reference, the granted bizs' apps always consume the latest released content of it.
+type ConfigShare struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *ConfigShareSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ConfigShareAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision              `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the config share's database table name.
+func (c *ConfigShare) TableName() string {
+	return "config_shares"
+}
+
+// AppID AuditRes interface
+func (c *ConfigShare) AppID() uint32 {
+	return c.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (c *ConfigShare) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ConfigShare) ResType() string {
+	return "config_share"
+}
+
+// ValidateCreate validate config share is valid or not when create it.
+func (c *ConfigShare) ValidateCreate(kit *kit.Kit) error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := c.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Spec.Grantees.Has(c.Attachment.BizID) {
+		return errors.New("config share can not be granted to its own biz")
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return c.Revision.ValidateCreate()
+}
+
+// ValidateUpdate validate config share is valid or not when update it.
+func (c *ConfigShare) ValidateUpdate(kit *kit.Kit) error {
+	if c.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := validator.ValidateMemo(kit, c.Spec.Memo, false); err != nil {
+		return err
+	}
+
+	if err := c.Spec.Grantees.Validate(); err != nil {
+		return err
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := c.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if c.Spec.Grantees.Has(c.Attachment.BizID) {
+		return errors.New("config share can not be granted to its own biz")
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return c.Revision.ValidateUpdate()
+}
+
+// ConfigShareSpec defines all the specifics for config share set by user.
+type ConfigShareSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	// ConfigType is the type of the shared config, file or kv.
+	ConfigType ConfigType `json:"config_type" gorm:"column:config_type"`
+	// ConfigItemID is the shared config item's id when the config type is file.
+	ConfigItemID uint32 `json:"config_item_id" gorm:"column:config_item_id"`
+	// Key is the shared kv's key when the config type is kv.
+	Key      string            `json:"key" gorm:"column:key"`
+	Grantees ConfigShareBizIDs `json:"grantees" gorm:"column:grantees;type:json"`
+	Memo     string            `json:"memo" gorm:"column:memo"`
+}
+
+// Validate config share spec.
+func (c *ConfigShareSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, c.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, c.Memo, false); err != nil {
+		return err
+	}
+
+	switch c.ConfigType {
+	case File:
+		if c.ConfigItemID <= 0 {
+			return errors.New("config item id should be set for file config share")
+		}
+		if c.Key != "" {
+			return errors.New("key should not be set for file config share")
+		}
+	case KV:
+		if c.Key == "" {
+			return errors.New("key should be set for kv config share")
+		}
+		if c.ConfigItemID > 0 {
+			return errors.New("config item id should not be set for kv config share")
+		}
+	default:
+		return fmt.Errorf("unsupported config share type: %s", c.ConfigType)
+	}
+
+	return c.Grantees.Validate()
+}
+
+// ConfigShareAttachment defines the config share attachments.
+type ConfigShareAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether config share attachment is valid or not.
+func (c *ConfigShareAttachment) Validate() error {
+	if c.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if c.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// ConfigShareBizIDs is the biz ids which are granted to consume a config share.
+type ConfigShareBizIDs []uint32
+
+// Validate the grantee biz ids.
+func (b ConfigShareBizIDs) Validate() error {
+	if len(b) > MaxConfigShareGrantees {
+		return fmt.Errorf("grantees should not exceed %d", MaxConfigShareGrantees)
+	}
+
+	exists := make(map[uint32]bool, len(b))
+	for _, id := range b {
+		if id <= 0 {
+			return errors.New("invalid grantee biz id")
+		}
+		if exists[id] {
+			return fmt.Errorf("grantee biz %d is duplicated", id)
+		}
+		exists[id] = true
+	}
+
+	return nil
+}
+
+// Has returns whether the biz is granted.
+func (b ConfigShareBizIDs) Has(bizID uint32) bool {
+	for _, id := range b {
+		if id == bizID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Value implements the driver.Valuer interface.
+func (b ConfigShareBizIDs) Value() (driver.Value, error) {
+	if b == nil {
+		return "[]", nil
+	}
+	return json.Marshal(b)
+}
+
+// Scan implements the sql.Scanner interface.
+func (b *ConfigShareBizIDs) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, b)
+	case string:
+		return json.Unmarshal([]byte(v), b)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported config share biz ids raw type: %T", v)
+	}
+}
+
+// ConfigShareRef is a read-only reference of a config share in a granted biz's app,
+// the shared config is released together with the app's own configs.
+type ConfigShareRef struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *ConfigShareRefSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ConfigShareRefAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                 `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the config share ref's database table name.
+func (c *ConfigShareRef) TableName() string {
+	return "config_share_refs"
+}
+
+// AppID AuditRes interface
+func (c *ConfigShareRef) AppID() uint32 {
+	return c.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (c *ConfigShareRef) ResID() uint32 {
+	return c.ID
+}
+
+// ResType AuditRes interface
+func (c *ConfigShareRef) ResType() string {
+	return "config_share_ref"
+}
+
+// ValidateCreate validate config share ref is valid or not when create it.
+func (c *ConfigShareRef) ValidateCreate() error {
+	if c.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if c.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if c.Spec.ShareID <= 0 {
+		return errors.New("share id should be set")
+	}
+
+	if c.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if c.Attachment.BizID <= 0 || c.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	if c.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return c.Revision.ValidateCreate()
+}
+
+// ConfigShareRefSpec defines all the specifics for config share ref set by user.
+type ConfigShareRefSpec struct {
+	ShareID uint32 `json:"share_id" gorm:"column:share_id"`
+}
+
+// ConfigShareRefAttachment defines the config share ref attachments.
+type ConfigShareRefAttachment struct {
+	// BizID and AppID is the consumer's biz and app.
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
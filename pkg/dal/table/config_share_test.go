@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestConfigShareValidateCreate(t *testing.T) {
+	share := &ConfigShare{
+		Spec: &ConfigShareSpec{
+			Name:         "mysql_conf",
+			ConfigType:   File,
+			ConfigItemID: 10,
+			Grantees:     ConfigShareBizIDs{2, 3},
+		},
+		Attachment: &ConfigShareAttachment{BizID: 1, AppID: 2},
+		Revision:   &Revision{Creator: "admin", Reviser: "admin"},
+	}
+	if err := share.ValidateCreate(kit.New()); err != nil {
+		t.Errorf("validate config share failed, err: %v", err)
+		return
+	}
+
+	share.Spec.Key = "host"
+	if err := share.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("file config share with key should be invalid")
+		return
+	}
+
+	share.Spec.ConfigType = KV
+	share.Spec.ConfigItemID = 0
+	if err := share.ValidateCreate(kit.New()); err != nil {
+		t.Errorf("validate kv config share failed, err: %v", err)
+		return
+	}
+
+	share.Spec.Grantees = ConfigShareBizIDs{2, 2}
+	if err := share.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("config share with duplicated grantees should be invalid")
+		return
+	}
+
+	share.Spec.Grantees = ConfigShareBizIDs{1}
+	if err := share.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("config share granted to its own biz should be invalid")
+		return
+	}
+}
+
+func TestConfigShareBizIDsScan(t *testing.T) {
+	ids := ConfigShareBizIDs{2, 3}
+	raw, err := ids.Value()
+	if err != nil {
+		t.Errorf("get grantees value failed, err: %v", err)
+		return
+	}
+
+	got := ConfigShareBizIDs{}
+	if err = got.Scan(raw); err != nil {
+		t.Errorf("scan grantees failed, err: %v", err)
+		return
+	}
+
+	if !got.Has(2) || !got.Has(3) || got.Has(1) {
+		t.Errorf("scanned grantees %v not match %v", got, ids)
+		return
+	}
+}
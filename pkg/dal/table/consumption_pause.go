@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// maxPauseMessageLen is the max length of the hold response message of a consumption pause.
+const maxPauseMessageLen = 256
+
+const (
+	// PauseModeLastApplied the clients keep the release they applied, new releases are not notified,
+	// and the pulls are answered with a retryable error so that the clients keep the local configs.
+	PauseModeLastApplied PauseMode = "last_applied"
+	// PauseModeHoldResponse the watches and pulls of the clients are answered with the configured hold response.
+	PauseModeHoldResponse PauseMode = "hold_response"
+)
+
+// PauseMode is the mode how feed server serves the clients when the consumption is paused.
+type PauseMode string
+
+// Validate the pause mode.
+func (m PauseMode) Validate(kit *kit.Kit) error {
+	switch m {
+	case PauseModeLastApplied, PauseModeHoldResponse:
+	default:
+		return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "unsupported pause mode: %s", m))
+	}
+
+	return nil
+}
+
+// ConsumptionPause is the kill switch which makes feed server stop serving new releases of an app or
+// to a credential temporarily, it halts a bad rollout faster than rolling back the releases.
+type ConsumptionPause struct {
+	Mode PauseMode `json:"mode"`
+	// Message 暂停时返回给客户端的提示信息
+	Message  string    `json:"message"`
+	Operator string    `json:"operator"`
+	PausedAt time.Time `json:"paused_at"`
+}
+
+// Paused returns whether the consumption is paused.
+func (p *ConsumptionPause) Paused() bool {
+	return p != nil && p.Mode != ""
+}
+
+// Holding returns whether the clients should be answered with the hold response.
+func (p *ConsumptionPause) Holding() bool {
+	return p.Paused() && p.Mode == PauseModeHoldResponse
+}
+
+// Reason returns the message answered to the clients when the consumption is paused.
+func (p *ConsumptionPause) Reason() string {
+	if p.Message != "" {
+		return p.Message
+	}
+
+	if p.Mode == PauseModeHoldResponse {
+		return "consumption is on hold"
+	}
+	return "keep the applied release"
+}
+
+// Validate the consumption pause.
+func (p *ConsumptionPause) Validate(kit *kit.Kit) error {
+	if err := p.Mode.Validate(kit); err != nil {
+		return err
+	}
+
+	if len(p.Message) > maxPauseMessageLen {
+		return errf.Errorf(errf.InvalidArgument,
+			i18n.T(kit, "pause message length should not exceed %d", maxPauseMessageLen))
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (p ConsumptionPause) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *ConsumptionPause) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported consumption pause raw type: %T", v)
+	}
+}
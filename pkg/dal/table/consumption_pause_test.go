@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestConsumptionPause(t *testing.T) {
+	kt := kit.New()
+
+	var none *ConsumptionPause
+	if none.Paused() || none.Holding() {
+		t.Errorf("nil pause should not be paused")
+	}
+
+	cases := []struct {
+		pause ConsumptionPause
+		valid bool
+	}{
+		{pause: ConsumptionPause{Mode: PauseModeLastApplied}, valid: true},
+		{pause: ConsumptionPause{Mode: PauseModeHoldResponse, Message: "rollback in progress"}, valid: true},
+		{pause: ConsumptionPause{}, valid: false},
+		{pause: ConsumptionPause{Mode: "stop"}, valid: false},
+		{pause: ConsumptionPause{Mode: PauseModeHoldResponse, Message: strings.Repeat("a", 257)}, valid: false},
+	}
+	for idx, c := range cases {
+		err := c.pause.Validate(kt)
+		if c.valid && err != nil {
+			t.Errorf("case %d should be valid, err: %v", idx, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("case %d should be invalid", idx)
+		}
+	}
+
+	hold := &ConsumptionPause{Mode: PauseModeHoldResponse, Message: "rollback in progress"}
+	raw, err := json.Marshal(hold)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := new(ConsumptionPause)
+	if err = got.Scan(raw); err != nil {
+		t.Fatal(err)
+	}
+	if !got.Holding() || got.Reason() != hold.Message {
+		t.Errorf("unexpected pause after scan: %+v", got)
+	}
+
+	// 已恢复的暂停开关存储为空模式
+	resumed := new(ConsumptionPause)
+	if err = resumed.Scan(`{"mode":""}`); err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Paused() {
+		t.Errorf("resumed pause should not be paused")
+	}
+}
@@ -93,6 +93,8 @@ type CredentialSpec struct {
 	Memo           string         `json:"memo" gorm:"column:memo"`
 	Enable         bool           `json:"enable" gorm:"column:enable"`
 	ExpiredAt      time.Time      `json:"expired_at" gorm:"column:expired_at"`
+	// Pause 暂停使用该密钥的客户端拉取配置的开关, 只能通过暂停接口修改
+	Pause ConsumptionPause `json:"pause" gorm:"column:pause"`
 }
 
 const (
@@ -0,0 +1,273 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaxDraftItems is the max item count of a draft.
+const MaxDraftItems = 200
+
+// Draft is a user's personal workspace of an app, the edits accumulate in the draft and
+// are invisible to other editors until it's merged into the app's shared unreleased state.
+type Draft struct {
+	ID         uint32           `json:"id" gorm:"primaryKey"`
+	Spec       *DraftSpec       `json:"spec" gorm:"embedded"`
+	Attachment *DraftAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision        `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the draft's database table name.
+func (d *Draft) TableName() string {
+	return "drafts"
+}
+
+// ValidateUpsert validate draft is valid or not when create or update it.
+func (d *Draft) ValidateUpsert(kit *kit.Kit) error {
+	if d.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := d.Spec.Items.Validate(kit); err != nil {
+		return err
+	}
+
+	if d.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := d.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if d.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return d.Revision.ValidateCreate()
+}
+
+// DraftSpec defines all the specifics for draft.
+type DraftSpec struct {
+	Items DraftItems `json:"items" gorm:"column:items;type:json"`
+}
+
+// DraftAction is the action of a draft item.
+type DraftAction string
+
+const (
+	// DraftUpsert create or update the resource when merged.
+	DraftUpsert DraftAction = "upsert"
+	// DraftDelete delete the resource when merged.
+	DraftDelete DraftAction = "delete"
+)
+
+// Validate the draft action is valid or not.
+func (a DraftAction) Validate() error {
+	switch a {
+	case DraftUpsert, DraftDelete:
+	default:
+		return fmt.Errorf("unsupported draft action: %s", a)
+	}
+
+	return nil
+}
+
+// DraftItemStatus is the status of a draft item compared with the app's shared unreleased state.
+type DraftItemStatus string
+
+const (
+	// DraftItemAdd the resource will be created when merged.
+	DraftItemAdd DraftItemStatus = "ADD"
+	// DraftItemRevise the resource will be updated when merged.
+	DraftItemRevise DraftItemStatus = "REVISE"
+	// DraftItemDelete the resource will be deleted when merged.
+	DraftItemDelete DraftItemStatus = "DELETE"
+	// DraftItemConflict the resource has been changed by others after it's edited in draft.
+	DraftItemConflict DraftItemStatus = "CONFLICT"
+)
+
+// DraftItem is an edit of a config item or kv in draft.
+type DraftItem struct {
+	Action DraftAction `json:"action"`
+	// ConfigItemID is the id of the edited config item, 0 means a new config item.
+	ConfigItemID uint32          `json:"config_item_id"`
+	ConfigItem   *ConfigItemSpec `json:"config_item"`
+	// Content is the content of config item, which has been uploaded to repository.
+	Content *ContentSpec `json:"content"`
+	Kv      *DraftKv     `json:"kv"`
+	// BaseRevision is the update time of the shared resource when it's edited in draft,
+	// used to detect conflicts when the draft is merged, empty means a new resource.
+	BaseRevision string `json:"base_revision"`
+}
+
+// DraftKv is the edited kv in draft.
+type DraftKv struct {
+	Key          string     `json:"key"`
+	KvType       DataType   `json:"kv_type"`
+	Value        string     `json:"value"`
+	Memo         string     `json:"memo"`
+	SecretType   SecretType `json:"secret_type"`
+	SecretHidden bool       `json:"secret_hidden"`
+}
+
+// Key returns the unique key of the draft item in a draft.
+func (i *DraftItem) Key() string {
+	if i.Kv != nil {
+		return i.Kv.Key
+	}
+
+	if i.ConfigItem != nil {
+		return path.Join(i.ConfigItem.Path, i.ConfigItem.Name)
+	}
+
+	return ""
+}
+
+// Validate the draft item is valid or not.
+func (i *DraftItem) Validate(kit *kit.Kit) error {
+	if err := i.Action.Validate(); err != nil {
+		return err
+	}
+
+	if (i.ConfigItem == nil) == (i.Kv == nil) {
+		return errors.New("one and only one of config item and kv should be set")
+	}
+
+	if i.Kv != nil {
+		if i.Kv.Key == "" {
+			return errors.New("kv key is required")
+		}
+		if i.Action == DraftUpsert {
+			if err := i.Kv.KvType.ValidateCreateKv(); err != nil {
+				return err
+			}
+			return i.Kv.KvType.ValidateValue(i.Kv.Value)
+		}
+		return nil
+	}
+
+	if i.Action == DraftDelete {
+		if i.ConfigItemID == 0 {
+			return errors.New("config item id is required when delete it")
+		}
+		return nil
+	}
+
+	if err := i.ConfigItem.ValidateCreate(kit); err != nil {
+		return err
+	}
+
+	if i.Content == nil || i.Content.Signature == "" {
+		return errors.New("config item content is required")
+	}
+
+	return nil
+}
+
+// DraftItems is []*DraftItem
+type DraftItems []*DraftItem
+
+// Validate the draft items are valid or not.
+func (d DraftItems) Validate(kit *kit.Kit) error {
+	if len(d) > MaxDraftItems {
+		return fmt.Errorf("the number of draft items exceeds the maximum %d", MaxDraftItems)
+	}
+
+	keys := make(map[string]bool, len(d))
+	for _, one := range d {
+		if one == nil {
+			return errors.New("draft item is nil")
+		}
+		if err := one.Validate(kit); err != nil {
+			return err
+		}
+		if keys[one.Key()] {
+			return fmt.Errorf("draft item %s is duplicated", one.Key())
+		}
+		keys[one.Key()] = true
+	}
+
+	return nil
+}
+
+// Upsert add the item to draft, replace the one with the same key if exists.
+func (d DraftItems) Upsert(item *DraftItem) DraftItems {
+	for idx, one := range d {
+		if one.Key() == item.Key() {
+			d[idx] = item
+			return d
+		}
+	}
+
+	return append(d, item)
+}
+
+// Remove the item with the key from draft.
+func (d DraftItems) Remove(key string) DraftItems {
+	result := make(DraftItems, 0, len(d))
+	for _, one := range d {
+		if one.Key() != key {
+			result = append(result, one)
+		}
+	}
+
+	return result
+}
+
+// Value implements the driver.Valuer interface.
+func (d DraftItems) Value() (driver.Value, error) {
+	if d == nil {
+		return "[]", nil
+	}
+	return json.Marshal(d)
+}
+
+// Scan implements the sql.Scanner interface.
+func (d *DraftItems) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, d)
+	case string:
+		return json.Unmarshal([]byte(v), d)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported draft items raw type: %T", v)
+	}
+}
+
+// DraftAttachment defines the draft attachments.
+type DraftAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether draft attachment is valid or not.
+func (a *DraftAttachment) Validate() error {
+	if a.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if a.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestDraftItemsUpsertRemove(t *testing.T) {
+	items := make(DraftItems, 0)
+	items = items.Upsert(&DraftItem{Action: DraftUpsert, Kv: &DraftKv{Key: "timeout", KvType: KvStr, Value: "10s"}})
+	items = items.Upsert(&DraftItem{Action: DraftUpsert, Kv: &DraftKv{Key: "retry", KvType: KvStr, Value: "3"}})
+	items = items.Upsert(&DraftItem{Action: DraftDelete, Kv: &DraftKv{Key: "timeout"}})
+	if len(items) != 2 || items[0].Action != DraftDelete {
+		t.Errorf("the draft item with the same key should be replaced, got: %+v", items)
+		return
+	}
+
+	if err := items.Validate(kit.New()); err != nil {
+		t.Errorf("validate draft items failed, err: %v", err)
+		return
+	}
+
+	items = items.Remove("timeout")
+	if len(items) != 1 || items[0].Key() != "retry" {
+		t.Errorf("the draft item should be removed, got: %+v", items)
+		return
+	}
+}
+
+func TestDraftItemValidate(t *testing.T) {
+	both := &DraftItem{
+		Action:     DraftUpsert,
+		ConfigItem: &ConfigItemSpec{Name: "server.yaml", Path: "/etc"},
+		Kv:         &DraftKv{Key: "timeout", KvType: KvStr, Value: "10s"},
+	}
+	if err := both.Validate(kit.New()); err == nil {
+		t.Errorf("draft item with both config item and kv should be invalid")
+		return
+	}
+
+	deleteCI := &DraftItem{Action: DraftDelete, ConfigItem: &ConfigItemSpec{Name: "server.yaml", Path: "/etc"}}
+	if err := deleteCI.Validate(kit.New()); err == nil {
+		t.Errorf("deleting config item in draft without id should be invalid")
+		return
+	}
+
+	unknown := &DraftItem{Action: "rename", Kv: &DraftKv{Key: "timeout"}}
+	if err := unknown.Validate(kit.New()); err == nil {
+		t.Errorf("draft item with unsupported action should be invalid")
+		return
+	}
+}
@@ -0,0 +1,197 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// EmergencyPublish is an emergency publish which publishes a release to an explicit
+// list of instances temporarily, it expires back to the mainline release after the ttl.
+type EmergencyPublish struct {
+	ID         uint32                      `json:"id" gorm:"primaryKey"`
+	Spec       *EmergencyPublishSpec       `json:"spec" gorm:"embedded"`
+	Attachment *EmergencyPublishAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                   `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the emergency publish's database table name.
+func (e *EmergencyPublish) TableName() string {
+	return "emergency_publishes"
+}
+
+// AppID AuditRes interface
+func (e *EmergencyPublish) AppID() uint32 {
+	return e.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (e *EmergencyPublish) ResID() uint32 {
+	return e.ID
+}
+
+// ResType AuditRes interface
+func (e *EmergencyPublish) ResType() string {
+	return "emergency_publish"
+}
+
+// ValidateCreate validate emergency publish is valid or not when create it.
+func (e *EmergencyPublish) ValidateCreate() error {
+	if e.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if e.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := e.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if e.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := e.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if e.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return e.Revision.ValidateCreate()
+}
+
+const (
+	// MinEmergencyPublishTTL is the min ttl of an emergency publish.
+	MinEmergencyPublishTTL = time.Minute
+	// MaxEmergencyPublishTTL is the max ttl of an emergency publish.
+	MaxEmergencyPublishTTL = 7 * 24 * time.Hour
+	// MaxEmergencyPublishUIDs is the max instance count of an emergency publish.
+	MaxEmergencyPublishUIDs = 100
+)
+
+// EmergencyPublishSpec defines all the specifics for emergency publish set by user.
+type EmergencyPublishSpec struct {
+	ReleaseID uint32 `json:"release_id" gorm:"column:release_id"`
+	// GroupID is the temporary group created for the instances, it's deleted when expired.
+	GroupID   uint32                `json:"group_id" gorm:"column:group_id"`
+	UIDs      EmergencyUIDs         `json:"uids" gorm:"column:uids;type:json"`
+	ExpiredAt time.Time             `json:"expired_at" gorm:"column:expired_at"`
+	State     EmergencyPublishState `json:"state" gorm:"column:state"`
+	Memo      string                `json:"memo" gorm:"column:memo"`
+}
+
+// ValidateCreate validate emergency publish spec when it is created.
+func (e *EmergencyPublishSpec) ValidateCreate() error {
+	if e.ReleaseID <= 0 {
+		return errors.New("release id not set")
+	}
+
+	if e.GroupID <= 0 {
+		return errors.New("group id not set")
+	}
+
+	if len(e.UIDs) == 0 {
+		return errors.New("uids not set")
+	}
+
+	if len(e.UIDs) > MaxEmergencyPublishUIDs {
+		return fmt.Errorf("uids should not exceed %d", MaxEmergencyPublishUIDs)
+	}
+
+	if e.ExpiredAt.IsZero() {
+		return errors.New("expired at not set")
+	}
+
+	if e.State != EmergencyPublishActive {
+		return fmt.Errorf("emergency publish should be created with %s state", EmergencyPublishActive)
+	}
+
+	return nil
+}
+
+// EmergencyPublishAttachment defines the emergency publish attachments.
+type EmergencyPublishAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether emergency publish attachment is valid or not.
+func (e *EmergencyPublishAttachment) Validate() error {
+	if e.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if e.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// EmergencyPublishState is the state of an emergency publish.
+type EmergencyPublishState string
+
+const (
+	// EmergencyPublishActive 生效中
+	EmergencyPublishActive EmergencyPublishState = "active"
+	// EmergencyPublishExpired 已过期，实例回到主线版本
+	EmergencyPublishExpired EmergencyPublishState = "expired"
+	// EmergencyPublishRevoked 已提前撤销
+	EmergencyPublishRevoked EmergencyPublishState = "revoked"
+)
+
+// Validate the emergency publish state is valid or not.
+func (s EmergencyPublishState) Validate() error {
+	switch s {
+	case EmergencyPublishActive:
+	case EmergencyPublishExpired:
+	case EmergencyPublishRevoked:
+	default:
+		return fmt.Errorf("unsupported emergency publish state: %s", s)
+	}
+
+	return nil
+}
+
+// EmergencyUIDs is the instance uids of an emergency publish.
+type EmergencyUIDs []string
+
+// Value implements the driver.Valuer interface.
+func (u EmergencyUIDs) Value() (driver.Value, error) {
+	if u == nil {
+		return "[]", nil
+	}
+	return json.Marshal(u)
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *EmergencyUIDs) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, u)
+	case string:
+		return json.Unmarshal([]byte(v), u)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported emergency uids raw type: %T", v)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmergencyPublishValidateCreate(t *testing.T) {
+	ep := &EmergencyPublish{
+		Spec: &EmergencyPublishSpec{
+			ReleaseID: 1,
+			GroupID:   2,
+			UIDs:      EmergencyUIDs{"uid-1"},
+			ExpiredAt: time.Now().Add(time.Hour),
+			State:     EmergencyPublishActive,
+		},
+		Attachment: &EmergencyPublishAttachment{BizID: 1, AppID: 1},
+		Revision:   &Revision{Creator: "admin", Reviser: "admin"},
+	}
+	if err := ep.ValidateCreate(); err != nil {
+		t.Errorf("validate emergency publish failed, err: %v", err)
+		return
+	}
+
+	ep.Spec.State = EmergencyPublishExpired
+	if err := ep.ValidateCreate(); err == nil {
+		t.Errorf("emergency publish should be created with active state")
+		return
+	}
+
+	ep.Spec.State = EmergencyPublishActive
+	ep.Spec.UIDs = make(EmergencyUIDs, MaxEmergencyPublishUIDs+1)
+	if err := ep.ValidateCreate(); err == nil {
+		t.Errorf("emergency publish with oversize uids should be invalid")
+		return
+	}
+}
+
+func TestEmergencyUIDsValueScan(t *testing.T) {
+	uids := EmergencyUIDs{"uid-1", "uid-2"}
+	raw, err := uids.Value()
+	if err != nil {
+		t.Errorf("encode emergency uids failed, err: %v", err)
+		return
+	}
+
+	scanned := EmergencyUIDs{}
+	if err = scanned.Scan(raw); err != nil {
+		t.Errorf("decode emergency uids failed, err: %v", err)
+		return
+	}
+
+	if len(scanned) != 2 || scanned[0] != "uid-1" || scanned[1] != "uid-2" {
+		t.Errorf("decoded emergency uids %v is not expected", scanned)
+		return
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaxEnvironmentsPerBiz is the max environment count of one biz.
+const MaxEnvironmentsPerBiz = 20
+
+// Environment is a deploy environment of a biz, such as dev, staging and prod.
+// the apps of a biz are bound to the environments, so that each environment has
+// its own releases, credentials and strategies.
+type Environment struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *EnvironmentSpec       `json:"spec" gorm:"embedded"`
+	Attachment *EnvironmentAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision              `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the environment's database table name.
+func (e *Environment) TableName() string {
+	return "environments"
+}
+
+// AppID AuditRes interface
+func (e *Environment) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (e *Environment) ResID() uint32 {
+	return e.ID
+}
+
+// ResType AuditRes interface
+func (e *Environment) ResType() string {
+	return "environment"
+}
+
+// ValidateCreate validate environment is valid or not when create it.
+func (e *Environment) ValidateCreate(kit *kit.Kit) error {
+	if e.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if e.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := e.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if e.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := e.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if e.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return e.Revision.ValidateCreate()
+}
+
+// ValidateUpdate validate environment is valid or not when update it.
+func (e *Environment) ValidateUpdate(kit *kit.Kit) error {
+	if e.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if e.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := e.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if e.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := e.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if e.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return e.Revision.ValidateUpdate()
+}
+
+// EnvironmentSpec defines all the specifics for environment set by user.
+type EnvironmentSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	Memo string `json:"memo" gorm:"column:memo"`
+	// Position is the order of the environment in the promotion path, such as dev(1) -> staging(2) -> prod(3).
+	Position uint32 `json:"position" gorm:"column:position"`
+}
+
+// Validate environment spec.
+func (e *EnvironmentSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, e.Name); err != nil {
+		return err
+	}
+
+	return validator.ValidateMemo(kit, e.Memo, false)
+}
+
+// EnvironmentAttachment defines the environment attachments.
+type EnvironmentAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
+
+// Validate whether environment attachment is valid or not.
+func (e *EnvironmentAttachment) Validate() error {
+	if e.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	return nil
+}
+
+// EnvAppBinding binds an app to an environment, the apps bound to different environments
+// with the same app key are the same logical app, and the releases can be promoted between them.
+type EnvAppBinding struct {
+	ID         uint32                   `json:"id" gorm:"primaryKey"`
+	Spec       *EnvAppBindingSpec       `json:"spec" gorm:"embedded"`
+	Attachment *EnvAppBindingAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the env app binding's database table name.
+func (e *EnvAppBinding) TableName() string {
+	return "env_app_bindings"
+}
+
+// AppID AuditRes interface
+func (e *EnvAppBinding) AppID() uint32 {
+	return e.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (e *EnvAppBinding) ResID() uint32 {
+	return e.ID
+}
+
+// ResType AuditRes interface
+func (e *EnvAppBinding) ResType() string {
+	return "env_app_binding"
+}
+
+// ValidateCreate validate env app binding is valid or not when create it.
+func (e *EnvAppBinding) ValidateCreate(kit *kit.Kit) error {
+	if e.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if e.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := e.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if e.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := e.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if e.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return e.Revision.ValidateCreate()
+}
+
+// EnvAppBindingSpec defines all the specifics for env app binding set by user.
+type EnvAppBindingSpec struct {
+	EnvID uint32 `json:"env_id" gorm:"column:env_id"`
+	// AppKey is the logical app name shared by the apps of different environments.
+	AppKey string `json:"app_key" gorm:"column:app_key"`
+}
+
+// Validate env app binding spec.
+func (e *EnvAppBindingSpec) Validate(kit *kit.Kit) error {
+	if e.EnvID <= 0 {
+		return errors.New("env id not set")
+	}
+
+	return validator.ValidateAppName(kit, e.AppKey)
+}
+
+// EnvAppBindingAttachment defines the env app binding attachments.
+type EnvAppBindingAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether env app binding attachment is valid or not.
+func (e *EnvAppBindingAttachment) Validate() error {
+	if e.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if e.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
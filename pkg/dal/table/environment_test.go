@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestEnvironmentValidateCreate(t *testing.T) {
+	env := &Environment{
+		Spec:       &EnvironmentSpec{Name: "prod", Position: 3},
+		Attachment: &EnvironmentAttachment{BizID: 1},
+		Revision:   &Revision{Creator: "admin", Reviser: "admin"},
+	}
+	if err := env.ValidateCreate(kit.New()); err != nil {
+		t.Errorf("validate environment failed, err: %v", err)
+		return
+	}
+
+	env.Spec.Name = ""
+	if err := env.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("environment without name should be invalid")
+		return
+	}
+}
+
+func TestEnvAppBindingValidateCreate(t *testing.T) {
+	b := &EnvAppBinding{
+		Spec:       &EnvAppBindingSpec{EnvID: 1, AppKey: "order-service"},
+		Attachment: &EnvAppBindingAttachment{BizID: 1, AppID: 2},
+		Revision:   &Revision{Creator: "admin", Reviser: "admin"},
+	}
+	if err := b.ValidateCreate(kit.New()); err != nil {
+		t.Errorf("validate env app binding failed, err: %v", err)
+		return
+	}
+
+	b.Spec.AppKey = "order service"
+	if err := b.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("env app binding with invalid app key should be invalid")
+		return
+	}
+
+	b.Spec.AppKey = "order-service"
+	b.Spec.EnvID = 0
+	if err := b.ValidateCreate(kit.New()); err == nil {
+		t.Errorf("env app binding without env id should be invalid")
+		return
+	}
+}
@@ -0,0 +1,308 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+const (
+	// MaxExperimentsPerApp is the max experiment count of one app.
+	MaxExperimentsPerApp = 50
+	// MinExperimentVariants is the min variant count of one experiment.
+	MinExperimentVariants = 2
+	// MaxExperimentVariants is the max variant count of one experiment.
+	MaxExperimentVariants = 10
+	// ExperimentTotalWeight is the sum of all the variants' weight of one experiment.
+	ExperimentTotalWeight = 100
+	// MaxExperimentValueLength is the max length of the value of one experiment variant.
+	MaxExperimentValueLength = 64 * 1024
+)
+
+// Experiment is an A/B experiment on a kv, the eligible clients are split into the variants by
+// weight, each variant delivers a different value of the kv in place of the released one.
+type Experiment struct {
+	ID         uint32                `json:"id" gorm:"primaryKey"`
+	Spec       *ExperimentSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ExperimentAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision             `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the experiment's database table name.
+func (e *Experiment) TableName() string {
+	return "experiments"
+}
+
+// AppID AuditRes interface
+func (e *Experiment) AppID() uint32 {
+	return e.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (e *Experiment) ResID() uint32 {
+	return e.ID
+}
+
+// ResType AuditRes interface
+func (e *Experiment) ResType() string {
+	return "experiment"
+}
+
+// ValidateCreate validate experiment is valid or not when create it.
+func (e *Experiment) ValidateCreate(kit *kit.Kit) error {
+	if e.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return e.validate(kit)
+}
+
+// ValidateUpdate validate experiment is valid or not when update it.
+func (e *Experiment) ValidateUpdate(kit *kit.Kit) error {
+	if e.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return e.validate(kit)
+}
+
+func (e *Experiment) validate(kit *kit.Kit) error {
+	if e.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := e.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if e.Attachment == nil || e.Attachment.BizID <= 0 || e.Attachment.AppID <= 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	if e.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// ExperimentSpec defines all the specifics for experiment set by user.
+type ExperimentSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	// Key 实验的 kv key, 同一个 kv 同时只能有一个实验
+	Key string `json:"key" gorm:"column:key"`
+	// Selector 参与实验的实例标签, 为空时所有实例都参与实验
+	Selector *selector.Selector `json:"selector" gorm:"column:selector;type:json"`
+	Variants ExperimentVariants `json:"variants" gorm:"column:variants;type:json"`
+	Enabled  bool               `json:"enabled" gorm:"column:enabled"`
+	Memo     string             `json:"memo" gorm:"column:memo"`
+}
+
+// Validate experiment spec.
+func (e *ExperimentSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, e.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, e.Memo, false); err != nil {
+		return err
+	}
+
+	if e.Key == "" {
+		return errors.New("experiment kv key is required")
+	}
+
+	if e.Selector != nil && !e.Selector.IsEmpty() {
+		if err := e.Selector.Validate(); err != nil {
+			return fmt.Errorf("invalid experiment selector, err: %v", err)
+		}
+	}
+
+	return e.Variants.Validate()
+}
+
+// ValidateKvType validate the variants' value with the type of the experiment kv.
+func (e *ExperimentSpec) ValidateKvType(kvType DataType) error {
+	// 分桶的值明文存储在db中, 不支持敏感类型
+	if kvType == KvSecret || kvType == KvCertBundle {
+		return fmt.Errorf("experiment is not supported for %s kv", kvType)
+	}
+
+	for _, one := range e.Variants {
+		if len(one.Value) > MaxExperimentValueLength {
+			return fmt.Errorf("the length of the experiment variant %s value must not exceed %d KB", one.Name,
+				MaxExperimentValueLength/1024)
+		}
+		if err := kvType.ValidateValue(one.Value); err != nil {
+			return fmt.Errorf("invalid experiment variant %s value, err: %v", one.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// MatchInstance returns whether the instance is eligible for the experiment.
+func (e *ExperimentSpec) MatchInstance(uid string, labels map[string]string) (bool, error) {
+	if e.Selector == nil || e.Selector.IsEmpty() {
+		return true, nil
+	}
+
+	return e.Selector.MatchInstance(uid, labels)
+}
+
+// ExperimentVariant is a bucket of the experiment.
+type ExperimentVariant struct {
+	Name string `json:"name"`
+	// Weight 分桶的百分比, 所有分桶之和为 100
+	Weight uint32 `json:"weight"`
+	// Value 命中分桶的实例下发的 kv 值
+	Value string `json:"value"`
+}
+
+// ExperimentVariants is the variants of the experiment.
+type ExperimentVariants []*ExperimentVariant
+
+// Validate the variants, the names should be unique and the weights sum to 100.
+func (v ExperimentVariants) Validate() error {
+	if len(v) < MinExperimentVariants || len(v) > MaxExperimentVariants {
+		return fmt.Errorf("experiment variants count should be in [%d, %d]", MinExperimentVariants,
+			MaxExperimentVariants)
+	}
+
+	names := make(map[string]struct{}, len(v))
+	var total uint32
+	for _, one := range v {
+		if one == nil || one.Name == "" {
+			return errors.New("experiment variant name is required")
+		}
+		if _, exists := names[one.Name]; exists {
+			return fmt.Errorf("duplicated experiment variant %s", one.Name)
+		}
+		names[one.Name] = struct{}{}
+
+		if one.Value == "" {
+			return fmt.Errorf("experiment variant %s value is required", one.Name)
+		}
+		total += one.Weight
+	}
+
+	if total != ExperimentTotalWeight {
+		return fmt.Errorf("the sum of experiment variants weight should be %d, but got %d",
+			ExperimentTotalWeight, total)
+	}
+
+	return nil
+}
+
+// Weights returns the weights of the variants in order.
+func (v ExperimentVariants) Weights() []uint32 {
+	weights := make([]uint32, len(v))
+	for i, one := range v {
+		weights[i] = one.Weight
+	}
+	return weights
+}
+
+// Value implements the driver.Valuer interface.
+func (v ExperimentVariants) Value() (driver.Value, error) {
+	if v == nil {
+		return "[]", nil
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements the sql.Scanner interface.
+func (v *ExperimentVariants) Scan(raw interface{}) error {
+	switch r := raw.(type) {
+	case []byte:
+		return json.Unmarshal(r, v)
+	case string:
+		return json.Unmarshal([]byte(r), v)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported experiment variants raw type: %T", r)
+	}
+}
+
+// ExperimentAttachment defines the experiment attachments.
+type ExperimentAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// ExperimentExposure is the aggregated exposure of a client in an experiment, the clients report
+// the exposure when the variant value is consumed, and only the first and the last exposure is kept.
+type ExperimentExposure struct {
+	ID         uint32                        `json:"id" gorm:"primaryKey"`
+	Spec       *ExperimentExposureSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ExperimentExposureAttachment `json:"attachment" gorm:"embedded"`
+}
+
+// TableName is the experiment exposure's database table name.
+func (e *ExperimentExposure) TableName() string {
+	return "experiment_exposures"
+}
+
+// ExperimentExposureSpec defines the specifics of the experiment exposure.
+type ExperimentExposureSpec struct {
+	Variant        string    `json:"variant" gorm:"column:variant"`
+	ReleaseID      uint32    `json:"release_id" gorm:"column:release_id"`
+	Count          uint32    `json:"count" gorm:"column:count"`
+	FirstExposedAt time.Time `json:"first_exposed_at" gorm:"column:first_exposed_at"`
+	LastExposedAt  time.Time `json:"last_exposed_at" gorm:"column:last_exposed_at"`
+}
+
+// ExperimentExposureAttachment defines the experiment exposure attachments.
+type ExperimentExposureAttachment struct {
+	BizID        uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID        uint32 `json:"app_id" gorm:"column:app_id"`
+	ExperimentID uint32 `json:"experiment_id" gorm:"column:experiment_id"`
+	UID          string `json:"uid" gorm:"column:uid"`
+}
+
+// Validate experiment exposure.
+func (e *ExperimentExposure) Validate() error {
+	if e.Spec == nil || e.Attachment == nil {
+		return errors.New("spec or attachment not set")
+	}
+
+	if e.Attachment.BizID <= 0 || e.Attachment.AppID <= 0 || e.Attachment.ExperimentID <= 0 {
+		return errors.New("invalid exposure biz id, app id or experiment id")
+	}
+
+	if e.Attachment.UID == "" {
+		return errors.New("exposure uid not set")
+	}
+
+	if e.Spec.Variant == "" {
+		return errors.New("exposure variant not set")
+	}
+
+	return nil
+}
+
+// ExperimentVariantStat is the exposure statistic of an experiment variant.
+type ExperimentVariantStat struct {
+	Variant   string `json:"variant" gorm:"column:variant"`
+	Clients   uint32 `json:"clients" gorm:"column:clients"`
+	Exposures uint32 `json:"exposures" gorm:"column:exposures"`
+}
@@ -0,0 +1,40 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestExperimentVariantsValidate(t *testing.T) {
+	variants := ExperimentVariants{
+		{Name: "control", Weight: 50, Value: "a"},
+		{Name: "treatment", Weight: 50, Value: "b"},
+	}
+	if err := variants.Validate(); err != nil {
+		t.Errorf("valid variants should pass, err: %v", err)
+	}
+
+	variants[1].Weight = 40
+	if err := variants.Validate(); err == nil {
+		t.Errorf("variants weight not sum to 100 should be rejected")
+	}
+
+	variants[1].Weight = 50
+	variants[1].Name = "control"
+	if err := variants.Validate(); err == nil {
+		t.Errorf("duplicated variant name should be rejected")
+	}
+
+	if err := variants[:1].Validate(); err == nil {
+		t.Errorf("single variant should be rejected")
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// FileTamperEvent is a managed file on the client host which is found drifted from the applied release
+// by the agent's integrity watch, it is used by the compliance-sensitive fleets to audit the tampering.
+type FileTamperEvent struct {
+	ID         uint32                     `gorm:"column:id" json:"id"`
+	Attachment *FileTamperEventAttachment `json:"attachment" gorm:"embedded"`
+	Spec       *FileTamperEventSpec       `json:"spec" gorm:"embedded"`
+}
+
+// FileTamperEventSpec is a file tamper event spec
+type FileTamperEventSpec struct {
+	ConfigItemID      uint32         `gorm:"column:config_item_id" json:"config_item_id"`
+	Path              string         `gorm:"column:path" json:"path"`
+	Name              string         `gorm:"column:name" json:"name"`
+	Kind              FileTamperKind `gorm:"column:kind" json:"kind"`
+	ExpectedSignature string         `gorm:"column:expected_signature" json:"expected_signature"`
+	ActualSignature   string         `gorm:"column:actual_signature" json:"actual_signature"`
+	Repaired          bool           `gorm:"column:repaired" json:"repaired"`
+	RepairError       string         `gorm:"column:repair_error" json:"repair_error"`
+	Ip                string         `gorm:"column:ip" json:"ip"`
+	ClientType        ClientType     `gorm:"column:client_type" json:"client_type"`
+	DetectedAt        time.Time      `gorm:"column:detected_at" json:"detected_at"`
+	CreatedAt         time.Time      `gorm:"column:created_at" json:"created_at"`
+}
+
+// FileTamperEventAttachment is a file tamper event attachment
+type FileTamperEventAttachment struct {
+	BizID     uint32 `db:"biz_id" gorm:"column:biz_id" json:"biz_id"`
+	AppID     uint32 `db:"app_id" gorm:"column:app_id" json:"app_id"`
+	ReleaseID uint32 `db:"release_id" gorm:"column:release_id" json:"release_id"`
+	UID       string `gorm:"column:uid" json:"uid"`
+}
+
+// FileTamperRepairErrorMaxLen is the max length of the repair error saved for a file tamper event.
+const FileTamperRepairErrorMaxLen = 1024
+
+// FileTamperKind is the kind of the file tampering.
+type FileTamperKind string
+
+const (
+	// FileTamperModified 文件内容被修改
+	FileTamperModified FileTamperKind = "modified"
+	// FileTamperMissing 文件被删除
+	FileTamperMissing FileTamperKind = "missing"
+	// FileTamperPermissionChanged 文件权限被修改
+	FileTamperPermissionChanged FileTamperKind = "permission_changed"
+)
+
+// Validate the file tamper kind is valid or not.
+func (k FileTamperKind) Validate() error {
+	switch k {
+	case FileTamperModified:
+	case FileTamperMissing:
+	case FileTamperPermissionChanged:
+	default:
+		return fmt.Errorf("unknown %s file tamper kind", k)
+	}
+
+	return nil
+}
+
+// TableName is the file tamper event's database table name.
+func (f *FileTamperEvent) TableName() string {
+	return "file_tamper_events"
+}
+
+// AppID AuditRes interface
+func (f *FileTamperEvent) AppID() uint32 {
+	return f.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (f *FileTamperEvent) ResID() uint32 {
+	return f.ID
+}
+
+// ResType AuditRes interface
+func (f *FileTamperEvent) ResType() string {
+	return "file_tamper_event"
+}
+
+// ValidateCreate validate file tamper event when it is created.
+func (f *FileTamperEvent) ValidateCreate() error {
+	if f.ID != 0 {
+		return errors.New("id can not be set")
+	}
+
+	if f.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := f.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if f.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := f.Attachment.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateCreate validate file tamper event spec when it is created.
+func (f *FileTamperEventSpec) ValidateCreate() error {
+	if err := f.Kind.Validate(); err != nil {
+		return err
+	}
+
+	if f.Name == "" {
+		return errors.New("file name not set")
+	}
+
+	if len(f.RepairError) > FileTamperRepairErrorMaxLen {
+		return fmt.Errorf("repair error should not exceed %d bytes", FileTamperRepairErrorMaxLen)
+	}
+
+	return nil
+}
+
+// ValidateCreate validate file tamper event attachment when it is created.
+func (f *FileTamperEventAttachment) ValidateCreate() error {
+	if f.BizID <= 0 {
+		return errors.New("biz id not set")
+	}
+
+	if f.AppID <= 0 {
+		return errors.New("app id not set")
+	}
+
+	if f.UID == "" {
+		return errors.New("uid not set")
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FullTextDoc is the full-text search index document of an unreleased config item or kv.
+type FullTextDoc struct {
+	ID         uint32                 `json:"id" gorm:"primaryKey"`
+	Spec       *FullTextDocSpec       `json:"spec" gorm:"embedded"`
+	Attachment *FullTextDocAttachment `json:"attachment" gorm:"embedded"`
+}
+
+// TableName is the full-text doc's database table name.
+func (d *FullTextDoc) TableName() string {
+	return "full_text_docs"
+}
+
+// ValidateUpsert validate full-text doc is valid or not when create or update it.
+func (d *FullTextDoc) ValidateUpsert() error {
+	if d.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := d.Spec.ResType.Validate(); err != nil {
+		return err
+	}
+
+	if d.Spec.ResID == 0 {
+		return errors.New("resource id not set")
+	}
+
+	if d.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if d.Attachment.BizID == 0 || d.Attachment.AppID == 0 {
+		return errors.New("invalid attachment biz id or app id")
+	}
+
+	return nil
+}
+
+// FullTextResType is the resource type of full-text doc.
+type FullTextResType string
+
+const (
+	// FullTextConfigItem is the config item of file app.
+	FullTextConfigItem FullTextResType = "config_item"
+	// FullTextKv is the kv of kv app.
+	FullTextKv FullTextResType = "kv"
+)
+
+// Validate the full-text resource type is valid or not.
+func (t FullTextResType) Validate() error {
+	switch t {
+	case FullTextConfigItem, FullTextKv:
+	default:
+		return fmt.Errorf("unsupported full-text resource type: %s", t)
+	}
+
+	return nil
+}
+
+// FullTextDocSpec defines all the specifics for full-text doc.
+type FullTextDocSpec struct {
+	ResType FullTextResType `json:"res_type" gorm:"column:res_type"`
+	ResID   uint32          `json:"res_id" gorm:"column:res_id"`
+	// Name is the absolute path of config item or the key of kv.
+	Name string `json:"name" gorm:"column:name"`
+	Memo string `json:"memo" gorm:"column:memo"`
+	// Content is the text content of config item or the value of kv, empty if it's not indexed.
+	Content string `json:"content" gorm:"column:content"`
+	// Signature is the sha256 of the indexed content, used to detect whether the doc should be re-indexed.
+	Signature string    `json:"signature" gorm:"column:signature"`
+	IndexedAt time.Time `json:"indexed_at" gorm:"column:indexed_at"`
+}
+
+// FullTextDocAttachment defines the full-text doc attachments.
+type FullTextDocAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Snippet returns the content around the first occurrence of the keyword, which is at most width runes,
+// the keyword is matched case-insensitively, returns empty if the content does not contain the keyword.
+func (s *FullTextDocSpec) Snippet(keyword string, width int) string {
+	if keyword == "" || width <= 0 {
+		return ""
+	}
+
+	content := []rune(s.Content)
+	lower := strings.ToLower(s.Content)
+	kw := strings.ToLower(keyword)
+	// 大小写转换后长度可能变化，此时无法按下标定位原文，不生成片段
+	if utf8.RuneCountInString(lower) != len(content) {
+		return ""
+	}
+
+	pos := strings.Index(lower, kw)
+	if pos < 0 {
+		return ""
+	}
+	idx := utf8.RuneCountInString(lower[:pos])
+	kwLen := utf8.RuneCountInString(kw)
+
+	start := idx - (width-kwLen)/2
+	if start < 0 {
+		start = 0
+	}
+	end := start + width
+	if end > len(content) {
+		end = len(content)
+		if start = end - width; start < 0 {
+			start = 0
+		}
+	}
+
+	snippet := string(content[start:end])
+	if start > 0 {
+		snippet = "..." + snippet
+	}
+	if end < len(content) {
+		snippet += "..."
+	}
+
+	return snippet
+}
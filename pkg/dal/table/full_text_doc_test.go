@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFullTextDocValidateUpsert(t *testing.T) {
+	doc := &FullTextDoc{
+		Spec:       &FullTextDocSpec{ResType: FullTextKv, ResID: 1, Name: "timeout"},
+		Attachment: &FullTextDocAttachment{BizID: 1, AppID: 2},
+	}
+	if err := doc.ValidateUpsert(); err != nil {
+		t.Errorf("validate full-text doc failed, err: %v", err)
+		return
+	}
+
+	doc.Spec.ResType = "app"
+	if err := doc.ValidateUpsert(); err == nil {
+		t.Errorf("full-text doc with unsupported resource type should be invalid")
+		return
+	}
+
+	doc.Spec.ResType = FullTextConfigItem
+	doc.Attachment.AppID = 0
+	if err := doc.ValidateUpsert(); err == nil {
+		t.Errorf("full-text doc without app id should be invalid")
+		return
+	}
+}
+
+func TestFullTextDocSpecSnippet(t *testing.T) {
+	spec := &FullTextDocSpec{Content: strings.Repeat("a", 100) + "Listen 8080" + strings.Repeat("b", 100)}
+
+	snippet := spec.Snippet("listen", 20)
+	if !strings.HasPrefix(snippet, "...") || !strings.HasSuffix(snippet, "...") ||
+		!strings.Contains(snippet, "Listen") {
+		t.Errorf("unexpected snippet: %s", snippet)
+		return
+	}
+
+	if got := spec.Snippet("8080", 1000); got != spec.Content {
+		t.Errorf("snippet should be the whole content when it's shorter than width, got: %s", got)
+		return
+	}
+
+	if got := spec.Snippet("9090", 20); got != "" {
+		t.Errorf("snippet should be empty when keyword not found, got: %s", got)
+		return
+	}
+
+	spec.Content = "数据库地址：127.0.0.1"
+	if got := spec.Snippet("地址", 4); got != "...库地址：..." {
+		t.Errorf("unexpected snippet of multi-byte content: %s", got)
+		return
+	}
+}
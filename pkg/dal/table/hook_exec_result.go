@@ -0,0 +1,148 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// HookExecResult is the result of a pre/post hook executed by a client when it applies a release.
+type HookExecResult struct {
+	ID         uint32                    `gorm:"column:id" json:"id"`
+	Attachment *HookExecResultAttachment `json:"attachment" gorm:"embedded"`
+	Spec       *HookExecResultSpec       `json:"spec" gorm:"embedded"`
+}
+
+// HookExecResultSpec is a hook exec result spec
+type HookExecResultSpec struct {
+	HookType   HookExecType `gorm:"column:hook_type" json:"hook_type"`
+	HookName   string       `gorm:"column:hook_name" json:"hook_name"`
+	ExitCode   int32        `gorm:"column:exit_code" json:"exit_code"`
+	DurationMs uint32       `gorm:"column:duration_ms" json:"duration_ms"`
+	Stdout     string       `gorm:"column:stdout" json:"stdout"`
+	Stderr     string       `gorm:"column:stderr" json:"stderr"`
+	Ip         string       `gorm:"column:ip" json:"ip"`
+	ClientType ClientType   `gorm:"column:client_type" json:"client_type"`
+	ExecutedAt time.Time    `gorm:"column:executed_at" json:"executed_at"`
+	CreatedAt  time.Time    `gorm:"column:created_at" json:"created_at"`
+}
+
+// HookExecResultAttachment is a hook exec result attachment
+type HookExecResultAttachment struct {
+	BizID     uint32 `db:"biz_id" gorm:"column:biz_id" json:"biz_id"`
+	AppID     uint32 `db:"app_id" gorm:"column:app_id" json:"app_id"`
+	ReleaseID uint32 `db:"release_id" gorm:"column:release_id" json:"release_id"`
+	UID       string `gorm:"column:uid" json:"uid"`
+}
+
+// HookExecOutputMaxLen is the max length of the stdout/stderr tail saved for a hook execution.
+const HookExecOutputMaxLen = 4096
+
+// HookExecType is the hook stage which the result belongs to.
+type HookExecType string
+
+const (
+	// PreHookExec 前置脚本
+	PreHookExec HookExecType = "pre_hook"
+	// PostHookExec 后置脚本
+	PostHookExec HookExecType = "post_hook"
+)
+
+// Validate the hook exec type is valid or not.
+func (h HookExecType) Validate() error {
+	switch h {
+	case PreHookExec:
+	case PostHookExec:
+	default:
+		return fmt.Errorf("unknown %s hook exec type", h)
+	}
+
+	return nil
+}
+
+// TableName is the hook exec result's database table name.
+func (h *HookExecResult) TableName() string {
+	return "hook_exec_results"
+}
+
+// AppID AuditRes interface
+func (h *HookExecResult) AppID() uint32 {
+	return h.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (h *HookExecResult) ResID() uint32 {
+	return h.ID
+}
+
+// ResType AuditRes interface
+func (h *HookExecResult) ResType() string {
+	return "hook_exec_result"
+}
+
+// ValidateCreate validate hook exec result when it is created.
+func (h *HookExecResult) ValidateCreate() error {
+	if h.ID != 0 {
+		return errors.New("id can not be set")
+	}
+
+	if h.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := h.Spec.ValidateCreate(); err != nil {
+		return err
+	}
+
+	if h.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := h.Attachment.ValidateCreate(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateCreate validate hook exec result spec when it is created.
+func (h *HookExecResultSpec) ValidateCreate() error {
+	if err := h.HookType.Validate(); err != nil {
+		return err
+	}
+
+	if len(h.Stdout) > HookExecOutputMaxLen || len(h.Stderr) > HookExecOutputMaxLen {
+		return fmt.Errorf("hook output tail should not exceed %d bytes", HookExecOutputMaxLen)
+	}
+
+	return nil
+}
+
+// ValidateCreate validate hook exec result attachment when it is created.
+func (h *HookExecResultAttachment) ValidateCreate() error {
+	if h.BizID <= 0 {
+		return errors.New("biz id not set")
+	}
+
+	if h.AppID <= 0 {
+		return errors.New("app id not set")
+	}
+
+	if h.UID == "" {
+		return errors.New("uid not set")
+	}
+
+	return nil
+}
@@ -0,0 +1,364 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"text/template"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// BuiltinHookTemplateBizID is the biz id of the platform level built-in hook templates,
+// which are shared by all the businesses and can not be modified by them.
+const BuiltinHookTemplateBizID uint32 = 0
+
+// HookTemplate is a reusable hook snippet with parameters, the app hooks can be
+// created from it and upgraded when the template is updated.
+type HookTemplate struct {
+	ID         uint32                  `json:"id" gorm:"primaryKey"`
+	Spec       *HookTemplateSpec       `json:"spec" gorm:"embedded"`
+	Attachment *HookTemplateAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision               `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the hook template's database table name.
+func (h *HookTemplate) TableName() string {
+	return "hook_templates"
+}
+
+// AppID AuditRes interface
+func (h *HookTemplate) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (h *HookTemplate) ResID() uint32 {
+	return h.ID
+}
+
+// ResType AuditRes interface
+func (h *HookTemplate) ResType() string {
+	return string(enumor.Hook)
+}
+
+// IsBuiltin returns whether the hook template is a platform level built-in template.
+func (h *HookTemplate) IsBuiltin() bool {
+	return h.Attachment != nil && h.Attachment.BizID == BuiltinHookTemplateBizID
+}
+
+// ValidateCreate validate hook template is valid or not when create it.
+func (h *HookTemplate) ValidateCreate(kit *kit.Kit) error {
+	if h.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if h.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := h.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if h.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if h.Attachment.BizID <= 0 {
+		return errors.New("biz id should be set")
+	}
+
+	if h.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return h.Revision.ValidateCreate()
+}
+
+// ValidateUpdate validate hook template is valid or not when update it.
+func (h *HookTemplate) ValidateUpdate(kit *kit.Kit) error {
+	if h.ID <= 0 {
+		return errors.New("hook template id should be set")
+	}
+
+	if h.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := h.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if h.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if h.Attachment.BizID <= 0 {
+		return errors.New("biz id should be set")
+	}
+
+	if h.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return h.Revision.ValidateUpdate()
+}
+
+// HookTemplateSpec defines all the specifics for hook template set by user.
+type HookTemplateSpec struct {
+	Name    string             `json:"name" gorm:"column:name"`
+	Type    ScriptType         `json:"type" gorm:"column:type"`
+	Content string             `json:"content" gorm:"column:content"`
+	Params  HookTemplateParams `json:"params" gorm:"column:params;type:json;default:'[]'"`
+	Memo    string             `json:"memo" gorm:"column:memo"`
+	// Version is increased every time the content or params is updated,
+	// it is used to determine whether the hooks created from this template are outdated.
+	Version uint32 `json:"version" gorm:"column:version"`
+}
+
+// Validate validate hook template spec.
+func (s *HookTemplateSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateFileName(kit, s.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, s.Memo, false); err != nil {
+		return err
+	}
+
+	if s.Type == "" {
+		return errors.New("hook template type should be set")
+	}
+
+	if err := s.Type.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.Params.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := s.parse(); err != nil {
+		return fmt.Errorf("invalid hook template content, err: %v", err)
+	}
+
+	return nil
+}
+
+// Render renders the template content with the given arguments, the default value is used
+// if a param is not given, and an error is returned if a required param is missing.
+func (s *HookTemplateSpec) Render(args map[string]string) (string, error) {
+	tpl, err := s.parse()
+	if err != nil {
+		return "", err
+	}
+
+	data := make(map[string]string, len(s.Params))
+	for _, p := range s.Params {
+		v, ok := args[p.Name]
+		if !ok || v == "" {
+			if p.Required {
+				return "", fmt.Errorf("hook template param %s is required", p.Name)
+			}
+			v = p.Default
+		}
+		data[p.Name] = v
+	}
+
+	for k := range args {
+		if _, ok := data[k]; !ok {
+			return "", fmt.Errorf("hook template param %s is not defined", k)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (s *HookTemplateSpec) parse() (*template.Template, error) {
+	return template.New(s.Name).Option("missingkey=error").Parse(s.Content)
+}
+
+// HookTemplateAttachment defines the hook template attachments.
+type HookTemplateAttachment struct {
+	// BizID is 0 for the built-in hook templates.
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
+
+// HookTemplateParam defines a param which can be referenced in the hook template content by {{ .name }}.
+type HookTemplateParam struct {
+	Name     string `json:"name"`
+	Default  string `json:"default"`
+	Required bool   `json:"required"`
+	Memo     string `json:"memo"`
+}
+
+var hookTemplateParamNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,63}$`)
+
+// HookTemplateParams is []*HookTemplateParam
+type HookTemplateParams []*HookTemplateParam
+
+// Validate validate the hook template params.
+func (p HookTemplateParams) Validate() error {
+	exists := make(map[string]struct{}, len(p))
+	for _, one := range p {
+		if one == nil {
+			return errors.New("hook template param is nil")
+		}
+
+		if !hookTemplateParamNameRegexp.MatchString(one.Name) {
+			return fmt.Errorf("invalid hook template param name %s, should match %s", one.Name,
+				hookTemplateParamNameRegexp.String())
+		}
+
+		if _, ok := exists[one.Name]; ok {
+			return fmt.Errorf("hook template param %s is duplicated", one.Name)
+		}
+		exists[one.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (p HookTemplateParams) Value() (driver.Value, error) {
+	if p == nil {
+		return "[]", nil
+	}
+
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (p *HookTemplateParams) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	default:
+		return errors.New("unsupported Scan type for HookTemplateParams")
+	}
+}
+
+// HookTemplateRef records a hook which is created from a hook template.
+type HookTemplateRef struct {
+	ID         uint32                     `json:"id" gorm:"primaryKey"`
+	Spec       *HookTemplateRefSpec       `json:"spec" gorm:"embedded"`
+	Attachment *HookTemplateRefAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                  `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the hook template ref's database table name.
+func (h *HookTemplateRef) TableName() string {
+	return "hook_template_refs"
+}
+
+// ValidateCreate validate hook template ref is valid or not when create it.
+func (h *HookTemplateRef) ValidateCreate() error {
+	if h.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if h.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if h.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if h.Attachment.BizID <= 0 || h.Attachment.HookID <= 0 || h.Attachment.TemplateID <= 0 {
+		return errors.New("biz id, hook id and template id should be set")
+	}
+
+	if h.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return h.Revision.ValidateCreate()
+}
+
+// HookTemplateRefSpec defines the specifics of a hook template ref.
+type HookTemplateRefSpec struct {
+	// Args is the arguments used to render the template.
+	Args HookTemplateArgs `json:"args" gorm:"column:args;type:json;default:'{}'"`
+	// TemplateVersion is the template version which the current hook revision is rendered from.
+	TemplateVersion uint32 `json:"template_version" gorm:"column:template_version"`
+	// AutoUpgrade if true, a new hook revision will be rendered and published
+	// once the template is updated.
+	AutoUpgrade bool `json:"auto_upgrade" gorm:"column:auto_upgrade"`
+}
+
+// HookTemplateRefAttachment defines the hook template ref attachments.
+type HookTemplateRefAttachment struct {
+	BizID      uint32 `json:"biz_id" gorm:"column:biz_id"`
+	HookID     uint32 `json:"hook_id" gorm:"column:hook_id"`
+	TemplateID uint32 `json:"template_id" gorm:"column:template_id"`
+}
+
+// HookTemplateArgs is the arguments to render a hook template.
+type HookTemplateArgs map[string]string
+
+// Value implements the driver.Valuer interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (a HookTemplateArgs) Value() (driver.Value, error) {
+	if a == nil {
+		return "{}", nil
+	}
+
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan implements the sql.Scanner interface
+// See gorm document about customizing data types: https://gorm.io/docs/data_types.html
+func (a *HookTemplateArgs) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, a)
+	case string:
+		return json.Unmarshal([]byte(v), a)
+	default:
+		return errors.New("unsupported Scan type for HookTemplateArgs")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+)
+
+func TestHookTemplateRender(t *testing.T) {
+	spec := &HookTemplateSpec{
+		Name:    "reload_systemd_unit",
+		Type:    Shell,
+		Content: "systemctl {{ .action }} {{ .unit }}",
+		Params: HookTemplateParams{
+			{Name: "unit", Required: true},
+			{Name: "action", Default: "reload"},
+		},
+	}
+
+	content, err := spec.Render(map[string]string{"unit": "nginx"})
+	if err != nil {
+		t.Errorf("render hook template failed, err: %v", err)
+		return
+	}
+	if content != "systemctl reload nginx" {
+		t.Errorf("rendered content %q is not what we expected", content)
+		return
+	}
+
+	if _, err := spec.Render(map[string]string{"action": "restart"}); err == nil {
+		t.Errorf("render hook template without required param should fail")
+		return
+	}
+
+	if _, err := spec.Render(map[string]string{"unit": "nginx", "unknown": "x"}); err == nil {
+		t.Errorf("render hook template with undefined param should fail")
+		return
+	}
+
+	spec.Content = "echo {{ .undefined }}"
+	if _, err := spec.Render(map[string]string{"unit": "nginx"}); err == nil {
+		t.Errorf("render hook template which references undefined param should fail")
+	}
+}
+
+func TestHookTemplateParamsValidate(t *testing.T) {
+	if err := (HookTemplateParams{{Name: "unit"}, {Name: "unit"}}).Validate(); err == nil {
+		t.Errorf("duplicated params should be invalid")
+	}
+
+	if err := (HookTemplateParams{{Name: "1unit"}}).Validate(); err == nil {
+		t.Errorf("param name start with digit should be invalid")
+	}
+
+	if err := (HookTemplateParams{{Name: "unit_name"}}).Validate(); err != nil {
+		t.Errorf("param should be valid, err: %v", err)
+	}
+}
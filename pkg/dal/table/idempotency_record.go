@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"time"
+)
+
+// IdempotencyRetention is the retention window of the idempotency records, the retries with the same
+// idempotency key after the window are treated as new requests.
+const IdempotencyRetention = 24 * time.Hour
+
+// IdempotencyProcessingTimeout is the max processing time of an idempotent request, the request still in
+// processing after the timeout is regarded as abandoned, e.g. the config-server restarted, and can be retried.
+const IdempotencyProcessingTimeout = 10 * time.Minute
+
+// IdempotencyState is the processing state of an idempotent request.
+type IdempotencyState string
+
+const (
+	// IdempotencyProcessing the request is being processed.
+	IdempotencyProcessing IdempotencyState = "processing"
+	// IdempotencyCompleted the request is completed, the response is recorded.
+	IdempotencyCompleted IdempotencyState = "completed"
+)
+
+// IdempotencyRecord records the request fingerprint and the response of a mutating api called with
+// an idempotency key, so that the retries of the same request return the original result.
+type IdempotencyRecord struct {
+	ID         uint32                       `json:"id" gorm:"primaryKey"`
+	Spec       *IdempotencyRecordSpec       `json:"spec" gorm:"embedded"`
+	Attachment *IdempotencyRecordAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                    `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the idempotency record's database table name.
+func (r *IdempotencyRecord) TableName() string {
+	return "idempotency_records"
+}
+
+// AppID AuditRes interface
+func (r *IdempotencyRecord) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (r *IdempotencyRecord) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *IdempotencyRecord) ResType() string {
+	return "idempotency_record"
+}
+
+// ValidateCreate validate idempotency record is valid or not when create it.
+func (r *IdempotencyRecord) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := r.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil || r.Attachment.User == "" {
+		return errors.New("invalid attachment user")
+	}
+
+	if r.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return r.Revision.ValidateCreate()
+}
+
+// IdempotencyRecordSpec defines all the specifics for idempotency record.
+type IdempotencyRecordSpec struct {
+	// Key 调用方传入的 Idempotency-Key
+	Key string `json:"key" gorm:"column:idem_key"`
+	// Method 接口的 grpc 全路径
+	Method string `json:"method" gorm:"column:method"`
+	// Fingerprint 请求内容的 sha256 摘要, 相同的 key 只允许用于相同的请求
+	Fingerprint string           `json:"fingerprint" gorm:"column:fingerprint"`
+	State       IdempotencyState `json:"state" gorm:"column:state"`
+	// Response 序列化后的原始响应, 请求完成后写入
+	Response  []byte    `json:"-" gorm:"column:response"`
+	ExpiredAt time.Time `json:"expired_at" gorm:"column:expired_at"`
+}
+
+// maxIdempotencyKeyLen 限制 key 的长度
+const maxIdempotencyKeyLen = 128
+
+// Validate idempotency record spec.
+func (s *IdempotencyRecordSpec) Validate() error {
+	if s.Key == "" || len(s.Key) > maxIdempotencyKeyLen {
+		return errors.New("idempotency key should be 1 to 128 characters")
+	}
+
+	if s.Method == "" || s.Fingerprint == "" {
+		return errors.New("method and fingerprint are required")
+	}
+
+	return nil
+}
+
+// Expired returns whether the record is out of the retention window.
+func (s *IdempotencyRecordSpec) Expired(now time.Time) bool {
+	return !s.ExpiredAt.After(now)
+}
+
+// Abandoned returns whether the record is still processing after the processing timeout.
+func (r *IdempotencyRecord) Abandoned(now time.Time) bool {
+	return r.Spec.State == IdempotencyProcessing && r.Revision.CreatedAt.Add(IdempotencyProcessingTimeout).Before(now)
+}
+
+// IdempotencyRecordAttachment defines the idempotency record attachments, the key is scoped by the
+// biz and the user, so different users never share the same record.
+type IdempotencyRecordAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	User  string `json:"user" gorm:"column:user"`
+}
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIdempotencyRecordExpired(t *testing.T) {
+	now := time.Now()
+
+	record := &IdempotencyRecord{
+		Spec: &IdempotencyRecordSpec{
+			Key:         "release-1",
+			Method:      "/pbcs.Config/CreateRelease",
+			Fingerprint: "abc",
+			State:       IdempotencyProcessing,
+			ExpiredAt:   now.Add(IdempotencyRetention),
+		},
+		Revision: &Revision{CreatedAt: now},
+	}
+	if record.Spec.Expired(now) || record.Abandoned(now) {
+		t.Errorf("new idempotency record should not be expired or abandoned")
+	}
+
+	later := now.Add(IdempotencyProcessingTimeout + time.Minute)
+	if !record.Abandoned(later) {
+		t.Errorf("processing idempotency record should be abandoned after timeout")
+	}
+
+	record.Spec.State = IdempotencyCompleted
+	if record.Abandoned(later) {
+		t.Errorf("completed idempotency record should not be abandoned")
+	}
+	if !record.Spec.Expired(now.Add(IdempotencyRetention)) {
+		t.Errorf("idempotency record should be expired after retention")
+	}
+}
+
+func TestIdempotencyRecordSpecValidate(t *testing.T) {
+	spec := &IdempotencyRecordSpec{Key: "k", Method: "m", Fingerprint: "f"}
+	if err := spec.Validate(); err != nil {
+		t.Errorf("validate idempotency record spec failed, err: %v", err)
+	}
+
+	spec.Key = strings.Repeat("k", maxIdempotencyKeyLen+1)
+	if err := spec.Validate(); err == nil {
+		t.Errorf("too long idempotency key should be invalid")
+	}
+}
@@ -48,6 +48,58 @@ type KvSpec struct {
 	SecretType                SecretType `json:"secret_type" gorm:"column:secret_type"`
 	SecretHidden              bool       `json:"secret_hidden" gorm:"column:secret_hidden"`
 	CertificateExpirationDate *time.Time `json:"certificate_expiration_date" gorm:"column:certificate_expiration_date"`
+	// ExpiredAt is the time after which feed server stops serving the kv, nil means never expire.
+	ExpiredAt *time.Time `json:"expired_at" gorm:"column:expired_at"`
+	// ExpiredFallback is the value served after the kv is expired, nil means the kv is not served any more,
+	// empty string is a valid fallback value.
+	ExpiredFallback *string `json:"expired_fallback" gorm:"column:expired_fallback"`
+}
+
+// MaxExpiredFallbackLength is the max length of the kv's expired fallback value, it is saved in db directly.
+const MaxExpiredFallbackLength = 64 * 1024
+
+// Expired returns whether the kv is expired at the given time.
+func (k *KvSpec) Expired(now time.Time) bool {
+	return k.ExpiredAt != nil && !now.Before(*k.ExpiredAt)
+}
+
+// ValidateExpiry validate the kv's expiry settings when it is created or updated, the expired time should
+// be later than now, such as the time-bounded overrides for incident mitigations.
+func (k *KvSpec) ValidateExpiry(now time.Time) error {
+	if k.ExpiredAt == nil {
+		if k.ExpiredFallback != nil {
+			return errors.New("expired fallback is set but expired at is not set")
+		}
+		return nil
+	}
+
+	if !k.ExpiredAt.After(now) {
+		return errors.New("expired at should be later than now")
+	}
+
+	if k.ExpiredFallback == nil {
+		return nil
+	}
+
+	// 过期后的兜底值明文存储在db中, 不支持敏感类型
+	if k.KvType == KvSecret || k.KvType == KvCertBundle {
+		return fmt.Errorf("expired fallback is not supported for %s kv", k.KvType)
+	}
+
+	if len(*k.ExpiredFallback) > MaxExpiredFallbackLength {
+		return fmt.Errorf("the length of the expired fallback must not exceed %d KB", MaxExpiredFallbackLength/1024)
+	}
+
+	// 字符串和文本类型允许空的兜底值, 其他类型的兜底值需符合该类型
+	if *k.ExpiredFallback == "" && (k.KvType == KvStr || k.KvType == KvText) {
+		return nil
+	}
+
+	if err := k.KvType.ValidateValue(*k.ExpiredFallback); err != nil {
+		return fmt.Errorf("invalid expired fallback, err: %v", err)
+	}
+
+	return nil
 }
 
 // KvAttachment is a kv attachment
@@ -139,6 +191,7 @@ func (k DataType) ValidateCreateKv() error {
 	case KvYAML:
 	case KvXml:
 	case KvSecret:
+	case KvBool, KvDuration, KvBytes, KvList, KvCertBundle:
 	default:
 		return errors.New("invalid data-type")
 	}
@@ -258,6 +311,9 @@ func (k DataType) ValidateValue(value string) error {
 		return nil
 	case KvSecret:
 		return nil
+	case KvBool, KvDuration, KvBytes, KvList, KvCertBundle:
+		_, err := k.NormalizeValue(value)
+		return err
 	default:
 		return errors.New("invalid key-value type")
 	}
@@ -0,0 +1,238 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+// KvOverride is the value of a kv which overrides the base value for the instances matched by a group,
+// so that the app can be shared by the instances which only need a few different values, e.g. per region.
+type KvOverride struct {
+	ID          uint32                `json:"id" gorm:"primaryKey"`
+	Spec        *KvOverrideSpec       `json:"spec" gorm:"embedded"`
+	Attachment  *KvOverrideAttachment `json:"attachment" gorm:"embedded"`
+	Revision    *Revision             `json:"revision" gorm:"embedded"`
+	ContentSpec *ContentSpec          `json:"content_spec" gorm:"embedded"`
+}
+
+// TableName is the kv override's database table name.
+func (k *KvOverride) TableName() string {
+	return "kv_overrides"
+}
+
+// AppID AuditRes interface
+func (k *KvOverride) AppID() uint32 {
+	return k.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (k *KvOverride) ResID() uint32 {
+	return k.ID
+}
+
+// ResType AuditRes interface
+func (k *KvOverride) ResType() string {
+	return "kv_override"
+}
+
+// ValidateCreate validate kv override is valid or not when create it.
+func (k *KvOverride) ValidateCreate() error {
+	if k.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if k.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := k.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if k.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := k.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if k.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// ValidateUpdate validate kv override is valid or not when update it.
+func (k *KvOverride) ValidateUpdate() error {
+	if k.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	if k.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if k.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := k.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if k.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// KvOverrideSpec defines the kv and the group which the override value belongs to.
+type KvOverrideSpec struct {
+	Key     string `json:"key" gorm:"column:key"`
+	GroupID uint32 `json:"group_id" gorm:"column:group_id"`
+	// Priority decides which override takes effect when an instance matches multiple groups of the kv,
+	// the smaller one wins.
+	Priority uint32 `json:"priority" gorm:"column:priority"`
+	// Version is the version of the override value in vault.
+	Version uint32 `json:"version" gorm:"column:version"`
+	Memo    string `json:"memo" gorm:"column:memo"`
+}
+
+// Validate kv override spec.
+func (k *KvOverrideSpec) Validate() error {
+	if k.Key == "" {
+		return errors.New("kv key is required")
+	}
+
+	if k.GroupID <= 0 {
+		return errors.New("invalid group id, should >= 1")
+	}
+
+	return nil
+}
+
+// KvOverrideAttachment defines the kv override attachments.
+type KvOverrideAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate kv override attachment.
+func (k *KvOverrideAttachment) Validate() error {
+	if k.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if k.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// ReleasedKvOverride is the kv override in a release, the group's selector is saved with it, so that
+// the later changes of the group do not affect the released overrides.
+type ReleasedKvOverride struct {
+	ID          uint32                `json:"id" gorm:"primaryKey"`
+	ReleaseID   uint32                `json:"release_id" gorm:"column:release_id"`
+	Spec        *KvOverrideSpec       `json:"spec" gorm:"embedded"`
+	Group       *KvOverrideGroup      `json:"group" gorm:"embedded"`
+	Attachment  *KvOverrideAttachment `json:"attachment" gorm:"embedded"`
+	Revision    *Revision             `json:"revision" gorm:"embedded"`
+	ContentSpec *ContentSpec          `json:"content_spec" gorm:"embedded"`
+}
+
+// TableName is the released kv override's database table name.
+func (r *ReleasedKvOverride) TableName() string {
+	return "released_kv_overrides"
+}
+
+// ValidateCreate validate released kv override is valid or not when create it.
+func (r *ReleasedKvOverride) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.ReleaseID <= 0 {
+		return errors.New("invalid release id")
+	}
+
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := r.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if r.Group == nil {
+		return errors.New("group not set")
+	}
+
+	if err := r.Group.Validate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	return r.Attachment.Validate()
+}
+
+// KvOverrideGroup is the group's matching rule of the released kv override.
+type KvOverrideGroup struct {
+	Mode     GroupMode          `json:"mode" gorm:"column:mode"`
+	Selector *selector.Selector `json:"selector" gorm:"column:selector;type:json"`
+	UID      string             `json:"uid" gorm:"column:uid"`
+}
+
+// Validate kv override group.
+func (g *KvOverrideGroup) Validate() error {
+	switch g.Mode {
+	case GroupModeCustom:
+		if g.Selector == nil || g.Selector.IsEmpty() {
+			return errors.New("custom group must have selector")
+		}
+	case GroupModeDebug:
+		if g.UID == "" {
+			return errors.New("debug group must have uid")
+		}
+	default:
+		// 默认分组匹配所有实例，即为 kv 的基础值，不能用于覆盖
+		return fmt.Errorf("unsupported kv override group mode: %s", g.Mode)
+	}
+
+	return nil
+}
+
+// MatchInstance returns whether the instance matches the group.
+func (g *KvOverrideGroup) MatchInstance(uid string, labels map[string]string) (bool, error) {
+	switch g.Mode {
+	case GroupModeDebug:
+		return g.UID == uid, nil
+	case GroupModeCustom:
+		if g.Selector == nil {
+			return false, errors.New("custom group must have selector")
+		}
+		return g.Selector.MatchInstance(uid, labels)
+	default:
+		return false, nil
+	}
+}
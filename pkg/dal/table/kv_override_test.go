@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+func TestKvOverrideGroupMatchInstance(t *testing.T) {
+	sel := new(selector.Selector)
+	if err := json.Unmarshal([]byte(`{"labels_and":[{"key":"region","op":"eq","value":"gz"}]}`), sel); err != nil {
+		t.Fatalf("unmarshal selector failed, err: %v", err)
+	}
+
+	custom := &KvOverrideGroup{Mode: GroupModeCustom, Selector: sel}
+	if err := custom.Validate(); err != nil {
+		t.Errorf("validate custom group failed, err: %v", err)
+		return
+	}
+	if matched, err := custom.MatchInstance("", map[string]string{"region": "gz"}); err != nil || !matched {
+		t.Errorf("instance in region gz should match the group, err: %v", err)
+	}
+	if matched, _ := custom.MatchInstance("", map[string]string{"region": "sh"}); matched {
+		t.Errorf("instance in region sh should not match the group")
+	}
+
+	debug := &KvOverrideGroup{Mode: GroupModeDebug, UID: "uid-1"}
+	if matched, _ := debug.MatchInstance("uid-1", nil); !matched {
+		t.Errorf("instance uid-1 should match the debug group")
+	}
+
+	def := &KvOverrideGroup{Mode: GroupModeDefault}
+	if err := def.Validate(); err == nil {
+		t.Errorf("default group should not be used to override kv values")
+	}
+}
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKvSpecValidateExpiry(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	earlier := now.Add(-time.Hour)
+
+	fallback := func(v string) *string { return &v }
+
+	cases := []struct {
+		name  string
+		spec  KvSpec
+		valid bool
+	}{
+		{name: "never expire", spec: KvSpec{KvType: KvStr}, valid: true},
+		{name: "fallback without expired at", spec: KvSpec{KvType: KvStr, ExpiredFallback: fallback("a")}},
+		{name: "expired at in the past", spec: KvSpec{KvType: KvStr, ExpiredAt: &earlier}},
+		{name: "expire without fallback", spec: KvSpec{KvType: KvStr, ExpiredAt: &later}, valid: true},
+		{name: "expire with fallback", spec: KvSpec{KvType: KvNumber, ExpiredAt: &later,
+			ExpiredFallback: fallback("1")}, valid: true},
+		{name: "fallback mismatch the type", spec: KvSpec{KvType: KvNumber, ExpiredAt: &later,
+			ExpiredFallback: fallback("a")}},
+		{name: "empty fallback", spec: KvSpec{KvType: KvStr, ExpiredAt: &later, ExpiredFallback: fallback("")},
+			valid: true},
+		{name: "empty fallback of number", spec: KvSpec{KvType: KvNumber, ExpiredAt: &later,
+			ExpiredFallback: fallback("")}},
+		{name: "fallback of secret", spec: KvSpec{KvType: KvSecret, ExpiredAt: &later, ExpiredFallback: fallback("a")}},
+	}
+
+	for _, c := range cases {
+		err := c.spec.ValidateExpiry(now)
+		if c.valid && err != nil {
+			t.Errorf("%s: expect valid, but got err: %v", c.name, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s: expect invalid, but got nil err", c.name)
+		}
+	}
+
+	spec := KvSpec{ExpiredAt: &later}
+	if spec.Expired(now) || !spec.Expired(later) {
+		t.Errorf("kv expired at %v is not expired correctly", later)
+	}
+	if (&KvSpec{}).Expired(now) {
+		t.Errorf("kv without expired at should never expire")
+	}
+}
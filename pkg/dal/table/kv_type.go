@@ -0,0 +1,264 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// kvBytesUnits is the multiple of bytes-size units, K/M/G is decimal and Ki/Mi/Gi is binary.
+var kvBytesUnits = map[string]uint64{
+	"": 1, "b": 1,
+	"k": 1e3, "kb": 1e3, "ki": 1 << 10, "kib": 1 << 10,
+	"m": 1e6, "mb": 1e6, "mi": 1 << 20, "mib": 1 << 20,
+	"g": 1e9, "gb": 1e9, "gi": 1 << 30, "gib": 1 << 30,
+	"t": 1e12, "tb": 1e12, "ti": 1 << 40, "tib": 1 << 40,
+}
+
+var kvBytesRegexp = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-zA-Z]*)$`)
+
+// ParseKvBytes parse the bytes-size value, e.g. 512Mi, 1.5GB, 1024.
+func ParseKvBytes(value string) (uint64, error) {
+	matches := kvBytesRegexp.FindStringSubmatch(strings.TrimSpace(value))
+	if len(matches) != 3 {
+		return 0, fmt.Errorf("value %s is not a bytes size, e.g. 512Mi, 1GB", value)
+	}
+
+	unit, ok := kvBytesUnits[strings.ToLower(matches[2])]
+	if !ok {
+		return 0, fmt.Errorf("unsupported bytes size unit %s", matches[2])
+	}
+	num, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("value %s is not a bytes size, err: %v", value, err)
+	}
+	size := num * float64(unit)
+	if size > math.MaxInt64 {
+		return 0, fmt.Errorf("bytes size %s is too large", value)
+	}
+
+	return uint64(size), nil
+}
+
+// ParseKvCertBundle parse the certificate bundle kv, which contains the PEM encoded certificate chain
+// (leaf certificate first) and the private key of the leaf certificate.
+func ParseKvCertBundle(value string) (*CertInfo, error) {
+	pair, err := tls.X509KeyPair([]byte(value), []byte(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate bundle, err: %v", err)
+	}
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate, err: %v", err)
+	}
+
+	return newCertInfo(leaf), nil
+}
+
+// NormalizeValue validate the value of the structured kv types and returns its canonical form,
+// the values which have same canonical form are semantically equal.
+func (k DataType) NormalizeValue(value string) (string, error) {
+	switch k {
+	case KvBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("value %s is not a bool", value)
+		}
+		return strconv.FormatBool(b), nil
+	case KvDuration:
+		d, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil {
+			return "", fmt.Errorf("value %s is not a duration, e.g. 30s, 1h30m", value)
+		}
+		return d.String(), nil
+	case KvBytes:
+		size, err := ParseKvBytes(value)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(size, 10), nil
+	case KvList:
+		var list []interface{}
+		if err := json.Unmarshal([]byte(value), &list); err != nil {
+			return "", errors.New("value is not a list, it should be a json array")
+		}
+		for _, one := range list {
+			switch one.(type) {
+			case string, float64, bool:
+			default:
+				return "", errors.New("the element of list should be string, number or bool")
+			}
+		}
+		b, _ := json.Marshal(list)
+		return string(b), nil
+	case KvJson:
+		var v interface{}
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return "", fmt.Errorf("value is not a json")
+		}
+		b, _ := json.Marshal(v)
+		return string(b), nil
+	case KvYAML:
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+			return "", fmt.Errorf("value is not a yaml, err: %v", err)
+		}
+		b, _ := yaml.Marshal(v)
+		return string(b), nil
+	case KvCertBundle:
+		if _, err := ParseKvCertBundle(value); err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(value), nil
+	default:
+		return value, nil
+	}
+}
+
+// DiffValue compare the two values of the kv type semantically, returns the readable changes,
+// it's empty if the values are semantically equal.
+func (k DataType) DiffValue(oldValue, newValue string) []string {
+	oldNorm, oErr := k.NormalizeValue(oldValue)
+	newNorm, nErr := k.NormalizeValue(newValue)
+	// 任一值不合法时按原始文本比较
+	if oErr != nil || nErr != nil {
+		if oldValue == newValue {
+			return nil
+		}
+		return []string{"content changed"}
+	}
+	if oldNorm == newNorm {
+		return nil
+	}
+
+	switch k {
+	case KvBool, KvDuration, KvBytes, KvNumber, KvStr:
+		return []string{fmt.Sprintf("%s -> %s", strings.TrimSpace(oldValue), strings.TrimSpace(newValue))}
+	case KvList:
+		return diffKvList(oldNorm, newNorm)
+	case KvJson, KvYAML:
+		return diffKvObject(k, oldValue, newValue)
+	case KvCertBundle:
+		return diffKvCertBundle(oldValue, newValue)
+	default:
+		return []string{"content changed"}
+	}
+}
+
+// diffKvList returns the added and removed elements of the list.
+func diffKvList(oldNorm, newNorm string) []string {
+	var oldList, newList []interface{}
+	_ = json.Unmarshal([]byte(oldNorm), &oldList)
+	_ = json.Unmarshal([]byte(newNorm), &newList)
+
+	count := make(map[string]int)
+	for _, one := range oldList {
+		count[fmt.Sprint(one)]++
+	}
+	for _, one := range newList {
+		count[fmt.Sprint(one)]--
+	}
+
+	changes := make([]string, 0)
+	for elem, c := range count {
+		for ; c > 0; c-- {
+			changes = append(changes, "- "+elem)
+		}
+		for ; c < 0; c++ {
+			changes = append(changes, "+ "+elem)
+		}
+	}
+	sort.Strings(changes)
+	if len(changes) == 0 {
+		changes = append(changes, "order changed")
+	}
+
+	return changes
+}
+
+// diffKvObject returns the added, removed and modified top-level keys of the json or yaml object.
+func diffKvObject(k DataType, oldValue, newValue string) []string {
+	oldObj, oOk := unmarshalKvObject(k, oldValue)
+	newObj, nOk := unmarshalKvObject(k, newValue)
+	if !oOk || !nOk {
+		return []string{"content changed"}
+	}
+
+	changes := make([]string, 0)
+	for key, ov := range oldObj {
+		nv, exist := newObj[key]
+		switch {
+		case !exist:
+			changes = append(changes, "- "+key)
+		case !reflect.DeepEqual(ov, nv):
+			changes = append(changes, "~ "+key)
+		}
+	}
+	for key := range newObj {
+		if _, exist := oldObj[key]; !exist {
+			changes = append(changes, "+ "+key)
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i][2:] < changes[j][2:] })
+
+	return changes
+}
+
+func unmarshalKvObject(k DataType, value string) (map[string]interface{}, bool) {
+	obj := make(map[string]interface{})
+	var err error
+	if k == KvJson {
+		err = json.Unmarshal([]byte(value), &obj)
+	} else {
+		err = yaml.Unmarshal([]byte(value), &obj)
+	}
+	return obj, err == nil
+}
+
+// diffKvCertBundle returns the changes of the leaf certificate.
+func diffKvCertBundle(oldValue, newValue string) []string {
+	oldInfo, _ := ParseKvCertBundle(oldValue)
+	newInfo, _ := ParseKvCertBundle(newValue)
+
+	changes := make([]string, 0)
+	if oldInfo.Subject != newInfo.Subject {
+		changes = append(changes, fmt.Sprintf("subject: %s -> %s", oldInfo.Subject, newInfo.Subject))
+	}
+	if strings.Join(oldInfo.DNSNames, ",") != strings.Join(newInfo.DNSNames, ",") {
+		changes = append(changes, fmt.Sprintf("dns names: %s -> %s", strings.Join(oldInfo.DNSNames, ","),
+			strings.Join(newInfo.DNSNames, ",")))
+	}
+	if !oldInfo.NotAfter.Equal(newInfo.NotAfter) {
+		changes = append(changes, fmt.Sprintf("not after: %s -> %s", oldInfo.NotAfter.Format(time.RFC3339),
+			newInfo.NotAfter.Format(time.RFC3339)))
+	}
+	if len(changes) == 0 {
+		changes = append(changes, "certificate chain or private key changed")
+	}
+
+	return changes
+}
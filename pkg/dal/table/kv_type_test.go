@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseKvBytes(t *testing.T) {
+	cases := map[string]uint64{
+		"1024":  1024,
+		"512Mi": 512 << 20,
+		"1GB":   1e9,
+		"1.5Ki": 1536,
+		"2 kib": 2048,
+	}
+	for value, expect := range cases {
+		size, err := ParseKvBytes(value)
+		if err != nil {
+			t.Errorf("parse bytes %s failed, err: %v", value, err)
+			return
+		}
+		if size != expect {
+			t.Errorf("parse bytes %s expect %d, but got %d", value, expect, size)
+			return
+		}
+	}
+
+	for _, value := range []string{"", "1Xi", "-1K", "Mi"} {
+		if _, err := ParseKvBytes(value); err == nil {
+			t.Errorf("bytes %s should be invalid", value)
+			return
+		}
+	}
+}
+
+func TestKvDataTypeDiffValue(t *testing.T) {
+	if changes := KvDuration.DiffValue("60s", "1m"); len(changes) != 0 {
+		t.Errorf("60s and 1m should be equal, but got changes: %v", changes)
+		return
+	}
+
+	if changes := KvBytes.DiffValue("1Gi", "1024Mi"); len(changes) != 0 {
+		t.Errorf("1Gi and 1024Mi should be equal, but got changes: %v", changes)
+		return
+	}
+
+	if changes := KvBool.DiffValue("true", "false"); len(changes) != 1 {
+		t.Errorf("true and false should be different, but got changes: %v", changes)
+		return
+	}
+
+	changes := KvList.DiffValue(`["a","b"]`, `["b","c"]`)
+	if len(changes) != 2 || changes[0] != "+ c" || changes[1] != "- a" {
+		t.Errorf("unexpected list changes: %v", changes)
+		return
+	}
+
+	changes = KvJson.DiffValue(`{"a":1,"b":2}`, `{"b":3,"c":4}`)
+	if len(changes) != 3 || changes[0] != "- a" || changes[1] != "~ b" || changes[2] != "+ c" {
+		t.Errorf("unexpected json changes: %v", changes)
+		return
+	}
+
+	if err := KvList.ValidateValue(`[{"a":1}]`); err == nil {
+		t.Errorf("list with object element should be invalid")
+		return
+	}
+}
+
+func TestParseKvCertBundle(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key failed, err: %v", err)
+	}
+	notAfter := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "bscp.example.com"},
+		DNSNames:     []string{"bscp.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate failed, err: %v", err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal private key failed, err: %v", err)
+	}
+	certPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	keyPem := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+
+	info, err := ParseKvCertBundle(certPem + keyPem)
+	if err != nil {
+		t.Errorf("parse certificate bundle failed, err: %v", err)
+		return
+	}
+	if !info.NotAfter.Equal(notAfter) || info.Subject != "CN=bscp.example.com" {
+		t.Errorf("unexpected certificate bundle info: %+v", info)
+		return
+	}
+
+	if err := KvCertBundle.ValidateValue(certPem); err == nil {
+		t.Errorf("certificate bundle without private key should be invalid")
+		return
+	}
+}
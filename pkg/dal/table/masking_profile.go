@@ -0,0 +1,181 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/masking"
+)
+
+// maxMaskingRules is the max masking rules of an app.
+const maxMaskingRules = 50
+
+// MaskingProfile is the app's masking rules applied to the config content in the read apis for the users
+// who can not view the sensitive data, so the structure can be inspected without the raw secrets.
+type MaskingProfile struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *MaskingProfileSpec       `json:"spec" gorm:"embedded"`
+	Attachment *MaskingProfileAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                 `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the masking profile's database table name.
+func (m *MaskingProfile) TableName() string {
+	return "masking_profiles"
+}
+
+// AppID AuditRes interface
+func (m *MaskingProfile) AppID() uint32 {
+	return m.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (m *MaskingProfile) ResID() uint32 {
+	return m.ID
+}
+
+// ResType AuditRes interface
+func (m *MaskingProfile) ResType() string {
+	return "masking_profile"
+}
+
+// ValidateCreate validate masking profile is valid or not when create it.
+func (m *MaskingProfile) ValidateCreate() error {
+	if m.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return m.validate()
+}
+
+// ValidateUpdate validate masking profile is valid or not when update it.
+func (m *MaskingProfile) ValidateUpdate() error {
+	if m.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return m.validate()
+}
+
+func (m *MaskingProfile) validate() error {
+	if m.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := m.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if m.Attachment == nil || m.Attachment.BizID <= 0 || m.Attachment.AppID <= 0 {
+		return errors.New("biz id and app id should be set")
+	}
+
+	if m.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// MaskingProfileSpec defines all the specifics for masking profile set by user.
+type MaskingProfileSpec struct {
+	Enabled bool         `json:"enabled" gorm:"column:enabled"`
+	Rules   MaskingRules `json:"rules" gorm:"column:rules;type:json"`
+}
+
+// Validate masking profile spec.
+func (m *MaskingProfileSpec) Validate() error {
+	if len(m.Rules) > maxMaskingRules {
+		return fmt.Errorf("masking rules should not exceed %d", maxMaskingRules)
+	}
+
+	names := make(map[string]bool, len(m.Rules))
+	for _, r := range m.Rules {
+		if r == nil || r.Name == "" || r.Pattern == "" {
+			return errors.New("name and pattern of masking rule are required")
+		}
+		if names[r.Name] {
+			return fmt.Errorf("masking rule %s is duplicated", r.Name)
+		}
+		names[r.Name] = true
+	}
+
+	// 校验规则的正则表达式和 JSONPath
+	_, err := m.Masker()
+	return err
+}
+
+// Masker returns the masker with the rules, nil if the profile is disabled or has no rules.
+func (m *MaskingProfileSpec) Masker() (*masking.Masker, error) {
+	if !m.Enabled || len(m.Rules) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]masking.Rule, 0, len(m.Rules))
+	for _, r := range m.Rules {
+		rules = append(rules, masking.Rule{
+			Name:        r.Name,
+			Type:        masking.RuleType(r.Type),
+			Pattern:     r.Pattern,
+			Replacement: r.Replacement,
+		})
+	}
+
+	return masking.New(rules)
+}
+
+// MaskingRule is a masking rule of the app.
+type MaskingRule struct {
+	Name string `json:"name"`
+	// Type regex 或 jsonpath
+	Type string `json:"type"`
+	// Pattern 正则表达式或 JSONPath, 正则有捕获组时只替换第一个捕获组
+	Pattern string `json:"pattern"`
+	// Replacement 替换的内容, 为空时为 ******
+	Replacement string `json:"replacement"`
+}
+
+// MaskingRules is the masking rules of the app.
+type MaskingRules []*MaskingRule
+
+// Value implements the driver.Valuer interface.
+func (r MaskingRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	return json.Marshal(r)
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *MaskingRules) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported masking rules raw type: %T", v)
+	}
+}
+
+// MaskingProfileAttachment defines the masking profile attachments.
+type MaskingProfileAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
@@ -0,0 +1,295 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+// MaxNotificationChannelsPerBiz is the max notification channel count of one biz.
+const MaxNotificationChannelsPerBiz = 50
+
+// NotificationChannel is a chatops channel (wecom bot, slack webhook or email) which receives
+// the messages of publish and approval events of the biz or one of its apps.
+type NotificationChannel struct {
+	ID         uint32                         `json:"id" gorm:"primaryKey"`
+	Spec       *NotificationChannelSpec       `json:"spec" gorm:"embedded"`
+	Attachment *NotificationChannelAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                      `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the notification channel's database table name.
+func (n *NotificationChannel) TableName() string {
+	return "notification_channels"
+}
+
+// AppID AuditRes interface
+func (n *NotificationChannel) AppID() uint32 {
+	if n.Attachment == nil {
+		return 0
+	}
+	return n.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (n *NotificationChannel) ResID() uint32 {
+	return n.ID
+}
+
+// ResType AuditRes interface
+func (n *NotificationChannel) ResType() string {
+	return "notification_channel"
+}
+
+// ValidateCreate validate notification channel is valid or not when create it.
+func (n *NotificationChannel) ValidateCreate(kit *kit.Kit) error {
+	if n.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return n.validate(kit)
+}
+
+// ValidateUpdate validate notification channel is valid or not when update it.
+func (n *NotificationChannel) ValidateUpdate(kit *kit.Kit) error {
+	if n.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return n.validate(kit)
+}
+
+func (n *NotificationChannel) validate(kit *kit.Kit) error {
+	if n.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := n.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if n.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := n.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if n.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// NotificationChannelSpec defines all the specifics for notification channel set by user.
+type NotificationChannelSpec struct {
+	Name string                  `json:"name" gorm:"column:name"`
+	Type NotificationChannelType `json:"type" gorm:"column:type"`
+	// Target 企业微信/Slack 为 webhook 地址, 邮件为逗号分隔的收件人列表
+	Target string             `json:"target" gorm:"column:target"`
+	Events NotificationEvents `json:"events" gorm:"column:events;type:json"`
+	// Template 自定义的消息模版(Go text/template), 为空时使用内置模版
+	Template string `json:"template" gorm:"column:template"`
+	Enabled  bool   `json:"enabled" gorm:"column:enabled"`
+	Memo     string `json:"memo" gorm:"column:memo"`
+}
+
+// Validate notification channel spec.
+func (n *NotificationChannelSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, n.Name); err != nil {
+		return err
+	}
+
+	if err := validator.ValidateMemo(kit, n.Memo, false); err != nil {
+		return err
+	}
+
+	if err := n.Type.Validate(); err != nil {
+		return err
+	}
+
+	if err := n.validateTarget(); err != nil {
+		return err
+	}
+
+	if err := n.Events.Validate(); err != nil {
+		return err
+	}
+
+	if n.Template != "" {
+		if _, err := template.New(n.Name).Parse(n.Template); err != nil {
+			return fmt.Errorf("invalid notification template, err: %v", err)
+		}
+	}
+
+	return nil
+}
+
+func (n *NotificationChannelSpec) validateTarget() error {
+	if n.Target == "" {
+		return errors.New("notification target is required")
+	}
+
+	if n.Type == NotificationEmail {
+		for _, addr := range n.Recipients() {
+			if _, err := mail.ParseAddress(addr); err != nil {
+				return fmt.Errorf("invalid email address %s", addr)
+			}
+		}
+		return nil
+	}
+
+	u, err := url.Parse(n.Target)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid %s webhook url", n.Type)
+	}
+
+	return nil
+}
+
+// Recipients returns the email recipients of the email channel.
+func (n *NotificationChannelSpec) Recipients() []string {
+	recipients := make([]string, 0)
+	for _, addr := range strings.Split(n.Target, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			recipients = append(recipients, addr)
+		}
+	}
+	return recipients
+}
+
+// Subscribed returns whether the channel subscribes the event.
+func (n *NotificationChannelSpec) Subscribed(event NotificationEvent) bool {
+	for _, e := range n.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationChannelType is the type of notification channel.
+type NotificationChannelType string
+
+const (
+	// NotificationWeCom sends markdown message by wecom group bot webhook.
+	NotificationWeCom NotificationChannelType = "wecom"
+	// NotificationSlack sends message by slack incoming webhook.
+	NotificationSlack NotificationChannelType = "slack"
+	// NotificationEmail sends email by the smtp server configured in data service.
+	NotificationEmail NotificationChannelType = "email"
+)
+
+// Validate notification channel type.
+func (t NotificationChannelType) Validate() error {
+	switch t {
+	case NotificationWeCom, NotificationSlack, NotificationEmail:
+	default:
+		return fmt.Errorf("unsupported notification channel type: %s", t)
+	}
+
+	return nil
+}
+
+// NotificationEvent is the publish or approval event which can be notified.
+type NotificationEvent string
+
+const (
+	// PublishStarted the release is being published, e.g. approved and waiting for the publish time.
+	PublishStarted NotificationEvent = "publish_started"
+	// PublishSucceeded the release is published.
+	PublishSucceeded NotificationEvent = "publish_succeeded"
+	// PublishFailed the publish is rejected or failed.
+	PublishFailed NotificationEvent = "publish_failed"
+	// ApprovalRequested the publish is submitted and waiting for the approvers.
+	ApprovalRequested NotificationEvent = "approval_requested"
+)
+
+// Validate notification event.
+func (e NotificationEvent) Validate() error {
+	switch e {
+	case PublishStarted, PublishSucceeded, PublishFailed, ApprovalRequested:
+	default:
+		return fmt.Errorf("unsupported notification event: %s", e)
+	}
+
+	return nil
+}
+
+// NotificationEvents is the events subscribed by the notification channel.
+type NotificationEvents []NotificationEvent
+
+// Validate notification events.
+func (e NotificationEvents) Validate() error {
+	if len(e) == 0 {
+		return errors.New("at least one notification event should be subscribed")
+	}
+
+	for _, one := range e {
+		if err := one.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (e NotificationEvents) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	return json.Marshal(e)
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *NotificationEvents) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported notification events raw type: %T", v)
+	}
+}
+
+// NotificationChannelAttachment defines the notification channel attachments.
+type NotificationChannelAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// AppID 为 0 时对业务下所有服务生效
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether notification channel attachment is valid or not.
+func (n *NotificationChannelAttachment) Validate() error {
+	if n.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	return nil
+}
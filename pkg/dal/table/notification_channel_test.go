@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestNotificationChannelSpecValidate(t *testing.T) {
+	spec := &NotificationChannelSpec{
+		Name:   "ops",
+		Type:   NotificationEmail,
+		Target: "alice@example.com, bob@example.com",
+		Events: NotificationEvents{PublishFailed},
+	}
+	if err := spec.validateTarget(); err != nil {
+		t.Errorf("validate email target failed, err: %v", err)
+	}
+	if got := spec.Recipients(); len(got) != 2 || got[1] != "bob@example.com" {
+		t.Errorf("unexpected recipients: %v", got)
+	}
+	if !spec.Subscribed(PublishFailed) || spec.Subscribed(PublishSucceeded) {
+		t.Errorf("only publish failed event should be subscribed")
+	}
+
+	spec.Type = NotificationSlack
+	if err := spec.validateTarget(); err == nil {
+		t.Errorf("slack target should be a webhook url")
+	}
+	spec.Target = "https://hooks.slack.com/services/T000/B000/XXXX"
+	if err := spec.validateTarget(); err != nil {
+		t.Errorf("validate slack target failed, err: %v", err)
+	}
+
+	if err := (NotificationEvents{}).Validate(); err == nil {
+		t.Errorf("empty events should be rejected")
+	}
+	if err := (NotificationEvents{"publish_done"}).Validate(); err == nil {
+		t.Errorf("unknown event should be rejected")
+	}
+}
@@ -0,0 +1,166 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxOperationErrors is the max count of the partial errors recorded in an operation.
+	MaxOperationErrors = 100
+	// OperationHeartbeatTimeout is the timeout of the running operation's heartbeat, the running operation without
+	// heartbeat after the timeout is regarded as interrupted, e.g. the data-service restarted.
+	OperationHeartbeatTimeout = 5 * time.Minute
+	// OperationRetention is the retention of the finished operations.
+	OperationRetention = 7 * 24 * time.Hour
+)
+
+// OperationType is the type of the async operation.
+type OperationType string
+
+const (
+	// AppCloneOperation clone an app.
+	AppCloneOperation OperationType = "app_clone"
+	// HookTemplateUpgradeOperation upgrade the hooks of all the apps referencing the hook template.
+	HookTemplateUpgradeOperation OperationType = "hook_template_upgrade"
+)
+
+// OperationState is the state of the async operation.
+type OperationState string
+
+const (
+	// OperationPending the operation is created and waiting to run.
+	OperationPending OperationState = "pending"
+	// OperationRunning the operation is running.
+	OperationRunning OperationState = "running"
+	// OperationSucceeded all the items of the operation succeeded.
+	OperationSucceeded OperationState = "succeeded"
+	// OperationFailed the operation failed or part of the items failed.
+	OperationFailed OperationState = "failed"
+	// OperationCanceled the operation is canceled by the user.
+	OperationCanceled OperationState = "canceled"
+)
+
+// Finished returns whether the operation is finished.
+func (s OperationState) Finished() bool {
+	return s == OperationSucceeded || s == OperationFailed || s == OperationCanceled
+}
+
+// Operation is a long-running async operation, e.g. app clone, the api returns the operation id immediately
+// and the client polls the operation for the progress and the result.
+type Operation struct {
+	ID         uint32               `json:"id" gorm:"primaryKey"`
+	Spec       *OperationSpec       `json:"spec" gorm:"embedded"`
+	Attachment *OperationAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision            `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the operation's database table name.
+func (o *Operation) TableName() string {
+	return "operations"
+}
+
+// AppID AuditRes interface
+func (o *Operation) AppID() uint32 {
+	return o.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (o *Operation) ResID() uint32 {
+	return o.ID
+}
+
+// ResType AuditRes interface
+func (o *Operation) ResType() string {
+	return "operation"
+}
+
+// ValidateCreate validate operation is valid or not when create it.
+func (o *Operation) ValidateCreate() error {
+	if o.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if o.Spec == nil || o.Spec.Type == "" {
+		return errors.New("operation type not set")
+	}
+
+	if o.Attachment == nil || o.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if o.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return o.Revision.ValidateCreate()
+}
+
+// OperationSpec defines all the specifics for operation.
+type OperationSpec struct {
+	Type  OperationType  `json:"type" gorm:"column:type"`
+	State OperationState `json:"state" gorm:"column:state"`
+	// Total 需要处理的条目总数, Succeeded/Failed 为已处理的条目数
+	Total     uint32          `json:"total" gorm:"column:total"`
+	Succeeded uint32          `json:"succeeded" gorm:"column:succeeded"`
+	Failed    uint32          `json:"failed" gorm:"column:failed"`
+	Errors    OperationErrors `json:"errors" gorm:"column:errors;type:json"`
+	// Result 操作完成后的结果, 为 json 格式, 例如复制服务后的服务ID
+	Result          string     `json:"result" gorm:"column:result"`
+	CancelRequested bool       `json:"cancel_requested" gorm:"column:cancel_requested"`
+	FinishedAt      *time.Time `json:"finished_at" gorm:"column:finished_at"`
+}
+
+// OperationError is a partial error of the operation.
+type OperationError struct {
+	// Item 失败的条目, 例如脚本ID
+	Item    string `json:"item"`
+	Message string `json:"message"`
+}
+
+// OperationErrors is the partial errors of the operation.
+type OperationErrors []*OperationError
+
+// Value implements the driver.Valuer interface.
+func (e OperationErrors) Value() (driver.Value, error) {
+	if e == nil {
+		return "[]", nil
+	}
+	return json.Marshal(e)
+}
+
+// Scan implements the sql.Scanner interface.
+func (e *OperationErrors) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported operation errors raw type: %T", v)
+	}
+}
+
+// OperationAttachment defines the operation attachments.
+type OperationAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// AppID 操作所属的服务, 业务级的操作为 0
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
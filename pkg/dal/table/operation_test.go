@@ -0,0 +1,50 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import "testing"
+
+func TestOperationErrorsValueScan(t *testing.T) {
+	var empty OperationErrors
+	v, err := empty.Value()
+	if err != nil || v != "[]" {
+		t.Errorf("nil operation errors should be saved as [], got %v, err: %v", v, err)
+	}
+
+	errs := OperationErrors{{Item: "hook 1", Message: "render failed"}}
+	v, err = errs.Value()
+	if err != nil {
+		t.Fatalf("marshal operation errors failed, err: %v", err)
+	}
+
+	var got OperationErrors
+	if err = got.Scan(v); err != nil {
+		t.Fatalf("scan operation errors failed, err: %v", err)
+	}
+	if len(got) != 1 || got[0].Item != "hook 1" || got[0].Message != "render failed" {
+		t.Errorf("unexpected operation errors: %+v", got)
+	}
+}
+
+func TestOperationStateFinished(t *testing.T) {
+	for _, s := range []OperationState{OperationSucceeded, OperationFailed, OperationCanceled} {
+		if !s.Finished() {
+			t.Errorf("operation state %s should be finished", s)
+		}
+	}
+	for _, s := range []OperationState{OperationPending, OperationRunning} {
+		if s.Finished() {
+			t.Errorf("operation state %s should not be finished", s)
+		}
+	}
+}
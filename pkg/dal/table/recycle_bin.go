@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RecycleBin is a deleted resource kept in the recycle bin, it can be restored
+// before it's expired, and is purged after the retention window.
+type RecycleBin struct {
+	ID         uint32                `json:"id" gorm:"primaryKey"`
+	Spec       *RecycleBinSpec       `json:"spec" gorm:"embedded"`
+	Attachment *RecycleBinAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *CreatedRevision      `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the recycle bin's database table name.
+func (r *RecycleBin) TableName() string {
+	return "recycle_bins"
+}
+
+// AppID AuditRes interface
+func (r *RecycleBin) AppID() uint32 {
+	return r.Attachment.AppID
+}
+
+// ResID AuditRes interface
+func (r *RecycleBin) ResID() uint32 {
+	return r.ID
+}
+
+// ResType AuditRes interface
+func (r *RecycleBin) ResType() string {
+	return "recycle_bin"
+}
+
+// ValidateCreate validate recycle bin is valid or not when create it.
+func (r *RecycleBin) ValidateCreate() error {
+	if r.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if r.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := r.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if r.Attachment == nil {
+		return errors.New("attachment not set")
+	}
+
+	if err := r.Attachment.Validate(); err != nil {
+		return err
+	}
+
+	if r.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return r.Revision.Validate()
+}
+
+// RecycleBinSpec defines all the specifics for the deleted resource.
+type RecycleBinSpec struct {
+	ResType RecycleResType `json:"res_type" gorm:"column:res_type"`
+	ResID   uint32         `json:"res_id" gorm:"column:res_id"`
+	// Name is the readable name of the resource, app name, config item's absolute path or kv's key.
+	Name string `json:"name" gorm:"column:name"`
+	// Data is the json snapshot of the deleted resource which is used to restore it.
+	Data      string    `json:"data" gorm:"column:data"`
+	ExpiredAt time.Time `json:"expired_at" gorm:"column:expired_at"`
+}
+
+// Validate recycle bin spec.
+func (r *RecycleBinSpec) Validate() error {
+	if err := r.ResType.Validate(); err != nil {
+		return err
+	}
+
+	if r.ResID <= 0 {
+		return errors.New("res id not set")
+	}
+
+	if len(r.Data) == 0 {
+		return errors.New("data not set")
+	}
+
+	if r.ExpiredAt.IsZero() {
+		return errors.New("expired at not set")
+	}
+
+	return nil
+}
+
+// RecycleBinAttachment defines the recycle bin attachments.
+type RecycleBinAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// Validate whether recycle bin attachment is valid or not.
+func (r *RecycleBinAttachment) Validate() error {
+	if r.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if r.AppID <= 0 {
+		return errors.New("invalid attachment app id")
+	}
+
+	return nil
+}
+
+// RecycleResType is the type of the resource in recycle bin.
+type RecycleResType string
+
+const (
+	// RecycleApp 服务
+	RecycleApp RecycleResType = "app"
+	// RecycleConfigItem 文件型服务的配置文件
+	RecycleConfigItem RecycleResType = "config_item"
+	// RecycleKv 键值型服务的配置项
+	RecycleKv RecycleResType = "kv"
+)
+
+// Validate the recycle resource type is valid or not.
+func (r RecycleResType) Validate() error {
+	switch r {
+	case RecycleApp:
+	case RecycleConfigItem:
+	case RecycleKv:
+	default:
+		return fmt.Errorf("unsupported recycle resource type: %s", r)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecycleBinValidateCreate(t *testing.T) {
+	rb := &RecycleBin{
+		Spec: &RecycleBinSpec{
+			ResType:   RecycleKv,
+			ResID:     10,
+			Name:      "timeout",
+			Data:      `{"id":10}`,
+			ExpiredAt: time.Now().Add(time.Hour),
+		},
+		Attachment: &RecycleBinAttachment{BizID: 1, AppID: 2},
+		Revision:   &CreatedRevision{Creator: "admin"},
+	}
+	if err := rb.ValidateCreate(); err != nil {
+		t.Errorf("validate recycle bin failed, err: %v", err)
+		return
+	}
+
+	rb.Spec.ResType = "release"
+	if err := rb.ValidateCreate(); err == nil {
+		t.Errorf("recycle bin with unsupported resource type should be invalid")
+		return
+	}
+
+	rb.Spec.ResType = RecycleApp
+	rb.Spec.Data = ""
+	if err := rb.ValidateCreate(); err == nil {
+		t.Errorf("recycle bin without snapshot data should be invalid")
+		return
+	}
+}
@@ -124,6 +124,8 @@ type ReleaseSpec struct {
 	PublishNum uint32 `db:"publish_num" json:"publish_num"`
 	// 是否全量发布过
 	FullyReleased bool `db:"fully_released" json:"fully_released"`
+	// 结构化的版本说明
+	Notes ReleaseNotes `db:"notes" json:"notes"`
 }
 
 // Validate a release specifics when it is created.
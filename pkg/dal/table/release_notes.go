@@ -0,0 +1,191 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+const (
+	// maxReleaseNoteFields is the max structured field count of a release notes policy.
+	maxReleaseNoteFields = 20
+	// maxReleaseNotesContentLen is the max length of the release notes content.
+	maxReleaseNotesContentLen = 4096
+	// maxReleaseNoteFieldValueLen is the max length of a release note field's value.
+	maxReleaseNoteFieldValueLen = 512
+)
+
+// ReleaseNotesPolicy defines how the release notes of an app's releases should be written.
+type ReleaseNotesPolicy struct {
+	// Required 为 true 时生成版本必须填写版本说明
+	Required bool `json:"required"`
+	// Fields 版本说明的结构化字段模版, 如 "ticket_id"
+	Fields []*ReleaseNoteField `json:"fields"`
+}
+
+// ReleaseNoteField is a structured field template of the release notes.
+type ReleaseNoteField struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	// Pattern 字段值需满足的正则表达式, 为空时不校验
+	Pattern string `json:"pattern"`
+}
+
+// Validate the release notes policy itself.
+func (p ReleaseNotesPolicy) Validate(kit *kit.Kit) error {
+	if len(p.Fields) > maxReleaseNoteFields {
+		return errf.Errorf(errf.InvalidArgument,
+			i18n.T(kit, "release note fields exceed the limit %d", maxReleaseNoteFields))
+	}
+
+	names := make(map[string]bool, len(p.Fields))
+	for _, f := range p.Fields {
+		if f == nil || strings.TrimSpace(f.Name) == "" {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "release note field name is required"))
+		}
+		if names[f.Name] {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "release note field %s is duplicated", f.Name))
+		}
+		names[f.Name] = true
+
+		if f.Pattern != "" {
+			if _, err := regexp.Compile(f.Pattern); err != nil {
+				return errf.Errorf(errf.InvalidArgument,
+					i18n.T(kit, "release note field %s pattern is invalid, err: %v", f.Name, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateNotes validate the release notes against the policy.
+func (p ReleaseNotesPolicy) ValidateNotes(kit *kit.Kit, notes ReleaseNotes) error {
+	if len(notes.Content) > maxReleaseNotesContentLen {
+		return errf.Errorf(errf.InvalidArgument,
+			i18n.T(kit, "release notes content exceeds the limit %d", maxReleaseNotesContentLen))
+	}
+
+	if notes.IsEmpty() {
+		if p.Required {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "release notes is required by the app's policy"))
+		}
+		return nil
+	}
+
+	fields := make(map[string]*ReleaseNoteField, len(p.Fields))
+	for _, f := range p.Fields {
+		fields[f.Name] = f
+	}
+
+	for name, value := range notes.Fields {
+		if _, ok := fields[name]; !ok {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "release note field %s is not defined", name))
+		}
+		if len(value) > maxReleaseNoteFieldValueLen {
+			return errf.Errorf(errf.InvalidArgument,
+				i18n.T(kit, "release note field %s exceeds the limit %d", name, maxReleaseNoteFieldValueLen))
+		}
+	}
+
+	for _, f := range p.Fields {
+		value := strings.TrimSpace(notes.Fields[f.Name])
+		if value == "" {
+			if f.Required {
+				return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "release note field %s is required", f.Name))
+			}
+			continue
+		}
+
+		if f.Pattern == "" {
+			continue
+		}
+		// pattern 在策略保存时已校验, 这里编译失败视为不匹配
+		re, err := regexp.Compile(f.Pattern)
+		if err != nil || !re.MatchString(value) {
+			return errf.Errorf(errf.InvalidArgument,
+				i18n.T(kit, "release note field %s does not match the pattern %s", f.Name, f.Pattern))
+		}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (p ReleaseNotesPolicy) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan implements the sql.Scanner interface.
+func (p *ReleaseNotesPolicy) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, p)
+	case string:
+		return json.Unmarshal([]byte(v), p)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported release notes policy raw type: %T", v)
+	}
+}
+
+// ReleaseNotes is the structured notes of a release.
+type ReleaseNotes struct {
+	// Content 版本说明正文
+	Content string `json:"content"`
+	// Fields 按服务策略中的字段模版填写的结构化字段
+	Fields map[string]string `json:"fields"`
+}
+
+// IsEmpty returns true if nothing is written in the release notes.
+func (n ReleaseNotes) IsEmpty() bool {
+	if strings.TrimSpace(n.Content) != "" {
+		return false
+	}
+
+	for _, v := range n.Fields {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value implements the driver.Valuer interface.
+func (n ReleaseNotes) Value() (driver.Value, error) {
+	return json.Marshal(n)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *ReleaseNotes) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, n)
+	case string:
+		return json.Unmarshal([]byte(v), n)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported release notes raw type: %T", v)
+	}
+}
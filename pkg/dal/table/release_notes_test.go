@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+func TestReleaseNotesPolicyValidateNotes(t *testing.T) {
+	kt := kit.New()
+
+	optional := ReleaseNotesPolicy{}
+	if err := optional.ValidateNotes(kt, ReleaseNotes{}); err != nil {
+		t.Errorf("empty notes should be allowed by optional policy, err: %v", err)
+	}
+
+	policy := ReleaseNotesPolicy{
+		Required: true,
+		Fields: []*ReleaseNoteField{
+			{Name: "ticket_id", Required: true, Pattern: `^[A-Z]+-\d+$`},
+			{Name: "owner"},
+		},
+	}
+	if err := policy.Validate(kt); err != nil {
+		t.Errorf("validate policy failed, err: %v", err)
+		return
+	}
+
+	cases := []struct {
+		notes ReleaseNotes
+		valid bool
+	}{
+		{notes: ReleaseNotes{}, valid: false},
+		{notes: ReleaseNotes{Content: "fix timeout"}, valid: false},
+		{notes: ReleaseNotes{Fields: map[string]string{"ticket_id": "bscp-1"}}, valid: false},
+		{notes: ReleaseNotes{Fields: map[string]string{"ticket_id": "BSCP-1", "unknown": "x"}}, valid: false},
+		{notes: ReleaseNotes{Content: "fix timeout", Fields: map[string]string{"ticket_id": "BSCP-1"}}, valid: true},
+	}
+	for idx, c := range cases {
+		err := policy.ValidateNotes(kt, c.notes)
+		if c.valid && err != nil {
+			t.Errorf("case %d should be valid, err: %v", idx, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("case %d should be invalid", idx)
+		}
+	}
+
+	dup := ReleaseNotesPolicy{Fields: []*ReleaseNoteField{{Name: "a"}, {Name: "a"}}}
+	if err := dup.Validate(kt); err == nil {
+		t.Errorf("duplicated field name should be rejected")
+	}
+}
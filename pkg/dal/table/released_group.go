@@ -35,12 +35,14 @@ var ReleasedGroupColumnDescriptor = mergeColumnDescriptors("",
 		{Column: "selector", NamedC: "selector", Type: enumor.String},
 		{Column: "uid", NamedC: "uid", Type: enumor.String},
 		{Column: "edited", NamedC: "edited", Type: enumor.Boolean},
+		{Column: "pinned", NamedC: "pinned", Type: enumor.Boolean},
 		{Column: "biz_id", NamedC: "biz_id", Type: enumor.Numeric},
 		{Column: "reviser", NamedC: "reviser", Type: enumor.String},
 		{Column: "updated_at", NamedC: "updated_at", Type: enumor.Time},
 	})
 
 // ReleasedGroup defines a basic configuration item
+// the pinned group keeps it's release, the subsequent publishes of the app skip it until it's unpinned.
 type ReleasedGroup struct {
 	// ID is an auto-increased value, which is a group app's
 	// unique identity.
@@ -53,6 +55,7 @@ type ReleasedGroup struct {
 	Selector   *selector.Selector `db:"selector" json:"selector" gorm:"column:selector;type:json"`
 	UID        string             `db:"uid" json:"uid" gorm:"column:uid"`
 	Edited     bool               `db:"edited" json:"edited" gorm:"column:edited"`
+	Pinned     bool               `db:"pinned" json:"pinned" gorm:"column:pinned"`
 	BizID      uint32             `db:"biz_id" json:"biz_id" gorm:"column:biz_id"`
 	Reviser    string             `db:"reviser" json:"reviser" gorm:"column:reviser"`
 	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at" gorm:"column:updated_at"`
@@ -29,6 +29,9 @@ type ReleasedKv struct {
 	Attachment  *KvAttachment `json:"attachment" gorm:"embedded"`
 	Revision    *Revision     `json:"revision" gorm:"embedded"`
 	ContentSpec *ContentSpec  `json:"content_spec" gorm:"embedded"`
+
+	// ExpiryNotified is whether the expiry event of the released kv has been fired.
+	ExpiryNotified bool `json:"expiry_notified" gorm:"column:expiry_notified"`
 }
 
 // TableName is the ReleasedKv's database table name.
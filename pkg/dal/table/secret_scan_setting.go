@@ -0,0 +1,241 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/secretscan"
+)
+
+// maxSecretScanCustomRules is the max custom rules of a biz.
+const maxSecretScanCustomRules = 50
+
+// SecretScanSetting is the biz's setting of the secret leak scanning, which is applied to the
+// config content when it is uploaded or committed.
+type SecretScanSetting struct {
+	ID         uint32                       `json:"id" gorm:"primaryKey"`
+	Spec       *SecretScanSettingSpec       `json:"spec" gorm:"embedded"`
+	Attachment *SecretScanSettingAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                    `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the secret scan setting's database table name.
+func (s *SecretScanSetting) TableName() string {
+	return "secret_scan_settings"
+}
+
+// AppID AuditRes interface
+func (s *SecretScanSetting) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (s *SecretScanSetting) ResID() uint32 {
+	return s.ID
+}
+
+// ResType AuditRes interface
+func (s *SecretScanSetting) ResType() string {
+	return "secret_scan_setting"
+}
+
+// ValidateCreate validate secret scan setting is valid or not when create it.
+func (s *SecretScanSetting) ValidateCreate() error {
+	if s.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return s.validate()
+}
+
+// ValidateUpdate validate secret scan setting is valid or not when update it.
+func (s *SecretScanSetting) ValidateUpdate() error {
+	if s.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return s.validate()
+}
+
+func (s *SecretScanSetting) validate() error {
+	if s.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := s.Spec.Validate(); err != nil {
+		return err
+	}
+
+	if s.Attachment == nil || s.Attachment.BizID <= 0 {
+		return errors.New("biz id should be set")
+	}
+
+	if s.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return nil
+}
+
+// DefaultSecretScanSettingSpec is the setting used by the biz which has not set it,
+// all the builtin rules are enabled and the findings are only warned.
+func DefaultSecretScanSettingSpec() *SecretScanSettingSpec {
+	return &SecretScanSettingSpec{
+		Mode:          SecretScanWarn,
+		DisabledRules: SecretScanRuleNames{},
+		CustomRules:   SecretScanRules{},
+	}
+}
+
+// SecretScanSettingSpec defines all the specifics for secret scan setting set by user.
+type SecretScanSettingSpec struct {
+	Mode SecretScanMode `json:"mode" gorm:"column:mode"`
+	// DisabledRules 关闭的内置规则
+	DisabledRules SecretScanRuleNames `json:"disabled_rules" gorm:"column:disabled_rules;type:json"`
+	// CustomRules 业务自定义的规则
+	CustomRules SecretScanRules `json:"custom_rules" gorm:"column:custom_rules;type:json"`
+}
+
+// Validate secret scan setting spec.
+func (s *SecretScanSettingSpec) Validate() error {
+	if err := s.Mode.Validate(); err != nil {
+		return err
+	}
+
+	for _, name := range s.DisabledRules {
+		if !secretscan.IsBuiltinRule(name) {
+			return fmt.Errorf("unknown builtin secret scan rule: %s", name)
+		}
+	}
+
+	if len(s.CustomRules) > maxSecretScanCustomRules {
+		return fmt.Errorf("custom secret scan rules should not exceed %d", maxSecretScanCustomRules)
+	}
+
+	names := make(map[string]bool, len(s.CustomRules))
+	for _, r := range s.CustomRules {
+		if r == nil || r.Name == "" || r.Pattern == "" {
+			return errors.New("name and pattern of custom secret scan rule are required")
+		}
+		if names[r.Name] || secretscan.IsBuiltinRule(r.Name) {
+			return fmt.Errorf("secret scan rule %s is duplicated", r.Name)
+		}
+		names[r.Name] = true
+	}
+
+	// 校验自定义规则的正则表达式
+	_, err := s.Scanner()
+	return err
+}
+
+// Scanner returns the scanner with the enabled rules, nil if the scanning is off.
+func (s *SecretScanSettingSpec) Scanner() (*secretscan.Scanner, error) {
+	if s.Mode == SecretScanOff {
+		return nil, nil
+	}
+
+	custom := make([]secretscan.CustomRule, 0, len(s.CustomRules))
+	for _, r := range s.CustomRules {
+		custom = append(custom, secretscan.CustomRule{Name: r.Name, Pattern: r.Pattern})
+	}
+
+	return secretscan.New(s.DisabledRules, custom)
+}
+
+const (
+	// SecretScanOff means the config content is not scanned.
+	SecretScanOff SecretScanMode = "off"
+	// SecretScanWarn means the findings are only logged as warnings.
+	SecretScanWarn SecretScanMode = "warn"
+	// SecretScanBlock means the config content with findings can not be uploaded.
+	SecretScanBlock SecretScanMode = "block"
+)
+
+// SecretScanMode is the handle mode when suspected credentials are found.
+type SecretScanMode string
+
+// Validate the secret scan mode.
+func (m SecretScanMode) Validate() error {
+	switch m {
+	case SecretScanOff, SecretScanWarn, SecretScanBlock:
+	default:
+		return fmt.Errorf("unsupported secret scan mode: %s", m)
+	}
+
+	return nil
+}
+
+// SecretScanRule is a custom secret scan rule.
+type SecretScanRule struct {
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+}
+
+// SecretScanRules is the custom secret scan rules.
+type SecretScanRules []*SecretScanRule
+
+// Value implements the driver.Valuer interface.
+func (r SecretScanRules) Value() (driver.Value, error) {
+	if r == nil {
+		return "[]", nil
+	}
+	return json.Marshal(r)
+}
+
+// Scan implements the sql.Scanner interface.
+func (r *SecretScanRules) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, r)
+	case string:
+		return json.Unmarshal([]byte(v), r)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported secret scan rules raw type: %T", v)
+	}
+}
+
+// SecretScanRuleNames is the names of the builtin secret scan rules.
+type SecretScanRuleNames []string
+
+// Value implements the driver.Valuer interface.
+func (n SecretScanRuleNames) Value() (driver.Value, error) {
+	if n == nil {
+		return "[]", nil
+	}
+	return json.Marshal(n)
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *SecretScanRuleNames) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, n)
+	case string:
+		return json.Unmarshal([]byte(v), n)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported secret scan rule names raw type: %T", v)
+	}
+}
+
+// SecretScanSettingAttachment defines the secret scan setting attachments.
+type SecretScanSettingAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
@@ -0,0 +1,272 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n"
+	"github.com/TencentBlueKing/bk-bscp/pkg/kit"
+)
+
+const (
+	// ServiceAccountUserPrefix is the user name prefix of the service accounts, the user name is
+	// formatted as bscp-sa:{biz_id}:{name}, so that it never conflicts with the human users.
+	ServiceAccountUserPrefix = "bscp-sa:"
+	// ServiceAccountKeyPrefix is the prefix of the service account's api key.
+	ServiceAccountKeyPrefix = "bscp_sa_"
+	// maxServiceAccountNameLen 限制名称长度, 保证服务账号用户名可以写入 creator 等字段
+	maxServiceAccountNameLen = 32
+)
+
+// ServiceAccount is a machine user of a biz for automation, such as the ci pipelines, it is
+// authenticated with the api key and authorized with its own scopes instead of iam.
+type ServiceAccount struct {
+	ID         uint32                    `json:"id" gorm:"primaryKey"`
+	Spec       *ServiceAccountSpec       `json:"spec" gorm:"embedded"`
+	Attachment *ServiceAccountAttachment `json:"attachment" gorm:"embedded"`
+	Revision   *Revision                 `json:"revision" gorm:"embedded"`
+}
+
+// TableName is the service account's database table name.
+func (s *ServiceAccount) TableName() string {
+	return "service_accounts"
+}
+
+// AppID AuditRes interface
+func (s *ServiceAccount) AppID() uint32 {
+	return 0
+}
+
+// ResID AuditRes interface
+func (s *ServiceAccount) ResID() uint32 {
+	return s.ID
+}
+
+// ResType AuditRes interface
+func (s *ServiceAccount) ResType() string {
+	return "service_account"
+}
+
+// User returns the user name of the service account.
+func (s *ServiceAccount) User() string {
+	return ServiceAccountUser(s.Attachment.BizID, s.Spec.Name)
+}
+
+// ValidateCreate validate service account is valid or not when create it.
+func (s *ServiceAccount) ValidateCreate(kit *kit.Kit) error {
+	if s.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	return s.validate(kit, s.Revision.ValidateCreate)
+}
+
+// ValidateUpdate validate service account is valid or not when update it.
+func (s *ServiceAccount) ValidateUpdate(kit *kit.Kit) error {
+	if s.ID <= 0 {
+		return errors.New("id should be set")
+	}
+
+	return s.validate(kit, s.Revision.ValidateUpdate)
+}
+
+func (s *ServiceAccount) validate(kit *kit.Kit, validateRevision func() error) error {
+	if s.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := s.Spec.Validate(kit); err != nil {
+		return err
+	}
+
+	if s.Attachment == nil || s.Attachment.BizID <= 0 {
+		return errors.New("invalid attachment biz id")
+	}
+
+	if s.Revision == nil {
+		return errors.New("revision not set")
+	}
+
+	return validateRevision()
+}
+
+// ServiceAccountSpec defines all the specifics for service account set by user.
+type ServiceAccountSpec struct {
+	Name string `json:"name" gorm:"column:name"`
+	Memo string `json:"memo" gorm:"column:memo"`
+	// KeyHash api key 的 sha256 摘要, api key 只在创建和重置时返回一次
+	KeyHash string `json:"-" gorm:"column:key_hash"`
+	// KeyPrefix api key 的前几位, 用于在列表中辨识
+	KeyPrefix  string               `json:"key_prefix" gorm:"column:key_prefix"`
+	Scopes     ServiceAccountScopes `json:"scopes" gorm:"column:scopes;type:json"`
+	Enabled    bool                 `json:"enabled" gorm:"column:enabled"`
+	ExpiredAt  *time.Time           `json:"expired_at" gorm:"column:expired_at"`
+	LastUsedAt *time.Time           `json:"last_used_at" gorm:"column:last_used_at"`
+}
+
+// Validate service account spec.
+func (s *ServiceAccountSpec) Validate(kit *kit.Kit) error {
+	if err := validator.ValidateName(kit, s.Name); err != nil {
+		return err
+	}
+
+	if len(s.Name) > maxServiceAccountNameLen {
+		return errf.Errorf(errf.InvalidArgument,
+			i18n.T(kit, "invalid name, length should <= %d", maxServiceAccountNameLen))
+	}
+
+	if err := validator.ValidateMemo(kit, s.Memo, false); err != nil {
+		return err
+	}
+
+	return s.Scopes.Validate(kit)
+}
+
+// Expired returns whether the service account is expired.
+func (s *ServiceAccountSpec) Expired(now time.Time) bool {
+	return s.ExpiredAt != nil && !s.ExpiredAt.IsZero() && now.After(*s.ExpiredAt)
+}
+
+// ServiceAccountAttachment defines the service account attachments.
+type ServiceAccountAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+}
+
+// ServiceAccountScope grants the actions of the app to the service account.
+type ServiceAccountScope struct {
+	// AppID 0 表示业务下的所有服务, 创建服务等不针对具体服务的操作也需要使用 0
+	AppID uint32 `json:"app_id"`
+	// Actions 允许的操作, 同 iam 操作, 如 view、view_secret、update、generate_release、publish
+	Actions []string `json:"actions"`
+}
+
+// serviceAccountActions is the actions can be granted to the service accounts.
+var serviceAccountActions = map[string]bool{
+	"view":             true,
+	"view_secret":      true,
+	"create":           true,
+	"update":           true,
+	"delete":           true,
+	"generate_release": true,
+	"publish":          true,
+}
+
+// ServiceAccountScopes is the scopes of the service account.
+type ServiceAccountScopes []*ServiceAccountScope
+
+// Validate the scopes of the service account.
+func (s ServiceAccountScopes) Validate(kit *kit.Kit) error {
+	for _, one := range s {
+		if one == nil || len(one.Actions) == 0 {
+			return errf.Errorf(errf.InvalidArgument, i18n.T(kit, "service account scope actions can not be empty"))
+		}
+		for _, action := range one.Actions {
+			if !serviceAccountActions[action] {
+				return errf.Errorf(errf.InvalidArgument,
+					i18n.T(kit, "unsupported service account action: %s", action))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Allowed returns whether the action of the app is granted, the app id is 0 for the biz level operations.
+func (s ServiceAccountScopes) Allowed(appID uint32, action string) bool {
+	for _, one := range s {
+		if one.AppID != 0 && one.AppID != appID {
+			continue
+		}
+		for _, a := range one.Actions {
+			if a == action {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Value implements the driver.Valuer interface.
+func (s ServiceAccountScopes) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	return json.Marshal(s)
+}
+
+// Scan implements the sql.Scanner interface.
+func (s *ServiceAccountScopes) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	case nil:
+		return nil
+	default:
+		return fmt.Errorf("unsupported service account scopes raw type: %T", v)
+	}
+}
+
+// ServiceAccountUser returns the user name of the service account.
+func ServiceAccountUser(bizID uint32, name string) string {
+	return fmt.Sprintf("%s%d:%s", ServiceAccountUserPrefix, bizID, name)
+}
+
+// ParseServiceAccountUser parse the biz id and name from the user name, returns false if
+// the user is not a service account.
+func ParseServiceAccountUser(user string) (uint32, string, bool) {
+	if !strings.HasPrefix(user, ServiceAccountUserPrefix) {
+		return 0, "", false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(user, ServiceAccountUserPrefix), ":", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return 0, "", false
+	}
+	bizID, err := strconv.ParseUint(parts[0], 10, 32)
+	if err != nil || bizID == 0 {
+		return 0, "", false
+	}
+
+	return uint32(bizID), parts[1], true
+}
+
+// GenerateServiceAccountKey generate a random api key, returns the key and its hash.
+func GenerateServiceAccountKey() (string, string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+
+	key := ServiceAccountKeyPrefix + hex.EncodeToString(b)
+	return key, HashServiceAccountKey(key), nil
+}
+
+// HashServiceAccountKey returns the sha256 hash of the api key.
+func HashServiceAccountKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
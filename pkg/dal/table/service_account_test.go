@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServiceAccountUser(t *testing.T) {
+	user := ServiceAccountUser(2, "ci-bot")
+	bizID, name, ok := ParseServiceAccountUser(user)
+	if !ok || bizID != 2 || name != "ci-bot" {
+		t.Errorf("parse %s got biz %d, name %s, ok %v", user, bizID, name, ok)
+	}
+
+	for _, user := range []string{"admin", "bscp-sa:", "bscp-sa:0:ci", "bscp-sa:abc:ci", "bscp-sa:2:"} {
+		if _, _, ok := ParseServiceAccountUser(user); ok {
+			t.Errorf("%s should not be a service account user", user)
+		}
+	}
+}
+
+func TestGenerateServiceAccountKey(t *testing.T) {
+	key, hash, err := GenerateServiceAccountKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasPrefix(key, ServiceAccountKeyPrefix) {
+		t.Errorf("key %s should has prefix %s", key, ServiceAccountKeyPrefix)
+	}
+	if hash != HashServiceAccountKey(key) {
+		t.Errorf("hash of key %s mismatch", key)
+	}
+
+	another, _, _ := GenerateServiceAccountKey()
+	if another == key {
+		t.Errorf("generated keys should be different")
+	}
+}
+
+func TestServiceAccountScopesAllowed(t *testing.T) {
+	scopes := ServiceAccountScopes{
+		{AppID: 0, Actions: []string{"view"}},
+		{AppID: 10, Actions: []string{"generate_release", "publish"}},
+	}
+
+	cases := []struct {
+		appID   uint32
+		action  string
+		allowed bool
+	}{
+		{appID: 10, action: "view", allowed: true},
+		{appID: 11, action: "view", allowed: true},
+		{appID: 10, action: "publish", allowed: true},
+		{appID: 11, action: "publish", allowed: false},
+		{appID: 0, action: "publish", allowed: false},
+		{appID: 10, action: "delete", allowed: false},
+	}
+
+	for _, c := range cases {
+		if got := scopes.Allowed(c.appID, c.action); got != c.allowed {
+			t.Errorf("app %d action %s, expect %v, got %v", c.appID, c.action, c.allowed, got)
+		}
+	}
+}
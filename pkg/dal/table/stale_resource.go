@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// StaleResource is an app, config item or credential which is not consumed for a long time,
+// it's detected periodically and the owners are notified, then it may be archived automatically.
+type StaleResource struct {
+	ID         uint32                   `json:"id" gorm:"primaryKey"`
+	Spec       *StaleResourceSpec       `json:"spec" gorm:"embedded"`
+	Attachment *StaleResourceAttachment `json:"attachment" gorm:"embedded"`
+}
+
+// TableName is the stale resource's database table name.
+func (s *StaleResource) TableName() string {
+	return "stale_resources"
+}
+
+// ValidateCreate validate stale resource is valid or not when create it.
+func (s *StaleResource) ValidateCreate() error {
+	if s.ID > 0 {
+		return errors.New("id should not be set")
+	}
+
+	if s.Spec == nil {
+		return errors.New("spec not set")
+	}
+
+	if err := s.Spec.ResType.Validate(); err != nil {
+		return err
+	}
+
+	if s.Spec.ResID <= 0 {
+		return errors.New("res id should be set")
+	}
+
+	if s.Attachment == nil || s.Attachment.BizID <= 0 {
+		return errors.New("biz id should be set")
+	}
+
+	return nil
+}
+
+// StaleResourceSpec defines the stale resource's specifics.
+type StaleResourceSpec struct {
+	ResType StaleResType `json:"res_type" gorm:"column:res_type"`
+	ResID   uint32       `json:"res_id" gorm:"column:res_id"`
+	Name    string       `json:"name" gorm:"column:name"`
+	// Reason 判定为闲置的原因
+	Reason string `json:"reason" gorm:"column:reason"`
+	// LastActiveAt 最近一次被消费或更新的时间, 从未被消费时为空
+	LastActiveAt *time.Time `json:"last_active_at" gorm:"column:last_active_at"`
+	// NotifiedAt 通知负责人的时间, 即首次检测到的时间
+	NotifiedAt time.Time `json:"notified_at" gorm:"column:notified_at"`
+	// ArchivedAt 自动归档的时间, 未归档时为空
+	ArchivedAt *time.Time `json:"archived_at" gorm:"column:archived_at"`
+}
+
+// ShouldArchive returns whether the stale resource should be archived at the time, which is
+// the given days after it's notified.
+func (s *StaleResourceSpec) ShouldArchive(now time.Time, afterDays uint) bool {
+	if s.ArchivedAt != nil {
+		return false
+	}
+
+	return !now.Before(s.NotifiedAt.Add(time.Duration(afterDays) * 24 * time.Hour))
+}
+
+// StaleResourceAttachment defines the stale resource attachments.
+type StaleResourceAttachment struct {
+	BizID uint32 `json:"biz_id" gorm:"column:biz_id"`
+	// AppID is the app of the config item, or the app itself, it's 0 for credential.
+	AppID uint32 `json:"app_id" gorm:"column:app_id"`
+}
+
+// StaleResType is the type of the stale resource.
+type StaleResType string
+
+const (
+	// StaleApp is the app which is not consumed by any client.
+	StaleApp StaleResType = "app"
+	// StaleConfigItem is the config item which is not released.
+	StaleConfigItem StaleResType = "config_item"
+	// StaleCredential is the credential which only grants the stale apps.
+	StaleCredential StaleResType = "credential"
+)
+
+// Validate the stale resource type.
+func (s StaleResType) Validate() error {
+	switch s {
+	case StaleApp, StaleConfigItem, StaleCredential:
+	default:
+		return fmt.Errorf("unsupported stale resource type: %s", s)
+	}
+
+	return nil
+}
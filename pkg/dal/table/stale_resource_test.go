@@ -0,0 +1,50 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStaleResourceShouldArchive(t *testing.T) {
+	now := time.Now()
+	spec := &StaleResourceSpec{
+		ResType:    StaleApp,
+		ResID:      1,
+		Name:       "demo",
+		NotifiedAt: now.Add(-10 * 24 * time.Hour),
+	}
+	if spec.ShouldArchive(now, 14) {
+		t.Errorf("stale resource notified 10 days ago should not be archived after 14 days")
+		return
+	}
+
+	if !spec.ShouldArchive(now, 7) {
+		t.Errorf("stale resource notified 10 days ago should be archived after 7 days")
+		return
+	}
+
+	spec.ArchivedAt = &now
+	if spec.ShouldArchive(now, 7) {
+		t.Errorf("archived stale resource should not be archived again")
+		return
+	}
+
+	one := &StaleResource{Spec: spec, Attachment: &StaleResourceAttachment{BizID: 1}}
+	spec.ResType = "release"
+	if err := one.ValidateCreate(); err == nil {
+		t.Errorf("stale resource with unsupported resource type should be invalid")
+		return
+	}
+}
@@ -239,6 +239,9 @@ type StrategySpec struct {
 	ItsmTicketSn      string        `db:"itsm_ticket_sn" json:"itsm_ticket_sn" gorm:"column:itsm_ticket_sn"`
 	ItsmTicketStatus  string        `db:"itsm_ticket_status" json:"itsm_ticket_status" gorm:"column:itsm_ticket_status"`
 	ItsmTicketStateID int           `db:"itsm_ticket_state_id" json:"itsm_ticket_state_id" gorm:"column:itsm_ticket_state_id"` // nolint
+	// ChangeTicketID is the change ticket referenced by the publish, ChangeTicketURL is its detail page.
+	ChangeTicketID  string `db:"change_ticket_id" json:"change_ticket_id" gorm:"column:change_ticket_id"`
+	ChangeTicketURL string `db:"change_ticket_url" json:"change_ticket_url" gorm:"column:change_ticket_url"`
 }
 
 // ValidateCreate validate strategy spec when it is created.
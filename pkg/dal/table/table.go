@@ -253,6 +253,70 @@ const (
 	ClientEventTable Name = "client_events"
 	// ConfigTable is configs table's name
 	ConfigTable Name = "configs"
+	// HookExecResultTable is hook_exec_results table's name
+	HookExecResultTable Name = "hook_exec_results"
+	// HookTemplateTable is hook_templates table's name
+	HookTemplateTable Name = "hook_templates"
+	// HookTemplateRefTable is hook_template_refs table's name
+	HookTemplateRefTable Name = "hook_template_refs"
+	// EmergencyPublishTable is emergency_publishes table's name
+	EmergencyPublishTable Name = "emergency_publishes"
+	// EnvironmentTable is environments table's name
+	EnvironmentTable Name = "environments"
+	// EnvAppBindingTable is env_app_bindings table's name
+	EnvAppBindingTable Name = "env_app_bindings"
+	// ConfigShareTable is config_shares table's name
+	ConfigShareTable Name = "config_shares"
+	// ConfigShareRefTable is config_share_refs table's name
+	ConfigShareRefTable Name = "config_share_refs"
+	// RecycleBinTable is recycle_bins table's name
+	RecycleBinTable Name = "recycle_bins"
+	// AppSnapshotTable is app_snapshots table's name
+	AppSnapshotTable Name = "app_snapshots"
+	// DraftTable is drafts table's name
+	DraftTable Name = "drafts"
+	// FullTextDocTable is full_text_docs table's name
+	FullTextDocTable Name = "full_text_docs"
+	// ConfigReferenceTable is config_references table's name
+	ConfigReferenceTable Name = "config_references"
+	// CertificateTable is certificates table's name
+	CertificateTable Name = "certificates"
+	// KvOverrideTable is kv overrides table's name
+	KvOverrideTable Name = "kv_overrides"
+	// ReleasedKvOverrideTable is released kv overrides table's name
+	ReleasedKvOverrideTable Name = "released_kv_overrides"
+	// CompliancePolicyTable is compliance policies table's name
+	CompliancePolicyTable Name = "compliance_policies"
+	// SecretScanSettingTable is secret scan settings table's name
+	SecretScanSettingTable Name = "secret_scan_settings"
+	// AppConsumptionStatTable is app consumption stats table's name
+	AppConsumptionStatTable Name = "app_consumption_stats"
+	// StaleResourceTable is stale resources table's name
+	StaleResourceTable Name = "stale_resources"
+	// BizUsageTable is biz usages table's name
+	BizUsageTable Name = "biz_usages"
+	// ServiceAccountTable is service accounts table's name
+	ServiceAccountTable Name = "service_accounts"
+	// AppRoleBindingTable is app role bindings table's name
+	AppRoleBindingTable Name = "app_role_bindings"
+	// IdempotencyRecordTable is idempotency records table's name
+	IdempotencyRecordTable Name = "idempotency_records"
+	// OperationTable is async operations table's name
+	OperationTable Name = "operations"
+	// NotificationChannelTable is notification channels table's name
+	NotificationChannelTable Name = "notification_channels"
+	// AlertRuleTable is alert rules table's name
+	AlertRuleTable Name = "alert_rules"
+	// ClientQuarantineTable is client quarantines table's name
+	ClientQuarantineTable Name = "client_quarantines"
+	// ExperimentTable is experiments table's name
+	ExperimentTable Name = "experiments"
+	// ExperimentExposureTable is experiment exposures table's name
+	ExperimentExposureTable Name = "experiment_exposures"
+	// MaskingProfileTable is masking profiles table's name
+	MaskingProfileTable Name = "masking_profiles"
+	// FileTamperEventTable is file tamper events table's name
+	FileTamperEventTable Name = "file_tamper_events"
 )
 
 // RevisionColumns defines all the Revision table's columns.
@@ -316,6 +380,29 @@ func (r Revision) ValidateUpdate() error {
 	return nil
 }
 
+// RevisionString returns the update time with microseconds in RFC3339 format, which is the revision of the
+// resource seen by the editor, used for optimistic locking.
+func (r Revision) RevisionString() string {
+	return r.UpdatedAt.Truncate(time.Microsecond).Format(time.RFC3339Nano)
+}
+
+// MatchRevision test whether the expected revision is the same as current one, the expected revision
+// is the update time in RFC3339 format which is seen by the editor, used for optimistic locking.
+// the update time is compared with microseconds, as the db stores, unless the expected revision has
+// no fractional seconds which is given by the old clients.
+func (r Revision) MatchRevision(expected string) (bool, error) {
+	t, err := time.Parse(time.RFC3339Nano, expected)
+	if err != nil {
+		return false, fmt.Errorf("invalid revision %s, should be in RFC3339 format", expected)
+	}
+
+	if !strings.Contains(expected, ".") {
+		return t.Unix() == r.UpdatedAt.Unix(), nil
+	}
+
+	return t.Truncate(time.Microsecond).Equal(r.UpdatedAt.Truncate(time.Microsecond)), nil
+}
+
 // CreatedRevisionColumns defines all the Revision table's columns.
 var CreatedRevisionColumns = mergeColumns(CreatedRevisionColumnDescriptor)
 
@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 )
@@ -66,3 +67,38 @@ func TestMergeNamedColumns(t *testing.T) {
 		return
 	}
 }
+
+func TestRevisionMatchRevision(t *testing.T) {
+	updatedAt := time.Date(2024, 5, 20, 10, 30, 0, 0, time.UTC)
+	r := Revision{Reviser: "admin", UpdatedAt: updatedAt.Add(300 * time.Millisecond)}
+
+	match, err := r.MatchRevision(updatedAt.In(time.FixedZone("CST", 8*3600)).Format(time.RFC3339))
+	if err != nil || !match {
+		t.Errorf("revision should match the same update time in other timezone, err: %v", err)
+		return
+	}
+
+	match, err = r.MatchRevision(updatedAt.Add(-time.Second).Format(time.RFC3339))
+	if err != nil || match {
+		t.Errorf("revision should not match a stale update time, err: %v", err)
+		return
+	}
+
+	if _, err = r.MatchRevision("2024-05-20 10:30:00"); err == nil {
+		t.Errorf("revision not in RFC3339 format should be invalid")
+		return
+	}
+
+	match, err = r.MatchRevision(r.RevisionString())
+	if err != nil || !match {
+		t.Errorf("revision should match its own revision string, err: %v", err)
+		return
+	}
+
+	// 同一秒内的另一次修改
+	match, err = r.MatchRevision(updatedAt.Add(100 * time.Millisecond).Format(time.RFC3339Nano))
+	if err != nil || match {
+		t.Errorf("revision should not match another update time in the same second, err: %v", err)
+		return
+	}
+}
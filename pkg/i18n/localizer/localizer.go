@@ -17,6 +17,8 @@ import (
 	"golang.org/x/text/language"
 	"golang.org/x/text/language/display"
 	"golang.org/x/text/message"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 )
 
 // supportedLangs are languages the server supports.
@@ -61,3 +63,34 @@ func Get(lang string) *Localizer {
 func (l *Localizer) Translate(key message.Reference, args ...interface{}) string {
 	return l.printer.Sprintf(key, args...)
 }
+
+// Negotiate returns the supported language of the first negotiable candidate, candidates can be language
+// tags or Accept-Language header values, eg: zh-cn, en, zh-CN,zh;q=0.9,en;q=0.8.
+// it returns constant.DefaultLanguage(zh-cn) or constant.EnglishLanguage(en), and returns empty string if none of the candidates is negotiable.
+func Negotiate(candidates ...string) string {
+	for _, c := range candidates {
+		if c == "" {
+			continue
+		}
+		tags, _, err := language.ParseAcceptLanguage(c)
+		if err != nil || len(tags) == 0 {
+			continue
+		}
+		tag, _, confidence := matcher.Match(tags...)
+		if confidence == language.No {
+			continue
+		}
+		if base, _ := tag.Base(); base.String() == "zh" {
+			return constant.DefaultLanguage
+		}
+		return constant.EnglishLanguage
+	}
+	return ""
+}
+
+// IsChinese returns whether the most matched language of lang is Chinese.
+func IsChinese(lang string) bool {
+	tag, _, _ := matcher.Match(language.Make(lang))
+	base, _ := tag.Base()
+	return base.String() == "zh"
+}
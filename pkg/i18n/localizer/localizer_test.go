@@ -0,0 +1,37 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package localizer
+
+import "testing"
+
+func TestNegotiate(t *testing.T) {
+	cases := []struct {
+		candidates []string
+		expect     string
+	}{
+		{[]string{"zh-cn"}, "zh-cn"},
+		{[]string{"en"}, "en"},
+		{[]string{"", "zh-CN,zh;q=0.9,en;q=0.8"}, "zh-cn"},
+		{[]string{"", "", "en-US,en;q=0.9"}, "en"},
+		{[]string{"en", "zh-cn"}, "en"},
+		{[]string{"fr", "zh-TW"}, "zh-cn"},
+		{[]string{"", "fr"}, ""},
+		{[]string{"!!"}, ""},
+	}
+
+	for _, c := range cases {
+		if got := Negotiate(c.candidates...); got != c.expect {
+			t.Errorf("negotiate %v, expect %q, got %q", c.candidates, c.expect, got)
+		}
+	}
+}
@@ -50,4 +50,8 @@ const (
 	SkipAction Action = "skip"
 	// Access means sidecar access the feed server action. and only for this scenario.
 	Access Action = "access"
+	// ViewSecret means view the secret values of the app, which is granted with the app's view permission in iam.
+	ViewSecret Action = "view_secret"
+	// ManageRole means manage the role bindings of the app, which is granted with the app's delete permission in iam.
+	ManageRole Action = "manage_role"
 )
@@ -39,6 +39,7 @@ func New() *Kit {
 
 var (
 	lowRidKey         = strings.ToLower(constant.RidKey)
+	lowRidKeyGeneric  = strings.ToLower(constant.RidKeyGeneric)
 	lowLangKey        = strings.ToLower(constant.LangKey)
 	lowUserKey        = strings.ToLower(constant.UserKey)
 	lowACKey          = strings.ToLower(constant.AppCodeKey)
@@ -56,8 +57,12 @@ func FromGrpcContext(ctx context.Context) *Kit {
 	}
 
 	md, _ := metadata.FromIncomingContext(ctx)
+	// 兼容通用的 X-Request-Id, 便于调用方使用自己的 request id 关联请求
 	rid := md[lowRidKey]
-	if len(rid) != 0 {
+	if len(rid) == 0 {
+		rid = md[lowRidKeyGeneric]
+	}
+	if len(rid) != 0 && rid[0] != "" {
 		kit.Rid = rid[0]
 	} else {
 		kit.Rid = "bscp-" + uuid.UUID()
@@ -121,7 +126,7 @@ func FromGrpcContext(ctx context.Context) *Kit {
 		kit.BizID = ctxBizID
 	}
 
-	kit.Ctx = context.WithValue(kit.Ctx, constant.RidKey, rid) //nolint
+	kit.Ctx = context.WithValue(kit.Ctx, constant.RidKey, kit.Rid) //nolint
 
 	// Note: need to add supplier id and authorization field.
 	return kit
@@ -64,6 +64,9 @@ type LogConfig struct {
 	StdErrThreshold    string
 	VModule            string
 	TraceLocation      string
+	// SlowRequestThreshold request cost more than it is logged as a slow request, 0 means use the
+	// default value, negative value disables the slow request log.
+	SlowRequestThreshold time.Duration
 }
 
 // InitLogger initializes logs the way we want for blog.
@@ -72,6 +75,10 @@ func InitLogger(logConfig LogConfig) {
 		int32(logConfig.Verbosity), logConfig.StdErrThreshold, logConfig.VModule, logConfig.TraceLocation,
 		logConfig.LogDir, logConfig.LogMaxSize, logConfig.LogLineMaxSize, int(logConfig.LogMaxNum))
 
+	if logConfig.SlowRequestThreshold != 0 {
+		SetSlowRequestThreshold(logConfig.SlowRequestThreshold)
+	}
+
 	// show inner start info.
 	glog.Info(version.GetStartInfo())
 	glog.Flush()
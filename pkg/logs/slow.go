@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultSlowRequestThreshold is the default threshold of the slow request.
+const DefaultSlowRequestThreshold = time.Second
+
+var slowRequestThreshold atomic.Int64
+
+func init() {
+	slowRequestThreshold.Store(int64(DefaultSlowRequestThreshold))
+}
+
+// SetSlowRequestThreshold set the slow request threshold, request cost more than it will be logged as
+// a slow request, a negative value disables the slow request log.
+func SetSlowRequestThreshold(d time.Duration) {
+	slowRequestThreshold.Store(int64(d))
+}
+
+// IsSlowRequest returns whether the request cost is over the slow request threshold.
+func IsSlowRequest(cost time.Duration) bool {
+	threshold := time.Duration(slowRequestThreshold.Load())
+	return threshold >= 0 && cost > threshold
+}
+
+type breakdownKey struct{}
+
+// Breakdown records the cost of the downstream calls (rpc, db etc.) of a request.
+type Breakdown struct {
+	lock  sync.Mutex
+	costs map[string]*downstreamCost
+}
+
+type downstreamCost struct {
+	count int
+	cost  time.Duration
+}
+
+// WithBreakdown returns a context which carries a new breakdown recorder.
+func WithBreakdown(ctx context.Context) (context.Context, *Breakdown) {
+	b := &Breakdown{costs: make(map[string]*downstreamCost)}
+	return context.WithValue(ctx, breakdownKey{}, b), b
+}
+
+// RecordDownstream record a downstream call cost into the request's breakdown if the context carries one.
+func RecordDownstream(ctx context.Context, name string, cost time.Duration) {
+	if ctx == nil {
+		return
+	}
+
+	b, ok := ctx.Value(breakdownKey{}).(*Breakdown)
+	if !ok || b == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	c, exist := b.costs[name]
+	if !exist {
+		c = new(downstreamCost)
+		b.costs[name] = c
+	}
+	c.count++
+	c.cost += cost
+}
+
+// String returns the breakdown as "name(count)=cost" sorted by the cost desc.
+func (b *Breakdown) String() string {
+	if b == nil {
+		return ""
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	names := make([]string, 0, len(b.costs))
+	for name := range b.costs {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return b.costs[names[i]].cost > b.costs[names[j]].cost
+	})
+
+	items := make([]string, 0, len(names))
+	for _, name := range names {
+		c := b.costs[name]
+		items = append(items, fmt.Sprintf("%s(%d)=%s", name, c.count, c.cost))
+	}
+
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package logs
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBreakdown(t *testing.T) {
+	// 未携带 breakdown 的 context 不记录
+	RecordDownstream(context.Background(), "rpc:ListApps", time.Second)
+
+	ctx, b := WithBreakdown(context.Background())
+	RecordDownstream(ctx, "db:apps", 10*time.Millisecond)
+	RecordDownstream(ctx, "rpc:ListApps", time.Second)
+	RecordDownstream(ctx, "db:apps", 20*time.Millisecond)
+
+	expected := "rpc:ListApps(1)=1s, db:apps(2)=30ms"
+	if got := b.String(); got != expected {
+		t.Errorf("breakdown should be %s, but got %s", expected, got)
+	}
+}
+
+func TestIsSlowRequest(t *testing.T) {
+	defer SetSlowRequestThreshold(DefaultSlowRequestThreshold)
+
+	SetSlowRequestThreshold(100 * time.Millisecond)
+	if !IsSlowRequest(200*time.Millisecond) || IsSlowRequest(50*time.Millisecond) {
+		t.Errorf("slow request judged with threshold 100ms is not as expected")
+	}
+
+	SetSlowRequestThreshold(-1)
+	if IsSlowRequest(time.Hour) {
+		t.Errorf("slow request log should be disabled with negative threshold")
+	}
+}
@@ -48,6 +48,9 @@ const (
 	// OrmCmdSubSys defines all the sharding database command related sub system.
 	OrmCmdSubSys = "orm"
 
+	// DaoSubSys defines the dao layer related sub system.
+	DaoSubSys = "dao"
+
 	// ResourceLockSubSys defines all the runtime lock related sub system.
 	ResourceLockSubSys = "resource_lock"
 
@@ -69,11 +72,29 @@ const (
 	// FSConfigConsume defines feed server's config consume sub system.
 	FSConfigConsume = "config_consume"
 
+	// FSWatchSessionSubSys defines feed server's sidecar watch session sub system.
+	FSWatchSessionSubSys = "watch_session"
+
+	// FSOverloadSubSys defines feed server's overload protection sub system.
+	FSOverloadSubSys = "overload"
+
+	// FSShadowSubSys defines feed server's request shadowing sub system.
+	FSShadowSubSys = "shadow"
+
+	// FSBinaryPatchSubSys defines feed server's binary patch sub system.
+	FSBinaryPatchSubSys = "binary_patch"
+
 	// RestfulSubSys defines rest server's sub system
 	RestfulSubSys = "restful"
 
 	// RepoSyncSubSys defines repo syncer sub system
 	RepoSyncSubSys = "repo_syncer"
+
+	// FeedProxySubSys defines feed proxy's sub system
+	FeedProxySubSys = "feed_proxy"
+
+	// SchedulerSubSys defines background job scheduler's sub system
+	SchedulerSubSys = "scheduler"
 )
 
 // labels
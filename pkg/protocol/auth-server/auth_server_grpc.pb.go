@@ -32,6 +32,8 @@ const (
 	Auth_QuerySpace_FullMethodName                 = "/pbas.Auth/QuerySpace"
 	Auth_GetAuthConf_FullMethodName                = "/pbas.Auth/GetAuthConf"
 	Auth_GrantResourceCreatorAction_FullMethodName = "/pbas.Auth/GrantResourceCreatorAction"
+	Auth_VerifyServiceAccount_FullMethodName       = "/pbas.Auth/VerifyServiceAccount"
+	Auth_Login_FullMethodName                      = "/pbas.Auth/Login"
 )
 
 // AuthClient is the client API for Auth service.
@@ -57,6 +59,10 @@ type AuthClient interface {
 	// auth login conf
 	GetAuthConf(ctx context.Context, in *GetAuthConfReq, opts ...grpc.CallOption) (*GetAuthConfResp, error)
 	GrantResourceCreatorAction(ctx context.Context, in *GrantResourceCreatorActionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// verify the api key of the service account, returns the service account's biz and name.
+	VerifyServiceAccount(ctx context.Context, in *VerifyServiceAccountReq, opts ...grpc.CallOption) (*VerifyServiceAccountResp, error)
+	// login with the standalone provider(OIDC/LDAP), returns the signed session token.
+	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
 }
 
 type authClient struct {
@@ -166,6 +172,24 @@ func (c *authClient) GrantResourceCreatorAction(ctx context.Context, in *GrantRe
 	return out, nil
 }
 
+func (c *authClient) VerifyServiceAccount(ctx context.Context, in *VerifyServiceAccountReq, opts ...grpc.CallOption) (*VerifyServiceAccountResp, error) {
+	out := new(VerifyServiceAccountResp)
+	err := c.cc.Invoke(ctx, Auth_VerifyServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Auth_Login_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServer is the server API for Auth service.
 // All implementations should embed UnimplementedAuthServer
 // for forward compatibility
@@ -189,6 +213,10 @@ type AuthServer interface {
 	// auth login conf
 	GetAuthConf(context.Context, *GetAuthConfReq) (*GetAuthConfResp, error)
 	GrantResourceCreatorAction(context.Context, *GrantResourceCreatorActionReq) (*base.EmptyResp, error)
+	// verify the api key of the service account, returns the service account's biz and name.
+	VerifyServiceAccount(context.Context, *VerifyServiceAccountReq) (*VerifyServiceAccountResp, error)
+	// login with the standalone provider(OIDC/LDAP), returns the signed session token.
+	Login(context.Context, *LoginReq) (*LoginResp, error)
 }
 
 // UnimplementedAuthServer should be embedded to have forward compatible implementations.
@@ -228,6 +256,12 @@ func (UnimplementedAuthServer) GetAuthConf(context.Context, *GetAuthConfReq) (*G
 func (UnimplementedAuthServer) GrantResourceCreatorAction(context.Context, *GrantResourceCreatorActionReq) (*base.EmptyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GrantResourceCreatorAction not implemented")
 }
+func (UnimplementedAuthServer) VerifyServiceAccount(context.Context, *VerifyServiceAccountReq) (*VerifyServiceAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyServiceAccount not implemented")
+}
+func (UnimplementedAuthServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
 
 // UnsafeAuthServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuthServer will
@@ -438,6 +472,42 @@ func _Auth_GrantResourceCreatorAction_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Auth_VerifyServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyServiceAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).VerifyServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_VerifyServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).VerifyServiceAccount(ctx, req.(*VerifyServiceAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Auth_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Auth_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Auth_ServiceDesc is the grpc.ServiceDesc for Auth service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -489,6 +559,14 @@ var Auth_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GrantResourceCreatorAction",
 			Handler:    _Auth_GrantResourceCreatorAction_Handler,
 		},
+		{
+			MethodName: "VerifyServiceAccount",
+			Handler:    _Auth_VerifyServiceAccount_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _Auth_Login_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth_server.proto",
@@ -20,22 +20,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Cache_GetAppID_FullMethodName                 = "/pbcs.Cache/GetAppID"
-	Cache_GetAppMeta_FullMethodName               = "/pbcs.Cache/GetAppMeta"
-	Cache_ListApps_FullMethodName                 = "/pbcs.Cache/ListApps"
-	Cache_GetReleasedCI_FullMethodName            = "/pbcs.Cache/GetReleasedCI"
-	Cache_GetReleasedHook_FullMethodName          = "/pbcs.Cache/GetReleasedHook"
-	Cache_ListAppReleasedGroups_FullMethodName    = "/pbcs.Cache/ListAppReleasedGroups"
-	Cache_GetCurrentCursorReminder_FullMethodName = "/pbcs.Cache/GetCurrentCursorReminder"
-	Cache_ListEventsMeta_FullMethodName           = "/pbcs.Cache/ListEventsMeta"
-	Cache_GetCredential_FullMethodName            = "/pbcs.Cache/GetCredential"
-	Cache_BenchAppMeta_FullMethodName             = "/pbcs.Cache/BenchAppMeta"
-	Cache_BenchReleasedCI_FullMethodName          = "/pbcs.Cache/BenchReleasedCI"
-	Cache_GetReleasedKv_FullMethodName            = "/pbcs.Cache/GetReleasedKv"
-	Cache_GetReleasedKvValue_FullMethodName       = "/pbcs.Cache/GetReleasedKvValue"
-	Cache_SetClientMetric_FullMethodName          = "/pbcs.Cache/SetClientMetric"
-	Cache_SetAppLastConsumedTime_FullMethodName   = "/pbcs.Cache/SetAppLastConsumedTime"
-	Cache_SetPublishTime_FullMethodName           = "/pbcs.Cache/SetPublishTime"
+	Cache_GetAppID_FullMethodName                 = "/pbcache.Cache/GetAppID"
+	Cache_GetAppMeta_FullMethodName               = "/pbcache.Cache/GetAppMeta"
+	Cache_ListApps_FullMethodName                 = "/pbcache.Cache/ListApps"
+	Cache_GetReleasedCI_FullMethodName            = "/pbcache.Cache/GetReleasedCI"
+	Cache_GetReleasedHook_FullMethodName          = "/pbcache.Cache/GetReleasedHook"
+	Cache_ListAppReleasedGroups_FullMethodName    = "/pbcache.Cache/ListAppReleasedGroups"
+	Cache_GetCurrentCursorReminder_FullMethodName = "/pbcache.Cache/GetCurrentCursorReminder"
+	Cache_ListEventsMeta_FullMethodName           = "/pbcache.Cache/ListEventsMeta"
+	Cache_GetCredential_FullMethodName            = "/pbcache.Cache/GetCredential"
+	Cache_ListClientQuarantines_FullMethodName    = "/pbcache.Cache/ListClientQuarantines"
+	Cache_ListAppExperiments_FullMethodName       = "/pbcache.Cache/ListAppExperiments"
+	Cache_BenchAppMeta_FullMethodName             = "/pbcache.Cache/BenchAppMeta"
+	Cache_BenchReleasedCI_FullMethodName          = "/pbcache.Cache/BenchReleasedCI"
+	Cache_GetReleasedKv_FullMethodName            = "/pbcache.Cache/GetReleasedKv"
+	Cache_GetReleasedKvValue_FullMethodName       = "/pbcache.Cache/GetReleasedKvValue"
+	Cache_SetClientMetric_FullMethodName          = "/pbcache.Cache/SetClientMetric"
+	Cache_SetAppLastConsumedTime_FullMethodName   = "/pbcache.Cache/SetAppLastConsumedTime"
+	Cache_ReportAppConsumption_FullMethodName     = "/pbcache.Cache/ReportAppConsumption"
+	Cache_SetPublishTime_FullMethodName           = "/pbcache.Cache/SetPublishTime"
 )
 
 // CacheClient is the client API for Cache service.
@@ -51,6 +54,8 @@ type CacheClient interface {
 	GetCurrentCursorReminder(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*CurrentCursorReminderResp, error)
 	ListEventsMeta(ctx context.Context, in *ListEventsReq, opts ...grpc.CallOption) (*ListEventsResp, error)
 	GetCredential(ctx context.Context, in *GetCredentialReq, opts ...grpc.CallOption) (*JsonRawResp, error)
+	ListClientQuarantines(ctx context.Context, in *ListBizClientQuarantinesReq, opts ...grpc.CallOption) (*JsonRawResp, error)
+	ListAppExperiments(ctx context.Context, in *ListAppExperimentsReq, opts ...grpc.CallOption) (*JsonRawResp, error)
 	// only stress test use.
 	BenchAppMeta(ctx context.Context, in *BenchAppMetaReq, opts ...grpc.CallOption) (*BenchAppMetaResp, error)
 	BenchReleasedCI(ctx context.Context, in *BenchReleasedCIReq, opts ...grpc.CallOption) (*BenchReleasedCIResp, error)
@@ -58,6 +63,7 @@ type CacheClient interface {
 	GetReleasedKvValue(ctx context.Context, in *GetReleasedKvValueReq, opts ...grpc.CallOption) (*JsonRawResp, error)
 	SetClientMetric(ctx context.Context, in *SetClientMetricReq, opts ...grpc.CallOption) (*SetClientMetricResp, error)
 	SetAppLastConsumedTime(ctx context.Context, in *SetAppLastConsumedTimeReq, opts ...grpc.CallOption) (*SetAppLastConsumedTimeResp, error)
+	ReportAppConsumption(ctx context.Context, in *ReportAppConsumptionReq, opts ...grpc.CallOption) (*ReportAppConsumptionResp, error)
 	SetPublishTime(ctx context.Context, in *SetPublishTimeReq, opts ...grpc.CallOption) (*SetPublishTimeResp, error)
 }
 
@@ -150,6 +156,24 @@ func (c *cacheClient) GetCredential(ctx context.Context, in *GetCredentialReq, o
 	return out, nil
 }
 
+func (c *cacheClient) ListClientQuarantines(ctx context.Context, in *ListBizClientQuarantinesReq, opts ...grpc.CallOption) (*JsonRawResp, error) {
+	out := new(JsonRawResp)
+	err := c.cc.Invoke(ctx, Cache_ListClientQuarantines_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheClient) ListAppExperiments(ctx context.Context, in *ListAppExperimentsReq, opts ...grpc.CallOption) (*JsonRawResp, error) {
+	out := new(JsonRawResp)
+	err := c.cc.Invoke(ctx, Cache_ListAppExperiments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cacheClient) BenchAppMeta(ctx context.Context, in *BenchAppMetaReq, opts ...grpc.CallOption) (*BenchAppMetaResp, error) {
 	out := new(BenchAppMetaResp)
 	err := c.cc.Invoke(ctx, Cache_BenchAppMeta_FullMethodName, in, out, opts...)
@@ -204,6 +228,15 @@ func (c *cacheClient) SetAppLastConsumedTime(ctx context.Context, in *SetAppLast
 	return out, nil
 }
 
+func (c *cacheClient) ReportAppConsumption(ctx context.Context, in *ReportAppConsumptionReq, opts ...grpc.CallOption) (*ReportAppConsumptionResp, error) {
+	out := new(ReportAppConsumptionResp)
+	err := c.cc.Invoke(ctx, Cache_ReportAppConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cacheClient) SetPublishTime(ctx context.Context, in *SetPublishTimeReq, opts ...grpc.CallOption) (*SetPublishTimeResp, error) {
 	out := new(SetPublishTimeResp)
 	err := c.cc.Invoke(ctx, Cache_SetPublishTime_FullMethodName, in, out, opts...)
@@ -226,6 +259,8 @@ type CacheServer interface {
 	GetCurrentCursorReminder(context.Context, *base.EmptyReq) (*CurrentCursorReminderResp, error)
 	ListEventsMeta(context.Context, *ListEventsReq) (*ListEventsResp, error)
 	GetCredential(context.Context, *GetCredentialReq) (*JsonRawResp, error)
+	ListClientQuarantines(context.Context, *ListBizClientQuarantinesReq) (*JsonRawResp, error)
+	ListAppExperiments(context.Context, *ListAppExperimentsReq) (*JsonRawResp, error)
 	// only stress test use.
 	BenchAppMeta(context.Context, *BenchAppMetaReq) (*BenchAppMetaResp, error)
 	BenchReleasedCI(context.Context, *BenchReleasedCIReq) (*BenchReleasedCIResp, error)
@@ -233,6 +268,7 @@ type CacheServer interface {
 	GetReleasedKvValue(context.Context, *GetReleasedKvValueReq) (*JsonRawResp, error)
 	SetClientMetric(context.Context, *SetClientMetricReq) (*SetClientMetricResp, error)
 	SetAppLastConsumedTime(context.Context, *SetAppLastConsumedTimeReq) (*SetAppLastConsumedTimeResp, error)
+	ReportAppConsumption(context.Context, *ReportAppConsumptionReq) (*ReportAppConsumptionResp, error)
 	SetPublishTime(context.Context, *SetPublishTimeReq) (*SetPublishTimeResp, error)
 }
 
@@ -267,6 +303,12 @@ func (UnimplementedCacheServer) ListEventsMeta(context.Context, *ListEventsReq)
 func (UnimplementedCacheServer) GetCredential(context.Context, *GetCredentialReq) (*JsonRawResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
 }
+func (UnimplementedCacheServer) ListClientQuarantines(context.Context, *ListBizClientQuarantinesReq) (*JsonRawResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuarantines not implemented")
+}
+func (UnimplementedCacheServer) ListAppExperiments(context.Context, *ListAppExperimentsReq) (*JsonRawResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppExperiments not implemented")
+}
 func (UnimplementedCacheServer) BenchAppMeta(context.Context, *BenchAppMetaReq) (*BenchAppMetaResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BenchAppMeta not implemented")
 }
@@ -285,6 +327,9 @@ func (UnimplementedCacheServer) SetClientMetric(context.Context, *SetClientMetri
 func (UnimplementedCacheServer) SetAppLastConsumedTime(context.Context, *SetAppLastConsumedTimeReq) (*SetAppLastConsumedTimeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAppLastConsumedTime not implemented")
 }
+func (UnimplementedCacheServer) ReportAppConsumption(context.Context, *ReportAppConsumptionReq) (*ReportAppConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportAppConsumption not implemented")
+}
 func (UnimplementedCacheServer) SetPublishTime(context.Context, *SetPublishTimeReq) (*SetPublishTimeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetPublishTime not implemented")
 }
@@ -462,6 +507,42 @@ func _Cache_GetCredential_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cache_ListClientQuarantines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBizClientQuarantinesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ListClientQuarantines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ListClientQuarantines_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ListClientQuarantines(ctx, req.(*ListBizClientQuarantinesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cache_ListAppExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppExperimentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ListAppExperiments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ListAppExperiments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ListAppExperiments(ctx, req.(*ListAppExperimentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cache_BenchAppMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BenchAppMetaReq)
 	if err := dec(in); err != nil {
@@ -570,6 +651,24 @@ func _Cache_SetAppLastConsumedTime_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cache_ReportAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportAppConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServer).ReportAppConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cache_ReportAppConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServer).ReportAppConsumption(ctx, req.(*ReportAppConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cache_SetPublishTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetPublishTimeReq)
 	if err := dec(in); err != nil {
@@ -592,7 +691,7 @@ func _Cache_SetPublishTime_Handler(srv interface{}, ctx context.Context, dec fun
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Cache_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "pbcs.Cache",
+	ServiceName: "pbcache.Cache",
 	HandlerType: (*CacheServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -631,6 +730,14 @@ var Cache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCredential",
 			Handler:    _Cache_GetCredential_Handler,
 		},
+		{
+			MethodName: "ListClientQuarantines",
+			Handler:    _Cache_ListClientQuarantines_Handler,
+		},
+		{
+			MethodName: "ListAppExperiments",
+			Handler:    _Cache_ListAppExperiments_Handler,
+		},
 		{
 			MethodName: "BenchAppMeta",
 			Handler:    _Cache_BenchAppMeta_Handler,
@@ -655,6 +762,10 @@ var Cache_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAppLastConsumedTime",
 			Handler:    _Cache_SetAppLastConsumedTime_Handler,
 		},
+		{
+			MethodName: "ReportAppConsumption",
+			Handler:    _Cache_ReportAppConsumption_Handler,
+		},
 		{
 			MethodName: "SetPublishTime",
 			Handler:    _Cache_SetPublishTime_Handler,
@@ -13,9 +13,23 @@
 package pbcs
 
 import (
+	"google.golang.org/grpc"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 )
 
+// legacyCacheServiceName is the grpc service name of cache service when its proto package was pbcs, which is
+// the same as config server's, so that they can not be linked into one binary.
+const legacyCacheServiceName = "pbcs.Cache"
+
+// RegisterLegacyCacheServer registers the cache service with its legacy grpc service name too, so that the
+// clients of the previous version can still call it during the rolling upgrade.
+func RegisterLegacyCacheServer(s grpc.ServiceRegistrar, srv CacheServer) {
+	desc := Cache_ServiceDesc
+	desc.ServiceName = legacyCacheServiceName
+	s.RegisterService(&desc, srv)
+}
+
 // Validate BenchAppMetaReq.
 func (m *BenchAppMetaReq) Validate() error {
 	if m.BizId == 0 {
@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compat
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Violation is a breaking change of the protocol.
+type Violation struct {
+	// Element is the full name of the changed message, field, enum or method.
+	Element string
+	Reason  string
+}
+
+// String returns the readable violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Element, v.Reason)
+}
+
+// Compare returns the breaking changes from the frozen registry to the current one. Adding messages,
+// fields, enum values and methods is compatible, while removing or changing them breaks the callers
+// which are not upgraded yet, removing a field is allowed only when its number is reserved.
+func Compare(frozen, current *Registry) []Violation {
+	vs := make([]Violation, 0)
+	add := func(element, format string, args ...interface{}) {
+		vs = append(vs, Violation{Element: element, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	for _, name := range sortedKeys(frozen.Messages) {
+		cur, ok := current.Messages[name]
+		if !ok {
+			add(name, "message is removed")
+			continue
+		}
+
+		for number, f := range frozen.Messages[name].Fields {
+			element := fmt.Sprintf("%s.%s(%d)", name, f.Name, number)
+			cf, ok := cur.Fields[number]
+			switch {
+			case !ok && !cur.reserved(number):
+				add(element, "field is removed without reserving its number")
+			case !ok:
+			case cf.Kind != f.Kind:
+				add(element, "field kind is changed from %s to %s", f.Kind, cf.Kind)
+			case cf.Cardinality != f.Cardinality:
+				add(element, "field cardinality is changed from %s to %s", f.Cardinality, cf.Cardinality)
+			case cf.Name != f.Name:
+				// 字段名变更不影响二进制编码, 但会影响 grpc-gateway 的 json 编解码
+				add(element, "field is renamed to %s", cf.Name)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(frozen.Enums) {
+		cur, ok := current.Enums[name]
+		if !ok {
+			add(name, "enum is removed")
+			continue
+		}
+
+		for value, number := range frozen.Enums[name] {
+			if cn, ok := cur[value]; !ok {
+				add(name+"."+value, "enum value is removed")
+			} else if cn != number {
+				add(name+"."+value, "enum value number is changed from %d to %d", number, cn)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(frozen.Services) {
+		cur, ok := current.Services[name]
+		if !ok {
+			add(name, "service is removed")
+			continue
+		}
+
+		for method, m := range frozen.Services[name] {
+			element := name + "/" + method
+			cm, ok := cur[method]
+			switch {
+			case !ok:
+				add(element, "method is removed")
+			case cm.Input != m.Input || cm.Output != m.Output:
+				add(element, "method signature is changed from (%s) %s to (%s) %s", m.Input, m.Output,
+					cm.Input, cm.Output)
+			case cm.ClientStreaming != m.ClientStreaming || cm.ServerStreaming != m.ServerStreaming:
+				add(element, "method streaming mode is changed")
+			}
+		}
+	}
+
+	sort.Slice(vs, func(i, j int) bool {
+		if vs[i].Element != vs[j].Element {
+			return vs[i].Element < vs[j].Element
+		}
+		return vs[i].Reason < vs[j].Reason
+	})
+	return vs
+}
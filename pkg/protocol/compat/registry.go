@@ -0,0 +1,223 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package compat keeps a frozen registry of the protobuf descriptors and detects the breaking changes
+// against it, so that the protocol evolution across the services stays backward compatible.
+package compat
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// bscpPackagePrefix bscp 所有 proto package 均以 pb 开头, 第三方的 google 等定义不纳入登记
+const bscpPackagePrefix = "pb"
+
+// Registry is the wire-relevant snapshot of the protobuf descriptors.
+type Registry struct {
+	Messages map[string]*Message          `json:"messages"`
+	Enums    map[string]map[string]int32  `json:"enums"`
+	Services map[string]map[string]Method `json:"services"`
+}
+
+// Message is the snapshot of a message, fields are keyed by the field number.
+type Message struct {
+	Fields   map[int32]Field `json:"fields"`
+	Reserved [][2]int32      `json:"reserved,omitempty"`
+}
+
+// Field is the snapshot of a message field.
+type Field struct {
+	Name        string `json:"name"`
+	Kind        string `json:"kind"`
+	Cardinality string `json:"cardinality"`
+}
+
+// Method is the snapshot of a service method.
+type Method struct {
+	Input           string `json:"input"`
+	Output          string `json:"output"`
+	ClientStreaming bool   `json:"client_streaming,omitempty"`
+	ServerStreaming bool   `json:"server_streaming,omitempty"`
+}
+
+// Snapshot takes the snapshot of the bscp protobuf files registered in the files.
+func Snapshot(files *protoregistry.Files) *Registry {
+	r := &Registry{
+		Messages: make(map[string]*Message),
+		Enums:    make(map[string]map[string]int32),
+		Services: make(map[string]map[string]Method),
+	}
+
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if !strings.HasPrefix(string(fd.Package()), bscpPackagePrefix) {
+			return true
+		}
+		r.addMessages(fd.Messages())
+		r.addEnums(fd.Enums())
+		for i := 0; i < fd.Services().Len(); i++ {
+			r.addService(fd.Services().Get(i))
+		}
+		return true
+	})
+
+	return r
+}
+
+func (r *Registry) addMessages(mds protoreflect.MessageDescriptors) {
+	for i := 0; i < mds.Len(); i++ {
+		md := mds.Get(i)
+		// map entry 由 map 字段的 kind 体现, 不单独登记
+		if md.IsMapEntry() {
+			continue
+		}
+
+		m := &Message{Fields: make(map[int32]Field)}
+		for j := 0; j < md.Fields().Len(); j++ {
+			fd := md.Fields().Get(j)
+			m.Fields[int32(fd.Number())] = Field{
+				Name:        string(fd.Name()),
+				Kind:        fieldKind(fd),
+				Cardinality: fieldCardinality(fd),
+			}
+		}
+		for j := 0; j < md.ReservedRanges().Len(); j++ {
+			rg := md.ReservedRanges().Get(j)
+			m.Reserved = append(m.Reserved, [2]int32{int32(rg[0]), int32(rg[1])})
+		}
+		r.Messages[string(md.FullName())] = m
+
+		r.addMessages(md.Messages())
+		r.addEnums(md.Enums())
+	}
+}
+
+func (r *Registry) addEnums(eds protoreflect.EnumDescriptors) {
+	for i := 0; i < eds.Len(); i++ {
+		ed := eds.Get(i)
+		values := make(map[string]int32, ed.Values().Len())
+		for j := 0; j < ed.Values().Len(); j++ {
+			v := ed.Values().Get(j)
+			values[string(v.Name())] = int32(v.Number())
+		}
+		r.Enums[string(ed.FullName())] = values
+	}
+}
+
+func (r *Registry) addService(sd protoreflect.ServiceDescriptor) {
+	methods := make(map[string]Method, sd.Methods().Len())
+	for i := 0; i < sd.Methods().Len(); i++ {
+		md := sd.Methods().Get(i)
+		methods[string(md.Name())] = Method{
+			Input:           string(md.Input().FullName()),
+			Output:          string(md.Output().FullName()),
+			ClientStreaming: md.IsStreamingClient(),
+			ServerStreaming: md.IsStreamingServer(),
+		}
+	}
+	r.Services[string(sd.FullName())] = methods
+}
+
+func fieldKind(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() {
+		return fmt.Sprintf("map<%s,%s>", fieldKind(fd.MapKey()), fieldKind(fd.MapValue()))
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return "message:" + string(fd.Message().FullName())
+	case protoreflect.EnumKind:
+		return "enum:" + string(fd.Enum().FullName())
+	default:
+		return fd.Kind().String()
+	}
+}
+
+func fieldCardinality(fd protoreflect.FieldDescriptor) string {
+	if fd.IsList() {
+		return "repeated"
+	}
+	if fd.HasOptionalKeyword() {
+		return "optional"
+	}
+	return "singular"
+}
+
+// Load loads the registry from the json file.
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(Registry)
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("unmarshal registry %s failed, err: %v", path, err)
+	}
+	return r, nil
+}
+
+// Save saves the registry to the json file, the keys are sorted so that the diff is reviewable.
+func (r *Registry) Save(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
+
+// reserved returns whether the field number is reserved by the message.
+func (m *Message) reserved(number int32) bool {
+	for _, rg := range m.Reserved {
+		// 描述符中的保留范围为左闭右开区间
+		if number >= rg[0] && number < rg[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Verify compares the registered files with the frozen registry in the path, and updates the frozen
+// registry with the current descriptors if there is no breaking change and update is true.
+func Verify(files *protoregistry.Files, path string, update bool) ([]Violation, error) {
+	frozen, err := Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("load frozen registry failed, err: %v", err)
+	}
+
+	current := Snapshot(files)
+	if vs := Compare(frozen, current); len(vs) != 0 {
+		return vs, nil
+	}
+
+	if update {
+		if err := current.Save(path); err != nil {
+			return nil, fmt.Errorf("save frozen registry failed, err: %v", err)
+		}
+	}
+	return nil, nil
+}
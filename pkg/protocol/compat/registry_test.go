@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compat
+
+import (
+	"flag"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// 引入各服务的协议, 将其依赖的所有 proto 文件注册到全局 registry
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/auth-server"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/cache-service"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/config-server"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/data-service"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server/v2"
+	_ "github.com/TencentBlueKing/bk-bscp/pkg/protocol/vault-server"
+)
+
+// frozenRegistry 冻结的协议描述, 协议新增定义后通过 make compat 更新
+const frozenRegistry = "frozen_registry.json"
+
+var update = flag.Bool("update", false, "update the frozen registry with the current descriptors")
+
+// TestFrozenRegistry fails when the current protocol breaks the frozen one.
+func TestFrozenRegistry(t *testing.T) {
+	vs, err := Verify(protoregistry.GlobalFiles, frozenRegistry, *update)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range vs {
+		t.Errorf("breaking change: %s", v)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	frozen := &Registry{
+		Messages: map[string]*Message{"pbx.Req": {Fields: map[int32]Field{
+			1: {Name: "id", Kind: "uint32", Cardinality: "singular"},
+			2: {Name: "name", Kind: "string", Cardinality: "singular"},
+			3: {Name: "tags", Kind: "string", Cardinality: "repeated"},
+			4: {Name: "memo", Kind: "string", Cardinality: "singular"},
+		}}},
+		Enums:    map[string]map[string]int32{"pbx.State": {"ON": 0, "OFF": 1}},
+		Services: map[string]map[string]Method{"pbx.Svc": {"Get": {Input: "pbx.Req", Output: "pbx.Req"}}},
+	}
+	current := &Registry{
+		Messages: map[string]*Message{"pbx.Req": {Fields: map[int32]Field{
+			1: {Name: "id", Kind: "uint64", Cardinality: "singular"},
+			3: {Name: "labels", Kind: "string", Cardinality: "repeated"},
+			5: {Name: "added", Kind: "string", Cardinality: "singular"},
+		}, Reserved: [][2]int32{{4, 5}}}},
+		Enums:    map[string]map[string]int32{"pbx.State": {"ON": 0, "OFF": 2, "PAUSED": 3}},
+		Services: map[string]map[string]Method{"pbx.Svc": {"List": {Input: "pbx.Req", Output: "pbx.Req"}}},
+	}
+
+	vs := Compare(frozen, current)
+	got := make([]string, 0, len(vs))
+	for _, v := range vs {
+		got = append(got, v.String())
+	}
+
+	expected := []string{
+		"pbx.Req.id(1): field kind is changed from uint32 to uint64",
+		"pbx.Req.name(2): field is removed without reserving its number",
+		"pbx.Req.tags(3): field is renamed to labels",
+		"pbx.State.OFF: enum value number is changed from 1 to 2",
+		"pbx.Svc/Get: method is removed",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected violations:\n%s", strings.Join(got, "\n"))
+	}
+}
+
+func TestUnknownFields(t *testing.T) {
+	page := &pbbase.BasePage{Limit: 10}
+	raw := protowire.AppendTag(nil, 99, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, 1)
+	page.ProtoReflect().SetUnknown(append(raw, raw...))
+
+	fields := UnknownFields(page)
+	if len(fields) != 1 || fields[0] != "pbbase.BasePage#99" {
+		t.Errorf("unexpected unknown fields: %v", fields)
+	}
+
+	if fields := UnknownFields(&pbbase.BasePage{}); len(fields) != 0 {
+		t.Errorf("unexpected unknown fields: %v", fields)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package compat
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnknownFields returns the unknown fields of the message and its sub messages, which are sent by a newer
+// peer and dropped by the current version, e.g. "pbds.CreateAppReq.spec#15".
+func UnknownFields(m proto.Message) []string {
+	if m == nil {
+		return nil
+	}
+
+	var fields []string
+	walkUnknown(m.ProtoReflect(), string(m.ProtoReflect().Descriptor().FullName()), &fields)
+	return fields
+}
+
+func walkUnknown(m protoreflect.Message, path string, fields *[]string) {
+	if !m.IsValid() {
+		return
+	}
+
+	// repeated 的未知字段会出现多次, 同一字段只记录一次
+	seen := make(map[protowire.Number]bool)
+	for raw := m.GetUnknown(); len(raw) > 0; {
+		number, _, n := protowire.ConsumeField(raw)
+		if n < 0 {
+			*fields = append(*fields, path+"#?")
+			break
+		}
+		if !seen[number] {
+			seen[number] = true
+			*fields = append(*fields, fmt.Sprintf("%s#%d", path, number))
+		}
+		raw = raw[n:]
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Message() == nil || fd.IsMap() && fd.MapValue().Message() == nil {
+			return true
+		}
+
+		sub := path + "." + string(fd.Name())
+		switch {
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				walkUnknown(list.Get(i).Message(), sub, fields)
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				walkUnknown(mv.Message(), sub, fields)
+				return true
+			})
+		default:
+			walkUnknown(v.Message(), sub, fields)
+		}
+		return true
+	})
+}
@@ -11,6 +11,7 @@ import (
 	app "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
 	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	client "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
+	client_preview "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-preview"
 	group "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
 	hook_revision "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-revision"
 	release "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
@@ -29,6 +30,9 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Config_CreateApp_FullMethodName                          = "/pbcs.Config/CreateApp"
 	Config_UpdateApp_FullMethodName                          = "/pbcs.Config/UpdateApp"
+	Config_UpdateAppState_FullMethodName                     = "/pbcs.Config/UpdateAppState"
+	Config_PauseAppConsumption_FullMethodName                = "/pbcs.Config/PauseAppConsumption"
+	Config_ResumeAppConsumption_FullMethodName               = "/pbcs.Config/ResumeAppConsumption"
 	Config_DeleteApp_FullMethodName                          = "/pbcs.Config/DeleteApp"
 	Config_GetApp_FullMethodName                             = "/pbcs.Config/GetApp"
 	Config_GetAppByName_FullMethodName                       = "/pbcs.Config/GetAppByName"
@@ -76,6 +80,100 @@ const (
 	Config_ListHookReferences_FullMethodName                 = "/pbcs.Config/ListHookReferences"
 	Config_ListHookRevisionReferences_FullMethodName         = "/pbcs.Config/ListHookRevisionReferences"
 	Config_GetReleaseHook_FullMethodName                     = "/pbcs.Config/GetReleaseHook"
+	Config_ListHookExecResults_FullMethodName                = "/pbcs.Config/ListHookExecResults"
+	Config_ListFileTamperEvents_FullMethodName               = "/pbcs.Config/ListFileTamperEvents"
+	Config_CreateHookTemplate_FullMethodName                 = "/pbcs.Config/CreateHookTemplate"
+	Config_ListHookTemplates_FullMethodName                  = "/pbcs.Config/ListHookTemplates"
+	Config_UpdateHookTemplate_FullMethodName                 = "/pbcs.Config/UpdateHookTemplate"
+	Config_DeleteHookTemplate_FullMethodName                 = "/pbcs.Config/DeleteHookTemplate"
+	Config_CreateHookFromTemplate_FullMethodName             = "/pbcs.Config/CreateHookFromTemplate"
+	Config_UpgradeHookFromTemplate_FullMethodName            = "/pbcs.Config/UpgradeHookFromTemplate"
+	Config_UpgradeHooksFromTemplate_FullMethodName           = "/pbcs.Config/UpgradeHooksFromTemplate"
+	Config_GetHookTemplateRef_FullMethodName                 = "/pbcs.Config/GetHookTemplateRef"
+	Config_CreateEnvironment_FullMethodName                  = "/pbcs.Config/CreateEnvironment"
+	Config_ListEnvironments_FullMethodName                   = "/pbcs.Config/ListEnvironments"
+	Config_UpdateEnvironment_FullMethodName                  = "/pbcs.Config/UpdateEnvironment"
+	Config_DeleteEnvironment_FullMethodName                  = "/pbcs.Config/DeleteEnvironment"
+	Config_BindEnvApp_FullMethodName                         = "/pbcs.Config/BindEnvApp"
+	Config_UnbindEnvApp_FullMethodName                       = "/pbcs.Config/UnbindEnvApp"
+	Config_ListEnvApps_FullMethodName                        = "/pbcs.Config/ListEnvApps"
+	Config_PromoteRelease_FullMethodName                     = "/pbcs.Config/PromoteRelease"
+	Config_CreateConfigShare_FullMethodName                  = "/pbcs.Config/CreateConfigShare"
+	Config_UpdateConfigShare_FullMethodName                  = "/pbcs.Config/UpdateConfigShare"
+	Config_DeleteConfigShare_FullMethodName                  = "/pbcs.Config/DeleteConfigShare"
+	Config_ListConfigShares_FullMethodName                   = "/pbcs.Config/ListConfigShares"
+	Config_ListSharedConfigs_FullMethodName                  = "/pbcs.Config/ListSharedConfigs"
+	Config_CreateConfigShareRef_FullMethodName               = "/pbcs.Config/CreateConfigShareRef"
+	Config_DeleteConfigShareRef_FullMethodName               = "/pbcs.Config/DeleteConfigShareRef"
+	Config_ListConfigShareRefs_FullMethodName                = "/pbcs.Config/ListConfigShareRefs"
+	Config_ListRecycleBin_FullMethodName                     = "/pbcs.Config/ListRecycleBin"
+	Config_RestoreRecycleBin_FullMethodName                  = "/pbcs.Config/RestoreRecycleBin"
+	Config_CreateAppSnapshot_FullMethodName                  = "/pbcs.Config/CreateAppSnapshot"
+	Config_ListAppSnapshots_FullMethodName                   = "/pbcs.Config/ListAppSnapshots"
+	Config_DeleteAppSnapshot_FullMethodName                  = "/pbcs.Config/DeleteAppSnapshot"
+	Config_RestoreAppSnapshot_FullMethodName                 = "/pbcs.Config/RestoreAppSnapshot"
+	Config_CloneApp_FullMethodName                           = "/pbcs.Config/CloneApp"
+	Config_UpsertDraftItem_FullMethodName                    = "/pbcs.Config/UpsertDraftItem"
+	Config_DeleteDraftItem_FullMethodName                    = "/pbcs.Config/DeleteDraftItem"
+	Config_GetDraft_FullMethodName                           = "/pbcs.Config/GetDraft"
+	Config_MergeDraft_FullMethodName                         = "/pbcs.Config/MergeDraft"
+	Config_DiscardDraft_FullMethodName                       = "/pbcs.Config/DiscardDraft"
+	Config_SearchFullText_FullMethodName                     = "/pbcs.Config/SearchFullText"
+	Config_ListTmplWhereUsed_FullMethodName                  = "/pbcs.Config/ListTmplWhereUsed"
+	Config_ListTmplVariableWhereUsed_FullMethodName          = "/pbcs.Config/ListTmplVariableWhereUsed"
+	Config_CreateConfigReference_FullMethodName              = "/pbcs.Config/CreateConfigReference"
+	Config_DeleteConfigReference_FullMethodName              = "/pbcs.Config/DeleteConfigReference"
+	Config_GetConfigRefGraph_FullMethodName                  = "/pbcs.Config/GetConfigRefGraph"
+	Config_ListExpiringCertificates_FullMethodName           = "/pbcs.Config/ListExpiringCertificates"
+	Config_RenewCertificate_FullMethodName                   = "/pbcs.Config/RenewCertificate"
+	Config_CreateKvOverride_FullMethodName                   = "/pbcs.Config/CreateKvOverride"
+	Config_UpdateKvOverride_FullMethodName                   = "/pbcs.Config/UpdateKvOverride"
+	Config_DeleteKvOverride_FullMethodName                   = "/pbcs.Config/DeleteKvOverride"
+	Config_ListKvOverrides_FullMethodName                    = "/pbcs.Config/ListKvOverrides"
+	Config_CreateCompliancePolicy_FullMethodName             = "/pbcs.Config/CreateCompliancePolicy"
+	Config_ListCompliancePolicies_FullMethodName             = "/pbcs.Config/ListCompliancePolicies"
+	Config_UpdateCompliancePolicy_FullMethodName             = "/pbcs.Config/UpdateCompliancePolicy"
+	Config_DeleteCompliancePolicy_FullMethodName             = "/pbcs.Config/DeleteCompliancePolicy"
+	Config_TestCompliancePolicy_FullMethodName               = "/pbcs.Config/TestCompliancePolicy"
+	Config_CreateNotificationChannel_FullMethodName          = "/pbcs.Config/CreateNotificationChannel"
+	Config_ListNotificationChannels_FullMethodName           = "/pbcs.Config/ListNotificationChannels"
+	Config_UpdateNotificationChannel_FullMethodName          = "/pbcs.Config/UpdateNotificationChannel"
+	Config_DeleteNotificationChannel_FullMethodName          = "/pbcs.Config/DeleteNotificationChannel"
+	Config_TestNotificationChannel_FullMethodName            = "/pbcs.Config/TestNotificationChannel"
+	Config_CreateAlertRule_FullMethodName                    = "/pbcs.Config/CreateAlertRule"
+	Config_ListAlertRules_FullMethodName                     = "/pbcs.Config/ListAlertRules"
+	Config_UpdateAlertRule_FullMethodName                    = "/pbcs.Config/UpdateAlertRule"
+	Config_DeleteAlertRule_FullMethodName                    = "/pbcs.Config/DeleteAlertRule"
+	Config_CreateClientQuarantine_FullMethodName             = "/pbcs.Config/CreateClientQuarantine"
+	Config_ListClientQuarantines_FullMethodName              = "/pbcs.Config/ListClientQuarantines"
+	Config_UpdateClientQuarantine_FullMethodName             = "/pbcs.Config/UpdateClientQuarantine"
+	Config_DeleteClientQuarantine_FullMethodName             = "/pbcs.Config/DeleteClientQuarantine"
+	Config_CreateExperiment_FullMethodName                   = "/pbcs.Config/CreateExperiment"
+	Config_ListExperiments_FullMethodName                    = "/pbcs.Config/ListExperiments"
+	Config_UpdateExperiment_FullMethodName                   = "/pbcs.Config/UpdateExperiment"
+	Config_DeleteExperiment_FullMethodName                   = "/pbcs.Config/DeleteExperiment"
+	Config_GetExperimentStats_FullMethodName                 = "/pbcs.Config/GetExperimentStats"
+	Config_CreateServiceAccount_FullMethodName               = "/pbcs.Config/CreateServiceAccount"
+	Config_ListServiceAccounts_FullMethodName                = "/pbcs.Config/ListServiceAccounts"
+	Config_UpdateServiceAccount_FullMethodName               = "/pbcs.Config/UpdateServiceAccount"
+	Config_DeleteServiceAccount_FullMethodName               = "/pbcs.Config/DeleteServiceAccount"
+	Config_ResetServiceAccountKey_FullMethodName             = "/pbcs.Config/ResetServiceAccountKey"
+	Config_SetAppRoleBinding_FullMethodName                  = "/pbcs.Config/SetAppRoleBinding"
+	Config_GrantTemporaryAppRole_FullMethodName              = "/pbcs.Config/GrantTemporaryAppRole"
+	Config_ListAppRoleBindings_FullMethodName                = "/pbcs.Config/ListAppRoleBindings"
+	Config_DeleteAppRoleBinding_FullMethodName               = "/pbcs.Config/DeleteAppRoleBinding"
+	Config_CheckAppPermissions_FullMethodName                = "/pbcs.Config/CheckAppPermissions"
+	Config_GetMaskingProfile_FullMethodName                  = "/pbcs.Config/GetMaskingProfile"
+	Config_UpdateMaskingProfile_FullMethodName               = "/pbcs.Config/UpdateMaskingProfile"
+	Config_PreviewMasking_FullMethodName                     = "/pbcs.Config/PreviewMasking"
+	Config_GetSecretScanSetting_FullMethodName               = "/pbcs.Config/GetSecretScanSetting"
+	Config_UpdateSecretScanSetting_FullMethodName            = "/pbcs.Config/UpdateSecretScanSetting"
+	Config_ScanSecretLeak_FullMethodName                     = "/pbcs.Config/ScanSecretLeak"
+	Config_GetAppConsumption_FullMethodName                  = "/pbcs.Config/GetAppConsumption"
+	Config_ListTopConsumingApps_FullMethodName               = "/pbcs.Config/ListTopConsumingApps"
+	Config_ListStaleResources_FullMethodName                 = "/pbcs.Config/ListStaleResources"
+	Config_ListBizUsages_FullMethodName                      = "/pbcs.Config/ListBizUsages"
+	Config_GetBizOverview_FullMethodName                     = "/pbcs.Config/GetBizOverview"
 	Config_CreateTemplateSpace_FullMethodName                = "/pbcs.Config/CreateTemplateSpace"
 	Config_DeleteTemplateSpace_FullMethodName                = "/pbcs.Config/DeleteTemplateSpace"
 	Config_UpdateTemplateSpace_FullMethodName                = "/pbcs.Config/UpdateTemplateSpace"
@@ -159,14 +257,26 @@ const (
 	Config_ListGroupReleasedApps_FullMethodName              = "/pbcs.Config/ListGroupReleasedApps"
 	Config_GetGroupByName_FullMethodName                     = "/pbcs.Config/GetGroupByName"
 	Config_ListGroupSelector_FullMethodName                  = "/pbcs.Config/ListGroupSelector"
+	Config_PreviewGroupClients_FullMethodName                = "/pbcs.Config/PreviewGroupClients"
+	Config_PreviewClientRelease_FullMethodName               = "/pbcs.Config/PreviewClientRelease"
 	Config_Publish_FullMethodName                            = "/pbcs.Config/Publish"
 	Config_GenerateReleaseAndPublish_FullMethodName          = "/pbcs.Config/GenerateReleaseAndPublish"
+	Config_BulkRelease_FullMethodName                        = "/pbcs.Config/BulkRelease"
+	Config_GetOperation_FullMethodName                       = "/pbcs.Config/GetOperation"
+	Config_ListOperations_FullMethodName                     = "/pbcs.Config/ListOperations"
+	Config_CancelOperation_FullMethodName                    = "/pbcs.Config/CancelOperation"
 	Config_SubmitPublishApprove_FullMethodName               = "/pbcs.Config/SubmitPublishApprove"
 	Config_Approve_FullMethodName                            = "/pbcs.Config/Approve"
+	Config_EmergencyPublish_FullMethodName                   = "/pbcs.Config/EmergencyPublish"
+	Config_ListEmergencyPublishes_FullMethodName             = "/pbcs.Config/ListEmergencyPublishes"
+	Config_RevokeEmergencyPublish_FullMethodName             = "/pbcs.Config/RevokeEmergencyPublish"
+	Config_PinReleasedGroup_FullMethodName                   = "/pbcs.Config/PinReleasedGroup"
+	Config_UnpinReleasedGroup_FullMethodName                 = "/pbcs.Config/UnpinReleasedGroup"
 	Config_GetLastSelect_FullMethodName                      = "/pbcs.Config/GetLastSelect"
 	Config_GetLastPublish_FullMethodName                     = "/pbcs.Config/GetLastPublish"
 	Config_GetReleasesStatus_FullMethodName                  = "/pbcs.Config/GetReleasesStatus"
 	Config_ListAudits_FullMethodName                         = "/pbcs.Config/ListAudits"
+	Config_ListAppTimeline_FullMethodName                    = "/pbcs.Config/ListAppTimeline"
 	Config_CreateCredentials_FullMethodName                  = "/pbcs.Config/CreateCredentials"
 	Config_ListCredentials_FullMethodName                    = "/pbcs.Config/ListCredentials"
 	Config_DeleteCredential_FullMethodName                   = "/pbcs.Config/DeleteCredential"
@@ -174,6 +284,8 @@ const (
 	Config_CheckCredentialName_FullMethodName                = "/pbcs.Config/CheckCredentialName"
 	Config_ListCredentialScopes_FullMethodName               = "/pbcs.Config/ListCredentialScopes"
 	Config_UpdateCredentialScope_FullMethodName              = "/pbcs.Config/UpdateCredentialScope"
+	Config_PauseCredentialConsumption_FullMethodName         = "/pbcs.Config/PauseCredentialConsumption"
+	Config_ResumeCredentialConsumption_FullMethodName        = "/pbcs.Config/ResumeCredentialConsumption"
 	Config_CredentialScopePreview_FullMethodName             = "/pbcs.Config/CredentialScopePreview"
 	Config_CreateKv_FullMethodName                           = "/pbcs.Config/CreateKv"
 	Config_UpdateKv_FullMethodName                           = "/pbcs.Config/UpdateKv"
@@ -181,11 +293,15 @@ const (
 	Config_DeleteKv_FullMethodName                           = "/pbcs.Config/DeleteKv"
 	Config_BatchDeleteKv_FullMethodName                      = "/pbcs.Config/BatchDeleteKv"
 	Config_BatchUpsertKvs_FullMethodName                     = "/pbcs.Config/BatchUpsertKvs"
+	Config_TransactKvs_FullMethodName                        = "/pbcs.Config/TransactKvs"
+	Config_ListKvHistory_FullMethodName                      = "/pbcs.Config/ListKvHistory"
+	Config_DiffKvVersions_FullMethodName                     = "/pbcs.Config/DiffKvVersions"
 	Config_UnDeleteKv_FullMethodName                         = "/pbcs.Config/UnDeleteKv"
 	Config_BatchUnDeleteKv_FullMethodName                    = "/pbcs.Config/BatchUnDeleteKv"
 	Config_UndoKv_FullMethodName                             = "/pbcs.Config/UndoKv"
 	Config_ImportKvs_FullMethodName                          = "/pbcs.Config/ImportKvs"
 	Config_FindNearExpiryCertKvs_FullMethodName              = "/pbcs.Config/FindNearExpiryCertKvs"
+	Config_CompareKvValue_FullMethodName                     = "/pbcs.Config/CompareKvValue"
 	Config_ListClients_FullMethodName                        = "/pbcs.Config/ListClients"
 	Config_ListClientEvents_FullMethodName                   = "/pbcs.Config/ListClientEvents"
 	Config_RetryClients_FullMethodName                       = "/pbcs.Config/RetryClients"
@@ -211,10 +327,16 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ConfigClient interface {
-	// 创建服务
+	//  创建服务
 	CreateApp(ctx context.Context, in *CreateAppReq, opts ...grpc.CallOption) (*CreateAppResp, error)
 	// 更新服务
 	UpdateApp(ctx context.Context, in *UpdateAppReq, opts ...grpc.CallOption) (*app.App, error)
+	// 变更服务生命周期状态：冻结后拒绝修改和上线但继续提供已上线版本，归档后停止向客户端提供配置
+	UpdateAppState(ctx context.Context, in *UpdateAppStateReq, opts ...grpc.CallOption) (*UpdateAppStateResp, error)
+	// 暂停客户端拉取服务的新版本，用于快速止损异常上线
+	PauseAppConsumption(ctx context.Context, in *PauseAppConsumptionReq, opts ...grpc.CallOption) (*PauseAppConsumptionResp, error)
+	// 恢复客户端拉取服务的配置
+	ResumeAppConsumption(ctx context.Context, in *ResumeAppConsumptionReq, opts ...grpc.CallOption) (*ResumeAppConsumptionResp, error)
 	// 删除服务
 	DeleteApp(ctx context.Context, in *DeleteAppReq, opts ...grpc.CallOption) (*DeleteAppResp, error)
 	// 获取服务
@@ -309,6 +431,194 @@ type ConfigClient interface {
 	ListHookRevisionReferences(ctx context.Context, in *ListHookRevisionReferencesReq, opts ...grpc.CallOption) (*ListHookRevisionReferencesResp, error)
 	// TODO
 	GetReleaseHook(ctx context.Context, in *GetReleaseHookReq, opts ...grpc.CallOption) (*GetReleaseHookResp, error)
+	// 获取客户端上报的前置/后置脚本执行结果
+	ListHookExecResults(ctx context.Context, in *ListHookExecResultsReq, opts ...grpc.CallOption) (*ListHookExecResultsResp, error)
+	// 获取客户端完整性校验上报的托管文件篡改事件
+	ListFileTamperEvents(ctx context.Context, in *ListFileTamperEventsReq, opts ...grpc.CallOption) (*ListFileTamperEventsResp, error)
+	// 创建脚本模板
+	CreateHookTemplate(ctx context.Context, in *CreateHookTemplateReq, opts ...grpc.CallOption) (*CreateHookTemplateResp, error)
+	// 获取脚本模板列表，包含平台内置模板
+	ListHookTemplates(ctx context.Context, in *ListHookTemplatesReq, opts ...grpc.CallOption) (*ListHookTemplatesResp, error)
+	// 更新脚本模板，开启自动升级的脚本会同步上线新的脚本版本
+	UpdateHookTemplate(ctx context.Context, in *UpdateHookTemplateReq, opts ...grpc.CallOption) (*UpdateHookTemplateResp, error)
+	// 删除脚本模板
+	DeleteHookTemplate(ctx context.Context, in *DeleteHookTemplateReq, opts ...grpc.CallOption) (*DeleteHookTemplateResp, error)
+	// 通过脚本模板创建脚本
+	CreateHookFromTemplate(ctx context.Context, in *CreateHookFromTemplateReq, opts ...grpc.CallOption) (*CreateHookResp, error)
+	// 将脚本升级到脚本模板的最新版本
+	UpgradeHookFromTemplate(ctx context.Context, in *UpgradeHookFromTemplateReq, opts ...grpc.CallOption) (*UpgradeHookFromTemplateResp, error)
+	// 异步批量升级引用脚本模板的脚本，返回异步操作ID
+	UpgradeHooksFromTemplate(ctx context.Context, in *UpgradeHooksFromTemplateReq, opts ...grpc.CallOption) (*UpgradeHooksFromTemplateResp, error)
+	// 获取脚本引用的脚本模板
+	GetHookTemplateRef(ctx context.Context, in *GetHookTemplateRefReq, opts ...grpc.CallOption) (*GetHookTemplateRefResp, error)
+	// 创建环境
+	CreateEnvironment(ctx context.Context, in *CreateEnvironmentReq, opts ...grpc.CallOption) (*CreateEnvironmentResp, error)
+	// 获取业务下的环境列表，按晋级顺序排列
+	ListEnvironments(ctx context.Context, in *ListEnvironmentsReq, opts ...grpc.CallOption) (*ListEnvironmentsResp, error)
+	// 更新环境
+	UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentReq, opts ...grpc.CallOption) (*UpdateEnvironmentResp, error)
+	// 删除环境，环境下存在服务时不允许删除
+	DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentReq, opts ...grpc.CallOption) (*DeleteEnvironmentResp, error)
+	// 将服务绑定到环境，不同环境下相同服务标识的服务视为同一个服务
+	BindEnvApp(ctx context.Context, in *BindEnvAppReq, opts ...grpc.CallOption) (*BindEnvAppResp, error)
+	// 解除服务与环境的绑定
+	UnbindEnvApp(ctx context.Context, in *UnbindEnvAppReq, opts ...grpc.CallOption) (*UnbindEnvAppResp, error)
+	// 获取服务与环境的绑定关系列表
+	ListEnvApps(ctx context.Context, in *ListEnvAppsReq, opts ...grpc.CallOption) (*ListEnvAppsResp, error)
+	// 将服务版本晋级到目标环境中相同服务标识的服务，在目标服务中生成同样内容的版本
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
+	// 将服务的配置文件或配置项共享给其他业务只读引用
+	CreateConfigShare(ctx context.Context, in *CreateConfigShareReq, opts ...grpc.CallOption) (*CreateConfigShareResp, error)
+	// 更新共享配置的授权业务和描述
+	UpdateConfigShare(ctx context.Context, in *UpdateConfigShareReq, opts ...grpc.CallOption) (*UpdateConfigShareResp, error)
+	// 删除共享配置，同时删除其他业务对它的引用
+	DeleteConfigShare(ctx context.Context, in *DeleteConfigShareReq, opts ...grpc.CallOption) (*DeleteConfigShareResp, error)
+	// 获取本业务共享出去的配置列表
+	ListConfigShares(ctx context.Context, in *ListConfigSharesReq, opts ...grpc.CallOption) (*ListConfigSharesResp, error)
+	// 获取其他业务授权给本业务的共享配置列表
+	ListSharedConfigs(ctx context.Context, in *ListSharedConfigsReq, opts ...grpc.CallOption) (*ListSharedConfigsResp, error)
+	// 服务引用其他业务授权的共享配置，生成版本时会带上共享配置最新的已生成版本内容
+	CreateConfigShareRef(ctx context.Context, in *CreateConfigShareRefReq, opts ...grpc.CallOption) (*CreateConfigShareRefResp, error)
+	// 删除服务对共享配置的引用
+	DeleteConfigShareRef(ctx context.Context, in *DeleteConfigShareRefReq, opts ...grpc.CallOption) (*DeleteConfigShareRefResp, error)
+	// 获取服务引用的共享配置列表
+	ListConfigShareRefs(ctx context.Context, in *ListConfigShareRefsReq, opts ...grpc.CallOption) (*ListConfigShareRefsResp, error)
+	// 获取回收站中被删除的服务、配置文件和配置项列表
+	ListRecycleBin(ctx context.Context, in *ListRecycleBinReq, opts ...grpc.CallOption) (*ListRecycleBinResp, error)
+	// 从回收站恢复被删除的服务、配置文件或配置项
+	RestoreRecycleBin(ctx context.Context, in *RestoreRecycleBinReq, opts ...grpc.CallOption) (*RestoreRecycleBinResp, error)
+	// 为服务当前的编辑态（配置文件、配置项、模板套餐绑定、变量）创建快照
+	CreateAppSnapshot(ctx context.Context, in *CreateAppSnapshotReq, opts ...grpc.CallOption) (*CreateAppSnapshotResp, error)
+	// 获取服务的快照列表
+	ListAppSnapshots(ctx context.Context, in *ListAppSnapshotsReq, opts ...grpc.CallOption) (*ListAppSnapshotsResp, error)
+	// 删除服务快照
+	DeleteAppSnapshot(ctx context.Context, in *DeleteAppSnapshotReq, opts ...grpc.CallOption) (*DeleteAppSnapshotResp, error)
+	// 将服务的编辑态恢复到快照，不影响已生成的版本
+	RestoreAppSnapshot(ctx context.Context, in *RestoreAppSnapshotReq, opts ...grpc.CallOption) (*RestoreAppSnapshotResp, error)
+	// 克隆服务，可选择复制配置文件、配置项、模版套餐绑定、变量及脚本，支持跨业务克隆和名称重映射
+	CloneApp(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CloneAppResp, error)
+	// 将配置文件或配置项的修改暂存到当前用户的个人草稿，合并前对其他人不可见
+	UpsertDraftItem(ctx context.Context, in *UpsertDraftItemReq, opts ...grpc.CallOption) (*UpsertDraftItemResp, error)
+	// 从当前用户的个人草稿中移除修改
+	DeleteDraftItem(ctx context.Context, in *DeleteDraftItemReq, opts ...grpc.CallOption) (*DeleteDraftItemResp, error)
+	// 获取当前用户的个人草稿及与服务共享编辑态的对比
+	GetDraft(ctx context.Context, in *GetDraftReq, opts ...grpc.CallOption) (*GetDraftResp, error)
+	// 将当前用户的个人草稿合并到服务的共享编辑态
+	MergeDraft(ctx context.Context, in *MergeDraftReq, opts ...grpc.CallOption) (*MergeDraftResp, error)
+	// 丢弃当前用户的个人草稿
+	DiscardDraft(ctx context.Context, in *DiscardDraftReq, opts ...grpc.CallOption) (*DiscardDraftResp, error)
+	// 全文检索业务下有权限查看的服务的配置文件内容和配置项的值
+	SearchFullText(ctx context.Context, in *SearchFullTextReq, opts ...grpc.CallOption) (*SearchFullTextResp, error)
+	// 查询模板文件被哪些服务、配置文件和上线中的版本使用，用于评估变更影响范围
+	ListTmplWhereUsed(ctx context.Context, in *ListTmplWhereUsedReq, opts ...grpc.CallOption) (*ListTmplWhereUsedResp, error)
+	// 查询模板变量被哪些服务、配置文件和上线中的版本使用，用于评估变更影响范围
+	ListTmplVariableWhereUsed(ctx context.Context, in *ListTmplVariableWhereUsedReq, opts ...grpc.CallOption) (*ListTmplVariableWhereUsedResp, error)
+	// 声明服务下的配置对其他配置的引用关系，被引用的配置在引用关系删除前不允许删除
+	CreateConfigReference(ctx context.Context, in *CreateConfigReferenceReq, opts ...grpc.CallOption) (*CreateConfigReferenceResp, error)
+	// 删除服务下的配置对其他配置的引用关系
+	DeleteConfigReference(ctx context.Context, in *DeleteConfigReferenceReq, opts ...grpc.CallOption) (*DeleteConfigReferenceResp, error)
+	// 查询配置的引用关系图，用于展示变更和删除的影响范围
+	GetConfigRefGraph(ctx context.Context, in *GetConfigRefGraphReq, opts ...grpc.CallOption) (*GetConfigRefGraphResp, error)
+	// 查询即将过期的证书
+	ListExpiringCertificates(ctx context.Context, in *ListExpiringCertificatesReq, opts ...grpc.CallOption) (*ListExpiringCertificatesResp, error)
+	// 通过内部 CA 续期证书，新证书保存为未上线配置
+	RenewCertificate(ctx context.Context, in *RenewCertificateReq, opts ...grpc.CallOption) (*RenewCertificateResp, error)
+	// 创建键值配置项的分组覆盖值，匹配该分组的客户端拉取到覆盖值
+	CreateKvOverride(ctx context.Context, in *CreateKvOverrideReq, opts ...grpc.CallOption) (*CreateKvOverrideResp, error)
+	// 更新键值配置项的分组覆盖值
+	UpdateKvOverride(ctx context.Context, in *UpdateKvOverrideReq, opts ...grpc.CallOption) (*UpdateKvOverrideResp, error)
+	// 删除键值配置项的分组覆盖值
+	DeleteKvOverride(ctx context.Context, in *DeleteKvOverrideReq, opts ...grpc.CallOption) (*DeleteKvOverrideResp, error)
+	// 获取键值配置项的分组覆盖值列表
+	ListKvOverrides(ctx context.Context, in *ListKvOverridesReq, opts ...grpc.CallOption) (*ListKvOverridesResp, error)
+	// 创建配置合规策略，在生成和上线版本时检查配置内容
+	CreateCompliancePolicy(ctx context.Context, in *CreateCompliancePolicyReq, opts ...grpc.CallOption) (*CreateCompliancePolicyResp, error)
+	// 获取业务下的配置合规策略列表
+	ListCompliancePolicies(ctx context.Context, in *ListCompliancePoliciesReq, opts ...grpc.CallOption) (*ListCompliancePoliciesResp, error)
+	// 更新配置合规策略
+	UpdateCompliancePolicy(ctx context.Context, in *UpdateCompliancePolicyReq, opts ...grpc.CallOption) (*UpdateCompliancePolicyResp, error)
+	// 删除配置合规策略
+	DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyReq, opts ...grpc.CallOption) (*DeleteCompliancePolicyResp, error)
+	// 测试配置合规策略，使用样例内容或指定服务的未命名版本检查，不保存策略
+	TestCompliancePolicy(ctx context.Context, in *TestCompliancePolicyReq, opts ...grpc.CallOption) (*TestCompliancePolicyResp, error)
+	// 创建通知渠道，发布和审批事件发生时通过企业微信、Slack 或邮件通知
+	CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelReq, opts ...grpc.CallOption) (*CreateNotificationChannelResp, error)
+	// 获取业务下的通知渠道列表
+	ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsReq, opts ...grpc.CallOption) (*ListNotificationChannelsResp, error)
+	// 更新通知渠道
+	UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelReq, opts ...grpc.CallOption) (*UpdateNotificationChannelResp, error)
+	// 删除通知渠道
+	DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelReq, opts ...grpc.CallOption) (*DeleteNotificationChannelResp, error)
+	// 测试通知渠道，使用样例数据发送一条指定事件的消息
+	TestNotificationChannel(ctx context.Context, in *TestNotificationChannelReq, opts ...grpc.CallOption) (*TestNotificationChannelResp, error)
+	// 创建告警规则，定时检测平台指标，超过阈值时发送到通知渠道
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleReq, opts ...grpc.CallOption) (*CreateAlertRuleResp, error)
+	// 获取业务下的告警规则列表
+	ListAlertRules(ctx context.Context, in *ListAlertRulesReq, opts ...grpc.CallOption) (*ListAlertRulesResp, error)
+	// 更新告警规则
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleReq, opts ...grpc.CallOption) (*UpdateAlertRuleResp, error)
+	// 删除告警规则
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleReq, opts ...grpc.CallOption) (*DeleteAlertRuleResp, error)
+	// 隔离指定指纹或IP的客户端，feed server 拒绝其握手和 watch 请求并返回退避时间，不影响使用同一密钥的其他客户端
+	CreateClientQuarantine(ctx context.Context, in *CreateClientQuarantineReq, opts ...grpc.CallOption) (*CreateClientQuarantineResp, error)
+	// 获取业务下的客户端隔离列表
+	ListClientQuarantines(ctx context.Context, in *ListClientQuarantinesReq, opts ...grpc.CallOption) (*ListClientQuarantinesResp, error)
+	// 更新客户端隔离，匹配方式和值不可修改
+	UpdateClientQuarantine(ctx context.Context, in *UpdateClientQuarantineReq, opts ...grpc.CallOption) (*UpdateClientQuarantineResp, error)
+	// 解除客户端隔离
+	DeleteClientQuarantine(ctx context.Context, in *DeleteClientQuarantineReq, opts ...grpc.CallOption) (*DeleteClientQuarantineResp, error)
+	// 创建配置项实验，参与实验的客户端按权重分桶，不同分桶下发不同的配置项值
+	CreateExperiment(ctx context.Context, in *CreateExperimentReq, opts ...grpc.CallOption) (*CreateExperimentResp, error)
+	// 获取服务下的配置项实验列表
+	ListExperiments(ctx context.Context, in *ListExperimentsReq, opts ...grpc.CallOption) (*ListExperimentsResp, error)
+	// 更新配置项实验，实验的配置项key不可修改
+	UpdateExperiment(ctx context.Context, in *UpdateExperimentReq, opts ...grpc.CallOption) (*UpdateExperimentResp, error)
+	// 删除配置项实验及其曝光记录
+	DeleteExperiment(ctx context.Context, in *DeleteExperimentReq, opts ...grpc.CallOption) (*DeleteExperimentResp, error)
+	// 获取配置项实验各分桶的曝光统计
+	GetExperimentStats(ctx context.Context, in *GetExperimentStatsReq, opts ...grpc.CallOption) (*GetExperimentStatsResp, error)
+	// 创建服务账号，用于流水线等自动化场景调用管理接口，API 密钥只在响应中返回一次
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*CreateServiceAccountResp, error)
+	// 获取业务下的服务账号列表
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsReq, opts ...grpc.CallOption) (*ListServiceAccountsResp, error)
+	// 更新服务账号的描述、授权范围、启用状态和过期时间
+	UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountReq, opts ...grpc.CallOption) (*UpdateServiceAccountResp, error)
+	// 删除服务账号
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountReq, opts ...grpc.CallOption) (*DeleteServiceAccountResp, error)
+	// 重置服务账号的 API 密钥，旧密钥立即失效
+	ResetServiceAccountKey(ctx context.Context, in *ResetServiceAccountKeyReq, opts ...grpc.CallOption) (*ResetServiceAccountKeyResp, error)
+	// 设置用户在服务下的角色，已有角色时覆盖
+	SetAppRoleBinding(ctx context.Context, in *SetAppRoleBindingReq, opts ...grpc.CallOption) (*SetAppRoleBindingResp, error)
+	// 临时授予用户服务角色，如发布窗口期内授予发布权限，到期后自动回收
+	GrantTemporaryAppRole(ctx context.Context, in *GrantTemporaryAppRoleReq, opts ...grpc.CallOption) (*GrantTemporaryAppRoleResp, error)
+	// 获取服务下的角色授权列表
+	ListAppRoleBindings(ctx context.Context, in *ListAppRoleBindingsReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error)
+	// 删除服务下的角色授权
+	DeleteAppRoleBinding(ctx context.Context, in *DeleteAppRoleBindingReq, opts ...grpc.CallOption) (*DeleteAppRoleBindingResp, error)
+	// 批量检查当前用户对服务的操作权限，用于前端展示操作按钮
+	CheckAppPermissions(ctx context.Context, in *CheckAppPermissionsReq, opts ...grpc.CallOption) (*CheckAppPermissionsResp, error)
+	// 获取服务的配置内容脱敏规则，未设置时返回未启用的空规则
+	GetMaskingProfile(ctx context.Context, in *GetMaskingProfileReq, opts ...grpc.CallOption) (*GetMaskingProfileResp, error)
+	// 更新服务的配置内容脱敏规则，没有查看敏感信息权限的用户读取配置内容时按规则脱敏
+	UpdateMaskingProfile(ctx context.Context, in *UpdateMaskingProfileReq, opts ...grpc.CallOption) (*UpdateMaskingProfileResp, error)
+	// 使用脱敏规则对样例内容进行脱敏预览，不保存规则
+	PreviewMasking(ctx context.Context, in *PreviewMaskingReq, opts ...grpc.CallOption) (*PreviewMaskingResp, error)
+	// 获取业务的凭证泄露扫描设置
+	GetSecretScanSetting(ctx context.Context, in *GetSecretScanSettingReq, opts ...grpc.CallOption) (*GetSecretScanSettingResp, error)
+	// 更新业务的凭证泄露扫描设置，上传配置文件和编辑配置项时按设置扫描疑似凭证
+	UpdateSecretScanSetting(ctx context.Context, in *UpdateSecretScanSettingReq, opts ...grpc.CallOption) (*UpdateSecretScanSettingResp, error)
+	// 扫描样例内容中的疑似凭证，不保存设置
+	ScanSecretLeak(ctx context.Context, in *ScanSecretLeakReq, opts ...grpc.CallOption) (*ScanSecretLeakResp, error)
+	// 获取服务的消费统计，包括拉取次数、下发字节数、在线客户端数和最后拉取时间
+	GetAppConsumption(ctx context.Context, in *GetAppConsumptionReq, opts ...grpc.CallOption) (*GetAppConsumptionResp, error)
+	// 获取业务下拉取次数最多的服务
+	ListTopConsumingApps(ctx context.Context, in *ListTopConsumingAppsReq, opts ...grpc.CallOption) (*ListTopConsumingAppsResp, error)
+	// 获取长期未被消费的服务、配置文件和密钥的闲置报告
+	ListStaleResources(ctx context.Context, in *ListStaleResourcesReq, opts ...grpc.CallOption) (*ListStaleResourcesResp, error)
+	// 获取业务每月的用量（存储、下发流量、请求数、监听时长），用于成本核算
+	ListBizUsages(ctx context.Context, in *ListBizUsagesReq, opts ...grpc.CallOption) (*ListBizUsagesResp, error)
+	// 获取业务概览，一次返回服务数、最近上线、失败的钩子、即将过期的证书、闲置服务和拉取最多的服务，供概览页使用
+	GetBizOverview(ctx context.Context, in *GetBizOverviewReq, opts ...grpc.CallOption) (*GetBizOverviewResp, error)
 	// 创建模板空间
 	CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateTemplateSpaceResp, error)
 	// 删除模板空间
@@ -473,17 +783,41 @@ type ConfigClient interface {
 	GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error)
 	// 分组选择器
 	ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error)
+	// 预览分组当前匹配的在线客户端
+	PreviewGroupClients(ctx context.Context, in *PreviewGroupClientsReq, opts ...grpc.CallOption) (*PreviewGroupClientsResp, error)
+	// 预览指定客户端当前将拉取的版本及配置内容，模拟 feed server 的上线策略匹配
+	PreviewClientRelease(ctx context.Context, in *PreviewClientReleaseReq, opts ...grpc.CallOption) (*client_preview.ClientReleasePreview, error)
 	// 发布服务版本
 	Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error)
 	// 生成新的服务版本并发布
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	// 一次调用提交配置并生成版本(可选直接上线), 任一步骤失败时编辑区恢复原状
+	BulkRelease(ctx context.Context, in *BulkReleaseReq, opts ...grpc.CallOption) (*BulkReleaseResp, error)
+	// 查询异步操作的进度、部分失败的错误和结果
+	GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*GetOperationResp, error)
+	// 获取业务下的异步操作列表
+	ListOperations(ctx context.Context, in *ListOperationsReq, opts ...grpc.CallOption) (*ListOperationsResp, error)
+	// 取消未完成的异步操作，已处理的条目不会回滚
+	CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*CancelOperationResp, error)
 	// 上线服务版本
 	SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error)
 	Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error)
+	// 紧急发布：将服务版本临时发布到指定的客户端，过期后自动回到主线版本
+	EmergencyPublish(ctx context.Context, in *EmergencyPublishReq, opts ...grpc.CallOption) (*EmergencyPublishResp, error)
+	// 查询紧急发布列表
+	ListEmergencyPublishes(ctx context.Context, in *ListEmergencyPublishesReq, opts ...grpc.CallOption) (*ListEmergencyPublishesResp, error)
+	// 提前撤销紧急发布，客户端回到主线版本
+	RevokeEmergencyPublish(ctx context.Context, in *RevokeEmergencyPublishReq, opts ...grpc.CallOption) (*RevokeEmergencyPublishResp, error)
+	// 固定分组的版本，后续上线（包括全量上线）跳过该分组，直到取消固定
+	PinReleasedGroup(ctx context.Context, in *PinReleasedGroupReq, opts ...grpc.CallOption) (*PinReleasedGroupResp, error)
+	// 取消固定分组的版本，分组保持当前版本直到下次上线
+	UnpinReleasedGroup(ctx context.Context, in *UnpinReleasedGroupReq, opts ...grpc.CallOption) (*UnpinReleasedGroupResp, error)
 	GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error)
 	GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error)
 	GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error)
 	ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error)
+	// 获取服务的变更时间线，合并编辑、生成版本、上线、审批、回滚和分组策略变更，用于历史页和对接外部变更管理系统
+	ListAppTimeline(ctx context.Context, in *ListAppTimelineReq, opts ...grpc.CallOption) (*ListAppTimelineResp, error)
 	// 创建客户端密钥
 	CreateCredentials(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateCredentialResp, error)
 	// 获取客户端密钥
@@ -498,6 +832,10 @@ type ConfigClient interface {
 	ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error)
 	// 更新客户端密钥关联服务规则
 	UpdateCredentialScope(ctx context.Context, in *UpdateCredentialScopeReq, opts ...grpc.CallOption) (*UpdateCredentialScopeResp, error)
+	// 暂停使用该客户端密钥的客户端拉取配置
+	PauseCredentialConsumption(ctx context.Context, in *PauseCredentialConsumptionReq, opts ...grpc.CallOption) (*PauseCredentialConsumptionResp, error)
+	// 恢复使用该客户端密钥的客户端拉取配置
+	ResumeCredentialConsumption(ctx context.Context, in *ResumeCredentialConsumptionReq, opts ...grpc.CallOption) (*ResumeCredentialConsumptionResp, error)
 	// 预览客户端密钥关联配置项
 	CredentialScopePreview(ctx context.Context, in *CredentialScopePreviewReq, opts ...grpc.CallOption) (*CredentialScopePreviewResp, error)
 	// 创建键值配置项
@@ -512,6 +850,12 @@ type ConfigClient interface {
 	BatchDeleteKv(ctx context.Context, in *BatchDeleteAppResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error)
 	// 批量创建或更新键值配置项
 	BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error)
+	// 在同一个事务中批量创建、更新和删除键值配置项，全部成功或全部失败
+	TransactKvs(ctx context.Context, in *TransactKvsReq, opts ...grpc.CallOption) (*TransactKvsResp, error)
+	// 获取键值配置项的历史版本
+	ListKvHistory(ctx context.Context, in *ListKvHistoryReq, opts ...grpc.CallOption) (*ListKvHistoryResp, error)
+	// 对比键值配置项两个版本的值
+	DiffKvVersions(ctx context.Context, in *DiffKvVersionsReq, opts ...grpc.CallOption) (*DiffKvVersionsResp, error)
 	// 恢复键值配置项
 	UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*UnDeleteKvResp, error)
 	// 批量恢复键值配置项
@@ -522,6 +866,8 @@ type ConfigClient interface {
 	ImportKvs(ctx context.Context, in *ImportKvsReq, opts ...grpc.CallOption) (*ImportKvsResp, error)
 	// 查找临近到期证书
 	FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error)
+	// 按类型语义比较kv当前值与待修改的值
+	CompareKvValue(ctx context.Context, in *CompareKvValueReq, opts ...grpc.CallOption) (*CompareKvValueResp, error)
 	// 获取客户端列表
 	ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error)
 	// 获取客户端拉取记录列表
@@ -588,6 +934,33 @@ func (c *configClient) UpdateApp(ctx context.Context, in *UpdateAppReq, opts ...
 	return out, nil
 }
 
+func (c *configClient) UpdateAppState(ctx context.Context, in *UpdateAppStateReq, opts ...grpc.CallOption) (*UpdateAppStateResp, error) {
+	out := new(UpdateAppStateResp)
+	err := c.cc.Invoke(ctx, Config_UpdateAppState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PauseAppConsumption(ctx context.Context, in *PauseAppConsumptionReq, opts ...grpc.CallOption) (*PauseAppConsumptionResp, error) {
+	out := new(PauseAppConsumptionResp)
+	err := c.cc.Invoke(ctx, Config_PauseAppConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ResumeAppConsumption(ctx context.Context, in *ResumeAppConsumptionReq, opts ...grpc.CallOption) (*ResumeAppConsumptionResp, error) {
+	out := new(ResumeAppConsumptionResp)
+	err := c.cc.Invoke(ctx, Config_ResumeAppConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *configClient) DeleteApp(ctx context.Context, in *DeleteAppReq, opts ...grpc.CallOption) (*DeleteAppResp, error) {
 	out := new(DeleteAppResp)
 	err := c.cc.Invoke(ctx, Config_DeleteApp_FullMethodName, in, out, opts...)
@@ -1011,1175 +1384,2189 @@ func (c *configClient) GetReleaseHook(ctx context.Context, in *GetReleaseHookReq
 	return out, nil
 }
 
-func (c *configClient) CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateTemplateSpaceResp, error) {
-	out := new(CreateTemplateSpaceResp)
-	err := c.cc.Invoke(ctx, Config_CreateTemplateSpace_FullMethodName, in, out, opts...)
+func (c *configClient) ListHookExecResults(ctx context.Context, in *ListHookExecResultsReq, opts ...grpc.CallOption) (*ListHookExecResultsResp, error) {
+	out := new(ListHookExecResultsResp)
+	err := c.cc.Invoke(ctx, Config_ListHookExecResults_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteTemplateSpace(ctx context.Context, in *DeleteTemplateSpaceReq, opts ...grpc.CallOption) (*DeleteTemplateSpaceResp, error) {
-	out := new(DeleteTemplateSpaceResp)
-	err := c.cc.Invoke(ctx, Config_DeleteTemplateSpace_FullMethodName, in, out, opts...)
+func (c *configClient) ListFileTamperEvents(ctx context.Context, in *ListFileTamperEventsReq, opts ...grpc.CallOption) (*ListFileTamperEventsResp, error) {
+	out := new(ListFileTamperEventsResp)
+	err := c.cc.Invoke(ctx, Config_ListFileTamperEvents_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateTemplateSpace(ctx context.Context, in *UpdateTemplateSpaceReq, opts ...grpc.CallOption) (*UpdateTemplateSpaceResp, error) {
-	out := new(UpdateTemplateSpaceResp)
-	err := c.cc.Invoke(ctx, Config_UpdateTemplateSpace_FullMethodName, in, out, opts...)
+func (c *configClient) CreateHookTemplate(ctx context.Context, in *CreateHookTemplateReq, opts ...grpc.CallOption) (*CreateHookTemplateResp, error) {
+	out := new(CreateHookTemplateResp)
+	err := c.cc.Invoke(ctx, Config_CreateHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error) {
-	out := new(ListTemplateSpacesResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateSpaces_FullMethodName, in, out, opts...)
+func (c *configClient) ListHookTemplates(ctx context.Context, in *ListHookTemplatesReq, opts ...grpc.CallOption) (*ListHookTemplatesResp, error) {
+	out := new(ListHookTemplatesResp)
+	err := c.cc.Invoke(ctx, Config_ListHookTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error) {
-	out := new(GetAllBizsOfTmplSpacesResp)
-	err := c.cc.Invoke(ctx, Config_GetAllBizsOfTmplSpaces_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateHookTemplate(ctx context.Context, in *UpdateHookTemplateReq, opts ...grpc.CallOption) (*UpdateHookTemplateResp, error) {
+	out := new(UpdateHookTemplateResp)
+	err := c.cc.Invoke(ctx, Config_UpdateHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateDefaultTmplSpace(ctx context.Context, in *CreateDefaultTmplSpaceReq, opts ...grpc.CallOption) (*CreateDefaultTmplSpaceResp, error) {
-	out := new(CreateDefaultTmplSpaceResp)
-	err := c.cc.Invoke(ctx, Config_CreateDefaultTmplSpace_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteHookTemplate(ctx context.Context, in *DeleteHookTemplateReq, opts ...grpc.CallOption) (*DeleteHookTemplateResp, error) {
+	out := new(DeleteHookTemplateResp)
+	err := c.cc.Invoke(ctx, Config_DeleteHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplSpacesByIDs(ctx context.Context, in *ListTmplSpacesByIDsReq, opts ...grpc.CallOption) (*ListTmplSpacesByIDsResp, error) {
-	out := new(ListTmplSpacesByIDsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplSpacesByIDs_FullMethodName, in, out, opts...)
+func (c *configClient) CreateHookFromTemplate(ctx context.Context, in *CreateHookFromTemplateReq, opts ...grpc.CallOption) (*CreateHookResp, error) {
+	out := new(CreateHookResp)
+	err := c.cc.Invoke(ctx, Config_CreateHookFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateTemplate(ctx context.Context, in *CreateTemplateReq, opts ...grpc.CallOption) (*CreateTemplateResp, error) {
-	out := new(CreateTemplateResp)
-	err := c.cc.Invoke(ctx, Config_CreateTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) UpgradeHookFromTemplate(ctx context.Context, in *UpgradeHookFromTemplateReq, opts ...grpc.CallOption) (*UpgradeHookFromTemplateResp, error) {
+	out := new(UpgradeHookFromTemplateResp)
+	err := c.cc.Invoke(ctx, Config_UpgradeHookFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateReq, opts ...grpc.CallOption) (*DeleteTemplateResp, error) {
-	out := new(DeleteTemplateResp)
-	err := c.cc.Invoke(ctx, Config_DeleteTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) UpgradeHooksFromTemplate(ctx context.Context, in *UpgradeHooksFromTemplateReq, opts ...grpc.CallOption) (*UpgradeHooksFromTemplateResp, error) {
+	out := new(UpgradeHooksFromTemplateResp)
+	err := c.cc.Invoke(ctx, Config_UpgradeHooksFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchDeleteTemplate(ctx context.Context, in *BatchDeleteTemplateReq, opts ...grpc.CallOption) (*BatchDeleteTemplateResp, error) {
-	out := new(BatchDeleteTemplateResp)
-	err := c.cc.Invoke(ctx, Config_BatchDeleteTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) GetHookTemplateRef(ctx context.Context, in *GetHookTemplateRefReq, opts ...grpc.CallOption) (*GetHookTemplateRefResp, error) {
+	out := new(GetHookTemplateRefResp)
+	err := c.cc.Invoke(ctx, Config_GetHookTemplateRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateReq, opts ...grpc.CallOption) (*UpdateTemplateResp, error) {
-	out := new(UpdateTemplateResp)
-	err := c.cc.Invoke(ctx, Config_UpdateTemplate_FullMethodName, in, out, opts...)
+func (c *configClient) CreateEnvironment(ctx context.Context, in *CreateEnvironmentReq, opts ...grpc.CallOption) (*CreateEnvironmentResp, error) {
+	out := new(CreateEnvironmentResp)
+	err := c.cc.Invoke(ctx, Config_CreateEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
-	out := new(ListTemplatesResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplates_FullMethodName, in, out, opts...)
+func (c *configClient) ListEnvironments(ctx context.Context, in *ListEnvironmentsReq, opts ...grpc.CallOption) (*ListEnvironmentsResp, error) {
+	out := new(ListEnvironmentsResp)
+	err := c.cc.Invoke(ctx, Config_ListEnvironments_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchUpsertTemplates(ctx context.Context, in *BatchUpsertTemplatesReq, opts ...grpc.CallOption) (*BatchUpsertTemplatesResp, error) {
-	out := new(BatchUpsertTemplatesResp)
-	err := c.cc.Invoke(ctx, Config_BatchUpsertTemplates_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentReq, opts ...grpc.CallOption) (*UpdateEnvironmentResp, error) {
+	out := new(UpdateEnvironmentResp)
+	err := c.cc.Invoke(ctx, Config_UpdateEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchUpdateTemplatePermissions(ctx context.Context, in *BatchUpdateTemplatePermissionsReq, opts ...grpc.CallOption) (*BatchUpdateTemplatePermissionsResp, error) {
-	out := new(BatchUpdateTemplatePermissionsResp)
-	err := c.cc.Invoke(ctx, Config_BatchUpdateTemplatePermissions_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentReq, opts ...grpc.CallOption) (*DeleteEnvironmentResp, error) {
+	out := new(DeleteEnvironmentResp)
+	err := c.cc.Invoke(ctx, Config_DeleteEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) AddTmplsToTmplSets(ctx context.Context, in *AddTmplsToTmplSetsReq, opts ...grpc.CallOption) (*AddTmplsToTmplSetsResp, error) {
-	out := new(AddTmplsToTmplSetsResp)
-	err := c.cc.Invoke(ctx, Config_AddTmplsToTmplSets_FullMethodName, in, out, opts...)
+func (c *configClient) BindEnvApp(ctx context.Context, in *BindEnvAppReq, opts ...grpc.CallOption) (*BindEnvAppResp, error) {
+	out := new(BindEnvAppResp)
+	err := c.cc.Invoke(ctx, Config_BindEnvApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteTmplsFromTmplSets(ctx context.Context, in *DeleteTmplsFromTmplSetsReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
-	out := new(BatchDeleteResp)
-	err := c.cc.Invoke(ctx, Config_DeleteTmplsFromTmplSets_FullMethodName, in, out, opts...)
+func (c *configClient) UnbindEnvApp(ctx context.Context, in *UnbindEnvAppReq, opts ...grpc.CallOption) (*UnbindEnvAppResp, error) {
+	out := new(UnbindEnvAppResp)
+	err := c.cc.Invoke(ctx, Config_UnbindEnvApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplatesByIDs(ctx context.Context, in *ListTemplatesByIDsReq, opts ...grpc.CallOption) (*ListTemplatesByIDsResp, error) {
-	out := new(ListTemplatesByIDsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplatesByIDs_FullMethodName, in, out, opts...)
+func (c *configClient) ListEnvApps(ctx context.Context, in *ListEnvAppsReq, opts ...grpc.CallOption) (*ListEnvAppsResp, error) {
+	out := new(ListEnvAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListEnvApps_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplatesNotBound(ctx context.Context, in *ListTemplatesNotBoundReq, opts ...grpc.CallOption) (*ListTemplatesNotBoundResp, error) {
-	out := new(ListTemplatesNotBoundResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplatesNotBound_FullMethodName, in, out, opts...)
+func (c *configClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Config_PromoteRelease_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateByTuple(ctx context.Context, in *ListTemplateByTupleReq, opts ...grpc.CallOption) (*ListTemplateByTupleResp, error) {
-	out := new(ListTemplateByTupleResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateByTuple_FullMethodName, in, out, opts...)
+func (c *configClient) CreateConfigShare(ctx context.Context, in *CreateConfigShareReq, opts ...grpc.CallOption) (*CreateConfigShareResp, error) {
+	out := new(CreateConfigShareResp)
+	err := c.cc.Invoke(ctx, Config_CreateConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplsOfTmplSet(ctx context.Context, in *ListTmplsOfTmplSetReq, opts ...grpc.CallOption) (*ListTmplsOfTmplSetResp, error) {
-	out := new(ListTmplsOfTmplSetResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplsOfTmplSet_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateConfigShare(ctx context.Context, in *UpdateConfigShareReq, opts ...grpc.CallOption) (*UpdateConfigShareResp, error) {
+	out := new(UpdateConfigShareResp)
+	err := c.cc.Invoke(ctx, Config_UpdateConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateSetsAndRevisions(ctx context.Context, in *ListTemplateSetsAndRevisionsReq, opts ...grpc.CallOption) (*ListTemplateSetsAndRevisionsResp, error) {
-	out := new(ListTemplateSetsAndRevisionsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateSetsAndRevisions_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteConfigShare(ctx context.Context, in *DeleteConfigShareReq, opts ...grpc.CallOption) (*DeleteConfigShareResp, error) {
+	out := new(DeleteConfigShareResp)
+	err := c.cc.Invoke(ctx, Config_DeleteConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateTemplateRevision(ctx context.Context, in *CreateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateTemplateRevisionResp, error) {
-	out := new(CreateTemplateRevisionResp)
-	err := c.cc.Invoke(ctx, Config_CreateTemplateRevision_FullMethodName, in, out, opts...)
+func (c *configClient) ListConfigShares(ctx context.Context, in *ListConfigSharesReq, opts ...grpc.CallOption) (*ListConfigSharesResp, error) {
+	out := new(ListConfigSharesResp)
+	err := c.cc.Invoke(ctx, Config_ListConfigShares_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateTemplateRevision(ctx context.Context, in *UpdateTemplateRevisionReq, opts ...grpc.CallOption) (*UpdateTemplateRevisionResp, error) {
-	out := new(UpdateTemplateRevisionResp)
-	err := c.cc.Invoke(ctx, Config_UpdateTemplateRevision_FullMethodName, in, out, opts...)
+func (c *configClient) ListSharedConfigs(ctx context.Context, in *ListSharedConfigsReq, opts ...grpc.CallOption) (*ListSharedConfigsResp, error) {
+	out := new(ListSharedConfigsResp)
+	err := c.cc.Invoke(ctx, Config_ListSharedConfigs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsResp, error) {
-	out := new(ListTemplateRevisionsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateRevisions_FullMethodName, in, out, opts...)
+func (c *configClient) CreateConfigShareRef(ctx context.Context, in *CreateConfigShareRefReq, opts ...grpc.CallOption) (*CreateConfigShareRefResp, error) {
+	out := new(CreateConfigShareRefResp)
+	err := c.cc.Invoke(ctx, Config_CreateConfigShareRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionReq, opts ...grpc.CallOption) (*GetTemplateRevisionResp, error) {
-	out := new(GetTemplateRevisionResp)
-	err := c.cc.Invoke(ctx, Config_GetTemplateRevision_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteConfigShareRef(ctx context.Context, in *DeleteConfigShareRefReq, opts ...grpc.CallOption) (*DeleteConfigShareRefResp, error) {
+	out := new(DeleteConfigShareRefResp)
+	err := c.cc.Invoke(ctx, Config_DeleteConfigShareRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateRevisionsByIDs(ctx context.Context, in *ListTemplateRevisionsByIDsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsByIDsResp, error) {
-	out := new(ListTemplateRevisionsByIDsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateRevisionsByIDs_FullMethodName, in, out, opts...)
+func (c *configClient) ListConfigShareRefs(ctx context.Context, in *ListConfigShareRefsReq, opts ...grpc.CallOption) (*ListConfigShareRefsResp, error) {
+	out := new(ListConfigShareRefsResp)
+	err := c.cc.Invoke(ctx, Config_ListConfigShareRefs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplRevisionNamesByTmplIDs(ctx context.Context, in *ListTmplRevisionNamesByTmplIDsReq, opts ...grpc.CallOption) (*ListTmplRevisionNamesByTmplIDsResp, error) {
-	out := new(ListTmplRevisionNamesByTmplIDsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplRevisionNamesByTmplIDs_FullMethodName, in, out, opts...)
+func (c *configClient) ListRecycleBin(ctx context.Context, in *ListRecycleBinReq, opts ...grpc.CallOption) (*ListRecycleBinResp, error) {
+	out := new(ListRecycleBinResp)
+	err := c.cc.Invoke(ctx, Config_ListRecycleBin_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateTemplateSet(ctx context.Context, in *CreateTemplateSetReq, opts ...grpc.CallOption) (*CreateTemplateSetResp, error) {
-	out := new(CreateTemplateSetResp)
-	err := c.cc.Invoke(ctx, Config_CreateTemplateSet_FullMethodName, in, out, opts...)
+func (c *configClient) RestoreRecycleBin(ctx context.Context, in *RestoreRecycleBinReq, opts ...grpc.CallOption) (*RestoreRecycleBinResp, error) {
+	out := new(RestoreRecycleBinResp)
+	err := c.cc.Invoke(ctx, Config_RestoreRecycleBin_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteTemplateSet(ctx context.Context, in *DeleteTemplateSetReq, opts ...grpc.CallOption) (*DeleteTemplateSetResp, error) {
-	out := new(DeleteTemplateSetResp)
-	err := c.cc.Invoke(ctx, Config_DeleteTemplateSet_FullMethodName, in, out, opts...)
+func (c *configClient) CreateAppSnapshot(ctx context.Context, in *CreateAppSnapshotReq, opts ...grpc.CallOption) (*CreateAppSnapshotResp, error) {
+	out := new(CreateAppSnapshotResp)
+	err := c.cc.Invoke(ctx, Config_CreateAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateTemplateSet(ctx context.Context, in *UpdateTemplateSetReq, opts ...grpc.CallOption) (*UpdateTemplateSetResp, error) {
-	out := new(UpdateTemplateSetResp)
-	err := c.cc.Invoke(ctx, Config_UpdateTemplateSet_FullMethodName, in, out, opts...)
+func (c *configClient) ListAppSnapshots(ctx context.Context, in *ListAppSnapshotsReq, opts ...grpc.CallOption) (*ListAppSnapshotsResp, error) {
+	out := new(ListAppSnapshotsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppSnapshots_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateSets(ctx context.Context, in *ListTemplateSetsReq, opts ...grpc.CallOption) (*ListTemplateSetsResp, error) {
-	out := new(ListTemplateSetsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateSets_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteAppSnapshot(ctx context.Context, in *DeleteAppSnapshotReq, opts ...grpc.CallOption) (*DeleteAppSnapshotResp, error) {
+	out := new(DeleteAppSnapshotResp)
+	err := c.cc.Invoke(ctx, Config_DeleteAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetLatestTemplateVersionsInSpace(ctx context.Context, in *GetLatestTemplateVersionsInSpaceReq, opts ...grpc.CallOption) (*GetLatestTemplateVersionsInSpaceResp, error) {
-	out := new(GetLatestTemplateVersionsInSpaceResp)
-	err := c.cc.Invoke(ctx, Config_GetLatestTemplateVersionsInSpace_FullMethodName, in, out, opts...)
+func (c *configClient) RestoreAppSnapshot(ctx context.Context, in *RestoreAppSnapshotReq, opts ...grpc.CallOption) (*RestoreAppSnapshotResp, error) {
+	out := new(RestoreAppSnapshotResp)
+	err := c.cc.Invoke(ctx, Config_RestoreAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAppTemplateSets(ctx context.Context, in *ListAppTemplateSetsReq, opts ...grpc.CallOption) (*ListAppTemplateSetsResp, error) {
-	out := new(ListAppTemplateSetsResp)
-	err := c.cc.Invoke(ctx, Config_ListAppTemplateSets_FullMethodName, in, out, opts...)
+func (c *configClient) CloneApp(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CloneAppResp, error) {
+	out := new(CloneAppResp)
+	err := c.cc.Invoke(ctx, Config_CloneApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateSetsByIDs(ctx context.Context, in *ListTemplateSetsByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetsByIDsResp, error) {
-	out := new(ListTemplateSetsByIDsResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateSetsByIDs_FullMethodName, in, out, opts...)
+func (c *configClient) UpsertDraftItem(ctx context.Context, in *UpsertDraftItemReq, opts ...grpc.CallOption) (*UpsertDraftItemResp, error) {
+	out := new(UpsertDraftItemResp)
+	err := c.cc.Invoke(ctx, Config_UpsertDraftItem_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplSetsOfBiz(ctx context.Context, in *ListTmplSetsOfBizReq, opts ...grpc.CallOption) (*ListTmplSetsOfBizResp, error) {
-	out := new(ListTmplSetsOfBizResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplSetsOfBiz_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteDraftItem(ctx context.Context, in *DeleteDraftItemReq, opts ...grpc.CallOption) (*DeleteDraftItemResp, error) {
+	out := new(DeleteDraftItemResp)
+	err := c.cc.Invoke(ctx, Config_DeleteDraftItem_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateAppTemplateBinding(ctx context.Context, in *CreateAppTemplateBindingReq, opts ...grpc.CallOption) (*CreateAppTemplateBindingResp, error) {
-	out := new(CreateAppTemplateBindingResp)
-	err := c.cc.Invoke(ctx, Config_CreateAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *configClient) GetDraft(ctx context.Context, in *GetDraftReq, opts ...grpc.CallOption) (*GetDraftResp, error) {
+	out := new(GetDraftResp)
+	err := c.cc.Invoke(ctx, Config_GetDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteAppTemplateBinding(ctx context.Context, in *DeleteAppTemplateBindingReq, opts ...grpc.CallOption) (*DeleteAppTemplateBindingResp, error) {
-	out := new(DeleteAppTemplateBindingResp)
-	err := c.cc.Invoke(ctx, Config_DeleteAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *configClient) MergeDraft(ctx context.Context, in *MergeDraftReq, opts ...grpc.CallOption) (*MergeDraftResp, error) {
+	out := new(MergeDraftResp)
+	err := c.cc.Invoke(ctx, Config_MergeDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateAppTemplateBinding(ctx context.Context, in *UpdateAppTemplateBindingReq, opts ...grpc.CallOption) (*UpdateAppTemplateBindingResp, error) {
-	out := new(UpdateAppTemplateBindingResp)
-	err := c.cc.Invoke(ctx, Config_UpdateAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *configClient) DiscardDraft(ctx context.Context, in *DiscardDraftReq, opts ...grpc.CallOption) (*DiscardDraftResp, error) {
+	out := new(DiscardDraftResp)
+	err := c.cc.Invoke(ctx, Config_DiscardDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAppTemplateBindings(ctx context.Context, in *ListAppTemplateBindingsReq, opts ...grpc.CallOption) (*ListAppTemplateBindingsResp, error) {
-	out := new(ListAppTemplateBindingsResp)
-	err := c.cc.Invoke(ctx, Config_ListAppTemplateBindings_FullMethodName, in, out, opts...)
+func (c *configClient) SearchFullText(ctx context.Context, in *SearchFullTextReq, opts ...grpc.CallOption) (*SearchFullTextResp, error) {
+	out := new(SearchFullTextResp)
+	err := c.cc.Invoke(ctx, Config_SearchFullText_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAppBoundTmplRevisions(ctx context.Context, in *ListAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListAppBoundTmplRevisionsResp, error) {
-	out := new(ListAppBoundTmplRevisionsResp)
-	err := c.cc.Invoke(ctx, Config_ListAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplWhereUsed(ctx context.Context, in *ListTmplWhereUsedReq, opts ...grpc.CallOption) (*ListTmplWhereUsedResp, error) {
+	out := new(ListTmplWhereUsedResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplWhereUsed_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListReleasedAppBoundTmplRevisions(ctx context.Context, in *ListReleasedAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListReleasedAppBoundTmplRevisionsResp, error) {
-	out := new(ListReleasedAppBoundTmplRevisionsResp)
-	err := c.cc.Invoke(ctx, Config_ListReleasedAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplVariableWhereUsed(ctx context.Context, in *ListTmplVariableWhereUsedReq, opts ...grpc.CallOption) (*ListTmplVariableWhereUsedResp, error) {
+	out := new(ListTmplVariableWhereUsedResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplVariableWhereUsed_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetReleasedAppBoundTmplRevision(ctx context.Context, in *GetReleasedAppBoundTmplRevisionReq, opts ...grpc.CallOption) (*GetReleasedAppBoundTmplRevisionResp, error) {
-	out := new(GetReleasedAppBoundTmplRevisionResp)
-	err := c.cc.Invoke(ctx, Config_GetReleasedAppBoundTmplRevision_FullMethodName, in, out, opts...)
+func (c *configClient) CreateConfigReference(ctx context.Context, in *CreateConfigReferenceReq, opts ...grpc.CallOption) (*CreateConfigReferenceResp, error) {
+	out := new(CreateConfigReferenceResp)
+	err := c.cc.Invoke(ctx, Config_CreateConfigReference_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateAppBoundTmplRevisions(ctx context.Context, in *UpdateAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*UpdateAppBoundTmplRevisionsResp, error) {
-	out := new(UpdateAppBoundTmplRevisionsResp)
-	err := c.cc.Invoke(ctx, Config_UpdateAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteConfigReference(ctx context.Context, in *DeleteConfigReferenceReq, opts ...grpc.CallOption) (*DeleteConfigReferenceResp, error) {
+	out := new(DeleteConfigReferenceResp)
+	err := c.cc.Invoke(ctx, Config_DeleteConfigReference_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteAppBoundTmplSets(ctx context.Context, in *DeleteAppBoundTmplSetsReq, opts ...grpc.CallOption) (*DeleteAppBoundTmplSetsResp, error) {
-	out := new(DeleteAppBoundTmplSetsResp)
-	err := c.cc.Invoke(ctx, Config_DeleteAppBoundTmplSets_FullMethodName, in, out, opts...)
+func (c *configClient) GetConfigRefGraph(ctx context.Context, in *GetConfigRefGraphReq, opts ...grpc.CallOption) (*GetConfigRefGraphResp, error) {
+	out := new(GetConfigRefGraphResp)
+	err := c.cc.Invoke(ctx, Config_GetConfigRefGraph_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) RemoveAppBoundTmplSet(ctx context.Context, in *RemoveAppBoundTmplSetReq, opts ...grpc.CallOption) (*RemoveAppBoundTmplSetResp, error) {
-	out := new(RemoveAppBoundTmplSetResp)
-	err := c.cc.Invoke(ctx, Config_RemoveAppBoundTmplSet_FullMethodName, in, out, opts...)
+func (c *configClient) ListExpiringCertificates(ctx context.Context, in *ListExpiringCertificatesReq, opts ...grpc.CallOption) (*ListExpiringCertificatesResp, error) {
+	out := new(ListExpiringCertificatesResp)
+	err := c.cc.Invoke(ctx, Config_ListExpiringCertificates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CheckAppTemplateBinding(ctx context.Context, in *CheckAppTemplateBindingReq, opts ...grpc.CallOption) (*CheckAppTemplateBindingResp, error) {
-	out := new(CheckAppTemplateBindingResp)
-	err := c.cc.Invoke(ctx, Config_CheckAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *configClient) RenewCertificate(ctx context.Context, in *RenewCertificateReq, opts ...grpc.CallOption) (*RenewCertificateResp, error) {
+	out := new(RenewCertificateResp)
+	err := c.cc.Invoke(ctx, Config_RenewCertificate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ImportFromTemplateSetToApp(ctx context.Context, in *ImportFromTemplateSetToAppReq, opts ...grpc.CallOption) (*ImportFromTemplateSetToAppResp, error) {
-	out := new(ImportFromTemplateSetToAppResp)
-	err := c.cc.Invoke(ctx, Config_ImportFromTemplateSetToApp_FullMethodName, in, out, opts...)
+func (c *configClient) CreateKvOverride(ctx context.Context, in *CreateKvOverrideReq, opts ...grpc.CallOption) (*CreateKvOverrideResp, error) {
+	out := new(CreateKvOverrideResp)
+	err := c.cc.Invoke(ctx, Config_CreateKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error) {
-	out := new(ListTmplBoundCountsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplBoundCounts_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateKvOverride(ctx context.Context, in *UpdateKvOverrideReq, opts ...grpc.CallOption) (*UpdateKvOverrideResp, error) {
+	out := new(UpdateKvOverrideResp)
+	err := c.cc.Invoke(ctx, Config_UpdateKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplRevisionBoundCounts(ctx context.Context, in *ListTmplRevisionBoundCountsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundCountsResp, error) {
-	out := new(ListTmplRevisionBoundCountsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundCounts_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteKvOverride(ctx context.Context, in *DeleteKvOverrideReq, opts ...grpc.CallOption) (*DeleteKvOverrideResp, error) {
+	out := new(DeleteKvOverrideResp)
+	err := c.cc.Invoke(ctx, Config_DeleteKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplSetBoundCounts(ctx context.Context, in *ListTmplSetBoundCountsReq, opts ...grpc.CallOption) (*ListTmplSetBoundCountsResp, error) {
-	out := new(ListTmplSetBoundCountsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundCounts_FullMethodName, in, out, opts...)
+func (c *configClient) ListKvOverrides(ctx context.Context, in *ListKvOverridesReq, opts ...grpc.CallOption) (*ListKvOverridesResp, error) {
+	out := new(ListKvOverridesResp)
+	err := c.cc.Invoke(ctx, Config_ListKvOverrides_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplBoundUnnamedApps(ctx context.Context, in *ListTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundUnnamedAppsResp, error) {
-	out := new(ListTmplBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) CreateCompliancePolicy(ctx context.Context, in *CreateCompliancePolicyReq, opts ...grpc.CallOption) (*CreateCompliancePolicyResp, error) {
+	out := new(CreateCompliancePolicyResp)
+	err := c.cc.Invoke(ctx, Config_CreateCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplBoundNamedApps(ctx context.Context, in *ListTmplBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundNamedAppsResp, error) {
-	out := new(ListTmplBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) ListCompliancePolicies(ctx context.Context, in *ListCompliancePoliciesReq, opts ...grpc.CallOption) (*ListCompliancePoliciesResp, error) {
+	out := new(ListCompliancePoliciesResp)
+	err := c.cc.Invoke(ctx, Config_ListCompliancePolicies_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplBoundTmplSets(ctx context.Context, in *ListTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListTmplBoundTmplSetsResp, error) {
-	out := new(ListTmplBoundTmplSetsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplBoundTmplSets_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateCompliancePolicy(ctx context.Context, in *UpdateCompliancePolicyReq, opts ...grpc.CallOption) (*UpdateCompliancePolicyResp, error) {
+	out := new(UpdateCompliancePolicyResp)
+	err := c.cc.Invoke(ctx, Config_UpdateCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListMultiTmplBoundTmplSets(ctx context.Context, in *ListMultiTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListMultiTmplBoundTmplSetsResp, error) {
-	out := new(ListMultiTmplBoundTmplSetsResp)
-	err := c.cc.Invoke(ctx, Config_ListMultiTmplBoundTmplSets_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyReq, opts ...grpc.CallOption) (*DeleteCompliancePolicyResp, error) {
+	out := new(DeleteCompliancePolicyResp)
+	err := c.cc.Invoke(ctx, Config_DeleteCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplRevisionBoundUnnamedApps(ctx context.Context, in *ListTmplRevisionBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
-	out := new(ListTmplRevisionBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) TestCompliancePolicy(ctx context.Context, in *TestCompliancePolicyReq, opts ...grpc.CallOption) (*TestCompliancePolicyResp, error) {
+	out := new(TestCompliancePolicyResp)
+	err := c.cc.Invoke(ctx, Config_TestCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplRevisionBoundNamedApps(ctx context.Context, in *ListTmplRevisionBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundNamedAppsResp, error) {
-	out := new(ListTmplRevisionBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelReq, opts ...grpc.CallOption) (*CreateNotificationChannelResp, error) {
+	out := new(CreateNotificationChannelResp)
+	err := c.cc.Invoke(ctx, Config_CreateNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplSetBoundUnnamedApps(ctx context.Context, in *ListTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundUnnamedAppsResp, error) {
-	out := new(ListTmplSetBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsReq, opts ...grpc.CallOption) (*ListNotificationChannelsResp, error) {
+	out := new(ListNotificationChannelsResp)
+	err := c.cc.Invoke(ctx, Config_ListNotificationChannels_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListMultiTmplSetBoundUnnamedApps(ctx context.Context, in *ListMultiTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
-	out := new(ListMultiTmplSetBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListMultiTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelReq, opts ...grpc.CallOption) (*UpdateNotificationChannelResp, error) {
+	out := new(UpdateNotificationChannelResp)
+	err := c.cc.Invoke(ctx, Config_UpdateNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CheckTemplateSetReferencesApps(ctx context.Context, in *CheckTemplateSetReferencesAppsReq, opts ...grpc.CallOption) (*CheckTemplateSetReferencesAppsResp, error) {
-	out := new(CheckTemplateSetReferencesAppsResp)
-	err := c.cc.Invoke(ctx, Config_CheckTemplateSetReferencesApps_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelReq, opts ...grpc.CallOption) (*DeleteNotificationChannelResp, error) {
+	out := new(DeleteNotificationChannelResp)
+	err := c.cc.Invoke(ctx, Config_DeleteNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTmplSetBoundNamedApps(ctx context.Context, in *ListTmplSetBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundNamedAppsResp, error) {
-	out := new(ListTmplSetBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) TestNotificationChannel(ctx context.Context, in *TestNotificationChannelReq, opts ...grpc.CallOption) (*TestNotificationChannelResp, error) {
+	out := new(TestNotificationChannelResp)
+	err := c.cc.Invoke(ctx, Config_TestNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListLatestTmplBoundUnnamedApps(ctx context.Context, in *ListLatestTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListLatestTmplBoundUnnamedAppsResp, error) {
-	out := new(ListLatestTmplBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListLatestTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *configClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleReq, opts ...grpc.CallOption) (*CreateAlertRuleResp, error) {
+	out := new(CreateAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_CreateAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateTemplateVariable(ctx context.Context, in *CreateTemplateVariableReq, opts ...grpc.CallOption) (*CreateTemplateVariableResp, error) {
-	out := new(CreateTemplateVariableResp)
-	err := c.cc.Invoke(ctx, Config_CreateTemplateVariable_FullMethodName, in, out, opts...)
+func (c *configClient) ListAlertRules(ctx context.Context, in *ListAlertRulesReq, opts ...grpc.CallOption) (*ListAlertRulesResp, error) {
+	out := new(ListAlertRulesResp)
+	err := c.cc.Invoke(ctx, Config_ListAlertRules_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteTemplateVariable(ctx context.Context, in *DeleteTemplateVariableReq, opts ...grpc.CallOption) (*DeleteTemplateVariableResp, error) {
-	out := new(DeleteTemplateVariableResp)
-	err := c.cc.Invoke(ctx, Config_DeleteTemplateVariable_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleReq, opts ...grpc.CallOption) (*UpdateAlertRuleResp, error) {
+	out := new(UpdateAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_UpdateAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchDeleteTemplateVariable(ctx context.Context, in *BatchDeleteBizResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
-	out := new(BatchDeleteResp)
-	err := c.cc.Invoke(ctx, Config_BatchDeleteTemplateVariable_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleReq, opts ...grpc.CallOption) (*DeleteAlertRuleResp, error) {
+	out := new(DeleteAlertRuleResp)
+	err := c.cc.Invoke(ctx, Config_DeleteAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateTemplateVariable(ctx context.Context, in *UpdateTemplateVariableReq, opts ...grpc.CallOption) (*UpdateTemplateVariableResp, error) {
-	out := new(UpdateTemplateVariableResp)
-	err := c.cc.Invoke(ctx, Config_UpdateTemplateVariable_FullMethodName, in, out, opts...)
+func (c *configClient) CreateClientQuarantine(ctx context.Context, in *CreateClientQuarantineReq, opts ...grpc.CallOption) (*CreateClientQuarantineResp, error) {
+	out := new(CreateClientQuarantineResp)
+	err := c.cc.Invoke(ctx, Config_CreateClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListTemplateVariables(ctx context.Context, in *ListTemplateVariablesReq, opts ...grpc.CallOption) (*ListTemplateVariablesResp, error) {
-	out := new(ListTemplateVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ListTemplateVariables_FullMethodName, in, out, opts...)
+func (c *configClient) ListClientQuarantines(ctx context.Context, in *ListClientQuarantinesReq, opts ...grpc.CallOption) (*ListClientQuarantinesResp, error) {
+	out := new(ListClientQuarantinesResp)
+	err := c.cc.Invoke(ctx, Config_ListClientQuarantines_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ImportTemplateVariables(ctx context.Context, in *ImportTemplateVariablesReq, opts ...grpc.CallOption) (*ImportTemplateVariablesResp, error) {
-	out := new(ImportTemplateVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ImportTemplateVariables_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateClientQuarantine(ctx context.Context, in *UpdateClientQuarantineReq, opts ...grpc.CallOption) (*UpdateClientQuarantineResp, error) {
+	out := new(UpdateClientQuarantineResp)
+	err := c.cc.Invoke(ctx, Config_UpdateClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ImportOtherFormatTemplateVariables(ctx context.Context, in *ImportOtherFormatTemplateVariablesReq, opts ...grpc.CallOption) (*ImportOtherFormatTemplateVariablesResp, error) {
-	out := new(ImportOtherFormatTemplateVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ImportOtherFormatTemplateVariables_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteClientQuarantine(ctx context.Context, in *DeleteClientQuarantineReq, opts ...grpc.CallOption) (*DeleteClientQuarantineResp, error) {
+	out := new(DeleteClientQuarantineResp)
+	err := c.cc.Invoke(ctx, Config_DeleteClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ExtractAppTmplVariables(ctx context.Context, in *ExtractAppTmplVariablesReq, opts ...grpc.CallOption) (*ExtractAppTmplVariablesResp, error) {
-	out := new(ExtractAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ExtractAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *configClient) CreateExperiment(ctx context.Context, in *CreateExperimentReq, opts ...grpc.CallOption) (*CreateExperimentResp, error) {
+	out := new(CreateExperimentResp)
+	err := c.cc.Invoke(ctx, Config_CreateExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetAppTmplVariableRefs(ctx context.Context, in *GetAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetAppTmplVariableRefsResp, error) {
-	out := new(GetAppTmplVariableRefsResp)
-	err := c.cc.Invoke(ctx, Config_GetAppTmplVariableRefs_FullMethodName, in, out, opts...)
+func (c *configClient) ListExperiments(ctx context.Context, in *ListExperimentsReq, opts ...grpc.CallOption) (*ListExperimentsResp, error) {
+	out := new(ListExperimentsResp)
+	err := c.cc.Invoke(ctx, Config_ListExperiments_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetReleasedAppTmplVariableRefs(ctx context.Context, in *GetReleasedAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetReleasedAppTmplVariableRefsResp, error) {
-	out := new(GetReleasedAppTmplVariableRefsResp)
-	err := c.cc.Invoke(ctx, Config_GetReleasedAppTmplVariableRefs_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateExperiment(ctx context.Context, in *UpdateExperimentReq, opts ...grpc.CallOption) (*UpdateExperimentResp, error) {
+	out := new(UpdateExperimentResp)
+	err := c.cc.Invoke(ctx, Config_UpdateExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateAppTmplVariables(ctx context.Context, in *UpdateAppTmplVariablesReq, opts ...grpc.CallOption) (*UpdateAppTmplVariablesResp, error) {
-	out := new(UpdateAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Config_UpdateAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteExperiment(ctx context.Context, in *DeleteExperimentReq, opts ...grpc.CallOption) (*DeleteExperimentResp, error) {
+	out := new(DeleteExperimentResp)
+	err := c.cc.Invoke(ctx, Config_DeleteExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error) {
-	out := new(ListAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ListAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *configClient) GetExperimentStats(ctx context.Context, in *GetExperimentStatsReq, opts ...grpc.CallOption) (*GetExperimentStatsResp, error) {
+	out := new(GetExperimentStatsResp)
+	err := c.cc.Invoke(ctx, Config_GetExperimentStats_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error) {
-	out := new(ListReleasedAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Config_ListReleasedAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *configClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*CreateServiceAccountResp, error) {
+	out := new(CreateServiceAccountResp)
+	err := c.cc.Invoke(ctx, Config_CreateServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
-	out := new(CreateGroupResp)
-	err := c.cc.Invoke(ctx, Config_CreateGroup_FullMethodName, in, out, opts...)
+func (c *configClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsReq, opts ...grpc.CallOption) (*ListServiceAccountsResp, error) {
+	out := new(ListServiceAccountsResp)
+	err := c.cc.Invoke(ctx, Config_ListServiceAccounts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteGroup(ctx context.Context, in *DeleteGroupReq, opts ...grpc.CallOption) (*DeleteGroupResp, error) {
-	out := new(DeleteGroupResp)
-	err := c.cc.Invoke(ctx, Config_DeleteGroup_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountReq, opts ...grpc.CallOption) (*UpdateServiceAccountResp, error) {
+	out := new(UpdateServiceAccountResp)
+	err := c.cc.Invoke(ctx, Config_UpdateServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchDeleteGroups(ctx context.Context, in *BatchDeleteBizResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
-	out := new(BatchDeleteResp)
-	err := c.cc.Invoke(ctx, Config_BatchDeleteGroups_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountReq, opts ...grpc.CallOption) (*DeleteServiceAccountResp, error) {
+	out := new(DeleteServiceAccountResp)
+	err := c.cc.Invoke(ctx, Config_DeleteServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateGroup(ctx context.Context, in *UpdateGroupReq, opts ...grpc.CallOption) (*UpdateGroupResp, error) {
-	out := new(UpdateGroupResp)
-	err := c.cc.Invoke(ctx, Config_UpdateGroup_FullMethodName, in, out, opts...)
+func (c *configClient) ResetServiceAccountKey(ctx context.Context, in *ResetServiceAccountKeyReq, opts ...grpc.CallOption) (*ResetServiceAccountKeyResp, error) {
+	out := new(ResetServiceAccountKeyResp)
+	err := c.cc.Invoke(ctx, Config_ResetServiceAccountKey_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAllGroups(ctx context.Context, in *ListAllGroupsReq, opts ...grpc.CallOption) (*ListAllGroupsResp, error) {
-	out := new(ListAllGroupsResp)
-	err := c.cc.Invoke(ctx, Config_ListAllGroups_FullMethodName, in, out, opts...)
+func (c *configClient) SetAppRoleBinding(ctx context.Context, in *SetAppRoleBindingReq, opts ...grpc.CallOption) (*SetAppRoleBindingResp, error) {
+	out := new(SetAppRoleBindingResp)
+	err := c.cc.Invoke(ctx, Config_SetAppRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAppGroups(ctx context.Context, in *ListAppGroupsReq, opts ...grpc.CallOption) (*ListAppGroupsResp, error) {
-	out := new(ListAppGroupsResp)
-	err := c.cc.Invoke(ctx, Config_ListAppGroups_FullMethodName, in, out, opts...)
+func (c *configClient) GrantTemporaryAppRole(ctx context.Context, in *GrantTemporaryAppRoleReq, opts ...grpc.CallOption) (*GrantTemporaryAppRoleResp, error) {
+	out := new(GrantTemporaryAppRoleResp)
+	err := c.cc.Invoke(ctx, Config_GrantTemporaryAppRole_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListGroupReleasedApps(ctx context.Context, in *ListGroupReleasedAppsReq, opts ...grpc.CallOption) (*ListGroupReleasedAppsResp, error) {
-	out := new(ListGroupReleasedAppsResp)
-	err := c.cc.Invoke(ctx, Config_ListGroupReleasedApps_FullMethodName, in, out, opts...)
+func (c *configClient) ListAppRoleBindings(ctx context.Context, in *ListAppRoleBindingsReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error) {
+	out := new(ListAppRoleBindingsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppRoleBindings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error) {
-	out := new(group.Group)
-	err := c.cc.Invoke(ctx, Config_GetGroupByName_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteAppRoleBinding(ctx context.Context, in *DeleteAppRoleBindingReq, opts ...grpc.CallOption) (*DeleteAppRoleBindingResp, error) {
+	out := new(DeleteAppRoleBindingResp)
+	err := c.cc.Invoke(ctx, Config_DeleteAppRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error) {
-	out := new(ListGroupSelectorResp)
-	err := c.cc.Invoke(ctx, Config_ListGroupSelector_FullMethodName, in, out, opts...)
+func (c *configClient) CheckAppPermissions(ctx context.Context, in *CheckAppPermissionsReq, opts ...grpc.CallOption) (*CheckAppPermissionsResp, error) {
+	out := new(CheckAppPermissionsResp)
+	err := c.cc.Invoke(ctx, Config_CheckAppPermissions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Config_Publish_FullMethodName, in, out, opts...)
+func (c *configClient) GetMaskingProfile(ctx context.Context, in *GetMaskingProfileReq, opts ...grpc.CallOption) (*GetMaskingProfileResp, error) {
+	out := new(GetMaskingProfileResp)
+	err := c.cc.Invoke(ctx, Config_GetMaskingProfile_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Config_GenerateReleaseAndPublish_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateMaskingProfile(ctx context.Context, in *UpdateMaskingProfileReq, opts ...grpc.CallOption) (*UpdateMaskingProfileResp, error) {
+	out := new(UpdateMaskingProfileResp)
+	err := c.cc.Invoke(ctx, Config_UpdateMaskingProfile_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Config_SubmitPublishApprove_FullMethodName, in, out, opts...)
+func (c *configClient) PreviewMasking(ctx context.Context, in *PreviewMaskingReq, opts ...grpc.CallOption) (*PreviewMaskingResp, error) {
+	out := new(PreviewMaskingResp)
+	err := c.cc.Invoke(ctx, Config_PreviewMasking_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error) {
-	out := new(ApproveResp)
-	err := c.cc.Invoke(ctx, Config_Approve_FullMethodName, in, out, opts...)
+func (c *configClient) GetSecretScanSetting(ctx context.Context, in *GetSecretScanSettingReq, opts ...grpc.CallOption) (*GetSecretScanSettingResp, error) {
+	out := new(GetSecretScanSettingResp)
+	err := c.cc.Invoke(ctx, Config_GetSecretScanSetting_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error) {
-	out := new(GetLastSelectResp)
-	err := c.cc.Invoke(ctx, Config_GetLastSelect_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateSecretScanSetting(ctx context.Context, in *UpdateSecretScanSettingReq, opts ...grpc.CallOption) (*UpdateSecretScanSettingResp, error) {
+	out := new(UpdateSecretScanSettingResp)
+	err := c.cc.Invoke(ctx, Config_UpdateSecretScanSetting_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error) {
-	out := new(GetLastPublishResp)
-	err := c.cc.Invoke(ctx, Config_GetLastPublish_FullMethodName, in, out, opts...)
+func (c *configClient) ScanSecretLeak(ctx context.Context, in *ScanSecretLeakReq, opts ...grpc.CallOption) (*ScanSecretLeakResp, error) {
+	out := new(ScanSecretLeakResp)
+	err := c.cc.Invoke(ctx, Config_ScanSecretLeak_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error) {
-	out := new(strategy.Strategy)
-	err := c.cc.Invoke(ctx, Config_GetReleasesStatus_FullMethodName, in, out, opts...)
+func (c *configClient) GetAppConsumption(ctx context.Context, in *GetAppConsumptionReq, opts ...grpc.CallOption) (*GetAppConsumptionResp, error) {
+	out := new(GetAppConsumptionResp)
+	err := c.cc.Invoke(ctx, Config_GetAppConsumption_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error) {
-	out := new(ListAuditsResp)
-	err := c.cc.Invoke(ctx, Config_ListAudits_FullMethodName, in, out, opts...)
+func (c *configClient) ListTopConsumingApps(ctx context.Context, in *ListTopConsumingAppsReq, opts ...grpc.CallOption) (*ListTopConsumingAppsResp, error) {
+	out := new(ListTopConsumingAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTopConsumingApps_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateCredentials(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateCredentialResp, error) {
-	out := new(CreateCredentialResp)
-	err := c.cc.Invoke(ctx, Config_CreateCredentials_FullMethodName, in, out, opts...)
+func (c *configClient) ListStaleResources(ctx context.Context, in *ListStaleResourcesReq, opts ...grpc.CallOption) (*ListStaleResourcesResp, error) {
+	out := new(ListStaleResourcesResp)
+	err := c.cc.Invoke(ctx, Config_ListStaleResources_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListCredentials(ctx context.Context, in *ListCredentialsReq, opts ...grpc.CallOption) (*ListCredentialsResp, error) {
-	out := new(ListCredentialsResp)
-	err := c.cc.Invoke(ctx, Config_ListCredentials_FullMethodName, in, out, opts...)
+func (c *configClient) ListBizUsages(ctx context.Context, in *ListBizUsagesReq, opts ...grpc.CallOption) (*ListBizUsagesResp, error) {
+	out := new(ListBizUsagesResp)
+	err := c.cc.Invoke(ctx, Config_ListBizUsages_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteCredential(ctx context.Context, in *DeleteCredentialsReq, opts ...grpc.CallOption) (*DeleteCredentialsResp, error) {
-	out := new(DeleteCredentialsResp)
-	err := c.cc.Invoke(ctx, Config_DeleteCredential_FullMethodName, in, out, opts...)
+func (c *configClient) GetBizOverview(ctx context.Context, in *GetBizOverviewReq, opts ...grpc.CallOption) (*GetBizOverviewResp, error) {
+	out := new(GetBizOverviewResp)
+	err := c.cc.Invoke(ctx, Config_GetBizOverview_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateCredential(ctx context.Context, in *UpdateCredentialsReq, opts ...grpc.CallOption) (*UpdateCredentialsResp, error) {
-	out := new(UpdateCredentialsResp)
-	err := c.cc.Invoke(ctx, Config_UpdateCredential_FullMethodName, in, out, opts...)
+func (c *configClient) CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateTemplateSpaceResp, error) {
+	out := new(CreateTemplateSpaceResp)
+	err := c.cc.Invoke(ctx, Config_CreateTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error) {
-	out := new(CheckCredentialNameResp)
-	err := c.cc.Invoke(ctx, Config_CheckCredentialName_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteTemplateSpace(ctx context.Context, in *DeleteTemplateSpaceReq, opts ...grpc.CallOption) (*DeleteTemplateSpaceResp, error) {
+	out := new(DeleteTemplateSpaceResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error) {
-	out := new(ListCredentialScopesResp)
-	err := c.cc.Invoke(ctx, Config_ListCredentialScopes_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateTemplateSpace(ctx context.Context, in *UpdateTemplateSpaceReq, opts ...grpc.CallOption) (*UpdateTemplateSpaceResp, error) {
+	out := new(UpdateTemplateSpaceResp)
+	err := c.cc.Invoke(ctx, Config_UpdateTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateCredentialScope(ctx context.Context, in *UpdateCredentialScopeReq, opts ...grpc.CallOption) (*UpdateCredentialScopeResp, error) {
-	out := new(UpdateCredentialScopeResp)
-	err := c.cc.Invoke(ctx, Config_UpdateCredentialScope_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error) {
+	out := new(ListTemplateSpacesResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateSpaces_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CredentialScopePreview(ctx context.Context, in *CredentialScopePreviewReq, opts ...grpc.CallOption) (*CredentialScopePreviewResp, error) {
-	out := new(CredentialScopePreviewResp)
-	err := c.cc.Invoke(ctx, Config_CredentialScopePreview_FullMethodName, in, out, opts...)
+func (c *configClient) GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error) {
+	out := new(GetAllBizsOfTmplSpacesResp)
+	err := c.cc.Invoke(ctx, Config_GetAllBizsOfTmplSpaces_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateKv(ctx context.Context, in *CreateKvReq, opts ...grpc.CallOption) (*CreateKvResp, error) {
-	out := new(CreateKvResp)
-	err := c.cc.Invoke(ctx, Config_CreateKv_FullMethodName, in, out, opts...)
+func (c *configClient) CreateDefaultTmplSpace(ctx context.Context, in *CreateDefaultTmplSpaceReq, opts ...grpc.CallOption) (*CreateDefaultTmplSpaceResp, error) {
+	out := new(CreateDefaultTmplSpaceResp)
+	err := c.cc.Invoke(ctx, Config_CreateDefaultTmplSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateKv(ctx context.Context, in *UpdateKvReq, opts ...grpc.CallOption) (*UpdateKvResp, error) {
-	out := new(UpdateKvResp)
-	err := c.cc.Invoke(ctx, Config_UpdateKv_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplSpacesByIDs(ctx context.Context, in *ListTmplSpacesByIDsReq, opts ...grpc.CallOption) (*ListTmplSpacesByIDsResp, error) {
+	out := new(ListTmplSpacesByIDsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplSpacesByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListKvs(ctx context.Context, in *ListKvsReq, opts ...grpc.CallOption) (*ListKvsResp, error) {
-	out := new(ListKvsResp)
-	err := c.cc.Invoke(ctx, Config_ListKvs_FullMethodName, in, out, opts...)
+func (c *configClient) CreateTemplate(ctx context.Context, in *CreateTemplateReq, opts ...grpc.CallOption) (*CreateTemplateResp, error) {
+	out := new(CreateTemplateResp)
+	err := c.cc.Invoke(ctx, Config_CreateTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteKv(ctx context.Context, in *DeleteKvReq, opts ...grpc.CallOption) (*DeleteKvResp, error) {
-	out := new(DeleteKvResp)
-	err := c.cc.Invoke(ctx, Config_DeleteKv_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateReq, opts ...grpc.CallOption) (*DeleteTemplateResp, error) {
+	out := new(DeleteTemplateResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchDeleteKv(ctx context.Context, in *BatchDeleteAppResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
-	out := new(BatchDeleteResp)
-	err := c.cc.Invoke(ctx, Config_BatchDeleteKv_FullMethodName, in, out, opts...)
+func (c *configClient) BatchDeleteTemplate(ctx context.Context, in *BatchDeleteTemplateReq, opts ...grpc.CallOption) (*BatchDeleteTemplateResp, error) {
+	out := new(BatchDeleteTemplateResp)
+	err := c.cc.Invoke(ctx, Config_BatchDeleteTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error) {
-	out := new(BatchUpsertKvsResp)
-	err := c.cc.Invoke(ctx, Config_BatchUpsertKvs_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateReq, opts ...grpc.CallOption) (*UpdateTemplateResp, error) {
+	out := new(UpdateTemplateResp)
+	err := c.cc.Invoke(ctx, Config_UpdateTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*UnDeleteKvResp, error) {
-	out := new(UnDeleteKvResp)
-	err := c.cc.Invoke(ctx, Config_UnDeleteKv_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
+	out := new(ListTemplatesResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) BatchUnDeleteKv(ctx context.Context, in *BatchUnDeleteKvReq, opts ...grpc.CallOption) (*BatchUnDeleteKvResp, error) {
-	out := new(BatchUnDeleteKvResp)
-	err := c.cc.Invoke(ctx, Config_BatchUnDeleteKv_FullMethodName, in, out, opts...)
+func (c *configClient) BatchUpsertTemplates(ctx context.Context, in *BatchUpsertTemplatesReq, opts ...grpc.CallOption) (*BatchUpsertTemplatesResp, error) {
+	out := new(BatchUpsertTemplatesResp)
+	err := c.cc.Invoke(ctx, Config_BatchUpsertTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*UndoKvResp, error) {
-	out := new(UndoKvResp)
-	err := c.cc.Invoke(ctx, Config_UndoKv_FullMethodName, in, out, opts...)
+func (c *configClient) BatchUpdateTemplatePermissions(ctx context.Context, in *BatchUpdateTemplatePermissionsReq, opts ...grpc.CallOption) (*BatchUpdateTemplatePermissionsResp, error) {
+	out := new(BatchUpdateTemplatePermissionsResp)
+	err := c.cc.Invoke(ctx, Config_BatchUpdateTemplatePermissions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ImportKvs(ctx context.Context, in *ImportKvsReq, opts ...grpc.CallOption) (*ImportKvsResp, error) {
-	out := new(ImportKvsResp)
-	err := c.cc.Invoke(ctx, Config_ImportKvs_FullMethodName, in, out, opts...)
+func (c *configClient) AddTmplsToTmplSets(ctx context.Context, in *AddTmplsToTmplSetsReq, opts ...grpc.CallOption) (*AddTmplsToTmplSetsResp, error) {
+	out := new(AddTmplsToTmplSetsResp)
+	err := c.cc.Invoke(ctx, Config_AddTmplsToTmplSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error) {
-	out := new(FindNearExpiryCertKvsResp)
-	err := c.cc.Invoke(ctx, Config_FindNearExpiryCertKvs_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteTmplsFromTmplSets(ctx context.Context, in *DeleteTmplsFromTmplSetsReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
+	out := new(BatchDeleteResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTmplsFromTmplSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error) {
-	out := new(ListClientsResp)
-	err := c.cc.Invoke(ctx, Config_ListClients_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplatesByIDs(ctx context.Context, in *ListTemplatesByIDsReq, opts ...grpc.CallOption) (*ListTemplatesByIDsResp, error) {
+	out := new(ListTemplatesByIDsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplatesByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListClientEvents(ctx context.Context, in *ListClientEventsReq, opts ...grpc.CallOption) (*ListClientEventsResp, error) {
-	out := new(ListClientEventsResp)
-	err := c.cc.Invoke(ctx, Config_ListClientEvents_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplatesNotBound(ctx context.Context, in *ListTemplatesNotBoundReq, opts ...grpc.CallOption) (*ListTemplatesNotBoundResp, error) {
+	out := new(ListTemplatesNotBoundResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplatesNotBound_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) RetryClients(ctx context.Context, in *RetryClientsReq, opts ...grpc.CallOption) (*RetryClientsResp, error) {
-	out := new(RetryClientsResp)
-	err := c.cc.Invoke(ctx, Config_RetryClients_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateByTuple(ctx context.Context, in *ListTemplateByTupleReq, opts ...grpc.CallOption) (*ListTemplateByTupleResp, error) {
+	out := new(ListTemplateByTupleResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateByTuple_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListClientQuerys(ctx context.Context, in *ListClientQuerysReq, opts ...grpc.CallOption) (*ListClientQuerysResp, error) {
-	out := new(ListClientQuerysResp)
-	err := c.cc.Invoke(ctx, Config_ListClientQuerys_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplsOfTmplSet(ctx context.Context, in *ListTmplsOfTmplSetReq, opts ...grpc.CallOption) (*ListTmplsOfTmplSetResp, error) {
+	out := new(ListTmplsOfTmplSetResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplsOfTmplSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CreateClientQuery(ctx context.Context, in *CreateClientQueryReq, opts ...grpc.CallOption) (*CreateClientQueryResp, error) {
-	out := new(CreateClientQueryResp)
-	err := c.cc.Invoke(ctx, Config_CreateClientQuery_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateSetsAndRevisions(ctx context.Context, in *ListTemplateSetsAndRevisionsReq, opts ...grpc.CallOption) (*ListTemplateSetsAndRevisionsResp, error) {
+	out := new(ListTemplateSetsAndRevisionsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateSetsAndRevisions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) UpdateClientQuery(ctx context.Context, in *UpdateClientQueryReq, opts ...grpc.CallOption) (*UpdateClientQueryResp, error) {
-	out := new(UpdateClientQueryResp)
-	err := c.cc.Invoke(ctx, Config_UpdateClientQuery_FullMethodName, in, out, opts...)
+func (c *configClient) CreateTemplateRevision(ctx context.Context, in *CreateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateTemplateRevisionResp, error) {
+	out := new(CreateTemplateRevisionResp)
+	err := c.cc.Invoke(ctx, Config_CreateTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) DeleteClientQuery(ctx context.Context, in *DeleteClientQueryReq, opts ...grpc.CallOption) (*DeleteClientQueryResp, error) {
-	out := new(DeleteClientQueryResp)
-	err := c.cc.Invoke(ctx, Config_DeleteClientQuery_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateTemplateRevision(ctx context.Context, in *UpdateTemplateRevisionReq, opts ...grpc.CallOption) (*UpdateTemplateRevisionResp, error) {
+	out := new(UpdateTemplateRevisionResp)
+	err := c.cc.Invoke(ctx, Config_UpdateTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CheckClientQueryName(ctx context.Context, in *CheckClientQueryNameReq, opts ...grpc.CallOption) (*CheckClientQueryNameResp, error) {
-	out := new(CheckClientQueryNameResp)
-	err := c.cc.Invoke(ctx, Config_CheckClientQueryName_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsResp, error) {
+	out := new(ListTemplateRevisionsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateRevisions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientConfigVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientConfigVersionStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionReq, opts ...grpc.CallOption) (*GetTemplateRevisionResp, error) {
+	out := new(GetTemplateRevisionResp)
+	err := c.cc.Invoke(ctx, Config_GetTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientPullTrendStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientPullTrendStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateRevisionsByIDs(ctx context.Context, in *ListTemplateRevisionsByIDsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsByIDsResp, error) {
+	out := new(ListTemplateRevisionsByIDsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateRevisionsByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientPullStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientPullStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplRevisionNamesByTmplIDs(ctx context.Context, in *ListTmplRevisionNamesByTmplIDsReq, opts ...grpc.CallOption) (*ListTmplRevisionNamesByTmplIDsResp, error) {
+	out := new(ListTmplRevisionNamesByTmplIDsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplRevisionNamesByTmplIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientLabelStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientLabelStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) CreateTemplateSet(ctx context.Context, in *CreateTemplateSetReq, opts ...grpc.CallOption) (*CreateTemplateSetResp, error) {
+	out := new(CreateTemplateSetResp)
+	err := c.cc.Invoke(ctx, Config_CreateTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientAnnotationStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientAnnotationStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) DeleteTemplateSet(ctx context.Context, in *DeleteTemplateSetReq, opts ...grpc.CallOption) (*DeleteTemplateSetResp, error) {
+	out := new(DeleteTemplateSetResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientVersionStatistics_FullMethodName, in, out, opts...)
+func (c *configClient) UpdateTemplateSet(ctx context.Context, in *UpdateTemplateSetReq, opts ...grpc.CallOption) (*UpdateTemplateSetResp, error) {
+	out := new(UpdateTemplateSetResp)
+	err := c.cc.Invoke(ctx, Config_UpdateTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ListClientLabelAndAnnotation_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateSets(ctx context.Context, in *ListTemplateSetsReq, opts ...grpc.CallOption) (*ListTemplateSetsResp, error) {
+	out := new(ListTemplateSetsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Config_ClientSpecificFailedReason_FullMethodName, in, out, opts...)
+func (c *configClient) GetLatestTemplateVersionsInSpace(ctx context.Context, in *GetLatestTemplateVersionsInSpaceReq, opts ...grpc.CallOption) (*GetLatestTemplateVersionsInSpaceResp, error) {
+	out := new(GetLatestTemplateVersionsInSpaceResp)
+	err := c.cc.Invoke(ctx, Config_GetLatestTemplateVersionsInSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
-	out := new(CompareConfigItemConflictsResp)
-	err := c.cc.Invoke(ctx, Config_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+func (c *configClient) ListAppTemplateSets(ctx context.Context, in *ListAppTemplateSetsReq, opts ...grpc.CallOption) (*ListAppTemplateSetsResp, error) {
+	out := new(ListAppTemplateSetsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppTemplateSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) CompareKvConflicts(ctx context.Context, in *CompareKvConflictsReq, opts ...grpc.CallOption) (*CompareKvConflictsResp, error) {
-	out := new(CompareKvConflictsResp)
-	err := c.cc.Invoke(ctx, Config_CompareKvConflicts_FullMethodName, in, out, opts...)
+func (c *configClient) ListTemplateSetsByIDs(ctx context.Context, in *ListTemplateSetsByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetsByIDsResp, error) {
+	out := new(ListTemplateSetsByIDsResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateSetsByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *configClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
-	out := new(GetTemplateAndNonTemplateCICountResp)
-	err := c.cc.Invoke(ctx, Config_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+func (c *configClient) ListTmplSetsOfBiz(ctx context.Context, in *ListTmplSetsOfBizReq, opts ...grpc.CallOption) (*ListTmplSetsOfBizResp, error) {
+	out := new(ListTmplSetsOfBizResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplSetsOfBiz_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// ConfigServer is the server API for Config service.
+func (c *configClient) CreateAppTemplateBinding(ctx context.Context, in *CreateAppTemplateBindingReq, opts ...grpc.CallOption) (*CreateAppTemplateBindingResp, error) {
+	out := new(CreateAppTemplateBindingResp)
+	err := c.cc.Invoke(ctx, Config_CreateAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteAppTemplateBinding(ctx context.Context, in *DeleteAppTemplateBindingReq, opts ...grpc.CallOption) (*DeleteAppTemplateBindingResp, error) {
+	out := new(DeleteAppTemplateBindingResp)
+	err := c.cc.Invoke(ctx, Config_DeleteAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateAppTemplateBinding(ctx context.Context, in *UpdateAppTemplateBindingReq, opts ...grpc.CallOption) (*UpdateAppTemplateBindingResp, error) {
+	out := new(UpdateAppTemplateBindingResp)
+	err := c.cc.Invoke(ctx, Config_UpdateAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAppTemplateBindings(ctx context.Context, in *ListAppTemplateBindingsReq, opts ...grpc.CallOption) (*ListAppTemplateBindingsResp, error) {
+	out := new(ListAppTemplateBindingsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppTemplateBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAppBoundTmplRevisions(ctx context.Context, in *ListAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListAppBoundTmplRevisionsResp, error) {
+	out := new(ListAppBoundTmplRevisionsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListReleasedAppBoundTmplRevisions(ctx context.Context, in *ListReleasedAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListReleasedAppBoundTmplRevisionsResp, error) {
+	out := new(ListReleasedAppBoundTmplRevisionsResp)
+	err := c.cc.Invoke(ctx, Config_ListReleasedAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetReleasedAppBoundTmplRevision(ctx context.Context, in *GetReleasedAppBoundTmplRevisionReq, opts ...grpc.CallOption) (*GetReleasedAppBoundTmplRevisionResp, error) {
+	out := new(GetReleasedAppBoundTmplRevisionResp)
+	err := c.cc.Invoke(ctx, Config_GetReleasedAppBoundTmplRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateAppBoundTmplRevisions(ctx context.Context, in *UpdateAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*UpdateAppBoundTmplRevisionsResp, error) {
+	out := new(UpdateAppBoundTmplRevisionsResp)
+	err := c.cc.Invoke(ctx, Config_UpdateAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteAppBoundTmplSets(ctx context.Context, in *DeleteAppBoundTmplSetsReq, opts ...grpc.CallOption) (*DeleteAppBoundTmplSetsResp, error) {
+	out := new(DeleteAppBoundTmplSetsResp)
+	err := c.cc.Invoke(ctx, Config_DeleteAppBoundTmplSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RemoveAppBoundTmplSet(ctx context.Context, in *RemoveAppBoundTmplSetReq, opts ...grpc.CallOption) (*RemoveAppBoundTmplSetResp, error) {
+	out := new(RemoveAppBoundTmplSetResp)
+	err := c.cc.Invoke(ctx, Config_RemoveAppBoundTmplSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CheckAppTemplateBinding(ctx context.Context, in *CheckAppTemplateBindingReq, opts ...grpc.CallOption) (*CheckAppTemplateBindingResp, error) {
+	out := new(CheckAppTemplateBindingResp)
+	err := c.cc.Invoke(ctx, Config_CheckAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ImportFromTemplateSetToApp(ctx context.Context, in *ImportFromTemplateSetToAppReq, opts ...grpc.CallOption) (*ImportFromTemplateSetToAppResp, error) {
+	out := new(ImportFromTemplateSetToAppResp)
+	err := c.cc.Invoke(ctx, Config_ImportFromTemplateSetToApp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error) {
+	out := new(ListTmplBoundCountsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplRevisionBoundCounts(ctx context.Context, in *ListTmplRevisionBoundCountsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundCountsResp, error) {
+	out := new(ListTmplRevisionBoundCountsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplSetBoundCounts(ctx context.Context, in *ListTmplSetBoundCountsReq, opts ...grpc.CallOption) (*ListTmplSetBoundCountsResp, error) {
+	out := new(ListTmplSetBoundCountsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplBoundUnnamedApps(ctx context.Context, in *ListTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundUnnamedAppsResp, error) {
+	out := new(ListTmplBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplBoundNamedApps(ctx context.Context, in *ListTmplBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundNamedAppsResp, error) {
+	out := new(ListTmplBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplBoundTmplSets(ctx context.Context, in *ListTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListTmplBoundTmplSetsResp, error) {
+	out := new(ListTmplBoundTmplSetsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplBoundTmplSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListMultiTmplBoundTmplSets(ctx context.Context, in *ListMultiTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListMultiTmplBoundTmplSetsResp, error) {
+	out := new(ListMultiTmplBoundTmplSetsResp)
+	err := c.cc.Invoke(ctx, Config_ListMultiTmplBoundTmplSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplRevisionBoundUnnamedApps(ctx context.Context, in *ListTmplRevisionBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
+	out := new(ListTmplRevisionBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplRevisionBoundNamedApps(ctx context.Context, in *ListTmplRevisionBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundNamedAppsResp, error) {
+	out := new(ListTmplRevisionBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplRevisionBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplSetBoundUnnamedApps(ctx context.Context, in *ListTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundUnnamedAppsResp, error) {
+	out := new(ListTmplSetBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListMultiTmplSetBoundUnnamedApps(ctx context.Context, in *ListMultiTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
+	out := new(ListMultiTmplSetBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListMultiTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CheckTemplateSetReferencesApps(ctx context.Context, in *CheckTemplateSetReferencesAppsReq, opts ...grpc.CallOption) (*CheckTemplateSetReferencesAppsResp, error) {
+	out := new(CheckTemplateSetReferencesAppsResp)
+	err := c.cc.Invoke(ctx, Config_CheckTemplateSetReferencesApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTmplSetBoundNamedApps(ctx context.Context, in *ListTmplSetBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundNamedAppsResp, error) {
+	out := new(ListTmplSetBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListTmplSetBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListLatestTmplBoundUnnamedApps(ctx context.Context, in *ListLatestTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListLatestTmplBoundUnnamedAppsResp, error) {
+	out := new(ListLatestTmplBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListLatestTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateTemplateVariable(ctx context.Context, in *CreateTemplateVariableReq, opts ...grpc.CallOption) (*CreateTemplateVariableResp, error) {
+	out := new(CreateTemplateVariableResp)
+	err := c.cc.Invoke(ctx, Config_CreateTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteTemplateVariable(ctx context.Context, in *DeleteTemplateVariableReq, opts ...grpc.CallOption) (*DeleteTemplateVariableResp, error) {
+	out := new(DeleteTemplateVariableResp)
+	err := c.cc.Invoke(ctx, Config_DeleteTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BatchDeleteTemplateVariable(ctx context.Context, in *BatchDeleteBizResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
+	out := new(BatchDeleteResp)
+	err := c.cc.Invoke(ctx, Config_BatchDeleteTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateTemplateVariable(ctx context.Context, in *UpdateTemplateVariableReq, opts ...grpc.CallOption) (*UpdateTemplateVariableResp, error) {
+	out := new(UpdateTemplateVariableResp)
+	err := c.cc.Invoke(ctx, Config_UpdateTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListTemplateVariables(ctx context.Context, in *ListTemplateVariablesReq, opts ...grpc.CallOption) (*ListTemplateVariablesResp, error) {
+	out := new(ListTemplateVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ListTemplateVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ImportTemplateVariables(ctx context.Context, in *ImportTemplateVariablesReq, opts ...grpc.CallOption) (*ImportTemplateVariablesResp, error) {
+	out := new(ImportTemplateVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ImportTemplateVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ImportOtherFormatTemplateVariables(ctx context.Context, in *ImportOtherFormatTemplateVariablesReq, opts ...grpc.CallOption) (*ImportOtherFormatTemplateVariablesResp, error) {
+	out := new(ImportOtherFormatTemplateVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ImportOtherFormatTemplateVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ExtractAppTmplVariables(ctx context.Context, in *ExtractAppTmplVariablesReq, opts ...grpc.CallOption) (*ExtractAppTmplVariablesResp, error) {
+	out := new(ExtractAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ExtractAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetAppTmplVariableRefs(ctx context.Context, in *GetAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetAppTmplVariableRefsResp, error) {
+	out := new(GetAppTmplVariableRefsResp)
+	err := c.cc.Invoke(ctx, Config_GetAppTmplVariableRefs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetReleasedAppTmplVariableRefs(ctx context.Context, in *GetReleasedAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetReleasedAppTmplVariableRefsResp, error) {
+	out := new(GetReleasedAppTmplVariableRefsResp)
+	err := c.cc.Invoke(ctx, Config_GetReleasedAppTmplVariableRefs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateAppTmplVariables(ctx context.Context, in *UpdateAppTmplVariablesReq, opts ...grpc.CallOption) (*UpdateAppTmplVariablesResp, error) {
+	out := new(UpdateAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_UpdateAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error) {
+	out := new(ListAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ListAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error) {
+	out := new(ListReleasedAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Config_ListReleasedAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateGroupResp, error) {
+	out := new(CreateGroupResp)
+	err := c.cc.Invoke(ctx, Config_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteGroup(ctx context.Context, in *DeleteGroupReq, opts ...grpc.CallOption) (*DeleteGroupResp, error) {
+	out := new(DeleteGroupResp)
+	err := c.cc.Invoke(ctx, Config_DeleteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BatchDeleteGroups(ctx context.Context, in *BatchDeleteBizResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
+	out := new(BatchDeleteResp)
+	err := c.cc.Invoke(ctx, Config_BatchDeleteGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateGroup(ctx context.Context, in *UpdateGroupReq, opts ...grpc.CallOption) (*UpdateGroupResp, error) {
+	out := new(UpdateGroupResp)
+	err := c.cc.Invoke(ctx, Config_UpdateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAllGroups(ctx context.Context, in *ListAllGroupsReq, opts ...grpc.CallOption) (*ListAllGroupsResp, error) {
+	out := new(ListAllGroupsResp)
+	err := c.cc.Invoke(ctx, Config_ListAllGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAppGroups(ctx context.Context, in *ListAppGroupsReq, opts ...grpc.CallOption) (*ListAppGroupsResp, error) {
+	out := new(ListAppGroupsResp)
+	err := c.cc.Invoke(ctx, Config_ListAppGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListGroupReleasedApps(ctx context.Context, in *ListGroupReleasedAppsReq, opts ...grpc.CallOption) (*ListGroupReleasedAppsResp, error) {
+	out := new(ListGroupReleasedAppsResp)
+	err := c.cc.Invoke(ctx, Config_ListGroupReleasedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error) {
+	out := new(group.Group)
+	err := c.cc.Invoke(ctx, Config_GetGroupByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error) {
+	out := new(ListGroupSelectorResp)
+	err := c.cc.Invoke(ctx, Config_ListGroupSelector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PreviewGroupClients(ctx context.Context, in *PreviewGroupClientsReq, opts ...grpc.CallOption) (*PreviewGroupClientsResp, error) {
+	out := new(PreviewGroupClientsResp)
+	err := c.cc.Invoke(ctx, Config_PreviewGroupClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PreviewClientRelease(ctx context.Context, in *PreviewClientReleaseReq, opts ...grpc.CallOption) (*client_preview.ClientReleasePreview, error) {
+	out := new(client_preview.ClientReleasePreview)
+	err := c.cc.Invoke(ctx, Config_PreviewClientRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Config_Publish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Config_GenerateReleaseAndPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BulkRelease(ctx context.Context, in *BulkReleaseReq, opts ...grpc.CallOption) (*BulkReleaseResp, error) {
+	out := new(BulkReleaseResp)
+	err := c.cc.Invoke(ctx, Config_BulkRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*GetOperationResp, error) {
+	out := new(GetOperationResp)
+	err := c.cc.Invoke(ctx, Config_GetOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListOperations(ctx context.Context, in *ListOperationsReq, opts ...grpc.CallOption) (*ListOperationsResp, error) {
+	out := new(ListOperationsResp)
+	err := c.cc.Invoke(ctx, Config_ListOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*CancelOperationResp, error) {
+	out := new(CancelOperationResp)
+	err := c.cc.Invoke(ctx, Config_CancelOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Config_SubmitPublishApprove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error) {
+	out := new(ApproveResp)
+	err := c.cc.Invoke(ctx, Config_Approve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) EmergencyPublish(ctx context.Context, in *EmergencyPublishReq, opts ...grpc.CallOption) (*EmergencyPublishResp, error) {
+	out := new(EmergencyPublishResp)
+	err := c.cc.Invoke(ctx, Config_EmergencyPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListEmergencyPublishes(ctx context.Context, in *ListEmergencyPublishesReq, opts ...grpc.CallOption) (*ListEmergencyPublishesResp, error) {
+	out := new(ListEmergencyPublishesResp)
+	err := c.cc.Invoke(ctx, Config_ListEmergencyPublishes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RevokeEmergencyPublish(ctx context.Context, in *RevokeEmergencyPublishReq, opts ...grpc.CallOption) (*RevokeEmergencyPublishResp, error) {
+	out := new(RevokeEmergencyPublishResp)
+	err := c.cc.Invoke(ctx, Config_RevokeEmergencyPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PinReleasedGroup(ctx context.Context, in *PinReleasedGroupReq, opts ...grpc.CallOption) (*PinReleasedGroupResp, error) {
+	out := new(PinReleasedGroupResp)
+	err := c.cc.Invoke(ctx, Config_PinReleasedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UnpinReleasedGroup(ctx context.Context, in *UnpinReleasedGroupReq, opts ...grpc.CallOption) (*UnpinReleasedGroupResp, error) {
+	out := new(UnpinReleasedGroupResp)
+	err := c.cc.Invoke(ctx, Config_UnpinReleasedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error) {
+	out := new(GetLastSelectResp)
+	err := c.cc.Invoke(ctx, Config_GetLastSelect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error) {
+	out := new(GetLastPublishResp)
+	err := c.cc.Invoke(ctx, Config_GetLastPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error) {
+	out := new(strategy.Strategy)
+	err := c.cc.Invoke(ctx, Config_GetReleasesStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error) {
+	out := new(ListAuditsResp)
+	err := c.cc.Invoke(ctx, Config_ListAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListAppTimeline(ctx context.Context, in *ListAppTimelineReq, opts ...grpc.CallOption) (*ListAppTimelineResp, error) {
+	out := new(ListAppTimelineResp)
+	err := c.cc.Invoke(ctx, Config_ListAppTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateCredentials(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateCredentialResp, error) {
+	out := new(CreateCredentialResp)
+	err := c.cc.Invoke(ctx, Config_CreateCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListCredentials(ctx context.Context, in *ListCredentialsReq, opts ...grpc.CallOption) (*ListCredentialsResp, error) {
+	out := new(ListCredentialsResp)
+	err := c.cc.Invoke(ctx, Config_ListCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteCredential(ctx context.Context, in *DeleteCredentialsReq, opts ...grpc.CallOption) (*DeleteCredentialsResp, error) {
+	out := new(DeleteCredentialsResp)
+	err := c.cc.Invoke(ctx, Config_DeleteCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateCredential(ctx context.Context, in *UpdateCredentialsReq, opts ...grpc.CallOption) (*UpdateCredentialsResp, error) {
+	out := new(UpdateCredentialsResp)
+	err := c.cc.Invoke(ctx, Config_UpdateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error) {
+	out := new(CheckCredentialNameResp)
+	err := c.cc.Invoke(ctx, Config_CheckCredentialName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error) {
+	out := new(ListCredentialScopesResp)
+	err := c.cc.Invoke(ctx, Config_ListCredentialScopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateCredentialScope(ctx context.Context, in *UpdateCredentialScopeReq, opts ...grpc.CallOption) (*UpdateCredentialScopeResp, error) {
+	out := new(UpdateCredentialScopeResp)
+	err := c.cc.Invoke(ctx, Config_UpdateCredentialScope_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) PauseCredentialConsumption(ctx context.Context, in *PauseCredentialConsumptionReq, opts ...grpc.CallOption) (*PauseCredentialConsumptionResp, error) {
+	out := new(PauseCredentialConsumptionResp)
+	err := c.cc.Invoke(ctx, Config_PauseCredentialConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ResumeCredentialConsumption(ctx context.Context, in *ResumeCredentialConsumptionReq, opts ...grpc.CallOption) (*ResumeCredentialConsumptionResp, error) {
+	out := new(ResumeCredentialConsumptionResp)
+	err := c.cc.Invoke(ctx, Config_ResumeCredentialConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CredentialScopePreview(ctx context.Context, in *CredentialScopePreviewReq, opts ...grpc.CallOption) (*CredentialScopePreviewResp, error) {
+	out := new(CredentialScopePreviewResp)
+	err := c.cc.Invoke(ctx, Config_CredentialScopePreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateKv(ctx context.Context, in *CreateKvReq, opts ...grpc.CallOption) (*CreateKvResp, error) {
+	out := new(CreateKvResp)
+	err := c.cc.Invoke(ctx, Config_CreateKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateKv(ctx context.Context, in *UpdateKvReq, opts ...grpc.CallOption) (*UpdateKvResp, error) {
+	out := new(UpdateKvResp)
+	err := c.cc.Invoke(ctx, Config_UpdateKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListKvs(ctx context.Context, in *ListKvsReq, opts ...grpc.CallOption) (*ListKvsResp, error) {
+	out := new(ListKvsResp)
+	err := c.cc.Invoke(ctx, Config_ListKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteKv(ctx context.Context, in *DeleteKvReq, opts ...grpc.CallOption) (*DeleteKvResp, error) {
+	out := new(DeleteKvResp)
+	err := c.cc.Invoke(ctx, Config_DeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BatchDeleteKv(ctx context.Context, in *BatchDeleteAppResourcesReq, opts ...grpc.CallOption) (*BatchDeleteResp, error) {
+	out := new(BatchDeleteResp)
+	err := c.cc.Invoke(ctx, Config_BatchDeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error) {
+	out := new(BatchUpsertKvsResp)
+	err := c.cc.Invoke(ctx, Config_BatchUpsertKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) TransactKvs(ctx context.Context, in *TransactKvsReq, opts ...grpc.CallOption) (*TransactKvsResp, error) {
+	out := new(TransactKvsResp)
+	err := c.cc.Invoke(ctx, Config_TransactKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListKvHistory(ctx context.Context, in *ListKvHistoryReq, opts ...grpc.CallOption) (*ListKvHistoryResp, error) {
+	out := new(ListKvHistoryResp)
+	err := c.cc.Invoke(ctx, Config_ListKvHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DiffKvVersions(ctx context.Context, in *DiffKvVersionsReq, opts ...grpc.CallOption) (*DiffKvVersionsResp, error) {
+	out := new(DiffKvVersionsResp)
+	err := c.cc.Invoke(ctx, Config_DiffKvVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*UnDeleteKvResp, error) {
+	out := new(UnDeleteKvResp)
+	err := c.cc.Invoke(ctx, Config_UnDeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) BatchUnDeleteKv(ctx context.Context, in *BatchUnDeleteKvReq, opts ...grpc.CallOption) (*BatchUnDeleteKvResp, error) {
+	out := new(BatchUnDeleteKvResp)
+	err := c.cc.Invoke(ctx, Config_BatchUnDeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*UndoKvResp, error) {
+	out := new(UndoKvResp)
+	err := c.cc.Invoke(ctx, Config_UndoKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ImportKvs(ctx context.Context, in *ImportKvsReq, opts ...grpc.CallOption) (*ImportKvsResp, error) {
+	out := new(ImportKvsResp)
+	err := c.cc.Invoke(ctx, Config_ImportKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error) {
+	out := new(FindNearExpiryCertKvsResp)
+	err := c.cc.Invoke(ctx, Config_FindNearExpiryCertKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CompareKvValue(ctx context.Context, in *CompareKvValueReq, opts ...grpc.CallOption) (*CompareKvValueResp, error) {
+	out := new(CompareKvValueResp)
+	err := c.cc.Invoke(ctx, Config_CompareKvValue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error) {
+	out := new(ListClientsResp)
+	err := c.cc.Invoke(ctx, Config_ListClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListClientEvents(ctx context.Context, in *ListClientEventsReq, opts ...grpc.CallOption) (*ListClientEventsResp, error) {
+	out := new(ListClientEventsResp)
+	err := c.cc.Invoke(ctx, Config_ListClientEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) RetryClients(ctx context.Context, in *RetryClientsReq, opts ...grpc.CallOption) (*RetryClientsResp, error) {
+	out := new(RetryClientsResp)
+	err := c.cc.Invoke(ctx, Config_RetryClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListClientQuerys(ctx context.Context, in *ListClientQuerysReq, opts ...grpc.CallOption) (*ListClientQuerysResp, error) {
+	out := new(ListClientQuerysResp)
+	err := c.cc.Invoke(ctx, Config_ListClientQuerys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CreateClientQuery(ctx context.Context, in *CreateClientQueryReq, opts ...grpc.CallOption) (*CreateClientQueryResp, error) {
+	out := new(CreateClientQueryResp)
+	err := c.cc.Invoke(ctx, Config_CreateClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) UpdateClientQuery(ctx context.Context, in *UpdateClientQueryReq, opts ...grpc.CallOption) (*UpdateClientQueryResp, error) {
+	out := new(UpdateClientQueryResp)
+	err := c.cc.Invoke(ctx, Config_UpdateClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) DeleteClientQuery(ctx context.Context, in *DeleteClientQueryReq, opts ...grpc.CallOption) (*DeleteClientQueryResp, error) {
+	out := new(DeleteClientQueryResp)
+	err := c.cc.Invoke(ctx, Config_DeleteClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CheckClientQueryName(ctx context.Context, in *CheckClientQueryNameReq, opts ...grpc.CallOption) (*CheckClientQueryNameResp, error) {
+	out := new(CheckClientQueryNameResp)
+	err := c.cc.Invoke(ctx, Config_CheckClientQueryName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientConfigVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientConfigVersionStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientPullTrendStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientPullTrendStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientPullStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientPullStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientLabelStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientLabelStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientAnnotationStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientAnnotationStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientVersionStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ListClientLabelAndAnnotation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Config_ClientSpecificFailedReason_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
+	out := new(CompareConfigItemConflictsResp)
+	err := c.cc.Invoke(ctx, Config_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) CompareKvConflicts(ctx context.Context, in *CompareKvConflictsReq, opts ...grpc.CallOption) (*CompareKvConflictsResp, error) {
+	out := new(CompareKvConflictsResp)
+	err := c.cc.Invoke(ctx, Config_CompareKvConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
+	out := new(GetTemplateAndNonTemplateCICountResp)
+	err := c.cc.Invoke(ctx, Config_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ConfigServer is the server API for Config service.
 // All implementations should embed UnimplementedConfigServer
 // for forward compatibility
 type ConfigServer interface {
-	// 创建服务
+	//  创建服务
 	CreateApp(context.Context, *CreateAppReq) (*CreateAppResp, error)
 	// 更新服务
 	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
+	// 变更服务生命周期状态：冻结后拒绝修改和上线但继续提供已上线版本，归档后停止向客户端提供配置
+	UpdateAppState(context.Context, *UpdateAppStateReq) (*UpdateAppStateResp, error)
+	// 暂停客户端拉取服务的新版本，用于快速止损异常上线
+	PauseAppConsumption(context.Context, *PauseAppConsumptionReq) (*PauseAppConsumptionResp, error)
+	// 恢复客户端拉取服务的配置
+	ResumeAppConsumption(context.Context, *ResumeAppConsumptionReq) (*ResumeAppConsumptionResp, error)
 	// 删除服务
 	DeleteApp(context.Context, *DeleteAppReq) (*DeleteAppResp, error)
 	// 获取服务
@@ -2274,6 +3661,194 @@ type ConfigServer interface {
 	ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error)
 	// TODO
 	GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error)
+	// 获取客户端上报的前置/后置脚本执行结果
+	ListHookExecResults(context.Context, *ListHookExecResultsReq) (*ListHookExecResultsResp, error)
+	// 获取客户端完整性校验上报的托管文件篡改事件
+	ListFileTamperEvents(context.Context, *ListFileTamperEventsReq) (*ListFileTamperEventsResp, error)
+	// 创建脚本模板
+	CreateHookTemplate(context.Context, *CreateHookTemplateReq) (*CreateHookTemplateResp, error)
+	// 获取脚本模板列表，包含平台内置模板
+	ListHookTemplates(context.Context, *ListHookTemplatesReq) (*ListHookTemplatesResp, error)
+	// 更新脚本模板，开启自动升级的脚本会同步上线新的脚本版本
+	UpdateHookTemplate(context.Context, *UpdateHookTemplateReq) (*UpdateHookTemplateResp, error)
+	// 删除脚本模板
+	DeleteHookTemplate(context.Context, *DeleteHookTemplateReq) (*DeleteHookTemplateResp, error)
+	// 通过脚本模板创建脚本
+	CreateHookFromTemplate(context.Context, *CreateHookFromTemplateReq) (*CreateHookResp, error)
+	// 将脚本升级到脚本模板的最新版本
+	UpgradeHookFromTemplate(context.Context, *UpgradeHookFromTemplateReq) (*UpgradeHookFromTemplateResp, error)
+	// 异步批量升级引用脚本模板的脚本，返回异步操作ID
+	UpgradeHooksFromTemplate(context.Context, *UpgradeHooksFromTemplateReq) (*UpgradeHooksFromTemplateResp, error)
+	// 获取脚本引用的脚本模板
+	GetHookTemplateRef(context.Context, *GetHookTemplateRefReq) (*GetHookTemplateRefResp, error)
+	// 创建环境
+	CreateEnvironment(context.Context, *CreateEnvironmentReq) (*CreateEnvironmentResp, error)
+	// 获取业务下的环境列表，按晋级顺序排列
+	ListEnvironments(context.Context, *ListEnvironmentsReq) (*ListEnvironmentsResp, error)
+	// 更新环境
+	UpdateEnvironment(context.Context, *UpdateEnvironmentReq) (*UpdateEnvironmentResp, error)
+	// 删除环境，环境下存在服务时不允许删除
+	DeleteEnvironment(context.Context, *DeleteEnvironmentReq) (*DeleteEnvironmentResp, error)
+	// 将服务绑定到环境，不同环境下相同服务标识的服务视为同一个服务
+	BindEnvApp(context.Context, *BindEnvAppReq) (*BindEnvAppResp, error)
+	// 解除服务与环境的绑定
+	UnbindEnvApp(context.Context, *UnbindEnvAppReq) (*UnbindEnvAppResp, error)
+	// 获取服务与环境的绑定关系列表
+	ListEnvApps(context.Context, *ListEnvAppsReq) (*ListEnvAppsResp, error)
+	// 将服务版本晋级到目标环境中相同服务标识的服务，在目标服务中生成同样内容的版本
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
+	// 将服务的配置文件或配置项共享给其他业务只读引用
+	CreateConfigShare(context.Context, *CreateConfigShareReq) (*CreateConfigShareResp, error)
+	// 更新共享配置的授权业务和描述
+	UpdateConfigShare(context.Context, *UpdateConfigShareReq) (*UpdateConfigShareResp, error)
+	// 删除共享配置，同时删除其他业务对它的引用
+	DeleteConfigShare(context.Context, *DeleteConfigShareReq) (*DeleteConfigShareResp, error)
+	// 获取本业务共享出去的配置列表
+	ListConfigShares(context.Context, *ListConfigSharesReq) (*ListConfigSharesResp, error)
+	// 获取其他业务授权给本业务的共享配置列表
+	ListSharedConfigs(context.Context, *ListSharedConfigsReq) (*ListSharedConfigsResp, error)
+	// 服务引用其他业务授权的共享配置，生成版本时会带上共享配置最新的已生成版本内容
+	CreateConfigShareRef(context.Context, *CreateConfigShareRefReq) (*CreateConfigShareRefResp, error)
+	// 删除服务对共享配置的引用
+	DeleteConfigShareRef(context.Context, *DeleteConfigShareRefReq) (*DeleteConfigShareRefResp, error)
+	// 获取服务引用的共享配置列表
+	ListConfigShareRefs(context.Context, *ListConfigShareRefsReq) (*ListConfigShareRefsResp, error)
+	// 获取回收站中被删除的服务、配置文件和配置项列表
+	ListRecycleBin(context.Context, *ListRecycleBinReq) (*ListRecycleBinResp, error)
+	// 从回收站恢复被删除的服务、配置文件或配置项
+	RestoreRecycleBin(context.Context, *RestoreRecycleBinReq) (*RestoreRecycleBinResp, error)
+	// 为服务当前的编辑态（配置文件、配置项、模板套餐绑定、变量）创建快照
+	CreateAppSnapshot(context.Context, *CreateAppSnapshotReq) (*CreateAppSnapshotResp, error)
+	// 获取服务的快照列表
+	ListAppSnapshots(context.Context, *ListAppSnapshotsReq) (*ListAppSnapshotsResp, error)
+	// 删除服务快照
+	DeleteAppSnapshot(context.Context, *DeleteAppSnapshotReq) (*DeleteAppSnapshotResp, error)
+	// 将服务的编辑态恢复到快照，不影响已生成的版本
+	RestoreAppSnapshot(context.Context, *RestoreAppSnapshotReq) (*RestoreAppSnapshotResp, error)
+	// 克隆服务，可选择复制配置文件、配置项、模版套餐绑定、变量及脚本，支持跨业务克隆和名称重映射
+	CloneApp(context.Context, *CloneAppReq) (*CloneAppResp, error)
+	// 将配置文件或配置项的修改暂存到当前用户的个人草稿，合并前对其他人不可见
+	UpsertDraftItem(context.Context, *UpsertDraftItemReq) (*UpsertDraftItemResp, error)
+	// 从当前用户的个人草稿中移除修改
+	DeleteDraftItem(context.Context, *DeleteDraftItemReq) (*DeleteDraftItemResp, error)
+	// 获取当前用户的个人草稿及与服务共享编辑态的对比
+	GetDraft(context.Context, *GetDraftReq) (*GetDraftResp, error)
+	// 将当前用户的个人草稿合并到服务的共享编辑态
+	MergeDraft(context.Context, *MergeDraftReq) (*MergeDraftResp, error)
+	// 丢弃当前用户的个人草稿
+	DiscardDraft(context.Context, *DiscardDraftReq) (*DiscardDraftResp, error)
+	// 全文检索业务下有权限查看的服务的配置文件内容和配置项的值
+	SearchFullText(context.Context, *SearchFullTextReq) (*SearchFullTextResp, error)
+	// 查询模板文件被哪些服务、配置文件和上线中的版本使用，用于评估变更影响范围
+	ListTmplWhereUsed(context.Context, *ListTmplWhereUsedReq) (*ListTmplWhereUsedResp, error)
+	// 查询模板变量被哪些服务、配置文件和上线中的版本使用，用于评估变更影响范围
+	ListTmplVariableWhereUsed(context.Context, *ListTmplVariableWhereUsedReq) (*ListTmplVariableWhereUsedResp, error)
+	// 声明服务下的配置对其他配置的引用关系，被引用的配置在引用关系删除前不允许删除
+	CreateConfigReference(context.Context, *CreateConfigReferenceReq) (*CreateConfigReferenceResp, error)
+	// 删除服务下的配置对其他配置的引用关系
+	DeleteConfigReference(context.Context, *DeleteConfigReferenceReq) (*DeleteConfigReferenceResp, error)
+	// 查询配置的引用关系图，用于展示变更和删除的影响范围
+	GetConfigRefGraph(context.Context, *GetConfigRefGraphReq) (*GetConfigRefGraphResp, error)
+	// 查询即将过期的证书
+	ListExpiringCertificates(context.Context, *ListExpiringCertificatesReq) (*ListExpiringCertificatesResp, error)
+	// 通过内部 CA 续期证书，新证书保存为未上线配置
+	RenewCertificate(context.Context, *RenewCertificateReq) (*RenewCertificateResp, error)
+	// 创建键值配置项的分组覆盖值，匹配该分组的客户端拉取到覆盖值
+	CreateKvOverride(context.Context, *CreateKvOverrideReq) (*CreateKvOverrideResp, error)
+	// 更新键值配置项的分组覆盖值
+	UpdateKvOverride(context.Context, *UpdateKvOverrideReq) (*UpdateKvOverrideResp, error)
+	// 删除键值配置项的分组覆盖值
+	DeleteKvOverride(context.Context, *DeleteKvOverrideReq) (*DeleteKvOverrideResp, error)
+	// 获取键值配置项的分组覆盖值列表
+	ListKvOverrides(context.Context, *ListKvOverridesReq) (*ListKvOverridesResp, error)
+	// 创建配置合规策略，在生成和上线版本时检查配置内容
+	CreateCompliancePolicy(context.Context, *CreateCompliancePolicyReq) (*CreateCompliancePolicyResp, error)
+	// 获取业务下的配置合规策略列表
+	ListCompliancePolicies(context.Context, *ListCompliancePoliciesReq) (*ListCompliancePoliciesResp, error)
+	// 更新配置合规策略
+	UpdateCompliancePolicy(context.Context, *UpdateCompliancePolicyReq) (*UpdateCompliancePolicyResp, error)
+	// 删除配置合规策略
+	DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyReq) (*DeleteCompliancePolicyResp, error)
+	// 测试配置合规策略，使用样例内容或指定服务的未命名版本检查，不保存策略
+	TestCompliancePolicy(context.Context, *TestCompliancePolicyReq) (*TestCompliancePolicyResp, error)
+	// 创建通知渠道，发布和审批事件发生时通过企业微信、Slack 或邮件通知
+	CreateNotificationChannel(context.Context, *CreateNotificationChannelReq) (*CreateNotificationChannelResp, error)
+	// 获取业务下的通知渠道列表
+	ListNotificationChannels(context.Context, *ListNotificationChannelsReq) (*ListNotificationChannelsResp, error)
+	// 更新通知渠道
+	UpdateNotificationChannel(context.Context, *UpdateNotificationChannelReq) (*UpdateNotificationChannelResp, error)
+	// 删除通知渠道
+	DeleteNotificationChannel(context.Context, *DeleteNotificationChannelReq) (*DeleteNotificationChannelResp, error)
+	// 测试通知渠道，使用样例数据发送一条指定事件的消息
+	TestNotificationChannel(context.Context, *TestNotificationChannelReq) (*TestNotificationChannelResp, error)
+	// 创建告警规则，定时检测平台指标，超过阈值时发送到通知渠道
+	CreateAlertRule(context.Context, *CreateAlertRuleReq) (*CreateAlertRuleResp, error)
+	// 获取业务下的告警规则列表
+	ListAlertRules(context.Context, *ListAlertRulesReq) (*ListAlertRulesResp, error)
+	// 更新告警规则
+	UpdateAlertRule(context.Context, *UpdateAlertRuleReq) (*UpdateAlertRuleResp, error)
+	// 删除告警规则
+	DeleteAlertRule(context.Context, *DeleteAlertRuleReq) (*DeleteAlertRuleResp, error)
+	// 隔离指定指纹或IP的客户端，feed server 拒绝其握手和 watch 请求并返回退避时间，不影响使用同一密钥的其他客户端
+	CreateClientQuarantine(context.Context, *CreateClientQuarantineReq) (*CreateClientQuarantineResp, error)
+	// 获取业务下的客户端隔离列表
+	ListClientQuarantines(context.Context, *ListClientQuarantinesReq) (*ListClientQuarantinesResp, error)
+	// 更新客户端隔离，匹配方式和值不可修改
+	UpdateClientQuarantine(context.Context, *UpdateClientQuarantineReq) (*UpdateClientQuarantineResp, error)
+	// 解除客户端隔离
+	DeleteClientQuarantine(context.Context, *DeleteClientQuarantineReq) (*DeleteClientQuarantineResp, error)
+	// 创建配置项实验，参与实验的客户端按权重分桶，不同分桶下发不同的配置项值
+	CreateExperiment(context.Context, *CreateExperimentReq) (*CreateExperimentResp, error)
+	// 获取服务下的配置项实验列表
+	ListExperiments(context.Context, *ListExperimentsReq) (*ListExperimentsResp, error)
+	// 更新配置项实验，实验的配置项key不可修改
+	UpdateExperiment(context.Context, *UpdateExperimentReq) (*UpdateExperimentResp, error)
+	// 删除配置项实验及其曝光记录
+	DeleteExperiment(context.Context, *DeleteExperimentReq) (*DeleteExperimentResp, error)
+	// 获取配置项实验各分桶的曝光统计
+	GetExperimentStats(context.Context, *GetExperimentStatsReq) (*GetExperimentStatsResp, error)
+	// 创建服务账号，用于流水线等自动化场景调用管理接口，API 密钥只在响应中返回一次
+	CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*CreateServiceAccountResp, error)
+	// 获取业务下的服务账号列表
+	ListServiceAccounts(context.Context, *ListServiceAccountsReq) (*ListServiceAccountsResp, error)
+	// 更新服务账号的描述、授权范围、启用状态和过期时间
+	UpdateServiceAccount(context.Context, *UpdateServiceAccountReq) (*UpdateServiceAccountResp, error)
+	// 删除服务账号
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountReq) (*DeleteServiceAccountResp, error)
+	// 重置服务账号的 API 密钥，旧密钥立即失效
+	ResetServiceAccountKey(context.Context, *ResetServiceAccountKeyReq) (*ResetServiceAccountKeyResp, error)
+	// 设置用户在服务下的角色，已有角色时覆盖
+	SetAppRoleBinding(context.Context, *SetAppRoleBindingReq) (*SetAppRoleBindingResp, error)
+	// 临时授予用户服务角色，如发布窗口期内授予发布权限，到期后自动回收
+	GrantTemporaryAppRole(context.Context, *GrantTemporaryAppRoleReq) (*GrantTemporaryAppRoleResp, error)
+	// 获取服务下的角色授权列表
+	ListAppRoleBindings(context.Context, *ListAppRoleBindingsReq) (*ListAppRoleBindingsResp, error)
+	// 删除服务下的角色授权
+	DeleteAppRoleBinding(context.Context, *DeleteAppRoleBindingReq) (*DeleteAppRoleBindingResp, error)
+	// 批量检查当前用户对服务的操作权限，用于前端展示操作按钮
+	CheckAppPermissions(context.Context, *CheckAppPermissionsReq) (*CheckAppPermissionsResp, error)
+	// 获取服务的配置内容脱敏规则，未设置时返回未启用的空规则
+	GetMaskingProfile(context.Context, *GetMaskingProfileReq) (*GetMaskingProfileResp, error)
+	// 更新服务的配置内容脱敏规则，没有查看敏感信息权限的用户读取配置内容时按规则脱敏
+	UpdateMaskingProfile(context.Context, *UpdateMaskingProfileReq) (*UpdateMaskingProfileResp, error)
+	// 使用脱敏规则对样例内容进行脱敏预览，不保存规则
+	PreviewMasking(context.Context, *PreviewMaskingReq) (*PreviewMaskingResp, error)
+	// 获取业务的凭证泄露扫描设置
+	GetSecretScanSetting(context.Context, *GetSecretScanSettingReq) (*GetSecretScanSettingResp, error)
+	// 更新业务的凭证泄露扫描设置，上传配置文件和编辑配置项时按设置扫描疑似凭证
+	UpdateSecretScanSetting(context.Context, *UpdateSecretScanSettingReq) (*UpdateSecretScanSettingResp, error)
+	// 扫描样例内容中的疑似凭证，不保存设置
+	ScanSecretLeak(context.Context, *ScanSecretLeakReq) (*ScanSecretLeakResp, error)
+	// 获取服务的消费统计，包括拉取次数、下发字节数、在线客户端数和最后拉取时间
+	GetAppConsumption(context.Context, *GetAppConsumptionReq) (*GetAppConsumptionResp, error)
+	// 获取业务下拉取次数最多的服务
+	ListTopConsumingApps(context.Context, *ListTopConsumingAppsReq) (*ListTopConsumingAppsResp, error)
+	// 获取长期未被消费的服务、配置文件和密钥的闲置报告
+	ListStaleResources(context.Context, *ListStaleResourcesReq) (*ListStaleResourcesResp, error)
+	// 获取业务每月的用量（存储、下发流量、请求数、监听时长），用于成本核算
+	ListBizUsages(context.Context, *ListBizUsagesReq) (*ListBizUsagesResp, error)
+	// 获取业务概览，一次返回服务数、最近上线、失败的钩子、即将过期的证书、闲置服务和拉取最多的服务，供概览页使用
+	GetBizOverview(context.Context, *GetBizOverviewReq) (*GetBizOverviewResp, error)
 	// 创建模板空间
 	CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateTemplateSpaceResp, error)
 	// 删除模板空间
@@ -2438,17 +4013,41 @@ type ConfigServer interface {
 	GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error)
 	// 分组选择器
 	ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error)
+	// 预览分组当前匹配的在线客户端
+	PreviewGroupClients(context.Context, *PreviewGroupClientsReq) (*PreviewGroupClientsResp, error)
+	// 预览指定客户端当前将拉取的版本及配置内容，模拟 feed server 的上线策略匹配
+	PreviewClientRelease(context.Context, *PreviewClientReleaseReq) (*client_preview.ClientReleasePreview, error)
 	// 发布服务版本
 	Publish(context.Context, *PublishReq) (*PublishResp, error)
 	// 生成新的服务版本并发布
 	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
+	// 一次调用提交配置并生成版本(可选直接上线), 任一步骤失败时编辑区恢复原状
+	BulkRelease(context.Context, *BulkReleaseReq) (*BulkReleaseResp, error)
+	// 查询异步操作的进度、部分失败的错误和结果
+	GetOperation(context.Context, *GetOperationReq) (*GetOperationResp, error)
+	// 获取业务下的异步操作列表
+	ListOperations(context.Context, *ListOperationsReq) (*ListOperationsResp, error)
+	// 取消未完成的异步操作，已处理的条目不会回滚
+	CancelOperation(context.Context, *CancelOperationReq) (*CancelOperationResp, error)
 	// 上线服务版本
 	SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error)
 	Approve(context.Context, *ApproveReq) (*ApproveResp, error)
+	// 紧急发布：将服务版本临时发布到指定的客户端，过期后自动回到主线版本
+	EmergencyPublish(context.Context, *EmergencyPublishReq) (*EmergencyPublishResp, error)
+	// 查询紧急发布列表
+	ListEmergencyPublishes(context.Context, *ListEmergencyPublishesReq) (*ListEmergencyPublishesResp, error)
+	// 提前撤销紧急发布，客户端回到主线版本
+	RevokeEmergencyPublish(context.Context, *RevokeEmergencyPublishReq) (*RevokeEmergencyPublishResp, error)
+	// 固定分组的版本，后续上线（包括全量上线）跳过该分组，直到取消固定
+	PinReleasedGroup(context.Context, *PinReleasedGroupReq) (*PinReleasedGroupResp, error)
+	// 取消固定分组的版本，分组保持当前版本直到下次上线
+	UnpinReleasedGroup(context.Context, *UnpinReleasedGroupReq) (*UnpinReleasedGroupResp, error)
 	GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error)
 	GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error)
 	GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error)
 	ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error)
+	// 获取服务的变更时间线，合并编辑、生成版本、上线、审批、回滚和分组策略变更，用于历史页和对接外部变更管理系统
+	ListAppTimeline(context.Context, *ListAppTimelineReq) (*ListAppTimelineResp, error)
 	// 创建客户端密钥
 	CreateCredentials(context.Context, *CreateCredentialReq) (*CreateCredentialResp, error)
 	// 获取客户端密钥
@@ -2463,6 +4062,10 @@ type ConfigServer interface {
 	ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error)
 	// 更新客户端密钥关联服务规则
 	UpdateCredentialScope(context.Context, *UpdateCredentialScopeReq) (*UpdateCredentialScopeResp, error)
+	// 暂停使用该客户端密钥的客户端拉取配置
+	PauseCredentialConsumption(context.Context, *PauseCredentialConsumptionReq) (*PauseCredentialConsumptionResp, error)
+	// 恢复使用该客户端密钥的客户端拉取配置
+	ResumeCredentialConsumption(context.Context, *ResumeCredentialConsumptionReq) (*ResumeCredentialConsumptionResp, error)
 	// 预览客户端密钥关联配置项
 	CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error)
 	// 创建键值配置项
@@ -2477,6 +4080,12 @@ type ConfigServer interface {
 	BatchDeleteKv(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error)
 	// 批量创建或更新键值配置项
 	BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error)
+	// 在同一个事务中批量创建、更新和删除键值配置项，全部成功或全部失败
+	TransactKvs(context.Context, *TransactKvsReq) (*TransactKvsResp, error)
+	// 获取键值配置项的历史版本
+	ListKvHistory(context.Context, *ListKvHistoryReq) (*ListKvHistoryResp, error)
+	// 对比键值配置项两个版本的值
+	DiffKvVersions(context.Context, *DiffKvVersionsReq) (*DiffKvVersionsResp, error)
 	// 恢复键值配置项
 	UnDeleteKv(context.Context, *UnDeleteKvReq) (*UnDeleteKvResp, error)
 	// 批量恢复键值配置项
@@ -2487,6 +4096,8 @@ type ConfigServer interface {
 	ImportKvs(context.Context, *ImportKvsReq) (*ImportKvsResp, error)
 	// 查找临近到期证书
 	FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error)
+	// 按类型语义比较kv当前值与待修改的值
+	CompareKvValue(context.Context, *CompareKvValueReq) (*CompareKvValueResp, error)
 	// 获取客户端列表
 	ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error)
 	// 获取客户端拉取记录列表
@@ -2526,1435 +4137,3526 @@ type ConfigServer interface {
 	// 获取模板和非模板配置项数量
 	GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error)
 }
-
-// UnimplementedConfigServer should be embedded to have forward compatible implementations.
-type UnimplementedConfigServer struct {
+
+// UnimplementedConfigServer should be embedded to have forward compatible implementations.
+type UnimplementedConfigServer struct {
+}
+
+func (UnimplementedConfigServer) CreateApp(context.Context, *CreateAppReq) (*CreateAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
+}
+func (UnimplementedConfigServer) UpdateApp(context.Context, *UpdateAppReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+}
+func (UnimplementedConfigServer) UpdateAppState(context.Context, *UpdateAppStateReq) (*UpdateAppStateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppState not implemented")
+}
+func (UnimplementedConfigServer) PauseAppConsumption(context.Context, *PauseAppConsumptionReq) (*PauseAppConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseAppConsumption not implemented")
+}
+func (UnimplementedConfigServer) ResumeAppConsumption(context.Context, *ResumeAppConsumptionReq) (*ResumeAppConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAppConsumption not implemented")
+}
+func (UnimplementedConfigServer) DeleteApp(context.Context, *DeleteAppReq) (*DeleteAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
+}
+func (UnimplementedConfigServer) GetApp(context.Context, *GetAppReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApp not implemented")
+}
+func (UnimplementedConfigServer) GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppByName not implemented")
+}
+func (UnimplementedConfigServer) ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppsRest not implemented")
+}
+func (UnimplementedConfigServer) ListAppsBySpaceRest(context.Context, *ListAppsBySpaceRestReq) (*ListAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppsBySpaceRest not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigItem not implemented")
+}
+func (UnimplementedConfigServer) BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertConfigItems not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*UpdateConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigItem not implemented")
+}
+func (UnimplementedConfigServer) DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*DeleteConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigItem not implemented")
+}
+func (UnimplementedConfigServer) BatchDeleteConfigItems(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteConfigItems not implemented")
+}
+func (UnimplementedConfigServer) UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*UnDeleteConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteConfigItem not implemented")
+}
+func (UnimplementedConfigServer) BatchUnDeleteConfigItem(context.Context, *BatchUnDeleteConfigItemReq) (*BatchUnDeleteConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUnDeleteConfigItem not implemented")
+}
+func (UnimplementedConfigServer) UndoConfigItem(context.Context, *UndoConfigItemReq) (*UndoConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoConfigItem not implemented")
+}
+func (UnimplementedConfigServer) GetConfigItem(context.Context, *GetConfigItemReq) (*GetConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigItem not implemented")
+}
+func (UnimplementedConfigServer) GetReleasedConfigItem(context.Context, *GetReleasedConfigItemReq) (*GetReleasedConfigItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
+}
+func (UnimplementedConfigServer) ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItems not implemented")
+}
+func (UnimplementedConfigServer) ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedConfigItems not implemented")
+}
+func (UnimplementedConfigServer) ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemCount not implemented")
+}
+func (UnimplementedConfigServer) ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemByTuple not implemented")
+}
+func (UnimplementedConfigServer) GetReleasedKv(context.Context, *GetReleasedKvReq) (*GetReleasedKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedKv not implemented")
+}
+func (UnimplementedConfigServer) ListReleasedKvs(context.Context, *ListReleasedKvsReq) (*ListReleasedKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedKvs not implemented")
+}
+func (UnimplementedConfigServer) ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllReleasedConfigItems not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*UpdateConfigHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigHook not implemented")
+}
+func (UnimplementedConfigServer) CreateRelease(context.Context, *CreateReleaseReq) (*CreateReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelease not implemented")
+}
+func (UnimplementedConfigServer) ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (UnimplementedConfigServer) GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseByName not implemented")
+}
+func (UnimplementedConfigServer) GetRelease(context.Context, *GetReleaseReq) (*release.Release, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelease not implemented")
+}
+func (UnimplementedConfigServer) DeprecateRelease(context.Context, *DeprecateReleaseReq) (*DeprecateReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateRelease not implemented")
+}
+func (UnimplementedConfigServer) UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*UnDeprecateReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeprecateRelease not implemented")
+}
+func (UnimplementedConfigServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*DeleteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
+}
+func (UnimplementedConfigServer) CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReleaseName not implemented")
+}
+func (UnimplementedConfigServer) CreateHook(context.Context, *CreateHookReq) (*CreateHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
+}
+func (UnimplementedConfigServer) DeleteHook(context.Context, *DeleteHookReq) (*DeleteHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHook not implemented")
+}
+func (UnimplementedConfigServer) BatchDeleteHook(context.Context, *BatchDeleteHookReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteHook not implemented")
+}
+func (UnimplementedConfigServer) UpdateHook(context.Context, *UpdateHookReq) (*UpdateHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHook not implemented")
+}
+func (UnimplementedConfigServer) ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHooks not implemented")
+}
+func (UnimplementedConfigServer) ListHookTags(context.Context, *ListHookTagsReq) (*ListHookTagsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookTags not implemented")
+}
+func (UnimplementedConfigServer) GetHook(context.Context, *GetHookReq) (*GetHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHook not implemented")
+}
+func (UnimplementedConfigServer) CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateHookRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookRevision not implemented")
+}
+func (UnimplementedConfigServer) ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisions not implemented")
+}
+func (UnimplementedConfigServer) DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*DeleteHookRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookRevision not implemented")
+}
+func (UnimplementedConfigServer) PublishHookRevision(context.Context, *PublishHookRevisionReq) (*PublishHookRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishHookRevision not implemented")
+}
+func (UnimplementedConfigServer) GetHookRevision(context.Context, *GetHookRevisionReq) (*hook_revision.HookRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevision not implemented")
+}
+func (UnimplementedConfigServer) UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*UpdateHookRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookRevision not implemented")
+}
+func (UnimplementedConfigServer) ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookReferences not implemented")
+}
+func (UnimplementedConfigServer) ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisionReferences not implemented")
+}
+func (UnimplementedConfigServer) GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHook not implemented")
+}
+func (UnimplementedConfigServer) ListHookExecResults(context.Context, *ListHookExecResultsReq) (*ListHookExecResultsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookExecResults not implemented")
+}
+func (UnimplementedConfigServer) ListFileTamperEvents(context.Context, *ListFileTamperEventsReq) (*ListFileTamperEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileTamperEvents not implemented")
+}
+func (UnimplementedConfigServer) CreateHookTemplate(context.Context, *CreateHookTemplateReq) (*CreateHookTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookTemplate not implemented")
+}
+func (UnimplementedConfigServer) ListHookTemplates(context.Context, *ListHookTemplatesReq) (*ListHookTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookTemplates not implemented")
+}
+func (UnimplementedConfigServer) UpdateHookTemplate(context.Context, *UpdateHookTemplateReq) (*UpdateHookTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookTemplate not implemented")
+}
+func (UnimplementedConfigServer) DeleteHookTemplate(context.Context, *DeleteHookTemplateReq) (*DeleteHookTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookTemplate not implemented")
+}
+func (UnimplementedConfigServer) CreateHookFromTemplate(context.Context, *CreateHookFromTemplateReq) (*CreateHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookFromTemplate not implemented")
+}
+func (UnimplementedConfigServer) UpgradeHookFromTemplate(context.Context, *UpgradeHookFromTemplateReq) (*UpgradeHookFromTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHookFromTemplate not implemented")
+}
+func (UnimplementedConfigServer) UpgradeHooksFromTemplate(context.Context, *UpgradeHooksFromTemplateReq) (*UpgradeHooksFromTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHooksFromTemplate not implemented")
+}
+func (UnimplementedConfigServer) GetHookTemplateRef(context.Context, *GetHookTemplateRefReq) (*GetHookTemplateRefResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookTemplateRef not implemented")
+}
+func (UnimplementedConfigServer) CreateEnvironment(context.Context, *CreateEnvironmentReq) (*CreateEnvironmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironment not implemented")
+}
+func (UnimplementedConfigServer) ListEnvironments(context.Context, *ListEnvironmentsReq) (*ListEnvironmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvironments not implemented")
+}
+func (UnimplementedConfigServer) UpdateEnvironment(context.Context, *UpdateEnvironmentReq) (*UpdateEnvironmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnvironment not implemented")
+}
+func (UnimplementedConfigServer) DeleteEnvironment(context.Context, *DeleteEnvironmentReq) (*DeleteEnvironmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
+}
+func (UnimplementedConfigServer) BindEnvApp(context.Context, *BindEnvAppReq) (*BindEnvAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindEnvApp not implemented")
+}
+func (UnimplementedConfigServer) UnbindEnvApp(context.Context, *UnbindEnvAppReq) (*UnbindEnvAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindEnvApp not implemented")
+}
+func (UnimplementedConfigServer) ListEnvApps(context.Context, *ListEnvAppsReq) (*ListEnvAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvApps not implemented")
+}
+func (UnimplementedConfigServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigShare(context.Context, *CreateConfigShareReq) (*CreateConfigShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigShare not implemented")
+}
+func (UnimplementedConfigServer) UpdateConfigShare(context.Context, *UpdateConfigShareReq) (*UpdateConfigShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigShare not implemented")
+}
+func (UnimplementedConfigServer) DeleteConfigShare(context.Context, *DeleteConfigShareReq) (*DeleteConfigShareResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigShare not implemented")
+}
+func (UnimplementedConfigServer) ListConfigShares(context.Context, *ListConfigSharesReq) (*ListConfigSharesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigShares not implemented")
+}
+func (UnimplementedConfigServer) ListSharedConfigs(context.Context, *ListSharedConfigsReq) (*ListSharedConfigsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSharedConfigs not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigShareRef(context.Context, *CreateConfigShareRefReq) (*CreateConfigShareRefResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigShareRef not implemented")
+}
+func (UnimplementedConfigServer) DeleteConfigShareRef(context.Context, *DeleteConfigShareRefReq) (*DeleteConfigShareRefResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigShareRef not implemented")
+}
+func (UnimplementedConfigServer) ListConfigShareRefs(context.Context, *ListConfigShareRefsReq) (*ListConfigShareRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigShareRefs not implemented")
+}
+func (UnimplementedConfigServer) ListRecycleBin(context.Context, *ListRecycleBinReq) (*ListRecycleBinResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecycleBin not implemented")
+}
+func (UnimplementedConfigServer) RestoreRecycleBin(context.Context, *RestoreRecycleBinReq) (*RestoreRecycleBinResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRecycleBin not implemented")
+}
+func (UnimplementedConfigServer) CreateAppSnapshot(context.Context, *CreateAppSnapshotReq) (*CreateAppSnapshotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppSnapshot not implemented")
+}
+func (UnimplementedConfigServer) ListAppSnapshots(context.Context, *ListAppSnapshotsReq) (*ListAppSnapshotsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppSnapshots not implemented")
+}
+func (UnimplementedConfigServer) DeleteAppSnapshot(context.Context, *DeleteAppSnapshotReq) (*DeleteAppSnapshotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppSnapshot not implemented")
+}
+func (UnimplementedConfigServer) RestoreAppSnapshot(context.Context, *RestoreAppSnapshotReq) (*RestoreAppSnapshotResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAppSnapshot not implemented")
+}
+func (UnimplementedConfigServer) CloneApp(context.Context, *CloneAppReq) (*CloneAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneApp not implemented")
+}
+func (UnimplementedConfigServer) UpsertDraftItem(context.Context, *UpsertDraftItemReq) (*UpsertDraftItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDraftItem not implemented")
+}
+func (UnimplementedConfigServer) DeleteDraftItem(context.Context, *DeleteDraftItemReq) (*DeleteDraftItemResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraftItem not implemented")
+}
+func (UnimplementedConfigServer) GetDraft(context.Context, *GetDraftReq) (*GetDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
+func (UnimplementedConfigServer) MergeDraft(context.Context, *MergeDraftReq) (*MergeDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDraft not implemented")
+}
+func (UnimplementedConfigServer) DiscardDraft(context.Context, *DiscardDraftReq) (*DiscardDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedConfigServer) SearchFullText(context.Context, *SearchFullTextReq) (*SearchFullTextResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFullText not implemented")
+}
+func (UnimplementedConfigServer) ListTmplWhereUsed(context.Context, *ListTmplWhereUsedReq) (*ListTmplWhereUsedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplWhereUsed not implemented")
+}
+func (UnimplementedConfigServer) ListTmplVariableWhereUsed(context.Context, *ListTmplVariableWhereUsedReq) (*ListTmplVariableWhereUsedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplVariableWhereUsed not implemented")
+}
+func (UnimplementedConfigServer) CreateConfigReference(context.Context, *CreateConfigReferenceReq) (*CreateConfigReferenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigReference not implemented")
+}
+func (UnimplementedConfigServer) DeleteConfigReference(context.Context, *DeleteConfigReferenceReq) (*DeleteConfigReferenceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigReference not implemented")
+}
+func (UnimplementedConfigServer) GetConfigRefGraph(context.Context, *GetConfigRefGraphReq) (*GetConfigRefGraphResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigRefGraph not implemented")
+}
+func (UnimplementedConfigServer) ListExpiringCertificates(context.Context, *ListExpiringCertificatesReq) (*ListExpiringCertificatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringCertificates not implemented")
+}
+func (UnimplementedConfigServer) RenewCertificate(context.Context, *RenewCertificateReq) (*RenewCertificateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
+func (UnimplementedConfigServer) CreateKvOverride(context.Context, *CreateKvOverrideReq) (*CreateKvOverrideResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKvOverride not implemented")
+}
+func (UnimplementedConfigServer) UpdateKvOverride(context.Context, *UpdateKvOverrideReq) (*UpdateKvOverrideResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKvOverride not implemented")
+}
+func (UnimplementedConfigServer) DeleteKvOverride(context.Context, *DeleteKvOverrideReq) (*DeleteKvOverrideResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKvOverride not implemented")
+}
+func (UnimplementedConfigServer) ListKvOverrides(context.Context, *ListKvOverridesReq) (*ListKvOverridesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvOverrides not implemented")
+}
+func (UnimplementedConfigServer) CreateCompliancePolicy(context.Context, *CreateCompliancePolicyReq) (*CreateCompliancePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompliancePolicy not implemented")
+}
+func (UnimplementedConfigServer) ListCompliancePolicies(context.Context, *ListCompliancePoliciesReq) (*ListCompliancePoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompliancePolicies not implemented")
+}
+func (UnimplementedConfigServer) UpdateCompliancePolicy(context.Context, *UpdateCompliancePolicyReq) (*UpdateCompliancePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompliancePolicy not implemented")
+}
+func (UnimplementedConfigServer) DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyReq) (*DeleteCompliancePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompliancePolicy not implemented")
+}
+func (UnimplementedConfigServer) TestCompliancePolicy(context.Context, *TestCompliancePolicyReq) (*TestCompliancePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCompliancePolicy not implemented")
+}
+func (UnimplementedConfigServer) CreateNotificationChannel(context.Context, *CreateNotificationChannelReq) (*CreateNotificationChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationChannel not implemented")
+}
+func (UnimplementedConfigServer) ListNotificationChannels(context.Context, *ListNotificationChannelsReq) (*ListNotificationChannelsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationChannels not implemented")
+}
+func (UnimplementedConfigServer) UpdateNotificationChannel(context.Context, *UpdateNotificationChannelReq) (*UpdateNotificationChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationChannel not implemented")
+}
+func (UnimplementedConfigServer) DeleteNotificationChannel(context.Context, *DeleteNotificationChannelReq) (*DeleteNotificationChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationChannel not implemented")
+}
+func (UnimplementedConfigServer) TestNotificationChannel(context.Context, *TestNotificationChannelReq) (*TestNotificationChannelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestNotificationChannel not implemented")
+}
+func (UnimplementedConfigServer) CreateAlertRule(context.Context, *CreateAlertRuleReq) (*CreateAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedConfigServer) ListAlertRules(context.Context, *ListAlertRulesReq) (*ListAlertRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedConfigServer) UpdateAlertRule(context.Context, *UpdateAlertRuleReq) (*UpdateAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedConfigServer) DeleteAlertRule(context.Context, *DeleteAlertRuleReq) (*DeleteAlertRuleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedConfigServer) CreateClientQuarantine(context.Context, *CreateClientQuarantineReq) (*CreateClientQuarantineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuarantine not implemented")
+}
+func (UnimplementedConfigServer) ListClientQuarantines(context.Context, *ListClientQuarantinesReq) (*ListClientQuarantinesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuarantines not implemented")
+}
+func (UnimplementedConfigServer) UpdateClientQuarantine(context.Context, *UpdateClientQuarantineReq) (*UpdateClientQuarantineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuarantine not implemented")
+}
+func (UnimplementedConfigServer) DeleteClientQuarantine(context.Context, *DeleteClientQuarantineReq) (*DeleteClientQuarantineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuarantine not implemented")
+}
+func (UnimplementedConfigServer) CreateExperiment(context.Context, *CreateExperimentReq) (*CreateExperimentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperiment not implemented")
+}
+func (UnimplementedConfigServer) ListExperiments(context.Context, *ListExperimentsReq) (*ListExperimentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
+}
+func (UnimplementedConfigServer) UpdateExperiment(context.Context, *UpdateExperimentReq) (*UpdateExperimentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperiment not implemented")
+}
+func (UnimplementedConfigServer) DeleteExperiment(context.Context, *DeleteExperimentReq) (*DeleteExperimentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperiment not implemented")
+}
+func (UnimplementedConfigServer) GetExperimentStats(context.Context, *GetExperimentStatsReq) (*GetExperimentStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentStats not implemented")
+}
+func (UnimplementedConfigServer) CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*CreateServiceAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedConfigServer) ListServiceAccounts(context.Context, *ListServiceAccountsReq) (*ListServiceAccountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedConfigServer) UpdateServiceAccount(context.Context, *UpdateServiceAccountReq) (*UpdateServiceAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceAccount not implemented")
+}
+func (UnimplementedConfigServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountReq) (*DeleteServiceAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedConfigServer) ResetServiceAccountKey(context.Context, *ResetServiceAccountKeyReq) (*ResetServiceAccountKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetServiceAccountKey not implemented")
+}
+func (UnimplementedConfigServer) SetAppRoleBinding(context.Context, *SetAppRoleBindingReq) (*SetAppRoleBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppRoleBinding not implemented")
+}
+func (UnimplementedConfigServer) GrantTemporaryAppRole(context.Context, *GrantTemporaryAppRoleReq) (*GrantTemporaryAppRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTemporaryAppRole not implemented")
+}
+func (UnimplementedConfigServer) ListAppRoleBindings(context.Context, *ListAppRoleBindingsReq) (*ListAppRoleBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppRoleBindings not implemented")
+}
+func (UnimplementedConfigServer) DeleteAppRoleBinding(context.Context, *DeleteAppRoleBindingReq) (*DeleteAppRoleBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppRoleBinding not implemented")
+}
+func (UnimplementedConfigServer) CheckAppPermissions(context.Context, *CheckAppPermissionsReq) (*CheckAppPermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAppPermissions not implemented")
+}
+func (UnimplementedConfigServer) GetMaskingProfile(context.Context, *GetMaskingProfileReq) (*GetMaskingProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaskingProfile not implemented")
+}
+func (UnimplementedConfigServer) UpdateMaskingProfile(context.Context, *UpdateMaskingProfileReq) (*UpdateMaskingProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaskingProfile not implemented")
+}
+func (UnimplementedConfigServer) PreviewMasking(context.Context, *PreviewMaskingReq) (*PreviewMaskingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewMasking not implemented")
+}
+func (UnimplementedConfigServer) GetSecretScanSetting(context.Context, *GetSecretScanSettingReq) (*GetSecretScanSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretScanSetting not implemented")
+}
+func (UnimplementedConfigServer) UpdateSecretScanSetting(context.Context, *UpdateSecretScanSettingReq) (*UpdateSecretScanSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecretScanSetting not implemented")
+}
+func (UnimplementedConfigServer) ScanSecretLeak(context.Context, *ScanSecretLeakReq) (*ScanSecretLeakResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanSecretLeak not implemented")
+}
+func (UnimplementedConfigServer) GetAppConsumption(context.Context, *GetAppConsumptionReq) (*GetAppConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppConsumption not implemented")
+}
+func (UnimplementedConfigServer) ListTopConsumingApps(context.Context, *ListTopConsumingAppsReq) (*ListTopConsumingAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopConsumingApps not implemented")
+}
+func (UnimplementedConfigServer) ListStaleResources(context.Context, *ListStaleResourcesReq) (*ListStaleResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleResources not implemented")
+}
+func (UnimplementedConfigServer) ListBizUsages(context.Context, *ListBizUsagesReq) (*ListBizUsagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBizUsages not implemented")
+}
+func (UnimplementedConfigServer) GetBizOverview(context.Context, *GetBizOverviewReq) (*GetBizOverviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBizOverview not implemented")
+}
+func (UnimplementedConfigServer) CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateTemplateSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSpace not implemented")
+}
+func (UnimplementedConfigServer) DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*DeleteTemplateSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpace not implemented")
+}
+func (UnimplementedConfigServer) UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*UpdateTemplateSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSpace not implemented")
+}
+func (UnimplementedConfigServer) ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaces not implemented")
+}
+func (UnimplementedConfigServer) GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBizsOfTmplSpaces not implemented")
+}
+func (UnimplementedConfigServer) CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateDefaultTmplSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultTmplSpace not implemented")
+}
+func (UnimplementedConfigServer) ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSpacesByIDs not implemented")
+}
+func (UnimplementedConfigServer) CreateTemplate(context.Context, *CreateTemplateReq) (*CreateTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedConfigServer) DeleteTemplate(context.Context, *DeleteTemplateReq) (*DeleteTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedConfigServer) BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*BatchDeleteTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplate not implemented")
 }
-
-func (UnimplementedConfigServer) CreateApp(context.Context, *CreateAppReq) (*CreateAppResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
+func (UnimplementedConfigServer) UpdateTemplate(context.Context, *UpdateTemplateReq) (*UpdateTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
 }
-func (UnimplementedConfigServer) UpdateApp(context.Context, *UpdateAppReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+func (UnimplementedConfigServer) ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
-func (UnimplementedConfigServer) DeleteApp(context.Context, *DeleteAppReq) (*DeleteAppResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
+func (UnimplementedConfigServer) BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertTemplates not implemented")
 }
-func (UnimplementedConfigServer) GetApp(context.Context, *GetAppReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApp not implemented")
+func (UnimplementedConfigServer) BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTemplatePermissions not implemented")
 }
-func (UnimplementedConfigServer) GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppByName not implemented")
+func (UnimplementedConfigServer) AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*AddTmplsToTmplSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTmplsToTmplSets not implemented")
 }
-func (UnimplementedConfigServer) ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppsRest not implemented")
+func (UnimplementedConfigServer) DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTmplsFromTmplSets not implemented")
 }
-func (UnimplementedConfigServer) ListAppsBySpaceRest(context.Context, *ListAppsBySpaceRestReq) (*ListAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppsBySpaceRest not implemented")
+func (UnimplementedConfigServer) ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesByIDs not implemented")
 }
-func (UnimplementedConfigServer) CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigItem not implemented")
+func (UnimplementedConfigServer) ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesNotBound not implemented")
 }
-func (UnimplementedConfigServer) BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertConfigItems not implemented")
+func (UnimplementedConfigServer) ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateByTuple not implemented")
 }
-func (UnimplementedConfigServer) UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*UpdateConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigItem not implemented")
+func (UnimplementedConfigServer) ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplsOfTmplSet not implemented")
 }
-func (UnimplementedConfigServer) DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*DeleteConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigItem not implemented")
+func (UnimplementedConfigServer) ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsAndRevisions not implemented")
 }
-func (UnimplementedConfigServer) BatchDeleteConfigItems(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteConfigItems not implemented")
+func (UnimplementedConfigServer) CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateTemplateRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateRevision not implemented")
 }
-func (UnimplementedConfigServer) UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*UnDeleteConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteConfigItem not implemented")
+func (UnimplementedConfigServer) UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*UpdateTemplateRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateRevision not implemented")
 }
-func (UnimplementedConfigServer) BatchUnDeleteConfigItem(context.Context, *BatchUnDeleteConfigItemReq) (*BatchUnDeleteConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUnDeleteConfigItem not implemented")
+func (UnimplementedConfigServer) ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisions not implemented")
 }
-func (UnimplementedConfigServer) UndoConfigItem(context.Context, *UndoConfigItemReq) (*UndoConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoConfigItem not implemented")
+func (UnimplementedConfigServer) GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateRevision not implemented")
 }
-func (UnimplementedConfigServer) GetConfigItem(context.Context, *GetConfigItemReq) (*GetConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigItem not implemented")
+func (UnimplementedConfigServer) ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisionsByIDs not implemented")
 }
-func (UnimplementedConfigServer) GetReleasedConfigItem(context.Context, *GetReleasedConfigItemReq) (*GetReleasedConfigItemResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
+func (UnimplementedConfigServer) ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionNamesByTmplIDs not implemented")
 }
-func (UnimplementedConfigServer) ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItems not implemented")
+func (UnimplementedConfigServer) CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateTemplateSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSet not implemented")
 }
-func (UnimplementedConfigServer) ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedConfigItems not implemented")
+func (UnimplementedConfigServer) DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*DeleteTemplateSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSet not implemented")
 }
-func (UnimplementedConfigServer) ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemCount not implemented")
+func (UnimplementedConfigServer) UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*UpdateTemplateSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSet not implemented")
 }
-func (UnimplementedConfigServer) ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemByTuple not implemented")
+func (UnimplementedConfigServer) ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSets not implemented")
 }
-func (UnimplementedConfigServer) GetReleasedKv(context.Context, *GetReleasedKvReq) (*GetReleasedKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedKv not implemented")
+func (UnimplementedConfigServer) GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTemplateVersionsInSpace not implemented")
 }
-func (UnimplementedConfigServer) ListReleasedKvs(context.Context, *ListReleasedKvsReq) (*ListReleasedKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedKvs not implemented")
+func (UnimplementedConfigServer) ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateSets not implemented")
+}
+func (UnimplementedConfigServer) ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsByIDs not implemented")
+}
+func (UnimplementedConfigServer) ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetsOfBiz not implemented")
+}
+func (UnimplementedConfigServer) CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateAppTemplateBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppTemplateBinding not implemented")
+}
+func (UnimplementedConfigServer) DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*DeleteAppTemplateBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppTemplateBinding not implemented")
+}
+func (UnimplementedConfigServer) UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*UpdateAppTemplateBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTemplateBinding not implemented")
+}
+func (UnimplementedConfigServer) ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateBindings not implemented")
+}
+func (UnimplementedConfigServer) ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppBoundTmplRevisions not implemented")
+}
+func (UnimplementedConfigServer) ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppBoundTmplRevisions not implemented")
+}
+func (UnimplementedConfigServer) GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppBoundTmplRevision not implemented")
+}
+func (UnimplementedConfigServer) UpdateAppBoundTmplRevisions(context.Context, *UpdateAppBoundTmplRevisionsReq) (*UpdateAppBoundTmplRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppBoundTmplRevisions not implemented")
+}
+func (UnimplementedConfigServer) DeleteAppBoundTmplSets(context.Context, *DeleteAppBoundTmplSetsReq) (*DeleteAppBoundTmplSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppBoundTmplSets not implemented")
+}
+func (UnimplementedConfigServer) RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*RemoveAppBoundTmplSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppBoundTmplSet not implemented")
+}
+func (UnimplementedConfigServer) CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAppTemplateBinding not implemented")
+}
+func (UnimplementedConfigServer) ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*ImportFromTemplateSetToAppResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromTemplateSetToApp not implemented")
+}
+func (UnimplementedConfigServer) ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundCounts not implemented")
+}
+func (UnimplementedConfigServer) ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundCounts not implemented")
+}
+func (UnimplementedConfigServer) ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundCounts not implemented")
+}
+func (UnimplementedConfigServer) ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundUnnamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundNamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundTmplSets not implemented")
+}
+func (UnimplementedConfigServer) ListMultiTmplBoundTmplSets(context.Context, *ListMultiTmplBoundTmplSetsReq) (*ListMultiTmplBoundTmplSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplBoundTmplSets not implemented")
+}
+func (UnimplementedConfigServer) ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundUnnamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundNamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundUnnamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplSetBoundUnnamedApps not implemented")
+}
+func (UnimplementedConfigServer) CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTemplateSetReferencesApps not implemented")
+}
+func (UnimplementedConfigServer) ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundNamedApps not implemented")
+}
+func (UnimplementedConfigServer) ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLatestTmplBoundUnnamedApps not implemented")
+}
+func (UnimplementedConfigServer) CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateTemplateVariableResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateVariable not implemented")
+}
+func (UnimplementedConfigServer) DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*DeleteTemplateVariableResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateVariable not implemented")
+}
+func (UnimplementedConfigServer) BatchDeleteTemplateVariable(context.Context, *BatchDeleteBizResourcesReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplateVariable not implemented")
+}
+func (UnimplementedConfigServer) UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*UpdateTemplateVariableResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateVariable not implemented")
+}
+func (UnimplementedConfigServer) ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateVariables not implemented")
+}
+func (UnimplementedConfigServer) ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateVariables not implemented")
+}
+func (UnimplementedConfigServer) ImportOtherFormatTemplateVariables(context.Context, *ImportOtherFormatTemplateVariablesReq) (*ImportOtherFormatTemplateVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportOtherFormatTemplateVariables not implemented")
+}
+func (UnimplementedConfigServer) ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractAppTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppTmplVariableRefs not implemented")
+}
+func (UnimplementedConfigServer) GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppTmplVariableRefs not implemented")
+}
+func (UnimplementedConfigServer) UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*UpdateAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
+}
+func (UnimplementedConfigServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedConfigServer) DeleteGroup(context.Context, *DeleteGroupReq) (*DeleteGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedConfigServer) BatchDeleteGroups(context.Context, *BatchDeleteBizResourcesReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteGroups not implemented")
+}
+func (UnimplementedConfigServer) UpdateGroup(context.Context, *UpdateGroupReq) (*UpdateGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedConfigServer) ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllGroups not implemented")
+}
+func (UnimplementedConfigServer) ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppGroups not implemented")
+}
+func (UnimplementedConfigServer) ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupReleasedApps not implemented")
+}
+func (UnimplementedConfigServer) GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByName not implemented")
+}
+func (UnimplementedConfigServer) ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSelector not implemented")
+}
+func (UnimplementedConfigServer) PreviewGroupClients(context.Context, *PreviewGroupClientsReq) (*PreviewGroupClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewGroupClients not implemented")
+}
+func (UnimplementedConfigServer) PreviewClientRelease(context.Context, *PreviewClientReleaseReq) (*client_preview.ClientReleasePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewClientRelease not implemented")
+}
+func (UnimplementedConfigServer) Publish(context.Context, *PublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedConfigServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
 }
-func (UnimplementedConfigServer) ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllReleasedConfigItems not implemented")
+func (UnimplementedConfigServer) BulkRelease(context.Context, *BulkReleaseReq) (*BulkReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRelease not implemented")
 }
-func (UnimplementedConfigServer) UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*UpdateConfigHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigHook not implemented")
+func (UnimplementedConfigServer) GetOperation(context.Context, *GetOperationReq) (*GetOperationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
 }
-func (UnimplementedConfigServer) CreateRelease(context.Context, *CreateReleaseReq) (*CreateReleaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRelease not implemented")
+func (UnimplementedConfigServer) ListOperations(context.Context, *ListOperationsReq) (*ListOperationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
 }
-func (UnimplementedConfigServer) ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+func (UnimplementedConfigServer) CancelOperation(context.Context, *CancelOperationReq) (*CancelOperationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
 }
-func (UnimplementedConfigServer) GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseByName not implemented")
+func (UnimplementedConfigServer) SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublishApprove not implemented")
 }
-func (UnimplementedConfigServer) GetRelease(context.Context, *GetReleaseReq) (*release.Release, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRelease not implemented")
+func (UnimplementedConfigServer) Approve(context.Context, *ApproveReq) (*ApproveResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
 }
-func (UnimplementedConfigServer) DeprecateRelease(context.Context, *DeprecateReleaseReq) (*DeprecateReleaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeprecateRelease not implemented")
+func (UnimplementedConfigServer) EmergencyPublish(context.Context, *EmergencyPublishReq) (*EmergencyPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyPublish not implemented")
 }
-func (UnimplementedConfigServer) UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*UnDeprecateReleaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeprecateRelease not implemented")
+func (UnimplementedConfigServer) ListEmergencyPublishes(context.Context, *ListEmergencyPublishesReq) (*ListEmergencyPublishesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyPublishes not implemented")
 }
-func (UnimplementedConfigServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*DeleteReleaseResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
+func (UnimplementedConfigServer) RevokeEmergencyPublish(context.Context, *RevokeEmergencyPublishReq) (*RevokeEmergencyPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEmergencyPublish not implemented")
 }
-func (UnimplementedConfigServer) CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckReleaseName not implemented")
+func (UnimplementedConfigServer) PinReleasedGroup(context.Context, *PinReleasedGroupReq) (*PinReleasedGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinReleasedGroup not implemented")
 }
-func (UnimplementedConfigServer) CreateHook(context.Context, *CreateHookReq) (*CreateHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
+func (UnimplementedConfigServer) UnpinReleasedGroup(context.Context, *UnpinReleasedGroupReq) (*UnpinReleasedGroupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinReleasedGroup not implemented")
 }
-func (UnimplementedConfigServer) DeleteHook(context.Context, *DeleteHookReq) (*DeleteHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHook not implemented")
+func (UnimplementedConfigServer) GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastSelect not implemented")
 }
-func (UnimplementedConfigServer) BatchDeleteHook(context.Context, *BatchDeleteHookReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteHook not implemented")
+func (UnimplementedConfigServer) GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastPublish not implemented")
 }
-func (UnimplementedConfigServer) UpdateHook(context.Context, *UpdateHookReq) (*UpdateHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHook not implemented")
+func (UnimplementedConfigServer) GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasesStatus not implemented")
 }
-func (UnimplementedConfigServer) ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHooks not implemented")
+func (UnimplementedConfigServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
 }
-func (UnimplementedConfigServer) ListHookTags(context.Context, *ListHookTagsReq) (*ListHookTagsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookTags not implemented")
+func (UnimplementedConfigServer) ListAppTimeline(context.Context, *ListAppTimelineReq) (*ListAppTimelineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTimeline not implemented")
 }
-func (UnimplementedConfigServer) GetHook(context.Context, *GetHookReq) (*GetHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHook not implemented")
+func (UnimplementedConfigServer) CreateCredentials(context.Context, *CreateCredentialReq) (*CreateCredentialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredentials not implemented")
 }
-func (UnimplementedConfigServer) CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateHookRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHookRevision not implemented")
+func (UnimplementedConfigServer) ListCredentials(context.Context, *ListCredentialsReq) (*ListCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
 }
-func (UnimplementedConfigServer) ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisions not implemented")
+func (UnimplementedConfigServer) DeleteCredential(context.Context, *DeleteCredentialsReq) (*DeleteCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
-func (UnimplementedConfigServer) DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*DeleteHookRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookRevision not implemented")
+func (UnimplementedConfigServer) UpdateCredential(context.Context, *UpdateCredentialsReq) (*UpdateCredentialsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
 }
-func (UnimplementedConfigServer) PublishHookRevision(context.Context, *PublishHookRevisionReq) (*PublishHookRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishHookRevision not implemented")
+func (UnimplementedConfigServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
 }
-func (UnimplementedConfigServer) GetHookRevision(context.Context, *GetHookRevisionReq) (*hook_revision.HookRevision, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevision not implemented")
+func (UnimplementedConfigServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
 }
-func (UnimplementedConfigServer) UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*UpdateHookRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookRevision not implemented")
+func (UnimplementedConfigServer) UpdateCredentialScope(context.Context, *UpdateCredentialScopeReq) (*UpdateCredentialScopeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScope not implemented")
 }
-func (UnimplementedConfigServer) ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookReferences not implemented")
+func (UnimplementedConfigServer) PauseCredentialConsumption(context.Context, *PauseCredentialConsumptionReq) (*PauseCredentialConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCredentialConsumption not implemented")
 }
-func (UnimplementedConfigServer) ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisionReferences not implemented")
+func (UnimplementedConfigServer) ResumeCredentialConsumption(context.Context, *ResumeCredentialConsumptionReq) (*ResumeCredentialConsumptionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCredentialConsumption not implemented")
 }
-func (UnimplementedConfigServer) GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHook not implemented")
+func (UnimplementedConfigServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
 }
-func (UnimplementedConfigServer) CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateTemplateSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSpace not implemented")
+func (UnimplementedConfigServer) CreateKv(context.Context, *CreateKvReq) (*CreateKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
 }
-func (UnimplementedConfigServer) DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*DeleteTemplateSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpace not implemented")
+func (UnimplementedConfigServer) UpdateKv(context.Context, *UpdateKvReq) (*UpdateKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
 }
-func (UnimplementedConfigServer) UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*UpdateTemplateSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSpace not implemented")
+func (UnimplementedConfigServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaces not implemented")
+func (UnimplementedConfigServer) DeleteKv(context.Context, *DeleteKvReq) (*DeleteKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
 }
-func (UnimplementedConfigServer) GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBizsOfTmplSpaces not implemented")
+func (UnimplementedConfigServer) BatchDeleteKv(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteKv not implemented")
 }
-func (UnimplementedConfigServer) CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateDefaultTmplSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultTmplSpace not implemented")
+func (UnimplementedConfigServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
 }
-func (UnimplementedConfigServer) ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSpacesByIDs not implemented")
+func (UnimplementedConfigServer) TransactKvs(context.Context, *TransactKvsReq) (*TransactKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactKvs not implemented")
 }
-func (UnimplementedConfigServer) CreateTemplate(context.Context, *CreateTemplateReq) (*CreateTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+func (UnimplementedConfigServer) ListKvHistory(context.Context, *ListKvHistoryReq) (*ListKvHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvHistory not implemented")
 }
-func (UnimplementedConfigServer) DeleteTemplate(context.Context, *DeleteTemplateReq) (*DeleteTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+func (UnimplementedConfigServer) DiffKvVersions(context.Context, *DiffKvVersionsReq) (*DiffKvVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffKvVersions not implemented")
 }
-func (UnimplementedConfigServer) BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*BatchDeleteTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplate not implemented")
+func (UnimplementedConfigServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*UnDeleteKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
 }
-func (UnimplementedConfigServer) UpdateTemplate(context.Context, *UpdateTemplateReq) (*UpdateTemplateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+func (UnimplementedConfigServer) BatchUnDeleteKv(context.Context, *BatchUnDeleteKvReq) (*BatchUnDeleteKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUnDeleteKv not implemented")
 }
-func (UnimplementedConfigServer) ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+func (UnimplementedConfigServer) UndoKv(context.Context, *UndoKvReq) (*UndoKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
 }
-func (UnimplementedConfigServer) BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertTemplates not implemented")
+func (UnimplementedConfigServer) ImportKvs(context.Context, *ImportKvsReq) (*ImportKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportKvs not implemented")
 }
-func (UnimplementedConfigServer) BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTemplatePermissions not implemented")
+func (UnimplementedConfigServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
 }
-func (UnimplementedConfigServer) AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*AddTmplsToTmplSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTmplsToTmplSets not implemented")
+func (UnimplementedConfigServer) CompareKvValue(context.Context, *CompareKvValueReq) (*CompareKvValueResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKvValue not implemented")
 }
-func (UnimplementedConfigServer) DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTmplsFromTmplSets not implemented")
+func (UnimplementedConfigServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
 }
-func (UnimplementedConfigServer) ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesByIDs not implemented")
+func (UnimplementedConfigServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
 }
-func (UnimplementedConfigServer) ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesNotBound not implemented")
+func (UnimplementedConfigServer) RetryClients(context.Context, *RetryClientsReq) (*RetryClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateByTuple not implemented")
+func (UnimplementedConfigServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
 }
-func (UnimplementedConfigServer) ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplsOfTmplSet not implemented")
+func (UnimplementedConfigServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsAndRevisions not implemented")
+func (UnimplementedConfigServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*UpdateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
 }
-func (UnimplementedConfigServer) CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateTemplateRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateRevision not implemented")
+func (UnimplementedConfigServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*DeleteClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
 }
-func (UnimplementedConfigServer) UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*UpdateTemplateRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateRevision not implemented")
+func (UnimplementedConfigServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisions not implemented")
+func (UnimplementedConfigServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
 }
-func (UnimplementedConfigServer) GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateRevision not implemented")
+func (UnimplementedConfigServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisionsByIDs not implemented")
+func (UnimplementedConfigServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
 }
-func (UnimplementedConfigServer) ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionNamesByTmplIDs not implemented")
+func (UnimplementedConfigServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
 }
-func (UnimplementedConfigServer) CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateTemplateSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSet not implemented")
+func (UnimplementedConfigServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
 }
-func (UnimplementedConfigServer) DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*DeleteTemplateSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSet not implemented")
+func (UnimplementedConfigServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
 }
-func (UnimplementedConfigServer) UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*UpdateTemplateSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSet not implemented")
+func (UnimplementedConfigServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSets not implemented")
+func (UnimplementedConfigServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
 }
-func (UnimplementedConfigServer) GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTemplateVersionsInSpace not implemented")
+func (UnimplementedConfigServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
 }
-func (UnimplementedConfigServer) ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateSets not implemented")
+func (UnimplementedConfigServer) CompareKvConflicts(context.Context, *CompareKvConflictsReq) (*CompareKvConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKvConflicts not implemented")
 }
-func (UnimplementedConfigServer) ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsByIDs not implemented")
+func (UnimplementedConfigServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
 }
-func (UnimplementedConfigServer) ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetsOfBiz not implemented")
+
+// UnsafeConfigServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ConfigServer will
+// result in compilation errors.
+type UnsafeConfigServer interface {
+	mustEmbedUnimplementedConfigServer()
 }
-func (UnimplementedConfigServer) CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateAppTemplateBindingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAppTemplateBinding not implemented")
+
+func RegisterConfigServer(s grpc.ServiceRegistrar, srv ConfigServer) {
+	s.RegisterService(&Config_ServiceDesc, srv)
 }
-func (UnimplementedConfigServer) DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*DeleteAppTemplateBindingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppTemplateBinding not implemented")
+
+func _Config_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateApp(ctx, req.(*CreateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*UpdateAppTemplateBindingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTemplateBinding not implemented")
+
+func _Config_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateApp(ctx, req.(*UpdateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateBindings not implemented")
+
+func _Config_UpdateAppState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateAppState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateAppState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateAppState(ctx, req.(*UpdateAppStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppBoundTmplRevisions not implemented")
+
+func _Config_PauseAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseAppConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PauseAppConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PauseAppConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PauseAppConsumption(ctx, req.(*PauseAppConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppBoundTmplRevisions not implemented")
+
+func _Config_ResumeAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeAppConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ResumeAppConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ResumeAppConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ResumeAppConsumption(ctx, req.(*ResumeAppConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppBoundTmplRevision not implemented")
+
+func _Config_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteApp(ctx, req.(*DeleteAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateAppBoundTmplRevisions(context.Context, *UpdateAppBoundTmplRevisionsReq) (*UpdateAppBoundTmplRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppBoundTmplRevisions not implemented")
+
+func _Config_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetApp(ctx, req.(*GetAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteAppBoundTmplSets(context.Context, *DeleteAppBoundTmplSetsReq) (*DeleteAppBoundTmplSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppBoundTmplSets not implemented")
+
+func _Config_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetAppByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetAppByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*RemoveAppBoundTmplSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppBoundTmplSet not implemented")
+
+func _Config_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppsRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppsRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckAppTemplateBinding not implemented")
+
+func _Config_ListAppsBySpaceRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsBySpaceRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppsBySpaceRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, req.(*ListAppsBySpaceRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*ImportFromTemplateSetToAppResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportFromTemplateSetToApp not implemented")
+
+func _Config_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundCounts not implemented")
+
+func _Config_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchUpsertConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundCounts not implemented")
+
+func _Config_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundCounts not implemented")
+
+func _Config_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundUnnamedApps not implemented")
+
+func _Config_BatchDeleteConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAppResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchDeleteConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, req.(*BatchDeleteAppResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundNamedApps not implemented")
+
+func _Config_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundTmplSets not implemented")
+
+func _Config_BatchUnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchUnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, req.(*BatchUnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListMultiTmplBoundTmplSets(context.Context, *ListMultiTmplBoundTmplSetsReq) (*ListMultiTmplBoundTmplSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplBoundTmplSets not implemented")
+
+func _Config_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UndoConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UndoConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundUnnamedApps not implemented")
+
+func _Config_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundNamedApps not implemented")
+
+func _Config_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleasedConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleasedConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleasedConfigItem(ctx, req.(*GetReleasedConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundUnnamedApps not implemented")
+
+func _Config_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplSetBoundUnnamedApps not implemented")
+
+func _Config_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckTemplateSetReferencesApps not implemented")
+
+func _Config_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundNamedApps not implemented")
+
+func _Config_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemByTupleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigItemByTuple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigItemByTuple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLatestTmplBoundUnnamedApps not implemented")
+
+func _Config_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleasedKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleasedKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateTemplateVariableResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateVariable not implemented")
+
+func _Config_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleasedKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleasedKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleasedKvs(ctx, req.(*ListReleasedKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*DeleteTemplateVariableResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateVariable not implemented")
+
+func _Config_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAllReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BatchDeleteTemplateVariable(context.Context, *BatchDeleteBizResourcesReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplateVariable not implemented")
+
+func _Config_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*UpdateTemplateVariableResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateVariable not implemented")
+
+func _Config_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListReleases(ctx, req.(*ListReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateVariables not implemented")
+
+func _Config_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleaseByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleaseByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateVariables not implemented")
+
+func _Config_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetRelease(ctx, req.(*GetReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ImportOtherFormatTemplateVariables(context.Context, *ImportOtherFormatTemplateVariablesReq) (*ImportOtherFormatTemplateVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportOtherFormatTemplateVariables not implemented")
+
+func _Config_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtractAppTmplVariables not implemented")
+
+func _Config_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnDeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnDeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppTmplVariableRefs not implemented")
+
+func _Config_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppTmplVariableRefs not implemented")
+
+func _Config_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReleaseNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CheckReleaseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CheckReleaseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*UpdateAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTmplVariables not implemented")
+
+func _Config_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHook(ctx, req.(*CreateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTmplVariables not implemented")
+
+func _Config_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteHook(ctx, req.(*DeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
+
+func _Config_BatchDeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchDeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchDeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchDeleteHook(ctx, req.(*BatchDeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateGroupResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+
+func _Config_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateHook(ctx, req.(*UpdateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteGroup(context.Context, *DeleteGroupReq) (*DeleteGroupResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+func _Config_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHooks(ctx, req.(*ListHooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BatchDeleteGroups(context.Context, *BatchDeleteBizResourcesReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteGroups not implemented")
+
+func _Config_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTagsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookTags(ctx, req.(*ListHookTagsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateGroup(context.Context, *UpdateGroupReq) (*UpdateGroupResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+
+func _Config_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetHook(ctx, req.(*GetHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllGroups not implemented")
+
+func _Config_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppGroups not implemented")
+
+func _Config_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroupReleasedApps not implemented")
+
+func _Config_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByName not implemented")
+
+func _Config_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PublishHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PublishHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSelector not implemented")
+
+func _Config_GetHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetHookRevision(ctx, req.(*GetHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) Publish(context.Context, *PublishReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+
+func _Config_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
+
+func _Config_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublishApprove not implemented")
+
+func _Config_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookRevisionReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookRevisionReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) Approve(context.Context, *ApproveReq) (*ApproveResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+
+func _Config_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetReleaseHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetReleaseHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastSelect not implemented")
+
+func _Config_ListHookExecResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookExecResultsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookExecResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookExecResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookExecResults(ctx, req.(*ListHookExecResultsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastPublish not implemented")
+
+func _Config_ListFileTamperEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileTamperEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListFileTamperEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListFileTamperEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListFileTamperEvents(ctx, req.(*ListFileTamperEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasesStatus not implemented")
+
+func _Config_CreateHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHookTemplate(ctx, req.(*CreateHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
+
+func _Config_ListHookTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListHookTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListHookTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListHookTemplates(ctx, req.(*ListHookTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateCredentials(context.Context, *CreateCredentialReq) (*CreateCredentialResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCredentials not implemented")
+
+func _Config_UpdateHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateHookTemplate(ctx, req.(*UpdateHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListCredentials(context.Context, *ListCredentialsReq) (*ListCredentialsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+
+func _Config_DeleteHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteHookTemplate(ctx, req.(*DeleteHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CreateHookFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateHookFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateHookFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateHookFromTemplate(ctx, req.(*CreateHookFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteCredential(context.Context, *DeleteCredentialsReq) (*DeleteCredentialsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+
+func _Config_UpgradeHookFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeHookFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpgradeHookFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpgradeHookFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpgradeHookFromTemplate(ctx, req.(*UpgradeHookFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateCredential(context.Context, *UpdateCredentialsReq) (*UpdateCredentialsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+
+func _Config_UpgradeHooksFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeHooksFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpgradeHooksFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpgradeHooksFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpgradeHooksFromTemplate(ctx, req.(*UpgradeHooksFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
+
+func _Config_GetHookTemplateRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookTemplateRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetHookTemplateRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetHookTemplateRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetHookTemplateRef(ctx, req.(*GetHookTemplateRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
+
+func _Config_CreateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateEnvironment(ctx, req.(*CreateEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateCredentialScope(context.Context, *UpdateCredentialScopeReq) (*UpdateCredentialScopeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScope not implemented")
+
+func _Config_ListEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvironmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListEnvironments(ctx, req.(*ListEnvironmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
+
+func _Config_UpdateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateEnvironment(ctx, req.(*UpdateEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateKv(context.Context, *CreateKvReq) (*CreateKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
+
+func _Config_DeleteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteEnvironment(ctx, req.(*DeleteEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateKv(context.Context, *UpdateKvReq) (*UpdateKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
+
+func _Config_BindEnvApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindEnvAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BindEnvApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BindEnvApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BindEnvApp(ctx, req.(*BindEnvAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
+
+func _Config_UnbindEnvApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbindEnvAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnbindEnvApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnbindEnvApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnbindEnvApp(ctx, req.(*UnbindEnvAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteKv(context.Context, *DeleteKvReq) (*DeleteKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
+
+func _Config_ListEnvApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvAppsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListEnvApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListEnvApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListEnvApps(ctx, req.(*ListEnvAppsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BatchDeleteKv(context.Context, *BatchDeleteAppResourcesReq) (*BatchDeleteResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteKv not implemented")
+
+func _Config_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
+
+func _Config_CreateConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigShare(ctx, req.(*CreateConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*UnDeleteKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
+
+func _Config_UpdateConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpdateConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpdateConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpdateConfigShare(ctx, req.(*UpdateConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) BatchUnDeleteKv(context.Context, *BatchUnDeleteKvReq) (*BatchUnDeleteKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUnDeleteKv not implemented")
+
+func _Config_DeleteConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigShare(ctx, req.(*DeleteConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UndoKv(context.Context, *UndoKvReq) (*UndoKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
+
+func _Config_ListConfigShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSharesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigShares(ctx, req.(*ListConfigSharesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ImportKvs(context.Context, *ImportKvsReq) (*ImportKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportKvs not implemented")
+
+func _Config_ListSharedConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSharedConfigsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListSharedConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListSharedConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListSharedConfigs(ctx, req.(*ListSharedConfigsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
+
+func _Config_CreateConfigShareRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigShareRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigShareRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigShareRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigShareRef(ctx, req.(*CreateConfigShareRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+
+func _Config_DeleteConfigShareRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigShareRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigShareRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigShareRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigShareRef(ctx, req.(*DeleteConfigShareRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+
+func _Config_ListConfigShareRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigShareRefsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListConfigShareRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListConfigShareRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListConfigShareRefs(ctx, req.(*ListConfigShareRefsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) RetryClients(context.Context, *RetryClientsReq) (*RetryClientsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+
+func _Config_ListRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecycleBinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListRecycleBin(ctx, req.(*ListRecycleBinReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+
+func _Config_RestoreRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecycleBinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).RestoreRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_RestoreRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).RestoreRecycleBin(ctx, req.(*RestoreRecycleBinReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+
+func _Config_CreateAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateAppSnapshot(ctx, req.(*CreateAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*UpdateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+
+func _Config_ListAppSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppSnapshots(ctx, req.(*ListAppSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*DeleteClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+
+func _Config_DeleteAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteAppSnapshot(ctx, req.(*DeleteAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+
+func _Config_RestoreAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).RestoreAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_RestoreAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).RestoreAppSnapshot(ctx, req.(*RestoreAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+
+func _Config_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CloneApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CloneApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CloneApp(ctx, req.(*CloneAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+
+func _Config_UpsertDraftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDraftItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UpsertDraftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UpsertDraftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UpsertDraftItem(ctx, req.(*UpsertDraftItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+
+func _Config_DeleteDraftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteDraftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteDraftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteDraftItem(ctx, req.(*DeleteDraftItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+
+func _Config_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetDraft(ctx, req.(*GetDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+
+func _Config_MergeDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).MergeDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_MergeDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).MergeDraft(ctx, req.(*MergeDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+
+func _Config_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DiscardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DiscardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DiscardDraft(ctx, req.(*DiscardDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+
+func _Config_SearchFullText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFullTextReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).SearchFullText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_SearchFullText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).SearchFullText(ctx, req.(*SearchFullTextReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+
+func _Config_ListTmplWhereUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplWhereUsedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListTmplWhereUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListTmplWhereUsed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListTmplWhereUsed(ctx, req.(*ListTmplWhereUsedReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+
+func _Config_ListTmplVariableWhereUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplVariableWhereUsedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListTmplVariableWhereUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListTmplVariableWhereUsed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListTmplVariableWhereUsed(ctx, req.(*ListTmplVariableWhereUsedReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) CompareKvConflicts(context.Context, *CompareKvConflictsReq) (*CompareKvConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareKvConflicts not implemented")
+
+func _Config_CreateConfigReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigReferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CreateConfigReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CreateConfigReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CreateConfigReference(ctx, req.(*CreateConfigReferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedConfigServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+
+func _Config_DeleteConfigReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigReferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).DeleteConfigReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteConfigReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteConfigReference(ctx, req.(*DeleteConfigReferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeConfigServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ConfigServer will
-// result in compilation errors.
-type UnsafeConfigServer interface {
-	mustEmbedUnimplementedConfigServer()
+func _Config_GetConfigRefGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRefGraphReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetConfigRefGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetConfigRefGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetConfigRefGraph(ctx, req.(*GetConfigRefGraphReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterConfigServer(s grpc.ServiceRegistrar, srv ConfigServer) {
-	s.RegisterService(&Config_ServiceDesc, srv)
+func _Config_ListExpiringCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringCertificatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListExpiringCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListExpiringCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListExpiringCertificates(ctx, req.(*ListExpiringCertificatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppReq)
+func _Config_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateApp(ctx, in)
+		return srv.(ConfigServer).RenewCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateApp_FullMethodName,
+		FullMethod: Config_RenewCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateApp(ctx, req.(*CreateAppReq))
+		return srv.(ConfigServer).RenewCertificate(ctx, req.(*RenewCertificateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppReq)
+func _Config_CreateKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateApp(ctx, in)
+		return srv.(ConfigServer).CreateKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateApp_FullMethodName,
+		FullMethod: Config_CreateKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateApp(ctx, req.(*UpdateAppReq))
+		return srv.(ConfigServer).CreateKvOverride(ctx, req.(*CreateKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppReq)
+func _Config_UpdateKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteApp(ctx, in)
+		return srv.(ConfigServer).UpdateKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteApp_FullMethodName,
+		FullMethod: Config_UpdateKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteApp(ctx, req.(*DeleteAppReq))
+		return srv.(ConfigServer).UpdateKvOverride(ctx, req.(*UpdateKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppReq)
+func _Config_DeleteKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetApp(ctx, in)
+		return srv.(ConfigServer).DeleteKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetApp_FullMethodName,
+		FullMethod: Config_DeleteKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetApp(ctx, req.(*GetAppReq))
+		return srv.(ConfigServer).DeleteKvOverride(ctx, req.(*DeleteKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByNameReq)
+func _Config_ListKvOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvOverridesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetAppByName(ctx, in)
+		return srv.(ConfigServer).ListKvOverrides(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetAppByName_FullMethodName,
+		FullMethod: Config_ListKvOverrides_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+		return srv.(ConfigServer).ListKvOverrides(ctx, req.(*ListKvOverridesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsRestReq)
+func _Config_CreateCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppsRest(ctx, in)
+		return srv.(ConfigServer).CreateCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppsRest_FullMethodName,
+		FullMethod: Config_CreateCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+		return srv.(ConfigServer).CreateCompliancePolicy(ctx, req.(*CreateCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAppsBySpaceRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsBySpaceRestReq)
+func _Config_ListCompliancePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompliancePoliciesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, in)
+		return srv.(ConfigServer).ListCompliancePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAppsBySpaceRest_FullMethodName,
+		FullMethod: Config_ListCompliancePolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAppsBySpaceRest(ctx, req.(*ListAppsBySpaceRestReq))
+		return srv.(ConfigServer).ListCompliancePolicies(ctx, req.(*ListCompliancePoliciesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConfigItemReq)
+func _Config_UpdateCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateConfigItem(ctx, in)
+		return srv.(ConfigServer).UpdateCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateConfigItem_FullMethodName,
+		FullMethod: Config_UpdateCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+		return srv.(ConfigServer).UpdateCompliancePolicy(ctx, req.(*UpdateCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertConfigItemsReq)
+func _Config_DeleteCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, in)
+		return srv.(ConfigServer).DeleteCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpsertConfigItems_FullMethodName,
+		FullMethod: Config_DeleteCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+		return srv.(ConfigServer).DeleteCompliancePolicy(ctx, req.(*DeleteCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigItemReq)
+func _Config_TestCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateConfigItem(ctx, in)
+		return srv.(ConfigServer).TestCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateConfigItem_FullMethodName,
+		FullMethod: Config_TestCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+		return srv.(ConfigServer).TestCompliancePolicy(ctx, req.(*TestCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigItemReq)
+func _Config_CreateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).CreateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteConfigItem_FullMethodName,
+		FullMethod: Config_CreateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+		return srv.(ConfigServer).CreateNotificationChannel(ctx, req.(*CreateNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteAppResourcesReq)
+func _Config_ListNotificationChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationChannelsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, in)
+		return srv.(ConfigServer).ListNotificationChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteConfigItems_FullMethodName,
+		FullMethod: Config_ListNotificationChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteConfigItems(ctx, req.(*BatchDeleteAppResourcesReq))
+		return srv.(ConfigServer).ListNotificationChannels(ctx, req.(*ListNotificationChannelsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteConfigItemReq)
+func _Config_UpdateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UnDeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).UpdateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UnDeleteConfigItem_FullMethodName,
+		FullMethod: Config_UpdateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+		return srv.(ConfigServer).UpdateNotificationChannel(ctx, req.(*UpdateNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUnDeleteConfigItemReq)
+func _Config_DeleteNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, in)
+		return srv.(ConfigServer).DeleteNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUnDeleteConfigItem_FullMethodName,
+		FullMethod: Config_DeleteNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUnDeleteConfigItem(ctx, req.(*BatchUnDeleteConfigItemReq))
+		return srv.(ConfigServer).DeleteNotificationChannel(ctx, req.(*DeleteNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoConfigItemReq)
+func _Config_TestNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UndoConfigItem(ctx, in)
+		return srv.(ConfigServer).TestNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UndoConfigItem_FullMethodName,
+		FullMethod: Config_TestNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+		return srv.(ConfigServer).TestNotificationChannel(ctx, req.(*TestNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigItemReq)
+func _Config_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetConfigItem(ctx, in)
+		return srv.(ConfigServer).CreateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetConfigItem_FullMethodName,
+		FullMethod: Config_CreateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+		return srv.(ConfigServer).CreateAlertRule(ctx, req.(*CreateAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedConfigItemReq)
+func _Config_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedConfigItem(ctx, in)
+		return srv.(ConfigServer).ListAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedConfigItem_FullMethodName,
+		FullMethod: Config_ListAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedConfigItem(ctx, req.(*GetReleasedConfigItemReq))
+		return srv.(ConfigServer).ListAlertRules(ctx, req.(*ListAlertRulesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemsReq)
+func _Config_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItems(ctx, in)
+		return srv.(ConfigServer).UpdateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItems_FullMethodName,
+		FullMethod: Config_UpdateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+		return srv.(ConfigServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedConfigItemsReq)
+func _Config_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedConfigItems(ctx, in)
+		return srv.(ConfigServer).DeleteAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedConfigItems_FullMethodName,
+		FullMethod: Config_DeleteAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+		return srv.(ConfigServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemCountReq)
+func _Config_CreateClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItemCount(ctx, in)
+		return srv.(ConfigServer).CreateClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItemCount_FullMethodName,
+		FullMethod: Config_CreateClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+		return srv.(ConfigServer).CreateClientQuarantine(ctx, req.(*CreateClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemByTupleReq)
+func _Config_ListClientQuarantines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientQuarantinesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListConfigItemByTuple(ctx, in)
+		return srv.(ConfigServer).ListClientQuarantines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListConfigItemByTuple_FullMethodName,
+		FullMethod: Config_ListClientQuarantines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+		return srv.(ConfigServer).ListClientQuarantines(ctx, req.(*ListClientQuarantinesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedKvReq)
+func _Config_UpdateClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleasedKv(ctx, in)
+		return srv.(ConfigServer).UpdateClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleasedKv_FullMethodName,
+		FullMethod: Config_UpdateClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+		return srv.(ConfigServer).UpdateClientQuarantine(ctx, req.(*UpdateClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedKvsReq)
+func _Config_DeleteClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleasedKvs(ctx, in)
+		return srv.(ConfigServer).DeleteClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleasedKvs_FullMethodName,
+		FullMethod: Config_DeleteClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleasedKvs(ctx, req.(*ListReleasedKvsReq))
+		return srv.(ConfigServer).DeleteClientQuarantine(ctx, req.(*DeleteClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllReleasedConfigItemsReq)
+func _Config_CreateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, in)
+		return srv.(ConfigServer).CreateExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListAllReleasedConfigItems_FullMethodName,
+		FullMethod: Config_CreateExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+		return srv.(ConfigServer).CreateExperiment(ctx, req.(*CreateExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigHookReq)
+func _Config_ListExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperimentsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateConfigHook(ctx, in)
+		return srv.(ConfigServer).ListExperiments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateConfigHook_FullMethodName,
+		FullMethod: Config_ListExperiments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+		return srv.(ConfigServer).ListExperiments(ctx, req.(*ListExperimentsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReleaseReq)
+func _Config_UpdateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateRelease(ctx, in)
+		return srv.(ConfigServer).UpdateExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateRelease_FullMethodName,
+		FullMethod: Config_UpdateExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+		return srv.(ConfigServer).UpdateExperiment(ctx, req.(*UpdateExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasesReq)
+func _Config_DeleteExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListReleases(ctx, in)
+		return srv.(ConfigServer).DeleteExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListReleases_FullMethodName,
+		FullMethod: Config_DeleteExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListReleases(ctx, req.(*ListReleasesReq))
+		return srv.(ConfigServer).DeleteExperiment(ctx, req.(*DeleteExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseByNameReq)
+func _Config_GetExperimentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentStatsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleaseByName(ctx, in)
+		return srv.(ConfigServer).GetExperimentStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleaseByName_FullMethodName,
+		FullMethod: Config_GetExperimentStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+		return srv.(ConfigServer).GetExperimentStats(ctx, req.(*GetExperimentStatsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseReq)
+func _Config_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetRelease(ctx, in)
+		return srv.(ConfigServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetRelease_FullMethodName,
+		FullMethod: Config_CreateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetRelease(ctx, req.(*GetReleaseReq))
+		return srv.(ConfigServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeprecateReleaseReq)
+func _Config_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeprecateRelease(ctx, in)
+		return srv.(ConfigServer).ListServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeprecateRelease_FullMethodName,
+		FullMethod: Config_ListServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+		return srv.(ConfigServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeprecateReleaseReq)
+func _Config_UpdateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UnDeprecateRelease(ctx, in)
+		return srv.(ConfigServer).UpdateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UnDeprecateRelease_FullMethodName,
+		FullMethod: Config_UpdateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+		return srv.(ConfigServer).UpdateServiceAccount(ctx, req.(*UpdateServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReleaseReq)
+func _Config_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteRelease(ctx, in)
+		return srv.(ConfigServer).DeleteServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteRelease_FullMethodName,
+		FullMethod: Config_DeleteServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+		return srv.(ConfigServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckReleaseNameReq)
+func _Config_ResetServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetServiceAccountKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CheckReleaseName(ctx, in)
+		return srv.(ConfigServer).ResetServiceAccountKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CheckReleaseName_FullMethodName,
+		FullMethod: Config_ResetServiceAccountKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+		return srv.(ConfigServer).ResetServiceAccountKey(ctx, req.(*ResetServiceAccountKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookReq)
+func _Config_SetAppRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateHook(ctx, in)
+		return srv.(ConfigServer).SetAppRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateHook_FullMethodName,
+		FullMethod: Config_SetAppRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateHook(ctx, req.(*CreateHookReq))
+		return srv.(ConfigServer).SetAppRoleBinding(ctx, req.(*SetAppRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookReq)
+func _Config_GrantTemporaryAppRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantTemporaryAppRoleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteHook(ctx, in)
+		return srv.(ConfigServer).GrantTemporaryAppRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteHook_FullMethodName,
+		FullMethod: Config_GrantTemporaryAppRole_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteHook(ctx, req.(*DeleteHookReq))
+		return srv.(ConfigServer).GrantTemporaryAppRole(ctx, req.(*GrantTemporaryAppRoleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteHookReq)
+func _Config_ListAppRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppRoleBindingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteHook(ctx, in)
+		return srv.(ConfigServer).ListAppRoleBindings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteHook_FullMethodName,
+		FullMethod: Config_ListAppRoleBindings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteHook(ctx, req.(*BatchDeleteHookReq))
+		return srv.(ConfigServer).ListAppRoleBindings(ctx, req.(*ListAppRoleBindingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookReq)
+func _Config_DeleteAppRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateHook(ctx, in)
+		return srv.(ConfigServer).DeleteAppRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateHook_FullMethodName,
+		FullMethod: Config_DeleteAppRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateHook(ctx, req.(*UpdateHookReq))
+		return srv.(ConfigServer).DeleteAppRoleBinding(ctx, req.(*DeleteAppRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHooksReq)
+func _Config_CheckAppPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAppPermissionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHooks(ctx, in)
+		return srv.(ConfigServer).CheckAppPermissions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHooks_FullMethodName,
+		FullMethod: Config_CheckAppPermissions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHooks(ctx, req.(*ListHooksReq))
+		return srv.(ConfigServer).CheckAppPermissions(ctx, req.(*CheckAppPermissionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookTagsReq)
+func _Config_GetMaskingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaskingProfileReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookTags(ctx, in)
+		return srv.(ConfigServer).GetMaskingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookTags_FullMethodName,
+		FullMethod: Config_GetMaskingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookTags(ctx, req.(*ListHookTagsReq))
+		return srv.(ConfigServer).GetMaskingProfile(ctx, req.(*GetMaskingProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReq)
+func _Config_UpdateMaskingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaskingProfileReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetHook(ctx, in)
+		return srv.(ConfigServer).UpdateMaskingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetHook_FullMethodName,
+		FullMethod: Config_UpdateMaskingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetHook(ctx, req.(*GetHookReq))
+		return srv.(ConfigServer).UpdateMaskingProfile(ctx, req.(*UpdateMaskingProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookRevisionReq)
+func _Config_PreviewMasking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMaskingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).CreateHookRevision(ctx, in)
+		return srv.(ConfigServer).PreviewMasking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_CreateHookRevision_FullMethodName,
+		FullMethod: Config_PreviewMasking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+		return srv.(ConfigServer).PreviewMasking(ctx, req.(*PreviewMaskingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionsReq)
+func _Config_GetSecretScanSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretScanSettingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookRevisions(ctx, in)
+		return srv.(ConfigServer).GetSecretScanSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookRevisions_FullMethodName,
+		FullMethod: Config_GetSecretScanSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+		return srv.(ConfigServer).GetSecretScanSetting(ctx, req.(*GetSecretScanSettingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookRevisionReq)
+func _Config_UpdateSecretScanSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretScanSettingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteHookRevision(ctx, in)
+		return srv.(ConfigServer).UpdateSecretScanSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteHookRevision_FullMethodName,
+		FullMethod: Config_UpdateSecretScanSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+		return srv.(ConfigServer).UpdateSecretScanSetting(ctx, req.(*UpdateSecretScanSettingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishHookRevisionReq)
+func _Config_ScanSecretLeak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanSecretLeakReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).PublishHookRevision(ctx, in)
+		return srv.(ConfigServer).ScanSecretLeak(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_PublishHookRevision_FullMethodName,
+		FullMethod: Config_ScanSecretLeak_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+		return srv.(ConfigServer).ScanSecretLeak(ctx, req.(*ScanSecretLeakReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookRevisionReq)
+func _Config_GetAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppConsumptionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetHookRevision(ctx, in)
+		return srv.(ConfigServer).GetAppConsumption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetHookRevision_FullMethodName,
+		FullMethod: Config_GetAppConsumption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetHookRevision(ctx, req.(*GetHookRevisionReq))
+		return srv.(ConfigServer).GetAppConsumption(ctx, req.(*GetAppConsumptionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookRevisionReq)
+func _Config_ListTopConsumingApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopConsumingAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).UpdateHookRevision(ctx, in)
+		return srv.(ConfigServer).ListTopConsumingApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_UpdateHookRevision_FullMethodName,
+		FullMethod: Config_ListTopConsumingApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+		return srv.(ConfigServer).ListTopConsumingApps(ctx, req.(*ListTopConsumingAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookReferencesReq)
+func _Config_ListStaleResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleResourcesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookReferences(ctx, in)
+		return srv.(ConfigServer).ListStaleResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookReferences_FullMethodName,
+		FullMethod: Config_ListStaleResources_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+		return srv.(ConfigServer).ListStaleResources(ctx, req.(*ListStaleResourcesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionReferencesReq)
+func _Config_ListBizUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBizUsagesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).ListHookRevisionReferences(ctx, in)
+		return srv.(ConfigServer).ListBizUsages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_ListHookRevisionReferences_FullMethodName,
+		FullMethod: Config_ListBizUsages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+		return srv.(ConfigServer).ListBizUsages(ctx, req.(*ListBizUsagesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseHookReq)
+func _Config_GetBizOverview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBizOverviewReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).GetReleaseHook(ctx, in)
+		return srv.(ConfigServer).GetBizOverview(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_GetReleaseHook_FullMethodName,
+		FullMethod: Config_GetBizOverview_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+		return srv.(ConfigServer).GetBizOverview(ctx, req.(*GetBizOverviewReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5453,6 +9155,42 @@ func _Config_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_PreviewGroupClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewGroupClientsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PreviewGroupClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PreviewGroupClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PreviewGroupClients(ctx, req.(*PreviewGroupClientsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_PreviewClientRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewClientReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PreviewClientRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PreviewClientRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PreviewClientRelease(ctx, req.(*PreviewClientReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishReq)
 	if err := dec(in); err != nil {
@@ -5489,6 +9227,78 @@ func _Config_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_BulkRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BulkRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BulkRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BulkRelease(ctx, req.(*BulkReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).GetOperation(ctx, req.(*GetOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListOperations(ctx, req.(*ListOperationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CancelOperation(ctx, req.(*CancelOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_SubmitPublishApprove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitPublishApproveReq)
 	if err := dec(in); err != nil {
@@ -5525,6 +9335,96 @@ func _Config_Approve_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_EmergencyPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyPublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).EmergencyPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_EmergencyPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).EmergencyPublish(ctx, req.(*EmergencyPublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ListEmergencyPublishes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyPublishesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListEmergencyPublishes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListEmergencyPublishes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListEmergencyPublishes(ctx, req.(*ListEmergencyPublishesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_RevokeEmergencyPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEmergencyPublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).RevokeEmergencyPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_RevokeEmergencyPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).RevokeEmergencyPublish(ctx, req.(*RevokeEmergencyPublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_PinReleasedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinReleasedGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PinReleasedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PinReleasedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PinReleasedGroup(ctx, req.(*PinReleasedGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_UnpinReleasedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinReleasedGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).UnpinReleasedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_UnpinReleasedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).UnpinReleasedGroup(ctx, req.(*UnpinReleasedGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_GetLastSelect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetLastSelectReq)
 	if err := dec(in); err != nil {
@@ -5597,6 +9497,24 @@ func _Config_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_ListAppTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTimelineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ListAppTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ListAppTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ListAppTimeline(ctx, req.(*ListAppTimelineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_CreateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCredentialReq)
 	if err := dec(in); err != nil {
@@ -5723,6 +9641,42 @@ func _Config_UpdateCredentialScope_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_PauseCredentialConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCredentialConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).PauseCredentialConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_PauseCredentialConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).PauseCredentialConsumption(ctx, req.(*PauseCredentialConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_ResumeCredentialConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCredentialConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).ResumeCredentialConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_ResumeCredentialConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).ResumeCredentialConsumption(ctx, req.(*ResumeCredentialConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_CredentialScopePreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CredentialScopePreviewReq)
 	if err := dec(in); err != nil {
@@ -5801,50 +9755,104 @@ func _Config_DeleteKv_Handler(srv interface{}, ctx context.Context, dec func(int
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).DeleteKv(ctx, in)
+		return srv.(ConfigServer).DeleteKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_DeleteKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).DeleteKv(ctx, req.(*DeleteKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_BatchDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteAppResourcesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchDeleteKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchDeleteKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchDeleteKv(ctx, req.(*BatchDeleteAppResourcesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).BatchUpsertKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_BatchUpsertKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Config_TransactKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).TransactKvs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_DeleteKv_FullMethodName,
+		FullMethod: Config_TransactKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).DeleteKv(ctx, req.(*DeleteKvReq))
+		return srv.(ConfigServer).TransactKvs(ctx, req.(*TransactKvsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchDeleteKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchDeleteAppResourcesReq)
+func _Config_ListKvHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvHistoryReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchDeleteKv(ctx, in)
+		return srv.(ConfigServer).ListKvHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchDeleteKv_FullMethodName,
+		FullMethod: Config_ListKvHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchDeleteKv(ctx, req.(*BatchDeleteAppResourcesReq))
+		return srv.(ConfigServer).ListKvHistory(ctx, req.(*ListKvHistoryReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Config_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertKvsReq)
+func _Config_DiffKvVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffKvVersionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ConfigServer).BatchUpsertKvs(ctx, in)
+		return srv.(ConfigServer).DiffKvVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Config_BatchUpsertKvs_FullMethodName,
+		FullMethod: Config_DiffKvVersions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ConfigServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+		return srv.(ConfigServer).DiffKvVersions(ctx, req.(*DiffKvVersionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5939,6 +9947,24 @@ func _Config_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Config_CompareKvValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareKvValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigServer).CompareKvValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Config_CompareKvValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigServer).CompareKvValue(ctx, req.(*CompareKvValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Config_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListClientsReq)
 	if err := dec(in); err != nil {
@@ -6296,6 +10322,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateApp",
 			Handler:    _Config_UpdateApp_Handler,
 		},
+		{
+			MethodName: "UpdateAppState",
+			Handler:    _Config_UpdateAppState_Handler,
+		},
+		{
+			MethodName: "PauseAppConsumption",
+			Handler:    _Config_PauseAppConsumption_Handler,
+		},
+		{
+			MethodName: "ResumeAppConsumption",
+			Handler:    _Config_ResumeAppConsumption_Handler,
+		},
 		{
 			MethodName: "DeleteApp",
 			Handler:    _Config_DeleteApp_Handler,
@@ -6484,6 +10522,382 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleaseHook",
 			Handler:    _Config_GetReleaseHook_Handler,
 		},
+		{
+			MethodName: "ListHookExecResults",
+			Handler:    _Config_ListHookExecResults_Handler,
+		},
+		{
+			MethodName: "ListFileTamperEvents",
+			Handler:    _Config_ListFileTamperEvents_Handler,
+		},
+		{
+			MethodName: "CreateHookTemplate",
+			Handler:    _Config_CreateHookTemplate_Handler,
+		},
+		{
+			MethodName: "ListHookTemplates",
+			Handler:    _Config_ListHookTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateHookTemplate",
+			Handler:    _Config_UpdateHookTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteHookTemplate",
+			Handler:    _Config_DeleteHookTemplate_Handler,
+		},
+		{
+			MethodName: "CreateHookFromTemplate",
+			Handler:    _Config_CreateHookFromTemplate_Handler,
+		},
+		{
+			MethodName: "UpgradeHookFromTemplate",
+			Handler:    _Config_UpgradeHookFromTemplate_Handler,
+		},
+		{
+			MethodName: "UpgradeHooksFromTemplate",
+			Handler:    _Config_UpgradeHooksFromTemplate_Handler,
+		},
+		{
+			MethodName: "GetHookTemplateRef",
+			Handler:    _Config_GetHookTemplateRef_Handler,
+		},
+		{
+			MethodName: "CreateEnvironment",
+			Handler:    _Config_CreateEnvironment_Handler,
+		},
+		{
+			MethodName: "ListEnvironments",
+			Handler:    _Config_ListEnvironments_Handler,
+		},
+		{
+			MethodName: "UpdateEnvironment",
+			Handler:    _Config_UpdateEnvironment_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironment",
+			Handler:    _Config_DeleteEnvironment_Handler,
+		},
+		{
+			MethodName: "BindEnvApp",
+			Handler:    _Config_BindEnvApp_Handler,
+		},
+		{
+			MethodName: "UnbindEnvApp",
+			Handler:    _Config_UnbindEnvApp_Handler,
+		},
+		{
+			MethodName: "ListEnvApps",
+			Handler:    _Config_ListEnvApps_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Config_PromoteRelease_Handler,
+		},
+		{
+			MethodName: "CreateConfigShare",
+			Handler:    _Config_CreateConfigShare_Handler,
+		},
+		{
+			MethodName: "UpdateConfigShare",
+			Handler:    _Config_UpdateConfigShare_Handler,
+		},
+		{
+			MethodName: "DeleteConfigShare",
+			Handler:    _Config_DeleteConfigShare_Handler,
+		},
+		{
+			MethodName: "ListConfigShares",
+			Handler:    _Config_ListConfigShares_Handler,
+		},
+		{
+			MethodName: "ListSharedConfigs",
+			Handler:    _Config_ListSharedConfigs_Handler,
+		},
+		{
+			MethodName: "CreateConfigShareRef",
+			Handler:    _Config_CreateConfigShareRef_Handler,
+		},
+		{
+			MethodName: "DeleteConfigShareRef",
+			Handler:    _Config_DeleteConfigShareRef_Handler,
+		},
+		{
+			MethodName: "ListConfigShareRefs",
+			Handler:    _Config_ListConfigShareRefs_Handler,
+		},
+		{
+			MethodName: "ListRecycleBin",
+			Handler:    _Config_ListRecycleBin_Handler,
+		},
+		{
+			MethodName: "RestoreRecycleBin",
+			Handler:    _Config_RestoreRecycleBin_Handler,
+		},
+		{
+			MethodName: "CreateAppSnapshot",
+			Handler:    _Config_CreateAppSnapshot_Handler,
+		},
+		{
+			MethodName: "ListAppSnapshots",
+			Handler:    _Config_ListAppSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteAppSnapshot",
+			Handler:    _Config_DeleteAppSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreAppSnapshot",
+			Handler:    _Config_RestoreAppSnapshot_Handler,
+		},
+		{
+			MethodName: "CloneApp",
+			Handler:    _Config_CloneApp_Handler,
+		},
+		{
+			MethodName: "UpsertDraftItem",
+			Handler:    _Config_UpsertDraftItem_Handler,
+		},
+		{
+			MethodName: "DeleteDraftItem",
+			Handler:    _Config_DeleteDraftItem_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _Config_GetDraft_Handler,
+		},
+		{
+			MethodName: "MergeDraft",
+			Handler:    _Config_MergeDraft_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _Config_DiscardDraft_Handler,
+		},
+		{
+			MethodName: "SearchFullText",
+			Handler:    _Config_SearchFullText_Handler,
+		},
+		{
+			MethodName: "ListTmplWhereUsed",
+			Handler:    _Config_ListTmplWhereUsed_Handler,
+		},
+		{
+			MethodName: "ListTmplVariableWhereUsed",
+			Handler:    _Config_ListTmplVariableWhereUsed_Handler,
+		},
+		{
+			MethodName: "CreateConfigReference",
+			Handler:    _Config_CreateConfigReference_Handler,
+		},
+		{
+			MethodName: "DeleteConfigReference",
+			Handler:    _Config_DeleteConfigReference_Handler,
+		},
+		{
+			MethodName: "GetConfigRefGraph",
+			Handler:    _Config_GetConfigRefGraph_Handler,
+		},
+		{
+			MethodName: "ListExpiringCertificates",
+			Handler:    _Config_ListExpiringCertificates_Handler,
+		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Config_RenewCertificate_Handler,
+		},
+		{
+			MethodName: "CreateKvOverride",
+			Handler:    _Config_CreateKvOverride_Handler,
+		},
+		{
+			MethodName: "UpdateKvOverride",
+			Handler:    _Config_UpdateKvOverride_Handler,
+		},
+		{
+			MethodName: "DeleteKvOverride",
+			Handler:    _Config_DeleteKvOverride_Handler,
+		},
+		{
+			MethodName: "ListKvOverrides",
+			Handler:    _Config_ListKvOverrides_Handler,
+		},
+		{
+			MethodName: "CreateCompliancePolicy",
+			Handler:    _Config_CreateCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "ListCompliancePolicies",
+			Handler:    _Config_ListCompliancePolicies_Handler,
+		},
+		{
+			MethodName: "UpdateCompliancePolicy",
+			Handler:    _Config_UpdateCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteCompliancePolicy",
+			Handler:    _Config_DeleteCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "TestCompliancePolicy",
+			Handler:    _Config_TestCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "CreateNotificationChannel",
+			Handler:    _Config_CreateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "ListNotificationChannels",
+			Handler:    _Config_ListNotificationChannels_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationChannel",
+			Handler:    _Config_UpdateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationChannel",
+			Handler:    _Config_DeleteNotificationChannel_Handler,
+		},
+		{
+			MethodName: "TestNotificationChannel",
+			Handler:    _Config_TestNotificationChannel_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Config_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Config_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _Config_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Config_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "CreateClientQuarantine",
+			Handler:    _Config_CreateClientQuarantine_Handler,
+		},
+		{
+			MethodName: "ListClientQuarantines",
+			Handler:    _Config_ListClientQuarantines_Handler,
+		},
+		{
+			MethodName: "UpdateClientQuarantine",
+			Handler:    _Config_UpdateClientQuarantine_Handler,
+		},
+		{
+			MethodName: "DeleteClientQuarantine",
+			Handler:    _Config_DeleteClientQuarantine_Handler,
+		},
+		{
+			MethodName: "CreateExperiment",
+			Handler:    _Config_CreateExperiment_Handler,
+		},
+		{
+			MethodName: "ListExperiments",
+			Handler:    _Config_ListExperiments_Handler,
+		},
+		{
+			MethodName: "UpdateExperiment",
+			Handler:    _Config_UpdateExperiment_Handler,
+		},
+		{
+			MethodName: "DeleteExperiment",
+			Handler:    _Config_DeleteExperiment_Handler,
+		},
+		{
+			MethodName: "GetExperimentStats",
+			Handler:    _Config_GetExperimentStats_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _Config_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _Config_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "UpdateServiceAccount",
+			Handler:    _Config_UpdateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _Config_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "ResetServiceAccountKey",
+			Handler:    _Config_ResetServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "SetAppRoleBinding",
+			Handler:    _Config_SetAppRoleBinding_Handler,
+		},
+		{
+			MethodName: "GrantTemporaryAppRole",
+			Handler:    _Config_GrantTemporaryAppRole_Handler,
+		},
+		{
+			MethodName: "ListAppRoleBindings",
+			Handler:    _Config_ListAppRoleBindings_Handler,
+		},
+		{
+			MethodName: "DeleteAppRoleBinding",
+			Handler:    _Config_DeleteAppRoleBinding_Handler,
+		},
+		{
+			MethodName: "CheckAppPermissions",
+			Handler:    _Config_CheckAppPermissions_Handler,
+		},
+		{
+			MethodName: "GetMaskingProfile",
+			Handler:    _Config_GetMaskingProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMaskingProfile",
+			Handler:    _Config_UpdateMaskingProfile_Handler,
+		},
+		{
+			MethodName: "PreviewMasking",
+			Handler:    _Config_PreviewMasking_Handler,
+		},
+		{
+			MethodName: "GetSecretScanSetting",
+			Handler:    _Config_GetSecretScanSetting_Handler,
+		},
+		{
+			MethodName: "UpdateSecretScanSetting",
+			Handler:    _Config_UpdateSecretScanSetting_Handler,
+		},
+		{
+			MethodName: "ScanSecretLeak",
+			Handler:    _Config_ScanSecretLeak_Handler,
+		},
+		{
+			MethodName: "GetAppConsumption",
+			Handler:    _Config_GetAppConsumption_Handler,
+		},
+		{
+			MethodName: "ListTopConsumingApps",
+			Handler:    _Config_ListTopConsumingApps_Handler,
+		},
+		{
+			MethodName: "ListStaleResources",
+			Handler:    _Config_ListStaleResources_Handler,
+		},
+		{
+			MethodName: "ListBizUsages",
+			Handler:    _Config_ListBizUsages_Handler,
+		},
+		{
+			MethodName: "GetBizOverview",
+			Handler:    _Config_GetBizOverview_Handler,
+		},
 		{
 			MethodName: "CreateTemplateSpace",
 			Handler:    _Config_CreateTemplateSpace_Handler,
@@ -6816,6 +11230,14 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGroupSelector",
 			Handler:    _Config_ListGroupSelector_Handler,
 		},
+		{
+			MethodName: "PreviewGroupClients",
+			Handler:    _Config_PreviewGroupClients_Handler,
+		},
+		{
+			MethodName: "PreviewClientRelease",
+			Handler:    _Config_PreviewClientRelease_Handler,
+		},
 		{
 			MethodName: "Publish",
 			Handler:    _Config_Publish_Handler,
@@ -6824,6 +11246,22 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateReleaseAndPublish",
 			Handler:    _Config_GenerateReleaseAndPublish_Handler,
 		},
+		{
+			MethodName: "BulkRelease",
+			Handler:    _Config_BulkRelease_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _Config_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _Config_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Config_CancelOperation_Handler,
+		},
 		{
 			MethodName: "SubmitPublishApprove",
 			Handler:    _Config_SubmitPublishApprove_Handler,
@@ -6832,6 +11270,26 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Approve",
 			Handler:    _Config_Approve_Handler,
 		},
+		{
+			MethodName: "EmergencyPublish",
+			Handler:    _Config_EmergencyPublish_Handler,
+		},
+		{
+			MethodName: "ListEmergencyPublishes",
+			Handler:    _Config_ListEmergencyPublishes_Handler,
+		},
+		{
+			MethodName: "RevokeEmergencyPublish",
+			Handler:    _Config_RevokeEmergencyPublish_Handler,
+		},
+		{
+			MethodName: "PinReleasedGroup",
+			Handler:    _Config_PinReleasedGroup_Handler,
+		},
+		{
+			MethodName: "UnpinReleasedGroup",
+			Handler:    _Config_UnpinReleasedGroup_Handler,
+		},
 		{
 			MethodName: "GetLastSelect",
 			Handler:    _Config_GetLastSelect_Handler,
@@ -6848,6 +11306,10 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAudits",
 			Handler:    _Config_ListAudits_Handler,
 		},
+		{
+			MethodName: "ListAppTimeline",
+			Handler:    _Config_ListAppTimeline_Handler,
+		},
 		{
 			MethodName: "CreateCredentials",
 			Handler:    _Config_CreateCredentials_Handler,
@@ -6876,6 +11338,14 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCredentialScope",
 			Handler:    _Config_UpdateCredentialScope_Handler,
 		},
+		{
+			MethodName: "PauseCredentialConsumption",
+			Handler:    _Config_PauseCredentialConsumption_Handler,
+		},
+		{
+			MethodName: "ResumeCredentialConsumption",
+			Handler:    _Config_ResumeCredentialConsumption_Handler,
+		},
 		{
 			MethodName: "CredentialScopePreview",
 			Handler:    _Config_CredentialScopePreview_Handler,
@@ -6904,6 +11374,18 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpsertKvs",
 			Handler:    _Config_BatchUpsertKvs_Handler,
 		},
+		{
+			MethodName: "TransactKvs",
+			Handler:    _Config_TransactKvs_Handler,
+		},
+		{
+			MethodName: "ListKvHistory",
+			Handler:    _Config_ListKvHistory_Handler,
+		},
+		{
+			MethodName: "DiffKvVersions",
+			Handler:    _Config_DiffKvVersions_Handler,
+		},
 		{
 			MethodName: "UnDeleteKv",
 			Handler:    _Config_UnDeleteKv_Handler,
@@ -6924,6 +11406,10 @@ var Config_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindNearExpiryCertKvs",
 			Handler:    _Config_FindNearExpiryCertKvs_Handler,
 		},
+		{
+			MethodName: "CompareKvValue",
+			Handler:    _Config_CompareKvValue_Handler,
+		},
 		{
 			MethodName: "ListClients",
 			Handler:    _Config_ListClients_Handler,
@@ -0,0 +1,502 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: alert_rule.proto
+
+package pbar
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AlertRule source resource reference: pkg/dal/table/alert_rule.go
+type AlertRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *AlertRuleSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *AlertRuleAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	State      *AlertRuleState      `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Revision   *base.Revision       `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *AlertRule) Reset() {
+	*x = AlertRule{}
+	mi := &file_alert_rule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRule) ProtoMessage() {}
+
+func (x *AlertRule) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_rule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
+func (*AlertRule) Descriptor() ([]byte, []int) {
+	return file_alert_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AlertRule) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AlertRule) GetSpec() *AlertRuleSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *AlertRule) GetAttachment() *AlertRuleAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AlertRule) GetState() *AlertRuleState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *AlertRule) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// AlertRuleSpec source resource reference: pkg/dal/table/alert_rule.go
+type AlertRuleSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Metric     string   `protobuf:"bytes,2,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold  float64  `protobuf:"fixed64,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	WindowMin  uint32   `protobuf:"varint,4,opt,name=window_min,json=windowMin,proto3" json:"window_min,omitempty"`
+	GroupId    uint32   `protobuf:"varint,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	ChannelIds []uint32 `protobuf:"varint,6,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	SilenceMin uint32   `protobuf:"varint,7,opt,name=silence_min,json=silenceMin,proto3" json:"silence_min,omitempty"`
+	Enabled    bool     `protobuf:"varint,8,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Memo       string   `protobuf:"bytes,9,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *AlertRuleSpec) Reset() {
+	*x = AlertRuleSpec{}
+	mi := &file_alert_rule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRuleSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleSpec) ProtoMessage() {}
+
+func (x *AlertRuleSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_rule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleSpec.ProtoReflect.Descriptor instead.
+func (*AlertRuleSpec) Descriptor() ([]byte, []int) {
+	return file_alert_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AlertRuleSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AlertRuleSpec) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AlertRuleSpec) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AlertRuleSpec) GetWindowMin() uint32 {
+	if x != nil {
+		return x.WindowMin
+	}
+	return 0
+}
+
+func (x *AlertRuleSpec) GetGroupId() uint32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AlertRuleSpec) GetChannelIds() []uint32 {
+	if x != nil {
+		return x.ChannelIds
+	}
+	return nil
+}
+
+func (x *AlertRuleSpec) GetSilenceMin() uint32 {
+	if x != nil {
+		return x.SilenceMin
+	}
+	return 0
+}
+
+func (x *AlertRuleSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AlertRuleSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// AlertRuleAttachment source resource reference: pkg/dal/table/alert_rule.go
+type AlertRuleAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *AlertRuleAttachment) Reset() {
+	*x = AlertRuleAttachment{}
+	mi := &file_alert_rule_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRuleAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleAttachment) ProtoMessage() {}
+
+func (x *AlertRuleAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_rule_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleAttachment.ProtoReflect.Descriptor instead.
+func (*AlertRuleAttachment) Descriptor() ([]byte, []int) {
+	return file_alert_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AlertRuleAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *AlertRuleAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// AlertRuleState source resource reference: pkg/dal/table/alert_rule.go
+type AlertRuleState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Firing          bool    `protobuf:"varint,1,opt,name=firing,proto3" json:"firing,omitempty"`
+	LastValue       float64 `protobuf:"fixed64,2,opt,name=last_value,json=lastValue,proto3" json:"last_value,omitempty"`
+	LastFiredAt     string  `protobuf:"bytes,3,opt,name=last_fired_at,json=lastFiredAt,proto3" json:"last_fired_at,omitempty"`
+	LastEvaluatedAt string  `protobuf:"bytes,4,opt,name=last_evaluated_at,json=lastEvaluatedAt,proto3" json:"last_evaluated_at,omitempty"`
+}
+
+func (x *AlertRuleState) Reset() {
+	*x = AlertRuleState{}
+	mi := &file_alert_rule_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlertRuleState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlertRuleState) ProtoMessage() {}
+
+func (x *AlertRuleState) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_rule_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlertRuleState.ProtoReflect.Descriptor instead.
+func (*AlertRuleState) Descriptor() ([]byte, []int) {
+	return file_alert_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AlertRuleState) GetFiring() bool {
+	if x != nil {
+		return x.Firing
+	}
+	return false
+}
+
+func (x *AlertRuleState) GetLastValue() float64 {
+	if x != nil {
+		return x.LastValue
+	}
+	return 0
+}
+
+func (x *AlertRuleState) GetLastFiredAt() string {
+	if x != nil {
+		return x.LastFiredAt
+	}
+	return ""
+}
+
+func (x *AlertRuleState) GetLastEvaluatedAt() string {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return ""
+}
+
+var File_alert_rule_proto protoreflect.FileDescriptor
+
+var file_alert_rule_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x61, 0x72, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x09,
+	0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0x91, 0x8a, 0xe8,
+	0xad, 0xa6, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27,
+	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x62, 0x61, 0x72, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x39, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62,
+	0x61, 0x72, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x61, 0x72, 0x2e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2c,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x06, 0x0a,
+	0x0d, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x25,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0xd3, 0x01, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0xba, 0x01, 0x92, 0x41, 0xb6, 0x01, 0x32, 0xb3, 0x01,
+	0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x9a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x3d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7,
+	0x8e, 0x87, 0x28, 0x25, 0x29, 0xef, 0xbc, 0x8c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x6f,
+	0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x3d, 0xe5, 0x88, 0x86, 0xe7, 0xbb,
+	0x84, 0xe6, 0x97, 0xa0, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf,
+	0x28, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x29, 0xef, 0xbc, 0x8c, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x3d, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0xe8, 0xb4, 0xa6, 0xe5, 0x8f, 0xb7, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5,
+	0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0xe6, 0x9c, 0x9f, 0x28, 0xe5,
+	0xa4, 0xa9, 0x29, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x8c, 0x01, 0x0a, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x6e, 0x92, 0x41, 0x6b, 0x32, 0x69, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe9, 0x98, 0x88, 0xe5,
+	0x80, 0xbc, 0xef, 0xbc, 0x8c, 0xe5, 0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x8e, 0x87, 0xe5, 0x92,
+	0x8c, 0xe6, 0x97, 0xa0, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf,
+	0xe8, 0xb6, 0x85, 0xe8, 0xbf, 0x87, 0xe9, 0x98, 0x88, 0xe5, 0x80, 0xbc, 0xe6, 0x97, 0xb6, 0xe5,
+	0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xef, 0xbc, 0x8c, 0xe5, 0x89, 0xa9, 0xe4, 0xbd, 0x99, 0xe6, 0x9c,
+	0x89, 0xe6, 0x95, 0x88, 0xe6, 0x9c, 0x9f, 0xe4, 0xb8, 0x8d, 0xe5, 0xa4, 0xa7, 0xe4, 0xba, 0x8e,
+	0xe9, 0x98, 0x88, 0xe5, 0x80, 0xbc, 0xe6, 0x97, 0xb6, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0x52,
+	0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x53, 0x0a, 0x0a, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x34,
+	0x92, 0x41, 0x31, 0x32, 0x2f, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe7, 0xaa, 0x97, 0xe5, 0x8f,
+	0xa3, 0x28, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x29, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe5,
+	0xa4, 0xb1, 0xe8, 0xb4, 0xa5, 0xe7, 0x8e, 0x87, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x69, 0x6e, 0x12,
+	0x52, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe6, 0xa3, 0x80, 0xe6, 0xb5, 0x8b, 0xe5, 0xbf,
+	0x83, 0xe8, 0xb7, 0xb3, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xef,
+	0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe6, 0x97, 0xa0, 0xe5, 0xbf, 0x83, 0xe8, 0xb7, 0xb3, 0xe6, 0x8c,
+	0x87, 0xe6, 0xa0, 0x87, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x22, 0x92, 0x41, 0x1f, 0x32, 0x1d, 0xe5,
+	0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe5, 0x8f, 0x91, 0xe9, 0x80, 0x81, 0xe7, 0x9a, 0x84, 0xe9, 0x80,
+	0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0x49, 0x44, 0x52, 0x0a, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x61, 0x0a, 0x0b, 0x73, 0x69, 0x6c, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x40, 0x92,
+	0x41, 0x3d, 0x32, 0x3b, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x8c, 0x81, 0xe7, 0xbb, 0xad,
+	0xe6, 0x97, 0xb6, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe7,
+	0x9a, 0x84, 0xe9, 0x97, 0xb4, 0xe9, 0x9a, 0x94, 0x28, 0xe5, 0x88, 0x86, 0xe9, 0x92, 0x9f, 0x29,
+	0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x36, 0x30, 0x52,
+	0x0a, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x4d, 0x69, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41,
+	0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef,
+	0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6,
+	0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x7d, 0x0a, 0x13, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69,
+	0x7a, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1,
+	0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe4,
+	0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe7, 0xba, 0xa7, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x81, 0x02, 0x0a, 0x0e, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52,
+	0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x69, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe6, 0xad, 0xa3, 0xe5, 0x9c, 0xa8, 0xe5, 0x91, 0x8a, 0xe8, 0xad,
+	0xa6, 0x52, 0x06, 0x66, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1d, 0x92,
+	0x41, 0x1a, 0x32, 0x18, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1,
+	0xe7, 0x9a, 0x84, 0xe6, 0x8c, 0x87, 0xe6, 0xa0, 0x87, 0xe5, 0x80, 0xbc, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3b, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x66, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe5, 0x91, 0x8a, 0xe8, 0xad,
+	0xa6, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x69, 0x72,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe6, 0xa3, 0x80, 0xe6,
+	0xb5, 0x8b, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x2d, 0x72, 0x75, 0x6c, 0x65, 0x3b, 0x70, 0x62, 0x61,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_alert_rule_proto_rawDescOnce sync.Once
+	file_alert_rule_proto_rawDescData = file_alert_rule_proto_rawDesc
+)
+
+func file_alert_rule_proto_rawDescGZIP() []byte {
+	file_alert_rule_proto_rawDescOnce.Do(func() {
+		file_alert_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_alert_rule_proto_rawDescData)
+	})
+	return file_alert_rule_proto_rawDescData
+}
+
+var file_alert_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_alert_rule_proto_goTypes = []any{
+	(*AlertRule)(nil),           // 0: pbar.AlertRule
+	(*AlertRuleSpec)(nil),       // 1: pbar.AlertRuleSpec
+	(*AlertRuleAttachment)(nil), // 2: pbar.AlertRuleAttachment
+	(*AlertRuleState)(nil),      // 3: pbar.AlertRuleState
+	(*base.Revision)(nil),       // 4: pbbase.Revision
+}
+var file_alert_rule_proto_depIdxs = []int32{
+	1, // 0: pbar.AlertRule.spec:type_name -> pbar.AlertRuleSpec
+	2, // 1: pbar.AlertRule.attachment:type_name -> pbar.AlertRuleAttachment
+	3, // 2: pbar.AlertRule.state:type_name -> pbar.AlertRuleState
+	4, // 3: pbar.AlertRule.revision:type_name -> pbbase.Revision
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_alert_rule_proto_init() }
+func file_alert_rule_proto_init() {
+	if File_alert_rule_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_alert_rule_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_alert_rule_proto_goTypes,
+		DependencyIndexes: file_alert_rule_proto_depIdxs,
+		MessageInfos:      file_alert_rule_proto_msgTypes,
+	}.Build()
+	File_alert_rule_proto = out.File
+	file_alert_rule_proto_rawDesc = nil
+	file_alert_rule_proto_goTypes = nil
+	file_alert_rule_proto_depIdxs = nil
+}
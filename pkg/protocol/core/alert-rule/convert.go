@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbar xxx
+package pbar
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// AlertRuleSpec convert pb AlertRuleSpec to table AlertRuleSpec
+func (m *AlertRuleSpec) AlertRuleSpec() *table.AlertRuleSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.AlertRuleSpec{
+		Name:       m.Name,
+		Metric:     table.AlertMetric(m.Metric),
+		Threshold:  m.Threshold,
+		WindowMin:  m.WindowMin,
+		GroupID:    m.GroupId,
+		ChannelIDs: m.ChannelIds,
+		SilenceMin: m.SilenceMin,
+		Enabled:    m.Enabled,
+		Memo:       m.Memo,
+	}
+}
+
+// PbAlertRuleSpec convert table AlertRuleSpec to pb AlertRuleSpec
+func PbAlertRuleSpec(spec *table.AlertRuleSpec) *AlertRuleSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &AlertRuleSpec{
+		Name:       spec.Name,
+		Metric:     string(spec.Metric),
+		Threshold:  spec.Threshold,
+		WindowMin:  spec.WindowMin,
+		GroupId:    spec.GroupID,
+		ChannelIds: spec.ChannelIDs,
+		SilenceMin: spec.SilenceMin,
+		Enabled:    spec.Enabled,
+		Memo:       spec.Memo,
+	}
+}
+
+// AlertRuleAttachment convert pb AlertRuleAttachment to table AlertRuleAttachment
+func (m *AlertRuleAttachment) AlertRuleAttachment() *table.AlertRuleAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.AlertRuleAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbAlertRuleState convert table AlertRuleState to pb AlertRuleState
+func PbAlertRuleState(s *table.AlertRuleState) *AlertRuleState {
+	if s == nil {
+		return nil
+	}
+
+	state := &AlertRuleState{
+		Firing:    s.Firing,
+		LastValue: s.LastValue,
+	}
+	if s.LastFiredAt != nil {
+		state.LastFiredAt = s.LastFiredAt.Format(time.RFC3339)
+	}
+	if s.LastEvaluatedAt != nil {
+		state.LastEvaluatedAt = s.LastEvaluatedAt.Format(time.RFC3339)
+	}
+
+	return state
+}
+
+// PbAlertRule convert table AlertRule to pb AlertRule
+func PbAlertRule(r *table.AlertRule) *AlertRule {
+	if r == nil {
+		return nil
+	}
+
+	var at *AlertRuleAttachment
+	if r.Attachment != nil {
+		at = &AlertRuleAttachment{BizId: r.Attachment.BizID, AppId: r.Attachment.AppID}
+	}
+
+	return &AlertRule{
+		Id:         r.ID,
+		Spec:       PbAlertRuleSpec(r.Spec),
+		Attachment: at,
+		State:      PbAlertRuleState(r.State),
+		Revision:   pbbase.PbRevision(r.Revision),
+	}
+}
+
+// PbAlertRules convert table AlertRule list to pb AlertRule list
+func PbAlertRules(list []*table.AlertRule) []*AlertRule {
+	result := make([]*AlertRule, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbAlertRule(one))
+	}
+
+	return result
+}
@@ -0,0 +1,335 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: app_role_binding.proto
+
+package pbarb
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AppRoleBinding source resource reference: pkg/dal/table/app_role_binding.go
+type AppRoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *AppRoleBindingSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *AppRoleBindingAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision            `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *AppRoleBinding) Reset() {
+	*x = AppRoleBinding{}
+	mi := &file_app_role_binding_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppRoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRoleBinding) ProtoMessage() {}
+
+func (x *AppRoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_app_role_binding_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRoleBinding.ProtoReflect.Descriptor instead.
+func (*AppRoleBinding) Descriptor() ([]byte, []int) {
+	return file_app_role_binding_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AppRoleBinding) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AppRoleBinding) GetSpec() *AppRoleBindingSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *AppRoleBinding) GetAttachment() *AppRoleBindingAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *AppRoleBinding) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// AppRoleBindingSpec source resource reference: pkg/dal/table/app_role_binding.go
+type AppRoleBindingSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Role      string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiredAt string `protobuf:"bytes,3,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AppRoleBindingSpec) Reset() {
+	*x = AppRoleBindingSpec{}
+	mi := &file_app_role_binding_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppRoleBindingSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRoleBindingSpec) ProtoMessage() {}
+
+func (x *AppRoleBindingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_app_role_binding_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRoleBindingSpec.ProtoReflect.Descriptor instead.
+func (*AppRoleBindingSpec) Descriptor() ([]byte, []int) {
+	return file_app_role_binding_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AppRoleBindingSpec) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *AppRoleBindingSpec) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AppRoleBindingSpec) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *AppRoleBindingSpec) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// AppRoleBindingAttachment source resource reference: pkg/dal/table/app_role_binding.go
+type AppRoleBindingAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *AppRoleBindingAttachment) Reset() {
+	*x = AppRoleBindingAttachment{}
+	mi := &file_app_role_binding_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppRoleBindingAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppRoleBindingAttachment) ProtoMessage() {}
+
+func (x *AppRoleBindingAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_app_role_binding_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppRoleBindingAttachment.ProtoReflect.Descriptor instead.
+func (*AppRoleBindingAttachment) Descriptor() ([]byte, []int) {
+	return file_app_role_binding_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AppRoleBindingAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *AppRoleBindingAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_app_role_binding_proto protoreflect.FileDescriptor
+
+var file_app_role_binding_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x61, 0x70, 0x70, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x61, 0x72, 0x62, 0x1a,
+	0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd3, 0x01, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xe6, 0x8e,
+	0x88, 0xe6, 0x9d, 0x83, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x72, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x70, 0x62, 0x61, 0x72, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x03, 0x0a, 0x12, 0x41, 0x70, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x22, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92,
+	0x41, 0x0b, 0x32, 0x09, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0xbc, 0x01, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0xa7, 0x01, 0x92, 0x41, 0xa3, 0x01, 0x32, 0xa0, 0x01, 0xe6, 0x9c, 0x8d, 0xe5,
+	0x8a, 0xa1, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0xaf, 0xe9, 0x80,
+	0x89, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x9a, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x28, 0xe6, 0x9f,
+	0xa5, 0xe7, 0x9c, 0x8b, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe8, 0xa7, 0x81,
+	0xe6, 0x95, 0x8f, 0xe6, 0x84, 0x9f, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x29, 0xe3, 0x80, 0x81,
+	0x65, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x28, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0xe3, 0x80, 0x81, 0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0x29, 0xe3, 0x80, 0x81, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x28,
+	0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x29, 0xe3, 0x80, 0x81, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x28,
+	0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe3, 0x80, 0x81, 0xe7,
+	0xae, 0xa1, 0xe7, 0x90, 0x86, 0xe8, 0xa7, 0x92, 0xe8, 0x89, 0xb2, 0x29, 0x52, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x12, 0x6b, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x32, 0x47, 0xe4, 0xb8, 0xb4,
+	0xe6, 0x97, 0xb6, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe7, 0x9a, 0x84, 0xe8, 0xbf, 0x87, 0xe6,
+	0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0x52, 0x46, 0x43, 0x33, 0x33,
+	0x33, 0x39, 0x20, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7,
+	0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0, 0xb8, 0xe4, 0xb9, 0x85, 0xe6, 0x8e,
+	0x88, 0xe6, 0x9d, 0x83, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x29, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x8e, 0x88, 0xe6, 0x9d, 0x83, 0xe5, 0x8e, 0x9f, 0xe5,
+	0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x18, 0x41, 0x70,
+	0x70, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a,
+	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06,
+	0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67,
+	0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x70, 0x2d, 0x72,
+	0x6f, 0x6c, 0x65, 0x2d, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x3b, 0x70, 0x62, 0x61, 0x72,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_app_role_binding_proto_rawDescOnce sync.Once
+	file_app_role_binding_proto_rawDescData = file_app_role_binding_proto_rawDesc
+)
+
+func file_app_role_binding_proto_rawDescGZIP() []byte {
+	file_app_role_binding_proto_rawDescOnce.Do(func() {
+		file_app_role_binding_proto_rawDescData = protoimpl.X.CompressGZIP(file_app_role_binding_proto_rawDescData)
+	})
+	return file_app_role_binding_proto_rawDescData
+}
+
+var file_app_role_binding_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_app_role_binding_proto_goTypes = []any{
+	(*AppRoleBinding)(nil),           // 0: pbarb.AppRoleBinding
+	(*AppRoleBindingSpec)(nil),       // 1: pbarb.AppRoleBindingSpec
+	(*AppRoleBindingAttachment)(nil), // 2: pbarb.AppRoleBindingAttachment
+	(*base.Revision)(nil),            // 3: pbbase.Revision
+}
+var file_app_role_binding_proto_depIdxs = []int32{
+	1, // 0: pbarb.AppRoleBinding.spec:type_name -> pbarb.AppRoleBindingSpec
+	2, // 1: pbarb.AppRoleBinding.attachment:type_name -> pbarb.AppRoleBindingAttachment
+	3, // 2: pbarb.AppRoleBinding.revision:type_name -> pbbase.Revision
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_app_role_binding_proto_init() }
+func file_app_role_binding_proto_init() {
+	if File_app_role_binding_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_app_role_binding_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_app_role_binding_proto_goTypes,
+		DependencyIndexes: file_app_role_binding_proto_depIdxs,
+		MessageInfos:      file_app_role_binding_proto_msgTypes,
+	}.Build()
+	File_app_role_binding_proto = out.File
+	file_app_role_binding_proto_rawDesc = nil
+	file_app_role_binding_proto_goTypes = nil
+	file_app_role_binding_proto_depIdxs = nil
+}
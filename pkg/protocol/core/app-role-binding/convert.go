@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbarb xxx
+package pbarb
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// PbAppRoleBinding convert table AppRoleBinding to pb AppRoleBinding
+func PbAppRoleBinding(b *table.AppRoleBinding) *AppRoleBinding {
+	if b == nil {
+		return nil
+	}
+
+	var spec *AppRoleBindingSpec
+	if b.Spec != nil {
+		spec = &AppRoleBindingSpec{User: b.Spec.User, Role: string(b.Spec.Role), Reason: b.Spec.Reason}
+		if b.Spec.Temporary() {
+			spec.ExpiredAt = b.Spec.ExpiredAt.Format(time.RFC3339)
+		}
+	}
+
+	var at *AppRoleBindingAttachment
+	if b.Attachment != nil {
+		at = &AppRoleBindingAttachment{BizId: b.Attachment.BizID, AppId: b.Attachment.AppID}
+	}
+
+	return &AppRoleBinding{
+		Id:         b.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbRevision(b.Revision),
+	}
+}
+
+// PbAppRoleBindings convert table AppRoleBinding list to pb AppRoleBinding list
+func PbAppRoleBindings(list []*table.AppRoleBinding) []*AppRoleBinding {
+	result := make([]*AppRoleBinding, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbAppRoleBinding(one))
+	}
+
+	return result
+}
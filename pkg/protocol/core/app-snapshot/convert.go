@@ -0,0 +1,53 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbasnap xxx
+package pbasnap
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// PbAppSnapshot convert table AppSnapshot to pb AppSnapshot, the snapshot data is not exposed.
+func PbAppSnapshot(a *table.AppSnapshot) *AppSnapshot {
+	if a == nil {
+		return nil
+	}
+
+	var spec *AppSnapshotSpec
+	if a.Spec != nil {
+		spec = &AppSnapshotSpec{Name: a.Spec.Name, Memo: a.Spec.Memo}
+	}
+
+	var at *AppSnapshotAttachment
+	if a.Attachment != nil {
+		at = &AppSnapshotAttachment{BizId: a.Attachment.BizID, AppId: a.Attachment.AppID}
+	}
+
+	return &AppSnapshot{
+		Id:         a.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbCreatedRevision(a.Revision),
+	}
+}
+
+// PbAppSnapshots convert table AppSnapshot list to pb AppSnapshot list
+func PbAppSnapshots(list []*table.AppSnapshot) []*AppSnapshot {
+	result := make([]*AppSnapshot, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbAppSnapshot(one))
+	}
+
+	return result
+}
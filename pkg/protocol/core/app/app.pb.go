@@ -130,14 +130,19 @@ type AppSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ConfigType  string `protobuf:"bytes,2,opt,name=config_type,json=configType,proto3" json:"config_type,omitempty"`
-	Memo        string `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
-	Alias       string `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
-	DataType    string `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	IsApprove   bool   `protobuf:"varint,6,opt,name=is_approve,json=isApprove,proto3" json:"is_approve,omitempty"`
-	ApproveType string `protobuf:"bytes,7,opt,name=approve_type,json=approveType,proto3" json:"approve_type,omitempty"`
-	Approver    string `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	Name               string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ConfigType         string              `protobuf:"bytes,2,opt,name=config_type,json=configType,proto3" json:"config_type,omitempty"`
+	Memo               string              `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo,omitempty"`
+	Alias              string              `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	DataType           string              `protobuf:"bytes,5,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	IsApprove          bool                `protobuf:"varint,6,opt,name=is_approve,json=isApprove,proto3" json:"is_approve,omitempty"`
+	ApproveType        string              `protobuf:"bytes,7,opt,name=approve_type,json=approveType,proto3" json:"approve_type,omitempty"`
+	Approver           string              `protobuf:"bytes,8,opt,name=approver,proto3" json:"approver,omitempty"`
+	ReleaseNotesPolicy *ReleaseNotesPolicy `protobuf:"bytes,9,opt,name=release_notes_policy,json=releaseNotesPolicy,proto3" json:"release_notes_policy,omitempty"`
+	State              string              `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+	PauseMode          string              `protobuf:"bytes,11,opt,name=pause_mode,json=pauseMode,proto3" json:"pause_mode,omitempty"`
+	PauseMessage       string              `protobuf:"bytes,12,opt,name=pause_message,json=pauseMessage,proto3" json:"pause_message,omitempty"`
+	MinClientVersion   string              `protobuf:"bytes,13,opt,name=min_client_version,json=minClientVersion,proto3" json:"min_client_version,omitempty"`
 }
 
 func (x *AppSpec) Reset() {
@@ -226,6 +231,157 @@ func (x *AppSpec) GetApprover() string {
 	return ""
 }
 
+func (x *AppSpec) GetReleaseNotesPolicy() *ReleaseNotesPolicy {
+	if x != nil {
+		return x.ReleaseNotesPolicy
+	}
+	return nil
+}
+
+func (x *AppSpec) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AppSpec) GetPauseMode() string {
+	if x != nil {
+		return x.PauseMode
+	}
+	return ""
+}
+
+func (x *AppSpec) GetPauseMessage() string {
+	if x != nil {
+		return x.PauseMessage
+	}
+	return ""
+}
+
+func (x *AppSpec) GetMinClientVersion() string {
+	if x != nil {
+		return x.MinClientVersion
+	}
+	return ""
+}
+
+// ReleaseNotesPolicy source resource reference: pkg/dal/table/release_notes.go
+type ReleaseNotesPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Required bool                `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	Fields   []*ReleaseNoteField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ReleaseNotesPolicy) Reset() {
+	*x = ReleaseNotesPolicy{}
+	mi := &file_app_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseNotesPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNotesPolicy) ProtoMessage() {}
+
+func (x *ReleaseNotesPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_app_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNotesPolicy.ProtoReflect.Descriptor instead.
+func (*ReleaseNotesPolicy) Descriptor() ([]byte, []int) {
+	return file_app_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseNotesPolicy) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ReleaseNotesPolicy) GetFields() []*ReleaseNoteField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ReleaseNoteField source resource reference: pkg/dal/table/release_notes.go
+type ReleaseNoteField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Required bool   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Pattern  string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *ReleaseNoteField) Reset() {
+	*x = ReleaseNoteField{}
+	mi := &file_app_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseNoteField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNoteField) ProtoMessage() {}
+
+func (x *ReleaseNoteField) ProtoReflect() protoreflect.Message {
+	mi := &file_app_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNoteField.ProtoReflect.Descriptor instead.
+func (*ReleaseNoteField) Descriptor() ([]byte, []int) {
+	return file_app_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReleaseNoteField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReleaseNoteField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *ReleaseNoteField) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
 // AuditApp audit and app
 type AuditApp struct {
 	state         protoimpl.MessageState
@@ -239,7 +395,7 @@ type AuditApp struct {
 
 func (x *AuditApp) Reset() {
 	*x = AuditApp{}
-	mi := &file_app_proto_msgTypes[2]
+	mi := &file_app_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +407,7 @@ func (x *AuditApp) String() string {
 func (*AuditApp) ProtoMessage() {}
 
 func (x *AuditApp) ProtoReflect() protoreflect.Message {
-	mi := &file_app_proto_msgTypes[2]
+	mi := &file_app_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +420,7 @@ func (x *AuditApp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditApp.ProtoReflect.Descriptor instead.
 func (*AuditApp) Descriptor() ([]byte, []int) {
-	return file_app_proto_rawDescGZIP(), []int{2}
+	return file_app_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AuditApp) GetName() string {
@@ -288,6 +444,162 @@ func (x *AuditApp) GetApproveType() string {
 	return ""
 }
 
+// AppConsumption source resource reference: pkg/dal/table/app_consumption_stat.go
+type AppConsumption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId            uint32                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppName          string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	PullCount        uint64                 `protobuf:"varint,3,opt,name=pull_count,json=pullCount,proto3" json:"pull_count,omitempty"`
+	DownloadBytes    uint64                 `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	OnlineClients    uint32                 `protobuf:"varint,5,opt,name=online_clients,json=onlineClients,proto3" json:"online_clients,omitempty"`
+	LastConsumedTime string                 `protobuf:"bytes,6,opt,name=last_consumed_time,json=lastConsumedTime,proto3" json:"last_consumed_time,omitempty"`
+	Daily            []*AppConsumptionDaily `protobuf:"bytes,7,rep,name=daily,proto3" json:"daily,omitempty"`
+}
+
+func (x *AppConsumption) Reset() {
+	*x = AppConsumption{}
+	mi := &file_app_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppConsumption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppConsumption) ProtoMessage() {}
+
+func (x *AppConsumption) ProtoReflect() protoreflect.Message {
+	mi := &file_app_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppConsumption.ProtoReflect.Descriptor instead.
+func (*AppConsumption) Descriptor() ([]byte, []int) {
+	return file_app_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AppConsumption) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *AppConsumption) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *AppConsumption) GetPullCount() uint64 {
+	if x != nil {
+		return x.PullCount
+	}
+	return 0
+}
+
+func (x *AppConsumption) GetDownloadBytes() uint64 {
+	if x != nil {
+		return x.DownloadBytes
+	}
+	return 0
+}
+
+func (x *AppConsumption) GetOnlineClients() uint32 {
+	if x != nil {
+		return x.OnlineClients
+	}
+	return 0
+}
+
+func (x *AppConsumption) GetLastConsumedTime() string {
+	if x != nil {
+		return x.LastConsumedTime
+	}
+	return ""
+}
+
+func (x *AppConsumption) GetDaily() []*AppConsumptionDaily {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+// AppConsumptionDaily source resource reference: pkg/dal/table/app_consumption_stat.go
+type AppConsumptionDaily struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatDate      string `protobuf:"bytes,1,opt,name=stat_date,json=statDate,proto3" json:"stat_date,omitempty"`
+	PullCount     uint64 `protobuf:"varint,2,opt,name=pull_count,json=pullCount,proto3" json:"pull_count,omitempty"`
+	DownloadBytes uint64 `protobuf:"varint,3,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+}
+
+func (x *AppConsumptionDaily) Reset() {
+	*x = AppConsumptionDaily{}
+	mi := &file_app_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppConsumptionDaily) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppConsumptionDaily) ProtoMessage() {}
+
+func (x *AppConsumptionDaily) ProtoReflect() protoreflect.Message {
+	mi := &file_app_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppConsumptionDaily.ProtoReflect.Descriptor instead.
+func (*AppConsumptionDaily) Descriptor() ([]byte, []int) {
+	return file_app_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AppConsumptionDaily) GetStatDate() string {
+	if x != nil {
+		return x.StatDate
+	}
+	return ""
+}
+
+func (x *AppConsumptionDaily) GetPullCount() uint64 {
+	if x != nil {
+		return x.PullCount
+	}
+	return 0
+}
+
+func (x *AppConsumptionDaily) GetDownloadBytes() uint64 {
+	if x != nil {
+		return x.DownloadBytes
+	}
+	return 0
+}
+
 var File_app_proto protoreflect.FileDescriptor
 
 var file_app_proto_rawDesc = []byte{
@@ -322,7 +634,7 @@ var file_app_proto_rawDesc = []byte{
 	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
 	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73,
 	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa9, 0x04, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x53, 0x70, 0x65, 0x63,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf5, 0x09, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x53, 0x70, 0x65, 0x63,
 	0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
 	0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0x52, 0x04,
 	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x51, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74,
@@ -335,44 +647,158 @@ var file_app_proto_rawDesc = []byte{
 	0x8a, 0xa1, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x27,
 	0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x88, 0xab, 0xe5, 0x90, 0x8d,
-	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x7b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5e, 0x92, 0x41, 0x5b, 0x32,
-	0x59, 0xe9, 0x94, 0xae, 0xe5, 0x80, 0xbc, 0xe5, 0x9e, 0x8b, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1,
-	0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x28,
-	0x61, 0x6e, 0x79, 0xe3, 0x80, 0x81, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0xe3, 0x80, 0x81, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x74, 0x65, 0x78, 0x74, 0xe3, 0x80, 0x81, 0x6a,
-	0x73, 0x6f, 0x6e, 0xe3, 0x80, 0x81, 0x79, 0x61, 0x6d, 0x6c, 0xe3, 0x80, 0x81, 0x78, 0x6d, 0x6c,
-	0xe3, 0x80, 0x81, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x29, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2e, 0x92, 0x41, 0x2b, 0x32, 0x29, 0xe6,
-	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe5, 0xae, 0xa1, 0xe6, 0x89,
-	0xb9, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5,
-	0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x69, 0x73, 0x41, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31,
-	0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0xe4,
-	0xbc, 0x9a, 0xe7, 0xad, 0xbe, 0x3d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e,
-	0xe3, 0x80, 0x81, 0xe6, 0x88, 0x96, 0xe7, 0xad, 0xbe, 0x3d, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67,
-	0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30,
-	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe4, 0xba, 0xba,
-	0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72,
-	0x22, 0xb3, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x12, 0x22, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b,
-	0x32, 0x09, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4,
-	0xba, 0xba, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x0c, 0x61,
-	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe7, 0xb1,
-	0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0xe4, 0xbc, 0x9a, 0xe7, 0xad, 0xbe, 0x3d, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0xe3, 0x80, 0x81, 0xe6, 0x88, 0x96, 0xe7, 0xad,
-	0xbe, 0x3d, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65,
-	0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61,
-	0x70, 0x70, 0x3b, 0x70, 0x62, 0x61, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x8f, 0x01, 0x92, 0x41,
+	0x8b, 0x01, 0x32, 0x88, 0x01, 0xe9, 0x94, 0xae, 0xe5, 0x80, 0xbc, 0xe5, 0x9e, 0x8b, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0x95, 0xb0, 0xe6, 0x8d, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xef, 0xbc, 0x9a, 0x28, 0x61, 0x6e, 0x79, 0xe3, 0x80, 0x81, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0xe3, 0x80, 0x81, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0xe3, 0x80, 0x81, 0x74, 0x65, 0x78, 0x74,
+	0xe3, 0x80, 0x81, 0x6a, 0x73, 0x6f, 0x6e, 0xe3, 0x80, 0x81, 0x79, 0x61, 0x6d, 0x6c, 0xe3, 0x80,
+	0x81, 0x78, 0x6d, 0x6c, 0xe3, 0x80, 0x81, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0xe3, 0x80, 0x81,
+	0x62, 0x6f, 0x6f, 0x6c, 0xe3, 0x80, 0x81, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xe3,
+	0x80, 0x81, 0x62, 0x79, 0x74, 0x65, 0x73, 0xe3, 0x80, 0x81, 0x6c, 0x69, 0x73, 0x74, 0xe3, 0x80,
+	0x81, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x29, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x2e, 0x92, 0x41, 0x2b,
+	0x32, 0x29, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x9c, 0x80, 0xe8, 0xa6, 0x81, 0xe5, 0xae,
+	0xa1, 0xe6, 0x89, 0xb9, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef,
+	0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x09, 0x69, 0x73, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41,
+	0x33, 0x32, 0x31, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef,
+	0xbc, 0x9a, 0xe4, 0xbc, 0x9a, 0xe7, 0xad, 0xbe, 0x3d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0xe3, 0x80, 0x81, 0xe6, 0x88, 0x96, 0xe7, 0xad, 0xbe, 0x3d, 0x6f, 0x72, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x30, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9,
+	0xe4, 0xba, 0xba, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0xe7,
+	0xad, 0x96, 0xe7, 0x95, 0xa5, 0x52, 0x12, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6a, 0x92, 0x41, 0x67, 0x32, 0x65,
+	0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0xe6,
+	0xad, 0xa3, 0xe5, 0xb8, 0xb8, 0x3d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0xe3, 0x80, 0x81, 0xe5,
+	0x86, 0xbb, 0xe7, 0xbb, 0x93, 0x3d, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0xe3, 0x80, 0x81, 0xe5,
+	0xbd, 0x92, 0xe6, 0xa1, 0xa3, 0x3d, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0xef, 0xbc,
+	0x8c, 0xe5, 0x8f, 0xaa, 0xe8, 0x83, 0xbd, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe7, 0x8a, 0xb6,
+	0xe6, 0x80, 0x81, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe6, 0x8e, 0xa5, 0xe5, 0x8f, 0xa3, 0xe4,
+	0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x9c, 0x01, 0x0a,
+	0x0a, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x7d, 0x92, 0x41, 0x7a, 0x32, 0x78, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8b,
+	0x89, 0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0xb9,
+	0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0xaa, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6,
+	0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xef, 0xbc, 0x9a, 0xe4, 0xbf, 0x9d, 0xe6, 0x8c,
+	0x81, 0xe5, 0xb7, 0xb2, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
+	0x3d, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0xe3, 0x80, 0x81,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8f, 0x90, 0xe7,
+	0xa4, 0xba, 0x3d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6,
+	0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x99, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf,
+	0xe7, 0x9a, 0x84, 0xe6, 0x8f, 0x90, 0xe7, 0xa4, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52,
+	0x0c, 0x70, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0xac, 0x01,
+	0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7e, 0x92, 0x41, 0x7b, 0x32,
+	0x79, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x28, 0x53, 0x44, 0x4b, 0x2f, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x29, 0xe6, 0x9c, 0x80, 0xe4, 0xbd, 0x8e, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x76, 0x31, 0x2e, 0x32, 0x2e, 0x30, 0xef, 0xbc,
+	0x8c, 0xe4, 0xbd, 0x8e, 0xe4, 0xba, 0x8e, 0xe8, 0xaf, 0xa5, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
+	0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x20, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x20, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0x97, 0xb6, 0xe8, 0xa2, 0xab, 0xe6,
+	0x8b, 0x92, 0xe7, 0xbb, 0x9d, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97,
+	0xb6, 0xe4, 0xb8, 0x8d, 0xe9, 0x99, 0x90, 0xe5, 0x88, 0xb6, 0x52, 0x10, 0x6d, 0x69, 0x6e, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a,
+	0x12, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x4e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe7, 0x94, 0x9f, 0xe6,
+	0x88, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x97, 0xb6, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0xa1, 0xab, 0xe5, 0x86, 0x99, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x2e, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x26, 0x92, 0x41,
+	0x23, 0x32, 0x21, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x84, 0xe5, 0x8c, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe6, 0xa8,
+	0xa1, 0xe7, 0x89, 0x88, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xda, 0x01, 0x0a,
+	0x10, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x32, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1e, 0x92, 0x41, 0x1b, 0x32, 0x19, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe5, 0x90, 0x8d, 0xef,
+	0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe6, 0x98,
+	0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xbf, 0x85, 0xe5, 0xa1, 0xab, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf,
+	0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73,
+	0x65, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x07, 0x70,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41,
+	0x2f, 0x32, 0x2d, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe5, 0x80, 0xbc, 0xe9, 0x9c, 0x80, 0xe6,
+	0xbb, 0xa1, 0xe8, 0xb6, 0xb3, 0xe7, 0x9a, 0x84, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xef, 0xbc,
+	0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe4, 0xb8, 0x8d, 0xe6, 0xa0, 0xa1, 0xe9, 0xaa, 0x8c,
+	0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x08, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x41, 0x70, 0x70, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
+	0xa1, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b,
+	0x32, 0x09, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe4, 0xba, 0xba, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x59, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32,
+	0x31, 0xe5, 0xae, 0xa1, 0xe6, 0x89, 0xb9, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a,
+	0xe4, 0xbc, 0x9a, 0xe7, 0xad, 0xbe, 0x3d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0xe3, 0x80, 0x81, 0xe6, 0x88, 0x96, 0xe7, 0xad, 0xbe, 0x3d, 0x6f, 0x72, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0xe1, 0x03, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49,
+	0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32,
+	0x09, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe7, 0xbb,
+	0x9f, 0xe8, 0xae, 0xa1, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe5, 0x86, 0x85, 0xe6, 0x8b, 0x89,
+	0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xb7, 0xe6,
+	0xb1, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x09, 0x70, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x59, 0x0a, 0x0e, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe7,
+	0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe5, 0x91, 0xa8, 0xe6, 0x9c, 0x9f, 0xe5, 0x86, 0x85, 0xe4, 0xb8,
+	0x8b, 0xe5, 0x8f, 0x91, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87,
+	0xe4, 0xbb, 0xb6, 0xe5, 0xad, 0x97, 0xe8, 0x8a, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x0d, 0x64, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x0e, 0x6f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x27, 0x92, 0x41, 0x24, 0x32, 0x22, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d,
+	0xe5, 0x9c, 0xa8, 0xe7, 0xba, 0xbf, 0x28, 0x77, 0x61, 0x74, 0x63, 0x68, 0x29, 0xe7, 0x9a, 0x84,
+	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x95, 0xb0, 0x52, 0x0d, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0x9c,
+	0x80, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52,
+	0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x52, 0x05, 0x64, 0x61,
+	0x69, 0x6c, 0x79, 0x22, 0xcf, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6, 0x97, 0xa5, 0xe6, 0x9c,
+	0x9f, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x70,
+	0x75, 0x6c, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x09,
+	0x70, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4a, 0x0a, 0x0e, 0x64, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe7, 0x9a,
+	0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0xad, 0x97,
+	0xe8, 0x8a, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
+	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61, 0x70,
+	0x70, 0x3b, 0x70, 0x62, 0x61, 0x70, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -387,21 +813,28 @@ func file_app_proto_rawDescGZIP() []byte {
 	return file_app_proto_rawDescData
 }
 
-var file_app_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_app_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_app_proto_goTypes = []any{
-	(*App)(nil),           // 0: pbapp.App
-	(*AppSpec)(nil),       // 1: pbapp.AppSpec
-	(*AuditApp)(nil),      // 2: pbapp.AuditApp
-	(*base.Revision)(nil), // 3: pbbase.Revision
+	(*App)(nil),                 // 0: pbapp.App
+	(*AppSpec)(nil),             // 1: pbapp.AppSpec
+	(*ReleaseNotesPolicy)(nil),  // 2: pbapp.ReleaseNotesPolicy
+	(*ReleaseNoteField)(nil),    // 3: pbapp.ReleaseNoteField
+	(*AuditApp)(nil),            // 4: pbapp.AuditApp
+	(*AppConsumption)(nil),      // 5: pbapp.AppConsumption
+	(*AppConsumptionDaily)(nil), // 6: pbapp.AppConsumptionDaily
+	(*base.Revision)(nil),       // 7: pbbase.Revision
 }
 var file_app_proto_depIdxs = []int32{
 	1, // 0: pbapp.App.spec:type_name -> pbapp.AppSpec
-	3, // 1: pbapp.App.revision:type_name -> pbbase.Revision
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	7, // 1: pbapp.App.revision:type_name -> pbbase.Revision
+	2, // 2: pbapp.AppSpec.release_notes_policy:type_name -> pbapp.ReleaseNotesPolicy
+	3, // 3: pbapp.ReleaseNotesPolicy.fields:type_name -> pbapp.ReleaseNoteField
+	6, // 4: pbapp.AppConsumption.daily:type_name -> pbapp.AppConsumptionDaily
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_app_proto_init() }
@@ -415,7 +848,7 @@ func file_app_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_app_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
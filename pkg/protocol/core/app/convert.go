@@ -25,14 +25,16 @@ func (m *AppSpec) AppSpec() *table.AppSpec {
 	}
 
 	return &table.AppSpec{
-		Name:        m.Name,
-		ConfigType:  table.ConfigType(m.ConfigType),
-		Memo:        m.Memo,
-		Alias:       m.Alias,
-		DataType:    table.DataType(m.DataType),
-		ApproveType: table.ApproveType(m.ApproveType),
-		IsApprove:   m.IsApprove,
-		Approver:    m.Approver,
+		Name:               m.Name,
+		ConfigType:         table.ConfigType(m.ConfigType),
+		Memo:               m.Memo,
+		Alias:              m.Alias,
+		DataType:           table.DataType(m.DataType),
+		ApproveType:        table.ApproveType(m.ApproveType),
+		IsApprove:          m.IsApprove,
+		Approver:           m.Approver,
+		ReleaseNotesPolicy: m.ReleaseNotesPolicy.ReleaseNotesPolicy(),
+		MinClientVersion:   m.MinClientVersion,
 	}
 }
 
@@ -43,14 +45,57 @@ func PbAppSpec(spec *table.AppSpec) *AppSpec { //nolint:revive
 	}
 
 	return &AppSpec{
-		Name:        spec.Name,
-		ConfigType:  string(spec.ConfigType),
-		Memo:        spec.Memo,
-		Alias:       spec.Alias,
-		DataType:    string(spec.DataType),
-		IsApprove:   spec.IsApprove,
-		ApproveType: string(spec.ApproveType),
-		Approver:    spec.Approver,
+		Name:               spec.Name,
+		ConfigType:         string(spec.ConfigType),
+		Memo:               spec.Memo,
+		Alias:              spec.Alias,
+		DataType:           string(spec.DataType),
+		IsApprove:          spec.IsApprove,
+		ApproveType:        string(spec.ApproveType),
+		Approver:           spec.Approver,
+		ReleaseNotesPolicy: PbReleaseNotesPolicy(spec.ReleaseNotesPolicy),
+		State:              string(spec.State.Normalize()),
+		PauseMode:          string(spec.Pause.Mode),
+		PauseMessage:       spec.Pause.Message,
+		MinClientVersion:   spec.MinClientVersion,
+	}
+}
+
+// ReleaseNotesPolicy convert pb ReleaseNotesPolicy to table ReleaseNotesPolicy
+func (m *ReleaseNotesPolicy) ReleaseNotesPolicy() table.ReleaseNotesPolicy {
+	if m == nil {
+		return table.ReleaseNotesPolicy{}
+	}
+
+	fields := make([]*table.ReleaseNoteField, 0, len(m.Fields))
+	for _, f := range m.Fields {
+		fields = append(fields, &table.ReleaseNoteField{
+			Name:     f.Name,
+			Required: f.Required,
+			Pattern:  f.Pattern,
+		})
+	}
+
+	return table.ReleaseNotesPolicy{
+		Required: m.Required,
+		Fields:   fields,
+	}
+}
+
+// PbReleaseNotesPolicy convert table ReleaseNotesPolicy to pb ReleaseNotesPolicy
+func PbReleaseNotesPolicy(policy table.ReleaseNotesPolicy) *ReleaseNotesPolicy {
+	fields := make([]*ReleaseNoteField, 0, len(policy.Fields))
+	for _, f := range policy.Fields {
+		fields = append(fields, &ReleaseNoteField{
+			Name:     f.Name,
+			Required: f.Required,
+			Pattern:  f.Pattern,
+		})
+	}
+
+	return &ReleaseNotesPolicy{
+		Required: policy.Required,
+		Fields:   fields,
 	}
 }
 
@@ -81,3 +126,17 @@ func PbApp(app *table.App) *App {
 		Revision: pbbase.PbRevision(app.Revision),
 	}
 }
+
+// PbAppConsumptionDailies convert table AppConsumptionStat list to pb AppConsumptionDaily list
+func PbAppConsumptionDailies(stats []*table.AppConsumptionStat) []*AppConsumptionDaily {
+	result := make([]*AppConsumptionDaily, 0, len(stats))
+	for _, one := range stats {
+		result = append(result, &AppConsumptionDaily{
+			StatDate:      one.Spec.StatDate,
+			PullCount:     one.Spec.PullCount,
+			DownloadBytes: one.Spec.DownloadBytes,
+		})
+	}
+
+	return result
+}
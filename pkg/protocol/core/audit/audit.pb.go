@@ -379,6 +379,261 @@ func (x *ListAuditsAppStrategy) GetApp() *app.AuditApp {
 	return nil
 }
 
+// TimelineEvent is one event of the app's change timeline, which is generated from the app's audits.
+type TimelineEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuditId uint32 `protobuf:"varint,1,opt,name=audit_id,json=auditId,proto3" json:"audit_id,omitempty"`
+	// type is one of edit, release, publish, rollback, strategy.
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ResType     string `protobuf:"bytes,3,opt,name=res_type,json=resType,proto3" json:"res_type,omitempty"`
+	Action      string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	ResId       uint32 `protobuf:"varint,5,opt,name=res_id,json=resId,proto3" json:"res_id,omitempty"`
+	ResInstance string `protobuf:"bytes,6,opt,name=res_instance,json=resInstance,proto3" json:"res_instance,omitempty"`
+	Actor       string `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	OperateWay  string `protobuf:"bytes,8,opt,name=operate_way,json=operateWay,proto3" json:"operate_way,omitempty"`
+	Status      string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	OccurredAt  string `protobuf:"bytes,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// release_id is the published release, only set for publish and rollback events.
+	ReleaseId uint32 `protobuf:"varint,11,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	// rollback_from is the latest release published before the rollback.
+	RollbackFrom    uint32            `protobuf:"varint,12,opt,name=rollback_from,json=rollbackFrom,proto3" json:"rollback_from,omitempty"`
+	Memo            string            `protobuf:"bytes,13,opt,name=memo,proto3" json:"memo,omitempty"`
+	Approval        *TimelineApproval `protobuf:"bytes,14,opt,name=approval,proto3" json:"approval,omitempty"`
+	ChangeTicketId  string            `protobuf:"bytes,15,opt,name=change_ticket_id,json=changeTicketId,proto3" json:"change_ticket_id,omitempty"`
+	ChangeTicketUrl string            `protobuf:"bytes,16,opt,name=change_ticket_url,json=changeTicketUrl,proto3" json:"change_ticket_url,omitempty"`
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	mi := &file_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TimelineEvent) GetAuditId() uint32 {
+	if x != nil {
+		return x.AuditId
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetResType() string {
+	if x != nil {
+		return x.ResType
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetResId() uint32 {
+	if x != nil {
+		return x.ResId
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetResInstance() string {
+	if x != nil {
+		return x.ResInstance
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetOperateWay() string {
+	if x != nil {
+		return x.OperateWay
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetRollbackFrom() uint32 {
+	if x != nil {
+		return x.RollbackFrom
+	}
+	return 0
+}
+
+func (x *TimelineEvent) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetApproval() *TimelineApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+func (x *TimelineEvent) GetChangeTicketId() string {
+	if x != nil {
+		return x.ChangeTicketId
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetChangeTicketUrl() string {
+	if x != nil {
+		return x.ChangeTicketUrl
+	}
+	return ""
+}
+
+// TimelineApproval is the approval of a publish event, empty if the publish needs no approval.
+type TimelineApproval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApproveType  string `protobuf:"bytes,1,opt,name=approve_type,json=approveType,proto3" json:"approve_type,omitempty"`
+	Approvers    string `protobuf:"bytes,2,opt,name=approvers,proto3" json:"approvers,omitempty"`
+	ApprovedBy   string `protobuf:"bytes,3,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	ApprovedAt   string `protobuf:"bytes,4,opt,name=approved_at,json=approvedAt,proto3" json:"approved_at,omitempty"`
+	RejectReason string `protobuf:"bytes,5,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`
+	TicketUrl    string `protobuf:"bytes,6,opt,name=ticket_url,json=ticketUrl,proto3" json:"ticket_url,omitempty"`
+}
+
+func (x *TimelineApproval) Reset() {
+	*x = TimelineApproval{}
+	mi := &file_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimelineApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineApproval) ProtoMessage() {}
+
+func (x *TimelineApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineApproval.ProtoReflect.Descriptor instead.
+func (*TimelineApproval) Descriptor() ([]byte, []int) {
+	return file_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TimelineApproval) GetApproveType() string {
+	if x != nil {
+		return x.ApproveType
+	}
+	return ""
+}
+
+func (x *TimelineApproval) GetApprovers() string {
+	if x != nil {
+		return x.Approvers
+	}
+	return ""
+}
+
+func (x *TimelineApproval) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+func (x *TimelineApproval) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *TimelineApproval) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *TimelineApproval) GetTicketUrl() string {
+	if x != nil {
+		return x.TicketUrl
+	}
+	return ""
+}
+
 var File_audit_proto protoreflect.FileDescriptor
 
 var file_audit_proto_rawDesc = []byte{
@@ -434,12 +689,58 @@ var file_audit_proto_rawDesc = []byte{
 	0x69, 0x74, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61,
 	0x74, 0x65, 0x67, 0x79, 0x12, 0x21, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x62, 0x61, 0x70, 0x70, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x41,
-	0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
-	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
-	0x61, 0x75, 0x64, 0x69, 0x74, 0x3b, 0x70, 0x62, 0x61, 0x75, 0x64, 0x69, 0x74, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70, 0x22, 0x80, 0x04, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x73,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x35, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x62, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x75, 0x72, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xd9, 0x01, 0x0a, 0x10, 0x54,
+	0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x55, 0x72, 0x6c, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65,
+	0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x3b, 0x70, 0x62, 0x61, 0x75, 0x64, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -454,28 +755,31 @@ func file_audit_proto_rawDescGZIP() []byte {
 	return file_audit_proto_rawDescData
 }
 
-var file_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_audit_proto_goTypes = []any{
 	(*Audit)(nil),                  // 0: pbaudit.Audit
 	(*AuditSpec)(nil),              // 1: pbaudit.AuditSpec
 	(*AuditAttachment)(nil),        // 2: pbaudit.AuditAttachment
 	(*Revision)(nil),               // 3: pbaudit.Revision
 	(*ListAuditsAppStrategy)(nil),  // 4: pbaudit.ListAuditsAppStrategy
-	(*strategy.AuditStrategy)(nil), // 5: pbstrategy.AuditStrategy
-	(*app.AuditApp)(nil),           // 6: pbapp.AuditApp
+	(*TimelineEvent)(nil),          // 5: pbaudit.TimelineEvent
+	(*TimelineApproval)(nil),       // 6: pbaudit.TimelineApproval
+	(*strategy.AuditStrategy)(nil), // 7: pbstrategy.AuditStrategy
+	(*app.AuditApp)(nil),           // 8: pbapp.AuditApp
 }
 var file_audit_proto_depIdxs = []int32{
 	1, // 0: pbaudit.Audit.spec:type_name -> pbaudit.AuditSpec
 	2, // 1: pbaudit.Audit.attachment:type_name -> pbaudit.AuditAttachment
 	3, // 2: pbaudit.Audit.revision:type_name -> pbaudit.Revision
 	0, // 3: pbaudit.ListAuditsAppStrategy.audit:type_name -> pbaudit.Audit
-	5, // 4: pbaudit.ListAuditsAppStrategy.strategy:type_name -> pbstrategy.AuditStrategy
-	6, // 5: pbaudit.ListAuditsAppStrategy.app:type_name -> pbapp.AuditApp
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	7, // 4: pbaudit.ListAuditsAppStrategy.strategy:type_name -> pbstrategy.AuditStrategy
+	8, // 5: pbaudit.ListAuditsAppStrategy.app:type_name -> pbapp.AuditApp
+	6, // 6: pbaudit.TimelineEvent.approval:type_name -> pbaudit.TimelineApproval
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_audit_proto_init() }
@@ -489,7 +793,7 @@ func file_audit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_audit_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
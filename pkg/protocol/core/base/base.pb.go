@@ -224,6 +224,84 @@ func (x *BasePage) GetOrder() string {
 	return ""
 }
 
+// CursorPage source resource reference: pkg/types/cursor.go
+type CursorPage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit  uint32   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort   string   `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	Filter string   `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *CursorPage) Reset() {
+	*x = CursorPage{}
+	mi := &file_base_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CursorPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CursorPage) ProtoMessage() {}
+
+func (x *CursorPage) ProtoReflect() protoreflect.Message {
+	mi := &file_base_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CursorPage.ProtoReflect.Descriptor instead.
+func (*CursorPage) Descriptor() ([]byte, []int) {
+	return file_base_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CursorPage) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *CursorPage) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *CursorPage) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *CursorPage) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *CursorPage) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type EmptyReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -232,7 +310,7 @@ type EmptyReq struct {
 
 func (x *EmptyReq) Reset() {
 	*x = EmptyReq{}
-	mi := &file_base_proto_msgTypes[3]
+	mi := &file_base_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -244,7 +322,7 @@ func (x *EmptyReq) String() string {
 func (*EmptyReq) ProtoMessage() {}
 
 func (x *EmptyReq) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[3]
+	mi := &file_base_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +335,7 @@ func (x *EmptyReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyReq.ProtoReflect.Descriptor instead.
 func (*EmptyReq) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{3}
+	return file_base_proto_rawDescGZIP(), []int{4}
 }
 
 type EmptyResp struct {
@@ -268,7 +346,7 @@ type EmptyResp struct {
 
 func (x *EmptyResp) Reset() {
 	*x = EmptyResp{}
-	mi := &file_base_proto_msgTypes[4]
+	mi := &file_base_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -280,7 +358,7 @@ func (x *EmptyResp) String() string {
 func (*EmptyResp) ProtoMessage() {}
 
 func (x *EmptyResp) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[4]
+	mi := &file_base_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +371,7 @@ func (x *EmptyResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmptyResp.ProtoReflect.Descriptor instead.
 func (*EmptyResp) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{4}
+	return file_base_proto_rawDescGZIP(), []int{5}
 }
 
 type BaseResp struct {
@@ -307,7 +385,7 @@ type BaseResp struct {
 
 func (x *BaseResp) Reset() {
 	*x = BaseResp{}
-	mi := &file_base_proto_msgTypes[5]
+	mi := &file_base_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -319,7 +397,7 @@ func (x *BaseResp) String() string {
 func (*BaseResp) ProtoMessage() {}
 
 func (x *BaseResp) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[5]
+	mi := &file_base_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +410,7 @@ func (x *BaseResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BaseResp.ProtoReflect.Descriptor instead.
 func (*BaseResp) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{5}
+	return file_base_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BaseResp) GetCode() int32 {
@@ -365,7 +443,7 @@ type Versioning struct {
 
 func (x *Versioning) Reset() {
 	*x = Versioning{}
-	mi := &file_base_proto_msgTypes[6]
+	mi := &file_base_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -377,7 +455,7 @@ func (x *Versioning) String() string {
 func (*Versioning) ProtoMessage() {}
 
 func (x *Versioning) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[6]
+	mi := &file_base_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +468,7 @@ func (x *Versioning) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Versioning.ProtoReflect.Descriptor instead.
 func (*Versioning) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{6}
+	return file_base_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Versioning) GetMajor() uint32 {
@@ -426,7 +504,7 @@ type InvalidArgument struct {
 
 func (x *InvalidArgument) Reset() {
 	*x = InvalidArgument{}
-	mi := &file_base_proto_msgTypes[7]
+	mi := &file_base_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -438,7 +516,7 @@ func (x *InvalidArgument) String() string {
 func (*InvalidArgument) ProtoMessage() {}
 
 func (x *InvalidArgument) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[7]
+	mi := &file_base_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +529,7 @@ func (x *InvalidArgument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvalidArgument.ProtoReflect.Descriptor instead.
 func (*InvalidArgument) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{7}
+	return file_base_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InvalidArgument) GetField() string {
@@ -479,7 +557,7 @@ type ErrDetails struct {
 
 func (x *ErrDetails) Reset() {
 	*x = ErrDetails{}
-	mi := &file_base_proto_msgTypes[8]
+	mi := &file_base_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +569,7 @@ func (x *ErrDetails) String() string {
 func (*ErrDetails) ProtoMessage() {}
 
 func (x *ErrDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_base_proto_msgTypes[8]
+	mi := &file_base_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +582,7 @@ func (x *ErrDetails) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrDetails.ProtoReflect.Descriptor instead.
 func (*ErrDetails) Descriptor() ([]byte, []int) {
-	return file_base_proto_rawDescGZIP(), []int{8}
+	return file_base_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ErrDetails) GetPrimaryError() uint32 {
@@ -557,32 +635,64 @@ var file_base_proto_rawDesc = []byte{
 	0xba, 0xe4, 0xba, 0xba, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73,
 	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12,
 	0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x0a, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x38,
-	0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4e, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4d, 0x69, 0x6e,
-	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x22, 0x41, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x0a, 0x45,
-	0x72, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a,
-	0x0e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
-	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61,
-	0x73, 0x65, 0x3b, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x86, 0x04, 0x0a, 0x0a, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x50, 0x61, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x30, 0xe4, 0xb8, 0x8a, 0xe4, 0xb8,
+	0x80, 0xe9, 0xa1, 0xb5, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0xe6, 0xb8, 0xb8,
+	0xe6, 0xa0, 0x87, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7,
+	0xa4, 0xba, 0xe7, 0xac, 0xac, 0xe4, 0xb8, 0x80, 0xe9, 0xa1, 0xb5, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x3f, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe6, 0xaf, 0x8f, 0xe9, 0xa1, 0xb5, 0xe6,
+	0x9d, 0xa1, 0xe6, 0x95, 0xb0, 0xef, 0xbc, 0x8c, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0x31, 0x30,
+	0x30, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0xa7, 0x35, 0x30, 0x30, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x75, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x61, 0x92, 0x41, 0x5e, 0x32, 0x5c, 0xe6, 0x8e, 0x92, 0xe5, 0xba, 0x8f, 0xe5,
+	0xad, 0x97, 0xe6, 0xae, 0xb5, 0xef, 0xbc, 0x8c, 0xe5, 0xa4, 0x9a, 0xe4, 0xb8, 0xaa, 0xe4, 0xbb,
+	0xa5, 0xe9, 0x80, 0x97, 0xe5, 0x8f, 0xb7, 0xe5, 0x88, 0x86, 0xe5, 0x89, 0xb2, 0xef, 0xbc, 0x8c,
+	0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe5, 0x89, 0x8d, 0xe5, 0x8a, 0xa0, 0x2d, 0xe8, 0xa1, 0xa8,
+	0xe7, 0xa4, 0xba, 0xe9, 0x99, 0x8d, 0xe5, 0xba, 0x8f, 0xef, 0xbc, 0x8c, 0xe4, 0xbe, 0x8b, 0xe5,
+	0xa6, 0x82, 0xef, 0xbc, 0x9a, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x2d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x89, 0x01, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x71, 0x92, 0x41,
+	0x6e, 0x32, 0x6c, 0x6a, 0x73, 0x6f, 0x6e, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xe7, 0x9a, 0x84,
+	0xe8, 0xbf, 0x87, 0xe6, 0xbb, 0xa4, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xef,
+	0xbc, 0x8c, 0xe4, 0xbe, 0x8b, 0xe5, 0xa6, 0x82, 0xef, 0xbc, 0x9a, 0x7b, 0x22, 0x6f, 0x70, 0x22,
+	0x3a, 0x22, 0x61, 0x6e, 0x64, 0x22, 0x2c, 0x22, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x3a, 0x5b,
+	0x7b, 0x22, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x3a, 0x22, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x2c, 0x22, 0x6f, 0x70, 0x22, 0x3a, 0x22, 0x65, 0x71, 0x22, 0x2c, 0x22, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x3a, 0x22, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x7d, 0x5d, 0x7d, 0x52,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x4d, 0x92, 0x41, 0x4a, 0x32, 0x48, 0xe8, 0xbf,
+	0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0x9a, 0x84, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xef, 0xbc, 0x8c,
+	0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe8, 0xbf, 0x94, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xef, 0xbc,
+	0x8c, 0xe4, 0xbe, 0x8b, 0xe5, 0xa6, 0x82, 0xef, 0xbc, 0x9a, 0x69, 0x64, 0x2c, 0x73, 0x70, 0x65,
+	0x63, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x0a,
+	0x0a, 0x08, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x38, 0x0a, 0x08, 0x42, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x4e, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x14, 0x0a, 0x05, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x50,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x50, 0x61, 0x74, 0x63,
+	0x68, 0x22, 0x41, 0x0a, 0x0f, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x58, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x42,
+	0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d,
+	0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x3b, 0x70, 0x62, 0x62, 0x61,
+	0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -597,17 +707,18 @@ func file_base_proto_rawDescGZIP() []byte {
 	return file_base_proto_rawDescData
 }
 
-var file_base_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_base_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_base_proto_goTypes = []any{
 	(*Revision)(nil),        // 0: pbbase.Revision
 	(*CreatedRevision)(nil), // 1: pbbase.CreatedRevision
 	(*BasePage)(nil),        // 2: pbbase.BasePage
-	(*EmptyReq)(nil),        // 3: pbbase.EmptyReq
-	(*EmptyResp)(nil),       // 4: pbbase.EmptyResp
-	(*BaseResp)(nil),        // 5: pbbase.BaseResp
-	(*Versioning)(nil),      // 6: pbbase.Versioning
-	(*InvalidArgument)(nil), // 7: pbbase.InvalidArgument
-	(*ErrDetails)(nil),      // 8: pbbase.ErrDetails
+	(*CursorPage)(nil),      // 3: pbbase.CursorPage
+	(*EmptyReq)(nil),        // 4: pbbase.EmptyReq
+	(*EmptyResp)(nil),       // 5: pbbase.EmptyResp
+	(*BaseResp)(nil),        // 6: pbbase.BaseResp
+	(*Versioning)(nil),      // 7: pbbase.Versioning
+	(*InvalidArgument)(nil), // 8: pbbase.InvalidArgument
+	(*ErrDetails)(nil),      // 9: pbbase.ErrDetails
 }
 var file_base_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -628,7 +739,7 @@ func file_base_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_base_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -38,6 +38,21 @@ func (m *BasePage) BasePage() *types.BasePage {
 	}
 }
 
+// CursorPage convert pb CursorPage to types CursorPage
+func (m *CursorPage) CursorPage() *types.CursorPage {
+	if m == nil {
+		return nil
+	}
+
+	return &types.CursorPage{
+		Cursor: m.Cursor,
+		Limit:  uint(m.Limit),
+		Sort:   m.Sort,
+		Filter: m.Filter,
+		Fields: m.Fields,
+	}
+}
+
 // PbRevision convert table Revision to pb Revision
 func PbRevision(r *table.Revision) *Revision {
 	if r == nil {
@@ -48,7 +63,8 @@ func PbRevision(r *table.Revision) *Revision {
 		Creator:  r.Creator,
 		Reviser:  r.Reviser,
 		CreateAt: r.CreatedAt.Format(time.RFC3339),
-		UpdateAt: r.UpdatedAt.Format(time.RFC3339),
+		// 更新时间带微秒, 作为乐观锁的修订版本
+		UpdateAt: r.RevisionString(),
 	}
 }
 
@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pbbase
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldTree is the tree of the kept fields, key is the proto field name.
+type fieldTree map[string]fieldTree
+
+func (t fieldTree) add(path []string) {
+	if len(path) == 0 {
+		return
+	}
+	sub, ok := t[path[0]]
+	if !ok {
+		sub = make(fieldTree)
+		t[path[0]] = sub
+	} else if len(sub) == 0 {
+		// 已保留整个字段
+		return
+	}
+	if len(path) == 1 {
+		// 保留整个字段, 忽略之前添加的子字段
+		t[path[0]] = make(fieldTree)
+		return
+	}
+	sub.add(path[1:])
+}
+
+// PruneFields keeps only the specified fields of the message and clears the others, the nested field
+// is separated by '.', eg: id,spec.name. it does nothing if fields is empty.
+func PruneFields(msg proto.Message, fields []string) {
+	if msg == nil || len(fields) == 0 {
+		return
+	}
+
+	tree := make(fieldTree)
+	for _, f := range fields {
+		for _, one := range strings.Split(f, ",") {
+			if one = strings.TrimSpace(one); one != "" {
+				tree.add(strings.Split(one, "."))
+			}
+		}
+	}
+	if len(tree) == 0 {
+		return
+	}
+
+	pruneMessage(msg.ProtoReflect(), tree)
+}
+
+func pruneMessage(m protoreflect.Message, tree fieldTree) {
+	cleared := make([]protoreflect.FieldDescriptor, 0)
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		sub, ok := tree[string(fd.Name())]
+		if !ok {
+			cleared = append(cleared, fd)
+			return true
+		}
+		if len(sub) == 0 || fd.Message() == nil || fd.IsMap() {
+			return true
+		}
+
+		if fd.IsList() {
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				pruneMessage(list.Get(i).Message(), sub)
+			}
+			return true
+		}
+		pruneMessage(v.Message(), sub)
+		return true
+	})
+
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
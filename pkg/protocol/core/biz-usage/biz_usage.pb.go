@@ -0,0 +1,201 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: biz_usage.proto
+
+package pbbu
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// BizUsage source resource reference: pkg/dal/table/biz_usage.go
+type BizUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId        uint32  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Month        string  `protobuf:"bytes,2,opt,name=month,proto3" json:"month,omitempty"`
+	StorageBytes uint64  `protobuf:"varint,3,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	EgressBytes  uint64  `protobuf:"varint,4,opt,name=egress_bytes,json=egressBytes,proto3" json:"egress_bytes,omitempty"`
+	ApiCalls     uint64  `protobuf:"varint,5,opt,name=api_calls,json=apiCalls,proto3" json:"api_calls,omitempty"`
+	WatchHours   float64 `protobuf:"fixed64,6,opt,name=watch_hours,json=watchHours,proto3" json:"watch_hours,omitempty"`
+}
+
+func (x *BizUsage) Reset() {
+	*x = BizUsage{}
+	mi := &file_biz_usage_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BizUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BizUsage) ProtoMessage() {}
+
+func (x *BizUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_biz_usage_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BizUsage.ProtoReflect.Descriptor instead.
+func (*BizUsage) Descriptor() ([]byte, []int) {
+	return file_biz_usage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BizUsage) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *BizUsage) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *BizUsage) GetStorageBytes() uint64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *BizUsage) GetEgressBytes() uint64 {
+	if x != nil {
+		return x.EgressBytes
+	}
+	return 0
+}
+
+func (x *BizUsage) GetApiCalls() uint64 {
+	if x != nil {
+		return x.ApiCalls
+	}
+	return 0
+}
+
+func (x *BizUsage) GetWatchHours() float64 {
+	if x != nil {
+		return x.WatchHours
+	}
+	return 0
+}
+
+var File_biz_usage_proto protoreflect.FileDescriptor
+
+var file_biz_usage_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x62, 0x69, 0x7a, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x04, 0x70, 0x62, 0x62, 0x75, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x03, 0x0a, 0x08, 0x42, 0x69, 0x7a, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a,
+	0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x05, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a,
+	0xe7, 0xbb, 0x9f, 0xe8, 0xae, 0xa1, 0xe6, 0x9c, 0x88, 0xe4, 0xbb, 0xbd, 0xef, 0xbc, 0x8c, 0xe5,
+	0xa6, 0x82, 0x20, 0x32, 0x30, 0x32, 0x35, 0x2d, 0x30, 0x36, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74,
+	0x68, 0x12, 0x78, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x53, 0x92, 0x41, 0x50, 0x32, 0x4e, 0xe5,
+	0xad, 0x98, 0xe5, 0x82, 0xa8, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x86,
+	0x85, 0xe5, 0xae, 0xb9, 0xe5, 0xa4, 0xa7, 0xe5, 0xb0, 0x8f, 0xef, 0xbc, 0x88, 0xe5, 0xad, 0x97,
+	0xe8, 0x8a, 0x82, 0xef, 0xbc, 0x89, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe6, 0x9c, 0x88, 0xe5,
+	0x86, 0x85, 0xe6, 0x9c, 0x80, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe7, 0xbb,
+	0x9f, 0xe8, 0xae, 0xa1, 0xe7, 0x9a, 0x84, 0xe5, 0xbf, 0xab, 0xe7, 0x85, 0xa7, 0x52, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x65,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x2c, 0x92, 0x41, 0x29, 0x32, 0x27, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe7, 0x9a,
+	0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0xa4, 0xa7,
+	0xe5, 0xb0, 0x8f, 0xef, 0xbc, 0x88, 0xe5, 0xad, 0x97, 0xe8, 0x8a, 0x82, 0xef, 0xbc, 0x89, 0x52,
+	0x0b, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x09,
+	0x61, 0x70, 0x69, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6,
+	0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe8, 0xaf,
+	0xb7, 0xe6, 0xb1, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x08, 0x61, 0x70, 0x69, 0x43, 0x61, 0x6c, 0x6c,
+	0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe5, 0xae, 0xa2,
+	0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0x9b, 0x91, 0xe5, 0x90, 0xac, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe5, 0x8f, 0x98, 0xe6, 0x9b, 0xb4, 0xe7, 0x9a, 0x84, 0xe7, 0xb4, 0xaf, 0xe8, 0xae,
+	0xa1, 0xe6, 0x97, 0xb6, 0xe9, 0x95, 0xbf, 0xef, 0xbc, 0x88, 0xe5, 0xb0, 0x8f, 0xe6, 0x97, 0xb6,
+	0xef, 0xbc, 0x89, 0x52, 0x0a, 0x77, 0x61, 0x74, 0x63, 0x68, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x42,
+	0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b,
+	0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x69, 0x7a, 0x2d, 0x75, 0x73, 0x61, 0x67,
+	0x65, 0x3b, 0x70, 0x62, 0x62, 0x75, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_biz_usage_proto_rawDescOnce sync.Once
+	file_biz_usage_proto_rawDescData = file_biz_usage_proto_rawDesc
+)
+
+func file_biz_usage_proto_rawDescGZIP() []byte {
+	file_biz_usage_proto_rawDescOnce.Do(func() {
+		file_biz_usage_proto_rawDescData = protoimpl.X.CompressGZIP(file_biz_usage_proto_rawDescData)
+	})
+	return file_biz_usage_proto_rawDescData
+}
+
+var file_biz_usage_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_biz_usage_proto_goTypes = []any{
+	(*BizUsage)(nil), // 0: pbbu.BizUsage
+}
+var file_biz_usage_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_biz_usage_proto_init() }
+func file_biz_usage_proto_init() {
+	if File_biz_usage_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_biz_usage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_biz_usage_proto_goTypes,
+		DependencyIndexes: file_biz_usage_proto_depIdxs,
+		MessageInfos:      file_biz_usage_proto_msgTypes,
+	}.Build()
+	File_biz_usage_proto = out.File
+	file_biz_usage_proto_rawDesc = nil
+	file_biz_usage_proto_goTypes = nil
+	file_biz_usage_proto_depIdxs = nil
+}
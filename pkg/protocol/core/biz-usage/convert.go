@@ -0,0 +1,44 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbbu xxx
+package pbbu
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// PbBizUsage convert table BizUsage to pb BizUsage
+func PbBizUsage(u *table.BizUsage) *BizUsage {
+	if u == nil || u.Spec == nil || u.Attachment == nil {
+		return nil
+	}
+
+	return &BizUsage{
+		BizId:        u.Attachment.BizID,
+		Month:        u.Spec.Month,
+		StorageBytes: u.Spec.StorageBytes,
+		EgressBytes:  u.Spec.EgressBytes,
+		ApiCalls:     u.Spec.APICalls,
+		WatchHours:   u.Spec.WatchHours(),
+	}
+}
+
+// PbBizUsages convert table BizUsage list to pb BizUsage list
+func PbBizUsages(list []*table.BizUsage) []*BizUsage {
+	result := make([]*BizUsage, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbBizUsage(one))
+	}
+
+	return result
+}
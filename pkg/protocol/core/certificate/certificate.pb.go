@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: certificate.proto
+
+package pbcert
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Certificate source resource reference: pkg/dal/table/certificate.go
+type Certificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *CertificateSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *CertificateAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	State      *CertificateState      `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *Certificate) Reset() {
+	*x = Certificate{}
+	mi := &file_certificate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Certificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Certificate) ProtoMessage() {}
+
+func (x *Certificate) ProtoReflect() protoreflect.Message {
+	mi := &file_certificate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Certificate.ProtoReflect.Descriptor instead.
+func (*Certificate) Descriptor() ([]byte, []int) {
+	return file_certificate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Certificate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Certificate) GetSpec() *CertificateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Certificate) GetAttachment() *CertificateAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Certificate) GetState() *CertificateState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// CertificateSpec source resource reference: pkg/dal/table/certificate.go
+type CertificateSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResType   string   `protobuf:"bytes,1,opt,name=res_type,json=resType,proto3" json:"res_type,omitempty"`
+	ResId     uint32   `protobuf:"varint,2,opt,name=res_id,json=resId,proto3" json:"res_id,omitempty"`
+	Name      string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Subject   string   `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	DnsNames  []string `protobuf:"bytes,5,rep,name=dns_names,json=dnsNames,proto3" json:"dns_names,omitempty"`
+	NotBefore string   `protobuf:"bytes,6,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter  string   `protobuf:"bytes,7,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+}
+
+func (x *CertificateSpec) Reset() {
+	*x = CertificateSpec{}
+	mi := &file_certificate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateSpec) ProtoMessage() {}
+
+func (x *CertificateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_certificate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateSpec.ProtoReflect.Descriptor instead.
+func (*CertificateSpec) Descriptor() ([]byte, []int) {
+	return file_certificate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CertificateSpec) GetResType() string {
+	if x != nil {
+		return x.ResType
+	}
+	return ""
+}
+
+func (x *CertificateSpec) GetResId() uint32 {
+	if x != nil {
+		return x.ResId
+	}
+	return 0
+}
+
+func (x *CertificateSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CertificateSpec) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CertificateSpec) GetDnsNames() []string {
+	if x != nil {
+		return x.DnsNames
+	}
+	return nil
+}
+
+func (x *CertificateSpec) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *CertificateSpec) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+// CertificateAttachment source resource reference: pkg/dal/table/certificate.go
+type CertificateAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *CertificateAttachment) Reset() {
+	*x = CertificateAttachment{}
+	mi := &file_certificate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateAttachment) ProtoMessage() {}
+
+func (x *CertificateAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_certificate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateAttachment.ProtoReflect.Descriptor instead.
+func (*CertificateAttachment) Descriptor() ([]byte, []int) {
+	return file_certificate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CertificateAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *CertificateAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// CertificateState source resource reference: pkg/dal/table/certificate.go
+type CertificateState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WarnedAt     string `protobuf:"bytes,1,opt,name=warned_at,json=warnedAt,proto3" json:"warned_at,omitempty"`
+	RenewStatus  string `protobuf:"bytes,2,opt,name=renew_status,json=renewStatus,proto3" json:"renew_status,omitempty"`
+	RenewMessage string `protobuf:"bytes,3,opt,name=renew_message,json=renewMessage,proto3" json:"renew_message,omitempty"`
+	ScannedAt    string `protobuf:"bytes,4,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+}
+
+func (x *CertificateState) Reset() {
+	*x = CertificateState{}
+	mi := &file_certificate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CertificateState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertificateState) ProtoMessage() {}
+
+func (x *CertificateState) ProtoReflect() protoreflect.Message {
+	mi := &file_certificate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertificateState.ProtoReflect.Descriptor instead.
+func (*CertificateState) Descriptor() ([]byte, []int) {
+	return file_certificate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CertificateState) GetWarnedAt() string {
+	if x != nil {
+		return x.WarnedAt
+	}
+	return ""
+}
+
+func (x *CertificateState) GetRenewStatus() string {
+	if x != nil {
+		return x.RenewStatus
+	}
+	return ""
+}
+
+func (x *CertificateState) GetRenewMessage() string {
+	if x != nil {
+		return x.RenewMessage
+	}
+	return ""
+}
+
+func (x *CertificateState) GetScannedAt() string {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return ""
+}
+
+var File_certificate_proto protoreflect.FileDescriptor
+
+var file_certificate_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x62, 0x63, 0x65, 0x72, 0x74, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x01, 0x0a, 0x0b,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x63, 0x65,
+	0x72, 0x74, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
+	0x62, 0x63, 0x65, 0x72, 0x74, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x63, 0x65, 0x72, 0x74, 0x2e,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x9b, 0x03, 0x0a, 0x0f, 0x43, 0x65, 0x72, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x4e, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x33, 0x92,
+	0x41, 0x30, 0x32, 0x2e, 0xe8, 0xaf, 0x81, 0xe4, 0xb9, 0xa6, 0xe6, 0x89, 0x80, 0xe5, 0x9c, 0xa8,
+	0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef,
+	0xbc, 0x9a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0xe3, 0x80, 0x81,
+	0x6b, 0x76, 0x52, 0x07, 0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1f, 0x92, 0x41, 0x1c,
+	0x32, 0x1a, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x88,
+	0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x49, 0x44, 0x52, 0x05, 0x72, 0x65,
+	0x73, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x28, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96,
+	0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
+	0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65, 0x79,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xaf,
+	0x81, 0xe4, 0xb9, 0xa6, 0xe4, 0xb8, 0xbb, 0xe4, 0xbd, 0x93, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x64, 0x6e, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xaf, 0x81,
+	0xe4, 0xb9, 0xa6, 0xe5, 0x9f, 0x9f, 0xe5, 0x90, 0x8d, 0x52, 0x08, 0x64, 0x6e, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x94,
+	0x9f, 0xe6, 0x95, 0x88, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8,
+	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x08, 0x6e, 0x6f, 0x74,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x15,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xba, 0x02, 0x0a,
+	0x10, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x9c, 0x80, 0xe8, 0xbf,
+	0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe5, 0x91, 0x8a, 0xe8, 0xad, 0xa6, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x86, 0x01,
+	0x0a, 0x0c, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x63, 0x92, 0x41, 0x60, 0x32, 0x5e, 0xe8, 0x87, 0xaa, 0xe5, 0x8a,
+	0xa8, 0xe7, 0xbb, 0xad, 0xe6, 0x9c, 0x9f, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a,
+	0xe7, 0xa9, 0xba, 0xef, 0xbc, 0x88, 0xe6, 0x9c, 0xaa, 0xe7, 0xbb, 0xad, 0xe6, 0x9c, 0x9f, 0xef,
+	0xbc, 0x89, 0xe3, 0x80, 0x81, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0xef, 0xbc, 0x88, 0xe5,
+	0xb7, 0xb2, 0xe7, 0xbb, 0xad, 0xe6, 0x9c, 0x9f, 0xef, 0xbc, 0x8c, 0xe6, 0x96, 0xb0, 0xe8, 0xaf,
+	0x81, 0xe4, 0xb9, 0xa6, 0xe5, 0xbe, 0x85, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0xef, 0xbc, 0x89,
+	0xe3, 0x80, 0x81, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x65, 0x77,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6e, 0x65, 0x77, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x9c, 0x80, 0xe8, 0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6,
+	0xac, 0xa1, 0xe6, 0x89, 0xab, 0xe6, 0x8f, 0x8f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x42, 0x49, 0x5a, 0x47, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42,
+	0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x3b, 0x70, 0x62,
+	0x63, 0x65, 0x72, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_certificate_proto_rawDescOnce sync.Once
+	file_certificate_proto_rawDescData = file_certificate_proto_rawDesc
+)
+
+func file_certificate_proto_rawDescGZIP() []byte {
+	file_certificate_proto_rawDescOnce.Do(func() {
+		file_certificate_proto_rawDescData = protoimpl.X.CompressGZIP(file_certificate_proto_rawDescData)
+	})
+	return file_certificate_proto_rawDescData
+}
+
+var file_certificate_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_certificate_proto_goTypes = []any{
+	(*Certificate)(nil),           // 0: pbcert.Certificate
+	(*CertificateSpec)(nil),       // 1: pbcert.CertificateSpec
+	(*CertificateAttachment)(nil), // 2: pbcert.CertificateAttachment
+	(*CertificateState)(nil),      // 3: pbcert.CertificateState
+}
+var file_certificate_proto_depIdxs = []int32{
+	1, // 0: pbcert.Certificate.spec:type_name -> pbcert.CertificateSpec
+	2, // 1: pbcert.Certificate.attachment:type_name -> pbcert.CertificateAttachment
+	3, // 2: pbcert.Certificate.state:type_name -> pbcert.CertificateState
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_certificate_proto_init() }
+func file_certificate_proto_init() {
+	if File_certificate_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_certificate_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_certificate_proto_goTypes,
+		DependencyIndexes: file_certificate_proto_depIdxs,
+		MessageInfos:      file_certificate_proto_msgTypes,
+	}.Build()
+	File_certificate_proto = out.File
+	file_certificate_proto_rawDesc = nil
+	file_certificate_proto_goTypes = nil
+	file_certificate_proto_depIdxs = nil
+}
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbcert xxx
+package pbcert
+
+import (
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// PbCertificate convert table Certificate to pb Certificate
+func PbCertificate(c *table.Certificate) *Certificate {
+	if c == nil {
+		return nil
+	}
+
+	return &Certificate{
+		Id:         c.ID,
+		Spec:       PbCertificateSpec(c.Spec),
+		Attachment: PbCertificateAttachment(c.Attachment),
+		State:      PbCertificateState(c.State),
+	}
+}
+
+// PbCertificates convert table Certificates to pb Certificates
+func PbCertificates(certs []*table.Certificate) []*Certificate {
+	result := make([]*Certificate, 0, len(certs))
+	for _, one := range certs {
+		result = append(result, PbCertificate(one))
+	}
+	return result
+}
+
+// PbCertificateSpec convert table CertificateSpec to pb CertificateSpec
+func PbCertificateSpec(spec *table.CertificateSpec) *CertificateSpec {
+	if spec == nil {
+		return nil
+	}
+
+	var dnsNames []string
+	if spec.DNSNames != "" {
+		dnsNames = strings.Split(spec.DNSNames, ",")
+	}
+	return &CertificateSpec{
+		ResType:   string(spec.ResType),
+		ResId:     spec.ResID,
+		Name:      spec.Name,
+		Subject:   spec.Subject,
+		DnsNames:  dnsNames,
+		NotBefore: spec.NotBefore.Format(time.RFC3339),
+		NotAfter:  spec.NotAfter.Format(time.RFC3339),
+	}
+}
+
+// PbCertificateAttachment convert table CertificateAttachment to pb CertificateAttachment
+func PbCertificateAttachment(at *table.CertificateAttachment) *CertificateAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &CertificateAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// PbCertificateState convert table CertificateState to pb CertificateState
+func PbCertificateState(state *table.CertificateState) *CertificateState {
+	if state == nil {
+		return nil
+	}
+
+	var warnedAt string
+	if state.WarnedAt != nil {
+		warnedAt = state.WarnedAt.Format(time.RFC3339)
+	}
+	return &CertificateState{
+		WarnedAt:     warnedAt,
+		RenewStatus:  string(state.RenewStatus),
+		RenewMessage: state.RenewMessage,
+		ScannedAt:    state.ScannedAt.Format(time.RFC3339),
+	}
+}
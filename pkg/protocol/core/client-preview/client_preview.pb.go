@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: client_preview.proto
+
+package pbcp
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ClientReleasePreview is the release and the content which a client would currently receive.
+type ClientReleasePreview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matched     bool                       `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Reason      string                     `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReleaseId   uint32                     `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseName string                     `protobuf:"bytes,4,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	GroupId     uint32                     `protobuf:"varint,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName   string                     `protobuf:"bytes,6,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupMode   string                     `protobuf:"bytes,7,opt,name=group_mode,json=groupMode,proto3" json:"group_mode,omitempty"`
+	StrategyId  uint32                     `protobuf:"varint,8,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+	ConfigType  string                     `protobuf:"bytes,9,opt,name=config_type,json=configType,proto3" json:"config_type,omitempty"`
+	ConfigItems []*ClientPreviewConfigItem `protobuf:"bytes,10,rep,name=config_items,json=configItems,proto3" json:"config_items,omitempty"`
+	Kvs         []*ClientPreviewKv         `protobuf:"bytes,11,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	Traces      []*ClientMatchTrace        `protobuf:"bytes,12,rep,name=traces,proto3" json:"traces,omitempty"`
+}
+
+func (x *ClientReleasePreview) Reset() {
+	*x = ClientReleasePreview{}
+	mi := &file_client_preview_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientReleasePreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientReleasePreview) ProtoMessage() {}
+
+func (x *ClientReleasePreview) ProtoReflect() protoreflect.Message {
+	mi := &file_client_preview_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientReleasePreview.ProtoReflect.Descriptor instead.
+func (*ClientReleasePreview) Descriptor() ([]byte, []int) {
+	return file_client_preview_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientReleasePreview) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *ClientReleasePreview) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClientReleasePreview) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ClientReleasePreview) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ClientReleasePreview) GetGroupId() uint32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ClientReleasePreview) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ClientReleasePreview) GetGroupMode() string {
+	if x != nil {
+		return x.GroupMode
+	}
+	return ""
+}
+
+func (x *ClientReleasePreview) GetStrategyId() uint32 {
+	if x != nil {
+		return x.StrategyId
+	}
+	return 0
+}
+
+func (x *ClientReleasePreview) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *ClientReleasePreview) GetConfigItems() []*ClientPreviewConfigItem {
+	if x != nil {
+		return x.ConfigItems
+	}
+	return nil
+}
+
+func (x *ClientReleasePreview) GetKvs() []*ClientPreviewKv {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
+
+func (x *ClientReleasePreview) GetTraces() []*ClientMatchTrace {
+	if x != nil {
+		return x.Traces
+	}
+	return nil
+}
+
+// ClientMatchTrace is the trace of a published group evaluated when match the client's release.
+type ClientMatchTrace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupId    uint32           `protobuf:"varint,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	GroupName  string           `protobuf:"bytes,2,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	GroupMode  string           `protobuf:"bytes,3,opt,name=group_mode,json=groupMode,proto3" json:"group_mode,omitempty"`
+	ReleaseId  uint32           `protobuf:"varint,4,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	StrategyId uint32           `protobuf:"varint,5,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+	UpdatedAt  string           `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Matched    bool             `protobuf:"varint,7,opt,name=matched,proto3" json:"matched,omitempty"`
+	Selected   bool             `protobuf:"varint,8,opt,name=selected,proto3" json:"selected,omitempty"`
+	Reason     string           `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	Selector   *structpb.Struct `protobuf:"bytes,10,opt,name=selector,proto3" json:"selector,omitempty"`
+}
+
+func (x *ClientMatchTrace) Reset() {
+	*x = ClientMatchTrace{}
+	mi := &file_client_preview_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientMatchTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientMatchTrace) ProtoMessage() {}
+
+func (x *ClientMatchTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_client_preview_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientMatchTrace.ProtoReflect.Descriptor instead.
+func (*ClientMatchTrace) Descriptor() ([]byte, []int) {
+	return file_client_preview_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientMatchTrace) GetGroupId() uint32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ClientMatchTrace) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ClientMatchTrace) GetGroupMode() string {
+	if x != nil {
+		return x.GroupMode
+	}
+	return ""
+}
+
+func (x *ClientMatchTrace) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ClientMatchTrace) GetStrategyId() uint32 {
+	if x != nil {
+		return x.StrategyId
+	}
+	return 0
+}
+
+func (x *ClientMatchTrace) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ClientMatchTrace) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+func (x *ClientMatchTrace) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+func (x *ClientMatchTrace) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClientMatchTrace) GetSelector() *structpb.Struct {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+// ClientPreviewConfigItem is the released config item which the client would receive.
+type ClientPreviewConfigItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigItemId    uint32 `protobuf:"varint,1,opt,name=config_item_id,json=configItemId,proto3" json:"config_item_id,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path            string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	FileType        string `protobuf:"bytes,4,opt,name=file_type,json=fileType,proto3" json:"file_type,omitempty"`
+	FileMode        string `protobuf:"bytes,5,opt,name=file_mode,json=fileMode,proto3" json:"file_mode,omitempty"`
+	User            string `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	UserGroup       string `protobuf:"bytes,7,opt,name=user_group,json=userGroup,proto3" json:"user_group,omitempty"`
+	Privilege       string `protobuf:"bytes,8,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	Signature       string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	ByteSize        uint64 `protobuf:"varint,10,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
+	Md5             string `protobuf:"bytes,11,opt,name=md5,proto3" json:"md5,omitempty"`
+	OriginSignature string `protobuf:"bytes,12,opt,name=origin_signature,json=originSignature,proto3" json:"origin_signature,omitempty"`
+}
+
+func (x *ClientPreviewConfigItem) Reset() {
+	*x = ClientPreviewConfigItem{}
+	mi := &file_client_preview_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientPreviewConfigItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientPreviewConfigItem) ProtoMessage() {}
+
+func (x *ClientPreviewConfigItem) ProtoReflect() protoreflect.Message {
+	mi := &file_client_preview_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientPreviewConfigItem.ProtoReflect.Descriptor instead.
+func (*ClientPreviewConfigItem) Descriptor() ([]byte, []int) {
+	return file_client_preview_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientPreviewConfigItem) GetConfigItemId() uint32 {
+	if x != nil {
+		return x.ConfigItemId
+	}
+	return 0
+}
+
+func (x *ClientPreviewConfigItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetFileType() string {
+	if x != nil {
+		return x.FileType
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetFileMode() string {
+	if x != nil {
+		return x.FileMode
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetUserGroup() string {
+	if x != nil {
+		return x.UserGroup
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetPrivilege() string {
+	if x != nil {
+		return x.Privilege
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetByteSize() uint64 {
+	if x != nil {
+		return x.ByteSize
+	}
+	return 0
+}
+
+func (x *ClientPreviewConfigItem) GetMd5() string {
+	if x != nil {
+		return x.Md5
+	}
+	return ""
+}
+
+func (x *ClientPreviewConfigItem) GetOriginSignature() string {
+	if x != nil {
+		return x.OriginSignature
+	}
+	return ""
+}
+
+// ClientPreviewKv is the released kv which the client would receive, with the group override resolved.
+type ClientPreviewKv struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key             string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KvType          string `protobuf:"bytes,2,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
+	Value           string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	SecretHidden    bool   `protobuf:"varint,4,opt,name=secret_hidden,json=secretHidden,proto3" json:"secret_hidden,omitempty"`
+	Signature       string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	ByteSize        uint64 `protobuf:"varint,6,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`
+	Md5             string `protobuf:"bytes,7,opt,name=md5,proto3" json:"md5,omitempty"`
+	OverrideGroupId uint32 `protobuf:"varint,8,opt,name=override_group_id,json=overrideGroupId,proto3" json:"override_group_id,omitempty"`
+}
+
+func (x *ClientPreviewKv) Reset() {
+	*x = ClientPreviewKv{}
+	mi := &file_client_preview_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientPreviewKv) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientPreviewKv) ProtoMessage() {}
+
+func (x *ClientPreviewKv) ProtoReflect() protoreflect.Message {
+	mi := &file_client_preview_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientPreviewKv.ProtoReflect.Descriptor instead.
+func (*ClientPreviewKv) Descriptor() ([]byte, []int) {
+	return file_client_preview_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ClientPreviewKv) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ClientPreviewKv) GetKvType() string {
+	if x != nil {
+		return x.KvType
+	}
+	return ""
+}
+
+func (x *ClientPreviewKv) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ClientPreviewKv) GetSecretHidden() bool {
+	if x != nil {
+		return x.SecretHidden
+	}
+	return false
+}
+
+func (x *ClientPreviewKv) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ClientPreviewKv) GetByteSize() uint64 {
+	if x != nil {
+		return x.ByteSize
+	}
+	return 0
+}
+
+func (x *ClientPreviewKv) GetMd5() string {
+	if x != nil {
+		return x.Md5
+	}
+	return ""
+}
+
+func (x *ClientPreviewKv) GetOverrideGroupId() uint32 {
+	if x != nil {
+		return x.OverrideGroupId
+	}
+	return 0
+}
+
+var File_client_preview_proto protoreflect.FileDescriptor
+
+var file_client_preview_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x63, 0x70, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7, 0x07, 0x0a, 0x14, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x50, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x12, 0x3d, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe5, 0x88, 0xb0, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf,
+	0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe6, 0x9c, 0xaa, 0xe5, 0x8c, 0xb9, 0xe9,
+	0x85, 0x8d, 0xe5, 0x88, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x88, 0x96, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84,
+	0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49,
+	0x44, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x44, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe5, 0x8c, 0xb9, 0xe9, 0x85,
+	0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30,
+	0xe4, 0xb8, 0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7,
+	0xbb, 0x84, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x60, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x41, 0x92, 0x41, 0x3e, 0x32, 0x3c, 0xe5, 0x8c,
+	0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x8c, 0xe6, 0x9e, 0x9a, 0xe4, 0xb8, 0xbe, 0xe5, 0x80, 0xbc, 0xef,
+	0xbc, 0x9a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0xe3, 0x80, 0x81, 0x64, 0x65, 0x62, 0x75, 0x67,
+	0xe3, 0x80, 0x81, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32,
+	0x0e, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x49, 0x44, 0x52,
+	0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x8c, 0xe6, 0x9e, 0x9a, 0xe4,
+	0xb8, 0xbe, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x9a, 0x66, 0x69, 0x6c, 0x65, 0xe3, 0x80, 0x81, 0x6b,
+	0x76, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x68, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x63, 0x70, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74,
+	0x65, 0x6d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
+	0xab, 0xaf, 0xe5, 0xb0, 0x86, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe7, 0x9a, 0x84, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x52, 0x0a, 0x03, 0x6b, 0x76, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x63, 0x70, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x76, 0x42, 0x29, 0x92, 0x41, 0x26,
+	0x32, 0x24, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5, 0xb0, 0x86, 0xe6, 0x8b,
+	0x89, 0xe5, 0x8f, 0x96, 0xe7, 0x9a, 0x84, 0xe9, 0x94, 0xae, 0xe5, 0x80, 0xbc, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x52, 0x03, 0x6b, 0x76, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x63, 0x70, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x72, 0x61, 0x63, 0x65, 0x42, 0x56, 0x92, 0x41, 0x53, 0x32, 0x51, 0xe4, 0xb8, 0x8a, 0xe7, 0xba,
+	0xbf, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe8, 0xbf, 0x87, 0xe7, 0xa8, 0x8b, 0xef, 0xbc, 0x8c, 0xe6, 0x8c, 0x89, 0xe4, 0xbc, 0x98, 0xe5,
+	0x85, 0x88, 0xe7, 0xba, 0xa7, 0xe6, 0x8e, 0x92, 0xe5, 0x88, 0x97, 0xef, 0xbc, 0x8c, 0xe4, 0xbb,
+	0x85, 0xe5, 0x9c, 0xa8, 0xe8, 0xaf, 0xb7, 0xe6, 0xb1, 0x82, 0x20, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x20, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0x52, 0x06, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x73, 0x22, 0xc9, 0x05, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x20, 0x92, 0x41, 0x1d,
+	0x32, 0x1b, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30, 0xe4, 0xb8,
+	0xba, 0xe9, 0xbb, 0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x09, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x57, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41,
+	0x35, 0x32, 0x33, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef,
+	0xbc, 0x8c, 0xe6, 0x9e, 0x9a, 0xe4, 0xb8, 0xbe, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x9a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0xe3, 0x80, 0x81, 0x64, 0x65, 0x62, 0x75, 0x67, 0xe3, 0x80, 0x81, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x92, 0x41, 0x19, 0x32, 0x17, 0xe5, 0x88, 0x86, 0xe7,
+	0xbb, 0x84, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34,
+	0x0a, 0x0b, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf,
+	0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x49, 0x44, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x49, 0x64, 0x12, 0x54, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x92, 0x41, 0x32, 0x32, 0x30, 0xe5,
+	0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe6, 0x97, 0xb6, 0xe9, 0x97,
+	0xb4, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe8, 0xb6, 0x8a, 0xe6, 0x99, 0x9a,
+	0xe4, 0xbc, 0x98, 0xe5, 0x85, 0x88, 0xe7, 0xba, 0xa7, 0xe8, 0xb6, 0x8a, 0xe9, 0xab, 0x98, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x20, 0x92, 0x41, 0x1d,
+	0x32, 0x1b, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x8c,
+	0xb9, 0xe9, 0x85, 0x8d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x52, 0x07, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe4, 0xb8, 0xba, 0xe6, 0x9c, 0x80, 0xe7, 0xbb, 0x88, 0xe7, 0x94,
+	0x9f, 0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x52, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe5, 0x8c,
+	0xb9, 0xe9, 0x85, 0x8d, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0xe7, 0x9a, 0x84, 0xe8, 0xaf, 0xb4,
+	0xe6, 0x98, 0x8e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x73, 0x0a, 0x08, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x3e, 0x92, 0x41, 0x3b, 0x32, 0x39, 0xe8, 0x87, 0xaa,
+	0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe6, 0xa0, 0x87, 0xe7,
+	0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6, 0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xe4, 0xb8, 0xad, 0xe5, 0x90,
+	0x84, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe8, 0xaf, 0xa6, 0xe6, 0x83, 0x85, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x22, 0xe1, 0x05, 0x0a, 0x17, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4,
+	0xbb, 0xb6, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x2e,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0xa0,
+	0xbc, 0xe5, 0xbc, 0x8f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e,
+	0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0xa8,
+	0xa1, 0xe5, 0xbc, 0x8f, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x2b,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41,
+	0x14, 0x32, 0x12, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe7,
+	0x94, 0xa8, 0xe6, 0x88, 0xb7, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x89, 0x80, 0xe5,
+	0xb1, 0x9e, 0xe7, 0x94, 0xa8, 0xe6, 0x88, 0xb7, 0xe7, 0xbb, 0x84, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2f, 0x0a, 0x09, 0x70, 0x72, 0x69, 0x76, 0x69, 0x6c,
+	0x65, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c,
+	0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x76, 0x69, 0x6c, 0x65, 0x67, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x68, 0x92, 0x41, 0x65,
+	0x32, 0x63, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f, 0x93, 0xe5, 0x90, 0x8e, 0xe5, 0x86, 0x85, 0xe5, 0xae,
+	0xb9, 0xe7, 0x9a, 0x84, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8e,
+	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x9c, 0xac, 0xe5, 0x9c, 0xb0, 0xe6,
+	0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0xe4, 0xb8,
+	0x80, 0xe8, 0x87, 0xb4, 0xe6, 0x97, 0xb6, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0xe5, 0xae, 0xa2,
+	0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5, 0xb7, 0xb2, 0xe6, 0x98, 0xaf, 0xe8, 0xaf, 0xa5, 0xe5,
+	0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x12, 0x3a, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f, 0x93,
+	0xe5, 0x90, 0x8e, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe7, 0x9a, 0x84, 0xe5, 0xa4, 0xa7, 0xe5,
+	0xb0, 0x8f, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x03,
+	0x6d, 0x64, 0x35, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
+	0xe6, 0xb8, 0xb2, 0xe6, 0x9f, 0x93, 0xe5, 0x90, 0x8e, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe7,
+	0x9a, 0x84, 0x6d, 0x64, 0x35, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x48, 0x0a, 0x10, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0xb8, 0xb2, 0xe6, 0x9f,
+	0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe7, 0x9a, 0x84, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x52, 0x0f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xc7, 0x03, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x76, 0x12, 0x1a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x92, 0x41, 0x05, 0x32, 0x03, 0xe9, 0x94, 0xae, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x94, 0xae, 0xe5,
+	0x80, 0xbc, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe5,
+	0xb0, 0x86, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe7, 0x9a, 0x84, 0xe5, 0x80, 0xbc, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f,
+	0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41,
+	0x1a, 0x32, 0x18, 0xe6, 0x95, 0x8f, 0xe6, 0x84, 0x9f, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0xe6,
+	0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0x52, 0x0c, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0x80, 0xbc, 0xe7, 0x9a, 0x84, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x80, 0xbc, 0xe7, 0x9a, 0x84, 0xe5, 0xa4, 0xa7, 0xe5, 0xb0, 0x8f,
+	0x52, 0x08, 0x62, 0x79, 0x74, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x03, 0x6d, 0x64,
+	0x35, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x80,
+	0xbc, 0xe7, 0x9a, 0x84, 0x6d, 0x64, 0x35, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x70, 0x0a, 0x11,
+	0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32, 0x3f, 0xe5, 0x91,
+	0xbd, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe8, 0xa6, 0x86,
+	0xe7, 0x9b, 0x96, 0xe5, 0x80, 0xbc, 0xe6, 0x89, 0x80, 0xe5, 0xb1, 0x9e, 0xe5, 0x88, 0x86, 0xe7,
+	0xbb, 0x84, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80, 0xe5, 0x80, 0xbc, 0x52, 0x0f, 0x6f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x42, 0x4a,
+	0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d,
+	0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x3b, 0x70, 0x62, 0x63, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_client_preview_proto_rawDescOnce sync.Once
+	file_client_preview_proto_rawDescData = file_client_preview_proto_rawDesc
+)
+
+func file_client_preview_proto_rawDescGZIP() []byte {
+	file_client_preview_proto_rawDescOnce.Do(func() {
+		file_client_preview_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_preview_proto_rawDescData)
+	})
+	return file_client_preview_proto_rawDescData
+}
+
+var file_client_preview_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_client_preview_proto_goTypes = []any{
+	(*ClientReleasePreview)(nil),    // 0: pbcp.ClientReleasePreview
+	(*ClientMatchTrace)(nil),        // 1: pbcp.ClientMatchTrace
+	(*ClientPreviewConfigItem)(nil), // 2: pbcp.ClientPreviewConfigItem
+	(*ClientPreviewKv)(nil),         // 3: pbcp.ClientPreviewKv
+	(*structpb.Struct)(nil),         // 4: google.protobuf.Struct
+}
+var file_client_preview_proto_depIdxs = []int32{
+	2, // 0: pbcp.ClientReleasePreview.config_items:type_name -> pbcp.ClientPreviewConfigItem
+	3, // 1: pbcp.ClientReleasePreview.kvs:type_name -> pbcp.ClientPreviewKv
+	1, // 2: pbcp.ClientReleasePreview.traces:type_name -> pbcp.ClientMatchTrace
+	4, // 3: pbcp.ClientMatchTrace.selector:type_name -> google.protobuf.Struct
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_client_preview_proto_init() }
+func file_client_preview_proto_init() {
+	if File_client_preview_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_client_preview_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_client_preview_proto_goTypes,
+		DependencyIndexes: file_client_preview_proto_depIdxs,
+		MessageInfos:      file_client_preview_proto_msgTypes,
+	}.Build()
+	File_client_preview_proto = out.File
+	file_client_preview_proto_rawDesc = nil
+	file_client_preview_proto_goTypes = nil
+	file_client_preview_proto_depIdxs = nil
+}
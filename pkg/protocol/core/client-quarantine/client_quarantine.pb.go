@@ -0,0 +1,349 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: client_quarantine.proto
+
+package pbcq
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ClientQuarantine source resource reference: pkg/dal/table/client_quarantine.go
+type ClientQuarantine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ClientQuarantineSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ClientQuarantineAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision              `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ClientQuarantine) Reset() {
+	*x = ClientQuarantine{}
+	mi := &file_client_quarantine_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientQuarantine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientQuarantine) ProtoMessage() {}
+
+func (x *ClientQuarantine) ProtoReflect() protoreflect.Message {
+	mi := &file_client_quarantine_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientQuarantine.ProtoReflect.Descriptor instead.
+func (*ClientQuarantine) Descriptor() ([]byte, []int) {
+	return file_client_quarantine_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ClientQuarantine) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ClientQuarantine) GetSpec() *ClientQuarantineSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ClientQuarantine) GetAttachment() *ClientQuarantineAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ClientQuarantine) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ClientQuarantineSpec source resource reference: pkg/dal/table/client_quarantine.go
+type ClientQuarantineSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MatchType     string `protobuf:"bytes,1,opt,name=match_type,json=matchType,proto3" json:"match_type,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RetryAfterSec uint32 `protobuf:"varint,4,opt,name=retry_after_sec,json=retryAfterSec,proto3" json:"retry_after_sec,omitempty"`
+	ExpiredAt     string `protobuf:"bytes,5,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	Enabled       bool   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *ClientQuarantineSpec) Reset() {
+	*x = ClientQuarantineSpec{}
+	mi := &file_client_quarantine_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientQuarantineSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientQuarantineSpec) ProtoMessage() {}
+
+func (x *ClientQuarantineSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_client_quarantine_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientQuarantineSpec.ProtoReflect.Descriptor instead.
+func (*ClientQuarantineSpec) Descriptor() ([]byte, []int) {
+	return file_client_quarantine_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ClientQuarantineSpec) GetMatchType() string {
+	if x != nil {
+		return x.MatchType
+	}
+	return ""
+}
+
+func (x *ClientQuarantineSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ClientQuarantineSpec) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ClientQuarantineSpec) GetRetryAfterSec() uint32 {
+	if x != nil {
+		return x.RetryAfterSec
+	}
+	return 0
+}
+
+func (x *ClientQuarantineSpec) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *ClientQuarantineSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// ClientQuarantineAttachment source resource reference: pkg/dal/table/client_quarantine.go
+type ClientQuarantineAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+}
+
+func (x *ClientQuarantineAttachment) Reset() {
+	*x = ClientQuarantineAttachment{}
+	mi := &file_client_quarantine_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientQuarantineAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientQuarantineAttachment) ProtoMessage() {}
+
+func (x *ClientQuarantineAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_client_quarantine_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientQuarantineAttachment.ProtoReflect.Descriptor instead.
+func (*ClientQuarantineAttachment) Descriptor() ([]byte, []int) {
+	return file_client_quarantine_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ClientQuarantineAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+var File_client_quarantine_proto protoreflect.FileDescriptor
+
+var file_client_quarantine_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74,
+	0x69, 0x6e, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x63, 0x71, 0x1a,
+	0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd1, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61,
+	0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe9, 0x9a, 0x94, 0xe7, 0xa6, 0xbb,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x63, 0x71, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x63,
+	0x71, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69,
+	0x6e, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x04, 0x0a, 0x14, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12,
+	0x66, 0x0a, 0x0a, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x42, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe6, 0x96, 0xb9, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x9a, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x3d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x8c,
+	0x87, 0xe7, 0xba, 0xb9, 0xef, 0xbc, 0x8c, 0x69, 0x70, 0x3d, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7,
+	0xe7, 0xab, 0xaf, 0x49, 0x50, 0xe6, 0x88, 0x96, 0x43, 0x49, 0x44, 0x52, 0x52, 0x09, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x38, 0x92, 0x41, 0x35, 0x32, 0x33, 0xe5, 0xae, 0xa2,
+	0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x8c, 0x87, 0xe7, 0xba, 0xb9, 0xe3, 0x80, 0x81, 0x49,
+	0x50, 0xe6, 0x88, 0x96, 0x43, 0x49, 0x44, 0x52, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb,
+	0xba, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x8d, 0xe5, 0x8f, 0xaf, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe9, 0x9a,
+	0x94, 0xe7, 0xa6, 0xbb, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0xef, 0xbc, 0x8c, 0xe4, 0xbc, 0x9a,
+	0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x99, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
+	0xab, 0xaf, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x63, 0x0a, 0x0f, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe8, 0xbf, 0x94, 0xe5, 0x9b, 0x9e,
+	0xe7, 0xbb, 0x99, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0x9a, 0x84, 0xe9,
+	0x80, 0x80, 0xe9, 0x81, 0xbf, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0xe7, 0xa7, 0x92, 0x29,
+	0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x33, 0x30, 0x30,
+	0x52, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x79, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
+	0x51, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x28, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x29, 0xef,
+	0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe6, 0xb0, 0xb8, 0xe4, 0xb9,
+	0x85, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75,
+	0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x1a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x51, 0x75, 0x61, 0x72, 0x61, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a,
+	0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2d, 0x71, 0x75, 0x61, 0x72, 0x61, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x71, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_client_quarantine_proto_rawDescOnce sync.Once
+	file_client_quarantine_proto_rawDescData = file_client_quarantine_proto_rawDesc
+)
+
+func file_client_quarantine_proto_rawDescGZIP() []byte {
+	file_client_quarantine_proto_rawDescOnce.Do(func() {
+		file_client_quarantine_proto_rawDescData = protoimpl.X.CompressGZIP(file_client_quarantine_proto_rawDescData)
+	})
+	return file_client_quarantine_proto_rawDescData
+}
+
+var file_client_quarantine_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_client_quarantine_proto_goTypes = []any{
+	(*ClientQuarantine)(nil),           // 0: pbcq.ClientQuarantine
+	(*ClientQuarantineSpec)(nil),       // 1: pbcq.ClientQuarantineSpec
+	(*ClientQuarantineAttachment)(nil), // 2: pbcq.ClientQuarantineAttachment
+	(*base.Revision)(nil),              // 3: pbbase.Revision
+}
+var file_client_quarantine_proto_depIdxs = []int32{
+	1, // 0: pbcq.ClientQuarantine.spec:type_name -> pbcq.ClientQuarantineSpec
+	2, // 1: pbcq.ClientQuarantine.attachment:type_name -> pbcq.ClientQuarantineAttachment
+	3, // 2: pbcq.ClientQuarantine.revision:type_name -> pbbase.Revision
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_client_quarantine_proto_init() }
+func file_client_quarantine_proto_init() {
+	if File_client_quarantine_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_client_quarantine_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_client_quarantine_proto_goTypes,
+		DependencyIndexes: file_client_quarantine_proto_depIdxs,
+		MessageInfos:      file_client_quarantine_proto_msgTypes,
+	}.Build()
+	File_client_quarantine_proto = out.File
+	file_client_quarantine_proto_rawDesc = nil
+	file_client_quarantine_proto_goTypes = nil
+	file_client_quarantine_proto_depIdxs = nil
+}
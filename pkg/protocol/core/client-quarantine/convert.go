@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbcq xxx
+package pbcq
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ClientQuarantineSpec convert pb ClientQuarantineSpec to table ClientQuarantineSpec
+func (m *ClientQuarantineSpec) ClientQuarantineSpec() (*table.ClientQuarantineSpec, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	spec := &table.ClientQuarantineSpec{
+		MatchType:     table.QuarantineMatchType(m.MatchType),
+		Value:         m.Value,
+		Reason:        m.Reason,
+		RetryAfterSec: m.RetryAfterSec,
+		Enabled:       m.Enabled,
+	}
+	if m.ExpiredAt != "" {
+		t, err := time.Parse(time.RFC3339, m.ExpiredAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expired at %s, should be RFC3339 format", m.ExpiredAt)
+		}
+		spec.ExpiredAt = &t
+	}
+
+	return spec, nil
+}
+
+// PbClientQuarantineSpec convert table ClientQuarantineSpec to pb ClientQuarantineSpec
+func PbClientQuarantineSpec(spec *table.ClientQuarantineSpec) *ClientQuarantineSpec {
+	if spec == nil {
+		return nil
+	}
+
+	result := &ClientQuarantineSpec{
+		MatchType:     string(spec.MatchType),
+		Value:         spec.Value,
+		Reason:        spec.Reason,
+		RetryAfterSec: spec.RetryAfterSec,
+		Enabled:       spec.Enabled,
+	}
+	if spec.ExpiredAt != nil {
+		result.ExpiredAt = spec.ExpiredAt.Format(time.RFC3339)
+	}
+
+	return result
+}
+
+// PbClientQuarantine convert table ClientQuarantine to pb ClientQuarantine
+func PbClientQuarantine(c *table.ClientQuarantine) *ClientQuarantine {
+	if c == nil {
+		return nil
+	}
+
+	var at *ClientQuarantineAttachment
+	if c.Attachment != nil {
+		at = &ClientQuarantineAttachment{BizId: c.Attachment.BizID}
+	}
+
+	return &ClientQuarantine{
+		Id:         c.ID,
+		Spec:       PbClientQuarantineSpec(c.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(c.Revision),
+	}
+}
+
+// PbClientQuarantines convert table ClientQuarantine list to pb ClientQuarantine list
+func PbClientQuarantines(list []*table.ClientQuarantine) []*ClientQuarantine {
+	result := make([]*ClientQuarantine, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbClientQuarantine(one))
+	}
+
+	return result
+}
@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: compliance_policy.proto
+
+package pbcp
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CompliancePolicy source resource reference: pkg/dal/table/compliance_policy.go
+type CompliancePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *CompliancePolicySpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *CompliancePolicyAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision              `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *CompliancePolicy) Reset() {
+	*x = CompliancePolicy{}
+	mi := &file_compliance_policy_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompliancePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompliancePolicy) ProtoMessage() {}
+
+func (x *CompliancePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_compliance_policy_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompliancePolicy.ProtoReflect.Descriptor instead.
+func (*CompliancePolicy) Descriptor() ([]byte, []int) {
+	return file_compliance_policy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompliancePolicy) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompliancePolicy) GetSpec() *CompliancePolicySpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetAttachment() *CompliancePolicyAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *CompliancePolicy) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// CompliancePolicySpec source resource reference: pkg/dal/table/compliance_policy.go
+type CompliancePolicySpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Memo     string          `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Severity string          `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Enabled  bool            `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Target   string          `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	EnvIds   []uint32        `protobuf:"varint,6,rep,packed,name=env_ids,json=envIds,proto3" json:"env_ids,omitempty"`
+	Rule     *ComplianceRule `protobuf:"bytes,7,opt,name=rule,proto3" json:"rule,omitempty"`
+}
+
+func (x *CompliancePolicySpec) Reset() {
+	*x = CompliancePolicySpec{}
+	mi := &file_compliance_policy_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompliancePolicySpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompliancePolicySpec) ProtoMessage() {}
+
+func (x *CompliancePolicySpec) ProtoReflect() protoreflect.Message {
+	mi := &file_compliance_policy_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompliancePolicySpec.ProtoReflect.Descriptor instead.
+func (*CompliancePolicySpec) Descriptor() ([]byte, []int) {
+	return file_compliance_policy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompliancePolicySpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompliancePolicySpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CompliancePolicySpec) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *CompliancePolicySpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *CompliancePolicySpec) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CompliancePolicySpec) GetEnvIds() []uint32 {
+	if x != nil {
+		return x.EnvIds
+	}
+	return nil
+}
+
+func (x *CompliancePolicySpec) GetRule() *ComplianceRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// ComplianceRule source resource reference: pkg/dal/table/compliance_policy.go
+type ComplianceRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	NamePattern string `protobuf:"bytes,2,opt,name=name_pattern,json=namePattern,proto3" json:"name_pattern,omitempty"`
+	Pattern     string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Op          string `protobuf:"bytes,4,opt,name=op,proto3" json:"op,omitempty"`
+	Expected    string `protobuf:"bytes,5,opt,name=expected,proto3" json:"expected,omitempty"`
+}
+
+func (x *ComplianceRule) Reset() {
+	*x = ComplianceRule{}
+	mi := &file_compliance_policy_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplianceRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceRule) ProtoMessage() {}
+
+func (x *ComplianceRule) ProtoReflect() protoreflect.Message {
+	mi := &file_compliance_policy_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceRule.ProtoReflect.Descriptor instead.
+func (*ComplianceRule) Descriptor() ([]byte, []int) {
+	return file_compliance_policy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ComplianceRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ComplianceRule) GetNamePattern() string {
+	if x != nil {
+		return x.NamePattern
+	}
+	return ""
+}
+
+func (x *ComplianceRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *ComplianceRule) GetOp() string {
+	if x != nil {
+		return x.Op
+	}
+	return ""
+}
+
+func (x *ComplianceRule) GetExpected() string {
+	if x != nil {
+		return x.Expected
+	}
+	return ""
+}
+
+// CompliancePolicyAttachment source resource reference: pkg/dal/table/compliance_policy.go
+type CompliancePolicyAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+}
+
+func (x *CompliancePolicyAttachment) Reset() {
+	*x = CompliancePolicyAttachment{}
+	mi := &file_compliance_policy_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompliancePolicyAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompliancePolicyAttachment) ProtoMessage() {}
+
+func (x *CompliancePolicyAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_compliance_policy_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompliancePolicyAttachment.ProtoReflect.Descriptor instead.
+func (*CompliancePolicyAttachment) Descriptor() ([]byte, []int) {
+	return file_compliance_policy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CompliancePolicyAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+// ComplianceViolation source resource reference: pkg/dal/table/compliance_policy.go
+type ComplianceViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PolicyId   uint32 `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	PolicyName string `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	Severity   string `protobuf:"bytes,3,opt,name=severity,proto3" json:"severity,omitempty"`
+	Target     string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	Name       string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Message    string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ComplianceViolation) Reset() {
+	*x = ComplianceViolation{}
+	mi := &file_compliance_policy_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ComplianceViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ComplianceViolation) ProtoMessage() {}
+
+func (x *ComplianceViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_compliance_policy_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ComplianceViolation.ProtoReflect.Descriptor instead.
+func (*ComplianceViolation) Descriptor() ([]byte, []int) {
+	return file_compliance_policy_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ComplianceViolation) GetPolicyId() uint32 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *ComplianceViolation) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *ComplianceViolation) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *ComplianceViolation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ComplianceViolation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComplianceViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_compliance_policy_proto protoreflect.FileDescriptor
+
+var file_compliance_policy_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x63, 0x70, 0x1a,
+	0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f,
+	0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0x90, 0x88, 0xe8, 0xa7, 0x84,
+	0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x63,
+	0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x63, 0x70, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf2, 0x03, 0x0a,
+	0x14, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32,
+	0x0c, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x12, 0x6e, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f, 0x32, 0x4d, 0xe8, 0xbf, 0x9d, 0xe5,
+	0x8f, 0x8d, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84, 0xe5, 0xa4,
+	0x84, 0xe7, 0x90, 0x86, 0xef, 0xbc, 0x9a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3d, 0xe9, 0x98, 0xbb,
+	0xe6, 0xad, 0xa2, 0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe5, 0x92, 0x8c, 0xe4, 0xb8, 0x8a, 0xe7,
+	0xba, 0xbf, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xef, 0xbc, 0x8c, 0x77, 0x61, 0x72, 0x6e, 0x3d,
+	0xe4, 0xbb, 0x85, 0xe6, 0x8f, 0x90, 0xe7, 0xa4, 0xba, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x42, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72,
+	0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe6, 0xa3,
+	0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x66, 0x69, 0x6c, 0x65, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0xef,
+	0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe9, 0x83, 0xbd, 0xe6, 0xa3,
+	0x80, 0xe6, 0x9f, 0xa5, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x59, 0x0a, 0x07,
+	0x65, 0x6e, 0x76, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x40, 0x92,
+	0x41, 0x3d, 0x32, 0x3b, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe7, 0x8e, 0xaf,
+	0xe5, 0xa2, 0x83, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97,
+	0xb6, 0xe5, 0xaf, 0xb9, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0x8b, 0xe6, 0x89, 0x80,
+	0xe6, 0x9c, 0x89, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52,
+	0x06, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x70, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x22, 0x88, 0x04, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x77, 0x92, 0x41, 0x74, 0x32, 0x72, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x64, 0x65, 0x6e, 0x79, 0x3d, 0xe5, 0x86,
+	0x85, 0xe5, 0xae, 0xb9, 0xe4, 0xb8, 0x8d, 0xe8, 0x83, 0xbd, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xef, 0xbc, 0x8c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x3d, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe5, 0xbf, 0x85, 0xe9, 0xa1, 0xbb, 0xe5, 0x8c, 0xb9,
+	0xe9, 0x85, 0x8d, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xef, 0xbc, 0x8c, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x3d, 0xe5, 0x80, 0xbc, 0xe9, 0x9c, 0x80, 0xe6, 0xbb, 0xa1, 0xe8, 0xb6, 0xb3,
+	0xe6, 0xaf, 0x94, 0xe8, 0xbe, 0x83, 0xe6, 0x9d, 0xa1, 0xe4, 0xbb, 0xb6, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x5d, 0x32, 0x5b,
+	0xe6, 0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6,
+	0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe,
+	0x84, 0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65,
+	0x79, 0xef, 0xbc, 0x8c, 0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0xe9, 0x80, 0x9a, 0xe9, 0x85, 0x8d,
+	0xe7, 0xac, 0xa6, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe6,
+	0xa3, 0x80, 0xe6, 0x9f, 0xa5, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0x52, 0x0b, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x69, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4f, 0x92, 0x41, 0x4c, 0x32, 0x4a,
+	0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xef,
+	0xbc, 0x8c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x20, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0x96, 0xe7, 0xac, 0xac, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe6,
+	0x8d, 0x95, 0xe8, 0x8e, 0xb7, 0xe7, 0xbb, 0x84, 0xe4, 0xbd, 0x9c, 0xe4, 0xb8, 0xba, 0xe6, 0xaf,
+	0x94, 0xe8, 0xbe, 0x83, 0xe7, 0x9a, 0x84, 0xe5, 0x80, 0xbc, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x12, 0x42, 0x0a, 0x02, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe6, 0xaf, 0x94, 0xe8, 0xbe, 0x83, 0xe6, 0x93, 0x8d, 0xe4,
+	0xbd, 0x9c, 0xe7, 0xac, 0xa6, 0xef, 0xbc, 0x9a, 0x65, 0x71, 0xe3, 0x80, 0x81, 0x6e, 0x65, 0xe3,
+	0x80, 0x81, 0x67, 0x74, 0xe3, 0x80, 0x81, 0x67, 0x65, 0xe3, 0x80, 0x81, 0x6c, 0x74, 0xe3, 0x80,
+	0x81, 0x6c, 0x65, 0x52, 0x02, 0x6f, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe6, 0xaf, 0x94, 0xe8, 0xbe, 0x83, 0xe7, 0x9a, 0x84, 0xe7, 0x9b, 0xae, 0xe6, 0xa0, 0x87, 0xe5,
+	0x80, 0xbc, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x1a,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64,
+	0x22, 0xc8, 0x02, 0x0a, 0x13, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x56,
+	0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10,
+	0x32, 0x0e, 0xe5, 0x90, 0x88, 0xe8, 0xa7, 0x84, 0xe7, 0xad, 0x96, 0xe7, 0x95, 0xa5, 0x49, 0x44,
+	0x52, 0x08, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x90, 0x88, 0xe8, 0xa7, 0x84, 0xe7, 0xad, 0x96, 0xe7,
+	0x95, 0xa5, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0xe3, 0x80, 0x81, 0x77, 0x61, 0x72, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0x66, 0x69, 0x6c, 0x65, 0xe3, 0x80,
+	0x81, 0x6b, 0x76, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x28,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5,
+	0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xbf, 0x9d, 0xe8, 0xa7, 0x84, 0xe8, 0xaf, 0xa6, 0xe6,
+	0x83, 0x85, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x4d, 0x5a, 0x4b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e,
+	0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63,
+	0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x2d, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x3b, 0x70, 0x62, 0x63, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_compliance_policy_proto_rawDescOnce sync.Once
+	file_compliance_policy_proto_rawDescData = file_compliance_policy_proto_rawDesc
+)
+
+func file_compliance_policy_proto_rawDescGZIP() []byte {
+	file_compliance_policy_proto_rawDescOnce.Do(func() {
+		file_compliance_policy_proto_rawDescData = protoimpl.X.CompressGZIP(file_compliance_policy_proto_rawDescData)
+	})
+	return file_compliance_policy_proto_rawDescData
+}
+
+var file_compliance_policy_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_compliance_policy_proto_goTypes = []any{
+	(*CompliancePolicy)(nil),           // 0: pbcp.CompliancePolicy
+	(*CompliancePolicySpec)(nil),       // 1: pbcp.CompliancePolicySpec
+	(*ComplianceRule)(nil),             // 2: pbcp.ComplianceRule
+	(*CompliancePolicyAttachment)(nil), // 3: pbcp.CompliancePolicyAttachment
+	(*ComplianceViolation)(nil),        // 4: pbcp.ComplianceViolation
+	(*base.Revision)(nil),              // 5: pbbase.Revision
+}
+var file_compliance_policy_proto_depIdxs = []int32{
+	1, // 0: pbcp.CompliancePolicy.spec:type_name -> pbcp.CompliancePolicySpec
+	3, // 1: pbcp.CompliancePolicy.attachment:type_name -> pbcp.CompliancePolicyAttachment
+	5, // 2: pbcp.CompliancePolicy.revision:type_name -> pbbase.Revision
+	2, // 3: pbcp.CompliancePolicySpec.rule:type_name -> pbcp.ComplianceRule
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_compliance_policy_proto_init() }
+func file_compliance_policy_proto_init() {
+	if File_compliance_policy_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_compliance_policy_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_compliance_policy_proto_goTypes,
+		DependencyIndexes: file_compliance_policy_proto_depIdxs,
+		MessageInfos:      file_compliance_policy_proto_msgTypes,
+	}.Build()
+	File_compliance_policy_proto = out.File
+	file_compliance_policy_proto_rawDesc = nil
+	file_compliance_policy_proto_goTypes = nil
+	file_compliance_policy_proto_depIdxs = nil
+}
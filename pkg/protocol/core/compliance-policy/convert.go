@@ -0,0 +1,126 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbcp xxx
+package pbcp
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// CompliancePolicySpec convert pb CompliancePolicySpec to table CompliancePolicySpec
+func (m *CompliancePolicySpec) CompliancePolicySpec() *table.CompliancePolicySpec {
+	if m == nil {
+		return nil
+	}
+
+	spec := &table.CompliancePolicySpec{
+		Name:     m.Name,
+		Memo:     m.Memo,
+		Severity: table.ComplianceSeverity(m.Severity),
+		Enabled:  m.Enabled,
+		Target:   table.ComplianceTarget(m.Target),
+		EnvIDs:   m.EnvIds,
+	}
+	if m.Rule != nil {
+		spec.Rule = table.ComplianceRule{
+			Type:        table.ComplianceRuleType(m.Rule.Type),
+			NamePattern: m.Rule.NamePattern,
+			Pattern:     m.Rule.Pattern,
+			Op:          m.Rule.Op,
+			Expected:    m.Rule.Expected,
+		}
+	}
+
+	return spec
+}
+
+// PbCompliancePolicySpec convert table CompliancePolicySpec to pb CompliancePolicySpec
+func PbCompliancePolicySpec(spec *table.CompliancePolicySpec) *CompliancePolicySpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &CompliancePolicySpec{
+		Name:     spec.Name,
+		Memo:     spec.Memo,
+		Severity: string(spec.Severity),
+		Enabled:  spec.Enabled,
+		Target:   string(spec.Target),
+		EnvIds:   spec.EnvIDs,
+		Rule: &ComplianceRule{
+			Type:        string(spec.Rule.Type),
+			NamePattern: spec.Rule.NamePattern,
+			Pattern:     spec.Rule.Pattern,
+			Op:          spec.Rule.Op,
+			Expected:    spec.Rule.Expected,
+		},
+	}
+}
+
+// CompliancePolicyAttachment convert pb CompliancePolicyAttachment to table CompliancePolicyAttachment
+func (m *CompliancePolicyAttachment) CompliancePolicyAttachment() *table.CompliancePolicyAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.CompliancePolicyAttachment{
+		BizID: m.BizId,
+	}
+}
+
+// PbCompliancePolicy convert table CompliancePolicy to pb CompliancePolicy
+func PbCompliancePolicy(p *table.CompliancePolicy) *CompliancePolicy {
+	if p == nil {
+		return nil
+	}
+
+	var at *CompliancePolicyAttachment
+	if p.Attachment != nil {
+		at = &CompliancePolicyAttachment{BizId: p.Attachment.BizID}
+	}
+
+	return &CompliancePolicy{
+		Id:         p.ID,
+		Spec:       PbCompliancePolicySpec(p.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(p.Revision),
+	}
+}
+
+// PbCompliancePolicies convert table CompliancePolicy list to pb CompliancePolicy list
+func PbCompliancePolicies(list []*table.CompliancePolicy) []*CompliancePolicy {
+	result := make([]*CompliancePolicy, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbCompliancePolicy(one))
+	}
+
+	return result
+}
+
+// PbComplianceViolations convert table ComplianceViolation list to pb ComplianceViolation list
+func PbComplianceViolations(list []*table.ComplianceViolation) []*ComplianceViolation {
+	result := make([]*ComplianceViolation, 0, len(list))
+	for _, one := range list {
+		result = append(result, &ComplianceViolation{
+			PolicyId:   one.PolicyID,
+			PolicyName: one.PolicyName,
+			Severity:   string(one.Severity),
+			Target:     string(one.Target),
+			Name:       one.Name,
+			Message:    one.Message,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: config_reference.proto
+
+package pbcref
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigReferenceSpec source resource reference: pkg/dal/table/config_reference.go
+type ConfigReferenceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SrcType string `protobuf:"bytes,1,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	SrcId   uint32 `protobuf:"varint,2,opt,name=src_id,json=srcId,proto3" json:"src_id,omitempty"`
+	DstType string `protobuf:"bytes,3,opt,name=dst_type,json=dstType,proto3" json:"dst_type,omitempty"`
+	DstId   uint32 `protobuf:"varint,4,opt,name=dst_id,json=dstId,proto3" json:"dst_id,omitempty"`
+	RefType string `protobuf:"bytes,5,opt,name=ref_type,json=refType,proto3" json:"ref_type,omitempty"`
+	Memo    string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ConfigReferenceSpec) Reset() {
+	*x = ConfigReferenceSpec{}
+	mi := &file_config_reference_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigReferenceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigReferenceSpec) ProtoMessage() {}
+
+func (x *ConfigReferenceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_reference_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigReferenceSpec.ProtoReflect.Descriptor instead.
+func (*ConfigReferenceSpec) Descriptor() ([]byte, []int) {
+	return file_config_reference_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigReferenceSpec) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *ConfigReferenceSpec) GetSrcId() uint32 {
+	if x != nil {
+		return x.SrcId
+	}
+	return 0
+}
+
+func (x *ConfigReferenceSpec) GetDstType() string {
+	if x != nil {
+		return x.DstType
+	}
+	return ""
+}
+
+func (x *ConfigReferenceSpec) GetDstId() uint32 {
+	if x != nil {
+		return x.DstId
+	}
+	return 0
+}
+
+func (x *ConfigReferenceSpec) GetRefType() string {
+	if x != nil {
+		return x.RefType
+	}
+	return ""
+}
+
+func (x *ConfigReferenceSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ConfigRefNode is a config in the reference graph.
+type ConfigRefNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Id    uint32 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	BizId uint32 `protobuf:"varint,3,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Name  string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *ConfigRefNode) Reset() {
+	*x = ConfigRefNode{}
+	mi := &file_config_reference_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigRefNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRefNode) ProtoMessage() {}
+
+func (x *ConfigRefNode) ProtoReflect() protoreflect.Message {
+	mi := &file_config_reference_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRefNode.ProtoReflect.Descriptor instead.
+func (*ConfigRefNode) Descriptor() ([]byte, []int) {
+	return file_config_reference_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigRefNode) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConfigRefNode) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigRefNode) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ConfigRefNode) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ConfigRefNode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ConfigRefEdge is a reference in the reference graph.
+type ConfigRefEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SrcType string `protobuf:"bytes,2,opt,name=src_type,json=srcType,proto3" json:"src_type,omitempty"`
+	SrcId   uint32 `protobuf:"varint,3,opt,name=src_id,json=srcId,proto3" json:"src_id,omitempty"`
+	DstType string `protobuf:"bytes,4,opt,name=dst_type,json=dstType,proto3" json:"dst_type,omitempty"`
+	DstId   uint32 `protobuf:"varint,5,opt,name=dst_id,json=dstId,proto3" json:"dst_id,omitempty"`
+	RefType string `protobuf:"bytes,6,opt,name=ref_type,json=refType,proto3" json:"ref_type,omitempty"`
+}
+
+func (x *ConfigRefEdge) Reset() {
+	*x = ConfigRefEdge{}
+	mi := &file_config_reference_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigRefEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRefEdge) ProtoMessage() {}
+
+func (x *ConfigRefEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_config_reference_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRefEdge.ProtoReflect.Descriptor instead.
+func (*ConfigRefEdge) Descriptor() ([]byte, []int) {
+	return file_config_reference_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigRefEdge) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigRefEdge) GetSrcType() string {
+	if x != nil {
+		return x.SrcType
+	}
+	return ""
+}
+
+func (x *ConfigRefEdge) GetSrcId() uint32 {
+	if x != nil {
+		return x.SrcId
+	}
+	return 0
+}
+
+func (x *ConfigRefEdge) GetDstType() string {
+	if x != nil {
+		return x.DstType
+	}
+	return ""
+}
+
+func (x *ConfigRefEdge) GetDstId() uint32 {
+	if x != nil {
+		return x.DstId
+	}
+	return 0
+}
+
+func (x *ConfigRefEdge) GetRefType() string {
+	if x != nil {
+		return x.RefType
+	}
+	return ""
+}
+
+var File_config_reference_proto protoreflect.FileDescriptor
+
+var file_config_reference_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x62, 0x63, 0x72, 0x65, 0x66,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xad, 0x03, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32,
+	0x28, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0xb9, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xef, 0xbc, 0x9a, 0x61, 0x70, 0x70, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6,
+	0x96, 0xb9, 0x49, 0x44, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x64,
+	0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x92,
+	0x41, 0x2d, 0x32, 0x2b, 0xe8, 0xa2, 0xab, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0xb9,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x61, 0x70, 0x70, 0xe3, 0x80, 0x81, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0x52,
+	0x07, 0x64, 0x73, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8,
+	0xa2, 0xab, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0xb9, 0x49, 0x44, 0x52, 0x05, 0x64,
+	0x73, 0x74, 0x49, 0x64, 0x12, 0x88, 0x01, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6d, 0x92, 0x41, 0x6a, 0x32, 0x68, 0xe5, 0xbc,
+	0x95, 0xe7, 0x94, 0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0xef, 0xbc, 0x88, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87,
+	0xe4, 0xbb, 0xb6, 0xe5, 0x8c, 0x85, 0xe5, 0x90, 0xab, 0xe5, 0x8f, 0xa6, 0xe4, 0xb8, 0x80, 0xe4,
+	0xb8, 0xaa, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xef, 0xbc,
+	0x89, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0xef, 0xbc, 0x88, 0xe4, 0xbd,
+	0xbf, 0xe7, 0x94, 0xa8, 0xe5, 0x8f, 0xa6, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe9, 0x85, 0x8d,
+	0xe7, 0xbd, 0xae, 0xef, 0xbc, 0x89, 0x52, 0x07, 0x72, 0x65, 0x66, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92,
+	0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x22, 0xdd, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x24, 0x92, 0x41, 0x21, 0x32, 0x1f, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a,
+	0x61, 0x70, 0x70, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65,
+	0x6d, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0x92, 0x41, 0x04, 0x32, 0x02, 0x49,
+	0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5d, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x46, 0x92, 0x41, 0x43, 0x32, 0x41, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe9, 0x80, 0x9a, 0xe8, 0xbf, 0x87, 0xe8, 0xb7, 0xa8,
+	0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe5, 0xbc, 0x95, 0xe7,
+	0x94, 0xa8, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0x8e, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe7, 0x9a,
+	0x84, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0x52, 0x05, 0x62,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe6, 0x89, 0x80, 0xe5, 0xb1,
+	0x9e, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64,
+	0x12, 0x6e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x5a,
+	0x92, 0x41, 0x57, 0x32, 0x55, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xef, 0xbc, 0x9a, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe3, 0x80, 0x81, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
+	0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5,
+	0xbe, 0x84, 0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b,
+	0x65, 0x79, 0xef, 0xbc, 0x8c, 0xe5, 0xb7, 0xb2, 0xe8, 0xa2, 0xab, 0xe5, 0x88, 0xa0, 0xe9, 0x99,
+	0xa4, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0xae, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x66, 0x45, 0x64,
+	0x67, 0x65, 0x12, 0x54, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x44,
+	0x92, 0x41, 0x41, 0x32, 0x3f, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe5, 0x85, 0xb3, 0xe7, 0xb3,
+	0xbb, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xb1, 0xe8, 0xb7, 0xa8, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x8e,
+	0xa8, 0xe5, 0xaf, 0xbc, 0xe5, 0x87, 0xba, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb,
+	0xe4, 0xb8, 0xba, 0x30, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x72, 0x63, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x72, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x72, 0x63, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x73,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x73,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x73, 0x74, 0x49, 0x64, 0x12, 0x63, 0x0a, 0x08,
+	0x72, 0x65, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x48,
+	0x92, 0x41, 0x45, 0x32, 0x43, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+	0x8b, 0xef, 0xbc, 0x9a, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0xe3, 0x80, 0x81, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0xe3, 0x80, 0x81, 0x73, 0x68, 0x61, 0x72, 0x65, 0xef, 0xbc, 0x88,
+	0xe8, 0xb7, 0xa8, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe5,
+	0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x89, 0x52, 0x07, 0x72, 0x65, 0x66, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
+	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2d, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x65,
+	0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_reference_proto_rawDescOnce sync.Once
+	file_config_reference_proto_rawDescData = file_config_reference_proto_rawDesc
+)
+
+func file_config_reference_proto_rawDescGZIP() []byte {
+	file_config_reference_proto_rawDescOnce.Do(func() {
+		file_config_reference_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_reference_proto_rawDescData)
+	})
+	return file_config_reference_proto_rawDescData
+}
+
+var file_config_reference_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_reference_proto_goTypes = []any{
+	(*ConfigReferenceSpec)(nil), // 0: pbcref.ConfigReferenceSpec
+	(*ConfigRefNode)(nil),       // 1: pbcref.ConfigRefNode
+	(*ConfigRefEdge)(nil),       // 2: pbcref.ConfigRefEdge
+}
+var file_config_reference_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_config_reference_proto_init() }
+func file_config_reference_proto_init() {
+	if File_config_reference_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_reference_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_reference_proto_goTypes,
+		DependencyIndexes: file_config_reference_proto_depIdxs,
+		MessageInfos:      file_config_reference_proto_msgTypes,
+	}.Build()
+	File_config_reference_proto = out.File
+	file_config_reference_proto_rawDesc = nil
+	file_config_reference_proto_goTypes = nil
+	file_config_reference_proto_depIdxs = nil
+}
@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbcref xxx
+package pbcref
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// ConfigReferenceSpec convert pb ConfigReferenceSpec to table ConfigReferenceSpec
+func (s *ConfigReferenceSpec) ConfigReferenceSpec() *table.ConfigReferenceSpec {
+	if s == nil {
+		return nil
+	}
+
+	return &table.ConfigReferenceSpec{
+		SrcType: table.ConfigRefNodeType(s.SrcType),
+		SrcID:   s.SrcId,
+		DstType: table.ConfigRefNodeType(s.DstType),
+		DstID:   s.DstId,
+		RefType: table.ConfigRefType(s.RefType),
+		Memo:    s.Memo,
+	}
+}
+
+// PbConfigReferenceSpec convert table ConfigReferenceSpec to pb ConfigReferenceSpec
+func PbConfigReferenceSpec(spec *table.ConfigReferenceSpec) *ConfigReferenceSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &ConfigReferenceSpec{
+		SrcType: string(spec.SrcType),
+		SrcId:   spec.SrcID,
+		DstType: string(spec.DstType),
+		DstId:   spec.DstID,
+		RefType: string(spec.RefType),
+		Memo:    spec.Memo,
+	}
+}
+
+// PbConfigRefEdge convert table ConfigReference to pb ConfigRefEdge
+func PbConfigRefEdge(r *table.ConfigReference) *ConfigRefEdge {
+	if r == nil || r.Spec == nil {
+		return nil
+	}
+
+	return &ConfigRefEdge{
+		Id:      r.ID,
+		SrcType: string(r.Spec.SrcType),
+		SrcId:   r.Spec.SrcID,
+		DstType: string(r.Spec.DstType),
+		DstId:   r.Spec.DstID,
+		RefType: string(r.Spec.RefType),
+	}
+}
@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: config_share.proto
+
+package pbcshare
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConfigShare source resource reference: pkg/dal/table/config_share.go
+type ConfigShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ConfigShareSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ConfigShareAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision         `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *ConfigShare) Reset() {
+	*x = ConfigShare{}
+	mi := &file_config_share_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigShare) ProtoMessage() {}
+
+func (x *ConfigShare) ProtoReflect() protoreflect.Message {
+	mi := &file_config_share_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigShare.ProtoReflect.Descriptor instead.
+func (*ConfigShare) Descriptor() ([]byte, []int) {
+	return file_config_share_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigShare) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigShare) GetSpec() *ConfigShareSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *ConfigShare) GetAttachment() *ConfigShareAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *ConfigShare) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ConfigShareSpec source resource reference: pkg/dal/table/config_share.go
+type ConfigShareSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ConfigType   string   `protobuf:"bytes,2,opt,name=config_type,json=configType,proto3" json:"config_type,omitempty"`
+	ConfigItemId uint32   `protobuf:"varint,3,opt,name=config_item_id,json=configItemId,proto3" json:"config_item_id,omitempty"`
+	Key          string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Grantees     []uint32 `protobuf:"varint,5,rep,packed,name=grantees,proto3" json:"grantees,omitempty"`
+	Memo         string   `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ConfigShareSpec) Reset() {
+	*x = ConfigShareSpec{}
+	mi := &file_config_share_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigShareSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigShareSpec) ProtoMessage() {}
+
+func (x *ConfigShareSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_config_share_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigShareSpec.ProtoReflect.Descriptor instead.
+func (*ConfigShareSpec) Descriptor() ([]byte, []int) {
+	return file_config_share_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ConfigShareSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigShareSpec) GetConfigType() string {
+	if x != nil {
+		return x.ConfigType
+	}
+	return ""
+}
+
+func (x *ConfigShareSpec) GetConfigItemId() uint32 {
+	if x != nil {
+		return x.ConfigItemId
+	}
+	return 0
+}
+
+func (x *ConfigShareSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ConfigShareSpec) GetGrantees() []uint32 {
+	if x != nil {
+		return x.Grantees
+	}
+	return nil
+}
+
+func (x *ConfigShareSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ConfigShareAttachment source resource reference: pkg/dal/table/config_share.go
+type ConfigShareAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *ConfigShareAttachment) Reset() {
+	*x = ConfigShareAttachment{}
+	mi := &file_config_share_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigShareAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigShareAttachment) ProtoMessage() {}
+
+func (x *ConfigShareAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_config_share_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigShareAttachment.ProtoReflect.Descriptor instead.
+func (*ConfigShareAttachment) Descriptor() ([]byte, []int) {
+	return file_config_share_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConfigShareAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ConfigShareAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// ConfigShareRef source resource reference: pkg/dal/table/config_share.go
+type ConfigShareRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ShareId  uint32         `protobuf:"varint,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	BizId    uint32         `protobuf:"varint,3,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId    uint32         `protobuf:"varint,4,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	Revision *base.Revision `protobuf:"bytes,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	Share    *ConfigShare   `protobuf:"bytes,6,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *ConfigShareRef) Reset() {
+	*x = ConfigShareRef{}
+	mi := &file_config_share_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigShareRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigShareRef) ProtoMessage() {}
+
+func (x *ConfigShareRef) ProtoReflect() protoreflect.Message {
+	mi := &file_config_share_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigShareRef.ProtoReflect.Descriptor instead.
+func (*ConfigShareRef) Descriptor() ([]byte, []int) {
+	return file_config_share_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfigShareRef) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigShareRef) GetShareId() uint32 {
+	if x != nil {
+		return x.ShareId
+	}
+	return 0
+}
+
+func (x *ConfigShareRef) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ConfigShareRef) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ConfigShareRef) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *ConfigShareRef) GetShare() *ConfigShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+var File_config_share_proto protoreflect.FileDescriptor
+
+var file_config_share_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x62, 0x63, 0x73, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x21,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xd0, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92,
+	0x41, 0x10, 0x32, 0x0e, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x63, 0x73, 0x68, 0x61, 0x72, 0x65, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3f, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x63, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0x8f, 0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0x85, 0xb1,
+	0xe4, 0xba, 0xab, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32,
+	0x1e, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x66, 0x69, 0x6c, 0x65, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x37, 0x92, 0x41, 0x34, 0x32, 0x32, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab,
+	0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x49,
+	0x44, 0xef, 0xbc, 0x8c, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe4, 0xb8, 0xba, 0x20, 0x66, 0x69,
+	0x6c, 0x65, 0x20, 0xe6, 0x97, 0xb6, 0xe5, 0xbf, 0x85, 0xe5, 0xa1, 0xab, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x34, 0x92, 0x41, 0x31, 0x32, 0x2f, 0xe5, 0x85,
+	0xb1, 0xe4, 0xba, 0xab, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9,
+	0x20, 0x6b, 0x65, 0x79, 0xef, 0xbc, 0x8c, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xe4, 0xb8, 0xba,
+	0x20, 0x6b, 0x76, 0x20, 0xe6, 0x97, 0xb6, 0xe5, 0xbf, 0x85, 0xe5, 0xa1, 0xab, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0d, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe8, 0xa2, 0xab, 0xe6, 0x8e,
+	0x88, 0xe6, 0x9d, 0x83, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe4, 0xb8, 0x9a,
+	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x08, 0x67, 0x72, 0x61,
+	0x6e, 0x74, 0x65, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x75, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe6, 0x96, 0xb9, 0xe4,
+	0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16,
+	0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe6, 0x96, 0xb9, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0x98, 0x02,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x65, 0x66,
+	0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
+	0x0a, 0x32, 0x08, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2e, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0x49, 0x44, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12,
+	0x2d, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0xb9, 0xe4,
+	0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16,
+	0x92, 0x41, 0x13, 0x32, 0x11, 0xe5, 0xbc, 0x95, 0xe7, 0x94, 0xa8, 0xe6, 0x96, 0xb9, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x63,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x42, 0x4c, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2d, 0x73, 0x68, 0x61, 0x72, 0x65, 0x3b, 0x70, 0x62,
+	0x63, 0x73, 0x68, 0x61, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_share_proto_rawDescOnce sync.Once
+	file_config_share_proto_rawDescData = file_config_share_proto_rawDesc
+)
+
+func file_config_share_proto_rawDescGZIP() []byte {
+	file_config_share_proto_rawDescOnce.Do(func() {
+		file_config_share_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_share_proto_rawDescData)
+	})
+	return file_config_share_proto_rawDescData
+}
+
+var file_config_share_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_share_proto_goTypes = []any{
+	(*ConfigShare)(nil),           // 0: pbcshare.ConfigShare
+	(*ConfigShareSpec)(nil),       // 1: pbcshare.ConfigShareSpec
+	(*ConfigShareAttachment)(nil), // 2: pbcshare.ConfigShareAttachment
+	(*ConfigShareRef)(nil),        // 3: pbcshare.ConfigShareRef
+	(*base.Revision)(nil),         // 4: pbbase.Revision
+}
+var file_config_share_proto_depIdxs = []int32{
+	1, // 0: pbcshare.ConfigShare.spec:type_name -> pbcshare.ConfigShareSpec
+	2, // 1: pbcshare.ConfigShare.attachment:type_name -> pbcshare.ConfigShareAttachment
+	4, // 2: pbcshare.ConfigShare.revision:type_name -> pbbase.Revision
+	4, // 3: pbcshare.ConfigShareRef.revision:type_name -> pbbase.Revision
+	0, // 4: pbcshare.ConfigShareRef.share:type_name -> pbcshare.ConfigShare
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_config_share_proto_init() }
+func file_config_share_proto_init() {
+	if File_config_share_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_share_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_share_proto_goTypes,
+		DependencyIndexes: file_config_share_proto_depIdxs,
+		MessageInfos:      file_config_share_proto_msgTypes,
+	}.Build()
+	File_config_share_proto = out.File
+	file_config_share_proto_rawDesc = nil
+	file_config_share_proto_goTypes = nil
+	file_config_share_proto_depIdxs = nil
+}
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbcshare xxx
+package pbcshare
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ConfigShareSpec convert pb ConfigShareSpec to table ConfigShareSpec
+func (m *ConfigShareSpec) ConfigShareSpec() *table.ConfigShareSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.ConfigShareSpec{
+		Name:         m.Name,
+		ConfigType:   table.ConfigType(m.ConfigType),
+		ConfigItemID: m.ConfigItemId,
+		Key:          m.Key,
+		Grantees:     m.Grantees,
+		Memo:         m.Memo,
+	}
+}
+
+// PbConfigShareSpec convert table ConfigShareSpec to pb ConfigShareSpec
+func PbConfigShareSpec(spec *table.ConfigShareSpec) *ConfigShareSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &ConfigShareSpec{
+		Name:         spec.Name,
+		ConfigType:   string(spec.ConfigType),
+		ConfigItemId: spec.ConfigItemID,
+		Key:          spec.Key,
+		Grantees:     spec.Grantees,
+		Memo:         spec.Memo,
+	}
+}
+
+// ConfigShareAttachment convert pb ConfigShareAttachment to table ConfigShareAttachment
+func (m *ConfigShareAttachment) ConfigShareAttachment() *table.ConfigShareAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.ConfigShareAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbConfigShare convert table ConfigShare to pb ConfigShare
+func PbConfigShare(c *table.ConfigShare) *ConfigShare {
+	if c == nil {
+		return nil
+	}
+
+	var at *ConfigShareAttachment
+	if c.Attachment != nil {
+		at = &ConfigShareAttachment{BizId: c.Attachment.BizID, AppId: c.Attachment.AppID}
+	}
+
+	return &ConfigShare{
+		Id:         c.ID,
+		Spec:       PbConfigShareSpec(c.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(c.Revision),
+	}
+}
+
+// PbConfigShares convert table ConfigShare list to pb ConfigShare list
+func PbConfigShares(list []*table.ConfigShare) []*ConfigShare {
+	result := make([]*ConfigShare, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbConfigShare(one))
+	}
+
+	return result
+}
+
+// PbConfigShareRef convert table ConfigShareRef to pb ConfigShareRef, the share is optional.
+func PbConfigShareRef(r *table.ConfigShareRef, share *table.ConfigShare) *ConfigShareRef {
+	if r == nil {
+		return nil
+	}
+
+	return &ConfigShareRef{
+		Id:       r.ID,
+		ShareId:  r.Spec.ShareID,
+		BizId:    r.Attachment.BizID,
+		AppId:    r.Attachment.AppID,
+		Revision: pbbase.PbRevision(r.Revision),
+		Share:    PbConfigShare(share),
+	}
+}
@@ -86,6 +86,8 @@ func PbCredentialSpec(spec *table.CredentialSpec) *CredentialSpec { //nolint:rev
 		Enable:         spec.Enable,
 		Memo:           spec.Memo,
 		Name:           spec.Name,
+		PauseMode:      string(spec.Pause.Mode),
+		PauseMessage:   spec.Pause.Message,
 	}
 }
 
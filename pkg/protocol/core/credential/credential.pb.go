@@ -110,6 +110,8 @@ type CredentialSpec struct {
 	Name           string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Memo           string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 	Enable         bool   `protobuf:"varint,6,opt,name=enable,proto3" json:"enable,omitempty"`
+	PauseMode      string `protobuf:"bytes,7,opt,name=pause_mode,json=pauseMode,proto3" json:"pause_mode,omitempty"`
+	PauseMessage   string `protobuf:"bytes,8,opt,name=pause_message,json=pauseMessage,proto3" json:"pause_message,omitempty"`
 }
 
 func (x *CredentialSpec) Reset() {
@@ -184,6 +186,20 @@ func (x *CredentialSpec) GetEnable() bool {
 	return false
 }
 
+func (x *CredentialSpec) GetPauseMode() string {
+	if x != nil {
+		return x.PauseMode
+	}
+	return ""
+}
+
+func (x *CredentialSpec) GetPauseMessage() string {
+	if x != nil {
+		return x.PauseMessage
+	}
+	return ""
+}
+
 type CredentialAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -302,7 +318,7 @@ var file_credential_proto_rawDesc = []byte{
 	0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0x92,
 	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99,
 	0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70,
-	0x65, 0x73, 0x22, 0xd6, 0x02, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x65, 0x73, 0x22, 0xd7, 0x04, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
 	0x6c, 0x53, 0x70, 0x65, 0x63, 0x12, 0x48, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f,
 	0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe5, 0x87, 0xad, 0xe8, 0xaf, 0x81, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
@@ -323,20 +339,36 @@ var file_credential_proto_rawDesc = []byte{
 	0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23,
 	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6,
 	0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61,
-	0x6c, 0x73, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1,
-	0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
-	0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x05,
-	0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
-	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x72,
-	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x65, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x73, 0x65, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0a,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x7d, 0x92, 0x41, 0x7a, 0x32, 0x78, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8b, 0x89,
+	0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0xb9, 0xe5,
+	0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0xaa, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x97,
+	0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xef, 0xbc, 0x9a, 0xe4, 0xbf, 0x9d, 0xe6, 0x8c, 0x81,
+	0xe5, 0xb7, 0xb2, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x3d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0xe3, 0x80, 0x81, 0xe8,
+	0xbf, 0x94, 0xe5, 0x9b, 0x9e, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8f, 0x90, 0xe7, 0xa4,
+	0xba, 0x3d, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x60, 0x0a, 0x0d, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3b, 0x92, 0x41, 0x38, 0x32, 0x36, 0xe6, 0x9a, 0x82, 0xe5, 0x81, 0x9c, 0xe6, 0x8b,
+	0x89, 0xe5, 0x8f, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe8, 0xbf, 0x94,
+	0xe5, 0x9b, 0x9e, 0xe7, 0xbb, 0x99, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7,
+	0x9a, 0x84, 0xe6, 0x8f, 0x90, 0xe7, 0xa4, 0xba, 0xe4, 0xbf, 0xa1, 0xe6, 0x81, 0xaf, 0x52, 0x0c,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3c, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a,
+	0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0x85, 0xb3, 0xe8, 0x81, 0x94, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65,
+	0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x3b, 0x70, 0x62, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbdraft xxx
+package pbdraft
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+)
+
+// DraftItem convert pb DraftItem to table DraftItem
+func (m *DraftItem) DraftItem() *table.DraftItem {
+	if m == nil {
+		return nil
+	}
+
+	item := &table.DraftItem{
+		Action:       table.DraftAction(m.Action),
+		ConfigItemID: m.ConfigItemId,
+		BaseRevision: m.BaseRevision,
+	}
+	if m.ConfigItem != nil {
+		item.ConfigItem = m.ConfigItem.ConfigItemSpec()
+	}
+	if m.Content != nil {
+		item.Content = m.Content.ContentSpec()
+	}
+	if m.Kv != nil {
+		item.Kv = &table.DraftKv{
+			Key:          m.Kv.Key,
+			KvType:       table.DataType(m.Kv.KvType),
+			Value:        m.Kv.Value,
+			Memo:         m.Kv.Memo,
+			SecretType:   table.SecretType(m.Kv.SecretType),
+			SecretHidden: m.Kv.SecretHidden,
+		}
+	}
+
+	return item
+}
+
+// PbDraftItem convert table DraftItem to pb DraftItem
+func PbDraftItem(i *table.DraftItem) *DraftItem {
+	if i == nil {
+		return nil
+	}
+
+	item := &DraftItem{
+		Action:       string(i.Action),
+		ConfigItemId: i.ConfigItemID,
+		ConfigItem:   pbci.PbConfigItemSpec(i.ConfigItem),
+		Content:      pbcontent.PbContentSpec(i.Content),
+		BaseRevision: i.BaseRevision,
+	}
+	if i.Kv != nil {
+		item.Kv = &DraftKv{
+			Key:          i.Kv.Key,
+			KvType:       string(i.Kv.KvType),
+			Value:        i.Kv.Value,
+			Memo:         i.Kv.Memo,
+			SecretType:   string(i.Kv.SecretType),
+			SecretHidden: i.Kv.SecretHidden,
+		}
+	}
+
+	return item
+}
+
+// PbDraft convert table Draft to pb Draft
+func PbDraft(d *table.Draft) *Draft {
+	if d == nil {
+		return nil
+	}
+
+	var spec *DraftSpec
+	if d.Spec != nil {
+		spec = &DraftSpec{Items: make([]*DraftItem, 0, len(d.Spec.Items))}
+		for _, one := range d.Spec.Items {
+			spec.Items = append(spec.Items, PbDraftItem(one))
+		}
+	}
+
+	var at *DraftAttachment
+	if d.Attachment != nil {
+		at = &DraftAttachment{BizId: d.Attachment.BizID, AppId: d.Attachment.AppID}
+	}
+
+	return &Draft{
+		Id:         d.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbRevision(d.Revision),
+	}
+}
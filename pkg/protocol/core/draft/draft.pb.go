@@ -0,0 +1,562 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: draft.proto
+
+package pbdraft
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	config_item "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	content "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Draft source resource reference: pkg/dal/table/draft.go
+type Draft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *DraftSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *DraftAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision   `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *Draft) Reset() {
+	*x = Draft{}
+	mi := &file_draft_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Draft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draft) ProtoMessage() {}
+
+func (x *Draft) ProtoReflect() protoreflect.Message {
+	mi := &file_draft_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draft.ProtoReflect.Descriptor instead.
+func (*Draft) Descriptor() ([]byte, []int) {
+	return file_draft_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Draft) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Draft) GetSpec() *DraftSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Draft) GetAttachment() *DraftAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Draft) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// DraftSpec source resource reference: pkg/dal/table/draft.go
+type DraftSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*DraftItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *DraftSpec) Reset() {
+	*x = DraftSpec{}
+	mi := &file_draft_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftSpec) ProtoMessage() {}
+
+func (x *DraftSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_draft_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftSpec.ProtoReflect.Descriptor instead.
+func (*DraftSpec) Descriptor() ([]byte, []int) {
+	return file_draft_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DraftSpec) GetItems() []*DraftItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// DraftItem source resource reference: pkg/dal/table/draft.go
+type DraftItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action       string                      `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	ConfigItemId uint32                      `protobuf:"varint,2,opt,name=config_item_id,json=configItemId,proto3" json:"config_item_id,omitempty"`
+	ConfigItem   *config_item.ConfigItemSpec `protobuf:"bytes,3,opt,name=config_item,json=configItem,proto3" json:"config_item,omitempty"`
+	Content      *content.ContentSpec        `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Kv           *DraftKv                    `protobuf:"bytes,5,opt,name=kv,proto3" json:"kv,omitempty"`
+	BaseRevision string                      `protobuf:"bytes,6,opt,name=base_revision,json=baseRevision,proto3" json:"base_revision,omitempty"`
+	// 以下为与服务当前共享编辑态的对比结果, 仅查询时返回
+	Status        string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	BaseSignature string `protobuf:"bytes,8,opt,name=base_signature,json=baseSignature,proto3" json:"base_signature,omitempty"`
+	BaseValue     string `protobuf:"bytes,9,opt,name=base_value,json=baseValue,proto3" json:"base_value,omitempty"`
+}
+
+func (x *DraftItem) Reset() {
+	*x = DraftItem{}
+	mi := &file_draft_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftItem) ProtoMessage() {}
+
+func (x *DraftItem) ProtoReflect() protoreflect.Message {
+	mi := &file_draft_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftItem.ProtoReflect.Descriptor instead.
+func (*DraftItem) Descriptor() ([]byte, []int) {
+	return file_draft_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DraftItem) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DraftItem) GetConfigItemId() uint32 {
+	if x != nil {
+		return x.ConfigItemId
+	}
+	return 0
+}
+
+func (x *DraftItem) GetConfigItem() *config_item.ConfigItemSpec {
+	if x != nil {
+		return x.ConfigItem
+	}
+	return nil
+}
+
+func (x *DraftItem) GetContent() *content.ContentSpec {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DraftItem) GetKv() *DraftKv {
+	if x != nil {
+		return x.Kv
+	}
+	return nil
+}
+
+func (x *DraftItem) GetBaseRevision() string {
+	if x != nil {
+		return x.BaseRevision
+	}
+	return ""
+}
+
+func (x *DraftItem) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DraftItem) GetBaseSignature() string {
+	if x != nil {
+		return x.BaseSignature
+	}
+	return ""
+}
+
+func (x *DraftItem) GetBaseValue() string {
+	if x != nil {
+		return x.BaseValue
+	}
+	return ""
+}
+
+// DraftKv source resource reference: pkg/dal/table/draft.go
+type DraftKv struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key          string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KvType       string `protobuf:"bytes,2,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
+	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Memo         string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	SecretType   string `protobuf:"bytes,5,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	SecretHidden bool   `protobuf:"varint,6,opt,name=secret_hidden,json=secretHidden,proto3" json:"secret_hidden,omitempty"`
+}
+
+func (x *DraftKv) Reset() {
+	*x = DraftKv{}
+	mi := &file_draft_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftKv) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftKv) ProtoMessage() {}
+
+func (x *DraftKv) ProtoReflect() protoreflect.Message {
+	mi := &file_draft_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftKv.ProtoReflect.Descriptor instead.
+func (*DraftKv) Descriptor() ([]byte, []int) {
+	return file_draft_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DraftKv) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DraftKv) GetKvType() string {
+	if x != nil {
+		return x.KvType
+	}
+	return ""
+}
+
+func (x *DraftKv) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *DraftKv) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *DraftKv) GetSecretType() string {
+	if x != nil {
+		return x.SecretType
+	}
+	return ""
+}
+
+func (x *DraftKv) GetSecretHidden() bool {
+	if x != nil {
+		return x.SecretHidden
+	}
+	return false
+}
+
+// DraftAttachment source resource reference: pkg/dal/table/draft.go
+type DraftAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *DraftAttachment) Reset() {
+	*x = DraftAttachment{}
+	mi := &file_draft_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DraftAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DraftAttachment) ProtoMessage() {}
+
+func (x *DraftAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_draft_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DraftAttachment.ProtoReflect.Descriptor instead.
+func (*DraftAttachment) Descriptor() ([]byte, []int) {
+	return file_draft_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DraftAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *DraftAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_draft_proto protoreflect.FileDescriptor
+
+var file_draft_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x64, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70,
+	0x62, 0x64, 0x72, 0x61, 0x66, 0x74, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2d, 0x69, 0x74, 0x65, 0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x05, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1d, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe8, 0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x64,
+	0x72, 0x61, 0x66, 0x74, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c,
+	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x09,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x2e, 0x44, 0x72, 0x61, 0x66, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x22, 0xdc, 0x05, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x66, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x23, 0x92, 0x41, 0x20, 0x32, 0x1e, 0xe8, 0x8d, 0x89, 0xe7, 0xa8, 0xbf, 0xe6, 0x93,
+	0x8d, 0xe4, 0xbd, 0x9c, 0xef, 0xbc, 0x9a, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0xe3, 0x80, 0x81,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4c,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe6, 0x96, 0x87,
+	0xe4, 0xbb, 0xb6, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x49, 0x44, 0xef, 0xbc,
+	0x8c, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x30, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x63, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x30, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x02, 0x6b, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x64, 0x72, 0x61, 0x66, 0x74, 0x2e, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x4b, 0x76, 0x52, 0x02, 0x6b, 0x76, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x62, 0x92, 0x41, 0x5f, 0x32, 0x5d, 0xe5, 0x8a, 0xa0, 0xe5, 0x85, 0xa5, 0xe8, 0x8d, 0x89, 0xe7,
+	0xa8, 0xbf, 0xe6, 0x97, 0xb6, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe9, 0x85, 0x8d, 0xe7, 0xbd,
+	0xae, 0xe9, 0xa1, 0xb9, 0xe7, 0x9a, 0x84, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0x88, 0xe5, 0xb9, 0xb6, 0xe6, 0x97, 0xb6, 0xe7,
+	0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0x86, 0xb2, 0xe7, 0xaa, 0x81, 0xe6, 0xa3, 0x80, 0xe6, 0xb5,
+	0x8b, 0xef, 0xbc, 0x8c, 0xe6, 0x96, 0xb0, 0xe5, 0xa2, 0x9e, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba,
+	0xe7, 0xa9, 0xba, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x57, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x3f, 0x92, 0x41, 0x3c, 0x32, 0x3a, 0xe5, 0xaf, 0xb9, 0xe6, 0xaf, 0x94, 0xe7, 0x8a,
+	0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a, 0x41, 0x44, 0x44, 0xe3, 0x80, 0x81, 0x52, 0x45, 0x56,
+	0x49, 0x53, 0x45, 0xe3, 0x80, 0x81, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0xe3, 0x80, 0x81, 0x55,
+	0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0xe3, 0x80, 0x81, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49,
+	0x43, 0x54, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x6b, 0x0a, 0x0e, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x44, 0x92, 0x41, 0x41, 0x32, 0x3f, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5,
+	0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x85, 0xb1, 0xe4, 0xba, 0xab, 0xe7, 0xbc, 0x96, 0xe8, 0xbe,
+	0x91, 0xe6, 0x80, 0x81, 0xe7, 0x9a, 0x84, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0xaf, 0xb9, 0xe6,
+	0xaf, 0x94, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x69, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x4a, 0x92, 0x41, 0x47,
+	0x32, 0x45, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe5, 0x85,
+	0xb1, 0xe4, 0xba, 0xab, 0xe7, 0xbc, 0x96, 0xe8, 0xbe, 0x91, 0xe6, 0x80, 0x81, 0xe7, 0x9a, 0x84,
+	0xe9, 0x94, 0xae, 0xe5, 0x80, 0xbc, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5,
+	0x80, 0xbc, 0xef, 0xbc, 0x8c, 0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x8e, 0xe5, 0xaf, 0xb9, 0xe6, 0xaf,
+	0x94, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x93, 0x02, 0x0a, 0x07, 0x44, 0x72, 0x61, 0x66, 0x74, 0x4b, 0x76, 0x12, 0x23,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5, 0x90, 0x8d, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x95, 0xb0, 0xe6, 0x8d,
+	0xae, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5, 0x80,
+	0xbc, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f,
+	0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x32, 0x0a, 0x0b, 0x73, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+	0x8b, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a,
+	0x0d, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe9, 0x9a, 0x90, 0xe8, 0x97, 0x8f, 0xe5, 0x80, 0xbc, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x48, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0x5d, 0x0a, 0x0f, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x44, 0x5a, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
+	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x64, 0x72, 0x61, 0x66, 0x74, 0x3b, 0x70, 0x62, 0x64, 0x72, 0x61, 0x66, 0x74, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_draft_proto_rawDescOnce sync.Once
+	file_draft_proto_rawDescData = file_draft_proto_rawDesc
+)
+
+func file_draft_proto_rawDescGZIP() []byte {
+	file_draft_proto_rawDescOnce.Do(func() {
+		file_draft_proto_rawDescData = protoimpl.X.CompressGZIP(file_draft_proto_rawDescData)
+	})
+	return file_draft_proto_rawDescData
+}
+
+var file_draft_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_draft_proto_goTypes = []any{
+	(*Draft)(nil),                      // 0: pbdraft.Draft
+	(*DraftSpec)(nil),                  // 1: pbdraft.DraftSpec
+	(*DraftItem)(nil),                  // 2: pbdraft.DraftItem
+	(*DraftKv)(nil),                    // 3: pbdraft.DraftKv
+	(*DraftAttachment)(nil),            // 4: pbdraft.DraftAttachment
+	(*base.Revision)(nil),              // 5: pbbase.Revision
+	(*config_item.ConfigItemSpec)(nil), // 6: pbci.ConfigItemSpec
+	(*content.ContentSpec)(nil),        // 7: pbcontent.ContentSpec
+}
+var file_draft_proto_depIdxs = []int32{
+	1, // 0: pbdraft.Draft.spec:type_name -> pbdraft.DraftSpec
+	4, // 1: pbdraft.Draft.attachment:type_name -> pbdraft.DraftAttachment
+	5, // 2: pbdraft.Draft.revision:type_name -> pbbase.Revision
+	2, // 3: pbdraft.DraftSpec.items:type_name -> pbdraft.DraftItem
+	6, // 4: pbdraft.DraftItem.config_item:type_name -> pbci.ConfigItemSpec
+	7, // 5: pbdraft.DraftItem.content:type_name -> pbcontent.ContentSpec
+	3, // 6: pbdraft.DraftItem.kv:type_name -> pbdraft.DraftKv
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_draft_proto_init() }
+func file_draft_proto_init() {
+	if File_draft_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_draft_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_draft_proto_goTypes,
+		DependencyIndexes: file_draft_proto_depIdxs,
+		MessageInfos:      file_draft_proto_msgTypes,
+	}.Build()
+	File_draft_proto = out.File
+	file_draft_proto_rawDesc = nil
+	file_draft_proto_goTypes = nil
+	file_draft_proto_depIdxs = nil
+}
@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbep xxx
+package pbep
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// PbEmergencyPublishSpec convert table EmergencyPublishSpec to pb EmergencyPublishSpec
+func PbEmergencyPublishSpec(spec *table.EmergencyPublishSpec) *EmergencyPublishSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &EmergencyPublishSpec{
+		ReleaseId: spec.ReleaseID,
+		GroupId:   spec.GroupID,
+		Uids:      spec.UIDs,
+		ExpiredAt: timestamppb.New(spec.ExpiredAt.UTC()),
+		State:     string(spec.State),
+		Memo:      spec.Memo,
+	}
+}
+
+// PbEmergencyPublishAttachment convert table EmergencyPublishAttachment to pb EmergencyPublishAttachment
+func PbEmergencyPublishAttachment(at *table.EmergencyPublishAttachment) *EmergencyPublishAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &EmergencyPublishAttachment{
+		BizId: at.BizID,
+		AppId: at.AppID,
+	}
+}
+
+// PbEmergencyPublish convert table EmergencyPublish to pb EmergencyPublish
+func PbEmergencyPublish(e *table.EmergencyPublish) *EmergencyPublish {
+	if e == nil {
+		return nil
+	}
+
+	return &EmergencyPublish{
+		Id:         e.ID,
+		Spec:       PbEmergencyPublishSpec(e.Spec),
+		Attachment: PbEmergencyPublishAttachment(e.Attachment),
+		Revision:   pbbase.PbRevision(e.Revision),
+	}
+}
+
+// PbEmergencyPublishes convert table EmergencyPublish list to pb EmergencyPublish list
+func PbEmergencyPublishes(list []*table.EmergencyPublish) []*EmergencyPublish {
+	result := make([]*EmergencyPublish, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbEmergencyPublish(one))
+	}
+
+	return result
+}
@@ -0,0 +1,356 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: emergency_publish.proto
+
+package pbep
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EmergencyPublish source resource reference: pkg/dal/table/emergency_publish.go
+type EmergencyPublish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *EmergencyPublishSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *EmergencyPublishAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision              `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *EmergencyPublish) Reset() {
+	*x = EmergencyPublish{}
+	mi := &file_emergency_publish_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyPublish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyPublish) ProtoMessage() {}
+
+func (x *EmergencyPublish) ProtoReflect() protoreflect.Message {
+	mi := &file_emergency_publish_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyPublish.ProtoReflect.Descriptor instead.
+func (*EmergencyPublish) Descriptor() ([]byte, []int) {
+	return file_emergency_publish_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmergencyPublish) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmergencyPublish) GetSpec() *EmergencyPublishSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *EmergencyPublish) GetAttachment() *EmergencyPublishAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *EmergencyPublish) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// EmergencyPublishSpec source resource reference: pkg/dal/table/emergency_publish.go
+type EmergencyPublishSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseId uint32                 `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	GroupId   uint32                 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Uids      []string               `protobuf:"bytes,3,rep,name=uids,proto3" json:"uids,omitempty"`
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	State     string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	Memo      string                 `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *EmergencyPublishSpec) Reset() {
+	*x = EmergencyPublishSpec{}
+	mi := &file_emergency_publish_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyPublishSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyPublishSpec) ProtoMessage() {}
+
+func (x *EmergencyPublishSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_emergency_publish_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyPublishSpec.ProtoReflect.Descriptor instead.
+func (*EmergencyPublishSpec) Descriptor() ([]byte, []int) {
+	return file_emergency_publish_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EmergencyPublishSpec) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *EmergencyPublishSpec) GetGroupId() uint32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *EmergencyPublishSpec) GetUids() []string {
+	if x != nil {
+		return x.Uids
+	}
+	return nil
+}
+
+func (x *EmergencyPublishSpec) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+func (x *EmergencyPublishSpec) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *EmergencyPublishSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// EmergencyPublishAttachment source resource reference: pkg/dal/table/emergency_publish.go
+type EmergencyPublishAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *EmergencyPublishAttachment) Reset() {
+	*x = EmergencyPublishAttachment{}
+	mi := &file_emergency_publish_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmergencyPublishAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmergencyPublishAttachment) ProtoMessage() {}
+
+func (x *EmergencyPublishAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_emergency_publish_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmergencyPublishAttachment.ProtoReflect.Descriptor instead.
+func (*EmergencyPublishAttachment) Descriptor() ([]byte, []int) {
+	return file_emergency_publish_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EmergencyPublishAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *EmergencyPublishAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_emergency_publish_proto protoreflect.FileDescriptor
+
+var file_emergency_publish_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x65, 0x70, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
+	0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd7, 0x01, 0x0a, 0x10, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63,
+	0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7, 0xb4, 0xa7, 0xe6, 0x80,
+	0xa5, 0xe5, 0x8f, 0x91, 0xe5, 0xb8, 0x83, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62,
+	0x65, 0x70, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x70, 0x62, 0x65, 0x70, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x03,
+	0x0a, 0x14, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x53, 0x70, 0x65, 0x63, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x25, 0x92, 0x41, 0x22, 0x32, 0x20, 0xe4, 0xb8, 0xb4,
+	0xe6, 0x97, 0xb6, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe8, 0xbf,
+	0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x75, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe5, 0xae, 0xa2, 0xe6, 0x88,
+	0xb7, 0xe7, 0xab, 0xaf, 0x55, 0x49, 0x44, 0xe5, 0x88, 0x97, 0xe8, 0xa1, 0xa8, 0x52, 0x04, 0x75,
+	0x69, 0x64, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x5f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x49, 0x92, 0x41, 0x46, 0x32, 0x44, 0xe7, 0x8a, 0xb6, 0xe6, 0x80, 0x81, 0xef, 0xbc, 0x9a,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x28, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0xe4, 0xb8, 0xad,
+	0x29, 0xe3, 0x80, 0x81, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x28, 0xe5, 0xb7, 0xb2, 0xe8,
+	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x29, 0xe3, 0x80, 0x81, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x28, 0xe5, 0xb7, 0xb2, 0xe6, 0x92, 0xa4, 0xe9, 0x94, 0x80, 0x29, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x22, 0x68, 0x0a, 0x1a, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x4d, 0x5a,
+	0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x2d,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x3b, 0x70, 0x62, 0x65, 0x70, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_emergency_publish_proto_rawDescOnce sync.Once
+	file_emergency_publish_proto_rawDescData = file_emergency_publish_proto_rawDesc
+)
+
+func file_emergency_publish_proto_rawDescGZIP() []byte {
+	file_emergency_publish_proto_rawDescOnce.Do(func() {
+		file_emergency_publish_proto_rawDescData = protoimpl.X.CompressGZIP(file_emergency_publish_proto_rawDescData)
+	})
+	return file_emergency_publish_proto_rawDescData
+}
+
+var file_emergency_publish_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_emergency_publish_proto_goTypes = []any{
+	(*EmergencyPublish)(nil),           // 0: pbep.EmergencyPublish
+	(*EmergencyPublishSpec)(nil),       // 1: pbep.EmergencyPublishSpec
+	(*EmergencyPublishAttachment)(nil), // 2: pbep.EmergencyPublishAttachment
+	(*base.Revision)(nil),              // 3: pbbase.Revision
+	(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+}
+var file_emergency_publish_proto_depIdxs = []int32{
+	1, // 0: pbep.EmergencyPublish.spec:type_name -> pbep.EmergencyPublishSpec
+	2, // 1: pbep.EmergencyPublish.attachment:type_name -> pbep.EmergencyPublishAttachment
+	3, // 2: pbep.EmergencyPublish.revision:type_name -> pbbase.Revision
+	4, // 3: pbep.EmergencyPublishSpec.expired_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_emergency_publish_proto_init() }
+func file_emergency_publish_proto_init() {
+	if File_emergency_publish_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_emergency_publish_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_emergency_publish_proto_goTypes,
+		DependencyIndexes: file_emergency_publish_proto_depIdxs,
+		MessageInfos:      file_emergency_publish_proto_msgTypes,
+	}.Build()
+	File_emergency_publish_proto = out.File
+	file_emergency_publish_proto_rawDesc = nil
+	file_emergency_publish_proto_goTypes = nil
+	file_emergency_publish_proto_depIdxs = nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbenv xxx
+package pbenv
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// EnvironmentSpec convert pb EnvironmentSpec to table EnvironmentSpec
+func (m *EnvironmentSpec) EnvironmentSpec() *table.EnvironmentSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &table.EnvironmentSpec{
+		Name:     m.Name,
+		Memo:     m.Memo,
+		Position: m.Position,
+	}
+}
+
+// PbEnvironmentSpec convert table EnvironmentSpec to pb EnvironmentSpec
+func PbEnvironmentSpec(spec *table.EnvironmentSpec) *EnvironmentSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &EnvironmentSpec{
+		Name:     spec.Name,
+		Memo:     spec.Memo,
+		Position: spec.Position,
+	}
+}
+
+// EnvironmentAttachment convert pb EnvironmentAttachment to table EnvironmentAttachment
+func (m *EnvironmentAttachment) EnvironmentAttachment() *table.EnvironmentAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.EnvironmentAttachment{
+		BizID: m.BizId,
+	}
+}
+
+// PbEnvironment convert table Environment to pb Environment
+func PbEnvironment(e *table.Environment) *Environment {
+	if e == nil {
+		return nil
+	}
+
+	var at *EnvironmentAttachment
+	if e.Attachment != nil {
+		at = &EnvironmentAttachment{BizId: e.Attachment.BizID}
+	}
+
+	return &Environment{
+		Id:         e.ID,
+		Spec:       PbEnvironmentSpec(e.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(e.Revision),
+	}
+}
+
+// PbEnvironments convert table Environment list to pb Environment list
+func PbEnvironments(list []*table.Environment) []*Environment {
+	result := make([]*Environment, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbEnvironment(one))
+	}
+
+	return result
+}
+
+// PbEnvAppBinding convert table EnvAppBinding to pb EnvAppBinding
+func PbEnvAppBinding(b *table.EnvAppBinding) *EnvAppBinding {
+	if b == nil {
+		return nil
+	}
+
+	var spec *EnvAppBindingSpec
+	if b.Spec != nil {
+		spec = &EnvAppBindingSpec{EnvId: b.Spec.EnvID, AppKey: b.Spec.AppKey}
+	}
+
+	var at *EnvAppBindingAttachment
+	if b.Attachment != nil {
+		at = &EnvAppBindingAttachment{BizId: b.Attachment.BizID, AppId: b.Attachment.AppID}
+	}
+
+	return &EnvAppBinding{
+		Id:         b.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbRevision(b.Revision),
+	}
+}
+
+// PbEnvAppBindings convert table EnvAppBinding list to pb EnvAppBinding list
+func PbEnvAppBindings(list []*table.EnvAppBinding) []*EnvAppBinding {
+	result := make([]*EnvAppBinding, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbEnvAppBinding(one))
+	}
+
+	return result
+}
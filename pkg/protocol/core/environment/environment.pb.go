@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: environment.proto
+
+package pbenv
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Environment source resource reference: pkg/dal/table/environment.go
+type Environment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *EnvironmentSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *EnvironmentAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision         `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *Environment) Reset() {
+	*x = Environment{}
+	mi := &file_environment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Environment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Environment) ProtoMessage() {}
+
+func (x *Environment) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Environment.ProtoReflect.Descriptor instead.
+func (*Environment) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Environment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Environment) GetSpec() *EnvironmentSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Environment) GetAttachment() *EnvironmentAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Environment) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// EnvironmentSpec source resource reference: pkg/dal/table/environment.go
+type EnvironmentSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Memo     string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Position uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *EnvironmentSpec) Reset() {
+	*x = EnvironmentSpec{}
+	mi := &file_environment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentSpec) ProtoMessage() {}
+
+func (x *EnvironmentSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentSpec.ProtoReflect.Descriptor instead.
+func (*EnvironmentSpec) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnvironmentSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvironmentSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *EnvironmentSpec) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+// EnvironmentAttachment source resource reference: pkg/dal/table/environment.go
+type EnvironmentAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+}
+
+func (x *EnvironmentAttachment) Reset() {
+	*x = EnvironmentAttachment{}
+	mi := &file_environment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvironmentAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvironmentAttachment) ProtoMessage() {}
+
+func (x *EnvironmentAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvironmentAttachment.ProtoReflect.Descriptor instead.
+func (*EnvironmentAttachment) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnvironmentAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+// EnvAppBinding source resource reference: pkg/dal/table/environment.go
+type EnvAppBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *EnvAppBindingSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *EnvAppBindingAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision           `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *EnvAppBinding) Reset() {
+	*x = EnvAppBinding{}
+	mi := &file_environment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvAppBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvAppBinding) ProtoMessage() {}
+
+func (x *EnvAppBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvAppBinding.ProtoReflect.Descriptor instead.
+func (*EnvAppBinding) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnvAppBinding) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EnvAppBinding) GetSpec() *EnvAppBindingSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *EnvAppBinding) GetAttachment() *EnvAppBindingAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *EnvAppBinding) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// EnvAppBindingSpec source resource reference: pkg/dal/table/environment.go
+type EnvAppBindingSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvId  uint32 `protobuf:"varint,1,opt,name=env_id,json=envId,proto3" json:"env_id,omitempty"`
+	AppKey string `protobuf:"bytes,2,opt,name=app_key,json=appKey,proto3" json:"app_key,omitempty"`
+}
+
+func (x *EnvAppBindingSpec) Reset() {
+	*x = EnvAppBindingSpec{}
+	mi := &file_environment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvAppBindingSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvAppBindingSpec) ProtoMessage() {}
+
+func (x *EnvAppBindingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvAppBindingSpec.ProtoReflect.Descriptor instead.
+func (*EnvAppBindingSpec) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EnvAppBindingSpec) GetEnvId() uint32 {
+	if x != nil {
+		return x.EnvId
+	}
+	return 0
+}
+
+func (x *EnvAppBindingSpec) GetAppKey() string {
+	if x != nil {
+		return x.AppKey
+	}
+	return ""
+}
+
+// EnvAppBindingAttachment source resource reference: pkg/dal/table/environment.go
+type EnvAppBindingAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *EnvAppBindingAttachment) Reset() {
+	*x = EnvAppBindingAttachment{}
+	mi := &file_environment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvAppBindingAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvAppBindingAttachment) ProtoMessage() {}
+
+func (x *EnvAppBindingAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_environment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvAppBindingAttachment.ProtoReflect.Descriptor instead.
+func (*EnvAppBindingAttachment) Descriptor() ([]byte, []int) {
+	return file_environment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EnvAppBindingAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *EnvAppBindingAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_environment_proto protoreflect.FileDescriptor
+
+var file_environment_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x65, 0x6e, 0x76, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61,
+	0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x01,
+	0x0a, 0x0b, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x65,
+	0x6e, 0x76, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70,
+	0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3c, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70,
+	0x62, 0x65, 0x6e, 0x76, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e,
+	0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x8e, 0xaf,
+	0xe5, 0xa2, 0x83, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x68, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x4c, 0x92, 0x41, 0x49, 0x32, 0x47, 0xe7,
+	0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0xe5, 0x9c, 0xa8, 0xe6, 0x99, 0x8b, 0xe7, 0xba, 0xa7, 0xe8, 0xb7,
+	0xaf, 0xe5, 0xbe, 0x84, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe9, 0xa1, 0xba, 0xe5, 0xba, 0x8f,
+	0xef, 0xbc, 0x8c, 0xe5, 0xa6, 0x82, 0x20, 0x64, 0x65, 0x76, 0x28, 0x31, 0x29, 0x20, 0x2d, 0x3e,
+	0x20, 0x73, 0x74, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x28, 0x32, 0x29, 0x20, 0x2d, 0x3e, 0x20, 0x70,
+	0x72, 0x6f, 0x64, 0x28, 0x33, 0x29, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x3d, 0x0a, 0x15, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22,
+	0xd0, 0x01, 0x0a, 0x0d, 0x45, 0x6e, 0x76, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92,
+	0x41, 0x10, 0x32, 0x0e, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe5, 0x85, 0xb3, 0xe7, 0xb3, 0xbb,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x76, 0x2e, 0x45, 0x6e, 0x76,
+	0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x65, 0x6e, 0x76,
+	0x2e, 0x45, 0x6e, 0x76, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0xa1, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x76, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x6e, 0x76, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7,
+	0x8e, 0xaf, 0xe5, 0xa2, 0x83, 0x49, 0x44, 0x52, 0x05, 0x65, 0x6e, 0x76, 0x49, 0x64, 0x12, 0x66,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4d, 0x92, 0x41, 0x4a, 0x32, 0x48, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe6, 0xa0, 0x87, 0xe8,
+	0xaf, 0x86, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe5, 0x90, 0x8c, 0xe7, 0x8e, 0xaf, 0xe5, 0xa2,
+	0x83, 0xe4, 0xb8, 0x8b, 0xe7, 0x9b, 0xb8, 0xe5, 0x90, 0x8c, 0xe6, 0xa0, 0x87, 0xe8, 0xaf, 0x86,
+	0xe7, 0x9a, 0x84, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe8, 0xa7, 0x86, 0xe4, 0xb8, 0xba, 0xe5,
+	0x90, 0x8c, 0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x52, 0x06,
+	0x61, 0x70, 0x70, 0x4b, 0x65, 0x79, 0x22, 0x65, 0x0a, 0x17, 0x45, 0x6e, 0x76, 0x41, 0x70, 0x70,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x48, 0x5a,
+	0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x3b, 0x70, 0x62, 0x65, 0x6e, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_environment_proto_rawDescOnce sync.Once
+	file_environment_proto_rawDescData = file_environment_proto_rawDesc
+)
+
+func file_environment_proto_rawDescGZIP() []byte {
+	file_environment_proto_rawDescOnce.Do(func() {
+		file_environment_proto_rawDescData = protoimpl.X.CompressGZIP(file_environment_proto_rawDescData)
+	})
+	return file_environment_proto_rawDescData
+}
+
+var file_environment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_environment_proto_goTypes = []any{
+	(*Environment)(nil),             // 0: pbenv.Environment
+	(*EnvironmentSpec)(nil),         // 1: pbenv.EnvironmentSpec
+	(*EnvironmentAttachment)(nil),   // 2: pbenv.EnvironmentAttachment
+	(*EnvAppBinding)(nil),           // 3: pbenv.EnvAppBinding
+	(*EnvAppBindingSpec)(nil),       // 4: pbenv.EnvAppBindingSpec
+	(*EnvAppBindingAttachment)(nil), // 5: pbenv.EnvAppBindingAttachment
+	(*base.Revision)(nil),           // 6: pbbase.Revision
+}
+var file_environment_proto_depIdxs = []int32{
+	1, // 0: pbenv.Environment.spec:type_name -> pbenv.EnvironmentSpec
+	2, // 1: pbenv.Environment.attachment:type_name -> pbenv.EnvironmentAttachment
+	6, // 2: pbenv.Environment.revision:type_name -> pbbase.Revision
+	4, // 3: pbenv.EnvAppBinding.spec:type_name -> pbenv.EnvAppBindingSpec
+	5, // 4: pbenv.EnvAppBinding.attachment:type_name -> pbenv.EnvAppBindingAttachment
+	6, // 5: pbenv.EnvAppBinding.revision:type_name -> pbbase.Revision
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_environment_proto_init() }
+func file_environment_proto_init() {
+	if File_environment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_environment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_environment_proto_goTypes,
+		DependencyIndexes: file_environment_proto_depIdxs,
+		MessageInfos:      file_environment_proto_msgTypes,
+	}.Build()
+	File_environment_proto = out.File
+	file_environment_proto_rawDesc = nil
+	file_environment_proto_goTypes = nil
+	file_environment_proto_depIdxs = nil
+}
@@ -0,0 +1,185 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbexp xxx
+package pbexp
+
+import (
+	"fmt"
+	"time"
+
+	pbstruct "github.com/golang/protobuf/ptypes/struct"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	pbgroup "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
+)
+
+// ExperimentSpec convert pb ExperimentSpec to table ExperimentSpec
+func (m *ExperimentSpec) ExperimentSpec() (*table.ExperimentSpec, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	spec := &table.ExperimentSpec{
+		Name:     m.Name,
+		Key:      m.Key,
+		Variants: make(table.ExperimentVariants, 0, len(m.Variants)),
+		Enabled:  m.Enabled,
+		Memo:     m.Memo,
+	}
+	if m.Selector != nil && len(m.Selector.Fields) != 0 {
+		s, err := pbgroup.UnmarshalSelector(m.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid experiment selector, err: %v", err)
+		}
+		spec.Selector = s
+	}
+	for _, v := range m.Variants {
+		if v == nil {
+			continue
+		}
+		spec.Variants = append(spec.Variants, &table.ExperimentVariant{
+			Name:   v.Name,
+			Weight: v.Weight,
+			Value:  v.Value,
+		})
+	}
+
+	return spec, nil
+}
+
+// PbExperimentSpec convert table ExperimentSpec to pb ExperimentSpec
+func PbExperimentSpec(spec *table.ExperimentSpec) (*ExperimentSpec, error) {
+	if spec == nil {
+		return nil, nil
+	}
+
+	sel := new(pbstruct.Struct)
+	if spec.Selector != nil {
+		s, err := spec.Selector.MarshalPB()
+		if err != nil {
+			return nil, err
+		}
+		sel = s
+	}
+
+	variants := make([]*ExperimentVariant, 0, len(spec.Variants))
+	for _, v := range spec.Variants {
+		variants = append(variants, &ExperimentVariant{Name: v.Name, Weight: v.Weight, Value: v.Value})
+	}
+
+	return &ExperimentSpec{
+		Name:     spec.Name,
+		Key:      spec.Key,
+		Selector: sel,
+		Variants: variants,
+		Enabled:  spec.Enabled,
+		Memo:     spec.Memo,
+	}, nil
+}
+
+// ExperimentAttachment convert pb ExperimentAttachment to table ExperimentAttachment
+func (m *ExperimentAttachment) ExperimentAttachment() *table.ExperimentAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.ExperimentAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbExperiment convert table Experiment to pb Experiment
+func PbExperiment(e *table.Experiment) (*Experiment, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	spec, err := PbExperimentSpec(e.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var at *ExperimentAttachment
+	if e.Attachment != nil {
+		at = &ExperimentAttachment{BizId: e.Attachment.BizID, AppId: e.Attachment.AppID}
+	}
+
+	return &Experiment{
+		Id:         e.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbRevision(e.Revision),
+	}, nil
+}
+
+// PbExperiments convert table Experiment list to pb Experiment list
+func PbExperiments(list []*table.Experiment) ([]*Experiment, error) {
+	result := make([]*Experiment, 0, len(list))
+	for _, one := range list {
+		e, err := PbExperiment(one)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, e)
+	}
+
+	return result, nil
+}
+
+// ExperimentExposure convert pb ExperimentExposure to table ExperimentExposure
+func (m *ExperimentExposure) ExperimentExposure() (*table.ExperimentExposure, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	exposedAt := time.Now().UTC()
+	if m.ExposedAt != "" {
+		t, err := time.Parse(time.RFC3339, m.ExposedAt)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exposed at %s, should be RFC3339 format", m.ExposedAt)
+		}
+		exposedAt = t.UTC()
+	}
+
+	return &table.ExperimentExposure{
+		Spec: &table.ExperimentExposureSpec{
+			Variant:        m.Variant,
+			ReleaseID:      m.ReleaseId,
+			Count:          1,
+			FirstExposedAt: exposedAt,
+			LastExposedAt:  exposedAt,
+		},
+		Attachment: &table.ExperimentExposureAttachment{
+			BizID:        m.BizId,
+			AppID:        m.AppId,
+			ExperimentID: m.ExperimentId,
+			UID:          m.Uid,
+		},
+	}, nil
+}
+
+// PbExperimentVariantStats convert table ExperimentVariantStat list to pb ExperimentVariantStat list
+func PbExperimentVariantStats(list []*table.ExperimentVariantStat) []*ExperimentVariantStat {
+	result := make([]*ExperimentVariantStat, 0, len(list))
+	for _, one := range list {
+		result = append(result, &ExperimentVariantStat{
+			Variant:   one.Variant,
+			Clients:   one.Clients,
+			Exposures: one.Exposures,
+		})
+	}
+
+	return result
+}
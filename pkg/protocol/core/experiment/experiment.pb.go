@@ -0,0 +1,615 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: experiment.proto
+
+package pbexp
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Experiment source resource reference: pkg/dal/table/experiment.go
+type Experiment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *ExperimentSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *ExperimentAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision        `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *Experiment) Reset() {
+	*x = Experiment{}
+	mi := &file_experiment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Experiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Experiment) ProtoMessage() {}
+
+func (x *Experiment) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Experiment.ProtoReflect.Descriptor instead.
+func (*Experiment) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Experiment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Experiment) GetSpec() *ExperimentSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Experiment) GetAttachment() *ExperimentAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *Experiment) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// ExperimentSpec source resource reference: pkg/dal/table/experiment.go
+type ExperimentSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key      string               `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Selector *structpb.Struct     `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	Variants []*ExperimentVariant `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+	Enabled  bool                 `protobuf:"varint,5,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Memo     string               `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *ExperimentSpec) Reset() {
+	*x = ExperimentSpec{}
+	mi := &file_experiment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentSpec) ProtoMessage() {}
+
+func (x *ExperimentSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentSpec.ProtoReflect.Descriptor instead.
+func (*ExperimentSpec) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExperimentSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExperimentSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ExperimentSpec) GetSelector() *structpb.Struct {
+	if x != nil {
+		return x.Selector
+	}
+	return nil
+}
+
+func (x *ExperimentSpec) GetVariants() []*ExperimentVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *ExperimentSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ExperimentSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// ExperimentVariant source resource reference: pkg/dal/table/experiment.go
+type ExperimentVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ExperimentVariant) Reset() {
+	*x = ExperimentVariant{}
+	mi := &file_experiment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariant) ProtoMessage() {}
+
+func (x *ExperimentVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariant.ProtoReflect.Descriptor instead.
+func (*ExperimentVariant) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExperimentVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExperimentVariant) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *ExperimentVariant) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// ExperimentAttachment source resource reference: pkg/dal/table/experiment.go
+type ExperimentAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *ExperimentAttachment) Reset() {
+	*x = ExperimentAttachment{}
+	mi := &file_experiment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentAttachment) ProtoMessage() {}
+
+func (x *ExperimentAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentAttachment.ProtoReflect.Descriptor instead.
+func (*ExperimentAttachment) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ExperimentAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ExperimentAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+// ExperimentExposure source resource reference: pkg/dal/table/experiment.go
+type ExperimentExposure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId        uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId        uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ExperimentId uint32 `protobuf:"varint,3,opt,name=experiment_id,json=experimentId,proto3" json:"experiment_id,omitempty"`
+	Uid          string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Variant      string `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`
+	ReleaseId    uint32 `protobuf:"varint,6,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ExposedAt    string `protobuf:"bytes,7,opt,name=exposed_at,json=exposedAt,proto3" json:"exposed_at,omitempty"`
+}
+
+func (x *ExperimentExposure) Reset() {
+	*x = ExperimentExposure{}
+	mi := &file_experiment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentExposure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentExposure) ProtoMessage() {}
+
+func (x *ExperimentExposure) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentExposure.ProtoReflect.Descriptor instead.
+func (*ExperimentExposure) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ExperimentExposure) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ExperimentExposure) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ExperimentExposure) GetExperimentId() uint32 {
+	if x != nil {
+		return x.ExperimentId
+	}
+	return 0
+}
+
+func (x *ExperimentExposure) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ExperimentExposure) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ExperimentExposure) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ExperimentExposure) GetExposedAt() string {
+	if x != nil {
+		return x.ExposedAt
+	}
+	return ""
+}
+
+// ExperimentVariantStat source resource reference: pkg/dal/table/experiment.go
+type ExperimentVariantStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variant   string `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	Clients   uint32 `protobuf:"varint,2,opt,name=clients,proto3" json:"clients,omitempty"`
+	Exposures uint32 `protobuf:"varint,3,opt,name=exposures,proto3" json:"exposures,omitempty"`
+}
+
+func (x *ExperimentVariantStat) Reset() {
+	*x = ExperimentVariantStat{}
+	mi := &file_experiment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExperimentVariantStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentVariantStat) ProtoMessage() {}
+
+func (x *ExperimentVariantStat) ProtoReflect() protoreflect.Message {
+	mi := &file_experiment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentVariantStat.ProtoReflect.Descriptor instead.
+func (*ExperimentVariantStat) Descriptor() ([]byte, []int) {
+	return file_experiment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ExperimentVariantStat) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *ExperimentVariantStat) GetClients() uint32 {
+	if x != nil {
+		return x.Clients
+	}
+	return 0
+}
+
+func (x *ExperimentVariantStat) GetExposures() uint32 {
+	if x != nil {
+		return x.Exposures
+	}
+	return 0
+}
+
+var File_experiment_proto protoreflect.FileDescriptor
+
+var file_experiment_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x65, 0x78, 0x70, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc1, 0x01, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe5, 0xae, 0x9e, 0xe9,
+	0xaa, 0x8c, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x62, 0x65, 0x78, 0x70, 0x2e, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x12, 0x3b, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x65, 0x78, 0x70, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xce,
+	0x03, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e, 0xe9, 0xaa, 0x8c, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x44, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe5, 0xae, 0x9e, 0xe9,
+	0xaa, 0x8c, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x6b, 0x65,
+	0x79, 0xef, 0xbc, 0x8c, 0xe5, 0x88, 0x9b, 0xe5, 0xbb, 0xba, 0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x8d,
+	0xe5, 0x8f, 0xaf, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x85,
+	0x01, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32,
+	0x4b, 0xe5, 0x8f, 0x82, 0xe4, 0xb8, 0x8e, 0xe5, 0xae, 0x9e, 0xe9, 0xaa, 0x8c, 0xe7, 0x9a, 0x84,
+	0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe6, 0xa0, 0x87, 0xe7, 0xad, 0xbe, 0xe9, 0x80, 0x89, 0xe6,
+	0x8b, 0xa9, 0xe5, 0x99, 0xa8, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97,
+	0xb6, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe9, 0x83, 0xbd,
+	0xe5, 0x8f, 0x82, 0xe4, 0xb8, 0x8e, 0xe5, 0xae, 0x9e, 0xe9, 0xaa, 0x8c, 0x52, 0x08, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x5c, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x65, 0x78, 0x70,
+	0x2e, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xae, 0x9e, 0xe9, 0xaa, 0x8c, 0xe5,
+	0x88, 0x86, 0xe6, 0xa1, 0xb6, 0xef, 0xbc, 0x8c, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0xe4, 0xb9,
+	0x8b, 0xe5, 0x92, 0x8c, 0xe4, 0xb8, 0xba, 0x31, 0x30, 0x30, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74,
+	0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0x9e,
+	0xe9, 0xaa, 0x8c, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22,
+	0xb7, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x88, 0x86, 0xe6, 0xa1, 0xb6,
+	0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1c, 0x92, 0x41,
+	0x19, 0x32, 0x17, 0xe5, 0x88, 0x86, 0xe6, 0xa1, 0xb6, 0xe6, 0x9d, 0x83, 0xe9, 0x87, 0x8d, 0x28,
+	0xe7, 0x99, 0xbe, 0xe5, 0x88, 0x86, 0xe6, 0xaf, 0x94, 0x29, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe5, 0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe5, 0x88,
+	0x86, 0xe6, 0xa1, 0xb6, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0xe4, 0xb8, 0x8b,
+	0xe5, 0x8f, 0x91, 0xe7, 0x9a, 0x84, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5,
+	0x80, 0xbc, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x14, 0x45, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xd1, 0x01,
+	0x0a, 0x12, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x78, 0x70, 0x6f,
+	0x73, 0x75, 0x72, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x70, 0x70,
+	0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xab, 0x01, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x76,
+	0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41,
+	0x0e, 0x32, 0x0c, 0xe5, 0x88, 0x86, 0xe6, 0xa1, 0xb6, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15,
+	0xe6, 0x9b, 0x9d, 0xe5, 0x85, 0x89, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
+	0xab, 0xaf, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9b, 0x9d, 0xe5, 0x85, 0x89, 0xe6, 0xac,
+	0xa1, 0xe6, 0x95, 0xb0, 0x52, 0x09, 0x65, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x73, 0x42,
+	0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b,
+	0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x3b, 0x70, 0x62, 0x65, 0x78, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_experiment_proto_rawDescOnce sync.Once
+	file_experiment_proto_rawDescData = file_experiment_proto_rawDesc
+)
+
+func file_experiment_proto_rawDescGZIP() []byte {
+	file_experiment_proto_rawDescOnce.Do(func() {
+		file_experiment_proto_rawDescData = protoimpl.X.CompressGZIP(file_experiment_proto_rawDescData)
+	})
+	return file_experiment_proto_rawDescData
+}
+
+var file_experiment_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_experiment_proto_goTypes = []any{
+	(*Experiment)(nil),            // 0: pbexp.Experiment
+	(*ExperimentSpec)(nil),        // 1: pbexp.ExperimentSpec
+	(*ExperimentVariant)(nil),     // 2: pbexp.ExperimentVariant
+	(*ExperimentAttachment)(nil),  // 3: pbexp.ExperimentAttachment
+	(*ExperimentExposure)(nil),    // 4: pbexp.ExperimentExposure
+	(*ExperimentVariantStat)(nil), // 5: pbexp.ExperimentVariantStat
+	(*base.Revision)(nil),         // 6: pbbase.Revision
+	(*structpb.Struct)(nil),       // 7: google.protobuf.Struct
+}
+var file_experiment_proto_depIdxs = []int32{
+	1, // 0: pbexp.Experiment.spec:type_name -> pbexp.ExperimentSpec
+	3, // 1: pbexp.Experiment.attachment:type_name -> pbexp.ExperimentAttachment
+	6, // 2: pbexp.Experiment.revision:type_name -> pbbase.Revision
+	7, // 3: pbexp.ExperimentSpec.selector:type_name -> google.protobuf.Struct
+	2, // 4: pbexp.ExperimentSpec.variants:type_name -> pbexp.ExperimentVariant
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_experiment_proto_init() }
+func file_experiment_proto_init() {
+	if File_experiment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_experiment_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_experiment_proto_goTypes,
+		DependencyIndexes: file_experiment_proto_depIdxs,
+		MessageInfos:      file_experiment_proto_msgTypes,
+	}.Build()
+	File_experiment_proto = out.File
+	file_experiment_proto_rawDesc = nil
+	file_experiment_proto_goTypes = nil
+	file_experiment_proto_depIdxs = nil
+}
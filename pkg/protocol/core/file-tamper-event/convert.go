@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbfte xxx
+package pbfte
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// FileTamperEventSpec convert pb FileTamperEventSpec to table FileTamperEventSpec
+func (f *FileTamperEventSpec) FileTamperEventSpec() *table.FileTamperEventSpec {
+	if f == nil {
+		return nil
+	}
+
+	return &table.FileTamperEventSpec{
+		ConfigItemID:      f.ConfigItemId,
+		Path:              f.Path,
+		Name:              f.Name,
+		Kind:              table.FileTamperKind(f.Kind),
+		ExpectedSignature: f.ExpectedSignature,
+		ActualSignature:   f.ActualSignature,
+		Repaired:          f.Repaired,
+		RepairError:       f.RepairError,
+		Ip:                f.Ip,
+		ClientType:        table.ClientType(f.ClientType),
+		DetectedAt:        f.DetectedAt.AsTime().UTC(),
+	}
+}
+
+// PbFileTamperEventSpec convert table FileTamperEventSpec to pb FileTamperEventSpec
+func PbFileTamperEventSpec(spec *table.FileTamperEventSpec) *FileTamperEventSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &FileTamperEventSpec{
+		ConfigItemId:      spec.ConfigItemID,
+		Path:              spec.Path,
+		Name:              spec.Name,
+		Kind:              string(spec.Kind),
+		ExpectedSignature: spec.ExpectedSignature,
+		ActualSignature:   spec.ActualSignature,
+		Repaired:          spec.Repaired,
+		RepairError:       spec.RepairError,
+		Ip:                spec.Ip,
+		ClientType:        string(spec.ClientType),
+		DetectedAt:        timestamppb.New(spec.DetectedAt.UTC()),
+		CreatedAt:         timestamppb.New(spec.CreatedAt.UTC()),
+	}
+}
+
+// FileTamperEventAttachment convert pb FileTamperEventAttachment to table FileTamperEventAttachment
+func (f *FileTamperEventAttachment) FileTamperEventAttachment() *table.FileTamperEventAttachment {
+	if f == nil {
+		return nil
+	}
+
+	return &table.FileTamperEventAttachment{
+		BizID:     f.BizId,
+		AppID:     f.AppId,
+		ReleaseID: f.ReleaseId,
+		UID:       f.Uid,
+	}
+}
+
+// PbFileTamperEventAttachment convert table FileTamperEventAttachment to pb FileTamperEventAttachment
+func PbFileTamperEventAttachment(at *table.FileTamperEventAttachment) *FileTamperEventAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &FileTamperEventAttachment{
+		BizId:     at.BizID,
+		AppId:     at.AppID,
+		ReleaseId: at.ReleaseID,
+		Uid:       at.UID,
+	}
+}
+
+// PbFileTamperEvent convert table FileTamperEvent to pb FileTamperEvent
+func PbFileTamperEvent(f *table.FileTamperEvent) *FileTamperEvent {
+	if f == nil {
+		return nil
+	}
+
+	return &FileTamperEvent{
+		Id:         f.ID,
+		Spec:       PbFileTamperEventSpec(f.Spec),
+		Attachment: PbFileTamperEventAttachment(f.Attachment),
+	}
+}
+
+// PbFileTamperEvents convert table FileTamperEvent to pb FileTamperEvent
+func PbFileTamperEvents(fs []*table.FileTamperEvent) []*FileTamperEvent {
+	result := make([]*FileTamperEvent, 0, len(fs))
+	for _, f := range fs {
+		result = append(result, PbFileTamperEvent(f))
+	}
+
+	return result
+}
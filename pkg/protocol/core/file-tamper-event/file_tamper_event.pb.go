@@ -0,0 +1,437 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: file_tamper_event.proto
+
+package pbfte
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FileTamperEvent source resource reference: pkg/dal/table/file_tamper_event.go
+type FileTamperEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *FileTamperEventSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *FileTamperEventAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *FileTamperEvent) Reset() {
+	*x = FileTamperEvent{}
+	mi := &file_file_tamper_event_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTamperEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTamperEvent) ProtoMessage() {}
+
+func (x *FileTamperEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_file_tamper_event_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTamperEvent.ProtoReflect.Descriptor instead.
+func (*FileTamperEvent) Descriptor() ([]byte, []int) {
+	return file_file_tamper_event_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FileTamperEvent) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FileTamperEvent) GetSpec() *FileTamperEventSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *FileTamperEvent) GetAttachment() *FileTamperEventAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// FileTamperEventSpec source resource reference: pkg/dal/table/file_tamper_event.go
+type FileTamperEventSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConfigItemId      uint32                 `protobuf:"varint,1,opt,name=config_item_id,json=configItemId,proto3" json:"config_item_id,omitempty"`
+	Path              string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Name              string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Kind              string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	ExpectedSignature string                 `protobuf:"bytes,5,opt,name=expected_signature,json=expectedSignature,proto3" json:"expected_signature,omitempty"`
+	ActualSignature   string                 `protobuf:"bytes,6,opt,name=actual_signature,json=actualSignature,proto3" json:"actual_signature,omitempty"`
+	Repaired          bool                   `protobuf:"varint,7,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	RepairError       string                 `protobuf:"bytes,8,opt,name=repair_error,json=repairError,proto3" json:"repair_error,omitempty"`
+	Ip                string                 `protobuf:"bytes,9,opt,name=ip,proto3" json:"ip,omitempty"`
+	ClientType        string                 `protobuf:"bytes,10,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	DetectedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *FileTamperEventSpec) Reset() {
+	*x = FileTamperEventSpec{}
+	mi := &file_file_tamper_event_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTamperEventSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTamperEventSpec) ProtoMessage() {}
+
+func (x *FileTamperEventSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_file_tamper_event_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTamperEventSpec.ProtoReflect.Descriptor instead.
+func (*FileTamperEventSpec) Descriptor() ([]byte, []int) {
+	return file_file_tamper_event_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FileTamperEventSpec) GetConfigItemId() uint32 {
+	if x != nil {
+		return x.ConfigItemId
+	}
+	return 0
+}
+
+func (x *FileTamperEventSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetExpectedSignature() string {
+	if x != nil {
+		return x.ExpectedSignature
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetActualSignature() string {
+	if x != nil {
+		return x.ActualSignature
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetRepaired() bool {
+	if x != nil {
+		return x.Repaired
+	}
+	return false
+}
+
+func (x *FileTamperEventSpec) GetRepairError() string {
+	if x != nil {
+		return x.RepairError
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetClientType() string {
+	if x != nil {
+		return x.ClientType
+	}
+	return ""
+}
+
+func (x *FileTamperEventSpec) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+func (x *FileTamperEventSpec) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// FileTamperEventAttachment source resource reference: pkg/dal/table/file_tamper_event.go
+type FileTamperEventAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId     uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId     uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ReleaseId uint32 `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Uid       string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *FileTamperEventAttachment) Reset() {
+	*x = FileTamperEventAttachment{}
+	mi := &file_file_tamper_event_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileTamperEventAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileTamperEventAttachment) ProtoMessage() {}
+
+func (x *FileTamperEventAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_file_tamper_event_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileTamperEventAttachment.ProtoReflect.Descriptor instead.
+func (*FileTamperEventAttachment) Descriptor() ([]byte, []int) {
+	return file_file_tamper_event_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FileTamperEventAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *FileTamperEventAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *FileTamperEventAttachment) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *FileTamperEventAttachment) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+var File_file_tamper_event_proto protoreflect.FileDescriptor
+
+var file_file_tamper_event_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x6d, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x66, 0x74, 0x65,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70,
+	0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xa8, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x6d, 0x70, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe7, 0xaf, 0xa1, 0xe6, 0x94, 0xb9, 0xe4, 0xba,
+	0x8b, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x66, 0x74, 0x65,
+	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x6d, 0x70, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x70, 0x62, 0x66, 0x74, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x6d, 0x70, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xb1, 0x06, 0x0a,
+	0x13, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x6d, 0x70, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x39, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41,
+	0x10, 0x32, 0x0e, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x49,
+	0x44, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe6, 0x96, 0x87, 0xe4, 0xbb,
+	0xb6, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x7f, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x6b, 0x92, 0x41, 0x68, 0x32, 0x66, 0xe7,
+	0xaf, 0xa1, 0xe6, 0x94, 0xb9, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x6d, 0x6f,
+	0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x3d, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe8, 0xa2, 0xab,
+	0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9, 0xef, 0xbc, 0x8c, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x3d, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe8, 0xa2, 0xab, 0xe5, 0x88, 0xa0, 0xe9, 0x99, 0xa4,
+	0xef, 0xbc, 0x8c, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x3d, 0xe6, 0x9d, 0x83, 0xe9, 0x99, 0x90, 0xe8, 0xa2, 0xab, 0xe4,
+	0xbf, 0xae, 0xe6, 0x94, 0xb9, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x4c, 0x0a, 0x12, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0xe4, 0xb8, 0xad, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84,
+	0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x48, 0x0a, 0x10, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe4, 0xb8, 0xbb, 0xe6, 0x9c, 0xba,
+	0xe4, 0xb8, 0x8a, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0x9a, 0x84, 0x73, 0x68, 0x61, 0x32,
+	0x35, 0x36, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe6, 0x98, 0xaf, 0xe5,
+	0x90, 0xa6, 0xe5, 0xb7, 0xb2, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0xe4, 0xb8, 0xba, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9,
+	0x52, 0x08, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1a, 0x92, 0x41, 0x17, 0x32, 0x15, 0xe4, 0xbf, 0xae, 0xe5, 0xa4, 0x8d, 0xe5, 0xa4, 0xb1,
+	0xe8, 0xb4, 0xa5, 0xe7, 0x9a, 0x84, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x61, 0x69, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6,
+	0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x49, 0x50, 0x52, 0x02, 0x69, 0x70, 0x12, 0x35, 0x0a, 0x0b, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf,
+	0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x91, 0xe7, 0x8e, 0xb0,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xba, 0x01, 0x0a, 0x19, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x61, 0x6d, 0x70, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
+	0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44, 0x52, 0x09, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6,
+	0x88, 0xb7, 0xe7, 0xab, 0xaf, 0x55, 0x49, 0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x42, 0x4e, 0x5a,
+	0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x2d, 0x74, 0x61, 0x6d, 0x70, 0x65,
+	0x72, 0x2d, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x3b, 0x70, 0x62, 0x66, 0x74, 0x65, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_file_tamper_event_proto_rawDescOnce sync.Once
+	file_file_tamper_event_proto_rawDescData = file_file_tamper_event_proto_rawDesc
+)
+
+func file_file_tamper_event_proto_rawDescGZIP() []byte {
+	file_file_tamper_event_proto_rawDescOnce.Do(func() {
+		file_file_tamper_event_proto_rawDescData = protoimpl.X.CompressGZIP(file_file_tamper_event_proto_rawDescData)
+	})
+	return file_file_tamper_event_proto_rawDescData
+}
+
+var file_file_tamper_event_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_file_tamper_event_proto_goTypes = []any{
+	(*FileTamperEvent)(nil),           // 0: pbfte.FileTamperEvent
+	(*FileTamperEventSpec)(nil),       // 1: pbfte.FileTamperEventSpec
+	(*FileTamperEventAttachment)(nil), // 2: pbfte.FileTamperEventAttachment
+	(*timestamppb.Timestamp)(nil),     // 3: google.protobuf.Timestamp
+}
+var file_file_tamper_event_proto_depIdxs = []int32{
+	1, // 0: pbfte.FileTamperEvent.spec:type_name -> pbfte.FileTamperEventSpec
+	2, // 1: pbfte.FileTamperEvent.attachment:type_name -> pbfte.FileTamperEventAttachment
+	3, // 2: pbfte.FileTamperEventSpec.detected_at:type_name -> google.protobuf.Timestamp
+	3, // 3: pbfte.FileTamperEventSpec.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_file_tamper_event_proto_init() }
+func file_file_tamper_event_proto_init() {
+	if File_file_tamper_event_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_file_tamper_event_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_file_tamper_event_proto_goTypes,
+		DependencyIndexes: file_file_tamper_event_proto_depIdxs,
+		MessageInfos:      file_file_tamper_event_proto_msgTypes,
+	}.Build()
+	File_file_tamper_event_proto = out.File
+	file_file_tamper_event_proto_rawDesc = nil
+	file_file_tamper_event_proto_goTypes = nil
+	file_file_tamper_event_proto_depIdxs = nil
+}
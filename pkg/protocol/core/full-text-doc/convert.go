@@ -0,0 +1,53 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbftd xxx
+package pbftd
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// snippetWidth is the max rune count of the content around the keyword in snippet.
+const snippetWidth = 120
+
+// PbFullTextHit convert table FullTextDoc to pb FullTextHit, only the snippet of content is exposed.
+func PbFullTextHit(doc *table.FullTextDoc, keyword string) *FullTextHit {
+	if doc == nil || doc.Spec == nil {
+		return nil
+	}
+
+	hit := &FullTextHit{
+		ResType:   string(doc.Spec.ResType),
+		ResId:     doc.Spec.ResID,
+		Name:      doc.Spec.Name,
+		Memo:      doc.Spec.Memo,
+		Snippet:   doc.Spec.Snippet(keyword, snippetWidth),
+		IndexedAt: timestamppb.New(doc.Spec.IndexedAt.UTC()),
+	}
+	if doc.Attachment != nil {
+		hit.AppId = doc.Attachment.AppID
+	}
+
+	return hit
+}
+
+// PbFullTextHits convert table FullTextDoc list to pb FullTextHit list
+func PbFullTextHits(docs []*table.FullTextDoc, keyword string) []*FullTextHit {
+	result := make([]*FullTextHit, 0, len(docs))
+	for _, one := range docs {
+		result = append(result, PbFullTextHit(one, keyword))
+	}
+
+	return result
+}
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: full_text_doc.proto
+
+package pbftd
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// FullTextHit source resource reference: pkg/dal/table/full_text_doc.go
+type FullTextHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId     uint32                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ResType   string                 `protobuf:"bytes,2,opt,name=res_type,json=resType,proto3" json:"res_type,omitempty"`
+	ResId     uint32                 `protobuf:"varint,3,opt,name=res_id,json=resId,proto3" json:"res_id,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Memo      string                 `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Snippet   string                 `protobuf:"bytes,6,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	IndexedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=indexed_at,json=indexedAt,proto3" json:"indexed_at,omitempty"`
+}
+
+func (x *FullTextHit) Reset() {
+	*x = FullTextHit{}
+	mi := &file_full_text_doc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FullTextHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FullTextHit) ProtoMessage() {}
+
+func (x *FullTextHit) ProtoReflect() protoreflect.Message {
+	mi := &file_full_text_doc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FullTextHit.ProtoReflect.Descriptor instead.
+func (*FullTextHit) Descriptor() ([]byte, []int) {
+	return file_full_text_doc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FullTextHit) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *FullTextHit) GetResType() string {
+	if x != nil {
+		return x.ResType
+	}
+	return ""
+}
+
+func (x *FullTextHit) GetResId() uint32 {
+	if x != nil {
+		return x.ResId
+	}
+	return 0
+}
+
+func (x *FullTextHit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FullTextHit) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *FullTextHit) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *FullTextHit) GetIndexedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IndexedAt
+	}
+	return nil
+}
+
+var File_full_text_doc_proto protoreflect.FileDescriptor
+
+var file_full_text_doc_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x6f, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x66, 0x74, 0x64, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x03,
+	0x0a, 0x0b, 0x46, 0x75, 0x6c, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x48, 0x69, 0x74, 0x12, 0x24, 0x0a,
+	0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92,
+	0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70,
+	0x70, 0x49, 0x64, 0x12, 0x48, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x28, 0xe5, 0x91, 0xbd, 0xe4,
+	0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e,
+	0x8b, 0xef, 0xbc, 0x9a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0xe3,
+	0x80, 0x81, 0x6b, 0x76, 0x52, 0x07, 0x72, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16, 0x92,
+	0x41, 0x13, 0x32, 0x11, 0xe5, 0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84,
+	0xe6, 0xba, 0x90, 0x49, 0x44, 0x52, 0x05, 0x72, 0x65, 0x73, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32,
+	0x28, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d,
+	0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0x92,
+	0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x64, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x45, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe4, 0xb8,
+	0xad, 0xe5, 0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe5, 0x85, 0xb3, 0xe9, 0x94, 0xae, 0xe5, 0xad, 0x97,
+	0xe7, 0x9a, 0x84, 0xe7, 0x89, 0x87, 0xe6, 0xae, 0xb5, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x85, 0xe5,
+	0x91, 0xbd, 0xe4, 0xb8, 0xad, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xe6, 0x88, 0x96, 0xe6, 0x8f,
+	0x8f, 0xe8, 0xbf, 0xb0, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x07, 0x73,
+	0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x0a, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0xb4, 0xa2,
+	0xe5, 0xbc, 0x95, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x65, 0x64, 0x41, 0x74, 0x42, 0x4a, 0x5a, 0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69,
+	0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x66, 0x75, 0x6c,
+	0x6c, 0x2d, 0x74, 0x65, 0x78, 0x74, 0x2d, 0x64, 0x6f, 0x63, 0x3b, 0x70, 0x62, 0x66, 0x74, 0x64,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_full_text_doc_proto_rawDescOnce sync.Once
+	file_full_text_doc_proto_rawDescData = file_full_text_doc_proto_rawDesc
+)
+
+func file_full_text_doc_proto_rawDescGZIP() []byte {
+	file_full_text_doc_proto_rawDescOnce.Do(func() {
+		file_full_text_doc_proto_rawDescData = protoimpl.X.CompressGZIP(file_full_text_doc_proto_rawDescData)
+	})
+	return file_full_text_doc_proto_rawDescData
+}
+
+var file_full_text_doc_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_full_text_doc_proto_goTypes = []any{
+	(*FullTextHit)(nil),           // 0: pbftd.FullTextHit
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_full_text_doc_proto_depIdxs = []int32{
+	1, // 0: pbftd.FullTextHit.indexed_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_full_text_doc_proto_init() }
+func file_full_text_doc_proto_init() {
+	if File_full_text_doc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_full_text_doc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_full_text_doc_proto_goTypes,
+		DependencyIndexes: file_full_text_doc_proto_depIdxs,
+		MessageInfos:      file_full_text_doc_proto_msgTypes,
+	}.Build()
+	File_full_text_doc_proto = out.File
+	file_full_text_doc_proto_rawDesc = nil
+	file_full_text_doc_proto_goTypes = nil
+	file_full_text_doc_proto_depIdxs = nil
+}
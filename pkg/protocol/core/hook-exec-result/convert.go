@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbher xxx
+package pbher
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// HookExecResultSpec convert pb HookExecResultSpec to table HookExecResultSpec
+func (h *HookExecResultSpec) HookExecResultSpec() *table.HookExecResultSpec {
+	if h == nil {
+		return nil
+	}
+
+	return &table.HookExecResultSpec{
+		HookType:   table.HookExecType(h.HookType),
+		HookName:   h.HookName,
+		ExitCode:   h.ExitCode,
+		DurationMs: h.DurationMs,
+		Stdout:     h.Stdout,
+		Stderr:     h.Stderr,
+		Ip:         h.Ip,
+		ClientType: table.ClientType(h.ClientType),
+		ExecutedAt: h.ExecutedAt.AsTime().UTC(),
+	}
+}
+
+// PbHookExecResultSpec convert table HookExecResultSpec to pb HookExecResultSpec
+func PbHookExecResultSpec(spec *table.HookExecResultSpec) *HookExecResultSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &HookExecResultSpec{
+		HookType:   string(spec.HookType),
+		HookName:   spec.HookName,
+		ExitCode:   spec.ExitCode,
+		DurationMs: spec.DurationMs,
+		Stdout:     spec.Stdout,
+		Stderr:     spec.Stderr,
+		Ip:         spec.Ip,
+		ClientType: string(spec.ClientType),
+		ExecutedAt: timestamppb.New(spec.ExecutedAt.UTC()),
+		CreatedAt:  timestamppb.New(spec.CreatedAt.UTC()),
+	}
+}
+
+// HookExecResultAttachment convert pb HookExecResultAttachment to table HookExecResultAttachment
+func (h *HookExecResultAttachment) HookExecResultAttachment() *table.HookExecResultAttachment {
+	if h == nil {
+		return nil
+	}
+
+	return &table.HookExecResultAttachment{
+		BizID:     h.BizId,
+		AppID:     h.AppId,
+		ReleaseID: h.ReleaseId,
+		UID:       h.Uid,
+	}
+}
+
+// PbHookExecResultAttachment convert table HookExecResultAttachment to pb HookExecResultAttachment
+func PbHookExecResultAttachment(at *table.HookExecResultAttachment) *HookExecResultAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &HookExecResultAttachment{
+		BizId:     at.BizID,
+		AppId:     at.AppID,
+		ReleaseId: at.ReleaseID,
+		Uid:       at.UID,
+	}
+}
+
+// PbHookExecResult convert table HookExecResult to pb HookExecResult
+func PbHookExecResult(h *table.HookExecResult) *HookExecResult {
+	if h == nil {
+		return nil
+	}
+
+	return &HookExecResult{
+		Id:         h.ID,
+		Spec:       PbHookExecResultSpec(h.Spec),
+		Attachment: PbHookExecResultAttachment(h.Attachment),
+	}
+}
+
+// PbHookExecResults convert table HookExecResult to pb HookExecResult
+func PbHookExecResults(hs []*table.HookExecResult) []*HookExecResult {
+	result := make([]*HookExecResult, 0, len(hs))
+	for _, h := range hs {
+		result = append(result, PbHookExecResult(h))
+	}
+
+	return result
+}
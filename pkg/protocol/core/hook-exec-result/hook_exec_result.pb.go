@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: hook_exec_result.proto
+
+package pbher
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HookExecResult source resource reference: pkg/dal/table/hook_exec_result.go
+type HookExecResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *HookExecResultSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *HookExecResultAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *HookExecResult) Reset() {
+	*x = HookExecResult{}
+	mi := &file_hook_exec_result_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookExecResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookExecResult) ProtoMessage() {}
+
+func (x *HookExecResult) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_exec_result_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookExecResult.ProtoReflect.Descriptor instead.
+func (*HookExecResult) Descriptor() ([]byte, []int) {
+	return file_hook_exec_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HookExecResult) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HookExecResult) GetSpec() *HookExecResultSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *HookExecResult) GetAttachment() *HookExecResultAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// HookExecResultSpec source resource reference: pkg/dal/table/hook_exec_result.go
+type HookExecResultSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HookType   string                 `protobuf:"bytes,1,opt,name=hook_type,json=hookType,proto3" json:"hook_type,omitempty"`
+	HookName   string                 `protobuf:"bytes,2,opt,name=hook_name,json=hookName,proto3" json:"hook_name,omitempty"`
+	ExitCode   int32                  `protobuf:"varint,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	DurationMs uint32                 `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	Stdout     string                 `protobuf:"bytes,5,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	Stderr     string                 `protobuf:"bytes,6,opt,name=stderr,proto3" json:"stderr,omitempty"`
+	Ip         string                 `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
+	ClientType string                 `protobuf:"bytes,8,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	ExecutedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=executed_at,json=executedAt,proto3" json:"executed_at,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *HookExecResultSpec) Reset() {
+	*x = HookExecResultSpec{}
+	mi := &file_hook_exec_result_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookExecResultSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookExecResultSpec) ProtoMessage() {}
+
+func (x *HookExecResultSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_exec_result_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookExecResultSpec.ProtoReflect.Descriptor instead.
+func (*HookExecResultSpec) Descriptor() ([]byte, []int) {
+	return file_hook_exec_result_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HookExecResultSpec) GetHookType() string {
+	if x != nil {
+		return x.HookType
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetHookName() string {
+	if x != nil {
+		return x.HookName
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetExitCode() int32 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *HookExecResultSpec) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *HookExecResultSpec) GetStdout() string {
+	if x != nil {
+		return x.Stdout
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetStderr() string {
+	if x != nil {
+		return x.Stderr
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetClientType() string {
+	if x != nil {
+		return x.ClientType
+	}
+	return ""
+}
+
+func (x *HookExecResultSpec) GetExecutedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExecutedAt
+	}
+	return nil
+}
+
+func (x *HookExecResultSpec) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// HookExecResultAttachment source resource reference: pkg/dal/table/hook_exec_result.go
+type HookExecResultAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId     uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId     uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ReleaseId uint32 `protobuf:"varint,3,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Uid       string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+}
+
+func (x *HookExecResultAttachment) Reset() {
+	*x = HookExecResultAttachment{}
+	mi := &file_hook_exec_result_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookExecResultAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookExecResultAttachment) ProtoMessage() {}
+
+func (x *HookExecResultAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_exec_result_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookExecResultAttachment.ProtoReflect.Descriptor instead.
+func (*HookExecResultAttachment) Descriptor() ([]byte, []int) {
+	return file_hook_exec_result_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HookExecResultAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *HookExecResultAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *HookExecResultAttachment) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *HookExecResultAttachment) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+var File_hook_exec_result_proto protoreflect.FileDescriptor
+
+var file_hook_exec_result_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x62, 0x68, 0x65, 0x72, 0x1a,
+	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xab, 0x01, 0x0a, 0x0e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0x89, 0xa7, 0xe8,
+	0xa1, 0x8c, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x9c, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d,
+	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x62, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3f, 0x0a,
+	0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x62, 0x68, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78,
+	0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xdd,
+	0x04, 0x0a, 0x12, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x45, 0x0a, 0x09, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32, 0x23, 0xe8,
+	0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x70, 0x72,
+	0x65, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0xe3, 0x80, 0x81, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x6f,
+	0x6f, 0x6b, 0x52, 0x08, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x09,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe5, 0x90, 0x8d, 0xe7,
+	0xa7, 0xb0, 0x52, 0x08, 0x68, 0x6f, 0x6f, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x09,
+	0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe9, 0x80, 0x80, 0xe5, 0x87, 0xba, 0xe7, 0xa0, 0x81, 0x52,
+	0x08, 0x65, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19,
+	0x92, 0x41, 0x16, 0x32, 0x14, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe8, 0x80, 0x97, 0xe6, 0x97,
+	0xb6, 0x28, 0xe6, 0xaf, 0xab, 0xe7, 0xa7, 0x92, 0x29, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe6, 0xa0, 0x87, 0xe5,
+	0x87, 0x86, 0xe8, 0xbe, 0x93, 0xe5, 0x87, 0xba, 0x28, 0xe5, 0xb0, 0xbe, 0xe9, 0x83, 0xa8, 0xe6,
+	0x88, 0xaa, 0xe6, 0x96, 0xad, 0x29, 0x52, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x12, 0x37,
+	0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f,
+	0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe6, 0xa0, 0x87, 0xe5, 0x87, 0x86, 0xe9, 0x94, 0x99, 0xe8, 0xaf,
+	0xaf, 0x28, 0xe5, 0xb0, 0xbe, 0xe9, 0x83, 0xa8, 0xe6, 0x88, 0xaa, 0xe6, 0x96, 0xad, 0x29, 0x52,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7,
+	0xe7, 0xab, 0xaf, 0x49, 0x50, 0x52, 0x02, 0x69, 0x70, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x4e, 0x0a, 0x0b, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0xa7, 0xe8, 0xa1, 0x8c, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xb8, 0x8a, 0xe6, 0x8a, 0xa5, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb9,
+	0x01, 0x0a, 0x18, 0x48, 0x6f, 0x6f, 0x6b, 0x45, 0x78, 0x65, 0x63, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62,
+	0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
+	0xab, 0xaf, 0x55, 0x49, 0x44, 0x52, 0x03, 0x75, 0x69, 0x64, 0x42, 0x4d, 0x5a, 0x4b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x2d, 0x65, 0x78, 0x65, 0x63, 0x2d, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x3b, 0x70, 0x62, 0x68, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_hook_exec_result_proto_rawDescOnce sync.Once
+	file_hook_exec_result_proto_rawDescData = file_hook_exec_result_proto_rawDesc
+)
+
+func file_hook_exec_result_proto_rawDescGZIP() []byte {
+	file_hook_exec_result_proto_rawDescOnce.Do(func() {
+		file_hook_exec_result_proto_rawDescData = protoimpl.X.CompressGZIP(file_hook_exec_result_proto_rawDescData)
+	})
+	return file_hook_exec_result_proto_rawDescData
+}
+
+var file_hook_exec_result_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hook_exec_result_proto_goTypes = []any{
+	(*HookExecResult)(nil),           // 0: pbher.HookExecResult
+	(*HookExecResultSpec)(nil),       // 1: pbher.HookExecResultSpec
+	(*HookExecResultAttachment)(nil), // 2: pbher.HookExecResultAttachment
+	(*timestamppb.Timestamp)(nil),    // 3: google.protobuf.Timestamp
+}
+var file_hook_exec_result_proto_depIdxs = []int32{
+	1, // 0: pbher.HookExecResult.spec:type_name -> pbher.HookExecResultSpec
+	2, // 1: pbher.HookExecResult.attachment:type_name -> pbher.HookExecResultAttachment
+	3, // 2: pbher.HookExecResultSpec.executed_at:type_name -> google.protobuf.Timestamp
+	3, // 3: pbher.HookExecResultSpec.created_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_hook_exec_result_proto_init() }
+func file_hook_exec_result_proto_init() {
+	if File_hook_exec_result_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hook_exec_result_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hook_exec_result_proto_goTypes,
+		DependencyIndexes: file_hook_exec_result_proto_depIdxs,
+		MessageInfos:      file_hook_exec_result_proto_msgTypes,
+	}.Build()
+	File_hook_exec_result_proto = out.File
+	file_hook_exec_result_proto_rawDesc = nil
+	file_hook_exec_result_proto_goTypes = nil
+	file_hook_exec_result_proto_depIdxs = nil
+}
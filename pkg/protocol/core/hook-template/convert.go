@@ -0,0 +1,133 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbhtpl xxx
+package pbhtpl
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// HookTemplateSpec convert pb HookTemplateSpec to table HookTemplateSpec
+func (m *HookTemplateSpec) HookTemplateSpec() *table.HookTemplateSpec {
+	if m == nil {
+		return nil
+	}
+
+	params := make(table.HookTemplateParams, 0, len(m.Params))
+	for _, p := range m.Params {
+		params = append(params, &table.HookTemplateParam{
+			Name:     p.Name,
+			Default:  p.Default,
+			Required: p.Required,
+			Memo:     p.Memo,
+		})
+	}
+
+	return &table.HookTemplateSpec{
+		Name:    m.Name,
+		Type:    table.ScriptType(m.Type),
+		Content: m.Content,
+		Params:  params,
+		Memo:    m.Memo,
+		Version: m.Version,
+	}
+}
+
+// PbHookTemplateSpec convert table HookTemplateSpec to pb HookTemplateSpec
+func PbHookTemplateSpec(spec *table.HookTemplateSpec) *HookTemplateSpec {
+	if spec == nil {
+		return nil
+	}
+
+	params := make([]*HookTemplateParam, 0, len(spec.Params))
+	for _, p := range spec.Params {
+		params = append(params, &HookTemplateParam{
+			Name:     p.Name,
+			Default:  p.Default,
+			Required: p.Required,
+			Memo:     p.Memo,
+		})
+	}
+
+	return &HookTemplateSpec{
+		Name:    spec.Name,
+		Type:    string(spec.Type),
+		Content: spec.Content,
+		Params:  params,
+		Memo:    spec.Memo,
+		Version: spec.Version,
+	}
+}
+
+// HookTemplateAttachment convert pb HookTemplateAttachment to table HookTemplateAttachment
+func (m *HookTemplateAttachment) HookTemplateAttachment() *table.HookTemplateAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.HookTemplateAttachment{
+		BizID: m.BizId,
+	}
+}
+
+// PbHookTemplateAttachment convert table HookTemplateAttachment to pb HookTemplateAttachment
+func PbHookTemplateAttachment(at *table.HookTemplateAttachment) *HookTemplateAttachment {
+	if at == nil {
+		return nil
+	}
+
+	return &HookTemplateAttachment{
+		BizId: at.BizID,
+	}
+}
+
+// PbHookTemplate convert table HookTemplate to pb HookTemplate
+func PbHookTemplate(t *table.HookTemplate) *HookTemplate {
+	if t == nil {
+		return nil
+	}
+
+	return &HookTemplate{
+		Id:         t.ID,
+		Spec:       PbHookTemplateSpec(t.Spec),
+		Attachment: PbHookTemplateAttachment(t.Attachment),
+		Revision:   pbbase.PbRevision(t.Revision),
+		Builtin:    t.IsBuiltin(),
+	}
+}
+
+// PbHookTemplates convert table HookTemplate to pb HookTemplate
+func PbHookTemplates(ts []*table.HookTemplate) []*HookTemplate {
+	result := make([]*HookTemplate, 0, len(ts))
+	for _, t := range ts {
+		result = append(result, PbHookTemplate(t))
+	}
+
+	return result
+}
+
+// PbHookTemplateRef convert table HookTemplateRef to pb HookTemplateRef
+func PbHookTemplateRef(r *table.HookTemplateRef) *HookTemplateRef {
+	if r == nil || r.Spec == nil || r.Attachment == nil {
+		return nil
+	}
+
+	return &HookTemplateRef{
+		HookId:          r.Attachment.HookID,
+		TemplateId:      r.Attachment.TemplateID,
+		TemplateVersion: r.Spec.TemplateVersion,
+		Args:            r.Spec.Args,
+		AutoUpgrade:     r.Spec.AutoUpgrade,
+	}
+}
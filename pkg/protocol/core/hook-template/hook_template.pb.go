@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: hook_template.proto
+
+package pbhtpl
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// HookTemplate source resource reference: pkg/dal/table/hook_template.go
+type HookTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *HookTemplateSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *HookTemplateAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision          `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	Builtin    bool                    `protobuf:"varint,5,opt,name=builtin,proto3" json:"builtin,omitempty"`
+}
+
+func (x *HookTemplate) Reset() {
+	*x = HookTemplate{}
+	mi := &file_hook_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTemplate) ProtoMessage() {}
+
+func (x *HookTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTemplate.ProtoReflect.Descriptor instead.
+func (*HookTemplate) Descriptor() ([]byte, []int) {
+	return file_hook_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *HookTemplate) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HookTemplate) GetSpec() *HookTemplateSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *HookTemplate) GetAttachment() *HookTemplateAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *HookTemplate) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *HookTemplate) GetBuiltin() bool {
+	if x != nil {
+		return x.Builtin
+	}
+	return false
+}
+
+// HookTemplateSpec source resource reference: pkg/dal/table/hook_template.go
+type HookTemplateSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type    string               `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Content string               `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Params  []*HookTemplateParam `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	Memo    string               `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Version uint32               `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *HookTemplateSpec) Reset() {
+	*x = HookTemplateSpec{}
+	mi := &file_hook_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookTemplateSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTemplateSpec) ProtoMessage() {}
+
+func (x *HookTemplateSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTemplateSpec.ProtoReflect.Descriptor instead.
+func (*HookTemplateSpec) Descriptor() ([]byte, []int) {
+	return file_hook_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HookTemplateSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HookTemplateSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *HookTemplateSpec) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *HookTemplateSpec) GetParams() []*HookTemplateParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *HookTemplateSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *HookTemplateSpec) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// HookTemplateParam source resource reference: pkg/dal/table/hook_template.go
+type HookTemplateParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default  string `protobuf:"bytes,2,opt,name=default,proto3" json:"default,omitempty"`
+	Required bool   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Memo     string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *HookTemplateParam) Reset() {
+	*x = HookTemplateParam{}
+	mi := &file_hook_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookTemplateParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTemplateParam) ProtoMessage() {}
+
+func (x *HookTemplateParam) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTemplateParam.ProtoReflect.Descriptor instead.
+func (*HookTemplateParam) Descriptor() ([]byte, []int) {
+	return file_hook_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HookTemplateParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HookTemplateParam) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *HookTemplateParam) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *HookTemplateParam) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// HookTemplateAttachment source resource reference: pkg/dal/table/hook_template.go
+type HookTemplateAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+}
+
+func (x *HookTemplateAttachment) Reset() {
+	*x = HookTemplateAttachment{}
+	mi := &file_hook_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookTemplateAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTemplateAttachment) ProtoMessage() {}
+
+func (x *HookTemplateAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTemplateAttachment.ProtoReflect.Descriptor instead.
+func (*HookTemplateAttachment) Descriptor() ([]byte, []int) {
+	return file_hook_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HookTemplateAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+// HookTemplateRef source resource reference: pkg/dal/table/hook_template.go
+type HookTemplateRef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HookId          uint32            `protobuf:"varint,1,opt,name=hook_id,json=hookId,proto3" json:"hook_id,omitempty"`
+	TemplateId      uint32            `protobuf:"varint,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	TemplateVersion uint32            `protobuf:"varint,3,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	Args            map[string]string `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AutoUpgrade     bool              `protobuf:"varint,5,opt,name=auto_upgrade,json=autoUpgrade,proto3" json:"auto_upgrade,omitempty"`
+}
+
+func (x *HookTemplateRef) Reset() {
+	*x = HookTemplateRef{}
+	mi := &file_hook_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HookTemplateRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HookTemplateRef) ProtoMessage() {}
+
+func (x *HookTemplateRef) ProtoReflect() protoreflect.Message {
+	mi := &file_hook_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HookTemplateRef.ProtoReflect.Descriptor instead.
+func (*HookTemplateRef) Descriptor() ([]byte, []int) {
+	return file_hook_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HookTemplateRef) GetHookId() uint32 {
+	if x != nil {
+		return x.HookId
+	}
+	return 0
+}
+
+func (x *HookTemplateRef) GetTemplateId() uint32 {
+	if x != nil {
+		return x.TemplateId
+	}
+	return 0
+}
+
+func (x *HookTemplateRef) GetTemplateVersion() uint32 {
+	if x != nil {
+		return x.TemplateVersion
+	}
+	return 0
+}
+
+func (x *HookTemplateRef) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *HookTemplateRef) GetAutoUpgrade() bool {
+	if x != nil {
+		return x.AutoUpgrade
+	}
+	return false
+}
+
+var File_hook_template_proto protoreflect.FileDescriptor
+
+var file_hook_template_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x62, 0x68, 0x74, 0x70, 0x6c, 0x1a, 0x21, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x88, 0x02, 0x0a, 0x0c, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92,
+	0x41, 0x10, 0x32, 0x0e, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf,
+	0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x68, 0x74, 0x70, 0x6c, 0x2e, 0x48, 0x6f,
+	0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04,
+	0x73, 0x70, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x68, 0x74, 0x70,
+	0x6c, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6,
+	0xe5, 0xb9, 0xb3, 0xe5, 0x8f, 0xb0, 0xe5, 0x86, 0x85, 0xe7, 0xbd, 0xae, 0xe6, 0xa8, 0xa1, 0xe6,
+	0x9d, 0xbf, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x22, 0x8e, 0x03, 0x0a, 0x10,
+	0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x2b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17,
+	0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d,
+	0xbf, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x4b, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x37, 0x92, 0x41, 0x34,
+	0x32, 0x32, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc,
+	0x9a, 0x28, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0xe3, 0x80, 0x81, 0x70, 0x79, 0x74, 0x68, 0x6f, 0x6e,
+	0xe3, 0x80, 0x81, 0x62, 0x61, 0x74, 0xe3, 0x80, 0x81, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x68,
+	0x65, 0x6c, 0x6c, 0x29, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x54, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3a, 0x92, 0x41, 0x37,
+	0x32, 0x35, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe5, 0x86,
+	0x85, 0xe5, 0xae, 0xb9, 0xef, 0xbc, 0x8c, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0x20, 0x7b, 0x7b,
+	0x20, 0x2e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x7d, 0x7d, 0x20, 0xe5, 0xbc, 0x95, 0xe7, 0x94,
+	0xa8, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x4a, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x68, 0x74, 0x70, 0x6c, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x42, 0x17, 0x92, 0x41, 0x14,
+	0x32, 0x12, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe5, 0x8f,
+	0x82, 0xe6, 0x95, 0xb0, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
+	0x12, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe6, 0x8f, 0x8f,
+	0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x31, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32,
+	0x12, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x89, 0x88,
+	0xe6, 0x9c, 0xac, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x01, 0x0a,
+	0x11, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe5, 0x90, 0x8d,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe9, 0xbb,
+	0x98, 0xe8, 0xae, 0xa4, 0xe5, 0x80, 0xbc, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x2d, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5,
+	0xbf, 0x85, 0xe5, 0xa1, 0xab, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92,
+	0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x3e, 0x0a, 0x16, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52,
+	0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x22, 0xa5, 0x03, 0x0a, 0x0f, 0x48, 0x6f, 0x6f, 0x6b, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x66, 0x12, 0x26, 0x0a, 0x07, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0x49, 0x44, 0x52, 0x06, 0x68, 0x6f, 0x6f, 0x6b,
+	0x49, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0x84,
+	0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x49, 0x44, 0x52, 0x0a, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x26, 0x92, 0x41, 0x23, 0x32, 0x21, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe4,
+	0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8,
+	0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x0f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x04, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x68, 0x74,
+	0x70, 0x6c, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x66, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe5,
+	0x8f, 0x82, 0xe6, 0x95, 0xb0, 0xe5, 0x80, 0xbc, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x55,
+	0x0a, 0x0c, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe8, 0x84, 0x9a, 0xe6, 0x9c,
+	0xac, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe6, 0x97, 0xb6,
+	0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0x8d, 0x87, 0xe7,
+	0xba, 0xa7, 0xe8, 0x84, 0x9a, 0xe6, 0x9c, 0xac, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x1a, 0x37, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x4b,
+	0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e,
+	0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d,
+	0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x68, 0x6f, 0x6f, 0x6b, 0x2d, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x3b, 0x70, 0x62, 0x68, 0x74, 0x70, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hook_template_proto_rawDescOnce sync.Once
+	file_hook_template_proto_rawDescData = file_hook_template_proto_rawDesc
+)
+
+func file_hook_template_proto_rawDescGZIP() []byte {
+	file_hook_template_proto_rawDescOnce.Do(func() {
+		file_hook_template_proto_rawDescData = protoimpl.X.CompressGZIP(file_hook_template_proto_rawDescData)
+	})
+	return file_hook_template_proto_rawDescData
+}
+
+var file_hook_template_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_hook_template_proto_goTypes = []any{
+	(*HookTemplate)(nil),           // 0: pbhtpl.HookTemplate
+	(*HookTemplateSpec)(nil),       // 1: pbhtpl.HookTemplateSpec
+	(*HookTemplateParam)(nil),      // 2: pbhtpl.HookTemplateParam
+	(*HookTemplateAttachment)(nil), // 3: pbhtpl.HookTemplateAttachment
+	(*HookTemplateRef)(nil),        // 4: pbhtpl.HookTemplateRef
+	nil,                            // 5: pbhtpl.HookTemplateRef.ArgsEntry
+	(*base.Revision)(nil),          // 6: pbbase.Revision
+}
+var file_hook_template_proto_depIdxs = []int32{
+	1, // 0: pbhtpl.HookTemplate.spec:type_name -> pbhtpl.HookTemplateSpec
+	3, // 1: pbhtpl.HookTemplate.attachment:type_name -> pbhtpl.HookTemplateAttachment
+	6, // 2: pbhtpl.HookTemplate.revision:type_name -> pbbase.Revision
+	2, // 3: pbhtpl.HookTemplateSpec.params:type_name -> pbhtpl.HookTemplateParam
+	5, // 4: pbhtpl.HookTemplateRef.args:type_name -> pbhtpl.HookTemplateRef.ArgsEntry
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_hook_template_proto_init() }
+func file_hook_template_proto_init() {
+	if File_hook_template_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hook_template_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_hook_template_proto_goTypes,
+		DependencyIndexes: file_hook_template_proto_depIdxs,
+		MessageInfos:      file_hook_template_proto_msgTypes,
+	}.Build()
+	File_hook_template_proto = out.File
+	file_hook_template_proto_rawDesc = nil
+	file_hook_template_proto_goTypes = nil
+	file_hook_template_proto_depIdxs = nil
+}
@@ -53,6 +53,14 @@ func (k *KvSpec) KvSpec() *table.KvSpec {
 			}
 			return nil
 		}(),
+		ExpiredAt: func() *time.Time {
+			parsedTime, _ := time.Parse(time.RFC3339, k.GetExpiredAt())
+			if !parsedTime.IsZero() {
+				return &parsedTime
+			}
+			return nil
+		}(),
+		ExpiredFallback: k.ExpiredFallback,
 	}
 }
 
@@ -104,6 +112,13 @@ func PbKvSpec(spec *table.KvSpec, value string) *KvSpec {
 			}
 			return ""
 		}(),
+		ExpiredAt: func() string {
+			if spec.ExpiredAt != nil {
+				return spec.ExpiredAt.Format(time.RFC3339)
+			}
+			return ""
+		}(),
+		ExpiredFallback: spec.ExpiredFallback,
 	}
 }
 
@@ -137,3 +152,27 @@ func PbKvs(kvs []*table.ReleasedKv) []*Kv {
 
 	return kvRevisions
 }
+
+// PbKvOverride convert table KvOverride to pb KvOverride
+func PbKvOverride(k *table.KvOverride, value string) *KvOverride {
+	if k == nil {
+		return nil
+	}
+
+	return &KvOverride{
+		Id: k.ID,
+		Spec: &KvOverrideSpec{
+			Key:      k.Spec.Key,
+			GroupId:  k.Spec.GroupID,
+			Priority: k.Spec.Priority,
+			Value:    value,
+			Memo:     k.Spec.Memo,
+		},
+		Attachment: &KvAttachment{
+			BizId: k.Attachment.BizID,
+			AppId: k.Attachment.AppID,
+		},
+		Revision:    pbbase.PbRevision(k.Revision),
+		ContentSpec: pbcontent.PbContentSpec(k.ContentSpec),
+	}
+}
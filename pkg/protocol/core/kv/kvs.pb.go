@@ -115,13 +115,15 @@ type KvSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key                       string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	KvType                    string `protobuf:"bytes,2,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
-	Value                     string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	Memo                      string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
-	SecretType                string `protobuf:"bytes,5,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
-	SecretHidden              bool   `protobuf:"varint,6,opt,name=secret_hidden,json=secretHidden,proto3" json:"secret_hidden,omitempty"`
-	CertificateExpirationDate string `protobuf:"bytes,7,opt,name=certificate_expiration_date,json=certificateExpirationDate,proto3" json:"certificate_expiration_date,omitempty"`
+	Key                       string  `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KvType                    string  `protobuf:"bytes,2,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
+	Value                     string  `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Memo                      string  `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	SecretType                string  `protobuf:"bytes,5,opt,name=secret_type,json=secretType,proto3" json:"secret_type,omitempty"`
+	SecretHidden              bool    `protobuf:"varint,6,opt,name=secret_hidden,json=secretHidden,proto3" json:"secret_hidden,omitempty"`
+	CertificateExpirationDate string  `protobuf:"bytes,7,opt,name=certificate_expiration_date,json=certificateExpirationDate,proto3" json:"certificate_expiration_date,omitempty"`
+	ExpiredAt                 string  `protobuf:"bytes,8,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+	ExpiredFallback           *string `protobuf:"bytes,10,opt,name=expired_fallback,json=expiredFallback,proto3,oneof" json:"expired_fallback,omitempty"`
 }
 
 func (x *KvSpec) Reset() {
@@ -203,6 +205,20 @@ func (x *KvSpec) GetCertificateExpirationDate() string {
 	return ""
 }
 
+func (x *KvSpec) GetExpiredAt() string {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return ""
+}
+
+func (x *KvSpec) GetExpiredFallback() string {
+	if x != nil && x.ExpiredFallback != nil {
+		return *x.ExpiredFallback
+	}
+	return ""
+}
+
 // KvAttachment source resource reference: pkg/dal/table/kvs.go
 type KvAttachment struct {
 	state         protoimpl.MessageState
@@ -257,6 +273,162 @@ func (x *KvAttachment) GetAppId() uint32 {
 	return 0
 }
 
+// KvOverride source resource reference: pkg/dal/table/kv_override.go
+type KvOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec        *KvOverrideSpec      `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment  *KvAttachment        `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision    *base.Revision       `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	ContentSpec *content.ContentSpec `protobuf:"bytes,5,opt,name=content_spec,json=contentSpec,proto3" json:"content_spec,omitempty"`
+}
+
+func (x *KvOverride) Reset() {
+	*x = KvOverride{}
+	mi := &file_kvs_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvOverride) ProtoMessage() {}
+
+func (x *KvOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_kvs_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvOverride.ProtoReflect.Descriptor instead.
+func (*KvOverride) Descriptor() ([]byte, []int) {
+	return file_kvs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KvOverride) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KvOverride) GetSpec() *KvOverrideSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *KvOverride) GetAttachment() *KvAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *KvOverride) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+func (x *KvOverride) GetContentSpec() *content.ContentSpec {
+	if x != nil {
+		return x.ContentSpec
+	}
+	return nil
+}
+
+// KvOverrideSpec source resource reference: pkg/dal/table/kv_override.go
+type KvOverrideSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	GroupId  uint32 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	Priority uint32 `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Value    string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Memo     string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *KvOverrideSpec) Reset() {
+	*x = KvOverrideSpec{}
+	mi := &file_kvs_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvOverrideSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvOverrideSpec) ProtoMessage() {}
+
+func (x *KvOverrideSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_kvs_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvOverrideSpec.ProtoReflect.Descriptor instead.
+func (*KvOverrideSpec) Descriptor() ([]byte, []int) {
+	return file_kvs_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *KvOverrideSpec) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KvOverrideSpec) GetGroupId() uint32 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *KvOverrideSpec) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *KvOverrideSpec) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *KvOverrideSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
 var File_kvs_proto protoreflect.FileDescriptor
 
 var file_kvs_proto_rawDesc = []byte{
@@ -289,7 +461,7 @@ var file_kvs_proto_rawDesc = []byte{
 	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x43,
 	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0xfc, 0x03, 0x0a, 0x06, 0x4b, 0x76, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0x80, 0x07, 0x0a, 0x06, 0x4b, 0x76, 0x53, 0x70,
 	0x65, 0x63, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
 	0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5,
 	0x90, 0x8d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x68, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79,
@@ -321,17 +493,79 @@ var file_kvs_proto_rawDesc = []byte{
 	0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe8, 0xaf, 0x81, 0xe4, 0xb9, 0xa6, 0xe8,
 	0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x19, 0x63, 0x65, 0x72,
 	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x4b, 0x76, 0x41, 0x74, 0x74, 0x61,
-	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a,
-	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06,
-	0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41,
-	0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70,
-	0x49, 0x64, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67,
-	0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6b, 0x76, 0x3b, 0x70, 0x62,
-	0x6b, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x9c, 0x01, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x7d, 0x92, 0x41, 0x7a,
+	0x32, 0x78, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc,
+	0x8c, 0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0xe6, 0xa0, 0xbc, 0xe5, 0xbc, 0x8f, 0xef, 0xbc,
+	0x8c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0xe4, 0xb8, 0x8d, 0xe5, 0x86, 0x8d, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f,
+	0x91, 0xe8, 0xaf, 0xa5, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe6, 0x88, 0x96,
+	0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe5, 0x85, 0x9c, 0xe5, 0xba, 0x95, 0xe5, 0x80, 0xbc, 0xef,
+	0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0xb0,
+	0xb8, 0xe4, 0xb8, 0x8d, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0xc7, 0x01, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x96, 0x01, 0x92, 0x41, 0x92, 0x01, 0x32, 0x8f, 0x01, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f,
+	0xe5, 0x90, 0x8e, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0x9c, 0xe5,
+	0xba, 0x95, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe8, 0xae, 0xbe, 0xe7, 0xbd,
+	0xae, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e,
+	0xe4, 0xb8, 0x8d, 0xe5, 0x86, 0x8d, 0xe4, 0xb8, 0x8b, 0xe5, 0x8f, 0x91, 0xef, 0xbc, 0x8c, 0xe7,
+	0xa9, 0xba, 0xe5, 0xad, 0x97, 0xe7, 0xac, 0xa6, 0xe4, 0xb8, 0xb2, 0xe4, 0xb9, 0x9f, 0xe6, 0x98,
+	0xaf, 0xe6, 0x9c, 0x89, 0xe6, 0x95, 0x88, 0xe7, 0x9a, 0x84, 0xe5, 0x85, 0x9c, 0xe5, 0xba, 0x95,
+	0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d, 0xe6, 0x94, 0xaf, 0xe6, 0x8c, 0x81, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0xe5, 0x92, 0x8c, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x22, 0x5a, 0x0a, 0x0c, 0x4b, 0x76,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69,
+	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32,
+	0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22, 0xf5, 0x01, 0x0a, 0x0a, 0x4b, 0x76, 0x4f, 0x76, 0x65,
+	0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x10, 0x92, 0x41, 0x0d, 0x32, 0x0b, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0xe5, 0x80,
+	0xbc, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x6b, 0x76, 0x2e, 0x4b, 0x76, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x12, 0x32, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x6b, 0x76, 0x2e, 0x4b, 0x76, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0xe2,
+	0x02, 0x0a, 0x0e, 0x4b, 0x76, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x12, 0x23, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0xe5, 0x90,
+	0x8d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x7c, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x61, 0x92, 0x41, 0x5e, 0x32, 0x5c, 0xe5,
+	0x88, 0x86, 0xe7, 0xbb, 0x84, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d,
+	0xe8, 0xaf, 0xa5, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84, 0xe7, 0x9a, 0x84, 0xe5, 0xae, 0xa2, 0xe6,
+	0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe5, 0x88, 0xb0, 0xe8, 0xa6,
+	0x86, 0xe7, 0x9b, 0x96, 0xe5, 0x80, 0xbc, 0xef, 0xbc, 0x8c, 0xe5, 0x85, 0xb6, 0xe4, 0xbd, 0x99,
+	0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7, 0xab, 0xaf, 0xe6, 0x8b, 0x89, 0xe5, 0x8f, 0x96, 0xe5,
+	0x88, 0xb0, 0xe5, 0x9f, 0xba, 0xe7, 0xa1, 0x80, 0xe5, 0x80, 0xbc, 0x52, 0x07, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x49, 0x64, 0x12, 0x66, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x4a, 0x92, 0x41, 0x47, 0x32, 0x45, 0xe4, 0xbc, 0x98,
+	0xe5, 0x85, 0x88, 0xe7, 0xba, 0xa7, 0xef, 0xbc, 0x8c, 0xe5, 0xae, 0xa2, 0xe6, 0x88, 0xb7, 0xe7,
+	0xab, 0xaf, 0xe5, 0x8c, 0xb9, 0xe9, 0x85, 0x8d, 0xe5, 0xa4, 0x9a, 0xe4, 0xb8, 0xaa, 0xe5, 0x88,
+	0x86, 0xe7, 0xbb, 0x84, 0xe6, 0x97, 0xb6, 0xef, 0xbc, 0x8c, 0xe5, 0x80, 0xbc, 0xe5, 0xb0, 0x8f,
+	0xe7, 0x9a, 0x84, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0xe5, 0x80, 0xbc, 0xe7, 0x94, 0x9f, 0xe6,
+	0x95, 0x88, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x24, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b,
+	0x32, 0x09, 0xe8, 0xa6, 0x86, 0xe7, 0x9b, 0x96, 0xe5, 0x80, 0xbc, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0x92, 0x41, 0x08, 0x32, 0x06, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e,
+	0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x6b, 0x76, 0x3b, 0x70,
+	0x62, 0x6b, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -346,24 +580,30 @@ func file_kvs_proto_rawDescGZIP() []byte {
 	return file_kvs_proto_rawDescData
 }
 
-var file_kvs_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_kvs_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_kvs_proto_goTypes = []any{
 	(*Kv)(nil),                  // 0: pbkv.Kv
 	(*KvSpec)(nil),              // 1: pbkv.KvSpec
 	(*KvAttachment)(nil),        // 2: pbkv.KvAttachment
-	(*base.Revision)(nil),       // 3: pbbase.Revision
-	(*content.ContentSpec)(nil), // 4: pbcontent.ContentSpec
+	(*KvOverride)(nil),          // 3: pbkv.KvOverride
+	(*KvOverrideSpec)(nil),      // 4: pbkv.KvOverrideSpec
+	(*base.Revision)(nil),       // 5: pbbase.Revision
+	(*content.ContentSpec)(nil), // 6: pbcontent.ContentSpec
 }
 var file_kvs_proto_depIdxs = []int32{
 	1, // 0: pbkv.Kv.spec:type_name -> pbkv.KvSpec
 	2, // 1: pbkv.Kv.attachment:type_name -> pbkv.KvAttachment
-	3, // 2: pbkv.Kv.revision:type_name -> pbbase.Revision
-	4, // 3: pbkv.Kv.content_spec:type_name -> pbcontent.ContentSpec
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	5, // 2: pbkv.Kv.revision:type_name -> pbbase.Revision
+	6, // 3: pbkv.Kv.content_spec:type_name -> pbcontent.ContentSpec
+	4, // 4: pbkv.KvOverride.spec:type_name -> pbkv.KvOverrideSpec
+	2, // 5: pbkv.KvOverride.attachment:type_name -> pbkv.KvAttachment
+	5, // 6: pbkv.KvOverride.revision:type_name -> pbbase.Revision
+	6, // 7: pbkv.KvOverride.content_spec:type_name -> pbcontent.ContentSpec
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_kvs_proto_init() }
@@ -371,13 +611,14 @@ func file_kvs_proto_init() {
 	if File_kvs_proto != nil {
 		return
 	}
+	file_kvs_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kvs_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbmp xxx
+package pbmp
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// MaskingProfileSpec convert pb MaskingProfileSpec to table MaskingProfileSpec
+func (m *MaskingProfileSpec) MaskingProfileSpec() *table.MaskingProfileSpec {
+	if m == nil {
+		return nil
+	}
+
+	rules := make(table.MaskingRules, 0, len(m.Rules))
+	for _, r := range m.Rules {
+		rules = append(rules, &table.MaskingRule{
+			Name:        r.Name,
+			Type:        r.Type,
+			Pattern:     r.Pattern,
+			Replacement: r.Replacement,
+		})
+	}
+
+	return &table.MaskingProfileSpec{
+		Enabled: m.Enabled,
+		Rules:   rules,
+	}
+}
+
+// PbMaskingProfileSpec convert table MaskingProfileSpec to pb MaskingProfileSpec
+func PbMaskingProfileSpec(spec *table.MaskingProfileSpec) *MaskingProfileSpec {
+	if spec == nil {
+		return nil
+	}
+
+	rules := make([]*MaskingRule, 0, len(spec.Rules))
+	for _, r := range spec.Rules {
+		rules = append(rules, &MaskingRule{
+			Name:        r.Name,
+			Type:        r.Type,
+			Pattern:     r.Pattern,
+			Replacement: r.Replacement,
+		})
+	}
+
+	return &MaskingProfileSpec{
+		Enabled: spec.Enabled,
+		Rules:   rules,
+	}
+}
+
+// PbMaskingProfile convert table MaskingProfile to pb MaskingProfile
+func PbMaskingProfile(m *table.MaskingProfile) *MaskingProfile {
+	if m == nil {
+		return nil
+	}
+
+	var at *MaskingProfileAttachment
+	if m.Attachment != nil {
+		at = &MaskingProfileAttachment{BizId: m.Attachment.BizID, AppId: m.Attachment.AppID}
+	}
+
+	return &MaskingProfile{
+		Id:         m.ID,
+		Spec:       PbMaskingProfileSpec(m.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(m.Revision),
+	}
+}
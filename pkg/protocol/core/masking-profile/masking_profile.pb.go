@@ -0,0 +1,398 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: masking_profile.proto
+
+package pbmp
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// MaskingProfile source resource reference: pkg/dal/table/masking_profile.go
+type MaskingProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *MaskingProfileSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *MaskingProfileAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision            `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *MaskingProfile) Reset() {
+	*x = MaskingProfile{}
+	mi := &file_masking_profile_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaskingProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingProfile) ProtoMessage() {}
+
+func (x *MaskingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_masking_profile_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingProfile.ProtoReflect.Descriptor instead.
+func (*MaskingProfile) Descriptor() ([]byte, []int) {
+	return file_masking_profile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MaskingProfile) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MaskingProfile) GetSpec() *MaskingProfileSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *MaskingProfile) GetAttachment() *MaskingProfileAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *MaskingProfile) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// MaskingProfileSpec source resource reference: pkg/dal/table/masking_profile.go
+type MaskingProfileSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool           `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Rules   []*MaskingRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *MaskingProfileSpec) Reset() {
+	*x = MaskingProfileSpec{}
+	mi := &file_masking_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaskingProfileSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingProfileSpec) ProtoMessage() {}
+
+func (x *MaskingProfileSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_masking_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingProfileSpec.ProtoReflect.Descriptor instead.
+func (*MaskingProfileSpec) Descriptor() ([]byte, []int) {
+	return file_masking_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MaskingProfileSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MaskingProfileSpec) GetRules() []*MaskingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// MaskingRule source resource reference: pkg/dal/table/masking_profile.go
+type MaskingRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Pattern     string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	Replacement string `protobuf:"bytes,4,opt,name=replacement,proto3" json:"replacement,omitempty"`
+}
+
+func (x *MaskingRule) Reset() {
+	*x = MaskingRule{}
+	mi := &file_masking_profile_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaskingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingRule) ProtoMessage() {}
+
+func (x *MaskingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_masking_profile_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingRule.ProtoReflect.Descriptor instead.
+func (*MaskingRule) Descriptor() ([]byte, []int) {
+	return file_masking_profile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MaskingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MaskingRule) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MaskingRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *MaskingRule) GetReplacement() string {
+	if x != nil {
+		return x.Replacement
+	}
+	return ""
+}
+
+// MaskingProfileAttachment source resource reference: pkg/dal/table/masking_profile.go
+type MaskingProfileAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *MaskingProfileAttachment) Reset() {
+	*x = MaskingProfileAttachment{}
+	mi := &file_masking_profile_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaskingProfileAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaskingProfileAttachment) ProtoMessage() {}
+
+func (x *MaskingProfileAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_masking_profile_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaskingProfileAttachment.ProtoReflect.Descriptor instead.
+func (*MaskingProfileAttachment) Descriptor() ([]byte, []int) {
+	return file_masking_profile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MaskingProfileAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *MaskingProfileAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_masking_profile_proto protoreflect.FileDescriptor
+
+var file_masking_profile_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x6d, 0x70, 0x1a, 0x21, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
+	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xd1, 0x01, 0x0a, 0x0e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x13, 0x92, 0x41, 0x10, 0x32, 0x0e, 0xe8, 0x84, 0xb1, 0xe6, 0x95, 0x8f, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x61,
+	0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3e, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x62, 0x6d,
+	0x70, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41,
+	0x25, 0x32, 0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef,
+	0xbc, 0x9a, 0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6,
+	0x3d, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x48, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x70, 0x62, 0x6d, 0x70, 0x2e, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c,
+	0x65, 0x42, 0x1f, 0x92, 0x41, 0x1c, 0x32, 0x1a, 0xe8, 0x84, 0xb1, 0xe6, 0x95, 0x8f, 0xe8, 0xa7,
+	0x84, 0xe5, 0x88, 0x99, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0x80, 0xe5, 0xa4, 0x9a, 0x35, 0x30, 0xe6,
+	0x9d, 0xa1, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xe6, 0x02, 0x0a, 0x0b, 0x4d, 0x61,
+	0x73, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe8, 0xa7,
+	0x84, 0xe5, 0x88, 0x99, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0x8d,
+	0xe5, 0x8f, 0xaf, 0xe9, 0x87, 0x8d, 0xe5, 0xa4, 0x8d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x69, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x55, 0x92,
+	0x41, 0x52, 0x32, 0x50, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b,
+	0xef, 0xbc, 0x9a, 0x72, 0x65, 0x67, 0x65, 0x78, 0x3d, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe8,
+	0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc, 0x8f, 0xef, 0xbc, 0x8c, 0x6a, 0x73, 0x6f, 0x6e, 0x70,
+	0x61, 0x74, 0x68, 0x3d, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x74, 0x68, 0x28, 0xe4, 0xbb, 0x85,
+	0xe5, 0xaf, 0xb9, 0x4a, 0x53, 0x4f, 0x4e, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9, 0xe7, 0x94, 0x9f,
+	0xe6, 0x95, 0x88, 0x29, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x6c, 0x0a, 0x07, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52, 0x92, 0x41, 0x4f,
+	0x32, 0x4d, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe, 0xbe, 0xe5, 0xbc,
+	0x8f, 0xe6, 0x88, 0x96, 0x4a, 0x53, 0x4f, 0x4e, 0x50, 0x61, 0x74, 0x68, 0xef, 0xbc, 0x8c, 0xe6,
+	0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe6, 0x9c, 0x89, 0xe6, 0x8d, 0x95, 0xe8, 0x8e, 0xb7, 0xe7, 0xbb,
+	0x84, 0xe6, 0x97, 0xb6, 0xe5, 0x8f, 0xaa, 0xe6, 0x9b, 0xbf, 0xe6, 0x8d, 0xa2, 0xe7, 0xac, 0xac,
+	0xe4, 0xb8, 0x80, 0xe4, 0xb8, 0xaa, 0xe6, 0x8d, 0x95, 0xe8, 0x8e, 0xb7, 0xe7, 0xbb, 0x84, 0x52,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x48, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0x92,
+	0x41, 0x23, 0x32, 0x21, 0xe6, 0x9b, 0xbf, 0xe6, 0x8d, 0xa2, 0xe5, 0x86, 0x85, 0xe5, 0xae, 0xb9,
+	0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x2a,
+	0x2a, 0x2a, 0x2a, 0x2a, 0x2a, 0x52, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0x66, 0x0a, 0x18, 0x4d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d,
+	0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62,
+	0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a,
+	0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x6d, 0x61, 0x73, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x3b, 0x70, 0x62, 0x6d, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_masking_profile_proto_rawDescOnce sync.Once
+	file_masking_profile_proto_rawDescData = file_masking_profile_proto_rawDesc
+)
+
+func file_masking_profile_proto_rawDescGZIP() []byte {
+	file_masking_profile_proto_rawDescOnce.Do(func() {
+		file_masking_profile_proto_rawDescData = protoimpl.X.CompressGZIP(file_masking_profile_proto_rawDescData)
+	})
+	return file_masking_profile_proto_rawDescData
+}
+
+var file_masking_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_masking_profile_proto_goTypes = []any{
+	(*MaskingProfile)(nil),           // 0: pbmp.MaskingProfile
+	(*MaskingProfileSpec)(nil),       // 1: pbmp.MaskingProfileSpec
+	(*MaskingRule)(nil),              // 2: pbmp.MaskingRule
+	(*MaskingProfileAttachment)(nil), // 3: pbmp.MaskingProfileAttachment
+	(*base.Revision)(nil),            // 4: pbbase.Revision
+}
+var file_masking_profile_proto_depIdxs = []int32{
+	1, // 0: pbmp.MaskingProfile.spec:type_name -> pbmp.MaskingProfileSpec
+	3, // 1: pbmp.MaskingProfile.attachment:type_name -> pbmp.MaskingProfileAttachment
+	4, // 2: pbmp.MaskingProfile.revision:type_name -> pbbase.Revision
+	2, // 3: pbmp.MaskingProfileSpec.rules:type_name -> pbmp.MaskingRule
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_masking_profile_proto_init() }
+func file_masking_profile_proto_init() {
+	if File_masking_profile_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_masking_profile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_masking_profile_proto_goTypes,
+		DependencyIndexes: file_masking_profile_proto_depIdxs,
+		MessageInfos:      file_masking_profile_proto_msgTypes,
+	}.Build()
+	File_masking_profile_proto = out.File
+	file_masking_profile_proto_rawDesc = nil
+	file_masking_profile_proto_goTypes = nil
+	file_masking_profile_proto_depIdxs = nil
+}
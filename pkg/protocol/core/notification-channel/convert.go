@@ -0,0 +1,104 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbnc xxx
+package pbnc
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// NotificationChannelSpec convert pb NotificationChannelSpec to table NotificationChannelSpec
+func (m *NotificationChannelSpec) NotificationChannelSpec() *table.NotificationChannelSpec {
+	if m == nil {
+		return nil
+	}
+
+	events := make(table.NotificationEvents, 0, len(m.Events))
+	for _, e := range m.Events {
+		events = append(events, table.NotificationEvent(e))
+	}
+
+	return &table.NotificationChannelSpec{
+		Name:     m.Name,
+		Type:     table.NotificationChannelType(m.Type),
+		Target:   m.Target,
+		Events:   events,
+		Template: m.Template,
+		Enabled:  m.Enabled,
+		Memo:     m.Memo,
+	}
+}
+
+// PbNotificationChannelSpec convert table NotificationChannelSpec to pb NotificationChannelSpec
+func PbNotificationChannelSpec(spec *table.NotificationChannelSpec) *NotificationChannelSpec {
+	if spec == nil {
+		return nil
+	}
+
+	events := make([]string, 0, len(spec.Events))
+	for _, e := range spec.Events {
+		events = append(events, string(e))
+	}
+
+	return &NotificationChannelSpec{
+		Name:     spec.Name,
+		Type:     string(spec.Type),
+		Target:   spec.Target,
+		Events:   events,
+		Template: spec.Template,
+		Enabled:  spec.Enabled,
+		Memo:     spec.Memo,
+	}
+}
+
+// NotificationChannelAttachment convert pb NotificationChannelAttachment to table NotificationChannelAttachment
+func (m *NotificationChannelAttachment) NotificationChannelAttachment() *table.NotificationChannelAttachment {
+	if m == nil {
+		return nil
+	}
+
+	return &table.NotificationChannelAttachment{
+		BizID: m.BizId,
+		AppID: m.AppId,
+	}
+}
+
+// PbNotificationChannel convert table NotificationChannel to pb NotificationChannel
+func PbNotificationChannel(c *table.NotificationChannel) *NotificationChannel {
+	if c == nil {
+		return nil
+	}
+
+	var at *NotificationChannelAttachment
+	if c.Attachment != nil {
+		at = &NotificationChannelAttachment{BizId: c.Attachment.BizID, AppId: c.Attachment.AppID}
+	}
+
+	return &NotificationChannel{
+		Id:         c.ID,
+		Spec:       PbNotificationChannelSpec(c.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(c.Revision),
+	}
+}
+
+// PbNotificationChannels convert table NotificationChannel list to pb NotificationChannel list
+func PbNotificationChannels(list []*table.NotificationChannel) []*NotificationChannel {
+	result := make([]*NotificationChannel, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbNotificationChannel(one))
+	}
+
+	return result
+}
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: notification_channel.proto
+
+package pbnc
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// NotificationChannel source resource reference: pkg/dal/table/notification_channel.go
+type NotificationChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *NotificationChannelSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *NotificationChannelAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.Revision                 `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *NotificationChannel) Reset() {
+	*x = NotificationChannel{}
+	mi := &file_notification_channel_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannel) ProtoMessage() {}
+
+func (x *NotificationChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_channel_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannel.ProtoReflect.Descriptor instead.
+func (*NotificationChannel) Descriptor() ([]byte, []int) {
+	return file_notification_channel_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationChannel) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *NotificationChannel) GetSpec() *NotificationChannelSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *NotificationChannel) GetAttachment() *NotificationChannelAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *NotificationChannel) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// NotificationChannelSpec source resource reference: pkg/dal/table/notification_channel.go
+type NotificationChannelSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type     string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Target   string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Events   []string `protobuf:"bytes,4,rep,name=events,proto3" json:"events,omitempty"`
+	Template string   `protobuf:"bytes,5,opt,name=template,proto3" json:"template,omitempty"`
+	Enabled  bool     `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Memo     string   `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *NotificationChannelSpec) Reset() {
+	*x = NotificationChannelSpec{}
+	mi := &file_notification_channel_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannelSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannelSpec) ProtoMessage() {}
+
+func (x *NotificationChannelSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_channel_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannelSpec.ProtoReflect.Descriptor instead.
+func (*NotificationChannelSpec) Descriptor() ([]byte, []int) {
+	return file_notification_channel_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationChannelSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationChannelSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NotificationChannelSpec) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *NotificationChannelSpec) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *NotificationChannelSpec) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *NotificationChannelSpec) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationChannelSpec) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+// NotificationChannelAttachment source resource reference: pkg/dal/table/notification_channel.go
+type NotificationChannelAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *NotificationChannelAttachment) Reset() {
+	*x = NotificationChannelAttachment{}
+	mi := &file_notification_channel_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationChannelAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationChannelAttachment) ProtoMessage() {}
+
+func (x *NotificationChannelAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_channel_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationChannelAttachment.ProtoReflect.Descriptor instead.
+func (*NotificationChannelAttachment) Descriptor() ([]byte, []int) {
+	return file_notification_channel_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NotificationChannelAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *NotificationChannelAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_notification_channel_proto protoreflect.FileDescriptor
+
+var file_notification_channel_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62,
+	0x6e, 0x63, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe0, 0x01, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x23, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0x92, 0x41, 0x10, 0x32, 0x0e,
+	0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x31, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x70, 0x62, 0x6e, 0x63, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x43, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x6e, 0x63,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xe9, 0x04, 0x0a, 0x17, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x70, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0xe5,
+	0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x6b, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x57, 0x92, 0x41, 0x54, 0x32, 0x52,
+	0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x77,
+	0x65, 0x63, 0x6f, 0x6d, 0x3d, 0xe4, 0xbc, 0x81, 0xe4, 0xb8, 0x9a, 0xe5, 0xbe, 0xae, 0xe4, 0xbf,
+	0xa1, 0xe7, 0xbe, 0xa4, 0xe6, 0x9c, 0xba, 0xe5, 0x99, 0xa8, 0xe4, 0xba, 0xba, 0xef, 0xbc, 0x8c,
+	0x73, 0x6c, 0x61, 0x63, 0x6b, 0x3d, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x20, 0x77, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0xef, 0xbc, 0x8c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x3d, 0xe9, 0x82, 0xae, 0xe4,
+	0xbb, 0xb6, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x69, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x51, 0x92, 0x41, 0x4e, 0x32, 0x4c, 0xe4,
+	0xbc, 0x81, 0xe4, 0xb8, 0x9a, 0xe5, 0xbe, 0xae, 0xe4, 0xbf, 0xa1, 0xe5, 0x92, 0x8c, 0x20, 0x53,
+	0x6c, 0x61, 0x63, 0x6b, 0x20, 0xe4, 0xb8, 0xba, 0x20, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x20, 0xe5, 0x9c, 0xb0, 0xe5, 0x9d, 0x80, 0xef, 0xbc, 0x8c, 0xe9, 0x82, 0xae, 0xe4, 0xbb, 0xb6,
+	0xe4, 0xb8, 0xba, 0xe9, 0x80, 0x97, 0xe5, 0x8f, 0xb7, 0xe5, 0x88, 0x86, 0xe9, 0x9a, 0x94, 0xe7,
+	0x9a, 0x84, 0xe6, 0x94, 0xb6, 0xe4, 0xbb, 0xb6, 0xe4, 0xba, 0xba, 0x52, 0x06, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x78, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x60, 0x92, 0x41, 0x5d, 0x32, 0x5b, 0xe8, 0xae, 0xa2, 0xe9, 0x98, 0x85,
+	0xe7, 0x9a, 0x84, 0xe4, 0xba, 0x8b, 0xe4, 0xbb, 0xb6, 0xef, 0xbc, 0x9a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0xe3, 0x80, 0x81, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0xe3,
+	0x80, 0x81, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0xe3, 0x80, 0x81, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6a, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4e, 0x92, 0x41, 0x4b, 0x32, 0x49, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9, 0x89, 0xe6,
+	0xb6, 0x88, 0xe6, 0x81, 0xaf, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0xef, 0xbc, 0x88, 0x47, 0x6f,
+	0x20, 0x74, 0x65, 0x78, 0x74, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0xef, 0xbc,
+	0x89, 0xef, 0xbc, 0x8c, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0xe6, 0x97, 0xb6, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe5, 0x86, 0x85, 0xe7, 0xbd, 0xae, 0xe6, 0xa8, 0xa1, 0xe7, 0x89, 0x88, 0x52,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x28, 0x92, 0x41, 0x25, 0x32,
+	0x23, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0x90, 0xaf, 0xe7, 0x94, 0xa8, 0xef, 0xbc, 0x9a,
+	0xe6, 0x98, 0xaf, 0x3d, 0x74, 0x72, 0x75, 0x65, 0xef, 0xbc, 0x8c, 0xe5, 0x90, 0xa6, 0x3d, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e,
+	0x32, 0x0c, 0xe6, 0xb8, 0xa0, 0xe9, 0x81, 0x93, 0xe6, 0x8f, 0x8f, 0xe8, 0xbf, 0xb0, 0x52, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x93, 0x01, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a,
+	0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x06,
+	0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x35, 0x92, 0x41,
+	0x32, 0x32, 0x30, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe4, 0xb8,
+	0xba, 0x30, 0xe6, 0x97, 0xb6, 0xe5, 0xaf, 0xb9, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0xe4, 0xb8,
+	0x8b, 0xe6, 0x89, 0x80, 0xe6, 0x9c, 0x89, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x94, 0x9f,
+	0xe6, 0x95, 0x88, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x50, 0x5a, 0x4e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2d,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x3b, 0x70, 0x62, 0x6e, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_notification_channel_proto_rawDescOnce sync.Once
+	file_notification_channel_proto_rawDescData = file_notification_channel_proto_rawDesc
+)
+
+func file_notification_channel_proto_rawDescGZIP() []byte {
+	file_notification_channel_proto_rawDescOnce.Do(func() {
+		file_notification_channel_proto_rawDescData = protoimpl.X.CompressGZIP(file_notification_channel_proto_rawDescData)
+	})
+	return file_notification_channel_proto_rawDescData
+}
+
+var file_notification_channel_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_notification_channel_proto_goTypes = []any{
+	(*NotificationChannel)(nil),           // 0: pbnc.NotificationChannel
+	(*NotificationChannelSpec)(nil),       // 1: pbnc.NotificationChannelSpec
+	(*NotificationChannelAttachment)(nil), // 2: pbnc.NotificationChannelAttachment
+	(*base.Revision)(nil),                 // 3: pbbase.Revision
+}
+var file_notification_channel_proto_depIdxs = []int32{
+	1, // 0: pbnc.NotificationChannel.spec:type_name -> pbnc.NotificationChannelSpec
+	2, // 1: pbnc.NotificationChannel.attachment:type_name -> pbnc.NotificationChannelAttachment
+	3, // 2: pbnc.NotificationChannel.revision:type_name -> pbbase.Revision
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_notification_channel_proto_init() }
+func file_notification_channel_proto_init() {
+	if File_notification_channel_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_notification_channel_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_notification_channel_proto_goTypes,
+		DependencyIndexes: file_notification_channel_proto_depIdxs,
+		MessageInfos:      file_notification_channel_proto_msgTypes,
+	}.Build()
+	File_notification_channel_proto = out.File
+	file_notification_channel_proto_rawDesc = nil
+	file_notification_channel_proto_goTypes = nil
+	file_notification_channel_proto_depIdxs = nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pbop
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// PbOperation convert table Operation to pb Operation
+func PbOperation(op *table.Operation) *Operation {
+	if op == nil {
+		return nil
+	}
+
+	var spec *OperationSpec
+	if op.Spec != nil {
+		spec = &OperationSpec{
+			Type:            string(op.Spec.Type),
+			State:           string(op.Spec.State),
+			Total:           op.Spec.Total,
+			Succeeded:       op.Spec.Succeeded,
+			Failed:          op.Spec.Failed,
+			Errors:          make([]*OperationError, 0, len(op.Spec.Errors)),
+			Result:          op.Spec.Result,
+			CancelRequested: op.Spec.CancelRequested,
+		}
+		for _, e := range op.Spec.Errors {
+			spec.Errors = append(spec.Errors, &OperationError{Item: e.Item, Message: e.Message})
+		}
+		if op.Spec.FinishedAt != nil {
+			spec.FinishedAt = op.Spec.FinishedAt.Format(time.RFC3339)
+		}
+	}
+
+	var at *OperationAttachment
+	if op.Attachment != nil {
+		at = &OperationAttachment{BizId: op.Attachment.BizID, AppId: op.Attachment.AppID}
+	}
+
+	return &Operation{
+		Id:         op.ID,
+		Spec:       spec,
+		Attachment: at,
+		Revision:   pbbase.PbRevision(op.Revision),
+	}
+}
+
+// PbOperations convert table Operation list to pb Operation list
+func PbOperations(list []*table.Operation) []*Operation {
+	result := make([]*Operation, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbOperation(one))
+	}
+
+	return result
+}
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbrb xxx
+package pbrb
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// PbRecycleBinSpec convert table RecycleBinSpec to pb RecycleBinSpec, the snapshot data is not exposed.
+func PbRecycleBinSpec(spec *table.RecycleBinSpec) *RecycleBinSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &RecycleBinSpec{
+		ResType:   string(spec.ResType),
+		ResId:     spec.ResID,
+		Name:      spec.Name,
+		ExpiredAt: timestamppb.New(spec.ExpiredAt.UTC()),
+	}
+}
+
+// PbRecycleBin convert table RecycleBin to pb RecycleBin
+func PbRecycleBin(r *table.RecycleBin) *RecycleBin {
+	if r == nil {
+		return nil
+	}
+
+	var at *RecycleBinAttachment
+	if r.Attachment != nil {
+		at = &RecycleBinAttachment{BizId: r.Attachment.BizID, AppId: r.Attachment.AppID}
+	}
+
+	return &RecycleBin{
+		Id:         r.ID,
+		Spec:       PbRecycleBinSpec(r.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbCreatedRevision(r.Revision),
+	}
+}
+
+// PbRecycleBins convert table RecycleBin list to pb RecycleBin list
+func PbRecycleBins(list []*table.RecycleBin) []*RecycleBin {
+	result := make([]*RecycleBin, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbRecycleBin(one))
+	}
+
+	return result
+}
@@ -0,0 +1,337 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: recycle_bin.proto
+
+package pbrb
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RecycleBin source resource reference: pkg/dal/table/recycle_bin.go
+type RecycleBin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *RecycleBinSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *RecycleBinAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	Revision   *base.CreatedRevision `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *RecycleBin) Reset() {
+	*x = RecycleBin{}
+	mi := &file_recycle_bin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBin) ProtoMessage() {}
+
+func (x *RecycleBin) ProtoReflect() protoreflect.Message {
+	mi := &file_recycle_bin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBin.ProtoReflect.Descriptor instead.
+func (*RecycleBin) Descriptor() ([]byte, []int) {
+	return file_recycle_bin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RecycleBin) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RecycleBin) GetSpec() *RecycleBinSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *RecycleBin) GetAttachment() *RecycleBinAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *RecycleBin) GetRevision() *base.CreatedRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// RecycleBinSpec source resource reference: pkg/dal/table/recycle_bin.go
+type RecycleBinSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResType   string                 `protobuf:"bytes,1,opt,name=res_type,json=resType,proto3" json:"res_type,omitempty"`
+	ResId     uint32                 `protobuf:"varint,2,opt,name=res_id,json=resId,proto3" json:"res_id,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ExpiredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expired_at,json=expiredAt,proto3" json:"expired_at,omitempty"`
+}
+
+func (x *RecycleBinSpec) Reset() {
+	*x = RecycleBinSpec{}
+	mi := &file_recycle_bin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBinSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBinSpec) ProtoMessage() {}
+
+func (x *RecycleBinSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_recycle_bin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBinSpec.ProtoReflect.Descriptor instead.
+func (*RecycleBinSpec) Descriptor() ([]byte, []int) {
+	return file_recycle_bin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RecycleBinSpec) GetResType() string {
+	if x != nil {
+		return x.ResType
+	}
+	return ""
+}
+
+func (x *RecycleBinSpec) GetResId() uint32 {
+	if x != nil {
+		return x.ResId
+	}
+	return 0
+}
+
+func (x *RecycleBinSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecycleBinSpec) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+// RecycleBinAttachment source resource reference: pkg/dal/table/recycle_bin.go
+type RecycleBinAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *RecycleBinAttachment) Reset() {
+	*x = RecycleBinAttachment{}
+	mi := &file_recycle_bin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecycleBinAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecycleBinAttachment) ProtoMessage() {}
+
+func (x *RecycleBinAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_recycle_bin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecycleBinAttachment.ProtoReflect.Descriptor instead.
+func (*RecycleBinAttachment) Descriptor() ([]byte, []int) {
+	return file_recycle_bin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecycleBinAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *RecycleBinAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_recycle_bin_proto protoreflect.FileDescriptor
+
+var file_recycle_bin_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x5f, 0x62, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x72, 0x62, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x01,
+	0x0a, 0x0a, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe5,
+	0x9b, 0x9e, 0xe6, 0x94, 0xb6, 0xe7, 0xab, 0x99, 0xe8, 0xae, 0xb0, 0xe5, 0xbd, 0x95, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x72, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3a,
+	0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x62, 0x72, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xeb, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x53, 0x70,
+	0x65, 0x63, 0x12, 0x51, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0x92, 0x41, 0x33, 0x32, 0x31, 0xe8, 0xa2, 0xab, 0xe5, 0x88,
+	0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb,
+	0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x61, 0x70, 0x70, 0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0xe3, 0x80, 0x81, 0x6b, 0x76, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19, 0x92, 0x41, 0x16, 0x32, 0x14, 0xe8, 0xa2, 0xab, 0xe5,
+	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x49, 0x44,
+	0x52, 0x05, 0x72, 0x65, 0x73, 0x49, 0x64, 0x12, 0x68, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x54, 0x92, 0x41, 0x51, 0x32, 0x4f, 0xe8, 0xa2, 0xab, 0xe5,
+	0x88, 0xa0, 0xe9, 0x99, 0xa4, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0xef, 0xbc, 0x9a, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d,
+	0xe3, 0x80, 0x81, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7,
+	0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0xe6, 0x88, 0x96, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65, 0x79, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x6a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe6, 0x97,
+	0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe8, 0xbf, 0x87, 0xe6, 0x9c, 0x9f, 0xe5, 0x90, 0x8e,
+	0xe5, 0xb0, 0x86, 0xe8, 0xa2, 0xab, 0xe5, 0xbd, 0xbb, 0xe5, 0xba, 0x95, 0xe6, 0xb8, 0x85, 0xe9,
+	0x99, 0xa4, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x62, 0x0a,
+	0x14, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61,
+	0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a,
+	0x32, 0x08, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x64, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
+	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c,
+	0x65, 0x2d, 0x62, 0x69, 0x6e, 0x3b, 0x70, 0x62, 0x72, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_recycle_bin_proto_rawDescOnce sync.Once
+	file_recycle_bin_proto_rawDescData = file_recycle_bin_proto_rawDesc
+)
+
+func file_recycle_bin_proto_rawDescGZIP() []byte {
+	file_recycle_bin_proto_rawDescOnce.Do(func() {
+		file_recycle_bin_proto_rawDescData = protoimpl.X.CompressGZIP(file_recycle_bin_proto_rawDescData)
+	})
+	return file_recycle_bin_proto_rawDescData
+}
+
+var file_recycle_bin_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_recycle_bin_proto_goTypes = []any{
+	(*RecycleBin)(nil),            // 0: pbrb.RecycleBin
+	(*RecycleBinSpec)(nil),        // 1: pbrb.RecycleBinSpec
+	(*RecycleBinAttachment)(nil),  // 2: pbrb.RecycleBinAttachment
+	(*base.CreatedRevision)(nil),  // 3: pbbase.CreatedRevision
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_recycle_bin_proto_depIdxs = []int32{
+	1, // 0: pbrb.RecycleBin.spec:type_name -> pbrb.RecycleBinSpec
+	2, // 1: pbrb.RecycleBin.attachment:type_name -> pbrb.RecycleBinAttachment
+	3, // 2: pbrb.RecycleBin.revision:type_name -> pbbase.CreatedRevision
+	4, // 3: pbrb.RecycleBinSpec.expired_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_recycle_bin_proto_init() }
+func file_recycle_bin_proto_init() {
+	if File_recycle_bin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_recycle_bin_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_recycle_bin_proto_goTypes,
+		DependencyIndexes: file_recycle_bin_proto_depIdxs,
+		MessageInfos:      file_recycle_bin_proto_msgTypes,
+	}.Build()
+	File_recycle_bin_proto = out.File
+	file_recycle_bin_proto_rawDesc = nil
+	file_recycle_bin_proto_goTypes = nil
+	file_recycle_bin_proto_depIdxs = nil
+}
@@ -29,6 +29,7 @@ func (m *ReleaseSpec) ReleaseSpec() *table.ReleaseSpec {
 		Memo:       m.Memo,
 		Deprecated: m.Deprecated,
 		PublishNum: m.PublishNum,
+		Notes:      m.Notes.ReleaseNotes(),
 	}
 }
 
@@ -45,6 +46,27 @@ func PbReleaseSpec(spec *table.ReleaseSpec) *ReleaseSpec {
 		Memo:       spec.Memo,
 		Deprecated: spec.Deprecated,
 		PublishNum: spec.PublishNum,
+		Notes:      PbReleaseNotes(spec.Notes),
+	}
+}
+
+// ReleaseNotes convert pb ReleaseNotes to table ReleaseNotes
+func (m *ReleaseNotes) ReleaseNotes() table.ReleaseNotes {
+	if m == nil {
+		return table.ReleaseNotes{}
+	}
+
+	return table.ReleaseNotes{
+		Content: m.Content,
+		Fields:  m.Fields,
+	}
+}
+
+// PbReleaseNotes convert table ReleaseNotes to pb ReleaseNotes
+func PbReleaseNotes(notes table.ReleaseNotes) *ReleaseNotes {
+	return &ReleaseNotes{
+		Content: notes.Content,
+		Fields:  notes.Fields,
 	}
 }
 
@@ -108,10 +108,11 @@ type ReleaseSpec struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Memo       string `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
-	Deprecated bool   `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
-	PublishNum uint32 `protobuf:"varint,4,opt,name=publish_num,json=publishNum,proto3" json:"publish_num,omitempty"`
+	Name       string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Memo       string        `protobuf:"bytes,2,opt,name=memo,proto3" json:"memo,omitempty"`
+	Deprecated bool          `protobuf:"varint,3,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	PublishNum uint32        `protobuf:"varint,4,opt,name=publish_num,json=publishNum,proto3" json:"publish_num,omitempty"`
+	Notes      *ReleaseNotes `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
 }
 
 func (x *ReleaseSpec) Reset() {
@@ -172,6 +173,67 @@ func (x *ReleaseSpec) GetPublishNum() uint32 {
 	return 0
 }
 
+func (x *ReleaseSpec) GetNotes() *ReleaseNotes {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+// ReleaseNotes source resource reference: pkg/dal/table/release_notes.go
+type ReleaseNotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string            `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	Fields  map[string]string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ReleaseNotes) Reset() {
+	*x = ReleaseNotes{}
+	mi := &file_release_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseNotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseNotes) ProtoMessage() {}
+
+func (x *ReleaseNotes) ProtoReflect() protoreflect.Message {
+	mi := &file_release_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseNotes.ProtoReflect.Descriptor instead.
+func (*ReleaseNotes) Descriptor() ([]byte, []int) {
+	return file_release_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReleaseNotes) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReleaseNotes) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 // ReleaseStatus status that not in db
 type ReleaseStatus struct {
 	state         protoimpl.MessageState
@@ -187,7 +249,7 @@ type ReleaseStatus struct {
 
 func (x *ReleaseStatus) Reset() {
 	*x = ReleaseStatus{}
-	mi := &file_release_proto_msgTypes[2]
+	mi := &file_release_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -199,7 +261,7 @@ func (x *ReleaseStatus) String() string {
 func (*ReleaseStatus) ProtoMessage() {}
 
 func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_release_proto_msgTypes[2]
+	mi := &file_release_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -212,7 +274,7 @@ func (x *ReleaseStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseStatus.ProtoReflect.Descriptor instead.
 func (*ReleaseStatus) Descriptor() ([]byte, []int) {
-	return file_release_proto_rawDescGZIP(), []int{2}
+	return file_release_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ReleaseStatus) GetPublishStatus() string {
@@ -255,7 +317,7 @@ type ReleaseAttachment struct {
 
 func (x *ReleaseAttachment) Reset() {
 	*x = ReleaseAttachment{}
-	mi := &file_release_proto_msgTypes[3]
+	mi := &file_release_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -267,7 +329,7 @@ func (x *ReleaseAttachment) String() string {
 func (*ReleaseAttachment) ProtoMessage() {}
 
 func (x *ReleaseAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_release_proto_msgTypes[3]
+	mi := &file_release_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -280,7 +342,7 @@ func (x *ReleaseAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseAttachment.ProtoReflect.Descriptor instead.
 func (*ReleaseAttachment) Descriptor() ([]byte, []int) {
-	return file_release_proto_rawDescGZIP(), []int{3}
+	return file_release_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ReleaseAttachment) GetBizId() uint32 {
@@ -310,11 +372,13 @@ type PublishRecord struct {
 	FullyReleased     bool            `protobuf:"varint,5,opt,name=fully_released,json=fullyReleased,proto3" json:"fully_released,omitempty"`
 	UpdatedAt         string          `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	FinalApprovalTime string          `protobuf:"bytes,7,opt,name=final_approval_time,json=finalApprovalTime,proto3" json:"final_approval_time,omitempty"`
+	AppId             uint32          `protobuf:"varint,8,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	ReleaseId         uint32          `protobuf:"varint,9,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
 }
 
 func (x *PublishRecord) Reset() {
 	*x = PublishRecord{}
-	mi := &file_release_proto_msgTypes[4]
+	mi := &file_release_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -326,7 +390,7 @@ func (x *PublishRecord) String() string {
 func (*PublishRecord) ProtoMessage() {}
 
 func (x *PublishRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_release_proto_msgTypes[4]
+	mi := &file_release_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -339,7 +403,7 @@ func (x *PublishRecord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRecord.ProtoReflect.Descriptor instead.
 func (*PublishRecord) Descriptor() ([]byte, []int) {
-	return file_release_proto_rawDescGZIP(), []int{4}
+	return file_release_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PublishRecord) GetPublishTime() string {
@@ -391,6 +455,20 @@ func (x *PublishRecord) GetFinalApprovalTime() string {
 	return ""
 }
 
+func (x *PublishRecord) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *PublishRecord) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
 type ReleaseStatus_ReleasedGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -407,7 +485,7 @@ type ReleaseStatus_ReleasedGroup struct {
 
 func (x *ReleaseStatus_ReleasedGroup) Reset() {
 	*x = ReleaseStatus_ReleasedGroup{}
-	mi := &file_release_proto_msgTypes[5]
+	mi := &file_release_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -419,7 +497,7 @@ func (x *ReleaseStatus_ReleasedGroup) String() string {
 func (*ReleaseStatus_ReleasedGroup) ProtoMessage() {}
 
 func (x *ReleaseStatus_ReleasedGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_release_proto_msgTypes[5]
+	mi := &file_release_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,7 +510,7 @@ func (x *ReleaseStatus_ReleasedGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReleaseStatus_ReleasedGroup.ProtoReflect.Descriptor instead.
 func (*ReleaseStatus_ReleasedGroup) Descriptor() ([]byte, []int) {
-	return file_release_proto_rawDescGZIP(), []int{2, 0}
+	return file_release_proto_rawDescGZIP(), []int{3, 0}
 }
 
 func (x *ReleaseStatus_ReleasedGroup) GetId() uint32 {
@@ -514,7 +592,7 @@ var file_release_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x43,
 	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08,
-	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x6c,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xa4, 0x02, 0x0a, 0x0b, 0x52, 0x65, 0x6c,
 	0x65, 0x61, 0x73, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x89, 0x88,
 	0xe6, 0x9c, 0xac, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
@@ -528,7 +606,25 @@ var file_release_proto_rawDesc = []byte{
 	0x12, 0x32, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6e, 0x75, 0x6d, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe5, 0x8f, 0x91, 0xe5,
 	0xb8, 0x83, 0xe6, 0x95, 0xb0, 0xe9, 0x87, 0x8f, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
-	0x68, 0x4e, 0x75, 0x6d, 0x22, 0x87, 0x06, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x68, 0x4e, 0x75, 0x6d, 0x12, 0x49, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x42, 0x1a, 0x92, 0x41,
+	0x17, 0x32, 0x15, 0xe7, 0xbb, 0x93, 0xe6, 0x9e, 0x84, 0xe5, 0x8c, 0x96, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe8, 0xaf, 0xb4, 0xe6, 0x98, 0x8e, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22,
+	0xd2, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe8, 0xaf,
+	0xb4, 0xe6, 0x98, 0x8e, 0xe6, 0xad, 0xa3, 0xe6, 0x96, 0x87, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x70, 0x62, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x2e,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe7,
+	0xbb, 0x93, 0xe6, 0x9e, 0x84, 0xe5, 0x8c, 0x96, 0xe5, 0xad, 0x97, 0xe6, 0xae, 0xb5, 0xe5, 0x80,
+	0xbc, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x87, 0x06, 0x0a, 0x0d, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
 	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x79, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
 	0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x52,
 	0x92, 0x41, 0x4f, 0x32, 0x4d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe7, 0x8a, 0xb6, 0xe6, 0x80,
@@ -583,7 +679,7 @@ var file_release_proto_rawDesc = []byte{
 	0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70,
 	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
 	0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x22,
-	0xff, 0x01, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0xb5, 0x02, 0x0a, 0x0d, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72,
 	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
 	0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
@@ -599,12 +695,15 @@ var file_release_proto_rawDesc = []byte{
 	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x61, 0x70, 0x70, 0x72, 0x6f,
 	0x76, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
 	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x54, 0x69, 0x6d,
-	0x65, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f,
-	0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x3b, 0x70, 0x62, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
+	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x3b, 0x70, 0x62, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -619,32 +718,36 @@ func file_release_proto_rawDescGZIP() []byte {
 	return file_release_proto_rawDescData
 }
 
-var file_release_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_release_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_release_proto_goTypes = []any{
 	(*Release)(nil),                     // 0: pbrelease.Release
 	(*ReleaseSpec)(nil),                 // 1: pbrelease.ReleaseSpec
-	(*ReleaseStatus)(nil),               // 2: pbrelease.ReleaseStatus
-	(*ReleaseAttachment)(nil),           // 3: pbrelease.ReleaseAttachment
-	(*PublishRecord)(nil),               // 4: pbrelease.PublishRecord
-	(*ReleaseStatus_ReleasedGroup)(nil), // 5: pbrelease.ReleaseStatus.ReleasedGroup
-	(*base.CreatedRevision)(nil),        // 6: pbbase.CreatedRevision
-	(*strategy.Scope)(nil),              // 7: pbstrategy.Scope
-	(*structpb.Struct)(nil),             // 8: google.protobuf.Struct
+	(*ReleaseNotes)(nil),                // 2: pbrelease.ReleaseNotes
+	(*ReleaseStatus)(nil),               // 3: pbrelease.ReleaseStatus
+	(*ReleaseAttachment)(nil),           // 4: pbrelease.ReleaseAttachment
+	(*PublishRecord)(nil),               // 5: pbrelease.PublishRecord
+	nil,                                 // 6: pbrelease.ReleaseNotes.FieldsEntry
+	(*ReleaseStatus_ReleasedGroup)(nil), // 7: pbrelease.ReleaseStatus.ReleasedGroup
+	(*base.CreatedRevision)(nil),        // 8: pbbase.CreatedRevision
+	(*strategy.Scope)(nil),              // 9: pbstrategy.Scope
+	(*structpb.Struct)(nil),             // 10: google.protobuf.Struct
 }
 var file_release_proto_depIdxs = []int32{
-	1, // 0: pbrelease.Release.spec:type_name -> pbrelease.ReleaseSpec
-	2, // 1: pbrelease.Release.status:type_name -> pbrelease.ReleaseStatus
-	3, // 2: pbrelease.Release.attachment:type_name -> pbrelease.ReleaseAttachment
-	6, // 3: pbrelease.Release.revision:type_name -> pbbase.CreatedRevision
-	5, // 4: pbrelease.ReleaseStatus.released_groups:type_name -> pbrelease.ReleaseStatus.ReleasedGroup
-	7, // 5: pbrelease.PublishRecord.scope:type_name -> pbstrategy.Scope
-	8, // 6: pbrelease.ReleaseStatus.ReleasedGroup.old_selector:type_name -> google.protobuf.Struct
-	8, // 7: pbrelease.ReleaseStatus.ReleasedGroup.new_selector:type_name -> google.protobuf.Struct
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: pbrelease.Release.spec:type_name -> pbrelease.ReleaseSpec
+	3,  // 1: pbrelease.Release.status:type_name -> pbrelease.ReleaseStatus
+	4,  // 2: pbrelease.Release.attachment:type_name -> pbrelease.ReleaseAttachment
+	8,  // 3: pbrelease.Release.revision:type_name -> pbbase.CreatedRevision
+	2,  // 4: pbrelease.ReleaseSpec.notes:type_name -> pbrelease.ReleaseNotes
+	6,  // 5: pbrelease.ReleaseNotes.fields:type_name -> pbrelease.ReleaseNotes.FieldsEntry
+	7,  // 6: pbrelease.ReleaseStatus.released_groups:type_name -> pbrelease.ReleaseStatus.ReleasedGroup
+	9,  // 7: pbrelease.PublishRecord.scope:type_name -> pbstrategy.Scope
+	10, // 8: pbrelease.ReleaseStatus.ReleasedGroup.old_selector:type_name -> google.protobuf.Struct
+	10, // 9: pbrelease.ReleaseStatus.ReleasedGroup.new_selector:type_name -> google.protobuf.Struct
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_release_proto_init() }
@@ -658,7 +761,7 @@ func file_release_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_release_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
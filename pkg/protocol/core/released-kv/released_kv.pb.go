@@ -110,6 +110,108 @@ func (x *ReleasedKv) GetContentSpec() *content.ContentSpec {
 	return nil
 }
 
+// KvHistory is one version of a kv in its history timeline.
+type KvHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseId     uint32               `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseName   string               `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	KvType        string               `protobuf:"bytes,3,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
+	ContentSpec   *content.ContentSpec `protobuf:"bytes,4,opt,name=content_spec,json=contentSpec,proto3" json:"content_spec,omitempty"`
+	Author        string               `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	UpdatedAt     string               `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PublishStatus string               `protobuf:"bytes,7,opt,name=publish_status,json=publishStatus,proto3" json:"publish_status,omitempty"`
+	Deprecated    bool                 `protobuf:"varint,8,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (x *KvHistory) Reset() {
+	*x = KvHistory{}
+	mi := &file_released_kv_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvHistory) ProtoMessage() {}
+
+func (x *KvHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_released_kv_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvHistory.ProtoReflect.Descriptor instead.
+func (*KvHistory) Descriptor() ([]byte, []int) {
+	return file_released_kv_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KvHistory) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *KvHistory) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *KvHistory) GetKvType() string {
+	if x != nil {
+		return x.KvType
+	}
+	return ""
+}
+
+func (x *KvHistory) GetContentSpec() *content.ContentSpec {
+	if x != nil {
+		return x.ContentSpec
+	}
+	return nil
+}
+
+func (x *KvHistory) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *KvHistory) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *KvHistory) GetPublishStatus() string {
+	if x != nil {
+		return x.PublishStatus
+	}
+	return ""
+}
+
+func (x *KvHistory) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
 var File_released_kv_proto protoreflect.FileDescriptor
 
 var file_released_kv_proto_rawDesc = []byte{
@@ -143,12 +245,41 @@ var file_released_kv_proto_rawDesc = []byte{
 	0x6e, 0x12, 0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65,
 	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74,
 	0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x42, 0x48, 0x5a, 0x46,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65,
-	0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73,
-	0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2d, 0x6b, 0x76,
-	0x3b, 0x70, 0x62, 0x72, 0x6b, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0xcb, 0x03, 0x0a,
+	0x09, 0x4b, 0x76, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x4b, 0x0a, 0x0a, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2c,
+	0x92, 0x41, 0x29, 0x32, 0x27, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30, 0xe8, 0xa1, 0xa8, 0xe7, 0xa4, 0xba, 0xe6, 0x9c, 0xaa,
+	0xe5, 0x91, 0xbd, 0xe5, 0x90, 0x8d, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x52, 0x09, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92,
+	0x41, 0x11, 0x32, 0x0f, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac,
+	0xe5, 0x90, 0x8d, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x2a, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe9, 0x94, 0xae, 0xe5, 0x80, 0xbc, 0xe7, 0xb1,
+	0xbb, 0xe5, 0x9e, 0x8b, 0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x92, 0x41, 0x0b, 0x32, 0x09, 0xe4, 0xbf,
+	0xae, 0xe6, 0x94, 0xb9, 0xe4, 0xba, 0xba, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12,
+	0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe4, 0xbf, 0xae, 0xe6, 0x94, 0xb9,
+	0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x3e, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12,
+	0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe7, 0x8a, 0xb6, 0xe6,
+	0x80, 0x81, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0xe6, 0x98, 0xaf, 0xe5, 0x90, 0xa6, 0xe5, 0xba, 0x9f, 0xe5, 0xbc, 0x83, 0x52, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74,
+	0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70,
+	0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x2d, 0x6b, 0x76, 0x3b, 0x70,
+	0x62, 0x72, 0x6b, 0x76, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -163,24 +294,26 @@ func file_released_kv_proto_rawDescGZIP() []byte {
 	return file_released_kv_proto_rawDescData
 }
 
-var file_released_kv_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_released_kv_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_released_kv_proto_goTypes = []any{
 	(*ReleasedKv)(nil),          // 0: pbrkv.ReleasedKv
-	(*kv.KvSpec)(nil),           // 1: pbkv.KvSpec
-	(*kv.KvAttachment)(nil),     // 2: pbkv.KvAttachment
-	(*base.Revision)(nil),       // 3: pbbase.Revision
-	(*content.ContentSpec)(nil), // 4: pbcontent.ContentSpec
+	(*KvHistory)(nil),           // 1: pbrkv.KvHistory
+	(*kv.KvSpec)(nil),           // 2: pbkv.KvSpec
+	(*kv.KvAttachment)(nil),     // 3: pbkv.KvAttachment
+	(*base.Revision)(nil),       // 4: pbbase.Revision
+	(*content.ContentSpec)(nil), // 5: pbcontent.ContentSpec
 }
 var file_released_kv_proto_depIdxs = []int32{
-	1, // 0: pbrkv.ReleasedKv.spec:type_name -> pbkv.KvSpec
-	2, // 1: pbrkv.ReleasedKv.attachment:type_name -> pbkv.KvAttachment
-	3, // 2: pbrkv.ReleasedKv.revision:type_name -> pbbase.Revision
-	4, // 3: pbrkv.ReleasedKv.content_spec:type_name -> pbcontent.ContentSpec
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 0: pbrkv.ReleasedKv.spec:type_name -> pbkv.KvSpec
+	3, // 1: pbrkv.ReleasedKv.attachment:type_name -> pbkv.KvAttachment
+	4, // 2: pbrkv.ReleasedKv.revision:type_name -> pbbase.Revision
+	5, // 3: pbrkv.ReleasedKv.content_spec:type_name -> pbcontent.ContentSpec
+	5, // 4: pbrkv.KvHistory.content_spec:type_name -> pbcontent.ContentSpec
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_released_kv_proto_init() }
@@ -194,7 +327,7 @@ func file_released_kv_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_released_kv_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbss xxx
+package pbss
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/secretscan"
+)
+
+// SecretScanSettingSpec convert pb SecretScanSettingSpec to table SecretScanSettingSpec
+func (m *SecretScanSettingSpec) SecretScanSettingSpec() *table.SecretScanSettingSpec {
+	if m == nil {
+		return nil
+	}
+
+	spec := &table.SecretScanSettingSpec{
+		Mode:          table.SecretScanMode(m.Mode),
+		DisabledRules: m.DisabledRules,
+		CustomRules:   make(table.SecretScanRules, 0, len(m.CustomRules)),
+	}
+	for _, r := range m.CustomRules {
+		spec.CustomRules = append(spec.CustomRules, &table.SecretScanRule{Name: r.Name, Pattern: r.Pattern})
+	}
+
+	return spec
+}
+
+// PbSecretScanSettingSpec convert table SecretScanSettingSpec to pb SecretScanSettingSpec
+func PbSecretScanSettingSpec(spec *table.SecretScanSettingSpec) *SecretScanSettingSpec {
+	if spec == nil {
+		return nil
+	}
+
+	rules := make([]*SecretScanRule, 0, len(spec.CustomRules))
+	for _, r := range spec.CustomRules {
+		rules = append(rules, &SecretScanRule{Name: r.Name, Pattern: r.Pattern})
+	}
+
+	return &SecretScanSettingSpec{
+		Mode:          string(spec.Mode),
+		DisabledRules: spec.DisabledRules,
+		CustomRules:   rules,
+	}
+}
+
+// PbSecretScanSetting convert table SecretScanSetting to pb SecretScanSetting
+func PbSecretScanSetting(s *table.SecretScanSetting) *SecretScanSetting {
+	if s == nil {
+		return nil
+	}
+
+	var bizID uint32
+	if s.Attachment != nil {
+		bizID = s.Attachment.BizID
+	}
+
+	return &SecretScanSetting{
+		Id:       s.ID,
+		Spec:     PbSecretScanSettingSpec(s.Spec),
+		BizId:    bizID,
+		Revision: pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbSecretLeakFindings convert secretscan Finding list to pb SecretLeakFinding list
+func PbSecretLeakFindings(name string, list []*secretscan.Finding) []*SecretLeakFinding {
+	result := make([]*SecretLeakFinding, 0, len(list))
+	for _, one := range list {
+		result = append(result, &SecretLeakFinding{
+			Name: name,
+			Rule: one.Rule,
+			Line: uint32(one.Line),
+		})
+	}
+
+	return result
+}
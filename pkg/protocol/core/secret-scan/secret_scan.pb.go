@@ -0,0 +1,392 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: secret_scan.proto
+
+package pbss
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SecretScanSetting source resource reference: pkg/dal/table/secret_scan_setting.go
+type SecretScanSetting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec     *SecretScanSettingSpec `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	BizId    uint32                 `protobuf:"varint,3,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Revision *base.Revision         `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (x *SecretScanSetting) Reset() {
+	*x = SecretScanSetting{}
+	mi := &file_secret_scan_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretScanSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretScanSetting) ProtoMessage() {}
+
+func (x *SecretScanSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_scan_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretScanSetting.ProtoReflect.Descriptor instead.
+func (*SecretScanSetting) Descriptor() ([]byte, []int) {
+	return file_secret_scan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SecretScanSetting) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SecretScanSetting) GetSpec() *SecretScanSettingSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *SecretScanSetting) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *SecretScanSetting) GetRevision() *base.Revision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+// SecretScanSettingSpec source resource reference: pkg/dal/table/secret_scan_setting.go
+type SecretScanSettingSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode          string            `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	DisabledRules []string          `protobuf:"bytes,2,rep,name=disabled_rules,json=disabledRules,proto3" json:"disabled_rules,omitempty"`
+	CustomRules   []*SecretScanRule `protobuf:"bytes,3,rep,name=custom_rules,json=customRules,proto3" json:"custom_rules,omitempty"`
+}
+
+func (x *SecretScanSettingSpec) Reset() {
+	*x = SecretScanSettingSpec{}
+	mi := &file_secret_scan_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretScanSettingSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretScanSettingSpec) ProtoMessage() {}
+
+func (x *SecretScanSettingSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_scan_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretScanSettingSpec.ProtoReflect.Descriptor instead.
+func (*SecretScanSettingSpec) Descriptor() ([]byte, []int) {
+	return file_secret_scan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SecretScanSettingSpec) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *SecretScanSettingSpec) GetDisabledRules() []string {
+	if x != nil {
+		return x.DisabledRules
+	}
+	return nil
+}
+
+func (x *SecretScanSettingSpec) GetCustomRules() []*SecretScanRule {
+	if x != nil {
+		return x.CustomRules
+	}
+	return nil
+}
+
+// SecretScanRule source resource reference: pkg/dal/table/secret_scan_setting.go
+type SecretScanRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pattern string `protobuf:"bytes,2,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *SecretScanRule) Reset() {
+	*x = SecretScanRule{}
+	mi := &file_secret_scan_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretScanRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretScanRule) ProtoMessage() {}
+
+func (x *SecretScanRule) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_scan_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretScanRule.ProtoReflect.Descriptor instead.
+func (*SecretScanRule) Descriptor() ([]byte, []int) {
+	return file_secret_scan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SecretScanRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretScanRule) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+// SecretLeakFinding source resource reference: pkg/runtime/secretscan/secretscan.go
+type SecretLeakFinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rule string `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	Line uint32 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *SecretLeakFinding) Reset() {
+	*x = SecretLeakFinding{}
+	mi := &file_secret_scan_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecretLeakFinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecretLeakFinding) ProtoMessage() {}
+
+func (x *SecretLeakFinding) ProtoReflect() protoreflect.Message {
+	mi := &file_secret_scan_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecretLeakFinding.ProtoReflect.Descriptor instead.
+func (*SecretLeakFinding) Descriptor() ([]byte, []int) {
+	return file_secret_scan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SecretLeakFinding) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SecretLeakFinding) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *SecretLeakFinding) GetLine() uint32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+var File_secret_scan_proto protoreflect.FileDescriptor
+
+var file_secret_scan_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x73, 0x73, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69,
+	0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xca, 0x01, 0x0a,
+	0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x30, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x20,
+	0x92, 0x41, 0x1d, 0x32, 0x1b, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0x49, 0x44, 0xef, 0xbc, 0x8c,
+	0xe6, 0x9c, 0xaa, 0xe8, 0xae, 0xbe, 0xe7, 0xbd, 0xae, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x30,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x73, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x53, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5,
+	0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x98, 0x02, 0x0a, 0x15, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x70, 0x65, 0x63, 0x12, 0x6d, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x59, 0x92, 0x41, 0x56, 0x32, 0x54, 0xe5, 0x8f, 0x91, 0xe7, 0x8e, 0xb0, 0xe7, 0x96,
+	0x91, 0xe4, 0xbc, 0xbc, 0xe5, 0x87, 0xad, 0xe8, 0xaf, 0x81, 0xe6, 0x97, 0xb6, 0xe7, 0x9a, 0x84,
+	0xe5, 0xa4, 0x84, 0xe7, 0x90, 0x86, 0xef, 0xbc, 0x9a, 0x6f, 0x66, 0x66, 0x3d, 0xe4, 0xb8, 0x8d,
+	0xe6, 0x89, 0xab, 0xe6, 0x8f, 0x8f, 0xef, 0xbc, 0x8c, 0x77, 0x61, 0x72, 0x6e, 0x3d, 0xe4, 0xbb,
+	0x85, 0xe6, 0x8f, 0x90, 0xe7, 0xa4, 0xba, 0xef, 0xbc, 0x8c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x3d,
+	0xe9, 0x98, 0xbb, 0xe6, 0xad, 0xa2, 0xe4, 0xb8, 0x8a, 0xe4, 0xbc, 0xa0, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x41, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1a, 0x92, 0x41, 0x17, 0x32,
+	0x15, 0xe5, 0x85, 0xb3, 0xe9, 0x97, 0xad, 0xe7, 0x9a, 0x84, 0xe5, 0x86, 0x85, 0xe7, 0xbd, 0xae,
+	0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62,
+	0x73, 0x73, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x75, 0x6c,
+	0x65, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe8, 0x87, 0xaa, 0xe5, 0xae, 0x9a, 0xe4, 0xb9,
+	0x89, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x53, 0x63,
+	0x61, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe8, 0xa7, 0x84, 0xe5, 0x88,
+	0x99, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0x92, 0x41, 0x11, 0x32, 0x0f, 0xe6, 0xad, 0xa3, 0xe5, 0x88, 0x99, 0xe8, 0xa1, 0xa8, 0xe8, 0xbe,
+	0xbe, 0xe5, 0xbc, 0x8f, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22, 0xa7, 0x01,
+	0x0a, 0x11, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x6b, 0x46, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x2d, 0x92, 0x41, 0x2a, 0x32, 0x28, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96,
+	0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84,
+	0xe6, 0x88, 0x96, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe9, 0xa1, 0xb9, 0x20, 0x6b, 0x65, 0x79,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe5, 0x91, 0xbd, 0xe4, 0xb8,
+	0xad, 0xe7, 0x9a, 0x84, 0xe8, 0xa7, 0x84, 0xe5, 0x88, 0x99, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x25, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x11,
+	0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x89, 0x80, 0xe5, 0x9c, 0xa8, 0xe8, 0xa1, 0x8c, 0xe5, 0x8f,
+	0xb7, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
+	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2d, 0x73, 0x63, 0x61, 0x6e, 0x3b, 0x70, 0x62, 0x73, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_secret_scan_proto_rawDescOnce sync.Once
+	file_secret_scan_proto_rawDescData = file_secret_scan_proto_rawDesc
+)
+
+func file_secret_scan_proto_rawDescGZIP() []byte {
+	file_secret_scan_proto_rawDescOnce.Do(func() {
+		file_secret_scan_proto_rawDescData = protoimpl.X.CompressGZIP(file_secret_scan_proto_rawDescData)
+	})
+	return file_secret_scan_proto_rawDescData
+}
+
+var file_secret_scan_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_secret_scan_proto_goTypes = []any{
+	(*SecretScanSetting)(nil),     // 0: pbss.SecretScanSetting
+	(*SecretScanSettingSpec)(nil), // 1: pbss.SecretScanSettingSpec
+	(*SecretScanRule)(nil),        // 2: pbss.SecretScanRule
+	(*SecretLeakFinding)(nil),     // 3: pbss.SecretLeakFinding
+	(*base.Revision)(nil),         // 4: pbbase.Revision
+}
+var file_secret_scan_proto_depIdxs = []int32{
+	1, // 0: pbss.SecretScanSetting.spec:type_name -> pbss.SecretScanSettingSpec
+	4, // 1: pbss.SecretScanSetting.revision:type_name -> pbbase.Revision
+	2, // 2: pbss.SecretScanSettingSpec.custom_rules:type_name -> pbss.SecretScanRule
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_secret_scan_proto_init() }
+func file_secret_scan_proto_init() {
+	if File_secret_scan_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_secret_scan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_secret_scan_proto_goTypes,
+		DependencyIndexes: file_secret_scan_proto_depIdxs,
+		MessageInfos:      file_secret_scan_proto_msgTypes,
+	}.Build()
+	File_secret_scan_proto = out.File
+	file_secret_scan_proto_rawDesc = nil
+	file_secret_scan_proto_goTypes = nil
+	file_secret_scan_proto_depIdxs = nil
+}
@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbsa xxx
+package pbsa
+
+import (
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+)
+
+// ServiceAccountScopes convert pb ServiceAccountScope list to table ServiceAccountScopes
+func ServiceAccountScopes(scopes []*ServiceAccountScope) table.ServiceAccountScopes {
+	result := make(table.ServiceAccountScopes, 0, len(scopes))
+	for _, one := range scopes {
+		if one == nil {
+			continue
+		}
+		result = append(result, &table.ServiceAccountScope{AppID: one.AppId, Actions: one.Actions})
+	}
+
+	return result
+}
+
+// PbServiceAccountScopes convert table ServiceAccountScopes to pb ServiceAccountScope list
+func PbServiceAccountScopes(scopes table.ServiceAccountScopes) []*ServiceAccountScope {
+	result := make([]*ServiceAccountScope, 0, len(scopes))
+	for _, one := range scopes {
+		result = append(result, &ServiceAccountScope{AppId: one.AppID, Actions: one.Actions})
+	}
+
+	return result
+}
+
+// PbServiceAccountSpec convert table ServiceAccountSpec to pb ServiceAccountSpec, the key hash is never returned.
+func PbServiceAccountSpec(spec *table.ServiceAccountSpec) *ServiceAccountSpec {
+	if spec == nil {
+		return nil
+	}
+
+	return &ServiceAccountSpec{
+		Name:       spec.Name,
+		Memo:       spec.Memo,
+		KeyPrefix:  spec.KeyPrefix,
+		Scopes:     PbServiceAccountScopes(spec.Scopes),
+		Enabled:    spec.Enabled,
+		ExpiredAt:  formatTime(spec.ExpiredAt),
+		LastUsedAt: formatTime(spec.LastUsedAt),
+	}
+}
+
+// PbServiceAccount convert table ServiceAccount to pb ServiceAccount
+func PbServiceAccount(s *table.ServiceAccount) *ServiceAccount {
+	if s == nil {
+		return nil
+	}
+
+	var at *ServiceAccountAttachment
+	if s.Attachment != nil {
+		at = &ServiceAccountAttachment{BizId: s.Attachment.BizID}
+	}
+
+	return &ServiceAccount{
+		Id:         s.ID,
+		Spec:       PbServiceAccountSpec(s.Spec),
+		Attachment: at,
+		Revision:   pbbase.PbRevision(s.Revision),
+	}
+}
+
+// PbServiceAccounts convert table ServiceAccount list to pb ServiceAccount list
+func PbServiceAccounts(list []*table.ServiceAccount) []*ServiceAccount {
+	result := make([]*ServiceAccount, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbServiceAccount(one))
+	}
+
+	return result
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package pbsr xxx
+package pbsr
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// PbStaleResourceSpec convert table StaleResourceSpec to pb StaleResourceSpec
+func PbStaleResourceSpec(spec *table.StaleResourceSpec) *StaleResourceSpec {
+	if spec == nil {
+		return nil
+	}
+
+	result := &StaleResourceSpec{
+		ResType:    string(spec.ResType),
+		ResId:      spec.ResID,
+		Name:       spec.Name,
+		Reason:     spec.Reason,
+		NotifiedAt: timestamppb.New(spec.NotifiedAt.UTC()),
+	}
+	if spec.LastActiveAt != nil {
+		result.LastActiveAt = timestamppb.New(spec.LastActiveAt.UTC())
+	}
+	if spec.ArchivedAt != nil {
+		result.ArchivedAt = timestamppb.New(spec.ArchivedAt.UTC())
+	}
+
+	return result
+}
+
+// PbStaleResource convert table StaleResource to pb StaleResource
+func PbStaleResource(s *table.StaleResource) *StaleResource {
+	if s == nil {
+		return nil
+	}
+
+	var at *StaleResourceAttachment
+	if s.Attachment != nil {
+		at = &StaleResourceAttachment{BizId: s.Attachment.BizID, AppId: s.Attachment.AppID}
+	}
+
+	return &StaleResource{
+		Id:         s.ID,
+		Spec:       PbStaleResourceSpec(s.Spec),
+		Attachment: at,
+	}
+}
+
+// PbStaleResources convert table StaleResource list to pb StaleResource list
+func PbStaleResources(list []*table.StaleResource) []*StaleResource {
+	result := make([]*StaleResource, 0, len(list))
+	for _, one := range list {
+		result = append(result, PbStaleResource(one))
+	}
+
+	return result
+}
@@ -0,0 +1,366 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: stale_resource.proto
+
+package pbsr
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StaleResource source resource reference: pkg/dal/table/stale_resource.go
+type StaleResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         uint32                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Spec       *StaleResourceSpec       `protobuf:"bytes,2,opt,name=spec,proto3" json:"spec,omitempty"`
+	Attachment *StaleResourceAttachment `protobuf:"bytes,3,opt,name=attachment,proto3" json:"attachment,omitempty"`
+}
+
+func (x *StaleResource) Reset() {
+	*x = StaleResource{}
+	mi := &file_stale_resource_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleResource) ProtoMessage() {}
+
+func (x *StaleResource) ProtoReflect() protoreflect.Message {
+	mi := &file_stale_resource_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleResource.ProtoReflect.Descriptor instead.
+func (*StaleResource) Descriptor() ([]byte, []int) {
+	return file_stale_resource_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StaleResource) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StaleResource) GetSpec() *StaleResourceSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *StaleResource) GetAttachment() *StaleResourceAttachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// StaleResourceSpec source resource reference: pkg/dal/table/stale_resource.go
+type StaleResourceSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResType      string                 `protobuf:"bytes,1,opt,name=res_type,json=resType,proto3" json:"res_type,omitempty"`
+	ResId        uint32                 `protobuf:"varint,2,opt,name=res_id,json=resId,proto3" json:"res_id,omitempty"`
+	Name         string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Reason       string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	LastActiveAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_active_at,json=lastActiveAt,proto3" json:"last_active_at,omitempty"`
+	NotifiedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"`
+	ArchivedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=archived_at,json=archivedAt,proto3" json:"archived_at,omitempty"`
+}
+
+func (x *StaleResourceSpec) Reset() {
+	*x = StaleResourceSpec{}
+	mi := &file_stale_resource_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleResourceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleResourceSpec) ProtoMessage() {}
+
+func (x *StaleResourceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_stale_resource_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleResourceSpec.ProtoReflect.Descriptor instead.
+func (*StaleResourceSpec) Descriptor() ([]byte, []int) {
+	return file_stale_resource_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StaleResourceSpec) GetResType() string {
+	if x != nil {
+		return x.ResType
+	}
+	return ""
+}
+
+func (x *StaleResourceSpec) GetResId() uint32 {
+	if x != nil {
+		return x.ResId
+	}
+	return 0
+}
+
+func (x *StaleResourceSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StaleResourceSpec) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StaleResourceSpec) GetLastActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActiveAt
+	}
+	return nil
+}
+
+func (x *StaleResourceSpec) GetNotifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return nil
+}
+
+func (x *StaleResourceSpec) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+// StaleResourceAttachment source resource reference: pkg/dal/table/stale_resource.go
+type StaleResourceAttachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+}
+
+func (x *StaleResourceAttachment) Reset() {
+	*x = StaleResourceAttachment{}
+	mi := &file_stale_resource_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleResourceAttachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleResourceAttachment) ProtoMessage() {}
+
+func (x *StaleResourceAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_stale_resource_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleResourceAttachment.ProtoReflect.Descriptor instead.
+func (*StaleResourceAttachment) Descriptor() ([]byte, []int) {
+	return file_stale_resource_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StaleResourceAttachment) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *StaleResourceAttachment) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+var File_stale_resource_proto protoreflect.FileDescriptor
+
+var file_stale_resource_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x73, 0x72, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa6, 0x01,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x29, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x19, 0x92, 0x41, 0x16,
+	0x32, 0x14, 0xe9, 0x97, 0xb2, 0xe7, 0xbd, 0xae, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe8, 0xae,
+	0xb0, 0xe5, 0xbd, 0x95, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x62, 0x73, 0x72, 0x2e,
+	0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65,
+	0x63, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x3d, 0x0a, 0x0a, 0x61, 0x74, 0x74, 0x61, 0x63,
+	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62,
+	0x73, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x8d, 0x05, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x70, 0x65, 0x63, 0x12, 0x56, 0x0a, 0x08,
+	0x72, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3b,
+	0x92, 0x41, 0x38, 0x32, 0x36, 0xe9, 0x97, 0xb2, 0xe7, 0xbd, 0xae, 0xe7, 0x9a, 0x84, 0xe8, 0xb5,
+	0x84, 0xe6, 0xba, 0x90, 0xe7, 0xb1, 0xbb, 0xe5, 0x9e, 0x8b, 0xef, 0xbc, 0x9a, 0x61, 0x70, 0x70,
+	0xe3, 0x80, 0x81, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0xe3, 0x80,
+	0x81, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x16, 0x92, 0x41, 0x13, 0x32, 0x11, 0xe9, 0x97, 0xb2, 0xe7, 0xbd,
+	0xae, 0xe7, 0x9a, 0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0x49, 0x44, 0x52, 0x05, 0x72, 0x65,
+	0x73, 0x49, 0x64, 0x12, 0x64, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x50, 0x92, 0x41, 0x4d, 0x32, 0x4b, 0xe9, 0x97, 0xb2, 0xe7, 0xbd, 0xae, 0xe7, 0x9a,
+	0x84, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0xef, 0xbc, 0x9a,
+	0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90, 0x8d, 0xe3, 0x80, 0x81, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe7, 0xbb, 0x9d, 0xe5, 0xaf, 0xb9, 0xe8, 0xb7,
+	0xaf, 0xe5, 0xbe, 0x84, 0xe6, 0x88, 0x96, 0xe5, 0xaf, 0x86, 0xe9, 0x92, 0xa5, 0xe5, 0x90, 0x8d,
+	0xe7, 0xa7, 0xb0, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0x92, 0x41, 0x1a, 0x32, 0x18,
+	0xe5, 0x88, 0xa4, 0xe5, 0xae, 0x9a, 0xe4, 0xb8, 0xba, 0xe9, 0x97, 0xb2, 0xe7, 0xbd, 0xae, 0xe7,
+	0x9a, 0x84, 0xe5, 0x8e, 0x9f, 0xe5, 0x9b, 0xa0, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x89, 0x01, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x47, 0x92, 0x41, 0x44, 0x32, 0x42, 0xe6, 0x9c, 0x80, 0xe8,
+	0xbf, 0x91, 0xe4, 0xb8, 0x80, 0xe6, 0xac, 0xa1, 0xe8, 0xa2, 0xab, 0xe6, 0xb6, 0x88, 0xe8, 0xb4,
+	0xb9, 0xe6, 0x88, 0x96, 0xe6, 0x9b, 0xb4, 0xe6, 0x96, 0xb0, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6,
+	0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe4, 0xbb, 0x8e, 0xe6, 0x9c, 0xaa, 0xe8, 0xa2, 0xab, 0xe6,
+	0xb6, 0x88, 0xe8, 0xb4, 0xb9, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x74, 0x12, 0x5a, 0x0a, 0x0b,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0x92,
+	0x41, 0x1a, 0x32, 0x18, 0xe9, 0x80, 0x9a, 0xe7, 0x9f, 0xa5, 0xe8, 0xb4, 0x9f, 0xe8, 0xb4, 0xa3,
+	0xe4, 0xba, 0xba, 0xe7, 0x9a, 0x84, 0xe6, 0x97, 0xb6, 0xe9, 0x97, 0xb4, 0x52, 0x0a, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x6c, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a,
+	0xe8, 0x87, 0xaa, 0xe5, 0x8a, 0xa8, 0xe5, 0xbd, 0x92, 0xe6, 0xa1, 0xa3, 0xe7, 0x9a, 0x84, 0xe6,
+	0x97, 0xb6, 0xe9, 0x97, 0xb4, 0xef, 0xbc, 0x8c, 0xe6, 0x9c, 0xaa, 0xe5, 0xbd, 0x92, 0xe6, 0xa1,
+	0xa3, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe7, 0xa9, 0xba, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x22, 0x65, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x24, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe4, 0xb8, 0x9a, 0xe5, 0x8a, 0xa1, 0x49, 0x44,
+	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe6, 0x9c,
+	0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x42, 0x4a, 0x5a,
+	0x48, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63,
+	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62,
+	0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x2d, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x3b, 0x70, 0x62, 0x73, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_stale_resource_proto_rawDescOnce sync.Once
+	file_stale_resource_proto_rawDescData = file_stale_resource_proto_rawDesc
+)
+
+func file_stale_resource_proto_rawDescGZIP() []byte {
+	file_stale_resource_proto_rawDescOnce.Do(func() {
+		file_stale_resource_proto_rawDescData = protoimpl.X.CompressGZIP(file_stale_resource_proto_rawDescData)
+	})
+	return file_stale_resource_proto_rawDescData
+}
+
+var file_stale_resource_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_stale_resource_proto_goTypes = []any{
+	(*StaleResource)(nil),           // 0: pbsr.StaleResource
+	(*StaleResourceSpec)(nil),       // 1: pbsr.StaleResourceSpec
+	(*StaleResourceAttachment)(nil), // 2: pbsr.StaleResourceAttachment
+	(*timestamppb.Timestamp)(nil),   // 3: google.protobuf.Timestamp
+}
+var file_stale_resource_proto_depIdxs = []int32{
+	1, // 0: pbsr.StaleResource.spec:type_name -> pbsr.StaleResourceSpec
+	2, // 1: pbsr.StaleResource.attachment:type_name -> pbsr.StaleResourceAttachment
+	3, // 2: pbsr.StaleResourceSpec.last_active_at:type_name -> google.protobuf.Timestamp
+	3, // 3: pbsr.StaleResourceSpec.notified_at:type_name -> google.protobuf.Timestamp
+	3, // 4: pbsr.StaleResourceSpec.archived_at:type_name -> google.protobuf.Timestamp
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_stale_resource_proto_init() }
+func file_stale_resource_proto_init() {
+	if File_stale_resource_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stale_resource_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stale_resource_proto_goTypes,
+		DependencyIndexes: file_stale_resource_proto_depIdxs,
+		MessageInfos:      file_stale_resource_proto_msgTypes,
+	}.Build()
+	File_stale_resource_proto = out.File
+	file_stale_resource_proto_rawDesc = nil
+	file_stale_resource_proto_goTypes = nil
+	file_stale_resource_proto_depIdxs = nil
+}
@@ -39,6 +39,8 @@ func (m *StrategySpec) StrategySpec() *table.StrategySpec {
 		RejectReason:     m.RejectReason,
 		Approver:         m.Approver,
 		ApproverProgress: m.ApproverProgress,
+		ChangeTicketID:   m.ChangeTicketId,
+		ChangeTicketURL:  m.ChangeTicketUrl,
 	}
 }
 
@@ -68,6 +70,8 @@ func PbStrategySpec(s *table.StrategySpec) *StrategySpec {
 		ItsmTicketSn:      s.ItsmTicketSn,
 		ItsmTicketStatus:  s.ItsmTicketStatus,
 		ItsmTicketStateId: int32(s.ItsmTicketStateID),
+		ChangeTicketId:    s.ChangeTicketID,
+		ChangeTicketUrl:   s.ChangeTicketURL,
 	}
 }
 
@@ -0,0 +1,32 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package pbwu xxx
+package pbwu
+
+import (
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// PbWhereUsedTmplConfigItem convert the template revision bound by the app to pb WhereUsedConfigItem
+func PbWhereUsedTmplConfigItem(r *table.TemplateRevision, isLatest bool) *WhereUsedConfigItem {
+	if r == nil || r.Spec == nil || r.Attachment == nil {
+		return nil
+	}
+
+	return &WhereUsedConfigItem{
+		Id:                 r.Attachment.TemplateID,
+		TemplateRevisionId: r.ID,
+		Name:               r.Spec.Name,
+		Path:               r.Spec.Path,
+		IsLatest:           isLatest,
+	}
+}
@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: where_used.proto
+
+package pbwu
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// WhereUsedApp is the app which uses the template or template variable.
+type WhereUsedApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppId       uint32                 `protobuf:"varint,1,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	AppName     string                 `protobuf:"bytes,2,opt,name=app_name,json=appName,proto3" json:"app_name,omitempty"`
+	ConfigItems []*WhereUsedConfigItem `protobuf:"bytes,3,rep,name=config_items,json=configItems,proto3" json:"config_items,omitempty"`
+	Releases    []*WhereUsedRelease    `protobuf:"bytes,4,rep,name=releases,proto3" json:"releases,omitempty"`
+}
+
+func (x *WhereUsedApp) Reset() {
+	*x = WhereUsedApp{}
+	mi := &file_where_used_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhereUsedApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhereUsedApp) ProtoMessage() {}
+
+func (x *WhereUsedApp) ProtoReflect() protoreflect.Message {
+	mi := &file_where_used_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhereUsedApp.ProtoReflect.Descriptor instead.
+func (*WhereUsedApp) Descriptor() ([]byte, []int) {
+	return file_where_used_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WhereUsedApp) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *WhereUsedApp) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *WhereUsedApp) GetConfigItems() []*WhereUsedConfigItem {
+	if x != nil {
+		return x.ConfigItems
+	}
+	return nil
+}
+
+func (x *WhereUsedApp) GetReleases() []*WhereUsedRelease {
+	if x != nil {
+		return x.Releases
+	}
+	return nil
+}
+
+// WhereUsedConfigItem is the config item or template config item which uses the template or template variable.
+type WhereUsedConfigItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TemplateRevisionId uint32 `protobuf:"varint,2,opt,name=template_revision_id,json=templateRevisionId,proto3" json:"template_revision_id,omitempty"`
+	Name               string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Path               string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	IsLatest           bool   `protobuf:"varint,5,opt,name=is_latest,json=isLatest,proto3" json:"is_latest,omitempty"`
+}
+
+func (x *WhereUsedConfigItem) Reset() {
+	*x = WhereUsedConfigItem{}
+	mi := &file_where_used_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhereUsedConfigItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhereUsedConfigItem) ProtoMessage() {}
+
+func (x *WhereUsedConfigItem) ProtoReflect() protoreflect.Message {
+	mi := &file_where_used_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhereUsedConfigItem.ProtoReflect.Descriptor instead.
+func (*WhereUsedConfigItem) Descriptor() ([]byte, []int) {
+	return file_where_used_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WhereUsedConfigItem) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WhereUsedConfigItem) GetTemplateRevisionId() uint32 {
+	if x != nil {
+		return x.TemplateRevisionId
+	}
+	return 0
+}
+
+func (x *WhereUsedConfigItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WhereUsedConfigItem) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WhereUsedConfigItem) GetIsLatest() bool {
+	if x != nil {
+		return x.IsLatest
+	}
+	return false
+}
+
+// WhereUsedRelease is the published release which uses the template or template variable.
+type WhereUsedRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReleaseId            uint32   `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseName          string   `protobuf:"bytes,2,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	GroupIds             []uint32 `protobuf:"varint,3,rep,packed,name=group_ids,json=groupIds,proto3" json:"group_ids,omitempty"`
+	TemplateRevisionId   uint32   `protobuf:"varint,4,opt,name=template_revision_id,json=templateRevisionId,proto3" json:"template_revision_id,omitempty"`
+	TemplateRevisionName string   `protobuf:"bytes,5,opt,name=template_revision_name,json=templateRevisionName,proto3" json:"template_revision_name,omitempty"`
+}
+
+func (x *WhereUsedRelease) Reset() {
+	*x = WhereUsedRelease{}
+	mi := &file_where_used_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhereUsedRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhereUsedRelease) ProtoMessage() {}
+
+func (x *WhereUsedRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_where_used_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhereUsedRelease.ProtoReflect.Descriptor instead.
+func (*WhereUsedRelease) Descriptor() ([]byte, []int) {
+	return file_where_used_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WhereUsedRelease) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *WhereUsedRelease) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *WhereUsedRelease) GetGroupIds() []uint32 {
+	if x != nil {
+		return x.GroupIds
+	}
+	return nil
+}
+
+func (x *WhereUsedRelease) GetTemplateRevisionId() uint32 {
+	if x != nil {
+		return x.TemplateRevisionId
+	}
+	return 0
+}
+
+func (x *WhereUsedRelease) GetTemplateRevisionName() string {
+	if x != nil {
+		return x.TemplateRevisionName
+	}
+	return ""
+}
+
+var File_where_used_proto protoreflect.FileDescriptor
+
+var file_where_used_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x77, 0x68, 0x65, 0x72, 0x65, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x04, 0x70, 0x62, 0x77, 0x75, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x0c, 0x57, 0x68, 0x65,
+	0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x41, 0x70, 0x70, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x70, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08,
+	0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0x49, 0x44, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12,
+	0x2c, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe6, 0x9c, 0x8d, 0xe5, 0x8a, 0xa1, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x6a, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x77, 0x75, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65,
+	0x55, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x2c,
+	0x92, 0x41, 0x29, 0x32, 0x27, 0xe5, 0xbd, 0x93, 0xe5, 0x89, 0x8d, 0xe7, 0xbc, 0x96, 0xe8, 0xbe,
+	0x91, 0xe6, 0x80, 0x81, 0xe4, 0xb8, 0xad, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84,
+	0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x9c, 0x01, 0x0a, 0x08, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x62, 0x77, 0x75, 0x2e, 0x57, 0x68, 0x65, 0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe6, 0xad, 0xa3, 0xe5, 0x9c,
+	0xa8, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe4, 0xb8, 0xad, 0xe4, 0xb8, 0x94, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe4, 0xba, 0x86, 0xe8, 0xaf, 0xa5, 0xe8, 0xb5, 0x84, 0xe6, 0xba, 0x90, 0xe7,
+	0x9a, 0x84, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xef, 0xbc, 0x8c, 0xe5, 0x8f, 0x98, 0xe6, 0x9b,
+	0xb4, 0xe5, 0x90, 0x8e, 0xe9, 0x9c, 0x80, 0xe9, 0x87, 0x8d, 0xe6, 0x96, 0xb0, 0xe7, 0x94, 0x9f,
+	0xe6, 0x88, 0x90, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0xb9, 0xb6, 0xe4, 0xb8, 0x8a, 0xe7,
+	0xba, 0xbf, 0xe6, 0x89, 0x8d, 0xe4, 0xbc, 0x9a, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x22, 0xbd, 0x03, 0x0a, 0x13, 0x57, 0x68, 0x65,
+	0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x61, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x51, 0x92, 0x41,
+	0x4e, 0x32, 0x4c, 0xe9, 0x9d, 0x9e, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe9, 0x85, 0x8d, 0xe7,
+	0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6,
+	0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb,
+	0xb6, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0x49, 0x44, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x64, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x32, 0x92, 0x41, 0x2f, 0x32, 0x2d, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x89,
+	0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe9, 0x9d, 0x9e, 0xe6, 0xa8, 0xa1, 0xe6,
+	0x9d, 0xbf, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe6, 0x97,
+	0xb6, 0xe4, 0xb8, 0xba, 0x30, 0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0x92, 0x41, 0x11, 0x32, 0x0f, 0xe9, 0x85,
+	0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96, 0x87, 0xe4, 0xbb, 0xb6, 0xe5, 0x90, 0x8d, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x17, 0x92, 0x41, 0x14, 0x32, 0x12, 0xe9, 0x85, 0x8d, 0xe7, 0xbd, 0xae, 0xe6, 0x96,
+	0x87, 0xe4, 0xbb, 0xb6, 0xe8, 0xb7, 0xaf, 0xe5, 0xbe, 0x84, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x85, 0x01, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x42, 0x68, 0x92, 0x41, 0x65, 0x32, 0x63, 0xe6, 0x98, 0xaf, 0xe5, 0x90,
+	0xa6, 0xe7, 0xbb, 0x91, 0xe5, 0xae, 0x9a, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x9a, 0x84,
+	0xe6, 0x9c, 0x80, 0xe6, 0x96, 0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xef, 0xbc, 0x8c, 0xe6,
+	0x98, 0xaf, 0xe5, 0x88, 0x99, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x9a, 0x84, 0xe6, 0x96,
+	0xb0, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe4, 0xbc, 0x9a, 0xe5, 0x9c, 0xa8, 0xe6, 0x9c, 0x8d,
+	0xe5, 0x8a, 0xa1, 0xe4, 0xb8, 0x8b, 0xe6, 0xac, 0xa1, 0xe7, 0x94, 0x9f, 0xe6, 0x88, 0x90, 0xe7,
+	0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe6, 0x97, 0xb6, 0xe7, 0x94, 0x9f, 0xe6, 0x95, 0x88, 0x52, 0x08,
+	0x69, 0x73, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x22, 0x9a, 0x03, 0x0a, 0x10, 0x57, 0x68, 0x65,
+	0x72, 0x65, 0x55, 0x73, 0x65, 0x64, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x2c, 0x0a,
+	0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0d, 0x92, 0x41, 0x0a, 0x32, 0x08, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0x49, 0x44,
+	0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x92, 0x41, 0x0e, 0x32, 0x0c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x90,
+	0x8d, 0xe7, 0xa7, 0xb0, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x4c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0d, 0x42, 0x2f, 0x92, 0x41, 0x2c, 0x32, 0x2a, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xe4, 0xb8, 0x8a, 0xe7, 0xba, 0xbf, 0xe7, 0x9a, 0x84, 0xe5, 0x88, 0x86, 0xe7, 0xbb, 0x84,
+	0x49, 0x44, 0xef, 0xbc, 0x8c, 0x30, 0xe4, 0xb8, 0xba, 0xe5, 0x85, 0xa8, 0xe9, 0x83, 0xa8, 0xe5,
+	0xae, 0x9e, 0xe4, 0xbe, 0x8b, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x73, 0x12,
+	0x73, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x41, 0x92,
+	0x41, 0x3e, 0x32, 0x3c, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe4, 0xb8, 0xad, 0xe4, 0xbd, 0xbf,
+	0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0xa8, 0xa1, 0xe6, 0x9d, 0xbf, 0xe7, 0x89, 0x88, 0xe6,
+	0x9c, 0xac, 0x49, 0x44, 0xef, 0xbc, 0x8c, 0xe6, 0x9f, 0xa5, 0xe8, 0xaf, 0xa2, 0xe6, 0xa8, 0xa1,
+	0xe6, 0x9d, 0xbf, 0xe5, 0x8f, 0x98, 0xe9, 0x87, 0x8f, 0xe6, 0x97, 0xb6, 0xe4, 0xb8, 0xba, 0x30,
+	0x52, 0x12, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x16, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0x92, 0x41, 0x26, 0x32, 0x24, 0xe7, 0x89, 0x88, 0xe6, 0x9c,
+	0xac, 0xe4, 0xb8, 0xad, 0xe4, 0xbd, 0xbf, 0xe7, 0x94, 0xa8, 0xe7, 0x9a, 0x84, 0xe6, 0xa8, 0xa1,
+	0xe6, 0x9d, 0xbf, 0xe7, 0x89, 0x88, 0xe6, 0x9c, 0xac, 0xe5, 0x90, 0x8d, 0xe7, 0xa7, 0xb0, 0x52,
+	0x14, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x46, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b,
+	0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x77, 0x68,
+	0x65, 0x72, 0x65, 0x2d, 0x75, 0x73, 0x65, 0x64, 0x3b, 0x70, 0x62, 0x77, 0x75, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_where_used_proto_rawDescOnce sync.Once
+	file_where_used_proto_rawDescData = file_where_used_proto_rawDesc
+)
+
+func file_where_used_proto_rawDescGZIP() []byte {
+	file_where_used_proto_rawDescOnce.Do(func() {
+		file_where_used_proto_rawDescData = protoimpl.X.CompressGZIP(file_where_used_proto_rawDescData)
+	})
+	return file_where_used_proto_rawDescData
+}
+
+var file_where_used_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_where_used_proto_goTypes = []any{
+	(*WhereUsedApp)(nil),        // 0: pbwu.WhereUsedApp
+	(*WhereUsedConfigItem)(nil), // 1: pbwu.WhereUsedConfigItem
+	(*WhereUsedRelease)(nil),    // 2: pbwu.WhereUsedRelease
+}
+var file_where_used_proto_depIdxs = []int32{
+	1, // 0: pbwu.WhereUsedApp.config_items:type_name -> pbwu.WhereUsedConfigItem
+	2, // 1: pbwu.WhereUsedApp.releases:type_name -> pbwu.WhereUsedRelease
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_where_used_proto_init() }
+func file_where_used_proto_init() {
+	if File_where_used_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_where_used_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_where_used_proto_goTypes,
+		DependencyIndexes: file_where_used_proto_depIdxs,
+		MessageInfos:      file_where_used_proto_msgTypes,
+	}.Build()
+	File_where_used_proto = out.File
+	file_where_used_proto_rawDesc = nil
+	file_where_used_proto_goTypes = nil
+	file_where_used_proto_depIdxs = nil
+}
@@ -11,14 +11,19 @@ import (
 	app "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/app"
 	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 	client "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
+	client_preview "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-preview"
 	commit "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/commit"
 	config_item "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
 	content "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+	draft "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/draft"
 	group "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/group"
 	hook_revision "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-revision"
+	operation "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/operation"
+	recycle_bin "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/recycle-bin"
 	release "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/release"
 	released_ci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-ci"
 	released_kv "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/released-kv"
+	service_account "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/service-account"
 	strategy "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/strategy"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -33,6 +38,9 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Data_CreateApp_FullMethodName                         = "/pbds.Data/CreateApp"
+	Data_UpdateAppState_FullMethodName                    = "/pbds.Data/UpdateAppState"
+	Data_PauseConsumption_FullMethodName                  = "/pbds.Data/PauseConsumption"
+	Data_ResumeConsumption_FullMethodName                 = "/pbds.Data/ResumeConsumption"
 	Data_UpdateApp_FullMethodName                         = "/pbds.Data/UpdateApp"
 	Data_DeleteApp_FullMethodName                         = "/pbds.Data/DeleteApp"
 	Data_GetApp_FullMethodName                            = "/pbds.Data/GetApp"
@@ -86,6 +94,104 @@ const (
 	Data_UpdateHookRevision_FullMethodName                = "/pbds.Data/UpdateHookRevision"
 	Data_ListHookRevisionReferences_FullMethodName        = "/pbds.Data/ListHookRevisionReferences"
 	Data_GetReleaseHook_FullMethodName                    = "/pbds.Data/GetReleaseHook"
+	Data_BatchCreateHookExecResults_FullMethodName        = "/pbds.Data/BatchCreateHookExecResults"
+	Data_ListHookExecResults_FullMethodName               = "/pbds.Data/ListHookExecResults"
+	Data_BatchCreateFileTamperEvents_FullMethodName       = "/pbds.Data/BatchCreateFileTamperEvents"
+	Data_ListFileTamperEvents_FullMethodName              = "/pbds.Data/ListFileTamperEvents"
+	Data_CreateHookTemplate_FullMethodName                = "/pbds.Data/CreateHookTemplate"
+	Data_ListHookTemplates_FullMethodName                 = "/pbds.Data/ListHookTemplates"
+	Data_UpdateHookTemplate_FullMethodName                = "/pbds.Data/UpdateHookTemplate"
+	Data_DeleteHookTemplate_FullMethodName                = "/pbds.Data/DeleteHookTemplate"
+	Data_CreateHookFromTemplate_FullMethodName            = "/pbds.Data/CreateHookFromTemplate"
+	Data_UpgradeHookFromTemplate_FullMethodName           = "/pbds.Data/UpgradeHookFromTemplate"
+	Data_GetHookTemplateRef_FullMethodName                = "/pbds.Data/GetHookTemplateRef"
+	Data_UpgradeHooksFromTemplate_FullMethodName          = "/pbds.Data/UpgradeHooksFromTemplate"
+	Data_CreateEnvironment_FullMethodName                 = "/pbds.Data/CreateEnvironment"
+	Data_ListEnvironments_FullMethodName                  = "/pbds.Data/ListEnvironments"
+	Data_UpdateEnvironment_FullMethodName                 = "/pbds.Data/UpdateEnvironment"
+	Data_DeleteEnvironment_FullMethodName                 = "/pbds.Data/DeleteEnvironment"
+	Data_BindEnvApp_FullMethodName                        = "/pbds.Data/BindEnvApp"
+	Data_UnbindEnvApp_FullMethodName                      = "/pbds.Data/UnbindEnvApp"
+	Data_ListEnvApps_FullMethodName                       = "/pbds.Data/ListEnvApps"
+	Data_PromoteRelease_FullMethodName                    = "/pbds.Data/PromoteRelease"
+	Data_CreateConfigShare_FullMethodName                 = "/pbds.Data/CreateConfigShare"
+	Data_UpdateConfigShare_FullMethodName                 = "/pbds.Data/UpdateConfigShare"
+	Data_DeleteConfigShare_FullMethodName                 = "/pbds.Data/DeleteConfigShare"
+	Data_ListConfigShares_FullMethodName                  = "/pbds.Data/ListConfigShares"
+	Data_CreateConfigShareRef_FullMethodName              = "/pbds.Data/CreateConfigShareRef"
+	Data_DeleteConfigShareRef_FullMethodName              = "/pbds.Data/DeleteConfigShareRef"
+	Data_ListConfigShareRefs_FullMethodName               = "/pbds.Data/ListConfigShareRefs"
+	Data_ListRecycleBin_FullMethodName                    = "/pbds.Data/ListRecycleBin"
+	Data_GetRecycleBin_FullMethodName                     = "/pbds.Data/GetRecycleBin"
+	Data_RestoreRecycleBin_FullMethodName                 = "/pbds.Data/RestoreRecycleBin"
+	Data_CreateAppSnapshot_FullMethodName                 = "/pbds.Data/CreateAppSnapshot"
+	Data_ListAppSnapshots_FullMethodName                  = "/pbds.Data/ListAppSnapshots"
+	Data_DeleteAppSnapshot_FullMethodName                 = "/pbds.Data/DeleteAppSnapshot"
+	Data_RestoreAppSnapshot_FullMethodName                = "/pbds.Data/RestoreAppSnapshot"
+	Data_CloneApp_FullMethodName                          = "/pbds.Data/CloneApp"
+	Data_CloneAppAsync_FullMethodName                     = "/pbds.Data/CloneAppAsync"
+	Data_UpsertDraftItem_FullMethodName                   = "/pbds.Data/UpsertDraftItem"
+	Data_DeleteDraftItem_FullMethodName                   = "/pbds.Data/DeleteDraftItem"
+	Data_GetDraft_FullMethodName                          = "/pbds.Data/GetDraft"
+	Data_MergeDraft_FullMethodName                        = "/pbds.Data/MergeDraft"
+	Data_DiscardDraft_FullMethodName                      = "/pbds.Data/DiscardDraft"
+	Data_SearchFullText_FullMethodName                    = "/pbds.Data/SearchFullText"
+	Data_ListTmplWhereUsed_FullMethodName                 = "/pbds.Data/ListTmplWhereUsed"
+	Data_ListTmplVariableWhereUsed_FullMethodName         = "/pbds.Data/ListTmplVariableWhereUsed"
+	Data_CreateConfigReference_FullMethodName             = "/pbds.Data/CreateConfigReference"
+	Data_DeleteConfigReference_FullMethodName             = "/pbds.Data/DeleteConfigReference"
+	Data_GetConfigRefGraph_FullMethodName                 = "/pbds.Data/GetConfigRefGraph"
+	Data_ListExpiringCertificates_FullMethodName          = "/pbds.Data/ListExpiringCertificates"
+	Data_RenewCertificate_FullMethodName                  = "/pbds.Data/RenewCertificate"
+	Data_CreateKvOverride_FullMethodName                  = "/pbds.Data/CreateKvOverride"
+	Data_UpdateKvOverride_FullMethodName                  = "/pbds.Data/UpdateKvOverride"
+	Data_DeleteKvOverride_FullMethodName                  = "/pbds.Data/DeleteKvOverride"
+	Data_ListKvOverrides_FullMethodName                   = "/pbds.Data/ListKvOverrides"
+	Data_CreateCompliancePolicy_FullMethodName            = "/pbds.Data/CreateCompliancePolicy"
+	Data_ListCompliancePolicies_FullMethodName            = "/pbds.Data/ListCompliancePolicies"
+	Data_UpdateCompliancePolicy_FullMethodName            = "/pbds.Data/UpdateCompliancePolicy"
+	Data_DeleteCompliancePolicy_FullMethodName            = "/pbds.Data/DeleteCompliancePolicy"
+	Data_TestCompliancePolicy_FullMethodName              = "/pbds.Data/TestCompliancePolicy"
+	Data_CreateNotificationChannel_FullMethodName         = "/pbds.Data/CreateNotificationChannel"
+	Data_ListNotificationChannels_FullMethodName          = "/pbds.Data/ListNotificationChannels"
+	Data_UpdateNotificationChannel_FullMethodName         = "/pbds.Data/UpdateNotificationChannel"
+	Data_DeleteNotificationChannel_FullMethodName         = "/pbds.Data/DeleteNotificationChannel"
+	Data_TestNotificationChannel_FullMethodName           = "/pbds.Data/TestNotificationChannel"
+	Data_CreateAlertRule_FullMethodName                   = "/pbds.Data/CreateAlertRule"
+	Data_ListAlertRules_FullMethodName                    = "/pbds.Data/ListAlertRules"
+	Data_UpdateAlertRule_FullMethodName                   = "/pbds.Data/UpdateAlertRule"
+	Data_DeleteAlertRule_FullMethodName                   = "/pbds.Data/DeleteAlertRule"
+	Data_CreateClientQuarantine_FullMethodName            = "/pbds.Data/CreateClientQuarantine"
+	Data_ListClientQuarantines_FullMethodName             = "/pbds.Data/ListClientQuarantines"
+	Data_UpdateClientQuarantine_FullMethodName            = "/pbds.Data/UpdateClientQuarantine"
+	Data_DeleteClientQuarantine_FullMethodName            = "/pbds.Data/DeleteClientQuarantine"
+	Data_CreateExperiment_FullMethodName                  = "/pbds.Data/CreateExperiment"
+	Data_ListExperiments_FullMethodName                   = "/pbds.Data/ListExperiments"
+	Data_UpdateExperiment_FullMethodName                  = "/pbds.Data/UpdateExperiment"
+	Data_DeleteExperiment_FullMethodName                  = "/pbds.Data/DeleteExperiment"
+	Data_GetExperimentStats_FullMethodName                = "/pbds.Data/GetExperimentStats"
+	Data_BatchUpsertExperimentExposures_FullMethodName    = "/pbds.Data/BatchUpsertExperimentExposures"
+	Data_GetMaskingProfile_FullMethodName                 = "/pbds.Data/GetMaskingProfile"
+	Data_UpdateMaskingProfile_FullMethodName              = "/pbds.Data/UpdateMaskingProfile"
+	Data_GetSecretScanSetting_FullMethodName              = "/pbds.Data/GetSecretScanSetting"
+	Data_UpdateSecretScanSetting_FullMethodName           = "/pbds.Data/UpdateSecretScanSetting"
+	Data_ScanSecretLeak_FullMethodName                    = "/pbds.Data/ScanSecretLeak"
+	Data_BatchIncreaseAppConsumption_FullMethodName       = "/pbds.Data/BatchIncreaseAppConsumption"
+	Data_GetAppConsumption_FullMethodName                 = "/pbds.Data/GetAppConsumption"
+	Data_ListTopConsumingApps_FullMethodName              = "/pbds.Data/ListTopConsumingApps"
+	Data_ListStaleResources_FullMethodName                = "/pbds.Data/ListStaleResources"
+	Data_ListBizUsages_FullMethodName                     = "/pbds.Data/ListBizUsages"
+	Data_CreateServiceAccount_FullMethodName              = "/pbds.Data/CreateServiceAccount"
+	Data_ListServiceAccounts_FullMethodName               = "/pbds.Data/ListServiceAccounts"
+	Data_UpdateServiceAccount_FullMethodName              = "/pbds.Data/UpdateServiceAccount"
+	Data_DeleteServiceAccount_FullMethodName              = "/pbds.Data/DeleteServiceAccount"
+	Data_ResetServiceAccountKey_FullMethodName            = "/pbds.Data/ResetServiceAccountKey"
+	Data_GetServiceAccountByKey_FullMethodName            = "/pbds.Data/GetServiceAccountByKey"
+	Data_GetServiceAccountByName_FullMethodName           = "/pbds.Data/GetServiceAccountByName"
+	Data_SetAppRoleBinding_FullMethodName                 = "/pbds.Data/SetAppRoleBinding"
+	Data_ListAppRoleBindings_FullMethodName               = "/pbds.Data/ListAppRoleBindings"
+	Data_DeleteAppRoleBinding_FullMethodName              = "/pbds.Data/DeleteAppRoleBinding"
+	Data_ListUserAppRoles_FullMethodName                  = "/pbds.Data/ListUserAppRoles"
 	Data_CreateTemplateSpace_FullMethodName               = "/pbds.Data/CreateTemplateSpace"
 	Data_ListTemplateSpaces_FullMethodName                = "/pbds.Data/ListTemplateSpaces"
 	Data_UpdateTemplateSpace_FullMethodName               = "/pbds.Data/UpdateTemplateSpace"
@@ -166,6 +272,8 @@ const (
 	Data_UpdateGroup_FullMethodName                       = "/pbds.Data/UpdateGroup"
 	Data_DeleteGroup_FullMethodName                       = "/pbds.Data/DeleteGroup"
 	Data_ListGroupSelector_FullMethodName                 = "/pbds.Data/ListGroupSelector"
+	Data_PreviewGroupClients_FullMethodName               = "/pbds.Data/PreviewGroupClients"
+	Data_PreviewClientRelease_FullMethodName              = "/pbds.Data/PreviewClientRelease"
 	Data_CountGroupsReleasedApps_FullMethodName           = "/pbds.Data/CountGroupsReleasedApps"
 	Data_ListGroupReleasedApps_FullMethodName             = "/pbds.Data/ListGroupReleasedApps"
 	Data_Publish_FullMethodName                           = "/pbds.Data/Publish"
@@ -173,9 +281,23 @@ const (
 	Data_Approve_FullMethodName                           = "/pbds.Data/Approve"
 	Data_GetLastSelect_FullMethodName                     = "/pbds.Data/GetLastSelect"
 	Data_GetLastPublish_FullMethodName                    = "/pbds.Data/GetLastPublish"
+	Data_ListRecentPublishes_FullMethodName               = "/pbds.Data/ListRecentPublishes"
 	Data_GetReleasesStatus_FullMethodName                 = "/pbds.Data/GetReleasesStatus"
+	Data_EmergencyPublish_FullMethodName                  = "/pbds.Data/EmergencyPublish"
+	Data_ListEmergencyPublishes_FullMethodName            = "/pbds.Data/ListEmergencyPublishes"
+	Data_RevokeEmergencyPublish_FullMethodName            = "/pbds.Data/RevokeEmergencyPublish"
+	Data_PinReleasedGroup_FullMethodName                  = "/pbds.Data/PinReleasedGroup"
+	Data_UnpinReleasedGroup_FullMethodName                = "/pbds.Data/UnpinReleasedGroup"
 	Data_GenerateReleaseAndPublish_FullMethodName         = "/pbds.Data/GenerateReleaseAndPublish"
+	Data_BulkRelease_FullMethodName                       = "/pbds.Data/BulkRelease"
+	Data_BeginIdempotentRequest_FullMethodName            = "/pbds.Data/BeginIdempotentRequest"
+	Data_CompleteIdempotentRequest_FullMethodName         = "/pbds.Data/CompleteIdempotentRequest"
+	Data_AbortIdempotentRequest_FullMethodName            = "/pbds.Data/AbortIdempotentRequest"
+	Data_GetOperation_FullMethodName                      = "/pbds.Data/GetOperation"
+	Data_ListOperations_FullMethodName                    = "/pbds.Data/ListOperations"
+	Data_CancelOperation_FullMethodName                   = "/pbds.Data/CancelOperation"
 	Data_ListAudits_FullMethodName                        = "/pbds.Data/ListAudits"
+	Data_ListAppTimeline_FullMethodName                   = "/pbds.Data/ListAppTimeline"
 	Data_CreateCredential_FullMethodName                  = "/pbds.Data/CreateCredential"
 	Data_ListCredentials_FullMethodName                   = "/pbds.Data/ListCredentials"
 	Data_DeleteCredential_FullMethodName                  = "/pbds.Data/DeleteCredential"
@@ -189,11 +311,15 @@ const (
 	Data_ListKvs_FullMethodName                           = "/pbds.Data/ListKvs"
 	Data_DeleteKv_FullMethodName                          = "/pbds.Data/DeleteKv"
 	Data_BatchUpsertKvs_FullMethodName                    = "/pbds.Data/BatchUpsertKvs"
+	Data_TransactKvs_FullMethodName                       = "/pbds.Data/TransactKvs"
+	Data_ListKvHistory_FullMethodName                     = "/pbds.Data/ListKvHistory"
+	Data_DiffKvVersions_FullMethodName                    = "/pbds.Data/DiffKvVersions"
 	Data_UnDeleteKv_FullMethodName                        = "/pbds.Data/UnDeleteKv"
 	Data_UndoKv_FullMethodName                            = "/pbds.Data/UndoKv"
 	Data_KvFetchIDsExcluding_FullMethodName               = "/pbds.Data/KvFetchIDsExcluding"
 	Data_KvFetchKeysExcluding_FullMethodName              = "/pbds.Data/KvFetchKeysExcluding"
 	Data_FindNearExpiryCertKvs_FullMethodName             = "/pbds.Data/FindNearExpiryCertKvs"
+	Data_CompareKvValue_FullMethodName                    = "/pbds.Data/CompareKvValue"
 	Data_ListClients_FullMethodName                       = "/pbds.Data/ListClients"
 	Data_RetryClients_FullMethodName                      = "/pbds.Data/RetryClients"
 	Data_ListClientEvents_FullMethodName                  = "/pbds.Data/ListClientEvents"
@@ -225,6 +351,9 @@ const (
 type DataClient interface {
 	// app related interface.
 	CreateApp(ctx context.Context, in *CreateAppReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateAppState(ctx context.Context, in *UpdateAppStateReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	PauseConsumption(ctx context.Context, in *PauseConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ResumeConsumption(ctx context.Context, in *ResumeConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	UpdateApp(ctx context.Context, in *UpdateAppReq, opts ...grpc.CallOption) (*app.App, error)
 	DeleteApp(ctx context.Context, in *DeleteAppReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	GetApp(ctx context.Context, in *GetAppReq, opts ...grpc.CallOption) (*app.App, error)
@@ -287,6 +416,126 @@ type DataClient interface {
 	UpdateHookRevision(ctx context.Context, in *UpdateHookRevisionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	ListHookRevisionReferences(ctx context.Context, in *ListHookRevisionReferencesReq, opts ...grpc.CallOption) (*ListHookRevisionReferencesResp, error)
 	GetReleaseHook(ctx context.Context, in *GetReleaseHookReq, opts ...grpc.CallOption) (*GetReleaseHookResp, error)
+	// hook exec result related interface.
+	BatchCreateHookExecResults(ctx context.Context, in *BatchCreateHookExecResultsReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListHookExecResults(ctx context.Context, in *ListHookExecResultsReq, opts ...grpc.CallOption) (*ListHookExecResultsResp, error)
+	// file tamper event related interface.
+	BatchCreateFileTamperEvents(ctx context.Context, in *BatchCreateFileTamperEventsReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListFileTamperEvents(ctx context.Context, in *ListFileTamperEventsReq, opts ...grpc.CallOption) (*ListFileTamperEventsResp, error)
+	// hook template related interface.
+	CreateHookTemplate(ctx context.Context, in *CreateHookTemplateReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListHookTemplates(ctx context.Context, in *ListHookTemplatesReq, opts ...grpc.CallOption) (*ListHookTemplatesResp, error)
+	UpdateHookTemplate(ctx context.Context, in *UpdateHookTemplateReq, opts ...grpc.CallOption) (*UpdateHookTemplateResp, error)
+	DeleteHookTemplate(ctx context.Context, in *DeleteHookTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	CreateHookFromTemplate(ctx context.Context, in *CreateHookFromTemplateReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpgradeHookFromTemplate(ctx context.Context, in *UpgradeHookFromTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetHookTemplateRef(ctx context.Context, in *GetHookTemplateRefReq, opts ...grpc.CallOption) (*GetHookTemplateRefResp, error)
+	// upgrade the hooks referencing the hook template asynchronously, returns the async operation id.
+	UpgradeHooksFromTemplate(ctx context.Context, in *UpgradeHooksFromTemplateReq, opts ...grpc.CallOption) (*CreateOperationResp, error)
+	// environment related interface.
+	CreateEnvironment(ctx context.Context, in *CreateEnvironmentReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListEnvironments(ctx context.Context, in *ListEnvironmentsReq, opts ...grpc.CallOption) (*ListEnvironmentsResp, error)
+	UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	BindEnvApp(ctx context.Context, in *BindEnvAppReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UnbindEnvApp(ctx context.Context, in *UnbindEnvAppReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListEnvApps(ctx context.Context, in *ListEnvAppsReq, opts ...grpc.CallOption) (*ListEnvAppsResp, error)
+	PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error)
+	// config share related interface.
+	CreateConfigShare(ctx context.Context, in *CreateConfigShareReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateConfigShare(ctx context.Context, in *UpdateConfigShareReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteConfigShare(ctx context.Context, in *DeleteConfigShareReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListConfigShares(ctx context.Context, in *ListConfigSharesReq, opts ...grpc.CallOption) (*ListConfigSharesResp, error)
+	CreateConfigShareRef(ctx context.Context, in *CreateConfigShareRefReq, opts ...grpc.CallOption) (*CreateResp, error)
+	DeleteConfigShareRef(ctx context.Context, in *DeleteConfigShareRefReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListConfigShareRefs(ctx context.Context, in *ListConfigShareRefsReq, opts ...grpc.CallOption) (*ListConfigShareRefsResp, error)
+	// recycle bin related interface.
+	ListRecycleBin(ctx context.Context, in *ListRecycleBinReq, opts ...grpc.CallOption) (*ListRecycleBinResp, error)
+	GetRecycleBin(ctx context.Context, in *GetRecycleBinReq, opts ...grpc.CallOption) (*recycle_bin.RecycleBin, error)
+	RestoreRecycleBin(ctx context.Context, in *RestoreRecycleBinReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// app snapshot related interface.
+	CreateAppSnapshot(ctx context.Context, in *CreateAppSnapshotReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListAppSnapshots(ctx context.Context, in *ListAppSnapshotsReq, opts ...grpc.CallOption) (*ListAppSnapshotsResp, error)
+	DeleteAppSnapshot(ctx context.Context, in *DeleteAppSnapshotReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	RestoreAppSnapshot(ctx context.Context, in *RestoreAppSnapshotReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	CloneApp(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CreateResp, error)
+	// clone app asynchronously, returns the async operation id.
+	CloneAppAsync(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CreateOperationResp, error)
+	// draft related interface.
+	UpsertDraftItem(ctx context.Context, in *UpsertDraftItemReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteDraftItem(ctx context.Context, in *DeleteDraftItemReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetDraft(ctx context.Context, in *GetDraftReq, opts ...grpc.CallOption) (*draft.Draft, error)
+	MergeDraft(ctx context.Context, in *MergeDraftReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DiscardDraft(ctx context.Context, in *DiscardDraftReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// full-text search related interface.
+	SearchFullText(ctx context.Context, in *SearchFullTextReq, opts ...grpc.CallOption) (*SearchFullTextResp, error)
+	// where-used related interface.
+	ListTmplWhereUsed(ctx context.Context, in *ListTmplWhereUsedReq, opts ...grpc.CallOption) (*ListWhereUsedResp, error)
+	ListTmplVariableWhereUsed(ctx context.Context, in *ListTmplVariableWhereUsedReq, opts ...grpc.CallOption) (*ListWhereUsedResp, error)
+	// config reference related interface.
+	CreateConfigReference(ctx context.Context, in *CreateConfigReferenceReq, opts ...grpc.CallOption) (*CreateResp, error)
+	DeleteConfigReference(ctx context.Context, in *DeleteConfigReferenceReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetConfigRefGraph(ctx context.Context, in *GetConfigRefGraphReq, opts ...grpc.CallOption) (*GetConfigRefGraphResp, error)
+	// certificate related interface.
+	ListExpiringCertificates(ctx context.Context, in *ListExpiringCertificatesReq, opts ...grpc.CallOption) (*ListExpiringCertificatesResp, error)
+	RenewCertificate(ctx context.Context, in *RenewCertificateReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// kv override related interface.
+	CreateKvOverride(ctx context.Context, in *CreateKvOverrideReq, opts ...grpc.CallOption) (*CreateResp, error)
+	UpdateKvOverride(ctx context.Context, in *UpdateKvOverrideReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteKvOverride(ctx context.Context, in *DeleteKvOverrideReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListKvOverrides(ctx context.Context, in *ListKvOverridesReq, opts ...grpc.CallOption) (*ListKvOverridesResp, error)
+	// compliance policy related interface.
+	CreateCompliancePolicy(ctx context.Context, in *CreateCompliancePolicyReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListCompliancePolicies(ctx context.Context, in *ListCompliancePoliciesReq, opts ...grpc.CallOption) (*ListCompliancePoliciesResp, error)
+	UpdateCompliancePolicy(ctx context.Context, in *UpdateCompliancePolicyReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	TestCompliancePolicy(ctx context.Context, in *TestCompliancePolicyReq, opts ...grpc.CallOption) (*TestCompliancePolicyResp, error)
+	// notification channel related interface.
+	CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsReq, opts ...grpc.CallOption) (*ListNotificationChannelsResp, error)
+	UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	TestNotificationChannel(ctx context.Context, in *TestNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// alert rule related interface.
+	CreateAlertRule(ctx context.Context, in *CreateAlertRuleReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListAlertRules(ctx context.Context, in *ListAlertRulesReq, opts ...grpc.CallOption) (*ListAlertRulesResp, error)
+	UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// client quarantine related interface.
+	CreateClientQuarantine(ctx context.Context, in *CreateClientQuarantineReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListClientQuarantines(ctx context.Context, in *ListClientQuarantinesReq, opts ...grpc.CallOption) (*ListClientQuarantinesResp, error)
+	UpdateClientQuarantine(ctx context.Context, in *UpdateClientQuarantineReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteClientQuarantine(ctx context.Context, in *DeleteClientQuarantineReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	CreateExperiment(ctx context.Context, in *CreateExperimentReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListExperiments(ctx context.Context, in *ListExperimentsReq, opts ...grpc.CallOption) (*ListExperimentsResp, error)
+	UpdateExperiment(ctx context.Context, in *UpdateExperimentReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteExperiment(ctx context.Context, in *DeleteExperimentReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetExperimentStats(ctx context.Context, in *GetExperimentStatsReq, opts ...grpc.CallOption) (*GetExperimentStatsResp, error)
+	BatchUpsertExperimentExposures(ctx context.Context, in *BatchUpsertExperimentExposuresReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// masking profile related interface.
+	GetMaskingProfile(ctx context.Context, in *GetMaskingProfileReq, opts ...grpc.CallOption) (*GetMaskingProfileResp, error)
+	UpdateMaskingProfile(ctx context.Context, in *UpdateMaskingProfileReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetSecretScanSetting(ctx context.Context, in *GetSecretScanSettingReq, opts ...grpc.CallOption) (*GetSecretScanSettingResp, error)
+	UpdateSecretScanSetting(ctx context.Context, in *UpdateSecretScanSettingReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ScanSecretLeak(ctx context.Context, in *ScanSecretLeakReq, opts ...grpc.CallOption) (*ScanSecretLeakResp, error)
+	BatchIncreaseAppConsumption(ctx context.Context, in *BatchIncreaseAppConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	GetAppConsumption(ctx context.Context, in *GetAppConsumptionReq, opts ...grpc.CallOption) (*app.AppConsumption, error)
+	ListTopConsumingApps(ctx context.Context, in *ListTopConsumingAppsReq, opts ...grpc.CallOption) (*ListTopConsumingAppsResp, error)
+	ListStaleResources(ctx context.Context, in *ListStaleResourcesReq, opts ...grpc.CallOption) (*ListStaleResourcesResp, error)
+	ListBizUsages(ctx context.Context, in *ListBizUsagesReq, opts ...grpc.CallOption) (*ListBizUsagesResp, error)
+	// service account related interface.
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*CreateServiceAccountResp, error)
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsReq, opts ...grpc.CallOption) (*ListServiceAccountsResp, error)
+	UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ResetServiceAccountKey(ctx context.Context, in *ResetServiceAccountKeyReq, opts ...grpc.CallOption) (*ResetServiceAccountKeyResp, error)
+	GetServiceAccountByKey(ctx context.Context, in *GetServiceAccountByKeyReq, opts ...grpc.CallOption) (*service_account.ServiceAccount, error)
+	GetServiceAccountByName(ctx context.Context, in *GetServiceAccountByNameReq, opts ...grpc.CallOption) (*service_account.ServiceAccount, error)
+	// app role binding related interface.
+	SetAppRoleBinding(ctx context.Context, in *SetAppRoleBindingReq, opts ...grpc.CallOption) (*CreateResp, error)
+	ListAppRoleBindings(ctx context.Context, in *ListAppRoleBindingsReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error)
+	DeleteAppRoleBinding(ctx context.Context, in *DeleteAppRoleBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	ListUserAppRoles(ctx context.Context, in *ListUserAppRolesReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error)
 	// template space related interface.
 	CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateResp, error)
 	ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error)
@@ -376,6 +625,8 @@ type DataClient interface {
 	UpdateGroup(ctx context.Context, in *UpdateGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	DeleteGroup(ctx context.Context, in *DeleteGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error)
+	PreviewGroupClients(ctx context.Context, in *PreviewGroupClientsReq, opts ...grpc.CallOption) (*PreviewGroupClientsResp, error)
+	PreviewClientRelease(ctx context.Context, in *PreviewClientReleaseReq, opts ...grpc.CallOption) (*client_preview.ClientReleasePreview, error)
 	// group current release related interface.
 	CountGroupsReleasedApps(ctx context.Context, in *CountGroupsReleasedAppsReq, opts ...grpc.CallOption) (*CountGroupsReleasedAppsResp, error)
 	ListGroupReleasedApps(ctx context.Context, in *ListGroupReleasedAppsReq, opts ...grpc.CallOption) (*ListGroupReleasedAppsResp, error)
@@ -385,10 +636,29 @@ type DataClient interface {
 	Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error)
 	GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error)
 	GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error)
+	ListRecentPublishes(ctx context.Context, in *ListRecentPublishesReq, opts ...grpc.CallOption) (*ListRecentPublishesResp, error)
 	GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error)
+	EmergencyPublish(ctx context.Context, in *EmergencyPublishReq, opts ...grpc.CallOption) (*EmergencyPublishResp, error)
+	ListEmergencyPublishes(ctx context.Context, in *ListEmergencyPublishesReq, opts ...grpc.CallOption) (*ListEmergencyPublishesResp, error)
+	RevokeEmergencyPublish(ctx context.Context, in *RevokeEmergencyPublishReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// pin the online group to it's release, the subsequent publishes skip it until it's unpinned.
+	PinReleasedGroup(ctx context.Context, in *PinReleasedGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	UnpinReleasedGroup(ctx context.Context, in *UnpinReleasedGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error)
+	// commit config items or kvs and create a release in one call, optionally publish it.
+	BulkRelease(ctx context.Context, in *BulkReleaseReq, opts ...grpc.CallOption) (*BulkReleaseResp, error)
+	// idempotency key related interface.
+	BeginIdempotentRequest(ctx context.Context, in *BeginIdempotentRequestReq, opts ...grpc.CallOption) (*BeginIdempotentRequestResp, error)
+	CompleteIdempotentRequest(ctx context.Context, in *CompleteIdempotentRequestReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	AbortIdempotentRequest(ctx context.Context, in *AbortIdempotentRequestReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
+	// async operation related interface.
+	GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*operation.Operation, error)
+	ListOperations(ctx context.Context, in *ListOperationsReq, opts ...grpc.CallOption) (*ListOperationsResp, error)
+	CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	// audit related interface.
 	ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error)
+	// list the change timeline of the app, which combines edits, releases, publishes, rollbacks and strategy changes.
+	ListAppTimeline(ctx context.Context, in *ListAppTimelineReq, opts ...grpc.CallOption) (*ListAppTimelineResp, error)
 	// credential related interface
 	CreateCredential(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateResp, error)
 	ListCredentials(ctx context.Context, in *ListCredentialReq, opts ...grpc.CallOption) (*ListCredentialResp, error)
@@ -405,11 +675,15 @@ type DataClient interface {
 	ListKvs(ctx context.Context, in *ListKvsReq, opts ...grpc.CallOption) (*ListKvsResp, error)
 	DeleteKv(ctx context.Context, in *DeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error)
+	TransactKvs(ctx context.Context, in *TransactKvsReq, opts ...grpc.CallOption) (*TransactKvsResp, error)
+	ListKvHistory(ctx context.Context, in *ListKvHistoryReq, opts ...grpc.CallOption) (*ListKvHistoryResp, error)
+	DiffKvVersions(ctx context.Context, in *DiffKvVersionsReq, opts ...grpc.CallOption) (*DiffKvVersionsResp, error)
 	UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
 	KvFetchIDsExcluding(ctx context.Context, in *KvFetchIDsExcludingReq, opts ...grpc.CallOption) (*KvFetchIDsExcludingResp, error)
 	KvFetchKeysExcluding(ctx context.Context, in *KvFetchKeysExcludingReq, opts ...grpc.CallOption) (*KvFetchKeysExcludingResp, error)
 	FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error)
+	CompareKvValue(ctx context.Context, in *CompareKvValueReq, opts ...grpc.CallOption) (*CompareKvValueResp, error)
 	// client related interface
 	ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error)
 	RetryClients(ctx context.Context, in *RetryClientsReq, opts ...grpc.CallOption) (*base.EmptyResp, error)
@@ -461,6 +735,33 @@ func (c *dataClient) CreateApp(ctx context.Context, in *CreateAppReq, opts ...gr
 	return out, nil
 }
 
+func (c *dataClient) UpdateAppState(ctx context.Context, in *UpdateAppStateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateAppState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) PauseConsumption(ctx context.Context, in *PauseConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_PauseConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ResumeConsumption(ctx context.Context, in *ResumeConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_ResumeConsumption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataClient) UpdateApp(ctx context.Context, in *UpdateAppReq, opts ...grpc.CallOption) (*app.App, error) {
 	out := new(app.App)
 	err := c.cc.Invoke(ctx, Data_UpdateApp_FullMethodName, in, out, opts...)
@@ -938,2949 +1239,6340 @@ func (c *dataClient) GetReleaseHook(ctx context.Context, in *GetReleaseHookReq,
 	return out, nil
 }
 
-func (c *dataClient) CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateTemplateSpace_FullMethodName, in, out, opts...)
+func (c *dataClient) BatchCreateHookExecResults(ctx context.Context, in *BatchCreateHookExecResultsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_BatchCreateHookExecResults_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error) {
-	out := new(ListTemplateSpacesResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateSpaces_FullMethodName, in, out, opts...)
+func (c *dataClient) ListHookExecResults(ctx context.Context, in *ListHookExecResultsReq, opts ...grpc.CallOption) (*ListHookExecResultsResp, error) {
+	out := new(ListHookExecResultsResp)
+	err := c.cc.Invoke(ctx, Data_ListHookExecResults_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateTemplateSpace(ctx context.Context, in *UpdateTemplateSpaceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) BatchCreateFileTamperEvents(ctx context.Context, in *BatchCreateFileTamperEventsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateTemplateSpace_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_BatchCreateFileTamperEvents_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTemplateSpace(ctx context.Context, in *DeleteTemplateSpaceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTemplateSpace_FullMethodName, in, out, opts...)
+func (c *dataClient) ListFileTamperEvents(ctx context.Context, in *ListFileTamperEventsReq, opts ...grpc.CallOption) (*ListFileTamperEventsResp, error) {
+	out := new(ListFileTamperEventsResp)
+	err := c.cc.Invoke(ctx, Data_ListFileTamperEvents_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error) {
-	out := new(GetAllBizsOfTmplSpacesResp)
-	err := c.cc.Invoke(ctx, Data_GetAllBizsOfTmplSpaces_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateHookTemplate(ctx context.Context, in *CreateHookTemplateReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateDefaultTmplSpace(ctx context.Context, in *CreateDefaultTmplSpaceReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateDefaultTmplSpace_FullMethodName, in, out, opts...)
+func (c *dataClient) ListHookTemplates(ctx context.Context, in *ListHookTemplatesReq, opts ...grpc.CallOption) (*ListHookTemplatesResp, error) {
+	out := new(ListHookTemplatesResp)
+	err := c.cc.Invoke(ctx, Data_ListHookTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplSpacesByIDs(ctx context.Context, in *ListTmplSpacesByIDsReq, opts ...grpc.CallOption) (*ListTmplSpacesByIDsResp, error) {
-	out := new(ListTmplSpacesByIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplSpacesByIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateHookTemplate(ctx context.Context, in *UpdateHookTemplateReq, opts ...grpc.CallOption) (*UpdateHookTemplateResp, error) {
+	out := new(UpdateHookTemplateResp)
+	err := c.cc.Invoke(ctx, Data_UpdateHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateTemplate(ctx context.Context, in *CreateTemplateReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteHookTemplate(ctx context.Context, in *DeleteHookTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteHookTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
-	out := new(ListTemplatesResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplates_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateHookFromTemplate(ctx context.Context, in *CreateHookFromTemplateReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateHookFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) UpgradeHookFromTemplate(ctx context.Context, in *UpgradeHookFromTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateTemplate_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_UpgradeHookFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) GetHookTemplateRef(ctx context.Context, in *GetHookTemplateRefReq, opts ...grpc.CallOption) (*GetHookTemplateRefResp, error) {
+	out := new(GetHookTemplateRefResp)
+	err := c.cc.Invoke(ctx, Data_GetHookTemplateRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchDeleteTemplate(ctx context.Context, in *BatchDeleteTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_BatchDeleteTemplate_FullMethodName, in, out, opts...)
+func (c *dataClient) UpgradeHooksFromTemplate(ctx context.Context, in *UpgradeHooksFromTemplateReq, opts ...grpc.CallOption) (*CreateOperationResp, error) {
+	out := new(CreateOperationResp)
+	err := c.cc.Invoke(ctx, Data_UpgradeHooksFromTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) AddTmplsToTmplSets(ctx context.Context, in *AddTmplsToTmplSetsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_AddTmplsToTmplSets_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateEnvironment(ctx context.Context, in *CreateEnvironmentReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTmplsFromTmplSets(ctx context.Context, in *DeleteTmplsFromTmplSetsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTmplsFromTmplSets_FullMethodName, in, out, opts...)
+func (c *dataClient) ListEnvironments(ctx context.Context, in *ListEnvironmentsReq, opts ...grpc.CallOption) (*ListEnvironmentsResp, error) {
+	out := new(ListEnvironmentsResp)
+	err := c.cc.Invoke(ctx, Data_ListEnvironments_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplatesByIDs(ctx context.Context, in *ListTemplatesByIDsReq, opts ...grpc.CallOption) (*ListTemplatesByIDsResp, error) {
-	out := new(ListTemplatesByIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplatesByIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplatesNotBound(ctx context.Context, in *ListTemplatesNotBoundReq, opts ...grpc.CallOption) (*ListTemplatesNotBoundResp, error) {
-	out := new(ListTemplatesNotBoundResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplatesNotBound_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteEnvironment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplsOfTmplSet(ctx context.Context, in *ListTmplsOfTmplSetReq, opts ...grpc.CallOption) (*ListTmplsOfTmplSetResp, error) {
-	out := new(ListTmplsOfTmplSetResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplsOfTmplSet_FullMethodName, in, out, opts...)
+func (c *dataClient) BindEnvApp(ctx context.Context, in *BindEnvAppReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_BindEnvApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateSetsAndRevisions(ctx context.Context, in *ListTemplateSetsAndRevisionsReq, opts ...grpc.CallOption) (*ListTemplateSetsAndRevisionsResp, error) {
-	out := new(ListTemplateSetsAndRevisionsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateSetsAndRevisions_FullMethodName, in, out, opts...)
+func (c *dataClient) UnbindEnvApp(ctx context.Context, in *UnbindEnvAppReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UnbindEnvApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateByTuple(ctx context.Context, in *ListTemplateByTupleReq, opts ...grpc.CallOption) (*ListTemplateByTupleReqResp, error) {
-	out := new(ListTemplateByTupleReqResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateByTuple_FullMethodName, in, out, opts...)
+func (c *dataClient) ListEnvApps(ctx context.Context, in *ListEnvAppsReq, opts ...grpc.CallOption) (*ListEnvAppsResp, error) {
+	out := new(ListEnvAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListEnvApps_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpsertTemplates(ctx context.Context, in *BatchUpsertTemplatesReq, opts ...grpc.CallOption) (*BatchUpsertTemplatesReqResp, error) {
-	out := new(BatchUpsertTemplatesReqResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpsertTemplates_FullMethodName, in, out, opts...)
+func (c *dataClient) PromoteRelease(ctx context.Context, in *PromoteReleaseReq, opts ...grpc.CallOption) (*PromoteReleaseResp, error) {
+	out := new(PromoteReleaseResp)
+	err := c.cc.Invoke(ctx, Data_PromoteRelease_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpdateTemplatePermissions(ctx context.Context, in *BatchUpdateTemplatePermissionsReq, opts ...grpc.CallOption) (*BatchUpdateTemplatePermissionsResp, error) {
-	out := new(BatchUpdateTemplatePermissionsResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpdateTemplatePermissions_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateConfigShare(ctx context.Context, in *CreateConfigShareReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateTemplateRevision(ctx context.Context, in *CreateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateTemplateRevision_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateConfigShare(ctx context.Context, in *UpdateConfigShareReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateTemplateRevision(ctx context.Context, in *UpdateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_UpdateTemplateRevision_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteConfigShare(ctx context.Context, in *DeleteConfigShareReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteConfigShare_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsResp, error) {
-	out := new(ListTemplateRevisionsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateRevisions_FullMethodName, in, out, opts...)
+func (c *dataClient) ListConfigShares(ctx context.Context, in *ListConfigSharesReq, opts ...grpc.CallOption) (*ListConfigSharesResp, error) {
+	out := new(ListConfigSharesResp)
+	err := c.cc.Invoke(ctx, Data_ListConfigShares_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionReq, opts ...grpc.CallOption) (*GetTemplateRevisionResp, error) {
-	out := new(GetTemplateRevisionResp)
-	err := c.cc.Invoke(ctx, Data_GetTemplateRevision_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateConfigShareRef(ctx context.Context, in *CreateConfigShareRefReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateConfigShareRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTemplateRevision(ctx context.Context, in *DeleteTemplateRevisionReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) DeleteConfigShareRef(ctx context.Context, in *DeleteConfigShareRefReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTemplateRevision_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_DeleteConfigShareRef_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateRevisionsByIDs(ctx context.Context, in *ListTemplateRevisionsByIDsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsByIDsResp, error) {
-	out := new(ListTemplateRevisionsByIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateRevisionsByIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) ListConfigShareRefs(ctx context.Context, in *ListConfigShareRefsReq, opts ...grpc.CallOption) (*ListConfigShareRefsResp, error) {
+	out := new(ListConfigShareRefsResp)
+	err := c.cc.Invoke(ctx, Data_ListConfigShareRefs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplRevisionNamesByTmplIDs(ctx context.Context, in *ListTmplRevisionNamesByTmplIDsReq, opts ...grpc.CallOption) (*ListTmplRevisionNamesByTmplIDsResp, error) {
-	out := new(ListTmplRevisionNamesByTmplIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplRevisionNamesByTmplIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) ListRecycleBin(ctx context.Context, in *ListRecycleBinReq, opts ...grpc.CallOption) (*ListRecycleBinResp, error) {
+	out := new(ListRecycleBinResp)
+	err := c.cc.Invoke(ctx, Data_ListRecycleBin_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateTemplateSet(ctx context.Context, in *CreateTemplateSetReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateTemplateSet_FullMethodName, in, out, opts...)
+func (c *dataClient) GetRecycleBin(ctx context.Context, in *GetRecycleBinReq, opts ...grpc.CallOption) (*recycle_bin.RecycleBin, error) {
+	out := new(recycle_bin.RecycleBin)
+	err := c.cc.Invoke(ctx, Data_GetRecycleBin_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateSets(ctx context.Context, in *ListTemplateSetsReq, opts ...grpc.CallOption) (*ListTemplateSetsResp, error) {
-	out := new(ListTemplateSetsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateSets_FullMethodName, in, out, opts...)
+func (c *dataClient) RestoreRecycleBin(ctx context.Context, in *RestoreRecycleBinReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RestoreRecycleBin_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateTemplateSet(ctx context.Context, in *UpdateTemplateSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateTemplateSet_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateAppSnapshot(ctx context.Context, in *CreateAppSnapshotReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTemplateSet(ctx context.Context, in *DeleteTemplateSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTemplateSet_FullMethodName, in, out, opts...)
+func (c *dataClient) ListAppSnapshots(ctx context.Context, in *ListAppSnapshotsReq, opts ...grpc.CallOption) (*ListAppSnapshotsResp, error) {
+	out := new(ListAppSnapshotsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppSnapshots_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAppTemplateSets(ctx context.Context, in *ListAppTemplateSetsReq, opts ...grpc.CallOption) (*ListAppTemplateSetsResp, error) {
-	out := new(ListAppTemplateSetsResp)
-	err := c.cc.Invoke(ctx, Data_ListAppTemplateSets_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteAppSnapshot(ctx context.Context, in *DeleteAppSnapshotReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateSetsByIDs(ctx context.Context, in *ListTemplateSetsByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetsByIDsResp, error) {
-	out := new(ListTemplateSetsByIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateSetsByIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) RestoreAppSnapshot(ctx context.Context, in *RestoreAppSnapshotReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RestoreAppSnapshot_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateSetBriefInfoByIDs(ctx context.Context, in *ListTemplateSetBriefInfoByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetBriefInfoByIDsResp, error) {
-	out := new(ListTemplateSetBriefInfoByIDsResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateSetBriefInfoByIDs_FullMethodName, in, out, opts...)
+func (c *dataClient) CloneApp(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CloneApp_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplSetsOfBiz(ctx context.Context, in *ListTmplSetsOfBizReq, opts ...grpc.CallOption) (*ListTmplSetsOfBizResp, error) {
-	out := new(ListTmplSetsOfBizResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplSetsOfBiz_FullMethodName, in, out, opts...)
+func (c *dataClient) CloneAppAsync(ctx context.Context, in *CloneAppReq, opts ...grpc.CallOption) (*CreateOperationResp, error) {
+	out := new(CreateOperationResp)
+	err := c.cc.Invoke(ctx, Data_CloneAppAsync_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetLatestTemplateVersionsInSpace(ctx context.Context, in *GetLatestTemplateVersionsInSpaceReq, opts ...grpc.CallOption) (*GetLatestTemplateVersionsInSpaceResp, error) {
-	out := new(GetLatestTemplateVersionsInSpaceResp)
-	err := c.cc.Invoke(ctx, Data_GetLatestTemplateVersionsInSpace_FullMethodName, in, out, opts...)
+func (c *dataClient) UpsertDraftItem(ctx context.Context, in *UpsertDraftItemReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpsertDraftItem_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateAppTemplateBinding(ctx context.Context, in *CreateAppTemplateBindingReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteDraftItem(ctx context.Context, in *DeleteDraftItemReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteDraftItem_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAppTemplateBindings(ctx context.Context, in *ListAppTemplateBindingsReq, opts ...grpc.CallOption) (*ListAppTemplateBindingsResp, error) {
-	out := new(ListAppTemplateBindingsResp)
-	err := c.cc.Invoke(ctx, Data_ListAppTemplateBindings_FullMethodName, in, out, opts...)
+func (c *dataClient) GetDraft(ctx context.Context, in *GetDraftReq, opts ...grpc.CallOption) (*draft.Draft, error) {
+	out := new(draft.Draft)
+	err := c.cc.Invoke(ctx, Data_GetDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateAppTemplateBinding(ctx context.Context, in *UpdateAppTemplateBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) MergeDraft(ctx context.Context, in *MergeDraftReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateAppTemplateBinding_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_MergeDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteAppTemplateBinding(ctx context.Context, in *DeleteAppTemplateBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) DiscardDraft(ctx context.Context, in *DiscardDraftReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteAppTemplateBinding_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_DiscardDraft_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAppBoundTmplRevisions(ctx context.Context, in *ListAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListAppBoundTmplRevisionsResp, error) {
-	out := new(ListAppBoundTmplRevisionsResp)
-	err := c.cc.Invoke(ctx, Data_ListAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+func (c *dataClient) SearchFullText(ctx context.Context, in *SearchFullTextReq, opts ...grpc.CallOption) (*SearchFullTextResp, error) {
+	out := new(SearchFullTextResp)
+	err := c.cc.Invoke(ctx, Data_SearchFullText_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListReleasedAppBoundTmplRevisions(ctx context.Context, in *ListReleasedAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListReleasedAppBoundTmplRevisionsResp, error) {
-	out := new(ListReleasedAppBoundTmplRevisionsResp)
-	err := c.cc.Invoke(ctx, Data_ListReleasedAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTmplWhereUsed(ctx context.Context, in *ListTmplWhereUsedReq, opts ...grpc.CallOption) (*ListWhereUsedResp, error) {
+	out := new(ListWhereUsedResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplWhereUsed_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetReleasedAppBoundTmplRevision(ctx context.Context, in *GetReleasedAppBoundTmplRevisionReq, opts ...grpc.CallOption) (*GetReleasedAppBoundTmplRevisionResp, error) {
-	out := new(GetReleasedAppBoundTmplRevisionResp)
-	err := c.cc.Invoke(ctx, Data_GetReleasedAppBoundTmplRevision_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTmplVariableWhereUsed(ctx context.Context, in *ListTmplVariableWhereUsedReq, opts ...grpc.CallOption) (*ListWhereUsedResp, error) {
+	out := new(ListWhereUsedResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplVariableWhereUsed_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CheckAppTemplateBinding(ctx context.Context, in *CheckAppTemplateBindingReq, opts ...grpc.CallOption) (*CheckAppTemplateBindingResp, error) {
-	out := new(CheckAppTemplateBindingResp)
-	err := c.cc.Invoke(ctx, Data_CheckAppTemplateBinding_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateConfigReference(ctx context.Context, in *CreateConfigReferenceReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateConfigReference_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ImportFromTemplateSetToApp(ctx context.Context, in *ImportFromTemplateSetToAppReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) DeleteConfigReference(ctx context.Context, in *DeleteConfigReferenceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_ImportFromTemplateSetToApp_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_DeleteConfigReference_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ExtractAppTmplVariables(ctx context.Context, in *ExtractAppTmplVariablesReq, opts ...grpc.CallOption) (*ExtractAppTmplVariablesResp, error) {
-	out := new(ExtractAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Data_ExtractAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) GetConfigRefGraph(ctx context.Context, in *GetConfigRefGraphReq, opts ...grpc.CallOption) (*GetConfigRefGraphResp, error) {
+	out := new(GetConfigRefGraphResp)
+	err := c.cc.Invoke(ctx, Data_GetConfigRefGraph_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetAppTmplVariableRefs(ctx context.Context, in *GetAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetAppTmplVariableRefsResp, error) {
-	out := new(GetAppTmplVariableRefsResp)
-	err := c.cc.Invoke(ctx, Data_GetAppTmplVariableRefs_FullMethodName, in, out, opts...)
+func (c *dataClient) ListExpiringCertificates(ctx context.Context, in *ListExpiringCertificatesReq, opts ...grpc.CallOption) (*ListExpiringCertificatesResp, error) {
+	out := new(ListExpiringCertificatesResp)
+	err := c.cc.Invoke(ctx, Data_ListExpiringCertificates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetReleasedAppTmplVariableRefs(ctx context.Context, in *GetReleasedAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetReleasedAppTmplVariableRefsResp, error) {
-	out := new(GetReleasedAppTmplVariableRefsResp)
-	err := c.cc.Invoke(ctx, Data_GetReleasedAppTmplVariableRefs_FullMethodName, in, out, opts...)
+func (c *dataClient) RenewCertificate(ctx context.Context, in *RenewCertificateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RenewCertificate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateAppTmplVariables(ctx context.Context, in *UpdateAppTmplVariablesReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateKvOverride(ctx context.Context, in *CreateKvOverrideReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error) {
-	out := new(ListAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Data_ListAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateKvOverride(ctx context.Context, in *UpdateKvOverrideReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error) {
-	out := new(ListReleasedAppTmplVariablesResp)
-	err := c.cc.Invoke(ctx, Data_ListReleasedAppTmplVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteKvOverride(ctx context.Context, in *DeleteKvOverrideReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteKvOverride_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error) {
-	out := new(ListTmplBoundCountsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplBoundCounts_FullMethodName, in, out, opts...)
+func (c *dataClient) ListKvOverrides(ctx context.Context, in *ListKvOverridesReq, opts ...grpc.CallOption) (*ListKvOverridesResp, error) {
+	out := new(ListKvOverridesResp)
+	err := c.cc.Invoke(ctx, Data_ListKvOverrides_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplRevisionBoundCounts(ctx context.Context, in *ListTmplRevisionBoundCountsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundCountsResp, error) {
-	out := new(ListTmplRevisionBoundCountsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundCounts_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateCompliancePolicy(ctx context.Context, in *CreateCompliancePolicyReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplSetBoundCounts(ctx context.Context, in *ListTmplSetBoundCountsReq, opts ...grpc.CallOption) (*ListTmplSetBoundCountsResp, error) {
-	out := new(ListTmplSetBoundCountsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundCounts_FullMethodName, in, out, opts...)
+func (c *dataClient) ListCompliancePolicies(ctx context.Context, in *ListCompliancePoliciesReq, opts ...grpc.CallOption) (*ListCompliancePoliciesResp, error) {
+	out := new(ListCompliancePoliciesResp)
+	err := c.cc.Invoke(ctx, Data_ListCompliancePolicies_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplBoundUnnamedApps(ctx context.Context, in *ListTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundUnnamedAppsResp, error) {
-	out := new(ListTmplBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateCompliancePolicy(ctx context.Context, in *UpdateCompliancePolicyReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplBoundNamedApps(ctx context.Context, in *ListTmplBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundNamedAppsResp, error) {
-	out := new(ListTmplBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteCompliancePolicy(ctx context.Context, in *DeleteCompliancePolicyReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplBoundTmplSets(ctx context.Context, in *ListTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListTmplBoundTmplSetsResp, error) {
-	out := new(ListTmplBoundTmplSetsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplBoundTmplSets_FullMethodName, in, out, opts...)
+func (c *dataClient) TestCompliancePolicy(ctx context.Context, in *TestCompliancePolicyReq, opts ...grpc.CallOption) (*TestCompliancePolicyResp, error) {
+	out := new(TestCompliancePolicyResp)
+	err := c.cc.Invoke(ctx, Data_TestCompliancePolicy_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListMultiTmplBoundTmplSets(ctx context.Context, in *ListMultiTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListMultiTmplBoundTmplSetsResp, error) {
-	out := new(ListMultiTmplBoundTmplSetsResp)
-	err := c.cc.Invoke(ctx, Data_ListMultiTmplBoundTmplSets_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateNotificationChannel(ctx context.Context, in *CreateNotificationChannelReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplRevisionBoundUnnamedApps(ctx context.Context, in *ListTmplRevisionBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
-	out := new(ListTmplRevisionBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) ListNotificationChannels(ctx context.Context, in *ListNotificationChannelsReq, opts ...grpc.CallOption) (*ListNotificationChannelsResp, error) {
+	out := new(ListNotificationChannelsResp)
+	err := c.cc.Invoke(ctx, Data_ListNotificationChannels_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplRevisionBoundNamedApps(ctx context.Context, in *ListTmplRevisionBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundNamedAppsResp, error) {
-	out := new(ListTmplRevisionBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateNotificationChannel(ctx context.Context, in *UpdateNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplSetBoundUnnamedApps(ctx context.Context, in *ListTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundUnnamedAppsResp, error) {
-	out := new(ListTmplSetBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteNotificationChannel(ctx context.Context, in *DeleteNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListMultiTmplSetBoundUnnamedApps(ctx context.Context, in *ListMultiTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
-	out := new(ListMultiTmplSetBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListMultiTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) TestNotificationChannel(ctx context.Context, in *TestNotificationChannelReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_TestNotificationChannel_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTmplSetBoundNamedApps(ctx context.Context, in *ListTmplSetBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundNamedAppsResp, error) {
-	out := new(ListTmplSetBoundNamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundNamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListLatestTmplBoundUnnamedApps(ctx context.Context, in *ListLatestTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListLatestTmplBoundUnnamedAppsResp, error) {
-	out := new(ListLatestTmplBoundUnnamedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListLatestTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) ListAlertRules(ctx context.Context, in *ListAlertRulesReq, opts ...grpc.CallOption) (*ListAlertRulesResp, error) {
+	out := new(ListAlertRulesResp)
+	err := c.cc.Invoke(ctx, Data_ListAlertRules_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) RemoveAppBoundTmplSet(ctx context.Context, in *RemoveAppBoundTmplSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) UpdateAlertRule(ctx context.Context, in *UpdateAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_RemoveAppBoundTmplSet_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_UpdateAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CheckTemplateSetReferencesApps(ctx context.Context, in *CheckTemplateSetReferencesAppsReq, opts ...grpc.CallOption) (*CheckTemplateSetReferencesAppsResp, error) {
-	out := new(CheckTemplateSetReferencesAppsResp)
-	err := c.cc.Invoke(ctx, Data_CheckTemplateSetReferencesApps_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteAlertRule(ctx context.Context, in *DeleteAlertRuleReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteAlertRule_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateTemplateVariable(ctx context.Context, in *CreateTemplateVariableReq, opts ...grpc.CallOption) (*CreateResp, error) {
+func (c *dataClient) CreateClientQuarantine(ctx context.Context, in *CreateClientQuarantineReq, opts ...grpc.CallOption) (*CreateResp, error) {
 	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateTemplateVariable_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_CreateClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListTemplateVariables(ctx context.Context, in *ListTemplateVariablesReq, opts ...grpc.CallOption) (*ListTemplateVariablesResp, error) {
-	out := new(ListTemplateVariablesResp)
-	err := c.cc.Invoke(ctx, Data_ListTemplateVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) ListClientQuarantines(ctx context.Context, in *ListClientQuarantinesReq, opts ...grpc.CallOption) (*ListClientQuarantinesResp, error) {
+	out := new(ListClientQuarantinesResp)
+	err := c.cc.Invoke(ctx, Data_ListClientQuarantines_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateTemplateVariable(ctx context.Context, in *UpdateTemplateVariableReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) UpdateClientQuarantine(ctx context.Context, in *UpdateClientQuarantineReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateTemplateVariable_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_UpdateClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteTemplateVariable(ctx context.Context, in *DeleteTemplateVariableReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) DeleteClientQuarantine(ctx context.Context, in *DeleteClientQuarantineReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteTemplateVariable_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_DeleteClientQuarantine_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) TemplateVariableFetchIDsExcluding(ctx context.Context, in *TemplateVariableFetchIDsExcludingReq, opts ...grpc.CallOption) (*TemplateVariableFetchIDsExcludingResp, error) {
-	out := new(TemplateVariableFetchIDsExcludingResp)
-	err := c.cc.Invoke(ctx, Data_TemplateVariableFetchIDsExcluding_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateExperiment(ctx context.Context, in *CreateExperimentReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ImportTemplateVariables(ctx context.Context, in *ImportTemplateVariablesReq, opts ...grpc.CallOption) (*ImportTemplateVariablesResp, error) {
-	out := new(ImportTemplateVariablesResp)
-	err := c.cc.Invoke(ctx, Data_ImportTemplateVariables_FullMethodName, in, out, opts...)
+func (c *dataClient) ListExperiments(ctx context.Context, in *ListExperimentsReq, opts ...grpc.CallOption) (*ListExperimentsResp, error) {
+	out := new(ListExperimentsResp)
+	err := c.cc.Invoke(ctx, Data_ListExperiments_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateGroup_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateExperiment(ctx context.Context, in *UpdateExperimentReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAllGroups(ctx context.Context, in *ListAllGroupsReq, opts ...grpc.CallOption) (*ListAllGroupsResp, error) {
-	out := new(ListAllGroupsResp)
-	err := c.cc.Invoke(ctx, Data_ListAllGroups_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteExperiment(ctx context.Context, in *DeleteExperimentReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteExperiment_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAppGroups(ctx context.Context, in *ListAppGroupsReq, opts ...grpc.CallOption) (*ListAppGroupsResp, error) {
-	out := new(ListAppGroupsResp)
-	err := c.cc.Invoke(ctx, Data_ListAppGroups_FullMethodName, in, out, opts...)
+func (c *dataClient) GetExperimentStats(ctx context.Context, in *GetExperimentStatsReq, opts ...grpc.CallOption) (*GetExperimentStatsResp, error) {
+	out := new(GetExperimentStatsResp)
+	err := c.cc.Invoke(ctx, Data_GetExperimentStats_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error) {
-	out := new(group.Group)
-	err := c.cc.Invoke(ctx, Data_GetGroupByName_FullMethodName, in, out, opts...)
+func (c *dataClient) BatchUpsertExperimentExposures(ctx context.Context, in *BatchUpsertExperimentExposuresReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpsertExperimentExposures_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateGroup(ctx context.Context, in *UpdateGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateGroup_FullMethodName, in, out, opts...)
+func (c *dataClient) GetMaskingProfile(ctx context.Context, in *GetMaskingProfileReq, opts ...grpc.CallOption) (*GetMaskingProfileResp, error) {
+	out := new(GetMaskingProfileResp)
+	err := c.cc.Invoke(ctx, Data_GetMaskingProfile_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteGroup(ctx context.Context, in *DeleteGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) UpdateMaskingProfile(ctx context.Context, in *UpdateMaskingProfileReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteGroup_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_UpdateMaskingProfile_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error) {
-	out := new(ListGroupSelectorResp)
-	err := c.cc.Invoke(ctx, Data_ListGroupSelector_FullMethodName, in, out, opts...)
+func (c *dataClient) GetSecretScanSetting(ctx context.Context, in *GetSecretScanSettingReq, opts ...grpc.CallOption) (*GetSecretScanSettingResp, error) {
+	out := new(GetSecretScanSettingResp)
+	err := c.cc.Invoke(ctx, Data_GetSecretScanSetting_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CountGroupsReleasedApps(ctx context.Context, in *CountGroupsReleasedAppsReq, opts ...grpc.CallOption) (*CountGroupsReleasedAppsResp, error) {
-	out := new(CountGroupsReleasedAppsResp)
-	err := c.cc.Invoke(ctx, Data_CountGroupsReleasedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateSecretScanSetting(ctx context.Context, in *UpdateSecretScanSettingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateSecretScanSetting_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListGroupReleasedApps(ctx context.Context, in *ListGroupReleasedAppsReq, opts ...grpc.CallOption) (*ListGroupReleasedAppsResp, error) {
-	out := new(ListGroupReleasedAppsResp)
-	err := c.cc.Invoke(ctx, Data_ListGroupReleasedApps_FullMethodName, in, out, opts...)
+func (c *dataClient) ScanSecretLeak(ctx context.Context, in *ScanSecretLeakReq, opts ...grpc.CallOption) (*ScanSecretLeakResp, error) {
+	out := new(ScanSecretLeakResp)
+	err := c.cc.Invoke(ctx, Data_ScanSecretLeak_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Data_Publish_FullMethodName, in, out, opts...)
+func (c *dataClient) BatchIncreaseAppConsumption(ctx context.Context, in *BatchIncreaseAppConsumptionReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_BatchIncreaseAppConsumption_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Data_SubmitPublishApprove_FullMethodName, in, out, opts...)
+func (c *dataClient) GetAppConsumption(ctx context.Context, in *GetAppConsumptionReq, opts ...grpc.CallOption) (*app.AppConsumption, error) {
+	out := new(app.AppConsumption)
+	err := c.cc.Invoke(ctx, Data_GetAppConsumption_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error) {
-	out := new(ApproveResp)
-	err := c.cc.Invoke(ctx, Data_Approve_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTopConsumingApps(ctx context.Context, in *ListTopConsumingAppsReq, opts ...grpc.CallOption) (*ListTopConsumingAppsResp, error) {
+	out := new(ListTopConsumingAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTopConsumingApps_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error) {
-	out := new(GetLastSelectResp)
-	err := c.cc.Invoke(ctx, Data_GetLastSelect_FullMethodName, in, out, opts...)
+func (c *dataClient) ListStaleResources(ctx context.Context, in *ListStaleResourcesReq, opts ...grpc.CallOption) (*ListStaleResourcesResp, error) {
+	out := new(ListStaleResourcesResp)
+	err := c.cc.Invoke(ctx, Data_ListStaleResources_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error) {
-	out := new(GetLastPublishResp)
-	err := c.cc.Invoke(ctx, Data_GetLastPublish_FullMethodName, in, out, opts...)
+func (c *dataClient) ListBizUsages(ctx context.Context, in *ListBizUsagesReq, opts ...grpc.CallOption) (*ListBizUsagesResp, error) {
+	out := new(ListBizUsagesResp)
+	err := c.cc.Invoke(ctx, Data_ListBizUsages_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error) {
-	out := new(strategy.Strategy)
-	err := c.cc.Invoke(ctx, Data_GetReleasesStatus_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountReq, opts ...grpc.CallOption) (*CreateServiceAccountResp, error) {
+	out := new(CreateServiceAccountResp)
+	err := c.cc.Invoke(ctx, Data_CreateServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
-	out := new(PublishResp)
-	err := c.cc.Invoke(ctx, Data_GenerateReleaseAndPublish_FullMethodName, in, out, opts...)
+func (c *dataClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsReq, opts ...grpc.CallOption) (*ListServiceAccountsResp, error) {
+	out := new(ListServiceAccountsResp)
+	err := c.cc.Invoke(ctx, Data_ListServiceAccounts_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error) {
-	out := new(ListAuditsResp)
-	err := c.cc.Invoke(ctx, Data_ListAudits_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateServiceAccount(ctx context.Context, in *UpdateServiceAccountReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateCredential(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateResp, error) {
-	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateCredential_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteServiceAccount_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListCredentials(ctx context.Context, in *ListCredentialReq, opts ...grpc.CallOption) (*ListCredentialResp, error) {
-	out := new(ListCredentialResp)
-	err := c.cc.Invoke(ctx, Data_ListCredentials_FullMethodName, in, out, opts...)
+func (c *dataClient) ResetServiceAccountKey(ctx context.Context, in *ResetServiceAccountKeyReq, opts ...grpc.CallOption) (*ResetServiceAccountKeyResp, error) {
+	out := new(ResetServiceAccountKeyResp)
+	err := c.cc.Invoke(ctx, Data_ResetServiceAccountKey_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteCredential(ctx context.Context, in *DeleteCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteCredential_FullMethodName, in, out, opts...)
+func (c *dataClient) GetServiceAccountByKey(ctx context.Context, in *GetServiceAccountByKeyReq, opts ...grpc.CallOption) (*service_account.ServiceAccount, error) {
+	out := new(service_account.ServiceAccount)
+	err := c.cc.Invoke(ctx, Data_GetServiceAccountByKey_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateCredential(ctx context.Context, in *UpdateCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateCredential_FullMethodName, in, out, opts...)
+func (c *dataClient) GetServiceAccountByName(ctx context.Context, in *GetServiceAccountByNameReq, opts ...grpc.CallOption) (*service_account.ServiceAccount, error) {
+	out := new(service_account.ServiceAccount)
+	err := c.cc.Invoke(ctx, Data_GetServiceAccountByName_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error) {
-	out := new(CheckCredentialNameResp)
-	err := c.cc.Invoke(ctx, Data_CheckCredentialName_FullMethodName, in, out, opts...)
+func (c *dataClient) SetAppRoleBinding(ctx context.Context, in *SetAppRoleBindingReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_SetAppRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error) {
-	out := new(ListCredentialScopesResp)
-	err := c.cc.Invoke(ctx, Data_ListCredentialScopes_FullMethodName, in, out, opts...)
+func (c *dataClient) ListAppRoleBindings(ctx context.Context, in *ListAppRoleBindingsReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error) {
+	out := new(ListAppRoleBindingsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppRoleBindings_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateCredentialScopes(ctx context.Context, in *UpdateCredentialScopesReq, opts ...grpc.CallOption) (*UpdateCredentialScopesResp, error) {
-	out := new(UpdateCredentialScopesResp)
-	err := c.cc.Invoke(ctx, Data_UpdateCredentialScopes_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteAppRoleBinding(ctx context.Context, in *DeleteAppRoleBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteAppRoleBinding_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CredentialScopePreview(ctx context.Context, in *CredentialScopePreviewReq, opts ...grpc.CallOption) (*CredentialScopePreviewResp, error) {
-	out := new(CredentialScopePreviewResp)
-	err := c.cc.Invoke(ctx, Data_CredentialScopePreview_FullMethodName, in, out, opts...)
+func (c *dataClient) ListUserAppRoles(ctx context.Context, in *ListUserAppRolesReq, opts ...grpc.CallOption) (*ListAppRoleBindingsResp, error) {
+	out := new(ListAppRoleBindingsResp)
+	err := c.cc.Invoke(ctx, Data_ListUserAppRoles_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateKv(ctx context.Context, in *CreateKvReq, opts ...grpc.CallOption) (*CreateResp, error) {
+func (c *dataClient) CreateTemplateSpace(ctx context.Context, in *CreateTemplateSpaceReq, opts ...grpc.CallOption) (*CreateResp, error) {
 	out := new(CreateResp)
-	err := c.cc.Invoke(ctx, Data_CreateKv_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_CreateTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateKv(ctx context.Context, in *UpdateKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateKv_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateSpaces(ctx context.Context, in *ListTemplateSpacesReq, opts ...grpc.CallOption) (*ListTemplateSpacesResp, error) {
+	out := new(ListTemplateSpacesResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSpaces_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListKvs(ctx context.Context, in *ListKvsReq, opts ...grpc.CallOption) (*ListKvsResp, error) {
-	out := new(ListKvsResp)
-	err := c.cc.Invoke(ctx, Data_ListKvs_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateTemplateSpace(ctx context.Context, in *UpdateTemplateSpaceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteKv(ctx context.Context, in *DeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) DeleteTemplateSpace(ctx context.Context, in *DeleteTemplateSpaceReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteKv_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplateSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error) {
-	out := new(BatchUpsertKvsResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpsertKvs_FullMethodName, in, out, opts...)
+func (c *dataClient) GetAllBizsOfTmplSpaces(ctx context.Context, in *base.EmptyReq, opts ...grpc.CallOption) (*GetAllBizsOfTmplSpacesResp, error) {
+	out := new(GetAllBizsOfTmplSpacesResp)
+	err := c.cc.Invoke(ctx, Data_GetAllBizsOfTmplSpaces_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UnDeleteKv_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateDefaultTmplSpace(ctx context.Context, in *CreateDefaultTmplSpaceReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateDefaultTmplSpace_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UndoKv_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTmplSpacesByIDs(ctx context.Context, in *ListTmplSpacesByIDsReq, opts ...grpc.CallOption) (*ListTmplSpacesByIDsResp, error) {
+	out := new(ListTmplSpacesByIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplSpacesByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) KvFetchIDsExcluding(ctx context.Context, in *KvFetchIDsExcludingReq, opts ...grpc.CallOption) (*KvFetchIDsExcludingResp, error) {
-	out := new(KvFetchIDsExcludingResp)
-	err := c.cc.Invoke(ctx, Data_KvFetchIDsExcluding_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateTemplate(ctx context.Context, in *CreateTemplateReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) KvFetchKeysExcluding(ctx context.Context, in *KvFetchKeysExcludingReq, opts ...grpc.CallOption) (*KvFetchKeysExcludingResp, error) {
-	out := new(KvFetchKeysExcludingResp)
-	err := c.cc.Invoke(ctx, Data_KvFetchKeysExcluding_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplates(ctx context.Context, in *ListTemplatesReq, opts ...grpc.CallOption) (*ListTemplatesResp, error) {
+	out := new(ListTemplatesResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error) {
-	out := new(FindNearExpiryCertKvsResp)
-	err := c.cc.Invoke(ctx, Data_FindNearExpiryCertKvs_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error) {
-	out := new(ListClientsResp)
-	err := c.cc.Invoke(ctx, Data_ListClients_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) RetryClients(ctx context.Context, in *RetryClientsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+func (c *dataClient) BatchDeleteTemplate(ctx context.Context, in *BatchDeleteTemplateReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
 	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_RetryClients_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Data_BatchDeleteTemplate_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListClientEvents(ctx context.Context, in *ListClientEventsReq, opts ...grpc.CallOption) (*ListClientEventsResp, error) {
-	out := new(ListClientEventsResp)
-	err := c.cc.Invoke(ctx, Data_ListClientEvents_FullMethodName, in, out, opts...)
+func (c *dataClient) AddTmplsToTmplSets(ctx context.Context, in *AddTmplsToTmplSetsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_AddTmplsToTmplSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListClientQuerys(ctx context.Context, in *ListClientQuerysReq, opts ...grpc.CallOption) (*ListClientQuerysResp, error) {
-	out := new(ListClientQuerysResp)
-	err := c.cc.Invoke(ctx, Data_ListClientQuerys_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteTmplsFromTmplSets(ctx context.Context, in *DeleteTmplsFromTmplSetsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTmplsFromTmplSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CreateClientQuery(ctx context.Context, in *CreateClientQueryReq, opts ...grpc.CallOption) (*CreateClientQueryResp, error) {
-	out := new(CreateClientQueryResp)
-	err := c.cc.Invoke(ctx, Data_CreateClientQuery_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplatesByIDs(ctx context.Context, in *ListTemplatesByIDsReq, opts ...grpc.CallOption) (*ListTemplatesByIDsResp, error) {
+	out := new(ListTemplatesByIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplatesByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) UpdateClientQuery(ctx context.Context, in *UpdateClientQueryReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_UpdateClientQuery_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplatesNotBound(ctx context.Context, in *ListTemplatesNotBoundReq, opts ...grpc.CallOption) (*ListTemplatesNotBoundResp, error) {
+	out := new(ListTemplatesNotBoundResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplatesNotBound_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) DeleteClientQuery(ctx context.Context, in *DeleteClientQueryReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
-	out := new(base.EmptyResp)
-	err := c.cc.Invoke(ctx, Data_DeleteClientQuery_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTmplsOfTmplSet(ctx context.Context, in *ListTmplsOfTmplSetReq, opts ...grpc.CallOption) (*ListTmplsOfTmplSetResp, error) {
+	out := new(ListTmplsOfTmplSetResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplsOfTmplSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CheckClientQueryName(ctx context.Context, in *CheckClientQueryNameReq, opts ...grpc.CallOption) (*CheckClientQueryNameResp, error) {
-	out := new(CheckClientQueryNameResp)
-	err := c.cc.Invoke(ctx, Data_CheckClientQueryName_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateSetsAndRevisions(ctx context.Context, in *ListTemplateSetsAndRevisionsReq, opts ...grpc.CallOption) (*ListTemplateSetsAndRevisionsResp, error) {
+	out := new(ListTemplateSetsAndRevisionsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSetsAndRevisions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientConfigVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientConfigVersionStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateByTuple(ctx context.Context, in *ListTemplateByTupleReq, opts ...grpc.CallOption) (*ListTemplateByTupleReqResp, error) {
+	out := new(ListTemplateByTupleReqResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateByTuple_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientPullTrendStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientPullTrendStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) BatchUpsertTemplates(ctx context.Context, in *BatchUpsertTemplatesReq, opts ...grpc.CallOption) (*BatchUpsertTemplatesReqResp, error) {
+	out := new(BatchUpsertTemplatesReqResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpsertTemplates_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientPullStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientPullStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) BatchUpdateTemplatePermissions(ctx context.Context, in *BatchUpdateTemplatePermissionsReq, opts ...grpc.CallOption) (*BatchUpdateTemplatePermissionsResp, error) {
+	out := new(BatchUpdateTemplatePermissionsResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpdateTemplatePermissions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientLabelStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientLabelStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateTemplateRevision(ctx context.Context, in *CreateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientAnnotationStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientAnnotationStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateTemplateRevision(ctx context.Context, in *UpdateTemplateRevisionReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_UpdateTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientVersionStatistics_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateRevisions(ctx context.Context, in *ListTemplateRevisionsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsResp, error) {
+	out := new(ListTemplateRevisionsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateRevisions_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ListClientLabelAndAnnotation_FullMethodName, in, out, opts...)
+func (c *dataClient) GetTemplateRevision(ctx context.Context, in *GetTemplateRevisionReq, opts ...grpc.CallOption) (*GetTemplateRevisionResp, error) {
+	out := new(GetTemplateRevisionResp)
+	err := c.cc.Invoke(ctx, Data_GetTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
-	out := new(structpb.Struct)
-	err := c.cc.Invoke(ctx, Data_ClientSpecificFailedReason_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteTemplateRevision(ctx context.Context, in *DeleteTemplateRevisionReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplateRevision_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) ListInstances(ctx context.Context, in *ListInstancesReq, opts ...grpc.CallOption) (*ListInstancesResp, error) {
-	out := new(ListInstancesResp)
-	err := c.cc.Invoke(ctx, Data_ListInstances_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateRevisionsByIDs(ctx context.Context, in *ListTemplateRevisionsByIDsReq, opts ...grpc.CallOption) (*ListTemplateRevisionsByIDsResp, error) {
+	out := new(ListTemplateRevisionsByIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateRevisionsByIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) FetchInstanceInfo(ctx context.Context, in *FetchInstanceInfoReq, opts ...grpc.CallOption) (*FetchInstanceInfoResp, error) {
-	out := new(FetchInstanceInfoResp)
-	err := c.cc.Invoke(ctx, Data_FetchInstanceInfo_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTmplRevisionNamesByTmplIDs(ctx context.Context, in *ListTmplRevisionNamesByTmplIDsReq, opts ...grpc.CallOption) (*ListTmplRevisionNamesByTmplIDsResp, error) {
+	out := new(ListTmplRevisionNamesByTmplIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplRevisionNamesByTmplIDs_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) Ping(ctx context.Context, in *PingMsg, opts ...grpc.CallOption) (*PingMsg, error) {
-	out := new(PingMsg)
-	err := c.cc.Invoke(ctx, Data_Ping_FullMethodName, in, out, opts...)
+func (c *dataClient) CreateTemplateSet(ctx context.Context, in *CreateTemplateSetReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpsertClientMetrics(ctx context.Context, in *BatchUpsertClientMetricsReq, opts ...grpc.CallOption) (*BatchUpsertClientMetricsResp, error) {
-	out := new(BatchUpsertClientMetricsResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpsertClientMetrics_FullMethodName, in, out, opts...)
+func (c *dataClient) ListTemplateSets(ctx context.Context, in *ListTemplateSetsReq, opts ...grpc.CallOption) (*ListTemplateSetsResp, error) {
+	out := new(ListTemplateSetsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
-	out := new(CompareConfigItemConflictsResp)
-	err := c.cc.Invoke(ctx, Data_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+func (c *dataClient) UpdateTemplateSet(ctx context.Context, in *UpdateTemplateSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
-	out := new(GetTemplateAndNonTemplateCICountResp)
-	err := c.cc.Invoke(ctx, Data_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+func (c *dataClient) DeleteTemplateSet(ctx context.Context, in *DeleteTemplateSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplateSet_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *dataClient) BatchUpdateLastConsumedTime(ctx context.Context, in *BatchUpdateLastConsumedTimeReq, opts ...grpc.CallOption) (*BatchUpdateLastConsumedTimeResp, error) {
-	out := new(BatchUpdateLastConsumedTimeResp)
-	err := c.cc.Invoke(ctx, Data_BatchUpdateLastConsumedTime_FullMethodName, in, out, opts...)
+func (c *dataClient) ListAppTemplateSets(ctx context.Context, in *ListAppTemplateSetsReq, opts ...grpc.CallOption) (*ListAppTemplateSetsResp, error) {
+	out := new(ListAppTemplateSetsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppTemplateSets_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// DataServer is the server API for Data service.
-// All implementations should embed UnimplementedDataServer
-// for forward compatibility
-type DataServer interface {
-	// app related interface.
-	CreateApp(context.Context, *CreateAppReq) (*CreateResp, error)
-	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
-	DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error)
-	GetApp(context.Context, *GetAppReq) (*app.App, error)
-	GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error)
-	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
-	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
-	ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error)
-	// config item related interface.
-	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error)
-	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
-	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error)
-	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error)
-	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error)
-	UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error)
-	GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error)
-	ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error)
-	ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error)
-	ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error)
-	ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error)
-	// config hook related interface.
-	UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*base.EmptyResp, error)
-	// content related interface.
-	CreateContent(context.Context, *CreateContentReq) (*CreateResp, error)
-	GetContent(context.Context, *GetContentReq) (*content.Content, error)
-	// commit related interface.
-	CreateCommit(context.Context, *CreateCommitReq) (*CreateResp, error)
-	GetLatestCommit(context.Context, *GetLatestCommitReq) (*commit.Commit, error)
-	// release related interface.
-	CreateRelease(context.Context, *CreateReleaseReq) (*CreateResp, error)
-	ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error)
-	GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error)
-	GetRelease(context.Context, *GetReleaseReq) (*release.Release, error)
-	DeprecateRelease(context.Context, *DeprecateReleaseReq) (*base.EmptyResp, error)
-	UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error)
-	DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error)
-	CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error)
-	// released config item related interface.
-	GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error)
-	// released kv related interface.
-	GetReleasedKv(context.Context, *GetReleasedKvReq) (*released_kv.ReleasedKv, error)
-	ListReleasedKvs(context.Context, *ListReleasedKvReq) (*ListReleasedKvResp, error)
-	ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error)
-	// hook related interface.
-	CreateHook(context.Context, *CreateHookReq) (*CreateResp, error)
-	ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error)
-	DeleteHook(context.Context, *DeleteHookReq) (*base.EmptyResp, error)
-	UpdateHook(context.Context, *UpdateHookReq) (*base.EmptyResp, error)
-	ListHookTags(context.Context, *ListHookTagReq) (*ListHookTagResp, error)
-	ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error)
-	GetHook(context.Context, *GetHookReq) (*GetHookResp, error)
-	HookFetchIDsExcluding(context.Context, *HookFetchIDsExcludingReq) (*HookFetchIDsExcludingResp, error)
-	GetHookReferencedIDs(context.Context, *GetHookReferencedIDsReq) (*GetHookReferencedIDsResp, error)
-	// hook revision related interface.
-	CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateResp, error)
-	ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error)
-	GetHookRevisionByID(context.Context, *GetHookRevisionByIdReq) (*hook_revision.HookRevision, error)
-	DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*base.EmptyResp, error)
-	PublishHookRevision(context.Context, *PublishHookRevisionReq) (*base.EmptyResp, error)
-	GetHookRevisionByPubState(context.Context, *GetByPubStateReq) (*hook_revision.HookRevision, error)
-	UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*base.EmptyResp, error)
-	ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error)
-	GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error)
-	// template space related interface.
-	CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error)
-	ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error)
-	UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*base.EmptyResp, error)
-	DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*base.EmptyResp, error)
-	GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error)
-	CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateResp, error)
-	ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error)
-	// template related interface.
-	CreateTemplate(context.Context, *CreateTemplateReq) (*CreateResp, error)
-	ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error)
-	UpdateTemplate(context.Context, *UpdateTemplateReq) (*base.EmptyResp, error)
-	DeleteTemplate(context.Context, *DeleteTemplateReq) (*base.EmptyResp, error)
-	BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*base.EmptyResp, error)
-	AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*base.EmptyResp, error)
-	DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*base.EmptyResp, error)
-	ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error)
-	ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error)
-	ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error)
-	ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error)
-	ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleReqResp, error)
-	BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesReqResp, error)
-	BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error)
-	// template release related interface.
-	CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateResp, error)
-	UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*CreateResp, error)
-	ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error)
-	GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error)
-	DeleteTemplateRevision(context.Context, *DeleteTemplateRevisionReq) (*base.EmptyResp, error)
-	ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error)
-	ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error)
-	// template set related interface.
-	CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateResp, error)
-	ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error)
-	UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*base.EmptyResp, error)
-	DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*base.EmptyResp, error)
-	ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error)
-	ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error)
-	ListTemplateSetBriefInfoByIDs(context.Context, *ListTemplateSetBriefInfoByIDsReq) (*ListTemplateSetBriefInfoByIDsResp, error)
-	ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error)
-	GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error)
-	// app template binding related interface.
-	CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateResp, error)
-	ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error)
-	UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*base.EmptyResp, error)
-	DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*base.EmptyResp, error)
-	ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error)
-	ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error)
-	GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error)
-	CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error)
-	ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*base.EmptyResp, error)
-	// app template variables related interface.
-	ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error)
-	GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error)
-	GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error)
-	UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*base.EmptyResp, error)
-	ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error)
-	ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error)
-	// template binding relation related interface.
-	ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error)
-	ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error)
-	ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error)
-	ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error)
-	ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error)
-	ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error)
-	ListMultiTmplBoundTmplSets(context.Context, *ListMultiTmplBoundTmplSetsReq) (*ListMultiTmplBoundTmplSetsResp, error)
-	ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error)
-	ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error)
-	ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error)
-	ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error)
-	ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error)
-	ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error)
-	RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*base.EmptyResp, error)
-	CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error)
-	// template variable related interface.
-	CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateResp, error)
-	ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error)
-	UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*base.EmptyResp, error)
-	DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*base.EmptyResp, error)
-	TemplateVariableFetchIDsExcluding(context.Context, *TemplateVariableFetchIDsExcludingReq) (*TemplateVariableFetchIDsExcludingResp, error)
-	ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error)
-	// group related interface.
-	CreateGroup(context.Context, *CreateGroupReq) (*CreateResp, error)
-	ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error)
-	ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error)
-	GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error)
-	UpdateGroup(context.Context, *UpdateGroupReq) (*base.EmptyResp, error)
-	DeleteGroup(context.Context, *DeleteGroupReq) (*base.EmptyResp, error)
-	ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error)
-	// group current release related interface.
-	CountGroupsReleasedApps(context.Context, *CountGroupsReleasedAppsReq) (*CountGroupsReleasedAppsResp, error)
-	ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error)
-	// publish related interface.
-	Publish(context.Context, *PublishReq) (*PublishResp, error)
-	SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error)
-	Approve(context.Context, *ApproveReq) (*ApproveResp, error)
-	GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error)
-	GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error)
-	GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error)
-	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
-	// audit related interface.
-	ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error)
-	// credential related interface
-	CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error)
-	ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error)
-	DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error)
-	UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error)
-	CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error)
-	// credential scope related interface
-	ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error)
-	UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error)
-	CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error)
-	// kv related interface
-	CreateKv(context.Context, *CreateKvReq) (*CreateResp, error)
-	UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error)
-	ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error)
-	DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error)
-	BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error)
-	UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error)
-	UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error)
-	KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error)
-	KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error)
-	FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error)
-	// client related interface
-	ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error)
-	RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error)
-	// client event related interface
-	ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error)
-	// client query related interface
-	ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error)
-	CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error)
-	UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error)
-	DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error)
-	CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error)
-	// client metrics chart related interface
-	ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error)
-	ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
-	// used iam pull resource callback.
-	ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error)
-	FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error)
-	// Ping verifies if the grpc connection is still alive.
-	Ping(context.Context, *PingMsg) (*PingMsg, error)
-	// client metric related interface
-	BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error)
-	// config item compare conflicts related interface
-	CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error)
-	// 获取模板和非模板配置项数量
-	GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error)
-	BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error)
-}
-
-// UnimplementedDataServer should be embedded to have forward compatible implementations.
-type UnimplementedDataServer struct {
-}
-
-func (UnimplementedDataServer) CreateApp(context.Context, *CreateAppReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
-}
-func (UnimplementedDataServer) UpdateApp(context.Context, *UpdateAppReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
-}
-func (UnimplementedDataServer) DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
+func (c *dataClient) ListTemplateSetsByIDs(ctx context.Context, in *ListTemplateSetsByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetsByIDsResp, error) {
+	out := new(ListTemplateSetsByIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSetsByIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetApp(context.Context, *GetAppReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetApp not implemented")
+
+func (c *dataClient) ListTemplateSetBriefInfoByIDs(ctx context.Context, in *ListTemplateSetBriefInfoByIDsReq, opts ...grpc.CallOption) (*ListTemplateSetBriefInfoByIDsResp, error) {
+	out := new(ListTemplateSetBriefInfoByIDsResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateSetBriefInfoByIDs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppByID not implemented")
+
+func (c *dataClient) ListTmplSetsOfBiz(ctx context.Context, in *ListTmplSetsOfBizReq, opts ...grpc.CallOption) (*ListTmplSetsOfBizResp, error) {
+	out := new(ListTmplSetsOfBizResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplSetsOfBiz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppByName not implemented")
+
+func (c *dataClient) GetLatestTemplateVersionsInSpace(ctx context.Context, in *GetLatestTemplateVersionsInSpaceReq, opts ...grpc.CallOption) (*GetLatestTemplateVersionsInSpaceResp, error) {
+	out := new(GetLatestTemplateVersionsInSpaceResp)
+	err := c.cc.Invoke(ctx, Data_GetLatestTemplateVersionsInSpace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppsRest not implemented")
+
+func (c *dataClient) CreateAppTemplateBinding(ctx context.Context, in *CreateAppTemplateBindingReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppsByIDs not implemented")
+
+func (c *dataClient) ListAppTemplateBindings(ctx context.Context, in *ListAppTemplateBindingsReq, opts ...grpc.CallOption) (*ListAppTemplateBindingsResp, error) {
+	out := new(ListAppTemplateBindingsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppTemplateBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigItem not implemented")
+
+func (c *dataClient) UpdateAppTemplateBinding(ctx context.Context, in *UpdateAppTemplateBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertConfigItems not implemented")
+
+func (c *dataClient) DeleteAppTemplateBinding(ctx context.Context, in *DeleteAppTemplateBindingReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigItem not implemented")
+
+func (c *dataClient) ListAppBoundTmplRevisions(ctx context.Context, in *ListAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListAppBoundTmplRevisionsResp, error) {
+	out := new(ListAppBoundTmplRevisionsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigItem not implemented")
+
+func (c *dataClient) ListReleasedAppBoundTmplRevisions(ctx context.Context, in *ListReleasedAppBoundTmplRevisionsReq, opts ...grpc.CallOption) (*ListReleasedAppBoundTmplRevisionsResp, error) {
+	out := new(ListReleasedAppBoundTmplRevisionsResp)
+	err := c.cc.Invoke(ctx, Data_ListReleasedAppBoundTmplRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteConfigItem not implemented")
+
+func (c *dataClient) GetReleasedAppBoundTmplRevision(ctx context.Context, in *GetReleasedAppBoundTmplRevisionReq, opts ...grpc.CallOption) (*GetReleasedAppBoundTmplRevisionResp, error) {
+	out := new(GetReleasedAppBoundTmplRevisionResp)
+	err := c.cc.Invoke(ctx, Data_GetReleasedAppBoundTmplRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoConfigItem not implemented")
+
+func (c *dataClient) CheckAppTemplateBinding(ctx context.Context, in *CheckAppTemplateBindingReq, opts ...grpc.CallOption) (*CheckAppTemplateBindingResp, error) {
+	out := new(CheckAppTemplateBindingResp)
+	err := c.cc.Invoke(ctx, Data_CheckAppTemplateBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfigItem not implemented")
+
+func (c *dataClient) ImportFromTemplateSetToApp(ctx context.Context, in *ImportFromTemplateSetToAppReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_ImportFromTemplateSetToApp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItems not implemented")
+
+func (c *dataClient) ExtractAppTmplVariables(ctx context.Context, in *ExtractAppTmplVariablesReq, opts ...grpc.CallOption) (*ExtractAppTmplVariablesResp, error) {
+	out := new(ExtractAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ExtractAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedConfigItems not implemented")
+
+func (c *dataClient) GetAppTmplVariableRefs(ctx context.Context, in *GetAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetAppTmplVariableRefsResp, error) {
+	out := new(GetAppTmplVariableRefsResp)
+	err := c.cc.Invoke(ctx, Data_GetAppTmplVariableRefs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemCount not implemented")
+
+func (c *dataClient) GetReleasedAppTmplVariableRefs(ctx context.Context, in *GetReleasedAppTmplVariableRefsReq, opts ...grpc.CallOption) (*GetReleasedAppTmplVariableRefsResp, error) {
+	out := new(GetReleasedAppTmplVariableRefsResp)
+	err := c.cc.Invoke(ctx, Data_GetReleasedAppTmplVariableRefs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemByTuple not implemented")
+
+func (c *dataClient) UpdateAppTmplVariables(ctx context.Context, in *UpdateAppTmplVariablesReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigHook not implemented")
+
+func (c *dataClient) ListAppTmplVariables(ctx context.Context, in *ListAppTmplVariablesReq, opts ...grpc.CallOption) (*ListAppTmplVariablesResp, error) {
+	out := new(ListAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ListAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateContent(context.Context, *CreateContentReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateContent not implemented")
+
+func (c *dataClient) ListReleasedAppTmplVariables(ctx context.Context, in *ListReleasedAppTmplVariablesReq, opts ...grpc.CallOption) (*ListReleasedAppTmplVariablesResp, error) {
+	out := new(ListReleasedAppTmplVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ListReleasedAppTmplVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetContent(context.Context, *GetContentReq) (*content.Content, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
+
+func (c *dataClient) ListTmplBoundCounts(ctx context.Context, in *ListTmplBoundCountsReq, opts ...grpc.CallOption) (*ListTmplBoundCountsResp, error) {
+	out := new(ListTmplBoundCountsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateCommit(context.Context, *CreateCommitReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCommit not implemented")
+
+func (c *dataClient) ListTmplRevisionBoundCounts(ctx context.Context, in *ListTmplRevisionBoundCountsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundCountsResp, error) {
+	out := new(ListTmplRevisionBoundCountsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetLatestCommit(context.Context, *GetLatestCommitReq) (*commit.Commit, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestCommit not implemented")
+
+func (c *dataClient) ListTmplSetBoundCounts(ctx context.Context, in *ListTmplSetBoundCountsReq, opts ...grpc.CallOption) (*ListTmplSetBoundCountsResp, error) {
+	out := new(ListTmplSetBoundCountsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateRelease(context.Context, *CreateReleaseReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRelease not implemented")
+
+func (c *dataClient) ListTmplBoundUnnamedApps(ctx context.Context, in *ListTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundUnnamedAppsResp, error) {
+	out := new(ListTmplBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+
+func (c *dataClient) ListTmplBoundNamedApps(ctx context.Context, in *ListTmplBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplBoundNamedAppsResp, error) {
+	out := new(ListTmplBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseByName not implemented")
+
+func (c *dataClient) ListTmplBoundTmplSets(ctx context.Context, in *ListTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListTmplBoundTmplSetsResp, error) {
+	out := new(ListTmplBoundTmplSetsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplBoundTmplSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetRelease(context.Context, *GetReleaseReq) (*release.Release, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRelease not implemented")
+
+func (c *dataClient) ListMultiTmplBoundTmplSets(ctx context.Context, in *ListMultiTmplBoundTmplSetsReq, opts ...grpc.CallOption) (*ListMultiTmplBoundTmplSetsResp, error) {
+	out := new(ListMultiTmplBoundTmplSetsResp)
+	err := c.cc.Invoke(ctx, Data_ListMultiTmplBoundTmplSets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeprecateRelease(context.Context, *DeprecateReleaseReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeprecateRelease not implemented")
+
+func (c *dataClient) ListTmplRevisionBoundUnnamedApps(ctx context.Context, in *ListTmplRevisionBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
+	out := new(ListTmplRevisionBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeprecateRelease not implemented")
+
+func (c *dataClient) ListTmplRevisionBoundNamedApps(ctx context.Context, in *ListTmplRevisionBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplRevisionBoundNamedAppsResp, error) {
+	out := new(ListTmplRevisionBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplRevisionBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
+
+func (c *dataClient) ListTmplSetBoundUnnamedApps(ctx context.Context, in *ListTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundUnnamedAppsResp, error) {
+	out := new(ListTmplSetBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckReleaseName not implemented")
+
+func (c *dataClient) ListMultiTmplSetBoundUnnamedApps(ctx context.Context, in *ListMultiTmplSetBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
+	out := new(ListMultiTmplSetBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListMultiTmplSetBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
+
+func (c *dataClient) ListTmplSetBoundNamedApps(ctx context.Context, in *ListTmplSetBoundNamedAppsReq, opts ...grpc.CallOption) (*ListTmplSetBoundNamedAppsResp, error) {
+	out := new(ListTmplSetBoundNamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListTmplSetBoundNamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleasedKv(context.Context, *GetReleasedKvReq) (*released_kv.ReleasedKv, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedKv not implemented")
+
+func (c *dataClient) ListLatestTmplBoundUnnamedApps(ctx context.Context, in *ListLatestTmplBoundUnnamedAppsReq, opts ...grpc.CallOption) (*ListLatestTmplBoundUnnamedAppsResp, error) {
+	out := new(ListLatestTmplBoundUnnamedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListLatestTmplBoundUnnamedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListReleasedKvs(context.Context, *ListReleasedKvReq) (*ListReleasedKvResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedKvs not implemented")
+
+func (c *dataClient) RemoveAppBoundTmplSet(ctx context.Context, in *RemoveAppBoundTmplSetReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RemoveAppBoundTmplSet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllReleasedConfigItems not implemented")
+
+func (c *dataClient) CheckTemplateSetReferencesApps(ctx context.Context, in *CheckTemplateSetReferencesAppsReq, opts ...grpc.CallOption) (*CheckTemplateSetReferencesAppsResp, error) {
+	out := new(CheckTemplateSetReferencesAppsResp)
+	err := c.cc.Invoke(ctx, Data_CheckTemplateSetReferencesApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateHook(context.Context, *CreateHookReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
+
+func (c *dataClient) CreateTemplateVariable(ctx context.Context, in *CreateTemplateVariableReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHooks not implemented")
+
+func (c *dataClient) ListTemplateVariables(ctx context.Context, in *ListTemplateVariablesReq, opts ...grpc.CallOption) (*ListTemplateVariablesResp, error) {
+	out := new(ListTemplateVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ListTemplateVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteHook(context.Context, *DeleteHookReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHook not implemented")
+
+func (c *dataClient) UpdateTemplateVariable(ctx context.Context, in *UpdateTemplateVariableReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateHook(context.Context, *UpdateHookReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHook not implemented")
+
+func (c *dataClient) DeleteTemplateVariable(ctx context.Context, in *DeleteTemplateVariableReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteTemplateVariable_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListHookTags(context.Context, *ListHookTagReq) (*ListHookTagResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookTags not implemented")
+
+func (c *dataClient) TemplateVariableFetchIDsExcluding(ctx context.Context, in *TemplateVariableFetchIDsExcludingReq, opts ...grpc.CallOption) (*TemplateVariableFetchIDsExcludingResp, error) {
+	out := new(TemplateVariableFetchIDsExcludingResp)
+	err := c.cc.Invoke(ctx, Data_TemplateVariableFetchIDsExcluding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookReferences not implemented")
+
+func (c *dataClient) ImportTemplateVariables(ctx context.Context, in *ImportTemplateVariablesReq, opts ...grpc.CallOption) (*ImportTemplateVariablesResp, error) {
+	out := new(ImportTemplateVariablesResp)
+	err := c.cc.Invoke(ctx, Data_ImportTemplateVariables_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetHook(context.Context, *GetHookReq) (*GetHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHook not implemented")
+
+func (c *dataClient) CreateGroup(ctx context.Context, in *CreateGroupReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) HookFetchIDsExcluding(context.Context, *HookFetchIDsExcludingReq) (*HookFetchIDsExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HookFetchIDsExcluding not implemented")
+
+func (c *dataClient) ListAllGroups(ctx context.Context, in *ListAllGroupsReq, opts ...grpc.CallOption) (*ListAllGroupsResp, error) {
+	out := new(ListAllGroupsResp)
+	err := c.cc.Invoke(ctx, Data_ListAllGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetHookReferencedIDs(context.Context, *GetHookReferencedIDsReq) (*GetHookReferencedIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHookReferencedIDs not implemented")
+
+func (c *dataClient) ListAppGroups(ctx context.Context, in *ListAppGroupsReq, opts ...grpc.CallOption) (*ListAppGroupsResp, error) {
+	out := new(ListAppGroupsResp)
+	err := c.cc.Invoke(ctx, Data_ListAppGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateHookRevision not implemented")
+
+func (c *dataClient) GetGroupByName(ctx context.Context, in *GetGroupByNameReq, opts ...grpc.CallOption) (*group.Group, error) {
+	out := new(group.Group)
+	err := c.cc.Invoke(ctx, Data_GetGroupByName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisions not implemented")
+
+func (c *dataClient) UpdateGroup(ctx context.Context, in *UpdateGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetHookRevisionByID(context.Context, *GetHookRevisionByIdReq) (*hook_revision.HookRevision, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevisionByID not implemented")
+
+func (c *dataClient) DeleteGroup(ctx context.Context, in *DeleteGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookRevision not implemented")
+
+func (c *dataClient) ListGroupSelector(ctx context.Context, in *ListGroupSelectorReq, opts ...grpc.CallOption) (*ListGroupSelectorResp, error) {
+	out := new(ListGroupSelectorResp)
+	err := c.cc.Invoke(ctx, Data_ListGroupSelector_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) PublishHookRevision(context.Context, *PublishHookRevisionReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishHookRevision not implemented")
+
+func (c *dataClient) PreviewGroupClients(ctx context.Context, in *PreviewGroupClientsReq, opts ...grpc.CallOption) (*PreviewGroupClientsResp, error) {
+	out := new(PreviewGroupClientsResp)
+	err := c.cc.Invoke(ctx, Data_PreviewGroupClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetHookRevisionByPubState(context.Context, *GetByPubStateReq) (*hook_revision.HookRevision, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevisionByPubState not implemented")
+
+func (c *dataClient) PreviewClientRelease(ctx context.Context, in *PreviewClientReleaseReq, opts ...grpc.CallOption) (*client_preview.ClientReleasePreview, error) {
+	out := new(client_preview.ClientReleasePreview)
+	err := c.cc.Invoke(ctx, Data_PreviewClientRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookRevision not implemented")
+
+func (c *dataClient) CountGroupsReleasedApps(ctx context.Context, in *CountGroupsReleasedAppsReq, opts ...grpc.CallOption) (*CountGroupsReleasedAppsResp, error) {
+	out := new(CountGroupsReleasedAppsResp)
+	err := c.cc.Invoke(ctx, Data_CountGroupsReleasedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisionReferences not implemented")
+
+func (c *dataClient) ListGroupReleasedApps(ctx context.Context, in *ListGroupReleasedAppsReq, opts ...grpc.CallOption) (*ListGroupReleasedAppsResp, error) {
+	out := new(ListGroupReleasedAppsResp)
+	err := c.cc.Invoke(ctx, Data_ListGroupReleasedApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHook not implemented")
-}
-func (UnimplementedDataServer) CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSpace not implemented")
+
+func (c *dataClient) Publish(ctx context.Context, in *PublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Data_Publish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaces not implemented")
+
+func (c *dataClient) SubmitPublishApprove(ctx context.Context, in *SubmitPublishApproveReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Data_SubmitPublishApprove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSpace not implemented")
+
+func (c *dataClient) Approve(ctx context.Context, in *ApproveReq, opts ...grpc.CallOption) (*ApproveResp, error) {
+	out := new(ApproveResp)
+	err := c.cc.Invoke(ctx, Data_Approve_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpace not implemented")
+
+func (c *dataClient) GetLastSelect(ctx context.Context, in *GetLastSelectReq, opts ...grpc.CallOption) (*GetLastSelectResp, error) {
+	out := new(GetLastSelectResp)
+	err := c.cc.Invoke(ctx, Data_GetLastSelect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllBizsOfTmplSpaces not implemented")
+
+func (c *dataClient) GetLastPublish(ctx context.Context, in *GetLastPublishReq, opts ...grpc.CallOption) (*GetLastPublishResp, error) {
+	out := new(GetLastPublishResp)
+	err := c.cc.Invoke(ctx, Data_GetLastPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultTmplSpace not implemented")
+
+func (c *dataClient) ListRecentPublishes(ctx context.Context, in *ListRecentPublishesReq, opts ...grpc.CallOption) (*ListRecentPublishesResp, error) {
+	out := new(ListRecentPublishesResp)
+	err := c.cc.Invoke(ctx, Data_ListRecentPublishes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSpacesByIDs not implemented")
+
+func (c *dataClient) GetReleasesStatus(ctx context.Context, in *GetReleasesStatusReq, opts ...grpc.CallOption) (*strategy.Strategy, error) {
+	out := new(strategy.Strategy)
+	err := c.cc.Invoke(ctx, Data_GetReleasesStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateTemplate(context.Context, *CreateTemplateReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+
+func (c *dataClient) EmergencyPublish(ctx context.Context, in *EmergencyPublishReq, opts ...grpc.CallOption) (*EmergencyPublishResp, error) {
+	out := new(EmergencyPublishResp)
+	err := c.cc.Invoke(ctx, Data_EmergencyPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+
+func (c *dataClient) ListEmergencyPublishes(ctx context.Context, in *ListEmergencyPublishesReq, opts ...grpc.CallOption) (*ListEmergencyPublishesResp, error) {
+	out := new(ListEmergencyPublishesResp)
+	err := c.cc.Invoke(ctx, Data_ListEmergencyPublishes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateTemplate(context.Context, *UpdateTemplateReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+
+func (c *dataClient) RevokeEmergencyPublish(ctx context.Context, in *RevokeEmergencyPublishReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RevokeEmergencyPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteTemplate(context.Context, *DeleteTemplateReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+
+func (c *dataClient) PinReleasedGroup(ctx context.Context, in *PinReleasedGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_PinReleasedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplate not implemented")
+
+func (c *dataClient) UnpinReleasedGroup(ctx context.Context, in *UnpinReleasedGroupReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UnpinReleasedGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddTmplsToTmplSets not implemented")
+
+func (c *dataClient) GenerateReleaseAndPublish(ctx context.Context, in *GenerateReleaseAndPublishReq, opts ...grpc.CallOption) (*PublishResp, error) {
+	out := new(PublishResp)
+	err := c.cc.Invoke(ctx, Data_GenerateReleaseAndPublish_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTmplsFromTmplSets not implemented")
+
+func (c *dataClient) BulkRelease(ctx context.Context, in *BulkReleaseReq, opts ...grpc.CallOption) (*BulkReleaseResp, error) {
+	out := new(BulkReleaseResp)
+	err := c.cc.Invoke(ctx, Data_BulkRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesByIDs not implemented")
+
+func (c *dataClient) BeginIdempotentRequest(ctx context.Context, in *BeginIdempotentRequestReq, opts ...grpc.CallOption) (*BeginIdempotentRequestResp, error) {
+	out := new(BeginIdempotentRequestResp)
+	err := c.cc.Invoke(ctx, Data_BeginIdempotentRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesNotBound not implemented")
+
+func (c *dataClient) CompleteIdempotentRequest(ctx context.Context, in *CompleteIdempotentRequestReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_CompleteIdempotentRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplsOfTmplSet not implemented")
+
+func (c *dataClient) AbortIdempotentRequest(ctx context.Context, in *AbortIdempotentRequestReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_AbortIdempotentRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsAndRevisions not implemented")
+
+func (c *dataClient) GetOperation(ctx context.Context, in *GetOperationReq, opts ...grpc.CallOption) (*operation.Operation, error) {
+	out := new(operation.Operation)
+	err := c.cc.Invoke(ctx, Data_GetOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleReqResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateByTuple not implemented")
+
+func (c *dataClient) ListOperations(ctx context.Context, in *ListOperationsReq, opts ...grpc.CallOption) (*ListOperationsResp, error) {
+	out := new(ListOperationsResp)
+	err := c.cc.Invoke(ctx, Data_ListOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesReqResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertTemplates not implemented")
+
+func (c *dataClient) CancelOperation(ctx context.Context, in *CancelOperationReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_CancelOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTemplatePermissions not implemented")
+
+func (c *dataClient) ListAudits(ctx context.Context, in *ListAuditsReq, opts ...grpc.CallOption) (*ListAuditsResp, error) {
+	out := new(ListAuditsResp)
+	err := c.cc.Invoke(ctx, Data_ListAudits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateRevision not implemented")
+
+func (c *dataClient) ListAppTimeline(ctx context.Context, in *ListAppTimelineReq, opts ...grpc.CallOption) (*ListAppTimelineResp, error) {
+	out := new(ListAppTimelineResp)
+	err := c.cc.Invoke(ctx, Data_ListAppTimeline_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateRevision not implemented")
+
+func (c *dataClient) CreateCredential(ctx context.Context, in *CreateCredentialReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisions not implemented")
+
+func (c *dataClient) ListCredentials(ctx context.Context, in *ListCredentialReq, opts ...grpc.CallOption) (*ListCredentialResp, error) {
+	out := new(ListCredentialResp)
+	err := c.cc.Invoke(ctx, Data_ListCredentials_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateRevision not implemented")
+
+func (c *dataClient) DeleteCredential(ctx context.Context, in *DeleteCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteTemplateRevision(context.Context, *DeleteTemplateRevisionReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateRevision not implemented")
+
+func (c *dataClient) UpdateCredential(ctx context.Context, in *UpdateCredentialReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisionsByIDs not implemented")
+
+func (c *dataClient) CheckCredentialName(ctx context.Context, in *CheckCredentialNameReq, opts ...grpc.CallOption) (*CheckCredentialNameResp, error) {
+	out := new(CheckCredentialNameResp)
+	err := c.cc.Invoke(ctx, Data_CheckCredentialName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionNamesByTmplIDs not implemented")
+
+func (c *dataClient) ListCredentialScopes(ctx context.Context, in *ListCredentialScopesReq, opts ...grpc.CallOption) (*ListCredentialScopesResp, error) {
+	out := new(ListCredentialScopesResp)
+	err := c.cc.Invoke(ctx, Data_ListCredentialScopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSet not implemented")
+
+func (c *dataClient) UpdateCredentialScopes(ctx context.Context, in *UpdateCredentialScopesReq, opts ...grpc.CallOption) (*UpdateCredentialScopesResp, error) {
+	out := new(UpdateCredentialScopesResp)
+	err := c.cc.Invoke(ctx, Data_UpdateCredentialScopes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSets not implemented")
-}
-func (UnimplementedDataServer) UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSet not implemented")
-}
-func (UnimplementedDataServer) DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSet not implemented")
-}
-func (UnimplementedDataServer) ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateSets not implemented")
+
+func (c *dataClient) CredentialScopePreview(ctx context.Context, in *CredentialScopePreviewReq, opts ...grpc.CallOption) (*CredentialScopePreviewResp, error) {
+	out := new(CredentialScopePreviewResp)
+	err := c.cc.Invoke(ctx, Data_CredentialScopePreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsByIDs not implemented")
+
+func (c *dataClient) CreateKv(ctx context.Context, in *CreateKvReq, opts ...grpc.CallOption) (*CreateResp, error) {
+	out := new(CreateResp)
+	err := c.cc.Invoke(ctx, Data_CreateKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTemplateSetBriefInfoByIDs(context.Context, *ListTemplateSetBriefInfoByIDsReq) (*ListTemplateSetBriefInfoByIDsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetBriefInfoByIDs not implemented")
+
+func (c *dataClient) UpdateKv(ctx context.Context, in *UpdateKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetsOfBiz not implemented")
+
+func (c *dataClient) ListKvs(ctx context.Context, in *ListKvsReq, opts ...grpc.CallOption) (*ListKvsResp, error) {
+	out := new(ListKvsResp)
+	err := c.cc.Invoke(ctx, Data_ListKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTemplateVersionsInSpace not implemented")
+
+func (c *dataClient) DeleteKv(ctx context.Context, in *DeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAppTemplateBinding not implemented")
+
+func (c *dataClient) BatchUpsertKvs(ctx context.Context, in *BatchUpsertKvsReq, opts ...grpc.CallOption) (*BatchUpsertKvsResp, error) {
+	out := new(BatchUpsertKvsResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpsertKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateBindings not implemented")
+
+func (c *dataClient) TransactKvs(ctx context.Context, in *TransactKvsReq, opts ...grpc.CallOption) (*TransactKvsResp, error) {
+	out := new(TransactKvsResp)
+	err := c.cc.Invoke(ctx, Data_TransactKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTemplateBinding not implemented")
+
+func (c *dataClient) ListKvHistory(ctx context.Context, in *ListKvHistoryReq, opts ...grpc.CallOption) (*ListKvHistoryResp, error) {
+	out := new(ListKvHistoryResp)
+	err := c.cc.Invoke(ctx, Data_ListKvHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppTemplateBinding not implemented")
+
+func (c *dataClient) DiffKvVersions(ctx context.Context, in *DiffKvVersionsReq, opts ...grpc.CallOption) (*DiffKvVersionsResp, error) {
+	out := new(DiffKvVersionsResp)
+	err := c.cc.Invoke(ctx, Data_DiffKvVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppBoundTmplRevisions not implemented")
+
+func (c *dataClient) UnDeleteKv(ctx context.Context, in *UnDeleteKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UnDeleteKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppBoundTmplRevisions not implemented")
+
+func (c *dataClient) UndoKv(ctx context.Context, in *UndoKvReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UndoKv_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppBoundTmplRevision not implemented")
+
+func (c *dataClient) KvFetchIDsExcluding(ctx context.Context, in *KvFetchIDsExcludingReq, opts ...grpc.CallOption) (*KvFetchIDsExcludingResp, error) {
+	out := new(KvFetchIDsExcludingResp)
+	err := c.cc.Invoke(ctx, Data_KvFetchIDsExcluding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckAppTemplateBinding not implemented")
+
+func (c *dataClient) KvFetchKeysExcluding(ctx context.Context, in *KvFetchKeysExcludingReq, opts ...grpc.CallOption) (*KvFetchKeysExcludingResp, error) {
+	out := new(KvFetchKeysExcludingResp)
+	err := c.cc.Invoke(ctx, Data_KvFetchKeysExcluding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportFromTemplateSetToApp not implemented")
+
+func (c *dataClient) FindNearExpiryCertKvs(ctx context.Context, in *FindNearExpiryCertKvsReq, opts ...grpc.CallOption) (*FindNearExpiryCertKvsResp, error) {
+	out := new(FindNearExpiryCertKvsResp)
+	err := c.cc.Invoke(ctx, Data_FindNearExpiryCertKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExtractAppTmplVariables not implemented")
+
+func (c *dataClient) CompareKvValue(ctx context.Context, in *CompareKvValueReq, opts ...grpc.CallOption) (*CompareKvValueResp, error) {
+	out := new(CompareKvValueResp)
+	err := c.cc.Invoke(ctx, Data_CompareKvValue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAppTmplVariableRefs not implemented")
+
+func (c *dataClient) ListClients(ctx context.Context, in *ListClientsReq, opts ...grpc.CallOption) (*ListClientsResp, error) {
+	out := new(ListClientsResp)
+	err := c.cc.Invoke(ctx, Data_ListClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppTmplVariableRefs not implemented")
+
+func (c *dataClient) RetryClients(ctx context.Context, in *RetryClientsReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_RetryClients_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTmplVariables not implemented")
+
+func (c *dataClient) ListClientEvents(ctx context.Context, in *ListClientEventsReq, opts ...grpc.CallOption) (*ListClientEventsResp, error) {
+	out := new(ListClientEventsResp)
+	err := c.cc.Invoke(ctx, Data_ListClientEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppTmplVariables not implemented")
+
+func (c *dataClient) ListClientQuerys(ctx context.Context, in *ListClientQuerysReq, opts ...grpc.CallOption) (*ListClientQuerysResp, error) {
+	out := new(ListClientQuerysResp)
+	err := c.cc.Invoke(ctx, Data_ListClientQuerys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
+
+func (c *dataClient) CreateClientQuery(ctx context.Context, in *CreateClientQueryReq, opts ...grpc.CallOption) (*CreateClientQueryResp, error) {
+	out := new(CreateClientQueryResp)
+	err := c.cc.Invoke(ctx, Data_CreateClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundCounts not implemented")
+
+func (c *dataClient) UpdateClientQuery(ctx context.Context, in *UpdateClientQueryReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_UpdateClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundCounts not implemented")
+
+func (c *dataClient) DeleteClientQuery(ctx context.Context, in *DeleteClientQueryReq, opts ...grpc.CallOption) (*base.EmptyResp, error) {
+	out := new(base.EmptyResp)
+	err := c.cc.Invoke(ctx, Data_DeleteClientQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundCounts not implemented")
+
+func (c *dataClient) CheckClientQueryName(ctx context.Context, in *CheckClientQueryNameReq, opts ...grpc.CallOption) (*CheckClientQueryNameResp, error) {
+	out := new(CheckClientQueryNameResp)
+	err := c.cc.Invoke(ctx, Data_CheckClientQueryName_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundUnnamedApps not implemented")
+
+func (c *dataClient) ClientConfigVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientConfigVersionStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundNamedApps not implemented")
+
+func (c *dataClient) ClientPullTrendStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientPullTrendStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedDataServer) ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundTmplSets not implemented")
+
+func (c *dataClient) ClientPullStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientPullStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ClientLabelStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientLabelStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ClientAnnotationStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientAnnotationStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ClientVersionStatistics(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientVersionStatistics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListClientLabelAndAnnotation(ctx context.Context, in *ListClientLabelAndAnnotationReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ListClientLabelAndAnnotation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ClientSpecificFailedReason(ctx context.Context, in *client.ClientCommonReq, opts ...grpc.CallOption) (*structpb.Struct, error) {
+	out := new(structpb.Struct)
+	err := c.cc.Invoke(ctx, Data_ClientSpecificFailedReason_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) ListInstances(ctx context.Context, in *ListInstancesReq, opts ...grpc.CallOption) (*ListInstancesResp, error) {
+	out := new(ListInstancesResp)
+	err := c.cc.Invoke(ctx, Data_ListInstances_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) FetchInstanceInfo(ctx context.Context, in *FetchInstanceInfoReq, opts ...grpc.CallOption) (*FetchInstanceInfoResp, error) {
+	out := new(FetchInstanceInfoResp)
+	err := c.cc.Invoke(ctx, Data_FetchInstanceInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) Ping(ctx context.Context, in *PingMsg, opts ...grpc.CallOption) (*PingMsg, error) {
+	out := new(PingMsg)
+	err := c.cc.Invoke(ctx, Data_Ping_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BatchUpsertClientMetrics(ctx context.Context, in *BatchUpsertClientMetricsReq, opts ...grpc.CallOption) (*BatchUpsertClientMetricsResp, error) {
+	out := new(BatchUpsertClientMetricsResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpsertClientMetrics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) CompareConfigItemConflicts(ctx context.Context, in *CompareConfigItemConflictsReq, opts ...grpc.CallOption) (*CompareConfigItemConflictsResp, error) {
+	out := new(CompareConfigItemConflictsResp)
+	err := c.cc.Invoke(ctx, Data_CompareConfigItemConflicts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) GetTemplateAndNonTemplateCICount(ctx context.Context, in *GetTemplateAndNonTemplateCICountReq, opts ...grpc.CallOption) (*GetTemplateAndNonTemplateCICountResp, error) {
+	out := new(GetTemplateAndNonTemplateCICountResp)
+	err := c.cc.Invoke(ctx, Data_GetTemplateAndNonTemplateCICount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataClient) BatchUpdateLastConsumedTime(ctx context.Context, in *BatchUpdateLastConsumedTimeReq, opts ...grpc.CallOption) (*BatchUpdateLastConsumedTimeResp, error) {
+	out := new(BatchUpdateLastConsumedTimeResp)
+	err := c.cc.Invoke(ctx, Data_BatchUpdateLastConsumedTime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataServer is the server API for Data service.
+// All implementations should embed UnimplementedDataServer
+// for forward compatibility
+type DataServer interface {
+	// app related interface.
+	CreateApp(context.Context, *CreateAppReq) (*CreateResp, error)
+	UpdateAppState(context.Context, *UpdateAppStateReq) (*base.EmptyResp, error)
+	PauseConsumption(context.Context, *PauseConsumptionReq) (*base.EmptyResp, error)
+	ResumeConsumption(context.Context, *ResumeConsumptionReq) (*base.EmptyResp, error)
+	UpdateApp(context.Context, *UpdateAppReq) (*app.App, error)
+	DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error)
+	GetApp(context.Context, *GetAppReq) (*app.App, error)
+	GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error)
+	GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error)
+	ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error)
+	ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error)
+	// config item related interface.
+	CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error)
+	BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error)
+	UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error)
+	DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error)
+	UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error)
+	UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error)
+	GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error)
+	ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error)
+	ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error)
+	ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error)
+	ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error)
+	// config hook related interface.
+	UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*base.EmptyResp, error)
+	// content related interface.
+	CreateContent(context.Context, *CreateContentReq) (*CreateResp, error)
+	GetContent(context.Context, *GetContentReq) (*content.Content, error)
+	// commit related interface.
+	CreateCommit(context.Context, *CreateCommitReq) (*CreateResp, error)
+	GetLatestCommit(context.Context, *GetLatestCommitReq) (*commit.Commit, error)
+	// release related interface.
+	CreateRelease(context.Context, *CreateReleaseReq) (*CreateResp, error)
+	ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error)
+	GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error)
+	GetRelease(context.Context, *GetReleaseReq) (*release.Release, error)
+	DeprecateRelease(context.Context, *DeprecateReleaseReq) (*base.EmptyResp, error)
+	UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error)
+	DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error)
+	CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error)
+	// released config item related interface.
+	GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error)
+	// released kv related interface.
+	GetReleasedKv(context.Context, *GetReleasedKvReq) (*released_kv.ReleasedKv, error)
+	ListReleasedKvs(context.Context, *ListReleasedKvReq) (*ListReleasedKvResp, error)
+	ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error)
+	// hook related interface.
+	CreateHook(context.Context, *CreateHookReq) (*CreateResp, error)
+	ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error)
+	DeleteHook(context.Context, *DeleteHookReq) (*base.EmptyResp, error)
+	UpdateHook(context.Context, *UpdateHookReq) (*base.EmptyResp, error)
+	ListHookTags(context.Context, *ListHookTagReq) (*ListHookTagResp, error)
+	ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error)
+	GetHook(context.Context, *GetHookReq) (*GetHookResp, error)
+	HookFetchIDsExcluding(context.Context, *HookFetchIDsExcludingReq) (*HookFetchIDsExcludingResp, error)
+	GetHookReferencedIDs(context.Context, *GetHookReferencedIDsReq) (*GetHookReferencedIDsResp, error)
+	// hook revision related interface.
+	CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateResp, error)
+	ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error)
+	GetHookRevisionByID(context.Context, *GetHookRevisionByIdReq) (*hook_revision.HookRevision, error)
+	DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*base.EmptyResp, error)
+	PublishHookRevision(context.Context, *PublishHookRevisionReq) (*base.EmptyResp, error)
+	GetHookRevisionByPubState(context.Context, *GetByPubStateReq) (*hook_revision.HookRevision, error)
+	UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*base.EmptyResp, error)
+	ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error)
+	GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error)
+	// hook exec result related interface.
+	BatchCreateHookExecResults(context.Context, *BatchCreateHookExecResultsReq) (*base.EmptyResp, error)
+	ListHookExecResults(context.Context, *ListHookExecResultsReq) (*ListHookExecResultsResp, error)
+	// file tamper event related interface.
+	BatchCreateFileTamperEvents(context.Context, *BatchCreateFileTamperEventsReq) (*base.EmptyResp, error)
+	ListFileTamperEvents(context.Context, *ListFileTamperEventsReq) (*ListFileTamperEventsResp, error)
+	// hook template related interface.
+	CreateHookTemplate(context.Context, *CreateHookTemplateReq) (*CreateResp, error)
+	ListHookTemplates(context.Context, *ListHookTemplatesReq) (*ListHookTemplatesResp, error)
+	UpdateHookTemplate(context.Context, *UpdateHookTemplateReq) (*UpdateHookTemplateResp, error)
+	DeleteHookTemplate(context.Context, *DeleteHookTemplateReq) (*base.EmptyResp, error)
+	CreateHookFromTemplate(context.Context, *CreateHookFromTemplateReq) (*CreateResp, error)
+	UpgradeHookFromTemplate(context.Context, *UpgradeHookFromTemplateReq) (*base.EmptyResp, error)
+	GetHookTemplateRef(context.Context, *GetHookTemplateRefReq) (*GetHookTemplateRefResp, error)
+	// upgrade the hooks referencing the hook template asynchronously, returns the async operation id.
+	UpgradeHooksFromTemplate(context.Context, *UpgradeHooksFromTemplateReq) (*CreateOperationResp, error)
+	// environment related interface.
+	CreateEnvironment(context.Context, *CreateEnvironmentReq) (*CreateResp, error)
+	ListEnvironments(context.Context, *ListEnvironmentsReq) (*ListEnvironmentsResp, error)
+	UpdateEnvironment(context.Context, *UpdateEnvironmentReq) (*base.EmptyResp, error)
+	DeleteEnvironment(context.Context, *DeleteEnvironmentReq) (*base.EmptyResp, error)
+	BindEnvApp(context.Context, *BindEnvAppReq) (*CreateResp, error)
+	UnbindEnvApp(context.Context, *UnbindEnvAppReq) (*base.EmptyResp, error)
+	ListEnvApps(context.Context, *ListEnvAppsReq) (*ListEnvAppsResp, error)
+	PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error)
+	// config share related interface.
+	CreateConfigShare(context.Context, *CreateConfigShareReq) (*CreateResp, error)
+	UpdateConfigShare(context.Context, *UpdateConfigShareReq) (*base.EmptyResp, error)
+	DeleteConfigShare(context.Context, *DeleteConfigShareReq) (*base.EmptyResp, error)
+	ListConfigShares(context.Context, *ListConfigSharesReq) (*ListConfigSharesResp, error)
+	CreateConfigShareRef(context.Context, *CreateConfigShareRefReq) (*CreateResp, error)
+	DeleteConfigShareRef(context.Context, *DeleteConfigShareRefReq) (*base.EmptyResp, error)
+	ListConfigShareRefs(context.Context, *ListConfigShareRefsReq) (*ListConfigShareRefsResp, error)
+	// recycle bin related interface.
+	ListRecycleBin(context.Context, *ListRecycleBinReq) (*ListRecycleBinResp, error)
+	GetRecycleBin(context.Context, *GetRecycleBinReq) (*recycle_bin.RecycleBin, error)
+	RestoreRecycleBin(context.Context, *RestoreRecycleBinReq) (*base.EmptyResp, error)
+	// app snapshot related interface.
+	CreateAppSnapshot(context.Context, *CreateAppSnapshotReq) (*CreateResp, error)
+	ListAppSnapshots(context.Context, *ListAppSnapshotsReq) (*ListAppSnapshotsResp, error)
+	DeleteAppSnapshot(context.Context, *DeleteAppSnapshotReq) (*base.EmptyResp, error)
+	RestoreAppSnapshot(context.Context, *RestoreAppSnapshotReq) (*base.EmptyResp, error)
+	CloneApp(context.Context, *CloneAppReq) (*CreateResp, error)
+	// clone app asynchronously, returns the async operation id.
+	CloneAppAsync(context.Context, *CloneAppReq) (*CreateOperationResp, error)
+	// draft related interface.
+	UpsertDraftItem(context.Context, *UpsertDraftItemReq) (*base.EmptyResp, error)
+	DeleteDraftItem(context.Context, *DeleteDraftItemReq) (*base.EmptyResp, error)
+	GetDraft(context.Context, *GetDraftReq) (*draft.Draft, error)
+	MergeDraft(context.Context, *MergeDraftReq) (*base.EmptyResp, error)
+	DiscardDraft(context.Context, *DiscardDraftReq) (*base.EmptyResp, error)
+	// full-text search related interface.
+	SearchFullText(context.Context, *SearchFullTextReq) (*SearchFullTextResp, error)
+	// where-used related interface.
+	ListTmplWhereUsed(context.Context, *ListTmplWhereUsedReq) (*ListWhereUsedResp, error)
+	ListTmplVariableWhereUsed(context.Context, *ListTmplVariableWhereUsedReq) (*ListWhereUsedResp, error)
+	// config reference related interface.
+	CreateConfigReference(context.Context, *CreateConfigReferenceReq) (*CreateResp, error)
+	DeleteConfigReference(context.Context, *DeleteConfigReferenceReq) (*base.EmptyResp, error)
+	GetConfigRefGraph(context.Context, *GetConfigRefGraphReq) (*GetConfigRefGraphResp, error)
+	// certificate related interface.
+	ListExpiringCertificates(context.Context, *ListExpiringCertificatesReq) (*ListExpiringCertificatesResp, error)
+	RenewCertificate(context.Context, *RenewCertificateReq) (*base.EmptyResp, error)
+	// kv override related interface.
+	CreateKvOverride(context.Context, *CreateKvOverrideReq) (*CreateResp, error)
+	UpdateKvOverride(context.Context, *UpdateKvOverrideReq) (*base.EmptyResp, error)
+	DeleteKvOverride(context.Context, *DeleteKvOverrideReq) (*base.EmptyResp, error)
+	ListKvOverrides(context.Context, *ListKvOverridesReq) (*ListKvOverridesResp, error)
+	// compliance policy related interface.
+	CreateCompliancePolicy(context.Context, *CreateCompliancePolicyReq) (*CreateResp, error)
+	ListCompliancePolicies(context.Context, *ListCompliancePoliciesReq) (*ListCompliancePoliciesResp, error)
+	UpdateCompliancePolicy(context.Context, *UpdateCompliancePolicyReq) (*base.EmptyResp, error)
+	DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyReq) (*base.EmptyResp, error)
+	TestCompliancePolicy(context.Context, *TestCompliancePolicyReq) (*TestCompliancePolicyResp, error)
+	// notification channel related interface.
+	CreateNotificationChannel(context.Context, *CreateNotificationChannelReq) (*CreateResp, error)
+	ListNotificationChannels(context.Context, *ListNotificationChannelsReq) (*ListNotificationChannelsResp, error)
+	UpdateNotificationChannel(context.Context, *UpdateNotificationChannelReq) (*base.EmptyResp, error)
+	DeleteNotificationChannel(context.Context, *DeleteNotificationChannelReq) (*base.EmptyResp, error)
+	TestNotificationChannel(context.Context, *TestNotificationChannelReq) (*base.EmptyResp, error)
+	// alert rule related interface.
+	CreateAlertRule(context.Context, *CreateAlertRuleReq) (*CreateResp, error)
+	ListAlertRules(context.Context, *ListAlertRulesReq) (*ListAlertRulesResp, error)
+	UpdateAlertRule(context.Context, *UpdateAlertRuleReq) (*base.EmptyResp, error)
+	DeleteAlertRule(context.Context, *DeleteAlertRuleReq) (*base.EmptyResp, error)
+	// client quarantine related interface.
+	CreateClientQuarantine(context.Context, *CreateClientQuarantineReq) (*CreateResp, error)
+	ListClientQuarantines(context.Context, *ListClientQuarantinesReq) (*ListClientQuarantinesResp, error)
+	UpdateClientQuarantine(context.Context, *UpdateClientQuarantineReq) (*base.EmptyResp, error)
+	DeleteClientQuarantine(context.Context, *DeleteClientQuarantineReq) (*base.EmptyResp, error)
+	CreateExperiment(context.Context, *CreateExperimentReq) (*CreateResp, error)
+	ListExperiments(context.Context, *ListExperimentsReq) (*ListExperimentsResp, error)
+	UpdateExperiment(context.Context, *UpdateExperimentReq) (*base.EmptyResp, error)
+	DeleteExperiment(context.Context, *DeleteExperimentReq) (*base.EmptyResp, error)
+	GetExperimentStats(context.Context, *GetExperimentStatsReq) (*GetExperimentStatsResp, error)
+	BatchUpsertExperimentExposures(context.Context, *BatchUpsertExperimentExposuresReq) (*base.EmptyResp, error)
+	// masking profile related interface.
+	GetMaskingProfile(context.Context, *GetMaskingProfileReq) (*GetMaskingProfileResp, error)
+	UpdateMaskingProfile(context.Context, *UpdateMaskingProfileReq) (*base.EmptyResp, error)
+	GetSecretScanSetting(context.Context, *GetSecretScanSettingReq) (*GetSecretScanSettingResp, error)
+	UpdateSecretScanSetting(context.Context, *UpdateSecretScanSettingReq) (*base.EmptyResp, error)
+	ScanSecretLeak(context.Context, *ScanSecretLeakReq) (*ScanSecretLeakResp, error)
+	BatchIncreaseAppConsumption(context.Context, *BatchIncreaseAppConsumptionReq) (*base.EmptyResp, error)
+	GetAppConsumption(context.Context, *GetAppConsumptionReq) (*app.AppConsumption, error)
+	ListTopConsumingApps(context.Context, *ListTopConsumingAppsReq) (*ListTopConsumingAppsResp, error)
+	ListStaleResources(context.Context, *ListStaleResourcesReq) (*ListStaleResourcesResp, error)
+	ListBizUsages(context.Context, *ListBizUsagesReq) (*ListBizUsagesResp, error)
+	// service account related interface.
+	CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*CreateServiceAccountResp, error)
+	ListServiceAccounts(context.Context, *ListServiceAccountsReq) (*ListServiceAccountsResp, error)
+	UpdateServiceAccount(context.Context, *UpdateServiceAccountReq) (*base.EmptyResp, error)
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountReq) (*base.EmptyResp, error)
+	ResetServiceAccountKey(context.Context, *ResetServiceAccountKeyReq) (*ResetServiceAccountKeyResp, error)
+	GetServiceAccountByKey(context.Context, *GetServiceAccountByKeyReq) (*service_account.ServiceAccount, error)
+	GetServiceAccountByName(context.Context, *GetServiceAccountByNameReq) (*service_account.ServiceAccount, error)
+	// app role binding related interface.
+	SetAppRoleBinding(context.Context, *SetAppRoleBindingReq) (*CreateResp, error)
+	ListAppRoleBindings(context.Context, *ListAppRoleBindingsReq) (*ListAppRoleBindingsResp, error)
+	DeleteAppRoleBinding(context.Context, *DeleteAppRoleBindingReq) (*base.EmptyResp, error)
+	ListUserAppRoles(context.Context, *ListUserAppRolesReq) (*ListAppRoleBindingsResp, error)
+	// template space related interface.
+	CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error)
+	ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error)
+	UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*base.EmptyResp, error)
+	DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*base.EmptyResp, error)
+	GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error)
+	CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateResp, error)
+	ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error)
+	// template related interface.
+	CreateTemplate(context.Context, *CreateTemplateReq) (*CreateResp, error)
+	ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error)
+	UpdateTemplate(context.Context, *UpdateTemplateReq) (*base.EmptyResp, error)
+	DeleteTemplate(context.Context, *DeleteTemplateReq) (*base.EmptyResp, error)
+	BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*base.EmptyResp, error)
+	AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*base.EmptyResp, error)
+	DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*base.EmptyResp, error)
+	ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error)
+	ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error)
+	ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error)
+	ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error)
+	ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleReqResp, error)
+	BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesReqResp, error)
+	BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error)
+	// template release related interface.
+	CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateResp, error)
+	UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*CreateResp, error)
+	ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error)
+	GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error)
+	DeleteTemplateRevision(context.Context, *DeleteTemplateRevisionReq) (*base.EmptyResp, error)
+	ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error)
+	ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error)
+	// template set related interface.
+	CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateResp, error)
+	ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error)
+	UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*base.EmptyResp, error)
+	DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*base.EmptyResp, error)
+	ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error)
+	ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error)
+	ListTemplateSetBriefInfoByIDs(context.Context, *ListTemplateSetBriefInfoByIDsReq) (*ListTemplateSetBriefInfoByIDsResp, error)
+	ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error)
+	GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error)
+	// app template binding related interface.
+	CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateResp, error)
+	ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error)
+	UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*base.EmptyResp, error)
+	DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*base.EmptyResp, error)
+	ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error)
+	ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error)
+	GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error)
+	CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error)
+	ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*base.EmptyResp, error)
+	// app template variables related interface.
+	ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error)
+	GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error)
+	GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error)
+	UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*base.EmptyResp, error)
+	ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error)
+	ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error)
+	// template binding relation related interface.
+	ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error)
+	ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error)
+	ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error)
+	ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error)
+	ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error)
+	ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error)
+	ListMultiTmplBoundTmplSets(context.Context, *ListMultiTmplBoundTmplSetsReq) (*ListMultiTmplBoundTmplSetsResp, error)
+	ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error)
+	ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error)
+	ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error)
+	ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error)
+	ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error)
+	ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error)
+	RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*base.EmptyResp, error)
+	CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error)
+	// template variable related interface.
+	CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateResp, error)
+	ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error)
+	UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*base.EmptyResp, error)
+	DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*base.EmptyResp, error)
+	TemplateVariableFetchIDsExcluding(context.Context, *TemplateVariableFetchIDsExcludingReq) (*TemplateVariableFetchIDsExcludingResp, error)
+	ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error)
+	// group related interface.
+	CreateGroup(context.Context, *CreateGroupReq) (*CreateResp, error)
+	ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error)
+	ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error)
+	GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error)
+	UpdateGroup(context.Context, *UpdateGroupReq) (*base.EmptyResp, error)
+	DeleteGroup(context.Context, *DeleteGroupReq) (*base.EmptyResp, error)
+	ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error)
+	PreviewGroupClients(context.Context, *PreviewGroupClientsReq) (*PreviewGroupClientsResp, error)
+	PreviewClientRelease(context.Context, *PreviewClientReleaseReq) (*client_preview.ClientReleasePreview, error)
+	// group current release related interface.
+	CountGroupsReleasedApps(context.Context, *CountGroupsReleasedAppsReq) (*CountGroupsReleasedAppsResp, error)
+	ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error)
+	// publish related interface.
+	Publish(context.Context, *PublishReq) (*PublishResp, error)
+	SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error)
+	Approve(context.Context, *ApproveReq) (*ApproveResp, error)
+	GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error)
+	GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error)
+	ListRecentPublishes(context.Context, *ListRecentPublishesReq) (*ListRecentPublishesResp, error)
+	GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error)
+	EmergencyPublish(context.Context, *EmergencyPublishReq) (*EmergencyPublishResp, error)
+	ListEmergencyPublishes(context.Context, *ListEmergencyPublishesReq) (*ListEmergencyPublishesResp, error)
+	RevokeEmergencyPublish(context.Context, *RevokeEmergencyPublishReq) (*base.EmptyResp, error)
+	// pin the online group to it's release, the subsequent publishes skip it until it's unpinned.
+	PinReleasedGroup(context.Context, *PinReleasedGroupReq) (*base.EmptyResp, error)
+	UnpinReleasedGroup(context.Context, *UnpinReleasedGroupReq) (*base.EmptyResp, error)
+	GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error)
+	// commit config items or kvs and create a release in one call, optionally publish it.
+	BulkRelease(context.Context, *BulkReleaseReq) (*BulkReleaseResp, error)
+	// idempotency key related interface.
+	BeginIdempotentRequest(context.Context, *BeginIdempotentRequestReq) (*BeginIdempotentRequestResp, error)
+	CompleteIdempotentRequest(context.Context, *CompleteIdempotentRequestReq) (*base.EmptyResp, error)
+	AbortIdempotentRequest(context.Context, *AbortIdempotentRequestReq) (*base.EmptyResp, error)
+	// async operation related interface.
+	GetOperation(context.Context, *GetOperationReq) (*operation.Operation, error)
+	ListOperations(context.Context, *ListOperationsReq) (*ListOperationsResp, error)
+	CancelOperation(context.Context, *CancelOperationReq) (*base.EmptyResp, error)
+	// audit related interface.
+	ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error)
+	// list the change timeline of the app, which combines edits, releases, publishes, rollbacks and strategy changes.
+	ListAppTimeline(context.Context, *ListAppTimelineReq) (*ListAppTimelineResp, error)
+	// credential related interface
+	CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error)
+	ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error)
+	DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error)
+	UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error)
+	CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error)
+	// credential scope related interface
+	ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error)
+	UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error)
+	CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error)
+	// kv related interface
+	CreateKv(context.Context, *CreateKvReq) (*CreateResp, error)
+	UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error)
+	ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error)
+	DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error)
+	BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error)
+	TransactKvs(context.Context, *TransactKvsReq) (*TransactKvsResp, error)
+	ListKvHistory(context.Context, *ListKvHistoryReq) (*ListKvHistoryResp, error)
+	DiffKvVersions(context.Context, *DiffKvVersionsReq) (*DiffKvVersionsResp, error)
+	UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error)
+	UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error)
+	KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error)
+	KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error)
+	FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error)
+	CompareKvValue(context.Context, *CompareKvValueReq) (*CompareKvValueResp, error)
+	// client related interface
+	ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error)
+	RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error)
+	// client event related interface
+	ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error)
+	// client query related interface
+	ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error)
+	CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error)
+	UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error)
+	DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error)
+	CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error)
+	// client metrics chart related interface
+	ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error)
+	ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error)
+	// used iam pull resource callback.
+	ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error)
+	FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error)
+	// Ping verifies if the grpc connection is still alive.
+	Ping(context.Context, *PingMsg) (*PingMsg, error)
+	// client metric related interface
+	BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error)
+	// config item compare conflicts related interface
+	CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error)
+	// 获取模板和非模板配置项数量
+	GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error)
+	BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error)
+}
+
+// UnimplementedDataServer should be embedded to have forward compatible implementations.
+type UnimplementedDataServer struct {
+}
+
+func (UnimplementedDataServer) CreateApp(context.Context, *CreateAppReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApp not implemented")
+}
+func (UnimplementedDataServer) UpdateAppState(context.Context, *UpdateAppStateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppState not implemented")
+}
+func (UnimplementedDataServer) PauseConsumption(context.Context, *PauseConsumptionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseConsumption not implemented")
+}
+func (UnimplementedDataServer) ResumeConsumption(context.Context, *ResumeConsumptionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeConsumption not implemented")
+}
+func (UnimplementedDataServer) UpdateApp(context.Context, *UpdateAppReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApp not implemented")
+}
+func (UnimplementedDataServer) DeleteApp(context.Context, *DeleteAppReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApp not implemented")
+}
+func (UnimplementedDataServer) GetApp(context.Context, *GetAppReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApp not implemented")
+}
+func (UnimplementedDataServer) GetAppByID(context.Context, *GetAppByIDReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppByID not implemented")
+}
+func (UnimplementedDataServer) GetAppByName(context.Context, *GetAppByNameReq) (*app.App, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppByName not implemented")
+}
+func (UnimplementedDataServer) ListAppsRest(context.Context, *ListAppsRestReq) (*ListAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppsRest not implemented")
+}
+func (UnimplementedDataServer) ListAppsByIDs(context.Context, *ListAppsByIDsReq) (*ListAppsByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppsByIDs not implemented")
+}
+func (UnimplementedDataServer) CreateConfigItem(context.Context, *CreateConfigItemReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigItem not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertConfigItems(context.Context, *BatchUpsertConfigItemsReq) (*BatchUpsertConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertConfigItems not implemented")
+}
+func (UnimplementedDataServer) UpdateConfigItem(context.Context, *UpdateConfigItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigItem not implemented")
+}
+func (UnimplementedDataServer) DeleteConfigItem(context.Context, *DeleteConfigItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigItem not implemented")
+}
+func (UnimplementedDataServer) UnDeleteConfigItem(context.Context, *UnDeleteConfigItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteConfigItem not implemented")
+}
+func (UnimplementedDataServer) UndoConfigItem(context.Context, *UndoConfigItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoConfigItem not implemented")
+}
+func (UnimplementedDataServer) GetConfigItem(context.Context, *GetConfigItemReq) (*config_item.ConfigItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigItem not implemented")
+}
+func (UnimplementedDataServer) ListConfigItems(context.Context, *ListConfigItemsReq) (*ListConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItems not implemented")
+}
+func (UnimplementedDataServer) ListReleasedConfigItems(context.Context, *ListReleasedConfigItemsReq) (*ListReleasedConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedConfigItems not implemented")
+}
+func (UnimplementedDataServer) ListConfigItemCount(context.Context, *ListConfigItemCountReq) (*ListConfigItemCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemCount not implemented")
+}
+func (UnimplementedDataServer) ListConfigItemByTuple(context.Context, *ListConfigItemByTupleReq) (*ListConfigItemByTupleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigItemByTuple not implemented")
+}
+func (UnimplementedDataServer) UpdateConfigHook(context.Context, *UpdateConfigHookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigHook not implemented")
+}
+func (UnimplementedDataServer) CreateContent(context.Context, *CreateContentReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContent not implemented")
+}
+func (UnimplementedDataServer) GetContent(context.Context, *GetContentReq) (*content.Content, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContent not implemented")
+}
+func (UnimplementedDataServer) CreateCommit(context.Context, *CreateCommitReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommit not implemented")
+}
+func (UnimplementedDataServer) GetLatestCommit(context.Context, *GetLatestCommitReq) (*commit.Commit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestCommit not implemented")
+}
+func (UnimplementedDataServer) CreateRelease(context.Context, *CreateReleaseReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRelease not implemented")
+}
+func (UnimplementedDataServer) ListReleases(context.Context, *ListReleasesReq) (*ListReleasesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleases not implemented")
+}
+func (UnimplementedDataServer) GetReleaseByName(context.Context, *GetReleaseByNameReq) (*release.Release, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseByName not implemented")
+}
+func (UnimplementedDataServer) GetRelease(context.Context, *GetReleaseReq) (*release.Release, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelease not implemented")
+}
+func (UnimplementedDataServer) DeprecateRelease(context.Context, *DeprecateReleaseReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateRelease not implemented")
+}
+func (UnimplementedDataServer) UnDeprecateRelease(context.Context, *UnDeprecateReleaseReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeprecateRelease not implemented")
+}
+func (UnimplementedDataServer) DeleteRelease(context.Context, *DeleteReleaseReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRelease not implemented")
+}
+func (UnimplementedDataServer) CheckReleaseName(context.Context, *CheckReleaseNameReq) (*CheckReleaseNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckReleaseName not implemented")
+}
+func (UnimplementedDataServer) GetReleasedConfigItem(context.Context, *GetReleasedCIReq) (*released_ci.ReleasedConfigItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedConfigItem not implemented")
+}
+func (UnimplementedDataServer) GetReleasedKv(context.Context, *GetReleasedKvReq) (*released_kv.ReleasedKv, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedKv not implemented")
+}
+func (UnimplementedDataServer) ListReleasedKvs(context.Context, *ListReleasedKvReq) (*ListReleasedKvResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedKvs not implemented")
+}
+func (UnimplementedDataServer) ListAllReleasedConfigItems(context.Context, *ListAllReleasedConfigItemsReq) (*ListAllReleasedConfigItemsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllReleasedConfigItems not implemented")
+}
+func (UnimplementedDataServer) CreateHook(context.Context, *CreateHookReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHook not implemented")
+}
+func (UnimplementedDataServer) ListHooks(context.Context, *ListHooksReq) (*ListHooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHooks not implemented")
+}
+func (UnimplementedDataServer) DeleteHook(context.Context, *DeleteHookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHook not implemented")
+}
+func (UnimplementedDataServer) UpdateHook(context.Context, *UpdateHookReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHook not implemented")
+}
+func (UnimplementedDataServer) ListHookTags(context.Context, *ListHookTagReq) (*ListHookTagResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookTags not implemented")
+}
+func (UnimplementedDataServer) ListHookReferences(context.Context, *ListHookReferencesReq) (*ListHookReferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookReferences not implemented")
+}
+func (UnimplementedDataServer) GetHook(context.Context, *GetHookReq) (*GetHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHook not implemented")
+}
+func (UnimplementedDataServer) HookFetchIDsExcluding(context.Context, *HookFetchIDsExcludingReq) (*HookFetchIDsExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HookFetchIDsExcluding not implemented")
+}
+func (UnimplementedDataServer) GetHookReferencedIDs(context.Context, *GetHookReferencedIDsReq) (*GetHookReferencedIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookReferencedIDs not implemented")
+}
+func (UnimplementedDataServer) CreateHookRevision(context.Context, *CreateHookRevisionReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookRevision not implemented")
+}
+func (UnimplementedDataServer) ListHookRevisions(context.Context, *ListHookRevisionsReq) (*ListHookRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisions not implemented")
+}
+func (UnimplementedDataServer) GetHookRevisionByID(context.Context, *GetHookRevisionByIdReq) (*hook_revision.HookRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevisionByID not implemented")
+}
+func (UnimplementedDataServer) DeleteHookRevision(context.Context, *DeleteHookRevisionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookRevision not implemented")
+}
+func (UnimplementedDataServer) PublishHookRevision(context.Context, *PublishHookRevisionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishHookRevision not implemented")
+}
+func (UnimplementedDataServer) GetHookRevisionByPubState(context.Context, *GetByPubStateReq) (*hook_revision.HookRevision, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookRevisionByPubState not implemented")
+}
+func (UnimplementedDataServer) UpdateHookRevision(context.Context, *UpdateHookRevisionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookRevision not implemented")
+}
+func (UnimplementedDataServer) ListHookRevisionReferences(context.Context, *ListHookRevisionReferencesReq) (*ListHookRevisionReferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookRevisionReferences not implemented")
+}
+func (UnimplementedDataServer) GetReleaseHook(context.Context, *GetReleaseHookReq) (*GetReleaseHookResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleaseHook not implemented")
+}
+func (UnimplementedDataServer) BatchCreateHookExecResults(context.Context, *BatchCreateHookExecResultsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateHookExecResults not implemented")
+}
+func (UnimplementedDataServer) ListHookExecResults(context.Context, *ListHookExecResultsReq) (*ListHookExecResultsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookExecResults not implemented")
+}
+func (UnimplementedDataServer) BatchCreateFileTamperEvents(context.Context, *BatchCreateFileTamperEventsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateFileTamperEvents not implemented")
+}
+func (UnimplementedDataServer) ListFileTamperEvents(context.Context, *ListFileTamperEventsReq) (*ListFileTamperEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileTamperEvents not implemented")
+}
+func (UnimplementedDataServer) CreateHookTemplate(context.Context, *CreateHookTemplateReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookTemplate not implemented")
+}
+func (UnimplementedDataServer) ListHookTemplates(context.Context, *ListHookTemplatesReq) (*ListHookTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHookTemplates not implemented")
+}
+func (UnimplementedDataServer) UpdateHookTemplate(context.Context, *UpdateHookTemplateReq) (*UpdateHookTemplateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHookTemplate not implemented")
+}
+func (UnimplementedDataServer) DeleteHookTemplate(context.Context, *DeleteHookTemplateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHookTemplate not implemented")
+}
+func (UnimplementedDataServer) CreateHookFromTemplate(context.Context, *CreateHookFromTemplateReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHookFromTemplate not implemented")
+}
+func (UnimplementedDataServer) UpgradeHookFromTemplate(context.Context, *UpgradeHookFromTemplateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHookFromTemplate not implemented")
+}
+func (UnimplementedDataServer) GetHookTemplateRef(context.Context, *GetHookTemplateRefReq) (*GetHookTemplateRefResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHookTemplateRef not implemented")
+}
+func (UnimplementedDataServer) UpgradeHooksFromTemplate(context.Context, *UpgradeHooksFromTemplateReq) (*CreateOperationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeHooksFromTemplate not implemented")
+}
+func (UnimplementedDataServer) CreateEnvironment(context.Context, *CreateEnvironmentReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironment not implemented")
+}
+func (UnimplementedDataServer) ListEnvironments(context.Context, *ListEnvironmentsReq) (*ListEnvironmentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvironments not implemented")
+}
+func (UnimplementedDataServer) UpdateEnvironment(context.Context, *UpdateEnvironmentReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnvironment not implemented")
+}
+func (UnimplementedDataServer) DeleteEnvironment(context.Context, *DeleteEnvironmentReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
+}
+func (UnimplementedDataServer) BindEnvApp(context.Context, *BindEnvAppReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindEnvApp not implemented")
+}
+func (UnimplementedDataServer) UnbindEnvApp(context.Context, *UnbindEnvAppReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindEnvApp not implemented")
+}
+func (UnimplementedDataServer) ListEnvApps(context.Context, *ListEnvAppsReq) (*ListEnvAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvApps not implemented")
+}
+func (UnimplementedDataServer) PromoteRelease(context.Context, *PromoteReleaseReq) (*PromoteReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteRelease not implemented")
+}
+func (UnimplementedDataServer) CreateConfigShare(context.Context, *CreateConfigShareReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigShare not implemented")
+}
+func (UnimplementedDataServer) UpdateConfigShare(context.Context, *UpdateConfigShareReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConfigShare not implemented")
+}
+func (UnimplementedDataServer) DeleteConfigShare(context.Context, *DeleteConfigShareReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigShare not implemented")
+}
+func (UnimplementedDataServer) ListConfigShares(context.Context, *ListConfigSharesReq) (*ListConfigSharesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigShares not implemented")
+}
+func (UnimplementedDataServer) CreateConfigShareRef(context.Context, *CreateConfigShareRefReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigShareRef not implemented")
+}
+func (UnimplementedDataServer) DeleteConfigShareRef(context.Context, *DeleteConfigShareRefReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigShareRef not implemented")
+}
+func (UnimplementedDataServer) ListConfigShareRefs(context.Context, *ListConfigShareRefsReq) (*ListConfigShareRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigShareRefs not implemented")
+}
+func (UnimplementedDataServer) ListRecycleBin(context.Context, *ListRecycleBinReq) (*ListRecycleBinResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecycleBin not implemented")
+}
+func (UnimplementedDataServer) GetRecycleBin(context.Context, *GetRecycleBinReq) (*recycle_bin.RecycleBin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecycleBin not implemented")
+}
+func (UnimplementedDataServer) RestoreRecycleBin(context.Context, *RestoreRecycleBinReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRecycleBin not implemented")
+}
+func (UnimplementedDataServer) CreateAppSnapshot(context.Context, *CreateAppSnapshotReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppSnapshot not implemented")
+}
+func (UnimplementedDataServer) ListAppSnapshots(context.Context, *ListAppSnapshotsReq) (*ListAppSnapshotsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppSnapshots not implemented")
+}
+func (UnimplementedDataServer) DeleteAppSnapshot(context.Context, *DeleteAppSnapshotReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppSnapshot not implemented")
+}
+func (UnimplementedDataServer) RestoreAppSnapshot(context.Context, *RestoreAppSnapshotReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAppSnapshot not implemented")
+}
+func (UnimplementedDataServer) CloneApp(context.Context, *CloneAppReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneApp not implemented")
+}
+func (UnimplementedDataServer) CloneAppAsync(context.Context, *CloneAppReq) (*CreateOperationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneAppAsync not implemented")
+}
+func (UnimplementedDataServer) UpsertDraftItem(context.Context, *UpsertDraftItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDraftItem not implemented")
+}
+func (UnimplementedDataServer) DeleteDraftItem(context.Context, *DeleteDraftItemReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraftItem not implemented")
+}
+func (UnimplementedDataServer) GetDraft(context.Context, *GetDraftReq) (*draft.Draft, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDraft not implemented")
+}
+func (UnimplementedDataServer) MergeDraft(context.Context, *MergeDraftReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeDraft not implemented")
+}
+func (UnimplementedDataServer) DiscardDraft(context.Context, *DiscardDraftReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardDraft not implemented")
+}
+func (UnimplementedDataServer) SearchFullText(context.Context, *SearchFullTextReq) (*SearchFullTextResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchFullText not implemented")
+}
+func (UnimplementedDataServer) ListTmplWhereUsed(context.Context, *ListTmplWhereUsedReq) (*ListWhereUsedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplWhereUsed not implemented")
+}
+func (UnimplementedDataServer) ListTmplVariableWhereUsed(context.Context, *ListTmplVariableWhereUsedReq) (*ListWhereUsedResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplVariableWhereUsed not implemented")
+}
+func (UnimplementedDataServer) CreateConfigReference(context.Context, *CreateConfigReferenceReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigReference not implemented")
+}
+func (UnimplementedDataServer) DeleteConfigReference(context.Context, *DeleteConfigReferenceReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteConfigReference not implemented")
+}
+func (UnimplementedDataServer) GetConfigRefGraph(context.Context, *GetConfigRefGraphReq) (*GetConfigRefGraphResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigRefGraph not implemented")
+}
+func (UnimplementedDataServer) ListExpiringCertificates(context.Context, *ListExpiringCertificatesReq) (*ListExpiringCertificatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringCertificates not implemented")
+}
+func (UnimplementedDataServer) RenewCertificate(context.Context, *RenewCertificateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewCertificate not implemented")
+}
+func (UnimplementedDataServer) CreateKvOverride(context.Context, *CreateKvOverrideReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKvOverride not implemented")
+}
+func (UnimplementedDataServer) UpdateKvOverride(context.Context, *UpdateKvOverrideReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKvOverride not implemented")
+}
+func (UnimplementedDataServer) DeleteKvOverride(context.Context, *DeleteKvOverrideReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKvOverride not implemented")
+}
+func (UnimplementedDataServer) ListKvOverrides(context.Context, *ListKvOverridesReq) (*ListKvOverridesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvOverrides not implemented")
+}
+func (UnimplementedDataServer) CreateCompliancePolicy(context.Context, *CreateCompliancePolicyReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompliancePolicy not implemented")
+}
+func (UnimplementedDataServer) ListCompliancePolicies(context.Context, *ListCompliancePoliciesReq) (*ListCompliancePoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompliancePolicies not implemented")
+}
+func (UnimplementedDataServer) UpdateCompliancePolicy(context.Context, *UpdateCompliancePolicyReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompliancePolicy not implemented")
+}
+func (UnimplementedDataServer) DeleteCompliancePolicy(context.Context, *DeleteCompliancePolicyReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompliancePolicy not implemented")
+}
+func (UnimplementedDataServer) TestCompliancePolicy(context.Context, *TestCompliancePolicyReq) (*TestCompliancePolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestCompliancePolicy not implemented")
+}
+func (UnimplementedDataServer) CreateNotificationChannel(context.Context, *CreateNotificationChannelReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationChannel not implemented")
+}
+func (UnimplementedDataServer) ListNotificationChannels(context.Context, *ListNotificationChannelsReq) (*ListNotificationChannelsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationChannels not implemented")
+}
+func (UnimplementedDataServer) UpdateNotificationChannel(context.Context, *UpdateNotificationChannelReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationChannel not implemented")
+}
+func (UnimplementedDataServer) DeleteNotificationChannel(context.Context, *DeleteNotificationChannelReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteNotificationChannel not implemented")
+}
+func (UnimplementedDataServer) TestNotificationChannel(context.Context, *TestNotificationChannelReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestNotificationChannel not implemented")
+}
+func (UnimplementedDataServer) CreateAlertRule(context.Context, *CreateAlertRuleReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAlertRule not implemented")
+}
+func (UnimplementedDataServer) ListAlertRules(context.Context, *ListAlertRulesReq) (*ListAlertRulesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAlertRules not implemented")
+}
+func (UnimplementedDataServer) UpdateAlertRule(context.Context, *UpdateAlertRuleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlertRule not implemented")
+}
+func (UnimplementedDataServer) DeleteAlertRule(context.Context, *DeleteAlertRuleReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAlertRule not implemented")
+}
+func (UnimplementedDataServer) CreateClientQuarantine(context.Context, *CreateClientQuarantineReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuarantine not implemented")
+}
+func (UnimplementedDataServer) ListClientQuarantines(context.Context, *ListClientQuarantinesReq) (*ListClientQuarantinesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuarantines not implemented")
+}
+func (UnimplementedDataServer) UpdateClientQuarantine(context.Context, *UpdateClientQuarantineReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuarantine not implemented")
+}
+func (UnimplementedDataServer) DeleteClientQuarantine(context.Context, *DeleteClientQuarantineReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuarantine not implemented")
+}
+func (UnimplementedDataServer) CreateExperiment(context.Context, *CreateExperimentReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperiment not implemented")
+}
+func (UnimplementedDataServer) ListExperiments(context.Context, *ListExperimentsReq) (*ListExperimentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExperiments not implemented")
+}
+func (UnimplementedDataServer) UpdateExperiment(context.Context, *UpdateExperimentReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExperiment not implemented")
+}
+func (UnimplementedDataServer) DeleteExperiment(context.Context, *DeleteExperimentReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperiment not implemented")
+}
+func (UnimplementedDataServer) GetExperimentStats(context.Context, *GetExperimentStatsReq) (*GetExperimentStatsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentStats not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertExperimentExposures(context.Context, *BatchUpsertExperimentExposuresReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertExperimentExposures not implemented")
+}
+func (UnimplementedDataServer) GetMaskingProfile(context.Context, *GetMaskingProfileReq) (*GetMaskingProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMaskingProfile not implemented")
+}
+func (UnimplementedDataServer) UpdateMaskingProfile(context.Context, *UpdateMaskingProfileReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaskingProfile not implemented")
+}
+func (UnimplementedDataServer) GetSecretScanSetting(context.Context, *GetSecretScanSettingReq) (*GetSecretScanSettingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretScanSetting not implemented")
+}
+func (UnimplementedDataServer) UpdateSecretScanSetting(context.Context, *UpdateSecretScanSettingReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecretScanSetting not implemented")
+}
+func (UnimplementedDataServer) ScanSecretLeak(context.Context, *ScanSecretLeakReq) (*ScanSecretLeakResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanSecretLeak not implemented")
+}
+func (UnimplementedDataServer) BatchIncreaseAppConsumption(context.Context, *BatchIncreaseAppConsumptionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchIncreaseAppConsumption not implemented")
+}
+func (UnimplementedDataServer) GetAppConsumption(context.Context, *GetAppConsumptionReq) (*app.AppConsumption, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppConsumption not implemented")
+}
+func (UnimplementedDataServer) ListTopConsumingApps(context.Context, *ListTopConsumingAppsReq) (*ListTopConsumingAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTopConsumingApps not implemented")
+}
+func (UnimplementedDataServer) ListStaleResources(context.Context, *ListStaleResourcesReq) (*ListStaleResourcesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaleResources not implemented")
+}
+func (UnimplementedDataServer) ListBizUsages(context.Context, *ListBizUsagesReq) (*ListBizUsagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBizUsages not implemented")
+}
+func (UnimplementedDataServer) CreateServiceAccount(context.Context, *CreateServiceAccountReq) (*CreateServiceAccountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedDataServer) ListServiceAccounts(context.Context, *ListServiceAccountsReq) (*ListServiceAccountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedDataServer) UpdateServiceAccount(context.Context, *UpdateServiceAccountReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceAccount not implemented")
+}
+func (UnimplementedDataServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedDataServer) ResetServiceAccountKey(context.Context, *ResetServiceAccountKeyReq) (*ResetServiceAccountKeyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetServiceAccountKey not implemented")
+}
+func (UnimplementedDataServer) GetServiceAccountByKey(context.Context, *GetServiceAccountByKeyReq) (*service_account.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountByKey not implemented")
+}
+func (UnimplementedDataServer) GetServiceAccountByName(context.Context, *GetServiceAccountByNameReq) (*service_account.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountByName not implemented")
+}
+func (UnimplementedDataServer) SetAppRoleBinding(context.Context, *SetAppRoleBindingReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAppRoleBinding not implemented")
+}
+func (UnimplementedDataServer) ListAppRoleBindings(context.Context, *ListAppRoleBindingsReq) (*ListAppRoleBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppRoleBindings not implemented")
+}
+func (UnimplementedDataServer) DeleteAppRoleBinding(context.Context, *DeleteAppRoleBindingReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppRoleBinding not implemented")
+}
+func (UnimplementedDataServer) ListUserAppRoles(context.Context, *ListUserAppRolesReq) (*ListAppRoleBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserAppRoles not implemented")
+}
+func (UnimplementedDataServer) CreateTemplateSpace(context.Context, *CreateTemplateSpaceReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSpace not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSpaces(context.Context, *ListTemplateSpacesReq) (*ListTemplateSpacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSpaces not implemented")
+}
+func (UnimplementedDataServer) UpdateTemplateSpace(context.Context, *UpdateTemplateSpaceReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSpace not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplateSpace(context.Context, *DeleteTemplateSpaceReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSpace not implemented")
+}
+func (UnimplementedDataServer) GetAllBizsOfTmplSpaces(context.Context, *base.EmptyReq) (*GetAllBizsOfTmplSpacesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllBizsOfTmplSpaces not implemented")
+}
+func (UnimplementedDataServer) CreateDefaultTmplSpace(context.Context, *CreateDefaultTmplSpaceReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDefaultTmplSpace not implemented")
+}
+func (UnimplementedDataServer) ListTmplSpacesByIDs(context.Context, *ListTmplSpacesByIDsReq) (*ListTmplSpacesByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSpacesByIDs not implemented")
+}
+func (UnimplementedDataServer) CreateTemplate(context.Context, *CreateTemplateReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedDataServer) ListTemplates(context.Context, *ListTemplatesReq) (*ListTemplatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedDataServer) UpdateTemplate(context.Context, *UpdateTemplateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplate(context.Context, *DeleteTemplateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedDataServer) BatchDeleteTemplate(context.Context, *BatchDeleteTemplateReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteTemplate not implemented")
+}
+func (UnimplementedDataServer) AddTmplsToTmplSets(context.Context, *AddTmplsToTmplSetsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTmplsToTmplSets not implemented")
+}
+func (UnimplementedDataServer) DeleteTmplsFromTmplSets(context.Context, *DeleteTmplsFromTmplSetsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTmplsFromTmplSets not implemented")
+}
+func (UnimplementedDataServer) ListTemplatesByIDs(context.Context, *ListTemplatesByIDsReq) (*ListTemplatesByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesByIDs not implemented")
+}
+func (UnimplementedDataServer) ListTemplatesNotBound(context.Context, *ListTemplatesNotBoundReq) (*ListTemplatesNotBoundResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplatesNotBound not implemented")
+}
+func (UnimplementedDataServer) ListTmplsOfTmplSet(context.Context, *ListTmplsOfTmplSetReq) (*ListTmplsOfTmplSetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplsOfTmplSet not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSetsAndRevisions(context.Context, *ListTemplateSetsAndRevisionsReq) (*ListTemplateSetsAndRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsAndRevisions not implemented")
+}
+func (UnimplementedDataServer) ListTemplateByTuple(context.Context, *ListTemplateByTupleReq) (*ListTemplateByTupleReqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateByTuple not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertTemplates(context.Context, *BatchUpsertTemplatesReq) (*BatchUpsertTemplatesReqResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertTemplates not implemented")
+}
+func (UnimplementedDataServer) BatchUpdateTemplatePermissions(context.Context, *BatchUpdateTemplatePermissionsReq) (*BatchUpdateTemplatePermissionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTemplatePermissions not implemented")
+}
+func (UnimplementedDataServer) CreateTemplateRevision(context.Context, *CreateTemplateRevisionReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateRevision not implemented")
+}
+func (UnimplementedDataServer) UpdateTemplateRevision(context.Context, *UpdateTemplateRevisionReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateRevision not implemented")
+}
+func (UnimplementedDataServer) ListTemplateRevisions(context.Context, *ListTemplateRevisionsReq) (*ListTemplateRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisions not implemented")
+}
+func (UnimplementedDataServer) GetTemplateRevision(context.Context, *GetTemplateRevisionReq) (*GetTemplateRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateRevision not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplateRevision(context.Context, *DeleteTemplateRevisionReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateRevision not implemented")
+}
+func (UnimplementedDataServer) ListTemplateRevisionsByIDs(context.Context, *ListTemplateRevisionsByIDsReq) (*ListTemplateRevisionsByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateRevisionsByIDs not implemented")
+}
+func (UnimplementedDataServer) ListTmplRevisionNamesByTmplIDs(context.Context, *ListTmplRevisionNamesByTmplIDsReq) (*ListTmplRevisionNamesByTmplIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionNamesByTmplIDs not implemented")
+}
+func (UnimplementedDataServer) CreateTemplateSet(context.Context, *CreateTemplateSetReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateSet not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSets(context.Context, *ListTemplateSetsReq) (*ListTemplateSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSets not implemented")
+}
+func (UnimplementedDataServer) UpdateTemplateSet(context.Context, *UpdateTemplateSetReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateSet not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplateSet(context.Context, *DeleteTemplateSetReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateSet not implemented")
+}
+func (UnimplementedDataServer) ListAppTemplateSets(context.Context, *ListAppTemplateSetsReq) (*ListAppTemplateSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateSets not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSetsByIDs(context.Context, *ListTemplateSetsByIDsReq) (*ListTemplateSetsByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetsByIDs not implemented")
+}
+func (UnimplementedDataServer) ListTemplateSetBriefInfoByIDs(context.Context, *ListTemplateSetBriefInfoByIDsReq) (*ListTemplateSetBriefInfoByIDsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateSetBriefInfoByIDs not implemented")
+}
+func (UnimplementedDataServer) ListTmplSetsOfBiz(context.Context, *ListTmplSetsOfBizReq) (*ListTmplSetsOfBizResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetsOfBiz not implemented")
+}
+func (UnimplementedDataServer) GetLatestTemplateVersionsInSpace(context.Context, *GetLatestTemplateVersionsInSpaceReq) (*GetLatestTemplateVersionsInSpaceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestTemplateVersionsInSpace not implemented")
+}
+func (UnimplementedDataServer) CreateAppTemplateBinding(context.Context, *CreateAppTemplateBindingReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAppTemplateBinding not implemented")
+}
+func (UnimplementedDataServer) ListAppTemplateBindings(context.Context, *ListAppTemplateBindingsReq) (*ListAppTemplateBindingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTemplateBindings not implemented")
+}
+func (UnimplementedDataServer) UpdateAppTemplateBinding(context.Context, *UpdateAppTemplateBindingReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTemplateBinding not implemented")
+}
+func (UnimplementedDataServer) DeleteAppTemplateBinding(context.Context, *DeleteAppTemplateBindingReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAppTemplateBinding not implemented")
+}
+func (UnimplementedDataServer) ListAppBoundTmplRevisions(context.Context, *ListAppBoundTmplRevisionsReq) (*ListAppBoundTmplRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppBoundTmplRevisions not implemented")
+}
+func (UnimplementedDataServer) ListReleasedAppBoundTmplRevisions(context.Context, *ListReleasedAppBoundTmplRevisionsReq) (*ListReleasedAppBoundTmplRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppBoundTmplRevisions not implemented")
+}
+func (UnimplementedDataServer) GetReleasedAppBoundTmplRevision(context.Context, *GetReleasedAppBoundTmplRevisionReq) (*GetReleasedAppBoundTmplRevisionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppBoundTmplRevision not implemented")
+}
+func (UnimplementedDataServer) CheckAppTemplateBinding(context.Context, *CheckAppTemplateBindingReq) (*CheckAppTemplateBindingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAppTemplateBinding not implemented")
+}
+func (UnimplementedDataServer) ImportFromTemplateSetToApp(context.Context, *ImportFromTemplateSetToAppReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportFromTemplateSetToApp not implemented")
+}
+func (UnimplementedDataServer) ExtractAppTmplVariables(context.Context, *ExtractAppTmplVariablesReq) (*ExtractAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtractAppTmplVariables not implemented")
+}
+func (UnimplementedDataServer) GetAppTmplVariableRefs(context.Context, *GetAppTmplVariableRefsReq) (*GetAppTmplVariableRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppTmplVariableRefs not implemented")
+}
+func (UnimplementedDataServer) GetReleasedAppTmplVariableRefs(context.Context, *GetReleasedAppTmplVariableRefsReq) (*GetReleasedAppTmplVariableRefsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasedAppTmplVariableRefs not implemented")
+}
+func (UnimplementedDataServer) UpdateAppTmplVariables(context.Context, *UpdateAppTmplVariablesReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAppTmplVariables not implemented")
+}
+func (UnimplementedDataServer) ListAppTmplVariables(context.Context, *ListAppTmplVariablesReq) (*ListAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTmplVariables not implemented")
+}
+func (UnimplementedDataServer) ListReleasedAppTmplVariables(context.Context, *ListReleasedAppTmplVariablesReq) (*ListReleasedAppTmplVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReleasedAppTmplVariables not implemented")
+}
+func (UnimplementedDataServer) ListTmplBoundCounts(context.Context, *ListTmplBoundCountsReq) (*ListTmplBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundCounts not implemented")
+}
+func (UnimplementedDataServer) ListTmplRevisionBoundCounts(context.Context, *ListTmplRevisionBoundCountsReq) (*ListTmplRevisionBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundCounts not implemented")
+}
+func (UnimplementedDataServer) ListTmplSetBoundCounts(context.Context, *ListTmplSetBoundCountsReq) (*ListTmplSetBoundCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundCounts not implemented")
+}
+func (UnimplementedDataServer) ListTmplBoundUnnamedApps(context.Context, *ListTmplBoundUnnamedAppsReq) (*ListTmplBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundUnnamedApps not implemented")
+}
+func (UnimplementedDataServer) ListTmplBoundNamedApps(context.Context, *ListTmplBoundNamedAppsReq) (*ListTmplBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundNamedApps not implemented")
+}
+func (UnimplementedDataServer) ListTmplBoundTmplSets(context.Context, *ListTmplBoundTmplSetsReq) (*ListTmplBoundTmplSetsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplBoundTmplSets not implemented")
 }
 func (UnimplementedDataServer) ListMultiTmplBoundTmplSets(context.Context, *ListMultiTmplBoundTmplSetsReq) (*ListMultiTmplBoundTmplSetsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplBoundTmplSets not implemented")
 }
-func (UnimplementedDataServer) ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundUnnamedApps not implemented")
+func (UnimplementedDataServer) ListTmplRevisionBoundUnnamedApps(context.Context, *ListTmplRevisionBoundUnnamedAppsReq) (*ListTmplRevisionBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundUnnamedApps not implemented")
+}
+func (UnimplementedDataServer) ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundNamedApps not implemented")
+}
+func (UnimplementedDataServer) ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundUnnamedApps not implemented")
+}
+func (UnimplementedDataServer) ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplSetBoundUnnamedApps not implemented")
+}
+func (UnimplementedDataServer) ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundNamedApps not implemented")
+}
+func (UnimplementedDataServer) ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLatestTmplBoundUnnamedApps not implemented")
+}
+func (UnimplementedDataServer) RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppBoundTmplSet not implemented")
+}
+func (UnimplementedDataServer) CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckTemplateSetReferencesApps not implemented")
+}
+func (UnimplementedDataServer) CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateVariable not implemented")
+}
+func (UnimplementedDataServer) ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateVariables not implemented")
+}
+func (UnimplementedDataServer) UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateVariable not implemented")
+}
+func (UnimplementedDataServer) DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateVariable not implemented")
+}
+func (UnimplementedDataServer) TemplateVariableFetchIDsExcluding(context.Context, *TemplateVariableFetchIDsExcludingReq) (*TemplateVariableFetchIDsExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TemplateVariableFetchIDsExcluding not implemented")
+}
+func (UnimplementedDataServer) ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateVariables not implemented")
+}
+func (UnimplementedDataServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+}
+func (UnimplementedDataServer) ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllGroups not implemented")
+}
+func (UnimplementedDataServer) ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppGroups not implemented")
+}
+func (UnimplementedDataServer) GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByName not implemented")
+}
+func (UnimplementedDataServer) UpdateGroup(context.Context, *UpdateGroupReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+}
+func (UnimplementedDataServer) DeleteGroup(context.Context, *DeleteGroupReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+}
+func (UnimplementedDataServer) ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSelector not implemented")
+}
+func (UnimplementedDataServer) PreviewGroupClients(context.Context, *PreviewGroupClientsReq) (*PreviewGroupClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewGroupClients not implemented")
+}
+func (UnimplementedDataServer) PreviewClientRelease(context.Context, *PreviewClientReleaseReq) (*client_preview.ClientReleasePreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewClientRelease not implemented")
+}
+func (UnimplementedDataServer) CountGroupsReleasedApps(context.Context, *CountGroupsReleasedAppsReq) (*CountGroupsReleasedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountGroupsReleasedApps not implemented")
+}
+func (UnimplementedDataServer) ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupReleasedApps not implemented")
+}
+func (UnimplementedDataServer) Publish(context.Context, *PublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedDataServer) SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublishApprove not implemented")
+}
+func (UnimplementedDataServer) Approve(context.Context, *ApproveReq) (*ApproveResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedDataServer) GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastSelect not implemented")
+}
+func (UnimplementedDataServer) GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastPublish not implemented")
+}
+func (UnimplementedDataServer) ListRecentPublishes(context.Context, *ListRecentPublishesReq) (*ListRecentPublishesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecentPublishes not implemented")
+}
+func (UnimplementedDataServer) GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReleasesStatus not implemented")
+}
+func (UnimplementedDataServer) EmergencyPublish(context.Context, *EmergencyPublishReq) (*EmergencyPublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyPublish not implemented")
+}
+func (UnimplementedDataServer) ListEmergencyPublishes(context.Context, *ListEmergencyPublishesReq) (*ListEmergencyPublishesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmergencyPublishes not implemented")
+}
+func (UnimplementedDataServer) RevokeEmergencyPublish(context.Context, *RevokeEmergencyPublishReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEmergencyPublish not implemented")
+}
+func (UnimplementedDataServer) PinReleasedGroup(context.Context, *PinReleasedGroupReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinReleasedGroup not implemented")
+}
+func (UnimplementedDataServer) UnpinReleasedGroup(context.Context, *UnpinReleasedGroupReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinReleasedGroup not implemented")
+}
+func (UnimplementedDataServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
+}
+func (UnimplementedDataServer) BulkRelease(context.Context, *BulkReleaseReq) (*BulkReleaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkRelease not implemented")
+}
+func (UnimplementedDataServer) BeginIdempotentRequest(context.Context, *BeginIdempotentRequestReq) (*BeginIdempotentRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginIdempotentRequest not implemented")
+}
+func (UnimplementedDataServer) CompleteIdempotentRequest(context.Context, *CompleteIdempotentRequestReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteIdempotentRequest not implemented")
+}
+func (UnimplementedDataServer) AbortIdempotentRequest(context.Context, *AbortIdempotentRequestReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortIdempotentRequest not implemented")
+}
+func (UnimplementedDataServer) GetOperation(context.Context, *GetOperationReq) (*operation.Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedDataServer) ListOperations(context.Context, *ListOperationsReq) (*ListOperationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedDataServer) CancelOperation(context.Context, *CancelOperationReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOperation not implemented")
+}
+func (UnimplementedDataServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
+}
+func (UnimplementedDataServer) ListAppTimeline(context.Context, *ListAppTimelineReq) (*ListAppTimelineResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAppTimeline not implemented")
+}
+func (UnimplementedDataServer) CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (UnimplementedDataServer) ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+}
+func (UnimplementedDataServer) DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (UnimplementedDataServer) UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (UnimplementedDataServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
+}
+func (UnimplementedDataServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
+}
+func (UnimplementedDataServer) UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScopes not implemented")
+}
+func (UnimplementedDataServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
+}
+func (UnimplementedDataServer) CreateKv(context.Context, *CreateKvReq) (*CreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
+}
+func (UnimplementedDataServer) UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
+}
+func (UnimplementedDataServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
+}
+func (UnimplementedDataServer) DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
+}
+func (UnimplementedDataServer) TransactKvs(context.Context, *TransactKvsReq) (*TransactKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactKvs not implemented")
+}
+func (UnimplementedDataServer) ListKvHistory(context.Context, *ListKvHistoryReq) (*ListKvHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKvHistory not implemented")
+}
+func (UnimplementedDataServer) DiffKvVersions(context.Context, *DiffKvVersionsReq) (*DiffKvVersionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffKvVersions not implemented")
+}
+func (UnimplementedDataServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
+}
+func (UnimplementedDataServer) UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
+}
+func (UnimplementedDataServer) KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KvFetchIDsExcluding not implemented")
+}
+func (UnimplementedDataServer) KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KvFetchKeysExcluding not implemented")
+}
+func (UnimplementedDataServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
+}
+func (UnimplementedDataServer) CompareKvValue(context.Context, *CompareKvValueReq) (*CompareKvValueResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareKvValue not implemented")
+}
+func (UnimplementedDataServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+}
+func (UnimplementedDataServer) RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+}
+func (UnimplementedDataServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+}
+func (UnimplementedDataServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+}
+func (UnimplementedDataServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+}
+func (UnimplementedDataServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+}
+func (UnimplementedDataServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+}
+func (UnimplementedDataServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+}
+func (UnimplementedDataServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+}
+func (UnimplementedDataServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+}
+func (UnimplementedDataServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+}
+func (UnimplementedDataServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+}
+func (UnimplementedDataServer) ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedDataServer) FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchInstanceInfo not implemented")
+}
+func (UnimplementedDataServer) Ping(context.Context, *PingMsg) (*PingMsg, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedDataServer) BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertClientMetrics not implemented")
+}
+func (UnimplementedDataServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+}
+func (UnimplementedDataServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+}
+func (UnimplementedDataServer) BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateLastConsumedTime not implemented")
+}
+
+// UnsafeDataServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DataServer will
+// result in compilation errors.
+type UnsafeDataServer interface {
+	mustEmbedUnimplementedDataServer()
+}
+
+func RegisterDataServer(s grpc.ServiceRegistrar, srv DataServer) {
+	s.RegisterService(&Data_ServiceDesc, srv)
+}
+
+func _Data_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateApp(ctx, req.(*CreateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateAppState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateAppState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateAppState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateAppState(ctx, req.(*UpdateAppStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_PauseConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PauseConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PauseConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PauseConsumption(ctx, req.(*PauseConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ResumeConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeConsumptionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ResumeConsumption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ResumeConsumption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ResumeConsumption(ctx, req.(*ResumeConsumptionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateApp(ctx, req.(*UpdateAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteApp(ctx, req.(*DeleteAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetApp(ctx, req.(*GetAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetAppByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByIDReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetAppByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetAppByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetAppByID(ctx, req.(*GetAppByIDReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetAppByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetAppByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsRestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppsRest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppsRest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListAppsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppsByIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppsByIDs(ctx, req.(*ListAppsByIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BatchUpsertConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BatchUpsertConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeleteConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnDeleteConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnDeleteConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndoConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UndoConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UndoConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigItemByTupleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigItemByTuple(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigItemByTuple_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateContent(ctx, req.(*CreateContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListTmplRevisionBoundNamedApps(context.Context, *ListTmplRevisionBoundNamedAppsReq) (*ListTmplRevisionBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplRevisionBoundNamedApps not implemented")
+
+func _Data_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetContent(ctx, req.(*GetContentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListTmplSetBoundUnnamedApps(context.Context, *ListTmplSetBoundUnnamedAppsReq) (*ListTmplSetBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundUnnamedApps not implemented")
+
+func _Data_CreateCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateCommit(ctx, req.(*CreateCommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListMultiTmplSetBoundUnnamedApps(context.Context, *ListMultiTmplSetBoundUnnamedAppsReq) (*ListMultiTmplSetBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListMultiTmplSetBoundUnnamedApps not implemented")
+
+func _Data_GetLatestCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestCommitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetLatestCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetLatestCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetLatestCommit(ctx, req.(*GetLatestCommitReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListTmplSetBoundNamedApps(context.Context, *ListTmplSetBoundNamedAppsReq) (*ListTmplSetBoundNamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTmplSetBoundNamedApps not implemented")
+
+func _Data_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListLatestTmplBoundUnnamedApps(context.Context, *ListLatestTmplBoundUnnamedAppsReq) (*ListLatestTmplBoundUnnamedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListLatestTmplBoundUnnamedApps not implemented")
+
+func _Data_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleases(ctx, req.(*ListReleasesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) RemoveAppBoundTmplSet(context.Context, *RemoveAppBoundTmplSetReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAppBoundTmplSet not implemented")
+
+func _Data_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseByNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleaseByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleaseByName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CheckTemplateSetReferencesApps(context.Context, *CheckTemplateSetReferencesAppsReq) (*CheckTemplateSetReferencesAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckTemplateSetReferencesApps not implemented")
+
+func _Data_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetRelease(ctx, req.(*GetReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateTemplateVariable(context.Context, *CreateTemplateVariableReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplateVariable not implemented")
+
+func _Data_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListTemplateVariables(context.Context, *ListTemplateVariablesReq) (*ListTemplateVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListTemplateVariables not implemented")
+
+func _Data_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnDeprecateReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnDeprecateRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnDeprecateRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckReleaseNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CheckReleaseName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CheckReleaseName_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedCIReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleasedConfigItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleasedConfigItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleasedConfigItem(ctx, req.(*GetReleasedCIReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateTemplateVariable(context.Context, *UpdateTemplateVariableReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplateVariable not implemented")
+
+func _Data_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleasedKv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleasedKv_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteTemplateVariable(context.Context, *DeleteTemplateVariableReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplateVariable not implemented")
+
+func _Data_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReleasedKvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListReleasedKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListReleasedKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListReleasedKvs(ctx, req.(*ListReleasedKvReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) TemplateVariableFetchIDsExcluding(context.Context, *TemplateVariableFetchIDsExcludingReq) (*TemplateVariableFetchIDsExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TemplateVariableFetchIDsExcluding not implemented")
+
+func _Data_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllReleasedConfigItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAllReleasedConfigItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAllReleasedConfigItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ImportTemplateVariables(context.Context, *ImportTemplateVariablesReq) (*ImportTemplateVariablesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImportTemplateVariables not implemented")
+
+func _Data_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHook(ctx, req.(*CreateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateGroup(context.Context, *CreateGroupReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateGroup not implemented")
+
+func _Data_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHooksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHooks(ctx, req.(*ListHooksReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListAllGroups(context.Context, *ListAllGroupsReq) (*ListAllGroupsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAllGroups not implemented")
+
+func _Data_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteHook(ctx, req.(*DeleteHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListAppGroups(context.Context, *ListAppGroupsReq) (*ListAppGroupsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAppGroups not implemented")
+
+func _Data_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateHook(ctx, req.(*UpdateHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetGroupByName(context.Context, *GetGroupByNameReq) (*group.Group, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetGroupByName not implemented")
+
+func _Data_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookTags(ctx, req.(*ListHookTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateGroup(context.Context, *UpdateGroupReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
+
+func _Data_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteGroup(context.Context, *DeleteGroupReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroup not implemented")
+
+func _Data_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHook(ctx, req.(*GetHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListGroupSelector(context.Context, *ListGroupSelectorReq) (*ListGroupSelectorResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroupSelector not implemented")
+
+func _Data_HookFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HookFetchIDsExcludingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).HookFetchIDsExcluding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_HookFetchIDsExcluding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).HookFetchIDsExcluding(ctx, req.(*HookFetchIDsExcludingReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CountGroupsReleasedApps(context.Context, *CountGroupsReleasedAppsReq) (*CountGroupsReleasedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CountGroupsReleasedApps not implemented")
+
+func _Data_GetHookReferencedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookReferencedIDsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookReferencedIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookReferencedIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookReferencedIDs(ctx, req.(*GetHookReferencedIDsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListGroupReleasedApps(context.Context, *ListGroupReleasedAppsReq) (*ListGroupReleasedAppsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListGroupReleasedApps not implemented")
+
+func _Data_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) Publish(context.Context, *PublishReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+
+func _Data_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) SubmitPublishApprove(context.Context, *SubmitPublishApproveReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPublishApprove not implemented")
+
+func _Data_GetHookRevisionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookRevisionByIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookRevisionByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookRevisionByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookRevisionByID(ctx, req.(*GetHookRevisionByIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) Approve(context.Context, *ApproveReq) (*ApproveResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+
+func _Data_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetLastSelect(context.Context, *GetLastSelectReq) (*GetLastSelectResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastSelect not implemented")
+
+func _Data_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PublishHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PublishHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetLastPublish(context.Context, *GetLastPublishReq) (*GetLastPublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastPublish not implemented")
+
+func _Data_GetHookRevisionByPubState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByPubStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookRevisionByPubState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookRevisionByPubState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookRevisionByPubState(ctx, req.(*GetByPubStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetReleasesStatus(context.Context, *GetReleasesStatusReq) (*strategy.Strategy, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetReleasesStatus not implemented")
+
+func _Data_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookRevisionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateHookRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateHookRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GenerateReleaseAndPublish(context.Context, *GenerateReleaseAndPublishReq) (*PublishResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateReleaseAndPublish not implemented")
+
+func _Data_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookRevisionReferencesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookRevisionReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookRevisionReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListAudits(context.Context, *ListAuditsReq) (*ListAuditsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListAudits not implemented")
+
+func _Data_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReleaseHookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetReleaseHook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetReleaseHook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateCredential(context.Context, *CreateCredentialReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+
+func _Data_BatchCreateHookExecResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateHookExecResultsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BatchCreateHookExecResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BatchCreateHookExecResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BatchCreateHookExecResults(ctx, req.(*BatchCreateHookExecResultsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListCredentials(context.Context, *ListCredentialReq) (*ListCredentialResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentials not implemented")
+
+func _Data_ListHookExecResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookExecResultsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookExecResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookExecResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookExecResults(ctx, req.(*ListHookExecResultsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteCredential(context.Context, *DeleteCredentialReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+
+func _Data_BatchCreateFileTamperEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateFileTamperEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BatchCreateFileTamperEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BatchCreateFileTamperEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BatchCreateFileTamperEvents(ctx, req.(*BatchCreateFileTamperEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateCredential(context.Context, *UpdateCredentialReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+
+func _Data_ListFileTamperEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileTamperEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListFileTamperEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListFileTamperEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListFileTamperEvents(ctx, req.(*ListFileTamperEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CheckCredentialName(context.Context, *CheckCredentialNameReq) (*CheckCredentialNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckCredentialName not implemented")
+
+func _Data_CreateHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHookTemplate(ctx, req.(*CreateHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListCredentialScopes(context.Context, *ListCredentialScopesReq) (*ListCredentialScopesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCredentialScopes not implemented")
+
+func _Data_ListHookTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHookTemplatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListHookTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListHookTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListHookTemplates(ctx, req.(*ListHookTemplatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateCredentialScopes(context.Context, *UpdateCredentialScopesReq) (*UpdateCredentialScopesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredentialScopes not implemented")
+
+func _Data_UpdateHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateHookTemplate(ctx, req.(*UpdateHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CredentialScopePreview(context.Context, *CredentialScopePreviewReq) (*CredentialScopePreviewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CredentialScopePreview not implemented")
+
+func _Data_DeleteHookTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteHookTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteHookTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteHookTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteHookTemplate(ctx, req.(*DeleteHookTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateKv(context.Context, *CreateKvReq) (*CreateResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateKv not implemented")
+
+func _Data_CreateHookFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHookFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateHookFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateHookFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateHookFromTemplate(ctx, req.(*CreateHookFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateKv(context.Context, *UpdateKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateKv not implemented")
+
+func _Data_UpgradeHookFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeHookFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpgradeHookFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpgradeHookFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpgradeHookFromTemplate(ctx, req.(*UpgradeHookFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListKvs(context.Context, *ListKvsReq) (*ListKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListKvs not implemented")
+
+func _Data_GetHookTemplateRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHookTemplateRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetHookTemplateRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetHookTemplateRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetHookTemplateRef(ctx, req.(*GetHookTemplateRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteKv(context.Context, *DeleteKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteKv not implemented")
+
+func _Data_UpgradeHooksFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeHooksFromTemplateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpgradeHooksFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpgradeHooksFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpgradeHooksFromTemplate(ctx, req.(*UpgradeHooksFromTemplateReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BatchUpsertKvs(context.Context, *BatchUpsertKvsReq) (*BatchUpsertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertKvs not implemented")
+
+func _Data_CreateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateEnvironment(ctx, req.(*CreateEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UnDeleteKv(context.Context, *UnDeleteKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnDeleteKv not implemented")
+
+func _Data_ListEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvironmentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListEnvironments(ctx, req.(*ListEnvironmentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UndoKv(context.Context, *UndoKvReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndoKv not implemented")
+
+func _Data_UpdateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateEnvironment(ctx, req.(*UpdateEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) KvFetchIDsExcluding(context.Context, *KvFetchIDsExcludingReq) (*KvFetchIDsExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KvFetchIDsExcluding not implemented")
+
+func _Data_DeleteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteEnvironment(ctx, req.(*DeleteEnvironmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) KvFetchKeysExcluding(context.Context, *KvFetchKeysExcludingReq) (*KvFetchKeysExcludingResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KvFetchKeysExcluding not implemented")
+
+func _Data_BindEnvApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindEnvAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BindEnvApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BindEnvApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BindEnvApp(ctx, req.(*BindEnvAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) FindNearExpiryCertKvs(context.Context, *FindNearExpiryCertKvsReq) (*FindNearExpiryCertKvsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindNearExpiryCertKvs not implemented")
+
+func _Data_UnbindEnvApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbindEnvAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnbindEnvApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnbindEnvApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnbindEnvApp(ctx, req.(*UnbindEnvAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListClients(context.Context, *ListClientsReq) (*ListClientsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClients not implemented")
+
+func _Data_ListEnvApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvAppsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListEnvApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListEnvApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListEnvApps(ctx, req.(*ListEnvAppsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) RetryClients(context.Context, *RetryClientsReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RetryClients not implemented")
+
+func _Data_PromoteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PromoteRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PromoteRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PromoteRelease(ctx, req.(*PromoteReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListClientEvents(context.Context, *ListClientEventsReq) (*ListClientEventsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientEvents not implemented")
+
+func _Data_CreateConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigShare(ctx, req.(*CreateConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListClientQuerys(context.Context, *ListClientQuerysReq) (*ListClientQuerysResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientQuerys not implemented")
+
+func _Data_UpdateConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpdateConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpdateConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpdateConfigShare(ctx, req.(*UpdateConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CreateClientQuery(context.Context, *CreateClientQueryReq) (*CreateClientQueryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClientQuery not implemented")
+
+func _Data_DeleteConfigShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigShareReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteConfigShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteConfigShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteConfigShare(ctx, req.(*DeleteConfigShareReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) UpdateClientQuery(context.Context, *UpdateClientQueryReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClientQuery not implemented")
+
+func _Data_ListConfigShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSharesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigShares(ctx, req.(*ListConfigSharesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) DeleteClientQuery(context.Context, *DeleteClientQueryReq) (*base.EmptyResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClientQuery not implemented")
+
+func _Data_CreateConfigShareRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigShareRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigShareRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigShareRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigShareRef(ctx, req.(*CreateConfigShareRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CheckClientQueryName(context.Context, *CheckClientQueryNameReq) (*CheckClientQueryNameResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckClientQueryName not implemented")
+
+func _Data_DeleteConfigShareRef_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigShareRefReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteConfigShareRef(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteConfigShareRef_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteConfigShareRef(ctx, req.(*DeleteConfigShareRefReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientConfigVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientConfigVersionStatistics not implemented")
+
+func _Data_ListConfigShareRefs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigShareRefsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListConfigShareRefs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListConfigShareRefs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListConfigShareRefs(ctx, req.(*ListConfigShareRefsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientPullTrendStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullTrendStatistics not implemented")
+
+func _Data_ListRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecycleBinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListRecycleBin(ctx, req.(*ListRecycleBinReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientPullStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientPullStatistics not implemented")
+
+func _Data_GetRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecycleBinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetRecycleBin(ctx, req.(*GetRecycleBinReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientLabelStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientLabelStatistics not implemented")
+
+func _Data_RestoreRecycleBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecycleBinReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RestoreRecycleBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RestoreRecycleBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RestoreRecycleBin(ctx, req.(*RestoreRecycleBinReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientAnnotationStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientAnnotationStatistics not implemented")
+
+func _Data_CreateAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateAppSnapshot(ctx, req.(*CreateAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientVersionStatistics(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientVersionStatistics not implemented")
+
+func _Data_ListAppSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppSnapshotsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppSnapshots(ctx, req.(*ListAppSnapshotsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListClientLabelAndAnnotation(context.Context, *ListClientLabelAndAnnotationReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClientLabelAndAnnotation not implemented")
+
+func _Data_DeleteAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteAppSnapshot(ctx, req.(*DeleteAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ClientSpecificFailedReason(context.Context, *client.ClientCommonReq) (*structpb.Struct, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClientSpecificFailedReason not implemented")
+
+func _Data_RestoreAppSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAppSnapshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RestoreAppSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RestoreAppSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RestoreAppSnapshot(ctx, req.(*RestoreAppSnapshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) ListInstances(context.Context, *ListInstancesReq) (*ListInstancesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+
+func _Data_CloneApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CloneApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CloneApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CloneApp(ctx, req.(*CloneAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) FetchInstanceInfo(context.Context, *FetchInstanceInfoReq) (*FetchInstanceInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FetchInstanceInfo not implemented")
+
+func _Data_CloneAppAsync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAppReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CloneAppAsync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CloneAppAsync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CloneAppAsync(ctx, req.(*CloneAppReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) Ping(context.Context, *PingMsg) (*PingMsg, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+
+func _Data_UpsertDraftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDraftItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UpsertDraftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UpsertDraftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UpsertDraftItem(ctx, req.(*UpsertDraftItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BatchUpsertClientMetrics(context.Context, *BatchUpsertClientMetricsReq) (*BatchUpsertClientMetricsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpsertClientMetrics not implemented")
+
+func _Data_DeleteDraftItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftItemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DeleteDraftItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DeleteDraftItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DeleteDraftItem(ctx, req.(*DeleteDraftItemReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) CompareConfigItemConflicts(context.Context, *CompareConfigItemConflictsReq) (*CompareConfigItemConflictsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CompareConfigItemConflicts not implemented")
+
+func _Data_GetDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetDraft(ctx, req.(*GetDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) GetTemplateAndNonTemplateCICount(context.Context, *GetTemplateAndNonTemplateCICountReq) (*GetTemplateAndNonTemplateCICountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTemplateAndNonTemplateCICount not implemented")
+
+func _Data_MergeDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).MergeDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_MergeDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).MergeDraft(ctx, req.(*MergeDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedDataServer) BatchUpdateLastConsumedTime(context.Context, *BatchUpdateLastConsumedTimeReq) (*BatchUpdateLastConsumedTimeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateLastConsumedTime not implemented")
+
+func _Data_DiscardDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DiscardDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DiscardDraft_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DiscardDraft(ctx, req.(*DiscardDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeDataServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to DataServer will
-// result in compilation errors.
-type UnsafeDataServer interface {
-	mustEmbedUnimplementedDataServer()
+func _Data_SearchFullText_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchFullTextReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).SearchFullText(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_SearchFullText_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).SearchFullText(ctx, req.(*SearchFullTextReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterDataServer(s grpc.ServiceRegistrar, srv DataServer) {
-	s.RegisterService(&Data_ServiceDesc, srv)
+func _Data_ListTmplWhereUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplWhereUsedReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListTmplWhereUsed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListTmplWhereUsed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListTmplWhereUsed(ctx, req.(*ListTmplWhereUsedReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAppReq)
+func _Data_ListTmplVariableWhereUsed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTmplVariableWhereUsedReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateApp(ctx, in)
+		return srv.(DataServer).ListTmplVariableWhereUsed(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateApp_FullMethodName,
+		FullMethod: Data_ListTmplVariableWhereUsed_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateApp(ctx, req.(*CreateAppReq))
+		return srv.(DataServer).ListTmplVariableWhereUsed(ctx, req.(*ListTmplVariableWhereUsedReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAppReq)
+func _Data_CreateConfigReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigReferenceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CreateConfigReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CreateConfigReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CreateConfigReference(ctx, req.(*CreateConfigReferenceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DeleteConfigReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigReferenceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateApp(ctx, in)
+		return srv.(DataServer).DeleteConfigReference(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateApp_FullMethodName,
+		FullMethod: Data_DeleteConfigReference_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateApp(ctx, req.(*UpdateAppReq))
+		return srv.(DataServer).DeleteConfigReference(ctx, req.(*DeleteConfigReferenceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAppReq)
+func _Data_GetConfigRefGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRefGraphReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteApp(ctx, in)
+		return srv.(DataServer).GetConfigRefGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteApp_FullMethodName,
+		FullMethod: Data_GetConfigRefGraph_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteApp(ctx, req.(*DeleteAppReq))
+		return srv.(DataServer).GetConfigRefGraph(ctx, req.(*GetConfigRefGraphReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppReq)
+func _Data_ListExpiringCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringCertificatesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetApp(ctx, in)
+		return srv.(DataServer).ListExpiringCertificates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetApp_FullMethodName,
+		FullMethod: Data_ListExpiringCertificates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetApp(ctx, req.(*GetAppReq))
+		return srv.(DataServer).ListExpiringCertificates(ctx, req.(*ListExpiringCertificatesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAppByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByIDReq)
+func _Data_RenewCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewCertificateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAppByID(ctx, in)
+		return srv.(DataServer).RenewCertificate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAppByID_FullMethodName,
+		FullMethod: Data_RenewCertificate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAppByID(ctx, req.(*GetAppByIDReq))
+		return srv.(DataServer).RenewCertificate(ctx, req.(*RenewCertificateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetAppByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAppByNameReq)
+func _Data_CreateKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetAppByName(ctx, in)
+		return srv.(DataServer).CreateKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetAppByName_FullMethodName,
+		FullMethod: Data_CreateKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetAppByName(ctx, req.(*GetAppByNameReq))
+		return srv.(DataServer).CreateKvOverride(ctx, req.(*CreateKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppsRest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsRestReq)
+func _Data_UpdateKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppsRest(ctx, in)
+		return srv.(DataServer).UpdateKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppsRest_FullMethodName,
+		FullMethod: Data_UpdateKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppsRest(ctx, req.(*ListAppsRestReq))
+		return srv.(DataServer).UpdateKvOverride(ctx, req.(*UpdateKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAppsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAppsByIDsReq)
+func _Data_DeleteKvOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteKvOverrideReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAppsByIDs(ctx, in)
+		return srv.(DataServer).DeleteKvOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAppsByIDs_FullMethodName,
+		FullMethod: Data_DeleteKvOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAppsByIDs(ctx, req.(*ListAppsByIDsReq))
+		return srv.(DataServer).DeleteKvOverride(ctx, req.(*DeleteKvOverrideReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateConfigItemReq)
+func _Data_ListKvOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvOverridesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateConfigItem(ctx, in)
+		return srv.(DataServer).ListKvOverrides(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateConfigItem_FullMethodName,
+		FullMethod: Data_ListKvOverrides_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateConfigItem(ctx, req.(*CreateConfigItemReq))
+		return srv.(DataServer).ListKvOverrides(ctx, req.(*ListKvOverridesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_BatchUpsertConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BatchUpsertConfigItemsReq)
+func _Data_CreateCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).BatchUpsertConfigItems(ctx, in)
+		return srv.(DataServer).CreateCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_BatchUpsertConfigItems_FullMethodName,
+		FullMethod: Data_CreateCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpsertConfigItems(ctx, req.(*BatchUpsertConfigItemsReq))
+		return srv.(DataServer).CreateCompliancePolicy(ctx, req.(*CreateCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigItemReq)
+func _Data_ListCompliancePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompliancePoliciesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateConfigItem(ctx, in)
+		return srv.(DataServer).ListCompliancePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateConfigItem_FullMethodName,
+		FullMethod: Data_ListCompliancePolicies_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateConfigItem(ctx, req.(*UpdateConfigItemReq))
+		return srv.(DataServer).ListCompliancePolicies(ctx, req.(*ListCompliancePoliciesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteConfigItemReq)
+func _Data_UpdateCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteConfigItem(ctx, in)
+		return srv.(DataServer).UpdateCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteConfigItem_FullMethodName,
+		FullMethod: Data_UpdateCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteConfigItem(ctx, req.(*DeleteConfigItemReq))
+		return srv.(DataServer).UpdateCompliancePolicy(ctx, req.(*UpdateCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UnDeleteConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeleteConfigItemReq)
+func _Data_DeleteCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UnDeleteConfigItem(ctx, in)
+		return srv.(DataServer).DeleteCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UnDeleteConfigItem_FullMethodName,
+		FullMethod: Data_DeleteCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UnDeleteConfigItem(ctx, req.(*UnDeleteConfigItemReq))
+		return srv.(DataServer).DeleteCompliancePolicy(ctx, req.(*DeleteCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UndoConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UndoConfigItemReq)
+func _Data_TestCompliancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestCompliancePolicyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UndoConfigItem(ctx, in)
+		return srv.(DataServer).TestCompliancePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UndoConfigItem_FullMethodName,
+		FullMethod: Data_TestCompliancePolicy_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UndoConfigItem(ctx, req.(*UndoConfigItemReq))
+		return srv.(DataServer).TestCompliancePolicy(ctx, req.(*TestCompliancePolicyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigItemReq)
+func _Data_CreateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetConfigItem(ctx, in)
+		return srv.(DataServer).CreateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetConfigItem_FullMethodName,
+		FullMethod: Data_CreateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetConfigItem(ctx, req.(*GetConfigItemReq))
+		return srv.(DataServer).CreateNotificationChannel(ctx, req.(*CreateNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemsReq)
+func _Data_ListNotificationChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationChannelsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItems(ctx, in)
+		return srv.(DataServer).ListNotificationChannels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItems_FullMethodName,
+		FullMethod: Data_ListNotificationChannels_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItems(ctx, req.(*ListConfigItemsReq))
+		return srv.(DataServer).ListNotificationChannels(ctx, req.(*ListNotificationChannelsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedConfigItemsReq)
+func _Data_UpdateNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedConfigItems(ctx, in)
+		return srv.(DataServer).UpdateNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedConfigItems_FullMethodName,
+		FullMethod: Data_UpdateNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedConfigItems(ctx, req.(*ListReleasedConfigItemsReq))
+		return srv.(DataServer).UpdateNotificationChannel(ctx, req.(*UpdateNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemCountReq)
+func _Data_DeleteNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItemCount(ctx, in)
+		return srv.(DataServer).DeleteNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItemCount_FullMethodName,
+		FullMethod: Data_DeleteNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItemCount(ctx, req.(*ListConfigItemCountReq))
+		return srv.(DataServer).DeleteNotificationChannel(ctx, req.(*DeleteNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListConfigItemByTuple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListConfigItemByTupleReq)
+func _Data_TestNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestNotificationChannelReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListConfigItemByTuple(ctx, in)
+		return srv.(DataServer).TestNotificationChannel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListConfigItemByTuple_FullMethodName,
+		FullMethod: Data_TestNotificationChannel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListConfigItemByTuple(ctx, req.(*ListConfigItemByTupleReq))
+		return srv.(DataServer).TestNotificationChannel(ctx, req.(*TestNotificationChannelReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateConfigHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateConfigHookReq)
+func _Data_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateConfigHook(ctx, in)
+		return srv.(DataServer).CreateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateConfigHook_FullMethodName,
+		FullMethod: Data_CreateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateConfigHook(ctx, req.(*UpdateConfigHookReq))
+		return srv.(DataServer).CreateAlertRule(ctx, req.(*CreateAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateContentReq)
+func _Data_ListAlertRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAlertRulesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateContent(ctx, in)
+		return srv.(DataServer).ListAlertRules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateContent_FullMethodName,
+		FullMethod: Data_ListAlertRules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateContent(ctx, req.(*CreateContentReq))
+		return srv.(DataServer).ListAlertRules(ctx, req.(*ListAlertRulesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetContentReq)
+func _Data_UpdateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetContent(ctx, in)
+		return srv.(DataServer).UpdateAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetContent_FullMethodName,
+		FullMethod: Data_UpdateAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetContent(ctx, req.(*GetContentReq))
+		return srv.(DataServer).UpdateAlertRule(ctx, req.(*UpdateAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommitReq)
+func _Data_DeleteAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAlertRuleReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateCommit(ctx, in)
+		return srv.(DataServer).DeleteAlertRule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateCommit_FullMethodName,
+		FullMethod: Data_DeleteAlertRule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateCommit(ctx, req.(*CreateCommitReq))
+		return srv.(DataServer).DeleteAlertRule(ctx, req.(*DeleteAlertRuleReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetLatestCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLatestCommitReq)
+func _Data_CreateClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetLatestCommit(ctx, in)
+		return srv.(DataServer).CreateClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetLatestCommit_FullMethodName,
+		FullMethod: Data_CreateClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetLatestCommit(ctx, req.(*GetLatestCommitReq))
+		return srv.(DataServer).CreateClientQuarantine(ctx, req.(*CreateClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateReleaseReq)
+func _Data_ListClientQuarantines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClientQuarantinesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateRelease(ctx, in)
+		return srv.(DataServer).ListClientQuarantines(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateRelease_FullMethodName,
+		FullMethod: Data_ListClientQuarantines_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateRelease(ctx, req.(*CreateReleaseReq))
+		return srv.(DataServer).ListClientQuarantines(ctx, req.(*ListClientQuarantinesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasesReq)
+func _Data_UpdateClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleases(ctx, in)
+		return srv.(DataServer).UpdateClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleases_FullMethodName,
+		FullMethod: Data_UpdateClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleases(ctx, req.(*ListReleasesReq))
+		return srv.(DataServer).UpdateClientQuarantine(ctx, req.(*UpdateClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleaseByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseByNameReq)
+func _Data_DeleteClientQuarantine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClientQuarantineReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleaseByName(ctx, in)
+		return srv.(DataServer).DeleteClientQuarantine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleaseByName_FullMethodName,
+		FullMethod: Data_DeleteClientQuarantine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleaseByName(ctx, req.(*GetReleaseByNameReq))
+		return srv.(DataServer).DeleteClientQuarantine(ctx, req.(*DeleteClientQuarantineReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseReq)
+func _Data_CreateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetRelease(ctx, in)
+		return srv.(DataServer).CreateExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetRelease_FullMethodName,
+		FullMethod: Data_CreateExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetRelease(ctx, req.(*GetReleaseReq))
+		return srv.(DataServer).CreateExperiment(ctx, req.(*CreateExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeprecateReleaseReq)
+func _Data_ListExperiments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExperimentsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeprecateRelease(ctx, in)
+		return srv.(DataServer).ListExperiments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeprecateRelease_FullMethodName,
+		FullMethod: Data_ListExperiments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeprecateRelease(ctx, req.(*DeprecateReleaseReq))
+		return srv.(DataServer).ListExperiments(ctx, req.(*ListExperimentsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UnDeprecateRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnDeprecateReleaseReq)
+func _Data_UpdateExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UnDeprecateRelease(ctx, in)
+		return srv.(DataServer).UpdateExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UnDeprecateRelease_FullMethodName,
+		FullMethod: Data_UpdateExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UnDeprecateRelease(ctx, req.(*UnDeprecateReleaseReq))
+		return srv.(DataServer).UpdateExperiment(ctx, req.(*UpdateExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteReleaseReq)
+func _Data_DeleteExperiment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperimentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteRelease(ctx, in)
+		return srv.(DataServer).DeleteExperiment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteRelease_FullMethodName,
+		FullMethod: Data_DeleteExperiment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteRelease(ctx, req.(*DeleteReleaseReq))
+		return srv.(DataServer).DeleteExperiment(ctx, req.(*DeleteExperimentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CheckReleaseName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckReleaseNameReq)
+func _Data_GetExperimentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentStatsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CheckReleaseName(ctx, in)
+		return srv.(DataServer).GetExperimentStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CheckReleaseName_FullMethodName,
+		FullMethod: Data_GetExperimentStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CheckReleaseName(ctx, req.(*CheckReleaseNameReq))
+		return srv.(DataServer).GetExperimentStats(ctx, req.(*GetExperimentStatsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedConfigItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedCIReq)
+func _Data_BatchUpsertExperimentExposures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpsertExperimentExposuresReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedConfigItem(ctx, in)
+		return srv.(DataServer).BatchUpsertExperimentExposures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedConfigItem_FullMethodName,
+		FullMethod: Data_BatchUpsertExperimentExposures_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedConfigItem(ctx, req.(*GetReleasedCIReq))
+		return srv.(DataServer).BatchUpsertExperimentExposures(ctx, req.(*BatchUpsertExperimentExposuresReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleasedKv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleasedKvReq)
+func _Data_GetMaskingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaskingProfileReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleasedKv(ctx, in)
+		return srv.(DataServer).GetMaskingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleasedKv_FullMethodName,
+		FullMethod: Data_GetMaskingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleasedKv(ctx, req.(*GetReleasedKvReq))
+		return srv.(DataServer).GetMaskingProfile(ctx, req.(*GetMaskingProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListReleasedKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListReleasedKvReq)
+func _Data_UpdateMaskingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaskingProfileReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListReleasedKvs(ctx, in)
+		return srv.(DataServer).UpdateMaskingProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListReleasedKvs_FullMethodName,
+		FullMethod: Data_UpdateMaskingProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListReleasedKvs(ctx, req.(*ListReleasedKvReq))
+		return srv.(DataServer).UpdateMaskingProfile(ctx, req.(*UpdateMaskingProfileReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListAllReleasedConfigItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAllReleasedConfigItemsReq)
+func _Data_GetSecretScanSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretScanSettingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListAllReleasedConfigItems(ctx, in)
+		return srv.(DataServer).GetSecretScanSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListAllReleasedConfigItems_FullMethodName,
+		FullMethod: Data_GetSecretScanSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListAllReleasedConfigItems(ctx, req.(*ListAllReleasedConfigItemsReq))
+		return srv.(DataServer).GetSecretScanSetting(ctx, req.(*GetSecretScanSettingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookReq)
+func _Data_UpdateSecretScanSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSecretScanSettingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateHook(ctx, in)
+		return srv.(DataServer).UpdateSecretScanSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateHook_FullMethodName,
+		FullMethod: Data_UpdateSecretScanSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateHook(ctx, req.(*CreateHookReq))
+		return srv.(DataServer).UpdateSecretScanSetting(ctx, req.(*UpdateSecretScanSettingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHooksReq)
+func _Data_ScanSecretLeak_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanSecretLeakReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHooks(ctx, in)
+		return srv.(DataServer).ScanSecretLeak(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHooks_FullMethodName,
+		FullMethod: Data_ScanSecretLeak_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHooks(ctx, req.(*ListHooksReq))
+		return srv.(DataServer).ScanSecretLeak(ctx, req.(*ScanSecretLeakReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookReq)
+func _Data_BatchIncreaseAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchIncreaseAppConsumptionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteHook(ctx, in)
+		return srv.(DataServer).BatchIncreaseAppConsumption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteHook_FullMethodName,
+		FullMethod: Data_BatchIncreaseAppConsumption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteHook(ctx, req.(*DeleteHookReq))
+		return srv.(DataServer).BatchIncreaseAppConsumption(ctx, req.(*BatchIncreaseAppConsumptionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookReq)
+func _Data_GetAppConsumption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppConsumptionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateHook(ctx, in)
+		return srv.(DataServer).GetAppConsumption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateHook_FullMethodName,
+		FullMethod: Data_GetAppConsumption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateHook(ctx, req.(*UpdateHookReq))
+		return srv.(DataServer).GetAppConsumption(ctx, req.(*GetAppConsumptionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookTagReq)
+func _Data_ListTopConsumingApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTopConsumingAppsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookTags(ctx, in)
+		return srv.(DataServer).ListTopConsumingApps(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookTags_FullMethodName,
+		FullMethod: Data_ListTopConsumingApps_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookTags(ctx, req.(*ListHookTagReq))
+		return srv.(DataServer).ListTopConsumingApps(ctx, req.(*ListTopConsumingAppsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookReferencesReq)
+func _Data_ListStaleResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaleResourcesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookReferences(ctx, in)
+		return srv.(DataServer).ListStaleResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookReferences_FullMethodName,
+		FullMethod: Data_ListStaleResources_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookReferences(ctx, req.(*ListHookReferencesReq))
+		return srv.(DataServer).ListStaleResources(ctx, req.(*ListStaleResourcesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReq)
+func _Data_ListBizUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBizUsagesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHook(ctx, in)
+		return srv.(DataServer).ListBizUsages(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHook_FullMethodName,
+		FullMethod: Data_ListBizUsages_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHook(ctx, req.(*GetHookReq))
+		return srv.(DataServer).ListBizUsages(ctx, req.(*ListBizUsagesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_HookFetchIDsExcluding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HookFetchIDsExcludingReq)
+func _Data_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).HookFetchIDsExcluding(ctx, in)
+		return srv.(DataServer).CreateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_HookFetchIDsExcluding_FullMethodName,
+		FullMethod: Data_CreateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).HookFetchIDsExcluding(ctx, req.(*HookFetchIDsExcludingReq))
+		return srv.(DataServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookReferencedIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookReferencedIDsReq)
+func _Data_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookReferencedIDs(ctx, in)
+		return srv.(DataServer).ListServiceAccounts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookReferencedIDs_FullMethodName,
+		FullMethod: Data_ListServiceAccounts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookReferencedIDs(ctx, req.(*GetHookReferencedIDsReq))
+		return srv.(DataServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_CreateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateHookRevisionReq)
+func _Data_UpdateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).CreateHookRevision(ctx, in)
+		return srv.(DataServer).UpdateServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_CreateHookRevision_FullMethodName,
+		FullMethod: Data_UpdateServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).CreateHookRevision(ctx, req.(*CreateHookRevisionReq))
+		return srv.(DataServer).UpdateServiceAccount(ctx, req.(*UpdateServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionsReq)
+func _Data_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookRevisions(ctx, in)
+		return srv.(DataServer).DeleteServiceAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookRevisions_FullMethodName,
+		FullMethod: Data_DeleteServiceAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookRevisions(ctx, req.(*ListHookRevisionsReq))
+		return srv.(DataServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookRevisionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHookRevisionByIdReq)
+func _Data_ResetServiceAccountKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetServiceAccountKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookRevisionByID(ctx, in)
+		return srv.(DataServer).ResetServiceAccountKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookRevisionByID_FullMethodName,
+		FullMethod: Data_ResetServiceAccountKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookRevisionByID(ctx, req.(*GetHookRevisionByIdReq))
+		return srv.(DataServer).ResetServiceAccountKey(ctx, req.(*ResetServiceAccountKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_DeleteHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteHookRevisionReq)
+func _Data_GetServiceAccountByKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountByKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).DeleteHookRevision(ctx, in)
+		return srv.(DataServer).GetServiceAccountByKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_DeleteHookRevision_FullMethodName,
+		FullMethod: Data_GetServiceAccountByKey_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).DeleteHookRevision(ctx, req.(*DeleteHookRevisionReq))
+		return srv.(DataServer).GetServiceAccountByKey(ctx, req.(*GetServiceAccountByKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_PublishHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishHookRevisionReq)
+func _Data_GetServiceAccountByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceAccountByNameReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).PublishHookRevision(ctx, in)
+		return srv.(DataServer).GetServiceAccountByName(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_PublishHookRevision_FullMethodName,
+		FullMethod: Data_GetServiceAccountByName_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).PublishHookRevision(ctx, req.(*PublishHookRevisionReq))
+		return srv.(DataServer).GetServiceAccountByName(ctx, req.(*GetServiceAccountByNameReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetHookRevisionByPubState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetByPubStateReq)
+func _Data_SetAppRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAppRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetHookRevisionByPubState(ctx, in)
+		return srv.(DataServer).SetAppRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetHookRevisionByPubState_FullMethodName,
+		FullMethod: Data_SetAppRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetHookRevisionByPubState(ctx, req.(*GetByPubStateReq))
+		return srv.(DataServer).SetAppRoleBinding(ctx, req.(*SetAppRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_UpdateHookRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateHookRevisionReq)
+func _Data_ListAppRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppRoleBindingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).UpdateHookRevision(ctx, in)
+		return srv.(DataServer).ListAppRoleBindings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_UpdateHookRevision_FullMethodName,
+		FullMethod: Data_ListAppRoleBindings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).UpdateHookRevision(ctx, req.(*UpdateHookRevisionReq))
+		return srv.(DataServer).ListAppRoleBindings(ctx, req.(*ListAppRoleBindingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_ListHookRevisionReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListHookRevisionReferencesReq)
+func _Data_DeleteAppRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAppRoleBindingReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).ListHookRevisionReferences(ctx, in)
+		return srv.(DataServer).DeleteAppRoleBinding(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_ListHookRevisionReferences_FullMethodName,
+		FullMethod: Data_DeleteAppRoleBinding_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).ListHookRevisionReferences(ctx, req.(*ListHookRevisionReferencesReq))
+		return srv.(DataServer).DeleteAppRoleBinding(ctx, req.(*DeleteAppRoleBindingReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Data_GetReleaseHook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetReleaseHookReq)
+func _Data_ListUserAppRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserAppRolesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DataServer).GetReleaseHook(ctx, in)
+		return srv.(DataServer).ListUserAppRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Data_GetReleaseHook_FullMethodName,
+		FullMethod: Data_ListUserAppRoles_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).GetReleaseHook(ctx, req.(*GetReleaseHookReq))
+		return srv.(DataServer).ListUserAppRoles(ctx, req.(*ListUserAppRolesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5325,6 +9017,42 @@ func _Data_ListGroupSelector_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_PreviewGroupClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewGroupClientsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PreviewGroupClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PreviewGroupClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PreviewGroupClients(ctx, req.(*PreviewGroupClientsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_PreviewClientRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewClientReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PreviewClientRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PreviewClientRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PreviewClientRelease(ctx, req.(*PreviewClientReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_CountGroupsReleasedApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CountGroupsReleasedAppsReq)
 	if err := dec(in); err != nil {
@@ -5451,6 +9179,24 @@ func _Data_GetLastPublish_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ListRecentPublishes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecentPublishesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListRecentPublishes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListRecentPublishes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListRecentPublishes(ctx, req.(*ListRecentPublishesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetReleasesStatusReq)
 	if err := dec(in); err != nil {
@@ -5469,6 +9215,96 @@ func _Data_GetReleasesStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_EmergencyPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmergencyPublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).EmergencyPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_EmergencyPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).EmergencyPublish(ctx, req.(*EmergencyPublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListEmergencyPublishes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmergencyPublishesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListEmergencyPublishes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListEmergencyPublishes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListEmergencyPublishes(ctx, req.(*ListEmergencyPublishesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_RevokeEmergencyPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeEmergencyPublishReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).RevokeEmergencyPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_RevokeEmergencyPublish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).RevokeEmergencyPublish(ctx, req.(*RevokeEmergencyPublishReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_PinReleasedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinReleasedGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).PinReleasedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_PinReleasedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).PinReleasedGroup(ctx, req.(*PinReleasedGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_UnpinReleasedGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinReleasedGroupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).UnpinReleasedGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_UnpinReleasedGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).UnpinReleasedGroup(ctx, req.(*UnpinReleasedGroupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateReleaseAndPublishReq)
 	if err := dec(in); err != nil {
@@ -5487,6 +9323,132 @@ func _Data_GenerateReleaseAndPublish_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_BulkRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkReleaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BulkRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BulkRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BulkRelease(ctx, req.(*BulkReleaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_BeginIdempotentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginIdempotentRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).BeginIdempotentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_BeginIdempotentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).BeginIdempotentRequest(ctx, req.(*BeginIdempotentRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CompleteIdempotentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteIdempotentRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CompleteIdempotentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CompleteIdempotentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CompleteIdempotentRequest(ctx, req.(*CompleteIdempotentRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_AbortIdempotentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortIdempotentRequestReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).AbortIdempotentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_AbortIdempotentRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).AbortIdempotentRequest(ctx, req.(*AbortIdempotentRequestReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).GetOperation(ctx, req.(*GetOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListOperations(ctx, req.(*ListOperationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_CancelOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOperationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CancelOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CancelOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CancelOperation(ctx, req.(*CancelOperationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAuditsReq)
 	if err := dec(in); err != nil {
@@ -5505,6 +9467,24 @@ func _Data_ListAudits_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_ListAppTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAppTimelineReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListAppTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListAppTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListAppTimeline(ctx, req.(*ListAppTimelineReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateCredentialReq)
 	if err := dec(in); err != nil {
@@ -5734,7 +9714,61 @@ func _Data_BatchUpsertKvs_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: Data_BatchUpsertKvs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+		return srv.(DataServer).BatchUpsertKvs(ctx, req.(*BatchUpsertKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_TransactKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).TransactKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_TransactKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).TransactKvs(ctx, req.(*TransactKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_ListKvHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKvHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).ListKvHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_ListKvHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).ListKvHistory(ctx, req.(*ListKvHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Data_DiffKvVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffKvVersionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).DiffKvVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_DiffKvVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).DiffKvVersions(ctx, req.(*DiffKvVersionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5829,6 +9863,24 @@ func _Data_FindNearExpiryCertKvs_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Data_CompareKvValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareKvValueReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServer).CompareKvValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Data_CompareKvValue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServer).CompareKvValue(ctx, req.(*CompareKvValueReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Data_ListClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListClientsReq)
 	if err := dec(in); err != nil {
@@ -6254,6 +10306,18 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateApp",
 			Handler:    _Data_CreateApp_Handler,
 		},
+		{
+			MethodName: "UpdateAppState",
+			Handler:    _Data_UpdateAppState_Handler,
+		},
+		{
+			MethodName: "PauseConsumption",
+			Handler:    _Data_PauseConsumption_Handler,
+		},
+		{
+			MethodName: "ResumeConsumption",
+			Handler:    _Data_ResumeConsumption_Handler,
+		},
 		{
 			MethodName: "UpdateApp",
 			Handler:    _Data_UpdateApp_Handler,
@@ -6466,6 +10530,398 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReleaseHook",
 			Handler:    _Data_GetReleaseHook_Handler,
 		},
+		{
+			MethodName: "BatchCreateHookExecResults",
+			Handler:    _Data_BatchCreateHookExecResults_Handler,
+		},
+		{
+			MethodName: "ListHookExecResults",
+			Handler:    _Data_ListHookExecResults_Handler,
+		},
+		{
+			MethodName: "BatchCreateFileTamperEvents",
+			Handler:    _Data_BatchCreateFileTamperEvents_Handler,
+		},
+		{
+			MethodName: "ListFileTamperEvents",
+			Handler:    _Data_ListFileTamperEvents_Handler,
+		},
+		{
+			MethodName: "CreateHookTemplate",
+			Handler:    _Data_CreateHookTemplate_Handler,
+		},
+		{
+			MethodName: "ListHookTemplates",
+			Handler:    _Data_ListHookTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateHookTemplate",
+			Handler:    _Data_UpdateHookTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteHookTemplate",
+			Handler:    _Data_DeleteHookTemplate_Handler,
+		},
+		{
+			MethodName: "CreateHookFromTemplate",
+			Handler:    _Data_CreateHookFromTemplate_Handler,
+		},
+		{
+			MethodName: "UpgradeHookFromTemplate",
+			Handler:    _Data_UpgradeHookFromTemplate_Handler,
+		},
+		{
+			MethodName: "GetHookTemplateRef",
+			Handler:    _Data_GetHookTemplateRef_Handler,
+		},
+		{
+			MethodName: "UpgradeHooksFromTemplate",
+			Handler:    _Data_UpgradeHooksFromTemplate_Handler,
+		},
+		{
+			MethodName: "CreateEnvironment",
+			Handler:    _Data_CreateEnvironment_Handler,
+		},
+		{
+			MethodName: "ListEnvironments",
+			Handler:    _Data_ListEnvironments_Handler,
+		},
+		{
+			MethodName: "UpdateEnvironment",
+			Handler:    _Data_UpdateEnvironment_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironment",
+			Handler:    _Data_DeleteEnvironment_Handler,
+		},
+		{
+			MethodName: "BindEnvApp",
+			Handler:    _Data_BindEnvApp_Handler,
+		},
+		{
+			MethodName: "UnbindEnvApp",
+			Handler:    _Data_UnbindEnvApp_Handler,
+		},
+		{
+			MethodName: "ListEnvApps",
+			Handler:    _Data_ListEnvApps_Handler,
+		},
+		{
+			MethodName: "PromoteRelease",
+			Handler:    _Data_PromoteRelease_Handler,
+		},
+		{
+			MethodName: "CreateConfigShare",
+			Handler:    _Data_CreateConfigShare_Handler,
+		},
+		{
+			MethodName: "UpdateConfigShare",
+			Handler:    _Data_UpdateConfigShare_Handler,
+		},
+		{
+			MethodName: "DeleteConfigShare",
+			Handler:    _Data_DeleteConfigShare_Handler,
+		},
+		{
+			MethodName: "ListConfigShares",
+			Handler:    _Data_ListConfigShares_Handler,
+		},
+		{
+			MethodName: "CreateConfigShareRef",
+			Handler:    _Data_CreateConfigShareRef_Handler,
+		},
+		{
+			MethodName: "DeleteConfigShareRef",
+			Handler:    _Data_DeleteConfigShareRef_Handler,
+		},
+		{
+			MethodName: "ListConfigShareRefs",
+			Handler:    _Data_ListConfigShareRefs_Handler,
+		},
+		{
+			MethodName: "ListRecycleBin",
+			Handler:    _Data_ListRecycleBin_Handler,
+		},
+		{
+			MethodName: "GetRecycleBin",
+			Handler:    _Data_GetRecycleBin_Handler,
+		},
+		{
+			MethodName: "RestoreRecycleBin",
+			Handler:    _Data_RestoreRecycleBin_Handler,
+		},
+		{
+			MethodName: "CreateAppSnapshot",
+			Handler:    _Data_CreateAppSnapshot_Handler,
+		},
+		{
+			MethodName: "ListAppSnapshots",
+			Handler:    _Data_ListAppSnapshots_Handler,
+		},
+		{
+			MethodName: "DeleteAppSnapshot",
+			Handler:    _Data_DeleteAppSnapshot_Handler,
+		},
+		{
+			MethodName: "RestoreAppSnapshot",
+			Handler:    _Data_RestoreAppSnapshot_Handler,
+		},
+		{
+			MethodName: "CloneApp",
+			Handler:    _Data_CloneApp_Handler,
+		},
+		{
+			MethodName: "CloneAppAsync",
+			Handler:    _Data_CloneAppAsync_Handler,
+		},
+		{
+			MethodName: "UpsertDraftItem",
+			Handler:    _Data_UpsertDraftItem_Handler,
+		},
+		{
+			MethodName: "DeleteDraftItem",
+			Handler:    _Data_DeleteDraftItem_Handler,
+		},
+		{
+			MethodName: "GetDraft",
+			Handler:    _Data_GetDraft_Handler,
+		},
+		{
+			MethodName: "MergeDraft",
+			Handler:    _Data_MergeDraft_Handler,
+		},
+		{
+			MethodName: "DiscardDraft",
+			Handler:    _Data_DiscardDraft_Handler,
+		},
+		{
+			MethodName: "SearchFullText",
+			Handler:    _Data_SearchFullText_Handler,
+		},
+		{
+			MethodName: "ListTmplWhereUsed",
+			Handler:    _Data_ListTmplWhereUsed_Handler,
+		},
+		{
+			MethodName: "ListTmplVariableWhereUsed",
+			Handler:    _Data_ListTmplVariableWhereUsed_Handler,
+		},
+		{
+			MethodName: "CreateConfigReference",
+			Handler:    _Data_CreateConfigReference_Handler,
+		},
+		{
+			MethodName: "DeleteConfigReference",
+			Handler:    _Data_DeleteConfigReference_Handler,
+		},
+		{
+			MethodName: "GetConfigRefGraph",
+			Handler:    _Data_GetConfigRefGraph_Handler,
+		},
+		{
+			MethodName: "ListExpiringCertificates",
+			Handler:    _Data_ListExpiringCertificates_Handler,
+		},
+		{
+			MethodName: "RenewCertificate",
+			Handler:    _Data_RenewCertificate_Handler,
+		},
+		{
+			MethodName: "CreateKvOverride",
+			Handler:    _Data_CreateKvOverride_Handler,
+		},
+		{
+			MethodName: "UpdateKvOverride",
+			Handler:    _Data_UpdateKvOverride_Handler,
+		},
+		{
+			MethodName: "DeleteKvOverride",
+			Handler:    _Data_DeleteKvOverride_Handler,
+		},
+		{
+			MethodName: "ListKvOverrides",
+			Handler:    _Data_ListKvOverrides_Handler,
+		},
+		{
+			MethodName: "CreateCompliancePolicy",
+			Handler:    _Data_CreateCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "ListCompliancePolicies",
+			Handler:    _Data_ListCompliancePolicies_Handler,
+		},
+		{
+			MethodName: "UpdateCompliancePolicy",
+			Handler:    _Data_UpdateCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteCompliancePolicy",
+			Handler:    _Data_DeleteCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "TestCompliancePolicy",
+			Handler:    _Data_TestCompliancePolicy_Handler,
+		},
+		{
+			MethodName: "CreateNotificationChannel",
+			Handler:    _Data_CreateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "ListNotificationChannels",
+			Handler:    _Data_ListNotificationChannels_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationChannel",
+			Handler:    _Data_UpdateNotificationChannel_Handler,
+		},
+		{
+			MethodName: "DeleteNotificationChannel",
+			Handler:    _Data_DeleteNotificationChannel_Handler,
+		},
+		{
+			MethodName: "TestNotificationChannel",
+			Handler:    _Data_TestNotificationChannel_Handler,
+		},
+		{
+			MethodName: "CreateAlertRule",
+			Handler:    _Data_CreateAlertRule_Handler,
+		},
+		{
+			MethodName: "ListAlertRules",
+			Handler:    _Data_ListAlertRules_Handler,
+		},
+		{
+			MethodName: "UpdateAlertRule",
+			Handler:    _Data_UpdateAlertRule_Handler,
+		},
+		{
+			MethodName: "DeleteAlertRule",
+			Handler:    _Data_DeleteAlertRule_Handler,
+		},
+		{
+			MethodName: "CreateClientQuarantine",
+			Handler:    _Data_CreateClientQuarantine_Handler,
+		},
+		{
+			MethodName: "ListClientQuarantines",
+			Handler:    _Data_ListClientQuarantines_Handler,
+		},
+		{
+			MethodName: "UpdateClientQuarantine",
+			Handler:    _Data_UpdateClientQuarantine_Handler,
+		},
+		{
+			MethodName: "DeleteClientQuarantine",
+			Handler:    _Data_DeleteClientQuarantine_Handler,
+		},
+		{
+			MethodName: "CreateExperiment",
+			Handler:    _Data_CreateExperiment_Handler,
+		},
+		{
+			MethodName: "ListExperiments",
+			Handler:    _Data_ListExperiments_Handler,
+		},
+		{
+			MethodName: "UpdateExperiment",
+			Handler:    _Data_UpdateExperiment_Handler,
+		},
+		{
+			MethodName: "DeleteExperiment",
+			Handler:    _Data_DeleteExperiment_Handler,
+		},
+		{
+			MethodName: "GetExperimentStats",
+			Handler:    _Data_GetExperimentStats_Handler,
+		},
+		{
+			MethodName: "BatchUpsertExperimentExposures",
+			Handler:    _Data_BatchUpsertExperimentExposures_Handler,
+		},
+		{
+			MethodName: "GetMaskingProfile",
+			Handler:    _Data_GetMaskingProfile_Handler,
+		},
+		{
+			MethodName: "UpdateMaskingProfile",
+			Handler:    _Data_UpdateMaskingProfile_Handler,
+		},
+		{
+			MethodName: "GetSecretScanSetting",
+			Handler:    _Data_GetSecretScanSetting_Handler,
+		},
+		{
+			MethodName: "UpdateSecretScanSetting",
+			Handler:    _Data_UpdateSecretScanSetting_Handler,
+		},
+		{
+			MethodName: "ScanSecretLeak",
+			Handler:    _Data_ScanSecretLeak_Handler,
+		},
+		{
+			MethodName: "BatchIncreaseAppConsumption",
+			Handler:    _Data_BatchIncreaseAppConsumption_Handler,
+		},
+		{
+			MethodName: "GetAppConsumption",
+			Handler:    _Data_GetAppConsumption_Handler,
+		},
+		{
+			MethodName: "ListTopConsumingApps",
+			Handler:    _Data_ListTopConsumingApps_Handler,
+		},
+		{
+			MethodName: "ListStaleResources",
+			Handler:    _Data_ListStaleResources_Handler,
+		},
+		{
+			MethodName: "ListBizUsages",
+			Handler:    _Data_ListBizUsages_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _Data_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _Data_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "UpdateServiceAccount",
+			Handler:    _Data_UpdateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _Data_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "ResetServiceAccountKey",
+			Handler:    _Data_ResetServiceAccountKey_Handler,
+		},
+		{
+			MethodName: "GetServiceAccountByKey",
+			Handler:    _Data_GetServiceAccountByKey_Handler,
+		},
+		{
+			MethodName: "GetServiceAccountByName",
+			Handler:    _Data_GetServiceAccountByName_Handler,
+		},
+		{
+			MethodName: "SetAppRoleBinding",
+			Handler:    _Data_SetAppRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListAppRoleBindings",
+			Handler:    _Data_ListAppRoleBindings_Handler,
+		},
+		{
+			MethodName: "DeleteAppRoleBinding",
+			Handler:    _Data_DeleteAppRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListUserAppRoles",
+			Handler:    _Data_ListUserAppRoles_Handler,
+		},
 		{
 			MethodName: "CreateTemplateSpace",
 			Handler:    _Data_CreateTemplateSpace_Handler,
@@ -6786,6 +11242,14 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListGroupSelector",
 			Handler:    _Data_ListGroupSelector_Handler,
 		},
+		{
+			MethodName: "PreviewGroupClients",
+			Handler:    _Data_PreviewGroupClients_Handler,
+		},
+		{
+			MethodName: "PreviewClientRelease",
+			Handler:    _Data_PreviewClientRelease_Handler,
+		},
 		{
 			MethodName: "CountGroupsReleasedApps",
 			Handler:    _Data_CountGroupsReleasedApps_Handler,
@@ -6814,18 +11278,74 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastPublish",
 			Handler:    _Data_GetLastPublish_Handler,
 		},
+		{
+			MethodName: "ListRecentPublishes",
+			Handler:    _Data_ListRecentPublishes_Handler,
+		},
 		{
 			MethodName: "GetReleasesStatus",
 			Handler:    _Data_GetReleasesStatus_Handler,
 		},
+		{
+			MethodName: "EmergencyPublish",
+			Handler:    _Data_EmergencyPublish_Handler,
+		},
+		{
+			MethodName: "ListEmergencyPublishes",
+			Handler:    _Data_ListEmergencyPublishes_Handler,
+		},
+		{
+			MethodName: "RevokeEmergencyPublish",
+			Handler:    _Data_RevokeEmergencyPublish_Handler,
+		},
+		{
+			MethodName: "PinReleasedGroup",
+			Handler:    _Data_PinReleasedGroup_Handler,
+		},
+		{
+			MethodName: "UnpinReleasedGroup",
+			Handler:    _Data_UnpinReleasedGroup_Handler,
+		},
 		{
 			MethodName: "GenerateReleaseAndPublish",
 			Handler:    _Data_GenerateReleaseAndPublish_Handler,
 		},
+		{
+			MethodName: "BulkRelease",
+			Handler:    _Data_BulkRelease_Handler,
+		},
+		{
+			MethodName: "BeginIdempotentRequest",
+			Handler:    _Data_BeginIdempotentRequest_Handler,
+		},
+		{
+			MethodName: "CompleteIdempotentRequest",
+			Handler:    _Data_CompleteIdempotentRequest_Handler,
+		},
+		{
+			MethodName: "AbortIdempotentRequest",
+			Handler:    _Data_AbortIdempotentRequest_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _Data_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _Data_ListOperations_Handler,
+		},
+		{
+			MethodName: "CancelOperation",
+			Handler:    _Data_CancelOperation_Handler,
+		},
 		{
 			MethodName: "ListAudits",
 			Handler:    _Data_ListAudits_Handler,
 		},
+		{
+			MethodName: "ListAppTimeline",
+			Handler:    _Data_ListAppTimeline_Handler,
+		},
 		{
 			MethodName: "CreateCredential",
 			Handler:    _Data_CreateCredential_Handler,
@@ -6878,6 +11398,18 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpsertKvs",
 			Handler:    _Data_BatchUpsertKvs_Handler,
 		},
+		{
+			MethodName: "TransactKvs",
+			Handler:    _Data_TransactKvs_Handler,
+		},
+		{
+			MethodName: "ListKvHistory",
+			Handler:    _Data_ListKvHistory_Handler,
+		},
+		{
+			MethodName: "DiffKvVersions",
+			Handler:    _Data_DiffKvVersions_Handler,
+		},
 		{
 			MethodName: "UnDeleteKv",
 			Handler:    _Data_UnDeleteKv_Handler,
@@ -6898,6 +11430,10 @@ var Data_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindNearExpiryCertKvs",
 			Handler:    _Data_FindNearExpiryCertKvs_Handler,
 		},
+		{
+			MethodName: "CompareKvValue",
+			Handler:    _Data_CompareKvValue_Handler,
+		},
 		{
 			MethodName: "ListClients",
 			Handler:    _Data_ListClients_Handler,
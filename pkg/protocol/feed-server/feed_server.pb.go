@@ -910,6 +910,9 @@ type GetDownloadURLReq struct {
 	BizId      uint32           `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
 	FileMeta   *FileMeta        `protobuf:"bytes,3,opt,name=file_meta,json=fileMeta,proto3" json:"file_meta,omitempty"`
 	Token      string           `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// the release id of the file which the client already has, used to download the binary patch instead of
+	// the full content, optional.
+	BaseReleaseId uint32 `protobuf:"varint,5,opt,name=base_release_id,json=baseReleaseId,proto3" json:"base_release_id,omitempty"`
 }
 
 func (x *GetDownloadURLReq) Reset() {
@@ -970,14 +973,22 @@ func (x *GetDownloadURLReq) GetToken() string {
 	return ""
 }
 
+func (x *GetDownloadURLReq) GetBaseReleaseId() uint32 {
+	if x != nil {
+		return x.BaseReleaseId
+	}
+	return 0
+}
+
 type GetDownloadURLResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Url         string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                       // compatible with old versions
-	WaitTimeMil int64    `protobuf:"varint,2,opt,name=wait_time_mil,json=waitTimeMil,proto3" json:"wait_time_mil,omitempty"` // the time(milliseconds) that the client should wait before downloading file
-	Urls        []string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`                                     // used for high availability
+	Url         string     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`                                       // compatible with old versions
+	WaitTimeMil int64      `protobuf:"varint,2,opt,name=wait_time_mil,json=waitTimeMil,proto3" json:"wait_time_mil,omitempty"` // the time(milliseconds) that the client should wait before downloading file
+	Urls        []string   `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty"`                                     // used for high availability
+	Patch       *FilePatch `protobuf:"bytes,4,opt,name=patch,proto3" json:"patch,omitempty"`                                   // the binary patch from the base release's content, empty if not available
 }
 
 func (x *GetDownloadURLResp) Reset() {
@@ -1031,6 +1042,85 @@ func (x *GetDownloadURLResp) GetUrls() []string {
 	return nil
 }
 
+func (x *GetDownloadURLResp) GetPatch() *FilePatch {
+	if x != nil {
+		return x.Patch
+	}
+	return nil
+}
+
+// FilePatch is the binary patch which updates the base content to the target content, the client should
+// verify the patched content with the target signature, and fall back to download the full content with urls
+// when the patch can not be applied.
+type FilePatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Urls          []string `protobuf:"bytes,1,rep,name=urls,proto3" json:"urls,omitempty"`
+	Signature     string   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`                              // sha256 of the patch
+	ByteSize      uint64   `protobuf:"varint,3,opt,name=byte_size,json=byteSize,proto3" json:"byte_size,omitempty"`               // byte size of the patch
+	BaseSignature string   `protobuf:"bytes,4,opt,name=base_signature,json=baseSignature,proto3" json:"base_signature,omitempty"` // sha256 of the base content which the patch applies to
+}
+
+func (x *FilePatch) Reset() {
+	*x = FilePatch{}
+	mi := &file_feed_server_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FilePatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilePatch) ProtoMessage() {}
+
+func (x *FilePatch) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilePatch.ProtoReflect.Descriptor instead.
+func (*FilePatch) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FilePatch) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *FilePatch) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *FilePatch) GetByteSize() uint64 {
+	if x != nil {
+		return x.ByteSize
+	}
+	return 0
+}
+
+func (x *FilePatch) GetBaseSignature() string {
+	if x != nil {
+		return x.BaseSignature
+	}
+	return ""
+}
+
 // App 对外简单版本
 type App struct {
 	state         protoimpl.MessageState
@@ -1045,7 +1135,7 @@ type App struct {
 
 func (x *App) Reset() {
 	*x = App{}
-	mi := &file_feed_server_proto_msgTypes[15]
+	mi := &file_feed_server_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1147,7 @@ func (x *App) String() string {
 func (*App) ProtoMessage() {}
 
 func (x *App) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[15]
+	mi := &file_feed_server_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1160,7 @@ func (x *App) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use App.ProtoReflect.Descriptor instead.
 func (*App) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{15}
+	return file_feed_server_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *App) GetId() uint32 {
@@ -1112,7 +1202,7 @@ type ListAppsReq struct {
 
 func (x *ListAppsReq) Reset() {
 	*x = ListAppsReq{}
-	mi := &file_feed_server_proto_msgTypes[16]
+	mi := &file_feed_server_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1124,7 +1214,7 @@ func (x *ListAppsReq) String() string {
 func (*ListAppsReq) ProtoMessage() {}
 
 func (x *ListAppsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[16]
+	mi := &file_feed_server_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1227,7 @@ func (x *ListAppsReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAppsReq.ProtoReflect.Descriptor instead.
 func (*ListAppsReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{16}
+	return file_feed_server_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAppsReq) GetBizId() uint32 {
@@ -1164,7 +1254,7 @@ type ListAppsResp struct {
 
 func (x *ListAppsResp) Reset() {
 	*x = ListAppsResp{}
-	mi := &file_feed_server_proto_msgTypes[17]
+	mi := &file_feed_server_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1176,7 +1266,7 @@ func (x *ListAppsResp) String() string {
 func (*ListAppsResp) ProtoMessage() {}
 
 func (x *ListAppsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[17]
+	mi := &file_feed_server_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1279,7 @@ func (x *ListAppsResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAppsResp.ProtoReflect.Descriptor instead.
 func (*ListAppsResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{17}
+	return file_feed_server_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListAppsResp) GetApps() []*App {
@@ -1211,7 +1301,7 @@ type PullKvMetaReq struct {
 
 func (x *PullKvMetaReq) Reset() {
 	*x = PullKvMetaReq{}
-	mi := &file_feed_server_proto_msgTypes[18]
+	mi := &file_feed_server_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1223,7 +1313,7 @@ func (x *PullKvMetaReq) String() string {
 func (*PullKvMetaReq) ProtoMessage() {}
 
 func (x *PullKvMetaReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[18]
+	mi := &file_feed_server_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1236,7 +1326,7 @@ func (x *PullKvMetaReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullKvMetaReq.ProtoReflect.Descriptor instead.
 func (*PullKvMetaReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{18}
+	return file_feed_server_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *PullKvMetaReq) GetBizId() uint32 {
@@ -1271,7 +1361,7 @@ type PullKvMetaResp struct {
 
 func (x *PullKvMetaResp) Reset() {
 	*x = PullKvMetaResp{}
-	mi := &file_feed_server_proto_msgTypes[19]
+	mi := &file_feed_server_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +1373,7 @@ func (x *PullKvMetaResp) String() string {
 func (*PullKvMetaResp) ProtoMessage() {}
 
 func (x *PullKvMetaResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[19]
+	mi := &file_feed_server_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1386,7 @@ func (x *PullKvMetaResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullKvMetaResp.ProtoReflect.Descriptor instead.
 func (*PullKvMetaResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{19}
+	return file_feed_server_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *PullKvMetaResp) GetReleaseId() uint32 {
@@ -1323,11 +1413,13 @@ type KvMeta struct {
 	Revision     *base.Revision       `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
 	KvAttachment *kv.KvAttachment     `protobuf:"bytes,4,opt,name=kv_attachment,json=kvAttachment,proto3" json:"kv_attachment,omitempty"`
 	ContentSpec  *content.ContentSpec `protobuf:"bytes,5,opt,name=content_spec,json=contentSpec,proto3" json:"content_spec,omitempty"`
+	// 实例参与的配置项实验, 客户端按 uid 分桶后使用分桶的值代替发布的值
+	Experiment *KvExperiment `protobuf:"bytes,6,opt,name=experiment,proto3" json:"experiment,omitempty"`
 }
 
 func (x *KvMeta) Reset() {
 	*x = KvMeta{}
-	mi := &file_feed_server_proto_msgTypes[20]
+	mi := &file_feed_server_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1339,7 +1431,7 @@ func (x *KvMeta) String() string {
 func (*KvMeta) ProtoMessage() {}
 
 func (x *KvMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[20]
+	mi := &file_feed_server_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1444,7 @@ func (x *KvMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KvMeta.ProtoReflect.Descriptor instead.
 func (*KvMeta) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{20}
+	return file_feed_server_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *KvMeta) GetKey() string {
@@ -1390,6 +1482,135 @@ func (x *KvMeta) GetContentSpec() *content.ContentSpec {
 	return nil
 }
 
+func (x *KvMeta) GetExperiment() *KvExperiment {
+	if x != nil {
+		return x.Experiment
+	}
+	return nil
+}
+
+type KvExperiment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Variants []*KvExperimentVariant `protobuf:"bytes,3,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *KvExperiment) Reset() {
+	*x = KvExperiment{}
+	mi := &file_feed_server_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvExperiment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvExperiment) ProtoMessage() {}
+
+func (x *KvExperiment) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvExperiment.ProtoReflect.Descriptor instead.
+func (*KvExperiment) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *KvExperiment) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KvExperiment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KvExperiment) GetVariants() []*KvExperimentVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type KvExperimentVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *KvExperimentVariant) Reset() {
+	*x = KvExperimentVariant{}
+	mi := &file_feed_server_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvExperimentVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvExperimentVariant) ProtoMessage() {}
+
+func (x *KvExperimentVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvExperimentVariant.ProtoReflect.Descriptor instead.
+func (*KvExperimentVariant) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *KvExperimentVariant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KvExperimentVariant) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *KvExperimentVariant) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type GetKvValueReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1402,7 +1623,7 @@ type GetKvValueReq struct {
 
 func (x *GetKvValueReq) Reset() {
 	*x = GetKvValueReq{}
-	mi := &file_feed_server_proto_msgTypes[21]
+	mi := &file_feed_server_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1414,7 +1635,7 @@ func (x *GetKvValueReq) String() string {
 func (*GetKvValueReq) ProtoMessage() {}
 
 func (x *GetKvValueReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[21]
+	mi := &file_feed_server_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1427,7 +1648,7 @@ func (x *GetKvValueReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKvValueReq.ProtoReflect.Descriptor instead.
 func (*GetKvValueReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{21}
+	return file_feed_server_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetKvValueReq) GetBizId() uint32 {
@@ -1462,7 +1683,7 @@ type GetKvValueResp struct {
 
 func (x *GetKvValueResp) Reset() {
 	*x = GetKvValueResp{}
-	mi := &file_feed_server_proto_msgTypes[22]
+	mi := &file_feed_server_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1474,7 +1695,7 @@ func (x *GetKvValueResp) String() string {
 func (*GetKvValueResp) ProtoMessage() {}
 
 func (x *GetKvValueResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[22]
+	mi := &file_feed_server_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1487,7 +1708,7 @@ func (x *GetKvValueResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetKvValueResp.ProtoReflect.Descriptor instead.
 func (*GetKvValueResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{22}
+	return file_feed_server_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetKvValueResp) GetKvType() string {
@@ -1504,6 +1725,201 @@ func (x *GetKvValueResp) GetValue() string {
 	return ""
 }
 
+type GetKvsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId   uint32   `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppMeta *AppMeta `protobuf:"bytes,2,opt,name=app_meta,json=appMeta,proto3" json:"app_meta,omitempty"`
+	// keys 指定的key列表, 与 match 同时为空时返回全部有权限的kv
+	Keys []string `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	// match key的匹配规则, 如 "prefix*"
+	Match []string `protobuf:"bytes,4,rep,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *GetKvsReq) Reset() {
+	*x = GetKvsReq{}
+	mi := &file_feed_server_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKvsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKvsReq) ProtoMessage() {}
+
+func (x *GetKvsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKvsReq.ProtoReflect.Descriptor instead.
+func (*GetKvsReq) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetKvsReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *GetKvsReq) GetAppMeta() *AppMeta {
+	if x != nil {
+		return x.AppMeta
+	}
+	return nil
+}
+
+func (x *GetKvsReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetKvsReq) GetMatch() []string {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+type GetKvsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// release_id 返回的kv所属版本, 可用于一致性校验
+	ReleaseId uint32     `protobuf:"varint,1,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	Kvs       []*KvValue `protobuf:"bytes,2,rep,name=kvs,proto3" json:"kvs,omitempty"`
+	// not_found_keys keys 中在该版本不存在的key
+	NotFoundKeys []string `protobuf:"bytes,3,rep,name=not_found_keys,json=notFoundKeys,proto3" json:"not_found_keys,omitempty"`
+}
+
+func (x *GetKvsResp) Reset() {
+	*x = GetKvsResp{}
+	mi := &file_feed_server_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKvsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKvsResp) ProtoMessage() {}
+
+func (x *GetKvsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKvsResp.ProtoReflect.Descriptor instead.
+func (*GetKvsResp) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetKvsResp) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *GetKvsResp) GetKvs() []*KvValue {
+	if x != nil {
+		return x.Kvs
+	}
+	return nil
+}
+
+func (x *GetKvsResp) GetNotFoundKeys() []string {
+	if x != nil {
+		return x.NotFoundKeys
+	}
+	return nil
+}
+
+type KvValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	KvType string `protobuf:"bytes,2,opt,name=kv_type,json=kvType,proto3" json:"kv_type,omitempty"`
+	Value  string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *KvValue) Reset() {
+	*x = KvValue{}
+	mi := &file_feed_server_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KvValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KvValue) ProtoMessage() {}
+
+func (x *KvValue) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KvValue.ProtoReflect.Descriptor instead.
+func (*KvValue) Descriptor() ([]byte, []int) {
+	return file_feed_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *KvValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KvValue) GetKvType() string {
+	if x != nil {
+		return x.KvType
+	}
+	return ""
+}
+
+func (x *KvValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type AsyncDownloadReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1521,7 +1937,7 @@ type AsyncDownloadReq struct {
 
 func (x *AsyncDownloadReq) Reset() {
 	*x = AsyncDownloadReq{}
-	mi := &file_feed_server_proto_msgTypes[23]
+	mi := &file_feed_server_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1533,7 +1949,7 @@ func (x *AsyncDownloadReq) String() string {
 func (*AsyncDownloadReq) ProtoMessage() {}
 
 func (x *AsyncDownloadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[23]
+	mi := &file_feed_server_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,7 +1962,7 @@ func (x *AsyncDownloadReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncDownloadReq.ProtoReflect.Descriptor instead.
 func (*AsyncDownloadReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{23}
+	return file_feed_server_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *AsyncDownloadReq) GetBizId() uint32 {
@@ -1608,7 +2024,7 @@ type AsyncDownloadResp struct {
 
 func (x *AsyncDownloadResp) Reset() {
 	*x = AsyncDownloadResp{}
-	mi := &file_feed_server_proto_msgTypes[24]
+	mi := &file_feed_server_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1620,7 +2036,7 @@ func (x *AsyncDownloadResp) String() string {
 func (*AsyncDownloadResp) ProtoMessage() {}
 
 func (x *AsyncDownloadResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[24]
+	mi := &file_feed_server_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1633,7 +2049,7 @@ func (x *AsyncDownloadResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncDownloadResp.ProtoReflect.Descriptor instead.
 func (*AsyncDownloadResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{24}
+	return file_feed_server_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *AsyncDownloadResp) GetTaskId() string {
@@ -1654,7 +2070,7 @@ type AsyncDownloadStatusReq struct {
 
 func (x *AsyncDownloadStatusReq) Reset() {
 	*x = AsyncDownloadStatusReq{}
-	mi := &file_feed_server_proto_msgTypes[25]
+	mi := &file_feed_server_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1666,7 +2082,7 @@ func (x *AsyncDownloadStatusReq) String() string {
 func (*AsyncDownloadStatusReq) ProtoMessage() {}
 
 func (x *AsyncDownloadStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[25]
+	mi := &file_feed_server_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,7 +2095,7 @@ func (x *AsyncDownloadStatusReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncDownloadStatusReq.ProtoReflect.Descriptor instead.
 func (*AsyncDownloadStatusReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{25}
+	return file_feed_server_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AsyncDownloadStatusReq) GetBizId() uint32 {
@@ -1706,7 +2122,7 @@ type AsyncDownloadStatusResp struct {
 
 func (x *AsyncDownloadStatusResp) Reset() {
 	*x = AsyncDownloadStatusResp{}
-	mi := &file_feed_server_proto_msgTypes[26]
+	mi := &file_feed_server_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1718,7 +2134,7 @@ func (x *AsyncDownloadStatusResp) String() string {
 func (*AsyncDownloadStatusResp) ProtoMessage() {}
 
 func (x *AsyncDownloadStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[26]
+	mi := &file_feed_server_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,7 +2147,7 @@ func (x *AsyncDownloadStatusResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AsyncDownloadStatusResp.ProtoReflect.Descriptor instead.
 func (*AsyncDownloadStatusResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{26}
+	return file_feed_server_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *AsyncDownloadStatusResp) GetStatus() AsyncDownloadStatus {
@@ -1753,7 +2169,7 @@ type GetSingleKvValueReq struct {
 
 func (x *GetSingleKvValueReq) Reset() {
 	*x = GetSingleKvValueReq{}
-	mi := &file_feed_server_proto_msgTypes[27]
+	mi := &file_feed_server_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1765,7 +2181,7 @@ func (x *GetSingleKvValueReq) String() string {
 func (*GetSingleKvValueReq) ProtoMessage() {}
 
 func (x *GetSingleKvValueReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[27]
+	mi := &file_feed_server_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,7 +2194,7 @@ func (x *GetSingleKvValueReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSingleKvValueReq.ProtoReflect.Descriptor instead.
 func (*GetSingleKvValueReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{27}
+	return file_feed_server_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetSingleKvValueReq) GetBizId() uint32 {
@@ -1812,7 +2228,7 @@ type GetSingleKvValueResp struct {
 
 func (x *GetSingleKvValueResp) Reset() {
 	*x = GetSingleKvValueResp{}
-	mi := &file_feed_server_proto_msgTypes[28]
+	mi := &file_feed_server_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1824,7 +2240,7 @@ func (x *GetSingleKvValueResp) String() string {
 func (*GetSingleKvValueResp) ProtoMessage() {}
 
 func (x *GetSingleKvValueResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[28]
+	mi := &file_feed_server_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,7 +2253,7 @@ func (x *GetSingleKvValueResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSingleKvValueResp.ProtoReflect.Descriptor instead.
 func (*GetSingleKvValueResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{28}
+	return file_feed_server_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetSingleKvValueResp) GetData() string {
@@ -1857,7 +2273,7 @@ type GetSingleKvMetaResp struct {
 
 func (x *GetSingleKvMetaResp) Reset() {
 	*x = GetSingleKvMetaResp{}
-	mi := &file_feed_server_proto_msgTypes[29]
+	mi := &file_feed_server_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1869,7 +2285,7 @@ func (x *GetSingleKvMetaResp) String() string {
 func (*GetSingleKvMetaResp) ProtoMessage() {}
 
 func (x *GetSingleKvMetaResp) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[29]
+	mi := &file_feed_server_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,7 +2298,7 @@ func (x *GetSingleKvMetaResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSingleKvMetaResp.ProtoReflect.Descriptor instead.
 func (*GetSingleKvMetaResp) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{29}
+	return file_feed_server_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetSingleKvMetaResp) GetData() *KvMeta {
@@ -1909,7 +2325,7 @@ type GetSingleFileContentReq struct {
 
 func (x *GetSingleFileContentReq) Reset() {
 	*x = GetSingleFileContentReq{}
-	mi := &file_feed_server_proto_msgTypes[30]
+	mi := &file_feed_server_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1921,7 +2337,7 @@ func (x *GetSingleFileContentReq) String() string {
 func (*GetSingleFileContentReq) ProtoMessage() {}
 
 func (x *GetSingleFileContentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[30]
+	mi := &file_feed_server_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1934,7 +2350,7 @@ func (x *GetSingleFileContentReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSingleFileContentReq.ProtoReflect.Descriptor instead.
 func (*GetSingleFileContentReq) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{30}
+	return file_feed_server_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetSingleFileContentReq) GetApiVersion() *base.Versioning {
@@ -1983,7 +2399,7 @@ type SingleFileChunk struct {
 
 func (x *SingleFileChunk) Reset() {
 	*x = SingleFileChunk{}
-	mi := &file_feed_server_proto_msgTypes[31]
+	mi := &file_feed_server_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +2411,7 @@ func (x *SingleFileChunk) String() string {
 func (*SingleFileChunk) ProtoMessage() {}
 
 func (x *SingleFileChunk) ProtoReflect() protoreflect.Message {
-	mi := &file_feed_server_proto_msgTypes[31]
+	mi := &file_feed_server_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2424,7 @@ func (x *SingleFileChunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleFileChunk.ProtoReflect.Descriptor instead.
 func (*SingleFileChunk) Descriptor() ([]byte, []int) {
-	return file_feed_server_proto_rawDescGZIP(), []int{31}
+	return file_feed_server_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *SingleFileChunk) GetContent() []byte {
@@ -2157,7 +2573,7 @@ var file_feed_server_proto_rawDesc = []byte{
 	0x6f, 0x6f, 0x6b, 0x12, 0x2d, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x68, 0x6f, 0x6f, 0x6b,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x2e,
 	0x48, 0x6f, 0x6f, 0x6b, 0x53, 0x70, 0x65, 0x63, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x48, 0x6f,
-	0x6f, 0x6b, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x22, 0xca, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
 	0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f,
 	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
 	0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e,
@@ -2167,199 +2583,253 @@ var file_feed_server_proto_rawDesc = []byte{
 	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x46,
 	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74,
 	0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x22, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x4d, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x22, 0x78, 0x0a, 0x03, 0x41, 0x70, 0x70, 0x12, 0x0e,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x22,
+	0x85, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x63, 0x68, 0x22, 0x81, 0x01, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x79, 0x74, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x03, 0x41,
+	0x70, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x22, 0x2d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1d, 0x0a, 0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73,
+	0x22, 0x66, 0x0a, 0x0d, 0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x66,
+	0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4d, 0x65,
+	0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x58, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c,
+	0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x6b, 0x76, 0x5f,
+	0x6d, 0x65, 0x74, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62,
+	0x66, 0x73, 0x2e, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x6b, 0x76, 0x4d, 0x65, 0x74,
+	0x61, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x06, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x17, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x6b, 0x76, 0x5f, 0x61, 0x74, 0x74,
+	0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x70, 0x62, 0x6b, 0x76, 0x2e, 0x4b, 0x76, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x0c, 0x6b, 0x76, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x39, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x32, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4b, 0x76, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x69,
+	0x0a, 0x0c, 0x4b, 0x76, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
 	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
 	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x3a, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x71,
-	0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x2d, 0x0a,
-	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a,
-	0x04, 0x61, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62,
-	0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70, 0x70, 0x73, 0x22, 0x66, 0x0a, 0x0d,
-	0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a,
+	0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4b, 0x76, 0x45, 0x78,
+	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52,
+	0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x22, 0x57, 0x0a, 0x13, 0x4b, 0x76, 0x45,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70,
+	0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70, 0x70,
+	0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x76, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4b, 0x76,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61,
+	0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70,
+	0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x72, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x03,
+	0x6b, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x66, 0x73,
+	0x2e, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x03, 0x6b, 0x76, 0x73, 0x12, 0x24, 0x0a,
+	0x0e, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x4b,
+	0x65, 0x79, 0x73, 0x22, 0x4a, 0x0a, 0x07, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6b, 0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xee, 0x01, 0x0a, 0x10, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x62,
+	0x6b, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6b, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62,
+	0x66, 0x73, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x69,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x72,
+	0x22, 0x2c, 0x0a, 0x11, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x48,
+	0x0a, 0x16, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a,
 	0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62,
 	0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70,
-	0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x14,
-	0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d,
-	0x61, 0x74, 0x63, 0x68, 0x22, 0x58, 0x0a, 0x0e, 0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x65, 0x6c, 0x65,
-	0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x6b, 0x76, 0x5f, 0x6d, 0x65, 0x74, 0x61,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4b,
-	0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x6b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x73, 0x22, 0xd5,
-	0x01, 0x0a, 0x06, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x6b,
-	0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x76,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0d, 0x6b, 0x76, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x6b, 0x76,
-	0x2e, 0x4b, 0x76, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6b,
-	0x76, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x70, 0x62, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x53, 0x70, 0x65, 0x63, 0x22, 0x62, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28,
-	0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x17, 0x0a, 0x07,
-	0x6b, 0x76, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b,
-	0x76, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xee, 0x01, 0x0a, 0x10,
-	0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x62, 0x6b, 0x5f, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6b,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x6f, 0x64, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6f, 0x64, 0x49, 0x64, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x74,
-	0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x44, 0x69, 0x72, 0x22, 0x2c, 0x0a, 0x11,
-	0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x16, 0x41, 0x73,
-	0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61,
-	0x73, 0x6b, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x17, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x31, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x68, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b,
-	0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64,
-	0x12, 0x28, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74,
-	0x61, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2a, 0x0a, 0x14,
-	0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x70, 0x62, 0x66, 0x73, 0x2e, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xc2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a,
-	0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x70, 0x70,
-	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x62,
-	0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x0f, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x2a, 0x3f, 0x0a, 0x13, 0x41, 0x73,
-	0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0f,
-	0x0a, 0x0b, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12,
-	0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0xc9, 0x08, 0x0a, 0x08,
-	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3a, 0x0a, 0x09, 0x48, 0x61, 0x6e, 0x64,
-	0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x48, 0x61, 0x6e,
-	0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x13, 0x2e,
-	0x70, 0x62, 0x66, 0x73, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e,
-	0x67, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x38, 0x0a,
-	0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x53, 0x69,
-	0x64, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x1a, 0x16, 0x2e, 0x70, 0x62,
-	0x66, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x50, 0x75, 0x6c, 0x6c, 0x41,
-	0x70, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x66,
-	0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c,
-	0x41, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x70,
-	0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55,
-	0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c,
-	0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x50, 0x75,
-	0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62,
-	0x66, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x61,
+	0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x33, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x08, 0x61, 0x70, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x0f, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x2a, 0x3f,
+	0x0a, 0x13, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x49, 0x4e,
+	0x47, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32,
+	0xa6, 0x09, 0x0a, 0x08, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3a, 0x0a, 0x09,
+	0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x66, 0x73,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61,
+	0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x66,
+	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x00, 0x12, 0x38, 0x0a, 0x05, 0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66,
+	0x73, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x1a,
+	0x16, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x50,
+	0x75, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x18,
+	0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x46, 0x69, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x41, 0x70, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x71,
+	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0a,
+	0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66,
+	0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a,
+	0x14, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4b, 0x76, 0x4d, 0x65, 0x74,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a,
+	0x22, 0x26, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62,
+	0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4b,
+	0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62,
+	0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b,
-	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x6c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x33, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
-	0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x7b, 0x6b, 0x65,
-	0x79, 0x7d, 0x12, 0x33, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12, 0x11,
-	0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x73, 0x79, 0x6e, 0x63,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e,
-	0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x1a, 0x17, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13, 0x41,
-	0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44,
-	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x00, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2f, 0x7b, 0x6b, 0x65,
-	0x79, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65,
+	0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x5b, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x73,
+	0x12, 0x0f, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x10, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x76, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a,
+	0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x33, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x12,
+	0x11, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70, 0x70, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x70,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0d, 0x41, 0x73, 0x79, 0x6e,
+	0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x62, 0x66, 0x73,
+	0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x71, 0x1a, 0x17, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x13,
+	0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x41, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x00, 0x12, 0x7f, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b,
+	0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65,
 	0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f, 0x69,
-	0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f,
-	0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x2e,
-	0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x70,
-	0x62, 0x66, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75,
-	0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3b, 0x70, 0x62, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x71, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x34, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2f, 0x7b, 0x6b,
+	0x65, 0x79, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x76, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x19, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x4b, 0x76, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a, 0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2f, 0x62, 0x69, 0x7a, 0x2f, 0x7b, 0x62, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6b, 0x76, 0x73, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2f, 0x7b, 0x6b, 0x65, 0x79, 0x7d, 0x12, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d,
+	0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
+	0x70, 0x62, 0x66, 0x73, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x00, 0x30, 0x01, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65, 0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b, 0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x66, 0x65, 0x65, 0x64,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x3b, 0x70, 0x62, 0x66, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2375,7 +2845,7 @@ func file_feed_server_proto_rawDescGZIP() []byte {
 }
 
 var file_feed_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_feed_server_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_feed_server_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_feed_server_proto_goTypes = []any{
 	(AsyncDownloadStatus)(0),                 // 0: pbfs.AsyncDownloadStatus
 	(*SidecarSpec)(nil),                      // 1: pbfs.SidecarSpec
@@ -2393,100 +2863,113 @@ var file_feed_server_proto_goTypes = []any{
 	(*PullAppFileMetaResp)(nil),              // 13: pbfs.PullAppFileMetaResp
 	(*GetDownloadURLReq)(nil),                // 14: pbfs.GetDownloadURLReq
 	(*GetDownloadURLResp)(nil),               // 15: pbfs.GetDownloadURLResp
-	(*App)(nil),                              // 16: pbfs.App
-	(*ListAppsReq)(nil),                      // 17: pbfs.ListAppsReq
-	(*ListAppsResp)(nil),                     // 18: pbfs.ListAppsResp
-	(*PullKvMetaReq)(nil),                    // 19: pbfs.PullKvMetaReq
-	(*PullKvMetaResp)(nil),                   // 20: pbfs.PullKvMetaResp
-	(*KvMeta)(nil),                           // 21: pbfs.KvMeta
-	(*GetKvValueReq)(nil),                    // 22: pbfs.GetKvValueReq
-	(*GetKvValueResp)(nil),                   // 23: pbfs.GetKvValueResp
-	(*AsyncDownloadReq)(nil),                 // 24: pbfs.AsyncDownloadReq
-	(*AsyncDownloadResp)(nil),                // 25: pbfs.AsyncDownloadResp
-	(*AsyncDownloadStatusReq)(nil),           // 26: pbfs.AsyncDownloadStatusReq
-	(*AsyncDownloadStatusResp)(nil),          // 27: pbfs.AsyncDownloadStatusResp
-	(*GetSingleKvValueReq)(nil),              // 28: pbfs.GetSingleKvValueReq
-	(*GetSingleKvValueResp)(nil),             // 29: pbfs.GetSingleKvValueResp
-	(*GetSingleKvMetaResp)(nil),              // 30: pbfs.GetSingleKvMetaResp
-	(*GetSingleFileContentReq)(nil),          // 31: pbfs.GetSingleFileContentReq
-	(*SingleFileChunk)(nil),                  // 32: pbfs.SingleFileChunk
-	nil,                                      // 33: pbfs.AppMeta.LabelsEntry
-	(*base.Versioning)(nil),                  // 34: pbbase.Versioning
-	(*commit.CommitSpec)(nil),                // 35: pbcommit.CommitSpec
-	(*config_item.ConfigItemSpec)(nil),       // 36: pbci.ConfigItemSpec
-	(*config_item.ConfigItemAttachment)(nil), // 37: pbci.ConfigItemAttachment
-	(*base.Revision)(nil),                    // 38: pbbase.Revision
-	(*hook.HookSpec)(nil),                    // 39: pbhook.HookSpec
-	(*kv.KvAttachment)(nil),                  // 40: pbkv.KvAttachment
-	(*content.ContentSpec)(nil),              // 41: pbcontent.ContentSpec
+	(*FilePatch)(nil),                        // 16: pbfs.FilePatch
+	(*App)(nil),                              // 17: pbfs.App
+	(*ListAppsReq)(nil),                      // 18: pbfs.ListAppsReq
+	(*ListAppsResp)(nil),                     // 19: pbfs.ListAppsResp
+	(*PullKvMetaReq)(nil),                    // 20: pbfs.PullKvMetaReq
+	(*PullKvMetaResp)(nil),                   // 21: pbfs.PullKvMetaResp
+	(*KvMeta)(nil),                           // 22: pbfs.KvMeta
+	(*KvExperiment)(nil),                     // 23: pbfs.KvExperiment
+	(*KvExperimentVariant)(nil),              // 24: pbfs.KvExperimentVariant
+	(*GetKvValueReq)(nil),                    // 25: pbfs.GetKvValueReq
+	(*GetKvValueResp)(nil),                   // 26: pbfs.GetKvValueResp
+	(*GetKvsReq)(nil),                        // 27: pbfs.GetKvsReq
+	(*GetKvsResp)(nil),                       // 28: pbfs.GetKvsResp
+	(*KvValue)(nil),                          // 29: pbfs.KvValue
+	(*AsyncDownloadReq)(nil),                 // 30: pbfs.AsyncDownloadReq
+	(*AsyncDownloadResp)(nil),                // 31: pbfs.AsyncDownloadResp
+	(*AsyncDownloadStatusReq)(nil),           // 32: pbfs.AsyncDownloadStatusReq
+	(*AsyncDownloadStatusResp)(nil),          // 33: pbfs.AsyncDownloadStatusResp
+	(*GetSingleKvValueReq)(nil),              // 34: pbfs.GetSingleKvValueReq
+	(*GetSingleKvValueResp)(nil),             // 35: pbfs.GetSingleKvValueResp
+	(*GetSingleKvMetaResp)(nil),              // 36: pbfs.GetSingleKvMetaResp
+	(*GetSingleFileContentReq)(nil),          // 37: pbfs.GetSingleFileContentReq
+	(*SingleFileChunk)(nil),                  // 38: pbfs.SingleFileChunk
+	nil,                                      // 39: pbfs.AppMeta.LabelsEntry
+	(*base.Versioning)(nil),                  // 40: pbbase.Versioning
+	(*commit.CommitSpec)(nil),                // 41: pbcommit.CommitSpec
+	(*config_item.ConfigItemSpec)(nil),       // 42: pbci.ConfigItemSpec
+	(*config_item.ConfigItemAttachment)(nil), // 43: pbci.ConfigItemAttachment
+	(*base.Revision)(nil),                    // 44: pbbase.Revision
+	(*hook.HookSpec)(nil),                    // 45: pbhook.HookSpec
+	(*kv.KvAttachment)(nil),                  // 46: pbkv.KvAttachment
+	(*content.ContentSpec)(nil),              // 47: pbcontent.ContentSpec
 }
 var file_feed_server_proto_depIdxs = []int32{
-	34, // 0: pbfs.SidecarSpec.version:type_name -> pbbase.Versioning
-	34, // 1: pbfs.HandshakeMessage.api_version:type_name -> pbbase.Versioning
+	40, // 0: pbfs.SidecarSpec.version:type_name -> pbbase.Versioning
+	40, // 1: pbfs.HandshakeMessage.api_version:type_name -> pbbase.Versioning
 	1,  // 2: pbfs.HandshakeMessage.spec:type_name -> pbfs.SidecarSpec
-	34, // 3: pbfs.HandshakeResp.api_version:type_name -> pbbase.Versioning
-	34, // 4: pbfs.MessagingMeta.api_version:type_name -> pbbase.Versioning
-	34, // 5: pbfs.SideWatchMeta.api_version:type_name -> pbbase.Versioning
-	34, // 6: pbfs.FeedWatchMessage.api_version:type_name -> pbbase.Versioning
-	33, // 7: pbfs.AppMeta.labels:type_name -> pbfs.AppMeta.LabelsEntry
-	35, // 8: pbfs.FileMeta.commit_spec:type_name -> pbcommit.CommitSpec
-	36, // 9: pbfs.FileMeta.config_item_spec:type_name -> pbci.ConfigItemSpec
-	37, // 10: pbfs.FileMeta.config_item_attachment:type_name -> pbci.ConfigItemAttachment
+	40, // 3: pbfs.HandshakeResp.api_version:type_name -> pbbase.Versioning
+	40, // 4: pbfs.MessagingMeta.api_version:type_name -> pbbase.Versioning
+	40, // 5: pbfs.SideWatchMeta.api_version:type_name -> pbbase.Versioning
+	40, // 6: pbfs.FeedWatchMessage.api_version:type_name -> pbbase.Versioning
+	39, // 7: pbfs.AppMeta.labels:type_name -> pbfs.AppMeta.LabelsEntry
+	41, // 8: pbfs.FileMeta.commit_spec:type_name -> pbcommit.CommitSpec
+	42, // 9: pbfs.FileMeta.config_item_spec:type_name -> pbci.ConfigItemSpec
+	43, // 10: pbfs.FileMeta.config_item_attachment:type_name -> pbci.ConfigItemAttachment
 	10, // 11: pbfs.FileMeta.repository_spec:type_name -> pbfs.RepositorySpec
-	38, // 12: pbfs.FileMeta.config_item_revision:type_name -> pbbase.Revision
-	34, // 13: pbfs.PullAppFileMetaReq.api_version:type_name -> pbbase.Versioning
+	44, // 12: pbfs.FileMeta.config_item_revision:type_name -> pbbase.Revision
+	40, // 13: pbfs.PullAppFileMetaReq.api_version:type_name -> pbbase.Versioning
 	8,  // 14: pbfs.PullAppFileMetaReq.app_meta:type_name -> pbfs.AppMeta
 	9,  // 15: pbfs.PullAppFileMetaResp.repository:type_name -> pbfs.Repository
 	11, // 16: pbfs.PullAppFileMetaResp.file_metas:type_name -> pbfs.FileMeta
-	39, // 17: pbfs.PullAppFileMetaResp.pre_hook:type_name -> pbhook.HookSpec
-	39, // 18: pbfs.PullAppFileMetaResp.post_hook:type_name -> pbhook.HookSpec
-	34, // 19: pbfs.GetDownloadURLReq.api_version:type_name -> pbbase.Versioning
+	45, // 17: pbfs.PullAppFileMetaResp.pre_hook:type_name -> pbhook.HookSpec
+	45, // 18: pbfs.PullAppFileMetaResp.post_hook:type_name -> pbhook.HookSpec
+	40, // 19: pbfs.GetDownloadURLReq.api_version:type_name -> pbbase.Versioning
 	11, // 20: pbfs.GetDownloadURLReq.file_meta:type_name -> pbfs.FileMeta
-	38, // 21: pbfs.App.revision:type_name -> pbbase.Revision
-	16, // 22: pbfs.ListAppsResp.apps:type_name -> pbfs.App
-	8,  // 23: pbfs.PullKvMetaReq.app_meta:type_name -> pbfs.AppMeta
-	21, // 24: pbfs.PullKvMetaResp.kv_metas:type_name -> pbfs.KvMeta
-	38, // 25: pbfs.KvMeta.revision:type_name -> pbbase.Revision
-	40, // 26: pbfs.KvMeta.kv_attachment:type_name -> pbkv.KvAttachment
-	41, // 27: pbfs.KvMeta.content_spec:type_name -> pbcontent.ContentSpec
-	8,  // 28: pbfs.GetKvValueReq.app_meta:type_name -> pbfs.AppMeta
-	11, // 29: pbfs.AsyncDownloadReq.file_meta:type_name -> pbfs.FileMeta
-	0,  // 30: pbfs.AsyncDownloadStatusResp.status:type_name -> pbfs.AsyncDownloadStatus
-	8,  // 31: pbfs.GetSingleKvValueReq.app_meta:type_name -> pbfs.AppMeta
-	21, // 32: pbfs.GetSingleKvMetaResp.data:type_name -> pbfs.KvMeta
-	34, // 33: pbfs.GetSingleFileContentReq.api_version:type_name -> pbbase.Versioning
-	8,  // 34: pbfs.GetSingleFileContentReq.app_meta:type_name -> pbfs.AppMeta
-	2,  // 35: pbfs.Upstream.Handshake:input_type -> pbfs.HandshakeMessage
-	4,  // 36: pbfs.Upstream.Messaging:input_type -> pbfs.MessagingMeta
-	6,  // 37: pbfs.Upstream.Watch:input_type -> pbfs.SideWatchMeta
-	12, // 38: pbfs.Upstream.PullAppFileMeta:input_type -> pbfs.PullAppFileMetaReq
-	14, // 39: pbfs.Upstream.GetDownloadURL:input_type -> pbfs.GetDownloadURLReq
-	19, // 40: pbfs.Upstream.PullKvMeta:input_type -> pbfs.PullKvMetaReq
-	22, // 41: pbfs.Upstream.GetKvValue:input_type -> pbfs.GetKvValueReq
-	17, // 42: pbfs.Upstream.ListApps:input_type -> pbfs.ListAppsReq
-	24, // 43: pbfs.Upstream.AsyncDownload:input_type -> pbfs.AsyncDownloadReq
-	26, // 44: pbfs.Upstream.AsyncDownloadStatus:input_type -> pbfs.AsyncDownloadStatusReq
-	28, // 45: pbfs.Upstream.GetSingleKvValue:input_type -> pbfs.GetSingleKvValueReq
-	28, // 46: pbfs.Upstream.GetSingleKvMeta:input_type -> pbfs.GetSingleKvValueReq
-	31, // 47: pbfs.Upstream.GetSingleFileContent:input_type -> pbfs.GetSingleFileContentReq
-	3,  // 48: pbfs.Upstream.Handshake:output_type -> pbfs.HandshakeResp
-	5,  // 49: pbfs.Upstream.Messaging:output_type -> pbfs.MessagingResp
-	7,  // 50: pbfs.Upstream.Watch:output_type -> pbfs.FeedWatchMessage
-	13, // 51: pbfs.Upstream.PullAppFileMeta:output_type -> pbfs.PullAppFileMetaResp
-	15, // 52: pbfs.Upstream.GetDownloadURL:output_type -> pbfs.GetDownloadURLResp
-	20, // 53: pbfs.Upstream.PullKvMeta:output_type -> pbfs.PullKvMetaResp
-	23, // 54: pbfs.Upstream.GetKvValue:output_type -> pbfs.GetKvValueResp
-	18, // 55: pbfs.Upstream.ListApps:output_type -> pbfs.ListAppsResp
-	25, // 56: pbfs.Upstream.AsyncDownload:output_type -> pbfs.AsyncDownloadResp
-	27, // 57: pbfs.Upstream.AsyncDownloadStatus:output_type -> pbfs.AsyncDownloadStatusResp
-	29, // 58: pbfs.Upstream.GetSingleKvValue:output_type -> pbfs.GetSingleKvValueResp
-	30, // 59: pbfs.Upstream.GetSingleKvMeta:output_type -> pbfs.GetSingleKvMetaResp
-	32, // 60: pbfs.Upstream.GetSingleFileContent:output_type -> pbfs.SingleFileChunk
-	48, // [48:61] is the sub-list for method output_type
-	35, // [35:48] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	16, // 21: pbfs.GetDownloadURLResp.patch:type_name -> pbfs.FilePatch
+	44, // 22: pbfs.App.revision:type_name -> pbbase.Revision
+	17, // 23: pbfs.ListAppsResp.apps:type_name -> pbfs.App
+	8,  // 24: pbfs.PullKvMetaReq.app_meta:type_name -> pbfs.AppMeta
+	22, // 25: pbfs.PullKvMetaResp.kv_metas:type_name -> pbfs.KvMeta
+	44, // 26: pbfs.KvMeta.revision:type_name -> pbbase.Revision
+	46, // 27: pbfs.KvMeta.kv_attachment:type_name -> pbkv.KvAttachment
+	47, // 28: pbfs.KvMeta.content_spec:type_name -> pbcontent.ContentSpec
+	23, // 29: pbfs.KvMeta.experiment:type_name -> pbfs.KvExperiment
+	24, // 30: pbfs.KvExperiment.variants:type_name -> pbfs.KvExperimentVariant
+	8,  // 31: pbfs.GetKvValueReq.app_meta:type_name -> pbfs.AppMeta
+	8,  // 32: pbfs.GetKvsReq.app_meta:type_name -> pbfs.AppMeta
+	29, // 33: pbfs.GetKvsResp.kvs:type_name -> pbfs.KvValue
+	11, // 34: pbfs.AsyncDownloadReq.file_meta:type_name -> pbfs.FileMeta
+	0,  // 35: pbfs.AsyncDownloadStatusResp.status:type_name -> pbfs.AsyncDownloadStatus
+	8,  // 36: pbfs.GetSingleKvValueReq.app_meta:type_name -> pbfs.AppMeta
+	22, // 37: pbfs.GetSingleKvMetaResp.data:type_name -> pbfs.KvMeta
+	40, // 38: pbfs.GetSingleFileContentReq.api_version:type_name -> pbbase.Versioning
+	8,  // 39: pbfs.GetSingleFileContentReq.app_meta:type_name -> pbfs.AppMeta
+	2,  // 40: pbfs.Upstream.Handshake:input_type -> pbfs.HandshakeMessage
+	4,  // 41: pbfs.Upstream.Messaging:input_type -> pbfs.MessagingMeta
+	6,  // 42: pbfs.Upstream.Watch:input_type -> pbfs.SideWatchMeta
+	12, // 43: pbfs.Upstream.PullAppFileMeta:input_type -> pbfs.PullAppFileMetaReq
+	14, // 44: pbfs.Upstream.GetDownloadURL:input_type -> pbfs.GetDownloadURLReq
+	20, // 45: pbfs.Upstream.PullKvMeta:input_type -> pbfs.PullKvMetaReq
+	25, // 46: pbfs.Upstream.GetKvValue:input_type -> pbfs.GetKvValueReq
+	27, // 47: pbfs.Upstream.GetKvs:input_type -> pbfs.GetKvsReq
+	18, // 48: pbfs.Upstream.ListApps:input_type -> pbfs.ListAppsReq
+	30, // 49: pbfs.Upstream.AsyncDownload:input_type -> pbfs.AsyncDownloadReq
+	32, // 50: pbfs.Upstream.AsyncDownloadStatus:input_type -> pbfs.AsyncDownloadStatusReq
+	34, // 51: pbfs.Upstream.GetSingleKvValue:input_type -> pbfs.GetSingleKvValueReq
+	34, // 52: pbfs.Upstream.GetSingleKvMeta:input_type -> pbfs.GetSingleKvValueReq
+	37, // 53: pbfs.Upstream.GetSingleFileContent:input_type -> pbfs.GetSingleFileContentReq
+	3,  // 54: pbfs.Upstream.Handshake:output_type -> pbfs.HandshakeResp
+	5,  // 55: pbfs.Upstream.Messaging:output_type -> pbfs.MessagingResp
+	7,  // 56: pbfs.Upstream.Watch:output_type -> pbfs.FeedWatchMessage
+	13, // 57: pbfs.Upstream.PullAppFileMeta:output_type -> pbfs.PullAppFileMetaResp
+	15, // 58: pbfs.Upstream.GetDownloadURL:output_type -> pbfs.GetDownloadURLResp
+	21, // 59: pbfs.Upstream.PullKvMeta:output_type -> pbfs.PullKvMetaResp
+	26, // 60: pbfs.Upstream.GetKvValue:output_type -> pbfs.GetKvValueResp
+	28, // 61: pbfs.Upstream.GetKvs:output_type -> pbfs.GetKvsResp
+	19, // 62: pbfs.Upstream.ListApps:output_type -> pbfs.ListAppsResp
+	31, // 63: pbfs.Upstream.AsyncDownload:output_type -> pbfs.AsyncDownloadResp
+	33, // 64: pbfs.Upstream.AsyncDownloadStatus:output_type -> pbfs.AsyncDownloadStatusResp
+	35, // 65: pbfs.Upstream.GetSingleKvValue:output_type -> pbfs.GetSingleKvValueResp
+	36, // 66: pbfs.Upstream.GetSingleKvMeta:output_type -> pbfs.GetSingleKvMetaResp
+	38, // 67: pbfs.Upstream.GetSingleFileContent:output_type -> pbfs.SingleFileChunk
+	54, // [54:68] is the sub-list for method output_type
+	40, // [40:54] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_feed_server_proto_init() }
@@ -2500,7 +2983,7 @@ func file_feed_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feed_server_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   33,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
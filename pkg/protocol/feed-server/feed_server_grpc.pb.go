@@ -26,6 +26,7 @@ const (
 	Upstream_GetDownloadURL_FullMethodName       = "/pbfs.Upstream/GetDownloadURL"
 	Upstream_PullKvMeta_FullMethodName           = "/pbfs.Upstream/PullKvMeta"
 	Upstream_GetKvValue_FullMethodName           = "/pbfs.Upstream/GetKvValue"
+	Upstream_GetKvs_FullMethodName               = "/pbfs.Upstream/GetKvs"
 	Upstream_ListApps_FullMethodName             = "/pbfs.Upstream/ListApps"
 	Upstream_AsyncDownload_FullMethodName        = "/pbfs.Upstream/AsyncDownload"
 	Upstream_AsyncDownloadStatus_FullMethodName  = "/pbfs.Upstream/AsyncDownloadStatus"
@@ -46,6 +47,8 @@ type UpstreamClient interface {
 	GetDownloadURL(ctx context.Context, in *GetDownloadURLReq, opts ...grpc.CallOption) (*GetDownloadURLResp, error)
 	PullKvMeta(ctx context.Context, in *PullKvMetaReq, opts ...grpc.CallOption) (*PullKvMetaResp, error)
 	GetKvValue(ctx context.Context, in *GetKvValueReq, opts ...grpc.CallOption) (*GetKvValueResp, error)
+	// 批量获取kv, 所有值取自同一个版本快照
+	GetKvs(ctx context.Context, in *GetKvsReq, opts ...grpc.CallOption) (*GetKvsResp, error)
 	ListApps(ctx context.Context, in *ListAppsReq, opts ...grpc.CallOption) (*ListAppsResp, error)
 	AsyncDownload(ctx context.Context, in *AsyncDownloadReq, opts ...grpc.CallOption) (*AsyncDownloadResp, error)
 	AsyncDownloadStatus(ctx context.Context, in *AsyncDownloadStatusReq, opts ...grpc.CallOption) (*AsyncDownloadStatusResp, error)
@@ -150,6 +153,15 @@ func (c *upstreamClient) GetKvValue(ctx context.Context, in *GetKvValueReq, opts
 	return out, nil
 }
 
+func (c *upstreamClient) GetKvs(ctx context.Context, in *GetKvsReq, opts ...grpc.CallOption) (*GetKvsResp, error) {
+	out := new(GetKvsResp)
+	err := c.cc.Invoke(ctx, Upstream_GetKvs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *upstreamClient) ListApps(ctx context.Context, in *ListAppsReq, opts ...grpc.CallOption) (*ListAppsResp, error) {
 	out := new(ListAppsResp)
 	err := c.cc.Invoke(ctx, Upstream_ListApps_FullMethodName, in, out, opts...)
@@ -239,6 +251,8 @@ type UpstreamServer interface {
 	GetDownloadURL(context.Context, *GetDownloadURLReq) (*GetDownloadURLResp, error)
 	PullKvMeta(context.Context, *PullKvMetaReq) (*PullKvMetaResp, error)
 	GetKvValue(context.Context, *GetKvValueReq) (*GetKvValueResp, error)
+	// 批量获取kv, 所有值取自同一个版本快照
+	GetKvs(context.Context, *GetKvsReq) (*GetKvsResp, error)
 	ListApps(context.Context, *ListAppsReq) (*ListAppsResp, error)
 	AsyncDownload(context.Context, *AsyncDownloadReq) (*AsyncDownloadResp, error)
 	AsyncDownloadStatus(context.Context, *AsyncDownloadStatusReq) (*AsyncDownloadStatusResp, error)
@@ -274,6 +288,9 @@ func (UnimplementedUpstreamServer) PullKvMeta(context.Context, *PullKvMetaReq) (
 func (UnimplementedUpstreamServer) GetKvValue(context.Context, *GetKvValueReq) (*GetKvValueResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetKvValue not implemented")
 }
+func (UnimplementedUpstreamServer) GetKvs(context.Context, *GetKvsReq) (*GetKvsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKvs not implemented")
+}
 func (UnimplementedUpstreamServer) ListApps(context.Context, *ListAppsReq) (*ListAppsResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApps not implemented")
 }
@@ -433,6 +450,24 @@ func _Upstream_GetKvValue_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Upstream_GetKvs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKvsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UpstreamServer).GetKvs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Upstream_GetKvs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UpstreamServer).GetKvs(ctx, req.(*GetKvsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Upstream_ListApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAppsReq)
 	if err := dec(in); err != nil {
@@ -575,6 +610,10 @@ var Upstream_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKvValue",
 			Handler:    _Upstream_GetKvValue_Handler,
 		},
+		{
+			MethodName: "GetKvs",
+			Handler:    _Upstream_GetKvs_Handler,
+		},
 		{
 			MethodName: "ListApps",
 			Handler:    _Upstream_ListApps_Handler,
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.2
+// 	protoc        v4.25.1
+// source: feed_server_v2.proto
+
+package pbfsv2
+
+import (
+	base "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchType int32
+
+const (
+	BatchType_BATCH_TYPE_UNSPECIFIED BatchType = 0
+	// EVENTS the batch carries the release change events.
+	BatchType_EVENTS BatchType = 1
+	// HEARTBEAT the batch keeps the stream alive when there is no events.
+	BatchType_HEARTBEAT BatchType = 2
+	// BOUNCE the feed server is shutting down, the client should reconnect to another one.
+	BatchType_BOUNCE BatchType = 3
+)
+
+// Enum value maps for BatchType.
+var (
+	BatchType_name = map[int32]string{
+		0: "BATCH_TYPE_UNSPECIFIED",
+		1: "EVENTS",
+		2: "HEARTBEAT",
+		3: "BOUNCE",
+	}
+	BatchType_value = map[string]int32{
+		"BATCH_TYPE_UNSPECIFIED": 0,
+		"EVENTS":                 1,
+		"HEARTBEAT":              2,
+		"BOUNCE":                 3,
+	}
+)
+
+func (x BatchType) Enum() *BatchType {
+	p := new(BatchType)
+	*p = x
+	return p
+}
+
+func (x BatchType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchType) Descriptor() protoreflect.EnumDescriptor {
+	return file_feed_server_v2_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchType) Type() protoreflect.EnumType {
+	return &file_feed_server_v2_proto_enumTypes[0]
+}
+
+func (x BatchType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchType.Descriptor instead.
+func (BatchType) EnumDescriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{0}
+}
+
+type WatchReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ApiVersion *base.Versioning `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	BizId      uint32           `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Apps       []*WatchApp      `protobuf:"bytes,3,rep,name=apps,proto3" json:"apps,omitempty"`
+	Option     *WatchOption     `protobuf:"bytes,4,opt,name=option,proto3" json:"option,omitempty"`
+}
+
+func (x *WatchReq) Reset() {
+	*x = WatchReq{}
+	mi := &file_feed_server_v2_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReq) ProtoMessage() {}
+
+func (x *WatchReq) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_v2_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReq.ProtoReflect.Descriptor instead.
+func (*WatchReq) Descriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchReq) GetApiVersion() *base.Versioning {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return nil
+}
+
+func (x *WatchReq) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *WatchReq) GetApps() []*WatchApp {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+func (x *WatchReq) GetOption() *WatchOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+// WatchApp defines an app instance to watch.
+type WatchApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	App    string            `protobuf:"bytes,1,opt,name=app,proto3" json:"app,omitempty"`
+	Uid    string            `protobuf:"bytes,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// match is the config item paths or kv keys patterns, only the matched ones are delivered, empty
+	// means all.
+	Match []string `protobuf:"bytes,4,rep,name=match,proto3" json:"match,omitempty"`
+	// current_release_id is the release the instance is using, the matched release is delivered at once
+	// if it is not this one, 0 means the instance has no release yet.
+	CurrentReleaseId uint32 `protobuf:"varint,5,opt,name=current_release_id,json=currentReleaseId,proto3" json:"current_release_id,omitempty"`
+	// cursor is the last event cursor handled by the instance, it is used to resume the watch.
+	Cursor uint32 `protobuf:"varint,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *WatchApp) Reset() {
+	*x = WatchApp{}
+	mi := &file_feed_server_v2_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchApp) ProtoMessage() {}
+
+func (x *WatchApp) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_v2_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchApp.ProtoReflect.Descriptor instead.
+func (*WatchApp) Descriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WatchApp) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *WatchApp) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *WatchApp) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *WatchApp) GetMatch() []string {
+	if x != nil {
+		return x.Match
+	}
+	return nil
+}
+
+func (x *WatchApp) GetCurrentReleaseId() uint32 {
+	if x != nil {
+		return x.CurrentReleaseId
+	}
+	return 0
+}
+
+func (x *WatchApp) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+type WatchOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max_batch_size is the max events in a batch, 0 means the server's default.
+	MaxBatchSize uint32 `protobuf:"varint,1,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// batch_window_ms is the max time an event waits for the others to be batched, 0 means the server's
+	// default.
+	BatchWindowMs uint32 `protobuf:"varint,2,opt,name=batch_window_ms,json=batchWindowMs,proto3" json:"batch_window_ms,omitempty"`
+	// heartbeat_seconds is the interval of the heartbeat batch when there is no events, 0 means the
+	// server's default.
+	HeartbeatSeconds uint32 `protobuf:"varint,3,opt,name=heartbeat_seconds,json=heartbeatSeconds,proto3" json:"heartbeat_seconds,omitempty"`
+	// meta_only means the events only carry the release meta without the config item and kv metas, the
+	// client pulls them on demand.
+	MetaOnly bool `protobuf:"varint,4,opt,name=meta_only,json=metaOnly,proto3" json:"meta_only,omitempty"`
+}
+
+func (x *WatchOption) Reset() {
+	*x = WatchOption{}
+	mi := &file_feed_server_v2_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOption) ProtoMessage() {}
+
+func (x *WatchOption) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_v2_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOption.ProtoReflect.Descriptor instead.
+func (*WatchOption) Descriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchOption) GetMaxBatchSize() uint32 {
+	if x != nil {
+		return x.MaxBatchSize
+	}
+	return 0
+}
+
+func (x *WatchOption) GetBatchWindowMs() uint32 {
+	if x != nil {
+		return x.BatchWindowMs
+	}
+	return 0
+}
+
+func (x *WatchOption) GetHeartbeatSeconds() uint32 {
+	if x != nil {
+		return x.HeartbeatSeconds
+	}
+	return 0
+}
+
+func (x *WatchOption) GetMetaOnly() bool {
+	if x != nil {
+		return x.MetaOnly
+	}
+	return false
+}
+
+type WatchBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rid    string          `protobuf:"bytes,1,opt,name=rid,proto3" json:"rid,omitempty"`
+	Type   BatchType       `protobuf:"varint,2,opt,name=type,proto3,enum=pbfs.v2.BatchType" json:"type,omitempty"`
+	Events []*ReleaseEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	// cursors is the latest cursor of each app in the batch, keyed by app id, the client resumes the
+	// watch with them.
+	Cursors map[uint32]uint32 `protobuf:"bytes,4,rep,name=cursors,proto3" json:"cursors,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *WatchBatch) Reset() {
+	*x = WatchBatch{}
+	mi := &file_feed_server_v2_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchBatch) ProtoMessage() {}
+
+func (x *WatchBatch) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_v2_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchBatch.ProtoReflect.Descriptor instead.
+func (*WatchBatch) Descriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WatchBatch) GetRid() string {
+	if x != nil {
+		return x.Rid
+	}
+	return ""
+}
+
+func (x *WatchBatch) GetType() BatchType {
+	if x != nil {
+		return x.Type
+	}
+	return BatchType_BATCH_TYPE_UNSPECIFIED
+}
+
+func (x *WatchBatch) GetEvents() []*ReleaseEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WatchBatch) GetCursors() map[uint32]uint32 {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+// ReleaseEvent defines the release change of an app instance.
+type ReleaseEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BizId       uint32 `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	AppId       uint32 `protobuf:"varint,2,opt,name=app_id,json=appId,proto3" json:"app_id,omitempty"`
+	App         string `protobuf:"bytes,3,opt,name=app,proto3" json:"app,omitempty"`
+	Uid         string `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	ReleaseId   uint32 `protobuf:"varint,5,opt,name=release_id,json=releaseId,proto3" json:"release_id,omitempty"`
+	ReleaseName string `protobuf:"bytes,6,opt,name=release_name,json=releaseName,proto3" json:"release_name,omitempty"`
+	Cursor      uint32 `protobuf:"varint,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// payload is the json raw bytes of sfs.ReleaseChangePayload as the v1 watch message, it is empty if
+	// meta_only is set.
+	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ReleaseEvent) Reset() {
+	*x = ReleaseEvent{}
+	mi := &file_feed_server_v2_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseEvent) ProtoMessage() {}
+
+func (x *ReleaseEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_feed_server_v2_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseEvent.ProtoReflect.Descriptor instead.
+func (*ReleaseEvent) Descriptor() ([]byte, []int) {
+	return file_feed_server_v2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReleaseEvent) GetBizId() uint32 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *ReleaseEvent) GetAppId() uint32 {
+	if x != nil {
+		return x.AppId
+	}
+	return 0
+}
+
+func (x *ReleaseEvent) GetApp() string {
+	if x != nil {
+		return x.App
+	}
+	return ""
+}
+
+func (x *ReleaseEvent) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ReleaseEvent) GetReleaseId() uint32 {
+	if x != nil {
+		return x.ReleaseId
+	}
+	return 0
+}
+
+func (x *ReleaseEvent) GetReleaseName() string {
+	if x != nil {
+		return x.ReleaseName
+	}
+	return ""
+}
+
+func (x *ReleaseEvent) GetCursor() uint32 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *ReleaseEvent) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+var File_feed_server_v2_proto protoreflect.FileDescriptor
+
+var file_feed_server_v2_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x66, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x32,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x1a,
+	0x21, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x12,
+	0x33, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x04, 0x61,
+	0x70, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x62, 0x66, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x52, 0x04, 0x61, 0x70,
+	0x70, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xfc, 0x01, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x41, 0x70, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69,
+	0x64, 0x12, 0x35, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x41, 0x70, 0x70, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2c,
+	0x0a, 0x12, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xa5, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x4d, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65,
+	0x74, 0x61, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xed, 0x01, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x2d, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x3a, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x62, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x69, 0x7a, 0x49, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x61, 0x70, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x61, 0x70, 0x70, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x70, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0x4e,
+	0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x42,
+	0x41, 0x54, 0x43, 0x48, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x53, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x48, 0x45, 0x41, 0x52, 0x54, 0x42, 0x45, 0x41, 0x54,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x4f, 0x55, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x32, 0x3f,
+	0x0a, 0x08, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x33, 0x0a, 0x05, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x70, 0x62, 0x66, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x22, 0x00, 0x30, 0x01, 0x42,
+	0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x54, 0x65,
+	0x6e, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6b,
+	0x2d, 0x62, 0x73, 0x63, 0x70, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x76,
+	0x32, 0x3b, 0x70, 0x62, 0x66, 0x73, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_feed_server_v2_proto_rawDescOnce sync.Once
+	file_feed_server_v2_proto_rawDescData = file_feed_server_v2_proto_rawDesc
+)
+
+func file_feed_server_v2_proto_rawDescGZIP() []byte {
+	file_feed_server_v2_proto_rawDescOnce.Do(func() {
+		file_feed_server_v2_proto_rawDescData = protoimpl.X.CompressGZIP(file_feed_server_v2_proto_rawDescData)
+	})
+	return file_feed_server_v2_proto_rawDescData
+}
+
+var file_feed_server_v2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_feed_server_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_feed_server_v2_proto_goTypes = []any{
+	(BatchType)(0),          // 0: pbfs.v2.BatchType
+	(*WatchReq)(nil),        // 1: pbfs.v2.WatchReq
+	(*WatchApp)(nil),        // 2: pbfs.v2.WatchApp
+	(*WatchOption)(nil),     // 3: pbfs.v2.WatchOption
+	(*WatchBatch)(nil),      // 4: pbfs.v2.WatchBatch
+	(*ReleaseEvent)(nil),    // 5: pbfs.v2.ReleaseEvent
+	nil,                     // 6: pbfs.v2.WatchApp.LabelsEntry
+	nil,                     // 7: pbfs.v2.WatchBatch.CursorsEntry
+	(*base.Versioning)(nil), // 8: pbbase.Versioning
+}
+var file_feed_server_v2_proto_depIdxs = []int32{
+	8, // 0: pbfs.v2.WatchReq.api_version:type_name -> pbbase.Versioning
+	2, // 1: pbfs.v2.WatchReq.apps:type_name -> pbfs.v2.WatchApp
+	3, // 2: pbfs.v2.WatchReq.option:type_name -> pbfs.v2.WatchOption
+	6, // 3: pbfs.v2.WatchApp.labels:type_name -> pbfs.v2.WatchApp.LabelsEntry
+	0, // 4: pbfs.v2.WatchBatch.type:type_name -> pbfs.v2.BatchType
+	5, // 5: pbfs.v2.WatchBatch.events:type_name -> pbfs.v2.ReleaseEvent
+	7, // 6: pbfs.v2.WatchBatch.cursors:type_name -> pbfs.v2.WatchBatch.CursorsEntry
+	1, // 7: pbfs.v2.Upstream.Watch:input_type -> pbfs.v2.WatchReq
+	4, // 8: pbfs.v2.Upstream.Watch:output_type -> pbfs.v2.WatchBatch
+	8, // [8:9] is the sub-list for method output_type
+	7, // [7:8] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_feed_server_v2_proto_init() }
+func file_feed_server_v2_proto_init() {
+	if File_feed_server_v2_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_feed_server_v2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_feed_server_v2_proto_goTypes,
+		DependencyIndexes: file_feed_server_v2_proto_depIdxs,
+		EnumInfos:         file_feed_server_v2_proto_enumTypes,
+		MessageInfos:      file_feed_server_v2_proto_msgTypes,
+	}.Build()
+	File_feed_server_v2_proto = out.File
+	file_feed_server_v2_proto_rawDesc = nil
+	file_feed_server_v2_proto_goTypes = nil
+	file_feed_server_v2_proto_depIdxs = nil
+}
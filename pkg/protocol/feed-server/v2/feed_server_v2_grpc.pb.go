@@ -0,0 +1,138 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: feed_server_v2.proto
+
+package pbfsv2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Upstream_Watch_FullMethodName = "/pbfs.v2.Upstream/Watch"
+)
+
+// UpstreamClient is the client API for Upstream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UpstreamClient interface {
+	// Watch subscribes the release change events of the apps, the credential is carried with the
+	// authorization metadata as the other feed apis.
+	Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Upstream_WatchClient, error)
+}
+
+type upstreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUpstreamClient(cc grpc.ClientConnInterface) UpstreamClient {
+	return &upstreamClient{cc}
+}
+
+func (c *upstreamClient) Watch(ctx context.Context, in *WatchReq, opts ...grpc.CallOption) (Upstream_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Upstream_ServiceDesc.Streams[0], Upstream_Watch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &upstreamWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Upstream_WatchClient interface {
+	Recv() (*WatchBatch, error)
+	grpc.ClientStream
+}
+
+type upstreamWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *upstreamWatchClient) Recv() (*WatchBatch, error) {
+	m := new(WatchBatch)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// UpstreamServer is the server API for Upstream service.
+// All implementations should embed UnimplementedUpstreamServer
+// for forward compatibility
+type UpstreamServer interface {
+	// Watch subscribes the release change events of the apps, the credential is carried with the
+	// authorization metadata as the other feed apis.
+	Watch(*WatchReq, Upstream_WatchServer) error
+}
+
+// UnimplementedUpstreamServer should be embedded to have forward compatible implementations.
+type UnimplementedUpstreamServer struct {
+}
+
+func (UnimplementedUpstreamServer) Watch(*WatchReq, Upstream_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+// UnsafeUpstreamServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UpstreamServer will
+// result in compilation errors.
+type UnsafeUpstreamServer interface {
+	mustEmbedUnimplementedUpstreamServer()
+}
+
+func RegisterUpstreamServer(s grpc.ServiceRegistrar, srv UpstreamServer) {
+	s.RegisterService(&Upstream_ServiceDesc, srv)
+}
+
+func _Upstream_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(UpstreamServer).Watch(m, &upstreamWatchServer{stream})
+}
+
+type Upstream_WatchServer interface {
+	Send(*WatchBatch) error
+	grpc.ServerStream
+}
+
+type upstreamWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *upstreamWatchServer) Send(m *WatchBatch) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Upstream_ServiceDesc is the grpc.ServiceDesc for Upstream service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Upstream_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pbfs.v2.Upstream",
+	HandlerType: (*UpstreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Upstream_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "feed_server_v2.proto",
+}
@@ -0,0 +1,169 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+)
+
+// cacheControl 响应需要鉴权, 只允许客户端缓存, 且每次使用前都需要通过 ETag 重新验证
+const cacheControl = "private, no-cache"
+
+// conditionalMaxBody is the max size of the response body buffered to compute the entity tag, the larger
+// responses are written through without the entity tag.
+const conditionalMaxBody = 1 << 20
+
+// ETag returns the strong entity tag of the resource with the id, such as the sha256 of the content.
+func ETag(id string) string {
+	return `"` + id + `"`
+}
+
+// NotModified reports whether one of the entity tags in the request's If-None-Match header matches the etag,
+// which means the client's cached copy is still valid. the weak comparison is used as RFC 7232 required.
+func NotModified(r *http.Request, etag string) bool {
+	header := r.Header.Get(constant.IfNoneMatchKey)
+	if header == "" {
+		return false
+	}
+
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, one := range strings.Split(header, ",") {
+		one = strings.TrimSpace(one)
+		if one == "*" || strings.TrimPrefix(one, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteConditional sets the etag and cache headers of the response, and replies 304 Not Modified if the
+// client's cached copy is still valid, in which case it returns true and the caller should not write the body.
+func WriteConditional(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set(constant.ETagKey, etag)
+	w.Header().Set("Cache-Control", cacheControl)
+
+	if !NotModified(r, etag) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// ConditionalGet is a middleware adds the entity tag computed from the body to the successful GET responses,
+// and replies 304 Not Modified without the body if the client's copy is unchanged, so the http consumers and
+// intermediary caches can avoid re-downloading the unchanged payloads. the responses which are flushed or
+// larger than 1MB are written through without the entity tag.
+func ConditionalGet(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		bw := &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(bw, r)
+		if bw.passthrough {
+			return
+		}
+
+		// 只处理成功的响应, handler 已经设置了 ETag 时以 handler 的为准
+		if bw.status == http.StatusOK && w.Header().Get(constant.ETagKey) == "" {
+			sum := sha256.Sum256(bw.body.Bytes())
+			if WriteConditional(w, r, ETag(hex.EncodeToString(sum[:]))) {
+				return
+			}
+			w.Header().Set("Content-Length", strconv.Itoa(bw.body.Len()))
+		}
+
+		w.WriteHeader(bw.status)
+		_, _ = w.Write(bw.body.Bytes())
+	})
+}
+
+// ConditionalGetOn returns the ConditionalGet middleware which only applies to the requests whose url path
+// matches the pattern, the other requests are served without buffering.
+func ConditionalGetOn(pattern *regexp.Regexp) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		conditional := ConditionalGet(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !pattern.MatchString(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			conditional.ServeHTTP(w, r)
+		})
+	}
+}
+
+// bufferedWriter buffers the response to compute the entity tag before it's written.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+	// passthrough means the response is flushed or too large to buffer, the buffered status and body are
+	// written, and the later writes are passed through to the underlying writer.
+	passthrough bool
+}
+
+// WriteHeader records the status code, which is written after the body is buffered.
+func (b *bufferedWriter) WriteHeader(status int) {
+	if b.passthrough {
+		return
+	}
+
+	b.status = status
+}
+
+// Write buffers the body, or writes it through if the body exceeds the buffer limit.
+func (b *bufferedWriter) Write(p []byte) (int, error) {
+	if !b.passthrough && b.body.Len()+len(p) > conditionalMaxBody {
+		b.writeBuffered()
+	}
+
+	if b.passthrough {
+		return b.ResponseWriter.Write(p)
+	}
+
+	return b.body.Write(p)
+}
+
+// Flush implements http.Flusher, the streaming responses are written through without the entity tag.
+func (b *bufferedWriter) Flush() {
+	b.writeBuffered()
+	if f, ok := b.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// writeBuffered writes the buffered status and body, and turns the writer to pass through.
+func (b *bufferedWriter) writeBuffered() {
+	if b.passthrough {
+		return
+	}
+
+	b.passthrough = true
+	b.ResponseWriter.WriteHeader(b.status)
+	_, _ = b.ResponseWriter.Write(b.body.Bytes())
+	b.body.Reset()
+}
@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rest
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestConditionalGet(t *testing.T) {
+	h := ConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":1}}`))
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/releases/1", nil))
+	etag := rec.Header().Get("ETag")
+	if rec.Code != http.StatusOK || etag == "" || rec.Body.String() != `{"data":{"id":1}}` {
+		t.Fatalf("unexpected first response, code: %d, etag: %s, body: %s", rec.Code, etag, rec.Body.String())
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/releases/1", nil)
+	req.Header.Set("If-None-Match", `"other", W/`+etag)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("matched etag should reply 304 without body, got %d, body: %s", rec.Code, rec.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/releases/1", nil)
+	req.Header.Set("If-None-Match", `"other"`)
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Errorf("unmatched etag should reply 200, got %d", rec.Code)
+	}
+
+	failed := ConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	rec = httptest.NewRecorder()
+	failed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/releases/2", nil))
+	if rec.Code != http.StatusNotFound || rec.Header().Get("ETag") != "" {
+		t.Errorf("failed response should not have etag, got %d, etag: %s", rec.Code, rec.Header().Get("ETag"))
+	}
+}
+
+func TestConditionalGetWithHandlerETag(t *testing.T) {
+	h := ConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if WriteConditional(w, r, ETag("content-sha256")) {
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/releases/1", nil)
+	req.Header.Set("If-None-Match", ETag("content-sha256"))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("handler replied 304 should be kept, got %d, body: %s", rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("ETag") != ETag("content-sha256") {
+		t.Errorf("handler's etag should be kept, got %s", rec.Header().Get("ETag"))
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/releases/1", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != ETag("content-sha256") ||
+		rec.Body.String() != "content" {
+		t.Errorf("unexpected response, code: %d, etag: %s, body: %s", rec.Code, rec.Header().Get("ETag"),
+			rec.Body.String())
+	}
+}
+
+func TestConditionalGetPassthrough(t *testing.T) {
+	large := bytes.Repeat([]byte("a"), conditionalMaxBody+1)
+	h := ConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(large[:10])
+		_, _ = w.Write(large[10:])
+	}))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/releases/1", nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != "" || rec.Body.Len() != len(large) {
+		t.Errorf("large response should be written through without etag, code: %d, etag: %s, length: %d",
+			rec.Code, rec.Header().Get("ETag"), rec.Body.Len())
+	}
+
+	flushed := ConditionalGet(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("chunk1"))
+		w.(http.Flusher).Flush()
+		_, _ = w.Write([]byte("chunk2"))
+	}))
+	rec = httptest.NewRecorder()
+	flushed.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/releases/1", nil))
+	if !rec.Flushed || rec.Header().Get("ETag") != "" || rec.Body.String() != "chunk1chunk2" {
+		t.Errorf("flushed response should be streamed without etag, flushed: %v, etag: %s, body: %s",
+			rec.Flushed, rec.Header().Get("ETag"), rec.Body.String())
+	}
+
+	only := ConditionalGetOn(regexp.MustCompile(`^/releases/`))(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			_, ok := w.(*bufferedWriter)
+			_, _ = w.Write([]byte(strconv.FormatBool(ok)))
+		}))
+	for path, buffered := range map[string]string{"/releases/1": "true", "/exports/1": "false"} {
+		rec = httptest.NewRecorder()
+		only.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		if rec.Body.String() != buffered {
+			t.Errorf("request %s buffered should be %s, got %s", path, buffered, rec.Body.String())
+		}
+	}
+}
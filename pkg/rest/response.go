@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"google.golang.org/grpc/status"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 )
@@ -104,6 +105,12 @@ type ErrorPayload struct {
 	Message string        `json:"message"`
 	Data    interface{}   `json:"data"`
 	Details []interface{} `json:"details"`
+	// RequestID 请求ID, 用户反馈问题时提供以便排查
+	RequestID string `json:"request_id,omitempty"`
+	// Domain 错误所属的业务领域, 仅bscp专用错误码返回
+	Domain string `json:"domain,omitempty"`
+	// Retryable 请求是否可以稍后重试
+	Retryable bool `json:"retryable,omitempty"`
 }
 
 // Error 实现error接口
@@ -142,6 +149,10 @@ func (res *ErrorResponse) Render(w http.ResponseWriter, r *http.Request) error {
 		res.Error.Details = []interface{}{}
 	}
 
+	if res.Error.RequestID == "" {
+		res.Error.RequestID = w.Header().Get(constant.RidKeyGeneric)
+	}
+
 	render.Status(r, statusCode)
 	return nil
 }
@@ -197,6 +208,10 @@ func GRPCErr(err error) *ErrorResponse {
 		status = http.StatusBadRequest
 	}
 
-	payload := &ErrorPayload{Code: code, Message: s.Message(), Details: s.Details()}
+	payload := &ErrorPayload{Code: code, Message: s.Message(), Details: s.Details(),
+		Retryable: errf.IsRetryable(int32(s.Code()))}
+	if r, ok := errf.LookupReason(int32(s.Code())); ok {
+		payload.Domain = r.Domain
+	}
 	return &ErrorResponse{Error: payload, HTTPStatusCode: status}
 }
@@ -0,0 +1,370 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package binpatch implements the binary patch (bsdiff algorithm) between two versions of a file, which is used to
+// deliver only the changed part of a large config file to the clients.
+//
+// The patch layout is:
+//
+//	magic(8) | base sha256(32) | target sha256(32) | target size(8, big endian) | flate(records...)
+//
+// each record is: uvarint(add length) | uvarint(copy length) | varint(seek) | add bytes | copy bytes,
+// the add bytes are added to the base content byte by byte, the copy bytes are copied to the target directly,
+// and then the base offset seeks with the given length.
+package binpatch
+
+import (
+	"bufio"
+	"bytes"
+	"compress/flate"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// magic is the header of the patch.
+var magic = []byte("BSCPDIF1")
+
+const headerSize = 8 + sha256.Size*2 + 8
+
+var (
+	// ErrBaseMismatch means the base content is not the one which the patch is generated from.
+	ErrBaseMismatch = errors.New("binpatch: base content mismatch")
+	// ErrChecksumMismatch means the patched content is not the expected target content.
+	ErrChecksumMismatch = errors.New("binpatch: patched content checksum mismatch")
+	// ErrCorruptPatch means the patch is invalid.
+	ErrCorruptPatch = errors.New("binpatch: corrupt patch")
+)
+
+// Header is the header of the patch.
+type Header struct {
+	// BaseSignature is the sha256 of the base content.
+	BaseSignature [sha256.Size]byte
+	// TargetSignature is the sha256 of the target content.
+	TargetSignature [sha256.Size]byte
+	// TargetSize is the byte size of the target content.
+	TargetSize uint64
+}
+
+// ReadHeader reads the header of the patch.
+func ReadHeader(r io.Reader) (*Header, error) {
+	buf := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, ErrCorruptPatch
+	}
+	if !bytes.Equal(buf[:len(magic)], magic) {
+		return nil, ErrCorruptPatch
+	}
+
+	h := new(Header)
+	buf = buf[len(magic):]
+	copy(h.BaseSignature[:], buf[:sha256.Size])
+	copy(h.TargetSignature[:], buf[sha256.Size:sha256.Size*2])
+	h.TargetSize = binary.BigEndian.Uint64(buf[sha256.Size*2:])
+	return h, nil
+}
+
+// Diff generates the patch which updates the old content to the new content.
+func Diff(old, new []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, 0, headerSize+len(new)/8))
+	buf.Write(magic)
+	baseSign := sha256.Sum256(old)
+	targetSign := sha256.Sum256(new)
+	buf.Write(baseSign[:])
+	buf.Write(targetSign[:])
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(new)))
+	buf.Write(size)
+
+	fw, err := flate.NewWriter(buf, flate.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if err := diff(old, new, fw); err != nil {
+		return nil, err
+	}
+	if err := fw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Apply applies the patch to the old content, and writes the target content to w.
+// The base content and the patched content are both verified with the checksum in the patch, if an error is
+// returned, the content written to w should be discarded.
+func Apply(old []byte, patch io.Reader, w io.Writer) error {
+	h, err := ReadHeader(patch)
+	if err != nil {
+		return err
+	}
+	if sha256.Sum256(old) != h.BaseSignature {
+		return ErrBaseMismatch
+	}
+
+	fr := flate.NewReader(patch)
+	defer fr.Close()
+	br := bufio.NewReader(fr)
+
+	hash := sha256.New()
+	dst := io.MultiWriter(w, hash)
+	var oldPos int64
+	var newSize uint64
+	chunk := make([]byte, 32*1024)
+	for {
+		add, err := binary.ReadUvarint(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return ErrCorruptPatch
+		}
+		cp, err := binary.ReadUvarint(br)
+		if err != nil {
+			return ErrCorruptPatch
+		}
+		seek, err := binary.ReadVarint(br)
+		if err != nil {
+			return ErrCorruptPatch
+		}
+
+		if add > h.TargetSize-newSize || cp > h.TargetSize-newSize-add {
+			return ErrCorruptPatch
+		}
+		if oldPos < 0 || uint64(oldPos)+add > uint64(len(old)) {
+			return ErrCorruptPatch
+		}
+
+		for left := add; left > 0; {
+			n := uint64(len(chunk))
+			if left < n {
+				n = left
+			}
+			if _, err := io.ReadFull(br, chunk[:n]); err != nil {
+				return ErrCorruptPatch
+			}
+			for i := uint64(0); i < n; i++ {
+				chunk[i] += old[oldPos+int64(i)]
+			}
+			if _, err := dst.Write(chunk[:n]); err != nil {
+				return err
+			}
+			oldPos += int64(n)
+			left -= n
+		}
+
+		if _, err := io.CopyN(dst, br, int64(cp)); err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return ErrCorruptPatch
+			}
+			return err
+		}
+
+		newSize += add + cp
+		oldPos += seek
+	}
+
+	if newSize != h.TargetSize {
+		return ErrCorruptPatch
+	}
+	if !bytes.Equal(hash.Sum(nil), h.TargetSignature[:]) {
+		return ErrChecksumMismatch
+	}
+
+	return nil
+}
+
+// diff writes the records which updates the old content to the new content, it's the bsdiff algorithm.
+func diff(old, new []byte, w io.Writer) error {
+	sa := qsufsort(old)
+	oldSize, newSize := len(old), len(new)
+
+	var scan, pos, length, lastScan, lastPos, lastOffset int
+	for scan < newSize {
+		oldScore := 0
+		scan += length
+		for scsc := scan; scan < newSize; scan++ {
+			pos, length = search(sa, old, new[scan:], 0, oldSize)
+
+			for ; scsc < scan+length; scsc++ {
+				if scsc+lastOffset < oldSize && old[scsc+lastOffset] == new[scsc] {
+					oldScore++
+				}
+			}
+
+			if (length == oldScore && length != 0) || length > oldScore+8 {
+				break
+			}
+
+			if scan+lastOffset < oldSize && old[scan+lastOffset] == new[scan] {
+				oldScore--
+			}
+		}
+
+		if length == oldScore && scan != newSize {
+			continue
+		}
+
+		// 向前扩展上一个匹配
+		var s, sf, lenf int
+		for i := 0; lastScan+i < scan && lastPos+i < oldSize; {
+			if old[lastPos+i] == new[lastScan+i] {
+				s++
+			}
+			i++
+			if s*2-i > sf*2-lenf {
+				sf = s
+				lenf = i
+			}
+		}
+
+		// 向后扩展当前匹配
+		lenb := 0
+		if scan < newSize {
+			var sb int
+			s = 0
+			for i := 1; scan >= lastScan+i && pos >= i; i++ {
+				if old[pos-i] == new[scan-i] {
+					s++
+				}
+				if s*2-i > sb*2-lenb {
+					sb = s
+					lenb = i
+				}
+			}
+		}
+
+		// 两段扩展重叠时找到最佳的切分点
+		if lastScan+lenf > scan-lenb {
+			overlap := (lastScan + lenf) - (scan - lenb)
+			var ss, lens int
+			s = 0
+			for i := 0; i < overlap; i++ {
+				if new[lastScan+lenf-overlap+i] == old[lastPos+lenf-overlap+i] {
+					s++
+				}
+				if new[scan-lenb+i] == old[pos-lenb+i] {
+					s--
+				}
+				if s > ss {
+					ss = s
+					lens = i + 1
+				}
+			}
+			lenf += lens - overlap
+			lenb -= lens
+		}
+
+		extra := (scan - lenb) - (lastScan + lenf)
+		seek := (pos - lenb) - (lastPos + lenf)
+		if err := writeRecord(w, old[lastPos:lastPos+lenf], new[lastScan:lastScan+lenf],
+			new[lastScan+lenf:lastScan+lenf+extra], seek); err != nil {
+			return err
+		}
+
+		lastScan = scan - lenb
+		lastPos = pos - lenb
+		lastOffset = pos - scan
+	}
+
+	return nil
+}
+
+// writeRecord writes one record of the patch.
+func writeRecord(w io.Writer, oldAdd, newAdd, extra []byte, seek int) error {
+	head := make([]byte, binary.MaxVarintLen64*3)
+	n := binary.PutUvarint(head, uint64(len(newAdd)))
+	n += binary.PutUvarint(head[n:], uint64(len(extra)))
+	n += binary.PutVarint(head[n:], int64(seek))
+	if _, err := w.Write(head[:n]); err != nil {
+		return fmt.Errorf("write patch record failed, err: %v", err)
+	}
+
+	add := make([]byte, len(newAdd))
+	for i := range newAdd {
+		add[i] = newAdd[i] - oldAdd[i]
+	}
+	if _, err := w.Write(add); err != nil {
+		return fmt.Errorf("write patch record failed, err: %v", err)
+	}
+	if _, err := w.Write(extra); err != nil {
+		return fmt.Errorf("write patch record failed, err: %v", err)
+	}
+
+	return nil
+}
+
+// search finds the longest match of target in the old content with the suffix array.
+func search(sa []int32, old, target []byte, st, en int) (pos, n int) {
+	for en-st >= 2 {
+		x := st + (en-st)/2
+		suffix := old[sa[x]:]
+		cmpLen := len(suffix)
+		if len(target) < cmpLen {
+			cmpLen = len(target)
+		}
+		if bytes.Compare(suffix[:cmpLen], target[:cmpLen]) < 0 {
+			st = x
+		} else {
+			en = x
+		}
+	}
+
+	x := matchLen(old[sa[st]:], target)
+	y := matchLen(old[sa[en]:], target)
+	if x > y {
+		return int(sa[st]), x
+	}
+	return int(sa[en]), y
+}
+
+func matchLen(a, b []byte) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// ApplyFile applies the patch to the base file, and writes the target content to the dst file atomically,
+// the dst file is left untouched if the patch can not be applied, then the caller should fall back to download
+// the full content.
+func ApplyFile(basePath string, patch io.Reader, dst string) error {
+	old, err := os.ReadFile(basePath)
+	if err != nil {
+		return fmt.Errorf("read base file failed, err: %v", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".binpatch-*")
+	if err != nil {
+		return fmt.Errorf("create temp file failed, err: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := Apply(old, patch, tmp); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("sync temp file failed, err: %v", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close temp file failed, err: %v", err)
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
@@ -0,0 +1,91 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package binpatch
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+)
+
+func TestDiffApply(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := make([]byte, 256*1024)
+	r.Read(random)
+
+	// 在随机内容上做插入, 删除, 修改
+	changed := append([]byte{}, random[:1000]...)
+	changed = append(changed, []byte("inserted content")...)
+	changed = append(changed, random[2000:100000]...)
+	for i := 50000; i < 50100; i++ {
+		changed[i]++
+	}
+	changed = append(changed, random[120000:]...)
+
+	repeated := bytes.Repeat([]byte("key: value\n"), 10000)
+	cases := []struct {
+		name     string
+		old, new []byte
+	}{
+		{name: "random", old: random, new: changed},
+		{name: "repeated", old: repeated, new: append(repeated[:5000:5000], []byte("key: new value\n")...)},
+		{name: "empty old", old: nil, new: []byte("new content")},
+		{name: "empty new", old: []byte("old content"), new: nil},
+		{name: "same", old: random, new: random},
+	}
+
+	for _, c := range cases {
+		patch, err := Diff(c.old, c.new)
+		if err != nil {
+			t.Fatalf("%s: diff failed, err: %v", c.name, err)
+		}
+
+		out := new(bytes.Buffer)
+		if err := Apply(c.old, bytes.NewReader(patch), out); err != nil {
+			t.Fatalf("%s: apply failed, err: %v", c.name, err)
+		}
+		if !bytes.Equal(out.Bytes(), c.new) {
+			t.Errorf("%s: patched content is not the target content", c.name)
+		}
+	}
+
+	patch, _ := Diff(random, changed)
+	if len(patch) > len(changed)/10 {
+		t.Errorf("patch is too large, size: %d", len(patch))
+	}
+}
+
+func TestApplyMismatch(t *testing.T) {
+	old := bytes.Repeat([]byte("0123456789"), 1000)
+	target := append([]byte("prefix"), old...)
+	patch, err := Diff(old, target)
+	if err != nil {
+		t.Fatalf("diff failed, err: %v", err)
+	}
+
+	other := append([]byte{}, old...)
+	other[0] = 'x'
+	if err := Apply(other, bytes.NewReader(patch), new(bytes.Buffer)); err != ErrBaseMismatch {
+		t.Errorf("expect base mismatch error, got: %v", err)
+	}
+
+	corrupt := append([]byte{}, patch...)
+	corrupt[len(corrupt)-3] ^= 0xff
+	if err := Apply(old, bytes.NewReader(corrupt), new(bytes.Buffer)); err == nil {
+		t.Errorf("apply corrupt patch should fail")
+	}
+
+	if err := Apply(old, bytes.NewReader(patch[:headerSize-1]), new(bytes.Buffer)); err != ErrCorruptPatch {
+		t.Errorf("expect corrupt patch error, got: %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package binpatch
+
+// qsufsort builds the suffix array of buf with the Larsson-Sadakane algorithm, the returned array has
+// len(buf)+1 elements, the first element is the empty suffix.
+func qsufsort(buf []byte) []int32 {
+	size := len(buf)
+	sa := make([]int32, size+1)
+	rank := make([]int32, size+1)
+
+	var buckets [256]int
+	for _, c := range buf {
+		buckets[c]++
+	}
+	for i := 1; i < 256; i++ {
+		buckets[i] += buckets[i-1]
+	}
+	for i := 255; i > 0; i-- {
+		buckets[i] = buckets[i-1]
+	}
+	buckets[0] = 0
+
+	for i, c := range buf {
+		buckets[c]++
+		sa[buckets[c]] = int32(i)
+	}
+	sa[0] = int32(size)
+	for i, c := range buf {
+		rank[i] = int32(buckets[c])
+	}
+	rank[size] = 0
+	for i := 1; i < 256; i++ {
+		if buckets[i] == buckets[i-1]+1 {
+			sa[buckets[i]] = -1
+		}
+	}
+	sa[0] = -1
+
+	for h := 1; sa[0] != -int32(size+1); h += h {
+		n := 0
+		i := 0
+		for i < size+1 {
+			if sa[i] < 0 {
+				n -= int(sa[i])
+				i -= int(sa[i])
+				continue
+			}
+
+			if n != 0 {
+				sa[i-n] = -int32(n)
+			}
+			n = int(rank[sa[i]]) + 1 - i
+			split(sa, rank, i, n, h)
+			i += n
+			n = 0
+		}
+		if n != 0 {
+			sa[i-n] = -int32(n)
+		}
+	}
+
+	for i := 0; i < size+1; i++ {
+		sa[rank[i]] = int32(i)
+	}
+
+	return sa
+}
+
+// split sorts the group sa[start:start+length] by the rank of the h-th following element.
+func split(sa, rank []int32, start, length, h int) {
+	if length < 16 {
+		for k := start; k < start+length; {
+			j := 1
+			x := rank[int(sa[k])+h]
+			for i := 1; k+i < start+length; i++ {
+				v := rank[int(sa[k+i])+h]
+				if v < x {
+					x = v
+					j = 0
+				}
+				if v == x {
+					sa[k+i], sa[k+j] = sa[k+j], sa[k+i]
+					j++
+				}
+			}
+			for i := 0; i < j; i++ {
+				rank[sa[k+i]] = int32(k + j - 1)
+			}
+			if j == 1 {
+				sa[k] = -1
+			}
+			k += j
+		}
+		return
+	}
+
+	x := rank[int(sa[start+length/2])+h]
+	var jj, kk int
+	for i := start; i < start+length; i++ {
+		v := rank[int(sa[i])+h]
+		if v < x {
+			jj++
+		}
+		if v == x {
+			kk++
+		}
+	}
+	jj += start
+	kk += jj
+
+	var j, k int
+	for i := start; i < jj; {
+		v := rank[int(sa[i])+h]
+		switch {
+		case v < x:
+			i++
+		case v == x:
+			sa[i], sa[jj+j] = sa[jj+j], sa[i]
+			j++
+		default:
+			sa[i], sa[kk+k] = sa[kk+k], sa[i]
+			k++
+		}
+	}
+
+	for jj+j < kk {
+		if rank[int(sa[jj+j])+h] == x {
+			j++
+		} else {
+			sa[jj+j], sa[kk+k] = sa[kk+k], sa[jj+j]
+			k++
+		}
+	}
+
+	if jj > start {
+		split(sa, rank, start, jj-start, h)
+	}
+
+	for i := 0; i < kk-jj; i++ {
+		rank[sa[jj+i]] = int32(kk - 1)
+	}
+	if jj == kk-1 {
+		sa[jj] = -1
+	}
+
+	if start+length > kk {
+		split(sa, rank, kk, start+length-kk, h)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package masking provides a masker which hides the sensitive parts of the config content by the
+// regex or JSONPath rules, so the structure of the content can be inspected without the raw secrets.
+package masking
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// DefaultReplacement is the replacement of the masked parts if the rule does not set it.
+const DefaultReplacement = "******"
+
+// RuleType is the type of the masking rule.
+type RuleType string
+
+const (
+	// RegexRule masks the matched text, only the first capture group is masked if the pattern has one.
+	RegexRule RuleType = "regex"
+	// JSONPathRule masks the values matched by the path, which only works with the json content.
+	JSONPathRule RuleType = "jsonpath"
+)
+
+// Rule is a masking rule defined by user.
+type Rule struct {
+	Name        string
+	Type        RuleType
+	Pattern     string
+	Replacement string
+}
+
+// Masker masks the content with the rules.
+type Masker struct {
+	regexes []*regexRule
+	paths   []*pathRule
+}
+
+type regexRule struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+type pathRule struct {
+	segments    []segment
+	replacement string
+}
+
+// New create a masker with the rules, returns error if any rule is invalid.
+func New(rules []Rule) (*Masker, error) {
+	m := new(Masker)
+	for _, r := range rules {
+		replacement := r.Replacement
+		if replacement == "" {
+			replacement = DefaultReplacement
+		}
+
+		switch r.Type {
+		case RegexRule:
+			p, err := regexp.Compile(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern of masking rule %s, err: %v", r.Name, err)
+			}
+			m.regexes = append(m.regexes, &regexRule{pattern: p, replacement: replacement})
+		case JSONPathRule:
+			segs, err := parsePath(r.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid path of masking rule %s, err: %v", r.Name, err)
+			}
+			m.paths = append(m.paths, &pathRule{segments: segs, replacement: strconv.Quote(replacement)})
+		default:
+			return nil, fmt.Errorf("unsupported masking rule type %s of rule %s", r.Type, r.Name)
+		}
+	}
+
+	return m, nil
+}
+
+// Mask masks the content, returns the masked content and whether any part is masked.
+func (m *Masker) Mask(content string) (string, bool) {
+	if m == nil || content == "" {
+		return content, false
+	}
+
+	masked := false
+	// JSONPath 规则只对 json 内容生效, 先于正则处理避免正则破坏 json 结构
+	if len(m.paths) != 0 && gjson.Valid(content) {
+		root := gjson.Parse(content)
+		var spans []span
+		for _, p := range m.paths {
+			var matched []gjson.Result
+			walk(root, p.segments, &matched)
+			for _, one := range matched {
+				spans = append(spans, span{start: one.Index, end: one.Index + len(one.Raw), text: p.replacement})
+			}
+		}
+		if len(spans) != 0 {
+			content = replaceSpans(content, spans)
+			masked = true
+		}
+	}
+
+	for _, r := range m.regexes {
+		var spans []span
+		for _, loc := range r.pattern.FindAllStringSubmatchIndex(content, -1) {
+			// 有捕获组时只替换第一个捕获组, 便于保留 key 等上下文
+			start, end := loc[0], loc[1]
+			if len(loc) >= 4 && loc[2] >= 0 {
+				start, end = loc[2], loc[3]
+			}
+			if start == end {
+				continue
+			}
+			spans = append(spans, span{start: start, end: end, text: r.replacement})
+		}
+		if len(spans) != 0 {
+			content = replaceSpans(content, spans)
+			masked = true
+		}
+	}
+
+	return content, masked
+}
+
+type span struct {
+	start int
+	end   int
+	text  string
+}
+
+// replaceSpans replace the spans of the content, the span overlapped with the previous one is skipped.
+func replaceSpans(content string, spans []span) string {
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	var b strings.Builder
+	last := 0
+	for _, s := range spans {
+		if s.start < last || s.end > len(content) {
+			continue
+		}
+		b.WriteString(content[last:s.start])
+		b.WriteString(s.text)
+		last = s.end
+	}
+	b.WriteString(content[last:])
+
+	return b.String()
+}
+
+// segment is a step of the JSONPath.
+type segment struct {
+	key       string
+	index     int
+	isIndex   bool
+	wildcard  bool
+	recursive bool
+}
+
+// parsePath parse the JSONPath, supports $.a.b, $['a.b'], $.a[0], $.a[*], $.* and $..a.
+func parsePath(path string) ([]segment, error) {
+	path = strings.TrimSpace(path)
+	if !strings.HasPrefix(path, "$") {
+		return nil, errors.New("path should start with $")
+	}
+
+	var segs []segment
+	rest := path[1:]
+	for len(rest) != 0 {
+		var seg segment
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				return nil, errors.New("recursive descent should be followed by a key")
+			}
+			seg, rest = parseKey(seg, rest)
+		case strings.HasPrefix(rest, "."):
+			seg, rest = parseKey(seg, rest[1:])
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, errors.New("unclosed bracket")
+			}
+			inner := rest[1:end]
+			rest = rest[end+1:]
+			switch {
+			case inner == "*":
+				seg.wildcard = true
+			case len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0]:
+				seg.key = inner[1 : len(inner)-1]
+			default:
+				idx, err := strconv.Atoi(inner)
+				if err != nil || idx < 0 {
+					return nil, fmt.Errorf("invalid array index %s", inner)
+				}
+				seg.index, seg.isIndex = idx, true
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %s", rest)
+		}
+
+		if !seg.wildcard && !seg.isIndex && seg.key == "" {
+			return nil, errors.New("empty key")
+		}
+		segs = append(segs, seg)
+	}
+
+	if len(segs) == 0 {
+		return nil, errors.New("path should not be the root")
+	}
+
+	return segs, nil
+}
+
+func parseKey(seg segment, rest string) (segment, string) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	key := rest[:end]
+	if key == "*" {
+		seg.wildcard = true
+	} else {
+		seg.key = key
+	}
+
+	return seg, rest[end:]
+}
+
+// walk collects the values matched by the segments, the index of the values is the offset in the content.
+func walk(res gjson.Result, segs []segment, matched *[]gjson.Result) {
+	if len(segs) == 0 {
+		*matched = append(*matched, res)
+		return
+	}
+
+	if !res.IsObject() && !res.IsArray() {
+		return
+	}
+
+	seg := segs[0]
+	if seg.recursive {
+		// 递归匹配当前层级及所有子层级
+		current := seg
+		current.recursive = false
+		walk(res, append([]segment{current}, segs[1:]...), matched)
+		res.ForEach(func(_, value gjson.Result) bool {
+			walk(value, segs, matched)
+			return true
+		})
+		return
+	}
+
+	i := 0
+	res.ForEach(func(key, value gjson.Result) bool {
+		var hit bool
+		switch {
+		case seg.wildcard:
+			hit = true
+		case seg.isIndex:
+			hit = res.IsArray() && i == seg.index
+		default:
+			hit = res.IsObject() && key.String() == seg.key
+		}
+		i++
+		if hit {
+			walk(value, segs[1:], matched)
+		}
+		return true
+	})
+}
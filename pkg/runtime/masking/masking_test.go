@@ -0,0 +1,67 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package masking
+
+import "testing"
+
+func TestMaskJSONPath(t *testing.T) {
+	m, err := New([]Rule{
+		{Name: "db", Type: JSONPathRule, Pattern: "$.db.password"},
+		{Name: "tokens", Type: JSONPathRule, Pattern: "$.clients[*].token", Replacement: "<hidden>"},
+		{Name: "keys", Type: JSONPathRule, Pattern: "$..secret"},
+	})
+	if err != nil {
+		t.Fatalf("new masker failed, err: %v", err)
+	}
+
+	content := `{"db": {"host": "127.0.0.1", "password": "p@ss"}, "clients": [{"id": 1, "token": "t1"}, ` +
+		`{"id": 2, "token": "t2"}], "nested": {"a": {"secret": {"k": "v"}}}}`
+	expect := `{"db": {"host": "127.0.0.1", "password": "******"}, "clients": [{"id": 1, "token": "<hidden>"}, ` +
+		`{"id": 2, "token": "<hidden>"}], "nested": {"a": {"secret": "******"}}}`
+	got, masked := m.Mask(content)
+	if !masked || got != expect {
+		t.Errorf("unexpected masked content: %s", got)
+	}
+
+	// 非 json 内容不处理 JSONPath 规则
+	if got, masked = m.Mask("password: p@ss"); masked || got != "password: p@ss" {
+		t.Errorf("jsonpath rule should not mask the non json content: %s", got)
+	}
+}
+
+func TestMaskRegex(t *testing.T) {
+	m, err := New([]Rule{{Name: "password", Type: RegexRule, Pattern: `password:\s*(\S+)`}})
+	if err != nil {
+		t.Fatalf("new masker failed, err: %v", err)
+	}
+
+	got, masked := m.Mask("user: admin\npassword: p@ss\n")
+	if !masked || got != "user: admin\npassword: ******\n" {
+		t.Errorf("unexpected masked content: %s", got)
+	}
+}
+
+func TestNewInvalidRule(t *testing.T) {
+	invalid := []Rule{
+		{Name: "regex", Type: RegexRule, Pattern: "("},
+		{Name: "root", Type: JSONPathRule, Pattern: "$"},
+		{Name: "no_root", Type: JSONPathRule, Pattern: "a.b"},
+		{Name: "index", Type: JSONPathRule, Pattern: "$.a[x]"},
+		{Name: "type", Type: "xpath", Pattern: "/a"},
+	}
+	for _, r := range invalid {
+		if _, err := New([]Rule{r}); err == nil {
+			t.Errorf("rule %s should be invalid", r.Name)
+		}
+	}
+}
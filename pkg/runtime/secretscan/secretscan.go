@@ -0,0 +1,134 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package secretscan provides a static scanner which detects the credentials (AK/SK, private keys,
+// tokens etc.) written in the config content.
+package secretscan
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxFindings is the max findings reported for one content.
+const MaxFindings = 20
+
+// Rule is a named secret pattern.
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// builtinRules 内置的凭证匹配规则
+var builtinRules = []*Rule{
+	{Name: "aws_access_key", Pattern: regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "tencent_cloud_secret_id", Pattern: regexp.MustCompile(`\bAKID[0-9A-Za-z]{32}\b`)},
+	{Name: "aliyun_access_key", Pattern: regexp.MustCompile(`\bLTAI[0-9A-Za-z]{12,20}\b`)},
+	{Name: "private_key", Pattern: regexp.MustCompile(
+		`-----BEGIN (RSA |EC |DSA |OPENSSH |ENCRYPTED |PGP )?PRIVATE KEY( BLOCK)?-----`)},
+	{Name: "github_token", Pattern: regexp.MustCompile(`\bgh[pousr]_[0-9A-Za-z]{36}\b`)},
+	{Name: "slack_token", Pattern: regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z-]{10,}`)},
+	{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[0-9A-Za-z_-]{8,}\.eyJ[0-9A-Za-z_-]{8,}\.[0-9A-Za-z_-]{8,}`)},
+	{Name: "bearer_token", Pattern: regexp.MustCompile(`(?i)\bbearer\s+[0-9A-Za-z._~+/-]{20,}=*`)},
+	{Name: "secret_assignment", Pattern: regexp.MustCompile(
+		`(?i)\b[\w.-]*(password|passwd|secret[_-]?key|access[_-]?key[_-]?secret|api[_-]?key|token)` +
+			`["']?\s*[:=]\s*["']?[^\s"'$\{][^\s"']{7,}`)},
+}
+
+// BuiltinRuleNames returns the names of all the builtin rules.
+func BuiltinRuleNames() []string {
+	names := make([]string, 0, len(builtinRules))
+	for _, r := range builtinRules {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+// IsBuiltinRule returns whether the name is a builtin rule.
+func IsBuiltinRule(name string) bool {
+	for _, r := range builtinRules {
+		if r.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Finding is a suspected credential found in the content.
+type Finding struct {
+	Rule string
+	// Line is the line number(starts from 1) where the credential is found.
+	Line int
+}
+
+// Scanner scans the content with the enabled rules.
+type Scanner struct {
+	rules []*Rule
+}
+
+// CustomRule is a secret pattern defined by user.
+type CustomRule struct {
+	Name    string
+	Pattern string
+}
+
+// New create a scanner with the builtin rules except the disabled ones and the custom rules.
+func New(disabled []string, custom []CustomRule) (*Scanner, error) {
+	skip := make(map[string]bool, len(disabled))
+	for _, name := range disabled {
+		skip[name] = true
+	}
+
+	rules := make([]*Rule, 0, len(builtinRules)+len(custom))
+	for _, r := range builtinRules {
+		if !skip[r.Name] {
+			rules = append(rules, r)
+		}
+	}
+
+	for _, c := range custom {
+		re, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secret scan rule %s, err: %v", c.Name, err)
+		}
+		rules = append(rules, &Rule{Name: c.Name, Pattern: re})
+	}
+
+	return &Scanner{rules: rules}, nil
+}
+
+// Scan the content line by line, at most MaxFindings findings are returned and one line is
+// reported only once.
+func (s *Scanner) Scan(content string) []*Finding {
+	if s == nil || len(s.rules) == 0 || content == "" {
+		return nil
+	}
+
+	findings := make([]*Finding, 0)
+	// 私钥等多行内容只需命中起始行
+	for i, line := range strings.Split(content, "\n") {
+		for _, r := range s.rules {
+			if !r.Pattern.MatchString(line) {
+				continue
+			}
+
+			findings = append(findings, &Finding{Rule: r.Name, Line: i + 1})
+			if len(findings) >= MaxFindings {
+				return findings
+			}
+			break
+		}
+	}
+
+	return findings
+}
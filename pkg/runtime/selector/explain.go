@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package selector
+
+import (
+	"fmt"
+
+	pbstruct "github.com/golang/protobuf/ptypes/struct"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+)
+
+// Explanation explains how a selector matches an instance, it's used to debug the gray release targeting.
+type Explanation struct {
+	// Matched is the final match result, which is the same as MatchInstance.
+	Matched  bool `json:"matched"`
+	MatchAll bool `json:"match_all,omitempty"`
+	// InInstances defines whether the instance's uid is in the explicit instance list.
+	InInstances bool `json:"in_instances,omitempty"`
+	// LabelsMatched defines whether the instance's labels are matched by the selector.
+	LabelsMatched bool             `json:"labels_matched"`
+	Clauses       []*ClauseResult  `json:"clauses,omitempty"`
+	Rollout       *RolloutDecision `json:"rollout,omitempty"`
+}
+
+// ClauseResult is the evaluation result of a labels clause of the selector.
+type ClauseResult struct {
+	// Clause is the name of the clause, labels_or, labels_and or labels_groups[n].
+	Clause   string           `json:"clause"`
+	Matched  bool             `json:"matched"`
+	Elements []*ElementResult `json:"elements"`
+}
+
+// ElementResult is the evaluation result of a label element.
+type ElementResult struct {
+	Key   string      `json:"key"`
+	Op    string      `json:"op"`
+	Value interface{} `json:"value"`
+	// Actual is the instance's value of the label key, empty if not exists.
+	Actual  string `json:"actual"`
+	Exists  bool   `json:"exists"`
+	Matched bool   `json:"matched"`
+	Error   string `json:"error,omitempty"`
+}
+
+// RolloutDecision is the rollout result of the instance.
+type RolloutDecision struct {
+	Percent float64 `json:"percent"`
+	Seed    string  `json:"seed,omitempty"`
+	// Bucket is the instance's bucket, the instance is hit when bucket < percent * 100.
+	Bucket uint32 `json:"bucket"`
+	Hit    bool   `json:"hit"`
+}
+
+// MarshalPB marshal the explanation to pb struct.
+func (e *Explanation) MarshalPB() (*pbstruct.Struct, error) {
+	if e == nil {
+		return nil, nil
+	}
+
+	marshal, err := jsoni.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	st := new(pbstruct.Struct)
+	if err = st.UnmarshalJSON(marshal); err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+// ExplainInstance matches the instance like MatchInstance, but evaluates all the clauses without short circuit
+// and returns the detail of each clause.
+func (s *Selector) ExplainInstance(uid string, labels map[string]string) (*Explanation, error) {
+	exp := &Explanation{MatchAll: s.MatchAll}
+	for _, one := range s.Instances {
+		if one == uid {
+			exp.InInstances = true
+			break
+		}
+	}
+
+	matched, err := s.MatchLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	exp.LabelsMatched = matched
+
+	if len(s.LabelsOr) > 0 {
+		exp.Clauses = append(exp.Clauses, explainClause("labels_or", s.LabelsOr, labels, false))
+	}
+	if len(s.LabelsAnd) > 0 {
+		exp.Clauses = append(exp.Clauses, explainClause("labels_and", s.LabelsAnd, labels, true))
+	}
+	for idx, group := range s.LabelsGroups {
+		exp.Clauses = append(exp.Clauses, explainClause(fmt.Sprintf("labels_groups[%d]", idx), group, labels, true))
+	}
+
+	if s.Rollout != nil {
+		exp.Rollout = &RolloutDecision{
+			Percent: s.Rollout.Percent,
+			Seed:    s.Rollout.Seed,
+			Bucket:  RolloutBucket(s.Rollout.Seed, uid),
+			Hit:     s.Rollout.Hit(uid),
+		}
+	}
+
+	exp.Matched = exp.InInstances || (exp.LabelsMatched && s.Rollout.Hit(uid))
+	return exp, nil
+}
+
+// explainClause evaluates all the elements of the clause, and, means the elements control "AND", otherwise "OR".
+func explainClause(name string, lb Label, labels map[string]string, and bool) *ClauseResult {
+	result := &ClauseResult{Clause: name, Matched: and, Elements: make([]*ElementResult, 0, len(lb))}
+	for i := range lb {
+		one := &lb[i]
+		er := &ElementResult{Key: one.Key, Value: one.Value}
+		if one.Op != nil {
+			er.Op = string(one.Op.Name())
+		}
+		er.Actual, er.Exists = labels[one.Key]
+
+		// only nexists operator can be matched with a not exist key.
+		if er.Exists || er.Op == string(NotExists) {
+			matched, err := one.Match(labels)
+			if err != nil {
+				er.Error = err.Error()
+			}
+			er.Matched = matched && err == nil
+		}
+
+		if and {
+			result.Matched = result.Matched && er.Matched
+		} else {
+			result.Matched = result.Matched || er.Matched
+		}
+		result.Elements = append(result.Elements, er)
+	}
+
+	return result
+}
@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package selector
+
+import (
+	"testing"
+)
+
+func TestExplainInstance(t *testing.T) {
+	s := &Selector{
+		LabelsAnd: []Element{
+			{Key: "env", Op: new(EqualType), Value: "prod"},
+			{Key: "region", Op: new(InType), Value: []interface{}{"gz", "sh"}},
+		},
+		LabelsGroups: []Label{{{Key: "canary", Op: new(ExistsType)}}},
+		Instances:    []string{"uid1"},
+	}
+
+	cases := []struct {
+		uid    string
+		labels map[string]string
+	}{
+		{uid: "uid1", labels: map[string]string{}},
+		{uid: "uid2", labels: map[string]string{"env": "prod", "region": "gz"}},
+		{uid: "uid2", labels: map[string]string{"env": "prod", "region": "bj"}},
+		{uid: "uid2", labels: map[string]string{"canary": "true"}},
+	}
+	for _, c := range cases {
+		matched, err := s.MatchInstance(c.uid, c.labels)
+		if err != nil {
+			t.Fatalf("match instance failed, err: %v", err)
+		}
+		exp, err := s.ExplainInstance(c.uid, c.labels)
+		if err != nil {
+			t.Fatalf("explain instance failed, err: %v", err)
+		}
+		if exp.Matched != matched {
+			t.Errorf("explanation of %s %v should be %v, got %v", c.uid, c.labels, matched, exp.Matched)
+		}
+	}
+
+	exp, _ := s.ExplainInstance("uid2", map[string]string{"env": "prod", "region": "bj"})
+	if len(exp.Clauses) != 2 || exp.Clauses[0].Matched || !exp.Clauses[0].Elements[0].Matched ||
+		exp.Clauses[0].Elements[1].Matched || exp.Clauses[0].Elements[1].Actual != "bj" {
+		t.Errorf("unexpected clause results: %+v", exp.Clauses[0])
+	}
+
+	// the explicit instances are not narrowed by the rollout
+	s.Rollout = &Rollout{Percent: 0.01, Seed: "x"}
+	exp, _ = s.ExplainInstance("uid1", nil)
+	if exp.Rollout == nil || exp.Rollout.Hit != s.Rollout.Hit("uid1") || !exp.Matched {
+		t.Errorf("unexpected rollout decision: %+v", exp.Rollout)
+	}
+
+	exp, _ = s.ExplainInstance("uid2", map[string]string{"canary": "true"})
+	if exp.Rollout == nil || exp.Matched != exp.Rollout.Hit {
+		t.Errorf("unexpected rollout decision: %+v", exp.Rollout)
+	}
+}
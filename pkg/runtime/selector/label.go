@@ -107,6 +107,8 @@ func (e *Element) Equal(other *Element) bool {
 		if e.Value != other.Value {
 			return false
 		}
+	case Exists, NotExists:
+		return true
 	case In, NotIn:
 		if len(e.Value.([]interface{})) != len(other.Value.([]interface{})) {
 			return false
@@ -171,7 +173,11 @@ func (e *Element) UnmarshalJSON(bytes []byte) error {
 	// set op field
 	e.Op = operator
 
-	// set value field
+	// set value field, the value of exists/nexists operator can be omitted.
+	if !v.Exists() {
+		return nil
+	}
+
 	if err := json.Unmarshal([]byte(v.Raw), &e.Value); err != nil {
 		return err
 	}
@@ -14,9 +14,11 @@ package selector
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
 )
@@ -54,6 +56,8 @@ const (
 	NotIn            OperatorType = "nin"
 	Regex            OperatorType = "re"
 	NotRegex         OperatorType = "nre"
+	Exists           OperatorType = "exists"
+	NotExists        OperatorType = "nexists"
 )
 
 // supported default operators
@@ -68,6 +72,8 @@ var (
 	NotInOperator            = NotInType(NotIn)
 	RegexOperator            = RegexType(Regex)
 	NotRegexOperator         = NotRegexType(NotRegex)
+	ExistsOperator           = ExistsType(Exists)
+	NotExistsOperator        = NotExistsType(NotExists)
 )
 
 // OperatorEnums enum all the supported operators.
@@ -82,6 +88,8 @@ var OperatorEnums = map[OperatorType]Operator{
 	NotIn:            &NotInOperator,
 	Regex:            &RegexOperator,
 	NotRegex:         &NotRegexOperator,
+	Exists:           &ExistsOperator,
+	NotExists:        &NotExistsOperator,
 }
 
 var _ Operator = new(EqualType)
@@ -174,31 +182,17 @@ func (gt *GreaterThanType) Name() OperatorType {
 
 // Validate valid the match element is valid to greater than operator or not
 func (gt *GreaterThanType) Validate(match *Element) error {
-	if !isNumeric(match.Value) {
-		return fmt.Errorf("invalid gt oper with value: %v, should be number", match.Value)
-	}
-	return nil
+	return validateCompareValue("gt", match)
 }
 
 // Match matched only when the match key is exist and value is greater than it's target value.
 func (gt *GreaterThanType) Match(match *Element, labels map[string]string) (bool, error) {
-	if !isNumeric(match.Value) {
-		return false, fmt.Errorf("invalid gt oper with value: %v, should be number", match.Value)
-	}
-
-	from := mustFloat64(match.Value)
-
-	compare, exists := labels[match.Key]
-	if !exists {
-		return false, nil
-	}
-
-	to, err := strconv.ParseFloat(compare, 32)
-	if err != nil {
-		return false, fmt.Errorf("parse gt oper's target label value: %s to float failed, err: %v", compare, err)
+	result, exists, err := compareLabelValue("gt", match, labels)
+	if err != nil || !exists {
+		return false, err
 	}
 
-	return to > from, nil
+	return result > 0, nil
 }
 
 var _ Operator = new(GreaterThanEqualType)
@@ -213,34 +207,20 @@ func (ge *GreaterThanEqualType) Name() OperatorType {
 
 // Validate valid the match element is valid to greater than equal operator or not
 func (ge *GreaterThanEqualType) Validate(match *Element) error {
-	if !isNumeric(match.Value) {
-		return fmt.Errorf("invalid ge oper with value: %v, should be number", match.Value)
-	}
-	return nil
+	return validateCompareValue("ge", match)
 }
 
 // Match matched only when the match key is exist and value is greater than equal with it's target value.
-func (ge *GreaterThanEqualType) Match(match *Element, with map[string]string) (bool, error) {
-	if !isNumeric(match.Value) {
-		return false, fmt.Errorf("invalid ge oper with value: %v, should be number", match.Value)
-	}
-
-	from := mustFloat64(match.Value)
-
-	compare, exists := with[match.Key]
-	if !exists {
-		return false, nil
-	}
-
-	to, err := strconv.ParseFloat(compare, 32)
-	if err != nil {
-		return false, fmt.Errorf("parse ge oper's target label value: %s to float failed, err: %v", compare, err)
+func (ge *GreaterThanEqualType) Match(match *Element, labels map[string]string) (bool, error) {
+	result, exists, err := compareLabelValue("ge", match, labels)
+	if err != nil || !exists {
+		return false, err
 	}
 
-	return to >= from, nil
+	return result >= 0, nil
 }
 
-var _ Operator = new(LessThanEqualType)
+var _ Operator = new(LessThanType)
 
 // LessThanType is a less than operator
 type LessThanType OperatorType
@@ -252,31 +232,17 @@ func (lt *LessThanType) Name() OperatorType {
 
 // Validate valid the match element is valid to less than operator or not
 func (lt *LessThanType) Validate(match *Element) error {
-	if !isNumeric(match.Value) {
-		return fmt.Errorf("invalid lt oper with value: %v, should be number", match.Value)
-	}
-	return nil
+	return validateCompareValue("lt", match)
 }
 
-// Match matched only when the match key is exist and value is less than with it's target value.
+// Match matched only when the match key is exist and value is less than it's target value.
 func (lt *LessThanType) Match(match *Element, labels map[string]string) (bool, error) {
-	if !isNumeric(match.Value) {
-		return false, fmt.Errorf("invalid lt oper with value: %v, should be number", match.Value)
-	}
-
-	from := mustFloat64(match.Value)
-
-	compare, exists := labels[match.Key]
-	if !exists {
-		return false, nil
-	}
-
-	to, err := strconv.ParseFloat(compare, 32)
-	if err != nil {
-		return false, fmt.Errorf("parse lt oper's target label value: %s to float failed, err: %v", compare, err)
+	result, exists, err := compareLabelValue("lt", match, labels)
+	if err != nil || !exists {
+		return false, err
 	}
 
-	return to < from, nil
+	return result < 0, nil
 }
 
 var _ Operator = new(LessThanEqualType)
@@ -291,31 +257,17 @@ func (le *LessThanEqualType) Name() OperatorType {
 
 // Validate valid the match element is valid to less than equal operator or not
 func (le *LessThanEqualType) Validate(match *Element) error {
-	if !isNumeric(match.Value) {
-		return fmt.Errorf("invalid le oper with value: %v, should be number", match.Value)
-	}
-	return nil
+	return validateCompareValue("le", match)
 }
 
 // Match matched only when the match key is exist and value is less than equal with it's target value.
 func (le *LessThanEqualType) Match(match *Element, labels map[string]string) (bool, error) {
-	if !isNumeric(match.Value) {
-		return false, fmt.Errorf("invalid le oper with value: %v, should be number", match.Value)
-	}
-
-	from := mustFloat64(match.Value)
-
-	compare, exists := labels[match.Key]
-	if !exists {
-		return false, nil
-	}
-
-	to, err := strconv.ParseFloat(compare, 32)
-	if err != nil {
-		return false, fmt.Errorf("parse le oper's target label value: %s to float failed, err: %v", compare, err)
+	result, exists, err := compareLabelValue("le", match, labels)
+	if err != nil || !exists {
+		return false, err
 	}
 
-	return to <= from, nil
+	return result <= 0, nil
 }
 
 var _ Operator = new(InType)
@@ -522,6 +474,171 @@ func (nre *NotRegexType) Match(match *Element, labels map[string]string) (bool,
 	return !matched, nil
 }
 
+var _ Operator = new(ExistsType)
+
+// ExistsType is an exists operator
+type ExistsType OperatorType
+
+// Name is the name of exists operator
+func (e *ExistsType) Name() OperatorType {
+	return Exists
+}
+
+// Validate valid the match element is valid to exists operator or not
+func (e *ExistsType) Validate(match *Element) error {
+	if !isEmptyValue(match.Value) {
+		return fmt.Errorf("invalid exists oper with value: %v, should be empty", match.Value)
+	}
+	return nil
+}
+
+// Match matched only when the match key is exist, whatever it's value is.
+func (e *ExistsType) Match(match *Element, labels map[string]string) (bool, error) {
+	_, exists := labels[match.Key]
+	return exists, nil
+}
+
+var _ Operator = new(NotExistsType)
+
+// NotExistsType is a not exists operator
+type NotExistsType OperatorType
+
+// Name is the name of not exists operator
+func (nex *NotExistsType) Name() OperatorType {
+	return NotExists
+}
+
+// Validate valid the match element is valid to not exists operator or not
+func (nex *NotExistsType) Validate(match *Element) error {
+	if !isEmptyValue(match.Value) {
+		return fmt.Errorf("invalid nexists oper with value: %v, should be empty", match.Value)
+	}
+	return nil
+}
+
+// Match matched only when the match key is not exist.
+// NOTE: this is the only operator which can be matched with a not exist key.
+func (nex *NotExistsType) Match(match *Element, labels map[string]string) (bool, error) {
+	_, exists := labels[match.Key]
+	return !exists, nil
+}
+
+// validateCompareValue validate the value of gt/ge/lt/le operator, which can be a number,
+// or a version string like "2.3", "v1.2.10".
+func validateCompareValue(op string, match *Element) error {
+	if isNumeric(match.Value) {
+		return nil
+	}
+
+	v, ok := match.Value.(string)
+	if !ok {
+		return fmt.Errorf("invalid %s oper with value: %v, should be number or version", op, match.Value)
+	}
+
+	if _, err := parseVersion(v); err != nil {
+		return fmt.Errorf("invalid %s oper with value: %s, %v", op, v, err)
+	}
+
+	return nil
+}
+
+// compareLabelValue compare the label's value with the match element's value, returns -1, 0, +1
+// when label's value is less than, equal to, greater than the match value.
+// a numeric match value is compared as float, and a string match value is compared as version.
+func compareLabelValue(op string, match *Element, labels map[string]string) (int, bool, error) {
+	if err := validateCompareValue(op, match); err != nil {
+		return 0, false, err
+	}
+
+	compare, exists := labels[match.Key]
+	if !exists {
+		return 0, false, nil
+	}
+
+	if isNumeric(match.Value) {
+		from := mustFloat64(match.Value)
+		to, err := strconv.ParseFloat(compare, 32)
+		if err != nil {
+			return 0, true, fmt.Errorf("parse %s oper's target label value: %s to float failed, err: %v",
+				op, compare, err)
+		}
+
+		switch {
+		case to > from:
+			return 1, true, nil
+		case to < from:
+			return -1, true, nil
+		default:
+			return 0, true, nil
+		}
+	}
+
+	// the match value has been validated above.
+	from, _ := parseVersion(match.Value.(string))
+	to, err := parseVersion(compare)
+	if err != nil {
+		return 0, true, fmt.Errorf("parse %s oper's target label value: %s to version failed, err: %v",
+			op, compare, err)
+	}
+
+	return compareVersion(to, from), true, nil
+}
+
+// parseVersion parse a dotted version string like "2.3" or "v1.2.10" to it's numeric segments.
+func parseVersion(v string) ([]uint64, error) {
+	v = strings.TrimPrefix(strings.TrimPrefix(v, "v"), "V")
+	if len(v) == 0 {
+		return nil, errors.New("version is empty")
+	}
+
+	parts := strings.Split(v, ".")
+	segments := make([]uint64, 0, len(parts))
+	for _, part := range parts {
+		seg, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version segment: %q", part)
+		}
+		segments = append(segments, seg)
+	}
+
+	return segments, nil
+}
+
+// compareVersion compare two versions, the missing segments is treated as 0, so "2.3" equals to "2.3.0".
+func compareVersion(a, b []uint64) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y uint64
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+
+		switch {
+		case x > y:
+			return 1
+		case x < y:
+			return -1
+		}
+	}
+
+	return 0
+}
+
+// isEmptyValue test the value of exists/nexists operator is empty or not.
+func isEmptyValue(val interface{}) bool {
+	switch v := val.(type) {
+	case nil:
+		return true
+	case string:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
 func isNumeric(val interface{}) bool {
 	switch val.(type) {
 	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, json.Number:
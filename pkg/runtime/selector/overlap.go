@@ -0,0 +1,287 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package selector
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Overlap is the scope matched by two selectors at the same time, the instances in it match both of them.
+type Overlap struct {
+	// Clauses is the overlapped label spaces, each of them is the conjunction of the two selectors' clauses,
+	// an empty clause means all the instances.
+	Clauses []Label `json:"clauses,omitempty"`
+	// Instances is the instance uids matched by both selectors' explicit instance lists.
+	Instances []string `json:"instances,omitempty"`
+}
+
+// String returns a readable description of the overlapped scope, eg. (os eq linux AND region in [sh]) OR
+// instances [uid1].
+func (o *Overlap) String() string {
+	if o == nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(o.Clauses)+1)
+	for _, clause := range o.Clauses {
+		if len(clause) == 0 {
+			parts = append(parts, "all instances")
+			continue
+		}
+		elements := make([]string, 0, len(clause))
+		for i := range clause {
+			elements = append(elements, elementString(&clause[i]))
+		}
+		parts = append(parts, "("+strings.Join(elements, " AND ")+")")
+	}
+
+	if len(o.Instances) != 0 {
+		parts = append(parts, fmt.Sprintf("instances %v", o.Instances))
+	}
+
+	return strings.Join(parts, " OR ")
+}
+
+// elementString returns a readable description of the element, eg. os eq linux.
+func elementString(e *Element) string {
+	if e.Op == nil {
+		return e.Key
+	}
+
+	switch e.Op.Name() {
+	case Exists, NotExists:
+		return fmt.Sprintf("%s %s", e.Key, e.Op.Name())
+	default:
+		return fmt.Sprintf("%s %s %v", e.Key, e.Op.Name(), e.Value)
+	}
+}
+
+// Overlap detect the scope matched by this selector and the other one at the same time, returns nil if there
+// is no overlap. the labels are compared statically, two clauses overlap unless some label key of them has
+// contradictory conditions, so the result is a superset of the actual overlap, eg. (version ge 2.0) and
+// (version re ^1\.) are treated as overlapped. the rollout only narrows the instances, it's not concerned.
+// NOTE: the explicit instances are only compared with the other's instances and match all clauses, because
+// the instance's labels are unknown.
+func (s *Selector) Overlap(other *Selector) *Overlap {
+	if s == nil || other == nil {
+		return nil
+	}
+
+	overlap := new(Overlap)
+	sc, oc := s.clauses(), other.clauses()
+	for _, a := range sc {
+		for _, b := range oc {
+			merged := make(Label, 0, len(a)+len(b))
+			merged = append(append(merged, a...), b...)
+			if satisfiable(merged) {
+				overlap.Clauses = append(overlap.Clauses, merged)
+			}
+		}
+	}
+
+	uids := make(map[string]struct{})
+	for _, one := range s.Instances {
+		uids[one] = struct{}{}
+	}
+	instances := make(map[string]struct{})
+	for _, one := range other.Instances {
+		if _, exists := uids[one]; exists || matchAllClause(sc) {
+			instances[one] = struct{}{}
+		}
+	}
+	if matchAllClause(oc) {
+		for one := range uids {
+			instances[one] = struct{}{}
+		}
+	}
+	for one := range instances {
+		overlap.Instances = append(overlap.Instances, one)
+	}
+	sort.Strings(overlap.Instances)
+
+	if len(overlap.Clauses) == 0 && len(overlap.Instances) == 0 {
+		return nil
+	}
+
+	return overlap
+}
+
+// clauses returns the labels clauses of the selector, the clauses control "OR" and the elements in one clause
+// control "AND", an empty clause means all the instances.
+func (s *Selector) clauses() []Label {
+	if s.MatchAll {
+		return []Label{{}}
+	}
+
+	// only rollout is set, then it works on all the instances.
+	if s.Rollout != nil && len(s.LabelsOr) == 0 && len(s.LabelsAnd) == 0 && len(s.LabelsGroups) == 0 &&
+		len(s.Instances) == 0 {
+		return []Label{{}}
+	}
+
+	clauses := make([]Label, 0, len(s.LabelsOr)+len(s.LabelsGroups)+1)
+	for _, one := range s.LabelsOr {
+		clauses = append(clauses, Label{one})
+	}
+	if len(s.LabelsAnd) != 0 {
+		clauses = append(clauses, s.LabelsAnd)
+	}
+	for _, group := range s.LabelsGroups {
+		if len(group) != 0 {
+			clauses = append(clauses, group)
+		}
+	}
+
+	return clauses
+}
+
+func matchAllClause(clauses []Label) bool {
+	for _, one := range clauses {
+		if len(one) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// satisfiable test whether there may be labels matched by all the elements of the clause.
+func satisfiable(clause Label) bool {
+	byKey := make(map[string][]*Element)
+	for i := range clause {
+		if clause[i].Op == nil {
+			continue
+		}
+		byKey[clause[i].Key] = append(byKey[clause[i].Key], &clause[i])
+	}
+
+	for key, elements := range byKey {
+		if !keySatisfiable(key, elements) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// keySatisfiable test whether there may be a value of the key matched by all the elements.
+func keySatisfiable(key string, elements []*Element) bool {
+	var notExists, others bool
+	var candidates []string
+	var hasCandidates bool
+	for _, one := range elements {
+		switch one.Op.Name() {
+		case NotExists:
+			notExists = true
+			continue
+		case Equal:
+			if v, ok := one.Value.(string); ok {
+				candidates = intersect(candidates, []string{v}, hasCandidates)
+				hasCandidates = true
+			}
+		case In:
+			if values, ok := one.Value.([]interface{}); ok {
+				in := make([]string, 0, len(values))
+				for _, v := range values {
+					if str, ok := v.(string); ok {
+						in = append(in, str)
+					}
+				}
+				candidates = intersect(candidates, in, hasCandidates)
+				hasCandidates = true
+			}
+		}
+		others = true
+	}
+
+	// all the operators except nexists require the key exists.
+	if notExists {
+		return !others
+	}
+
+	// the value must be one of the candidates, test them with all the elements.
+	if hasCandidates {
+		for _, value := range candidates {
+			if matchAllElements(key, value, elements) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return rangeSatisfiable(key, elements)
+}
+
+func intersect(a, b []string, hasA bool) []string {
+	if !hasA {
+		return b
+	}
+
+	result := make([]string, 0)
+	for _, one := range a {
+		for _, two := range b {
+			if one == two {
+				result = append(result, one)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func matchAllElements(key, value string, elements []*Element) bool {
+	labels := map[string]string{key: value}
+	for _, one := range elements {
+		matched, err := one.Match(labels)
+		// the value can not be compared, regard it as matched.
+		if err == nil && !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// rangeSatisfiable test whether the lower bounds and upper bounds of the key have intersection.
+func rangeSatisfiable(key string, elements []*Element) bool {
+	for _, lower := range elements {
+		lop := lower.Op.Name()
+		if lop != GreaterThan && lop != GreaterThanEqual {
+			continue
+		}
+		for _, upper := range elements {
+			uop := upper.Op.Name()
+			if uop != LessThan && uop != LessThanEqual {
+				continue
+			}
+			// both of the bounds should be number or version.
+			if isNumeric(lower.Value) != isNumeric(upper.Value) {
+				continue
+			}
+
+			bound := fmt.Sprint(upper.Value)
+			if isNumeric(upper.Value) {
+				bound = strconv.FormatFloat(mustFloat64(upper.Value), 'f', -1, 64)
+			}
+			cmp, exists, err := compareLabelValue(string(lop), lower, map[string]string{key: bound})
+			if err != nil || !exists {
+				continue
+			}
+			if cmp < 0 || (cmp == 0 && (lop == GreaterThan || uop == LessThan)) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package selector
+
+import (
+	"testing"
+)
+
+func TestSelectorOverlap(t *testing.T) {
+	and := func(elements ...Element) *Selector { return &Selector{LabelsAnd: elements} }
+	eq := func(k, v string) Element { return Element{Key: k, Op: new(EqualType), Value: v} }
+	version := func(k string, op Operator, v string) Element { return Element{Key: k, Op: op, Value: v} }
+	cases := []struct {
+		name    string
+		a, b    *Selector
+		overlap bool
+	}{
+		{"different values", and(eq("os", "linux")), and(eq("os", "windows")), false},
+		{"different keys", and(eq("os", "linux")), and(eq("region", "sh")), true},
+		{"in and eq", and(eq("os", "linux")),
+			and(Element{Key: "os", Op: new(InType), Value: []interface{}{"mac", "linux"}}), true},
+		{"eq and ne", and(eq("os", "linux")), and(Element{Key: "os", Op: new(NotEqualType), Value: "linux"}), false},
+		{"exists and nexists", and(Element{Key: "canary", Op: new(ExistsType)}),
+			and(Element{Key: "canary", Op: new(NotExistsType)}), false},
+		{"disjoint version range", and(version("v", new(LessThanType), "2.0")),
+			and(version("v", new(GreaterThanEqualType), "2.0")), false},
+		{"version range", and(version("v", new(LessThanEqualType), "2.0")),
+			and(version("v", new(GreaterThanEqualType), "2.0")), true},
+		{"labels or", &Selector{LabelsOr: Label{eq("os", "linux"), eq("os", "mac")}}, and(eq("os", "mac")), true},
+		{"match all", &Selector{MatchAll: true}, and(eq("os", "mac")), true},
+		{"instances", &Selector{Instances: []string{"uid1"}}, &Selector{Instances: []string{"uid2", "uid1"}}, true},
+		{"different instance lists", &Selector{Instances: []string{"uid1"}}, &Selector{Instances: []string{"uid2"}},
+			false},
+	}
+
+	for _, c := range cases {
+		overlap := c.a.Overlap(c.b)
+		if (overlap != nil) != c.overlap {
+			t.Errorf("%s: expect overlap %v, but got %s", c.name, c.overlap, overlap)
+		}
+	}
+
+	overlap := (&Selector{LabelsAnd: Label{eq("os", "linux")}, Instances: []string{"uid1"}}).Overlap(
+		&Selector{LabelsAnd: Label{eq("region", "sh")}, Instances: []string{"uid1"}})
+	if got := overlap.String(); got != "(os eq linux AND region eq sh) OR instances [uid1]" {
+		t.Errorf("unexpected overlap description: %s", got)
+	}
+}
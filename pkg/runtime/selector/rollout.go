@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+const (
+	// MaxRolloutPercent is the max percent of a rollout.
+	MaxRolloutPercent float64 = 100
+	// rolloutBucketCount is the total bucket count of a rollout, every bucket holds 0.01% instances.
+	rolloutBucketCount uint32 = 10000
+	// maxRolloutSeedLength is the max length of a rollout's seed.
+	maxRolloutSeedLength = 64
+)
+
+// Rollout defines a percentage-based rollout, which targets a stable percentage of
+// instances by consistent hashing of the instance's uid(fingerprint).
+// the instances are hashed into fixed buckets, and an instance is hit when it's bucket
+// is less than the percent, so the hit instances stay selected as the percent grows.
+type Rollout struct {
+	// Percent is the percentage of instances to hit, range (0, 100], with at most 2 decimals.
+	Percent float64 `json:"percent"`
+	// Seed is mixed into the hash, different seed selects a different set of instances
+	// with the same percent. it can be empty.
+	Seed string `json:"seed,omitempty"`
+}
+
+// Validate the rollout is valid or not.
+func (r *Rollout) Validate() error {
+	if r == nil {
+		return errors.New("rollout is nil")
+	}
+
+	if r.Percent <= 0 || r.Percent > MaxRolloutPercent {
+		return fmt.Errorf("invalid rollout percent: %v, should be in range (0, %v]", r.Percent, MaxRolloutPercent)
+	}
+
+	if math.Abs(r.Percent*100-math.Round(r.Percent*100)) > 1e-6 {
+		return fmt.Errorf("invalid rollout percent: %v, at most 2 decimals is allowed", r.Percent)
+	}
+
+	if len(r.Seed) > maxRolloutSeedLength {
+		return fmt.Errorf("rollout seed is too long, should be less than %d", maxRolloutSeedLength)
+	}
+
+	return nil
+}
+
+// Equal check if this rollout is equal to another one.
+func (r *Rollout) Equal(other *Rollout) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+
+	return r.Percent == other.Percent && r.Seed == other.Seed
+}
+
+// Hit test the instance with the uid is hit by this rollout or not.
+func (r *Rollout) Hit(uid string) bool {
+	if r == nil {
+		return true
+	}
+
+	if r.Percent >= MaxRolloutPercent {
+		return true
+	}
+
+	if len(uid) == 0 {
+		return false
+	}
+
+	return RolloutBucket(r.Seed, uid) < uint32(math.Round(r.Percent*100))
+}
+
+// RolloutBucket returns the bucket of an instance with the uid, range [0, 10000).
+func RolloutBucket(seed, uid string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(seed))
+	_, _ = h.Write([]byte{'/'})
+	_, _ = h.Write([]byte(uid))
+	return h.Sum32() % rolloutBucketCount
+}
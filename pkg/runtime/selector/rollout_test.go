@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package selector
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRolloutHit(t *testing.T) {
+	const total = 10000
+	uids := make([]string, 0, total)
+	for i := 0; i < total; i++ {
+		uids = append(uids, fmt.Sprintf("instance-%d", i))
+	}
+
+	small := &Rollout{Percent: 10, Seed: "app-1"}
+	large := &Rollout{Percent: 30, Seed: "app-1"}
+
+	hit := 0
+	for _, uid := range uids {
+		if !small.Hit(uid) {
+			continue
+		}
+		hit++
+
+		// the instances hit by small percent should be always hit by the larger one.
+		if !large.Hit(uid) {
+			t.Errorf("instance %s is hit by 10%% rollout, but not hit by 30%% rollout", uid)
+			return
+		}
+	}
+
+	if hit < total*8/100 || hit > total*12/100 {
+		t.Errorf("10%% rollout hit %d instances of %d, out of expected range", hit, total)
+		return
+	}
+
+	if !(&Rollout{Percent: 100}).Hit("any") {
+		t.Errorf("100%% rollout should hit all the instances")
+		return
+	}
+
+	for _, p := range []float64{0, -1, 100.5, 10.001} {
+		if err := (&Rollout{Percent: p}).Validate(); err == nil {
+			t.Errorf("rollout percent %v should be invalid", p)
+			return
+		}
+	}
+
+	if err := (&Rollout{Percent: 10.01}).Validate(); err != nil {
+		t.Errorf("rollout percent 10.01 should be valid, err: %v", err)
+		return
+	}
+}
+
+func TestSelectorMatchInstance(t *testing.T) {
+	s := new(Selector)
+	if err := s.Unmarshal([]byte(`{"rollout": {"percent": 50, "seed": "s"}}`)); err != nil {
+		t.Errorf("unmarshal rollout only selector failed, err: %v", err)
+		return
+	}
+
+	for i := 0; i < 100; i++ {
+		uid := fmt.Sprintf("uid-%d", i)
+		matched, err := s.MatchInstance(uid, nil)
+		if err != nil {
+			t.Errorf("match instance failed, err: %v", err)
+			return
+		}
+
+		if matched != s.Rollout.Hit(uid) {
+			t.Errorf("instance %s match result is not consistent with the rollout", uid)
+			return
+		}
+	}
+
+	s = &Selector{
+		LabelsAnd: []Element{{Key: "region", Op: &EqualOperator, Value: "gz"}},
+		Rollout:   &Rollout{Percent: 100},
+	}
+	matched, err := s.MatchInstance("uid", map[string]string{"region": "sh"})
+	if err != nil {
+		t.Errorf("match instance failed, err: %v", err)
+		return
+	}
+	if matched {
+		t.Errorf("instance not matched by labels should not be matched")
+		return
+	}
+}
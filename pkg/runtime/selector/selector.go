@@ -29,8 +29,8 @@ import (
 // Selector defines a group's working scope.
 type Selector struct {
 	// MatchAll is true means this strategy match all the target.
-	// 1. if MatchAll is true, then LabelsOr and LabelsAnd field must be empty.
-	// 2. if MatchAll is false, then LabelsOr and LabelsAnd field can not be empty at the same time.
+	// 1. if MatchAll is true, then LabelsOr, LabelsAnd and LabelsGroups field must be empty.
+	// 2. if MatchAll is false, then LabelsOr, LabelsAnd and LabelsGroups field can not be empty at the same time.
 	MatchAll bool `json:"match_all,omitempty"`
 
 	// LabelsOr is instance labels in strategy which control "OR".
@@ -39,10 +39,31 @@ type Selector struct {
 	// LabelsAnd is instance labels in strategy which control "AND".
 	LabelsAnd Label `json:"labels_and,omitempty"`
 
-	// NOTE: when LabelsOr(OR) and LabelsAnd(AND) both exist, the strategy need IN(OR) logical relationship,
-	// eg. (IN(LabelsOr, LabelsAnd), the strategy matched when any labels logical matched.
+	// LabelsGroups is a set of label groups, the elements in one group control "AND",
+	// and the groups control "OR". eg. (region in (gz, sh) AND version >= 2.3) OR (env exists).
+	LabelsGroups []Label `json:"labels_groups,omitempty"`
+
+	// Instances is an explicit instance uid list, the instances in it are always matched,
+	// whatever it's labels are and whether they are hit by the rollout or not. it works together
+	// with labels in "OR" logical relationship.
+	Instances []string `json:"instances,omitempty"`
+
+	// Rollout is a percentage-based rollout, which further narrows the instances matched by labels
+	// to a stable percentage of them. when the labels is empty, it works on all the instances.
+	Rollout *Rollout `json:"rollout,omitempty"`
+
+	// NOTE: when LabelsOr(OR), LabelsAnd(AND) and LabelsGroups exist, the strategy need IN(OR) logical
+	// relationship, eg. (IN(LabelsOr, LabelsAnd, LabelsGroups), the strategy matched when any labels
+	// logical matched.
 }
 
+const (
+	// MaxLabelsGroupCount is the max group count of a selector's labels_groups.
+	MaxLabelsGroupCount = 10
+	// MaxInstanceCount is the max instance count of a selector's instances.
+	MaxInstanceCount = 1000
+)
+
 // Scan is used to decode raw message which is read from db into a structured Selector instance.
 func (s *Selector) Scan(raw interface{}) error {
 	if s == nil {
@@ -122,7 +143,8 @@ func (s *Selector) IsEmpty() bool {
 		return true
 	}
 
-	if !s.MatchAll && (len(s.LabelsOr) == 0) && (len(s.LabelsAnd) == 0) {
+	if !s.MatchAll && (len(s.LabelsOr) == 0) && (len(s.LabelsAnd) == 0) && (len(s.LabelsGroups) == 0) &&
+		len(s.Instances) == 0 && s.Rollout == nil {
 		return true
 	}
 
@@ -151,17 +173,74 @@ func (s *Selector) Equal(other *Selector) bool {
 		return false
 	}
 
+	if !s.Rollout.Equal(other.Rollout) {
+		return false
+	}
+
+	if !sameInstances(s.Instances, other.Instances) {
+		return false
+	}
+
+	if len(s.LabelsGroups) != len(other.LabelsGroups) {
+		return false
+	}
+
+	for i := range s.LabelsGroups {
+		if !s.LabelsGroups[i].Equal(other.LabelsGroups[i]) {
+			return false
+		}
+	}
+
 	return true
 }
 
+// MatchInstance matches strategy base on instance's uid and labels info,
+// the instance in the explicit instance list is always matched, otherwise it should be matched by
+// labels, and hit by the rollout if it's set.
+func (s *Selector) MatchInstance(uid string, labels map[string]string) (bool, error) {
+	for _, one := range s.Instances {
+		if one == uid {
+			return true, nil
+		}
+	}
+
+	matched, err := s.MatchLabels(labels)
+	if err != nil || !matched {
+		return false, err
+	}
+
+	return s.Rollout.Hit(uid), nil
+}
+
+// sameInstances checks if the two instance lists contain the same uids, the order is ignored.
+func sameInstances(a, b []string) bool {
+	as := make(map[string]struct{}, len(a))
+	for _, one := range a {
+		as[one] = struct{}{}
+	}
+
+	bs := make(map[string]struct{}, len(b))
+	for _, one := range b {
+		if _, exists := as[one]; !exists {
+			return false
+		}
+		bs[one] = struct{}{}
+	}
+
+	return len(as) == len(bs)
+}
+
 // MatchLabels matches strategy base on labels info.
+// Note: it does not care about the rollout, use MatchInstance instead if the rollout is concerned.
 func (s *Selector) MatchLabels(labels map[string]string) (bool, error) {
 	if s.MatchAll {
 		return true, nil
 	}
 
-	if len(labels) == 0 {
-		return false, nil
+	// only rollout is set, then it works on all the instances.
+	if s.Rollout != nil && len(s.LabelsOr) == 0 && len(s.LabelsAnd) == 0 && len(s.LabelsGroups) == 0 &&
+		len(s.Instances) == 0 {
+		return true, nil
 	}
 
 	// match IN multi LabelsOr...
@@ -174,21 +253,29 @@ func (s *Selector) MatchLabels(labels map[string]string) (bool, error) {
 		return true, nil
 	}
 
-	if len(s.LabelsAnd) == 0 {
-		return false, nil
-	}
-
 	// match IN multi LabelsAnd...
 	matched, err = s.matchLabelsAnd(s.LabelsAnd, labels)
 	if err != nil {
 		return false, err
 	}
 
-	if !matched {
-		return false, nil
+	if matched {
+		return true, nil
 	}
 
-	return true, nil
+	// match IN multi LabelsGroups...
+	for _, group := range s.LabelsGroups {
+		matched, err = s.matchLabelsAnd(group, labels)
+		if err != nil {
+			return false, err
+		}
+
+		if matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
 }
 
 // Validate validate a strategy is valid or not
@@ -197,16 +284,42 @@ func (s *Selector) Validate() error {
 		return errors.New("strategy is nil")
 	}
 
+	if s.Rollout != nil {
+		if err := s.Rollout.Validate(); err != nil {
+			return err
+		}
+	}
+
 	if s.MatchAll {
-		if len(s.LabelsOr) != 0 || len(s.LabelsAnd) != 0 {
-			return errors.New("match_all is true, but labels_or or labels_and is not empty")
+		if len(s.LabelsOr) != 0 || len(s.LabelsAnd) != 0 || len(s.LabelsGroups) != 0 || len(s.Instances) != 0 {
+			return errors.New("match_all is true, but labels_or, labels_and, labels_groups or instances is not empty")
 		}
 		return nil
 	}
 
-	// not match all, at least one of labels_and or labels_or labels should not be empty.
-	if len(s.LabelsOr) == 0 && len(s.LabelsAnd) == 0 {
-		return errors.New("match_all is false, but both labels_or and labels_and is empty")
+	// not match all, at least one of labels_and, labels_or, labels_groups, instances or rollout
+	// should not be empty.
+	if len(s.LabelsOr) == 0 && len(s.LabelsAnd) == 0 && len(s.LabelsGroups) == 0 && len(s.Instances) == 0 &&
+		s.Rollout == nil {
+		return errors.New("match_all is false, but labels_or, labels_and, labels_groups, instances and rollout " +
+			"are all empty")
+	}
+
+	// validate instances
+	if len(s.Instances) > MaxInstanceCount {
+		return fmt.Errorf("instances contains oversize uids, should be less than %d", MaxInstanceCount)
+	}
+
+	uids := make(map[string]struct{}, len(s.Instances))
+	for _, uid := range s.Instances {
+		if err := validator.ValidateUidLength(uid); err != nil {
+			return fmt.Errorf("invalid instance uid: %s, %v", uid, err)
+		}
+
+		if _, exists := uids[uid]; exists {
+			return fmt.Errorf("duplicate instance uid: %s", uid)
+		}
+		uids[uid] = struct{}{}
 	}
 
 	// validate and labels
@@ -236,6 +349,27 @@ func (s *Selector) Validate() error {
 		}
 	}
 
+	// validate labels groups
+	if len(s.LabelsGroups) > MaxLabelsGroupCount {
+		return fmt.Errorf("labels_groups contains oversize groups, should be less than %d groups",
+			MaxLabelsGroupCount)
+	}
+
+	for idx, group := range s.LabelsGroups {
+		if len(group) == 0 {
+			return fmt.Errorf("labels_groups[%d] is empty", idx)
+		}
+
+		if len(group) > validator.MaxLabelKeyCount {
+			return fmt.Errorf("labels_groups[%d] contains oversize labels, should be less than %d labels",
+				idx, validator.MaxLabelKeyCount)
+		}
+
+		if err := group.Validate(); err != nil {
+			return fmt.Errorf("labels_groups[%d] is invalid, %v", idx, err)
+		}
+	}
+
 	return nil
 }
 
@@ -246,7 +380,8 @@ func (s *Selector) matchLabelsOr(labelsOr Label, labels map[string]string) (bool
 
 	var exist bool
 	for _, one := range labelsOr {
-		if _, exist = labels[one.Key]; !exist {
+		// only nexists operator can be matched with a not exist key.
+		if _, exist = labels[one.Key]; !exist && one.Op.Name() != NotExists {
 			continue
 		}
 
@@ -271,7 +406,7 @@ func (s *Selector) matchLabelsAnd(labelsAnd Label, labels map[string]string) (bo
 
 	var exist bool
 	for _, one := range labelsAnd {
-		if _, exist = labels[one.Key]; !exist {
+		if _, exist = labels[one.Key]; !exist && one.Op.Name() != NotExists {
 			return false, nil
 		}
 
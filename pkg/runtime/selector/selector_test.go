@@ -130,3 +130,138 @@ func TestEqual(t *testing.T) {
 	}
 
 }
+
+func TestMatchLabelsGroups(t *testing.T) {
+	const js = `{
+		"labels_groups": [
+			[
+				{"key": "region", "op": "in", "value": ["gz", "sh"]},
+				{"key": "version", "op": "ge", "value": "2.3"}
+			],
+			[
+				{"key": "canary", "op": "exists"},
+				{"key": "blocked", "op": "nexists"}
+			]
+		]
+	}`
+
+	s := new(Selector)
+	if err := s.Unmarshal([]byte(js)); err != nil {
+		t.Errorf("unmarshal selector failed, err: %v", err)
+		return
+	}
+
+	type expectResult struct {
+		labels map[string]string
+		expect bool
+	}
+
+	var cases = []expectResult{
+		{labels: map[string]string{"region": "gz", "version": "2.3"}, expect: true},
+		{labels: map[string]string{"region": "sh", "version": "v2.10.1"}, expect: true},
+		{labels: map[string]string{"region": "sh", "version": "2.2.9"}, expect: false},
+		{labels: map[string]string{"region": "bj", "version": "3.0"}, expect: false},
+		{labels: map[string]string{"region": "gz"}, expect: false},
+		{labels: map[string]string{"canary": ""}, expect: true},
+		{labels: map[string]string{"canary": "true", "blocked": "true"}, expect: false},
+		{labels: map[string]string{}, expect: false},
+	}
+
+	for idx, c := range cases {
+		matched, err := s.MatchLabels(c.labels)
+		if err != nil {
+			t.Errorf("selector case %d match failed, err: %v", idx, err)
+			return
+		}
+
+		if matched != c.expect {
+			t.Errorf("selector case %d match result should be %v", idx, c.expect)
+			return
+		}
+	}
+
+	invalid := []string{
+		`{"match_all": true, "labels_groups": [[{"key": "a", "op": "exists"}]]}`,
+		`{"labels_groups": [[]]}`,
+		`{"labels_groups": [[{"key": "a", "op": "exists", "value": "x"}]]}`,
+		`{"labels_groups": [[{"key": "a", "op": "gt", "value": "x.y"}]]}`,
+	}
+	for idx, one := range invalid {
+		if err := new(Selector).Unmarshal([]byte(one)); err == nil {
+			t.Errorf("invalid selector %d should not be unmarshal success", idx)
+			return
+		}
+	}
+}
+
+func TestMatchInstances(t *testing.T) {
+	s := new(Selector)
+	if err := s.Unmarshal([]byte(`{"instances": ["uid-1", "uid-2"],
+		"labels_and": [{"key": "env", "op": "eq", "value": "test"}]}`)); err != nil {
+		t.Errorf("unmarshal selector failed, err: %v", err)
+		return
+	}
+
+	type expectResult struct {
+		uid    string
+		labels map[string]string
+		expect bool
+	}
+
+	var cases = []expectResult{
+		{uid: "uid-1", labels: nil, expect: true},
+		{uid: "uid-2", labels: map[string]string{"env": "prod"}, expect: true},
+		{uid: "uid-3", labels: map[string]string{"env": "test"}, expect: true},
+		{uid: "uid-3", labels: map[string]string{"env": "prod"}, expect: false},
+	}
+
+	for idx, c := range cases {
+		matched, err := s.MatchInstance(c.uid, c.labels)
+		if err != nil {
+			t.Errorf("selector case %d match failed, err: %v", idx, err)
+			return
+		}
+
+		if matched != c.expect {
+			t.Errorf("selector case %d match result should be %v", idx, c.expect)
+			return
+		}
+	}
+}
+
+func TestMatchInstancesWithRollout(t *testing.T) {
+	s := &Selector{
+		Instances: []string{"uid-1", "uid-2"},
+		Rollout:   &Rollout{Percent: 0.01, Seed: "x"},
+	}
+
+	// the explicit instances are always matched, whatever the rollout is.
+	for _, uid := range s.Instances {
+		if matched, _ := s.MatchInstance(uid, nil); !matched {
+			t.Errorf("explicit instance %s should be matched by the rollout selector", uid)
+		}
+	}
+}
+
+func TestEqualInstances(t *testing.T) {
+	cases := []struct {
+		a, b   []string
+		expect bool
+	}{
+		{a: []string{"a", "b"}, b: []string{"b", "a"}, expect: true},
+		{a: []string{"a", "b"}, b: []string{"a", "a"}, expect: false},
+		{a: []string{"a", "a"}, b: []string{"a", "b"}, expect: false},
+		{a: []string{"a"}, b: []string{"a", "b"}, expect: false},
+	}
+	for idx, c := range cases {
+		if (&Selector{Instances: c.a}).Equal(&Selector{Instances: c.b}) != c.expect {
+			t.Errorf("selector instances %d equal failed", idx)
+		}
+	}
+}
+
+func TestValidateDuplicateInstances(t *testing.T) {
+	if err := new(Selector).Unmarshal([]byte(`{"instances": ["uid-1", "uid-1"]}`)); err == nil {
+		t.Errorf("selector with duplicate instances should not be unmarshal success")
+	}
+}
@@ -24,6 +24,12 @@ type selectorRaw struct {
 	MatchAll  bool       `json:"match_all,omitempty"`
 	LabelsOr  []*element `json:"labels_or,omitempty"`
 	LabelsAnd []*element `json:"labels_and,omitempty"`
+	// LabelsGroups 多组 AND 条件，组间为 OR
+	LabelsGroups [][]*element `json:"labels_groups,omitempty"`
+	// Instances 显式指定的实例 uid 列表
+	Instances []string `json:"instances,omitempty"`
+	// Rollout 按实例 uid 哈希灰度的百分比
+	Rollout *Rollout `json:"rollout,omitempty"`
 }
 
 func validatEelement(e *element) error {
@@ -84,9 +90,24 @@ func validatEelement(e *element) error {
 		return nil
 	}
 
+	if operator == &ExistsOperator || operator == &NotExistsOperator {
+		if !isEmptyValue(e.Value) {
+			return fmt.Errorf("selector label value %v must be empty", e.Value)
+		}
+		return nil
+	}
+
+	// gt/ge/lt/le 支持数字和版本号, eg. 2.3, v1.2.10
+	if value, ok := e.Value.(string); ok {
+		if _, err := parseVersion(value); err != nil {
+			return fmt.Errorf("selector label value %v is invalid version: %v", value, err)
+		}
+		return nil
+	}
+
 	_, ok := e.Value.(float64)
 	if !ok {
-		return fmt.Errorf("selector label value %v must be int/float", e.Value)
+		return fmt.Errorf("selector label value %v must be int/float or version", e.Value)
 	}
 
 	return nil
@@ -99,7 +120,8 @@ func ValidateBeforeUnmarshal(bytes []byte) error {
 		return err
 	}
 
-	if len(r.LabelsAnd) == 0 && len(r.LabelsOr) == 0 {
+	if len(r.LabelsAnd) == 0 && len(r.LabelsOr) == 0 && len(r.LabelsGroups) == 0 && len(r.Instances) == 0 &&
+		r.Rollout == nil {
 		return errors.New("selector labels is required")
 	}
 
@@ -115,5 +137,13 @@ func ValidateBeforeUnmarshal(bytes []byte) error {
 		}
 	}
 
+	for _, group := range r.LabelsGroups {
+		for _, v := range group {
+			if err := validatEelement(v); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,239 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	semver "github.com/hashicorp/go-version"
+)
+
+const (
+	// AgentUpdateApp is the name of the system app which distributes the agent binaries, the agents of a biz
+	// watch this app to upgrade themselves.
+	AgentUpdateApp = "bscp-agent-update"
+	// AgentUpdateManifestName is the name of the config item in the agent update app which describes the binaries.
+	AgentUpdateManifestName = "manifest.json"
+)
+
+// AgentUpdateManifest describes the agent version to be upgraded to, it is published as a config item of
+// the AgentUpdateApp, and the binaries are published as the other config items of the same release.
+type AgentUpdateManifest struct {
+	// Version is the semantic version of the agent binaries.
+	Version  string         `json:"version"`
+	Binaries []*AgentBinary `json:"binaries"`
+	// Window is the maintenance window the agents exec into the new version, nil means at once.
+	Window *MaintenanceWindow `json:"window,omitempty"`
+}
+
+// AgentBinary is the agent binary of a platform.
+type AgentBinary struct {
+	OS   string `json:"os"`
+	Arch string `json:"arch"`
+	// Path and Name is the config item of the binary in the agent update app.
+	Path string `json:"path"`
+	Name string `json:"name"`
+	// Sha256 is the hex encoded sha256 of the binary.
+	Sha256 string `json:"sha256"`
+	// Signature is the base64 encoded ed25519 signature of the binary's sha256 digest.
+	Signature string `json:"signature"`
+}
+
+// Validate the agent update manifest.
+func (m *AgentUpdateManifest) Validate() error {
+	if m == nil {
+		return errors.New("agent update manifest is nil")
+	}
+
+	if _, err := semver.NewVersion(m.Version); err != nil {
+		return fmt.Errorf("invalid agent version %s, err: %v", m.Version, err)
+	}
+
+	if len(m.Binaries) == 0 {
+		return errors.New("agent binaries are empty")
+	}
+
+	platforms := make(map[string]bool, len(m.Binaries))
+	for _, one := range m.Binaries {
+		if one == nil || one.OS == "" || one.Arch == "" || one.Name == "" {
+			return errors.New("agent binary's os, arch and name are required")
+		}
+		platform := one.OS + "/" + one.Arch
+		if platforms[platform] {
+			return fmt.Errorf("agent binary of %s is duplicated", platform)
+		}
+		platforms[platform] = true
+
+		if digest, err := hex.DecodeString(one.Sha256); err != nil || len(digest) != sha256.Size {
+			return fmt.Errorf("invalid sha256 of agent binary %s", platform)
+		}
+		if one.Signature == "" {
+			return fmt.Errorf("agent binary of %s is not signed", platform)
+		}
+	}
+
+	if m.Window != nil {
+		if err := m.Window.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NeedUpdate returns whether the agent of the current version should be upgraded to the manifest's version,
+// downgrading is not supported.
+func (m *AgentUpdateManifest) NeedUpdate(current string) (bool, error) {
+	target, err := semver.NewVersion(m.Version)
+	if err != nil {
+		return false, fmt.Errorf("invalid agent version %s, err: %v", m.Version, err)
+	}
+
+	cur, err := semver.NewVersion(current)
+	if err != nil {
+		return false, fmt.Errorf("invalid current agent version %s, err: %v", current, err)
+	}
+
+	return target.GreaterThan(cur), nil
+}
+
+// Binary returns the agent binary of the platform, such as runtime.GOOS and runtime.GOARCH.
+func (m *AgentUpdateManifest) Binary(os, arch string) (*AgentBinary, error) {
+	for _, one := range m.Binaries {
+		if one.OS == os && one.Arch == arch {
+			return one, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no agent binary of %s/%s in version %s", os, arch, m.Version)
+}
+
+// Verify the downloaded binary content with the sha256 and the signature, the public key is distributed
+// with the agent itself, so that a tampered release can not make the agents exec into it.
+func (b *AgentBinary) Verify(content []byte, publicKey ed25519.PublicKey) error {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return errors.New("invalid agent update public key")
+	}
+
+	digest := sha256.Sum256(content)
+	if hex.EncodeToString(digest[:]) != b.Sha256 {
+		return fmt.Errorf("sha256 of agent binary %s/%s mismatched", b.OS, b.Arch)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(b.Signature)
+	if err != nil {
+		return fmt.Errorf("decode signature of agent binary %s/%s failed, err: %v", b.OS, b.Arch, err)
+	}
+
+	if !ed25519.Verify(publicKey, digest[:], sig) {
+		return fmt.Errorf("signature of agent binary %s/%s is invalid", b.OS, b.Arch)
+	}
+
+	return nil
+}
+
+// MaintenanceWindow is the daily time window the agents are allowed to exec into the new version.
+type MaintenanceWindow struct {
+	// Start and End is the time of day in "15:04" format, the window crosses midnight if End is before Start.
+	Start string `json:"start"`
+	End   string `json:"end"`
+	// Weekdays the window is opened on, 0 is Sunday, empty means every day.
+	Weekdays []time.Weekday `json:"weekdays,omitempty"`
+	// Timezone is the IANA time zone name of the window, empty means the agent's local time zone.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Validate the maintenance window.
+func (w *MaintenanceWindow) Validate() error {
+	if _, err := time.Parse("15:04", w.Start); err != nil {
+		return fmt.Errorf("invalid maintenance window start %s", w.Start)
+	}
+
+	if _, err := time.Parse("15:04", w.End); err != nil {
+		return fmt.Errorf("invalid maintenance window end %s", w.End)
+	}
+
+	for _, day := range w.Weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("invalid maintenance window weekday %d", day)
+		}
+	}
+
+	if w.Timezone != "" {
+		if _, err := time.LoadLocation(w.Timezone); err != nil {
+			return fmt.Errorf("invalid maintenance window timezone %s", w.Timezone)
+		}
+	}
+
+	return nil
+}
+
+// Contains returns whether the time is in the maintenance window, a nil window always contains the time.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	if w == nil {
+		return true
+	}
+
+	if w.Timezone != "" {
+		if loc, err := time.LoadLocation(w.Timezone); err == nil {
+			t = t.In(loc)
+		}
+	}
+
+	start, err := time.Parse("15:04", w.Start)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", w.End)
+	if err != nil {
+		return false
+	}
+
+	minute := t.Hour()*60 + t.Minute()
+	from, to := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+
+	// 跨零点的窗口, 零点之后的部分属于前一天的窗口
+	day := t.Weekday()
+	var in bool
+	switch {
+	case from <= to:
+		in = minute >= from && minute < to
+	case minute >= from:
+		in = true
+	case minute < to:
+		in = true
+		day = (day + 6) % 7
+	}
+
+	return in && w.openOn(day)
+}
+
+func (w *MaintenanceWindow) openOn(day time.Weekday) bool {
+	if len(w.Weekdays) == 0 {
+		return true
+	}
+
+	for _, one := range w.Weekdays {
+		if one == day {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+)
+
+func TestAgentUpdateManifest(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := []byte("agent binary v1.2.0")
+	digest := sha256.Sum256(content)
+	m := &AgentUpdateManifest{
+		Version: "v1.2.0",
+		Binaries: []*AgentBinary{{
+			OS:        "linux",
+			Arch:      "amd64",
+			Path:      "/linux/amd64",
+			Name:      "bscp-agent",
+			Sha256:    hex.EncodeToString(digest[:]),
+			Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(priv, digest[:])),
+		}},
+	}
+	if err := m.Validate(); err != nil {
+		t.Fatalf("validate manifest failed, err: %v", err)
+	}
+
+	if need, err := m.NeedUpdate("v1.1.9"); err != nil || !need {
+		t.Errorf("v1.1.9 should be upgraded, need: %v, err: %v", need, err)
+	}
+	if need, _ := m.NeedUpdate("v1.2.0"); need {
+		t.Errorf("the same version should not be upgraded")
+	}
+
+	bin, err := m.Binary("linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Binary("darwin", "arm64"); err == nil {
+		t.Errorf("binary of darwin/arm64 should not be found")
+	}
+
+	if err := bin.Verify(content, pub); err != nil {
+		t.Errorf("verify binary failed, err: %v", err)
+	}
+	if err := bin.Verify([]byte("tampered"), pub); err == nil {
+		t.Errorf("tampered binary should not be verified")
+	}
+	otherPub, _, _ := ed25519.GenerateKey(nil)
+	if err := bin.Verify(content, otherPub); err == nil {
+		t.Errorf("binary should not be verified with another public key")
+	}
+
+	m.Binaries = append(m.Binaries, m.Binaries[0])
+	if err := m.Validate(); err == nil {
+		t.Errorf("duplicated platform should be invalid")
+	}
+}
+
+func TestMaintenanceWindow(t *testing.T) {
+	// 周五 23:00 ~ 次日 02:00
+	w := &MaintenanceWindow{Start: "23:00", End: "02:00", Weekdays: []time.Weekday{time.Friday}, Timezone: "UTC"}
+	if err := w.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		at   string
+		want bool
+	}{
+		{at: "2024-05-10T23:30:00Z", want: true},  // Friday
+		{at: "2024-05-11T01:59:00Z", want: true},  // Saturday, in Friday's window
+		{at: "2024-05-11T02:00:00Z", want: false}, // window ended
+		{at: "2024-05-11T23:30:00Z", want: false}, // Saturday
+		{at: "2024-05-10T22:00:00Z", want: false},
+	}
+	for _, c := range cases {
+		at, _ := time.Parse(time.RFC3339, c.at)
+		if got := w.Contains(at); got != c.want {
+			t.Errorf("contains %s, want %v, got %v", c.at, c.want, got)
+		}
+	}
+
+	var always *MaintenanceWindow
+	if !always.Contains(time.Now()) {
+		t.Errorf("nil window should always contain the time")
+	}
+
+	if err := (&MaintenanceWindow{Start: "25:00", End: "02:00"}).Validate(); err == nil {
+		t.Errorf("invalid start should not pass validation")
+	}
+}
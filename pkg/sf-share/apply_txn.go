@@ -0,0 +1,228 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// StagedConfigs is the configs staged by an apply transaction, which are passed to the verify callback.
+type StagedConfigs struct {
+	// Dir is the staging directory, the files are staged at the same relative paths as in the target dir.
+	Dir string
+	// Files are the relative paths of the staged files.
+	Files []string
+	// Kvs are the staged kvs.
+	Kvs map[string]string
+}
+
+// VerifyFunc verifies the staged configs before they take effect, returns an error to reject them.
+type VerifyFunc func(staged *StagedConfigs) error
+
+// ApplyTransaction applies the configs of a release as a transaction: the files and kvs are staged first,
+// then the verify callback of the application checks the staged configs, they replace the configs in
+// effect only when they are verified, otherwise they are rolled back and the configs in effect are kept.
+type ApplyTransaction struct {
+	targetDir string
+	staged    *StagedConfigs
+	done      bool
+}
+
+// NewApplyTransaction create an apply transaction for the target directory, the staging directory is
+// created beside the target directory so that the staged files can be renamed into it.
+func NewApplyTransaction(targetDir string) (*ApplyTransaction, error) {
+	targetDir = filepath.Clean(targetDir)
+	if err := os.MkdirAll(targetDir, os.ModePerm); err != nil {
+		return nil, WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: NewFolderFailed,
+			Err: fmt.Errorf("create target dir %s failed, err: %v", targetDir, err)})
+	}
+
+	dir, err := os.MkdirTemp(filepath.Dir(targetDir), "."+filepath.Base(targetDir)+".staging-")
+	if err != nil {
+		return nil, WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: NewFolderFailed,
+			Err: fmt.Errorf("create staging dir failed, err: %v", err)})
+	}
+
+	return &ApplyTransaction{
+		targetDir: targetDir,
+		staged:    &StagedConfigs{Dir: dir, Kvs: make(map[string]string)},
+	}, nil
+}
+
+// StageFile writes the file content to the staging directory at the relative path.
+func (t *ApplyTransaction) StageFile(relPath string, content []byte, perm os.FileMode) error {
+	if t.done {
+		return errors.New("apply transaction is already finished")
+	}
+
+	rel, err := stagedRelPath(relPath)
+	if err != nil {
+		return WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: FilePathNotFound, Err: err})
+	}
+
+	file := filepath.Join(t.staged.Dir, rel)
+	if err = os.MkdirAll(filepath.Dir(file), os.ModePerm); err != nil {
+		return WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: NewFolderFailed,
+			Err: fmt.Errorf("create staging dir of %s failed, err: %v", rel, err)})
+	}
+	if err = os.WriteFile(file, content, perm); err != nil {
+		return WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: WriteFileFailed,
+			Err: fmt.Errorf("stage file %s failed, err: %v", rel, err)})
+	}
+
+	t.staged.Files = append(t.staged.Files, rel)
+	return nil
+}
+
+// StageKv stages the kv.
+func (t *ApplyTransaction) StageKv(key, value string) {
+	t.staged.Kvs[key] = value
+}
+
+// Staged returns the staged configs.
+func (t *ApplyTransaction) Staged() *StagedConfigs {
+	return t.staged
+}
+
+// Commit verifies the staged configs with the verify callback, a nil callback means no verification, and
+// moves the staged files into the target directory when they are verified. the staged files are rolled back
+// and an error with VerifyFailed reason is returned if they are rejected.
+func (t *ApplyTransaction) Commit(verify VerifyFunc) error {
+	if t.done {
+		return errors.New("apply transaction is already finished")
+	}
+
+	if verify != nil {
+		if err := callVerify(verify, t.staged); err != nil {
+			if rbErr := t.Rollback(); rbErr != nil {
+				return WrapPrimaryError(VerifyFailed, SecondaryError{SpecificFailedReason: RollbackStagedFailed,
+					Err: fmt.Errorf("verify staged configs failed, err: %v, then %v", err, rbErr)})
+			}
+			return WrapPrimaryError(VerifyFailed, SecondaryError{SpecificFailedReason: VerifyCallbackFailed,
+				Err: err})
+		}
+	}
+
+	if err := t.moveStaged(); err != nil {
+		return err
+	}
+
+	// 已生效, 清理暂存目录失败不影响结果
+	_ = t.cleanup()
+	return nil
+}
+
+// Rollback removes the staged files, the configs in effect are not changed.
+func (t *ApplyTransaction) Rollback() error {
+	return t.cleanup()
+}
+
+// cleanup removes the staging and backup directories and finishes the transaction.
+func (t *ApplyTransaction) cleanup() error {
+	t.done = true
+	for _, dir := range []string{t.staged.Dir, t.backupDir()} {
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("remove staging dir %s failed, err: %v", dir, err)
+		}
+	}
+	return nil
+}
+
+// backupDir is where the replaced files are backed up during the commit.
+func (t *ApplyTransaction) backupDir() string {
+	return t.staged.Dir + ".backup"
+}
+
+// moveStaged moves the staged files into the target directory, the replaced files are backed up in the
+// staging directory and restored if any of the files can not be moved.
+func (t *ApplyTransaction) moveStaged() error {
+	backupDir := t.backupDir()
+	type moved struct {
+		rel      string
+		backedUp bool
+	}
+	done := make([]moved, 0, len(t.staged.Files))
+
+	restore := func() error {
+		for i := len(done) - 1; i >= 0; i-- {
+			target := filepath.Join(t.targetDir, done[i].rel)
+			if err := os.Remove(target); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			if done[i].backedUp {
+				if err := os.Rename(filepath.Join(backupDir, done[i].rel), target); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	for _, rel := range t.staged.Files {
+		target := filepath.Join(t.targetDir, rel)
+		m := moved{rel: rel}
+
+		err := os.MkdirAll(filepath.Dir(target), os.ModePerm)
+		if err == nil {
+			if _, statErr := os.Lstat(target); statErr == nil {
+				backup := filepath.Join(backupDir, rel)
+				if err = os.MkdirAll(filepath.Dir(backup), os.ModePerm); err == nil {
+					err = os.Rename(target, backup)
+					m.backedUp = err == nil
+				}
+			}
+		}
+		if err == nil {
+			err = os.Rename(filepath.Join(t.staged.Dir, rel), target)
+		}
+		if err != nil {
+			if m.backedUp {
+				done = append(done, m)
+			}
+			if rbErr := restore(); rbErr != nil {
+				return WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: RollbackStagedFailed,
+					Err: fmt.Errorf("commit file %s failed, err: %v, then restore failed, %v", rel, err, rbErr)})
+			}
+			_ = t.cleanup()
+			return WrapPrimaryError(DownloadFailed, SecondaryError{SpecificFailedReason: WriteFileFailed,
+				Err: fmt.Errorf("commit file %s failed, err: %v", rel, err)})
+		}
+		done = append(done, m)
+	}
+
+	return nil
+}
+
+// callVerify calls the verify callback, a panic of the callback rejects the staged configs.
+func callVerify(verify VerifyFunc, staged *StagedConfigs) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("verify callback panic: %v", r)
+		}
+	}()
+
+	return verify(staged)
+}
+
+// stagedRelPath cleans the relative path of a staged file, which should not escape the target directory.
+func stagedRelPath(relPath string) (string, error) {
+	rel := filepath.Clean(filepath.FromSlash(strings.TrimPrefix(relPath, "/")))
+	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid staged file path %s", relPath)
+	}
+	return rel, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestApplyTransaction(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "configs")
+	if err := os.MkdirAll(target, os.ModePerm); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(target, "app.yaml"), []byte("v1"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stage := func() *ApplyTransaction {
+		txn, err := NewApplyTransaction(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = txn.StageFile("/app.yaml", []byte("v2"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err = txn.StageFile("conf.d/new.yaml", []byte("new"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		txn.StageKv("key", "value")
+		return txn
+	}
+
+	// 校验不通过时回滚暂存的文件, 保留生效中的配置
+	txn := stage()
+	err := txn.Commit(func(staged *StagedConfigs) error {
+		if len(staged.Files) != 2 || staged.Kvs["key"] != "value" {
+			t.Errorf("unexpected staged configs: %+v", staged)
+		}
+		content, _ := os.ReadFile(filepath.Join(staged.Dir, "app.yaml"))
+		if string(content) != "v2" {
+			t.Errorf("staged file content should be v2, got %s", content)
+		}
+		return errors.New("bad config")
+	})
+	var pe PrimaryError
+	if !errors.As(err, &pe) || pe.FailedReason != VerifyFailed || pe.SpecificFailedReason != VerifyCallbackFailed {
+		t.Fatalf("rejected configs should fail with verify reasons, err: %v", err)
+	}
+	assertFile(t, filepath.Join(target, "app.yaml"), "v1")
+	if _, err = os.Stat(filepath.Join(target, "conf.d", "new.yaml")); !os.IsNotExist(err) {
+		t.Errorf("rejected file should not be committed")
+	}
+	if _, err = os.Stat(txn.Staged().Dir); !os.IsNotExist(err) {
+		t.Errorf("staging dir should be removed after rollback")
+	}
+
+	// 校验回调 panic 时同样拒绝
+	err = stage().Commit(func(*StagedConfigs) error { panic("boom") })
+	if !errors.As(err, &pe) || pe.FailedReason != VerifyFailed {
+		t.Fatalf("panicked verify callback should reject the configs, err: %v", err)
+	}
+	assertFile(t, filepath.Join(target, "app.yaml"), "v1")
+
+	// 校验通过后生效
+	txn = stage()
+	if err = txn.Commit(func(*StagedConfigs) error { return nil }); err != nil {
+		t.Fatalf("commit verified configs failed, err: %v", err)
+	}
+	assertFile(t, filepath.Join(target, "app.yaml"), "v2")
+	assertFile(t, filepath.Join(target, "conf.d", "new.yaml"), "new")
+	if _, err = os.Stat(txn.Staged().Dir); !os.IsNotExist(err) {
+		t.Errorf("staging dir should be removed after commit")
+	}
+	if err = txn.Commit(nil); err == nil {
+		t.Errorf("finished transaction should not be committed again")
+	}
+
+	txn = stage()
+	defer txn.Rollback()
+	if err = txn.StageFile("../escape.yaml", []byte("x"), 0644); err == nil {
+		t.Errorf("staged file should not escape the target dir")
+	}
+}
+
+func assertFile(t *testing.T, file, content string) {
+	t.Helper()
+	got, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != content {
+		t.Errorf("content of %s should be %s, got %s", file, content, got)
+	}
+}
@@ -0,0 +1,336 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	// cacheKeySize is the size of the cache key, which uses AES-256.
+	cacheKeySize = 32
+	// cacheChunkSize is the size of the plaintext chunk, the content is sealed chunk by chunk so that
+	// the large config files in the temp download directory can be encrypted in stream.
+	cacheChunkSize = 64 * 1024
+	// cacheNoncePrefixSize is the size of the random nonce prefix, the gcm nonce is composed of the prefix,
+	// the 4 bytes chunk counter and the 1 byte last chunk flag.
+	cacheNoncePrefixSize = 7
+)
+
+// cacheMagic is the header of the encrypted cache, which distinguishes it from the plaintext cache.
+var cacheMagic = []byte("BSCPENC1")
+
+var (
+	// ErrCacheNotEncrypted means the cache is not encrypted, such as the cache written by the old versions,
+	// the client can read it as plaintext and rewrite it encrypted.
+	ErrCacheNotEncrypted = errors.New("cache is not encrypted")
+	// ErrCacheDecrypt means the cache can not be decrypted, the key is changed or the cache is corrupted,
+	// the client should drop the cache and pull it again.
+	ErrCacheDecrypt = errors.New("decrypt cache failed, the cache key is changed or the cache is corrupted")
+)
+
+// CacheKeyProvider provides the key of the sdk and the sidecar to encrypt the local snapshot and the
+// temp download directory, so that the secrets pulled from bscp are not left in plaintext on the shared hosts.
+type CacheKeyProvider interface {
+	CacheKey() ([]byte, error)
+}
+
+// CredentialCacheKey derives the cache key from the client's credential, so that the cache can only be
+// decrypted by the clients holding the same credential of the biz.
+type CredentialCacheKey struct {
+	BizID uint32
+	Token string
+}
+
+// CacheKey returns the key derived from the credential.
+func (c CredentialCacheKey) CacheKey() ([]byte, error) {
+	if c.Token == "" {
+		return nil, errors.New("credential token is empty, can not derive the cache key")
+	}
+
+	mac := hmac.New(sha256.New, []byte("bscp-cache-key-v1"))
+	mac.Write([]byte(strconv.FormatUint(uint64(c.BizID), 10) + ":" + c.Token))
+	return mac.Sum(nil), nil
+}
+
+// StaticCacheKey is the key managed outside bscp, such as the key stored in the OS keyring, the client
+// reads it and passes it in.
+type StaticCacheKey []byte
+
+// CacheKey returns the static key.
+func (k StaticCacheKey) CacheKey() ([]byte, error) {
+	if len(k) != cacheKeySize {
+		return nil, fmt.Errorf("cache key should be %d bytes, but got %d", cacheKeySize, len(k))
+	}
+
+	return k, nil
+}
+
+// CacheCipher encrypts and decrypts the local cache of the clients with AES-256-GCM.
+type CacheCipher struct {
+	aead cipher.AEAD
+}
+
+// NewCacheCipher create a cache cipher with the key of the provider.
+func NewCacheCipher(p CacheKeyProvider) (*CacheCipher, error) {
+	if p == nil {
+		return nil, errors.New("cache key provider is nil")
+	}
+
+	key, err := p.CacheKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != cacheKeySize {
+		return nil, fmt.Errorf("cache key should be %d bytes, but got %d", cacheKeySize, len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CacheCipher{aead: aead}, nil
+}
+
+// NewWriter returns a writer which encrypts the content into w, the writer must be closed to
+// write the last chunk, otherwise the content can not be decrypted.
+func (c *CacheCipher) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	prefix := make([]byte, cacheNoncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(append(append([]byte{}, cacheMagic...), prefix...)); err != nil {
+		return nil, err
+	}
+
+	return &cacheWriter{aead: c.aead, w: w, prefix: prefix, buf: make([]byte, 0, cacheChunkSize)}, nil
+}
+
+// NewReader returns a reader which decrypts the content from r, ErrCacheNotEncrypted is returned
+// if r is not encrypted, and ErrCacheDecrypt is returned by Read if r can not be decrypted.
+func (c *CacheCipher) NewReader(r io.Reader) (io.Reader, error) {
+	header := make([]byte, len(cacheMagic)+cacheNoncePrefixSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, ErrCacheNotEncrypted
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(cacheMagic)], cacheMagic) {
+		return nil, ErrCacheNotEncrypted
+	}
+
+	return &cacheReader{
+		aead:   c.aead,
+		r:      bufio.NewReader(r),
+		prefix: header[len(cacheMagic):],
+		chunk:  make([]byte, cacheChunkSize+c.aead.Overhead()),
+	}, nil
+}
+
+// WriteFile encrypts the data and writes it to the file atomically, the file is only readable
+// by the current user.
+func (c *CacheCipher) WriteFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	w, err := c.NewWriter(tmp)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = w.Close(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// ReadFile reads and decrypts the file written by WriteFile.
+func (c *CacheCipher) ReadFile(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := c.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+// cacheNonce returns the nonce of the chunk, the last flag prevents the content from being truncated.
+func cacheNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, cacheNoncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+type cacheWriter struct {
+	aead    cipher.AEAD
+	w       io.Writer
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+// Write buffers the content and seals the full chunks.
+func (cw *cacheWriter) Write(p []byte) (int, error) {
+	if cw.closed {
+		return 0, errors.New("write to closed cache writer")
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		// 缓冲区满且还有后续内容时才写入, 保证最后一个分块在 Close 时写入
+		if len(cw.buf) == cacheChunkSize {
+			if err := cw.seal(false); err != nil {
+				return 0, err
+			}
+		}
+
+		size := cacheChunkSize - len(cw.buf)
+		if size > len(p) {
+			size = len(p)
+		}
+		cw.buf = append(cw.buf, p[:size]...)
+		p = p[size:]
+	}
+
+	return n, nil
+}
+
+// Close seals the last chunk, it does not close the underlying writer.
+func (cw *cacheWriter) Close() error {
+	if cw.closed {
+		return nil
+	}
+	cw.closed = true
+
+	return cw.seal(true)
+}
+
+func (cw *cacheWriter) seal(last bool) error {
+	if cw.counter == ^uint32(0) {
+		return errors.New("cache content is too large")
+	}
+
+	sealed := cw.aead.Seal(nil, cacheNonce(cw.prefix, cw.counter, last), cw.buf, nil)
+	if _, err := cw.w.Write(sealed); err != nil {
+		return err
+	}
+	cw.counter++
+	cw.buf = cw.buf[:0]
+
+	return nil
+}
+
+type cacheReader struct {
+	aead    cipher.AEAD
+	r       *bufio.Reader
+	prefix  []byte
+	counter uint32
+	chunk   []byte
+	plain   []byte
+	done    bool
+}
+
+// Read decrypts the content chunk by chunk.
+func (cr *cacheReader) Read(p []byte) (int, error) {
+	for len(cr.plain) == 0 {
+		if cr.done {
+			return 0, io.EOF
+		}
+		if err := cr.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, cr.plain)
+	cr.plain = cr.plain[n:]
+	return n, nil
+}
+
+func (cr *cacheReader) open() error {
+	n, err := io.ReadFull(cr.r, cr.chunk)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		if errors.Is(err, io.EOF) {
+			// 缺少最后一个分块, 内容被截断
+			return ErrCacheDecrypt
+		}
+		return err
+	}
+
+	// 读满一个分块且后面还有内容时不是最后一个分块
+	last := true
+	if n == len(cr.chunk) {
+		if _, err = cr.r.Peek(1); err == nil {
+			last = false
+		} else if !errors.Is(err, io.EOF) {
+			return err
+		}
+	}
+
+	plain, err := cr.aead.Open(cr.chunk[:0], cacheNonce(cr.prefix, cr.counter, last), cr.chunk[:n], nil)
+	if err != nil {
+		return ErrCacheDecrypt
+	}
+	cr.counter++
+	cr.plain = plain
+	cr.done = last
+
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCacheCipher(t *testing.T) {
+	c, err := NewCacheCipher(CredentialCacheKey{BizID: 2, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, size := range []int{0, 1, cacheChunkSize, cacheChunkSize + 1, 3*cacheChunkSize - 7} {
+		plain := bytes.Repeat([]byte("secret"), size/6+1)[:size]
+
+		var buf bytes.Buffer
+		w, err := c.NewWriter(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write(plain); err != nil {
+			t.Fatal(err)
+		}
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if size > 0 && bytes.Contains(buf.Bytes(), []byte("secret")) {
+			t.Errorf("size %d: the encrypted cache should not contain the plaintext", size)
+		}
+		sealed := buf.Bytes()
+
+		r, err := c.NewReader(bytes.NewReader(sealed))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("size %d: read failed, err: %v", size, err)
+		}
+		if !bytes.Equal(got, plain) {
+			t.Errorf("size %d: decrypted content is not the same as the plaintext", size)
+		}
+
+		// 截断最后一个分块后不能解密
+		r, err = c.NewReader(bytes.NewReader(sealed[:len(sealed)-c.aead.Overhead()-1]))
+		if err == nil {
+			_, err = io.ReadAll(r)
+		}
+		if !errors.Is(err, ErrCacheDecrypt) && !errors.Is(err, ErrCacheNotEncrypted) {
+			t.Errorf("size %d: truncated cache should not be decrypted, err: %v", size, err)
+		}
+	}
+}
+
+func TestCacheCipherFile(t *testing.T) {
+	c, err := NewCacheCipher(CredentialCacheKey{BizID: 2, Token: "token"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+	if err = c.WriteFile(path, []byte(`{"password":"123"}`)); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("cache file mode should be 0600, but got %v", info.Mode().Perm())
+	}
+
+	got, err := c.ReadFile(path)
+	if err != nil || string(got) != `{"password":"123"}` {
+		t.Errorf("read cache file failed, got: %s, err: %v", got, err)
+	}
+
+	other, err := NewCacheCipher(CredentialCacheKey{BizID: 2, Token: "other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = other.ReadFile(path); !errors.Is(err, ErrCacheDecrypt) {
+		t.Errorf("cache should not be decrypted with other credential, err: %v", err)
+	}
+
+	if err = os.WriteFile(path, []byte(`{"password":"123"}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.ReadFile(path); !errors.Is(err, ErrCacheNotEncrypted) {
+		t.Errorf("plaintext cache should return ErrCacheNotEncrypted, err: %v", err)
+	}
+}
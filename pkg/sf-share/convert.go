@@ -14,11 +14,16 @@ package sfs
 
 import (
 	"errors"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/logs"
 	pbclient "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client"
 	pbce "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/client-event"
+	pbexp "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/experiment"
+	pbfte "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/file-tamper-event"
+	pbher "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/hook-exec-result"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
 )
 
@@ -180,3 +185,111 @@ func toString(label interface{}) string {
 
 	return string(marshal)
 }
+
+// PbHookExecResults hook exec result payload convert to hook exec result proto
+func (h *HookExecResultPayload) PbHookExecResults() ([]*pbher.HookExecResult, error) {
+	if h == nil {
+		return nil, errors.New("HookExecResultPayload is nil, can not be convert to proto")
+	}
+	if err := h.Validate(); err != nil {
+		return nil, err
+	}
+
+	results := make([]*pbher.HookExecResult, 0, len(h.Results))
+	for _, r := range h.Results {
+		if err := r.Validate(); err != nil {
+			logs.Warnf("drop invalid hook exec result of biz %d app %d uid %s, err: %v", h.BasicData.BizID,
+				h.Application.AppID, h.Application.Uid, err)
+			continue
+		}
+		results = append(results, &pbher.HookExecResult{
+			Spec: &pbher.HookExecResultSpec{
+				HookType:   r.HookType,
+				HookName:   r.HookName,
+				ExitCode:   r.ExitCode,
+				DurationMs: r.DurationMs,
+				Stdout:     r.Stdout,
+				Stderr:     r.Stderr,
+				Ip:         h.BasicData.IP,
+				ClientType: string(h.BasicData.ClientType),
+				ExecutedAt: timestamppb.New(r.ExecutedAt),
+			},
+			Attachment: &pbher.HookExecResultAttachment{
+				BizId:     h.BasicData.BizID,
+				AppId:     h.Application.AppID,
+				ReleaseId: r.ReleaseID,
+				Uid:       h.Application.Uid,
+			},
+		})
+	}
+
+	return results, nil
+}
+
+// PbExperimentExposures experiment exposure payload convert to experiment exposure proto
+func (e *ExperimentExposurePayload) PbExperimentExposures() ([]*pbexp.ExperimentExposure, error) {
+	if e == nil {
+		return nil, errors.New("ExperimentExposurePayload is nil, can not be convert to proto")
+	}
+	if err := e.Validate(); err != nil {
+		return nil, err
+	}
+
+	exposures := make([]*pbexp.ExperimentExposure, 0, len(e.Exposures))
+	for _, one := range e.Exposures {
+		if one == nil {
+			continue
+		}
+		exposures = append(exposures, &pbexp.ExperimentExposure{
+			BizId:        e.BasicData.BizID,
+			AppId:        e.Application.AppID,
+			ExperimentId: one.ExperimentID,
+			Uid:          e.Application.Uid,
+			Variant:      one.Variant,
+			ReleaseId:    one.ReleaseID,
+			ExposedAt:    one.ExposedAt.UTC().Format(time.RFC3339),
+		})
+	}
+
+	return exposures, nil
+}
+
+// PbFileTamperEvents file tamper payload convert to file tamper event proto
+func (f *FileTamperPayload) PbFileTamperEvents() ([]*pbfte.FileTamperEvent, error) {
+	if f == nil {
+		return nil, errors.New("FileTamperPayload is nil, can not be convert to proto")
+	}
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	events := make([]*pbfte.FileTamperEvent, 0, len(f.Events))
+	for _, e := range f.Events {
+		if e == nil {
+			continue
+		}
+		events = append(events, &pbfte.FileTamperEvent{
+			Spec: &pbfte.FileTamperEventSpec{
+				ConfigItemId:      e.ConfigItemID,
+				Path:              e.Path,
+				Name:              e.Name,
+				Kind:              string(e.Kind),
+				ExpectedSignature: e.ExpectedSignature,
+				ActualSignature:   e.ActualSignature,
+				Repaired:          e.Repaired,
+				RepairError:       e.RepairError,
+				Ip:                f.BasicData.IP,
+				ClientType:        string(f.BasicData.ClientType),
+				DetectedAt:        timestamppb.New(e.DetectedAt),
+			},
+			Attachment: &pbfte.FileTamperEventAttachment{
+				BizId:     f.BasicData.BizID,
+				AppId:     f.Application.AppID,
+				ReleaseId: e.ReleaseID,
+				Uid:       f.Application.Uid,
+			},
+		})
+	}
+
+	return events, nil
+}
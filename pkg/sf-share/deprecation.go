@@ -0,0 +1,190 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+)
+
+// DeprecationHeaderKey is the response header key of the protocol deprecation warnings, each value
+// of the key is a json encoded Deprecation.
+const DeprecationHeaderKey = "x-bscp-deprecation"
+
+// Deprecation defines a deprecation warning of the feed protocol attached to the response by feed server,
+// so that the clients get notice before the method or the field is removed.
+type Deprecation struct {
+	// Method is the full grpc method name, such as /pbfs.Upstream/Watch.
+	Method string `json:"method"`
+	// Field is the field to be removed, empty means the whole method is deprecated.
+	Field string `json:"field,omitempty"`
+	// Sunset is the date the method or the field is removed, in 2006-01-02 format.
+	Sunset string `json:"sunset,omitempty"`
+	// Message is the notice to the users, such as the replacement.
+	Message string `json:"message"`
+}
+
+// Validate the deprecation is valid or not.
+func (d *Deprecation) Validate() error {
+	if d.Method == "" {
+		return errors.New("deprecation method is not set")
+	}
+
+	if d.Message == "" {
+		return errors.New("deprecation message is not set")
+	}
+
+	return nil
+}
+
+// String returns the human readable warning, which is used by the clients to log it.
+func (d *Deprecation) String() string {
+	target := d.Method
+	if d.Field != "" {
+		target = fmt.Sprintf("field %s of %s", d.Field, d.Method)
+	}
+
+	if d.Sunset != "" {
+		return fmt.Sprintf("%s is deprecated and will be removed at %s, %s", target, d.Sunset, d.Message)
+	}
+	return fmt.Sprintf("%s is deprecated, %s", target, d.Message)
+}
+
+// Encode the deprecation to the header value.
+func (d *Deprecation) Encode() (string, error) {
+	if err := d.Validate(); err != nil {
+		return "", err
+	}
+
+	js, err := jsoni.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(js), nil
+}
+
+// ParseDeprecations parse the deprecation warnings from the response header, the invalid values are
+// ignored, because the warnings should never break the requests.
+func ParseDeprecations(md metadata.MD) []*Deprecation {
+	values := md.Get(DeprecationHeaderKey)
+	if len(values) == 0 {
+		return nil
+	}
+
+	deps := make([]*Deprecation, 0, len(values))
+	for _, v := range values {
+		d := new(Deprecation)
+		if err := jsoni.UnmarshalFromString(v, d); err != nil {
+			continue
+		}
+		if err := d.Validate(); err != nil {
+			continue
+		}
+		deps = append(deps, d)
+	}
+	return deps
+}
+
+// DeprecationHandler handles the deprecation warnings received from feed server, such as logging it
+// or reporting it to the user's monitor system.
+type DeprecationHandler func(d *Deprecation)
+
+// DeprecationWatcher collects the deprecation warnings from the responses of feed server with the grpc
+// client interceptors, and calls the handler once for each distinct warning, so the long-running clients
+// do not flood the logs.
+type DeprecationWatcher struct {
+	handler DeprecationHandler
+
+	lock sync.Mutex
+	seen map[Deprecation]struct{}
+}
+
+// NewDeprecationWatcher create a deprecation watcher with the handler.
+func NewDeprecationWatcher(handler DeprecationHandler) *DeprecationWatcher {
+	return &DeprecationWatcher{
+		handler: handler,
+		seen:    make(map[Deprecation]struct{}),
+	}
+}
+
+// Handle the response header, the handler is called for the warnings which are not seen before.
+func (w *DeprecationWatcher) Handle(md metadata.MD) {
+	if w == nil || w.handler == nil {
+		return
+	}
+
+	for _, d := range ParseDeprecations(md) {
+		w.lock.Lock()
+		_, seen := w.seen[*d]
+		if !seen {
+			w.seen[*d] = struct{}{}
+		}
+		w.lock.Unlock()
+
+		if !seen {
+			w.handler(d)
+		}
+	}
+}
+
+// UnaryClientInterceptor returns the grpc unary client interceptor which handles the deprecation warnings.
+func (w *DeprecationWatcher) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var md metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&md))...)
+		w.Handle(md)
+		return err
+	}
+}
+
+// StreamClientInterceptor returns the grpc stream client interceptor which handles the deprecation warnings.
+func (w *DeprecationWatcher) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &deprecationClientStream{ClientStream: cs, watcher: w}, nil
+	}
+}
+
+// deprecationClientStream handles the header of the stream after the first message is received,
+// when the header is surely arrived.
+type deprecationClientStream struct {
+	grpc.ClientStream
+	watcher *DeprecationWatcher
+	once    sync.Once
+}
+
+// RecvMsg receives the message and handles the header once.
+func (s *deprecationClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || errors.Is(err, io.EOF) {
+		s.once.Do(func() {
+			if md, e := s.ClientStream.Header(); e == nil {
+				s.watcher.Handle(md)
+			}
+		})
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+func TestDeprecationWatcher(t *testing.T) {
+	d := &Deprecation{
+		Method:  "/pbfs.Upstream/PullAppFileMeta",
+		Sunset:  "2027-01-01",
+		Message: "use /pbfs.Upstream/GetKvs instead",
+	}
+	value, err := d.Encode()
+	if err != nil {
+		t.Fatalf("encode deprecation failed, err: %v", err)
+	}
+
+	if _, err := (&Deprecation{Method: d.Method}).Encode(); err == nil {
+		t.Errorf("expect encode deprecation without message failed")
+	}
+
+	md := metadata.Pairs(DeprecationHeaderKey, value, DeprecationHeaderKey, "invalid")
+	deps := ParseDeprecations(md)
+	if len(deps) != 1 || *deps[0] != *d {
+		t.Fatalf("expect parse 1 deprecation %v, got %v", d, deps)
+	}
+
+	handled := 0
+	w := NewDeprecationWatcher(func(got *Deprecation) {
+		handled++
+		if *got != *d {
+			t.Errorf("expect deprecation %v, got %v", d, got)
+		}
+	})
+	w.Handle(md)
+	w.Handle(md)
+	w.Handle(metadata.MD{})
+	if handled != 1 {
+		t.Errorf("expect the deprecation is handled once, got %d", handled)
+	}
+
+	// nil watcher is a no-op.
+	var nw *DeprecationWatcher
+	nw.Handle(md)
+}
@@ -40,6 +40,9 @@ const (
 	UpdateMetadataFailed FailedReason = 9
 	// UnknownFailed represents failure in unknown
 	UnknownFailed FailedReason = 10
+	// VerifyFailed represents the staged configs are rejected by the verify callback of the application,
+	// the staged files and kvs are rolled back and the configs in effect are kept.
+	VerifyFailed FailedReason = 11
 )
 
 // Validate the failed reason is valid or not
@@ -47,7 +50,7 @@ func (fr FailedReason) Validate() error {
 	switch fr {
 	case PreHookFailed, PostHookFailed, DownloadFailed, SkipFailed, TokenFailed,
 		VersionIsTooLowFailed, AppMetaFailed, DeleteOldFilesFailed,
-		UpdateMetadataFailed:
+		UpdateMetadataFailed, VerifyFailed:
 		return nil
 	default:
 		return fmt.Errorf("unknown %d sidecar failed reason", fr)
@@ -77,6 +80,8 @@ func (fr FailedReason) String() string {
 		return "UpdateMetadataFailed"
 	case UnknownFailed:
 		return "UnknownFailed"
+	case VerifyFailed:
+		return "VerifyFailed"
 	default:
 		return ""
 	}
@@ -137,6 +142,13 @@ const (
 
 	// UnknownSpecificFailed represents failure in unknown
 	UnknownSpecificFailed
+
+	// Note: the values are reported by the clients, append the new reasons here to keep the existing ones.
+
+	// VerifyCallbackFailed represents the verify callback rejects the staged configs or returns an error
+	VerifyCallbackFailed
+	// RollbackStagedFailed represents failure in rolling back the staged files after the verification failed
+	RollbackStagedFailed
 )
 
 // Validate the specific failed reason is valid or not
@@ -149,7 +161,7 @@ func (s SpecificFailedReason) Validate() error {
 		DownloadChunkFailed, RetryDownloadFailed,
 		DataEmpty, SerializationFailed,
 		FormattingFailed, TraverseFolderFailed, StatFileFailed, SDKVersionIsTooLowFailed,
-		DeleteFolderFailed:
+		DeleteFolderFailed, VerifyCallbackFailed, RollbackStagedFailed:
 		return nil
 	default:
 		return fmt.Errorf("unknown %d sidecar specific failed reason", s)
@@ -205,6 +217,10 @@ func (s SpecificFailedReason) String() string {
 		return "SDKVersionIsTooLowFailed"
 	case UnknownSpecificFailed:
 		return "UnknownSpecificFailed"
+	case VerifyCallbackFailed:
+		return "VerifyCallbackFailed"
+	case RollbackStagedFailed:
+		return "RollbackStagedFailed"
 	default:
 		return ""
 	}
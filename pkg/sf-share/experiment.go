@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"fmt"
+
+	pbfs "github.com/TencentBlueKing/bk-bscp/pkg/protocol/feed-server"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+// AssignExperimentVariant returns the index of the variant which the instance with the uid is assigned to
+// by the weights(percent), the assignment is stable for the same experiment and uid, -1 means none.
+func AssignExperimentVariant(experimentID uint32, uid string, weights []uint32) int {
+	if uid == "" || len(weights) == 0 {
+		return -1
+	}
+
+	// 桶的范围为 [0, 10000), 权重为百分比
+	bucket := selector.RolloutBucket(fmt.Sprintf("experiment-%d", experimentID), uid)
+	var upper uint32
+	for i, w := range weights {
+		upper += w * 100
+		if bucket < upper {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ExperimentVariant returns the variant of the kv experiment which the instance with the uid is assigned to,
+// nil means the instance should use the released value.
+func ExperimentVariant(exp *pbfs.KvExperiment, uid string) *pbfs.KvExperimentVariant {
+	if exp == nil || len(exp.Variants) == 0 {
+		return nil
+	}
+
+	weights := make([]uint32, len(exp.Variants))
+	for i, v := range exp.Variants {
+		weights[i] = v.Weight
+	}
+
+	idx := AssignExperimentVariant(exp.Id, uid, weights)
+	if idx < 0 {
+		return nil
+	}
+
+	return exp.Variants[idx]
+}
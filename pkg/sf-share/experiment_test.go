@@ -0,0 +1,42 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAssignExperimentVariant(t *testing.T) {
+	weights := []uint32{30, 70}
+	counts := make([]int, len(weights))
+	for i := 0; i < 10000; i++ {
+		uid := fmt.Sprintf("uid-%d", i)
+		idx := AssignExperimentVariant(1, uid, weights)
+		if idx < 0 {
+			t.Fatalf("uid %s should be assigned to a variant", uid)
+		}
+		if AssignExperimentVariant(1, uid, weights) != idx {
+			t.Fatalf("the assignment of uid %s should be stable", uid)
+		}
+		counts[idx]++
+	}
+
+	if counts[0] < 2700 || counts[0] > 3300 {
+		t.Errorf("the variant with 30%% weight got %d of 10000 instances", counts[0])
+	}
+
+	if AssignExperimentVariant(1, "", weights) != -1 {
+		t.Errorf("empty uid should not be assigned")
+	}
+}
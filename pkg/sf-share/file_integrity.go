@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// FileDriftKind is the kind of the managed file drifted from the applied release.
+type FileDriftKind string
+
+const (
+	// FileModified the content of the file is modified.
+	FileModified FileDriftKind = "modified"
+	// FileMissing the file is deleted.
+	FileMissing FileDriftKind = "missing"
+	// FilePermissionChanged the privilege of the file is changed.
+	FilePermissionChanged FileDriftKind = "permission_changed"
+)
+
+// Validate the file drift kind is valid or not.
+func (k FileDriftKind) Validate() error {
+	switch k {
+	case FileModified:
+	case FileMissing:
+	case FilePermissionChanged:
+	default:
+		return fmt.Errorf("unknown %s file drift kind", k)
+	}
+
+	return nil
+}
+
+// FileDrift is a managed file which is not consistent with the applied release.
+type FileDrift struct {
+	ConfigItem *ConfigItemMetaV1
+	// FilePath is the path of the file on disk.
+	FilePath string
+	Kind     FileDriftKind
+	// ActualSignature is the sha256 of the file on disk, it is empty if the file is missing or its size
+	// is different from the released one.
+	ActualSignature string
+}
+
+// CheckFileIntegrity verifies the managed files of the applied release under the dir, the file of a config
+// item is located at dir/path/name as the agent writes it. It is used by the agent's integrity watch mode,
+// which runs it periodically or on the file system events, re-downloads the drifted files and reports the
+// tamper events with FileTamperPayload.
+func CheckFileIntegrity(dir string, cis []*ConfigItemMetaV1) ([]*FileDrift, error) {
+	drifts := make([]*FileDrift, 0)
+	for _, ci := range cis {
+		if ci == nil || ci.ConfigItemSpec == nil || ci.ContentSpec == nil {
+			continue
+		}
+
+		drift, err := checkFile(dir, ci)
+		if err != nil {
+			return nil, err
+		}
+		if drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	return drifts, nil
+}
+
+func checkFile(dir string, ci *ConfigItemMetaV1) (*FileDrift, error) {
+	filePath := filepath.Join(dir, ci.ConfigItemSpec.Path, ci.ConfigItemSpec.Name)
+	drift := &FileDrift{ConfigItem: ci, FilePath: filePath}
+
+	info, err := os.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			drift.Kind = FileMissing
+			return drift, nil
+		}
+		return nil, fmt.Errorf("stat file %s failed, err: %v", filePath, err)
+	}
+
+	// 大小不一致时无需计算摘要
+	if uint64(info.Size()) != ci.ContentSpec.ByteSize {
+		drift.Kind = FileModified
+		return drift, nil
+	}
+
+	signature, err := fileSha256(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if signature != ci.ContentSpec.Signature {
+		drift.Kind = FileModified
+		drift.ActualSignature = signature
+		return drift, nil
+	}
+
+	// windows 没有 unix 文件权限, 不校验
+	privilege := ci.ConfigItemSpec.GetPermission().GetPrivilege()
+	if privilege == "" || runtime.GOOS == "windows" {
+		return nil, nil
+	}
+	mode, err := strconv.ParseUint(privilege, 8, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid privilege %s of file %s, err: %v", privilege, filePath, err)
+	}
+	if info.Mode().Perm() != os.FileMode(mode).Perm() {
+		drift.Kind = FilePermissionChanged
+		drift.ActualSignature = signature
+		return drift, nil
+	}
+
+	return nil, nil
+}
+
+// TamperEvent returns the tamper event of the drift in the applied release to be reported, repairErr is
+// the error of restoring the file to the released content, nil means it is repaired.
+func (d *FileDrift) TamperEvent(releaseID uint32, repairErr error) *FileTamperEvent {
+	event := &FileTamperEvent{
+		ReleaseID:         releaseID,
+		ConfigItemID:      d.ConfigItem.ID,
+		Path:              d.ConfigItem.ConfigItemSpec.Path,
+		Name:              d.ConfigItem.ConfigItemSpec.Name,
+		Kind:              d.Kind,
+		ExpectedSignature: d.ConfigItem.ContentSpec.Signature,
+		ActualSignature:   d.ActualSignature,
+		Repaired:          repairErr == nil,
+		DetectedAt:        time.Now().UTC(),
+	}
+	if repairErr != nil {
+		event.RepairError = repairErr.Error()
+	}
+
+	return event
+}
+
+func fileSha256(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", fmt.Errorf("open file %s failed, err: %v", filePath, err)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("read file %s failed, err: %v", filePath, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbci "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/config-item"
+	pbcontent "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/content"
+)
+
+func TestCheckFileIntegrity(t *testing.T) {
+	dir := t.TempDir()
+	content := []byte("port: 8080\n")
+	sum := sha256.Sum256(content)
+
+	newCi := func(id uint32, name string) *ConfigItemMetaV1 {
+		return &ConfigItemMetaV1{
+			ID: id,
+			ContentSpec: &pbcontent.ContentSpec{
+				Signature: hex.EncodeToString(sum[:]),
+				ByteSize:  uint64(len(content)),
+			},
+			ConfigItemSpec: &pbci.ConfigItemSpec{
+				Name:       name,
+				Path:       "/etc",
+				Permission: &pbci.FilePermission{Privilege: "644"},
+			},
+		}
+	}
+	cis := []*ConfigItemMetaV1{newCi(1, "ok.yaml"), newCi(2, "modified.yaml"), newCi(3, "missing.yaml"),
+		newCi(4, "chmod.yaml")}
+
+	if err := os.MkdirAll(filepath.Join(dir, "etc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{"ok.yaml": content, "modified.yaml": []byte("port: 9090\n"), "chmod.yaml": content}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, "etc", name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		// 不受 umask 影响
+		if err := os.Chmod(filepath.Join(dir, "etc", name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chmod(filepath.Join(dir, "etc", "chmod.yaml"), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	drifts, err := CheckFileIntegrity(dir, cis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[uint32]FileDriftKind{2: FileModified, 3: FileMissing, 4: FilePermissionChanged}
+	if len(drifts) != len(expected) {
+		t.Fatalf("expect %d drifted files, but got %d", len(expected), len(drifts))
+	}
+	for _, d := range drifts {
+		if expected[d.ConfigItem.ID] != d.Kind {
+			t.Errorf("config item %d should be %s, but got %s", d.ConfigItem.ID, expected[d.ConfigItem.ID], d.Kind)
+		}
+	}
+
+	event := drifts[0].TamperEvent(10, nil)
+	if event.ReleaseID != 10 || !event.Repaired || event.Kind.Validate() != nil {
+		t.Errorf("unexpected tamper event: %+v", event)
+	}
+}
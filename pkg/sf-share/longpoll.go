@@ -0,0 +1,142 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/validator"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/jsoni"
+)
+
+const (
+	// DefaultLongPollTimeoutSec is the default seconds a long poll watch request waits for the release changes.
+	DefaultLongPollTimeoutSec = 30
+	// MaxLongPollTimeoutSec is the max seconds a long poll watch request waits for the release changes.
+	MaxLongPollTimeoutSec = 120
+)
+
+// ReleaseChangeNotice is the release change notice sent to the consumers which can not hold a
+// grpc watch stream, only the release's basic info is carried, the consumer should pull the
+// release's details by itself.
+type ReleaseChangeNotice struct {
+	BizID       uint32 `json:"bizID"`
+	AppID       uint32 `json:"appID"`
+	App         string `json:"app"`
+	ReleaseID   uint32 `json:"releaseID"`
+	ReleaseName string `json:"releaseName"`
+	CursorID    uint32 `json:"cursorID"`
+}
+
+// LongPollApp defines an app instance to watch with long poll.
+type LongPollApp struct {
+	App    string            `json:"app"`
+	Uid    string            `json:"uid"`
+	Labels map[string]string `json:"labels"`
+	// CurrentReleaseID is the release id the instance is using, it is ignored when the cursor is set.
+	CurrentReleaseID uint32 `json:"currentReleaseID"`
+}
+
+// LongPollWatchReq is the long poll watch request, it returns immediately when a new release exists
+// for any of the apps, otherwise it returns after the timeout.
+type LongPollWatchReq struct {
+	BizID uint32         `json:"bizID"`
+	Apps  []*LongPollApp `json:"apps"`
+	// Cursor is the cursor token returned by the last long poll watch response.
+	Cursor     string `json:"cursor"`
+	TimeoutSec uint32 `json:"timeoutSec"`
+}
+
+// Validate the long poll watch request.
+func (r *LongPollWatchReq) Validate() error {
+	if r.BizID <= 0 {
+		return errors.New("invalid biz id")
+	}
+
+	if len(r.Apps) == 0 {
+		return errors.New("apps is required")
+	}
+
+	for _, one := range r.Apps {
+		if one.App == "" {
+			return errors.New("invalid app")
+		}
+
+		if one.Uid == "" {
+			return fmt.Errorf("app %s uid is required", one.App)
+		}
+
+		if err := validator.ValidateLabel(one.Labels); err != nil {
+			return fmt.Errorf("app %s labels is invalid, err: %v", one.App, err)
+		}
+	}
+
+	if r.TimeoutSec > MaxLongPollTimeoutSec {
+		return fmt.Errorf("timeout should be no more than %d seconds", MaxLongPollTimeoutSec)
+	}
+
+	return nil
+}
+
+// LongPollWatchResp is the long poll watch response.
+type LongPollWatchResp struct {
+	// Changed is true when there is any release change in the Changes.
+	Changed bool                   `json:"changed"`
+	Changes []*ReleaseChangeNotice `json:"changes"`
+	// Cursor should be carried by the next long poll watch request.
+	Cursor string `json:"cursor"`
+}
+
+// LongPollCursor records the releases the consumer has received, it is encoded as an opaque
+// token to the consumer.
+type LongPollCursor struct {
+	// Releases is the app id to the release id the consumer has received.
+	Releases map[uint32]uint32 `json:"r"`
+	// CursorID is the largest event cursor id the consumer has received.
+	CursorID uint32 `json:"c"`
+}
+
+// Encode the cursor to token.
+func (c *LongPollCursor) Encode() (string, error) {
+	data, err := jsoni.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodeLongPollCursor decode the cursor from token, empty token returns an empty cursor.
+func DecodeLongPollCursor(token string) (*LongPollCursor, error) {
+	cursor := &LongPollCursor{Releases: make(map[uint32]uint32)}
+	if token == "" {
+		return cursor, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor, err: %v", err)
+	}
+
+	if err := jsoni.Unmarshal(data, cursor); err != nil {
+		return nil, fmt.Errorf("invalid cursor, err: %v", err)
+	}
+
+	if cursor.Releases == nil {
+		cursor.Releases = make(map[uint32]uint32)
+	}
+
+	return cursor, nil
+}
@@ -0,0 +1,49 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLongPollCursor(t *testing.T) {
+	cursor := &LongPollCursor{Releases: map[uint32]uint32{1: 10, 2: 20}, CursorID: 100}
+
+	token, err := cursor.Encode()
+	if err != nil {
+		t.Errorf("encode cursor failed, err: %v", err)
+		return
+	}
+
+	decoded, err := DecodeLongPollCursor(token)
+	if err != nil {
+		t.Errorf("decode cursor failed, err: %v", err)
+		return
+	}
+
+	if !reflect.DeepEqual(cursor, decoded) {
+		t.Errorf("decoded cursor %+v not equal to %+v", decoded, cursor)
+		return
+	}
+
+	empty, err := DecodeLongPollCursor("")
+	if err != nil || empty.Releases == nil || empty.CursorID != 0 {
+		t.Errorf("decode empty cursor got %+v, err: %v", empty, err)
+		return
+	}
+
+	if _, err := DecodeLongPollCursor("!invalid"); err == nil {
+		t.Errorf("decode invalid cursor should be failed")
+	}
+}
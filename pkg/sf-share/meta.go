@@ -30,6 +30,9 @@ import (
 type SidecarMetaHeader struct {
 	BizID       uint32 `json:"bid"`
 	Fingerprint string `json:"fpt"`
+	// Version is the client's version like v1.2.3, it's sent with every request and checked against the
+	// min client version of the apps.
+	Version string `json:"ver,omitempty"`
 }
 
 // Validate the sidecar meta header is valid or not.
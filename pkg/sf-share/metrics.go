@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	clientMetricNamespace = "bscp"
+	clientMetricSubSys    = "client"
+
+	resultSuccess = "success"
+	resultFailed  = "failed"
+)
+
+// ClientMetrics is the optional metrics registry of the sdk and the sidecar, which exposes the client side
+// config health in prometheus format, so that the platform can alert on it fleet-wide.
+// All the methods are no-op on a nil ClientMetrics, the clients can pass nil when the metrics is disabled.
+type ClientMetrics struct {
+	registry *prometheus.Registry
+
+	// pullLatency records the latency of pulling the release metadata from feed server.
+	pullLatency *prometheus.HistogramVec
+	// watchReconnects records the times the watch stream is re-established.
+	watchReconnects *prometheus.CounterVec
+	// applyTotal records the apply results, the failed reason is the FailedReason of the PrimaryError.
+	applyTotal *prometheus.CounterVec
+	// downloadBytes records the bytes of the config contents downloaded.
+	downloadBytes *prometheus.CounterVec
+	// currentRelease records the release id in effect of the app.
+	currentRelease *prometheus.GaugeVec
+}
+
+// NewClientMetrics create a client metrics registry, the const labels such as the client's uid or
+// hostname are attached to all the metrics.
+func NewClientMetrics(constLabels prometheus.Labels) *ClientMetrics {
+	m := &ClientMetrics{registry: prometheus.NewRegistry()}
+
+	m.pullLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace:   clientMetricNamespace,
+		Subsystem:   clientMetricSubSys,
+		Name:        "pull_latency_seconds",
+		Help:        "the latency of pulling the release metadata from feed server",
+		ConstLabels: constLabels,
+		Buckets:     []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"biz", "app", "result"})
+
+	m.watchReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   clientMetricNamespace,
+		Subsystem:   clientMetricSubSys,
+		Name:        "watch_reconnect_total",
+		Help:        "the total times the watch stream to feed server is re-established",
+		ConstLabels: constLabels,
+	}, []string{"biz"})
+
+	m.applyTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   clientMetricNamespace,
+		Subsystem:   clientMetricSubSys,
+		Name:        "apply_total",
+		Help:        "the total applies of the releases, labeled by the result and the failed reason",
+		ConstLabels: constLabels,
+	}, []string{"biz", "app", "result", "reason"})
+
+	m.downloadBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace:   clientMetricNamespace,
+		Subsystem:   clientMetricSubSys,
+		Name:        "download_bytes_total",
+		Help:        "the total bytes of the config contents downloaded",
+		ConstLabels: constLabels,
+	}, []string{"biz", "app"})
+
+	m.currentRelease = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace:   clientMetricNamespace,
+		Subsystem:   clientMetricSubSys,
+		Name:        "current_release_id",
+		Help:        "the release id in effect of the app",
+		ConstLabels: constLabels,
+	}, []string{"biz", "app"})
+
+	m.registry.MustRegister(m.pullLatency, m.watchReconnects, m.applyTotal, m.downloadBytes, m.currentRelease)
+
+	return m
+}
+
+// Registry returns the prometheus registry, the clients can register their own collectors to it.
+func (m *ClientMetrics) Registry() *prometheus.Registry {
+	if m == nil {
+		return nil
+	}
+	return m.registry
+}
+
+// Handler returns the http handler which exposes the metrics.
+func (m *ClientMetrics) Handler() http.Handler {
+	if m == nil {
+		return http.NotFoundHandler()
+	}
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObservePull record a pull of the app's release metadata.
+func (m *ClientMetrics) ObservePull(bizID uint32, app string, cost time.Duration, err error) {
+	if m == nil {
+		return
+	}
+
+	result := resultSuccess
+	if err != nil {
+		result = resultFailed
+	}
+	m.pullLatency.WithLabelValues(strconv.FormatUint(uint64(bizID), 10), app, result).Observe(cost.Seconds())
+}
+
+// IncWatchReconnect record a re-establishment of the watch stream.
+func (m *ClientMetrics) IncWatchReconnect(bizID uint32) {
+	if m == nil {
+		return
+	}
+	m.watchReconnects.WithLabelValues(strconv.FormatUint(uint64(bizID), 10)).Inc()
+}
+
+// ObserveApply record an apply of the app's release, the current release id is updated when it succeeds.
+// the failed reason is parsed from the PrimaryError, UnknownFailed is used for the other errors.
+func (m *ClientMetrics) ObserveApply(bizID uint32, app string, releaseID uint32, err error) {
+	if m == nil {
+		return
+	}
+
+	biz := strconv.FormatUint(uint64(bizID), 10)
+	if err == nil {
+		m.applyTotal.WithLabelValues(biz, app, resultSuccess, "").Inc()
+		m.currentRelease.WithLabelValues(biz, app).Set(float64(releaseID))
+		return
+	}
+
+	reason := UnknownFailed
+	var pe PrimaryError
+	if errors.As(err, &pe) {
+		reason = pe.FailedReason
+	}
+	m.applyTotal.WithLabelValues(biz, app, resultFailed, reason.String()).Inc()
+}
+
+// AddDownloadBytes record the bytes of the config contents downloaded.
+func (m *ClientMetrics) AddDownloadBytes(bizID uint32, app string, bytes uint64) {
+	if m == nil {
+		return
+	}
+	m.downloadBytes.WithLabelValues(strconv.FormatUint(uint64(bizID), 10), app).Add(float64(bytes))
+}
@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sfs
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestClientMetrics(t *testing.T) {
+	var disabled *ClientMetrics
+	disabled.ObserveApply(2, "demo", 10, nil)
+	if disabled.Registry() != nil {
+		t.Errorf("disabled metrics should have no registry")
+	}
+
+	m := NewClientMetrics(prometheus.Labels{"uid": "u1"})
+	m.ObservePull(2, "demo", 20*time.Millisecond, nil)
+	m.IncWatchReconnect(2)
+	m.AddDownloadBytes(2, "demo", 1024)
+	m.ObserveApply(2, "demo", 10, nil)
+	m.ObserveApply(2, "demo", 11, WrapPrimaryError(VerifyFailed, SecondaryError{
+		SpecificFailedReason: VerifyCallbackFailed, Err: errors.New("port is invalid")}))
+	m.ObserveApply(2, "demo", 11, errors.New("disk is full"))
+
+	expected := `
+# HELP bscp_client_apply_total the total applies of the releases, labeled by the result and the failed reason
+# TYPE bscp_client_apply_total counter
+bscp_client_apply_total{app="demo",biz="2",reason="",result="success",uid="u1"} 1
+bscp_client_apply_total{app="demo",biz="2",reason="UnknownFailed",result="failed",uid="u1"} 1
+bscp_client_apply_total{app="demo",biz="2",reason="VerifyFailed",result="failed",uid="u1"} 1
+# HELP bscp_client_current_release_id the release id in effect of the app
+# TYPE bscp_client_current_release_id gauge
+bscp_client_current_release_id{app="demo",biz="2",uid="u1"} 10
+# HELP bscp_client_download_bytes_total the total bytes of the config contents downloaded
+# TYPE bscp_client_download_bytes_total counter
+bscp_client_download_bytes_total{app="demo",biz="2",uid="u1"} 1024
+# HELP bscp_client_watch_reconnect_total the total times the watch stream to feed server is re-established
+# TYPE bscp_client_watch_reconnect_total counter
+bscp_client_watch_reconnect_total{biz="2",uid="u1"} 1
+`
+	if err := testutil.GatherAndCompare(m.Registry(), strings.NewReader(expected), "bscp_client_apply_total",
+		"bscp_client_current_release_id", "bscp_client_download_bytes_total",
+		"bscp_client_watch_reconnect_total"); err != nil {
+		t.Error(err)
+	}
+
+	if n := testutil.CollectAndCount(m.pullLatency); n != 1 {
+		t.Errorf("expect 1 pull latency series, but got %d", n)
+	}
+}
@@ -70,6 +70,12 @@ const (
 	Heartbeat MessagingType = 2
 	// VersionChangeMessage the version change event was reported. Procedure
 	VersionChangeMessage MessagingType = 3
+	// HookExecResultMessage the pre/post hook execution results were reported.
+	HookExecResultMessage MessagingType = 4
+	// ExperimentExposureMessage the kv experiment exposures were reported.
+	ExperimentExposureMessage MessagingType = 5
+	// FileTamperMessage the managed files drifted from the applied release were reported.
+	FileTamperMessage MessagingType = 6
 )
 
 // Validate the messaging type is valid or not.
@@ -78,6 +84,9 @@ func (sm MessagingType) Validate() error {
 	case SidecarOffline:
 	case Heartbeat:
 	case VersionChangeMessage:
+	case HookExecResultMessage:
+	case ExperimentExposureMessage:
+	case FileTamperMessage:
 	default:
 		return fmt.Errorf("unknown %d sidecar message type", sm)
 	}
@@ -94,6 +103,12 @@ func (sm MessagingType) String() string {
 		return "Heartbeat"
 	case VersionChangeMessage:
 		return "VersionChange"
+	case HookExecResultMessage:
+		return "HookExecResult"
+	case ExperimentExposureMessage:
+		return "ExperimentExposure"
+	case FileTamperMessage:
+		return "FileTamper"
 	default:
 		return "Unknown"
 	}
@@ -103,6 +118,25 @@ func (sm MessagingType) String() string {
 type SideWatchPayload struct {
 	BizID        uint32        `json:"bizID"`
 	Applications []SideAppMeta `json:"apps"`
+	// Subscriptions are the apps subscribed with other credentials over the same watch stream, which may
+	// belong to other bizs, so that one sdk/agent process does not need a watch stream for each of them.
+	Subscriptions []*SideSubscription `json:"subscriptions,omitempty"`
+}
+
+// SideSubscription defines the apps subscribed with a credential.
+type SideSubscription struct {
+	BizID uint32 `json:"bizID"`
+	// Token is the credential of the apps, the watch stream's credential is used when it is empty.
+	Token        string        `json:"token,omitempty"`
+	Applications []SideAppMeta `json:"apps"`
+}
+
+// AllSubscriptions returns the subscriptions of the watch stream, the first one is the apps of the
+// payload's biz with the watch stream's credential.
+func (s *SideWatchPayload) AllSubscriptions() []*SideSubscription {
+	all := make([]*SideSubscription, 0, len(s.Subscriptions)+1)
+	all = append(all, &SideSubscription{BizID: s.BizID, Applications: s.Applications})
+	return append(all, s.Subscriptions...)
 }
 
 // Validate the sidecar's watch payload is valid or not.
@@ -115,14 +149,26 @@ func (s SideWatchPayload) Validate() error {
 		return errors.New("invalid sidecar watch payload, no apps are set")
 	}
 
-	if len(s.Applications) > validator.MaxAppMetas {
-		return fmt.Errorf("at most %d apps is allowed for one sidecar", validator.MaxAppMetas)
-	}
+	total := 0
+	for _, sub := range s.AllSubscriptions() {
+		if sub == nil || sub.BizID <= 0 {
+			return errors.New("invalid sidecar watch subscription biz id")
+		}
 
-	for _, one := range s.Applications {
-		if err := one.Validate(); err != nil {
-			return err
+		if len(sub.Applications) == 0 {
+			return fmt.Errorf("invalid sidecar watch subscription of biz %d, no apps are set", sub.BizID)
+		}
+
+		for _, one := range sub.Applications {
+			if err := one.Validate(); err != nil {
+				return err
+			}
 		}
+		total += len(sub.Applications)
+	}
+
+	if total > validator.MaxAppMetas {
+		return fmt.Errorf("at most %d apps is allowed for one sidecar", validator.MaxAppMetas)
 	}
 
 	return nil
@@ -137,6 +183,12 @@ type SideAppMeta struct {
 	Labels    map[string]string `json:"labels"`
 	// Match is app config item's match conditions
 	Match []string `json:"match"`
+	// Scopes is the path scopes of the app granted to the credential, it is set by feed server.
+	Scopes []string `json:"-"`
+	// Credential and CredentialPause are the credential the app is watched with and its consumption pause,
+	// they are set by feed server.
+	Credential      string                  `json:"-"`
+	CredentialPause *table.ConsumptionPause `json:"-"`
 	// CurrentReleaseID is sidecar's current effected release id.
 	CurrentReleaseID uint32 `json:"currentReleaseID"`
 	// sidecar's current cursor id
@@ -235,6 +287,13 @@ type InstanceSpec struct {
 	// Match is app config item's match conditions
 	Match      []string         `json:"match"`
 	ConfigType table.ConfigType `json:"configType"`
+	// Scopes is the path scopes of the app the instance's credential is allowed to consume, the config
+	// items out of the scopes are filtered out, empty means no restriction.
+	Scopes []string `json:"-"`
+	// Credential is the credential the instance watches with, CredentialPause is its consumption pause when
+	// the instance subscribes, the release change is not pushed to the instance when it's paused.
+	Credential      string                  `json:"-"`
+	CredentialPause *table.ConsumptionPause `json:"-"`
 }
 
 // Validate the instance spec is valid or not
@@ -440,6 +499,9 @@ type SidecarRuntimeOption struct {
 type ServiceInfo struct {
 	// Name feed server instance name, it is used to determine which service instance sidecar is connected to.
 	Name string `json:"name"`
+	// WatchVersions is the watch api versions served by the feed server, the client selects the highest
+	// one it supports with SelectWatchVersion.
+	WatchVersions []uint32 `json:"watchVersions,omitempty"`
 }
 
 // OfflinePayload defines sidecar offline to send payload to feed server.
@@ -519,6 +581,8 @@ type KvMetaV1 struct {
 	Revision     *pbbase.Revision       `json:"revision"`
 	KvAttachment *pbkv.KvAttachment     `json:"kv_attachment"`
 	ContentSpec  *pbcontent.ContentSpec `json:"content_spec"`
+	// Experiment 实例参与的配置项实验, 为空时使用发布的值
+	Experiment *pbfs.KvExperiment `json:"experiment,omitempty"`
 }
 
 // BasicData 上报时基础数据
@@ -592,6 +656,236 @@ func (v *VersionChangePayload) Decode(data []byte) error {
 	return nil
 }
 
+// HookExecResultPayload defines sdk hook execution results to send payload to feed server.
+type HookExecResultPayload struct {
+	// BasicData 基础信息：例如客户端唯一标识、bizID、客户端模式
+	BasicData *BasicData `json:"basicData"`
+	// Application app相关信息：例如 appName、appID、currentReleaseID等
+	Application *SideAppMeta `json:"application"`
+	// Results 本次上报的脚本执行结果
+	Results []*HookExecResult `json:"results"`
+}
+
+// HookExecResult defines the result of a pre/post hook execution.
+type HookExecResult struct {
+	// HookType pre_hook or post_hook
+	HookType string `json:"hookType"`
+	// HookName the name of the executed hook
+	HookName  string `json:"hookName"`
+	ReleaseID uint32 `json:"releaseID"`
+	ExitCode  int32  `json:"exitCode"`
+	// DurationMs the execution cost time in milliseconds
+	DurationMs uint32 `json:"durationMs"`
+	// Stdout/Stderr the tail of the hook output, truncated by the client
+	Stdout     string    `json:"stdout"`
+	Stderr     string    `json:"stderr"`
+	ExecutedAt time.Time `json:"executedAt"`
+}
+
+// Validate the hook exec result payload is valid or not.
+func (h *HookExecResultPayload) Validate() error {
+	if h.BasicData == nil {
+		return errors.New("basic data is nil")
+	}
+
+	if err := h.BasicData.Validate(); err != nil {
+		return err
+	}
+
+	if h.Application == nil {
+		return errors.New("application is nil")
+	}
+
+	if len(h.Results) == 0 {
+		return errors.New("hook exec results is empty")
+	}
+
+	// 单条结果无效时只丢弃该条, 全部无效时整个上报无效
+	for _, r := range h.Results {
+		if r.Validate() == nil {
+			return nil
+		}
+	}
+
+	return errors.New("no valid hook exec result")
+}
+
+// Validate the hook exec result is valid or not.
+func (r *HookExecResult) Validate() error {
+	if r == nil {
+		return errors.New("hook exec result is nil")
+	}
+
+	return table.HookExecType(r.HookType).Validate()
+}
+
+// MessagingType return the payload related sidecar message type.
+func (h *HookExecResultPayload) MessagingType() MessagingType {
+	return HookExecResultMessage
+}
+
+// Encode the HookExecResultPayload to bytes.
+func (h *HookExecResultPayload) Encode() ([]byte, error) {
+	if h == nil {
+		return nil, errors.New("HookExecResultPayload is nil, can not be encoded")
+	}
+
+	return jsoni.Marshal(h)
+}
+
+// Decode the HookExecResultPayload to bytes.
+func (h *HookExecResultPayload) Decode(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("HookExecResultPayload is nil, can not be decoded")
+	}
+
+	return jsoni.Unmarshal(data, h)
+}
+
+// ExperimentExposurePayload defines sdk kv experiment exposures to send payload to feed server.
+type ExperimentExposurePayload struct {
+	// BasicData 基础信息：例如客户端唯一标识、bizID、客户端模式
+	BasicData *BasicData `json:"basicData"`
+	// Application app相关信息：例如 appName、appID、currentReleaseID等
+	Application *SideAppMeta `json:"application"`
+	// Exposures 本次上报的实验曝光, 客户端读取实验配置项时记录
+	Exposures []*ExperimentExposure `json:"exposures"`
+}
+
+// ExperimentExposure defines an exposure of the client in a kv experiment.
+type ExperimentExposure struct {
+	ExperimentID uint32 `json:"experimentID"`
+	// Key the kv key of the experiment
+	Key string `json:"key"`
+	// Variant the variant name which the client is assigned to
+	Variant   string    `json:"variant"`
+	ReleaseID uint32    `json:"releaseID"`
+	ExposedAt time.Time `json:"exposedAt"`
+}
+
+// Validate the experiment exposure payload is valid or not.
+func (e *ExperimentExposurePayload) Validate() error {
+	if e.BasicData == nil {
+		return errors.New("basic data is nil")
+	}
+
+	if err := e.BasicData.Validate(); err != nil {
+		return err
+	}
+
+	if e.Application == nil {
+		return errors.New("application is nil")
+	}
+
+	if len(e.Exposures) == 0 {
+		return errors.New("experiment exposures is empty")
+	}
+
+	return nil
+}
+
+// MessagingType return the payload related sidecar message type.
+func (e *ExperimentExposurePayload) MessagingType() MessagingType {
+	return ExperimentExposureMessage
+}
+
+// Encode the ExperimentExposurePayload to bytes.
+func (e *ExperimentExposurePayload) Encode() ([]byte, error) {
+	if e == nil {
+		return nil, errors.New("ExperimentExposurePayload is nil, can not be encoded")
+	}
+
+	return jsoni.Marshal(e)
+}
+
+// Decode the ExperimentExposurePayload to bytes.
+func (e *ExperimentExposurePayload) Decode(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("ExperimentExposurePayload is nil, can not be decoded")
+	}
+
+	return jsoni.Unmarshal(data, e)
+}
+
+// FileTamperPayload defines the agent file tamper events to send payload to feed server.
+type FileTamperPayload struct {
+	// BasicData 基础信息：例如客户端唯一标识、bizID、客户端模式
+	BasicData *BasicData `json:"basicData"`
+	// Application app相关信息：例如 appName、appID、currentReleaseID等
+	Application *SideAppMeta `json:"application"`
+	// Events 本次上报的文件篡改事件, 客户端校验托管文件与已应用版本不一致时记录
+	Events []*FileTamperEvent `json:"events"`
+}
+
+// FileTamperEvent defines a managed file which is drifted from the applied release.
+type FileTamperEvent struct {
+	ReleaseID    uint32        `json:"releaseID"`
+	ConfigItemID uint32        `json:"configItemID"`
+	Path         string        `json:"path"`
+	Name         string        `json:"name"`
+	Kind         FileDriftKind `json:"kind"`
+	// ExpectedSignature/ActualSignature the sha256 of the released content and the file on disk
+	ExpectedSignature string `json:"expectedSignature"`
+	ActualSignature   string `json:"actualSignature"`
+	// Repaired whether the file is restored to the released content, RepairError is set when failed
+	Repaired    bool      `json:"repaired"`
+	RepairError string    `json:"repairError"`
+	DetectedAt  time.Time `json:"detectedAt"`
+}
+
+// Validate the file tamper payload is valid or not.
+func (f *FileTamperPayload) Validate() error {
+	if f.BasicData == nil {
+		return errors.New("basic data is nil")
+	}
+
+	if err := f.BasicData.Validate(); err != nil {
+		return err
+	}
+
+	if f.Application == nil {
+		return errors.New("application is nil")
+	}
+
+	if len(f.Events) == 0 {
+		return errors.New("file tamper events is empty")
+	}
+
+	for _, one := range f.Events {
+		if one == nil {
+			continue
+		}
+		if err := one.Kind.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MessagingType return the payload related sidecar message type.
+func (f *FileTamperPayload) MessagingType() MessagingType {
+	return FileTamperMessage
+}
+
+// Encode the FileTamperPayload to bytes.
+func (f *FileTamperPayload) Encode() ([]byte, error) {
+	if f == nil {
+		return nil, errors.New("FileTamperPayload is nil, can not be encoded")
+	}
+
+	return jsoni.Marshal(f)
+}
+
+// Decode the FileTamperPayload to bytes.
+func (f *FileTamperPayload) Decode(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("FileTamperPayload is nil, can not be decoded")
+	}
+
+	return jsoni.Unmarshal(data, f)
+}
+
 // OnlineStatus define the online status structure
 type OnlineStatus uint32
 
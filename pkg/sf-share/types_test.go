@@ -55,3 +55,86 @@ func TestTLSBytes(t *testing.T) {
 	}
 
 }
+
+func TestHookExecResultPayload(t *testing.T) {
+	payload := &HookExecResultPayload{
+		BasicData:   &BasicData{BizID: 2, IP: "127.0.0.1", ClientType: Sidecar},
+		Application: &SideAppMeta{AppID: 3, App: "demo", Uid: "uid-1"},
+		Results: []*HookExecResult{
+			{HookType: "pre_hook", HookName: "check", ReleaseID: 4, ExitCode: 1, Stderr: "boom"},
+		},
+	}
+
+	bytes, err := payload.Encode()
+	if err != nil {
+		t.Errorf("encode hook exec result payload failed, err: %v", err)
+		return
+	}
+
+	decoded := new(HookExecResultPayload)
+	if err := decoded.Decode(bytes); err != nil {
+		t.Errorf("decode hook exec result payload failed, err: %v", err)
+		return
+	}
+
+	results, err := decoded.PbHookExecResults()
+	if err != nil {
+		t.Errorf("convert hook exec result payload failed, err: %v", err)
+		return
+	}
+
+	if len(results) != 1 || results[0].Attachment.AppId != 3 || results[0].Attachment.ReleaseId != 4 ||
+		results[0].Spec.Stderr != "boom" || results[0].Spec.Ip != "127.0.0.1" {
+		t.Errorf("converted hook exec results is not what we expected!")
+		return
+	}
+
+	// 无效的结果只丢弃该条
+	decoded.Results = append(decoded.Results, &HookExecResult{HookType: "unknown_hook", HookName: "bad"})
+	results, err = decoded.PbHookExecResults()
+	if err != nil || len(results) != 1 || results[0].Spec.HookName != "check" {
+		t.Errorf("invalid hook exec result should be dropped, err: %v", err)
+		return
+	}
+
+	decoded.Results = decoded.Results[1:]
+	if _, err := decoded.PbHookExecResults(); err == nil {
+		t.Errorf("hook exec results without valid one should be invalid")
+	}
+
+	decoded.Results = nil
+	if _, err := decoded.PbHookExecResults(); err == nil {
+		t.Errorf("empty hook exec results should be invalid")
+	}
+}
+
+func TestSideWatchPayloadSubscriptions(t *testing.T) {
+	payload := &SideWatchPayload{
+		BizID:        2,
+		Applications: []SideAppMeta{{AppID: 1, App: "demo", Uid: "uid"}},
+		Subscriptions: []*SideSubscription{{
+			BizID:        3,
+			Token:        "token",
+			Applications: []SideAppMeta{{AppID: 5, App: "other", Uid: "uid"}},
+		}},
+	}
+	if err := payload.Validate(); err != nil {
+		t.Fatalf("validate payload failed, err: %v", err)
+	}
+
+	all := payload.AllSubscriptions()
+	if len(all) != 2 || all[0].BizID != 2 || all[1].BizID != 3 {
+		t.Fatalf("unexpected subscriptions: %+v", all)
+	}
+
+	// 订阅中的 app id 回填到原始 payload 中
+	all[0].Applications[0].AppID = 10
+	if payload.Applications[0].AppID != 10 {
+		t.Errorf("subscription apps should share with the payload")
+	}
+
+	payload.Subscriptions[0].Applications = nil
+	if err := payload.Validate(); err == nil {
+		t.Errorf("subscription without apps should be invalid")
+	}
+}
@@ -13,6 +13,8 @@
 package sfs
 
 import (
+	semver "github.com/hashicorp/go-version"
+
 	pbbase "github.com/TencentBlueKing/bk-bscp/pkg/protocol/core/base"
 )
 
@@ -92,3 +94,56 @@ func IsSidecarVersionMatch(ver *pbbase.Versioning) bool {
 	return true
 
 }
+
+// IsClientVersionAllowed test if the client's version is not lower than the min client version required
+// by the app, an empty or invalid min version means no limit, the client without version is not allowed
+// if the app has a min version.
+func IsClientVersionAllowed(minVersion string, ver string) bool {
+	if minVersion == "" {
+		return true
+	}
+
+	least, err := semver.NewVersion(minVersion)
+	if err != nil {
+		return true
+	}
+
+	if ver == "" {
+		return false
+	}
+
+	cur, err := semver.NewVersion(ver)
+	if err != nil {
+		return false
+	}
+
+	return !cur.LessThan(least)
+}
+
+const (
+	// WatchV1 is the v1 watch api, the events are delivered one by one with pbfs.Upstream/Watch.
+	WatchV1 uint32 = 1
+	// WatchV2 is the v2 watch api, the events are delivered in batches with pbfs.v2.Upstream/Watch.
+	WatchV2 uint32 = 2
+)
+
+// SupportedWatchVersions is the watch api versions served by the feed server, returned with handshake.
+var SupportedWatchVersions = []uint32{WatchV1, WatchV2}
+
+// SelectWatchVersion selects the highest watch api version supported by both the feed server and the
+// client. The feed server which does not return the versions with handshake only supports v1.
+func SelectWatchVersion(server *ServiceInfo, client []uint32) uint32 {
+	if server == nil || len(server.WatchVersions) == 0 {
+		return WatchV1
+	}
+
+	selected := WatchV1
+	for _, sv := range server.WatchVersions {
+		for _, cv := range client {
+			if sv == cv && sv > selected {
+				selected = sv
+			}
+		}
+	}
+	return selected
+}
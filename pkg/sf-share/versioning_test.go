@@ -94,3 +94,52 @@ func TestIsAPIVersionMatch(t *testing.T) {
 		}
 	}
 }
+
+func TestSelectWatchVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		server   *ServiceInfo
+		client   []uint32
+		expected uint32
+	}{
+		{name: "old feed server", server: &ServiceInfo{Name: "feed"}, client: []uint32{WatchV1, WatchV2},
+			expected: WatchV1},
+		{name: "old client", server: &ServiceInfo{WatchVersions: SupportedWatchVersions}, client: []uint32{WatchV1},
+			expected: WatchV1},
+		{name: "both support v2", server: &ServiceInfo{WatchVersions: SupportedWatchVersions},
+			client: []uint32{WatchV1, WatchV2}, expected: WatchV2},
+		{name: "nil server info", client: []uint32{WatchV2}, expected: WatchV1},
+	}
+
+	for _, tc := range testCases {
+		if v := SelectWatchVersion(tc.server, tc.client); v != tc.expected {
+			t.Errorf("Test %s failed, Expected %d, got %d", tc.name, tc.expected, v)
+		}
+	}
+}
+
+func TestIsClientVersionAllowed(t *testing.T) {
+	ver := "v1.2.3"
+
+	testCases := []struct {
+		minVersion string
+		ver        string
+		expected   bool
+	}{
+		{minVersion: "", ver: "", expected: true},
+		{minVersion: "v1.2.3", ver: ver, expected: true},
+		{minVersion: "1.2.0", ver: ver, expected: true},
+		{minVersion: "v1.3.0", ver: ver, expected: false},
+		{minVersion: "v2.0.0", ver: ver, expected: false},
+		{minVersion: "v1.0.0", ver: "", expected: false},
+		{minVersion: "v1.0.0", ver: "bscp-bench", expected: false},
+		{minVersion: "latest", ver: ver, expected: true},
+	}
+
+	for _, tc := range testCases {
+		if got := IsClientVersionAllowed(tc.minVersion, tc.ver); got != tc.expected {
+			t.Errorf("min version %q, client version %q, expected %v, got %v", tc.minVersion,
+				tc.ver, tc.expected, got)
+		}
+	}
+}
@@ -13,10 +13,13 @@
 package tools
 
 import (
+	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
@@ -29,7 +32,7 @@ const (
 func GetIPsFromEnv() (string, []string) {
 	podIP := os.Getenv(podIPEnv)
 	if podIP == "" {
-		podIP = "127.0.0.1"
+		podIP = LoopbackIP()
 	}
 	if os.Getenv(podIPsEnv) == "" {
 		return podIP, []string{}
@@ -38,11 +41,85 @@ func GetIPsFromEnv() (string, []string) {
 	return podIP, podIPs
 }
 
+var (
+	loopbackOnce sync.Once
+	loopbackIP   string
+)
+
+// LoopbackIP 返回本机可用的回环地址, 仅支持 IPv6 的环境下返回 ::1
+func LoopbackIP() string {
+	loopbackOnce.Do(func() {
+		loopbackIP = "127.0.0.1"
+
+		addrs, err := net.InterfaceAddrs()
+		if err != nil {
+			return
+		}
+
+		hasV6 := false
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok || !ipNet.IP.IsLoopback() {
+				continue
+			}
+			if ipNet.IP.To4() != nil {
+				return
+			}
+			hasV6 = true
+		}
+
+		if hasV6 {
+			loopbackIP = "::1"
+		}
+	})
+
+	return loopbackIP
+}
+
 // IsIPv6 判断是否为IPv6地址
 func IsIPv6(ip string) bool {
 	return strings.Contains(ip, ":")
 }
 
+// ValidateEndpoint 校验 host:port 格式的地址, IPv6 地址需要使用 [ip]:port 格式
+func ValidateEndpoint(endpoint string) error {
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		if strings.Count(endpoint, ":") > 1 && !strings.HasPrefix(endpoint, "[") {
+			return fmt.Errorf("invalid endpoint %s, ipv6 address should be in [ip]:port format", endpoint)
+		}
+		return fmt.Errorf("invalid endpoint %s, err: %v", endpoint, err)
+	}
+
+	if host == "" {
+		return fmt.Errorf("invalid endpoint %s, host is empty", endpoint)
+	}
+
+	if p, err := strconv.Atoi(port); err != nil || p <= 0 || p > 65535 {
+		return fmt.Errorf("invalid endpoint %s, invalid port", endpoint)
+	}
+
+	return nil
+}
+
+// ValidateURLEndpoint 校验 url 或者 host:port 格式的地址, IPv6 地址需要使用 scheme://[ip]:port 格式
+func ValidateURLEndpoint(endpoint string) error {
+	if !strings.Contains(endpoint, "://") {
+		return ValidateEndpoint(endpoint)
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("invalid endpoint %s, err: %v", endpoint, err)
+	}
+
+	if u.Hostname() == "" {
+		return fmt.Errorf("invalid endpoint %s, host is empty", endpoint)
+	}
+
+	return nil
+}
+
 // GetListenAddr for dualstack
 func GetListenAddr(addr string, port int) string {
 	if ip := net.ParseIP(addr); ip == nil {
@@ -50,9 +127,10 @@ func GetListenAddr(addr string, port int) string {
 	}
 
 	if IsIPv6(addr) {
-		// local link ipv6 需要带上 interface， 格式如::%eth0
+		// local link ipv6 需要带上 interface， 格式如::%eth0, 其余地址带上 interface 会导致监听失败
 		ipv6Interface := os.Getenv(ipv6Interface)
-		if ipv6Interface != "" {
+		ip := net.ParseIP(addr)
+		if ipv6Interface != "" && (ip.IsLinkLocalUnicast() || ip.IsUnspecified()) {
 			addr = addr + "%" + ipv6Interface
 		}
 	}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"testing"
+)
+
+func TestGetListenAddr(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"127.0.0.1", "127.0.0.1:8080"},
+		{"::", "[::]:8080"},
+		{"fd00::1", "[fd00::1]:8080"},
+		{"invalid", ""},
+	}
+
+	for _, test := range tests {
+		if result := GetListenAddr(test.input, 8080); result != test.expected {
+			t.Errorf("For input %s, expected %s, but got %s", test.input, test.expected, result)
+		}
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	tests := []struct {
+		input string
+		err   bool
+	}{
+		{"127.0.0.1:3306", false},
+		{"[::1]:3306", false},
+		{"mysql.svc:3306", false},
+		{"::1:3306", true},
+		{"127.0.0.1", true},
+		{":3306", true},
+		{"127.0.0.1:0", true},
+	}
+
+	for _, test := range tests {
+		err := ValidateEndpoint(test.input)
+		if test.err != (err != nil) {
+			t.Errorf("For input %s, expected error: %v, but got %v", test.input, test.err, err)
+		}
+	}
+}
+
+func TestValidateURLEndpoint(t *testing.T) {
+	tests := []struct {
+		input string
+		err   bool
+	}{
+		{"http://127.0.0.1:2379", false},
+		{"https://[fd00::1]:2379", false},
+		{"[fd00::1]:2379", false},
+		{"http://", true},
+		{"fd00::1:2379", true},
+	}
+
+	for _, test := range tests {
+		err := ValidateURLEndpoint(test.input)
+		if test.err != (err != nil) {
+			t.Errorf("For input %s, expected error: %v, but got %v", test.input, test.err, err)
+		}
+	}
+}
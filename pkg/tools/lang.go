@@ -16,16 +16,17 @@ import (
 	"net/http"
 
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/constant"
+	"github.com/TencentBlueKing/bk-bscp/pkg/i18n/localizer"
 )
 
-// GetLangFromReq get language from request, priority: cookie > header
+// GetLangFromReq get language from request, priority: cookie > header > Accept-Language
 func GetLangFromReq(r *http.Request) string {
-	c, err := r.Cookie("blueking_language")
-	if err == nil {
-		return c.Value
+	var cookie string
+	if c, err := r.Cookie(constant.LangCookieKey); err == nil {
+		cookie = c.Value
 	}
 
-	lang := r.Header.Get(constant.LangKey)
+	lang := localizer.Negotiate(cookie, r.Header.Get(constant.LangKey), r.Header.Get(constant.AcceptLanguageKey))
 	if lang == "" {
 		lang = constant.DefaultLanguage
 	}
@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyConfig defines the outbound proxy and the custom CA bundle used to access the repository and
+// download servers, which is shared by the server components and the sdk/agent.
+type ProxyConfig struct {
+	// ProxyURL is the http(s) proxy url, such as http://proxy.example.com:3128, empty means the proxy
+	// is taken from the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// NoProxy is the comma separated hosts, domains and CIDRs which are accessed without the proxy.
+	NoProxy string
+	// CAFile is the PEM encoded CA bundle trusted besides the system root CAs.
+	CAFile string
+	// InsecureSkipVerify skip verifying the server's certificate, for testing only.
+	InsecureSkipVerify bool
+}
+
+// Enable returns whether the proxy config is set.
+func (c ProxyConfig) Enable() bool {
+	return c.ProxyURL != "" || c.CAFile != "" || c.InsecureSkipVerify
+}
+
+// Validate the proxy config.
+func (c ProxyConfig) Validate() error {
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return fmt.Errorf("invalid proxy url %s, err: %v", c.ProxyURL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid proxy url %s, scheme should be http or https", c.ProxyURL)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("invalid proxy url %s, host is required", c.ProxyURL)
+		}
+	}
+
+	if c.NoProxy != "" && c.ProxyURL == "" {
+		return errors.New("no proxy is set without the proxy url")
+	}
+
+	return nil
+}
+
+// NewProxyTransport clone the base transport and set the proxy and the custom CA bundle to it,
+// http.DefaultTransport is cloned if the base is nil.
+func NewProxyTransport(base *http.Transport, c ProxyConfig) (*http.Transport, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	if base == nil {
+		base = http.DefaultTransport.(*http.Transport)
+	}
+	transport := base.Clone()
+
+	if c.ProxyURL != "" {
+		proxy := (&httpproxy.Config{HTTPProxy: c.ProxyURL, HTTPSProxy: c.ProxyURL, NoProxy: c.NoProxy}).ProxyFunc()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxy(req.URL)
+		}
+	}
+
+	if c.CAFile == "" && !c.InsecureSkipVerify {
+		return transport, nil
+	}
+
+	tlsConf := new(tls.Config)
+	if transport.TLSClientConfig != nil {
+		tlsConf = transport.TLSClientConfig.Clone()
+	}
+	tlsConf.InsecureSkipVerify = c.InsecureSkipVerify //nolint
+
+	if c.CAFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		ca, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("read ca file %s failed, err: %v", c.CAFile, err)
+		}
+		if !pool.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("no valid certificate is found in ca file %s", c.CAFile)
+		}
+		tlsConf.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConf
+
+	return transport, nil
+}
@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNewProxyTransport(t *testing.T) {
+	conf := ProxyConfig{ProxyURL: "http://proxy.example.com:3128", NoProxy: "internal.example.com"}
+	transport, err := NewProxyTransport(nil, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, "https://cos.example.com/file", nil)
+	proxy, err := transport.Proxy(req)
+	if err != nil || proxy == nil || proxy.Host != "proxy.example.com:3128" {
+		t.Errorf("request should be sent via the proxy, proxy: %v, err: %v", proxy, err)
+	}
+
+	req, _ = http.NewRequest(http.MethodGet, "https://internal.example.com/file", nil)
+	if proxy, _ := transport.Proxy(req); proxy != nil {
+		t.Errorf("no proxy host should be accessed directly, but got proxy %v", proxy)
+	}
+
+	if _, err := NewProxyTransport(nil, ProxyConfig{ProxyURL: "socks5://127.0.0.1:1080"}); err == nil {
+		t.Errorf("unsupported proxy scheme should be invalid")
+	}
+}
+
+func TestNewProxyTransportCA(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := os.WriteFile(caFile, ca, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// 未信任私有 CA 时访问失败
+	if _, err := (&http.Client{Transport: http.DefaultTransport}).Get(srv.URL); err == nil {
+		t.Fatalf("request should fail without the private ca")
+	}
+
+	transport, err := NewProxyTransport(nil, ProxyConfig{CAFile: caFile})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("request with the private ca failed, err: %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestListenUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run", "feed.sock")
+
+	ln, err := ListenUnix(path, 0600)
+	if err != nil {
+		t.Fatalf("listen unix failed, err: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat socket file failed, err: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected socket file mode 0600, but got %v", info.Mode().Perm())
+	}
+	_ = ln.Close()
+
+	// 非 socket 文件不能被覆盖
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ListenUnix(file, 0600); err == nil {
+		t.Errorf("listen on a regular file should be failed")
+	}
+}
@@ -16,6 +16,7 @@ package types
 import (
 	"time"
 
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/filter"
@@ -123,6 +124,48 @@ type StrategyPart struct {
 	ItsmTicketStateID int         `db:"itsm_ticket_state_id" json:"itsm_ticket_state_id" gorm:"column:itsm_ticket_state_id"` // nolint
 	ApproveType       string      `db:"approve_type" json:"approve_type" gorm:"column:approve_type"`
 	Memo              string      `db:"memo" json:"memo" gorm:"column:memo"`
+	ChangeTicketID    string      `db:"change_ticket_id" json:"change_ticket_id" gorm:"column:change_ticket_id"`
+	ChangeTicketURL   string      `db:"change_ticket_url" json:"change_ticket_url" gorm:"column:change_ticket_url"`
+}
+
+// ListAppTimelineOption defines options to list the app's change timeline.
+type ListAppTimelineOption struct {
+	BizID     uint32
+	AppID     uint32
+	StartTime time.Time
+	EndTime   time.Time
+	Types     []enumor.TimelineEventType
+	Page      *BasePage
+}
+
+// Validate the list app timeline options
+func (o *ListAppTimelineOption) Validate() error {
+	if o.BizID <= 0 {
+		return errf.New(errf.InvalidParameter, "invalid biz id, should >= 1")
+	}
+
+	if o.AppID <= 0 {
+		return errf.New(errf.InvalidParameter, "invalid app id, should >= 1")
+	}
+
+	for _, one := range o.Types {
+		if err := one.Validate(); err != nil {
+			return errf.New(errf.InvalidParameter, err.Error())
+		}
+	}
+
+	if o.Page == nil {
+		return errf.New(errf.InvalidParameter, "page is null")
+	}
+
+	return o.Page.Validate(DefaultPageOption)
+}
+
+// AppTimelineRecord defines the audit with its publish strategy of the app's timeline
+type AppTimelineRecord struct {
+	Audit      AuditPart    `json:"audit" gorm:"embedded"`
+	StrategyID uint32       `json:"strategy_id" gorm:"column:strategy_id"`
+	Strategy   StrategyPart `json:"strategy" gorm:"embedded"`
 }
 
 // TemplateSetSpaceName template space set name
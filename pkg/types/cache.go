@@ -29,6 +29,11 @@ import (
 type AppCacheMeta struct {
 	Name       string           `json:"name"`
 	ConfigType table.ConfigType `json:"cft"`
+	State      table.AppState   `json:"st"`
+	// Pause 服务暂停拉取配置的开关, 未暂停时为空
+	Pause *table.ConsumptionPause `json:"pause,omitempty"`
+	// MinVersion 客户端最低版本, 为空时不限制
+	MinVersion string `json:"mcv,omitempty"`
 }
 
 // ReleasedGroupCache is the released group info which will be stored in cache.
@@ -43,6 +48,7 @@ type ReleasedGroupCache struct {
 	Mode       table.GroupMode    `db:"mode" json:"mode"`
 	Selector   *selector.Selector `db:"selector" json:"selector"`
 	UID        string             `db:"uid" json:"uid"`
+	Pinned     bool               `db:"pinned" json:"pinned"`
 	BizID      uint32             `db:"biz_id" json:"biz_id"`
 	UpdatedAt  time.Time          `db:"updated_at" json:"updated_at"`
 }
@@ -77,6 +83,50 @@ type ReleaseKvCache struct {
 	Revision    *table.Revision     `json:"revision"`
 	Attachment  *table.KvAttachment `json:"am"`
 	ContentSpec *table.ContentSpec  `json:"content_spec"`
+	// Overrides is the override values of the kv for the groups, ordered by priority.
+	Overrides []*ReleaseKvOverrideCache `json:"overrides,omitempty"`
+	// ExpiredAt is the time after which the kv is not served, or the fallback value is served instead.
+	ExpiredAt *time.Time `json:"exp,omitempty"`
+	// ExpiredFallback is the value served after the kv is expired, nil means the kv is not served any more.
+	ExpiredFallback *string `json:"efb,omitempty"`
+}
+
+// Expired returns whether the kv is expired at the given time.
+func (r *ReleaseKvCache) Expired(now time.Time) bool {
+	return r.ExpiredAt != nil && !now.Before(*r.ExpiredAt)
+}
+
+// FallbackContentSpec returns the content spec of the expired fallback value.
+func (r *ReleaseKvCache) FallbackContentSpec() *table.ContentSpec {
+	fallback := lo.FromPtr(r.ExpiredFallback)
+	return &table.ContentSpec{
+		Signature: tools.SHA256(fallback),
+		Md5:       tools.MD5(fallback),
+		ByteSize:  uint64(len(fallback)),
+	}
+}
+
+// ReleaseKvOverrideCache is the released kv override info which will be stored in cache.
+type ReleaseKvOverrideCache struct {
+	GroupID     uint32                 `json:"gid"`
+	Group       *table.KvOverrideGroup `json:"group"`
+	ContentSpec *table.ContentSpec     `json:"content_spec"`
+}
+
+// MatchOverride returns the first override of the kv which matches the instance, nil if none matched,
+// which means the instance uses the base value.
+func (r *ReleaseKvCache) MatchOverride(uid string, labels map[string]string) (*ReleaseKvOverrideCache, error) {
+	for _, one := range r.Overrides {
+		matched, err := one.Group.MatchInstance(uid, labels)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			return one, nil
+		}
+	}
+
+	return nil, nil
 }
 
 // ReleasedHooksCache is the released hooks info which will be stored in cache.
@@ -126,10 +176,11 @@ type FilePermissionCache struct {
 	Privilege string `json:"priv"`
 }
 
-// CredentialCache cache struct.
+// CredentialCache cache struct, Pause is nil when the credential is not paused.
 type CredentialCache struct {
-	Enabled      bool     `json:"enabled"`
-	Scope        []string `json:"scope"`
+	Enabled      bool                    `json:"enabled"`
+	Scope        []string                `json:"scope"`
+	Pause        *table.ConsumptionPause `json:"pause,omitempty"`
 	scopeMap     map[string][]string
 	isPreprocess bool
 }
@@ -168,8 +219,17 @@ func (c *CredentialCache) MatchApp(app string) bool {
 	return ok
 }
 
+// AppScopes 返回凭证在 App 下的路径/键范围
+func (c *CredentialCache) AppScopes(app string) []string {
+	c.preprocess()
+
+	return c.scopeMap[app]
+}
+
 // MatchKv 是否匹配kv
 func (c *CredentialCache) MatchKv(app, key string) bool {
+	c.preprocess()
+
 	scopes, ok := c.scopeMap[app]
 	if !ok {
 		return false
@@ -190,6 +250,8 @@ func (c *CredentialCache) MatchKv(app, key string) bool {
 
 // MatchConfigItem 是否匹配配置文件
 func (c *CredentialCache) MatchConfigItem(app, path, name string) bool {
+	c.preprocess()
+
 	scopes, ok := c.scopeMap[app]
 	if !ok {
 		return false
@@ -241,25 +303,71 @@ func ReleaseCICaches(rs []*table.ReleasedConfigItem, releaseName string) []*Rele
 	return list
 }
 
+// ReleasedGroupCaches convert ReleasedGroup to ReleasedGroupCache.
+func ReleasedGroupCaches(rs []*table.ReleasedGroup) []*ReleasedGroupCache {
+	return lo.Map(rs, func(one *table.ReleasedGroup, index int) *ReleasedGroupCache {
+		return &ReleasedGroupCache{
+			ID:         one.ID,
+			GroupID:    one.GroupID,
+			AppID:      one.AppID,
+			ReleaseID:  one.ReleaseID,
+			StrategyID: one.StrategyID,
+			Mode:       one.Mode,
+			Selector:   one.Selector,
+			UID:        one.UID,
+			Pinned:     one.Pinned,
+			BizID:      one.BizID,
+			UpdatedAt:  one.UpdatedAt,
+		}
+	})
+}
+
 // ReleaseKvCaches convert ReleasedConfigItem to ReleaseKvCache.
 func ReleaseKvCaches(rs []*table.ReleasedKv) []*ReleaseKvCache {
 	// 泛型转换处理
 	list := lo.Map(rs, func(one *table.ReleasedKv, index int) *ReleaseKvCache {
 		return &ReleaseKvCache{
-			ID:          one.ID,
-			ReleaseID:   one.ReleaseID,
-			Key:         one.Spec.Key,
-			Memo:        one.Spec.Memo,
-			KvType:      string(one.Spec.KvType),
-			Revision:    one.Revision,
-			Attachment:  one.Attachment,
-			ContentSpec: one.ContentSpec,
+			ID:              one.ID,
+			ReleaseID:       one.ReleaseID,
+			Key:             one.Spec.Key,
+			Memo:            one.Spec.Memo,
+			KvType:          string(one.Spec.KvType),
+			Revision:        one.Revision,
+			Attachment:      one.Attachment,
+			ContentSpec:     one.ContentSpec,
+			ExpiredAt:       one.Spec.ExpiredAt,
+			ExpiredFallback: one.Spec.ExpiredFallback,
 		}
 	})
 
 	return list
 }
 
+// AttachReleaseKvOverrides attach the released kv overrides to the released kvs of the same release,
+// the overrides should be ordered by priority.
+func AttachReleaseKvOverrides(list []*ReleaseKvCache, overrides []*table.ReleasedKvOverride) {
+	if len(overrides) == 0 {
+		return
+	}
+
+	index := make(map[string]*ReleaseKvCache, len(list))
+	for _, one := range list {
+		index[fmt.Sprintf("%d-%s", one.ReleaseID, one.Key)] = one
+	}
+
+	for _, one := range overrides {
+		kv, ok := index[fmt.Sprintf("%d-%s", one.ReleaseID, one.Spec.Key)]
+		if !ok {
+			continue
+		}
+		kv.Overrides = append(kv.Overrides, &ReleaseKvOverrideCache{
+			GroupID:     one.Spec.GroupID,
+			Group:       one.Group,
+			ContentSpec: one.ContentSpec,
+		})
+	}
+}
+
 // ReleaseKvValueCache is the release kv value info which will be stored in cache.
 type ReleaseKvValueCache struct {
 	ID        uint32 `json:"id"`
@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCredentialCacheScopes(t *testing.T) {
+	c := &CredentialCache{Enabled: true, Scope: []string{"shared/team-a/**", "shared/common.yaml", "other/**"}}
+
+	if !reflect.DeepEqual(c.AppScopes("shared"), []string{"team-a/**", "common.yaml"}) {
+		t.Errorf("unexpected scopes of app shared: %v", c.AppScopes("shared"))
+	}
+
+	cases := []struct {
+		path string
+		name string
+		want bool
+	}{
+		{path: "/team-a/etc", name: "a.yaml", want: true},
+		{path: "/", name: "common.yaml", want: true},
+		{path: "/team-b", name: "b.yaml", want: false},
+	}
+	for _, one := range cases {
+		if got := c.MatchConfigItem("shared", one.path, one.name); got != one.want {
+			t.Errorf("match %s/%s, want %v, got %v", one.path, one.name, one.want, got)
+		}
+	}
+
+	kv := &CredentialCache{Enabled: true, Scope: []string{"shared/team_a.*"}}
+	if !kv.MatchKv("shared", "team_a.timeout") || kv.MatchKv("shared", "team_b.timeout") {
+		t.Errorf("kv should be restricted by the key prefix")
+	}
+}
@@ -80,3 +80,24 @@ type PrimaryAndForeign struct {
 	PrimaryVal, ForeignVal string
 	Count                  int
 }
+
+// AppConsumptionSum 服务在统计周期内的消费汇总
+type AppConsumptionSum struct {
+	AppID         uint32 `json:"app_id"`
+	PullCount     uint64 `json:"pull_count"`
+	DownloadBytes uint64 `json:"download_bytes"`
+}
+
+// BizConsumptionSum 业务在统计周期内的消费汇总
+type BizConsumptionSum struct {
+	BizID         uint32 `json:"biz_id"`
+	PullCount     uint64 `json:"pull_count"`
+	DownloadBytes uint64 `json:"download_bytes"`
+	WatchSeconds  uint64 `json:"watch_seconds"`
+}
+
+// BizStorageSum 业务存储的配置内容大小
+type BizStorageSum struct {
+	BizID        uint32 `json:"biz_id"`
+	StorageBytes uint64 `json:"storage_bytes"`
+}
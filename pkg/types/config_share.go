@@ -0,0 +1,46 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import "errors"
+
+// ListConfigSharesOption defines options to list config shares.
+type ListConfigSharesOption struct {
+	// BizID is the owner biz of the config shares.
+	BizID uint32 `json:"biz_id"`
+	// GranteeBizID lists the config shares granted to the biz, it's exclusive with BizID.
+	GranteeBizID uint32    `json:"grantee_biz_id"`
+	AppID        uint32    `json:"app_id"`
+	Page         *BasePage `json:"page"`
+}
+
+// Validate the list config shares options
+func (opt *ListConfigSharesOption) Validate(po *PageOption) error {
+	if opt.BizID <= 0 && opt.GranteeBizID <= 0 {
+		return errors.New("biz id or grantee biz id should be set")
+	}
+
+	if opt.BizID > 0 && opt.GranteeBizID > 0 {
+		return errors.New("biz id and grantee biz id can not be set at the same time")
+	}
+
+	if opt.Page == nil {
+		return errors.New("page is null")
+	}
+
+	if err := opt.Page.Validate(po); err != nil {
+		return err
+	}
+
+	return nil
+}
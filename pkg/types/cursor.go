@@ -0,0 +1,248 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/enumor"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/filter"
+)
+
+const (
+	// DefaultMaxCursorLimit is the default max limit of a cursor page.
+	DefaultMaxCursorLimit = uint(500)
+	// DefaultCursorLimit is the default limit of a cursor page when the limit is not set.
+	DefaultCursorLimit = uint(100)
+	// maxSortFields is the max number of the sort fields.
+	maxSortFields = 3
+)
+
+// SortField defines a field to sort the queried resources.
+type SortField struct {
+	Field string `json:"field"`
+	Order Order  `json:"order"`
+}
+
+// ParseSort parse the sort expression like "name,-created_at",
+// the field prefixed with '-' is sorted by descending, others are sorted by ascending.
+func ParseSort(sort string) ([]SortField, error) {
+	fields := make([]SortField, 0)
+	exists := make(map[string]bool)
+	for _, one := range strings.Split(sort, ",") {
+		one = strings.TrimSpace(one)
+		if one == "" {
+			continue
+		}
+
+		sf := SortField{Field: strings.TrimLeft(one, "+-"), Order: Ascending}
+		if strings.HasPrefix(one, "-") {
+			sf.Order = Descending
+		}
+		if sf.Field == "" {
+			return nil, fmt.Errorf("invalid sort field: %s", one)
+		}
+		if exists[sf.Field] {
+			return nil, fmt.Errorf("sort field %s is repeated", sf.Field)
+		}
+		exists[sf.Field] = true
+		fields = append(fields, sf)
+	}
+
+	if len(fields) > maxSortFields {
+		return nil, fmt.Errorf("at most %d sort fields are supported", maxSortFields)
+	}
+
+	return fields, nil
+}
+
+// Cursor records the sort field values and id of the last resource of the previous page.
+type Cursor struct {
+	Values []interface{} `json:"v,omitempty"`
+	ID     uint32        `json:"id"`
+}
+
+// Encode the cursor to an opaque string.
+func (c *Cursor) Encode() (string, error) {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// DecodeCursor decode the cursor from an opaque string.
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	c := new(Cursor)
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+	return c, nil
+}
+
+// CursorOption defines the fields which can be used to sort and filter by the cursor page.
+type CursorOption struct {
+	// Fields is the sortable and filterable fields of the resource, key is the column name.
+	Fields map[string]enumor.ColumnType
+	// MaxLimit defines max limit value of a page, if not set, use DefaultMaxCursorLimit.
+	MaxLimit uint
+}
+
+// CursorPage defines the cursor based page to query resources, the cursor page uses the sort
+// fields and id of the last resource as the query condition instead of the offset, so that the
+// query performance does not decrease when the page goes deeper.
+type CursorPage struct {
+	// Cursor is returned by the previous page, empty means the first page.
+	Cursor string `json:"cursor"`
+	// Limit is the max number of resources returned in a page.
+	Limit uint `json:"limit"`
+	// Sort is the sort expression, eg: name,-created_at, id is always used as the last sort field.
+	Sort string `json:"sort"`
+	// Filter is the json format filter expression, see more in pkg/runtime/filter.
+	Filter string `json:"filter"`
+	// Fields is the returned fields of the resource, empty means all the fields.
+	Fields []string `json:"fields"`
+
+	sorts  []SortField
+	expr   *filter.Expression
+	cursor *Cursor
+}
+
+// Validate the cursor page and parse the sort, filter and cursor.
+func (cp *CursorPage) Validate(opt *CursorOption) error {
+	if opt == nil {
+		return errors.New("cursor option is required")
+	}
+
+	maxLimit := DefaultMaxCursorLimit
+	if opt.MaxLimit > 0 {
+		maxLimit = opt.MaxLimit
+	}
+	if cp.Limit == 0 {
+		cp.Limit = DefaultCursorLimit
+	}
+	if cp.Limit > maxLimit {
+		return fmt.Errorf("invalid page.limit max value: %d", maxLimit)
+	}
+
+	sorts, err := ParseSort(cp.Sort)
+	if err != nil {
+		return err
+	}
+	for _, s := range sorts {
+		if _, ok := opt.Fields[s.Field]; !ok && s.Field != "id" {
+			return fmt.Errorf("sort field %s is not supported", s.Field)
+		}
+	}
+	// id 作为最后的排序字段, 保证排序结果唯一
+	if len(sorts) == 0 || sorts[len(sorts)-1].Field != "id" {
+		sorts = append(sorts, SortField{Field: "id", Order: Ascending})
+	}
+	cp.sorts = sorts
+
+	if cp.Filter != "" {
+		expr := new(filter.Expression)
+		if err = expr.UnmarshalJSON([]byte(cp.Filter)); err != nil {
+			return fmt.Errorf("invalid filter, err: %v", err)
+		}
+		if err = expr.Validate(&filter.ExprOption{RuleFields: opt.Fields}); err != nil {
+			return err
+		}
+		cp.expr = expr
+	}
+
+	if cp.Cursor != "" {
+		c, err := DecodeCursor(cp.Cursor)
+		if err != nil {
+			return err
+		}
+		if len(c.Values) != len(cp.sorts)-1 {
+			return errors.New("cursor does not match the sort fields")
+		}
+		// 时间类型的值需要转换为时间, 避免按字符串比较
+		for i, v := range c.Values {
+			if opt.Fields[cp.sorts[i].Field] != enumor.Time {
+				continue
+			}
+			s, ok := v.(string)
+			if !ok {
+				return errors.New("invalid cursor")
+			}
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return errors.New("invalid cursor")
+			}
+			c.Values[i] = t
+		}
+		cp.cursor = c
+	}
+
+	return nil
+}
+
+// SortFields returns the parsed sort fields, the last one is always id.
+func (cp *CursorPage) SortFields() []SortField {
+	return cp.sorts
+}
+
+// FilterExpr returns the parsed filter expression, nil means no filter.
+func (cp *CursorPage) FilterExpr() *filter.Expression {
+	return cp.expr
+}
+
+// DecodedCursor returns the decoded cursor, nil means the first page.
+func (cp *CursorPage) DecodedCursor() *Cursor {
+	return cp.cursor
+}
+
+var (
+	// AppCursorOption defines the sortable and filterable fields of app.
+	AppCursorOption = &CursorOption{Fields: map[string]enumor.ColumnType{
+		"name": enumor.String, "alias": enumor.String, "config_type": enumor.String, "creator": enumor.String,
+		"reviser": enumor.String, "created_at": enumor.Time, "updated_at": enumor.Time,
+	}}
+
+	// ReleaseCursorOption defines the sortable and filterable fields of release.
+	ReleaseCursorOption = &CursorOption{Fields: map[string]enumor.ColumnType{
+		"name": enumor.String, "deprecated": enumor.Boolean, "publish_num": enumor.Numeric,
+		"creator": enumor.String, "created_at": enumor.Time,
+	}}
+
+	// ConfigItemCursorOption defines the sortable and filterable fields of config item.
+	ConfigItemCursorOption = &CursorOption{Fields: map[string]enumor.ColumnType{
+		"name": enumor.String, "path": enumor.String, "file_type": enumor.String, "creator": enumor.String,
+		"reviser": enumor.String, "created_at": enumor.Time, "updated_at": enumor.Time,
+	}}
+
+	// KvCursorOption defines the sortable and filterable fields of kv.
+	KvCursorOption = &CursorOption{Fields: map[string]enumor.ColumnType{
+		"key": enumor.String, "kv_type": enumor.String, "kv_state": enumor.String, "creator": enumor.String,
+		"reviser": enumor.String, "created_at": enumor.Time, "updated_at": enumor.Time,
+	}}
+
+	// CredentialCursorOption defines the sortable and filterable fields of credential.
+	CredentialCursorOption = &CursorOption{Fields: map[string]enumor.ColumnType{
+		"name": enumor.String, "enable": enumor.Boolean, "creator": enumor.String, "reviser": enumor.String,
+		"created_at": enumor.Time, "updated_at": enumor.Time,
+	}}
+)
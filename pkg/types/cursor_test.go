@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package types
+
+import (
+	"testing"
+)
+
+func TestParseSort(t *testing.T) {
+	sorts, err := ParseSort("name, -created_at")
+	if err != nil {
+		t.Fatalf("parse sort failed, err: %v", err)
+	}
+	if len(sorts) != 2 || sorts[0].Order != Ascending || sorts[1].Field != "created_at" ||
+		sorts[1].Order != Descending {
+		t.Errorf("unexpected sort fields: %+v", sorts)
+	}
+
+	if _, err = ParseSort("name,-name"); err == nil {
+		t.Error("repeated sort field should be invalid")
+	}
+	if _, err = ParseSort("a,b,c,d"); err == nil {
+		t.Error("too many sort fields should be invalid")
+	}
+}
+
+func TestCursorPageValidate(t *testing.T) {
+	cp := &CursorPage{Sort: "-created_at"}
+	if err := cp.Validate(AppCursorOption); err != nil {
+		t.Fatalf("validate cursor page failed, err: %v", err)
+	}
+	if cp.Limit != DefaultCursorLimit {
+		t.Errorf("limit should be defaulted to %d, got %d", DefaultCursorLimit, cp.Limit)
+	}
+	if sorts := cp.SortFields(); len(sorts) != 2 || sorts[1].Field != "id" {
+		t.Errorf("id should be appended as the last sort field, got %+v", sorts)
+	}
+
+	c := &Cursor{Values: []interface{}{"2024-01-02T03:04:05Z"}, ID: 10}
+	encoded, err := c.Encode()
+	if err != nil {
+		t.Fatalf("encode cursor failed, err: %v", err)
+	}
+	cp = &CursorPage{Sort: "-created_at", Cursor: encoded}
+	if err = cp.Validate(AppCursorOption); err != nil {
+		t.Fatalf("validate cursor page failed, err: %v", err)
+	}
+	if cp.DecodedCursor().ID != 10 {
+		t.Errorf("unexpected cursor id: %d", cp.DecodedCursor().ID)
+	}
+
+	// 游标与排序字段不匹配
+	cp = &CursorPage{Sort: "name,-created_at", Cursor: encoded}
+	if err = cp.Validate(AppCursorOption); err == nil {
+		t.Error("cursor not matched with sort fields should be invalid")
+	}
+
+	cp = &CursorPage{Sort: "unknown"}
+	if err = cp.Validate(AppCursorOption); err == nil {
+		t.Error("unsupported sort field should be invalid")
+	}
+
+	cp = &CursorPage{Filter: `{"op":"and","rules":[{"field":"name","op":"eq","value":"demo"}]}`}
+	if err = cp.Validate(AppCursorOption); err != nil || cp.FilterExpr() == nil {
+		t.Errorf("validate filter failed, err: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package types
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// FullTextSearchOption defines options to search the full-text docs.
+type FullTextSearchOption struct {
+	BizID uint32 `json:"biz_id"`
+	// AppIDs is the apps which the searcher has permission to view, must not be empty.
+	AppIDs  []uint32              `json:"app_ids"`
+	Keyword string                `json:"keyword"`
+	ResType table.FullTextResType `json:"res_type"`
+	Page    *BasePage             `json:"page"`
+}
+
+// Validate the full-text search options
+func (opt *FullTextSearchOption) Validate(po *PageOption) error {
+	if opt.BizID <= 0 {
+		return errors.New("invalid biz id, should >= 1")
+	}
+
+	if len(opt.AppIDs) == 0 {
+		return errors.New("app ids is empty")
+	}
+
+	if len(opt.Keyword) == 0 {
+		return errors.New("keyword is empty")
+	}
+
+	if opt.ResType != "" {
+		if err := opt.ResType.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if opt.Page == nil {
+		return errors.New("page is null")
+	}
+
+	if err := opt.Page.Validate(po); err != nil {
+		return err
+	}
+
+	return nil
+}
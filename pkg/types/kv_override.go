@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"errors"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+)
+
+// UpsertKvOverrideOption is used to define options for saving the kv override value to vault.
+type UpsertKvOverrideOption struct {
+	BizID uint32
+	AppID uint32
+	// ReleaseID is set when save the released kv override value.
+	ReleaseID uint32
+	Key       string
+	GroupID   uint32
+	Value     string
+	KvType    table.DataType
+}
+
+// Validate is used to validate the effectiveness of the UpsertKvOverrideOption structure.
+func (o *UpsertKvOverrideOption) Validate() error {
+	if o.BizID <= 0 {
+		return errors.New("invalid biz id, should >= 1")
+	}
+
+	if o.AppID <= 0 {
+		return errors.New("invalid app id, should >= 1")
+	}
+
+	if o.Key == "" {
+		return errors.New("kv key is required")
+	}
+
+	if o.GroupID <= 0 {
+		return errors.New("invalid group id, should >= 1")
+	}
+
+	if o.Value == "" {
+		return errors.New("kv value is required")
+	}
+
+	return o.KvType.ValidateValue(o.Value)
+}
+
+// GetKvOverrideOption is used to define options for getting the kv override value from vault.
+type GetKvOverrideOption struct {
+	BizID uint32
+	AppID uint32
+	// ReleaseID is set when get the released kv override value.
+	ReleaseID uint32
+	Key       string
+	GroupID   uint32
+	Version   int
+}
+
+// Validate is used to validate the effectiveness of the GetKvOverrideOption structure.
+func (o *GetKvOverrideOption) Validate() error {
+	if o.BizID <= 0 {
+		return errors.New("invalid biz id, should >= 1")
+	}
+
+	if o.AppID <= 0 {
+		return errors.New("invalid app id, should >= 1")
+	}
+
+	if o.Key == "" {
+		return errors.New("kv key is required")
+	}
+
+	if o.GroupID <= 0 {
+		return errors.New("invalid group id, should >= 1")
+	}
+
+	if o.Version <= 0 {
+		return errors.New("invalid version, should >= 1")
+	}
+
+	return nil
+}
+
+// DeleteKvOverrideOption is used to define options for deleting the kv override value from vault.
+type DeleteKvOverrideOption struct {
+	BizID   uint32
+	AppID   uint32
+	Key     string
+	GroupID uint32
+}
+
+// Validate is used to validate the effectiveness of the DeleteKvOverrideOption structure.
+func (o *DeleteKvOverrideOption) Validate() error {
+	if o.BizID <= 0 {
+		return errors.New("invalid biz id, should >= 1")
+	}
+
+	if o.AppID <= 0 {
+		return errors.New("invalid app id, should >= 1")
+	}
+
+	if o.Key == "" {
+		return errors.New("kv key is required")
+	}
+
+	if o.GroupID <= 0 {
+		return errors.New("invalid group id, should >= 1")
+	}
+
+	return nil
+}
@@ -37,6 +37,8 @@ type PublishOption struct {
 	ApproverProgress string                 `json:"approver_progress"`
 	PubState         string                 `json:"pub_state"`
 	ApproveType      string                 `json:"approve_type"`
+	ChangeTicketID   string                 `json:"change_ticket_id"`
+	ChangeTicketURL  string                 `json:"change_ticket_url"`
 }
 
 // Validate options is valid or not.
@@ -63,4 +63,6 @@ type ListReleasesStrategies struct {
 	Creator           string      `gorm:"column:creator" json:"creator"`
 	FullyReleased     bool        `gorm:"column:fully_released" json:"fully_released"`
 	FinalApprovalTime time.Time   `gorm:"column:final_approval_time" json:"final_approval_time"`
+	AppID             uint32      `gorm:"column:app_id" json:"app_id"`
+	ReleaseID         uint32      `gorm:"column:release_id" json:"release_id"`
 }
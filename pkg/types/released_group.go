@@ -13,9 +13,15 @@
 package types
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
 	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
 	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
 	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/filter"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
 )
 
 // CountGroupsReleasedAppsOption defines options to count each group's published apps.
@@ -65,3 +71,174 @@ func (opt *ListReleasedGroupsOption) Validate() error {
 
 	return nil
 }
+
+// MatchReleasedGroup match at most one released group with the app instance's uid and labels, which is the
+// strategy used by feed server to decide the release of the instance:
+// 1. the released groups are sorted by update time, the latest one takes precedence, except the pinned groups,
+// which always take precedence over the others.
+// 2. the debug and custom groups matched by the instance take precedence over the default group.
+// returns errf.ErrAppInstanceNotMatchedRelease if none of the groups is matched.
+func MatchReleasedGroup(groups []*ReleasedGroupCache, uid string, labels map[string]string) (
+	*ReleasedGroupCache, error) {
+	return matchReleasedGroup(groups, uid, labels, nil)
+}
+
+// GroupMatchTrace is the trace of a released group evaluated when match the instance's release.
+type GroupMatchTrace struct {
+	GroupID    uint32          `json:"group_id"`
+	ReleaseID  uint32          `json:"release_id"`
+	StrategyID uint32          `json:"strategy_id"`
+	Mode       table.GroupMode `json:"mode"`
+	UpdatedAt  time.Time       `json:"updated_at"`
+	// Matched defines whether the group matches the instance.
+	Matched bool `json:"matched"`
+	// Selected defines whether the group is the one which decides the instance's release.
+	Selected bool `json:"selected"`
+	// Reason explains the match result of the group.
+	Reason string `json:"reason"`
+	// Selector is the evaluation detail of the custom group's selector.
+	Selector *selector.Explanation `json:"selector,omitempty"`
+}
+
+// ExplainReleasedGroupMatch matches the released group in the same way as MatchReleasedGroup, and returns the
+// trace of all the evaluated groups in the order of precedence at the same time, the traces are also returned
+// with errf.ErrAppInstanceNotMatchedRelease when none of the groups is matched.
+func ExplainReleasedGroupMatch(groups []*ReleasedGroupCache, uid string, labels map[string]string) (
+	*ReleasedGroupCache, []*GroupMatchTrace, error) {
+
+	traces := make([]*GroupMatchTrace, 0, len(groups))
+	matched, err := matchReleasedGroup(groups, uid, labels, &traces)
+	if err != nil && !errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
+		return nil, nil, err
+	}
+
+	for _, one := range traces {
+		switch {
+		case matched != nil && one.GroupID == matched.GroupID && one.StrategyID == matched.StrategyID:
+			one.Selected = true
+			one.Reason += ", selected"
+		case one.Matched && matched != nil:
+			one.Reason += fmt.Sprintf(", but overridden by group %d which takes precedence", matched.GroupID)
+		}
+	}
+
+	return matched, traces, err
+}
+
+// matchReleasedGroup match the released group, the groups are all evaluated and recorded in the traces if
+// traces is not nil, otherwise returns as soon as the group is matched.
+func matchReleasedGroup(groups []*ReleasedGroupCache, uid string, labels map[string]string,
+	traces *[]*GroupMatchTrace) (*ReleasedGroupCache, error) {
+
+	// 固定版本的分组不受后续上线影响, 优先匹配
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Pinned != groups[j].Pinned {
+			return groups[i].Pinned
+		}
+		return groups[i].UpdatedAt.After(groups[j].UpdatedAt)
+	})
+
+	var hit, def *ReleasedGroupCache
+	for _, group := range groups {
+		var matched bool
+		var exp *selector.Explanation
+		switch group.Mode {
+		case table.GroupModeDebug:
+			matched = group.UID == uid
+		case table.GroupModeCustom:
+			if group.Selector == nil {
+				return nil, errf.New(errf.InvalidParameter, "custom group must have selector")
+			}
+			var err error
+			if traces == nil {
+				matched, err = group.Selector.MatchInstance(uid, labels)
+			} else {
+				exp, err = group.Selector.ExplainInstance(uid, labels)
+				matched = exp != nil && exp.Matched
+			}
+			if err != nil {
+				return nil, err
+			}
+		case table.GroupModeDefault:
+			def = group
+		}
+
+		if traces != nil {
+			*traces = append(*traces, newGroupMatchTrace(group, uid, matched, exp))
+		}
+
+		if matched && hit == nil {
+			hit = group
+			if traces == nil {
+				return hit, nil
+			}
+		}
+	}
+
+	if hit != nil {
+		return hit, nil
+	}
+
+	if def == nil {
+		return nil, errf.ErrAppInstanceNotMatchedRelease
+	}
+
+	return def, nil
+}
+
+func newGroupMatchTrace(group *ReleasedGroupCache, uid string, matched bool,
+	exp *selector.Explanation) *GroupMatchTrace {
+
+	trace := &GroupMatchTrace{
+		GroupID:    group.GroupID,
+		ReleaseID:  group.ReleaseID,
+		StrategyID: group.StrategyID,
+		Mode:       group.Mode,
+		UpdatedAt:  group.UpdatedAt,
+		Matched:    matched,
+		Selector:   exp,
+	}
+
+	switch group.Mode {
+	case table.GroupModeDebug:
+		if matched {
+			trace.Reason = fmt.Sprintf("debug group uid %q equals to the instance uid %q", group.UID, uid)
+		} else {
+			trace.Reason = fmt.Sprintf("debug group uid %q not equals to the instance uid %q", group.UID, uid)
+		}
+	case table.GroupModeCustom:
+		trace.Reason = explainSelectorReason(exp)
+	case table.GroupModeDefault:
+		trace.Matched = true
+		trace.Reason = "default group matches all the instances, it takes effect when no other group matched"
+	default:
+		trace.Reason = fmt.Sprintf("unknown group mode %s, skipped", group.Mode)
+	}
+
+	return trace
+}
+
+func explainSelectorReason(exp *selector.Explanation) string {
+	var reason string
+	switch {
+	case exp.MatchAll:
+		reason = "selector matches all the instances"
+	case exp.InInstances:
+		reason = "instance uid is in the selector's instance list"
+	case exp.LabelsMatched:
+		reason = "instance labels are matched by the selector"
+	default:
+		return "neither the instance uid nor the labels are matched by the selector"
+	}
+
+	if exp.Rollout != nil {
+		if exp.Rollout.Hit {
+			reason += fmt.Sprintf(", and hit by the %v%% rollout", exp.Rollout.Percent)
+		} else {
+			reason += fmt.Sprintf(", but not hit by the %v%% rollout(bucket %d)", exp.Rollout.Percent,
+				exp.Rollout.Bucket)
+		}
+	}
+
+	return reason
+}
@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making Blueking Container Service available.
+ * Copyright (C) 2019 THL A29 Limited, a Tencent company. All rights reserved.
+ * Licensed under the MIT License (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ * http://opensource.org/licenses/MIT
+ * Unless required by applicable law or agreed to in writing, software distributed under
+ * the License is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND,
+ * either express or implied. See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/TencentBlueKing/bk-bscp/pkg/criteria/errf"
+	"github.com/TencentBlueKing/bk-bscp/pkg/dal/table"
+	"github.com/TencentBlueKing/bk-bscp/pkg/runtime/selector"
+)
+
+func TestMatchReleasedGroup(t *testing.T) {
+	now := time.Now()
+	envProd := &selector.Selector{LabelsAnd: []selector.Element{
+		{Key: "env", Op: new(selector.EqualType), Value: "prod"},
+	}}
+	groups := []*ReleasedGroupCache{
+		{GroupID: 0, ReleaseID: 1, Mode: table.GroupModeDefault, UpdatedAt: now.Add(-3 * time.Hour)},
+		{GroupID: 1, ReleaseID: 2, Mode: table.GroupModeCustom, Selector: envProd, UpdatedAt: now.Add(-2 * time.Hour)},
+		{GroupID: 2, ReleaseID: 3, Mode: table.GroupModeDebug, UID: "uid1", UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	cases := []struct {
+		uid     string
+		labels  map[string]string
+		release uint32
+	}{
+		{uid: "uid1", labels: map[string]string{"env": "prod"}, release: 3},
+		{uid: "uid2", labels: map[string]string{"env": "prod"}, release: 2},
+		{uid: "uid2", labels: map[string]string{"env": "test"}, release: 1},
+	}
+	for _, c := range cases {
+		matched, err := MatchReleasedGroup(groups, c.uid, c.labels)
+		if err != nil {
+			t.Fatalf("match released group failed, err: %v", err)
+		}
+		if matched.ReleaseID != c.release {
+			t.Errorf("client %s %v should match release %d, got %d", c.uid, c.labels, c.release, matched.ReleaseID)
+		}
+	}
+
+	// 固定版本的分组优先于后上线的分组
+	pinned := []*ReleasedGroupCache{
+		{GroupID: 1, ReleaseID: 2, Mode: table.GroupModeCustom, Selector: envProd, Pinned: true,
+			UpdatedAt: now.Add(-2 * time.Hour)},
+		{GroupID: 3, ReleaseID: 4, Mode: table.GroupModeCustom, Selector: envProd, UpdatedAt: now},
+	}
+	matched, err := MatchReleasedGroup(pinned, "uid2", map[string]string{"env": "prod"})
+	if err != nil || matched.GroupID != 1 {
+		t.Errorf("pinned group should take precedence, got %+v, err: %v", matched, err)
+	}
+
+	// 没有默认分组时未匹配任何分组
+	custom := []*ReleasedGroupCache{{GroupID: 1, ReleaseID: 2, Mode: table.GroupModeCustom, Selector: envProd}}
+	_, err = MatchReleasedGroup(custom, "uid2", map[string]string{"env": "test"})
+	if !errors.Is(err, errf.ErrAppInstanceNotMatchedRelease) {
+		t.Errorf("expect not matched error, got %v", err)
+	}
+}
+
+func TestExplainReleasedGroupMatch(t *testing.T) {
+	now := time.Now()
+	envProd := &selector.Selector{LabelsAnd: []selector.Element{
+		{Key: "env", Op: new(selector.EqualType), Value: "prod"},
+	}}
+	groups := []*ReleasedGroupCache{
+		{GroupID: 0, ReleaseID: 1, Mode: table.GroupModeDefault, UpdatedAt: now.Add(-3 * time.Hour)},
+		{GroupID: 1, ReleaseID: 2, Mode: table.GroupModeCustom, Selector: envProd, UpdatedAt: now.Add(-2 * time.Hour)},
+		{GroupID: 2, ReleaseID: 3, Mode: table.GroupModeDebug, UID: "uid1", UpdatedAt: now.Add(-time.Hour)},
+	}
+
+	matched, traces, err := ExplainReleasedGroupMatch(groups, "uid2", map[string]string{"env": "prod"})
+	if err != nil {
+		t.Fatalf("explain released group match failed, err: %v", err)
+	}
+	if matched.GroupID != 1 || len(traces) != 3 {
+		t.Fatalf("unexpected match result, group: %d, traces: %d", matched.GroupID, len(traces))
+	}
+
+	// 按优先级排列: 调试分组、自定义分组、默认分组
+	if traces[0].Matched || !traces[1].Selected || traces[1].Selector == nil || !traces[2].Matched ||
+		traces[2].Selected {
+		t.Errorf("unexpected traces: %+v, %+v, %+v", traces[0], traces[1], traces[2])
+	}
+}
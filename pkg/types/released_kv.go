@@ -26,6 +26,15 @@ type CreateReleasedKvOption struct {
 	Key       string
 	Value     string
 	KvType    table.DataType
+	// Base is the same key released in the previous release, the value may be stored as a delta of it.
+	Base *ReleasedKvBase
+}
+
+// ReleasedKvBase defines the base released kv of a delta stored released kv.
+type ReleasedKvBase struct {
+	ReleaseID uint32
+	Version   int
+	Value     string
 }
 
 // Validate is used to validate the effectiveness of the CreateReleasedKvOption structure.
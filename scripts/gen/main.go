@@ -64,6 +64,38 @@ func main() {
 		table.ClientEvent{},
 		table.ClientQuery{},
 		table.Config{},
+		table.HookExecResult{},
+		table.HookTemplate{},
+		table.HookTemplateRef{},
+		table.EmergencyPublish{},
+		table.Environment{},
+		table.EnvAppBinding{},
+		table.ConfigShare{},
+		table.ConfigShareRef{},
+		table.RecycleBin{},
+		table.AppSnapshot{},
+		table.Draft{},
+		table.FullTextDoc{},
+		table.ConfigReference{},
+		table.Certificate{},
+		table.KvOverride{},
+		table.ReleasedKvOverride{},
+		table.CompliancePolicy{},
+		table.SecretScanSetting{},
+		table.AppConsumptionStat{},
+		table.StaleResource{},
+		table.BizUsage{},
+		table.ServiceAccount{},
+		table.AppRoleBinding{},
+		table.IdempotencyRecord{},
+		table.Operation{},
+		table.NotificationChannel{},
+		table.AlertRule{},
+		table.ClientQuarantine{},
+		table.Experiment{},
+		table.ExperimentExposure{},
+		table.MaskingProfile{},
+		table.FileTamperEvent{},
 	)
 
 	g.Execute()
@@ -22,6 +22,7 @@ import (
 	"github.com/TencentBlueKing/bk-bscp/cmd/feed-server/bll/types"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest"
 	"github.com/TencentBlueKing/bk-bscp/pkg/rest/client"
+	sfs "github.com/TencentBlueKing/bk-bscp/pkg/sf-share"
 	"github.com/TencentBlueKing/bk-bscp/pkg/tools"
 )
 
@@ -70,6 +71,39 @@ func (c *Client) ListAppFileLatestRelease(ctx context.Context, header http.Heade
 	return pbResp, nil
 }
 
+// LongPollWatch watch the release changes with long poll, it is the last resort transport when neither
+// grpc nor persistent connections can be used, the returned cursor should be carried in the next request.
+func (c *Client) LongPollWatch(ctx context.Context, header http.Header, req *sfs.LongPollWatchReq) (
+	*sfs.LongPollWatchResp, error) {
+
+	timeout := req.TimeoutSec
+	if timeout == 0 {
+		timeout = sfs.DefaultLongPollTimeoutSec
+	}
+
+	resp := c.feedClient.Post().
+		WithContext(ctx).
+		SubResourcef("/longpoll/watch").
+		WithHeaders(header).
+		// 预留请求在服务端排队及网络传输的时间
+		WithTimeout(time.Duration(timeout)*time.Second + 5*time.Second).
+		Body(req).
+		Do()
+
+	pollResp := &struct {
+		Data *sfs.LongPollWatchResp `json:"data"`
+	}{}
+	if err := resp.Into(pollResp); err != nil {
+		return nil, err
+	}
+
+	if pollResp.Data == nil {
+		return nil, errors.New("long poll watch response data is empty")
+	}
+
+	return pollResp.Data, nil
+}
+
 type discovery struct {
 	server string
 }
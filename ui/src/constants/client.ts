@@ -42,6 +42,10 @@ export const CLIENT_ERROR_CATEGORY_MAP = [
     name: localT('未知错误'),
     value: 'UnknownFailed',
   },
+  {
+    name: localT('配置校验失败'),
+    value: 'VerifyFailed',
+  },
 ];
 
 // 错误子类映射
@@ -126,6 +130,14 @@ export const CLIENT_ERROR_SUBCLASSES_MAP = [
     name: localT('未知特殊错误'),
     value: 'UnknownSpecificFailed',
   },
+  {
+    name: localT('校验回调未通过'),
+    value: 'VerifyCallbackFailed',
+  },
+  {
+    name: localT('回滚暂存文件失败'),
+    value: 'RollbackStagedFailed',
+  },
 ];
 
 // 客户端组件类型映射
@@ -1250,6 +1250,9 @@ export default {
   token无权限: 'TokenPermissionFailed',
   sdk版本太低: 'SDKVersionIsTooLowFailed',
   未知特殊错误: 'UnknownSpecificFailed',
+  配置校验失败: 'VerifyFailed',
+  校验回调未通过: 'VerifyCallbackFailed',
+  回滚暂存文件失败: 'RollbackStagedFailed',
   错误类别: 'Error category',
   错误子类别: 'Error subclass',
   错误详情: 'Error details',
@@ -1257,6 +1257,9 @@ export default {
   token无权限: 'token无权限',
   sdk版本太低: 'sdk版本太低',
   未知特殊错误: '未知特殊错误',
+  配置校验失败: '配置校验失败',
+  校验回调未通过: '校验回调未通过',
+  回滚暂存文件失败: '回滚暂存文件失败',
   错误类别: '错误类别',
   错误子类别: '错误子类别',
   错误详情: '错误详情',